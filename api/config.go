@@ -48,9 +48,23 @@ type AuthRule struct {
 }
 
 // SpaceRule defines the space-scoping policy for gRPC methods matching the selector.
+// MinRole and Roles restrict scoped methods to members holding the given space roles;
+// declaring either implies Scoped.
 type SpaceRule struct {
-	Selector string `yaml:"selector"`
-	Scoped   bool   `yaml:"scoped"`
+	Selector string   `yaml:"selector"`
+	Scoped   bool     `yaml:"scoped"`
+	MinRole  string   `yaml:"min_role,omitempty"`
+	Roles    []string `yaml:"roles,omitempty"`
+}
+
+// RequiresRole reports whether the rule restricts access by space role.
+func (r SpaceRule) RequiresRole() bool {
+	return r.MinRole != "" || len(r.Roles) > 0
+}
+
+// IsScoped reports whether methods matching the rule require a space scope.
+func (r SpaceRule) IsScoped() bool {
+	return r.Scoped || r.RequiresRole()
 }
 
 // LoadServiceConfigs reads all config YAML files from the embed.FS.
@@ -286,9 +300,11 @@ func ApplyConfig(specJSON []byte) ([]byte, error) {
 
 			// Find the last matching space rule for this operation
 			spaceScoped := true // secure-by-default fallback
+			var spaceRule SpaceRule
 			for _, rule := range spaceRules {
 				if matchMethodSelector(rule.Selector, fqn) {
-					spaceScoped = rule.Scoped
+					spaceScoped = rule.IsScoped()
+					spaceRule = rule
 				}
 			}
 
@@ -308,6 +324,16 @@ func ApplyConfig(specJSON []byte) ([]byte, error) {
 				op["x-access-levels"] = alJSON
 			}
 
+			// Inject x-space-min-role / x-space-roles vendor extensions for endpoints with space role restrictions
+			if spaceScoped && spaceRule.MinRole != "" {
+				mrJSON, _ := json.Marshal(spaceRule.MinRole)
+				op["x-space-min-role"] = mrJSON
+			}
+			if spaceScoped && len(spaceRule.Roles) > 0 {
+				srJSON, _ := json.Marshal(spaceRule.Roles)
+				op["x-space-roles"] = srJSON
+			}
+
 			updatedOp, _ := json.Marshal(op)
 			pathOps[method] = updatedOp
 		}
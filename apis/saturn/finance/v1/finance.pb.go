@@ -7,6 +7,7 @@
 package financev1
 
 import (
+	_ "github.com/masterkeysrd/saturn/apis/saturn/platform/message/v1"
 	_ "github.com/masterkeysrd/saturn/apis/saturn/platform/scheduler/v1"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
@@ -193,6 +194,121 @@ func (BorrowingLinkType) EnumDescriptor() ([]byte, []int) {
 	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{2}
 }
 
+// Supported bank statement file formats.
+type StatementFormat int32
+
+const (
+	StatementFormat_STATEMENT_FORMAT_UNSPECIFIED StatementFormat = 0
+	StatementFormat_STATEMENT_FORMAT_CSV         StatementFormat = 1 // Delimited text export, parsed with a column mapping
+	StatementFormat_STATEMENT_FORMAT_OFX         StatementFormat = 2 // Open Financial Exchange, SGML (1.x) or XML (2.x)
+	StatementFormat_STATEMENT_FORMAT_QFX         StatementFormat = 3 // Quicken Web Connect, an OFX variant
+	StatementFormat_STATEMENT_FORMAT_CAMT_053    StatementFormat = 4 // ISO 20022 bank-to-customer statement
+)
+
+// Enum value maps for StatementFormat.
+var (
+	StatementFormat_name = map[int32]string{
+		0: "STATEMENT_FORMAT_UNSPECIFIED",
+		1: "STATEMENT_FORMAT_CSV",
+		2: "STATEMENT_FORMAT_OFX",
+		3: "STATEMENT_FORMAT_QFX",
+		4: "STATEMENT_FORMAT_CAMT_053",
+	}
+	StatementFormat_value = map[string]int32{
+		"STATEMENT_FORMAT_UNSPECIFIED": 0,
+		"STATEMENT_FORMAT_CSV":         1,
+		"STATEMENT_FORMAT_OFX":         2,
+		"STATEMENT_FORMAT_QFX":         3,
+		"STATEMENT_FORMAT_CAMT_053":    4,
+	}
+)
+
+func (x StatementFormat) Enum() *StatementFormat {
+	p := new(StatementFormat)
+	*p = x
+	return p
+}
+
+func (x StatementFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StatementFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_saturn_finance_v1_finance_proto_enumTypes[3].Descriptor()
+}
+
+func (StatementFormat) Type() protoreflect.EnumType {
+	return &file_saturn_finance_v1_finance_proto_enumTypes[3]
+}
+
+func (x StatementFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StatementFormat.Descriptor instead.
+func (StatementFormat) EnumDescriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{3}
+}
+
+// Supported ledger export file formats.
+type LedgerFormat int32
+
+const (
+	LedgerFormat_LEDGER_FORMAT_UNSPECIFIED LedgerFormat = 0
+	LedgerFormat_LEDGER_FORMAT_CSV         LedgerFormat = 1 // One row per transaction or split line
+	LedgerFormat_LEDGER_FORMAT_JSONL       LedgerFormat = 2 // One JSON record per line: accounts, budgets, transfers and transactions
+	LedgerFormat_LEDGER_FORMAT_OFX         LedgerFormat = 3 // OFX 2.2 with one statement per account
+	LedgerFormat_LEDGER_FORMAT_BEANCOUNT   LedgerFormat = 4 // Plain-text double-entry journal in beancount syntax
+	LedgerFormat_LEDGER_FORMAT_HLEDGER     LedgerFormat = 5 // Plain-text double-entry journal in hledger syntax
+)
+
+// Enum value maps for LedgerFormat.
+var (
+	LedgerFormat_name = map[int32]string{
+		0: "LEDGER_FORMAT_UNSPECIFIED",
+		1: "LEDGER_FORMAT_CSV",
+		2: "LEDGER_FORMAT_JSONL",
+		3: "LEDGER_FORMAT_OFX",
+		4: "LEDGER_FORMAT_BEANCOUNT",
+		5: "LEDGER_FORMAT_HLEDGER",
+	}
+	LedgerFormat_value = map[string]int32{
+		"LEDGER_FORMAT_UNSPECIFIED": 0,
+		"LEDGER_FORMAT_CSV":         1,
+		"LEDGER_FORMAT_JSONL":       2,
+		"LEDGER_FORMAT_OFX":         3,
+		"LEDGER_FORMAT_BEANCOUNT":   4,
+		"LEDGER_FORMAT_HLEDGER":     5,
+	}
+)
+
+func (x LedgerFormat) Enum() *LedgerFormat {
+	p := new(LedgerFormat)
+	*p = x
+	return p
+}
+
+func (x LedgerFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LedgerFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_saturn_finance_v1_finance_proto_enumTypes[4].Descriptor()
+}
+
+func (LedgerFormat) Type() protoreflect.EnumType {
+	return &file_saturn_finance_v1_finance_proto_enumTypes[4]
+}
+
+func (x LedgerFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LedgerFormat.Descriptor instead.
+func (LedgerFormat) EnumDescriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{4}
+}
+
 // RecurrenceInterval defines the frequency of budgeting or transaction execution rules.
 type Budget_RecurrenceInterval int32
 
@@ -205,6 +321,10 @@ const (
 	Budget_MONTHLY Budget_RecurrenceInterval = 2
 	// Budget resets or templates trigger every calendar year.
 	Budget_YEARLY Budget_RecurrenceInterval = 3
+	// Budget resets every 14 days counted from the period anchor.
+	Budget_BIWEEKLY Budget_RecurrenceInterval = 4
+	// Budget resets every calendar quarter.
+	Budget_QUARTERLY Budget_RecurrenceInterval = 5
 )
 
 // Enum value maps for Budget_RecurrenceInterval.
@@ -214,12 +334,16 @@ var (
 		1: "WEEKLY",
 		2: "MONTHLY",
 		3: "YEARLY",
+		4: "BIWEEKLY",
+		5: "QUARTERLY",
 	}
 	Budget_RecurrenceInterval_value = map[string]int32{
 		"RECURRENCE_INTERVAL_UNSPECIFIED": 0,
 		"WEEKLY":                          1,
 		"MONTHLY":                         2,
 		"YEARLY":                          3,
+		"BIWEEKLY":                        4,
+		"QUARTERLY":                       5,
 	}
 )
 
@@ -234,11 +358,11 @@ func (x Budget_RecurrenceInterval) String() string {
 }
 
 func (Budget_RecurrenceInterval) Descriptor() protoreflect.EnumDescriptor {
-	return file_saturn_finance_v1_finance_proto_enumTypes[3].Descriptor()
+	return file_saturn_finance_v1_finance_proto_enumTypes[5].Descriptor()
 }
 
 func (Budget_RecurrenceInterval) Type() protoreflect.EnumType {
-	return &file_saturn_finance_v1_finance_proto_enumTypes[3]
+	return &file_saturn_finance_v1_finance_proto_enumTypes[5]
 }
 
 func (x Budget_RecurrenceInterval) Number() protoreflect.EnumNumber {
@@ -250,6 +374,67 @@ func (Budget_RecurrenceInterval) EnumDescriptor() ([]byte, []int) {
 	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{1, 0}
 }
 
+// RolloverPolicy controls how a period's remaining balance flows into the next period.
+type Budget_RolloverPolicy int32
+
+const (
+	// Default unspecified value. Treated as NONE.
+	Budget_ROLLOVER_POLICY_UNSPECIFIED Budget_RolloverPolicy = 0
+	// Each period starts fresh from the budget limit.
+	Budget_NONE Budget_RolloverPolicy = 1
+	// Unspent amounts are added to the next period's limit.
+	Budget_CARRY_SURPLUS Budget_RolloverPolicy = 2
+	// Overspent amounts are deducted from the next period's limit.
+	Budget_CARRY_DEFICIT Budget_RolloverPolicy = 3
+	// Both unspent and overspent amounts are carried into the next period.
+	Budget_CARRY_BOTH Budget_RolloverPolicy = 4
+)
+
+// Enum value maps for Budget_RolloverPolicy.
+var (
+	Budget_RolloverPolicy_name = map[int32]string{
+		0: "ROLLOVER_POLICY_UNSPECIFIED",
+		1: "NONE",
+		2: "CARRY_SURPLUS",
+		3: "CARRY_DEFICIT",
+		4: "CARRY_BOTH",
+	}
+	Budget_RolloverPolicy_value = map[string]int32{
+		"ROLLOVER_POLICY_UNSPECIFIED": 0,
+		"NONE":                        1,
+		"CARRY_SURPLUS":               2,
+		"CARRY_DEFICIT":               3,
+		"CARRY_BOTH":                  4,
+	}
+)
+
+func (x Budget_RolloverPolicy) Enum() *Budget_RolloverPolicy {
+	p := new(Budget_RolloverPolicy)
+	*p = x
+	return p
+}
+
+func (x Budget_RolloverPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Budget_RolloverPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_saturn_finance_v1_finance_proto_enumTypes[6].Descriptor()
+}
+
+func (Budget_RolloverPolicy) Type() protoreflect.EnumType {
+	return &file_saturn_finance_v1_finance_proto_enumTypes[6]
+}
+
+func (x Budget_RolloverPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Budget_RolloverPolicy.Descriptor instead.
+func (Budget_RolloverPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{1, 1}
+}
+
 // Scoped resource view options.
 type Budget_View int32
 
@@ -287,11 +472,11 @@ func (x Budget_View) String() string {
 }
 
 func (Budget_View) Descriptor() protoreflect.EnumDescriptor {
-	return file_saturn_finance_v1_finance_proto_enumTypes[4].Descriptor()
+	return file_saturn_finance_v1_finance_proto_enumTypes[7].Descriptor()
 }
 
 func (Budget_View) Type() protoreflect.EnumType {
-	return &file_saturn_finance_v1_finance_proto_enumTypes[4]
+	return &file_saturn_finance_v1_finance_proto_enumTypes[7]
 }
 
 func (x Budget_View) Number() protoreflect.EnumNumber {
@@ -300,7 +485,7 @@ func (x Budget_View) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Budget_View.Descriptor instead.
 func (Budget_View) EnumDescriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{1, 1}
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{1, 2}
 }
 
 // Type defines the direction and flow of funds.
@@ -352,11 +537,11 @@ func (x Transaction_Type) String() string {
 }
 
 func (Transaction_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_saturn_finance_v1_finance_proto_enumTypes[5].Descriptor()
+	return file_saturn_finance_v1_finance_proto_enumTypes[8].Descriptor()
 }
 
 func (Transaction_Type) Type() protoreflect.EnumType {
-	return &file_saturn_finance_v1_finance_proto_enumTypes[5]
+	return &file_saturn_finance_v1_finance_proto_enumTypes[8]
 }
 
 func (x Transaction_Type) Number() protoreflect.EnumNumber {
@@ -365,7 +550,7 @@ func (x Transaction_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Transaction_Type.Descriptor instead.
 func (Transaction_Type) EnumDescriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{19, 0}
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{20, 0}
 }
 
 // View controls the hydration of related metadata.
@@ -405,11 +590,11 @@ func (x Transaction_View) String() string {
 }
 
 func (Transaction_View) Descriptor() protoreflect.EnumDescriptor {
-	return file_saturn_finance_v1_finance_proto_enumTypes[6].Descriptor()
+	return file_saturn_finance_v1_finance_proto_enumTypes[9].Descriptor()
 }
 
 func (Transaction_View) Type() protoreflect.EnumType {
-	return &file_saturn_finance_v1_finance_proto_enumTypes[6]
+	return &file_saturn_finance_v1_finance_proto_enumTypes[9]
 }
 
 func (x Transaction_View) Number() protoreflect.EnumNumber {
@@ -418,7 +603,133 @@ func (x Transaction_View) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Transaction_View.Descriptor instead.
 func (Transaction_View) EnumDescriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{19, 1}
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{20, 1}
+}
+
+// Cadence defines how often the income source is expected to pay out.
+type IncomeSource_Cadence int32
+
+const (
+	// Default unspecified value. Invalid fallback.
+	IncomeSource_CADENCE_UNSPECIFIED IncomeSource_Cadence = 0
+	// Paid every week.
+	IncomeSource_WEEKLY IncomeSource_Cadence = 1
+	// Paid every two weeks.
+	IncomeSource_BIWEEKLY IncomeSource_Cadence = 2
+	// Paid every calendar month.
+	IncomeSource_MONTHLY IncomeSource_Cadence = 3
+	// Paid every calendar year.
+	IncomeSource_YEARLY IncomeSource_Cadence = 4
+	// Paid on no fixed schedule (e.g. freelance work).
+	IncomeSource_IRREGULAR IncomeSource_Cadence = 5
+	// Paid following a recurrence rule that has no simple cadence.
+	IncomeSource_CUSTOM IncomeSource_Cadence = 6
+)
+
+// Enum value maps for IncomeSource_Cadence.
+var (
+	IncomeSource_Cadence_name = map[int32]string{
+		0: "CADENCE_UNSPECIFIED",
+		1: "WEEKLY",
+		2: "BIWEEKLY",
+		3: "MONTHLY",
+		4: "YEARLY",
+		5: "IRREGULAR",
+		6: "CUSTOM",
+	}
+	IncomeSource_Cadence_value = map[string]int32{
+		"CADENCE_UNSPECIFIED": 0,
+		"WEEKLY":              1,
+		"BIWEEKLY":            2,
+		"MONTHLY":             3,
+		"YEARLY":              4,
+		"IRREGULAR":           5,
+		"CUSTOM":              6,
+	}
+)
+
+func (x IncomeSource_Cadence) Enum() *IncomeSource_Cadence {
+	p := new(IncomeSource_Cadence)
+	*p = x
+	return p
+}
+
+func (x IncomeSource_Cadence) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IncomeSource_Cadence) Descriptor() protoreflect.EnumDescriptor {
+	return file_saturn_finance_v1_finance_proto_enumTypes[10].Descriptor()
+}
+
+func (IncomeSource_Cadence) Type() protoreflect.EnumType {
+	return &file_saturn_finance_v1_finance_proto_enumTypes[10]
+}
+
+func (x IncomeSource_Cadence) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IncomeSource_Cadence.Descriptor instead.
+func (IncomeSource_Cadence) EnumDescriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{28, 0}
+}
+
+// Status defines the lifecycle state of a goal.
+type Goal_Status int32
+
+const (
+	// Default unspecified value. Invalid fallback.
+	Goal_STATUS_UNSPECIFIED Goal_Status = 0
+	// The goal is being saved towards.
+	Goal_ACTIVE Goal_Status = 1
+	// The goal has been reached.
+	Goal_COMPLETED Goal_Status = 2
+	// The goal is no longer tracked.
+	Goal_ARCHIVED Goal_Status = 3
+)
+
+// Enum value maps for Goal_Status.
+var (
+	Goal_Status_name = map[int32]string{
+		0: "STATUS_UNSPECIFIED",
+		1: "ACTIVE",
+		2: "COMPLETED",
+		3: "ARCHIVED",
+	}
+	Goal_Status_value = map[string]int32{
+		"STATUS_UNSPECIFIED": 0,
+		"ACTIVE":             1,
+		"COMPLETED":          2,
+		"ARCHIVED":           3,
+	}
+)
+
+func (x Goal_Status) Enum() *Goal_Status {
+	p := new(Goal_Status)
+	*p = x
+	return p
+}
+
+func (x Goal_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Goal_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_saturn_finance_v1_finance_proto_enumTypes[11].Descriptor()
+}
+
+func (Goal_Status) Type() protoreflect.EnumType {
+	return &file_saturn_finance_v1_finance_proto_enumTypes[11]
+}
+
+func (x Goal_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Goal_Status.Descriptor instead.
+func (Goal_Status) EnumDescriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{35, 0}
 }
 
 // Scoped resource representation view level.
@@ -455,11 +766,11 @@ func (x RecurringExpense_View) String() string {
 }
 
 func (RecurringExpense_View) Descriptor() protoreflect.EnumDescriptor {
-	return file_saturn_finance_v1_finance_proto_enumTypes[7].Descriptor()
+	return file_saturn_finance_v1_finance_proto_enumTypes[12].Descriptor()
 }
 
 func (RecurringExpense_View) Type() protoreflect.EnumType {
-	return &file_saturn_finance_v1_finance_proto_enumTypes[7]
+	return &file_saturn_finance_v1_finance_proto_enumTypes[12]
 }
 
 func (x RecurringExpense_View) Number() protoreflect.EnumNumber {
@@ -468,7 +779,7 @@ func (x RecurringExpense_View) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use RecurringExpense_View.Descriptor instead.
 func (RecurringExpense_View) EnumDescriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{31, 0}
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{90, 0}
 }
 
 // Execution interval recurrence rule.
@@ -479,6 +790,10 @@ const (
 	RecurringExpense_WEEKLY               RecurringExpense_Interval = 1
 	RecurringExpense_MONTHLY              RecurringExpense_Interval = 2
 	RecurringExpense_YEARLY               RecurringExpense_Interval = 3
+	// Derived from a recurrence rule repeating every two weeks.
+	RecurringExpense_BIWEEKLY RecurringExpense_Interval = 4
+	// Derived from a recurrence rule that has no simple interval.
+	RecurringExpense_CUSTOM RecurringExpense_Interval = 5
 )
 
 // Enum value maps for RecurringExpense_Interval.
@@ -488,12 +803,16 @@ var (
 		1: "WEEKLY",
 		2: "MONTHLY",
 		3: "YEARLY",
+		4: "BIWEEKLY",
+		5: "CUSTOM",
 	}
 	RecurringExpense_Interval_value = map[string]int32{
 		"INTERVAL_UNSPECIFIED": 0,
 		"WEEKLY":               1,
 		"MONTHLY":              2,
 		"YEARLY":               3,
+		"BIWEEKLY":             4,
+		"CUSTOM":               5,
 	}
 )
 
@@ -508,11 +827,11 @@ func (x RecurringExpense_Interval) String() string {
 }
 
 func (RecurringExpense_Interval) Descriptor() protoreflect.EnumDescriptor {
-	return file_saturn_finance_v1_finance_proto_enumTypes[8].Descriptor()
+	return file_saturn_finance_v1_finance_proto_enumTypes[13].Descriptor()
 }
 
 func (RecurringExpense_Interval) Type() protoreflect.EnumType {
-	return &file_saturn_finance_v1_finance_proto_enumTypes[8]
+	return &file_saturn_finance_v1_finance_proto_enumTypes[13]
 }
 
 func (x RecurringExpense_Interval) Number() protoreflect.EnumNumber {
@@ -521,7 +840,7 @@ func (x RecurringExpense_Interval) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use RecurringExpense_Interval.Descriptor instead.
 func (RecurringExpense_Interval) EnumDescriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{31, 1}
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{90, 1}
 }
 
 // Active template status.
@@ -561,11 +880,11 @@ func (x RecurringExpense_Status) String() string {
 }
 
 func (RecurringExpense_Status) Descriptor() protoreflect.EnumDescriptor {
-	return file_saturn_finance_v1_finance_proto_enumTypes[9].Descriptor()
+	return file_saturn_finance_v1_finance_proto_enumTypes[14].Descriptor()
 }
 
 func (RecurringExpense_Status) Type() protoreflect.EnumType {
-	return &file_saturn_finance_v1_finance_proto_enumTypes[9]
+	return &file_saturn_finance_v1_finance_proto_enumTypes[14]
 }
 
 func (x RecurringExpense_Status) Number() protoreflect.EnumNumber {
@@ -574,7 +893,7 @@ func (x RecurringExpense_Status) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use RecurringExpense_Status.Descriptor instead.
 func (RecurringExpense_Status) EnumDescriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{31, 2}
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{90, 2}
 }
 
 // Scoped resource representation view level.
@@ -611,11 +930,11 @@ func (x ScheduledPayment_View) String() string {
 }
 
 func (ScheduledPayment_View) Descriptor() protoreflect.EnumDescriptor {
-	return file_saturn_finance_v1_finance_proto_enumTypes[10].Descriptor()
+	return file_saturn_finance_v1_finance_proto_enumTypes[15].Descriptor()
 }
 
 func (ScheduledPayment_View) Type() protoreflect.EnumType {
-	return &file_saturn_finance_v1_finance_proto_enumTypes[10]
+	return &file_saturn_finance_v1_finance_proto_enumTypes[15]
 }
 
 func (x ScheduledPayment_View) Number() protoreflect.EnumNumber {
@@ -624,7 +943,7 @@ func (x ScheduledPayment_View) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ScheduledPayment_View.Descriptor instead.
 func (ScheduledPayment_View) EnumDescriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{32, 0}
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{91, 0}
 }
 
 // Parent template source type.
@@ -635,6 +954,7 @@ const (
 	ScheduledPayment_RECURRENT_EXPENSE       ScheduledPayment_SourceType = 1
 	ScheduledPayment_LOAN                    ScheduledPayment_SourceType = 2
 	ScheduledPayment_TAX                     ScheduledPayment_SourceType = 3
+	ScheduledPayment_CARD_STATEMENT          ScheduledPayment_SourceType = 4
 )
 
 // Enum value maps for ScheduledPayment_SourceType.
@@ -644,12 +964,14 @@ var (
 		1: "RECURRENT_EXPENSE",
 		2: "LOAN",
 		3: "TAX",
+		4: "CARD_STATEMENT",
 	}
 	ScheduledPayment_SourceType_value = map[string]int32{
 		"SOURCE_TYPE_UNSPECIFIED": 0,
 		"RECURRENT_EXPENSE":       1,
 		"LOAN":                    2,
 		"TAX":                     3,
+		"CARD_STATEMENT":          4,
 	}
 )
 
@@ -664,11 +986,11 @@ func (x ScheduledPayment_SourceType) String() string {
 }
 
 func (ScheduledPayment_SourceType) Descriptor() protoreflect.EnumDescriptor {
-	return file_saturn_finance_v1_finance_proto_enumTypes[11].Descriptor()
+	return file_saturn_finance_v1_finance_proto_enumTypes[16].Descriptor()
 }
 
 func (ScheduledPayment_SourceType) Type() protoreflect.EnumType {
-	return &file_saturn_finance_v1_finance_proto_enumTypes[11]
+	return &file_saturn_finance_v1_finance_proto_enumTypes[16]
 }
 
 func (x ScheduledPayment_SourceType) Number() protoreflect.EnumNumber {
@@ -677,7 +999,7 @@ func (x ScheduledPayment_SourceType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ScheduledPayment_SourceType.Descriptor instead.
 func (ScheduledPayment_SourceType) EnumDescriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{32, 1}
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{91, 1}
 }
 
 // Instance execution status.
@@ -720,11 +1042,11 @@ func (x ScheduledPayment_Status) String() string {
 }
 
 func (ScheduledPayment_Status) Descriptor() protoreflect.EnumDescriptor {
-	return file_saturn_finance_v1_finance_proto_enumTypes[12].Descriptor()
+	return file_saturn_finance_v1_finance_proto_enumTypes[17].Descriptor()
 }
 
 func (ScheduledPayment_Status) Type() protoreflect.EnumType {
-	return &file_saturn_finance_v1_finance_proto_enumTypes[12]
+	return &file_saturn_finance_v1_finance_proto_enumTypes[17]
 }
 
 func (x ScheduledPayment_Status) Number() protoreflect.EnumNumber {
@@ -733,7 +1055,7 @@ func (x ScheduledPayment_Status) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ScheduledPayment_Status.Descriptor instead.
 func (ScheduledPayment_Status) EnumDescriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{32, 2}
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{91, 2}
 }
 
 // BorrowingDirection defines the type/direction of personal debt agreements.
@@ -773,11 +1095,11 @@ func (x Borrowing_Direction) String() string {
 }
 
 func (Borrowing_Direction) Descriptor() protoreflect.EnumDescriptor {
-	return file_saturn_finance_v1_finance_proto_enumTypes[13].Descriptor()
+	return file_saturn_finance_v1_finance_proto_enumTypes[18].Descriptor()
 }
 
 func (Borrowing_Direction) Type() protoreflect.EnumType {
-	return &file_saturn_finance_v1_finance_proto_enumTypes[13]
+	return &file_saturn_finance_v1_finance_proto_enumTypes[18]
 }
 
 func (x Borrowing_Direction) Number() protoreflect.EnumNumber {
@@ -786,7 +1108,7 @@ func (x Borrowing_Direction) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Borrowing_Direction.Descriptor instead.
 func (Borrowing_Direction) EnumDescriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{44, 0}
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{107, 0}
 }
 
 // BorrowingStatus defines the lifecycle status of debt agreements.
@@ -826,11 +1148,11 @@ func (x Borrowing_Status) String() string {
 }
 
 func (Borrowing_Status) Descriptor() protoreflect.EnumDescriptor {
-	return file_saturn_finance_v1_finance_proto_enumTypes[14].Descriptor()
+	return file_saturn_finance_v1_finance_proto_enumTypes[19].Descriptor()
 }
 
 func (Borrowing_Status) Type() protoreflect.EnumType {
-	return &file_saturn_finance_v1_finance_proto_enumTypes[14]
+	return &file_saturn_finance_v1_finance_proto_enumTypes[19]
 }
 
 func (x Borrowing_Status) Number() protoreflect.EnumNumber {
@@ -839,121 +1161,357 @@ func (x Borrowing_Status) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Borrowing_Status.Descriptor instead.
 func (Borrowing_Status) EnumDescriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{44, 1}
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{107, 1}
 }
 
-// Type defines the classification of payment accounts.
-type Account_Type int32
+// InterestType defines how interest is charged on an instalment plan.
+type Borrowing_InterestType int32
 
 const (
-	// Default unspecified type. Invalid fallback.
-	Account_TYPE_UNSPECIFIED Account_Type = 0
-	// Checking or savings bank account.
-	Account_BANK Account_Type = 1
-	// Credit card account. Credit accounts support negative balances.
-	Account_CREDIT_CARD Account_Type = 2
-	// Cash balance.
-	Account_CASH Account_Type = 3
-	// Digital wallet or virtual balance.
-	Account_DIGITAL_ACCOUNT Account_Type = 4
+	// Default unspecified value. Treated as no interest.
+	Borrowing_INTEREST_TYPE_UNSPECIFIED Borrowing_InterestType = 0
+	// No interest; instalments split the principal evenly.
+	Borrowing_NO_INTEREST Borrowing_InterestType = 1
+	// Flat interest on the original principal for the whole term.
+	Borrowing_SIMPLE Borrowing_InterestType = 2
+	// Interest on the outstanding balance each period, repaid with level payments.
+	Borrowing_COMPOUND Borrowing_InterestType = 3
 )
 
-// Enum value maps for Account_Type.
+// Enum value maps for Borrowing_InterestType.
 var (
-	Account_Type_name = map[int32]string{
-		0: "TYPE_UNSPECIFIED",
-		1: "BANK",
-		2: "CREDIT_CARD",
-		3: "CASH",
-		4: "DIGITAL_ACCOUNT",
-	}
-	Account_Type_value = map[string]int32{
-		"TYPE_UNSPECIFIED": 0,
-		"BANK":             1,
-		"CREDIT_CARD":      2,
-		"CASH":             3,
-		"DIGITAL_ACCOUNT":  4,
+	Borrowing_InterestType_name = map[int32]string{
+		0: "INTEREST_TYPE_UNSPECIFIED",
+		1: "NO_INTEREST",
+		2: "SIMPLE",
+		3: "COMPOUND",
+	}
+	Borrowing_InterestType_value = map[string]int32{
+		"INTEREST_TYPE_UNSPECIFIED": 0,
+		"NO_INTEREST":               1,
+		"SIMPLE":                    2,
+		"COMPOUND":                  3,
 	}
 )
 
-func (x Account_Type) Enum() *Account_Type {
-	p := new(Account_Type)
+func (x Borrowing_InterestType) Enum() *Borrowing_InterestType {
+	p := new(Borrowing_InterestType)
 	*p = x
 	return p
 }
 
-func (x Account_Type) String() string {
+func (x Borrowing_InterestType) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (Account_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_saturn_finance_v1_finance_proto_enumTypes[15].Descriptor()
+func (Borrowing_InterestType) Descriptor() protoreflect.EnumDescriptor {
+	return file_saturn_finance_v1_finance_proto_enumTypes[20].Descriptor()
 }
 
-func (Account_Type) Type() protoreflect.EnumType {
-	return &file_saturn_finance_v1_finance_proto_enumTypes[15]
+func (Borrowing_InterestType) Type() protoreflect.EnumType {
+	return &file_saturn_finance_v1_finance_proto_enumTypes[20]
 }
 
-func (x Account_Type) Number() protoreflect.EnumNumber {
+func (x Borrowing_InterestType) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use Account_Type.Descriptor instead.
-func (Account_Type) EnumDescriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{59, 0}
+// Deprecated: Use Borrowing_InterestType.Descriptor instead.
+func (Borrowing_InterestType) EnumDescriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{107, 2}
 }
 
-// View controls the hydration of related metadata.
-type Account_View int32
+// InstallmentFrequency defines the spacing between instalments.
+type Borrowing_InstallmentFrequency int32
 
 const (
-	// Default view. Resolves only basic configuration fields.
-	Account_VIEW_UNSPECIFIED Account_View = 0
-	// Returns account details without base currency conversion calculations.
-	Account_BASIC Account_View = 1
-	// Hydrates complete structure including exchange rate conversion metrics.
-	Account_FULL Account_View = 2
+	// Default unspecified value. Invalid when an instalment plan is set.
+	Borrowing_INSTALLMENT_FREQUENCY_UNSPECIFIED Borrowing_InstallmentFrequency = 0
+	// Every week.
+	Borrowing_WEEKLY Borrowing_InstallmentFrequency = 1
+	// Every two weeks.
+	Borrowing_BIWEEKLY Borrowing_InstallmentFrequency = 2
+	// Every month, on the day of month of the first instalment.
+	Borrowing_MONTHLY Borrowing_InstallmentFrequency = 3
+	// Every three months.
+	Borrowing_QUARTERLY Borrowing_InstallmentFrequency = 4
+	// Every year.
+	Borrowing_YEARLY Borrowing_InstallmentFrequency = 5
 )
 
-// Enum value maps for Account_View.
+// Enum value maps for Borrowing_InstallmentFrequency.
 var (
-	Account_View_name = map[int32]string{
-		0: "VIEW_UNSPECIFIED",
-		1: "BASIC",
-		2: "FULL",
+	Borrowing_InstallmentFrequency_name = map[int32]string{
+		0: "INSTALLMENT_FREQUENCY_UNSPECIFIED",
+		1: "WEEKLY",
+		2: "BIWEEKLY",
+		3: "MONTHLY",
+		4: "QUARTERLY",
+		5: "YEARLY",
 	}
-	Account_View_value = map[string]int32{
-		"VIEW_UNSPECIFIED": 0,
-		"BASIC":            1,
-		"FULL":             2,
+	Borrowing_InstallmentFrequency_value = map[string]int32{
+		"INSTALLMENT_FREQUENCY_UNSPECIFIED": 0,
+		"WEEKLY":                            1,
+		"BIWEEKLY":                          2,
+		"MONTHLY":                           3,
+		"QUARTERLY":                         4,
+		"YEARLY":                            5,
 	}
 )
 
-func (x Account_View) Enum() *Account_View {
-	p := new(Account_View)
+func (x Borrowing_InstallmentFrequency) Enum() *Borrowing_InstallmentFrequency {
+	p := new(Borrowing_InstallmentFrequency)
 	*p = x
 	return p
 }
 
-func (x Account_View) String() string {
+func (x Borrowing_InstallmentFrequency) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (Account_View) Descriptor() protoreflect.EnumDescriptor {
-	return file_saturn_finance_v1_finance_proto_enumTypes[16].Descriptor()
+func (Borrowing_InstallmentFrequency) Descriptor() protoreflect.EnumDescriptor {
+	return file_saturn_finance_v1_finance_proto_enumTypes[21].Descriptor()
 }
 
-func (Account_View) Type() protoreflect.EnumType {
-	return &file_saturn_finance_v1_finance_proto_enumTypes[16]
+func (Borrowing_InstallmentFrequency) Type() protoreflect.EnumType {
+	return &file_saturn_finance_v1_finance_proto_enumTypes[21]
 }
 
-func (x Account_View) Number() protoreflect.EnumNumber {
+func (x Borrowing_InstallmentFrequency) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use Account_View.Descriptor instead.
+// Deprecated: Use Borrowing_InstallmentFrequency.Descriptor instead.
+func (Borrowing_InstallmentFrequency) EnumDescriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{107, 3}
+}
+
+// Status defines the repayment state of an instalment.
+type BorrowingInstallment_Status int32
+
+const (
+	// Default unspecified value.
+	BorrowingInstallment_STATUS_UNSPECIFIED BorrowingInstallment_Status = 0
+	// Fully repaid.
+	BorrowingInstallment_PAID BorrowingInstallment_Status = 1
+	// Partially repaid and not yet due.
+	BorrowingInstallment_PARTIAL BorrowingInstallment_Status = 2
+	// Past its due date and not fully repaid.
+	BorrowingInstallment_OVERDUE BorrowingInstallment_Status = 3
+	// Not yet due.
+	BorrowingInstallment_UPCOMING BorrowingInstallment_Status = 4
+)
+
+// Enum value maps for BorrowingInstallment_Status.
+var (
+	BorrowingInstallment_Status_name = map[int32]string{
+		0: "STATUS_UNSPECIFIED",
+		1: "PAID",
+		2: "PARTIAL",
+		3: "OVERDUE",
+		4: "UPCOMING",
+	}
+	BorrowingInstallment_Status_value = map[string]int32{
+		"STATUS_UNSPECIFIED": 0,
+		"PAID":               1,
+		"PARTIAL":            2,
+		"OVERDUE":            3,
+		"UPCOMING":           4,
+	}
+)
+
+func (x BorrowingInstallment_Status) Enum() *BorrowingInstallment_Status {
+	p := new(BorrowingInstallment_Status)
+	*p = x
+	return p
+}
+
+func (x BorrowingInstallment_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BorrowingInstallment_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_saturn_finance_v1_finance_proto_enumTypes[22].Descriptor()
+}
+
+func (BorrowingInstallment_Status) Type() protoreflect.EnumType {
+	return &file_saturn_finance_v1_finance_proto_enumTypes[22]
+}
+
+func (x BorrowingInstallment_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BorrowingInstallment_Status.Descriptor instead.
+func (BorrowingInstallment_Status) EnumDescriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{108, 0}
+}
+
+// Type defines the classification of payment accounts.
+type Account_Type int32
+
+const (
+	// Default unspecified type. Invalid fallback.
+	Account_TYPE_UNSPECIFIED Account_Type = 0
+	// Checking or savings bank account.
+	Account_BANK Account_Type = 1
+	// Credit card account. Credit accounts support negative balances.
+	Account_CREDIT_CARD Account_Type = 2
+	// Cash balance.
+	Account_CASH Account_Type = 3
+	// Digital wallet or virtual balance.
+	Account_DIGITAL_ACCOUNT Account_Type = 4
+)
+
+// Enum value maps for Account_Type.
+var (
+	Account_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "BANK",
+		2: "CREDIT_CARD",
+		3: "CASH",
+		4: "DIGITAL_ACCOUNT",
+	}
+	Account_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED": 0,
+		"BANK":             1,
+		"CREDIT_CARD":      2,
+		"CASH":             3,
+		"DIGITAL_ACCOUNT":  4,
+	}
+)
+
+func (x Account_Type) Enum() *Account_Type {
+	p := new(Account_Type)
+	*p = x
+	return p
+}
+
+func (x Account_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Account_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_saturn_finance_v1_finance_proto_enumTypes[23].Descriptor()
+}
+
+func (Account_Type) Type() protoreflect.EnumType {
+	return &file_saturn_finance_v1_finance_proto_enumTypes[23]
+}
+
+func (x Account_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Account_Type.Descriptor instead.
+func (Account_Type) EnumDescriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{123, 0}
+}
+
+// View controls the hydration of related metadata.
+type Account_View int32
+
+const (
+	// Default view. Resolves only basic configuration fields.
+	Account_VIEW_UNSPECIFIED Account_View = 0
+	// Returns account details without base currency conversion calculations.
+	Account_BASIC Account_View = 1
+	// Hydrates complete structure including exchange rate conversion metrics.
+	Account_FULL Account_View = 2
+)
+
+// Enum value maps for Account_View.
+var (
+	Account_View_name = map[int32]string{
+		0: "VIEW_UNSPECIFIED",
+		1: "BASIC",
+		2: "FULL",
+	}
+	Account_View_value = map[string]int32{
+		"VIEW_UNSPECIFIED": 0,
+		"BASIC":            1,
+		"FULL":             2,
+	}
+)
+
+func (x Account_View) Enum() *Account_View {
+	p := new(Account_View)
+	*p = x
+	return p
+}
+
+func (x Account_View) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Account_View) Descriptor() protoreflect.EnumDescriptor {
+	return file_saturn_finance_v1_finance_proto_enumTypes[24].Descriptor()
+}
+
+func (Account_View) Type() protoreflect.EnumType {
+	return &file_saturn_finance_v1_finance_proto_enumTypes[24]
+}
+
+func (x Account_View) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Account_View.Descriptor instead.
 func (Account_View) EnumDescriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{59, 1}
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{123, 1}
+}
+
+// Status defines the lifecycle of a reconciliation session.
+type Reconciliation_Status int32
+
+const (
+	// Default unspecified status.
+	Reconciliation_STATUS_UNSPECIFIED Reconciliation_Status = 0
+	// Transactions are still being cleared against the statement.
+	Reconciliation_IN_PROGRESS Reconciliation_Status = 1
+	// The session is closed and its transactions are locked.
+	Reconciliation_FINALIZED Reconciliation_Status = 2
+)
+
+// Enum value maps for Reconciliation_Status.
+var (
+	Reconciliation_Status_name = map[int32]string{
+		0: "STATUS_UNSPECIFIED",
+		1: "IN_PROGRESS",
+		2: "FINALIZED",
+	}
+	Reconciliation_Status_value = map[string]int32{
+		"STATUS_UNSPECIFIED": 0,
+		"IN_PROGRESS":        1,
+		"FINALIZED":          2,
+	}
+)
+
+func (x Reconciliation_Status) Enum() *Reconciliation_Status {
+	p := new(Reconciliation_Status)
+	*p = x
+	return p
+}
+
+func (x Reconciliation_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Reconciliation_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_saturn_finance_v1_finance_proto_enumTypes[25].Descriptor()
+}
+
+func (Reconciliation_Status) Type() protoreflect.EnumType {
+	return &file_saturn_finance_v1_finance_proto_enumTypes[25]
+}
+
+func (x Reconciliation_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Reconciliation_Status.Descriptor instead.
+func (Reconciliation_Status) EnumDescriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{128, 0}
 }
 
 // Staging lifecycle status enum.
@@ -996,11 +1554,11 @@ func (x InboxItem_Status) String() string {
 }
 
 func (InboxItem_Status) Descriptor() protoreflect.EnumDescriptor {
-	return file_saturn_finance_v1_finance_proto_enumTypes[17].Descriptor()
+	return file_saturn_finance_v1_finance_proto_enumTypes[26].Descriptor()
 }
 
 func (InboxItem_Status) Type() protoreflect.EnumType {
-	return &file_saturn_finance_v1_finance_proto_enumTypes[17]
+	return &file_saturn_finance_v1_finance_proto_enumTypes[26]
 }
 
 func (x InboxItem_Status) Number() protoreflect.EnumNumber {
@@ -1009,7 +1567,7 @@ func (x InboxItem_Status) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use InboxItem_Status.Descriptor instead.
 func (InboxItem_Status) EnumDescriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{74, 0}
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{148, 0}
 }
 
 // Document category classification enum.
@@ -1055,11 +1613,11 @@ func (x InboxItem_DocType) String() string {
 }
 
 func (InboxItem_DocType) Descriptor() protoreflect.EnumDescriptor {
-	return file_saturn_finance_v1_finance_proto_enumTypes[18].Descriptor()
+	return file_saturn_finance_v1_finance_proto_enumTypes[27].Descriptor()
 }
 
 func (InboxItem_DocType) Type() protoreflect.EnumType {
-	return &file_saturn_finance_v1_finance_proto_enumTypes[18]
+	return &file_saturn_finance_v1_finance_proto_enumTypes[27]
 }
 
 func (x InboxItem_DocType) Number() protoreflect.EnumNumber {
@@ -1068,7 +1626,7 @@ func (x InboxItem_DocType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use InboxItem_DocType.Descriptor instead.
 func (InboxItem_DocType) EnumDescriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{74, 1}
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{148, 1}
 }
 
 // Optional representation view.
@@ -1105,11 +1663,11 @@ func (x InboxItem_View) String() string {
 }
 
 func (InboxItem_View) Descriptor() protoreflect.EnumDescriptor {
-	return file_saturn_finance_v1_finance_proto_enumTypes[19].Descriptor()
+	return file_saturn_finance_v1_finance_proto_enumTypes[28].Descriptor()
 }
 
 func (InboxItem_View) Type() protoreflect.EnumType {
-	return &file_saturn_finance_v1_finance_proto_enumTypes[19]
+	return &file_saturn_finance_v1_finance_proto_enumTypes[28]
 }
 
 func (x InboxItem_View) Number() protoreflect.EnumNumber {
@@ -1118,87 +1676,486 @@ func (x InboxItem_View) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use InboxItem_View.Descriptor instead.
 func (InboxItem_View) EnumDescriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{74, 2}
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{148, 2}
 }
 
-// FinanceSettings represents the workspace configuration.
-type FinanceSettings struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Output only. Associated space identifier.
-	SpaceId string `protobuf:"bytes,1,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
-	// Required. Base currency for conversions and insights (e.g. "USD").
-	// Analytics calculations and overall spend views default to this currency.
-	BaseCurrency string `protobuf:"bytes,2,opt,name=base_currency,json=baseCurrency,proto3" json:"base_currency,omitempty"`
-	// Output only. Creation time of the settings.
-	CreateTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
-	// Output only. Last update time of the settings.
-	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// Processing state of an export.
+type LedgerExport_Status int32
+
+const (
+	LedgerExport_STATUS_UNSPECIFIED LedgerExport_Status = 0
+	LedgerExport_PENDING            LedgerExport_Status = 1 // Queued for processing
+	LedgerExport_RUNNING            LedgerExport_Status = 2 // Being generated
+	LedgerExport_COMPLETED          LedgerExport_Status = 3 // Ready for download
+	LedgerExport_FAILED             LedgerExport_Status = 4 // Generation failed; see `error`
+)
+
+// Enum value maps for LedgerExport_Status.
+var (
+	LedgerExport_Status_name = map[int32]string{
+		0: "STATUS_UNSPECIFIED",
+		1: "PENDING",
+		2: "RUNNING",
+		3: "COMPLETED",
+		4: "FAILED",
+	}
+	LedgerExport_Status_value = map[string]int32{
+		"STATUS_UNSPECIFIED": 0,
+		"PENDING":            1,
+		"RUNNING":            2,
+		"COMPLETED":          3,
+		"FAILED":             4,
+	}
+)
+
+func (x LedgerExport_Status) Enum() *LedgerExport_Status {
+	p := new(LedgerExport_Status)
+	*p = x
+	return p
 }
 
-func (x *FinanceSettings) Reset() {
-	*x = FinanceSettings{}
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[0]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x LedgerExport_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *FinanceSettings) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (LedgerExport_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_saturn_finance_v1_finance_proto_enumTypes[29].Descriptor()
 }
 
-func (*FinanceSettings) ProtoMessage() {}
+func (LedgerExport_Status) Type() protoreflect.EnumType {
+	return &file_saturn_finance_v1_finance_proto_enumTypes[29]
+}
 
-func (x *FinanceSettings) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[0]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (x LedgerExport_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use FinanceSettings.ProtoReflect.Descriptor instead.
-func (*FinanceSettings) Descriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use LedgerExport_Status.Descriptor instead.
+func (LedgerExport_Status) EnumDescriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{160, 0}
 }
 
-func (x *FinanceSettings) GetSpaceId() string {
-	if x != nil {
-		return x.SpaceId
+// Status tracks how much of the statement balance has been paid.
+type CardStatement_Status int32
+
+const (
+	// Default unspecified status.
+	CardStatement_STATUS_UNSPECIFIED CardStatement_Status = 0
+	// Statement issued with nothing paid yet.
+	CardStatement_ISSUED CardStatement_Status = 1
+	// Part of the statement balance has been paid.
+	CardStatement_PARTIALLY_PAID CardStatement_Status = 2
+	// The statement balance is fully paid.
+	CardStatement_PAID CardStatement_Status = 3
+)
+
+// Enum value maps for CardStatement_Status.
+var (
+	CardStatement_Status_name = map[int32]string{
+		0: "STATUS_UNSPECIFIED",
+		1: "ISSUED",
+		2: "PARTIALLY_PAID",
+		3: "PAID",
 	}
-	return ""
+	CardStatement_Status_value = map[string]int32{
+		"STATUS_UNSPECIFIED": 0,
+		"ISSUED":             1,
+		"PARTIALLY_PAID":     2,
+		"PAID":               3,
+	}
+)
+
+func (x CardStatement_Status) Enum() *CardStatement_Status {
+	p := new(CardStatement_Status)
+	*p = x
+	return p
 }
 
-func (x *FinanceSettings) GetBaseCurrency() string {
-	if x != nil {
-		return x.BaseCurrency
-	}
-	return ""
+func (x CardStatement_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *FinanceSettings) GetCreateTime() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreateTime
-	}
-	return nil
+func (CardStatement_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_saturn_finance_v1_finance_proto_enumTypes[30].Descriptor()
 }
 
-func (x *FinanceSettings) GetUpdateTime() *timestamppb.Timestamp {
-	if x != nil {
-		return x.UpdateTime
-	}
-	return nil
+func (CardStatement_Status) Type() protoreflect.EnumType {
+	return &file_saturn_finance_v1_finance_proto_enumTypes[30]
 }
 
-// Budget represents a budget template definition.
-type Budget struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Output only. Budget template identifier.
+func (x CardStatement_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CardStatement_Status.Descriptor instead.
+func (CardStatement_Status) EnumDescriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{166, 0}
+}
+
+// Kind identifies what produced the movement.
+type ForecastEvent_Kind int32
+
+const (
+	// Default unspecified kind.
+	ForecastEvent_KIND_UNSPECIFIED ForecastEvent_Kind = 0
+	// A pending scheduled payment.
+	ForecastEvent_SCHEDULED_PAYMENT ForecastEvent_Kind = 1
+	// A recurring expense occurrence not yet scheduled.
+	ForecastEvent_RECURRING_EXPENSE ForecastEvent_Kind = 2
+	// A borrowing instalment.
+	ForecastEvent_LOAN_INSTALLMENT ForecastEvent_Kind = 3
+	// A credit card statement payment.
+	ForecastEvent_CARD_PAYMENT ForecastEvent_Kind = 4
+	// Expected income.
+	ForecastEvent_INCOME ForecastEvent_Kind = 5
+)
+
+// Enum value maps for ForecastEvent_Kind.
+var (
+	ForecastEvent_Kind_name = map[int32]string{
+		0: "KIND_UNSPECIFIED",
+		1: "SCHEDULED_PAYMENT",
+		2: "RECURRING_EXPENSE",
+		3: "LOAN_INSTALLMENT",
+		4: "CARD_PAYMENT",
+		5: "INCOME",
+	}
+	ForecastEvent_Kind_value = map[string]int32{
+		"KIND_UNSPECIFIED":  0,
+		"SCHEDULED_PAYMENT": 1,
+		"RECURRING_EXPENSE": 2,
+		"LOAN_INSTALLMENT":  3,
+		"CARD_PAYMENT":      4,
+		"INCOME":            5,
+	}
+)
+
+func (x ForecastEvent_Kind) Enum() *ForecastEvent_Kind {
+	p := new(ForecastEvent_Kind)
+	*p = x
+	return p
+}
+
+func (x ForecastEvent_Kind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ForecastEvent_Kind) Descriptor() protoreflect.EnumDescriptor {
+	return file_saturn_finance_v1_finance_proto_enumTypes[31].Descriptor()
+}
+
+func (ForecastEvent_Kind) Type() protoreflect.EnumType {
+	return &file_saturn_finance_v1_finance_proto_enumTypes[31]
+}
+
+func (x ForecastEvent_Kind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ForecastEvent_Kind.Descriptor instead.
+func (ForecastEvent_Kind) EnumDescriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{174, 0}
+}
+
+// Kind classifies the low balance state.
+type ForecastAlert_Kind int32
+
+const (
+	// Default unspecified kind.
+	ForecastAlert_KIND_UNSPECIFIED ForecastAlert_Kind = 0
+	// The balance, or available credit, drops below zero.
+	ForecastAlert_BELOW_ZERO ForecastAlert_Kind = 1
+	// The balance, or available credit, drops below the account threshold.
+	ForecastAlert_BELOW_THRESHOLD ForecastAlert_Kind = 2
+)
+
+// Enum value maps for ForecastAlert_Kind.
+var (
+	ForecastAlert_Kind_name = map[int32]string{
+		0: "KIND_UNSPECIFIED",
+		1: "BELOW_ZERO",
+		2: "BELOW_THRESHOLD",
+	}
+	ForecastAlert_Kind_value = map[string]int32{
+		"KIND_UNSPECIFIED": 0,
+		"BELOW_ZERO":       1,
+		"BELOW_THRESHOLD":  2,
+	}
+)
+
+func (x ForecastAlert_Kind) Enum() *ForecastAlert_Kind {
+	p := new(ForecastAlert_Kind)
+	*p = x
+	return p
+}
+
+func (x ForecastAlert_Kind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ForecastAlert_Kind) Descriptor() protoreflect.EnumDescriptor {
+	return file_saturn_finance_v1_finance_proto_enumTypes[32].Descriptor()
+}
+
+func (ForecastAlert_Kind) Type() protoreflect.EnumType {
+	return &file_saturn_finance_v1_finance_proto_enumTypes[32]
+}
+
+func (x ForecastAlert_Kind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ForecastAlert_Kind.Descriptor instead.
+func (ForecastAlert_Kind) EnumDescriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{175, 0}
+}
+
+// Rule identifies the rule that raised the alert.
+type Alert_Rule int32
+
+const (
+	// Default unspecified rule.
+	Alert_RULE_UNSPECIFIED Alert_Rule = 0
+	// Budget spending reached one of the configured percentages of its limit.
+	Alert_BUDGET_THRESHOLD Alert_Rule = 1
+	// The current spending pace exceeds the budget limit before the period ends.
+	Alert_PROJECTED_OVERSPEND Alert_Rule = 2
+	// An expense is far above the budget's usual amounts.
+	Alert_AMOUNT_ANOMALY Alert_Rule = 3
+	// An expense looks like a repeat of a recent charge.
+	Alert_DUPLICATE_CHARGE Alert_Rule = 4
+)
+
+// Enum value maps for Alert_Rule.
+var (
+	Alert_Rule_name = map[int32]string{
+		0: "RULE_UNSPECIFIED",
+		1: "BUDGET_THRESHOLD",
+		2: "PROJECTED_OVERSPEND",
+		3: "AMOUNT_ANOMALY",
+		4: "DUPLICATE_CHARGE",
+	}
+	Alert_Rule_value = map[string]int32{
+		"RULE_UNSPECIFIED":    0,
+		"BUDGET_THRESHOLD":    1,
+		"PROJECTED_OVERSPEND": 2,
+		"AMOUNT_ANOMALY":      3,
+		"DUPLICATE_CHARGE":    4,
+	}
+)
+
+func (x Alert_Rule) Enum() *Alert_Rule {
+	p := new(Alert_Rule)
+	*p = x
+	return p
+}
+
+func (x Alert_Rule) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Alert_Rule) Descriptor() protoreflect.EnumDescriptor {
+	return file_saturn_finance_v1_finance_proto_enumTypes[33].Descriptor()
+}
+
+func (Alert_Rule) Type() protoreflect.EnumType {
+	return &file_saturn_finance_v1_finance_proto_enumTypes[33]
+}
+
+func (x Alert_Rule) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Alert_Rule.Descriptor instead.
+func (Alert_Rule) EnumDescriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{176, 0}
+}
+
+// Status tracks whether the alert still needs attention.
+type Alert_Status int32
+
+const (
+	// Default unspecified status.
+	Alert_STATUS_UNSPECIFIED Alert_Status = 0
+	// The alert needs attention.
+	Alert_OPEN Alert_Status = 1
+	// The alert was handled.
+	Alert_ACKNOWLEDGED Alert_Status = 2
+	// The alert is hidden until `snooze_until`.
+	Alert_SNOOZED Alert_Status = 3
+)
+
+// Enum value maps for Alert_Status.
+var (
+	Alert_Status_name = map[int32]string{
+		0: "STATUS_UNSPECIFIED",
+		1: "OPEN",
+		2: "ACKNOWLEDGED",
+		3: "SNOOZED",
+	}
+	Alert_Status_value = map[string]int32{
+		"STATUS_UNSPECIFIED": 0,
+		"OPEN":               1,
+		"ACKNOWLEDGED":       2,
+		"SNOOZED":            3,
+	}
+)
+
+func (x Alert_Status) Enum() *Alert_Status {
+	p := new(Alert_Status)
+	*p = x
+	return p
+}
+
+func (x Alert_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Alert_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_saturn_finance_v1_finance_proto_enumTypes[34].Descriptor()
+}
+
+func (Alert_Status) Type() protoreflect.EnumType {
+	return &file_saturn_finance_v1_finance_proto_enumTypes[34]
+}
+
+func (x Alert_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Alert_Status.Descriptor instead.
+func (Alert_Status) EnumDescriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{176, 1}
+}
+
+// FinanceSettings represents the workspace configuration.
+type FinanceSettings struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Output only. Associated space identifier.
+	SpaceId string `protobuf:"bytes,1,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	// Required. Base currency for conversions and insights (e.g. "USD").
+	// Analytics calculations and overall spend views default to this currency.
+	BaseCurrency string `protobuf:"bytes,2,opt,name=base_currency,json=baseCurrency,proto3" json:"base_currency,omitempty"`
+	// Output only. Creation time of the settings.
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// Output only. Last update time of the settings.
+	UpdateTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	// Optional. ISO 4217 codes allowed for accounts, budgets, transactions and borrowings.
+	// An empty list enables every currency in the catalog. The base currency is always enabled.
+	EnabledCurrencies []string `protobuf:"bytes,5,rep,name=enabled_currencies,json=enabledCurrencies,proto3" json:"enabled_currencies,omitempty"`
+	// Optional. IANA timezone (e.g. "America/New_York") used to compute budget period bounds.
+	// Defaults to "UTC".
+	Timezone string `protobuf:"bytes,6,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// Optional. Budget usage percentages (1-1000) that raise an alert when reached.
+	// Defaults to 80 and 100 when empty.
+	BudgetAlertPercents []int32 `protobuf:"varint,7,rep,packed,name=budget_alert_percents,json=budgetAlertPercents,proto3" json:"budget_alert_percents,omitempty"`
+	// Optional. Standard deviations above a budget's usual expense amount that flag an expense as unusual.
+	// Defaults to 3 when unset.
+	AnomalyStdDevs float64 `protobuf:"fixed64,8,opt,name=anomaly_std_devs,json=anomalyStdDevs,proto3" json:"anomaly_std_devs,omitempty"`
+	// Optional. Hours within which two identical charges are flagged as a possible duplicate.
+	// Defaults to 48 when unset.
+	DuplicateWindowHours int32 `protobuf:"varint,9,opt,name=duplicate_window_hours,json=duplicateWindowHours,proto3" json:"duplicate_window_hours,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *FinanceSettings) Reset() {
+	*x = FinanceSettings{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FinanceSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinanceSettings) ProtoMessage() {}
+
+func (x *FinanceSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinanceSettings.ProtoReflect.Descriptor instead.
+func (*FinanceSettings) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FinanceSettings) GetSpaceId() string {
+	if x != nil {
+		return x.SpaceId
+	}
+	return ""
+}
+
+func (x *FinanceSettings) GetBaseCurrency() string {
+	if x != nil {
+		return x.BaseCurrency
+	}
+	return ""
+}
+
+func (x *FinanceSettings) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *FinanceSettings) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+func (x *FinanceSettings) GetEnabledCurrencies() []string {
+	if x != nil {
+		return x.EnabledCurrencies
+	}
+	return nil
+}
+
+func (x *FinanceSettings) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *FinanceSettings) GetBudgetAlertPercents() []int32 {
+	if x != nil {
+		return x.BudgetAlertPercents
+	}
+	return nil
+}
+
+func (x *FinanceSettings) GetAnomalyStdDevs() float64 {
+	if x != nil {
+		return x.AnomalyStdDevs
+	}
+	return 0
+}
+
+func (x *FinanceSettings) GetDuplicateWindowHours() int32 {
+	if x != nil {
+		return x.DuplicateWindowHours
+	}
+	return 0
+}
+
+// Budget represents a budget template definition.
+type Budget struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Output only. Budget template identifier.
 	// Values are of the form `bud_[a-zA-Z0-9]+`.
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	// Output only. Space identifier.
@@ -1227,7 +2184,17 @@ type Budget struct {
 	// Output only. Last update time of the budget.
 	UpdateTime *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
 	// Output only. Monotonic version counter for optimistic concurrency control.
-	Version       int64 `protobuf:"varint,14,opt,name=version,proto3" json:"version,omitempty"`
+	Version int64 `protobuf:"varint,14,opt,name=version,proto3" json:"version,omitempty"`
+	// Optional. How remaining balances roll over between periods. Defaults to NONE.
+	RolloverPolicy Budget_RolloverPolicy `protobuf:"varint,15,opt,name=rollover_policy,json=rolloverPolicy,proto3,enum=saturn.finance.v1.Budget_RolloverPolicy" json:"rollover_policy,omitempty"`
+	// Optional. Maximum absolute amount in cents carried between periods. Zero means uncapped.
+	RolloverCap int64 `protobuf:"varint,16,opt,name=rollover_cap,json=rolloverCap,proto3" json:"rollover_cap,omitempty"`
+	// Optional. Day of month (1-28) on which monthly, quarterly and yearly periods begin. Defaults to 1.
+	PeriodStartDay int32 `protobuf:"varint,17,opt,name=period_start_day,json=periodStartDay,proto3" json:"period_start_day,omitempty"`
+	// Optional. ISO weekday (1 = Monday ... 7 = Sunday) on which weekly periods begin. Defaults to Monday.
+	WeekStart int32 `protobuf:"varint,18,opt,name=week_start,json=weekStart,proto3" json:"week_start,omitempty"`
+	// Optional. First day of any bi-weekly period (e.g. a payday). Required for BIWEEKLY budgets.
+	PeriodAnchor  *timestamppb.Timestamp `protobuf:"bytes,19,opt,name=period_anchor,json=periodAnchor,proto3" json:"period_anchor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1360,6 +2327,41 @@ func (x *Budget) GetVersion() int64 {
 	return 0
 }
 
+func (x *Budget) GetRolloverPolicy() Budget_RolloverPolicy {
+	if x != nil {
+		return x.RolloverPolicy
+	}
+	return Budget_ROLLOVER_POLICY_UNSPECIFIED
+}
+
+func (x *Budget) GetRolloverCap() int64 {
+	if x != nil {
+		return x.RolloverCap
+	}
+	return 0
+}
+
+func (x *Budget) GetPeriodStartDay() int32 {
+	if x != nil {
+		return x.PeriodStartDay
+	}
+	return 0
+}
+
+func (x *Budget) GetWeekStart() int32 {
+	if x != nil {
+		return x.WeekStart
+	}
+	return 0
+}
+
+func (x *Budget) GetPeriodAnchor() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodAnchor
+	}
+	return nil
+}
+
 // BudgetPeriod represents an active instantiation of a budget.
 type BudgetPeriod struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -1390,9 +2392,15 @@ type BudgetPeriod struct {
 	// Output only. Spent amount in cents within this period.
 	SpentAmount int64 `protobuf:"varint,12,opt,name=spent_amount,json=spentAmount,proto3" json:"spent_amount,omitempty"`
 	// Output only. Spent amount converted to base currency cents.
-	SpentInBase   int64 `protobuf:"varint,13,opt,name=spent_in_base,json=spentInBase,proto3" json:"spent_in_base,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	SpentInBase int64 `protobuf:"varint,13,opt,name=spent_in_base,json=spentInBase,proto3" json:"spent_in_base,omitempty"`
+	// Output only. Signed balance carried in from the previous period in local currency cents.
+	CarriedIn int64 `protobuf:"varint,14,opt,name=carried_in,json=carriedIn,proto3" json:"carried_in,omitempty"`
+	// Output only. Signed balance carried out into the next period in local currency cents.
+	CarriedOut int64 `protobuf:"varint,15,opt,name=carried_out,json=carriedOut,proto3" json:"carried_out,omitempty"`
+	// Output only. Spendable limit for the period (limit plus carried-in balance) in local currency cents.
+	EffectiveLimit int64 `protobuf:"varint,16,opt,name=effective_limit,json=effectiveLimit,proto3" json:"effective_limit,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *BudgetPeriod) Reset() {
@@ -1516,13 +2524,36 @@ func (x *BudgetPeriod) GetSpentInBase() int64 {
 	return 0
 }
 
+func (x *BudgetPeriod) GetCarriedIn() int64 {
+	if x != nil {
+		return x.CarriedIn
+	}
+	return 0
+}
+
+func (x *BudgetPeriod) GetCarriedOut() int64 {
+	if x != nil {
+		return x.CarriedOut
+	}
+	return 0
+}
+
+func (x *BudgetPeriod) GetEffectiveLimit() int64 {
+	if x != nil {
+		return x.EffectiveLimit
+	}
+	return 0
+}
+
 // The request for
 // [ConfigureFinance][saturn.finance.v1.Finance.ConfigureFinance].
 type ConfigureFinanceRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Required. Base currency for conversions and insights (e.g. "USD").
 	// Conversions and aggregated spent statistics will default to this currency.
-	BaseCurrency  string `protobuf:"bytes,1,opt,name=base_currency,json=baseCurrency,proto3" json:"base_currency,omitempty"`
+	BaseCurrency string `protobuf:"bytes,1,opt,name=base_currency,json=baseCurrency,proto3" json:"base_currency,omitempty"`
+	// Optional. IANA timezone used to compute budget period bounds. Defaults to "UTC".
+	Timezone      string `protobuf:"bytes,2,opt,name=timezone,proto3" json:"timezone,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1564,6 +2595,13 @@ func (x *ConfigureFinanceRequest) GetBaseCurrency() string {
 	return ""
 }
 
+func (x *ConfigureFinanceRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
 // The request for
 // [GetFinanceSettings][saturn.finance.v1.Finance.GetFinanceSettings].
 type GetFinanceSettingsRequest struct {
@@ -1603,19 +2641,76 @@ func (*GetFinanceSettingsRequest) Descriptor() ([]byte, []int) {
 }
 
 // The request for
-// [GetBudget][saturn.finance.v1.Finance.GetBudget].
-type GetBudgetRequest struct {
+// [UpdateFinanceSettings][saturn.finance.v1.Finance.UpdateFinanceSettings].
+type UpdateFinanceSettingsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. Unique identifier of the budget template to retrieve.
-	// Values are of the form `bud_[a-zA-Z0-9]+`.
-	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Required. Updated settings values.
+	Settings *FinanceSettings `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	// Optional. Field mask defining which fields to update. Only `enabled_currencies`, `timezone`,
+	// `budget_alert_percents`, `anomaly_std_devs` and `duplicate_window_hours` are mutable.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3,oneof" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateFinanceSettingsRequest) Reset() {
+	*x = UpdateFinanceSettingsRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateFinanceSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFinanceSettingsRequest) ProtoMessage() {}
+
+func (x *UpdateFinanceSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFinanceSettingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateFinanceSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateFinanceSettingsRequest) GetSettings() *FinanceSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+func (x *UpdateFinanceSettingsRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+// The request for
+// [GetBudget][saturn.finance.v1.Finance.GetBudget].
+type GetBudgetRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. Unique identifier of the budget template to retrieve.
+	// Values are of the form `bud_[a-zA-Z0-9]+`.
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetBudgetRequest) Reset() {
 	*x = GetBudgetRequest{}
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[5]
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1627,7 +2722,7 @@ func (x *GetBudgetRequest) String() string {
 func (*GetBudgetRequest) ProtoMessage() {}
 
 func (x *GetBudgetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[5]
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1640,7 +2735,7 @@ func (x *GetBudgetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBudgetRequest.ProtoReflect.Descriptor instead.
 func (*GetBudgetRequest) Descriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{5}
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetBudgetRequest) GetId() string {
@@ -1662,7 +2757,7 @@ type CreateBudgetRequest struct {
 
 func (x *CreateBudgetRequest) Reset() {
 	*x = CreateBudgetRequest{}
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[6]
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1674,7 +2769,7 @@ func (x *CreateBudgetRequest) String() string {
 func (*CreateBudgetRequest) ProtoMessage() {}
 
 func (x *CreateBudgetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[6]
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1687,7 +2782,7 @@ func (x *CreateBudgetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateBudgetRequest.ProtoReflect.Descriptor instead.
 func (*CreateBudgetRequest) Descriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{6}
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *CreateBudgetRequest) GetBudget() *Budget {
@@ -1718,7 +2813,7 @@ type UpdateBudgetRequest struct {
 
 func (x *UpdateBudgetRequest) Reset() {
 	*x = UpdateBudgetRequest{}
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[7]
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1730,7 +2825,7 @@ func (x *UpdateBudgetRequest) String() string {
 func (*UpdateBudgetRequest) ProtoMessage() {}
 
 func (x *UpdateBudgetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[7]
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1743,7 +2838,7 @@ func (x *UpdateBudgetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateBudgetRequest.ProtoReflect.Descriptor instead.
 func (*UpdateBudgetRequest) Descriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{7}
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *UpdateBudgetRequest) GetId() string {
@@ -1796,7 +2891,7 @@ type DeleteBudgetRequest struct {
 
 func (x *DeleteBudgetRequest) Reset() {
 	*x = DeleteBudgetRequest{}
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[8]
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1808,7 +2903,7 @@ func (x *DeleteBudgetRequest) String() string {
 func (*DeleteBudgetRequest) ProtoMessage() {}
 
 func (x *DeleteBudgetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[8]
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1821,7 +2916,7 @@ func (x *DeleteBudgetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteBudgetRequest.ProtoReflect.Descriptor instead.
 func (*DeleteBudgetRequest) Descriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{8}
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *DeleteBudgetRequest) GetId() string {
@@ -1862,7 +2957,7 @@ type ListBudgetsRequest struct {
 
 func (x *ListBudgetsRequest) Reset() {
 	*x = ListBudgetsRequest{}
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[9]
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1874,7 +2969,7 @@ func (x *ListBudgetsRequest) String() string {
 func (*ListBudgetsRequest) ProtoMessage() {}
 
 func (x *ListBudgetsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[9]
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1887,7 +2982,7 @@ func (x *ListBudgetsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListBudgetsRequest.ProtoReflect.Descriptor instead.
 func (*ListBudgetsRequest) Descriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{9}
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ListBudgetsRequest) GetPageSize() int32 {
@@ -1953,7 +3048,7 @@ type ListBudgetsResponse struct {
 
 func (x *ListBudgetsResponse) Reset() {
 	*x = ListBudgetsResponse{}
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[10]
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1965,7 +3060,7 @@ func (x *ListBudgetsResponse) String() string {
 func (*ListBudgetsResponse) ProtoMessage() {}
 
 func (x *ListBudgetsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[10]
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1978,7 +3073,7 @@ func (x *ListBudgetsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListBudgetsResponse.ProtoReflect.Descriptor instead.
 func (*ListBudgetsResponse) Descriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{10}
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ListBudgetsResponse) GetBudgets() []*Budget {
@@ -2010,7 +3105,7 @@ type GetBudgetPeriodRequest struct {
 
 func (x *GetBudgetPeriodRequest) Reset() {
 	*x = GetBudgetPeriodRequest{}
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[11]
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2022,7 +3117,7 @@ func (x *GetBudgetPeriodRequest) String() string {
 func (*GetBudgetPeriodRequest) ProtoMessage() {}
 
 func (x *GetBudgetPeriodRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[11]
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2035,7 +3130,7 @@ func (x *GetBudgetPeriodRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBudgetPeriodRequest.ProtoReflect.Descriptor instead.
 func (*GetBudgetPeriodRequest) Descriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{11}
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *GetBudgetPeriodRequest) GetBudgetId() string {
@@ -2075,7 +3170,7 @@ type ExchangeRate struct {
 
 func (x *ExchangeRate) Reset() {
 	*x = ExchangeRate{}
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[12]
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2087,7 +3182,7 @@ func (x *ExchangeRate) String() string {
 func (*ExchangeRate) ProtoMessage() {}
 
 func (x *ExchangeRate) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[12]
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2100,7 +3195,7 @@ func (x *ExchangeRate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExchangeRate.ProtoReflect.Descriptor instead.
 func (*ExchangeRate) Descriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{12}
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ExchangeRate) GetId() string {
@@ -2164,7 +3259,7 @@ type CreateExchangeRateRequest struct {
 
 func (x *CreateExchangeRateRequest) Reset() {
 	*x = CreateExchangeRateRequest{}
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[13]
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2176,7 +3271,7 @@ func (x *CreateExchangeRateRequest) String() string {
 func (*CreateExchangeRateRequest) ProtoMessage() {}
 
 func (x *CreateExchangeRateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[13]
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2189,7 +3284,7 @@ func (x *CreateExchangeRateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateExchangeRateRequest.ProtoReflect.Descriptor instead.
 func (*CreateExchangeRateRequest) Descriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{13}
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *CreateExchangeRateRequest) GetExchangeRate() *ExchangeRate {
@@ -2211,7 +3306,7 @@ type GetExchangeRateRequest struct {
 
 func (x *GetExchangeRateRequest) Reset() {
 	*x = GetExchangeRateRequest{}
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[14]
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2223,7 +3318,7 @@ func (x *GetExchangeRateRequest) String() string {
 func (*GetExchangeRateRequest) ProtoMessage() {}
 
 func (x *GetExchangeRateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[14]
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2236,7 +3331,7 @@ func (x *GetExchangeRateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetExchangeRateRequest.ProtoReflect.Descriptor instead.
 func (*GetExchangeRateRequest) Descriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{14}
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *GetExchangeRateRequest) GetId() string {
@@ -2260,7 +3355,7 @@ type UpdateExchangeRateRequest struct {
 
 func (x *UpdateExchangeRateRequest) Reset() {
 	*x = UpdateExchangeRateRequest{}
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[15]
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2272,7 +3367,7 @@ func (x *UpdateExchangeRateRequest) String() string {
 func (*UpdateExchangeRateRequest) ProtoMessage() {}
 
 func (x *UpdateExchangeRateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[15]
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2285,7 +3380,7 @@ func (x *UpdateExchangeRateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateExchangeRateRequest.ProtoReflect.Descriptor instead.
 func (*UpdateExchangeRateRequest) Descriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{15}
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *UpdateExchangeRateRequest) GetId() string {
@@ -2326,7 +3421,7 @@ type ListExchangeRatesRequest struct {
 
 func (x *ListExchangeRatesRequest) Reset() {
 	*x = ListExchangeRatesRequest{}
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[16]
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2338,7 +3433,7 @@ func (x *ListExchangeRatesRequest) String() string {
 func (*ListExchangeRatesRequest) ProtoMessage() {}
 
 func (x *ListExchangeRatesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[16]
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2351,7 +3446,7 @@ func (x *ListExchangeRatesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListExchangeRatesRequest.ProtoReflect.Descriptor instead.
 func (*ListExchangeRatesRequest) Descriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{16}
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *ListExchangeRatesRequest) GetPageSize() int32 {
@@ -2417,7 +3512,7 @@ type ListExchangeRatesResponse struct {
 
 func (x *ListExchangeRatesResponse) Reset() {
 	*x = ListExchangeRatesResponse{}
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[17]
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2429,7 +3524,7 @@ func (x *ListExchangeRatesResponse) String() string {
 func (*ListExchangeRatesResponse) ProtoMessage() {}
 
 func (x *ListExchangeRatesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[17]
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2442,7 +3537,7 @@ func (x *ListExchangeRatesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListExchangeRatesResponse.ProtoReflect.Descriptor instead.
 func (*ListExchangeRatesResponse) Descriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{17}
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *ListExchangeRatesResponse) GetExchangeRates() []*ExchangeRate {
@@ -2471,7 +3566,7 @@ type DeleteExchangeRateRequest struct {
 
 func (x *DeleteExchangeRateRequest) Reset() {
 	*x = DeleteExchangeRateRequest{}
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[18]
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2483,7 +3578,7 @@ func (x *DeleteExchangeRateRequest) String() string {
 func (*DeleteExchangeRateRequest) ProtoMessage() {}
 
 func (x *DeleteExchangeRateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[18]
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2496,7 +3591,7 @@ func (x *DeleteExchangeRateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteExchangeRateRequest.ProtoReflect.Descriptor instead.
 func (*DeleteExchangeRateRequest) Descriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{18}
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *DeleteExchangeRateRequest) GetId() string {
@@ -2546,14 +3641,30 @@ type Transaction struct {
 	// Output only. Hydrated minimal budget info. Available only on FULL view.
 	Budget *Transaction_BudgetInfo `protobuf:"bytes,19,opt,name=budget,proto3,oneof" json:"budget,omitempty"`
 	// Optional. Context metadata key-value pairs.
-	Metadata      map[string]string `protobuf:"bytes,20,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Metadata map[string]string `protobuf:"bytes,20,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Optional. Classification category ID.
+	// Values are of the form `cat_[a-zA-Z0-9]+`.
+	CategoryId *string `protobuf:"bytes,21,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	// Optional. Free-form lowercase tags.
+	Tags []string `protobuf:"bytes,22,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Optional. Split lines allocating the amount across budgets and categories.
+	// When present, the split amounts add up to `amount` and `budget_id` is unset.
+	Splits []*TransactionSplit `protobuf:"bytes,23,rep,name=splits,proto3" json:"splits,omitempty"`
+	// Output only. Indicates if the transaction was confirmed against a bank statement.
+	Cleared bool `protobuf:"varint,24,opt,name=cleared,proto3" json:"cleared,omitempty"`
+	// Output only. Finalized reconciliation locking the transaction from edits.
+	// Values are of the form `rcn_[a-zA-Z0-9]+`.
+	ReconciliationId *string `protobuf:"bytes,25,opt,name=reconciliation_id,json=reconciliationId,proto3,oneof" json:"reconciliation_id,omitempty"`
+	// Optional. Payee the description was normalized to.
+	// Values are of the form `pye_[a-zA-Z0-9]+`.
+	PayeeId       *string `protobuf:"bytes,26,opt,name=payee_id,json=payeeId,proto3,oneof" json:"payee_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Transaction) Reset() {
 	*x = Transaction{}
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[19]
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2565,7 +3676,7 @@ func (x *Transaction) String() string {
 func (*Transaction) ProtoMessage() {}
 
 func (x *Transaction) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[19]
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2578,7 +3689,7 @@ func (x *Transaction) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Transaction.ProtoReflect.Descriptor instead.
 func (*Transaction) Descriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{19}
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *Transaction) GetId() string {
@@ -2700,10 +3811,159 @@ func (x *Transaction) GetMetadata() map[string]string {
 	return nil
 }
 
+func (x *Transaction) GetCategoryId() string {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return ""
+}
+
+func (x *Transaction) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Transaction) GetSplits() []*TransactionSplit {
+	if x != nil {
+		return x.Splits
+	}
+	return nil
+}
+
+func (x *Transaction) GetCleared() bool {
+	if x != nil {
+		return x.Cleared
+	}
+	return false
+}
+
+func (x *Transaction) GetReconciliationId() string {
+	if x != nil && x.ReconciliationId != nil {
+		return *x.ReconciliationId
+	}
+	return ""
+}
+
+func (x *Transaction) GetPayeeId() string {
+	if x != nil && x.PayeeId != nil {
+		return *x.PayeeId
+	}
+	return ""
+}
+
+// TransactionSplit allocates a portion of an expense to its own budget and category.
+type TransactionSplit struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Output only. Unique identifier.
+	// Values are of the form `tsp_[a-zA-Z0-9]+`.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Optional. Budget the split line is charged to. Required for expenses
+	// that are not linked to a borrowing.
+	// Values are of the form `bgt_[a-zA-Z0-9]+`.
+	BudgetId *string `protobuf:"bytes,2,opt,name=budget_id,json=budgetId,proto3,oneof" json:"budget_id,omitempty"`
+	// Output only. Budget period the split line is attributed to.
+	// Values are of the form `bgp_[a-zA-Z0-9]+`.
+	PeriodId *string `protobuf:"bytes,3,opt,name=period_id,json=periodId,proto3,oneof" json:"period_id,omitempty"`
+	// Optional. Classification category identifier. Falls back to the
+	// transaction category when omitted.
+	// Values are of the form `cat_[a-zA-Z0-9]+`.
+	CategoryId *string `protobuf:"bytes,4,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	// Required. Split amount in transaction currency cents.
+	Amount int64 `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Output only. Split amount converted to the space base currency.
+	AmountInBase int64 `protobuf:"varint,6,opt,name=amount_in_base,json=amountInBase,proto3" json:"amount_in_base,omitempty"`
+	// Optional. Narration note for the split line.
+	Note          string `protobuf:"bytes,7,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransactionSplit) Reset() {
+	*x = TransactionSplit{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionSplit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionSplit) ProtoMessage() {}
+
+func (x *TransactionSplit) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionSplit.ProtoReflect.Descriptor instead.
+func (*TransactionSplit) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *TransactionSplit) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TransactionSplit) GetBudgetId() string {
+	if x != nil && x.BudgetId != nil {
+		return *x.BudgetId
+	}
+	return ""
+}
+
+func (x *TransactionSplit) GetPeriodId() string {
+	if x != nil && x.PeriodId != nil {
+		return *x.PeriodId
+	}
+	return ""
+}
+
+func (x *TransactionSplit) GetCategoryId() string {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return ""
+}
+
+func (x *TransactionSplit) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *TransactionSplit) GetAmountInBase() int64 {
+	if x != nil {
+		return x.AmountInBase
+	}
+	return 0
+}
+
+func (x *TransactionSplit) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
 // ExpenseInput encapsulates fields representing an expense record payload.
 type ExpenseInput struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. Budget category identifier.
+	// Required unless `splits` is provided or a transaction rule or payee assigns one.
+	// Budget category identifier.
 	// Values are of the form `bud_[a-zA-Z0-9]+`.
 	BudgetId string `protobuf:"bytes,1,opt,name=budget_id,json=budgetId,proto3" json:"budget_id,omitempty"`
 	// Required. Absolute value of transaction in local currency cents (e.g. 10000 for $100.00).
@@ -2718,14 +3978,26 @@ type ExpenseInput struct {
 	EffectiveDate *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=effective_date,json=effectiveDate,proto3" json:"effective_date,omitempty"`
 	// Optional. Target account identifier.
 	// Values are of the form `acc_[a-zA-Z0-9]+`.
-	AccountId     *string `protobuf:"bytes,7,opt,name=account_id,json=accountId,proto3,oneof" json:"account_id,omitempty"`
+	AccountId *string `protobuf:"bytes,7,opt,name=account_id,json=accountId,proto3,oneof" json:"account_id,omitempty"`
+	// Optional. Classification category identifier.
+	// Values are of the form `cat_[a-zA-Z0-9]+`.
+	CategoryId *string `protobuf:"bytes,8,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	// Optional. Free-form tags. Normalized to lowercase and de-duplicated.
+	Tags []string `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Optional. Split lines dividing the expense across budgets and categories.
+	// Requires at least two lines whose amounts add up to `amount`; `budget_id`
+	// must be empty when splits are provided.
+	Splits []*TransactionSplit `protobuf:"bytes,10,rep,name=splits,proto3" json:"splits,omitempty"`
+	// Optional. Payee identifier. Assigned by the space's rules and payees when omitted.
+	// Values are of the form `pye_[a-zA-Z0-9]+`.
+	PayeeId       *string `protobuf:"bytes,11,opt,name=payee_id,json=payeeId,proto3,oneof" json:"payee_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ExpenseInput) Reset() {
 	*x = ExpenseInput{}
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[20]
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2737,7 +4009,7 @@ func (x *ExpenseInput) String() string {
 func (*ExpenseInput) ProtoMessage() {}
 
 func (x *ExpenseInput) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[20]
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2750,7 +4022,7 @@ func (x *ExpenseInput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExpenseInput.ProtoReflect.Descriptor instead.
 func (*ExpenseInput) Descriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{20}
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *ExpenseInput) GetBudgetId() string {
@@ -2802,6 +4074,34 @@ func (x *ExpenseInput) GetAccountId() string {
 	return ""
 }
 
+func (x *ExpenseInput) GetCategoryId() string {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return ""
+}
+
+func (x *ExpenseInput) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ExpenseInput) GetSplits() []*TransactionSplit {
+	if x != nil {
+		return x.Splits
+	}
+	return nil
+}
+
+func (x *ExpenseInput) GetPayeeId() string {
+	if x != nil && x.PayeeId != nil {
+		return *x.PayeeId
+	}
+	return ""
+}
+
 // The request for
 // [CreateExpense][saturn.finance.v1.Finance.CreateExpense].
 type CreateExpenseRequest struct {
@@ -2814,7 +4114,7 @@ type CreateExpenseRequest struct {
 
 func (x *CreateExpenseRequest) Reset() {
 	*x = CreateExpenseRequest{}
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[21]
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2826,7 +4126,7 @@ func (x *CreateExpenseRequest) String() string {
 func (*CreateExpenseRequest) ProtoMessage() {}
 
 func (x *CreateExpenseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[21]
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2839,7 +4139,7 @@ func (x *CreateExpenseRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateExpenseRequest.ProtoReflect.Descriptor instead.
 func (*CreateExpenseRequest) Descriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{21}
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *CreateExpenseRequest) GetExpense() *ExpenseInput {
@@ -2864,7 +4164,7 @@ type UpdateExpenseRequest struct {
 
 func (x *UpdateExpenseRequest) Reset() {
 	*x = UpdateExpenseRequest{}
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[22]
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2876,7 +4176,7 @@ func (x *UpdateExpenseRequest) String() string {
 func (*UpdateExpenseRequest) ProtoMessage() {}
 
 func (x *UpdateExpenseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[22]
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2889,7 +4189,7 @@ func (x *UpdateExpenseRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateExpenseRequest.ProtoReflect.Descriptor instead.
 func (*UpdateExpenseRequest) Descriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{22}
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *UpdateExpenseRequest) GetId() string {
@@ -2906,160 +4206,51 @@ func (x *UpdateExpenseRequest) GetExpense() *ExpenseInput {
 	return nil
 }
 
-// The request for
-// [DeleteTransaction][saturn.finance.v1.Finance.DeleteTransaction].
-type DeleteTransactionRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. Unique identifier of the transaction to delete.
-	// Values are of the form `txn_[a-zA-Z0-9]+`.
-	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *DeleteTransactionRequest) Reset() {
-	*x = DeleteTransactionRequest{}
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[23]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *DeleteTransactionRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*DeleteTransactionRequest) ProtoMessage() {}
-
-func (x *DeleteTransactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[23]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use DeleteTransactionRequest.ProtoReflect.Descriptor instead.
-func (*DeleteTransactionRequest) Descriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{23}
-}
-
-func (x *DeleteTransactionRequest) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-// The request for
-// [GetTransaction][saturn.finance.v1.Finance.GetTransaction].
-type GetTransactionRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. Unique identifier of the transaction record to retrieve.
-	// Values are of the form `txn_[a-zA-Z0-9]+`.
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Optional. Scoped budget representation view type.
-	View          *Transaction_View `protobuf:"varint,2,opt,name=view,proto3,enum=saturn.finance.v1.Transaction_View,oneof" json:"view,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetTransactionRequest) Reset() {
-	*x = GetTransactionRequest{}
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[24]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetTransactionRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetTransactionRequest) ProtoMessage() {}
-
-func (x *GetTransactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[24]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetTransactionRequest.ProtoReflect.Descriptor instead.
-func (*GetTransactionRequest) Descriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{24}
-}
-
-func (x *GetTransactionRequest) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *GetTransactionRequest) GetView() Transaction_View {
-	if x != nil && x.View != nil {
-		return *x.View
-	}
-	return Transaction_VIEW_UNSPECIFIED
-}
-
-// The request for
-// [ListTransactions][saturn.finance.v1.Finance.ListTransactions].
-type ListTransactionsRequest struct {
+// IncomeInput encapsulates fields representing an income record payload.
+type IncomeInput struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Optional. Scoped budget representation view type.
-	View *Transaction_View `protobuf:"varint,1,opt,name=view,proto3,enum=saturn.finance.v1.Transaction_View,oneof" json:"view,omitempty"`
-	// Optional. Target parent budget ID filter.
-	// Values are of the form `bud_[a-zA-Z0-9]+`.
-	BudgetId string `protobuf:"bytes,2,opt,name=budget_id,json=budgetId,proto3" json:"budget_id,omitempty"`
-	// Optional. Target transaction flow type filter.
-	Type Transaction_Type `protobuf:"varint,3,opt,name=type,proto3,enum=saturn.finance.v1.Transaction_Type" json:"type,omitempty"`
-	// Optional. Target account identifier.
+	// Required. Absolute value of transaction in local currency cents (e.g. 250000 for $2,500.00).
+	Amount int64 `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Required. Currency code.
+	Currency string `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	// Optional. Narration notes.
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Optional. Time transaction occurred.
+	TransactionDate *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=transaction_date,json=transactionDate,proto3" json:"transaction_date,omitempty"`
+	// Optional. Execution/posting date.
+	EffectiveDate *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=effective_date,json=effectiveDate,proto3" json:"effective_date,omitempty"`
+	// Optional. Receiving account identifier. Defaults to the income source deposit account when omitted.
 	// Values are of the form `acc_[a-zA-Z0-9]+`.
 	AccountId *string `protobuf:"bytes,6,opt,name=account_id,json=accountId,proto3,oneof" json:"account_id,omitempty"`
-	// Optional. Text search query matching transaction description notes.
-	SearchQuery *string `protobuf:"bytes,7,opt,name=search_query,json=searchQuery,proto3,oneof" json:"search_query,omitempty"`
-	// Optional. Maximum number of items to return.
-	PageSize int32 `protobuf:"varint,8,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	// Optional. Keyset page token.
-	PageToken string `protobuf:"bytes,9,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	// Optional. Sort order string.
-	Sort *string `protobuf:"bytes,10,opt,name=sort,proto3,oneof" json:"sort,omitempty"`
-	// Optional. Target transfer ID filter.
-	// Values are of the form `trn_[a-zA-Z0-9]+`.
-	TransferId *string `protobuf:"bytes,11,opt,name=transfer_id,json=transferId,proto3,oneof" json:"transfer_id,omitempty"`
-	// Optional. Target scheduled payment ID filter.
-	// Values are of the form `sch_[a-zA-Z0-9]+`.
-	ScheduledPaymentId *string `protobuf:"bytes,13,opt,name=scheduled_payment_id,json=scheduledPaymentId,proto3,oneof" json:"scheduled_payment_id,omitempty"`
-	// Optional. Target borrowing ID filter.
-	// Values are of the form `bor_[a-zA-Z0-9]+`.
-	BorrowingId   *string `protobuf:"bytes,14,opt,name=borrowing_id,json=borrowingId,proto3,oneof" json:"borrowing_id,omitempty"`
+	// Optional. Income source that originated the inflow.
+	// Values are of the form `ics_[a-zA-Z0-9]+`.
+	IncomeSourceId *string `protobuf:"bytes,7,opt,name=income_source_id,json=incomeSourceId,proto3,oneof" json:"income_source_id,omitempty"`
+	// Optional. Classification category identifier.
+	// Values are of the form `cat_[a-zA-Z0-9]+`.
+	CategoryId *string `protobuf:"bytes,8,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	// Optional. Free-form tags. Normalized to lowercase and de-duplicated.
+	Tags []string `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Optional. Payee identifier. Assigned by the space's rules and payees when omitted.
+	// Values are of the form `pye_[a-zA-Z0-9]+`.
+	PayeeId       *string `protobuf:"bytes,10,opt,name=payee_id,json=payeeId,proto3,oneof" json:"payee_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListTransactionsRequest) Reset() {
-	*x = ListTransactionsRequest{}
+func (x *IncomeInput) Reset() {
+	*x = IncomeInput{}
 	mi := &file_saturn_finance_v1_finance_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTransactionsRequest) String() string {
+func (x *IncomeInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTransactionsRequest) ProtoMessage() {}
+func (*IncomeInput) ProtoMessage() {}
 
-func (x *ListTransactionsRequest) ProtoReflect() protoreflect.Message {
+func (x *IncomeInput) ProtoReflect() protoreflect.Message {
 	mi := &file_saturn_finance_v1_finance_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3071,114 +4262,105 @@ func (x *ListTransactionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTransactionsRequest.ProtoReflect.Descriptor instead.
-func (*ListTransactionsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use IncomeInput.ProtoReflect.Descriptor instead.
+func (*IncomeInput) Descriptor() ([]byte, []int) {
 	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *ListTransactionsRequest) GetView() Transaction_View {
-	if x != nil && x.View != nil {
-		return *x.View
+func (x *IncomeInput) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
 	}
-	return Transaction_VIEW_UNSPECIFIED
+	return 0
 }
 
-func (x *ListTransactionsRequest) GetBudgetId() string {
+func (x *IncomeInput) GetCurrency() string {
 	if x != nil {
-		return x.BudgetId
+		return x.Currency
 	}
 	return ""
 }
 
-func (x *ListTransactionsRequest) GetType() Transaction_Type {
+func (x *IncomeInput) GetDescription() string {
 	if x != nil {
-		return x.Type
-	}
-	return Transaction_TYPE_UNSPECIFIED
-}
-
-func (x *ListTransactionsRequest) GetAccountId() string {
-	if x != nil && x.AccountId != nil {
-		return *x.AccountId
+		return x.Description
 	}
 	return ""
 }
 
-func (x *ListTransactionsRequest) GetSearchQuery() string {
-	if x != nil && x.SearchQuery != nil {
-		return *x.SearchQuery
+func (x *IncomeInput) GetTransactionDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TransactionDate
 	}
-	return ""
+	return nil
 }
 
-func (x *ListTransactionsRequest) GetPageSize() int32 {
+func (x *IncomeInput) GetEffectiveDate() *timestamppb.Timestamp {
 	if x != nil {
-		return x.PageSize
+		return x.EffectiveDate
 	}
-	return 0
+	return nil
 }
 
-func (x *ListTransactionsRequest) GetPageToken() string {
-	if x != nil {
-		return x.PageToken
+func (x *IncomeInput) GetAccountId() string {
+	if x != nil && x.AccountId != nil {
+		return *x.AccountId
 	}
 	return ""
 }
 
-func (x *ListTransactionsRequest) GetSort() string {
-	if x != nil && x.Sort != nil {
-		return *x.Sort
+func (x *IncomeInput) GetIncomeSourceId() string {
+	if x != nil && x.IncomeSourceId != nil {
+		return *x.IncomeSourceId
 	}
 	return ""
 }
 
-func (x *ListTransactionsRequest) GetTransferId() string {
-	if x != nil && x.TransferId != nil {
-		return *x.TransferId
+func (x *IncomeInput) GetCategoryId() string {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
 	}
 	return ""
 }
 
-func (x *ListTransactionsRequest) GetScheduledPaymentId() string {
-	if x != nil && x.ScheduledPaymentId != nil {
-		return *x.ScheduledPaymentId
+func (x *IncomeInput) GetTags() []string {
+	if x != nil {
+		return x.Tags
 	}
-	return ""
+	return nil
 }
 
-func (x *ListTransactionsRequest) GetBorrowingId() string {
-	if x != nil && x.BorrowingId != nil {
-		return *x.BorrowingId
+func (x *IncomeInput) GetPayeeId() string {
+	if x != nil && x.PayeeId != nil {
+		return *x.PayeeId
 	}
 	return ""
 }
 
-// The response for
-// [ListTransactions][saturn.finance.v1.Finance.ListTransactions].
-type ListTransactionsResponse struct {
+// The request for
+// [CreateIncome][saturn.finance.v1.Finance.CreateIncome].
+type CreateIncomeRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// List of transactions matching filter rules.
-	Transactions []*Transaction `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
-	// Next page keyset token. Empty if no more pages are available.
-	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// Required. Target income transaction parameters.
+	Income        *IncomeInput `protobuf:"bytes,1,opt,name=income,proto3" json:"income,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListTransactionsResponse) Reset() {
-	*x = ListTransactionsResponse{}
+func (x *CreateIncomeRequest) Reset() {
+	*x = CreateIncomeRequest{}
 	mi := &file_saturn_finance_v1_finance_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTransactionsResponse) String() string {
+func (x *CreateIncomeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTransactionsResponse) ProtoMessage() {}
+func (*CreateIncomeRequest) ProtoMessage() {}
 
-func (x *ListTransactionsResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateIncomeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_saturn_finance_v1_finance_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3190,53 +4372,45 @@ func (x *ListTransactionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTransactionsResponse.ProtoReflect.Descriptor instead.
-func (*ListTransactionsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateIncomeRequest.ProtoReflect.Descriptor instead.
+func (*CreateIncomeRequest) Descriptor() ([]byte, []int) {
 	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *ListTransactionsResponse) GetTransactions() []*Transaction {
+func (x *CreateIncomeRequest) GetIncome() *IncomeInput {
 	if x != nil {
-		return x.Transactions
+		return x.Income
 	}
 	return nil
 }
 
-func (x *ListTransactionsResponse) GetNextPageToken() string {
-	if x != nil {
-		return x.NextPageToken
-	}
-	return ""
-}
-
 // The request for
-// [GetInsights][saturn.finance.v1.Finance.GetInsights].
-type GetInsightsRequest struct {
+// [UpdateIncome][saturn.finance.v1.Finance.UpdateIncome].
+type UpdateIncomeRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. Statistical grouping granularity.
-	Granularity InsightGranularity `protobuf:"varint,1,opt,name=granularity,proto3,enum=saturn.finance.v1.InsightGranularity" json:"granularity,omitempty"`
-	// Required. Target start date window.
-	StartDate *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	// Required. Target end date window.
-	EndDate       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	// Required. Unique identifier of the transaction to update.
+	// Values are of the form `txn_[a-zA-Z0-9]+`.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Required. Updated income transaction parameters.
+	Income        *IncomeInput `protobuf:"bytes,2,opt,name=income,proto3" json:"income,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetInsightsRequest) Reset() {
-	*x = GetInsightsRequest{}
+func (x *UpdateIncomeRequest) Reset() {
+	*x = UpdateIncomeRequest{}
 	mi := &file_saturn_finance_v1_finance_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetInsightsRequest) String() string {
+func (x *UpdateIncomeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetInsightsRequest) ProtoMessage() {}
+func (*UpdateIncomeRequest) ProtoMessage() {}
 
-func (x *GetInsightsRequest) ProtoReflect() protoreflect.Message {
+func (x *UpdateIncomeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_saturn_finance_v1_finance_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3248,56 +4422,78 @@ func (x *GetInsightsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetInsightsRequest.ProtoReflect.Descriptor instead.
-func (*GetInsightsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateIncomeRequest.ProtoReflect.Descriptor instead.
+func (*UpdateIncomeRequest) Descriptor() ([]byte, []int) {
 	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *GetInsightsRequest) GetGranularity() InsightGranularity {
+func (x *UpdateIncomeRequest) GetId() string {
 	if x != nil {
-		return x.Granularity
+		return x.Id
 	}
-	return InsightGranularity_INSIGHT_GRANULARITY_UNSPECIFIED
+	return ""
 }
 
-func (x *GetInsightsRequest) GetStartDate() *timestamppb.Timestamp {
+func (x *UpdateIncomeRequest) GetIncome() *IncomeInput {
 	if x != nil {
-		return x.StartDate
+		return x.Income
 	}
 	return nil
 }
 
-func (x *GetInsightsRequest) GetEndDate() *timestamppb.Timestamp {
-	if x != nil {
-		return x.EndDate
-	}
-	return nil
-}
-
-// The response for
-// [GetInsights][saturn.finance.v1.Finance.GetInsights].
-type GetInsightsResponse struct {
+// IncomeSource represents a recurring origin of inflows, such as an employer.
+type IncomeSource struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Spent insights statistics.
-	Spent         *SpentInsights `protobuf:"bytes,1,opt,name=spent,proto3" json:"spent,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetInsightsResponse) Reset() {
-	*x = GetInsightsResponse{}
+	// Output only. Unique identifier.
+	// Values are of the form `ics_[a-zA-Z0-9]+`.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Output only. Space identifier.
+	SpaceId string `protobuf:"bytes,2,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	// Required. User-friendly name (e.g. "Salary").
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// Optional. Employer or paying counterparty name.
+	Employer string `protobuf:"bytes,4,opt,name=employer,proto3" json:"employer,omitempty"`
+	// Optional. Expected amount per payout in cents.
+	ExpectedAmount int64 `protobuf:"varint,5,opt,name=expected_amount,json=expectedAmount,proto3" json:"expected_amount,omitempty"`
+	// Required. Currency code.
+	Currency string `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`
+	// Required. Expected payout cadence.
+	Cadence IncomeSource_Cadence `protobuf:"varint,7,opt,name=cadence,proto3,enum=saturn.finance.v1.IncomeSource_Cadence" json:"cadence,omitempty"`
+	// Optional. Default receiving account for income from this source.
+	// Values are of the form `acc_[a-zA-Z0-9]+`.
+	AccountId *string `protobuf:"bytes,8,opt,name=account_id,json=accountId,proto3,oneof" json:"account_id,omitempty"`
+	// Optional. Indicates if the income source is active.
+	IsActive bool `protobuf:"varint,9,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	// Output only. Creation timestamp.
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// Output only. Last update timestamp.
+	UpdateTime *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	// Optional. RFC 5545 recurrence rule for expected payouts
+	// (e.g. `FREQ=MONTHLY;BYMONTHDAY=15,-1`). When set, the cadence is derived
+	// from the rule and reported as CUSTOM if it has no simple equivalent.
+	RecurrenceRule string `protobuf:"bytes,12,opt,name=recurrence_rule,json=recurrenceRule,proto3" json:"recurrence_rule,omitempty"`
+	// Optional. First date of the recurrence. Required with a recurrence rule.
+	StartDate *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=start_date,json=startDate,proto3,oneof" json:"start_date,omitempty"`
+	// Optional. Dates on which an expected payout is skipped.
+	ExceptionDates []*timestamppb.Timestamp `protobuf:"bytes,14,rep,name=exception_dates,json=exceptionDates,proto3" json:"exception_dates,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *IncomeSource) Reset() {
+	*x = IncomeSource{}
 	mi := &file_saturn_finance_v1_finance_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetInsightsResponse) String() string {
+func (x *IncomeSource) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetInsightsResponse) ProtoMessage() {}
+func (*IncomeSource) ProtoMessage() {}
 
-func (x *GetInsightsResponse) ProtoReflect() protoreflect.Message {
+func (x *IncomeSource) ProtoReflect() protoreflect.Message {
 	mi := &file_saturn_finance_v1_finance_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3309,139 +4505,181 @@ func (x *GetInsightsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetInsightsResponse.ProtoReflect.Descriptor instead.
-func (*GetInsightsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use IncomeSource.ProtoReflect.Descriptor instead.
+func (*IncomeSource) Descriptor() ([]byte, []int) {
 	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *GetInsightsResponse) GetSpent() *SpentInsights {
+func (x *IncomeSource) GetId() string {
 	if x != nil {
-		return x.Spent
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-// SpentInsights aggregates workspace statistics.
-type SpentInsights struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Total configured budget limits in base currency cents.
-	TotalLimit int64 `protobuf:"varint,1,opt,name=total_limit,json=totalLimit,proto3" json:"total_limit,omitempty"`
-	// Total spent in base currency cents.
-	TotalSpent int64 `protobuf:"varint,2,opt,name=total_spent,json=totalSpent,proto3" json:"total_spent,omitempty"`
-	// Remaining space budget in base currency cents.
-	RemainingBudget int64 `protobuf:"varint,3,opt,name=remaining_budget,json=remainingBudget,proto3" json:"remaining_budget,omitempty"`
-	// Average spend speed rate relative to elapsed time.
-	BurnRate float64 `protobuf:"fixed64,4,opt,name=burn_rate,json=burnRate,proto3" json:"burn_rate,omitempty"`
-	// List of granular interval trend points.
-	Trend []*SpentInsights_TrendDataPoint `protobuf:"bytes,5,rep,name=trend,proto3" json:"trend,omitempty"`
-	// Distribution breakdown by budget category.
-	Distributions []*SpentInsights_BudgetUsage `protobuf:"bytes,6,rep,name=distributions,proto3" json:"distributions,omitempty"`
-	// List of largest expense transactions.
-	TopExpenses   []*SpentInsights_HighValueExpense `protobuf:"bytes,7,rep,name=top_expenses,json=topExpenses,proto3" json:"top_expenses,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *IncomeSource) GetSpaceId() string {
+	if x != nil {
+		return x.SpaceId
+	}
+	return ""
 }
 
-func (x *SpentInsights) Reset() {
-	*x = SpentInsights{}
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[29]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *IncomeSource) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-func (x *SpentInsights) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *IncomeSource) GetEmployer() string {
+	if x != nil {
+		return x.Employer
+	}
+	return ""
 }
 
-func (*SpentInsights) ProtoMessage() {}
+func (x *IncomeSource) GetExpectedAmount() int64 {
+	if x != nil {
+		return x.ExpectedAmount
+	}
+	return 0
+}
 
-func (x *SpentInsights) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[29]
+func (x *IncomeSource) GetCurrency() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Currency
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use SpentInsights.ProtoReflect.Descriptor instead.
-func (*SpentInsights) Descriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{29}
+func (x *IncomeSource) GetCadence() IncomeSource_Cadence {
+	if x != nil {
+		return x.Cadence
+	}
+	return IncomeSource_CADENCE_UNSPECIFIED
 }
 
-func (x *SpentInsights) GetTotalLimit() int64 {
+func (x *IncomeSource) GetAccountId() string {
+	if x != nil && x.AccountId != nil {
+		return *x.AccountId
+	}
+	return ""
+}
+
+func (x *IncomeSource) GetIsActive() bool {
 	if x != nil {
-		return x.TotalLimit
+		return x.IsActive
 	}
-	return 0
+	return false
 }
 
-func (x *SpentInsights) GetTotalSpent() int64 {
+func (x *IncomeSource) GetCreateTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.TotalSpent
+		return x.CreateTime
 	}
-	return 0
+	return nil
 }
 
-func (x *SpentInsights) GetRemainingBudget() int64 {
+func (x *IncomeSource) GetUpdateTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.RemainingBudget
+		return x.UpdateTime
 	}
-	return 0
+	return nil
 }
 
-func (x *SpentInsights) GetBurnRate() float64 {
+func (x *IncomeSource) GetRecurrenceRule() string {
 	if x != nil {
-		return x.BurnRate
+		return x.RecurrenceRule
 	}
-	return 0
+	return ""
 }
 
-func (x *SpentInsights) GetTrend() []*SpentInsights_TrendDataPoint {
+func (x *IncomeSource) GetStartDate() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Trend
+		return x.StartDate
 	}
 	return nil
 }
 
-func (x *SpentInsights) GetDistributions() []*SpentInsights_BudgetUsage {
+func (x *IncomeSource) GetExceptionDates() []*timestamppb.Timestamp {
 	if x != nil {
-		return x.Distributions
+		return x.ExceptionDates
 	}
 	return nil
 }
 
-func (x *SpentInsights) GetTopExpenses() []*SpentInsights_HighValueExpense {
+// The request for
+// [CreateIncomeSource][saturn.finance.v1.Finance.CreateIncomeSource].
+type CreateIncomeSourceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The income source to create.
+	IncomeSource  *IncomeSource `protobuf:"bytes,1,opt,name=income_source,json=incomeSource,proto3" json:"income_source,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateIncomeSourceRequest) Reset() {
+	*x = CreateIncomeSourceRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateIncomeSourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateIncomeSourceRequest) ProtoMessage() {}
+
+func (x *CreateIncomeSourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[29]
 	if x != nil {
-		return x.TopExpenses
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateIncomeSourceRequest.ProtoReflect.Descriptor instead.
+func (*CreateIncomeSourceRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CreateIncomeSourceRequest) GetIncomeSource() *IncomeSource {
+	if x != nil {
+		return x.IncomeSource
 	}
 	return nil
 }
 
-// GenerateScheduledPaymentsPayload defines the cron scheduling payload.
-type GenerateScheduledPaymentsPayload struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+// The request for
+// [GetIncomeSource][saturn.finance.v1.Finance.GetIncomeSource].
+type GetIncomeSourceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. Unique identifier of the income source.
+	// Values are of the form `ics_[a-zA-Z0-9]+`.
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GenerateScheduledPaymentsPayload) Reset() {
-	*x = GenerateScheduledPaymentsPayload{}
+func (x *GetIncomeSourceRequest) Reset() {
+	*x = GetIncomeSourceRequest{}
 	mi := &file_saturn_finance_v1_finance_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GenerateScheduledPaymentsPayload) String() string {
+func (x *GetIncomeSourceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerateScheduledPaymentsPayload) ProtoMessage() {}
+func (*GetIncomeSourceRequest) ProtoMessage() {}
 
-func (x *GenerateScheduledPaymentsPayload) ProtoReflect() protoreflect.Message {
+func (x *GetIncomeSourceRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_saturn_finance_v1_finance_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3453,62 +4691,45 @@ func (x *GenerateScheduledPaymentsPayload) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenerateScheduledPaymentsPayload.ProtoReflect.Descriptor instead.
-func (*GenerateScheduledPaymentsPayload) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetIncomeSourceRequest.ProtoReflect.Descriptor instead.
+func (*GetIncomeSourceRequest) Descriptor() ([]byte, []int) {
 	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{30}
 }
 
-// RecurringExpense represents a template rule to repeat payments.
-type RecurringExpense struct {
+func (x *GetIncomeSourceRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// The request for
+// [UpdateIncomeSource][saturn.finance.v1.Finance.UpdateIncomeSource].
+type UpdateIncomeSourceRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Output only. Unique identifier.
-	// Values are of the form `rec_[a-zA-Z0-9]+`.
+	// Required. Unique identifier of the income source to update.
+	// Values are of the form `ics_[a-zA-Z0-9]+`.
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Output only. Space identifier.
-	SpaceId string `protobuf:"bytes,2,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
-	// Required. Budget category identifier.
-	// Values are of the form `bud_[a-zA-Z0-9]+`.
-	BudgetId string `protobuf:"bytes,3,opt,name=budget_id,json=budgetId,proto3" json:"budget_id,omitempty"`
-	// Required. User-friendly name.
-	Name string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
-	// Required. Base payment amount in cents.
-	Amount int64 `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"`
-	// Required. Currency code.
-	Currency string `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`
-	// Required. Execution interval rule.
-	Interval RecurringExpense_Interval `protobuf:"varint,7,opt,name=interval,proto3,enum=saturn.finance.v1.RecurringExpense_Interval" json:"interval,omitempty"`
-	// Required. Scheduler engine runtime state.
-	ExecutionState *RecurringExpense_ExecutionState `protobuf:"bytes,8,opt,name=execution_state,json=executionState,proto3" json:"execution_state,omitempty"`
-	// Optional. Indicates if the payment amount is variable.
-	IsVariable bool `protobuf:"varint,9,opt,name=is_variable,json=isVariable,proto3" json:"is_variable,omitempty"`
-	// Required. Active template status.
-	Status RecurringExpense_Status `protobuf:"varint,10,opt,name=status,proto3,enum=saturn.finance.v1.RecurringExpense_Status" json:"status,omitempty"`
-	// Optional. Days allowed past due date before triggering warning events.
-	GracePeriodDays int32 `protobuf:"varint,11,opt,name=grace_period_days,json=gracePeriodDays,proto3" json:"grace_period_days,omitempty"`
-	// Output only. Creation timestamp.
-	CreateTime *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
-	// Output only. Last update timestamp.
-	UpdateTime *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
-	// Output only. Hydrated minimal budget details. Available only on FULL view.
-	Budget        *RecurringExpense_BudgetInfo `protobuf:"bytes,14,opt,name=budget,proto3,oneof" json:"budget,omitempty"`
+	// Required. Updated income source parameters.
+	IncomeSource  *IncomeSource `protobuf:"bytes,2,opt,name=income_source,json=incomeSource,proto3" json:"income_source,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RecurringExpense) Reset() {
-	*x = RecurringExpense{}
+func (x *UpdateIncomeSourceRequest) Reset() {
+	*x = UpdateIncomeSourceRequest{}
 	mi := &file_saturn_finance_v1_finance_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RecurringExpense) String() string {
+func (x *UpdateIncomeSourceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecurringExpense) ProtoMessage() {}
+func (*UpdateIncomeSourceRequest) ProtoMessage() {}
 
-func (x *RecurringExpense) ProtoReflect() protoreflect.Message {
+func (x *UpdateIncomeSourceRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_saturn_finance_v1_finance_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3520,161 +4741,268 @@ func (x *RecurringExpense) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecurringExpense.ProtoReflect.Descriptor instead.
-func (*RecurringExpense) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateIncomeSourceRequest.ProtoReflect.Descriptor instead.
+func (*UpdateIncomeSourceRequest) Descriptor() ([]byte, []int) {
 	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *RecurringExpense) GetId() string {
+func (x *UpdateIncomeSourceRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *RecurringExpense) GetSpaceId() string {
+func (x *UpdateIncomeSourceRequest) GetIncomeSource() *IncomeSource {
 	if x != nil {
-		return x.SpaceId
+		return x.IncomeSource
 	}
-	return ""
+	return nil
 }
 
-func (x *RecurringExpense) GetBudgetId() string {
-	if x != nil {
-		return x.BudgetId
-	}
-	return ""
+// The request for
+// [DeleteIncomeSource][saturn.finance.v1.Finance.DeleteIncomeSource].
+type DeleteIncomeSourceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. Unique identifier of the income source to delete.
+	// Values are of the form `ics_[a-zA-Z0-9]+`.
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RecurringExpense) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+func (x *DeleteIncomeSourceRequest) Reset() {
+	*x = DeleteIncomeSourceRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *RecurringExpense) GetAmount() int64 {
+func (x *DeleteIncomeSourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteIncomeSourceRequest) ProtoMessage() {}
+
+func (x *DeleteIncomeSourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[32]
 	if x != nil {
-		return x.Amount
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *RecurringExpense) GetCurrency() string {
+// Deprecated: Use DeleteIncomeSourceRequest.ProtoReflect.Descriptor instead.
+func (*DeleteIncomeSourceRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *DeleteIncomeSourceRequest) GetId() string {
 	if x != nil {
-		return x.Currency
+		return x.Id
 	}
 	return ""
 }
 
-func (x *RecurringExpense) GetInterval() RecurringExpense_Interval {
-	if x != nil {
-		return x.Interval
+// The request for
+// [ListIncomeSources][saturn.finance.v1.Finance.ListIncomeSources].
+type ListIncomeSourcesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional. Maximum number of items to return.
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Optional. Keyset page token.
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Optional. Filter only active income sources.
+	ActiveOnly *bool `protobuf:"varint,3,opt,name=active_only,json=activeOnly,proto3,oneof" json:"active_only,omitempty"`
+	// Optional. Text search query matching the name or employer.
+	SearchQuery *string `protobuf:"bytes,4,opt,name=search_query,json=searchQuery,proto3,oneof" json:"search_query,omitempty"`
+	// Optional. Sorting specification.
+	Sort          *string `protobuf:"bytes,5,opt,name=sort,proto3,oneof" json:"sort,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIncomeSourcesRequest) Reset() {
+	*x = ListIncomeSourcesRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIncomeSourcesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIncomeSourcesRequest) ProtoMessage() {}
+
+func (x *ListIncomeSourcesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return RecurringExpense_INTERVAL_UNSPECIFIED
+	return mi.MessageOf(x)
 }
 
-func (x *RecurringExpense) GetExecutionState() *RecurringExpense_ExecutionState {
+// Deprecated: Use ListIncomeSourcesRequest.ProtoReflect.Descriptor instead.
+func (*ListIncomeSourcesRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListIncomeSourcesRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.ExecutionState
+		return x.PageSize
 	}
-	return nil
+	return 0
 }
 
-func (x *RecurringExpense) GetIsVariable() bool {
+func (x *ListIncomeSourcesRequest) GetPageToken() string {
 	if x != nil {
-		return x.IsVariable
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListIncomeSourcesRequest) GetActiveOnly() bool {
+	if x != nil && x.ActiveOnly != nil {
+		return *x.ActiveOnly
 	}
 	return false
 }
 
-func (x *RecurringExpense) GetStatus() RecurringExpense_Status {
-	if x != nil {
-		return x.Status
+func (x *ListIncomeSourcesRequest) GetSearchQuery() string {
+	if x != nil && x.SearchQuery != nil {
+		return *x.SearchQuery
 	}
-	return RecurringExpense_STATUS_UNSPECIFIED
+	return ""
 }
 
-func (x *RecurringExpense) GetGracePeriodDays() int32 {
-	if x != nil {
-		return x.GracePeriodDays
+func (x *ListIncomeSourcesRequest) GetSort() string {
+	if x != nil && x.Sort != nil {
+		return *x.Sort
 	}
-	return 0
+	return ""
 }
 
-func (x *RecurringExpense) GetCreateTime() *timestamppb.Timestamp {
+// The response for
+// [ListIncomeSources][saturn.finance.v1.Finance.ListIncomeSources].
+type ListIncomeSourcesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// List of income sources matching filters.
+	IncomeSources []*IncomeSource `protobuf:"bytes,1,rep,name=income_sources,json=incomeSources,proto3" json:"income_sources,omitempty"`
+	// Next page keyset token. Empty if no more pages are available.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIncomeSourcesResponse) Reset() {
+	*x = ListIncomeSourcesResponse{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIncomeSourcesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIncomeSourcesResponse) ProtoMessage() {}
+
+func (x *ListIncomeSourcesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[34]
 	if x != nil {
-		return x.CreateTime
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *RecurringExpense) GetUpdateTime() *timestamppb.Timestamp {
+// Deprecated: Use ListIncomeSourcesResponse.ProtoReflect.Descriptor instead.
+func (*ListIncomeSourcesResponse) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListIncomeSourcesResponse) GetIncomeSources() []*IncomeSource {
 	if x != nil {
-		return x.UpdateTime
+		return x.IncomeSources
 	}
 	return nil
 }
 
-func (x *RecurringExpense) GetBudget() *RecurringExpense_BudgetInfo {
+func (x *ListIncomeSourcesResponse) GetNextPageToken() string {
 	if x != nil {
-		return x.Budget
+		return x.NextPageToken
 	}
-	return nil
+	return ""
 }
 
-// ScheduledPayment represents a spawned pending payment instance.
-type ScheduledPayment struct {
+// Goal is a savings target tracked against transfers into a linked account or tagged transactions.
+type Goal struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Output only. Unique identifier.
-	// Values are of the form `sch_[a-zA-Z0-9]+`.
+	// Values are of the form `goal_[a-zA-Z0-9]+`.
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	// Output only. Space identifier.
 	SpaceId string `protobuf:"bytes,2,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
-	// Required. Budget category identifier.
-	// Values are of the form `bud_[a-zA-Z0-9]+`.
-	BudgetId string `protobuf:"bytes,3,opt,name=budget_id,json=budgetId,proto3" json:"budget_id,omitempty"`
-	// Required. Parent template source type.
-	SourceType ScheduledPayment_SourceType `protobuf:"varint,4,opt,name=source_type,json=sourceType,proto3,enum=saturn.finance.v1.ScheduledPayment_SourceType" json:"source_type,omitempty"`
-	// Required. Parent template source ID.
-	SourceId string `protobuf:"bytes,5,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
-	// Required. Target payment amount in cents.
-	Amount int64 `protobuf:"varint,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Required. User-friendly name (e.g. "Emergency fund").
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// Optional. Free-form description.
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// Required. Amount to save in the currency's minor units.
+	TargetAmount int64 `protobuf:"varint,5,opt,name=target_amount,json=targetAmount,proto3" json:"target_amount,omitempty"`
 	// Required. Currency code.
-	Currency string `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"`
-	// Required. Scheduled execution due date.
-	DueDate *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
-	// Required. Instance execution status.
-	Status ScheduledPayment_Status `protobuf:"varint,9,opt,name=status,proto3,enum=saturn.finance.v1.ScheduledPayment_Status" json:"status,omitempty"`
-	// Optional. Metadata binary payload.
-	Metadata []byte `protobuf:"bytes,10,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Currency string `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`
+	// Optional. Contributions dated before this are ignored. Defaults to the creation time.
+	StartDate *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	// Optional. Date by which the target should be reached.
+	Deadline *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	// Optional. Account holding the savings. Transfers into it count as contributions
+	// and transfers out of it as withdrawals.
+	// Values are of the form `acc_[a-zA-Z0-9]+`.
+	AccountId *string `protobuf:"bytes,9,opt,name=account_id,json=accountId,proto3,oneof" json:"account_id,omitempty"`
+	// Optional. Transactions carrying this tag count as contributions.
+	// At least one of `account_id` or `tag` is required.
+	Tag string `protobuf:"bytes,10,opt,name=tag,proto3" json:"tag,omitempty"`
+	// Optional. Color hex code.
+	Color string `protobuf:"bytes,11,opt,name=color,proto3" json:"color,omitempty"`
+	// Optional. Icon name.
+	Icon string `protobuf:"bytes,12,opt,name=icon,proto3" json:"icon,omitempty"`
+	// Optional. Lifecycle status. New goals are always active.
+	Status Goal_Status `protobuf:"varint,13,opt,name=status,proto3,enum=saturn.finance.v1.Goal_Status" json:"status,omitempty"`
 	// Output only. Creation timestamp.
-	CreateTime *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
 	// Output only. Last update timestamp.
-	UpdateTime *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
-	// Output only. Hydrated minimal budget details. Available only on FULL view.
-	Budget *ScheduledPayment_BudgetInfo `protobuf:"bytes,13,opt,name=budget,proto3,oneof" json:"budget,omitempty"`
-	// Output only. Hydrated parent template details. Available only on FULL view.
-	RecurringExpense *ScheduledPayment_RecurringExpenseInfo `protobuf:"bytes,14,opt,name=recurring_expense,json=recurringExpense,proto3,oneof" json:"recurring_expense,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ScheduledPayment) Reset() {
-	*x = ScheduledPayment{}
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[32]
+func (x *Goal) Reset() {
+	*x = Goal{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ScheduledPayment) String() string {
+func (x *Goal) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ScheduledPayment) ProtoMessage() {}
+func (*Goal) ProtoMessage() {}
 
-func (x *ScheduledPayment) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[32]
+func (x *Goal) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3685,134 +5013,158 @@ func (x *ScheduledPayment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ScheduledPayment.ProtoReflect.Descriptor instead.
-func (*ScheduledPayment) Descriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use Goal.ProtoReflect.Descriptor instead.
+func (*Goal) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *ScheduledPayment) GetId() string {
+func (x *Goal) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *ScheduledPayment) GetSpaceId() string {
+func (x *Goal) GetSpaceId() string {
 	if x != nil {
 		return x.SpaceId
 	}
 	return ""
 }
 
-func (x *ScheduledPayment) GetBudgetId() string {
+func (x *Goal) GetName() string {
 	if x != nil {
-		return x.BudgetId
+		return x.Name
 	}
 	return ""
 }
 
-func (x *ScheduledPayment) GetSourceType() ScheduledPayment_SourceType {
-	if x != nil {
-		return x.SourceType
-	}
-	return ScheduledPayment_SOURCE_TYPE_UNSPECIFIED
-}
-
-func (x *ScheduledPayment) GetSourceId() string {
+func (x *Goal) GetDescription() string {
 	if x != nil {
-		return x.SourceId
+		return x.Description
 	}
 	return ""
 }
 
-func (x *ScheduledPayment) GetAmount() int64 {
+func (x *Goal) GetTargetAmount() int64 {
 	if x != nil {
-		return x.Amount
+		return x.TargetAmount
 	}
 	return 0
 }
 
-func (x *ScheduledPayment) GetCurrency() string {
+func (x *Goal) GetCurrency() string {
 	if x != nil {
 		return x.Currency
 	}
 	return ""
 }
 
-func (x *ScheduledPayment) GetDueDate() *timestamppb.Timestamp {
+func (x *Goal) GetStartDate() *timestamppb.Timestamp {
 	if x != nil {
-		return x.DueDate
+		return x.StartDate
 	}
 	return nil
 }
 
-func (x *ScheduledPayment) GetStatus() ScheduledPayment_Status {
+func (x *Goal) GetDeadline() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Status
+		return x.Deadline
 	}
-	return ScheduledPayment_STATUS_UNSPECIFIED
+	return nil
 }
 
-func (x *ScheduledPayment) GetMetadata() []byte {
+func (x *Goal) GetAccountId() string {
+	if x != nil && x.AccountId != nil {
+		return *x.AccountId
+	}
+	return ""
+}
+
+func (x *Goal) GetTag() string {
 	if x != nil {
-		return x.Metadata
+		return x.Tag
 	}
-	return nil
+	return ""
 }
 
-func (x *ScheduledPayment) GetCreateTime() *timestamppb.Timestamp {
+func (x *Goal) GetColor() string {
 	if x != nil {
-		return x.CreateTime
+		return x.Color
 	}
-	return nil
+	return ""
 }
 
-func (x *ScheduledPayment) GetUpdateTime() *timestamppb.Timestamp {
+func (x *Goal) GetIcon() string {
 	if x != nil {
-		return x.UpdateTime
+		return x.Icon
 	}
-	return nil
+	return ""
 }
 
-func (x *ScheduledPayment) GetBudget() *ScheduledPayment_BudgetInfo {
+func (x *Goal) GetStatus() Goal_Status {
 	if x != nil {
-		return x.Budget
+		return x.Status
+	}
+	return Goal_STATUS_UNSPECIFIED
+}
+
+func (x *Goal) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
 	}
 	return nil
 }
 
-func (x *ScheduledPayment) GetRecurringExpense() *ScheduledPayment_RecurringExpenseInfo {
+func (x *Goal) GetUpdateTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.RecurringExpense
+		return x.UpdateTime
 	}
 	return nil
 }
 
-// The request for
-// [CreateRecurringExpense][saturn.finance.v1.Finance.CreateRecurringExpense].
-type CreateRecurringExpenseRequest struct {
+// GoalProgress summarizes how far a goal has come and when it is expected to be reached.
+type GoalProgress struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. The recurring expense template to create.
-	RecurringExpense *RecurringExpense `protobuf:"bytes,1,opt,name=recurring_expense,json=recurringExpense,proto3" json:"recurring_expense,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	// The goal being tracked.
+	Goal *Goal `protobuf:"bytes,1,opt,name=goal,proto3" json:"goal,omitempty"`
+	// Net amount saved so far in goal currency minor units.
+	SavedAmount int64 `protobuf:"varint,2,opt,name=saved_amount,json=savedAmount,proto3" json:"saved_amount,omitempty"`
+	// Amount left to reach the target in goal currency minor units.
+	RemainingAmount int64 `protobuf:"varint,3,opt,name=remaining_amount,json=remainingAmount,proto3" json:"remaining_amount,omitempty"`
+	// Share of the target saved, as a percentage capped at 100.
+	Percentage float64 `protobuf:"fixed64,4,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	// Number of contributions and withdrawals recorded since the start date.
+	ContributionCount int32 `protobuf:"varint,5,opt,name=contribution_count,json=contributionCount,proto3" json:"contribution_count,omitempty"`
+	// Average net contribution per 30 days over the last 90 days.
+	MonthlyVelocity int64 `protobuf:"varint,6,opt,name=monthly_velocity,json=monthlyVelocity,proto3" json:"monthly_velocity,omitempty"`
+	// Projected date the target is reached at the current velocity. Unset when the goal is not progressing.
+	ProjectedCompletionDate *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=projected_completion_date,json=projectedCompletionDate,proto3" json:"projected_completion_date,omitempty"`
+	// Monthly contribution needed to meet the deadline. Zero without a deadline.
+	RequiredMonthlyContribution int64 `protobuf:"varint,8,opt,name=required_monthly_contribution,json=requiredMonthlyContribution,proto3" json:"required_monthly_contribution,omitempty"`
+	// Whether the projected completion date meets the deadline.
+	OnTrack bool `protobuf:"varint,9,opt,name=on_track,json=onTrack,proto3" json:"on_track,omitempty"`
+	// Contribution history, oldest first. Omitted from insights.
+	Contributions []*GoalProgress_Contribution `protobuf:"bytes,10,rep,name=contributions,proto3" json:"contributions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateRecurringExpenseRequest) Reset() {
-	*x = CreateRecurringExpenseRequest{}
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[33]
+func (x *GoalProgress) Reset() {
+	*x = GoalProgress{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateRecurringExpenseRequest) String() string {
+func (x *GoalProgress) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateRecurringExpenseRequest) ProtoMessage() {}
+func (*GoalProgress) ProtoMessage() {}
 
-func (x *CreateRecurringExpenseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[33]
+func (x *GoalProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3823,100 +5175,106 @@ func (x *CreateRecurringExpenseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateRecurringExpenseRequest.ProtoReflect.Descriptor instead.
-func (*CreateRecurringExpenseRequest) Descriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use GoalProgress.ProtoReflect.Descriptor instead.
+func (*GoalProgress) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *CreateRecurringExpenseRequest) GetRecurringExpense() *RecurringExpense {
+func (x *GoalProgress) GetGoal() *Goal {
 	if x != nil {
-		return x.RecurringExpense
+		return x.Goal
 	}
 	return nil
 }
 
-// The request for
-// [UpdateRecurringExpense][saturn.finance.v1.Finance.UpdateRecurringExpense].
-type UpdateRecurringExpenseRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. Unique identifier of the template to update.
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Required. The recurring expense template to update.
-	RecurringExpense *RecurringExpense `protobuf:"bytes,2,opt,name=recurring_expense,json=recurringExpense,proto3" json:"recurring_expense,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+func (x *GoalProgress) GetSavedAmount() int64 {
+	if x != nil {
+		return x.SavedAmount
+	}
+	return 0
 }
 
-func (x *UpdateRecurringExpenseRequest) Reset() {
-	*x = UpdateRecurringExpenseRequest{}
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[34]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *GoalProgress) GetRemainingAmount() int64 {
+	if x != nil {
+		return x.RemainingAmount
+	}
+	return 0
 }
 
-func (x *UpdateRecurringExpenseRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GoalProgress) GetPercentage() float64 {
+	if x != nil {
+		return x.Percentage
+	}
+	return 0
 }
 
-func (*UpdateRecurringExpenseRequest) ProtoMessage() {}
+func (x *GoalProgress) GetContributionCount() int32 {
+	if x != nil {
+		return x.ContributionCount
+	}
+	return 0
+}
 
-func (x *UpdateRecurringExpenseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[34]
+func (x *GoalProgress) GetMonthlyVelocity() int64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.MonthlyVelocity
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use UpdateRecurringExpenseRequest.ProtoReflect.Descriptor instead.
-func (*UpdateRecurringExpenseRequest) Descriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{34}
+func (x *GoalProgress) GetProjectedCompletionDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ProjectedCompletionDate
+	}
+	return nil
 }
 
-func (x *UpdateRecurringExpenseRequest) GetId() string {
+func (x *GoalProgress) GetRequiredMonthlyContribution() int64 {
 	if x != nil {
-		return x.Id
+		return x.RequiredMonthlyContribution
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateRecurringExpenseRequest) GetRecurringExpense() *RecurringExpense {
+func (x *GoalProgress) GetOnTrack() bool {
 	if x != nil {
-		return x.RecurringExpense
+		return x.OnTrack
+	}
+	return false
+}
+
+func (x *GoalProgress) GetContributions() []*GoalProgress_Contribution {
+	if x != nil {
+		return x.Contributions
 	}
 	return nil
 }
 
 // The request for
-// [DeleteRecurringExpense][saturn.finance.v1.Finance.DeleteRecurringExpense].
-type DeleteRecurringExpenseRequest struct {
+// [CreateGoal][saturn.finance.v1.Finance.CreateGoal].
+type CreateGoalRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. Unique identifier of the template to delete.
-	// Values are of the form `rec_[a-zA-Z0-9]+`.
-	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Required. The goal to create.
+	Goal          *Goal `protobuf:"bytes,1,opt,name=goal,proto3" json:"goal,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteRecurringExpenseRequest) Reset() {
-	*x = DeleteRecurringExpenseRequest{}
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[35]
+func (x *CreateGoalRequest) Reset() {
+	*x = CreateGoalRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteRecurringExpenseRequest) String() string {
+func (x *CreateGoalRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteRecurringExpenseRequest) ProtoMessage() {}
+func (*CreateGoalRequest) ProtoMessage() {}
 
-func (x *DeleteRecurringExpenseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[35]
+func (x *CreateGoalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3927,53 +5285,44 @@ func (x *DeleteRecurringExpenseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteRecurringExpenseRequest.ProtoReflect.Descriptor instead.
-func (*DeleteRecurringExpenseRequest) Descriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use CreateGoalRequest.ProtoReflect.Descriptor instead.
+func (*CreateGoalRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *DeleteRecurringExpenseRequest) GetId() string {
+func (x *CreateGoalRequest) GetGoal() *Goal {
 	if x != nil {
-		return x.Id
+		return x.Goal
 	}
-	return ""
+	return nil
 }
 
 // The request for
-// [ListRecurringExpenses][saturn.finance.v1.Finance.ListRecurringExpenses].
-type ListRecurringExpensesRequest struct {
+// [GetGoal][saturn.finance.v1.Finance.GetGoal].
+type GetGoalRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Optional. Filter templates by active status.
-	Status RecurringExpense_Status `protobuf:"varint,1,opt,name=status,proto3,enum=saturn.finance.v1.RecurringExpense_Status" json:"status,omitempty"`
-	// Optional. Maximum number of items to return.
-	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	// Optional. Keyset page token.
-	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	// Optional. Representation view level.
-	View *RecurringExpense_View `protobuf:"varint,4,opt,name=view,proto3,enum=saturn.finance.v1.RecurringExpense_View,oneof" json:"view,omitempty"`
-	// Optional. Search string querying the name of the template.
-	SearchQuery *string `protobuf:"bytes,5,opt,name=search_query,json=searchQuery,proto3,oneof" json:"search_query,omitempty"`
-	// Optional. Sorting specification.
-	Sort          *string `protobuf:"bytes,6,opt,name=sort,proto3,oneof" json:"sort,omitempty"`
+	// Required. Unique identifier of the goal.
+	// Values are of the form `goal_[a-zA-Z0-9]+`.
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListRecurringExpensesRequest) Reset() {
-	*x = ListRecurringExpensesRequest{}
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[36]
+func (x *GetGoalRequest) Reset() {
+	*x = GetGoalRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListRecurringExpensesRequest) String() string {
+func (x *GetGoalRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListRecurringExpensesRequest) ProtoMessage() {}
+func (*GetGoalRequest) ProtoMessage() {}
 
-func (x *ListRecurringExpensesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[36]
+func (x *GetGoalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3984,80 +5333,101 @@ func (x *ListRecurringExpensesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListRecurringExpensesRequest.ProtoReflect.Descriptor instead.
-func (*ListRecurringExpensesRequest) Descriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use GetGoalRequest.ProtoReflect.Descriptor instead.
+func (*GetGoalRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *ListRecurringExpensesRequest) GetStatus() RecurringExpense_Status {
+func (x *GetGoalRequest) GetId() string {
 	if x != nil {
-		return x.Status
+		return x.Id
 	}
-	return RecurringExpense_STATUS_UNSPECIFIED
+	return ""
 }
 
-func (x *ListRecurringExpensesRequest) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
+// The request for
+// [UpdateGoal][saturn.finance.v1.Finance.UpdateGoal].
+type UpdateGoalRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. Unique identifier of the goal to update.
+	// Values are of the form `goal_[a-zA-Z0-9]+`.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Required. Updated goal parameters.
+	Goal          *Goal `protobuf:"bytes,2,opt,name=goal,proto3" json:"goal,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListRecurringExpensesRequest) GetPageToken() string {
+func (x *UpdateGoalRequest) Reset() {
+	*x = UpdateGoalRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGoalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGoalRequest) ProtoMessage() {}
+
+func (x *UpdateGoalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[39]
 	if x != nil {
-		return x.PageToken
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ListRecurringExpensesRequest) GetView() RecurringExpense_View {
-	if x != nil && x.View != nil {
-		return *x.View
-	}
-	return RecurringExpense_VIEW_UNSPECIFIED
+// Deprecated: Use UpdateGoalRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGoalRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *ListRecurringExpensesRequest) GetSearchQuery() string {
-	if x != nil && x.SearchQuery != nil {
-		return *x.SearchQuery
+func (x *UpdateGoalRequest) GetId() string {
+	if x != nil {
+		return x.Id
 	}
 	return ""
 }
 
-func (x *ListRecurringExpensesRequest) GetSort() string {
-	if x != nil && x.Sort != nil {
-		return *x.Sort
+func (x *UpdateGoalRequest) GetGoal() *Goal {
+	if x != nil {
+		return x.Goal
 	}
-	return ""
+	return nil
 }
 
-// The response for
-// [ListRecurringExpenses][saturn.finance.v1.Finance.ListRecurringExpenses].
-type ListRecurringExpensesResponse struct {
+// The request for
+// [DeleteGoal][saturn.finance.v1.Finance.DeleteGoal].
+type DeleteGoalRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// List of recurring expense templates matching filters.
-	RecurringExpenses []*RecurringExpense `protobuf:"bytes,1,rep,name=recurring_expenses,json=recurringExpenses,proto3" json:"recurring_expenses,omitempty"`
-	// Next page keyset token. Empty if no more pages are available.
-	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// Required. Unique identifier of the goal to delete.
+	// Values are of the form `goal_[a-zA-Z0-9]+`.
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListRecurringExpensesResponse) Reset() {
-	*x = ListRecurringExpensesResponse{}
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[37]
+func (x *DeleteGoalRequest) Reset() {
+	*x = DeleteGoalRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListRecurringExpensesResponse) String() string {
+func (x *DeleteGoalRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListRecurringExpensesResponse) ProtoMessage() {}
+func (*DeleteGoalRequest) ProtoMessage() {}
 
-func (x *ListRecurringExpensesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[37]
+func (x *DeleteGoalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4068,64 +5438,51 @@ func (x *ListRecurringExpensesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListRecurringExpensesResponse.ProtoReflect.Descriptor instead.
-func (*ListRecurringExpensesResponse) Descriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{37}
-}
-
-func (x *ListRecurringExpensesResponse) GetRecurringExpenses() []*RecurringExpense {
-	if x != nil {
-		return x.RecurringExpenses
-	}
-	return nil
+// Deprecated: Use DeleteGoalRequest.ProtoReflect.Descriptor instead.
+func (*DeleteGoalRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *ListRecurringExpensesResponse) GetNextPageToken() string {
+func (x *DeleteGoalRequest) GetId() string {
 	if x != nil {
-		return x.NextPageToken
+		return x.Id
 	}
 	return ""
 }
 
 // The request for
-// [ListScheduledPayments][saturn.finance.v1.Finance.ListScheduledPayments].
-type ListScheduledPaymentsRequest struct {
+// [ListGoals][saturn.finance.v1.Finance.ListGoals].
+type ListGoalsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Optional. Filter instances by payment status.
-	Status ScheduledPayment_Status `protobuf:"varint,1,opt,name=status,proto3,enum=saturn.finance.v1.ScheduledPayment_Status" json:"status,omitempty"`
-	// Optional. Target start date of execution window.
-	StartDate *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	// Optional. Target end date of execution window.
-	EndDate *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
 	// Optional. Maximum number of items to return.
-	PageSize int32 `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	// Optional. Keyset page token.
-	PageToken string `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	// Optional. Representation view level.
-	View *ScheduledPayment_View `protobuf:"varint,6,opt,name=view,proto3,enum=saturn.finance.v1.ScheduledPayment_View,oneof" json:"view,omitempty"`
-	// Optional. Search string querying the description or parent info.
-	SearchQuery *string `protobuf:"bytes,7,opt,name=search_query,json=searchQuery,proto3,oneof" json:"search_query,omitempty"`
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Optional. Filter by goal status.
+	Status *Goal_Status `protobuf:"varint,3,opt,name=status,proto3,enum=saturn.finance.v1.Goal_Status,oneof" json:"status,omitempty"`
+	// Optional. Text search query matching the name or description.
+	SearchQuery *string `protobuf:"bytes,4,opt,name=search_query,json=searchQuery,proto3,oneof" json:"search_query,omitempty"`
 	// Optional. Sorting specification.
-	Sort          *string `protobuf:"bytes,8,opt,name=sort,proto3,oneof" json:"sort,omitempty"`
+	Sort          *string `protobuf:"bytes,5,opt,name=sort,proto3,oneof" json:"sort,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListScheduledPaymentsRequest) Reset() {
-	*x = ListScheduledPaymentsRequest{}
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[38]
+func (x *ListGoalsRequest) Reset() {
+	*x = ListGoalsRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListScheduledPaymentsRequest) String() string {
+func (x *ListGoalsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListScheduledPaymentsRequest) ProtoMessage() {}
+func (*ListGoalsRequest) ProtoMessage() {}
 
-func (x *ListScheduledPaymentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[38]
+func (x *ListGoalsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4136,61 +5493,40 @@ func (x *ListScheduledPaymentsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListScheduledPaymentsRequest.ProtoReflect.Descriptor instead.
-func (*ListScheduledPaymentsRequest) Descriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{38}
-}
-
-func (x *ListScheduledPaymentsRequest) GetStatus() ScheduledPayment_Status {
-	if x != nil {
-		return x.Status
-	}
-	return ScheduledPayment_STATUS_UNSPECIFIED
-}
-
-func (x *ListScheduledPaymentsRequest) GetStartDate() *timestamppb.Timestamp {
-	if x != nil {
-		return x.StartDate
-	}
-	return nil
-}
-
-func (x *ListScheduledPaymentsRequest) GetEndDate() *timestamppb.Timestamp {
-	if x != nil {
-		return x.EndDate
-	}
-	return nil
+// Deprecated: Use ListGoalsRequest.ProtoReflect.Descriptor instead.
+func (*ListGoalsRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *ListScheduledPaymentsRequest) GetPageSize() int32 {
+func (x *ListGoalsRequest) GetPageSize() int32 {
 	if x != nil {
 		return x.PageSize
 	}
 	return 0
 }
 
-func (x *ListScheduledPaymentsRequest) GetPageToken() string {
+func (x *ListGoalsRequest) GetPageToken() string {
 	if x != nil {
 		return x.PageToken
 	}
 	return ""
 }
 
-func (x *ListScheduledPaymentsRequest) GetView() ScheduledPayment_View {
-	if x != nil && x.View != nil {
-		return *x.View
+func (x *ListGoalsRequest) GetStatus() Goal_Status {
+	if x != nil && x.Status != nil {
+		return *x.Status
 	}
-	return ScheduledPayment_VIEW_UNSPECIFIED
+	return Goal_STATUS_UNSPECIFIED
 }
 
-func (x *ListScheduledPaymentsRequest) GetSearchQuery() string {
+func (x *ListGoalsRequest) GetSearchQuery() string {
 	if x != nil && x.SearchQuery != nil {
 		return *x.SearchQuery
 	}
 	return ""
 }
 
-func (x *ListScheduledPaymentsRequest) GetSort() string {
+func (x *ListGoalsRequest) GetSort() string {
 	if x != nil && x.Sort != nil {
 		return *x.Sort
 	}
@@ -4198,32 +5534,32 @@ func (x *ListScheduledPaymentsRequest) GetSort() string {
 }
 
 // The response for
-// [ListScheduledPayments][saturn.finance.v1.Finance.ListScheduledPayments].
-type ListScheduledPaymentsResponse struct {
+// [ListGoals][saturn.finance.v1.Finance.ListGoals].
+type ListGoalsResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// List of scheduled payment instances matching filters.
-	ScheduledPayments []*ScheduledPayment `protobuf:"bytes,1,rep,name=scheduled_payments,json=scheduledPayments,proto3" json:"scheduled_payments,omitempty"`
+	// List of goals matching filters.
+	Goals []*Goal `protobuf:"bytes,1,rep,name=goals,proto3" json:"goals,omitempty"`
 	// Next page keyset token. Empty if no more pages are available.
 	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListScheduledPaymentsResponse) Reset() {
-	*x = ListScheduledPaymentsResponse{}
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[39]
+func (x *ListGoalsResponse) Reset() {
+	*x = ListGoalsResponse{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListScheduledPaymentsResponse) String() string {
+func (x *ListGoalsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListScheduledPaymentsResponse) ProtoMessage() {}
+func (*ListGoalsResponse) ProtoMessage() {}
 
-func (x *ListScheduledPaymentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[39]
+func (x *ListGoalsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4234,19 +5570,19 @@ func (x *ListScheduledPaymentsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListScheduledPaymentsResponse.ProtoReflect.Descriptor instead.
-func (*ListScheduledPaymentsResponse) Descriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use ListGoalsResponse.ProtoReflect.Descriptor instead.
+func (*ListGoalsResponse) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *ListScheduledPaymentsResponse) GetScheduledPayments() []*ScheduledPayment {
+func (x *ListGoalsResponse) GetGoals() []*Goal {
 	if x != nil {
-		return x.ScheduledPayments
+		return x.Goals
 	}
 	return nil
 }
 
-func (x *ListScheduledPaymentsResponse) GetNextPageToken() string {
+func (x *ListGoalsResponse) GetNextPageToken() string {
 	if x != nil {
 		return x.NextPageToken
 	}
@@ -4254,31 +5590,31 @@ func (x *ListScheduledPaymentsResponse) GetNextPageToken() string {
 }
 
 // The request for
-// [GetScheduledPayment][saturn.finance.v1.Finance.GetScheduledPayment].
-type GetScheduledPaymentRequest struct {
+// [GetGoalProgress][saturn.finance.v1.Finance.GetGoalProgress].
+type GetGoalProgressRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. Unique identifier of the scheduled payment to retrieve.
-	// Values are of the form `sch_[a-zA-Z0-9]+`.
+	// Required. Unique identifier of the goal.
+	// Values are of the form `goal_[a-zA-Z0-9]+`.
 	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetScheduledPaymentRequest) Reset() {
-	*x = GetScheduledPaymentRequest{}
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[40]
+func (x *GetGoalProgressRequest) Reset() {
+	*x = GetGoalProgressRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetScheduledPaymentRequest) String() string {
+func (x *GetGoalProgressRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetScheduledPaymentRequest) ProtoMessage() {}
+func (*GetGoalProgressRequest) ProtoMessage() {}
 
-func (x *GetScheduledPaymentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[40]
+func (x *GetGoalProgressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4289,63 +5625,58 @@ func (x *GetScheduledPaymentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetScheduledPaymentRequest.ProtoReflect.Descriptor instead.
-func (*GetScheduledPaymentRequest) Descriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use GetGoalProgressRequest.ProtoReflect.Descriptor instead.
+func (*GetGoalProgressRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *GetScheduledPaymentRequest) GetId() string {
+func (x *GetGoalProgressRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-// The request for
-// [ConfirmScheduledPayment][saturn.finance.v1.Finance.ConfirmScheduledPayment].
-type ConfirmScheduledPaymentRequest struct {
+// Category classifies transactions independently of budgets. Categories can be nested.
+type Category struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. Unique identifier of the scheduled payment to confirm.
-	// Values are of the form `sch_[a-zA-Z0-9]+`.
-	PaymentId string `protobuf:"bytes,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
-	// Required. Time transaction occurred.
-	TransactionDate *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=transaction_date,json=transactionDate,proto3" json:"transaction_date,omitempty"`
-	// Required. Execution/posting date.
-	EffectiveDate *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=effective_date,json=effectiveDate,proto3" json:"effective_date,omitempty"`
-	// Required. Actual amount cleared in local currency cents.
-	ActualAmount int64 `protobuf:"varint,4,opt,name=actual_amount,json=actualAmount,proto3" json:"actual_amount,omitempty"`
-	// Optional. Narration notes.
-	Description *string `protobuf:"bytes,5,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	// Optional. Financial account identifier used to settle the payment.
-	// When provided, the account balance will be updated accordingly.
-	// Values are of the form `acc_[a-zA-Z0-9]+`.
-	AccountId *string `protobuf:"bytes,6,opt,name=account_id,json=accountId,proto3,oneof" json:"account_id,omitempty"`
-	// Optional. Budget category identifier to allocate the cleared transaction under.
-	// If omitted, defaults to the budget category configured on the scheduled payment.
-	// Values are of the form `bud_[a-zA-Z0-9]+`.
-	BudgetId *string `protobuf:"bytes,7,opt,name=budget_id,json=budgetId,proto3,oneof" json:"budget_id,omitempty"`
-	// Optional. Settlement currency code (ISO 4217 standard 3-letter code).
-	// If omitted, defaults to the currency configured on the scheduled payment.
-	Currency      *string `protobuf:"bytes,8,opt,name=currency,proto3,oneof" json:"currency,omitempty"`
+	// Output only. Unique identifier.
+	// Values are of the form `cat_[a-zA-Z0-9]+`.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Output only. Space identifier.
+	SpaceId string `protobuf:"bytes,2,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	// Optional. Parent category identifier. Empty for top-level categories.
+	// Values are of the form `cat_[a-zA-Z0-9]+`.
+	ParentId *string `protobuf:"bytes,3,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`
+	// Required. User-friendly name (e.g. "Groceries").
+	Name string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	// Optional. Visual UI icon identifier.
+	Icon string `protobuf:"bytes,5,opt,name=icon,proto3" json:"icon,omitempty"`
+	// Optional. Visual UI color hex code or tag.
+	Color string `protobuf:"bytes,6,opt,name=color,proto3" json:"color,omitempty"`
+	// Output only. Creation timestamp.
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// Output only. Last update timestamp.
+	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ConfirmScheduledPaymentRequest) Reset() {
-	*x = ConfirmScheduledPaymentRequest{}
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[41]
+func (x *Category) Reset() {
+	*x = Category{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ConfirmScheduledPaymentRequest) String() string {
+func (x *Category) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConfirmScheduledPaymentRequest) ProtoMessage() {}
+func (*Category) ProtoMessage() {}
 
-func (x *ConfirmScheduledPaymentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[41]
+func (x *Category) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4356,95 +5687,92 @@ func (x *ConfirmScheduledPaymentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConfirmScheduledPaymentRequest.ProtoReflect.Descriptor instead.
-func (*ConfirmScheduledPaymentRequest) Descriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use Category.ProtoReflect.Descriptor instead.
+func (*Category) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *ConfirmScheduledPaymentRequest) GetPaymentId() string {
+func (x *Category) GetId() string {
 	if x != nil {
-		return x.PaymentId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *ConfirmScheduledPaymentRequest) GetTransactionDate() *timestamppb.Timestamp {
+func (x *Category) GetSpaceId() string {
 	if x != nil {
-		return x.TransactionDate
+		return x.SpaceId
 	}
-	return nil
+	return ""
 }
 
-func (x *ConfirmScheduledPaymentRequest) GetEffectiveDate() *timestamppb.Timestamp {
-	if x != nil {
-		return x.EffectiveDate
+func (x *Category) GetParentId() string {
+	if x != nil && x.ParentId != nil {
+		return *x.ParentId
 	}
-	return nil
+	return ""
 }
 
-func (x *ConfirmScheduledPaymentRequest) GetActualAmount() int64 {
+func (x *Category) GetName() string {
 	if x != nil {
-		return x.ActualAmount
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *ConfirmScheduledPaymentRequest) GetDescription() string {
-	if x != nil && x.Description != nil {
-		return *x.Description
+func (x *Category) GetIcon() string {
+	if x != nil {
+		return x.Icon
 	}
 	return ""
 }
 
-func (x *ConfirmScheduledPaymentRequest) GetAccountId() string {
-	if x != nil && x.AccountId != nil {
-		return *x.AccountId
+func (x *Category) GetColor() string {
+	if x != nil {
+		return x.Color
 	}
 	return ""
 }
 
-func (x *ConfirmScheduledPaymentRequest) GetBudgetId() string {
-	if x != nil && x.BudgetId != nil {
-		return *x.BudgetId
+func (x *Category) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
 	}
-	return ""
+	return nil
 }
 
-func (x *ConfirmScheduledPaymentRequest) GetCurrency() string {
-	if x != nil && x.Currency != nil {
-		return *x.Currency
+func (x *Category) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
 	}
-	return ""
+	return nil
 }
 
-// The request for [MatchScheduledPayment][saturn.finance.v1.Finance.MatchScheduledPayment].
-type MatchScheduledPaymentRequest struct {
+// The request for
+// [CreateCategory][saturn.finance.v1.Finance.CreateCategory].
+type CreateCategoryRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. Target scheduled payment identifier.
-	// Values are of the form `sch_[a-zA-Z0-9]+`.
-	PaymentId string `protobuf:"bytes,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
-	// Required. Existing transaction identifier to match and link.
-	// Values are of the form `txn_[a-zA-Z0-9]+`.
-	TransactionId string `protobuf:"bytes,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	// Required. The category to create.
+	Category      *Category `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MatchScheduledPaymentRequest) Reset() {
-	*x = MatchScheduledPaymentRequest{}
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[42]
+func (x *CreateCategoryRequest) Reset() {
+	*x = CreateCategoryRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MatchScheduledPaymentRequest) String() string {
+func (x *CreateCategoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MatchScheduledPaymentRequest) ProtoMessage() {}
+func (*CreateCategoryRequest) ProtoMessage() {}
 
-func (x *MatchScheduledPaymentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[42]
+func (x *CreateCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4455,50 +5783,44 @@ func (x *MatchScheduledPaymentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MatchScheduledPaymentRequest.ProtoReflect.Descriptor instead.
-func (*MatchScheduledPaymentRequest) Descriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{42}
-}
-
-func (x *MatchScheduledPaymentRequest) GetPaymentId() string {
-	if x != nil {
-		return x.PaymentId
-	}
-	return ""
+// Deprecated: Use CreateCategoryRequest.ProtoReflect.Descriptor instead.
+func (*CreateCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *MatchScheduledPaymentRequest) GetTransactionId() string {
+func (x *CreateCategoryRequest) GetCategory() *Category {
 	if x != nil {
-		return x.TransactionId
+		return x.Category
 	}
-	return ""
+	return nil
 }
 
-// The request for [SkipScheduledPayment][saturn.finance.v1.Finance.SkipScheduledPayment].
-type SkipScheduledPaymentRequest struct {
+// The request for
+// [GetCategory][saturn.finance.v1.Finance.GetCategory].
+type GetCategoryRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. Identifier of the scheduled payment instance to skip.
-	// Values are of the form `sch_[a-zA-Z0-9]+`.
+	// Required. Unique identifier of the category.
+	// Values are of the form `cat_[a-zA-Z0-9]+`.
 	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SkipScheduledPaymentRequest) Reset() {
-	*x = SkipScheduledPaymentRequest{}
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[43]
+func (x *GetCategoryRequest) Reset() {
+	*x = GetCategoryRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SkipScheduledPaymentRequest) String() string {
+func (x *GetCategoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SkipScheduledPaymentRequest) ProtoMessage() {}
+func (*GetCategoryRequest) ProtoMessage() {}
 
-func (x *SkipScheduledPaymentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[43]
+func (x *GetCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4509,74 +5831,46 @@ func (x *SkipScheduledPaymentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SkipScheduledPaymentRequest.ProtoReflect.Descriptor instead.
-func (*SkipScheduledPaymentRequest) Descriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use GetCategoryRequest.ProtoReflect.Descriptor instead.
+func (*GetCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *SkipScheduledPaymentRequest) GetId() string {
+func (x *GetCategoryRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-// Borrowing represents a personal lent/borrowed debt agreement.
-type Borrowing struct {
+// The request for
+// [UpdateCategory][saturn.finance.v1.Finance.UpdateCategory].
+type UpdateCategoryRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Output only. Unique identifier.
-	// Values are of the form `bor_[a-zA-Z0-9]+`.
+	// Required. Unique identifier of the category to update.
+	// Values are of the form `cat_[a-zA-Z0-9]+`.
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Output only. Space identifier.
-	SpaceId string `protobuf:"bytes,2,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
-	// Required. Direction flow of the debt.
-	Direction Borrowing_Direction `protobuf:"varint,3,opt,name=direction,proto3,enum=saturn.finance.v1.Borrowing_Direction" json:"direction,omitempty"`
-	// Required. Name of the counterparty entity.
-	Counterparty string `protobuf:"bytes,4,opt,name=counterparty,proto3" json:"counterparty,omitempty"`
-	// Optional. Contact information.
-	ContactInfo string `protobuf:"bytes,5,opt,name=contact_info,json=contactInfo,proto3" json:"contact_info,omitempty"`
-	// Required. Initial loan principal amount in cents.
-	TotalAmount int64 `protobuf:"varint,6,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
-	// Output only. Remaining balance amount in cents.
-	RemainingAmount int64 `protobuf:"varint,7,opt,name=remaining_amount,json=remainingAmount,proto3" json:"remaining_amount,omitempty"`
-	// Required. Currency code.
-	Currency string `protobuf:"bytes,8,opt,name=currency,proto3" json:"currency,omitempty"`
-	// Required. Lifecycle status.
-	Status Borrowing_Status `protobuf:"varint,9,opt,name=status,proto3,enum=saturn.finance.v1.Borrowing_Status" json:"status,omitempty"`
-	// Required. Date established.
-	EstablishedAt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=established_at,json=establishedAt,proto3" json:"established_at,omitempty"`
-	// Optional. Target repayment due date.
-	DueAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=due_at,json=dueAt,proto3" json:"due_at,omitempty"`
-	// Optional. Automatically create a parallel transaction entry in the ledger.
-	CreateAsTransaction bool `protobuf:"varint,12,opt,name=create_as_transaction,json=createAsTransaction,proto3" json:"create_as_transaction,omitempty"`
-	// Optional. Narration notes.
-	Notes string `protobuf:"bytes,13,opt,name=notes,proto3" json:"notes,omitempty"`
-	// Output only. Creation timestamp.
-	CreateTime *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
-	// Output only. Last update timestamp.
-	UpdateTime *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
-	// Optional. Source account identifier for transaction creation.
-	// Values are of the form `acc_[a-zA-Z0-9]+`.
-	AccountId     *string `protobuf:"bytes,16,opt,name=account_id,json=accountId,proto3,oneof" json:"account_id,omitempty"`
+	// Required. Updated category parameters.
+	Category      *Category `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Borrowing) Reset() {
-	*x = Borrowing{}
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[44]
+func (x *UpdateCategoryRequest) Reset() {
+	*x = UpdateCategoryRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Borrowing) String() string {
+func (x *UpdateCategoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Borrowing) ProtoMessage() {}
+func (*UpdateCategoryRequest) ProtoMessage() {}
 
-func (x *Borrowing) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[44]
+func (x *UpdateCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4587,166 +5881,7483 @@ func (x *Borrowing) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Borrowing.ProtoReflect.Descriptor instead.
-func (*Borrowing) Descriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use UpdateCategoryRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *Borrowing) GetId() string {
+func (x *UpdateCategoryRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *Borrowing) GetSpaceId() string {
+func (x *UpdateCategoryRequest) GetCategory() *Category {
 	if x != nil {
-		return x.SpaceId
+		return x.Category
 	}
-	return ""
+	return nil
 }
 
-func (x *Borrowing) GetDirection() Borrowing_Direction {
-	if x != nil {
-		return x.Direction
-	}
-	return Borrowing_DIRECTION_UNSPECIFIED
+// The request for
+// [DeleteCategory][saturn.finance.v1.Finance.DeleteCategory].
+type DeleteCategoryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. Unique identifier of the category to delete.
+	// Values are of the form `cat_[a-zA-Z0-9]+`.
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Borrowing) GetCounterparty() string {
-	if x != nil {
-		return x.Counterparty
-	}
-	return ""
+func (x *DeleteCategoryRequest) Reset() {
+	*x = DeleteCategoryRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Borrowing) GetContactInfo() string {
-	if x != nil {
-		return x.ContactInfo
-	}
-	return ""
+func (x *DeleteCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Borrowing) GetTotalAmount() int64 {
-	if x != nil {
-		return x.TotalAmount
-	}
-	return 0
-}
+func (*DeleteCategoryRequest) ProtoMessage() {}
 
-func (x *Borrowing) GetRemainingAmount() int64 {
+func (x *DeleteCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[48]
 	if x != nil {
-		return x.RemainingAmount
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Borrowing) GetCurrency() string {
-	if x != nil {
-		return x.Currency
-	}
-	return ""
+// Deprecated: Use DeleteCategoryRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *Borrowing) GetStatus() Borrowing_Status {
+func (x *DeleteCategoryRequest) GetId() string {
 	if x != nil {
-		return x.Status
+		return x.Id
 	}
-	return Borrowing_STATUS_UNSPECIFIED
+	return ""
 }
 
-func (x *Borrowing) GetEstablishedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.EstablishedAt
-	}
+// The request for
+// [ListCategories][saturn.finance.v1.Finance.ListCategories].
+type ListCategoriesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional. Maximum number of items to return.
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Optional. Keyset page token.
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Optional. Return only direct children of this category.
+	// Values are of the form `cat_[a-zA-Z0-9]+`.
+	ParentId *string `protobuf:"bytes,3,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`
+	// Optional. Return only top-level categories. Ignored when parent_id is set.
+	TopLevelOnly bool `protobuf:"varint,4,opt,name=top_level_only,json=topLevelOnly,proto3" json:"top_level_only,omitempty"`
+	// Optional. Text search query matching the name.
+	SearchQuery *string `protobuf:"bytes,5,opt,name=search_query,json=searchQuery,proto3,oneof" json:"search_query,omitempty"`
+	// Optional. Sorting specification.
+	Sort          *string `protobuf:"bytes,6,opt,name=sort,proto3,oneof" json:"sort,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoriesRequest) Reset() {
+	*x = ListCategoriesRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoriesRequest) ProtoMessage() {}
+
+func (x *ListCategoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoriesRequest.ProtoReflect.Descriptor instead.
+func (*ListCategoriesRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ListCategoriesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListCategoriesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListCategoriesRequest) GetParentId() string {
+	if x != nil && x.ParentId != nil {
+		return *x.ParentId
+	}
+	return ""
+}
+
+func (x *ListCategoriesRequest) GetTopLevelOnly() bool {
+	if x != nil {
+		return x.TopLevelOnly
+	}
+	return false
+}
+
+func (x *ListCategoriesRequest) GetSearchQuery() string {
+	if x != nil && x.SearchQuery != nil {
+		return *x.SearchQuery
+	}
+	return ""
+}
+
+func (x *ListCategoriesRequest) GetSort() string {
+	if x != nil && x.Sort != nil {
+		return *x.Sort
+	}
+	return ""
+}
+
+// The response for
+// [ListCategories][saturn.finance.v1.Finance.ListCategories].
+type ListCategoriesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// List of categories matching filters.
+	Categories []*Category `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	// Next page keyset token. Empty if no more pages are available.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoriesResponse) Reset() {
+	*x = ListCategoriesResponse{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoriesResponse) ProtoMessage() {}
+
+func (x *ListCategoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoriesResponse.ProtoReflect.Descriptor instead.
+func (*ListCategoriesResponse) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ListCategoriesResponse) GetCategories() []*Category {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *ListCategoriesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// The request for
+// [DeleteTransaction][saturn.finance.v1.Finance.DeleteTransaction].
+type DeleteTransactionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. Unique identifier of the transaction to delete.
+	// Values are of the form `txn_[a-zA-Z0-9]+`.
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTransactionRequest) Reset() {
+	*x = DeleteTransactionRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTransactionRequest) ProtoMessage() {}
+
+func (x *DeleteTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTransactionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *DeleteTransactionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// The request for
+// [GetTransaction][saturn.finance.v1.Finance.GetTransaction].
+type GetTransactionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. Unique identifier of the transaction record to retrieve.
+	// Values are of the form `txn_[a-zA-Z0-9]+`.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Optional. Scoped budget representation view type.
+	View          *Transaction_View `protobuf:"varint,2,opt,name=view,proto3,enum=saturn.finance.v1.Transaction_View,oneof" json:"view,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransactionRequest) Reset() {
+	*x = GetTransactionRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionRequest) ProtoMessage() {}
+
+func (x *GetTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionRequest.ProtoReflect.Descriptor instead.
+func (*GetTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetTransactionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetTransactionRequest) GetView() Transaction_View {
+	if x != nil && x.View != nil {
+		return *x.View
+	}
+	return Transaction_VIEW_UNSPECIFIED
+}
+
+// The request for
+// [ListTransactions][saturn.finance.v1.Finance.ListTransactions].
+type ListTransactionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional. Scoped budget representation view type.
+	View *Transaction_View `protobuf:"varint,1,opt,name=view,proto3,enum=saturn.finance.v1.Transaction_View,oneof" json:"view,omitempty"`
+	// Optional. Target parent budget ID filter.
+	// Values are of the form `bud_[a-zA-Z0-9]+`.
+	BudgetId string `protobuf:"bytes,2,opt,name=budget_id,json=budgetId,proto3" json:"budget_id,omitempty"`
+	// Optional. Target transaction flow type filter.
+	Type Transaction_Type `protobuf:"varint,3,opt,name=type,proto3,enum=saturn.finance.v1.Transaction_Type" json:"type,omitempty"`
+	// Optional. Target account identifier.
+	// Values are of the form `acc_[a-zA-Z0-9]+`.
+	AccountId *string `protobuf:"bytes,6,opt,name=account_id,json=accountId,proto3,oneof" json:"account_id,omitempty"`
+	// Optional. Text search query matching transaction description notes.
+	SearchQuery *string `protobuf:"bytes,7,opt,name=search_query,json=searchQuery,proto3,oneof" json:"search_query,omitempty"`
+	// Optional. Maximum number of items to return.
+	PageSize int32 `protobuf:"varint,8,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Optional. Keyset page token.
+	PageToken string `protobuf:"bytes,9,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Optional. Sort order string.
+	Sort *string `protobuf:"bytes,10,opt,name=sort,proto3,oneof" json:"sort,omitempty"`
+	// Optional. Target transfer ID filter.
+	// Values are of the form `trn_[a-zA-Z0-9]+`.
+	TransferId *string `protobuf:"bytes,11,opt,name=transfer_id,json=transferId,proto3,oneof" json:"transfer_id,omitempty"`
+	// Optional. Target scheduled payment ID filter.
+	// Values are of the form `sch_[a-zA-Z0-9]+`.
+	ScheduledPaymentId *string `protobuf:"bytes,13,opt,name=scheduled_payment_id,json=scheduledPaymentId,proto3,oneof" json:"scheduled_payment_id,omitempty"`
+	// Optional. Target borrowing ID filter.
+	// Values are of the form `bor_[a-zA-Z0-9]+`.
+	BorrowingId *string `protobuf:"bytes,14,opt,name=borrowing_id,json=borrowingId,proto3,oneof" json:"borrowing_id,omitempty"`
+	// Optional. Target income source ID filter.
+	// Values are of the form `ics_[a-zA-Z0-9]+`.
+	IncomeSourceId *string `protobuf:"bytes,15,opt,name=income_source_id,json=incomeSourceId,proto3,oneof" json:"income_source_id,omitempty"`
+	// Optional. Target category ID filter. Includes transactions in subcategories.
+	// Values are of the form `cat_[a-zA-Z0-9]+`.
+	CategoryId *string `protobuf:"bytes,16,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	// Optional. Only return transactions carrying all of these tags.
+	Tags []string `protobuf:"bytes,17,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Optional. AIP-160 filter expression, combined with the other filters using AND.
+	// Supported fields: transaction_date, effective_date, create_time, amount, amount_in_base,
+	// currency, type, description, budget_id, account_id, category_id, payee_id, tags, cleared.
+	// Example: `transaction_date >= 2025-01-01 AND amount > 5000 AND budget_id IN ("bud_a", "bud_b")`.
+	Filter *string `protobuf:"bytes,18,opt,name=filter,proto3,oneof" json:"filter,omitempty"`
+	// Optional. Saved filter whose expression is applied in addition to `filter`.
+	// Its default sort is used when `sort` is not set.
+	// Values are of the form `sfl_[a-zA-Z0-9]+`.
+	SavedFilterId *string `protobuf:"bytes,19,opt,name=saved_filter_id,json=savedFilterId,proto3,oneof" json:"saved_filter_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTransactionsRequest) Reset() {
+	*x = ListTransactionsRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTransactionsRequest) ProtoMessage() {}
+
+func (x *ListTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*ListTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ListTransactionsRequest) GetView() Transaction_View {
+	if x != nil && x.View != nil {
+		return *x.View
+	}
+	return Transaction_VIEW_UNSPECIFIED
+}
+
+func (x *ListTransactionsRequest) GetBudgetId() string {
+	if x != nil {
+		return x.BudgetId
+	}
+	return ""
+}
+
+func (x *ListTransactionsRequest) GetType() Transaction_Type {
+	if x != nil {
+		return x.Type
+	}
+	return Transaction_TYPE_UNSPECIFIED
+}
+
+func (x *ListTransactionsRequest) GetAccountId() string {
+	if x != nil && x.AccountId != nil {
+		return *x.AccountId
+	}
+	return ""
+}
+
+func (x *ListTransactionsRequest) GetSearchQuery() string {
+	if x != nil && x.SearchQuery != nil {
+		return *x.SearchQuery
+	}
+	return ""
+}
+
+func (x *ListTransactionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListTransactionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListTransactionsRequest) GetSort() string {
+	if x != nil && x.Sort != nil {
+		return *x.Sort
+	}
+	return ""
+}
+
+func (x *ListTransactionsRequest) GetTransferId() string {
+	if x != nil && x.TransferId != nil {
+		return *x.TransferId
+	}
+	return ""
+}
+
+func (x *ListTransactionsRequest) GetScheduledPaymentId() string {
+	if x != nil && x.ScheduledPaymentId != nil {
+		return *x.ScheduledPaymentId
+	}
+	return ""
+}
+
+func (x *ListTransactionsRequest) GetBorrowingId() string {
+	if x != nil && x.BorrowingId != nil {
+		return *x.BorrowingId
+	}
+	return ""
+}
+
+func (x *ListTransactionsRequest) GetIncomeSourceId() string {
+	if x != nil && x.IncomeSourceId != nil {
+		return *x.IncomeSourceId
+	}
+	return ""
+}
+
+func (x *ListTransactionsRequest) GetCategoryId() string {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return ""
+}
+
+func (x *ListTransactionsRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ListTransactionsRequest) GetFilter() string {
+	if x != nil && x.Filter != nil {
+		return *x.Filter
+	}
+	return ""
+}
+
+func (x *ListTransactionsRequest) GetSavedFilterId() string {
+	if x != nil && x.SavedFilterId != nil {
+		return *x.SavedFilterId
+	}
+	return ""
+}
+
+// The response for
+// [ListTransactions][saturn.finance.v1.Finance.ListTransactions].
+type ListTransactionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// List of transactions matching filter rules.
+	Transactions []*Transaction `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	// Next page keyset token. Empty if no more pages are available.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTransactionsResponse) Reset() {
+	*x = ListTransactionsResponse{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTransactionsResponse) ProtoMessage() {}
+
+func (x *ListTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*ListTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ListTransactionsResponse) GetTransactions() []*Transaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+func (x *ListTransactionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// The request for
+// [BatchUpdateTransactions][saturn.finance.v1.Finance.BatchUpdateTransactions].
+// Exactly one of `ids` or `filter`/`saved_filter_id` must be set. Only expenses and income that are
+// not reconciled or linked to a borrowing can be changed; otherwise the whole batch is rejected.
+type BatchUpdateTransactionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional. Transactions to update, at most 500.
+	// Values are of the form `txn_[a-zA-Z0-9]+`.
+	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	// Optional. AIP-160 filter expression selecting the transactions to update, using the fields
+	// supported by `ListTransactions`. Must match at most 500 transactions.
+	Filter *string `protobuf:"bytes,2,opt,name=filter,proto3,oneof" json:"filter,omitempty"`
+	// Optional. Saved filter whose expression selects the transactions, AND-ed with `filter`.
+	// Values are of the form `sfl_[a-zA-Z0-9]+`.
+	SavedFilterId *string `protobuf:"bytes,3,opt,name=saved_filter_id,json=savedFilterId,proto3,oneof" json:"saved_filter_id,omitempty"`
+	// Required. New values of the fields listed in `update_mask`.
+	Transaction *Transaction `protobuf:"bytes,4,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	// Required. Fields to change. Supported fields: description, budget_id, account_id, category_id,
+	// payee_id, tags.
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,5,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// Optional. Compute and return the changes without applying them.
+	ValidateOnly  bool `protobuf:"varint,6,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchUpdateTransactionsRequest) Reset() {
+	*x = BatchUpdateTransactionsRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchUpdateTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpdateTransactionsRequest) ProtoMessage() {}
+
+func (x *BatchUpdateTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpdateTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*BatchUpdateTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *BatchUpdateTransactionsRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *BatchUpdateTransactionsRequest) GetFilter() string {
+	if x != nil && x.Filter != nil {
+		return *x.Filter
+	}
+	return ""
+}
+
+func (x *BatchUpdateTransactionsRequest) GetSavedFilterId() string {
+	if x != nil && x.SavedFilterId != nil {
+		return *x.SavedFilterId
+	}
+	return ""
+}
+
+func (x *BatchUpdateTransactionsRequest) GetTransaction() *Transaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+func (x *BatchUpdateTransactionsRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *BatchUpdateTransactionsRequest) GetValidateOnly() bool {
+	if x != nil {
+		return x.ValidateOnly
+	}
+	return false
+}
+
+// TransactionDiff lists the field changes a batch update makes to one transaction.
+type TransactionDiff struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Changed transaction.
+	// Values are of the form `txn_[a-zA-Z0-9]+`.
+	TransactionId string `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	// Changed fields.
+	Changes       []*TransactionDiff_FieldChange `protobuf:"bytes,2,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransactionDiff) Reset() {
+	*x = TransactionDiff{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionDiff) ProtoMessage() {}
+
+func (x *TransactionDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionDiff.ProtoReflect.Descriptor instead.
+func (*TransactionDiff) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *TransactionDiff) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *TransactionDiff) GetChanges() []*TransactionDiff_FieldChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+// AccountBalanceChange is the net effect of a batch operation on an account's current balance.
+type AccountBalanceChange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Affected account.
+	// Values are of the form `acc_[a-zA-Z0-9]+`.
+	AccountId string `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// Current balance before the batch, in account currency cents.
+	OldBalance int64 `protobuf:"varint,2,opt,name=old_balance,json=oldBalance,proto3" json:"old_balance,omitempty"`
+	// Current balance after the batch, in account currency cents.
+	NewBalance    int64 `protobuf:"varint,3,opt,name=new_balance,json=newBalance,proto3" json:"new_balance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccountBalanceChange) Reset() {
+	*x = AccountBalanceChange{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountBalanceChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountBalanceChange) ProtoMessage() {}
+
+func (x *AccountBalanceChange) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountBalanceChange.ProtoReflect.Descriptor instead.
+func (*AccountBalanceChange) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *AccountBalanceChange) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *AccountBalanceChange) GetOldBalance() int64 {
+	if x != nil {
+		return x.OldBalance
+	}
+	return 0
+}
+
+func (x *AccountBalanceChange) GetNewBalance() int64 {
+	if x != nil {
+		return x.NewBalance
+	}
+	return 0
+}
+
+// The response for
+// [BatchUpdateTransactions][saturn.finance.v1.Finance.BatchUpdateTransactions].
+// With `validate_only` it describes the changes that would have been made.
+type BatchUpdateTransactionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Transactions changed by the batch, with their new values.
+	Transactions []*Transaction `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	// Field changes per transaction. Selected transactions left unchanged are omitted.
+	Diffs []*TransactionDiff `protobuf:"bytes,2,rep,name=diffs,proto3" json:"diffs,omitempty"`
+	// Net balance change per affected account.
+	BalanceChanges []*AccountBalanceChange `protobuf:"bytes,3,rep,name=balance_changes,json=balanceChanges,proto3" json:"balance_changes,omitempty"`
+	// Budget periods whose spent totals change.
+	// Values are of the form `per_[a-zA-Z0-9]+`.
+	PeriodIds     []string `protobuf:"bytes,4,rep,name=period_ids,json=periodIds,proto3" json:"period_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchUpdateTransactionsResponse) Reset() {
+	*x = BatchUpdateTransactionsResponse{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchUpdateTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpdateTransactionsResponse) ProtoMessage() {}
+
+func (x *BatchUpdateTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpdateTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*BatchUpdateTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *BatchUpdateTransactionsResponse) GetTransactions() []*Transaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+func (x *BatchUpdateTransactionsResponse) GetDiffs() []*TransactionDiff {
+	if x != nil {
+		return x.Diffs
+	}
+	return nil
+}
+
+func (x *BatchUpdateTransactionsResponse) GetBalanceChanges() []*AccountBalanceChange {
+	if x != nil {
+		return x.BalanceChanges
+	}
+	return nil
+}
+
+func (x *BatchUpdateTransactionsResponse) GetPeriodIds() []string {
+	if x != nil {
+		return x.PeriodIds
+	}
+	return nil
+}
+
+// The request for
+// [BatchDeleteTransactions][saturn.finance.v1.Finance.BatchDeleteTransactions].
+// Exactly one of `ids` or `filter`/`saved_filter_id` must be set. Only expenses and income that are
+// not reconciled or linked to a borrowing can be deleted; otherwise the whole batch is rejected.
+type BatchDeleteTransactionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional. Transactions to delete, at most 500.
+	// Values are of the form `txn_[a-zA-Z0-9]+`.
+	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	// Optional. AIP-160 filter expression selecting the transactions to delete, using the fields
+	// supported by `ListTransactions`. Must match at most 500 transactions.
+	Filter *string `protobuf:"bytes,2,opt,name=filter,proto3,oneof" json:"filter,omitempty"`
+	// Optional. Saved filter whose expression selects the transactions, AND-ed with `filter`.
+	// Values are of the form `sfl_[a-zA-Z0-9]+`.
+	SavedFilterId *string `protobuf:"bytes,3,opt,name=saved_filter_id,json=savedFilterId,proto3,oneof" json:"saved_filter_id,omitempty"`
+	// Optional. Compute and return the changes without applying them.
+	ValidateOnly  bool `protobuf:"varint,4,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchDeleteTransactionsRequest) Reset() {
+	*x = BatchDeleteTransactionsRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchDeleteTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchDeleteTransactionsRequest) ProtoMessage() {}
+
+func (x *BatchDeleteTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchDeleteTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*BatchDeleteTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *BatchDeleteTransactionsRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *BatchDeleteTransactionsRequest) GetFilter() string {
+	if x != nil && x.Filter != nil {
+		return *x.Filter
+	}
+	return ""
+}
+
+func (x *BatchDeleteTransactionsRequest) GetSavedFilterId() string {
+	if x != nil && x.SavedFilterId != nil {
+		return *x.SavedFilterId
+	}
+	return ""
+}
+
+func (x *BatchDeleteTransactionsRequest) GetValidateOnly() bool {
+	if x != nil {
+		return x.ValidateOnly
+	}
+	return false
+}
+
+// The response for
+// [BatchDeleteTransactions][saturn.finance.v1.Finance.BatchDeleteTransactions].
+// With `validate_only` it describes the changes that would have been made.
+type BatchDeleteTransactionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deleted transactions.
+	Transactions []*Transaction `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	// Net balance change per affected account.
+	BalanceChanges []*AccountBalanceChange `protobuf:"bytes,2,rep,name=balance_changes,json=balanceChanges,proto3" json:"balance_changes,omitempty"`
+	// Budget periods whose spent totals change.
+	// Values are of the form `per_[a-zA-Z0-9]+`.
+	PeriodIds     []string `protobuf:"bytes,3,rep,name=period_ids,json=periodIds,proto3" json:"period_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchDeleteTransactionsResponse) Reset() {
+	*x = BatchDeleteTransactionsResponse{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchDeleteTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchDeleteTransactionsResponse) ProtoMessage() {}
+
+func (x *BatchDeleteTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchDeleteTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*BatchDeleteTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *BatchDeleteTransactionsResponse) GetTransactions() []*Transaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+func (x *BatchDeleteTransactionsResponse) GetBalanceChanges() []*AccountBalanceChange {
+	if x != nil {
+		return x.BalanceChanges
+	}
+	return nil
+}
+
+func (x *BatchDeleteTransactionsResponse) GetPeriodIds() []string {
+	if x != nil {
+		return x.PeriodIds
+	}
+	return nil
+}
+
+// SavedFilter is a named transaction filter expression shared across a space.
+type SavedFilter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Output only. Unique identifier.
+	// Values are of the form `sfl_[a-zA-Z0-9]+`.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Output only. Space identifier.
+	SpaceId string `protobuf:"bytes,2,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	// Required. User-friendly name (e.g. "Large groceries this year").
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// Optional. Free-form description.
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// Required. AIP-160 filter expression, using the fields accepted by
+	// [ListTransactionsRequest.filter][saturn.finance.v1.ListTransactionsRequest.filter].
+	Expression string `protobuf:"bytes,5,opt,name=expression,proto3" json:"expression,omitempty"`
+	// Optional. Default sort applied when listing transactions with this filter (e.g. "amount:desc").
+	Sort string `protobuf:"bytes,6,opt,name=sort,proto3" json:"sort,omitempty"`
+	// Output only. Creation timestamp.
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// Output only. Last update timestamp.
+	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SavedFilter) Reset() {
+	*x = SavedFilter{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SavedFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavedFilter) ProtoMessage() {}
+
+func (x *SavedFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavedFilter.ProtoReflect.Descriptor instead.
+func (*SavedFilter) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *SavedFilter) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SavedFilter) GetSpaceId() string {
+	if x != nil {
+		return x.SpaceId
+	}
+	return ""
+}
+
+func (x *SavedFilter) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SavedFilter) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *SavedFilter) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+func (x *SavedFilter) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *SavedFilter) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *SavedFilter) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+// The request for
+// [CreateSavedFilter][saturn.finance.v1.Finance.CreateSavedFilter].
+type CreateSavedFilterRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The saved filter to create.
+	SavedFilter   *SavedFilter `protobuf:"bytes,1,opt,name=saved_filter,json=savedFilter,proto3" json:"saved_filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSavedFilterRequest) Reset() {
+	*x = CreateSavedFilterRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSavedFilterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSavedFilterRequest) ProtoMessage() {}
+
+func (x *CreateSavedFilterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSavedFilterRequest.ProtoReflect.Descriptor instead.
+func (*CreateSavedFilterRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *CreateSavedFilterRequest) GetSavedFilter() *SavedFilter {
+	if x != nil {
+		return x.SavedFilter
+	}
+	return nil
+}
+
+// The request for
+// [GetSavedFilter][saturn.finance.v1.Finance.GetSavedFilter].
+type GetSavedFilterRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. Unique identifier of the saved filter.
+	// Values are of the form `sfl_[a-zA-Z0-9]+`.
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSavedFilterRequest) Reset() {
+	*x = GetSavedFilterRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSavedFilterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSavedFilterRequest) ProtoMessage() {}
+
+func (x *GetSavedFilterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSavedFilterRequest.ProtoReflect.Descriptor instead.
+func (*GetSavedFilterRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *GetSavedFilterRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// The request for
+// [UpdateSavedFilter][saturn.finance.v1.Finance.UpdateSavedFilter].
+type UpdateSavedFilterRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. Unique identifier of the saved filter to update.
+	// Values are of the form `sfl_[a-zA-Z0-9]+`.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Required. Updated saved filter parameters.
+	SavedFilter *SavedFilter `protobuf:"bytes,2,opt,name=saved_filter,json=savedFilter,proto3" json:"saved_filter,omitempty"`
+	// Optional. Field mask defining which fields to update for partial updates.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3,oneof" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSavedFilterRequest) Reset() {
+	*x = UpdateSavedFilterRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSavedFilterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSavedFilterRequest) ProtoMessage() {}
+
+func (x *UpdateSavedFilterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSavedFilterRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSavedFilterRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *UpdateSavedFilterRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateSavedFilterRequest) GetSavedFilter() *SavedFilter {
+	if x != nil {
+		return x.SavedFilter
+	}
+	return nil
+}
+
+func (x *UpdateSavedFilterRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+// The request for
+// [DeleteSavedFilter][saturn.finance.v1.Finance.DeleteSavedFilter].
+type DeleteSavedFilterRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. Unique identifier of the saved filter to delete.
+	// Values are of the form `sfl_[a-zA-Z0-9]+`.
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSavedFilterRequest) Reset() {
+	*x = DeleteSavedFilterRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSavedFilterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSavedFilterRequest) ProtoMessage() {}
+
+func (x *DeleteSavedFilterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSavedFilterRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSavedFilterRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *DeleteSavedFilterRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// The request for
+// [ListSavedFilters][saturn.finance.v1.Finance.ListSavedFilters].
+type ListSavedFiltersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional. Maximum number of items to return.
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Optional. Keyset page token.
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Optional. Sorting specification.
+	Sort          *string `protobuf:"bytes,3,opt,name=sort,proto3,oneof" json:"sort,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSavedFiltersRequest) Reset() {
+	*x = ListSavedFiltersRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSavedFiltersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSavedFiltersRequest) ProtoMessage() {}
+
+func (x *ListSavedFiltersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSavedFiltersRequest.ProtoReflect.Descriptor instead.
+func (*ListSavedFiltersRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ListSavedFiltersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListSavedFiltersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListSavedFiltersRequest) GetSort() string {
+	if x != nil && x.Sort != nil {
+		return *x.Sort
+	}
+	return ""
+}
+
+// The response for
+// [ListSavedFilters][saturn.finance.v1.Finance.ListSavedFilters].
+type ListSavedFiltersResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// List of saved filters in the space.
+	SavedFilters []*SavedFilter `protobuf:"bytes,1,rep,name=saved_filters,json=savedFilters,proto3" json:"saved_filters,omitempty"`
+	// Next page keyset token. Empty if no more pages are available.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSavedFiltersResponse) Reset() {
+	*x = ListSavedFiltersResponse{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSavedFiltersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSavedFiltersResponse) ProtoMessage() {}
+
+func (x *ListSavedFiltersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSavedFiltersResponse.ProtoReflect.Descriptor instead.
+func (*ListSavedFiltersResponse) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ListSavedFiltersResponse) GetSavedFilters() []*SavedFilter {
+	if x != nil {
+		return x.SavedFilters
+	}
+	return nil
+}
+
+func (x *ListSavedFiltersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Payee is a merchant or counterparty that transaction descriptions are normalized to, so that
+// "AMZN MKTP US*2K3" and "Amazon" are recognized as the same vendor.
+type Payee struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Output only. Unique identifier.
+	// Values are of the form `pye_[a-zA-Z0-9]+`.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Output only. Space identifier.
+	SpaceId string `protobuf:"bytes,2,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	// Required. Display name (e.g. "Amazon").
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// Optional. Alternative spellings matched as whole words, ignoring case and punctuation
+	// (e.g. "AMZN MKTP"). The name itself is always matched.
+	Aliases []string `protobuf:"bytes,4,rep,name=aliases,proto3" json:"aliases,omitempty"`
+	// Optional. Case-insensitive regular expressions matched against descriptions.
+	Patterns []string `protobuf:"bytes,5,rep,name=patterns,proto3" json:"patterns,omitempty"`
+	// Optional. Budget assigned to expenses from this payee that have none.
+	// Values are of the form `bud_[a-zA-Z0-9]+`.
+	DefaultBudgetId *string `protobuf:"bytes,6,opt,name=default_budget_id,json=defaultBudgetId,proto3,oneof" json:"default_budget_id,omitempty"`
+	// Optional. Account assigned to transactions from this payee that have none.
+	// Values are of the form `acc_[a-zA-Z0-9]+`.
+	DefaultAccountId *string `protobuf:"bytes,7,opt,name=default_account_id,json=defaultAccountId,proto3,oneof" json:"default_account_id,omitempty"`
+	// Optional. Absolute http(s) URL of the payee's logo.
+	LogoUrl string `protobuf:"bytes,8,opt,name=logo_url,json=logoUrl,proto3" json:"logo_url,omitempty"`
+	// Output only. Creation timestamp.
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// Output only. Last update timestamp.
+	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Payee) Reset() {
+	*x = Payee{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Payee) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Payee) ProtoMessage() {}
+
+func (x *Payee) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Payee.ProtoReflect.Descriptor instead.
+func (*Payee) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *Payee) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Payee) GetSpaceId() string {
+	if x != nil {
+		return x.SpaceId
+	}
+	return ""
+}
+
+func (x *Payee) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Payee) GetAliases() []string {
+	if x != nil {
+		return x.Aliases
+	}
+	return nil
+}
+
+func (x *Payee) GetPatterns() []string {
+	if x != nil {
+		return x.Patterns
+	}
+	return nil
+}
+
+func (x *Payee) GetDefaultBudgetId() string {
+	if x != nil && x.DefaultBudgetId != nil {
+		return *x.DefaultBudgetId
+	}
+	return ""
+}
+
+func (x *Payee) GetDefaultAccountId() string {
+	if x != nil && x.DefaultAccountId != nil {
+		return *x.DefaultAccountId
+	}
+	return ""
+}
+
+func (x *Payee) GetLogoUrl() string {
+	if x != nil {
+		return x.LogoUrl
+	}
+	return ""
+}
+
+func (x *Payee) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *Payee) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+// The request for
+// [CreatePayee][saturn.finance.v1.Finance.CreatePayee].
+type CreatePayeeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The payee to create.
+	Payee         *Payee `protobuf:"bytes,1,opt,name=payee,proto3" json:"payee,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePayeeRequest) Reset() {
+	*x = CreatePayeeRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePayeeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePayeeRequest) ProtoMessage() {}
+
+func (x *CreatePayeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePayeeRequest.ProtoReflect.Descriptor instead.
+func (*CreatePayeeRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *CreatePayeeRequest) GetPayee() *Payee {
+	if x != nil {
+		return x.Payee
+	}
+	return nil
+}
+
+// The request for
+// [GetPayee][saturn.finance.v1.Finance.GetPayee].
+type GetPayeeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. Unique identifier of the payee.
+	// Values are of the form `pye_[a-zA-Z0-9]+`.
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPayeeRequest) Reset() {
+	*x = GetPayeeRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPayeeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPayeeRequest) ProtoMessage() {}
+
+func (x *GetPayeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPayeeRequest.ProtoReflect.Descriptor instead.
+func (*GetPayeeRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *GetPayeeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// The request for
+// [UpdatePayee][saturn.finance.v1.Finance.UpdatePayee].
+type UpdatePayeeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. Unique identifier of the payee to update.
+	// Values are of the form `pye_[a-zA-Z0-9]+`.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Required. Updated payee parameters.
+	Payee *Payee `protobuf:"bytes,2,opt,name=payee,proto3" json:"payee,omitempty"`
+	// Optional. Field mask defining which fields to update for partial updates.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3,oneof" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePayeeRequest) Reset() {
+	*x = UpdatePayeeRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePayeeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePayeeRequest) ProtoMessage() {}
+
+func (x *UpdatePayeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePayeeRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePayeeRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *UpdatePayeeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdatePayeeRequest) GetPayee() *Payee {
+	if x != nil {
+		return x.Payee
+	}
+	return nil
+}
+
+func (x *UpdatePayeeRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+// The request for
+// [DeletePayee][saturn.finance.v1.Finance.DeletePayee].
+type DeletePayeeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. Unique identifier of the payee to delete.
+	// Values are of the form `pye_[a-zA-Z0-9]+`.
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePayeeRequest) Reset() {
+	*x = DeletePayeeRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePayeeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePayeeRequest) ProtoMessage() {}
+
+func (x *DeletePayeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePayeeRequest.ProtoReflect.Descriptor instead.
+func (*DeletePayeeRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *DeletePayeeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// The request for
+// [ListPayees][saturn.finance.v1.Finance.ListPayees].
+type ListPayeesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional. Maximum number of items to return.
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Optional. Keyset page token.
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Optional. Sorting specification.
+	Sort          *string `protobuf:"bytes,3,opt,name=sort,proto3,oneof" json:"sort,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPayeesRequest) Reset() {
+	*x = ListPayeesRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPayeesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPayeesRequest) ProtoMessage() {}
+
+func (x *ListPayeesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPayeesRequest.ProtoReflect.Descriptor instead.
+func (*ListPayeesRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ListPayeesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListPayeesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListPayeesRequest) GetSort() string {
+	if x != nil && x.Sort != nil {
+		return *x.Sort
+	}
+	return ""
+}
+
+// The response for
+// [ListPayees][saturn.finance.v1.Finance.ListPayees].
+type ListPayeesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// List of payees in the space.
+	Payees []*Payee `protobuf:"bytes,1,rep,name=payees,proto3" json:"payees,omitempty"`
+	// Next page keyset token. Empty if no more pages are available.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPayeesResponse) Reset() {
+	*x = ListPayeesResponse{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPayeesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPayeesResponse) ProtoMessage() {}
+
+func (x *ListPayeesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPayeesResponse.ProtoReflect.Descriptor instead.
+func (*ListPayeesResponse) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *ListPayeesResponse) GetPayees() []*Payee {
+	if x != nil {
+		return x.Payees
+	}
+	return nil
+}
+
+func (x *ListPayeesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// TransactionRule assigns a budget, payee, category, or tags to transactions matching all of its
+// conditions. Rules run by ascending priority; for each action the first matching rule wins.
+type TransactionRule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Output only. Unique identifier.
+	// Values are of the form `trr_[a-zA-Z0-9]+`.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Output only. Space identifier.
+	SpaceId string `protobuf:"bytes,2,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	// Required. User-friendly name (e.g. "Amazon purchases on the Visa card").
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// Optional. Evaluation order; lower runs first.
+	Priority int32 `protobuf:"varint,4,opt,name=priority,proto3" json:"priority,omitempty"`
+	// Optional. Inactive rules are ignored. New rules are active.
+	IsActive bool `protobuf:"varint,5,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	// Optional. Case-insensitive regular expression the description must match.
+	DescriptionPattern string `protobuf:"bytes,6,opt,name=description_pattern,json=descriptionPattern,proto3" json:"description_pattern,omitempty"`
+	// Optional. Account the transaction must be booked on.
+	// Values are of the form `acc_[a-zA-Z0-9]+`.
+	AccountId *string `protobuf:"bytes,7,opt,name=account_id,json=accountId,proto3,oneof" json:"account_id,omitempty"`
+	// Optional. Inclusive minimum amount in local currency cents.
+	MinAmount *int64 `protobuf:"varint,8,opt,name=min_amount,json=minAmount,proto3,oneof" json:"min_amount,omitempty"`
+	// Optional. Inclusive maximum amount in local currency cents.
+	MaxAmount *int64 `protobuf:"varint,9,opt,name=max_amount,json=maxAmount,proto3,oneof" json:"max_amount,omitempty"`
+	// Optional. Budget assigned to matching expenses that are not split.
+	// Values are of the form `bud_[a-zA-Z0-9]+`.
+	SetBudgetId *string `protobuf:"bytes,10,opt,name=set_budget_id,json=setBudgetId,proto3,oneof" json:"set_budget_id,omitempty"`
+	// Optional. Payee assigned to matching transactions.
+	// Values are of the form `pye_[a-zA-Z0-9]+`.
+	SetPayeeId *string `protobuf:"bytes,11,opt,name=set_payee_id,json=setPayeeId,proto3,oneof" json:"set_payee_id,omitempty"`
+	// Optional. Category assigned to matching transactions.
+	// Values are of the form `cat_[a-zA-Z0-9]+`.
+	SetCategoryId *string `protobuf:"bytes,12,opt,name=set_category_id,json=setCategoryId,proto3,oneof" json:"set_category_id,omitempty"`
+	// Optional. Tags added to matching transactions.
+	AddTags []string `protobuf:"bytes,13,rep,name=add_tags,json=addTags,proto3" json:"add_tags,omitempty"`
+	// Output only. Creation timestamp.
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// Output only. Last update timestamp.
+	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransactionRule) Reset() {
+	*x = TransactionRule{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionRule) ProtoMessage() {}
+
+func (x *TransactionRule) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionRule.ProtoReflect.Descriptor instead.
+func (*TransactionRule) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *TransactionRule) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TransactionRule) GetSpaceId() string {
+	if x != nil {
+		return x.SpaceId
+	}
+	return ""
+}
+
+func (x *TransactionRule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TransactionRule) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *TransactionRule) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *TransactionRule) GetDescriptionPattern() string {
+	if x != nil {
+		return x.DescriptionPattern
+	}
+	return ""
+}
+
+func (x *TransactionRule) GetAccountId() string {
+	if x != nil && x.AccountId != nil {
+		return *x.AccountId
+	}
+	return ""
+}
+
+func (x *TransactionRule) GetMinAmount() int64 {
+	if x != nil && x.MinAmount != nil {
+		return *x.MinAmount
+	}
+	return 0
+}
+
+func (x *TransactionRule) GetMaxAmount() int64 {
+	if x != nil && x.MaxAmount != nil {
+		return *x.MaxAmount
+	}
+	return 0
+}
+
+func (x *TransactionRule) GetSetBudgetId() string {
+	if x != nil && x.SetBudgetId != nil {
+		return *x.SetBudgetId
+	}
+	return ""
+}
+
+func (x *TransactionRule) GetSetPayeeId() string {
+	if x != nil && x.SetPayeeId != nil {
+		return *x.SetPayeeId
+	}
+	return ""
+}
+
+func (x *TransactionRule) GetSetCategoryId() string {
+	if x != nil && x.SetCategoryId != nil {
+		return *x.SetCategoryId
+	}
+	return ""
+}
+
+func (x *TransactionRule) GetAddTags() []string {
+	if x != nil {
+		return x.AddTags
+	}
+	return nil
+}
+
+func (x *TransactionRule) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *TransactionRule) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+// The request for
+// [CreateTransactionRule][saturn.finance.v1.Finance.CreateTransactionRule].
+type CreateTransactionRuleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The rule to create.
+	TransactionRule *TransactionRule `protobuf:"bytes,1,opt,name=transaction_rule,json=transactionRule,proto3" json:"transaction_rule,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateTransactionRuleRequest) Reset() {
+	*x = CreateTransactionRuleRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTransactionRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTransactionRuleRequest) ProtoMessage() {}
+
+func (x *CreateTransactionRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTransactionRuleRequest.ProtoReflect.Descriptor instead.
+func (*CreateTransactionRuleRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *CreateTransactionRuleRequest) GetTransactionRule() *TransactionRule {
+	if x != nil {
+		return x.TransactionRule
+	}
+	return nil
+}
+
+// The request for
+// [GetTransactionRule][saturn.finance.v1.Finance.GetTransactionRule].
+type GetTransactionRuleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. Unique identifier of the rule.
+	// Values are of the form `trr_[a-zA-Z0-9]+`.
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransactionRuleRequest) Reset() {
+	*x = GetTransactionRuleRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionRuleRequest) ProtoMessage() {}
+
+func (x *GetTransactionRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionRuleRequest.ProtoReflect.Descriptor instead.
+func (*GetTransactionRuleRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *GetTransactionRuleRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// The request for
+// [UpdateTransactionRule][saturn.finance.v1.Finance.UpdateTransactionRule].
+type UpdateTransactionRuleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. Unique identifier of the rule to update.
+	// Values are of the form `trr_[a-zA-Z0-9]+`.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Required. Updated rule parameters.
+	TransactionRule *TransactionRule `protobuf:"bytes,2,opt,name=transaction_rule,json=transactionRule,proto3" json:"transaction_rule,omitempty"`
+	// Optional. Field mask defining which fields to update for partial updates.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3,oneof" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTransactionRuleRequest) Reset() {
+	*x = UpdateTransactionRuleRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTransactionRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTransactionRuleRequest) ProtoMessage() {}
+
+func (x *UpdateTransactionRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTransactionRuleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTransactionRuleRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *UpdateTransactionRuleRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateTransactionRuleRequest) GetTransactionRule() *TransactionRule {
+	if x != nil {
+		return x.TransactionRule
+	}
+	return nil
+}
+
+func (x *UpdateTransactionRuleRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+// The request for
+// [DeleteTransactionRule][saturn.finance.v1.Finance.DeleteTransactionRule].
+type DeleteTransactionRuleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. Unique identifier of the rule to delete.
+	// Values are of the form `trr_[a-zA-Z0-9]+`.
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTransactionRuleRequest) Reset() {
+	*x = DeleteTransactionRuleRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTransactionRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTransactionRuleRequest) ProtoMessage() {}
+
+func (x *DeleteTransactionRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTransactionRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTransactionRuleRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *DeleteTransactionRuleRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// The request for
+// [ListTransactionRules][saturn.finance.v1.Finance.ListTransactionRules].
+type ListTransactionRulesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional. Maximum number of items to return.
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Optional. Keyset page token.
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Optional. Sorting specification.
+	Sort *string `protobuf:"bytes,3,opt,name=sort,proto3,oneof" json:"sort,omitempty"`
+	// Optional. Only return active rules.
+	ActiveOnly    *bool `protobuf:"varint,4,opt,name=active_only,json=activeOnly,proto3,oneof" json:"active_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTransactionRulesRequest) Reset() {
+	*x = ListTransactionRulesRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTransactionRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTransactionRulesRequest) ProtoMessage() {}
+
+func (x *ListTransactionRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTransactionRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListTransactionRulesRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *ListTransactionRulesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListTransactionRulesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListTransactionRulesRequest) GetSort() string {
+	if x != nil && x.Sort != nil {
+		return *x.Sort
+	}
+	return ""
+}
+
+func (x *ListTransactionRulesRequest) GetActiveOnly() bool {
+	if x != nil && x.ActiveOnly != nil {
+		return *x.ActiveOnly
+	}
+	return false
+}
+
+// The response for
+// [ListTransactionRules][saturn.finance.v1.Finance.ListTransactionRules].
+type ListTransactionRulesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// List of transaction rules in the space.
+	TransactionRules []*TransactionRule `protobuf:"bytes,1,rep,name=transaction_rules,json=transactionRules,proto3" json:"transaction_rules,omitempty"`
+	// Next page keyset token. Empty if no more pages are available.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTransactionRulesResponse) Reset() {
+	*x = ListTransactionRulesResponse{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTransactionRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTransactionRulesResponse) ProtoMessage() {}
+
+func (x *ListTransactionRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTransactionRulesResponse.ProtoReflect.Descriptor instead.
+func (*ListTransactionRulesResponse) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *ListTransactionRulesResponse) GetTransactionRules() []*TransactionRule {
+	if x != nil {
+		return x.TransactionRules
+	}
+	return nil
+}
+
+func (x *ListTransactionRulesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// The request for
+// [ApplyTransactionRules][saturn.finance.v1.Finance.ApplyTransactionRules].
+type ApplyTransactionRulesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional. Only transactions on or after this date are evaluated.
+	StartDate *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	// Optional. Only transactions on or before this date are evaluated.
+	EndDate *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	// Optional. Replace payees, budgets, and categories that are already set. By default only
+	// empty fields are filled. Accounts are never replaced.
+	Overwrite     bool `protobuf:"varint,3,opt,name=overwrite,proto3" json:"overwrite,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyTransactionRulesRequest) Reset() {
+	*x = ApplyTransactionRulesRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyTransactionRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyTransactionRulesRequest) ProtoMessage() {}
+
+func (x *ApplyTransactionRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyTransactionRulesRequest.ProtoReflect.Descriptor instead.
+func (*ApplyTransactionRulesRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *ApplyTransactionRulesRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *ApplyTransactionRulesRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *ApplyTransactionRulesRequest) GetOverwrite() bool {
+	if x != nil {
+		return x.Overwrite
+	}
+	return false
+}
+
+// The response for
+// [ApplyTransactionRules][saturn.finance.v1.Finance.ApplyTransactionRules].
+type ApplyTransactionRulesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Number of expenses and income evaluated.
+	ScannedCount int32 `protobuf:"varint,1,opt,name=scanned_count,json=scannedCount,proto3" json:"scanned_count,omitempty"`
+	// Number of transactions changed by a rule or payee.
+	UpdatedCount int32 `protobuf:"varint,2,opt,name=updated_count,json=updatedCount,proto3" json:"updated_count,omitempty"`
+	// Number of transactions skipped because a finalized reconciliation locks them.
+	SkippedCount  int32 `protobuf:"varint,3,opt,name=skipped_count,json=skippedCount,proto3" json:"skipped_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyTransactionRulesResponse) Reset() {
+	*x = ApplyTransactionRulesResponse{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyTransactionRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyTransactionRulesResponse) ProtoMessage() {}
+
+func (x *ApplyTransactionRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyTransactionRulesResponse.ProtoReflect.Descriptor instead.
+func (*ApplyTransactionRulesResponse) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *ApplyTransactionRulesResponse) GetScannedCount() int32 {
+	if x != nil {
+		return x.ScannedCount
+	}
+	return 0
+}
+
+func (x *ApplyTransactionRulesResponse) GetUpdatedCount() int32 {
+	if x != nil {
+		return x.UpdatedCount
+	}
+	return 0
+}
+
+func (x *ApplyTransactionRulesResponse) GetSkippedCount() int32 {
+	if x != nil {
+		return x.SkippedCount
+	}
+	return 0
+}
+
+// The request for
+// [GetInsights][saturn.finance.v1.Finance.GetInsights].
+type GetInsightsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. Statistical grouping granularity.
+	Granularity InsightGranularity `protobuf:"varint,1,opt,name=granularity,proto3,enum=saturn.finance.v1.InsightGranularity" json:"granularity,omitempty"`
+	// Required. Target start date window.
+	StartDate *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	// Required. Target end date window.
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInsightsRequest) Reset() {
+	*x = GetInsightsRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInsightsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInsightsRequest) ProtoMessage() {}
+
+func (x *GetInsightsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInsightsRequest.ProtoReflect.Descriptor instead.
+func (*GetInsightsRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *GetInsightsRequest) GetGranularity() InsightGranularity {
+	if x != nil {
+		return x.Granularity
+	}
+	return InsightGranularity_INSIGHT_GRANULARITY_UNSPECIFIED
+}
+
+func (x *GetInsightsRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *GetInsightsRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+// The response for
+// [GetInsights][saturn.finance.v1.Finance.GetInsights].
+type GetInsightsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Spent insights statistics.
+	Spent *SpentInsights `protobuf:"bytes,1,opt,name=spent,proto3" json:"spent,omitempty"`
+	// Net cash flow statistics comparing income against expenses.
+	CashFlow *CashFlowInsights `protobuf:"bytes,2,opt,name=cash_flow,json=cashFlow,proto3" json:"cash_flow,omitempty"`
+	// Progress of every active savings goal.
+	Goals         []*GoalProgress `protobuf:"bytes,3,rep,name=goals,proto3" json:"goals,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInsightsResponse) Reset() {
+	*x = GetInsightsResponse{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInsightsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInsightsResponse) ProtoMessage() {}
+
+func (x *GetInsightsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInsightsResponse.ProtoReflect.Descriptor instead.
+func (*GetInsightsResponse) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *GetInsightsResponse) GetSpent() *SpentInsights {
+	if x != nil {
+		return x.Spent
+	}
+	return nil
+}
+
+func (x *GetInsightsResponse) GetCashFlow() *CashFlowInsights {
+	if x != nil {
+		return x.CashFlow
+	}
+	return nil
+}
+
+func (x *GetInsightsResponse) GetGoals() []*GoalProgress {
+	if x != nil {
+		return x.Goals
+	}
+	return nil
+}
+
+// CashFlowInsights aggregates inflows against outflows in the base currency.
+type CashFlowInsights struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Total income in base currency cents.
+	TotalIncome int64 `protobuf:"varint,1,opt,name=total_income,json=totalIncome,proto3" json:"total_income,omitempty"`
+	// Total expenses in base currency cents.
+	TotalExpense int64 `protobuf:"varint,2,opt,name=total_expense,json=totalExpense,proto3" json:"total_expense,omitempty"`
+	// Net cash flow (income minus expenses) in base currency cents.
+	NetCashFlow int64 `protobuf:"varint,3,opt,name=net_cash_flow,json=netCashFlow,proto3" json:"net_cash_flow,omitempty"`
+	// Share of income retained after expenses, as a percentage.
+	SavingsRate float64 `protobuf:"fixed64,4,opt,name=savings_rate,json=savingsRate,proto3" json:"savings_rate,omitempty"`
+	// List of granular interval cash flow points.
+	Trend []*CashFlowInsights_CashFlowDataPoint `protobuf:"bytes,5,rep,name=trend,proto3" json:"trend,omitempty"`
+	// Expenses charged to credit cards in base currency cents. Included in `total_expense`.
+	CardSpending int64 `protobuf:"varint,6,opt,name=card_spending,json=cardSpending,proto3" json:"card_spending,omitempty"`
+	// Credit card payments in base currency cents. Excluded from `total_expense` so card
+	// purchases are not counted twice.
+	CardPayments  int64 `protobuf:"varint,7,opt,name=card_payments,json=cardPayments,proto3" json:"card_payments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CashFlowInsights) Reset() {
+	*x = CashFlowInsights{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CashFlowInsights) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CashFlowInsights) ProtoMessage() {}
+
+func (x *CashFlowInsights) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CashFlowInsights.ProtoReflect.Descriptor instead.
+func (*CashFlowInsights) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *CashFlowInsights) GetTotalIncome() int64 {
+	if x != nil {
+		return x.TotalIncome
+	}
+	return 0
+}
+
+func (x *CashFlowInsights) GetTotalExpense() int64 {
+	if x != nil {
+		return x.TotalExpense
+	}
+	return 0
+}
+
+func (x *CashFlowInsights) GetNetCashFlow() int64 {
+	if x != nil {
+		return x.NetCashFlow
+	}
+	return 0
+}
+
+func (x *CashFlowInsights) GetSavingsRate() float64 {
+	if x != nil {
+		return x.SavingsRate
+	}
+	return 0
+}
+
+func (x *CashFlowInsights) GetTrend() []*CashFlowInsights_CashFlowDataPoint {
+	if x != nil {
+		return x.Trend
+	}
+	return nil
+}
+
+func (x *CashFlowInsights) GetCardSpending() int64 {
+	if x != nil {
+		return x.CardSpending
+	}
+	return 0
+}
+
+func (x *CashFlowInsights) GetCardPayments() int64 {
+	if x != nil {
+		return x.CardPayments
+	}
+	return 0
+}
+
+// SpentInsights aggregates workspace statistics.
+type SpentInsights struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Total configured budget limits in base currency cents.
+	TotalLimit int64 `protobuf:"varint,1,opt,name=total_limit,json=totalLimit,proto3" json:"total_limit,omitempty"`
+	// Total spent in base currency cents.
+	TotalSpent int64 `protobuf:"varint,2,opt,name=total_spent,json=totalSpent,proto3" json:"total_spent,omitempty"`
+	// Remaining space budget in base currency cents.
+	RemainingBudget int64 `protobuf:"varint,3,opt,name=remaining_budget,json=remainingBudget,proto3" json:"remaining_budget,omitempty"`
+	// Average spend speed rate relative to elapsed time.
+	BurnRate float64 `protobuf:"fixed64,4,opt,name=burn_rate,json=burnRate,proto3" json:"burn_rate,omitempty"`
+	// List of granular interval trend points.
+	Trend []*SpentInsights_TrendDataPoint `protobuf:"bytes,5,rep,name=trend,proto3" json:"trend,omitempty"`
+	// Distribution breakdown by budget category.
+	Distributions []*SpentInsights_BudgetUsage `protobuf:"bytes,6,rep,name=distributions,proto3" json:"distributions,omitempty"`
+	// List of largest expense transactions.
+	TopExpenses []*SpentInsights_HighValueExpense `protobuf:"bytes,7,rep,name=top_expenses,json=topExpenses,proto3" json:"top_expenses,omitempty"`
+	// Distribution breakdown by transaction category.
+	Categories []*SpentInsights_CategoryUsage `protobuf:"bytes,8,rep,name=categories,proto3" json:"categories,omitempty"`
+	// Distribution breakdown by tag.
+	Tags          []*SpentInsights_TagUsage `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpentInsights) Reset() {
+	*x = SpentInsights{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpentInsights) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpentInsights) ProtoMessage() {}
+
+func (x *SpentInsights) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpentInsights.ProtoReflect.Descriptor instead.
+func (*SpentInsights) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *SpentInsights) GetTotalLimit() int64 {
+	if x != nil {
+		return x.TotalLimit
+	}
+	return 0
+}
+
+func (x *SpentInsights) GetTotalSpent() int64 {
+	if x != nil {
+		return x.TotalSpent
+	}
+	return 0
+}
+
+func (x *SpentInsights) GetRemainingBudget() int64 {
+	if x != nil {
+		return x.RemainingBudget
+	}
+	return 0
+}
+
+func (x *SpentInsights) GetBurnRate() float64 {
+	if x != nil {
+		return x.BurnRate
+	}
+	return 0
+}
+
+func (x *SpentInsights) GetTrend() []*SpentInsights_TrendDataPoint {
+	if x != nil {
+		return x.Trend
+	}
+	return nil
+}
+
+func (x *SpentInsights) GetDistributions() []*SpentInsights_BudgetUsage {
+	if x != nil {
+		return x.Distributions
+	}
+	return nil
+}
+
+func (x *SpentInsights) GetTopExpenses() []*SpentInsights_HighValueExpense {
+	if x != nil {
+		return x.TopExpenses
+	}
+	return nil
+}
+
+func (x *SpentInsights) GetCategories() []*SpentInsights_CategoryUsage {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *SpentInsights) GetTags() []*SpentInsights_TagUsage {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+// GenerateScheduledPaymentsPayload defines the cron scheduling payload.
+type GenerateScheduledPaymentsPayload struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateScheduledPaymentsPayload) Reset() {
+	*x = GenerateScheduledPaymentsPayload{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateScheduledPaymentsPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateScheduledPaymentsPayload) ProtoMessage() {}
+
+func (x *GenerateScheduledPaymentsPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateScheduledPaymentsPayload.ProtoReflect.Descriptor instead.
+func (*GenerateScheduledPaymentsPayload) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{88}
+}
+
+// SyncExchangeRatesPayload defines the cron payload for fetching reference exchange rates.
+type SyncExchangeRatesPayload struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncExchangeRatesPayload) Reset() {
+	*x = SyncExchangeRatesPayload{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncExchangeRatesPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncExchangeRatesPayload) ProtoMessage() {}
+
+func (x *SyncExchangeRatesPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncExchangeRatesPayload.ProtoReflect.Descriptor instead.
+func (*SyncExchangeRatesPayload) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{89}
+}
+
+// RecurringExpense represents a template rule to repeat payments.
+type RecurringExpense struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Output only. Unique identifier.
+	// Values are of the form `rec_[a-zA-Z0-9]+`.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Output only. Space identifier.
+	SpaceId string `protobuf:"bytes,2,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	// Required. Budget category identifier.
+	// Values are of the form `bud_[a-zA-Z0-9]+`.
+	BudgetId string `protobuf:"bytes,3,opt,name=budget_id,json=budgetId,proto3" json:"budget_id,omitempty"`
+	// Required. User-friendly name.
+	Name string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	// Required. Base payment amount in cents.
+	Amount int64 `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Required. Currency code.
+	Currency string `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`
+	// Optional. Execution interval rule. Required unless a recurrence rule is
+	// given, in which case it is derived from the rule.
+	Interval RecurringExpense_Interval `protobuf:"varint,7,opt,name=interval,proto3,enum=saturn.finance.v1.RecurringExpense_Interval" json:"interval,omitempty"`
+	// Required. Scheduler engine runtime state.
+	ExecutionState *RecurringExpense_ExecutionState `protobuf:"bytes,8,opt,name=execution_state,json=executionState,proto3" json:"execution_state,omitempty"`
+	// Optional. Indicates if the payment amount is variable.
+	IsVariable bool `protobuf:"varint,9,opt,name=is_variable,json=isVariable,proto3" json:"is_variable,omitempty"`
+	// Required. Active template status.
+	Status RecurringExpense_Status `protobuf:"varint,10,opt,name=status,proto3,enum=saturn.finance.v1.RecurringExpense_Status" json:"status,omitempty"`
+	// Optional. Days allowed past due date before triggering warning events.
+	GracePeriodDays int32 `protobuf:"varint,11,opt,name=grace_period_days,json=gracePeriodDays,proto3" json:"grace_period_days,omitempty"`
+	// Output only. Creation timestamp.
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// Output only. Last update timestamp.
+	UpdateTime *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	// Output only. Hydrated minimal budget details. Available only on FULL view.
+	Budget *RecurringExpense_BudgetInfo `protobuf:"bytes,14,opt,name=budget,proto3,oneof" json:"budget,omitempty"`
+	// Optional. RFC 5545 recurrence rule (e.g. `FREQ=WEEKLY;INTERVAL=2;BYDAY=FR`
+	// or `FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1`). Takes precedence
+	// over the interval when set.
+	RecurrenceRule string `protobuf:"bytes,15,opt,name=recurrence_rule,json=recurrenceRule,proto3" json:"recurrence_rule,omitempty"`
+	// Optional. First date of the recurrence. Defaults to the next due date.
+	StartDate *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=start_date,json=startDate,proto3,oneof" json:"start_date,omitempty"`
+	// Optional. Dates on which no scheduled payment is generated.
+	ExceptionDates []*timestamppb.Timestamp `protobuf:"bytes,17,rep,name=exception_dates,json=exceptionDates,proto3" json:"exception_dates,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RecurringExpense) Reset() {
+	*x = RecurringExpense{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecurringExpense) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecurringExpense) ProtoMessage() {}
+
+func (x *RecurringExpense) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecurringExpense.ProtoReflect.Descriptor instead.
+func (*RecurringExpense) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *RecurringExpense) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RecurringExpense) GetSpaceId() string {
+	if x != nil {
+		return x.SpaceId
+	}
+	return ""
+}
+
+func (x *RecurringExpense) GetBudgetId() string {
+	if x != nil {
+		return x.BudgetId
+	}
+	return ""
+}
+
+func (x *RecurringExpense) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RecurringExpense) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *RecurringExpense) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *RecurringExpense) GetInterval() RecurringExpense_Interval {
+	if x != nil {
+		return x.Interval
+	}
+	return RecurringExpense_INTERVAL_UNSPECIFIED
+}
+
+func (x *RecurringExpense) GetExecutionState() *RecurringExpense_ExecutionState {
+	if x != nil {
+		return x.ExecutionState
+	}
+	return nil
+}
+
+func (x *RecurringExpense) GetIsVariable() bool {
+	if x != nil {
+		return x.IsVariable
+	}
+	return false
+}
+
+func (x *RecurringExpense) GetStatus() RecurringExpense_Status {
+	if x != nil {
+		return x.Status
+	}
+	return RecurringExpense_STATUS_UNSPECIFIED
+}
+
+func (x *RecurringExpense) GetGracePeriodDays() int32 {
+	if x != nil {
+		return x.GracePeriodDays
+	}
+	return 0
+}
+
+func (x *RecurringExpense) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *RecurringExpense) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+func (x *RecurringExpense) GetBudget() *RecurringExpense_BudgetInfo {
+	if x != nil {
+		return x.Budget
+	}
+	return nil
+}
+
+func (x *RecurringExpense) GetRecurrenceRule() string {
+	if x != nil {
+		return x.RecurrenceRule
+	}
+	return ""
+}
+
+func (x *RecurringExpense) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *RecurringExpense) GetExceptionDates() []*timestamppb.Timestamp {
+	if x != nil {
+		return x.ExceptionDates
+	}
+	return nil
+}
+
+// ScheduledPayment represents a spawned pending payment instance.
+type ScheduledPayment struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Output only. Unique identifier.
+	// Values are of the form `sch_[a-zA-Z0-9]+`.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Output only. Space identifier.
+	SpaceId string `protobuf:"bytes,2,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	// Required. Budget category identifier.
+	// Values are of the form `bud_[a-zA-Z0-9]+`.
+	BudgetId string `protobuf:"bytes,3,opt,name=budget_id,json=budgetId,proto3" json:"budget_id,omitempty"`
+	// Required. Parent template source type.
+	SourceType ScheduledPayment_SourceType `protobuf:"varint,4,opt,name=source_type,json=sourceType,proto3,enum=saturn.finance.v1.ScheduledPayment_SourceType" json:"source_type,omitempty"`
+	// Required. Parent template source ID.
+	SourceId string `protobuf:"bytes,5,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	// Required. Target payment amount in cents.
+	Amount int64 `protobuf:"varint,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Required. Currency code.
+	Currency string `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"`
+	// Required. Scheduled execution due date.
+	DueDate *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	// Required. Instance execution status.
+	Status ScheduledPayment_Status `protobuf:"varint,9,opt,name=status,proto3,enum=saturn.finance.v1.ScheduledPayment_Status" json:"status,omitempty"`
+	// Optional. Metadata binary payload.
+	Metadata []byte `protobuf:"bytes,10,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// Output only. Creation timestamp.
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// Output only. Last update timestamp.
+	UpdateTime *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	// Output only. Hydrated minimal budget details. Available only on FULL view.
+	Budget *ScheduledPayment_BudgetInfo `protobuf:"bytes,13,opt,name=budget,proto3,oneof" json:"budget,omitempty"`
+	// Output only. Hydrated parent template details. Available only on FULL view.
+	RecurringExpense *ScheduledPayment_RecurringExpenseInfo `protobuf:"bytes,14,opt,name=recurring_expense,json=recurringExpense,proto3,oneof" json:"recurring_expense,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ScheduledPayment) Reset() {
+	*x = ScheduledPayment{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduledPayment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledPayment) ProtoMessage() {}
+
+func (x *ScheduledPayment) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduledPayment.ProtoReflect.Descriptor instead.
+func (*ScheduledPayment) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *ScheduledPayment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ScheduledPayment) GetSpaceId() string {
+	if x != nil {
+		return x.SpaceId
+	}
+	return ""
+}
+
+func (x *ScheduledPayment) GetBudgetId() string {
+	if x != nil {
+		return x.BudgetId
+	}
+	return ""
+}
+
+func (x *ScheduledPayment) GetSourceType() ScheduledPayment_SourceType {
+	if x != nil {
+		return x.SourceType
+	}
+	return ScheduledPayment_SOURCE_TYPE_UNSPECIFIED
+}
+
+func (x *ScheduledPayment) GetSourceId() string {
+	if x != nil {
+		return x.SourceId
+	}
+	return ""
+}
+
+func (x *ScheduledPayment) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *ScheduledPayment) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *ScheduledPayment) GetDueDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueDate
+	}
+	return nil
+}
+
+func (x *ScheduledPayment) GetStatus() ScheduledPayment_Status {
+	if x != nil {
+		return x.Status
+	}
+	return ScheduledPayment_STATUS_UNSPECIFIED
+}
+
+func (x *ScheduledPayment) GetMetadata() []byte {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *ScheduledPayment) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *ScheduledPayment) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+func (x *ScheduledPayment) GetBudget() *ScheduledPayment_BudgetInfo {
+	if x != nil {
+		return x.Budget
+	}
+	return nil
+}
+
+func (x *ScheduledPayment) GetRecurringExpense() *ScheduledPayment_RecurringExpenseInfo {
+	if x != nil {
+		return x.RecurringExpense
+	}
+	return nil
+}
+
+// The request for
+// [CreateRecurringExpense][saturn.finance.v1.Finance.CreateRecurringExpense].
+type CreateRecurringExpenseRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The recurring expense template to create.
+	RecurringExpense *RecurringExpense `protobuf:"bytes,1,opt,name=recurring_expense,json=recurringExpense,proto3" json:"recurring_expense,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CreateRecurringExpenseRequest) Reset() {
+	*x = CreateRecurringExpenseRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRecurringExpenseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRecurringExpenseRequest) ProtoMessage() {}
+
+func (x *CreateRecurringExpenseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRecurringExpenseRequest.ProtoReflect.Descriptor instead.
+func (*CreateRecurringExpenseRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *CreateRecurringExpenseRequest) GetRecurringExpense() *RecurringExpense {
+	if x != nil {
+		return x.RecurringExpense
+	}
+	return nil
+}
+
+// The request for
+// [UpdateRecurringExpense][saturn.finance.v1.Finance.UpdateRecurringExpense].
+type UpdateRecurringExpenseRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. Unique identifier of the template to update.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Required. The recurring expense template to update.
+	RecurringExpense *RecurringExpense `protobuf:"bytes,2,opt,name=recurring_expense,json=recurringExpense,proto3" json:"recurring_expense,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UpdateRecurringExpenseRequest) Reset() {
+	*x = UpdateRecurringExpenseRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRecurringExpenseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRecurringExpenseRequest) ProtoMessage() {}
+
+func (x *UpdateRecurringExpenseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRecurringExpenseRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRecurringExpenseRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *UpdateRecurringExpenseRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateRecurringExpenseRequest) GetRecurringExpense() *RecurringExpense {
+	if x != nil {
+		return x.RecurringExpense
+	}
+	return nil
+}
+
+// The request for
+// [DeleteRecurringExpense][saturn.finance.v1.Finance.DeleteRecurringExpense].
+type DeleteRecurringExpenseRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. Unique identifier of the template to delete.
+	// Values are of the form `rec_[a-zA-Z0-9]+`.
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRecurringExpenseRequest) Reset() {
+	*x = DeleteRecurringExpenseRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRecurringExpenseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRecurringExpenseRequest) ProtoMessage() {}
+
+func (x *DeleteRecurringExpenseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRecurringExpenseRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRecurringExpenseRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *DeleteRecurringExpenseRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// The request for
+// [ListRecurringExpenses][saturn.finance.v1.Finance.ListRecurringExpenses].
+type ListRecurringExpensesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional. Filter templates by active status.
+	Status RecurringExpense_Status `protobuf:"varint,1,opt,name=status,proto3,enum=saturn.finance.v1.RecurringExpense_Status" json:"status,omitempty"`
+	// Optional. Maximum number of items to return.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Optional. Keyset page token.
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Optional. Representation view level.
+	View *RecurringExpense_View `protobuf:"varint,4,opt,name=view,proto3,enum=saturn.finance.v1.RecurringExpense_View,oneof" json:"view,omitempty"`
+	// Optional. Search string querying the name of the template.
+	SearchQuery *string `protobuf:"bytes,5,opt,name=search_query,json=searchQuery,proto3,oneof" json:"search_query,omitempty"`
+	// Optional. Sorting specification.
+	Sort          *string `protobuf:"bytes,6,opt,name=sort,proto3,oneof" json:"sort,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRecurringExpensesRequest) Reset() {
+	*x = ListRecurringExpensesRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRecurringExpensesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRecurringExpensesRequest) ProtoMessage() {}
+
+func (x *ListRecurringExpensesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRecurringExpensesRequest.ProtoReflect.Descriptor instead.
+func (*ListRecurringExpensesRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *ListRecurringExpensesRequest) GetStatus() RecurringExpense_Status {
+	if x != nil {
+		return x.Status
+	}
+	return RecurringExpense_STATUS_UNSPECIFIED
+}
+
+func (x *ListRecurringExpensesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListRecurringExpensesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListRecurringExpensesRequest) GetView() RecurringExpense_View {
+	if x != nil && x.View != nil {
+		return *x.View
+	}
+	return RecurringExpense_VIEW_UNSPECIFIED
+}
+
+func (x *ListRecurringExpensesRequest) GetSearchQuery() string {
+	if x != nil && x.SearchQuery != nil {
+		return *x.SearchQuery
+	}
+	return ""
+}
+
+func (x *ListRecurringExpensesRequest) GetSort() string {
+	if x != nil && x.Sort != nil {
+		return *x.Sort
+	}
+	return ""
+}
+
+// The response for
+// [ListRecurringExpenses][saturn.finance.v1.Finance.ListRecurringExpenses].
+type ListRecurringExpensesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// List of recurring expense templates matching filters.
+	RecurringExpenses []*RecurringExpense `protobuf:"bytes,1,rep,name=recurring_expenses,json=recurringExpenses,proto3" json:"recurring_expenses,omitempty"`
+	// Next page keyset token. Empty if no more pages are available.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRecurringExpensesResponse) Reset() {
+	*x = ListRecurringExpensesResponse{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRecurringExpensesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRecurringExpensesResponse) ProtoMessage() {}
+
+func (x *ListRecurringExpensesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRecurringExpensesResponse.ProtoReflect.Descriptor instead.
+func (*ListRecurringExpensesResponse) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *ListRecurringExpensesResponse) GetRecurringExpenses() []*RecurringExpense {
+	if x != nil {
+		return x.RecurringExpenses
+	}
+	return nil
+}
+
+func (x *ListRecurringExpensesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// SubscriptionSuggestion is a periodic charge found in the transaction history.
+type SubscriptionSuggestion struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Output only. Unsaved recurring expense template prefilled from the charges. The budget is empty
+	// when none of the charges were budgeted.
+	RecurringExpense *RecurringExpense `protobuf:"bytes,1,opt,name=recurring_expense,json=recurringExpense,proto3" json:"recurring_expense,omitempty"`
+	// Output only. Confidence between 0 and 1 that the charges are a subscription.
+	Confidence float64 `protobuf:"fixed64,2,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	// Output only. Supporting transactions, oldest first.
+	TransactionIds []string `protobuf:"bytes,3,rep,name=transaction_ids,json=transactionIds,proto3" json:"transaction_ids,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SubscriptionSuggestion) Reset() {
+	*x = SubscriptionSuggestion{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscriptionSuggestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscriptionSuggestion) ProtoMessage() {}
+
+func (x *SubscriptionSuggestion) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscriptionSuggestion.ProtoReflect.Descriptor instead.
+func (*SubscriptionSuggestion) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *SubscriptionSuggestion) GetRecurringExpense() *RecurringExpense {
+	if x != nil {
+		return x.RecurringExpense
+	}
+	return nil
+}
+
+func (x *SubscriptionSuggestion) GetConfidence() float64 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+func (x *SubscriptionSuggestion) GetTransactionIds() []string {
+	if x != nil {
+		return x.TransactionIds
+	}
+	return nil
+}
+
+// The request for
+// [DetectSubscriptions][saturn.finance.v1.Finance.DetectSubscriptions].
+type DetectSubscriptionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional. Number of days of history to scan. Defaults to 400, at most 1100.
+	LookbackDays  int32 `protobuf:"varint,1,opt,name=lookback_days,json=lookbackDays,proto3" json:"lookback_days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetectSubscriptionsRequest) Reset() {
+	*x = DetectSubscriptionsRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetectSubscriptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetectSubscriptionsRequest) ProtoMessage() {}
+
+func (x *DetectSubscriptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetectSubscriptionsRequest.ProtoReflect.Descriptor instead.
+func (*DetectSubscriptionsRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *DetectSubscriptionsRequest) GetLookbackDays() int32 {
+	if x != nil {
+		return x.LookbackDays
+	}
+	return 0
+}
+
+// The response for
+// [DetectSubscriptions][saturn.finance.v1.Finance.DetectSubscriptions].
+type DetectSubscriptionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Suggestions ordered by confidence, highest first.
+	Suggestions   []*SubscriptionSuggestion `protobuf:"bytes,1,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetectSubscriptionsResponse) Reset() {
+	*x = DetectSubscriptionsResponse{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetectSubscriptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetectSubscriptionsResponse) ProtoMessage() {}
+
+func (x *DetectSubscriptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetectSubscriptionsResponse.ProtoReflect.Descriptor instead.
+func (*DetectSubscriptionsResponse) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *DetectSubscriptionsResponse) GetSuggestions() []*SubscriptionSuggestion {
+	if x != nil {
+		return x.Suggestions
+	}
+	return nil
+}
+
+// The request for
+// [ConvertToRecurringExpense][saturn.finance.v1.Finance.ConvertToRecurringExpense].
+type ConvertToRecurringExpenseRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The recurring expense to create, usually the suggested template.
+	RecurringExpense *RecurringExpense `protobuf:"bytes,1,opt,name=recurring_expense,json=recurringExpense,proto3" json:"recurring_expense,omitempty"`
+	// Required. Expenses to link to the new recurring expense.
+	// Values are of the form `txn_[a-zA-Z0-9]+`.
+	TransactionIds []string `protobuf:"bytes,2,rep,name=transaction_ids,json=transactionIds,proto3" json:"transaction_ids,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ConvertToRecurringExpenseRequest) Reset() {
+	*x = ConvertToRecurringExpenseRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConvertToRecurringExpenseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConvertToRecurringExpenseRequest) ProtoMessage() {}
+
+func (x *ConvertToRecurringExpenseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConvertToRecurringExpenseRequest.ProtoReflect.Descriptor instead.
+func (*ConvertToRecurringExpenseRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *ConvertToRecurringExpenseRequest) GetRecurringExpense() *RecurringExpense {
+	if x != nil {
+		return x.RecurringExpense
+	}
+	return nil
+}
+
+func (x *ConvertToRecurringExpenseRequest) GetTransactionIds() []string {
+	if x != nil {
+		return x.TransactionIds
+	}
+	return nil
+}
+
+// The request for
+// [ListScheduledPayments][saturn.finance.v1.Finance.ListScheduledPayments].
+type ListScheduledPaymentsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional. Filter instances by payment status.
+	Status ScheduledPayment_Status `protobuf:"varint,1,opt,name=status,proto3,enum=saturn.finance.v1.ScheduledPayment_Status" json:"status,omitempty"`
+	// Optional. Target start date of execution window.
+	StartDate *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	// Optional. Target end date of execution window.
+	EndDate *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	// Optional. Maximum number of items to return.
+	PageSize int32 `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Optional. Keyset page token.
+	PageToken string `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Optional. Representation view level.
+	View *ScheduledPayment_View `protobuf:"varint,6,opt,name=view,proto3,enum=saturn.finance.v1.ScheduledPayment_View,oneof" json:"view,omitempty"`
+	// Optional. Search string querying the description or parent info.
+	SearchQuery *string `protobuf:"bytes,7,opt,name=search_query,json=searchQuery,proto3,oneof" json:"search_query,omitempty"`
+	// Optional. Sorting specification.
+	Sort          *string `protobuf:"bytes,8,opt,name=sort,proto3,oneof" json:"sort,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListScheduledPaymentsRequest) Reset() {
+	*x = ListScheduledPaymentsRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScheduledPaymentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScheduledPaymentsRequest) ProtoMessage() {}
+
+func (x *ListScheduledPaymentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScheduledPaymentsRequest.ProtoReflect.Descriptor instead.
+func (*ListScheduledPaymentsRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *ListScheduledPaymentsRequest) GetStatus() ScheduledPayment_Status {
+	if x != nil {
+		return x.Status
+	}
+	return ScheduledPayment_STATUS_UNSPECIFIED
+}
+
+func (x *ListScheduledPaymentsRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *ListScheduledPaymentsRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *ListScheduledPaymentsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListScheduledPaymentsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListScheduledPaymentsRequest) GetView() ScheduledPayment_View {
+	if x != nil && x.View != nil {
+		return *x.View
+	}
+	return ScheduledPayment_VIEW_UNSPECIFIED
+}
+
+func (x *ListScheduledPaymentsRequest) GetSearchQuery() string {
+	if x != nil && x.SearchQuery != nil {
+		return *x.SearchQuery
+	}
+	return ""
+}
+
+func (x *ListScheduledPaymentsRequest) GetSort() string {
+	if x != nil && x.Sort != nil {
+		return *x.Sort
+	}
+	return ""
+}
+
+// The response for
+// [ListScheduledPayments][saturn.finance.v1.Finance.ListScheduledPayments].
+type ListScheduledPaymentsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// List of scheduled payment instances matching filters.
+	ScheduledPayments []*ScheduledPayment `protobuf:"bytes,1,rep,name=scheduled_payments,json=scheduledPayments,proto3" json:"scheduled_payments,omitempty"`
+	// Next page keyset token. Empty if no more pages are available.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListScheduledPaymentsResponse) Reset() {
+	*x = ListScheduledPaymentsResponse{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScheduledPaymentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScheduledPaymentsResponse) ProtoMessage() {}
+
+func (x *ListScheduledPaymentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScheduledPaymentsResponse.ProtoReflect.Descriptor instead.
+func (*ListScheduledPaymentsResponse) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *ListScheduledPaymentsResponse) GetScheduledPayments() []*ScheduledPayment {
+	if x != nil {
+		return x.ScheduledPayments
+	}
+	return nil
+}
+
+func (x *ListScheduledPaymentsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// The request for
+// [GetScheduledPayment][saturn.finance.v1.Finance.GetScheduledPayment].
+type GetScheduledPaymentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. Unique identifier of the scheduled payment to retrieve.
+	// Values are of the form `sch_[a-zA-Z0-9]+`.
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetScheduledPaymentRequest) Reset() {
+	*x = GetScheduledPaymentRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetScheduledPaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScheduledPaymentRequest) ProtoMessage() {}
+
+func (x *GetScheduledPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScheduledPaymentRequest.ProtoReflect.Descriptor instead.
+func (*GetScheduledPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *GetScheduledPaymentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// The request for
+// [ConfirmScheduledPayment][saturn.finance.v1.Finance.ConfirmScheduledPayment].
+type ConfirmScheduledPaymentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. Unique identifier of the scheduled payment to confirm.
+	// Values are of the form `sch_[a-zA-Z0-9]+`.
+	PaymentId string `protobuf:"bytes,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	// Required. Time transaction occurred.
+	TransactionDate *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=transaction_date,json=transactionDate,proto3" json:"transaction_date,omitempty"`
+	// Required. Execution/posting date.
+	EffectiveDate *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=effective_date,json=effectiveDate,proto3" json:"effective_date,omitempty"`
+	// Required. Actual amount cleared in local currency cents.
+	ActualAmount int64 `protobuf:"varint,4,opt,name=actual_amount,json=actualAmount,proto3" json:"actual_amount,omitempty"`
+	// Optional. Narration notes.
+	Description *string `protobuf:"bytes,5,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	// Optional. Financial account identifier used to settle the payment.
+	// When provided, the account balance will be updated accordingly.
+	// Values are of the form `acc_[a-zA-Z0-9]+`.
+	AccountId *string `protobuf:"bytes,6,opt,name=account_id,json=accountId,proto3,oneof" json:"account_id,omitempty"`
+	// Optional. Budget category identifier to allocate the cleared transaction under.
+	// If omitted, defaults to the budget category configured on the scheduled payment.
+	// Values are of the form `bud_[a-zA-Z0-9]+`.
+	BudgetId *string `protobuf:"bytes,7,opt,name=budget_id,json=budgetId,proto3,oneof" json:"budget_id,omitempty"`
+	// Optional. Settlement currency code (ISO 4217 standard 3-letter code).
+	// If omitted, defaults to the currency configured on the scheduled payment.
+	Currency      *string `protobuf:"bytes,8,opt,name=currency,proto3,oneof" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmScheduledPaymentRequest) Reset() {
+	*x = ConfirmScheduledPaymentRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmScheduledPaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmScheduledPaymentRequest) ProtoMessage() {}
+
+func (x *ConfirmScheduledPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmScheduledPaymentRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmScheduledPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *ConfirmScheduledPaymentRequest) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+func (x *ConfirmScheduledPaymentRequest) GetTransactionDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TransactionDate
+	}
+	return nil
+}
+
+func (x *ConfirmScheduledPaymentRequest) GetEffectiveDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveDate
+	}
+	return nil
+}
+
+func (x *ConfirmScheduledPaymentRequest) GetActualAmount() int64 {
+	if x != nil {
+		return x.ActualAmount
+	}
+	return 0
+}
+
+func (x *ConfirmScheduledPaymentRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *ConfirmScheduledPaymentRequest) GetAccountId() string {
+	if x != nil && x.AccountId != nil {
+		return *x.AccountId
+	}
+	return ""
+}
+
+func (x *ConfirmScheduledPaymentRequest) GetBudgetId() string {
+	if x != nil && x.BudgetId != nil {
+		return *x.BudgetId
+	}
+	return ""
+}
+
+func (x *ConfirmScheduledPaymentRequest) GetCurrency() string {
+	if x != nil && x.Currency != nil {
+		return *x.Currency
+	}
+	return ""
+}
+
+// The request for [MatchScheduledPayment][saturn.finance.v1.Finance.MatchScheduledPayment].
+type MatchScheduledPaymentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. Target scheduled payment identifier.
+	// Values are of the form `sch_[a-zA-Z0-9]+`.
+	PaymentId string `protobuf:"bytes,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	// Required. Existing transaction identifier to match and link.
+	// Values are of the form `txn_[a-zA-Z0-9]+`.
+	TransactionId string `protobuf:"bytes,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MatchScheduledPaymentRequest) Reset() {
+	*x = MatchScheduledPaymentRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatchScheduledPaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchScheduledPaymentRequest) ProtoMessage() {}
+
+func (x *MatchScheduledPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchScheduledPaymentRequest.ProtoReflect.Descriptor instead.
+func (*MatchScheduledPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *MatchScheduledPaymentRequest) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+func (x *MatchScheduledPaymentRequest) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+// The request for [SkipScheduledPayment][saturn.finance.v1.Finance.SkipScheduledPayment].
+type SkipScheduledPaymentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. Identifier of the scheduled payment instance to skip.
+	// Values are of the form `sch_[a-zA-Z0-9]+`.
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SkipScheduledPaymentRequest) Reset() {
+	*x = SkipScheduledPaymentRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SkipScheduledPaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkipScheduledPaymentRequest) ProtoMessage() {}
+
+func (x *SkipScheduledPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkipScheduledPaymentRequest.ProtoReflect.Descriptor instead.
+func (*SkipScheduledPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *SkipScheduledPaymentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Borrowing represents a personal lent/borrowed debt agreement.
+type Borrowing struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Output only. Unique identifier.
+	// Values are of the form `bor_[a-zA-Z0-9]+`.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Output only. Space identifier.
+	SpaceId string `protobuf:"bytes,2,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	// Required. Direction flow of the debt.
+	Direction Borrowing_Direction `protobuf:"varint,3,opt,name=direction,proto3,enum=saturn.finance.v1.Borrowing_Direction" json:"direction,omitempty"`
+	// Required. Name of the counterparty entity.
+	Counterparty string `protobuf:"bytes,4,opt,name=counterparty,proto3" json:"counterparty,omitempty"`
+	// Optional. Contact information.
+	ContactInfo string `protobuf:"bytes,5,opt,name=contact_info,json=contactInfo,proto3" json:"contact_info,omitempty"`
+	// Required. Initial loan principal amount in cents.
+	TotalAmount int64 `protobuf:"varint,6,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	// Output only. Remaining balance amount in cents.
+	RemainingAmount int64 `protobuf:"varint,7,opt,name=remaining_amount,json=remainingAmount,proto3" json:"remaining_amount,omitempty"`
+	// Required. Currency code.
+	Currency string `protobuf:"bytes,8,opt,name=currency,proto3" json:"currency,omitempty"`
+	// Required. Lifecycle status.
+	Status Borrowing_Status `protobuf:"varint,9,opt,name=status,proto3,enum=saturn.finance.v1.Borrowing_Status" json:"status,omitempty"`
+	// Required. Date established.
+	EstablishedAt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=established_at,json=establishedAt,proto3" json:"established_at,omitempty"`
+	// Optional. Target repayment due date.
+	DueAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=due_at,json=dueAt,proto3" json:"due_at,omitempty"`
+	// Optional. Automatically create a parallel transaction entry in the ledger.
+	CreateAsTransaction bool `protobuf:"varint,12,opt,name=create_as_transaction,json=createAsTransaction,proto3" json:"create_as_transaction,omitempty"`
+	// Optional. Narration notes.
+	Notes string `protobuf:"bytes,13,opt,name=notes,proto3" json:"notes,omitempty"`
+	// Output only. Creation timestamp.
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// Output only. Last update timestamp.
+	UpdateTime *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	// Optional. Source account identifier for transaction creation.
+	// Values are of the form `acc_[a-zA-Z0-9]+`.
+	AccountId *string `protobuf:"bytes,16,opt,name=account_id,json=accountId,proto3,oneof" json:"account_id,omitempty"`
+	// Optional. How interest is charged.
+	InterestType Borrowing_InterestType `protobuf:"varint,17,opt,name=interest_type,json=interestType,proto3,enum=saturn.finance.v1.Borrowing_InterestType" json:"interest_type,omitempty"`
+	// Optional. Annual percentage rate in basis points (525 = 5.25%).
+	InterestRateBps int32 `protobuf:"varint,18,opt,name=interest_rate_bps,json=interestRateBps,proto3" json:"interest_rate_bps,omitempty"`
+	// Optional. Number of instalments. Zero means the borrowing is repaid ad hoc.
+	InstallmentCount int32 `protobuf:"varint,19,opt,name=installment_count,json=installmentCount,proto3" json:"installment_count,omitempty"`
+	// Optional. Spacing between instalments. Required when installment_count is set.
+	InstallmentFrequency Borrowing_InstallmentFrequency `protobuf:"varint,20,opt,name=installment_frequency,json=installmentFrequency,proto3,enum=saturn.finance.v1.Borrowing_InstallmentFrequency" json:"installment_frequency,omitempty"`
+	// Optional. Due date of the first instalment. Defaults to one period after established_at.
+	FirstInstallmentDate *timestamppb.Timestamp `protobuf:"bytes,21,opt,name=first_installment_date,json=firstInstallmentDate,proto3" json:"first_installment_date,omitempty"`
+	// Optional. Budget charged by the instalment scheduled payments. Required for borrowed
+	// loans with an instalment plan.
+	// Values are of the form `bud_[a-zA-Z0-9]+`.
+	BudgetId *string `protobuf:"bytes,22,opt,name=budget_id,json=budgetId,proto3,oneof" json:"budget_id,omitempty"`
+	// Output only. Total repaid so far in cents, interest included.
+	PaidAmount int64 `protobuf:"varint,23,opt,name=paid_amount,json=paidAmount,proto3" json:"paid_amount,omitempty"`
+	// Output only. Amortization table. Populated by GetBorrowing for instalment plans.
+	Installments []*BorrowingInstallment `protobuf:"bytes,24,rep,name=installments,proto3" json:"installments,omitempty"`
+	// Output only. Principal plus all scheduled interest in cents.
+	TotalPayable int64 `protobuf:"varint,25,opt,name=total_payable,json=totalPayable,proto3" json:"total_payable,omitempty"`
+	// Output only. Interest charged over the whole plan in cents.
+	TotalInterest int64 `protobuf:"varint,26,opt,name=total_interest,json=totalInterest,proto3" json:"total_interest,omitempty"`
+	// Output only. Interest accrued to date in cents, including the running period pro rata.
+	AccruedInterest int64 `protobuf:"varint,27,opt,name=accrued_interest,json=accruedInterest,proto3" json:"accrued_interest,omitempty"`
+	// Output only. Interest repaid so far in cents.
+	InterestPaid int64 `protobuf:"varint,28,opt,name=interest_paid,json=interestPaid,proto3" json:"interest_paid,omitempty"`
+	// Output only. Number of instalments past their due date and not fully repaid.
+	OverdueInstallmentCount int32 `protobuf:"varint,29,opt,name=overdue_installment_count,json=overdueInstallmentCount,proto3" json:"overdue_installment_count,omitempty"`
+	// Output only. Unpaid amount of the overdue instalments in cents.
+	OverdueAmount int64 `protobuf:"varint,30,opt,name=overdue_amount,json=overdueAmount,proto3" json:"overdue_amount,omitempty"`
+	// Output only. The next instalment that is not yet paid or overdue.
+	NextInstallment *BorrowingInstallment `protobuf:"bytes,31,opt,name=next_installment,json=nextInstallment,proto3" json:"next_installment,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Borrowing) Reset() {
+	*x = Borrowing{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Borrowing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Borrowing) ProtoMessage() {}
+
+func (x *Borrowing) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Borrowing.ProtoReflect.Descriptor instead.
+func (*Borrowing) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *Borrowing) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Borrowing) GetSpaceId() string {
+	if x != nil {
+		return x.SpaceId
+	}
+	return ""
+}
+
+func (x *Borrowing) GetDirection() Borrowing_Direction {
+	if x != nil {
+		return x.Direction
+	}
+	return Borrowing_DIRECTION_UNSPECIFIED
+}
+
+func (x *Borrowing) GetCounterparty() string {
+	if x != nil {
+		return x.Counterparty
+	}
+	return ""
+}
+
+func (x *Borrowing) GetContactInfo() string {
+	if x != nil {
+		return x.ContactInfo
+	}
+	return ""
+}
+
+func (x *Borrowing) GetTotalAmount() int64 {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return 0
+}
+
+func (x *Borrowing) GetRemainingAmount() int64 {
+	if x != nil {
+		return x.RemainingAmount
+	}
+	return 0
+}
+
+func (x *Borrowing) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Borrowing) GetStatus() Borrowing_Status {
+	if x != nil {
+		return x.Status
+	}
+	return Borrowing_STATUS_UNSPECIFIED
+}
+
+func (x *Borrowing) GetEstablishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EstablishedAt
+	}
+	return nil
+}
+
+func (x *Borrowing) GetDueAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueAt
+	}
+	return nil
+}
+
+func (x *Borrowing) GetCreateAsTransaction() bool {
+	if x != nil {
+		return x.CreateAsTransaction
+	}
+	return false
+}
+
+func (x *Borrowing) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *Borrowing) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *Borrowing) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+func (x *Borrowing) GetAccountId() string {
+	if x != nil && x.AccountId != nil {
+		return *x.AccountId
+	}
+	return ""
+}
+
+func (x *Borrowing) GetInterestType() Borrowing_InterestType {
+	if x != nil {
+		return x.InterestType
+	}
+	return Borrowing_INTEREST_TYPE_UNSPECIFIED
+}
+
+func (x *Borrowing) GetInterestRateBps() int32 {
+	if x != nil {
+		return x.InterestRateBps
+	}
+	return 0
+}
+
+func (x *Borrowing) GetInstallmentCount() int32 {
+	if x != nil {
+		return x.InstallmentCount
+	}
+	return 0
+}
+
+func (x *Borrowing) GetInstallmentFrequency() Borrowing_InstallmentFrequency {
+	if x != nil {
+		return x.InstallmentFrequency
+	}
+	return Borrowing_INSTALLMENT_FREQUENCY_UNSPECIFIED
+}
+
+func (x *Borrowing) GetFirstInstallmentDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FirstInstallmentDate
+	}
+	return nil
+}
+
+func (x *Borrowing) GetBudgetId() string {
+	if x != nil && x.BudgetId != nil {
+		return *x.BudgetId
+	}
+	return ""
+}
+
+func (x *Borrowing) GetPaidAmount() int64 {
+	if x != nil {
+		return x.PaidAmount
+	}
+	return 0
+}
+
+func (x *Borrowing) GetInstallments() []*BorrowingInstallment {
+	if x != nil {
+		return x.Installments
+	}
+	return nil
+}
+
+func (x *Borrowing) GetTotalPayable() int64 {
+	if x != nil {
+		return x.TotalPayable
+	}
+	return 0
+}
+
+func (x *Borrowing) GetTotalInterest() int64 {
+	if x != nil {
+		return x.TotalInterest
+	}
+	return 0
+}
+
+func (x *Borrowing) GetAccruedInterest() int64 {
+	if x != nil {
+		return x.AccruedInterest
+	}
+	return 0
+}
+
+func (x *Borrowing) GetInterestPaid() int64 {
+	if x != nil {
+		return x.InterestPaid
+	}
+	return 0
+}
+
+func (x *Borrowing) GetOverdueInstallmentCount() int32 {
+	if x != nil {
+		return x.OverdueInstallmentCount
+	}
+	return 0
+}
+
+func (x *Borrowing) GetOverdueAmount() int64 {
+	if x != nil {
+		return x.OverdueAmount
+	}
+	return 0
+}
+
+func (x *Borrowing) GetNextInstallment() *BorrowingInstallment {
+	if x != nil {
+		return x.NextInstallment
+	}
+	return nil
+}
+
+// BorrowingInstallment is one row of a borrowing's amortization table.
+type BorrowingInstallment struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Output only. 1-based position in the plan.
+	Number int32 `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	// Output only. Due date.
+	DueDate *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	// Output only. Instalment amount in cents; principal plus interest.
+	Payment int64 `protobuf:"varint,3,opt,name=payment,proto3" json:"payment,omitempty"`
+	// Output only. Principal share in cents.
+	Principal int64 `protobuf:"varint,4,opt,name=principal,proto3" json:"principal,omitempty"`
+	// Output only. Interest share in cents.
+	Interest int64 `protobuf:"varint,5,opt,name=interest,proto3" json:"interest,omitempty"`
+	// Output only. Principal still owed after this instalment in cents.
+	RemainingBalance int64 `protobuf:"varint,6,opt,name=remaining_balance,json=remainingBalance,proto3" json:"remaining_balance,omitempty"`
+	// Output only. Portion of the payment covered by repayments in cents.
+	PaidAmount int64 `protobuf:"varint,7,opt,name=paid_amount,json=paidAmount,proto3" json:"paid_amount,omitempty"`
+	// Output only. Repayment state.
+	Status        BorrowingInstallment_Status `protobuf:"varint,8,opt,name=status,proto3,enum=saturn.finance.v1.BorrowingInstallment_Status" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BorrowingInstallment) Reset() {
+	*x = BorrowingInstallment{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BorrowingInstallment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BorrowingInstallment) ProtoMessage() {}
+
+func (x *BorrowingInstallment) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BorrowingInstallment.ProtoReflect.Descriptor instead.
+func (*BorrowingInstallment) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *BorrowingInstallment) GetNumber() int32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *BorrowingInstallment) GetDueDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueDate
+	}
+	return nil
+}
+
+func (x *BorrowingInstallment) GetPayment() int64 {
+	if x != nil {
+		return x.Payment
+	}
+	return 0
+}
+
+func (x *BorrowingInstallment) GetPrincipal() int64 {
+	if x != nil {
+		return x.Principal
+	}
+	return 0
+}
+
+func (x *BorrowingInstallment) GetInterest() int64 {
+	if x != nil {
+		return x.Interest
+	}
+	return 0
+}
+
+func (x *BorrowingInstallment) GetRemainingBalance() int64 {
+	if x != nil {
+		return x.RemainingBalance
+	}
+	return 0
+}
+
+func (x *BorrowingInstallment) GetPaidAmount() int64 {
+	if x != nil {
+		return x.PaidAmount
+	}
+	return 0
+}
+
+func (x *BorrowingInstallment) GetStatus() BorrowingInstallment_Status {
+	if x != nil {
+		return x.Status
+	}
+	return BorrowingInstallment_STATUS_UNSPECIFIED
+}
+
+// BorrowingRepayment represents an installment payment towards a borrowing.
+type BorrowingRepayment struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Output only. Unique identifier.
+	// Values are of the form `rep_[a-zA-Z0-9]+`.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Required. Parent borrowing identifier.
+	// Values are of the form `bor_[a-zA-Z0-9]+`.
+	BorrowingId string `protobuf:"bytes,2,opt,name=borrowing_id,json=borrowingId,proto3" json:"borrowing_id,omitempty"`
+	// Output only. Space identifier.
+	SpaceId string `protobuf:"bytes,3,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	// Required. Installment amount in cents.
+	Amount int64 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Required. Installment payment date.
+	PaymentDate *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=payment_date,json=paymentDate,proto3" json:"payment_date,omitempty"`
+	// Optional. Narration notes.
+	Notes string `protobuf:"bytes,6,opt,name=notes,proto3" json:"notes,omitempty"`
+	// Output only. Creation timestamp.
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// Output only. Last update timestamp.
+	UpdateTime *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	// Required. Source account identifier.
+	// Values are of the form `acc_[a-zA-Z0-9]+`.
+	AccountId     string `protobuf:"bytes,9,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BorrowingRepayment) Reset() {
+	*x = BorrowingRepayment{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BorrowingRepayment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BorrowingRepayment) ProtoMessage() {}
+
+func (x *BorrowingRepayment) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BorrowingRepayment.ProtoReflect.Descriptor instead.
+func (*BorrowingRepayment) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *BorrowingRepayment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BorrowingRepayment) GetBorrowingId() string {
+	if x != nil {
+		return x.BorrowingId
+	}
+	return ""
+}
+
+func (x *BorrowingRepayment) GetSpaceId() string {
+	if x != nil {
+		return x.SpaceId
+	}
+	return ""
+}
+
+func (x *BorrowingRepayment) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *BorrowingRepayment) GetPaymentDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PaymentDate
+	}
+	return nil
+}
+
+func (x *BorrowingRepayment) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *BorrowingRepayment) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *BorrowingRepayment) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+func (x *BorrowingRepayment) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+// The request for
+// [CreateBorrowing][saturn.finance.v1.Finance.CreateBorrowing].
+type CreateBorrowingRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. Target borrowing parameters.
+	Borrowing     *Borrowing `protobuf:"bytes,1,opt,name=borrowing,proto3" json:"borrowing,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBorrowingRequest) Reset() {
+	*x = CreateBorrowingRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBorrowingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBorrowingRequest) ProtoMessage() {}
+
+func (x *CreateBorrowingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBorrowingRequest.ProtoReflect.Descriptor instead.
+func (*CreateBorrowingRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *CreateBorrowingRequest) GetBorrowing() *Borrowing {
+	if x != nil {
+		return x.Borrowing
+	}
+	return nil
+}
+
+// The request for
+// [GetBorrowing][saturn.finance.v1.Finance.GetBorrowing].
+type GetBorrowingRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. ID of the borrowing record to retrieve.
+	// Values are of the form `bor_[a-zA-Z0-9]+`.
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBorrowingRequest) Reset() {
+	*x = GetBorrowingRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBorrowingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBorrowingRequest) ProtoMessage() {}
+
+func (x *GetBorrowingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBorrowingRequest.ProtoReflect.Descriptor instead.
+func (*GetBorrowingRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *GetBorrowingRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// The request for
+// [ListBorrowings][saturn.finance.v1.Finance.ListBorrowings].
+type ListBorrowingsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional. Filter by debt status.
+	Status *Borrowing_Status `protobuf:"varint,1,opt,name=status,proto3,enum=saturn.finance.v1.Borrowing_Status,oneof" json:"status,omitempty"`
+	// Optional. Filter by debt flow direction.
+	Direction *Borrowing_Direction `protobuf:"varint,2,opt,name=direction,proto3,enum=saturn.finance.v1.Borrowing_Direction,oneof" json:"direction,omitempty"`
+	// Optional. Maximum number of items to return.
+	PageSize *int32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	// Optional. Keyset page token.
+	PageToken *string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3,oneof" json:"page_token,omitempty"`
+	// Optional. Sort order for results.
+	OrderBy       *string `protobuf:"bytes,5,opt,name=order_by,json=orderBy,proto3,oneof" json:"order_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBorrowingsRequest) Reset() {
+	*x = ListBorrowingsRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBorrowingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBorrowingsRequest) ProtoMessage() {}
+
+func (x *ListBorrowingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBorrowingsRequest.ProtoReflect.Descriptor instead.
+func (*ListBorrowingsRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *ListBorrowingsRequest) GetStatus() Borrowing_Status {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return Borrowing_STATUS_UNSPECIFIED
+}
+
+func (x *ListBorrowingsRequest) GetDirection() Borrowing_Direction {
+	if x != nil && x.Direction != nil {
+		return *x.Direction
+	}
+	return Borrowing_DIRECTION_UNSPECIFIED
+}
+
+func (x *ListBorrowingsRequest) GetPageSize() int32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+func (x *ListBorrowingsRequest) GetPageToken() string {
+	if x != nil && x.PageToken != nil {
+		return *x.PageToken
+	}
+	return ""
+}
+
+func (x *ListBorrowingsRequest) GetOrderBy() string {
+	if x != nil && x.OrderBy != nil {
+		return *x.OrderBy
+	}
+	return ""
+}
+
+// The response for
+// [ListBorrowings][saturn.finance.v1.Finance.ListBorrowings].
+type ListBorrowingsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// List of borrowing records matching filters.
+	Borrowings []*Borrowing `protobuf:"bytes,1,rep,name=borrowings,proto3" json:"borrowings,omitempty"`
+	// Next page keyset token. Empty if no more pages are available.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBorrowingsResponse) Reset() {
+	*x = ListBorrowingsResponse{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBorrowingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBorrowingsResponse) ProtoMessage() {}
+
+func (x *ListBorrowingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBorrowingsResponse.ProtoReflect.Descriptor instead.
+func (*ListBorrowingsResponse) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *ListBorrowingsResponse) GetBorrowings() []*Borrowing {
+	if x != nil {
+		return x.Borrowings
+	}
+	return nil
+}
+
+func (x *ListBorrowingsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// The request for
+// [UpdateBorrowing][saturn.finance.v1.Finance.UpdateBorrowing].
+type UpdateBorrowingRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. Unique identifier of the borrowing record to update.
+	// Values are of the form `bor_[a-zA-Z0-9]+`.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Required. Updated borrowing parameters.
+	Borrowing     *Borrowing `protobuf:"bytes,2,opt,name=borrowing,proto3" json:"borrowing,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateBorrowingRequest) Reset() {
+	*x = UpdateBorrowingRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBorrowingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBorrowingRequest) ProtoMessage() {}
+
+func (x *UpdateBorrowingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBorrowingRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBorrowingRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *UpdateBorrowingRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateBorrowingRequest) GetBorrowing() *Borrowing {
+	if x != nil {
+		return x.Borrowing
+	}
+	return nil
+}
+
+// The request for
+// [DeleteBorrowing][saturn.finance.v1.Finance.DeleteBorrowing].
+type DeleteBorrowingRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. Unique identifier of the borrowing record to delete.
+	// Values are of the form `bor_[a-zA-Z0-9]+`.
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBorrowingRequest) Reset() {
+	*x = DeleteBorrowingRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBorrowingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBorrowingRequest) ProtoMessage() {}
+
+func (x *DeleteBorrowingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBorrowingRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBorrowingRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *DeleteBorrowingRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// The request for
+// [CreateBorrowingRepayment][saturn.finance.v1.Finance.CreateBorrowingRepayment].
+type CreateBorrowingRepaymentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. Target parent borrowing identifier.
+	// Values are of the form `bor_[a-zA-Z0-9]+`.
+	BorrowingId string `protobuf:"bytes,1,opt,name=borrowing_id,json=borrowingId,proto3" json:"borrowing_id,omitempty"`
+	// Required. Updated repayment parameters.
+	Repayment     *BorrowingRepayment `protobuf:"bytes,2,opt,name=repayment,proto3" json:"repayment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBorrowingRepaymentRequest) Reset() {
+	*x = CreateBorrowingRepaymentRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBorrowingRepaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBorrowingRepaymentRequest) ProtoMessage() {}
+
+func (x *CreateBorrowingRepaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBorrowingRepaymentRequest.ProtoReflect.Descriptor instead.
+func (*CreateBorrowingRepaymentRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *CreateBorrowingRepaymentRequest) GetBorrowingId() string {
+	if x != nil {
+		return x.BorrowingId
+	}
+	return ""
+}
+
+func (x *CreateBorrowingRepaymentRequest) GetRepayment() *BorrowingRepayment {
+	if x != nil {
+		return x.Repayment
+	}
+	return nil
+}
+
+// The request for
+// [ListBorrowingRepayments][saturn.finance.v1.Finance.ListBorrowingRepayments].
+type ListBorrowingRepaymentsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. Target parent borrowing identifier.
+	// Values are of the form `bor_[a-zA-Z0-9]+`.
+	BorrowingId   string `protobuf:"bytes,1,opt,name=borrowing_id,json=borrowingId,proto3" json:"borrowing_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBorrowingRepaymentsRequest) Reset() {
+	*x = ListBorrowingRepaymentsRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBorrowingRepaymentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBorrowingRepaymentsRequest) ProtoMessage() {}
+
+func (x *ListBorrowingRepaymentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBorrowingRepaymentsRequest.ProtoReflect.Descriptor instead.
+func (*ListBorrowingRepaymentsRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *ListBorrowingRepaymentsRequest) GetBorrowingId() string {
+	if x != nil {
+		return x.BorrowingId
+	}
+	return ""
+}
+
+// The response for
+// [ListBorrowingRepayments][saturn.finance.v1.Finance.ListBorrowingRepayments].
+type ListBorrowingRepaymentsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// List of installment repayments.
+	Repayments    []*BorrowingRepayment `protobuf:"bytes,1,rep,name=repayments,proto3" json:"repayments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBorrowingRepaymentsResponse) Reset() {
+	*x = ListBorrowingRepaymentsResponse{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBorrowingRepaymentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBorrowingRepaymentsResponse) ProtoMessage() {}
+
+func (x *ListBorrowingRepaymentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBorrowingRepaymentsResponse.ProtoReflect.Descriptor instead.
+func (*ListBorrowingRepaymentsResponse) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *ListBorrowingRepaymentsResponse) GetRepayments() []*BorrowingRepayment {
+	if x != nil {
+		return x.Repayments
+	}
+	return nil
+}
+
+// The request for
+// [DeleteBorrowingRepayment][saturn.finance.v1.Finance.DeleteBorrowingRepayment].
+type DeleteBorrowingRepaymentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. Target parent borrowing identifier.
+	// Values are of the form `bor_[a-zA-Z0-9]+`.
+	BorrowingId string `protobuf:"bytes,1,opt,name=borrowing_id,json=borrowingId,proto3" json:"borrowing_id,omitempty"`
+	// Required. Unique identifier of the installment repayment to delete.
+	// Values are of the form `rep_[a-zA-Z0-9]+`.
+	Id            string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBorrowingRepaymentRequest) Reset() {
+	*x = DeleteBorrowingRepaymentRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBorrowingRepaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBorrowingRepaymentRequest) ProtoMessage() {}
+
+func (x *DeleteBorrowingRepaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBorrowingRepaymentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBorrowingRepaymentRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *DeleteBorrowingRepaymentRequest) GetBorrowingId() string {
+	if x != nil {
+		return x.BorrowingId
+	}
+	return ""
+}
+
+func (x *DeleteBorrowingRepaymentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// CurrencyInfo encapsulates currency code metadata.
+type CurrencyInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. ISO currency code (e.g. USD).
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	// Required. User-friendly currency name.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Output only. Display symbol (e.g. "$", "¥"). Falls back to the ISO code.
+	Symbol string `protobuf:"bytes,3,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// Output only. Number of decimal places in the minor unit (ISO 4217 exponent).
+	// All amounts in this currency are expressed as integers of 10^-minor_units.
+	MinorUnits int32 `protobuf:"varint,4,opt,name=minor_units,json=minorUnits,proto3" json:"minor_units,omitempty"`
+	// Output only. Whether the currency is enabled in the current workspace.
+	Enabled       bool `protobuf:"varint,5,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CurrencyInfo) Reset() {
+	*x = CurrencyInfo{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CurrencyInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrencyInfo) ProtoMessage() {}
+
+func (x *CurrencyInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CurrencyInfo.ProtoReflect.Descriptor instead.
+func (*CurrencyInfo) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *CurrencyInfo) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CurrencyInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CurrencyInfo) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *CurrencyInfo) GetMinorUnits() int32 {
+	if x != nil {
+		return x.MinorUnits
+	}
+	return 0
+}
+
+func (x *CurrencyInfo) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+// The request for
+// [ListCurrencies][saturn.finance.v1.Finance.ListCurrencies].
+type ListCurrenciesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCurrenciesRequest) Reset() {
+	*x = ListCurrenciesRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCurrenciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCurrenciesRequest) ProtoMessage() {}
+
+func (x *ListCurrenciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCurrenciesRequest.ProtoReflect.Descriptor instead.
+func (*ListCurrenciesRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{121}
+}
+
+// The response for
+// [ListCurrencies][saturn.finance.v1.Finance.ListCurrencies].
+type ListCurrenciesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// List of currency codes and details.
+	Currencies    []*CurrencyInfo `protobuf:"bytes,1,rep,name=currencies,proto3" json:"currencies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCurrenciesResponse) Reset() {
+	*x = ListCurrenciesResponse{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCurrenciesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCurrenciesResponse) ProtoMessage() {}
+
+func (x *ListCurrenciesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCurrenciesResponse.ProtoReflect.Descriptor instead.
+func (*ListCurrenciesResponse) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *ListCurrenciesResponse) GetCurrencies() []*CurrencyInfo {
+	if x != nil {
+		return x.Currencies
+	}
+	return nil
+}
+
+// Account represents a physical or digital payment account.
+type Account struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Output only. Unique identifier of the account.
+	// Values are of the form `acc_[a-zA-Z0-9]+`.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Output only. Space identifier.
+	SpaceId string `protobuf:"bytes,2,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	// Required. User-friendly account name (e.g. "Chase Checking").
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// Required. Account classification type.
+	Type Account_Type `protobuf:"varint,4,opt,name=type,proto3,enum=saturn.finance.v1.Account_Type" json:"type,omitempty"`
+	// Required. Account currency (e.g. "USD").
+	Currency string `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	// Required. Initial balance amount in cents (e.g. 500000 for $5,000.00).
+	InitialBalance int64 `protobuf:"varint,6,opt,name=initial_balance,json=initialBalance,proto3" json:"initial_balance,omitempty"`
+	// Output only. Computed current balance amount in cents.
+	// Calculated dynamically as `InitialBalance + Sum(Income/TransferIn) - Sum(Expense/TransferOut)`.
+	CurrentBalance int64 `protobuf:"varint,7,opt,name=current_balance,json=currentBalance,proto3" json:"current_balance,omitempty"`
+	// Optional. Credit limit amount in cents (applicable only if CREDIT_CARD type).
+	CreditLimit int64 `protobuf:"varint,8,opt,name=credit_limit,json=creditLimit,proto3" json:"credit_limit,omitempty"`
+	// Optional. Indicates if the account is the workspace default. Default accounts receive
+	// transactions if no explicit account is nominated.
+	IsDefault bool `protobuf:"varint,9,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+	// Optional. Indicates if the account is active.
+	IsActive bool `protobuf:"varint,10,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	// Optional. Color HEX code for visual UI identification.
+	Color string `protobuf:"bytes,11,opt,name=color,proto3" json:"color,omitempty"`
+	// Optional. Narration notes.
+	Notes string `protobuf:"bytes,12,opt,name=notes,proto3" json:"notes,omitempty"`
+	// Optional. Last four digits of card or account number.
+	LastFour string `protobuf:"bytes,13,opt,name=last_four,json=lastFour,proto3" json:"last_four,omitempty"`
+	// Output only. Creation timestamp.
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// Output only. Last update timestamp.
+	UpdateTime *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	// Output only. Currency conversion statistics.
+	Conversion *Account_Conversion `protobuf:"bytes,16,opt,name=conversion,proto3" json:"conversion,omitempty"`
+	// Optional. Day of the month the card statement closes (1-31, clamped to short months).
+	// Zero disables statements. Applicable only if CREDIT_CARD type.
+	StatementClosingDay int32 `protobuf:"varint,17,opt,name=statement_closing_day,json=statementClosingDay,proto3" json:"statement_closing_day,omitempty"`
+	// Optional. Day of the month the statement payment is due, after the closing day.
+	// Required when `statement_closing_day` is set.
+	PaymentDueDay int32 `protobuf:"varint,18,opt,name=payment_due_day,json=paymentDueDay,proto3" json:"payment_due_day,omitempty"`
+	// Optional. Minimum payment as basis points of the statement balance (e.g. 300 for 3%).
+	MinimumPaymentBps int32 `protobuf:"varint,19,opt,name=minimum_payment_bps,json=minimumPaymentBps,proto3" json:"minimum_payment_bps,omitempty"`
+	// Optional. Lowest minimum payment in cents.
+	MinimumPaymentFloor int64 `protobuf:"varint,20,opt,name=minimum_payment_floor,json=minimumPaymentFloor,proto3" json:"minimum_payment_floor,omitempty"`
+	// Optional. Budget the scheduled statement payment is drawn from.
+	// Required when `statement_closing_day` is set.
+	PaymentBudgetId string `protobuf:"bytes,21,opt,name=payment_budget_id,json=paymentBudgetId,proto3" json:"payment_budget_id,omitempty"`
+	// Output only. Current balance as a percentage of the credit limit.
+	Utilization float64 `protobuf:"fixed64,22,opt,name=utilization,proto3" json:"utilization,omitempty"`
+	// Optional. Cash flow forecasts flag days where the balance, or the available credit of a
+	// credit card, drops below this amount in cents. Zero disables the check.
+	LowBalanceThreshold int64 `protobuf:"varint,23,opt,name=low_balance_threshold,json=lowBalanceThreshold,proto3" json:"low_balance_threshold,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *Account) Reset() {
+	*x = Account{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Account) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Account) ProtoMessage() {}
+
+func (x *Account) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Account.ProtoReflect.Descriptor instead.
+func (*Account) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *Account) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Account) GetSpaceId() string {
+	if x != nil {
+		return x.SpaceId
+	}
+	return ""
+}
+
+func (x *Account) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Account) GetType() Account_Type {
+	if x != nil {
+		return x.Type
+	}
+	return Account_TYPE_UNSPECIFIED
+}
+
+func (x *Account) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Account) GetInitialBalance() int64 {
+	if x != nil {
+		return x.InitialBalance
+	}
+	return 0
+}
+
+func (x *Account) GetCurrentBalance() int64 {
+	if x != nil {
+		return x.CurrentBalance
+	}
+	return 0
+}
+
+func (x *Account) GetCreditLimit() int64 {
+	if x != nil {
+		return x.CreditLimit
+	}
+	return 0
+}
+
+func (x *Account) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+func (x *Account) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *Account) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *Account) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *Account) GetLastFour() string {
+	if x != nil {
+		return x.LastFour
+	}
+	return ""
+}
+
+func (x *Account) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *Account) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+func (x *Account) GetConversion() *Account_Conversion {
+	if x != nil {
+		return x.Conversion
+	}
+	return nil
+}
+
+func (x *Account) GetStatementClosingDay() int32 {
+	if x != nil {
+		return x.StatementClosingDay
+	}
+	return 0
+}
+
+func (x *Account) GetPaymentDueDay() int32 {
+	if x != nil {
+		return x.PaymentDueDay
+	}
+	return 0
+}
+
+func (x *Account) GetMinimumPaymentBps() int32 {
+	if x != nil {
+		return x.MinimumPaymentBps
+	}
+	return 0
+}
+
+func (x *Account) GetMinimumPaymentFloor() int64 {
+	if x != nil {
+		return x.MinimumPaymentFloor
+	}
+	return 0
+}
+
+func (x *Account) GetPaymentBudgetId() string {
+	if x != nil {
+		return x.PaymentBudgetId
+	}
+	return ""
+}
+
+func (x *Account) GetUtilization() float64 {
+	if x != nil {
+		return x.Utilization
+	}
+	return 0
+}
+
+func (x *Account) GetLowBalanceThreshold() int64 {
+	if x != nil {
+		return x.LowBalanceThreshold
+	}
+	return 0
+}
+
+// The request for
+// [CreateAccount][saturn.finance.v1.Finance.CreateAccount].
+type CreateAccountRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. Account configuration parameters.
+	Account       *Account `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAccountRequest) Reset() {
+	*x = CreateAccountRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAccountRequest) ProtoMessage() {}
+
+func (x *CreateAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAccountRequest.ProtoReflect.Descriptor instead.
+func (*CreateAccountRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *CreateAccountRequest) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+// The request for
+// [GetAccount][saturn.finance.v1.Finance.GetAccount].
+type GetAccountRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. Target account identifier.
+	// Values are of the form `acc_[a-zA-Z0-9]+`.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Optional. Account view details level.
+	View          *Account_View `protobuf:"varint,2,opt,name=view,proto3,enum=saturn.finance.v1.Account_View,oneof" json:"view,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccountRequest) Reset() {
+	*x = GetAccountRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountRequest) ProtoMessage() {}
+
+func (x *GetAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountRequest.ProtoReflect.Descriptor instead.
+func (*GetAccountRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *GetAccountRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetAccountRequest) GetView() Account_View {
+	if x != nil && x.View != nil {
+		return *x.View
+	}
+	return Account_VIEW_UNSPECIFIED
+}
+
+// The request for
+// [UpdateAccount][saturn.finance.v1.Finance.UpdateAccount].
+type UpdateAccountRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. Target account identifier to update.
+	// Values are of the form `acc_[a-zA-Z0-9]+`.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Required. Updated account parameters.
+	Account       *Account `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAccountRequest) Reset() {
+	*x = UpdateAccountRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAccountRequest) ProtoMessage() {}
+
+func (x *UpdateAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAccountRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAccountRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *UpdateAccountRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateAccountRequest) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+// The request for
+// [AdjustAccountBalance][saturn.finance.v1.Finance.AdjustAccountBalance].
+type AdjustAccountBalanceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. Target account identifier to adjust balance for.
+	AccountId string `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// Required. Target balance in cents.
+	TargetBalance int64 `protobuf:"varint,2,opt,name=target_balance,json=targetBalance,proto3" json:"target_balance,omitempty"`
+	// Optional. ISO timestamp of the adjustment date.
+	AdjustmentDate *string `protobuf:"bytes,3,opt,name=adjustment_date,json=adjustmentDate,proto3,oneof" json:"adjustment_date,omitempty"`
+	// Optional. Reason or note for reconciliation.
+	Note          *string `protobuf:"bytes,4,opt,name=note,proto3,oneof" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdjustAccountBalanceRequest) Reset() {
+	*x = AdjustAccountBalanceRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdjustAccountBalanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdjustAccountBalanceRequest) ProtoMessage() {}
+
+func (x *AdjustAccountBalanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdjustAccountBalanceRequest.ProtoReflect.Descriptor instead.
+func (*AdjustAccountBalanceRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *AdjustAccountBalanceRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *AdjustAccountBalanceRequest) GetTargetBalance() int64 {
+	if x != nil {
+		return x.TargetBalance
+	}
+	return 0
+}
+
+func (x *AdjustAccountBalanceRequest) GetAdjustmentDate() string {
+	if x != nil && x.AdjustmentDate != nil {
+		return *x.AdjustmentDate
+	}
+	return ""
+}
+
+func (x *AdjustAccountBalanceRequest) GetNote() string {
+	if x != nil && x.Note != nil {
+		return *x.Note
+	}
+	return ""
+}
+
+// Reconciliation is a session matching an account's cleared transactions against a bank statement.
+type Reconciliation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Output only. Unique identifier.
+	// Values are of the form `rcn_[a-zA-Z0-9]+`.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Output only. Reconciled account identifier.
+	// Values are of the form `acc_[a-zA-Z0-9]+`.
+	AccountId string `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// Required. Statement end date. Transactions dated on or before this day are covered.
+	StatementDate *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=statement_date,json=statementDate,proto3" json:"statement_date,omitempty"`
+	// Required. Closing balance printed on the statement, in account currency cents.
+	StatementBalance int64 `protobuf:"varint,4,opt,name=statement_balance,json=statementBalance,proto3" json:"statement_balance,omitempty"`
+	// Output only. Statement balance of the previous finalized session, or the account
+	// initial balance for the first session.
+	OpeningBalance int64 `protobuf:"varint,5,opt,name=opening_balance,json=openingBalance,proto3" json:"opening_balance,omitempty"`
+	// Output only. Opening balance plus the impact of cleared transactions.
+	ClearedBalance int64 `protobuf:"varint,6,opt,name=cleared_balance,json=clearedBalance,proto3" json:"cleared_balance,omitempty"`
+	// Output only. Remaining difference between the statement and cleared balances.
+	Difference int64 `protobuf:"varint,7,opt,name=difference,proto3" json:"difference,omitempty"`
+	// Output only. Number of cleared transactions covered by the session.
+	ClearedCount int32 `protobuf:"varint,8,opt,name=cleared_count,json=clearedCount,proto3" json:"cleared_count,omitempty"`
+	// Output only. Balance adjustment booked to close a difference on finalization.
+	// Values are of the form `txn_[a-zA-Z0-9]+`.
+	AdjustmentTransactionId *string `protobuf:"bytes,9,opt,name=adjustment_transaction_id,json=adjustmentTransactionId,proto3,oneof" json:"adjustment_transaction_id,omitempty"`
+	// Output only. Session status.
+	Status Reconciliation_Status `protobuf:"varint,10,opt,name=status,proto3,enum=saturn.finance.v1.Reconciliation_Status" json:"status,omitempty"`
+	// Optional. Narration notes.
+	Note string `protobuf:"bytes,11,opt,name=note,proto3" json:"note,omitempty"`
+	// Output only. Finalization timestamp.
+	FinalizeTime *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=finalize_time,json=finalizeTime,proto3" json:"finalize_time,omitempty"`
+	// Output only. Creation timestamp.
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// Output only. Last update timestamp.
+	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Reconciliation) Reset() {
+	*x = Reconciliation{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Reconciliation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reconciliation) ProtoMessage() {}
+
+func (x *Reconciliation) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reconciliation.ProtoReflect.Descriptor instead.
+func (*Reconciliation) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *Reconciliation) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Reconciliation) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *Reconciliation) GetStatementDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StatementDate
+	}
+	return nil
+}
+
+func (x *Reconciliation) GetStatementBalance() int64 {
+	if x != nil {
+		return x.StatementBalance
+	}
+	return 0
+}
+
+func (x *Reconciliation) GetOpeningBalance() int64 {
+	if x != nil {
+		return x.OpeningBalance
+	}
+	return 0
+}
+
+func (x *Reconciliation) GetClearedBalance() int64 {
+	if x != nil {
+		return x.ClearedBalance
+	}
+	return 0
+}
+
+func (x *Reconciliation) GetDifference() int64 {
+	if x != nil {
+		return x.Difference
+	}
+	return 0
+}
+
+func (x *Reconciliation) GetClearedCount() int32 {
+	if x != nil {
+		return x.ClearedCount
+	}
+	return 0
+}
+
+func (x *Reconciliation) GetAdjustmentTransactionId() string {
+	if x != nil && x.AdjustmentTransactionId != nil {
+		return *x.AdjustmentTransactionId
+	}
+	return ""
+}
+
+func (x *Reconciliation) GetStatus() Reconciliation_Status {
+	if x != nil {
+		return x.Status
+	}
+	return Reconciliation_STATUS_UNSPECIFIED
+}
+
+func (x *Reconciliation) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *Reconciliation) GetFinalizeTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinalizeTime
+	}
+	return nil
+}
+
+func (x *Reconciliation) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *Reconciliation) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+// The request for
+// [StartReconciliation][saturn.finance.v1.Finance.StartReconciliation].
+type StartReconciliationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. Account to reconcile.
+	// Values are of the form `acc_[a-zA-Z0-9]+`.
+	AccountId string `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// Required. Statement details.
+	Reconciliation *Reconciliation `protobuf:"bytes,2,opt,name=reconciliation,proto3" json:"reconciliation,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *StartReconciliationRequest) Reset() {
+	*x = StartReconciliationRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartReconciliationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartReconciliationRequest) ProtoMessage() {}
+
+func (x *StartReconciliationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartReconciliationRequest.ProtoReflect.Descriptor instead.
+func (*StartReconciliationRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *StartReconciliationRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *StartReconciliationRequest) GetReconciliation() *Reconciliation {
+	if x != nil {
+		return x.Reconciliation
+	}
+	return nil
+}
+
+// The request for
+// [GetReconciliation][saturn.finance.v1.Finance.GetReconciliation].
+type GetReconciliationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. Reconciliation identifier.
+	// Values are of the form `rcn_[a-zA-Z0-9]+`.
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReconciliationRequest) Reset() {
+	*x = GetReconciliationRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReconciliationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReconciliationRequest) ProtoMessage() {}
+
+func (x *GetReconciliationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReconciliationRequest.ProtoReflect.Descriptor instead.
+func (*GetReconciliationRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *GetReconciliationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// The request for
+// [ListReconciliations][saturn.finance.v1.Finance.ListReconciliations].
+type ListReconciliationsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. Account identifier.
+	// Values are of the form `acc_[a-zA-Z0-9]+`.
+	AccountId     string `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReconciliationsRequest) Reset() {
+	*x = ListReconciliationsRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReconciliationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReconciliationsRequest) ProtoMessage() {}
+
+func (x *ListReconciliationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[131]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReconciliationsRequest.ProtoReflect.Descriptor instead.
+func (*ListReconciliationsRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *ListReconciliationsRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+// The response for
+// [ListReconciliations][saturn.finance.v1.Finance.ListReconciliations].
+type ListReconciliationsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Reconciliation sessions, most recent statement first.
+	Reconciliations []*Reconciliation `protobuf:"bytes,1,rep,name=reconciliations,proto3" json:"reconciliations,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListReconciliationsResponse) Reset() {
+	*x = ListReconciliationsResponse{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[132]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReconciliationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReconciliationsResponse) ProtoMessage() {}
+
+func (x *ListReconciliationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[132]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReconciliationsResponse.ProtoReflect.Descriptor instead.
+func (*ListReconciliationsResponse) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *ListReconciliationsResponse) GetReconciliations() []*Reconciliation {
+	if x != nil {
+		return x.Reconciliations
+	}
+	return nil
+}
+
+// The request for
+// [ListReconciliationTransactions][saturn.finance.v1.Finance.ListReconciliationTransactions].
+type ListReconciliationTransactionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. Reconciliation identifier.
+	// Values are of the form `rcn_[a-zA-Z0-9]+`.
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReconciliationTransactionsRequest) Reset() {
+	*x = ListReconciliationTransactionsRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReconciliationTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReconciliationTransactionsRequest) ProtoMessage() {}
+
+func (x *ListReconciliationTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[133]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReconciliationTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*ListReconciliationTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *ListReconciliationTransactionsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// The response for
+// [ListReconciliationTransactions][saturn.finance.v1.Finance.ListReconciliationTransactions].
+type ListReconciliationTransactionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unreconciled transactions up to the statement date for sessions in progress,
+	// or the locked transactions of a finalized session.
+	Transactions  []*Transaction `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReconciliationTransactionsResponse) Reset() {
+	*x = ListReconciliationTransactionsResponse{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[134]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReconciliationTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReconciliationTransactionsResponse) ProtoMessage() {}
+
+func (x *ListReconciliationTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[134]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReconciliationTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*ListReconciliationTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *ListReconciliationTransactionsResponse) GetTransactions() []*Transaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+// The request for
+// [SetTransactionsCleared][saturn.finance.v1.Finance.SetTransactionsCleared].
+type SetTransactionsClearedRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. Reconciliation identifier.
+	// Values are of the form `rcn_[a-zA-Z0-9]+`.
+	ReconciliationId string `protobuf:"bytes,1,opt,name=reconciliation_id,json=reconciliationId,proto3" json:"reconciliation_id,omitempty"`
+	// Required. Transactions to update. They must belong to the reconciled account.
+	TransactionIds []string `protobuf:"bytes,2,rep,name=transaction_ids,json=transactionIds,proto3" json:"transaction_ids,omitempty"`
+	// Required. Whether the transactions are cleared.
+	Cleared       bool `protobuf:"varint,3,opt,name=cleared,proto3" json:"cleared,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTransactionsClearedRequest) Reset() {
+	*x = SetTransactionsClearedRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[135]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTransactionsClearedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTransactionsClearedRequest) ProtoMessage() {}
+
+func (x *SetTransactionsClearedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[135]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTransactionsClearedRequest.ProtoReflect.Descriptor instead.
+func (*SetTransactionsClearedRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *SetTransactionsClearedRequest) GetReconciliationId() string {
+	if x != nil {
+		return x.ReconciliationId
+	}
+	return ""
+}
+
+func (x *SetTransactionsClearedRequest) GetTransactionIds() []string {
+	if x != nil {
+		return x.TransactionIds
+	}
+	return nil
+}
+
+func (x *SetTransactionsClearedRequest) GetCleared() bool {
+	if x != nil {
+		return x.Cleared
+	}
+	return false
+}
+
+// The request for
+// [FinalizeReconciliation][saturn.finance.v1.Finance.FinalizeReconciliation].
+type FinalizeReconciliationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. Reconciliation identifier.
+	// Values are of the form `rcn_[a-zA-Z0-9]+`.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Optional. Books a remaining difference as a balance adjustment dated on the
+	// statement date instead of rejecting the finalization.
+	CreateAdjustment bool `protobuf:"varint,2,opt,name=create_adjustment,json=createAdjustment,proto3" json:"create_adjustment,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *FinalizeReconciliationRequest) Reset() {
+	*x = FinalizeReconciliationRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FinalizeReconciliationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinalizeReconciliationRequest) ProtoMessage() {}
+
+func (x *FinalizeReconciliationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[136]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinalizeReconciliationRequest.ProtoReflect.Descriptor instead.
+func (*FinalizeReconciliationRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *FinalizeReconciliationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *FinalizeReconciliationRequest) GetCreateAdjustment() bool {
+	if x != nil {
+		return x.CreateAdjustment
+	}
+	return false
+}
+
+// The request for
+// [CancelReconciliation][saturn.finance.v1.Finance.CancelReconciliation].
+type CancelReconciliationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. Reconciliation identifier.
+	// Values are of the form `rcn_[a-zA-Z0-9]+`.
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelReconciliationRequest) Reset() {
+	*x = CancelReconciliationRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[137]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelReconciliationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelReconciliationRequest) ProtoMessage() {}
+
+func (x *CancelReconciliationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[137]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelReconciliationRequest.ProtoReflect.Descriptor instead.
+func (*CancelReconciliationRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *CancelReconciliationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// The request for
+// [DeleteAccount][saturn.finance.v1.Finance.DeleteAccount].
+type DeleteAccountRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. Target account identifier to delete.
+	// Values are of the form `acc_[a-zA-Z0-9]+`.
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAccountRequest) Reset() {
+	*x = DeleteAccountRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[138]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAccountRequest) ProtoMessage() {}
+
+func (x *DeleteAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[138]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAccountRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAccountRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *DeleteAccountRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// The request for
+// [ListAccounts][saturn.finance.v1.Finance.ListAccounts].
+type ListAccountsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional. Account view details level.
+	View *Account_View `protobuf:"varint,1,opt,name=view,proto3,enum=saturn.finance.v1.Account_View,oneof" json:"view,omitempty"`
+	// Optional. Filter only active accounts.
+	ActiveOnly *bool `protobuf:"varint,2,opt,name=active_only,json=activeOnly,proto3,oneof" json:"active_only,omitempty"`
+	// Optional. Search text filter matching account name or notes.
+	SearchQuery *string `protobuf:"bytes,3,opt,name=search_query,json=searchQuery,proto3,oneof" json:"search_query,omitempty"`
+	// Optional. Maximum number of items to return in a single page.
+	PageSize *int32 `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	// Optional. Keyset page token returned by a previous call.
+	PageToken *string `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3,oneof" json:"page_token,omitempty"`
+	// Optional. Sort order string specifying column and optional ordering suffix.
+	Sort          *string `protobuf:"bytes,6,opt,name=sort,proto3,oneof" json:"sort,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccountsRequest) Reset() {
+	*x = ListAccountsRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[139]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccountsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccountsRequest) ProtoMessage() {}
+
+func (x *ListAccountsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[139]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccountsRequest.ProtoReflect.Descriptor instead.
+func (*ListAccountsRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *ListAccountsRequest) GetView() Account_View {
+	if x != nil && x.View != nil {
+		return *x.View
+	}
+	return Account_VIEW_UNSPECIFIED
+}
+
+func (x *ListAccountsRequest) GetActiveOnly() bool {
+	if x != nil && x.ActiveOnly != nil {
+		return *x.ActiveOnly
+	}
+	return false
+}
+
+func (x *ListAccountsRequest) GetSearchQuery() string {
+	if x != nil && x.SearchQuery != nil {
+		return *x.SearchQuery
+	}
+	return ""
+}
+
+func (x *ListAccountsRequest) GetPageSize() int32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+func (x *ListAccountsRequest) GetPageToken() string {
+	if x != nil && x.PageToken != nil {
+		return *x.PageToken
+	}
+	return ""
+}
+
+func (x *ListAccountsRequest) GetSort() string {
+	if x != nil && x.Sort != nil {
+		return *x.Sort
+	}
+	return ""
+}
+
+// The response for
+// [ListAccounts][saturn.finance.v1.Finance.ListAccounts].
+type ListAccountsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// List of accounts matching filters.
+	Accounts []*Account `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	// Next page keyset token. Empty if no more pages are available.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccountsResponse) Reset() {
+	*x = ListAccountsResponse{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[140]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccountsResponse) ProtoMessage() {}
+
+func (x *ListAccountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[140]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccountsResponse.ProtoReflect.Descriptor instead.
+func (*ListAccountsResponse) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *ListAccountsResponse) GetAccounts() []*Account {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+func (x *ListAccountsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Transfer represents a new transfer between accounts.
+type Transfer struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Output only. Unique identifier.
+	// Values are of the form `txn_[a-zA-Z0-9]+`.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Output only. Space identifier.
+	SpaceId string `protobuf:"bytes,2,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	// Required. Source account ID.
+	// Values are of the form `acc_[a-zA-Z0-9]+`.
+	SourceAccountId string `protobuf:"bytes,3,opt,name=source_account_id,json=sourceAccountId,proto3" json:"source_account_id,omitempty"`
+	// Required. Destination account ID.
+	// Values are of the form `acc_[a-zA-Z0-9]+`.
+	DestinationAccountId string `protobuf:"bytes,4,opt,name=destination_account_id,json=destinationAccountId,proto3" json:"destination_account_id,omitempty"`
+	// Required. Source amount in source account currency cents.
+	SourceAmount int64 `protobuf:"varint,5,opt,name=source_amount,json=sourceAmount,proto3" json:"source_amount,omitempty"`
+	// Required. Destination amount in destination account currency cents.
+	DestinationAmount int64 `protobuf:"varint,6,opt,name=destination_amount,json=destinationAmount,proto3" json:"destination_amount,omitempty"`
+	// Required. Transfer date.
+	TransferDate *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=transfer_date,json=transferDate,proto3" json:"transfer_date,omitempty"`
+	// Optional. Narration notes.
+	Notes string `protobuf:"bytes,8,opt,name=notes,proto3" json:"notes,omitempty"`
+	// Output only. Creation timestamp.
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// Output only. Last update timestamp.
+	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Transfer) Reset() {
+	*x = Transfer{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[141]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Transfer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Transfer) ProtoMessage() {}
+
+func (x *Transfer) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[141]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Transfer.ProtoReflect.Descriptor instead.
+func (*Transfer) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *Transfer) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Transfer) GetSpaceId() string {
+	if x != nil {
+		return x.SpaceId
+	}
+	return ""
+}
+
+func (x *Transfer) GetSourceAccountId() string {
+	if x != nil {
+		return x.SourceAccountId
+	}
+	return ""
+}
+
+func (x *Transfer) GetDestinationAccountId() string {
+	if x != nil {
+		return x.DestinationAccountId
+	}
+	return ""
+}
+
+func (x *Transfer) GetSourceAmount() int64 {
+	if x != nil {
+		return x.SourceAmount
+	}
+	return 0
+}
+
+func (x *Transfer) GetDestinationAmount() int64 {
+	if x != nil {
+		return x.DestinationAmount
+	}
+	return 0
+}
+
+func (x *Transfer) GetTransferDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TransferDate
+	}
+	return nil
+}
+
+func (x *Transfer) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *Transfer) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *Transfer) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+// The request for
+// [CreateTransfer][saturn.finance.v1.Finance.CreateTransfer].
+type CreateTransferRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. Source account ID.
+	// Values are of the form `acc_[a-zA-Z0-9]+`.
+	SourceAccountId string `protobuf:"bytes,1,opt,name=source_account_id,json=sourceAccountId,proto3" json:"source_account_id,omitempty"`
+	// Required. Destination account ID.
+	// Values are of the form `acc_[a-zA-Z0-9]+`.
+	DestinationAccountId string `protobuf:"bytes,2,opt,name=destination_account_id,json=destinationAccountId,proto3" json:"destination_account_id,omitempty"`
+	// Required. Source amount in source account currency cents.
+	SourceAmount int64 `protobuf:"varint,3,opt,name=source_amount,json=sourceAmount,proto3" json:"source_amount,omitempty"`
+	// Required. Destination amount in destination account currency cents.
+	DestinationAmount int64 `protobuf:"varint,4,opt,name=destination_amount,json=destinationAmount,proto3" json:"destination_amount,omitempty"`
+	// Required. Transfer date.
+	TransferDate *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=transfer_date,json=transferDate,proto3" json:"transfer_date,omitempty"`
+	// Optional. Narration notes.
+	Notes         string `protobuf:"bytes,6,opt,name=notes,proto3" json:"notes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTransferRequest) Reset() {
+	*x = CreateTransferRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[142]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTransferRequest) ProtoMessage() {}
+
+func (x *CreateTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[142]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTransferRequest.ProtoReflect.Descriptor instead.
+func (*CreateTransferRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *CreateTransferRequest) GetSourceAccountId() string {
+	if x != nil {
+		return x.SourceAccountId
+	}
+	return ""
+}
+
+func (x *CreateTransferRequest) GetDestinationAccountId() string {
+	if x != nil {
+		return x.DestinationAccountId
+	}
+	return ""
+}
+
+func (x *CreateTransferRequest) GetSourceAmount() int64 {
+	if x != nil {
+		return x.SourceAmount
+	}
+	return 0
+}
+
+func (x *CreateTransferRequest) GetDestinationAmount() int64 {
+	if x != nil {
+		return x.DestinationAmount
+	}
+	return 0
+}
+
+func (x *CreateTransferRequest) GetTransferDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TransferDate
+	}
+	return nil
+}
+
+func (x *CreateTransferRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+// The request for
+// [ListTransfers][saturn.finance.v1.Finance.ListTransfers].
+type ListTransfersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional. Maximum number of items to return.
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Optional. Keyset page token.
+	PageToken     string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTransfersRequest) Reset() {
+	*x = ListTransfersRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[143]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTransfersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTransfersRequest) ProtoMessage() {}
+
+func (x *ListTransfersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[143]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTransfersRequest.ProtoReflect.Descriptor instead.
+func (*ListTransfersRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *ListTransfersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListTransfersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// The response for
+// [ListTransfers][saturn.finance.v1.Finance.ListTransfers].
+type ListTransfersResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// List of transfer records.
+	Transfers []*Transfer `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers,omitempty"`
+	// Next page keyset token. Empty if no more pages are available.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTransfersResponse) Reset() {
+	*x = ListTransfersResponse{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[144]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTransfersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTransfersResponse) ProtoMessage() {}
+
+func (x *ListTransfersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[144]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTransfersResponse.ProtoReflect.Descriptor instead.
+func (*ListTransfersResponse) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *ListTransfersResponse) GetTransfers() []*Transfer {
+	if x != nil {
+		return x.Transfers
+	}
 	return nil
 }
 
-func (x *Borrowing) GetDueAt() *timestamppb.Timestamp {
+func (x *ListTransfersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// The request for
+// [ListTransactionEvents][saturn.finance.v1.Finance.ListTransactionEvents].
+type ListTransactionEventsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. Target transaction ID.
+	// Values are of the form `txn_[a-zA-Z0-9]+`.
+	TxnId         string `protobuf:"bytes,1,opt,name=txn_id,json=txnId,proto3" json:"txn_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTransactionEventsRequest) Reset() {
+	*x = ListTransactionEventsRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[145]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTransactionEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTransactionEventsRequest) ProtoMessage() {}
+
+func (x *ListTransactionEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[145]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTransactionEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListTransactionEventsRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *ListTransactionEventsRequest) GetTxnId() string {
+	if x != nil {
+		return x.TxnId
+	}
+	return ""
+}
+
+// TransactionEvent represents a historical transaction lifecycle change.
+type TransactionEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Output only. Unique identifier.
+	// Values are of the form `evt_[a-zA-Z0-9]+`.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Output only. Space identifier.
+	SpaceId string `protobuf:"bytes,2,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	// Required. Associated transaction ID.
+	// Values are of the form `txn_[a-zA-Z0-9]+`.
+	TxnId string `protobuf:"bytes,3,opt,name=txn_id,json=txnId,proto3" json:"txn_id,omitempty"`
+	// Required. Lifecycle change event type (e.g. "created", "updated").
+	EventType string `protobuf:"bytes,4,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	// Required. JSON metadata describing the change details (e.g. diff properties).
+	Metadata string `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// Output only. Event registration timestamp.
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransactionEvent) Reset() {
+	*x = TransactionEvent{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[146]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionEvent) ProtoMessage() {}
+
+func (x *TransactionEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[146]
 	if x != nil {
-		return x.DueAt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Borrowing) GetCreateAsTransaction() bool {
+// Deprecated: Use TransactionEvent.ProtoReflect.Descriptor instead.
+func (*TransactionEvent) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *TransactionEvent) GetId() string {
 	if x != nil {
-		return x.CreateAsTransaction
+		return x.Id
 	}
-	return false
+	return ""
 }
 
-func (x *Borrowing) GetNotes() string {
+func (x *TransactionEvent) GetSpaceId() string {
 	if x != nil {
-		return x.Notes
+		return x.SpaceId
 	}
 	return ""
 }
 
-func (x *Borrowing) GetCreateTime() *timestamppb.Timestamp {
+func (x *TransactionEvent) GetTxnId() string {
+	if x != nil {
+		return x.TxnId
+	}
+	return ""
+}
+
+func (x *TransactionEvent) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *TransactionEvent) GetMetadata() string {
+	if x != nil {
+		return x.Metadata
+	}
+	return ""
+}
+
+func (x *TransactionEvent) GetCreateTime() *timestamppb.Timestamp {
 	if x != nil {
 		return x.CreateTime
 	}
 	return nil
 }
 
-func (x *Borrowing) GetUpdateTime() *timestamppb.Timestamp {
+// The response for
+// [ListTransactionEvents][saturn.finance.v1.Finance.ListTransactionEvents].
+type ListTransactionEventsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// List of transaction lifecycle history events.
+	Events        []*TransactionEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTransactionEventsResponse) Reset() {
+	*x = ListTransactionEventsResponse{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[147]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTransactionEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTransactionEventsResponse) ProtoMessage() {}
+
+func (x *ListTransactionEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[147]
 	if x != nil {
-		return x.UpdateTime
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Borrowing) GetAccountId() string {
-	if x != nil && x.AccountId != nil {
-		return *x.AccountId
+// Deprecated: Use ListTransactionEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListTransactionEventsResponse) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *ListTransactionEventsResponse) GetEvents() []*TransactionEvent {
+	if x != nil {
+		return x.Events
 	}
-	return ""
+	return nil
 }
 
-// BorrowingRepayment represents an installment payment towards a borrowing.
-type BorrowingRepayment struct {
+// InboxItem represents an ingested invoice, receipt, or notification in processing staging.
+type InboxItem struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Output only. Unique identifier.
-	// Values are of the form `rep_[a-zA-Z0-9]+`.
+	// Values are of the form `inb_[a-zA-Z0-9]+`.
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Required. Parent borrowing identifier.
-	// Values are of the form `bor_[a-zA-Z0-9]+`.
-	BorrowingId string `protobuf:"bytes,2,opt,name=borrowing_id,json=borrowingId,proto3" json:"borrowing_id,omitempty"`
 	// Output only. Space identifier.
-	SpaceId string `protobuf:"bytes,3,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
-	// Required. Installment amount in cents.
-	Amount int64 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
-	// Required. Installment payment date.
-	PaymentDate *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=payment_date,json=paymentDate,proto3" json:"payment_date,omitempty"`
-	// Optional. Narration notes.
-	Notes string `protobuf:"bytes,6,opt,name=notes,proto3" json:"notes,omitempty"`
-	// Output only. Creation timestamp.
-	CreateTime *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
-	// Output only. Last update timestamp.
-	UpdateTime *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
-	// Required. Source account identifier.
+	SpaceId string `protobuf:"bytes,2,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	// Output only. Ingestion integration channel identifier.
+	IntegrationId string `protobuf:"bytes,3,opt,name=integration_id,json=integrationId,proto3" json:"integration_id,omitempty"`
+	// Output only. Staging lifecycle status.
+	Status InboxItem_Status `protobuf:"varint,4,opt,name=status,proto3,enum=saturn.finance.v1.InboxItem_Status" json:"status,omitempty"`
+	// Required. Extracted document classification category.
+	DocType InboxItem_DocType `protobuf:"varint,5,opt,name=doc_type,json=docType,proto3,enum=saturn.finance.v1.InboxItem_DocType" json:"doc_type,omitempty"`
+	// Required. Extracted transaction amount in cents.
+	Amount int64 `protobuf:"varint,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Required. Extracted currency code.
+	Currency string `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"`
+	// Required. Extracted vendor counterparty name.
+	VendorName string `protobuf:"bytes,8,opt,name=vendor_name,json=vendorName,proto3" json:"vendor_name,omitempty"`
+	// Required. Extracted transaction transaction date.
+	TransactionDate *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=transaction_date,json=transactionDate,proto3" json:"transaction_date,omitempty"`
+	// Optional. Suggested account ID for categorization.
 	// Values are of the form `acc_[a-zA-Z0-9]+`.
-	AccountId     string `protobuf:"bytes,9,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	AccountId string `protobuf:"bytes,10,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// Optional. Suggested budget category ID.
+	// Values are of the form `bud_[a-zA-Z0-9]+`.
+	BudgetId string `protobuf:"bytes,11,opt,name=budget_id,json=budgetId,proto3" json:"budget_id,omitempty"`
+	// Optional. Associated scheduled payment identifier to clear.
+	// Values are of the form `sch_[a-zA-Z0-9]+`.
+	ScheduledPaymentId string `protobuf:"bytes,12,opt,name=scheduled_payment_id,json=scheduledPaymentId,proto3" json:"scheduled_payment_id,omitempty"`
+	// Optional. Associated completed transaction ledger ID.
+	// Values are of the form `txn_[a-zA-Z0-9]+`.
+	TransactionId string `protobuf:"bytes,13,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	// Output only. Ingestion raw notification payload details.
+	RawPayload string `protobuf:"bytes,14,opt,name=raw_payload,json=rawPayload,proto3" json:"raw_payload,omitempty"`
+	// Optional. Additional extracted metadata payload key-value pairs.
+	Metadata map[string]string `protobuf:"bytes,15,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Output only. Ingestion stage timestamp.
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// Optional. Associated borrowing/lending agreement identifier.
+	// Values are of the form `bor_[a-zA-Z0-9]+`.
+	BorrowingId *string `protobuf:"bytes,17,opt,name=borrowing_id,json=borrowingId,proto3,oneof" json:"borrowing_id,omitempty"`
+	// Optional. Relationship classification for borrowing link (e.g., initial receipt, repayment, additional loan).
+	BorrowingLinkType *BorrowingLinkType `protobuf:"varint,18,opt,name=borrowing_link_type,json=borrowingLinkType,proto3,enum=saturn.finance.v1.BorrowingLinkType,oneof" json:"borrowing_link_type,omitempty"`
+	// Optional. Suggested split lines. When present, approving the item creates
+	// a split expense instead of charging a single budget.
+	Splits []*TransactionSplit `protobuf:"bytes,19,rep,name=splits,proto3" json:"splits,omitempty"`
+	// Output only. Origin of the item, e.g. `integration` or `statement_ofx`.
+	Source string `protobuf:"bytes,20,opt,name=source,proto3" json:"source,omitempty"`
+	// Output only. Stable identifier of the statement row the item was imported from.
+	ExternalId *string `protobuf:"bytes,21,opt,name=external_id,json=externalId,proto3,oneof" json:"external_id,omitempty"`
+	// Optional. Payee matched by the space's rules and payees.
+	// Values are of the form `pye_[a-zA-Z0-9]+`.
+	PayeeId       *string `protobuf:"bytes,22,opt,name=payee_id,json=payeeId,proto3,oneof" json:"payee_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BorrowingRepayment) Reset() {
-	*x = BorrowingRepayment{}
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[45]
+func (x *InboxItem) Reset() {
+	*x = InboxItem{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[148]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BorrowingRepayment) String() string {
+func (x *InboxItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BorrowingRepayment) ProtoMessage() {}
+func (*InboxItem) ProtoMessage() {}
 
-func (x *BorrowingRepayment) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[45]
+func (x *InboxItem) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[148]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4757,147 +13368,202 @@ func (x *BorrowingRepayment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BorrowingRepayment.ProtoReflect.Descriptor instead.
-func (*BorrowingRepayment) Descriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use InboxItem.ProtoReflect.Descriptor instead.
+func (*InboxItem) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{148}
 }
 
-func (x *BorrowingRepayment) GetId() string {
+func (x *InboxItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *InboxItem) GetSpaceId() string {
+	if x != nil {
+		return x.SpaceId
+	}
+	return ""
+}
+
+func (x *InboxItem) GetIntegrationId() string {
+	if x != nil {
+		return x.IntegrationId
+	}
+	return ""
+}
+
+func (x *InboxItem) GetStatus() InboxItem_Status {
+	if x != nil {
+		return x.Status
+	}
+	return InboxItem_STATUS_UNSPECIFIED
+}
+
+func (x *InboxItem) GetDocType() InboxItem_DocType {
+	if x != nil {
+		return x.DocType
+	}
+	return InboxItem_DOC_TYPE_UNSPECIFIED
+}
+
+func (x *InboxItem) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *InboxItem) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *InboxItem) GetVendorName() string {
+	if x != nil {
+		return x.VendorName
+	}
+	return ""
+}
+
+func (x *InboxItem) GetTransactionDate() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Id
+		return x.TransactionDate
 	}
-	return ""
+	return nil
 }
 
-func (x *BorrowingRepayment) GetBorrowingId() string {
+func (x *InboxItem) GetAccountId() string {
 	if x != nil {
-		return x.BorrowingId
+		return x.AccountId
 	}
 	return ""
 }
 
-func (x *BorrowingRepayment) GetSpaceId() string {
+func (x *InboxItem) GetBudgetId() string {
 	if x != nil {
-		return x.SpaceId
+		return x.BudgetId
 	}
 	return ""
 }
 
-func (x *BorrowingRepayment) GetAmount() int64 {
+func (x *InboxItem) GetScheduledPaymentId() string {
 	if x != nil {
-		return x.Amount
+		return x.ScheduledPaymentId
 	}
-	return 0
+	return ""
 }
 
-func (x *BorrowingRepayment) GetPaymentDate() *timestamppb.Timestamp {
+func (x *InboxItem) GetTransactionId() string {
 	if x != nil {
-		return x.PaymentDate
+		return x.TransactionId
 	}
-	return nil
+	return ""
 }
 
-func (x *BorrowingRepayment) GetNotes() string {
+func (x *InboxItem) GetRawPayload() string {
 	if x != nil {
-		return x.Notes
+		return x.RawPayload
 	}
 	return ""
 }
 
-func (x *BorrowingRepayment) GetCreateTime() *timestamppb.Timestamp {
+func (x *InboxItem) GetMetadata() map[string]string {
 	if x != nil {
-		return x.CreateTime
+		return x.Metadata
 	}
 	return nil
 }
 
-func (x *BorrowingRepayment) GetUpdateTime() *timestamppb.Timestamp {
+func (x *InboxItem) GetCreateTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.UpdateTime
+		return x.CreateTime
 	}
 	return nil
 }
 
-func (x *BorrowingRepayment) GetAccountId() string {
-	if x != nil {
-		return x.AccountId
+func (x *InboxItem) GetBorrowingId() string {
+	if x != nil && x.BorrowingId != nil {
+		return *x.BorrowingId
 	}
 	return ""
 }
 
-// The request for
-// [CreateBorrowing][saturn.finance.v1.Finance.CreateBorrowing].
-type CreateBorrowingRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. Target borrowing parameters.
-	Borrowing     *Borrowing `protobuf:"bytes,1,opt,name=borrowing,proto3" json:"borrowing,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *CreateBorrowingRequest) Reset() {
-	*x = CreateBorrowingRequest{}
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[46]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *InboxItem) GetBorrowingLinkType() BorrowingLinkType {
+	if x != nil && x.BorrowingLinkType != nil {
+		return *x.BorrowingLinkType
+	}
+	return BorrowingLinkType_BORROWING_LINK_TYPE_UNSPECIFIED
 }
 
-func (x *CreateBorrowingRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *InboxItem) GetSplits() []*TransactionSplit {
+	if x != nil {
+		return x.Splits
+	}
+	return nil
 }
 
-func (*CreateBorrowingRequest) ProtoMessage() {}
-
-func (x *CreateBorrowingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[46]
+func (x *InboxItem) GetSource() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Source
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use CreateBorrowingRequest.ProtoReflect.Descriptor instead.
-func (*CreateBorrowingRequest) Descriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{46}
+func (x *InboxItem) GetExternalId() string {
+	if x != nil && x.ExternalId != nil {
+		return *x.ExternalId
+	}
+	return ""
 }
 
-func (x *CreateBorrowingRequest) GetBorrowing() *Borrowing {
-	if x != nil {
-		return x.Borrowing
+func (x *InboxItem) GetPayeeId() string {
+	if x != nil && x.PayeeId != nil {
+		return *x.PayeeId
 	}
-	return nil
+	return ""
 }
 
 // The request for
-// [GetBorrowing][saturn.finance.v1.Finance.GetBorrowing].
-type GetBorrowingRequest struct {
+// [ListInboxItems][saturn.finance.v1.Finance.ListInboxItems].
+type ListInboxItemsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. ID of the borrowing record to retrieve.
-	// Values are of the form `bor_[a-zA-Z0-9]+`.
-	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Optional. Number of items to retrieve per page.
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Optional. Cursor token from previous page response.
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Optional. Free-text search query matching vendor names or classifications.
+	SearchQuery *string `protobuf:"bytes,3,opt,name=search_query,json=searchQuery,proto3,oneof" json:"search_query,omitempty"`
+	// Optional. Stage status to filter by.
+	Status *InboxItem_Status `protobuf:"varint,4,opt,name=status,proto3,enum=saturn.finance.v1.InboxItem_Status,oneof" json:"status,omitempty"`
+	// Optional. Document category classification filter.
+	DocType *InboxItem_DocType `protobuf:"varint,5,opt,name=doc_type,json=docType,proto3,enum=saturn.finance.v1.InboxItem_DocType,oneof" json:"doc_type,omitempty"`
+	// Optional. Sorting rules (e.g. "create_time:desc").
+	Sort string `protobuf:"bytes,6,opt,name=sort,proto3" json:"sort,omitempty"`
+	// Optional. Representation view.
+	View          InboxItem_View `protobuf:"varint,7,opt,name=view,proto3,enum=saturn.finance.v1.InboxItem_View" json:"view,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetBorrowingRequest) Reset() {
-	*x = GetBorrowingRequest{}
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[47]
+func (x *ListInboxItemsRequest) Reset() {
+	*x = ListInboxItemsRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[149]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetBorrowingRequest) String() string {
+func (x *ListInboxItemsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBorrowingRequest) ProtoMessage() {}
+func (*ListInboxItemsRequest) ProtoMessage() {}
 
-func (x *GetBorrowingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[47]
+func (x *ListInboxItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[149]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4908,128 +13574,87 @@ func (x *GetBorrowingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBorrowingRequest.ProtoReflect.Descriptor instead.
-func (*GetBorrowingRequest) Descriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use ListInboxItemsRequest.ProtoReflect.Descriptor instead.
+func (*ListInboxItemsRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{149}
 }
 
-func (x *GetBorrowingRequest) GetId() string {
+func (x *ListInboxItemsRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.Id
+		return x.PageSize
 	}
-	return ""
-}
-
-// The request for
-// [ListBorrowings][saturn.finance.v1.Finance.ListBorrowings].
-type ListBorrowingsRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Optional. Filter by debt status.
-	Status *Borrowing_Status `protobuf:"varint,1,opt,name=status,proto3,enum=saturn.finance.v1.Borrowing_Status,oneof" json:"status,omitempty"`
-	// Optional. Filter by debt flow direction.
-	Direction *Borrowing_Direction `protobuf:"varint,2,opt,name=direction,proto3,enum=saturn.finance.v1.Borrowing_Direction,oneof" json:"direction,omitempty"`
-	// Optional. Maximum number of items to return.
-	PageSize *int32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
-	// Optional. Keyset page token.
-	PageToken *string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3,oneof" json:"page_token,omitempty"`
-	// Optional. Sort order for results.
-	OrderBy       *string `protobuf:"bytes,5,opt,name=order_by,json=orderBy,proto3,oneof" json:"order_by,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ListBorrowingsRequest) Reset() {
-	*x = ListBorrowingsRequest{}
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[48]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ListBorrowingsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return 0
 }
 
-func (*ListBorrowingsRequest) ProtoMessage() {}
-
-func (x *ListBorrowingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[48]
+func (x *ListInboxItemsRequest) GetPageToken() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.PageToken
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use ListBorrowingsRequest.ProtoReflect.Descriptor instead.
-func (*ListBorrowingsRequest) Descriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{48}
+func (x *ListInboxItemsRequest) GetSearchQuery() string {
+	if x != nil && x.SearchQuery != nil {
+		return *x.SearchQuery
+	}
+	return ""
 }
 
-func (x *ListBorrowingsRequest) GetStatus() Borrowing_Status {
+func (x *ListInboxItemsRequest) GetStatus() InboxItem_Status {
 	if x != nil && x.Status != nil {
 		return *x.Status
 	}
-	return Borrowing_STATUS_UNSPECIFIED
-}
-
-func (x *ListBorrowingsRequest) GetDirection() Borrowing_Direction {
-	if x != nil && x.Direction != nil {
-		return *x.Direction
-	}
-	return Borrowing_DIRECTION_UNSPECIFIED
+	return InboxItem_STATUS_UNSPECIFIED
 }
 
-func (x *ListBorrowingsRequest) GetPageSize() int32 {
-	if x != nil && x.PageSize != nil {
-		return *x.PageSize
+func (x *ListInboxItemsRequest) GetDocType() InboxItem_DocType {
+	if x != nil && x.DocType != nil {
+		return *x.DocType
 	}
-	return 0
+	return InboxItem_DOC_TYPE_UNSPECIFIED
 }
 
-func (x *ListBorrowingsRequest) GetPageToken() string {
-	if x != nil && x.PageToken != nil {
-		return *x.PageToken
+func (x *ListInboxItemsRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
 	}
 	return ""
 }
 
-func (x *ListBorrowingsRequest) GetOrderBy() string {
-	if x != nil && x.OrderBy != nil {
-		return *x.OrderBy
+func (x *ListInboxItemsRequest) GetView() InboxItem_View {
+	if x != nil {
+		return x.View
 	}
-	return ""
+	return InboxItem_VIEW_UNSPECIFIED
 }
 
 // The response for
-// [ListBorrowings][saturn.finance.v1.Finance.ListBorrowings].
-type ListBorrowingsResponse struct {
+// [ListInboxItems][saturn.finance.v1.Finance.ListInboxItems].
+type ListInboxItemsResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// List of borrowing records matching filters.
-	Borrowings []*Borrowing `protobuf:"bytes,1,rep,name=borrowings,proto3" json:"borrowings,omitempty"`
-	// Next page keyset token. Empty if no more pages are available.
+	// List of staged inbox items.
+	InboxItems []*InboxItem `protobuf:"bytes,1,rep,name=inbox_items,json=inboxItems,proto3" json:"inbox_items,omitempty"`
+	// Cursor page token for retrieving the next page.
 	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListBorrowingsResponse) Reset() {
-	*x = ListBorrowingsResponse{}
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[49]
+func (x *ListInboxItemsResponse) Reset() {
+	*x = ListInboxItemsResponse{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[150]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListBorrowingsResponse) String() string {
+func (x *ListInboxItemsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListBorrowingsResponse) ProtoMessage() {}
+func (*ListInboxItemsResponse) ProtoMessage() {}
 
-func (x *ListBorrowingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[49]
+func (x *ListInboxItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[150]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5040,19 +13665,19 @@ func (x *ListBorrowingsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListBorrowingsResponse.ProtoReflect.Descriptor instead.
-func (*ListBorrowingsResponse) Descriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use ListInboxItemsResponse.ProtoReflect.Descriptor instead.
+func (*ListInboxItemsResponse) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{150}
 }
 
-func (x *ListBorrowingsResponse) GetBorrowings() []*Borrowing {
+func (x *ListInboxItemsResponse) GetInboxItems() []*InboxItem {
 	if x != nil {
-		return x.Borrowings
+		return x.InboxItems
 	}
 	return nil
 }
 
-func (x *ListBorrowingsResponse) GetNextPageToken() string {
+func (x *ListInboxItemsResponse) GetNextPageToken() string {
 	if x != nil {
 		return x.NextPageToken
 	}
@@ -5060,33 +13685,33 @@ func (x *ListBorrowingsResponse) GetNextPageToken() string {
 }
 
 // The request for
-// [UpdateBorrowing][saturn.finance.v1.Finance.UpdateBorrowing].
-type UpdateBorrowingRequest struct {
+// [UpdateInboxItem][saturn.finance.v1.Finance.UpdateInboxItem].
+type UpdateInboxItemRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. Unique identifier of the borrowing record to update.
-	// Values are of the form `bor_[a-zA-Z0-9]+`.
+	// Required. Target staged inbox item identifier to update.
+	// Values are of the form `inb_[a-zA-Z0-9]+`.
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Required. Updated borrowing parameters.
-	Borrowing     *Borrowing `protobuf:"bytes,2,opt,name=borrowing,proto3" json:"borrowing,omitempty"`
+	// Required. Updated staging inbox item parameters.
+	InboxItem     *InboxItem `protobuf:"bytes,2,opt,name=inbox_item,json=inboxItem,proto3" json:"inbox_item,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateBorrowingRequest) Reset() {
-	*x = UpdateBorrowingRequest{}
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[50]
+func (x *UpdateInboxItemRequest) Reset() {
+	*x = UpdateInboxItemRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[151]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateBorrowingRequest) String() string {
+func (x *UpdateInboxItemRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateBorrowingRequest) ProtoMessage() {}
+func (*UpdateInboxItemRequest) ProtoMessage() {}
 
-func (x *UpdateBorrowingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[50]
+func (x *UpdateInboxItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[151]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5097,51 +13722,51 @@ func (x *UpdateBorrowingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateBorrowingRequest.ProtoReflect.Descriptor instead.
-func (*UpdateBorrowingRequest) Descriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{50}
+// Deprecated: Use UpdateInboxItemRequest.ProtoReflect.Descriptor instead.
+func (*UpdateInboxItemRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{151}
 }
 
-func (x *UpdateBorrowingRequest) GetId() string {
+func (x *UpdateInboxItemRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *UpdateBorrowingRequest) GetBorrowing() *Borrowing {
+func (x *UpdateInboxItemRequest) GetInboxItem() *InboxItem {
 	if x != nil {
-		return x.Borrowing
+		return x.InboxItem
 	}
 	return nil
 }
 
 // The request for
-// [DeleteBorrowing][saturn.finance.v1.Finance.DeleteBorrowing].
-type DeleteBorrowingRequest struct {
+// [ApproveInboxItem][saturn.finance.v1.Finance.ApproveInboxItem].
+type ApproveInboxItemRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. Unique identifier of the borrowing record to delete.
-	// Values are of the form `bor_[a-zA-Z0-9]+`.
+	// Required. Target staged inbox item identifier to approve.
+	// Values are of the form `inb_[a-zA-Z0-9]+`.
 	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteBorrowingRequest) Reset() {
-	*x = DeleteBorrowingRequest{}
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[51]
+func (x *ApproveInboxItemRequest) Reset() {
+	*x = ApproveInboxItemRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[152]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteBorrowingRequest) String() string {
+func (x *ApproveInboxItemRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteBorrowingRequest) ProtoMessage() {}
+func (*ApproveInboxItemRequest) ProtoMessage() {}
 
-func (x *DeleteBorrowingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[51]
+func (x *ApproveInboxItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[152]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5152,12 +13777,12 @@ func (x *DeleteBorrowingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteBorrowingRequest.ProtoReflect.Descriptor instead.
-func (*DeleteBorrowingRequest) Descriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{51}
+// Deprecated: Use ApproveInboxItemRequest.ProtoReflect.Descriptor instead.
+func (*ApproveInboxItemRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{152}
 }
 
-func (x *DeleteBorrowingRequest) GetId() string {
+func (x *ApproveInboxItemRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
@@ -5165,33 +13790,31 @@ func (x *DeleteBorrowingRequest) GetId() string {
 }
 
 // The request for
-// [CreateBorrowingRepayment][saturn.finance.v1.Finance.CreateBorrowingRepayment].
-type CreateBorrowingRepaymentRequest struct {
+// [DiscardInboxItem][saturn.finance.v1.Finance.DiscardInboxItem].
+type DiscardInboxItemRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. Target parent borrowing identifier.
-	// Values are of the form `bor_[a-zA-Z0-9]+`.
-	BorrowingId string `protobuf:"bytes,1,opt,name=borrowing_id,json=borrowingId,proto3" json:"borrowing_id,omitempty"`
-	// Required. Updated repayment parameters.
-	Repayment     *BorrowingRepayment `protobuf:"bytes,2,opt,name=repayment,proto3" json:"repayment,omitempty"`
+	// Required. Target staged inbox item identifier to discard.
+	// Values are of the form `inb_[a-zA-Z0-9]+`.
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateBorrowingRepaymentRequest) Reset() {
-	*x = CreateBorrowingRepaymentRequest{}
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[52]
+func (x *DiscardInboxItemRequest) Reset() {
+	*x = DiscardInboxItemRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[153]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateBorrowingRepaymentRequest) String() string {
+func (x *DiscardInboxItemRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateBorrowingRepaymentRequest) ProtoMessage() {}
+func (*DiscardInboxItemRequest) ProtoMessage() {}
 
-func (x *CreateBorrowingRepaymentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[52]
+func (x *DiscardInboxItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[153]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5202,51 +13825,72 @@ func (x *CreateBorrowingRepaymentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateBorrowingRepaymentRequest.ProtoReflect.Descriptor instead.
-func (*CreateBorrowingRepaymentRequest) Descriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{52}
+// Deprecated: Use DiscardInboxItemRequest.ProtoReflect.Descriptor instead.
+func (*DiscardInboxItemRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{153}
 }
 
-func (x *CreateBorrowingRepaymentRequest) GetBorrowingId() string {
+func (x *DiscardInboxItemRequest) GetId() string {
 	if x != nil {
-		return x.BorrowingId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *CreateBorrowingRepaymentRequest) GetRepayment() *BorrowingRepayment {
-	if x != nil {
-		return x.Repayment
-	}
-	return nil
-}
-
-// The request for
-// [ListBorrowingRepayments][saturn.finance.v1.Finance.ListBorrowingRepayments].
-type ListBorrowingRepaymentsRequest struct {
+// StatementMapping describes how the columns of an account's CSV exports map onto
+// statement rows. Columns are referenced by header name when the file has a header
+// row, or by zero-based index otherwise.
+type StatementMapping struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. Target parent borrowing identifier.
-	// Values are of the form `bor_[a-zA-Z0-9]+`.
-	BorrowingId   string `protobuf:"bytes,1,opt,name=borrowing_id,json=borrowingId,proto3" json:"borrowing_id,omitempty"`
+	// Output only. Account the mapping belongs to.
+	// Values are of the form `acc_[a-zA-Z0-9]+`.
+	AccountId string `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// Optional. Field delimiter. Defaults to `,`.
+	Delimiter string `protobuf:"bytes,2,opt,name=delimiter,proto3" json:"delimiter,omitempty"`
+	// Optional. Whether the first row holds column names.
+	HasHeader bool `protobuf:"varint,3,opt,name=has_header,json=hasHeader,proto3" json:"has_header,omitempty"`
+	// Required. Column holding the booking date.
+	DateColumn string `protobuf:"bytes,4,opt,name=date_column,json=dateColumn,proto3" json:"date_column,omitempty"`
+	// Optional. Go reference layout of the date column. Defaults to `2006-01-02`.
+	DateFormat string `protobuf:"bytes,5,opt,name=date_format,json=dateFormat,proto3" json:"date_format,omitempty"`
+	// Optional. Column holding a signed amount. Mutually exclusive with
+	// `debit_column` and `credit_column`.
+	AmountColumn string `protobuf:"bytes,6,opt,name=amount_column,json=amountColumn,proto3" json:"amount_column,omitempty"`
+	// Optional. Column holding outflow amounts.
+	DebitColumn string `protobuf:"bytes,7,opt,name=debit_column,json=debitColumn,proto3" json:"debit_column,omitempty"`
+	// Optional. Column holding inflow amounts.
+	CreditColumn string `protobuf:"bytes,8,opt,name=credit_column,json=creditColumn,proto3" json:"credit_column,omitempty"`
+	// Required. Column holding the payee or narration.
+	DescriptionColumn string `protobuf:"bytes,9,opt,name=description_column,json=descriptionColumn,proto3" json:"description_column,omitempty"`
+	// Optional. Column holding the bank reference used to skip re-imported rows.
+	ReferenceColumn string `protobuf:"bytes,10,opt,name=reference_column,json=referenceColumn,proto3" json:"reference_column,omitempty"`
+	// Optional. Column holding the row currency. Defaults to the account currency.
+	CurrencyColumn string `protobuf:"bytes,11,opt,name=currency_column,json=currencyColumn,proto3" json:"currency_column,omitempty"`
+	// Optional. Decimal separator, `.` or `,`. Defaults to `.`.
+	DecimalSeparator string `protobuf:"bytes,12,opt,name=decimal_separator,json=decimalSeparator,proto3" json:"decimal_separator,omitempty"`
+	// Optional. Flip amount signs, for exports that list purchases as positive amounts.
+	InvertAmounts bool `protobuf:"varint,13,opt,name=invert_amounts,json=invertAmounts,proto3" json:"invert_amounts,omitempty"`
+	// Output only. Last time the mapping was saved.
+	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListBorrowingRepaymentsRequest) Reset() {
-	*x = ListBorrowingRepaymentsRequest{}
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[53]
+func (x *StatementMapping) Reset() {
+	*x = StatementMapping{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[154]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListBorrowingRepaymentsRequest) String() string {
+func (x *StatementMapping) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListBorrowingRepaymentsRequest) ProtoMessage() {}
+func (*StatementMapping) ProtoMessage() {}
 
-func (x *ListBorrowingRepaymentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[53]
+func (x *StatementMapping) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[154]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5257,94 +13901,143 @@ func (x *ListBorrowingRepaymentsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListBorrowingRepaymentsRequest.ProtoReflect.Descriptor instead.
-func (*ListBorrowingRepaymentsRequest) Descriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{53}
+// Deprecated: Use StatementMapping.ProtoReflect.Descriptor instead.
+func (*StatementMapping) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{154}
 }
 
-func (x *ListBorrowingRepaymentsRequest) GetBorrowingId() string {
+func (x *StatementMapping) GetAccountId() string {
 	if x != nil {
-		return x.BorrowingId
+		return x.AccountId
 	}
 	return ""
 }
 
-// The response for
-// [ListBorrowingRepayments][saturn.finance.v1.Finance.ListBorrowingRepayments].
-type ListBorrowingRepaymentsResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// List of installment repayments.
-	Repayments    []*BorrowingRepayment `protobuf:"bytes,1,rep,name=repayments,proto3" json:"repayments,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *StatementMapping) GetDelimiter() string {
+	if x != nil {
+		return x.Delimiter
+	}
+	return ""
 }
 
-func (x *ListBorrowingRepaymentsResponse) Reset() {
-	*x = ListBorrowingRepaymentsResponse{}
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[54]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *StatementMapping) GetHasHeader() bool {
+	if x != nil {
+		return x.HasHeader
+	}
+	return false
 }
 
-func (x *ListBorrowingRepaymentsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *StatementMapping) GetDateColumn() string {
+	if x != nil {
+		return x.DateColumn
+	}
+	return ""
 }
 
-func (*ListBorrowingRepaymentsResponse) ProtoMessage() {}
+func (x *StatementMapping) GetDateFormat() string {
+	if x != nil {
+		return x.DateFormat
+	}
+	return ""
+}
 
-func (x *ListBorrowingRepaymentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[54]
+func (x *StatementMapping) GetAmountColumn() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.AmountColumn
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use ListBorrowingRepaymentsResponse.ProtoReflect.Descriptor instead.
-func (*ListBorrowingRepaymentsResponse) Descriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{54}
+func (x *StatementMapping) GetDebitColumn() string {
+	if x != nil {
+		return x.DebitColumn
+	}
+	return ""
 }
 
-func (x *ListBorrowingRepaymentsResponse) GetRepayments() []*BorrowingRepayment {
+func (x *StatementMapping) GetCreditColumn() string {
 	if x != nil {
-		return x.Repayments
+		return x.CreditColumn
+	}
+	return ""
+}
+
+func (x *StatementMapping) GetDescriptionColumn() string {
+	if x != nil {
+		return x.DescriptionColumn
+	}
+	return ""
+}
+
+func (x *StatementMapping) GetReferenceColumn() string {
+	if x != nil {
+		return x.ReferenceColumn
+	}
+	return ""
+}
+
+func (x *StatementMapping) GetCurrencyColumn() string {
+	if x != nil {
+		return x.CurrencyColumn
+	}
+	return ""
+}
+
+func (x *StatementMapping) GetDecimalSeparator() string {
+	if x != nil {
+		return x.DecimalSeparator
+	}
+	return ""
+}
+
+func (x *StatementMapping) GetInvertAmounts() bool {
+	if x != nil {
+		return x.InvertAmounts
+	}
+	return false
+}
+
+func (x *StatementMapping) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
 	}
 	return nil
 }
 
 // The request for
-// [DeleteBorrowingRepayment][saturn.finance.v1.Finance.DeleteBorrowingRepayment].
-type DeleteBorrowingRepaymentRequest struct {
+// [ImportStatement][saturn.finance.v1.Finance.ImportStatement].
+type ImportStatementRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. Target parent borrowing identifier.
-	// Values are of the form `bor_[a-zA-Z0-9]+`.
-	BorrowingId string `protobuf:"bytes,1,opt,name=borrowing_id,json=borrowingId,proto3" json:"borrowing_id,omitempty"`
-	// Required. Unique identifier of the installment repayment to delete.
-	// Values are of the form `rep_[a-zA-Z0-9]+`.
-	Id            string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// Required. Account the statement belongs to.
+	// Values are of the form `acc_[a-zA-Z0-9]+`.
+	AccountId string `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// Required. File format of `content`.
+	Format StatementFormat `protobuf:"varint,2,opt,name=format,proto3,enum=saturn.finance.v1.StatementFormat" json:"format,omitempty"`
+	// Required. Raw statement file content.
+	Content []byte `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	// Optional. CSV column mapping. Falls back to the account's saved mapping when omitted.
+	CsvMapping *StatementMapping `protobuf:"bytes,4,opt,name=csv_mapping,json=csvMapping,proto3" json:"csv_mapping,omitempty"`
+	// Optional. Save `csv_mapping` as the account's mapping for future imports.
+	SaveMapping   bool `protobuf:"varint,5,opt,name=save_mapping,json=saveMapping,proto3" json:"save_mapping,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteBorrowingRepaymentRequest) Reset() {
-	*x = DeleteBorrowingRepaymentRequest{}
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[55]
+func (x *ImportStatementRequest) Reset() {
+	*x = ImportStatementRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[155]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteBorrowingRepaymentRequest) String() string {
+func (x *ImportStatementRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteBorrowingRepaymentRequest) ProtoMessage() {}
+func (*ImportStatementRequest) ProtoMessage() {}
 
-func (x *DeleteBorrowingRepaymentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[55]
+func (x *ImportStatementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[155]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5355,51 +14048,75 @@ func (x *DeleteBorrowingRepaymentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteBorrowingRepaymentRequest.ProtoReflect.Descriptor instead.
-func (*DeleteBorrowingRepaymentRequest) Descriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{55}
+// Deprecated: Use ImportStatementRequest.ProtoReflect.Descriptor instead.
+func (*ImportStatementRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{155}
 }
 
-func (x *DeleteBorrowingRepaymentRequest) GetBorrowingId() string {
+func (x *ImportStatementRequest) GetAccountId() string {
 	if x != nil {
-		return x.BorrowingId
+		return x.AccountId
 	}
 	return ""
 }
 
-func (x *DeleteBorrowingRepaymentRequest) GetId() string {
+func (x *ImportStatementRequest) GetFormat() StatementFormat {
 	if x != nil {
-		return x.Id
+		return x.Format
 	}
-	return ""
+	return StatementFormat_STATEMENT_FORMAT_UNSPECIFIED
 }
 
-// CurrencyInfo encapsulates currency code metadata.
-type CurrencyInfo struct {
+func (x *ImportStatementRequest) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *ImportStatementRequest) GetCsvMapping() *StatementMapping {
+	if x != nil {
+		return x.CsvMapping
+	}
+	return nil
+}
+
+func (x *ImportStatementRequest) GetSaveMapping() bool {
+	if x != nil {
+		return x.SaveMapping
+	}
+	return false
+}
+
+// The response for
+// [ImportStatement][saturn.finance.v1.Finance.ImportStatement].
+type ImportStatementResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. ISO currency code (e.g. USD).
-	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
-	// Required. User-friendly currency name.
-	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// Inbox items staged from the statement rows.
+	InboxItems []*InboxItem `protobuf:"bytes,1,rep,name=inbox_items,json=inboxItems,proto3" json:"inbox_items,omitempty"`
+	// Number of rows skipped because a previous import already staged them.
+	SkippedCount int32 `protobuf:"varint,2,opt,name=skipped_count,json=skippedCount,proto3" json:"skipped_count,omitempty"`
+	// Number of staged rows flagged as potential duplicates of ledger transactions.
+	DuplicateCount int32 `protobuf:"varint,3,opt,name=duplicate_count,json=duplicateCount,proto3" json:"duplicate_count,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *CurrencyInfo) Reset() {
-	*x = CurrencyInfo{}
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[56]
+func (x *ImportStatementResponse) Reset() {
+	*x = ImportStatementResponse{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[156]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CurrencyInfo) String() string {
+func (x *ImportStatementResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CurrencyInfo) ProtoMessage() {}
+func (*ImportStatementResponse) ProtoMessage() {}
 
-func (x *CurrencyInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[56]
+func (x *ImportStatementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[156]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5410,48 +14127,58 @@ func (x *CurrencyInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CurrencyInfo.ProtoReflect.Descriptor instead.
-func (*CurrencyInfo) Descriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{56}
+// Deprecated: Use ImportStatementResponse.ProtoReflect.Descriptor instead.
+func (*ImportStatementResponse) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{156}
+}
+
+func (x *ImportStatementResponse) GetInboxItems() []*InboxItem {
+	if x != nil {
+		return x.InboxItems
+	}
+	return nil
 }
 
-func (x *CurrencyInfo) GetCode() string {
+func (x *ImportStatementResponse) GetSkippedCount() int32 {
 	if x != nil {
-		return x.Code
+		return x.SkippedCount
 	}
-	return ""
+	return 0
 }
 
-func (x *CurrencyInfo) GetName() string {
+func (x *ImportStatementResponse) GetDuplicateCount() int32 {
 	if x != nil {
-		return x.Name
+		return x.DuplicateCount
 	}
-	return ""
+	return 0
 }
 
 // The request for
-// [ListCurrencies][saturn.finance.v1.Finance.ListCurrencies].
-type ListCurrenciesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+// [GetStatementMapping][saturn.finance.v1.Finance.GetStatementMapping].
+type GetStatementMappingRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. Account identifier.
+	// Values are of the form `acc_[a-zA-Z0-9]+`.
+	AccountId     string `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListCurrenciesRequest) Reset() {
-	*x = ListCurrenciesRequest{}
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[57]
+func (x *GetStatementMappingRequest) Reset() {
+	*x = GetStatementMappingRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[157]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCurrenciesRequest) String() string {
+func (x *GetStatementMappingRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCurrenciesRequest) ProtoMessage() {}
+func (*GetStatementMappingRequest) ProtoMessage() {}
 
-func (x *ListCurrenciesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[57]
+func (x *GetStatementMappingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[157]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5462,36 +14189,47 @@ func (x *ListCurrenciesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCurrenciesRequest.ProtoReflect.Descriptor instead.
-func (*ListCurrenciesRequest) Descriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{57}
+// Deprecated: Use GetStatementMappingRequest.ProtoReflect.Descriptor instead.
+func (*GetStatementMappingRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{157}
 }
 
-// The response for
-// [ListCurrencies][saturn.finance.v1.Finance.ListCurrencies].
-type ListCurrenciesResponse struct {
+func (x *GetStatementMappingRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+// The request for
+// [ExportLedger][saturn.finance.v1.Finance.ExportLedger].
+type ExportLedgerRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// List of currency codes and details.
-	Currencies    []*CurrencyInfo `protobuf:"bytes,1,rep,name=currencies,proto3" json:"currencies,omitempty"`
+	// Required. Output file format.
+	Format LedgerFormat `protobuf:"varint,1,opt,name=format,proto3,enum=saturn.finance.v1.LedgerFormat" json:"format,omitempty"`
+	// Required. First day to export, inclusive.
+	StartDate *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	// Required. Last day to export, inclusive.
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListCurrenciesResponse) Reset() {
-	*x = ListCurrenciesResponse{}
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[58]
+func (x *ExportLedgerRequest) Reset() {
+	*x = ExportLedgerRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[158]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCurrenciesResponse) String() string {
+func (x *ExportLedgerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCurrenciesResponse) ProtoMessage() {}
+func (*ExportLedgerRequest) ProtoMessage() {}
 
-func (x *ListCurrenciesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[58]
+func (x *ExportLedgerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[158]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5502,75 +14240,60 @@ func (x *ListCurrenciesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCurrenciesResponse.ProtoReflect.Descriptor instead.
-func (*ListCurrenciesResponse) Descriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{58}
+// Deprecated: Use ExportLedgerRequest.ProtoReflect.Descriptor instead.
+func (*ExportLedgerRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{158}
 }
 
-func (x *ListCurrenciesResponse) GetCurrencies() []*CurrencyInfo {
+func (x *ExportLedgerRequest) GetFormat() LedgerFormat {
 	if x != nil {
-		return x.Currencies
+		return x.Format
+	}
+	return LedgerFormat_LEDGER_FORMAT_UNSPECIFIED
+}
+
+func (x *ExportLedgerRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
 	}
 	return nil
 }
 
-// Account represents a physical or digital payment account.
-type Account struct {
+func (x *ExportLedgerRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+// A piece of an exported ledger file.
+type LedgerExportChunk struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Output only. Unique identifier of the account.
-	// Values are of the form `acc_[a-zA-Z0-9]+`.
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Output only. Space identifier.
-	SpaceId string `protobuf:"bytes,2,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
-	// Required. User-friendly account name (e.g. "Chase Checking").
-	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	// Required. Account classification type.
-	Type Account_Type `protobuf:"varint,4,opt,name=type,proto3,enum=saturn.finance.v1.Account_Type" json:"type,omitempty"`
-	// Required. Account currency (e.g. "USD").
-	Currency string `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
-	// Required. Initial balance amount in cents (e.g. 500000 for $5,000.00).
-	InitialBalance int64 `protobuf:"varint,6,opt,name=initial_balance,json=initialBalance,proto3" json:"initial_balance,omitempty"`
-	// Output only. Computed current balance amount in cents.
-	// Calculated dynamically as `InitialBalance + Sum(Income/TransferIn) - Sum(Expense/TransferOut)`.
-	CurrentBalance int64 `protobuf:"varint,7,opt,name=current_balance,json=currentBalance,proto3" json:"current_balance,omitempty"`
-	// Optional. Credit limit amount in cents (applicable only if CREDIT_CARD type).
-	CreditLimit int64 `protobuf:"varint,8,opt,name=credit_limit,json=creditLimit,proto3" json:"credit_limit,omitempty"`
-	// Optional. Indicates if the account is the workspace default. Default accounts receive
-	// transactions if no explicit account is nominated.
-	IsDefault bool `protobuf:"varint,9,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
-	// Optional. Indicates if the account is active.
-	IsActive bool `protobuf:"varint,10,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	// Optional. Color HEX code for visual UI identification.
-	Color string `protobuf:"bytes,11,opt,name=color,proto3" json:"color,omitempty"`
-	// Optional. Narration notes.
-	Notes string `protobuf:"bytes,12,opt,name=notes,proto3" json:"notes,omitempty"`
-	// Optional. Last four digits of card or account number.
-	LastFour string `protobuf:"bytes,13,opt,name=last_four,json=lastFour,proto3" json:"last_four,omitempty"`
-	// Output only. Creation timestamp.
-	CreateTime *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
-	// Output only. Last update timestamp.
-	UpdateTime *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
-	// Output only. Currency conversion statistics.
-	Conversion    *Account_Conversion `protobuf:"bytes,16,opt,name=conversion,proto3" json:"conversion,omitempty"`
+	// MIME type of the file. Set on the first chunk only.
+	ContentType string `protobuf:"bytes,1,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// Suggested file name. Set on the first chunk only.
+	FileName string `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	// File content.
+	Data          []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Account) Reset() {
-	*x = Account{}
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[59]
+func (x *LedgerExportChunk) Reset() {
+	*x = LedgerExportChunk{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[159]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Account) String() string {
+func (x *LedgerExportChunk) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Account) ProtoMessage() {}
+func (*LedgerExportChunk) ProtoMessage() {}
 
-func (x *Account) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[59]
+func (x *LedgerExportChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[159]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5581,255 +14304,221 @@ func (x *Account) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Account.ProtoReflect.Descriptor instead.
-func (*Account) Descriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{59}
-}
-
-func (x *Account) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+// Deprecated: Use LedgerExportChunk.ProtoReflect.Descriptor instead.
+func (*LedgerExportChunk) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{159}
 }
 
-func (x *Account) GetSpaceId() string {
+func (x *LedgerExportChunk) GetContentType() string {
 	if x != nil {
-		return x.SpaceId
+		return x.ContentType
 	}
 	return ""
 }
 
-func (x *Account) GetName() string {
+func (x *LedgerExportChunk) GetFileName() string {
 	if x != nil {
-		return x.Name
+		return x.FileName
 	}
 	return ""
 }
 
-func (x *Account) GetType() Account_Type {
-	if x != nil {
-		return x.Type
-	}
-	return Account_TYPE_UNSPECIFIED
-}
-
-func (x *Account) GetCurrency() string {
+func (x *LedgerExportChunk) GetData() []byte {
 	if x != nil {
-		return x.Currency
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-func (x *Account) GetInitialBalance() int64 {
-	if x != nil {
-		return x.InitialBalance
-	}
-	return 0
+// LedgerExport is a ledger export produced by a background job.
+type LedgerExport struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Output only. Unique identifier.
+	// Values are of the form `lex_[a-zA-Z0-9]+`.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Output only. Space identifier.
+	SpaceId string `protobuf:"bytes,2,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	// Required. Output file format.
+	Format LedgerFormat `protobuf:"varint,3,opt,name=format,proto3,enum=saturn.finance.v1.LedgerFormat" json:"format,omitempty"`
+	// Required. First day to export, inclusive.
+	StartDate *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	// Required. Last day to export, inclusive.
+	EndDate *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	// Output only. Processing state.
+	Status LedgerExport_Status `protobuf:"varint,6,opt,name=status,proto3,enum=saturn.finance.v1.LedgerExport_Status" json:"status,omitempty"`
+	// Output only. Suggested file name.
+	FileName string `protobuf:"bytes,7,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	// Output only. MIME type of the file.
+	ContentType string `protobuf:"bytes,8,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// Output only. File size in bytes, once completed.
+	SizeBytes int64 `protobuf:"varint,9,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	// Output only. Number of transactions exported, once completed.
+	EntryCount int64 `protobuf:"varint,10,opt,name=entry_count,json=entryCount,proto3" json:"entry_count,omitempty"`
+	// Output only. Failure reason when the export failed.
+	Error string `protobuf:"bytes,11,opt,name=error,proto3" json:"error,omitempty"`
+	// Output only. Creation timestamp.
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// Output only. Last update timestamp.
+	UpdateTime *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	// Output only. Completion timestamp.
+	CompleteTime  *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=complete_time,json=completeTime,proto3" json:"complete_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Account) GetCurrentBalance() int64 {
-	if x != nil {
-		return x.CurrentBalance
-	}
-	return 0
+func (x *LedgerExport) Reset() {
+	*x = LedgerExport{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[160]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Account) GetCreditLimit() int64 {
-	if x != nil {
-		return x.CreditLimit
-	}
-	return 0
+func (x *LedgerExport) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Account) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
-	}
-	return false
-}
+func (*LedgerExport) ProtoMessage() {}
 
-func (x *Account) GetIsActive() bool {
+func (x *LedgerExport) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[160]
 	if x != nil {
-		return x.IsActive
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *Account) GetColor() string {
-	if x != nil {
-		return x.Color
-	}
-	return ""
+// Deprecated: Use LedgerExport.ProtoReflect.Descriptor instead.
+func (*LedgerExport) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{160}
 }
 
-func (x *Account) GetNotes() string {
+func (x *LedgerExport) GetId() string {
 	if x != nil {
-		return x.Notes
+		return x.Id
 	}
 	return ""
 }
 
-func (x *Account) GetLastFour() string {
+func (x *LedgerExport) GetSpaceId() string {
 	if x != nil {
-		return x.LastFour
+		return x.SpaceId
 	}
 	return ""
 }
 
-func (x *Account) GetCreateTime() *timestamppb.Timestamp {
+func (x *LedgerExport) GetFormat() LedgerFormat {
 	if x != nil {
-		return x.CreateTime
+		return x.Format
 	}
-	return nil
+	return LedgerFormat_LEDGER_FORMAT_UNSPECIFIED
 }
 
-func (x *Account) GetUpdateTime() *timestamppb.Timestamp {
+func (x *LedgerExport) GetStartDate() *timestamppb.Timestamp {
 	if x != nil {
-		return x.UpdateTime
+		return x.StartDate
 	}
 	return nil
 }
 
-func (x *Account) GetConversion() *Account_Conversion {
+func (x *LedgerExport) GetEndDate() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Conversion
+		return x.EndDate
 	}
 	return nil
 }
 
-// The request for
-// [CreateAccount][saturn.finance.v1.Finance.CreateAccount].
-type CreateAccountRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. Account configuration parameters.
-	Account       *Account `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *CreateAccountRequest) Reset() {
-	*x = CreateAccountRequest{}
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[60]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *CreateAccountRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*CreateAccountRequest) ProtoMessage() {}
-
-func (x *CreateAccountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[60]
+func (x *LedgerExport) GetStatus() LedgerExport_Status {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Status
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use CreateAccountRequest.ProtoReflect.Descriptor instead.
-func (*CreateAccountRequest) Descriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{60}
+	return LedgerExport_STATUS_UNSPECIFIED
 }
 
-func (x *CreateAccountRequest) GetAccount() *Account {
+func (x *LedgerExport) GetFileName() string {
 	if x != nil {
-		return x.Account
+		return x.FileName
 	}
-	return nil
-}
-
-// The request for
-// [GetAccount][saturn.finance.v1.Finance.GetAccount].
-type GetAccountRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. Target account identifier.
-	// Values are of the form `acc_[a-zA-Z0-9]+`.
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Optional. Account view details level.
-	View          *Account_View `protobuf:"varint,2,opt,name=view,proto3,enum=saturn.finance.v1.Account_View,oneof" json:"view,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return ""
 }
 
-func (x *GetAccountRequest) Reset() {
-	*x = GetAccountRequest{}
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[61]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *LedgerExport) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
 }
 
-func (x *GetAccountRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *LedgerExport) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
 }
 
-func (*GetAccountRequest) ProtoMessage() {}
+func (x *LedgerExport) GetEntryCount() int64 {
+	if x != nil {
+		return x.EntryCount
+	}
+	return 0
+}
 
-func (x *GetAccountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[61]
+func (x *LedgerExport) GetError() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Error
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetAccountRequest.ProtoReflect.Descriptor instead.
-func (*GetAccountRequest) Descriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{61}
+func (x *LedgerExport) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
 }
 
-func (x *GetAccountRequest) GetId() string {
+func (x *LedgerExport) GetUpdateTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Id
+		return x.UpdateTime
 	}
-	return ""
+	return nil
 }
 
-func (x *GetAccountRequest) GetView() Account_View {
-	if x != nil && x.View != nil {
-		return *x.View
+func (x *LedgerExport) GetCompleteTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompleteTime
 	}
-	return Account_VIEW_UNSPECIFIED
+	return nil
 }
 
 // The request for
-// [UpdateAccount][saturn.finance.v1.Finance.UpdateAccount].
-type UpdateAccountRequest struct {
+// [CreateLedgerExport][saturn.finance.v1.Finance.CreateLedgerExport].
+type CreateLedgerExportRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. Target account identifier to update.
-	// Values are of the form `acc_[a-zA-Z0-9]+`.
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Required. Updated account parameters.
-	Account       *Account `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	// Required. The export to queue.
+	LedgerExport  *LedgerExport `protobuf:"bytes,1,opt,name=ledger_export,json=ledgerExport,proto3" json:"ledger_export,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateAccountRequest) Reset() {
-	*x = UpdateAccountRequest{}
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[62]
+func (x *CreateLedgerExportRequest) Reset() {
+	*x = CreateLedgerExportRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[161]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateAccountRequest) String() string {
+func (x *CreateLedgerExportRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateAccountRequest) ProtoMessage() {}
+func (*CreateLedgerExportRequest) ProtoMessage() {}
 
-func (x *UpdateAccountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[62]
+func (x *CreateLedgerExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[161]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5840,56 +14529,44 @@ func (x *UpdateAccountRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateAccountRequest.ProtoReflect.Descriptor instead.
-func (*UpdateAccountRequest) Descriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{62}
-}
-
-func (x *UpdateAccountRequest) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+// Deprecated: Use CreateLedgerExportRequest.ProtoReflect.Descriptor instead.
+func (*CreateLedgerExportRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{161}
 }
 
-func (x *UpdateAccountRequest) GetAccount() *Account {
+func (x *CreateLedgerExportRequest) GetLedgerExport() *LedgerExport {
 	if x != nil {
-		return x.Account
+		return x.LedgerExport
 	}
 	return nil
 }
 
 // The request for
-// [AdjustAccountBalance][saturn.finance.v1.Finance.AdjustAccountBalance].
-type AdjustAccountBalanceRequest struct {
+// [GetLedgerExport][saturn.finance.v1.Finance.GetLedgerExport].
+type GetLedgerExportRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. Target account identifier to adjust balance for.
-	AccountId string `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
-	// Required. Target balance in cents.
-	TargetBalance int64 `protobuf:"varint,2,opt,name=target_balance,json=targetBalance,proto3" json:"target_balance,omitempty"`
-	// Optional. ISO timestamp of the adjustment date.
-	AdjustmentDate *string `protobuf:"bytes,3,opt,name=adjustment_date,json=adjustmentDate,proto3,oneof" json:"adjustment_date,omitempty"`
-	// Optional. Reason or note for reconciliation.
-	Note          *string `protobuf:"bytes,4,opt,name=note,proto3,oneof" json:"note,omitempty"`
+	// Required. Unique identifier of the export.
+	// Values are of the form `lex_[a-zA-Z0-9]+`.
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AdjustAccountBalanceRequest) Reset() {
-	*x = AdjustAccountBalanceRequest{}
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[63]
+func (x *GetLedgerExportRequest) Reset() {
+	*x = GetLedgerExportRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[162]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AdjustAccountBalanceRequest) String() string {
+func (x *GetLedgerExportRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdjustAccountBalanceRequest) ProtoMessage() {}
+func (*GetLedgerExportRequest) ProtoMessage() {}
 
-func (x *AdjustAccountBalanceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[63]
+func (x *GetLedgerExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[162]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5900,65 +14577,45 @@ func (x *AdjustAccountBalanceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdjustAccountBalanceRequest.ProtoReflect.Descriptor instead.
-func (*AdjustAccountBalanceRequest) Descriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{63}
-}
-
-func (x *AdjustAccountBalanceRequest) GetAccountId() string {
-	if x != nil {
-		return x.AccountId
-	}
-	return ""
+// Deprecated: Use GetLedgerExportRequest.ProtoReflect.Descriptor instead.
+func (*GetLedgerExportRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{162}
 }
 
-func (x *AdjustAccountBalanceRequest) GetTargetBalance() int64 {
+func (x *GetLedgerExportRequest) GetId() string {
 	if x != nil {
-		return x.TargetBalance
-	}
-	return 0
-}
-
-func (x *AdjustAccountBalanceRequest) GetAdjustmentDate() string {
-	if x != nil && x.AdjustmentDate != nil {
-		return *x.AdjustmentDate
-	}
-	return ""
-}
-
-func (x *AdjustAccountBalanceRequest) GetNote() string {
-	if x != nil && x.Note != nil {
-		return *x.Note
+		return x.Id
 	}
 	return ""
 }
 
 // The request for
-// [DeleteAccount][saturn.finance.v1.Finance.DeleteAccount].
-type DeleteAccountRequest struct {
+// [ListLedgerExports][saturn.finance.v1.Finance.ListLedgerExports].
+type ListLedgerExportsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. Target account identifier to delete.
-	// Values are of the form `acc_[a-zA-Z0-9]+`.
-	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Optional. Maximum number of items to return.
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Optional. Keyset page token.
+	PageToken     string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteAccountRequest) Reset() {
-	*x = DeleteAccountRequest{}
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[64]
+func (x *ListLedgerExportsRequest) Reset() {
+	*x = ListLedgerExportsRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[163]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteAccountRequest) String() string {
+func (x *ListLedgerExportsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteAccountRequest) ProtoMessage() {}
+func (*ListLedgerExportsRequest) ProtoMessage() {}
 
-func (x *DeleteAccountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[64]
+func (x *ListLedgerExportsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[163]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5969,53 +14626,52 @@ func (x *DeleteAccountRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteAccountRequest.ProtoReflect.Descriptor instead.
-func (*DeleteAccountRequest) Descriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{64}
+// Deprecated: Use ListLedgerExportsRequest.ProtoReflect.Descriptor instead.
+func (*ListLedgerExportsRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{163}
 }
 
-func (x *DeleteAccountRequest) GetId() string {
+func (x *ListLedgerExportsRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.Id
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListLedgerExportsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
 	}
 	return ""
 }
 
-// The request for
-// [ListAccounts][saturn.finance.v1.Finance.ListAccounts].
-type ListAccountsRequest struct {
+// The response for
+// [ListLedgerExports][saturn.finance.v1.Finance.ListLedgerExports].
+type ListLedgerExportsResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Optional. Account view details level.
-	View *Account_View `protobuf:"varint,1,opt,name=view,proto3,enum=saturn.finance.v1.Account_View,oneof" json:"view,omitempty"`
-	// Optional. Filter only active accounts.
-	ActiveOnly *bool `protobuf:"varint,2,opt,name=active_only,json=activeOnly,proto3,oneof" json:"active_only,omitempty"`
-	// Optional. Search text filter matching account name or notes.
-	SearchQuery *string `protobuf:"bytes,3,opt,name=search_query,json=searchQuery,proto3,oneof" json:"search_query,omitempty"`
-	// Optional. Maximum number of items to return in a single page.
-	PageSize *int32 `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
-	// Optional. Keyset page token returned by a previous call.
-	PageToken *string `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3,oneof" json:"page_token,omitempty"`
-	// Optional. Sort order string specifying column and optional ordering suffix.
-	Sort          *string `protobuf:"bytes,6,opt,name=sort,proto3,oneof" json:"sort,omitempty"`
+	// List of exports in the space.
+	LedgerExports []*LedgerExport `protobuf:"bytes,1,rep,name=ledger_exports,json=ledgerExports,proto3" json:"ledger_exports,omitempty"`
+	// Next page keyset token. Empty if no more pages are available.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListAccountsRequest) Reset() {
-	*x = ListAccountsRequest{}
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[65]
+func (x *ListLedgerExportsResponse) Reset() {
+	*x = ListLedgerExportsResponse{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[164]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListAccountsRequest) String() string {
+func (x *ListLedgerExportsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListAccountsRequest) ProtoMessage() {}
+func (*ListLedgerExportsResponse) ProtoMessage() {}
 
-func (x *ListAccountsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[65]
+func (x *ListLedgerExportsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[164]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6026,80 +14682,51 @@ func (x *ListAccountsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListAccountsRequest.ProtoReflect.Descriptor instead.
-func (*ListAccountsRequest) Descriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{65}
-}
-
-func (x *ListAccountsRequest) GetView() Account_View {
-	if x != nil && x.View != nil {
-		return *x.View
-	}
-	return Account_VIEW_UNSPECIFIED
-}
-
-func (x *ListAccountsRequest) GetActiveOnly() bool {
-	if x != nil && x.ActiveOnly != nil {
-		return *x.ActiveOnly
-	}
-	return false
-}
-
-func (x *ListAccountsRequest) GetSearchQuery() string {
-	if x != nil && x.SearchQuery != nil {
-		return *x.SearchQuery
-	}
-	return ""
-}
-
-func (x *ListAccountsRequest) GetPageSize() int32 {
-	if x != nil && x.PageSize != nil {
-		return *x.PageSize
-	}
-	return 0
+// Deprecated: Use ListLedgerExportsResponse.ProtoReflect.Descriptor instead.
+func (*ListLedgerExportsResponse) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{164}
 }
 
-func (x *ListAccountsRequest) GetPageToken() string {
-	if x != nil && x.PageToken != nil {
-		return *x.PageToken
+func (x *ListLedgerExportsResponse) GetLedgerExports() []*LedgerExport {
+	if x != nil {
+		return x.LedgerExports
 	}
-	return ""
+	return nil
 }
 
-func (x *ListAccountsRequest) GetSort() string {
-	if x != nil && x.Sort != nil {
-		return *x.Sort
+func (x *ListLedgerExportsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
 	}
 	return ""
 }
 
-// The response for
-// [ListAccounts][saturn.finance.v1.Finance.ListAccounts].
-type ListAccountsResponse struct {
+// The request for
+// [DownloadLedgerExport][saturn.finance.v1.Finance.DownloadLedgerExport].
+type DownloadLedgerExportRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// List of accounts matching filters.
-	Accounts []*Account `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
-	// Next page keyset token. Empty if no more pages are available.
-	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// Required. Unique identifier of a completed export.
+	// Values are of the form `lex_[a-zA-Z0-9]+`.
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListAccountsResponse) Reset() {
-	*x = ListAccountsResponse{}
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[66]
+func (x *DownloadLedgerExportRequest) Reset() {
+	*x = DownloadLedgerExportRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[165]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListAccountsResponse) String() string {
+func (x *DownloadLedgerExportRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListAccountsResponse) ProtoMessage() {}
+func (*DownloadLedgerExportRequest) ProtoMessage() {}
 
-func (x *ListAccountsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[66]
+func (x *DownloadLedgerExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[165]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6110,70 +14737,80 @@ func (x *ListAccountsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListAccountsResponse.ProtoReflect.Descriptor instead.
-func (*ListAccountsResponse) Descriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{66}
-}
-
-func (x *ListAccountsResponse) GetAccounts() []*Account {
-	if x != nil {
-		return x.Accounts
-	}
-	return nil
+// Deprecated: Use DownloadLedgerExportRequest.ProtoReflect.Descriptor instead.
+func (*DownloadLedgerExportRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{165}
 }
 
-func (x *ListAccountsResponse) GetNextPageToken() string {
+func (x *DownloadLedgerExportRequest) GetId() string {
 	if x != nil {
-		return x.NextPageToken
+		return x.Id
 	}
 	return ""
 }
 
-// Transfer represents a new transfer between accounts.
-type Transfer struct {
+// CardStatement is the snapshot of a credit card billing cycle taken on its closing date.
+// Balances are debt owed, in cents of the card currency.
+type CardStatement struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Output only. Unique identifier.
-	// Values are of the form `txn_[a-zA-Z0-9]+`.
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Output only. Space identifier.
-	SpaceId string `protobuf:"bytes,2,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
-	// Required. Source account ID.
-	// Values are of the form `acc_[a-zA-Z0-9]+`.
-	SourceAccountId string `protobuf:"bytes,3,opt,name=source_account_id,json=sourceAccountId,proto3" json:"source_account_id,omitempty"`
-	// Required. Destination account ID.
-	// Values are of the form `acc_[a-zA-Z0-9]+`.
-	DestinationAccountId string `protobuf:"bytes,4,opt,name=destination_account_id,json=destinationAccountId,proto3" json:"destination_account_id,omitempty"`
-	// Required. Source amount in source account currency cents.
-	SourceAmount int64 `protobuf:"varint,5,opt,name=source_amount,json=sourceAmount,proto3" json:"source_amount,omitempty"`
-	// Required. Destination amount in destination account currency cents.
-	DestinationAmount int64 `protobuf:"varint,6,opt,name=destination_amount,json=destinationAmount,proto3" json:"destination_amount,omitempty"`
-	// Required. Transfer date.
-	TransferDate *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=transfer_date,json=transferDate,proto3" json:"transfer_date,omitempty"`
-	// Optional. Narration notes.
-	Notes string `protobuf:"bytes,8,opt,name=notes,proto3" json:"notes,omitempty"`
+	// Output only. Unique identifier of the statement.
+	// Values are of the form `cst_[a-zA-Z0-9]+`.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Output only. Space identifier.
+	SpaceId string `protobuf:"bytes,2,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	// Output only. Credit card account identifier.
+	AccountId string `protobuf:"bytes,3,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// Output only. Card currency.
+	Currency string `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	// Output only. First instant covered by the billing cycle.
+	PeriodStart *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	// Output only. Day the billing cycle closed.
+	ClosingDate *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=closing_date,json=closingDate,proto3" json:"closing_date,omitempty"`
+	// Output only. Day the statement payment is due.
+	DueDate *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	// Output only. Balance owed when the cycle opened.
+	OpeningBalance int64 `protobuf:"varint,8,opt,name=opening_balance,json=openingBalance,proto3" json:"opening_balance,omitempty"`
+	// Output only. Charges posted during the cycle.
+	Purchases int64 `protobuf:"varint,9,opt,name=purchases,proto3" json:"purchases,omitempty"`
+	// Output only. Payments and refunds posted during the cycle.
+	Credits int64 `protobuf:"varint,10,opt,name=credits,proto3" json:"credits,omitempty"`
+	// Output only. Balance owed at the closing date.
+	StatementBalance int64 `protobuf:"varint,11,opt,name=statement_balance,json=statementBalance,proto3" json:"statement_balance,omitempty"`
+	// Output only. Minimum payment due by the due date.
+	MinimumPayment int64 `protobuf:"varint,12,opt,name=minimum_payment,json=minimumPayment,proto3" json:"minimum_payment,omitempty"`
+	// Output only. Payments applied to the statement since it closed.
+	PaidAmount int64 `protobuf:"varint,13,opt,name=paid_amount,json=paidAmount,proto3" json:"paid_amount,omitempty"`
+	// Output only. Credit limit at the closing date.
+	CreditLimit int64 `protobuf:"varint,14,opt,name=credit_limit,json=creditLimit,proto3" json:"credit_limit,omitempty"`
+	// Output only. Statement balance as a percentage of the credit limit.
+	Utilization float64 `protobuf:"fixed64,15,opt,name=utilization,proto3" json:"utilization,omitempty"`
+	// Output only. Payment status.
+	Status CardStatement_Status `protobuf:"varint,16,opt,name=status,proto3,enum=saturn.finance.v1.CardStatement_Status" json:"status,omitempty"`
+	// Output only. Scheduled payment created for the statement balance. Empty when nothing was owed.
+	ScheduledPaymentId string `protobuf:"bytes,17,opt,name=scheduled_payment_id,json=scheduledPaymentId,proto3" json:"scheduled_payment_id,omitempty"`
 	// Output only. Creation timestamp.
-	CreateTime *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
 	// Output only. Last update timestamp.
-	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,19,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Transfer) Reset() {
-	*x = Transfer{}
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[67]
+func (x *CardStatement) Reset() {
+	*x = CardStatement{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[166]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Transfer) String() string {
+func (x *CardStatement) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Transfer) ProtoMessage() {}
+func (*CardStatement) ProtoMessage() {}
 
-func (x *Transfer) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[67]
+func (x *CardStatement) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[166]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6184,75 +14821,138 @@ func (x *Transfer) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Transfer.ProtoReflect.Descriptor instead.
-func (*Transfer) Descriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{67}
+// Deprecated: Use CardStatement.ProtoReflect.Descriptor instead.
+func (*CardStatement) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{166}
 }
 
-func (x *Transfer) GetId() string {
+func (x *CardStatement) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *Transfer) GetSpaceId() string {
+func (x *CardStatement) GetSpaceId() string {
 	if x != nil {
 		return x.SpaceId
 	}
 	return ""
 }
 
-func (x *Transfer) GetSourceAccountId() string {
+func (x *CardStatement) GetAccountId() string {
 	if x != nil {
-		return x.SourceAccountId
+		return x.AccountId
 	}
 	return ""
 }
 
-func (x *Transfer) GetDestinationAccountId() string {
+func (x *CardStatement) GetCurrency() string {
 	if x != nil {
-		return x.DestinationAccountId
+		return x.Currency
 	}
 	return ""
 }
 
-func (x *Transfer) GetSourceAmount() int64 {
+func (x *CardStatement) GetPeriodStart() *timestamppb.Timestamp {
 	if x != nil {
-		return x.SourceAmount
+		return x.PeriodStart
+	}
+	return nil
+}
+
+func (x *CardStatement) GetClosingDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ClosingDate
+	}
+	return nil
+}
+
+func (x *CardStatement) GetDueDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueDate
+	}
+	return nil
+}
+
+func (x *CardStatement) GetOpeningBalance() int64 {
+	if x != nil {
+		return x.OpeningBalance
 	}
 	return 0
 }
 
-func (x *Transfer) GetDestinationAmount() int64 {
+func (x *CardStatement) GetPurchases() int64 {
 	if x != nil {
-		return x.DestinationAmount
+		return x.Purchases
 	}
 	return 0
 }
 
-func (x *Transfer) GetTransferDate() *timestamppb.Timestamp {
+func (x *CardStatement) GetCredits() int64 {
 	if x != nil {
-		return x.TransferDate
+		return x.Credits
 	}
-	return nil
+	return 0
 }
 
-func (x *Transfer) GetNotes() string {
+func (x *CardStatement) GetStatementBalance() int64 {
 	if x != nil {
-		return x.Notes
+		return x.StatementBalance
+	}
+	return 0
+}
+
+func (x *CardStatement) GetMinimumPayment() int64 {
+	if x != nil {
+		return x.MinimumPayment
+	}
+	return 0
+}
+
+func (x *CardStatement) GetPaidAmount() int64 {
+	if x != nil {
+		return x.PaidAmount
+	}
+	return 0
+}
+
+func (x *CardStatement) GetCreditLimit() int64 {
+	if x != nil {
+		return x.CreditLimit
+	}
+	return 0
+}
+
+func (x *CardStatement) GetUtilization() float64 {
+	if x != nil {
+		return x.Utilization
+	}
+	return 0
+}
+
+func (x *CardStatement) GetStatus() CardStatement_Status {
+	if x != nil {
+		return x.Status
+	}
+	return CardStatement_STATUS_UNSPECIFIED
+}
+
+func (x *CardStatement) GetScheduledPaymentId() string {
+	if x != nil {
+		return x.ScheduledPaymentId
 	}
 	return ""
 }
 
-func (x *Transfer) GetCreateTime() *timestamppb.Timestamp {
+func (x *CardStatement) GetCreateTime() *timestamppb.Timestamp {
 	if x != nil {
 		return x.CreateTime
 	}
 	return nil
 }
 
-func (x *Transfer) GetUpdateTime() *timestamppb.Timestamp {
+func (x *CardStatement) GetUpdateTime() *timestamppb.Timestamp {
 	if x != nil {
 		return x.UpdateTime
 	}
@@ -6260,42 +14960,35 @@ func (x *Transfer) GetUpdateTime() *timestamppb.Timestamp {
 }
 
 // The request for
-// [CreateTransfer][saturn.finance.v1.Finance.CreateTransfer].
-type CreateTransferRequest struct {
+// [ListCardStatements][saturn.finance.v1.Finance.ListCardStatements].
+type ListCardStatementsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. Source account ID.
-	// Values are of the form `acc_[a-zA-Z0-9]+`.
-	SourceAccountId string `protobuf:"bytes,1,opt,name=source_account_id,json=sourceAccountId,proto3" json:"source_account_id,omitempty"`
-	// Required. Destination account ID.
+	// Required. Credit card account identifier.
 	// Values are of the form `acc_[a-zA-Z0-9]+`.
-	DestinationAccountId string `protobuf:"bytes,2,opt,name=destination_account_id,json=destinationAccountId,proto3" json:"destination_account_id,omitempty"`
-	// Required. Source amount in source account currency cents.
-	SourceAmount int64 `protobuf:"varint,3,opt,name=source_amount,json=sourceAmount,proto3" json:"source_amount,omitempty"`
-	// Required. Destination amount in destination account currency cents.
-	DestinationAmount int64 `protobuf:"varint,4,opt,name=destination_amount,json=destinationAmount,proto3" json:"destination_amount,omitempty"`
-	// Required. Transfer date.
-	TransferDate *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=transfer_date,json=transferDate,proto3" json:"transfer_date,omitempty"`
-	// Optional. Narration notes.
-	Notes         string `protobuf:"bytes,6,opt,name=notes,proto3" json:"notes,omitempty"`
+	AccountId string `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// Optional. Maximum number of statements to return. Returns every statement when unset.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Optional. Only return statements that are not fully paid.
+	UnpaidOnly    bool `protobuf:"varint,3,opt,name=unpaid_only,json=unpaidOnly,proto3" json:"unpaid_only,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateTransferRequest) Reset() {
-	*x = CreateTransferRequest{}
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[68]
+func (x *ListCardStatementsRequest) Reset() {
+	*x = ListCardStatementsRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[167]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateTransferRequest) String() string {
+func (x *ListCardStatementsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTransferRequest) ProtoMessage() {}
+func (*ListCardStatementsRequest) ProtoMessage() {}
 
-func (x *CreateTransferRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[68]
+func (x *ListCardStatementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[167]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6306,80 +14999,154 @@ func (x *CreateTransferRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTransferRequest.ProtoReflect.Descriptor instead.
-func (*CreateTransferRequest) Descriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{68}
+// Deprecated: Use ListCardStatementsRequest.ProtoReflect.Descriptor instead.
+func (*ListCardStatementsRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{167}
 }
 
-func (x *CreateTransferRequest) GetSourceAccountId() string {
+func (x *ListCardStatementsRequest) GetAccountId() string {
 	if x != nil {
-		return x.SourceAccountId
+		return x.AccountId
 	}
 	return ""
 }
 
-func (x *CreateTransferRequest) GetDestinationAccountId() string {
+func (x *ListCardStatementsRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.DestinationAccountId
+		return x.PageSize
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateTransferRequest) GetSourceAmount() int64 {
+func (x *ListCardStatementsRequest) GetUnpaidOnly() bool {
 	if x != nil {
-		return x.SourceAmount
+		return x.UnpaidOnly
 	}
-	return 0
+	return false
 }
 
-func (x *CreateTransferRequest) GetDestinationAmount() int64 {
+// The response for
+// [ListCardStatements][saturn.finance.v1.Finance.ListCardStatements].
+type ListCardStatementsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Statements of the card, most recent closing date first.
+	CardStatements []*CardStatement `protobuf:"bytes,1,rep,name=card_statements,json=cardStatements,proto3" json:"card_statements,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListCardStatementsResponse) Reset() {
+	*x = ListCardStatementsResponse{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[168]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCardStatementsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCardStatementsResponse) ProtoMessage() {}
+
+func (x *ListCardStatementsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[168]
 	if x != nil {
-		return x.DestinationAmount
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *CreateTransferRequest) GetTransferDate() *timestamppb.Timestamp {
+// Deprecated: Use ListCardStatementsResponse.ProtoReflect.Descriptor instead.
+func (*ListCardStatementsResponse) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{168}
+}
+
+func (x *ListCardStatementsResponse) GetCardStatements() []*CardStatement {
 	if x != nil {
-		return x.TransferDate
+		return x.CardStatements
 	}
 	return nil
 }
 
-func (x *CreateTransferRequest) GetNotes() string {
+// The request for
+// [GetCardStatement][saturn.finance.v1.Finance.GetCardStatement].
+type GetCardStatementRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. Unique identifier of the statement.
+	// Values are of the form `cst_[a-zA-Z0-9]+`.
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCardStatementRequest) Reset() {
+	*x = GetCardStatementRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[169]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCardStatementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCardStatementRequest) ProtoMessage() {}
+
+func (x *GetCardStatementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[169]
 	if x != nil {
-		return x.Notes
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCardStatementRequest.ProtoReflect.Descriptor instead.
+func (*GetCardStatementRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{169}
+}
+
+func (x *GetCardStatementRequest) GetId() string {
+	if x != nil {
+		return x.Id
 	}
 	return ""
 }
 
 // The request for
-// [ListTransfers][saturn.finance.v1.Finance.ListTransfers].
-type ListTransfersRequest struct {
+// [ForecastCashFlow][saturn.finance.v1.Finance.ForecastCashFlow].
+type ForecastCashFlowRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Optional. Maximum number of items to return.
-	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	// Optional. Keyset page token.
-	PageToken     string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Optional. Number of days to project, starting today in the space timezone. Defaults to 30, at most 365.
+	Days int32 `protobuf:"varint,1,opt,name=days,proto3" json:"days,omitempty"`
+	// Optional. Restricts the response to these accounts. Returns every active account when empty.
+	AccountIds    []string `protobuf:"bytes,2,rep,name=account_ids,json=accountIds,proto3" json:"account_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListTransfersRequest) Reset() {
-	*x = ListTransfersRequest{}
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[69]
+func (x *ForecastCashFlowRequest) Reset() {
+	*x = ForecastCashFlowRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[170]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTransfersRequest) String() string {
+func (x *ForecastCashFlowRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTransfersRequest) ProtoMessage() {}
+func (*ForecastCashFlowRequest) ProtoMessage() {}
 
-func (x *ListTransfersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[69]
+func (x *ForecastCashFlowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[170]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6390,52 +15157,146 @@ func (x *ListTransfersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTransfersRequest.ProtoReflect.Descriptor instead.
-func (*ListTransfersRequest) Descriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{69}
+// Deprecated: Use ForecastCashFlowRequest.ProtoReflect.Descriptor instead.
+func (*ForecastCashFlowRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{170}
 }
 
-func (x *ListTransfersRequest) GetPageSize() int32 {
+func (x *ForecastCashFlowRequest) GetDays() int32 {
 	if x != nil {
-		return x.PageSize
+		return x.Days
 	}
 	return 0
 }
 
-func (x *ListTransfersRequest) GetPageToken() string {
+func (x *ForecastCashFlowRequest) GetAccountIds() []string {
 	if x != nil {
-		return x.PageToken
+		return x.AccountIds
 	}
-	return ""
+	return nil
 }
 
 // The response for
-// [ListTransfers][saturn.finance.v1.Finance.ListTransfers].
-type ListTransfersResponse struct {
+// [ForecastCashFlow][saturn.finance.v1.Finance.ForecastCashFlow].
+type ForecastCashFlowResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// First projected day.
+	StartDate *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	// Last projected day.
+	EndDate *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	// Day-by-day projection per account.
+	Accounts []*AccountForecast `protobuf:"bytes,3,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	// Projected inflows and outflows, in date order.
+	Events []*ForecastEvent `protobuf:"bytes,4,rep,name=events,proto3" json:"events,omitempty"`
+	// Days where an account enters a low balance state, in date order.
+	Alerts        []*ForecastAlert `protobuf:"bytes,5,rep,name=alerts,proto3" json:"alerts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForecastCashFlowResponse) Reset() {
+	*x = ForecastCashFlowResponse{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[171]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForecastCashFlowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForecastCashFlowResponse) ProtoMessage() {}
+
+func (x *ForecastCashFlowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[171]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForecastCashFlowResponse.ProtoReflect.Descriptor instead.
+func (*ForecastCashFlowResponse) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{171}
+}
+
+func (x *ForecastCashFlowResponse) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *ForecastCashFlowResponse) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *ForecastCashFlowResponse) GetAccounts() []*AccountForecast {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+func (x *ForecastCashFlowResponse) GetEvents() []*ForecastEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *ForecastCashFlowResponse) GetAlerts() []*ForecastAlert {
+	if x != nil {
+		return x.Alerts
+	}
+	return nil
+}
+
+// AccountForecast is the day-by-day balance projection of one account.
+type AccountForecast struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// List of transfer records.
-	Transfers []*Transfer `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers,omitempty"`
-	// Next page keyset token. Empty if no more pages are available.
-	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// Account identifier.
+	AccountId string `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// Account name.
+	AccountName string `protobuf:"bytes,2,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	// Account currency.
+	Currency string `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	// Balance in cents before the first projected day.
+	StartingBalance int64 `protobuf:"varint,4,opt,name=starting_balance,json=startingBalance,proto3" json:"starting_balance,omitempty"`
+	// Lowest projected balance, or available credit for credit cards, in cents.
+	LowestAvailable int64 `protobuf:"varint,5,opt,name=lowest_available,json=lowestAvailable,proto3" json:"lowest_available,omitempty"`
+	// Day the lowest figure is first reached.
+	LowestDate *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=lowest_date,json=lowestDate,proto3" json:"lowest_date,omitempty"`
+	// Configured low balance threshold in cents. Zero when disabled.
+	LowBalanceThreshold int64 `protobuf:"varint,7,opt,name=low_balance_threshold,json=lowBalanceThreshold,proto3" json:"low_balance_threshold,omitempty"`
+	// Projected end-of-day positions, one per day.
+	Days          []*ForecastDay `protobuf:"bytes,8,rep,name=days,proto3" json:"days,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListTransfersResponse) Reset() {
-	*x = ListTransfersResponse{}
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[70]
+func (x *AccountForecast) Reset() {
+	*x = AccountForecast{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[172]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTransfersResponse) String() string {
+func (x *AccountForecast) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTransfersResponse) ProtoMessage() {}
+func (*AccountForecast) ProtoMessage() {}
 
-func (x *ListTransfersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[70]
+func (x *AccountForecast) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[172]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6446,51 +15307,99 @@ func (x *ListTransfersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTransfersResponse.ProtoReflect.Descriptor instead.
-func (*ListTransfersResponse) Descriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{70}
+// Deprecated: Use AccountForecast.ProtoReflect.Descriptor instead.
+func (*AccountForecast) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{172}
 }
 
-func (x *ListTransfersResponse) GetTransfers() []*Transfer {
+func (x *AccountForecast) GetAccountId() string {
 	if x != nil {
-		return x.Transfers
+		return x.AccountId
 	}
-	return nil
+	return ""
 }
 
-func (x *ListTransfersResponse) GetNextPageToken() string {
+func (x *AccountForecast) GetAccountName() string {
 	if x != nil {
-		return x.NextPageToken
+		return x.AccountName
 	}
 	return ""
 }
 
-// The request for
-// [ListTransactionEvents][saturn.finance.v1.Finance.ListTransactionEvents].
-type ListTransactionEventsRequest struct {
+func (x *AccountForecast) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *AccountForecast) GetStartingBalance() int64 {
+	if x != nil {
+		return x.StartingBalance
+	}
+	return 0
+}
+
+func (x *AccountForecast) GetLowestAvailable() int64 {
+	if x != nil {
+		return x.LowestAvailable
+	}
+	return 0
+}
+
+func (x *AccountForecast) GetLowestDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LowestDate
+	}
+	return nil
+}
+
+func (x *AccountForecast) GetLowBalanceThreshold() int64 {
+	if x != nil {
+		return x.LowBalanceThreshold
+	}
+	return 0
+}
+
+func (x *AccountForecast) GetDays() []*ForecastDay {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+// ForecastDay is an account's projected position at the end of a day.
+type ForecastDay struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. Target transaction ID.
-	// Values are of the form `txn_[a-zA-Z0-9]+`.
-	TxnId         string `protobuf:"bytes,1,opt,name=txn_id,json=txnId,proto3" json:"txn_id,omitempty"`
+	// Projected day.
+	Date *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	// Projected balance in cents. Debt owed for credit cards.
+	Balance int64 `protobuf:"varint,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	// Projected balance for asset accounts, remaining credit for credit cards.
+	Available int64 `protobuf:"varint,3,opt,name=available,proto3" json:"available,omitempty"`
+	// Money entering the account during the day, in cents.
+	Inflow int64 `protobuf:"varint,4,opt,name=inflow,proto3" json:"inflow,omitempty"`
+	// Money leaving the account during the day, in cents.
+	Outflow       int64 `protobuf:"varint,5,opt,name=outflow,proto3" json:"outflow,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListTransactionEventsRequest) Reset() {
-	*x = ListTransactionEventsRequest{}
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[71]
+func (x *ForecastDay) Reset() {
+	*x = ForecastDay{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[173]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTransactionEventsRequest) String() string {
+func (x *ForecastDay) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTransactionEventsRequest) ProtoMessage() {}
+func (*ForecastDay) ProtoMessage() {}
 
-func (x *ListTransactionEventsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[71]
+func (x *ForecastDay) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[173]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6501,54 +15410,82 @@ func (x *ListTransactionEventsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTransactionEventsRequest.ProtoReflect.Descriptor instead.
-func (*ListTransactionEventsRequest) Descriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{71}
+// Deprecated: Use ForecastDay.ProtoReflect.Descriptor instead.
+func (*ForecastDay) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{173}
 }
 
-func (x *ListTransactionEventsRequest) GetTxnId() string {
+func (x *ForecastDay) GetDate() *timestamppb.Timestamp {
 	if x != nil {
-		return x.TxnId
+		return x.Date
 	}
-	return ""
+	return nil
 }
 
-// TransactionEvent represents a historical transaction lifecycle change.
-type TransactionEvent struct {
+func (x *ForecastDay) GetBalance() int64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *ForecastDay) GetAvailable() int64 {
+	if x != nil {
+		return x.Available
+	}
+	return 0
+}
+
+func (x *ForecastDay) GetInflow() int64 {
+	if x != nil {
+		return x.Inflow
+	}
+	return 0
+}
+
+func (x *ForecastDay) GetOutflow() int64 {
+	if x != nil {
+		return x.Outflow
+	}
+	return 0
+}
+
+// ForecastEvent is a projected inflow or outflow on one account.
+type ForecastEvent struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Output only. Unique identifier.
-	// Values are of the form `evt_[a-zA-Z0-9]+`.
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Output only. Space identifier.
-	SpaceId string `protobuf:"bytes,2,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
-	// Required. Associated transaction ID.
-	// Values are of the form `txn_[a-zA-Z0-9]+`.
-	TxnId string `protobuf:"bytes,3,opt,name=txn_id,json=txnId,proto3" json:"txn_id,omitempty"`
-	// Required. Lifecycle change event type (e.g. "created", "updated").
-	EventType string `protobuf:"bytes,4,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
-	// Required. JSON metadata describing the change details (e.g. diff properties).
-	Metadata string `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	// Output only. Event registration timestamp.
-	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// Projected day.
+	Date *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	// Account moved by the event. Empty when no account could be resolved.
+	AccountId string `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// Event kind.
+	Kind ForecastEvent_Kind `protobuf:"varint,3,opt,name=kind,proto3,enum=saturn.finance.v1.ForecastEvent_Kind" json:"kind,omitempty"`
+	// Identifier of the scheduled payment, recurring expense, borrowing or income source.
+	SourceId string `protobuf:"bytes,4,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	// Human readable description.
+	Description string `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	// Signed amount in cents of the account currency. Negative amounts leave the account.
+	Amount int64 `protobuf:"varint,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Whether the amount is estimated from history rather than known.
+	Estimated     bool `protobuf:"varint,7,opt,name=estimated,proto3" json:"estimated,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TransactionEvent) Reset() {
-	*x = TransactionEvent{}
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[72]
+func (x *ForecastEvent) Reset() {
+	*x = ForecastEvent{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[174]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TransactionEvent) String() string {
+func (x *ForecastEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TransactionEvent) ProtoMessage() {}
+func (*ForecastEvent) ProtoMessage() {}
 
-func (x *TransactionEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[72]
+func (x *ForecastEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[174]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6559,78 +15496,92 @@ func (x *TransactionEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TransactionEvent.ProtoReflect.Descriptor instead.
-func (*TransactionEvent) Descriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{72}
+// Deprecated: Use ForecastEvent.ProtoReflect.Descriptor instead.
+func (*ForecastEvent) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{174}
 }
 
-func (x *TransactionEvent) GetId() string {
+func (x *ForecastEvent) GetDate() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Id
+		return x.Date
 	}
-	return ""
+	return nil
 }
 
-func (x *TransactionEvent) GetSpaceId() string {
+func (x *ForecastEvent) GetAccountId() string {
 	if x != nil {
-		return x.SpaceId
+		return x.AccountId
 	}
 	return ""
 }
 
-func (x *TransactionEvent) GetTxnId() string {
+func (x *ForecastEvent) GetKind() ForecastEvent_Kind {
 	if x != nil {
-		return x.TxnId
+		return x.Kind
 	}
-	return ""
+	return ForecastEvent_KIND_UNSPECIFIED
 }
 
-func (x *TransactionEvent) GetEventType() string {
+func (x *ForecastEvent) GetSourceId() string {
 	if x != nil {
-		return x.EventType
+		return x.SourceId
 	}
 	return ""
 }
 
-func (x *TransactionEvent) GetMetadata() string {
+func (x *ForecastEvent) GetDescription() string {
 	if x != nil {
-		return x.Metadata
+		return x.Description
 	}
 	return ""
 }
 
-func (x *TransactionEvent) GetCreateTime() *timestamppb.Timestamp {
+func (x *ForecastEvent) GetAmount() int64 {
 	if x != nil {
-		return x.CreateTime
+		return x.Amount
 	}
-	return nil
+	return 0
 }
 
-// The response for
-// [ListTransactionEvents][saturn.finance.v1.Finance.ListTransactionEvents].
-type ListTransactionEventsResponse struct {
+func (x *ForecastEvent) GetEstimated() bool {
+	if x != nil {
+		return x.Estimated
+	}
+	return false
+}
+
+// ForecastAlert flags the first day an account enters a low balance state.
+type ForecastAlert struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// List of transaction lifecycle history events.
-	Events        []*TransactionEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	// Account identifier.
+	AccountId string `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// Day the state is entered.
+	Date *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	// Alert kind.
+	Kind ForecastAlert_Kind `protobuf:"varint,3,opt,name=kind,proto3,enum=saturn.finance.v1.ForecastAlert_Kind" json:"kind,omitempty"`
+	// Projected balance, or available credit, on that day in cents.
+	Available int64 `protobuf:"varint,4,opt,name=available,proto3" json:"available,omitempty"`
+	// Account threshold in cents.
+	Threshold     int64 `protobuf:"varint,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListTransactionEventsResponse) Reset() {
-	*x = ListTransactionEventsResponse{}
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[73]
+func (x *ForecastAlert) Reset() {
+	*x = ForecastAlert{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[175]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTransactionEventsResponse) String() string {
+func (x *ForecastAlert) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTransactionEventsResponse) ProtoMessage() {}
+func (*ForecastAlert) ProtoMessage() {}
 
-func (x *ListTransactionEventsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[73]
+func (x *ForecastAlert) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[175]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6641,82 +15592,99 @@ func (x *ListTransactionEventsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTransactionEventsResponse.ProtoReflect.Descriptor instead.
-func (*ListTransactionEventsResponse) Descriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{73}
+// Deprecated: Use ForecastAlert.ProtoReflect.Descriptor instead.
+func (*ForecastAlert) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{175}
 }
 
-func (x *ListTransactionEventsResponse) GetEvents() []*TransactionEvent {
+func (x *ForecastAlert) GetAccountId() string {
 	if x != nil {
-		return x.Events
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *ForecastAlert) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
 	}
 	return nil
 }
 
-// InboxItem represents an ingested invoice, receipt, or notification in processing staging.
-type InboxItem struct {
+func (x *ForecastAlert) GetKind() ForecastAlert_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return ForecastAlert_KIND_UNSPECIFIED
+}
+
+func (x *ForecastAlert) GetAvailable() int64 {
+	if x != nil {
+		return x.Available
+	}
+	return 0
+}
+
+func (x *ForecastAlert) GetThreshold() int64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+// Alert is a finding of the alert rules evaluated whenever an expense is created or changed.
+type Alert struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Output only. Unique identifier.
-	// Values are of the form `inb_[a-zA-Z0-9]+`.
+	// Output only. Unique identifier of the alert.
+	// Values are of the form `alr_[a-zA-Z0-9]+`.
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Output only. Space identifier.
-	SpaceId string `protobuf:"bytes,2,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
-	// Output only. Ingestion integration channel identifier.
-	IntegrationId string `protobuf:"bytes,3,opt,name=integration_id,json=integrationId,proto3" json:"integration_id,omitempty"`
-	// Output only. Staging lifecycle status.
-	Status InboxItem_Status `protobuf:"varint,4,opt,name=status,proto3,enum=saturn.finance.v1.InboxItem_Status" json:"status,omitempty"`
-	// Required. Extracted document classification category.
-	DocType InboxItem_DocType `protobuf:"varint,5,opt,name=doc_type,json=docType,proto3,enum=saturn.finance.v1.InboxItem_DocType" json:"doc_type,omitempty"`
-	// Required. Extracted transaction amount in cents.
-	Amount int64 `protobuf:"varint,6,opt,name=amount,proto3" json:"amount,omitempty"`
-	// Required. Extracted currency code.
-	Currency string `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"`
-	// Required. Extracted vendor counterparty name.
-	VendorName string `protobuf:"bytes,8,opt,name=vendor_name,json=vendorName,proto3" json:"vendor_name,omitempty"`
-	// Required. Extracted transaction transaction date.
-	TransactionDate *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=transaction_date,json=transactionDate,proto3" json:"transaction_date,omitempty"`
-	// Optional. Suggested account ID for categorization.
-	// Values are of the form `acc_[a-zA-Z0-9]+`.
-	AccountId string `protobuf:"bytes,10,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
-	// Optional. Suggested budget category ID.
-	// Values are of the form `bud_[a-zA-Z0-9]+`.
-	BudgetId string `protobuf:"bytes,11,opt,name=budget_id,json=budgetId,proto3" json:"budget_id,omitempty"`
-	// Optional. Associated scheduled payment identifier to clear.
-	// Values are of the form `sch_[a-zA-Z0-9]+`.
-	ScheduledPaymentId string `protobuf:"bytes,12,opt,name=scheduled_payment_id,json=scheduledPaymentId,proto3" json:"scheduled_payment_id,omitempty"`
-	// Optional. Associated completed transaction ledger ID.
-	// Values are of the form `txn_[a-zA-Z0-9]+`.
-	TransactionId string `protobuf:"bytes,13,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
-	// Output only. Ingestion raw notification payload details.
-	RawPayload string `protobuf:"bytes,14,opt,name=raw_payload,json=rawPayload,proto3" json:"raw_payload,omitempty"`
-	// Optional. Additional extracted metadata payload key-value pairs.
-	Metadata map[string]string `protobuf:"bytes,15,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	// Output only. Ingestion stage timestamp.
-	CreateTime *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
-	// Optional. Associated borrowing/lending agreement identifier.
-	// Values are of the form `bor_[a-zA-Z0-9]+`.
-	BorrowingId *string `protobuf:"bytes,17,opt,name=borrowing_id,json=borrowingId,proto3,oneof" json:"borrowing_id,omitempty"`
-	// Optional. Relationship classification for borrowing link (e.g., initial receipt, repayment, additional loan).
-	BorrowingLinkType *BorrowingLinkType `protobuf:"varint,18,opt,name=borrowing_link_type,json=borrowingLinkType,proto3,enum=saturn.finance.v1.BorrowingLinkType,oneof" json:"borrowing_link_type,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// Output only. Rule that raised the alert.
+	Rule Alert_Rule `protobuf:"varint,2,opt,name=rule,proto3,enum=saturn.finance.v1.Alert_Rule" json:"rule,omitempty"`
+	// Output only. Current status.
+	Status Alert_Status `protobuf:"varint,3,opt,name=status,proto3,enum=saturn.finance.v1.Alert_Status" json:"status,omitempty"`
+	// Output only. Human readable description of the finding.
+	Message string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	// Output only. Budget the alert concerns, when any.
+	BudgetId *string `protobuf:"bytes,5,opt,name=budget_id,json=budgetId,proto3,oneof" json:"budget_id,omitempty"`
+	// Output only. Budget period the alert concerns, when any.
+	PeriodId *string `protobuf:"bytes,6,opt,name=period_id,json=periodId,proto3,oneof" json:"period_id,omitempty"`
+	// Output only. Transaction whose change raised the alert.
+	TransactionId *string `protobuf:"bytes,7,opt,name=transaction_id,json=transactionId,proto3,oneof" json:"transaction_id,omitempty"`
+	// Output only. Earlier charge a duplicate matches.
+	RelatedTransactionId *string `protobuf:"bytes,8,opt,name=related_transaction_id,json=relatedTransactionId,proto3,oneof" json:"related_transaction_id,omitempty"`
+	// Output only. Spent, projected or charged amount in cents.
+	Amount int64 `protobuf:"varint,9,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Output only. Limit, threshold or expected amount the rule compared against, in cents.
+	Threshold int64 `protobuf:"varint,10,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// Output only. Currency of `amount` and `threshold`.
+	Currency string `protobuf:"bytes,11,opt,name=currency,proto3" json:"currency,omitempty"`
+	// Output only. Time the alert becomes active again while snoozed.
+	SnoozeUntil *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=snooze_until,json=snoozeUntil,proto3,oneof" json:"snooze_until,omitempty"`
+	// Output only. Time the alert was acknowledged.
+	AcknowledgeTime *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=acknowledge_time,json=acknowledgeTime,proto3,oneof" json:"acknowledge_time,omitempty"`
+	// Output only. Creation time.
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// Output only. Last update time.
+	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InboxItem) Reset() {
-	*x = InboxItem{}
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[74]
+func (x *Alert) Reset() {
+	*x = Alert{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[176]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InboxItem) String() string {
+func (x *Alert) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InboxItem) ProtoMessage() {}
+func (*Alert) ProtoMessage() {}
 
-func (x *InboxItem) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[74]
+func (x *Alert) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[176]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6724,177 +15692,274 @@ func (x *InboxItem) ProtoReflect() protoreflect.Message {
 		}
 		return ms
 	}
-	return mi.MessageOf(x)
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Alert.ProtoReflect.Descriptor instead.
+func (*Alert) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{176}
+}
+
+func (x *Alert) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Alert) GetRule() Alert_Rule {
+	if x != nil {
+		return x.Rule
+	}
+	return Alert_RULE_UNSPECIFIED
 }
 
-// Deprecated: Use InboxItem.ProtoReflect.Descriptor instead.
-func (*InboxItem) Descriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{74}
+func (x *Alert) GetStatus() Alert_Status {
+	if x != nil {
+		return x.Status
+	}
+	return Alert_STATUS_UNSPECIFIED
 }
 
-func (x *InboxItem) GetId() string {
+func (x *Alert) GetMessage() string {
 	if x != nil {
-		return x.Id
+		return x.Message
 	}
 	return ""
 }
 
-func (x *InboxItem) GetSpaceId() string {
-	if x != nil {
-		return x.SpaceId
+func (x *Alert) GetBudgetId() string {
+	if x != nil && x.BudgetId != nil {
+		return *x.BudgetId
 	}
 	return ""
 }
 
-func (x *InboxItem) GetIntegrationId() string {
-	if x != nil {
-		return x.IntegrationId
+func (x *Alert) GetPeriodId() string {
+	if x != nil && x.PeriodId != nil {
+		return *x.PeriodId
 	}
 	return ""
 }
 
-func (x *InboxItem) GetStatus() InboxItem_Status {
-	if x != nil {
-		return x.Status
+func (x *Alert) GetTransactionId() string {
+	if x != nil && x.TransactionId != nil {
+		return *x.TransactionId
 	}
-	return InboxItem_STATUS_UNSPECIFIED
+	return ""
 }
 
-func (x *InboxItem) GetDocType() InboxItem_DocType {
-	if x != nil {
-		return x.DocType
+func (x *Alert) GetRelatedTransactionId() string {
+	if x != nil && x.RelatedTransactionId != nil {
+		return *x.RelatedTransactionId
 	}
-	return InboxItem_DOC_TYPE_UNSPECIFIED
+	return ""
 }
 
-func (x *InboxItem) GetAmount() int64 {
+func (x *Alert) GetAmount() int64 {
 	if x != nil {
 		return x.Amount
 	}
 	return 0
 }
 
-func (x *InboxItem) GetCurrency() string {
+func (x *Alert) GetThreshold() int64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *Alert) GetCurrency() string {
 	if x != nil {
 		return x.Currency
 	}
 	return ""
 }
 
-func (x *InboxItem) GetVendorName() string {
+func (x *Alert) GetSnoozeUntil() *timestamppb.Timestamp {
 	if x != nil {
-		return x.VendorName
+		return x.SnoozeUntil
 	}
-	return ""
+	return nil
 }
 
-func (x *InboxItem) GetTransactionDate() *timestamppb.Timestamp {
+func (x *Alert) GetAcknowledgeTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.TransactionDate
+		return x.AcknowledgeTime
 	}
 	return nil
 }
 
-func (x *InboxItem) GetAccountId() string {
+func (x *Alert) GetCreateTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.AccountId
+		return x.CreateTime
 	}
-	return ""
+	return nil
 }
 
-func (x *InboxItem) GetBudgetId() string {
+func (x *Alert) GetUpdateTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.BudgetId
+		return x.UpdateTime
 	}
-	return ""
+	return nil
 }
 
-func (x *InboxItem) GetScheduledPaymentId() string {
+// The request for
+// [ListAlerts][saturn.finance.v1.Finance.ListAlerts].
+type ListAlertsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional. Only return open alerts and snoozed alerts whose snooze has elapsed.
+	ActiveOnly bool `protobuf:"varint,1,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
+	// Optional. Only return alerts raised by this rule.
+	Rule *Alert_Rule `protobuf:"varint,2,opt,name=rule,proto3,enum=saturn.finance.v1.Alert_Rule,oneof" json:"rule,omitempty"`
+	// Optional. Maximum number of items to return.
+	PageSize int32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Optional. Keyset page token.
+	PageToken     string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAlertsRequest) Reset() {
+	*x = ListAlertsRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[177]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAlertsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAlertsRequest) ProtoMessage() {}
+
+func (x *ListAlertsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[177]
 	if x != nil {
-		return x.ScheduledPaymentId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *InboxItem) GetTransactionId() string {
+// Deprecated: Use ListAlertsRequest.ProtoReflect.Descriptor instead.
+func (*ListAlertsRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{177}
+}
+
+func (x *ListAlertsRequest) GetActiveOnly() bool {
 	if x != nil {
-		return x.TransactionId
+		return x.ActiveOnly
 	}
-	return ""
+	return false
 }
 
-func (x *InboxItem) GetRawPayload() string {
+func (x *ListAlertsRequest) GetRule() Alert_Rule {
+	if x != nil && x.Rule != nil {
+		return *x.Rule
+	}
+	return Alert_RULE_UNSPECIFIED
+}
+
+func (x *ListAlertsRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.RawPayload
+		return x.PageSize
 	}
-	return ""
+	return 0
 }
 
-func (x *InboxItem) GetMetadata() map[string]string {
+func (x *ListAlertsRequest) GetPageToken() string {
 	if x != nil {
-		return x.Metadata
+		return x.PageToken
 	}
-	return nil
+	return ""
 }
 
-func (x *InboxItem) GetCreateTime() *timestamppb.Timestamp {
+// The response for
+// [ListAlerts][saturn.finance.v1.Finance.ListAlerts].
+type ListAlertsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Alerts of the space.
+	Alerts []*Alert `protobuf:"bytes,1,rep,name=alerts,proto3" json:"alerts,omitempty"`
+	// Next page keyset token. Empty if no more pages are available.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAlertsResponse) Reset() {
+	*x = ListAlertsResponse{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[178]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAlertsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAlertsResponse) ProtoMessage() {}
+
+func (x *ListAlertsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[178]
 	if x != nil {
-		return x.CreateTime
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *InboxItem) GetBorrowingId() string {
-	if x != nil && x.BorrowingId != nil {
-		return *x.BorrowingId
+// Deprecated: Use ListAlertsResponse.ProtoReflect.Descriptor instead.
+func (*ListAlertsResponse) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{178}
+}
+
+func (x *ListAlertsResponse) GetAlerts() []*Alert {
+	if x != nil {
+		return x.Alerts
 	}
-	return ""
+	return nil
 }
 
-func (x *InboxItem) GetBorrowingLinkType() BorrowingLinkType {
-	if x != nil && x.BorrowingLinkType != nil {
-		return *x.BorrowingLinkType
+func (x *ListAlertsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
 	}
-	return BorrowingLinkType_BORROWING_LINK_TYPE_UNSPECIFIED
+	return ""
 }
 
 // The request for
-// [ListInboxItems][saturn.finance.v1.Finance.ListInboxItems].
-type ListInboxItemsRequest struct {
+// [GetAlert][saturn.finance.v1.Finance.GetAlert].
+type GetAlertRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Optional. Number of items to retrieve per page.
-	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	// Optional. Cursor token from previous page response.
-	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	// Optional. Free-text search query matching vendor names or classifications.
-	SearchQuery *string `protobuf:"bytes,3,opt,name=search_query,json=searchQuery,proto3,oneof" json:"search_query,omitempty"`
-	// Optional. Stage status to filter by.
-	Status *InboxItem_Status `protobuf:"varint,4,opt,name=status,proto3,enum=saturn.finance.v1.InboxItem_Status,oneof" json:"status,omitempty"`
-	// Optional. Document category classification filter.
-	DocType *InboxItem_DocType `protobuf:"varint,5,opt,name=doc_type,json=docType,proto3,enum=saturn.finance.v1.InboxItem_DocType,oneof" json:"doc_type,omitempty"`
-	// Optional. Sorting rules (e.g. "create_time:desc").
-	Sort string `protobuf:"bytes,6,opt,name=sort,proto3" json:"sort,omitempty"`
-	// Optional. Representation view.
-	View          InboxItem_View `protobuf:"varint,7,opt,name=view,proto3,enum=saturn.finance.v1.InboxItem_View" json:"view,omitempty"`
+	// Required. Alert identifier.
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListInboxItemsRequest) Reset() {
-	*x = ListInboxItemsRequest{}
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[75]
+func (x *GetAlertRequest) Reset() {
+	*x = GetAlertRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[179]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListInboxItemsRequest) String() string {
+func (x *GetAlertRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListInboxItemsRequest) ProtoMessage() {}
+func (*GetAlertRequest) ProtoMessage() {}
 
-func (x *ListInboxItemsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[75]
+func (x *GetAlertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[179]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6905,87 +15970,147 @@ func (x *ListInboxItemsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListInboxItemsRequest.ProtoReflect.Descriptor instead.
-func (*ListInboxItemsRequest) Descriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{75}
+// Deprecated: Use GetAlertRequest.ProtoReflect.Descriptor instead.
+func (*GetAlertRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{179}
 }
 
-func (x *ListInboxItemsRequest) GetPageSize() int32 {
+func (x *GetAlertRequest) GetId() string {
 	if x != nil {
-		return x.PageSize
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-func (x *ListInboxItemsRequest) GetPageToken() string {
+// The request for
+// [AcknowledgeAlert][saturn.finance.v1.Finance.AcknowledgeAlert].
+type AcknowledgeAlertRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. Alert identifier.
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcknowledgeAlertRequest) Reset() {
+	*x = AcknowledgeAlertRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[180]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcknowledgeAlertRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcknowledgeAlertRequest) ProtoMessage() {}
+
+func (x *AcknowledgeAlertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[180]
 	if x != nil {
-		return x.PageToken
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ListInboxItemsRequest) GetSearchQuery() string {
-	if x != nil && x.SearchQuery != nil {
-		return *x.SearchQuery
+// Deprecated: Use AcknowledgeAlertRequest.ProtoReflect.Descriptor instead.
+func (*AcknowledgeAlertRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{180}
+}
+
+func (x *AcknowledgeAlertRequest) GetId() string {
+	if x != nil {
+		return x.Id
 	}
 	return ""
 }
 
-func (x *ListInboxItemsRequest) GetStatus() InboxItem_Status {
-	if x != nil && x.Status != nil {
-		return *x.Status
-	}
-	return InboxItem_STATUS_UNSPECIFIED
+// The request for
+// [SnoozeAlert][saturn.finance.v1.Finance.SnoozeAlert].
+type SnoozeAlertRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. Alert identifier.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Required. Time the alert becomes active again. Must be in the future.
+	SnoozeUntil   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=snooze_until,json=snoozeUntil,proto3" json:"snooze_until,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListInboxItemsRequest) GetDocType() InboxItem_DocType {
-	if x != nil && x.DocType != nil {
-		return *x.DocType
+func (x *SnoozeAlertRequest) Reset() {
+	*x = SnoozeAlertRequest{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[181]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SnoozeAlertRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnoozeAlertRequest) ProtoMessage() {}
+
+func (x *SnoozeAlertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[181]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return InboxItem_DOC_TYPE_UNSPECIFIED
+	return mi.MessageOf(x)
 }
 
-func (x *ListInboxItemsRequest) GetSort() string {
+// Deprecated: Use SnoozeAlertRequest.ProtoReflect.Descriptor instead.
+func (*SnoozeAlertRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{181}
+}
+
+func (x *SnoozeAlertRequest) GetId() string {
 	if x != nil {
-		return x.Sort
+		return x.Id
 	}
 	return ""
 }
 
-func (x *ListInboxItemsRequest) GetView() InboxItem_View {
+func (x *SnoozeAlertRequest) GetSnoozeUntil() *timestamppb.Timestamp {
 	if x != nil {
-		return x.View
+		return x.SnoozeUntil
 	}
-	return InboxItem_VIEW_UNSPECIFIED
+	return nil
 }
 
-// The response for
-// [ListInboxItems][saturn.finance.v1.Finance.ListInboxItems].
-type ListInboxItemsResponse struct {
+// TransactionChangedEvent is published after an expense is created or updated, so the alert rules can evaluate it.
+type TransactionChangedEvent struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// List of staged inbox items.
-	InboxItems []*InboxItem `protobuf:"bytes,1,rep,name=inbox_items,json=inboxItems,proto3" json:"inbox_items,omitempty"`
-	// Cursor page token for retrieving the next page.
-	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// Space the transaction belongs to.
+	SpaceId string `protobuf:"bytes,1,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	// Transaction identifier.
+	TransactionId string `protobuf:"bytes,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListInboxItemsResponse) Reset() {
-	*x = ListInboxItemsResponse{}
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[76]
+func (x *TransactionChangedEvent) Reset() {
+	*x = TransactionChangedEvent{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[182]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListInboxItemsResponse) String() string {
+func (x *TransactionChangedEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListInboxItemsResponse) ProtoMessage() {}
+func (*TransactionChangedEvent) ProtoMessage() {}
 
-func (x *ListInboxItemsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[76]
+func (x *TransactionChangedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[182]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6996,53 +16121,51 @@ func (x *ListInboxItemsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListInboxItemsResponse.ProtoReflect.Descriptor instead.
-func (*ListInboxItemsResponse) Descriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{76}
+// Deprecated: Use TransactionChangedEvent.ProtoReflect.Descriptor instead.
+func (*TransactionChangedEvent) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{182}
 }
 
-func (x *ListInboxItemsResponse) GetInboxItems() []*InboxItem {
+func (x *TransactionChangedEvent) GetSpaceId() string {
 	if x != nil {
-		return x.InboxItems
+		return x.SpaceId
 	}
-	return nil
+	return ""
 }
 
-func (x *ListInboxItemsResponse) GetNextPageToken() string {
+func (x *TransactionChangedEvent) GetTransactionId() string {
 	if x != nil {
-		return x.NextPageToken
+		return x.TransactionId
 	}
 	return ""
 }
 
-// The request for
-// [UpdateInboxItem][saturn.finance.v1.Finance.UpdateInboxItem].
-type UpdateInboxItemRequest struct {
+// AlertRaisedEvent is published for every new alert.
+type AlertRaisedEvent struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. Target staged inbox item identifier to update.
-	// Values are of the form `inb_[a-zA-Z0-9]+`.
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Required. Updated staging inbox item parameters.
-	InboxItem     *InboxItem `protobuf:"bytes,2,opt,name=inbox_item,json=inboxItem,proto3" json:"inbox_item,omitempty"`
+	// Space the alert belongs to.
+	SpaceId string `protobuf:"bytes,1,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	// The raised alert.
+	Alert         *Alert `protobuf:"bytes,2,opt,name=alert,proto3" json:"alert,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateInboxItemRequest) Reset() {
-	*x = UpdateInboxItemRequest{}
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[77]
+func (x *AlertRaisedEvent) Reset() {
+	*x = AlertRaisedEvent{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[183]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateInboxItemRequest) String() string {
+func (x *AlertRaisedEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateInboxItemRequest) ProtoMessage() {}
+func (*AlertRaisedEvent) ProtoMessage() {}
 
-func (x *UpdateInboxItemRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[77]
+func (x *AlertRaisedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[183]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7053,51 +16176,47 @@ func (x *UpdateInboxItemRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateInboxItemRequest.ProtoReflect.Descriptor instead.
-func (*UpdateInboxItemRequest) Descriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{77}
+// Deprecated: Use AlertRaisedEvent.ProtoReflect.Descriptor instead.
+func (*AlertRaisedEvent) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{183}
 }
 
-func (x *UpdateInboxItemRequest) GetId() string {
+func (x *AlertRaisedEvent) GetSpaceId() string {
 	if x != nil {
-		return x.Id
+		return x.SpaceId
 	}
 	return ""
 }
 
-func (x *UpdateInboxItemRequest) GetInboxItem() *InboxItem {
+func (x *AlertRaisedEvent) GetAlert() *Alert {
 	if x != nil {
-		return x.InboxItem
+		return x.Alert
 	}
 	return nil
 }
 
-// The request for
-// [ApproveInboxItem][saturn.finance.v1.Finance.ApproveInboxItem].
-type ApproveInboxItemRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. Target staged inbox item identifier to approve.
-	// Values are of the form `inb_[a-zA-Z0-9]+`.
-	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+// CloseCardStatementsPayload defines the job payload that closes elapsed credit card billing cycles.
+type CloseCardStatementsPayload struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ApproveInboxItemRequest) Reset() {
-	*x = ApproveInboxItemRequest{}
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[78]
+func (x *CloseCardStatementsPayload) Reset() {
+	*x = CloseCardStatementsPayload{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[184]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ApproveInboxItemRequest) String() string {
+func (x *CloseCardStatementsPayload) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApproveInboxItemRequest) ProtoMessage() {}
+func (*CloseCardStatementsPayload) ProtoMessage() {}
 
-func (x *ApproveInboxItemRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[78]
+func (x *CloseCardStatementsPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[184]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7108,44 +16227,37 @@ func (x *ApproveInboxItemRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApproveInboxItemRequest.ProtoReflect.Descriptor instead.
-func (*ApproveInboxItemRequest) Descriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{78}
-}
-
-func (x *ApproveInboxItemRequest) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+// Deprecated: Use CloseCardStatementsPayload.ProtoReflect.Descriptor instead.
+func (*CloseCardStatementsPayload) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{184}
 }
 
-// The request for
-// [DiscardInboxItem][saturn.finance.v1.Finance.DiscardInboxItem].
-type DiscardInboxItemRequest struct {
+// RunLedgerExportPayload defines the job payload that generates a queued ledger export.
+type RunLedgerExportPayload struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. Target staged inbox item identifier to discard.
-	// Values are of the form `inb_[a-zA-Z0-9]+`.
-	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Space the export belongs to.
+	SpaceId string `protobuf:"bytes,1,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	// Export identifier.
+	ExportId      string `protobuf:"bytes,2,opt,name=export_id,json=exportId,proto3" json:"export_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DiscardInboxItemRequest) Reset() {
-	*x = DiscardInboxItemRequest{}
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[79]
+func (x *RunLedgerExportPayload) Reset() {
+	*x = RunLedgerExportPayload{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[185]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DiscardInboxItemRequest) String() string {
+func (x *RunLedgerExportPayload) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DiscardInboxItemRequest) ProtoMessage() {}
+func (*RunLedgerExportPayload) ProtoMessage() {}
 
-func (x *DiscardInboxItemRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[79]
+func (x *RunLedgerExportPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[185]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7156,14 +16268,21 @@ func (x *DiscardInboxItemRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DiscardInboxItemRequest.ProtoReflect.Descriptor instead.
-func (*DiscardInboxItemRequest) Descriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{79}
+// Deprecated: Use RunLedgerExportPayload.ProtoReflect.Descriptor instead.
+func (*RunLedgerExportPayload) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{185}
 }
 
-func (x *DiscardInboxItemRequest) GetId() string {
+func (x *RunLedgerExportPayload) GetSpaceId() string {
 	if x != nil {
-		return x.Id
+		return x.SpaceId
+	}
+	return ""
+}
+
+func (x *RunLedgerExportPayload) GetExportId() string {
+	if x != nil {
+		return x.ExportId
 	}
 	return ""
 }
@@ -7183,15 +16302,21 @@ type Budget_ActivePeriod struct {
 	ExchangeRateToBase float64 `protobuf:"fixed64,5,opt,name=exchange_rate_to_base,json=exchangeRateToBase,proto3" json:"exchange_rate_to_base,omitempty"`
 	// Output only. Base currency identifier.
 	BaseCurrency string `protobuf:"bytes,6,opt,name=base_currency,json=baseCurrency,proto3" json:"base_currency,omitempty"`
-	// Output only. Limit in base currency cents.
-	LimitInBase   int64 `protobuf:"varint,7,opt,name=limit_in_base,json=limitInBase,proto3" json:"limit_in_base,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// Output only. Effective limit (including carried-in balance) in base currency cents.
+	LimitInBase int64 `protobuf:"varint,7,opt,name=limit_in_base,json=limitInBase,proto3" json:"limit_in_base,omitempty"`
+	// Output only. Signed balance carried in from the previous period in local currency cents.
+	CarriedIn int64 `protobuf:"varint,8,opt,name=carried_in,json=carriedIn,proto3" json:"carried_in,omitempty"`
+	// Output only. Signed balance carried out into the next period in local currency cents.
+	CarriedOut int64 `protobuf:"varint,9,opt,name=carried_out,json=carriedOut,proto3" json:"carried_out,omitempty"`
+	// Output only. Spendable limit for the period (limit plus carried-in balance) in local currency cents.
+	EffectiveLimit int64 `protobuf:"varint,10,opt,name=effective_limit,json=effectiveLimit,proto3" json:"effective_limit,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *Budget_ActivePeriod) Reset() {
 	*x = Budget_ActivePeriod{}
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[80]
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[186]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7203,7 +16328,7 @@ func (x *Budget_ActivePeriod) String() string {
 func (*Budget_ActivePeriod) ProtoMessage() {}
 
 func (x *Budget_ActivePeriod) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[80]
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[186]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7268,6 +16393,27 @@ func (x *Budget_ActivePeriod) GetLimitInBase() int64 {
 	return 0
 }
 
+func (x *Budget_ActivePeriod) GetCarriedIn() int64 {
+	if x != nil {
+		return x.CarriedIn
+	}
+	return 0
+}
+
+func (x *Budget_ActivePeriod) GetCarriedOut() int64 {
+	if x != nil {
+		return x.CarriedOut
+	}
+	return 0
+}
+
+func (x *Budget_ActivePeriod) GetEffectiveLimit() int64 {
+	if x != nil {
+		return x.EffectiveLimit
+	}
+	return 0
+}
+
 // AccountInfo wraps minimal account details required for UI listing.
 type Transaction_AccountInfo struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -7285,7 +16431,7 @@ type Transaction_AccountInfo struct {
 
 func (x *Transaction_AccountInfo) Reset() {
 	*x = Transaction_AccountInfo{}
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[81]
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[187]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7297,7 +16443,7 @@ func (x *Transaction_AccountInfo) String() string {
 func (*Transaction_AccountInfo) ProtoMessage() {}
 
 func (x *Transaction_AccountInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[81]
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[187]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7310,7 +16456,7 @@ func (x *Transaction_AccountInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Transaction_AccountInfo.ProtoReflect.Descriptor instead.
 func (*Transaction_AccountInfo) Descriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{19, 0}
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{20, 0}
 }
 
 func (x *Transaction_AccountInfo) GetId() string {
@@ -7320,53 +16466,264 @@ func (x *Transaction_AccountInfo) GetId() string {
 	return ""
 }
 
-func (x *Transaction_AccountInfo) GetName() string {
+func (x *Transaction_AccountInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Transaction_AccountInfo) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *Transaction_AccountInfo) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+// BudgetInfo wraps minimal budget details required for UI listing.
+type Transaction_BudgetInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Output only. Unique identifier of the budget.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Output only. User-friendly name of the budget.
+	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Transaction_BudgetInfo) Reset() {
+	*x = Transaction_BudgetInfo{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[188]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Transaction_BudgetInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Transaction_BudgetInfo) ProtoMessage() {}
+
+func (x *Transaction_BudgetInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[188]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Transaction_BudgetInfo.ProtoReflect.Descriptor instead.
+func (*Transaction_BudgetInfo) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{20, 1}
+}
+
+func (x *Transaction_BudgetInfo) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Transaction_BudgetInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// Contribution is a single movement of funds towards or away from the goal.
+type GoalProgress_Contribution struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Identifier of the contributing transaction.
+	TransactionId string `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	// Transaction type of the contribution.
+	Type Transaction_Type `protobuf:"varint,2,opt,name=type,proto3,enum=saturn.finance.v1.Transaction_Type" json:"type,omitempty"`
+	// Transaction description.
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Signed amount in goal currency minor units. Withdrawals are negative.
+	Amount int64 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Date of the contribution.
+	TransactionDate *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=transaction_date,json=transactionDate,proto3" json:"transaction_date,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GoalProgress_Contribution) Reset() {
+	*x = GoalProgress_Contribution{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[190]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoalProgress_Contribution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoalProgress_Contribution) ProtoMessage() {}
+
+func (x *GoalProgress_Contribution) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[190]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoalProgress_Contribution.ProtoReflect.Descriptor instead.
+func (*GoalProgress_Contribution) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{36, 0}
+}
+
+func (x *GoalProgress_Contribution) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *GoalProgress_Contribution) GetType() Transaction_Type {
+	if x != nil {
+		return x.Type
+	}
+	return Transaction_TYPE_UNSPECIFIED
+}
+
+func (x *GoalProgress_Contribution) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *GoalProgress_Contribution) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *GoalProgress_Contribution) GetTransactionDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TransactionDate
+	}
+	return nil
+}
+
+// A single changed field.
+type TransactionDiff_FieldChange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Field name, as used in the update mask.
+	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	// Value before the change. Empty when unset; tags are comma separated.
+	OldValue string `protobuf:"bytes,2,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"`
+	// Value after the change. Empty when unset; tags are comma separated.
+	NewValue      string `protobuf:"bytes,3,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransactionDiff_FieldChange) Reset() {
+	*x = TransactionDiff_FieldChange{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[191]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionDiff_FieldChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionDiff_FieldChange) ProtoMessage() {}
+
+func (x *TransactionDiff_FieldChange) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[191]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionDiff_FieldChange.ProtoReflect.Descriptor instead.
+func (*TransactionDiff_FieldChange) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{56, 0}
+}
+
+func (x *TransactionDiff_FieldChange) GetField() string {
 	if x != nil {
-		return x.Name
+		return x.Field
 	}
 	return ""
 }
 
-func (x *Transaction_AccountInfo) GetColor() string {
+func (x *TransactionDiff_FieldChange) GetOldValue() string {
 	if x != nil {
-		return x.Color
+		return x.OldValue
 	}
 	return ""
 }
 
-func (x *Transaction_AccountInfo) GetType() string {
+func (x *TransactionDiff_FieldChange) GetNewValue() string {
 	if x != nil {
-		return x.Type
+		return x.NewValue
 	}
 	return ""
 }
 
-// BudgetInfo wraps minimal budget details required for UI listing.
-type Transaction_BudgetInfo struct {
+// CashFlowDataPoint tracks income and expenses grouped by granular interval.
+type CashFlowInsights_CashFlowDataPoint struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Output only. Unique identifier of the budget.
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Output only. User-friendly name of the budget.
-	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *Transaction_BudgetInfo) Reset() {
-	*x = Transaction_BudgetInfo{}
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[82]
+	// Dynamic grouping label (e.g. Month name, date string).
+	Label string `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	// Interval start date boundary.
+	StartDate string `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	// Income amount in base currency cents.
+	IncomeInBase int64 `protobuf:"varint,3,opt,name=income_in_base,json=incomeInBase,proto3" json:"income_in_base,omitempty"`
+	// Expense amount in base currency cents.
+	ExpenseInBase int64 `protobuf:"varint,4,opt,name=expense_in_base,json=expenseInBase,proto3" json:"expense_in_base,omitempty"`
+	// Net cash flow (income minus expenses) in base currency cents.
+	NetInBase int64 `protobuf:"varint,5,opt,name=net_in_base,json=netInBase,proto3" json:"net_in_base,omitempty"`
+	// Expenses charged to credit cards in base currency cents. Included in `expense_in_base`.
+	CardSpendingInBase int64 `protobuf:"varint,6,opt,name=card_spending_in_base,json=cardSpendingInBase,proto3" json:"card_spending_in_base,omitempty"`
+	// Credit card payments in base currency cents. Not counted as expenses.
+	CardPaymentsInBase int64 `protobuf:"varint,7,opt,name=card_payments_in_base,json=cardPaymentsInBase,proto3" json:"card_payments_in_base,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CashFlowInsights_CashFlowDataPoint) Reset() {
+	*x = CashFlowInsights_CashFlowDataPoint{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[192]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Transaction_BudgetInfo) String() string {
+func (x *CashFlowInsights_CashFlowDataPoint) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Transaction_BudgetInfo) ProtoMessage() {}
+func (*CashFlowInsights_CashFlowDataPoint) ProtoMessage() {}
 
-func (x *Transaction_BudgetInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[82]
+func (x *CashFlowInsights_CashFlowDataPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[192]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7377,25 +16734,60 @@ func (x *Transaction_BudgetInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Transaction_BudgetInfo.ProtoReflect.Descriptor instead.
-func (*Transaction_BudgetInfo) Descriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{19, 1}
+// Deprecated: Use CashFlowInsights_CashFlowDataPoint.ProtoReflect.Descriptor instead.
+func (*CashFlowInsights_CashFlowDataPoint) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{86, 0}
 }
 
-func (x *Transaction_BudgetInfo) GetId() string {
+func (x *CashFlowInsights_CashFlowDataPoint) GetLabel() string {
 	if x != nil {
-		return x.Id
+		return x.Label
 	}
 	return ""
 }
 
-func (x *Transaction_BudgetInfo) GetName() string {
+func (x *CashFlowInsights_CashFlowDataPoint) GetStartDate() string {
 	if x != nil {
-		return x.Name
+		return x.StartDate
 	}
 	return ""
 }
 
+func (x *CashFlowInsights_CashFlowDataPoint) GetIncomeInBase() int64 {
+	if x != nil {
+		return x.IncomeInBase
+	}
+	return 0
+}
+
+func (x *CashFlowInsights_CashFlowDataPoint) GetExpenseInBase() int64 {
+	if x != nil {
+		return x.ExpenseInBase
+	}
+	return 0
+}
+
+func (x *CashFlowInsights_CashFlowDataPoint) GetNetInBase() int64 {
+	if x != nil {
+		return x.NetInBase
+	}
+	return 0
+}
+
+func (x *CashFlowInsights_CashFlowDataPoint) GetCardSpendingInBase() int64 {
+	if x != nil {
+		return x.CardSpendingInBase
+	}
+	return 0
+}
+
+func (x *CashFlowInsights_CashFlowDataPoint) GetCardPaymentsInBase() int64 {
+	if x != nil {
+		return x.CardPaymentsInBase
+	}
+	return 0
+}
+
 // Detailed contribution metrics of a single budget.
 type SpentInsights_BudgetContribution struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -7419,7 +16811,7 @@ type SpentInsights_BudgetContribution struct {
 
 func (x *SpentInsights_BudgetContribution) Reset() {
 	*x = SpentInsights_BudgetContribution{}
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[84]
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[193]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7431,7 +16823,7 @@ func (x *SpentInsights_BudgetContribution) String() string {
 func (*SpentInsights_BudgetContribution) ProtoMessage() {}
 
 func (x *SpentInsights_BudgetContribution) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[84]
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[193]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7444,7 +16836,7 @@ func (x *SpentInsights_BudgetContribution) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SpentInsights_BudgetContribution.ProtoReflect.Descriptor instead.
 func (*SpentInsights_BudgetContribution) Descriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{29, 0}
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{87, 0}
 }
 
 func (x *SpentInsights_BudgetContribution) GetBudgetId() string {
@@ -7515,7 +16907,7 @@ type SpentInsights_TrendDataPoint struct {
 
 func (x *SpentInsights_TrendDataPoint) Reset() {
 	*x = SpentInsights_TrendDataPoint{}
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[85]
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[194]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7526,96 +16918,307 @@ func (x *SpentInsights_TrendDataPoint) String() string {
 
 func (*SpentInsights_TrendDataPoint) ProtoMessage() {}
 
-func (x *SpentInsights_TrendDataPoint) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[85]
+func (x *SpentInsights_TrendDataPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[194]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpentInsights_TrendDataPoint.ProtoReflect.Descriptor instead.
+func (*SpentInsights_TrendDataPoint) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{87, 1}
+}
+
+func (x *SpentInsights_TrendDataPoint) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *SpentInsights_TrendDataPoint) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *SpentInsights_TrendDataPoint) GetAmountInBase() int64 {
+	if x != nil {
+		return x.AmountInBase
+	}
+	return 0
+}
+
+func (x *SpentInsights_TrendDataPoint) GetTransactionCount() int32 {
+	if x != nil {
+		return x.TransactionCount
+	}
+	return 0
+}
+
+func (x *SpentInsights_TrendDataPoint) GetContributions() []*SpentInsights_BudgetContribution {
+	if x != nil {
+		return x.Contributions
+	}
+	return nil
+}
+
+// BudgetUsage represents progress against budget limits.
+type SpentInsights_BudgetUsage struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique identifier of the budget.
+	BudgetId string `protobuf:"bytes,1,opt,name=budget_id,json=budgetId,proto3" json:"budget_id,omitempty"`
+	// User-friendly name.
+	BudgetName string `protobuf:"bytes,2,opt,name=budget_name,json=budgetName,proto3" json:"budget_name,omitempty"`
+	// Color hex code.
+	BudgetColor string `protobuf:"bytes,3,opt,name=budget_color,json=budgetColor,proto3" json:"budget_color,omitempty"`
+	// Icon identifier.
+	BudgetIcon string `protobuf:"bytes,4,opt,name=budget_icon,json=budgetIcon,proto3" json:"budget_icon,omitempty"`
+	// Budget limit in cents.
+	Limit int64 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Spent amount in cents.
+	Spent int64 `protobuf:"varint,6,opt,name=spent,proto3" json:"spent,omitempty"`
+	// Spent amount converted to base currency cents.
+	SpentInBase int64 `protobuf:"varint,7,opt,name=spent_in_base,json=spentInBase,proto3" json:"spent_in_base,omitempty"`
+	// Limit consumption percentage.
+	UsagePercentage float64 `protobuf:"fixed64,8,opt,name=usage_percentage,json=usagePercentage,proto3" json:"usage_percentage,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SpentInsights_BudgetUsage) Reset() {
+	*x = SpentInsights_BudgetUsage{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[195]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpentInsights_BudgetUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpentInsights_BudgetUsage) ProtoMessage() {}
+
+func (x *SpentInsights_BudgetUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[195]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpentInsights_BudgetUsage.ProtoReflect.Descriptor instead.
+func (*SpentInsights_BudgetUsage) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{87, 2}
+}
+
+func (x *SpentInsights_BudgetUsage) GetBudgetId() string {
+	if x != nil {
+		return x.BudgetId
+	}
+	return ""
+}
+
+func (x *SpentInsights_BudgetUsage) GetBudgetName() string {
+	if x != nil {
+		return x.BudgetName
+	}
+	return ""
+}
+
+func (x *SpentInsights_BudgetUsage) GetBudgetColor() string {
+	if x != nil {
+		return x.BudgetColor
+	}
+	return ""
+}
+
+func (x *SpentInsights_BudgetUsage) GetBudgetIcon() string {
+	if x != nil {
+		return x.BudgetIcon
+	}
+	return ""
+}
+
+func (x *SpentInsights_BudgetUsage) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SpentInsights_BudgetUsage) GetSpent() int64 {
+	if x != nil {
+		return x.Spent
+	}
+	return 0
+}
+
+func (x *SpentInsights_BudgetUsage) GetSpentInBase() int64 {
+	if x != nil {
+		return x.SpentInBase
+	}
+	return 0
+}
+
+func (x *SpentInsights_BudgetUsage) GetUsagePercentage() float64 {
+	if x != nil {
+		return x.UsagePercentage
+	}
+	return 0
+}
+
+// CategoryUsage represents spend distribution by transaction category.
+type SpentInsights_CategoryUsage struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique identifier of the category. "uncategorized" for unclassified spend.
+	CategoryId string `protobuf:"bytes,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	// Parent category identifier. Empty for top-level categories.
+	ParentId string `protobuf:"bytes,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	// User-friendly name.
+	CategoryName string `protobuf:"bytes,3,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
+	// Color hex code.
+	CategoryColor string `protobuf:"bytes,4,opt,name=category_color,json=categoryColor,proto3" json:"category_color,omitempty"`
+	// Icon identifier.
+	CategoryIcon string `protobuf:"bytes,5,opt,name=category_icon,json=categoryIcon,proto3" json:"category_icon,omitempty"`
+	// Total transactions classified under this category.
+	TransactionCount int32 `protobuf:"varint,6,opt,name=transaction_count,json=transactionCount,proto3" json:"transaction_count,omitempty"`
+	// Spent amount converted to base currency cents.
+	SpentInBase int64 `protobuf:"varint,7,opt,name=spent_in_base,json=spentInBase,proto3" json:"spent_in_base,omitempty"`
+	// Share percentage relative to total spent.
+	SharePercentage float64 `protobuf:"fixed64,8,opt,name=share_percentage,json=sharePercentage,proto3" json:"share_percentage,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SpentInsights_CategoryUsage) Reset() {
+	*x = SpentInsights_CategoryUsage{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[196]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpentInsights_CategoryUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpentInsights_CategoryUsage) ProtoMessage() {}
+
+func (x *SpentInsights_CategoryUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[196]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpentInsights_CategoryUsage.ProtoReflect.Descriptor instead.
+func (*SpentInsights_CategoryUsage) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{87, 3}
+}
+
+func (x *SpentInsights_CategoryUsage) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *SpentInsights_CategoryUsage) GetParentId() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ParentId
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use SpentInsights_TrendDataPoint.ProtoReflect.Descriptor instead.
-func (*SpentInsights_TrendDataPoint) Descriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{29, 1}
+func (x *SpentInsights_CategoryUsage) GetCategoryName() string {
+	if x != nil {
+		return x.CategoryName
+	}
+	return ""
 }
 
-func (x *SpentInsights_TrendDataPoint) GetLabel() string {
+func (x *SpentInsights_CategoryUsage) GetCategoryColor() string {
 	if x != nil {
-		return x.Label
+		return x.CategoryColor
 	}
 	return ""
 }
 
-func (x *SpentInsights_TrendDataPoint) GetStartDate() string {
+func (x *SpentInsights_CategoryUsage) GetCategoryIcon() string {
 	if x != nil {
-		return x.StartDate
+		return x.CategoryIcon
 	}
 	return ""
 }
 
-func (x *SpentInsights_TrendDataPoint) GetAmountInBase() int64 {
+func (x *SpentInsights_CategoryUsage) GetTransactionCount() int32 {
 	if x != nil {
-		return x.AmountInBase
+		return x.TransactionCount
 	}
 	return 0
 }
 
-func (x *SpentInsights_TrendDataPoint) GetTransactionCount() int32 {
+func (x *SpentInsights_CategoryUsage) GetSpentInBase() int64 {
 	if x != nil {
-		return x.TransactionCount
+		return x.SpentInBase
 	}
 	return 0
 }
 
-func (x *SpentInsights_TrendDataPoint) GetContributions() []*SpentInsights_BudgetContribution {
+func (x *SpentInsights_CategoryUsage) GetSharePercentage() float64 {
 	if x != nil {
-		return x.Contributions
+		return x.SharePercentage
 	}
-	return nil
+	return 0
 }
 
-// BudgetUsage represents progress against budget limits.
-type SpentInsights_BudgetUsage struct {
+// TagUsage represents spend distribution by tag. A transaction
+// carrying several tags contributes to each of them.
+type SpentInsights_TagUsage struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Unique identifier of the budget.
-	BudgetId string `protobuf:"bytes,1,opt,name=budget_id,json=budgetId,proto3" json:"budget_id,omitempty"`
-	// User-friendly name.
-	BudgetName string `protobuf:"bytes,2,opt,name=budget_name,json=budgetName,proto3" json:"budget_name,omitempty"`
-	// Color hex code.
-	BudgetColor string `protobuf:"bytes,3,opt,name=budget_color,json=budgetColor,proto3" json:"budget_color,omitempty"`
-	// Icon identifier.
-	BudgetIcon string `protobuf:"bytes,4,opt,name=budget_icon,json=budgetIcon,proto3" json:"budget_icon,omitempty"`
-	// Budget limit in cents.
-	Limit int64 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
-	// Spent amount in cents.
-	Spent int64 `protobuf:"varint,6,opt,name=spent,proto3" json:"spent,omitempty"`
+	// Tag label.
+	Tag string `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	// Total transactions carrying this tag.
+	TransactionCount int32 `protobuf:"varint,2,opt,name=transaction_count,json=transactionCount,proto3" json:"transaction_count,omitempty"`
 	// Spent amount converted to base currency cents.
-	SpentInBase int64 `protobuf:"varint,7,opt,name=spent_in_base,json=spentInBase,proto3" json:"spent_in_base,omitempty"`
-	// Limit consumption percentage.
-	UsagePercentage float64 `protobuf:"fixed64,8,opt,name=usage_percentage,json=usagePercentage,proto3" json:"usage_percentage,omitempty"`
+	SpentInBase int64 `protobuf:"varint,3,opt,name=spent_in_base,json=spentInBase,proto3" json:"spent_in_base,omitempty"`
+	// Share percentage relative to total spent.
+	SharePercentage float64 `protobuf:"fixed64,4,opt,name=share_percentage,json=sharePercentage,proto3" json:"share_percentage,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
-func (x *SpentInsights_BudgetUsage) Reset() {
-	*x = SpentInsights_BudgetUsage{}
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[86]
+func (x *SpentInsights_TagUsage) Reset() {
+	*x = SpentInsights_TagUsage{}
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[197]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SpentInsights_BudgetUsage) String() string {
+func (x *SpentInsights_TagUsage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SpentInsights_BudgetUsage) ProtoMessage() {}
+func (*SpentInsights_TagUsage) ProtoMessage() {}
 
-func (x *SpentInsights_BudgetUsage) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[86]
+func (x *SpentInsights_TagUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[197]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7626,63 +17229,35 @@ func (x *SpentInsights_BudgetUsage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SpentInsights_BudgetUsage.ProtoReflect.Descriptor instead.
-func (*SpentInsights_BudgetUsage) Descriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{29, 2}
-}
-
-func (x *SpentInsights_BudgetUsage) GetBudgetId() string {
-	if x != nil {
-		return x.BudgetId
-	}
-	return ""
-}
-
-func (x *SpentInsights_BudgetUsage) GetBudgetName() string {
-	if x != nil {
-		return x.BudgetName
-	}
-	return ""
-}
-
-func (x *SpentInsights_BudgetUsage) GetBudgetColor() string {
-	if x != nil {
-		return x.BudgetColor
-	}
-	return ""
+// Deprecated: Use SpentInsights_TagUsage.ProtoReflect.Descriptor instead.
+func (*SpentInsights_TagUsage) Descriptor() ([]byte, []int) {
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{87, 4}
 }
 
-func (x *SpentInsights_BudgetUsage) GetBudgetIcon() string {
+func (x *SpentInsights_TagUsage) GetTag() string {
 	if x != nil {
-		return x.BudgetIcon
+		return x.Tag
 	}
 	return ""
 }
 
-func (x *SpentInsights_BudgetUsage) GetLimit() int64 {
-	if x != nil {
-		return x.Limit
-	}
-	return 0
-}
-
-func (x *SpentInsights_BudgetUsage) GetSpent() int64 {
+func (x *SpentInsights_TagUsage) GetTransactionCount() int32 {
 	if x != nil {
-		return x.Spent
+		return x.TransactionCount
 	}
 	return 0
 }
 
-func (x *SpentInsights_BudgetUsage) GetSpentInBase() int64 {
+func (x *SpentInsights_TagUsage) GetSpentInBase() int64 {
 	if x != nil {
 		return x.SpentInBase
 	}
 	return 0
 }
 
-func (x *SpentInsights_BudgetUsage) GetUsagePercentage() float64 {
+func (x *SpentInsights_TagUsage) GetSharePercentage() float64 {
 	if x != nil {
-		return x.UsagePercentage
+		return x.SharePercentage
 	}
 	return 0
 }
@@ -7712,7 +17287,7 @@ type SpentInsights_HighValueExpense struct {
 
 func (x *SpentInsights_HighValueExpense) Reset() {
 	*x = SpentInsights_HighValueExpense{}
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[87]
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[198]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7724,7 +17299,7 @@ func (x *SpentInsights_HighValueExpense) String() string {
 func (*SpentInsights_HighValueExpense) ProtoMessage() {}
 
 func (x *SpentInsights_HighValueExpense) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[87]
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[198]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7737,7 +17312,7 @@ func (x *SpentInsights_HighValueExpense) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SpentInsights_HighValueExpense.ProtoReflect.Descriptor instead.
 func (*SpentInsights_HighValueExpense) Descriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{29, 3}
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{87, 5}
 }
 
 func (x *SpentInsights_HighValueExpense) GetTransactionId() string {
@@ -7809,7 +17384,7 @@ type RecurringExpense_BudgetInfo struct {
 
 func (x *RecurringExpense_BudgetInfo) Reset() {
 	*x = RecurringExpense_BudgetInfo{}
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[88]
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[199]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7821,7 +17396,7 @@ func (x *RecurringExpense_BudgetInfo) String() string {
 func (*RecurringExpense_BudgetInfo) ProtoMessage() {}
 
 func (x *RecurringExpense_BudgetInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[88]
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[199]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7834,7 +17409,7 @@ func (x *RecurringExpense_BudgetInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RecurringExpense_BudgetInfo.ProtoReflect.Descriptor instead.
 func (*RecurringExpense_BudgetInfo) Descriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{31, 0}
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{90, 0}
 }
 
 func (x *RecurringExpense_BudgetInfo) GetId() string {
@@ -7878,7 +17453,7 @@ type RecurringExpense_ExecutionState struct {
 
 func (x *RecurringExpense_ExecutionState) Reset() {
 	*x = RecurringExpense_ExecutionState{}
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[89]
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[200]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7890,7 +17465,7 @@ func (x *RecurringExpense_ExecutionState) String() string {
 func (*RecurringExpense_ExecutionState) ProtoMessage() {}
 
 func (x *RecurringExpense_ExecutionState) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[89]
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[200]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7903,7 +17478,7 @@ func (x *RecurringExpense_ExecutionState) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RecurringExpense_ExecutionState.ProtoReflect.Descriptor instead.
 func (*RecurringExpense_ExecutionState) Descriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{31, 1}
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{90, 1}
 }
 
 func (x *RecurringExpense_ExecutionState) GetNextDueDate() *timestamppb.Timestamp {
@@ -7933,7 +17508,7 @@ type ScheduledPayment_BudgetInfo struct {
 
 func (x *ScheduledPayment_BudgetInfo) Reset() {
 	*x = ScheduledPayment_BudgetInfo{}
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[90]
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[201]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7945,7 +17520,7 @@ func (x *ScheduledPayment_BudgetInfo) String() string {
 func (*ScheduledPayment_BudgetInfo) ProtoMessage() {}
 
 func (x *ScheduledPayment_BudgetInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[90]
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[201]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7958,7 +17533,7 @@ func (x *ScheduledPayment_BudgetInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScheduledPayment_BudgetInfo.ProtoReflect.Descriptor instead.
 func (*ScheduledPayment_BudgetInfo) Descriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{32, 0}
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{91, 0}
 }
 
 func (x *ScheduledPayment_BudgetInfo) GetId() string {
@@ -8001,7 +17576,7 @@ type ScheduledPayment_RecurringExpenseInfo struct {
 
 func (x *ScheduledPayment_RecurringExpenseInfo) Reset() {
 	*x = ScheduledPayment_RecurringExpenseInfo{}
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[91]
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[202]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8013,7 +17588,7 @@ func (x *ScheduledPayment_RecurringExpenseInfo) String() string {
 func (*ScheduledPayment_RecurringExpenseInfo) ProtoMessage() {}
 
 func (x *ScheduledPayment_RecurringExpenseInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[91]
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[202]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8026,7 +17601,7 @@ func (x *ScheduledPayment_RecurringExpenseInfo) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use ScheduledPayment_RecurringExpenseInfo.ProtoReflect.Descriptor instead.
 func (*ScheduledPayment_RecurringExpenseInfo) Descriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{32, 1}
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{91, 1}
 }
 
 func (x *ScheduledPayment_RecurringExpenseInfo) GetId() string {
@@ -8063,7 +17638,7 @@ type Account_Conversion struct {
 
 func (x *Account_Conversion) Reset() {
 	*x = Account_Conversion{}
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[92]
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[203]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8075,7 +17650,7 @@ func (x *Account_Conversion) String() string {
 func (*Account_Conversion) ProtoMessage() {}
 
 func (x *Account_Conversion) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_finance_v1_finance_proto_msgTypes[92]
+	mi := &file_saturn_finance_v1_finance_proto_msgTypes[203]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8088,7 +17663,7 @@ func (x *Account_Conversion) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Account_Conversion.ProtoReflect.Descriptor instead.
 func (*Account_Conversion) Descriptor() ([]byte, []int) {
-	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{59, 0}
+	return file_saturn_finance_v1_finance_proto_rawDescGZIP(), []int{123, 0}
 }
 
 func (x *Account_Conversion) GetBalance() int64 {
@@ -8109,14 +17684,19 @@ var File_saturn_finance_v1_finance_proto protoreflect.FileDescriptor
 
 const file_saturn_finance_v1_finance_proto_rawDesc = "" +
 	"\n" +
-	"\x1fsaturn/finance/v1/finance.proto\x12\x11saturn.finance.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a*saturn/platform/scheduler/v1/options.proto\"\xdf\x01\n" +
+	"\x1fsaturn/finance/v1/finance.proto\x12\x11saturn.finance.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a(saturn/platform/message/v1/options.proto\x1a*saturn/platform/scheduler/v1/options.proto\"\xd7\x03\n" +
 	"\x0fFinanceSettings\x12\x1e\n" +
 	"\bspace_id\x18\x01 \x01(\tB\x03\xe0A\x03R\aspaceId\x12(\n" +
 	"\rbase_currency\x18\x02 \x01(\tB\x03\xe0A\x02R\fbaseCurrency\x12@\n" +
 	"\vcreate_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
 	"createTime\x12@\n" +
 	"\vupdate_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
-	"updateTime\"\x86\t\n" +
+	"updateTime\x122\n" +
+	"\x12enabled_currencies\x18\x05 \x03(\tB\x03\xe0A\x01R\x11enabledCurrencies\x12\x1f\n" +
+	"\btimezone\x18\x06 \x01(\tB\x03\xe0A\x01R\btimezone\x127\n" +
+	"\x15budget_alert_percents\x18\a \x03(\x05B\x03\xe0A\x01R\x13budgetAlertPercents\x12-\n" +
+	"\x10anomaly_std_devs\x18\b \x01(\x01B\x03\xe0A\x01R\x0eanomalyStdDevs\x129\n" +
+	"\x16duplicate_window_hours\x18\t \x01(\x05B\x03\xe0A\x01R\x14duplicateWindowHours\"\xa7\r\n" +
 	"\x06Budget\x12\x13\n" +
 	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x03R\x02id\x12\x1e\n" +
 	"\bspace_id\x18\x02 \x01(\tB\x03\xe0A\x03R\aspaceId\x12\x17\n" +
@@ -8134,7 +17714,13 @@ const file_saturn_finance_v1_finance_proto_rawDesc = "" +
 	"createTime\x12@\n" +
 	"\vupdate_time\x18\r \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
 	"updateTime\x12\x1d\n" +
-	"\aversion\x18\x0e \x01(\x03B\x03\xe0A\x03R\aversion\x1a\xe6\x02\n" +
+	"\aversion\x18\x0e \x01(\x03B\x03\xe0A\x03R\aversion\x12V\n" +
+	"\x0frollover_policy\x18\x0f \x01(\x0e2(.saturn.finance.v1.Budget.RolloverPolicyB\x03\xe0A\x01R\x0erolloverPolicy\x12&\n" +
+	"\frollover_cap\x18\x10 \x01(\x03B\x03\xe0A\x01R\vrolloverCap\x12-\n" +
+	"\x10period_start_day\x18\x11 \x01(\x05B\x03\xe0A\x01R\x0eperiodStartDay\x12\"\n" +
+	"\n" +
+	"week_start\x18\x12 \x01(\x05B\x03\xe0A\x01R\tweekStart\x12D\n" +
+	"\rperiod_anchor\x18\x13 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x01R\fperiodAnchor\x1a\xde\x03\n" +
 	"\fActivePeriod\x12>\n" +
 	"\n" +
 	"start_date\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\tstartDate\x12:\n" +
@@ -8143,19 +17729,34 @@ const file_saturn_finance_v1_finance_proto_rawDesc = "" +
 	"\rspent_in_base\x18\x04 \x01(\x03B\x03\xe0A\x03R\vspentInBase\x126\n" +
 	"\x15exchange_rate_to_base\x18\x05 \x01(\x01B\x03\xe0A\x03R\x12exchangeRateToBase\x12(\n" +
 	"\rbase_currency\x18\x06 \x01(\tB\x03\xe0A\x03R\fbaseCurrency\x12'\n" +
-	"\rlimit_in_base\x18\a \x01(\x03B\x03\xe0A\x03R\vlimitInBase\"^\n" +
+	"\rlimit_in_base\x18\a \x01(\x03B\x03\xe0A\x03R\vlimitInBase\x12\"\n" +
+	"\n" +
+	"carried_in\x18\b \x01(\x03B\x03\xe0A\x03R\tcarriedIn\x12$\n" +
+	"\vcarried_out\x18\t \x01(\x03B\x03\xe0A\x03R\n" +
+	"carriedOut\x12,\n" +
+	"\x0feffective_limit\x18\n" +
+	" \x01(\x03B\x03\xe0A\x03R\x0eeffectiveLimit\"{\n" +
 	"\x12RecurrenceInterval\x12#\n" +
 	"\x1fRECURRENCE_INTERVAL_UNSPECIFIED\x10\x00\x12\n" +
 	"\n" +
 	"\x06WEEKLY\x10\x01\x12\v\n" +
 	"\aMONTHLY\x10\x02\x12\n" +
 	"\n" +
-	"\x06YEARLY\x10\x03\"1\n" +
+	"\x06YEARLY\x10\x03\x12\f\n" +
+	"\bBIWEEKLY\x10\x04\x12\r\n" +
+	"\tQUARTERLY\x10\x05\"q\n" +
+	"\x0eRolloverPolicy\x12\x1f\n" +
+	"\x1bROLLOVER_POLICY_UNSPECIFIED\x10\x00\x12\b\n" +
+	"\x04NONE\x10\x01\x12\x11\n" +
+	"\rCARRY_SURPLUS\x10\x02\x12\x11\n" +
+	"\rCARRY_DEFICIT\x10\x03\x12\x0e\n" +
+	"\n" +
+	"CARRY_BOTH\x10\x04\"1\n" +
 	"\x04View\x12\x14\n" +
 	"\x10VIEW_UNSPECIFIED\x10\x00\x12\t\n" +
 	"\x05BASIC\x10\x01\x12\b\n" +
 	"\x04FULL\x10\x02B\x15\n" +
-	"\x13_default_account_id\"\xe1\x04\n" +
+	"\x13_default_account_id\"\xd9\x05\n" +
 	"\fBudgetPeriod\x12\x13\n" +
 	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x03R\x02id\x12 \n" +
 	"\tbudget_id\x18\x02 \x01(\tB\x03\xe0A\x03R\bbudgetId\x12\x1e\n" +
@@ -8173,10 +17774,21 @@ const file_saturn_finance_v1_finance_proto_rawDesc = "" +
 	"\vupdate_time\x18\v \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
 	"updateTime\x12&\n" +
 	"\fspent_amount\x18\f \x01(\x03B\x03\xe0A\x03R\vspentAmount\x12'\n" +
-	"\rspent_in_base\x18\r \x01(\x03B\x03\xe0A\x03R\vspentInBase\"C\n" +
+	"\rspent_in_base\x18\r \x01(\x03B\x03\xe0A\x03R\vspentInBase\x12\"\n" +
+	"\n" +
+	"carried_in\x18\x0e \x01(\x03B\x03\xe0A\x03R\tcarriedIn\x12$\n" +
+	"\vcarried_out\x18\x0f \x01(\x03B\x03\xe0A\x03R\n" +
+	"carriedOut\x12,\n" +
+	"\x0feffective_limit\x18\x10 \x01(\x03B\x03\xe0A\x03R\x0eeffectiveLimit\"d\n" +
 	"\x17ConfigureFinanceRequest\x12(\n" +
-	"\rbase_currency\x18\x01 \x01(\tB\x03\xe0A\x02R\fbaseCurrency\"\x1b\n" +
-	"\x19GetFinanceSettingsRequest\"'\n" +
+	"\rbase_currency\x18\x01 \x01(\tB\x03\xe0A\x02R\fbaseCurrency\x12\x1f\n" +
+	"\btimezone\x18\x02 \x01(\tB\x03\xe0A\x01R\btimezone\"\x1b\n" +
+	"\x19GetFinanceSettingsRequest\"\xba\x01\n" +
+	"\x1cUpdateFinanceSettingsRequest\x12C\n" +
+	"\bsettings\x18\x01 \x01(\v2\".saturn.finance.v1.FinanceSettingsB\x03\xe0A\x02R\bsettings\x12E\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskB\x03\xe0A\x01H\x00R\n" +
+	"updateMask\x88\x01\x01B\x0e\n" +
+	"\f_update_mask\"'\n" +
 	"\x10GetBudgetRequest\x12\x13\n" +
 	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\"M\n" +
 	"\x13CreateBudgetRequest\x126\n" +
@@ -8258,8 +17870,7 @@ const file_saturn_finance_v1_finance_proto_rawDesc = "" +
 	"\x0eexchange_rates\x18\x01 \x03(\v2\x1f.saturn.finance.v1.ExchangeRateR\rexchangeRates\x12&\n" +
 	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"0\n" +
 	"\x19DeleteExchangeRateRequest\x12\x13\n" +
-	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\"\xb6\n" +
-	"\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\"\xea\f\n" +
 	"\vTransaction\x12\x13\n" +
 	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x03R\x02id\x12\x1e\n" +
 	"\bspace_id\x18\x02 \x01(\tB\x03\xe0A\x03R\aspaceId\x12<\n" +
@@ -8281,7 +17892,14 @@ const file_saturn_finance_v1_finance_proto_rawDesc = "" +
 	"account_id\x18\x10 \x01(\tB\x03\xe0A\x01H\x00R\taccountId\x88\x01\x01\x12N\n" +
 	"\aaccount\x18\x12 \x01(\v2*.saturn.finance.v1.Transaction.AccountInfoB\x03\xe0A\x03H\x01R\aaccount\x88\x01\x01\x12K\n" +
 	"\x06budget\x18\x13 \x01(\v2).saturn.finance.v1.Transaction.BudgetInfoB\x03\xe0A\x03H\x02R\x06budget\x88\x01\x01\x12M\n" +
-	"\bmetadata\x18\x14 \x03(\v2,.saturn.finance.v1.Transaction.MetadataEntryB\x03\xe0A\x01R\bmetadata\x1ao\n" +
+	"\bmetadata\x18\x14 \x03(\v2,.saturn.finance.v1.Transaction.MetadataEntryB\x03\xe0A\x01R\bmetadata\x12)\n" +
+	"\vcategory_id\x18\x15 \x01(\tB\x03\xe0A\x01H\x03R\n" +
+	"categoryId\x88\x01\x01\x12\x17\n" +
+	"\x04tags\x18\x16 \x03(\tB\x03\xe0A\x01R\x04tags\x12@\n" +
+	"\x06splits\x18\x17 \x03(\v2#.saturn.finance.v1.TransactionSplitB\x03\xe0A\x01R\x06splits\x12\x1d\n" +
+	"\acleared\x18\x18 \x01(\bB\x03\xe0A\x03R\acleared\x125\n" +
+	"\x11reconciliation_id\x18\x19 \x01(\tB\x03\xe0A\x03H\x04R\x10reconciliationId\x88\x01\x01\x12#\n" +
+	"\bpayee_id\x18\x1a \x01(\tB\x03\xe0A\x01H\x05R\apayeeId\x88\x01\x01\x1ao\n" +
 	"\vAccountInfo\x12\x13\n" +
 	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x03R\x02id\x12\x17\n" +
 	"\x04name\x18\x02 \x01(\tB\x03\xe0A\x03R\x04name\x12\x19\n" +
@@ -8309,28 +17927,243 @@ const file_saturn_finance_v1_finance_proto_rawDesc = "" +
 	"\v_account_idB\n" +
 	"\n" +
 	"\b_accountB\t\n" +
-	"\a_budget\"\xe1\x02\n" +
+	"\a_budgetB\x0e\n" +
+	"\f_category_idB\x14\n" +
+	"\x12_reconciliation_idB\v\n" +
+	"\t_payee_id\"\xad\x02\n" +
+	"\x10TransactionSplit\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x03R\x02id\x12%\n" +
+	"\tbudget_id\x18\x02 \x01(\tB\x03\xe0A\x01H\x00R\bbudgetId\x88\x01\x01\x12%\n" +
+	"\tperiod_id\x18\x03 \x01(\tB\x03\xe0A\x03H\x01R\bperiodId\x88\x01\x01\x12)\n" +
+	"\vcategory_id\x18\x04 \x01(\tB\x03\xe0A\x01H\x02R\n" +
+	"categoryId\x88\x01\x01\x12\x1b\n" +
+	"\x06amount\x18\x05 \x01(\x03B\x03\xe0A\x02R\x06amount\x12)\n" +
+	"\x0eamount_in_base\x18\x06 \x01(\x03B\x03\xe0A\x03R\famountInBase\x12\x17\n" +
+	"\x04note\x18\a \x01(\tB\x03\xe0A\x01R\x04noteB\f\n" +
+	"\n" +
+	"_budget_idB\f\n" +
+	"\n" +
+	"_period_idB\x0e\n" +
+	"\f_category_id\"\xa9\x04\n" +
 	"\fExpenseInput\x12 \n" +
-	"\tbudget_id\x18\x01 \x01(\tB\x03\xe0A\x02R\bbudgetId\x12\x1b\n" +
+	"\tbudget_id\x18\x01 \x01(\tB\x03\xe0A\x01R\bbudgetId\x12\x1b\n" +
 	"\x06amount\x18\x02 \x01(\x03B\x03\xe0A\x02R\x06amount\x12\x1f\n" +
 	"\bcurrency\x18\x03 \x01(\tB\x03\xe0A\x02R\bcurrency\x12%\n" +
 	"\vdescription\x18\x04 \x01(\tB\x03\xe0A\x01R\vdescription\x12J\n" +
 	"\x10transaction_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x01R\x0ftransactionDate\x12F\n" +
 	"\x0eeffective_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x01R\reffectiveDate\x12'\n" +
 	"\n" +
-	"account_id\x18\a \x01(\tB\x03\xe0A\x01H\x00R\taccountId\x88\x01\x01B\r\n" +
-	"\v_account_id\"V\n" +
+	"account_id\x18\a \x01(\tB\x03\xe0A\x01H\x00R\taccountId\x88\x01\x01\x12)\n" +
+	"\vcategory_id\x18\b \x01(\tB\x03\xe0A\x01H\x01R\n" +
+	"categoryId\x88\x01\x01\x12\x17\n" +
+	"\x04tags\x18\t \x03(\tB\x03\xe0A\x01R\x04tags\x12@\n" +
+	"\x06splits\x18\n" +
+	" \x03(\v2#.saturn.finance.v1.TransactionSplitB\x03\xe0A\x01R\x06splits\x12#\n" +
+	"\bpayee_id\x18\v \x01(\tB\x03\xe0A\x01H\x02R\apayeeId\x88\x01\x01B\r\n" +
+	"\v_account_idB\x0e\n" +
+	"\f_category_idB\v\n" +
+	"\t_payee_id\"V\n" +
 	"\x14CreateExpenseRequest\x12>\n" +
 	"\aexpense\x18\x01 \x01(\v2\x1f.saturn.finance.v1.ExpenseInputB\x03\xe0A\x02R\aexpense\"k\n" +
 	"\x14UpdateExpenseRequest\x12\x13\n" +
 	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\x12>\n" +
-	"\aexpense\x18\x02 \x01(\v2\x1f.saturn.finance.v1.ExpenseInputB\x03\xe0A\x02R\aexpense\"/\n" +
+	"\aexpense\x18\x02 \x01(\v2\x1f.saturn.finance.v1.ExpenseInputB\x03\xe0A\x02R\aexpense\"\x8d\x04\n" +
+	"\vIncomeInput\x12\x1b\n" +
+	"\x06amount\x18\x01 \x01(\x03B\x03\xe0A\x02R\x06amount\x12\x1f\n" +
+	"\bcurrency\x18\x02 \x01(\tB\x03\xe0A\x02R\bcurrency\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tB\x03\xe0A\x01R\vdescription\x12J\n" +
+	"\x10transaction_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x01R\x0ftransactionDate\x12F\n" +
+	"\x0eeffective_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x01R\reffectiveDate\x12'\n" +
+	"\n" +
+	"account_id\x18\x06 \x01(\tB\x03\xe0A\x01H\x00R\taccountId\x88\x01\x01\x122\n" +
+	"\x10income_source_id\x18\a \x01(\tB\x03\xe0A\x01H\x01R\x0eincomeSourceId\x88\x01\x01\x12)\n" +
+	"\vcategory_id\x18\b \x01(\tB\x03\xe0A\x01H\x02R\n" +
+	"categoryId\x88\x01\x01\x12\x17\n" +
+	"\x04tags\x18\t \x03(\tB\x03\xe0A\x01R\x04tags\x12#\n" +
+	"\bpayee_id\x18\n" +
+	" \x01(\tB\x03\xe0A\x01H\x03R\apayeeId\x88\x01\x01B\r\n" +
+	"\v_account_idB\x13\n" +
+	"\x11_income_source_idB\x0e\n" +
+	"\f_category_idB\v\n" +
+	"\t_payee_id\"R\n" +
+	"\x13CreateIncomeRequest\x12;\n" +
+	"\x06income\x18\x01 \x01(\v2\x1e.saturn.finance.v1.IncomeInputB\x03\xe0A\x02R\x06income\"g\n" +
+	"\x13UpdateIncomeRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\x12;\n" +
+	"\x06income\x18\x02 \x01(\v2\x1e.saturn.finance.v1.IncomeInputB\x03\xe0A\x02R\x06income\"\xb0\x06\n" +
+	"\fIncomeSource\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x03R\x02id\x12\x1e\n" +
+	"\bspace_id\x18\x02 \x01(\tB\x03\xe0A\x03R\aspaceId\x12\x17\n" +
+	"\x04name\x18\x03 \x01(\tB\x03\xe0A\x02R\x04name\x12\x1f\n" +
+	"\bemployer\x18\x04 \x01(\tB\x03\xe0A\x01R\bemployer\x12,\n" +
+	"\x0fexpected_amount\x18\x05 \x01(\x03B\x03\xe0A\x01R\x0eexpectedAmount\x12\x1f\n" +
+	"\bcurrency\x18\x06 \x01(\tB\x03\xe0A\x02R\bcurrency\x12F\n" +
+	"\acadence\x18\a \x01(\x0e2'.saturn.finance.v1.IncomeSource.CadenceB\x03\xe0A\x02R\acadence\x12'\n" +
+	"\n" +
+	"account_id\x18\b \x01(\tB\x03\xe0A\x01H\x00R\taccountId\x88\x01\x01\x12 \n" +
+	"\tis_active\x18\t \x01(\bB\x03\xe0A\x01R\bisActive\x12@\n" +
+	"\vcreate_time\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime\x12@\n" +
+	"\vupdate_time\x18\v \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"updateTime\x12,\n" +
+	"\x0frecurrence_rule\x18\f \x01(\tB\x03\xe0A\x01R\x0erecurrenceRule\x12C\n" +
+	"\n" +
+	"start_date\x18\r \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x01H\x01R\tstartDate\x88\x01\x01\x12H\n" +
+	"\x0fexception_dates\x18\x0e \x03(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x01R\x0eexceptionDates\"p\n" +
+	"\aCadence\x12\x17\n" +
+	"\x13CADENCE_UNSPECIFIED\x10\x00\x12\n" +
+	"\n" +
+	"\x06WEEKLY\x10\x01\x12\f\n" +
+	"\bBIWEEKLY\x10\x02\x12\v\n" +
+	"\aMONTHLY\x10\x03\x12\n" +
+	"\n" +
+	"\x06YEARLY\x10\x04\x12\r\n" +
+	"\tIRREGULAR\x10\x05\x12\n" +
+	"\n" +
+	"\x06CUSTOM\x10\x06B\r\n" +
+	"\v_account_idB\r\n" +
+	"\v_start_date\"f\n" +
+	"\x19CreateIncomeSourceRequest\x12I\n" +
+	"\rincome_source\x18\x01 \x01(\v2\x1f.saturn.finance.v1.IncomeSourceB\x03\xe0A\x02R\fincomeSource\"-\n" +
+	"\x16GetIncomeSourceRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\"{\n" +
+	"\x19UpdateIncomeSourceRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\x12I\n" +
+	"\rincome_source\x18\x02 \x01(\v2\x1f.saturn.finance.v1.IncomeSourceB\x03\xe0A\x02R\fincomeSource\"0\n" +
+	"\x19DeleteIncomeSourceRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\"\x80\x02\n" +
+	"\x18ListIncomeSourcesRequest\x12 \n" +
+	"\tpage_size\x18\x01 \x01(\x05B\x03\xe0A\x01R\bpageSize\x12\"\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tB\x03\xe0A\x01R\tpageToken\x12)\n" +
+	"\vactive_only\x18\x03 \x01(\bB\x03\xe0A\x01H\x00R\n" +
+	"activeOnly\x88\x01\x01\x12+\n" +
+	"\fsearch_query\x18\x04 \x01(\tB\x03\xe0A\x01H\x01R\vsearchQuery\x88\x01\x01\x12\x1c\n" +
+	"\x04sort\x18\x05 \x01(\tB\x03\xe0A\x01H\x02R\x04sort\x88\x01\x01B\x0e\n" +
+	"\f_active_onlyB\x0f\n" +
+	"\r_search_queryB\a\n" +
+	"\x05_sort\"\x8b\x01\n" +
+	"\x19ListIncomeSourcesResponse\x12F\n" +
+	"\x0eincome_sources\x18\x01 \x03(\v2\x1f.saturn.finance.v1.IncomeSourceR\rincomeSources\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xd2\x05\n" +
+	"\x04Goal\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x03R\x02id\x12\x1e\n" +
+	"\bspace_id\x18\x02 \x01(\tB\x03\xe0A\x03R\aspaceId\x12\x17\n" +
+	"\x04name\x18\x03 \x01(\tB\x03\xe0A\x02R\x04name\x12%\n" +
+	"\vdescription\x18\x04 \x01(\tB\x03\xe0A\x01R\vdescription\x12(\n" +
+	"\rtarget_amount\x18\x05 \x01(\x03B\x03\xe0A\x02R\ftargetAmount\x12\x1f\n" +
+	"\bcurrency\x18\x06 \x01(\tB\x03\xe0A\x02R\bcurrency\x12>\n" +
+	"\n" +
+	"start_date\x18\a \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x01R\tstartDate\x12;\n" +
+	"\bdeadline\x18\b \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x01R\bdeadline\x12'\n" +
+	"\n" +
+	"account_id\x18\t \x01(\tB\x03\xe0A\x01H\x00R\taccountId\x88\x01\x01\x12\x15\n" +
+	"\x03tag\x18\n" +
+	" \x01(\tB\x03\xe0A\x01R\x03tag\x12\x19\n" +
+	"\x05color\x18\v \x01(\tB\x03\xe0A\x01R\x05color\x12\x17\n" +
+	"\x04icon\x18\f \x01(\tB\x03\xe0A\x01R\x04icon\x12;\n" +
+	"\x06status\x18\r \x01(\x0e2\x1e.saturn.finance.v1.Goal.StatusB\x03\xe0A\x01R\x06status\x12@\n" +
+	"\vcreate_time\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime\x12@\n" +
+	"\vupdate_time\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"updateTime\"I\n" +
+	"\x06Status\x12\x16\n" +
+	"\x12STATUS_UNSPECIFIED\x10\x00\x12\n" +
+	"\n" +
+	"\x06ACTIVE\x10\x01\x12\r\n" +
+	"\tCOMPLETED\x10\x02\x12\f\n" +
+	"\bARCHIVED\x10\x03B\r\n" +
+	"\v_account_id\"\x80\x06\n" +
+	"\fGoalProgress\x12+\n" +
+	"\x04goal\x18\x01 \x01(\v2\x17.saturn.finance.v1.GoalR\x04goal\x12!\n" +
+	"\fsaved_amount\x18\x02 \x01(\x03R\vsavedAmount\x12)\n" +
+	"\x10remaining_amount\x18\x03 \x01(\x03R\x0fremainingAmount\x12\x1e\n" +
+	"\n" +
+	"percentage\x18\x04 \x01(\x01R\n" +
+	"percentage\x12-\n" +
+	"\x12contribution_count\x18\x05 \x01(\x05R\x11contributionCount\x12)\n" +
+	"\x10monthly_velocity\x18\x06 \x01(\x03R\x0fmonthlyVelocity\x12V\n" +
+	"\x19projected_completion_date\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x17projectedCompletionDate\x12B\n" +
+	"\x1drequired_monthly_contribution\x18\b \x01(\x03R\x1brequiredMonthlyContribution\x12\x19\n" +
+	"\bon_track\x18\t \x01(\bR\aonTrack\x12R\n" +
+	"\rcontributions\x18\n" +
+	" \x03(\v2,.saturn.finance.v1.GoalProgress.ContributionR\rcontributions\x1a\xef\x01\n" +
+	"\fContribution\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\tR\rtransactionId\x127\n" +
+	"\x04type\x18\x02 \x01(\x0e2#.saturn.finance.v1.Transaction.TypeR\x04type\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x03R\x06amount\x12E\n" +
+	"\x10transaction_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x0ftransactionDate\"E\n" +
+	"\x11CreateGoalRequest\x120\n" +
+	"\x04goal\x18\x01 \x01(\v2\x17.saturn.finance.v1.GoalB\x03\xe0A\x02R\x04goal\"%\n" +
+	"\x0eGetGoalRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\"Z\n" +
+	"\x11UpdateGoalRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\x120\n" +
+	"\x04goal\x18\x02 \x01(\v2\x17.saturn.finance.v1.GoalB\x03\xe0A\x02R\x04goal\"(\n" +
+	"\x11DeleteGoalRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\"\x8a\x02\n" +
+	"\x10ListGoalsRequest\x12 \n" +
+	"\tpage_size\x18\x01 \x01(\x05B\x03\xe0A\x01R\bpageSize\x12\"\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tB\x03\xe0A\x01R\tpageToken\x12@\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x1e.saturn.finance.v1.Goal.StatusB\x03\xe0A\x01H\x00R\x06status\x88\x01\x01\x12+\n" +
+	"\fsearch_query\x18\x04 \x01(\tB\x03\xe0A\x01H\x01R\vsearchQuery\x88\x01\x01\x12\x1c\n" +
+	"\x04sort\x18\x05 \x01(\tB\x03\xe0A\x01H\x02R\x04sort\x88\x01\x01B\t\n" +
+	"\a_statusB\x0f\n" +
+	"\r_search_queryB\a\n" +
+	"\x05_sort\"j\n" +
+	"\x11ListGoalsResponse\x12-\n" +
+	"\x05goals\x18\x01 \x03(\v2\x17.saturn.finance.v1.GoalR\x05goals\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"-\n" +
+	"\x16GetGoalProgressRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\"\xc5\x02\n" +
+	"\bCategory\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x03R\x02id\x12\x1e\n" +
+	"\bspace_id\x18\x02 \x01(\tB\x03\xe0A\x03R\aspaceId\x12%\n" +
+	"\tparent_id\x18\x03 \x01(\tB\x03\xe0A\x01H\x00R\bparentId\x88\x01\x01\x12\x17\n" +
+	"\x04name\x18\x04 \x01(\tB\x03\xe0A\x02R\x04name\x12\x17\n" +
+	"\x04icon\x18\x05 \x01(\tB\x03\xe0A\x01R\x04icon\x12\x19\n" +
+	"\x05color\x18\x06 \x01(\tB\x03\xe0A\x01R\x05color\x12@\n" +
+	"\vcreate_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime\x12@\n" +
+	"\vupdate_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"updateTimeB\f\n" +
+	"\n" +
+	"_parent_id\"U\n" +
+	"\x15CreateCategoryRequest\x12<\n" +
+	"\bcategory\x18\x01 \x01(\v2\x1b.saturn.finance.v1.CategoryB\x03\xe0A\x02R\bcategory\")\n" +
+	"\x12GetCategoryRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\"j\n" +
+	"\x15UpdateCategoryRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\x12<\n" +
+	"\bcategory\x18\x02 \x01(\v2\x1b.saturn.finance.v1.CategoryB\x03\xe0A\x02R\bcategory\",\n" +
+	"\x15DeleteCategoryRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\"\xa2\x02\n" +
+	"\x15ListCategoriesRequest\x12 \n" +
+	"\tpage_size\x18\x01 \x01(\x05B\x03\xe0A\x01R\bpageSize\x12\"\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tB\x03\xe0A\x01R\tpageToken\x12%\n" +
+	"\tparent_id\x18\x03 \x01(\tB\x03\xe0A\x01H\x00R\bparentId\x88\x01\x01\x12)\n" +
+	"\x0etop_level_only\x18\x04 \x01(\bB\x03\xe0A\x01R\ftopLevelOnly\x12+\n" +
+	"\fsearch_query\x18\x05 \x01(\tB\x03\xe0A\x01H\x01R\vsearchQuery\x88\x01\x01\x12\x1c\n" +
+	"\x04sort\x18\x06 \x01(\tB\x03\xe0A\x01H\x02R\x04sort\x88\x01\x01B\f\n" +
+	"\n" +
+	"_parent_idB\x0f\n" +
+	"\r_search_queryB\a\n" +
+	"\x05_sort\"}\n" +
+	"\x16ListCategoriesResponse\x12;\n" +
+	"\n" +
+	"categories\x18\x01 \x03(\v2\x1b.saturn.finance.v1.CategoryR\n" +
+	"categories\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"/\n" +
 	"\x18DeleteTransactionRequest\x12\x13\n" +
 	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\"x\n" +
 	"\x15GetTransactionRequest\x12\x13\n" +
 	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\x12A\n" +
 	"\x04view\x18\x02 \x01(\x0e2#.saturn.finance.v1.Transaction.ViewB\x03\xe0A\x01H\x00R\x04view\x88\x01\x01B\a\n" +
-	"\x05_view\"\xf6\x04\n" +
+	"\x05_view\"\x86\a\n" +
 	"\x17ListTransactionsRequest\x12A\n" +
 	"\x04view\x18\x01 \x01(\x0e2#.saturn.finance.v1.Transaction.ViewB\x03\xe0A\x01H\x00R\x04view\x88\x01\x01\x12 \n" +
 	"\tbudget_id\x18\x02 \x01(\tB\x03\xe0A\x01R\bbudgetId\x12<\n" +
@@ -8346,24 +18179,229 @@ const file_saturn_finance_v1_finance_proto_rawDesc = "" +
 	"\vtransfer_id\x18\v \x01(\tB\x03\xe0A\x01H\x04R\n" +
 	"transferId\x88\x01\x01\x12:\n" +
 	"\x14scheduled_payment_id\x18\r \x01(\tB\x03\xe0A\x01H\x05R\x12scheduledPaymentId\x88\x01\x01\x12+\n" +
-	"\fborrowing_id\x18\x0e \x01(\tB\x03\xe0A\x01H\x06R\vborrowingId\x88\x01\x01B\a\n" +
+	"\fborrowing_id\x18\x0e \x01(\tB\x03\xe0A\x01H\x06R\vborrowingId\x88\x01\x01\x122\n" +
+	"\x10income_source_id\x18\x0f \x01(\tB\x03\xe0A\x01H\aR\x0eincomeSourceId\x88\x01\x01\x12)\n" +
+	"\vcategory_id\x18\x10 \x01(\tB\x03\xe0A\x01H\bR\n" +
+	"categoryId\x88\x01\x01\x12\x17\n" +
+	"\x04tags\x18\x11 \x03(\tB\x03\xe0A\x01R\x04tags\x12 \n" +
+	"\x06filter\x18\x12 \x01(\tB\x03\xe0A\x01H\tR\x06filter\x88\x01\x01\x120\n" +
+	"\x0fsaved_filter_id\x18\x13 \x01(\tB\x03\xe0A\x01H\n" +
+	"R\rsavedFilterId\x88\x01\x01B\a\n" +
 	"\x05_viewB\r\n" +
 	"\v_account_idB\x0f\n" +
 	"\r_search_queryB\a\n" +
 	"\x05_sortB\x0e\n" +
 	"\f_transfer_idB\x17\n" +
 	"\x15_scheduled_payment_idB\x0f\n" +
-	"\r_borrowing_id\"\x86\x01\n" +
+	"\r_borrowing_idB\x13\n" +
+	"\x11_income_source_idB\x0e\n" +
+	"\f_category_idB\t\n" +
+	"\a_filterB\x12\n" +
+	"\x10_saved_filter_id\"\x86\x01\n" +
 	"\x18ListTransactionsResponse\x12B\n" +
 	"\ftransactions\x18\x01 \x03(\v2\x1e.saturn.finance.v1.TransactionR\ftransactions\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xde\x01\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xdd\x02\n" +
+	"\x1eBatchUpdateTransactionsRequest\x12\x15\n" +
+	"\x03ids\x18\x01 \x03(\tB\x03\xe0A\x01R\x03ids\x12 \n" +
+	"\x06filter\x18\x02 \x01(\tB\x03\xe0A\x01H\x00R\x06filter\x88\x01\x01\x120\n" +
+	"\x0fsaved_filter_id\x18\x03 \x01(\tB\x03\xe0A\x01H\x01R\rsavedFilterId\x88\x01\x01\x12E\n" +
+	"\vtransaction\x18\x04 \x01(\v2\x1e.saturn.finance.v1.TransactionB\x03\xe0A\x02R\vtransaction\x12@\n" +
+	"\vupdate_mask\x18\x05 \x01(\v2\x1a.google.protobuf.FieldMaskB\x03\xe0A\x02R\n" +
+	"updateMask\x12(\n" +
+	"\rvalidate_only\x18\x06 \x01(\bB\x03\xe0A\x01R\fvalidateOnlyB\t\n" +
+	"\a_filterB\x12\n" +
+	"\x10_saved_filter_id\"\xe1\x01\n" +
+	"\x0fTransactionDiff\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\tR\rtransactionId\x12H\n" +
+	"\achanges\x18\x02 \x03(\v2..saturn.finance.v1.TransactionDiff.FieldChangeR\achanges\x1a]\n" +
+	"\vFieldChange\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x1b\n" +
+	"\told_value\x18\x02 \x01(\tR\boldValue\x12\x1b\n" +
+	"\tnew_value\x18\x03 \x01(\tR\bnewValue\"w\n" +
+	"\x14AccountBalanceChange\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x1f\n" +
+	"\vold_balance\x18\x02 \x01(\x03R\n" +
+	"oldBalance\x12\x1f\n" +
+	"\vnew_balance\x18\x03 \x01(\x03R\n" +
+	"newBalance\"\x90\x02\n" +
+	"\x1fBatchUpdateTransactionsResponse\x12B\n" +
+	"\ftransactions\x18\x01 \x03(\v2\x1e.saturn.finance.v1.TransactionR\ftransactions\x128\n" +
+	"\x05diffs\x18\x02 \x03(\v2\".saturn.finance.v1.TransactionDiffR\x05diffs\x12P\n" +
+	"\x0fbalance_changes\x18\x03 \x03(\v2'.saturn.finance.v1.AccountBalanceChangeR\x0ebalanceChanges\x12\x1d\n" +
+	"\n" +
+	"period_ids\x18\x04 \x03(\tR\tperiodIds\"\xd4\x01\n" +
+	"\x1eBatchDeleteTransactionsRequest\x12\x15\n" +
+	"\x03ids\x18\x01 \x03(\tB\x03\xe0A\x01R\x03ids\x12 \n" +
+	"\x06filter\x18\x02 \x01(\tB\x03\xe0A\x01H\x00R\x06filter\x88\x01\x01\x120\n" +
+	"\x0fsaved_filter_id\x18\x03 \x01(\tB\x03\xe0A\x01H\x01R\rsavedFilterId\x88\x01\x01\x12(\n" +
+	"\rvalidate_only\x18\x04 \x01(\bB\x03\xe0A\x01R\fvalidateOnlyB\t\n" +
+	"\a_filterB\x12\n" +
+	"\x10_saved_filter_id\"\xd6\x01\n" +
+	"\x1fBatchDeleteTransactionsResponse\x12B\n" +
+	"\ftransactions\x18\x01 \x03(\v2\x1e.saturn.finance.v1.TransactionR\ftransactions\x12P\n" +
+	"\x0fbalance_changes\x18\x02 \x03(\v2'.saturn.finance.v1.AccountBalanceChangeR\x0ebalanceChanges\x12\x1d\n" +
+	"\n" +
+	"period_ids\x18\x03 \x03(\tR\tperiodIds\"\xc4\x02\n" +
+	"\vSavedFilter\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x03R\x02id\x12\x1e\n" +
+	"\bspace_id\x18\x02 \x01(\tB\x03\xe0A\x03R\aspaceId\x12\x17\n" +
+	"\x04name\x18\x03 \x01(\tB\x03\xe0A\x02R\x04name\x12%\n" +
+	"\vdescription\x18\x04 \x01(\tB\x03\xe0A\x01R\vdescription\x12#\n" +
+	"\n" +
+	"expression\x18\x05 \x01(\tB\x03\xe0A\x02R\n" +
+	"expression\x12\x17\n" +
+	"\x04sort\x18\x06 \x01(\tB\x03\xe0A\x01R\x04sort\x12@\n" +
+	"\vcreate_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime\x12@\n" +
+	"\vupdate_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"updateTime\"b\n" +
+	"\x18CreateSavedFilterRequest\x12F\n" +
+	"\fsaved_filter\x18\x01 \x01(\v2\x1e.saturn.finance.v1.SavedFilterB\x03\xe0A\x02R\vsavedFilter\",\n" +
+	"\x15GetSavedFilterRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\"\xce\x01\n" +
+	"\x18UpdateSavedFilterRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\x12F\n" +
+	"\fsaved_filter\x18\x02 \x01(\v2\x1e.saturn.finance.v1.SavedFilterB\x03\xe0A\x02R\vsavedFilter\x12E\n" +
+	"\vupdate_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskB\x03\xe0A\x01H\x00R\n" +
+	"updateMask\x88\x01\x01B\x0e\n" +
+	"\f_update_mask\"/\n" +
+	"\x18DeleteSavedFilterRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\"\x86\x01\n" +
+	"\x17ListSavedFiltersRequest\x12 \n" +
+	"\tpage_size\x18\x01 \x01(\x05B\x03\xe0A\x01R\bpageSize\x12\"\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tB\x03\xe0A\x01R\tpageToken\x12\x1c\n" +
+	"\x04sort\x18\x03 \x01(\tB\x03\xe0A\x01H\x00R\x04sort\x88\x01\x01B\a\n" +
+	"\x05_sort\"\x87\x01\n" +
+	"\x18ListSavedFiltersResponse\x12C\n" +
+	"\rsaved_filters\x18\x01 \x03(\v2\x1e.saturn.finance.v1.SavedFilterR\fsavedFilters\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xd4\x03\n" +
+	"\x05Payee\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x03R\x02id\x12\x1e\n" +
+	"\bspace_id\x18\x02 \x01(\tB\x03\xe0A\x03R\aspaceId\x12\x17\n" +
+	"\x04name\x18\x03 \x01(\tB\x03\xe0A\x02R\x04name\x12\x1d\n" +
+	"\aaliases\x18\x04 \x03(\tB\x03\xe0A\x01R\aaliases\x12\x1f\n" +
+	"\bpatterns\x18\x05 \x03(\tB\x03\xe0A\x01R\bpatterns\x124\n" +
+	"\x11default_budget_id\x18\x06 \x01(\tB\x03\xe0A\x01H\x00R\x0fdefaultBudgetId\x88\x01\x01\x126\n" +
+	"\x12default_account_id\x18\a \x01(\tB\x03\xe0A\x01H\x01R\x10defaultAccountId\x88\x01\x01\x12\x1e\n" +
+	"\blogo_url\x18\b \x01(\tB\x03\xe0A\x01R\alogoUrl\x12@\n" +
+	"\vcreate_time\x18\t \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime\x12@\n" +
+	"\vupdate_time\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"updateTimeB\x14\n" +
+	"\x12_default_budget_idB\x15\n" +
+	"\x13_default_account_id\"I\n" +
+	"\x12CreatePayeeRequest\x123\n" +
+	"\x05payee\x18\x01 \x01(\v2\x18.saturn.finance.v1.PayeeB\x03\xe0A\x02R\x05payee\"&\n" +
+	"\x0fGetPayeeRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\"\xb5\x01\n" +
+	"\x12UpdatePayeeRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\x123\n" +
+	"\x05payee\x18\x02 \x01(\v2\x18.saturn.finance.v1.PayeeB\x03\xe0A\x02R\x05payee\x12E\n" +
+	"\vupdate_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskB\x03\xe0A\x01H\x00R\n" +
+	"updateMask\x88\x01\x01B\x0e\n" +
+	"\f_update_mask\")\n" +
+	"\x12DeletePayeeRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\"\x80\x01\n" +
+	"\x11ListPayeesRequest\x12 \n" +
+	"\tpage_size\x18\x01 \x01(\x05B\x03\xe0A\x01R\bpageSize\x12\"\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tB\x03\xe0A\x01R\tpageToken\x12\x1c\n" +
+	"\x04sort\x18\x03 \x01(\tB\x03\xe0A\x01H\x00R\x04sort\x88\x01\x01B\a\n" +
+	"\x05_sort\"n\n" +
+	"\x12ListPayeesResponse\x120\n" +
+	"\x06payees\x18\x01 \x03(\v2\x18.saturn.finance.v1.PayeeR\x06payees\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xe7\x05\n" +
+	"\x0fTransactionRule\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x03R\x02id\x12\x1e\n" +
+	"\bspace_id\x18\x02 \x01(\tB\x03\xe0A\x03R\aspaceId\x12\x17\n" +
+	"\x04name\x18\x03 \x01(\tB\x03\xe0A\x02R\x04name\x12\x1f\n" +
+	"\bpriority\x18\x04 \x01(\x05B\x03\xe0A\x01R\bpriority\x12 \n" +
+	"\tis_active\x18\x05 \x01(\bB\x03\xe0A\x01R\bisActive\x124\n" +
+	"\x13description_pattern\x18\x06 \x01(\tB\x03\xe0A\x01R\x12descriptionPattern\x12'\n" +
+	"\n" +
+	"account_id\x18\a \x01(\tB\x03\xe0A\x01H\x00R\taccountId\x88\x01\x01\x12'\n" +
+	"\n" +
+	"min_amount\x18\b \x01(\x03B\x03\xe0A\x01H\x01R\tminAmount\x88\x01\x01\x12'\n" +
+	"\n" +
+	"max_amount\x18\t \x01(\x03B\x03\xe0A\x01H\x02R\tmaxAmount\x88\x01\x01\x12,\n" +
+	"\rset_budget_id\x18\n" +
+	" \x01(\tB\x03\xe0A\x01H\x03R\vsetBudgetId\x88\x01\x01\x12*\n" +
+	"\fset_payee_id\x18\v \x01(\tB\x03\xe0A\x01H\x04R\n" +
+	"setPayeeId\x88\x01\x01\x120\n" +
+	"\x0fset_category_id\x18\f \x01(\tB\x03\xe0A\x01H\x05R\rsetCategoryId\x88\x01\x01\x12\x1e\n" +
+	"\badd_tags\x18\r \x03(\tB\x03\xe0A\x01R\aaddTags\x12@\n" +
+	"\vcreate_time\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime\x12@\n" +
+	"\vupdate_time\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"updateTimeB\r\n" +
+	"\v_account_idB\r\n" +
+	"\v_min_amountB\r\n" +
+	"\v_max_amountB\x10\n" +
+	"\x0e_set_budget_idB\x0f\n" +
+	"\r_set_payee_idB\x12\n" +
+	"\x10_set_category_id\"r\n" +
+	"\x1cCreateTransactionRuleRequest\x12R\n" +
+	"\x10transaction_rule\x18\x01 \x01(\v2\".saturn.finance.v1.TransactionRuleB\x03\xe0A\x02R\x0ftransactionRule\"0\n" +
+	"\x19GetTransactionRuleRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\"\xde\x01\n" +
+	"\x1cUpdateTransactionRuleRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\x12R\n" +
+	"\x10transaction_rule\x18\x02 \x01(\v2\".saturn.finance.v1.TransactionRuleB\x03\xe0A\x02R\x0ftransactionRule\x12E\n" +
+	"\vupdate_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskB\x03\xe0A\x01H\x00R\n" +
+	"updateMask\x88\x01\x01B\x0e\n" +
+	"\f_update_mask\"3\n" +
+	"\x1cDeleteTransactionRuleRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\"\xc5\x01\n" +
+	"\x1bListTransactionRulesRequest\x12 \n" +
+	"\tpage_size\x18\x01 \x01(\x05B\x03\xe0A\x01R\bpageSize\x12\"\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tB\x03\xe0A\x01R\tpageToken\x12\x1c\n" +
+	"\x04sort\x18\x03 \x01(\tB\x03\xe0A\x01H\x00R\x04sort\x88\x01\x01\x12)\n" +
+	"\vactive_only\x18\x04 \x01(\bB\x03\xe0A\x01H\x01R\n" +
+	"activeOnly\x88\x01\x01B\a\n" +
+	"\x05_sortB\x0e\n" +
+	"\f_active_only\"\x97\x01\n" +
+	"\x1cListTransactionRulesResponse\x12O\n" +
+	"\x11transaction_rules\x18\x01 \x03(\v2\".saturn.finance.v1.TransactionRuleR\x10transactionRules\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xbd\x01\n" +
+	"\x1cApplyTransactionRulesRequest\x12>\n" +
+	"\n" +
+	"start_date\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x01R\tstartDate\x12:\n" +
+	"\bend_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x01R\aendDate\x12!\n" +
+	"\toverwrite\x18\x03 \x01(\bB\x03\xe0A\x01R\toverwrite\"\x8e\x01\n" +
+	"\x1dApplyTransactionRulesResponse\x12#\n" +
+	"\rscanned_count\x18\x01 \x01(\x05R\fscannedCount\x12#\n" +
+	"\rupdated_count\x18\x02 \x01(\x05R\fupdatedCount\x12#\n" +
+	"\rskipped_count\x18\x03 \x01(\x05R\fskippedCount\"\xde\x01\n" +
 	"\x12GetInsightsRequest\x12L\n" +
 	"\vgranularity\x18\x01 \x01(\x0e2%.saturn.finance.v1.InsightGranularityB\x03\xe0A\x02R\vgranularity\x12>\n" +
 	"\n" +
 	"start_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x02R\tstartDate\x12:\n" +
-	"\bend_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x02R\aendDate\"M\n" +
+	"\bend_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x02R\aendDate\"\xc6\x01\n" +
 	"\x13GetInsightsResponse\x126\n" +
-	"\x05spent\x18\x01 \x01(\v2 .saturn.finance.v1.SpentInsightsR\x05spent\"\x96\f\n" +
+	"\x05spent\x18\x01 \x01(\v2 .saturn.finance.v1.SpentInsightsR\x05spent\x12@\n" +
+	"\tcash_flow\x18\x02 \x01(\v2#.saturn.finance.v1.CashFlowInsightsR\bcashFlow\x125\n" +
+	"\x05goals\x18\x03 \x03(\v2\x1f.saturn.finance.v1.GoalProgressR\x05goals\"\xd7\x04\n" +
+	"\x10CashFlowInsights\x12!\n" +
+	"\ftotal_income\x18\x01 \x01(\x03R\vtotalIncome\x12#\n" +
+	"\rtotal_expense\x18\x02 \x01(\x03R\ftotalExpense\x12\"\n" +
+	"\rnet_cash_flow\x18\x03 \x01(\x03R\vnetCashFlow\x12!\n" +
+	"\fsavings_rate\x18\x04 \x01(\x01R\vsavingsRate\x12K\n" +
+	"\x05trend\x18\x05 \x03(\v25.saturn.finance.v1.CashFlowInsights.CashFlowDataPointR\x05trend\x12#\n" +
+	"\rcard_spending\x18\x06 \x01(\x03R\fcardSpending\x12#\n" +
+	"\rcard_payments\x18\a \x01(\x03R\fcardPayments\x1a\x9c\x02\n" +
+	"\x11CashFlowDataPoint\x12\x14\n" +
+	"\x05label\x18\x01 \x01(\tR\x05label\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x02 \x01(\tR\tstartDate\x12$\n" +
+	"\x0eincome_in_base\x18\x03 \x01(\x03R\fincomeInBase\x12&\n" +
+	"\x0fexpense_in_base\x18\x04 \x01(\x03R\rexpenseInBase\x12\x1e\n" +
+	"\vnet_in_base\x18\x05 \x01(\x03R\tnetInBase\x121\n" +
+	"\x15card_spending_in_base\x18\x06 \x01(\x03R\x12cardSpendingInBase\x121\n" +
+	"\x15card_payments_in_base\x18\a \x01(\x03R\x12cardPaymentsInBase\"\xfd\x10\n" +
 	"\rSpentInsights\x12\x1f\n" +
 	"\vtotal_limit\x18\x01 \x01(\x03R\n" +
 	"totalLimit\x12\x1f\n" +
@@ -8373,7 +18411,11 @@ const file_saturn_finance_v1_finance_proto_rawDesc = "" +
 	"\tburn_rate\x18\x04 \x01(\x01R\bburnRate\x12E\n" +
 	"\x05trend\x18\x05 \x03(\v2/.saturn.finance.v1.SpentInsights.TrendDataPointR\x05trend\x12R\n" +
 	"\rdistributions\x18\x06 \x03(\v2,.saturn.finance.v1.SpentInsights.BudgetUsageR\rdistributions\x12T\n" +
-	"\ftop_expenses\x18\a \x03(\v21.saturn.finance.v1.SpentInsights.HighValueExpenseR\vtopExpenses\x1a\xa3\x02\n" +
+	"\ftop_expenses\x18\a \x03(\v21.saturn.finance.v1.SpentInsights.HighValueExpenseR\vtopExpenses\x12N\n" +
+	"\n" +
+	"categories\x18\b \x03(\v2..saturn.finance.v1.SpentInsights.CategoryUsageR\n" +
+	"categories\x12=\n" +
+	"\x04tags\x18\t \x03(\v2).saturn.finance.v1.SpentInsights.TagUsageR\x04tags\x1a\xa3\x02\n" +
 	"\x12BudgetContribution\x12\x1b\n" +
 	"\tbudget_id\x18\x01 \x01(\tR\bbudgetId\x12\x1f\n" +
 	"\vbudget_name\x18\x02 \x01(\tR\n" +
@@ -8400,7 +18442,22 @@ const file_saturn_finance_v1_finance_proto_rawDesc = "" +
 	"\x05limit\x18\x05 \x01(\x03R\x05limit\x12\x14\n" +
 	"\x05spent\x18\x06 \x01(\x03R\x05spent\x12\"\n" +
 	"\rspent_in_base\x18\a \x01(\x03R\vspentInBase\x12)\n" +
-	"\x10usage_percentage\x18\b \x01(\x01R\x0fusagePercentage\x1a\xe0\x02\n" +
+	"\x10usage_percentage\x18\b \x01(\x01R\x0fusagePercentage\x1a\xba\x02\n" +
+	"\rCategoryUsage\x12\x1f\n" +
+	"\vcategory_id\x18\x01 \x01(\tR\n" +
+	"categoryId\x12\x1b\n" +
+	"\tparent_id\x18\x02 \x01(\tR\bparentId\x12#\n" +
+	"\rcategory_name\x18\x03 \x01(\tR\fcategoryName\x12%\n" +
+	"\x0ecategory_color\x18\x04 \x01(\tR\rcategoryColor\x12#\n" +
+	"\rcategory_icon\x18\x05 \x01(\tR\fcategoryIcon\x12+\n" +
+	"\x11transaction_count\x18\x06 \x01(\x05R\x10transactionCount\x12\"\n" +
+	"\rspent_in_base\x18\a \x01(\x03R\vspentInBase\x12)\n" +
+	"\x10share_percentage\x18\b \x01(\x01R\x0fsharePercentage\x1a\x98\x01\n" +
+	"\bTagUsage\x12\x10\n" +
+	"\x03tag\x18\x01 \x01(\tR\x03tag\x12+\n" +
+	"\x11transaction_count\x18\x02 \x01(\x05R\x10transactionCount\x12\"\n" +
+	"\rspent_in_base\x18\x03 \x01(\x03R\vspentInBase\x12)\n" +
+	"\x10share_percentage\x18\x04 \x01(\x01R\x0fsharePercentage\x1a\xe0\x02\n" +
 	"\x10HighValueExpense\x12%\n" +
 	"\x0etransaction_id\x18\x01 \x01(\tR\rtransactionId\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x16\n" +
@@ -8411,7 +18468,8 @@ const file_saturn_finance_v1_finance_proto_rawDesc = "" +
 	"budgetName\x12E\n" +
 	"\x10transaction_date\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x0ftransactionDate\x12A\n" +
 	"\x0eeffective_date\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\reffectiveDate\"I\n" +
-	" GenerateScheduledPaymentsPayload:%\x8a\xb5\x18!finance.GenerateScheduledPayments\"\xb6\t\n" +
+	" GenerateScheduledPaymentsPayload:%\x8a\xb5\x18!finance.GenerateScheduledPayments\"9\n" +
+	"\x18SyncExchangeRatesPayload:\x1d\x8a\xb5\x18\x19finance.SyncExchangeRates\"\x9c\v\n" +
 	"\x10RecurringExpense\x12\x13\n" +
 	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x03R\x02id\x12\x1e\n" +
 	"\bspace_id\x18\x02 \x01(\tB\x03\xe0A\x03R\aspaceId\x12 \n" +
@@ -8419,7 +18477,7 @@ const file_saturn_finance_v1_finance_proto_rawDesc = "" +
 	"\x04name\x18\x04 \x01(\tB\x03\xe0A\x02R\x04name\x12\x1b\n" +
 	"\x06amount\x18\x05 \x01(\x03B\x03\xe0A\x02R\x06amount\x12\x1f\n" +
 	"\bcurrency\x18\x06 \x01(\tB\x03\xe0A\x02R\bcurrency\x12M\n" +
-	"\binterval\x18\a \x01(\x0e2,.saturn.finance.v1.RecurringExpense.IntervalB\x03\xe0A\x02R\binterval\x12`\n" +
+	"\binterval\x18\a \x01(\x0e2,.saturn.finance.v1.RecurringExpense.IntervalB\x03\xe0A\x01R\binterval\x12`\n" +
 	"\x0fexecution_state\x18\b \x01(\v22.saturn.finance.v1.RecurringExpense.ExecutionStateB\x03\xe0A\x02R\x0eexecutionState\x12$\n" +
 	"\vis_variable\x18\t \x01(\bB\x03\xe0A\x01R\n" +
 	"isVariable\x12G\n" +
@@ -8430,7 +18488,11 @@ const file_saturn_finance_v1_finance_proto_rawDesc = "" +
 	"createTime\x12@\n" +
 	"\vupdate_time\x18\r \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
 	"updateTime\x12P\n" +
-	"\x06budget\x18\x0e \x01(\v2..saturn.finance.v1.RecurringExpense.BudgetInfoB\x03\xe0A\x03H\x00R\x06budget\x88\x01\x01\x1aZ\n" +
+	"\x06budget\x18\x0e \x01(\v2..saturn.finance.v1.RecurringExpense.BudgetInfoB\x03\xe0A\x03H\x00R\x06budget\x88\x01\x01\x12,\n" +
+	"\x0frecurrence_rule\x18\x0f \x01(\tB\x03\xe0A\x01R\x0erecurrenceRule\x12C\n" +
+	"\n" +
+	"start_date\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x01H\x01R\tstartDate\x88\x01\x01\x12H\n" +
+	"\x0fexception_dates\x18\x11 \x03(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x01R\x0eexceptionDates\x1aZ\n" +
 	"\n" +
 	"BudgetInfo\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
@@ -8443,14 +18505,17 @@ const file_saturn_finance_v1_finance_proto_rawDesc = "" +
 	"\x04View\x12\x14\n" +
 	"\x10VIEW_UNSPECIFIED\x10\x00\x12\t\n" +
 	"\x05BASIC\x10\x01\x12\b\n" +
-	"\x04FULL\x10\x02\"I\n" +
+	"\x04FULL\x10\x02\"c\n" +
 	"\bInterval\x12\x18\n" +
 	"\x14INTERVAL_UNSPECIFIED\x10\x00\x12\n" +
 	"\n" +
 	"\x06WEEKLY\x10\x01\x12\v\n" +
 	"\aMONTHLY\x10\x02\x12\n" +
 	"\n" +
-	"\x06YEARLY\x10\x03\"C\n" +
+	"\x06YEARLY\x10\x03\x12\f\n" +
+	"\bBIWEEKLY\x10\x04\x12\n" +
+	"\n" +
+	"\x06CUSTOM\x10\x05\"C\n" +
 	"\x06Status\x12\x16\n" +
 	"\x12STATUS_UNSPECIFIED\x10\x00\x12\n" +
 	"\n" +
@@ -8458,7 +18523,9 @@ const file_saturn_finance_v1_finance_proto_rawDesc = "" +
 	"\n" +
 	"\x06PAUSED\x10\x02\x12\t\n" +
 	"\x05ENDED\x10\x03B\t\n" +
-	"\a_budget\"\xee\t\n" +
+	"\a_budgetB\r\n" +
+	"\v_start_date\"\x82\n" +
+	"\n" +
 	"\x10ScheduledPayment\x12\x13\n" +
 	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x03R\x02id\x12\x1e\n" +
 	"\bspace_id\x18\x02 \x01(\tB\x03\xe0A\x03R\aspaceId\x12 \n" +
@@ -8491,13 +18558,14 @@ const file_saturn_finance_v1_finance_proto_rawDesc = "" +
 	"\x04View\x12\x14\n" +
 	"\x10VIEW_UNSPECIFIED\x10\x00\x12\t\n" +
 	"\x05BASIC\x10\x01\x12\b\n" +
-	"\x04FULL\x10\x02\"S\n" +
+	"\x04FULL\x10\x02\"g\n" +
 	"\n" +
 	"SourceType\x12\x1b\n" +
 	"\x17SOURCE_TYPE_UNSPECIFIED\x10\x00\x12\x15\n" +
 	"\x11RECURRENT_EXPENSE\x10\x01\x12\b\n" +
 	"\x04LOAN\x10\x02\x12\a\n" +
-	"\x03TAX\x10\x03\"T\n" +
+	"\x03TAX\x10\x03\x12\x12\n" +
+	"\x0eCARD_STATEMENT\x10\x04\"T\n" +
 	"\x06Status\x12\x16\n" +
 	"\x12STATUS_UNSPECIFIED\x10\x00\x12\v\n" +
 	"\aPENDING\x10\x01\x12\x0e\n" +
@@ -8527,7 +18595,20 @@ const file_saturn_finance_v1_finance_proto_rawDesc = "" +
 	"\x05_sort\"\x9b\x01\n" +
 	"\x1dListRecurringExpensesResponse\x12R\n" +
 	"\x12recurring_expenses\x18\x01 \x03(\v2#.saturn.finance.v1.RecurringExpenseR\x11recurringExpenses\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xdf\x03\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xc2\x01\n" +
+	"\x16SubscriptionSuggestion\x12U\n" +
+	"\x11recurring_expense\x18\x01 \x01(\v2#.saturn.finance.v1.RecurringExpenseB\x03\xe0A\x03R\x10recurringExpense\x12#\n" +
+	"\n" +
+	"confidence\x18\x02 \x01(\x01B\x03\xe0A\x03R\n" +
+	"confidence\x12,\n" +
+	"\x0ftransaction_ids\x18\x03 \x03(\tB\x03\xe0A\x03R\x0etransactionIds\"F\n" +
+	"\x1aDetectSubscriptionsRequest\x12(\n" +
+	"\rlookback_days\x18\x01 \x01(\x05B\x03\xe0A\x01R\flookbackDays\"j\n" +
+	"\x1bDetectSubscriptionsResponse\x12K\n" +
+	"\vsuggestions\x18\x01 \x03(\v2).saturn.finance.v1.SubscriptionSuggestionR\vsuggestions\"\xa7\x01\n" +
+	" ConvertToRecurringExpenseRequest\x12U\n" +
+	"\x11recurring_expense\x18\x01 \x01(\v2#.saturn.finance.v1.RecurringExpenseB\x03\xe0A\x02R\x10recurringExpense\x12,\n" +
+	"\x0ftransaction_ids\x18\x02 \x03(\tB\x03\xe0A\x02R\x0etransactionIds\"\xdf\x03\n" +
 	"\x1cListScheduledPaymentsRequest\x12G\n" +
 	"\x06status\x18\x01 \x01(\x0e2*.saturn.finance.v1.ScheduledPayment.StatusB\x03\xe0A\x01R\x06status\x12>\n" +
 	"\n" +
@@ -8568,7 +18649,7 @@ const file_saturn_finance_v1_finance_proto_rawDesc = "" +
 	"payment_id\x18\x01 \x01(\tB\x03\xe0A\x02R\tpaymentId\x12*\n" +
 	"\x0etransaction_id\x18\x02 \x01(\tB\x03\xe0A\x02R\rtransactionId\"2\n" +
 	"\x1bSkipScheduledPaymentRequest\x12\x13\n" +
-	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\"\xa3\a\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\"\x9d\x10\n" +
 	"\tBorrowing\x12\x13\n" +
 	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x03R\x02id\x12\x1e\n" +
 	"\bspace_id\x18\x02 \x01(\tB\x03\xe0A\x03R\aspaceId\x12I\n" +
@@ -8589,7 +18670,23 @@ const file_saturn_finance_v1_finance_proto_rawDesc = "" +
 	"\vupdate_time\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
 	"updateTime\x12'\n" +
 	"\n" +
-	"account_id\x18\x10 \x01(\tB\x03\xe0A\x01H\x00R\taccountId\x88\x01\x01\">\n" +
+	"account_id\x18\x10 \x01(\tB\x03\xe0A\x01H\x00R\taccountId\x88\x01\x01\x12S\n" +
+	"\rinterest_type\x18\x11 \x01(\x0e2).saturn.finance.v1.Borrowing.InterestTypeB\x03\xe0A\x01R\finterestType\x12/\n" +
+	"\x11interest_rate_bps\x18\x12 \x01(\x05B\x03\xe0A\x01R\x0finterestRateBps\x120\n" +
+	"\x11installment_count\x18\x13 \x01(\x05B\x03\xe0A\x01R\x10installmentCount\x12k\n" +
+	"\x15installment_frequency\x18\x14 \x01(\x0e21.saturn.finance.v1.Borrowing.InstallmentFrequencyB\x03\xe0A\x01R\x14installmentFrequency\x12U\n" +
+	"\x16first_installment_date\x18\x15 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x01R\x14firstInstallmentDate\x12%\n" +
+	"\tbudget_id\x18\x16 \x01(\tB\x03\xe0A\x01H\x01R\bbudgetId\x88\x01\x01\x12$\n" +
+	"\vpaid_amount\x18\x17 \x01(\x03B\x03\xe0A\x03R\n" +
+	"paidAmount\x12P\n" +
+	"\finstallments\x18\x18 \x03(\v2'.saturn.finance.v1.BorrowingInstallmentB\x03\xe0A\x03R\finstallments\x12(\n" +
+	"\rtotal_payable\x18\x19 \x01(\x03B\x03\xe0A\x03R\ftotalPayable\x12*\n" +
+	"\x0etotal_interest\x18\x1a \x01(\x03B\x03\xe0A\x03R\rtotalInterest\x12.\n" +
+	"\x10accrued_interest\x18\x1b \x01(\x03B\x03\xe0A\x03R\x0faccruedInterest\x12(\n" +
+	"\rinterest_paid\x18\x1c \x01(\x03B\x03\xe0A\x03R\finterestPaid\x12?\n" +
+	"\x19overdue_installment_count\x18\x1d \x01(\x05B\x03\xe0A\x03R\x17overdueInstallmentCount\x12*\n" +
+	"\x0eoverdue_amount\x18\x1e \x01(\x03B\x03\xe0A\x03R\roverdueAmount\x12W\n" +
+	"\x10next_installment\x18\x1f \x01(\v2'.saturn.finance.v1.BorrowingInstallmentB\x03\xe0A\x03R\x0fnextInstallment\">\n" +
 	"\tDirection\x12\x19\n" +
 	"\x15DIRECTION_UNSPECIFIED\x10\x00\x12\f\n" +
 	"\bBORROWED\x10\x01\x12\b\n" +
@@ -8598,8 +18695,41 @@ const file_saturn_finance_v1_finance_proto_rawDesc = "" +
 	"\x12STATUS_UNSPECIFIED\x10\x00\x12\n" +
 	"\n" +
 	"\x06ACTIVE\x10\x01\x12\f\n" +
-	"\bPAID_OFF\x10\x02B\r\n" +
-	"\v_account_id\"\x95\x03\n" +
+	"\bPAID_OFF\x10\x02\"X\n" +
+	"\fInterestType\x12\x1d\n" +
+	"\x19INTEREST_TYPE_UNSPECIFIED\x10\x00\x12\x0f\n" +
+	"\vNO_INTEREST\x10\x01\x12\n" +
+	"\n" +
+	"\x06SIMPLE\x10\x02\x12\f\n" +
+	"\bCOMPOUND\x10\x03\"\x7f\n" +
+	"\x14InstallmentFrequency\x12%\n" +
+	"!INSTALLMENT_FREQUENCY_UNSPECIFIED\x10\x00\x12\n" +
+	"\n" +
+	"\x06WEEKLY\x10\x01\x12\f\n" +
+	"\bBIWEEKLY\x10\x02\x12\v\n" +
+	"\aMONTHLY\x10\x03\x12\r\n" +
+	"\tQUARTERLY\x10\x04\x12\n" +
+	"\n" +
+	"\x06YEARLY\x10\x05B\r\n" +
+	"\v_account_idB\f\n" +
+	"\n" +
+	"_budget_id\"\xcb\x03\n" +
+	"\x14BorrowingInstallment\x12\x1b\n" +
+	"\x06number\x18\x01 \x01(\x05B\x03\xe0A\x03R\x06number\x12:\n" +
+	"\bdue_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\adueDate\x12\x1d\n" +
+	"\apayment\x18\x03 \x01(\x03B\x03\xe0A\x03R\apayment\x12!\n" +
+	"\tprincipal\x18\x04 \x01(\x03B\x03\xe0A\x03R\tprincipal\x12\x1f\n" +
+	"\binterest\x18\x05 \x01(\x03B\x03\xe0A\x03R\binterest\x120\n" +
+	"\x11remaining_balance\x18\x06 \x01(\x03B\x03\xe0A\x03R\x10remainingBalance\x12$\n" +
+	"\vpaid_amount\x18\a \x01(\x03B\x03\xe0A\x03R\n" +
+	"paidAmount\x12K\n" +
+	"\x06status\x18\b \x01(\x0e2..saturn.finance.v1.BorrowingInstallment.StatusB\x03\xe0A\x03R\x06status\"R\n" +
+	"\x06Status\x12\x16\n" +
+	"\x12STATUS_UNSPECIFIED\x10\x00\x12\b\n" +
+	"\x04PAID\x10\x01\x12\v\n" +
+	"\aPARTIAL\x10\x02\x12\v\n" +
+	"\aOVERDUE\x10\x03\x12\f\n" +
+	"\bUPCOMING\x10\x04\"\x95\x03\n" +
 	"\x12BorrowingRepayment\x12\x13\n" +
 	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x03R\x02id\x12&\n" +
 	"\fborrowing_id\x18\x02 \x01(\tB\x03\xe0A\x02R\vborrowingId\x12\x1e\n" +
@@ -8652,15 +18782,19 @@ const file_saturn_finance_v1_finance_proto_rawDesc = "" +
 	"repayments\"^\n" +
 	"\x1fDeleteBorrowingRepaymentRequest\x12&\n" +
 	"\fborrowing_id\x18\x01 \x01(\tB\x03\xe0A\x02R\vborrowingId\x12\x13\n" +
-	"\x02id\x18\x02 \x01(\tB\x03\xe0A\x02R\x02id\"@\n" +
+	"\x02id\x18\x02 \x01(\tB\x03\xe0A\x02R\x02id\"\xa2\x01\n" +
 	"\fCurrencyInfo\x12\x17\n" +
 	"\x04code\x18\x01 \x01(\tB\x03\xe0A\x02R\x04code\x12\x17\n" +
-	"\x04name\x18\x02 \x01(\tB\x03\xe0A\x02R\x04name\"\x17\n" +
+	"\x04name\x18\x02 \x01(\tB\x03\xe0A\x02R\x04name\x12\x1b\n" +
+	"\x06symbol\x18\x03 \x01(\tB\x03\xe0A\x03R\x06symbol\x12$\n" +
+	"\vminor_units\x18\x04 \x01(\x05B\x03\xe0A\x03R\n" +
+	"minorUnits\x12\x1d\n" +
+	"\aenabled\x18\x05 \x01(\bB\x03\xe0A\x03R\aenabled\"\x17\n" +
 	"\x15ListCurrenciesRequest\"Y\n" +
 	"\x16ListCurrenciesResponse\x12?\n" +
 	"\n" +
 	"currencies\x18\x01 \x03(\v2\x1f.saturn.finance.v1.CurrencyInfoR\n" +
-	"currencies\"\xf5\x06\n" +
+	"currencies\"\xda\t\n" +
 	"\aAccount\x12\x13\n" +
 	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x03R\x02id\x12\x1e\n" +
 	"\bspace_id\x18\x02 \x01(\tB\x03\xe0A\x03R\aspaceId\x12\x17\n" +
@@ -8683,7 +18817,14 @@ const file_saturn_finance_v1_finance_proto_rawDesc = "" +
 	"updateTime\x12J\n" +
 	"\n" +
 	"conversion\x18\x10 \x01(\v2%.saturn.finance.v1.Account.ConversionB\x03\xe0A\x03R\n" +
-	"conversion\x1aD\n" +
+	"conversion\x127\n" +
+	"\x15statement_closing_day\x18\x11 \x01(\x05B\x03\xe0A\x01R\x13statementClosingDay\x12+\n" +
+	"\x0fpayment_due_day\x18\x12 \x01(\x05B\x03\xe0A\x01R\rpaymentDueDay\x123\n" +
+	"\x13minimum_payment_bps\x18\x13 \x01(\x05B\x03\xe0A\x01R\x11minimumPaymentBps\x127\n" +
+	"\x15minimum_payment_floor\x18\x14 \x01(\x03B\x03\xe0A\x01R\x13minimumPaymentFloor\x12/\n" +
+	"\x11payment_budget_id\x18\x15 \x01(\tB\x03\xe0A\x01R\x0fpaymentBudgetId\x12%\n" +
+	"\vutilization\x18\x16 \x01(\x01B\x03\xe0A\x03R\vutilization\x127\n" +
+	"\x15low_balance_threshold\x18\x17 \x01(\x03B\x03\xe0A\x01R\x13lowBalanceThreshold\x1aD\n" +
 	"\n" +
 	"Conversion\x12\x1d\n" +
 	"\abalance\x18\x01 \x01(\x03B\x03\xe0A\x03R\abalance\x12\x17\n" +
@@ -8714,7 +18855,57 @@ const file_saturn_finance_v1_finance_proto_rawDesc = "" +
 	"\x0fadjustment_date\x18\x03 \x01(\tB\x03\xe0A\x01H\x00R\x0eadjustmentDate\x88\x01\x01\x12\x1c\n" +
 	"\x04note\x18\x04 \x01(\tB\x03\xe0A\x01H\x01R\x04note\x88\x01\x01B\x12\n" +
 	"\x10_adjustment_dateB\a\n" +
-	"\x05_note\"+\n" +
+	"\x05_note\"\xbe\x06\n" +
+	"\x0eReconciliation\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x03R\x02id\x12\"\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\tB\x03\xe0A\x03R\taccountId\x12F\n" +
+	"\x0estatement_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x02R\rstatementDate\x120\n" +
+	"\x11statement_balance\x18\x04 \x01(\x03B\x03\xe0A\x02R\x10statementBalance\x12,\n" +
+	"\x0fopening_balance\x18\x05 \x01(\x03B\x03\xe0A\x03R\x0eopeningBalance\x12,\n" +
+	"\x0fcleared_balance\x18\x06 \x01(\x03B\x03\xe0A\x03R\x0eclearedBalance\x12#\n" +
+	"\n" +
+	"difference\x18\a \x01(\x03B\x03\xe0A\x03R\n" +
+	"difference\x12(\n" +
+	"\rcleared_count\x18\b \x01(\x05B\x03\xe0A\x03R\fclearedCount\x12D\n" +
+	"\x19adjustment_transaction_id\x18\t \x01(\tB\x03\xe0A\x03H\x00R\x17adjustmentTransactionId\x88\x01\x01\x12E\n" +
+	"\x06status\x18\n" +
+	" \x01(\x0e2(.saturn.finance.v1.Reconciliation.StatusB\x03\xe0A\x03R\x06status\x12\x17\n" +
+	"\x04note\x18\v \x01(\tB\x03\xe0A\x01R\x04note\x12D\n" +
+	"\rfinalize_time\x18\f \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\ffinalizeTime\x12@\n" +
+	"\vcreate_time\x18\r \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime\x12@\n" +
+	"\vupdate_time\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"updateTime\"@\n" +
+	"\x06Status\x12\x16\n" +
+	"\x12STATUS_UNSPECIFIED\x10\x00\x12\x0f\n" +
+	"\vIN_PROGRESS\x10\x01\x12\r\n" +
+	"\tFINALIZED\x10\x02B\x1c\n" +
+	"\x1a_adjustment_transaction_id\"\x90\x01\n" +
+	"\x1aStartReconciliationRequest\x12\"\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tB\x03\xe0A\x02R\taccountId\x12N\n" +
+	"\x0ereconciliation\x18\x02 \x01(\v2!.saturn.finance.v1.ReconciliationB\x03\xe0A\x02R\x0ereconciliation\"/\n" +
+	"\x18GetReconciliationRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\"@\n" +
+	"\x1aListReconciliationsRequest\x12\"\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tB\x03\xe0A\x02R\taccountId\"j\n" +
+	"\x1bListReconciliationsResponse\x12K\n" +
+	"\x0freconciliations\x18\x01 \x03(\v2!.saturn.finance.v1.ReconciliationR\x0freconciliations\"<\n" +
+	"%ListReconciliationTransactionsRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\"l\n" +
+	"&ListReconciliationTransactionsResponse\x12B\n" +
+	"\ftransactions\x18\x01 \x03(\v2\x1e.saturn.finance.v1.TransactionR\ftransactions\"\x9e\x01\n" +
+	"\x1dSetTransactionsClearedRequest\x120\n" +
+	"\x11reconciliation_id\x18\x01 \x01(\tB\x03\xe0A\x02R\x10reconciliationId\x12,\n" +
+	"\x0ftransaction_ids\x18\x02 \x03(\tB\x03\xe0A\x02R\x0etransactionIds\x12\x1d\n" +
+	"\acleared\x18\x03 \x01(\bB\x03\xe0A\x02R\acleared\"f\n" +
+	"\x1dFinalizeReconciliationRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\x120\n" +
+	"\x11create_adjustment\x18\x02 \x01(\bB\x03\xe0A\x01R\x10createAdjustment\"2\n" +
+	"\x1bCancelReconciliationRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\"+\n" +
 	"\x14DeleteAccountRequest\x12\x13\n" +
 	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\"\xea\x02\n" +
 	"\x13ListAccountsRequest\x12=\n" +
@@ -8776,7 +18967,7 @@ const file_saturn_finance_v1_finance_proto_rawDesc = "" +
 	"\vcreate_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
 	"createTime\"\\\n" +
 	"\x1dListTransactionEventsResponse\x12;\n" +
-	"\x06events\x18\x01 \x03(\v2#.saturn.finance.v1.TransactionEventR\x06events\"\xc3\t\n" +
+	"\x06events\x18\x01 \x03(\v2#.saturn.finance.v1.TransactionEventR\x06events\"\x85\v\n" +
 	"\tInboxItem\x12\x13\n" +
 	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x03R\x02id\x12\x1e\n" +
 	"\bspace_id\x18\x02 \x01(\tB\x03\xe0A\x03R\aspaceId\x12*\n" +
@@ -8800,7 +18991,12 @@ const file_saturn_finance_v1_finance_proto_rawDesc = "" +
 	"\vcreate_time\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
 	"createTime\x12&\n" +
 	"\fborrowing_id\x18\x11 \x01(\tH\x00R\vborrowingId\x88\x01\x01\x12Y\n" +
-	"\x13borrowing_link_type\x18\x12 \x01(\x0e2$.saturn.finance.v1.BorrowingLinkTypeH\x01R\x11borrowingLinkType\x88\x01\x01\x1a;\n" +
+	"\x13borrowing_link_type\x18\x12 \x01(\x0e2$.saturn.finance.v1.BorrowingLinkTypeH\x01R\x11borrowingLinkType\x88\x01\x01\x12;\n" +
+	"\x06splits\x18\x13 \x03(\v2#.saturn.finance.v1.TransactionSplitR\x06splits\x12\x1b\n" +
+	"\x06source\x18\x14 \x01(\tB\x03\xe0A\x03R\x06source\x12)\n" +
+	"\vexternal_id\x18\x15 \x01(\tB\x03\xe0A\x03H\x02R\n" +
+	"externalId\x88\x01\x01\x12\x1e\n" +
+	"\bpayee_id\x18\x16 \x01(\tH\x03R\apayeeId\x88\x01\x01\x1a;\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"Y\n" +
@@ -8823,7 +19019,9 @@ const file_saturn_finance_v1_finance_proto_rawDesc = "" +
 	"\x05BASIC\x10\x01\x12\b\n" +
 	"\x04FULL\x10\x02B\x0f\n" +
 	"\r_borrowing_idB\x16\n" +
-	"\x14_borrowing_link_type\"\xf7\x02\n" +
+	"\x14_borrowing_link_typeB\x0e\n" +
+	"\f_external_idB\v\n" +
+	"\t_payee_id\"\xf7\x02\n" +
 	"\x15ListInboxItemsRequest\x12\x1b\n" +
 	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
@@ -8847,7 +19045,258 @@ const file_saturn_finance_v1_finance_proto_rawDesc = "" +
 	"\x17ApproveInboxItemRequest\x12\x13\n" +
 	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\".\n" +
 	"\x17DiscardInboxItemRequest\x12\x13\n" +
-	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id*\x84\x01\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\"\xf7\x04\n" +
+	"\x10StatementMapping\x12\"\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tB\x03\xe0A\x03R\taccountId\x12!\n" +
+	"\tdelimiter\x18\x02 \x01(\tB\x03\xe0A\x01R\tdelimiter\x12\"\n" +
+	"\n" +
+	"has_header\x18\x03 \x01(\bB\x03\xe0A\x01R\thasHeader\x12$\n" +
+	"\vdate_column\x18\x04 \x01(\tB\x03\xe0A\x02R\n" +
+	"dateColumn\x12$\n" +
+	"\vdate_format\x18\x05 \x01(\tB\x03\xe0A\x01R\n" +
+	"dateFormat\x12(\n" +
+	"\ramount_column\x18\x06 \x01(\tB\x03\xe0A\x01R\famountColumn\x12&\n" +
+	"\fdebit_column\x18\a \x01(\tB\x03\xe0A\x01R\vdebitColumn\x12(\n" +
+	"\rcredit_column\x18\b \x01(\tB\x03\xe0A\x01R\fcreditColumn\x122\n" +
+	"\x12description_column\x18\t \x01(\tB\x03\xe0A\x02R\x11descriptionColumn\x12.\n" +
+	"\x10reference_column\x18\n" +
+	" \x01(\tB\x03\xe0A\x01R\x0freferenceColumn\x12,\n" +
+	"\x0fcurrency_column\x18\v \x01(\tB\x03\xe0A\x01R\x0ecurrencyColumn\x120\n" +
+	"\x11decimal_separator\x18\f \x01(\tB\x03\xe0A\x01R\x10decimalSeparator\x12*\n" +
+	"\x0einvert_amounts\x18\r \x01(\bB\x03\xe0A\x01R\rinvertAmounts\x12@\n" +
+	"\vupdate_time\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"updateTime\"\x8f\x02\n" +
+	"\x16ImportStatementRequest\x12\"\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tB\x03\xe0A\x02R\taccountId\x12?\n" +
+	"\x06format\x18\x02 \x01(\x0e2\".saturn.finance.v1.StatementFormatB\x03\xe0A\x02R\x06format\x12\x1d\n" +
+	"\acontent\x18\x03 \x01(\fB\x03\xe0A\x02R\acontent\x12I\n" +
+	"\vcsv_mapping\x18\x04 \x01(\v2#.saturn.finance.v1.StatementMappingB\x03\xe0A\x01R\n" +
+	"csvMapping\x12&\n" +
+	"\fsave_mapping\x18\x05 \x01(\bB\x03\xe0A\x01R\vsaveMapping\"\xa6\x01\n" +
+	"\x17ImportStatementResponse\x12=\n" +
+	"\vinbox_items\x18\x01 \x03(\v2\x1c.saturn.finance.v1.InboxItemR\n" +
+	"inboxItems\x12#\n" +
+	"\rskipped_count\x18\x02 \x01(\x05R\fskippedCount\x12'\n" +
+	"\x0fduplicate_count\x18\x03 \x01(\x05R\x0eduplicateCount\"@\n" +
+	"\x1aGetStatementMappingRequest\x12\"\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tB\x03\xe0A\x02R\taccountId\"\xcf\x01\n" +
+	"\x13ExportLedgerRequest\x12<\n" +
+	"\x06format\x18\x01 \x01(\x0e2\x1f.saturn.finance.v1.LedgerFormatB\x03\xe0A\x02R\x06format\x12>\n" +
+	"\n" +
+	"start_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x02R\tstartDate\x12:\n" +
+	"\bend_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x02R\aendDate\"g\n" +
+	"\x11LedgerExportChunk\x12!\n" +
+	"\fcontent_type\x18\x01 \x01(\tR\vcontentType\x12\x1b\n" +
+	"\tfile_name\x18\x02 \x01(\tR\bfileName\x12\x12\n" +
+	"\x04data\x18\x03 \x01(\fR\x04data\"\x92\x06\n" +
+	"\fLedgerExport\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x03R\x02id\x12\x1e\n" +
+	"\bspace_id\x18\x02 \x01(\tB\x03\xe0A\x03R\aspaceId\x12<\n" +
+	"\x06format\x18\x03 \x01(\x0e2\x1f.saturn.finance.v1.LedgerFormatB\x03\xe0A\x02R\x06format\x12>\n" +
+	"\n" +
+	"start_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x02R\tstartDate\x12:\n" +
+	"\bend_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x02R\aendDate\x12C\n" +
+	"\x06status\x18\x06 \x01(\x0e2&.saturn.finance.v1.LedgerExport.StatusB\x03\xe0A\x03R\x06status\x12 \n" +
+	"\tfile_name\x18\a \x01(\tB\x03\xe0A\x03R\bfileName\x12&\n" +
+	"\fcontent_type\x18\b \x01(\tB\x03\xe0A\x03R\vcontentType\x12\"\n" +
+	"\n" +
+	"size_bytes\x18\t \x01(\x03B\x03\xe0A\x03R\tsizeBytes\x12$\n" +
+	"\ventry_count\x18\n" +
+	" \x01(\x03B\x03\xe0A\x03R\n" +
+	"entryCount\x12\x19\n" +
+	"\x05error\x18\v \x01(\tB\x03\xe0A\x03R\x05error\x12@\n" +
+	"\vcreate_time\x18\f \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime\x12@\n" +
+	"\vupdate_time\x18\r \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"updateTime\x12D\n" +
+	"\rcomplete_time\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\fcompleteTime\"U\n" +
+	"\x06Status\x12\x16\n" +
+	"\x12STATUS_UNSPECIFIED\x10\x00\x12\v\n" +
+	"\aPENDING\x10\x01\x12\v\n" +
+	"\aRUNNING\x10\x02\x12\r\n" +
+	"\tCOMPLETED\x10\x03\x12\n" +
+	"\n" +
+	"\x06FAILED\x10\x04\"f\n" +
+	"\x19CreateLedgerExportRequest\x12I\n" +
+	"\rledger_export\x18\x01 \x01(\v2\x1f.saturn.finance.v1.LedgerExportB\x03\xe0A\x02R\fledgerExport\"-\n" +
+	"\x16GetLedgerExportRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\"`\n" +
+	"\x18ListLedgerExportsRequest\x12 \n" +
+	"\tpage_size\x18\x01 \x01(\x05B\x03\xe0A\x01R\bpageSize\x12\"\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tB\x03\xe0A\x01R\tpageToken\"\x8b\x01\n" +
+	"\x19ListLedgerExportsResponse\x12F\n" +
+	"\x0eledger_exports\x18\x01 \x03(\v2\x1f.saturn.finance.v1.LedgerExportR\rledgerExports\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"2\n" +
+	"\x1bDownloadLedgerExportRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\"\xdf\a\n" +
+	"\rCardStatement\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x03R\x02id\x12\x1e\n" +
+	"\bspace_id\x18\x02 \x01(\tB\x03\xe0A\x03R\aspaceId\x12\"\n" +
+	"\n" +
+	"account_id\x18\x03 \x01(\tB\x03\xe0A\x03R\taccountId\x12\x1f\n" +
+	"\bcurrency\x18\x04 \x01(\tB\x03\xe0A\x03R\bcurrency\x12B\n" +
+	"\fperiod_start\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\vperiodStart\x12B\n" +
+	"\fclosing_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\vclosingDate\x12:\n" +
+	"\bdue_date\x18\a \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\adueDate\x12,\n" +
+	"\x0fopening_balance\x18\b \x01(\x03B\x03\xe0A\x03R\x0eopeningBalance\x12!\n" +
+	"\tpurchases\x18\t \x01(\x03B\x03\xe0A\x03R\tpurchases\x12\x1d\n" +
+	"\acredits\x18\n" +
+	" \x01(\x03B\x03\xe0A\x03R\acredits\x120\n" +
+	"\x11statement_balance\x18\v \x01(\x03B\x03\xe0A\x03R\x10statementBalance\x12,\n" +
+	"\x0fminimum_payment\x18\f \x01(\x03B\x03\xe0A\x03R\x0eminimumPayment\x12$\n" +
+	"\vpaid_amount\x18\r \x01(\x03B\x03\xe0A\x03R\n" +
+	"paidAmount\x12&\n" +
+	"\fcredit_limit\x18\x0e \x01(\x03B\x03\xe0A\x03R\vcreditLimit\x12%\n" +
+	"\vutilization\x18\x0f \x01(\x01B\x03\xe0A\x03R\vutilization\x12D\n" +
+	"\x06status\x18\x10 \x01(\x0e2'.saturn.finance.v1.CardStatement.StatusB\x03\xe0A\x03R\x06status\x125\n" +
+	"\x14scheduled_payment_id\x18\x11 \x01(\tB\x03\xe0A\x03R\x12scheduledPaymentId\x12@\n" +
+	"\vcreate_time\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime\x12@\n" +
+	"\vupdate_time\x18\x13 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"updateTime\"J\n" +
+	"\x06Status\x12\x16\n" +
+	"\x12STATUS_UNSPECIFIED\x10\x00\x12\n" +
+	"\n" +
+	"\x06ISSUED\x10\x01\x12\x12\n" +
+	"\x0ePARTIALLY_PAID\x10\x02\x12\b\n" +
+	"\x04PAID\x10\x03\"\x87\x01\n" +
+	"\x19ListCardStatementsRequest\x12\"\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tB\x03\xe0A\x02R\taccountId\x12 \n" +
+	"\tpage_size\x18\x02 \x01(\x05B\x03\xe0A\x01R\bpageSize\x12$\n" +
+	"\vunpaid_only\x18\x03 \x01(\bB\x03\xe0A\x01R\n" +
+	"unpaidOnly\"g\n" +
+	"\x1aListCardStatementsResponse\x12I\n" +
+	"\x0fcard_statements\x18\x01 \x03(\v2 .saturn.finance.v1.CardStatementR\x0ecardStatements\".\n" +
+	"\x17GetCardStatementRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\"X\n" +
+	"\x17ForecastCashFlowRequest\x12\x17\n" +
+	"\x04days\x18\x01 \x01(\x05B\x03\xe0A\x01R\x04days\x12$\n" +
+	"\vaccount_ids\x18\x02 \x03(\tB\x03\xe0A\x01R\n" +
+	"accountIds\"\xc0\x02\n" +
+	"\x18ForecastCashFlowResponse\x129\n" +
+	"\n" +
+	"start_date\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12>\n" +
+	"\baccounts\x18\x03 \x03(\v2\".saturn.finance.v1.AccountForecastR\baccounts\x128\n" +
+	"\x06events\x18\x04 \x03(\v2 .saturn.finance.v1.ForecastEventR\x06events\x128\n" +
+	"\x06alerts\x18\x05 \x03(\v2 .saturn.finance.v1.ForecastAlertR\x06alerts\"\xea\x02\n" +
+	"\x0fAccountForecast\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12!\n" +
+	"\faccount_name\x18\x02 \x01(\tR\vaccountName\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x12)\n" +
+	"\x10starting_balance\x18\x04 \x01(\x03R\x0fstartingBalance\x12)\n" +
+	"\x10lowest_available\x18\x05 \x01(\x03R\x0flowestAvailable\x12;\n" +
+	"\vlowest_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lowestDate\x122\n" +
+	"\x15low_balance_threshold\x18\a \x01(\x03R\x13lowBalanceThreshold\x122\n" +
+	"\x04days\x18\b \x03(\v2\x1e.saturn.finance.v1.ForecastDayR\x04days\"\xa7\x01\n" +
+	"\vForecastDay\x12.\n" +
+	"\x04date\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x12\x18\n" +
+	"\abalance\x18\x02 \x01(\x03R\abalance\x12\x1c\n" +
+	"\tavailable\x18\x03 \x01(\x03R\tavailable\x12\x16\n" +
+	"\x06inflow\x18\x04 \x01(\x03R\x06inflow\x12\x18\n" +
+	"\aoutflow\x18\x05 \x01(\x03R\aoutflow\"\x8e\x03\n" +
+	"\rForecastEvent\x12.\n" +
+	"\x04date\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\tR\taccountId\x129\n" +
+	"\x04kind\x18\x03 \x01(\x0e2%.saturn.finance.v1.ForecastEvent.KindR\x04kind\x12\x1b\n" +
+	"\tsource_id\x18\x04 \x01(\tR\bsourceId\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06amount\x18\x06 \x01(\x03R\x06amount\x12\x1c\n" +
+	"\testimated\x18\a \x01(\bR\testimated\"~\n" +
+	"\x04Kind\x12\x14\n" +
+	"\x10KIND_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11SCHEDULED_PAYMENT\x10\x01\x12\x15\n" +
+	"\x11RECURRING_EXPENSE\x10\x02\x12\x14\n" +
+	"\x10LOAN_INSTALLMENT\x10\x03\x12\x10\n" +
+	"\fCARD_PAYMENT\x10\x04\x12\n" +
+	"\n" +
+	"\x06INCOME\x10\x05\"\x98\x02\n" +
+	"\rForecastAlert\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12.\n" +
+	"\x04date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x129\n" +
+	"\x04kind\x18\x03 \x01(\x0e2%.saturn.finance.v1.ForecastAlert.KindR\x04kind\x12\x1c\n" +
+	"\tavailable\x18\x04 \x01(\x03R\tavailable\x12\x1c\n" +
+	"\tthreshold\x18\x05 \x01(\x03R\tthreshold\"A\n" +
+	"\x04Kind\x12\x14\n" +
+	"\x10KIND_UNSPECIFIED\x10\x00\x12\x0e\n" +
+	"\n" +
+	"BELOW_ZERO\x10\x01\x12\x13\n" +
+	"\x0fBELOW_THRESHOLD\x10\x02\"\xa1\b\n" +
+	"\x05Alert\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x03R\x02id\x126\n" +
+	"\x04rule\x18\x02 \x01(\x0e2\x1d.saturn.finance.v1.Alert.RuleB\x03\xe0A\x03R\x04rule\x12<\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x1f.saturn.finance.v1.Alert.StatusB\x03\xe0A\x03R\x06status\x12\x1d\n" +
+	"\amessage\x18\x04 \x01(\tB\x03\xe0A\x03R\amessage\x12%\n" +
+	"\tbudget_id\x18\x05 \x01(\tB\x03\xe0A\x03H\x00R\bbudgetId\x88\x01\x01\x12%\n" +
+	"\tperiod_id\x18\x06 \x01(\tB\x03\xe0A\x03H\x01R\bperiodId\x88\x01\x01\x12/\n" +
+	"\x0etransaction_id\x18\a \x01(\tB\x03\xe0A\x03H\x02R\rtransactionId\x88\x01\x01\x12>\n" +
+	"\x16related_transaction_id\x18\b \x01(\tB\x03\xe0A\x03H\x03R\x14relatedTransactionId\x88\x01\x01\x12\x1b\n" +
+	"\x06amount\x18\t \x01(\x03B\x03\xe0A\x03R\x06amount\x12!\n" +
+	"\tthreshold\x18\n" +
+	" \x01(\x03B\x03\xe0A\x03R\tthreshold\x12\x1f\n" +
+	"\bcurrency\x18\v \x01(\tB\x03\xe0A\x03R\bcurrency\x12G\n" +
+	"\fsnooze_until\x18\f \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03H\x04R\vsnoozeUntil\x88\x01\x01\x12O\n" +
+	"\x10acknowledge_time\x18\r \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03H\x05R\x0facknowledgeTime\x88\x01\x01\x12@\n" +
+	"\vcreate_time\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime\x12@\n" +
+	"\vupdate_time\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"updateTime\"u\n" +
+	"\x04Rule\x12\x14\n" +
+	"\x10RULE_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10BUDGET_THRESHOLD\x10\x01\x12\x17\n" +
+	"\x13PROJECTED_OVERSPEND\x10\x02\x12\x12\n" +
+	"\x0eAMOUNT_ANOMALY\x10\x03\x12\x14\n" +
+	"\x10DUPLICATE_CHARGE\x10\x04\"I\n" +
+	"\x06Status\x12\x16\n" +
+	"\x12STATUS_UNSPECIFIED\x10\x00\x12\b\n" +
+	"\x04OPEN\x10\x01\x12\x10\n" +
+	"\fACKNOWLEDGED\x10\x02\x12\v\n" +
+	"\aSNOOZED\x10\x03B\f\n" +
+	"\n" +
+	"_budget_idB\f\n" +
+	"\n" +
+	"_period_idB\x11\n" +
+	"\x0f_transaction_idB\x19\n" +
+	"\x17_related_transaction_idB\x0f\n" +
+	"\r_snooze_untilB\x13\n" +
+	"\x11_acknowledge_time\"\xc5\x01\n" +
+	"\x11ListAlertsRequest\x12$\n" +
+	"\vactive_only\x18\x01 \x01(\bB\x03\xe0A\x01R\n" +
+	"activeOnly\x12;\n" +
+	"\x04rule\x18\x02 \x01(\x0e2\x1d.saturn.finance.v1.Alert.RuleB\x03\xe0A\x01H\x00R\x04rule\x88\x01\x01\x12 \n" +
+	"\tpage_size\x18\x03 \x01(\x05B\x03\xe0A\x01R\bpageSize\x12\"\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tB\x03\xe0A\x01R\tpageTokenB\a\n" +
+	"\x05_rule\"n\n" +
+	"\x12ListAlertsResponse\x120\n" +
+	"\x06alerts\x18\x01 \x03(\v2\x18.saturn.finance.v1.AlertR\x06alerts\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"&\n" +
+	"\x0fGetAlertRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\".\n" +
+	"\x17AcknowledgeAlertRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\"m\n" +
+	"\x12SnoozeAlertRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\x12B\n" +
+	"\fsnooze_until\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x02R\vsnoozeUntil\"|\n" +
+	"\x17TransactionChangedEvent\x12\x19\n" +
+	"\bspace_id\x18\x01 \x01(\tR\aspaceId\x12%\n" +
+	"\x0etransaction_id\x18\x02 \x01(\tR\rtransactionId:\x1f\x92\xb5\x18\x1bfinance.transaction.changed\"w\n" +
+	"\x10AlertRaisedEvent\x12\x19\n" +
+	"\bspace_id\x18\x01 \x01(\tR\aspaceId\x12.\n" +
+	"\x05alert\x18\x02 \x01(\v2\x18.saturn.finance.v1.AlertR\x05alert:\x18\x92\xb5\x18\x14finance.alert.raised\"=\n" +
+	"\x1aCloseCardStatementsPayload:\x1f\x8a\xb5\x18\x1bfinance.CloseCardStatements\"m\n" +
+	"\x16RunLedgerExportPayload\x12\x19\n" +
+	"\bspace_id\x18\x01 \x01(\tR\aspaceId\x12\x1b\n" +
+	"\texport_id\x18\x02 \x01(\tR\bexportId:\x1b\x8a\xb5\x18\x17finance.RunLedgerExport*\x84\x01\n" +
 	"\x10LimitPropagation\x12!\n" +
 	"\x1dLIMIT_PROPAGATION_UNSPECIFIED\x10\x00\x12$\n" +
 	" LIMIT_PROPAGATION_CURRENT_PERIOD\x10\x01\x12'\n" +
@@ -8864,10 +19313,24 @@ const file_saturn_finance_v1_finance_proto_rawDesc = "" +
 	"\x1fBORROWING_LINK_TYPE_UNSPECIFIED\x10\x00\x12'\n" +
 	"#BORROWING_LINK_TYPE_INITIAL_RECEIPT\x10\x01\x12!\n" +
 	"\x1dBORROWING_LINK_TYPE_REPAYMENT\x10\x02\x12'\n" +
-	"#BORROWING_LINK_TYPE_ADDITIONAL_LOAN\x10\x032\xd47\n" +
+	"#BORROWING_LINK_TYPE_ADDITIONAL_LOAN\x10\x03*\xa0\x01\n" +
+	"\x0fStatementFormat\x12 \n" +
+	"\x1cSTATEMENT_FORMAT_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14STATEMENT_FORMAT_CSV\x10\x01\x12\x18\n" +
+	"\x14STATEMENT_FORMAT_OFX\x10\x02\x12\x18\n" +
+	"\x14STATEMENT_FORMAT_QFX\x10\x03\x12\x1d\n" +
+	"\x19STATEMENT_FORMAT_CAMT_053\x10\x04*\xac\x01\n" +
+	"\fLedgerFormat\x12\x1d\n" +
+	"\x19LEDGER_FORMAT_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11LEDGER_FORMAT_CSV\x10\x01\x12\x17\n" +
+	"\x13LEDGER_FORMAT_JSONL\x10\x02\x12\x15\n" +
+	"\x11LEDGER_FORMAT_OFX\x10\x03\x12\x1b\n" +
+	"\x17LEDGER_FORMAT_BEANCOUNT\x10\x04\x12\x19\n" +
+	"\x15LEDGER_FORMAT_HLEDGER\x10\x052\xcbz\n" +
 	"\aFinance\x12\x83\x01\n" +
 	"\x10ConfigureFinance\x12*.saturn.finance.v1.ConfigureFinanceRequest\x1a\".saturn.finance.v1.FinanceSettings\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1/finance/settings\x12\x84\x01\n" +
-	"\x12GetFinanceSettings\x12,.saturn.finance.v1.GetFinanceSettingsRequest\x1a\".saturn.finance.v1.FinanceSettings\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/finance/settings\x12v\n" +
+	"\x12GetFinanceSettings\x12,.saturn.finance.v1.GetFinanceSettingsRequest\x1a\".saturn.finance.v1.FinanceSettings\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/finance/settings\x12\x94\x01\n" +
+	"\x15UpdateFinanceSettings\x12/.saturn.finance.v1.UpdateFinanceSettingsRequest\x1a\".saturn.finance.v1.FinanceSettings\"&\x82\xd3\xe4\x93\x02 :\bsettings2\x14/v1/finance/settings\x12v\n" +
 	"\fCreateBudget\x12&.saturn.finance.v1.CreateBudgetRequest\x1a\x19.saturn.finance.v1.Budget\"#\x82\xd3\xe4\x93\x02\x1d:\x06budget\"\x13/v1/finance/budgets\x12m\n" +
 	"\tGetBudget\x12#.saturn.finance.v1.GetBudgetRequest\x1a\x19.saturn.finance.v1.Budget\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/v1/finance/budgets/{id}\x12{\n" +
 	"\fUpdateBudget\x12&.saturn.finance.v1.UpdateBudgetRequest\x1a\x19.saturn.finance.v1.Budget\"(\x82\xd3\xe4\x93\x02\":\x06budget\x1a\x18/v1/finance/budgets/{id}\x12p\n" +
@@ -8880,16 +19343,64 @@ const file_saturn_finance_v1_finance_proto_rawDesc = "" +
 	"\x11ListExchangeRates\x12+.saturn.finance.v1.ListExchangeRatesRequest\x1a,.saturn.finance.v1.ListExchangeRatesResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v1/finance/exchange-rates\x12\x83\x01\n" +
 	"\x12DeleteExchangeRate\x12,.saturn.finance.v1.DeleteExchangeRateRequest\x1a\x16.google.protobuf.Empty\"'\x82\xd3\xe4\x93\x02!*\x1f/v1/finance/exchange-rates/{id}\x12\x7f\n" +
 	"\rCreateExpense\x12'.saturn.finance.v1.CreateExpenseRequest\x1a\x1e.saturn.finance.v1.Transaction\"%\x82\xd3\xe4\x93\x02\x1f:\aexpense\"\x14/v1/finance/expenses\x12\x84\x01\n" +
-	"\rUpdateExpense\x12'.saturn.finance.v1.UpdateExpenseRequest\x1a\x1e.saturn.finance.v1.Transaction\"*\x82\xd3\xe4\x93\x02$:\aexpense\x1a\x19/v1/finance/expenses/{id}\x12\x7f\n" +
+	"\rUpdateExpense\x12'.saturn.finance.v1.UpdateExpenseRequest\x1a\x1e.saturn.finance.v1.Transaction\"*\x82\xd3\xe4\x93\x02$:\aexpense\x1a\x19/v1/finance/expenses/{id}\x12{\n" +
+	"\fCreateIncome\x12&.saturn.finance.v1.CreateIncomeRequest\x1a\x1e.saturn.finance.v1.Transaction\"#\x82\xd3\xe4\x93\x02\x1d:\x06income\"\x13/v1/finance/incomes\x12\x80\x01\n" +
+	"\fUpdateIncome\x12&.saturn.finance.v1.UpdateIncomeRequest\x1a\x1e.saturn.finance.v1.Transaction\"(\x82\xd3\xe4\x93\x02\":\x06income\x1a\x18/v1/finance/incomes/{id}\x12\x96\x01\n" +
+	"\x12CreateIncomeSource\x12,.saturn.finance.v1.CreateIncomeSourceRequest\x1a\x1f.saturn.finance.v1.IncomeSource\"1\x82\xd3\xe4\x93\x02+:\rincome_source\"\x1a/v1/finance/income-sources\x12\x86\x01\n" +
+	"\x0fGetIncomeSource\x12).saturn.finance.v1.GetIncomeSourceRequest\x1a\x1f.saturn.finance.v1.IncomeSource\"'\x82\xd3\xe4\x93\x02!\x12\x1f/v1/finance/income-sources/{id}\x12\x9b\x01\n" +
+	"\x12UpdateIncomeSource\x12,.saturn.finance.v1.UpdateIncomeSourceRequest\x1a\x1f.saturn.finance.v1.IncomeSource\"6\x82\xd3\xe4\x93\x020:\rincome_source\x1a\x1f/v1/finance/income-sources/{id}\x12\x83\x01\n" +
+	"\x12DeleteIncomeSource\x12,.saturn.finance.v1.DeleteIncomeSourceRequest\x1a\x16.google.protobuf.Empty\"'\x82\xd3\xe4\x93\x02!*\x1f/v1/finance/income-sources/{id}\x12\x92\x01\n" +
+	"\x11ListIncomeSources\x12+.saturn.finance.v1.ListIncomeSourcesRequest\x1a,.saturn.finance.v1.ListIncomeSourcesResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v1/finance/income-sources\x12l\n" +
+	"\n" +
+	"CreateGoal\x12$.saturn.finance.v1.CreateGoalRequest\x1a\x17.saturn.finance.v1.Goal\"\x1f\x82\xd3\xe4\x93\x02\x19:\x04goal\"\x11/v1/finance/goals\x12e\n" +
+	"\aGetGoal\x12!.saturn.finance.v1.GetGoalRequest\x1a\x17.saturn.finance.v1.Goal\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/finance/goals/{id}\x12q\n" +
+	"\n" +
+	"UpdateGoal\x12$.saturn.finance.v1.UpdateGoalRequest\x1a\x17.saturn.finance.v1.Goal\"$\x82\xd3\xe4\x93\x02\x1e:\x04goal\x1a\x16/v1/finance/goals/{id}\x12j\n" +
+	"\n" +
+	"DeleteGoal\x12$.saturn.finance.v1.DeleteGoalRequest\x1a\x16.google.protobuf.Empty\"\x1e\x82\xd3\xe4\x93\x02\x18*\x16/v1/finance/goals/{id}\x12q\n" +
+	"\tListGoals\x12#.saturn.finance.v1.ListGoalsRequest\x1a$.saturn.finance.v1.ListGoalsResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/finance/goals\x12\x86\x01\n" +
+	"\x0fGetGoalProgress\x12).saturn.finance.v1.GetGoalProgressRequest\x1a\x1f.saturn.finance.v1.GoalProgress\"'\x82\xd3\xe4\x93\x02!\x12\x1f/v1/finance/goals/{id}/progress\x12\x81\x01\n" +
+	"\x0eCreateCategory\x12(.saturn.finance.v1.CreateCategoryRequest\x1a\x1b.saturn.finance.v1.Category\"(\x82\xd3\xe4\x93\x02\":\bcategory\"\x16/v1/finance/categories\x12v\n" +
+	"\vGetCategory\x12%.saturn.finance.v1.GetCategoryRequest\x1a\x1b.saturn.finance.v1.Category\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/v1/finance/categories/{id}\x12\x86\x01\n" +
+	"\x0eUpdateCategory\x12(.saturn.finance.v1.UpdateCategoryRequest\x1a\x1b.saturn.finance.v1.Category\"-\x82\xd3\xe4\x93\x02':\bcategory\x1a\x1b/v1/finance/categories/{id}\x12w\n" +
+	"\x0eDeleteCategory\x12(.saturn.finance.v1.DeleteCategoryRequest\x1a\x16.google.protobuf.Empty\"#\x82\xd3\xe4\x93\x02\x1d*\x1b/v1/finance/categories/{id}\x12\x85\x01\n" +
+	"\x0eListCategories\x12(.saturn.finance.v1.ListCategoriesRequest\x1a).saturn.finance.v1.ListCategoriesResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/finance/categories\x12\x7f\n" +
 	"\x11DeleteTransaction\x12+.saturn.finance.v1.DeleteTransactionRequest\x1a\x16.google.protobuf.Empty\"%\x82\xd3\xe4\x93\x02\x1f*\x1d/v1/finance/transactions/{id}\x12\x8d\x01\n" +
-	"\x10ListTransactions\x12*.saturn.finance.v1.ListTransactionsRequest\x1a+.saturn.finance.v1.ListTransactionsResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/v1/finance/transactions\x12\x81\x01\n" +
+	"\x10ListTransactions\x12*.saturn.finance.v1.ListTransactionsRequest\x1a+.saturn.finance.v1.ListTransactionsResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/v1/finance/transactions\x12\xb1\x01\n" +
+	"\x17BatchUpdateTransactions\x121.saturn.finance.v1.BatchUpdateTransactionsRequest\x1a2.saturn.finance.v1.BatchUpdateTransactionsResponse\"/\x82\xd3\xe4\x93\x02):\x01*\"$/v1/finance/transactions:batchUpdate\x12\xb1\x01\n" +
+	"\x17BatchDeleteTransactions\x121.saturn.finance.v1.BatchDeleteTransactionsRequest\x1a2.saturn.finance.v1.BatchDeleteTransactionsResponse\"/\x82\xd3\xe4\x93\x02):\x01*\"$/v1/finance/transactions:batchDelete\x12\x91\x01\n" +
+	"\x11CreateSavedFilter\x12+.saturn.finance.v1.CreateSavedFilterRequest\x1a\x1e.saturn.finance.v1.SavedFilter\"/\x82\xd3\xe4\x93\x02):\fsaved_filter\"\x19/v1/finance/saved-filters\x12\x82\x01\n" +
+	"\x0eGetSavedFilter\x12(.saturn.finance.v1.GetSavedFilterRequest\x1a\x1e.saturn.finance.v1.SavedFilter\"&\x82\xd3\xe4\x93\x02 \x12\x1e/v1/finance/saved-filters/{id}\x12\x96\x01\n" +
+	"\x11UpdateSavedFilter\x12+.saturn.finance.v1.UpdateSavedFilterRequest\x1a\x1e.saturn.finance.v1.SavedFilter\"4\x82\xd3\xe4\x93\x02.:\fsaved_filter\x1a\x1e/v1/finance/saved-filters/{id}\x12\x80\x01\n" +
+	"\x11DeleteSavedFilter\x12+.saturn.finance.v1.DeleteSavedFilterRequest\x1a\x16.google.protobuf.Empty\"&\x82\xd3\xe4\x93\x02 *\x1e/v1/finance/saved-filters/{id}\x12\x8e\x01\n" +
+	"\x10ListSavedFilters\x12*.saturn.finance.v1.ListSavedFiltersRequest\x1a+.saturn.finance.v1.ListSavedFiltersResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/finance/saved-filters\x12q\n" +
+	"\vCreatePayee\x12%.saturn.finance.v1.CreatePayeeRequest\x1a\x18.saturn.finance.v1.Payee\"!\x82\xd3\xe4\x93\x02\x1b:\x05payee\"\x12/v1/finance/payees\x12i\n" +
+	"\bGetPayee\x12\".saturn.finance.v1.GetPayeeRequest\x1a\x18.saturn.finance.v1.Payee\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/finance/payees/{id}\x12v\n" +
+	"\vUpdatePayee\x12%.saturn.finance.v1.UpdatePayeeRequest\x1a\x18.saturn.finance.v1.Payee\"&\x82\xd3\xe4\x93\x02 :\x05payee\x1a\x17/v1/finance/payees/{id}\x12m\n" +
+	"\vDeletePayee\x12%.saturn.finance.v1.DeletePayeeRequest\x1a\x16.google.protobuf.Empty\"\x1f\x82\xd3\xe4\x93\x02\x19*\x17/v1/finance/payees/{id}\x12u\n" +
+	"\n" +
+	"ListPayees\x12$.saturn.finance.v1.ListPayeesRequest\x1a%.saturn.finance.v1.ListPayeesResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/finance/payees\x12\xa5\x01\n" +
+	"\x15CreateTransactionRule\x12/.saturn.finance.v1.CreateTransactionRuleRequest\x1a\".saturn.finance.v1.TransactionRule\"7\x82\xd3\xe4\x93\x021:\x10transaction_rule\"\x1d/v1/finance/transaction-rules\x12\x92\x01\n" +
+	"\x12GetTransactionRule\x12,.saturn.finance.v1.GetTransactionRuleRequest\x1a\".saturn.finance.v1.TransactionRule\"*\x82\xd3\xe4\x93\x02$\x12\"/v1/finance/transaction-rules/{id}\x12\xaa\x01\n" +
+	"\x15UpdateTransactionRule\x12/.saturn.finance.v1.UpdateTransactionRuleRequest\x1a\".saturn.finance.v1.TransactionRule\"<\x82\xd3\xe4\x93\x026:\x10transaction_rule\x1a\"/v1/finance/transaction-rules/{id}\x12\x8c\x01\n" +
+	"\x15DeleteTransactionRule\x12/.saturn.finance.v1.DeleteTransactionRuleRequest\x1a\x16.google.protobuf.Empty\"*\x82\xd3\xe4\x93\x02$*\"/v1/finance/transaction-rules/{id}\x12\x9e\x01\n" +
+	"\x14ListTransactionRules\x12..saturn.finance.v1.ListTransactionRulesRequest\x1a/.saturn.finance.v1.ListTransactionRulesResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/v1/finance/transaction-rules\x12\xaa\x01\n" +
+	"\x15ApplyTransactionRules\x12/.saturn.finance.v1.ApplyTransactionRulesRequest\x1a0.saturn.finance.v1.ApplyTransactionRulesResponse\".\x82\xd3\xe4\x93\x02(:\x01*\"#/v1/finance/transaction-rules:apply\x12\x81\x01\n" +
 	"\x0eGetTransaction\x12(.saturn.finance.v1.GetTransactionRequest\x1a\x1e.saturn.finance.v1.Transaction\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/v1/finance/transactions/{id}\x12\xac\x01\n" +
 	"\x15ListTransactionEvents\x12/.saturn.finance.v1.ListTransactionEventsRequest\x1a0.saturn.finance.v1.ListTransactionEventsResponse\"0\x82\xd3\xe4\x93\x02*\x12(/v1/finance/transactions/{txn_id}/events\x12z\n" +
-	"\vGetInsights\x12%.saturn.finance.v1.GetInsightsRequest\x1a&.saturn.finance.v1.GetInsightsResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/finance/insights\x12\xaa\x01\n" +
+	"\vGetInsights\x12%.saturn.finance.v1.GetInsightsRequest\x1a&.saturn.finance.v1.GetInsightsResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/finance/insights\x12\x9c\x01\n" +
+	"\x10ForecastCashFlow\x12*.saturn.finance.v1.ForecastCashFlowRequest\x1a+.saturn.finance.v1.ForecastCashFlowResponse\"/\x82\xd3\xe4\x93\x02)\x12'/v1/finance/insights/cash-flow-forecast\x12u\n" +
+	"\n" +
+	"ListAlerts\x12$.saturn.finance.v1.ListAlertsRequest\x1a%.saturn.finance.v1.ListAlertsResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/finance/alerts\x12i\n" +
+	"\bGetAlert\x12\".saturn.finance.v1.GetAlertRequest\x1a\x18.saturn.finance.v1.Alert\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/finance/alerts/{id}\x12\x88\x01\n" +
+	"\x10AcknowledgeAlert\x12*.saturn.finance.v1.AcknowledgeAlertRequest\x1a\x18.saturn.finance.v1.Alert\".\x82\xd3\xe4\x93\x02(:\x01*\"#/v1/finance/alerts/{id}:acknowledge\x12y\n" +
+	"\vSnoozeAlert\x12%.saturn.finance.v1.SnoozeAlertRequest\x1a\x18.saturn.finance.v1.Alert\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/v1/finance/alerts/{id}:snooze\x12\xaa\x01\n" +
 	"\x16CreateRecurringExpense\x120.saturn.finance.v1.CreateRecurringExpenseRequest\x1a#.saturn.finance.v1.RecurringExpense\"9\x82\xd3\xe4\x93\x023:\x11recurring_expense\"\x1e/v1/finance/recurring-expenses\x12\xaf\x01\n" +
 	"\x16UpdateRecurringExpense\x120.saturn.finance.v1.UpdateRecurringExpenseRequest\x1a#.saturn.finance.v1.RecurringExpense\">\x82\xd3\xe4\x93\x028:\x11recurring_expense\x1a#/v1/finance/recurring-expenses/{id}\x12\x8f\x01\n" +
 	"\x16DeleteRecurringExpense\x120.saturn.finance.v1.DeleteRecurringExpenseRequest\x1a\x16.google.protobuf.Empty\"+\x82\xd3\xe4\x93\x02%*#/v1/finance/recurring-expenses/{id}\x12\xa2\x01\n" +
-	"\x15ListRecurringExpenses\x12/.saturn.finance.v1.ListRecurringExpensesRequest\x1a0.saturn.finance.v1.ListRecurringExpensesResponse\"&\x82\xd3\xe4\x93\x02 \x12\x1e/v1/finance/recurring-expenses\x12\xa2\x01\n" +
+	"\x15ListRecurringExpenses\x12/.saturn.finance.v1.ListRecurringExpensesRequest\x1a0.saturn.finance.v1.ListRecurringExpensesResponse\"&\x82\xd3\xe4\x93\x02 \x12\x1e/v1/finance/recurring-expenses\x12\xa3\x01\n" +
+	"\x13DetectSubscriptions\x12-.saturn.finance.v1.DetectSubscriptionsRequest\x1a..saturn.finance.v1.DetectSubscriptionsResponse\"-\x82\xd3\xe4\x93\x02'\x12%/v1/finance/recurring-expenses:detect\x12\xa8\x01\n" +
+	"\x19ConvertToRecurringExpense\x123.saturn.finance.v1.ConvertToRecurringExpenseRequest\x1a#.saturn.finance.v1.RecurringExpense\"1\x82\xd3\xe4\x93\x02+:\x01*\"&/v1/finance/recurring-expenses:convert\x12\xa2\x01\n" +
 	"\x15ListScheduledPayments\x12/.saturn.finance.v1.ListScheduledPaymentsRequest\x1a0.saturn.finance.v1.ListScheduledPaymentsResponse\"&\x82\xd3\xe4\x93\x02 \x12\x1e/v1/finance/scheduled-payments\x12\x96\x01\n" +
 	"\x13GetScheduledPayment\x12-.saturn.finance.v1.GetScheduledPaymentRequest\x1a#.saturn.finance.v1.ScheduledPayment\"+\x82\xd3\xe4\x93\x02%\x12#/v1/finance/scheduled-payments/{id}\x12\xac\x01\n" +
 	"\x17ConfirmScheduledPayment\x121.saturn.finance.v1.ConfirmScheduledPaymentRequest\x1a\x1e.saturn.finance.v1.Transaction\">\x82\xd3\xe4\x93\x028:\x01*\"3/v1/finance/scheduled-payments/{payment_id}/confirm\x12\xa6\x01\n" +
@@ -8907,7 +19418,16 @@ const file_saturn_finance_v1_finance_proto_rawDesc = "" +
 	"\n" +
 	"GetAccount\x12$.saturn.finance.v1.GetAccountRequest\x1a\x1a.saturn.finance.v1.Account\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/finance/accounts/{id}\x12\x80\x01\n" +
 	"\rUpdateAccount\x12'.saturn.finance.v1.UpdateAccountRequest\x1a\x1a.saturn.finance.v1.Account\"*\x82\xd3\xe4\x93\x02$:\aaccount\x1a\x19/v1/finance/accounts/{id}\x12\x9f\x01\n" +
-	"\x14AdjustAccountBalance\x12..saturn.finance.v1.AdjustAccountBalanceRequest\x1a\x1a.saturn.finance.v1.Account\";\x82\xd3\xe4\x93\x025:\x01*\"0/v1/finance/accounts/{account_id}:adjust-balance\x12s\n" +
+	"\x14AdjustAccountBalance\x12..saturn.finance.v1.AdjustAccountBalanceRequest\x1a\x1a.saturn.finance.v1.Account\";\x82\xd3\xe4\x93\x025:\x01*\"0/v1/finance/accounts/{account_id}:adjust-balance\x12\xac\x01\n" +
+	"\x12ListCardStatements\x12,.saturn.finance.v1.ListCardStatementsRequest\x1a-.saturn.finance.v1.ListCardStatementsResponse\"9\x82\xd3\xe4\x93\x023\x121/v1/finance/accounts/{account_id}/card-statements\x12\x8a\x01\n" +
+	"\x10GetCardStatement\x12*.saturn.finance.v1.GetCardStatementRequest\x1a .saturn.finance.v1.CardStatement\"(\x82\xd3\xe4\x93\x02\"\x12 /v1/finance/card-statements/{id}\x12\xb2\x01\n" +
+	"\x13StartReconciliation\x12-.saturn.finance.v1.StartReconciliationRequest\x1a!.saturn.finance.v1.Reconciliation\"I\x82\xd3\xe4\x93\x02C:\x0ereconciliation\"1/v1/finance/accounts/{account_id}/reconciliations\x12\x8d\x01\n" +
+	"\x11GetReconciliation\x12+.saturn.finance.v1.GetReconciliationRequest\x1a!.saturn.finance.v1.Reconciliation\"(\x82\xd3\xe4\x93\x02\"\x12 /v1/finance/reconciliations/{id}\x12\xaf\x01\n" +
+	"\x13ListReconciliations\x12-.saturn.finance.v1.ListReconciliationsRequest\x1a..saturn.finance.v1.ListReconciliationsResponse\"9\x82\xd3\xe4\x93\x023\x121/v1/finance/accounts/{account_id}/reconciliations\x12\xcc\x01\n" +
+	"\x1eListReconciliationTransactions\x128.saturn.finance.v1.ListReconciliationTransactionsRequest\x1a9.saturn.finance.v1.ListReconciliationTransactionsResponse\"5\x82\xd3\xe4\x93\x02/\x12-/v1/finance/reconciliations/{id}/transactions\x12\xb5\x01\n" +
+	"\x16SetTransactionsCleared\x120.saturn.finance.v1.SetTransactionsClearedRequest\x1a!.saturn.finance.v1.Reconciliation\"F\x82\xd3\xe4\x93\x02@:\x01*\";/v1/finance/reconciliations/{reconciliation_id}:set-cleared\x12\xa3\x01\n" +
+	"\x16FinalizeReconciliation\x120.saturn.finance.v1.FinalizeReconciliationRequest\x1a!.saturn.finance.v1.Reconciliation\"4\x82\xd3\xe4\x93\x02.:\x01*\")/v1/finance/reconciliations/{id}:finalize\x12\x88\x01\n" +
+	"\x14CancelReconciliation\x12..saturn.finance.v1.CancelReconciliationRequest\x1a\x16.google.protobuf.Empty\"(\x82\xd3\xe4\x93\x02\"* /v1/finance/reconciliations/{id}\x12s\n" +
 	"\rDeleteAccount\x12'.saturn.finance.v1.DeleteAccountRequest\x1a\x16.google.protobuf.Empty\"!\x82\xd3\xe4\x93\x02\x1b*\x19/v1/finance/accounts/{id}\x12}\n" +
 	"\fListAccounts\x12&.saturn.finance.v1.ListAccountsRequest\x1a'.saturn.finance.v1.ListAccountsResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/finance/accounts\x12y\n" +
 	"\x0eCreateTransfer\x12(.saturn.finance.v1.CreateTransferRequest\x1a\x1b.saturn.finance.v1.Transfer\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/finance/transfers\x12\x81\x01\n" +
@@ -8917,7 +19437,14 @@ const file_saturn_finance_v1_finance_proto_rawDesc = "" +
 	"\x0fUpdateInboxItem\x12).saturn.finance.v1.UpdateInboxItemRequest\x1a\x1c.saturn.finance.v1.InboxItem\"0\x82\xd3\xe4\x93\x02*:\n" +
 	"inbox_item\x1a\x1c/v1/finance/inbox-items/{id}\x12\x8d\x01\n" +
 	"\x10ApproveInboxItem\x12*.saturn.finance.v1.ApproveInboxItemRequest\x1a\x1c.saturn.finance.v1.InboxItem\"/\x82\xd3\xe4\x93\x02):\x01*\"$/v1/finance/inbox-items/{id}:approve\x12|\n" +
-	"\x10DiscardInboxItem\x12*.saturn.finance.v1.DiscardInboxItemRequest\x1a\x16.google.protobuf.Empty\"$\x82\xd3\xe4\x93\x02\x1e*\x1c/v1/finance/inbox-items/{id}BAZ?github.com/masterkeysrd/saturn/apis/saturn/finance/v1;financev1b\x06proto3"
+	"\x10DiscardInboxItem\x12*.saturn.finance.v1.DiscardInboxItemRequest\x1a\x16.google.protobuf.Empty\"$\x82\xd3\xe4\x93\x02\x1e*\x1c/v1/finance/inbox-items/{id}\x12\xa8\x01\n" +
+	"\x0fImportStatement\x12).saturn.finance.v1.ImportStatementRequest\x1a*.saturn.finance.v1.ImportStatementResponse\">\x82\xd3\xe4\x93\x028:\x01*\"3/v1/finance/accounts/{account_id}/statements:import\x12\xa6\x01\n" +
+	"\x13GetStatementMapping\x12-.saturn.finance.v1.GetStatementMappingRequest\x1a#.saturn.finance.v1.StatementMapping\";\x82\xd3\xe4\x93\x025\x123/v1/finance/accounts/{account_id}/statement-mapping\x12^\n" +
+	"\fExportLedger\x12&.saturn.finance.v1.ExportLedgerRequest\x1a$.saturn.finance.v1.LedgerExportChunk0\x01\x12\x96\x01\n" +
+	"\x12CreateLedgerExport\x12,.saturn.finance.v1.CreateLedgerExportRequest\x1a\x1f.saturn.finance.v1.LedgerExport\"1\x82\xd3\xe4\x93\x02+:\rledger_export\"\x1a/v1/finance/ledger-exports\x12\x86\x01\n" +
+	"\x0fGetLedgerExport\x12).saturn.finance.v1.GetLedgerExportRequest\x1a\x1f.saturn.finance.v1.LedgerExport\"'\x82\xd3\xe4\x93\x02!\x12\x1f/v1/finance/ledger-exports/{id}\x12\x92\x01\n" +
+	"\x11ListLedgerExports\x12+.saturn.finance.v1.ListLedgerExportsRequest\x1a,.saturn.finance.v1.ListLedgerExportsResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v1/finance/ledger-exports\x12n\n" +
+	"\x14DownloadLedgerExport\x12..saturn.finance.v1.DownloadLedgerExportRequest\x1a$.saturn.finance.v1.LedgerExportChunk0\x01BAZ?github.com/masterkeysrd/saturn/apis/saturn/finance/v1;financev1b\x06proto3"
 
 var (
 	file_saturn_finance_v1_finance_proto_rawDescOnce sync.Once
@@ -8931,358 +19458,740 @@ func file_saturn_finance_v1_finance_proto_rawDescGZIP() []byte {
 	return file_saturn_finance_v1_finance_proto_rawDescData
 }
 
-var file_saturn_finance_v1_finance_proto_enumTypes = make([]protoimpl.EnumInfo, 20)
-var file_saturn_finance_v1_finance_proto_msgTypes = make([]protoimpl.MessageInfo, 94)
+var file_saturn_finance_v1_finance_proto_enumTypes = make([]protoimpl.EnumInfo, 35)
+var file_saturn_finance_v1_finance_proto_msgTypes = make([]protoimpl.MessageInfo, 205)
 var file_saturn_finance_v1_finance_proto_goTypes = []any{
-	(LimitPropagation)(0),                         // 0: saturn.finance.v1.LimitPropagation
-	(InsightGranularity)(0),                       // 1: saturn.finance.v1.InsightGranularity
-	(BorrowingLinkType)(0),                        // 2: saturn.finance.v1.BorrowingLinkType
-	(Budget_RecurrenceInterval)(0),                // 3: saturn.finance.v1.Budget.RecurrenceInterval
-	(Budget_View)(0),                              // 4: saturn.finance.v1.Budget.View
-	(Transaction_Type)(0),                         // 5: saturn.finance.v1.Transaction.Type
-	(Transaction_View)(0),                         // 6: saturn.finance.v1.Transaction.View
-	(RecurringExpense_View)(0),                    // 7: saturn.finance.v1.RecurringExpense.View
-	(RecurringExpense_Interval)(0),                // 8: saturn.finance.v1.RecurringExpense.Interval
-	(RecurringExpense_Status)(0),                  // 9: saturn.finance.v1.RecurringExpense.Status
-	(ScheduledPayment_View)(0),                    // 10: saturn.finance.v1.ScheduledPayment.View
-	(ScheduledPayment_SourceType)(0),              // 11: saturn.finance.v1.ScheduledPayment.SourceType
-	(ScheduledPayment_Status)(0),                  // 12: saturn.finance.v1.ScheduledPayment.Status
-	(Borrowing_Direction)(0),                      // 13: saturn.finance.v1.Borrowing.Direction
-	(Borrowing_Status)(0),                         // 14: saturn.finance.v1.Borrowing.Status
-	(Account_Type)(0),                             // 15: saturn.finance.v1.Account.Type
-	(Account_View)(0),                             // 16: saturn.finance.v1.Account.View
-	(InboxItem_Status)(0),                         // 17: saturn.finance.v1.InboxItem.Status
-	(InboxItem_DocType)(0),                        // 18: saturn.finance.v1.InboxItem.DocType
-	(InboxItem_View)(0),                           // 19: saturn.finance.v1.InboxItem.View
-	(*FinanceSettings)(nil),                       // 20: saturn.finance.v1.FinanceSettings
-	(*Budget)(nil),                                // 21: saturn.finance.v1.Budget
-	(*BudgetPeriod)(nil),                          // 22: saturn.finance.v1.BudgetPeriod
-	(*ConfigureFinanceRequest)(nil),               // 23: saturn.finance.v1.ConfigureFinanceRequest
-	(*GetFinanceSettingsRequest)(nil),             // 24: saturn.finance.v1.GetFinanceSettingsRequest
-	(*GetBudgetRequest)(nil),                      // 25: saturn.finance.v1.GetBudgetRequest
-	(*CreateBudgetRequest)(nil),                   // 26: saturn.finance.v1.CreateBudgetRequest
-	(*UpdateBudgetRequest)(nil),                   // 27: saturn.finance.v1.UpdateBudgetRequest
-	(*DeleteBudgetRequest)(nil),                   // 28: saturn.finance.v1.DeleteBudgetRequest
-	(*ListBudgetsRequest)(nil),                    // 29: saturn.finance.v1.ListBudgetsRequest
-	(*ListBudgetsResponse)(nil),                   // 30: saturn.finance.v1.ListBudgetsResponse
-	(*GetBudgetPeriodRequest)(nil),                // 31: saturn.finance.v1.GetBudgetPeriodRequest
-	(*ExchangeRate)(nil),                          // 32: saturn.finance.v1.ExchangeRate
-	(*CreateExchangeRateRequest)(nil),             // 33: saturn.finance.v1.CreateExchangeRateRequest
-	(*GetExchangeRateRequest)(nil),                // 34: saturn.finance.v1.GetExchangeRateRequest
-	(*UpdateExchangeRateRequest)(nil),             // 35: saturn.finance.v1.UpdateExchangeRateRequest
-	(*ListExchangeRatesRequest)(nil),              // 36: saturn.finance.v1.ListExchangeRatesRequest
-	(*ListExchangeRatesResponse)(nil),             // 37: saturn.finance.v1.ListExchangeRatesResponse
-	(*DeleteExchangeRateRequest)(nil),             // 38: saturn.finance.v1.DeleteExchangeRateRequest
-	(*Transaction)(nil),                           // 39: saturn.finance.v1.Transaction
-	(*ExpenseInput)(nil),                          // 40: saturn.finance.v1.ExpenseInput
-	(*CreateExpenseRequest)(nil),                  // 41: saturn.finance.v1.CreateExpenseRequest
-	(*UpdateExpenseRequest)(nil),                  // 42: saturn.finance.v1.UpdateExpenseRequest
-	(*DeleteTransactionRequest)(nil),              // 43: saturn.finance.v1.DeleteTransactionRequest
-	(*GetTransactionRequest)(nil),                 // 44: saturn.finance.v1.GetTransactionRequest
-	(*ListTransactionsRequest)(nil),               // 45: saturn.finance.v1.ListTransactionsRequest
-	(*ListTransactionsResponse)(nil),              // 46: saturn.finance.v1.ListTransactionsResponse
-	(*GetInsightsRequest)(nil),                    // 47: saturn.finance.v1.GetInsightsRequest
-	(*GetInsightsResponse)(nil),                   // 48: saturn.finance.v1.GetInsightsResponse
-	(*SpentInsights)(nil),                         // 49: saturn.finance.v1.SpentInsights
-	(*GenerateScheduledPaymentsPayload)(nil),      // 50: saturn.finance.v1.GenerateScheduledPaymentsPayload
-	(*RecurringExpense)(nil),                      // 51: saturn.finance.v1.RecurringExpense
-	(*ScheduledPayment)(nil),                      // 52: saturn.finance.v1.ScheduledPayment
-	(*CreateRecurringExpenseRequest)(nil),         // 53: saturn.finance.v1.CreateRecurringExpenseRequest
-	(*UpdateRecurringExpenseRequest)(nil),         // 54: saturn.finance.v1.UpdateRecurringExpenseRequest
-	(*DeleteRecurringExpenseRequest)(nil),         // 55: saturn.finance.v1.DeleteRecurringExpenseRequest
-	(*ListRecurringExpensesRequest)(nil),          // 56: saturn.finance.v1.ListRecurringExpensesRequest
-	(*ListRecurringExpensesResponse)(nil),         // 57: saturn.finance.v1.ListRecurringExpensesResponse
-	(*ListScheduledPaymentsRequest)(nil),          // 58: saturn.finance.v1.ListScheduledPaymentsRequest
-	(*ListScheduledPaymentsResponse)(nil),         // 59: saturn.finance.v1.ListScheduledPaymentsResponse
-	(*GetScheduledPaymentRequest)(nil),            // 60: saturn.finance.v1.GetScheduledPaymentRequest
-	(*ConfirmScheduledPaymentRequest)(nil),        // 61: saturn.finance.v1.ConfirmScheduledPaymentRequest
-	(*MatchScheduledPaymentRequest)(nil),          // 62: saturn.finance.v1.MatchScheduledPaymentRequest
-	(*SkipScheduledPaymentRequest)(nil),           // 63: saturn.finance.v1.SkipScheduledPaymentRequest
-	(*Borrowing)(nil),                             // 64: saturn.finance.v1.Borrowing
-	(*BorrowingRepayment)(nil),                    // 65: saturn.finance.v1.BorrowingRepayment
-	(*CreateBorrowingRequest)(nil),                // 66: saturn.finance.v1.CreateBorrowingRequest
-	(*GetBorrowingRequest)(nil),                   // 67: saturn.finance.v1.GetBorrowingRequest
-	(*ListBorrowingsRequest)(nil),                 // 68: saturn.finance.v1.ListBorrowingsRequest
-	(*ListBorrowingsResponse)(nil),                // 69: saturn.finance.v1.ListBorrowingsResponse
-	(*UpdateBorrowingRequest)(nil),                // 70: saturn.finance.v1.UpdateBorrowingRequest
-	(*DeleteBorrowingRequest)(nil),                // 71: saturn.finance.v1.DeleteBorrowingRequest
-	(*CreateBorrowingRepaymentRequest)(nil),       // 72: saturn.finance.v1.CreateBorrowingRepaymentRequest
-	(*ListBorrowingRepaymentsRequest)(nil),        // 73: saturn.finance.v1.ListBorrowingRepaymentsRequest
-	(*ListBorrowingRepaymentsResponse)(nil),       // 74: saturn.finance.v1.ListBorrowingRepaymentsResponse
-	(*DeleteBorrowingRepaymentRequest)(nil),       // 75: saturn.finance.v1.DeleteBorrowingRepaymentRequest
-	(*CurrencyInfo)(nil),                          // 76: saturn.finance.v1.CurrencyInfo
-	(*ListCurrenciesRequest)(nil),                 // 77: saturn.finance.v1.ListCurrenciesRequest
-	(*ListCurrenciesResponse)(nil),                // 78: saturn.finance.v1.ListCurrenciesResponse
-	(*Account)(nil),                               // 79: saturn.finance.v1.Account
-	(*CreateAccountRequest)(nil),                  // 80: saturn.finance.v1.CreateAccountRequest
-	(*GetAccountRequest)(nil),                     // 81: saturn.finance.v1.GetAccountRequest
-	(*UpdateAccountRequest)(nil),                  // 82: saturn.finance.v1.UpdateAccountRequest
-	(*AdjustAccountBalanceRequest)(nil),           // 83: saturn.finance.v1.AdjustAccountBalanceRequest
-	(*DeleteAccountRequest)(nil),                  // 84: saturn.finance.v1.DeleteAccountRequest
-	(*ListAccountsRequest)(nil),                   // 85: saturn.finance.v1.ListAccountsRequest
-	(*ListAccountsResponse)(nil),                  // 86: saturn.finance.v1.ListAccountsResponse
-	(*Transfer)(nil),                              // 87: saturn.finance.v1.Transfer
-	(*CreateTransferRequest)(nil),                 // 88: saturn.finance.v1.CreateTransferRequest
-	(*ListTransfersRequest)(nil),                  // 89: saturn.finance.v1.ListTransfersRequest
-	(*ListTransfersResponse)(nil),                 // 90: saturn.finance.v1.ListTransfersResponse
-	(*ListTransactionEventsRequest)(nil),          // 91: saturn.finance.v1.ListTransactionEventsRequest
-	(*TransactionEvent)(nil),                      // 92: saturn.finance.v1.TransactionEvent
-	(*ListTransactionEventsResponse)(nil),         // 93: saturn.finance.v1.ListTransactionEventsResponse
-	(*InboxItem)(nil),                             // 94: saturn.finance.v1.InboxItem
-	(*ListInboxItemsRequest)(nil),                 // 95: saturn.finance.v1.ListInboxItemsRequest
-	(*ListInboxItemsResponse)(nil),                // 96: saturn.finance.v1.ListInboxItemsResponse
-	(*UpdateInboxItemRequest)(nil),                // 97: saturn.finance.v1.UpdateInboxItemRequest
-	(*ApproveInboxItemRequest)(nil),               // 98: saturn.finance.v1.ApproveInboxItemRequest
-	(*DiscardInboxItemRequest)(nil),               // 99: saturn.finance.v1.DiscardInboxItemRequest
-	(*Budget_ActivePeriod)(nil),                   // 100: saturn.finance.v1.Budget.ActivePeriod
-	(*Transaction_AccountInfo)(nil),               // 101: saturn.finance.v1.Transaction.AccountInfo
-	(*Transaction_BudgetInfo)(nil),                // 102: saturn.finance.v1.Transaction.BudgetInfo
-	nil,                                           // 103: saturn.finance.v1.Transaction.MetadataEntry
-	(*SpentInsights_BudgetContribution)(nil),      // 104: saturn.finance.v1.SpentInsights.BudgetContribution
-	(*SpentInsights_TrendDataPoint)(nil),          // 105: saturn.finance.v1.SpentInsights.TrendDataPoint
-	(*SpentInsights_BudgetUsage)(nil),             // 106: saturn.finance.v1.SpentInsights.BudgetUsage
-	(*SpentInsights_HighValueExpense)(nil),        // 107: saturn.finance.v1.SpentInsights.HighValueExpense
-	(*RecurringExpense_BudgetInfo)(nil),           // 108: saturn.finance.v1.RecurringExpense.BudgetInfo
-	(*RecurringExpense_ExecutionState)(nil),       // 109: saturn.finance.v1.RecurringExpense.ExecutionState
-	(*ScheduledPayment_BudgetInfo)(nil),           // 110: saturn.finance.v1.ScheduledPayment.BudgetInfo
-	(*ScheduledPayment_RecurringExpenseInfo)(nil), // 111: saturn.finance.v1.ScheduledPayment.RecurringExpenseInfo
-	(*Account_Conversion)(nil),                    // 112: saturn.finance.v1.Account.Conversion
-	nil,                                           // 113: saturn.finance.v1.InboxItem.MetadataEntry
-	(*timestamppb.Timestamp)(nil),                 // 114: google.protobuf.Timestamp
-	(*fieldmaskpb.FieldMask)(nil),                 // 115: google.protobuf.FieldMask
-	(*emptypb.Empty)(nil),                         // 116: google.protobuf.Empty
+	(LimitPropagation)(0),                          // 0: saturn.finance.v1.LimitPropagation
+	(InsightGranularity)(0),                        // 1: saturn.finance.v1.InsightGranularity
+	(BorrowingLinkType)(0),                         // 2: saturn.finance.v1.BorrowingLinkType
+	(StatementFormat)(0),                           // 3: saturn.finance.v1.StatementFormat
+	(LedgerFormat)(0),                              // 4: saturn.finance.v1.LedgerFormat
+	(Budget_RecurrenceInterval)(0),                 // 5: saturn.finance.v1.Budget.RecurrenceInterval
+	(Budget_RolloverPolicy)(0),                     // 6: saturn.finance.v1.Budget.RolloverPolicy
+	(Budget_View)(0),                               // 7: saturn.finance.v1.Budget.View
+	(Transaction_Type)(0),                          // 8: saturn.finance.v1.Transaction.Type
+	(Transaction_View)(0),                          // 9: saturn.finance.v1.Transaction.View
+	(IncomeSource_Cadence)(0),                      // 10: saturn.finance.v1.IncomeSource.Cadence
+	(Goal_Status)(0),                               // 11: saturn.finance.v1.Goal.Status
+	(RecurringExpense_View)(0),                     // 12: saturn.finance.v1.RecurringExpense.View
+	(RecurringExpense_Interval)(0),                 // 13: saturn.finance.v1.RecurringExpense.Interval
+	(RecurringExpense_Status)(0),                   // 14: saturn.finance.v1.RecurringExpense.Status
+	(ScheduledPayment_View)(0),                     // 15: saturn.finance.v1.ScheduledPayment.View
+	(ScheduledPayment_SourceType)(0),               // 16: saturn.finance.v1.ScheduledPayment.SourceType
+	(ScheduledPayment_Status)(0),                   // 17: saturn.finance.v1.ScheduledPayment.Status
+	(Borrowing_Direction)(0),                       // 18: saturn.finance.v1.Borrowing.Direction
+	(Borrowing_Status)(0),                          // 19: saturn.finance.v1.Borrowing.Status
+	(Borrowing_InterestType)(0),                    // 20: saturn.finance.v1.Borrowing.InterestType
+	(Borrowing_InstallmentFrequency)(0),            // 21: saturn.finance.v1.Borrowing.InstallmentFrequency
+	(BorrowingInstallment_Status)(0),               // 22: saturn.finance.v1.BorrowingInstallment.Status
+	(Account_Type)(0),                              // 23: saturn.finance.v1.Account.Type
+	(Account_View)(0),                              // 24: saturn.finance.v1.Account.View
+	(Reconciliation_Status)(0),                     // 25: saturn.finance.v1.Reconciliation.Status
+	(InboxItem_Status)(0),                          // 26: saturn.finance.v1.InboxItem.Status
+	(InboxItem_DocType)(0),                         // 27: saturn.finance.v1.InboxItem.DocType
+	(InboxItem_View)(0),                            // 28: saturn.finance.v1.InboxItem.View
+	(LedgerExport_Status)(0),                       // 29: saturn.finance.v1.LedgerExport.Status
+	(CardStatement_Status)(0),                      // 30: saturn.finance.v1.CardStatement.Status
+	(ForecastEvent_Kind)(0),                        // 31: saturn.finance.v1.ForecastEvent.Kind
+	(ForecastAlert_Kind)(0),                        // 32: saturn.finance.v1.ForecastAlert.Kind
+	(Alert_Rule)(0),                                // 33: saturn.finance.v1.Alert.Rule
+	(Alert_Status)(0),                              // 34: saturn.finance.v1.Alert.Status
+	(*FinanceSettings)(nil),                        // 35: saturn.finance.v1.FinanceSettings
+	(*Budget)(nil),                                 // 36: saturn.finance.v1.Budget
+	(*BudgetPeriod)(nil),                           // 37: saturn.finance.v1.BudgetPeriod
+	(*ConfigureFinanceRequest)(nil),                // 38: saturn.finance.v1.ConfigureFinanceRequest
+	(*GetFinanceSettingsRequest)(nil),              // 39: saturn.finance.v1.GetFinanceSettingsRequest
+	(*UpdateFinanceSettingsRequest)(nil),           // 40: saturn.finance.v1.UpdateFinanceSettingsRequest
+	(*GetBudgetRequest)(nil),                       // 41: saturn.finance.v1.GetBudgetRequest
+	(*CreateBudgetRequest)(nil),                    // 42: saturn.finance.v1.CreateBudgetRequest
+	(*UpdateBudgetRequest)(nil),                    // 43: saturn.finance.v1.UpdateBudgetRequest
+	(*DeleteBudgetRequest)(nil),                    // 44: saturn.finance.v1.DeleteBudgetRequest
+	(*ListBudgetsRequest)(nil),                     // 45: saturn.finance.v1.ListBudgetsRequest
+	(*ListBudgetsResponse)(nil),                    // 46: saturn.finance.v1.ListBudgetsResponse
+	(*GetBudgetPeriodRequest)(nil),                 // 47: saturn.finance.v1.GetBudgetPeriodRequest
+	(*ExchangeRate)(nil),                           // 48: saturn.finance.v1.ExchangeRate
+	(*CreateExchangeRateRequest)(nil),              // 49: saturn.finance.v1.CreateExchangeRateRequest
+	(*GetExchangeRateRequest)(nil),                 // 50: saturn.finance.v1.GetExchangeRateRequest
+	(*UpdateExchangeRateRequest)(nil),              // 51: saturn.finance.v1.UpdateExchangeRateRequest
+	(*ListExchangeRatesRequest)(nil),               // 52: saturn.finance.v1.ListExchangeRatesRequest
+	(*ListExchangeRatesResponse)(nil),              // 53: saturn.finance.v1.ListExchangeRatesResponse
+	(*DeleteExchangeRateRequest)(nil),              // 54: saturn.finance.v1.DeleteExchangeRateRequest
+	(*Transaction)(nil),                            // 55: saturn.finance.v1.Transaction
+	(*TransactionSplit)(nil),                       // 56: saturn.finance.v1.TransactionSplit
+	(*ExpenseInput)(nil),                           // 57: saturn.finance.v1.ExpenseInput
+	(*CreateExpenseRequest)(nil),                   // 58: saturn.finance.v1.CreateExpenseRequest
+	(*UpdateExpenseRequest)(nil),                   // 59: saturn.finance.v1.UpdateExpenseRequest
+	(*IncomeInput)(nil),                            // 60: saturn.finance.v1.IncomeInput
+	(*CreateIncomeRequest)(nil),                    // 61: saturn.finance.v1.CreateIncomeRequest
+	(*UpdateIncomeRequest)(nil),                    // 62: saturn.finance.v1.UpdateIncomeRequest
+	(*IncomeSource)(nil),                           // 63: saturn.finance.v1.IncomeSource
+	(*CreateIncomeSourceRequest)(nil),              // 64: saturn.finance.v1.CreateIncomeSourceRequest
+	(*GetIncomeSourceRequest)(nil),                 // 65: saturn.finance.v1.GetIncomeSourceRequest
+	(*UpdateIncomeSourceRequest)(nil),              // 66: saturn.finance.v1.UpdateIncomeSourceRequest
+	(*DeleteIncomeSourceRequest)(nil),              // 67: saturn.finance.v1.DeleteIncomeSourceRequest
+	(*ListIncomeSourcesRequest)(nil),               // 68: saturn.finance.v1.ListIncomeSourcesRequest
+	(*ListIncomeSourcesResponse)(nil),              // 69: saturn.finance.v1.ListIncomeSourcesResponse
+	(*Goal)(nil),                                   // 70: saturn.finance.v1.Goal
+	(*GoalProgress)(nil),                           // 71: saturn.finance.v1.GoalProgress
+	(*CreateGoalRequest)(nil),                      // 72: saturn.finance.v1.CreateGoalRequest
+	(*GetGoalRequest)(nil),                         // 73: saturn.finance.v1.GetGoalRequest
+	(*UpdateGoalRequest)(nil),                      // 74: saturn.finance.v1.UpdateGoalRequest
+	(*DeleteGoalRequest)(nil),                      // 75: saturn.finance.v1.DeleteGoalRequest
+	(*ListGoalsRequest)(nil),                       // 76: saturn.finance.v1.ListGoalsRequest
+	(*ListGoalsResponse)(nil),                      // 77: saturn.finance.v1.ListGoalsResponse
+	(*GetGoalProgressRequest)(nil),                 // 78: saturn.finance.v1.GetGoalProgressRequest
+	(*Category)(nil),                               // 79: saturn.finance.v1.Category
+	(*CreateCategoryRequest)(nil),                  // 80: saturn.finance.v1.CreateCategoryRequest
+	(*GetCategoryRequest)(nil),                     // 81: saturn.finance.v1.GetCategoryRequest
+	(*UpdateCategoryRequest)(nil),                  // 82: saturn.finance.v1.UpdateCategoryRequest
+	(*DeleteCategoryRequest)(nil),                  // 83: saturn.finance.v1.DeleteCategoryRequest
+	(*ListCategoriesRequest)(nil),                  // 84: saturn.finance.v1.ListCategoriesRequest
+	(*ListCategoriesResponse)(nil),                 // 85: saturn.finance.v1.ListCategoriesResponse
+	(*DeleteTransactionRequest)(nil),               // 86: saturn.finance.v1.DeleteTransactionRequest
+	(*GetTransactionRequest)(nil),                  // 87: saturn.finance.v1.GetTransactionRequest
+	(*ListTransactionsRequest)(nil),                // 88: saturn.finance.v1.ListTransactionsRequest
+	(*ListTransactionsResponse)(nil),               // 89: saturn.finance.v1.ListTransactionsResponse
+	(*BatchUpdateTransactionsRequest)(nil),         // 90: saturn.finance.v1.BatchUpdateTransactionsRequest
+	(*TransactionDiff)(nil),                        // 91: saturn.finance.v1.TransactionDiff
+	(*AccountBalanceChange)(nil),                   // 92: saturn.finance.v1.AccountBalanceChange
+	(*BatchUpdateTransactionsResponse)(nil),        // 93: saturn.finance.v1.BatchUpdateTransactionsResponse
+	(*BatchDeleteTransactionsRequest)(nil),         // 94: saturn.finance.v1.BatchDeleteTransactionsRequest
+	(*BatchDeleteTransactionsResponse)(nil),        // 95: saturn.finance.v1.BatchDeleteTransactionsResponse
+	(*SavedFilter)(nil),                            // 96: saturn.finance.v1.SavedFilter
+	(*CreateSavedFilterRequest)(nil),               // 97: saturn.finance.v1.CreateSavedFilterRequest
+	(*GetSavedFilterRequest)(nil),                  // 98: saturn.finance.v1.GetSavedFilterRequest
+	(*UpdateSavedFilterRequest)(nil),               // 99: saturn.finance.v1.UpdateSavedFilterRequest
+	(*DeleteSavedFilterRequest)(nil),               // 100: saturn.finance.v1.DeleteSavedFilterRequest
+	(*ListSavedFiltersRequest)(nil),                // 101: saturn.finance.v1.ListSavedFiltersRequest
+	(*ListSavedFiltersResponse)(nil),               // 102: saturn.finance.v1.ListSavedFiltersResponse
+	(*Payee)(nil),                                  // 103: saturn.finance.v1.Payee
+	(*CreatePayeeRequest)(nil),                     // 104: saturn.finance.v1.CreatePayeeRequest
+	(*GetPayeeRequest)(nil),                        // 105: saturn.finance.v1.GetPayeeRequest
+	(*UpdatePayeeRequest)(nil),                     // 106: saturn.finance.v1.UpdatePayeeRequest
+	(*DeletePayeeRequest)(nil),                     // 107: saturn.finance.v1.DeletePayeeRequest
+	(*ListPayeesRequest)(nil),                      // 108: saturn.finance.v1.ListPayeesRequest
+	(*ListPayeesResponse)(nil),                     // 109: saturn.finance.v1.ListPayeesResponse
+	(*TransactionRule)(nil),                        // 110: saturn.finance.v1.TransactionRule
+	(*CreateTransactionRuleRequest)(nil),           // 111: saturn.finance.v1.CreateTransactionRuleRequest
+	(*GetTransactionRuleRequest)(nil),              // 112: saturn.finance.v1.GetTransactionRuleRequest
+	(*UpdateTransactionRuleRequest)(nil),           // 113: saturn.finance.v1.UpdateTransactionRuleRequest
+	(*DeleteTransactionRuleRequest)(nil),           // 114: saturn.finance.v1.DeleteTransactionRuleRequest
+	(*ListTransactionRulesRequest)(nil),            // 115: saturn.finance.v1.ListTransactionRulesRequest
+	(*ListTransactionRulesResponse)(nil),           // 116: saturn.finance.v1.ListTransactionRulesResponse
+	(*ApplyTransactionRulesRequest)(nil),           // 117: saturn.finance.v1.ApplyTransactionRulesRequest
+	(*ApplyTransactionRulesResponse)(nil),          // 118: saturn.finance.v1.ApplyTransactionRulesResponse
+	(*GetInsightsRequest)(nil),                     // 119: saturn.finance.v1.GetInsightsRequest
+	(*GetInsightsResponse)(nil),                    // 120: saturn.finance.v1.GetInsightsResponse
+	(*CashFlowInsights)(nil),                       // 121: saturn.finance.v1.CashFlowInsights
+	(*SpentInsights)(nil),                          // 122: saturn.finance.v1.SpentInsights
+	(*GenerateScheduledPaymentsPayload)(nil),       // 123: saturn.finance.v1.GenerateScheduledPaymentsPayload
+	(*SyncExchangeRatesPayload)(nil),               // 124: saturn.finance.v1.SyncExchangeRatesPayload
+	(*RecurringExpense)(nil),                       // 125: saturn.finance.v1.RecurringExpense
+	(*ScheduledPayment)(nil),                       // 126: saturn.finance.v1.ScheduledPayment
+	(*CreateRecurringExpenseRequest)(nil),          // 127: saturn.finance.v1.CreateRecurringExpenseRequest
+	(*UpdateRecurringExpenseRequest)(nil),          // 128: saturn.finance.v1.UpdateRecurringExpenseRequest
+	(*DeleteRecurringExpenseRequest)(nil),          // 129: saturn.finance.v1.DeleteRecurringExpenseRequest
+	(*ListRecurringExpensesRequest)(nil),           // 130: saturn.finance.v1.ListRecurringExpensesRequest
+	(*ListRecurringExpensesResponse)(nil),          // 131: saturn.finance.v1.ListRecurringExpensesResponse
+	(*SubscriptionSuggestion)(nil),                 // 132: saturn.finance.v1.SubscriptionSuggestion
+	(*DetectSubscriptionsRequest)(nil),             // 133: saturn.finance.v1.DetectSubscriptionsRequest
+	(*DetectSubscriptionsResponse)(nil),            // 134: saturn.finance.v1.DetectSubscriptionsResponse
+	(*ConvertToRecurringExpenseRequest)(nil),       // 135: saturn.finance.v1.ConvertToRecurringExpenseRequest
+	(*ListScheduledPaymentsRequest)(nil),           // 136: saturn.finance.v1.ListScheduledPaymentsRequest
+	(*ListScheduledPaymentsResponse)(nil),          // 137: saturn.finance.v1.ListScheduledPaymentsResponse
+	(*GetScheduledPaymentRequest)(nil),             // 138: saturn.finance.v1.GetScheduledPaymentRequest
+	(*ConfirmScheduledPaymentRequest)(nil),         // 139: saturn.finance.v1.ConfirmScheduledPaymentRequest
+	(*MatchScheduledPaymentRequest)(nil),           // 140: saturn.finance.v1.MatchScheduledPaymentRequest
+	(*SkipScheduledPaymentRequest)(nil),            // 141: saturn.finance.v1.SkipScheduledPaymentRequest
+	(*Borrowing)(nil),                              // 142: saturn.finance.v1.Borrowing
+	(*BorrowingInstallment)(nil),                   // 143: saturn.finance.v1.BorrowingInstallment
+	(*BorrowingRepayment)(nil),                     // 144: saturn.finance.v1.BorrowingRepayment
+	(*CreateBorrowingRequest)(nil),                 // 145: saturn.finance.v1.CreateBorrowingRequest
+	(*GetBorrowingRequest)(nil),                    // 146: saturn.finance.v1.GetBorrowingRequest
+	(*ListBorrowingsRequest)(nil),                  // 147: saturn.finance.v1.ListBorrowingsRequest
+	(*ListBorrowingsResponse)(nil),                 // 148: saturn.finance.v1.ListBorrowingsResponse
+	(*UpdateBorrowingRequest)(nil),                 // 149: saturn.finance.v1.UpdateBorrowingRequest
+	(*DeleteBorrowingRequest)(nil),                 // 150: saturn.finance.v1.DeleteBorrowingRequest
+	(*CreateBorrowingRepaymentRequest)(nil),        // 151: saturn.finance.v1.CreateBorrowingRepaymentRequest
+	(*ListBorrowingRepaymentsRequest)(nil),         // 152: saturn.finance.v1.ListBorrowingRepaymentsRequest
+	(*ListBorrowingRepaymentsResponse)(nil),        // 153: saturn.finance.v1.ListBorrowingRepaymentsResponse
+	(*DeleteBorrowingRepaymentRequest)(nil),        // 154: saturn.finance.v1.DeleteBorrowingRepaymentRequest
+	(*CurrencyInfo)(nil),                           // 155: saturn.finance.v1.CurrencyInfo
+	(*ListCurrenciesRequest)(nil),                  // 156: saturn.finance.v1.ListCurrenciesRequest
+	(*ListCurrenciesResponse)(nil),                 // 157: saturn.finance.v1.ListCurrenciesResponse
+	(*Account)(nil),                                // 158: saturn.finance.v1.Account
+	(*CreateAccountRequest)(nil),                   // 159: saturn.finance.v1.CreateAccountRequest
+	(*GetAccountRequest)(nil),                      // 160: saturn.finance.v1.GetAccountRequest
+	(*UpdateAccountRequest)(nil),                   // 161: saturn.finance.v1.UpdateAccountRequest
+	(*AdjustAccountBalanceRequest)(nil),            // 162: saturn.finance.v1.AdjustAccountBalanceRequest
+	(*Reconciliation)(nil),                         // 163: saturn.finance.v1.Reconciliation
+	(*StartReconciliationRequest)(nil),             // 164: saturn.finance.v1.StartReconciliationRequest
+	(*GetReconciliationRequest)(nil),               // 165: saturn.finance.v1.GetReconciliationRequest
+	(*ListReconciliationsRequest)(nil),             // 166: saturn.finance.v1.ListReconciliationsRequest
+	(*ListReconciliationsResponse)(nil),            // 167: saturn.finance.v1.ListReconciliationsResponse
+	(*ListReconciliationTransactionsRequest)(nil),  // 168: saturn.finance.v1.ListReconciliationTransactionsRequest
+	(*ListReconciliationTransactionsResponse)(nil), // 169: saturn.finance.v1.ListReconciliationTransactionsResponse
+	(*SetTransactionsClearedRequest)(nil),          // 170: saturn.finance.v1.SetTransactionsClearedRequest
+	(*FinalizeReconciliationRequest)(nil),          // 171: saturn.finance.v1.FinalizeReconciliationRequest
+	(*CancelReconciliationRequest)(nil),            // 172: saturn.finance.v1.CancelReconciliationRequest
+	(*DeleteAccountRequest)(nil),                   // 173: saturn.finance.v1.DeleteAccountRequest
+	(*ListAccountsRequest)(nil),                    // 174: saturn.finance.v1.ListAccountsRequest
+	(*ListAccountsResponse)(nil),                   // 175: saturn.finance.v1.ListAccountsResponse
+	(*Transfer)(nil),                               // 176: saturn.finance.v1.Transfer
+	(*CreateTransferRequest)(nil),                  // 177: saturn.finance.v1.CreateTransferRequest
+	(*ListTransfersRequest)(nil),                   // 178: saturn.finance.v1.ListTransfersRequest
+	(*ListTransfersResponse)(nil),                  // 179: saturn.finance.v1.ListTransfersResponse
+	(*ListTransactionEventsRequest)(nil),           // 180: saturn.finance.v1.ListTransactionEventsRequest
+	(*TransactionEvent)(nil),                       // 181: saturn.finance.v1.TransactionEvent
+	(*ListTransactionEventsResponse)(nil),          // 182: saturn.finance.v1.ListTransactionEventsResponse
+	(*InboxItem)(nil),                              // 183: saturn.finance.v1.InboxItem
+	(*ListInboxItemsRequest)(nil),                  // 184: saturn.finance.v1.ListInboxItemsRequest
+	(*ListInboxItemsResponse)(nil),                 // 185: saturn.finance.v1.ListInboxItemsResponse
+	(*UpdateInboxItemRequest)(nil),                 // 186: saturn.finance.v1.UpdateInboxItemRequest
+	(*ApproveInboxItemRequest)(nil),                // 187: saturn.finance.v1.ApproveInboxItemRequest
+	(*DiscardInboxItemRequest)(nil),                // 188: saturn.finance.v1.DiscardInboxItemRequest
+	(*StatementMapping)(nil),                       // 189: saturn.finance.v1.StatementMapping
+	(*ImportStatementRequest)(nil),                 // 190: saturn.finance.v1.ImportStatementRequest
+	(*ImportStatementResponse)(nil),                // 191: saturn.finance.v1.ImportStatementResponse
+	(*GetStatementMappingRequest)(nil),             // 192: saturn.finance.v1.GetStatementMappingRequest
+	(*ExportLedgerRequest)(nil),                    // 193: saturn.finance.v1.ExportLedgerRequest
+	(*LedgerExportChunk)(nil),                      // 194: saturn.finance.v1.LedgerExportChunk
+	(*LedgerExport)(nil),                           // 195: saturn.finance.v1.LedgerExport
+	(*CreateLedgerExportRequest)(nil),              // 196: saturn.finance.v1.CreateLedgerExportRequest
+	(*GetLedgerExportRequest)(nil),                 // 197: saturn.finance.v1.GetLedgerExportRequest
+	(*ListLedgerExportsRequest)(nil),               // 198: saturn.finance.v1.ListLedgerExportsRequest
+	(*ListLedgerExportsResponse)(nil),              // 199: saturn.finance.v1.ListLedgerExportsResponse
+	(*DownloadLedgerExportRequest)(nil),            // 200: saturn.finance.v1.DownloadLedgerExportRequest
+	(*CardStatement)(nil),                          // 201: saturn.finance.v1.CardStatement
+	(*ListCardStatementsRequest)(nil),              // 202: saturn.finance.v1.ListCardStatementsRequest
+	(*ListCardStatementsResponse)(nil),             // 203: saturn.finance.v1.ListCardStatementsResponse
+	(*GetCardStatementRequest)(nil),                // 204: saturn.finance.v1.GetCardStatementRequest
+	(*ForecastCashFlowRequest)(nil),                // 205: saturn.finance.v1.ForecastCashFlowRequest
+	(*ForecastCashFlowResponse)(nil),               // 206: saturn.finance.v1.ForecastCashFlowResponse
+	(*AccountForecast)(nil),                        // 207: saturn.finance.v1.AccountForecast
+	(*ForecastDay)(nil),                            // 208: saturn.finance.v1.ForecastDay
+	(*ForecastEvent)(nil),                          // 209: saturn.finance.v1.ForecastEvent
+	(*ForecastAlert)(nil),                          // 210: saturn.finance.v1.ForecastAlert
+	(*Alert)(nil),                                  // 211: saturn.finance.v1.Alert
+	(*ListAlertsRequest)(nil),                      // 212: saturn.finance.v1.ListAlertsRequest
+	(*ListAlertsResponse)(nil),                     // 213: saturn.finance.v1.ListAlertsResponse
+	(*GetAlertRequest)(nil),                        // 214: saturn.finance.v1.GetAlertRequest
+	(*AcknowledgeAlertRequest)(nil),                // 215: saturn.finance.v1.AcknowledgeAlertRequest
+	(*SnoozeAlertRequest)(nil),                     // 216: saturn.finance.v1.SnoozeAlertRequest
+	(*TransactionChangedEvent)(nil),                // 217: saturn.finance.v1.TransactionChangedEvent
+	(*AlertRaisedEvent)(nil),                       // 218: saturn.finance.v1.AlertRaisedEvent
+	(*CloseCardStatementsPayload)(nil),             // 219: saturn.finance.v1.CloseCardStatementsPayload
+	(*RunLedgerExportPayload)(nil),                 // 220: saturn.finance.v1.RunLedgerExportPayload
+	(*Budget_ActivePeriod)(nil),                    // 221: saturn.finance.v1.Budget.ActivePeriod
+	(*Transaction_AccountInfo)(nil),                // 222: saturn.finance.v1.Transaction.AccountInfo
+	(*Transaction_BudgetInfo)(nil),                 // 223: saturn.finance.v1.Transaction.BudgetInfo
+	nil,                                            // 224: saturn.finance.v1.Transaction.MetadataEntry
+	(*GoalProgress_Contribution)(nil),              // 225: saturn.finance.v1.GoalProgress.Contribution
+	(*TransactionDiff_FieldChange)(nil),            // 226: saturn.finance.v1.TransactionDiff.FieldChange
+	(*CashFlowInsights_CashFlowDataPoint)(nil),     // 227: saturn.finance.v1.CashFlowInsights.CashFlowDataPoint
+	(*SpentInsights_BudgetContribution)(nil),       // 228: saturn.finance.v1.SpentInsights.BudgetContribution
+	(*SpentInsights_TrendDataPoint)(nil),           // 229: saturn.finance.v1.SpentInsights.TrendDataPoint
+	(*SpentInsights_BudgetUsage)(nil),              // 230: saturn.finance.v1.SpentInsights.BudgetUsage
+	(*SpentInsights_CategoryUsage)(nil),            // 231: saturn.finance.v1.SpentInsights.CategoryUsage
+	(*SpentInsights_TagUsage)(nil),                 // 232: saturn.finance.v1.SpentInsights.TagUsage
+	(*SpentInsights_HighValueExpense)(nil),         // 233: saturn.finance.v1.SpentInsights.HighValueExpense
+	(*RecurringExpense_BudgetInfo)(nil),            // 234: saturn.finance.v1.RecurringExpense.BudgetInfo
+	(*RecurringExpense_ExecutionState)(nil),        // 235: saturn.finance.v1.RecurringExpense.ExecutionState
+	(*ScheduledPayment_BudgetInfo)(nil),            // 236: saturn.finance.v1.ScheduledPayment.BudgetInfo
+	(*ScheduledPayment_RecurringExpenseInfo)(nil),  // 237: saturn.finance.v1.ScheduledPayment.RecurringExpenseInfo
+	(*Account_Conversion)(nil),                     // 238: saturn.finance.v1.Account.Conversion
+	nil,                                            // 239: saturn.finance.v1.InboxItem.MetadataEntry
+	(*timestamppb.Timestamp)(nil),                  // 240: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),                  // 241: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),                          // 242: google.protobuf.Empty
 }
 var file_saturn_finance_v1_finance_proto_depIdxs = []int32{
-	114, // 0: saturn.finance.v1.FinanceSettings.create_time:type_name -> google.protobuf.Timestamp
-	114, // 1: saturn.finance.v1.FinanceSettings.update_time:type_name -> google.protobuf.Timestamp
-	3,   // 2: saturn.finance.v1.Budget.interval:type_name -> saturn.finance.v1.Budget.RecurrenceInterval
-	100, // 3: saturn.finance.v1.Budget.current_period:type_name -> saturn.finance.v1.Budget.ActivePeriod
-	114, // 4: saturn.finance.v1.Budget.create_time:type_name -> google.protobuf.Timestamp
-	114, // 5: saturn.finance.v1.Budget.update_time:type_name -> google.protobuf.Timestamp
-	114, // 6: saturn.finance.v1.BudgetPeriod.start_date:type_name -> google.protobuf.Timestamp
-	114, // 7: saturn.finance.v1.BudgetPeriod.end_date:type_name -> google.protobuf.Timestamp
-	114, // 8: saturn.finance.v1.BudgetPeriod.create_time:type_name -> google.protobuf.Timestamp
-	114, // 9: saturn.finance.v1.BudgetPeriod.update_time:type_name -> google.protobuf.Timestamp
-	21,  // 10: saturn.finance.v1.CreateBudgetRequest.budget:type_name -> saturn.finance.v1.Budget
-	21,  // 11: saturn.finance.v1.UpdateBudgetRequest.budget:type_name -> saturn.finance.v1.Budget
-	0,   // 12: saturn.finance.v1.UpdateBudgetRequest.propagation:type_name -> saturn.finance.v1.LimitPropagation
-	115, // 13: saturn.finance.v1.UpdateBudgetRequest.update_mask:type_name -> google.protobuf.FieldMask
-	4,   // 14: saturn.finance.v1.ListBudgetsRequest.view:type_name -> saturn.finance.v1.Budget.View
-	114, // 15: saturn.finance.v1.ListBudgetsRequest.target_date:type_name -> google.protobuf.Timestamp
-	21,  // 16: saturn.finance.v1.ListBudgetsResponse.budgets:type_name -> saturn.finance.v1.Budget
-	114, // 17: saturn.finance.v1.GetBudgetPeriodRequest.date:type_name -> google.protobuf.Timestamp
-	114, // 18: saturn.finance.v1.ExchangeRate.rate_date:type_name -> google.protobuf.Timestamp
-	114, // 19: saturn.finance.v1.ExchangeRate.create_time:type_name -> google.protobuf.Timestamp
-	32,  // 20: saturn.finance.v1.CreateExchangeRateRequest.exchange_rate:type_name -> saturn.finance.v1.ExchangeRate
-	32,  // 21: saturn.finance.v1.UpdateExchangeRateRequest.exchange_rate:type_name -> saturn.finance.v1.ExchangeRate
-	114, // 22: saturn.finance.v1.ListExchangeRatesRequest.start_date:type_name -> google.protobuf.Timestamp
-	114, // 23: saturn.finance.v1.ListExchangeRatesRequest.end_date:type_name -> google.protobuf.Timestamp
-	32,  // 24: saturn.finance.v1.ListExchangeRatesResponse.exchange_rates:type_name -> saturn.finance.v1.ExchangeRate
-	5,   // 25: saturn.finance.v1.Transaction.type:type_name -> saturn.finance.v1.Transaction.Type
-	114, // 26: saturn.finance.v1.Transaction.transaction_date:type_name -> google.protobuf.Timestamp
-	114, // 27: saturn.finance.v1.Transaction.create_time:type_name -> google.protobuf.Timestamp
-	114, // 28: saturn.finance.v1.Transaction.update_time:type_name -> google.protobuf.Timestamp
-	114, // 29: saturn.finance.v1.Transaction.effective_date:type_name -> google.protobuf.Timestamp
-	101, // 30: saturn.finance.v1.Transaction.account:type_name -> saturn.finance.v1.Transaction.AccountInfo
-	102, // 31: saturn.finance.v1.Transaction.budget:type_name -> saturn.finance.v1.Transaction.BudgetInfo
-	103, // 32: saturn.finance.v1.Transaction.metadata:type_name -> saturn.finance.v1.Transaction.MetadataEntry
-	114, // 33: saturn.finance.v1.ExpenseInput.transaction_date:type_name -> google.protobuf.Timestamp
-	114, // 34: saturn.finance.v1.ExpenseInput.effective_date:type_name -> google.protobuf.Timestamp
-	40,  // 35: saturn.finance.v1.CreateExpenseRequest.expense:type_name -> saturn.finance.v1.ExpenseInput
-	40,  // 36: saturn.finance.v1.UpdateExpenseRequest.expense:type_name -> saturn.finance.v1.ExpenseInput
-	6,   // 37: saturn.finance.v1.GetTransactionRequest.view:type_name -> saturn.finance.v1.Transaction.View
-	6,   // 38: saturn.finance.v1.ListTransactionsRequest.view:type_name -> saturn.finance.v1.Transaction.View
-	5,   // 39: saturn.finance.v1.ListTransactionsRequest.type:type_name -> saturn.finance.v1.Transaction.Type
-	39,  // 40: saturn.finance.v1.ListTransactionsResponse.transactions:type_name -> saturn.finance.v1.Transaction
-	1,   // 41: saturn.finance.v1.GetInsightsRequest.granularity:type_name -> saturn.finance.v1.InsightGranularity
-	114, // 42: saturn.finance.v1.GetInsightsRequest.start_date:type_name -> google.protobuf.Timestamp
-	114, // 43: saturn.finance.v1.GetInsightsRequest.end_date:type_name -> google.protobuf.Timestamp
-	49,  // 44: saturn.finance.v1.GetInsightsResponse.spent:type_name -> saturn.finance.v1.SpentInsights
-	105, // 45: saturn.finance.v1.SpentInsights.trend:type_name -> saturn.finance.v1.SpentInsights.TrendDataPoint
-	106, // 46: saturn.finance.v1.SpentInsights.distributions:type_name -> saturn.finance.v1.SpentInsights.BudgetUsage
-	107, // 47: saturn.finance.v1.SpentInsights.top_expenses:type_name -> saturn.finance.v1.SpentInsights.HighValueExpense
-	8,   // 48: saturn.finance.v1.RecurringExpense.interval:type_name -> saturn.finance.v1.RecurringExpense.Interval
-	109, // 49: saturn.finance.v1.RecurringExpense.execution_state:type_name -> saturn.finance.v1.RecurringExpense.ExecutionState
-	9,   // 50: saturn.finance.v1.RecurringExpense.status:type_name -> saturn.finance.v1.RecurringExpense.Status
-	114, // 51: saturn.finance.v1.RecurringExpense.create_time:type_name -> google.protobuf.Timestamp
-	114, // 52: saturn.finance.v1.RecurringExpense.update_time:type_name -> google.protobuf.Timestamp
-	108, // 53: saturn.finance.v1.RecurringExpense.budget:type_name -> saturn.finance.v1.RecurringExpense.BudgetInfo
-	11,  // 54: saturn.finance.v1.ScheduledPayment.source_type:type_name -> saturn.finance.v1.ScheduledPayment.SourceType
-	114, // 55: saturn.finance.v1.ScheduledPayment.due_date:type_name -> google.protobuf.Timestamp
-	12,  // 56: saturn.finance.v1.ScheduledPayment.status:type_name -> saturn.finance.v1.ScheduledPayment.Status
-	114, // 57: saturn.finance.v1.ScheduledPayment.create_time:type_name -> google.protobuf.Timestamp
-	114, // 58: saturn.finance.v1.ScheduledPayment.update_time:type_name -> google.protobuf.Timestamp
-	110, // 59: saturn.finance.v1.ScheduledPayment.budget:type_name -> saturn.finance.v1.ScheduledPayment.BudgetInfo
-	111, // 60: saturn.finance.v1.ScheduledPayment.recurring_expense:type_name -> saturn.finance.v1.ScheduledPayment.RecurringExpenseInfo
-	51,  // 61: saturn.finance.v1.CreateRecurringExpenseRequest.recurring_expense:type_name -> saturn.finance.v1.RecurringExpense
-	51,  // 62: saturn.finance.v1.UpdateRecurringExpenseRequest.recurring_expense:type_name -> saturn.finance.v1.RecurringExpense
-	9,   // 63: saturn.finance.v1.ListRecurringExpensesRequest.status:type_name -> saturn.finance.v1.RecurringExpense.Status
-	7,   // 64: saturn.finance.v1.ListRecurringExpensesRequest.view:type_name -> saturn.finance.v1.RecurringExpense.View
-	51,  // 65: saturn.finance.v1.ListRecurringExpensesResponse.recurring_expenses:type_name -> saturn.finance.v1.RecurringExpense
-	12,  // 66: saturn.finance.v1.ListScheduledPaymentsRequest.status:type_name -> saturn.finance.v1.ScheduledPayment.Status
-	114, // 67: saturn.finance.v1.ListScheduledPaymentsRequest.start_date:type_name -> google.protobuf.Timestamp
-	114, // 68: saturn.finance.v1.ListScheduledPaymentsRequest.end_date:type_name -> google.protobuf.Timestamp
-	10,  // 69: saturn.finance.v1.ListScheduledPaymentsRequest.view:type_name -> saturn.finance.v1.ScheduledPayment.View
-	52,  // 70: saturn.finance.v1.ListScheduledPaymentsResponse.scheduled_payments:type_name -> saturn.finance.v1.ScheduledPayment
-	114, // 71: saturn.finance.v1.ConfirmScheduledPaymentRequest.transaction_date:type_name -> google.protobuf.Timestamp
-	114, // 72: saturn.finance.v1.ConfirmScheduledPaymentRequest.effective_date:type_name -> google.protobuf.Timestamp
-	13,  // 73: saturn.finance.v1.Borrowing.direction:type_name -> saturn.finance.v1.Borrowing.Direction
-	14,  // 74: saturn.finance.v1.Borrowing.status:type_name -> saturn.finance.v1.Borrowing.Status
-	114, // 75: saturn.finance.v1.Borrowing.established_at:type_name -> google.protobuf.Timestamp
-	114, // 76: saturn.finance.v1.Borrowing.due_at:type_name -> google.protobuf.Timestamp
-	114, // 77: saturn.finance.v1.Borrowing.create_time:type_name -> google.protobuf.Timestamp
-	114, // 78: saturn.finance.v1.Borrowing.update_time:type_name -> google.protobuf.Timestamp
-	114, // 79: saturn.finance.v1.BorrowingRepayment.payment_date:type_name -> google.protobuf.Timestamp
-	114, // 80: saturn.finance.v1.BorrowingRepayment.create_time:type_name -> google.protobuf.Timestamp
-	114, // 81: saturn.finance.v1.BorrowingRepayment.update_time:type_name -> google.protobuf.Timestamp
-	64,  // 82: saturn.finance.v1.CreateBorrowingRequest.borrowing:type_name -> saturn.finance.v1.Borrowing
-	14,  // 83: saturn.finance.v1.ListBorrowingsRequest.status:type_name -> saturn.finance.v1.Borrowing.Status
-	13,  // 84: saturn.finance.v1.ListBorrowingsRequest.direction:type_name -> saturn.finance.v1.Borrowing.Direction
-	64,  // 85: saturn.finance.v1.ListBorrowingsResponse.borrowings:type_name -> saturn.finance.v1.Borrowing
-	64,  // 86: saturn.finance.v1.UpdateBorrowingRequest.borrowing:type_name -> saturn.finance.v1.Borrowing
-	65,  // 87: saturn.finance.v1.CreateBorrowingRepaymentRequest.repayment:type_name -> saturn.finance.v1.BorrowingRepayment
-	65,  // 88: saturn.finance.v1.ListBorrowingRepaymentsResponse.repayments:type_name -> saturn.finance.v1.BorrowingRepayment
-	76,  // 89: saturn.finance.v1.ListCurrenciesResponse.currencies:type_name -> saturn.finance.v1.CurrencyInfo
-	15,  // 90: saturn.finance.v1.Account.type:type_name -> saturn.finance.v1.Account.Type
-	114, // 91: saturn.finance.v1.Account.create_time:type_name -> google.protobuf.Timestamp
-	114, // 92: saturn.finance.v1.Account.update_time:type_name -> google.protobuf.Timestamp
-	112, // 93: saturn.finance.v1.Account.conversion:type_name -> saturn.finance.v1.Account.Conversion
-	79,  // 94: saturn.finance.v1.CreateAccountRequest.account:type_name -> saturn.finance.v1.Account
-	16,  // 95: saturn.finance.v1.GetAccountRequest.view:type_name -> saturn.finance.v1.Account.View
-	79,  // 96: saturn.finance.v1.UpdateAccountRequest.account:type_name -> saturn.finance.v1.Account
-	16,  // 97: saturn.finance.v1.ListAccountsRequest.view:type_name -> saturn.finance.v1.Account.View
-	79,  // 98: saturn.finance.v1.ListAccountsResponse.accounts:type_name -> saturn.finance.v1.Account
-	114, // 99: saturn.finance.v1.Transfer.transfer_date:type_name -> google.protobuf.Timestamp
-	114, // 100: saturn.finance.v1.Transfer.create_time:type_name -> google.protobuf.Timestamp
-	114, // 101: saturn.finance.v1.Transfer.update_time:type_name -> google.protobuf.Timestamp
-	114, // 102: saturn.finance.v1.CreateTransferRequest.transfer_date:type_name -> google.protobuf.Timestamp
-	87,  // 103: saturn.finance.v1.ListTransfersResponse.transfers:type_name -> saturn.finance.v1.Transfer
-	114, // 104: saturn.finance.v1.TransactionEvent.create_time:type_name -> google.protobuf.Timestamp
-	92,  // 105: saturn.finance.v1.ListTransactionEventsResponse.events:type_name -> saturn.finance.v1.TransactionEvent
-	17,  // 106: saturn.finance.v1.InboxItem.status:type_name -> saturn.finance.v1.InboxItem.Status
-	18,  // 107: saturn.finance.v1.InboxItem.doc_type:type_name -> saturn.finance.v1.InboxItem.DocType
-	114, // 108: saturn.finance.v1.InboxItem.transaction_date:type_name -> google.protobuf.Timestamp
-	113, // 109: saturn.finance.v1.InboxItem.metadata:type_name -> saturn.finance.v1.InboxItem.MetadataEntry
-	114, // 110: saturn.finance.v1.InboxItem.create_time:type_name -> google.protobuf.Timestamp
-	2,   // 111: saturn.finance.v1.InboxItem.borrowing_link_type:type_name -> saturn.finance.v1.BorrowingLinkType
-	17,  // 112: saturn.finance.v1.ListInboxItemsRequest.status:type_name -> saturn.finance.v1.InboxItem.Status
-	18,  // 113: saturn.finance.v1.ListInboxItemsRequest.doc_type:type_name -> saturn.finance.v1.InboxItem.DocType
-	19,  // 114: saturn.finance.v1.ListInboxItemsRequest.view:type_name -> saturn.finance.v1.InboxItem.View
-	94,  // 115: saturn.finance.v1.ListInboxItemsResponse.inbox_items:type_name -> saturn.finance.v1.InboxItem
-	94,  // 116: saturn.finance.v1.UpdateInboxItemRequest.inbox_item:type_name -> saturn.finance.v1.InboxItem
-	114, // 117: saturn.finance.v1.Budget.ActivePeriod.start_date:type_name -> google.protobuf.Timestamp
-	114, // 118: saturn.finance.v1.Budget.ActivePeriod.end_date:type_name -> google.protobuf.Timestamp
-	104, // 119: saturn.finance.v1.SpentInsights.TrendDataPoint.contributions:type_name -> saturn.finance.v1.SpentInsights.BudgetContribution
-	114, // 120: saturn.finance.v1.SpentInsights.HighValueExpense.transaction_date:type_name -> google.protobuf.Timestamp
-	114, // 121: saturn.finance.v1.SpentInsights.HighValueExpense.effective_date:type_name -> google.protobuf.Timestamp
-	114, // 122: saturn.finance.v1.RecurringExpense.ExecutionState.next_due_date:type_name -> google.protobuf.Timestamp
-	114, // 123: saturn.finance.v1.RecurringExpense.ExecutionState.last_payment_date:type_name -> google.protobuf.Timestamp
-	8,   // 124: saturn.finance.v1.ScheduledPayment.RecurringExpenseInfo.interval:type_name -> saturn.finance.v1.RecurringExpense.Interval
-	23,  // 125: saturn.finance.v1.Finance.ConfigureFinance:input_type -> saturn.finance.v1.ConfigureFinanceRequest
-	24,  // 126: saturn.finance.v1.Finance.GetFinanceSettings:input_type -> saturn.finance.v1.GetFinanceSettingsRequest
-	26,  // 127: saturn.finance.v1.Finance.CreateBudget:input_type -> saturn.finance.v1.CreateBudgetRequest
-	25,  // 128: saturn.finance.v1.Finance.GetBudget:input_type -> saturn.finance.v1.GetBudgetRequest
-	27,  // 129: saturn.finance.v1.Finance.UpdateBudget:input_type -> saturn.finance.v1.UpdateBudgetRequest
-	28,  // 130: saturn.finance.v1.Finance.DeleteBudget:input_type -> saturn.finance.v1.DeleteBudgetRequest
-	29,  // 131: saturn.finance.v1.Finance.ListBudgets:input_type -> saturn.finance.v1.ListBudgetsRequest
-	31,  // 132: saturn.finance.v1.Finance.GetBudgetPeriod:input_type -> saturn.finance.v1.GetBudgetPeriodRequest
-	33,  // 133: saturn.finance.v1.Finance.CreateExchangeRate:input_type -> saturn.finance.v1.CreateExchangeRateRequest
-	34,  // 134: saturn.finance.v1.Finance.GetExchangeRate:input_type -> saturn.finance.v1.GetExchangeRateRequest
-	35,  // 135: saturn.finance.v1.Finance.UpdateExchangeRate:input_type -> saturn.finance.v1.UpdateExchangeRateRequest
-	36,  // 136: saturn.finance.v1.Finance.ListExchangeRates:input_type -> saturn.finance.v1.ListExchangeRatesRequest
-	38,  // 137: saturn.finance.v1.Finance.DeleteExchangeRate:input_type -> saturn.finance.v1.DeleteExchangeRateRequest
-	41,  // 138: saturn.finance.v1.Finance.CreateExpense:input_type -> saturn.finance.v1.CreateExpenseRequest
-	42,  // 139: saturn.finance.v1.Finance.UpdateExpense:input_type -> saturn.finance.v1.UpdateExpenseRequest
-	43,  // 140: saturn.finance.v1.Finance.DeleteTransaction:input_type -> saturn.finance.v1.DeleteTransactionRequest
-	45,  // 141: saturn.finance.v1.Finance.ListTransactions:input_type -> saturn.finance.v1.ListTransactionsRequest
-	44,  // 142: saturn.finance.v1.Finance.GetTransaction:input_type -> saturn.finance.v1.GetTransactionRequest
-	91,  // 143: saturn.finance.v1.Finance.ListTransactionEvents:input_type -> saturn.finance.v1.ListTransactionEventsRequest
-	47,  // 144: saturn.finance.v1.Finance.GetInsights:input_type -> saturn.finance.v1.GetInsightsRequest
-	53,  // 145: saturn.finance.v1.Finance.CreateRecurringExpense:input_type -> saturn.finance.v1.CreateRecurringExpenseRequest
-	54,  // 146: saturn.finance.v1.Finance.UpdateRecurringExpense:input_type -> saturn.finance.v1.UpdateRecurringExpenseRequest
-	55,  // 147: saturn.finance.v1.Finance.DeleteRecurringExpense:input_type -> saturn.finance.v1.DeleteRecurringExpenseRequest
-	56,  // 148: saturn.finance.v1.Finance.ListRecurringExpenses:input_type -> saturn.finance.v1.ListRecurringExpensesRequest
-	58,  // 149: saturn.finance.v1.Finance.ListScheduledPayments:input_type -> saturn.finance.v1.ListScheduledPaymentsRequest
-	60,  // 150: saturn.finance.v1.Finance.GetScheduledPayment:input_type -> saturn.finance.v1.GetScheduledPaymentRequest
-	61,  // 151: saturn.finance.v1.Finance.ConfirmScheduledPayment:input_type -> saturn.finance.v1.ConfirmScheduledPaymentRequest
-	62,  // 152: saturn.finance.v1.Finance.MatchScheduledPayment:input_type -> saturn.finance.v1.MatchScheduledPaymentRequest
-	63,  // 153: saturn.finance.v1.Finance.SkipScheduledPayment:input_type -> saturn.finance.v1.SkipScheduledPaymentRequest
-	66,  // 154: saturn.finance.v1.Finance.CreateBorrowing:input_type -> saturn.finance.v1.CreateBorrowingRequest
-	67,  // 155: saturn.finance.v1.Finance.GetBorrowing:input_type -> saturn.finance.v1.GetBorrowingRequest
-	68,  // 156: saturn.finance.v1.Finance.ListBorrowings:input_type -> saturn.finance.v1.ListBorrowingsRequest
-	70,  // 157: saturn.finance.v1.Finance.UpdateBorrowing:input_type -> saturn.finance.v1.UpdateBorrowingRequest
-	71,  // 158: saturn.finance.v1.Finance.DeleteBorrowing:input_type -> saturn.finance.v1.DeleteBorrowingRequest
-	72,  // 159: saturn.finance.v1.Finance.CreateBorrowingRepayment:input_type -> saturn.finance.v1.CreateBorrowingRepaymentRequest
-	73,  // 160: saturn.finance.v1.Finance.ListBorrowingRepayments:input_type -> saturn.finance.v1.ListBorrowingRepaymentsRequest
-	75,  // 161: saturn.finance.v1.Finance.DeleteBorrowingRepayment:input_type -> saturn.finance.v1.DeleteBorrowingRepaymentRequest
-	80,  // 162: saturn.finance.v1.Finance.CreateAccount:input_type -> saturn.finance.v1.CreateAccountRequest
-	81,  // 163: saturn.finance.v1.Finance.GetAccount:input_type -> saturn.finance.v1.GetAccountRequest
-	82,  // 164: saturn.finance.v1.Finance.UpdateAccount:input_type -> saturn.finance.v1.UpdateAccountRequest
-	83,  // 165: saturn.finance.v1.Finance.AdjustAccountBalance:input_type -> saturn.finance.v1.AdjustAccountBalanceRequest
-	84,  // 166: saturn.finance.v1.Finance.DeleteAccount:input_type -> saturn.finance.v1.DeleteAccountRequest
-	85,  // 167: saturn.finance.v1.Finance.ListAccounts:input_type -> saturn.finance.v1.ListAccountsRequest
-	88,  // 168: saturn.finance.v1.Finance.CreateTransfer:input_type -> saturn.finance.v1.CreateTransferRequest
-	89,  // 169: saturn.finance.v1.Finance.ListTransfers:input_type -> saturn.finance.v1.ListTransfersRequest
-	77,  // 170: saturn.finance.v1.Finance.ListCurrencies:input_type -> saturn.finance.v1.ListCurrenciesRequest
-	95,  // 171: saturn.finance.v1.Finance.ListInboxItems:input_type -> saturn.finance.v1.ListInboxItemsRequest
-	97,  // 172: saturn.finance.v1.Finance.UpdateInboxItem:input_type -> saturn.finance.v1.UpdateInboxItemRequest
-	98,  // 173: saturn.finance.v1.Finance.ApproveInboxItem:input_type -> saturn.finance.v1.ApproveInboxItemRequest
-	99,  // 174: saturn.finance.v1.Finance.DiscardInboxItem:input_type -> saturn.finance.v1.DiscardInboxItemRequest
-	20,  // 175: saturn.finance.v1.Finance.ConfigureFinance:output_type -> saturn.finance.v1.FinanceSettings
-	20,  // 176: saturn.finance.v1.Finance.GetFinanceSettings:output_type -> saturn.finance.v1.FinanceSettings
-	21,  // 177: saturn.finance.v1.Finance.CreateBudget:output_type -> saturn.finance.v1.Budget
-	21,  // 178: saturn.finance.v1.Finance.GetBudget:output_type -> saturn.finance.v1.Budget
-	21,  // 179: saturn.finance.v1.Finance.UpdateBudget:output_type -> saturn.finance.v1.Budget
-	116, // 180: saturn.finance.v1.Finance.DeleteBudget:output_type -> google.protobuf.Empty
-	30,  // 181: saturn.finance.v1.Finance.ListBudgets:output_type -> saturn.finance.v1.ListBudgetsResponse
-	22,  // 182: saturn.finance.v1.Finance.GetBudgetPeriod:output_type -> saturn.finance.v1.BudgetPeriod
-	32,  // 183: saturn.finance.v1.Finance.CreateExchangeRate:output_type -> saturn.finance.v1.ExchangeRate
-	32,  // 184: saturn.finance.v1.Finance.GetExchangeRate:output_type -> saturn.finance.v1.ExchangeRate
-	32,  // 185: saturn.finance.v1.Finance.UpdateExchangeRate:output_type -> saturn.finance.v1.ExchangeRate
-	37,  // 186: saturn.finance.v1.Finance.ListExchangeRates:output_type -> saturn.finance.v1.ListExchangeRatesResponse
-	116, // 187: saturn.finance.v1.Finance.DeleteExchangeRate:output_type -> google.protobuf.Empty
-	39,  // 188: saturn.finance.v1.Finance.CreateExpense:output_type -> saturn.finance.v1.Transaction
-	39,  // 189: saturn.finance.v1.Finance.UpdateExpense:output_type -> saturn.finance.v1.Transaction
-	116, // 190: saturn.finance.v1.Finance.DeleteTransaction:output_type -> google.protobuf.Empty
-	46,  // 191: saturn.finance.v1.Finance.ListTransactions:output_type -> saturn.finance.v1.ListTransactionsResponse
-	39,  // 192: saturn.finance.v1.Finance.GetTransaction:output_type -> saturn.finance.v1.Transaction
-	93,  // 193: saturn.finance.v1.Finance.ListTransactionEvents:output_type -> saturn.finance.v1.ListTransactionEventsResponse
-	48,  // 194: saturn.finance.v1.Finance.GetInsights:output_type -> saturn.finance.v1.GetInsightsResponse
-	51,  // 195: saturn.finance.v1.Finance.CreateRecurringExpense:output_type -> saturn.finance.v1.RecurringExpense
-	51,  // 196: saturn.finance.v1.Finance.UpdateRecurringExpense:output_type -> saturn.finance.v1.RecurringExpense
-	116, // 197: saturn.finance.v1.Finance.DeleteRecurringExpense:output_type -> google.protobuf.Empty
-	57,  // 198: saturn.finance.v1.Finance.ListRecurringExpenses:output_type -> saturn.finance.v1.ListRecurringExpensesResponse
-	59,  // 199: saturn.finance.v1.Finance.ListScheduledPayments:output_type -> saturn.finance.v1.ListScheduledPaymentsResponse
-	52,  // 200: saturn.finance.v1.Finance.GetScheduledPayment:output_type -> saturn.finance.v1.ScheduledPayment
-	39,  // 201: saturn.finance.v1.Finance.ConfirmScheduledPayment:output_type -> saturn.finance.v1.Transaction
-	39,  // 202: saturn.finance.v1.Finance.MatchScheduledPayment:output_type -> saturn.finance.v1.Transaction
-	52,  // 203: saturn.finance.v1.Finance.SkipScheduledPayment:output_type -> saturn.finance.v1.ScheduledPayment
-	64,  // 204: saturn.finance.v1.Finance.CreateBorrowing:output_type -> saturn.finance.v1.Borrowing
-	64,  // 205: saturn.finance.v1.Finance.GetBorrowing:output_type -> saturn.finance.v1.Borrowing
-	69,  // 206: saturn.finance.v1.Finance.ListBorrowings:output_type -> saturn.finance.v1.ListBorrowingsResponse
-	64,  // 207: saturn.finance.v1.Finance.UpdateBorrowing:output_type -> saturn.finance.v1.Borrowing
-	116, // 208: saturn.finance.v1.Finance.DeleteBorrowing:output_type -> google.protobuf.Empty
-	65,  // 209: saturn.finance.v1.Finance.CreateBorrowingRepayment:output_type -> saturn.finance.v1.BorrowingRepayment
-	74,  // 210: saturn.finance.v1.Finance.ListBorrowingRepayments:output_type -> saturn.finance.v1.ListBorrowingRepaymentsResponse
-	116, // 211: saturn.finance.v1.Finance.DeleteBorrowingRepayment:output_type -> google.protobuf.Empty
-	79,  // 212: saturn.finance.v1.Finance.CreateAccount:output_type -> saturn.finance.v1.Account
-	79,  // 213: saturn.finance.v1.Finance.GetAccount:output_type -> saturn.finance.v1.Account
-	79,  // 214: saturn.finance.v1.Finance.UpdateAccount:output_type -> saturn.finance.v1.Account
-	79,  // 215: saturn.finance.v1.Finance.AdjustAccountBalance:output_type -> saturn.finance.v1.Account
-	116, // 216: saturn.finance.v1.Finance.DeleteAccount:output_type -> google.protobuf.Empty
-	86,  // 217: saturn.finance.v1.Finance.ListAccounts:output_type -> saturn.finance.v1.ListAccountsResponse
-	87,  // 218: saturn.finance.v1.Finance.CreateTransfer:output_type -> saturn.finance.v1.Transfer
-	90,  // 219: saturn.finance.v1.Finance.ListTransfers:output_type -> saturn.finance.v1.ListTransfersResponse
-	78,  // 220: saturn.finance.v1.Finance.ListCurrencies:output_type -> saturn.finance.v1.ListCurrenciesResponse
-	96,  // 221: saturn.finance.v1.Finance.ListInboxItems:output_type -> saturn.finance.v1.ListInboxItemsResponse
-	94,  // 222: saturn.finance.v1.Finance.UpdateInboxItem:output_type -> saturn.finance.v1.InboxItem
-	94,  // 223: saturn.finance.v1.Finance.ApproveInboxItem:output_type -> saturn.finance.v1.InboxItem
-	116, // 224: saturn.finance.v1.Finance.DiscardInboxItem:output_type -> google.protobuf.Empty
-	175, // [175:225] is the sub-list for method output_type
-	125, // [125:175] is the sub-list for method input_type
-	125, // [125:125] is the sub-list for extension type_name
-	125, // [125:125] is the sub-list for extension extendee
-	0,   // [0:125] is the sub-list for field type_name
+	240, // 0: saturn.finance.v1.FinanceSettings.create_time:type_name -> google.protobuf.Timestamp
+	240, // 1: saturn.finance.v1.FinanceSettings.update_time:type_name -> google.protobuf.Timestamp
+	5,   // 2: saturn.finance.v1.Budget.interval:type_name -> saturn.finance.v1.Budget.RecurrenceInterval
+	221, // 3: saturn.finance.v1.Budget.current_period:type_name -> saturn.finance.v1.Budget.ActivePeriod
+	240, // 4: saturn.finance.v1.Budget.create_time:type_name -> google.protobuf.Timestamp
+	240, // 5: saturn.finance.v1.Budget.update_time:type_name -> google.protobuf.Timestamp
+	6,   // 6: saturn.finance.v1.Budget.rollover_policy:type_name -> saturn.finance.v1.Budget.RolloverPolicy
+	240, // 7: saturn.finance.v1.Budget.period_anchor:type_name -> google.protobuf.Timestamp
+	240, // 8: saturn.finance.v1.BudgetPeriod.start_date:type_name -> google.protobuf.Timestamp
+	240, // 9: saturn.finance.v1.BudgetPeriod.end_date:type_name -> google.protobuf.Timestamp
+	240, // 10: saturn.finance.v1.BudgetPeriod.create_time:type_name -> google.protobuf.Timestamp
+	240, // 11: saturn.finance.v1.BudgetPeriod.update_time:type_name -> google.protobuf.Timestamp
+	35,  // 12: saturn.finance.v1.UpdateFinanceSettingsRequest.settings:type_name -> saturn.finance.v1.FinanceSettings
+	241, // 13: saturn.finance.v1.UpdateFinanceSettingsRequest.update_mask:type_name -> google.protobuf.FieldMask
+	36,  // 14: saturn.finance.v1.CreateBudgetRequest.budget:type_name -> saturn.finance.v1.Budget
+	36,  // 15: saturn.finance.v1.UpdateBudgetRequest.budget:type_name -> saturn.finance.v1.Budget
+	0,   // 16: saturn.finance.v1.UpdateBudgetRequest.propagation:type_name -> saturn.finance.v1.LimitPropagation
+	241, // 17: saturn.finance.v1.UpdateBudgetRequest.update_mask:type_name -> google.protobuf.FieldMask
+	7,   // 18: saturn.finance.v1.ListBudgetsRequest.view:type_name -> saturn.finance.v1.Budget.View
+	240, // 19: saturn.finance.v1.ListBudgetsRequest.target_date:type_name -> google.protobuf.Timestamp
+	36,  // 20: saturn.finance.v1.ListBudgetsResponse.budgets:type_name -> saturn.finance.v1.Budget
+	240, // 21: saturn.finance.v1.GetBudgetPeriodRequest.date:type_name -> google.protobuf.Timestamp
+	240, // 22: saturn.finance.v1.ExchangeRate.rate_date:type_name -> google.protobuf.Timestamp
+	240, // 23: saturn.finance.v1.ExchangeRate.create_time:type_name -> google.protobuf.Timestamp
+	48,  // 24: saturn.finance.v1.CreateExchangeRateRequest.exchange_rate:type_name -> saturn.finance.v1.ExchangeRate
+	48,  // 25: saturn.finance.v1.UpdateExchangeRateRequest.exchange_rate:type_name -> saturn.finance.v1.ExchangeRate
+	240, // 26: saturn.finance.v1.ListExchangeRatesRequest.start_date:type_name -> google.protobuf.Timestamp
+	240, // 27: saturn.finance.v1.ListExchangeRatesRequest.end_date:type_name -> google.protobuf.Timestamp
+	48,  // 28: saturn.finance.v1.ListExchangeRatesResponse.exchange_rates:type_name -> saturn.finance.v1.ExchangeRate
+	8,   // 29: saturn.finance.v1.Transaction.type:type_name -> saturn.finance.v1.Transaction.Type
+	240, // 30: saturn.finance.v1.Transaction.transaction_date:type_name -> google.protobuf.Timestamp
+	240, // 31: saturn.finance.v1.Transaction.create_time:type_name -> google.protobuf.Timestamp
+	240, // 32: saturn.finance.v1.Transaction.update_time:type_name -> google.protobuf.Timestamp
+	240, // 33: saturn.finance.v1.Transaction.effective_date:type_name -> google.protobuf.Timestamp
+	222, // 34: saturn.finance.v1.Transaction.account:type_name -> saturn.finance.v1.Transaction.AccountInfo
+	223, // 35: saturn.finance.v1.Transaction.budget:type_name -> saturn.finance.v1.Transaction.BudgetInfo
+	224, // 36: saturn.finance.v1.Transaction.metadata:type_name -> saturn.finance.v1.Transaction.MetadataEntry
+	56,  // 37: saturn.finance.v1.Transaction.splits:type_name -> saturn.finance.v1.TransactionSplit
+	240, // 38: saturn.finance.v1.ExpenseInput.transaction_date:type_name -> google.protobuf.Timestamp
+	240, // 39: saturn.finance.v1.ExpenseInput.effective_date:type_name -> google.protobuf.Timestamp
+	56,  // 40: saturn.finance.v1.ExpenseInput.splits:type_name -> saturn.finance.v1.TransactionSplit
+	57,  // 41: saturn.finance.v1.CreateExpenseRequest.expense:type_name -> saturn.finance.v1.ExpenseInput
+	57,  // 42: saturn.finance.v1.UpdateExpenseRequest.expense:type_name -> saturn.finance.v1.ExpenseInput
+	240, // 43: saturn.finance.v1.IncomeInput.transaction_date:type_name -> google.protobuf.Timestamp
+	240, // 44: saturn.finance.v1.IncomeInput.effective_date:type_name -> google.protobuf.Timestamp
+	60,  // 45: saturn.finance.v1.CreateIncomeRequest.income:type_name -> saturn.finance.v1.IncomeInput
+	60,  // 46: saturn.finance.v1.UpdateIncomeRequest.income:type_name -> saturn.finance.v1.IncomeInput
+	10,  // 47: saturn.finance.v1.IncomeSource.cadence:type_name -> saturn.finance.v1.IncomeSource.Cadence
+	240, // 48: saturn.finance.v1.IncomeSource.create_time:type_name -> google.protobuf.Timestamp
+	240, // 49: saturn.finance.v1.IncomeSource.update_time:type_name -> google.protobuf.Timestamp
+	240, // 50: saturn.finance.v1.IncomeSource.start_date:type_name -> google.protobuf.Timestamp
+	240, // 51: saturn.finance.v1.IncomeSource.exception_dates:type_name -> google.protobuf.Timestamp
+	63,  // 52: saturn.finance.v1.CreateIncomeSourceRequest.income_source:type_name -> saturn.finance.v1.IncomeSource
+	63,  // 53: saturn.finance.v1.UpdateIncomeSourceRequest.income_source:type_name -> saturn.finance.v1.IncomeSource
+	63,  // 54: saturn.finance.v1.ListIncomeSourcesResponse.income_sources:type_name -> saturn.finance.v1.IncomeSource
+	240, // 55: saturn.finance.v1.Goal.start_date:type_name -> google.protobuf.Timestamp
+	240, // 56: saturn.finance.v1.Goal.deadline:type_name -> google.protobuf.Timestamp
+	11,  // 57: saturn.finance.v1.Goal.status:type_name -> saturn.finance.v1.Goal.Status
+	240, // 58: saturn.finance.v1.Goal.create_time:type_name -> google.protobuf.Timestamp
+	240, // 59: saturn.finance.v1.Goal.update_time:type_name -> google.protobuf.Timestamp
+	70,  // 60: saturn.finance.v1.GoalProgress.goal:type_name -> saturn.finance.v1.Goal
+	240, // 61: saturn.finance.v1.GoalProgress.projected_completion_date:type_name -> google.protobuf.Timestamp
+	225, // 62: saturn.finance.v1.GoalProgress.contributions:type_name -> saturn.finance.v1.GoalProgress.Contribution
+	70,  // 63: saturn.finance.v1.CreateGoalRequest.goal:type_name -> saturn.finance.v1.Goal
+	70,  // 64: saturn.finance.v1.UpdateGoalRequest.goal:type_name -> saturn.finance.v1.Goal
+	11,  // 65: saturn.finance.v1.ListGoalsRequest.status:type_name -> saturn.finance.v1.Goal.Status
+	70,  // 66: saturn.finance.v1.ListGoalsResponse.goals:type_name -> saturn.finance.v1.Goal
+	240, // 67: saturn.finance.v1.Category.create_time:type_name -> google.protobuf.Timestamp
+	240, // 68: saturn.finance.v1.Category.update_time:type_name -> google.protobuf.Timestamp
+	79,  // 69: saturn.finance.v1.CreateCategoryRequest.category:type_name -> saturn.finance.v1.Category
+	79,  // 70: saturn.finance.v1.UpdateCategoryRequest.category:type_name -> saturn.finance.v1.Category
+	79,  // 71: saturn.finance.v1.ListCategoriesResponse.categories:type_name -> saturn.finance.v1.Category
+	9,   // 72: saturn.finance.v1.GetTransactionRequest.view:type_name -> saturn.finance.v1.Transaction.View
+	9,   // 73: saturn.finance.v1.ListTransactionsRequest.view:type_name -> saturn.finance.v1.Transaction.View
+	8,   // 74: saturn.finance.v1.ListTransactionsRequest.type:type_name -> saturn.finance.v1.Transaction.Type
+	55,  // 75: saturn.finance.v1.ListTransactionsResponse.transactions:type_name -> saturn.finance.v1.Transaction
+	55,  // 76: saturn.finance.v1.BatchUpdateTransactionsRequest.transaction:type_name -> saturn.finance.v1.Transaction
+	241, // 77: saturn.finance.v1.BatchUpdateTransactionsRequest.update_mask:type_name -> google.protobuf.FieldMask
+	226, // 78: saturn.finance.v1.TransactionDiff.changes:type_name -> saturn.finance.v1.TransactionDiff.FieldChange
+	55,  // 79: saturn.finance.v1.BatchUpdateTransactionsResponse.transactions:type_name -> saturn.finance.v1.Transaction
+	91,  // 80: saturn.finance.v1.BatchUpdateTransactionsResponse.diffs:type_name -> saturn.finance.v1.TransactionDiff
+	92,  // 81: saturn.finance.v1.BatchUpdateTransactionsResponse.balance_changes:type_name -> saturn.finance.v1.AccountBalanceChange
+	55,  // 82: saturn.finance.v1.BatchDeleteTransactionsResponse.transactions:type_name -> saturn.finance.v1.Transaction
+	92,  // 83: saturn.finance.v1.BatchDeleteTransactionsResponse.balance_changes:type_name -> saturn.finance.v1.AccountBalanceChange
+	240, // 84: saturn.finance.v1.SavedFilter.create_time:type_name -> google.protobuf.Timestamp
+	240, // 85: saturn.finance.v1.SavedFilter.update_time:type_name -> google.protobuf.Timestamp
+	96,  // 86: saturn.finance.v1.CreateSavedFilterRequest.saved_filter:type_name -> saturn.finance.v1.SavedFilter
+	96,  // 87: saturn.finance.v1.UpdateSavedFilterRequest.saved_filter:type_name -> saturn.finance.v1.SavedFilter
+	241, // 88: saturn.finance.v1.UpdateSavedFilterRequest.update_mask:type_name -> google.protobuf.FieldMask
+	96,  // 89: saturn.finance.v1.ListSavedFiltersResponse.saved_filters:type_name -> saturn.finance.v1.SavedFilter
+	240, // 90: saturn.finance.v1.Payee.create_time:type_name -> google.protobuf.Timestamp
+	240, // 91: saturn.finance.v1.Payee.update_time:type_name -> google.protobuf.Timestamp
+	103, // 92: saturn.finance.v1.CreatePayeeRequest.payee:type_name -> saturn.finance.v1.Payee
+	103, // 93: saturn.finance.v1.UpdatePayeeRequest.payee:type_name -> saturn.finance.v1.Payee
+	241, // 94: saturn.finance.v1.UpdatePayeeRequest.update_mask:type_name -> google.protobuf.FieldMask
+	103, // 95: saturn.finance.v1.ListPayeesResponse.payees:type_name -> saturn.finance.v1.Payee
+	240, // 96: saturn.finance.v1.TransactionRule.create_time:type_name -> google.protobuf.Timestamp
+	240, // 97: saturn.finance.v1.TransactionRule.update_time:type_name -> google.protobuf.Timestamp
+	110, // 98: saturn.finance.v1.CreateTransactionRuleRequest.transaction_rule:type_name -> saturn.finance.v1.TransactionRule
+	110, // 99: saturn.finance.v1.UpdateTransactionRuleRequest.transaction_rule:type_name -> saturn.finance.v1.TransactionRule
+	241, // 100: saturn.finance.v1.UpdateTransactionRuleRequest.update_mask:type_name -> google.protobuf.FieldMask
+	110, // 101: saturn.finance.v1.ListTransactionRulesResponse.transaction_rules:type_name -> saturn.finance.v1.TransactionRule
+	240, // 102: saturn.finance.v1.ApplyTransactionRulesRequest.start_date:type_name -> google.protobuf.Timestamp
+	240, // 103: saturn.finance.v1.ApplyTransactionRulesRequest.end_date:type_name -> google.protobuf.Timestamp
+	1,   // 104: saturn.finance.v1.GetInsightsRequest.granularity:type_name -> saturn.finance.v1.InsightGranularity
+	240, // 105: saturn.finance.v1.GetInsightsRequest.start_date:type_name -> google.protobuf.Timestamp
+	240, // 106: saturn.finance.v1.GetInsightsRequest.end_date:type_name -> google.protobuf.Timestamp
+	122, // 107: saturn.finance.v1.GetInsightsResponse.spent:type_name -> saturn.finance.v1.SpentInsights
+	121, // 108: saturn.finance.v1.GetInsightsResponse.cash_flow:type_name -> saturn.finance.v1.CashFlowInsights
+	71,  // 109: saturn.finance.v1.GetInsightsResponse.goals:type_name -> saturn.finance.v1.GoalProgress
+	227, // 110: saturn.finance.v1.CashFlowInsights.trend:type_name -> saturn.finance.v1.CashFlowInsights.CashFlowDataPoint
+	229, // 111: saturn.finance.v1.SpentInsights.trend:type_name -> saturn.finance.v1.SpentInsights.TrendDataPoint
+	230, // 112: saturn.finance.v1.SpentInsights.distributions:type_name -> saturn.finance.v1.SpentInsights.BudgetUsage
+	233, // 113: saturn.finance.v1.SpentInsights.top_expenses:type_name -> saturn.finance.v1.SpentInsights.HighValueExpense
+	231, // 114: saturn.finance.v1.SpentInsights.categories:type_name -> saturn.finance.v1.SpentInsights.CategoryUsage
+	232, // 115: saturn.finance.v1.SpentInsights.tags:type_name -> saturn.finance.v1.SpentInsights.TagUsage
+	13,  // 116: saturn.finance.v1.RecurringExpense.interval:type_name -> saturn.finance.v1.RecurringExpense.Interval
+	235, // 117: saturn.finance.v1.RecurringExpense.execution_state:type_name -> saturn.finance.v1.RecurringExpense.ExecutionState
+	14,  // 118: saturn.finance.v1.RecurringExpense.status:type_name -> saturn.finance.v1.RecurringExpense.Status
+	240, // 119: saturn.finance.v1.RecurringExpense.create_time:type_name -> google.protobuf.Timestamp
+	240, // 120: saturn.finance.v1.RecurringExpense.update_time:type_name -> google.protobuf.Timestamp
+	234, // 121: saturn.finance.v1.RecurringExpense.budget:type_name -> saturn.finance.v1.RecurringExpense.BudgetInfo
+	240, // 122: saturn.finance.v1.RecurringExpense.start_date:type_name -> google.protobuf.Timestamp
+	240, // 123: saturn.finance.v1.RecurringExpense.exception_dates:type_name -> google.protobuf.Timestamp
+	16,  // 124: saturn.finance.v1.ScheduledPayment.source_type:type_name -> saturn.finance.v1.ScheduledPayment.SourceType
+	240, // 125: saturn.finance.v1.ScheduledPayment.due_date:type_name -> google.protobuf.Timestamp
+	17,  // 126: saturn.finance.v1.ScheduledPayment.status:type_name -> saturn.finance.v1.ScheduledPayment.Status
+	240, // 127: saturn.finance.v1.ScheduledPayment.create_time:type_name -> google.protobuf.Timestamp
+	240, // 128: saturn.finance.v1.ScheduledPayment.update_time:type_name -> google.protobuf.Timestamp
+	236, // 129: saturn.finance.v1.ScheduledPayment.budget:type_name -> saturn.finance.v1.ScheduledPayment.BudgetInfo
+	237, // 130: saturn.finance.v1.ScheduledPayment.recurring_expense:type_name -> saturn.finance.v1.ScheduledPayment.RecurringExpenseInfo
+	125, // 131: saturn.finance.v1.CreateRecurringExpenseRequest.recurring_expense:type_name -> saturn.finance.v1.RecurringExpense
+	125, // 132: saturn.finance.v1.UpdateRecurringExpenseRequest.recurring_expense:type_name -> saturn.finance.v1.RecurringExpense
+	14,  // 133: saturn.finance.v1.ListRecurringExpensesRequest.status:type_name -> saturn.finance.v1.RecurringExpense.Status
+	12,  // 134: saturn.finance.v1.ListRecurringExpensesRequest.view:type_name -> saturn.finance.v1.RecurringExpense.View
+	125, // 135: saturn.finance.v1.ListRecurringExpensesResponse.recurring_expenses:type_name -> saturn.finance.v1.RecurringExpense
+	125, // 136: saturn.finance.v1.SubscriptionSuggestion.recurring_expense:type_name -> saturn.finance.v1.RecurringExpense
+	132, // 137: saturn.finance.v1.DetectSubscriptionsResponse.suggestions:type_name -> saturn.finance.v1.SubscriptionSuggestion
+	125, // 138: saturn.finance.v1.ConvertToRecurringExpenseRequest.recurring_expense:type_name -> saturn.finance.v1.RecurringExpense
+	17,  // 139: saturn.finance.v1.ListScheduledPaymentsRequest.status:type_name -> saturn.finance.v1.ScheduledPayment.Status
+	240, // 140: saturn.finance.v1.ListScheduledPaymentsRequest.start_date:type_name -> google.protobuf.Timestamp
+	240, // 141: saturn.finance.v1.ListScheduledPaymentsRequest.end_date:type_name -> google.protobuf.Timestamp
+	15,  // 142: saturn.finance.v1.ListScheduledPaymentsRequest.view:type_name -> saturn.finance.v1.ScheduledPayment.View
+	126, // 143: saturn.finance.v1.ListScheduledPaymentsResponse.scheduled_payments:type_name -> saturn.finance.v1.ScheduledPayment
+	240, // 144: saturn.finance.v1.ConfirmScheduledPaymentRequest.transaction_date:type_name -> google.protobuf.Timestamp
+	240, // 145: saturn.finance.v1.ConfirmScheduledPaymentRequest.effective_date:type_name -> google.protobuf.Timestamp
+	18,  // 146: saturn.finance.v1.Borrowing.direction:type_name -> saturn.finance.v1.Borrowing.Direction
+	19,  // 147: saturn.finance.v1.Borrowing.status:type_name -> saturn.finance.v1.Borrowing.Status
+	240, // 148: saturn.finance.v1.Borrowing.established_at:type_name -> google.protobuf.Timestamp
+	240, // 149: saturn.finance.v1.Borrowing.due_at:type_name -> google.protobuf.Timestamp
+	240, // 150: saturn.finance.v1.Borrowing.create_time:type_name -> google.protobuf.Timestamp
+	240, // 151: saturn.finance.v1.Borrowing.update_time:type_name -> google.protobuf.Timestamp
+	20,  // 152: saturn.finance.v1.Borrowing.interest_type:type_name -> saturn.finance.v1.Borrowing.InterestType
+	21,  // 153: saturn.finance.v1.Borrowing.installment_frequency:type_name -> saturn.finance.v1.Borrowing.InstallmentFrequency
+	240, // 154: saturn.finance.v1.Borrowing.first_installment_date:type_name -> google.protobuf.Timestamp
+	143, // 155: saturn.finance.v1.Borrowing.installments:type_name -> saturn.finance.v1.BorrowingInstallment
+	143, // 156: saturn.finance.v1.Borrowing.next_installment:type_name -> saturn.finance.v1.BorrowingInstallment
+	240, // 157: saturn.finance.v1.BorrowingInstallment.due_date:type_name -> google.protobuf.Timestamp
+	22,  // 158: saturn.finance.v1.BorrowingInstallment.status:type_name -> saturn.finance.v1.BorrowingInstallment.Status
+	240, // 159: saturn.finance.v1.BorrowingRepayment.payment_date:type_name -> google.protobuf.Timestamp
+	240, // 160: saturn.finance.v1.BorrowingRepayment.create_time:type_name -> google.protobuf.Timestamp
+	240, // 161: saturn.finance.v1.BorrowingRepayment.update_time:type_name -> google.protobuf.Timestamp
+	142, // 162: saturn.finance.v1.CreateBorrowingRequest.borrowing:type_name -> saturn.finance.v1.Borrowing
+	19,  // 163: saturn.finance.v1.ListBorrowingsRequest.status:type_name -> saturn.finance.v1.Borrowing.Status
+	18,  // 164: saturn.finance.v1.ListBorrowingsRequest.direction:type_name -> saturn.finance.v1.Borrowing.Direction
+	142, // 165: saturn.finance.v1.ListBorrowingsResponse.borrowings:type_name -> saturn.finance.v1.Borrowing
+	142, // 166: saturn.finance.v1.UpdateBorrowingRequest.borrowing:type_name -> saturn.finance.v1.Borrowing
+	144, // 167: saturn.finance.v1.CreateBorrowingRepaymentRequest.repayment:type_name -> saturn.finance.v1.BorrowingRepayment
+	144, // 168: saturn.finance.v1.ListBorrowingRepaymentsResponse.repayments:type_name -> saturn.finance.v1.BorrowingRepayment
+	155, // 169: saturn.finance.v1.ListCurrenciesResponse.currencies:type_name -> saturn.finance.v1.CurrencyInfo
+	23,  // 170: saturn.finance.v1.Account.type:type_name -> saturn.finance.v1.Account.Type
+	240, // 171: saturn.finance.v1.Account.create_time:type_name -> google.protobuf.Timestamp
+	240, // 172: saturn.finance.v1.Account.update_time:type_name -> google.protobuf.Timestamp
+	238, // 173: saturn.finance.v1.Account.conversion:type_name -> saturn.finance.v1.Account.Conversion
+	158, // 174: saturn.finance.v1.CreateAccountRequest.account:type_name -> saturn.finance.v1.Account
+	24,  // 175: saturn.finance.v1.GetAccountRequest.view:type_name -> saturn.finance.v1.Account.View
+	158, // 176: saturn.finance.v1.UpdateAccountRequest.account:type_name -> saturn.finance.v1.Account
+	240, // 177: saturn.finance.v1.Reconciliation.statement_date:type_name -> google.protobuf.Timestamp
+	25,  // 178: saturn.finance.v1.Reconciliation.status:type_name -> saturn.finance.v1.Reconciliation.Status
+	240, // 179: saturn.finance.v1.Reconciliation.finalize_time:type_name -> google.protobuf.Timestamp
+	240, // 180: saturn.finance.v1.Reconciliation.create_time:type_name -> google.protobuf.Timestamp
+	240, // 181: saturn.finance.v1.Reconciliation.update_time:type_name -> google.protobuf.Timestamp
+	163, // 182: saturn.finance.v1.StartReconciliationRequest.reconciliation:type_name -> saturn.finance.v1.Reconciliation
+	163, // 183: saturn.finance.v1.ListReconciliationsResponse.reconciliations:type_name -> saturn.finance.v1.Reconciliation
+	55,  // 184: saturn.finance.v1.ListReconciliationTransactionsResponse.transactions:type_name -> saturn.finance.v1.Transaction
+	24,  // 185: saturn.finance.v1.ListAccountsRequest.view:type_name -> saturn.finance.v1.Account.View
+	158, // 186: saturn.finance.v1.ListAccountsResponse.accounts:type_name -> saturn.finance.v1.Account
+	240, // 187: saturn.finance.v1.Transfer.transfer_date:type_name -> google.protobuf.Timestamp
+	240, // 188: saturn.finance.v1.Transfer.create_time:type_name -> google.protobuf.Timestamp
+	240, // 189: saturn.finance.v1.Transfer.update_time:type_name -> google.protobuf.Timestamp
+	240, // 190: saturn.finance.v1.CreateTransferRequest.transfer_date:type_name -> google.protobuf.Timestamp
+	176, // 191: saturn.finance.v1.ListTransfersResponse.transfers:type_name -> saturn.finance.v1.Transfer
+	240, // 192: saturn.finance.v1.TransactionEvent.create_time:type_name -> google.protobuf.Timestamp
+	181, // 193: saturn.finance.v1.ListTransactionEventsResponse.events:type_name -> saturn.finance.v1.TransactionEvent
+	26,  // 194: saturn.finance.v1.InboxItem.status:type_name -> saturn.finance.v1.InboxItem.Status
+	27,  // 195: saturn.finance.v1.InboxItem.doc_type:type_name -> saturn.finance.v1.InboxItem.DocType
+	240, // 196: saturn.finance.v1.InboxItem.transaction_date:type_name -> google.protobuf.Timestamp
+	239, // 197: saturn.finance.v1.InboxItem.metadata:type_name -> saturn.finance.v1.InboxItem.MetadataEntry
+	240, // 198: saturn.finance.v1.InboxItem.create_time:type_name -> google.protobuf.Timestamp
+	2,   // 199: saturn.finance.v1.InboxItem.borrowing_link_type:type_name -> saturn.finance.v1.BorrowingLinkType
+	56,  // 200: saturn.finance.v1.InboxItem.splits:type_name -> saturn.finance.v1.TransactionSplit
+	26,  // 201: saturn.finance.v1.ListInboxItemsRequest.status:type_name -> saturn.finance.v1.InboxItem.Status
+	27,  // 202: saturn.finance.v1.ListInboxItemsRequest.doc_type:type_name -> saturn.finance.v1.InboxItem.DocType
+	28,  // 203: saturn.finance.v1.ListInboxItemsRequest.view:type_name -> saturn.finance.v1.InboxItem.View
+	183, // 204: saturn.finance.v1.ListInboxItemsResponse.inbox_items:type_name -> saturn.finance.v1.InboxItem
+	183, // 205: saturn.finance.v1.UpdateInboxItemRequest.inbox_item:type_name -> saturn.finance.v1.InboxItem
+	240, // 206: saturn.finance.v1.StatementMapping.update_time:type_name -> google.protobuf.Timestamp
+	3,   // 207: saturn.finance.v1.ImportStatementRequest.format:type_name -> saturn.finance.v1.StatementFormat
+	189, // 208: saturn.finance.v1.ImportStatementRequest.csv_mapping:type_name -> saturn.finance.v1.StatementMapping
+	183, // 209: saturn.finance.v1.ImportStatementResponse.inbox_items:type_name -> saturn.finance.v1.InboxItem
+	4,   // 210: saturn.finance.v1.ExportLedgerRequest.format:type_name -> saturn.finance.v1.LedgerFormat
+	240, // 211: saturn.finance.v1.ExportLedgerRequest.start_date:type_name -> google.protobuf.Timestamp
+	240, // 212: saturn.finance.v1.ExportLedgerRequest.end_date:type_name -> google.protobuf.Timestamp
+	4,   // 213: saturn.finance.v1.LedgerExport.format:type_name -> saturn.finance.v1.LedgerFormat
+	240, // 214: saturn.finance.v1.LedgerExport.start_date:type_name -> google.protobuf.Timestamp
+	240, // 215: saturn.finance.v1.LedgerExport.end_date:type_name -> google.protobuf.Timestamp
+	29,  // 216: saturn.finance.v1.LedgerExport.status:type_name -> saturn.finance.v1.LedgerExport.Status
+	240, // 217: saturn.finance.v1.LedgerExport.create_time:type_name -> google.protobuf.Timestamp
+	240, // 218: saturn.finance.v1.LedgerExport.update_time:type_name -> google.protobuf.Timestamp
+	240, // 219: saturn.finance.v1.LedgerExport.complete_time:type_name -> google.protobuf.Timestamp
+	195, // 220: saturn.finance.v1.CreateLedgerExportRequest.ledger_export:type_name -> saturn.finance.v1.LedgerExport
+	195, // 221: saturn.finance.v1.ListLedgerExportsResponse.ledger_exports:type_name -> saturn.finance.v1.LedgerExport
+	240, // 222: saturn.finance.v1.CardStatement.period_start:type_name -> google.protobuf.Timestamp
+	240, // 223: saturn.finance.v1.CardStatement.closing_date:type_name -> google.protobuf.Timestamp
+	240, // 224: saturn.finance.v1.CardStatement.due_date:type_name -> google.protobuf.Timestamp
+	30,  // 225: saturn.finance.v1.CardStatement.status:type_name -> saturn.finance.v1.CardStatement.Status
+	240, // 226: saturn.finance.v1.CardStatement.create_time:type_name -> google.protobuf.Timestamp
+	240, // 227: saturn.finance.v1.CardStatement.update_time:type_name -> google.protobuf.Timestamp
+	201, // 228: saturn.finance.v1.ListCardStatementsResponse.card_statements:type_name -> saturn.finance.v1.CardStatement
+	240, // 229: saturn.finance.v1.ForecastCashFlowResponse.start_date:type_name -> google.protobuf.Timestamp
+	240, // 230: saturn.finance.v1.ForecastCashFlowResponse.end_date:type_name -> google.protobuf.Timestamp
+	207, // 231: saturn.finance.v1.ForecastCashFlowResponse.accounts:type_name -> saturn.finance.v1.AccountForecast
+	209, // 232: saturn.finance.v1.ForecastCashFlowResponse.events:type_name -> saturn.finance.v1.ForecastEvent
+	210, // 233: saturn.finance.v1.ForecastCashFlowResponse.alerts:type_name -> saturn.finance.v1.ForecastAlert
+	240, // 234: saturn.finance.v1.AccountForecast.lowest_date:type_name -> google.protobuf.Timestamp
+	208, // 235: saturn.finance.v1.AccountForecast.days:type_name -> saturn.finance.v1.ForecastDay
+	240, // 236: saturn.finance.v1.ForecastDay.date:type_name -> google.protobuf.Timestamp
+	240, // 237: saturn.finance.v1.ForecastEvent.date:type_name -> google.protobuf.Timestamp
+	31,  // 238: saturn.finance.v1.ForecastEvent.kind:type_name -> saturn.finance.v1.ForecastEvent.Kind
+	240, // 239: saturn.finance.v1.ForecastAlert.date:type_name -> google.protobuf.Timestamp
+	32,  // 240: saturn.finance.v1.ForecastAlert.kind:type_name -> saturn.finance.v1.ForecastAlert.Kind
+	33,  // 241: saturn.finance.v1.Alert.rule:type_name -> saturn.finance.v1.Alert.Rule
+	34,  // 242: saturn.finance.v1.Alert.status:type_name -> saturn.finance.v1.Alert.Status
+	240, // 243: saturn.finance.v1.Alert.snooze_until:type_name -> google.protobuf.Timestamp
+	240, // 244: saturn.finance.v1.Alert.acknowledge_time:type_name -> google.protobuf.Timestamp
+	240, // 245: saturn.finance.v1.Alert.create_time:type_name -> google.protobuf.Timestamp
+	240, // 246: saturn.finance.v1.Alert.update_time:type_name -> google.protobuf.Timestamp
+	33,  // 247: saturn.finance.v1.ListAlertsRequest.rule:type_name -> saturn.finance.v1.Alert.Rule
+	211, // 248: saturn.finance.v1.ListAlertsResponse.alerts:type_name -> saturn.finance.v1.Alert
+	240, // 249: saturn.finance.v1.SnoozeAlertRequest.snooze_until:type_name -> google.protobuf.Timestamp
+	211, // 250: saturn.finance.v1.AlertRaisedEvent.alert:type_name -> saturn.finance.v1.Alert
+	240, // 251: saturn.finance.v1.Budget.ActivePeriod.start_date:type_name -> google.protobuf.Timestamp
+	240, // 252: saturn.finance.v1.Budget.ActivePeriod.end_date:type_name -> google.protobuf.Timestamp
+	8,   // 253: saturn.finance.v1.GoalProgress.Contribution.type:type_name -> saturn.finance.v1.Transaction.Type
+	240, // 254: saturn.finance.v1.GoalProgress.Contribution.transaction_date:type_name -> google.protobuf.Timestamp
+	228, // 255: saturn.finance.v1.SpentInsights.TrendDataPoint.contributions:type_name -> saturn.finance.v1.SpentInsights.BudgetContribution
+	240, // 256: saturn.finance.v1.SpentInsights.HighValueExpense.transaction_date:type_name -> google.protobuf.Timestamp
+	240, // 257: saturn.finance.v1.SpentInsights.HighValueExpense.effective_date:type_name -> google.protobuf.Timestamp
+	240, // 258: saturn.finance.v1.RecurringExpense.ExecutionState.next_due_date:type_name -> google.protobuf.Timestamp
+	240, // 259: saturn.finance.v1.RecurringExpense.ExecutionState.last_payment_date:type_name -> google.protobuf.Timestamp
+	13,  // 260: saturn.finance.v1.ScheduledPayment.RecurringExpenseInfo.interval:type_name -> saturn.finance.v1.RecurringExpense.Interval
+	38,  // 261: saturn.finance.v1.Finance.ConfigureFinance:input_type -> saturn.finance.v1.ConfigureFinanceRequest
+	39,  // 262: saturn.finance.v1.Finance.GetFinanceSettings:input_type -> saturn.finance.v1.GetFinanceSettingsRequest
+	40,  // 263: saturn.finance.v1.Finance.UpdateFinanceSettings:input_type -> saturn.finance.v1.UpdateFinanceSettingsRequest
+	42,  // 264: saturn.finance.v1.Finance.CreateBudget:input_type -> saturn.finance.v1.CreateBudgetRequest
+	41,  // 265: saturn.finance.v1.Finance.GetBudget:input_type -> saturn.finance.v1.GetBudgetRequest
+	43,  // 266: saturn.finance.v1.Finance.UpdateBudget:input_type -> saturn.finance.v1.UpdateBudgetRequest
+	44,  // 267: saturn.finance.v1.Finance.DeleteBudget:input_type -> saturn.finance.v1.DeleteBudgetRequest
+	45,  // 268: saturn.finance.v1.Finance.ListBudgets:input_type -> saturn.finance.v1.ListBudgetsRequest
+	47,  // 269: saturn.finance.v1.Finance.GetBudgetPeriod:input_type -> saturn.finance.v1.GetBudgetPeriodRequest
+	49,  // 270: saturn.finance.v1.Finance.CreateExchangeRate:input_type -> saturn.finance.v1.CreateExchangeRateRequest
+	50,  // 271: saturn.finance.v1.Finance.GetExchangeRate:input_type -> saturn.finance.v1.GetExchangeRateRequest
+	51,  // 272: saturn.finance.v1.Finance.UpdateExchangeRate:input_type -> saturn.finance.v1.UpdateExchangeRateRequest
+	52,  // 273: saturn.finance.v1.Finance.ListExchangeRates:input_type -> saturn.finance.v1.ListExchangeRatesRequest
+	54,  // 274: saturn.finance.v1.Finance.DeleteExchangeRate:input_type -> saturn.finance.v1.DeleteExchangeRateRequest
+	58,  // 275: saturn.finance.v1.Finance.CreateExpense:input_type -> saturn.finance.v1.CreateExpenseRequest
+	59,  // 276: saturn.finance.v1.Finance.UpdateExpense:input_type -> saturn.finance.v1.UpdateExpenseRequest
+	61,  // 277: saturn.finance.v1.Finance.CreateIncome:input_type -> saturn.finance.v1.CreateIncomeRequest
+	62,  // 278: saturn.finance.v1.Finance.UpdateIncome:input_type -> saturn.finance.v1.UpdateIncomeRequest
+	64,  // 279: saturn.finance.v1.Finance.CreateIncomeSource:input_type -> saturn.finance.v1.CreateIncomeSourceRequest
+	65,  // 280: saturn.finance.v1.Finance.GetIncomeSource:input_type -> saturn.finance.v1.GetIncomeSourceRequest
+	66,  // 281: saturn.finance.v1.Finance.UpdateIncomeSource:input_type -> saturn.finance.v1.UpdateIncomeSourceRequest
+	67,  // 282: saturn.finance.v1.Finance.DeleteIncomeSource:input_type -> saturn.finance.v1.DeleteIncomeSourceRequest
+	68,  // 283: saturn.finance.v1.Finance.ListIncomeSources:input_type -> saturn.finance.v1.ListIncomeSourcesRequest
+	72,  // 284: saturn.finance.v1.Finance.CreateGoal:input_type -> saturn.finance.v1.CreateGoalRequest
+	73,  // 285: saturn.finance.v1.Finance.GetGoal:input_type -> saturn.finance.v1.GetGoalRequest
+	74,  // 286: saturn.finance.v1.Finance.UpdateGoal:input_type -> saturn.finance.v1.UpdateGoalRequest
+	75,  // 287: saturn.finance.v1.Finance.DeleteGoal:input_type -> saturn.finance.v1.DeleteGoalRequest
+	76,  // 288: saturn.finance.v1.Finance.ListGoals:input_type -> saturn.finance.v1.ListGoalsRequest
+	78,  // 289: saturn.finance.v1.Finance.GetGoalProgress:input_type -> saturn.finance.v1.GetGoalProgressRequest
+	80,  // 290: saturn.finance.v1.Finance.CreateCategory:input_type -> saturn.finance.v1.CreateCategoryRequest
+	81,  // 291: saturn.finance.v1.Finance.GetCategory:input_type -> saturn.finance.v1.GetCategoryRequest
+	82,  // 292: saturn.finance.v1.Finance.UpdateCategory:input_type -> saturn.finance.v1.UpdateCategoryRequest
+	83,  // 293: saturn.finance.v1.Finance.DeleteCategory:input_type -> saturn.finance.v1.DeleteCategoryRequest
+	84,  // 294: saturn.finance.v1.Finance.ListCategories:input_type -> saturn.finance.v1.ListCategoriesRequest
+	86,  // 295: saturn.finance.v1.Finance.DeleteTransaction:input_type -> saturn.finance.v1.DeleteTransactionRequest
+	88,  // 296: saturn.finance.v1.Finance.ListTransactions:input_type -> saturn.finance.v1.ListTransactionsRequest
+	90,  // 297: saturn.finance.v1.Finance.BatchUpdateTransactions:input_type -> saturn.finance.v1.BatchUpdateTransactionsRequest
+	94,  // 298: saturn.finance.v1.Finance.BatchDeleteTransactions:input_type -> saturn.finance.v1.BatchDeleteTransactionsRequest
+	97,  // 299: saturn.finance.v1.Finance.CreateSavedFilter:input_type -> saturn.finance.v1.CreateSavedFilterRequest
+	98,  // 300: saturn.finance.v1.Finance.GetSavedFilter:input_type -> saturn.finance.v1.GetSavedFilterRequest
+	99,  // 301: saturn.finance.v1.Finance.UpdateSavedFilter:input_type -> saturn.finance.v1.UpdateSavedFilterRequest
+	100, // 302: saturn.finance.v1.Finance.DeleteSavedFilter:input_type -> saturn.finance.v1.DeleteSavedFilterRequest
+	101, // 303: saturn.finance.v1.Finance.ListSavedFilters:input_type -> saturn.finance.v1.ListSavedFiltersRequest
+	104, // 304: saturn.finance.v1.Finance.CreatePayee:input_type -> saturn.finance.v1.CreatePayeeRequest
+	105, // 305: saturn.finance.v1.Finance.GetPayee:input_type -> saturn.finance.v1.GetPayeeRequest
+	106, // 306: saturn.finance.v1.Finance.UpdatePayee:input_type -> saturn.finance.v1.UpdatePayeeRequest
+	107, // 307: saturn.finance.v1.Finance.DeletePayee:input_type -> saturn.finance.v1.DeletePayeeRequest
+	108, // 308: saturn.finance.v1.Finance.ListPayees:input_type -> saturn.finance.v1.ListPayeesRequest
+	111, // 309: saturn.finance.v1.Finance.CreateTransactionRule:input_type -> saturn.finance.v1.CreateTransactionRuleRequest
+	112, // 310: saturn.finance.v1.Finance.GetTransactionRule:input_type -> saturn.finance.v1.GetTransactionRuleRequest
+	113, // 311: saturn.finance.v1.Finance.UpdateTransactionRule:input_type -> saturn.finance.v1.UpdateTransactionRuleRequest
+	114, // 312: saturn.finance.v1.Finance.DeleteTransactionRule:input_type -> saturn.finance.v1.DeleteTransactionRuleRequest
+	115, // 313: saturn.finance.v1.Finance.ListTransactionRules:input_type -> saturn.finance.v1.ListTransactionRulesRequest
+	117, // 314: saturn.finance.v1.Finance.ApplyTransactionRules:input_type -> saturn.finance.v1.ApplyTransactionRulesRequest
+	87,  // 315: saturn.finance.v1.Finance.GetTransaction:input_type -> saturn.finance.v1.GetTransactionRequest
+	180, // 316: saturn.finance.v1.Finance.ListTransactionEvents:input_type -> saturn.finance.v1.ListTransactionEventsRequest
+	119, // 317: saturn.finance.v1.Finance.GetInsights:input_type -> saturn.finance.v1.GetInsightsRequest
+	205, // 318: saturn.finance.v1.Finance.ForecastCashFlow:input_type -> saturn.finance.v1.ForecastCashFlowRequest
+	212, // 319: saturn.finance.v1.Finance.ListAlerts:input_type -> saturn.finance.v1.ListAlertsRequest
+	214, // 320: saturn.finance.v1.Finance.GetAlert:input_type -> saturn.finance.v1.GetAlertRequest
+	215, // 321: saturn.finance.v1.Finance.AcknowledgeAlert:input_type -> saturn.finance.v1.AcknowledgeAlertRequest
+	216, // 322: saturn.finance.v1.Finance.SnoozeAlert:input_type -> saturn.finance.v1.SnoozeAlertRequest
+	127, // 323: saturn.finance.v1.Finance.CreateRecurringExpense:input_type -> saturn.finance.v1.CreateRecurringExpenseRequest
+	128, // 324: saturn.finance.v1.Finance.UpdateRecurringExpense:input_type -> saturn.finance.v1.UpdateRecurringExpenseRequest
+	129, // 325: saturn.finance.v1.Finance.DeleteRecurringExpense:input_type -> saturn.finance.v1.DeleteRecurringExpenseRequest
+	130, // 326: saturn.finance.v1.Finance.ListRecurringExpenses:input_type -> saturn.finance.v1.ListRecurringExpensesRequest
+	133, // 327: saturn.finance.v1.Finance.DetectSubscriptions:input_type -> saturn.finance.v1.DetectSubscriptionsRequest
+	135, // 328: saturn.finance.v1.Finance.ConvertToRecurringExpense:input_type -> saturn.finance.v1.ConvertToRecurringExpenseRequest
+	136, // 329: saturn.finance.v1.Finance.ListScheduledPayments:input_type -> saturn.finance.v1.ListScheduledPaymentsRequest
+	138, // 330: saturn.finance.v1.Finance.GetScheduledPayment:input_type -> saturn.finance.v1.GetScheduledPaymentRequest
+	139, // 331: saturn.finance.v1.Finance.ConfirmScheduledPayment:input_type -> saturn.finance.v1.ConfirmScheduledPaymentRequest
+	140, // 332: saturn.finance.v1.Finance.MatchScheduledPayment:input_type -> saturn.finance.v1.MatchScheduledPaymentRequest
+	141, // 333: saturn.finance.v1.Finance.SkipScheduledPayment:input_type -> saturn.finance.v1.SkipScheduledPaymentRequest
+	145, // 334: saturn.finance.v1.Finance.CreateBorrowing:input_type -> saturn.finance.v1.CreateBorrowingRequest
+	146, // 335: saturn.finance.v1.Finance.GetBorrowing:input_type -> saturn.finance.v1.GetBorrowingRequest
+	147, // 336: saturn.finance.v1.Finance.ListBorrowings:input_type -> saturn.finance.v1.ListBorrowingsRequest
+	149, // 337: saturn.finance.v1.Finance.UpdateBorrowing:input_type -> saturn.finance.v1.UpdateBorrowingRequest
+	150, // 338: saturn.finance.v1.Finance.DeleteBorrowing:input_type -> saturn.finance.v1.DeleteBorrowingRequest
+	151, // 339: saturn.finance.v1.Finance.CreateBorrowingRepayment:input_type -> saturn.finance.v1.CreateBorrowingRepaymentRequest
+	152, // 340: saturn.finance.v1.Finance.ListBorrowingRepayments:input_type -> saturn.finance.v1.ListBorrowingRepaymentsRequest
+	154, // 341: saturn.finance.v1.Finance.DeleteBorrowingRepayment:input_type -> saturn.finance.v1.DeleteBorrowingRepaymentRequest
+	159, // 342: saturn.finance.v1.Finance.CreateAccount:input_type -> saturn.finance.v1.CreateAccountRequest
+	160, // 343: saturn.finance.v1.Finance.GetAccount:input_type -> saturn.finance.v1.GetAccountRequest
+	161, // 344: saturn.finance.v1.Finance.UpdateAccount:input_type -> saturn.finance.v1.UpdateAccountRequest
+	162, // 345: saturn.finance.v1.Finance.AdjustAccountBalance:input_type -> saturn.finance.v1.AdjustAccountBalanceRequest
+	202, // 346: saturn.finance.v1.Finance.ListCardStatements:input_type -> saturn.finance.v1.ListCardStatementsRequest
+	204, // 347: saturn.finance.v1.Finance.GetCardStatement:input_type -> saturn.finance.v1.GetCardStatementRequest
+	164, // 348: saturn.finance.v1.Finance.StartReconciliation:input_type -> saturn.finance.v1.StartReconciliationRequest
+	165, // 349: saturn.finance.v1.Finance.GetReconciliation:input_type -> saturn.finance.v1.GetReconciliationRequest
+	166, // 350: saturn.finance.v1.Finance.ListReconciliations:input_type -> saturn.finance.v1.ListReconciliationsRequest
+	168, // 351: saturn.finance.v1.Finance.ListReconciliationTransactions:input_type -> saturn.finance.v1.ListReconciliationTransactionsRequest
+	170, // 352: saturn.finance.v1.Finance.SetTransactionsCleared:input_type -> saturn.finance.v1.SetTransactionsClearedRequest
+	171, // 353: saturn.finance.v1.Finance.FinalizeReconciliation:input_type -> saturn.finance.v1.FinalizeReconciliationRequest
+	172, // 354: saturn.finance.v1.Finance.CancelReconciliation:input_type -> saturn.finance.v1.CancelReconciliationRequest
+	173, // 355: saturn.finance.v1.Finance.DeleteAccount:input_type -> saturn.finance.v1.DeleteAccountRequest
+	174, // 356: saturn.finance.v1.Finance.ListAccounts:input_type -> saturn.finance.v1.ListAccountsRequest
+	177, // 357: saturn.finance.v1.Finance.CreateTransfer:input_type -> saturn.finance.v1.CreateTransferRequest
+	178, // 358: saturn.finance.v1.Finance.ListTransfers:input_type -> saturn.finance.v1.ListTransfersRequest
+	156, // 359: saturn.finance.v1.Finance.ListCurrencies:input_type -> saturn.finance.v1.ListCurrenciesRequest
+	184, // 360: saturn.finance.v1.Finance.ListInboxItems:input_type -> saturn.finance.v1.ListInboxItemsRequest
+	186, // 361: saturn.finance.v1.Finance.UpdateInboxItem:input_type -> saturn.finance.v1.UpdateInboxItemRequest
+	187, // 362: saturn.finance.v1.Finance.ApproveInboxItem:input_type -> saturn.finance.v1.ApproveInboxItemRequest
+	188, // 363: saturn.finance.v1.Finance.DiscardInboxItem:input_type -> saturn.finance.v1.DiscardInboxItemRequest
+	190, // 364: saturn.finance.v1.Finance.ImportStatement:input_type -> saturn.finance.v1.ImportStatementRequest
+	192, // 365: saturn.finance.v1.Finance.GetStatementMapping:input_type -> saturn.finance.v1.GetStatementMappingRequest
+	193, // 366: saturn.finance.v1.Finance.ExportLedger:input_type -> saturn.finance.v1.ExportLedgerRequest
+	196, // 367: saturn.finance.v1.Finance.CreateLedgerExport:input_type -> saturn.finance.v1.CreateLedgerExportRequest
+	197, // 368: saturn.finance.v1.Finance.GetLedgerExport:input_type -> saturn.finance.v1.GetLedgerExportRequest
+	198, // 369: saturn.finance.v1.Finance.ListLedgerExports:input_type -> saturn.finance.v1.ListLedgerExportsRequest
+	200, // 370: saturn.finance.v1.Finance.DownloadLedgerExport:input_type -> saturn.finance.v1.DownloadLedgerExportRequest
+	35,  // 371: saturn.finance.v1.Finance.ConfigureFinance:output_type -> saturn.finance.v1.FinanceSettings
+	35,  // 372: saturn.finance.v1.Finance.GetFinanceSettings:output_type -> saturn.finance.v1.FinanceSettings
+	35,  // 373: saturn.finance.v1.Finance.UpdateFinanceSettings:output_type -> saturn.finance.v1.FinanceSettings
+	36,  // 374: saturn.finance.v1.Finance.CreateBudget:output_type -> saturn.finance.v1.Budget
+	36,  // 375: saturn.finance.v1.Finance.GetBudget:output_type -> saturn.finance.v1.Budget
+	36,  // 376: saturn.finance.v1.Finance.UpdateBudget:output_type -> saturn.finance.v1.Budget
+	242, // 377: saturn.finance.v1.Finance.DeleteBudget:output_type -> google.protobuf.Empty
+	46,  // 378: saturn.finance.v1.Finance.ListBudgets:output_type -> saturn.finance.v1.ListBudgetsResponse
+	37,  // 379: saturn.finance.v1.Finance.GetBudgetPeriod:output_type -> saturn.finance.v1.BudgetPeriod
+	48,  // 380: saturn.finance.v1.Finance.CreateExchangeRate:output_type -> saturn.finance.v1.ExchangeRate
+	48,  // 381: saturn.finance.v1.Finance.GetExchangeRate:output_type -> saturn.finance.v1.ExchangeRate
+	48,  // 382: saturn.finance.v1.Finance.UpdateExchangeRate:output_type -> saturn.finance.v1.ExchangeRate
+	53,  // 383: saturn.finance.v1.Finance.ListExchangeRates:output_type -> saturn.finance.v1.ListExchangeRatesResponse
+	242, // 384: saturn.finance.v1.Finance.DeleteExchangeRate:output_type -> google.protobuf.Empty
+	55,  // 385: saturn.finance.v1.Finance.CreateExpense:output_type -> saturn.finance.v1.Transaction
+	55,  // 386: saturn.finance.v1.Finance.UpdateExpense:output_type -> saturn.finance.v1.Transaction
+	55,  // 387: saturn.finance.v1.Finance.CreateIncome:output_type -> saturn.finance.v1.Transaction
+	55,  // 388: saturn.finance.v1.Finance.UpdateIncome:output_type -> saturn.finance.v1.Transaction
+	63,  // 389: saturn.finance.v1.Finance.CreateIncomeSource:output_type -> saturn.finance.v1.IncomeSource
+	63,  // 390: saturn.finance.v1.Finance.GetIncomeSource:output_type -> saturn.finance.v1.IncomeSource
+	63,  // 391: saturn.finance.v1.Finance.UpdateIncomeSource:output_type -> saturn.finance.v1.IncomeSource
+	242, // 392: saturn.finance.v1.Finance.DeleteIncomeSource:output_type -> google.protobuf.Empty
+	69,  // 393: saturn.finance.v1.Finance.ListIncomeSources:output_type -> saturn.finance.v1.ListIncomeSourcesResponse
+	70,  // 394: saturn.finance.v1.Finance.CreateGoal:output_type -> saturn.finance.v1.Goal
+	70,  // 395: saturn.finance.v1.Finance.GetGoal:output_type -> saturn.finance.v1.Goal
+	70,  // 396: saturn.finance.v1.Finance.UpdateGoal:output_type -> saturn.finance.v1.Goal
+	242, // 397: saturn.finance.v1.Finance.DeleteGoal:output_type -> google.protobuf.Empty
+	77,  // 398: saturn.finance.v1.Finance.ListGoals:output_type -> saturn.finance.v1.ListGoalsResponse
+	71,  // 399: saturn.finance.v1.Finance.GetGoalProgress:output_type -> saturn.finance.v1.GoalProgress
+	79,  // 400: saturn.finance.v1.Finance.CreateCategory:output_type -> saturn.finance.v1.Category
+	79,  // 401: saturn.finance.v1.Finance.GetCategory:output_type -> saturn.finance.v1.Category
+	79,  // 402: saturn.finance.v1.Finance.UpdateCategory:output_type -> saturn.finance.v1.Category
+	242, // 403: saturn.finance.v1.Finance.DeleteCategory:output_type -> google.protobuf.Empty
+	85,  // 404: saturn.finance.v1.Finance.ListCategories:output_type -> saturn.finance.v1.ListCategoriesResponse
+	242, // 405: saturn.finance.v1.Finance.DeleteTransaction:output_type -> google.protobuf.Empty
+	89,  // 406: saturn.finance.v1.Finance.ListTransactions:output_type -> saturn.finance.v1.ListTransactionsResponse
+	93,  // 407: saturn.finance.v1.Finance.BatchUpdateTransactions:output_type -> saturn.finance.v1.BatchUpdateTransactionsResponse
+	95,  // 408: saturn.finance.v1.Finance.BatchDeleteTransactions:output_type -> saturn.finance.v1.BatchDeleteTransactionsResponse
+	96,  // 409: saturn.finance.v1.Finance.CreateSavedFilter:output_type -> saturn.finance.v1.SavedFilter
+	96,  // 410: saturn.finance.v1.Finance.GetSavedFilter:output_type -> saturn.finance.v1.SavedFilter
+	96,  // 411: saturn.finance.v1.Finance.UpdateSavedFilter:output_type -> saturn.finance.v1.SavedFilter
+	242, // 412: saturn.finance.v1.Finance.DeleteSavedFilter:output_type -> google.protobuf.Empty
+	102, // 413: saturn.finance.v1.Finance.ListSavedFilters:output_type -> saturn.finance.v1.ListSavedFiltersResponse
+	103, // 414: saturn.finance.v1.Finance.CreatePayee:output_type -> saturn.finance.v1.Payee
+	103, // 415: saturn.finance.v1.Finance.GetPayee:output_type -> saturn.finance.v1.Payee
+	103, // 416: saturn.finance.v1.Finance.UpdatePayee:output_type -> saturn.finance.v1.Payee
+	242, // 417: saturn.finance.v1.Finance.DeletePayee:output_type -> google.protobuf.Empty
+	109, // 418: saturn.finance.v1.Finance.ListPayees:output_type -> saturn.finance.v1.ListPayeesResponse
+	110, // 419: saturn.finance.v1.Finance.CreateTransactionRule:output_type -> saturn.finance.v1.TransactionRule
+	110, // 420: saturn.finance.v1.Finance.GetTransactionRule:output_type -> saturn.finance.v1.TransactionRule
+	110, // 421: saturn.finance.v1.Finance.UpdateTransactionRule:output_type -> saturn.finance.v1.TransactionRule
+	242, // 422: saturn.finance.v1.Finance.DeleteTransactionRule:output_type -> google.protobuf.Empty
+	116, // 423: saturn.finance.v1.Finance.ListTransactionRules:output_type -> saturn.finance.v1.ListTransactionRulesResponse
+	118, // 424: saturn.finance.v1.Finance.ApplyTransactionRules:output_type -> saturn.finance.v1.ApplyTransactionRulesResponse
+	55,  // 425: saturn.finance.v1.Finance.GetTransaction:output_type -> saturn.finance.v1.Transaction
+	182, // 426: saturn.finance.v1.Finance.ListTransactionEvents:output_type -> saturn.finance.v1.ListTransactionEventsResponse
+	120, // 427: saturn.finance.v1.Finance.GetInsights:output_type -> saturn.finance.v1.GetInsightsResponse
+	206, // 428: saturn.finance.v1.Finance.ForecastCashFlow:output_type -> saturn.finance.v1.ForecastCashFlowResponse
+	213, // 429: saturn.finance.v1.Finance.ListAlerts:output_type -> saturn.finance.v1.ListAlertsResponse
+	211, // 430: saturn.finance.v1.Finance.GetAlert:output_type -> saturn.finance.v1.Alert
+	211, // 431: saturn.finance.v1.Finance.AcknowledgeAlert:output_type -> saturn.finance.v1.Alert
+	211, // 432: saturn.finance.v1.Finance.SnoozeAlert:output_type -> saturn.finance.v1.Alert
+	125, // 433: saturn.finance.v1.Finance.CreateRecurringExpense:output_type -> saturn.finance.v1.RecurringExpense
+	125, // 434: saturn.finance.v1.Finance.UpdateRecurringExpense:output_type -> saturn.finance.v1.RecurringExpense
+	242, // 435: saturn.finance.v1.Finance.DeleteRecurringExpense:output_type -> google.protobuf.Empty
+	131, // 436: saturn.finance.v1.Finance.ListRecurringExpenses:output_type -> saturn.finance.v1.ListRecurringExpensesResponse
+	134, // 437: saturn.finance.v1.Finance.DetectSubscriptions:output_type -> saturn.finance.v1.DetectSubscriptionsResponse
+	125, // 438: saturn.finance.v1.Finance.ConvertToRecurringExpense:output_type -> saturn.finance.v1.RecurringExpense
+	137, // 439: saturn.finance.v1.Finance.ListScheduledPayments:output_type -> saturn.finance.v1.ListScheduledPaymentsResponse
+	126, // 440: saturn.finance.v1.Finance.GetScheduledPayment:output_type -> saturn.finance.v1.ScheduledPayment
+	55,  // 441: saturn.finance.v1.Finance.ConfirmScheduledPayment:output_type -> saturn.finance.v1.Transaction
+	55,  // 442: saturn.finance.v1.Finance.MatchScheduledPayment:output_type -> saturn.finance.v1.Transaction
+	126, // 443: saturn.finance.v1.Finance.SkipScheduledPayment:output_type -> saturn.finance.v1.ScheduledPayment
+	142, // 444: saturn.finance.v1.Finance.CreateBorrowing:output_type -> saturn.finance.v1.Borrowing
+	142, // 445: saturn.finance.v1.Finance.GetBorrowing:output_type -> saturn.finance.v1.Borrowing
+	148, // 446: saturn.finance.v1.Finance.ListBorrowings:output_type -> saturn.finance.v1.ListBorrowingsResponse
+	142, // 447: saturn.finance.v1.Finance.UpdateBorrowing:output_type -> saturn.finance.v1.Borrowing
+	242, // 448: saturn.finance.v1.Finance.DeleteBorrowing:output_type -> google.protobuf.Empty
+	144, // 449: saturn.finance.v1.Finance.CreateBorrowingRepayment:output_type -> saturn.finance.v1.BorrowingRepayment
+	153, // 450: saturn.finance.v1.Finance.ListBorrowingRepayments:output_type -> saturn.finance.v1.ListBorrowingRepaymentsResponse
+	242, // 451: saturn.finance.v1.Finance.DeleteBorrowingRepayment:output_type -> google.protobuf.Empty
+	158, // 452: saturn.finance.v1.Finance.CreateAccount:output_type -> saturn.finance.v1.Account
+	158, // 453: saturn.finance.v1.Finance.GetAccount:output_type -> saturn.finance.v1.Account
+	158, // 454: saturn.finance.v1.Finance.UpdateAccount:output_type -> saturn.finance.v1.Account
+	158, // 455: saturn.finance.v1.Finance.AdjustAccountBalance:output_type -> saturn.finance.v1.Account
+	203, // 456: saturn.finance.v1.Finance.ListCardStatements:output_type -> saturn.finance.v1.ListCardStatementsResponse
+	201, // 457: saturn.finance.v1.Finance.GetCardStatement:output_type -> saturn.finance.v1.CardStatement
+	163, // 458: saturn.finance.v1.Finance.StartReconciliation:output_type -> saturn.finance.v1.Reconciliation
+	163, // 459: saturn.finance.v1.Finance.GetReconciliation:output_type -> saturn.finance.v1.Reconciliation
+	167, // 460: saturn.finance.v1.Finance.ListReconciliations:output_type -> saturn.finance.v1.ListReconciliationsResponse
+	169, // 461: saturn.finance.v1.Finance.ListReconciliationTransactions:output_type -> saturn.finance.v1.ListReconciliationTransactionsResponse
+	163, // 462: saturn.finance.v1.Finance.SetTransactionsCleared:output_type -> saturn.finance.v1.Reconciliation
+	163, // 463: saturn.finance.v1.Finance.FinalizeReconciliation:output_type -> saturn.finance.v1.Reconciliation
+	242, // 464: saturn.finance.v1.Finance.CancelReconciliation:output_type -> google.protobuf.Empty
+	242, // 465: saturn.finance.v1.Finance.DeleteAccount:output_type -> google.protobuf.Empty
+	175, // 466: saturn.finance.v1.Finance.ListAccounts:output_type -> saturn.finance.v1.ListAccountsResponse
+	176, // 467: saturn.finance.v1.Finance.CreateTransfer:output_type -> saturn.finance.v1.Transfer
+	179, // 468: saturn.finance.v1.Finance.ListTransfers:output_type -> saturn.finance.v1.ListTransfersResponse
+	157, // 469: saturn.finance.v1.Finance.ListCurrencies:output_type -> saturn.finance.v1.ListCurrenciesResponse
+	185, // 470: saturn.finance.v1.Finance.ListInboxItems:output_type -> saturn.finance.v1.ListInboxItemsResponse
+	183, // 471: saturn.finance.v1.Finance.UpdateInboxItem:output_type -> saturn.finance.v1.InboxItem
+	183, // 472: saturn.finance.v1.Finance.ApproveInboxItem:output_type -> saturn.finance.v1.InboxItem
+	242, // 473: saturn.finance.v1.Finance.DiscardInboxItem:output_type -> google.protobuf.Empty
+	191, // 474: saturn.finance.v1.Finance.ImportStatement:output_type -> saturn.finance.v1.ImportStatementResponse
+	189, // 475: saturn.finance.v1.Finance.GetStatementMapping:output_type -> saturn.finance.v1.StatementMapping
+	194, // 476: saturn.finance.v1.Finance.ExportLedger:output_type -> saturn.finance.v1.LedgerExportChunk
+	195, // 477: saturn.finance.v1.Finance.CreateLedgerExport:output_type -> saturn.finance.v1.LedgerExport
+	195, // 478: saturn.finance.v1.Finance.GetLedgerExport:output_type -> saturn.finance.v1.LedgerExport
+	199, // 479: saturn.finance.v1.Finance.ListLedgerExports:output_type -> saturn.finance.v1.ListLedgerExportsResponse
+	194, // 480: saturn.finance.v1.Finance.DownloadLedgerExport:output_type -> saturn.finance.v1.LedgerExportChunk
+	371, // [371:481] is the sub-list for method output_type
+	261, // [261:371] is the sub-list for method input_type
+	261, // [261:261] is the sub-list for extension type_name
+	261, // [261:261] is the sub-list for extension extendee
+	0,   // [0:261] is the sub-list for field type_name
 }
 
 func init() { file_saturn_finance_v1_finance_proto_init() }
@@ -9291,33 +20200,55 @@ func file_saturn_finance_v1_finance_proto_init() {
 		return
 	}
 	file_saturn_finance_v1_finance_proto_msgTypes[1].OneofWrappers = []any{}
-	file_saturn_finance_v1_finance_proto_msgTypes[7].OneofWrappers = []any{}
+	file_saturn_finance_v1_finance_proto_msgTypes[5].OneofWrappers = []any{}
 	file_saturn_finance_v1_finance_proto_msgTypes[8].OneofWrappers = []any{}
 	file_saturn_finance_v1_finance_proto_msgTypes[9].OneofWrappers = []any{}
-	file_saturn_finance_v1_finance_proto_msgTypes[16].OneofWrappers = []any{}
-	file_saturn_finance_v1_finance_proto_msgTypes[19].OneofWrappers = []any{}
+	file_saturn_finance_v1_finance_proto_msgTypes[10].OneofWrappers = []any{}
+	file_saturn_finance_v1_finance_proto_msgTypes[17].OneofWrappers = []any{}
 	file_saturn_finance_v1_finance_proto_msgTypes[20].OneofWrappers = []any{}
-	file_saturn_finance_v1_finance_proto_msgTypes[24].OneofWrappers = []any{}
+	file_saturn_finance_v1_finance_proto_msgTypes[21].OneofWrappers = []any{}
+	file_saturn_finance_v1_finance_proto_msgTypes[22].OneofWrappers = []any{}
 	file_saturn_finance_v1_finance_proto_msgTypes[25].OneofWrappers = []any{}
-	file_saturn_finance_v1_finance_proto_msgTypes[31].OneofWrappers = []any{}
-	file_saturn_finance_v1_finance_proto_msgTypes[32].OneofWrappers = []any{}
-	file_saturn_finance_v1_finance_proto_msgTypes[36].OneofWrappers = []any{}
-	file_saturn_finance_v1_finance_proto_msgTypes[38].OneofWrappers = []any{}
+	file_saturn_finance_v1_finance_proto_msgTypes[28].OneofWrappers = []any{}
+	file_saturn_finance_v1_finance_proto_msgTypes[33].OneofWrappers = []any{}
+	file_saturn_finance_v1_finance_proto_msgTypes[35].OneofWrappers = []any{}
 	file_saturn_finance_v1_finance_proto_msgTypes[41].OneofWrappers = []any{}
 	file_saturn_finance_v1_finance_proto_msgTypes[44].OneofWrappers = []any{}
-	file_saturn_finance_v1_finance_proto_msgTypes[48].OneofWrappers = []any{}
-	file_saturn_finance_v1_finance_proto_msgTypes[61].OneofWrappers = []any{}
-	file_saturn_finance_v1_finance_proto_msgTypes[63].OneofWrappers = []any{}
-	file_saturn_finance_v1_finance_proto_msgTypes[65].OneofWrappers = []any{}
-	file_saturn_finance_v1_finance_proto_msgTypes[74].OneofWrappers = []any{}
+	file_saturn_finance_v1_finance_proto_msgTypes[49].OneofWrappers = []any{}
+	file_saturn_finance_v1_finance_proto_msgTypes[52].OneofWrappers = []any{}
+	file_saturn_finance_v1_finance_proto_msgTypes[53].OneofWrappers = []any{}
+	file_saturn_finance_v1_finance_proto_msgTypes[55].OneofWrappers = []any{}
+	file_saturn_finance_v1_finance_proto_msgTypes[59].OneofWrappers = []any{}
+	file_saturn_finance_v1_finance_proto_msgTypes[64].OneofWrappers = []any{}
+	file_saturn_finance_v1_finance_proto_msgTypes[66].OneofWrappers = []any{}
+	file_saturn_finance_v1_finance_proto_msgTypes[68].OneofWrappers = []any{}
+	file_saturn_finance_v1_finance_proto_msgTypes[71].OneofWrappers = []any{}
+	file_saturn_finance_v1_finance_proto_msgTypes[73].OneofWrappers = []any{}
 	file_saturn_finance_v1_finance_proto_msgTypes[75].OneofWrappers = []any{}
+	file_saturn_finance_v1_finance_proto_msgTypes[78].OneofWrappers = []any{}
+	file_saturn_finance_v1_finance_proto_msgTypes[80].OneofWrappers = []any{}
+	file_saturn_finance_v1_finance_proto_msgTypes[90].OneofWrappers = []any{}
+	file_saturn_finance_v1_finance_proto_msgTypes[91].OneofWrappers = []any{}
+	file_saturn_finance_v1_finance_proto_msgTypes[95].OneofWrappers = []any{}
+	file_saturn_finance_v1_finance_proto_msgTypes[101].OneofWrappers = []any{}
+	file_saturn_finance_v1_finance_proto_msgTypes[104].OneofWrappers = []any{}
+	file_saturn_finance_v1_finance_proto_msgTypes[107].OneofWrappers = []any{}
+	file_saturn_finance_v1_finance_proto_msgTypes[112].OneofWrappers = []any{}
+	file_saturn_finance_v1_finance_proto_msgTypes[125].OneofWrappers = []any{}
+	file_saturn_finance_v1_finance_proto_msgTypes[127].OneofWrappers = []any{}
+	file_saturn_finance_v1_finance_proto_msgTypes[128].OneofWrappers = []any{}
+	file_saturn_finance_v1_finance_proto_msgTypes[139].OneofWrappers = []any{}
+	file_saturn_finance_v1_finance_proto_msgTypes[148].OneofWrappers = []any{}
+	file_saturn_finance_v1_finance_proto_msgTypes[149].OneofWrappers = []any{}
+	file_saturn_finance_v1_finance_proto_msgTypes[176].OneofWrappers = []any{}
+	file_saturn_finance_v1_finance_proto_msgTypes[177].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_saturn_finance_v1_finance_proto_rawDesc), len(file_saturn_finance_v1_finance_proto_rawDesc)),
-			NumEnums:      20,
-			NumMessages:   94,
+			NumEnums:      35,
+			NumMessages:   205,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
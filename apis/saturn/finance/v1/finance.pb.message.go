@@ -0,0 +1,63 @@
+// Code generated by protoc-gen-go-message. DO NOT EDIT.
+// Source: finance.proto
+
+package financev1
+
+import (
+	"context"
+
+	"github.com/masterkeysrd/saturn/internal/platform/eventbus"
+	"google.golang.org/protobuf/proto"
+)
+
+// TopicTransactionChangedEvent is the eventbus topic for TransactionChangedEvent.
+const TopicTransactionChangedEvent = "finance.transaction.changed"
+
+// TransactionChangedEventHandler is the strongly-typed callback for the 'finance.transaction.changed' topic.
+type TransactionChangedEventHandler func(ctx context.Context, payload *TransactionChangedEvent) error
+
+// SubscribeTransactionChangedEvent binds a handler callback to the eventbus for 'finance.transaction.changed'.
+func SubscribeTransactionChangedEvent(engine *eventbus.Engine, subscriberID string, handler TransactionChangedEventHandler) {
+	engine.Subscribe("finance.transaction.changed", subscriberID, func(ctx context.Context, msg eventbus.Message) error {
+		var payload TransactionChangedEvent
+		if err := proto.Unmarshal(msg.Payload, &payload); err != nil {
+			return err
+		}
+		return handler(ctx, &payload)
+	})
+}
+
+// PublishTransactionChangedEvent serializes and broadcasts the TransactionChangedEvent message to the eventbus.
+func PublishTransactionChangedEvent(ctx context.Context, engine *eventbus.Engine, msg *TransactionChangedEvent) error {
+	payloadBytes, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	return engine.Publish(ctx, "finance.transaction.changed", payloadBytes)
+}
+
+// TopicAlertRaisedEvent is the eventbus topic for AlertRaisedEvent.
+const TopicAlertRaisedEvent = "finance.alert.raised"
+
+// AlertRaisedEventHandler is the strongly-typed callback for the 'finance.alert.raised' topic.
+type AlertRaisedEventHandler func(ctx context.Context, payload *AlertRaisedEvent) error
+
+// SubscribeAlertRaisedEvent binds a handler callback to the eventbus for 'finance.alert.raised'.
+func SubscribeAlertRaisedEvent(engine *eventbus.Engine, subscriberID string, handler AlertRaisedEventHandler) {
+	engine.Subscribe("finance.alert.raised", subscriberID, func(ctx context.Context, msg eventbus.Message) error {
+		var payload AlertRaisedEvent
+		if err := proto.Unmarshal(msg.Payload, &payload); err != nil {
+			return err
+		}
+		return handler(ctx, &payload)
+	})
+}
+
+// PublishAlertRaisedEvent serializes and broadcasts the AlertRaisedEvent message to the eventbus.
+func PublishAlertRaisedEvent(ctx context.Context, engine *eventbus.Engine, msg *AlertRaisedEvent) error {
+	payloadBytes, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	return engine.Publish(ctx, "finance.alert.raised", payloadBytes)
+}
@@ -41,3 +41,96 @@ func EnqueueGenerateScheduledPaymentsPayload(ctx context.Context, sched schedule
 		MaxAttempts: job.MaxAttempts,
 	})
 }
+
+// SyncExchangeRatesPayloadHandler is the strongly-typed callback signature for the 'finance.SyncExchangeRates' job.
+type SyncExchangeRatesPayloadHandler func(ctx context.Context, payload *SyncExchangeRatesPayload) error
+
+// RegisterSyncExchangeRatesPayload binds the handler callback to the scheduler engine.
+func RegisterSyncExchangeRatesPayload(engine *scheduler.Engine, handler SyncExchangeRatesPayloadHandler) {
+	engine.Register("finance.SyncExchangeRates", func(ctx context.Context, payloadBytes []byte) error {
+		var payload SyncExchangeRatesPayload
+		if err := json.Unmarshal(payloadBytes, &payload); err != nil {
+			return err
+		}
+		return handler(ctx, &payload)
+	})
+}
+
+// SyncExchangeRatesPayloadJob represents the enqueue request options for 'finance.SyncExchangeRates'.
+type SyncExchangeRatesPayloadJob struct {
+	Payload     *SyncExchangeRatesPayload
+	RunAt       time.Time
+	MaxAttempts int
+}
+
+// EnqueueSyncExchangeRatesPayload puts the job on the queue with compile-time type safety.
+func EnqueueSyncExchangeRatesPayload(ctx context.Context, sched scheduler.Scheduler, job SyncExchangeRatesPayloadJob) error {
+	return sched.Enqueue(ctx, scheduler.Job{
+		JobType:     "finance.SyncExchangeRates",
+		RunAt:       job.RunAt,
+		Payload:     job.Payload,
+		MaxAttempts: job.MaxAttempts,
+	})
+}
+
+// CloseCardStatementsPayloadHandler is the strongly-typed callback signature for the 'finance.CloseCardStatements' job.
+type CloseCardStatementsPayloadHandler func(ctx context.Context, payload *CloseCardStatementsPayload) error
+
+// RegisterCloseCardStatementsPayload binds the handler callback to the scheduler engine.
+func RegisterCloseCardStatementsPayload(engine *scheduler.Engine, handler CloseCardStatementsPayloadHandler) {
+	engine.Register("finance.CloseCardStatements", func(ctx context.Context, payloadBytes []byte) error {
+		var payload CloseCardStatementsPayload
+		if err := json.Unmarshal(payloadBytes, &payload); err != nil {
+			return err
+		}
+		return handler(ctx, &payload)
+	})
+}
+
+// CloseCardStatementsPayloadJob represents the enqueue request options for 'finance.CloseCardStatements'.
+type CloseCardStatementsPayloadJob struct {
+	Payload     *CloseCardStatementsPayload
+	RunAt       time.Time
+	MaxAttempts int
+}
+
+// EnqueueCloseCardStatementsPayload puts the job on the queue with compile-time type safety.
+func EnqueueCloseCardStatementsPayload(ctx context.Context, sched scheduler.Scheduler, job CloseCardStatementsPayloadJob) error {
+	return sched.Enqueue(ctx, scheduler.Job{
+		JobType:     "finance.CloseCardStatements",
+		RunAt:       job.RunAt,
+		Payload:     job.Payload,
+		MaxAttempts: job.MaxAttempts,
+	})
+}
+
+// RunLedgerExportPayloadHandler is the strongly-typed callback signature for the 'finance.RunLedgerExport' job.
+type RunLedgerExportPayloadHandler func(ctx context.Context, payload *RunLedgerExportPayload) error
+
+// RegisterRunLedgerExportPayload binds the handler callback to the scheduler engine.
+func RegisterRunLedgerExportPayload(engine *scheduler.Engine, handler RunLedgerExportPayloadHandler) {
+	engine.Register("finance.RunLedgerExport", func(ctx context.Context, payloadBytes []byte) error {
+		var payload RunLedgerExportPayload
+		if err := json.Unmarshal(payloadBytes, &payload); err != nil {
+			return err
+		}
+		return handler(ctx, &payload)
+	})
+}
+
+// RunLedgerExportPayloadJob represents the enqueue request options for 'finance.RunLedgerExport'.
+type RunLedgerExportPayloadJob struct {
+	Payload     *RunLedgerExportPayload
+	RunAt       time.Time
+	MaxAttempts int
+}
+
+// EnqueueRunLedgerExportPayload puts the job on the queue with compile-time type safety.
+func EnqueueRunLedgerExportPayload(ctx context.Context, sched scheduler.Scheduler, job RunLedgerExportPayloadJob) error {
+	return sched.Enqueue(ctx, scheduler.Job{
+		JobType:     "finance.RunLedgerExport",
+		RunAt:       job.RunAt,
+		Payload:     job.Payload,
+		MaxAttempts: job.MaxAttempts,
+	})
+}
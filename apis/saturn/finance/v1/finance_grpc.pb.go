@@ -20,56 +20,116 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Finance_ConfigureFinance_FullMethodName         = "/saturn.finance.v1.Finance/ConfigureFinance"
-	Finance_GetFinanceSettings_FullMethodName       = "/saturn.finance.v1.Finance/GetFinanceSettings"
-	Finance_CreateBudget_FullMethodName             = "/saturn.finance.v1.Finance/CreateBudget"
-	Finance_GetBudget_FullMethodName                = "/saturn.finance.v1.Finance/GetBudget"
-	Finance_UpdateBudget_FullMethodName             = "/saturn.finance.v1.Finance/UpdateBudget"
-	Finance_DeleteBudget_FullMethodName             = "/saturn.finance.v1.Finance/DeleteBudget"
-	Finance_ListBudgets_FullMethodName              = "/saturn.finance.v1.Finance/ListBudgets"
-	Finance_GetBudgetPeriod_FullMethodName          = "/saturn.finance.v1.Finance/GetBudgetPeriod"
-	Finance_CreateExchangeRate_FullMethodName       = "/saturn.finance.v1.Finance/CreateExchangeRate"
-	Finance_GetExchangeRate_FullMethodName          = "/saturn.finance.v1.Finance/GetExchangeRate"
-	Finance_UpdateExchangeRate_FullMethodName       = "/saturn.finance.v1.Finance/UpdateExchangeRate"
-	Finance_ListExchangeRates_FullMethodName        = "/saturn.finance.v1.Finance/ListExchangeRates"
-	Finance_DeleteExchangeRate_FullMethodName       = "/saturn.finance.v1.Finance/DeleteExchangeRate"
-	Finance_CreateExpense_FullMethodName            = "/saturn.finance.v1.Finance/CreateExpense"
-	Finance_UpdateExpense_FullMethodName            = "/saturn.finance.v1.Finance/UpdateExpense"
-	Finance_DeleteTransaction_FullMethodName        = "/saturn.finance.v1.Finance/DeleteTransaction"
-	Finance_ListTransactions_FullMethodName         = "/saturn.finance.v1.Finance/ListTransactions"
-	Finance_GetTransaction_FullMethodName           = "/saturn.finance.v1.Finance/GetTransaction"
-	Finance_ListTransactionEvents_FullMethodName    = "/saturn.finance.v1.Finance/ListTransactionEvents"
-	Finance_GetInsights_FullMethodName              = "/saturn.finance.v1.Finance/GetInsights"
-	Finance_CreateRecurringExpense_FullMethodName   = "/saturn.finance.v1.Finance/CreateRecurringExpense"
-	Finance_UpdateRecurringExpense_FullMethodName   = "/saturn.finance.v1.Finance/UpdateRecurringExpense"
-	Finance_DeleteRecurringExpense_FullMethodName   = "/saturn.finance.v1.Finance/DeleteRecurringExpense"
-	Finance_ListRecurringExpenses_FullMethodName    = "/saturn.finance.v1.Finance/ListRecurringExpenses"
-	Finance_ListScheduledPayments_FullMethodName    = "/saturn.finance.v1.Finance/ListScheduledPayments"
-	Finance_GetScheduledPayment_FullMethodName      = "/saturn.finance.v1.Finance/GetScheduledPayment"
-	Finance_ConfirmScheduledPayment_FullMethodName  = "/saturn.finance.v1.Finance/ConfirmScheduledPayment"
-	Finance_MatchScheduledPayment_FullMethodName    = "/saturn.finance.v1.Finance/MatchScheduledPayment"
-	Finance_SkipScheduledPayment_FullMethodName     = "/saturn.finance.v1.Finance/SkipScheduledPayment"
-	Finance_CreateBorrowing_FullMethodName          = "/saturn.finance.v1.Finance/CreateBorrowing"
-	Finance_GetBorrowing_FullMethodName             = "/saturn.finance.v1.Finance/GetBorrowing"
-	Finance_ListBorrowings_FullMethodName           = "/saturn.finance.v1.Finance/ListBorrowings"
-	Finance_UpdateBorrowing_FullMethodName          = "/saturn.finance.v1.Finance/UpdateBorrowing"
-	Finance_DeleteBorrowing_FullMethodName          = "/saturn.finance.v1.Finance/DeleteBorrowing"
-	Finance_CreateBorrowingRepayment_FullMethodName = "/saturn.finance.v1.Finance/CreateBorrowingRepayment"
-	Finance_ListBorrowingRepayments_FullMethodName  = "/saturn.finance.v1.Finance/ListBorrowingRepayments"
-	Finance_DeleteBorrowingRepayment_FullMethodName = "/saturn.finance.v1.Finance/DeleteBorrowingRepayment"
-	Finance_CreateAccount_FullMethodName            = "/saturn.finance.v1.Finance/CreateAccount"
-	Finance_GetAccount_FullMethodName               = "/saturn.finance.v1.Finance/GetAccount"
-	Finance_UpdateAccount_FullMethodName            = "/saturn.finance.v1.Finance/UpdateAccount"
-	Finance_AdjustAccountBalance_FullMethodName     = "/saturn.finance.v1.Finance/AdjustAccountBalance"
-	Finance_DeleteAccount_FullMethodName            = "/saturn.finance.v1.Finance/DeleteAccount"
-	Finance_ListAccounts_FullMethodName             = "/saturn.finance.v1.Finance/ListAccounts"
-	Finance_CreateTransfer_FullMethodName           = "/saturn.finance.v1.Finance/CreateTransfer"
-	Finance_ListTransfers_FullMethodName            = "/saturn.finance.v1.Finance/ListTransfers"
-	Finance_ListCurrencies_FullMethodName           = "/saturn.finance.v1.Finance/ListCurrencies"
-	Finance_ListInboxItems_FullMethodName           = "/saturn.finance.v1.Finance/ListInboxItems"
-	Finance_UpdateInboxItem_FullMethodName          = "/saturn.finance.v1.Finance/UpdateInboxItem"
-	Finance_ApproveInboxItem_FullMethodName         = "/saturn.finance.v1.Finance/ApproveInboxItem"
-	Finance_DiscardInboxItem_FullMethodName         = "/saturn.finance.v1.Finance/DiscardInboxItem"
+	Finance_ConfigureFinance_FullMethodName               = "/saturn.finance.v1.Finance/ConfigureFinance"
+	Finance_GetFinanceSettings_FullMethodName             = "/saturn.finance.v1.Finance/GetFinanceSettings"
+	Finance_UpdateFinanceSettings_FullMethodName          = "/saturn.finance.v1.Finance/UpdateFinanceSettings"
+	Finance_CreateBudget_FullMethodName                   = "/saturn.finance.v1.Finance/CreateBudget"
+	Finance_GetBudget_FullMethodName                      = "/saturn.finance.v1.Finance/GetBudget"
+	Finance_UpdateBudget_FullMethodName                   = "/saturn.finance.v1.Finance/UpdateBudget"
+	Finance_DeleteBudget_FullMethodName                   = "/saturn.finance.v1.Finance/DeleteBudget"
+	Finance_ListBudgets_FullMethodName                    = "/saturn.finance.v1.Finance/ListBudgets"
+	Finance_GetBudgetPeriod_FullMethodName                = "/saturn.finance.v1.Finance/GetBudgetPeriod"
+	Finance_CreateExchangeRate_FullMethodName             = "/saturn.finance.v1.Finance/CreateExchangeRate"
+	Finance_GetExchangeRate_FullMethodName                = "/saturn.finance.v1.Finance/GetExchangeRate"
+	Finance_UpdateExchangeRate_FullMethodName             = "/saturn.finance.v1.Finance/UpdateExchangeRate"
+	Finance_ListExchangeRates_FullMethodName              = "/saturn.finance.v1.Finance/ListExchangeRates"
+	Finance_DeleteExchangeRate_FullMethodName             = "/saturn.finance.v1.Finance/DeleteExchangeRate"
+	Finance_CreateExpense_FullMethodName                  = "/saturn.finance.v1.Finance/CreateExpense"
+	Finance_UpdateExpense_FullMethodName                  = "/saturn.finance.v1.Finance/UpdateExpense"
+	Finance_CreateIncome_FullMethodName                   = "/saturn.finance.v1.Finance/CreateIncome"
+	Finance_UpdateIncome_FullMethodName                   = "/saturn.finance.v1.Finance/UpdateIncome"
+	Finance_CreateIncomeSource_FullMethodName             = "/saturn.finance.v1.Finance/CreateIncomeSource"
+	Finance_GetIncomeSource_FullMethodName                = "/saturn.finance.v1.Finance/GetIncomeSource"
+	Finance_UpdateIncomeSource_FullMethodName             = "/saturn.finance.v1.Finance/UpdateIncomeSource"
+	Finance_DeleteIncomeSource_FullMethodName             = "/saturn.finance.v1.Finance/DeleteIncomeSource"
+	Finance_ListIncomeSources_FullMethodName              = "/saturn.finance.v1.Finance/ListIncomeSources"
+	Finance_CreateGoal_FullMethodName                     = "/saturn.finance.v1.Finance/CreateGoal"
+	Finance_GetGoal_FullMethodName                        = "/saturn.finance.v1.Finance/GetGoal"
+	Finance_UpdateGoal_FullMethodName                     = "/saturn.finance.v1.Finance/UpdateGoal"
+	Finance_DeleteGoal_FullMethodName                     = "/saturn.finance.v1.Finance/DeleteGoal"
+	Finance_ListGoals_FullMethodName                      = "/saturn.finance.v1.Finance/ListGoals"
+	Finance_GetGoalProgress_FullMethodName                = "/saturn.finance.v1.Finance/GetGoalProgress"
+	Finance_CreateCategory_FullMethodName                 = "/saturn.finance.v1.Finance/CreateCategory"
+	Finance_GetCategory_FullMethodName                    = "/saturn.finance.v1.Finance/GetCategory"
+	Finance_UpdateCategory_FullMethodName                 = "/saturn.finance.v1.Finance/UpdateCategory"
+	Finance_DeleteCategory_FullMethodName                 = "/saturn.finance.v1.Finance/DeleteCategory"
+	Finance_ListCategories_FullMethodName                 = "/saturn.finance.v1.Finance/ListCategories"
+	Finance_DeleteTransaction_FullMethodName              = "/saturn.finance.v1.Finance/DeleteTransaction"
+	Finance_ListTransactions_FullMethodName               = "/saturn.finance.v1.Finance/ListTransactions"
+	Finance_BatchUpdateTransactions_FullMethodName        = "/saturn.finance.v1.Finance/BatchUpdateTransactions"
+	Finance_BatchDeleteTransactions_FullMethodName        = "/saturn.finance.v1.Finance/BatchDeleteTransactions"
+	Finance_CreateSavedFilter_FullMethodName              = "/saturn.finance.v1.Finance/CreateSavedFilter"
+	Finance_GetSavedFilter_FullMethodName                 = "/saturn.finance.v1.Finance/GetSavedFilter"
+	Finance_UpdateSavedFilter_FullMethodName              = "/saturn.finance.v1.Finance/UpdateSavedFilter"
+	Finance_DeleteSavedFilter_FullMethodName              = "/saturn.finance.v1.Finance/DeleteSavedFilter"
+	Finance_ListSavedFilters_FullMethodName               = "/saturn.finance.v1.Finance/ListSavedFilters"
+	Finance_CreatePayee_FullMethodName                    = "/saturn.finance.v1.Finance/CreatePayee"
+	Finance_GetPayee_FullMethodName                       = "/saturn.finance.v1.Finance/GetPayee"
+	Finance_UpdatePayee_FullMethodName                    = "/saturn.finance.v1.Finance/UpdatePayee"
+	Finance_DeletePayee_FullMethodName                    = "/saturn.finance.v1.Finance/DeletePayee"
+	Finance_ListPayees_FullMethodName                     = "/saturn.finance.v1.Finance/ListPayees"
+	Finance_CreateTransactionRule_FullMethodName          = "/saturn.finance.v1.Finance/CreateTransactionRule"
+	Finance_GetTransactionRule_FullMethodName             = "/saturn.finance.v1.Finance/GetTransactionRule"
+	Finance_UpdateTransactionRule_FullMethodName          = "/saturn.finance.v1.Finance/UpdateTransactionRule"
+	Finance_DeleteTransactionRule_FullMethodName          = "/saturn.finance.v1.Finance/DeleteTransactionRule"
+	Finance_ListTransactionRules_FullMethodName           = "/saturn.finance.v1.Finance/ListTransactionRules"
+	Finance_ApplyTransactionRules_FullMethodName          = "/saturn.finance.v1.Finance/ApplyTransactionRules"
+	Finance_GetTransaction_FullMethodName                 = "/saturn.finance.v1.Finance/GetTransaction"
+	Finance_ListTransactionEvents_FullMethodName          = "/saturn.finance.v1.Finance/ListTransactionEvents"
+	Finance_GetInsights_FullMethodName                    = "/saturn.finance.v1.Finance/GetInsights"
+	Finance_ForecastCashFlow_FullMethodName               = "/saturn.finance.v1.Finance/ForecastCashFlow"
+	Finance_ListAlerts_FullMethodName                     = "/saturn.finance.v1.Finance/ListAlerts"
+	Finance_GetAlert_FullMethodName                       = "/saturn.finance.v1.Finance/GetAlert"
+	Finance_AcknowledgeAlert_FullMethodName               = "/saturn.finance.v1.Finance/AcknowledgeAlert"
+	Finance_SnoozeAlert_FullMethodName                    = "/saturn.finance.v1.Finance/SnoozeAlert"
+	Finance_CreateRecurringExpense_FullMethodName         = "/saturn.finance.v1.Finance/CreateRecurringExpense"
+	Finance_UpdateRecurringExpense_FullMethodName         = "/saturn.finance.v1.Finance/UpdateRecurringExpense"
+	Finance_DeleteRecurringExpense_FullMethodName         = "/saturn.finance.v1.Finance/DeleteRecurringExpense"
+	Finance_ListRecurringExpenses_FullMethodName          = "/saturn.finance.v1.Finance/ListRecurringExpenses"
+	Finance_DetectSubscriptions_FullMethodName            = "/saturn.finance.v1.Finance/DetectSubscriptions"
+	Finance_ConvertToRecurringExpense_FullMethodName      = "/saturn.finance.v1.Finance/ConvertToRecurringExpense"
+	Finance_ListScheduledPayments_FullMethodName          = "/saturn.finance.v1.Finance/ListScheduledPayments"
+	Finance_GetScheduledPayment_FullMethodName            = "/saturn.finance.v1.Finance/GetScheduledPayment"
+	Finance_ConfirmScheduledPayment_FullMethodName        = "/saturn.finance.v1.Finance/ConfirmScheduledPayment"
+	Finance_MatchScheduledPayment_FullMethodName          = "/saturn.finance.v1.Finance/MatchScheduledPayment"
+	Finance_SkipScheduledPayment_FullMethodName           = "/saturn.finance.v1.Finance/SkipScheduledPayment"
+	Finance_CreateBorrowing_FullMethodName                = "/saturn.finance.v1.Finance/CreateBorrowing"
+	Finance_GetBorrowing_FullMethodName                   = "/saturn.finance.v1.Finance/GetBorrowing"
+	Finance_ListBorrowings_FullMethodName                 = "/saturn.finance.v1.Finance/ListBorrowings"
+	Finance_UpdateBorrowing_FullMethodName                = "/saturn.finance.v1.Finance/UpdateBorrowing"
+	Finance_DeleteBorrowing_FullMethodName                = "/saturn.finance.v1.Finance/DeleteBorrowing"
+	Finance_CreateBorrowingRepayment_FullMethodName       = "/saturn.finance.v1.Finance/CreateBorrowingRepayment"
+	Finance_ListBorrowingRepayments_FullMethodName        = "/saturn.finance.v1.Finance/ListBorrowingRepayments"
+	Finance_DeleteBorrowingRepayment_FullMethodName       = "/saturn.finance.v1.Finance/DeleteBorrowingRepayment"
+	Finance_CreateAccount_FullMethodName                  = "/saturn.finance.v1.Finance/CreateAccount"
+	Finance_GetAccount_FullMethodName                     = "/saturn.finance.v1.Finance/GetAccount"
+	Finance_UpdateAccount_FullMethodName                  = "/saturn.finance.v1.Finance/UpdateAccount"
+	Finance_AdjustAccountBalance_FullMethodName           = "/saturn.finance.v1.Finance/AdjustAccountBalance"
+	Finance_ListCardStatements_FullMethodName             = "/saturn.finance.v1.Finance/ListCardStatements"
+	Finance_GetCardStatement_FullMethodName               = "/saturn.finance.v1.Finance/GetCardStatement"
+	Finance_StartReconciliation_FullMethodName            = "/saturn.finance.v1.Finance/StartReconciliation"
+	Finance_GetReconciliation_FullMethodName              = "/saturn.finance.v1.Finance/GetReconciliation"
+	Finance_ListReconciliations_FullMethodName            = "/saturn.finance.v1.Finance/ListReconciliations"
+	Finance_ListReconciliationTransactions_FullMethodName = "/saturn.finance.v1.Finance/ListReconciliationTransactions"
+	Finance_SetTransactionsCleared_FullMethodName         = "/saturn.finance.v1.Finance/SetTransactionsCleared"
+	Finance_FinalizeReconciliation_FullMethodName         = "/saturn.finance.v1.Finance/FinalizeReconciliation"
+	Finance_CancelReconciliation_FullMethodName           = "/saturn.finance.v1.Finance/CancelReconciliation"
+	Finance_DeleteAccount_FullMethodName                  = "/saturn.finance.v1.Finance/DeleteAccount"
+	Finance_ListAccounts_FullMethodName                   = "/saturn.finance.v1.Finance/ListAccounts"
+	Finance_CreateTransfer_FullMethodName                 = "/saturn.finance.v1.Finance/CreateTransfer"
+	Finance_ListTransfers_FullMethodName                  = "/saturn.finance.v1.Finance/ListTransfers"
+	Finance_ListCurrencies_FullMethodName                 = "/saturn.finance.v1.Finance/ListCurrencies"
+	Finance_ListInboxItems_FullMethodName                 = "/saturn.finance.v1.Finance/ListInboxItems"
+	Finance_UpdateInboxItem_FullMethodName                = "/saturn.finance.v1.Finance/UpdateInboxItem"
+	Finance_ApproveInboxItem_FullMethodName               = "/saturn.finance.v1.Finance/ApproveInboxItem"
+	Finance_DiscardInboxItem_FullMethodName               = "/saturn.finance.v1.Finance/DiscardInboxItem"
+	Finance_ImportStatement_FullMethodName                = "/saturn.finance.v1.Finance/ImportStatement"
+	Finance_GetStatementMapping_FullMethodName            = "/saturn.finance.v1.Finance/GetStatementMapping"
+	Finance_ExportLedger_FullMethodName                   = "/saturn.finance.v1.Finance/ExportLedger"
+	Finance_CreateLedgerExport_FullMethodName             = "/saturn.finance.v1.Finance/CreateLedgerExport"
+	Finance_GetLedgerExport_FullMethodName                = "/saturn.finance.v1.Finance/GetLedgerExport"
+	Finance_ListLedgerExports_FullMethodName              = "/saturn.finance.v1.Finance/ListLedgerExports"
+	Finance_DownloadLedgerExport_FullMethodName           = "/saturn.finance.v1.Finance/DownloadLedgerExport"
 )
 
 // FinanceClient is the client API for Finance service.
@@ -84,6 +144,9 @@ type FinanceClient interface {
 	ConfigureFinance(ctx context.Context, in *ConfigureFinanceRequest, opts ...grpc.CallOption) (*FinanceSettings, error)
 	// Retrieves the current finance settings, including the configured base currency.
 	GetFinanceSettings(ctx context.Context, in *GetFinanceSettingsRequest, opts ...grpc.CallOption) (*FinanceSettings, error)
+	// Updates mutable finance settings, such as the currencies enabled for the workspace.
+	// Restricted to space owners and admins. The base currency cannot be changed.
+	UpdateFinanceSettings(ctx context.Context, in *UpdateFinanceSettingsRequest, opts ...grpc.CallOption) (*FinanceSettings, error)
 	// Creates a budget category template. Budgets track spent thresholds over repeating intervals.
 	CreateBudget(ctx context.Context, in *CreateBudgetRequest, opts ...grpc.CallOption) (*Budget, error)
 	// Retrieves details of a specific budget category template.
@@ -110,16 +173,101 @@ type FinanceClient interface {
 	CreateExpense(ctx context.Context, in *CreateExpenseRequest, opts ...grpc.CallOption) (*Transaction, error)
 	// Modifies properties of a logged expense transaction. Re-calculates budget and currency conversions.
 	UpdateExpense(ctx context.Context, in *UpdateExpenseRequest, opts ...grpc.CallOption) (*Transaction, error)
+	// Logs a new income transaction (such as a paycheck), increasing the balance of the receiving account.
+	CreateIncome(ctx context.Context, in *CreateIncomeRequest, opts ...grpc.CallOption) (*Transaction, error)
+	// Modifies properties of a logged income transaction. Re-calculates currency conversions and account balances.
+	UpdateIncome(ctx context.Context, in *UpdateIncomeRequest, opts ...grpc.CallOption) (*Transaction, error)
+	// Registers an income source, such as an employer paying a recurring salary.
+	CreateIncomeSource(ctx context.Context, in *CreateIncomeSourceRequest, opts ...grpc.CallOption) (*IncomeSource, error)
+	// Retrieves details of a specific income source.
+	GetIncomeSource(ctx context.Context, in *GetIncomeSourceRequest, opts ...grpc.CallOption) (*IncomeSource, error)
+	// Updates an income source's employer, expected amount, cadence, or deposit account.
+	UpdateIncomeSource(ctx context.Context, in *UpdateIncomeSourceRequest, opts ...grpc.CallOption) (*IncomeSource, error)
+	// Deletes an income source. Income transactions previously linked to it are preserved.
+	DeleteIncomeSource(ctx context.Context, in *DeleteIncomeSourceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Lists income sources configured in the space.
+	ListIncomeSources(ctx context.Context, in *ListIncomeSourcesRequest, opts ...grpc.CallOption) (*ListIncomeSourcesResponse, error)
+	// Creates a savings goal funded by transfers into a linked account or by tagged transactions.
+	CreateGoal(ctx context.Context, in *CreateGoalRequest, opts ...grpc.CallOption) (*Goal, error)
+	// Retrieves details of a specific savings goal.
+	GetGoal(ctx context.Context, in *GetGoalRequest, opts ...grpc.CallOption) (*Goal, error)
+	// Updates a goal's target, deadline, contribution sources, or status.
+	UpdateGoal(ctx context.Context, in *UpdateGoalRequest, opts ...grpc.CallOption) (*Goal, error)
+	// Deletes a savings goal. Contributing transactions and transfers are preserved.
+	DeleteGoal(ctx context.Context, in *DeleteGoalRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Lists savings goals configured in the space.
+	ListGoals(ctx context.Context, in *ListGoalsRequest, opts ...grpc.CallOption) (*ListGoalsResponse, error)
+	// Computes a goal's saved amount, contribution history, and projected completion date.
+	GetGoalProgress(ctx context.Context, in *GetGoalProgressRequest, opts ...grpc.CallOption) (*GoalProgress, error)
+	// Creates a transaction category, optionally nested under a parent category.
+	CreateCategory(ctx context.Context, in *CreateCategoryRequest, opts ...grpc.CallOption) (*Category, error)
+	// Retrieves details of a specific category.
+	GetCategory(ctx context.Context, in *GetCategoryRequest, opts ...grpc.CallOption) (*Category, error)
+	// Updates a category's name, appearance, or parent.
+	UpdateCategory(ctx context.Context, in *UpdateCategoryRequest, opts ...grpc.CallOption) (*Category, error)
+	// Deletes a category. Subcategories become top-level and linked transactions become uncategorized.
+	DeleteCategory(ctx context.Context, in *DeleteCategoryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Lists categories configured in the space.
+	ListCategories(ctx context.Context, in *ListCategoriesRequest, opts ...grpc.CallOption) (*ListCategoriesResponse, error)
 	// Deletes a transaction, reversing all changes to budget period consumption and account balances.
 	DeleteTransaction(ctx context.Context, in *DeleteTransactionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Lists paginated transaction records matching specific budget, account, source, or text queries.
 	ListTransactions(ctx context.Context, in *ListTransactionsRequest, opts ...grpc.CallOption) (*ListTransactionsResponse, error)
+	// Applies the same field changes to many expenses and income atomically, selected by ID or by filter.
+	// Account balances are adjusted once by the net change of the batch.
+	BatchUpdateTransactions(ctx context.Context, in *BatchUpdateTransactionsRequest, opts ...grpc.CallOption) (*BatchUpdateTransactionsResponse, error)
+	// Deletes many expenses and income atomically, selected by ID or by filter.
+	BatchDeleteTransactions(ctx context.Context, in *BatchDeleteTransactionsRequest, opts ...grpc.CallOption) (*BatchDeleteTransactionsResponse, error)
+	// Saves a named transaction filter expression that can be reused when listing transactions.
+	CreateSavedFilter(ctx context.Context, in *CreateSavedFilterRequest, opts ...grpc.CallOption) (*SavedFilter, error)
+	// Retrieves details of a specific saved filter.
+	GetSavedFilter(ctx context.Context, in *GetSavedFilterRequest, opts ...grpc.CallOption) (*SavedFilter, error)
+	// Updates a saved filter's name, expression, or default sort.
+	UpdateSavedFilter(ctx context.Context, in *UpdateSavedFilterRequest, opts ...grpc.CallOption) (*SavedFilter, error)
+	// Deletes a saved filter.
+	DeleteSavedFilter(ctx context.Context, in *DeleteSavedFilterRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Lists saved filters configured in the space.
+	ListSavedFilters(ctx context.Context, in *ListSavedFiltersRequest, opts ...grpc.CallOption) (*ListSavedFiltersResponse, error)
+	// Creates a payee that transaction descriptions are normalized to.
+	CreatePayee(ctx context.Context, in *CreatePayeeRequest, opts ...grpc.CallOption) (*Payee, error)
+	// Retrieves details of a specific payee.
+	GetPayee(ctx context.Context, in *GetPayeeRequest, opts ...grpc.CallOption) (*Payee, error)
+	// Updates a payee's name, matchers, defaults, or logo.
+	UpdatePayee(ctx context.Context, in *UpdatePayeeRequest, opts ...grpc.CallOption) (*Payee, error)
+	// Deletes a payee. Transactions linked to it keep their descriptions.
+	DeletePayee(ctx context.Context, in *DeletePayeeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Lists payees configured in the space.
+	ListPayees(ctx context.Context, in *ListPayeesRequest, opts ...grpc.CallOption) (*ListPayeesResponse, error)
+	// Creates a rule that assigns a budget, payee, category, or tags to matching transactions
+	// when they are created, imported, or ingested.
+	CreateTransactionRule(ctx context.Context, in *CreateTransactionRuleRequest, opts ...grpc.CallOption) (*TransactionRule, error)
+	// Retrieves details of a specific transaction rule.
+	GetTransactionRule(ctx context.Context, in *GetTransactionRuleRequest, opts ...grpc.CallOption) (*TransactionRule, error)
+	// Updates a transaction rule's conditions, actions, priority, or active state.
+	UpdateTransactionRule(ctx context.Context, in *UpdateTransactionRuleRequest, opts ...grpc.CallOption) (*TransactionRule, error)
+	// Deletes a transaction rule. Transactions it already classified are unchanged.
+	DeleteTransactionRule(ctx context.Context, in *DeleteTransactionRuleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Lists transaction rules configured in the space, by ascending priority by default.
+	ListTransactionRules(ctx context.Context, in *ListTransactionRulesRequest, opts ...grpc.CallOption) (*ListTransactionRulesResponse, error)
+	// Re-runs the space's active rules and payees over existing expenses and income.
+	ApplyTransactionRules(ctx context.Context, in *ApplyTransactionRulesRequest, opts ...grpc.CallOption) (*ApplyTransactionRulesResponse, error)
 	// Retrieves details of a specific logged transaction record by ID.
 	GetTransaction(ctx context.Context, in *GetTransactionRequest, opts ...grpc.CallOption) (*Transaction, error)
 	// Lists historical lifecycle events tracking mutations and updates applied to a transaction.
 	ListTransactionEvents(ctx context.Context, in *ListTransactionEventsRequest, opts ...grpc.CallOption) (*ListTransactionEventsResponse, error)
 	// Aggregates space spend patterns, limits, remaining budgets, burn rates, and budget category distributions.
 	GetInsights(ctx context.Context, in *GetInsightsRequest, opts ...grpc.CallOption) (*GetInsightsResponse, error)
+	// Projects the balance of every active account day by day over the coming days, and flags the days
+	// an account is expected to drop below zero or below its low balance threshold.
+	ForecastCashFlow(ctx context.Context, in *ForecastCashFlowRequest, opts ...grpc.CallOption) (*ForecastCashFlowResponse, error)
+	// Lists the alerts raised for the space, most recent first.
+	ListAlerts(ctx context.Context, in *ListAlertsRequest, opts ...grpc.CallOption) (*ListAlertsResponse, error)
+	// Retrieves an alert by ID.
+	GetAlert(ctx context.Context, in *GetAlertRequest, opts ...grpc.CallOption) (*Alert, error)
+	// Marks an alert as handled, removing it from the active alerts for good.
+	AcknowledgeAlert(ctx context.Context, in *AcknowledgeAlertRequest, opts ...grpc.CallOption) (*Alert, error)
+	// Hides an alert from the active alerts until the given time.
+	SnoozeAlert(ctx context.Context, in *SnoozeAlertRequest, opts ...grpc.CallOption) (*Alert, error)
 	// Registers a recurring expense template, generating repeating payment obligations periodically.
 	CreateRecurringExpense(ctx context.Context, in *CreateRecurringExpenseRequest, opts ...grpc.CallOption) (*RecurringExpense, error)
 	// Updates an active recurring expense template.
@@ -128,6 +276,12 @@ type FinanceClient interface {
 	DeleteRecurringExpense(ctx context.Context, in *DeleteRecurringExpenseRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Lists all configured recurring templates in the space.
 	ListRecurringExpenses(ctx context.Context, in *ListRecurringExpensesRequest, opts ...grpc.CallOption) (*ListRecurringExpensesResponse, error)
+	// Scans the space's expense history for periodic charges with a similar vendor, a stable amount and
+	// a regular cadence that no recurring expense tracks yet.
+	DetectSubscriptions(ctx context.Context, in *DetectSubscriptionsRequest, opts ...grpc.CallOption) (*DetectSubscriptionsResponse, error)
+	// Creates a recurring expense from a detected subscription and links its supporting transactions
+	// to the new template.
+	ConvertToRecurringExpense(ctx context.Context, in *ConvertToRecurringExpenseRequest, opts ...grpc.CallOption) (*RecurringExpense, error)
 	// Lists scheduled payment instances spawned by recurring templates.
 	ListScheduledPayments(ctx context.Context, in *ListScheduledPaymentsRequest, opts ...grpc.CallOption) (*ListScheduledPaymentsResponse, error)
 	// Retrieves details of a specific scheduled payment by ID.
@@ -162,6 +316,24 @@ type FinanceClient interface {
 	UpdateAccount(ctx context.Context, in *UpdateAccountRequest, opts ...grpc.CallOption) (*Account, error)
 	// Adjusts an account's live balance to a target amount by creating a system reconciliation transaction.
 	AdjustAccountBalance(ctx context.Context, in *AdjustAccountBalanceRequest, opts ...grpc.CallOption) (*Account, error)
+	// Lists the closed statements of a credit card, most recent first.
+	ListCardStatements(ctx context.Context, in *ListCardStatementsRequest, opts ...grpc.CallOption) (*ListCardStatementsResponse, error)
+	// Retrieves a closed credit card statement.
+	GetCardStatement(ctx context.Context, in *GetCardStatementRequest, opts ...grpc.CallOption) (*CardStatement, error)
+	// Opens a reconciliation session matching an account's cleared transactions against a bank statement.
+	StartReconciliation(ctx context.Context, in *StartReconciliationRequest, opts ...grpc.CallOption) (*Reconciliation, error)
+	// Retrieves a reconciliation session. Balances of sessions in progress are recalculated.
+	GetReconciliation(ctx context.Context, in *GetReconciliationRequest, opts ...grpc.CallOption) (*Reconciliation, error)
+	// Lists the reconciliation history of an account, most recent statement first.
+	ListReconciliations(ctx context.Context, in *ListReconciliationsRequest, opts ...grpc.CallOption) (*ListReconciliationsResponse, error)
+	// Lists the transactions covered by a reconciliation session.
+	ListReconciliationTransactions(ctx context.Context, in *ListReconciliationTransactionsRequest, opts ...grpc.CallOption) (*ListReconciliationTransactionsResponse, error)
+	// Marks transactions as cleared, or uncleared, within a reconciliation session in progress.
+	SetTransactionsCleared(ctx context.Context, in *SetTransactionsClearedRequest, opts ...grpc.CallOption) (*Reconciliation, error)
+	// Finalizes a balanced reconciliation session, locking its cleared transactions from edits.
+	FinalizeReconciliation(ctx context.Context, in *FinalizeReconciliationRequest, opts ...grpc.CallOption) (*Reconciliation, error)
+	// Discards a reconciliation session in progress. Cleared flags are kept.
+	CancelReconciliation(ctx context.Context, in *CancelReconciliationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Deletes a payment account. Default accounts cannot be deleted until another default is nominated.
 	DeleteAccount(ctx context.Context, in *DeleteAccountRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Lists payment accounts in the workspace, supporting optional currency conversion hydration.
@@ -170,7 +342,7 @@ type FinanceClient interface {
 	CreateTransfer(ctx context.Context, in *CreateTransferRequest, opts ...grpc.CallOption) (*Transfer, error)
 	// Lists all transfer transactions registered in the workspace.
 	ListTransfers(ctx context.Context, in *ListTransfersRequest, opts ...grpc.CallOption) (*ListTransfersResponse, error)
-	// Retrieves a static list of supported currencies.
+	// Retrieves the ISO 4217 currency catalog with minor units, flagging the currencies enabled in the workspace.
 	ListCurrencies(ctx context.Context, in *ListCurrenciesRequest, opts ...grpc.CallOption) (*ListCurrenciesResponse, error)
 	// Lists staging inbox items queue awaiting manual approval or transaction matching.
 	ListInboxItems(ctx context.Context, in *ListInboxItemsRequest, opts ...grpc.CallOption) (*ListInboxItemsResponse, error)
@@ -180,6 +352,25 @@ type FinanceClient interface {
 	ApproveInboxItem(ctx context.Context, in *ApproveInboxItemRequest, opts ...grpc.CallOption) (*InboxItem, error)
 	// Discards an ingested inbox item from the staging queue, preventing classification.
 	DiscardInboxItem(ctx context.Context, in *DiscardInboxItemRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Imports a bank statement file (CSV, OFX/QFX or CAMT.053) for an account and stages
+	// each row as an inbox item. Rows already staged by a previous import are skipped.
+	ImportStatement(ctx context.Context, in *ImportStatementRequest, opts ...grpc.CallOption) (*ImportStatementResponse, error)
+	// Retrieves the saved CSV column mapping for an account.
+	GetStatementMapping(ctx context.Context, in *GetStatementMappingRequest, opts ...grpc.CallOption) (*StatementMapping, error)
+	// Streams the space's accounts, budgets, transactions and transfers for a date range in the
+	// requested format. The first chunk carries the content type and suggested file name. Served
+	// over HTTP as a download at `GET /v1/finance/ledger:export`.
+	ExportLedger(ctx context.Context, in *ExportLedgerRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[LedgerExportChunk], error)
+	// Queues a ledger export to run as a background job. Use this for large date ranges and
+	// download the result once the export has completed.
+	CreateLedgerExport(ctx context.Context, in *CreateLedgerExportRequest, opts ...grpc.CallOption) (*LedgerExport, error)
+	// Retrieves the status of a background ledger export.
+	GetLedgerExport(ctx context.Context, in *GetLedgerExportRequest, opts ...grpc.CallOption) (*LedgerExport, error)
+	// Lists background ledger exports in the space, newest first.
+	ListLedgerExports(ctx context.Context, in *ListLedgerExportsRequest, opts ...grpc.CallOption) (*ListLedgerExportsResponse, error)
+	// Streams the file of a completed background ledger export. Served over HTTP as a download
+	// at `GET /v1/finance/ledger-exports/{id}:download`.
+	DownloadLedgerExport(ctx context.Context, in *DownloadLedgerExportRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[LedgerExportChunk], error)
 }
 
 type financeClient struct {
@@ -210,6 +401,16 @@ func (c *financeClient) GetFinanceSettings(ctx context.Context, in *GetFinanceSe
 	return out, nil
 }
 
+func (c *financeClient) UpdateFinanceSettings(ctx context.Context, in *UpdateFinanceSettingsRequest, opts ...grpc.CallOption) (*FinanceSettings, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FinanceSettings)
+	err := c.cc.Invoke(ctx, Finance_UpdateFinanceSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *financeClient) CreateBudget(ctx context.Context, in *CreateBudgetRequest, opts ...grpc.CallOption) (*Budget, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Budget)
@@ -340,1377 +541,3250 @@ func (c *financeClient) UpdateExpense(ctx context.Context, in *UpdateExpenseRequ
 	return out, nil
 }
 
-func (c *financeClient) DeleteTransaction(ctx context.Context, in *DeleteTransactionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *financeClient) CreateIncome(ctx context.Context, in *CreateIncomeRequest, opts ...grpc.CallOption) (*Transaction, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, Finance_DeleteTransaction_FullMethodName, in, out, cOpts...)
+	out := new(Transaction)
+	err := c.cc.Invoke(ctx, Finance_CreateIncome_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *financeClient) ListTransactions(ctx context.Context, in *ListTransactionsRequest, opts ...grpc.CallOption) (*ListTransactionsResponse, error) {
+func (c *financeClient) UpdateIncome(ctx context.Context, in *UpdateIncomeRequest, opts ...grpc.CallOption) (*Transaction, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ListTransactionsResponse)
-	err := c.cc.Invoke(ctx, Finance_ListTransactions_FullMethodName, in, out, cOpts...)
+	out := new(Transaction)
+	err := c.cc.Invoke(ctx, Finance_UpdateIncome_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *financeClient) GetTransaction(ctx context.Context, in *GetTransactionRequest, opts ...grpc.CallOption) (*Transaction, error) {
+func (c *financeClient) CreateIncomeSource(ctx context.Context, in *CreateIncomeSourceRequest, opts ...grpc.CallOption) (*IncomeSource, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Transaction)
-	err := c.cc.Invoke(ctx, Finance_GetTransaction_FullMethodName, in, out, cOpts...)
+	out := new(IncomeSource)
+	err := c.cc.Invoke(ctx, Finance_CreateIncomeSource_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *financeClient) ListTransactionEvents(ctx context.Context, in *ListTransactionEventsRequest, opts ...grpc.CallOption) (*ListTransactionEventsResponse, error) {
+func (c *financeClient) GetIncomeSource(ctx context.Context, in *GetIncomeSourceRequest, opts ...grpc.CallOption) (*IncomeSource, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ListTransactionEventsResponse)
-	err := c.cc.Invoke(ctx, Finance_ListTransactionEvents_FullMethodName, in, out, cOpts...)
+	out := new(IncomeSource)
+	err := c.cc.Invoke(ctx, Finance_GetIncomeSource_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *financeClient) GetInsights(ctx context.Context, in *GetInsightsRequest, opts ...grpc.CallOption) (*GetInsightsResponse, error) {
+func (c *financeClient) UpdateIncomeSource(ctx context.Context, in *UpdateIncomeSourceRequest, opts ...grpc.CallOption) (*IncomeSource, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetInsightsResponse)
-	err := c.cc.Invoke(ctx, Finance_GetInsights_FullMethodName, in, out, cOpts...)
+	out := new(IncomeSource)
+	err := c.cc.Invoke(ctx, Finance_UpdateIncomeSource_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *financeClient) CreateRecurringExpense(ctx context.Context, in *CreateRecurringExpenseRequest, opts ...grpc.CallOption) (*RecurringExpense, error) {
+func (c *financeClient) DeleteIncomeSource(ctx context.Context, in *DeleteIncomeSourceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(RecurringExpense)
-	err := c.cc.Invoke(ctx, Finance_CreateRecurringExpense_FullMethodName, in, out, cOpts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Finance_DeleteIncomeSource_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *financeClient) UpdateRecurringExpense(ctx context.Context, in *UpdateRecurringExpenseRequest, opts ...grpc.CallOption) (*RecurringExpense, error) {
+func (c *financeClient) ListIncomeSources(ctx context.Context, in *ListIncomeSourcesRequest, opts ...grpc.CallOption) (*ListIncomeSourcesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(RecurringExpense)
-	err := c.cc.Invoke(ctx, Finance_UpdateRecurringExpense_FullMethodName, in, out, cOpts...)
+	out := new(ListIncomeSourcesResponse)
+	err := c.cc.Invoke(ctx, Finance_ListIncomeSources_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *financeClient) DeleteRecurringExpense(ctx context.Context, in *DeleteRecurringExpenseRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *financeClient) CreateGoal(ctx context.Context, in *CreateGoalRequest, opts ...grpc.CallOption) (*Goal, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, Finance_DeleteRecurringExpense_FullMethodName, in, out, cOpts...)
+	out := new(Goal)
+	err := c.cc.Invoke(ctx, Finance_CreateGoal_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *financeClient) ListRecurringExpenses(ctx context.Context, in *ListRecurringExpensesRequest, opts ...grpc.CallOption) (*ListRecurringExpensesResponse, error) {
+func (c *financeClient) GetGoal(ctx context.Context, in *GetGoalRequest, opts ...grpc.CallOption) (*Goal, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ListRecurringExpensesResponse)
-	err := c.cc.Invoke(ctx, Finance_ListRecurringExpenses_FullMethodName, in, out, cOpts...)
+	out := new(Goal)
+	err := c.cc.Invoke(ctx, Finance_GetGoal_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *financeClient) ListScheduledPayments(ctx context.Context, in *ListScheduledPaymentsRequest, opts ...grpc.CallOption) (*ListScheduledPaymentsResponse, error) {
+func (c *financeClient) UpdateGoal(ctx context.Context, in *UpdateGoalRequest, opts ...grpc.CallOption) (*Goal, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ListScheduledPaymentsResponse)
-	err := c.cc.Invoke(ctx, Finance_ListScheduledPayments_FullMethodName, in, out, cOpts...)
+	out := new(Goal)
+	err := c.cc.Invoke(ctx, Finance_UpdateGoal_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *financeClient) GetScheduledPayment(ctx context.Context, in *GetScheduledPaymentRequest, opts ...grpc.CallOption) (*ScheduledPayment, error) {
+func (c *financeClient) DeleteGoal(ctx context.Context, in *DeleteGoalRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ScheduledPayment)
-	err := c.cc.Invoke(ctx, Finance_GetScheduledPayment_FullMethodName, in, out, cOpts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Finance_DeleteGoal_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *financeClient) ConfirmScheduledPayment(ctx context.Context, in *ConfirmScheduledPaymentRequest, opts ...grpc.CallOption) (*Transaction, error) {
+func (c *financeClient) ListGoals(ctx context.Context, in *ListGoalsRequest, opts ...grpc.CallOption) (*ListGoalsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Transaction)
-	err := c.cc.Invoke(ctx, Finance_ConfirmScheduledPayment_FullMethodName, in, out, cOpts...)
+	out := new(ListGoalsResponse)
+	err := c.cc.Invoke(ctx, Finance_ListGoals_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *financeClient) MatchScheduledPayment(ctx context.Context, in *MatchScheduledPaymentRequest, opts ...grpc.CallOption) (*Transaction, error) {
+func (c *financeClient) GetGoalProgress(ctx context.Context, in *GetGoalProgressRequest, opts ...grpc.CallOption) (*GoalProgress, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Transaction)
-	err := c.cc.Invoke(ctx, Finance_MatchScheduledPayment_FullMethodName, in, out, cOpts...)
+	out := new(GoalProgress)
+	err := c.cc.Invoke(ctx, Finance_GetGoalProgress_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *financeClient) SkipScheduledPayment(ctx context.Context, in *SkipScheduledPaymentRequest, opts ...grpc.CallOption) (*ScheduledPayment, error) {
+func (c *financeClient) CreateCategory(ctx context.Context, in *CreateCategoryRequest, opts ...grpc.CallOption) (*Category, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ScheduledPayment)
-	err := c.cc.Invoke(ctx, Finance_SkipScheduledPayment_FullMethodName, in, out, cOpts...)
+	out := new(Category)
+	err := c.cc.Invoke(ctx, Finance_CreateCategory_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *financeClient) CreateBorrowing(ctx context.Context, in *CreateBorrowingRequest, opts ...grpc.CallOption) (*Borrowing, error) {
+func (c *financeClient) GetCategory(ctx context.Context, in *GetCategoryRequest, opts ...grpc.CallOption) (*Category, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Borrowing)
-	err := c.cc.Invoke(ctx, Finance_CreateBorrowing_FullMethodName, in, out, cOpts...)
+	out := new(Category)
+	err := c.cc.Invoke(ctx, Finance_GetCategory_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *financeClient) GetBorrowing(ctx context.Context, in *GetBorrowingRequest, opts ...grpc.CallOption) (*Borrowing, error) {
+func (c *financeClient) UpdateCategory(ctx context.Context, in *UpdateCategoryRequest, opts ...grpc.CallOption) (*Category, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Borrowing)
-	err := c.cc.Invoke(ctx, Finance_GetBorrowing_FullMethodName, in, out, cOpts...)
+	out := new(Category)
+	err := c.cc.Invoke(ctx, Finance_UpdateCategory_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *financeClient) ListBorrowings(ctx context.Context, in *ListBorrowingsRequest, opts ...grpc.CallOption) (*ListBorrowingsResponse, error) {
+func (c *financeClient) DeleteCategory(ctx context.Context, in *DeleteCategoryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ListBorrowingsResponse)
-	err := c.cc.Invoke(ctx, Finance_ListBorrowings_FullMethodName, in, out, cOpts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Finance_DeleteCategory_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *financeClient) UpdateBorrowing(ctx context.Context, in *UpdateBorrowingRequest, opts ...grpc.CallOption) (*Borrowing, error) {
+func (c *financeClient) ListCategories(ctx context.Context, in *ListCategoriesRequest, opts ...grpc.CallOption) (*ListCategoriesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Borrowing)
-	err := c.cc.Invoke(ctx, Finance_UpdateBorrowing_FullMethodName, in, out, cOpts...)
+	out := new(ListCategoriesResponse)
+	err := c.cc.Invoke(ctx, Finance_ListCategories_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *financeClient) DeleteBorrowing(ctx context.Context, in *DeleteBorrowingRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *financeClient) DeleteTransaction(ctx context.Context, in *DeleteTransactionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, Finance_DeleteBorrowing_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, Finance_DeleteTransaction_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *financeClient) CreateBorrowingRepayment(ctx context.Context, in *CreateBorrowingRepaymentRequest, opts ...grpc.CallOption) (*BorrowingRepayment, error) {
+func (c *financeClient) ListTransactions(ctx context.Context, in *ListTransactionsRequest, opts ...grpc.CallOption) (*ListTransactionsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(BorrowingRepayment)
-	err := c.cc.Invoke(ctx, Finance_CreateBorrowingRepayment_FullMethodName, in, out, cOpts...)
+	out := new(ListTransactionsResponse)
+	err := c.cc.Invoke(ctx, Finance_ListTransactions_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *financeClient) ListBorrowingRepayments(ctx context.Context, in *ListBorrowingRepaymentsRequest, opts ...grpc.CallOption) (*ListBorrowingRepaymentsResponse, error) {
+func (c *financeClient) BatchUpdateTransactions(ctx context.Context, in *BatchUpdateTransactionsRequest, opts ...grpc.CallOption) (*BatchUpdateTransactionsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ListBorrowingRepaymentsResponse)
-	err := c.cc.Invoke(ctx, Finance_ListBorrowingRepayments_FullMethodName, in, out, cOpts...)
+	out := new(BatchUpdateTransactionsResponse)
+	err := c.cc.Invoke(ctx, Finance_BatchUpdateTransactions_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *financeClient) DeleteBorrowingRepayment(ctx context.Context, in *DeleteBorrowingRepaymentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *financeClient) BatchDeleteTransactions(ctx context.Context, in *BatchDeleteTransactionsRequest, opts ...grpc.CallOption) (*BatchDeleteTransactionsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, Finance_DeleteBorrowingRepayment_FullMethodName, in, out, cOpts...)
+	out := new(BatchDeleteTransactionsResponse)
+	err := c.cc.Invoke(ctx, Finance_BatchDeleteTransactions_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *financeClient) CreateAccount(ctx context.Context, in *CreateAccountRequest, opts ...grpc.CallOption) (*Account, error) {
+func (c *financeClient) CreateSavedFilter(ctx context.Context, in *CreateSavedFilterRequest, opts ...grpc.CallOption) (*SavedFilter, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Account)
-	err := c.cc.Invoke(ctx, Finance_CreateAccount_FullMethodName, in, out, cOpts...)
+	out := new(SavedFilter)
+	err := c.cc.Invoke(ctx, Finance_CreateSavedFilter_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *financeClient) GetAccount(ctx context.Context, in *GetAccountRequest, opts ...grpc.CallOption) (*Account, error) {
+func (c *financeClient) GetSavedFilter(ctx context.Context, in *GetSavedFilterRequest, opts ...grpc.CallOption) (*SavedFilter, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Account)
-	err := c.cc.Invoke(ctx, Finance_GetAccount_FullMethodName, in, out, cOpts...)
+	out := new(SavedFilter)
+	err := c.cc.Invoke(ctx, Finance_GetSavedFilter_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *financeClient) UpdateAccount(ctx context.Context, in *UpdateAccountRequest, opts ...grpc.CallOption) (*Account, error) {
+func (c *financeClient) UpdateSavedFilter(ctx context.Context, in *UpdateSavedFilterRequest, opts ...grpc.CallOption) (*SavedFilter, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Account)
-	err := c.cc.Invoke(ctx, Finance_UpdateAccount_FullMethodName, in, out, cOpts...)
+	out := new(SavedFilter)
+	err := c.cc.Invoke(ctx, Finance_UpdateSavedFilter_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *financeClient) AdjustAccountBalance(ctx context.Context, in *AdjustAccountBalanceRequest, opts ...grpc.CallOption) (*Account, error) {
+func (c *financeClient) DeleteSavedFilter(ctx context.Context, in *DeleteSavedFilterRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Account)
-	err := c.cc.Invoke(ctx, Finance_AdjustAccountBalance_FullMethodName, in, out, cOpts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Finance_DeleteSavedFilter_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *financeClient) DeleteAccount(ctx context.Context, in *DeleteAccountRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *financeClient) ListSavedFilters(ctx context.Context, in *ListSavedFiltersRequest, opts ...grpc.CallOption) (*ListSavedFiltersResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, Finance_DeleteAccount_FullMethodName, in, out, cOpts...)
+	out := new(ListSavedFiltersResponse)
+	err := c.cc.Invoke(ctx, Finance_ListSavedFilters_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *financeClient) ListAccounts(ctx context.Context, in *ListAccountsRequest, opts ...grpc.CallOption) (*ListAccountsResponse, error) {
+func (c *financeClient) CreatePayee(ctx context.Context, in *CreatePayeeRequest, opts ...grpc.CallOption) (*Payee, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ListAccountsResponse)
-	err := c.cc.Invoke(ctx, Finance_ListAccounts_FullMethodName, in, out, cOpts...)
+	out := new(Payee)
+	err := c.cc.Invoke(ctx, Finance_CreatePayee_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *financeClient) CreateTransfer(ctx context.Context, in *CreateTransferRequest, opts ...grpc.CallOption) (*Transfer, error) {
+func (c *financeClient) GetPayee(ctx context.Context, in *GetPayeeRequest, opts ...grpc.CallOption) (*Payee, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Transfer)
-	err := c.cc.Invoke(ctx, Finance_CreateTransfer_FullMethodName, in, out, cOpts...)
+	out := new(Payee)
+	err := c.cc.Invoke(ctx, Finance_GetPayee_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *financeClient) ListTransfers(ctx context.Context, in *ListTransfersRequest, opts ...grpc.CallOption) (*ListTransfersResponse, error) {
+func (c *financeClient) UpdatePayee(ctx context.Context, in *UpdatePayeeRequest, opts ...grpc.CallOption) (*Payee, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ListTransfersResponse)
-	err := c.cc.Invoke(ctx, Finance_ListTransfers_FullMethodName, in, out, cOpts...)
+	out := new(Payee)
+	err := c.cc.Invoke(ctx, Finance_UpdatePayee_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *financeClient) ListCurrencies(ctx context.Context, in *ListCurrenciesRequest, opts ...grpc.CallOption) (*ListCurrenciesResponse, error) {
+func (c *financeClient) DeletePayee(ctx context.Context, in *DeletePayeeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ListCurrenciesResponse)
-	err := c.cc.Invoke(ctx, Finance_ListCurrencies_FullMethodName, in, out, cOpts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Finance_DeletePayee_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *financeClient) ListInboxItems(ctx context.Context, in *ListInboxItemsRequest, opts ...grpc.CallOption) (*ListInboxItemsResponse, error) {
+func (c *financeClient) ListPayees(ctx context.Context, in *ListPayeesRequest, opts ...grpc.CallOption) (*ListPayeesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ListInboxItemsResponse)
-	err := c.cc.Invoke(ctx, Finance_ListInboxItems_FullMethodName, in, out, cOpts...)
+	out := new(ListPayeesResponse)
+	err := c.cc.Invoke(ctx, Finance_ListPayees_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *financeClient) UpdateInboxItem(ctx context.Context, in *UpdateInboxItemRequest, opts ...grpc.CallOption) (*InboxItem, error) {
+func (c *financeClient) CreateTransactionRule(ctx context.Context, in *CreateTransactionRuleRequest, opts ...grpc.CallOption) (*TransactionRule, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(InboxItem)
-	err := c.cc.Invoke(ctx, Finance_UpdateInboxItem_FullMethodName, in, out, cOpts...)
+	out := new(TransactionRule)
+	err := c.cc.Invoke(ctx, Finance_CreateTransactionRule_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *financeClient) ApproveInboxItem(ctx context.Context, in *ApproveInboxItemRequest, opts ...grpc.CallOption) (*InboxItem, error) {
+func (c *financeClient) GetTransactionRule(ctx context.Context, in *GetTransactionRuleRequest, opts ...grpc.CallOption) (*TransactionRule, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(InboxItem)
-	err := c.cc.Invoke(ctx, Finance_ApproveInboxItem_FullMethodName, in, out, cOpts...)
+	out := new(TransactionRule)
+	err := c.cc.Invoke(ctx, Finance_GetTransactionRule_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *financeClient) DiscardInboxItem(ctx context.Context, in *DiscardInboxItemRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *financeClient) UpdateTransactionRule(ctx context.Context, in *UpdateTransactionRuleRequest, opts ...grpc.CallOption) (*TransactionRule, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, Finance_DiscardInboxItem_FullMethodName, in, out, cOpts...)
+	out := new(TransactionRule)
+	err := c.cc.Invoke(ctx, Finance_UpdateTransactionRule_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// FinanceServer is the server API for Finance service.
-// All implementations should embed UnimplementedFinanceServer
-// for forward compatibility.
-//
-// Finance service provides APIs for budgeting, accounts management, transaction
-// recording, daily exchange rate configurations, and analytics insights.
-type FinanceServer interface {
-	// Configures the base currency of a space. The base currency acts as the unified
-	// currency in which financial insights and multi-currency conversions are performed.
-	ConfigureFinance(context.Context, *ConfigureFinanceRequest) (*FinanceSettings, error)
-	// Retrieves the current finance settings, including the configured base currency.
-	GetFinanceSettings(context.Context, *GetFinanceSettingsRequest) (*FinanceSettings, error)
-	// Creates a budget category template. Budgets track spent thresholds over repeating intervals.
-	CreateBudget(context.Context, *CreateBudgetRequest) (*Budget, error)
-	// Retrieves details of a specific budget category template.
-	GetBudget(context.Context, *GetBudgetRequest) (*Budget, error)
-	// Updates an existing budget template's properties, such as visual parameters or limit propagation policies.
-	UpdateBudget(context.Context, *UpdateBudgetRequest) (*Budget, error)
-	// Deletes a budget template. Only inactive budgets or budgets without active transactions can be deleted.
-	DeleteBudget(context.Context, *DeleteBudgetRequest) (*emptypb.Empty, error)
-	// Lists budget templates inside the space, supporting paginated, sorted, and text-based filter views.
-	ListBudgets(context.Context, *ListBudgetsRequest) (*ListBudgetsResponse, error)
-	// Retrieves or automatically spawns the active budget period containing current spend limits for a target date.
-	GetBudgetPeriod(context.Context, *GetBudgetPeriodRequest) (*BudgetPeriod, error)
-	// Registers a daily exchange rate conversion coefficient between two currencies.
-	CreateExchangeRate(context.Context, *CreateExchangeRateRequest) (*ExchangeRate, error)
-	// Retrieves a specific daily exchange rate conversion rule by ID.
-	GetExchangeRate(context.Context, *GetExchangeRateRequest) (*ExchangeRate, error)
-	// Updates an existing daily exchange rate conversion coefficient.
-	UpdateExchangeRate(context.Context, *UpdateExchangeRateRequest) (*ExchangeRate, error)
-	// Lists historical exchange rate conversion coefficients.
-	ListExchangeRates(context.Context, *ListExchangeRatesRequest) (*ListExchangeRatesResponse, error)
-	// Deletes a registered daily exchange rate conversion rule.
-	DeleteExchangeRate(context.Context, *DeleteExchangeRateRequest) (*emptypb.Empty, error)
-	// Logs a new expense transaction, updating target budget periods and reducing account balances.
-	CreateExpense(context.Context, *CreateExpenseRequest) (*Transaction, error)
-	// Modifies properties of a logged expense transaction. Re-calculates budget and currency conversions.
-	UpdateExpense(context.Context, *UpdateExpenseRequest) (*Transaction, error)
-	// Deletes a transaction, reversing all changes to budget period consumption and account balances.
-	DeleteTransaction(context.Context, *DeleteTransactionRequest) (*emptypb.Empty, error)
-	// Lists paginated transaction records matching specific budget, account, source, or text queries.
-	ListTransactions(context.Context, *ListTransactionsRequest) (*ListTransactionsResponse, error)
-	// Retrieves details of a specific logged transaction record by ID.
-	GetTransaction(context.Context, *GetTransactionRequest) (*Transaction, error)
-	// Lists historical lifecycle events tracking mutations and updates applied to a transaction.
-	ListTransactionEvents(context.Context, *ListTransactionEventsRequest) (*ListTransactionEventsResponse, error)
-	// Aggregates space spend patterns, limits, remaining budgets, burn rates, and budget category distributions.
-	GetInsights(context.Context, *GetInsightsRequest) (*GetInsightsResponse, error)
-	// Registers a recurring expense template, generating repeating payment obligations periodically.
-	CreateRecurringExpense(context.Context, *CreateRecurringExpenseRequest) (*RecurringExpense, error)
-	// Updates an active recurring expense template.
-	UpdateRecurringExpense(context.Context, *UpdateRecurringExpenseRequest) (*RecurringExpense, error)
-	// Deletes a recurring template, preventing any future payment instances from spawning.
-	DeleteRecurringExpense(context.Context, *DeleteRecurringExpenseRequest) (*emptypb.Empty, error)
-	// Lists all configured recurring templates in the space.
-	ListRecurringExpenses(context.Context, *ListRecurringExpensesRequest) (*ListRecurringExpensesResponse, error)
-	// Lists scheduled payment instances spawned by recurring templates.
-	ListScheduledPayments(context.Context, *ListScheduledPaymentsRequest) (*ListScheduledPaymentsResponse, error)
-	// Retrieves details of a specific scheduled payment by ID.
-	GetScheduledPayment(context.Context, *GetScheduledPaymentRequest) (*ScheduledPayment, error)
-	// Clears a scheduled payment instance, converting it into a permanent, reconciled ledger transaction.
-	ConfirmScheduledPayment(context.Context, *ConfirmScheduledPaymentRequest) (*Transaction, error)
-	// Links an existing transaction with a pending scheduled payment, marking it cleared.
-	MatchScheduledPayment(context.Context, *MatchScheduledPaymentRequest) (*Transaction, error)
-	// Dismisses a pending scheduled payment instance without creating a transaction.
-	SkipScheduledPayment(context.Context, *SkipScheduledPaymentRequest) (*ScheduledPayment, error)
-	// Logs a new personal debt agreement (lent or borrowed money).
-	CreateBorrowing(context.Context, *CreateBorrowingRequest) (*Borrowing, error)
-	// Retrieves detail metrics of a borrowing record.
-	GetBorrowing(context.Context, *GetBorrowingRequest) (*Borrowing, error)
-	// Lists active or paid-off personal debt agreements.
-	ListBorrowings(context.Context, *ListBorrowingsRequest) (*ListBorrowingsResponse, error)
-	// Updates properties of a borrowing record (such as counterparty contact details).
-	UpdateBorrowing(context.Context, *UpdateBorrowingRequest) (*Borrowing, error)
-	// Deletes a borrowing record and all corresponding repayments.
-	DeleteBorrowing(context.Context, *DeleteBorrowingRequest) (*emptypb.Empty, error)
-	// Logs a repayment installment reducing the remaining amount of an active debt agreement.
-	CreateBorrowingRepayment(context.Context, *CreateBorrowingRepaymentRequest) (*BorrowingRepayment, error)
-	// Lists all repayment installments registered under a debt agreement.
-	ListBorrowingRepayments(context.Context, *ListBorrowingRepaymentsRequest) (*ListBorrowingRepaymentsResponse, error)
-	// Deletes a borrowing repayment, restoring the debt balance of the borrowing agreement.
-	DeleteBorrowingRepayment(context.Context, *DeleteBorrowingRepaymentRequest) (*emptypb.Empty, error)
-	// Registers a new payment or liquidity account (such as cash, bank, or credit card).
-	CreateAccount(context.Context, *CreateAccountRequest) (*Account, error)
-	// Retrieves details of a specific payment account.
-	GetAccount(context.Context, *GetAccountRequest) (*Account, error)
-	// Updates details of a payment account (such as notes, active flags, or default overrides).
-	UpdateAccount(context.Context, *UpdateAccountRequest) (*Account, error)
-	// Adjusts an account's live balance to a target amount by creating a system reconciliation transaction.
-	AdjustAccountBalance(context.Context, *AdjustAccountBalanceRequest) (*Account, error)
-	// Deletes a payment account. Default accounts cannot be deleted until another default is nominated.
-	DeleteAccount(context.Context, *DeleteAccountRequest) (*emptypb.Empty, error)
-	// Lists payment accounts in the workspace, supporting optional currency conversion hydration.
-	ListAccounts(context.Context, *ListAccountsRequest) (*ListAccountsResponse, error)
-	// Logs a transfer transaction, shifting funds between a source and destination account.
-	CreateTransfer(context.Context, *CreateTransferRequest) (*Transfer, error)
-	// Lists all transfer transactions registered in the workspace.
-	ListTransfers(context.Context, *ListTransfersRequest) (*ListTransfersResponse, error)
-	// Retrieves a static list of supported currencies.
-	ListCurrencies(context.Context, *ListCurrenciesRequest) (*ListCurrenciesResponse, error)
-	// Lists staging inbox items queue awaiting manual approval or transaction matching.
-	ListInboxItems(context.Context, *ListInboxItemsRequest) (*ListInboxItemsResponse, error)
-	// Updates a staged inbox item's draft properties.
-	UpdateInboxItem(context.Context, *UpdateInboxItemRequest) (*InboxItem, error)
-	// Approves a staged inbox item and commits it to the ledger, returning the updated item with links.
-	ApproveInboxItem(context.Context, *ApproveInboxItemRequest) (*InboxItem, error)
-	// Discards an ingested inbox item from the staging queue, preventing classification.
-	DiscardInboxItem(context.Context, *DiscardInboxItemRequest) (*emptypb.Empty, error)
+func (c *financeClient) DeleteTransactionRule(ctx context.Context, in *DeleteTransactionRuleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Finance_DeleteTransactionRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedFinanceServer should be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedFinanceServer struct{}
+func (c *financeClient) ListTransactionRules(ctx context.Context, in *ListTransactionRulesRequest, opts ...grpc.CallOption) (*ListTransactionRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTransactionRulesResponse)
+	err := c.cc.Invoke(ctx, Finance_ListTransactionRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-func (UnimplementedFinanceServer) ConfigureFinance(context.Context, *ConfigureFinanceRequest) (*FinanceSettings, error) {
-	return nil, status.Error(codes.Unimplemented, "method ConfigureFinance not implemented")
+func (c *financeClient) ApplyTransactionRules(ctx context.Context, in *ApplyTransactionRulesRequest, opts ...grpc.CallOption) (*ApplyTransactionRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApplyTransactionRulesResponse)
+	err := c.cc.Invoke(ctx, Finance_ApplyTransactionRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedFinanceServer) GetFinanceSettings(context.Context, *GetFinanceSettingsRequest) (*FinanceSettings, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetFinanceSettings not implemented")
+
+func (c *financeClient) GetTransaction(ctx context.Context, in *GetTransactionRequest, opts ...grpc.CallOption) (*Transaction, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Transaction)
+	err := c.cc.Invoke(ctx, Finance_GetTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedFinanceServer) CreateBudget(context.Context, *CreateBudgetRequest) (*Budget, error) {
-	return nil, status.Error(codes.Unimplemented, "method CreateBudget not implemented")
+
+func (c *financeClient) ListTransactionEvents(ctx context.Context, in *ListTransactionEventsRequest, opts ...grpc.CallOption) (*ListTransactionEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTransactionEventsResponse)
+	err := c.cc.Invoke(ctx, Finance_ListTransactionEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedFinanceServer) GetBudget(context.Context, *GetBudgetRequest) (*Budget, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetBudget not implemented")
+
+func (c *financeClient) GetInsights(ctx context.Context, in *GetInsightsRequest, opts ...grpc.CallOption) (*GetInsightsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetInsightsResponse)
+	err := c.cc.Invoke(ctx, Finance_GetInsights_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedFinanceServer) UpdateBudget(context.Context, *UpdateBudgetRequest) (*Budget, error) {
-	return nil, status.Error(codes.Unimplemented, "method UpdateBudget not implemented")
+
+func (c *financeClient) ForecastCashFlow(ctx context.Context, in *ForecastCashFlowRequest, opts ...grpc.CallOption) (*ForecastCashFlowResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ForecastCashFlowResponse)
+	err := c.cc.Invoke(ctx, Finance_ForecastCashFlow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedFinanceServer) DeleteBudget(context.Context, *DeleteBudgetRequest) (*emptypb.Empty, error) {
-	return nil, status.Error(codes.Unimplemented, "method DeleteBudget not implemented")
+
+func (c *financeClient) ListAlerts(ctx context.Context, in *ListAlertsRequest, opts ...grpc.CallOption) (*ListAlertsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAlertsResponse)
+	err := c.cc.Invoke(ctx, Finance_ListAlerts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedFinanceServer) ListBudgets(context.Context, *ListBudgetsRequest) (*ListBudgetsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ListBudgets not implemented")
+
+func (c *financeClient) GetAlert(ctx context.Context, in *GetAlertRequest, opts ...grpc.CallOption) (*Alert, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Alert)
+	err := c.cc.Invoke(ctx, Finance_GetAlert_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedFinanceServer) GetBudgetPeriod(context.Context, *GetBudgetPeriodRequest) (*BudgetPeriod, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetBudgetPeriod not implemented")
+
+func (c *financeClient) AcknowledgeAlert(ctx context.Context, in *AcknowledgeAlertRequest, opts ...grpc.CallOption) (*Alert, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Alert)
+	err := c.cc.Invoke(ctx, Finance_AcknowledgeAlert_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedFinanceServer) CreateExchangeRate(context.Context, *CreateExchangeRateRequest) (*ExchangeRate, error) {
-	return nil, status.Error(codes.Unimplemented, "method CreateExchangeRate not implemented")
+
+func (c *financeClient) SnoozeAlert(ctx context.Context, in *SnoozeAlertRequest, opts ...grpc.CallOption) (*Alert, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Alert)
+	err := c.cc.Invoke(ctx, Finance_SnoozeAlert_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedFinanceServer) GetExchangeRate(context.Context, *GetExchangeRateRequest) (*ExchangeRate, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetExchangeRate not implemented")
+
+func (c *financeClient) CreateRecurringExpense(ctx context.Context, in *CreateRecurringExpenseRequest, opts ...grpc.CallOption) (*RecurringExpense, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecurringExpense)
+	err := c.cc.Invoke(ctx, Finance_CreateRecurringExpense_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedFinanceServer) UpdateExchangeRate(context.Context, *UpdateExchangeRateRequest) (*ExchangeRate, error) {
-	return nil, status.Error(codes.Unimplemented, "method UpdateExchangeRate not implemented")
+
+func (c *financeClient) UpdateRecurringExpense(ctx context.Context, in *UpdateRecurringExpenseRequest, opts ...grpc.CallOption) (*RecurringExpense, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecurringExpense)
+	err := c.cc.Invoke(ctx, Finance_UpdateRecurringExpense_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedFinanceServer) ListExchangeRates(context.Context, *ListExchangeRatesRequest) (*ListExchangeRatesResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ListExchangeRates not implemented")
+
+func (c *financeClient) DeleteRecurringExpense(ctx context.Context, in *DeleteRecurringExpenseRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Finance_DeleteRecurringExpense_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedFinanceServer) DeleteExchangeRate(context.Context, *DeleteExchangeRateRequest) (*emptypb.Empty, error) {
-	return nil, status.Error(codes.Unimplemented, "method DeleteExchangeRate not implemented")
+
+func (c *financeClient) ListRecurringExpenses(ctx context.Context, in *ListRecurringExpensesRequest, opts ...grpc.CallOption) (*ListRecurringExpensesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRecurringExpensesResponse)
+	err := c.cc.Invoke(ctx, Finance_ListRecurringExpenses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedFinanceServer) CreateExpense(context.Context, *CreateExpenseRequest) (*Transaction, error) {
-	return nil, status.Error(codes.Unimplemented, "method CreateExpense not implemented")
+
+func (c *financeClient) DetectSubscriptions(ctx context.Context, in *DetectSubscriptionsRequest, opts ...grpc.CallOption) (*DetectSubscriptionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DetectSubscriptionsResponse)
+	err := c.cc.Invoke(ctx, Finance_DetectSubscriptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedFinanceServer) UpdateExpense(context.Context, *UpdateExpenseRequest) (*Transaction, error) {
-	return nil, status.Error(codes.Unimplemented, "method UpdateExpense not implemented")
+
+func (c *financeClient) ConvertToRecurringExpense(ctx context.Context, in *ConvertToRecurringExpenseRequest, opts ...grpc.CallOption) (*RecurringExpense, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecurringExpense)
+	err := c.cc.Invoke(ctx, Finance_ConvertToRecurringExpense_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedFinanceServer) DeleteTransaction(context.Context, *DeleteTransactionRequest) (*emptypb.Empty, error) {
-	return nil, status.Error(codes.Unimplemented, "method DeleteTransaction not implemented")
+
+func (c *financeClient) ListScheduledPayments(ctx context.Context, in *ListScheduledPaymentsRequest, opts ...grpc.CallOption) (*ListScheduledPaymentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListScheduledPaymentsResponse)
+	err := c.cc.Invoke(ctx, Finance_ListScheduledPayments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedFinanceServer) ListTransactions(context.Context, *ListTransactionsRequest) (*ListTransactionsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ListTransactions not implemented")
+
+func (c *financeClient) GetScheduledPayment(ctx context.Context, in *GetScheduledPaymentRequest, opts ...grpc.CallOption) (*ScheduledPayment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScheduledPayment)
+	err := c.cc.Invoke(ctx, Finance_GetScheduledPayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedFinanceServer) GetTransaction(context.Context, *GetTransactionRequest) (*Transaction, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetTransaction not implemented")
+
+func (c *financeClient) ConfirmScheduledPayment(ctx context.Context, in *ConfirmScheduledPaymentRequest, opts ...grpc.CallOption) (*Transaction, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Transaction)
+	err := c.cc.Invoke(ctx, Finance_ConfirmScheduledPayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedFinanceServer) ListTransactionEvents(context.Context, *ListTransactionEventsRequest) (*ListTransactionEventsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ListTransactionEvents not implemented")
+
+func (c *financeClient) MatchScheduledPayment(ctx context.Context, in *MatchScheduledPaymentRequest, opts ...grpc.CallOption) (*Transaction, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Transaction)
+	err := c.cc.Invoke(ctx, Finance_MatchScheduledPayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedFinanceServer) GetInsights(context.Context, *GetInsightsRequest) (*GetInsightsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetInsights not implemented")
+
+func (c *financeClient) SkipScheduledPayment(ctx context.Context, in *SkipScheduledPaymentRequest, opts ...grpc.CallOption) (*ScheduledPayment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScheduledPayment)
+	err := c.cc.Invoke(ctx, Finance_SkipScheduledPayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedFinanceServer) CreateRecurringExpense(context.Context, *CreateRecurringExpenseRequest) (*RecurringExpense, error) {
-	return nil, status.Error(codes.Unimplemented, "method CreateRecurringExpense not implemented")
+
+func (c *financeClient) CreateBorrowing(ctx context.Context, in *CreateBorrowingRequest, opts ...grpc.CallOption) (*Borrowing, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Borrowing)
+	err := c.cc.Invoke(ctx, Finance_CreateBorrowing_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedFinanceServer) UpdateRecurringExpense(context.Context, *UpdateRecurringExpenseRequest) (*RecurringExpense, error) {
-	return nil, status.Error(codes.Unimplemented, "method UpdateRecurringExpense not implemented")
+
+func (c *financeClient) GetBorrowing(ctx context.Context, in *GetBorrowingRequest, opts ...grpc.CallOption) (*Borrowing, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Borrowing)
+	err := c.cc.Invoke(ctx, Finance_GetBorrowing_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedFinanceServer) DeleteRecurringExpense(context.Context, *DeleteRecurringExpenseRequest) (*emptypb.Empty, error) {
-	return nil, status.Error(codes.Unimplemented, "method DeleteRecurringExpense not implemented")
+
+func (c *financeClient) ListBorrowings(ctx context.Context, in *ListBorrowingsRequest, opts ...grpc.CallOption) (*ListBorrowingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBorrowingsResponse)
+	err := c.cc.Invoke(ctx, Finance_ListBorrowings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedFinanceServer) ListRecurringExpenses(context.Context, *ListRecurringExpensesRequest) (*ListRecurringExpensesResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ListRecurringExpenses not implemented")
+
+func (c *financeClient) UpdateBorrowing(ctx context.Context, in *UpdateBorrowingRequest, opts ...grpc.CallOption) (*Borrowing, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Borrowing)
+	err := c.cc.Invoke(ctx, Finance_UpdateBorrowing_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedFinanceServer) ListScheduledPayments(context.Context, *ListScheduledPaymentsRequest) (*ListScheduledPaymentsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ListScheduledPayments not implemented")
+
+func (c *financeClient) DeleteBorrowing(ctx context.Context, in *DeleteBorrowingRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Finance_DeleteBorrowing_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedFinanceServer) GetScheduledPayment(context.Context, *GetScheduledPaymentRequest) (*ScheduledPayment, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetScheduledPayment not implemented")
+
+func (c *financeClient) CreateBorrowingRepayment(ctx context.Context, in *CreateBorrowingRepaymentRequest, opts ...grpc.CallOption) (*BorrowingRepayment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BorrowingRepayment)
+	err := c.cc.Invoke(ctx, Finance_CreateBorrowingRepayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedFinanceServer) ConfirmScheduledPayment(context.Context, *ConfirmScheduledPaymentRequest) (*Transaction, error) {
+
+func (c *financeClient) ListBorrowingRepayments(ctx context.Context, in *ListBorrowingRepaymentsRequest, opts ...grpc.CallOption) (*ListBorrowingRepaymentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBorrowingRepaymentsResponse)
+	err := c.cc.Invoke(ctx, Finance_ListBorrowingRepayments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeClient) DeleteBorrowingRepayment(ctx context.Context, in *DeleteBorrowingRepaymentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Finance_DeleteBorrowingRepayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeClient) CreateAccount(ctx context.Context, in *CreateAccountRequest, opts ...grpc.CallOption) (*Account, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Account)
+	err := c.cc.Invoke(ctx, Finance_CreateAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeClient) GetAccount(ctx context.Context, in *GetAccountRequest, opts ...grpc.CallOption) (*Account, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Account)
+	err := c.cc.Invoke(ctx, Finance_GetAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeClient) UpdateAccount(ctx context.Context, in *UpdateAccountRequest, opts ...grpc.CallOption) (*Account, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Account)
+	err := c.cc.Invoke(ctx, Finance_UpdateAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeClient) AdjustAccountBalance(ctx context.Context, in *AdjustAccountBalanceRequest, opts ...grpc.CallOption) (*Account, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Account)
+	err := c.cc.Invoke(ctx, Finance_AdjustAccountBalance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeClient) ListCardStatements(ctx context.Context, in *ListCardStatementsRequest, opts ...grpc.CallOption) (*ListCardStatementsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCardStatementsResponse)
+	err := c.cc.Invoke(ctx, Finance_ListCardStatements_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeClient) GetCardStatement(ctx context.Context, in *GetCardStatementRequest, opts ...grpc.CallOption) (*CardStatement, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CardStatement)
+	err := c.cc.Invoke(ctx, Finance_GetCardStatement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeClient) StartReconciliation(ctx context.Context, in *StartReconciliationRequest, opts ...grpc.CallOption) (*Reconciliation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Reconciliation)
+	err := c.cc.Invoke(ctx, Finance_StartReconciliation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeClient) GetReconciliation(ctx context.Context, in *GetReconciliationRequest, opts ...grpc.CallOption) (*Reconciliation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Reconciliation)
+	err := c.cc.Invoke(ctx, Finance_GetReconciliation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeClient) ListReconciliations(ctx context.Context, in *ListReconciliationsRequest, opts ...grpc.CallOption) (*ListReconciliationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReconciliationsResponse)
+	err := c.cc.Invoke(ctx, Finance_ListReconciliations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeClient) ListReconciliationTransactions(ctx context.Context, in *ListReconciliationTransactionsRequest, opts ...grpc.CallOption) (*ListReconciliationTransactionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReconciliationTransactionsResponse)
+	err := c.cc.Invoke(ctx, Finance_ListReconciliationTransactions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeClient) SetTransactionsCleared(ctx context.Context, in *SetTransactionsClearedRequest, opts ...grpc.CallOption) (*Reconciliation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Reconciliation)
+	err := c.cc.Invoke(ctx, Finance_SetTransactionsCleared_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeClient) FinalizeReconciliation(ctx context.Context, in *FinalizeReconciliationRequest, opts ...grpc.CallOption) (*Reconciliation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Reconciliation)
+	err := c.cc.Invoke(ctx, Finance_FinalizeReconciliation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeClient) CancelReconciliation(ctx context.Context, in *CancelReconciliationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Finance_CancelReconciliation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeClient) DeleteAccount(ctx context.Context, in *DeleteAccountRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Finance_DeleteAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeClient) ListAccounts(ctx context.Context, in *ListAccountsRequest, opts ...grpc.CallOption) (*ListAccountsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAccountsResponse)
+	err := c.cc.Invoke(ctx, Finance_ListAccounts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeClient) CreateTransfer(ctx context.Context, in *CreateTransferRequest, opts ...grpc.CallOption) (*Transfer, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Transfer)
+	err := c.cc.Invoke(ctx, Finance_CreateTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeClient) ListTransfers(ctx context.Context, in *ListTransfersRequest, opts ...grpc.CallOption) (*ListTransfersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTransfersResponse)
+	err := c.cc.Invoke(ctx, Finance_ListTransfers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeClient) ListCurrencies(ctx context.Context, in *ListCurrenciesRequest, opts ...grpc.CallOption) (*ListCurrenciesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCurrenciesResponse)
+	err := c.cc.Invoke(ctx, Finance_ListCurrencies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeClient) ListInboxItems(ctx context.Context, in *ListInboxItemsRequest, opts ...grpc.CallOption) (*ListInboxItemsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListInboxItemsResponse)
+	err := c.cc.Invoke(ctx, Finance_ListInboxItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeClient) UpdateInboxItem(ctx context.Context, in *UpdateInboxItemRequest, opts ...grpc.CallOption) (*InboxItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InboxItem)
+	err := c.cc.Invoke(ctx, Finance_UpdateInboxItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeClient) ApproveInboxItem(ctx context.Context, in *ApproveInboxItemRequest, opts ...grpc.CallOption) (*InboxItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InboxItem)
+	err := c.cc.Invoke(ctx, Finance_ApproveInboxItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeClient) DiscardInboxItem(ctx context.Context, in *DiscardInboxItemRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Finance_DiscardInboxItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeClient) ImportStatement(ctx context.Context, in *ImportStatementRequest, opts ...grpc.CallOption) (*ImportStatementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportStatementResponse)
+	err := c.cc.Invoke(ctx, Finance_ImportStatement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeClient) GetStatementMapping(ctx context.Context, in *GetStatementMappingRequest, opts ...grpc.CallOption) (*StatementMapping, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StatementMapping)
+	err := c.cc.Invoke(ctx, Finance_GetStatementMapping_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeClient) ExportLedger(ctx context.Context, in *ExportLedgerRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[LedgerExportChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Finance_ServiceDesc.Streams[0], Finance_ExportLedger_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportLedgerRequest, LedgerExportChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Finance_ExportLedgerClient = grpc.ServerStreamingClient[LedgerExportChunk]
+
+func (c *financeClient) CreateLedgerExport(ctx context.Context, in *CreateLedgerExportRequest, opts ...grpc.CallOption) (*LedgerExport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LedgerExport)
+	err := c.cc.Invoke(ctx, Finance_CreateLedgerExport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeClient) GetLedgerExport(ctx context.Context, in *GetLedgerExportRequest, opts ...grpc.CallOption) (*LedgerExport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LedgerExport)
+	err := c.cc.Invoke(ctx, Finance_GetLedgerExport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeClient) ListLedgerExports(ctx context.Context, in *ListLedgerExportsRequest, opts ...grpc.CallOption) (*ListLedgerExportsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLedgerExportsResponse)
+	err := c.cc.Invoke(ctx, Finance_ListLedgerExports_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeClient) DownloadLedgerExport(ctx context.Context, in *DownloadLedgerExportRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[LedgerExportChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Finance_ServiceDesc.Streams[1], Finance_DownloadLedgerExport_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[DownloadLedgerExportRequest, LedgerExportChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Finance_DownloadLedgerExportClient = grpc.ServerStreamingClient[LedgerExportChunk]
+
+// FinanceServer is the server API for Finance service.
+// All implementations should embed UnimplementedFinanceServer
+// for forward compatibility.
+//
+// Finance service provides APIs for budgeting, accounts management, transaction
+// recording, daily exchange rate configurations, and analytics insights.
+type FinanceServer interface {
+	// Configures the base currency of a space. The base currency acts as the unified
+	// currency in which financial insights and multi-currency conversions are performed.
+	ConfigureFinance(context.Context, *ConfigureFinanceRequest) (*FinanceSettings, error)
+	// Retrieves the current finance settings, including the configured base currency.
+	GetFinanceSettings(context.Context, *GetFinanceSettingsRequest) (*FinanceSettings, error)
+	// Updates mutable finance settings, such as the currencies enabled for the workspace.
+	// Restricted to space owners and admins. The base currency cannot be changed.
+	UpdateFinanceSettings(context.Context, *UpdateFinanceSettingsRequest) (*FinanceSettings, error)
+	// Creates a budget category template. Budgets track spent thresholds over repeating intervals.
+	CreateBudget(context.Context, *CreateBudgetRequest) (*Budget, error)
+	// Retrieves details of a specific budget category template.
+	GetBudget(context.Context, *GetBudgetRequest) (*Budget, error)
+	// Updates an existing budget template's properties, such as visual parameters or limit propagation policies.
+	UpdateBudget(context.Context, *UpdateBudgetRequest) (*Budget, error)
+	// Deletes a budget template. Only inactive budgets or budgets without active transactions can be deleted.
+	DeleteBudget(context.Context, *DeleteBudgetRequest) (*emptypb.Empty, error)
+	// Lists budget templates inside the space, supporting paginated, sorted, and text-based filter views.
+	ListBudgets(context.Context, *ListBudgetsRequest) (*ListBudgetsResponse, error)
+	// Retrieves or automatically spawns the active budget period containing current spend limits for a target date.
+	GetBudgetPeriod(context.Context, *GetBudgetPeriodRequest) (*BudgetPeriod, error)
+	// Registers a daily exchange rate conversion coefficient between two currencies.
+	CreateExchangeRate(context.Context, *CreateExchangeRateRequest) (*ExchangeRate, error)
+	// Retrieves a specific daily exchange rate conversion rule by ID.
+	GetExchangeRate(context.Context, *GetExchangeRateRequest) (*ExchangeRate, error)
+	// Updates an existing daily exchange rate conversion coefficient.
+	UpdateExchangeRate(context.Context, *UpdateExchangeRateRequest) (*ExchangeRate, error)
+	// Lists historical exchange rate conversion coefficients.
+	ListExchangeRates(context.Context, *ListExchangeRatesRequest) (*ListExchangeRatesResponse, error)
+	// Deletes a registered daily exchange rate conversion rule.
+	DeleteExchangeRate(context.Context, *DeleteExchangeRateRequest) (*emptypb.Empty, error)
+	// Logs a new expense transaction, updating target budget periods and reducing account balances.
+	CreateExpense(context.Context, *CreateExpenseRequest) (*Transaction, error)
+	// Modifies properties of a logged expense transaction. Re-calculates budget and currency conversions.
+	UpdateExpense(context.Context, *UpdateExpenseRequest) (*Transaction, error)
+	// Logs a new income transaction (such as a paycheck), increasing the balance of the receiving account.
+	CreateIncome(context.Context, *CreateIncomeRequest) (*Transaction, error)
+	// Modifies properties of a logged income transaction. Re-calculates currency conversions and account balances.
+	UpdateIncome(context.Context, *UpdateIncomeRequest) (*Transaction, error)
+	// Registers an income source, such as an employer paying a recurring salary.
+	CreateIncomeSource(context.Context, *CreateIncomeSourceRequest) (*IncomeSource, error)
+	// Retrieves details of a specific income source.
+	GetIncomeSource(context.Context, *GetIncomeSourceRequest) (*IncomeSource, error)
+	// Updates an income source's employer, expected amount, cadence, or deposit account.
+	UpdateIncomeSource(context.Context, *UpdateIncomeSourceRequest) (*IncomeSource, error)
+	// Deletes an income source. Income transactions previously linked to it are preserved.
+	DeleteIncomeSource(context.Context, *DeleteIncomeSourceRequest) (*emptypb.Empty, error)
+	// Lists income sources configured in the space.
+	ListIncomeSources(context.Context, *ListIncomeSourcesRequest) (*ListIncomeSourcesResponse, error)
+	// Creates a savings goal funded by transfers into a linked account or by tagged transactions.
+	CreateGoal(context.Context, *CreateGoalRequest) (*Goal, error)
+	// Retrieves details of a specific savings goal.
+	GetGoal(context.Context, *GetGoalRequest) (*Goal, error)
+	// Updates a goal's target, deadline, contribution sources, or status.
+	UpdateGoal(context.Context, *UpdateGoalRequest) (*Goal, error)
+	// Deletes a savings goal. Contributing transactions and transfers are preserved.
+	DeleteGoal(context.Context, *DeleteGoalRequest) (*emptypb.Empty, error)
+	// Lists savings goals configured in the space.
+	ListGoals(context.Context, *ListGoalsRequest) (*ListGoalsResponse, error)
+	// Computes a goal's saved amount, contribution history, and projected completion date.
+	GetGoalProgress(context.Context, *GetGoalProgressRequest) (*GoalProgress, error)
+	// Creates a transaction category, optionally nested under a parent category.
+	CreateCategory(context.Context, *CreateCategoryRequest) (*Category, error)
+	// Retrieves details of a specific category.
+	GetCategory(context.Context, *GetCategoryRequest) (*Category, error)
+	// Updates a category's name, appearance, or parent.
+	UpdateCategory(context.Context, *UpdateCategoryRequest) (*Category, error)
+	// Deletes a category. Subcategories become top-level and linked transactions become uncategorized.
+	DeleteCategory(context.Context, *DeleteCategoryRequest) (*emptypb.Empty, error)
+	// Lists categories configured in the space.
+	ListCategories(context.Context, *ListCategoriesRequest) (*ListCategoriesResponse, error)
+	// Deletes a transaction, reversing all changes to budget period consumption and account balances.
+	DeleteTransaction(context.Context, *DeleteTransactionRequest) (*emptypb.Empty, error)
+	// Lists paginated transaction records matching specific budget, account, source, or text queries.
+	ListTransactions(context.Context, *ListTransactionsRequest) (*ListTransactionsResponse, error)
+	// Applies the same field changes to many expenses and income atomically, selected by ID or by filter.
+	// Account balances are adjusted once by the net change of the batch.
+	BatchUpdateTransactions(context.Context, *BatchUpdateTransactionsRequest) (*BatchUpdateTransactionsResponse, error)
+	// Deletes many expenses and income atomically, selected by ID or by filter.
+	BatchDeleteTransactions(context.Context, *BatchDeleteTransactionsRequest) (*BatchDeleteTransactionsResponse, error)
+	// Saves a named transaction filter expression that can be reused when listing transactions.
+	CreateSavedFilter(context.Context, *CreateSavedFilterRequest) (*SavedFilter, error)
+	// Retrieves details of a specific saved filter.
+	GetSavedFilter(context.Context, *GetSavedFilterRequest) (*SavedFilter, error)
+	// Updates a saved filter's name, expression, or default sort.
+	UpdateSavedFilter(context.Context, *UpdateSavedFilterRequest) (*SavedFilter, error)
+	// Deletes a saved filter.
+	DeleteSavedFilter(context.Context, *DeleteSavedFilterRequest) (*emptypb.Empty, error)
+	// Lists saved filters configured in the space.
+	ListSavedFilters(context.Context, *ListSavedFiltersRequest) (*ListSavedFiltersResponse, error)
+	// Creates a payee that transaction descriptions are normalized to.
+	CreatePayee(context.Context, *CreatePayeeRequest) (*Payee, error)
+	// Retrieves details of a specific payee.
+	GetPayee(context.Context, *GetPayeeRequest) (*Payee, error)
+	// Updates a payee's name, matchers, defaults, or logo.
+	UpdatePayee(context.Context, *UpdatePayeeRequest) (*Payee, error)
+	// Deletes a payee. Transactions linked to it keep their descriptions.
+	DeletePayee(context.Context, *DeletePayeeRequest) (*emptypb.Empty, error)
+	// Lists payees configured in the space.
+	ListPayees(context.Context, *ListPayeesRequest) (*ListPayeesResponse, error)
+	// Creates a rule that assigns a budget, payee, category, or tags to matching transactions
+	// when they are created, imported, or ingested.
+	CreateTransactionRule(context.Context, *CreateTransactionRuleRequest) (*TransactionRule, error)
+	// Retrieves details of a specific transaction rule.
+	GetTransactionRule(context.Context, *GetTransactionRuleRequest) (*TransactionRule, error)
+	// Updates a transaction rule's conditions, actions, priority, or active state.
+	UpdateTransactionRule(context.Context, *UpdateTransactionRuleRequest) (*TransactionRule, error)
+	// Deletes a transaction rule. Transactions it already classified are unchanged.
+	DeleteTransactionRule(context.Context, *DeleteTransactionRuleRequest) (*emptypb.Empty, error)
+	// Lists transaction rules configured in the space, by ascending priority by default.
+	ListTransactionRules(context.Context, *ListTransactionRulesRequest) (*ListTransactionRulesResponse, error)
+	// Re-runs the space's active rules and payees over existing expenses and income.
+	ApplyTransactionRules(context.Context, *ApplyTransactionRulesRequest) (*ApplyTransactionRulesResponse, error)
+	// Retrieves details of a specific logged transaction record by ID.
+	GetTransaction(context.Context, *GetTransactionRequest) (*Transaction, error)
+	// Lists historical lifecycle events tracking mutations and updates applied to a transaction.
+	ListTransactionEvents(context.Context, *ListTransactionEventsRequest) (*ListTransactionEventsResponse, error)
+	// Aggregates space spend patterns, limits, remaining budgets, burn rates, and budget category distributions.
+	GetInsights(context.Context, *GetInsightsRequest) (*GetInsightsResponse, error)
+	// Projects the balance of every active account day by day over the coming days, and flags the days
+	// an account is expected to drop below zero or below its low balance threshold.
+	ForecastCashFlow(context.Context, *ForecastCashFlowRequest) (*ForecastCashFlowResponse, error)
+	// Lists the alerts raised for the space, most recent first.
+	ListAlerts(context.Context, *ListAlertsRequest) (*ListAlertsResponse, error)
+	// Retrieves an alert by ID.
+	GetAlert(context.Context, *GetAlertRequest) (*Alert, error)
+	// Marks an alert as handled, removing it from the active alerts for good.
+	AcknowledgeAlert(context.Context, *AcknowledgeAlertRequest) (*Alert, error)
+	// Hides an alert from the active alerts until the given time.
+	SnoozeAlert(context.Context, *SnoozeAlertRequest) (*Alert, error)
+	// Registers a recurring expense template, generating repeating payment obligations periodically.
+	CreateRecurringExpense(context.Context, *CreateRecurringExpenseRequest) (*RecurringExpense, error)
+	// Updates an active recurring expense template.
+	UpdateRecurringExpense(context.Context, *UpdateRecurringExpenseRequest) (*RecurringExpense, error)
+	// Deletes a recurring template, preventing any future payment instances from spawning.
+	DeleteRecurringExpense(context.Context, *DeleteRecurringExpenseRequest) (*emptypb.Empty, error)
+	// Lists all configured recurring templates in the space.
+	ListRecurringExpenses(context.Context, *ListRecurringExpensesRequest) (*ListRecurringExpensesResponse, error)
+	// Scans the space's expense history for periodic charges with a similar vendor, a stable amount and
+	// a regular cadence that no recurring expense tracks yet.
+	DetectSubscriptions(context.Context, *DetectSubscriptionsRequest) (*DetectSubscriptionsResponse, error)
+	// Creates a recurring expense from a detected subscription and links its supporting transactions
+	// to the new template.
+	ConvertToRecurringExpense(context.Context, *ConvertToRecurringExpenseRequest) (*RecurringExpense, error)
+	// Lists scheduled payment instances spawned by recurring templates.
+	ListScheduledPayments(context.Context, *ListScheduledPaymentsRequest) (*ListScheduledPaymentsResponse, error)
+	// Retrieves details of a specific scheduled payment by ID.
+	GetScheduledPayment(context.Context, *GetScheduledPaymentRequest) (*ScheduledPayment, error)
+	// Clears a scheduled payment instance, converting it into a permanent, reconciled ledger transaction.
+	ConfirmScheduledPayment(context.Context, *ConfirmScheduledPaymentRequest) (*Transaction, error)
+	// Links an existing transaction with a pending scheduled payment, marking it cleared.
+	MatchScheduledPayment(context.Context, *MatchScheduledPaymentRequest) (*Transaction, error)
+	// Dismisses a pending scheduled payment instance without creating a transaction.
+	SkipScheduledPayment(context.Context, *SkipScheduledPaymentRequest) (*ScheduledPayment, error)
+	// Logs a new personal debt agreement (lent or borrowed money).
+	CreateBorrowing(context.Context, *CreateBorrowingRequest) (*Borrowing, error)
+	// Retrieves detail metrics of a borrowing record.
+	GetBorrowing(context.Context, *GetBorrowingRequest) (*Borrowing, error)
+	// Lists active or paid-off personal debt agreements.
+	ListBorrowings(context.Context, *ListBorrowingsRequest) (*ListBorrowingsResponse, error)
+	// Updates properties of a borrowing record (such as counterparty contact details).
+	UpdateBorrowing(context.Context, *UpdateBorrowingRequest) (*Borrowing, error)
+	// Deletes a borrowing record and all corresponding repayments.
+	DeleteBorrowing(context.Context, *DeleteBorrowingRequest) (*emptypb.Empty, error)
+	// Logs a repayment installment reducing the remaining amount of an active debt agreement.
+	CreateBorrowingRepayment(context.Context, *CreateBorrowingRepaymentRequest) (*BorrowingRepayment, error)
+	// Lists all repayment installments registered under a debt agreement.
+	ListBorrowingRepayments(context.Context, *ListBorrowingRepaymentsRequest) (*ListBorrowingRepaymentsResponse, error)
+	// Deletes a borrowing repayment, restoring the debt balance of the borrowing agreement.
+	DeleteBorrowingRepayment(context.Context, *DeleteBorrowingRepaymentRequest) (*emptypb.Empty, error)
+	// Registers a new payment or liquidity account (such as cash, bank, or credit card).
+	CreateAccount(context.Context, *CreateAccountRequest) (*Account, error)
+	// Retrieves details of a specific payment account.
+	GetAccount(context.Context, *GetAccountRequest) (*Account, error)
+	// Updates details of a payment account (such as notes, active flags, or default overrides).
+	UpdateAccount(context.Context, *UpdateAccountRequest) (*Account, error)
+	// Adjusts an account's live balance to a target amount by creating a system reconciliation transaction.
+	AdjustAccountBalance(context.Context, *AdjustAccountBalanceRequest) (*Account, error)
+	// Lists the closed statements of a credit card, most recent first.
+	ListCardStatements(context.Context, *ListCardStatementsRequest) (*ListCardStatementsResponse, error)
+	// Retrieves a closed credit card statement.
+	GetCardStatement(context.Context, *GetCardStatementRequest) (*CardStatement, error)
+	// Opens a reconciliation session matching an account's cleared transactions against a bank statement.
+	StartReconciliation(context.Context, *StartReconciliationRequest) (*Reconciliation, error)
+	// Retrieves a reconciliation session. Balances of sessions in progress are recalculated.
+	GetReconciliation(context.Context, *GetReconciliationRequest) (*Reconciliation, error)
+	// Lists the reconciliation history of an account, most recent statement first.
+	ListReconciliations(context.Context, *ListReconciliationsRequest) (*ListReconciliationsResponse, error)
+	// Lists the transactions covered by a reconciliation session.
+	ListReconciliationTransactions(context.Context, *ListReconciliationTransactionsRequest) (*ListReconciliationTransactionsResponse, error)
+	// Marks transactions as cleared, or uncleared, within a reconciliation session in progress.
+	SetTransactionsCleared(context.Context, *SetTransactionsClearedRequest) (*Reconciliation, error)
+	// Finalizes a balanced reconciliation session, locking its cleared transactions from edits.
+	FinalizeReconciliation(context.Context, *FinalizeReconciliationRequest) (*Reconciliation, error)
+	// Discards a reconciliation session in progress. Cleared flags are kept.
+	CancelReconciliation(context.Context, *CancelReconciliationRequest) (*emptypb.Empty, error)
+	// Deletes a payment account. Default accounts cannot be deleted until another default is nominated.
+	DeleteAccount(context.Context, *DeleteAccountRequest) (*emptypb.Empty, error)
+	// Lists payment accounts in the workspace, supporting optional currency conversion hydration.
+	ListAccounts(context.Context, *ListAccountsRequest) (*ListAccountsResponse, error)
+	// Logs a transfer transaction, shifting funds between a source and destination account.
+	CreateTransfer(context.Context, *CreateTransferRequest) (*Transfer, error)
+	// Lists all transfer transactions registered in the workspace.
+	ListTransfers(context.Context, *ListTransfersRequest) (*ListTransfersResponse, error)
+	// Retrieves the ISO 4217 currency catalog with minor units, flagging the currencies enabled in the workspace.
+	ListCurrencies(context.Context, *ListCurrenciesRequest) (*ListCurrenciesResponse, error)
+	// Lists staging inbox items queue awaiting manual approval or transaction matching.
+	ListInboxItems(context.Context, *ListInboxItemsRequest) (*ListInboxItemsResponse, error)
+	// Updates a staged inbox item's draft properties.
+	UpdateInboxItem(context.Context, *UpdateInboxItemRequest) (*InboxItem, error)
+	// Approves a staged inbox item and commits it to the ledger, returning the updated item with links.
+	ApproveInboxItem(context.Context, *ApproveInboxItemRequest) (*InboxItem, error)
+	// Discards an ingested inbox item from the staging queue, preventing classification.
+	DiscardInboxItem(context.Context, *DiscardInboxItemRequest) (*emptypb.Empty, error)
+	// Imports a bank statement file (CSV, OFX/QFX or CAMT.053) for an account and stages
+	// each row as an inbox item. Rows already staged by a previous import are skipped.
+	ImportStatement(context.Context, *ImportStatementRequest) (*ImportStatementResponse, error)
+	// Retrieves the saved CSV column mapping for an account.
+	GetStatementMapping(context.Context, *GetStatementMappingRequest) (*StatementMapping, error)
+	// Streams the space's accounts, budgets, transactions and transfers for a date range in the
+	// requested format. The first chunk carries the content type and suggested file name. Served
+	// over HTTP as a download at `GET /v1/finance/ledger:export`.
+	ExportLedger(*ExportLedgerRequest, grpc.ServerStreamingServer[LedgerExportChunk]) error
+	// Queues a ledger export to run as a background job. Use this for large date ranges and
+	// download the result once the export has completed.
+	CreateLedgerExport(context.Context, *CreateLedgerExportRequest) (*LedgerExport, error)
+	// Retrieves the status of a background ledger export.
+	GetLedgerExport(context.Context, *GetLedgerExportRequest) (*LedgerExport, error)
+	// Lists background ledger exports in the space, newest first.
+	ListLedgerExports(context.Context, *ListLedgerExportsRequest) (*ListLedgerExportsResponse, error)
+	// Streams the file of a completed background ledger export. Served over HTTP as a download
+	// at `GET /v1/finance/ledger-exports/{id}:download`.
+	DownloadLedgerExport(*DownloadLedgerExportRequest, grpc.ServerStreamingServer[LedgerExportChunk]) error
+}
+
+// UnimplementedFinanceServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedFinanceServer struct{}
+
+func (UnimplementedFinanceServer) ConfigureFinance(context.Context, *ConfigureFinanceRequest) (*FinanceSettings, error) {
+	return nil, status.Error(codes.Unimplemented, "method ConfigureFinance not implemented")
+}
+func (UnimplementedFinanceServer) GetFinanceSettings(context.Context, *GetFinanceSettingsRequest) (*FinanceSettings, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetFinanceSettings not implemented")
+}
+func (UnimplementedFinanceServer) UpdateFinanceSettings(context.Context, *UpdateFinanceSettingsRequest) (*FinanceSettings, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateFinanceSettings not implemented")
+}
+func (UnimplementedFinanceServer) CreateBudget(context.Context, *CreateBudgetRequest) (*Budget, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateBudget not implemented")
+}
+func (UnimplementedFinanceServer) GetBudget(context.Context, *GetBudgetRequest) (*Budget, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetBudget not implemented")
+}
+func (UnimplementedFinanceServer) UpdateBudget(context.Context, *UpdateBudgetRequest) (*Budget, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateBudget not implemented")
+}
+func (UnimplementedFinanceServer) DeleteBudget(context.Context, *DeleteBudgetRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteBudget not implemented")
+}
+func (UnimplementedFinanceServer) ListBudgets(context.Context, *ListBudgetsRequest) (*ListBudgetsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListBudgets not implemented")
+}
+func (UnimplementedFinanceServer) GetBudgetPeriod(context.Context, *GetBudgetPeriodRequest) (*BudgetPeriod, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetBudgetPeriod not implemented")
+}
+func (UnimplementedFinanceServer) CreateExchangeRate(context.Context, *CreateExchangeRateRequest) (*ExchangeRate, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateExchangeRate not implemented")
+}
+func (UnimplementedFinanceServer) GetExchangeRate(context.Context, *GetExchangeRateRequest) (*ExchangeRate, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetExchangeRate not implemented")
+}
+func (UnimplementedFinanceServer) UpdateExchangeRate(context.Context, *UpdateExchangeRateRequest) (*ExchangeRate, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateExchangeRate not implemented")
+}
+func (UnimplementedFinanceServer) ListExchangeRates(context.Context, *ListExchangeRatesRequest) (*ListExchangeRatesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListExchangeRates not implemented")
+}
+func (UnimplementedFinanceServer) DeleteExchangeRate(context.Context, *DeleteExchangeRateRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteExchangeRate not implemented")
+}
+func (UnimplementedFinanceServer) CreateExpense(context.Context, *CreateExpenseRequest) (*Transaction, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateExpense not implemented")
+}
+func (UnimplementedFinanceServer) UpdateExpense(context.Context, *UpdateExpenseRequest) (*Transaction, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateExpense not implemented")
+}
+func (UnimplementedFinanceServer) CreateIncome(context.Context, *CreateIncomeRequest) (*Transaction, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateIncome not implemented")
+}
+func (UnimplementedFinanceServer) UpdateIncome(context.Context, *UpdateIncomeRequest) (*Transaction, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateIncome not implemented")
+}
+func (UnimplementedFinanceServer) CreateIncomeSource(context.Context, *CreateIncomeSourceRequest) (*IncomeSource, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateIncomeSource not implemented")
+}
+func (UnimplementedFinanceServer) GetIncomeSource(context.Context, *GetIncomeSourceRequest) (*IncomeSource, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetIncomeSource not implemented")
+}
+func (UnimplementedFinanceServer) UpdateIncomeSource(context.Context, *UpdateIncomeSourceRequest) (*IncomeSource, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateIncomeSource not implemented")
+}
+func (UnimplementedFinanceServer) DeleteIncomeSource(context.Context, *DeleteIncomeSourceRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteIncomeSource not implemented")
+}
+func (UnimplementedFinanceServer) ListIncomeSources(context.Context, *ListIncomeSourcesRequest) (*ListIncomeSourcesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListIncomeSources not implemented")
+}
+func (UnimplementedFinanceServer) CreateGoal(context.Context, *CreateGoalRequest) (*Goal, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateGoal not implemented")
+}
+func (UnimplementedFinanceServer) GetGoal(context.Context, *GetGoalRequest) (*Goal, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetGoal not implemented")
+}
+func (UnimplementedFinanceServer) UpdateGoal(context.Context, *UpdateGoalRequest) (*Goal, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateGoal not implemented")
+}
+func (UnimplementedFinanceServer) DeleteGoal(context.Context, *DeleteGoalRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteGoal not implemented")
+}
+func (UnimplementedFinanceServer) ListGoals(context.Context, *ListGoalsRequest) (*ListGoalsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListGoals not implemented")
+}
+func (UnimplementedFinanceServer) GetGoalProgress(context.Context, *GetGoalProgressRequest) (*GoalProgress, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetGoalProgress not implemented")
+}
+func (UnimplementedFinanceServer) CreateCategory(context.Context, *CreateCategoryRequest) (*Category, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateCategory not implemented")
+}
+func (UnimplementedFinanceServer) GetCategory(context.Context, *GetCategoryRequest) (*Category, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCategory not implemented")
+}
+func (UnimplementedFinanceServer) UpdateCategory(context.Context, *UpdateCategoryRequest) (*Category, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateCategory not implemented")
+}
+func (UnimplementedFinanceServer) DeleteCategory(context.Context, *DeleteCategoryRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteCategory not implemented")
+}
+func (UnimplementedFinanceServer) ListCategories(context.Context, *ListCategoriesRequest) (*ListCategoriesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCategories not implemented")
+}
+func (UnimplementedFinanceServer) DeleteTransaction(context.Context, *DeleteTransactionRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteTransaction not implemented")
+}
+func (UnimplementedFinanceServer) ListTransactions(context.Context, *ListTransactionsRequest) (*ListTransactionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTransactions not implemented")
+}
+func (UnimplementedFinanceServer) BatchUpdateTransactions(context.Context, *BatchUpdateTransactionsRequest) (*BatchUpdateTransactionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BatchUpdateTransactions not implemented")
+}
+func (UnimplementedFinanceServer) BatchDeleteTransactions(context.Context, *BatchDeleteTransactionsRequest) (*BatchDeleteTransactionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BatchDeleteTransactions not implemented")
+}
+func (UnimplementedFinanceServer) CreateSavedFilter(context.Context, *CreateSavedFilterRequest) (*SavedFilter, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateSavedFilter not implemented")
+}
+func (UnimplementedFinanceServer) GetSavedFilter(context.Context, *GetSavedFilterRequest) (*SavedFilter, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSavedFilter not implemented")
+}
+func (UnimplementedFinanceServer) UpdateSavedFilter(context.Context, *UpdateSavedFilterRequest) (*SavedFilter, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateSavedFilter not implemented")
+}
+func (UnimplementedFinanceServer) DeleteSavedFilter(context.Context, *DeleteSavedFilterRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteSavedFilter not implemented")
+}
+func (UnimplementedFinanceServer) ListSavedFilters(context.Context, *ListSavedFiltersRequest) (*ListSavedFiltersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListSavedFilters not implemented")
+}
+func (UnimplementedFinanceServer) CreatePayee(context.Context, *CreatePayeeRequest) (*Payee, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreatePayee not implemented")
+}
+func (UnimplementedFinanceServer) GetPayee(context.Context, *GetPayeeRequest) (*Payee, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPayee not implemented")
+}
+func (UnimplementedFinanceServer) UpdatePayee(context.Context, *UpdatePayeeRequest) (*Payee, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdatePayee not implemented")
+}
+func (UnimplementedFinanceServer) DeletePayee(context.Context, *DeletePayeeRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeletePayee not implemented")
+}
+func (UnimplementedFinanceServer) ListPayees(context.Context, *ListPayeesRequest) (*ListPayeesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListPayees not implemented")
+}
+func (UnimplementedFinanceServer) CreateTransactionRule(context.Context, *CreateTransactionRuleRequest) (*TransactionRule, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateTransactionRule not implemented")
+}
+func (UnimplementedFinanceServer) GetTransactionRule(context.Context, *GetTransactionRuleRequest) (*TransactionRule, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTransactionRule not implemented")
+}
+func (UnimplementedFinanceServer) UpdateTransactionRule(context.Context, *UpdateTransactionRuleRequest) (*TransactionRule, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateTransactionRule not implemented")
+}
+func (UnimplementedFinanceServer) DeleteTransactionRule(context.Context, *DeleteTransactionRuleRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteTransactionRule not implemented")
+}
+func (UnimplementedFinanceServer) ListTransactionRules(context.Context, *ListTransactionRulesRequest) (*ListTransactionRulesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTransactionRules not implemented")
+}
+func (UnimplementedFinanceServer) ApplyTransactionRules(context.Context, *ApplyTransactionRulesRequest) (*ApplyTransactionRulesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ApplyTransactionRules not implemented")
+}
+func (UnimplementedFinanceServer) GetTransaction(context.Context, *GetTransactionRequest) (*Transaction, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTransaction not implemented")
+}
+func (UnimplementedFinanceServer) ListTransactionEvents(context.Context, *ListTransactionEventsRequest) (*ListTransactionEventsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTransactionEvents not implemented")
+}
+func (UnimplementedFinanceServer) GetInsights(context.Context, *GetInsightsRequest) (*GetInsightsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetInsights not implemented")
+}
+func (UnimplementedFinanceServer) ForecastCashFlow(context.Context, *ForecastCashFlowRequest) (*ForecastCashFlowResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ForecastCashFlow not implemented")
+}
+func (UnimplementedFinanceServer) ListAlerts(context.Context, *ListAlertsRequest) (*ListAlertsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAlerts not implemented")
+}
+func (UnimplementedFinanceServer) GetAlert(context.Context, *GetAlertRequest) (*Alert, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAlert not implemented")
+}
+func (UnimplementedFinanceServer) AcknowledgeAlert(context.Context, *AcknowledgeAlertRequest) (*Alert, error) {
+	return nil, status.Error(codes.Unimplemented, "method AcknowledgeAlert not implemented")
+}
+func (UnimplementedFinanceServer) SnoozeAlert(context.Context, *SnoozeAlertRequest) (*Alert, error) {
+	return nil, status.Error(codes.Unimplemented, "method SnoozeAlert not implemented")
+}
+func (UnimplementedFinanceServer) CreateRecurringExpense(context.Context, *CreateRecurringExpenseRequest) (*RecurringExpense, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateRecurringExpense not implemented")
+}
+func (UnimplementedFinanceServer) UpdateRecurringExpense(context.Context, *UpdateRecurringExpenseRequest) (*RecurringExpense, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateRecurringExpense not implemented")
+}
+func (UnimplementedFinanceServer) DeleteRecurringExpense(context.Context, *DeleteRecurringExpenseRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteRecurringExpense not implemented")
+}
+func (UnimplementedFinanceServer) ListRecurringExpenses(context.Context, *ListRecurringExpensesRequest) (*ListRecurringExpensesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListRecurringExpenses not implemented")
+}
+func (UnimplementedFinanceServer) DetectSubscriptions(context.Context, *DetectSubscriptionsRequest) (*DetectSubscriptionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DetectSubscriptions not implemented")
+}
+func (UnimplementedFinanceServer) ConvertToRecurringExpense(context.Context, *ConvertToRecurringExpenseRequest) (*RecurringExpense, error) {
+	return nil, status.Error(codes.Unimplemented, "method ConvertToRecurringExpense not implemented")
+}
+func (UnimplementedFinanceServer) ListScheduledPayments(context.Context, *ListScheduledPaymentsRequest) (*ListScheduledPaymentsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListScheduledPayments not implemented")
+}
+func (UnimplementedFinanceServer) GetScheduledPayment(context.Context, *GetScheduledPaymentRequest) (*ScheduledPayment, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetScheduledPayment not implemented")
+}
+func (UnimplementedFinanceServer) ConfirmScheduledPayment(context.Context, *ConfirmScheduledPaymentRequest) (*Transaction, error) {
 	return nil, status.Error(codes.Unimplemented, "method ConfirmScheduledPayment not implemented")
 }
-func (UnimplementedFinanceServer) MatchScheduledPayment(context.Context, *MatchScheduledPaymentRequest) (*Transaction, error) {
-	return nil, status.Error(codes.Unimplemented, "method MatchScheduledPayment not implemented")
+func (UnimplementedFinanceServer) MatchScheduledPayment(context.Context, *MatchScheduledPaymentRequest) (*Transaction, error) {
+	return nil, status.Error(codes.Unimplemented, "method MatchScheduledPayment not implemented")
+}
+func (UnimplementedFinanceServer) SkipScheduledPayment(context.Context, *SkipScheduledPaymentRequest) (*ScheduledPayment, error) {
+	return nil, status.Error(codes.Unimplemented, "method SkipScheduledPayment not implemented")
+}
+func (UnimplementedFinanceServer) CreateBorrowing(context.Context, *CreateBorrowingRequest) (*Borrowing, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateBorrowing not implemented")
+}
+func (UnimplementedFinanceServer) GetBorrowing(context.Context, *GetBorrowingRequest) (*Borrowing, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetBorrowing not implemented")
+}
+func (UnimplementedFinanceServer) ListBorrowings(context.Context, *ListBorrowingsRequest) (*ListBorrowingsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListBorrowings not implemented")
+}
+func (UnimplementedFinanceServer) UpdateBorrowing(context.Context, *UpdateBorrowingRequest) (*Borrowing, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateBorrowing not implemented")
+}
+func (UnimplementedFinanceServer) DeleteBorrowing(context.Context, *DeleteBorrowingRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteBorrowing not implemented")
+}
+func (UnimplementedFinanceServer) CreateBorrowingRepayment(context.Context, *CreateBorrowingRepaymentRequest) (*BorrowingRepayment, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateBorrowingRepayment not implemented")
+}
+func (UnimplementedFinanceServer) ListBorrowingRepayments(context.Context, *ListBorrowingRepaymentsRequest) (*ListBorrowingRepaymentsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListBorrowingRepayments not implemented")
+}
+func (UnimplementedFinanceServer) DeleteBorrowingRepayment(context.Context, *DeleteBorrowingRepaymentRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteBorrowingRepayment not implemented")
+}
+func (UnimplementedFinanceServer) CreateAccount(context.Context, *CreateAccountRequest) (*Account, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateAccount not implemented")
+}
+func (UnimplementedFinanceServer) GetAccount(context.Context, *GetAccountRequest) (*Account, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAccount not implemented")
+}
+func (UnimplementedFinanceServer) UpdateAccount(context.Context, *UpdateAccountRequest) (*Account, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateAccount not implemented")
+}
+func (UnimplementedFinanceServer) AdjustAccountBalance(context.Context, *AdjustAccountBalanceRequest) (*Account, error) {
+	return nil, status.Error(codes.Unimplemented, "method AdjustAccountBalance not implemented")
+}
+func (UnimplementedFinanceServer) ListCardStatements(context.Context, *ListCardStatementsRequest) (*ListCardStatementsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCardStatements not implemented")
+}
+func (UnimplementedFinanceServer) GetCardStatement(context.Context, *GetCardStatementRequest) (*CardStatement, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCardStatement not implemented")
+}
+func (UnimplementedFinanceServer) StartReconciliation(context.Context, *StartReconciliationRequest) (*Reconciliation, error) {
+	return nil, status.Error(codes.Unimplemented, "method StartReconciliation not implemented")
+}
+func (UnimplementedFinanceServer) GetReconciliation(context.Context, *GetReconciliationRequest) (*Reconciliation, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetReconciliation not implemented")
+}
+func (UnimplementedFinanceServer) ListReconciliations(context.Context, *ListReconciliationsRequest) (*ListReconciliationsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListReconciliations not implemented")
+}
+func (UnimplementedFinanceServer) ListReconciliationTransactions(context.Context, *ListReconciliationTransactionsRequest) (*ListReconciliationTransactionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListReconciliationTransactions not implemented")
+}
+func (UnimplementedFinanceServer) SetTransactionsCleared(context.Context, *SetTransactionsClearedRequest) (*Reconciliation, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetTransactionsCleared not implemented")
+}
+func (UnimplementedFinanceServer) FinalizeReconciliation(context.Context, *FinalizeReconciliationRequest) (*Reconciliation, error) {
+	return nil, status.Error(codes.Unimplemented, "method FinalizeReconciliation not implemented")
+}
+func (UnimplementedFinanceServer) CancelReconciliation(context.Context, *CancelReconciliationRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method CancelReconciliation not implemented")
+}
+func (UnimplementedFinanceServer) DeleteAccount(context.Context, *DeleteAccountRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteAccount not implemented")
+}
+func (UnimplementedFinanceServer) ListAccounts(context.Context, *ListAccountsRequest) (*ListAccountsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAccounts not implemented")
+}
+func (UnimplementedFinanceServer) CreateTransfer(context.Context, *CreateTransferRequest) (*Transfer, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateTransfer not implemented")
+}
+func (UnimplementedFinanceServer) ListTransfers(context.Context, *ListTransfersRequest) (*ListTransfersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTransfers not implemented")
+}
+func (UnimplementedFinanceServer) ListCurrencies(context.Context, *ListCurrenciesRequest) (*ListCurrenciesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCurrencies not implemented")
+}
+func (UnimplementedFinanceServer) ListInboxItems(context.Context, *ListInboxItemsRequest) (*ListInboxItemsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListInboxItems not implemented")
+}
+func (UnimplementedFinanceServer) UpdateInboxItem(context.Context, *UpdateInboxItemRequest) (*InboxItem, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateInboxItem not implemented")
+}
+func (UnimplementedFinanceServer) ApproveInboxItem(context.Context, *ApproveInboxItemRequest) (*InboxItem, error) {
+	return nil, status.Error(codes.Unimplemented, "method ApproveInboxItem not implemented")
+}
+func (UnimplementedFinanceServer) DiscardInboxItem(context.Context, *DiscardInboxItemRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DiscardInboxItem not implemented")
+}
+func (UnimplementedFinanceServer) ImportStatement(context.Context, *ImportStatementRequest) (*ImportStatementResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ImportStatement not implemented")
+}
+func (UnimplementedFinanceServer) GetStatementMapping(context.Context, *GetStatementMappingRequest) (*StatementMapping, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetStatementMapping not implemented")
+}
+func (UnimplementedFinanceServer) ExportLedger(*ExportLedgerRequest, grpc.ServerStreamingServer[LedgerExportChunk]) error {
+	return status.Error(codes.Unimplemented, "method ExportLedger not implemented")
+}
+func (UnimplementedFinanceServer) CreateLedgerExport(context.Context, *CreateLedgerExportRequest) (*LedgerExport, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateLedgerExport not implemented")
+}
+func (UnimplementedFinanceServer) GetLedgerExport(context.Context, *GetLedgerExportRequest) (*LedgerExport, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetLedgerExport not implemented")
+}
+func (UnimplementedFinanceServer) ListLedgerExports(context.Context, *ListLedgerExportsRequest) (*ListLedgerExportsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListLedgerExports not implemented")
+}
+func (UnimplementedFinanceServer) DownloadLedgerExport(*DownloadLedgerExportRequest, grpc.ServerStreamingServer[LedgerExportChunk]) error {
+	return status.Error(codes.Unimplemented, "method DownloadLedgerExport not implemented")
+}
+func (UnimplementedFinanceServer) testEmbeddedByValue() {}
+
+// UnsafeFinanceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FinanceServer will
+// result in compilation errors.
+type UnsafeFinanceServer interface {
+	mustEmbedUnimplementedFinanceServer()
+}
+
+func RegisterFinanceServer(s grpc.ServiceRegistrar, srv FinanceServer) {
+	// If the following call panics, it indicates UnimplementedFinanceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Finance_ServiceDesc, srv)
+}
+
+func _Finance_ConfigureFinance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfigureFinanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServer).ConfigureFinance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Finance_ConfigureFinance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServer).ConfigureFinance(ctx, req.(*ConfigureFinanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Finance_GetFinanceSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFinanceSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServer).GetFinanceSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Finance_GetFinanceSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServer).GetFinanceSettings(ctx, req.(*GetFinanceSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Finance_UpdateFinanceSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateFinanceSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServer).UpdateFinanceSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Finance_UpdateFinanceSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServer).UpdateFinanceSettings(ctx, req.(*UpdateFinanceSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Finance_CreateBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBudgetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServer).CreateBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Finance_CreateBudget_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServer).CreateBudget(ctx, req.(*CreateBudgetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Finance_GetBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBudgetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServer).GetBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Finance_GetBudget_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServer).GetBudget(ctx, req.(*GetBudgetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Finance_UpdateBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBudgetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServer).UpdateBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Finance_UpdateBudget_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServer).UpdateBudget(ctx, req.(*UpdateBudgetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Finance_DeleteBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBudgetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServer).DeleteBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Finance_DeleteBudget_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServer).DeleteBudget(ctx, req.(*DeleteBudgetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Finance_ListBudgets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBudgetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServer).ListBudgets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Finance_ListBudgets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServer).ListBudgets(ctx, req.(*ListBudgetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Finance_GetBudgetPeriod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBudgetPeriodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServer).GetBudgetPeriod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Finance_GetBudgetPeriod_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServer).GetBudgetPeriod(ctx, req.(*GetBudgetPeriodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Finance_CreateExchangeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateExchangeRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServer).CreateExchangeRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Finance_CreateExchangeRate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServer).CreateExchangeRate(ctx, req.(*CreateExchangeRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Finance_GetExchangeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExchangeRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServer).GetExchangeRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Finance_GetExchangeRate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServer).GetExchangeRate(ctx, req.(*GetExchangeRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Finance_UpdateExchangeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateExchangeRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServer).UpdateExchangeRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Finance_UpdateExchangeRate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServer).UpdateExchangeRate(ctx, req.(*UpdateExchangeRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Finance_ListExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExchangeRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServer).ListExchangeRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Finance_ListExchangeRates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServer).ListExchangeRates(ctx, req.(*ListExchangeRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Finance_DeleteExchangeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteExchangeRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServer).DeleteExchangeRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Finance_DeleteExchangeRate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServer).DeleteExchangeRate(ctx, req.(*DeleteExchangeRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Finance_CreateExpense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateExpenseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServer).CreateExpense(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Finance_CreateExpense_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServer).CreateExpense(ctx, req.(*CreateExpenseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Finance_UpdateExpense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateExpenseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServer).UpdateExpense(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Finance_UpdateExpense_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServer).UpdateExpense(ctx, req.(*UpdateExpenseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Finance_CreateIncome_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateIncomeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServer).CreateIncome(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Finance_CreateIncome_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServer).CreateIncome(ctx, req.(*CreateIncomeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Finance_UpdateIncome_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateIncomeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServer).UpdateIncome(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Finance_UpdateIncome_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServer).UpdateIncome(ctx, req.(*UpdateIncomeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Finance_CreateIncomeSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateIncomeSourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServer).CreateIncomeSource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Finance_CreateIncomeSource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServer).CreateIncomeSource(ctx, req.(*CreateIncomeSourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedFinanceServer) SkipScheduledPayment(context.Context, *SkipScheduledPaymentRequest) (*ScheduledPayment, error) {
-	return nil, status.Error(codes.Unimplemented, "method SkipScheduledPayment not implemented")
+
+func _Finance_GetIncomeSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIncomeSourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServer).GetIncomeSource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Finance_GetIncomeSource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServer).GetIncomeSource(ctx, req.(*GetIncomeSourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedFinanceServer) CreateBorrowing(context.Context, *CreateBorrowingRequest) (*Borrowing, error) {
-	return nil, status.Error(codes.Unimplemented, "method CreateBorrowing not implemented")
+
+func _Finance_UpdateIncomeSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateIncomeSourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServer).UpdateIncomeSource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Finance_UpdateIncomeSource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServer).UpdateIncomeSource(ctx, req.(*UpdateIncomeSourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedFinanceServer) GetBorrowing(context.Context, *GetBorrowingRequest) (*Borrowing, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetBorrowing not implemented")
+
+func _Finance_DeleteIncomeSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteIncomeSourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServer).DeleteIncomeSource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Finance_DeleteIncomeSource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServer).DeleteIncomeSource(ctx, req.(*DeleteIncomeSourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedFinanceServer) ListBorrowings(context.Context, *ListBorrowingsRequest) (*ListBorrowingsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ListBorrowings not implemented")
+
+func _Finance_ListIncomeSources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIncomeSourcesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServer).ListIncomeSources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Finance_ListIncomeSources_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServer).ListIncomeSources(ctx, req.(*ListIncomeSourcesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedFinanceServer) UpdateBorrowing(context.Context, *UpdateBorrowingRequest) (*Borrowing, error) {
-	return nil, status.Error(codes.Unimplemented, "method UpdateBorrowing not implemented")
+
+func _Finance_CreateGoal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGoalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServer).CreateGoal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Finance_CreateGoal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServer).CreateGoal(ctx, req.(*CreateGoalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedFinanceServer) DeleteBorrowing(context.Context, *DeleteBorrowingRequest) (*emptypb.Empty, error) {
-	return nil, status.Error(codes.Unimplemented, "method DeleteBorrowing not implemented")
+
+func _Finance_GetGoal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGoalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServer).GetGoal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Finance_GetGoal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServer).GetGoal(ctx, req.(*GetGoalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedFinanceServer) CreateBorrowingRepayment(context.Context, *CreateBorrowingRepaymentRequest) (*BorrowingRepayment, error) {
-	return nil, status.Error(codes.Unimplemented, "method CreateBorrowingRepayment not implemented")
+
+func _Finance_UpdateGoal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGoalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServer).UpdateGoal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Finance_UpdateGoal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServer).UpdateGoal(ctx, req.(*UpdateGoalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Finance_DeleteGoal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGoalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServer).DeleteGoal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Finance_DeleteGoal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServer).DeleteGoal(ctx, req.(*DeleteGoalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Finance_ListGoals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGoalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServer).ListGoals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Finance_ListGoals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServer).ListGoals(ctx, req.(*ListGoalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Finance_GetGoalProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGoalProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServer).GetGoalProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Finance_GetGoalProgress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServer).GetGoalProgress(ctx, req.(*GetGoalProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Finance_CreateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServer).CreateCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Finance_CreateCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServer).CreateCategory(ctx, req.(*CreateCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Finance_GetCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServer).GetCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Finance_GetCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServer).GetCategory(ctx, req.(*GetCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Finance_UpdateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServer).UpdateCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Finance_UpdateCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServer).UpdateCategory(ctx, req.(*UpdateCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Finance_DeleteCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServer).DeleteCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Finance_DeleteCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServer).DeleteCategory(ctx, req.(*DeleteCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Finance_ListCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCategoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServer).ListCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Finance_ListCategories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServer).ListCategories(ctx, req.(*ListCategoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Finance_DeleteTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServer).DeleteTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Finance_DeleteTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServer).DeleteTransaction(ctx, req.(*DeleteTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Finance_ListTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServer).ListTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Finance_ListTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServer).ListTransactions(ctx, req.(*ListTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedFinanceServer) ListBorrowingRepayments(context.Context, *ListBorrowingRepaymentsRequest) (*ListBorrowingRepaymentsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ListBorrowingRepayments not implemented")
+
+func _Finance_BatchUpdateTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpdateTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServer).BatchUpdateTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Finance_BatchUpdateTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServer).BatchUpdateTransactions(ctx, req.(*BatchUpdateTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedFinanceServer) DeleteBorrowingRepayment(context.Context, *DeleteBorrowingRepaymentRequest) (*emptypb.Empty, error) {
-	return nil, status.Error(codes.Unimplemented, "method DeleteBorrowingRepayment not implemented")
+
+func _Finance_BatchDeleteTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDeleteTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServer).BatchDeleteTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Finance_BatchDeleteTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServer).BatchDeleteTransactions(ctx, req.(*BatchDeleteTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedFinanceServer) CreateAccount(context.Context, *CreateAccountRequest) (*Account, error) {
-	return nil, status.Error(codes.Unimplemented, "method CreateAccount not implemented")
+
+func _Finance_CreateSavedFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSavedFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServer).CreateSavedFilter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Finance_CreateSavedFilter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServer).CreateSavedFilter(ctx, req.(*CreateSavedFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedFinanceServer) GetAccount(context.Context, *GetAccountRequest) (*Account, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetAccount not implemented")
+
+func _Finance_GetSavedFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSavedFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServer).GetSavedFilter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Finance_GetSavedFilter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServer).GetSavedFilter(ctx, req.(*GetSavedFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedFinanceServer) UpdateAccount(context.Context, *UpdateAccountRequest) (*Account, error) {
-	return nil, status.Error(codes.Unimplemented, "method UpdateAccount not implemented")
+
+func _Finance_UpdateSavedFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSavedFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServer).UpdateSavedFilter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Finance_UpdateSavedFilter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServer).UpdateSavedFilter(ctx, req.(*UpdateSavedFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedFinanceServer) AdjustAccountBalance(context.Context, *AdjustAccountBalanceRequest) (*Account, error) {
-	return nil, status.Error(codes.Unimplemented, "method AdjustAccountBalance not implemented")
+
+func _Finance_DeleteSavedFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSavedFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServer).DeleteSavedFilter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Finance_DeleteSavedFilter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServer).DeleteSavedFilter(ctx, req.(*DeleteSavedFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedFinanceServer) DeleteAccount(context.Context, *DeleteAccountRequest) (*emptypb.Empty, error) {
-	return nil, status.Error(codes.Unimplemented, "method DeleteAccount not implemented")
+
+func _Finance_ListSavedFilters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSavedFiltersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServer).ListSavedFilters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Finance_ListSavedFilters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServer).ListSavedFilters(ctx, req.(*ListSavedFiltersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedFinanceServer) ListAccounts(context.Context, *ListAccountsRequest) (*ListAccountsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ListAccounts not implemented")
+
+func _Finance_CreatePayee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePayeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServer).CreatePayee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Finance_CreatePayee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServer).CreatePayee(ctx, req.(*CreatePayeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedFinanceServer) CreateTransfer(context.Context, *CreateTransferRequest) (*Transfer, error) {
-	return nil, status.Error(codes.Unimplemented, "method CreateTransfer not implemented")
+
+func _Finance_GetPayee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPayeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServer).GetPayee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Finance_GetPayee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServer).GetPayee(ctx, req.(*GetPayeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedFinanceServer) ListTransfers(context.Context, *ListTransfersRequest) (*ListTransfersResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ListTransfers not implemented")
+
+func _Finance_UpdatePayee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePayeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServer).UpdatePayee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Finance_UpdatePayee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServer).UpdatePayee(ctx, req.(*UpdatePayeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedFinanceServer) ListCurrencies(context.Context, *ListCurrenciesRequest) (*ListCurrenciesResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ListCurrencies not implemented")
+
+func _Finance_DeletePayee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePayeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServer).DeletePayee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Finance_DeletePayee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServer).DeletePayee(ctx, req.(*DeletePayeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedFinanceServer) ListInboxItems(context.Context, *ListInboxItemsRequest) (*ListInboxItemsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ListInboxItems not implemented")
+
+func _Finance_ListPayees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPayeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServer).ListPayees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Finance_ListPayees_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServer).ListPayees(ctx, req.(*ListPayeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedFinanceServer) UpdateInboxItem(context.Context, *UpdateInboxItemRequest) (*InboxItem, error) {
-	return nil, status.Error(codes.Unimplemented, "method UpdateInboxItem not implemented")
+
+func _Finance_CreateTransactionRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTransactionRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServer).CreateTransactionRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Finance_CreateTransactionRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServer).CreateTransactionRule(ctx, req.(*CreateTransactionRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedFinanceServer) ApproveInboxItem(context.Context, *ApproveInboxItemRequest) (*InboxItem, error) {
-	return nil, status.Error(codes.Unimplemented, "method ApproveInboxItem not implemented")
+
+func _Finance_GetTransactionRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServer).GetTransactionRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Finance_GetTransactionRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServer).GetTransactionRule(ctx, req.(*GetTransactionRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedFinanceServer) DiscardInboxItem(context.Context, *DiscardInboxItemRequest) (*emptypb.Empty, error) {
-	return nil, status.Error(codes.Unimplemented, "method DiscardInboxItem not implemented")
+
+func _Finance_UpdateTransactionRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTransactionRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServer).UpdateTransactionRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Finance_UpdateTransactionRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServer).UpdateTransactionRule(ctx, req.(*UpdateTransactionRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedFinanceServer) testEmbeddedByValue() {}
 
-// UnsafeFinanceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to FinanceServer will
-// result in compilation errors.
-type UnsafeFinanceServer interface {
-	mustEmbedUnimplementedFinanceServer()
+func _Finance_DeleteTransactionRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTransactionRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServer).DeleteTransactionRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Finance_DeleteTransactionRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServer).DeleteTransactionRule(ctx, req.(*DeleteTransactionRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterFinanceServer(s grpc.ServiceRegistrar, srv FinanceServer) {
-	// If the following call panics, it indicates UnimplementedFinanceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _Finance_ListTransactionRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTransactionRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&Finance_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(FinanceServer).ListTransactionRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Finance_ListTransactionRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServer).ListTransactionRules(ctx, req.(*ListTransactionRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Finance_ConfigureFinance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ConfigureFinanceRequest)
+func _Finance_ApplyTransactionRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyTransactionRulesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FinanceServer).ConfigureFinance(ctx, in)
+		return srv.(FinanceServer).ApplyTransactionRules(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Finance_ConfigureFinance_FullMethodName,
+		FullMethod: Finance_ApplyTransactionRules_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FinanceServer).ConfigureFinance(ctx, req.(*ConfigureFinanceRequest))
+		return srv.(FinanceServer).ApplyTransactionRules(ctx, req.(*ApplyTransactionRulesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Finance_GetFinanceSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetFinanceSettingsRequest)
+func _Finance_GetTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FinanceServer).GetFinanceSettings(ctx, in)
+		return srv.(FinanceServer).GetTransaction(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Finance_GetFinanceSettings_FullMethodName,
+		FullMethod: Finance_GetTransaction_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FinanceServer).GetFinanceSettings(ctx, req.(*GetFinanceSettingsRequest))
+		return srv.(FinanceServer).GetTransaction(ctx, req.(*GetTransactionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Finance_CreateBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateBudgetRequest)
+func _Finance_ListTransactionEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTransactionEventsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FinanceServer).CreateBudget(ctx, in)
+		return srv.(FinanceServer).ListTransactionEvents(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Finance_CreateBudget_FullMethodName,
+		FullMethod: Finance_ListTransactionEvents_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FinanceServer).CreateBudget(ctx, req.(*CreateBudgetRequest))
+		return srv.(FinanceServer).ListTransactionEvents(ctx, req.(*ListTransactionEventsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Finance_GetBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetBudgetRequest)
+func _Finance_GetInsights_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInsightsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FinanceServer).GetBudget(ctx, in)
+		return srv.(FinanceServer).GetInsights(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Finance_GetBudget_FullMethodName,
+		FullMethod: Finance_GetInsights_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FinanceServer).GetBudget(ctx, req.(*GetBudgetRequest))
+		return srv.(FinanceServer).GetInsights(ctx, req.(*GetInsightsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Finance_UpdateBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateBudgetRequest)
+func _Finance_ForecastCashFlow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForecastCashFlowRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FinanceServer).UpdateBudget(ctx, in)
+		return srv.(FinanceServer).ForecastCashFlow(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Finance_UpdateBudget_FullMethodName,
+		FullMethod: Finance_ForecastCashFlow_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FinanceServer).UpdateBudget(ctx, req.(*UpdateBudgetRequest))
+		return srv.(FinanceServer).ForecastCashFlow(ctx, req.(*ForecastCashFlowRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Finance_DeleteBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteBudgetRequest)
+func _Finance_ListAlerts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAlertsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FinanceServer).DeleteBudget(ctx, in)
+		return srv.(FinanceServer).ListAlerts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Finance_DeleteBudget_FullMethodName,
+		FullMethod: Finance_ListAlerts_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FinanceServer).DeleteBudget(ctx, req.(*DeleteBudgetRequest))
+		return srv.(FinanceServer).ListAlerts(ctx, req.(*ListAlertsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Finance_ListBudgets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListBudgetsRequest)
+func _Finance_GetAlert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAlertRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FinanceServer).ListBudgets(ctx, in)
+		return srv.(FinanceServer).GetAlert(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Finance_ListBudgets_FullMethodName,
+		FullMethod: Finance_GetAlert_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FinanceServer).ListBudgets(ctx, req.(*ListBudgetsRequest))
+		return srv.(FinanceServer).GetAlert(ctx, req.(*GetAlertRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Finance_GetBudgetPeriod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetBudgetPeriodRequest)
+func _Finance_AcknowledgeAlert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcknowledgeAlertRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FinanceServer).GetBudgetPeriod(ctx, in)
+		return srv.(FinanceServer).AcknowledgeAlert(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Finance_GetBudgetPeriod_FullMethodName,
+		FullMethod: Finance_AcknowledgeAlert_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FinanceServer).GetBudgetPeriod(ctx, req.(*GetBudgetPeriodRequest))
+		return srv.(FinanceServer).AcknowledgeAlert(ctx, req.(*AcknowledgeAlertRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Finance_CreateExchangeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateExchangeRateRequest)
+func _Finance_SnoozeAlert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SnoozeAlertRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FinanceServer).CreateExchangeRate(ctx, in)
+		return srv.(FinanceServer).SnoozeAlert(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Finance_CreateExchangeRate_FullMethodName,
+		FullMethod: Finance_SnoozeAlert_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FinanceServer).CreateExchangeRate(ctx, req.(*CreateExchangeRateRequest))
+		return srv.(FinanceServer).SnoozeAlert(ctx, req.(*SnoozeAlertRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Finance_GetExchangeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetExchangeRateRequest)
+func _Finance_CreateRecurringExpense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRecurringExpenseRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FinanceServer).GetExchangeRate(ctx, in)
+		return srv.(FinanceServer).CreateRecurringExpense(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Finance_GetExchangeRate_FullMethodName,
+		FullMethod: Finance_CreateRecurringExpense_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FinanceServer).GetExchangeRate(ctx, req.(*GetExchangeRateRequest))
+		return srv.(FinanceServer).CreateRecurringExpense(ctx, req.(*CreateRecurringExpenseRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Finance_UpdateExchangeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateExchangeRateRequest)
+func _Finance_UpdateRecurringExpense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRecurringExpenseRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FinanceServer).UpdateExchangeRate(ctx, in)
+		return srv.(FinanceServer).UpdateRecurringExpense(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Finance_UpdateExchangeRate_FullMethodName,
+		FullMethod: Finance_UpdateRecurringExpense_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FinanceServer).UpdateExchangeRate(ctx, req.(*UpdateExchangeRateRequest))
+		return srv.(FinanceServer).UpdateRecurringExpense(ctx, req.(*UpdateRecurringExpenseRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Finance_ListExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListExchangeRatesRequest)
+func _Finance_DeleteRecurringExpense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRecurringExpenseRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FinanceServer).ListExchangeRates(ctx, in)
+		return srv.(FinanceServer).DeleteRecurringExpense(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Finance_ListExchangeRates_FullMethodName,
+		FullMethod: Finance_DeleteRecurringExpense_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FinanceServer).ListExchangeRates(ctx, req.(*ListExchangeRatesRequest))
+		return srv.(FinanceServer).DeleteRecurringExpense(ctx, req.(*DeleteRecurringExpenseRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Finance_DeleteExchangeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteExchangeRateRequest)
+func _Finance_ListRecurringExpenses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRecurringExpensesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FinanceServer).DeleteExchangeRate(ctx, in)
+		return srv.(FinanceServer).ListRecurringExpenses(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Finance_DeleteExchangeRate_FullMethodName,
+		FullMethod: Finance_ListRecurringExpenses_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FinanceServer).DeleteExchangeRate(ctx, req.(*DeleteExchangeRateRequest))
+		return srv.(FinanceServer).ListRecurringExpenses(ctx, req.(*ListRecurringExpensesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Finance_CreateExpense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateExpenseRequest)
+func _Finance_DetectSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DetectSubscriptionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FinanceServer).CreateExpense(ctx, in)
+		return srv.(FinanceServer).DetectSubscriptions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Finance_CreateExpense_FullMethodName,
+		FullMethod: Finance_DetectSubscriptions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FinanceServer).CreateExpense(ctx, req.(*CreateExpenseRequest))
+		return srv.(FinanceServer).DetectSubscriptions(ctx, req.(*DetectSubscriptionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Finance_UpdateExpense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateExpenseRequest)
+func _Finance_ConvertToRecurringExpense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConvertToRecurringExpenseRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FinanceServer).UpdateExpense(ctx, in)
+		return srv.(FinanceServer).ConvertToRecurringExpense(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Finance_UpdateExpense_FullMethodName,
+		FullMethod: Finance_ConvertToRecurringExpense_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FinanceServer).UpdateExpense(ctx, req.(*UpdateExpenseRequest))
+		return srv.(FinanceServer).ConvertToRecurringExpense(ctx, req.(*ConvertToRecurringExpenseRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Finance_DeleteTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteTransactionRequest)
+func _Finance_ListScheduledPayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScheduledPaymentsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FinanceServer).DeleteTransaction(ctx, in)
+		return srv.(FinanceServer).ListScheduledPayments(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Finance_DeleteTransaction_FullMethodName,
+		FullMethod: Finance_ListScheduledPayments_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FinanceServer).DeleteTransaction(ctx, req.(*DeleteTransactionRequest))
+		return srv.(FinanceServer).ListScheduledPayments(ctx, req.(*ListScheduledPaymentsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Finance_ListTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTransactionsRequest)
+func _Finance_GetScheduledPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetScheduledPaymentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FinanceServer).ListTransactions(ctx, in)
+		return srv.(FinanceServer).GetScheduledPayment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Finance_ListTransactions_FullMethodName,
+		FullMethod: Finance_GetScheduledPayment_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FinanceServer).ListTransactions(ctx, req.(*ListTransactionsRequest))
+		return srv.(FinanceServer).GetScheduledPayment(ctx, req.(*GetScheduledPaymentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Finance_GetTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTransactionRequest)
+func _Finance_ConfirmScheduledPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmScheduledPaymentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FinanceServer).GetTransaction(ctx, in)
+		return srv.(FinanceServer).ConfirmScheduledPayment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Finance_GetTransaction_FullMethodName,
+		FullMethod: Finance_ConfirmScheduledPayment_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FinanceServer).GetTransaction(ctx, req.(*GetTransactionRequest))
+		return srv.(FinanceServer).ConfirmScheduledPayment(ctx, req.(*ConfirmScheduledPaymentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Finance_ListTransactionEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTransactionEventsRequest)
+func _Finance_MatchScheduledPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MatchScheduledPaymentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FinanceServer).ListTransactionEvents(ctx, in)
+		return srv.(FinanceServer).MatchScheduledPayment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Finance_ListTransactionEvents_FullMethodName,
+		FullMethod: Finance_MatchScheduledPayment_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FinanceServer).ListTransactionEvents(ctx, req.(*ListTransactionEventsRequest))
+		return srv.(FinanceServer).MatchScheduledPayment(ctx, req.(*MatchScheduledPaymentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Finance_GetInsights_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetInsightsRequest)
+func _Finance_SkipScheduledPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SkipScheduledPaymentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FinanceServer).GetInsights(ctx, in)
+		return srv.(FinanceServer).SkipScheduledPayment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Finance_GetInsights_FullMethodName,
+		FullMethod: Finance_SkipScheduledPayment_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FinanceServer).GetInsights(ctx, req.(*GetInsightsRequest))
+		return srv.(FinanceServer).SkipScheduledPayment(ctx, req.(*SkipScheduledPaymentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Finance_CreateRecurringExpense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateRecurringExpenseRequest)
+func _Finance_CreateBorrowing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBorrowingRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FinanceServer).CreateRecurringExpense(ctx, in)
+		return srv.(FinanceServer).CreateBorrowing(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Finance_CreateRecurringExpense_FullMethodName,
+		FullMethod: Finance_CreateBorrowing_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FinanceServer).CreateRecurringExpense(ctx, req.(*CreateRecurringExpenseRequest))
+		return srv.(FinanceServer).CreateBorrowing(ctx, req.(*CreateBorrowingRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Finance_UpdateRecurringExpense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateRecurringExpenseRequest)
+func _Finance_GetBorrowing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBorrowingRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FinanceServer).UpdateRecurringExpense(ctx, in)
+		return srv.(FinanceServer).GetBorrowing(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Finance_UpdateRecurringExpense_FullMethodName,
+		FullMethod: Finance_GetBorrowing_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FinanceServer).UpdateRecurringExpense(ctx, req.(*UpdateRecurringExpenseRequest))
+		return srv.(FinanceServer).GetBorrowing(ctx, req.(*GetBorrowingRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Finance_DeleteRecurringExpense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteRecurringExpenseRequest)
+func _Finance_ListBorrowings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBorrowingsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FinanceServer).DeleteRecurringExpense(ctx, in)
+		return srv.(FinanceServer).ListBorrowings(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Finance_DeleteRecurringExpense_FullMethodName,
+		FullMethod: Finance_ListBorrowings_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FinanceServer).DeleteRecurringExpense(ctx, req.(*DeleteRecurringExpenseRequest))
+		return srv.(FinanceServer).ListBorrowings(ctx, req.(*ListBorrowingsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Finance_ListRecurringExpenses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListRecurringExpensesRequest)
+func _Finance_UpdateBorrowing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBorrowingRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FinanceServer).ListRecurringExpenses(ctx, in)
+		return srv.(FinanceServer).UpdateBorrowing(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Finance_ListRecurringExpenses_FullMethodName,
+		FullMethod: Finance_UpdateBorrowing_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FinanceServer).ListRecurringExpenses(ctx, req.(*ListRecurringExpensesRequest))
+		return srv.(FinanceServer).UpdateBorrowing(ctx, req.(*UpdateBorrowingRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Finance_ListScheduledPayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListScheduledPaymentsRequest)
+func _Finance_DeleteBorrowing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBorrowingRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FinanceServer).ListScheduledPayments(ctx, in)
+		return srv.(FinanceServer).DeleteBorrowing(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Finance_ListScheduledPayments_FullMethodName,
+		FullMethod: Finance_DeleteBorrowing_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FinanceServer).ListScheduledPayments(ctx, req.(*ListScheduledPaymentsRequest))
+		return srv.(FinanceServer).DeleteBorrowing(ctx, req.(*DeleteBorrowingRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Finance_GetScheduledPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetScheduledPaymentRequest)
+func _Finance_CreateBorrowingRepayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBorrowingRepaymentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FinanceServer).GetScheduledPayment(ctx, in)
+		return srv.(FinanceServer).CreateBorrowingRepayment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Finance_GetScheduledPayment_FullMethodName,
+		FullMethod: Finance_CreateBorrowingRepayment_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FinanceServer).GetScheduledPayment(ctx, req.(*GetScheduledPaymentRequest))
+		return srv.(FinanceServer).CreateBorrowingRepayment(ctx, req.(*CreateBorrowingRepaymentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Finance_ConfirmScheduledPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ConfirmScheduledPaymentRequest)
+func _Finance_ListBorrowingRepayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBorrowingRepaymentsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FinanceServer).ConfirmScheduledPayment(ctx, in)
+		return srv.(FinanceServer).ListBorrowingRepayments(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Finance_ConfirmScheduledPayment_FullMethodName,
+		FullMethod: Finance_ListBorrowingRepayments_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FinanceServer).ConfirmScheduledPayment(ctx, req.(*ConfirmScheduledPaymentRequest))
+		return srv.(FinanceServer).ListBorrowingRepayments(ctx, req.(*ListBorrowingRepaymentsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Finance_MatchScheduledPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MatchScheduledPaymentRequest)
+func _Finance_DeleteBorrowingRepayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBorrowingRepaymentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FinanceServer).MatchScheduledPayment(ctx, in)
+		return srv.(FinanceServer).DeleteBorrowingRepayment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Finance_MatchScheduledPayment_FullMethodName,
+		FullMethod: Finance_DeleteBorrowingRepayment_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FinanceServer).MatchScheduledPayment(ctx, req.(*MatchScheduledPaymentRequest))
+		return srv.(FinanceServer).DeleteBorrowingRepayment(ctx, req.(*DeleteBorrowingRepaymentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Finance_SkipScheduledPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SkipScheduledPaymentRequest)
+func _Finance_CreateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAccountRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FinanceServer).SkipScheduledPayment(ctx, in)
+		return srv.(FinanceServer).CreateAccount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Finance_SkipScheduledPayment_FullMethodName,
+		FullMethod: Finance_CreateAccount_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FinanceServer).SkipScheduledPayment(ctx, req.(*SkipScheduledPaymentRequest))
+		return srv.(FinanceServer).CreateAccount(ctx, req.(*CreateAccountRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Finance_CreateBorrowing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateBorrowingRequest)
+func _Finance_GetAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FinanceServer).CreateBorrowing(ctx, in)
+		return srv.(FinanceServer).GetAccount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Finance_CreateBorrowing_FullMethodName,
+		FullMethod: Finance_GetAccount_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FinanceServer).CreateBorrowing(ctx, req.(*CreateBorrowingRequest))
+		return srv.(FinanceServer).GetAccount(ctx, req.(*GetAccountRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Finance_GetBorrowing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetBorrowingRequest)
+func _Finance_UpdateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAccountRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FinanceServer).GetBorrowing(ctx, in)
+		return srv.(FinanceServer).UpdateAccount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Finance_GetBorrowing_FullMethodName,
+		FullMethod: Finance_UpdateAccount_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FinanceServer).GetBorrowing(ctx, req.(*GetBorrowingRequest))
+		return srv.(FinanceServer).UpdateAccount(ctx, req.(*UpdateAccountRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Finance_ListBorrowings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListBorrowingsRequest)
+func _Finance_AdjustAccountBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdjustAccountBalanceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FinanceServer).ListBorrowings(ctx, in)
+		return srv.(FinanceServer).AdjustAccountBalance(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Finance_ListBorrowings_FullMethodName,
+		FullMethod: Finance_AdjustAccountBalance_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FinanceServer).ListBorrowings(ctx, req.(*ListBorrowingsRequest))
+		return srv.(FinanceServer).AdjustAccountBalance(ctx, req.(*AdjustAccountBalanceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Finance_UpdateBorrowing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateBorrowingRequest)
+func _Finance_ListCardStatements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCardStatementsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FinanceServer).UpdateBorrowing(ctx, in)
+		return srv.(FinanceServer).ListCardStatements(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Finance_UpdateBorrowing_FullMethodName,
+		FullMethod: Finance_ListCardStatements_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FinanceServer).UpdateBorrowing(ctx, req.(*UpdateBorrowingRequest))
+		return srv.(FinanceServer).ListCardStatements(ctx, req.(*ListCardStatementsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Finance_DeleteBorrowing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteBorrowingRequest)
+func _Finance_GetCardStatement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCardStatementRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FinanceServer).DeleteBorrowing(ctx, in)
+		return srv.(FinanceServer).GetCardStatement(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Finance_DeleteBorrowing_FullMethodName,
+		FullMethod: Finance_GetCardStatement_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FinanceServer).DeleteBorrowing(ctx, req.(*DeleteBorrowingRequest))
+		return srv.(FinanceServer).GetCardStatement(ctx, req.(*GetCardStatementRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Finance_CreateBorrowingRepayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateBorrowingRepaymentRequest)
+func _Finance_StartReconciliation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartReconciliationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FinanceServer).CreateBorrowingRepayment(ctx, in)
+		return srv.(FinanceServer).StartReconciliation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Finance_CreateBorrowingRepayment_FullMethodName,
+		FullMethod: Finance_StartReconciliation_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FinanceServer).CreateBorrowingRepayment(ctx, req.(*CreateBorrowingRepaymentRequest))
+		return srv.(FinanceServer).StartReconciliation(ctx, req.(*StartReconciliationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Finance_ListBorrowingRepayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListBorrowingRepaymentsRequest)
+func _Finance_GetReconciliation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReconciliationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FinanceServer).ListBorrowingRepayments(ctx, in)
+		return srv.(FinanceServer).GetReconciliation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Finance_ListBorrowingRepayments_FullMethodName,
+		FullMethod: Finance_GetReconciliation_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FinanceServer).ListBorrowingRepayments(ctx, req.(*ListBorrowingRepaymentsRequest))
+		return srv.(FinanceServer).GetReconciliation(ctx, req.(*GetReconciliationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Finance_DeleteBorrowingRepayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteBorrowingRepaymentRequest)
+func _Finance_ListReconciliations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReconciliationsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FinanceServer).DeleteBorrowingRepayment(ctx, in)
+		return srv.(FinanceServer).ListReconciliations(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Finance_DeleteBorrowingRepayment_FullMethodName,
+		FullMethod: Finance_ListReconciliations_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FinanceServer).DeleteBorrowingRepayment(ctx, req.(*DeleteBorrowingRepaymentRequest))
+		return srv.(FinanceServer).ListReconciliations(ctx, req.(*ListReconciliationsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Finance_CreateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateAccountRequest)
+func _Finance_ListReconciliationTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReconciliationTransactionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FinanceServer).CreateAccount(ctx, in)
+		return srv.(FinanceServer).ListReconciliationTransactions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Finance_CreateAccount_FullMethodName,
+		FullMethod: Finance_ListReconciliationTransactions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FinanceServer).CreateAccount(ctx, req.(*CreateAccountRequest))
+		return srv.(FinanceServer).ListReconciliationTransactions(ctx, req.(*ListReconciliationTransactionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Finance_GetAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAccountRequest)
+func _Finance_SetTransactionsCleared_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTransactionsClearedRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FinanceServer).GetAccount(ctx, in)
+		return srv.(FinanceServer).SetTransactionsCleared(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Finance_GetAccount_FullMethodName,
+		FullMethod: Finance_SetTransactionsCleared_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FinanceServer).GetAccount(ctx, req.(*GetAccountRequest))
+		return srv.(FinanceServer).SetTransactionsCleared(ctx, req.(*SetTransactionsClearedRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Finance_UpdateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateAccountRequest)
+func _Finance_FinalizeReconciliation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinalizeReconciliationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FinanceServer).UpdateAccount(ctx, in)
+		return srv.(FinanceServer).FinalizeReconciliation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Finance_UpdateAccount_FullMethodName,
+		FullMethod: Finance_FinalizeReconciliation_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FinanceServer).UpdateAccount(ctx, req.(*UpdateAccountRequest))
+		return srv.(FinanceServer).FinalizeReconciliation(ctx, req.(*FinalizeReconciliationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Finance_AdjustAccountBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AdjustAccountBalanceRequest)
+func _Finance_CancelReconciliation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelReconciliationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FinanceServer).AdjustAccountBalance(ctx, in)
+		return srv.(FinanceServer).CancelReconciliation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Finance_AdjustAccountBalance_FullMethodName,
+		FullMethod: Finance_CancelReconciliation_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FinanceServer).AdjustAccountBalance(ctx, req.(*AdjustAccountBalanceRequest))
+		return srv.(FinanceServer).CancelReconciliation(ctx, req.(*CancelReconciliationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1877,6 +3951,118 @@ func _Finance_DiscardInboxItem_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Finance_ImportStatement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportStatementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServer).ImportStatement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Finance_ImportStatement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServer).ImportStatement(ctx, req.(*ImportStatementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Finance_GetStatementMapping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStatementMappingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServer).GetStatementMapping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Finance_GetStatementMapping_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServer).GetStatementMapping(ctx, req.(*GetStatementMappingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Finance_ExportLedger_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportLedgerRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FinanceServer).ExportLedger(m, &grpc.GenericServerStream[ExportLedgerRequest, LedgerExportChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Finance_ExportLedgerServer = grpc.ServerStreamingServer[LedgerExportChunk]
+
+func _Finance_CreateLedgerExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateLedgerExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServer).CreateLedgerExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Finance_CreateLedgerExport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServer).CreateLedgerExport(ctx, req.(*CreateLedgerExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Finance_GetLedgerExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLedgerExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServer).GetLedgerExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Finance_GetLedgerExport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServer).GetLedgerExport(ctx, req.(*GetLedgerExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Finance_ListLedgerExports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLedgerExportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServer).ListLedgerExports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Finance_ListLedgerExports_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServer).ListLedgerExports(ctx, req.(*ListLedgerExportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Finance_DownloadLedgerExport_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadLedgerExportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FinanceServer).DownloadLedgerExport(m, &grpc.GenericServerStream[DownloadLedgerExportRequest, LedgerExportChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Finance_DownloadLedgerExportServer = grpc.ServerStreamingServer[LedgerExportChunk]
+
 // Finance_ServiceDesc is the grpc.ServiceDesc for Finance service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1892,6 +4078,10 @@ var Finance_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetFinanceSettings",
 			Handler:    _Finance_GetFinanceSettings_Handler,
 		},
+		{
+			MethodName: "UpdateFinanceSettings",
+			Handler:    _Finance_UpdateFinanceSettings_Handler,
+		},
 		{
 			MethodName: "CreateBudget",
 			Handler:    _Finance_CreateBudget_Handler,
@@ -1944,6 +4134,78 @@ var Finance_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateExpense",
 			Handler:    _Finance_UpdateExpense_Handler,
 		},
+		{
+			MethodName: "CreateIncome",
+			Handler:    _Finance_CreateIncome_Handler,
+		},
+		{
+			MethodName: "UpdateIncome",
+			Handler:    _Finance_UpdateIncome_Handler,
+		},
+		{
+			MethodName: "CreateIncomeSource",
+			Handler:    _Finance_CreateIncomeSource_Handler,
+		},
+		{
+			MethodName: "GetIncomeSource",
+			Handler:    _Finance_GetIncomeSource_Handler,
+		},
+		{
+			MethodName: "UpdateIncomeSource",
+			Handler:    _Finance_UpdateIncomeSource_Handler,
+		},
+		{
+			MethodName: "DeleteIncomeSource",
+			Handler:    _Finance_DeleteIncomeSource_Handler,
+		},
+		{
+			MethodName: "ListIncomeSources",
+			Handler:    _Finance_ListIncomeSources_Handler,
+		},
+		{
+			MethodName: "CreateGoal",
+			Handler:    _Finance_CreateGoal_Handler,
+		},
+		{
+			MethodName: "GetGoal",
+			Handler:    _Finance_GetGoal_Handler,
+		},
+		{
+			MethodName: "UpdateGoal",
+			Handler:    _Finance_UpdateGoal_Handler,
+		},
+		{
+			MethodName: "DeleteGoal",
+			Handler:    _Finance_DeleteGoal_Handler,
+		},
+		{
+			MethodName: "ListGoals",
+			Handler:    _Finance_ListGoals_Handler,
+		},
+		{
+			MethodName: "GetGoalProgress",
+			Handler:    _Finance_GetGoalProgress_Handler,
+		},
+		{
+			MethodName: "CreateCategory",
+			Handler:    _Finance_CreateCategory_Handler,
+		},
+		{
+			MethodName: "GetCategory",
+			Handler:    _Finance_GetCategory_Handler,
+		},
+		{
+			MethodName: "UpdateCategory",
+			Handler:    _Finance_UpdateCategory_Handler,
+		},
+		{
+			MethodName: "DeleteCategory",
+			Handler:    _Finance_DeleteCategory_Handler,
+		},
+		{
+			MethodName: "ListCategories",
+			Handler:    _Finance_ListCategories_Handler,
+		},
 		{
 			MethodName: "DeleteTransaction",
 			Handler:    _Finance_DeleteTransaction_Handler,
@@ -1952,6 +4214,78 @@ var Finance_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListTransactions",
 			Handler:    _Finance_ListTransactions_Handler,
 		},
+		{
+			MethodName: "BatchUpdateTransactions",
+			Handler:    _Finance_BatchUpdateTransactions_Handler,
+		},
+		{
+			MethodName: "BatchDeleteTransactions",
+			Handler:    _Finance_BatchDeleteTransactions_Handler,
+		},
+		{
+			MethodName: "CreateSavedFilter",
+			Handler:    _Finance_CreateSavedFilter_Handler,
+		},
+		{
+			MethodName: "GetSavedFilter",
+			Handler:    _Finance_GetSavedFilter_Handler,
+		},
+		{
+			MethodName: "UpdateSavedFilter",
+			Handler:    _Finance_UpdateSavedFilter_Handler,
+		},
+		{
+			MethodName: "DeleteSavedFilter",
+			Handler:    _Finance_DeleteSavedFilter_Handler,
+		},
+		{
+			MethodName: "ListSavedFilters",
+			Handler:    _Finance_ListSavedFilters_Handler,
+		},
+		{
+			MethodName: "CreatePayee",
+			Handler:    _Finance_CreatePayee_Handler,
+		},
+		{
+			MethodName: "GetPayee",
+			Handler:    _Finance_GetPayee_Handler,
+		},
+		{
+			MethodName: "UpdatePayee",
+			Handler:    _Finance_UpdatePayee_Handler,
+		},
+		{
+			MethodName: "DeletePayee",
+			Handler:    _Finance_DeletePayee_Handler,
+		},
+		{
+			MethodName: "ListPayees",
+			Handler:    _Finance_ListPayees_Handler,
+		},
+		{
+			MethodName: "CreateTransactionRule",
+			Handler:    _Finance_CreateTransactionRule_Handler,
+		},
+		{
+			MethodName: "GetTransactionRule",
+			Handler:    _Finance_GetTransactionRule_Handler,
+		},
+		{
+			MethodName: "UpdateTransactionRule",
+			Handler:    _Finance_UpdateTransactionRule_Handler,
+		},
+		{
+			MethodName: "DeleteTransactionRule",
+			Handler:    _Finance_DeleteTransactionRule_Handler,
+		},
+		{
+			MethodName: "ListTransactionRules",
+			Handler:    _Finance_ListTransactionRules_Handler,
+		},
+		{
+			MethodName: "ApplyTransactionRules",
+			Handler:    _Finance_ApplyTransactionRules_Handler,
+		},
 		{
 			MethodName: "GetTransaction",
 			Handler:    _Finance_GetTransaction_Handler,
@@ -1964,6 +4298,26 @@ var Finance_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetInsights",
 			Handler:    _Finance_GetInsights_Handler,
 		},
+		{
+			MethodName: "ForecastCashFlow",
+			Handler:    _Finance_ForecastCashFlow_Handler,
+		},
+		{
+			MethodName: "ListAlerts",
+			Handler:    _Finance_ListAlerts_Handler,
+		},
+		{
+			MethodName: "GetAlert",
+			Handler:    _Finance_GetAlert_Handler,
+		},
+		{
+			MethodName: "AcknowledgeAlert",
+			Handler:    _Finance_AcknowledgeAlert_Handler,
+		},
+		{
+			MethodName: "SnoozeAlert",
+			Handler:    _Finance_SnoozeAlert_Handler,
+		},
 		{
 			MethodName: "CreateRecurringExpense",
 			Handler:    _Finance_CreateRecurringExpense_Handler,
@@ -1980,6 +4334,14 @@ var Finance_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListRecurringExpenses",
 			Handler:    _Finance_ListRecurringExpenses_Handler,
 		},
+		{
+			MethodName: "DetectSubscriptions",
+			Handler:    _Finance_DetectSubscriptions_Handler,
+		},
+		{
+			MethodName: "ConvertToRecurringExpense",
+			Handler:    _Finance_ConvertToRecurringExpense_Handler,
+		},
 		{
 			MethodName: "ListScheduledPayments",
 			Handler:    _Finance_ListScheduledPayments_Handler,
@@ -2048,6 +4410,42 @@ var Finance_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AdjustAccountBalance",
 			Handler:    _Finance_AdjustAccountBalance_Handler,
 		},
+		{
+			MethodName: "ListCardStatements",
+			Handler:    _Finance_ListCardStatements_Handler,
+		},
+		{
+			MethodName: "GetCardStatement",
+			Handler:    _Finance_GetCardStatement_Handler,
+		},
+		{
+			MethodName: "StartReconciliation",
+			Handler:    _Finance_StartReconciliation_Handler,
+		},
+		{
+			MethodName: "GetReconciliation",
+			Handler:    _Finance_GetReconciliation_Handler,
+		},
+		{
+			MethodName: "ListReconciliations",
+			Handler:    _Finance_ListReconciliations_Handler,
+		},
+		{
+			MethodName: "ListReconciliationTransactions",
+			Handler:    _Finance_ListReconciliationTransactions_Handler,
+		},
+		{
+			MethodName: "SetTransactionsCleared",
+			Handler:    _Finance_SetTransactionsCleared_Handler,
+		},
+		{
+			MethodName: "FinalizeReconciliation",
+			Handler:    _Finance_FinalizeReconciliation_Handler,
+		},
+		{
+			MethodName: "CancelReconciliation",
+			Handler:    _Finance_CancelReconciliation_Handler,
+		},
 		{
 			MethodName: "DeleteAccount",
 			Handler:    _Finance_DeleteAccount_Handler,
@@ -2084,7 +4482,38 @@ var Finance_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DiscardInboxItem",
 			Handler:    _Finance_DiscardInboxItem_Handler,
 		},
+		{
+			MethodName: "ImportStatement",
+			Handler:    _Finance_ImportStatement_Handler,
+		},
+		{
+			MethodName: "GetStatementMapping",
+			Handler:    _Finance_GetStatementMapping_Handler,
+		},
+		{
+			MethodName: "CreateLedgerExport",
+			Handler:    _Finance_CreateLedgerExport_Handler,
+		},
+		{
+			MethodName: "GetLedgerExport",
+			Handler:    _Finance_GetLedgerExport_Handler,
+		},
+		{
+			MethodName: "ListLedgerExports",
+			Handler:    _Finance_ListLedgerExports_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportLedger",
+			Handler:       _Finance_ExportLedger_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "DownloadLedgerExport",
+			Handler:       _Finance_DownloadLedgerExport_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "saturn/finance/v1/finance.proto",
 }
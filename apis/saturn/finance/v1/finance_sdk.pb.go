@@ -48,6 +48,20 @@ func (c *Client) GetFinanceSettings(ctx context.Context, req *GetFinanceSettings
 	return &resp, nil
 }
 
+// UpdateFinanceSettings executes PATCH /api/v1/finance/settings.
+func (c *Client) UpdateFinanceSettings(ctx context.Context, req *UpdateFinanceSettingsRequest) (*FinanceSettings, error) {
+	var resp FinanceSettings
+	path := "/api/v1/finance/settings"
+	payload := req.GetSettings()
+	if payload == nil {
+		return nil, fmt.Errorf("settings payload is required")
+	}
+	if err := c.base.Do(ctx, "PATCH", path, payload, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
 // CreateBudget executes POST /api/v1/finance/budgets.
 func (c *Client) CreateBudget(ctx context.Context, req *CreateBudgetRequest) (*Budget, error) {
 	var resp Budget
@@ -205,6 +219,218 @@ func (c *Client) UpdateExpense(ctx context.Context, req *UpdateExpenseRequest) (
 	return &resp, nil
 }
 
+// CreateIncome executes POST /api/v1/finance/incomes.
+func (c *Client) CreateIncome(ctx context.Context, req *CreateIncomeRequest) (*Transaction, error) {
+	var resp Transaction
+	path := "/api/v1/finance/incomes"
+	payload := req.GetIncome()
+	if payload == nil {
+		return nil, fmt.Errorf("income payload is required")
+	}
+	if err := c.base.Do(ctx, "POST", path, payload, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// UpdateIncome executes PUT /api/v1/finance/incomes/{id}.
+func (c *Client) UpdateIncome(ctx context.Context, req *UpdateIncomeRequest) (*Transaction, error) {
+	var resp Transaction
+	path := fmt.Sprintf("/api/v1/finance/incomes/%s", req.GetId())
+	payload := req.GetIncome()
+	if payload == nil {
+		return nil, fmt.Errorf("income payload is required")
+	}
+	if err := c.base.Do(ctx, "PUT", path, payload, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// CreateIncomeSource executes POST /api/v1/finance/income-sources.
+func (c *Client) CreateIncomeSource(ctx context.Context, req *CreateIncomeSourceRequest) (*IncomeSource, error) {
+	var resp IncomeSource
+	path := "/api/v1/finance/income-sources"
+	payload := req.GetIncomeSource()
+	if payload == nil {
+		return nil, fmt.Errorf("income_source payload is required")
+	}
+	if err := c.base.Do(ctx, "POST", path, payload, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetIncomeSource executes GET /api/v1/finance/income-sources/{id}.
+func (c *Client) GetIncomeSource(ctx context.Context, req *GetIncomeSourceRequest) (*IncomeSource, error) {
+	var resp IncomeSource
+	path := fmt.Sprintf("/api/v1/finance/income-sources/%s", req.GetId())
+	if err := c.base.Do(ctx, "GET", path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// UpdateIncomeSource executes PUT /api/v1/finance/income-sources/{id}.
+func (c *Client) UpdateIncomeSource(ctx context.Context, req *UpdateIncomeSourceRequest) (*IncomeSource, error) {
+	var resp IncomeSource
+	path := fmt.Sprintf("/api/v1/finance/income-sources/%s", req.GetId())
+	payload := req.GetIncomeSource()
+	if payload == nil {
+		return nil, fmt.Errorf("income_source payload is required")
+	}
+	if err := c.base.Do(ctx, "PUT", path, payload, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// DeleteIncomeSource executes DELETE /api/v1/finance/income-sources/{id}.
+func (c *Client) DeleteIncomeSource(ctx context.Context, req *DeleteIncomeSourceRequest) (*emptypb.Empty, error) {
+	var resp emptypb.Empty
+	path := fmt.Sprintf("/api/v1/finance/income-sources/%s", req.GetId())
+	if err := c.base.Do(ctx, "DELETE", path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListIncomeSources executes GET /api/v1/finance/income-sources.
+func (c *Client) ListIncomeSources(ctx context.Context, req *ListIncomeSourcesRequest) (*ListIncomeSourcesResponse, error) {
+	var resp ListIncomeSourcesResponse
+	path := "/api/v1/finance/income-sources"
+	if err := c.base.Do(ctx, "GET", path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// CreateGoal executes POST /api/v1/finance/goals.
+func (c *Client) CreateGoal(ctx context.Context, req *CreateGoalRequest) (*Goal, error) {
+	var resp Goal
+	path := "/api/v1/finance/goals"
+	payload := req.GetGoal()
+	if payload == nil {
+		return nil, fmt.Errorf("goal payload is required")
+	}
+	if err := c.base.Do(ctx, "POST", path, payload, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetGoal executes GET /api/v1/finance/goals/{id}.
+func (c *Client) GetGoal(ctx context.Context, req *GetGoalRequest) (*Goal, error) {
+	var resp Goal
+	path := fmt.Sprintf("/api/v1/finance/goals/%s", req.GetId())
+	if err := c.base.Do(ctx, "GET", path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// UpdateGoal executes PUT /api/v1/finance/goals/{id}.
+func (c *Client) UpdateGoal(ctx context.Context, req *UpdateGoalRequest) (*Goal, error) {
+	var resp Goal
+	path := fmt.Sprintf("/api/v1/finance/goals/%s", req.GetId())
+	payload := req.GetGoal()
+	if payload == nil {
+		return nil, fmt.Errorf("goal payload is required")
+	}
+	if err := c.base.Do(ctx, "PUT", path, payload, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// DeleteGoal executes DELETE /api/v1/finance/goals/{id}.
+func (c *Client) DeleteGoal(ctx context.Context, req *DeleteGoalRequest) (*emptypb.Empty, error) {
+	var resp emptypb.Empty
+	path := fmt.Sprintf("/api/v1/finance/goals/%s", req.GetId())
+	if err := c.base.Do(ctx, "DELETE", path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListGoals executes GET /api/v1/finance/goals.
+func (c *Client) ListGoals(ctx context.Context, req *ListGoalsRequest) (*ListGoalsResponse, error) {
+	var resp ListGoalsResponse
+	path := "/api/v1/finance/goals"
+	if err := c.base.Do(ctx, "GET", path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetGoalProgress executes GET /api/v1/finance/goals/{id}/progress.
+func (c *Client) GetGoalProgress(ctx context.Context, req *GetGoalProgressRequest) (*GoalProgress, error) {
+	var resp GoalProgress
+	path := fmt.Sprintf("/api/v1/finance/goals/%s/progress", req.GetId())
+	if err := c.base.Do(ctx, "GET", path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// CreateCategory executes POST /api/v1/finance/categories.
+func (c *Client) CreateCategory(ctx context.Context, req *CreateCategoryRequest) (*Category, error) {
+	var resp Category
+	path := "/api/v1/finance/categories"
+	payload := req.GetCategory()
+	if payload == nil {
+		return nil, fmt.Errorf("category payload is required")
+	}
+	if err := c.base.Do(ctx, "POST", path, payload, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetCategory executes GET /api/v1/finance/categories/{id}.
+func (c *Client) GetCategory(ctx context.Context, req *GetCategoryRequest) (*Category, error) {
+	var resp Category
+	path := fmt.Sprintf("/api/v1/finance/categories/%s", req.GetId())
+	if err := c.base.Do(ctx, "GET", path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// UpdateCategory executes PUT /api/v1/finance/categories/{id}.
+func (c *Client) UpdateCategory(ctx context.Context, req *UpdateCategoryRequest) (*Category, error) {
+	var resp Category
+	path := fmt.Sprintf("/api/v1/finance/categories/%s", req.GetId())
+	payload := req.GetCategory()
+	if payload == nil {
+		return nil, fmt.Errorf("category payload is required")
+	}
+	if err := c.base.Do(ctx, "PUT", path, payload, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// DeleteCategory executes DELETE /api/v1/finance/categories/{id}.
+func (c *Client) DeleteCategory(ctx context.Context, req *DeleteCategoryRequest) (*emptypb.Empty, error) {
+	var resp emptypb.Empty
+	path := fmt.Sprintf("/api/v1/finance/categories/%s", req.GetId())
+	if err := c.base.Do(ctx, "DELETE", path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListCategories executes GET /api/v1/finance/categories.
+func (c *Client) ListCategories(ctx context.Context, req *ListCategoriesRequest) (*ListCategoriesResponse, error) {
+	var resp ListCategoriesResponse
+	path := "/api/v1/finance/categories"
+	if err := c.base.Do(ctx, "GET", path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
 // DeleteTransaction executes DELETE /api/v1/finance/transactions/{id}.
 func (c *Client) DeleteTransaction(ctx context.Context, req *DeleteTransactionRequest) (*emptypb.Empty, error) {
 	var resp emptypb.Empty
@@ -225,6 +451,210 @@ func (c *Client) ListTransactions(ctx context.Context, req *ListTransactionsRequ
 	return &resp, nil
 }
 
+// BatchUpdateTransactions executes POST /api/v1/finance/transactions:batchUpdate.
+func (c *Client) BatchUpdateTransactions(ctx context.Context, req *BatchUpdateTransactionsRequest) (*BatchUpdateTransactionsResponse, error) {
+	var resp BatchUpdateTransactionsResponse
+	path := "/api/v1/finance/transactions:batchUpdate"
+	if err := c.base.Do(ctx, "POST", path, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// BatchDeleteTransactions executes POST /api/v1/finance/transactions:batchDelete.
+func (c *Client) BatchDeleteTransactions(ctx context.Context, req *BatchDeleteTransactionsRequest) (*BatchDeleteTransactionsResponse, error) {
+	var resp BatchDeleteTransactionsResponse
+	path := "/api/v1/finance/transactions:batchDelete"
+	if err := c.base.Do(ctx, "POST", path, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// CreateSavedFilter executes POST /api/v1/finance/saved-filters.
+func (c *Client) CreateSavedFilter(ctx context.Context, req *CreateSavedFilterRequest) (*SavedFilter, error) {
+	var resp SavedFilter
+	path := "/api/v1/finance/saved-filters"
+	payload := req.GetSavedFilter()
+	if payload == nil {
+		return nil, fmt.Errorf("saved_filter payload is required")
+	}
+	if err := c.base.Do(ctx, "POST", path, payload, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetSavedFilter executes GET /api/v1/finance/saved-filters/{id}.
+func (c *Client) GetSavedFilter(ctx context.Context, req *GetSavedFilterRequest) (*SavedFilter, error) {
+	var resp SavedFilter
+	path := fmt.Sprintf("/api/v1/finance/saved-filters/%s", req.GetId())
+	if err := c.base.Do(ctx, "GET", path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// UpdateSavedFilter executes PUT /api/v1/finance/saved-filters/{id}.
+func (c *Client) UpdateSavedFilter(ctx context.Context, req *UpdateSavedFilterRequest) (*SavedFilter, error) {
+	var resp SavedFilter
+	path := fmt.Sprintf("/api/v1/finance/saved-filters/%s", req.GetId())
+	payload := req.GetSavedFilter()
+	if payload == nil {
+		return nil, fmt.Errorf("saved_filter payload is required")
+	}
+	if err := c.base.Do(ctx, "PUT", path, payload, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// DeleteSavedFilter executes DELETE /api/v1/finance/saved-filters/{id}.
+func (c *Client) DeleteSavedFilter(ctx context.Context, req *DeleteSavedFilterRequest) (*emptypb.Empty, error) {
+	var resp emptypb.Empty
+	path := fmt.Sprintf("/api/v1/finance/saved-filters/%s", req.GetId())
+	if err := c.base.Do(ctx, "DELETE", path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListSavedFilters executes GET /api/v1/finance/saved-filters.
+func (c *Client) ListSavedFilters(ctx context.Context, req *ListSavedFiltersRequest) (*ListSavedFiltersResponse, error) {
+	var resp ListSavedFiltersResponse
+	path := "/api/v1/finance/saved-filters"
+	if err := c.base.Do(ctx, "GET", path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// CreatePayee executes POST /api/v1/finance/payees.
+func (c *Client) CreatePayee(ctx context.Context, req *CreatePayeeRequest) (*Payee, error) {
+	var resp Payee
+	path := "/api/v1/finance/payees"
+	payload := req.GetPayee()
+	if payload == nil {
+		return nil, fmt.Errorf("payee payload is required")
+	}
+	if err := c.base.Do(ctx, "POST", path, payload, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetPayee executes GET /api/v1/finance/payees/{id}.
+func (c *Client) GetPayee(ctx context.Context, req *GetPayeeRequest) (*Payee, error) {
+	var resp Payee
+	path := fmt.Sprintf("/api/v1/finance/payees/%s", req.GetId())
+	if err := c.base.Do(ctx, "GET", path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// UpdatePayee executes PUT /api/v1/finance/payees/{id}.
+func (c *Client) UpdatePayee(ctx context.Context, req *UpdatePayeeRequest) (*Payee, error) {
+	var resp Payee
+	path := fmt.Sprintf("/api/v1/finance/payees/%s", req.GetId())
+	payload := req.GetPayee()
+	if payload == nil {
+		return nil, fmt.Errorf("payee payload is required")
+	}
+	if err := c.base.Do(ctx, "PUT", path, payload, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// DeletePayee executes DELETE /api/v1/finance/payees/{id}.
+func (c *Client) DeletePayee(ctx context.Context, req *DeletePayeeRequest) (*emptypb.Empty, error) {
+	var resp emptypb.Empty
+	path := fmt.Sprintf("/api/v1/finance/payees/%s", req.GetId())
+	if err := c.base.Do(ctx, "DELETE", path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListPayees executes GET /api/v1/finance/payees.
+func (c *Client) ListPayees(ctx context.Context, req *ListPayeesRequest) (*ListPayeesResponse, error) {
+	var resp ListPayeesResponse
+	path := "/api/v1/finance/payees"
+	if err := c.base.Do(ctx, "GET", path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// CreateTransactionRule executes POST /api/v1/finance/transaction-rules.
+func (c *Client) CreateTransactionRule(ctx context.Context, req *CreateTransactionRuleRequest) (*TransactionRule, error) {
+	var resp TransactionRule
+	path := "/api/v1/finance/transaction-rules"
+	payload := req.GetTransactionRule()
+	if payload == nil {
+		return nil, fmt.Errorf("transaction_rule payload is required")
+	}
+	if err := c.base.Do(ctx, "POST", path, payload, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetTransactionRule executes GET /api/v1/finance/transaction-rules/{id}.
+func (c *Client) GetTransactionRule(ctx context.Context, req *GetTransactionRuleRequest) (*TransactionRule, error) {
+	var resp TransactionRule
+	path := fmt.Sprintf("/api/v1/finance/transaction-rules/%s", req.GetId())
+	if err := c.base.Do(ctx, "GET", path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// UpdateTransactionRule executes PUT /api/v1/finance/transaction-rules/{id}.
+func (c *Client) UpdateTransactionRule(ctx context.Context, req *UpdateTransactionRuleRequest) (*TransactionRule, error) {
+	var resp TransactionRule
+	path := fmt.Sprintf("/api/v1/finance/transaction-rules/%s", req.GetId())
+	payload := req.GetTransactionRule()
+	if payload == nil {
+		return nil, fmt.Errorf("transaction_rule payload is required")
+	}
+	if err := c.base.Do(ctx, "PUT", path, payload, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// DeleteTransactionRule executes DELETE /api/v1/finance/transaction-rules/{id}.
+func (c *Client) DeleteTransactionRule(ctx context.Context, req *DeleteTransactionRuleRequest) (*emptypb.Empty, error) {
+	var resp emptypb.Empty
+	path := fmt.Sprintf("/api/v1/finance/transaction-rules/%s", req.GetId())
+	if err := c.base.Do(ctx, "DELETE", path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListTransactionRules executes GET /api/v1/finance/transaction-rules.
+func (c *Client) ListTransactionRules(ctx context.Context, req *ListTransactionRulesRequest) (*ListTransactionRulesResponse, error) {
+	var resp ListTransactionRulesResponse
+	path := "/api/v1/finance/transaction-rules"
+	if err := c.base.Do(ctx, "GET", path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ApplyTransactionRules executes POST /api/v1/finance/transaction-rules:apply.
+func (c *Client) ApplyTransactionRules(ctx context.Context, req *ApplyTransactionRulesRequest) (*ApplyTransactionRulesResponse, error) {
+	var resp ApplyTransactionRulesResponse
+	path := "/api/v1/finance/transaction-rules:apply"
+	if err := c.base.Do(ctx, "POST", path, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
 // GetTransaction executes GET /api/v1/finance/transactions/{id}.
 func (c *Client) GetTransaction(ctx context.Context, req *GetTransactionRequest) (*Transaction, error) {
 	var resp Transaction
@@ -255,6 +685,56 @@ func (c *Client) GetInsights(ctx context.Context, req *GetInsightsRequest) (*Get
 	return &resp, nil
 }
 
+// ForecastCashFlow executes GET /api/v1/finance/insights/cash-flow-forecast.
+func (c *Client) ForecastCashFlow(ctx context.Context, req *ForecastCashFlowRequest) (*ForecastCashFlowResponse, error) {
+	var resp ForecastCashFlowResponse
+	path := "/api/v1/finance/insights/cash-flow-forecast"
+	if err := c.base.Do(ctx, "GET", path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListAlerts executes GET /api/v1/finance/alerts.
+func (c *Client) ListAlerts(ctx context.Context, req *ListAlertsRequest) (*ListAlertsResponse, error) {
+	var resp ListAlertsResponse
+	path := "/api/v1/finance/alerts"
+	if err := c.base.Do(ctx, "GET", path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetAlert executes GET /api/v1/finance/alerts/{id}.
+func (c *Client) GetAlert(ctx context.Context, req *GetAlertRequest) (*Alert, error) {
+	var resp Alert
+	path := fmt.Sprintf("/api/v1/finance/alerts/%s", req.GetId())
+	if err := c.base.Do(ctx, "GET", path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// AcknowledgeAlert executes POST /api/v1/finance/alerts/{id}:acknowledge.
+func (c *Client) AcknowledgeAlert(ctx context.Context, req *AcknowledgeAlertRequest) (*Alert, error) {
+	var resp Alert
+	path := fmt.Sprintf("/api/v1/finance/alerts/%s:acknowledge", req.GetId())
+	if err := c.base.Do(ctx, "POST", path, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// SnoozeAlert executes POST /api/v1/finance/alerts/{id}:snooze.
+func (c *Client) SnoozeAlert(ctx context.Context, req *SnoozeAlertRequest) (*Alert, error) {
+	var resp Alert
+	path := fmt.Sprintf("/api/v1/finance/alerts/%s:snooze", req.GetId())
+	if err := c.base.Do(ctx, "POST", path, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
 // CreateRecurringExpense executes POST /api/v1/finance/recurring-expenses.
 func (c *Client) CreateRecurringExpense(ctx context.Context, req *CreateRecurringExpenseRequest) (*RecurringExpense, error) {
 	var resp RecurringExpense
@@ -303,6 +783,26 @@ func (c *Client) ListRecurringExpenses(ctx context.Context, req *ListRecurringEx
 	return &resp, nil
 }
 
+// DetectSubscriptions executes GET /api/v1/finance/recurring-expenses:detect.
+func (c *Client) DetectSubscriptions(ctx context.Context, req *DetectSubscriptionsRequest) (*DetectSubscriptionsResponse, error) {
+	var resp DetectSubscriptionsResponse
+	path := "/api/v1/finance/recurring-expenses:detect"
+	if err := c.base.Do(ctx, "GET", path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ConvertToRecurringExpense executes POST /api/v1/finance/recurring-expenses:convert.
+func (c *Client) ConvertToRecurringExpense(ctx context.Context, req *ConvertToRecurringExpenseRequest) (*RecurringExpense, error) {
+	var resp RecurringExpense
+	path := "/api/v1/finance/recurring-expenses:convert"
+	if err := c.base.Do(ctx, "POST", path, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
 // ListScheduledPayments executes GET /api/v1/finance/scheduled-payments.
 func (c *Client) ListScheduledPayments(ctx context.Context, req *ListScheduledPaymentsRequest) (*ListScheduledPaymentsResponse, error) {
 	var resp ListScheduledPaymentsResponse
@@ -493,6 +993,100 @@ func (c *Client) AdjustAccountBalance(ctx context.Context, req *AdjustAccountBal
 	return &resp, nil
 }
 
+// ListCardStatements executes GET /api/v1/finance/accounts/{account_id}/card-statements.
+func (c *Client) ListCardStatements(ctx context.Context, req *ListCardStatementsRequest) (*ListCardStatementsResponse, error) {
+	var resp ListCardStatementsResponse
+	path := fmt.Sprintf("/api/v1/finance/accounts/%s/card-statements", req.GetAccountId())
+	if err := c.base.Do(ctx, "GET", path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetCardStatement executes GET /api/v1/finance/card-statements/{id}.
+func (c *Client) GetCardStatement(ctx context.Context, req *GetCardStatementRequest) (*CardStatement, error) {
+	var resp CardStatement
+	path := fmt.Sprintf("/api/v1/finance/card-statements/%s", req.GetId())
+	if err := c.base.Do(ctx, "GET", path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// StartReconciliation executes POST /api/v1/finance/accounts/{account_id}/reconciliations.
+func (c *Client) StartReconciliation(ctx context.Context, req *StartReconciliationRequest) (*Reconciliation, error) {
+	var resp Reconciliation
+	path := fmt.Sprintf("/api/v1/finance/accounts/%s/reconciliations", req.GetAccountId())
+	payload := req.GetReconciliation()
+	if payload == nil {
+		return nil, fmt.Errorf("reconciliation payload is required")
+	}
+	if err := c.base.Do(ctx, "POST", path, payload, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetReconciliation executes GET /api/v1/finance/reconciliations/{id}.
+func (c *Client) GetReconciliation(ctx context.Context, req *GetReconciliationRequest) (*Reconciliation, error) {
+	var resp Reconciliation
+	path := fmt.Sprintf("/api/v1/finance/reconciliations/%s", req.GetId())
+	if err := c.base.Do(ctx, "GET", path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListReconciliations executes GET /api/v1/finance/accounts/{account_id}/reconciliations.
+func (c *Client) ListReconciliations(ctx context.Context, req *ListReconciliationsRequest) (*ListReconciliationsResponse, error) {
+	var resp ListReconciliationsResponse
+	path := fmt.Sprintf("/api/v1/finance/accounts/%s/reconciliations", req.GetAccountId())
+	if err := c.base.Do(ctx, "GET", path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListReconciliationTransactions executes GET /api/v1/finance/reconciliations/{id}/transactions.
+func (c *Client) ListReconciliationTransactions(ctx context.Context, req *ListReconciliationTransactionsRequest) (*ListReconciliationTransactionsResponse, error) {
+	var resp ListReconciliationTransactionsResponse
+	path := fmt.Sprintf("/api/v1/finance/reconciliations/%s/transactions", req.GetId())
+	if err := c.base.Do(ctx, "GET", path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// SetTransactionsCleared executes POST /api/v1/finance/reconciliations/{reconciliation_id}:set-cleared.
+func (c *Client) SetTransactionsCleared(ctx context.Context, req *SetTransactionsClearedRequest) (*Reconciliation, error) {
+	var resp Reconciliation
+	path := fmt.Sprintf("/api/v1/finance/reconciliations/%s:set-cleared", req.GetReconciliationId())
+	if err := c.base.Do(ctx, "POST", path, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// FinalizeReconciliation executes POST /api/v1/finance/reconciliations/{id}:finalize.
+func (c *Client) FinalizeReconciliation(ctx context.Context, req *FinalizeReconciliationRequest) (*Reconciliation, error) {
+	var resp Reconciliation
+	path := fmt.Sprintf("/api/v1/finance/reconciliations/%s:finalize", req.GetId())
+	if err := c.base.Do(ctx, "POST", path, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// CancelReconciliation executes DELETE /api/v1/finance/reconciliations/{id}.
+func (c *Client) CancelReconciliation(ctx context.Context, req *CancelReconciliationRequest) (*emptypb.Empty, error) {
+	var resp emptypb.Empty
+	path := fmt.Sprintf("/api/v1/finance/reconciliations/%s", req.GetId())
+	if err := c.base.Do(ctx, "DELETE", path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
 // DeleteAccount executes DELETE /api/v1/finance/accounts/{id}.
 func (c *Client) DeleteAccount(ctx context.Context, req *DeleteAccountRequest) (*emptypb.Empty, error) {
 	var resp emptypb.Empty
@@ -596,3 +1190,57 @@ func (c *Client) DiscardInboxItem(ctx context.Context, req *DiscardInboxItemRequ
 	}
 	return &resp, nil
 }
+
+// ImportStatement executes POST /api/v1/finance/accounts/{account_id}/statements:import.
+func (c *Client) ImportStatement(ctx context.Context, req *ImportStatementRequest) (*ImportStatementResponse, error) {
+	var resp ImportStatementResponse
+	path := fmt.Sprintf("/api/v1/finance/accounts/%s/statements:import", req.GetAccountId())
+	if err := c.base.Do(ctx, "POST", path, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetStatementMapping executes GET /api/v1/finance/accounts/{account_id}/statement-mapping.
+func (c *Client) GetStatementMapping(ctx context.Context, req *GetStatementMappingRequest) (*StatementMapping, error) {
+	var resp StatementMapping
+	path := fmt.Sprintf("/api/v1/finance/accounts/%s/statement-mapping", req.GetAccountId())
+	if err := c.base.Do(ctx, "GET", path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// CreateLedgerExport executes POST /api/v1/finance/ledger-exports.
+func (c *Client) CreateLedgerExport(ctx context.Context, req *CreateLedgerExportRequest) (*LedgerExport, error) {
+	var resp LedgerExport
+	path := "/api/v1/finance/ledger-exports"
+	payload := req.GetLedgerExport()
+	if payload == nil {
+		return nil, fmt.Errorf("ledger_export payload is required")
+	}
+	if err := c.base.Do(ctx, "POST", path, payload, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetLedgerExport executes GET /api/v1/finance/ledger-exports/{id}.
+func (c *Client) GetLedgerExport(ctx context.Context, req *GetLedgerExportRequest) (*LedgerExport, error) {
+	var resp LedgerExport
+	path := fmt.Sprintf("/api/v1/finance/ledger-exports/%s", req.GetId())
+	if err := c.base.Do(ctx, "GET", path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListLedgerExports executes GET /api/v1/finance/ledger-exports.
+func (c *Client) ListLedgerExports(ctx context.Context, req *ListLedgerExportsRequest) (*ListLedgerExportsResponse, error) {
+	var resp ListLedgerExportsResponse
+	path := "/api/v1/finance/ledger-exports"
+	if err := c.base.Do(ctx, "GET", path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
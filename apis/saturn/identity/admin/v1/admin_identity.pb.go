@@ -475,6 +475,86 @@ func (x *RevokeAllSessionsResponse) GetRevokedCount() int64 {
 	return 0
 }
 
+type ResetUserMFARequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetUserMFARequest) Reset() {
+	*x = ResetUserMFARequest{}
+	mi := &file_saturn_identity_admin_v1_admin_identity_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetUserMFARequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetUserMFARequest) ProtoMessage() {}
+
+func (x *ResetUserMFARequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_identity_admin_v1_admin_identity_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetUserMFARequest.ProtoReflect.Descriptor instead.
+func (*ResetUserMFARequest) Descriptor() ([]byte, []int) {
+	return file_saturn_identity_admin_v1_admin_identity_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ResetUserMFARequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ResetUserMFAResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetUserMFAResponse) Reset() {
+	*x = ResetUserMFAResponse{}
+	mi := &file_saturn_identity_admin_v1_admin_identity_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetUserMFAResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetUserMFAResponse) ProtoMessage() {}
+
+func (x *ResetUserMFAResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_identity_admin_v1_admin_identity_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetUserMFAResponse.ProtoReflect.Descriptor instead.
+func (*ResetUserMFAResponse) Descriptor() ([]byte, []int) {
+	return file_saturn_identity_admin_v1_admin_identity_proto_rawDescGZIP(), []int{8}
+}
+
 type UpdateUserRoleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
@@ -484,7 +564,7 @@ type UpdateUserRoleResponse struct {
 
 func (x *UpdateUserRoleResponse) Reset() {
 	*x = UpdateUserRoleResponse{}
-	mi := &file_saturn_identity_admin_v1_admin_identity_proto_msgTypes[7]
+	mi := &file_saturn_identity_admin_v1_admin_identity_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -496,7 +576,7 @@ func (x *UpdateUserRoleResponse) String() string {
 func (*UpdateUserRoleResponse) ProtoMessage() {}
 
 func (x *UpdateUserRoleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_identity_admin_v1_admin_identity_proto_msgTypes[7]
+	mi := &file_saturn_identity_admin_v1_admin_identity_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -509,7 +589,7 @@ func (x *UpdateUserRoleResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateUserRoleResponse.ProtoReflect.Descriptor instead.
 func (*UpdateUserRoleResponse) Descriptor() ([]byte, []int) {
-	return file_saturn_identity_admin_v1_admin_identity_proto_rawDescGZIP(), []int{7}
+	return file_saturn_identity_admin_v1_admin_identity_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *UpdateUserRoleResponse) GetUser() *User {
@@ -528,7 +608,7 @@ type ApproveUserResponse struct {
 
 func (x *ApproveUserResponse) Reset() {
 	*x = ApproveUserResponse{}
-	mi := &file_saturn_identity_admin_v1_admin_identity_proto_msgTypes[8]
+	mi := &file_saturn_identity_admin_v1_admin_identity_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -540,7 +620,7 @@ func (x *ApproveUserResponse) String() string {
 func (*ApproveUserResponse) ProtoMessage() {}
 
 func (x *ApproveUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_identity_admin_v1_admin_identity_proto_msgTypes[8]
+	mi := &file_saturn_identity_admin_v1_admin_identity_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -553,7 +633,7 @@ func (x *ApproveUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApproveUserResponse.ProtoReflect.Descriptor instead.
 func (*ApproveUserResponse) Descriptor() ([]byte, []int) {
-	return file_saturn_identity_admin_v1_admin_identity_proto_rawDescGZIP(), []int{8}
+	return file_saturn_identity_admin_v1_admin_identity_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ApproveUserResponse) GetUser() *User {
@@ -576,13 +656,14 @@ type User struct {
 	Version       int64                  `protobuf:"varint,8,opt,name=version,proto3" json:"version,omitempty"`
 	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
 	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	EmailVerified bool                   `protobuf:"varint,11,opt,name=email_verified,json=emailVerified,proto3" json:"email_verified,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *User) Reset() {
 	*x = User{}
-	mi := &file_saturn_identity_admin_v1_admin_identity_proto_msgTypes[9]
+	mi := &file_saturn_identity_admin_v1_admin_identity_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -594,7 +675,7 @@ func (x *User) String() string {
 func (*User) ProtoMessage() {}
 
 func (x *User) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_identity_admin_v1_admin_identity_proto_msgTypes[9]
+	mi := &file_saturn_identity_admin_v1_admin_identity_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -607,7 +688,7 @@ func (x *User) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use User.ProtoReflect.Descriptor instead.
 func (*User) Descriptor() ([]byte, []int) {
-	return file_saturn_identity_admin_v1_admin_identity_proto_rawDescGZIP(), []int{9}
+	return file_saturn_identity_admin_v1_admin_identity_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *User) GetId() string {
@@ -680,6 +761,13 @@ func (x *User) GetUpdateTime() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *User) GetEmailVerified() bool {
+	if x != nil {
+		return x.EmailVerified
+	}
+	return false
+}
+
 type SecurityEvent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -695,7 +783,7 @@ type SecurityEvent struct {
 
 func (x *SecurityEvent) Reset() {
 	*x = SecurityEvent{}
-	mi := &file_saturn_identity_admin_v1_admin_identity_proto_msgTypes[10]
+	mi := &file_saturn_identity_admin_v1_admin_identity_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -707,7 +795,7 @@ func (x *SecurityEvent) String() string {
 func (*SecurityEvent) ProtoMessage() {}
 
 func (x *SecurityEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_identity_admin_v1_admin_identity_proto_msgTypes[10]
+	mi := &file_saturn_identity_admin_v1_admin_identity_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -720,7 +808,7 @@ func (x *SecurityEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SecurityEvent.ProtoReflect.Descriptor instead.
 func (*SecurityEvent) Descriptor() ([]byte, []int) {
-	return file_saturn_identity_admin_v1_admin_identity_proto_rawDescGZIP(), []int{10}
+	return file_saturn_identity_admin_v1_admin_identity_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *SecurityEvent) GetId() string {
@@ -784,7 +872,7 @@ type ListSecurityEventsRequest struct {
 
 func (x *ListSecurityEventsRequest) Reset() {
 	*x = ListSecurityEventsRequest{}
-	mi := &file_saturn_identity_admin_v1_admin_identity_proto_msgTypes[11]
+	mi := &file_saturn_identity_admin_v1_admin_identity_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -796,7 +884,7 @@ func (x *ListSecurityEventsRequest) String() string {
 func (*ListSecurityEventsRequest) ProtoMessage() {}
 
 func (x *ListSecurityEventsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_identity_admin_v1_admin_identity_proto_msgTypes[11]
+	mi := &file_saturn_identity_admin_v1_admin_identity_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -809,7 +897,7 @@ func (x *ListSecurityEventsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListSecurityEventsRequest.ProtoReflect.Descriptor instead.
 func (*ListSecurityEventsRequest) Descriptor() ([]byte, []int) {
-	return file_saturn_identity_admin_v1_admin_identity_proto_rawDescGZIP(), []int{11}
+	return file_saturn_identity_admin_v1_admin_identity_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ListSecurityEventsRequest) GetEmail() string {
@@ -850,7 +938,7 @@ type ListSecurityEventsResponse struct {
 
 func (x *ListSecurityEventsResponse) Reset() {
 	*x = ListSecurityEventsResponse{}
-	mi := &file_saturn_identity_admin_v1_admin_identity_proto_msgTypes[12]
+	mi := &file_saturn_identity_admin_v1_admin_identity_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -862,7 +950,7 @@ func (x *ListSecurityEventsResponse) String() string {
 func (*ListSecurityEventsResponse) ProtoMessage() {}
 
 func (x *ListSecurityEventsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_identity_admin_v1_admin_identity_proto_msgTypes[12]
+	mi := &file_saturn_identity_admin_v1_admin_identity_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -875,7 +963,7 @@ func (x *ListSecurityEventsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListSecurityEventsResponse.ProtoReflect.Descriptor instead.
 func (*ListSecurityEventsResponse) Descriptor() ([]byte, []int) {
-	return file_saturn_identity_admin_v1_admin_identity_proto_rawDescGZIP(), []int{12}
+	return file_saturn_identity_admin_v1_admin_identity_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ListSecurityEventsResponse) GetEvents() []*SecurityEvent {
@@ -922,11 +1010,14 @@ const file_saturn_identity_admin_v1_admin_identity_proto_rawDesc = "" +
 	"\x18RevokeAllSessionsRequest\x12\x1c\n" +
 	"\auser_id\x18\x01 \x01(\tB\x03\xe0A\x02R\x06userId\"@\n" +
 	"\x19RevokeAllSessionsResponse\x12#\n" +
-	"\rrevoked_count\x18\x01 \x01(\x03R\frevokedCount\"L\n" +
+	"\rrevoked_count\x18\x01 \x01(\x03R\frevokedCount\"3\n" +
+	"\x13ResetUserMFARequest\x12\x1c\n" +
+	"\auser_id\x18\x01 \x01(\tB\x03\xe0A\x02R\x06userId\"\x16\n" +
+	"\x14ResetUserMFAResponse\"L\n" +
 	"\x16UpdateUserRoleResponse\x122\n" +
 	"\x04user\x18\x01 \x01(\v2\x1e.saturn.identity.admin.v1.UserR\x04user\"I\n" +
 	"\x13ApproveUserResponse\x122\n" +
-	"\x04user\x18\x01 \x01(\v2\x1e.saturn.identity.admin.v1.UserR\x04user\"\xf1\x02\n" +
+	"\x04user\x18\x01 \x01(\v2\x1e.saturn.identity.admin.v1.UserR\x04user\"\x98\x03\n" +
 	"\x04User\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05email\x18\x02 \x01(\tR\x05email\x12\x1a\n" +
@@ -941,7 +1032,8 @@ const file_saturn_identity_admin_v1_admin_identity_proto_rawDesc = "" +
 	"createTime\x12;\n" +
 	"\vupdate_time\x18\n" +
 	" \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"updateTime\"\xe6\x01\n" +
+	"updateTime\x12%\n" +
+	"\x0eemail_verified\x18\v \x01(\bR\remailVerified\"\xe6\x01\n" +
 	"\rSecurityEvent\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x14\n" +
@@ -966,14 +1058,15 @@ const file_saturn_identity_admin_v1_admin_identity_proto_rawDesc = "" +
 	"\vAccessLevel\x12\x1c\n" +
 	"\x18ACCESS_LEVEL_UNSPECIFIED\x10\x00\x12\x15\n" +
 	"\x11ACCESS_LEVEL_USER\x10\x01\x12\x16\n" +
-	"\x12ACCESS_LEVEL_ADMIN\x10\x022\xee\a\n" +
+	"\x12ACCESS_LEVEL_ADMIN\x10\x022\x97\t\n" +
 	"\rAdminIdentity\x12\x86\x01\n" +
 	"\tListUsers\x12*.saturn.identity.admin.v1.ListUsersRequest\x1a+.saturn.identity.admin.v1.ListUsersResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/v1/admin/identity/users\x12\xa1\x01\n" +
 	"\vApproveUser\x12,.saturn.identity.admin.v1.ApproveUserRequest\x1a-.saturn.identity.admin.v1.ApproveUserResponse\"5\x82\xd3\xe4\x93\x02/:\x01*\"*/v1/admin/identity/users/{user_id}:approve\x12\x9e\x01\n" +
 	"\n" +
 	"RejectUser\x12+.saturn.identity.admin.v1.RejectUserRequest\x1a-.saturn.identity.admin.v1.ApproveUserResponse\"4\x82\xd3\xe4\x93\x02.:\x01*\")/v1/admin/identity/users/{user_id}:reject\x12\xa2\x01\n" +
 	"\x0eUpdateUserRole\x12/.saturn.identity.admin.v1.UpdateUserRoleRequest\x1a0.saturn.identity.admin.v1.UpdateUserRoleResponse\"-\x82\xd3\xe4\x93\x02':\x01*2\"/v1/admin/identity/users/{user_id}\x12\xbb\x01\n" +
-	"\x11RevokeAllSessions\x122.saturn.identity.admin.v1.RevokeAllSessionsRequest\x1a3.saturn.identity.admin.v1.RevokeAllSessionsResponse\"=\x82\xd3\xe4\x93\x027:\x01*\"2/v1/admin/identity/users/{user_id}:revoke-sessions\x12\xab\x01\n" +
+	"\x11RevokeAllSessions\x122.saturn.identity.admin.v1.RevokeAllSessionsRequest\x1a3.saturn.identity.admin.v1.RevokeAllSessionsResponse\"=\x82\xd3\xe4\x93\x027:\x01*\"2/v1/admin/identity/users/{user_id}:revoke-sessions\x12\xa6\x01\n" +
+	"\fResetUserMFA\x12-.saturn.identity.admin.v1.ResetUserMFARequest\x1a..saturn.identity.admin.v1.ResetUserMFAResponse\"7\x82\xd3\xe4\x93\x021:\x01*\",/v1/admin/identity/users/{user_id}:reset-mfa\x12\xab\x01\n" +
 	"\x12ListSecurityEvents\x123.saturn.identity.admin.v1.ListSecurityEventsRequest\x1a4.saturn.identity.admin.v1.ListSecurityEventsResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/v1/admin/identity/security-eventsBNZLgithub.com/masterkeysrd/saturn/apis/saturn/identity/admin/v1;adminidentityv1b\x06proto3"
 
 var (
@@ -989,7 +1082,7 @@ func file_saturn_identity_admin_v1_admin_identity_proto_rawDescGZIP() []byte {
 }
 
 var file_saturn_identity_admin_v1_admin_identity_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_saturn_identity_admin_v1_admin_identity_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_saturn_identity_admin_v1_admin_identity_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_saturn_identity_admin_v1_admin_identity_proto_goTypes = []any{
 	(AccessLevel)(0),                   // 0: saturn.identity.admin.v1.AccessLevel
 	(ListUsersRequest_StatusFilter)(0), // 1: saturn.identity.admin.v1.ListUsersRequest.StatusFilter
@@ -1000,39 +1093,43 @@ var file_saturn_identity_admin_v1_admin_identity_proto_goTypes = []any{
 	(*UpdateUserRoleRequest)(nil),      // 6: saturn.identity.admin.v1.UpdateUserRoleRequest
 	(*RevokeAllSessionsRequest)(nil),   // 7: saturn.identity.admin.v1.RevokeAllSessionsRequest
 	(*RevokeAllSessionsResponse)(nil),  // 8: saturn.identity.admin.v1.RevokeAllSessionsResponse
-	(*UpdateUserRoleResponse)(nil),     // 9: saturn.identity.admin.v1.UpdateUserRoleResponse
-	(*ApproveUserResponse)(nil),        // 10: saturn.identity.admin.v1.ApproveUserResponse
-	(*User)(nil),                       // 11: saturn.identity.admin.v1.User
-	(*SecurityEvent)(nil),              // 12: saturn.identity.admin.v1.SecurityEvent
-	(*ListSecurityEventsRequest)(nil),  // 13: saturn.identity.admin.v1.ListSecurityEventsRequest
-	(*ListSecurityEventsResponse)(nil), // 14: saturn.identity.admin.v1.ListSecurityEventsResponse
-	(*timestamppb.Timestamp)(nil),      // 15: google.protobuf.Timestamp
+	(*ResetUserMFARequest)(nil),        // 9: saturn.identity.admin.v1.ResetUserMFARequest
+	(*ResetUserMFAResponse)(nil),       // 10: saturn.identity.admin.v1.ResetUserMFAResponse
+	(*UpdateUserRoleResponse)(nil),     // 11: saturn.identity.admin.v1.UpdateUserRoleResponse
+	(*ApproveUserResponse)(nil),        // 12: saturn.identity.admin.v1.ApproveUserResponse
+	(*User)(nil),                       // 13: saturn.identity.admin.v1.User
+	(*SecurityEvent)(nil),              // 14: saturn.identity.admin.v1.SecurityEvent
+	(*ListSecurityEventsRequest)(nil),  // 15: saturn.identity.admin.v1.ListSecurityEventsRequest
+	(*ListSecurityEventsResponse)(nil), // 16: saturn.identity.admin.v1.ListSecurityEventsResponse
+	(*timestamppb.Timestamp)(nil),      // 17: google.protobuf.Timestamp
 }
 var file_saturn_identity_admin_v1_admin_identity_proto_depIdxs = []int32{
 	1,  // 0: saturn.identity.admin.v1.ListUsersRequest.status_filter:type_name -> saturn.identity.admin.v1.ListUsersRequest.StatusFilter
-	11, // 1: saturn.identity.admin.v1.ListUsersResponse.users:type_name -> saturn.identity.admin.v1.User
+	13, // 1: saturn.identity.admin.v1.ListUsersResponse.users:type_name -> saturn.identity.admin.v1.User
 	0,  // 2: saturn.identity.admin.v1.UpdateUserRoleRequest.access_level:type_name -> saturn.identity.admin.v1.AccessLevel
-	11, // 3: saturn.identity.admin.v1.UpdateUserRoleResponse.user:type_name -> saturn.identity.admin.v1.User
-	11, // 4: saturn.identity.admin.v1.ApproveUserResponse.user:type_name -> saturn.identity.admin.v1.User
+	13, // 3: saturn.identity.admin.v1.UpdateUserRoleResponse.user:type_name -> saturn.identity.admin.v1.User
+	13, // 4: saturn.identity.admin.v1.ApproveUserResponse.user:type_name -> saturn.identity.admin.v1.User
 	0,  // 5: saturn.identity.admin.v1.User.access_level:type_name -> saturn.identity.admin.v1.AccessLevel
-	15, // 6: saturn.identity.admin.v1.User.create_time:type_name -> google.protobuf.Timestamp
-	15, // 7: saturn.identity.admin.v1.User.update_time:type_name -> google.protobuf.Timestamp
-	15, // 8: saturn.identity.admin.v1.SecurityEvent.created_at:type_name -> google.protobuf.Timestamp
-	12, // 9: saturn.identity.admin.v1.ListSecurityEventsResponse.events:type_name -> saturn.identity.admin.v1.SecurityEvent
+	17, // 6: saturn.identity.admin.v1.User.create_time:type_name -> google.protobuf.Timestamp
+	17, // 7: saturn.identity.admin.v1.User.update_time:type_name -> google.protobuf.Timestamp
+	17, // 8: saturn.identity.admin.v1.SecurityEvent.created_at:type_name -> google.protobuf.Timestamp
+	14, // 9: saturn.identity.admin.v1.ListSecurityEventsResponse.events:type_name -> saturn.identity.admin.v1.SecurityEvent
 	2,  // 10: saturn.identity.admin.v1.AdminIdentity.ListUsers:input_type -> saturn.identity.admin.v1.ListUsersRequest
 	4,  // 11: saturn.identity.admin.v1.AdminIdentity.ApproveUser:input_type -> saturn.identity.admin.v1.ApproveUserRequest
 	5,  // 12: saturn.identity.admin.v1.AdminIdentity.RejectUser:input_type -> saturn.identity.admin.v1.RejectUserRequest
 	6,  // 13: saturn.identity.admin.v1.AdminIdentity.UpdateUserRole:input_type -> saturn.identity.admin.v1.UpdateUserRoleRequest
 	7,  // 14: saturn.identity.admin.v1.AdminIdentity.RevokeAllSessions:input_type -> saturn.identity.admin.v1.RevokeAllSessionsRequest
-	13, // 15: saturn.identity.admin.v1.AdminIdentity.ListSecurityEvents:input_type -> saturn.identity.admin.v1.ListSecurityEventsRequest
-	3,  // 16: saturn.identity.admin.v1.AdminIdentity.ListUsers:output_type -> saturn.identity.admin.v1.ListUsersResponse
-	10, // 17: saturn.identity.admin.v1.AdminIdentity.ApproveUser:output_type -> saturn.identity.admin.v1.ApproveUserResponse
-	10, // 18: saturn.identity.admin.v1.AdminIdentity.RejectUser:output_type -> saturn.identity.admin.v1.ApproveUserResponse
-	9,  // 19: saturn.identity.admin.v1.AdminIdentity.UpdateUserRole:output_type -> saturn.identity.admin.v1.UpdateUserRoleResponse
-	8,  // 20: saturn.identity.admin.v1.AdminIdentity.RevokeAllSessions:output_type -> saturn.identity.admin.v1.RevokeAllSessionsResponse
-	14, // 21: saturn.identity.admin.v1.AdminIdentity.ListSecurityEvents:output_type -> saturn.identity.admin.v1.ListSecurityEventsResponse
-	16, // [16:22] is the sub-list for method output_type
-	10, // [10:16] is the sub-list for method input_type
+	9,  // 15: saturn.identity.admin.v1.AdminIdentity.ResetUserMFA:input_type -> saturn.identity.admin.v1.ResetUserMFARequest
+	15, // 16: saturn.identity.admin.v1.AdminIdentity.ListSecurityEvents:input_type -> saturn.identity.admin.v1.ListSecurityEventsRequest
+	3,  // 17: saturn.identity.admin.v1.AdminIdentity.ListUsers:output_type -> saturn.identity.admin.v1.ListUsersResponse
+	12, // 18: saturn.identity.admin.v1.AdminIdentity.ApproveUser:output_type -> saturn.identity.admin.v1.ApproveUserResponse
+	12, // 19: saturn.identity.admin.v1.AdminIdentity.RejectUser:output_type -> saturn.identity.admin.v1.ApproveUserResponse
+	11, // 20: saturn.identity.admin.v1.AdminIdentity.UpdateUserRole:output_type -> saturn.identity.admin.v1.UpdateUserRoleResponse
+	8,  // 21: saturn.identity.admin.v1.AdminIdentity.RevokeAllSessions:output_type -> saturn.identity.admin.v1.RevokeAllSessionsResponse
+	10, // 22: saturn.identity.admin.v1.AdminIdentity.ResetUserMFA:output_type -> saturn.identity.admin.v1.ResetUserMFAResponse
+	16, // 23: saturn.identity.admin.v1.AdminIdentity.ListSecurityEvents:output_type -> saturn.identity.admin.v1.ListSecurityEventsResponse
+	17, // [17:24] is the sub-list for method output_type
+	10, // [10:17] is the sub-list for method input_type
 	10, // [10:10] is the sub-list for extension type_name
 	10, // [10:10] is the sub-list for extension extendee
 	0,  // [0:10] is the sub-list for field type_name
@@ -1049,7 +1146,7 @@ func file_saturn_identity_admin_v1_admin_identity_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_saturn_identity_admin_v1_admin_identity_proto_rawDesc), len(file_saturn_identity_admin_v1_admin_identity_proto_rawDesc)),
 			NumEnums:      2,
-			NumMessages:   13,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -24,6 +24,7 @@ const (
 	AdminIdentity_RejectUser_FullMethodName         = "/saturn.identity.admin.v1.AdminIdentity/RejectUser"
 	AdminIdentity_UpdateUserRole_FullMethodName     = "/saturn.identity.admin.v1.AdminIdentity/UpdateUserRole"
 	AdminIdentity_RevokeAllSessions_FullMethodName  = "/saturn.identity.admin.v1.AdminIdentity/RevokeAllSessions"
+	AdminIdentity_ResetUserMFA_FullMethodName       = "/saturn.identity.admin.v1.AdminIdentity/ResetUserMFA"
 	AdminIdentity_ListSecurityEvents_FullMethodName = "/saturn.identity.admin.v1.AdminIdentity/ListSecurityEvents"
 )
 
@@ -43,6 +44,8 @@ type AdminIdentityClient interface {
 	UpdateUserRole(ctx context.Context, in *UpdateUserRoleRequest, opts ...grpc.CallOption) (*UpdateUserRoleResponse, error)
 	// RevokeAllSessions revokes all sessions for a user and increments auth_version.
 	RevokeAllSessions(ctx context.Context, in *RevokeAllSessionsRequest, opts ...grpc.CallOption) (*RevokeAllSessionsResponse, error)
+	// ResetUserMFA removes a user's two-factor authentication and signs them out everywhere.
+	ResetUserMFA(ctx context.Context, in *ResetUserMFARequest, opts ...grpc.CallOption) (*ResetUserMFAResponse, error)
 	// ListSecurityEvents returns a list of security audit logs.
 	ListSecurityEvents(ctx context.Context, in *ListSecurityEventsRequest, opts ...grpc.CallOption) (*ListSecurityEventsResponse, error)
 }
@@ -105,6 +108,16 @@ func (c *adminIdentityClient) RevokeAllSessions(ctx context.Context, in *RevokeA
 	return out, nil
 }
 
+func (c *adminIdentityClient) ResetUserMFA(ctx context.Context, in *ResetUserMFARequest, opts ...grpc.CallOption) (*ResetUserMFAResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResetUserMFAResponse)
+	err := c.cc.Invoke(ctx, AdminIdentity_ResetUserMFA_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminIdentityClient) ListSecurityEvents(ctx context.Context, in *ListSecurityEventsRequest, opts ...grpc.CallOption) (*ListSecurityEventsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListSecurityEventsResponse)
@@ -131,6 +144,8 @@ type AdminIdentityServer interface {
 	UpdateUserRole(context.Context, *UpdateUserRoleRequest) (*UpdateUserRoleResponse, error)
 	// RevokeAllSessions revokes all sessions for a user and increments auth_version.
 	RevokeAllSessions(context.Context, *RevokeAllSessionsRequest) (*RevokeAllSessionsResponse, error)
+	// ResetUserMFA removes a user's two-factor authentication and signs them out everywhere.
+	ResetUserMFA(context.Context, *ResetUserMFARequest) (*ResetUserMFAResponse, error)
 	// ListSecurityEvents returns a list of security audit logs.
 	ListSecurityEvents(context.Context, *ListSecurityEventsRequest) (*ListSecurityEventsResponse, error)
 }
@@ -157,6 +172,9 @@ func (UnimplementedAdminIdentityServer) UpdateUserRole(context.Context, *UpdateU
 func (UnimplementedAdminIdentityServer) RevokeAllSessions(context.Context, *RevokeAllSessionsRequest) (*RevokeAllSessionsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method RevokeAllSessions not implemented")
 }
+func (UnimplementedAdminIdentityServer) ResetUserMFA(context.Context, *ResetUserMFARequest) (*ResetUserMFAResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResetUserMFA not implemented")
+}
 func (UnimplementedAdminIdentityServer) ListSecurityEvents(context.Context, *ListSecurityEventsRequest) (*ListSecurityEventsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListSecurityEvents not implemented")
 }
@@ -270,6 +288,24 @@ func _AdminIdentity_RevokeAllSessions_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminIdentity_ResetUserMFA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetUserMFARequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminIdentityServer).ResetUserMFA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminIdentity_ResetUserMFA_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminIdentityServer).ResetUserMFA(ctx, req.(*ResetUserMFARequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminIdentity_ListSecurityEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListSecurityEventsRequest)
 	if err := dec(in); err != nil {
@@ -315,6 +351,10 @@ var AdminIdentity_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RevokeAllSessions",
 			Handler:    _AdminIdentity_RevokeAllSessions_Handler,
 		},
+		{
+			MethodName: "ResetUserMFA",
+			Handler:    _AdminIdentity_ResetUserMFA_Handler,
+		},
 		{
 			MethodName: "ListSecurityEvents",
 			Handler:    _AdminIdentity_ListSecurityEvents_Handler,
@@ -74,6 +74,16 @@ func (c *Client) RevokeAllSessions(ctx context.Context, req *RevokeAllSessionsRe
 	return &resp, nil
 }
 
+// ResetUserMFA executes POST /api/v1/admin/identity/users/{user_id}:reset-mfa.
+func (c *Client) ResetUserMFA(ctx context.Context, req *ResetUserMFARequest) (*ResetUserMFAResponse, error) {
+	var resp ResetUserMFAResponse
+	path := fmt.Sprintf("/api/v1/admin/identity/users/%s:reset-mfa", req.GetUserId())
+	if err := c.base.Do(ctx, "POST", path, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
 // ListSecurityEvents executes GET /api/v1/admin/identity/security-events.
 func (c *Client) ListSecurityEvents(ctx context.Context, req *ListSecurityEventsRequest) (*ListSecurityEventsResponse, error) {
 	var resp ListSecurityEventsResponse
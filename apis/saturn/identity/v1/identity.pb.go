@@ -29,6 +29,8 @@ type LoginUserRequest struct {
 	// Types that are valid to be assigned to Method:
 	//
 	//	*LoginUserRequest_UserPassword_
+	//	*LoginUserRequest_MfaChallenge
+	//	*LoginUserRequest_Passkey
 	Method        isLoginUserRequest_Method `protobuf_oneof:"method"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -80,6 +82,24 @@ func (x *LoginUserRequest) GetUserPassword() *LoginUserRequest_UserPassword {
 	return nil
 }
 
+func (x *LoginUserRequest) GetMfaChallenge() *LoginUserRequest_MFAChallenge {
+	if x != nil {
+		if x, ok := x.Method.(*LoginUserRequest_MfaChallenge); ok {
+			return x.MfaChallenge
+		}
+	}
+	return nil
+}
+
+func (x *LoginUserRequest) GetPasskey() *LoginUserRequest_PasskeyAssertion {
+	if x != nil {
+		if x, ok := x.Method.(*LoginUserRequest_Passkey); ok {
+			return x.Passkey
+		}
+	}
+	return nil
+}
+
 type isLoginUserRequest_Method interface {
 	isLoginUserRequest_Method()
 }
@@ -89,8 +109,22 @@ type LoginUserRequest_UserPassword_ struct {
 	UserPassword *LoginUserRequest_UserPassword `protobuf:"bytes,1,opt,name=user_password,json=userPassword,proto3,oneof"`
 }
 
+type LoginUserRequest_MfaChallenge struct {
+	// MFAChallenge second step of a two-factor login.
+	MfaChallenge *LoginUserRequest_MFAChallenge `protobuf:"bytes,2,opt,name=mfa_challenge,json=mfaChallenge,proto3,oneof"`
+}
+
+type LoginUserRequest_Passkey struct {
+	// Passkey authentication method. It does not require a second factor.
+	Passkey *LoginUserRequest_PasskeyAssertion `protobuf:"bytes,3,opt,name=passkey,proto3,oneof"`
+}
+
 func (*LoginUserRequest_UserPassword_) isLoginUserRequest_Method() {}
 
+func (*LoginUserRequest_MfaChallenge) isLoginUserRequest_Method() {}
+
+func (*LoginUserRequest_Passkey) isLoginUserRequest_Method() {}
+
 // LoginUserResponse contains the authentication result with both tokens.
 type LoginUserResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -104,8 +138,15 @@ type LoginUserResponse struct {
 	RefreshToken string `protobuf:"bytes,4,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
 	// Expiration time of the refresh token (Unix seconds).
 	RefreshTokenExpiresAt int64 `protobuf:"varint,5,opt,name=refresh_token_expires_at,json=refreshTokenExpiresAt,proto3" json:"refresh_token_expires_at,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+	// Set when the password was correct but a second factor is required. No tokens are issued;
+	// call LoginUser again with mfa_challenge.
+	MfaRequired bool `protobuf:"varint,6,opt,name=mfa_required,json=mfaRequired,proto3" json:"mfa_required,omitempty"`
+	// The challenge token to present with the second factor.
+	MfaToken string `protobuf:"bytes,7,opt,name=mfa_token,json=mfaToken,proto3" json:"mfa_token,omitempty"`
+	// Expiration time of the challenge token (Unix seconds).
+	MfaTokenExpiresAt int64 `protobuf:"varint,8,opt,name=mfa_token_expires_at,json=mfaTokenExpiresAt,proto3" json:"mfa_token_expires_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *LoginUserResponse) Reset() {
@@ -173,6 +214,27 @@ func (x *LoginUserResponse) GetRefreshTokenExpiresAt() int64 {
 	return 0
 }
 
+func (x *LoginUserResponse) GetMfaRequired() bool {
+	if x != nil {
+		return x.MfaRequired
+	}
+	return false
+}
+
+func (x *LoginUserResponse) GetMfaToken() string {
+	if x != nil {
+		return x.MfaToken
+	}
+	return ""
+}
+
+func (x *LoginUserResponse) GetMfaTokenExpiresAt() int64 {
+	if x != nil {
+		return x.MfaTokenExpiresAt
+	}
+	return 0
+}
+
 // RegisterUserRequest contains the fields for creating a new user account.
 type RegisterUserRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -184,8 +246,17 @@ type RegisterUserRequest struct {
 	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
 	// The user's avatar URL (optional).
 	AvatarUrl string `protobuf:"bytes,4,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
-	// The user's password for authentication.
-	Password      string `protobuf:"bytes,5,opt,name=password,proto3" json:"password,omitempty"`
+	// The user's password for authentication. Required unless the account is registered with a passkey.
+	Password string `protobuf:"bytes,5,opt,name=password,proto3" json:"password,omitempty"`
+	// Optional space invitation token. It verifies the email address; the invitation is
+	// accepted once the account can sign in.
+	InvitationToken string `protobuf:"bytes,6,opt,name=invitation_token,json=invitationToken,proto3" json:"invitation_token,omitempty"`
+	// The ceremony_id returned by BeginPasskeySignup, to register with a passkey.
+	PasskeyCeremonyId string `protobuf:"bytes,7,opt,name=passkey_ceremony_id,json=passkeyCeremonyId,proto3" json:"passkey_ceremony_id,omitempty"`
+	// The JSON serialized PublicKeyCredential returned by navigator.credentials.create.
+	PasskeyCredential string `protobuf:"bytes,8,opt,name=passkey_credential,json=passkeyCredential,proto3" json:"passkey_credential,omitempty"`
+	// Optional name of the passkey.
+	PasskeyName   string `protobuf:"bytes,9,opt,name=passkey_name,json=passkeyName,proto3" json:"passkey_name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -255,6 +326,34 @@ func (x *RegisterUserRequest) GetPassword() string {
 	return ""
 }
 
+func (x *RegisterUserRequest) GetInvitationToken() string {
+	if x != nil {
+		return x.InvitationToken
+	}
+	return ""
+}
+
+func (x *RegisterUserRequest) GetPasskeyCeremonyId() string {
+	if x != nil {
+		return x.PasskeyCeremonyId
+	}
+	return ""
+}
+
+func (x *RegisterUserRequest) GetPasskeyCredential() string {
+	if x != nil {
+		return x.PasskeyCredential
+	}
+	return ""
+}
+
+func (x *RegisterUserRequest) GetPasskeyName() string {
+	if x != nil {
+		return x.PasskeyName
+	}
+	return ""
+}
+
 // User represents a registered user in the system.
 type User struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -272,6 +371,8 @@ type User struct {
 	Status string `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
 	// The optimistic locking version.
 	Version int64 `protobuf:"varint,9,opt,name=version,proto3" json:"version,omitempty"`
+	// Whether the user has verified their email address.
+	EmailVerified bool `protobuf:"varint,10,opt,name=email_verified,json=emailVerified,proto3" json:"email_verified,omitempty"`
 	// The creation timestamp.
 	CreateTime *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
 	// The last update timestamp.
@@ -359,6 +460,13 @@ func (x *User) GetVersion() int64 {
 	return 0
 }
 
+func (x *User) GetEmailVerified() bool {
+	if x != nil {
+		return x.EmailVerified
+	}
+	return false
+}
+
 func (x *User) GetCreateTime() *timestamppb.Timestamp {
 	if x != nil {
 		return x.CreateTime
@@ -1114,31 +1222,26 @@ func (x *ListMySecurityEventsResponse) GetNextPageToken() string {
 	return ""
 }
 
-// UserPassword authentication method.
-type LoginUserRequest_UserPassword struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Username or email of the user.
-	Identifier string `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
-	// Password of the user.
-	Password      string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+type GetMFAStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LoginUserRequest_UserPassword) Reset() {
-	*x = LoginUserRequest_UserPassword{}
+func (x *GetMFAStatusRequest) Reset() {
+	*x = GetMFAStatusRequest{}
 	mi := &file_saturn_identity_v1_identity_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LoginUserRequest_UserPassword) String() string {
+func (x *GetMFAStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoginUserRequest_UserPassword) ProtoMessage() {}
+func (*GetMFAStatusRequest) ProtoMessage() {}
 
-func (x *LoginUserRequest_UserPassword) ProtoReflect() protoreflect.Message {
+func (x *GetMFAStatusRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_saturn_identity_v1_identity_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1150,123 +1253,1787 @@ func (x *LoginUserRequest_UserPassword) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoginUserRequest_UserPassword.ProtoReflect.Descriptor instead.
-func (*LoginUserRequest_UserPassword) Descriptor() ([]byte, []int) {
-	return file_saturn_identity_v1_identity_proto_rawDescGZIP(), []int{0, 0}
+// Deprecated: Use GetMFAStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetMFAStatusRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_identity_v1_identity_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *LoginUserRequest_UserPassword) GetIdentifier() string {
+// MFAStatus describes the second factors of a user.
+type MFAStatus struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether a confirmed authenticator app is required at login.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// When two-factor authentication was enabled.
+	ConfirmTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=confirm_time,json=confirmTime,proto3" json:"confirm_time,omitempty"`
+	// Number of unused recovery codes.
+	RecoveryCodesRemaining int32 `protobuf:"varint,3,opt,name=recovery_codes_remaining,json=recoveryCodesRemaining,proto3" json:"recovery_codes_remaining,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *MFAStatus) Reset() {
+	*x = MFAStatus{}
+	mi := &file_saturn_identity_v1_identity_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MFAStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MFAStatus) ProtoMessage() {}
+
+func (x *MFAStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_identity_v1_identity_proto_msgTypes[20]
 	if x != nil {
-		return x.Identifier
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MFAStatus.ProtoReflect.Descriptor instead.
+func (*MFAStatus) Descriptor() ([]byte, []int) {
+	return file_saturn_identity_v1_identity_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *MFAStatus) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *MFAStatus) GetConfirmTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ConfirmTime
+	}
+	return nil
+}
+
+func (x *MFAStatus) GetRecoveryCodesRemaining() int32 {
+	if x != nil {
+		return x.RecoveryCodesRemaining
+	}
+	return 0
+}
+
+type EnrollTOTPRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnrollTOTPRequest) Reset() {
+	*x = EnrollTOTPRequest{}
+	mi := &file_saturn_identity_v1_identity_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnrollTOTPRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollTOTPRequest) ProtoMessage() {}
+
+func (x *EnrollTOTPRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_identity_v1_identity_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollTOTPRequest.ProtoReflect.Descriptor instead.
+func (*EnrollTOTPRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_identity_v1_identity_proto_rawDescGZIP(), []int{21}
+}
+
+type EnrollTOTPResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Base32 secret for manual entry.
+	Secret string `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	// otpauth:// URI to render as a QR code.
+	Uri           string `protobuf:"bytes,2,opt,name=uri,proto3" json:"uri,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnrollTOTPResponse) Reset() {
+	*x = EnrollTOTPResponse{}
+	mi := &file_saturn_identity_v1_identity_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnrollTOTPResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollTOTPResponse) ProtoMessage() {}
+
+func (x *EnrollTOTPResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_identity_v1_identity_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollTOTPResponse.ProtoReflect.Descriptor instead.
+func (*EnrollTOTPResponse) Descriptor() ([]byte, []int) {
+	return file_saturn_identity_v1_identity_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *EnrollTOTPResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
 	}
 	return ""
 }
 
-func (x *LoginUserRequest_UserPassword) GetPassword() string {
+func (x *EnrollTOTPResponse) GetUri() string {
 	if x != nil {
-		return x.Password
+		return x.Uri
 	}
 	return ""
 }
 
-var File_saturn_identity_v1_identity_proto protoreflect.FileDescriptor
+type ConfirmTOTPRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// A code from the authenticator app.
+	Code          string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_saturn_identity_v1_identity_proto_rawDesc = "" +
-	"\n" +
-	"!saturn/identity/v1/identity.proto\x12\x12saturn.identity.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xcc\x01\n" +
-	"\x10LoginUserRequest\x12X\n" +
-	"\ruser_password\x18\x01 \x01(\v21.saturn.identity.v1.LoginUserRequest.UserPasswordH\x00R\fuserPassword\x1aT\n" +
-	"\fUserPassword\x12#\n" +
-	"\n" +
-	"identifier\x18\x01 \x01(\tB\x03\xe0A\x02R\n" +
-	"identifier\x12\x1f\n" +
-	"\bpassword\x18\x02 \x01(\tB\x03\xe0A\x02R\bpasswordB\b\n" +
-	"\x06method\"\xe4\x01\n" +
-	"\x11LoginUserResponse\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x12!\n" +
-	"\faccess_token\x18\x02 \x01(\tR\vaccessToken\x125\n" +
-	"\x17access_token_expires_at\x18\x03 \x01(\x03R\x14accessTokenExpiresAt\x12#\n" +
-	"\rrefresh_token\x18\x04 \x01(\tR\frefreshToken\x127\n" +
-	"\x18refresh_token_expires_at\x18\x05 \x01(\x03R\x15refreshTokenExpiresAt\"\xaa\x01\n" +
-	"\x13RegisterUserRequest\x12\x19\n" +
-	"\x05email\x18\x01 \x01(\tB\x03\xe0A\x02R\x05email\x12\x1f\n" +
-	"\busername\x18\x02 \x01(\tB\x03\xe0A\x02R\busername\x12\x17\n" +
-	"\x04name\x18\x03 \x01(\tB\x03\xe0A\x02R\x04name\x12\x1d\n" +
-	"\n" +
-	"avatar_url\x18\x04 \x01(\tR\tavatarUrl\x12\x1f\n" +
-	"\bpassword\x18\x05 \x01(\tB\x03\xe0A\x02R\bpassword\"\xa7\x02\n" +
-	"\x04User\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
-	"\x05email\x18\x02 \x01(\tR\x05email\x12\x1a\n" +
-	"\busername\x18\x03 \x01(\tR\busername\x12\x12\n" +
-	"\x04name\x18\x04 \x01(\tR\x04name\x12\x1d\n" +
-	"\n" +
-	"avatar_url\x18\x05 \x01(\tR\tavatarUrl\x12\x16\n" +
-	"\x06status\x18\x06 \x01(\tR\x06status\x12\x18\n" +
-	"\aversion\x18\t \x01(\x03R\aversion\x12;\n" +
-	"\vcreate_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"createTime\x12;\n" +
-	"\vupdate_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"updateTime\"A\n" +
-	"\x15RefreshSessionRequest\x12(\n" +
-	"\rrefresh_token\x18\x01 \x01(\tB\x03\xe0A\x02R\frefreshToken\"\xd0\x01\n" +
-	"\x16RefreshSessionResponse\x12!\n" +
-	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x125\n" +
-	"\x17access_token_expires_at\x18\x02 \x01(\x03R\x14accessTokenExpiresAt\x12#\n" +
-	"\rrefresh_token\x18\x03 \x01(\tR\frefreshToken\x127\n" +
-	"\x18refresh_token_expires_at\x18\x04 \x01(\x03R\x15refreshTokenExpiresAt\"9\n" +
-	"\rLogoutRequest\x12(\n" +
-	"\rrefresh_token\x18\x01 \x01(\tB\x03\xe0A\x02R\frefreshToken\"\x10\n" +
-	"\x0eLogoutResponse\"\x17\n" +
-	"\x15GetCurrentUserRequest\"\xe5\x01\n" +
-	"\vUserSession\x12\x1d\n" +
-	"\n" +
-	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x1d\n" +
-	"\n" +
-	"user_agent\x18\x02 \x01(\tR\tuserAgent\x12\x1d\n" +
-	"\n" +
-	"ip_address\x18\x03 \x01(\tR\tipAddress\x12;\n" +
-	"\vcreate_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"createTime\x12<\n" +
-	"\flast_used_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"lastUsedAt\"\x1b\n" +
-	"\x19ListActiveSessionsRequest\"Y\n" +
-	"\x1aListActiveSessionsResponse\x12;\n" +
-	"\bsessions\x18\x01 \x03(\v2\x1f.saturn.identity.v1.UserSessionR\bsessions\":\n" +
-	"\x14RevokeSessionRequest\x12\"\n" +
-	"\n" +
-	"session_id\x18\x01 \x01(\tB\x03\xe0A\x02R\tsessionId\"\x17\n" +
-	"\x15RevokeSessionResponse\"\x1a\n" +
-	"\x18RevokeAllSessionsRequest\"\x1b\n" +
-	"\x19RevokeAllSessionsResponse\"\xcd\x01\n" +
-	"\rSecurityEvent\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
-	"\x05email\x18\x02 \x01(\tR\x05email\x12\x1d\n" +
-	"\n" +
-	"event_type\x18\x03 \x01(\tR\teventType\x12\x1d\n" +
-	"\n" +
-	"ip_address\x18\x04 \x01(\tR\tipAddress\x12\x1d\n" +
-	"\n" +
-	"user_agent\x18\x05 \x01(\tR\tuserAgent\x129\n" +
-	"\n" +
-	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"[\n" +
-	"\x1bListMySecurityEventsRequest\x12\x14\n" +
-	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x81\x01\n" +
-	"\x1cListMySecurityEventsResponse\x129\n" +
-	"\x06events\x18\x01 \x03(\v2!.saturn.identity.v1.SecurityEventR\x06events\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken2\x85\n" +
-	"\n" +
-	"\bIdentity\x12}\n" +
-	"\tLoginUser\x12$.saturn.identity.v1.LoginUserRequest\x1a%.saturn.identity.v1.LoginUserResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/identity/users:login\x12y\n" +
-	"\fRegisterUser\x12'.saturn.identity.v1.RegisterUserRequest\x1a\x18.saturn.identity.v1.User\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/v1/identity/users:register\x12\x91\x01\n" +
-	"\x0eRefreshSession\x12).saturn.identity.v1.RefreshSessionRequest\x1a*.saturn.identity.v1.RefreshSessionResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/v1/identity/sessions:refresh\x12x\n" +
-	"\x06Logout\x12!.saturn.identity.v1.LogoutRequest\x1a\".saturn.identity.v1.LogoutResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/v1/identity/sessions:logout\x12t\n" +
-	"\x0eGetCurrentUser\x12).saturn.identity.v1.GetCurrentUserRequest\x1a\x18.saturn.identity.v1.User\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/identity/users/me\x12\x92\x01\n" +
-	"\x12ListActiveSessions\x12-.saturn.identity.v1.ListActiveSessionsRequest\x1a..saturn.identity.v1.ListActiveSessionsResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/identity/sessions\x12\x9a\x01\n" +
-	"\rRevokeSession\x12(.saturn.identity.v1.RevokeSessionRequest\x1a).saturn.identity.v1.RevokeSessionResponse\"4\x82\xd3\xe4\x93\x02.:\x01*\")/v1/identity/sessions/{session_id}:revoke\x12\x9d\x01\n" +
-	"\x11RevokeAllSessions\x12,.saturn.identity.v1.RevokeAllSessionsRequest\x1a-.saturn.identity.v1.RevokeAllSessionsResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /v1/identity/sessions:revoke-all\x12\xa8\x01\n" +
-	"\x14ListMySecurityEvents\x12/.saturn.identity.v1.ListMySecurityEventsRequest\x1a0.saturn.identity.v1.ListMySecurityEventsResponse\"-\x82\xd3\xe4\x93\x02'\x12%/v1/identity/users/me/security-eventsBCZAgithub.com/masterkeysrd/saturn/apis/saturn/identity/v1;identityv1b\x06proto3"
+func (x *ConfirmTOTPRequest) Reset() {
+	*x = ConfirmTOTPRequest{}
+	mi := &file_saturn_identity_v1_identity_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmTOTPRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmTOTPRequest) ProtoMessage() {}
+
+func (x *ConfirmTOTPRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_identity_v1_identity_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmTOTPRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmTOTPRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_identity_v1_identity_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ConfirmTOTPRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+// RecoveryCodesResponse returns one-time recovery codes. They are not retrievable again.
+type RecoveryCodesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RecoveryCodes []string               `protobuf:"bytes,1,rep,name=recovery_codes,json=recoveryCodes,proto3" json:"recovery_codes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecoveryCodesResponse) Reset() {
+	*x = RecoveryCodesResponse{}
+	mi := &file_saturn_identity_v1_identity_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecoveryCodesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecoveryCodesResponse) ProtoMessage() {}
+
+func (x *RecoveryCodesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_identity_v1_identity_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecoveryCodesResponse.ProtoReflect.Descriptor instead.
+func (*RecoveryCodesResponse) Descriptor() ([]byte, []int) {
+	return file_saturn_identity_v1_identity_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RecoveryCodesResponse) GetRecoveryCodes() []string {
+	if x != nil {
+		return x.RecoveryCodes
+	}
+	return nil
+}
+
+type RegenerateRecoveryCodesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// A code from the authenticator app.
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	// A recovery code, used instead of code.
+	RecoveryCode  string `protobuf:"bytes,2,opt,name=recovery_code,json=recoveryCode,proto3" json:"recovery_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegenerateRecoveryCodesRequest) Reset() {
+	*x = RegenerateRecoveryCodesRequest{}
+	mi := &file_saturn_identity_v1_identity_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegenerateRecoveryCodesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegenerateRecoveryCodesRequest) ProtoMessage() {}
+
+func (x *RegenerateRecoveryCodesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_identity_v1_identity_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegenerateRecoveryCodesRequest.ProtoReflect.Descriptor instead.
+func (*RegenerateRecoveryCodesRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_identity_v1_identity_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *RegenerateRecoveryCodesRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *RegenerateRecoveryCodesRequest) GetRecoveryCode() string {
+	if x != nil {
+		return x.RecoveryCode
+	}
+	return ""
+}
+
+type DisableMFARequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// A code from the authenticator app.
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	// A recovery code, used instead of code.
+	RecoveryCode  string `protobuf:"bytes,2,opt,name=recovery_code,json=recoveryCode,proto3" json:"recovery_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisableMFARequest) Reset() {
+	*x = DisableMFARequest{}
+	mi := &file_saturn_identity_v1_identity_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisableMFARequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableMFARequest) ProtoMessage() {}
+
+func (x *DisableMFARequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_identity_v1_identity_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableMFARequest.ProtoReflect.Descriptor instead.
+func (*DisableMFARequest) Descriptor() ([]byte, []int) {
+	return file_saturn_identity_v1_identity_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *DisableMFARequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *DisableMFARequest) GetRecoveryCode() string {
+	if x != nil {
+		return x.RecoveryCode
+	}
+	return ""
+}
+
+type DisableMFAResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisableMFAResponse) Reset() {
+	*x = DisableMFAResponse{}
+	mi := &file_saturn_identity_v1_identity_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisableMFAResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableMFAResponse) ProtoMessage() {}
+
+func (x *DisableMFAResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_identity_v1_identity_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableMFAResponse.ProtoReflect.Descriptor instead.
+func (*DisableMFAResponse) Descriptor() ([]byte, []int) {
+	return file_saturn_identity_v1_identity_proto_rawDescGZIP(), []int{27}
+}
+
+// PasskeyChallenge starts a WebAuthn ceremony in the browser.
+type PasskeyChallenge struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Identifies the ceremony when completing it.
+	CeremonyId string `protobuf:"bytes,1,opt,name=ceremony_id,json=ceremonyId,proto3" json:"ceremony_id,omitempty"`
+	// JSON options for navigator.credentials.create or navigator.credentials.get, under "publicKey".
+	Options string `protobuf:"bytes,2,opt,name=options,proto3" json:"options,omitempty"`
+	// The ceremony must be completed before this time.
+	ExpireTime    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PasskeyChallenge) Reset() {
+	*x = PasskeyChallenge{}
+	mi := &file_saturn_identity_v1_identity_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PasskeyChallenge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PasskeyChallenge) ProtoMessage() {}
+
+func (x *PasskeyChallenge) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_identity_v1_identity_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PasskeyChallenge.ProtoReflect.Descriptor instead.
+func (*PasskeyChallenge) Descriptor() ([]byte, []int) {
+	return file_saturn_identity_v1_identity_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *PasskeyChallenge) GetCeremonyId() string {
+	if x != nil {
+		return x.CeremonyId
+	}
+	return ""
+}
+
+func (x *PasskeyChallenge) GetOptions() string {
+	if x != nil {
+		return x.Options
+	}
+	return ""
+}
+
+func (x *PasskeyChallenge) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
+// Passkey is a WebAuthn credential registered by the user.
+type Passkey struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The passkey identifier.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The name chosen by the user.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Transports the authenticator reported, e.g. "internal", "usb", "hybrid".
+	Transports []string `protobuf:"bytes,3,rep,name=transports,proto3" json:"transports,omitempty"`
+	// Whether the passkey is synced between devices.
+	BackedUp bool `protobuf:"varint,4,opt,name=backed_up,json=backedUp,proto3" json:"backed_up,omitempty"`
+	// When the passkey was registered.
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// When the passkey was last used to sign in.
+	LastUsedTime  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_used_time,json=lastUsedTime,proto3" json:"last_used_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Passkey) Reset() {
+	*x = Passkey{}
+	mi := &file_saturn_identity_v1_identity_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Passkey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Passkey) ProtoMessage() {}
+
+func (x *Passkey) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_identity_v1_identity_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Passkey.ProtoReflect.Descriptor instead.
+func (*Passkey) Descriptor() ([]byte, []int) {
+	return file_saturn_identity_v1_identity_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *Passkey) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Passkey) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Passkey) GetTransports() []string {
+	if x != nil {
+		return x.Transports
+	}
+	return nil
+}
+
+func (x *Passkey) GetBackedUp() bool {
+	if x != nil {
+		return x.BackedUp
+	}
+	return false
+}
+
+func (x *Passkey) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *Passkey) GetLastUsedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedTime
+	}
+	return nil
+}
+
+type BeginPasskeyLoginRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional username or email. Without it the browser offers every passkey it holds for the site.
+	Identifier    string `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BeginPasskeyLoginRequest) Reset() {
+	*x = BeginPasskeyLoginRequest{}
+	mi := &file_saturn_identity_v1_identity_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BeginPasskeyLoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeginPasskeyLoginRequest) ProtoMessage() {}
+
+func (x *BeginPasskeyLoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_identity_v1_identity_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeginPasskeyLoginRequest.ProtoReflect.Descriptor instead.
+func (*BeginPasskeyLoginRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_identity_v1_identity_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *BeginPasskeyLoginRequest) GetIdentifier() string {
+	if x != nil {
+		return x.Identifier
+	}
+	return ""
+}
+
+type BeginPasskeySignupRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The email address of the new account.
+	Email string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	// The username of the new account.
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	// The display name of the new account.
+	Name          string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BeginPasskeySignupRequest) Reset() {
+	*x = BeginPasskeySignupRequest{}
+	mi := &file_saturn_identity_v1_identity_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BeginPasskeySignupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeginPasskeySignupRequest) ProtoMessage() {}
+
+func (x *BeginPasskeySignupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_identity_v1_identity_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeginPasskeySignupRequest.ProtoReflect.Descriptor instead.
+func (*BeginPasskeySignupRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_identity_v1_identity_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *BeginPasskeySignupRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *BeginPasskeySignupRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *BeginPasskeySignupRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type BeginPasskeyRegistrationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BeginPasskeyRegistrationRequest) Reset() {
+	*x = BeginPasskeyRegistrationRequest{}
+	mi := &file_saturn_identity_v1_identity_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BeginPasskeyRegistrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeginPasskeyRegistrationRequest) ProtoMessage() {}
+
+func (x *BeginPasskeyRegistrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_identity_v1_identity_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeginPasskeyRegistrationRequest.ProtoReflect.Descriptor instead.
+func (*BeginPasskeyRegistrationRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_identity_v1_identity_proto_rawDescGZIP(), []int{32}
+}
+
+type CreatePasskeyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ceremony_id returned by BeginPasskeyRegistration.
+	CeremonyId string `protobuf:"bytes,1,opt,name=ceremony_id,json=ceremonyId,proto3" json:"ceremony_id,omitempty"`
+	// The JSON serialized PublicKeyCredential returned by navigator.credentials.create.
+	Credential string `protobuf:"bytes,2,opt,name=credential,proto3" json:"credential,omitempty"`
+	// Optional name of the passkey, e.g. "Work laptop".
+	Name          string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePasskeyRequest) Reset() {
+	*x = CreatePasskeyRequest{}
+	mi := &file_saturn_identity_v1_identity_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePasskeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePasskeyRequest) ProtoMessage() {}
+
+func (x *CreatePasskeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_identity_v1_identity_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePasskeyRequest.ProtoReflect.Descriptor instead.
+func (*CreatePasskeyRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_identity_v1_identity_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CreatePasskeyRequest) GetCeremonyId() string {
+	if x != nil {
+		return x.CeremonyId
+	}
+	return ""
+}
+
+func (x *CreatePasskeyRequest) GetCredential() string {
+	if x != nil {
+		return x.Credential
+	}
+	return ""
+}
+
+func (x *CreatePasskeyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ListPasskeysRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPasskeysRequest) Reset() {
+	*x = ListPasskeysRequest{}
+	mi := &file_saturn_identity_v1_identity_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPasskeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPasskeysRequest) ProtoMessage() {}
+
+func (x *ListPasskeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_identity_v1_identity_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPasskeysRequest.ProtoReflect.Descriptor instead.
+func (*ListPasskeysRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_identity_v1_identity_proto_rawDescGZIP(), []int{34}
+}
+
+type ListPasskeysResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Passkeys      []*Passkey             `protobuf:"bytes,1,rep,name=passkeys,proto3" json:"passkeys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPasskeysResponse) Reset() {
+	*x = ListPasskeysResponse{}
+	mi := &file_saturn_identity_v1_identity_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPasskeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPasskeysResponse) ProtoMessage() {}
+
+func (x *ListPasskeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_identity_v1_identity_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPasskeysResponse.ProtoReflect.Descriptor instead.
+func (*ListPasskeysResponse) Descriptor() ([]byte, []int) {
+	return file_saturn_identity_v1_identity_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListPasskeysResponse) GetPasskeys() []*Passkey {
+	if x != nil {
+		return x.Passkeys
+	}
+	return nil
+}
+
+type RenamePasskeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PasskeyId     string                 `protobuf:"bytes,1,opt,name=passkey_id,json=passkeyId,proto3" json:"passkey_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenamePasskeyRequest) Reset() {
+	*x = RenamePasskeyRequest{}
+	mi := &file_saturn_identity_v1_identity_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenamePasskeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenamePasskeyRequest) ProtoMessage() {}
+
+func (x *RenamePasskeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_identity_v1_identity_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenamePasskeyRequest.ProtoReflect.Descriptor instead.
+func (*RenamePasskeyRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_identity_v1_identity_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *RenamePasskeyRequest) GetPasskeyId() string {
+	if x != nil {
+		return x.PasskeyId
+	}
+	return ""
+}
+
+func (x *RenamePasskeyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DeletePasskeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PasskeyId     string                 `protobuf:"bytes,1,opt,name=passkey_id,json=passkeyId,proto3" json:"passkey_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePasskeyRequest) Reset() {
+	*x = DeletePasskeyRequest{}
+	mi := &file_saturn_identity_v1_identity_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePasskeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePasskeyRequest) ProtoMessage() {}
+
+func (x *DeletePasskeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_identity_v1_identity_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePasskeyRequest.ProtoReflect.Descriptor instead.
+func (*DeletePasskeyRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_identity_v1_identity_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *DeletePasskeyRequest) GetPasskeyId() string {
+	if x != nil {
+		return x.PasskeyId
+	}
+	return ""
+}
+
+type DeletePasskeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePasskeyResponse) Reset() {
+	*x = DeletePasskeyResponse{}
+	mi := &file_saturn_identity_v1_identity_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePasskeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePasskeyResponse) ProtoMessage() {}
+
+func (x *DeletePasskeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_identity_v1_identity_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePasskeyResponse.ProtoReflect.Descriptor instead.
+func (*DeletePasskeyResponse) Descriptor() ([]byte, []int) {
+	return file_saturn_identity_v1_identity_proto_rawDescGZIP(), []int{38}
+}
+
+type RequestPasswordResetRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The email address of the account.
+	Email         string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestPasswordResetRequest) Reset() {
+	*x = RequestPasswordResetRequest{}
+	mi := &file_saturn_identity_v1_identity_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestPasswordResetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestPasswordResetRequest) ProtoMessage() {}
+
+func (x *RequestPasswordResetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_identity_v1_identity_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestPasswordResetRequest.ProtoReflect.Descriptor instead.
+func (*RequestPasswordResetRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_identity_v1_identity_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *RequestPasswordResetRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type RequestPasswordResetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestPasswordResetResponse) Reset() {
+	*x = RequestPasswordResetResponse{}
+	mi := &file_saturn_identity_v1_identity_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestPasswordResetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestPasswordResetResponse) ProtoMessage() {}
+
+func (x *RequestPasswordResetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_identity_v1_identity_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestPasswordResetResponse.ProtoReflect.Descriptor instead.
+func (*RequestPasswordResetResponse) Descriptor() ([]byte, []int) {
+	return file_saturn_identity_v1_identity_proto_rawDescGZIP(), []int{40}
+}
+
+type ConfirmPasswordResetRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The token from the password reset link.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// The new password.
+	NewPassword   string `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmPasswordResetRequest) Reset() {
+	*x = ConfirmPasswordResetRequest{}
+	mi := &file_saturn_identity_v1_identity_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmPasswordResetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmPasswordResetRequest) ProtoMessage() {}
+
+func (x *ConfirmPasswordResetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_identity_v1_identity_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmPasswordResetRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmPasswordResetRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_identity_v1_identity_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ConfirmPasswordResetRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ConfirmPasswordResetRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+type ConfirmPasswordResetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmPasswordResetResponse) Reset() {
+	*x = ConfirmPasswordResetResponse{}
+	mi := &file_saturn_identity_v1_identity_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmPasswordResetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmPasswordResetResponse) ProtoMessage() {}
+
+func (x *ConfirmPasswordResetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_identity_v1_identity_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmPasswordResetResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmPasswordResetResponse) Descriptor() ([]byte, []int) {
+	return file_saturn_identity_v1_identity_proto_rawDescGZIP(), []int{42}
+}
+
+type ChangePasswordRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The password the user signs in with today.
+	CurrentPassword string `protobuf:"bytes,1,opt,name=current_password,json=currentPassword,proto3" json:"current_password,omitempty"`
+	// The new password.
+	NewPassword   string `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangePasswordRequest) Reset() {
+	*x = ChangePasswordRequest{}
+	mi := &file_saturn_identity_v1_identity_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangePasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePasswordRequest) ProtoMessage() {}
+
+func (x *ChangePasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_identity_v1_identity_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePasswordRequest.ProtoReflect.Descriptor instead.
+func (*ChangePasswordRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_identity_v1_identity_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ChangePasswordRequest) GetCurrentPassword() string {
+	if x != nil {
+		return x.CurrentPassword
+	}
+	return ""
+}
+
+func (x *ChangePasswordRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+type ChangePasswordResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangePasswordResponse) Reset() {
+	*x = ChangePasswordResponse{}
+	mi := &file_saturn_identity_v1_identity_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangePasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePasswordResponse) ProtoMessage() {}
+
+func (x *ChangePasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_identity_v1_identity_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePasswordResponse.ProtoReflect.Descriptor instead.
+func (*ChangePasswordResponse) Descriptor() ([]byte, []int) {
+	return file_saturn_identity_v1_identity_proto_rawDescGZIP(), []int{44}
+}
+
+type ResendVerificationEmailRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The email address of the account.
+	Email         string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResendVerificationEmailRequest) Reset() {
+	*x = ResendVerificationEmailRequest{}
+	mi := &file_saturn_identity_v1_identity_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResendVerificationEmailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResendVerificationEmailRequest) ProtoMessage() {}
+
+func (x *ResendVerificationEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_identity_v1_identity_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResendVerificationEmailRequest.ProtoReflect.Descriptor instead.
+func (*ResendVerificationEmailRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_identity_v1_identity_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ResendVerificationEmailRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type ResendVerificationEmailResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResendVerificationEmailResponse) Reset() {
+	*x = ResendVerificationEmailResponse{}
+	mi := &file_saturn_identity_v1_identity_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResendVerificationEmailResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResendVerificationEmailResponse) ProtoMessage() {}
+
+func (x *ResendVerificationEmailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_identity_v1_identity_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResendVerificationEmailResponse.ProtoReflect.Descriptor instead.
+func (*ResendVerificationEmailResponse) Descriptor() ([]byte, []int) {
+	return file_saturn_identity_v1_identity_proto_rawDescGZIP(), []int{46}
+}
+
+type VerifyEmailRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The token from the verification link.
+	Token         string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyEmailRequest) Reset() {
+	*x = VerifyEmailRequest{}
+	mi := &file_saturn_identity_v1_identity_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyEmailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyEmailRequest) ProtoMessage() {}
+
+func (x *VerifyEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_identity_v1_identity_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyEmailRequest.ProtoReflect.Descriptor instead.
+func (*VerifyEmailRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_identity_v1_identity_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *VerifyEmailRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type VerifyEmailResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyEmailResponse) Reset() {
+	*x = VerifyEmailResponse{}
+	mi := &file_saturn_identity_v1_identity_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyEmailResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyEmailResponse) ProtoMessage() {}
+
+func (x *VerifyEmailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_identity_v1_identity_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyEmailResponse.ProtoReflect.Descriptor instead.
+func (*VerifyEmailResponse) Descriptor() ([]byte, []int) {
+	return file_saturn_identity_v1_identity_proto_rawDescGZIP(), []int{48}
+}
+
+// UserPassword authentication method.
+type LoginUserRequest_UserPassword struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Username or email of the user.
+	Identifier string `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	// Password of the user.
+	Password      string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginUserRequest_UserPassword) Reset() {
+	*x = LoginUserRequest_UserPassword{}
+	mi := &file_saturn_identity_v1_identity_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginUserRequest_UserPassword) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginUserRequest_UserPassword) ProtoMessage() {}
+
+func (x *LoginUserRequest_UserPassword) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_identity_v1_identity_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginUserRequest_UserPassword.ProtoReflect.Descriptor instead.
+func (*LoginUserRequest_UserPassword) Descriptor() ([]byte, []int) {
+	return file_saturn_identity_v1_identity_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *LoginUserRequest_UserPassword) GetIdentifier() string {
+	if x != nil {
+		return x.Identifier
+	}
+	return ""
+}
+
+func (x *LoginUserRequest_UserPassword) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+// MFAChallenge completes a login that returned mfa_required.
+type LoginUserRequest_MFAChallenge struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The mfa_token returned by the password step.
+	MfaToken string `protobuf:"bytes,1,opt,name=mfa_token,json=mfaToken,proto3" json:"mfa_token,omitempty"`
+	// A code from the authenticator app.
+	Code string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	// A one-time recovery code, used instead of code.
+	RecoveryCode  string `protobuf:"bytes,3,opt,name=recovery_code,json=recoveryCode,proto3" json:"recovery_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginUserRequest_MFAChallenge) Reset() {
+	*x = LoginUserRequest_MFAChallenge{}
+	mi := &file_saturn_identity_v1_identity_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginUserRequest_MFAChallenge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginUserRequest_MFAChallenge) ProtoMessage() {}
+
+func (x *LoginUserRequest_MFAChallenge) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_identity_v1_identity_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginUserRequest_MFAChallenge.ProtoReflect.Descriptor instead.
+func (*LoginUserRequest_MFAChallenge) Descriptor() ([]byte, []int) {
+	return file_saturn_identity_v1_identity_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *LoginUserRequest_MFAChallenge) GetMfaToken() string {
+	if x != nil {
+		return x.MfaToken
+	}
+	return ""
+}
+
+func (x *LoginUserRequest_MFAChallenge) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *LoginUserRequest_MFAChallenge) GetRecoveryCode() string {
+	if x != nil {
+		return x.RecoveryCode
+	}
+	return ""
+}
+
+// PasskeyAssertion completes a login started with BeginPasskeyLogin.
+type LoginUserRequest_PasskeyAssertion struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ceremony_id returned by BeginPasskeyLogin.
+	CeremonyId string `protobuf:"bytes,1,opt,name=ceremony_id,json=ceremonyId,proto3" json:"ceremony_id,omitempty"`
+	// The JSON serialized PublicKeyCredential returned by navigator.credentials.get.
+	Credential    string `protobuf:"bytes,2,opt,name=credential,proto3" json:"credential,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginUserRequest_PasskeyAssertion) Reset() {
+	*x = LoginUserRequest_PasskeyAssertion{}
+	mi := &file_saturn_identity_v1_identity_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginUserRequest_PasskeyAssertion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginUserRequest_PasskeyAssertion) ProtoMessage() {}
+
+func (x *LoginUserRequest_PasskeyAssertion) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_identity_v1_identity_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginUserRequest_PasskeyAssertion.ProtoReflect.Descriptor instead.
+func (*LoginUserRequest_PasskeyAssertion) Descriptor() ([]byte, []int) {
+	return file_saturn_identity_v1_identity_proto_rawDescGZIP(), []int{0, 2}
+}
+
+func (x *LoginUserRequest_PasskeyAssertion) GetCeremonyId() string {
+	if x != nil {
+		return x.CeremonyId
+	}
+	return ""
+}
+
+func (x *LoginUserRequest_PasskeyAssertion) GetCredential() string {
+	if x != nil {
+		return x.Credential
+	}
+	return ""
+}
+
+var File_saturn_identity_v1_identity_proto protoreflect.FileDescriptor
+
+const file_saturn_identity_v1_identity_proto_rawDesc = "" +
+	"\n" +
+	"!saturn/identity/v1/identity.proto\x12\x12saturn.identity.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc3\x04\n" +
+	"\x10LoginUserRequest\x12X\n" +
+	"\ruser_password\x18\x01 \x01(\v21.saturn.identity.v1.LoginUserRequest.UserPasswordH\x00R\fuserPassword\x12X\n" +
+	"\rmfa_challenge\x18\x02 \x01(\v21.saturn.identity.v1.LoginUserRequest.MFAChallengeH\x00R\fmfaChallenge\x12Q\n" +
+	"\apasskey\x18\x03 \x01(\v25.saturn.identity.v1.LoginUserRequest.PasskeyAssertionH\x00R\apasskey\x1aT\n" +
+	"\fUserPassword\x12#\n" +
+	"\n" +
+	"identifier\x18\x01 \x01(\tB\x03\xe0A\x02R\n" +
+	"identifier\x12\x1f\n" +
+	"\bpassword\x18\x02 \x01(\tB\x03\xe0A\x02R\bpassword\x1ai\n" +
+	"\fMFAChallenge\x12 \n" +
+	"\tmfa_token\x18\x01 \x01(\tB\x03\xe0A\x02R\bmfaToken\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12#\n" +
+	"\rrecovery_code\x18\x03 \x01(\tR\frecoveryCode\x1a]\n" +
+	"\x10PasskeyAssertion\x12$\n" +
+	"\vceremony_id\x18\x01 \x01(\tB\x03\xe0A\x02R\n" +
+	"ceremonyId\x12#\n" +
+	"\n" +
+	"credential\x18\x02 \x01(\tB\x03\xe0A\x02R\n" +
+	"credentialB\b\n" +
+	"\x06method\"\xd5\x02\n" +
+	"\x11LoginUserResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12!\n" +
+	"\faccess_token\x18\x02 \x01(\tR\vaccessToken\x125\n" +
+	"\x17access_token_expires_at\x18\x03 \x01(\x03R\x14accessTokenExpiresAt\x12#\n" +
+	"\rrefresh_token\x18\x04 \x01(\tR\frefreshToken\x127\n" +
+	"\x18refresh_token_expires_at\x18\x05 \x01(\x03R\x15refreshTokenExpiresAt\x12!\n" +
+	"\fmfa_required\x18\x06 \x01(\bR\vmfaRequired\x12\x1b\n" +
+	"\tmfa_token\x18\a \x01(\tR\bmfaToken\x12/\n" +
+	"\x14mfa_token_expires_at\x18\b \x01(\x03R\x11mfaTokenExpiresAt\"\xd2\x02\n" +
+	"\x13RegisterUserRequest\x12\x19\n" +
+	"\x05email\x18\x01 \x01(\tB\x03\xe0A\x02R\x05email\x12\x1f\n" +
+	"\busername\x18\x02 \x01(\tB\x03\xe0A\x02R\busername\x12\x17\n" +
+	"\x04name\x18\x03 \x01(\tB\x03\xe0A\x02R\x04name\x12\x1d\n" +
+	"\n" +
+	"avatar_url\x18\x04 \x01(\tR\tavatarUrl\x12\x1a\n" +
+	"\bpassword\x18\x05 \x01(\tR\bpassword\x12)\n" +
+	"\x10invitation_token\x18\x06 \x01(\tR\x0finvitationToken\x12.\n" +
+	"\x13passkey_ceremony_id\x18\a \x01(\tR\x11passkeyCeremonyId\x12-\n" +
+	"\x12passkey_credential\x18\b \x01(\tR\x11passkeyCredential\x12!\n" +
+	"\fpasskey_name\x18\t \x01(\tR\vpasskeyName\"\xce\x02\n" +
+	"\x04User\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x1a\n" +
+	"\busername\x18\x03 \x01(\tR\busername\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"avatar_url\x18\x05 \x01(\tR\tavatarUrl\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12\x18\n" +
+	"\aversion\x18\t \x01(\x03R\aversion\x12%\n" +
+	"\x0eemail_verified\x18\n" +
+	" \x01(\bR\remailVerified\x12;\n" +
+	"\vcreate_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12;\n" +
+	"\vupdate_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updateTime\"A\n" +
+	"\x15RefreshSessionRequest\x12(\n" +
+	"\rrefresh_token\x18\x01 \x01(\tB\x03\xe0A\x02R\frefreshToken\"\xd0\x01\n" +
+	"\x16RefreshSessionResponse\x12!\n" +
+	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x125\n" +
+	"\x17access_token_expires_at\x18\x02 \x01(\x03R\x14accessTokenExpiresAt\x12#\n" +
+	"\rrefresh_token\x18\x03 \x01(\tR\frefreshToken\x127\n" +
+	"\x18refresh_token_expires_at\x18\x04 \x01(\x03R\x15refreshTokenExpiresAt\"9\n" +
+	"\rLogoutRequest\x12(\n" +
+	"\rrefresh_token\x18\x01 \x01(\tB\x03\xe0A\x02R\frefreshToken\"\x10\n" +
+	"\x0eLogoutResponse\"\x17\n" +
+	"\x15GetCurrentUserRequest\"\xe5\x01\n" +
+	"\vUserSession\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\x02 \x01(\tR\tuserAgent\x12\x1d\n" +
+	"\n" +
+	"ip_address\x18\x03 \x01(\tR\tipAddress\x12;\n" +
+	"\vcreate_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12<\n" +
+	"\flast_used_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastUsedAt\"\x1b\n" +
+	"\x19ListActiveSessionsRequest\"Y\n" +
+	"\x1aListActiveSessionsResponse\x12;\n" +
+	"\bsessions\x18\x01 \x03(\v2\x1f.saturn.identity.v1.UserSessionR\bsessions\":\n" +
+	"\x14RevokeSessionRequest\x12\"\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tB\x03\xe0A\x02R\tsessionId\"\x17\n" +
+	"\x15RevokeSessionResponse\"\x1a\n" +
+	"\x18RevokeAllSessionsRequest\"\x1b\n" +
+	"\x19RevokeAllSessionsResponse\"\xcd\x01\n" +
+	"\rSecurityEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x03 \x01(\tR\teventType\x12\x1d\n" +
+	"\n" +
+	"ip_address\x18\x04 \x01(\tR\tipAddress\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\x05 \x01(\tR\tuserAgent\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"[\n" +
+	"\x1bListMySecurityEventsRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x81\x01\n" +
+	"\x1cListMySecurityEventsResponse\x129\n" +
+	"\x06events\x18\x01 \x03(\v2!.saturn.identity.v1.SecurityEventR\x06events\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x15\n" +
+	"\x13GetMFAStatusRequest\"\x9e\x01\n" +
+	"\tMFAStatus\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12=\n" +
+	"\fconfirm_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\vconfirmTime\x128\n" +
+	"\x18recovery_codes_remaining\x18\x03 \x01(\x05R\x16recoveryCodesRemaining\"\x13\n" +
+	"\x11EnrollTOTPRequest\">\n" +
+	"\x12EnrollTOTPResponse\x12\x16\n" +
+	"\x06secret\x18\x01 \x01(\tR\x06secret\x12\x10\n" +
+	"\x03uri\x18\x02 \x01(\tR\x03uri\"-\n" +
+	"\x12ConfirmTOTPRequest\x12\x17\n" +
+	"\x04code\x18\x01 \x01(\tB\x03\xe0A\x02R\x04code\">\n" +
+	"\x15RecoveryCodesResponse\x12%\n" +
+	"\x0erecovery_codes\x18\x01 \x03(\tR\rrecoveryCodes\"Y\n" +
+	"\x1eRegenerateRecoveryCodesRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12#\n" +
+	"\rrecovery_code\x18\x02 \x01(\tR\frecoveryCode\"L\n" +
+	"\x11DisableMFARequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12#\n" +
+	"\rrecovery_code\x18\x02 \x01(\tR\frecoveryCode\"\x14\n" +
+	"\x12DisableMFAResponse\"\x8a\x01\n" +
+	"\x10PasskeyChallenge\x12\x1f\n" +
+	"\vceremony_id\x18\x01 \x01(\tR\n" +
+	"ceremonyId\x12\x18\n" +
+	"\aoptions\x18\x02 \x01(\tR\aoptions\x12;\n" +
+	"\vexpire_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expireTime\"\xe9\x01\n" +
+	"\aPasskey\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1e\n" +
+	"\n" +
+	"transports\x18\x03 \x03(\tR\n" +
+	"transports\x12\x1b\n" +
+	"\tbacked_up\x18\x04 \x01(\bR\bbackedUp\x12;\n" +
+	"\vcreate_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12@\n" +
+	"\x0elast_used_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\flastUsedTime\":\n" +
+	"\x18BeginPasskeyLoginRequest\x12\x1e\n" +
+	"\n" +
+	"identifier\x18\x01 \x01(\tR\n" +
+	"identifier\"p\n" +
+	"\x19BeginPasskeySignupRequest\x12\x19\n" +
+	"\x05email\x18\x01 \x01(\tB\x03\xe0A\x02R\x05email\x12\x1f\n" +
+	"\busername\x18\x02 \x01(\tB\x03\xe0A\x02R\busername\x12\x17\n" +
+	"\x04name\x18\x03 \x01(\tB\x03\xe0A\x02R\x04name\"!\n" +
+	"\x1fBeginPasskeyRegistrationRequest\"u\n" +
+	"\x14CreatePasskeyRequest\x12$\n" +
+	"\vceremony_id\x18\x01 \x01(\tB\x03\xe0A\x02R\n" +
+	"ceremonyId\x12#\n" +
+	"\n" +
+	"credential\x18\x02 \x01(\tB\x03\xe0A\x02R\n" +
+	"credential\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\"\x15\n" +
+	"\x13ListPasskeysRequest\"O\n" +
+	"\x14ListPasskeysResponse\x127\n" +
+	"\bpasskeys\x18\x01 \x03(\v2\x1b.saturn.identity.v1.PasskeyR\bpasskeys\"S\n" +
+	"\x14RenamePasskeyRequest\x12\"\n" +
+	"\n" +
+	"passkey_id\x18\x01 \x01(\tB\x03\xe0A\x02R\tpasskeyId\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tB\x03\xe0A\x02R\x04name\":\n" +
+	"\x14DeletePasskeyRequest\x12\"\n" +
+	"\n" +
+	"passkey_id\x18\x01 \x01(\tB\x03\xe0A\x02R\tpasskeyId\"\x17\n" +
+	"\x15DeletePasskeyResponse\"8\n" +
+	"\x1bRequestPasswordResetRequest\x12\x19\n" +
+	"\x05email\x18\x01 \x01(\tB\x03\xe0A\x02R\x05email\"\x1e\n" +
+	"\x1cRequestPasswordResetResponse\"`\n" +
+	"\x1bConfirmPasswordResetRequest\x12\x19\n" +
+	"\x05token\x18\x01 \x01(\tB\x03\xe0A\x02R\x05token\x12&\n" +
+	"\fnew_password\x18\x02 \x01(\tB\x03\xe0A\x02R\vnewPassword\"\x1e\n" +
+	"\x1cConfirmPasswordResetResponse\"o\n" +
+	"\x15ChangePasswordRequest\x12.\n" +
+	"\x10current_password\x18\x01 \x01(\tB\x03\xe0A\x02R\x0fcurrentPassword\x12&\n" +
+	"\fnew_password\x18\x02 \x01(\tB\x03\xe0A\x02R\vnewPassword\"\x18\n" +
+	"\x16ChangePasswordResponse\";\n" +
+	"\x1eResendVerificationEmailRequest\x12\x19\n" +
+	"\x05email\x18\x01 \x01(\tB\x03\xe0A\x02R\x05email\"!\n" +
+	"\x1fResendVerificationEmailResponse\"/\n" +
+	"\x12VerifyEmailRequest\x12\x19\n" +
+	"\x05token\x18\x01 \x01(\tB\x03\xe0A\x02R\x05token\"\x15\n" +
+	"\x13VerifyEmailResponse2\xb8\x1e\n" +
+	"\bIdentity\x12}\n" +
+	"\tLoginUser\x12$.saturn.identity.v1.LoginUserRequest\x1a%.saturn.identity.v1.LoginUserResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/identity/users:login\x12y\n" +
+	"\fRegisterUser\x12'.saturn.identity.v1.RegisterUserRequest\x1a\x18.saturn.identity.v1.User\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/v1/identity/users:register\x12\x91\x01\n" +
+	"\x0eRefreshSession\x12).saturn.identity.v1.RefreshSessionRequest\x1a*.saturn.identity.v1.RefreshSessionResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/v1/identity/sessions:refresh\x12x\n" +
+	"\x06Logout\x12!.saturn.identity.v1.LogoutRequest\x1a\".saturn.identity.v1.LogoutResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/v1/identity/sessions:logout\x12t\n" +
+	"\x0eGetCurrentUser\x12).saturn.identity.v1.GetCurrentUserRequest\x1a\x18.saturn.identity.v1.User\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/identity/users/me\x12\x92\x01\n" +
+	"\x12ListActiveSessions\x12-.saturn.identity.v1.ListActiveSessionsRequest\x1a..saturn.identity.v1.ListActiveSessionsResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/identity/sessions\x12\x9a\x01\n" +
+	"\rRevokeSession\x12(.saturn.identity.v1.RevokeSessionRequest\x1a).saturn.identity.v1.RevokeSessionResponse\"4\x82\xd3\xe4\x93\x02.:\x01*\")/v1/identity/sessions/{session_id}:revoke\x12\x9d\x01\n" +
+	"\x11RevokeAllSessions\x12,.saturn.identity.v1.RevokeAllSessionsRequest\x1a-.saturn.identity.v1.RevokeAllSessionsResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /v1/identity/sessions:revoke-all\x12\xa8\x01\n" +
+	"\x14ListMySecurityEvents\x12/.saturn.identity.v1.ListMySecurityEventsRequest\x1a0.saturn.identity.v1.ListMySecurityEventsResponse\"-\x82\xd3\xe4\x93\x02'\x12%/v1/identity/users/me/security-events\x12y\n" +
+	"\fGetMFAStatus\x12'.saturn.identity.v1.GetMFAStatusRequest\x1a\x1d.saturn.identity.v1.MFAStatus\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/identity/users/me/mfa\x12\x8d\x01\n" +
+	"\n" +
+	"EnrollTOTP\x12%.saturn.identity.v1.EnrollTOTPRequest\x1a&.saturn.identity.v1.EnrollTOTPResponse\"0\x82\xd3\xe4\x93\x02*:\x01*\"%/v1/identity/users/me/mfa/totp:enroll\x12\x93\x01\n" +
+	"\vConfirmTOTP\x12&.saturn.identity.v1.ConfirmTOTPRequest\x1a).saturn.identity.v1.RecoveryCodesResponse\"1\x82\xd3\xe4\x93\x02+:\x01*\"&/v1/identity/users/me/mfa/totp:confirm\x12\xb8\x01\n" +
+	"\x17RegenerateRecoveryCodes\x122.saturn.identity.v1.RegenerateRecoveryCodesRequest\x1a).saturn.identity.v1.RecoveryCodesResponse\">\x82\xd3\xe4\x93\x028:\x01*\"3/v1/identity/users/me/mfa/recovery-codes:regenerate\x12\x89\x01\n" +
+	"\n" +
+	"DisableMFA\x12%.saturn.identity.v1.DisableMFARequest\x1a&.saturn.identity.v1.DisableMFAResponse\",\x82\xd3\xe4\x93\x02&:\x01*\"!/v1/identity/users/me/mfa:disable\x12\x95\x01\n" +
+	"\x11BeginPasskeyLogin\x12,.saturn.identity.v1.BeginPasskeyLoginRequest\x1a$.saturn.identity.v1.PasskeyChallenge\",\x82\xd3\xe4\x93\x02&:\x01*\"!/v1/identity/passkeys:begin-login\x12\x98\x01\n" +
+	"\x12BeginPasskeySignup\x12-.saturn.identity.v1.BeginPasskeySignupRequest\x1a$.saturn.identity.v1.PasskeyChallenge\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/v1/identity/passkeys:begin-signup\x12\xa6\x01\n" +
+	"\x18BeginPasskeyRegistration\x123.saturn.identity.v1.BeginPasskeyRegistrationRequest\x1a$.saturn.identity.v1.PasskeyChallenge\"/\x82\xd3\xe4\x93\x02):\x01*\"$/v1/identity/users/me/passkeys:begin\x12\x81\x01\n" +
+	"\rCreatePasskey\x12(.saturn.identity.v1.CreatePasskeyRequest\x1a\x1b.saturn.identity.v1.Passkey\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/v1/identity/users/me/passkeys\x12\x89\x01\n" +
+	"\fListPasskeys\x12'.saturn.identity.v1.ListPasskeysRequest\x1a(.saturn.identity.v1.ListPasskeysResponse\"&\x82\xd3\xe4\x93\x02 \x12\x1e/v1/identity/users/me/passkeys\x12\x95\x01\n" +
+	"\rRenamePasskey\x12(.saturn.identity.v1.RenamePasskeyRequest\x1a\x1b.saturn.identity.v1.Passkey\"=\x82\xd3\xe4\x93\x027:\x01*\"2/v1/identity/users/me/passkeys/{passkey_id}:rename\x12\x99\x01\n" +
+	"\rDeletePasskey\x12(.saturn.identity.v1.DeletePasskeyRequest\x1a).saturn.identity.v1.DeletePasskeyResponse\"3\x82\xd3\xe4\x93\x02-*+/v1/identity/users/me/passkeys/{passkey_id}\x12\xa9\x01\n" +
+	"\x14RequestPasswordReset\x12/.saturn.identity.v1.RequestPasswordResetRequest\x1a0.saturn.identity.v1.RequestPasswordResetResponse\".\x82\xd3\xe4\x93\x02(:\x01*\"#/v1/identity/password:request-reset\x12\xa1\x01\n" +
+	"\x14ConfirmPasswordReset\x12/.saturn.identity.v1.ConfirmPasswordResetRequest\x1a0.saturn.identity.v1.ConfirmPasswordResetResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/v1/identity/password:reset\x12\x99\x01\n" +
+	"\x0eChangePassword\x12).saturn.identity.v1.ChangePasswordRequest\x1a*.saturn.identity.v1.ChangePasswordResponse\"0\x82\xd3\xe4\x93\x02*:\x01*\"%/v1/identity/users/me/password:change\x12\xb5\x01\n" +
+	"\x17ResendVerificationEmail\x122.saturn.identity.v1.ResendVerificationEmailRequest\x1a3.saturn.identity.v1.ResendVerificationEmailResponse\"1\x82\xd3\xe4\x93\x02+:\x01*\"&/v1/identity/email:resend-verification\x12\x84\x01\n" +
+	"\vVerifyEmail\x12&.saturn.identity.v1.VerifyEmailRequest\x1a'.saturn.identity.v1.VerifyEmailResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v1/identity/email:verifyBCZAgithub.com/masterkeysrd/saturn/apis/saturn/identity/v1;identityv1b\x06proto3"
 
 var (
 	file_saturn_identity_v1_identity_proto_rawDescOnce sync.Once
@@ -1280,62 +3047,135 @@ func file_saturn_identity_v1_identity_proto_rawDescGZIP() []byte {
 	return file_saturn_identity_v1_identity_proto_rawDescData
 }
 
-var file_saturn_identity_v1_identity_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_saturn_identity_v1_identity_proto_msgTypes = make([]protoimpl.MessageInfo, 52)
 var file_saturn_identity_v1_identity_proto_goTypes = []any{
-	(*LoginUserRequest)(nil),              // 0: saturn.identity.v1.LoginUserRequest
-	(*LoginUserResponse)(nil),             // 1: saturn.identity.v1.LoginUserResponse
-	(*RegisterUserRequest)(nil),           // 2: saturn.identity.v1.RegisterUserRequest
-	(*User)(nil),                          // 3: saturn.identity.v1.User
-	(*RefreshSessionRequest)(nil),         // 4: saturn.identity.v1.RefreshSessionRequest
-	(*RefreshSessionResponse)(nil),        // 5: saturn.identity.v1.RefreshSessionResponse
-	(*LogoutRequest)(nil),                 // 6: saturn.identity.v1.LogoutRequest
-	(*LogoutResponse)(nil),                // 7: saturn.identity.v1.LogoutResponse
-	(*GetCurrentUserRequest)(nil),         // 8: saturn.identity.v1.GetCurrentUserRequest
-	(*UserSession)(nil),                   // 9: saturn.identity.v1.UserSession
-	(*ListActiveSessionsRequest)(nil),     // 10: saturn.identity.v1.ListActiveSessionsRequest
-	(*ListActiveSessionsResponse)(nil),    // 11: saturn.identity.v1.ListActiveSessionsResponse
-	(*RevokeSessionRequest)(nil),          // 12: saturn.identity.v1.RevokeSessionRequest
-	(*RevokeSessionResponse)(nil),         // 13: saturn.identity.v1.RevokeSessionResponse
-	(*RevokeAllSessionsRequest)(nil),      // 14: saturn.identity.v1.RevokeAllSessionsRequest
-	(*RevokeAllSessionsResponse)(nil),     // 15: saturn.identity.v1.RevokeAllSessionsResponse
-	(*SecurityEvent)(nil),                 // 16: saturn.identity.v1.SecurityEvent
-	(*ListMySecurityEventsRequest)(nil),   // 17: saturn.identity.v1.ListMySecurityEventsRequest
-	(*ListMySecurityEventsResponse)(nil),  // 18: saturn.identity.v1.ListMySecurityEventsResponse
-	(*LoginUserRequest_UserPassword)(nil), // 19: saturn.identity.v1.LoginUserRequest.UserPassword
-	(*timestamppb.Timestamp)(nil),         // 20: google.protobuf.Timestamp
+	(*LoginUserRequest)(nil),                  // 0: saturn.identity.v1.LoginUserRequest
+	(*LoginUserResponse)(nil),                 // 1: saturn.identity.v1.LoginUserResponse
+	(*RegisterUserRequest)(nil),               // 2: saturn.identity.v1.RegisterUserRequest
+	(*User)(nil),                              // 3: saturn.identity.v1.User
+	(*RefreshSessionRequest)(nil),             // 4: saturn.identity.v1.RefreshSessionRequest
+	(*RefreshSessionResponse)(nil),            // 5: saturn.identity.v1.RefreshSessionResponse
+	(*LogoutRequest)(nil),                     // 6: saturn.identity.v1.LogoutRequest
+	(*LogoutResponse)(nil),                    // 7: saturn.identity.v1.LogoutResponse
+	(*GetCurrentUserRequest)(nil),             // 8: saturn.identity.v1.GetCurrentUserRequest
+	(*UserSession)(nil),                       // 9: saturn.identity.v1.UserSession
+	(*ListActiveSessionsRequest)(nil),         // 10: saturn.identity.v1.ListActiveSessionsRequest
+	(*ListActiveSessionsResponse)(nil),        // 11: saturn.identity.v1.ListActiveSessionsResponse
+	(*RevokeSessionRequest)(nil),              // 12: saturn.identity.v1.RevokeSessionRequest
+	(*RevokeSessionResponse)(nil),             // 13: saturn.identity.v1.RevokeSessionResponse
+	(*RevokeAllSessionsRequest)(nil),          // 14: saturn.identity.v1.RevokeAllSessionsRequest
+	(*RevokeAllSessionsResponse)(nil),         // 15: saturn.identity.v1.RevokeAllSessionsResponse
+	(*SecurityEvent)(nil),                     // 16: saturn.identity.v1.SecurityEvent
+	(*ListMySecurityEventsRequest)(nil),       // 17: saturn.identity.v1.ListMySecurityEventsRequest
+	(*ListMySecurityEventsResponse)(nil),      // 18: saturn.identity.v1.ListMySecurityEventsResponse
+	(*GetMFAStatusRequest)(nil),               // 19: saturn.identity.v1.GetMFAStatusRequest
+	(*MFAStatus)(nil),                         // 20: saturn.identity.v1.MFAStatus
+	(*EnrollTOTPRequest)(nil),                 // 21: saturn.identity.v1.EnrollTOTPRequest
+	(*EnrollTOTPResponse)(nil),                // 22: saturn.identity.v1.EnrollTOTPResponse
+	(*ConfirmTOTPRequest)(nil),                // 23: saturn.identity.v1.ConfirmTOTPRequest
+	(*RecoveryCodesResponse)(nil),             // 24: saturn.identity.v1.RecoveryCodesResponse
+	(*RegenerateRecoveryCodesRequest)(nil),    // 25: saturn.identity.v1.RegenerateRecoveryCodesRequest
+	(*DisableMFARequest)(nil),                 // 26: saturn.identity.v1.DisableMFARequest
+	(*DisableMFAResponse)(nil),                // 27: saturn.identity.v1.DisableMFAResponse
+	(*PasskeyChallenge)(nil),                  // 28: saturn.identity.v1.PasskeyChallenge
+	(*Passkey)(nil),                           // 29: saturn.identity.v1.Passkey
+	(*BeginPasskeyLoginRequest)(nil),          // 30: saturn.identity.v1.BeginPasskeyLoginRequest
+	(*BeginPasskeySignupRequest)(nil),         // 31: saturn.identity.v1.BeginPasskeySignupRequest
+	(*BeginPasskeyRegistrationRequest)(nil),   // 32: saturn.identity.v1.BeginPasskeyRegistrationRequest
+	(*CreatePasskeyRequest)(nil),              // 33: saturn.identity.v1.CreatePasskeyRequest
+	(*ListPasskeysRequest)(nil),               // 34: saturn.identity.v1.ListPasskeysRequest
+	(*ListPasskeysResponse)(nil),              // 35: saturn.identity.v1.ListPasskeysResponse
+	(*RenamePasskeyRequest)(nil),              // 36: saturn.identity.v1.RenamePasskeyRequest
+	(*DeletePasskeyRequest)(nil),              // 37: saturn.identity.v1.DeletePasskeyRequest
+	(*DeletePasskeyResponse)(nil),             // 38: saturn.identity.v1.DeletePasskeyResponse
+	(*RequestPasswordResetRequest)(nil),       // 39: saturn.identity.v1.RequestPasswordResetRequest
+	(*RequestPasswordResetResponse)(nil),      // 40: saturn.identity.v1.RequestPasswordResetResponse
+	(*ConfirmPasswordResetRequest)(nil),       // 41: saturn.identity.v1.ConfirmPasswordResetRequest
+	(*ConfirmPasswordResetResponse)(nil),      // 42: saturn.identity.v1.ConfirmPasswordResetResponse
+	(*ChangePasswordRequest)(nil),             // 43: saturn.identity.v1.ChangePasswordRequest
+	(*ChangePasswordResponse)(nil),            // 44: saturn.identity.v1.ChangePasswordResponse
+	(*ResendVerificationEmailRequest)(nil),    // 45: saturn.identity.v1.ResendVerificationEmailRequest
+	(*ResendVerificationEmailResponse)(nil),   // 46: saturn.identity.v1.ResendVerificationEmailResponse
+	(*VerifyEmailRequest)(nil),                // 47: saturn.identity.v1.VerifyEmailRequest
+	(*VerifyEmailResponse)(nil),               // 48: saturn.identity.v1.VerifyEmailResponse
+	(*LoginUserRequest_UserPassword)(nil),     // 49: saturn.identity.v1.LoginUserRequest.UserPassword
+	(*LoginUserRequest_MFAChallenge)(nil),     // 50: saturn.identity.v1.LoginUserRequest.MFAChallenge
+	(*LoginUserRequest_PasskeyAssertion)(nil), // 51: saturn.identity.v1.LoginUserRequest.PasskeyAssertion
+	(*timestamppb.Timestamp)(nil),             // 52: google.protobuf.Timestamp
 }
 var file_saturn_identity_v1_identity_proto_depIdxs = []int32{
-	19, // 0: saturn.identity.v1.LoginUserRequest.user_password:type_name -> saturn.identity.v1.LoginUserRequest.UserPassword
-	20, // 1: saturn.identity.v1.User.create_time:type_name -> google.protobuf.Timestamp
-	20, // 2: saturn.identity.v1.User.update_time:type_name -> google.protobuf.Timestamp
-	20, // 3: saturn.identity.v1.UserSession.create_time:type_name -> google.protobuf.Timestamp
-	20, // 4: saturn.identity.v1.UserSession.last_used_at:type_name -> google.protobuf.Timestamp
-	9,  // 5: saturn.identity.v1.ListActiveSessionsResponse.sessions:type_name -> saturn.identity.v1.UserSession
-	20, // 6: saturn.identity.v1.SecurityEvent.created_at:type_name -> google.protobuf.Timestamp
-	16, // 7: saturn.identity.v1.ListMySecurityEventsResponse.events:type_name -> saturn.identity.v1.SecurityEvent
-	0,  // 8: saturn.identity.v1.Identity.LoginUser:input_type -> saturn.identity.v1.LoginUserRequest
-	2,  // 9: saturn.identity.v1.Identity.RegisterUser:input_type -> saturn.identity.v1.RegisterUserRequest
-	4,  // 10: saturn.identity.v1.Identity.RefreshSession:input_type -> saturn.identity.v1.RefreshSessionRequest
-	6,  // 11: saturn.identity.v1.Identity.Logout:input_type -> saturn.identity.v1.LogoutRequest
-	8,  // 12: saturn.identity.v1.Identity.GetCurrentUser:input_type -> saturn.identity.v1.GetCurrentUserRequest
-	10, // 13: saturn.identity.v1.Identity.ListActiveSessions:input_type -> saturn.identity.v1.ListActiveSessionsRequest
-	12, // 14: saturn.identity.v1.Identity.RevokeSession:input_type -> saturn.identity.v1.RevokeSessionRequest
-	14, // 15: saturn.identity.v1.Identity.RevokeAllSessions:input_type -> saturn.identity.v1.RevokeAllSessionsRequest
-	17, // 16: saturn.identity.v1.Identity.ListMySecurityEvents:input_type -> saturn.identity.v1.ListMySecurityEventsRequest
-	1,  // 17: saturn.identity.v1.Identity.LoginUser:output_type -> saturn.identity.v1.LoginUserResponse
-	3,  // 18: saturn.identity.v1.Identity.RegisterUser:output_type -> saturn.identity.v1.User
-	5,  // 19: saturn.identity.v1.Identity.RefreshSession:output_type -> saturn.identity.v1.RefreshSessionResponse
-	7,  // 20: saturn.identity.v1.Identity.Logout:output_type -> saturn.identity.v1.LogoutResponse
-	3,  // 21: saturn.identity.v1.Identity.GetCurrentUser:output_type -> saturn.identity.v1.User
-	11, // 22: saturn.identity.v1.Identity.ListActiveSessions:output_type -> saturn.identity.v1.ListActiveSessionsResponse
-	13, // 23: saturn.identity.v1.Identity.RevokeSession:output_type -> saturn.identity.v1.RevokeSessionResponse
-	15, // 24: saturn.identity.v1.Identity.RevokeAllSessions:output_type -> saturn.identity.v1.RevokeAllSessionsResponse
-	18, // 25: saturn.identity.v1.Identity.ListMySecurityEvents:output_type -> saturn.identity.v1.ListMySecurityEventsResponse
-	17, // [17:26] is the sub-list for method output_type
-	8,  // [8:17] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	49, // 0: saturn.identity.v1.LoginUserRequest.user_password:type_name -> saturn.identity.v1.LoginUserRequest.UserPassword
+	50, // 1: saturn.identity.v1.LoginUserRequest.mfa_challenge:type_name -> saturn.identity.v1.LoginUserRequest.MFAChallenge
+	51, // 2: saturn.identity.v1.LoginUserRequest.passkey:type_name -> saturn.identity.v1.LoginUserRequest.PasskeyAssertion
+	52, // 3: saturn.identity.v1.User.create_time:type_name -> google.protobuf.Timestamp
+	52, // 4: saturn.identity.v1.User.update_time:type_name -> google.protobuf.Timestamp
+	52, // 5: saturn.identity.v1.UserSession.create_time:type_name -> google.protobuf.Timestamp
+	52, // 6: saturn.identity.v1.UserSession.last_used_at:type_name -> google.protobuf.Timestamp
+	9,  // 7: saturn.identity.v1.ListActiveSessionsResponse.sessions:type_name -> saturn.identity.v1.UserSession
+	52, // 8: saturn.identity.v1.SecurityEvent.created_at:type_name -> google.protobuf.Timestamp
+	16, // 9: saturn.identity.v1.ListMySecurityEventsResponse.events:type_name -> saturn.identity.v1.SecurityEvent
+	52, // 10: saturn.identity.v1.MFAStatus.confirm_time:type_name -> google.protobuf.Timestamp
+	52, // 11: saturn.identity.v1.PasskeyChallenge.expire_time:type_name -> google.protobuf.Timestamp
+	52, // 12: saturn.identity.v1.Passkey.create_time:type_name -> google.protobuf.Timestamp
+	52, // 13: saturn.identity.v1.Passkey.last_used_time:type_name -> google.protobuf.Timestamp
+	29, // 14: saturn.identity.v1.ListPasskeysResponse.passkeys:type_name -> saturn.identity.v1.Passkey
+	0,  // 15: saturn.identity.v1.Identity.LoginUser:input_type -> saturn.identity.v1.LoginUserRequest
+	2,  // 16: saturn.identity.v1.Identity.RegisterUser:input_type -> saturn.identity.v1.RegisterUserRequest
+	4,  // 17: saturn.identity.v1.Identity.RefreshSession:input_type -> saturn.identity.v1.RefreshSessionRequest
+	6,  // 18: saturn.identity.v1.Identity.Logout:input_type -> saturn.identity.v1.LogoutRequest
+	8,  // 19: saturn.identity.v1.Identity.GetCurrentUser:input_type -> saturn.identity.v1.GetCurrentUserRequest
+	10, // 20: saturn.identity.v1.Identity.ListActiveSessions:input_type -> saturn.identity.v1.ListActiveSessionsRequest
+	12, // 21: saturn.identity.v1.Identity.RevokeSession:input_type -> saturn.identity.v1.RevokeSessionRequest
+	14, // 22: saturn.identity.v1.Identity.RevokeAllSessions:input_type -> saturn.identity.v1.RevokeAllSessionsRequest
+	17, // 23: saturn.identity.v1.Identity.ListMySecurityEvents:input_type -> saturn.identity.v1.ListMySecurityEventsRequest
+	19, // 24: saturn.identity.v1.Identity.GetMFAStatus:input_type -> saturn.identity.v1.GetMFAStatusRequest
+	21, // 25: saturn.identity.v1.Identity.EnrollTOTP:input_type -> saturn.identity.v1.EnrollTOTPRequest
+	23, // 26: saturn.identity.v1.Identity.ConfirmTOTP:input_type -> saturn.identity.v1.ConfirmTOTPRequest
+	25, // 27: saturn.identity.v1.Identity.RegenerateRecoveryCodes:input_type -> saturn.identity.v1.RegenerateRecoveryCodesRequest
+	26, // 28: saturn.identity.v1.Identity.DisableMFA:input_type -> saturn.identity.v1.DisableMFARequest
+	30, // 29: saturn.identity.v1.Identity.BeginPasskeyLogin:input_type -> saturn.identity.v1.BeginPasskeyLoginRequest
+	31, // 30: saturn.identity.v1.Identity.BeginPasskeySignup:input_type -> saturn.identity.v1.BeginPasskeySignupRequest
+	32, // 31: saturn.identity.v1.Identity.BeginPasskeyRegistration:input_type -> saturn.identity.v1.BeginPasskeyRegistrationRequest
+	33, // 32: saturn.identity.v1.Identity.CreatePasskey:input_type -> saturn.identity.v1.CreatePasskeyRequest
+	34, // 33: saturn.identity.v1.Identity.ListPasskeys:input_type -> saturn.identity.v1.ListPasskeysRequest
+	36, // 34: saturn.identity.v1.Identity.RenamePasskey:input_type -> saturn.identity.v1.RenamePasskeyRequest
+	37, // 35: saturn.identity.v1.Identity.DeletePasskey:input_type -> saturn.identity.v1.DeletePasskeyRequest
+	39, // 36: saturn.identity.v1.Identity.RequestPasswordReset:input_type -> saturn.identity.v1.RequestPasswordResetRequest
+	41, // 37: saturn.identity.v1.Identity.ConfirmPasswordReset:input_type -> saturn.identity.v1.ConfirmPasswordResetRequest
+	43, // 38: saturn.identity.v1.Identity.ChangePassword:input_type -> saturn.identity.v1.ChangePasswordRequest
+	45, // 39: saturn.identity.v1.Identity.ResendVerificationEmail:input_type -> saturn.identity.v1.ResendVerificationEmailRequest
+	47, // 40: saturn.identity.v1.Identity.VerifyEmail:input_type -> saturn.identity.v1.VerifyEmailRequest
+	1,  // 41: saturn.identity.v1.Identity.LoginUser:output_type -> saturn.identity.v1.LoginUserResponse
+	3,  // 42: saturn.identity.v1.Identity.RegisterUser:output_type -> saturn.identity.v1.User
+	5,  // 43: saturn.identity.v1.Identity.RefreshSession:output_type -> saturn.identity.v1.RefreshSessionResponse
+	7,  // 44: saturn.identity.v1.Identity.Logout:output_type -> saturn.identity.v1.LogoutResponse
+	3,  // 45: saturn.identity.v1.Identity.GetCurrentUser:output_type -> saturn.identity.v1.User
+	11, // 46: saturn.identity.v1.Identity.ListActiveSessions:output_type -> saturn.identity.v1.ListActiveSessionsResponse
+	13, // 47: saturn.identity.v1.Identity.RevokeSession:output_type -> saturn.identity.v1.RevokeSessionResponse
+	15, // 48: saturn.identity.v1.Identity.RevokeAllSessions:output_type -> saturn.identity.v1.RevokeAllSessionsResponse
+	18, // 49: saturn.identity.v1.Identity.ListMySecurityEvents:output_type -> saturn.identity.v1.ListMySecurityEventsResponse
+	20, // 50: saturn.identity.v1.Identity.GetMFAStatus:output_type -> saturn.identity.v1.MFAStatus
+	22, // 51: saturn.identity.v1.Identity.EnrollTOTP:output_type -> saturn.identity.v1.EnrollTOTPResponse
+	24, // 52: saturn.identity.v1.Identity.ConfirmTOTP:output_type -> saturn.identity.v1.RecoveryCodesResponse
+	24, // 53: saturn.identity.v1.Identity.RegenerateRecoveryCodes:output_type -> saturn.identity.v1.RecoveryCodesResponse
+	27, // 54: saturn.identity.v1.Identity.DisableMFA:output_type -> saturn.identity.v1.DisableMFAResponse
+	28, // 55: saturn.identity.v1.Identity.BeginPasskeyLogin:output_type -> saturn.identity.v1.PasskeyChallenge
+	28, // 56: saturn.identity.v1.Identity.BeginPasskeySignup:output_type -> saturn.identity.v1.PasskeyChallenge
+	28, // 57: saturn.identity.v1.Identity.BeginPasskeyRegistration:output_type -> saturn.identity.v1.PasskeyChallenge
+	29, // 58: saturn.identity.v1.Identity.CreatePasskey:output_type -> saturn.identity.v1.Passkey
+	35, // 59: saturn.identity.v1.Identity.ListPasskeys:output_type -> saturn.identity.v1.ListPasskeysResponse
+	29, // 60: saturn.identity.v1.Identity.RenamePasskey:output_type -> saturn.identity.v1.Passkey
+	38, // 61: saturn.identity.v1.Identity.DeletePasskey:output_type -> saturn.identity.v1.DeletePasskeyResponse
+	40, // 62: saturn.identity.v1.Identity.RequestPasswordReset:output_type -> saturn.identity.v1.RequestPasswordResetResponse
+	42, // 63: saturn.identity.v1.Identity.ConfirmPasswordReset:output_type -> saturn.identity.v1.ConfirmPasswordResetResponse
+	44, // 64: saturn.identity.v1.Identity.ChangePassword:output_type -> saturn.identity.v1.ChangePasswordResponse
+	46, // 65: saturn.identity.v1.Identity.ResendVerificationEmail:output_type -> saturn.identity.v1.ResendVerificationEmailResponse
+	48, // 66: saturn.identity.v1.Identity.VerifyEmail:output_type -> saturn.identity.v1.VerifyEmailResponse
+	41, // [41:67] is the sub-list for method output_type
+	15, // [15:41] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
 }
 
 func init() { file_saturn_identity_v1_identity_proto_init() }
@@ -1345,6 +3185,8 @@ func file_saturn_identity_v1_identity_proto_init() {
 	}
 	file_saturn_identity_v1_identity_proto_msgTypes[0].OneofWrappers = []any{
 		(*LoginUserRequest_UserPassword_)(nil),
+		(*LoginUserRequest_MfaChallenge)(nil),
+		(*LoginUserRequest_Passkey)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1352,7 +3194,7 @@ func file_saturn_identity_v1_identity_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_saturn_identity_v1_identity_proto_rawDesc), len(file_saturn_identity_v1_identity_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   20,
+			NumMessages:   52,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
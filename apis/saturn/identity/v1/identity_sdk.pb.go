@@ -113,3 +113,173 @@ func (c *Client) ListMySecurityEvents(ctx context.Context, req *ListMySecurityEv
 	}
 	return &resp, nil
 }
+
+// GetMFAStatus executes GET /api/v1/identity/users/me/mfa.
+func (c *Client) GetMFAStatus(ctx context.Context, req *GetMFAStatusRequest) (*MFAStatus, error) {
+	var resp MFAStatus
+	path := "/api/v1/identity/users/me/mfa"
+	if err := c.base.Do(ctx, "GET", path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// EnrollTOTP executes POST /api/v1/identity/users/me/mfa/totp:enroll.
+func (c *Client) EnrollTOTP(ctx context.Context, req *EnrollTOTPRequest) (*EnrollTOTPResponse, error) {
+	var resp EnrollTOTPResponse
+	path := "/api/v1/identity/users/me/mfa/totp:enroll"
+	if err := c.base.Do(ctx, "POST", path, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ConfirmTOTP executes POST /api/v1/identity/users/me/mfa/totp:confirm.
+func (c *Client) ConfirmTOTP(ctx context.Context, req *ConfirmTOTPRequest) (*RecoveryCodesResponse, error) {
+	var resp RecoveryCodesResponse
+	path := "/api/v1/identity/users/me/mfa/totp:confirm"
+	if err := c.base.Do(ctx, "POST", path, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// RegenerateRecoveryCodes executes POST /api/v1/identity/users/me/mfa/recovery-codes:regenerate.
+func (c *Client) RegenerateRecoveryCodes(ctx context.Context, req *RegenerateRecoveryCodesRequest) (*RecoveryCodesResponse, error) {
+	var resp RecoveryCodesResponse
+	path := "/api/v1/identity/users/me/mfa/recovery-codes:regenerate"
+	if err := c.base.Do(ctx, "POST", path, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// DisableMFA executes POST /api/v1/identity/users/me/mfa:disable.
+func (c *Client) DisableMFA(ctx context.Context, req *DisableMFARequest) (*DisableMFAResponse, error) {
+	var resp DisableMFAResponse
+	path := "/api/v1/identity/users/me/mfa:disable"
+	if err := c.base.Do(ctx, "POST", path, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// BeginPasskeyLogin executes POST /api/v1/identity/passkeys:begin-login.
+func (c *Client) BeginPasskeyLogin(ctx context.Context, req *BeginPasskeyLoginRequest) (*PasskeyChallenge, error) {
+	var resp PasskeyChallenge
+	path := "/api/v1/identity/passkeys:begin-login"
+	if err := c.base.Do(ctx, "POST", path, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// BeginPasskeySignup executes POST /api/v1/identity/passkeys:begin-signup.
+func (c *Client) BeginPasskeySignup(ctx context.Context, req *BeginPasskeySignupRequest) (*PasskeyChallenge, error) {
+	var resp PasskeyChallenge
+	path := "/api/v1/identity/passkeys:begin-signup"
+	if err := c.base.Do(ctx, "POST", path, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// BeginPasskeyRegistration executes POST /api/v1/identity/users/me/passkeys:begin.
+func (c *Client) BeginPasskeyRegistration(ctx context.Context, req *BeginPasskeyRegistrationRequest) (*PasskeyChallenge, error) {
+	var resp PasskeyChallenge
+	path := "/api/v1/identity/users/me/passkeys:begin"
+	if err := c.base.Do(ctx, "POST", path, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// CreatePasskey executes POST /api/v1/identity/users/me/passkeys.
+func (c *Client) CreatePasskey(ctx context.Context, req *CreatePasskeyRequest) (*Passkey, error) {
+	var resp Passkey
+	path := "/api/v1/identity/users/me/passkeys"
+	if err := c.base.Do(ctx, "POST", path, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListPasskeys executes GET /api/v1/identity/users/me/passkeys.
+func (c *Client) ListPasskeys(ctx context.Context, req *ListPasskeysRequest) (*ListPasskeysResponse, error) {
+	var resp ListPasskeysResponse
+	path := "/api/v1/identity/users/me/passkeys"
+	if err := c.base.Do(ctx, "GET", path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// RenamePasskey executes POST /api/v1/identity/users/me/passkeys/{passkey_id}:rename.
+func (c *Client) RenamePasskey(ctx context.Context, req *RenamePasskeyRequest) (*Passkey, error) {
+	var resp Passkey
+	path := fmt.Sprintf("/api/v1/identity/users/me/passkeys/%s:rename", req.GetPasskeyId())
+	if err := c.base.Do(ctx, "POST", path, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// DeletePasskey executes DELETE /api/v1/identity/users/me/passkeys/{passkey_id}.
+func (c *Client) DeletePasskey(ctx context.Context, req *DeletePasskeyRequest) (*DeletePasskeyResponse, error) {
+	var resp DeletePasskeyResponse
+	path := fmt.Sprintf("/api/v1/identity/users/me/passkeys/%s", req.GetPasskeyId())
+	if err := c.base.Do(ctx, "DELETE", path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// RequestPasswordReset executes POST /api/v1/identity/password:request-reset.
+func (c *Client) RequestPasswordReset(ctx context.Context, req *RequestPasswordResetRequest) (*RequestPasswordResetResponse, error) {
+	var resp RequestPasswordResetResponse
+	path := "/api/v1/identity/password:request-reset"
+	if err := c.base.Do(ctx, "POST", path, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ConfirmPasswordReset executes POST /api/v1/identity/password:reset.
+func (c *Client) ConfirmPasswordReset(ctx context.Context, req *ConfirmPasswordResetRequest) (*ConfirmPasswordResetResponse, error) {
+	var resp ConfirmPasswordResetResponse
+	path := "/api/v1/identity/password:reset"
+	if err := c.base.Do(ctx, "POST", path, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ChangePassword executes POST /api/v1/identity/users/me/password:change.
+func (c *Client) ChangePassword(ctx context.Context, req *ChangePasswordRequest) (*ChangePasswordResponse, error) {
+	var resp ChangePasswordResponse
+	path := "/api/v1/identity/users/me/password:change"
+	if err := c.base.Do(ctx, "POST", path, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ResendVerificationEmail executes POST /api/v1/identity/email:resend-verification.
+func (c *Client) ResendVerificationEmail(ctx context.Context, req *ResendVerificationEmailRequest) (*ResendVerificationEmailResponse, error) {
+	var resp ResendVerificationEmailResponse
+	path := "/api/v1/identity/email:resend-verification"
+	if err := c.base.Do(ctx, "POST", path, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// VerifyEmail executes POST /api/v1/identity/email:verify.
+func (c *Client) VerifyEmail(ctx context.Context, req *VerifyEmailRequest) (*VerifyEmailResponse, error) {
+	var resp VerifyEmailResponse
+	path := "/api/v1/identity/email:verify"
+	if err := c.base.Do(ctx, "POST", path, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
@@ -902,6 +902,477 @@ func (x *ListSpaceMembersResponse) GetNextPageToken() string {
 	return ""
 }
 
+// Invitation offers membership of a workspace to an email address.
+type Invitation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The invitation's unique identifier.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The workspace ID.
+	SpaceId string `protobuf:"bytes,2,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	// The invited email address.
+	Email string `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	// The role granted on acceptance.
+	Role string `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	// The user ID of the member who sent the invitation.
+	InviterId string `protobuf:"bytes,5,opt,name=inviter_id,json=inviterId,proto3" json:"inviter_id,omitempty"`
+	// The invitation status: pending, accepted, declined or revoked.
+	Status string `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	// When the invitation stops being answerable.
+	ExpireTime *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	// The user ID that accepted the invitation, if accepted.
+	AcceptedBy *string `protobuf:"bytes,8,opt,name=accepted_by,json=acceptedBy,proto3,oneof" json:"accepted_by,omitempty"`
+	// The creation timestamp.
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// The last update timestamp.
+	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Invitation) Reset() {
+	*x = Invitation{}
+	mi := &file_saturn_space_v1_space_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Invitation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Invitation) ProtoMessage() {}
+
+func (x *Invitation) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_space_v1_space_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Invitation.ProtoReflect.Descriptor instead.
+func (*Invitation) Descriptor() ([]byte, []int) {
+	return file_saturn_space_v1_space_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Invitation) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Invitation) GetSpaceId() string {
+	if x != nil {
+		return x.SpaceId
+	}
+	return ""
+}
+
+func (x *Invitation) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Invitation) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *Invitation) GetInviterId() string {
+	if x != nil {
+		return x.InviterId
+	}
+	return ""
+}
+
+func (x *Invitation) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Invitation) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
+func (x *Invitation) GetAcceptedBy() string {
+	if x != nil && x.AcceptedBy != nil {
+		return *x.AcceptedBy
+	}
+	return ""
+}
+
+func (x *Invitation) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *Invitation) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+// CreateInvitationRequest contains the fields for inviting an email address.
+type CreateInvitationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The workspace ID.
+	SpaceId string `protobuf:"bytes,1,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	// The email address to invite.
+	Email string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	// The role to grant: admin, member or viewer.
+	Role string `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	// How long the invitation stays valid, in hours. Defaults to 168 (7 days), max 720.
+	ExpireHours   int32 `protobuf:"varint,4,opt,name=expire_hours,json=expireHours,proto3" json:"expire_hours,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateInvitationRequest) Reset() {
+	*x = CreateInvitationRequest{}
+	mi := &file_saturn_space_v1_space_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInvitationRequest) ProtoMessage() {}
+
+func (x *CreateInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_space_v1_space_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInvitationRequest.ProtoReflect.Descriptor instead.
+func (*CreateInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_space_v1_space_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CreateInvitationRequest) GetSpaceId() string {
+	if x != nil {
+		return x.SpaceId
+	}
+	return ""
+}
+
+func (x *CreateInvitationRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *CreateInvitationRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *CreateInvitationRequest) GetExpireHours() int32 {
+	if x != nil {
+		return x.ExpireHours
+	}
+	return 0
+}
+
+// ListInvitationsRequest contains the fields for listing workspace invitations.
+type ListInvitationsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The workspace ID.
+	SpaceId       string `protobuf:"bytes,1,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	PageSize      int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`                 // default 20, max 100
+	NextPageToken string `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // cursor-based pagination token
+	// Only return invitations that can still be answered.
+	PendingOnly   bool `protobuf:"varint,4,opt,name=pending_only,json=pendingOnly,proto3" json:"pending_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInvitationsRequest) Reset() {
+	*x = ListInvitationsRequest{}
+	mi := &file_saturn_space_v1_space_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvitationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvitationsRequest) ProtoMessage() {}
+
+func (x *ListInvitationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_space_v1_space_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvitationsRequest.ProtoReflect.Descriptor instead.
+func (*ListInvitationsRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_space_v1_space_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListInvitationsRequest) GetSpaceId() string {
+	if x != nil {
+		return x.SpaceId
+	}
+	return ""
+}
+
+func (x *ListInvitationsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListInvitationsRequest) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListInvitationsRequest) GetPendingOnly() bool {
+	if x != nil {
+		return x.PendingOnly
+	}
+	return false
+}
+
+// ListInvitationsResponse contains the list of invitations and pagination token.
+type ListInvitationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invitations   []*Invitation          `protobuf:"bytes,1,rep,name=invitations,proto3" json:"invitations,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInvitationsResponse) Reset() {
+	*x = ListInvitationsResponse{}
+	mi := &file_saturn_space_v1_space_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvitationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvitationsResponse) ProtoMessage() {}
+
+func (x *ListInvitationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_space_v1_space_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvitationsResponse.ProtoReflect.Descriptor instead.
+func (*ListInvitationsResponse) Descriptor() ([]byte, []int) {
+	return file_saturn_space_v1_space_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListInvitationsResponse) GetInvitations() []*Invitation {
+	if x != nil {
+		return x.Invitations
+	}
+	return nil
+}
+
+func (x *ListInvitationsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// RevokeInvitationRequest contains the fields for revoking an invitation.
+type RevokeInvitationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The workspace ID.
+	SpaceId string `protobuf:"bytes,1,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	// The invitation ID.
+	InvitationId  string `protobuf:"bytes,2,opt,name=invitation_id,json=invitationId,proto3" json:"invitation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeInvitationRequest) Reset() {
+	*x = RevokeInvitationRequest{}
+	mi := &file_saturn_space_v1_space_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeInvitationRequest) ProtoMessage() {}
+
+func (x *RevokeInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_space_v1_space_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeInvitationRequest.ProtoReflect.Descriptor instead.
+func (*RevokeInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_space_v1_space_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RevokeInvitationRequest) GetSpaceId() string {
+	if x != nil {
+		return x.SpaceId
+	}
+	return ""
+}
+
+func (x *RevokeInvitationRequest) GetInvitationId() string {
+	if x != nil {
+		return x.InvitationId
+	}
+	return ""
+}
+
+// AcceptInvitationRequest contains the invite token received by email.
+type AcceptInvitationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The raw invite token.
+	Token         string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptInvitationRequest) Reset() {
+	*x = AcceptInvitationRequest{}
+	mi := &file_saturn_space_v1_space_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptInvitationRequest) ProtoMessage() {}
+
+func (x *AcceptInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_space_v1_space_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptInvitationRequest.ProtoReflect.Descriptor instead.
+func (*AcceptInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_space_v1_space_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *AcceptInvitationRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// DeclineInvitationRequest contains the invite token received by email.
+type DeclineInvitationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The raw invite token.
+	Token         string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeclineInvitationRequest) Reset() {
+	*x = DeclineInvitationRequest{}
+	mi := &file_saturn_space_v1_space_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeclineInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeclineInvitationRequest) ProtoMessage() {}
+
+func (x *DeclineInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saturn_space_v1_space_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeclineInvitationRequest.ProtoReflect.Descriptor instead.
+func (*DeclineInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_saturn_space_v1_space_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DeclineInvitationRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
 // The nested user profile details.
 type SpaceMember_Profile struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -914,7 +1385,7 @@ type SpaceMember_Profile struct {
 
 func (x *SpaceMember_Profile) Reset() {
 	*x = SpaceMember_Profile{}
-	mi := &file_saturn_space_v1_space_proto_msgTypes[15]
+	mi := &file_saturn_space_v1_space_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -926,7 +1397,7 @@ func (x *SpaceMember_Profile) String() string {
 func (*SpaceMember_Profile) ProtoMessage() {}
 
 func (x *SpaceMember_Profile) ProtoReflect() protoreflect.Message {
-	mi := &file_saturn_space_v1_space_proto_msgTypes[15]
+	mi := &file_saturn_space_v1_space_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1028,7 +1499,46 @@ const file_saturn_space_v1_space_proto_rawDesc = "" +
 	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\"z\n" +
 	"\x18ListSpaceMembersResponse\x126\n" +
 	"\amembers\x18\x01 \x03(\v2\x1c.saturn.space.v1.SpaceMemberR\amembers\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken2\xef\b\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xa3\x03\n" +
+	"\n" +
+	"Invitation\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x03R\x02id\x12\x1e\n" +
+	"\bspace_id\x18\x02 \x01(\tB\x03\xe0A\x03R\aspaceId\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\x12\"\n" +
+	"\n" +
+	"inviter_id\x18\x05 \x01(\tB\x03\xe0A\x03R\tinviterId\x12\x1b\n" +
+	"\x06status\x18\x06 \x01(\tB\x03\xe0A\x03R\x06status\x12@\n" +
+	"\vexpire_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"expireTime\x12)\n" +
+	"\vaccepted_by\x18\b \x01(\tB\x03\xe0A\x03H\x00R\n" +
+	"acceptedBy\x88\x01\x01\x12;\n" +
+	"\vcreate_time\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12;\n" +
+	"\vupdate_time\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updateTimeB\x0e\n" +
+	"\f_accepted_by\"\x90\x01\n" +
+	"\x17CreateInvitationRequest\x12\x1e\n" +
+	"\bspace_id\x18\x01 \x01(\tB\x03\xe0A\x02R\aspaceId\x12\x19\n" +
+	"\x05email\x18\x02 \x01(\tB\x03\xe0A\x02R\x05email\x12\x17\n" +
+	"\x04role\x18\x03 \x01(\tB\x03\xe0A\x02R\x04role\x12!\n" +
+	"\fexpire_hours\x18\x04 \x01(\x05R\vexpireHours\"\xa0\x01\n" +
+	"\x16ListInvitationsRequest\x12\x1e\n" +
+	"\bspace_id\x18\x01 \x01(\tB\x03\xe0A\x02R\aspaceId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\x12!\n" +
+	"\fpending_only\x18\x04 \x01(\bR\vpendingOnly\"\x80\x01\n" +
+	"\x17ListInvitationsResponse\x12=\n" +
+	"\vinvitations\x18\x01 \x03(\v2\x1b.saturn.space.v1.InvitationR\vinvitations\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"c\n" +
+	"\x17RevokeInvitationRequest\x12\x1e\n" +
+	"\bspace_id\x18\x01 \x01(\tB\x03\xe0A\x02R\aspaceId\x12(\n" +
+	"\rinvitation_id\x18\x02 \x01(\tB\x03\xe0A\x02R\finvitationId\"4\n" +
+	"\x17AcceptInvitationRequest\x12\x19\n" +
+	"\x05token\x18\x01 \x01(\tB\x03\xe0A\x02R\x05token\"5\n" +
+	"\x18DeclineInvitationRequest\x12\x19\n" +
+	"\x05token\x18\x01 \x01(\tB\x03\xe0A\x02R\x05token2\xac\x0e\n" +
 	"\x06Spaces\x12a\n" +
 	"\vCreateSpace\x12#.saturn.space.v1.CreateSpaceRequest\x1a\x16.saturn.space.v1.Space\"\x15\x82\xd3\xe4\x93\x02\x0f:\x01*\"\n" +
 	"/v1/spaces\x12c\n" +
@@ -1041,7 +1551,12 @@ const file_saturn_space_v1_space_proto_rawDesc = "" +
 	"\x0eAddSpaceMember\x12&.saturn.space.v1.AddSpaceMemberRequest\x1a\x1c.saturn.space.v1.SpaceMember\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/v1/spaces/{space_id}/members\x12\x9b\x01\n" +
 	"\x11RemoveSpaceMember\x12).saturn.space.v1.RemoveSpaceMemberRequest\x1a*.saturn.space.v1.RemoveSpaceMemberResponse\"/\x82\xd3\xe4\x93\x02)*'/v1/spaces/{space_id}/members/{user_id}\x12\x98\x01\n" +
 	"\x15UpdateSpaceMemberRole\x12-.saturn.space.v1.UpdateSpaceMemberRoleRequest\x1a\x1c.saturn.space.v1.SpaceMember\"2\x82\xd3\xe4\x93\x02,:\x01*2'/v1/spaces/{space_id}/members/{user_id}\x12\x8e\x01\n" +
-	"\x10ListSpaceMembers\x12(.saturn.space.v1.ListSpaceMembersRequest\x1a).saturn.space.v1.ListSpaceMembersResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/v1/spaces/{space_id}/membersB=Z;github.com/masterkeysrd/saturn/apis/saturn/space/v1;spacev1b\x06proto3"
+	"\x10ListSpaceMembers\x12(.saturn.space.v1.ListSpaceMembersRequest\x1a).saturn.space.v1.ListSpaceMembersResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/v1/spaces/{space_id}/members\x12\x87\x01\n" +
+	"\x10CreateInvitation\x12(.saturn.space.v1.CreateInvitationRequest\x1a\x1b.saturn.space.v1.Invitation\",\x82\xd3\xe4\x93\x02&:\x01*\"!/v1/spaces/{space_id}/invitations\x12\x8f\x01\n" +
+	"\x0fListInvitations\x12'.saturn.space.v1.ListInvitationsRequest\x1a(.saturn.space.v1.ListInvitationsResponse\")\x82\xd3\xe4\x93\x02#\x12!/v1/spaces/{space_id}/invitations\x12\x9e\x01\n" +
+	"\x10RevokeInvitation\x12(.saturn.space.v1.RevokeInvitationRequest\x1a\x1b.saturn.space.v1.Invitation\"C\x82\xd3\xe4\x93\x02=:\x01*\"8/v1/spaces/{space_id}/invitations/{invitation_id}:revoke\x12}\n" +
+	"\x10AcceptInvitation\x12(.saturn.space.v1.AcceptInvitationRequest\x1a\x1c.saturn.space.v1.SpaceMember\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/invitations:accept\x12\x7f\n" +
+	"\x11DeclineInvitation\x12).saturn.space.v1.DeclineInvitationRequest\x1a\x1b.saturn.space.v1.Invitation\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/invitations:declineB=Z;github.com/masterkeysrd/saturn/apis/saturn/space/v1;spacev1b\x06proto3"
 
 var (
 	file_saturn_space_v1_space_proto_rawDescOnce sync.Once
@@ -1055,7 +1570,7 @@ func file_saturn_space_v1_space_proto_rawDescGZIP() []byte {
 	return file_saturn_space_v1_space_proto_rawDescData
 }
 
-var file_saturn_space_v1_space_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_saturn_space_v1_space_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
 var file_saturn_space_v1_space_proto_goTypes = []any{
 	(*Space)(nil),                        // 0: saturn.space.v1.Space
 	(*SpaceMember)(nil),                  // 1: saturn.space.v1.SpaceMember
@@ -1072,40 +1587,61 @@ var file_saturn_space_v1_space_proto_goTypes = []any{
 	(*UpdateSpaceMemberRoleRequest)(nil), // 12: saturn.space.v1.UpdateSpaceMemberRoleRequest
 	(*ListSpaceMembersRequest)(nil),      // 13: saturn.space.v1.ListSpaceMembersRequest
 	(*ListSpaceMembersResponse)(nil),     // 14: saturn.space.v1.ListSpaceMembersResponse
-	(*SpaceMember_Profile)(nil),          // 15: saturn.space.v1.SpaceMember.Profile
-	(*timestamppb.Timestamp)(nil),        // 16: google.protobuf.Timestamp
+	(*Invitation)(nil),                   // 15: saturn.space.v1.Invitation
+	(*CreateInvitationRequest)(nil),      // 16: saturn.space.v1.CreateInvitationRequest
+	(*ListInvitationsRequest)(nil),       // 17: saturn.space.v1.ListInvitationsRequest
+	(*ListInvitationsResponse)(nil),      // 18: saturn.space.v1.ListInvitationsResponse
+	(*RevokeInvitationRequest)(nil),      // 19: saturn.space.v1.RevokeInvitationRequest
+	(*AcceptInvitationRequest)(nil),      // 20: saturn.space.v1.AcceptInvitationRequest
+	(*DeclineInvitationRequest)(nil),     // 21: saturn.space.v1.DeclineInvitationRequest
+	(*SpaceMember_Profile)(nil),          // 22: saturn.space.v1.SpaceMember.Profile
+	(*timestamppb.Timestamp)(nil),        // 23: google.protobuf.Timestamp
 }
 var file_saturn_space_v1_space_proto_depIdxs = []int32{
-	16, // 0: saturn.space.v1.Space.create_time:type_name -> google.protobuf.Timestamp
-	16, // 1: saturn.space.v1.Space.update_time:type_name -> google.protobuf.Timestamp
-	16, // 2: saturn.space.v1.SpaceMember.create_time:type_name -> google.protobuf.Timestamp
-	16, // 3: saturn.space.v1.SpaceMember.update_time:type_name -> google.protobuf.Timestamp
-	15, // 4: saturn.space.v1.SpaceMember.profile:type_name -> saturn.space.v1.SpaceMember.Profile
+	23, // 0: saturn.space.v1.Space.create_time:type_name -> google.protobuf.Timestamp
+	23, // 1: saturn.space.v1.Space.update_time:type_name -> google.protobuf.Timestamp
+	23, // 2: saturn.space.v1.SpaceMember.create_time:type_name -> google.protobuf.Timestamp
+	23, // 3: saturn.space.v1.SpaceMember.update_time:type_name -> google.protobuf.Timestamp
+	22, // 4: saturn.space.v1.SpaceMember.profile:type_name -> saturn.space.v1.SpaceMember.Profile
 	0,  // 5: saturn.space.v1.ListSpacesResponse.spaces:type_name -> saturn.space.v1.Space
 	1,  // 6: saturn.space.v1.ListSpaceMembersResponse.members:type_name -> saturn.space.v1.SpaceMember
-	2,  // 7: saturn.space.v1.Spaces.CreateSpace:input_type -> saturn.space.v1.CreateSpaceRequest
-	3,  // 8: saturn.space.v1.Spaces.GetSpace:input_type -> saturn.space.v1.GetSpaceRequest
-	4,  // 9: saturn.space.v1.Spaces.UpdateSpace:input_type -> saturn.space.v1.UpdateSpaceRequest
-	5,  // 10: saturn.space.v1.Spaces.DeleteSpace:input_type -> saturn.space.v1.DeleteSpaceRequest
-	7,  // 11: saturn.space.v1.Spaces.ListSpaces:input_type -> saturn.space.v1.ListSpacesRequest
-	9,  // 12: saturn.space.v1.Spaces.AddSpaceMember:input_type -> saturn.space.v1.AddSpaceMemberRequest
-	10, // 13: saturn.space.v1.Spaces.RemoveSpaceMember:input_type -> saturn.space.v1.RemoveSpaceMemberRequest
-	12, // 14: saturn.space.v1.Spaces.UpdateSpaceMemberRole:input_type -> saturn.space.v1.UpdateSpaceMemberRoleRequest
-	13, // 15: saturn.space.v1.Spaces.ListSpaceMembers:input_type -> saturn.space.v1.ListSpaceMembersRequest
-	0,  // 16: saturn.space.v1.Spaces.CreateSpace:output_type -> saturn.space.v1.Space
-	0,  // 17: saturn.space.v1.Spaces.GetSpace:output_type -> saturn.space.v1.Space
-	0,  // 18: saturn.space.v1.Spaces.UpdateSpace:output_type -> saturn.space.v1.Space
-	6,  // 19: saturn.space.v1.Spaces.DeleteSpace:output_type -> saturn.space.v1.DeleteSpaceResponse
-	8,  // 20: saturn.space.v1.Spaces.ListSpaces:output_type -> saturn.space.v1.ListSpacesResponse
-	1,  // 21: saturn.space.v1.Spaces.AddSpaceMember:output_type -> saturn.space.v1.SpaceMember
-	11, // 22: saturn.space.v1.Spaces.RemoveSpaceMember:output_type -> saturn.space.v1.RemoveSpaceMemberResponse
-	1,  // 23: saturn.space.v1.Spaces.UpdateSpaceMemberRole:output_type -> saturn.space.v1.SpaceMember
-	14, // 24: saturn.space.v1.Spaces.ListSpaceMembers:output_type -> saturn.space.v1.ListSpaceMembersResponse
-	16, // [16:25] is the sub-list for method output_type
-	7,  // [7:16] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	23, // 7: saturn.space.v1.Invitation.expire_time:type_name -> google.protobuf.Timestamp
+	23, // 8: saturn.space.v1.Invitation.create_time:type_name -> google.protobuf.Timestamp
+	23, // 9: saturn.space.v1.Invitation.update_time:type_name -> google.protobuf.Timestamp
+	15, // 10: saturn.space.v1.ListInvitationsResponse.invitations:type_name -> saturn.space.v1.Invitation
+	2,  // 11: saturn.space.v1.Spaces.CreateSpace:input_type -> saturn.space.v1.CreateSpaceRequest
+	3,  // 12: saturn.space.v1.Spaces.GetSpace:input_type -> saturn.space.v1.GetSpaceRequest
+	4,  // 13: saturn.space.v1.Spaces.UpdateSpace:input_type -> saturn.space.v1.UpdateSpaceRequest
+	5,  // 14: saturn.space.v1.Spaces.DeleteSpace:input_type -> saturn.space.v1.DeleteSpaceRequest
+	7,  // 15: saturn.space.v1.Spaces.ListSpaces:input_type -> saturn.space.v1.ListSpacesRequest
+	9,  // 16: saturn.space.v1.Spaces.AddSpaceMember:input_type -> saturn.space.v1.AddSpaceMemberRequest
+	10, // 17: saturn.space.v1.Spaces.RemoveSpaceMember:input_type -> saturn.space.v1.RemoveSpaceMemberRequest
+	12, // 18: saturn.space.v1.Spaces.UpdateSpaceMemberRole:input_type -> saturn.space.v1.UpdateSpaceMemberRoleRequest
+	13, // 19: saturn.space.v1.Spaces.ListSpaceMembers:input_type -> saturn.space.v1.ListSpaceMembersRequest
+	16, // 20: saturn.space.v1.Spaces.CreateInvitation:input_type -> saturn.space.v1.CreateInvitationRequest
+	17, // 21: saturn.space.v1.Spaces.ListInvitations:input_type -> saturn.space.v1.ListInvitationsRequest
+	19, // 22: saturn.space.v1.Spaces.RevokeInvitation:input_type -> saturn.space.v1.RevokeInvitationRequest
+	20, // 23: saturn.space.v1.Spaces.AcceptInvitation:input_type -> saturn.space.v1.AcceptInvitationRequest
+	21, // 24: saturn.space.v1.Spaces.DeclineInvitation:input_type -> saturn.space.v1.DeclineInvitationRequest
+	0,  // 25: saturn.space.v1.Spaces.CreateSpace:output_type -> saturn.space.v1.Space
+	0,  // 26: saturn.space.v1.Spaces.GetSpace:output_type -> saturn.space.v1.Space
+	0,  // 27: saturn.space.v1.Spaces.UpdateSpace:output_type -> saturn.space.v1.Space
+	6,  // 28: saturn.space.v1.Spaces.DeleteSpace:output_type -> saturn.space.v1.DeleteSpaceResponse
+	8,  // 29: saturn.space.v1.Spaces.ListSpaces:output_type -> saturn.space.v1.ListSpacesResponse
+	1,  // 30: saturn.space.v1.Spaces.AddSpaceMember:output_type -> saturn.space.v1.SpaceMember
+	11, // 31: saturn.space.v1.Spaces.RemoveSpaceMember:output_type -> saturn.space.v1.RemoveSpaceMemberResponse
+	1,  // 32: saturn.space.v1.Spaces.UpdateSpaceMemberRole:output_type -> saturn.space.v1.SpaceMember
+	14, // 33: saturn.space.v1.Spaces.ListSpaceMembers:output_type -> saturn.space.v1.ListSpaceMembersResponse
+	15, // 34: saturn.space.v1.Spaces.CreateInvitation:output_type -> saturn.space.v1.Invitation
+	18, // 35: saturn.space.v1.Spaces.ListInvitations:output_type -> saturn.space.v1.ListInvitationsResponse
+	15, // 36: saturn.space.v1.Spaces.RevokeInvitation:output_type -> saturn.space.v1.Invitation
+	1,  // 37: saturn.space.v1.Spaces.AcceptInvitation:output_type -> saturn.space.v1.SpaceMember
+	15, // 38: saturn.space.v1.Spaces.DeclineInvitation:output_type -> saturn.space.v1.Invitation
+	25, // [25:39] is the sub-list for method output_type
+	11, // [11:25] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_saturn_space_v1_space_proto_init() }
@@ -1113,13 +1649,14 @@ func file_saturn_space_v1_space_proto_init() {
 	if File_saturn_space_v1_space_proto != nil {
 		return
 	}
+	file_saturn_space_v1_space_proto_msgTypes[15].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_saturn_space_v1_space_proto_rawDesc), len(file_saturn_space_v1_space_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   16,
+			NumMessages:   23,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
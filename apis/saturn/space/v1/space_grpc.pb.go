@@ -28,6 +28,11 @@ const (
 	Spaces_RemoveSpaceMember_FullMethodName     = "/saturn.space.v1.Spaces/RemoveSpaceMember"
 	Spaces_UpdateSpaceMemberRole_FullMethodName = "/saturn.space.v1.Spaces/UpdateSpaceMemberRole"
 	Spaces_ListSpaceMembers_FullMethodName      = "/saturn.space.v1.Spaces/ListSpaceMembers"
+	Spaces_CreateInvitation_FullMethodName      = "/saturn.space.v1.Spaces/CreateInvitation"
+	Spaces_ListInvitations_FullMethodName       = "/saturn.space.v1.Spaces/ListInvitations"
+	Spaces_RevokeInvitation_FullMethodName      = "/saturn.space.v1.Spaces/RevokeInvitation"
+	Spaces_AcceptInvitation_FullMethodName      = "/saturn.space.v1.Spaces/AcceptInvitation"
+	Spaces_DeclineInvitation_FullMethodName     = "/saturn.space.v1.Spaces/DeclineInvitation"
 )
 
 // SpacesClient is the client API for Spaces service.
@@ -54,6 +59,16 @@ type SpacesClient interface {
 	UpdateSpaceMemberRole(ctx context.Context, in *UpdateSpaceMemberRoleRequest, opts ...grpc.CallOption) (*SpaceMember, error)
 	// ListSpaceMembers lists all members of a workspace.
 	ListSpaceMembers(ctx context.Context, in *ListSpaceMembersRequest, opts ...grpc.CallOption) (*ListSpaceMembersResponse, error)
+	// CreateInvitation invites an email address to a workspace and emails the invite link.
+	CreateInvitation(ctx context.Context, in *CreateInvitationRequest, opts ...grpc.CallOption) (*Invitation, error)
+	// ListInvitations lists the invitations of a workspace.
+	ListInvitations(ctx context.Context, in *ListInvitationsRequest, opts ...grpc.CallOption) (*ListInvitationsResponse, error)
+	// RevokeInvitation withdraws a pending invitation.
+	RevokeInvitation(ctx context.Context, in *RevokeInvitationRequest, opts ...grpc.CallOption) (*Invitation, error)
+	// AcceptInvitation joins the authenticated user to the inviting workspace.
+	AcceptInvitation(ctx context.Context, in *AcceptInvitationRequest, opts ...grpc.CallOption) (*SpaceMember, error)
+	// DeclineInvitation declines an invitation addressed to the authenticated user.
+	DeclineInvitation(ctx context.Context, in *DeclineInvitationRequest, opts ...grpc.CallOption) (*Invitation, error)
 }
 
 type spacesClient struct {
@@ -154,6 +169,56 @@ func (c *spacesClient) ListSpaceMembers(ctx context.Context, in *ListSpaceMember
 	return out, nil
 }
 
+func (c *spacesClient) CreateInvitation(ctx context.Context, in *CreateInvitationRequest, opts ...grpc.CallOption) (*Invitation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Invitation)
+	err := c.cc.Invoke(ctx, Spaces_CreateInvitation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spacesClient) ListInvitations(ctx context.Context, in *ListInvitationsRequest, opts ...grpc.CallOption) (*ListInvitationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListInvitationsResponse)
+	err := c.cc.Invoke(ctx, Spaces_ListInvitations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spacesClient) RevokeInvitation(ctx context.Context, in *RevokeInvitationRequest, opts ...grpc.CallOption) (*Invitation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Invitation)
+	err := c.cc.Invoke(ctx, Spaces_RevokeInvitation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spacesClient) AcceptInvitation(ctx context.Context, in *AcceptInvitationRequest, opts ...grpc.CallOption) (*SpaceMember, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SpaceMember)
+	err := c.cc.Invoke(ctx, Spaces_AcceptInvitation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spacesClient) DeclineInvitation(ctx context.Context, in *DeclineInvitationRequest, opts ...grpc.CallOption) (*Invitation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Invitation)
+	err := c.cc.Invoke(ctx, Spaces_DeclineInvitation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SpacesServer is the server API for Spaces service.
 // All implementations should embed UnimplementedSpacesServer
 // for forward compatibility.
@@ -178,6 +243,16 @@ type SpacesServer interface {
 	UpdateSpaceMemberRole(context.Context, *UpdateSpaceMemberRoleRequest) (*SpaceMember, error)
 	// ListSpaceMembers lists all members of a workspace.
 	ListSpaceMembers(context.Context, *ListSpaceMembersRequest) (*ListSpaceMembersResponse, error)
+	// CreateInvitation invites an email address to a workspace and emails the invite link.
+	CreateInvitation(context.Context, *CreateInvitationRequest) (*Invitation, error)
+	// ListInvitations lists the invitations of a workspace.
+	ListInvitations(context.Context, *ListInvitationsRequest) (*ListInvitationsResponse, error)
+	// RevokeInvitation withdraws a pending invitation.
+	RevokeInvitation(context.Context, *RevokeInvitationRequest) (*Invitation, error)
+	// AcceptInvitation joins the authenticated user to the inviting workspace.
+	AcceptInvitation(context.Context, *AcceptInvitationRequest) (*SpaceMember, error)
+	// DeclineInvitation declines an invitation addressed to the authenticated user.
+	DeclineInvitation(context.Context, *DeclineInvitationRequest) (*Invitation, error)
 }
 
 // UnimplementedSpacesServer should be embedded to have
@@ -214,6 +289,21 @@ func (UnimplementedSpacesServer) UpdateSpaceMemberRole(context.Context, *UpdateS
 func (UnimplementedSpacesServer) ListSpaceMembers(context.Context, *ListSpaceMembersRequest) (*ListSpaceMembersResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListSpaceMembers not implemented")
 }
+func (UnimplementedSpacesServer) CreateInvitation(context.Context, *CreateInvitationRequest) (*Invitation, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateInvitation not implemented")
+}
+func (UnimplementedSpacesServer) ListInvitations(context.Context, *ListInvitationsRequest) (*ListInvitationsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListInvitations not implemented")
+}
+func (UnimplementedSpacesServer) RevokeInvitation(context.Context, *RevokeInvitationRequest) (*Invitation, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeInvitation not implemented")
+}
+func (UnimplementedSpacesServer) AcceptInvitation(context.Context, *AcceptInvitationRequest) (*SpaceMember, error) {
+	return nil, status.Error(codes.Unimplemented, "method AcceptInvitation not implemented")
+}
+func (UnimplementedSpacesServer) DeclineInvitation(context.Context, *DeclineInvitationRequest) (*Invitation, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeclineInvitation not implemented")
+}
 func (UnimplementedSpacesServer) testEmbeddedByValue() {}
 
 // UnsafeSpacesServer may be embedded to opt out of forward compatibility for this service.
@@ -396,6 +486,96 @@ func _Spaces_ListSpaceMembers_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Spaces_CreateInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpacesServer).CreateInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Spaces_CreateInvitation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpacesServer).CreateInvitation(ctx, req.(*CreateInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Spaces_ListInvitations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInvitationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpacesServer).ListInvitations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Spaces_ListInvitations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpacesServer).ListInvitations(ctx, req.(*ListInvitationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Spaces_RevokeInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpacesServer).RevokeInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Spaces_RevokeInvitation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpacesServer).RevokeInvitation(ctx, req.(*RevokeInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Spaces_AcceptInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpacesServer).AcceptInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Spaces_AcceptInvitation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpacesServer).AcceptInvitation(ctx, req.(*AcceptInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Spaces_DeclineInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeclineInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpacesServer).DeclineInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Spaces_DeclineInvitation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpacesServer).DeclineInvitation(ctx, req.(*DeclineInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Spaces_ServiceDesc is the grpc.ServiceDesc for Spaces service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -439,6 +619,26 @@ var Spaces_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListSpaceMembers",
 			Handler:    _Spaces_ListSpaceMembers_Handler,
 		},
+		{
+			MethodName: "CreateInvitation",
+			Handler:    _Spaces_CreateInvitation_Handler,
+		},
+		{
+			MethodName: "ListInvitations",
+			Handler:    _Spaces_ListInvitations_Handler,
+		},
+		{
+			MethodName: "RevokeInvitation",
+			Handler:    _Spaces_RevokeInvitation_Handler,
+		},
+		{
+			MethodName: "AcceptInvitation",
+			Handler:    _Spaces_AcceptInvitation_Handler,
+		},
+		{
+			MethodName: "DeclineInvitation",
+			Handler:    _Spaces_DeclineInvitation_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "saturn/space/v1/space.proto",
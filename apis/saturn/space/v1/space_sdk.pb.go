@@ -113,3 +113,53 @@ func (c *Client) ListSpaceMembers(ctx context.Context, req *ListSpaceMembersRequ
 	}
 	return &resp, nil
 }
+
+// CreateInvitation executes POST /api/v1/spaces/{space_id}/invitations.
+func (c *Client) CreateInvitation(ctx context.Context, req *CreateInvitationRequest) (*Invitation, error) {
+	var resp Invitation
+	path := fmt.Sprintf("/api/v1/spaces/%s/invitations", req.GetSpaceId())
+	if err := c.base.Do(ctx, "POST", path, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListInvitations executes GET /api/v1/spaces/{space_id}/invitations.
+func (c *Client) ListInvitations(ctx context.Context, req *ListInvitationsRequest) (*ListInvitationsResponse, error) {
+	var resp ListInvitationsResponse
+	path := fmt.Sprintf("/api/v1/spaces/%s/invitations", req.GetSpaceId())
+	if err := c.base.Do(ctx, "GET", path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// RevokeInvitation executes POST /api/v1/spaces/{space_id}/invitations/{invitation_id}:revoke.
+func (c *Client) RevokeInvitation(ctx context.Context, req *RevokeInvitationRequest) (*Invitation, error) {
+	var resp Invitation
+	path := fmt.Sprintf("/api/v1/spaces/%s/invitations/%s:revoke", req.GetSpaceId(), req.GetInvitationId())
+	if err := c.base.Do(ctx, "POST", path, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// AcceptInvitation executes POST /api/v1/invitations:accept.
+func (c *Client) AcceptInvitation(ctx context.Context, req *AcceptInvitationRequest) (*SpaceMember, error) {
+	var resp SpaceMember
+	path := "/api/v1/invitations:accept"
+	if err := c.base.Do(ctx, "POST", path, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// DeclineInvitation executes POST /api/v1/invitations:decline.
+func (c *Client) DeclineInvitation(ctx context.Context, req *DeclineInvitationRequest) (*Invitation, error) {
+	var resp Invitation
+	path := "/api/v1/invitations:decline"
+	if err := c.base.Do(ctx, "POST", path, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
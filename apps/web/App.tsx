@@ -11,6 +11,9 @@ import { useQueryClient } from "@tanstack/react-query"
 import { AuthLayout } from "@/layouts/auth-layout"
 import { LoginView } from "@/features/auth/login-view"
 import { RegisterView } from "@/features/auth/register-view"
+import { ForgotPasswordView } from "@/features/auth/forgot-password-view"
+import { ResetPasswordView } from "@/features/auth/reset-password-view"
+import { VerifyEmailView } from "@/features/auth/verify-email-view"
 import { ProtectedRoute } from "@/components/protected-route"
 import { SidebarProvider, SidebarTrigger } from "@/components/ui/sidebar"
 import { AppSidebar } from "@/components/app-sidebar"
@@ -141,6 +144,9 @@ export function App() {
       <Route element={<AuthLayout />}>
         <Route path="/login" element={<LoginView />} />
         <Route path="/register" element={<RegisterView />} />
+        <Route path="/forgot-password" element={<ForgotPasswordView />} />
+        <Route path="/reset-password" element={<ResetPasswordView />} />
+        <Route path="/verify-email" element={<VerifyEmailView />} />
       </Route>
 
       {/* Protected Routes with Sidebar Layout */}
@@ -9,12 +9,17 @@ export interface AuthUser extends Partial<User> {
   role?: "admin" | "user"
 }
 
+export interface LoginResult {
+  /** Set when the account requires a second factor to finish signing in. */
+  mfaToken?: string
+}
+
 export interface AuthContextType {
   user: AuthUser | null
   accessToken: string | null
   isAuthenticated: boolean
   isLoading: boolean
-  login: (req: LoginUserRequest) => Promise<void>
+  login: (req: LoginUserRequest) => Promise<LoginResult>
   register: (req: RegisterUserRequest) => Promise<void>
   logoutUser: () => Promise<void>
   error: string | null
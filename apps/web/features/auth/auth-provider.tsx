@@ -94,10 +94,16 @@ export function AuthProvider({ children }: { children: ReactNode }) {
     try {
       const res = await loginUser(req)
 
+      // Two-factor accounts get a challenge instead of tokens
+      if (res.mfaRequired) {
+        return { mfaToken: res.mfaToken }
+      }
+
       // Store tokens only — user profile is fetched from API
       authStorage.setSession(res.accessToken)
 
       setAccessToken(res.accessToken)
+      return {}
     } catch (err) {
       const message =
         err instanceof Error ? err.message : "Failed to authenticate"
@@ -0,0 +1,95 @@
+import { useState, useEffect, type SyntheticEvent } from "react"
+import { Link } from "react-router-dom"
+import { AuthCard } from "./components/auth-card"
+import { FormInput } from "./components/form-input"
+import { Button } from "@/components/ui/button"
+import { requestPasswordReset } from "@/gen/saturn/identity/v1/identity"
+
+export function ForgotPasswordView() {
+  useEffect(() => {
+    document.title = "Forgot Password | Saturn"
+  }, [])
+
+  const [email, setEmail] = useState("")
+  const [error, setError] = useState<string | null>(null)
+  const [isSubmitting, setIsSubmitting] = useState(false)
+  const [sent, setSent] = useState(false)
+
+  const handleSubmit = async (e: SyntheticEvent<HTMLFormElement>) => {
+    e.preventDefault()
+    setError(null)
+
+    if (!email.trim()) {
+      setError("Email is required")
+      return
+    }
+
+    setIsSubmitting(true)
+    try {
+      await requestPasswordReset({ email: email.trim() })
+      setSent(true)
+    } catch (err) {
+      setError(err instanceof Error ? err.message : "Failed to send reset link")
+    } finally {
+      setIsSubmitting(false)
+    }
+  }
+
+  return (
+    <AuthCard
+      title="Forgot your password?"
+      subtitle="We will email you a link to choose a new one"
+    >
+      {sent ? (
+        <div className="flex flex-col space-y-5">
+          <p className="text-center text-sm text-muted-foreground">
+            If an account exists for {email.trim()}, a reset link is on its way.
+            The link expires in one hour.
+          </p>
+          <BackToLogin />
+        </div>
+      ) : (
+        <form onSubmit={handleSubmit} className="flex flex-col space-y-5">
+          {error && (
+            <div className="animate-in rounded-2xl border border-destructive/20 bg-destructive/10 p-4 text-sm text-destructive duration-300 fade-in">
+              {error}
+            </div>
+          )}
+
+          <FormInput
+            id="email"
+            type="email"
+            label="Email"
+            value={email}
+            onChange={(e) => setEmail(e.target.value)}
+            disabled={isSubmitting}
+            autoFocus
+          />
+
+          <Button
+            type="submit"
+            className="w-full cursor-pointer rounded-2xl py-6 font-semibold shadow-lg transition-transform hover:scale-[1.01] active:scale-[0.99]"
+            disabled={isSubmitting}
+          >
+            {isSubmitting ? "Sending..." : "Send Reset Link"}
+          </Button>
+
+          <BackToLogin />
+        </form>
+      )}
+    </AuthCard>
+  )
+}
+
+export function BackToLogin() {
+  return (
+    <p className="text-center text-xs text-muted-foreground">
+      <Link
+        to="/login"
+        className="font-medium text-primary hover:underline focus:outline-none"
+      >
+        Back to sign in
+      </Link>
+    </p>
+  )
+}
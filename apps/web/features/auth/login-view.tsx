@@ -4,6 +4,8 @@ import { useAuth } from "./use-auth"
 import { AuthCard } from "./components/auth-card"
 import { FormInput } from "./components/form-input"
 import { Button } from "@/components/ui/button"
+import { beginPasskeyLogin } from "@/gen/saturn/identity/v1/identity"
+import { getPasskeyCredential, isPasskeySupported } from "@/lib/webauthn"
 
 export function LoginView() {
   useEffect(() => {
@@ -14,6 +16,8 @@ export function LoginView() {
   const navigate = useNavigate()
   const [identifier, setIdentifier] = useState("")
   const [password, setPassword] = useState("")
+  const [mfaToken, setMfaToken] = useState("")
+  const [code, setCode] = useState("")
   const [isSubmitting, setIsSubmitting] = useState(false)
   const [fieldErrors, setFieldErrors] = useState<{ [key: string]: string }>({})
 
@@ -22,6 +26,11 @@ export function LoginView() {
     setError(null)
     setFieldErrors({})
 
+    if (mfaToken) {
+      await handleMfaSubmit()
+      return
+    }
+
     const errors: { [key: string]: string } = {}
     if (!identifier.trim()) {
       errors.identifier = "Username or email is required"
@@ -37,12 +46,43 @@ export function LoginView() {
 
     setIsSubmitting(true)
     try {
-      await login({
+      const result = await login({
         userPassword: {
           identifier,
           password,
         },
       })
+      if (result.mfaToken) {
+        setMfaToken(result.mfaToken)
+        return
+      }
+      navigate("/")
+    } catch {
+      // Error is caught and stored in the AuthContext error state
+    } finally {
+      setIsSubmitting(false)
+    }
+  }
+
+  const handleMfaSubmit = async () => {
+    const value = code.trim()
+    if (!value) {
+      setFieldErrors({ code: "Authentication code is required" })
+      return
+    }
+
+    // Six digit codes come from the authenticator app; anything else is a recovery code
+    const isTotp = /^\d{6}$/.test(value.replace(/\s/g, ""))
+
+    setIsSubmitting(true)
+    try {
+      await login({
+        mfaChallenge: {
+          mfaToken,
+          code: isTotp ? value : "",
+          recoveryCode: isTotp ? "" : value,
+        },
+      })
       navigate("/")
     } catch {
       // Error is caught and stored in the AuthContext error state
@@ -51,6 +91,32 @@ export function LoginView() {
     }
   }
 
+  const handlePasskeyLogin = async () => {
+    setError(null)
+    setFieldErrors({})
+    setIsSubmitting(true)
+    try {
+      const challenge = await beginPasskeyLogin({
+        identifier: identifier.trim(),
+      })
+      const credential = await getPasskeyCredential(challenge.options)
+      await login({
+        passkey: {
+          ceremonyId: challenge.ceremonyId,
+          credential,
+        },
+      })
+      navigate("/")
+    } catch (err) {
+      // Login errors are stored by the AuthContext; browser errors are surfaced here
+      if (err instanceof DOMException) {
+        setError("Passkey sign in was cancelled or is not available")
+      }
+    } finally {
+      setIsSubmitting(false)
+    }
+  }
+
   return (
     <AuthCard title="Welcome back" subtitle="Sign in to your Saturn account">
       <form onSubmit={handleSubmit} className="flex flex-col space-y-5">
@@ -60,34 +126,69 @@ export function LoginView() {
           </div>
         )}
 
-        <FormInput
-          id="identifier"
-          type="text"
-          label="Username or Email"
-          value={identifier}
-          onChange={(e) => setIdentifier(e.target.value)}
-          error={fieldErrors.identifier}
-          disabled={isSubmitting}
-        />
-
-        <FormInput
-          id="password"
-          type="password"
-          label="Password"
-          value={password}
-          onChange={(e) => setPassword(e.target.value)}
-          error={fieldErrors.password}
-          disabled={isSubmitting}
-        />
+        {mfaToken ? (
+          <FormInput
+            id="code"
+            type="text"
+            label="Authentication code or recovery code"
+            value={code}
+            onChange={(e) => setCode(e.target.value)}
+            error={fieldErrors.code}
+            disabled={isSubmitting}
+            autoComplete="one-time-code"
+            autoFocus
+          />
+        ) : (
+          <>
+            <FormInput
+              id="identifier"
+              type="text"
+              label="Username or Email"
+              value={identifier}
+              onChange={(e) => setIdentifier(e.target.value)}
+              error={fieldErrors.identifier}
+              disabled={isSubmitting}
+            />
+
+            <FormInput
+              id="password"
+              type="password"
+              label="Password"
+              value={password}
+              onChange={(e) => setPassword(e.target.value)}
+              error={fieldErrors.password}
+              disabled={isSubmitting}
+            />
+
+            <Link
+              to="/forgot-password"
+              className="-mt-3 self-end text-xs font-medium text-primary hover:underline focus:outline-none"
+            >
+              Forgot password?
+            </Link>
+          </>
+        )}
 
         <Button
           type="submit"
           className="w-full cursor-pointer rounded-2xl py-6 font-semibold shadow-lg transition-transform hover:scale-[1.01] active:scale-[0.99]"
           disabled={isSubmitting}
         >
-          {isSubmitting ? "Signing in..." : "Sign In"}
+          {isSubmitting ? "Signing in..." : mfaToken ? "Verify" : "Sign In"}
         </Button>
 
+        {!mfaToken && isPasskeySupported() && (
+          <Button
+            type="button"
+            variant="outline"
+            className="w-full cursor-pointer rounded-2xl py-6 font-semibold"
+            onClick={handlePasskeyLogin}
+            disabled={isSubmitting}
+          >
+            Sign in with a passkey
+          </Button>
+        )}
+
         <p className="text-center text-xs text-muted-foreground">
           Don&apos;t have an account?{" "}
           <Link
@@ -0,0 +1,123 @@
+import { useState, useEffect, type SyntheticEvent } from "react"
+import { Link, useSearchParams } from "react-router-dom"
+import { AuthCard } from "./components/auth-card"
+import { FormInput } from "./components/form-input"
+import { BackToLogin } from "./forgot-password-view"
+import { Button } from "@/components/ui/button"
+import { confirmPasswordReset } from "@/gen/saturn/identity/v1/identity"
+
+export function ResetPasswordView() {
+  useEffect(() => {
+    document.title = "Reset Password | Saturn"
+  }, [])
+
+  const [searchParams] = useSearchParams()
+  const token = searchParams.get("token") ?? ""
+  const [password, setPassword] = useState("")
+  const [confirmPassword, setConfirmPassword] = useState("")
+  const [error, setError] = useState<string | null>(null)
+  const [fieldErrors, setFieldErrors] = useState<{ [key: string]: string }>({})
+  const [isSubmitting, setIsSubmitting] = useState(false)
+  const [done, setDone] = useState(false)
+
+  const handleSubmit = async (e: SyntheticEvent<HTMLFormElement>) => {
+    e.preventDefault()
+    setError(null)
+    setFieldErrors({})
+
+    const errors: { [key: string]: string } = {}
+    if (password.length < 12) {
+      errors.password = "Password must be at least 12 characters long"
+    }
+    if (password !== confirmPassword) {
+      errors.confirmPassword = "Passwords do not match"
+    }
+    if (Object.keys(errors).length > 0) {
+      setFieldErrors(errors)
+      return
+    }
+
+    setIsSubmitting(true)
+    try {
+      await confirmPasswordReset({ token, newPassword: password })
+      setDone(true)
+    } catch (err) {
+      setError(err instanceof Error ? err.message : "Failed to reset password")
+    } finally {
+      setIsSubmitting(false)
+    }
+  }
+
+  if (!token) {
+    return (
+      <AuthCard
+        title="Invalid reset link"
+        subtitle="The link is missing its token"
+      >
+        <p className="text-center text-xs text-muted-foreground">
+          <Link
+            to="/forgot-password"
+            className="font-medium text-primary hover:underline focus:outline-none"
+          >
+            Request a new link
+          </Link>
+        </p>
+      </AuthCard>
+    )
+  }
+
+  return (
+    <AuthCard
+      title="Choose a new password"
+      subtitle="You will be signed out of every device"
+    >
+      {done ? (
+        <div className="flex flex-col space-y-5">
+          <p className="text-center text-sm text-muted-foreground">
+            Your password has been changed. Sign in with the new password.
+          </p>
+          <BackToLogin />
+        </div>
+      ) : (
+        <form onSubmit={handleSubmit} className="flex flex-col space-y-5">
+          {error && (
+            <div className="animate-in rounded-2xl border border-destructive/20 bg-destructive/10 p-4 text-sm text-destructive duration-300 fade-in">
+              {error}
+            </div>
+          )}
+
+          <FormInput
+            id="password"
+            type="password"
+            label="New Password"
+            value={password}
+            onChange={(e) => setPassword(e.target.value)}
+            error={fieldErrors.password}
+            disabled={isSubmitting}
+            autoComplete="new-password"
+            autoFocus
+          />
+
+          <FormInput
+            id="confirmPassword"
+            type="password"
+            label="Confirm New Password"
+            value={confirmPassword}
+            onChange={(e) => setConfirmPassword(e.target.value)}
+            error={fieldErrors.confirmPassword}
+            disabled={isSubmitting}
+            autoComplete="new-password"
+          />
+
+          <Button
+            type="submit"
+            className="w-full cursor-pointer rounded-2xl py-6 font-semibold shadow-lg transition-transform hover:scale-[1.01] active:scale-[0.99]"
+            disabled={isSubmitting}
+          >
+            {isSubmitting ? "Saving..." : "Reset Password"}
+          </Button>
+        </form>
+      )}
+    </AuthCard>
+  )
+}
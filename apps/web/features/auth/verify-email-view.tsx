@@ -0,0 +1,50 @@
+import { useState, useEffect, useRef } from "react"
+import { useSearchParams } from "react-router-dom"
+import { AuthCard } from "./components/auth-card"
+import { BackToLogin } from "./forgot-password-view"
+import { verifyEmail } from "@/gen/saturn/identity/v1/identity"
+
+export function VerifyEmailView() {
+  useEffect(() => {
+    document.title = "Verify Email | Saturn"
+  }, [])
+
+  const [searchParams] = useSearchParams()
+  const token = searchParams.get("token") ?? ""
+  const [state, setState] = useState<"verifying" | "verified" | "failed">(
+    token ? "verifying" : "failed"
+  )
+  const [error, setError] = useState("The link is missing its token")
+
+  // The token is single-use; guard against the double effect run of React Strict Mode
+  const submitted = useRef(false)
+  useEffect(() => {
+    if (!token || submitted.current) return
+    submitted.current = true
+    verifyEmail({ token })
+      .then(() => setState("verified"))
+      .catch((err) => {
+        setError(err instanceof Error ? err.message : "Failed to verify email")
+        setState("failed")
+      })
+  }, [token])
+
+  const subtitle = {
+    verifying: "Checking your verification link...",
+    verified: "Your email address is verified",
+    failed: "We could not verify your email address",
+  }[state]
+
+  return (
+    <AuthCard title="Email verification" subtitle={subtitle}>
+      <div className="flex flex-col space-y-5">
+        {state === "failed" && (
+          <div className="rounded-2xl border border-destructive/20 bg-destructive/10 p-4 text-sm text-destructive">
+            {error}
+          </div>
+        )}
+        {state !== "verifying" && <BackToLogin />}
+      </div>
+    </AuthCard>
+  )
+}
@@ -75,7 +75,13 @@ import {
   DropdownMenuContent,
   DropdownMenuItem,
 } from "@/components/ui/dropdown-menu"
-import { formatCents, toCentsString } from "./utils"
+import {
+  formatAmount,
+  formatCents,
+  toAmountString,
+  toCentsString,
+  useMinorUnits,
+} from "./utils"
 import { cn } from "@/lib/utils"
 
 const ACCOUNT_COLORS = [
@@ -183,6 +189,8 @@ export function AccountsView() {
     { enabled: !!spaceId }
   )
 
+  const minorUnits = useMinorUnits()
+
   const [urlState, setUrlState] = useUrlState(ACCOUNTS_FILTER_DEFAULTS)
 
   const [searchQuery, setSearchQuery] = useState(urlState.q)
@@ -249,7 +257,8 @@ export function AccountsView() {
 
   const handleOpenAdjust = (acc: Account) => {
     setAdjustingAccount(acc)
-    setTargetBalanceStr((Number(acc.currentBalance || 0) / 100).toFixed(2))
+    const units = minorUnits(acc.currency)
+    setTargetBalanceStr(formatCents(acc.currentBalance, units).toFixed(units))
     setAdjustNote("")
     setAdjustError(null)
     setAdjustOpen(true)
@@ -265,13 +274,15 @@ export function AccountsView() {
       return
     }
 
-    const targetBalanceCents = Math.round(parsedNum * 100)
     setAdjustError(null)
     adjustMutation.mutate({
       account_id: adjustingAccount.id,
       req: {
         accountId: adjustingAccount.id,
-        targetBalance: targetBalanceCents.toString(),
+        targetBalance: toCentsString(
+          parsedNum,
+          minorUnits(adjustingAccount.currency)
+        ),
         note: adjustNote || undefined,
       },
     })
@@ -290,9 +301,12 @@ export function AccountsView() {
     accounts.forEach((acc) => {
       if (acc.isActive) {
         activeCount++
-        const baseValue = formatCents(
-          acc.conversion?.balance || acc.currentBalance
-        )
+        const baseValue = acc.conversion?.balance
+          ? formatCents(
+              acc.conversion.balance,
+              minorUnits(settings?.baseCurrency)
+            )
+          : formatCents(acc.currentBalance, minorUnits(acc.currency))
 
         if (acc.type === "CREDIT_CARD") {
           if (baseValue > 0) {
@@ -321,7 +335,7 @@ export function AccountsView() {
       activeCount,
       defaultAccount,
     }
-  }, [accounts])
+  }, [accounts, minorUnits, settings?.baseCurrency])
 
   const handleDeleteAccount = async (id: string) => {
     const acc = accounts.find((a) => a.id === id)
@@ -705,12 +719,9 @@ export function AccountsView() {
                                   : "text-foreground"
                             )}
                           >
-                            {formatCents(acc.currentBalance).toLocaleString(
-                              undefined,
-                              {
-                                minimumFractionDigits: 2,
-                                maximumFractionDigits: 2,
-                              }
+                            {formatAmount(
+                              acc.currentBalance,
+                              minorUnits(acc.currency)
                             )}{" "}
                             <span className="text-xs leading-none font-bold text-muted-foreground uppercase">
                               {acc.currency}
@@ -722,6 +733,7 @@ export function AccountsView() {
                       {isCredit && (
                         <div className="mt-5 space-y-2 border-t border-border/30 pt-4">
                           {(() => {
+                            const units = minorUnits(acc.currency)
                             const limit = Number(acc.creditLimit || "0")
                             const rawBal = Number(acc.currentBalance || "0")
                             const debtOwed = rawBal > 0 ? rawBal : 0
@@ -755,14 +767,19 @@ export function AccountsView() {
                               <>
                                 <div className="flex items-center justify-between text-xs font-semibold text-muted-foreground">
                                   <span>
-                                    Limit: {formatCents(limit).toLocaleString()}{" "}
+                                    Limit:{" "}
+                                    {formatCents(
+                                      limit,
+                                      units
+                                    ).toLocaleString()}{" "}
                                     {acc.currency}
                                   </span>
                                   <span>
                                     Available:{" "}
                                     <span className="font-bold text-foreground">
                                       {formatCents(
-                                        availableCents
+                                        availableCents,
+                                        units
                                       ).toLocaleString()}{" "}
                                       {acc.currency}
                                     </span>
@@ -789,14 +806,19 @@ export function AccountsView() {
                                       <span className="font-bold text-rose-400">
                                         Over Limit by{" "}
                                         {formatCents(
-                                          overLimitCents
+                                          overLimitCents,
+                                          units
                                         ).toLocaleString()}{" "}
                                         {acc.currency}
                                       </span>
                                     )}
                                     {overpayment > 0 && (
                                       <span className="font-bold text-emerald-400">
-                                        +{formatCents(overpayment).toFixed(2)}{" "}
+                                        +
+                                        {toAmountString(
+                                          overpayment,
+                                          units
+                                        )}{" "}
                                         Overpaid
                                       </span>
                                     )}
@@ -867,7 +889,11 @@ export function AccountsView() {
                             </span>
                           </div>
                           <span className="mt-1 block text-sm font-black text-rose-500">
-                            -{formatCents(t.sourceAmount).toLocaleString()}{" "}
+                            -
+                            {formatCents(
+                              t.sourceAmount,
+                              minorUnits(srcAcc?.currency)
+                            ).toLocaleString()}{" "}
                             <span className="text-[10px] text-muted-foreground uppercase">
                               {srcAcc?.currency}
                             </span>
@@ -886,7 +912,11 @@ export function AccountsView() {
                             </span>
                           </div>
                           <span className="mt-1 block text-sm font-black text-emerald-500">
-                            +{formatCents(t.destinationAmount).toLocaleString()}{" "}
+                            +
+                            {formatCents(
+                              t.destinationAmount,
+                              minorUnits(dstAcc?.currency)
+                            ).toLocaleString()}{" "}
                             <span className="text-[10px] text-muted-foreground uppercase">
                               {dstAcc?.currency}
                             </span>
@@ -951,13 +981,16 @@ export function AccountsView() {
 
           {adjustingAccount &&
             (() => {
+              const units = minorUnits(adjustingAccount.currency)
               const currentCents = Number(adjustingAccount.currentBalance || 0)
               const parsedNum = parseFloat(targetBalanceStr)
               const targetCents = isNaN(parsedNum)
                 ? currentCents
-                : Math.round(parsedNum * 100)
+                : Number(toCentsString(parsedNum, units))
               const deltaCents = targetCents - currentCents
-              const deltaStr = (Math.abs(deltaCents) / 100).toFixed(2)
+              const deltaStr = formatCents(Math.abs(deltaCents), units).toFixed(
+                units
+              )
 
               return (
                 <form onSubmit={handleConfirmAdjust} className="space-y-4 pt-2">
@@ -968,7 +1001,7 @@ export function AccountsView() {
                         Current Saturn Balance
                       </span>
                       <span className="text-sm font-extrabold text-foreground">
-                        ${(currentCents / 100).toFixed(2)}{" "}
+                        ${formatCents(currentCents, units).toFixed(units)}{" "}
                         {adjustingAccount.currency}
                       </span>
                     </div>
@@ -977,7 +1010,7 @@ export function AccountsView() {
                         Target Real-World Balance
                       </span>
                       <span className="text-sm font-extrabold text-foreground">
-                        ${(targetCents / 100).toFixed(2)}{" "}
+                        ${formatCents(targetCents, units).toFixed(units)}{" "}
                         {adjustingAccount.currency}
                       </span>
                     </div>
@@ -1107,6 +1140,7 @@ function CreateAccountSheet({
     value: c.code,
     label: `${c.code}${c.name ? ` (${c.name})` : ""}`,
   }))
+  const minorUnits = useMinorUnits()
 
   const createMutation = useCreateAccountMutation()
   const updateMutation = useUpdateAccountMutation()
@@ -1137,6 +1171,7 @@ function CreateAccountSheet({
   useEffect(() => {
     if (open) {
       if (editAccount) {
+        const units = minorUnits(editAccount.currency)
         reset({
           name: editAccount.name,
           lastFour: editAccount.lastFour || "",
@@ -1146,11 +1181,12 @@ function CreateAccountSheet({
             editAccount.type === "CREDIT_CARD" &&
             Number(editAccount.initialBalance) < 0
               ? formatCents(
-                  Math.abs(Number(editAccount.initialBalance))
+                  Math.abs(Number(editAccount.initialBalance)),
+                  units
                 ).toString()
-              : formatCents(editAccount.initialBalance).toString(),
+              : formatCents(editAccount.initialBalance, units).toString(),
           creditLimit: editAccount.creditLimit
-            ? formatCents(editAccount.creditLimit).toString()
+            ? formatCents(editAccount.creditLimit, units).toString()
             : "",
           color: editAccount.color || "indigo",
           isDefault: editAccount.isDefault,
@@ -1172,7 +1208,7 @@ function CreateAccountSheet({
         })
       }
     }
-  }, [open, editAccount, baseCurrency, reset])
+  }, [open, editAccount, baseCurrency, reset, minorUnits])
 
   const accountType = useWatch({ control, name: "type" })
   const currentColor = useWatch({ control, name: "color" })
@@ -1181,7 +1217,8 @@ function CreateAccountSheet({
   const isPending = createMutation.isPending || updateMutation.isPending
 
   const onSubmit = async (data: AccountFormValues) => {
-    let centsStr = toCentsString(data.initialBalance || "0")
+    const units = minorUnits(data.currency)
+    let centsStr = toCentsString(data.initialBalance || "0", units)
     if (data.type === "CREDIT_CARD") {
       const parsedVal = parseFloat(data.initialBalance || "0")
       if (parsedVal > 0) {
@@ -1191,7 +1228,7 @@ function CreateAccountSheet({
 
     const limitStr =
       data.type === "CREDIT_CARD" && data.creditLimit
-        ? toCentsString(data.creditLimit)
+        ? toCentsString(data.creditLimit, units)
         : "0"
 
     try {
@@ -1491,6 +1528,7 @@ function CreateTransferSheet({
     [ratesData?.exchangeRates]
   )
   const createMutation = useCreateTransferMutation()
+  const minorUnits = useMinorUnits()
 
   const {
     register,
@@ -1555,11 +1593,14 @@ function CreateTransferSheet({
           )[0]
 
         if (rate) {
-          setValue("destinationAmount", (srcVal * rate.rate).toFixed(2))
+          setValue(
+            "destinationAmount",
+            (srcVal * rate.rate).toFixed(minorUnits(dAcc.currency))
+          )
         }
       }
     },
-    [activeAccounts, rates, setValue]
+    [activeAccounts, rates, setValue, minorUnits]
   )
 
   useEffect(() => {
@@ -1573,8 +1614,14 @@ function CreateTransferSheet({
       await createMutation.mutateAsync({
         sourceAccountId: data.sourceAccountId,
         destinationAccountId: data.destinationAccountId,
-        sourceAmount: toCentsString(data.sourceAmount),
-        destinationAmount: toCentsString(data.destinationAmount),
+        sourceAmount: toCentsString(
+          data.sourceAmount,
+          minorUnits(srcAcc?.currency)
+        ),
+        destinationAmount: toCentsString(
+          data.destinationAmount,
+          minorUnits(dstAcc?.currency)
+        ),
         transferDate: data.transferDate.toISOString(),
         notes: data.notes || "",
       })
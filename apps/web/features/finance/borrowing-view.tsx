@@ -19,7 +19,7 @@ import {
 } from "@/components/ui/input-group"
 import { CreateBorrowingSheet } from "./components/create-borrowing-sheet"
 import { BorrowingDetailSheet } from "./components/borrowing-detail-sheet"
-import { formatCents } from "./utils"
+import { formatAmount, formatCents, useMinorUnits } from "./utils"
 import {
   HandCoins,
   TrendingUp,
@@ -49,6 +49,7 @@ export function BorrowingView() {
     { enabled: !!spaceId }
   )
   const baseCurrency = settings?.baseCurrency || "USD"
+  const minorUnits = useMinorUnits()
 
   const [urlState, setUrlState] = useUrlState(BORROWING_FILTER_DEFAULTS)
   const [searchQuery, setSearchQuery] = useState(urlState.q)
@@ -121,10 +122,18 @@ export function BorrowingView() {
   const activeBorrowings = borrowings.filter((b) => b.status === "ACTIVE")
   const totalLent = activeBorrowings
     .filter((b) => b.direction === "LENT")
-    .reduce((sum, b) => sum + parseFloat(b.remainingAmount || "0"), 0)
+    .reduce(
+      (sum, b) =>
+        sum + formatCents(b.remainingAmount || "0", minorUnits(b.currency)),
+      0
+    )
   const totalBorrowed = activeBorrowings
     .filter((b) => b.direction === "BORROWED")
-    .reduce((sum, b) => sum + parseFloat(b.remainingAmount || "0"), 0)
+    .reduce(
+      (sum, b) =>
+        sum + formatCents(b.remainingAmount || "0", minorUnits(b.currency)),
+      0
+    )
 
   const handleOpenCreate = () => {
     setEditBorrowing(null)
@@ -173,9 +182,9 @@ export function BorrowingView() {
                   Owed to Me (Lent)
                 </span>
                 <h3 className="mt-0.5 text-2xl font-black tracking-tight text-foreground">
-                  {formatCents(totalLent).toLocaleString(undefined, {
-                    minimumFractionDigits: 2,
-                    maximumFractionDigits: 2,
+                  {totalLent.toLocaleString(undefined, {
+                    minimumFractionDigits: minorUnits(baseCurrency),
+                    maximumFractionDigits: minorUnits(baseCurrency),
                   })}{" "}
                   <span className="text-xs font-normal text-muted-foreground uppercase">
                     {baseCurrency}
@@ -196,9 +205,9 @@ export function BorrowingView() {
                   I Owe to Others (Borrowed)
                 </span>
                 <h3 className="mt-0.5 text-2xl font-black tracking-tight text-foreground">
-                  {formatCents(totalBorrowed).toLocaleString(undefined, {
-                    minimumFractionDigits: 2,
-                    maximumFractionDigits: 2,
+                  {totalBorrowed.toLocaleString(undefined, {
+                    minimumFractionDigits: minorUnits(baseCurrency),
+                    maximumFractionDigits: minorUnits(baseCurrency),
                   })}{" "}
                   <span className="text-xs font-normal text-muted-foreground uppercase">
                     {baseCurrency}
@@ -353,12 +362,9 @@ export function BorrowingView() {
                         Remaining Balance
                       </span>
                       <p className="mt-0.5 truncate text-lg font-black text-foreground">
-                        {formatCents(b.remainingAmount).toLocaleString(
-                          undefined,
-                          {
-                            minimumFractionDigits: 2,
-                            maximumFractionDigits: 2,
-                          }
+                        {formatAmount(
+                          b.remainingAmount,
+                          minorUnits(b.currency)
                         )}{" "}
                         <span className="text-[10px] font-medium text-muted-foreground uppercase">
                           {b.currency}
@@ -370,10 +376,10 @@ export function BorrowingView() {
                         Original Total
                       </span>
                       <p className="mt-1 truncate text-sm font-semibold text-muted-foreground">
-                        {formatCents(b.totalAmount).toLocaleString(undefined, {
-                          minimumFractionDigits: 2,
-                          maximumFractionDigits: 2,
-                        })}{" "}
+                        {formatAmount(
+                          b.totalAmount,
+                          minorUnits(b.currency)
+                        )}{" "}
                         <span className="text-[9px] uppercase">
                           {b.currency}
                         </span>
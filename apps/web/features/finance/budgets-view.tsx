@@ -14,7 +14,7 @@ import { CreateBudgetSheet } from "./components/create-budget-sheet"
 import { EditBudgetSheet } from "./components/edit-budget-sheet"
 import { CreateTransactionSheet } from "./components/create-transaction-sheet"
 import { BudgetHistorySheet } from "./components/budget-history-sheet"
-import { formatCents } from "./utils"
+import { formatCents, useMinorUnits } from "./utils"
 import { toast } from "@/components/ui/toast"
 import {
   AlertDialog,
@@ -35,6 +35,8 @@ export function BudgetsView() {
     {},
     { enabled: !!spaceId }
   )
+  const minorUnits = useMinorUnits()
+  const baseUnits = minorUnits(settings?.baseCurrency)
 
   // 2. Fetch budgets with FULL view
   const { data: budgetsData, refetch: refetchBudgets } = useListBudgetsQuery(
@@ -65,11 +67,11 @@ export function BudgetsView() {
     return budgets.reduce((acc, b) => {
       if (!b.isActive) return acc
       if (b.currentPeriod) {
-        return acc + formatCents(b.currentPeriod.limitInBase || "0")
+        return acc + formatCents(b.currentPeriod.limitInBase || "0", baseUnits)
       }
-      return acc + formatCents(b.limitAmount)
+      return acc + formatCents(b.limitAmount, minorUnits(b.currency))
     }, 0)
-  }, [budgetsData])
+  }, [budgetsData, minorUnits, baseUnits])
 
   const handlePeriodLoaded = () => {}
 
@@ -145,8 +147,8 @@ export function BudgetsView() {
               </span>
               <span className="mt-1 block text-2xl font-black tracking-tight text-foreground">
                 {totalLimitBudgeted.toLocaleString(undefined, {
-                  minimumFractionDigits: 2,
-                  maximumFractionDigits: 2,
+                  minimumFractionDigits: baseUnits,
+                  maximumFractionDigits: baseUnits,
                 })}{" "}
                 <span className="text-xs font-bold text-muted-foreground uppercase">
                   {settings?.baseCurrency}
@@ -23,7 +23,7 @@ import {
   Tag,
   Scale,
 } from "lucide-react"
-import { formatCents } from "../utils"
+import { formatAmount, formatCents, useMinorUnits } from "../utils"
 import { cn } from "@/lib/utils"
 
 interface AccountHistorySheetProps {
@@ -43,6 +43,7 @@ export function AccountHistorySheet({
     { enabled: open && !!spaceId }
   )
   const baseCurrency = settings?.baseCurrency || "USD"
+  const minorUnits = useMinorUnits()
 
   // Fetch transaction history for this account
   const { data: txnsData, isLoading: isTxnsLoading } = useListTransactionsQuery(
@@ -104,7 +105,8 @@ export function AccountHistorySheet({
               {transactions.map((txn) => {
                 const conversionPreview = txn.currency !== baseCurrency
                 const budget = budgets.find((b) => b.id === txn.budgetId)
-                const rawAmt = formatCents(txn.amount)
+                const units = minorUnits(txn.currency)
+                const rawAmt = formatCents(txn.amount, units)
                 const isNegative = rawAmt < 0
                 const isExpense =
                   txn.type === "EXPENSE" || txn.type === "TRANSFER_OUT"
@@ -167,8 +169,8 @@ export function AccountHistorySheet({
                         <span className="block text-xs font-black text-foreground">
                           {isNegative ? "-" : "+"}
                           {Math.abs(rawAmt).toLocaleString(undefined, {
-                            minimumFractionDigits: 2,
-                            maximumFractionDigits: 2,
+                            minimumFractionDigits: units,
+                            maximumFractionDigits: units,
                           })}{" "}
                           <span className="text-[9px] font-bold uppercase opacity-85">
                             {txn.currency}
@@ -179,12 +181,9 @@ export function AccountHistorySheet({
                           <span className="mt-0.5 flex items-center justify-end gap-1 text-[9px] font-medium text-muted-foreground">
                             <ArrowRight className="h-3 w-3" />
                             {baseCurrency}{" "}
-                            {formatCents(txn.amountInBase).toLocaleString(
-                              undefined,
-                              {
-                                minimumFractionDigits: 2,
-                                maximumFractionDigits: 2,
-                              }
+                            {formatAmount(
+                              txn.amountInBase,
+                              minorUnits(baseCurrency)
                             )}
                           </span>
                         )}
@@ -14,11 +14,7 @@ import {
   type FieldValues,
   type Path,
 } from "react-hook-form"
-
-// Formatting helper
-const formatCents = (cents: string | number) => {
-  return Number(cents) / 100
-}
+import { formatAmount, useMinorUnits } from "../utils"
 
 interface BaseAccountSelectProps {
   accounts: Account[]
@@ -174,6 +170,7 @@ function AccountSelectInner({
   value: string
   onValueChange: (value: string) => void
 }) {
+  const minorUnits = useMinorUnits()
   const selectedAccount = accounts.find((a) => a.id === value)
   const selectedColors = selectedAccount
     ? getAccountColorClasses(selectedAccount.color)
@@ -228,12 +225,10 @@ function AccountSelectInner({
                 {selectedAccount.type === "CREDIT_CARD" &&
                   Number(selectedAccount.currentBalance || "0") > 0 &&
                   "-"}
-                {formatCents(
-                  selectedAccount.currentBalance || "0"
-                ).toLocaleString(undefined, {
-                  minimumFractionDigits: 2,
-                  maximumFractionDigits: 2,
-                })}{" "}
+                {formatAmount(
+                  selectedAccount.currentBalance || "0",
+                  minorUnits(selectedAccount.currency)
+                )}{" "}
                 {selectedAccount.currency}
               </span>
             </div>
@@ -287,12 +282,9 @@ function AccountSelectInner({
                     {acc.type === "CREDIT_CARD" &&
                       Number(acc.currentBalance || "0") > 0 &&
                       "-"}
-                    {formatCents(acc.currentBalance || "0").toLocaleString(
-                      undefined,
-                      {
-                        minimumFractionDigits: 2,
-                        maximumFractionDigits: 2,
-                      }
+                    {formatAmount(
+                      acc.currentBalance || "0",
+                      minorUnits(acc.currency)
                     )}{" "}
                     <span className="text-[9px] text-muted-foreground uppercase">
                       {acc.currency}
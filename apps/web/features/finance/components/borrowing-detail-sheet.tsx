@@ -22,7 +22,7 @@ import { Button } from "@/components/ui/button"
 import { Input } from "@/components/ui/input"
 import { Label } from "@/components/ui/label"
 import { Loader2, Trash2, Calendar, HandCoins } from "lucide-react"
-import { formatCents, toCentsString } from "../utils"
+import { formatAmount, toCentsString, useMinorUnits } from "../utils"
 import { DatePicker } from "@/components/ui/date-picker"
 import { CurrencyConversionPreview } from "./currency-conversion-preview"
 import { AccountSelect } from "./account-select"
@@ -48,6 +48,7 @@ export function BorrowingDetailSheet({
     { enabled: open && !!spaceId }
   )
   const baseCurrency = settings?.baseCurrency || "USD"
+  const minorUnits = useMinorUnits()
 
   const { data: ratesData } = useListExchangeRatesQuery(
     { pageSize: 100, pageToken: "" },
@@ -132,7 +133,9 @@ export function BorrowingDetailSheet({
   const onSubmitRepayment = async (data: RepaymentFormValues) => {
     if (!borrowing) return
 
-    const cents = parseInt(toCentsString(data.amount))
+    const cents = parseInt(
+      toCentsString(data.amount, minorUnits(borrowing.currency))
+    )
     if (isNaN(cents) || cents <= 0) return
 
     try {
@@ -241,12 +244,9 @@ export function BorrowingDetailSheet({
                     Remaining Balance
                   </span>
                   <span className="text-2xl font-black text-primary">
-                    {formatCents(borrowing.remainingAmount).toLocaleString(
-                      undefined,
-                      {
-                        minimumFractionDigits: 2,
-                        maximumFractionDigits: 2,
-                      }
+                    {formatAmount(
+                      borrowing.remainingAmount,
+                      minorUnits(borrowing.currency)
                     )}{" "}
                     <span className="font-sans text-xs font-normal text-muted-foreground">
                       {currency}
@@ -258,12 +258,9 @@ export function BorrowingDetailSheet({
                     Total Agreement
                   </span>
                   <span className="text-lg font-bold text-foreground/80">
-                    {formatCents(borrowing.totalAmount).toLocaleString(
-                      undefined,
-                      {
-                        minimumFractionDigits: 2,
-                        maximumFractionDigits: 2,
-                      }
+                    {formatAmount(
+                      borrowing.totalAmount,
+                      minorUnits(borrowing.currency)
                     )}{" "}
                     <span className="font-sans text-xs font-normal text-muted-foreground">
                       {currency}
@@ -334,10 +331,7 @@ export function BorrowingDetailSheet({
                       <div className="min-w-0 flex-1 pr-3">
                         <div className="flex items-baseline gap-2">
                           <span className="font-mono text-xs font-bold text-foreground">
-                            {formatCents(r.amount).toLocaleString(undefined, {
-                              minimumFractionDigits: 2,
-                              maximumFractionDigits: 2,
-                            })}{" "}
+                            {formatAmount(r.amount, minorUnits(currency))}{" "}
                             {currency}
                           </span>
                           <span className="text-[10px] text-muted-foreground">
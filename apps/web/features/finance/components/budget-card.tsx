@@ -15,7 +15,12 @@ import {
   Plus,
   History,
 } from "lucide-react"
-import { getBudgetColors, getBudgetIcon, formatCents } from "../utils"
+import {
+  getBudgetColors,
+  getBudgetIcon,
+  formatAmount,
+  useMinorUnits,
+} from "../utils"
 import { BudgetPeriodProgress } from "./budget-period-progress"
 
 interface BudgetCardProps {
@@ -37,6 +42,7 @@ export function BudgetCard({
   onAddExpense,
   onViewHistory,
 }: BudgetCardProps) {
+  const minorUnits = useMinorUnits()
   const intervalColorClass =
     budget.interval === "WEEKLY"
       ? "bg-teal-500/10 text-teal-500 border-teal-500/20"
@@ -81,10 +87,7 @@ export function BudgetCard({
 
         <div className="flex items-center gap-1.5">
           <span className="text-base font-black tracking-tight text-foreground">
-            {formatCents(budget.limitAmount).toLocaleString(undefined, {
-              minimumFractionDigits: 2,
-              maximumFractionDigits: 2,
-            })}
+            {formatAmount(budget.limitAmount, minorUnits(budget.currency))}
           </span>
           <span className="text-[10px] font-bold text-muted-foreground uppercase">
             {budget.currency}
@@ -22,7 +22,7 @@ import {
   TrendingUp,
   Wallet,
 } from "lucide-react"
-import { formatCents, getBudgetColors } from "../utils"
+import { formatAmount, getBudgetColors, useMinorUnits } from "../utils"
 import { cn } from "@/lib/utils"
 
 interface BudgetHistorySheetProps {
@@ -42,6 +42,7 @@ export function BudgetHistorySheet({
     { enabled: open && !!spaceId }
   )
   const baseCurrency = settings?.baseCurrency || "USD"
+  const minorUnits = useMinorUnits()
 
   // Fetch transaction history for this budget
   const { data: txnsData, isLoading: isTxnsLoading } = useListTransactionsQuery(
@@ -165,10 +166,10 @@ export function BudgetHistorySheet({
                           )}
                         >
                           {isExpense ? "-" : "+"}
-                          {formatCents(txn.amount).toLocaleString(undefined, {
-                            minimumFractionDigits: 2,
-                            maximumFractionDigits: 2,
-                          })}{" "}
+                          {formatAmount(
+                            txn.amount,
+                            minorUnits(txn.currency)
+                          )}{" "}
                           <span className="text-[9px] font-bold uppercase opacity-85">
                             {txn.currency}
                           </span>
@@ -178,12 +179,9 @@ export function BudgetHistorySheet({
                           <span className="mt-0.5 flex items-center justify-end gap-1 text-[9px] font-medium text-muted-foreground">
                             <ArrowRight className="h-3 w-3" />
                             {baseCurrency}{" "}
-                            {formatCents(txn.amountInBase).toLocaleString(
-                              undefined,
-                              {
-                                minimumFractionDigits: 2,
-                                maximumFractionDigits: 2,
-                              }
+                            {formatAmount(
+                              txn.amountInBase,
+                              minorUnits(baseCurrency)
                             )}
                           </span>
                         )}
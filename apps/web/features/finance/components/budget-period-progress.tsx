@@ -1,7 +1,7 @@
 import { useEffect } from "react"
 import { type Budget } from "@/gen/saturn/finance/v1/finance"
 import { AlertTriangle, Calendar } from "lucide-react"
-import { formatCents, getBudgetColors } from "../utils"
+import { formatCents, getBudgetColors, useMinorUnits } from "../utils"
 import { cn } from "@/lib/utils"
 
 interface BudgetPeriodProgressProps {
@@ -14,16 +14,17 @@ export function BudgetPeriodProgress({
   onPeriodLoaded,
 }: BudgetPeriodProgressProps) {
   const period = budget.currentPeriod
+  const units = useMinorUnits()(budget.currency)
 
   // Propagate total limit in base currency to parent for dashboard overview stats
   useEffect(() => {
     if (period && onPeriodLoaded && (period.exchangeRateToBase ?? 0) > 0) {
-      const limit = formatCents(budget.limitAmount)
+      const limit = formatCents(budget.limitAmount, units)
       const limitInBase = limit * (period.exchangeRateToBase ?? 0)
       onPeriodLoaded(limitInBase)
     }
     // eslint-disable-next-line react-hooks/exhaustive-deps
-  }, [period, budget.limitAmount])
+  }, [period, budget.limitAmount, units])
 
   if (!period) return null
 
@@ -49,8 +50,8 @@ export function BudgetPeriodProgress({
     )
   }
 
-  const limit = formatCents(budget.limitAmount)
-  const spent = formatCents(period.spentAmount || "0")
+  const limit = formatCents(budget.limitAmount, units)
+  const spent = formatCents(period.spentAmount || "0", units)
   const progressPercent = limit > 0 ? Math.min((spent / limit) * 100, 100) : 0
 
   // Bounds display formatting
@@ -96,13 +97,13 @@ export function BudgetPeriodProgress({
           )}
         >
           {spent.toLocaleString(undefined, {
-            minimumFractionDigits: 2,
-            maximumFractionDigits: 2,
+            minimumFractionDigits: units,
+            maximumFractionDigits: units,
           })}{" "}
           /{" "}
           {limit.toLocaleString(undefined, {
-            minimumFractionDigits: 2,
-            maximumFractionDigits: 2,
+            minimumFractionDigits: units,
+            maximumFractionDigits: units,
           })}{" "}
           {budget.currency}
         </span>
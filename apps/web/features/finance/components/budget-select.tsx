@@ -10,8 +10,9 @@ import { cn } from "@/lib/utils"
 import {
   getBudgetIcon,
   getBudgetColors,
-  formatCents,
+  formatAmount,
   formatInterval,
+  useMinorUnits,
 } from "../utils"
 import { PauseCircle } from "lucide-react"
 import {
@@ -120,6 +121,7 @@ function BudgetSelectInner({
   value: string
   onValueChange: (value: string) => void
 }) {
+  const minorUnits = useMinorUnits()
   const selectedBudget =
     value && value !== "_none" ? budgets.find((b) => b.id === value) : null
 
@@ -192,12 +194,9 @@ function BudgetSelectInner({
                 </div>
               </div>
               <span className="ml-2 shrink-0 text-[10px] font-bold text-muted-foreground tabular-nums">
-                {formatCents(selectedBudget.limitAmount).toLocaleString(
-                  undefined,
-                  {
-                    minimumFractionDigits: 2,
-                    maximumFractionDigits: 2,
-                  }
+                {formatAmount(
+                  selectedBudget.limitAmount,
+                  minorUnits(selectedBudget.currency)
                 )}{" "}
                 {selectedBudget.currency}
               </span>
@@ -264,10 +263,7 @@ function BudgetSelectInner({
                 </div>
                 <div className="shrink-0 text-right">
                   <span className="block text-xs font-bold text-foreground tabular-nums">
-                    {formatCents(b.limitAmount).toLocaleString(undefined, {
-                      minimumFractionDigits: 2,
-                      maximumFractionDigits: 2,
-                    })}{" "}
+                    {formatAmount(b.limitAmount, minorUnits(b.currency))}{" "}
                     <span className="text-[9px] text-muted-foreground uppercase">
                       {b.currency}
                     </span>
@@ -14,6 +14,7 @@ import {
   useListBudgetsQuery,
   useListRecurringExpensesQuery,
   useListTransactionsQuery,
+  useGetFinanceSettingsQuery,
   type ScheduledPayment,
   type Transaction,
 } from "@/gen/saturn/finance/v1/finance"
@@ -52,6 +53,8 @@ import { cn } from "@/lib/utils"
 import {
   toCentsString,
   formatCents,
+  toAmountString,
+  useMinorUnits,
   formatSourceType,
   getBudgetColors,
   getBudgetIcon,
@@ -105,6 +108,13 @@ export function ConfirmPaymentSheet({
   const [txSearch, setTxSearch] = useState<string>("")
   const [popoverOpen, setPopoverOpen] = useState<boolean>(false)
 
+  const minorUnits = useMinorUnits()
+  const { data: settings } = useGetFinanceSettingsQuery(
+    {},
+    { enabled: open }
+  )
+  const baseCurrency = settings?.baseCurrency || "USD"
+
   const { data: accountsData } = useListAccountsQuery(
     { pageSize: 100, pageToken: "" },
     { enabled: open }
@@ -140,7 +150,7 @@ export function ConfirmPaymentSheet({
     const q = txSearch.toLowerCase().trim()
     if (!q) return true
     const vendor = (t.description || "").toLowerCase()
-    const amountStr = formatCents(t.amount || "0").toFixed(2)
+    const amountStr = toAmountString(t.amount || "0", minorUnits(t.currency))
     const budgetName =
       budgets.find((b) => b.id === t.budgetId)?.name?.toLowerCase() || ""
     const accountName =
@@ -223,7 +233,10 @@ export function ConfirmPaymentSheet({
       const defaultDesc = metaDesc || `${name} (${dueFormatted})`
 
       reset({
-        amount: formatCents(payment.amount).toString(),
+        amount: formatCents(
+          payment.amount,
+          minorUnits(payment.currency)
+        ).toString(),
         transactionDate: new Date(),
         effectiveDate: new Date(payment.dueDate),
         budgetId: payment.budgetId || "",
@@ -231,7 +244,7 @@ export function ConfirmPaymentSheet({
         description: defaultDesc,
       })
     }
-  }, [open, payment, expenses, reset])
+  }, [open, payment, expenses, reset, minorUnits])
 
   const amountValue = useWatch({ control, name: "amount" })
   const budgetIdValue = useWatch({ control, name: "budgetId" })
@@ -257,7 +270,10 @@ export function ConfirmPaymentSheet({
       refetchPayments()
       setConfirmedTxn(res)
     } else {
-      const centsAmount = toCentsString(data.amount)
+      const centsAmount = toCentsString(
+        data.amount,
+        minorUnits(payment.currency)
+      )
       const txDateStr = toLocalISODate(data.transactionDate)
       const effDateStr = toLocalISODate(data.effectiveDate)
 
@@ -313,23 +329,28 @@ export function ConfirmPaymentSheet({
                 <div className="flex justify-between border-b border-border/10 pb-2">
                   <span className="text-muted-foreground">Cleared Amount:</span>
                   <span className="font-bold text-foreground">
-                    {formatCents(confirmedTxn.amount).toFixed(2)}{" "}
+                    {toAmountString(
+                      confirmedTxn.amount,
+                      minorUnits(confirmedTxn.currency)
+                    )}{" "}
                     <span className="text-[10px] font-medium text-muted-foreground uppercase">
                       {confirmedTxn.currency}
                     </span>
                   </span>
                 </div>
-                {confirmedTxn.currency !==
-                  (confirmedTxn.amountInBase ? "USD" : "") &&
+                {confirmedTxn.currency !== baseCurrency &&
                   confirmedTxn.amountInBase && (
                     <div className="flex justify-between border-b border-border/10 pb-2">
                       <span className="text-muted-foreground">
                         Amount in Base:
                       </span>
                       <span className="font-bold text-foreground">
-                        {formatCents(confirmedTxn.amountInBase).toFixed(2)}{" "}
+                        {toAmountString(
+                          confirmedTxn.amountInBase,
+                          minorUnits(baseCurrency)
+                        )}{" "}
                         <span className="text-[10px] font-medium text-muted-foreground uppercase">
-                          USD
+                          {baseCurrency}
                         </span>
                       </span>
                     </div>
@@ -456,7 +477,11 @@ export function ConfirmPaymentSheet({
                                 </span>
                               )}
                               <span className="shrink-0 font-semibold text-foreground">
-                                Target: {formatCents(payment.amount).toFixed(2)}{" "}
+                                Target:{" "}
+                                {toAmountString(
+                                  payment.amount,
+                                  minorUnits(payment.currency)
+                                )}{" "}
                                 <span className="text-[10px] font-medium text-muted-foreground uppercase">
                                   {payment.currency}
                                 </span>
@@ -560,8 +585,9 @@ export function ConfirmPaymentSheet({
                                       "Bank Outflow"}
                                   </span>
                                   <span className="font-bold">
-                                    {formatCents(candidateMatch.amount).toFixed(
-                                      2
+                                    {toAmountString(
+                                      candidateMatch.amount,
+                                      minorUnits(candidateMatch.currency)
                                     )}{" "}
                                     <span className="text-[9px] font-medium text-muted-foreground uppercase">
                                       {candidateMatch.currency}
@@ -615,9 +641,10 @@ export function ConfirmPaymentSheet({
                                             day: "numeric",
                                           })
                                         : ""
-                                      const amtStr = formatCents(
-                                        matched.amount || "0"
-                                      ).toFixed(2)
+                                      const amtStr = toAmountString(
+                                        matched.amount || "0",
+                                        minorUnits(matched.currency)
+                                      )
                                       return (
                                         <div className="flex w-full items-center justify-between pr-1 text-xs">
                                           <div className="flex min-w-0 items-center gap-2">
@@ -689,9 +716,10 @@ export function ConfirmPaymentSheet({
                                                 day: "numeric",
                                               })
                                             : ""
-                                          const amtStr = formatCents(
-                                            t.amount || "0"
-                                          ).toFixed(2)
+                                          const amtStr = toAmountString(
+                                            t.amount || "0",
+                                            minorUnits(t.currency)
+                                          )
                                           const budgetName = budgets.find(
                                             (b) => b.id === t.budgetId
                                           )?.name
@@ -936,7 +964,9 @@ export function ConfirmPaymentSheet({
         onConfirm={handleConfirmSkip}
         isPending={skipMutation.isPending}
         amountFormatted={
-          payment ? formatCents(payment.amount).toFixed(2) : undefined
+          payment
+            ? toAmountString(payment.amount, minorUnits(payment.currency))
+            : undefined
         }
         currency={payment?.currency}
       />
@@ -23,7 +23,7 @@ import { Label } from "@/components/ui/label"
 import { Checkbox } from "@/components/ui/checkbox"
 import { FormSelect } from "@/components/ui/form-select"
 import { Loader2 } from "lucide-react"
-import { toCentsString, formatCents } from "../utils"
+import { toCentsString, formatCents, useMinorUnits } from "../utils"
 import { DatePicker } from "@/components/ui/date-picker"
 import { CurrencyConversionPreview } from "./currency-conversion-preview"
 import { borrowingSchema, type BorrowingFormValues } from "../schemas/borrowing"
@@ -55,6 +55,7 @@ export function CreateBorrowingSheet({
     { enabled: open && !!spaceId, staleTime: 1000 * 60 * 30 }
   )
   const currencies = currenciesData?.currencies || []
+  const minorUnits = useMinorUnits()
 
   const { data: ratesData } = useListExchangeRatesQuery(
     { pageSize: 100, pageToken: "" },
@@ -132,7 +133,10 @@ export function CreateBorrowingSheet({
           direction: editBorrowing.direction || "LENT",
           counterparty: editBorrowing.counterparty || "",
           contactInfo: editBorrowing.contactInfo || "",
-          amount: formatCents(editBorrowing.totalAmount).toString(),
+          amount: formatCents(
+            editBorrowing.totalAmount,
+            minorUnits(editBorrowing.currency)
+          ).toString(),
           currency: editBorrowing.currency || baseCurrency || "USD",
           establishedAt: editBorrowing.establishedAt
             ? new Date(editBorrowing.establishedAt)
@@ -159,7 +163,7 @@ export function CreateBorrowingSheet({
         })
       }
     }
-  }, [open, editBorrowing, baseCurrency, reset])
+  }, [open, editBorrowing, baseCurrency, reset, minorUnits])
 
   const amountValue = useWatch({ control, name: "amount" })
   const currencyValue = useWatch({ control, name: "currency" })
@@ -174,7 +178,9 @@ export function CreateBorrowingSheet({
     createBorrowingMutation.isPending || updateBorrowingMutation.isPending
 
   const onSubmit = async (data: BorrowingFormValues) => {
-    const cents = parseInt(toCentsString(data.amount))
+    const cents = parseInt(
+      toCentsString(data.amount, minorUnits(data.currency))
+    )
     if (isNaN(cents) || cents <= 0) return
 
     const borrowingPayload = {
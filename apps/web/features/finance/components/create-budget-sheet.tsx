@@ -34,6 +34,7 @@ import {
   getBudgetColors,
   getBudgetIcon,
   toCentsString,
+  useMinorUnits,
 } from "../utils"
 import { budgetSchema, type BudgetFormValues } from "../schemas/budget"
 
@@ -72,6 +73,7 @@ export function CreateBudgetSheet({
     value: cur.code,
     label: `${cur.code}${cur.name ? ` (${cur.name})` : ""}`,
   }))
+  const minorUnits = useMinorUnits()
 
   const { data: accountsData } = useListAccountsQuery(
     {},
@@ -144,7 +146,7 @@ export function CreateBudgetSheet({
     await createMutation.mutateAsync({
       budget: {
         name: data.name,
-        limitAmount: toCentsString(data.limit),
+        limitAmount: toCentsString(data.limit, minorUnits(data.currency)),
         currency: data.currency,
         interval: data.interval,
         isActive: true,
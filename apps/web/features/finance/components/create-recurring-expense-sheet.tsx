@@ -26,7 +26,7 @@ import { Loader2 } from "lucide-react"
 import { CurrencyConversionPreview } from "./currency-conversion-preview"
 import { BudgetSelect } from "./budget-select"
 import { FormSelect } from "@/components/ui/form-select"
-import { toCentsString, formatCents } from "../utils"
+import { toCentsString, formatCents, useMinorUnits } from "../utils"
 import {
   recurringExpenseSchema,
   type RecurringExpenseFormValues,
@@ -73,6 +73,7 @@ export function CreateRecurringExpenseSheet({
 }: CreateRecurringExpenseSheetProps) {
   const createMutation = useCreateRecurringExpenseMutation()
   const updateMutation = useUpdateRecurringExpenseMutation()
+  const minorUnits = useMinorUnits()
 
   const currencyItems = currencies.map((cur) => ({
     value: cur.code,
@@ -145,7 +146,10 @@ export function CreateRecurringExpenseSheet({
         reset({
           budgetId: editExpense.budgetId,
           name: editExpense.name,
-          amount: formatCents(editExpense.amount).toString(),
+          amount: formatCents(
+            editExpense.amount,
+            minorUnits(editExpense.currency)
+          ).toString(),
           currency: editExpense.currency,
           interval: normalizeIntervalVal(editExpense.interval),
           nextDueDate:
@@ -170,7 +174,7 @@ export function CreateRecurringExpenseSheet({
         })
       }
     }
-  }, [open, editExpense, baseCurrency, reset])
+  }, [open, editExpense, baseCurrency, reset, minorUnits])
 
   const amountValue = useWatch({ control, name: "amount" })
   const currencyValue = useWatch({ control, name: "currency" })
@@ -194,7 +198,7 @@ export function CreateRecurringExpenseSheet({
   const conversion = getConversionPreview(amountValue, currencyValue)
 
   const onSubmit = async (data: RecurringExpenseFormValues) => {
-    const centsAmount = toCentsString(data.amount)
+    const centsAmount = toCentsString(data.amount, minorUnits(data.currency))
     const nextDueDateStr = toLocalISODate(data.nextDueDate)
 
     if (editExpense) {
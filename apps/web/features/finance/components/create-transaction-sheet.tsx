@@ -23,7 +23,7 @@ import { Input } from "@/components/ui/input"
 import { Label } from "@/components/ui/label"
 import { Loader2 } from "lucide-react"
 import { CurrencyConversionPreview } from "./currency-conversion-preview"
-import { toCentsString, formatCents } from "../utils"
+import { toCentsString, formatCents, useMinorUnits } from "../utils"
 import { AccountSelect } from "./account-select"
 import { BudgetSelect } from "./budget-select"
 import { FormSelect } from "@/components/ui/form-select"
@@ -71,6 +71,7 @@ export function CreateTransactionSheet({
     value: c.code,
     label: c.code,
   }))
+  const minorUnits = useMinorUnits()
 
   const { data: accountsData } = useListAccountsQuery(
     {},
@@ -122,7 +123,10 @@ export function CreateTransactionSheet({
           budgetId: editTransaction.budgetId,
           accountId: editTransaction.accountId || "",
           description: editTransaction.description,
-          amount: formatCents(editTransaction.amount).toString(),
+          amount: formatCents(
+            editTransaction.amount,
+            minorUnits(editTransaction.currency)
+          ).toString(),
           currency: editTransaction.currency,
           transactionDate: new Date(editTransaction.transactionDate),
           hasCustomEffectiveDate: isCustomEff,
@@ -156,6 +160,7 @@ export function CreateTransactionSheet({
     budgets,
     activeAccounts,
     reset,
+    minorUnits,
   ])
 
   const budgetIdValue = useWatch({ control, name: "budgetId" })
@@ -229,7 +234,7 @@ export function CreateTransactionSheet({
           id: editTransaction.id || "",
           expense: {
             budgetId: data.budgetId,
-            amount: toCentsString(data.amount),
+            amount: toCentsString(data.amount, minorUnits(data.currency)),
             currency: data.currency,
             description: data.description,
             transactionDate: txDateStr,
@@ -242,7 +247,7 @@ export function CreateTransactionSheet({
       await createExpenseMutation.mutateAsync({
         expense: {
           budgetId: data.budgetId,
-          amount: toCentsString(data.amount),
+          amount: toCentsString(data.amount, minorUnits(data.currency)),
           currency: data.currency,
           description: data.description,
           transactionDate: txDateStr,
@@ -42,6 +42,7 @@ import {
   getBudgetIcon,
   formatCents,
   toCentsString,
+  useMinorUnits,
 } from "../utils"
 import { budgetSchema, type BudgetFormValues } from "../schemas/budget"
 
@@ -93,6 +94,7 @@ export function EditBudgetSheet({
     value: cur.code,
     label: `${cur.code}${cur.name ? ` (${cur.name})` : ""}`,
   }))
+  const minorUnits = useMinorUnits()
 
   const { data: accountsData } = useListAccountsQuery(
     {},
@@ -138,7 +140,10 @@ export function EditBudgetSheet({
     if (activeBudget) {
       reset({
         name: activeBudget.name,
-        limit: formatCents(activeBudget.limitAmount).toString(),
+        limit: formatCents(
+          activeBudget.limitAmount,
+          minorUnits(activeBudget.currency)
+        ).toString(),
         currency: activeBudget.currency,
         interval: activeBudget.interval,
         icon: activeBudget.icon || "piggy-bank",
@@ -146,7 +151,7 @@ export function EditBudgetSheet({
         defaultAccountId: activeBudget.defaultAccountId || "",
       })
     }
-  }, [activeBudget, reset])
+  }, [activeBudget, reset, minorUnits])
 
   const limitValue = useWatch({ control, name: "limit" })
   const currencyValue = useWatch({ control, name: "currency" })
@@ -190,7 +195,7 @@ export function EditBudgetSheet({
         version: activeBudget.version,
         budget: {
           name: data.name,
-          limitAmount: toCentsString(data.limit),
+          limitAmount: toCentsString(data.limit, minorUnits(data.currency)),
           currency: data.currency,
           interval: data.interval,
           isActive,
@@ -28,7 +28,14 @@ import {
 import { AccountSelect } from "./account-select"
 import { BudgetSelect } from "./budget-select"
 import { FormSelect } from "@/components/ui/form-select"
-import { formatCents, decodeBase64Utf8, formatSourceType } from "../utils"
+import {
+  formatAmount,
+  formatCents,
+  toAmountString,
+  useMinorUnits,
+  decodeBase64Utf8,
+  formatSourceType,
+} from "../utils"
 import { cn } from "@/lib/utils"
 import {
   Check,
@@ -94,6 +101,7 @@ export function InboxItemReviewPanel({
   error,
 }: InboxItemReviewPanelProps) {
   const [nowTime] = useState(() => Date.now())
+  const minorUnits = useMinorUnits()
   const [rawOpen, setRawOpen] = useState(false)
   const [popoverOpen, setPopoverOpen] = useState(false)
   const [txSearch, setTxSearch] = useState("")
@@ -287,7 +295,10 @@ export function InboxItemReviewPanel({
         ? txnTypeVal
         : "EXPENSE",
       description: selectedItem.vendorName || "",
-      amountStr: (Number(selectedItem.amount || 0) / 100).toFixed(2),
+      amountStr: toAmountString(
+        selectedItem.amount,
+        minorUnits(selectedItem.currency)
+      ),
       currency: selectedItem.currency || "USD",
       accountId: selectedItem.accountId || "",
       destinationAccountId: "",
@@ -296,7 +307,7 @@ export function InboxItemReviewPanel({
       scheduledPaymentId: initialScheduledPaymentId,
       borrowingId: initialBorrowingId,
     })
-  }, [selectedItem, suggestedBill, suggestedBorrowing, reset])
+  }, [selectedItem, suggestedBill, suggestedBorrowing, reset, minorUnits])
 
   const currentScheduledPaymentId = useWatch({
     control,
@@ -310,7 +321,7 @@ export function InboxItemReviewPanel({
     return payments.filter((p) => {
       const budget = budgets.find((b) => b.id === p.budgetId)
       const budgetName = (budget?.name || "").toLowerCase()
-      const amtStr = (Number(p.amount || 0) / 100).toFixed(2)
+      const amtStr = toAmountString(p.amount, minorUnits(p.currency))
       const dateStr = p.dueDate
         ? new Date(p.dueDate).toLocaleDateString().toLowerCase()
         : ""
@@ -323,7 +334,7 @@ export function InboxItemReviewPanel({
         sourceType.includes(q)
       )
     })
-  }, [payments, budgets, billSearch])
+  }, [payments, budgets, billSearch, minorUnits])
 
   const currentBorrowingId = useWatch({ control, name: "borrowingId" })
   const currentBorrowingLinkType = useWatch({
@@ -337,8 +348,9 @@ export function InboxItemReviewPanel({
 
     return borrowings.filter((b) => {
       const counterparty = (b.counterparty || "").toLowerCase()
-      const remAmtStr = (Number(b.remainingAmount || 0) / 100).toFixed(2)
-      const totalAmtStr = (Number(b.totalAmount || 0) / 100).toFixed(2)
+      const units = minorUnits(b.currency)
+      const remAmtStr = toAmountString(b.remainingAmount, units)
+      const totalAmtStr = toAmountString(b.totalAmount, units)
       const direction = (b.direction || "").toLowerCase()
 
       return (
@@ -348,7 +360,7 @@ export function InboxItemReviewPanel({
         direction.includes(q)
       )
     })
-  }, [borrowings, borrowingSearch])
+  }, [borrowings, borrowingSearch, minorUnits])
 
   const selectedTxId = useWatch({ control, name: "selectedTxId" })
   const overwriteLinkedTx = useWatch({ control, name: "overwriteLinkedTx" })
@@ -405,7 +417,7 @@ export function InboxItemReviewPanel({
     const q = txSearch.toLowerCase().trim()
     if (!q) return true
     const vendor = (t.description || "").toLowerCase()
-    const amountStr = (Number(t.amount || 0) / 100).toFixed(2)
+    const amountStr = toAmountString(t.amount, minorUnits(t.currency))
     const budgetName =
       budgets.find((b) => b.id === t.budgetId)?.name?.toLowerCase() || ""
     const accountName =
@@ -480,7 +492,10 @@ export function InboxItemReviewPanel({
                 </span>
               ) : (
                 <>
-                  {formatCents(selectedItem.amount || "0")}{" "}
+                  {formatCents(
+                    selectedItem.amount || "0",
+                    minorUnits(selectedItem.currency)
+                  )}{" "}
                   <span className="text-[10px] text-muted-foreground uppercase">
                     {selectedItem.currency || "USD"}
                   </span>
@@ -590,7 +605,7 @@ export function InboxItemReviewPanel({
                     <div>
                       <span className="text-muted-foreground">Amount:</span>{" "}
                       {dupTx.currency}{" "}
-                      {(Number(dupTx.amount || 0) / 100).toFixed(2)}
+                      {toAmountString(dupTx.amount, minorUnits(dupTx.currency))}
                     </div>
                     <div className="col-span-2">
                       <span className="text-muted-foreground">Vendor:</span>{" "}
@@ -640,7 +655,10 @@ export function InboxItemReviewPanel({
                       const dateStr = matched.transactionDate
                         ? new Date(matched.transactionDate).toLocaleDateString()
                         : ""
-                      const amtStr = formatCents(matched.amount || "0")
+                      const amtStr = formatCents(
+                        matched.amount || "0",
+                        minorUnits(matched.currency)
+                      )
                       return (
                         <div className="flex w-full items-center justify-between pr-1 text-xs">
                           <div className="flex min-w-0 items-center gap-2">
@@ -765,7 +783,11 @@ export function InboxItemReviewPanel({
                               </div>
                               <div className="flex shrink-0 flex-col items-end gap-0.5 pl-2 text-right">
                                 <span className="font-bold text-foreground">
-                                  {formatCents(t.amount || "0")} {t.currency}
+                                  {formatCents(
+                                    t.amount || "0",
+                                    minorUnits(t.currency)
+                                  )}{" "}
+                                  {t.currency}
                                 </span>
                               </div>
                             </button>
@@ -789,12 +811,9 @@ export function InboxItemReviewPanel({
                     </span>
                     <span className="shrink-0 text-[11px]">
                       ({candidateTxMatch.currency}{" "}
-                      {formatCents(candidateTxMatch.amount).toLocaleString(
-                        undefined,
-                        {
-                          minimumFractionDigits: 2,
-                          maximumFractionDigits: 2,
-                        }
+                      {formatAmount(
+                        candidateTxMatch.amount,
+                        minorUnits(candidateTxMatch.currency)
                       )}
                       )
                     </span>
@@ -834,9 +853,13 @@ export function InboxItemReviewPanel({
                 if (!hasAmountMismatch && !hasCurrencyMismatch) return null
 
                 const stagingAmt = formatCents(
-                  selectedItem.amount?.toString() || "0"
+                  selectedItem.amount?.toString() || "0",
+                  minorUnits(selectedItem.currency)
+                )
+                const ledgerAmt = formatCents(
+                  matched.amount?.toString() || "0",
+                  minorUnits(matched.currency)
                 )
-                const ledgerAmt = formatCents(matched.amount?.toString() || "0")
 
                 return (
                   <div className="mt-2.5 flex animate-in flex-col gap-3 rounded-2xl border border-amber-500/20 bg-amber-500/5 p-3 duration-200 fade-in md:col-span-2">
@@ -885,9 +908,10 @@ export function InboxItemReviewPanel({
                             if (isOverwrite) {
                               setValue(
                                 "amountStr",
-                                (
-                                  Number(selectedItem.amount || 0) / 100
-                                ).toFixed(2)
+                                toAmountString(
+                                  selectedItem.amount,
+                                  minorUnits(selectedItem.currency)
+                                )
                               )
                               setValue(
                                 "description",
@@ -896,7 +920,10 @@ export function InboxItemReviewPanel({
                             } else {
                               setValue(
                                 "amountStr",
-                                (Number(matched.amount || 0) / 100).toFixed(2)
+                                toAmountString(
+                                  matched.amount,
+                                  minorUnits(matched.currency)
+                                )
                               )
                               setValue("description", matched.description || "")
                             }
@@ -1150,12 +1177,10 @@ export function InboxItemReviewPanel({
                                     </span>
                                   </div>
                                   <span className="shrink-0 pl-2 font-bold text-foreground">
-                                    {formatCents(
-                                      selectedPaymentObj.amount
-                                    ).toLocaleString(undefined, {
-                                      minimumFractionDigits: 2,
-                                      maximumFractionDigits: 2,
-                                    })}{" "}
+                                    {formatAmount(
+                                      selectedPaymentObj.amount,
+                                      minorUnits(selectedPaymentObj.currency)
+                                    )}{" "}
                                     {selectedPaymentObj.currency}
                                   </span>
                                 </div>
@@ -1263,12 +1288,10 @@ export function InboxItemReviewPanel({
                                           </div>
                                           <div className="flex shrink-0 flex-col items-end gap-0.5 pl-2 text-right">
                                             <span className="font-bold text-foreground">
-                                              {formatCents(
-                                                p.amount
-                                              ).toLocaleString(undefined, {
-                                                minimumFractionDigits: 2,
-                                                maximumFractionDigits: 2,
-                                              })}{" "}
+                                              {formatAmount(
+                                                p.amount,
+                                                minorUnits(p.currency)
+                                              )}{" "}
                                               {p.currency}
                                             </span>
                                           </div>
@@ -1329,12 +1352,12 @@ export function InboxItemReviewPanel({
                                     </div>
                                     <span className="shrink-0 pl-2 font-bold text-foreground">
                                       Bal:{" "}
-                                      {formatCents(
-                                        selectedBorrowingObj.remainingAmount
-                                      ).toLocaleString(undefined, {
-                                        minimumFractionDigits: 2,
-                                        maximumFractionDigits: 2,
-                                      })}{" "}
+                                      {formatAmount(
+                                        selectedBorrowingObj.remainingAmount,
+                                        minorUnits(
+                                          selectedBorrowingObj.currency
+                                        )
+                                      )}{" "}
                                       {selectedBorrowingObj.currency}
                                     </span>
                                   </div>
@@ -1429,22 +1452,18 @@ export function InboxItemReviewPanel({
                                             <div className="flex shrink-0 flex-col items-end gap-0.5 pl-2 text-right">
                                               <span className="font-bold text-foreground">
                                                 Bal:{" "}
-                                                {formatCents(
-                                                  b.remainingAmount
-                                                ).toLocaleString(undefined, {
-                                                  minimumFractionDigits: 2,
-                                                  maximumFractionDigits: 2,
-                                                })}{" "}
+                                                {formatAmount(
+                                                  b.remainingAmount,
+                                                  minorUnits(b.currency)
+                                                )}{" "}
                                                 {b.currency}
                                               </span>
                                               <span className="text-[9px] text-muted-foreground">
                                                 Total:{" "}
-                                                {formatCents(
-                                                  b.totalAmount
-                                                ).toLocaleString(undefined, {
-                                                  minimumFractionDigits: 2,
-                                                  maximumFractionDigits: 2,
-                                                })}
+                                                {formatAmount(
+                                                  b.totalAmount,
+                                                  minorUnits(b.currency)
+                                                )}
                                               </span>
                                             </div>
                                           </button>
@@ -1538,12 +1557,9 @@ export function InboxItemReviewPanel({
                           </span>
                           <span className="shrink-0 text-[11px]">
                             ({suggestedBill.currency}{" "}
-                            {formatCents(suggestedBill.amount).toLocaleString(
-                              undefined,
-                              {
-                                minimumFractionDigits: 2,
-                                maximumFractionDigits: 2,
-                              }
+                            {formatAmount(
+                              suggestedBill.amount,
+                              minorUnits(suggestedBill.currency)
                             )}
                             )
                           </span>
@@ -1586,12 +1602,10 @@ export function InboxItemReviewPanel({
                           </span>
                           <span className="shrink-0 text-[11px]">
                             ({suggestedBorrowing.currency}{" "}
-                            {formatCents(
-                              suggestedBorrowing.remainingAmount
-                            ).toLocaleString(undefined, {
-                              minimumFractionDigits: 2,
-                              maximumFractionDigits: 2,
-                            })}
+                            {formatAmount(
+                              suggestedBorrowing.remainingAmount,
+                              minorUnits(suggestedBorrowing.currency)
+                            )}
                             )
                           </span>
                         </div>
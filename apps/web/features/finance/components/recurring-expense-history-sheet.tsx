@@ -13,7 +13,7 @@ import {
 import { useActiveSpaceContext } from "@/features/space/use-space"
 import { ScrollArea } from "@/components/ui/scroll-area"
 import { Loader2, Calendar, FileText, ArrowRight } from "lucide-react"
-import { formatCents } from "../utils"
+import { toAmountString, useMinorUnits } from "../utils"
 
 interface RecurringExpenseHistorySheetProps {
   open: boolean
@@ -32,6 +32,7 @@ export function RecurringExpenseHistorySheet({
     { enabled: open && !!spaceId }
   )
   const baseCurrency = settings?.baseCurrency || "USD"
+  const minorUnits = useMinorUnits()
 
   // Fetch transaction history for this template
   const { data, isLoading } = useListTransactionsQuery(
@@ -119,7 +120,10 @@ export function RecurringExpenseHistorySheet({
 
                       <div className="text-right">
                         <span className="block text-xs font-bold text-foreground">
-                          {formatCents(txn.amount).toFixed(2)}{" "}
+                          {toAmountString(
+                            txn.amount,
+                            minorUnits(txn.currency)
+                          )}{" "}
                           <span className="text-[9px] font-medium text-muted-foreground uppercase">
                             {txn.currency}
                           </span>
@@ -129,7 +133,10 @@ export function RecurringExpenseHistorySheet({
                           <span className="mt-0.5 flex items-center justify-end gap-1 text-[9px] font-medium text-muted-foreground">
                             <ArrowRight className="h-3 w-3" />
                             {baseCurrency}{" "}
-                            {formatCents(txn.amountInBase).toFixed(2)}
+                            {toAmountString(
+                              txn.amountInBase,
+                              minorUnits(baseCurrency)
+                            )}
                           </span>
                         )}
                       </div>
@@ -31,9 +31,11 @@ import {
 } from "@/components/ui/select"
 import { Inbox, Loader2 } from "lucide-react"
 import { toast } from "@/components/ui/toast"
+import { formatAmount, toCentsString, useMinorUnits } from "./utils"
 
 export function InboxView() {
   const { spaceId } = useActiveSpaceContext()
+  const minorUnits = useMinorUnits()
   const [urlState, setUrlState] = useUrlState({
     search: "",
     docType: "ALL",
@@ -225,11 +227,8 @@ export function InboxView() {
     }
 
     let finalAmount = tx.amount || "0"
-    if (amtStr) {
-      const cents = parseFloat(amtStr) * 100
-      if (!isNaN(cents)) {
-        finalAmount = Math.round(cents).toString()
-      }
+    if (amtStr && !isNaN(parseFloat(amtStr))) {
+      finalAmount = toCentsString(amtStr, minorUnits(currency))
     }
 
     try {
@@ -456,10 +455,7 @@ export function InboxView() {
                 ) : (
                   inboxItems.map((tx) => {
                     const isSelected = tx.id === selectedItemId
-                    const amt = (Number(tx.amount || 0) / 100).toLocaleString(
-                      undefined,
-                      { minimumFractionDigits: 2 }
-                    )
+                    const amt = formatAmount(tx.amount, minorUnits(tx.currency))
                     const meta = {
                       duplicate_warning:
                         tx.metadata?.duplicate_warning === "true",
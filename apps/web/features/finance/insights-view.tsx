@@ -4,9 +4,16 @@ import {
   useGetInsightsQuery,
   type InsightGranularity,
   useGetFinanceSettingsQuery,
+  useListBudgetsQuery,
 } from "@/gen/saturn/finance/v1/finance"
 import { FinancePageLayout } from "./components/finance-page-layout"
-import { formatCents, getBudgetColors, getBudgetIcon } from "./utils"
+import {
+  formatAmount,
+  formatCents,
+  getBudgetColors,
+  getBudgetIcon,
+  useMinorUnits,
+} from "./utils"
 import { cn } from "@/lib/utils"
 import {
   TrendingDownIcon,
@@ -47,8 +54,16 @@ export function InsightsView() {
     }
   )
 
+  const { data: budgetsData } = useListBudgetsQuery(
+    { pageSize: 100, pageToken: "" },
+    { enabled: !!settings }
+  )
+  const budgets = budgetsData?.budgets || []
+
   const spentInsights = insightsData?.spent
   const baseCurrency = settings?.baseCurrency || "USD"
+  const minorUnits = useMinorUnits()
+  const baseUnits = minorUnits(baseCurrency)
 
   // Active hover states for custom stacked bar chart tooltips
   const [activeTooltip, setActiveTooltip] = useState<{
@@ -151,10 +166,7 @@ export function InsightsView() {
               <div className="mt-2.5 flex items-baseline gap-1">
                 <span className="text-xl font-bold tracking-tight">
                   {baseCurrency}{" "}
-                  {formatCents(spentInsights.totalSpent).toLocaleString(
-                    undefined,
-                    { minimumFractionDigits: 2, maximumFractionDigits: 2 }
-                  )}
+                  {formatAmount(spentInsights.totalSpent, baseUnits)}
                 </span>
               </div>
             </div>
@@ -170,10 +182,7 @@ export function InsightsView() {
               <div className="mt-2.5 flex items-baseline gap-1">
                 <span className="text-xl font-bold tracking-tight">
                   {baseCurrency}{" "}
-                  {formatCents(spentInsights.totalLimit).toLocaleString(
-                    undefined,
-                    { minimumFractionDigits: 2, maximumFractionDigits: 2 }
-                  )}
+                  {formatAmount(spentInsights.totalLimit, baseUnits)}
                 </span>
               </div>
             </div>
@@ -189,10 +198,7 @@ export function InsightsView() {
               <div className="mt-2.5 flex items-baseline gap-1">
                 <span className="text-xl font-bold tracking-tight">
                   {baseCurrency}{" "}
-                  {formatCents(spentInsights.remainingBudget).toLocaleString(
-                    undefined,
-                    { minimumFractionDigits: 2, maximumFractionDigits: 2 }
-                  )}
+                  {formatAmount(spentInsights.remainingBudget, baseUnits)}
                 </span>
               </div>
             </div>
@@ -208,12 +214,7 @@ export function InsightsView() {
               <div className="mt-2.5 flex items-baseline gap-1">
                 <span className="text-xl font-bold tracking-tight">
                   {baseCurrency}{" "}
-                  {formatCents(
-                    Math.round(spentInsights.burnRate)
-                  ).toLocaleString(undefined, {
-                    minimumFractionDigits: 2,
-                    maximumFractionDigits: 2,
-                  })}
+                  {formatAmount(Math.round(spentInsights.burnRate), baseUnits)}
                 </span>
               </div>
             </div>
@@ -261,12 +262,16 @@ export function InsightsView() {
                 <div className="pointer-events-none absolute top-0 right-0 bottom-0 left-0 flex flex-col justify-between font-mono text-[8px] text-muted-foreground/30">
                   <div className="w-full border-t border-dashed border-muted/10 pt-0.5">
                     {baseCurrency}{" "}
-                    {formatCents(Math.round(maxTrendAmount)).toLocaleString()}
+                    {formatCents(
+                      Math.round(maxTrendAmount),
+                      baseUnits
+                    ).toLocaleString()}
                   </div>
                   <div className="w-full border-t border-dashed border-muted/10 pt-0.5">
                     {baseCurrency}{" "}
                     {formatCents(
-                      Math.round(maxTrendAmount / 2)
+                      Math.round(maxTrendAmount / 2),
+                      baseUnits
                     ).toLocaleString()}
                   </div>
                   <div className="w-full"></div>
@@ -346,10 +351,7 @@ export function InsightsView() {
                     <CalendarIcon className="h-2.5 w-2.5 text-primary" />
                     <span className="text-[8px] font-bold tracking-wide text-muted-foreground uppercase">
                       {activeTooltip.label} • {baseCurrency}{" "}
-                      {formatCents(activeTooltip.total).toLocaleString(
-                        undefined,
-                        { minimumFractionDigits: 2, maximumFractionDigits: 2 }
-                      )}
+                      {formatAmount(activeTooltip.total, baseUnits)}
                     </span>
                   </div>
                   <div className="space-y-1">
@@ -369,12 +371,10 @@ export function InsightsView() {
                         <span>Spent:</span>
                         <span className="font-bold text-foreground">
                           {activeTooltip.contrib.localCurrency}{" "}
-                          {formatCents(
-                            activeTooltip.contrib.amountInLocal
-                          ).toLocaleString(undefined, {
-                            minimumFractionDigits: 2,
-                            maximumFractionDigits: 2,
-                          })}
+                          {formatAmount(
+                            activeTooltip.contrib.amountInLocal,
+                            minorUnits(activeTooltip.contrib.localCurrency)
+                          )}
                         </span>
                       </div>
                       {activeTooltip.contrib.localCurrency !== baseCurrency && (
@@ -382,12 +382,10 @@ export function InsightsView() {
                           <span>Converted:</span>
                           <span className="font-semibold text-foreground">
                             {baseCurrency}{" "}
-                            {formatCents(
-                              activeTooltip.contrib.amountInBase
-                            ).toLocaleString(undefined, {
-                              minimumFractionDigits: 2,
-                              maximumFractionDigits: 2,
-                            })}
+                            {formatAmount(
+                              activeTooltip.contrib.amountInBase,
+                              baseUnits
+                            )}
                           </span>
                         </div>
                       )}
@@ -424,6 +422,9 @@ export function InsightsView() {
                   {spentInsights.distributions.map((dist) => {
                     const Icon = getBudgetIcon(dist.budgetIcon)
                     const colors = getBudgetColors(dist.budgetColor)
+                    const budgetUnits = minorUnits(
+                      budgets.find((b) => b.id === dist.budgetId)?.currency
+                    )
 
                     const isOver = dist.usagePercentage >= 100
                     const isNear =
@@ -466,7 +467,10 @@ export function InsightsView() {
                               <div className="text-[9px] text-muted-foreground">
                                 Limit:{" "}
                                 {Number(dist.limit) > 0
-                                  ? `${formatCents(dist.limit).toLocaleString()}`
+                                  ? formatCents(
+                                      dist.limit,
+                                      budgetUnits
+                                    ).toLocaleString()
                                   : "No limit"}
                               </div>
                             </div>
@@ -483,11 +487,17 @@ export function InsightsView() {
                                     : "text-foreground"
                               )}
                             >
-                              {formatCents(dist.spent).toLocaleString()}
+                              {formatCents(
+                                dist.spent,
+                                budgetUnits
+                              ).toLocaleString()}
                             </span>
                             <span className="block text-[9px] text-muted-foreground">
                               {baseCurrency}{" "}
-                              {formatCents(dist.spentInBase).toLocaleString()}
+                              {formatCents(
+                                dist.spentInBase,
+                                baseUnits
+                              ).toLocaleString()}
                             </span>
                           </div>
                         </div>
@@ -564,21 +574,12 @@ export function InsightsView() {
                       <div className="text-right">
                         <span className="text-xs font-bold text-rose-500">
                           -{exp.currency}{" "}
-                          {formatCents(exp.amount).toLocaleString(undefined, {
-                            minimumFractionDigits: 2,
-                            maximumFractionDigits: 2,
-                          })}
+                          {formatAmount(exp.amount, minorUnits(exp.currency))}
                         </span>
                         {exp.currency !== baseCurrency && (
                           <span className="block text-[9px] text-muted-foreground">
                             {baseCurrency}{" "}
-                            {formatCents(exp.amountInBase).toLocaleString(
-                              undefined,
-                              {
-                                minimumFractionDigits: 2,
-                                maximumFractionDigits: 2,
-                              }
-                            )}
+                            {formatAmount(exp.amountInBase, baseUnits)}
                           </span>
                         )}
                       </div>
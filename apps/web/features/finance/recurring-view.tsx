@@ -44,7 +44,10 @@ import { ConfirmPaymentSheet } from "./components/confirm-payment-sheet"
 import { RecurringExpenseHistorySheet } from "./components/recurring-expense-history-sheet"
 import { SkipPaymentDialog } from "./components/skip-payment-dialog"
 import {
+  formatAmount,
   formatCents,
+  toAmountString,
+  useMinorUnits,
   getBudgetColors,
   getBudgetIcon,
   formatInterval,
@@ -85,6 +88,7 @@ export function RecurringView() {
     { enabled: !!spaceId }
   )
   const baseCurrency = settings?.baseCurrency || "USD"
+  const minorUnits = useMinorUnits()
 
   const { data: budgetsData } = useListBudgetsQuery(
     { pageSize: 100, pageToken: "" },
@@ -230,7 +234,7 @@ export function RecurringView() {
   const monthlyOverhead = expenses.reduce((acc, exp) => {
     if (!isStatusActive(exp.status)) return acc
 
-    const amountVal = formatCents(exp.amount)
+    const amountVal = formatCents(exp.amount, minorUnits(exp.currency))
     const convertedAmount = convertToBase(amountVal, exp.currency)
     let normalizedAmount = convertedAmount
 
@@ -254,7 +258,7 @@ export function RecurringView() {
   const upcomingOutflows = payments.reduce((acc, pay) => {
     const dueDate = new Date(pay.dueDate)
     if (dueDate <= next7Days) {
-      const amountVal = formatCents(pay.amount)
+      const amountVal = formatCents(pay.amount, minorUnits(pay.currency))
       const convertedAmount = convertToBase(amountVal, pay.currency)
       return acc + convertedAmount
     }
@@ -433,12 +437,9 @@ export function RecurringView() {
                                   </h4>
                                   <div className="mt-1 flex flex-wrap items-center gap-x-2 gap-y-1 text-xs text-muted-foreground">
                                     <span className="font-semibold text-foreground">
-                                      {formatCents(exp.amount).toLocaleString(
-                                        undefined,
-                                        {
-                                          minimumFractionDigits: 2,
-                                          maximumFractionDigits: 2,
-                                        }
+                                      {formatAmount(
+                                        exp.amount,
+                                        minorUnits(exp.currency)
                                       )}{" "}
                                       <span className="text-[10px] font-medium text-muted-foreground uppercase">
                                         {exp.currency}
@@ -662,12 +663,9 @@ export function RecurringView() {
                               <div className="flex shrink-0 items-center gap-2">
                                 <div className="text-right">
                                   <span className="block text-xs font-bold text-foreground">
-                                    {formatCents(pay.amount).toLocaleString(
-                                      undefined,
-                                      {
-                                        minimumFractionDigits: 2,
-                                        maximumFractionDigits: 2,
-                                      }
+                                    {formatAmount(
+                                      pay.amount,
+                                      minorUnits(pay.currency)
                                     )}
                                   </span>
                                   <span className="block text-[8px] font-semibold text-muted-foreground uppercase">
@@ -833,12 +831,9 @@ export function RecurringView() {
                             <div className="flex items-center gap-4">
                               <div className="text-right">
                                 <span className="block text-xs font-bold text-foreground">
-                                  {formatCents(txn.amount).toLocaleString(
-                                    undefined,
-                                    {
-                                      minimumFractionDigits: 2,
-                                      maximumFractionDigits: 2,
-                                    }
+                                  {formatAmount(
+                                    txn.amount,
+                                    minorUnits(txn.currency)
                                   )}{" "}
                                   <span className="text-[10px] font-medium text-muted-foreground uppercase">
                                     {txn.currency}
@@ -848,12 +843,10 @@ export function RecurringView() {
                                   <span className="mt-0.5 flex items-center justify-end gap-1 text-[9px] font-medium text-muted-foreground">
                                     <ArrowRight className="h-3 w-3" />
                                     {baseCurrency}{" "}
-                                    {formatCents(
-                                      txn.amountInBase
-                                    ).toLocaleString(undefined, {
-                                      minimumFractionDigits: 2,
-                                      maximumFractionDigits: 2,
-                                    })}
+                                    {formatAmount(
+                                      txn.amountInBase,
+                                      minorUnits(baseCurrency)
+                                    )}
                                   </span>
                                 )}
                               </div>
@@ -915,7 +908,10 @@ export function RecurringView() {
         }
         amountFormatted={
           paymentToSkip
-            ? formatCents(paymentToSkip.amount).toFixed(2)
+            ? toAmountString(
+                paymentToSkip.amount,
+                minorUnits(paymentToSkip.currency)
+              )
             : undefined
         }
         currency={paymentToSkip?.currency}
@@ -49,7 +49,12 @@ import {
   DropdownMenuItem,
 } from "@/components/ui/dropdown-menu"
 import { FinancePageLayout } from "./components/finance-page-layout"
-import { formatCents, getBudgetColors, getBudgetIcon } from "./utils"
+import {
+  formatCents,
+  getBudgetColors,
+  getBudgetIcon,
+  useMinorUnits,
+} from "./utils"
 import { CreateTransactionSheet } from "./components/create-transaction-sheet"
 import { TransactionEventsSheet } from "./components/transaction-events-sheet"
 const TRANSACTIONS_FILTER_DEFAULTS = {
@@ -68,6 +73,8 @@ export function TransactionsView() {
     { enabled: !!spaceId }
   )
   const baseCurrency = settings?.baseCurrency || "USD"
+  const minorUnits = useMinorUnits()
+  const baseUnits = minorUnits(baseCurrency)
 
   const { data: budgetsData, refetch: refetchBudgets } = useListBudgetsQuery(
     { pageSize: 100, pageToken: "" },
@@ -205,7 +212,7 @@ export function TransactionsView() {
   // Calculate stats from queried stream
   const transactions = txnData?.transactions || []
   const totalSpent = transactions.reduce(
-    (acc, t) => acc + formatCents(t.amountInBase),
+    (acc, t) => acc + formatCents(t.amountInBase, baseUnits),
     0
   )
   const txCount = transactions.length
@@ -242,8 +249,8 @@ export function TransactionsView() {
               </p>
               <h4 className="mt-0.5 text-2xl font-bold text-foreground">
                 {totalSpent.toLocaleString(undefined, {
-                  minimumFractionDigits: 2,
-                  maximumFractionDigits: 2,
+                  minimumFractionDigits: baseUnits,
+                  maximumFractionDigits: baseUnits,
                 })}{" "}
                 <span className="text-xs font-bold text-muted-foreground uppercase">
                   {settings?.baseCurrency}
@@ -262,8 +269,8 @@ export function TransactionsView() {
               </p>
               <h4 className="mt-0.5 text-2xl font-bold text-foreground">
                 {avgSpent.toLocaleString(undefined, {
-                  minimumFractionDigits: 2,
-                  maximumFractionDigits: 2,
+                  minimumFractionDigits: baseUnits,
+                  maximumFractionDigits: baseUnits,
                 })}{" "}
                 <span className="text-xs font-bold text-muted-foreground uppercase">
                   {settings?.baseCurrency}
@@ -539,8 +546,9 @@ export function TransactionsView() {
             ) : (
               <div className="space-y-3.5 select-none">
                 {transactions.map((t) => {
-                  const amtLocal = formatCents(t.amount)
-                  const amtBase = formatCents(t.amountInBase)
+                  const localUnits = minorUnits(t.currency)
+                  const amtLocal = formatCents(t.amount, localUnits)
+                  const amtBase = formatCents(t.amountInBase, baseUnits)
                   const isCrossCurrency = t.currency !== settings?.baseCurrency
                   const details = getBudgetDetails(t.budgetId)
                   const colors = getBudgetColors(details.color)
@@ -690,8 +698,8 @@ export function TransactionsView() {
                                 <span className="block truncate text-sm font-extrabold tracking-tight text-foreground sm:text-base">
                                   {displaySign}
                                   {absAmtLocal.toLocaleString(undefined, {
-                                    minimumFractionDigits: 2,
-                                    maximumFractionDigits: 2,
+                                    minimumFractionDigits: localUnits,
+                                    maximumFractionDigits: localUnits,
                                   })}
                                   <span className="ml-1 text-[9px] font-bold text-muted-foreground uppercase sm:text-[10px]">
                                     {t.currency}
@@ -701,8 +709,8 @@ export function TransactionsView() {
                                   <span className="mt-0.5 flex items-center justify-end gap-0.5 truncate font-mono text-[9px] text-muted-foreground sm:text-[10px]">
                                     {displaySign}
                                     {absAmtBase.toLocaleString(undefined, {
-                                      minimumFractionDigits: 2,
-                                      maximumFractionDigits: 2,
+                                      minimumFractionDigits: baseUnits,
+                                      maximumFractionDigits: baseUnits,
                                     })}{" "}
                                     {settings?.baseCurrency}
                                   </span>
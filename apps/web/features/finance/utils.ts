@@ -1,3 +1,4 @@
+import { useCallback } from "react"
 import {
   PiggyBank,
   Utensils,
@@ -11,6 +12,8 @@ import {
   Briefcase,
   Sparkles,
 } from "lucide-react"
+import { useListCurrenciesQuery } from "@/gen/saturn/finance/v1/finance"
+import { useActiveSpaceContext } from "@/features/space/use-space"
 
 export const BUDGET_COLORS = [
   {
@@ -85,15 +88,63 @@ export function getBudgetIcon(iconName: string) {
   return BUDGET_ICONS.find((i) => i.value === iconName)?.icon || PiggyBank
 }
 
-export function formatCents(cents: string | number | undefined | null): number {
+// DEFAULT_MINOR_UNITS is the exponent assumed for currencies not in the catalog.
+export const DEFAULT_MINOR_UNITS = 2
+
+/**
+ * Returns a lookup of a currency's minor units (ISO 4217 exponent) as reported
+ * by ListCurrencies. Amounts in a currency are integers of 10^-minorUnits.
+ */
+export function useMinorUnits(): (
+  currency: string | undefined | null
+) => number {
+  const { spaceId } = useActiveSpaceContext()
+  const { data } = useListCurrenciesQuery(
+    {},
+    { enabled: !!spaceId, staleTime: 1000 * 60 * 30 }
+  )
+  return useCallback(
+    (currency: string | undefined | null) =>
+      data?.currencies.find((c) => c.code === currency)?.minorUnits ??
+      DEFAULT_MINOR_UNITS,
+    [data]
+  )
+}
+
+export function formatCents(
+  cents: string | number | undefined | null,
+  minorUnits: number = DEFAULT_MINOR_UNITS
+): number {
   if (cents === undefined || cents === null) return 0
   const val = typeof cents === "number" ? cents : parseFloat(cents)
-  return isNaN(val) ? 0 : val / 100
+  return isNaN(val) ? 0 : val / 10 ** minorUnits
 }
 
-export function toCentsString(amountStr: string | number): string {
+// formatAmount renders an amount in minor units with the currency's decimals.
+export function formatAmount(
+  cents: string | number | undefined | null,
+  minorUnits: number = DEFAULT_MINOR_UNITS
+): string {
+  return formatCents(cents, minorUnits).toLocaleString(undefined, {
+    minimumFractionDigits: minorUnits,
+    maximumFractionDigits: minorUnits,
+  })
+}
+
+// toAmountString renders an amount in minor units as a major unit string.
+export function toAmountString(
+  cents: string | number | undefined | null,
+  minorUnits: number = DEFAULT_MINOR_UNITS
+): string {
+  return formatCents(cents, minorUnits).toFixed(minorUnits)
+}
+
+export function toCentsString(
+  amountStr: string | number,
+  minorUnits: number = DEFAULT_MINOR_UNITS
+): string {
   const val = typeof amountStr === "number" ? amountStr : parseFloat(amountStr)
-  return isNaN(val) ? "0" : Math.round(val * 100).toString()
+  return isNaN(val) ? "0" : Math.round(val * 10 ** minorUnits).toString()
 }
 
 export function formatInterval(interval: string | undefined | null): string {
@@ -60,6 +60,27 @@ export type BorrowingLinkType =
   | "BORROWING_LINK_TYPE_REPAYMENT"
   | "BORROWING_LINK_TYPE_ADDITIONAL_LOAN"
 
+/**
+ * Supported bank statement file formats.
+ */
+export type StatementFormat =
+  | "STATEMENT_FORMAT_UNSPECIFIED"
+  | "STATEMENT_FORMAT_CSV"
+  | "STATEMENT_FORMAT_OFX"
+  | "STATEMENT_FORMAT_QFX"
+  | "STATEMENT_FORMAT_CAMT_053"
+
+/**
+ * Supported ledger export file formats.
+ */
+export type LedgerFormat =
+  | "LEDGER_FORMAT_UNSPECIFIED"
+  | "LEDGER_FORMAT_CSV"
+  | "LEDGER_FORMAT_JSONL"
+  | "LEDGER_FORMAT_OFX"
+  | "LEDGER_FORMAT_BEANCOUNT"
+  | "LEDGER_FORMAT_HLEDGER"
+
 /**
  * RecurrenceInterval defines the frequency of budgeting or transaction execution rules.
  */
@@ -80,6 +101,39 @@ export type Budget_RecurrenceInterval =
    * Budget resets or templates trigger every calendar year.
    */
   | "YEARLY"
+  /**
+   * Budget resets every 14 days counted from the period anchor.
+   */
+  | "BIWEEKLY"
+  /**
+   * Budget resets every calendar quarter.
+   */
+  | "QUARTERLY"
+
+/**
+ * RolloverPolicy controls how a period's remaining balance flows into the next period.
+ */
+export type Budget_RolloverPolicy =
+  /**
+   * Default unspecified value. Treated as NONE.
+   */
+  | "ROLLOVER_POLICY_UNSPECIFIED"
+  /**
+   * Each period starts fresh from the budget limit.
+   */
+  | "NONE"
+  /**
+   * Unspent amounts are added to the next period's limit.
+   */
+  | "CARRY_SURPLUS"
+  /**
+   * Overspent amounts are deducted from the next period's limit.
+   */
+  | "CARRY_DEFICIT"
+  /**
+   * Both unspent and overspent amounts are carried into the next period.
+   */
+  | "CARRY_BOTH"
 
 /**
  * Scoped resource view options.
@@ -144,6 +198,60 @@ export type Transaction_View =
    */
   | "FULL"
 
+/**
+ * Cadence defines how often the income source is expected to pay out.
+ */
+export type IncomeSource_Cadence =
+  /**
+   * Default unspecified value. Invalid fallback.
+   */
+  | "CADENCE_UNSPECIFIED"
+  /**
+   * Paid every week.
+   */
+  | "WEEKLY"
+  /**
+   * Paid every two weeks.
+   */
+  | "BIWEEKLY"
+  /**
+   * Paid every calendar month.
+   */
+  | "MONTHLY"
+  /**
+   * Paid every calendar year.
+   */
+  | "YEARLY"
+  /**
+   * Paid on no fixed schedule (e.g. freelance work).
+   */
+  | "IRREGULAR"
+  /**
+   * Paid following a recurrence rule that has no simple cadence.
+   */
+  | "CUSTOM"
+
+/**
+ * Status defines the lifecycle state of a goal.
+ */
+export type Goal_Status =
+  /**
+   * Default unspecified value. Invalid fallback.
+   */
+  | "STATUS_UNSPECIFIED"
+  /**
+   * The goal is being saved towards.
+   */
+  | "ACTIVE"
+  /**
+   * The goal has been reached.
+   */
+  | "COMPLETED"
+  /**
+   * The goal is no longer tracked.
+   */
+  | "ARCHIVED"
+
 /**
  * Scoped resource representation view level.
  */
@@ -153,7 +261,18 @@ export type RecurringExpense_View = "VIEW_UNSPECIFIED" | "BASIC" | "FULL"
  * Execution interval recurrence rule.
  */
 export type RecurringExpense_Interval =
-  "INTERVAL_UNSPECIFIED" | "WEEKLY" | "MONTHLY" | "YEARLY"
+  | "INTERVAL_UNSPECIFIED"
+  | "WEEKLY"
+  | "MONTHLY"
+  | "YEARLY"
+  /**
+   * Derived from a recurrence rule repeating every two weeks.
+   */
+  | "BIWEEKLY"
+  /**
+   * Derived from a recurrence rule that has no simple interval.
+   */
+  | "CUSTOM"
 
 /**
  * Active template status.
@@ -170,7 +289,11 @@ export type ScheduledPayment_View = "VIEW_UNSPECIFIED" | "BASIC" | "FULL"
  * Parent template source type.
  */
 export type ScheduledPayment_SourceType =
-  "SOURCE_TYPE_UNSPECIFIED" | "RECURRENT_EXPENSE" | "LOAN" | "TAX"
+  | "SOURCE_TYPE_UNSPECIFIED"
+  | "RECURRENT_EXPENSE"
+  | "LOAN"
+  | "TAX"
+  | "CARD_STATEMENT"
 
 /**
  * Instance execution status.
@@ -212,6 +335,81 @@ export type Borrowing_Status =
    */
   | "PAID_OFF"
 
+/**
+ * InterestType defines how interest is charged on an instalment plan.
+ */
+export type Borrowing_InterestType =
+  /**
+   * Default unspecified value. Treated as no interest.
+   */
+  | "INTEREST_TYPE_UNSPECIFIED"
+  /**
+   * No interest; instalments split the principal evenly.
+   */
+  | "NO_INTEREST"
+  /**
+   * Flat interest on the original principal for the whole term.
+   */
+  | "SIMPLE"
+  /**
+   * Interest on the outstanding balance each period, repaid with level payments.
+   */
+  | "COMPOUND"
+
+/**
+ * InstallmentFrequency defines the spacing between instalments.
+ */
+export type Borrowing_InstallmentFrequency =
+  /**
+   * Default unspecified value. Invalid when an instalment plan is set.
+   */
+  | "INSTALLMENT_FREQUENCY_UNSPECIFIED"
+  /**
+   * Every week.
+   */
+  | "WEEKLY"
+  /**
+   * Every two weeks.
+   */
+  | "BIWEEKLY"
+  /**
+   * Every month, on the day of month of the first instalment.
+   */
+  | "MONTHLY"
+  /**
+   * Every three months.
+   */
+  | "QUARTERLY"
+  /**
+   * Every year.
+   */
+  | "YEARLY"
+
+/**
+ * Status defines the repayment state of an instalment.
+ */
+export type BorrowingInstallment_Status =
+  /**
+   * Default unspecified value.
+   */
+  | "STATUS_UNSPECIFIED"
+  /**
+   * Fully repaid.
+   */
+  | "PAID"
+  /**
+   * Partially repaid and not yet due.
+   */
+  | "PARTIAL"
+  /**
+   * Past its due date and not fully repaid.
+   */
+  | "OVERDUE"
+  /**
+   * Not yet due.
+   */
+  | "UPCOMING"
+
 /**
  * Type defines the classification of payment accounts.
  */
@@ -254,6 +452,23 @@ export type Account_View =
    */
   | "FULL"
 
+/**
+ * Status defines the lifecycle of a reconciliation session.
+ */
+export type Reconciliation_Status =
+  /**
+   * Default unspecified status.
+   */
+  | "STATUS_UNSPECIFIED"
+  /**
+   * Transactions are still being cleared against the statement.
+   */
+  | "IN_PROGRESS"
+  /**
+   * The session is closed and its transactions are locked.
+   */
+  | "FINALIZED"
+
 /**
  * Staging lifecycle status enum.
  */
@@ -276,6 +491,125 @@ export type InboxItem_DocType =
  */
 export type InboxItem_View = "VIEW_UNSPECIFIED" | "BASIC" | "FULL"
 
+/**
+ * Processing state of an export.
+ */
+export type LedgerExport_Status =
+  "STATUS_UNSPECIFIED" | "PENDING" | "RUNNING" | "COMPLETED" | "FAILED"
+
+/**
+ * Status tracks how much of the statement balance has been paid.
+ */
+export type CardStatement_Status =
+  /**
+   * Default unspecified status.
+   */
+  | "STATUS_UNSPECIFIED"
+  /**
+   * Statement issued with nothing paid yet.
+   */
+  | "ISSUED"
+  /**
+   * Part of the statement balance has been paid.
+   */
+  | "PARTIALLY_PAID"
+  /**
+   * The statement balance is fully paid.
+   */
+  | "PAID"
+
+/**
+ * Kind identifies what produced the movement.
+ */
+export type ForecastEvent_Kind =
+  /**
+   * Default unspecified kind.
+   */
+  | "KIND_UNSPECIFIED"
+  /**
+   * A pending scheduled payment.
+   */
+  | "SCHEDULED_PAYMENT"
+  /**
+   * A recurring expense occurrence not yet scheduled.
+   */
+  | "RECURRING_EXPENSE"
+  /**
+   * A borrowing instalment.
+   */
+  | "LOAN_INSTALLMENT"
+  /**
+   * A credit card statement payment.
+   */
+  | "CARD_PAYMENT"
+  /**
+   * Expected income.
+   */
+  | "INCOME"
+
+/**
+ * Kind classifies the low balance state.
+ */
+export type ForecastAlert_Kind =
+  /**
+   * Default unspecified kind.
+   */
+  | "KIND_UNSPECIFIED"
+  /**
+   * The balance, or available credit, drops below zero.
+   */
+  | "BELOW_ZERO"
+  /**
+   * The balance, or available credit, drops below the account threshold.
+   */
+  | "BELOW_THRESHOLD"
+
+/**
+ * Rule identifies the rule that raised the alert.
+ */
+export type Alert_Rule =
+  /**
+   * Default unspecified rule.
+   */
+  | "RULE_UNSPECIFIED"
+  /**
+   * Budget spending reached one of the configured percentages of its limit.
+   */
+  | "BUDGET_THRESHOLD"
+  /**
+   * The current spending pace exceeds the budget limit before the period ends.
+   */
+  | "PROJECTED_OVERSPEND"
+  /**
+   * An expense is far above the budget's usual amounts.
+   */
+  | "AMOUNT_ANOMALY"
+  /**
+   * An expense looks like a repeat of a recent charge.
+   */
+  | "DUPLICATE_CHARGE"
+
+/**
+ * Status tracks whether the alert still needs attention.
+ */
+export type Alert_Status =
+  /**
+   * Default unspecified status.
+   */
+  | "STATUS_UNSPECIFIED"
+  /**
+   * The alert needs attention.
+   */
+  | "OPEN"
+  /**
+   * The alert was handled.
+   */
+  | "ACKNOWLEDGED"
+  /**
+   * The alert is hidden until `snooze_until`.
+   */
+  | "SNOOZED"
+
 /**
  * FinanceSettings represents the workspace configuration.
  */
@@ -297,6 +631,31 @@ export interface FinanceSettings {
    * Output only. Last update time of the settings.
    */
   updateTime?: string
+  /**
+   * Optional. ISO 4217 codes allowed for accounts, budgets, transactions and borrowings.
+   * An empty list enables every currency in the catalog. The base currency is always enabled.
+   */
+  enabledCurrencies: string[]
+  /**
+   * Optional. IANA timezone (e.g. "America/New_York") used to compute budget period bounds.
+   * Defaults to "UTC".
+   */
+  timezone: string
+  /**
+   * Optional. Budget usage percentages (1-1000) that raise an alert when reached.
+   * Defaults to 80 and 100 when empty.
+   */
+  budgetAlertPercents: number[]
+  /**
+   * Optional. Standard deviations above a budget's usual expense amount that flag an expense as unusual.
+   * Defaults to 3 when unset.
+   */
+  anomalyStdDevs: number
+  /**
+   * Optional. Hours within which two identical charges are flagged as a possible duplicate.
+   * Defaults to 48 when unset.
+   */
+  duplicateWindowHours: number
 }
 
 /**
@@ -361,6 +720,26 @@ export interface Budget {
    * Output only. Monotonic version counter for optimistic concurrency control.
    */
   version?: string
+  /**
+   * Optional. How remaining balances roll over between periods. Defaults to NONE.
+   */
+  rolloverPolicy: Budget_RolloverPolicy
+  /**
+   * Optional. Maximum absolute amount in cents carried between periods. Zero means uncapped.
+   */
+  rolloverCap: string
+  /**
+   * Optional. Day of month (1-28) on which monthly, quarterly and yearly periods begin. Defaults to 1.
+   */
+  periodStartDay: number
+  /**
+   * Optional. ISO weekday (1 = Monday ... 7 = Sunday) on which weekly periods begin. Defaults to Monday.
+   */
+  weekStart: number
+  /**
+   * Optional. First day of any bi-weekly period (e.g. a payday). Required for BIWEEKLY budgets.
+   */
+  periodAnchor: string
 }
 
 /**
@@ -392,9 +771,21 @@ export interface Budget_ActivePeriod {
    */
   baseCurrency?: string
   /**
-   * Output only. Limit in base currency cents.
+   * Output only. Effective limit (including carried-in balance) in base currency cents.
    */
   limitInBase?: string
+  /**
+   * Output only. Signed balance carried in from the previous period in local currency cents.
+   */
+  carriedIn?: string
+  /**
+   * Output only. Signed balance carried out into the next period in local currency cents.
+   */
+  carriedOut?: string
+  /**
+   * Output only. Spendable limit for the period (limit plus carried-in balance) in local currency cents.
+   */
+  effectiveLimit?: string
 }
 
 /**
@@ -455,6 +846,18 @@ export interface BudgetPeriod {
    * Output only. Spent amount converted to base currency cents.
    */
   spentInBase?: string
+  /**
+   * Output only. Signed balance carried in from the previous period in local currency cents.
+   */
+  carriedIn?: string
+  /**
+   * Output only. Signed balance carried out into the next period in local currency cents.
+   */
+  carriedOut?: string
+  /**
+   * Output only. Spendable limit for the period (limit plus carried-in balance) in local currency cents.
+   */
+  effectiveLimit?: string
 }
 
 /**
@@ -467,6 +870,10 @@ export interface ConfigureFinanceRequest {
    * Conversions and aggregated spent statistics will default to this currency.
    */
   baseCurrency: string
+  /**
+   * Optional. IANA timezone used to compute budget period bounds. Defaults to "UTC".
+   */
+  timezone: string
 }
 
 /**
@@ -475,6 +882,22 @@ export interface ConfigureFinanceRequest {
  */
 export type GetFinanceSettingsRequest = Record<string, never>
 
+/**
+ * The request for
+ * [UpdateFinanceSettings][saturn.finance.v1.Finance.UpdateFinanceSettings].
+ */
+export interface UpdateFinanceSettingsRequest {
+  /**
+   * Required. Updated settings values.
+   */
+  settings: FinanceSettings
+  /**
+   * Optional. Field mask defining which fields to update. Only `enabled_currencies`, `timezone`,
+   * `budget_alert_percents`, `anomaly_std_devs` and `duplicate_window_hours` are mutable.
+   */
+  updateMask?: { paths?: string[] }
+}
+
 /**
  * The request for
  * [GetBudget][saturn.finance.v1.Finance.GetBudget].
@@ -816,6 +1239,34 @@ export interface Transaction {
    * Optional. Context metadata key-value pairs.
    */
   metadata: Record<string, string>
+  /**
+   * Optional. Classification category ID.
+   * Values are of the form `cat_[a-zA-Z0-9]+`.
+   */
+  categoryId?: string
+  /**
+   * Optional. Free-form lowercase tags.
+   */
+  tags: string[]
+  /**
+   * Optional. Split lines allocating the amount across budgets and categories.
+   * When present, the split amounts add up to `amount` and `budget_id` is unset.
+   */
+  splits: TransactionSplit[]
+  /**
+   * Output only. Indicates if the transaction was confirmed against a bank statement.
+   */
+  cleared?: boolean
+  /**
+   * Output only. Finalized reconciliation locking the transaction from edits.
+   * Values are of the form `rcn_[a-zA-Z0-9]+`.
+   */
+  reconciliationId?: string
+  /**
+   * Optional. Payee the description was normalized to.
+   * Values are of the form `pye_[a-zA-Z0-9]+`.
+   */
+  payeeId?: string
 }
 
 /**
@@ -855,30 +1306,71 @@ export interface Transaction_BudgetInfo {
 }
 
 /**
- * ExpenseInput encapsulates fields representing an expense record payload.
+ * TransactionSplit allocates a portion of an expense to its own budget and category.
  */
-export interface ExpenseInput {
+export interface TransactionSplit {
   /**
-   * Required. Budget category identifier.
-   * Values are of the form `bud_[a-zA-Z0-9]+`.
+   * Output only. Unique identifier.
+   * Values are of the form `tsp_[a-zA-Z0-9]+`.
    */
-  budgetId: string
+  id?: string
   /**
-   * Required. Absolute value of transaction in local currency cents (e.g. 10000 for $100.00).
+   * Optional. Budget the split line is charged to. Required for expenses
+   * that are not linked to a borrowing.
+   * Values are of the form `bgt_[a-zA-Z0-9]+`.
    */
-  amount: string
+  budgetId?: string
   /**
-   * Required. Currency code.
+   * Output only. Budget period the split line is attributed to.
+   * Values are of the form `bgp_[a-zA-Z0-9]+`.
    */
-  currency: string
+  periodId?: string
   /**
-   * Optional. Narration notes.
+   * Optional. Classification category identifier. Falls back to the
+   * transaction category when omitted.
+   * Values are of the form `cat_[a-zA-Z0-9]+`.
    */
-  description: string
+  categoryId?: string
   /**
-   * Optional. Time transaction occurred.
+   * Required. Split amount in transaction currency cents.
    */
-  transactionDate: string
+  amount: string
+  /**
+   * Output only. Split amount converted to the space base currency.
+   */
+  amountInBase?: string
+  /**
+   * Optional. Narration note for the split line.
+   */
+  note: string
+}
+
+/**
+ * ExpenseInput encapsulates fields representing an expense record payload.
+ */
+export interface ExpenseInput {
+  /**
+   * Required unless `splits` is provided or a transaction rule or payee assigns one.
+   * Budget category identifier.
+   * Values are of the form `bud_[a-zA-Z0-9]+`.
+   */
+  budgetId: string
+  /**
+   * Required. Absolute value of transaction in local currency cents (e.g. 10000 for $100.00).
+   */
+  amount: string
+  /**
+   * Required. Currency code.
+   */
+  currency: string
+  /**
+   * Optional. Narration notes.
+   */
+  description: string
+  /**
+   * Optional. Time transaction occurred.
+   */
+  transactionDate: string
   /**
    * Optional. Execution/posting date.
    */
@@ -888,6 +1380,26 @@ export interface ExpenseInput {
    * Values are of the form `acc_[a-zA-Z0-9]+`.
    */
   accountId?: string
+  /**
+   * Optional. Classification category identifier.
+   * Values are of the form `cat_[a-zA-Z0-9]+`.
+   */
+  categoryId?: string
+  /**
+   * Optional. Free-form tags. Normalized to lowercase and de-duplicated.
+   */
+  tags: string[]
+  /**
+   * Optional. Split lines dividing the expense across budgets and categories.
+   * Requires at least two lines whose amounts add up to `amount`; `budget_id`
+   * must be empty when splits are provided.
+   */
+  splits: TransactionSplit[]
+  /**
+   * Optional. Payee identifier. Assigned by the space's rules and payees when omitted.
+   * Values are of the form `pye_[a-zA-Z0-9]+`.
+   */
+  payeeId?: string
 }
 
 /**
@@ -917,405 +1429,496 @@ export interface UpdateExpenseRequest {
   expense: ExpenseInput
 }
 
+/**
+ * IncomeInput encapsulates fields representing an income record payload.
+ */
+export interface IncomeInput {
+  /**
+   * Required. Absolute value of transaction in local currency cents (e.g. 250000 for $2,500.00).
+   */
+  amount: string
+  /**
+   * Required. Currency code.
+   */
+  currency: string
+  /**
+   * Optional. Narration notes.
+   */
+  description: string
+  /**
+   * Optional. Time transaction occurred.
+   */
+  transactionDate: string
+  /**
+   * Optional. Execution/posting date.
+   */
+  effectiveDate: string
+  /**
+   * Optional. Receiving account identifier. Defaults to the income source deposit account when omitted.
+   * Values are of the form `acc_[a-zA-Z0-9]+`.
+   */
+  accountId?: string
+  /**
+   * Optional. Income source that originated the inflow.
+   * Values are of the form `ics_[a-zA-Z0-9]+`.
+   */
+  incomeSourceId?: string
+  /**
+   * Optional. Classification category identifier.
+   * Values are of the form `cat_[a-zA-Z0-9]+`.
+   */
+  categoryId?: string
+  /**
+   * Optional. Free-form tags. Normalized to lowercase and de-duplicated.
+   */
+  tags: string[]
+  /**
+   * Optional. Payee identifier. Assigned by the space's rules and payees when omitted.
+   * Values are of the form `pye_[a-zA-Z0-9]+`.
+   */
+  payeeId?: string
+}
+
 /**
  * The request for
- * [DeleteTransaction][saturn.finance.v1.Finance.DeleteTransaction].
+ * [CreateIncome][saturn.finance.v1.Finance.CreateIncome].
  */
-export interface DeleteTransactionRequest {
+export interface CreateIncomeRequest {
   /**
-   * Required. Unique identifier of the transaction to delete.
-   * Values are of the form `txn_[a-zA-Z0-9]+`.
+   * Required. Target income transaction parameters.
    */
-  id: string
+  income: IncomeInput
 }
 
 /**
  * The request for
- * [GetTransaction][saturn.finance.v1.Finance.GetTransaction].
+ * [UpdateIncome][saturn.finance.v1.Finance.UpdateIncome].
  */
-export interface GetTransactionRequest {
+export interface UpdateIncomeRequest {
   /**
-   * Required. Unique identifier of the transaction record to retrieve.
+   * Required. Unique identifier of the transaction to update.
    * Values are of the form `txn_[a-zA-Z0-9]+`.
    */
   id: string
   /**
-   * Optional. Scoped budget representation view type.
+   * Required. Updated income transaction parameters.
    */
-  view?: Transaction_View
+  income: IncomeInput
 }
 
 /**
- * The request for
- * [ListTransactions][saturn.finance.v1.Finance.ListTransactions].
+ * IncomeSource represents a recurring origin of inflows, such as an employer.
  */
-export interface ListTransactionsRequest {
+export interface IncomeSource {
   /**
-   * Optional. Scoped budget representation view type.
+   * Output only. Unique identifier.
+   * Values are of the form `ics_[a-zA-Z0-9]+`.
    */
-  view?: Transaction_View
+  id?: string
   /**
-   * Optional. Target parent budget ID filter.
-   * Values are of the form `bud_[a-zA-Z0-9]+`.
+   * Output only. Space identifier.
    */
-  budgetId: string
+  spaceId?: string
   /**
-   * Optional. Target transaction flow type filter.
+   * Required. User-friendly name (e.g. "Salary").
    */
-  type: Transaction_Type
+  name: string
   /**
-   * Optional. Target account identifier.
-   * Values are of the form `acc_[a-zA-Z0-9]+`.
+   * Optional. Employer or paying counterparty name.
    */
-  accountId?: string
+  employer: string
   /**
-   * Optional. Text search query matching transaction description notes.
+   * Optional. Expected amount per payout in cents.
    */
-  searchQuery?: string
+  expectedAmount: string
   /**
-   * Optional. Maximum number of items to return.
+   * Required. Currency code.
    */
-  pageSize: number
+  currency: string
   /**
-   * Optional. Keyset page token.
+   * Required. Expected payout cadence.
    */
-  pageToken: string
+  cadence: IncomeSource_Cadence
   /**
-   * Optional. Sort order string.
+   * Optional. Default receiving account for income from this source.
+   * Values are of the form `acc_[a-zA-Z0-9]+`.
    */
-  sort?: string
+  accountId?: string
   /**
-   * Optional. Target transfer ID filter.
-   * Values are of the form `trn_[a-zA-Z0-9]+`.
+   * Optional. Indicates if the income source is active.
    */
-  transferId?: string
+  isActive: boolean
   /**
-   * Optional. Target scheduled payment ID filter.
-   * Values are of the form `sch_[a-zA-Z0-9]+`.
+   * Output only. Creation timestamp.
    */
-  scheduledPaymentId?: string
+  createTime?: string
   /**
-   * Optional. Target borrowing ID filter.
-   * Values are of the form `bor_[a-zA-Z0-9]+`.
+   * Output only. Last update timestamp.
    */
-  borrowingId?: string
+  updateTime?: string
+  /**
+   * Optional. RFC 5545 recurrence rule for expected payouts
+   * (e.g. `FREQ=MONTHLY;BYMONTHDAY=15,-1`). When set, the cadence is derived
+   * from the rule and reported as CUSTOM if it has no simple equivalent.
+   */
+  recurrenceRule: string
+  /**
+   * Optional. First date of the recurrence. Required with a recurrence rule.
+   */
+  startDate?: string
+  /**
+   * Optional. Dates on which an expected payout is skipped.
+   */
+  exceptionDates: string[]
 }
 
 /**
- * The response for
- * [ListTransactions][saturn.finance.v1.Finance.ListTransactions].
+ * The request for
+ * [CreateIncomeSource][saturn.finance.v1.Finance.CreateIncomeSource].
  */
-export interface ListTransactionsResponse {
-  /**
-   * List of transactions matching filter rules.
-   */
-  transactions: Transaction[]
+export interface CreateIncomeSourceRequest {
   /**
-   * Next page keyset token. Empty if no more pages are available.
+   * Required. The income source to create.
    */
-  nextPageToken: string
+  incomeSource: IncomeSource
 }
 
 /**
  * The request for
- * [GetInsights][saturn.finance.v1.Finance.GetInsights].
+ * [GetIncomeSource][saturn.finance.v1.Finance.GetIncomeSource].
  */
-export interface GetInsightsRequest {
+export interface GetIncomeSourceRequest {
   /**
-   * Required. Statistical grouping granularity.
+   * Required. Unique identifier of the income source.
+   * Values are of the form `ics_[a-zA-Z0-9]+`.
    */
-  granularity: InsightGranularity
+  id: string
+}
+
+/**
+ * The request for
+ * [UpdateIncomeSource][saturn.finance.v1.Finance.UpdateIncomeSource].
+ */
+export interface UpdateIncomeSourceRequest {
   /**
-   * Required. Target start date window.
+   * Required. Unique identifier of the income source to update.
+   * Values are of the form `ics_[a-zA-Z0-9]+`.
    */
-  startDate: string
+  id: string
   /**
-   * Required. Target end date window.
+   * Required. Updated income source parameters.
    */
-  endDate: string
+  incomeSource: IncomeSource
 }
 
 /**
- * The response for
- * [GetInsights][saturn.finance.v1.Finance.GetInsights].
+ * The request for
+ * [DeleteIncomeSource][saturn.finance.v1.Finance.DeleteIncomeSource].
  */
-export interface GetInsightsResponse {
+export interface DeleteIncomeSourceRequest {
   /**
-   * Spent insights statistics.
+   * Required. Unique identifier of the income source to delete.
+   * Values are of the form `ics_[a-zA-Z0-9]+`.
    */
-  spent: SpentInsights
+  id: string
 }
 
 /**
- * SpentInsights aggregates workspace statistics.
+ * The request for
+ * [ListIncomeSources][saturn.finance.v1.Finance.ListIncomeSources].
  */
-export interface SpentInsights {
+export interface ListIncomeSourcesRequest {
   /**
-   * Total configured budget limits in base currency cents.
+   * Optional. Maximum number of items to return.
    */
-  totalLimit: string
+  pageSize: number
   /**
-   * Total spent in base currency cents.
+   * Optional. Keyset page token.
    */
-  totalSpent: string
+  pageToken: string
   /**
-   * Remaining space budget in base currency cents.
+   * Optional. Filter only active income sources.
    */
-  remainingBudget: string
+  activeOnly?: boolean
   /**
-   * Average spend speed rate relative to elapsed time.
+   * Optional. Text search query matching the name or employer.
    */
-  burnRate: number
+  searchQuery?: string
   /**
-   * List of granular interval trend points.
+   * Optional. Sorting specification.
    */
-  trend: SpentInsights_TrendDataPoint[]
+  sort?: string
+}
+
+/**
+ * The response for
+ * [ListIncomeSources][saturn.finance.v1.Finance.ListIncomeSources].
+ */
+export interface ListIncomeSourcesResponse {
   /**
-   * Distribution breakdown by budget category.
+   * List of income sources matching filters.
    */
-  distributions: SpentInsights_BudgetUsage[]
+  incomeSources: IncomeSource[]
   /**
-   * List of largest expense transactions.
+   * Next page keyset token. Empty if no more pages are available.
    */
-  topExpenses: SpentInsights_HighValueExpense[]
+  nextPageToken: string
 }
 
 /**
- * Detailed contribution metrics of a single budget.
+ * Goal is a savings target tracked against transfers into a linked account or tagged transactions.
  */
-export interface SpentInsights_BudgetContribution {
+export interface Goal {
   /**
-   * Unique identifier of the budget.
+   * Output only. Unique identifier.
+   * Values are of the form `goal_[a-zA-Z0-9]+`.
    */
-  budgetId: string
+  id?: string
   /**
-   * User-friendly name.
+   * Output only. Space identifier.
    */
-  budgetName: string
+  spaceId?: string
   /**
-   * Color hex code.
+   * Required. User-friendly name (e.g. "Emergency fund").
    */
-  budgetColor: string
+  name: string
   /**
-   * Amount spent in base currency cents.
+   * Optional. Free-form description.
    */
-  amountInBase: string
+  description: string
   /**
-   * Amount spent in local currency cents.
+   * Required. Amount to save in the currency's minor units.
    */
-  amountInLocal: string
+  targetAmount: string
   /**
-   * Local currency code.
+   * Required. Currency code.
    */
-  localCurrency: string
+  currency: string
   /**
-   * Contribution percentage relative to total spent.
+   * Optional. Contributions dated before this are ignored. Defaults to the creation time.
    */
-  contributionPercentage: number
-}
-
-/**
- * TrendDataPoint tracks spend patterns grouped by granular interval.
- */
-export interface SpentInsights_TrendDataPoint {
+  startDate: string
   /**
-   * Dynamic grouping label (e.g. Month name, date string).
+   * Optional. Date by which the target should be reached.
    */
-  label: string
+  deadline: string
   /**
-   * Interval start date boundary.
+   * Optional. Account holding the savings. Transfers into it count as contributions
+   * and transfers out of it as withdrawals.
+   * Values are of the form `acc_[a-zA-Z0-9]+`.
    */
-  startDate: string
+  accountId?: string
   /**
-   * Spent amount converted to base currency cents.
+   * Optional. Transactions carrying this tag count as contributions.
+   * At least one of `account_id` or `tag` is required.
    */
-  amountInBase: string
+  tag: string
   /**
-   * Total transactions within this interval.
+   * Optional. Color hex code.
    */
-  transactionCount: number
+  color: string
   /**
-   * List of budget category contributions within this interval.
+   * Optional. Icon name.
    */
-  contributions: SpentInsights_BudgetContribution[]
-}
-
-/**
- * BudgetUsage represents progress against budget limits.
- */
-export interface SpentInsights_BudgetUsage {
+  icon: string
   /**
-   * Unique identifier of the budget.
+   * Optional. Lifecycle status. New goals are always active.
    */
-  budgetId: string
+  status: Goal_Status
   /**
-   * User-friendly name.
+   * Output only. Creation timestamp.
    */
-  budgetName: string
-  /**
-   * Color hex code.
-   */
-  budgetColor: string
-  /**
-   * Icon identifier.
-   */
-  budgetIcon: string
-  /**
-   * Budget limit in cents.
-   */
-  limit: string
-  /**
-   * Spent amount in cents.
-   */
-  spent: string
-  /**
-   * Spent amount converted to base currency cents.
-   */
-  spentInBase: string
+  createTime?: string
   /**
-   * Limit consumption percentage.
+   * Output only. Last update timestamp.
    */
-  usagePercentage: number
+  updateTime?: string
 }
 
 /**
- * HighValueExpense tracks outlier transactions exceeding typical limits.
+ * GoalProgress summarizes how far a goal has come and when it is expected to be reached.
  */
-export interface SpentInsights_HighValueExpense {
+export interface GoalProgress {
   /**
-   * Unique identifier of the transaction.
+   * The goal being tracked.
    */
-  transactionId: string
+  goal: Goal
   /**
-   * Narration notes.
+   * Net amount saved so far in goal currency minor units.
    */
-  description: string
+  savedAmount: string
   /**
-   * Absolute value in local currency cents.
+   * Amount left to reach the target in goal currency minor units.
    */
-  amount: string
+  remainingAmount: string
   /**
-   * Local currency code.
+   * Share of the target saved, as a percentage capped at 100.
    */
-  currency: string
+  percentage: number
   /**
-   * Value converted to base currency cents.
+   * Number of contributions and withdrawals recorded since the start date.
    */
-  amountInBase: string
+  contributionCount: number
   /**
-   * Parent budget name.
+   * Average net contribution per 30 days over the last 90 days.
    */
-  budgetName: string
+  monthlyVelocity: string
   /**
-   * Time transaction occurred.
+   * Projected date the target is reached at the current velocity. Unset when the goal is not progressing.
    */
-  transactionDate: string
+  projectedCompletionDate: string
   /**
-   * Execution/posting date.
+   * Monthly contribution needed to meet the deadline. Zero without a deadline.
    */
-  effectiveDate: string
+  requiredMonthlyContribution: string
+  /**
+   * Whether the projected completion date meets the deadline.
+   */
+  onTrack: boolean
+  /**
+   * Contribution history, oldest first. Omitted from insights.
+   */
+  contributions: GoalProgress_Contribution[]
 }
 
 /**
- * GenerateScheduledPaymentsPayload defines the cron scheduling payload.
- */
-export type GenerateScheduledPaymentsPayload = Record<string, never>
-
-/**
- * RecurringExpense represents a template rule to repeat payments.
+ * Contribution is a single movement of funds towards or away from the goal.
  */
-export interface RecurringExpense {
+export interface GoalProgress_Contribution {
   /**
-   * Output only. Unique identifier.
-   * Values are of the form `rec_[a-zA-Z0-9]+`.
+   * Identifier of the contributing transaction.
    */
-  id?: string
+  transactionId: string
   /**
-   * Output only. Space identifier.
+   * Transaction type of the contribution.
    */
-  spaceId?: string
+  type: Transaction_Type
   /**
-   * Required. Budget category identifier.
-   * Values are of the form `bud_[a-zA-Z0-9]+`.
+   * Transaction description.
    */
-  budgetId: string
+  description: string
   /**
-   * Required. User-friendly name.
+   * Signed amount in goal currency minor units. Withdrawals are negative.
    */
-  name: string
+  amount: string
   /**
-   * Required. Base payment amount in cents.
+   * Date of the contribution.
    */
-  amount: string
+  transactionDate: string
+}
+
+/**
+ * The request for
+ * [CreateGoal][saturn.finance.v1.Finance.CreateGoal].
+ */
+export interface CreateGoalRequest {
   /**
-   * Required. Currency code.
+   * Required. The goal to create.
    */
-  currency: string
+  goal: Goal
+}
+
+/**
+ * The request for
+ * [GetGoal][saturn.finance.v1.Finance.GetGoal].
+ */
+export interface GetGoalRequest {
   /**
-   * Required. Execution interval rule.
+   * Required. Unique identifier of the goal.
+   * Values are of the form `goal_[a-zA-Z0-9]+`.
    */
-  interval: RecurringExpense_Interval
+  id: string
+}
+
+/**
+ * The request for
+ * [UpdateGoal][saturn.finance.v1.Finance.UpdateGoal].
+ */
+export interface UpdateGoalRequest {
   /**
-   * Required. Scheduler engine runtime state.
+   * Required. Unique identifier of the goal to update.
+   * Values are of the form `goal_[a-zA-Z0-9]+`.
    */
-  executionState: RecurringExpense_ExecutionState
+  id: string
   /**
-   * Optional. Indicates if the payment amount is variable.
+   * Required. Updated goal parameters.
    */
-  isVariable: boolean
+  goal: Goal
+}
+
+/**
+ * The request for
+ * [DeleteGoal][saturn.finance.v1.Finance.DeleteGoal].
+ */
+export interface DeleteGoalRequest {
   /**
-   * Required. Active template status.
+   * Required. Unique identifier of the goal to delete.
+   * Values are of the form `goal_[a-zA-Z0-9]+`.
    */
-  status: RecurringExpense_Status
+  id: string
+}
+
+/**
+ * The request for
+ * [ListGoals][saturn.finance.v1.Finance.ListGoals].
+ */
+export interface ListGoalsRequest {
   /**
-   * Optional. Days allowed past due date before triggering warning events.
+   * Optional. Maximum number of items to return.
    */
-  gracePeriodDays: number
+  pageSize: number
   /**
-   * Output only. Creation timestamp.
+   * Optional. Keyset page token.
    */
-  createTime?: string
+  pageToken: string
   /**
-   * Output only. Last update timestamp.
+   * Optional. Filter by goal status.
    */
-  updateTime?: string
+  status?: Goal_Status
   /**
-   * Output only. Hydrated minimal budget details. Available only on FULL view.
+   * Optional. Text search query matching the name or description.
    */
-  budget?: RecurringExpense_BudgetInfo
+  searchQuery?: string
+  /**
+   * Optional. Sorting specification.
+   */
+  sort?: string
 }
 
 /**
- * BudgetInfo wraps minimal budget details required for UI listing.
+ * The response for
+ * [ListGoals][saturn.finance.v1.Finance.ListGoals].
  */
-export interface RecurringExpense_BudgetInfo {
-  id: string
-  name: string
-  color: string
-  icon: string
+export interface ListGoalsResponse {
+  /**
+   * List of goals matching filters.
+   */
+  goals: Goal[]
+  /**
+   * Next page keyset token. Empty if no more pages are available.
+   */
+  nextPageToken: string
 }
 
 /**
- * ExecutionState represents the scheduler engine runtime tracking parameters.
+ * The request for
+ * [GetGoalProgress][saturn.finance.v1.Finance.GetGoalProgress].
  */
-export interface RecurringExpense_ExecutionState {
-  /**
-   * Required. Next execution due date.
-   */
-  nextDueDate: string
+export interface GetGoalProgressRequest {
   /**
-   * Output only. Last execution/posting date.
+   * Required. Unique identifier of the goal.
+   * Values are of the form `goal_[a-zA-Z0-9]+`.
    */
-  lastPaymentDate?: string
+  id: string
 }
 
 /**
- * ScheduledPayment represents a spawned pending payment instance.
+ * Category classifies transactions independently of budgets. Categories can be nested.
  */
-export interface ScheduledPayment {
+export interface Category {
   /**
    * Output only. Unique identifier.
-   * Values are of the form `sch_[a-zA-Z0-9]+`.
+   * Values are of the form `cat_[a-zA-Z0-9]+`.
    */
   id?: string
   /**
@@ -1323,38 +1926,22 @@ export interface ScheduledPayment {
    */
   spaceId?: string
   /**
-   * Required. Budget category identifier.
-   * Values are of the form `bud_[a-zA-Z0-9]+`.
-   */
-  budgetId: string
-  /**
-   * Required. Parent template source type.
-   */
-  sourceType: ScheduledPayment_SourceType
-  /**
-   * Required. Parent template source ID.
-   */
-  sourceId: string
-  /**
-   * Required. Target payment amount in cents.
-   */
-  amount: string
-  /**
-   * Required. Currency code.
+   * Optional. Parent category identifier. Empty for top-level categories.
+   * Values are of the form `cat_[a-zA-Z0-9]+`.
    */
-  currency: string
+  parentId?: string
   /**
-   * Required. Scheduled execution due date.
+   * Required. User-friendly name (e.g. "Groceries").
    */
-  dueDate: string
+  name: string
   /**
-   * Required. Instance execution status.
+   * Optional. Visual UI icon identifier.
    */
-  status: ScheduledPayment_Status
+  icon: string
   /**
-   * Optional. Metadata binary payload.
+   * Optional. Visual UI color hex code or tag.
    */
-  metadata: string
+  color: string
   /**
    * Output only. Creation timestamp.
    */
@@ -1363,82 +1950,64 @@ export interface ScheduledPayment {
    * Output only. Last update timestamp.
    */
   updateTime?: string
-  /**
-   * Output only. Hydrated minimal budget details. Available only on FULL view.
-   */
-  budget?: ScheduledPayment_BudgetInfo
-  /**
-   * Output only. Hydrated parent template details. Available only on FULL view.
-   */
-  recurringExpense?: ScheduledPayment_RecurringExpenseInfo
-}
-
-/**
- * BudgetInfo wraps minimal budget details required for UI listing.
- */
-export interface ScheduledPayment_BudgetInfo {
-  id: string
-  name: string
-  color: string
-  icon: string
 }
 
 /**
- * RecurringExpenseInfo wraps parent template details.
+ * The request for
+ * [CreateCategory][saturn.finance.v1.Finance.CreateCategory].
  */
-export interface ScheduledPayment_RecurringExpenseInfo {
-  id: string
-  name: string
-  interval: RecurringExpense_Interval
+export interface CreateCategoryRequest {
+  /**
+   * Required. The category to create.
+   */
+  category: Category
 }
 
 /**
  * The request for
- * [CreateRecurringExpense][saturn.finance.v1.Finance.CreateRecurringExpense].
+ * [GetCategory][saturn.finance.v1.Finance.GetCategory].
  */
-export interface CreateRecurringExpenseRequest {
+export interface GetCategoryRequest {
   /**
-   * Required. The recurring expense template to create.
+   * Required. Unique identifier of the category.
+   * Values are of the form `cat_[a-zA-Z0-9]+`.
    */
-  recurringExpense: RecurringExpense
+  id: string
 }
 
 /**
  * The request for
- * [UpdateRecurringExpense][saturn.finance.v1.Finance.UpdateRecurringExpense].
+ * [UpdateCategory][saturn.finance.v1.Finance.UpdateCategory].
  */
-export interface UpdateRecurringExpenseRequest {
+export interface UpdateCategoryRequest {
   /**
-   * Required. Unique identifier of the template to update.
+   * Required. Unique identifier of the category to update.
+   * Values are of the form `cat_[a-zA-Z0-9]+`.
    */
   id: string
   /**
-   * Required. The recurring expense template to update.
+   * Required. Updated category parameters.
    */
-  recurringExpense: RecurringExpense
+  category: Category
 }
 
 /**
  * The request for
- * [DeleteRecurringExpense][saturn.finance.v1.Finance.DeleteRecurringExpense].
+ * [DeleteCategory][saturn.finance.v1.Finance.DeleteCategory].
  */
-export interface DeleteRecurringExpenseRequest {
+export interface DeleteCategoryRequest {
   /**
-   * Required. Unique identifier of the template to delete.
-   * Values are of the form `rec_[a-zA-Z0-9]+`.
+   * Required. Unique identifier of the category to delete.
+   * Values are of the form `cat_[a-zA-Z0-9]+`.
    */
   id: string
 }
 
 /**
  * The request for
- * [ListRecurringExpenses][saturn.finance.v1.Finance.ListRecurringExpenses].
+ * [ListCategories][saturn.finance.v1.Finance.ListCategories].
  */
-export interface ListRecurringExpensesRequest {
-  /**
-   * Optional. Filter templates by active status.
-   */
-  status: RecurringExpense_Status
+export interface ListCategoriesRequest {
   /**
    * Optional. Maximum number of items to return.
    */
@@ -1448,11 +2017,16 @@ export interface ListRecurringExpensesRequest {
    */
   pageToken: string
   /**
-   * Optional. Representation view level.
+   * Optional. Return only direct children of this category.
+   * Values are of the form `cat_[a-zA-Z0-9]+`.
    */
-  view?: RecurringExpense_View
+  parentId?: string
   /**
-   * Optional. Search string querying the name of the template.
+   * Optional. Return only top-level categories. Ignored when parent_id is set.
+   */
+  topLevelOnly: boolean
+  /**
+   * Optional. Text search query matching the name.
    */
   searchQuery?: string
   /**
@@ -1463,13 +2037,13 @@ export interface ListRecurringExpensesRequest {
 
 /**
  * The response for
- * [ListRecurringExpenses][saturn.finance.v1.Finance.ListRecurringExpenses].
+ * [ListCategories][saturn.finance.v1.Finance.ListCategories].
  */
-export interface ListRecurringExpensesResponse {
+export interface ListCategoriesResponse {
   /**
-   * List of recurring expense templates matching filters.
+   * List of categories matching filters.
    */
-  recurringExpenses: RecurringExpense[]
+  categories: Category[]
   /**
    * Next page keyset token. Empty if no more pages are available.
    */
@@ -1478,21 +2052,59 @@ export interface ListRecurringExpensesResponse {
 
 /**
  * The request for
- * [ListScheduledPayments][saturn.finance.v1.Finance.ListScheduledPayments].
+ * [DeleteTransaction][saturn.finance.v1.Finance.DeleteTransaction].
  */
-export interface ListScheduledPaymentsRequest {
+export interface DeleteTransactionRequest {
   /**
-   * Optional. Filter instances by payment status.
+   * Required. Unique identifier of the transaction to delete.
+   * Values are of the form `txn_[a-zA-Z0-9]+`.
    */
-  status: ScheduledPayment_Status
+  id: string
+}
+
+/**
+ * The request for
+ * [GetTransaction][saturn.finance.v1.Finance.GetTransaction].
+ */
+export interface GetTransactionRequest {
   /**
-   * Optional. Target start date of execution window.
+   * Required. Unique identifier of the transaction record to retrieve.
+   * Values are of the form `txn_[a-zA-Z0-9]+`.
    */
-  startDate: string
+  id: string
   /**
-   * Optional. Target end date of execution window.
+   * Optional. Scoped budget representation view type.
    */
-  endDate: string
+  view?: Transaction_View
+}
+
+/**
+ * The request for
+ * [ListTransactions][saturn.finance.v1.Finance.ListTransactions].
+ */
+export interface ListTransactionsRequest {
+  /**
+   * Optional. Scoped budget representation view type.
+   */
+  view?: Transaction_View
+  /**
+   * Optional. Target parent budget ID filter.
+   * Values are of the form `bud_[a-zA-Z0-9]+`.
+   */
+  budgetId: string
+  /**
+   * Optional. Target transaction flow type filter.
+   */
+  type: Transaction_Type
+  /**
+   * Optional. Target account identifier.
+   * Values are of the form `acc_[a-zA-Z0-9]+`.
+   */
+  accountId?: string
+  /**
+   * Optional. Text search query matching transaction description notes.
+   */
+  searchQuery?: string
   /**
    * Optional. Maximum number of items to return.
    */
@@ -1502,28 +2114,62 @@ export interface ListScheduledPaymentsRequest {
    */
   pageToken: string
   /**
-   * Optional. Representation view level.
+   * Optional. Sort order string.
    */
-  view?: ScheduledPayment_View
+  sort?: string
   /**
-   * Optional. Search string querying the description or parent info.
+   * Optional. Target transfer ID filter.
+   * Values are of the form `trn_[a-zA-Z0-9]+`.
    */
-  searchQuery?: string
+  transferId?: string
   /**
-   * Optional. Sorting specification.
+   * Optional. Target scheduled payment ID filter.
+   * Values are of the form `sch_[a-zA-Z0-9]+`.
    */
-  sort?: string
+  scheduledPaymentId?: string
+  /**
+   * Optional. Target borrowing ID filter.
+   * Values are of the form `bor_[a-zA-Z0-9]+`.
+   */
+  borrowingId?: string
+  /**
+   * Optional. Target income source ID filter.
+   * Values are of the form `ics_[a-zA-Z0-9]+`.
+   */
+  incomeSourceId?: string
+  /**
+   * Optional. Target category ID filter. Includes transactions in subcategories.
+   * Values are of the form `cat_[a-zA-Z0-9]+`.
+   */
+  categoryId?: string
+  /**
+   * Optional. Only return transactions carrying all of these tags.
+   */
+  tags: string[]
+  /**
+   * Optional. AIP-160 filter expression, combined with the other filters using AND.
+   * Supported fields: transaction_date, effective_date, create_time, amount, amount_in_base,
+   * currency, type, description, budget_id, account_id, category_id, payee_id, tags, cleared.
+   * Example: `transaction_date >= 2025-01-01 AND amount > 5000 AND budget_id IN ("bud_a", "bud_b")`.
+   */
+  filter?: string
+  /**
+   * Optional. Saved filter whose expression is applied in addition to `filter`.
+   * Its default sort is used when `sort` is not set.
+   * Values are of the form `sfl_[a-zA-Z0-9]+`.
+   */
+  savedFilterId?: string
 }
 
 /**
  * The response for
- * [ListScheduledPayments][saturn.finance.v1.Finance.ListScheduledPayments].
+ * [ListTransactions][saturn.finance.v1.Finance.ListTransactions].
  */
-export interface ListScheduledPaymentsResponse {
+export interface ListTransactionsResponse {
   /**
-   * List of scheduled payment instances matching filters.
+   * List of transactions matching filter rules.
    */
-  scheduledPayments: ScheduledPayment[]
+  transactions: Transaction[]
   /**
    * Next page keyset token. Empty if no more pages are available.
    */
@@ -1532,190 +2178,197 @@ export interface ListScheduledPaymentsResponse {
 
 /**
  * The request for
- * [GetScheduledPayment][saturn.finance.v1.Finance.GetScheduledPayment].
- */
-export interface GetScheduledPaymentRequest {
-  /**
-   * Required. Unique identifier of the scheduled payment to retrieve.
-   * Values are of the form `sch_[a-zA-Z0-9]+`.
-   */
-  id: string
-}
-
-/**
- * The request for
- * [ConfirmScheduledPayment][saturn.finance.v1.Finance.ConfirmScheduledPayment].
+ * [BatchUpdateTransactions][saturn.finance.v1.Finance.BatchUpdateTransactions].
+ * Exactly one of `ids` or `filter`/`saved_filter_id` must be set. Only expenses and income that are
+ * not reconciled or linked to a borrowing can be changed; otherwise the whole batch is rejected.
  */
-export interface ConfirmScheduledPaymentRequest {
-  /**
-   * Required. Unique identifier of the scheduled payment to confirm.
-   * Values are of the form `sch_[a-zA-Z0-9]+`.
-   */
-  paymentId: string
-  /**
-   * Required. Time transaction occurred.
-   */
-  transactionDate: string
+export interface BatchUpdateTransactionsRequest {
   /**
-   * Required. Execution/posting date.
+   * Optional. Transactions to update, at most 500.
+   * Values are of the form `txn_[a-zA-Z0-9]+`.
    */
-  effectiveDate: string
+  ids: string[]
   /**
-   * Required. Actual amount cleared in local currency cents.
+   * Optional. AIP-160 filter expression selecting the transactions to update, using the fields
+   * supported by `ListTransactions`. Must match at most 500 transactions.
    */
-  actualAmount: string
+  filter?: string
   /**
-   * Optional. Narration notes.
+   * Optional. Saved filter whose expression selects the transactions, AND-ed with `filter`.
+   * Values are of the form `sfl_[a-zA-Z0-9]+`.
    */
-  description?: string
+  savedFilterId?: string
   /**
-   * Optional. Financial account identifier used to settle the payment.
-   * When provided, the account balance will be updated accordingly.
-   * Values are of the form `acc_[a-zA-Z0-9]+`.
+   * Required. New values of the fields listed in `update_mask`.
    */
-  accountId?: string
+  transaction: Transaction
   /**
-   * Optional. Budget category identifier to allocate the cleared transaction under.
-   * If omitted, defaults to the budget category configured on the scheduled payment.
-   * Values are of the form `bud_[a-zA-Z0-9]+`.
+   * Required. Fields to change. Supported fields: description, budget_id, account_id, category_id,
+   * payee_id, tags.
    */
-  budgetId?: string
+  updateMask: { paths?: string[] }
   /**
-   * Optional. Settlement currency code (ISO 4217 standard 3-letter code).
-   * If omitted, defaults to the currency configured on the scheduled payment.
+   * Optional. Compute and return the changes without applying them.
    */
-  currency?: string
+  validateOnly: boolean
 }
 
 /**
- * The request for [MatchScheduledPayment][saturn.finance.v1.Finance.MatchScheduledPayment].
+ * TransactionDiff lists the field changes a batch update makes to one transaction.
  */
-export interface MatchScheduledPaymentRequest {
-  /**
-   * Required. Target scheduled payment identifier.
-   * Values are of the form `sch_[a-zA-Z0-9]+`.
-   */
-  paymentId: string
+export interface TransactionDiff {
   /**
-   * Required. Existing transaction identifier to match and link.
+   * Changed transaction.
    * Values are of the form `txn_[a-zA-Z0-9]+`.
    */
   transactionId: string
-}
-
-/**
- * The request for [SkipScheduledPayment][saturn.finance.v1.Finance.SkipScheduledPayment].
- */
-export interface SkipScheduledPaymentRequest {
   /**
-   * Required. Identifier of the scheduled payment instance to skip.
-   * Values are of the form `sch_[a-zA-Z0-9]+`.
+   * Changed fields.
    */
-  id: string
+  changes: TransactionDiff_FieldChange[]
 }
 
 /**
- * Borrowing represents a personal lent/borrowed debt agreement.
+ * A single changed field.
  */
-export interface Borrowing {
+export interface TransactionDiff_FieldChange {
   /**
-   * Output only. Unique identifier.
-   * Values are of the form `bor_[a-zA-Z0-9]+`.
+   * Field name, as used in the update mask.
    */
-  id?: string
+  field: string
   /**
-   * Output only. Space identifier.
+   * Value before the change. Empty when unset; tags are comma separated.
    */
-  spaceId?: string
+  oldValue: string
   /**
-   * Required. Direction flow of the debt.
+   * Value after the change. Empty when unset; tags are comma separated.
    */
-  direction: Borrowing_Direction
+  newValue: string
+}
+
+/**
+ * AccountBalanceChange is the net effect of a batch operation on an account's current balance.
+ */
+export interface AccountBalanceChange {
   /**
-   * Required. Name of the counterparty entity.
+   * Affected account.
+   * Values are of the form `acc_[a-zA-Z0-9]+`.
    */
-  counterparty: string
+  accountId: string
   /**
-   * Optional. Contact information.
+   * Current balance before the batch, in account currency cents.
    */
-  contactInfo: string
+  oldBalance: string
   /**
-   * Required. Initial loan principal amount in cents.
+   * Current balance after the batch, in account currency cents.
    */
-  totalAmount: string
+  newBalance: string
+}
+
+/**
+ * The response for
+ * [BatchUpdateTransactions][saturn.finance.v1.Finance.BatchUpdateTransactions].
+ * With `validate_only` it describes the changes that would have been made.
+ */
+export interface BatchUpdateTransactionsResponse {
   /**
-   * Output only. Remaining balance amount in cents.
+   * Transactions changed by the batch, with their new values.
    */
-  remainingAmount?: string
+  transactions: Transaction[]
   /**
-   * Required. Currency code.
+   * Field changes per transaction. Selected transactions left unchanged are omitted.
    */
-  currency: string
+  diffs: TransactionDiff[]
   /**
-   * Required. Lifecycle status.
+   * Net balance change per affected account.
    */
-  status: Borrowing_Status
+  balanceChanges: AccountBalanceChange[]
   /**
-   * Required. Date established.
+   * Budget periods whose spent totals change.
+   * Values are of the form `per_[a-zA-Z0-9]+`.
    */
-  establishedAt: string
+  periodIds: string[]
+}
+
+/**
+ * The request for
+ * [BatchDeleteTransactions][saturn.finance.v1.Finance.BatchDeleteTransactions].
+ * Exactly one of `ids` or `filter`/`saved_filter_id` must be set. Only expenses and income that are
+ * not reconciled or linked to a borrowing can be deleted; otherwise the whole batch is rejected.
+ */
+export interface BatchDeleteTransactionsRequest {
   /**
-   * Optional. Target repayment due date.
+   * Optional. Transactions to delete, at most 500.
+   * Values are of the form `txn_[a-zA-Z0-9]+`.
    */
-  dueAt: string
+  ids: string[]
   /**
-   * Optional. Automatically create a parallel transaction entry in the ledger.
+   * Optional. AIP-160 filter expression selecting the transactions to delete, using the fields
+   * supported by `ListTransactions`. Must match at most 500 transactions.
    */
-  createAsTransaction: boolean
+  filter?: string
   /**
-   * Optional. Narration notes.
+   * Optional. Saved filter whose expression selects the transactions, AND-ed with `filter`.
+   * Values are of the form `sfl_[a-zA-Z0-9]+`.
    */
-  notes: string
+  savedFilterId?: string
   /**
-   * Output only. Creation timestamp.
+   * Optional. Compute and return the changes without applying them.
    */
-  createTime?: string
+  validateOnly: boolean
+}
+
+/**
+ * The response for
+ * [BatchDeleteTransactions][saturn.finance.v1.Finance.BatchDeleteTransactions].
+ * With `validate_only` it describes the changes that would have been made.
+ */
+export interface BatchDeleteTransactionsResponse {
   /**
-   * Output only. Last update timestamp.
+   * Deleted transactions.
    */
-  updateTime?: string
+  transactions: Transaction[]
   /**
-   * Optional. Source account identifier for transaction creation.
-   * Values are of the form `acc_[a-zA-Z0-9]+`.
+   * Net balance change per affected account.
    */
-  accountId?: string
+  balanceChanges: AccountBalanceChange[]
+  /**
+   * Budget periods whose spent totals change.
+   * Values are of the form `per_[a-zA-Z0-9]+`.
+   */
+  periodIds: string[]
 }
 
 /**
- * BorrowingRepayment represents an installment payment towards a borrowing.
+ * SavedFilter is a named transaction filter expression shared across a space.
  */
-export interface BorrowingRepayment {
+export interface SavedFilter {
   /**
    * Output only. Unique identifier.
-   * Values are of the form `rep_[a-zA-Z0-9]+`.
+   * Values are of the form `sfl_[a-zA-Z0-9]+`.
    */
   id?: string
-  /**
-   * Required. Parent borrowing identifier.
-   * Values are of the form `bor_[a-zA-Z0-9]+`.
-   */
-  borrowingId: string
   /**
    * Output only. Space identifier.
    */
   spaceId?: string
   /**
-   * Required. Installment amount in cents.
+   * Required. User-friendly name (e.g. "Large groceries this year").
    */
-  amount: string
+  name: string
   /**
-   * Required. Installment payment date.
+   * Optional. Free-form description.
    */
-  paymentDate: string
+  description: string
   /**
-   * Optional. Narration notes.
+   * Required. AIP-160 filter expression, using the fields accepted by
+   * [ListTransactionsRequest.filter][saturn.finance.v1.ListTransactionsRequest.filter].
    */
-  notes: string
+  expression: string
+  /**
+   * Optional. Default sort applied when listing transactions with this filter (e.g. "amount:desc").
+   */
+  sort: string
   /**
    * Output only. Creation timestamp.
    */
@@ -1724,200 +2377,245 @@ export interface BorrowingRepayment {
    * Output only. Last update timestamp.
    */
   updateTime?: string
-  /**
-   * Required. Source account identifier.
-   * Values are of the form `acc_[a-zA-Z0-9]+`.
-   */
-  accountId: string
 }
 
 /**
  * The request for
- * [CreateBorrowing][saturn.finance.v1.Finance.CreateBorrowing].
+ * [CreateSavedFilter][saturn.finance.v1.Finance.CreateSavedFilter].
  */
-export interface CreateBorrowingRequest {
+export interface CreateSavedFilterRequest {
   /**
-   * Required. Target borrowing parameters.
+   * Required. The saved filter to create.
    */
-  borrowing: Borrowing
+  savedFilter: SavedFilter
 }
 
 /**
  * The request for
- * [GetBorrowing][saturn.finance.v1.Finance.GetBorrowing].
+ * [GetSavedFilter][saturn.finance.v1.Finance.GetSavedFilter].
  */
-export interface GetBorrowingRequest {
+export interface GetSavedFilterRequest {
   /**
-   * Required. ID of the borrowing record to retrieve.
-   * Values are of the form `bor_[a-zA-Z0-9]+`.
+   * Required. Unique identifier of the saved filter.
+   * Values are of the form `sfl_[a-zA-Z0-9]+`.
    */
   id: string
 }
 
 /**
  * The request for
- * [ListBorrowings][saturn.finance.v1.Finance.ListBorrowings].
+ * [UpdateSavedFilter][saturn.finance.v1.Finance.UpdateSavedFilter].
  */
-export interface ListBorrowingsRequest {
-  /**
-   * Optional. Filter by debt status.
-   */
-  status?: Borrowing_Status
-  /**
-   * Optional. Filter by debt flow direction.
-   */
-  direction?: Borrowing_Direction
+export interface UpdateSavedFilterRequest {
   /**
-   * Optional. Maximum number of items to return.
+   * Required. Unique identifier of the saved filter to update.
+   * Values are of the form `sfl_[a-zA-Z0-9]+`.
    */
-  pageSize?: number
+  id: string
   /**
-   * Optional. Keyset page token.
+   * Required. Updated saved filter parameters.
    */
-  pageToken?: string
+  savedFilter: SavedFilter
   /**
-   * Optional. Sort order for results.
+   * Optional. Field mask defining which fields to update for partial updates.
    */
-  orderBy?: string
+  updateMask?: { paths?: string[] }
 }
 
 /**
- * The response for
- * [ListBorrowings][saturn.finance.v1.Finance.ListBorrowings].
+ * The request for
+ * [DeleteSavedFilter][saturn.finance.v1.Finance.DeleteSavedFilter].
  */
-export interface ListBorrowingsResponse {
-  /**
-   * List of borrowing records matching filters.
-   */
-  borrowings: Borrowing[]
+export interface DeleteSavedFilterRequest {
   /**
-   * Next page keyset token. Empty if no more pages are available.
+   * Required. Unique identifier of the saved filter to delete.
+   * Values are of the form `sfl_[a-zA-Z0-9]+`.
    */
-  nextPageToken: string
+  id: string
 }
 
 /**
  * The request for
- * [UpdateBorrowing][saturn.finance.v1.Finance.UpdateBorrowing].
+ * [ListSavedFilters][saturn.finance.v1.Finance.ListSavedFilters].
  */
-export interface UpdateBorrowingRequest {
+export interface ListSavedFiltersRequest {
   /**
-   * Required. Unique identifier of the borrowing record to update.
-   * Values are of the form `bor_[a-zA-Z0-9]+`.
+   * Optional. Maximum number of items to return.
    */
-  id: string
+  pageSize: number
   /**
-   * Required. Updated borrowing parameters.
+   * Optional. Keyset page token.
    */
-  borrowing: Borrowing
+  pageToken: string
+  /**
+   * Optional. Sorting specification.
+   */
+  sort?: string
 }
 
 /**
- * The request for
- * [DeleteBorrowing][saturn.finance.v1.Finance.DeleteBorrowing].
+ * The response for
+ * [ListSavedFilters][saturn.finance.v1.Finance.ListSavedFilters].
  */
-export interface DeleteBorrowingRequest {
+export interface ListSavedFiltersResponse {
   /**
-   * Required. Unique identifier of the borrowing record to delete.
-   * Values are of the form `bor_[a-zA-Z0-9]+`.
+   * List of saved filters in the space.
    */
-  id: string
+  savedFilters: SavedFilter[]
+  /**
+   * Next page keyset token. Empty if no more pages are available.
+   */
+  nextPageToken: string
 }
 
 /**
- * The request for
- * [CreateBorrowingRepayment][saturn.finance.v1.Finance.CreateBorrowingRepayment].
+ * Payee is a merchant or counterparty that transaction descriptions are normalized to, so that
+ * "AMZN MKTP US*2K3" and "Amazon" are recognized as the same vendor.
  */
-export interface CreateBorrowingRepaymentRequest {
+export interface Payee {
   /**
-   * Required. Target parent borrowing identifier.
-   * Values are of the form `bor_[a-zA-Z0-9]+`.
+   * Output only. Unique identifier.
+   * Values are of the form `pye_[a-zA-Z0-9]+`.
    */
-  borrowingId: string
+  id?: string
   /**
-   * Required. Updated repayment parameters.
+   * Output only. Space identifier.
    */
-  repayment: BorrowingRepayment
+  spaceId?: string
+  /**
+   * Required. Display name (e.g. "Amazon").
+   */
+  name: string
+  /**
+   * Optional. Alternative spellings matched as whole words, ignoring case and punctuation
+   * (e.g. "AMZN MKTP"). The name itself is always matched.
+   */
+  aliases: string[]
+  /**
+   * Optional. Case-insensitive regular expressions matched against descriptions.
+   */
+  patterns: string[]
+  /**
+   * Optional. Budget assigned to expenses from this payee that have none.
+   * Values are of the form `bud_[a-zA-Z0-9]+`.
+   */
+  defaultBudgetId?: string
+  /**
+   * Optional. Account assigned to transactions from this payee that have none.
+   * Values are of the form `acc_[a-zA-Z0-9]+`.
+   */
+  defaultAccountId?: string
+  /**
+   * Optional. Absolute http(s) URL of the payee's logo.
+   */
+  logoUrl: string
+  /**
+   * Output only. Creation timestamp.
+   */
+  createTime?: string
+  /**
+   * Output only. Last update timestamp.
+   */
+  updateTime?: string
 }
 
 /**
  * The request for
- * [ListBorrowingRepayments][saturn.finance.v1.Finance.ListBorrowingRepayments].
+ * [CreatePayee][saturn.finance.v1.Finance.CreatePayee].
  */
-export interface ListBorrowingRepaymentsRequest {
+export interface CreatePayeeRequest {
   /**
-   * Required. Target parent borrowing identifier.
-   * Values are of the form `bor_[a-zA-Z0-9]+`.
+   * Required. The payee to create.
    */
-  borrowingId: string
+  payee: Payee
 }
 
 /**
- * The response for
- * [ListBorrowingRepayments][saturn.finance.v1.Finance.ListBorrowingRepayments].
+ * The request for
+ * [GetPayee][saturn.finance.v1.Finance.GetPayee].
  */
-export interface ListBorrowingRepaymentsResponse {
+export interface GetPayeeRequest {
   /**
-   * List of installment repayments.
+   * Required. Unique identifier of the payee.
+   * Values are of the form `pye_[a-zA-Z0-9]+`.
    */
-  repayments: BorrowingRepayment[]
+  id: string
 }
 
 /**
  * The request for
- * [DeleteBorrowingRepayment][saturn.finance.v1.Finance.DeleteBorrowingRepayment].
+ * [UpdatePayee][saturn.finance.v1.Finance.UpdatePayee].
  */
-export interface DeleteBorrowingRepaymentRequest {
+export interface UpdatePayeeRequest {
   /**
-   * Required. Target parent borrowing identifier.
-   * Values are of the form `bor_[a-zA-Z0-9]+`.
+   * Required. Unique identifier of the payee to update.
+   * Values are of the form `pye_[a-zA-Z0-9]+`.
    */
-  borrowingId: string
+  id: string
   /**
-   * Required. Unique identifier of the installment repayment to delete.
-   * Values are of the form `rep_[a-zA-Z0-9]+`.
+   * Required. Updated payee parameters.
    */
-  id: string
+  payee: Payee
+  /**
+   * Optional. Field mask defining which fields to update for partial updates.
+   */
+  updateMask?: { paths?: string[] }
 }
 
 /**
- * CurrencyInfo encapsulates currency code metadata.
+ * The request for
+ * [DeletePayee][saturn.finance.v1.Finance.DeletePayee].
  */
-export interface CurrencyInfo {
+export interface DeletePayeeRequest {
   /**
-   * Required. ISO currency code (e.g. USD).
-   */
-  code: string
-  /**
-   * Required. User-friendly currency name.
+   * Required. Unique identifier of the payee to delete.
+   * Values are of the form `pye_[a-zA-Z0-9]+`.
    */
-  name: string
+  id: string
 }
 
 /**
  * The request for
- * [ListCurrencies][saturn.finance.v1.Finance.ListCurrencies].
+ * [ListPayees][saturn.finance.v1.Finance.ListPayees].
  */
-export type ListCurrenciesRequest = Record<string, never>
+export interface ListPayeesRequest {
+  /**
+   * Optional. Maximum number of items to return.
+   */
+  pageSize: number
+  /**
+   * Optional. Keyset page token.
+   */
+  pageToken: string
+  /**
+   * Optional. Sorting specification.
+   */
+  sort?: string
+}
 
 /**
  * The response for
- * [ListCurrencies][saturn.finance.v1.Finance.ListCurrencies].
+ * [ListPayees][saturn.finance.v1.Finance.ListPayees].
  */
-export interface ListCurrenciesResponse {
+export interface ListPayeesResponse {
   /**
-   * List of currency codes and details.
+   * List of payees in the space.
    */
-  currencies: CurrencyInfo[]
+  payees: Payee[]
+  /**
+   * Next page keyset token. Empty if no more pages are available.
+   */
+  nextPageToken: string
 }
 
 /**
- * Account represents a physical or digital payment account.
+ * TransactionRule assigns a budget, payee, category, or tags to transactions matching all of its
+ * conditions. Rules run by ascending priority; for each action the first matching rule wins.
  */
-export interface Account {
+export interface TransactionRule {
   /**
-   * Output only. Unique identifier of the account.
-   * Values are of the form `acc_[a-zA-Z0-9]+`.
+   * Output only. Unique identifier.
+   * Values are of the form `trr_[a-zA-Z0-9]+`.
    */
   id?: string
   /**
@@ -1925,51 +2623,53 @@ export interface Account {
    */
   spaceId?: string
   /**
-   * Required. User-friendly account name (e.g. "Chase Checking").
+   * Required. User-friendly name (e.g. "Amazon purchases on the Visa card").
    */
   name: string
   /**
-   * Required. Account classification type.
+   * Optional. Evaluation order; lower runs first.
    */
-  type: Account_Type
+  priority: number
   /**
-   * Required. Account currency (e.g. "USD").
+   * Optional. Inactive rules are ignored. New rules are active.
    */
-  currency: string
+  isActive: boolean
   /**
-   * Required. Initial balance amount in cents (e.g. 500000 for $5,000.00).
+   * Optional. Case-insensitive regular expression the description must match.
    */
-  initialBalance: string
+  descriptionPattern: string
   /**
-   * Output only. Computed current balance amount in cents.
-   * Calculated dynamically as `InitialBalance + Sum(Income/TransferIn) - Sum(Expense/TransferOut)`.
+   * Optional. Account the transaction must be booked on.
+   * Values are of the form `acc_[a-zA-Z0-9]+`.
    */
-  currentBalance?: string
+  accountId?: string
   /**
-   * Optional. Credit limit amount in cents (applicable only if CREDIT_CARD type).
+   * Optional. Inclusive minimum amount in local currency cents.
    */
-  creditLimit: string
+  minAmount?: string
   /**
-   * Optional. Indicates if the account is the workspace default. Default accounts receive
-   * transactions if no explicit account is nominated.
+   * Optional. Inclusive maximum amount in local currency cents.
    */
-  isDefault: boolean
+  maxAmount?: string
   /**
-   * Optional. Indicates if the account is active.
+   * Optional. Budget assigned to matching expenses that are not split.
+   * Values are of the form `bud_[a-zA-Z0-9]+`.
    */
-  isActive: boolean
+  setBudgetId?: string
   /**
-   * Optional. Color HEX code for visual UI identification.
+   * Optional. Payee assigned to matching transactions.
+   * Values are of the form `pye_[a-zA-Z0-9]+`.
    */
-  color: string
+  setPayeeId?: string
   /**
-   * Optional. Narration notes.
+   * Optional. Category assigned to matching transactions.
+   * Values are of the form `cat_[a-zA-Z0-9]+`.
    */
-  notes: string
+  setCategoryId?: string
   /**
-   * Optional. Last four digits of card or account number.
+   * Optional. Tags added to matching transactions.
    */
-  lastFour: string
+  addTags: string[]
   /**
    * Output only. Creation timestamp.
    */
@@ -1978,949 +2678,4406 @@ export interface Account {
    * Output only. Last update timestamp.
    */
   updateTime?: string
-  /**
-   * Output only. Currency conversion statistics.
-   */
-  conversion?: Account_Conversion
 }
 
 /**
- * Conversion contains hydrated conversion metrics relative to the workspace base currency.
+ * The request for
+ * [CreateTransactionRule][saturn.finance.v1.Finance.CreateTransactionRule].
  */
-export interface Account_Conversion {
-  /**
-   * Output only. Converted balance in base currency cents.
-   */
-  balance?: string
+export interface CreateTransactionRuleRequest {
   /**
-   * Output only. Exchange rate multiplier used.
+   * Required. The rule to create.
    */
-  rate?: number
+  transactionRule: TransactionRule
 }
 
 /**
  * The request for
- * [CreateAccount][saturn.finance.v1.Finance.CreateAccount].
+ * [GetTransactionRule][saturn.finance.v1.Finance.GetTransactionRule].
  */
-export interface CreateAccountRequest {
+export interface GetTransactionRuleRequest {
   /**
-   * Required. Account configuration parameters.
+   * Required. Unique identifier of the rule.
+   * Values are of the form `trr_[a-zA-Z0-9]+`.
    */
-  account: Account
+  id: string
 }
 
 /**
  * The request for
- * [GetAccount][saturn.finance.v1.Finance.GetAccount].
+ * [UpdateTransactionRule][saturn.finance.v1.Finance.UpdateTransactionRule].
  */
-export interface GetAccountRequest {
+export interface UpdateTransactionRuleRequest {
   /**
-   * Required. Target account identifier.
-   * Values are of the form `acc_[a-zA-Z0-9]+`.
+   * Required. Unique identifier of the rule to update.
+   * Values are of the form `trr_[a-zA-Z0-9]+`.
    */
   id: string
   /**
-   * Optional. Account view details level.
+   * Required. Updated rule parameters.
    */
-  view?: Account_View
+  transactionRule: TransactionRule
+  /**
+   * Optional. Field mask defining which fields to update for partial updates.
+   */
+  updateMask?: { paths?: string[] }
 }
 
 /**
  * The request for
- * [UpdateAccount][saturn.finance.v1.Finance.UpdateAccount].
+ * [DeleteTransactionRule][saturn.finance.v1.Finance.DeleteTransactionRule].
  */
-export interface UpdateAccountRequest {
+export interface DeleteTransactionRuleRequest {
   /**
-   * Required. Target account identifier to update.
-   * Values are of the form `acc_[a-zA-Z0-9]+`.
+   * Required. Unique identifier of the rule to delete.
+   * Values are of the form `trr_[a-zA-Z0-9]+`.
    */
   id: string
-  /**
-   * Required. Updated account parameters.
-   */
-  account: Account
 }
 
 /**
  * The request for
- * [AdjustAccountBalance][saturn.finance.v1.Finance.AdjustAccountBalance].
+ * [ListTransactionRules][saturn.finance.v1.Finance.ListTransactionRules].
  */
-export interface AdjustAccountBalanceRequest {
+export interface ListTransactionRulesRequest {
   /**
-   * Required. Target account identifier to adjust balance for.
+   * Optional. Maximum number of items to return.
    */
-  accountId: string
+  pageSize: number
   /**
-   * Required. Target balance in cents.
+   * Optional. Keyset page token.
    */
-  targetBalance: string
+  pageToken: string
   /**
-   * Optional. ISO timestamp of the adjustment date.
+   * Optional. Sorting specification.
    */
-  adjustmentDate?: string
+  sort?: string
   /**
-   * Optional. Reason or note for reconciliation.
+   * Optional. Only return active rules.
    */
-  note?: string
+  activeOnly?: boolean
 }
 
 /**
- * The request for
- * [DeleteAccount][saturn.finance.v1.Finance.DeleteAccount].
+ * The response for
+ * [ListTransactionRules][saturn.finance.v1.Finance.ListTransactionRules].
  */
-export interface DeleteAccountRequest {
+export interface ListTransactionRulesResponse {
   /**
-   * Required. Target account identifier to delete.
-   * Values are of the form `acc_[a-zA-Z0-9]+`.
+   * List of transaction rules in the space.
    */
-  id: string
+  transactionRules: TransactionRule[]
+  /**
+   * Next page keyset token. Empty if no more pages are available.
+   */
+  nextPageToken: string
 }
 
 /**
  * The request for
- * [ListAccounts][saturn.finance.v1.Finance.ListAccounts].
+ * [ApplyTransactionRules][saturn.finance.v1.Finance.ApplyTransactionRules].
  */
-export interface ListAccountsRequest {
+export interface ApplyTransactionRulesRequest {
   /**
-   * Optional. Account view details level.
+   * Optional. Only transactions on or after this date are evaluated.
    */
-  view?: Account_View
+  startDate: string
   /**
-   * Optional. Filter only active accounts.
+   * Optional. Only transactions on or before this date are evaluated.
    */
-  activeOnly?: boolean
+  endDate: string
   /**
-   * Optional. Search text filter matching account name or notes.
+   * Optional. Replace payees, budgets, and categories that are already set. By default only
+   * empty fields are filled. Accounts are never replaced.
    */
-  searchQuery?: string
+  overwrite: boolean
+}
+
+/**
+ * The response for
+ * [ApplyTransactionRules][saturn.finance.v1.Finance.ApplyTransactionRules].
+ */
+export interface ApplyTransactionRulesResponse {
   /**
-   * Optional. Maximum number of items to return in a single page.
+   * Number of expenses and income evaluated.
    */
-  pageSize?: number
+  scannedCount: number
   /**
-   * Optional. Keyset page token returned by a previous call.
+   * Number of transactions changed by a rule or payee.
    */
-  pageToken?: string
+  updatedCount: number
   /**
-   * Optional. Sort order string specifying column and optional ordering suffix.
+   * Number of transactions skipped because a finalized reconciliation locks them.
    */
-  sort?: string
+  skippedCount: number
 }
 
 /**
- * The response for
- * [ListAccounts][saturn.finance.v1.Finance.ListAccounts].
+ * The request for
+ * [GetInsights][saturn.finance.v1.Finance.GetInsights].
  */
-export interface ListAccountsResponse {
+export interface GetInsightsRequest {
   /**
-   * List of accounts matching filters.
+   * Required. Statistical grouping granularity.
    */
-  accounts: Account[]
+  granularity: InsightGranularity
   /**
-   * Next page keyset token. Empty if no more pages are available.
+   * Required. Target start date window.
    */
-  nextPageToken: string
+  startDate: string
+  /**
+   * Required. Target end date window.
+   */
+  endDate: string
 }
 
 /**
- * Transfer represents a new transfer between accounts.
+ * The response for
+ * [GetInsights][saturn.finance.v1.Finance.GetInsights].
  */
-export interface Transfer {
+export interface GetInsightsResponse {
   /**
-   * Output only. Unique identifier.
-   * Values are of the form `txn_[a-zA-Z0-9]+`.
+   * Spent insights statistics.
    */
-  id?: string
+  spent: SpentInsights
   /**
-   * Output only. Space identifier.
+   * Net cash flow statistics comparing income against expenses.
    */
-  spaceId?: string
+  cashFlow: CashFlowInsights
   /**
-   * Required. Source account ID.
-   * Values are of the form `acc_[a-zA-Z0-9]+`.
+   * Progress of every active savings goal.
    */
-  sourceAccountId: string
+  goals: GoalProgress[]
+}
+
+/**
+ * CashFlowInsights aggregates inflows against outflows in the base currency.
+ */
+export interface CashFlowInsights {
   /**
-   * Required. Destination account ID.
-   * Values are of the form `acc_[a-zA-Z0-9]+`.
+   * Total income in base currency cents.
    */
-  destinationAccountId: string
+  totalIncome: string
   /**
-   * Required. Source amount in source account currency cents.
+   * Total expenses in base currency cents.
    */
-  sourceAmount: string
+  totalExpense: string
   /**
-   * Required. Destination amount in destination account currency cents.
+   * Net cash flow (income minus expenses) in base currency cents.
    */
-  destinationAmount: string
+  netCashFlow: string
   /**
-   * Required. Transfer date.
+   * Share of income retained after expenses, as a percentage.
    */
-  transferDate: string
+  savingsRate: number
   /**
-   * Optional. Narration notes.
+   * List of granular interval cash flow points.
    */
-  notes: string
+  trend: CashFlowInsights_CashFlowDataPoint[]
   /**
-   * Output only. Creation timestamp.
+   * Expenses charged to credit cards in base currency cents. Included in `total_expense`.
    */
-  createTime?: string
+  cardSpending: string
   /**
-   * Output only. Last update timestamp.
+   * Credit card payments in base currency cents. Excluded from `total_expense` so card
+   * purchases are not counted twice.
    */
-  updateTime?: string
+  cardPayments: string
 }
 
 /**
- * The request for
- * [CreateTransfer][saturn.finance.v1.Finance.CreateTransfer].
+ * CashFlowDataPoint tracks income and expenses grouped by granular interval.
  */
-export interface CreateTransferRequest {
-  /**
-   * Required. Source account ID.
-   * Values are of the form `acc_[a-zA-Z0-9]+`.
-   */
-  sourceAccountId: string
+export interface CashFlowInsights_CashFlowDataPoint {
   /**
-   * Required. Destination account ID.
-   * Values are of the form `acc_[a-zA-Z0-9]+`.
+   * Dynamic grouping label (e.g. Month name, date string).
    */
-  destinationAccountId: string
+  label: string
   /**
-   * Required. Source amount in source account currency cents.
+   * Interval start date boundary.
    */
-  sourceAmount: string
+  startDate: string
   /**
-   * Required. Destination amount in destination account currency cents.
+   * Income amount in base currency cents.
    */
-  destinationAmount: string
+  incomeInBase: string
   /**
-   * Required. Transfer date.
+   * Expense amount in base currency cents.
    */
-  transferDate: string
+  expenseInBase: string
   /**
-   * Optional. Narration notes.
+   * Net cash flow (income minus expenses) in base currency cents.
    */
-  notes: string
-}
-
-/**
- * The request for
- * [ListTransfers][saturn.finance.v1.Finance.ListTransfers].
- */
-export interface ListTransfersRequest {
+  netInBase: string
   /**
-   * Optional. Maximum number of items to return.
+   * Expenses charged to credit cards in base currency cents. Included in `expense_in_base`.
    */
-  pageSize: number
+  cardSpendingInBase: string
   /**
-   * Optional. Keyset page token.
+   * Credit card payments in base currency cents. Not counted as expenses.
    */
-  pageToken: string
+  cardPaymentsInBase: string
 }
 
 /**
- * The response for
- * [ListTransfers][saturn.finance.v1.Finance.ListTransfers].
+ * SpentInsights aggregates workspace statistics.
  */
-export interface ListTransfersResponse {
+export interface SpentInsights {
   /**
-   * List of transfer records.
+   * Total configured budget limits in base currency cents.
    */
-  transfers: Transfer[]
+  totalLimit: string
   /**
-   * Next page keyset token. Empty if no more pages are available.
+   * Total spent in base currency cents.
    */
-  nextPageToken: string
-}
-
-/**
- * The request for
- * [ListTransactionEvents][saturn.finance.v1.Finance.ListTransactionEvents].
- */
-export interface ListTransactionEventsRequest {
+  totalSpent: string
   /**
-   * Required. Target transaction ID.
-   * Values are of the form `txn_[a-zA-Z0-9]+`.
+   * Remaining space budget in base currency cents.
    */
-  txnId: string
-}
-
-/**
- * TransactionEvent represents a historical transaction lifecycle change.
- */
-export interface TransactionEvent {
+  remainingBudget: string
   /**
-   * Output only. Unique identifier.
-   * Values are of the form `evt_[a-zA-Z0-9]+`.
+   * Average spend speed rate relative to elapsed time.
    */
-  id?: string
+  burnRate: number
   /**
-   * Output only. Space identifier.
+   * List of granular interval trend points.
    */
-  spaceId?: string
+  trend: SpentInsights_TrendDataPoint[]
   /**
-   * Required. Associated transaction ID.
-   * Values are of the form `txn_[a-zA-Z0-9]+`.
+   * Distribution breakdown by budget category.
    */
-  txnId: string
+  distributions: SpentInsights_BudgetUsage[]
   /**
-   * Required. Lifecycle change event type (e.g. "created", "updated").
+   * List of largest expense transactions.
    */
-  eventType: string
+  topExpenses: SpentInsights_HighValueExpense[]
   /**
-   * Required. JSON metadata describing the change details (e.g. diff properties).
+   * Distribution breakdown by transaction category.
    */
-  metadata: string
+  categories: SpentInsights_CategoryUsage[]
   /**
-   * Output only. Event registration timestamp.
+   * Distribution breakdown by tag.
    */
-  createTime?: string
+  tags: SpentInsights_TagUsage[]
 }
 
 /**
- * The response for
- * [ListTransactionEvents][saturn.finance.v1.Finance.ListTransactionEvents].
+ * Detailed contribution metrics of a single budget.
  */
-export interface ListTransactionEventsResponse {
+export interface SpentInsights_BudgetContribution {
   /**
-   * List of transaction lifecycle history events.
+   * Unique identifier of the budget.
    */
-  events: TransactionEvent[]
-}
-
-/**
- * InboxItem represents an ingested invoice, receipt, or notification in processing staging.
- */
-export interface InboxItem {
+  budgetId: string
   /**
-   * Output only. Unique identifier.
-   * Values are of the form `inb_[a-zA-Z0-9]+`.
+   * User-friendly name.
    */
-  id?: string
+  budgetName: string
   /**
-   * Output only. Space identifier.
+   * Color hex code.
    */
-  spaceId?: string
+  budgetColor: string
   /**
-   * Output only. Ingestion integration channel identifier.
+   * Amount spent in base currency cents.
    */
-  integrationId?: string
+  amountInBase: string
   /**
-   * Output only. Staging lifecycle status.
+   * Amount spent in local currency cents.
    */
-  status?: InboxItem_Status
+  amountInLocal: string
   /**
-   * Required. Extracted document classification category.
+   * Local currency code.
    */
-  docType: InboxItem_DocType
+  localCurrency: string
   /**
-   * Required. Extracted transaction amount in cents.
+   * Contribution percentage relative to total spent.
    */
-  amount: string
+  contributionPercentage: number
+}
+
+/**
+ * TrendDataPoint tracks spend patterns grouped by granular interval.
+ */
+export interface SpentInsights_TrendDataPoint {
   /**
-   * Required. Extracted currency code.
+   * Dynamic grouping label (e.g. Month name, date string).
    */
-  currency: string
+  label: string
   /**
-   * Required. Extracted vendor counterparty name.
+   * Interval start date boundary.
    */
-  vendorName: string
+  startDate: string
   /**
-   * Required. Extracted transaction transaction date.
+   * Spent amount converted to base currency cents.
    */
-  transactionDate: string
+  amountInBase: string
   /**
-   * Optional. Suggested account ID for categorization.
-   * Values are of the form `acc_[a-zA-Z0-9]+`.
+   * Total transactions within this interval.
    */
-  accountId: string
+  transactionCount: number
   /**
-   * Optional. Suggested budget category ID.
-   * Values are of the form `bud_[a-zA-Z0-9]+`.
+   * List of budget category contributions within this interval.
+   */
+  contributions: SpentInsights_BudgetContribution[]
+}
+
+/**
+ * BudgetUsage represents progress against budget limits.
+ */
+export interface SpentInsights_BudgetUsage {
+  /**
+   * Unique identifier of the budget.
    */
   budgetId: string
   /**
-   * Optional. Associated scheduled payment identifier to clear.
-   * Values are of the form `sch_[a-zA-Z0-9]+`.
+   * User-friendly name.
    */
-  scheduledPaymentId: string
+  budgetName: string
   /**
-   * Optional. Associated completed transaction ledger ID.
-   * Values are of the form `txn_[a-zA-Z0-9]+`.
+   * Color hex code.
    */
-  transactionId: string
+  budgetColor: string
   /**
-   * Output only. Ingestion raw notification payload details.
+   * Icon identifier.
    */
-  rawPayload?: string
+  budgetIcon: string
   /**
-   * Optional. Additional extracted metadata payload key-value pairs.
+   * Budget limit in cents.
    */
-  metadata: Record<string, string>
+  limit: string
   /**
-   * Output only. Ingestion stage timestamp.
+   * Spent amount in cents.
    */
-  createTime?: string
+  spent: string
   /**
-   * Optional. Associated borrowing/lending agreement identifier.
-   * Values are of the form `bor_[a-zA-Z0-9]+`.
+   * Spent amount converted to base currency cents.
    */
-  borrowingId?: string
+  spentInBase: string
   /**
-   * Optional. Relationship classification for borrowing link (e.g., initial receipt, repayment, additional loan).
+   * Limit consumption percentage.
    */
-  borrowingLinkType?: BorrowingLinkType
+  usagePercentage: number
 }
 
 /**
- * The request for
- * [ListInboxItems][saturn.finance.v1.Finance.ListInboxItems].
+ * CategoryUsage represents spend distribution by transaction category.
  */
-export interface ListInboxItemsRequest {
+export interface SpentInsights_CategoryUsage {
   /**
-   * Optional. Number of items to retrieve per page.
+   * Unique identifier of the category. "uncategorized" for unclassified spend.
    */
-  pageSize: number
+  categoryId: string
   /**
-   * Optional. Cursor token from previous page response.
+   * Parent category identifier. Empty for top-level categories.
    */
-  pageToken: string
+  parentId: string
   /**
-   * Optional. Free-text search query matching vendor names or classifications.
+   * User-friendly name.
    */
-  searchQuery?: string
+  categoryName: string
   /**
-   * Optional. Stage status to filter by.
+   * Color hex code.
    */
-  status?: InboxItem_Status
+  categoryColor: string
   /**
-   * Optional. Document category classification filter.
+   * Icon identifier.
    */
-  docType?: InboxItem_DocType
+  categoryIcon: string
   /**
-   * Optional. Sorting rules (e.g. "create_time:desc").
+   * Total transactions classified under this category.
    */
-  sort: string
+  transactionCount: number
   /**
-   * Optional. Representation view.
+   * Spent amount converted to base currency cents.
    */
-  view: InboxItem_View
+  spentInBase: string
+  /**
+   * Share percentage relative to total spent.
+   */
+  sharePercentage: number
 }
 
 /**
- * The response for
- * [ListInboxItems][saturn.finance.v1.Finance.ListInboxItems].
+ * TagUsage represents spend distribution by tag. A transaction
+ * carrying several tags contributes to each of them.
  */
-export interface ListInboxItemsResponse {
+export interface SpentInsights_TagUsage {
   /**
-   * List of staged inbox items.
+   * Tag label.
    */
-  inboxItems: InboxItem[]
+  tag: string
   /**
-   * Cursor page token for retrieving the next page.
+   * Total transactions carrying this tag.
+   */
+  transactionCount: number
+  /**
+   * Spent amount converted to base currency cents.
+   */
+  spentInBase: string
+  /**
+   * Share percentage relative to total spent.
+   */
+  sharePercentage: number
+}
+
+/**
+ * HighValueExpense tracks outlier transactions exceeding typical limits.
+ */
+export interface SpentInsights_HighValueExpense {
+  /**
+   * Unique identifier of the transaction.
+   */
+  transactionId: string
+  /**
+   * Narration notes.
+   */
+  description: string
+  /**
+   * Absolute value in local currency cents.
+   */
+  amount: string
+  /**
+   * Local currency code.
+   */
+  currency: string
+  /**
+   * Value converted to base currency cents.
+   */
+  amountInBase: string
+  /**
+   * Parent budget name.
+   */
+  budgetName: string
+  /**
+   * Time transaction occurred.
+   */
+  transactionDate: string
+  /**
+   * Execution/posting date.
+   */
+  effectiveDate: string
+}
+
+/**
+ * GenerateScheduledPaymentsPayload defines the cron scheduling payload.
+ */
+export type GenerateScheduledPaymentsPayload = Record<string, never>
+
+/**
+ * SyncExchangeRatesPayload defines the cron payload for fetching reference exchange rates.
+ */
+export type SyncExchangeRatesPayload = Record<string, never>
+
+/**
+ * RecurringExpense represents a template rule to repeat payments.
+ */
+export interface RecurringExpense {
+  /**
+   * Output only. Unique identifier.
+   * Values are of the form `rec_[a-zA-Z0-9]+`.
+   */
+  id?: string
+  /**
+   * Output only. Space identifier.
+   */
+  spaceId?: string
+  /**
+   * Required. Budget category identifier.
+   * Values are of the form `bud_[a-zA-Z0-9]+`.
+   */
+  budgetId: string
+  /**
+   * Required. User-friendly name.
+   */
+  name: string
+  /**
+   * Required. Base payment amount in cents.
+   */
+  amount: string
+  /**
+   * Required. Currency code.
+   */
+  currency: string
+  /**
+   * Optional. Execution interval rule. Required unless a recurrence rule is
+   * given, in which case it is derived from the rule.
+   */
+  interval: RecurringExpense_Interval
+  /**
+   * Required. Scheduler engine runtime state.
+   */
+  executionState: RecurringExpense_ExecutionState
+  /**
+   * Optional. Indicates if the payment amount is variable.
+   */
+  isVariable: boolean
+  /**
+   * Required. Active template status.
+   */
+  status: RecurringExpense_Status
+  /**
+   * Optional. Days allowed past due date before triggering warning events.
+   */
+  gracePeriodDays: number
+  /**
+   * Output only. Creation timestamp.
+   */
+  createTime?: string
+  /**
+   * Output only. Last update timestamp.
+   */
+  updateTime?: string
+  /**
+   * Output only. Hydrated minimal budget details. Available only on FULL view.
+   */
+  budget?: RecurringExpense_BudgetInfo
+  /**
+   * Optional. RFC 5545 recurrence rule (e.g. `FREQ=WEEKLY;INTERVAL=2;BYDAY=FR`
+   * or `FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1`). Takes precedence
+   * over the interval when set.
+   */
+  recurrenceRule: string
+  /**
+   * Optional. First date of the recurrence. Defaults to the next due date.
+   */
+  startDate?: string
+  /**
+   * Optional. Dates on which no scheduled payment is generated.
+   */
+  exceptionDates: string[]
+}
+
+/**
+ * BudgetInfo wraps minimal budget details required for UI listing.
+ */
+export interface RecurringExpense_BudgetInfo {
+  id: string
+  name: string
+  color: string
+  icon: string
+}
+
+/**
+ * ExecutionState represents the scheduler engine runtime tracking parameters.
+ */
+export interface RecurringExpense_ExecutionState {
+  /**
+   * Required. Next execution due date.
+   */
+  nextDueDate: string
+  /**
+   * Output only. Last execution/posting date.
+   */
+  lastPaymentDate?: string
+}
+
+/**
+ * ScheduledPayment represents a spawned pending payment instance.
+ */
+export interface ScheduledPayment {
+  /**
+   * Output only. Unique identifier.
+   * Values are of the form `sch_[a-zA-Z0-9]+`.
+   */
+  id?: string
+  /**
+   * Output only. Space identifier.
+   */
+  spaceId?: string
+  /**
+   * Required. Budget category identifier.
+   * Values are of the form `bud_[a-zA-Z0-9]+`.
+   */
+  budgetId: string
+  /**
+   * Required. Parent template source type.
+   */
+  sourceType: ScheduledPayment_SourceType
+  /**
+   * Required. Parent template source ID.
+   */
+  sourceId: string
+  /**
+   * Required. Target payment amount in cents.
+   */
+  amount: string
+  /**
+   * Required. Currency code.
+   */
+  currency: string
+  /**
+   * Required. Scheduled execution due date.
+   */
+  dueDate: string
+  /**
+   * Required. Instance execution status.
+   */
+  status: ScheduledPayment_Status
+  /**
+   * Optional. Metadata binary payload.
+   */
+  metadata: string
+  /**
+   * Output only. Creation timestamp.
+   */
+  createTime?: string
+  /**
+   * Output only. Last update timestamp.
+   */
+  updateTime?: string
+  /**
+   * Output only. Hydrated minimal budget details. Available only on FULL view.
+   */
+  budget?: ScheduledPayment_BudgetInfo
+  /**
+   * Output only. Hydrated parent template details. Available only on FULL view.
+   */
+  recurringExpense?: ScheduledPayment_RecurringExpenseInfo
+}
+
+/**
+ * BudgetInfo wraps minimal budget details required for UI listing.
+ */
+export interface ScheduledPayment_BudgetInfo {
+  id: string
+  name: string
+  color: string
+  icon: string
+}
+
+/**
+ * RecurringExpenseInfo wraps parent template details.
+ */
+export interface ScheduledPayment_RecurringExpenseInfo {
+  id: string
+  name: string
+  interval: RecurringExpense_Interval
+}
+
+/**
+ * The request for
+ * [CreateRecurringExpense][saturn.finance.v1.Finance.CreateRecurringExpense].
+ */
+export interface CreateRecurringExpenseRequest {
+  /**
+   * Required. The recurring expense template to create.
+   */
+  recurringExpense: RecurringExpense
+}
+
+/**
+ * The request for
+ * [UpdateRecurringExpense][saturn.finance.v1.Finance.UpdateRecurringExpense].
+ */
+export interface UpdateRecurringExpenseRequest {
+  /**
+   * Required. Unique identifier of the template to update.
+   */
+  id: string
+  /**
+   * Required. The recurring expense template to update.
+   */
+  recurringExpense: RecurringExpense
+}
+
+/**
+ * The request for
+ * [DeleteRecurringExpense][saturn.finance.v1.Finance.DeleteRecurringExpense].
+ */
+export interface DeleteRecurringExpenseRequest {
+  /**
+   * Required. Unique identifier of the template to delete.
+   * Values are of the form `rec_[a-zA-Z0-9]+`.
+   */
+  id: string
+}
+
+/**
+ * The request for
+ * [ListRecurringExpenses][saturn.finance.v1.Finance.ListRecurringExpenses].
+ */
+export interface ListRecurringExpensesRequest {
+  /**
+   * Optional. Filter templates by active status.
+   */
+  status: RecurringExpense_Status
+  /**
+   * Optional. Maximum number of items to return.
+   */
+  pageSize: number
+  /**
+   * Optional. Keyset page token.
+   */
+  pageToken: string
+  /**
+   * Optional. Representation view level.
+   */
+  view?: RecurringExpense_View
+  /**
+   * Optional. Search string querying the name of the template.
+   */
+  searchQuery?: string
+  /**
+   * Optional. Sorting specification.
+   */
+  sort?: string
+}
+
+/**
+ * The response for
+ * [ListRecurringExpenses][saturn.finance.v1.Finance.ListRecurringExpenses].
+ */
+export interface ListRecurringExpensesResponse {
+  /**
+   * List of recurring expense templates matching filters.
+   */
+  recurringExpenses: RecurringExpense[]
+  /**
+   * Next page keyset token. Empty if no more pages are available.
+   */
+  nextPageToken: string
+}
+
+/**
+ * SubscriptionSuggestion is a periodic charge found in the transaction history.
+ */
+export interface SubscriptionSuggestion {
+  /**
+   * Output only. Unsaved recurring expense template prefilled from the charges. The budget is empty
+   * when none of the charges were budgeted.
+   */
+  recurringExpense?: RecurringExpense
+  /**
+   * Output only. Confidence between 0 and 1 that the charges are a subscription.
+   */
+  confidence?: number
+  /**
+   * Output only. Supporting transactions, oldest first.
+   */
+  transactionIds?: string[]
+}
+
+/**
+ * The request for
+ * [DetectSubscriptions][saturn.finance.v1.Finance.DetectSubscriptions].
+ */
+export interface DetectSubscriptionsRequest {
+  /**
+   * Optional. Number of days of history to scan. Defaults to 400, at most 1100.
+   */
+  lookbackDays: number
+}
+
+/**
+ * The response for
+ * [DetectSubscriptions][saturn.finance.v1.Finance.DetectSubscriptions].
+ */
+export interface DetectSubscriptionsResponse {
+  /**
+   * Suggestions ordered by confidence, highest first.
+   */
+  suggestions: SubscriptionSuggestion[]
+}
+
+/**
+ * The request for
+ * [ConvertToRecurringExpense][saturn.finance.v1.Finance.ConvertToRecurringExpense].
+ */
+export interface ConvertToRecurringExpenseRequest {
+  /**
+   * Required. The recurring expense to create, usually the suggested template.
+   */
+  recurringExpense: RecurringExpense
+  /**
+   * Required. Expenses to link to the new recurring expense.
+   * Values are of the form `txn_[a-zA-Z0-9]+`.
+   */
+  transactionIds: string[]
+}
+
+/**
+ * The request for
+ * [ListScheduledPayments][saturn.finance.v1.Finance.ListScheduledPayments].
+ */
+export interface ListScheduledPaymentsRequest {
+  /**
+   * Optional. Filter instances by payment status.
+   */
+  status: ScheduledPayment_Status
+  /**
+   * Optional. Target start date of execution window.
+   */
+  startDate: string
+  /**
+   * Optional. Target end date of execution window.
+   */
+  endDate: string
+  /**
+   * Optional. Maximum number of items to return.
+   */
+  pageSize: number
+  /**
+   * Optional. Keyset page token.
+   */
+  pageToken: string
+  /**
+   * Optional. Representation view level.
+   */
+  view?: ScheduledPayment_View
+  /**
+   * Optional. Search string querying the description or parent info.
+   */
+  searchQuery?: string
+  /**
+   * Optional. Sorting specification.
+   */
+  sort?: string
+}
+
+/**
+ * The response for
+ * [ListScheduledPayments][saturn.finance.v1.Finance.ListScheduledPayments].
+ */
+export interface ListScheduledPaymentsResponse {
+  /**
+   * List of scheduled payment instances matching filters.
+   */
+  scheduledPayments: ScheduledPayment[]
+  /**
+   * Next page keyset token. Empty if no more pages are available.
+   */
+  nextPageToken: string
+}
+
+/**
+ * The request for
+ * [GetScheduledPayment][saturn.finance.v1.Finance.GetScheduledPayment].
+ */
+export interface GetScheduledPaymentRequest {
+  /**
+   * Required. Unique identifier of the scheduled payment to retrieve.
+   * Values are of the form `sch_[a-zA-Z0-9]+`.
+   */
+  id: string
+}
+
+/**
+ * The request for
+ * [ConfirmScheduledPayment][saturn.finance.v1.Finance.ConfirmScheduledPayment].
+ */
+export interface ConfirmScheduledPaymentRequest {
+  /**
+   * Required. Unique identifier of the scheduled payment to confirm.
+   * Values are of the form `sch_[a-zA-Z0-9]+`.
+   */
+  paymentId: string
+  /**
+   * Required. Time transaction occurred.
+   */
+  transactionDate: string
+  /**
+   * Required. Execution/posting date.
+   */
+  effectiveDate: string
+  /**
+   * Required. Actual amount cleared in local currency cents.
+   */
+  actualAmount: string
+  /**
+   * Optional. Narration notes.
+   */
+  description?: string
+  /**
+   * Optional. Financial account identifier used to settle the payment.
+   * When provided, the account balance will be updated accordingly.
+   * Values are of the form `acc_[a-zA-Z0-9]+`.
+   */
+  accountId?: string
+  /**
+   * Optional. Budget category identifier to allocate the cleared transaction under.
+   * If omitted, defaults to the budget category configured on the scheduled payment.
+   * Values are of the form `bud_[a-zA-Z0-9]+`.
+   */
+  budgetId?: string
+  /**
+   * Optional. Settlement currency code (ISO 4217 standard 3-letter code).
+   * If omitted, defaults to the currency configured on the scheduled payment.
+   */
+  currency?: string
+}
+
+/**
+ * The request for [MatchScheduledPayment][saturn.finance.v1.Finance.MatchScheduledPayment].
+ */
+export interface MatchScheduledPaymentRequest {
+  /**
+   * Required. Target scheduled payment identifier.
+   * Values are of the form `sch_[a-zA-Z0-9]+`.
+   */
+  paymentId: string
+  /**
+   * Required. Existing transaction identifier to match and link.
+   * Values are of the form `txn_[a-zA-Z0-9]+`.
+   */
+  transactionId: string
+}
+
+/**
+ * The request for [SkipScheduledPayment][saturn.finance.v1.Finance.SkipScheduledPayment].
+ */
+export interface SkipScheduledPaymentRequest {
+  /**
+   * Required. Identifier of the scheduled payment instance to skip.
+   * Values are of the form `sch_[a-zA-Z0-9]+`.
+   */
+  id: string
+}
+
+/**
+ * Borrowing represents a personal lent/borrowed debt agreement.
+ */
+export interface Borrowing {
+  /**
+   * Output only. Unique identifier.
+   * Values are of the form `bor_[a-zA-Z0-9]+`.
+   */
+  id?: string
+  /**
+   * Output only. Space identifier.
+   */
+  spaceId?: string
+  /**
+   * Required. Direction flow of the debt.
+   */
+  direction: Borrowing_Direction
+  /**
+   * Required. Name of the counterparty entity.
+   */
+  counterparty: string
+  /**
+   * Optional. Contact information.
+   */
+  contactInfo: string
+  /**
+   * Required. Initial loan principal amount in cents.
+   */
+  totalAmount: string
+  /**
+   * Output only. Remaining balance amount in cents.
+   */
+  remainingAmount?: string
+  /**
+   * Required. Currency code.
+   */
+  currency: string
+  /**
+   * Required. Lifecycle status.
+   */
+  status: Borrowing_Status
+  /**
+   * Required. Date established.
+   */
+  establishedAt: string
+  /**
+   * Optional. Target repayment due date.
+   */
+  dueAt: string
+  /**
+   * Optional. Automatically create a parallel transaction entry in the ledger.
+   */
+  createAsTransaction: boolean
+  /**
+   * Optional. Narration notes.
+   */
+  notes: string
+  /**
+   * Output only. Creation timestamp.
+   */
+  createTime?: string
+  /**
+   * Output only. Last update timestamp.
+   */
+  updateTime?: string
+  /**
+   * Optional. Source account identifier for transaction creation.
+   * Values are of the form `acc_[a-zA-Z0-9]+`.
+   */
+  accountId?: string
+  /**
+   * Optional. How interest is charged.
+   */
+  interestType: Borrowing_InterestType
+  /**
+   * Optional. Annual percentage rate in basis points (525 = 5.25%).
+   */
+  interestRateBps: number
+  /**
+   * Optional. Number of instalments. Zero means the borrowing is repaid ad hoc.
+   */
+  installmentCount: number
+  /**
+   * Optional. Spacing between instalments. Required when installment_count is set.
+   */
+  installmentFrequency: Borrowing_InstallmentFrequency
+  /**
+   * Optional. Due date of the first instalment. Defaults to one period after established_at.
+   */
+  firstInstallmentDate: string
+  /**
+   * Optional. Budget charged by the instalment scheduled payments. Required for borrowed
+   * loans with an instalment plan.
+   * Values are of the form `bud_[a-zA-Z0-9]+`.
+   */
+  budgetId?: string
+  /**
+   * Output only. Total repaid so far in cents, interest included.
+   */
+  paidAmount?: string
+  /**
+   * Output only. Amortization table. Populated by GetBorrowing for instalment plans.
+   */
+  installments?: BorrowingInstallment[]
+  /**
+   * Output only. Principal plus all scheduled interest in cents.
+   */
+  totalPayable?: string
+  /**
+   * Output only. Interest charged over the whole plan in cents.
+   */
+  totalInterest?: string
+  /**
+   * Output only. Interest accrued to date in cents, including the running period pro rata.
+   */
+  accruedInterest?: string
+  /**
+   * Output only. Interest repaid so far in cents.
+   */
+  interestPaid?: string
+  /**
+   * Output only. Number of instalments past their due date and not fully repaid.
+   */
+  overdueInstallmentCount?: number
+  /**
+   * Output only. Unpaid amount of the overdue instalments in cents.
+   */
+  overdueAmount?: string
+  /**
+   * Output only. The next instalment that is not yet paid or overdue.
+   */
+  nextInstallment?: BorrowingInstallment
+}
+
+/**
+ * BorrowingInstallment is one row of a borrowing's amortization table.
+ */
+export interface BorrowingInstallment {
+  /**
+   * Output only. 1-based position in the plan.
+   */
+  number?: number
+  /**
+   * Output only. Due date.
+   */
+  dueDate?: string
+  /**
+   * Output only. Instalment amount in cents; principal plus interest.
+   */
+  payment?: string
+  /**
+   * Output only. Principal share in cents.
+   */
+  principal?: string
+  /**
+   * Output only. Interest share in cents.
+   */
+  interest?: string
+  /**
+   * Output only. Principal still owed after this instalment in cents.
+   */
+  remainingBalance?: string
+  /**
+   * Output only. Portion of the payment covered by repayments in cents.
+   */
+  paidAmount?: string
+  /**
+   * Output only. Repayment state.
+   */
+  status?: BorrowingInstallment_Status
+}
+
+/**
+ * BorrowingRepayment represents an installment payment towards a borrowing.
+ */
+export interface BorrowingRepayment {
+  /**
+   * Output only. Unique identifier.
+   * Values are of the form `rep_[a-zA-Z0-9]+`.
+   */
+  id?: string
+  /**
+   * Required. Parent borrowing identifier.
+   * Values are of the form `bor_[a-zA-Z0-9]+`.
+   */
+  borrowingId: string
+  /**
+   * Output only. Space identifier.
+   */
+  spaceId?: string
+  /**
+   * Required. Installment amount in cents.
+   */
+  amount: string
+  /**
+   * Required. Installment payment date.
+   */
+  paymentDate: string
+  /**
+   * Optional. Narration notes.
+   */
+  notes: string
+  /**
+   * Output only. Creation timestamp.
+   */
+  createTime?: string
+  /**
+   * Output only. Last update timestamp.
+   */
+  updateTime?: string
+  /**
+   * Required. Source account identifier.
+   * Values are of the form `acc_[a-zA-Z0-9]+`.
+   */
+  accountId: string
+}
+
+/**
+ * The request for
+ * [CreateBorrowing][saturn.finance.v1.Finance.CreateBorrowing].
+ */
+export interface CreateBorrowingRequest {
+  /**
+   * Required. Target borrowing parameters.
+   */
+  borrowing: Borrowing
+}
+
+/**
+ * The request for
+ * [GetBorrowing][saturn.finance.v1.Finance.GetBorrowing].
+ */
+export interface GetBorrowingRequest {
+  /**
+   * Required. ID of the borrowing record to retrieve.
+   * Values are of the form `bor_[a-zA-Z0-9]+`.
+   */
+  id: string
+}
+
+/**
+ * The request for
+ * [ListBorrowings][saturn.finance.v1.Finance.ListBorrowings].
+ */
+export interface ListBorrowingsRequest {
+  /**
+   * Optional. Filter by debt status.
+   */
+  status?: Borrowing_Status
+  /**
+   * Optional. Filter by debt flow direction.
+   */
+  direction?: Borrowing_Direction
+  /**
+   * Optional. Maximum number of items to return.
+   */
+  pageSize?: number
+  /**
+   * Optional. Keyset page token.
+   */
+  pageToken?: string
+  /**
+   * Optional. Sort order for results.
+   */
+  orderBy?: string
+}
+
+/**
+ * The response for
+ * [ListBorrowings][saturn.finance.v1.Finance.ListBorrowings].
+ */
+export interface ListBorrowingsResponse {
+  /**
+   * List of borrowing records matching filters.
+   */
+  borrowings: Borrowing[]
+  /**
+   * Next page keyset token. Empty if no more pages are available.
+   */
+  nextPageToken: string
+}
+
+/**
+ * The request for
+ * [UpdateBorrowing][saturn.finance.v1.Finance.UpdateBorrowing].
+ */
+export interface UpdateBorrowingRequest {
+  /**
+   * Required. Unique identifier of the borrowing record to update.
+   * Values are of the form `bor_[a-zA-Z0-9]+`.
+   */
+  id: string
+  /**
+   * Required. Updated borrowing parameters.
+   */
+  borrowing: Borrowing
+}
+
+/**
+ * The request for
+ * [DeleteBorrowing][saturn.finance.v1.Finance.DeleteBorrowing].
+ */
+export interface DeleteBorrowingRequest {
+  /**
+   * Required. Unique identifier of the borrowing record to delete.
+   * Values are of the form `bor_[a-zA-Z0-9]+`.
+   */
+  id: string
+}
+
+/**
+ * The request for
+ * [CreateBorrowingRepayment][saturn.finance.v1.Finance.CreateBorrowingRepayment].
+ */
+export interface CreateBorrowingRepaymentRequest {
+  /**
+   * Required. Target parent borrowing identifier.
+   * Values are of the form `bor_[a-zA-Z0-9]+`.
+   */
+  borrowingId: string
+  /**
+   * Required. Updated repayment parameters.
+   */
+  repayment: BorrowingRepayment
+}
+
+/**
+ * The request for
+ * [ListBorrowingRepayments][saturn.finance.v1.Finance.ListBorrowingRepayments].
+ */
+export interface ListBorrowingRepaymentsRequest {
+  /**
+   * Required. Target parent borrowing identifier.
+   * Values are of the form `bor_[a-zA-Z0-9]+`.
+   */
+  borrowingId: string
+}
+
+/**
+ * The response for
+ * [ListBorrowingRepayments][saturn.finance.v1.Finance.ListBorrowingRepayments].
+ */
+export interface ListBorrowingRepaymentsResponse {
+  /**
+   * List of installment repayments.
+   */
+  repayments: BorrowingRepayment[]
+}
+
+/**
+ * The request for
+ * [DeleteBorrowingRepayment][saturn.finance.v1.Finance.DeleteBorrowingRepayment].
+ */
+export interface DeleteBorrowingRepaymentRequest {
+  /**
+   * Required. Target parent borrowing identifier.
+   * Values are of the form `bor_[a-zA-Z0-9]+`.
+   */
+  borrowingId: string
+  /**
+   * Required. Unique identifier of the installment repayment to delete.
+   * Values are of the form `rep_[a-zA-Z0-9]+`.
+   */
+  id: string
+}
+
+/**
+ * CurrencyInfo encapsulates currency code metadata.
+ */
+export interface CurrencyInfo {
+  /**
+   * Required. ISO currency code (e.g. USD).
+   */
+  code: string
+  /**
+   * Required. User-friendly currency name.
+   */
+  name: string
+  /**
+   * Output only. Display symbol (e.g. "$", "¥"). Falls back to the ISO code.
+   */
+  symbol?: string
+  /**
+   * Output only. Number of decimal places in the minor unit (ISO 4217 exponent).
+   * All amounts in this currency are expressed as integers of 10^-minor_units.
+   */
+  minorUnits?: number
+  /**
+   * Output only. Whether the currency is enabled in the current workspace.
+   */
+  enabled?: boolean
+}
+
+/**
+ * The request for
+ * [ListCurrencies][saturn.finance.v1.Finance.ListCurrencies].
+ */
+export type ListCurrenciesRequest = Record<string, never>
+
+/**
+ * The response for
+ * [ListCurrencies][saturn.finance.v1.Finance.ListCurrencies].
+ */
+export interface ListCurrenciesResponse {
+  /**
+   * List of currency codes and details.
+   */
+  currencies: CurrencyInfo[]
+}
+
+/**
+ * Account represents a physical or digital payment account.
+ */
+export interface Account {
+  /**
+   * Output only. Unique identifier of the account.
+   * Values are of the form `acc_[a-zA-Z0-9]+`.
+   */
+  id?: string
+  /**
+   * Output only. Space identifier.
+   */
+  spaceId?: string
+  /**
+   * Required. User-friendly account name (e.g. "Chase Checking").
+   */
+  name: string
+  /**
+   * Required. Account classification type.
+   */
+  type: Account_Type
+  /**
+   * Required. Account currency (e.g. "USD").
+   */
+  currency: string
+  /**
+   * Required. Initial balance amount in cents (e.g. 500000 for $5,000.00).
+   */
+  initialBalance: string
+  /**
+   * Output only. Computed current balance amount in cents.
+   * Calculated dynamically as `InitialBalance + Sum(Income/TransferIn) - Sum(Expense/TransferOut)`.
+   */
+  currentBalance?: string
+  /**
+   * Optional. Credit limit amount in cents (applicable only if CREDIT_CARD type).
+   */
+  creditLimit: string
+  /**
+   * Optional. Indicates if the account is the workspace default. Default accounts receive
+   * transactions if no explicit account is nominated.
+   */
+  isDefault: boolean
+  /**
+   * Optional. Indicates if the account is active.
+   */
+  isActive: boolean
+  /**
+   * Optional. Color HEX code for visual UI identification.
+   */
+  color: string
+  /**
+   * Optional. Narration notes.
+   */
+  notes: string
+  /**
+   * Optional. Last four digits of card or account number.
+   */
+  lastFour: string
+  /**
+   * Output only. Creation timestamp.
+   */
+  createTime?: string
+  /**
+   * Output only. Last update timestamp.
+   */
+  updateTime?: string
+  /**
+   * Output only. Currency conversion statistics.
+   */
+  conversion?: Account_Conversion
+  /**
+   * Optional. Day of the month the card statement closes (1-31, clamped to short months).
+   * Zero disables statements. Applicable only if CREDIT_CARD type.
+   */
+  statementClosingDay: number
+  /**
+   * Optional. Day of the month the statement payment is due, after the closing day.
+   * Required when `statement_closing_day` is set.
+   */
+  paymentDueDay: number
+  /**
+   * Optional. Minimum payment as basis points of the statement balance (e.g. 300 for 3%).
+   */
+  minimumPaymentBps: number
+  /**
+   * Optional. Lowest minimum payment in cents.
+   */
+  minimumPaymentFloor: string
+  /**
+   * Optional. Budget the scheduled statement payment is drawn from.
+   * Required when `statement_closing_day` is set.
+   */
+  paymentBudgetId: string
+  /**
+   * Output only. Current balance as a percentage of the credit limit.
+   */
+  utilization?: number
+  /**
+   * Optional. Cash flow forecasts flag days where the balance, or the available credit of a
+   * credit card, drops below this amount in cents. Zero disables the check.
+   */
+  lowBalanceThreshold: string
+}
+
+/**
+ * Conversion contains hydrated conversion metrics relative to the workspace base currency.
+ */
+export interface Account_Conversion {
+  /**
+   * Output only. Converted balance in base currency cents.
+   */
+  balance?: string
+  /**
+   * Output only. Exchange rate multiplier used.
+   */
+  rate?: number
+}
+
+/**
+ * The request for
+ * [CreateAccount][saturn.finance.v1.Finance.CreateAccount].
+ */
+export interface CreateAccountRequest {
+  /**
+   * Required. Account configuration parameters.
+   */
+  account: Account
+}
+
+/**
+ * The request for
+ * [GetAccount][saturn.finance.v1.Finance.GetAccount].
+ */
+export interface GetAccountRequest {
+  /**
+   * Required. Target account identifier.
+   * Values are of the form `acc_[a-zA-Z0-9]+`.
+   */
+  id: string
+  /**
+   * Optional. Account view details level.
+   */
+  view?: Account_View
+}
+
+/**
+ * The request for
+ * [UpdateAccount][saturn.finance.v1.Finance.UpdateAccount].
+ */
+export interface UpdateAccountRequest {
+  /**
+   * Required. Target account identifier to update.
+   * Values are of the form `acc_[a-zA-Z0-9]+`.
+   */
+  id: string
+  /**
+   * Required. Updated account parameters.
+   */
+  account: Account
+}
+
+/**
+ * The request for
+ * [AdjustAccountBalance][saturn.finance.v1.Finance.AdjustAccountBalance].
+ */
+export interface AdjustAccountBalanceRequest {
+  /**
+   * Required. Target account identifier to adjust balance for.
+   */
+  accountId: string
+  /**
+   * Required. Target balance in cents.
+   */
+  targetBalance: string
+  /**
+   * Optional. ISO timestamp of the adjustment date.
+   */
+  adjustmentDate?: string
+  /**
+   * Optional. Reason or note for reconciliation.
+   */
+  note?: string
+}
+
+/**
+ * Reconciliation is a session matching an account's cleared transactions against a bank statement.
+ */
+export interface Reconciliation {
+  /**
+   * Output only. Unique identifier.
+   * Values are of the form `rcn_[a-zA-Z0-9]+`.
+   */
+  id?: string
+  /**
+   * Output only. Reconciled account identifier.
+   * Values are of the form `acc_[a-zA-Z0-9]+`.
+   */
+  accountId?: string
+  /**
+   * Required. Statement end date. Transactions dated on or before this day are covered.
+   */
+  statementDate: string
+  /**
+   * Required. Closing balance printed on the statement, in account currency cents.
+   */
+  statementBalance: string
+  /**
+   * Output only. Statement balance of the previous finalized session, or the account
+   * initial balance for the first session.
+   */
+  openingBalance?: string
+  /**
+   * Output only. Opening balance plus the impact of cleared transactions.
+   */
+  clearedBalance?: string
+  /**
+   * Output only. Remaining difference between the statement and cleared balances.
+   */
+  difference?: string
+  /**
+   * Output only. Number of cleared transactions covered by the session.
+   */
+  clearedCount?: number
+  /**
+   * Output only. Balance adjustment booked to close a difference on finalization.
+   * Values are of the form `txn_[a-zA-Z0-9]+`.
+   */
+  adjustmentTransactionId?: string
+  /**
+   * Output only. Session status.
+   */
+  status?: Reconciliation_Status
+  /**
+   * Optional. Narration notes.
+   */
+  note: string
+  /**
+   * Output only. Finalization timestamp.
+   */
+  finalizeTime?: string
+  /**
+   * Output only. Creation timestamp.
+   */
+  createTime?: string
+  /**
+   * Output only. Last update timestamp.
+   */
+  updateTime?: string
+}
+
+/**
+ * The request for
+ * [StartReconciliation][saturn.finance.v1.Finance.StartReconciliation].
+ */
+export interface StartReconciliationRequest {
+  /**
+   * Required. Account to reconcile.
+   * Values are of the form `acc_[a-zA-Z0-9]+`.
+   */
+  accountId: string
+  /**
+   * Required. Statement details.
+   */
+  reconciliation: Reconciliation
+}
+
+/**
+ * The request for
+ * [GetReconciliation][saturn.finance.v1.Finance.GetReconciliation].
+ */
+export interface GetReconciliationRequest {
+  /**
+   * Required. Reconciliation identifier.
+   * Values are of the form `rcn_[a-zA-Z0-9]+`.
+   */
+  id: string
+}
+
+/**
+ * The request for
+ * [ListReconciliations][saturn.finance.v1.Finance.ListReconciliations].
+ */
+export interface ListReconciliationsRequest {
+  /**
+   * Required. Account identifier.
+   * Values are of the form `acc_[a-zA-Z0-9]+`.
+   */
+  accountId: string
+}
+
+/**
+ * The response for
+ * [ListReconciliations][saturn.finance.v1.Finance.ListReconciliations].
+ */
+export interface ListReconciliationsResponse {
+  /**
+   * Reconciliation sessions, most recent statement first.
+   */
+  reconciliations: Reconciliation[]
+}
+
+/**
+ * The request for
+ * [ListReconciliationTransactions][saturn.finance.v1.Finance.ListReconciliationTransactions].
+ */
+export interface ListReconciliationTransactionsRequest {
+  /**
+   * Required. Reconciliation identifier.
+   * Values are of the form `rcn_[a-zA-Z0-9]+`.
+   */
+  id: string
+}
+
+/**
+ * The response for
+ * [ListReconciliationTransactions][saturn.finance.v1.Finance.ListReconciliationTransactions].
+ */
+export interface ListReconciliationTransactionsResponse {
+  /**
+   * Unreconciled transactions up to the statement date for sessions in progress,
+   * or the locked transactions of a finalized session.
+   */
+  transactions: Transaction[]
+}
+
+/**
+ * The request for
+ * [SetTransactionsCleared][saturn.finance.v1.Finance.SetTransactionsCleared].
+ */
+export interface SetTransactionsClearedRequest {
+  /**
+   * Required. Reconciliation identifier.
+   * Values are of the form `rcn_[a-zA-Z0-9]+`.
+   */
+  reconciliationId: string
+  /**
+   * Required. Transactions to update. They must belong to the reconciled account.
+   */
+  transactionIds: string[]
+  /**
+   * Required. Whether the transactions are cleared.
+   */
+  cleared: boolean
+}
+
+/**
+ * The request for
+ * [FinalizeReconciliation][saturn.finance.v1.Finance.FinalizeReconciliation].
+ */
+export interface FinalizeReconciliationRequest {
+  /**
+   * Required. Reconciliation identifier.
+   * Values are of the form `rcn_[a-zA-Z0-9]+`.
+   */
+  id: string
+  /**
+   * Optional. Books a remaining difference as a balance adjustment dated on the
+   * statement date instead of rejecting the finalization.
+   */
+  createAdjustment: boolean
+}
+
+/**
+ * The request for
+ * [CancelReconciliation][saturn.finance.v1.Finance.CancelReconciliation].
+ */
+export interface CancelReconciliationRequest {
+  /**
+   * Required. Reconciliation identifier.
+   * Values are of the form `rcn_[a-zA-Z0-9]+`.
+   */
+  id: string
+}
+
+/**
+ * The request for
+ * [DeleteAccount][saturn.finance.v1.Finance.DeleteAccount].
+ */
+export interface DeleteAccountRequest {
+  /**
+   * Required. Target account identifier to delete.
+   * Values are of the form `acc_[a-zA-Z0-9]+`.
+   */
+  id: string
+}
+
+/**
+ * The request for
+ * [ListAccounts][saturn.finance.v1.Finance.ListAccounts].
+ */
+export interface ListAccountsRequest {
+  /**
+   * Optional. Account view details level.
+   */
+  view?: Account_View
+  /**
+   * Optional. Filter only active accounts.
+   */
+  activeOnly?: boolean
+  /**
+   * Optional. Search text filter matching account name or notes.
+   */
+  searchQuery?: string
+  /**
+   * Optional. Maximum number of items to return in a single page.
+   */
+  pageSize?: number
+  /**
+   * Optional. Keyset page token returned by a previous call.
+   */
+  pageToken?: string
+  /**
+   * Optional. Sort order string specifying column and optional ordering suffix.
+   */
+  sort?: string
+}
+
+/**
+ * The response for
+ * [ListAccounts][saturn.finance.v1.Finance.ListAccounts].
+ */
+export interface ListAccountsResponse {
+  /**
+   * List of accounts matching filters.
+   */
+  accounts: Account[]
+  /**
+   * Next page keyset token. Empty if no more pages are available.
+   */
+  nextPageToken: string
+}
+
+/**
+ * Transfer represents a new transfer between accounts.
+ */
+export interface Transfer {
+  /**
+   * Output only. Unique identifier.
+   * Values are of the form `txn_[a-zA-Z0-9]+`.
+   */
+  id?: string
+  /**
+   * Output only. Space identifier.
+   */
+  spaceId?: string
+  /**
+   * Required. Source account ID.
+   * Values are of the form `acc_[a-zA-Z0-9]+`.
+   */
+  sourceAccountId: string
+  /**
+   * Required. Destination account ID.
+   * Values are of the form `acc_[a-zA-Z0-9]+`.
+   */
+  destinationAccountId: string
+  /**
+   * Required. Source amount in source account currency cents.
+   */
+  sourceAmount: string
+  /**
+   * Required. Destination amount in destination account currency cents.
+   */
+  destinationAmount: string
+  /**
+   * Required. Transfer date.
+   */
+  transferDate: string
+  /**
+   * Optional. Narration notes.
+   */
+  notes: string
+  /**
+   * Output only. Creation timestamp.
+   */
+  createTime?: string
+  /**
+   * Output only. Last update timestamp.
+   */
+  updateTime?: string
+}
+
+/**
+ * The request for
+ * [CreateTransfer][saturn.finance.v1.Finance.CreateTransfer].
+ */
+export interface CreateTransferRequest {
+  /**
+   * Required. Source account ID.
+   * Values are of the form `acc_[a-zA-Z0-9]+`.
+   */
+  sourceAccountId: string
+  /**
+   * Required. Destination account ID.
+   * Values are of the form `acc_[a-zA-Z0-9]+`.
+   */
+  destinationAccountId: string
+  /**
+   * Required. Source amount in source account currency cents.
+   */
+  sourceAmount: string
+  /**
+   * Required. Destination amount in destination account currency cents.
+   */
+  destinationAmount: string
+  /**
+   * Required. Transfer date.
+   */
+  transferDate: string
+  /**
+   * Optional. Narration notes.
+   */
+  notes: string
+}
+
+/**
+ * The request for
+ * [ListTransfers][saturn.finance.v1.Finance.ListTransfers].
+ */
+export interface ListTransfersRequest {
+  /**
+   * Optional. Maximum number of items to return.
+   */
+  pageSize: number
+  /**
+   * Optional. Keyset page token.
+   */
+  pageToken: string
+}
+
+/**
+ * The response for
+ * [ListTransfers][saturn.finance.v1.Finance.ListTransfers].
+ */
+export interface ListTransfersResponse {
+  /**
+   * List of transfer records.
+   */
+  transfers: Transfer[]
+  /**
+   * Next page keyset token. Empty if no more pages are available.
+   */
+  nextPageToken: string
+}
+
+/**
+ * The request for
+ * [ListTransactionEvents][saturn.finance.v1.Finance.ListTransactionEvents].
+ */
+export interface ListTransactionEventsRequest {
+  /**
+   * Required. Target transaction ID.
+   * Values are of the form `txn_[a-zA-Z0-9]+`.
+   */
+  txnId: string
+}
+
+/**
+ * TransactionEvent represents a historical transaction lifecycle change.
+ */
+export interface TransactionEvent {
+  /**
+   * Output only. Unique identifier.
+   * Values are of the form `evt_[a-zA-Z0-9]+`.
+   */
+  id?: string
+  /**
+   * Output only. Space identifier.
+   */
+  spaceId?: string
+  /**
+   * Required. Associated transaction ID.
+   * Values are of the form `txn_[a-zA-Z0-9]+`.
+   */
+  txnId: string
+  /**
+   * Required. Lifecycle change event type (e.g. "created", "updated").
+   */
+  eventType: string
+  /**
+   * Required. JSON metadata describing the change details (e.g. diff properties).
+   */
+  metadata: string
+  /**
+   * Output only. Event registration timestamp.
+   */
+  createTime?: string
+}
+
+/**
+ * The response for
+ * [ListTransactionEvents][saturn.finance.v1.Finance.ListTransactionEvents].
+ */
+export interface ListTransactionEventsResponse {
+  /**
+   * List of transaction lifecycle history events.
+   */
+  events: TransactionEvent[]
+}
+
+/**
+ * InboxItem represents an ingested invoice, receipt, or notification in processing staging.
+ */
+export interface InboxItem {
+  /**
+   * Output only. Unique identifier.
+   * Values are of the form `inb_[a-zA-Z0-9]+`.
+   */
+  id?: string
+  /**
+   * Output only. Space identifier.
+   */
+  spaceId?: string
+  /**
+   * Output only. Ingestion integration channel identifier.
+   */
+  integrationId?: string
+  /**
+   * Output only. Staging lifecycle status.
+   */
+  status?: InboxItem_Status
+  /**
+   * Required. Extracted document classification category.
+   */
+  docType: InboxItem_DocType
+  /**
+   * Required. Extracted transaction amount in cents.
+   */
+  amount: string
+  /**
+   * Required. Extracted currency code.
+   */
+  currency: string
+  /**
+   * Required. Extracted vendor counterparty name.
+   */
+  vendorName: string
+  /**
+   * Required. Extracted transaction transaction date.
+   */
+  transactionDate: string
+  /**
+   * Optional. Suggested account ID for categorization.
+   * Values are of the form `acc_[a-zA-Z0-9]+`.
+   */
+  accountId: string
+  /**
+   * Optional. Suggested budget category ID.
+   * Values are of the form `bud_[a-zA-Z0-9]+`.
+   */
+  budgetId: string
+  /**
+   * Optional. Associated scheduled payment identifier to clear.
+   * Values are of the form `sch_[a-zA-Z0-9]+`.
+   */
+  scheduledPaymentId: string
+  /**
+   * Optional. Associated completed transaction ledger ID.
+   * Values are of the form `txn_[a-zA-Z0-9]+`.
+   */
+  transactionId: string
+  /**
+   * Output only. Ingestion raw notification payload details.
+   */
+  rawPayload?: string
+  /**
+   * Optional. Additional extracted metadata payload key-value pairs.
+   */
+  metadata: Record<string, string>
+  /**
+   * Output only. Ingestion stage timestamp.
+   */
+  createTime?: string
+  /**
+   * Optional. Associated borrowing/lending agreement identifier.
+   * Values are of the form `bor_[a-zA-Z0-9]+`.
+   */
+  borrowingId?: string
+  /**
+   * Optional. Relationship classification for borrowing link (e.g., initial receipt, repayment, additional loan).
+   */
+  borrowingLinkType?: BorrowingLinkType
+  /**
+   * Optional. Suggested split lines. When present, approving the item creates
+   * a split expense instead of charging a single budget.
+   */
+  splits: TransactionSplit[]
+  /**
+   * Output only. Origin of the item, e.g. `integration` or `statement_ofx`.
+   */
+  source?: string
+  /**
+   * Output only. Stable identifier of the statement row the item was imported from.
+   */
+  externalId?: string
+  /**
+   * Optional. Payee matched by the space's rules and payees.
+   * Values are of the form `pye_[a-zA-Z0-9]+`.
+   */
+  payeeId?: string
+}
+
+/**
+ * The request for
+ * [ListInboxItems][saturn.finance.v1.Finance.ListInboxItems].
+ */
+export interface ListInboxItemsRequest {
+  /**
+   * Optional. Number of items to retrieve per page.
+   */
+  pageSize: number
+  /**
+   * Optional. Cursor token from previous page response.
+   */
+  pageToken: string
+  /**
+   * Optional. Free-text search query matching vendor names or classifications.
+   */
+  searchQuery?: string
+  /**
+   * Optional. Stage status to filter by.
+   */
+  status?: InboxItem_Status
+  /**
+   * Optional. Document category classification filter.
+   */
+  docType?: InboxItem_DocType
+  /**
+   * Optional. Sorting rules (e.g. "create_time:desc").
+   */
+  sort: string
+  /**
+   * Optional. Representation view.
+   */
+  view: InboxItem_View
+}
+
+/**
+ * The response for
+ * [ListInboxItems][saturn.finance.v1.Finance.ListInboxItems].
+ */
+export interface ListInboxItemsResponse {
+  /**
+   * List of staged inbox items.
+   */
+  inboxItems: InboxItem[]
+  /**
+   * Cursor page token for retrieving the next page.
+   */
+  nextPageToken: string
+}
+
+/**
+ * The request for
+ * [UpdateInboxItem][saturn.finance.v1.Finance.UpdateInboxItem].
+ */
+export interface UpdateInboxItemRequest {
+  /**
+   * Required. Target staged inbox item identifier to update.
+   * Values are of the form `inb_[a-zA-Z0-9]+`.
+   */
+  id: string
+  /**
+   * Required. Updated staging inbox item parameters.
+   */
+  inboxItem: InboxItem
+}
+
+/**
+ * The request for
+ * [ApproveInboxItem][saturn.finance.v1.Finance.ApproveInboxItem].
+ */
+export interface ApproveInboxItemRequest {
+  /**
+   * Required. Target staged inbox item identifier to approve.
+   * Values are of the form `inb_[a-zA-Z0-9]+`.
+   */
+  id: string
+}
+
+/**
+ * The request for
+ * [DiscardInboxItem][saturn.finance.v1.Finance.DiscardInboxItem].
+ */
+export interface DiscardInboxItemRequest {
+  /**
+   * Required. Target staged inbox item identifier to discard.
+   * Values are of the form `inb_[a-zA-Z0-9]+`.
+   */
+  id: string
+}
+
+/**
+ * StatementMapping describes how the columns of an account's CSV exports map onto
+ * statement rows. Columns are referenced by header name when the file has a header
+ * row, or by zero-based index otherwise.
+ */
+export interface StatementMapping {
+  /**
+   * Output only. Account the mapping belongs to.
+   * Values are of the form `acc_[a-zA-Z0-9]+`.
+   */
+  accountId?: string
+  /**
+   * Optional. Field delimiter. Defaults to `,`.
+   */
+  delimiter: string
+  /**
+   * Optional. Whether the first row holds column names.
+   */
+  hasHeader: boolean
+  /**
+   * Required. Column holding the booking date.
+   */
+  dateColumn: string
+  /**
+   * Optional. Go reference layout of the date column. Defaults to `2006-01-02`.
+   */
+  dateFormat: string
+  /**
+   * Optional. Column holding a signed amount. Mutually exclusive with
+   * `debit_column` and `credit_column`.
+   */
+  amountColumn: string
+  /**
+   * Optional. Column holding outflow amounts.
+   */
+  debitColumn: string
+  /**
+   * Optional. Column holding inflow amounts.
+   */
+  creditColumn: string
+  /**
+   * Required. Column holding the payee or narration.
+   */
+  descriptionColumn: string
+  /**
+   * Optional. Column holding the bank reference used to skip re-imported rows.
+   */
+  referenceColumn: string
+  /**
+   * Optional. Column holding the row currency. Defaults to the account currency.
+   */
+  currencyColumn: string
+  /**
+   * Optional. Decimal separator, `.` or `,`. Defaults to `.`.
+   */
+  decimalSeparator: string
+  /**
+   * Optional. Flip amount signs, for exports that list purchases as positive amounts.
+   */
+  invertAmounts: boolean
+  /**
+   * Output only. Last time the mapping was saved.
+   */
+  updateTime?: string
+}
+
+/**
+ * The request for
+ * [ImportStatement][saturn.finance.v1.Finance.ImportStatement].
+ */
+export interface ImportStatementRequest {
+  /**
+   * Required. Account the statement belongs to.
+   * Values are of the form `acc_[a-zA-Z0-9]+`.
+   */
+  accountId: string
+  /**
+   * Required. File format of `content`.
+   */
+  format: StatementFormat
+  /**
+   * Required. Raw statement file content.
+   */
+  content: string
+  /**
+   * Optional. CSV column mapping. Falls back to the account's saved mapping when omitted.
+   */
+  csvMapping: StatementMapping
+  /**
+   * Optional. Save `csv_mapping` as the account's mapping for future imports.
+   */
+  saveMapping: boolean
+}
+
+/**
+ * The response for
+ * [ImportStatement][saturn.finance.v1.Finance.ImportStatement].
+ */
+export interface ImportStatementResponse {
+  /**
+   * Inbox items staged from the statement rows.
+   */
+  inboxItems: InboxItem[]
+  /**
+   * Number of rows skipped because a previous import already staged them.
+   */
+  skippedCount: number
+  /**
+   * Number of staged rows flagged as potential duplicates of ledger transactions.
+   */
+  duplicateCount: number
+}
+
+/**
+ * The request for
+ * [GetStatementMapping][saturn.finance.v1.Finance.GetStatementMapping].
+ */
+export interface GetStatementMappingRequest {
+  /**
+   * Required. Account identifier.
+   * Values are of the form `acc_[a-zA-Z0-9]+`.
+   */
+  accountId: string
+}
+
+/**
+ * The request for
+ * [ExportLedger][saturn.finance.v1.Finance.ExportLedger].
+ */
+export interface ExportLedgerRequest {
+  /**
+   * Required. Output file format.
+   */
+  format: LedgerFormat
+  /**
+   * Required. First day to export, inclusive.
+   */
+  startDate: string
+  /**
+   * Required. Last day to export, inclusive.
+   */
+  endDate: string
+}
+
+/**
+ * A piece of an exported ledger file.
+ */
+export interface LedgerExportChunk {
+  /**
+   * MIME type of the file. Set on the first chunk only.
+   */
+  contentType: string
+  /**
+   * Suggested file name. Set on the first chunk only.
+   */
+  fileName: string
+  /**
+   * File content.
+   */
+  data: string
+}
+
+/**
+ * LedgerExport is a ledger export produced by a background job.
+ */
+export interface LedgerExport {
+  /**
+   * Output only. Unique identifier.
+   * Values are of the form `lex_[a-zA-Z0-9]+`.
+   */
+  id?: string
+  /**
+   * Output only. Space identifier.
+   */
+  spaceId?: string
+  /**
+   * Required. Output file format.
+   */
+  format: LedgerFormat
+  /**
+   * Required. First day to export, inclusive.
+   */
+  startDate: string
+  /**
+   * Required. Last day to export, inclusive.
+   */
+  endDate: string
+  /**
+   * Output only. Processing state.
+   */
+  status?: LedgerExport_Status
+  /**
+   * Output only. Suggested file name.
+   */
+  fileName?: string
+  /**
+   * Output only. MIME type of the file.
+   */
+  contentType?: string
+  /**
+   * Output only. File size in bytes, once completed.
+   */
+  sizeBytes?: string
+  /**
+   * Output only. Number of transactions exported, once completed.
+   */
+  entryCount?: string
+  /**
+   * Output only. Failure reason when the export failed.
+   */
+  error?: string
+  /**
+   * Output only. Creation timestamp.
+   */
+  createTime?: string
+  /**
+   * Output only. Last update timestamp.
+   */
+  updateTime?: string
+  /**
+   * Output only. Completion timestamp.
+   */
+  completeTime?: string
+}
+
+/**
+ * The request for
+ * [CreateLedgerExport][saturn.finance.v1.Finance.CreateLedgerExport].
+ */
+export interface CreateLedgerExportRequest {
+  /**
+   * Required. The export to queue.
+   */
+  ledgerExport: LedgerExport
+}
+
+/**
+ * The request for
+ * [GetLedgerExport][saturn.finance.v1.Finance.GetLedgerExport].
+ */
+export interface GetLedgerExportRequest {
+  /**
+   * Required. Unique identifier of the export.
+   * Values are of the form `lex_[a-zA-Z0-9]+`.
+   */
+  id: string
+}
+
+/**
+ * The request for
+ * [ListLedgerExports][saturn.finance.v1.Finance.ListLedgerExports].
+ */
+export interface ListLedgerExportsRequest {
+  /**
+   * Optional. Maximum number of items to return.
+   */
+  pageSize: number
+  /**
+   * Optional. Keyset page token.
+   */
+  pageToken: string
+}
+
+/**
+ * The response for
+ * [ListLedgerExports][saturn.finance.v1.Finance.ListLedgerExports].
+ */
+export interface ListLedgerExportsResponse {
+  /**
+   * List of exports in the space.
+   */
+  ledgerExports: LedgerExport[]
+  /**
+   * Next page keyset token. Empty if no more pages are available.
+   */
+  nextPageToken: string
+}
+
+/**
+ * The request for
+ * [DownloadLedgerExport][saturn.finance.v1.Finance.DownloadLedgerExport].
+ */
+export interface DownloadLedgerExportRequest {
+  /**
+   * Required. Unique identifier of a completed export.
+   * Values are of the form `lex_[a-zA-Z0-9]+`.
+   */
+  id: string
+}
+
+/**
+ * CardStatement is the snapshot of a credit card billing cycle taken on its closing date.
+ * Balances are debt owed, in cents of the card currency.
+ */
+export interface CardStatement {
+  /**
+   * Output only. Unique identifier of the statement.
+   * Values are of the form `cst_[a-zA-Z0-9]+`.
+   */
+  id?: string
+  /**
+   * Output only. Space identifier.
+   */
+  spaceId?: string
+  /**
+   * Output only. Credit card account identifier.
+   */
+  accountId?: string
+  /**
+   * Output only. Card currency.
+   */
+  currency?: string
+  /**
+   * Output only. First instant covered by the billing cycle.
+   */
+  periodStart?: string
+  /**
+   * Output only. Day the billing cycle closed.
+   */
+  closingDate?: string
+  /**
+   * Output only. Day the statement payment is due.
+   */
+  dueDate?: string
+  /**
+   * Output only. Balance owed when the cycle opened.
+   */
+  openingBalance?: string
+  /**
+   * Output only. Charges posted during the cycle.
+   */
+  purchases?: string
+  /**
+   * Output only. Payments and refunds posted during the cycle.
+   */
+  credits?: string
+  /**
+   * Output only. Balance owed at the closing date.
+   */
+  statementBalance?: string
+  /**
+   * Output only. Minimum payment due by the due date.
+   */
+  minimumPayment?: string
+  /**
+   * Output only. Payments applied to the statement since it closed.
+   */
+  paidAmount?: string
+  /**
+   * Output only. Credit limit at the closing date.
+   */
+  creditLimit?: string
+  /**
+   * Output only. Statement balance as a percentage of the credit limit.
+   */
+  utilization?: number
+  /**
+   * Output only. Payment status.
+   */
+  status?: CardStatement_Status
+  /**
+   * Output only. Scheduled payment created for the statement balance. Empty when nothing was owed.
+   */
+  scheduledPaymentId?: string
+  /**
+   * Output only. Creation timestamp.
+   */
+  createTime?: string
+  /**
+   * Output only. Last update timestamp.
+   */
+  updateTime?: string
+}
+
+/**
+ * The request for
+ * [ListCardStatements][saturn.finance.v1.Finance.ListCardStatements].
+ */
+export interface ListCardStatementsRequest {
+  /**
+   * Required. Credit card account identifier.
+   * Values are of the form `acc_[a-zA-Z0-9]+`.
+   */
+  accountId: string
+  /**
+   * Optional. Maximum number of statements to return. Returns every statement when unset.
+   */
+  pageSize: number
+  /**
+   * Optional. Only return statements that are not fully paid.
+   */
+  unpaidOnly: boolean
+}
+
+/**
+ * The response for
+ * [ListCardStatements][saturn.finance.v1.Finance.ListCardStatements].
+ */
+export interface ListCardStatementsResponse {
+  /**
+   * Statements of the card, most recent closing date first.
+   */
+  cardStatements: CardStatement[]
+}
+
+/**
+ * The request for
+ * [GetCardStatement][saturn.finance.v1.Finance.GetCardStatement].
+ */
+export interface GetCardStatementRequest {
+  /**
+   * Required. Unique identifier of the statement.
+   * Values are of the form `cst_[a-zA-Z0-9]+`.
+   */
+  id: string
+}
+
+/**
+ * The request for
+ * [ForecastCashFlow][saturn.finance.v1.Finance.ForecastCashFlow].
+ */
+export interface ForecastCashFlowRequest {
+  /**
+   * Optional. Number of days to project, starting today in the space timezone. Defaults to 30, at most 365.
+   */
+  days: number
+  /**
+   * Optional. Restricts the response to these accounts. Returns every active account when empty.
+   */
+  accountIds: string[]
+}
+
+/**
+ * The response for
+ * [ForecastCashFlow][saturn.finance.v1.Finance.ForecastCashFlow].
+ */
+export interface ForecastCashFlowResponse {
+  /**
+   * First projected day.
+   */
+  startDate: string
+  /**
+   * Last projected day.
+   */
+  endDate: string
+  /**
+   * Day-by-day projection per account.
+   */
+  accounts: AccountForecast[]
+  /**
+   * Projected inflows and outflows, in date order.
+   */
+  events: ForecastEvent[]
+  /**
+   * Days where an account enters a low balance state, in date order.
+   */
+  alerts: ForecastAlert[]
+}
+
+/**
+ * AccountForecast is the day-by-day balance projection of one account.
+ */
+export interface AccountForecast {
+  /**
+   * Account identifier.
+   */
+  accountId: string
+  /**
+   * Account name.
+   */
+  accountName: string
+  /**
+   * Account currency.
+   */
+  currency: string
+  /**
+   * Balance in cents before the first projected day.
+   */
+  startingBalance: string
+  /**
+   * Lowest projected balance, or available credit for credit cards, in cents.
+   */
+  lowestAvailable: string
+  /**
+   * Day the lowest figure is first reached.
+   */
+  lowestDate: string
+  /**
+   * Configured low balance threshold in cents. Zero when disabled.
+   */
+  lowBalanceThreshold: string
+  /**
+   * Projected end-of-day positions, one per day.
+   */
+  days: ForecastDay[]
+}
+
+/**
+ * ForecastDay is an account's projected position at the end of a day.
+ */
+export interface ForecastDay {
+  /**
+   * Projected day.
+   */
+  date: string
+  /**
+   * Projected balance in cents. Debt owed for credit cards.
+   */
+  balance: string
+  /**
+   * Projected balance for asset accounts, remaining credit for credit cards.
+   */
+  available: string
+  /**
+   * Money entering the account during the day, in cents.
+   */
+  inflow: string
+  /**
+   * Money leaving the account during the day, in cents.
+   */
+  outflow: string
+}
+
+/**
+ * ForecastEvent is a projected inflow or outflow on one account.
+ */
+export interface ForecastEvent {
+  /**
+   * Projected day.
+   */
+  date: string
+  /**
+   * Account moved by the event. Empty when no account could be resolved.
+   */
+  accountId: string
+  /**
+   * Event kind.
+   */
+  kind: ForecastEvent_Kind
+  /**
+   * Identifier of the scheduled payment, recurring expense, borrowing or income source.
+   */
+  sourceId: string
+  /**
+   * Human readable description.
+   */
+  description: string
+  /**
+   * Signed amount in cents of the account currency. Negative amounts leave the account.
+   */
+  amount: string
+  /**
+   * Whether the amount is estimated from history rather than known.
+   */
+  estimated: boolean
+}
+
+/**
+ * ForecastAlert flags the first day an account enters a low balance state.
+ */
+export interface ForecastAlert {
+  /**
+   * Account identifier.
+   */
+  accountId: string
+  /**
+   * Day the state is entered.
+   */
+  date: string
+  /**
+   * Alert kind.
+   */
+  kind: ForecastAlert_Kind
+  /**
+   * Projected balance, or available credit, on that day in cents.
+   */
+  available: string
+  /**
+   * Account threshold in cents.
+   */
+  threshold: string
+}
+
+/**
+ * Alert is a finding of the alert rules evaluated whenever an expense is created or changed.
+ */
+export interface Alert {
+  /**
+   * Output only. Unique identifier of the alert.
+   * Values are of the form `alr_[a-zA-Z0-9]+`.
+   */
+  id?: string
+  /**
+   * Output only. Rule that raised the alert.
+   */
+  rule?: Alert_Rule
+  /**
+   * Output only. Current status.
+   */
+  status?: Alert_Status
+  /**
+   * Output only. Human readable description of the finding.
+   */
+  message?: string
+  /**
+   * Output only. Budget the alert concerns, when any.
+   */
+  budgetId?: string
+  /**
+   * Output only. Budget period the alert concerns, when any.
+   */
+  periodId?: string
+  /**
+   * Output only. Transaction whose change raised the alert.
+   */
+  transactionId?: string
+  /**
+   * Output only. Earlier charge a duplicate matches.
+   */
+  relatedTransactionId?: string
+  /**
+   * Output only. Spent, projected or charged amount in cents.
+   */
+  amount?: string
+  /**
+   * Output only. Limit, threshold or expected amount the rule compared against, in cents.
+   */
+  threshold?: string
+  /**
+   * Output only. Currency of `amount` and `threshold`.
+   */
+  currency?: string
+  /**
+   * Output only. Time the alert becomes active again while snoozed.
+   */
+  snoozeUntil?: string
+  /**
+   * Output only. Time the alert was acknowledged.
+   */
+  acknowledgeTime?: string
+  /**
+   * Output only. Creation time.
+   */
+  createTime?: string
+  /**
+   * Output only. Last update time.
+   */
+  updateTime?: string
+}
+
+/**
+ * The request for
+ * [ListAlerts][saturn.finance.v1.Finance.ListAlerts].
+ */
+export interface ListAlertsRequest {
+  /**
+   * Optional. Only return open alerts and snoozed alerts whose snooze has elapsed.
+   */
+  activeOnly: boolean
+  /**
+   * Optional. Only return alerts raised by this rule.
+   */
+  rule?: Alert_Rule
+  /**
+   * Optional. Maximum number of items to return.
+   */
+  pageSize: number
+  /**
+   * Optional. Keyset page token.
+   */
+  pageToken: string
+}
+
+/**
+ * The response for
+ * [ListAlerts][saturn.finance.v1.Finance.ListAlerts].
+ */
+export interface ListAlertsResponse {
+  /**
+   * Alerts of the space.
+   */
+  alerts: Alert[]
+  /**
+   * Next page keyset token. Empty if no more pages are available.
    */
   nextPageToken: string
 }
 
 /**
- * The request for
- * [UpdateInboxItem][saturn.finance.v1.Finance.UpdateInboxItem].
+ * The request for
+ * [GetAlert][saturn.finance.v1.Finance.GetAlert].
+ */
+export interface GetAlertRequest {
+  /**
+   * Required. Alert identifier.
+   */
+  id: string
+}
+
+/**
+ * The request for
+ * [AcknowledgeAlert][saturn.finance.v1.Finance.AcknowledgeAlert].
+ */
+export interface AcknowledgeAlertRequest {
+  /**
+   * Required. Alert identifier.
+   */
+  id: string
+}
+
+/**
+ * The request for
+ * [SnoozeAlert][saturn.finance.v1.Finance.SnoozeAlert].
+ */
+export interface SnoozeAlertRequest {
+  /**
+   * Required. Alert identifier.
+   */
+  id: string
+  /**
+   * Required. Time the alert becomes active again. Must be in the future.
+   */
+  snoozeUntil: string
+}
+
+/**
+ * TransactionChangedEvent is published after an expense is created or updated, so the alert rules can evaluate it.
+ */
+export interface TransactionChangedEvent {
+  /**
+   * Space the transaction belongs to.
+   */
+  spaceId: string
+  /**
+   * Transaction identifier.
+   */
+  transactionId: string
+}
+
+/**
+ * AlertRaisedEvent is published for every new alert.
+ */
+export interface AlertRaisedEvent {
+  /**
+   * Space the alert belongs to.
+   */
+  spaceId: string
+  /**
+   * The raised alert.
+   */
+  alert: Alert
+}
+
+/**
+ * CloseCardStatementsPayload defines the job payload that closes elapsed credit card billing cycles.
+ */
+export type CloseCardStatementsPayload = Record<string, never>
+
+/**
+ * RunLedgerExportPayload defines the job payload that generates a queued ledger export.
+ */
+export interface RunLedgerExportPayload {
+  /**
+   * Space the export belongs to.
+   */
+  spaceId: string
+  /**
+   * Export identifier.
+   */
+  exportId: string
+}
+
+/**
+ * Finance service provides APIs for budgeting, accounts management, transaction
+ * recording, daily exchange rate configurations, and analytics insights.
+ */
+/**
+ * Configures the base currency of a space. The base currency acts as the unified
+ * currency in which financial insights and multi-currency conversions are performed.
+ */
+export async function configureFinance(
+  req: ConfigureFinanceRequest
+): Promise<FinanceSettings> {
+  return request<FinanceSettings>({
+    method: "POST",
+    url: "/api/v1/finance/settings",
+    data: req,
+  })
+}
+
+export function useConfigureFinanceMutation(
+  options?: UseMutationOptions<FinanceSettings, Error, ConfigureFinanceRequest>
+) {
+  return useMutation<FinanceSettings, Error, ConfigureFinanceRequest>({
+    mutationFn: (req) => configureFinance(req),
+    ...options,
+  })
+}
+
+/**
+ * Retrieves the current finance settings, including the configured base currency.
+ */
+export async function getFinanceSettings(
+  _req?: GetFinanceSettingsRequest
+): Promise<FinanceSettings> {
+  return request<FinanceSettings>({
+    method: "GET",
+    url: "/api/v1/finance/settings",
+  })
+}
+
+export function useGetFinanceSettingsQuery(
+  req: GetFinanceSettingsRequest,
+  options?: Omit<
+    UseQueryOptions<FinanceSettings, Error>,
+    "queryKey" | "queryFn"
+  >
+) {
+  return useQuery<FinanceSettings, Error>({
+    queryKey: ["/api/v1/finance/settings", req],
+    queryFn: () => getFinanceSettings(req),
+    ...options,
+  })
+}
+
+/**
+ * Updates mutable finance settings, such as the currencies enabled for the workspace.
+ * Restricted to space owners and admins. The base currency cannot be changed.
+ */
+export async function updateFinanceSettings(
+  req: UpdateFinanceSettingsRequest
+): Promise<FinanceSettings> {
+  const params = { ...req }
+  delete (params as Record<string, unknown>).settings
+  return request<FinanceSettings>({
+    method: "PATCH",
+    url: "/api/v1/finance/settings",
+    params: params,
+    data: req.settings,
+  })
+}
+
+export function useUpdateFinanceSettingsMutation(
+  options?: UseMutationOptions<
+    FinanceSettings,
+    Error,
+    UpdateFinanceSettingsRequest
+  >
+) {
+  return useMutation<FinanceSettings, Error, UpdateFinanceSettingsRequest>({
+    mutationFn: (req) => updateFinanceSettings(req),
+    ...options,
+  })
+}
+
+/**
+ * Creates a budget category template. Budgets track spent thresholds over repeating intervals.
+ */
+export async function createBudget(req: CreateBudgetRequest): Promise<Budget> {
+  return request<Budget>({
+    method: "POST",
+    url: "/api/v1/finance/budgets",
+    data: req.budget,
+  })
+}
+
+export function useCreateBudgetMutation(
+  options?: UseMutationOptions<Budget, Error, CreateBudgetRequest>
+) {
+  return useMutation<Budget, Error, CreateBudgetRequest>({
+    mutationFn: (req) => createBudget(req),
+    ...options,
+  })
+}
+
+/**
+ * Retrieves details of a specific budget category template.
+ */
+export async function getBudget(
+  id: string,
+  _req: GetBudgetRequest
+): Promise<Budget> {
+  return request<Budget>({
+    method: "GET",
+    url: `/api/v1/finance/budgets/${id}`,
+  })
+}
+
+export function useGetBudgetQuery(
+  req: GetBudgetRequest,
+  options?: Omit<UseQueryOptions<Budget, Error>, "queryKey" | "queryFn">
+) {
+  return useQuery<Budget, Error>({
+    queryKey: [`/api/v1/finance/budgets/${req.id}`, req],
+    queryFn: () => getBudget(req.id, req),
+    ...options,
+  })
+}
+
+/**
+ * Updates an existing budget template's properties, such as visual parameters or limit propagation policies.
+ */
+export async function updateBudget(
+  id: string,
+  req: UpdateBudgetRequest
+): Promise<Budget> {
+  const params = { ...req }
+  delete (params as Record<string, unknown>).id
+  delete (params as Record<string, unknown>).budget
+  return request<Budget>({
+    method: "PUT",
+    url: `/api/v1/finance/budgets/${id}`,
+    params: params,
+    data: req.budget,
+  })
+}
+
+export function useUpdateBudgetMutation(
+  options?: UseMutationOptions<
+    Budget,
+    Error,
+    { id: string; req: UpdateBudgetRequest }
+  >
+) {
+  return useMutation<Budget, Error, { id: string; req: UpdateBudgetRequest }>({
+    mutationFn: ({ id, req }) => updateBudget(id, req),
+    ...options,
+  })
+}
+
+/**
+ * Deletes a budget template. Only inactive budgets or budgets without active transactions can be deleted.
+ */
+export async function deleteBudget(
+  id: string,
+  req: DeleteBudgetRequest
+): Promise<Record<string, never>> {
+  const params = { ...req }
+  delete (params as Record<string, unknown>).id
+  return request<Record<string, never>>({
+    method: "DELETE",
+    url: `/api/v1/finance/budgets/${id}`,
+    params: params,
+  })
+}
+
+export function useDeleteBudgetMutation(
+  options?: UseMutationOptions<
+    Record<string, never>,
+    Error,
+    { id: string; req: DeleteBudgetRequest }
+  >
+) {
+  return useMutation<
+    Record<string, never>,
+    Error,
+    { id: string; req: DeleteBudgetRequest }
+  >({
+    mutationFn: ({ id, req }) => deleteBudget(id, req),
+    ...options,
+  })
+}
+
+/**
+ * Lists budget templates inside the space, supporting paginated, sorted, and text-based filter views.
+ */
+export async function listBudgets(
+  req: ListBudgetsRequest
+): Promise<ListBudgetsResponse> {
+  const params = { ...req }
+  return request<ListBudgetsResponse>({
+    method: "GET",
+    url: "/api/v1/finance/budgets",
+    params: params,
+  })
+}
+
+export function useListBudgetsQuery(
+  req: ListBudgetsRequest,
+  options?: Omit<
+    UseQueryOptions<ListBudgetsResponse, Error>,
+    "queryKey" | "queryFn"
+  >
+) {
+  return useQuery<ListBudgetsResponse, Error>({
+    queryKey: ["/api/v1/finance/budgets", req],
+    queryFn: () => listBudgets(req),
+    ...options,
+  })
+}
+
+/**
+ * Retrieves or automatically spawns the active budget period containing current spend limits for a target date.
+ */
+export async function getBudgetPeriod(
+  budget_id: string,
+  req: GetBudgetPeriodRequest
+): Promise<BudgetPeriod> {
+  const params = { ...req }
+  delete (params as Record<string, unknown>).budgetId
+  return request<BudgetPeriod>({
+    method: "GET",
+    url: `/api/v1/finance/budgets/${budget_id}/period`,
+    params: params,
+  })
+}
+
+export function useGetBudgetPeriodQuery(
+  req: GetBudgetPeriodRequest,
+  options?: Omit<UseQueryOptions<BudgetPeriod, Error>, "queryKey" | "queryFn">
+) {
+  return useQuery<BudgetPeriod, Error>({
+    queryKey: [`/api/v1/finance/budgets/${req.budgetId}/period`, req],
+    queryFn: () => getBudgetPeriod(req.budgetId, req),
+    ...options,
+  })
+}
+
+/**
+ * Registers a daily exchange rate conversion coefficient between two currencies.
+ */
+export async function createExchangeRate(
+  req: CreateExchangeRateRequest
+): Promise<ExchangeRate> {
+  return request<ExchangeRate>({
+    method: "POST",
+    url: "/api/v1/finance/exchange-rates",
+    data: req.exchangeRate,
+  })
+}
+
+export function useCreateExchangeRateMutation(
+  options?: UseMutationOptions<ExchangeRate, Error, CreateExchangeRateRequest>
+) {
+  return useMutation<ExchangeRate, Error, CreateExchangeRateRequest>({
+    mutationFn: (req) => createExchangeRate(req),
+    ...options,
+  })
+}
+
+/**
+ * Retrieves a specific daily exchange rate conversion rule by ID.
+ */
+export async function getExchangeRate(
+  id: string,
+  _req: GetExchangeRateRequest
+): Promise<ExchangeRate> {
+  return request<ExchangeRate>({
+    method: "GET",
+    url: `/api/v1/finance/exchange-rates/${id}`,
+  })
+}
+
+export function useGetExchangeRateQuery(
+  req: GetExchangeRateRequest,
+  options?: Omit<UseQueryOptions<ExchangeRate, Error>, "queryKey" | "queryFn">
+) {
+  return useQuery<ExchangeRate, Error>({
+    queryKey: [`/api/v1/finance/exchange-rates/${req.id}`, req],
+    queryFn: () => getExchangeRate(req.id, req),
+    ...options,
+  })
+}
+
+/**
+ * Updates an existing daily exchange rate conversion coefficient.
+ */
+export async function updateExchangeRate(
+  id: string,
+  req: UpdateExchangeRateRequest
+): Promise<ExchangeRate> {
+  return request<ExchangeRate>({
+    method: "PUT",
+    url: `/api/v1/finance/exchange-rates/${id}`,
+    data: req.exchangeRate,
+  })
+}
+
+export function useUpdateExchangeRateMutation(
+  options?: UseMutationOptions<
+    ExchangeRate,
+    Error,
+    { id: string; req: UpdateExchangeRateRequest }
+  >
+) {
+  return useMutation<
+    ExchangeRate,
+    Error,
+    { id: string; req: UpdateExchangeRateRequest }
+  >({
+    mutationFn: ({ id, req }) => updateExchangeRate(id, req),
+    ...options,
+  })
+}
+
+/**
+ * Lists historical exchange rate conversion coefficients.
+ */
+export async function listExchangeRates(
+  req: ListExchangeRatesRequest
+): Promise<ListExchangeRatesResponse> {
+  const params = { ...req }
+  return request<ListExchangeRatesResponse>({
+    method: "GET",
+    url: "/api/v1/finance/exchange-rates",
+    params: params,
+  })
+}
+
+export function useListExchangeRatesQuery(
+  req: ListExchangeRatesRequest,
+  options?: Omit<
+    UseQueryOptions<ListExchangeRatesResponse, Error>,
+    "queryKey" | "queryFn"
+  >
+) {
+  return useQuery<ListExchangeRatesResponse, Error>({
+    queryKey: ["/api/v1/finance/exchange-rates", req],
+    queryFn: () => listExchangeRates(req),
+    ...options,
+  })
+}
+
+/**
+ * Deletes a registered daily exchange rate conversion rule.
+ */
+export async function deleteExchangeRate(
+  id: string,
+  _req: DeleteExchangeRateRequest
+): Promise<Record<string, never>> {
+  return request<Record<string, never>>({
+    method: "DELETE",
+    url: `/api/v1/finance/exchange-rates/${id}`,
+  })
+}
+
+export function useDeleteExchangeRateMutation(
+  options?: UseMutationOptions<
+    Record<string, never>,
+    Error,
+    { id: string; req: DeleteExchangeRateRequest }
+  >
+) {
+  return useMutation<
+    Record<string, never>,
+    Error,
+    { id: string; req: DeleteExchangeRateRequest }
+  >({
+    mutationFn: ({ id, req }) => deleteExchangeRate(id, req),
+    ...options,
+  })
+}
+
+/**
+ * Logs a new expense transaction, updating target budget periods and reducing account balances.
+ */
+export async function createExpense(
+  req: CreateExpenseRequest
+): Promise<Transaction> {
+  return request<Transaction>({
+    method: "POST",
+    url: "/api/v1/finance/expenses",
+    data: req.expense,
+  })
+}
+
+export function useCreateExpenseMutation(
+  options?: UseMutationOptions<Transaction, Error, CreateExpenseRequest>
+) {
+  return useMutation<Transaction, Error, CreateExpenseRequest>({
+    mutationFn: (req) => createExpense(req),
+    ...options,
+  })
+}
+
+/**
+ * Modifies properties of a logged expense transaction. Re-calculates budget and currency conversions.
+ */
+export async function updateExpense(
+  id: string,
+  req: UpdateExpenseRequest
+): Promise<Transaction> {
+  return request<Transaction>({
+    method: "PUT",
+    url: `/api/v1/finance/expenses/${id}`,
+    data: req.expense,
+  })
+}
+
+export function useUpdateExpenseMutation(
+  options?: UseMutationOptions<
+    Transaction,
+    Error,
+    { id: string; req: UpdateExpenseRequest }
+  >
+) {
+  return useMutation<
+    Transaction,
+    Error,
+    { id: string; req: UpdateExpenseRequest }
+  >({
+    mutationFn: ({ id, req }) => updateExpense(id, req),
+    ...options,
+  })
+}
+
+/**
+ * Logs a new income transaction (such as a paycheck), increasing the balance of the receiving account.
+ */
+export async function createIncome(
+  req: CreateIncomeRequest
+): Promise<Transaction> {
+  return request<Transaction>({
+    method: "POST",
+    url: "/api/v1/finance/incomes",
+    data: req.income,
+  })
+}
+
+export function useCreateIncomeMutation(
+  options?: UseMutationOptions<Transaction, Error, CreateIncomeRequest>
+) {
+  return useMutation<Transaction, Error, CreateIncomeRequest>({
+    mutationFn: (req) => createIncome(req),
+    ...options,
+  })
+}
+
+/**
+ * Modifies properties of a logged income transaction. Re-calculates currency conversions and account balances.
+ */
+export async function updateIncome(
+  id: string,
+  req: UpdateIncomeRequest
+): Promise<Transaction> {
+  return request<Transaction>({
+    method: "PUT",
+    url: `/api/v1/finance/incomes/${id}`,
+    data: req.income,
+  })
+}
+
+export function useUpdateIncomeMutation(
+  options?: UseMutationOptions<
+    Transaction,
+    Error,
+    { id: string; req: UpdateIncomeRequest }
+  >
+) {
+  return useMutation<
+    Transaction,
+    Error,
+    { id: string; req: UpdateIncomeRequest }
+  >({
+    mutationFn: ({ id, req }) => updateIncome(id, req),
+    ...options,
+  })
+}
+
+/**
+ * Registers an income source, such as an employer paying a recurring salary.
+ */
+export async function createIncomeSource(
+  req: CreateIncomeSourceRequest
+): Promise<IncomeSource> {
+  return request<IncomeSource>({
+    method: "POST",
+    url: "/api/v1/finance/income-sources",
+    data: req.incomeSource,
+  })
+}
+
+export function useCreateIncomeSourceMutation(
+  options?: UseMutationOptions<IncomeSource, Error, CreateIncomeSourceRequest>
+) {
+  return useMutation<IncomeSource, Error, CreateIncomeSourceRequest>({
+    mutationFn: (req) => createIncomeSource(req),
+    ...options,
+  })
+}
+
+/**
+ * Retrieves details of a specific income source.
+ */
+export async function getIncomeSource(
+  id: string,
+  _req: GetIncomeSourceRequest
+): Promise<IncomeSource> {
+  return request<IncomeSource>({
+    method: "GET",
+    url: `/api/v1/finance/income-sources/${id}`,
+  })
+}
+
+export function useGetIncomeSourceQuery(
+  req: GetIncomeSourceRequest,
+  options?: Omit<UseQueryOptions<IncomeSource, Error>, "queryKey" | "queryFn">
+) {
+  return useQuery<IncomeSource, Error>({
+    queryKey: [`/api/v1/finance/income-sources/${req.id}`, req],
+    queryFn: () => getIncomeSource(req.id, req),
+    ...options,
+  })
+}
+
+/**
+ * Updates an income source's employer, expected amount, cadence, or deposit account.
+ */
+export async function updateIncomeSource(
+  id: string,
+  req: UpdateIncomeSourceRequest
+): Promise<IncomeSource> {
+  return request<IncomeSource>({
+    method: "PUT",
+    url: `/api/v1/finance/income-sources/${id}`,
+    data: req.incomeSource,
+  })
+}
+
+export function useUpdateIncomeSourceMutation(
+  options?: UseMutationOptions<
+    IncomeSource,
+    Error,
+    { id: string; req: UpdateIncomeSourceRequest }
+  >
+) {
+  return useMutation<
+    IncomeSource,
+    Error,
+    { id: string; req: UpdateIncomeSourceRequest }
+  >({
+    mutationFn: ({ id, req }) => updateIncomeSource(id, req),
+    ...options,
+  })
+}
+
+/**
+ * Deletes an income source. Income transactions previously linked to it are preserved.
+ */
+export async function deleteIncomeSource(
+  id: string,
+  _req: DeleteIncomeSourceRequest
+): Promise<Record<string, never>> {
+  return request<Record<string, never>>({
+    method: "DELETE",
+    url: `/api/v1/finance/income-sources/${id}`,
+  })
+}
+
+export function useDeleteIncomeSourceMutation(
+  options?: UseMutationOptions<
+    Record<string, never>,
+    Error,
+    { id: string; req: DeleteIncomeSourceRequest }
+  >
+) {
+  return useMutation<
+    Record<string, never>,
+    Error,
+    { id: string; req: DeleteIncomeSourceRequest }
+  >({
+    mutationFn: ({ id, req }) => deleteIncomeSource(id, req),
+    ...options,
+  })
+}
+
+/**
+ * Lists income sources configured in the space.
+ */
+export async function listIncomeSources(
+  req: ListIncomeSourcesRequest
+): Promise<ListIncomeSourcesResponse> {
+  const params = { ...req }
+  return request<ListIncomeSourcesResponse>({
+    method: "GET",
+    url: "/api/v1/finance/income-sources",
+    params: params,
+  })
+}
+
+export function useListIncomeSourcesQuery(
+  req: ListIncomeSourcesRequest,
+  options?: Omit<
+    UseQueryOptions<ListIncomeSourcesResponse, Error>,
+    "queryKey" | "queryFn"
+  >
+) {
+  return useQuery<ListIncomeSourcesResponse, Error>({
+    queryKey: ["/api/v1/finance/income-sources", req],
+    queryFn: () => listIncomeSources(req),
+    ...options,
+  })
+}
+
+/**
+ * Creates a savings goal funded by transfers into a linked account or by tagged transactions.
+ */
+export async function createGoal(req: CreateGoalRequest): Promise<Goal> {
+  return request<Goal>({
+    method: "POST",
+    url: "/api/v1/finance/goals",
+    data: req.goal,
+  })
+}
+
+export function useCreateGoalMutation(
+  options?: UseMutationOptions<Goal, Error, CreateGoalRequest>
+) {
+  return useMutation<Goal, Error, CreateGoalRequest>({
+    mutationFn: (req) => createGoal(req),
+    ...options,
+  })
+}
+
+/**
+ * Retrieves details of a specific savings goal.
+ */
+export async function getGoal(id: string, _req: GetGoalRequest): Promise<Goal> {
+  return request<Goal>({
+    method: "GET",
+    url: `/api/v1/finance/goals/${id}`,
+  })
+}
+
+export function useGetGoalQuery(
+  req: GetGoalRequest,
+  options?: Omit<UseQueryOptions<Goal, Error>, "queryKey" | "queryFn">
+) {
+  return useQuery<Goal, Error>({
+    queryKey: [`/api/v1/finance/goals/${req.id}`, req],
+    queryFn: () => getGoal(req.id, req),
+    ...options,
+  })
+}
+
+/**
+ * Updates a goal's target, deadline, contribution sources, or status.
+ */
+export async function updateGoal(
+  id: string,
+  req: UpdateGoalRequest
+): Promise<Goal> {
+  return request<Goal>({
+    method: "PUT",
+    url: `/api/v1/finance/goals/${id}`,
+    data: req.goal,
+  })
+}
+
+export function useUpdateGoalMutation(
+  options?: UseMutationOptions<
+    Goal,
+    Error,
+    { id: string; req: UpdateGoalRequest }
+  >
+) {
+  return useMutation<Goal, Error, { id: string; req: UpdateGoalRequest }>({
+    mutationFn: ({ id, req }) => updateGoal(id, req),
+    ...options,
+  })
+}
+
+/**
+ * Deletes a savings goal. Contributing transactions and transfers are preserved.
+ */
+export async function deleteGoal(
+  id: string,
+  _req: DeleteGoalRequest
+): Promise<Record<string, never>> {
+  return request<Record<string, never>>({
+    method: "DELETE",
+    url: `/api/v1/finance/goals/${id}`,
+  })
+}
+
+export function useDeleteGoalMutation(
+  options?: UseMutationOptions<
+    Record<string, never>,
+    Error,
+    { id: string; req: DeleteGoalRequest }
+  >
+) {
+  return useMutation<
+    Record<string, never>,
+    Error,
+    { id: string; req: DeleteGoalRequest }
+  >({
+    mutationFn: ({ id, req }) => deleteGoal(id, req),
+    ...options,
+  })
+}
+
+/**
+ * Lists savings goals configured in the space.
+ */
+export async function listGoals(
+  req: ListGoalsRequest
+): Promise<ListGoalsResponse> {
+  const params = { ...req }
+  return request<ListGoalsResponse>({
+    method: "GET",
+    url: "/api/v1/finance/goals",
+    params: params,
+  })
+}
+
+export function useListGoalsQuery(
+  req: ListGoalsRequest,
+  options?: Omit<
+    UseQueryOptions<ListGoalsResponse, Error>,
+    "queryKey" | "queryFn"
+  >
+) {
+  return useQuery<ListGoalsResponse, Error>({
+    queryKey: ["/api/v1/finance/goals", req],
+    queryFn: () => listGoals(req),
+    ...options,
+  })
+}
+
+/**
+ * Computes a goal's saved amount, contribution history, and projected completion date.
+ */
+export async function getGoalProgress(
+  id: string,
+  _req: GetGoalProgressRequest
+): Promise<GoalProgress> {
+  return request<GoalProgress>({
+    method: "GET",
+    url: `/api/v1/finance/goals/${id}/progress`,
+  })
+}
+
+export function useGetGoalProgressQuery(
+  req: GetGoalProgressRequest,
+  options?: Omit<UseQueryOptions<GoalProgress, Error>, "queryKey" | "queryFn">
+) {
+  return useQuery<GoalProgress, Error>({
+    queryKey: [`/api/v1/finance/goals/${req.id}/progress`, req],
+    queryFn: () => getGoalProgress(req.id, req),
+    ...options,
+  })
+}
+
+/**
+ * Creates a transaction category, optionally nested under a parent category.
+ */
+export async function createCategory(
+  req: CreateCategoryRequest
+): Promise<Category> {
+  return request<Category>({
+    method: "POST",
+    url: "/api/v1/finance/categories",
+    data: req.category,
+  })
+}
+
+export function useCreateCategoryMutation(
+  options?: UseMutationOptions<Category, Error, CreateCategoryRequest>
+) {
+  return useMutation<Category, Error, CreateCategoryRequest>({
+    mutationFn: (req) => createCategory(req),
+    ...options,
+  })
+}
+
+/**
+ * Retrieves details of a specific category.
+ */
+export async function getCategory(
+  id: string,
+  _req: GetCategoryRequest
+): Promise<Category> {
+  return request<Category>({
+    method: "GET",
+    url: `/api/v1/finance/categories/${id}`,
+  })
+}
+
+export function useGetCategoryQuery(
+  req: GetCategoryRequest,
+  options?: Omit<UseQueryOptions<Category, Error>, "queryKey" | "queryFn">
+) {
+  return useQuery<Category, Error>({
+    queryKey: [`/api/v1/finance/categories/${req.id}`, req],
+    queryFn: () => getCategory(req.id, req),
+    ...options,
+  })
+}
+
+/**
+ * Updates a category's name, appearance, or parent.
+ */
+export async function updateCategory(
+  id: string,
+  req: UpdateCategoryRequest
+): Promise<Category> {
+  return request<Category>({
+    method: "PUT",
+    url: `/api/v1/finance/categories/${id}`,
+    data: req.category,
+  })
+}
+
+export function useUpdateCategoryMutation(
+  options?: UseMutationOptions<
+    Category,
+    Error,
+    { id: string; req: UpdateCategoryRequest }
+  >
+) {
+  return useMutation<
+    Category,
+    Error,
+    { id: string; req: UpdateCategoryRequest }
+  >({
+    mutationFn: ({ id, req }) => updateCategory(id, req),
+    ...options,
+  })
+}
+
+/**
+ * Deletes a category. Subcategories become top-level and linked transactions become uncategorized.
  */
-export interface UpdateInboxItemRequest {
-  /**
-   * Required. Target staged inbox item identifier to update.
-   * Values are of the form `inb_[a-zA-Z0-9]+`.
-   */
-  id: string
-  /**
-   * Required. Updated staging inbox item parameters.
-   */
-  inboxItem: InboxItem
+export async function deleteCategory(
+  id: string,
+  _req: DeleteCategoryRequest
+): Promise<Record<string, never>> {
+  return request<Record<string, never>>({
+    method: "DELETE",
+    url: `/api/v1/finance/categories/${id}`,
+  })
+}
+
+export function useDeleteCategoryMutation(
+  options?: UseMutationOptions<
+    Record<string, never>,
+    Error,
+    { id: string; req: DeleteCategoryRequest }
+  >
+) {
+  return useMutation<
+    Record<string, never>,
+    Error,
+    { id: string; req: DeleteCategoryRequest }
+  >({
+    mutationFn: ({ id, req }) => deleteCategory(id, req),
+    ...options,
+  })
 }
 
 /**
- * The request for
- * [ApproveInboxItem][saturn.finance.v1.Finance.ApproveInboxItem].
+ * Lists categories configured in the space.
  */
-export interface ApproveInboxItemRequest {
-  /**
-   * Required. Target staged inbox item identifier to approve.
-   * Values are of the form `inb_[a-zA-Z0-9]+`.
-   */
-  id: string
+export async function listCategories(
+  req: ListCategoriesRequest
+): Promise<ListCategoriesResponse> {
+  const params = { ...req }
+  return request<ListCategoriesResponse>({
+    method: "GET",
+    url: "/api/v1/finance/categories",
+    params: params,
+  })
+}
+
+export function useListCategoriesQuery(
+  req: ListCategoriesRequest,
+  options?: Omit<
+    UseQueryOptions<ListCategoriesResponse, Error>,
+    "queryKey" | "queryFn"
+  >
+) {
+  return useQuery<ListCategoriesResponse, Error>({
+    queryKey: ["/api/v1/finance/categories", req],
+    queryFn: () => listCategories(req),
+    ...options,
+  })
 }
 
 /**
- * The request for
- * [DiscardInboxItem][saturn.finance.v1.Finance.DiscardInboxItem].
+ * Deletes a transaction, reversing all changes to budget period consumption and account balances.
  */
-export interface DiscardInboxItemRequest {
-  /**
-   * Required. Target staged inbox item identifier to discard.
-   * Values are of the form `inb_[a-zA-Z0-9]+`.
-   */
-  id: string
+export async function deleteTransaction(
+  id: string,
+  _req: DeleteTransactionRequest
+): Promise<Record<string, never>> {
+  return request<Record<string, never>>({
+    method: "DELETE",
+    url: `/api/v1/finance/transactions/${id}`,
+  })
+}
+
+export function useDeleteTransactionMutation(
+  options?: UseMutationOptions<
+    Record<string, never>,
+    Error,
+    { id: string; req: DeleteTransactionRequest }
+  >
+) {
+  return useMutation<
+    Record<string, never>,
+    Error,
+    { id: string; req: DeleteTransactionRequest }
+  >({
+    mutationFn: ({ id, req }) => deleteTransaction(id, req),
+    ...options,
+  })
 }
 
 /**
- * Finance service provides APIs for budgeting, accounts management, transaction
- * recording, daily exchange rate configurations, and analytics insights.
+ * Lists paginated transaction records matching specific budget, account, source, or text queries.
  */
+export async function listTransactions(
+  req: ListTransactionsRequest
+): Promise<ListTransactionsResponse> {
+  const params = { ...req }
+  return request<ListTransactionsResponse>({
+    method: "GET",
+    url: "/api/v1/finance/transactions",
+    params: params,
+  })
+}
+
+export function useListTransactionsQuery(
+  req: ListTransactionsRequest,
+  options?: Omit<
+    UseQueryOptions<ListTransactionsResponse, Error>,
+    "queryKey" | "queryFn"
+  >
+) {
+  return useQuery<ListTransactionsResponse, Error>({
+    queryKey: ["/api/v1/finance/transactions", req],
+    queryFn: () => listTransactions(req),
+    ...options,
+  })
+}
+
 /**
- * Configures the base currency of a space. The base currency acts as the unified
- * currency in which financial insights and multi-currency conversions are performed.
+ * Applies the same field changes to many expenses and income atomically, selected by ID or by filter.
+ * Account balances are adjusted once by the net change of the batch.
  */
-export async function configureFinance(
-  req: ConfigureFinanceRequest
-): Promise<FinanceSettings> {
-  return request<FinanceSettings>({
+export async function batchUpdateTransactions(
+  req: BatchUpdateTransactionsRequest
+): Promise<BatchUpdateTransactionsResponse> {
+  return request<BatchUpdateTransactionsResponse>({
     method: "POST",
-    url: "/api/v1/finance/settings",
+    url: "/api/v1/finance/transactions:batchUpdate",
     data: req,
   })
 }
 
-export function useConfigureFinanceMutation(
-  options?: UseMutationOptions<FinanceSettings, Error, ConfigureFinanceRequest>
+export function useBatchUpdateTransactionsMutation(
+  options?: UseMutationOptions<
+    BatchUpdateTransactionsResponse,
+    Error,
+    BatchUpdateTransactionsRequest
+  >
 ) {
-  return useMutation<FinanceSettings, Error, ConfigureFinanceRequest>({
-    mutationFn: (req) => configureFinance(req),
+  return useMutation<
+    BatchUpdateTransactionsResponse,
+    Error,
+    BatchUpdateTransactionsRequest
+  >({
+    mutationFn: (req) => batchUpdateTransactions(req),
     ...options,
   })
 }
 
 /**
- * Retrieves the current finance settings, including the configured base currency.
+ * Deletes many expenses and income atomically, selected by ID or by filter.
  */
-export async function getFinanceSettings(
-  _req?: GetFinanceSettingsRequest
-): Promise<FinanceSettings> {
-  return request<FinanceSettings>({
-    method: "GET",
-    url: "/api/v1/finance/settings",
+export async function batchDeleteTransactions(
+  req: BatchDeleteTransactionsRequest
+): Promise<BatchDeleteTransactionsResponse> {
+  return request<BatchDeleteTransactionsResponse>({
+    method: "POST",
+    url: "/api/v1/finance/transactions:batchDelete",
+    data: req,
   })
 }
 
-export function useGetFinanceSettingsQuery(
-  req: GetFinanceSettingsRequest,
-  options?: Omit<
-    UseQueryOptions<FinanceSettings, Error>,
-    "queryKey" | "queryFn"
+export function useBatchDeleteTransactionsMutation(
+  options?: UseMutationOptions<
+    BatchDeleteTransactionsResponse,
+    Error,
+    BatchDeleteTransactionsRequest
   >
 ) {
-  return useQuery<FinanceSettings, Error>({
-    queryKey: ["/api/v1/finance/settings", req],
-    queryFn: () => getFinanceSettings(req),
+  return useMutation<
+    BatchDeleteTransactionsResponse,
+    Error,
+    BatchDeleteTransactionsRequest
+  >({
+    mutationFn: (req) => batchDeleteTransactions(req),
     ...options,
   })
 }
 
 /**
- * Creates a budget category template. Budgets track spent thresholds over repeating intervals.
+ * Saves a named transaction filter expression that can be reused when listing transactions.
  */
-export async function createBudget(req: CreateBudgetRequest): Promise<Budget> {
-  return request<Budget>({
+export async function createSavedFilter(
+  req: CreateSavedFilterRequest
+): Promise<SavedFilter> {
+  return request<SavedFilter>({
     method: "POST",
-    url: "/api/v1/finance/budgets",
-    data: req.budget,
+    url: "/api/v1/finance/saved-filters",
+    data: req.savedFilter,
   })
 }
 
-export function useCreateBudgetMutation(
-  options?: UseMutationOptions<Budget, Error, CreateBudgetRequest>
+export function useCreateSavedFilterMutation(
+  options?: UseMutationOptions<SavedFilter, Error, CreateSavedFilterRequest>
 ) {
-  return useMutation<Budget, Error, CreateBudgetRequest>({
-    mutationFn: (req) => createBudget(req),
+  return useMutation<SavedFilter, Error, CreateSavedFilterRequest>({
+    mutationFn: (req) => createSavedFilter(req),
     ...options,
   })
 }
 
 /**
- * Retrieves details of a specific budget category template.
+ * Retrieves details of a specific saved filter.
  */
-export async function getBudget(
+export async function getSavedFilter(
   id: string,
-  _req: GetBudgetRequest
-): Promise<Budget> {
-  return request<Budget>({
+  _req: GetSavedFilterRequest
+): Promise<SavedFilter> {
+  return request<SavedFilter>({
     method: "GET",
-    url: `/api/v1/finance/budgets/${id}`,
+    url: `/api/v1/finance/saved-filters/${id}`,
   })
 }
 
-export function useGetBudgetQuery(
-  req: GetBudgetRequest,
-  options?: Omit<UseQueryOptions<Budget, Error>, "queryKey" | "queryFn">
+export function useGetSavedFilterQuery(
+  req: GetSavedFilterRequest,
+  options?: Omit<UseQueryOptions<SavedFilter, Error>, "queryKey" | "queryFn">
 ) {
-  return useQuery<Budget, Error>({
-    queryKey: [`/api/v1/finance/budgets/${req.id}`, req],
-    queryFn: () => getBudget(req.id, req),
+  return useQuery<SavedFilter, Error>({
+    queryKey: [`/api/v1/finance/saved-filters/${req.id}`, req],
+    queryFn: () => getSavedFilter(req.id, req),
     ...options,
   })
 }
 
 /**
- * Updates an existing budget template's properties, such as visual parameters or limit propagation policies.
+ * Updates a saved filter's name, expression, or default sort.
  */
-export async function updateBudget(
+export async function updateSavedFilter(
   id: string,
-  req: UpdateBudgetRequest
-): Promise<Budget> {
+  req: UpdateSavedFilterRequest
+): Promise<SavedFilter> {
   const params = { ...req }
   delete (params as Record<string, unknown>).id
-  delete (params as Record<string, unknown>).budget
-  return request<Budget>({
+  delete (params as Record<string, unknown>).savedFilter
+  return request<SavedFilter>({
     method: "PUT",
-    url: `/api/v1/finance/budgets/${id}`,
+    url: `/api/v1/finance/saved-filters/${id}`,
     params: params,
-    data: req.budget,
+    data: req.savedFilter,
   })
 }
 
-export function useUpdateBudgetMutation(
+export function useUpdateSavedFilterMutation(
   options?: UseMutationOptions<
-    Budget,
+    SavedFilter,
     Error,
-    { id: string; req: UpdateBudgetRequest }
+    { id: string; req: UpdateSavedFilterRequest }
   >
 ) {
-  return useMutation<Budget, Error, { id: string; req: UpdateBudgetRequest }>({
-    mutationFn: ({ id, req }) => updateBudget(id, req),
+  return useMutation<
+    SavedFilter,
+    Error,
+    { id: string; req: UpdateSavedFilterRequest }
+  >({
+    mutationFn: ({ id, req }) => updateSavedFilter(id, req),
     ...options,
   })
 }
 
 /**
- * Deletes a budget template. Only inactive budgets or budgets without active transactions can be deleted.
+ * Deletes a saved filter.
  */
-export async function deleteBudget(
+export async function deleteSavedFilter(
   id: string,
-  req: DeleteBudgetRequest
+  _req: DeleteSavedFilterRequest
 ): Promise<Record<string, never>> {
-  const params = { ...req }
-  delete (params as Record<string, unknown>).id
   return request<Record<string, never>>({
     method: "DELETE",
-    url: `/api/v1/finance/budgets/${id}`,
-    params: params,
+    url: `/api/v1/finance/saved-filters/${id}`,
   })
 }
 
-export function useDeleteBudgetMutation(
+export function useDeleteSavedFilterMutation(
   options?: UseMutationOptions<
     Record<string, never>,
     Error,
-    { id: string; req: DeleteBudgetRequest }
+    { id: string; req: DeleteSavedFilterRequest }
   >
 ) {
   return useMutation<
     Record<string, never>,
     Error,
-    { id: string; req: DeleteBudgetRequest }
+    { id: string; req: DeleteSavedFilterRequest }
   >({
-    mutationFn: ({ id, req }) => deleteBudget(id, req),
+    mutationFn: ({ id, req }) => deleteSavedFilter(id, req),
     ...options,
   })
 }
 
 /**
- * Lists budget templates inside the space, supporting paginated, sorted, and text-based filter views.
+ * Lists saved filters configured in the space.
  */
-export async function listBudgets(
-  req: ListBudgetsRequest
-): Promise<ListBudgetsResponse> {
+export async function listSavedFilters(
+  req: ListSavedFiltersRequest
+): Promise<ListSavedFiltersResponse> {
   const params = { ...req }
-  return request<ListBudgetsResponse>({
+  return request<ListSavedFiltersResponse>({
     method: "GET",
-    url: "/api/v1/finance/budgets",
+    url: "/api/v1/finance/saved-filters",
     params: params,
   })
 }
 
-export function useListBudgetsQuery(
-  req: ListBudgetsRequest,
+export function useListSavedFiltersQuery(
+  req: ListSavedFiltersRequest,
   options?: Omit<
-    UseQueryOptions<ListBudgetsResponse, Error>,
+    UseQueryOptions<ListSavedFiltersResponse, Error>,
     "queryKey" | "queryFn"
   >
 ) {
-  return useQuery<ListBudgetsResponse, Error>({
-    queryKey: ["/api/v1/finance/budgets", req],
-    queryFn: () => listBudgets(req),
-    ...options,
-  })
-}
-
-/**
- * Retrieves or automatically spawns the active budget period containing current spend limits for a target date.
- */
-export async function getBudgetPeriod(
-  budget_id: string,
-  req: GetBudgetPeriodRequest
-): Promise<BudgetPeriod> {
-  const params = { ...req }
-  delete (params as Record<string, unknown>).budgetId
-  return request<BudgetPeriod>({
-    method: "GET",
-    url: `/api/v1/finance/budgets/${budget_id}/period`,
-    params: params,
-  })
-}
-
-export function useGetBudgetPeriodQuery(
-  req: GetBudgetPeriodRequest,
-  options?: Omit<UseQueryOptions<BudgetPeriod, Error>, "queryKey" | "queryFn">
-) {
-  return useQuery<BudgetPeriod, Error>({
-    queryKey: [`/api/v1/finance/budgets/${req.budgetId}/period`, req],
-    queryFn: () => getBudgetPeriod(req.budgetId, req),
+  return useQuery<ListSavedFiltersResponse, Error>({
+    queryKey: ["/api/v1/finance/saved-filters", req],
+    queryFn: () => listSavedFilters(req),
     ...options,
   })
 }
 
 /**
- * Registers a daily exchange rate conversion coefficient between two currencies.
+ * Creates a payee that transaction descriptions are normalized to.
  */
-export async function createExchangeRate(
-  req: CreateExchangeRateRequest
-): Promise<ExchangeRate> {
-  return request<ExchangeRate>({
+export async function createPayee(req: CreatePayeeRequest): Promise<Payee> {
+  return request<Payee>({
     method: "POST",
-    url: "/api/v1/finance/exchange-rates",
-    data: req.exchangeRate,
+    url: "/api/v1/finance/payees",
+    data: req.payee,
   })
 }
 
-export function useCreateExchangeRateMutation(
-  options?: UseMutationOptions<ExchangeRate, Error, CreateExchangeRateRequest>
+export function useCreatePayeeMutation(
+  options?: UseMutationOptions<Payee, Error, CreatePayeeRequest>
 ) {
-  return useMutation<ExchangeRate, Error, CreateExchangeRateRequest>({
-    mutationFn: (req) => createExchangeRate(req),
+  return useMutation<Payee, Error, CreatePayeeRequest>({
+    mutationFn: (req) => createPayee(req),
     ...options,
   })
 }
 
 /**
- * Retrieves a specific daily exchange rate conversion rule by ID.
+ * Retrieves details of a specific payee.
  */
-export async function getExchangeRate(
+export async function getPayee(
   id: string,
-  _req: GetExchangeRateRequest
-): Promise<ExchangeRate> {
-  return request<ExchangeRate>({
+  _req: GetPayeeRequest
+): Promise<Payee> {
+  return request<Payee>({
     method: "GET",
-    url: `/api/v1/finance/exchange-rates/${id}`,
+    url: `/api/v1/finance/payees/${id}`,
   })
 }
 
-export function useGetExchangeRateQuery(
-  req: GetExchangeRateRequest,
-  options?: Omit<UseQueryOptions<ExchangeRate, Error>, "queryKey" | "queryFn">
+export function useGetPayeeQuery(
+  req: GetPayeeRequest,
+  options?: Omit<UseQueryOptions<Payee, Error>, "queryKey" | "queryFn">
 ) {
-  return useQuery<ExchangeRate, Error>({
-    queryKey: [`/api/v1/finance/exchange-rates/${req.id}`, req],
-    queryFn: () => getExchangeRate(req.id, req),
+  return useQuery<Payee, Error>({
+    queryKey: [`/api/v1/finance/payees/${req.id}`, req],
+    queryFn: () => getPayee(req.id, req),
     ...options,
   })
 }
 
 /**
- * Updates an existing daily exchange rate conversion coefficient.
+ * Updates a payee's name, matchers, defaults, or logo.
  */
-export async function updateExchangeRate(
+export async function updatePayee(
   id: string,
-  req: UpdateExchangeRateRequest
-): Promise<ExchangeRate> {
-  return request<ExchangeRate>({
+  req: UpdatePayeeRequest
+): Promise<Payee> {
+  const params = { ...req }
+  delete (params as Record<string, unknown>).id
+  delete (params as Record<string, unknown>).payee
+  return request<Payee>({
     method: "PUT",
-    url: `/api/v1/finance/exchange-rates/${id}`,
-    data: req.exchangeRate,
+    url: `/api/v1/finance/payees/${id}`,
+    params: params,
+    data: req.payee,
   })
 }
 
-export function useUpdateExchangeRateMutation(
+export function useUpdatePayeeMutation(
   options?: UseMutationOptions<
-    ExchangeRate,
+    Payee,
     Error,
-    { id: string; req: UpdateExchangeRateRequest }
+    { id: string; req: UpdatePayeeRequest }
+  >
+) {
+  return useMutation<Payee, Error, { id: string; req: UpdatePayeeRequest }>({
+    mutationFn: ({ id, req }) => updatePayee(id, req),
+    ...options,
+  })
+}
+
+/**
+ * Deletes a payee. Transactions linked to it keep their descriptions.
+ */
+export async function deletePayee(
+  id: string,
+  _req: DeletePayeeRequest
+): Promise<Record<string, never>> {
+  return request<Record<string, never>>({
+    method: "DELETE",
+    url: `/api/v1/finance/payees/${id}`,
+  })
+}
+
+export function useDeletePayeeMutation(
+  options?: UseMutationOptions<
+    Record<string, never>,
+    Error,
+    { id: string; req: DeletePayeeRequest }
   >
 ) {
   return useMutation<
-    ExchangeRate,
+    Record<string, never>,
     Error,
-    { id: string; req: UpdateExchangeRateRequest }
+    { id: string; req: DeletePayeeRequest }
   >({
-    mutationFn: ({ id, req }) => updateExchangeRate(id, req),
+    mutationFn: ({ id, req }) => deletePayee(id, req),
     ...options,
   })
 }
 
 /**
- * Lists historical exchange rate conversion coefficients.
+ * Lists payees configured in the space.
  */
-export async function listExchangeRates(
-  req: ListExchangeRatesRequest
-): Promise<ListExchangeRatesResponse> {
+export async function listPayees(
+  req: ListPayeesRequest
+): Promise<ListPayeesResponse> {
   const params = { ...req }
-  return request<ListExchangeRatesResponse>({
+  return request<ListPayeesResponse>({
     method: "GET",
-    url: "/api/v1/finance/exchange-rates",
+    url: "/api/v1/finance/payees",
     params: params,
   })
 }
 
-export function useListExchangeRatesQuery(
-  req: ListExchangeRatesRequest,
+export function useListPayeesQuery(
+  req: ListPayeesRequest,
   options?: Omit<
-    UseQueryOptions<ListExchangeRatesResponse, Error>,
+    UseQueryOptions<ListPayeesResponse, Error>,
     "queryKey" | "queryFn"
   >
 ) {
-  return useQuery<ListExchangeRatesResponse, Error>({
-    queryKey: ["/api/v1/finance/exchange-rates", req],
-    queryFn: () => listExchangeRates(req),
+  return useQuery<ListPayeesResponse, Error>({
+    queryKey: ["/api/v1/finance/payees", req],
+    queryFn: () => listPayees(req),
     ...options,
   })
 }
 
 /**
- * Deletes a registered daily exchange rate conversion rule.
+ * Creates a rule that assigns a budget, payee, category, or tags to matching transactions
+ * when they are created, imported, or ingested.
  */
-export async function deleteExchangeRate(
-  id: string,
-  _req: DeleteExchangeRateRequest
-): Promise<Record<string, never>> {
-  return request<Record<string, never>>({
-    method: "DELETE",
-    url: `/api/v1/finance/exchange-rates/${id}`,
+export async function createTransactionRule(
+  req: CreateTransactionRuleRequest
+): Promise<TransactionRule> {
+  return request<TransactionRule>({
+    method: "POST",
+    url: "/api/v1/finance/transaction-rules",
+    data: req.transactionRule,
   })
 }
 
-export function useDeleteExchangeRateMutation(
+export function useCreateTransactionRuleMutation(
   options?: UseMutationOptions<
-    Record<string, never>,
+    TransactionRule,
     Error,
-    { id: string; req: DeleteExchangeRateRequest }
+    CreateTransactionRuleRequest
   >
 ) {
-  return useMutation<
-    Record<string, never>,
-    Error,
-    { id: string; req: DeleteExchangeRateRequest }
-  >({
-    mutationFn: ({ id, req }) => deleteExchangeRate(id, req),
+  return useMutation<TransactionRule, Error, CreateTransactionRuleRequest>({
+    mutationFn: (req) => createTransactionRule(req),
     ...options,
   })
 }
 
 /**
- * Logs a new expense transaction, updating target budget periods and reducing account balances.
+ * Retrieves details of a specific transaction rule.
  */
-export async function createExpense(
-  req: CreateExpenseRequest
-): Promise<Transaction> {
-  return request<Transaction>({
-    method: "POST",
-    url: "/api/v1/finance/expenses",
-    data: req.expense,
+export async function getTransactionRule(
+  id: string,
+  _req: GetTransactionRuleRequest
+): Promise<TransactionRule> {
+  return request<TransactionRule>({
+    method: "GET",
+    url: `/api/v1/finance/transaction-rules/${id}`,
   })
 }
 
-export function useCreateExpenseMutation(
-  options?: UseMutationOptions<Transaction, Error, CreateExpenseRequest>
+export function useGetTransactionRuleQuery(
+  req: GetTransactionRuleRequest,
+  options?: Omit<
+    UseQueryOptions<TransactionRule, Error>,
+    "queryKey" | "queryFn"
+  >
 ) {
-  return useMutation<Transaction, Error, CreateExpenseRequest>({
-    mutationFn: (req) => createExpense(req),
+  return useQuery<TransactionRule, Error>({
+    queryKey: [`/api/v1/finance/transaction-rules/${req.id}`, req],
+    queryFn: () => getTransactionRule(req.id, req),
     ...options,
   })
 }
 
 /**
- * Modifies properties of a logged expense transaction. Re-calculates budget and currency conversions.
+ * Updates a transaction rule's conditions, actions, priority, or active state.
  */
-export async function updateExpense(
+export async function updateTransactionRule(
   id: string,
-  req: UpdateExpenseRequest
-): Promise<Transaction> {
-  return request<Transaction>({
+  req: UpdateTransactionRuleRequest
+): Promise<TransactionRule> {
+  const params = { ...req }
+  delete (params as Record<string, unknown>).id
+  delete (params as Record<string, unknown>).transactionRule
+  return request<TransactionRule>({
     method: "PUT",
-    url: `/api/v1/finance/expenses/${id}`,
-    data: req.expense,
+    url: `/api/v1/finance/transaction-rules/${id}`,
+    params: params,
+    data: req.transactionRule,
   })
 }
 
-export function useUpdateExpenseMutation(
+export function useUpdateTransactionRuleMutation(
   options?: UseMutationOptions<
-    Transaction,
+    TransactionRule,
     Error,
-    { id: string; req: UpdateExpenseRequest }
+    { id: string; req: UpdateTransactionRuleRequest }
   >
 ) {
   return useMutation<
-    Transaction,
+    TransactionRule,
     Error,
-    { id: string; req: UpdateExpenseRequest }
+    { id: string; req: UpdateTransactionRuleRequest }
   >({
-    mutationFn: ({ id, req }) => updateExpense(id, req),
+    mutationFn: ({ id, req }) => updateTransactionRule(id, req),
     ...options,
   })
 }
 
 /**
- * Deletes a transaction, reversing all changes to budget period consumption and account balances.
+ * Deletes a transaction rule. Transactions it already classified are unchanged.
  */
-export async function deleteTransaction(
+export async function deleteTransactionRule(
   id: string,
-  _req: DeleteTransactionRequest
+  _req: DeleteTransactionRuleRequest
 ): Promise<Record<string, never>> {
   return request<Record<string, never>>({
     method: "DELETE",
-    url: `/api/v1/finance/transactions/${id}`,
+    url: `/api/v1/finance/transaction-rules/${id}`,
   })
 }
 
-export function useDeleteTransactionMutation(
+export function useDeleteTransactionRuleMutation(
   options?: UseMutationOptions<
     Record<string, never>,
     Error,
-    { id: string; req: DeleteTransactionRequest }
+    { id: string; req: DeleteTransactionRuleRequest }
   >
 ) {
   return useMutation<
     Record<string, never>,
     Error,
-    { id: string; req: DeleteTransactionRequest }
+    { id: string; req: DeleteTransactionRuleRequest }
   >({
-    mutationFn: ({ id, req }) => deleteTransaction(id, req),
+    mutationFn: ({ id, req }) => deleteTransactionRule(id, req),
     ...options,
   })
 }
 
 /**
- * Lists paginated transaction records matching specific budget, account, source, or text queries.
+ * Lists transaction rules configured in the space, by ascending priority by default.
  */
-export async function listTransactions(
-  req: ListTransactionsRequest
-): Promise<ListTransactionsResponse> {
+export async function listTransactionRules(
+  req: ListTransactionRulesRequest
+): Promise<ListTransactionRulesResponse> {
   const params = { ...req }
-  return request<ListTransactionsResponse>({
+  return request<ListTransactionRulesResponse>({
     method: "GET",
-    url: "/api/v1/finance/transactions",
+    url: "/api/v1/finance/transaction-rules",
     params: params,
   })
 }
 
-export function useListTransactionsQuery(
-  req: ListTransactionsRequest,
+export function useListTransactionRulesQuery(
+  req: ListTransactionRulesRequest,
   options?: Omit<
-    UseQueryOptions<ListTransactionsResponse, Error>,
+    UseQueryOptions<ListTransactionRulesResponse, Error>,
     "queryKey" | "queryFn"
   >
 ) {
-  return useQuery<ListTransactionsResponse, Error>({
-    queryKey: ["/api/v1/finance/transactions", req],
-    queryFn: () => listTransactions(req),
+  return useQuery<ListTransactionRulesResponse, Error>({
+    queryKey: ["/api/v1/finance/transaction-rules", req],
+    queryFn: () => listTransactionRules(req),
+    ...options,
+  })
+}
+
+/**
+ * Re-runs the space's active rules and payees over existing expenses and income.
+ */
+export async function applyTransactionRules(
+  req: ApplyTransactionRulesRequest
+): Promise<ApplyTransactionRulesResponse> {
+  return request<ApplyTransactionRulesResponse>({
+    method: "POST",
+    url: "/api/v1/finance/transaction-rules:apply",
+    data: req,
+  })
+}
+
+export function useApplyTransactionRulesMutation(
+  options?: UseMutationOptions<
+    ApplyTransactionRulesResponse,
+    Error,
+    ApplyTransactionRulesRequest
+  >
+) {
+  return useMutation<
+    ApplyTransactionRulesResponse,
+    Error,
+    ApplyTransactionRulesRequest
+  >({
+    mutationFn: (req) => applyTransactionRules(req),
     ...options,
   })
 }
@@ -3007,6 +7164,145 @@ export function useGetInsightsQuery(
   })
 }
 
+/**
+ * Projects the balance of every active account day by day over the coming days, and flags the days
+ * an account is expected to drop below zero or below its low balance threshold.
+ */
+export async function forecastCashFlow(
+  req: ForecastCashFlowRequest
+): Promise<ForecastCashFlowResponse> {
+  const params = { ...req }
+  return request<ForecastCashFlowResponse>({
+    method: "GET",
+    url: "/api/v1/finance/insights/cash-flow-forecast",
+    params: params,
+  })
+}
+
+export function useForecastCashFlowQuery(
+  req: ForecastCashFlowRequest,
+  options?: Omit<
+    UseQueryOptions<ForecastCashFlowResponse, Error>,
+    "queryKey" | "queryFn"
+  >
+) {
+  return useQuery<ForecastCashFlowResponse, Error>({
+    queryKey: ["/api/v1/finance/insights/cash-flow-forecast", req],
+    queryFn: () => forecastCashFlow(req),
+    ...options,
+  })
+}
+
+/**
+ * Lists the alerts raised for the space, most recent first.
+ */
+export async function listAlerts(
+  req: ListAlertsRequest
+): Promise<ListAlertsResponse> {
+  const params = { ...req }
+  return request<ListAlertsResponse>({
+    method: "GET",
+    url: "/api/v1/finance/alerts",
+    params: params,
+  })
+}
+
+export function useListAlertsQuery(
+  req: ListAlertsRequest,
+  options?: Omit<
+    UseQueryOptions<ListAlertsResponse, Error>,
+    "queryKey" | "queryFn"
+  >
+) {
+  return useQuery<ListAlertsResponse, Error>({
+    queryKey: ["/api/v1/finance/alerts", req],
+    queryFn: () => listAlerts(req),
+    ...options,
+  })
+}
+
+/**
+ * Retrieves an alert by ID.
+ */
+export async function getAlert(
+  id: string,
+  _req: GetAlertRequest
+): Promise<Alert> {
+  return request<Alert>({
+    method: "GET",
+    url: `/api/v1/finance/alerts/${id}`,
+  })
+}
+
+export function useGetAlertQuery(
+  req: GetAlertRequest,
+  options?: Omit<UseQueryOptions<Alert, Error>, "queryKey" | "queryFn">
+) {
+  return useQuery<Alert, Error>({
+    queryKey: [`/api/v1/finance/alerts/${req.id}`, req],
+    queryFn: () => getAlert(req.id, req),
+    ...options,
+  })
+}
+
+/**
+ * Marks an alert as handled, removing it from the active alerts for good.
+ */
+export async function acknowledgeAlert(
+  id: string,
+  req: AcknowledgeAlertRequest
+): Promise<Alert> {
+  return request<Alert>({
+    method: "POST",
+    url: `/api/v1/finance/alerts/${id}:acknowledge`,
+    data: req,
+  })
+}
+
+export function useAcknowledgeAlertMutation(
+  options?: UseMutationOptions<
+    Alert,
+    Error,
+    { id: string; req: AcknowledgeAlertRequest }
+  >
+) {
+  return useMutation<
+    Alert,
+    Error,
+    { id: string; req: AcknowledgeAlertRequest }
+  >({
+    mutationFn: ({ id, req }) => acknowledgeAlert(id, req),
+    ...options,
+  })
+}
+
+/**
+ * Hides an alert from the active alerts until the given time.
+ */
+export async function snoozeAlert(
+  id: string,
+  req: SnoozeAlertRequest
+): Promise<Alert> {
+  return request<Alert>({
+    method: "POST",
+    url: `/api/v1/finance/alerts/${id}:snooze`,
+    data: req,
+  })
+}
+
+export function useSnoozeAlertMutation(
+  options?: UseMutationOptions<
+    Alert,
+    Error,
+    { id: string; req: SnoozeAlertRequest }
+  >
+) {
+  return useMutation<Alert, Error, { id: string; req: SnoozeAlertRequest }>({
+    mutationFn: ({ id, req }) => snoozeAlert(id, req),
+    ...options,
+  })
+}
+
 /**
  * Registers a recurring expense template, generating repeating payment obligations periodically.
  */
@@ -3095,33 +7391,91 @@ export function useDeleteRecurringExpenseMutation(
 }
 
 /**
- * Lists all configured recurring templates in the space.
+ * Lists all configured recurring templates in the space.
+ */
+export async function listRecurringExpenses(
+  req: ListRecurringExpensesRequest
+): Promise<ListRecurringExpensesResponse> {
+  const params = { ...req }
+  return request<ListRecurringExpensesResponse>({
+    method: "GET",
+    url: "/api/v1/finance/recurring-expenses",
+    params: params,
+  })
+}
+
+export function useListRecurringExpensesQuery(
+  req: ListRecurringExpensesRequest,
+  options?: Omit<
+    UseQueryOptions<ListRecurringExpensesResponse, Error>,
+    "queryKey" | "queryFn"
+  >
+) {
+  return useQuery<ListRecurringExpensesResponse, Error>({
+    queryKey: ["/api/v1/finance/recurring-expenses", req],
+    queryFn: () => listRecurringExpenses(req),
+    ...options,
+  })
+}
+
+/**
+ * Scans the space's expense history for periodic charges with a similar vendor, a stable amount and
+ * a regular cadence that no recurring expense tracks yet.
  */
-export async function listRecurringExpenses(
-  req: ListRecurringExpensesRequest
-): Promise<ListRecurringExpensesResponse> {
+export async function detectSubscriptions(
+  req: DetectSubscriptionsRequest
+): Promise<DetectSubscriptionsResponse> {
   const params = { ...req }
-  return request<ListRecurringExpensesResponse>({
+  return request<DetectSubscriptionsResponse>({
     method: "GET",
-    url: "/api/v1/finance/recurring-expenses",
+    url: "/api/v1/finance/recurring-expenses:detect",
     params: params,
   })
 }
 
-export function useListRecurringExpensesQuery(
-  req: ListRecurringExpensesRequest,
+export function useDetectSubscriptionsQuery(
+  req: DetectSubscriptionsRequest,
   options?: Omit<
-    UseQueryOptions<ListRecurringExpensesResponse, Error>,
+    UseQueryOptions<DetectSubscriptionsResponse, Error>,
     "queryKey" | "queryFn"
   >
 ) {
-  return useQuery<ListRecurringExpensesResponse, Error>({
-    queryKey: ["/api/v1/finance/recurring-expenses", req],
-    queryFn: () => listRecurringExpenses(req),
+  return useQuery<DetectSubscriptionsResponse, Error>({
+    queryKey: ["/api/v1/finance/recurring-expenses:detect", req],
+    queryFn: () => detectSubscriptions(req),
     ...options,
   })
 }
 
+/**
+ * Creates a recurring expense from a detected subscription and links its supporting transactions
+ * to the new template.
+ */
+export async function convertToRecurringExpense(
+  req: ConvertToRecurringExpenseRequest
+): Promise<RecurringExpense> {
+  return request<RecurringExpense>({
+    method: "POST",
+    url: "/api/v1/finance/recurring-expenses:convert",
+    data: req,
+  })
+}
+
+export function useConvertToRecurringExpenseMutation(
+  options?: UseMutationOptions<
+    RecurringExpense,
+    Error,
+    ConvertToRecurringExpenseRequest
+  >
+) {
+  return useMutation<RecurringExpense, Error, ConvertToRecurringExpenseRequest>(
+    {
+      mutationFn: (req) => convertToRecurringExpense(req),
+      ...options,
+    }
+  )
+}
+
 /**
  * Lists scheduled payment instances spawned by recurring templates.
  */
@@ -3606,6 +7960,268 @@ export function useAdjustAccountBalanceMutation(
   })
 }
 
+/**
+ * Lists the closed statements of a credit card, most recent first.
+ */
+export async function listCardStatements(
+  account_id: string,
+  req: ListCardStatementsRequest
+): Promise<ListCardStatementsResponse> {
+  const params = { ...req }
+  delete (params as Record<string, unknown>).accountId
+  return request<ListCardStatementsResponse>({
+    method: "GET",
+    url: `/api/v1/finance/accounts/${account_id}/card-statements`,
+    params: params,
+  })
+}
+
+export function useListCardStatementsQuery(
+  req: ListCardStatementsRequest,
+  options?: Omit<
+    UseQueryOptions<ListCardStatementsResponse, Error>,
+    "queryKey" | "queryFn"
+  >
+) {
+  return useQuery<ListCardStatementsResponse, Error>({
+    queryKey: [
+      `/api/v1/finance/accounts/${req.accountId}/card-statements`,
+      req,
+    ],
+    queryFn: () => listCardStatements(req.accountId, req),
+    ...options,
+  })
+}
+
+/**
+ * Retrieves a closed credit card statement.
+ */
+export async function getCardStatement(
+  id: string,
+  _req: GetCardStatementRequest
+): Promise<CardStatement> {
+  return request<CardStatement>({
+    method: "GET",
+    url: `/api/v1/finance/card-statements/${id}`,
+  })
+}
+
+export function useGetCardStatementQuery(
+  req: GetCardStatementRequest,
+  options?: Omit<UseQueryOptions<CardStatement, Error>, "queryKey" | "queryFn">
+) {
+  return useQuery<CardStatement, Error>({
+    queryKey: [`/api/v1/finance/card-statements/${req.id}`, req],
+    queryFn: () => getCardStatement(req.id, req),
+    ...options,
+  })
+}
+
+/**
+ * Opens a reconciliation session matching an account's cleared transactions against a bank statement.
+ */
+export async function startReconciliation(
+  account_id: string,
+  req: StartReconciliationRequest
+): Promise<Reconciliation> {
+  return request<Reconciliation>({
+    method: "POST",
+    url: `/api/v1/finance/accounts/${account_id}/reconciliations`,
+    data: req.reconciliation,
+  })
+}
+
+export function useStartReconciliationMutation(
+  options?: UseMutationOptions<
+    Reconciliation,
+    Error,
+    { account_id: string; req: StartReconciliationRequest }
+  >
+) {
+  return useMutation<
+    Reconciliation,
+    Error,
+    { account_id: string; req: StartReconciliationRequest }
+  >({
+    mutationFn: ({ account_id, req }) => startReconciliation(account_id, req),
+    ...options,
+  })
+}
+
+/**
+ * Retrieves a reconciliation session. Balances of sessions in progress are recalculated.
+ */
+export async function getReconciliation(
+  id: string,
+  _req: GetReconciliationRequest
+): Promise<Reconciliation> {
+  return request<Reconciliation>({
+    method: "GET",
+    url: `/api/v1/finance/reconciliations/${id}`,
+  })
+}
+
+export function useGetReconciliationQuery(
+  req: GetReconciliationRequest,
+  options?: Omit<UseQueryOptions<Reconciliation, Error>, "queryKey" | "queryFn">
+) {
+  return useQuery<Reconciliation, Error>({
+    queryKey: [`/api/v1/finance/reconciliations/${req.id}`, req],
+    queryFn: () => getReconciliation(req.id, req),
+    ...options,
+  })
+}
+
+/**
+ * Lists the reconciliation history of an account, most recent statement first.
+ */
+export async function listReconciliations(
+  account_id: string,
+  _req: ListReconciliationsRequest
+): Promise<ListReconciliationsResponse> {
+  return request<ListReconciliationsResponse>({
+    method: "GET",
+    url: `/api/v1/finance/accounts/${account_id}/reconciliations`,
+  })
+}
+
+export function useListReconciliationsQuery(
+  req: ListReconciliationsRequest,
+  options?: Omit<
+    UseQueryOptions<ListReconciliationsResponse, Error>,
+    "queryKey" | "queryFn"
+  >
+) {
+  return useQuery<ListReconciliationsResponse, Error>({
+    queryKey: [
+      `/api/v1/finance/accounts/${req.accountId}/reconciliations`,
+      req,
+    ],
+    queryFn: () => listReconciliations(req.accountId, req),
+    ...options,
+  })
+}
+
+/**
+ * Lists the transactions covered by a reconciliation session.
+ */
+export async function listReconciliationTransactions(
+  id: string,
+  _req: ListReconciliationTransactionsRequest
+): Promise<ListReconciliationTransactionsResponse> {
+  return request<ListReconciliationTransactionsResponse>({
+    method: "GET",
+    url: `/api/v1/finance/reconciliations/${id}/transactions`,
+  })
+}
+
+export function useListReconciliationTransactionsQuery(
+  req: ListReconciliationTransactionsRequest,
+  options?: Omit<
+    UseQueryOptions<ListReconciliationTransactionsResponse, Error>,
+    "queryKey" | "queryFn"
+  >
+) {
+  return useQuery<ListReconciliationTransactionsResponse, Error>({
+    queryKey: [`/api/v1/finance/reconciliations/${req.id}/transactions`, req],
+    queryFn: () => listReconciliationTransactions(req.id, req),
+    ...options,
+  })
+}
+
+/**
+ * Marks transactions as cleared, or uncleared, within a reconciliation session in progress.
+ */
+export async function setTransactionsCleared(
+  reconciliation_id: string,
+  req: SetTransactionsClearedRequest
+): Promise<Reconciliation> {
+  return request<Reconciliation>({
+    method: "POST",
+    url: `/api/v1/finance/reconciliations/${reconciliation_id}:set-cleared`,
+    data: req,
+  })
+}
+
+export function useSetTransactionsClearedMutation(
+  options?: UseMutationOptions<
+    Reconciliation,
+    Error,
+    { reconciliation_id: string; req: SetTransactionsClearedRequest }
+  >
+) {
+  return useMutation<
+    Reconciliation,
+    Error,
+    { reconciliation_id: string; req: SetTransactionsClearedRequest }
+  >({
+    mutationFn: ({ reconciliation_id, req }) =>
+      setTransactionsCleared(reconciliation_id, req),
+    ...options,
+  })
+}
+
+/**
+ * Finalizes a balanced reconciliation session, locking its cleared transactions from edits.
+ */
+export async function finalizeReconciliation(
+  id: string,
+  req: FinalizeReconciliationRequest
+): Promise<Reconciliation> {
+  return request<Reconciliation>({
+    method: "POST",
+    url: `/api/v1/finance/reconciliations/${id}:finalize`,
+    data: req,
+  })
+}
+
+export function useFinalizeReconciliationMutation(
+  options?: UseMutationOptions<
+    Reconciliation,
+    Error,
+    { id: string; req: FinalizeReconciliationRequest }
+  >
+) {
+  return useMutation<
+    Reconciliation,
+    Error,
+    { id: string; req: FinalizeReconciliationRequest }
+  >({
+    mutationFn: ({ id, req }) => finalizeReconciliation(id, req),
+    ...options,
+  })
+}
+
+/**
+ * Discards a reconciliation session in progress. Cleared flags are kept.
+ */
+export async function cancelReconciliation(
+  id: string,
+  _req: CancelReconciliationRequest
+): Promise<Record<string, never>> {
+  return request<Record<string, never>>({
+    method: "DELETE",
+    url: `/api/v1/finance/reconciliations/${id}`,
+  })
+}
+
+export function useCancelReconciliationMutation(
+  options?: UseMutationOptions<
+    Record<string, never>,
+    Error,
+    { id: string; req: CancelReconciliationRequest }
+  >
+) {
+  return useMutation<
+    Record<string, never>,
+    Error,
+    { id: string; req: CancelReconciliationRequest }
+  >({
+    mutationFn: ({ id, req }) => cancelReconciliation(id, req),
+    ...options,
+  })
+}
+
 /**
  * Deletes a payment account. Default accounts cannot be deleted until another default is nominated.
  */
@@ -3715,7 +8331,7 @@ export function useListTransfersQuery(
 }
 
 /**
- * Retrieves a static list of supported currencies.
+ * Retrieves the ISO 4217 currency catalog with minor units, flagging the currencies enabled in the workspace.
  */
 export async function listCurrencies(
   _req?: ListCurrenciesRequest
@@ -3859,3 +8475,140 @@ export function useDiscardInboxItemMutation(
     ...options,
   })
 }
+
+/**
+ * Imports a bank statement file (CSV, OFX/QFX or CAMT.053) for an account and stages
+ * each row as an inbox item. Rows already staged by a previous import are skipped.
+ */
+export async function importStatement(
+  account_id: string,
+  req: ImportStatementRequest
+): Promise<ImportStatementResponse> {
+  return request<ImportStatementResponse>({
+    method: "POST",
+    url: `/api/v1/finance/accounts/${account_id}/statements:import`,
+    data: req,
+  })
+}
+
+export function useImportStatementMutation(
+  options?: UseMutationOptions<
+    ImportStatementResponse,
+    Error,
+    { account_id: string; req: ImportStatementRequest }
+  >
+) {
+  return useMutation<
+    ImportStatementResponse,
+    Error,
+    { account_id: string; req: ImportStatementRequest }
+  >({
+    mutationFn: ({ account_id, req }) => importStatement(account_id, req),
+    ...options,
+  })
+}
+
+/**
+ * Retrieves the saved CSV column mapping for an account.
+ */
+export async function getStatementMapping(
+  account_id: string,
+  _req: GetStatementMappingRequest
+): Promise<StatementMapping> {
+  return request<StatementMapping>({
+    method: "GET",
+    url: `/api/v1/finance/accounts/${account_id}/statement-mapping`,
+  })
+}
+
+export function useGetStatementMappingQuery(
+  req: GetStatementMappingRequest,
+  options?: Omit<
+    UseQueryOptions<StatementMapping, Error>,
+    "queryKey" | "queryFn"
+  >
+) {
+  return useQuery<StatementMapping, Error>({
+    queryKey: [
+      `/api/v1/finance/accounts/${req.accountId}/statement-mapping`,
+      req,
+    ],
+    queryFn: () => getStatementMapping(req.accountId, req),
+    ...options,
+  })
+}
+
+/**
+ * Queues a ledger export to run as a background job. Use this for large date ranges and
+ * download the result once the export has completed.
+ */
+export async function createLedgerExport(
+  req: CreateLedgerExportRequest
+): Promise<LedgerExport> {
+  return request<LedgerExport>({
+    method: "POST",
+    url: "/api/v1/finance/ledger-exports",
+    data: req.ledgerExport,
+  })
+}
+
+export function useCreateLedgerExportMutation(
+  options?: UseMutationOptions<LedgerExport, Error, CreateLedgerExportRequest>
+) {
+  return useMutation<LedgerExport, Error, CreateLedgerExportRequest>({
+    mutationFn: (req) => createLedgerExport(req),
+    ...options,
+  })
+}
+
+/**
+ * Retrieves the status of a background ledger export.
+ */
+export async function getLedgerExport(
+  id: string,
+  _req: GetLedgerExportRequest
+): Promise<LedgerExport> {
+  return request<LedgerExport>({
+    method: "GET",
+    url: `/api/v1/finance/ledger-exports/${id}`,
+  })
+}
+
+export function useGetLedgerExportQuery(
+  req: GetLedgerExportRequest,
+  options?: Omit<UseQueryOptions<LedgerExport, Error>, "queryKey" | "queryFn">
+) {
+  return useQuery<LedgerExport, Error>({
+    queryKey: [`/api/v1/finance/ledger-exports/${req.id}`, req],
+    queryFn: () => getLedgerExport(req.id, req),
+    ...options,
+  })
+}
+
+/**
+ * Lists background ledger exports in the space, newest first.
+ */
+export async function listLedgerExports(
+  req: ListLedgerExportsRequest
+): Promise<ListLedgerExportsResponse> {
+  const params = { ...req }
+  return request<ListLedgerExportsResponse>({
+    method: "GET",
+    url: "/api/v1/finance/ledger-exports",
+    params: params,
+  })
+}
+
+export function useListLedgerExportsQuery(
+  req: ListLedgerExportsRequest,
+  options?: Omit<
+    UseQueryOptions<ListLedgerExportsResponse, Error>,
+    "queryKey" | "queryFn"
+  >
+) {
+  return useQuery<ListLedgerExportsResponse, Error>({
+    queryKey: ["/api/v1/finance/ledger-exports", req],
+    queryFn: () => listLedgerExports(req),
+    ...options,
+  })
+}
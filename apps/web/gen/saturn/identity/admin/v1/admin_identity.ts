@@ -72,6 +72,12 @@ export interface RevokeAllSessionsResponse {
   revokedCount: string
 }
 
+export interface ResetUserMFARequest {
+  userId: string
+}
+
+export type ResetUserMFAResponse = Record<string, never>
+
 export interface UpdateUserRoleResponse {
   user: User
 }
@@ -94,6 +100,7 @@ export interface User {
   version: string
   createTime: string
   updateTime: string
+  emailVerified: boolean
 }
 
 export interface SecurityEvent {
@@ -273,6 +280,37 @@ export function useRevokeAllSessionsMutation(
   })
 }
 
+/**
+ * ResetUserMFA removes a user's two-factor authentication and signs them out everywhere.
+ */
+export async function resetUserMFA(
+  user_id: string,
+  req: ResetUserMFARequest
+): Promise<ResetUserMFAResponse> {
+  return request<ResetUserMFAResponse>({
+    method: "POST",
+    url: `/api/v1/admin/identity/users/${user_id}:reset-mfa`,
+    data: req,
+  })
+}
+
+export function useResetUserMFAMutation(
+  options?: UseMutationOptions<
+    ResetUserMFAResponse,
+    Error,
+    { user_id: string; req: ResetUserMFARequest }
+  >
+) {
+  return useMutation<
+    ResetUserMFAResponse,
+    Error,
+    { user_id: string; req: ResetUserMFARequest }
+  >({
+    mutationFn: ({ user_id, req }) => resetUserMFA(user_id, req),
+    ...options,
+  })
+}
+
 /**
  * ListSecurityEvents returns a list of security audit logs.
  */
@@ -17,6 +17,14 @@ export interface LoginUserRequest {
    * UserPassword authentication method.
    */
   userPassword?: LoginUserRequest_UserPassword
+  /**
+   * MFAChallenge second step of a two-factor login.
+   */
+  mfaChallenge?: LoginUserRequest_MFAChallenge
+  /**
+   * Passkey authentication method. It does not require a second factor.
+   */
+  passkey?: LoginUserRequest_PasskeyAssertion
 }
 
 /**
@@ -33,6 +41,38 @@ export interface LoginUserRequest_UserPassword {
   password: string
 }
 
+/**
+ * MFAChallenge completes a login that returned mfa_required.
+ */
+export interface LoginUserRequest_MFAChallenge {
+  /**
+   * The mfa_token returned by the password step.
+   */
+  mfaToken: string
+  /**
+   * A code from the authenticator app.
+   */
+  code: string
+  /**
+   * A one-time recovery code, used instead of code.
+   */
+  recoveryCode: string
+}
+
+/**
+ * PasskeyAssertion completes a login started with BeginPasskeyLogin.
+ */
+export interface LoginUserRequest_PasskeyAssertion {
+  /**
+   * The ceremony_id returned by BeginPasskeyLogin.
+   */
+  ceremonyId: string
+  /**
+   * The JSON serialized PublicKeyCredential returned by navigator.credentials.get.
+   */
+  credential: string
+}
+
 /**
  * LoginUserResponse contains the authentication result with both tokens.
  */
@@ -57,6 +97,19 @@ export interface LoginUserResponse {
    * Expiration time of the refresh token (Unix seconds).
    */
   refreshTokenExpiresAt: string
+  /**
+   * Set when the password was correct but a second factor is required. No tokens are issued;
+   * call LoginUser again with mfa_challenge.
+   */
+  mfaRequired: boolean
+  /**
+   * The challenge token to present with the second factor.
+   */
+  mfaToken: string
+  /**
+   * Expiration time of the challenge token (Unix seconds).
+   */
+  mfaTokenExpiresAt: string
 }
 
 /**
@@ -80,9 +133,26 @@ export interface RegisterUserRequest {
    */
   avatarUrl: string
   /**
-   * The user's password for authentication.
+   * The user's password for authentication. Required unless the account is registered with a passkey.
    */
   password: string
+  /**
+   * Optional space invitation token. It verifies the email address; the invitation is
+   * accepted once the account can sign in.
+   */
+  invitationToken: string
+  /**
+   * The ceremony_id returned by BeginPasskeySignup, to register with a passkey.
+   */
+  passkeyCeremonyId: string
+  /**
+   * The JSON serialized PublicKeyCredential returned by navigator.credentials.create.
+   */
+  passkeyCredential: string
+  /**
+   * Optional name of the passkey.
+   */
+  passkeyName: string
 }
 
 /**
@@ -117,6 +187,10 @@ export interface User {
    * The optimistic locking version.
    */
   version: string
+  /**
+   * Whether the user has verified their email address.
+   */
+  emailVerified: boolean
   /**
    * The creation timestamp.
    */
@@ -240,6 +314,234 @@ export interface ListMySecurityEventsResponse {
   nextPageToken: string
 }
 
+export type GetMFAStatusRequest = Record<string, never>
+
+/**
+ * MFAStatus describes the second factors of a user.
+ */
+export interface MFAStatus {
+  /**
+   * Whether a confirmed authenticator app is required at login.
+   */
+  enabled: boolean
+  /**
+   * When two-factor authentication was enabled.
+   */
+  confirmTime: string
+  /**
+   * Number of unused recovery codes.
+   */
+  recoveryCodesRemaining: number
+}
+
+export type EnrollTOTPRequest = Record<string, never>
+
+export interface EnrollTOTPResponse {
+  /**
+   * Base32 secret for manual entry.
+   */
+  secret: string
+  /**
+   * otpauth:// URI to render as a QR code.
+   */
+  uri: string
+}
+
+export interface ConfirmTOTPRequest {
+  /**
+   * A code from the authenticator app.
+   */
+  code: string
+}
+
+/**
+ * RecoveryCodesResponse returns one-time recovery codes. They are not retrievable again.
+ */
+export interface RecoveryCodesResponse {
+  recoveryCodes: string[]
+}
+
+export interface RegenerateRecoveryCodesRequest {
+  /**
+   * A code from the authenticator app.
+   */
+  code: string
+  /**
+   * A recovery code, used instead of code.
+   */
+  recoveryCode: string
+}
+
+export interface DisableMFARequest {
+  /**
+   * A code from the authenticator app.
+   */
+  code: string
+  /**
+   * A recovery code, used instead of code.
+   */
+  recoveryCode: string
+}
+
+export type DisableMFAResponse = Record<string, never>
+
+/**
+ * PasskeyChallenge starts a WebAuthn ceremony in the browser.
+ */
+export interface PasskeyChallenge {
+  /**
+   * Identifies the ceremony when completing it.
+   */
+  ceremonyId: string
+  /**
+   * JSON options for navigator.credentials.create or navigator.credentials.get, under "publicKey".
+   */
+  options: string
+  /**
+   * The ceremony must be completed before this time.
+   */
+  expireTime: string
+}
+
+/**
+ * Passkey is a WebAuthn credential registered by the user.
+ */
+export interface Passkey {
+  /**
+   * The passkey identifier.
+   */
+  id: string
+  /**
+   * The name chosen by the user.
+   */
+  name: string
+  /**
+   * Transports the authenticator reported, e.g. "internal", "usb", "hybrid".
+   */
+  transports: string[]
+  /**
+   * Whether the passkey is synced between devices.
+   */
+  backedUp: boolean
+  /**
+   * When the passkey was registered.
+   */
+  createTime: string
+  /**
+   * When the passkey was last used to sign in.
+   */
+  lastUsedTime: string
+}
+
+export interface BeginPasskeyLoginRequest {
+  /**
+   * Optional username or email. Without it the browser offers every passkey it holds for the site.
+   */
+  identifier: string
+}
+
+export interface BeginPasskeySignupRequest {
+  /**
+   * The email address of the new account.
+   */
+  email: string
+  /**
+   * The username of the new account.
+   */
+  username: string
+  /**
+   * The display name of the new account.
+   */
+  name: string
+}
+
+export type BeginPasskeyRegistrationRequest = Record<string, never>
+
+export interface CreatePasskeyRequest {
+  /**
+   * The ceremony_id returned by BeginPasskeyRegistration.
+   */
+  ceremonyId: string
+  /**
+   * The JSON serialized PublicKeyCredential returned by navigator.credentials.create.
+   */
+  credential: string
+  /**
+   * Optional name of the passkey, e.g. "Work laptop".
+   */
+  name: string
+}
+
+export type ListPasskeysRequest = Record<string, never>
+
+export interface ListPasskeysResponse {
+  passkeys: Passkey[]
+}
+
+export interface RenamePasskeyRequest {
+  passkeyId: string
+  name: string
+}
+
+export interface DeletePasskeyRequest {
+  passkeyId: string
+}
+
+export type DeletePasskeyResponse = Record<string, never>
+
+export interface RequestPasswordResetRequest {
+  /**
+   * The email address of the account.
+   */
+  email: string
+}
+
+export type RequestPasswordResetResponse = Record<string, never>
+
+export interface ConfirmPasswordResetRequest {
+  /**
+   * The token from the password reset link.
+   */
+  token: string
+  /**
+   * The new password.
+   */
+  newPassword: string
+}
+
+export type ConfirmPasswordResetResponse = Record<string, never>
+
+export interface ChangePasswordRequest {
+  /**
+   * The password the user signs in with today.
+   */
+  currentPassword: string
+  /**
+   * The new password.
+   */
+  newPassword: string
+}
+
+export type ChangePasswordResponse = Record<string, never>
+
+export interface ResendVerificationEmailRequest {
+  /**
+   * The email address of the account.
+   */
+  email: string
+}
+
+export type ResendVerificationEmailResponse = Record<string, never>
+
+export interface VerifyEmailRequest {
+  /**
+   * The token from the verification link.
+   */
+  token: string
+}
+
+export type VerifyEmailResponse = Record<string, never>
+
 /**
  * Identity service provides user authentication and account management.
  */
@@ -468,3 +770,449 @@ export function useListMySecurityEventsQuery(
     ...options,
   })
 }
+
+/**
+ * GetMFAStatus reports whether the authenticated user has two-factor authentication enabled.
+ */
+export async function getMFAStatus(
+  _req?: GetMFAStatusRequest
+): Promise<MFAStatus> {
+  return request<MFAStatus>({
+    method: "GET",
+    url: "/api/v1/identity/users/me/mfa",
+  })
+}
+
+export function useGetMFAStatusQuery(
+  req: GetMFAStatusRequest,
+  options?: Omit<UseQueryOptions<MFAStatus, Error>, "queryKey" | "queryFn">
+) {
+  return useQuery<MFAStatus, Error>({
+    queryKey: ["/api/v1/identity/users/me/mfa", req],
+    queryFn: () => getMFAStatus(req),
+    ...options,
+  })
+}
+
+/**
+ * EnrollTOTP generates a TOTP secret for an authenticator app. MFA is enforced once confirmed.
+ */
+export async function enrollTOTP(
+  req?: EnrollTOTPRequest
+): Promise<EnrollTOTPResponse> {
+  return request<EnrollTOTPResponse>({
+    method: "POST",
+    url: "/api/v1/identity/users/me/mfa/totp:enroll",
+    data: req,
+  })
+}
+
+export function useEnrollTOTPMutation(
+  options?: UseMutationOptions<EnrollTOTPResponse, Error, EnrollTOTPRequest>
+) {
+  return useMutation<EnrollTOTPResponse, Error, EnrollTOTPRequest>({
+    mutationFn: (req) => enrollTOTP(req),
+    ...options,
+  })
+}
+
+/**
+ * ConfirmTOTP verifies the first code from the authenticator app and returns recovery codes.
+ */
+export async function confirmTOTP(
+  req: ConfirmTOTPRequest
+): Promise<RecoveryCodesResponse> {
+  return request<RecoveryCodesResponse>({
+    method: "POST",
+    url: "/api/v1/identity/users/me/mfa/totp:confirm",
+    data: req,
+  })
+}
+
+export function useConfirmTOTPMutation(
+  options?: UseMutationOptions<RecoveryCodesResponse, Error, ConfirmTOTPRequest>
+) {
+  return useMutation<RecoveryCodesResponse, Error, ConfirmTOTPRequest>({
+    mutationFn: (req) => confirmTOTP(req),
+    ...options,
+  })
+}
+
+/**
+ * RegenerateRecoveryCodes replaces the recovery codes of the authenticated user.
+ */
+export async function regenerateRecoveryCodes(
+  req: RegenerateRecoveryCodesRequest
+): Promise<RecoveryCodesResponse> {
+  return request<RecoveryCodesResponse>({
+    method: "POST",
+    url: "/api/v1/identity/users/me/mfa/recovery-codes:regenerate",
+    data: req,
+  })
+}
+
+export function useRegenerateRecoveryCodesMutation(
+  options?: UseMutationOptions<
+    RecoveryCodesResponse,
+    Error,
+    RegenerateRecoveryCodesRequest
+  >
+) {
+  return useMutation<
+    RecoveryCodesResponse,
+    Error,
+    RegenerateRecoveryCodesRequest
+  >({
+    mutationFn: (req) => regenerateRecoveryCodes(req),
+    ...options,
+  })
+}
+
+/**
+ * DisableMFA removes two-factor authentication from the authenticated user.
+ */
+export async function disableMFA(
+  req: DisableMFARequest
+): Promise<DisableMFAResponse> {
+  return request<DisableMFAResponse>({
+    method: "POST",
+    url: "/api/v1/identity/users/me/mfa:disable",
+    data: req,
+  })
+}
+
+export function useDisableMFAMutation(
+  options?: UseMutationOptions<DisableMFAResponse, Error, DisableMFARequest>
+) {
+  return useMutation<DisableMFAResponse, Error, DisableMFARequest>({
+    mutationFn: (req) => disableMFA(req),
+    ...options,
+  })
+}
+
+/**
+ * BeginPasskeyLogin starts a passkey login. Complete it with LoginUser and the passkey method.
+ */
+export async function beginPasskeyLogin(
+  req: BeginPasskeyLoginRequest
+): Promise<PasskeyChallenge> {
+  return request<PasskeyChallenge>({
+    method: "POST",
+    url: "/api/v1/identity/passkeys:begin-login",
+    data: req,
+  })
+}
+
+export function useBeginPasskeyLoginMutation(
+  options?: UseMutationOptions<
+    PasskeyChallenge,
+    Error,
+    BeginPasskeyLoginRequest
+  >
+) {
+  return useMutation<PasskeyChallenge, Error, BeginPasskeyLoginRequest>({
+    mutationFn: (req) => beginPasskeyLogin(req),
+    ...options,
+  })
+}
+
+/**
+ * BeginPasskeySignup starts creating the passkey of a new account that has no password.
+ * Complete it with RegisterUser and the passkey fields.
+ */
+export async function beginPasskeySignup(
+  req: BeginPasskeySignupRequest
+): Promise<PasskeyChallenge> {
+  return request<PasskeyChallenge>({
+    method: "POST",
+    url: "/api/v1/identity/passkeys:begin-signup",
+    data: req,
+  })
+}
+
+export function useBeginPasskeySignupMutation(
+  options?: UseMutationOptions<
+    PasskeyChallenge,
+    Error,
+    BeginPasskeySignupRequest
+  >
+) {
+  return useMutation<PasskeyChallenge, Error, BeginPasskeySignupRequest>({
+    mutationFn: (req) => beginPasskeySignup(req),
+    ...options,
+  })
+}
+
+/**
+ * BeginPasskeyRegistration starts adding a passkey to the authenticated user. Complete it with CreatePasskey.
+ */
+export async function beginPasskeyRegistration(
+  req?: BeginPasskeyRegistrationRequest
+): Promise<PasskeyChallenge> {
+  return request<PasskeyChallenge>({
+    method: "POST",
+    url: "/api/v1/identity/users/me/passkeys:begin",
+    data: req,
+  })
+}
+
+export function useBeginPasskeyRegistrationMutation(
+  options?: UseMutationOptions<
+    PasskeyChallenge,
+    Error,
+    BeginPasskeyRegistrationRequest
+  >
+) {
+  return useMutation<PasskeyChallenge, Error, BeginPasskeyRegistrationRequest>({
+    mutationFn: (req) => beginPasskeyRegistration(req),
+    ...options,
+  })
+}
+
+/**
+ * CreatePasskey verifies and stores the passkey of a registration ceremony.
+ */
+export async function createPasskey(
+  req: CreatePasskeyRequest
+): Promise<Passkey> {
+  return request<Passkey>({
+    method: "POST",
+    url: "/api/v1/identity/users/me/passkeys",
+    data: req,
+  })
+}
+
+export function useCreatePasskeyMutation(
+  options?: UseMutationOptions<Passkey, Error, CreatePasskeyRequest>
+) {
+  return useMutation<Passkey, Error, CreatePasskeyRequest>({
+    mutationFn: (req) => createPasskey(req),
+    ...options,
+  })
+}
+
+/**
+ * ListPasskeys lists the passkeys of the authenticated user.
+ */
+export async function listPasskeys(
+  _req?: ListPasskeysRequest
+): Promise<ListPasskeysResponse> {
+  return request<ListPasskeysResponse>({
+    method: "GET",
+    url: "/api/v1/identity/users/me/passkeys",
+  })
+}
+
+export function useListPasskeysQuery(
+  req: ListPasskeysRequest,
+  options?: Omit<
+    UseQueryOptions<ListPasskeysResponse, Error>,
+    "queryKey" | "queryFn"
+  >
+) {
+  return useQuery<ListPasskeysResponse, Error>({
+    queryKey: ["/api/v1/identity/users/me/passkeys", req],
+    queryFn: () => listPasskeys(req),
+    ...options,
+  })
+}
+
+/**
+ * RenamePasskey changes the name of a passkey.
+ */
+export async function renamePasskey(
+  passkey_id: string,
+  req: RenamePasskeyRequest
+): Promise<Passkey> {
+  return request<Passkey>({
+    method: "POST",
+    url: `/api/v1/identity/users/me/passkeys/${passkey_id}:rename`,
+    data: req,
+  })
+}
+
+export function useRenamePasskeyMutation(
+  options?: UseMutationOptions<
+    Passkey,
+    Error,
+    { passkey_id: string; req: RenamePasskeyRequest }
+  >
+) {
+  return useMutation<
+    Passkey,
+    Error,
+    { passkey_id: string; req: RenamePasskeyRequest }
+  >({
+    mutationFn: ({ passkey_id, req }) => renamePasskey(passkey_id, req),
+    ...options,
+  })
+}
+
+/**
+ * DeletePasskey removes a passkey. The last passkey of an account without a password cannot be removed.
+ */
+export async function deletePasskey(
+  passkey_id: string,
+  _req: DeletePasskeyRequest
+): Promise<DeletePasskeyResponse> {
+  return request<DeletePasskeyResponse>({
+    method: "DELETE",
+    url: `/api/v1/identity/users/me/passkeys/${passkey_id}`,
+  })
+}
+
+export function useDeletePasskeyMutation(
+  options?: UseMutationOptions<
+    DeletePasskeyResponse,
+    Error,
+    { passkey_id: string; req: DeletePasskeyRequest }
+  >
+) {
+  return useMutation<
+    DeletePasskeyResponse,
+    Error,
+    { passkey_id: string; req: DeletePasskeyRequest }
+  >({
+    mutationFn: ({ passkey_id, req }) => deletePasskey(passkey_id, req),
+    ...options,
+  })
+}
+
+/**
+ * RequestPasswordReset mails a password reset link. It succeeds whether or not the account exists.
+ */
+export async function requestPasswordReset(
+  req: RequestPasswordResetRequest
+): Promise<RequestPasswordResetResponse> {
+  return request<RequestPasswordResetResponse>({
+    method: "POST",
+    url: "/api/v1/identity/password:request-reset",
+    data: req,
+  })
+}
+
+export function useRequestPasswordResetMutation(
+  options?: UseMutationOptions<
+    RequestPasswordResetResponse,
+    Error,
+    RequestPasswordResetRequest
+  >
+) {
+  return useMutation<
+    RequestPasswordResetResponse,
+    Error,
+    RequestPasswordResetRequest
+  >({
+    mutationFn: (req) => requestPasswordReset(req),
+    ...options,
+  })
+}
+
+/**
+ * ConfirmPasswordReset sets a new password with the token of a reset link and signs the user out everywhere.
+ */
+export async function confirmPasswordReset(
+  req: ConfirmPasswordResetRequest
+): Promise<ConfirmPasswordResetResponse> {
+  return request<ConfirmPasswordResetResponse>({
+    method: "POST",
+    url: "/api/v1/identity/password:reset",
+    data: req,
+  })
+}
+
+export function useConfirmPasswordResetMutation(
+  options?: UseMutationOptions<
+    ConfirmPasswordResetResponse,
+    Error,
+    ConfirmPasswordResetRequest
+  >
+) {
+  return useMutation<
+    ConfirmPasswordResetResponse,
+    Error,
+    ConfirmPasswordResetRequest
+  >({
+    mutationFn: (req) => confirmPasswordReset(req),
+    ...options,
+  })
+}
+
+/**
+ * ChangePassword replaces the password of the authenticated user and signs them out everywhere.
+ */
+export async function changePassword(
+  req: ChangePasswordRequest
+): Promise<ChangePasswordResponse> {
+  return request<ChangePasswordResponse>({
+    method: "POST",
+    url: "/api/v1/identity/users/me/password:change",
+    data: req,
+  })
+}
+
+export function useChangePasswordMutation(
+  options?: UseMutationOptions<
+    ChangePasswordResponse,
+    Error,
+    ChangePasswordRequest
+  >
+) {
+  return useMutation<ChangePasswordResponse, Error, ChangePasswordRequest>({
+    mutationFn: (req) => changePassword(req),
+    ...options,
+  })
+}
+
+/**
+ * ResendVerificationEmail mails a new email verification link to an unverified account. It succeeds
+ * whether or not the account exists.
+ */
+export async function resendVerificationEmail(
+  req: ResendVerificationEmailRequest
+): Promise<ResendVerificationEmailResponse> {
+  return request<ResendVerificationEmailResponse>({
+    method: "POST",
+    url: "/api/v1/identity/email:resend-verification",
+    data: req,
+  })
+}
+
+export function useResendVerificationEmailMutation(
+  options?: UseMutationOptions<
+    ResendVerificationEmailResponse,
+    Error,
+    ResendVerificationEmailRequest
+  >
+) {
+  return useMutation<
+    ResendVerificationEmailResponse,
+    Error,
+    ResendVerificationEmailRequest
+  >({
+    mutationFn: (req) => resendVerificationEmail(req),
+    ...options,
+  })
+}
+
+/**
+ * VerifyEmail verifies an email address with the token of a verification link.
+ */
+export async function verifyEmail(
+  req: VerifyEmailRequest
+): Promise<VerifyEmailResponse> {
+  return request<VerifyEmailResponse>({
+    method: "POST",
+    url: "/api/v1/identity/email:verify",
+    data: req,
+  })
+}
+
+export function useVerifyEmailMutation(
+  options?: UseMutationOptions<VerifyEmailResponse, Error, VerifyEmailRequest>
+) {
+  return useMutation<VerifyEmailResponse, Error, VerifyEmailRequest>({
+    mutationFn: (req) => verifyEmail(req),
+    ...options,
+  })
+}
@@ -243,6 +243,140 @@ export interface ListSpaceMembersResponse {
   nextPageToken: string
 }
 
+/**
+ * Invitation offers membership of a workspace to an email address.
+ */
+export interface Invitation {
+  /**
+   * The invitation's unique identifier.
+   */
+  id?: string
+  /**
+   * The workspace ID.
+   */
+  spaceId?: string
+  /**
+   * The invited email address.
+   */
+  email: string
+  /**
+   * The role granted on acceptance.
+   */
+  role: string
+  /**
+   * The user ID of the member who sent the invitation.
+   */
+  inviterId?: string
+  /**
+   * The invitation status: pending, accepted, declined or revoked.
+   */
+  status?: string
+  /**
+   * When the invitation stops being answerable.
+   */
+  expireTime?: string
+  /**
+   * The user ID that accepted the invitation, if accepted.
+   */
+  acceptedBy?: string
+  /**
+   * The creation timestamp.
+   */
+  createTime: string
+  /**
+   * The last update timestamp.
+   */
+  updateTime: string
+}
+
+/**
+ * CreateInvitationRequest contains the fields for inviting an email address.
+ */
+export interface CreateInvitationRequest {
+  /**
+   * The workspace ID.
+   */
+  spaceId: string
+  /**
+   * The email address to invite.
+   */
+  email: string
+  /**
+   * The role to grant: admin, member or viewer.
+   */
+  role: string
+  /**
+   * How long the invitation stays valid, in hours. Defaults to 168 (7 days), max 720.
+   */
+  expireHours: number
+}
+
+/**
+ * ListInvitationsRequest contains the fields for listing workspace invitations.
+ */
+export interface ListInvitationsRequest {
+  /**
+   * The workspace ID.
+   */
+  spaceId: string
+  /**
+   *
+   * @description default 20, max 100
+   */
+  pageSize: number
+  /**
+   *
+   * @description cursor-based pagination token
+   */
+  nextPageToken: string
+  /**
+   * Only return invitations that can still be answered.
+   */
+  pendingOnly: boolean
+}
+
+/**
+ * ListInvitationsResponse contains the list of invitations and pagination token.
+ */
+export interface ListInvitationsResponse {
+  invitations: Invitation[]
+  nextPageToken: string
+}
+
+/**
+ * RevokeInvitationRequest contains the fields for revoking an invitation.
+ */
+export interface RevokeInvitationRequest {
+  /**
+   * The workspace ID.
+   */
+  spaceId: string
+  /**
+   * The invitation ID.
+   */
+  invitationId: string
+}
+
+/**
+ * AcceptInvitationRequest contains the invite token received by email.
+ */
+export interface AcceptInvitationRequest {
+  /**
+   * The raw invite token.
+   */
+  token: string
+}
+
+/**
+ * DeclineInvitationRequest contains the invite token received by email.
+ */
+export interface DeclineInvitationRequest {
+  /**
+   * The raw invite token.
+   */
+  token: string
+}
+
 /**
  * Spaces provides workspace (space) management including CRUD operations and member management.
  */
@@ -504,3 +638,141 @@ export function useListSpaceMembersQuery(
     ...options,
   })
 }
+
+/**
+ * CreateInvitation invites an email address to a workspace and emails the invite link.
+ */
+export async function createInvitation(
+  space_id: string,
+  req: CreateInvitationRequest
+): Promise<Invitation> {
+  return request<Invitation>({
+    method: "POST",
+    url: `/api/v1/spaces/${space_id}/invitations`,
+    data: req,
+  })
+}
+
+export function useCreateInvitationMutation(
+  options?: UseMutationOptions<
+    Invitation,
+    Error,
+    { space_id: string; req: CreateInvitationRequest }
+  >
+) {
+  return useMutation<
+    Invitation,
+    Error,
+    { space_id: string; req: CreateInvitationRequest }
+  >({
+    mutationFn: ({ space_id, req }) => createInvitation(space_id, req),
+    ...options,
+  })
+}
+
+/**
+ * ListInvitations lists the invitations of a workspace.
+ */
+export async function listInvitations(
+  space_id: string,
+  req: ListInvitationsRequest
+): Promise<ListInvitationsResponse> {
+  const params = { ...req }
+  delete (params as Record<string, unknown>).spaceId
+  return request<ListInvitationsResponse>({
+    method: "GET",
+    url: `/api/v1/spaces/${space_id}/invitations`,
+    params: params,
+  })
+}
+
+export function useListInvitationsQuery(
+  req: ListInvitationsRequest,
+  options?: Omit<
+    UseQueryOptions<ListInvitationsResponse, Error>,
+    "queryKey" | "queryFn"
+  >
+) {
+  return useQuery<ListInvitationsResponse, Error>({
+    queryKey: [`/api/v1/spaces/${req.spaceId}/invitations`, req],
+    queryFn: () => listInvitations(req.spaceId, req),
+    ...options,
+  })
+}
+
+/**
+ * RevokeInvitation withdraws a pending invitation.
+ */
+export async function revokeInvitation(
+  space_id: string,
+  invitation_id: string,
+  req: RevokeInvitationRequest
+): Promise<Invitation> {
+  return request<Invitation>({
+    method: "POST",
+    url: `/api/v1/spaces/${space_id}/invitations/${invitation_id}:revoke`,
+    data: req,
+  })
+}
+
+export function useRevokeInvitationMutation(
+  options?: UseMutationOptions<
+    Invitation,
+    Error,
+    { space_id: string; invitation_id: string; req: RevokeInvitationRequest }
+  >
+) {
+  return useMutation<
+    Invitation,
+    Error,
+    { space_id: string; invitation_id: string; req: RevokeInvitationRequest }
+  >({
+    mutationFn: ({ space_id, invitation_id, req }) =>
+      revokeInvitation(space_id, invitation_id, req),
+    ...options,
+  })
+}
+
+/**
+ * AcceptInvitation joins the authenticated user to the inviting workspace.
+ */
+export async function acceptInvitation(
+  req: AcceptInvitationRequest
+): Promise<SpaceMember> {
+  return request<SpaceMember>({
+    method: "POST",
+    url: "/api/v1/invitations:accept",
+    data: req,
+  })
+}
+
+export function useAcceptInvitationMutation(
+  options?: UseMutationOptions<SpaceMember, Error, AcceptInvitationRequest>
+) {
+  return useMutation<SpaceMember, Error, AcceptInvitationRequest>({
+    mutationFn: (req) => acceptInvitation(req),
+    ...options,
+  })
+}
+
+/**
+ * DeclineInvitation declines an invitation addressed to the authenticated user.
+ */
+export async function declineInvitation(
+  req: DeclineInvitationRequest
+): Promise<Invitation> {
+  return request<Invitation>({
+    method: "POST",
+    url: "/api/v1/invitations:decline",
+    data: req,
+  })
+}
+
+export function useDeclineInvitationMutation(
+  options?: UseMutationOptions<Invitation, Error, DeclineInvitationRequest>
+) {
+  return useMutation<Invitation, Error, DeclineInvitationRequest>({
+    mutationFn: (req) => declineInvitation(req),
+    ...options,
+  })
+}
@@ -0,0 +1,101 @@
+// Helpers for the WebAuthn ceremonies. The server sends its options as JSON with binary
+// fields encoded as base64url and expects the resulting credential in the same encoding.
+
+function decode(value: string): ArrayBuffer {
+  const base64 = value.replace(/-/g, "+").replace(/_/g, "/")
+  const padded = base64.padEnd(Math.ceil(base64.length / 4) * 4, "=")
+  const binary = window.atob(padded)
+  const bytes = new Uint8Array(binary.length)
+  for (let i = 0; i < binary.length; i++) {
+    bytes[i] = binary.charCodeAt(i)
+  }
+  return bytes.buffer
+}
+
+function encode(value: ArrayBuffer): string {
+  let binary = ""
+  for (const byte of new Uint8Array(value)) {
+    binary += String.fromCharCode(byte)
+  }
+  return window
+    .btoa(binary)
+    .replace(/\+/g, "-")
+    .replace(/\//g, "_")
+    .replace(/=+$/, "")
+}
+
+interface CredentialDescriptorJSON {
+  type: PublicKeyCredentialType
+  id: string
+  transports?: AuthenticatorTransport[]
+}
+
+function toDescriptors(list?: CredentialDescriptorJSON[]) {
+  return list?.map((c) => ({ ...c, id: decode(c.id) }))
+}
+
+export function isPasskeySupported(): boolean {
+  return typeof window !== "undefined" && !!window.PublicKeyCredential
+}
+
+/** Runs navigator.credentials.create with the options of a registration ceremony. */
+export async function createPasskeyCredential(
+  options: string
+): Promise<string> {
+  const { publicKey } = JSON.parse(options)
+  const credential = (await navigator.credentials.create({
+    publicKey: {
+      ...publicKey,
+      challenge: decode(publicKey.challenge),
+      user: { ...publicKey.user, id: decode(publicKey.user.id) },
+      excludeCredentials: toDescriptors(publicKey.excludeCredentials),
+    },
+  })) as PublicKeyCredential | null
+  if (!credential) {
+    throw new Error("Passkey creation was cancelled")
+  }
+
+  const response = credential.response as AuthenticatorAttestationResponse
+  return JSON.stringify({
+    id: credential.id,
+    rawId: encode(credential.rawId),
+    type: credential.type,
+    authenticatorAttachment: credential.authenticatorAttachment,
+    clientExtensionResults: credential.getClientExtensionResults(),
+    response: {
+      clientDataJSON: encode(response.clientDataJSON),
+      attestationObject: encode(response.attestationObject),
+      transports: response.getTransports?.() ?? [],
+    },
+  })
+}
+
+/** Runs navigator.credentials.get with the options of a login ceremony. */
+export async function getPasskeyCredential(options: string): Promise<string> {
+  const { publicKey } = JSON.parse(options)
+  const credential = (await navigator.credentials.get({
+    publicKey: {
+      ...publicKey,
+      challenge: decode(publicKey.challenge),
+      allowCredentials: toDescriptors(publicKey.allowCredentials),
+    },
+  })) as PublicKeyCredential | null
+  if (!credential) {
+    throw new Error("Passkey sign in was cancelled")
+  }
+
+  const response = credential.response as AuthenticatorAssertionResponse
+  return JSON.stringify({
+    id: credential.id,
+    rawId: encode(credential.rawId),
+    type: credential.type,
+    authenticatorAttachment: credential.authenticatorAttachment,
+    clientExtensionResults: credential.getClientExtensionResults(),
+    response: {
+      clientDataJSON: encode(response.clientDataJSON),
+      authenticatorData: encode(response.authenticatorData),
+      signature: encode(response.signature),
+      userHandle: response.userHandle ? encode(response.userHandle) : undefined,
+    },
+  })
+}
@@ -55,6 +55,40 @@ type Config struct {
 	Backup   BackupConfig
 	Webhook  WebhookConfig
 	Security SecurityConfig
+	Rates    RatesConfig
+	Mail     MailConfig
+	WebAuthn WebAuthnConfig
+}
+
+// WebAuthnConfig identifies the relying party that passkeys are bound to. RPID is the
+// registrable domain of the web app and Origins lists every origin it is served from.
+type WebAuthnConfig struct {
+	RPID          string   `mapstructure:"rp_id"`
+	RPDisplayName string   `mapstructure:"rp_display_name"`
+	Origins       []string `mapstructure:"origins"`
+}
+
+// MailConfig holds outbound email delivery settings.
+type MailConfig struct {
+	Driver           string `mapstructure:"driver"`
+	FileDir          string `mapstructure:"file_dir"`
+	From             string `mapstructure:"from"`
+	InvitationURL    string `mapstructure:"invitation_url"`
+	PasswordResetURL string `mapstructure:"password_reset_url"`
+	VerifyEmailURL   string `mapstructure:"verify_email_url"`
+}
+
+// RatesConfig selects the provider used to fetch daily exchange rates.
+// Provider is one of "ecb", "json" or "none".
+type RatesConfig struct {
+	Provider     string `mapstructure:"provider"`
+	URL          string `mapstructure:"url"`
+	Base         string `mapstructure:"base"`
+	BaseField    string `mapstructure:"base_field"`
+	DateField    string `mapstructure:"date_field"`
+	RatesField   string `mapstructure:"rates_field"`
+	APIKeyHeader string `mapstructure:"api_key_header"`
+	APIKey       string `mapstructure:"api_key"`
 }
 
 // SecurityConfig holds encryption and security settings.
@@ -132,6 +166,9 @@ type AuthConfig struct {
 	ActiveKeyID    string            `mapstructure:"active_key_id"`
 	PrivateKeyPath string            `mapstructure:"private_key_path"`
 	PublicKeys     map[string]string `mapstructure:"public_keys"`
+
+	// RequireVerifiedEmail refuses to sign in users who have not verified their email address.
+	RequireVerifiedEmail bool `mapstructure:"require_verified_email"`
 }
 
 // NewViper creates and configures a Viper instance with config file search
@@ -173,6 +210,7 @@ func NewViper() *viper.Viper {
 	v.SetDefault("auth.active_key_id", defaultJWTActiveKeyID)
 	v.SetDefault("auth.private_key_path", defaultJWTKeyDir+"/private.pem")
 	v.SetDefault("auth.public_keys", map[string]string{})
+	v.SetDefault("auth.require_verified_email", false)
 
 	v.SetDefault("backup.driver", "local")
 	v.SetDefault("backup.local_dir", "./backups")
@@ -183,6 +221,25 @@ func NewViper() *viper.Viper {
 	v.SetDefault("webhook.secret", "dev_webhook_secret")
 	v.SetDefault("security.encryption_key", "")
 
+	v.SetDefault("rates.provider", "ecb")
+	v.SetDefault("rates.url", "")
+	v.SetDefault("rates.base", "")
+	v.SetDefault("rates.base_field", "")
+	v.SetDefault("rates.date_field", "")
+	v.SetDefault("rates.rates_field", "")
+	v.SetDefault("rates.api_key_header", "")
+	v.SetDefault("rates.api_key", "")
+
+	v.SetDefault("mail.driver", "log")
+	v.SetDefault("mail.file_dir", "./mail")
+	v.SetDefault("mail.from", "Saturn <no-reply@saturn.local>")
+	v.SetDefault("mail.invitation_url", "http://localhost:8080/invitations/accept")
+	v.SetDefault("mail.password_reset_url", "http://localhost:8080/reset-password")
+	v.SetDefault("mail.verify_email_url", "http://localhost:8080/verify-email")
+	v.SetDefault("webauthn.rp_id", "localhost")
+	v.SetDefault("webauthn.rp_display_name", "Saturn")
+	v.SetDefault("webauthn.origins", []string{"http://localhost:8080"})
+
 	return v
 }
 
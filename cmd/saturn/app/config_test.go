@@ -16,3 +16,39 @@ func TestConfig_EncryptionKeyBinding(t *testing.T) {
 		t.Errorf("expected Security.EncryptionKey to be 'prod-secret-key-1234567890123456', got %q", cfg.Security.EncryptionKey)
 	}
 }
+
+func TestConfig_RatesDefaults(t *testing.T) {
+	v := NewViper()
+	cfg := LoadConfig(v)
+
+	if cfg.Rates.Provider != "ecb" {
+		t.Errorf("expected Rates.Provider to default to 'ecb', got %q", cfg.Rates.Provider)
+	}
+}
+
+func TestConfig_WebAuthnOriginsBinding(t *testing.T) {
+	os.Setenv("SATURN_WEBAUTHN_ORIGINS", "https://saturn.example.com,https://app.saturn.example.com")
+	defer os.Unsetenv("SATURN_WEBAUTHN_ORIGINS")
+
+	v := NewViper()
+	cfg := LoadConfig(v)
+
+	if len(cfg.WebAuthn.Origins) != 2 || cfg.WebAuthn.Origins[1] != "https://app.saturn.example.com" {
+		t.Errorf("expected WebAuthn.Origins to be split on commas, got %q", cfg.WebAuthn.Origins)
+	}
+}
+
+func TestConfig_RequireVerifiedEmailBinding(t *testing.T) {
+	os.Setenv("SATURN_AUTH_REQUIRE_VERIFIED_EMAIL", "true")
+	defer os.Unsetenv("SATURN_AUTH_REQUIRE_VERIFIED_EMAIL")
+
+	v := NewViper()
+	cfg := LoadConfig(v)
+
+	if !cfg.Auth.RequireVerifiedEmail {
+		t.Error("expected Auth.RequireVerifiedEmail to be true")
+	}
+	if cfg.Mail.PasswordResetURL != "http://localhost:8080/reset-password" {
+		t.Errorf("expected Mail.PasswordResetURL to default to the local web app, got %q", cfg.Mail.PasswordResetURL)
+	}
+}
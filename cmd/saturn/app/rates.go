@@ -0,0 +1,37 @@
+package app
+
+import (
+	"fmt"
+
+	"github.com/masterkeysrd/saturn/internal/domain/finance"
+	"github.com/masterkeysrd/saturn/internal/domain/finance/rateprovider"
+)
+
+// newRateProvider builds the exchange-rate provider selected in the configuration.
+// It returns nil when automatic rate fetching is disabled.
+func newRateProvider(cfg RatesConfig) (finance.RateProvider, error) {
+	switch cfg.Provider {
+	case "", "none":
+		return nil, nil
+	case "ecb":
+		return rateprovider.NewECBProvider(nil, cfg.URL), nil
+	case "json":
+		if cfg.URL == "" {
+			return nil, fmt.Errorf("rates.url is required for the json provider")
+		}
+		var headers map[string]string
+		if cfg.APIKeyHeader != "" {
+			headers = map[string]string{cfg.APIKeyHeader: cfg.APIKey}
+		}
+		return rateprovider.NewJSONProvider(nil, rateprovider.JSONConfig{
+			URL:        cfg.URL,
+			Headers:    headers,
+			Base:       finance.Currency(cfg.Base),
+			BaseField:  cfg.BaseField,
+			DateField:  cfg.DateField,
+			RatesField: cfg.RatesField,
+		}), nil
+	default:
+		return nil, fmt.Errorf("unknown rates provider %q", cfg.Provider)
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/masterkeysrd/saturn/internal/foundation/auth"
 	"github.com/masterkeysrd/saturn/internal/platform/backup"
 	"github.com/masterkeysrd/saturn/internal/platform/eventbus"
+	"github.com/masterkeysrd/saturn/internal/platform/passkey"
 	"github.com/masterkeysrd/saturn/internal/platform/token"
 	transportauth "github.com/masterkeysrd/saturn/internal/transport/auth"
 	"golang.org/x/sync/errgroup"
@@ -50,7 +51,9 @@ import (
 	"github.com/masterkeysrd/saturn/internal/domain/space"
 	spacestorage "github.com/masterkeysrd/saturn/internal/domain/space/storage"
 	"github.com/masterkeysrd/saturn/internal/platform/agent"
+	"github.com/masterkeysrd/saturn/internal/platform/crypto"
 	"github.com/masterkeysrd/saturn/internal/platform/integration"
+	"github.com/masterkeysrd/saturn/internal/platform/mail"
 	"github.com/masterkeysrd/saturn/internal/platform/password"
 	"github.com/masterkeysrd/saturn/internal/platform/scheduler"
 	"github.com/masterkeysrd/saturn/internal/shutdown"
@@ -103,26 +106,59 @@ func (s *GRPCServer) Start(ctx context.Context, cfg *Config, db *sql.DB) error {
 	}
 	sessionStore := identitystorage.NewSessionStore(sqlxDB)
 	securityEventStore := identitystorage.NewSecurityEventStore(sqlxDB)
+	recoveryCodeStore := identitystorage.NewRecoveryCodeStore(sqlxDB)
+	secretCipher, err := crypto.NewCipher(cfg.Security.EncryptionKey)
+	if err != nil {
+		return fmt.Errorf("init secret cipher: %w", err)
+	}
+	relyingParty, err := passkey.New(passkey.Config{
+		RPID:          cfg.WebAuthn.RPID,
+		RPDisplayName: cfg.WebAuthn.RPDisplayName,
+		RPOrigins:     cfg.WebAuthn.Origins,
+	})
+	if err != nil {
+		return fmt.Errorf("init webauthn relying party: %w", err)
+	}
 	identityService := identity.NewService(
 		identity.Dependencies{
-			UserStore:          userStore,
-			CredentialStore:    credentialStore,
-			SessionStore:       sessionStore,
-			SecurityEventStore: securityEventStore,
-			Hasher:             passwordHasher,
+			UserStore:            userStore,
+			CredentialStore:      credentialStore,
+			SessionStore:         sessionStore,
+			SecurityEventStore:   securityEventStore,
+			RecoveryCodeStore:    recoveryCodeStore,
+			Hasher:               passwordHasher,
+			Cipher:               secretCipher,
+			PasskeyStore:         identitystorage.NewPasskeyStore(sqlxDB),
+			PasskeyCeremonyStore: identitystorage.NewPasskeyCeremonyStore(sqlxDB),
+			RelyingParty:         relyingParty,
+			AccountTokenStore:    identitystorage.NewAccountTokenStore(sqlxDB),
 		},
 	)
 
 	// Wire Space stores
 	spaceStore := spacestorage.NewSpaceStore(sqlxDB)
 	memberStore := spacestorage.NewMemberStore(sqlxDB)
+	invitationStore := spacestorage.NewInvitationStore(sqlxDB)
 
 	// Wire Space service
 	spaceService := space.NewService(space.Dependencies{
-		SpaceStore:  spaceStore,
-		MemberStore: memberStore,
+		SpaceStore:      spaceStore,
+		MemberStore:     memberStore,
+		InvitationStore: invitationStore,
 	})
 
+	// Wire outbound mail sender
+	var mailSender mail.Sender
+	switch cfg.Mail.Driver {
+	case "file":
+		mailSender, err = mail.NewFileSender(cfg.Mail.FileDir)
+		if err != nil {
+			return fmt.Errorf("init mail sender failed: %w", err)
+		}
+	default:
+		mailSender = mail.NewLogSender(slog.Default())
+	}
+
 	// Wire JWT token service
 	issuer, audience, accessTTL, clockSkew, activeKeyID := cfg.Auth.ToTokenConfig()
 	var activeKey ed25519.PrivateKey
@@ -156,6 +192,13 @@ func (s *GRPCServer) Start(ctx context.Context, cfg *Config, db *sql.DB) error {
 		PasswordHasher:  passwordHasher,
 		SpaceService:    spaceService,
 		TokenService:    tokenService,
+		MailSender:      mailSender,
+		Accounts: iam.AccountConfig{
+			From:                 cfg.Mail.From,
+			PasswordResetURL:     cfg.Mail.PasswordResetURL,
+			VerifyEmailURL:       cfg.Mail.VerifyEmailURL,
+			RequireVerifiedEmail: cfg.Auth.RequireVerifiedEmail,
+		},
 	})
 
 	iamApp := identitygrpc.NewIAMApplication(coordinator)
@@ -198,6 +241,11 @@ func (s *GRPCServer) Start(ctx context.Context, cfg *Config, db *sql.DB) error {
 	spaceCoordinator := spaceapp.NewCoordinator(spaceapp.Dependencies{
 		SpaceService:    spaceService,
 		IdentityService: identityService,
+		MailSender:      mailSender,
+		Invitations: spaceapp.InvitationConfig{
+			From:      cfg.Mail.From,
+			AcceptURL: cfg.Mail.InvitationURL,
+		},
 	})
 	spaceHandler := spacegrpc.NewHandler(spaceCoordinator)
 	spacev1.RegisterSpacesServer(s.grpc, spaceHandler)
@@ -216,6 +264,26 @@ func (s *GRPCServer) Start(ctx context.Context, cfg *Config, db *sql.DB) error {
 	transferStore := financestorage.NewTransferStore(sqlxDB)
 	transactionEventStore := financestorage.NewTransactionEventStore(sqlxDB)
 	inboxItemStore := financestorage.NewInboxItemStore(sqlxDB)
+	incomeSourceStore := financestorage.NewIncomeSourceStore(sqlxDB)
+	categoryStore := financestorage.NewCategoryStore(sqlxDB)
+	statementMappingStore := financestorage.NewStatementMappingStore(sqlxDB)
+	reconciliationStore := financestorage.NewReconciliationStore(sqlxDB)
+	goalStore := financestorage.NewGoalStore(sqlxDB)
+	savedFilterStore := financestorage.NewSavedFilterStore(sqlxDB)
+	payeeStore := financestorage.NewPayeeStore(sqlxDB)
+	transactionRuleStore := financestorage.NewTransactionRuleStore(sqlxDB)
+	ledgerExportStore := financestorage.NewLedgerExportStore(sqlxDB)
+	cardStatementStore := financestorage.NewCardStatementStore(sqlxDB)
+	alertStore := financestorage.NewAlertStore(sqlxDB)
+
+	// The event bus engine is created up front so the finance service can publish events; workers
+	// start below.
+	eventBusEngine := eventbus.NewEngine(sqlxDB)
+
+	rateProvider, err := newRateProvider(cfg.Rates)
+	if err != nil {
+		return fmt.Errorf("init rate provider: %w", err)
+	}
 
 	financeService := finance.NewService(finance.Dependencies{
 		SettingsStore:         settingsStore,
@@ -231,6 +299,19 @@ func (s *GRPCServer) Start(ctx context.Context, cfg *Config, db *sql.DB) error {
 		TransferStore:         transferStore,
 		TransactionEventStore: transactionEventStore,
 		InboxItemStore:        inboxItemStore,
+		IncomeSourceStore:     incomeSourceStore,
+		CategoryStore:         categoryStore,
+		StatementMappingStore: statementMappingStore,
+		ReconciliationStore:   reconciliationStore,
+		GoalStore:             goalStore,
+		SavedFilterStore:      savedFilterStore,
+		PayeeStore:            payeeStore,
+		TransactionRuleStore:  transactionRuleStore,
+		LedgerExportStore:     ledgerExportStore,
+		CardStatementStore:    cardStatementStore,
+		AlertStore:            alertStore,
+		RateProvider:          rateProvider,
+		TransactionNotifier:   financegrpc.NewTransactionChangedPublisher(eventBusEngine),
 	})
 
 	integrationRegistry := integration.NewRegistry(sqlxDB)
@@ -267,8 +348,11 @@ func (s *GRPCServer) Start(ctx context.Context, cfg *Config, db *sql.DB) error {
 	emailProvider := email.NewTransactionIngestionProvider(integrationRegistry, financeCoordinator, emailSecret)
 	integrationRegistry.Register(emailProvider)
 
+	// The scheduler engine is created up front so handlers can enqueue jobs; workers start below.
+	schedulerEngine := scheduler.NewEngine(sqlxDB)
+
 	financeAggregator := financeaggregator.NewService(financeService)
-	financeHandler := financegrpc.NewHandler(financeCoordinator, financeAggregator)
+	financeHandler := financegrpc.NewHandler(financeCoordinator, financeAggregator, schedulerEngine)
 	financev1.RegisterFinanceServer(s.grpc, financeHandler)
 
 	agentCoordinator.RegisterSuggestionProcessor("transaction_extractor", financeCoordinator)
@@ -284,25 +368,27 @@ func (s *GRPCServer) Start(ctx context.Context, cfg *Config, db *sql.DB) error {
 	integrationv1.RegisterIntegrationServiceServer(s.grpc, integrationHandler)
 
 	// Wire EventBus service & register space context propagation middlewares
-	eventBusEngine := eventbus.NewEngine(sqlxDB)
 	eventBusEngine.UseProducer(eventbus.HeaderContextInjector("space_id", auth.SpaceIDFromContext))
 	eventBusEngine.UseConsumer(eventbus.HeaderContextUnpacker("space_id", auth.WithSpaceID))
 	eventBusEngine.Start(ctx)
 	s.EventBus = eventBusEngine
 
-	// Register webhook eventbus subscribers
+	// Register eventbus subscribers
 	webhook.RegisterSubscribers(eventBusEngine, integrationRegistry)
+	financeHandler.RegisterSubscribers(eventBusEngine)
 	messageHandler := messagegrpc.NewHandler(eventBusEngine)
 	messagev1.RegisterMessageAdminServer(s.grpc, messageHandler)
 
 	// Wire Scheduler service & start workers
-	schedulerEngine := scheduler.NewEngine(sqlxDB)
 	schedulerEngine.Start(ctx)
 	schedulerHandler := schedulergrpc.NewHandler(schedulerEngine)
 	schedulerv1.RegisterSchedulerAdminServer(s.grpc, schedulerHandler)
 
 	// Register background task handler execution callbacks
 	financev1.RegisterGenerateScheduledPaymentsPayload(schedulerEngine, financeHandler.HandleGenerateScheduledPayments)
+	financev1.RegisterSyncExchangeRatesPayload(schedulerEngine, financeHandler.HandleSyncExchangeRates)
+	financev1.RegisterCloseCardStatementsPayload(schedulerEngine, financeHandler.HandleCloseCardStatements)
+	financev1.RegisterRunLedgerExportPayload(schedulerEngine, financeHandler.HandleRunLedgerExport)
 
 	// Seed cron schedules / triggers
 	if err := financeHandler.RegisterSchedules(ctx, schedulerEngine); err != nil {
@@ -433,6 +519,9 @@ func (s *GRPCGatewayServer) Start(ctx context.Context, cfg *Config) error {
 	if err := financev1.RegisterFinanceHandlerFromEndpoint(ctx, s.mux, "unix:"+cfg.GRPC.Socket, []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}); err != nil {
 		return fmt.Errorf("register finance gateway handler: %w", err)
 	}
+	if err := financegrpc.RegisterDownloadHandlers(s.mux, s.grpcConn); err != nil {
+		return fmt.Errorf("register finance download handlers: %w", err)
+	}
 
 	if err := schedulerv1.RegisterSchedulerAdminHandlerFromEndpoint(ctx, s.mux, "unix:"+cfg.GRPC.Socket, []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}); err != nil {
 		return fmt.Errorf("register scheduler admin gateway handler: %w", err)
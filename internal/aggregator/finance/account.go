@@ -131,7 +131,7 @@ func (s *Service) hydrateAccounts(ctx context.Context, spaceID finance.SpaceID,
 		if settings.BaseCurrency != acc.Currency {
 			key := string(acc.Currency) + "->" + string(settings.BaseCurrency)
 			if rate, ok := ratesMap[key]; ok {
-				balanceInBase = int64(float64(acc.CurrentBalance) * rate)
+				balanceInBase = finance.ConvertAmount(acc.CurrentBalance, acc.Currency, settings.BaseCurrency, rate)
 				rateToBase = rate
 			} else {
 				balanceInBase = 0
@@ -78,7 +78,7 @@ func (s *Service) ListBudgets(
 						BudgetPeriod: period,
 						SpentAmount:  spentAmount,
 						SpentInBase:  spentInBase,
-						LimitInBase:  int64(float64(period.LimitAmount) * period.ExchangeRateToBase),
+						LimitInBase:  finance.ConvertAmount(period.EffectiveLimit(), period.Currency, period.BaseCurrency, period.ExchangeRateToBase),
 					}
 				}
 			}
@@ -121,6 +121,6 @@ func (s *Service) GetBudgetPeriod(ctx context.Context, spaceID finance.SpaceID,
 		BudgetPeriod: period,
 		SpentAmount:  spentAmount,
 		SpentInBase:  spentInBase,
-		LimitInBase:  int64(float64(period.LimitAmount) * period.ExchangeRateToBase),
+		LimitInBase:  finance.ConvertAmount(period.EffectiveLimit(), period.Currency, period.BaseCurrency, period.ExchangeRateToBase),
 	}, nil
 }
@@ -32,6 +32,10 @@ func (m *mockSettingsStore) GetByID(ctx context.Context, spaceID finance.SpaceID
 	}
 	return s, nil
 }
+func (m *mockSettingsStore) Update(ctx context.Context, s *finance.FinanceSettings) error {
+	m.settings[s.SpaceID] = s
+	return nil
+}
 
 type mockBudgetStore struct {
 	budgets map[finance.BudgetID]*finance.Budget
@@ -164,27 +168,32 @@ func (m *mockPeriodStore) Create(ctx context.Context, p *finance.BudgetPeriod) e
 	m.periods[key] = p
 	return nil
 }
-func (m *mockPeriodStore) GetByRange(ctx context.Context, budgetID finance.BudgetID, startDate, endDate time.Time) (*finance.BudgetPeriod, error) {
-	key := string(budgetID) + "_" + startDate.Format(time.RFC3339) + "_" + endDate.Format(time.RFC3339)
-	p, ok := m.periods[key]
-	if !ok {
-		return nil, finance.ErrPeriodNotFound
+func (m *mockPeriodStore) GetByDate(ctx context.Context, budgetID finance.BudgetID, date time.Time) (*finance.BudgetPeriod, error) {
+	for _, p := range m.periods {
+		if p.BudgetID == budgetID && !p.StartDate.After(date) && !p.EndDate.Before(date) {
+			return p, nil
+		}
 	}
-	return p, nil
+	return nil, finance.ErrPeriodNotFound
 }
-func (m *mockPeriodStore) GetByRanges(ctx context.Context, keys []finance.PeriodRangeKey) ([]*finance.BudgetPeriod, error) {
+func (m *mockPeriodStore) GetByDates(ctx context.Context, budgetIDs []finance.BudgetID, date time.Time) ([]*finance.BudgetPeriod, error) {
 	var list []*finance.BudgetPeriod
-	for _, key := range keys {
-		k := string(key.BudgetID) + "_" + key.StartDate.Format(time.RFC3339) + "_" + key.EndDate.Format(time.RFC3339)
-		if p, ok := m.periods[k]; ok {
+	for _, budgetID := range budgetIDs {
+		if p, err := m.GetByDate(ctx, budgetID, date); err == nil {
 			list = append(list, p)
 		}
 	}
 	return list, nil
 }
+func (m *mockPeriodStore) ListByRange(ctx context.Context, budgetID finance.BudgetID, startDate, endDate time.Time) ([]*finance.BudgetPeriod, error) {
+	return nil, nil
+}
 func (m *mockPeriodStore) UpdateLimit(ctx context.Context, periodID finance.PeriodID, limitAmount int64) error {
 	return nil
 }
+func (m *mockPeriodStore) UpdateCarry(ctx context.Context, periodID finance.PeriodID, carriedIn, carriedOut int64) error {
+	return nil
+}
 func (m *mockPeriodStore) ListByBudget(ctx context.Context, budgetID finance.BudgetID) ([]*finance.BudgetPeriod, error) {
 	return nil, nil
 }
@@ -208,6 +217,21 @@ func (m *mockTransactionStore) HasTransactions(ctx context.Context, spaceID fina
 func (m *mockTransactionStore) AggregateSpent(ctx context.Context, periodID finance.PeriodID, budgetCurrency finance.Currency, exchangeRateToBase float64) (int64, int64, error) {
 	return 1500, 1500, nil // Mock $15.00 spent
 }
+func (m *mockTransactionStore) ListForReconciliation(ctx context.Context, spaceID finance.SpaceID, accountID finance.AccountID, reconciliationID *finance.ReconciliationID, before time.Time) ([]*finance.Transaction, error) {
+	return nil, nil
+}
+func (m *mockTransactionStore) ListForGoal(ctx context.Context, spaceID finance.SpaceID, accountID *finance.AccountID, tag string, since time.Time) ([]*finance.Transaction, error) {
+	return nil, nil
+}
+func (m *mockTransactionStore) SetCleared(ctx context.Context, spaceID finance.SpaceID, ids []finance.TransactionID, cleared bool) error {
+	return nil
+}
+func (m *mockTransactionStore) MarkReconciled(ctx context.Context, spaceID finance.SpaceID, ids []finance.TransactionID, reconciliationID finance.ReconciliationID) error {
+	return nil
+}
+func (m *mockTransactionStore) ApplyBatch(ctx context.Context, b *finance.TransactionBatch) error {
+	return nil
+}
 func (m *mockTransactionStore) AggregateSpentBatch(ctx context.Context, periodIDs []finance.PeriodID) ([]finance.PeriodSpent, error) {
 	res := make([]finance.PeriodSpent, len(periodIDs))
 	for i, id := range periodIDs {
@@ -16,6 +16,13 @@ type CreateAccountRequest struct {
 	Color          string
 	Notes          string
 	LastFour       string
+
+	StatementClosingDay int32
+	PaymentDueDay       int32
+	MinimumPaymentBps   int32
+	MinimumPaymentFloor int64
+	PaymentBudgetID     *finance.BudgetID
+	LowBalanceThreshold int64
 }
 
 type UpdateAccountRequest struct {
@@ -30,6 +37,13 @@ type UpdateAccountRequest struct {
 	Color          string
 	Notes          string
 	LastFour       string
+
+	StatementClosingDay int32
+	PaymentDueDay       int32
+	MinimumPaymentBps   int32
+	MinimumPaymentFloor int64
+	PaymentBudgetID     *finance.BudgetID
+	LowBalanceThreshold int64
 }
 
 func (c *Coordinator) CreateAccount(ctx context.Context, req *CreateAccountRequest) (*finance.Account, error) {
@@ -50,6 +64,13 @@ func (c *Coordinator) CreateAccount(ctx context.Context, req *CreateAccountReque
 		Color:          req.Color,
 		Notes:          req.Notes,
 		LastFour:       req.LastFour,
+
+		StatementClosingDay: req.StatementClosingDay,
+		PaymentDueDay:       req.PaymentDueDay,
+		MinimumPaymentBps:   req.MinimumPaymentBps,
+		MinimumPaymentFloor: req.MinimumPaymentFloor,
+		PaymentBudgetID:     req.PaymentBudgetID,
+		LowBalanceThreshold: req.LowBalanceThreshold,
 	}
 
 	return c.financeService.CreateAccount(ctx, acc)
@@ -74,6 +95,13 @@ func (c *Coordinator) UpdateAccount(ctx context.Context, req *UpdateAccountReque
 		Color:          req.Color,
 		Notes:          req.Notes,
 		LastFour:       req.LastFour,
+
+		StatementClosingDay: req.StatementClosingDay,
+		PaymentDueDay:       req.PaymentDueDay,
+		MinimumPaymentBps:   req.MinimumPaymentBps,
+		MinimumPaymentFloor: req.MinimumPaymentFloor,
+		PaymentBudgetID:     req.PaymentBudgetID,
+		LowBalanceThreshold: req.LowBalanceThreshold,
 	}
 
 	return c.financeService.UpdateAccount(ctx, acc)
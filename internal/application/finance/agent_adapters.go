@@ -5,6 +5,7 @@ import (
 	_ "embed"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	agentapp "github.com/masterkeysrd/saturn/internal/application/agent"
@@ -146,7 +147,7 @@ func (a *AgentIngestionParser) Parse(ctx context.Context, spaceID string, doc st
 		paymentInfos = append(paymentInfos, scheduledPaymentInfo{
 			ID:         string(p.ID),
 			SourceType: p.SourceType,
-			Amount:     float64(p.Amount) / 100.0,
+			Amount:     p.Currency.ToMajor(p.Amount),
 			Currency:   string(p.Currency),
 			DueDate:    p.DueDate.Format(time.RFC3339),
 			Status:     string(p.Status),
@@ -167,7 +168,7 @@ func (a *AgentIngestionParser) Parse(ctx context.Context, spaceID string, doc st
 		recurringInfos = append(recurringInfos, recurringExpenseInfo{
 			ID:          string(re.ID),
 			Name:        re.Name,
-			Amount:      float64(re.Amount) / 100.0,
+			Amount:      re.Currency.ToMajor(re.Amount),
 			Currency:    string(re.Currency),
 			Interval:    re.Interval,
 			NextDueDate: re.NextDueDate.Format(time.RFC3339),
@@ -189,8 +190,8 @@ func (a *AgentIngestionParser) Parse(ctx context.Context, spaceID string, doc st
 			ID:              string(b.ID),
 			Direction:       string(b.Direction),
 			Counterparty:    b.Counterparty,
-			TotalAmount:     float64(b.TotalAmount) / 100.0,
-			RemainingAmount: float64(b.RemainingAmount) / 100.0,
+			TotalAmount:     b.Currency.ToMajor(b.TotalAmount),
+			RemainingAmount: b.Currency.ToMajor(b.RemainingAmount),
 			Currency:        string(b.Currency),
 		})
 	}
@@ -253,7 +254,7 @@ func (a *AgentIngestionParser) Parse(ctx context.Context, spaceID string, doc st
 		ReferenceNumber:      extracted.ReferenceNumber,
 		TransactionType:      extracted.TransactionType,
 		Date:                 extracted.Date,
-		Amount:               int64(extracted.Amount * 100),
+		Amount:               finance.Currency(strings.ToUpper(extracted.Currency)).FromMajor(extracted.Amount),
 		Currency:             extracted.Currency,
 		Counterparty:         extracted.Counterparty,
 		CardLastFour:         extracted.SourceAccount.LastFour,
@@ -285,7 +286,7 @@ func (d *AgentIngestionDeduplicator) Deduplicate(ctx context.Context, spaceID st
 	for _, r := range recent {
 		recentInfos = append(recentInfos, recentTxInfo{
 			ID:              string(r.ID),
-			Amount:          float64(r.Amount) / 100.0,
+			Amount:          r.Currency.ToMajor(r.Amount),
 			Currency:        string(r.Currency),
 			Description:     r.Description,
 			TransactionDate: r.TransactionDate.Format(time.RFC3339),
@@ -298,7 +299,7 @@ func (d *AgentIngestionDeduplicator) Deduplicate(ctx context.Context, spaceID st
 		Params: map[string]any{
 			"dedup": true,
 			"extracted_transaction": map[string]any{
-				"Amount":   float64(tx.Amount) / 100.0,
+				"Amount":   finance.Currency(strings.ToUpper(tx.Currency)).ToMajor(tx.Amount),
 				"Currency": tx.Currency,
 				"Vendor":   tx.Counterparty,
 				"Date":     tx.Date,
@@ -0,0 +1,55 @@
+package financeapp
+
+import (
+	"context"
+	"time"
+
+	"github.com/masterkeysrd/saturn/internal/domain/finance"
+	"github.com/masterkeysrd/saturn/internal/platform/paging"
+)
+
+// ListAlerts lists the alerts of the active space.
+func (c *Coordinator) ListAlerts(ctx context.Context, filter *finance.ListAlertsFilter) (*paging.Page[*finance.Alert], error) {
+	rCtx, err := c.resolveContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.financeService.ListAlerts(ctx, rCtx.SpaceID, filter)
+}
+
+// GetAlert retrieves an alert of the active space.
+func (c *Coordinator) GetAlert(ctx context.Context, id finance.AlertID) (*finance.Alert, error) {
+	rCtx, err := c.resolveContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.financeService.GetAlert(ctx, rCtx.SpaceID, id)
+}
+
+// AcknowledgeAlert marks an alert of the active space as handled.
+func (c *Coordinator) AcknowledgeAlert(ctx context.Context, id finance.AlertID) (*finance.Alert, error) {
+	rCtx, err := c.resolveContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.financeService.AcknowledgeAlert(ctx, rCtx.SpaceID, id, time.Now())
+}
+
+// SnoozeAlert hides an alert of the active space until the given time.
+func (c *Coordinator) SnoozeAlert(ctx context.Context, id finance.AlertID, until time.Time) (*finance.Alert, error) {
+	rCtx, err := c.resolveContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.financeService.SnoozeAlert(ctx, rCtx.SpaceID, id, until, time.Now())
+}
+
+// EvaluateTransactionAlerts runs the alert rules against a changed transaction and returns the alerts it raised.
+// It runs from an event bus subscriber, outside any request context.
+func (c *Coordinator) EvaluateTransactionAlerts(ctx context.Context, spaceID finance.SpaceID, txnID finance.TransactionID) ([]*finance.Alert, error) {
+	return c.financeService.EvaluateTransactionAlerts(ctx, spaceID, txnID, time.Now())
+}
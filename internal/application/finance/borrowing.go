@@ -8,16 +8,22 @@ import (
 )
 
 type CreateBorrowingRequest struct {
-	Direction           string
-	Counterparty        string
-	ContactInfo         string
-	TotalAmount         int64
-	Currency            string
-	EstablishedAt       time.Time
-	DueAt               *time.Time
-	Notes               string
-	CreateAsTransaction bool
-	AccountID           *finance.AccountID
+	Direction            string
+	Counterparty         string
+	ContactInfo          string
+	TotalAmount          int64
+	Currency             string
+	EstablishedAt        time.Time
+	DueAt                *time.Time
+	Notes                string
+	CreateAsTransaction  bool
+	AccountID            *finance.AccountID
+	InterestType         finance.InterestType
+	InterestRateBps      int32
+	InstallmentCount     int32
+	InstallmentFrequency finance.InstallmentFrequency
+	FirstInstallmentDate *time.Time
+	BudgetID             *finance.BudgetID
 }
 
 func (c *Coordinator) CreateBorrowing(ctx context.Context, req *CreateBorrowingRequest) (*finance.Borrowing, error) {
@@ -37,22 +43,35 @@ func (c *Coordinator) CreateBorrowing(ctx context.Context, req *CreateBorrowingR
 		DueAt:         req.DueAt,
 		Notes:         req.Notes,
 		AccountID:     req.AccountID,
+
+		InterestType:         req.InterestType,
+		InterestRateBps:      req.InterestRateBps,
+		InstallmentCount:     req.InstallmentCount,
+		InstallmentFrequency: req.InstallmentFrequency,
+		FirstInstallmentDate: req.FirstInstallmentDate,
+		BudgetID:             req.BudgetID,
 	}
 
 	return c.financeService.CreateBorrowing(ctx, b, req.CreateAsTransaction)
 }
 
 type UpdateBorrowingRequest struct {
-	ID            finance.BorrowingID
-	Direction     string
-	Counterparty  string
-	ContactInfo   string
-	TotalAmount   int64
-	Currency      string
-	EstablishedAt time.Time
-	DueAt         *time.Time
-	Notes         string
-	AccountID     *finance.AccountID
+	ID                   finance.BorrowingID
+	Direction            string
+	Counterparty         string
+	ContactInfo          string
+	TotalAmount          int64
+	Currency             string
+	EstablishedAt        time.Time
+	DueAt                *time.Time
+	Notes                string
+	AccountID            *finance.AccountID
+	InterestType         finance.InterestType
+	InterestRateBps      int32
+	InstallmentCount     int32
+	InstallmentFrequency finance.InstallmentFrequency
+	FirstInstallmentDate *time.Time
+	BudgetID             *finance.BudgetID
 }
 
 func (c *Coordinator) UpdateBorrowing(ctx context.Context, req *UpdateBorrowingRequest) (*finance.Borrowing, error) {
@@ -73,6 +92,13 @@ func (c *Coordinator) UpdateBorrowing(ctx context.Context, req *UpdateBorrowingR
 		DueAt:         req.DueAt,
 		Notes:         req.Notes,
 		AccountID:     req.AccountID,
+
+		InterestType:         req.InterestType,
+		InterestRateBps:      req.InterestRateBps,
+		InstallmentCount:     req.InstallmentCount,
+		InstallmentFrequency: req.InstallmentFrequency,
+		FirstInstallmentDate: req.FirstInstallmentDate,
+		BudgetID:             req.BudgetID,
 	}
 
 	return c.financeService.UpdateBorrowing(ctx, b)
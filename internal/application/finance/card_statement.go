@@ -0,0 +1,32 @@
+package financeapp
+
+import (
+	"context"
+	"time"
+
+	"github.com/masterkeysrd/saturn/internal/domain/finance"
+)
+
+func (c *Coordinator) ListCardStatements(ctx context.Context, accountID finance.AccountID, filter *finance.ListCardStatementsFilter) ([]*finance.CardStatement, error) {
+	rCtx, err := c.resolveContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.financeService.ListCardStatements(ctx, rCtx.SpaceID, accountID, filter)
+}
+
+func (c *Coordinator) GetCardStatement(ctx context.Context, id finance.CardStatementID) (*finance.CardStatement, error) {
+	rCtx, err := c.resolveContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.financeService.GetCardStatement(ctx, rCtx.SpaceID, id)
+}
+
+// CloseCardStatements issues the statements of every card whose billing cycle closed.
+// It runs from the background scheduler, outside any request context.
+func (c *Coordinator) CloseCardStatements(ctx context.Context) error {
+	return c.financeService.CloseCardStatements(ctx, time.Now())
+}
@@ -0,0 +1,85 @@
+package financeapp
+
+import (
+	"context"
+
+	"github.com/masterkeysrd/saturn/internal/domain/finance"
+	"github.com/masterkeysrd/saturn/internal/platform/paging"
+)
+
+type CreateCategoryRequest struct {
+	ParentID *finance.CategoryID
+	Name     string
+	Icon     string
+	Color    string
+}
+
+func (c *Coordinator) CreateCategory(ctx context.Context, req *CreateCategoryRequest) (*finance.Category, error) {
+	rCtx, err := c.resolveContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	category := &finance.Category{
+		SpaceID:  rCtx.SpaceID,
+		ParentID: req.ParentID,
+		Name:     req.Name,
+		Icon:     req.Icon,
+		Color:    req.Color,
+	}
+
+	return c.financeService.CreateCategory(ctx, category)
+}
+
+type UpdateCategoryRequest struct {
+	ID       finance.CategoryID
+	ParentID *finance.CategoryID
+	Name     string
+	Icon     string
+	Color    string
+}
+
+func (c *Coordinator) UpdateCategory(ctx context.Context, req *UpdateCategoryRequest) (*finance.Category, error) {
+	rCtx, err := c.resolveContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	category := &finance.Category{
+		ID:       req.ID,
+		SpaceID:  rCtx.SpaceID,
+		ParentID: req.ParentID,
+		Name:     req.Name,
+		Icon:     req.Icon,
+		Color:    req.Color,
+	}
+
+	return c.financeService.UpdateCategory(ctx, category)
+}
+
+func (c *Coordinator) GetCategory(ctx context.Context, id finance.CategoryID) (*finance.Category, error) {
+	rCtx, err := c.resolveContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.financeService.GetCategory(ctx, rCtx.SpaceID, id)
+}
+
+func (c *Coordinator) DeleteCategory(ctx context.Context, id finance.CategoryID) error {
+	rCtx, err := c.resolveContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	return c.financeService.DeleteCategory(ctx, rCtx.SpaceID, id)
+}
+
+func (c *Coordinator) ListCategories(ctx context.Context, filter *finance.ListCategoriesFilter) (*paging.Page[*finance.Category], error) {
+	rCtx, err := c.resolveContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.financeService.ListCategories(ctx, rCtx.SpaceID, filter)
+}
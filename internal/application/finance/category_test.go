@@ -0,0 +1,45 @@
+package financeapp_test
+
+import (
+	"testing"
+
+	financeapp "github.com/masterkeysrd/saturn/internal/application/finance"
+	"github.com/masterkeysrd/saturn/internal/domain/finance"
+)
+
+func TestCreateCategoryRequest_Fields(t *testing.T) {
+	parentID := finance.CategoryID("cat_food")
+
+	req := &financeapp.CreateCategoryRequest{
+		ParentID: &parentID,
+		Name:     "Groceries",
+		Icon:     "cart",
+		Color:    "green",
+	}
+
+	if req.Name != "Groceries" {
+		t.Errorf("expected name Groceries, got %s", req.Name)
+	}
+	if req.ParentID == nil || *req.ParentID != "cat_food" {
+		t.Errorf("expected parent ID cat_food")
+	}
+}
+
+func TestCreateExpenseRequest_Classification(t *testing.T) {
+	categoryID := finance.CategoryID("cat_groceries")
+
+	req := &financeapp.CreateExpenseRequest{
+		BudgetID:   "bgt_food",
+		Amount:     4500,
+		Currency:   "USD",
+		CategoryID: &categoryID,
+		Tags:       []string{"weekly", "costco"},
+	}
+
+	if req.CategoryID == nil || *req.CategoryID != "cat_groceries" {
+		t.Errorf("expected category ID cat_groceries")
+	}
+	if len(req.Tags) != 2 {
+		t.Errorf("expected 2 tags, got %d", len(req.Tags))
+	}
+}
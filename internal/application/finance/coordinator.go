@@ -3,6 +3,7 @@ package financeapp
 import (
 	"context"
 	"errors"
+	"io"
 	"time"
 
 	"github.com/masterkeysrd/saturn/internal/domain/finance"
@@ -35,22 +36,81 @@ type FinanceService interface {
 	CreateExpense(ctx context.Context, txn *finance.Transaction) (*finance.Transaction, error)
 	GetTransaction(ctx context.Context, spaceID finance.SpaceID, id finance.TransactionID) (*finance.Transaction, error)
 	UpdateExpense(ctx context.Context, txn *finance.Transaction) (*finance.Transaction, error)
+	CreateIncome(ctx context.Context, txn *finance.Transaction) (*finance.Transaction, error)
+	UpdateIncome(ctx context.Context, txn *finance.Transaction) (*finance.Transaction, error)
 	DeleteTransaction(ctx context.Context, spaceID finance.SpaceID, id finance.TransactionID) error
 	ListTransactions(ctx context.Context, spaceID finance.SpaceID, filter *finance.TransactionFilter) (*paging.Page[*finance.Transaction], error)
+	BatchUpdateTransactions(ctx context.Context, req *finance.BatchUpdateTransactionsRequest) (*finance.BatchTransactionsResult, error)
+	BatchDeleteTransactions(ctx context.Context, req *finance.BatchDeleteTransactionsRequest) (*finance.BatchTransactionsResult, error)
 	ListTransactionEvents(ctx context.Context, spaceID finance.SpaceID, txnID finance.TransactionID) ([]*finance.TransactionEvent, error)
 	GetSpentInsights(ctx context.Context, req *finance.GetSpentInsightsRequest) (*finance.SpentInsights, error)
+	ForecastCashFlow(ctx context.Context, req *finance.ForecastCashFlowRequest) (*finance.CashFlowForecast, error)
+	EvaluateTransactionAlerts(ctx context.Context, spaceID finance.SpaceID, txnID finance.TransactionID, now time.Time) ([]*finance.Alert, error)
+	GetAlert(ctx context.Context, spaceID finance.SpaceID, id finance.AlertID) (*finance.Alert, error)
+	ListAlerts(ctx context.Context, spaceID finance.SpaceID, filter *finance.ListAlertsFilter) (*paging.Page[*finance.Alert], error)
+	AcknowledgeAlert(ctx context.Context, spaceID finance.SpaceID, id finance.AlertID, now time.Time) (*finance.Alert, error)
+	SnoozeAlert(ctx context.Context, spaceID finance.SpaceID, id finance.AlertID, until, now time.Time) (*finance.Alert, error)
+
+	CreateIncomeSource(ctx context.Context, source *finance.IncomeSource) (*finance.IncomeSource, error)
+	GetIncomeSource(ctx context.Context, spaceID finance.SpaceID, id finance.IncomeSourceID) (*finance.IncomeSource, error)
+	UpdateIncomeSource(ctx context.Context, source *finance.IncomeSource) (*finance.IncomeSource, error)
+	DeleteIncomeSource(ctx context.Context, spaceID finance.SpaceID, id finance.IncomeSourceID) error
+	ListIncomeSources(ctx context.Context, spaceID finance.SpaceID, filter *finance.ListIncomeSourcesFilter) (*paging.Page[*finance.IncomeSource], error)
+
+	CreateGoal(ctx context.Context, goal *finance.Goal) (*finance.Goal, error)
+	GetGoal(ctx context.Context, spaceID finance.SpaceID, id finance.GoalID) (*finance.Goal, error)
+	UpdateGoal(ctx context.Context, goal *finance.Goal) (*finance.Goal, error)
+	DeleteGoal(ctx context.Context, spaceID finance.SpaceID, id finance.GoalID) error
+	ListGoals(ctx context.Context, spaceID finance.SpaceID, filter *finance.ListGoalsFilter) (*paging.Page[*finance.Goal], error)
+	GetGoalProgress(ctx context.Context, spaceID finance.SpaceID, id finance.GoalID) (*finance.GoalProgress, error)
+
+	CreateSavedFilter(ctx context.Context, f *finance.SavedFilter) (*finance.SavedFilter, error)
+	GetSavedFilter(ctx context.Context, spaceID finance.SpaceID, id finance.SavedFilterID) (*finance.SavedFilter, error)
+	UpdateSavedFilter(ctx context.Context, f *finance.SavedFilter, mask []string) (*finance.SavedFilter, error)
+	DeleteSavedFilter(ctx context.Context, spaceID finance.SpaceID, id finance.SavedFilterID) error
+	ListSavedFilters(ctx context.Context, spaceID finance.SpaceID, filter *finance.ListSavedFiltersFilter) (*paging.Page[*finance.SavedFilter], error)
+
+	CreatePayee(ctx context.Context, p *finance.Payee) (*finance.Payee, error)
+	GetPayee(ctx context.Context, spaceID finance.SpaceID, id finance.PayeeID) (*finance.Payee, error)
+	UpdatePayee(ctx context.Context, p *finance.Payee, mask []string) (*finance.Payee, error)
+	DeletePayee(ctx context.Context, spaceID finance.SpaceID, id finance.PayeeID) error
+	ListPayees(ctx context.Context, spaceID finance.SpaceID, filter *finance.ListPayeesFilter) (*paging.Page[*finance.Payee], error)
+
+	CreateTransactionRule(ctx context.Context, r *finance.TransactionRule) (*finance.TransactionRule, error)
+	GetTransactionRule(ctx context.Context, spaceID finance.SpaceID, id finance.TransactionRuleID) (*finance.TransactionRule, error)
+	UpdateTransactionRule(ctx context.Context, r *finance.TransactionRule, mask []string) (*finance.TransactionRule, error)
+	DeleteTransactionRule(ctx context.Context, spaceID finance.SpaceID, id finance.TransactionRuleID) error
+	ListTransactionRules(ctx context.Context, spaceID finance.SpaceID, filter *finance.ListTransactionRulesFilter) (*paging.Page[*finance.TransactionRule], error)
+	MatchTransactionRules(ctx context.Context, spaceID finance.SpaceID, txn *finance.Transaction) (*finance.TransactionRuleMatch, error)
+	ApplyTransactionRules(ctx context.Context, req *finance.ApplyTransactionRulesRequest) (*finance.ApplyTransactionRulesResult, error)
+
+	ExportLedger(ctx context.Context, q *finance.LedgerQuery, w io.Writer) (int64, error)
+	CreateLedgerExport(ctx context.Context, e *finance.LedgerExport) (*finance.LedgerExport, error)
+	GetLedgerExport(ctx context.Context, spaceID finance.SpaceID, id finance.LedgerExportID) (*finance.LedgerExport, error)
+	ListLedgerExports(ctx context.Context, spaceID finance.SpaceID, filter *finance.ListLedgerExportsFilter) (*paging.Page[*finance.LedgerExport], error)
+	RunLedgerExport(ctx context.Context, spaceID finance.SpaceID, id finance.LedgerExportID) (*finance.LedgerExport, error)
+	GetLedgerExportContent(ctx context.Context, spaceID finance.SpaceID, id finance.LedgerExportID) (*finance.LedgerExport, []byte, error)
+
+	CreateCategory(ctx context.Context, category *finance.Category) (*finance.Category, error)
+	GetCategory(ctx context.Context, spaceID finance.SpaceID, id finance.CategoryID) (*finance.Category, error)
+	UpdateCategory(ctx context.Context, category *finance.Category) (*finance.Category, error)
+	DeleteCategory(ctx context.Context, spaceID finance.SpaceID, id finance.CategoryID) error
+	ListCategories(ctx context.Context, spaceID finance.SpaceID, filter *finance.ListCategoriesFilter) (*paging.Page[*finance.Category], error)
 
 	CreateRecurringExpense(ctx context.Context, expense *finance.RecurringExpense) (*finance.RecurringExpense, error)
 	GetRecurringExpense(ctx context.Context, spaceID finance.SpaceID, id finance.RecurringExpenseID) (*finance.RecurringExpense, error)
 	UpdateRecurringExpense(ctx context.Context, expense *finance.RecurringExpense) (*finance.RecurringExpense, error)
 	DeleteRecurringExpense(ctx context.Context, id finance.RecurringExpenseID) error
 	ListRecurringExpenses(ctx context.Context, spaceID finance.SpaceID, filter *finance.ListRecurringExpensesFilter) (*paging.Page[*finance.RecurringExpense], error)
+	DetectSubscriptions(ctx context.Context, req *finance.DetectSubscriptionsRequest) ([]*finance.SubscriptionSuggestion, error)
+	ConvertToRecurringExpense(ctx context.Context, req *finance.ConvertToRecurringExpenseRequest) (*finance.RecurringExpense, error)
 	ListScheduledPayments(ctx context.Context, spaceID finance.SpaceID, filter *finance.ListScheduledPaymentsFilter) (*paging.Page[*finance.ScheduledPayment], error)
 	GetScheduledPayment(ctx context.Context, spaceID finance.SpaceID, id finance.ScheduledPaymentID) (*finance.ScheduledPayment, error)
 	ConfirmScheduledPayment(ctx context.Context, req finance.ConfirmScheduledPaymentRequest) (*finance.Transaction, error)
 	MatchScheduledPayment(ctx context.Context, req finance.MatchScheduledPaymentRequest) (*finance.Transaction, error)
 	SkipScheduledPayment(ctx context.Context, spaceID finance.SpaceID, id finance.ScheduledPaymentID) (*finance.ScheduledPayment, error)
 	GenerateScheduledPayments(ctx context.Context) error
+	SyncExchangeRates(ctx context.Context) error
 
 	CreateBorrowing(ctx context.Context, b *finance.Borrowing, createAsTransaction bool) (*finance.Borrowing, error)
 	GetBorrowing(ctx context.Context, spaceID finance.SpaceID, id finance.BorrowingID) (*finance.Borrowing, error)
@@ -60,7 +120,8 @@ type FinanceService interface {
 	CreateBorrowingRepayment(ctx context.Context, r *finance.BorrowingRepayment) (*finance.BorrowingRepayment, error)
 	ListBorrowingRepayments(ctx context.Context, spaceID finance.SpaceID, borrowingID finance.BorrowingID) ([]*finance.BorrowingRepayment, error)
 	DeleteBorrowingRepayment(ctx context.Context, req finance.DeleteBorrowingRepaymentRequest) error
-	ListCurrencies(ctx context.Context) ([]finance.CurrencyInfo, error)
+	ListCurrencies(ctx context.Context, spaceID finance.SpaceID) ([]finance.SpaceCurrency, error)
+	UpdateFinanceSettings(ctx context.Context, settings *finance.FinanceSettings, mask []string) (*finance.FinanceSettings, error)
 
 	CreateAccount(ctx context.Context, account *finance.Account) (*finance.Account, error)
 	GetAccount(ctx context.Context, spaceID finance.SpaceID, id finance.AccountID) (*finance.Account, error)
@@ -68,15 +129,28 @@ type FinanceService interface {
 	AdjustAccountBalance(ctx context.Context, spaceID finance.SpaceID, accountID finance.AccountID, targetBalance int64, adjustmentDate string, note string) (*finance.Account, error)
 	DeleteAccount(ctx context.Context, spaceID finance.SpaceID, id finance.AccountID) error
 	ListAccounts(ctx context.Context, spaceID finance.SpaceID, filter *finance.ListAccountsFilter) (*paging.Page[*finance.Account], error)
+	StartReconciliation(ctx context.Context, r *finance.Reconciliation) (*finance.Reconciliation, error)
+	GetReconciliation(ctx context.Context, spaceID finance.SpaceID, id finance.ReconciliationID) (*finance.Reconciliation, error)
+	ListReconciliations(ctx context.Context, spaceID finance.SpaceID, accountID finance.AccountID) ([]*finance.Reconciliation, error)
+	ListReconciliationTransactions(ctx context.Context, spaceID finance.SpaceID, id finance.ReconciliationID) ([]*finance.Transaction, error)
+	SetTransactionsCleared(ctx context.Context, spaceID finance.SpaceID, id finance.ReconciliationID, txnIDs []finance.TransactionID, cleared bool) (*finance.Reconciliation, error)
+	FinalizeReconciliation(ctx context.Context, spaceID finance.SpaceID, id finance.ReconciliationID, createAdjustment bool) (*finance.Reconciliation, error)
+	CancelReconciliation(ctx context.Context, spaceID finance.SpaceID, id finance.ReconciliationID) error
 	CreateTransfer(ctx context.Context, transfer *finance.Transfer) (*finance.Transfer, error)
 	GetTransfer(ctx context.Context, spaceID finance.SpaceID, id finance.TransferID) (*finance.Transfer, error)
 	DeleteTransfer(ctx context.Context, spaceID finance.SpaceID, id finance.TransferID) error
 	ListTransfers(ctx context.Context, spaceID finance.SpaceID, limit int32, pageToken string) ([]*finance.Transfer, string, error)
+	ListCardStatements(ctx context.Context, spaceID finance.SpaceID, accountID finance.AccountID, filter *finance.ListCardStatementsFilter) ([]*finance.CardStatement, error)
+	GetCardStatement(ctx context.Context, spaceID finance.SpaceID, id finance.CardStatementID) (*finance.CardStatement, error)
+	CloseCardStatements(ctx context.Context, now time.Time) error
 
 	StageInboxItem(ctx context.Context, spaceID finance.SpaceID, req *finance.StageInboxItem) (*finance.InboxItem, error)
 	UpdateInboxItem(ctx context.Context, spaceID finance.SpaceID, item *finance.InboxItem) (*finance.InboxItem, error)
 	DiscardInboxItem(ctx context.Context, spaceID finance.SpaceID, id string) error
 	ApproveInboxItem(ctx context.Context, spaceID finance.SpaceID, id string) (*finance.InboxItem, error)
+	ListStagedExternalIDs(ctx context.Context, spaceID finance.SpaceID, accountID finance.AccountID, externalIDs []string) (map[string]bool, error)
+	GetStatementMapping(ctx context.Context, spaceID finance.SpaceID, accountID finance.AccountID) (*finance.StatementMapping, error)
+	SaveStatementMapping(ctx context.Context, mapping *finance.StatementMapping) (*finance.StatementMapping, error)
 }
 
 // ParsedTransaction represents structured transaction data parsed by an ingestion agent.
@@ -159,6 +233,12 @@ func NewCoordinator(deps Dependencies) *Coordinator {
 type RequestContext struct {
 	SpaceID finance.SpaceID
 	UserID  string
+	Role    string
+}
+
+// IsSpaceAdmin reports whether the caller administers the active space.
+func (r *RequestContext) IsSpaceAdmin() bool {
+	return r.Role == "owner" || r.Role == "admin"
 }
 
 // resolveContext extracts space and user identity safely into a RequestContext struct.
@@ -173,15 +253,19 @@ func (c *Coordinator) resolveContext(ctx context.Context) (*RequestContext, erro
 		return nil, errors.New("access denied: missing user principal")
 	}
 
+	role, _ := auth.SpaceRoleFromContext(ctx)
+
 	return &RequestContext{
 		SpaceID: finance.SpaceID(spaceIDStr),
 		UserID:  principal.Subject,
+		Role:    role,
 	}, nil
 }
 
 // ConfigureFinanceRequest represents settings setup inputs.
 type ConfigureFinanceRequest struct {
 	BaseCurrency finance.Currency
+	Timezone     string
 }
 
 // ConfigureFinance sets up base currency preferences for a workspace.
@@ -194,6 +278,7 @@ func (c *Coordinator) ConfigureFinance(ctx context.Context, req *ConfigureFinanc
 	settings := &finance.FinanceSettings{
 		SpaceID:      rCtx.SpaceID,
 		BaseCurrency: req.BaseCurrency,
+		Timezone:     req.Timezone,
 	}
 
 	return c.financeService.ConfigureFinance(ctx, settings)
@@ -209,11 +294,43 @@ func (c *Coordinator) GetFinanceSettings(ctx context.Context) (*finance.FinanceS
 	return c.financeService.GetFinanceSettings(ctx, rCtx.SpaceID)
 }
 
-// ListCurrencies returns the list of supported currencies.
-func (c *Coordinator) ListCurrencies(ctx context.Context) ([]finance.CurrencyInfo, error) {
-	_, err := c.resolveContext(ctx)
+// UpdateFinanceSettingsRequest represents a partial settings update.
+type UpdateFinanceSettingsRequest struct {
+	EnabledCurrencies    []finance.Currency
+	Timezone             string
+	BudgetAlertPercents  []int32
+	AnomalyStdDevs       float64
+	DuplicateWindowHours int32
+	UpdateMask           []string
+}
+
+// UpdateFinanceSettings changes the enabled currencies, timezone or alert tuning of a workspace. Only space admins may do so.
+func (c *Coordinator) UpdateFinanceSettings(ctx context.Context, req *UpdateFinanceSettingsRequest) (*finance.FinanceSettings, error) {
+	rCtx, err := c.resolveContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !rCtx.IsSpaceAdmin() {
+		return nil, errors.New("access denied: only space admins can update finance settings")
+	}
+
+	settings := &finance.FinanceSettings{
+		SpaceID:              rCtx.SpaceID,
+		EnabledCurrencies:    req.EnabledCurrencies,
+		Timezone:             req.Timezone,
+		BudgetAlertPercents:  req.BudgetAlertPercents,
+		AnomalyStdDevs:       req.AnomalyStdDevs,
+		DuplicateWindowHours: req.DuplicateWindowHours,
+	}
+
+	return c.financeService.UpdateFinanceSettings(ctx, settings, req.UpdateMask)
+}
+
+// ListCurrencies returns the ISO 4217 catalog with the workspace's enabled flags.
+func (c *Coordinator) ListCurrencies(ctx context.Context) ([]finance.SpaceCurrency, error) {
+	rCtx, err := c.resolveContext(ctx)
 	if err != nil {
 		return nil, err
 	}
-	return c.financeService.ListCurrencies(ctx)
+	return c.financeService.ListCurrencies(ctx, rCtx.SpaceID)
 }
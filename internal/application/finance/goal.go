@@ -0,0 +1,121 @@
+package financeapp
+
+import (
+	"context"
+	"time"
+
+	"github.com/masterkeysrd/saturn/internal/domain/finance"
+	"github.com/masterkeysrd/saturn/internal/platform/paging"
+)
+
+type CreateGoalRequest struct {
+	Name         string
+	Description  string
+	TargetAmount int64
+	Currency     finance.Currency
+	StartDate    time.Time
+	Deadline     *time.Time
+	AccountID    *finance.AccountID
+	Tag          string
+	Color        string
+	Icon         string
+}
+
+func (c *Coordinator) CreateGoal(ctx context.Context, req *CreateGoalRequest) (*finance.Goal, error) {
+	rCtx, err := c.resolveContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	goal := &finance.Goal{
+		SpaceID:      rCtx.SpaceID,
+		Name:         req.Name,
+		Description:  req.Description,
+		TargetAmount: req.TargetAmount,
+		Currency:     req.Currency,
+		StartDate:    req.StartDate,
+		Deadline:     req.Deadline,
+		AccountID:    req.AccountID,
+		Tag:          req.Tag,
+		Color:        req.Color,
+		Icon:         req.Icon,
+	}
+
+	return c.financeService.CreateGoal(ctx, goal)
+}
+
+type UpdateGoalRequest struct {
+	ID           finance.GoalID
+	Name         string
+	Description  string
+	TargetAmount int64
+	Currency     finance.Currency
+	StartDate    time.Time
+	Deadline     *time.Time
+	AccountID    *finance.AccountID
+	Tag          string
+	Color        string
+	Icon         string
+	Status       finance.GoalStatus
+}
+
+func (c *Coordinator) UpdateGoal(ctx context.Context, req *UpdateGoalRequest) (*finance.Goal, error) {
+	rCtx, err := c.resolveContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	goal := &finance.Goal{
+		ID:           req.ID,
+		SpaceID:      rCtx.SpaceID,
+		Name:         req.Name,
+		Description:  req.Description,
+		TargetAmount: req.TargetAmount,
+		Currency:     req.Currency,
+		StartDate:    req.StartDate,
+		Deadline:     req.Deadline,
+		AccountID:    req.AccountID,
+		Tag:          req.Tag,
+		Color:        req.Color,
+		Icon:         req.Icon,
+		Status:       req.Status,
+	}
+
+	return c.financeService.UpdateGoal(ctx, goal)
+}
+
+func (c *Coordinator) GetGoal(ctx context.Context, id finance.GoalID) (*finance.Goal, error) {
+	rCtx, err := c.resolveContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.financeService.GetGoal(ctx, rCtx.SpaceID, id)
+}
+
+func (c *Coordinator) DeleteGoal(ctx context.Context, id finance.GoalID) error {
+	rCtx, err := c.resolveContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	return c.financeService.DeleteGoal(ctx, rCtx.SpaceID, id)
+}
+
+func (c *Coordinator) ListGoals(ctx context.Context, filter *finance.ListGoalsFilter) (*paging.Page[*finance.Goal], error) {
+	rCtx, err := c.resolveContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.financeService.ListGoals(ctx, rCtx.SpaceID, filter)
+}
+
+func (c *Coordinator) GetGoalProgress(ctx context.Context, id finance.GoalID) (*finance.GoalProgress, error) {
+	rCtx, err := c.resolveContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.financeService.GetGoalProgress(ctx, rCtx.SpaceID, id)
+}
@@ -0,0 +1,48 @@
+package financeapp_test
+
+import (
+	"testing"
+	"time"
+
+	financeapp "github.com/masterkeysrd/saturn/internal/application/finance"
+	"github.com/masterkeysrd/saturn/internal/domain/finance"
+)
+
+func TestCreateGoalRequest_Fields(t *testing.T) {
+	deadline := time.Now().UTC().AddDate(1, 0, 0)
+	accID := finance.AccountID("acc_savings")
+
+	req := &financeapp.CreateGoalRequest{
+		Name:         "Emergency fund",
+		TargetAmount: 1000000,
+		Currency:     "USD",
+		Deadline:     &deadline,
+		AccountID:    &accID,
+		Tag:          "emergency",
+	}
+
+	if req.Name != "Emergency fund" || req.TargetAmount != 1000000 {
+		t.Errorf("unexpected goal name/target")
+	}
+	if req.AccountID == nil || *req.AccountID != "acc_savings" || req.Tag != "emergency" {
+		t.Errorf("expected account acc_savings and tag emergency as contribution sources")
+	}
+}
+
+func TestUpdateGoalRequest_Fields(t *testing.T) {
+	req := &financeapp.UpdateGoalRequest{
+		ID:           "goal_abc",
+		Name:         "Emergency fund",
+		TargetAmount: 1500000,
+		Currency:     "USD",
+		Tag:          "emergency",
+		Status:       finance.GoalStatusArchived,
+	}
+
+	if req.ID != "goal_abc" || req.TargetAmount != 1500000 {
+		t.Errorf("unexpected goal ID/target")
+	}
+	if req.Status != finance.GoalStatusArchived {
+		t.Errorf("expected archived status, got %q", req.Status)
+	}
+}
@@ -0,0 +1,206 @@
+package financeapp
+
+import (
+	"context"
+	"time"
+
+	"github.com/masterkeysrd/saturn/internal/domain/finance"
+	"github.com/masterkeysrd/saturn/internal/platform/paging"
+)
+
+type CreateIncomeRequest struct {
+	Amount          int64
+	Currency        finance.Currency
+	Description     string
+	TransactionDate time.Time
+	EffectiveDate   time.Time
+	AccountID       *finance.AccountID
+	IncomeSourceID  *finance.IncomeSourceID
+	CategoryID      *finance.CategoryID
+	PayeeID         *finance.PayeeID
+	Tags            []string
+}
+
+func (c *Coordinator) CreateIncome(ctx context.Context, req *CreateIncomeRequest) (*finance.Transaction, error) {
+	rCtx, err := c.resolveContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	date := req.TransactionDate
+	if date.IsZero() {
+		date = time.Now().UTC()
+	}
+
+	effectiveDate := req.EffectiveDate
+	if effectiveDate.IsZero() {
+		effectiveDate = date
+	}
+
+	txn := &finance.Transaction{
+		SpaceID:         rCtx.SpaceID,
+		Amount:          req.Amount,
+		Currency:        req.Currency,
+		Description:     req.Description,
+		TransactionDate: date.UTC(),
+		EffectiveDate:   effectiveDate.UTC(),
+		AccountID:       req.AccountID,
+		CategoryID:      req.CategoryID,
+		PayeeID:         req.PayeeID,
+		Tags:            req.Tags,
+		Metadata: finance.TransactionMetadata{
+			IncomeSourceID: req.IncomeSourceID,
+		},
+	}
+
+	return c.financeService.CreateIncome(ctx, txn)
+}
+
+type UpdateIncomeRequest struct {
+	TransactionID   finance.TransactionID
+	Amount          int64
+	Currency        finance.Currency
+	Description     string
+	TransactionDate time.Time
+	EffectiveDate   time.Time
+	AccountID       *finance.AccountID
+	IncomeSourceID  *finance.IncomeSourceID
+	CategoryID      *finance.CategoryID
+	PayeeID         *finance.PayeeID
+	Tags            []string
+}
+
+func (c *Coordinator) UpdateIncome(ctx context.Context, req *UpdateIncomeRequest) (*finance.Transaction, error) {
+	rCtx, err := c.resolveContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	date := req.TransactionDate
+	if date.IsZero() {
+		date = time.Now().UTC()
+	}
+
+	effectiveDate := req.EffectiveDate
+	if effectiveDate.IsZero() {
+		effectiveDate = date
+	}
+
+	txn := &finance.Transaction{
+		ID:              req.TransactionID,
+		SpaceID:         rCtx.SpaceID,
+		Amount:          req.Amount,
+		Currency:        req.Currency,
+		Description:     req.Description,
+		TransactionDate: date.UTC(),
+		EffectiveDate:   effectiveDate.UTC(),
+		AccountID:       req.AccountID,
+		CategoryID:      req.CategoryID,
+		PayeeID:         req.PayeeID,
+		Tags:            req.Tags,
+		Metadata: finance.TransactionMetadata{
+			IncomeSourceID: req.IncomeSourceID,
+		},
+	}
+
+	return c.financeService.UpdateIncome(ctx, txn)
+}
+
+type CreateIncomeSourceRequest struct {
+	Name           string
+	Employer       string
+	ExpectedAmount int64
+	Currency       finance.Currency
+	Cadence        finance.IncomeCadence
+	RecurrenceRule string
+	StartDate      time.Time
+	ExceptionDates []time.Time
+	AccountID      *finance.AccountID
+}
+
+func (c *Coordinator) CreateIncomeSource(ctx context.Context, req *CreateIncomeSourceRequest) (*finance.IncomeSource, error) {
+	rCtx, err := c.resolveContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	source := &finance.IncomeSource{
+		SpaceID:        rCtx.SpaceID,
+		Name:           req.Name,
+		Employer:       req.Employer,
+		ExpectedAmount: req.ExpectedAmount,
+		Currency:       req.Currency,
+		Cadence:        req.Cadence,
+		RecurrenceRule: req.RecurrenceRule,
+		StartDate:      req.StartDate,
+		ExceptionDates: req.ExceptionDates,
+		AccountID:      req.AccountID,
+	}
+
+	return c.financeService.CreateIncomeSource(ctx, source)
+}
+
+type UpdateIncomeSourceRequest struct {
+	ID             finance.IncomeSourceID
+	Name           string
+	Employer       string
+	ExpectedAmount int64
+	Currency       finance.Currency
+	Cadence        finance.IncomeCadence
+	RecurrenceRule string
+	StartDate      time.Time
+	ExceptionDates []time.Time
+	AccountID      *finance.AccountID
+	IsActive       bool
+}
+
+func (c *Coordinator) UpdateIncomeSource(ctx context.Context, req *UpdateIncomeSourceRequest) (*finance.IncomeSource, error) {
+	rCtx, err := c.resolveContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	source := &finance.IncomeSource{
+		ID:             req.ID,
+		SpaceID:        rCtx.SpaceID,
+		Name:           req.Name,
+		Employer:       req.Employer,
+		ExpectedAmount: req.ExpectedAmount,
+		Currency:       req.Currency,
+		Cadence:        req.Cadence,
+		RecurrenceRule: req.RecurrenceRule,
+		StartDate:      req.StartDate,
+		ExceptionDates: req.ExceptionDates,
+		AccountID:      req.AccountID,
+		IsActive:       req.IsActive,
+	}
+
+	return c.financeService.UpdateIncomeSource(ctx, source)
+}
+
+func (c *Coordinator) GetIncomeSource(ctx context.Context, id finance.IncomeSourceID) (*finance.IncomeSource, error) {
+	rCtx, err := c.resolveContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.financeService.GetIncomeSource(ctx, rCtx.SpaceID, id)
+}
+
+func (c *Coordinator) DeleteIncomeSource(ctx context.Context, id finance.IncomeSourceID) error {
+	rCtx, err := c.resolveContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	return c.financeService.DeleteIncomeSource(ctx, rCtx.SpaceID, id)
+}
+
+func (c *Coordinator) ListIncomeSources(ctx context.Context, filter *finance.ListIncomeSourcesFilter) (*paging.Page[*finance.IncomeSource], error) {
+	rCtx, err := c.resolveContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.financeService.ListIncomeSources(ctx, rCtx.SpaceID, filter)
+}
@@ -0,0 +1,49 @@
+package financeapp_test
+
+import (
+	"testing"
+	"time"
+
+	financeapp "github.com/masterkeysrd/saturn/internal/application/finance"
+	"github.com/masterkeysrd/saturn/internal/domain/finance"
+)
+
+func TestCreateIncomeRequest_Fields(t *testing.T) {
+	now := time.Now().UTC()
+	accID := finance.AccountID("acc_payroll")
+	srcID := finance.IncomeSourceID("ics_acme")
+
+	req := &financeapp.CreateIncomeRequest{
+		Amount:          250000,
+		Currency:        "USD",
+		Description:     "Monthly salary",
+		TransactionDate: now,
+		EffectiveDate:   now,
+		AccountID:       &accID,
+		IncomeSourceID:  &srcID,
+	}
+
+	if req.Amount != 250000 || req.Currency != "USD" {
+		t.Errorf("unexpected income amount/currency")
+	}
+	if req.IncomeSourceID == nil || *req.IncomeSourceID != "ics_acme" {
+		t.Errorf("expected income source ID ics_acme")
+	}
+}
+
+func TestCreateIncomeSourceRequest_Fields(t *testing.T) {
+	req := &financeapp.CreateIncomeSourceRequest{
+		Name:           "Salary",
+		Employer:       "Acme Corp",
+		ExpectedAmount: 250000,
+		Currency:       "USD",
+		Cadence:        finance.IncomeCadenceMonthly,
+	}
+
+	if req.Name != "Salary" || req.Employer != "Acme Corp" {
+		t.Errorf("unexpected income source name/employer")
+	}
+	if req.Cadence != finance.IncomeCadenceMonthly {
+		t.Errorf("expected monthly cadence, got %q", req.Cadence)
+	}
+}
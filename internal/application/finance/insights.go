@@ -26,3 +26,22 @@ func (c *Coordinator) GetInsights(ctx context.Context, req *GetInsightsRequest)
 		EndDate:     req.EndDate,
 	})
 }
+
+type ForecastCashFlowRequest struct {
+	Days       int32
+	AccountIDs []finance.AccountID
+}
+
+func (c *Coordinator) ForecastCashFlow(ctx context.Context, req *ForecastCashFlowRequest) (*finance.CashFlowForecast, error) {
+	rCtx, err := c.resolveContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.financeService.ForecastCashFlow(ctx, &finance.ForecastCashFlowRequest{
+		SpaceID:    rCtx.SpaceID,
+		Days:       req.Days,
+		AccountIDs: req.AccountIDs,
+		Now:        time.Now(),
+	})
+}
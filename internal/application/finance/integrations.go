@@ -63,6 +63,12 @@ func (c *Coordinator) IngestEmail(ctx context.Context, spaceID string, integrati
 		rawPayload = state.Request.TextContent
 	}
 
+	// Budgets resolved by rules or by name are passed by ID; StageInboxItem only accepts budget IDs.
+	suggestedBudget := state.SuggestedBudget
+	if state.BudgetID != nil {
+		suggestedBudget = *state.BudgetID
+	}
+
 	staged, err := c.financeService.StageInboxItem(ctx, finance.SpaceID(spaceID), &finance.StageInboxItem{
 		IntegrationID:   integrationID,
 		DocType:         docType,
@@ -71,7 +77,8 @@ func (c *Coordinator) IngestEmail(ctx context.Context, spaceID string, integrati
 		Currency:        state.Currency,
 		AccountID:       state.AccountID,
 		CardLastFour:    state.CardLastFour,
-		SuggestedBudget: state.SuggestedBudget,
+		SuggestedBudget: suggestedBudget,
+		PayeeID:         state.PayeeID,
 		Date:            state.Date,
 		RawPayload:      rawPayload,
 		Metadata:        state.Metadata,
@@ -0,0 +1,104 @@
+package financeapp
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/masterkeysrd/saturn/internal/domain/finance"
+	"github.com/masterkeysrd/saturn/internal/platform/paging"
+)
+
+type ExportLedgerRequest struct {
+	Format    finance.LedgerFormat
+	StartDate time.Time
+	EndDate   time.Time
+}
+
+// ExportLedger streams the active space's ledger to w and returns the number of transactions written.
+func (c *Coordinator) ExportLedger(ctx context.Context, req *ExportLedgerRequest, w io.Writer) (int64, error) {
+	rCtx, err := c.resolveContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	q := &finance.LedgerQuery{
+		SpaceID:   rCtx.SpaceID,
+		Format:    req.Format,
+		StartDate: req.StartDate,
+		EndDate:   req.EndDate,
+	}
+
+	return c.financeService.ExportLedger(ctx, q, w)
+}
+
+// ValidateLedgerQuery checks an export request up front so that errors are reported before any output is written.
+func (c *Coordinator) ValidateLedgerQuery(ctx context.Context, req *ExportLedgerRequest) error {
+	rCtx, err := c.resolveContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	q := &finance.LedgerQuery{
+		SpaceID:   rCtx.SpaceID,
+		Format:    req.Format,
+		StartDate: req.StartDate,
+		EndDate:   req.EndDate,
+	}
+	if err := q.Validate(); err != nil {
+		return err
+	}
+
+	_, err = c.financeService.GetFinanceSettings(ctx, rCtx.SpaceID)
+	return err
+}
+
+func (c *Coordinator) CreateLedgerExport(ctx context.Context, req *ExportLedgerRequest) (*finance.LedgerExport, error) {
+	rCtx, err := c.resolveContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	e := &finance.LedgerExport{
+		SpaceID:   rCtx.SpaceID,
+		Format:    req.Format,
+		StartDate: req.StartDate,
+		EndDate:   req.EndDate,
+	}
+
+	return c.financeService.CreateLedgerExport(ctx, e)
+}
+
+func (c *Coordinator) GetLedgerExport(ctx context.Context, id finance.LedgerExportID) (*finance.LedgerExport, error) {
+	rCtx, err := c.resolveContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.financeService.GetLedgerExport(ctx, rCtx.SpaceID, id)
+}
+
+func (c *Coordinator) ListLedgerExports(ctx context.Context, filter *finance.ListLedgerExportsFilter) (*paging.Page[*finance.LedgerExport], error) {
+	rCtx, err := c.resolveContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.financeService.ListLedgerExports(ctx, rCtx.SpaceID, filter)
+}
+
+// GetLedgerExportContent returns a completed export of the active space together with its file content.
+func (c *Coordinator) GetLedgerExportContent(ctx context.Context, id finance.LedgerExportID) (*finance.LedgerExport, []byte, error) {
+	rCtx, err := c.resolveContext(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return c.financeService.GetLedgerExportContent(ctx, rCtx.SpaceID, id)
+}
+
+// RunLedgerExport generates a queued export. It runs from the background scheduler, outside any request context.
+func (c *Coordinator) RunLedgerExport(ctx context.Context, spaceID finance.SpaceID, id finance.LedgerExportID) error {
+	_, err := c.financeService.RunLedgerExport(ctx, spaceID, id)
+	return err
+}
@@ -0,0 +1,24 @@
+package financeapp_test
+
+import (
+	"testing"
+	"time"
+
+	financeapp "github.com/masterkeysrd/saturn/internal/application/finance"
+	"github.com/masterkeysrd/saturn/internal/domain/finance"
+)
+
+func TestExportLedgerRequest_Fields(t *testing.T) {
+	req := &financeapp.ExportLedgerRequest{
+		Format:    finance.LedgerFormatBeancount,
+		StartDate: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC),
+	}
+
+	if req.Format != finance.LedgerFormatBeancount {
+		t.Errorf("expected beancount format, got %q", req.Format)
+	}
+	if !req.EndDate.After(req.StartDate) {
+		t.Errorf("expected end date after start date")
+	}
+}
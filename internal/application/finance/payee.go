@@ -0,0 +1,94 @@
+package financeapp
+
+import (
+	"context"
+
+	"github.com/masterkeysrd/saturn/internal/domain/finance"
+	"github.com/masterkeysrd/saturn/internal/platform/paging"
+)
+
+type CreatePayeeRequest struct {
+	Name             string
+	Aliases          []string
+	Patterns         []string
+	DefaultBudgetID  *finance.BudgetID
+	DefaultAccountID *finance.AccountID
+	LogoURL          string
+}
+
+func (c *Coordinator) CreatePayee(ctx context.Context, req *CreatePayeeRequest) (*finance.Payee, error) {
+	rCtx, err := c.resolveContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &finance.Payee{
+		SpaceID:          rCtx.SpaceID,
+		Name:             req.Name,
+		Aliases:          req.Aliases,
+		Patterns:         req.Patterns,
+		DefaultBudgetID:  req.DefaultBudgetID,
+		DefaultAccountID: req.DefaultAccountID,
+		LogoURL:          req.LogoURL,
+	}
+
+	return c.financeService.CreatePayee(ctx, p)
+}
+
+type UpdatePayeeRequest struct {
+	ID               finance.PayeeID
+	Name             string
+	Aliases          []string
+	Patterns         []string
+	DefaultBudgetID  *finance.BudgetID
+	DefaultAccountID *finance.AccountID
+	LogoURL          string
+	UpdateMask       []string
+}
+
+func (c *Coordinator) UpdatePayee(ctx context.Context, req *UpdatePayeeRequest) (*finance.Payee, error) {
+	rCtx, err := c.resolveContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &finance.Payee{
+		ID:               req.ID,
+		SpaceID:          rCtx.SpaceID,
+		Name:             req.Name,
+		Aliases:          req.Aliases,
+		Patterns:         req.Patterns,
+		DefaultBudgetID:  req.DefaultBudgetID,
+		DefaultAccountID: req.DefaultAccountID,
+		LogoURL:          req.LogoURL,
+	}
+
+	return c.financeService.UpdatePayee(ctx, p, req.UpdateMask)
+}
+
+func (c *Coordinator) GetPayee(ctx context.Context, id finance.PayeeID) (*finance.Payee, error) {
+	rCtx, err := c.resolveContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.financeService.GetPayee(ctx, rCtx.SpaceID, id)
+}
+
+func (c *Coordinator) DeletePayee(ctx context.Context, id finance.PayeeID) error {
+	rCtx, err := c.resolveContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	return c.financeService.DeletePayee(ctx, rCtx.SpaceID, id)
+}
+
+func (c *Coordinator) ListPayees(ctx context.Context, filter *finance.ListPayeesFilter) (*paging.Page[*finance.Payee], error) {
+	rCtx, err := c.resolveContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.financeService.ListPayees(ctx, rCtx.SpaceID, filter)
+}
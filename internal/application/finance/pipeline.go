@@ -49,9 +49,10 @@ type IngestionState struct {
 	CardLastFour    string
 	SuggestedBudget string
 
-	// Node 3: Database Entity Resolution Mappings
+	// Node 0 and 3: Rule and Database Entity Resolution Mappings
 	AccountID *string
 	BudgetID  *string
+	PayeeID   *string
 
 	// Node 4: Deduplication Warnings
 	PotentialDuplicateID *string
@@ -68,7 +69,7 @@ func (s *IngestionState) Copy() *IngestionState {
 	if s == nil {
 		return nil
 	}
-	var accID, budID, dupID *string
+	var accID, budID, payID, dupID *string
 	if s.AccountID != nil {
 		val := *s.AccountID
 		accID = &val
@@ -77,6 +78,10 @@ func (s *IngestionState) Copy() *IngestionState {
 		val := *s.BudgetID
 		budID = &val
 	}
+	if s.PayeeID != nil {
+		val := *s.PayeeID
+		payID = &val
+	}
 	if s.PotentialDuplicateID != nil {
 		val := *s.PotentialDuplicateID
 		dupID = &val
@@ -101,12 +106,13 @@ func (s *IngestionState) Copy() *IngestionState {
 		SuggestedBudget:      s.SuggestedBudget,
 		AccountID:            accID,
 		BudgetID:             budID,
+		PayeeID:              payID,
 		PotentialDuplicateID: dupID,
 		StagedItem:           s.StagedItem,
 	}
 }
 
-// ProcessSignalPipeline executes the core Loom Graph (Classifier -> Extractor -> Resolver -> Deduplicator).
+// ProcessSignalPipeline executes the core Loom Graph (Rules -> Classifier -> Extractor -> Resolver -> Deduplicator).
 // Returns the enriched IngestionState without performing side-effects (e.g. DB staging).
 func (c *Coordinator) ProcessSignalPipeline(ctx context.Context, spaceID string, req *IngestionRequest) (*IngestionState, error) {
 	if req == nil {
@@ -123,11 +129,13 @@ func (c *Coordinator) ProcessSignalPipeline(ctx context.Context, spaceID string,
 	// Build the Loom Graph
 	g, err := graph.New[*IngestionState]().
 		WithName("finance-signal-processing").
+		AddNode("apply_rules", graph.NodeFunc(c.pipelineApplyRulesNode)).
 		AddNode("classify", graph.NodeFunc(c.pipelineClassifyNode)).
 		AddNode("extract", graph.NodeFunc(c.pipelineExtractNode)).
 		AddNode("resolve", graph.NodeFunc(c.pipelineResolveNode)).
 		AddNode("deduplicate", graph.NodeFunc(c.pipelineDeduplicateNode)).
-		AddEdge(graph.START, "classify").
+		AddEdge(graph.START, "apply_rules").
+		AddEdge("apply_rules", "classify").
 		AddConditionalEdge("classify", "extract", func(s *IngestionState) bool {
 			return s.Classification != "UNKNOWN"
 		}).
@@ -169,6 +177,7 @@ type SignalSuggestion struct {
 	SuggestedBudget      string         `json:"suggestedBudget,omitempty"`
 	AccountID            *string        `json:"accountId,omitempty"`
 	BudgetID             *string        `json:"budgetId,omitempty"`
+	PayeeID              *string        `json:"payeeId,omitempty"`
 	PotentialDuplicateID *string        `json:"potentialDuplicateId,omitempty"`
 	Metadata             map[string]any `json:"metadata,omitempty"`
 }
@@ -190,11 +199,61 @@ func (c *Coordinator) GetSignalSuggestions(ctx context.Context, spaceID string,
 		SuggestedBudget:      state.SuggestedBudget,
 		AccountID:            state.AccountID,
 		BudgetID:             state.BudgetID,
+		PayeeID:              state.PayeeID,
 		PotentialDuplicateID: state.PotentialDuplicateID,
 		Metadata:             state.Metadata,
 	}, nil
 }
 
+// 0. Rules Node: Applies the space's transaction rules and payees to the raw signal before any agent runs,
+// so user-defined assignments take precedence over the suggestions of the extractor.
+func (c *Coordinator) pipelineApplyRulesNode(ctx context.Context, state *IngestionState) (graph.Command[*IngestionState], error) {
+	var text strings.Builder
+	if state.Request != nil {
+		if subj, ok := state.Request.Metadata["subject"].(string); ok {
+			text.WriteString(subj)
+			text.WriteString("\n")
+		}
+		text.WriteString(state.Request.TextContent)
+	}
+
+	match, err := c.financeService.MatchTransactionRules(ctx, finance.SpaceID(state.SpaceID), &finance.Transaction{
+		Type:        finance.TransactionTypeExpense,
+		Description: text.String(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("match transaction rules: %w", err)
+	}
+
+	return graph.Update[*IngestionState](func(s *IngestionState) *IngestionState {
+		applyRuleMatch(s, match)
+		return s
+	}), nil
+}
+
+// applyRuleMatch fills the state's blank payee, budget and account from a rule match and records the matching rules.
+func applyRuleMatch(s *IngestionState, match *finance.TransactionRuleMatch) {
+	if match.Payee != nil && s.PayeeID == nil {
+		payeeID := string(match.Payee.ID)
+		s.PayeeID = &payeeID
+	}
+	if match.BudgetID != nil && s.BudgetID == nil {
+		budgetID := string(*match.BudgetID)
+		s.BudgetID = &budgetID
+	}
+	if match.AccountID != nil && s.AccountID == nil {
+		accountID := string(*match.AccountID)
+		s.AccountID = &accountID
+	}
+	if len(match.RuleIDs) > 0 {
+		ruleIDs := make([]string, len(match.RuleIDs))
+		for i, id := range match.RuleIDs {
+			ruleIDs[i] = string(id)
+		}
+		s.Metadata["matched_rule_ids"] = ruleIDs
+	}
+}
+
 // 1. Classifier Node: Decides if document is INVOICE, RECEIPT, BANK_NOTIFICATION, SYSTEM_VERIFICATION, or UNKNOWN.
 func (c *Coordinator) pipelineClassifyNode(ctx context.Context, state *IngestionState) (graph.Command[*IngestionState], error) {
 	textContent := ""
@@ -366,13 +425,54 @@ func (c *Coordinator) pipelineResolveNode(ctx context.Context, state *IngestionS
 		}
 	}
 
+	// Second rule pass over the extracted counterparty, which is far more precise than the raw signal.
+	draft := &finance.Transaction{
+		Type:        finance.TransactionTypeExpense,
+		Description: state.Vendor,
+		Amount:      state.Amount,
+	}
+	if accountID != nil {
+		accID := finance.AccountID(*accountID)
+		draft.AccountID = &accID
+	}
+	match, err := c.financeService.MatchTransactionRules(ctx, finance.SpaceID(state.SpaceID), draft)
+	if err != nil {
+		return nil, fmt.Errorf("match transaction rules: %w", err)
+	}
+
 	return graph.Update[*IngestionState](func(s *IngestionState) *IngestionState {
-		s.AccountID = accountID
-		s.BudgetID = budgetID
+		// A card match identifies the account better than a payee default; rule budgets win over the extractor.
+		if accountID != nil {
+			s.AccountID = accountID
+		}
+		applyRuleMatch(s, match)
+		if s.BudgetID == nil {
+			s.BudgetID = budgetID
+		}
+		if match.Payee != nil && s.PayeeID != nil && *s.PayeeID == string(match.Payee.ID) {
+			s.Vendor = match.Payee.Name
+		}
 		return s
 	}), nil
 }
 
+// dedupCandidateFilter narrows ledger transactions down to those within the deduplication
+// window and amount tolerance of a parsed signal.
+func dedupCandidateFilter(amount int64, date time.Time) *finance.TransactionFilter {
+	minAmt := int64(float64(amount) * dedupAmountMinFactor)
+	maxAmt := int64(float64(amount) * dedupAmountMaxFactor)
+	startDate := date.AddDate(0, 0, -dedupDateRangeDays)
+	endDate := date.AddDate(0, 0, dedupDateRangeDays)
+
+	return &finance.TransactionFilter{
+		PageSize:  dedupMaxCandidates,
+		MinAmount: &minAmt,
+		MaxAmount: &maxAmt,
+		StartDate: &startDate,
+		EndDate:   &endDate,
+	}
+}
+
 // 4. Deduplicate Node: Audits recently logged transactions to flag double-entries.
 func (c *Coordinator) pipelineDeduplicateNode(ctx context.Context, state *IngestionState) (graph.Command[*IngestionState], error) {
 	var parsedDate time.Time
@@ -387,25 +487,9 @@ func (c *Coordinator) pipelineDeduplicateNode(ctx context.Context, state *Ingest
 		parsedDate = time.Now()
 	}
 
-	minAmt := int64(float64(state.Amount) * dedupAmountMinFactor)
-	maxAmt := int64(float64(state.Amount) * dedupAmountMaxFactor)
-	startDate := parsedDate.AddDate(0, 0, -dedupDateRangeDays)
-	endDate := parsedDate.AddDate(0, 0, dedupDateRangeDays)
-
-	var searchQuery *string
+	filter := dedupCandidateFilter(state.Amount, parsedDate)
 	if state.Vendor != "" {
-		searchQuery = &state.Vendor
-	}
-
-	filter := &finance.TransactionFilter{
-		PageSize:  dedupMaxCandidates,
-		MinAmount: &minAmt,
-		MaxAmount: &maxAmt,
-		StartDate: &startDate,
-		EndDate:   &endDate,
-	}
-	if searchQuery != nil {
-		filter.SearchQuery = searchQuery
+		filter.SearchQuery = &state.Vendor
 	}
 
 	page, err := c.financeService.ListTransactions(ctx, finance.SpaceID(state.SpaceID), filter)
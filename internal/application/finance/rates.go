@@ -104,3 +104,8 @@ func (c *Coordinator) DeleteExchangeRate(ctx context.Context, req *DeleteExchang
 
 	return c.financeService.DeleteExchangeRateByID(ctx, rCtx.SpaceID, req.ID)
 }
+
+// SyncExchangeRates fetches reference rates from the configured provider for every space.
+func (c *Coordinator) SyncExchangeRates(ctx context.Context) error {
+	return c.financeService.SyncExchangeRates(ctx)
+}
@@ -0,0 +1,85 @@
+package financeapp
+
+import (
+	"context"
+	"time"
+
+	"github.com/masterkeysrd/saturn/internal/domain/finance"
+)
+
+// StartReconciliationRequest represents the bank statement an account is reconciled against.
+type StartReconciliationRequest struct {
+	AccountID        finance.AccountID
+	StatementDate    time.Time
+	StatementBalance int64
+	Note             string
+}
+
+func (c *Coordinator) StartReconciliation(ctx context.Context, req *StartReconciliationRequest) (*finance.Reconciliation, error) {
+	rCtx, err := c.resolveContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.financeService.StartReconciliation(ctx, &finance.Reconciliation{
+		SpaceID:          rCtx.SpaceID,
+		AccountID:        req.AccountID,
+		StatementDate:    req.StatementDate,
+		StatementBalance: req.StatementBalance,
+		Note:             req.Note,
+	})
+}
+
+func (c *Coordinator) GetReconciliation(ctx context.Context, id finance.ReconciliationID) (*finance.Reconciliation, error) {
+	rCtx, err := c.resolveContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.financeService.GetReconciliation(ctx, rCtx.SpaceID, id)
+}
+
+func (c *Coordinator) ListReconciliations(ctx context.Context, accountID finance.AccountID) ([]*finance.Reconciliation, error) {
+	rCtx, err := c.resolveContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.financeService.ListReconciliations(ctx, rCtx.SpaceID, accountID)
+}
+
+func (c *Coordinator) ListReconciliationTransactions(ctx context.Context, id finance.ReconciliationID) ([]*finance.Transaction, error) {
+	rCtx, err := c.resolveContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.financeService.ListReconciliationTransactions(ctx, rCtx.SpaceID, id)
+}
+
+func (c *Coordinator) SetTransactionsCleared(ctx context.Context, id finance.ReconciliationID, txnIDs []finance.TransactionID, cleared bool) (*finance.Reconciliation, error) {
+	rCtx, err := c.resolveContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.financeService.SetTransactionsCleared(ctx, rCtx.SpaceID, id, txnIDs, cleared)
+}
+
+func (c *Coordinator) FinalizeReconciliation(ctx context.Context, id finance.ReconciliationID, createAdjustment bool) (*finance.Reconciliation, error) {
+	rCtx, err := c.resolveContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.financeService.FinalizeReconciliation(ctx, rCtx.SpaceID, id, createAdjustment)
+}
+
+func (c *Coordinator) CancelReconciliation(ctx context.Context, id finance.ReconciliationID) error {
+	rCtx, err := c.resolveContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	return c.financeService.CancelReconciliation(ctx, rCtx.SpaceID, id)
+}
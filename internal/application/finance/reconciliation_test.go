@@ -0,0 +1,26 @@
+package financeapp_test
+
+import (
+	"testing"
+	"time"
+
+	financeapp "github.com/masterkeysrd/saturn/internal/application/finance"
+	"github.com/masterkeysrd/saturn/internal/domain/finance"
+)
+
+func TestStartReconciliationRequest_Fields(t *testing.T) {
+	statementDate := time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC)
+	req := &financeapp.StartReconciliationRequest{
+		AccountID:        finance.AccountID("acc_123"),
+		StatementDate:    statementDate,
+		StatementBalance: 245000,
+		Note:             "March statement",
+	}
+
+	if req.AccountID != "acc_123" {
+		t.Errorf("expected account ID acc_123, got %s", req.AccountID)
+	}
+	if !req.StatementDate.Equal(statementDate) || req.StatementBalance != 245000 {
+		t.Errorf("unexpected statement fields: %v / %d", req.StatementDate, req.StatementBalance)
+	}
+}
@@ -13,6 +13,9 @@ type CreateRecurringExpenseRequest struct {
 	Amount          int64
 	Currency        finance.Currency
 	Interval        string
+	RecurrenceRule  string
+	StartDate       time.Time
+	ExceptionDates  []time.Time
 	DueDate         time.Time
 	IsVariable      bool
 	GracePeriodDays int32
@@ -25,6 +28,9 @@ type UpdateRecurringExpenseRequest struct {
 	Amount          int64
 	Currency        finance.Currency
 	Interval        string
+	RecurrenceRule  string
+	StartDate       time.Time
+	ExceptionDates  []time.Time
 	DueDate         time.Time
 	IsVariable      bool
 	Status          string
@@ -48,24 +54,69 @@ func (c *Coordinator) CreateRecurringExpense(ctx context.Context, req *CreateRec
 		return nil, err
 	}
 
+	res, err := c.financeService.CreateRecurringExpense(ctx, newRecurringExpense(rCtx.SpaceID, req))
+	if err != nil {
+		return nil, err
+	}
+
+	_ = c.financeService.GenerateScheduledPayments(ctx)
+	return res, nil
+}
+
+func newRecurringExpense(spaceID finance.SpaceID, req *CreateRecurringExpenseRequest) *finance.RecurringExpense {
 	dueDate := req.DueDate
 	if dueDate.IsZero() {
 		dueDate = time.Now().UTC()
 	}
 
-	expense := &finance.RecurringExpense{
-		SpaceID:         rCtx.SpaceID,
+	return &finance.RecurringExpense{
+		SpaceID:         spaceID,
 		BudgetID:        req.BudgetID,
 		Name:            req.Name,
 		Amount:          req.Amount,
 		Currency:        req.Currency,
 		Interval:        req.Interval,
+		RecurrenceRule:  req.RecurrenceRule,
+		StartDate:       req.StartDate,
+		ExceptionDates:  req.ExceptionDates,
 		NextDueDate:     dueDate,
 		IsVariable:      req.IsVariable,
 		GracePeriodDays: req.GracePeriodDays,
 	}
+}
 
-	res, err := c.financeService.CreateRecurringExpense(ctx, expense)
+type DetectSubscriptionsRequest struct {
+	LookbackDays int32
+}
+
+func (c *Coordinator) DetectSubscriptions(ctx context.Context, req *DetectSubscriptionsRequest) ([]*finance.SubscriptionSuggestion, error) {
+	rCtx, err := c.resolveContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.financeService.DetectSubscriptions(ctx, &finance.DetectSubscriptionsRequest{
+		SpaceID:      rCtx.SpaceID,
+		LookbackDays: req.LookbackDays,
+		Now:          time.Now().UTC(),
+	})
+}
+
+type ConvertToRecurringExpenseRequest struct {
+	RecurringExpense CreateRecurringExpenseRequest
+	TransactionIDs   []finance.TransactionID
+}
+
+func (c *Coordinator) ConvertToRecurringExpense(ctx context.Context, req *ConvertToRecurringExpenseRequest) (*finance.RecurringExpense, error) {
+	rCtx, err := c.resolveContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.financeService.ConvertToRecurringExpense(ctx, &finance.ConvertToRecurringExpenseRequest{
+		RecurringExpense: newRecurringExpense(rCtx.SpaceID, &req.RecurringExpense),
+		TransactionIDs:   req.TransactionIDs,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -88,6 +139,9 @@ func (c *Coordinator) UpdateRecurringExpense(ctx context.Context, req *UpdateRec
 		Amount:          req.Amount,
 		Currency:        req.Currency,
 		Interval:        req.Interval,
+		RecurrenceRule:  req.RecurrenceRule,
+		StartDate:       req.StartDate,
+		ExceptionDates:  req.ExceptionDates,
 		NextDueDate:     req.DueDate,
 		IsVariable:      req.IsVariable,
 		Status:          finance.RecurringExpenseStatus(req.Status),
@@ -0,0 +1,86 @@
+package financeapp
+
+import (
+	"context"
+
+	"github.com/masterkeysrd/saturn/internal/domain/finance"
+	"github.com/masterkeysrd/saturn/internal/platform/paging"
+)
+
+type CreateSavedFilterRequest struct {
+	Name        string
+	Description string
+	Expression  string
+	Sort        string
+}
+
+func (c *Coordinator) CreateSavedFilter(ctx context.Context, req *CreateSavedFilterRequest) (*finance.SavedFilter, error) {
+	rCtx, err := c.resolveContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	f := &finance.SavedFilter{
+		SpaceID:     rCtx.SpaceID,
+		Name:        req.Name,
+		Description: req.Description,
+		Expression:  req.Expression,
+		Sort:        req.Sort,
+	}
+
+	return c.financeService.CreateSavedFilter(ctx, f)
+}
+
+type UpdateSavedFilterRequest struct {
+	ID          finance.SavedFilterID
+	Name        string
+	Description string
+	Expression  string
+	Sort        string
+	UpdateMask  []string
+}
+
+func (c *Coordinator) UpdateSavedFilter(ctx context.Context, req *UpdateSavedFilterRequest) (*finance.SavedFilter, error) {
+	rCtx, err := c.resolveContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	f := &finance.SavedFilter{
+		ID:          req.ID,
+		SpaceID:     rCtx.SpaceID,
+		Name:        req.Name,
+		Description: req.Description,
+		Expression:  req.Expression,
+		Sort:        req.Sort,
+	}
+
+	return c.financeService.UpdateSavedFilter(ctx, f, req.UpdateMask)
+}
+
+func (c *Coordinator) GetSavedFilter(ctx context.Context, id finance.SavedFilterID) (*finance.SavedFilter, error) {
+	rCtx, err := c.resolveContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.financeService.GetSavedFilter(ctx, rCtx.SpaceID, id)
+}
+
+func (c *Coordinator) DeleteSavedFilter(ctx context.Context, id finance.SavedFilterID) error {
+	rCtx, err := c.resolveContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	return c.financeService.DeleteSavedFilter(ctx, rCtx.SpaceID, id)
+}
+
+func (c *Coordinator) ListSavedFilters(ctx context.Context, filter *finance.ListSavedFiltersFilter) (*paging.Page[*finance.SavedFilter], error) {
+	rCtx, err := c.resolveContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.financeService.ListSavedFilters(ctx, rCtx.SpaceID, filter)
+}
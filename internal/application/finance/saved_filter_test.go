@@ -0,0 +1,23 @@
+package financeapp_test
+
+import (
+	"testing"
+
+	financeapp "github.com/masterkeysrd/saturn/internal/application/finance"
+)
+
+func TestUpdateSavedFilterRequest_Fields(t *testing.T) {
+	req := &financeapp.UpdateSavedFilterRequest{
+		ID:         "sfl_abc",
+		Expression: `amount >= 5000 AND budget_id IN ("bud_a", "bud_b")`,
+		Sort:       "amount:desc",
+		UpdateMask: []string{"expression", "sort"},
+	}
+
+	if req.ID != "sfl_abc" || req.Sort != "amount:desc" {
+		t.Errorf("unexpected saved filter ID/sort")
+	}
+	if len(req.UpdateMask) != 2 || req.UpdateMask[0] != "expression" {
+		t.Errorf("expected expression and sort in update mask, got %v", req.UpdateMask)
+	}
+}
@@ -0,0 +1,164 @@
+package financeapp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/masterkeysrd/saturn/internal/domain/finance"
+)
+
+// ImportStatementRequest represents a bank statement file upload for an account.
+type ImportStatementRequest struct {
+	AccountID   finance.AccountID
+	Format      finance.StatementFormat
+	Content     []byte
+	Mapping     *finance.StatementMapping // Optional: CSV column mapping, falls back to the saved mapping
+	SaveMapping bool                      // Persist Mapping as the account's default CSV mapping
+}
+
+// ImportStatementResult summarizes the rows staged from an imported statement.
+type ImportStatementResult struct {
+	Items          []*finance.InboxItem
+	SkippedCount   int32 // Rows already staged by a previous import
+	DuplicateCount int32 // Staged rows flagged as potential duplicates of ledger transactions
+}
+
+// ImportStatement parses a bank statement and stages each row in the inbox. Rows are
+// checked against the ledger using the same window and amount tolerance as the signal
+// pipeline, but matching is deterministic and does not involve the deduplication agent.
+func (c *Coordinator) ImportStatement(ctx context.Context, req *ImportStatementRequest) (*ImportStatementResult, error) {
+	rCtx, err := c.resolveContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := c.financeService.GetAccount(ctx, rCtx.SpaceID, req.AccountID)
+	if err != nil {
+		return nil, fmt.Errorf("get account: %w", err)
+	}
+
+	var mapping *finance.StatementMapping
+	if req.Format == finance.StatementFormatCSV {
+		mapping, err = c.resolveStatementMapping(ctx, rCtx.SpaceID, req)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	entries, err := finance.ParseStatement(req.Format, req.Content, mapping, account.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	externalIDs := finance.StatementExternalIDs(entries)
+	staged, err := c.financeService.ListStagedExternalIDs(ctx, rCtx.SpaceID, account.ID, externalIDs)
+	if err != nil {
+		return nil, fmt.Errorf("list staged statement rows: %w", err)
+	}
+
+	accountIDStr := string(account.ID)
+	result := &ImportStatementResult{}
+	for i, entry := range entries {
+		if staged[externalIDs[i]] {
+			result.SkippedCount++
+			continue
+		}
+
+		currency := entry.Currency
+		if currency == "" {
+			currency = account.Currency
+		}
+
+		meta := map[string]any{
+			"received":         time.Now().Format(time.RFC3339),
+			"transaction_type": string(entry.TransactionType()),
+			"statement_format": string(req.Format),
+		}
+		if entry.Reference != "" {
+			meta["reference_number"] = entry.Reference
+		}
+
+		duplicate, err := c.findStatementDuplicate(ctx, rCtx.SpaceID, account.ID, entry)
+		if err != nil {
+			return nil, err
+		}
+		if duplicate != nil {
+			meta["duplicate_warning"] = true
+			meta["potential_duplicate_id"] = string(duplicate.ID)
+			meta["duplicate_reason"] = fmt.Sprintf("matches %s transaction of %d on %s",
+				duplicate.Type, duplicate.Amount, duplicate.TransactionDate.Format("2006-01-02"))
+			result.DuplicateCount++
+		}
+
+		externalID := externalIDs[i]
+		item, err := c.financeService.StageInboxItem(ctx, rCtx.SpaceID, &finance.StageInboxItem{
+			Source:     req.Format.InboxSource(),
+			ExternalID: &externalID,
+			DocType:    finance.InboxItemDocBankNotification,
+			Vendor:     entry.Description,
+			Amount:     entry.AbsAmount(),
+			Currency:   string(currency),
+			AccountID:  &accountIDStr,
+			Date:       entry.Date.Format(time.RFC3339),
+			RawPayload: entry.Raw,
+			Metadata:   meta,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("stage statement row %d: %w", i, err)
+		}
+		result.Items = append(result.Items, item)
+	}
+
+	return result, nil
+}
+
+// resolveStatementMapping returns the CSV column mapping supplied with the request,
+// saving it when asked to, or the account's previously saved mapping.
+func (c *Coordinator) resolveStatementMapping(ctx context.Context, spaceID finance.SpaceID, req *ImportStatementRequest) (*finance.StatementMapping, error) {
+	if req.Mapping == nil {
+		mapping, err := c.financeService.GetStatementMapping(ctx, spaceID, req.AccountID)
+		if err != nil {
+			if errors.Is(err, finance.ErrStatementMappingNotFound) {
+				return nil, fmt.Errorf("%w: provide a CSV column mapping for this account", err)
+			}
+			return nil, err
+		}
+		return mapping, nil
+	}
+
+	mapping := req.Mapping
+	mapping.SpaceID = spaceID
+	mapping.AccountID = req.AccountID
+	if req.SaveMapping {
+		return c.financeService.SaveStatementMapping(ctx, mapping)
+	}
+	if err := mapping.Validate(); err != nil {
+		return nil, err
+	}
+	return mapping, nil
+}
+
+// findStatementDuplicate looks up a ledger transaction that likely records the same
+// movement as a statement entry.
+func (c *Coordinator) findStatementDuplicate(ctx context.Context, spaceID finance.SpaceID, accountID finance.AccountID, entry *finance.StatementEntry) (*finance.Transaction, error) {
+	txnType := entry.TransactionType()
+	filter := dedupCandidateFilter(entry.AbsAmount(), entry.Date)
+	filter.Type = &txnType
+
+	page, err := c.financeService.ListTransactions(ctx, spaceID, filter)
+	if err != nil {
+		return nil, fmt.Errorf("list transactions: %w", err)
+	}
+	return entry.MatchDuplicate(accountID, page.Items), nil
+}
+
+// GetStatementMapping retrieves the saved CSV column mapping for an account.
+func (c *Coordinator) GetStatementMapping(ctx context.Context, accountID finance.AccountID) (*finance.StatementMapping, error) {
+	rCtx, err := c.resolveContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.financeService.GetStatementMapping(ctx, rCtx.SpaceID, accountID)
+}
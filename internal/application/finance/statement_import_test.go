@@ -0,0 +1,30 @@
+package financeapp_test
+
+import (
+	"testing"
+
+	financeapp "github.com/masterkeysrd/saturn/internal/application/finance"
+	"github.com/masterkeysrd/saturn/internal/domain/finance"
+)
+
+func TestImportStatementRequest_Fields(t *testing.T) {
+	req := &financeapp.ImportStatementRequest{
+		AccountID: "acc_checking",
+		Format:    finance.StatementFormatCSV,
+		Content:   []byte("Date,Payee,Amount\n2026-03-01,Bakery,-12.00\n"),
+		Mapping: &finance.StatementMapping{
+			HasHeader:         true,
+			DateColumn:        "Date",
+			AmountColumn:      "Amount",
+			DescriptionColumn: "Payee",
+		},
+		SaveMapping: true,
+	}
+
+	if req.Format.InboxSource() != finance.InboxItemSourceStatementCSV {
+		t.Errorf("expected CSV inbox source, got %s", req.Format.InboxSource())
+	}
+	if req.Mapping == nil || req.Mapping.DescriptionColumn != "Payee" {
+		t.Errorf("expected mapping description column Payee")
+	}
+}
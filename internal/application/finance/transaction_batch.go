@@ -0,0 +1,73 @@
+package financeapp
+
+import (
+	"context"
+
+	"github.com/masterkeysrd/saturn/internal/domain/finance"
+)
+
+type BatchUpdateTransactionsRequest struct {
+	IDs           []finance.TransactionID
+	Filter        string
+	SavedFilterID *finance.SavedFilterID
+	Description   string
+	BudgetID      *finance.BudgetID
+	AccountID     *finance.AccountID
+	CategoryID    *finance.CategoryID
+	PayeeID       *finance.PayeeID
+	Tags          []string
+	UpdateMask    []string
+	ValidateOnly  bool
+}
+
+// BatchUpdateTransactions applies the same field changes to the selected expenses and income.
+func (c *Coordinator) BatchUpdateTransactions(ctx context.Context, req *BatchUpdateTransactionsRequest) (*finance.BatchTransactionsResult, error) {
+	rCtx, err := c.resolveContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.financeService.BatchUpdateTransactions(ctx, &finance.BatchUpdateTransactionsRequest{
+		SpaceID: rCtx.SpaceID,
+		Selector: finance.TransactionSelector{
+			IDs:           req.IDs,
+			Expression:    req.Filter,
+			SavedFilterID: req.SavedFilterID,
+		},
+		Transaction: &finance.Transaction{
+			Description: req.Description,
+			BudgetID:    req.BudgetID,
+			AccountID:   req.AccountID,
+			CategoryID:  req.CategoryID,
+			PayeeID:     req.PayeeID,
+			Tags:        req.Tags,
+		},
+		UpdateMask:   req.UpdateMask,
+		ValidateOnly: req.ValidateOnly,
+	})
+}
+
+type BatchDeleteTransactionsRequest struct {
+	IDs           []finance.TransactionID
+	Filter        string
+	SavedFilterID *finance.SavedFilterID
+	ValidateOnly  bool
+}
+
+// BatchDeleteTransactions deletes the selected expenses and income.
+func (c *Coordinator) BatchDeleteTransactions(ctx context.Context, req *BatchDeleteTransactionsRequest) (*finance.BatchTransactionsResult, error) {
+	rCtx, err := c.resolveContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.financeService.BatchDeleteTransactions(ctx, &finance.BatchDeleteTransactionsRequest{
+		SpaceID: rCtx.SpaceID,
+		Selector: finance.TransactionSelector{
+			IDs:           req.IDs,
+			Expression:    req.Filter,
+			SavedFilterID: req.SavedFilterID,
+		},
+		ValidateOnly: req.ValidateOnly,
+	})
+}
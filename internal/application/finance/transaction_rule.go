@@ -0,0 +1,134 @@
+package financeapp
+
+import (
+	"context"
+	"time"
+
+	"github.com/masterkeysrd/saturn/internal/domain/finance"
+	"github.com/masterkeysrd/saturn/internal/platform/paging"
+)
+
+type CreateTransactionRuleRequest struct {
+	Name               string
+	Priority           int32
+	DescriptionPattern string
+	AccountID          *finance.AccountID
+	MinAmount          *int64
+	MaxAmount          *int64
+	SetBudgetID        *finance.BudgetID
+	SetPayeeID         *finance.PayeeID
+	SetCategoryID      *finance.CategoryID
+	AddTags            []string
+}
+
+func (c *Coordinator) CreateTransactionRule(ctx context.Context, req *CreateTransactionRuleRequest) (*finance.TransactionRule, error) {
+	rCtx, err := c.resolveContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &finance.TransactionRule{
+		SpaceID:            rCtx.SpaceID,
+		Name:               req.Name,
+		Priority:           req.Priority,
+		DescriptionPattern: req.DescriptionPattern,
+		AccountID:          req.AccountID,
+		MinAmount:          req.MinAmount,
+		MaxAmount:          req.MaxAmount,
+		SetBudgetID:        req.SetBudgetID,
+		SetPayeeID:         req.SetPayeeID,
+		SetCategoryID:      req.SetCategoryID,
+		AddTags:            req.AddTags,
+	}
+
+	return c.financeService.CreateTransactionRule(ctx, r)
+}
+
+type UpdateTransactionRuleRequest struct {
+	ID                 finance.TransactionRuleID
+	Name               string
+	Priority           int32
+	IsActive           bool
+	DescriptionPattern string
+	AccountID          *finance.AccountID
+	MinAmount          *int64
+	MaxAmount          *int64
+	SetBudgetID        *finance.BudgetID
+	SetPayeeID         *finance.PayeeID
+	SetCategoryID      *finance.CategoryID
+	AddTags            []string
+	UpdateMask         []string
+}
+
+func (c *Coordinator) UpdateTransactionRule(ctx context.Context, req *UpdateTransactionRuleRequest) (*finance.TransactionRule, error) {
+	rCtx, err := c.resolveContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &finance.TransactionRule{
+		ID:                 req.ID,
+		SpaceID:            rCtx.SpaceID,
+		Name:               req.Name,
+		Priority:           req.Priority,
+		IsActive:           req.IsActive,
+		DescriptionPattern: req.DescriptionPattern,
+		AccountID:          req.AccountID,
+		MinAmount:          req.MinAmount,
+		MaxAmount:          req.MaxAmount,
+		SetBudgetID:        req.SetBudgetID,
+		SetPayeeID:         req.SetPayeeID,
+		SetCategoryID:      req.SetCategoryID,
+		AddTags:            req.AddTags,
+	}
+
+	return c.financeService.UpdateTransactionRule(ctx, r, req.UpdateMask)
+}
+
+func (c *Coordinator) GetTransactionRule(ctx context.Context, id finance.TransactionRuleID) (*finance.TransactionRule, error) {
+	rCtx, err := c.resolveContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.financeService.GetTransactionRule(ctx, rCtx.SpaceID, id)
+}
+
+func (c *Coordinator) DeleteTransactionRule(ctx context.Context, id finance.TransactionRuleID) error {
+	rCtx, err := c.resolveContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	return c.financeService.DeleteTransactionRule(ctx, rCtx.SpaceID, id)
+}
+
+func (c *Coordinator) ListTransactionRules(ctx context.Context, filter *finance.ListTransactionRulesFilter) (*paging.Page[*finance.TransactionRule], error) {
+	rCtx, err := c.resolveContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.financeService.ListTransactionRules(ctx, rCtx.SpaceID, filter)
+}
+
+type ApplyTransactionRulesRequest struct {
+	StartDate *time.Time
+	EndDate   *time.Time
+	Overwrite bool
+}
+
+// ApplyTransactionRules re-runs the space's rules and payees over its transaction history.
+func (c *Coordinator) ApplyTransactionRules(ctx context.Context, req *ApplyTransactionRulesRequest) (*finance.ApplyTransactionRulesResult, error) {
+	rCtx, err := c.resolveContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.financeService.ApplyTransactionRules(ctx, &finance.ApplyTransactionRulesRequest{
+		SpaceID:   rCtx.SpaceID,
+		StartDate: req.StartDate,
+		EndDate:   req.EndDate,
+		Overwrite: req.Overwrite,
+	})
+}
@@ -15,6 +15,10 @@ type CreateExpenseRequest struct {
 	TransactionDate time.Time
 	EffectiveDate   time.Time
 	AccountID       *finance.AccountID
+	CategoryID      *finance.CategoryID
+	PayeeID         *finance.PayeeID
+	Tags            []string
+	Splits          []*finance.TransactionSplit
 }
 
 func (c *Coordinator) CreateExpense(ctx context.Context, req *CreateExpenseRequest) (*finance.Transaction, error) {
@@ -35,13 +39,17 @@ func (c *Coordinator) CreateExpense(ctx context.Context, req *CreateExpenseReque
 
 	txn := &finance.Transaction{
 		SpaceID:         rCtx.SpaceID,
-		BudgetID:        &req.BudgetID,
+		BudgetID:        expenseBudgetID(req.BudgetID),
 		Amount:          req.Amount,
 		Currency:        req.Currency,
 		Description:     req.Description,
 		TransactionDate: date.UTC(),
 		EffectiveDate:   effectiveDate.UTC(),
 		AccountID:       req.AccountID,
+		CategoryID:      req.CategoryID,
+		PayeeID:         req.PayeeID,
+		Tags:            req.Tags,
+		Splits:          req.Splits,
 	}
 
 	return c.financeService.CreateExpense(ctx, txn)
@@ -64,6 +72,10 @@ type UpdateExpenseRequest struct {
 	TransactionDate time.Time
 	EffectiveDate   time.Time
 	AccountID       *finance.AccountID
+	CategoryID      *finance.CategoryID
+	PayeeID         *finance.PayeeID
+	Tags            []string
+	Splits          []*finance.TransactionSplit
 }
 
 func (c *Coordinator) UpdateExpense(ctx context.Context, req *UpdateExpenseRequest) (*finance.Transaction, error) {
@@ -85,18 +97,32 @@ func (c *Coordinator) UpdateExpense(ctx context.Context, req *UpdateExpenseReque
 	txn := &finance.Transaction{
 		ID:              req.TransactionID,
 		SpaceID:         rCtx.SpaceID,
-		BudgetID:        &req.BudgetID,
+		BudgetID:        expenseBudgetID(req.BudgetID),
 		Amount:          req.Amount,
 		Currency:        req.Currency,
 		Description:     req.Description,
 		TransactionDate: date.UTC(),
 		EffectiveDate:   effectiveDate.UTC(),
 		AccountID:       req.AccountID,
+		CategoryID:      req.CategoryID,
+		PayeeID:         req.PayeeID,
+		Tags:            req.Tags,
+		Splits:          req.Splits,
 	}
 
 	return c.financeService.UpdateExpense(ctx, txn)
 }
 
+// expenseBudgetID returns the transaction-level budget for an expense request.
+// Split expenses carry their budgets on each split line instead, and expenses
+// without a budget are left for the space's transaction rules to assign.
+func expenseBudgetID(budgetID finance.BudgetID) *finance.BudgetID {
+	if budgetID == "" {
+		return nil
+	}
+	return &budgetID
+}
+
 type ListTransactionEventsRequest struct {
 	TransactionID finance.TransactionID
 }
@@ -0,0 +1,29 @@
+package financeapp_test
+
+import (
+	"testing"
+
+	financeapp "github.com/masterkeysrd/saturn/internal/application/finance"
+	"github.com/masterkeysrd/saturn/internal/domain/finance"
+)
+
+func TestCreateExpenseRequest_Splits(t *testing.T) {
+	food := finance.BudgetID("bgt_food")
+	pharmacy := finance.BudgetID("bgt_pharmacy")
+
+	req := &financeapp.CreateExpenseRequest{
+		Amount:   8000,
+		Currency: "USD",
+		Splits: []*finance.TransactionSplit{
+			{BudgetID: &food, Amount: 6500, Note: "Groceries"},
+			{BudgetID: &pharmacy, Amount: 1500, Note: "Vitamins"},
+		},
+	}
+
+	if req.BudgetID != "" {
+		t.Errorf("expected no transaction-level budget, got %s", req.BudgetID)
+	}
+	if len(req.Splits) != 2 || *req.Splits[1].BudgetID != "bgt_pharmacy" {
+		t.Errorf("expected pharmacy split line")
+	}
+}
@@ -0,0 +1,272 @@
+package iam
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"time"
+
+	"github.com/masterkeysrd/saturn/internal/domain/identity"
+	"github.com/masterkeysrd/saturn/internal/platform/id"
+	"github.com/masterkeysrd/saturn/internal/platform/mail"
+)
+
+// AccountConfig holds the settings of the self-service password and email verification flows.
+type AccountConfig struct {
+	// From is the sender address of account emails.
+	From string
+	// PasswordResetURL is the web page that resets a password; the token is appended as a query parameter.
+	PasswordResetURL string
+	// VerifyEmailURL is the web page that verifies an email address; the token is appended as a query parameter.
+	VerifyEmailURL string
+	// RequireVerifiedEmail refuses to sign in users who have not verified their email address.
+	RequireVerifiedEmail bool
+}
+
+// RequestPasswordResetRequest is the input for mailing a password reset link.
+type RequestPasswordResetRequest struct {
+	Email     string
+	UserAgent string
+	IPAddress string
+}
+
+// ConfirmPasswordResetRequest sets a new password with the token of a reset link.
+type ConfirmPasswordResetRequest struct {
+	Token       string
+	NewPassword string
+	UserAgent   string
+	IPAddress   string
+}
+
+// ChangePasswordRequest is the input for a signed in user replacing their password.
+type ChangePasswordRequest struct {
+	UserID          string
+	CurrentPassword string
+	NewPassword     string
+	UserAgent       string
+	IPAddress       string
+}
+
+// ResendVerificationEmailRequest is the input for mailing a new email verification link.
+type ResendVerificationEmailRequest struct {
+	Email     string
+	UserAgent string
+	IPAddress string
+}
+
+// VerifyEmailRequest verifies an email address with the token of a verification link.
+type VerifyEmailRequest struct {
+	Token     string
+	UserAgent string
+	IPAddress string
+}
+
+// RequestPasswordReset mails a password reset link to the account with the email address. It
+// succeeds whether or not such an account exists so that accounts cannot be probed.
+func (c *Coordinator) RequestPasswordReset(ctx context.Context, req *RequestPasswordResetRequest) error {
+	now := time.Now()
+
+	user, err := c.identityService.GetUserByEmail(ctx, req.Email)
+	if err != nil || user == nil {
+		eventID, _ := id.Generate("evt_")
+		if err := c.identityService.CreateSecurityEvent(ctx, &identity.SecurityEvent{
+			ID:        eventID,
+			UserID:    nil,
+			Email:     req.Email,
+			EventType: identity.SecurityEventPasswordResetRequested,
+			IPAddress: req.IPAddress,
+			UserAgent: req.UserAgent,
+			CreatedAt: now,
+		}); err != nil {
+			slog.Error("failed to create security event", "error", err)
+		}
+		return nil
+	}
+
+	raw, err := c.identityService.IssueAccountToken(ctx, user, identity.AccountTokenPasswordReset, now)
+	if err != nil {
+		return err
+	}
+
+	// A delivery failure is logged rather than returned, which would tell the account exists
+	if err := c.sendAccountEmail(ctx, user, c.accounts.PasswordResetURL, raw,
+		"Reset your Saturn password",
+		"Someone, hopefully you, asked to reset the password of your Saturn account.\n\n"+
+			"Choose a new password: %s\n\n"+
+			"The link expires in %s and works once. If you did not ask for it, you can ignore this email.\n",
+		identity.PasswordResetTokenTTL); err != nil {
+		slog.ErrorContext(ctx, "failed to send password reset email", "user_id", user.ID, "err", err)
+	}
+
+	c.recordSecurityEvent(ctx, user, identity.SecurityEventPasswordResetRequested, req.UserAgent, req.IPAddress, now)
+	return nil
+}
+
+// ConfirmPasswordReset sets a new password with the token of a reset link. Every session of the
+// user is signed out and a lockout from failed logins is lifted.
+func (c *Coordinator) ConfirmPasswordReset(ctx context.Context, req *ConfirmPasswordResetRequest) error {
+	now := time.Now()
+
+	// Hash first so that a rejected password does not use up the link
+	encodedHash, err := c.passwordHasher.Hash(req.NewPassword)
+	if err != nil {
+		return fmt.Errorf("hash password: %w", err)
+	}
+
+	_, user, err := c.identityService.ConsumeAccountToken(ctx, identity.AccountTokenPasswordReset, req.Token, now)
+	if err != nil {
+		return err
+	}
+
+	if err := c.replacePassword(ctx, user, encodedHash); err != nil {
+		return err
+	}
+
+	// The link reached the user's mailbox, which proves they own the address
+	if err := c.identityService.MarkEmailVerified(ctx, user, now); err != nil {
+		slog.WarnContext(ctx, "failed to mark email verified on password reset", "user_id", user.ID, "err", err)
+	}
+	c.clearFailedAttempts(ctx, user)
+
+	c.recordSecurityEvent(ctx, user, identity.SecurityEventPasswordReset, req.UserAgent, req.IPAddress, now)
+	return nil
+}
+
+// ChangePassword replaces the password of a signed in user after checking the current one. Wrong
+// passwords count towards the account lockout. Every session, including the caller's, is signed out.
+func (c *Coordinator) ChangePassword(ctx context.Context, req *ChangePasswordRequest) error {
+	now := time.Now()
+
+	user, err := c.identityService.GetUserByID(ctx, identity.UserID(req.UserID))
+	if err != nil {
+		return err
+	}
+
+	if user.LockedUntil != nil && user.LockedUntil.After(now) {
+		c.recordSecurityEvent(ctx, user, identity.SecurityEventPasswordChangeFailed, req.UserAgent, req.IPAddress, now)
+		return ErrAccountLocked
+	}
+
+	if err := c.identityService.VerifyPassword(ctx, user.ID, req.CurrentPassword); err != nil {
+		if !errors.Is(err, identity.ErrIncorrectPassword) {
+			return err
+		}
+		if c.recordFailedAttempt(ctx, user, identity.SecurityEventPasswordChangeFailed, req.UserAgent, req.IPAddress, now) {
+			return ErrAccountLocked
+		}
+		return err
+	}
+
+	encodedHash, err := c.passwordHasher.Hash(req.NewPassword)
+	if err != nil {
+		return fmt.Errorf("hash password: %w", err)
+	}
+
+	if err := c.replacePassword(ctx, user, encodedHash); err != nil {
+		return err
+	}
+	c.clearFailedAttempts(ctx, user)
+
+	c.recordSecurityEvent(ctx, user, identity.SecurityEventPasswordChanged, req.UserAgent, req.IPAddress, now)
+	return nil
+}
+
+// ResendVerificationEmail mails a new verification link to an unverified account. Like
+// RequestPasswordReset it reveals nothing about whether the account exists.
+func (c *Coordinator) ResendVerificationEmail(ctx context.Context, req *ResendVerificationEmailRequest) error {
+	user, err := c.identityService.GetUserByEmail(ctx, req.Email)
+	if err != nil || user == nil || user.IsEmailVerified() {
+		return nil
+	}
+
+	if err := c.sendVerificationEmail(ctx, user, req.UserAgent, req.IPAddress, time.Now()); err != nil {
+		slog.ErrorContext(ctx, "failed to send verification email", "user_id", user.ID, "err", err)
+	}
+	return nil
+}
+
+// VerifyEmail marks the address a verification link was sent to as verified.
+func (c *Coordinator) VerifyEmail(ctx context.Context, req *VerifyEmailRequest) (*identity.User, error) {
+	now := time.Now()
+
+	_, user, err := c.identityService.ConsumeAccountToken(ctx, identity.AccountTokenEmailVerification, req.Token, now)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.identityService.MarkEmailVerified(ctx, user, now); err != nil {
+		return nil, err
+	}
+
+	c.recordSecurityEvent(ctx, user, identity.SecurityEventEmailVerified, req.UserAgent, req.IPAddress, now)
+	return user, nil
+}
+
+// replacePassword stores the new password hash and signs the user out everywhere by bumping their
+// auth version.
+func (c *Coordinator) replacePassword(ctx context.Context, user *identity.User, encodedHash string) error {
+	if err := c.identityService.SetPassword(ctx, user.ID, encodedHash); err != nil {
+		return fmt.Errorf("set password: %w", err)
+	}
+	if _, err := c.identityService.RevokeAllSessions(ctx, user.ID); err != nil {
+		return fmt.Errorf("revoke sessions: %w", err)
+	}
+	return nil
+}
+
+// sendVerificationEmail issues an email verification token and mails the link to the user.
+func (c *Coordinator) sendVerificationEmail(ctx context.Context, user *identity.User, userAgent, ipAddress string, now time.Time) error {
+	raw, err := c.identityService.IssueAccountToken(ctx, user, identity.AccountTokenEmailVerification, now)
+	if err != nil {
+		return err
+	}
+
+	if err := c.sendAccountEmail(ctx, user, c.accounts.VerifyEmailURL, raw,
+		"Verify your email address for Saturn",
+		"Confirm that this is the email address of your Saturn account: %s\n\n"+
+			"The link expires in %s. If you did not create an account, you can ignore this email.\n",
+		identity.EmailVerificationTokenTTL); err != nil {
+		return err
+	}
+
+	c.recordSecurityEvent(ctx, user, identity.SecurityEventEmailVerificationSent, userAgent, ipAddress, now)
+	return nil
+}
+
+// sendAccountEmail mails the user a link to pageURL carrying the raw token. The body format takes
+// the link and the validity of the token.
+func (c *Coordinator) sendAccountEmail(ctx context.Context, user *identity.User, pageURL, rawToken, subject, body string, ttl time.Duration) error {
+	link, err := accountLink(pageURL, rawToken)
+	if err != nil {
+		return err
+	}
+
+	return c.mailSender.Send(ctx, &mail.Message{
+		From:    c.accounts.From,
+		To:      user.Email,
+		Subject: subject,
+		Body:    fmt.Sprintf("Hi %s,\n\n", user.Name) + fmt.Sprintf(body, link, formatHours(ttl)),
+	})
+}
+
+// formatHours renders a whole number of hours for an email, e.g. "1 hour" or "48 hours".
+func formatHours(d time.Duration) string {
+	if hours := int(d.Hours()); hours != 1 {
+		return fmt.Sprintf("%d hours", hours)
+	}
+	return "1 hour"
+}
+
+// accountLink appends the token to the page URL.
+func accountLink(pageURL, rawToken string) (string, error) {
+	u, err := url.Parse(pageURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid account page URL: %w", err)
+	}
+	q := u.Query()
+	q.Set("token", rawToken)
+	u.RawQuery = q.Encode()
+	return u.String(), nil
+}
@@ -0,0 +1,231 @@
+package iam
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/masterkeysrd/saturn/internal/domain/identity"
+	"github.com/masterkeysrd/saturn/internal/platform/mail"
+	"github.com/masterkeysrd/saturn/internal/platform/password"
+)
+
+// recordingMailSender is a mail.Sender that keeps the sent messages.
+type recordingMailSender struct {
+	sent []*mail.Message
+}
+
+func (s *recordingMailSender) Send(ctx context.Context, msg *mail.Message) error {
+	s.sent = append(s.sent, msg)
+	return nil
+}
+
+// mailedToken extracts the token of the link in the last sent message.
+func (s *recordingMailSender) mailedToken(t *testing.T) string {
+	t.Helper()
+	if len(s.sent) == 0 {
+		t.Fatal("expected an email to be sent")
+	}
+	body := s.sent[len(s.sent)-1].Body
+	start := strings.Index(body, "http")
+	if start < 0 {
+		t.Fatalf("expected a link in the email, got %q", body)
+	}
+	link, err := url.Parse(strings.Fields(body[start:])[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	return link.Query().Get("token")
+}
+
+// accountIdentityService is an mfaIdentityService that also keeps account tokens and a password.
+type accountIdentityService struct {
+	*mfaIdentityService
+	tokens       map[string]identity.AccountTokenPurpose
+	password     string
+	passwordHash string
+}
+
+func (f *accountIdentityService) GetUserByEmail(ctx context.Context, email string) (*identity.User, error) {
+	if email != f.user.Email {
+		return nil, identity.ErrUserNotFound
+	}
+	return f.user, nil
+}
+
+func (f *accountIdentityService) IssueAccountToken(ctx context.Context, user *identity.User, purpose identity.AccountTokenPurpose, now time.Time) (string, error) {
+	raw, _, err := identity.GenerateAccountToken()
+	if err != nil {
+		return "", err
+	}
+	f.tokens[raw] = purpose
+	return raw, nil
+}
+
+func (f *accountIdentityService) ConsumeAccountToken(ctx context.Context, purpose identity.AccountTokenPurpose, raw string, now time.Time) (*identity.AccountToken, *identity.User, error) {
+	if f.tokens[raw] != purpose {
+		return nil, nil, identity.ErrInvalidAccountToken
+	}
+	delete(f.tokens, raw)
+	return &identity.AccountToken{UserID: f.user.ID, Purpose: purpose, Email: f.user.Email}, f.user, nil
+}
+
+func (f *accountIdentityService) VerifyPassword(ctx context.Context, userID identity.UserID, password string) error {
+	if password != f.password {
+		return identity.ErrIncorrectPassword
+	}
+	return nil
+}
+
+func (f *accountIdentityService) SetPassword(ctx context.Context, userID identity.UserID, encodedHash string) error {
+	f.passwordHash = encodedHash
+	return nil
+}
+
+func (f *accountIdentityService) MarkEmailVerified(ctx context.Context, user *identity.User, now time.Time) error {
+	user.EmailVerifyTime = &now
+	return nil
+}
+
+func (f *accountIdentityService) RevokeAllSessions(ctx context.Context, userID identity.UserID) (int64, error) {
+	f.authVersion++
+	return f.authVersion, nil
+}
+
+func newAccountCoordinator(t *testing.T, accounts AccountConfig) (*Coordinator, *accountIdentityService, *recordingMailSender) {
+	t.Helper()
+	_, mfa, tokens := newMFACoordinator(t)
+	svc := &accountIdentityService{
+		mfaIdentityService: mfa,
+		tokens:             map[string]identity.AccountTokenPurpose{},
+		password:           "securepassword123",
+	}
+	sender := &recordingMailSender{}
+	accounts.PasswordResetURL = "https://saturn.example.com/reset-password"
+	accounts.VerifyEmailURL = "https://saturn.example.com/verify-email"
+	return NewCoordinator(Dependencies{
+		IdentityService: svc,
+		PasswordHasher:  newTestHasher(password.DefaultParams()),
+		TokenService:    tokens,
+		MailSender:      sender,
+		Accounts:        accounts,
+	}), svc, sender
+}
+
+func TestPasswordResetSignsOutEverywhere(t *testing.T) {
+	coord, svc, sender := newAccountCoordinator(t, AccountConfig{})
+	ctx := context.Background()
+
+	if err := coord.RequestPasswordReset(ctx, &RequestPasswordResetRequest{Email: "jane@example.com"}); err != nil {
+		t.Fatalf("RequestPasswordReset: %v", err)
+	}
+	if len(sender.sent) != 1 || sender.sent[0].To != "jane@example.com" {
+		t.Fatalf("expected a reset email to jane@example.com, got %+v", sender.sent)
+	}
+	raw := sender.mailedToken(t)
+
+	if err := coord.ConfirmPasswordReset(ctx, &ConfirmPasswordResetRequest{Token: raw, NewPassword: "short"}); !errors.Is(err, password.ErrInvalidPassword) {
+		t.Fatalf("expected ErrInvalidPassword, got %v", err)
+	}
+	if err := coord.ConfirmPasswordReset(ctx, &ConfirmPasswordResetRequest{Token: raw, NewPassword: "anothersecurepassword"}); err != nil {
+		t.Fatalf("ConfirmPasswordReset: %v", err)
+	}
+	if !strings.HasPrefix(svc.passwordHash, "$argon2id$") {
+		t.Errorf("expected the new password to be hashed, got %q", svc.passwordHash)
+	}
+	if svc.authVersion != 2 {
+		t.Errorf("expected the auth version to be bumped, got %d", svc.authVersion)
+	}
+	if err := coord.ConfirmPasswordReset(ctx, &ConfirmPasswordResetRequest{Token: raw, NewPassword: "anothersecurepassword"}); !errors.Is(err, identity.ErrInvalidAccountToken) {
+		t.Fatalf("expected the link to work once, got %v", err)
+	}
+	if !slices.Equal(svc.events, []identity.SecurityEventType{identity.SecurityEventPasswordResetRequested, identity.SecurityEventPasswordReset}) {
+		t.Errorf("unexpected security events: %v", svc.events)
+	}
+}
+
+func TestRequestPasswordResetUnknownEmail(t *testing.T) {
+	coord, svc, sender := newAccountCoordinator(t, AccountConfig{})
+
+	if err := coord.RequestPasswordReset(context.Background(), &RequestPasswordResetRequest{Email: "nobody@example.com"}); err != nil {
+		t.Fatalf("expected unknown emails to succeed, got %v", err)
+	}
+	if len(sender.sent) != 0 {
+		t.Errorf("expected no email, got %+v", sender.sent)
+	}
+	if !slices.Equal(svc.events, []identity.SecurityEventType{identity.SecurityEventPasswordResetRequested}) {
+		t.Errorf("unexpected security events: %v", svc.events)
+	}
+}
+
+func TestChangePasswordChecksCurrentPassword(t *testing.T) {
+	coord, svc, _ := newAccountCoordinator(t, AccountConfig{})
+	ctx := context.Background()
+	userID := string(svc.user.ID)
+
+	for i := 1; i < maxFailedAttempts; i++ {
+		err := coord.ChangePassword(ctx, &ChangePasswordRequest{UserID: userID, CurrentPassword: "wrongpassword", NewPassword: "anothersecurepassword"})
+		if !errors.Is(err, identity.ErrIncorrectPassword) {
+			t.Fatalf("attempt %d: expected ErrIncorrectPassword, got %v", i, err)
+		}
+	}
+	if svc.passwordHash != "" || svc.authVersion != 1 {
+		t.Fatal("a wrong current password must not change the password")
+	}
+
+	if err := coord.ChangePassword(ctx, &ChangePasswordRequest{UserID: userID, CurrentPassword: "securepassword123", NewPassword: "anothersecurepassword"}); err != nil {
+		t.Fatalf("ChangePassword: %v", err)
+	}
+	if svc.passwordHash == "" || svc.authVersion != 2 {
+		t.Errorf("expected the password to change and the auth version to be bumped, got %d", svc.authVersion)
+	}
+	if !slices.Contains(svc.events, identity.SecurityEventPasswordChanged) {
+		t.Errorf("expected a password_changed event, got %v", svc.events)
+	}
+}
+
+func TestChangePasswordFailuresLockTheAccount(t *testing.T) {
+	coord, svc, _ := newAccountCoordinator(t, AccountConfig{})
+	ctx := context.Background()
+	userID := string(svc.user.ID)
+
+	for range maxFailedAttempts - 1 {
+		_ = coord.ChangePassword(ctx, &ChangePasswordRequest{UserID: userID, CurrentPassword: "wrongpassword", NewPassword: "anothersecurepassword"})
+	}
+	if err := coord.ChangePassword(ctx, &ChangePasswordRequest{UserID: userID, CurrentPassword: "wrongpassword", NewPassword: "anothersecurepassword"}); !errors.Is(err, ErrAccountLocked) {
+		t.Fatalf("expected ErrAccountLocked, got %v", err)
+	}
+	if err := coord.ChangePassword(ctx, &ChangePasswordRequest{UserID: userID, CurrentPassword: "securepassword123", NewPassword: "anothersecurepassword"}); !errors.Is(err, ErrAccountLocked) {
+		t.Fatalf("expected a locked account to reject the right password, got %v", err)
+	}
+}
+
+func TestLoginRequiresVerifiedEmail(t *testing.T) {
+	coord, svc, sender := newAccountCoordinator(t, AccountConfig{RequireVerifiedEmail: true})
+	ctx := context.Background()
+
+	if _, err := coord.Login(ctx, &LoginRequest{Identifier: "jane@example.com", Password: "securepassword123"}); !errors.Is(err, identity.ErrEmailNotVerified) {
+		t.Fatalf("expected ErrEmailNotVerified, got %v", err)
+	}
+
+	if err := coord.ResendVerificationEmail(ctx, &ResendVerificationEmailRequest{Email: "jane@example.com"}); err != nil {
+		t.Fatalf("ResendVerificationEmail: %v", err)
+	}
+	if _, err := coord.VerifyEmail(ctx, &VerifyEmailRequest{Token: sender.mailedToken(t)}); err != nil {
+		t.Fatalf("VerifyEmail: %v", err)
+	}
+	if !svc.user.IsEmailVerified() {
+		t.Fatal("expected the email to be verified")
+	}
+
+	if _, err := coord.Login(ctx, &LoginRequest{Identifier: "jane@example.com", Password: "securepassword123"}); err != nil {
+		t.Fatalf("Login: %v", err)
+	}
+	if !slices.Contains(svc.events, identity.SecurityEventEmailVerificationSent) || !slices.Contains(svc.events, identity.SecurityEventEmailVerified) {
+		t.Errorf("expected verification events, got %v", svc.events)
+	}
+}
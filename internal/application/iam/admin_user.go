@@ -51,16 +51,18 @@ func (c *Coordinator) AdminCreateUser(ctx context.Context, req *AdminCreateUserR
 		status = identity.UserStatusActive
 	}
 
-	// 4. Create user
+	// 4. Create user. The administrator vouches for the email address.
+	now := time.Now()
 	user := &identity.User{
-		ID:          userID,
-		Email:       req.Email,
-		Username:    req.Username,
-		Name:        req.Name,
-		Status:      status,
-		AccessLevel: req.AccessLevel,
-		CreateTime:  time.Now(),
-		UpdateTime:  time.Now(),
+		ID:              userID,
+		Email:           req.Email,
+		Username:        req.Username,
+		Name:            req.Name,
+		Status:          status,
+		AccessLevel:     req.AccessLevel,
+		EmailVerifyTime: &now,
+		CreateTime:      now,
+		UpdateTime:      now,
 	}
 
 	if err := c.identityService.CreateUser(ctx, user); err != nil {
@@ -70,7 +72,7 @@ func (c *Coordinator) AdminCreateUser(ctx context.Context, req *AdminCreateUserR
 	// 5. Create credential with hashed password
 	credential := &identity.Credential{
 		UserID:     userID,
-		AuthType:   "password",
+		AuthType:   identity.AuthTypePassword,
 		SecretData: encodedHash,
 	}
 
@@ -2,9 +2,11 @@ package iam
 
 import (
 	"context"
+	"time"
 
 	"github.com/masterkeysrd/saturn/internal/domain/identity"
 	"github.com/masterkeysrd/saturn/internal/domain/space"
+	"github.com/masterkeysrd/saturn/internal/platform/mail"
 	"github.com/masterkeysrd/saturn/internal/platform/password"
 	"github.com/masterkeysrd/saturn/internal/platform/token"
 )
@@ -15,6 +17,8 @@ type Dependencies struct {
 	PasswordHasher  password.Hasher
 	SpaceService    SpaceService
 	TokenService    token.Service
+	MailSender      mail.Sender
+	Accounts        AccountConfig
 }
 
 // Coordinator orchestrates identity operations across multiple services.
@@ -23,6 +27,8 @@ type Coordinator struct {
 	passwordHasher  password.Hasher
 	spaceService    SpaceService
 	tokenService    token.Service
+	mailSender      mail.Sender
+	accounts        AccountConfig
 }
 
 // NewCoordinator creates a new Coordinator.
@@ -32,6 +38,8 @@ func NewCoordinator(deps Dependencies) *Coordinator {
 		passwordHasher:  deps.PasswordHasher,
 		spaceService:    deps.SpaceService,
 		tokenService:    deps.TokenService,
+		mailSender:      deps.MailSender,
+		accounts:        deps.Accounts,
 	}
 }
 
@@ -81,9 +89,31 @@ type IdentityService interface {
 	UpdateLockoutState(ctx context.Context, req identity.UpdateLockoutRequest) error
 	CreateSecurityEvent(ctx context.Context, event *identity.SecurityEvent) error
 	ListSecurityEvents(ctx context.Context, filter identity.SecurityEventFilter) ([]*identity.SecurityEvent, string, error)
+	GetMFAStatus(ctx context.Context, userID identity.UserID) (*identity.MFAStatus, error)
+	EnrollTOTP(ctx context.Context, user *identity.User) (*identity.TOTPEnrollment, error)
+	ConfirmTOTP(ctx context.Context, userID identity.UserID, code string, now time.Time) ([]string, error)
+	VerifyTOTP(ctx context.Context, userID identity.UserID, code string, now time.Time) error
+	UseRecoveryCode(ctx context.Context, userID identity.UserID, code string, now time.Time) error
+	RegenerateRecoveryCodes(ctx context.Context, userID identity.UserID) ([]string, error)
+	DisableMFA(ctx context.Context, userID identity.UserID) error
+	BeginPasskeyRegistration(ctx context.Context, user *identity.User, kind identity.PasskeyCeremonyKind, now time.Time) (*identity.PasskeyChallenge, error)
+	FinishPasskeyRegistration(ctx context.Context, req *identity.FinishPasskeyRegistrationRequest, now time.Time) (*identity.Passkey, error)
+	CreatePasskey(ctx context.Context, passkey *identity.Passkey) error
+	BeginPasskeyLogin(ctx context.Context, user *identity.User, now time.Time) (*identity.PasskeyChallenge, error)
+	FinishPasskeyLogin(ctx context.Context, ceremonyID string, response []byte, now time.Time) (*identity.User, error)
+	ListPasskeys(ctx context.Context, userID identity.UserID) ([]*identity.Passkey, error)
+	RenamePasskey(ctx context.Context, userID identity.UserID, passkeyID identity.PasskeyID, name string) (*identity.Passkey, error)
+	DeletePasskey(ctx context.Context, userID identity.UserID, passkeyID identity.PasskeyID) (*identity.Passkey, error)
+	IssueAccountToken(ctx context.Context, user *identity.User, purpose identity.AccountTokenPurpose, now time.Time) (string, error)
+	ConsumeAccountToken(ctx context.Context, purpose identity.AccountTokenPurpose, raw string, now time.Time) (*identity.AccountToken, *identity.User, error)
+	VerifyPassword(ctx context.Context, userID identity.UserID, password string) error
+	SetPassword(ctx context.Context, userID identity.UserID, encodedHash string) error
+	MarkEmailVerified(ctx context.Context, user *identity.User, now time.Time) error
 }
 
 // SpaceService defines the interface for space operations required by IAM application.
 type SpaceService interface {
 	CreateSpace(ctx context.Context, space *space.Space) (*space.Space, error)
+	GetPendingInvitation(ctx context.Context, rawToken string) (*space.Invitation, error)
+	AcceptInvitation(ctx context.Context, userID space.SpaceID, email string, rawToken string) (*space.Member, error)
 }
@@ -22,14 +22,26 @@ type LoginRequest struct {
 }
 
 // LoginResponse represents the application output after successful user authentication.
+// When MFARequired is set no session was created; the client must present MFAToken and a
+// second factor to LoginMFA instead.
 type LoginResponse struct {
 	User                  *identity.User
 	AccessToken           string
 	AccessTokenExpiresAt  int64
 	RefreshToken          string
 	RefreshTokenExpiresAt int64
+
+	MFARequired       bool
+	MFAToken          string
+	MFATokenExpiresAt int64
 }
 
+// maxFailedAttempts is the number of failed password or MFA attempts that locks an account.
+const maxFailedAttempts = 5
+
+// ErrAccountLocked is returned while a user is locked out after repeated failed attempts.
+var ErrAccountLocked = errors.New("account is temporarily locked due to too many failed login attempts; please try again later")
+
 // Login authenticates credentials, issues access/refresh tokens, and persists the session.
 func (c *Coordinator) Login(ctx context.Context, req *LoginRequest) (*LoginResponse, error) {
 	now := time.Now()
@@ -72,7 +84,7 @@ func (c *Coordinator) Login(ctx context.Context, req *LoginRequest) (*LoginRespo
 		}); err != nil {
 			slog.Error("failed to create security event", "error", err)
 		}
-		return nil, errors.New("account is temporarily locked due to too many failed login attempts; please try again later")
+		return nil, ErrAccountLocked
 	}
 
 	// 3. Authenticate
@@ -89,7 +101,7 @@ func (c *Coordinator) Login(ctx context.Context, req *LoginRequest) (*LoginRespo
 		var lockedUntil *time.Time
 		var eventType = identity.SecurityEventLoginFailed
 
-		if attempts >= 5 {
+		if attempts >= maxFailedAttempts {
 			lockTime := now.Add(15 * time.Minute)
 			lockedUntil = &lockTime
 			eventType = identity.SecurityEventAccountLocked
@@ -114,42 +126,43 @@ func (c *Coordinator) Login(ctx context.Context, req *LoginRequest) (*LoginRespo
 			slog.Error("failed to create security event", "error", err)
 		}
 
-		if attempts >= 5 {
-			return nil, errors.New("account is temporarily locked due to too many failed login attempts; please try again later")
+		if attempts >= maxFailedAttempts {
+			return nil, ErrAccountLocked
 		}
 		return nil, errors.New("invalid credentials")
 	}
 
-	// 4. On successful login, reset failed attempts & write success audit
-	if user.FailedLoginAttempts > 0 || user.LockedUntil != nil {
-		_ = c.identityService.UpdateLockoutState(ctx, identity.UpdateLockoutRequest{
-			UserID:      user.ID,
-			Attempts:    0,
-			LockedUntil: nil,
-		})
+	// 4. When the policy requires it, the email address must be verified before signing in
+	if c.accounts.RequireVerifiedEmail && !authUser.IsEmailVerified() {
+		return nil, identity.ErrEmailNotVerified
 	}
 
-	eventID, _ := id.Generate("evt_")
-	if err := c.identityService.CreateSecurityEvent(ctx, &identity.SecurityEvent{
-		ID:        eventID,
-		UserID:    &user.ID,
-		Email:     user.Email,
-		EventType: identity.SecurityEventLoginSuccess,
-		IPAddress: req.IPAddress,
-		UserAgent: req.UserAgent,
-		CreatedAt: now,
-	}); err != nil {
-		slog.Error("failed to create security event", "error", err)
+	// 5. With a second factor enrolled, the password only earns a short-lived challenge
+	mfa, err := c.identityService.GetMFAStatus(ctx, authUser.ID)
+	if err != nil {
+		return nil, fmt.Errorf("get mfa status: %w", err)
 	}
+	if mfa.Enabled {
+		return c.issueMFAChallenge(ctx, authUser, now)
+	}
+
+	return c.completeLogin(ctx, user, req.UserAgent, req.IPAddress, now)
+}
 
-	authVersion, err := c.identityService.GetAuthVersion(ctx, authUser.ID)
+// completeLogin resets failed attempts, writes the success audit, and issues the session tokens.
+func (c *Coordinator) completeLogin(ctx context.Context, user *identity.User, userAgent, ipAddress string, now time.Time) (*LoginResponse, error) {
+	c.clearFailedAttempts(ctx, user)
+
+	c.recordSecurityEvent(ctx, user, identity.SecurityEventLoginSuccess, userAgent, ipAddress, now)
+
+	authVersion, err := c.identityService.GetAuthVersion(ctx, user.ID)
 	if err != nil {
 		return nil, fmt.Errorf("get auth version: %w", err)
 	}
 
 	accessToken, _, err := c.tokenService.IssueAccessToken(token.IssueInput{
-		Subject:     string(authUser.ID),
-		AccessLevel: string(authUser.AccessLevel),
+		Subject:     string(user.ID),
+		AccessLevel: string(user.AccessLevel),
 		AuthVersion: authVersion,
 	}, now)
 	if err != nil {
@@ -158,8 +171,8 @@ func (c *Coordinator) Login(ctx context.Context, req *LoginRequest) (*LoginRespo
 
 	// Session refresh token absolute expiry is 7 days, sliding window is 24 hours
 	refreshToken, _, err := c.tokenService.IssueRefreshToken(token.IssueInput{
-		Subject:     string(authUser.ID),
-		AccessLevel: string(authUser.AccessLevel),
+		Subject:     string(user.ID),
+		AccessLevel: string(user.AccessLevel),
 		AuthVersion: authVersion,
 	}, now, now.Add(7*24*time.Hour))
 	if err != nil {
@@ -169,10 +182,10 @@ func (c *Coordinator) Login(ctx context.Context, req *LoginRequest) (*LoginRespo
 	refreshTokenHash := hash.SHA256String(refreshToken)
 
 	if _, err := c.identityService.CreateSession(ctx, &identity.CreateSessionRequest{
-		UserID:            authUser.ID,
+		UserID:            user.ID,
 		RefreshTokenHash:  refreshTokenHash,
-		UserAgent:         req.UserAgent,
-		IPAddress:         req.IPAddress,
+		UserAgent:         userAgent,
+		IPAddress:         ipAddress,
 		ExpiresAt:         now.Add(24 * time.Hour),
 		AbsoluteExpiresAt: now.Add(7 * 24 * time.Hour),
 	}); err != nil {
@@ -180,10 +193,61 @@ func (c *Coordinator) Login(ctx context.Context, req *LoginRequest) (*LoginRespo
 	}
 
 	return &LoginResponse{
-		User:                  authUser,
+		User:                  user,
 		AccessToken:           accessToken,
 		AccessTokenExpiresAt:  now.Add(15 * time.Minute).Unix(),
 		RefreshToken:          refreshToken,
 		RefreshTokenExpiresAt: now.Add(24 * time.Hour).Unix(),
 	}, nil
 }
+
+// clearFailedAttempts resets the failed attempt counter and lifts any lockout of the user.
+func (c *Coordinator) clearFailedAttempts(ctx context.Context, user *identity.User) {
+	if user.FailedLoginAttempts > 0 || user.LockedUntil != nil {
+		_ = c.identityService.UpdateLockoutState(ctx, identity.UpdateLockoutRequest{
+			UserID:      user.ID,
+			Attempts:    0,
+			LockedUntil: nil,
+		})
+	}
+}
+
+// recordFailedAttempt counts a wrong password or second factor towards the account lockout and
+// records eventType. It reports whether the attempt locked the account.
+func (c *Coordinator) recordFailedAttempt(ctx context.Context, user *identity.User, eventType identity.SecurityEventType, userAgent, ipAddress string, now time.Time) bool {
+	attempts := user.FailedLoginAttempts + 1
+	var lockedUntil *time.Time
+	if attempts >= maxFailedAttempts {
+		lockTime := now.Add(15 * time.Minute)
+		lockedUntil = &lockTime
+	}
+	_ = c.identityService.UpdateLockoutState(ctx, identity.UpdateLockoutRequest{
+		UserID:      user.ID,
+		Attempts:    attempts,
+		LockedUntil: lockedUntil,
+	})
+
+	c.recordSecurityEvent(ctx, user, eventType, userAgent, ipAddress, now)
+	if lockedUntil != nil {
+		c.recordSecurityEvent(ctx, user, identity.SecurityEventAccountLocked, userAgent, ipAddress, now)
+		return true
+	}
+	return false
+}
+
+// recordSecurityEvent writes an audit event for user. Failures are logged, never returned, so that
+// auditing cannot block authentication.
+func (c *Coordinator) recordSecurityEvent(ctx context.Context, user *identity.User, eventType identity.SecurityEventType, userAgent, ipAddress string, now time.Time) {
+	eventID, _ := id.Generate("evt_")
+	if err := c.identityService.CreateSecurityEvent(ctx, &identity.SecurityEvent{
+		ID:        eventID,
+		UserID:    &user.ID,
+		Email:     user.Email,
+		EventType: eventType,
+		IPAddress: ipAddress,
+		UserAgent: userAgent,
+		CreatedAt: now,
+	}); err != nil {
+		slog.Error("failed to create security event", "error", err)
+	}
+}
@@ -0,0 +1,163 @@
+package iam
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/masterkeysrd/saturn/internal/domain/identity"
+	"github.com/masterkeysrd/saturn/internal/platform/token"
+)
+
+// mfaIdentityService is a fakeIdentityService for a single user with TOTP enabled.
+type mfaIdentityService struct {
+	*fakeIdentityService
+	user        *identity.User
+	validCode   string
+	authVersion int64
+	sessions    int
+	events      []identity.SecurityEventType
+}
+
+func newMFAIdentityService() *mfaIdentityService {
+	return &mfaIdentityService{
+		fakeIdentityService: newFakeIdentityService(),
+		user: &identity.User{
+			ID:          "usr_2NzN5q3y1pUQvU1ZQ9t2tq8xKxF",
+			Email:       "jane@example.com",
+			Status:      identity.UserStatusActive,
+			AccessLevel: identity.AccessLevelUser,
+		},
+		validCode:   "123456",
+		authVersion: 1,
+	}
+}
+
+func (f *mfaIdentityService) GetUserByEmail(ctx context.Context, email string) (*identity.User, error) {
+	return f.user, nil
+}
+
+func (f *mfaIdentityService) GetUserByID(ctx context.Context, id identity.UserID) (*identity.User, error) {
+	return f.user, nil
+}
+
+func (f *mfaIdentityService) Authenticate(ctx context.Context, identifier string, password string) (*identity.User, error) {
+	return f.user, nil
+}
+
+func (f *mfaIdentityService) GetAuthVersion(ctx context.Context, id identity.UserID) (int64, error) {
+	return f.authVersion, nil
+}
+
+func (f *mfaIdentityService) GetMFAStatus(ctx context.Context, userID identity.UserID) (*identity.MFAStatus, error) {
+	return &identity.MFAStatus{Enabled: true}, nil
+}
+
+func (f *mfaIdentityService) VerifyTOTP(ctx context.Context, userID identity.UserID, code string, now time.Time) error {
+	if code != f.validCode {
+		return identity.ErrInvalidMFACode
+	}
+	return nil
+}
+
+func (f *mfaIdentityService) UpdateLockoutState(ctx context.Context, req identity.UpdateLockoutRequest) error {
+	f.user.FailedLoginAttempts = req.Attempts
+	f.user.LockedUntil = req.LockedUntil
+	return nil
+}
+
+func (f *mfaIdentityService) CreateSession(ctx context.Context, req *identity.CreateSessionRequest) (*identity.Session, error) {
+	f.sessions++
+	return &identity.Session{UserID: req.UserID}, nil
+}
+
+func (f *mfaIdentityService) CreateSecurityEvent(ctx context.Context, event *identity.SecurityEvent) error {
+	f.events = append(f.events, event.EventType)
+	return nil
+}
+
+func newMFACoordinator(t *testing.T) (*Coordinator, *mfaIdentityService, token.Service) {
+	t.Helper()
+	tokens, err := token.NewTestService()
+	if err != nil {
+		t.Fatal(err)
+	}
+	svc := newMFAIdentityService()
+	return NewCoordinator(Dependencies{IdentityService: svc, TokenService: tokens}), svc, tokens
+}
+
+func TestLoginRequiresSecondFactor(t *testing.T) {
+	coord, svc, tokens := newMFACoordinator(t)
+	ctx := context.Background()
+
+	first, err := coord.Login(ctx, &LoginRequest{Identifier: "jane@example.com", Password: "securepassword123"})
+	if err != nil {
+		t.Fatalf("Login: %v", err)
+	}
+	if !first.MFARequired || first.MFAToken == "" || first.AccessToken != "" || first.RefreshToken != "" {
+		t.Fatalf("expected an MFA challenge and no tokens, got %+v", first)
+	}
+	if svc.sessions != 0 {
+		t.Fatal("no session must be created before the second factor")
+	}
+	if _, err := tokens.ValidateAccessToken(first.MFAToken, time.Now()); err == nil {
+		t.Fatal("the MFA challenge token must not be accepted as an access token")
+	}
+
+	second, err := coord.LoginMFA(ctx, &LoginMFARequest{MFAToken: first.MFAToken, Code: "123456"})
+	if err != nil {
+		t.Fatalf("LoginMFA: %v", err)
+	}
+	if second.AccessToken == "" || second.RefreshToken == "" || svc.sessions != 1 {
+		t.Fatalf("expected a session after the second factor, got %+v", second)
+	}
+	if !slices.Equal(svc.events, []identity.SecurityEventType{identity.SecurityEventMFASuccess, identity.SecurityEventLoginSuccess}) {
+		t.Errorf("unexpected security events: %v", svc.events)
+	}
+}
+
+func TestLoginMFAFailuresLockTheAccount(t *testing.T) {
+	coord, svc, _ := newMFACoordinator(t)
+	ctx := context.Background()
+
+	first, err := coord.Login(ctx, &LoginRequest{Identifier: "jane@example.com", Password: "securepassword123"})
+	if err != nil {
+		t.Fatalf("Login: %v", err)
+	}
+
+	for i := 1; i < maxFailedAttempts; i++ {
+		if _, err := coord.LoginMFA(ctx, &LoginMFARequest{MFAToken: first.MFAToken, Code: "000000"}); !errors.Is(err, identity.ErrInvalidMFACode) {
+			t.Fatalf("attempt %d: expected ErrInvalidMFACode, got %v", i, err)
+		}
+	}
+	if _, err := coord.LoginMFA(ctx, &LoginMFARequest{MFAToken: first.MFAToken, Code: "000000"}); !errors.Is(err, ErrAccountLocked) {
+		t.Fatalf("expected ErrAccountLocked, got %v", err)
+	}
+	if _, err := coord.LoginMFA(ctx, &LoginMFARequest{MFAToken: first.MFAToken, Code: "123456"}); !errors.Is(err, ErrAccountLocked) {
+		t.Fatalf("expected a locked account to reject a valid code, got %v", err)
+	}
+	if svc.sessions != 0 {
+		t.Error("no session must be created for failed challenges")
+	}
+}
+
+func TestLoginMFARejectsStaleChallenge(t *testing.T) {
+	coord, svc, _ := newMFACoordinator(t)
+	ctx := context.Background()
+
+	first, err := coord.Login(ctx, &LoginRequest{Identifier: "jane@example.com", Password: "securepassword123"})
+	if err != nil {
+		t.Fatalf("Login: %v", err)
+	}
+
+	// Revoking sessions or resetting MFA bumps the auth version
+	svc.authVersion++
+	if _, err := coord.LoginMFA(ctx, &LoginMFARequest{MFAToken: first.MFAToken, Code: "123456"}); !errors.Is(err, identity.ErrMFAChallengeExpired) {
+		t.Fatalf("expected ErrMFAChallengeExpired, got %v", err)
+	}
+	if _, err := coord.LoginMFA(ctx, &LoginMFARequest{MFAToken: "garbage", Code: "123456"}); !errors.Is(err, identity.ErrMFAChallengeExpired) {
+		t.Fatalf("expected ErrMFAChallengeExpired, got %v", err)
+	}
+}
@@ -0,0 +1,261 @@
+package iam
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/masterkeysrd/saturn/internal/domain/identity"
+	"github.com/masterkeysrd/saturn/internal/platform/token"
+)
+
+// LoginMFARequest completes a login that returned MFARequired by presenting either a TOTP code or
+// a recovery code.
+type LoginMFARequest struct {
+	MFAToken     string
+	Code         string
+	RecoveryCode string
+	UserAgent    string
+	IPAddress    string
+}
+
+// EnrollTOTPRequest is the input for starting TOTP enrollment.
+type EnrollTOTPRequest struct {
+	UserID string
+}
+
+// ConfirmTOTPRequest is the input for completing TOTP enrollment.
+type ConfirmTOTPRequest struct {
+	UserID    string
+	Code      string
+	UserAgent string
+	IPAddress string
+}
+
+// RecoveryCodesResponse carries newly issued recovery codes. They are shown to the user once.
+type RecoveryCodesResponse struct {
+	RecoveryCodes []string
+}
+
+// SecondFactorRequest identifies the user and proves possession of their second factor, for
+// operations that change it.
+type SecondFactorRequest struct {
+	UserID       string
+	Code         string
+	RecoveryCode string
+	UserAgent    string
+	IPAddress    string
+}
+
+// ResetUserMFARequest is the input for an administrator removing a user's second factor.
+type ResetUserMFARequest struct {
+	UserID    string
+	UserAgent string
+	IPAddress string
+}
+
+// issueMFAChallenge returns the first half of a two-step login.
+func (c *Coordinator) issueMFAChallenge(ctx context.Context, user *identity.User, now time.Time) (*LoginResponse, error) {
+	authVersion, err := c.identityService.GetAuthVersion(ctx, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("get auth version: %w", err)
+	}
+
+	mfaToken, expiresAt, err := c.tokenService.IssueMFAChallengeToken(token.IssueInput{
+		Subject:     string(user.ID),
+		AccessLevel: string(user.AccessLevel),
+		AuthVersion: authVersion,
+	}, now)
+	if err != nil {
+		return nil, fmt.Errorf("issue mfa challenge token: %w", err)
+	}
+
+	return &LoginResponse{
+		User:              user,
+		MFARequired:       true,
+		MFAToken:          mfaToken,
+		MFATokenExpiresAt: expiresAt.Unix(),
+	}, nil
+}
+
+// LoginMFA verifies the second factor of a login challenge and issues the session tokens.
+func (c *Coordinator) LoginMFA(ctx context.Context, req *LoginMFARequest) (*LoginResponse, error) {
+	now := time.Now()
+
+	claims, err := c.tokenService.ValidateMFAChallengeToken(req.MFAToken, now)
+	if err != nil {
+		return nil, identity.ErrMFAChallengeExpired
+	}
+
+	user, err := c.identityService.GetUserByID(ctx, identity.UserID(claims.Subject))
+	if err != nil {
+		return nil, identity.ErrMFAChallengeExpired
+	}
+
+	// A challenge issued before sessions were revoked or MFA was reset is stale
+	authVersion, err := c.identityService.GetAuthVersion(ctx, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("get auth version: %w", err)
+	}
+	if claims.AuthVersion != authVersion {
+		return nil, identity.ErrMFAChallengeExpired
+	}
+
+	switch user.Status {
+	case identity.UserStatusSuspended:
+		return nil, identity.ErrAccountSuspended
+	case identity.UserStatusInactive:
+		return nil, identity.ErrAccountInactive
+	}
+
+	eventType, err := c.verifySecondFactor(ctx, user, req.Code, req.RecoveryCode, req.UserAgent, req.IPAddress, now)
+	if err != nil {
+		return nil, err
+	}
+	c.recordSecurityEvent(ctx, user, eventType, req.UserAgent, req.IPAddress, now)
+
+	return c.completeLogin(ctx, user, req.UserAgent, req.IPAddress, now)
+}
+
+// GetMFAStatus reports the second factors of the user.
+func (c *Coordinator) GetMFAStatus(ctx context.Context, userID identity.UserID) (*identity.MFAStatus, error) {
+	return c.identityService.GetMFAStatus(ctx, userID)
+}
+
+// EnrollTOTP starts TOTP enrollment and returns the secret to load into an authenticator app.
+// MFA is not enforced until the enrollment is confirmed with ConfirmTOTP.
+func (c *Coordinator) EnrollTOTP(ctx context.Context, req *EnrollTOTPRequest) (*identity.TOTPEnrollment, error) {
+	user, err := c.identityService.GetUserByID(ctx, identity.UserID(req.UserID))
+	if err != nil {
+		return nil, err
+	}
+
+	enrollment, err := c.identityService.EnrollTOTP(ctx, user)
+	if err != nil {
+		return nil, fmt.Errorf("enroll totp: %w", err)
+	}
+	return enrollment, nil
+}
+
+// ConfirmTOTP verifies the first code from the authenticator app, enables MFA, and returns the
+// recovery codes.
+func (c *Coordinator) ConfirmTOTP(ctx context.Context, req *ConfirmTOTPRequest) (*RecoveryCodesResponse, error) {
+	now := time.Now()
+
+	user, err := c.identityService.GetUserByID(ctx, identity.UserID(req.UserID))
+	if err != nil {
+		return nil, err
+	}
+
+	codes, err := c.identityService.ConfirmTOTP(ctx, user.ID, req.Code, now)
+	if err != nil {
+		return nil, fmt.Errorf("confirm totp: %w", err)
+	}
+
+	c.recordSecurityEvent(ctx, user, identity.SecurityEventMFAEnrolled, req.UserAgent, req.IPAddress, now)
+	return &RecoveryCodesResponse{RecoveryCodes: codes}, nil
+}
+
+// RegenerateRecoveryCodes replaces the user's recovery codes after checking their second factor.
+func (c *Coordinator) RegenerateRecoveryCodes(ctx context.Context, req *SecondFactorRequest) (*RecoveryCodesResponse, error) {
+	now := time.Now()
+
+	user, err := c.stepUp(ctx, req, now)
+	if err != nil {
+		return nil, err
+	}
+
+	codes, err := c.identityService.RegenerateRecoveryCodes(ctx, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("regenerate recovery codes: %w", err)
+	}
+
+	c.recordSecurityEvent(ctx, user, identity.SecurityEventRecoveryCodesIssued, req.UserAgent, req.IPAddress, now)
+	return &RecoveryCodesResponse{RecoveryCodes: codes}, nil
+}
+
+// DisableMFA removes the user's second factor after checking it.
+func (c *Coordinator) DisableMFA(ctx context.Context, req *SecondFactorRequest) error {
+	now := time.Now()
+
+	user, err := c.stepUp(ctx, req, now)
+	if err != nil {
+		return err
+	}
+
+	if err := c.identityService.DisableMFA(ctx, user.ID); err != nil {
+		return fmt.Errorf("disable mfa: %w", err)
+	}
+
+	c.recordSecurityEvent(ctx, user, identity.SecurityEventMFADisabled, req.UserAgent, req.IPAddress, now)
+	return nil
+}
+
+// ResetUserMFA lets an administrator remove the second factor of a user who lost it. The user is
+// signed out everywhere, which also invalidates pending login challenges.
+func (c *Coordinator) ResetUserMFA(ctx context.Context, req *ResetUserMFARequest) error {
+	now := time.Now()
+
+	user, err := c.identityService.GetUserByID(ctx, identity.UserID(req.UserID))
+	if err != nil {
+		return err
+	}
+
+	if err := c.identityService.DisableMFA(ctx, user.ID); err != nil {
+		return fmt.Errorf("reset mfa: %w", err)
+	}
+	if _, err := c.identityService.RevokeAllSessions(ctx, user.ID); err != nil {
+		return fmt.Errorf("revoke sessions: %w", err)
+	}
+
+	c.recordSecurityEvent(ctx, user, identity.SecurityEventMFAReset, req.UserAgent, req.IPAddress, now)
+	return nil
+}
+
+// stepUp loads the user and checks their second factor before a change to it.
+func (c *Coordinator) stepUp(ctx context.Context, req *SecondFactorRequest, now time.Time) (*identity.User, error) {
+	user, err := c.identityService.GetUserByID(ctx, identity.UserID(req.UserID))
+	if err != nil {
+		return nil, err
+	}
+
+	eventType, err := c.verifySecondFactor(ctx, user, req.Code, req.RecoveryCode, req.UserAgent, req.IPAddress, now)
+	if err != nil {
+		return nil, err
+	}
+	if eventType == identity.SecurityEventRecoveryCodeUsed {
+		c.recordSecurityEvent(ctx, user, eventType, req.UserAgent, req.IPAddress, now)
+	}
+	return user, nil
+}
+
+// verifySecondFactor checks a TOTP code, or a recovery code when one is given, and returns the
+// event type to record on success. Wrong codes count towards the account lockout like wrong
+// passwords do.
+func (c *Coordinator) verifySecondFactor(ctx context.Context, user *identity.User, code, recoveryCode, userAgent, ipAddress string, now time.Time) (identity.SecurityEventType, error) {
+	if user.LockedUntil != nil && user.LockedUntil.After(now) {
+		c.recordSecurityEvent(ctx, user, identity.SecurityEventMFAFailed, userAgent, ipAddress, now)
+		return "", ErrAccountLocked
+	}
+
+	eventType := identity.SecurityEventMFASuccess
+	var err error
+	if recoveryCode != "" {
+		eventType = identity.SecurityEventRecoveryCodeUsed
+		err = c.identityService.UseRecoveryCode(ctx, user.ID, recoveryCode, now)
+	} else {
+		err = c.identityService.VerifyTOTP(ctx, user.ID, code, now)
+	}
+	if err == nil {
+		return eventType, nil
+	}
+	if !errors.Is(err, identity.ErrInvalidMFACode) && !errors.Is(err, identity.ErrInvalidRecoveryCode) {
+		return "", err
+	}
+
+	if c.recordFailedAttempt(ctx, user, identity.SecurityEventMFAFailed, userAgent, ipAddress, now) {
+		return "", ErrAccountLocked
+	}
+	return "", err
+}
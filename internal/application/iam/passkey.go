@@ -0,0 +1,185 @@
+package iam
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/masterkeysrd/saturn/internal/domain/identity"
+)
+
+// BeginPasskeyRegistrationRequest is the input for adding a passkey to the signed in user.
+type BeginPasskeyRegistrationRequest struct {
+	UserID string
+}
+
+// FinishPasskeyRegistrationRequest completes adding a passkey.
+type FinishPasskeyRegistrationRequest struct {
+	UserID     string
+	CeremonyID string
+	Name       string
+	// Credential is the JSON serialized PublicKeyCredential returned by navigator.credentials.create.
+	Credential string
+	UserAgent  string
+	IPAddress  string
+}
+
+// BeginPasskeySignupRequest is the input for starting a registration without a password. The
+// account is created by Register with the resulting ceremony.
+type BeginPasskeySignupRequest struct {
+	Email    string
+	Username string
+	Name     string
+}
+
+// BeginPasskeyLoginRequest is the input for starting a passkey login. Without an identifier the
+// browser offers every passkey it holds for the site.
+type BeginPasskeyLoginRequest struct {
+	Identifier string
+}
+
+// LoginPasskeyRequest completes a passkey login.
+type LoginPasskeyRequest struct {
+	CeremonyID string
+	// Credential is the JSON serialized PublicKeyCredential returned by navigator.credentials.get.
+	Credential string
+	UserAgent  string
+	IPAddress  string
+}
+
+// RenamePasskeyRequest is the input for renaming a passkey.
+type RenamePasskeyRequest struct {
+	UserID    string
+	PasskeyID string
+	Name      string
+}
+
+// DeletePasskeyRequest is the input for removing a passkey.
+type DeletePasskeyRequest struct {
+	UserID    string
+	PasskeyID string
+	UserAgent string
+	IPAddress string
+}
+
+// BeginPasskeyRegistration starts adding a passkey to the user's account.
+func (c *Coordinator) BeginPasskeyRegistration(ctx context.Context, req *BeginPasskeyRegistrationRequest) (*identity.PasskeyChallenge, error) {
+	user, err := c.identityService.GetUserByID(ctx, identity.UserID(req.UserID))
+	if err != nil {
+		return nil, err
+	}
+	return c.identityService.BeginPasskeyRegistration(ctx, user, identity.PasskeyCeremonyRegistration, time.Now())
+}
+
+// FinishPasskeyRegistration verifies the new passkey and stores it.
+func (c *Coordinator) FinishPasskeyRegistration(ctx context.Context, req *FinishPasskeyRegistrationRequest) (*identity.Passkey, error) {
+	now := time.Now()
+
+	user, err := c.identityService.GetUserByID(ctx, identity.UserID(req.UserID))
+	if err != nil {
+		return nil, err
+	}
+
+	pk, err := c.identityService.FinishPasskeyRegistration(ctx, &identity.FinishPasskeyRegistrationRequest{
+		UserID:     user.ID,
+		CeremonyID: req.CeremonyID,
+		Name:       req.Name,
+		Response:   []byte(req.Credential),
+	}, now)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.identityService.CreatePasskey(ctx, pk); err != nil {
+		return nil, fmt.Errorf("create passkey: %w", err)
+	}
+
+	c.recordSecurityEvent(ctx, user, identity.SecurityEventPasskeyRegistered, req.UserAgent, req.IPAddress, now)
+	return pk, nil
+}
+
+// BeginPasskeySignup reserves a user ID and starts creating the first passkey of a new account.
+func (c *Coordinator) BeginPasskeySignup(ctx context.Context, req *BeginPasskeySignupRequest) (*identity.PasskeyChallenge, error) {
+	if existing, err := c.identityService.GetUserByEmail(ctx, req.Email); err == nil && existing != nil {
+		return nil, identity.ErrUserExists
+	}
+	if existing, err := c.identityService.GetUserByUsername(ctx, req.Username); err == nil && existing != nil {
+		return nil, identity.ErrUserExists
+	}
+
+	userID, err := identity.NewUserID()
+	if err != nil {
+		return nil, err
+	}
+	user := &identity.User{ID: userID, Email: req.Email, Username: req.Username, Name: req.Name}
+	return c.identityService.BeginPasskeyRegistration(ctx, user, identity.PasskeyCeremonySignup, time.Now())
+}
+
+// BeginPasskeyLogin starts a passkey login. An unknown identifier, or one without passkeys, gets a
+// discoverable ceremony rather than an error so that accounts cannot be probed.
+func (c *Coordinator) BeginPasskeyLogin(ctx context.Context, req *BeginPasskeyLoginRequest) (*identity.PasskeyChallenge, error) {
+	var user *identity.User
+	if req.Identifier != "" {
+		var err error
+		if user, err = c.identityService.GetUserByEmail(ctx, req.Identifier); err != nil {
+			user, _ = c.identityService.GetUserByUsername(ctx, req.Identifier)
+		}
+	}
+	return c.identityService.BeginPasskeyLogin(ctx, user, time.Now())
+}
+
+// LoginPasskey verifies a passkey assertion and issues the session tokens. A passkey proves both
+// possession and, through user verification, knowledge or inherence, so no TOTP challenge follows.
+func (c *Coordinator) LoginPasskey(ctx context.Context, req *LoginPasskeyRequest) (*LoginResponse, error) {
+	now := time.Now()
+
+	user, err := c.identityService.FinishPasskeyLogin(ctx, req.CeremonyID, []byte(req.Credential), now)
+	if err != nil {
+		return nil, err
+	}
+
+	switch user.Status {
+	case identity.UserStatusPendingApproval:
+		return nil, identity.ErrAccountPendingApproval
+	case identity.UserStatusSuspended:
+		return nil, identity.ErrAccountSuspended
+	case identity.UserStatusInactive:
+		return nil, identity.ErrAccountInactive
+	}
+	if c.accounts.RequireVerifiedEmail && !user.IsEmailVerified() {
+		return nil, identity.ErrEmailNotVerified
+	}
+
+	if user.LockedUntil != nil && user.LockedUntil.After(now) {
+		c.recordSecurityEvent(ctx, user, identity.SecurityEventLoginFailed, req.UserAgent, req.IPAddress, now)
+		return nil, ErrAccountLocked
+	}
+
+	return c.completeLogin(ctx, user, req.UserAgent, req.IPAddress, now)
+}
+
+// ListPasskeys returns the user's passkeys.
+func (c *Coordinator) ListPasskeys(ctx context.Context, userID identity.UserID) ([]*identity.Passkey, error) {
+	return c.identityService.ListPasskeys(ctx, userID)
+}
+
+// RenamePasskey changes the name of one of the user's passkeys.
+func (c *Coordinator) RenamePasskey(ctx context.Context, req *RenamePasskeyRequest) (*identity.Passkey, error) {
+	return c.identityService.RenamePasskey(ctx, identity.UserID(req.UserID), identity.PasskeyID(req.PasskeyID), req.Name)
+}
+
+// DeletePasskey removes one of the user's passkeys.
+func (c *Coordinator) DeletePasskey(ctx context.Context, req *DeletePasskeyRequest) error {
+	now := time.Now()
+
+	user, err := c.identityService.GetUserByID(ctx, identity.UserID(req.UserID))
+	if err != nil {
+		return err
+	}
+
+	if _, err := c.identityService.DeletePasskey(ctx, user.ID, identity.PasskeyID(req.PasskeyID)); err != nil {
+		return err
+	}
+
+	c.recordSecurityEvent(ctx, user, identity.SecurityEventPasskeyDeleted, req.UserAgent, req.IPAddress, now)
+	return nil
+}
@@ -0,0 +1,105 @@
+package iam
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/masterkeysrd/saturn/internal/domain/identity"
+	"github.com/masterkeysrd/saturn/internal/platform/password"
+)
+
+// passkeyIdentityService is an mfaIdentityService whose passkey ceremonies always succeed.
+type passkeyIdentityService struct {
+	*mfaIdentityService
+	reservedID identity.UserID
+	created    *identity.Passkey
+}
+
+func (f *passkeyIdentityService) FinishPasskeyRegistration(ctx context.Context, req *identity.FinishPasskeyRegistrationRequest, now time.Time) (*identity.Passkey, error) {
+	if req.UserID != "" {
+		return nil, errors.New("expected a signup ceremony")
+	}
+	return &identity.Passkey{ID: "pk_2NzN5q3y1pUQvU1ZQ9t2tq8xKxF", UserID: f.reservedID, Name: req.Name}, nil
+}
+
+func (f *passkeyIdentityService) CreatePasskey(ctx context.Context, passkey *identity.Passkey) error {
+	f.created = passkey
+	return nil
+}
+
+func (f *passkeyIdentityService) FinishPasskeyLogin(ctx context.Context, ceremonyID string, response []byte, now time.Time) (*identity.User, error) {
+	return f.user, nil
+}
+
+func newPasskeyCoordinator(t *testing.T) (*Coordinator, *passkeyIdentityService) {
+	t.Helper()
+	_, mfa, tokens := newMFACoordinator(t)
+	svc := &passkeyIdentityService{mfaIdentityService: mfa, reservedID: "usr_2NzN5q3y1pUQvU1ZQ9t2tq8xKxG"}
+	return NewCoordinator(Dependencies{
+		IdentityService: svc,
+		PasswordHasher:  newTestHasher(password.DefaultParams()),
+		TokenService:    tokens,
+		MailSender:      &recordingMailSender{},
+	}), svc
+}
+
+func TestRegisterWithPasskeyOnly(t *testing.T) {
+	coord, svc := newPasskeyCoordinator(t)
+
+	user, err := coord.Register(context.Background(), &RegisterUserRequest{
+		Email:             "new@example.com",
+		Username:          "newuser",
+		Name:              "New User",
+		PasskeyCeremonyID: "pkc_2NzN5q3y1pUQvU1ZQ9t2tq8xKxF",
+		PasskeyCredential: "{}",
+		PasskeyName:       "Laptop",
+	})
+	if err != nil {
+		t.Fatalf("Register: %v", err)
+	}
+
+	if user.UserID != string(svc.reservedID) {
+		t.Errorf("expected the account to use the ID reserved by the ceremony, got %s", user.UserID)
+	}
+	if svc.created == nil || svc.created.UserID != svc.reservedID || svc.created.Name != "Laptop" {
+		t.Errorf("expected the passkey to be stored, got %+v", svc.created)
+	}
+	if svc.createCredential != nil {
+		t.Errorf("expected no password credential, got %+v", svc.createCredential)
+	}
+}
+
+func TestLoginPasskeySkipsSecondFactor(t *testing.T) {
+	coord, svc := newPasskeyCoordinator(t)
+
+	resp, err := coord.LoginPasskey(context.Background(), &LoginPasskeyRequest{CeremonyID: "pkc_2NzN5q3y1pUQvU1ZQ9t2tq8xKxF", Credential: "{}"})
+	if err != nil {
+		t.Fatalf("LoginPasskey: %v", err)
+	}
+	if resp.MFARequired || resp.AccessToken == "" || resp.RefreshToken == "" || svc.sessions != 1 {
+		t.Fatalf("expected a session without an MFA challenge, got %+v", resp)
+	}
+}
+
+func TestLoginPasskeyChecksAccount(t *testing.T) {
+	coord, svc := newPasskeyCoordinator(t)
+	ctx := context.Background()
+	req := &LoginPasskeyRequest{CeremonyID: "pkc_2NzN5q3y1pUQvU1ZQ9t2tq8xKxF", Credential: "{}"}
+
+	svc.user.Status = identity.UserStatusSuspended
+	if _, err := coord.LoginPasskey(ctx, req); !errors.Is(err, identity.ErrAccountSuspended) {
+		t.Errorf("expected ErrAccountSuspended, got %v", err)
+	}
+
+	svc.user.Status = identity.UserStatusActive
+	lockedUntil := time.Now().Add(time.Minute)
+	svc.user.LockedUntil = &lockedUntil
+	if _, err := coord.LoginPasskey(ctx, req); !errors.Is(err, ErrAccountLocked) {
+		t.Errorf("expected ErrAccountLocked, got %v", err)
+	}
+	if svc.sessions != 0 {
+		t.Error("no session must be created for a rejected account")
+	}
+}
@@ -3,9 +3,11 @@ package iam
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"time"
 
 	"github.com/masterkeysrd/saturn/internal/domain/identity"
+	"github.com/masterkeysrd/saturn/internal/domain/space"
 )
 
 // RegisterUserRequest represents the input for user registration.
@@ -15,75 +17,155 @@ type RegisterUserRequest struct {
 	Name      string
 	AvatarURL string
 	Password  string
+
+	// PasskeyCeremonyID and PasskeyCredential register the account with a passkey started by
+	// BeginPasskeySignup instead of, or in addition to, a password.
+	PasskeyCeremonyID string
+	PasskeyCredential string
+	PasskeyName       string
+
+	// InvitationToken optionally names a space invitation sent to Email. It verifies the address,
+	// and the invitation is accepted once the account can sign in.
+	InvitationToken string
+
+	UserAgent string
+	IPAddress string
 }
 
 // RegisterUserResponse represents the output after user registration.
 type RegisterUserResponse struct {
-	UserID      string               `json:"user_id"`
-	Email       string               `json:"email"`
-	Username    string               `json:"username"`
-	Name        string               `json:"name"`
-	AvatarURL   string               `json:"avatar_url,omitempty"`
-	Status      identity.UserStatus  `json:"status"`
-	AccessLevel identity.AccessLevel `json:"access_level"`
-	Version     int64                `json:"version"`
-	CreateTime  time.Time            `json:"create_time"`
-	UpdateTime  time.Time            `json:"update_time"`
+	UserID        string               `json:"user_id"`
+	Email         string               `json:"email"`
+	Username      string               `json:"username"`
+	Name          string               `json:"name"`
+	AvatarURL     string               `json:"avatar_url,omitempty"`
+	Status        identity.UserStatus  `json:"status"`
+	AccessLevel   identity.AccessLevel `json:"access_level"`
+	EmailVerified bool                 `json:"email_verified"`
+	Version       int64                `json:"version"`
+	CreateTime    time.Time            `json:"create_time"`
+	UpdateTime    time.Time            `json:"update_time"`
 }
 
-// Register handles the registration flow: creates user, creates credential, returns response.
+// Register handles the registration flow: creates user, creates credentials, returns response.
+// Unless an invitation already proved the email address, a verification link is mailed to it.
 func (c *Coordinator) Register(ctx context.Context, req *RegisterUserRequest) (*RegisterUserResponse, error) {
-	// 1. Generate user ID
-	userID, err := identity.NewUserID()
-	if err != nil {
+	now := time.Now()
+
+	// 0. Verify the invitation up front so an unusable token does not leave a half-linked account.
+	// The invitation was mailed to the address, so it also verifies the email.
+	var emailVerifyTime *time.Time
+	if req.InvitationToken != "" {
+		invitation, err := c.spaceService.GetPendingInvitation(ctx, req.InvitationToken)
+		if err != nil {
+			return nil, err
+		}
+		if !invitation.IsFor(req.Email) {
+			return nil, space.ErrInvitationEmailMismatch
+		}
+		emailVerifyTime = &now
+	}
+
+	// 1. Generate user ID, or take the one reserved by the signup ceremony once the passkey checks out
+	var passkey *identity.Passkey
+	var userID identity.UserID
+	var err error
+	if req.PasskeyCeremonyID != "" {
+		passkey, err = c.identityService.FinishPasskeyRegistration(ctx, &identity.FinishPasskeyRegistrationRequest{
+			CeremonyID: req.PasskeyCeremonyID,
+			Name:       req.PasskeyName,
+			Response:   []byte(req.PasskeyCredential),
+		}, now)
+		if err != nil {
+			return nil, err
+		}
+		userID = passkey.UserID
+	} else if userID, err = identity.NewUserID(); err != nil {
 		return nil, err
 	}
 
-	// 2. Hash password before creating user
-	encodedHash, err := c.passwordHasher.Hash(req.Password)
-	if err != nil {
-		return nil, fmt.Errorf("hash password: %w", err)
+	// 2. Hash password before creating user. Passkey-only accounts have none.
+	var encodedHash string
+	if passkey == nil || req.Password != "" {
+		if encodedHash, err = c.passwordHasher.Hash(req.Password); err != nil {
+			return nil, fmt.Errorf("hash password: %w", err)
+		}
 	}
 
 	// 3. Create user
 	user := &identity.User{
-		ID:          userID,
-		Email:       req.Email,
-		Username:    req.Username,
-		Name:        req.Name,
-		AvatarURL:   req.AvatarURL,
-		Status:      identity.UserStatusPendingApproval,
-		AccessLevel: identity.AccessLevelUser,
-		CreateTime:  time.Now(),
-		UpdateTime:  time.Now(),
+		ID:              userID,
+		Email:           req.Email,
+		Username:        req.Username,
+		Name:            req.Name,
+		AvatarURL:       req.AvatarURL,
+		Status:          identity.UserStatusPendingApproval,
+		AccessLevel:     identity.AccessLevelUser,
+		EmailVerifyTime: emailVerifyTime,
+		CreateTime:      now,
+		UpdateTime:      now,
 	}
 
 	if err := c.identityService.CreateUser(ctx, user); err != nil {
 		return nil, err
 	}
 
-	// 4. Create credential with hashed password
-	credential := &identity.Credential{
-		UserID:     userID,
-		AuthType:   "password",
-		SecretData: encodedHash,
+	// 4. Create credentials: the hashed password and/or the passkey
+	if encodedHash != "" {
+		credential := &identity.Credential{
+			UserID:     userID,
+			AuthType:   identity.AuthTypePassword,
+			SecretData: encodedHash,
+		}
+
+		if err := c.identityService.CreateCredential(ctx, credential); err != nil {
+			return nil, err
+		}
+	}
+	if passkey != nil {
+		if err := c.identityService.CreatePasskey(ctx, passkey); err != nil {
+			return nil, err
+		}
+	}
+
+	// 5. Join the inviting space, but only with an account that can sign in. A pending account
+	// leaves the invitation pending; the invitee accepts it from the invitation link once approved.
+	// The account already exists at this point, so a failure here (e.g. the invitation was revoked
+	// meanwhile) is logged and the invitation can be retried later.
+	if req.InvitationToken != "" && c.canSignIn(user) {
+		if _, err := c.spaceService.AcceptInvitation(ctx, space.SpaceID(userID), user.Email, req.InvitationToken); err != nil {
+			slog.WarnContext(ctx, "failed to accept invitation on registration", "user_id", userID, "err", err)
+		}
 	}
 
-	if err := c.identityService.CreateCredential(ctx, credential); err != nil {
-		return nil, err
+	// 6. Mail the verification link. The account exists already, so a failure is logged and the
+	// user can ask for the link again.
+	if !user.IsEmailVerified() {
+		if err := c.sendVerificationEmail(ctx, user, req.UserAgent, req.IPAddress, now); err != nil {
+			slog.WarnContext(ctx, "failed to send verification email on registration", "user_id", userID, "err", err)
+		}
 	}
 
-	// 5. Return the response
+	// 7. Return the response
 	return &RegisterUserResponse{
-		UserID:      string(userID),
-		Email:       user.Email,
-		Username:    user.Username,
-		Name:        user.Name,
-		AvatarURL:   user.AvatarURL,
-		Status:      user.Status,
-		AccessLevel: user.AccessLevel,
-		Version:     user.Version,
-		CreateTime:  user.CreateTime,
-		UpdateTime:  user.UpdateTime,
+		UserID:        string(userID),
+		Email:         user.Email,
+		Username:      user.Username,
+		Name:          user.Name,
+		AvatarURL:     user.AvatarURL,
+		Status:        user.Status,
+		AccessLevel:   user.AccessLevel,
+		EmailVerified: user.IsEmailVerified(),
+		Version:       user.Version,
+		CreateTime:    user.CreateTime,
+		UpdateTime:    user.UpdateTime,
 	}, nil
 }
+
+// canSignIn reports whether the account is active and meets the email verification policy.
+func (c *Coordinator) canSignIn(user *identity.User) bool {
+	if user.Status != identity.UserStatusActive {
+		return false
+	}
+	return !c.accounts.RequireVerifiedEmail || user.IsEmailVerified()
+}
@@ -5,8 +5,10 @@ import (
 	"errors"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/masterkeysrd/saturn/internal/domain/identity"
+	"github.com/masterkeysrd/saturn/internal/domain/space"
 	"github.com/masterkeysrd/saturn/internal/platform/password"
 )
 
@@ -162,12 +164,93 @@ func (f *fakeIdentityService) ListSecurityEvents(ctx context.Context, filter ide
 	return nil, "", nil
 }
 
+func (f *fakeIdentityService) GetMFAStatus(ctx context.Context, userID identity.UserID) (*identity.MFAStatus, error) {
+	return &identity.MFAStatus{}, nil
+}
+
+func (f *fakeIdentityService) EnrollTOTP(ctx context.Context, user *identity.User) (*identity.TOTPEnrollment, error) {
+	return nil, nil
+}
+
+func (f *fakeIdentityService) ConfirmTOTP(ctx context.Context, userID identity.UserID, code string, now time.Time) ([]string, error) {
+	return nil, nil
+}
+
+func (f *fakeIdentityService) VerifyTOTP(ctx context.Context, userID identity.UserID, code string, now time.Time) error {
+	return nil
+}
+
+func (f *fakeIdentityService) UseRecoveryCode(ctx context.Context, userID identity.UserID, code string, now time.Time) error {
+	return nil
+}
+
+func (f *fakeIdentityService) RegenerateRecoveryCodes(ctx context.Context, userID identity.UserID) ([]string, error) {
+	return nil, nil
+}
+
+func (f *fakeIdentityService) DisableMFA(ctx context.Context, userID identity.UserID) error {
+	return nil
+}
+
+func (f *fakeIdentityService) BeginPasskeyRegistration(ctx context.Context, user *identity.User, kind identity.PasskeyCeremonyKind, now time.Time) (*identity.PasskeyChallenge, error) {
+	return nil, nil
+}
+
+func (f *fakeIdentityService) FinishPasskeyRegistration(ctx context.Context, req *identity.FinishPasskeyRegistrationRequest, now time.Time) (*identity.Passkey, error) {
+	return nil, nil
+}
+
+func (f *fakeIdentityService) CreatePasskey(ctx context.Context, passkey *identity.Passkey) error {
+	return nil
+}
+
+func (f *fakeIdentityService) BeginPasskeyLogin(ctx context.Context, user *identity.User, now time.Time) (*identity.PasskeyChallenge, error) {
+	return nil, nil
+}
+
+func (f *fakeIdentityService) FinishPasskeyLogin(ctx context.Context, ceremonyID string, response []byte, now time.Time) (*identity.User, error) {
+	return nil, nil
+}
+
+func (f *fakeIdentityService) ListPasskeys(ctx context.Context, userID identity.UserID) ([]*identity.Passkey, error) {
+	return nil, nil
+}
+
+func (f *fakeIdentityService) RenamePasskey(ctx context.Context, userID identity.UserID, passkeyID identity.PasskeyID, name string) (*identity.Passkey, error) {
+	return nil, nil
+}
+
+func (f *fakeIdentityService) DeletePasskey(ctx context.Context, userID identity.UserID, passkeyID identity.PasskeyID) (*identity.Passkey, error) {
+	return nil, nil
+}
+
+func (f *fakeIdentityService) IssueAccountToken(ctx context.Context, user *identity.User, purpose identity.AccountTokenPurpose, now time.Time) (string, error) {
+	return "", nil
+}
+
+func (f *fakeIdentityService) ConsumeAccountToken(ctx context.Context, purpose identity.AccountTokenPurpose, raw string, now time.Time) (*identity.AccountToken, *identity.User, error) {
+	return nil, nil, identity.ErrInvalidAccountToken
+}
+
+func (f *fakeIdentityService) VerifyPassword(ctx context.Context, userID identity.UserID, password string) error {
+	return nil
+}
+
+func (f *fakeIdentityService) SetPassword(ctx context.Context, userID identity.UserID, encodedHash string) error {
+	return nil
+}
+
+func (f *fakeIdentityService) MarkEmailVerified(ctx context.Context, user *identity.User, now time.Time) error {
+	return nil
+}
+
 func TestRegisterHashesPassword(t *testing.T) {
 	fakeSvc := newFakeIdentityService()
 	testH := newTestHasher(password.DefaultParams())
 	coord := NewCoordinator(Dependencies{
 		IdentityService: fakeSvc,
 		PasswordHasher:  testH,
+		MailSender:      &recordingMailSender{},
 	})
 
 	req := &RegisterUserRequest{
@@ -202,6 +285,7 @@ func TestRegisterNeverSendsPlaintext(t *testing.T) {
 	coord := NewCoordinator(Dependencies{
 		IdentityService: fakeSvc,
 		PasswordHasher:  testH,
+		MailSender:      &recordingMailSender{},
 	})
 
 	req := &RegisterUserRequest{
@@ -327,3 +411,75 @@ func TestRegisterHasherErrorPropagated(t *testing.T) {
 		t.Fatal("CreateUser must not be called when hashing fails")
 	}
 }
+
+// fakeSpaceService is a test double for SpaceService.
+type fakeSpaceService struct {
+	invitation *space.Invitation
+	acceptedBy space.SpaceID
+}
+
+func (f *fakeSpaceService) CreateSpace(ctx context.Context, sp *space.Space) (*space.Space, error) {
+	return sp, nil
+}
+
+func (f *fakeSpaceService) GetPendingInvitation(ctx context.Context, rawToken string) (*space.Invitation, error) {
+	if f.invitation == nil {
+		return nil, space.ErrInvitationNotFound
+	}
+	return f.invitation, nil
+}
+
+func (f *fakeSpaceService) AcceptInvitation(ctx context.Context, userID space.SpaceID, email string, rawToken string) (*space.Member, error) {
+	f.acceptedBy = userID
+	return &space.Member{SpaceID: f.invitation.SpaceID, UserID: userID, Role: f.invitation.Role}, nil
+}
+
+func TestRegisterDefersInvitationUntilApproved(t *testing.T) {
+	fakeSpaces := &fakeSpaceService{invitation: &space.Invitation{Email: "test@example.com", Role: space.RoleMember}}
+	coord := NewCoordinator(Dependencies{
+		IdentityService: newFakeIdentityService(),
+		SpaceService:    fakeSpaces,
+		PasswordHasher:  newTestHasher(password.DefaultParams()),
+	})
+
+	user, err := coord.Register(context.Background(), &RegisterUserRequest{
+		Email:           "Test@Example.com",
+		Username:        "testuser",
+		Name:            "Test User",
+		Password:        "securepassword123",
+		InvitationToken: "token",
+	})
+	if err != nil {
+		t.Fatalf("Register: %v", err)
+	}
+
+	if user.Status != identity.UserStatusPendingApproval || !user.EmailVerified {
+		t.Errorf("expected a pending account with a verified email, got status %s", user.Status)
+	}
+	if fakeSpaces.acceptedBy != "" {
+		t.Errorf("expected the invitation to stay pending until approval, got accepted by %q", fakeSpaces.acceptedBy)
+	}
+}
+
+func TestRegisterInvitationEmailMismatch(t *testing.T) {
+	fakeSvc := newFakeIdentityService()
+	coord := NewCoordinator(Dependencies{
+		IdentityService: fakeSvc,
+		SpaceService:    &fakeSpaceService{invitation: &space.Invitation{Email: "someone@example.com", Role: space.RoleMember}},
+		PasswordHasher:  newTestHasher(password.DefaultParams()),
+	})
+
+	_, err := coord.Register(context.Background(), &RegisterUserRequest{
+		Email:           "test@example.com",
+		Username:        "testuser",
+		Name:            "Test User",
+		Password:        "securepassword123",
+		InvitationToken: "token",
+	})
+	if !errors.Is(err, space.ErrInvitationEmailMismatch) {
+		t.Fatalf("expected ErrInvitationEmailMismatch, got %v", err)
+	}
+	if fakeSvc.createUserCalled {
+		t.Fatal("CreateUser must not be called when the invitation is for another email")
+	}
+}
@@ -3,9 +3,11 @@ package space
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/masterkeysrd/saturn/internal/domain/identity"
 	"github.com/masterkeysrd/saturn/internal/domain/space"
+	"github.com/masterkeysrd/saturn/internal/platform/mail"
 )
 
 // Sentinel errors for coordinator operations.
@@ -17,12 +19,16 @@ var (
 type Dependencies struct {
 	SpaceService    SpaceService
 	IdentityService IdentityService
+	MailSender      mail.Sender
+	Invitations     InvitationConfig
 }
 
 // Coordinator orchestrates space and membership operations.
 type Coordinator struct {
 	spaceService    SpaceService
 	identityService IdentityService
+	mailSender      mail.Sender
+	invitations     InvitationConfig
 }
 
 // NewCoordinator creates a new Coordinator.
@@ -30,6 +36,8 @@ func NewCoordinator(deps Dependencies) *Coordinator {
 	return &Coordinator{
 		spaceService:    deps.SpaceService,
 		identityService: deps.IdentityService,
+		mailSender:      deps.MailSender,
+		invitations:     deps.Invitations,
 	}
 }
 
@@ -233,9 +241,16 @@ type SpaceService interface {
 	RemoveSpaceMember(ctx context.Context, session space.Session, targetUserID space.SpaceID) error
 	UpdateSpaceMemberRole(ctx context.Context, session space.Session, member *space.Member) (*space.Member, error)
 	ListSpaceMembers(ctx context.Context, session space.Session, filter *space.ListMembersFilter) ([]*space.Member, string, error)
+	IsSpaceMember(ctx context.Context, spaceID space.SpaceID, userID space.SpaceID) (bool, error)
+	CreateInvitation(ctx context.Context, session space.Session, invitation *space.Invitation, inviteeID space.SpaceID, ttl time.Duration) (*space.Invitation, string, error)
+	ListInvitations(ctx context.Context, session space.Session, filter *space.ListInvitationsFilter) ([]*space.Invitation, string, error)
+	RevokeInvitation(ctx context.Context, session space.Session, id space.InvitationID) (*space.Invitation, error)
+	AcceptInvitation(ctx context.Context, userID space.SpaceID, email string, rawToken string) (*space.Member, error)
+	DeclineInvitation(ctx context.Context, email string, rawToken string) (*space.Invitation, error)
 }
 
 // IdentityService defines the interface for required identity operations.
 type IdentityService interface {
 	GetUserByID(ctx context.Context, id identity.UserID) (*identity.User, error)
+	GetUserByEmail(ctx context.Context, email string) (*identity.User, error)
 }
@@ -0,0 +1,172 @@
+package space
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/masterkeysrd/saturn/internal/domain/identity"
+	"github.com/masterkeysrd/saturn/internal/domain/space"
+	"github.com/masterkeysrd/saturn/internal/platform/mail"
+)
+
+// InvitationConfig holds the settings used to deliver invitation emails.
+type InvitationConfig struct {
+	// From is the sender address of invitation emails.
+	From string
+	// AcceptURL is the web page that accepts an invitation; the token is appended as a query parameter.
+	AcceptURL string
+}
+
+// CreateInvitationRequest represents the input for inviting an email address to a workspace.
+type CreateInvitationRequest struct {
+	SpaceID string
+	UserID  string
+	Email   string
+	Role    string
+	TTL     time.Duration // Zero uses the default expiry
+}
+
+// ListInvitationsRequest represents the input for listing workspace invitations.
+type ListInvitationsRequest struct {
+	SpaceID string
+	UserID  string
+	Filter  *space.ListInvitationsFilter
+}
+
+// RevokeInvitationRequest represents the input for revoking an invitation.
+type RevokeInvitationRequest struct {
+	SpaceID      string
+	UserID       string
+	InvitationID space.InvitationID
+}
+
+// AnswerInvitationRequest represents the input for accepting or declining an invitation.
+type AnswerInvitationRequest struct {
+	UserID string
+	Token  string
+}
+
+// CreateInvitation orchestrates creating an invitation and emailing its token to the invitee.
+func (c *Coordinator) CreateInvitation(ctx context.Context, req *CreateInvitationRequest) (*space.Invitation, error) {
+	session := space.Session{
+		SpaceID: space.SpaceID(req.SpaceID),
+		UserID:  space.SpaceID(req.UserID),
+	}
+
+	// The domain service checks membership only after authorizing the caller
+	var inviteeID space.SpaceID
+	if existing, err := c.identityService.GetUserByEmail(ctx, strings.TrimSpace(req.Email)); err == nil && existing != nil {
+		inviteeID = space.SpaceID(existing.ID)
+	}
+
+	invitation, rawToken, err := c.spaceService.CreateInvitation(ctx, session, &space.Invitation{
+		Email: req.Email,
+		Role:  space.SpaceRole(req.Role),
+	}, inviteeID, req.TTL)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.sendInvitation(ctx, session, invitation, rawToken); err != nil {
+		// Rollback: the token was never delivered, so the invitation cannot be answered
+		if _, revokeErr := c.spaceService.RevokeInvitation(ctx, session, invitation.ID); revokeErr != nil {
+			slog.WarnContext(ctx, "failed to revoke undelivered invitation", "invitation_id", invitation.ID, "err", revokeErr)
+		}
+		return nil, fmt.Errorf("send invitation email: %w", err)
+	}
+
+	return invitation, nil
+}
+
+// ListInvitations orchestrates listing workspace invitations.
+func (c *Coordinator) ListInvitations(ctx context.Context, req *ListInvitationsRequest) ([]*space.Invitation, string, error) {
+	session := space.Session{
+		SpaceID: space.SpaceID(req.SpaceID),
+		UserID:  space.SpaceID(req.UserID),
+	}
+	return c.spaceService.ListInvitations(ctx, session, req.Filter)
+}
+
+// RevokeInvitation orchestrates revoking a pending invitation.
+func (c *Coordinator) RevokeInvitation(ctx context.Context, req *RevokeInvitationRequest) (*space.Invitation, error) {
+	session := space.Session{
+		SpaceID: space.SpaceID(req.SpaceID),
+		UserID:  space.SpaceID(req.UserID),
+	}
+	return c.spaceService.RevokeInvitation(ctx, session, req.InvitationID)
+}
+
+// AcceptInvitation orchestrates joining a workspace through an invitation addressed to the caller.
+func (c *Coordinator) AcceptInvitation(ctx context.Context, req *AnswerInvitationRequest) (*space.Member, error) {
+	user, err := c.activeUser(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return c.spaceService.AcceptInvitation(ctx, space.SpaceID(user.ID), user.Email, req.Token)
+}
+
+// DeclineInvitation orchestrates declining an invitation addressed to the caller.
+func (c *Coordinator) DeclineInvitation(ctx context.Context, req *AnswerInvitationRequest) (*space.Invitation, error) {
+	user, err := c.activeUser(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return c.spaceService.DeclineInvitation(ctx, user.Email, req.Token)
+}
+
+// activeUser loads the calling user and verifies the account is active.
+func (c *Coordinator) activeUser(ctx context.Context, userID string) (*identity.User, error) {
+	user, err := c.identityService.GetUserByID(ctx, identity.UserID(userID))
+	if err != nil {
+		return nil, err
+	}
+	if user.Status != identity.UserStatusActive {
+		return nil, ErrUserNotActive
+	}
+	return user, nil
+}
+
+// sendInvitation emails the invite link to the invitee.
+func (c *Coordinator) sendInvitation(ctx context.Context, session space.Session, invitation *space.Invitation, rawToken string) error {
+	spaceName := string(session.SpaceID)
+	if sp, err := c.spaceService.GetSpace(ctx, session); err == nil {
+		spaceName = sp.Name
+	}
+	inviterName := "A Saturn user"
+	if inviter, err := c.identityService.GetUserByID(ctx, identity.UserID(session.UserID)); err == nil && inviter != nil {
+		inviterName = inviter.Name
+	}
+
+	link, err := invitationLink(c.invitations.AcceptURL, rawToken)
+	if err != nil {
+		return err
+	}
+
+	return c.mailSender.Send(ctx, &mail.Message{
+		From:    c.invitations.From,
+		To:      invitation.Email,
+		Subject: fmt.Sprintf("%s invited you to %s on Saturn", inviterName, spaceName),
+		Body: fmt.Sprintf("%s invited you to join the workspace %q as %s.\n\n"+
+			"Accept the invitation: %s\n\n"+
+			"If you do not have an account yet, register with this email address and the invitation token below.\n\n"+
+			"Invitation token: %s\n\n"+
+			"This invitation expires on %s.\n",
+			inviterName, spaceName, invitation.Role, link, rawToken, invitation.ExpireTime.UTC().Format(time.RFC1123)),
+	})
+}
+
+// invitationLink appends the token to the accept page URL.
+func invitationLink(acceptURL, rawToken string) (string, error) {
+	u, err := url.Parse(acceptURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid invitation accept URL: %w", err)
+	}
+	q := u.Query()
+	q.Set("token", rawToken)
+	u.RawQuery = q.Encode()
+	return u.String(), nil
+}
@@ -76,6 +76,16 @@ type Account struct {
 	LastFour       string
 	CreateTime     time.Time
 	UpdateTime     time.Time
+
+	// Billing cycle, credit cards only. A zero StatementClosingDay disables statements.
+	StatementClosingDay int32     // Day of month the statement closes (1-31)
+	PaymentDueDay       int32     // Day of month the statement payment is due (1-31)
+	MinimumPaymentBps   int32     // Minimum payment as basis points of the statement balance
+	MinimumPaymentFloor int64     // Lowest minimum payment in minor units
+	PaymentBudgetID     *BudgetID // Budget the scheduled card payment is drawn from
+
+	// LowBalanceThreshold flags forecast days where the balance (available credit for cards) drops below it. Zero disables it.
+	LowBalanceThreshold int64
 }
 
 // Validate checks the account's business rules.
@@ -93,11 +103,27 @@ func (a *Account) Validate() error {
 	if err := a.Currency.Validate(); err != nil {
 		return fmt.Errorf("validate currency: %w", err)
 	}
+	if err := a.Currency.ValidateAmount(a.InitialBalance); err != nil {
+		return fmt.Errorf("validate initial balance: %w", err)
+	}
+	if err := a.Currency.ValidateAmount(a.CreditLimit); err != nil {
+		return fmt.Errorf("validate credit limit: %w", err)
+	}
 	if a.Type == AccountTypeCreditCard && a.CreditLimit < 0 {
 		return errors.New("credit limit cannot be negative")
 	}
 	if a.Type != AccountTypeCreditCard {
 		a.CreditLimit = 0
+		a.clearStatementCycle()
+	}
+	if err := a.validateStatementCycle(); err != nil {
+		return err
+	}
+	if a.LowBalanceThreshold < 0 {
+		return errors.New("low balance threshold cannot be negative")
+	}
+	if err := a.Currency.ValidateAmount(a.LowBalanceThreshold); err != nil {
+		return fmt.Errorf("validate low balance threshold: %w", err)
 	}
 	a.LastFour = strings.TrimSpace(a.LastFour)
 	if a.LastFour != "" {
@@ -123,6 +149,40 @@ func (a *Account) Validate() error {
 	return nil
 }
 
+// BalanceImpact returns the signed change a transaction of the given type and amount makes to the
+// account's current balance.
+func (a *Account) BalanceImpact(amount int64, txnType TransactionType) int64 {
+	if txnType == TransactionTypeBalanceAdjustment {
+		return amount
+	}
+
+	// Determine if the transaction is an inflow or an outflow
+	isOutflow := (txnType == TransactionTypeExpense || txnType == TransactionTypeTransferOut)
+	isInflow := (txnType == TransactionTypeIncome || txnType == TransactionTypeTransferIn)
+
+	if a.Type == AccountTypeCreditCard {
+		// Liability Account Rules (Positive = Debt Owed):
+		// Outflow (Purchase/Expense/TransferOut) INCREASES debt (+amount)
+		// Inflow (Card Payment/Refund/TransferIn) DECREASES debt (-amount)
+		if isOutflow {
+			return amount
+		} else if isInflow {
+			return -amount
+		}
+		return 0
+	}
+
+	// Asset Account Rules (Positive = Money Owned):
+	// Outflow (Withdrawal/Expense/TransferOut) DECREASES asset (-amount)
+	// Inflow (Deposit/Income/TransferIn) INCREASES asset (+amount)
+	if isOutflow {
+		return -amount
+	} else if isInflow {
+		return amount
+	}
+	return 0
+}
+
 // DefaultAccountSortField represents the fallback sorting column name for accounts.
 const DefaultAccountSortField = "name"
 
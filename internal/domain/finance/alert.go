@@ -0,0 +1,222 @@
+package finance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/masterkeysrd/saturn/internal/platform/id"
+	"github.com/masterkeysrd/saturn/internal/platform/sorting"
+)
+
+const (
+	// MaxBudgetAlertPercent bounds the budget usage percentages an alert can be configured for.
+	MaxBudgetAlertPercent = 1000
+	// DefaultAnomalyStdDevs is used when a space does not configure its own anomaly sensitivity.
+	DefaultAnomalyStdDevs = 3.0
+	// DefaultDuplicateWindowHours is used when a space does not configure its own duplicate window.
+	DefaultDuplicateWindowHours = 48
+	// minAnomalySamples is how many past expenses a budget needs before anomalies are flagged.
+	minAnomalySamples = 5
+	// anomalySampleSize is how many past expenses the anomaly rule compares against.
+	anomalySampleSize = 50
+)
+
+// TransactionNotifier is told about every created or updated expense so the alert rules can
+// evaluate it.
+type TransactionNotifier interface {
+	// TransactionChanged is called once the change is stored, so it cannot fail the write; the
+	// implementation deals with its own errors.
+	TransactionChanged(ctx context.Context, txn *Transaction)
+}
+
+// DefaultBudgetAlertPercents are the budget usage alerts raised when a space configures none.
+var DefaultBudgetAlertPercents = []int32{80, 100}
+
+// AlertPercents returns the budget usage percentages that raise alerts.
+func (fs *FinanceSettings) AlertPercents() []int32 {
+	if len(fs.BudgetAlertPercents) == 0 {
+		return DefaultBudgetAlertPercents
+	}
+	return fs.BudgetAlertPercents
+}
+
+// AnomalyThreshold returns how many standard deviations above the mean flag an expense.
+func (fs *FinanceSettings) AnomalyThreshold() float64 {
+	if fs.AnomalyStdDevs <= 0 {
+		return DefaultAnomalyStdDevs
+	}
+	return fs.AnomalyStdDevs
+}
+
+// DuplicateWindow returns how far apart two identical charges may be to look duplicated.
+func (fs *FinanceSettings) DuplicateWindow() time.Duration {
+	hours := fs.DuplicateWindowHours
+	if hours <= 0 {
+		hours = DefaultDuplicateWindowHours
+	}
+	return time.Duration(hours) * time.Hour
+}
+
+// AlertID is a custom string type representing an alert's unique identifier (KSUID).
+type AlertID string
+
+const alertPrefix = "alr_"
+
+// NewAlertID creates a new AlertID using the default ID generator.
+func NewAlertID() (AlertID, error) {
+	raw, err := id.Generate(alertPrefix)
+	if err != nil {
+		return "", err
+	}
+	return AlertID(raw), nil
+}
+
+// ParseAlertID parses a string into an AlertID and validates it.
+func ParseAlertID(s string) (AlertID, error) {
+	if err := id.Validate(s, alertPrefix); err != nil {
+		return "", fmt.Errorf("invalid alert ID: %w", err)
+	}
+	return AlertID(s), nil
+}
+
+// String returns the string representation.
+func (aid AlertID) String() string {
+	return string(aid)
+}
+
+// Validate checks if the AlertID is valid.
+func (aid AlertID) Validate() error {
+	return id.Validate(string(aid), alertPrefix)
+}
+
+// AlertRule identifies the rule that raised an alert.
+type AlertRule string
+
+const (
+	AlertRuleBudgetThreshold    AlertRule = "BUDGET_THRESHOLD"    // Budget spending reached a configured share of its limit
+	AlertRuleProjectedOverspend AlertRule = "PROJECTED_OVERSPEND" // The current burn rate exceeds the limit before the period ends
+	AlertRuleAmountAnomaly      AlertRule = "AMOUNT_ANOMALY"      // An expense is far above the budget's usual amounts
+	AlertRuleDuplicateCharge    AlertRule = "DUPLICATE_CHARGE"    // An expense looks like a repeat of a recent one
+)
+
+// AlertStatus tracks whether an alert still needs attention.
+type AlertStatus string
+
+const (
+	AlertStatusOpen         AlertStatus = "OPEN"
+	AlertStatusAcknowledged AlertStatus = "ACKNOWLEDGED"
+	AlertStatusSnoozed      AlertStatus = "SNOOZED"
+)
+
+// Alert is a persisted finding of the alert rules engine.
+type Alert struct {
+	ID                   AlertID
+	SpaceID              SpaceID
+	Rule                 AlertRule
+	Status               AlertStatus
+	Message              string
+	BudgetID             *BudgetID
+	PeriodID             *PeriodID
+	TransactionID        *TransactionID
+	RelatedTransactionID *TransactionID // The earlier charge a duplicate matches
+	Amount               int64          // Spent, projected or charged amount, in Currency
+	Threshold            int64          // Limit, threshold or expected amount the rule compared against
+	Currency             Currency
+	DedupKey             string // Identifies the condition so it is raised only once
+	SnoozedUntil         *time.Time
+	AcknowledgeTime      *time.Time
+	CreateTime           time.Time
+	UpdateTime           time.Time
+}
+
+// Validate checks the alert's business rules.
+func (a *Alert) Validate() error {
+	if err := a.ID.Validate(); err != nil {
+		return fmt.Errorf("validate alert ID: %w", err)
+	}
+	if err := a.SpaceID.Validate(); err != nil {
+		return fmt.Errorf("validate space ID: %w", err)
+	}
+	switch a.Rule {
+	case AlertRuleBudgetThreshold, AlertRuleProjectedOverspend, AlertRuleAmountAnomaly, AlertRuleDuplicateCharge:
+	default:
+		return fmt.Errorf("invalid alert rule: %q", a.Rule)
+	}
+	switch a.Status {
+	case AlertStatusOpen, AlertStatusAcknowledged, AlertStatusSnoozed:
+	default:
+		return fmt.Errorf("invalid alert status: %q", a.Status)
+	}
+	if a.DedupKey == "" {
+		return errors.New("alert dedup key is required")
+	}
+	return nil
+}
+
+// IsActive reports whether the alert needs attention at now. Snoozed alerts wake up once the snooze elapses.
+func (a *Alert) IsActive(now time.Time) bool {
+	switch a.Status {
+	case AlertStatusOpen:
+		return true
+	case AlertStatusSnoozed:
+		return a.SnoozedUntil == nil || !now.Before(*a.SnoozedUntil)
+	default:
+		return false
+	}
+}
+
+// Acknowledge marks the alert as handled.
+func (a *Alert) Acknowledge(now time.Time) {
+	a.Status = AlertStatusAcknowledged
+	a.AcknowledgeTime = &now
+	a.SnoozedUntil = nil
+	a.UpdateTime = now
+}
+
+// Snooze hides the alert until the given time.
+func (a *Alert) Snooze(until, now time.Time) error {
+	if !until.After(now) {
+		return errors.New("snooze time must be in the future")
+	}
+	if a.Status == AlertStatusAcknowledged {
+		return ErrAlertAcknowledged
+	}
+	a.Status = AlertStatusSnoozed
+	a.SnoozedUntil = &until
+	a.UpdateTime = now
+	return nil
+}
+
+// DefaultAlertSortField represents the fallback sorting column name for alerts.
+const DefaultAlertSortField = "create_time"
+
+// AlertSortFields registry maps sortable alert field names to their cursor string extraction logic.
+var AlertSortFields = map[string]func(*Alert) string{
+	"create_time": func(a *Alert) string { return a.CreateTime.Format(time.RFC3339Nano) },
+}
+
+// IsAlertSortField validates if a sort column name is allowed.
+func IsAlertSortField(field string) bool {
+	_, ok := AlertSortFields[field]
+	return ok
+}
+
+// GetSortValue extracts the sort value string for pagination cursor generation.
+func (a *Alert) GetSortValue(field string) string {
+	if fn, ok := AlertSortFields[field]; ok {
+		return fn(a)
+	}
+	return a.GetSortValue(DefaultAlertSortField)
+}
+
+// ListAlertsFilter encapsulates filtering parameters for listing alerts.
+type ListAlertsFilter struct {
+	ActiveOnly    bool       // Only open alerts and snoozed alerts whose snooze elapsed
+	Now           time.Time  // Reference time for ActiveOnly
+	Rule          *AlertRule // Optional rule filter
+	PageSize      int32
+	NextPageToken string
+	Sort          sorting.SortOrder
+}
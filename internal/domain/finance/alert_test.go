@@ -0,0 +1,67 @@
+package finance
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestFinanceSettings_AlertDefaults(t *testing.T) {
+	var fs FinanceSettings
+	if got := fs.AlertPercents(); !slices.Equal(got, DefaultBudgetAlertPercents) {
+		t.Errorf("AlertPercents() = %v, want %v", got, DefaultBudgetAlertPercents)
+	}
+	if got := fs.AnomalyThreshold(); got != DefaultAnomalyStdDevs {
+		t.Errorf("AnomalyThreshold() = %v, want %v", got, DefaultAnomalyStdDevs)
+	}
+	if got := fs.DuplicateWindow(); got != DefaultDuplicateWindowHours*time.Hour {
+		t.Errorf("DuplicateWindow() = %v, want %v", got, DefaultDuplicateWindowHours*time.Hour)
+	}
+
+	fs = FinanceSettings{BudgetAlertPercents: []int32{50}, AnomalyStdDevs: 2, DuplicateWindowHours: 6}
+	if got := fs.AlertPercents(); !slices.Equal(got, []int32{50}) {
+		t.Errorf("AlertPercents() = %v, want [50]", got)
+	}
+	if got := fs.AnomalyThreshold(); got != 2 {
+		t.Errorf("AnomalyThreshold() = %v, want 2", got)
+	}
+	if got := fs.DuplicateWindow(); got != 6*time.Hour {
+		t.Errorf("DuplicateWindow() = %v, want 6h", got)
+	}
+}
+
+func TestAlert_Lifecycle(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	alert := &Alert{Rule: AlertRuleBudgetThreshold, Status: AlertStatusOpen}
+
+	if !alert.IsActive(now) {
+		t.Error("open alert should be active")
+	}
+
+	if err := alert.Snooze(now, now); err == nil {
+		t.Error("expected an error snoozing until now")
+	}
+
+	until := now.Add(24 * time.Hour)
+	if err := alert.Snooze(until, now); err != nil {
+		t.Fatalf("Snooze failed: %v", err)
+	}
+	if alert.IsActive(now) {
+		t.Error("snoozed alert should not be active before the snooze elapses")
+	}
+	if !alert.IsActive(until) {
+		t.Error("snoozed alert should be active again once the snooze elapses")
+	}
+
+	alert.Acknowledge(now)
+	if alert.Status != AlertStatusAcknowledged || alert.AcknowledgeTime == nil || alert.SnoozedUntil != nil {
+		t.Errorf("after Acknowledge: status = %s, acknowledge time = %v, snoozed until = %v", alert.Status, alert.AcknowledgeTime, alert.SnoozedUntil)
+	}
+	if alert.IsActive(until) {
+		t.Error("acknowledged alert should never be active")
+	}
+	if err := alert.Snooze(until, now); !errors.Is(err, ErrAlertAcknowledged) {
+		t.Errorf("Snooze after Acknowledge error = %v, want ErrAlertAcknowledged", err)
+	}
+}
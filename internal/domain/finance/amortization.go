@@ -0,0 +1,358 @@
+package finance
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+// SourceTypeLoan marks scheduled payments generated from a borrowing's instalment plan.
+const SourceTypeLoan = "loan"
+
+// MaxBorrowingInstallments caps instalment plans at 50 years of monthly payments.
+const MaxBorrowingInstallments = 600
+
+// InterestType selects how interest is charged on a borrowing.
+type InterestType string
+
+const (
+	// InterestTypeNone charges no interest; instalments split the principal evenly.
+	InterestTypeNone InterestType = "NONE"
+	// InterestTypeSimple charges flat interest on the original principal for the whole term.
+	InterestTypeSimple InterestType = "SIMPLE"
+	// InterestTypeCompound charges interest on the outstanding balance each period, with
+	// level payments (a standard annuity / mortgage schedule).
+	InterestTypeCompound InterestType = "COMPOUND"
+)
+
+// Validate checks that the interest type is known. An empty value means no interest.
+func (t InterestType) Validate() error {
+	switch t {
+	case "", InterestTypeNone, InterestTypeSimple, InterestTypeCompound:
+		return nil
+	default:
+		return fmt.Errorf("invalid interest type: %s", t)
+	}
+}
+
+// InstallmentFrequency is the spacing between instalments of a borrowing.
+type InstallmentFrequency string
+
+const (
+	InstallmentFrequencyWeekly    InstallmentFrequency = "WEEKLY"
+	InstallmentFrequencyBiweekly  InstallmentFrequency = "BIWEEKLY"
+	InstallmentFrequencyMonthly   InstallmentFrequency = "MONTHLY"
+	InstallmentFrequencyQuarterly InstallmentFrequency = "QUARTERLY"
+	InstallmentFrequencyYearly    InstallmentFrequency = "YEARLY"
+)
+
+// Validate checks that the frequency is known.
+func (f InstallmentFrequency) Validate() error {
+	if f.PeriodsPerYear() == 0 {
+		return fmt.Errorf("invalid instalment frequency: %s", f)
+	}
+	return nil
+}
+
+// PeriodsPerYear returns how many instalments fall in a year, used to derive the periodic rate from the APR.
+func (f InstallmentFrequency) PeriodsPerYear() int {
+	switch f {
+	case InstallmentFrequencyWeekly:
+		return 52
+	case InstallmentFrequencyBiweekly:
+		return 26
+	case InstallmentFrequencyMonthly:
+		return 12
+	case InstallmentFrequencyQuarterly:
+		return 4
+	case InstallmentFrequencyYearly:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// DueDate returns the due date of the n-th instalment (zero based) counted from first.
+// Month based frequencies keep the day of month of first, clamped to the end of shorter months.
+func (f InstallmentFrequency) DueDate(first time.Time, n int) time.Time {
+	switch f {
+	case InstallmentFrequencyWeekly:
+		return first.AddDate(0, 0, 7*n)
+	case InstallmentFrequencyBiweekly:
+		return first.AddDate(0, 0, 14*n)
+	case InstallmentFrequencyMonthly:
+		return addMonthsClamped(first, n)
+	case InstallmentFrequencyQuarterly:
+		return addMonthsClamped(first, 3*n)
+	case InstallmentFrequencyYearly:
+		return addMonthsClamped(first, 12*n)
+	default:
+		return first
+	}
+}
+
+func addMonthsClamped(t time.Time, months int) time.Time {
+	y, m, d := t.Date()
+	firstOfTarget := time.Date(y, m+time.Month(months), 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+	lastDay := firstOfTarget.AddDate(0, 1, -1).Day()
+	return firstOfTarget.AddDate(0, 0, min(d, lastDay)-1)
+}
+
+// InstallmentStatus is the repayment state of a single amortization entry.
+type InstallmentStatus string
+
+const (
+	InstallmentStatusPaid     InstallmentStatus = "PAID"
+	InstallmentStatusPartial  InstallmentStatus = "PARTIAL"
+	InstallmentStatusOverdue  InstallmentStatus = "OVERDUE"
+	InstallmentStatusUpcoming InstallmentStatus = "UPCOMING"
+)
+
+// AmortizationEntry is one row of a borrowing's amortization table. Amounts are in the
+// borrowing currency's minor units; Payment always equals Principal plus Interest.
+type AmortizationEntry struct {
+	Number           int32
+	DueDate          time.Time
+	Payment          int64
+	Principal        int64
+	Interest         int64
+	RemainingBalance int64 // Principal still owed after this instalment.
+	PaidAmount       int64 // Portion of Payment covered by repayments so far.
+	Status           InstallmentStatus
+}
+
+// BorrowingProgress summarizes where a borrowing stands against its amortization table.
+type BorrowingProgress struct {
+	Schedule        []AmortizationEntry
+	TotalPayable    int64
+	TotalInterest   int64
+	AccruedInterest int64 // Interest earned up to the evaluation date, including the running period pro rata.
+	InterestPaid    int64
+	OverdueCount    int32
+	OverdueAmount   int64
+	NextInstallment *AmortizationEntry
+}
+
+// HasInstallmentPlan reports whether the borrowing is repaid in scheduled instalments.
+func (b *Borrowing) HasInstallmentPlan() bool {
+	return b.InstallmentCount > 0
+}
+
+// firstInstallmentDate defaults to one period after the borrowing was established.
+func (b *Borrowing) firstInstallmentDate() time.Time {
+	if b.FirstInstallmentDate != nil && !b.FirstInstallmentDate.IsZero() {
+		return *b.FirstInstallmentDate
+	}
+	return b.InstallmentFrequency.DueDate(b.EstablishedAt, 1)
+}
+
+func (b *Borrowing) validateInstallmentPlan() error {
+	if err := b.InterestType.Validate(); err != nil {
+		return err
+	}
+	if b.InterestRateBps < 0 {
+		return errors.New("interest rate cannot be negative")
+	}
+	if b.InterestRateBps > 0 && (b.InterestType == "" || b.InterestType == InterestTypeNone) {
+		return errors.New("interest type is required when an interest rate is set")
+	}
+	if b.InstallmentCount < 0 || b.InstallmentCount > MaxBorrowingInstallments {
+		return fmt.Errorf("instalment count must be between 0 and %d", MaxBorrowingInstallments)
+	}
+	if !b.HasInstallmentPlan() {
+		if b.InterestRateBps > 0 {
+			return errors.New("an instalment plan is required to charge interest")
+		}
+		return nil
+	}
+	if err := b.InstallmentFrequency.Validate(); err != nil {
+		return err
+	}
+	if b.FirstInstallmentDate != nil && b.FirstInstallmentDate.Before(b.EstablishedAt) {
+		return errors.New("first instalment date cannot be before the established date")
+	}
+	if b.BudgetID != nil {
+		if err := b.BudgetID.Validate(); err != nil {
+			return fmt.Errorf("validate budget ID: %w", err)
+		}
+	}
+	if b.Direction == BorrowingDirectionBorrowed && b.BudgetID == nil {
+		return errors.New("a budget is required to schedule instalments of a borrowed loan")
+	}
+	return nil
+}
+
+// AmortizationSchedule splits the borrowing into instalments of principal and interest.
+// It returns nil when the borrowing has no instalment plan.
+func (b *Borrowing) AmortizationSchedule() []AmortizationEntry {
+	if !b.HasInstallmentPlan() || b.InstallmentFrequency.Validate() != nil {
+		return nil
+	}
+
+	n := int64(b.InstallmentCount)
+	principal := b.TotalAmount
+	periodicRate := float64(b.InterestRateBps) / 10000 / float64(b.InstallmentFrequency.PeriodsPerYear())
+
+	interestType := b.InterestType
+	if b.InterestRateBps == 0 {
+		interestType = InterestTypeNone
+	}
+
+	var level int64
+	var flatInterest int64
+	switch interestType {
+	case InterestTypeCompound:
+		level = int64(math.Round(float64(principal) * periodicRate / (1 - math.Pow(1+periodicRate, -float64(n)))))
+	case InterestTypeSimple:
+		flatInterest = int64(math.Round(float64(principal) * periodicRate * float64(n)))
+	}
+
+	first := b.firstInstallmentDate()
+	schedule := make([]AmortizationEntry, 0, n)
+	balance := principal
+	for i := range n {
+		last := i == n-1
+		var p, interest int64
+		switch interestType {
+		case InterestTypeCompound:
+			interest = int64(math.Round(float64(balance) * periodicRate))
+			p = level - interest
+		case InterestTypeSimple:
+			interest = flatInterest / n
+			if last {
+				interest = flatInterest - interest*(n-1)
+			}
+			p = principal / n
+		default:
+			p = principal / n
+		}
+		// The final instalment absorbs rounding so the principal is fully repaid.
+		if last || p > balance {
+			p = balance
+		}
+		balance -= p
+		schedule = append(schedule, AmortizationEntry{
+			Number:           int32(i + 1),
+			DueDate:          b.InstallmentFrequency.DueDate(first, int(i)),
+			Payment:          p + interest,
+			Principal:        p,
+			Interest:         interest,
+			RemainingBalance: balance,
+		})
+	}
+	return schedule
+}
+
+// TotalPayable is the principal plus all scheduled interest.
+func (b *Borrowing) TotalPayable() int64 {
+	schedule := b.AmortizationSchedule()
+	if schedule == nil {
+		return b.TotalAmount
+	}
+	var total int64
+	for _, e := range schedule {
+		total += e.Payment
+	}
+	return total
+}
+
+// OutstandingPayable is how much can still be repaid: the remaining principal for ad hoc
+// borrowings, or the unpaid balance of the amortization table for instalment plans.
+func (b *Borrowing) OutstandingPayable() int64 {
+	if !b.HasInstallmentPlan() {
+		return b.RemainingAmount
+	}
+	return max(b.TotalPayable()-b.PaidAmount, 0)
+}
+
+// ApplyRepayment records a repayment (or, with a negative amount, its reversal) and derives
+// the outstanding principal and status. Instalment plans settle instalments in order,
+// interest before principal, so only the principal share reduces RemainingAmount.
+func (b *Borrowing) ApplyRepayment(amount int64) {
+	b.PaidAmount = max(b.PaidAmount+amount, 0)
+
+	schedule := b.AmortizationSchedule()
+	if schedule == nil {
+		b.RemainingAmount = min(max(b.RemainingAmount-amount, 0), b.TotalAmount)
+		if b.RemainingAmount == 0 {
+			b.Status = BorrowingStatusPaidOff
+		} else {
+			b.Status = BorrowingStatusActive
+		}
+		return
+	}
+
+	left := b.PaidAmount
+	var principalPaid, payable int64
+	for _, e := range schedule {
+		payable += e.Payment
+		switch {
+		case left >= e.Payment:
+			principalPaid += e.Principal
+			left -= e.Payment
+		case left > 0:
+			principalPaid += max(left-e.Interest, 0)
+			left = 0
+		}
+	}
+	b.RemainingAmount = b.TotalAmount - principalPaid
+	if b.PaidAmount >= payable {
+		b.RemainingAmount = 0
+		b.Status = BorrowingStatusPaidOff
+	} else {
+		b.Status = BorrowingStatusActive
+	}
+}
+
+// ProgressAt evaluates the amortization table as of now: which instalments are paid, partially
+// paid or overdue, how much interest has accrued and which instalment is due next.
+// It returns nil when the borrowing has no instalment plan.
+func (b *Borrowing) ProgressAt(now time.Time) *BorrowingProgress {
+	schedule := b.AmortizationSchedule()
+	if schedule == nil {
+		return nil
+	}
+
+	today := truncateToDay(now)
+	prog := &BorrowingProgress{Schedule: schedule}
+	left := b.PaidAmount
+	periodStart := b.EstablishedAt
+	for i := range schedule {
+		e := &schedule[i]
+		prog.TotalPayable += e.Payment
+		prog.TotalInterest += e.Interest
+
+		e.PaidAmount = min(left, e.Payment)
+		left -= e.PaidAmount
+		prog.InterestPaid += min(e.PaidAmount, e.Interest)
+
+		// Interest accrues linearly over each instalment period.
+		switch {
+		case !e.DueDate.After(now):
+			prog.AccruedInterest += e.Interest
+		case now.After(periodStart):
+			elapsed := now.Sub(periodStart).Seconds() / e.DueDate.Sub(periodStart).Seconds()
+			prog.AccruedInterest += int64(math.Round(float64(e.Interest) * elapsed))
+		}
+		periodStart = e.DueDate
+
+		switch {
+		case e.PaidAmount == e.Payment:
+			e.Status = InstallmentStatusPaid
+		case e.DueDate.Before(today):
+			e.Status = InstallmentStatusOverdue
+			prog.OverdueCount++
+			prog.OverdueAmount += e.Payment - e.PaidAmount
+		case e.PaidAmount > 0:
+			e.Status = InstallmentStatusPartial
+		default:
+			e.Status = InstallmentStatusUpcoming
+		}
+
+		if prog.NextInstallment == nil && e.Status != InstallmentStatusPaid && e.Status != InstallmentStatusOverdue {
+			prog.NextInstallment = e
+		}
+	}
+	return prog
+}
@@ -0,0 +1,191 @@
+package finance
+
+import (
+	"testing"
+	"time"
+
+	"github.com/masterkeysrd/saturn/internal/platform/id"
+)
+
+func newTestLoan(t *testing.T, interestType InterestType, rateBps int32, count int32) *Borrowing {
+	t.Helper()
+	bID, _ := NewBorrowingID()
+	rawSpace, _ := id.Generate("spc_")
+	budgetID, _ := NewBudgetID()
+	return &Borrowing{
+		ID:                   bID,
+		SpaceID:              SpaceID(rawSpace),
+		Direction:            BorrowingDirectionBorrowed,
+		Counterparty:         "Bank",
+		TotalAmount:          1200000,
+		RemainingAmount:      1200000,
+		Currency:             "USD",
+		Status:               BorrowingStatusActive,
+		EstablishedAt:        time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC),
+		InterestType:         interestType,
+		InterestRateBps:      rateBps,
+		InstallmentCount:     count,
+		InstallmentFrequency: InstallmentFrequencyMonthly,
+		BudgetID:             &budgetID,
+	}
+}
+
+func TestBorrowing_AmortizationSchedule(t *testing.T) {
+	tests := []struct {
+		name          string
+		interestType  InterestType
+		rateBps       int32
+		firstPayment  int64
+		firstInterest int64
+		totalInterest int64
+	}{
+		{name: "no interest", interestType: InterestTypeNone, firstPayment: 100000},
+		{name: "simple", interestType: InterestTypeSimple, rateBps: 1200, firstPayment: 112000, firstInterest: 12000, totalInterest: 144000},
+		{name: "compound", interestType: InterestTypeCompound, rateBps: 1200, firstPayment: 106619, firstInterest: 12000, totalInterest: 79423},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := newTestLoan(t, tt.interestType, tt.rateBps, 12)
+			schedule := b.AmortizationSchedule()
+			if len(schedule) != 12 {
+				t.Fatalf("len(schedule) = %d, want 12", len(schedule))
+			}
+			if schedule[0].Payment != tt.firstPayment || schedule[0].Interest != tt.firstInterest {
+				t.Errorf("first instalment = %d (interest %d), want %d (interest %d)", schedule[0].Payment, schedule[0].Interest, tt.firstPayment, tt.firstInterest)
+			}
+
+			var principal, interest int64
+			for _, e := range schedule {
+				if e.Payment != e.Principal+e.Interest {
+					t.Errorf("instalment %d: payment %d != principal %d + interest %d", e.Number, e.Payment, e.Principal, e.Interest)
+				}
+				principal += e.Principal
+				interest += e.Interest
+			}
+			if principal != b.TotalAmount {
+				t.Errorf("principal repaid = %d, want %d", principal, b.TotalAmount)
+			}
+			if interest != tt.totalInterest {
+				t.Errorf("total interest = %d, want %d", interest, tt.totalInterest)
+			}
+			if last := schedule[11]; last.RemainingBalance != 0 {
+				t.Errorf("final remaining balance = %d, want 0", last.RemainingBalance)
+			}
+		})
+	}
+}
+
+func TestBorrowing_AmortizationSchedule_DueDates(t *testing.T) {
+	b := newTestLoan(t, InterestTypeNone, 0, 3)
+	schedule := b.AmortizationSchedule()
+
+	want := []time.Time{
+		time.Date(2026, 2, 28, 0, 0, 0, 0, time.UTC),
+		time.Date(2026, 3, 28, 0, 0, 0, 0, time.UTC),
+		time.Date(2026, 4, 28, 0, 0, 0, 0, time.UTC),
+	}
+	for i, e := range schedule {
+		if !e.DueDate.Equal(want[i]) {
+			t.Errorf("instalment %d due %s, want %s", e.Number, e.DueDate.Format(time.DateOnly), want[i].Format(time.DateOnly))
+		}
+	}
+
+	first := time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC)
+	b.FirstInstallmentDate = &first
+	if got := b.AmortizationSchedule()[1].DueDate; !got.Equal(time.Date(2026, 2, 28, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("second instalment due %s, want 2026-02-28", got.Format(time.DateOnly))
+	}
+}
+
+func TestBorrowing_ValidateInstallmentPlan(t *testing.T) {
+	tests := []struct {
+		name    string
+		mutate  func(b *Borrowing)
+		wantErr bool
+	}{
+		{name: "valid compound plan", mutate: func(b *Borrowing) {}},
+		{name: "missing frequency", mutate: func(b *Borrowing) { b.InstallmentFrequency = "" }, wantErr: true},
+		{name: "rate without interest type", mutate: func(b *Borrowing) { b.InterestType = InterestTypeNone }, wantErr: true},
+		{name: "interest without plan", mutate: func(b *Borrowing) { b.InstallmentCount = 0 }, wantErr: true},
+		{name: "borrowed plan without budget", mutate: func(b *Borrowing) { b.BudgetID = nil }, wantErr: true},
+		{name: "lent plan without budget", mutate: func(b *Borrowing) {
+			b.BudgetID = nil
+			b.Direction = BorrowingDirectionLent
+		}},
+		{name: "too many instalments", mutate: func(b *Borrowing) { b.InstallmentCount = MaxBorrowingInstallments + 1 }, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := newTestLoan(t, InterestTypeCompound, 1200, 12)
+			tt.mutate(b)
+			if err := b.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestBorrowing_ApplyRepayment(t *testing.T) {
+	b := newTestLoan(t, InterestTypeSimple, 1200, 12)
+
+	// One and a half instalments: 2 × 12000 interest settled, 100000 + 44000 principal.
+	b.ApplyRepayment(168000)
+	if b.RemainingAmount != 1200000-144000 {
+		t.Errorf("RemainingAmount = %d, want %d", b.RemainingAmount, 1200000-144000)
+	}
+	if b.Status != BorrowingStatusActive {
+		t.Errorf("Status = %s, want ACTIVE", b.Status)
+	}
+	if got, want := b.OutstandingPayable(), int64(1344000-168000); got != want {
+		t.Errorf("OutstandingPayable() = %d, want %d", got, want)
+	}
+
+	b.ApplyRepayment(b.OutstandingPayable())
+	if b.RemainingAmount != 0 || b.Status != BorrowingStatusPaidOff {
+		t.Errorf("after full repayment: remaining %d status %s, want 0 PAID_OFF", b.RemainingAmount, b.Status)
+	}
+
+	b.ApplyRepayment(-112000)
+	if b.Status != BorrowingStatusActive || b.RemainingAmount != 100000 {
+		t.Errorf("after reverting last instalment: remaining %d status %s, want 100000 ACTIVE", b.RemainingAmount, b.Status)
+	}
+}
+
+func TestBorrowing_ProgressAt(t *testing.T) {
+	b := newTestLoan(t, InterestTypeSimple, 1200, 12)
+	b.ApplyRepayment(112000 + 50000)
+
+	// Mid-April: February is paid, March is partially paid and overdue.
+	now := time.Date(2026, 4, 13, 12, 0, 0, 0, time.UTC)
+	p := b.ProgressAt(now)
+	if p == nil {
+		t.Fatal("ProgressAt() = nil, want progress")
+	}
+
+	if p.Schedule[0].Status != InstallmentStatusPaid {
+		t.Errorf("instalment 1 status = %s, want PAID", p.Schedule[0].Status)
+	}
+	if p.Schedule[1].Status != InstallmentStatusOverdue || p.Schedule[1].PaidAmount != 50000 {
+		t.Errorf("instalment 2 = %s paid %d, want OVERDUE paid 50000", p.Schedule[1].Status, p.Schedule[1].PaidAmount)
+	}
+	if p.OverdueCount != 1 || p.OverdueAmount != 62000 {
+		t.Errorf("overdue = %d (%d), want 1 (62000)", p.OverdueCount, p.OverdueAmount)
+	}
+	if p.NextInstallment == nil || p.NextInstallment.Number != 3 {
+		t.Errorf("NextInstallment = %+v, want instalment 3", p.NextInstallment)
+	}
+	if p.InterestPaid != 24000 {
+		t.Errorf("InterestPaid = %d, want 24000", p.InterestPaid)
+	}
+	// Two periods fully accrued plus roughly half of the third.
+	if p.AccruedInterest <= 24000 || p.AccruedInterest >= 36000 {
+		t.Errorf("AccruedInterest = %d, want between 24000 and 36000", p.AccruedInterest)
+	}
+
+	adHoc := newTestLoan(t, InterestTypeNone, 0, 0)
+	if adHoc.ProgressAt(now) != nil {
+		t.Error("expected no progress for a borrowing without instalment plan")
+	}
+}
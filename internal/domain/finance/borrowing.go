@@ -99,6 +99,17 @@ type Borrowing struct {
 	AccountID       *AccountID // Transient: used to link a transaction on creation/update
 	CreateTime      time.Time
 	UpdateTime      time.Time
+
+	// Instalment plan. A zero InstallmentCount means the borrowing is repaid ad hoc.
+	InterestType         InterestType
+	InterestRateBps      int32 // Annual percentage rate in basis points (525 = 5.25%).
+	InstallmentCount     int32
+	InstallmentFrequency InstallmentFrequency
+	FirstInstallmentDate *time.Time
+	BudgetID             *BudgetID // Budget charged by the instalment scheduled payments.
+	PaidAmount           int64     // Total repaid so far, interest included.
+
+	Progress *BorrowingProgress // Transient: populated by GetBorrowing for instalment plans.
 }
 
 // Validate checks basic properties of a borrowing.
@@ -129,12 +140,18 @@ func (b *Borrowing) Validate() error {
 	if err := b.Currency.Validate(); err != nil {
 		return fmt.Errorf("validate currency: %w", err)
 	}
+	if err := b.Currency.ValidateAmount(b.TotalAmount); err != nil {
+		return fmt.Errorf("validate total amount: %w", err)
+	}
 	if b.Status != BorrowingStatusActive && b.Status != BorrowingStatusPaidOff {
 		return fmt.Errorf("invalid borrowing status: %s", b.Status)
 	}
 	if b.EstablishedAt.IsZero() {
 		return errors.New("established date is required")
 	}
+	if err := b.validateInstallmentPlan(); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -13,11 +13,16 @@ import (
 type RecurrenceInterval string
 
 const (
-	IntervalWeekly  RecurrenceInterval = "weekly"
-	IntervalMonthly RecurrenceInterval = "monthly"
-	IntervalYearly  RecurrenceInterval = "yearly"
+	IntervalWeekly    RecurrenceInterval = "weekly"
+	IntervalBiweekly  RecurrenceInterval = "biweekly"
+	IntervalMonthly   RecurrenceInterval = "monthly"
+	IntervalQuarterly RecurrenceInterval = "quarterly"
+	IntervalYearly    RecurrenceInterval = "yearly"
 )
 
+// MaxPeriodStartDay is the latest day of month a period may start on, so every month contains it.
+const MaxPeriodStartDay = 28
+
 type LimitPropagation string
 
 const (
@@ -25,6 +30,66 @@ const (
 	PropagationCurrentPeriod   LimitPropagation = "current_period"
 )
 
+// RolloverPolicy controls how a period's remaining balance flows into the next period.
+type RolloverPolicy string
+
+const (
+	RolloverNone         RolloverPolicy = "none"
+	RolloverCarrySurplus RolloverPolicy = "carry_surplus"
+	RolloverCarryDeficit RolloverPolicy = "carry_deficit"
+	RolloverCarryBoth    RolloverPolicy = "carry_both"
+)
+
+// Validate checks that the policy is a known value.
+func (p RolloverPolicy) Validate() error {
+	switch p {
+	case RolloverNone, RolloverCarrySurplus, RolloverCarryDeficit, RolloverCarryBoth:
+		return nil
+	default:
+		return fmt.Errorf("invalid rollover policy %q: must be none, carry_surplus, carry_deficit, or carry_both", p)
+	}
+}
+
+// Carry returns the signed amount to carry into the next period given the closing period's remaining balance
+// (effective limit minus spent). A positive result is a surplus, a negative one a deficit. A non-zero ceiling
+// bounds the absolute carried amount.
+func (p RolloverPolicy) Carry(remaining, ceiling int64) int64 {
+	var carry int64
+	switch p {
+	case RolloverCarrySurplus:
+		carry = max(remaining, 0)
+	case RolloverCarryDeficit:
+		carry = min(remaining, 0)
+	case RolloverCarryBoth:
+		carry = remaining
+	default:
+		return 0
+	}
+	if ceiling > 0 {
+		carry = max(min(carry, ceiling), -ceiling)
+	}
+	return carry
+}
+
+// carryAcross returns the balance rolled from prev into next given what remained of prev's effective limit.
+// Cycles between the two that never got a period had nothing spent, so their whole limit rolls on as well.
+// Balances are not converted across currencies.
+func (b *Budget) carryAcross(prev *BudgetPeriod, remaining int64, next *BudgetPeriod, loc *time.Location) int64 {
+	if prev.Currency != next.Currency {
+		return 0
+	}
+	carry := b.RolloverPolicy.Carry(remaining, b.RolloverCap)
+	for start := prev.EndDate.Add(time.Second); start.Before(next.StartDate); {
+		_, end := b.CalculateBoundsIn(start, loc)
+		if !end.Before(next.StartDate) {
+			break
+		}
+		carry = b.RolloverPolicy.Carry(b.LimitAmount+carry, b.RolloverCap)
+		start = end.Add(time.Second)
+	}
+	return carry
+}
+
 // BudgetID is a custom string type representing a budget's unique identifier (KSUID).
 type BudgetID string
 
@@ -78,6 +143,11 @@ type Budget struct {
 	Icon             string
 	Color            string
 	DefaultAccountID *AccountID // Nullable default account for spending
+	RolloverPolicy   RolloverPolicy
+	RolloverCap      int64      // Maximum absolute amount carried between periods; zero means uncapped
+	PeriodStartDay   int        // Day of month (1-28) on which monthly, quarterly and yearly periods begin
+	WeekStart        int        // ISO weekday (1 = Monday ... 7 = Sunday) on which weekly periods begin
+	PeriodAnchor     *time.Time // First day of any bi-weekly period; required for bi-weekly budgets
 	Version          int64
 	CreateTime       time.Time
 	UpdateTime       time.Time
@@ -121,11 +191,41 @@ func (b *Budget) Validate() error {
 	if err := b.Currency.Validate(); err != nil {
 		return fmt.Errorf("validate currency: %w", err)
 	}
+	if err := b.Currency.ValidateAmount(b.LimitAmount); err != nil {
+		return fmt.Errorf("validate limit amount: %w", err)
+	}
 	switch b.Interval {
-	case IntervalWeekly, IntervalMonthly, IntervalYearly:
+	case IntervalWeekly, IntervalBiweekly, IntervalMonthly, IntervalQuarterly, IntervalYearly:
 		// Valid
 	default:
-		return fmt.Errorf("invalid interval %q: must be weekly, monthly, or yearly", b.Interval)
+		return fmt.Errorf("invalid interval %q: must be weekly, biweekly, monthly, quarterly, or yearly", b.Interval)
+	}
+	if b.PeriodStartDay == 0 {
+		b.PeriodStartDay = 1
+	}
+	if b.PeriodStartDay < 1 || b.PeriodStartDay > MaxPeriodStartDay {
+		return fmt.Errorf("period start day must be between 1 and %d", MaxPeriodStartDay)
+	}
+	if b.WeekStart == 0 {
+		b.WeekStart = int(time.Monday)
+	}
+	if b.WeekStart < 1 || b.WeekStart > 7 {
+		return errors.New("week start must be an ISO weekday between 1 (Monday) and 7 (Sunday)")
+	}
+	if b.Interval == IntervalBiweekly && b.PeriodAnchor == nil {
+		return errors.New("biweekly budgets require a period anchor date")
+	}
+	if b.RolloverPolicy == "" {
+		b.RolloverPolicy = RolloverNone
+	}
+	if err := b.RolloverPolicy.Validate(); err != nil {
+		return err
+	}
+	if b.RolloverCap < 0 {
+		return errors.New("rollover cap must not be negative")
+	}
+	if err := b.Currency.ValidateAmount(b.RolloverCap); err != nil {
+		return fmt.Errorf("validate rollover cap: %w", err)
 	}
 	b.Icon = strings.TrimSpace(b.Icon)
 	if b.Icon == "" {
@@ -151,30 +251,79 @@ func (b *Budget) Validate() error {
 
 // CalculateBounds computes the start and end time boundaries around a given date in UTC.
 func (b *Budget) CalculateBounds(t time.Time) (time.Time, time.Time) {
-	t = t.UTC()
+	return b.CalculateBoundsIn(t, time.UTC)
+}
+
+// CalculateBoundsIn computes the period boundaries around an instant using calendar days in loc.
+// Both bounds are returned in UTC; the end is one second before the next period starts.
+func (b *Budget) CalculateBoundsIn(t time.Time, loc *time.Location) (time.Time, time.Time) {
+	if loc == nil {
+		loc = time.UTC
+	}
+	t = t.In(loc)
+	day := max(b.PeriodStartDay, 1)
+
+	var start, next time.Time
 	switch b.Interval {
 	case IntervalWeekly:
-		// Go back to Monday
-		offset := int(t.Weekday()) - int(time.Monday)
-		if offset < 0 {
-			offset += 7
+		start = startOfWeek(t, b.WeekStart)
+		next = start.AddDate(0, 0, 7)
+
+	case IntervalBiweekly:
+		anchor := t
+		if b.PeriodAnchor != nil {
+			anchor = b.PeriodAnchor.In(loc)
+		}
+		// Count whole calendar days between the anchor and t so DST shifts don't skew the cycle.
+		anchorDay := time.Date(anchor.Year(), anchor.Month(), anchor.Day(), 0, 0, 0, 0, time.UTC)
+		targetDay := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+		days := int(targetDay.Sub(anchorDay).Hours() / 24)
+		cycle := days / 14
+		if days < 0 && days%14 != 0 {
+			cycle--
+		}
+		start = time.Date(anchor.Year(), anchor.Month(), anchor.Day()+cycle*14, 0, 0, 0, 0, loc)
+		next = time.Date(anchor.Year(), anchor.Month(), anchor.Day()+(cycle+1)*14, 0, 0, 0, 0, loc)
+
+	case IntervalQuarterly:
+		quarterMonth := time.Month((int(t.Month())-1)/3*3 + 1)
+		start = time.Date(t.Year(), quarterMonth, day, 0, 0, 0, 0, loc)
+		if t.Before(start) {
+			start = start.AddDate(0, -3, 0)
 		}
-		start := time.Date(t.Year(), t.Month(), t.Day()-offset, 0, 0, 0, 0, time.UTC)
-		end := start.AddDate(0, 0, 7).Add(-time.Second)
-		return start, end
+		next = start.AddDate(0, 3, 0)
 
 	case IntervalYearly:
-		start := time.Date(t.Year(), 1, 1, 0, 0, 0, 0, time.UTC)
-		end := start.AddDate(1, 0, 0).Add(-time.Second)
-		return start, end
+		start = time.Date(t.Year(), time.January, day, 0, 0, 0, 0, loc)
+		if t.Before(start) {
+			start = start.AddDate(-1, 0, 0)
+		}
+		next = start.AddDate(1, 0, 0)
 
 	case IntervalMonthly:
 		fallthrough
 	default:
-		start := time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
-		end := start.AddDate(0, 1, 0).Add(-time.Second)
-		return start, end
+		start = time.Date(t.Year(), t.Month(), day, 0, 0, 0, 0, loc)
+		if t.Before(start) {
+			start = start.AddDate(0, -1, 0)
+		}
+		next = start.AddDate(0, 1, 0)
+	}
+
+	return start.UTC(), next.Add(-time.Second).UTC()
+}
+
+// startOfWeek returns local midnight of the most recent ISO weekday (1 = Monday ... 7 = Sunday) on or before t.
+func startOfWeek(t time.Time, isoWeekday int) time.Time {
+	weekStart := time.Monday
+	if isoWeekday >= 1 && isoWeekday <= 7 {
+		weekStart = time.Weekday(isoWeekday % 7)
+	}
+	offset := int(t.Weekday()) - int(weekStart)
+	if offset < 0 {
+		offset += 7
 	}
+	return time.Date(t.Year(), t.Month(), t.Day()-offset, 0, 0, 0, 0, t.Location())
 }
 
 // BudgetPatchSchema defines all patchable fields for a Budget entity.
@@ -186,7 +335,12 @@ var BudgetPatchSchema = patch.NewSchema[Budget]().
 	Register("is_active", patch.Field(func(b *Budget) *bool { return &b.IsActive })).
 	Register("icon", patch.Field(func(b *Budget) *string { return &b.Icon })).
 	Register("color", patch.Field(func(b *Budget) *string { return &b.Color })).
-	Register("default_account_id", patch.Field(func(b *Budget) **AccountID { return &b.DefaultAccountID }))
+	Register("default_account_id", patch.Field(func(b *Budget) **AccountID { return &b.DefaultAccountID })).
+	Register("rollover_policy", patch.Field(func(b *Budget) *RolloverPolicy { return &b.RolloverPolicy })).
+	Register("rollover_cap", patch.Field(func(b *Budget) *int64 { return &b.RolloverCap })).
+	Register("period_start_day", patch.Field(func(b *Budget) *int { return &b.PeriodStartDay })).
+	Register("week_start", patch.Field(func(b *Budget) *int { return &b.WeekStart })).
+	Register("period_anchor", patch.Field(func(b *Budget) **time.Time { return &b.PeriodAnchor }))
 
 // ApplyPatch applies partial updates from an incoming budget based on the field mask.
 func (b *Budget) ApplyPatch(incoming *Budget, mask []string) error {
@@ -100,3 +100,127 @@ func TestBudget_ApplyPatch(t *testing.T) {
 		}
 	})
 }
+
+func TestRolloverPolicy_Carry(t *testing.T) {
+	tests := []struct {
+		name      string
+		policy    finance.RolloverPolicy
+		remaining int64
+		ceiling   int64
+		want      int64
+	}{
+		{name: "none ignores surplus", policy: finance.RolloverNone, remaining: 2500, want: 0},
+		{name: "none ignores deficit", policy: finance.RolloverNone, remaining: -2500, want: 0},
+		{name: "surplus carries unspent", policy: finance.RolloverCarrySurplus, remaining: 2500, want: 2500},
+		{name: "surplus drops deficit", policy: finance.RolloverCarrySurplus, remaining: -2500, want: 0},
+		{name: "deficit carries overspend", policy: finance.RolloverCarryDeficit, remaining: -2500, want: -2500},
+		{name: "deficit drops surplus", policy: finance.RolloverCarryDeficit, remaining: 2500, want: 0},
+		{name: "both carries surplus", policy: finance.RolloverCarryBoth, remaining: 2500, want: 2500},
+		{name: "both carries deficit", policy: finance.RolloverCarryBoth, remaining: -2500, want: -2500},
+		{name: "cap bounds surplus", policy: finance.RolloverCarryBoth, remaining: 2500, ceiling: 1000, want: 1000},
+		{name: "cap bounds deficit", policy: finance.RolloverCarryBoth, remaining: -2500, ceiling: 1000, want: -1000},
+		{name: "cap above remaining is a no-op", policy: finance.RolloverCarrySurplus, remaining: 500, ceiling: 1000, want: 500},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.policy.Carry(tt.remaining, tt.ceiling); got != tt.want {
+				t.Errorf("Carry(%d, %d) = %d, want %d", tt.remaining, tt.ceiling, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBudget_ValidateRollover(t *testing.T) {
+	budgetID, _ := finance.NewBudgetID()
+	base := finance.Budget{
+		ID:          budgetID,
+		SpaceID:     finance.SpaceID("spc_2dE1V8ZqWz4eS2N9yX3bL1mK7pO"),
+		Name:        "Dining",
+		LimitAmount: 30000,
+		Currency:    finance.Currency("USD"),
+		Interval:    finance.IntervalMonthly,
+	}
+
+	t.Run("defaults to none", func(t *testing.T) {
+		b := base
+		if err := b.Validate(); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if b.RolloverPolicy != finance.RolloverNone {
+			t.Errorf("RolloverPolicy = %q, want %q", b.RolloverPolicy, finance.RolloverNone)
+		}
+	})
+
+	t.Run("rejects unknown policy", func(t *testing.T) {
+		b := base
+		b.RolloverPolicy = "carry_everything"
+		if err := b.Validate(); err == nil {
+			t.Fatal("expected error for unknown rollover policy")
+		}
+	})
+
+	t.Run("rejects negative cap", func(t *testing.T) {
+		b := base
+		b.RolloverPolicy = finance.RolloverCarrySurplus
+		b.RolloverCap = -1
+		if err := b.Validate(); err == nil {
+			t.Fatal("expected error for negative rollover cap")
+		}
+	})
+}
+
+func TestBudget_ValidatePeriodConfig(t *testing.T) {
+	budgetID, _ := finance.NewBudgetID()
+	base := finance.Budget{
+		ID:          budgetID,
+		SpaceID:     finance.SpaceID("spc_2dE1V8ZqWz4eS2N9yX3bL1mK7pO"),
+		Name:        "Paycheck",
+		LimitAmount: 120000,
+		Currency:    finance.Currency("USD"),
+		Interval:    finance.IntervalMonthly,
+	}
+
+	t.Run("defaults start day and week start", func(t *testing.T) {
+		b := base
+		if err := b.Validate(); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if b.PeriodStartDay != 1 {
+			t.Errorf("PeriodStartDay = %d, want 1", b.PeriodStartDay)
+		}
+		if b.WeekStart != int(time.Monday) {
+			t.Errorf("WeekStart = %d, want %d", b.WeekStart, time.Monday)
+		}
+	})
+
+	t.Run("rejects start day past the 28th", func(t *testing.T) {
+		b := base
+		b.PeriodStartDay = 31
+		if err := b.Validate(); err == nil {
+			t.Fatal("expected error for period start day 31")
+		}
+	})
+
+	t.Run("rejects invalid week start", func(t *testing.T) {
+		b := base
+		b.Interval = finance.IntervalWeekly
+		b.WeekStart = 8
+		if err := b.Validate(); err == nil {
+			t.Fatal("expected error for week start 8")
+		}
+	})
+
+	t.Run("biweekly requires anchor", func(t *testing.T) {
+		b := base
+		b.Interval = finance.IntervalBiweekly
+		if err := b.Validate(); err == nil {
+			t.Fatal("expected error for biweekly budget without anchor")
+		}
+		anchor := time.Date(2026, 1, 9, 0, 0, 0, 0, time.UTC)
+		b.PeriodAnchor = &anchor
+		if err := b.Validate(); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+}
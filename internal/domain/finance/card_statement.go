@@ -0,0 +1,264 @@
+package finance
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/masterkeysrd/saturn/internal/platform/id"
+)
+
+// SourceTypeCardStatement marks scheduled payments generated for a closed credit card statement.
+const SourceTypeCardStatement = "card_statement"
+
+// CardStatementID is a custom string type representing a card statement's unique identifier (KSUID).
+type CardStatementID string
+
+const cardStatementPrefix = "cst_"
+
+// NewCardStatementID creates a new CardStatementID using the default ID generator.
+func NewCardStatementID() (CardStatementID, error) {
+	raw, err := id.Generate(cardStatementPrefix)
+	if err != nil {
+		return "", err
+	}
+	return CardStatementID(raw), nil
+}
+
+// ParseCardStatementID parses a string into a CardStatementID and validates it.
+func ParseCardStatementID(s string) (CardStatementID, error) {
+	if err := id.Validate(s, cardStatementPrefix); err != nil {
+		return "", fmt.Errorf("invalid card statement ID: %w", err)
+	}
+	return CardStatementID(s), nil
+}
+
+// String returns the string representation.
+func (cid CardStatementID) String() string {
+	return string(cid)
+}
+
+// Validate checks if the CardStatementID is valid.
+func (cid CardStatementID) Validate() error {
+	return id.Validate(string(cid), cardStatementPrefix)
+}
+
+// CardStatementStatus tracks how much of a statement balance has been paid.
+type CardStatementStatus string
+
+const (
+	CardStatementStatusIssued        CardStatementStatus = "ISSUED"
+	CardStatementStatusPartiallyPaid CardStatementStatus = "PARTIALLY_PAID"
+	CardStatementStatusPaid          CardStatementStatus = "PAID"
+)
+
+// CardStatement is the snapshot of a credit card billing cycle taken on its closing date.
+// Balances follow the card convention: positive amounts are debt owed.
+type CardStatement struct {
+	ID                 CardStatementID
+	SpaceID            SpaceID
+	AccountID          AccountID
+	Currency           Currency
+	PeriodStart        time.Time // First instant covered by the cycle
+	ClosingDate        time.Time // Calendar day the cycle closed, in the space timezone
+	DueDate            time.Time
+	OpeningBalance     int64
+	Purchases          int64 // Charges posted during the cycle
+	Credits            int64 // Payments and refunds posted during the cycle
+	StatementBalance   int64
+	MinimumPayment     int64
+	PaidAmount         int64 // Payments applied to this statement after it closed
+	CreditLimit        int64
+	Utilization        float64 // Statement balance as a percentage of the credit limit
+	Status             CardStatementStatus
+	ScheduledPaymentID *ScheduledPaymentID // Nullable; nil when nothing was owed
+	CreateTime         time.Time
+	UpdateTime         time.Time
+}
+
+// Validate checks the statement's business rules.
+func (cs *CardStatement) Validate() error {
+	if err := cs.ID.Validate(); err != nil {
+		return fmt.Errorf("validate card statement ID: %w", err)
+	}
+	if err := cs.SpaceID.Validate(); err != nil {
+		return fmt.Errorf("validate space ID: %w", err)
+	}
+	if err := cs.AccountID.Validate(); err != nil {
+		return fmt.Errorf("validate account ID: %w", err)
+	}
+	if err := cs.Currency.Validate(); err != nil {
+		return fmt.Errorf("validate currency: %w", err)
+	}
+	if cs.ClosingDate.IsZero() || cs.DueDate.IsZero() {
+		return errors.New("closing date and due date are required")
+	}
+	if cs.DueDate.Before(cs.ClosingDate) {
+		return errors.New("due date must not be before the closing date")
+	}
+	if cs.MinimumPayment < 0 || cs.PaidAmount < 0 {
+		return errors.New("minimum payment and paid amount cannot be negative")
+	}
+	switch cs.Status {
+	case CardStatementStatusIssued, CardStatementStatusPartiallyPaid, CardStatementStatusPaid:
+	default:
+		return fmt.Errorf("invalid card statement status: %q", cs.Status)
+	}
+	return nil
+}
+
+// RemainingDue returns the part of the statement balance not yet paid.
+func (cs *CardStatement) RemainingDue() int64 {
+	return max(cs.StatementBalance-cs.PaidAmount, 0)
+}
+
+// ApplyPayment settles up to amount against the statement and returns the part applied.
+// A negative amount reverts earlier payments, bounded by what was paid.
+func (cs *CardStatement) ApplyPayment(amount int64) int64 {
+	applied := amount
+	if amount > 0 {
+		applied = min(amount, cs.RemainingDue())
+	} else {
+		applied = -min(-amount, cs.PaidAmount)
+	}
+	cs.PaidAmount += applied
+	cs.refreshStatus()
+	return applied
+}
+
+func (cs *CardStatement) refreshStatus() {
+	switch {
+	case cs.RemainingDue() == 0:
+		cs.Status = CardStatementStatusPaid
+	case cs.PaidAmount > 0:
+		cs.Status = CardStatementStatusPartiallyPaid
+	default:
+		cs.Status = CardStatementStatusIssued
+	}
+}
+
+// MaxMinimumPaymentBps caps the minimum payment percentage at the full statement balance.
+const MaxMinimumPaymentBps = 10000
+
+// HasStatementCycle reports whether the account is a credit card with a configured billing cycle.
+func (a *Account) HasStatementCycle() bool {
+	return a.Type == AccountTypeCreditCard && a.StatementClosingDay > 0
+}
+
+// Utilization returns the current balance as a percentage of the credit limit.
+// Accounts without a limit, or with a credit balance, report zero.
+func (a *Account) Utilization() float64 {
+	if a.Type != AccountTypeCreditCard || a.CreditLimit <= 0 || a.CurrentBalance <= 0 {
+		return 0
+	}
+	return float64(a.CurrentBalance) / float64(a.CreditLimit) * 100.0
+}
+
+// validateStatementCycle checks the billing cycle settings of a credit card.
+func (a *Account) validateStatementCycle() error {
+	if a.StatementClosingDay < 0 || a.StatementClosingDay > 31 {
+		return errors.New("statement closing day must be between 1 and 31")
+	}
+	if a.PaymentDueDay < 0 || a.PaymentDueDay > 31 {
+		return errors.New("payment due day must be between 1 and 31")
+	}
+	if a.MinimumPaymentBps < 0 || a.MinimumPaymentBps > MaxMinimumPaymentBps {
+		return errors.New("minimum payment percentage must be between 0 and 100")
+	}
+	if a.MinimumPaymentFloor < 0 {
+		return errors.New("minimum payment floor cannot be negative")
+	}
+	if err := a.Currency.ValidateAmount(a.MinimumPaymentFloor); err != nil {
+		return fmt.Errorf("validate minimum payment floor: %w", err)
+	}
+	if a.StatementClosingDay == 0 {
+		return nil
+	}
+	if a.PaymentDueDay == 0 {
+		return errors.New("payment due day is required when a statement closing day is set")
+	}
+	if a.PaymentBudgetID == nil || *a.PaymentBudgetID == "" {
+		return errors.New("a payment budget is required when a statement closing day is set")
+	}
+	if err := a.PaymentBudgetID.Validate(); err != nil {
+		return fmt.Errorf("validate payment budget ID: %w", err)
+	}
+	return nil
+}
+
+// clearStatementCycle drops billing cycle settings from accounts that are not credit cards.
+func (a *Account) clearStatementCycle() {
+	a.StatementClosingDay = 0
+	a.PaymentDueDay = 0
+	a.MinimumPaymentBps = 0
+	a.MinimumPaymentFloor = 0
+	a.PaymentBudgetID = nil
+}
+
+// LastClosingDate returns the most recent statement closing day that has fully elapsed at now,
+// expressed as midnight in loc. Closing days past the end of a month fall on its last day.
+func (a *Account) LastClosingDate(now time.Time, loc *time.Location) time.Time {
+	now = now.In(loc)
+	closing := dayInMonth(now.Year(), now.Month(), int(a.StatementClosingDay), loc)
+	if !now.Before(closing.AddDate(0, 0, 1)) {
+		return closing
+	}
+	prev := time.Date(now.Year(), now.Month()-1, 1, 0, 0, 0, 0, loc)
+	return dayInMonth(prev.Year(), prev.Month(), int(a.StatementClosingDay), loc)
+}
+
+// PreviousClosingDate returns the closing day of the cycle before the one closing on closing.
+func (a *Account) PreviousClosingDate(closing time.Time) time.Time {
+	prev := time.Date(closing.Year(), closing.Month()-1, 1, 0, 0, 0, 0, closing.Location())
+	return dayInMonth(prev.Year(), prev.Month(), int(a.StatementClosingDay), closing.Location())
+}
+
+// PaymentDueDate returns the first payment due day after the closing day.
+func (a *Account) PaymentDueDate(closing time.Time) time.Time {
+	due := dayInMonth(closing.Year(), closing.Month(), int(a.PaymentDueDay), closing.Location())
+	if due.After(closing) {
+		return due
+	}
+	next := time.Date(closing.Year(), closing.Month()+1, 1, 0, 0, 0, 0, closing.Location())
+	return dayInMonth(next.Year(), next.Month(), int(a.PaymentDueDay), closing.Location())
+}
+
+// MinimumPaymentFor returns the minimum payment due on a statement balance: the configured
+// percentage rounded up, raised to the floor and never more than the balance itself.
+func (a *Account) MinimumPaymentFor(balance int64) int64 {
+	if balance <= 0 {
+		return 0
+	}
+	minimum := (balance*int64(a.MinimumPaymentBps) + MaxMinimumPaymentBps - 1) / MaxMinimumPaymentBps
+	minimum = max(minimum, a.MinimumPaymentFloor)
+	if minimum == 0 {
+		// Cards without a minimum rule owe the full statement.
+		minimum = balance
+	}
+	return min(minimum, balance)
+}
+
+// dayInMonth returns midnight of the given day in loc, clamped to the last day of the month.
+func dayInMonth(year int, month time.Month, day int, loc *time.Location) time.Time {
+	last := time.Date(year, month+1, 0, 0, 0, 0, 0, loc).Day()
+	return time.Date(year, month, min(day, last), 0, 0, 0, 0, loc)
+}
+
+// cardBalanceDelta returns how a transaction moves a credit card balance (positive = more debt),
+// mirroring adjustAccountBalance.
+func cardBalanceDelta(t *Transaction) int64 {
+	switch t.Type {
+	case TransactionTypeExpense, TransactionTypeTransferOut, TransactionTypeBalanceAdjustment:
+		return t.Amount
+	case TransactionTypeIncome, TransactionTypeTransferIn:
+		return -t.Amount
+	default:
+		return 0
+	}
+}
+
+// ListCardStatementsFilter encapsulates filtering parameters for listing card statements.
+type ListCardStatementsFilter struct {
+	UnpaidOnly bool
+	PageSize   int32
+}
@@ -0,0 +1,178 @@
+package finance
+
+import (
+	"testing"
+	"time"
+
+	"github.com/masterkeysrd/saturn/internal/platform/id"
+)
+
+func newTestCard(t *testing.T, closingDay, dueDay int32) *Account {
+	t.Helper()
+	aID, _ := NewAccountID()
+	rawSpace, _ := id.Generate("spc_")
+	budgetID, _ := NewBudgetID()
+	return &Account{
+		ID:                  aID,
+		SpaceID:             SpaceID(rawSpace),
+		Name:                "Visa",
+		Type:                AccountTypeCreditCard,
+		Currency:            "USD",
+		CreditLimit:         500000,
+		IsActive:            true,
+		StatementClosingDay: closingDay,
+		PaymentDueDay:       dueDay,
+		MinimumPaymentBps:   300,
+		MinimumPaymentFloor: 2500,
+		PaymentBudgetID:     &budgetID,
+	}
+}
+
+func TestAccount_ValidateStatementCycle(t *testing.T) {
+	tests := []struct {
+		name    string
+		mutate  func(a *Account)
+		wantErr bool
+	}{
+		{name: "valid cycle", mutate: func(a *Account) {}},
+		{name: "cycle disabled", mutate: func(a *Account) {
+			a.StatementClosingDay = 0
+			a.PaymentDueDay = 0
+			a.PaymentBudgetID = nil
+		}},
+		{name: "closing day out of range", mutate: func(a *Account) { a.StatementClosingDay = 32 }, wantErr: true},
+		{name: "missing due day", mutate: func(a *Account) { a.PaymentDueDay = 0 }, wantErr: true},
+		{name: "missing payment budget", mutate: func(a *Account) { a.PaymentBudgetID = nil }, wantErr: true},
+		{name: "minimum above 100 percent", mutate: func(a *Account) { a.MinimumPaymentBps = 10001 }, wantErr: true},
+		{name: "negative minimum floor", mutate: func(a *Account) { a.MinimumPaymentFloor = -1 }, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := newTestCard(t, 25, 15)
+			tt.mutate(a)
+			if err := a.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	t.Run("cleared for non-card accounts", func(t *testing.T) {
+		a := newTestCard(t, 25, 15)
+		a.Type = AccountTypeBank
+		if err := a.Validate(); err != nil {
+			t.Fatalf("Validate() error = %v", err)
+		}
+		if a.StatementClosingDay != 0 || a.PaymentBudgetID != nil {
+			t.Errorf("billing cycle kept on a bank account: closing day %d, budget %v", a.StatementClosingDay, a.PaymentBudgetID)
+		}
+	})
+}
+
+func TestAccount_StatementDates(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("timezone data unavailable: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		closingDay  int32
+		dueDay      int32
+		now         time.Time
+		wantClosing string
+		wantDue     string
+	}{
+		{
+			name:       "closing day elapsed this month",
+			closingDay: 25, dueDay: 15,
+			now:         time.Date(2026, 3, 27, 12, 0, 0, 0, time.UTC),
+			wantClosing: "2026-03-25", wantDue: "2026-04-15",
+		},
+		{
+			name:       "closing day still running in the space timezone",
+			closingDay: 25, dueDay: 15,
+			now:         time.Date(2026, 3, 26, 3, 0, 0, 0, time.UTC), // 23:00 on the 25th in New York
+			wantClosing: "2026-02-25", wantDue: "2026-03-15",
+		},
+		{
+			name:       "closing day clamped to short month",
+			closingDay: 31, dueDay: 25,
+			now:         time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC),
+			wantClosing: "2026-02-28", wantDue: "2026-03-25",
+		},
+		{
+			name:       "due day later in the closing month",
+			closingDay: 1, dueDay: 20,
+			now:         time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC),
+			wantClosing: "2026-03-01", wantDue: "2026-03-20",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := newTestCard(t, tt.closingDay, tt.dueDay)
+			closing := a.LastClosingDate(tt.now, loc)
+			if got := closing.Format(time.DateOnly); got != tt.wantClosing {
+				t.Errorf("LastClosingDate() = %s, want %s", got, tt.wantClosing)
+			}
+			if got := a.PaymentDueDate(closing).Format(time.DateOnly); got != tt.wantDue {
+				t.Errorf("PaymentDueDate() = %s, want %s", got, tt.wantDue)
+			}
+		})
+	}
+}
+
+func TestAccount_MinimumPaymentFor(t *testing.T) {
+	tests := []struct {
+		name    string
+		bps     int32
+		floor   int64
+		balance int64
+		want    int64
+	}{
+		{name: "percentage rounded up", bps: 300, floor: 2500, balance: 123456, want: 3704},
+		{name: "floor applies", bps: 300, floor: 2500, balance: 50000, want: 2500},
+		{name: "never above balance", bps: 300, floor: 2500, balance: 1000, want: 1000},
+		{name: "no rule owes the full balance", balance: 80000, want: 80000},
+		{name: "credit balance owes nothing", bps: 300, floor: 2500, balance: -5000, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := newTestCard(t, 25, 15)
+			a.MinimumPaymentBps = tt.bps
+			a.MinimumPaymentFloor = tt.floor
+			if got := a.MinimumPaymentFor(tt.balance); got != tt.want {
+				t.Errorf("MinimumPaymentFor(%d) = %d, want %d", tt.balance, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAccount_Utilization(t *testing.T) {
+	a := newTestCard(t, 25, 15)
+	a.CurrentBalance = 125000
+	if got := a.Utilization(); got != 25 {
+		t.Errorf("Utilization() = %v, want 25", got)
+	}
+
+	a.CreditLimit = 0
+	if got := a.Utilization(); got != 0 {
+		t.Errorf("Utilization() without limit = %v, want 0", got)
+	}
+}
+
+func TestCardStatement_ApplyPayment(t *testing.T) {
+	st := &CardStatement{StatementBalance: 100000, Status: CardStatementStatusIssued}
+
+	if applied := st.ApplyPayment(40000); applied != 40000 || st.Status != CardStatementStatusPartiallyPaid {
+		t.Errorf("partial payment applied %d status %s, want 40000 PARTIALLY_PAID", applied, st.Status)
+	}
+	if applied := st.ApplyPayment(90000); applied != 60000 || st.Status != CardStatementStatusPaid {
+		t.Errorf("overpayment applied %d status %s, want 60000 PAID", applied, st.Status)
+	}
+	if applied := st.ApplyPayment(-150000); applied != -100000 || st.Status != CardStatementStatusIssued || st.PaidAmount != 0 {
+		t.Errorf("reversal applied %d status %s paid %d, want -100000 ISSUED 0", applied, st.Status, st.PaidAmount)
+	}
+}
@@ -0,0 +1,144 @@
+package finance
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/masterkeysrd/saturn/internal/platform/id"
+)
+
+// CategoryID is a custom string type representing a category's unique identifier (KSUID).
+type CategoryID string
+
+// NewCategoryID creates a new CategoryID using the default ID generator.
+func NewCategoryID() (CategoryID, error) {
+	raw, err := id.Generate(categoryPrefix)
+	if err != nil {
+		return "", err
+	}
+	return CategoryID(raw), nil
+}
+
+// ParseCategoryID parses a string into a CategoryID and validates it.
+func ParseCategoryID(s string) (CategoryID, error) {
+	if err := id.Validate(s, categoryPrefix); err != nil {
+		return "", fmt.Errorf("invalid category ID: %w", err)
+	}
+	return CategoryID(s), nil
+}
+
+// String returns the string representation.
+func (cid CategoryID) String() string {
+	return string(cid)
+}
+
+// Validate checks if the CategoryID is valid.
+func (cid CategoryID) Validate() error {
+	return id.Validate(string(cid), categoryPrefix)
+}
+
+const categoryPrefix = "cat_"
+
+// MaxCategoryDepth bounds how deeply categories can be nested below a top-level category.
+const MaxCategoryDepth = 5
+
+// Category classifies transactions independently of budgets. Categories form a tree via ParentID.
+type Category struct {
+	ID         CategoryID
+	SpaceID    SpaceID
+	ParentID   *CategoryID // Nullable; nil for top-level categories
+	Name       string
+	Icon       string
+	Color      string
+	CreateTime time.Time
+	UpdateTime time.Time
+}
+
+// Validate checks the category's business rules.
+func (c *Category) Validate() error {
+	c.Name = strings.TrimSpace(c.Name)
+	if c.Name == "" {
+		return errors.New("category name is required")
+	}
+	if len(c.Name) > 255 {
+		return errors.New("category name must not exceed 255 characters")
+	}
+	c.Icon = strings.TrimSpace(c.Icon)
+	if c.Icon == "" {
+		c.Icon = "tag"
+	}
+	c.Color = strings.TrimSpace(c.Color)
+	if c.Color == "" {
+		c.Color = "slate"
+	}
+	if err := c.ID.Validate(); err != nil {
+		return fmt.Errorf("validate category ID: %w", err)
+	}
+	if err := c.SpaceID.Validate(); err != nil {
+		return fmt.Errorf("validate space ID: %w", err)
+	}
+	if c.ParentID != nil {
+		if err := c.ParentID.Validate(); err != nil {
+			return fmt.Errorf("validate parent category ID: %w", err)
+		}
+		if *c.ParentID == c.ID {
+			return errors.New("category cannot be its own parent")
+		}
+	}
+	return nil
+}
+
+// DefaultCategorySortField represents the fallback sorting column name for categories.
+const DefaultCategorySortField = "name"
+
+// CategorySortFields registry maps sortable category field names to their cursor string extraction logic.
+var CategorySortFields = map[string]func(*Category) string{
+	"name":        func(c *Category) string { return c.Name },
+	"create_time": func(c *Category) string { return c.CreateTime.Format(time.RFC3339) },
+}
+
+// IsCategorySortField validates if a sort column name is allowed.
+func IsCategorySortField(field string) bool {
+	_, ok := CategorySortFields[field]
+	return ok
+}
+
+// GetSortValue extracts and formats the string representation of a field for cursor-based lexical sorting.
+func (c *Category) GetSortValue(field string) string {
+	if fn, ok := CategorySortFields[field]; ok {
+		return fn(c)
+	}
+	return c.GetSortValue(DefaultCategorySortField)
+}
+
+const (
+	maxTagsPerTransaction = 20
+	maxTagLength          = 50
+)
+
+// NormalizeTags trims, lowercases, de-duplicates, and sorts free-form transaction tags.
+func NormalizeTags(tags []string) ([]string, error) {
+	out := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" {
+			continue
+		}
+		if len(tag) > maxTagLength {
+			return nil, fmt.Errorf("tag %q must not exceed %d characters", tag, maxTagLength)
+		}
+		if strings.ContainsAny(tag, ",\n\t") {
+			return nil, fmt.Errorf("tag %q contains invalid characters", tag)
+		}
+		out = append(out, tag)
+	}
+	slices.Sort(out)
+	out = slices.Compact(out)
+	if len(out) > maxTagsPerTransaction {
+		return nil, fmt.Errorf("a transaction can have at most %d tags", maxTagsPerTransaction)
+	}
+	return out, nil
+}
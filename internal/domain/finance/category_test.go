@@ -0,0 +1,65 @@
+package finance
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/masterkeysrd/saturn/internal/platform/id"
+)
+
+func TestCategory_Validate(t *testing.T) {
+	catID, _ := NewCategoryID()
+	parentID, _ := NewCategoryID()
+	rawSpace, _ := id.Generate("spc_")
+	spaceID := SpaceID(rawSpace)
+
+	tests := []struct {
+		name    string
+		mutate  func(c *Category)
+		wantErr bool
+	}{
+		{name: "valid top-level category", mutate: func(c *Category) {}},
+		{name: "valid subcategory", mutate: func(c *Category) { c.ParentID = &parentID }},
+		{name: "blank name", mutate: func(c *Category) { c.Name = "   " }, wantErr: true},
+		{name: "name too long", mutate: func(c *Category) { c.Name = strings.Repeat("x", 256) }, wantErr: true},
+		{name: "own parent", mutate: func(c *Category) { c.ParentID = &catID }, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := Category{ID: catID, SpaceID: spaceID, Name: "Groceries"}
+			tt.mutate(&c)
+			err := c.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNormalizeTags(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      []string
+		want    []string
+		wantErr bool
+	}{
+		{name: "nil", in: nil, want: []string{}},
+		{name: "trims, lowercases and de-duplicates", in: []string{" Travel", "travel", "WORK", ""}, want: []string{"travel", "work"}},
+		{name: "tag too long", in: []string{strings.Repeat("a", 51)}, wantErr: true},
+		{name: "comma in tag", in: []string{"a,b"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NormalizeTags(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NormalizeTags() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !slices.Equal(got, tt.want) {
+				t.Errorf("NormalizeTags() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -2,19 +2,36 @@ package finance
 
 import (
 	"fmt"
+	"math"
+	"strconv"
 	"strings"
 )
 
-// Currency represents a 3-letter ISO currency code.
+// Currency represents a 3-letter ISO 4217 currency code.
 type Currency string
 
-var supportedCurrencies = map[Currency]bool{
-	"USD": true,
-	"EUR": true,
-	"GBP": true,
-	"CAD": true,
-	"JPY": true,
-	"DOP": true,
+// CurrencyInfo describes an ISO 4217 currency.
+type CurrencyInfo struct {
+	Code       Currency
+	Name       string
+	Symbol     string
+	MinorUnits int32 // Number of decimal places in the minor unit (e.g. 2 for USD, 0 for JPY, 3 for KWD)
+}
+
+// MaxMajorAmount caps any single amount at one trillion major units, regardless of the currency exponent.
+const MaxMajorAmount = 1_000_000_000_000
+
+// Currencies returns the ISO 4217 catalog ordered by code.
+func Currencies() []CurrencyInfo {
+	out := make([]CurrencyInfo, len(currencyCatalog))
+	copy(out, currencyCatalog)
+	return out
+}
+
+// LookupCurrency returns the catalog entry for a currency code.
+func LookupCurrency(c Currency) (CurrencyInfo, bool) {
+	info, ok := currencyIndex[c]
+	return info, ok
 }
 
 // ParseCurrency parses, trims, and validates a string into a Currency type.
@@ -26,9 +43,9 @@ func ParseCurrency(s string) (Currency, error) {
 	return c, nil
 }
 
-// Validate checks if the currency code is one of the supported codes.
+// Validate checks if the currency code exists in the ISO 4217 catalog.
 func (c Currency) Validate() error {
-	if !supportedCurrencies[c] {
+	if _, ok := currencyIndex[c]; !ok {
 		return fmt.Errorf("currency '%s' is not supported", c)
 	}
 	return nil
@@ -38,3 +55,88 @@ func (c Currency) Validate() error {
 func (c Currency) String() string {
 	return string(c)
 }
+
+// MinorUnits returns the currency exponent. Unknown codes default to 2 decimals.
+func (c Currency) MinorUnits() int32 {
+	if info, ok := currencyIndex[c]; ok {
+		return info.MinorUnits
+	}
+	return 2
+}
+
+// Symbol returns the display symbol, falling back to the ISO code.
+func (c Currency) Symbol() string {
+	if info, ok := currencyIndex[c]; ok && info.Symbol != "" {
+		return info.Symbol
+	}
+	return string(c)
+}
+
+// scale returns 10^MinorUnits, the number of minor units in one major unit.
+func (c Currency) scale() int64 {
+	s := int64(1)
+	for i := int32(0); i < c.MinorUnits(); i++ {
+		s *= 10
+	}
+	return s
+}
+
+// ValidateAmount checks that an amount expressed in minor units is within the supported range.
+func (c Currency) ValidateAmount(amount int64) error {
+	limit := MaxMajorAmount * c.scale()
+	if amount > limit || amount < -limit {
+		return fmt.Errorf("amount %s exceeds the maximum of %s", c.FormatAmount(amount), c.FormatAmount(limit))
+	}
+	return nil
+}
+
+// ToMajor converts an amount in minor units into major units (e.g. 1234 USD cents -> 12.34).
+func (c Currency) ToMajor(amount int64) float64 {
+	return float64(amount) / float64(c.scale())
+}
+
+// FromMajor converts an amount in major units into minor units, rounding to the nearest unit.
+func (c Currency) FromMajor(amount float64) int64 {
+	return int64(math.Round(amount * float64(c.scale())))
+}
+
+// FormatAmount renders an amount in minor units with the currency symbol and exponent (e.g. "$12.34", "¥1234").
+func (c Currency) FormatAmount(amount int64) string {
+	sign := ""
+	if amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+	return sign + c.Symbol() + c.FormatDecimal(amount)
+}
+
+// FormatDecimal renders an amount in minor units as a plain decimal in major units (e.g. "-12.34", "1234").
+func (c Currency) FormatDecimal(amount int64) string {
+	sign := ""
+	if amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+
+	digits := int(c.MinorUnits())
+	value := strconv.FormatInt(amount, 10)
+	if digits > 0 {
+		if len(value) <= digits {
+			value = strings.Repeat("0", digits-len(value)+1) + value
+		}
+		value = value[:len(value)-digits] + "." + value[len(value)-digits:]
+	}
+	return sign + value
+}
+
+// ConvertAmount converts an amount in minor units of from into minor units of to using rate,
+// the number of to major units per from major unit. Differences in exponents are honoured, so
+// converting 1000 JPY at 0.0067 yields 670 USD cents. Same-exponent conversions keep the
+// historical truncation so stored base amounts do not shift.
+func ConvertAmount(amount int64, from, to Currency, rate float64) int64 {
+	exp := to.MinorUnits() - from.MinorUnits()
+	if exp == 0 {
+		return int64(float64(amount) * rate)
+	}
+	return int64(math.Round(float64(amount) * rate * math.Pow10(int(exp))))
+}
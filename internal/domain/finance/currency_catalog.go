@@ -0,0 +1,173 @@
+package finance
+
+// currencyCatalog lists the circulating ISO 4217 currencies, ordered by code.
+// Fund codes (e.g. CLF, USN), precious metals and testing codes are excluded.
+var currencyCatalog = []CurrencyInfo{
+	{Code: "AED", Name: "UAE Dirham", Symbol: "د.إ", MinorUnits: 2},
+	{Code: "AFN", Name: "Afghan Afghani", Symbol: "؋", MinorUnits: 2},
+	{Code: "ALL", Name: "Albanian Lek", Symbol: "L", MinorUnits: 2},
+	{Code: "AMD", Name: "Armenian Dram", Symbol: "֏", MinorUnits: 2},
+	{Code: "ANG", Name: "Netherlands Antillean Guilder", Symbol: "ƒ", MinorUnits: 2},
+	{Code: "AOA", Name: "Angolan Kwanza", Symbol: "Kz", MinorUnits: 2},
+	{Code: "ARS", Name: "Argentine Peso", Symbol: "$", MinorUnits: 2},
+	{Code: "AUD", Name: "Australian Dollar", Symbol: "A$", MinorUnits: 2},
+	{Code: "AWG", Name: "Aruban Florin", Symbol: "ƒ", MinorUnits: 2},
+	{Code: "AZN", Name: "Azerbaijani Manat", Symbol: "₼", MinorUnits: 2},
+	{Code: "BAM", Name: "Bosnia-Herzegovina Convertible Mark", Symbol: "KM", MinorUnits: 2},
+	{Code: "BBD", Name: "Barbadian Dollar", Symbol: "Bds$", MinorUnits: 2},
+	{Code: "BDT", Name: "Bangladeshi Taka", Symbol: "৳", MinorUnits: 2},
+	{Code: "BGN", Name: "Bulgarian Lev", Symbol: "лв", MinorUnits: 2},
+	{Code: "BHD", Name: "Bahraini Dinar", Symbol: "BD", MinorUnits: 3},
+	{Code: "BIF", Name: "Burundian Franc", Symbol: "FBu", MinorUnits: 0},
+	{Code: "BMD", Name: "Bermudian Dollar", Symbol: "$", MinorUnits: 2},
+	{Code: "BND", Name: "Brunei Dollar", Symbol: "B$", MinorUnits: 2},
+	{Code: "BOB", Name: "Bolivian Boliviano", Symbol: "Bs.", MinorUnits: 2},
+	{Code: "BRL", Name: "Brazilian Real", Symbol: "R$", MinorUnits: 2},
+	{Code: "BSD", Name: "Bahamian Dollar", Symbol: "B$", MinorUnits: 2},
+	{Code: "BTN", Name: "Bhutanese Ngultrum", Symbol: "Nu.", MinorUnits: 2},
+	{Code: "BWP", Name: "Botswana Pula", Symbol: "P", MinorUnits: 2},
+	{Code: "BYN", Name: "Belarusian Ruble", Symbol: "Br", MinorUnits: 2},
+	{Code: "BZD", Name: "Belize Dollar", Symbol: "BZ$", MinorUnits: 2},
+	{Code: "CAD", Name: "Canadian Dollar", Symbol: "CA$", MinorUnits: 2},
+	{Code: "CDF", Name: "Congolese Franc", Symbol: "FC", MinorUnits: 2},
+	{Code: "CHF", Name: "Swiss Franc", Symbol: "CHF", MinorUnits: 2},
+	{Code: "CLP", Name: "Chilean Peso", Symbol: "$", MinorUnits: 0},
+	{Code: "CNY", Name: "Chinese Yuan", Symbol: "CN¥", MinorUnits: 2},
+	{Code: "COP", Name: "Colombian Peso", Symbol: "$", MinorUnits: 2},
+	{Code: "CRC", Name: "Costa Rican Colón", Symbol: "₡", MinorUnits: 2},
+	{Code: "CUP", Name: "Cuban Peso", Symbol: "$", MinorUnits: 2},
+	{Code: "CVE", Name: "Cape Verdean Escudo", Symbol: "Esc", MinorUnits: 2},
+	{Code: "CZK", Name: "Czech Koruna", Symbol: "Kč", MinorUnits: 2},
+	{Code: "DJF", Name: "Djiboutian Franc", Symbol: "Fdj", MinorUnits: 0},
+	{Code: "DKK", Name: "Danish Krone", Symbol: "kr", MinorUnits: 2},
+	{Code: "DOP", Name: "Dominican Peso", Symbol: "RD$", MinorUnits: 2},
+	{Code: "DZD", Name: "Algerian Dinar", Symbol: "DA", MinorUnits: 2},
+	{Code: "EGP", Name: "Egyptian Pound", Symbol: "E£", MinorUnits: 2},
+	{Code: "ERN", Name: "Eritrean Nakfa", Symbol: "Nfk", MinorUnits: 2},
+	{Code: "ETB", Name: "Ethiopian Birr", Symbol: "Br", MinorUnits: 2},
+	{Code: "EUR", Name: "Euro", Symbol: "€", MinorUnits: 2},
+	{Code: "FJD", Name: "Fijian Dollar", Symbol: "FJ$", MinorUnits: 2},
+	{Code: "FKP", Name: "Falkland Islands Pound", Symbol: "£", MinorUnits: 2},
+	{Code: "GBP", Name: "British Pound", Symbol: "£", MinorUnits: 2},
+	{Code: "GEL", Name: "Georgian Lari", Symbol: "₾", MinorUnits: 2},
+	{Code: "GHS", Name: "Ghanaian Cedi", Symbol: "GH₵", MinorUnits: 2},
+	{Code: "GIP", Name: "Gibraltar Pound", Symbol: "£", MinorUnits: 2},
+	{Code: "GMD", Name: "Gambian Dalasi", Symbol: "D", MinorUnits: 2},
+	{Code: "GNF", Name: "Guinean Franc", Symbol: "FG", MinorUnits: 0},
+	{Code: "GTQ", Name: "Guatemalan Quetzal", Symbol: "Q", MinorUnits: 2},
+	{Code: "GYD", Name: "Guyanese Dollar", Symbol: "G$", MinorUnits: 2},
+	{Code: "HKD", Name: "Hong Kong Dollar", Symbol: "HK$", MinorUnits: 2},
+	{Code: "HNL", Name: "Honduran Lempira", Symbol: "L", MinorUnits: 2},
+	{Code: "HTG", Name: "Haitian Gourde", Symbol: "G", MinorUnits: 2},
+	{Code: "HUF", Name: "Hungarian Forint", Symbol: "Ft", MinorUnits: 2},
+	{Code: "IDR", Name: "Indonesian Rupiah", Symbol: "Rp", MinorUnits: 2},
+	{Code: "ILS", Name: "Israeli New Shekel", Symbol: "₪", MinorUnits: 2},
+	{Code: "INR", Name: "Indian Rupee", Symbol: "₹", MinorUnits: 2},
+	{Code: "IQD", Name: "Iraqi Dinar", Symbol: "IQD", MinorUnits: 3},
+	{Code: "IRR", Name: "Iranian Rial", Symbol: "﷼", MinorUnits: 2},
+	{Code: "ISK", Name: "Icelandic Króna", Symbol: "kr", MinorUnits: 0},
+	{Code: "JMD", Name: "Jamaican Dollar", Symbol: "J$", MinorUnits: 2},
+	{Code: "JOD", Name: "Jordanian Dinar", Symbol: "JD", MinorUnits: 3},
+	{Code: "JPY", Name: "Japanese Yen", Symbol: "¥", MinorUnits: 0},
+	{Code: "KES", Name: "Kenyan Shilling", Symbol: "KSh", MinorUnits: 2},
+	{Code: "KGS", Name: "Kyrgystani Som", Symbol: "с", MinorUnits: 2},
+	{Code: "KHR", Name: "Cambodian Riel", Symbol: "៛", MinorUnits: 2},
+	{Code: "KMF", Name: "Comorian Franc", Symbol: "CF", MinorUnits: 0},
+	{Code: "KPW", Name: "North Korean Won", Symbol: "₩", MinorUnits: 2},
+	{Code: "KRW", Name: "South Korean Won", Symbol: "₩", MinorUnits: 0},
+	{Code: "KWD", Name: "Kuwaiti Dinar", Symbol: "KD", MinorUnits: 3},
+	{Code: "KYD", Name: "Cayman Islands Dollar", Symbol: "CI$", MinorUnits: 2},
+	{Code: "KZT", Name: "Kazakhstani Tenge", Symbol: "₸", MinorUnits: 2},
+	{Code: "LAK", Name: "Laotian Kip", Symbol: "₭", MinorUnits: 2},
+	{Code: "LBP", Name: "Lebanese Pound", Symbol: "L£", MinorUnits: 2},
+	{Code: "LKR", Name: "Sri Lankan Rupee", Symbol: "Rs", MinorUnits: 2},
+	{Code: "LRD", Name: "Liberian Dollar", Symbol: "L$", MinorUnits: 2},
+	{Code: "LSL", Name: "Lesotho Loti", Symbol: "L", MinorUnits: 2},
+	{Code: "LYD", Name: "Libyan Dinar", Symbol: "LD", MinorUnits: 3},
+	{Code: "MAD", Name: "Moroccan Dirham", Symbol: "DH", MinorUnits: 2},
+	{Code: "MDL", Name: "Moldovan Leu", Symbol: "L", MinorUnits: 2},
+	{Code: "MGA", Name: "Malagasy Ariary", Symbol: "Ar", MinorUnits: 2},
+	{Code: "MKD", Name: "Macedonian Denar", Symbol: "ден", MinorUnits: 2},
+	{Code: "MMK", Name: "Myanmar Kyat", Symbol: "K", MinorUnits: 2},
+	{Code: "MNT", Name: "Mongolian Tugrik", Symbol: "₮", MinorUnits: 2},
+	{Code: "MOP", Name: "Macanese Pataca", Symbol: "MOP$", MinorUnits: 2},
+	{Code: "MRU", Name: "Mauritanian Ouguiya", Symbol: "UM", MinorUnits: 2},
+	{Code: "MUR", Name: "Mauritian Rupee", Symbol: "Rs", MinorUnits: 2},
+	{Code: "MVR", Name: "Maldivian Rufiyaa", Symbol: "Rf", MinorUnits: 2},
+	{Code: "MWK", Name: "Malawian Kwacha", Symbol: "MK", MinorUnits: 2},
+	{Code: "MXN", Name: "Mexican Peso", Symbol: "MX$", MinorUnits: 2},
+	{Code: "MYR", Name: "Malaysian Ringgit", Symbol: "RM", MinorUnits: 2},
+	{Code: "MZN", Name: "Mozambican Metical", Symbol: "MT", MinorUnits: 2},
+	{Code: "NAD", Name: "Namibian Dollar", Symbol: "N$", MinorUnits: 2},
+	{Code: "NGN", Name: "Nigerian Naira", Symbol: "₦", MinorUnits: 2},
+	{Code: "NIO", Name: "Nicaraguan Córdoba", Symbol: "C$", MinorUnits: 2},
+	{Code: "NOK", Name: "Norwegian Krone", Symbol: "kr", MinorUnits: 2},
+	{Code: "NPR", Name: "Nepalese Rupee", Symbol: "Rs", MinorUnits: 2},
+	{Code: "NZD", Name: "New Zealand Dollar", Symbol: "NZ$", MinorUnits: 2},
+	{Code: "OMR", Name: "Omani Rial", Symbol: "RO", MinorUnits: 3},
+	{Code: "PAB", Name: "Panamanian Balboa", Symbol: "B/.", MinorUnits: 2},
+	{Code: "PEN", Name: "Peruvian Sol", Symbol: "S/", MinorUnits: 2},
+	{Code: "PGK", Name: "Papua New Guinean Kina", Symbol: "K", MinorUnits: 2},
+	{Code: "PHP", Name: "Philippine Peso", Symbol: "₱", MinorUnits: 2},
+	{Code: "PKR", Name: "Pakistani Rupee", Symbol: "Rs", MinorUnits: 2},
+	{Code: "PLN", Name: "Polish Złoty", Symbol: "zł", MinorUnits: 2},
+	{Code: "PYG", Name: "Paraguayan Guarani", Symbol: "₲", MinorUnits: 0},
+	{Code: "QAR", Name: "Qatari Riyal", Symbol: "QR", MinorUnits: 2},
+	{Code: "RON", Name: "Romanian Leu", Symbol: "lei", MinorUnits: 2},
+	{Code: "RSD", Name: "Serbian Dinar", Symbol: "дин.", MinorUnits: 2},
+	{Code: "RUB", Name: "Russian Ruble", Symbol: "₽", MinorUnits: 2},
+	{Code: "RWF", Name: "Rwandan Franc", Symbol: "FRw", MinorUnits: 0},
+	{Code: "SAR", Name: "Saudi Riyal", Symbol: "SR", MinorUnits: 2},
+	{Code: "SBD", Name: "Solomon Islands Dollar", Symbol: "SI$", MinorUnits: 2},
+	{Code: "SCR", Name: "Seychellois Rupee", Symbol: "SRe", MinorUnits: 2},
+	{Code: "SDG", Name: "Sudanese Pound", Symbol: "SDG", MinorUnits: 2},
+	{Code: "SEK", Name: "Swedish Krona", Symbol: "kr", MinorUnits: 2},
+	{Code: "SGD", Name: "Singapore Dollar", Symbol: "S$", MinorUnits: 2},
+	{Code: "SHP", Name: "Saint Helena Pound", Symbol: "£", MinorUnits: 2},
+	{Code: "SLE", Name: "Sierra Leonean Leone", Symbol: "Le", MinorUnits: 2},
+	{Code: "SOS", Name: "Somali Shilling", Symbol: "Sh", MinorUnits: 2},
+	{Code: "SRD", Name: "Surinamese Dollar", Symbol: "Sr$", MinorUnits: 2},
+	{Code: "SSP", Name: "South Sudanese Pound", Symbol: "SSP", MinorUnits: 2},
+	{Code: "STN", Name: "São Tomé and Príncipe Dobra", Symbol: "Db", MinorUnits: 2},
+	{Code: "SVC", Name: "Salvadoran Colón", Symbol: "₡", MinorUnits: 2},
+	{Code: "SYP", Name: "Syrian Pound", Symbol: "LS", MinorUnits: 2},
+	{Code: "SZL", Name: "Swazi Lilangeni", Symbol: "E", MinorUnits: 2},
+	{Code: "THB", Name: "Thai Baht", Symbol: "฿", MinorUnits: 2},
+	{Code: "TJS", Name: "Tajikistani Somoni", Symbol: "SM", MinorUnits: 2},
+	{Code: "TMT", Name: "Turkmenistani Manat", Symbol: "m", MinorUnits: 2},
+	{Code: "TND", Name: "Tunisian Dinar", Symbol: "DT", MinorUnits: 3},
+	{Code: "TOP", Name: "Tongan Paʻanga", Symbol: "T$", MinorUnits: 2},
+	{Code: "TRY", Name: "Turkish Lira", Symbol: "₺", MinorUnits: 2},
+	{Code: "TTD", Name: "Trinidad and Tobago Dollar", Symbol: "TT$", MinorUnits: 2},
+	{Code: "TWD", Name: "New Taiwan Dollar", Symbol: "NT$", MinorUnits: 2},
+	{Code: "TZS", Name: "Tanzanian Shilling", Symbol: "TSh", MinorUnits: 2},
+	{Code: "UAH", Name: "Ukrainian Hryvnia", Symbol: "₴", MinorUnits: 2},
+	{Code: "UGX", Name: "Ugandan Shilling", Symbol: "USh", MinorUnits: 0},
+	{Code: "USD", Name: "US Dollar", Symbol: "$", MinorUnits: 2},
+	{Code: "UYU", Name: "Uruguayan Peso", Symbol: "$U", MinorUnits: 2},
+	{Code: "UZS", Name: "Uzbekistani Som", Symbol: "soʻm", MinorUnits: 2},
+	{Code: "VED", Name: "Venezuelan Digital Bolívar", Symbol: "Bs.D", MinorUnits: 2},
+	{Code: "VES", Name: "Venezuelan Bolívar", Symbol: "Bs.S", MinorUnits: 2},
+	{Code: "VND", Name: "Vietnamese Dong", Symbol: "₫", MinorUnits: 0},
+	{Code: "VUV", Name: "Vanuatu Vatu", Symbol: "VT", MinorUnits: 0},
+	{Code: "WST", Name: "Samoan Tala", Symbol: "WS$", MinorUnits: 2},
+	{Code: "XAF", Name: "Central African CFA Franc", Symbol: "FCFA", MinorUnits: 0},
+	{Code: "XCD", Name: "East Caribbean Dollar", Symbol: "EC$", MinorUnits: 2},
+	{Code: "XCG", Name: "Caribbean Guilder", Symbol: "Cg", MinorUnits: 2},
+	{Code: "XOF", Name: "West African CFA Franc", Symbol: "CFA", MinorUnits: 0},
+	{Code: "XPF", Name: "CFP Franc", Symbol: "CFPF", MinorUnits: 0},
+	{Code: "YER", Name: "Yemeni Rial", Symbol: "YR", MinorUnits: 2},
+	{Code: "ZAR", Name: "South African Rand", Symbol: "R", MinorUnits: 2},
+	{Code: "ZMW", Name: "Zambian Kwacha", Symbol: "ZK", MinorUnits: 2},
+	{Code: "ZWG", Name: "Zimbabwe Gold", Symbol: "ZiG", MinorUnits: 2},
+}
+
+var currencyIndex = indexCurrencies(currencyCatalog)
+
+func indexCurrencies(catalog []CurrencyInfo) map[Currency]CurrencyInfo {
+	index := make(map[Currency]CurrencyInfo, len(catalog))
+	for _, info := range catalog {
+		index[info.Code] = info
+	}
+	return index
+}
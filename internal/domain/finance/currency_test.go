@@ -0,0 +1,154 @@
+package finance
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCurrencyCatalog(t *testing.T) {
+	tests := []struct {
+		code       Currency
+		minorUnits int32
+		symbol     string
+	}{
+		{code: "USD", minorUnits: 2, symbol: "$"},
+		{code: "JPY", minorUnits: 0, symbol: "¥"},
+		{code: "KWD", minorUnits: 3, symbol: "KD"},
+		{code: "CLP", minorUnits: 0, symbol: "$"},
+		{code: "DOP", minorUnits: 2, symbol: "RD$"},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.code), func(t *testing.T) {
+			if err := tt.code.Validate(); err != nil {
+				t.Fatalf("Validate() unexpected error: %v", err)
+			}
+			if got := tt.code.MinorUnits(); got != tt.minorUnits {
+				t.Errorf("MinorUnits() = %d, want %d", got, tt.minorUnits)
+			}
+			if got := tt.code.Symbol(); got != tt.symbol {
+				t.Errorf("Symbol() = %q, want %q", got, tt.symbol)
+			}
+		})
+	}
+
+	if _, err := ParseCurrency("xyz"); err == nil {
+		t.Errorf("expected unknown code to be rejected")
+	}
+	if c, err := ParseCurrency(" kwd "); err != nil || c != "KWD" {
+		t.Errorf("ParseCurrency(\" kwd \") = %q, %v", c, err)
+	}
+
+	catalog := Currencies()
+	for i := 1; i < len(catalog); i++ {
+		if catalog[i-1].Code >= catalog[i].Code {
+			t.Fatalf("catalog not sorted or has duplicates at %s", catalog[i].Code)
+		}
+	}
+}
+
+func TestCurrency_FormatAmount(t *testing.T) {
+	tests := []struct {
+		code   Currency
+		amount int64
+		want   string
+	}{
+		{code: "USD", amount: 1234, want: "$12.34"},
+		{code: "USD", amount: 5, want: "$0.05"},
+		{code: "USD", amount: -1999, want: "-$19.99"},
+		{code: "JPY", amount: 1234, want: "¥1234"},
+		{code: "KWD", amount: 1500, want: "KD1.500"},
+	}
+	for _, tt := range tests {
+		if got := tt.code.FormatAmount(tt.amount); got != tt.want {
+			t.Errorf("%s.FormatAmount(%d) = %q, want %q", tt.code, tt.amount, got, tt.want)
+		}
+	}
+}
+
+func TestCurrency_FormatDecimal(t *testing.T) {
+	tests := []struct {
+		code   Currency
+		amount int64
+		want   string
+	}{
+		{code: "USD", amount: 1234, want: "12.34"},
+		{code: "USD", amount: -5, want: "-0.05"},
+		{code: "JPY", amount: -1234, want: "-1234"},
+		{code: "KWD", amount: 1500, want: "1.500"},
+	}
+	for _, tt := range tests {
+		if got := tt.code.FormatDecimal(tt.amount); got != tt.want {
+			t.Errorf("%s.FormatDecimal(%d) = %q, want %q", tt.code, tt.amount, got, tt.want)
+		}
+	}
+}
+
+func TestCurrency_MajorConversion(t *testing.T) {
+	if got := Currency("JPY").FromMajor(1500); got != 1500 {
+		t.Errorf("JPY FromMajor(1500) = %d, want 1500", got)
+	}
+	if got := Currency("KWD").FromMajor(2.5); got != 2500 {
+		t.Errorf("KWD FromMajor(2.5) = %d, want 2500", got)
+	}
+	if got := Currency("USD").ToMajor(1999); got != 19.99 {
+		t.Errorf("USD ToMajor(1999) = %v, want 19.99", got)
+	}
+}
+
+func TestCurrency_ValidateAmount(t *testing.T) {
+	if err := Currency("JPY").ValidateAmount(MaxMajorAmount); err != nil {
+		t.Errorf("expected JPY max amount to be valid: %v", err)
+	}
+	if err := Currency("JPY").ValidateAmount(MaxMajorAmount + 1); err == nil || !strings.Contains(err.Error(), "exceeds the maximum") {
+		t.Errorf("expected JPY amount above max to fail, got %v", err)
+	}
+	if err := Currency("KWD").ValidateAmount(MaxMajorAmount * 1000); err != nil {
+		t.Errorf("expected KWD max amount in fils to be valid: %v", err)
+	}
+	if err := Currency("USD").ValidateAmount(-(MaxMajorAmount*100 + 1)); err == nil {
+		t.Errorf("expected USD negative amount below min to fail")
+	}
+}
+
+func TestConvertAmount(t *testing.T) {
+	tests := []struct {
+		name     string
+		amount   int64
+		from, to Currency
+		rate     float64
+		want     int64
+	}{
+		{name: "same exponent", amount: 10000, from: "EUR", to: "USD", rate: 1.10, want: 11000},
+		{name: "zero to two decimals", amount: 1000, from: "JPY", to: "USD", rate: 0.0067, want: 670},
+		{name: "two to zero decimals", amount: 1000, from: "USD", to: "JPY", rate: 150, want: 1500},
+		{name: "three to two decimals", amount: 1000, from: "KWD", to: "USD", rate: 3.25, want: 325},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ConvertAmount(tt.amount, tt.from, tt.to, tt.rate); got != tt.want {
+				t.Errorf("ConvertAmount() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFinanceSettings_EnabledCurrencies(t *testing.T) {
+	settings := &FinanceSettings{SpaceID: "spc_2dE1V8ZqWz4eS2N9yX3bL1mK7pO", BaseCurrency: "USD"}
+	if !settings.IsCurrencyEnabled("JPY") {
+		t.Errorf("expected every currency to be enabled when the list is empty")
+	}
+
+	if err := settings.ApplyPatch(&FinanceSettings{EnabledCurrencies: []Currency{"EUR", "USD", "EUR"}}, []string{"enabled_currencies"}); err != nil {
+		t.Fatalf("ApplyPatch failed: %v", err)
+	}
+	if len(settings.EnabledCurrencies) != 2 {
+		t.Errorf("expected duplicates to be removed, got %v", settings.EnabledCurrencies)
+	}
+	if settings.IsCurrencyEnabled("JPY") || !settings.IsCurrencyEnabled("EUR") {
+		t.Errorf("unexpected enablement: %v", settings.EnabledCurrencies)
+	}
+
+	if err := settings.ApplyPatch(&FinanceSettings{EnabledCurrencies: []Currency{"EUR"}}, []string{"enabled_currencies"}); err == nil {
+		t.Errorf("expected disabling the base currency to fail")
+	}
+}
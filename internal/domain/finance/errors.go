@@ -7,12 +7,34 @@ var (
 	ErrSettingsNotFound                                   = errors.New("finance settings not found")
 	ErrBudgetNotFound                                     = errors.New("budget not found")
 	ErrPeriodNotFound                                     = errors.New("budget period not found")
+	ErrCurrencyDisabled                                   = errors.New("currency is not enabled for this space")
 	ErrExchangeRateNotFound                               = errors.New("exchange rate not found")
 	ErrTransactionNotFound                                = errors.New("transaction not found")
 	ErrBorrowingNotFound                                  = errors.New("borrowing not found")
 	ErrRepaymentNotFound                                  = errors.New("borrowing repayment not found")
 	ErrAccountNotFound                                    = errors.New("account not found")
 	ErrTransferNotFound                                   = errors.New("transfer not found")
+	ErrIncomeSourceNotFound                               = errors.New("income source not found")
+	ErrGoalNotFound                                       = errors.New("goal not found")
+	ErrSavedFilterNotFound                                = errors.New("saved filter not found")
+	ErrPayeeNotFound                                      = errors.New("payee not found")
+	ErrTransactionRuleNotFound                            = errors.New("transaction rule not found")
+	ErrLedgerExportNotFound                               = errors.New("ledger export not found")
+	ErrLedgerExportNotReady                               = errors.New("ledger export has not completed")
+	ErrCardStatementNotFound                              = errors.New("card statement not found")
+	ErrAlertNotFound                                      = errors.New("alert not found")
+	ErrAlertAcknowledged                                  = errors.New("alert is already acknowledged")
+	ErrCategoryNotFound                                   = errors.New("category not found")
+	ErrCategoryCycle                                      = errors.New("category cannot be nested under itself or one of its subcategories")
+	ErrSplitAmountMismatch                                = errors.New("split amounts must add up to the transaction amount")
+	ErrSplitNotSupported                                  = errors.New("only expense transactions can be split")
+	ErrInvalidStatement                                   = errors.New("invalid statement file")
+	ErrStatementMappingNotFound                           = errors.New("statement column mapping not found")
+	ErrReconciliationNotFound                             = errors.New("reconciliation not found")
+	ErrReconciliationInProgress                           = errors.New("account already has a reconciliation in progress")
+	ErrReconciliationFinalized                            = errors.New("reconciliation is already finalized")
+	ErrReconciliationUnbalanced                           = errors.New("reconciliation difference must be zero to finalize")
+	ErrTransactionReconciled                              = errors.New("transaction is locked by a finalized reconciliation")
 	ErrCannotDeleteDefaultAccount                         = errors.New("cannot delete the default account. please select another account as default first")
 	ErrBudgetVersionMismatch                              = errors.New("update failed: budget not found or version mismatch")
 	ErrBudgetHasTransactions                              = errors.New("cannot delete budget with existing transactions. deactivate it instead")
@@ -20,4 +42,5 @@ var (
 	ErrCannotLinkReceiptToTransfer                        = errors.New("cannot link receipt to transfer transaction")
 	ErrCannotRelinkTransactionToDifferentBorrowing        = errors.New("cannot relink transaction to a different borrowing agreement")
 	ErrCannotRelinkTransactionToDifferentScheduledPayment = errors.New("cannot relink transaction to a different scheduled payment")
+	ErrCannotRelinkTransactionToDifferentRecurringExpense = errors.New("cannot relink transaction to a different recurring expense")
 )
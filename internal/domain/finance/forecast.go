@@ -0,0 +1,259 @@
+package finance
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+const (
+	// DefaultForecastDays is the forecast horizon used when the request does not set one.
+	DefaultForecastDays = 30
+	// MaxForecastDays bounds the forecast horizon.
+	MaxForecastDays = 365
+	// variableAmountSampleSize is how many past payments estimate a variable recurring expense.
+	variableAmountSampleSize = 6
+)
+
+// ForecastEventKind identifies what produced a projected balance movement.
+type ForecastEventKind string
+
+const (
+	ForecastEventScheduledPayment ForecastEventKind = "SCHEDULED_PAYMENT"
+	ForecastEventRecurringExpense ForecastEventKind = "RECURRING_EXPENSE"
+	ForecastEventLoanInstallment  ForecastEventKind = "LOAN_INSTALLMENT"
+	ForecastEventCardPayment      ForecastEventKind = "CARD_PAYMENT"
+	ForecastEventIncome           ForecastEventKind = "INCOME"
+)
+
+// ForecastEvent is a projected inflow or outflow on one account.
+// Amount is in the account currency; negative amounts leave the account.
+type ForecastEvent struct {
+	Date        time.Time
+	AccountID   *AccountID // Nil when no account could be resolved; such events do not move balances
+	Kind        ForecastEventKind
+	SourceID    string
+	Description string
+	Amount      int64
+	Estimated   bool // Amount is estimated from history rather than known
+}
+
+// ForecastAlertKind classifies a projected low balance.
+type ForecastAlertKind string
+
+const (
+	ForecastAlertBelowZero      ForecastAlertKind = "BELOW_ZERO"
+	ForecastAlertBelowThreshold ForecastAlertKind = "BELOW_THRESHOLD"
+)
+
+// ForecastAlert flags the first day an account enters a low balance state.
+// For credit cards the checked figure is the available credit.
+type ForecastAlert struct {
+	AccountID AccountID
+	Date      time.Time
+	Kind      ForecastAlertKind
+	Available int64
+	Threshold int64
+}
+
+// ForecastDay is an account's projected position at the end of a day.
+type ForecastDay struct {
+	Date      time.Time
+	Balance   int64
+	Available int64 // Balance for asset accounts, remaining credit for credit cards
+	Inflow    int64
+	Outflow   int64
+}
+
+// AccountForecast is the day-by-day projection of one account.
+type AccountForecast struct {
+	Account         *Account
+	StartingBalance int64
+	LowestAvailable int64 // Lowest projected available figure, starting balance included
+	LowestDate      time.Time
+	Days            []*ForecastDay
+}
+
+// CashFlowForecast is the projection of every active account of a space.
+type CashFlowForecast struct {
+	StartDate time.Time
+	EndDate   time.Time
+	Accounts  []*AccountForecast
+	Events    []*ForecastEvent
+	Alerts    []*ForecastAlert
+}
+
+// ForecastCashFlowRequest selects the space and horizon of a forecast.
+type ForecastCashFlowRequest struct {
+	SpaceID    SpaceID
+	Days       int32
+	AccountIDs []AccountID // Optional; restricts the result, every account is still simulated
+	Now        time.Time
+}
+
+// Validate checks the request and applies the default horizon.
+func (r *ForecastCashFlowRequest) Validate() error {
+	if err := r.SpaceID.Validate(); err != nil {
+		return fmt.Errorf("validate space ID: %w", err)
+	}
+	if r.Days == 0 {
+		r.Days = DefaultForecastDays
+	}
+	if r.Days < 0 || r.Days > MaxForecastDays {
+		return fmt.Errorf("forecast days must be between 1 and %d", MaxForecastDays)
+	}
+	if r.Now.IsZero() {
+		return errors.New("forecast start time is required")
+	}
+	for _, accID := range r.AccountIDs {
+		if err := accID.Validate(); err != nil {
+			return fmt.Errorf("validate account ID: %w", err)
+		}
+	}
+	return nil
+}
+
+// Only keeps the accounts, events and alerts of the given accounts. An empty list keeps everything.
+func (f *CashFlowForecast) Only(accountIDs []AccountID) {
+	if len(accountIDs) == 0 {
+		return
+	}
+	f.Accounts = slices.DeleteFunc(f.Accounts, func(af *AccountForecast) bool {
+		return !slices.Contains(accountIDs, af.Account.ID)
+	})
+	f.Events = slices.DeleteFunc(f.Events, func(e *ForecastEvent) bool {
+		return e.AccountID == nil || !slices.Contains(accountIDs, *e.AccountID)
+	})
+	f.Alerts = slices.DeleteFunc(f.Alerts, func(a *ForecastAlert) bool {
+		return !slices.Contains(accountIDs, a.AccountID)
+	})
+}
+
+// available returns the figure low balance checks apply to. Credit cards without a limit are never flagged.
+func (f *AccountForecast) available(balance int64) (int64, bool) {
+	if f.Account.Type != AccountTypeCreditCard {
+		return balance, true
+	}
+	if f.Account.CreditLimit <= 0 {
+		return 0, false
+	}
+	return f.Account.CreditLimit - balance, true
+}
+
+// SimulateCashFlow projects account balances day by day from start (today's calendar date in the
+// space timezone) over the given number of days. Events outside the window or without an account are ignored.
+func SimulateCashFlow(accounts []*Account, events []*ForecastEvent, start time.Time, days int) *CashFlowForecast {
+	forecast := &CashFlowForecast{
+		StartDate: start,
+		EndDate:   start.AddDate(0, 0, days-1),
+		Events:    events,
+	}
+
+	byAccount := make(map[AccountID]*AccountForecast, len(accounts))
+	for _, acc := range accounts {
+		af := &AccountForecast{Account: acc, StartingBalance: acc.CurrentBalance, Days: make([]*ForecastDay, 0, days)}
+		af.LowestAvailable, _ = af.available(acc.CurrentBalance)
+		af.LowestDate = start
+		byAccount[acc.ID] = af
+		forecast.Accounts = append(forecast.Accounts, af)
+	}
+
+	// Bucket event amounts by account and day offset.
+	type movement struct{ inflow, outflow int64 }
+	moves := make(map[AccountID][]movement, len(accounts))
+	for _, e := range events {
+		if e.AccountID == nil {
+			continue
+		}
+		if _, ok := byAccount[*e.AccountID]; !ok {
+			continue
+		}
+		day := daysBetween(start, e.Date)
+		if day < 0 || day >= days {
+			continue
+		}
+		m := moves[*e.AccountID]
+		if m == nil {
+			m = make([]movement, days)
+			moves[*e.AccountID] = m
+		}
+		if e.Amount >= 0 {
+			m[day].inflow += e.Amount
+		} else {
+			m[day].outflow -= e.Amount
+		}
+	}
+
+	for _, af := range forecast.Accounts {
+		acc := af.Account
+		balance := acc.CurrentBalance
+		var state ForecastAlertKind
+		for day := range days {
+			date := start.AddDate(0, 0, day)
+			var inflow, outflow int64
+			if m := moves[acc.ID]; m != nil {
+				inflow, outflow = m[day].inflow, m[day].outflow
+			}
+			if acc.Type == AccountTypeCreditCard {
+				balance += outflow - inflow
+			} else {
+				balance += inflow - outflow
+			}
+
+			available, checked := af.available(balance)
+			af.Days = append(af.Days, &ForecastDay{
+				Date:      date,
+				Balance:   balance,
+				Available: available,
+				Inflow:    inflow,
+				Outflow:   outflow,
+			})
+			if !checked {
+				continue
+			}
+			if available < af.LowestAvailable {
+				af.LowestAvailable, af.LowestDate = available, date
+			}
+
+			// Alert once when an account enters a worse state, not on every day it stays there.
+			next := ForecastAlertKind("")
+			switch {
+			case available < 0:
+				next = ForecastAlertBelowZero
+			case acc.LowBalanceThreshold > 0 && available < acc.LowBalanceThreshold:
+				next = ForecastAlertBelowThreshold
+			}
+			if next != "" && next != state && (state == "" || next == ForecastAlertBelowZero) {
+				forecast.Alerts = append(forecast.Alerts, &ForecastAlert{
+					AccountID: acc.ID,
+					Date:      date,
+					Kind:      next,
+					Available: available,
+					Threshold: acc.LowBalanceThreshold,
+				})
+			}
+			state = next
+		}
+	}
+
+	slices.SortStableFunc(forecast.Alerts, func(a, b *ForecastAlert) int { return a.Date.Compare(b.Date) })
+	slices.SortStableFunc(forecast.Events, func(a, b *ForecastEvent) int { return a.Date.Compare(b.Date) })
+	return forecast
+}
+
+// daysBetween returns the number of calendar days from start to the calendar date of t.
+// Both are date-only values, so the time of day and location are ignored.
+func daysBetween(start, t time.Time) int {
+	from := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC)
+	to := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	return int(to.Sub(from).Hours() / 24)
+}
+
+// latestDate returns the later of two calendar dates.
+func latestDate(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return b
+	}
+	return a
+}
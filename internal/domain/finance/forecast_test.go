@@ -0,0 +1,104 @@
+package finance
+
+import (
+	"testing"
+	"time"
+
+	"github.com/masterkeysrd/saturn/internal/platform/id"
+)
+
+func TestSimulateCashFlow(t *testing.T) {
+	start := time.Date(2026, 4, 10, 0, 0, 0, 0, time.UTC)
+	day := func(n int) time.Time { return start.AddDate(0, 0, n) }
+
+	checkingID, _ := NewAccountID()
+	cardID, _ := NewAccountID()
+	checking := &Account{ID: checkingID, Type: AccountTypeBank, Currency: "USD", CurrentBalance: 100000, LowBalanceThreshold: 50000}
+	card := &Account{ID: cardID, Type: AccountTypeCreditCard, Currency: "USD", CurrentBalance: 80000, CreditLimit: 100000, LowBalanceThreshold: 10000}
+
+	events := []*ForecastEvent{
+		{Date: day(5), AccountID: &checkingID, Amount: 200000},
+		{Date: day(1), AccountID: &checkingID, Amount: -60000},
+		{Date: day(3), AccountID: &checkingID, Amount: -50000},
+		{Date: day(4), AccountID: &checkingID, Amount: -5000},
+		{Date: day(2), AccountID: &cardID, Amount: -15000}, // Charge: available credit 5000
+		{Date: day(6), AccountID: &cardID, Amount: 50000},  // Payment
+		{Date: day(30), AccountID: &checkingID, Amount: -1},
+		{Date: day(2), Amount: -99999}, // No account resolved
+	}
+
+	forecast := SimulateCashFlow([]*Account{checking, card}, events, start, 7)
+
+	if got := forecast.EndDate; !got.Equal(day(6)) {
+		t.Errorf("EndDate = %s, want %s", got, day(6))
+	}
+	if len(forecast.Accounts) != 2 || len(forecast.Accounts[0].Days) != 7 {
+		t.Fatalf("unexpected forecast shape: %d accounts", len(forecast.Accounts))
+	}
+
+	bank := forecast.Accounts[0]
+	wantBalances := []int64{100000, 40000, 40000, -10000, -15000, 185000, 185000}
+	for i, want := range wantBalances {
+		if got := bank.Days[i].Balance; got != want {
+			t.Errorf("checking day %d balance = %d, want %d", i, got, want)
+		}
+	}
+	if bank.LowestAvailable != -15000 || !bank.LowestDate.Equal(day(4)) {
+		t.Errorf("checking lowest = %d on %s, want -15000 on %s", bank.LowestAvailable, bank.LowestDate, day(4))
+	}
+
+	credit := forecast.Accounts[1]
+	if got := credit.Days[2]; got.Balance != 95000 || got.Available != 5000 || got.Outflow != 15000 {
+		t.Errorf("card day 2 = balance %d available %d outflow %d, want 95000 5000 15000", got.Balance, got.Available, got.Outflow)
+	}
+	if got := credit.Days[6].Balance; got != 45000 {
+		t.Errorf("card final balance = %d, want 45000", got)
+	}
+
+	wantAlerts := []struct {
+		account AccountID
+		date    time.Time
+		kind    ForecastAlertKind
+	}{
+		{checkingID, day(1), ForecastAlertBelowThreshold},
+		{cardID, day(2), ForecastAlertBelowThreshold},
+		{checkingID, day(3), ForecastAlertBelowZero},
+	}
+	if len(forecast.Alerts) != len(wantAlerts) {
+		t.Fatalf("got %d alerts, want %d", len(forecast.Alerts), len(wantAlerts))
+	}
+	for i, want := range wantAlerts {
+		got := forecast.Alerts[i]
+		if got.AccountID != want.account || !got.Date.Equal(want.date) || got.Kind != want.kind {
+			t.Errorf("alert %d = %s %s %s, want %s %s %s", i, got.AccountID, got.Date.Format(time.DateOnly), got.Kind, want.account, want.date.Format(time.DateOnly), want.kind)
+		}
+	}
+}
+
+func TestForecastCashFlowRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		days     int32
+		wantDays int32
+		wantErr  bool
+	}{
+		{name: "defaults the horizon", days: 0, wantDays: DefaultForecastDays},
+		{name: "keeps the horizon", days: 90, wantDays: 90},
+		{name: "negative horizon", days: -1, wantErr: true},
+		{name: "horizon too long", days: MaxForecastDays + 1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rawSpace, _ := id.Generate("spc_")
+			req := &ForecastCashFlowRequest{SpaceID: SpaceID(rawSpace), Days: tt.days, Now: time.Now()}
+			err := req.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && req.Days != tt.wantDays {
+				t.Errorf("Days = %d, want %d", req.Days, tt.wantDays)
+			}
+		})
+	}
+}
@@ -0,0 +1,279 @@
+package finance
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/masterkeysrd/saturn/internal/platform/id"
+	"github.com/masterkeysrd/saturn/internal/platform/sorting"
+)
+
+// GoalID is a custom string type representing a savings goal's unique identifier (KSUID).
+type GoalID string
+
+const goalPrefix = "goal_"
+
+// NewGoalID creates a new GoalID using the default ID generator.
+func NewGoalID() (GoalID, error) {
+	raw, err := id.Generate(goalPrefix)
+	if err != nil {
+		return "", err
+	}
+	return GoalID(raw), nil
+}
+
+// ParseGoalID parses a string into a GoalID and validates it.
+func ParseGoalID(s string) (GoalID, error) {
+	if err := id.Validate(s, goalPrefix); err != nil {
+		return "", fmt.Errorf("invalid goal ID: %w", err)
+	}
+	return GoalID(s), nil
+}
+
+// String returns the string representation.
+func (gid GoalID) String() string {
+	return string(gid)
+}
+
+// Validate checks if the GoalID is valid.
+func (gid GoalID) Validate() error {
+	return id.Validate(string(gid), goalPrefix)
+}
+
+type GoalStatus string
+
+const (
+	GoalStatusActive    GoalStatus = "ACTIVE"
+	GoalStatusCompleted GoalStatus = "COMPLETED"
+	GoalStatusArchived  GoalStatus = "ARCHIVED"
+)
+
+// goalVelocityWindow bounds how far back contributions are averaged when projecting completion.
+const goalVelocityWindow = 90 * 24 * time.Hour
+
+// Goal represents a savings target funded by transfers into a linked account or by tagged transactions.
+type Goal struct {
+	ID           GoalID
+	SpaceID      SpaceID
+	Name         string
+	Description  string
+	TargetAmount int64
+	Currency     Currency
+	StartDate    time.Time  // Contributions dated before this are ignored
+	Deadline     *time.Time // Nullable
+	AccountID    *AccountID // Transfers into this account count as contributions, transfers out as withdrawals
+	Tag          string     // Transactions carrying this tag count as contributions
+	Color        string
+	Icon         string
+	Status       GoalStatus
+	CreateTime   time.Time
+	UpdateTime   time.Time
+}
+
+// Validate checks the goal's business rules.
+func (g *Goal) Validate() error {
+	if err := g.ID.Validate(); err != nil {
+		return fmt.Errorf("validate goal ID: %w", err)
+	}
+	if err := g.SpaceID.Validate(); err != nil {
+		return fmt.Errorf("validate space ID: %w", err)
+	}
+	g.Name = strings.TrimSpace(g.Name)
+	if g.Name == "" {
+		return errors.New("goal name is required")
+	}
+	if len(g.Name) > 255 {
+		return errors.New("goal name must not exceed 255 characters")
+	}
+	if g.TargetAmount <= 0 {
+		return errors.New("goal target amount must be greater than zero")
+	}
+	if err := g.Currency.Validate(); err != nil {
+		return fmt.Errorf("validate currency: %w", err)
+	}
+	if err := g.Currency.ValidateAmount(g.TargetAmount); err != nil {
+		return fmt.Errorf("validate target amount: %w", err)
+	}
+	if g.StartDate.IsZero() {
+		return errors.New("goal start date is required")
+	}
+	if g.Deadline != nil && !g.Deadline.After(g.StartDate) {
+		return errors.New("goal deadline must be after its start date")
+	}
+	if g.AccountID != nil {
+		if err := g.AccountID.Validate(); err != nil {
+			return fmt.Errorf("validate account ID: %w", err)
+		}
+	}
+	if g.Tag != "" {
+		tags, err := NormalizeTags([]string{g.Tag})
+		if err != nil {
+			return fmt.Errorf("validate tag: %w", err)
+		}
+		g.Tag = ""
+		if len(tags) > 0 {
+			g.Tag = tags[0]
+		}
+	}
+	if g.AccountID == nil && g.Tag == "" {
+		return errors.New("goal must be linked to an account or a contribution tag")
+	}
+	switch g.Status {
+	case GoalStatusActive, GoalStatusCompleted, GoalStatusArchived:
+	default:
+		return fmt.Errorf("invalid goal status: %s", g.Status)
+	}
+	g.Color = strings.TrimSpace(g.Color)
+	if g.Color == "" {
+		g.Color = "#10b981"
+	}
+	g.Icon = strings.TrimSpace(g.Icon)
+	if g.Icon == "" {
+		g.Icon = "PiggyBank"
+	}
+	return nil
+}
+
+// IsContribution reports whether the transaction funds or draws from the goal.
+func (g *Goal) IsContribution(txn *Transaction) bool {
+	if txn.TransactionDate.Before(g.StartDate) {
+		return false
+	}
+	if g.AccountID != nil && txn.AccountID != nil && *txn.AccountID == *g.AccountID &&
+		(txn.Type == TransactionTypeTransferIn || txn.Type == TransactionTypeTransferOut) {
+		return true
+	}
+	if g.Tag != "" {
+		for _, tag := range txn.Tags {
+			if tag == g.Tag {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// GoalContribution is a single movement of funds towards (or away from) a goal.
+type GoalContribution struct {
+	TransactionID   TransactionID
+	Type            TransactionType
+	Description     string
+	Amount          int64 // Signed in goal currency; withdrawals are negative
+	TransactionDate time.Time
+}
+
+// GoalProgress summarizes how far a goal has come and when it is expected to be reached.
+type GoalProgress struct {
+	Goal                        *Goal
+	SavedAmount                 int64
+	RemainingAmount             int64
+	Percentage                  float64
+	ContributionCount           int32
+	MonthlyVelocity             int64      // Average net contribution per 30 days over the recent window
+	ProjectedCompletionDate     *time.Time // Nil when the goal is not progressing
+	RequiredMonthlyContribution int64      // Needed to meet the deadline; zero without a deadline
+	OnTrack                     bool
+	Contributions               []*GoalContribution
+}
+
+// ProjectGoal computes progress and a completion projection from the goal's contributions as of now.
+// Velocity is averaged over the most recent 90 days (or since the goal started, if later).
+func ProjectGoal(goal *Goal, contributions []*GoalContribution, now time.Time) *GoalProgress {
+	p := &GoalProgress{
+		Goal:              goal,
+		Contributions:     contributions,
+		ContributionCount: int32(len(contributions)),
+	}
+
+	windowStart := now.Add(-goalVelocityWindow)
+	if goal.StartDate.After(windowStart) {
+		windowStart = goal.StartDate
+	}
+
+	var windowSaved int64
+	for _, c := range contributions {
+		p.SavedAmount += c.Amount
+		if !c.TransactionDate.Before(windowStart) {
+			windowSaved += c.Amount
+		}
+	}
+
+	p.RemainingAmount = max(goal.TargetAmount-p.SavedAmount, 0)
+	p.Percentage = math.Min(float64(p.SavedAmount)/float64(goal.TargetAmount)*100.0, 100.0)
+	if p.Percentage < 0 {
+		p.Percentage = 0
+	}
+
+	windowDays := math.Max(now.Sub(windowStart).Hours()/24.0, 1)
+	dailyVelocity := float64(windowSaved) / windowDays
+	p.MonthlyVelocity = int64(math.Round(dailyVelocity * 30))
+
+	if p.RemainingAmount == 0 {
+		completed := now
+		if n := len(contributions); n > 0 {
+			completed = contributions[n-1].TransactionDate
+		}
+		p.ProjectedCompletionDate = &completed
+	} else if dailyVelocity > 0 {
+		days := math.Ceil(float64(p.RemainingAmount) / dailyVelocity)
+		projected := now.AddDate(0, 0, int(days))
+		p.ProjectedCompletionDate = &projected
+	}
+
+	if goal.Deadline != nil {
+		if p.RemainingAmount > 0 {
+			months := goal.Deadline.Sub(now).Hours() / 24.0 / 30.0
+			if months < 1 {
+				months = 1
+			}
+			p.RequiredMonthlyContribution = int64(math.Ceil(float64(p.RemainingAmount) / months))
+		}
+		p.OnTrack = p.ProjectedCompletionDate != nil && !p.ProjectedCompletionDate.After(*goal.Deadline)
+	} else {
+		p.OnTrack = p.ProjectedCompletionDate != nil
+	}
+
+	return p
+}
+
+// DefaultGoalSortField represents the fallback sorting column name for goals.
+const DefaultGoalSortField = "create_time"
+
+// GoalSortFields registry maps sortable goal field names to their cursor string extraction logic.
+var GoalSortFields = map[string]func(*Goal) string{
+	"name":          func(g *Goal) string { return g.Name },
+	"target_amount": func(g *Goal) string { return fmt.Sprintf("%018d", g.TargetAmount) },
+	"deadline": func(g *Goal) string {
+		if g.Deadline != nil {
+			return g.Deadline.Format(time.RFC3339)
+		}
+		return ""
+	},
+	"create_time": func(g *Goal) string { return g.CreateTime.Format(time.RFC3339Nano) },
+}
+
+// IsGoalSortField validates if a sort column name is allowed.
+func IsGoalSortField(field string) bool {
+	_, ok := GoalSortFields[field]
+	return ok
+}
+
+// GetSortValue extracts the sort value string for pagination cursor generation.
+func (g *Goal) GetSortValue(field string) string {
+	if fn, ok := GoalSortFields[field]; ok {
+		return fn(g)
+	}
+	return g.GetSortValue(DefaultGoalSortField)
+}
+
+// ListGoalsFilter encapsulates filtering parameters for listing goals.
+type ListGoalsFilter struct {
+	Status        *GoalStatus
+	PageSize      int32
+	NextPageToken string
+	SearchQuery   *string
+	Sort          sorting.SortOrder
+}
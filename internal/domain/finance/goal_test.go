@@ -0,0 +1,132 @@
+package finance
+
+import (
+	"testing"
+	"time"
+
+	"github.com/masterkeysrd/saturn/internal/platform/id"
+)
+
+func TestGoalID(t *testing.T) {
+	goalID, err := NewGoalID()
+	if err != nil {
+		t.Fatalf("unexpected error creating goal ID: %v", err)
+	}
+	if err := goalID.Validate(); err != nil {
+		t.Errorf("expected valid goal ID, got: %v", err)
+	}
+
+	parsed, err := ParseGoalID(string(goalID))
+	if err != nil || parsed != goalID {
+		t.Errorf("failed to parse goal ID: %v", err)
+	}
+
+	if _, err := ParseGoalID("acc_invalid"); err == nil {
+		t.Error("expected error parsing ID with a foreign prefix")
+	}
+}
+
+func TestGoal_Validate(t *testing.T) {
+	goalID, _ := NewGoalID()
+	rawSpace, _ := id.Generate("spc_")
+	spaceID := SpaceID(rawSpace)
+	accountID, _ := NewAccountID()
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	early := start.AddDate(0, 0, -1)
+
+	valid := func() Goal {
+		return Goal{
+			ID:           goalID,
+			SpaceID:      spaceID,
+			Name:         "Emergency fund",
+			TargetAmount: 500000,
+			Currency:     "USD",
+			StartDate:    start,
+			AccountID:    &accountID,
+			Status:       GoalStatusActive,
+		}
+	}
+
+	tests := []struct {
+		name    string
+		mutate  func(g *Goal)
+		wantErr bool
+	}{
+		{name: "valid with account", mutate: func(g *Goal) {}},
+		{name: "valid with tag only", mutate: func(g *Goal) { g.AccountID = nil; g.Tag = "emergency" }},
+		{name: "missing name", mutate: func(g *Goal) { g.Name = "  " }, wantErr: true},
+		{name: "zero target", mutate: func(g *Goal) { g.TargetAmount = 0 }, wantErr: true},
+		{name: "target above maximum", mutate: func(g *Goal) { g.Currency = "JPY"; g.TargetAmount = MaxMajorAmount + 1 }, wantErr: true},
+		{name: "deadline before start", mutate: func(g *Goal) { g.Deadline = &early }, wantErr: true},
+		{name: "no contribution source", mutate: func(g *Goal) { g.AccountID = nil }, wantErr: true},
+		{name: "invalid status", mutate: func(g *Goal) { g.Status = "PAUSED" }, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := valid()
+			tt.mutate(&g)
+			err := g.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestProjectGoal(t *testing.T) {
+	now := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	deadline := now.AddDate(0, 1, 0)
+	goal := &Goal{TargetAmount: 100000, StartDate: now.AddDate(-1, 0, 0), Deadline: &deadline}
+
+	t.Run("no contributions", func(t *testing.T) {
+		p := ProjectGoal(goal, nil, now)
+		if p.SavedAmount != 0 || p.ProjectedCompletionDate != nil || p.OnTrack {
+			t.Errorf("expected no projection, got %+v", p)
+		}
+		if p.RequiredMonthlyContribution != 100000 {
+			t.Errorf("RequiredMonthlyContribution = %d, want 100000", p.RequiredMonthlyContribution)
+		}
+	})
+
+	t.Run("velocity only counts the recent window", func(t *testing.T) {
+		p := ProjectGoal(goal, []*GoalContribution{
+			{Amount: 40000, TransactionDate: now.AddDate(0, -6, 0)},
+			{Amount: 9000, TransactionDate: now.AddDate(0, 0, -30)},
+		}, now)
+		if p.SavedAmount != 49000 {
+			t.Errorf("SavedAmount = %d, want 49000", p.SavedAmount)
+		}
+		// 9000 over the 90 day window
+		if p.MonthlyVelocity != 3000 {
+			t.Errorf("MonthlyVelocity = %d, want 3000", p.MonthlyVelocity)
+		}
+		if p.ProjectedCompletionDate == nil || !p.ProjectedCompletionDate.After(deadline) || p.OnTrack {
+			t.Errorf("expected the goal to be behind its deadline, got %+v", p)
+		}
+	})
+
+	t.Run("withdrawals stall the projection", func(t *testing.T) {
+		p := ProjectGoal(goal, []*GoalContribution{
+			{Amount: 20000, TransactionDate: now.AddDate(0, 0, -60)},
+			{Amount: -25000, TransactionDate: now.AddDate(0, 0, -10)},
+		}, now)
+		if p.Percentage != 0 || p.MonthlyVelocity >= 0 || p.ProjectedCompletionDate != nil {
+			t.Errorf("expected a stalled goal, got %+v", p)
+		}
+	})
+
+	t.Run("completed", func(t *testing.T) {
+		reached := now.AddDate(0, 0, -2)
+		p := ProjectGoal(goal, []*GoalContribution{
+			{Amount: 60000, TransactionDate: now.AddDate(0, 0, -20)},
+			{Amount: 50000, TransactionDate: reached},
+		}, now)
+		if p.RemainingAmount != 0 || p.Percentage != 100 || p.RequiredMonthlyContribution != 0 {
+			t.Errorf("unexpected completed progress: %+v", p)
+		}
+		if p.ProjectedCompletionDate == nil || !p.ProjectedCompletionDate.Equal(reached) || !p.OnTrack {
+			t.Errorf("expected completion on the last contribution date, got %v", p.ProjectedCompletionDate)
+		}
+	})
+}
@@ -25,6 +25,17 @@ const (
 	InboxItemDocSystemVerification InboxItemDocType = "system_verification"
 )
 
+// InboxItemSource tags where a staged inbox item originated.
+type InboxItemSource string
+
+const (
+	InboxItemSourceIntegration      InboxItemSource = "integration"
+	InboxItemSourceStatementCSV     InboxItemSource = "statement_csv"
+	InboxItemSourceStatementOFX     InboxItemSource = "statement_ofx"
+	InboxItemSourceStatementQFX     InboxItemSource = "statement_qfx"
+	InboxItemSourceStatementCAMT053 InboxItemSource = "statement_camt053"
+)
+
 type BorrowingLinkType string
 
 const (
@@ -54,6 +65,8 @@ type InboxItem struct {
 	ID                 string             `json:"id"`
 	SpaceID            string             `json:"spaceId"`
 	IntegrationID      string             `json:"integrationId"`
+	Source             InboxItemSource    `json:"source"`
+	ExternalID         *string            `json:"externalId,omitempty"`
 	Status             InboxItemStatus    `json:"status"`
 	DocType            InboxItemDocType   `json:"docType"`
 	Amount             int64              `json:"amount"`
@@ -62,15 +75,55 @@ type InboxItem struct {
 	TransactionDate    time.Time          `json:"transactionDate"`
 	AccountID          *string            `json:"accountId,omitempty"`
 	BudgetID           *string            `json:"budgetId,omitempty"`
+	PayeeID            *string            `json:"payeeId,omitempty"`
 	ScheduledPaymentID *string            `json:"scheduledPaymentId,omitempty"`
 	TransactionID      *string            `json:"transactionId,omitempty"`
 	BorrowingID        *string            `json:"borrowingId,omitempty"`
 	BorrowingLinkType  *BorrowingLinkType `json:"borrowingLinkType,omitempty"`
+	Splits             []*InboxItemSplit  `json:"splits,omitempty"`
 	RawPayload         string             `json:"rawPayload"`
 	Metadata           map[string]any     `json:"metadata"`
 	CreateTime         time.Time          `json:"createTime"`
 }
 
+// InboxItemSplit is a draft split line that becomes a TransactionSplit when the item is approved.
+type InboxItemSplit struct {
+	BudgetID   *string `json:"budgetId,omitempty"`
+	CategoryID *string `json:"categoryId,omitempty"`
+	Amount     int64   `json:"amount"`
+	Note       string  `json:"note,omitempty"`
+}
+
+// TransactionSplits converts the draft split lines into transaction split lines.
+func (i *InboxItem) TransactionSplits() ([]*TransactionSplit, error) {
+	if i == nil || len(i.Splits) == 0 {
+		return nil, nil
+	}
+	splits := make([]*TransactionSplit, 0, len(i.Splits))
+	for idx, draft := range i.Splits {
+		split := &TransactionSplit{
+			Amount: draft.Amount,
+			Note:   draft.Note,
+		}
+		if draft.BudgetID != nil && *draft.BudgetID != "" {
+			bID, err := ParseBudgetID(*draft.BudgetID)
+			if err != nil {
+				return nil, fmt.Errorf("split %d: %w", idx, err)
+			}
+			split.BudgetID = &bID
+		}
+		if draft.CategoryID != nil && *draft.CategoryID != "" {
+			cID, err := ParseCategoryID(*draft.CategoryID)
+			if err != nil {
+				return nil, fmt.Errorf("split %d: %w", idx, err)
+			}
+			split.CategoryID = &cID
+		}
+		splits = append(splits, split)
+	}
+	return splits, nil
+}
+
 // MetadataBool retrieves a boolean value from the metadata map safely.
 func (i *InboxItem) MetadataBool(key string) bool {
 	if i == nil || i.Metadata == nil {
@@ -92,6 +145,8 @@ func (i *InboxItem) MetadataString(key string) string {
 // StageInboxItem defines parameters to draft and stage an inbox item.
 type StageInboxItem struct {
 	IntegrationID   string
+	Source          InboxItemSource
+	ExternalID      *string
 	DocType         InboxItemDocType
 	Vendor          string
 	Amount          int64
@@ -99,6 +154,7 @@ type StageInboxItem struct {
 	AccountID       *string
 	CardLastFour    string
 	SuggestedBudget string
+	PayeeID         *string
 	Date            string
 	RawPayload      string
 	Metadata        map[string]any
@@ -0,0 +1,161 @@
+package finance
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/masterkeysrd/saturn/internal/platform/id"
+)
+
+type IncomeSourceID string
+
+const incomeSourcePrefix = "ics_"
+
+func NewIncomeSourceID() (IncomeSourceID, error) {
+	raw, err := id.Generate(incomeSourcePrefix)
+	if err != nil {
+		return "", err
+	}
+	return IncomeSourceID(raw), nil
+}
+
+func ParseIncomeSourceID(s string) (IncomeSourceID, error) {
+	if err := id.Validate(s, incomeSourcePrefix); err != nil {
+		return "", fmt.Errorf("invalid income source ID: %w", err)
+	}
+	return IncomeSourceID(s), nil
+}
+
+func (iid IncomeSourceID) String() string {
+	return string(iid)
+}
+
+func (iid IncomeSourceID) Validate() error {
+	return id.Validate(string(iid), incomeSourcePrefix)
+}
+
+// IncomeCadence defines how often an income source is expected to pay out.
+type IncomeCadence string
+
+const (
+	IncomeCadenceWeekly    IncomeCadence = "weekly"
+	IncomeCadenceBiweekly  IncomeCadence = "biweekly"
+	IncomeCadenceMonthly   IncomeCadence = "monthly"
+	IncomeCadenceYearly    IncomeCadence = "yearly"
+	IncomeCadenceIrregular IncomeCadence = "irregular"
+	IncomeCadenceCustom    IncomeCadence = "custom" // Derived from a recurrence rule that no simple cadence describes
+)
+
+func (c IncomeCadence) Validate() error {
+	switch c {
+	case IncomeCadenceWeekly, IncomeCadenceBiweekly, IncomeCadenceMonthly, IncomeCadenceYearly, IncomeCadenceIrregular, IncomeCadenceCustom:
+		return nil
+	default:
+		return fmt.Errorf("invalid income cadence: %q", c)
+	}
+}
+
+// IncomeSource represents a recurring origin of inflows, such as an employer paying a salary.
+type IncomeSource struct {
+	ID             IncomeSourceID
+	SpaceID        SpaceID
+	Name           string
+	Employer       string
+	ExpectedAmount int64
+	Currency       Currency
+	Cadence        IncomeCadence
+	RecurrenceRule string // Optional RFC 5545 RRULE; sets Cadence and fixes the pay dates when set
+	StartDate      time.Time
+	ExceptionDates []time.Time
+	AccountID      *AccountID
+	IsActive       bool
+	CreateTime     time.Time
+	UpdateTime     time.Time
+}
+
+func (s *IncomeSource) Validate() error {
+	if err := s.ID.Validate(); err != nil {
+		return fmt.Errorf("validate income source ID: %w", err)
+	}
+	if err := s.SpaceID.Validate(); err != nil {
+		return fmt.Errorf("validate space ID: %w", err)
+	}
+	if s.Name == "" {
+		return errors.New("income source name cannot be empty")
+	}
+	if s.ExpectedAmount < 0 {
+		return errors.New("income source expected amount cannot be negative")
+	}
+	if err := s.Currency.Validate(); err != nil {
+		return fmt.Errorf("validate currency: %w", err)
+	}
+	if err := s.Cadence.Validate(); err != nil {
+		return err
+	}
+	if s.RecurrenceRule != "" {
+		if _, err := ParseRecurrenceRule(s.RecurrenceRule); err != nil {
+			return fmt.Errorf("validate recurrence rule: %w", err)
+		}
+		if s.StartDate.IsZero() {
+			return errors.New("income source with a recurrence rule requires a start date")
+		}
+	} else if s.Cadence == IncomeCadenceCustom {
+		return errors.New("custom cadence requires a recurrence rule")
+	}
+	if s.AccountID != nil {
+		if err := s.AccountID.Validate(); err != nil {
+			return fmt.Errorf("validate account ID: %w", err)
+		}
+	}
+	return nil
+}
+
+// NormalizeRecurrence canonicalises the recurrence rule and derives Cadence from it.
+func (s *IncomeSource) NormalizeRecurrence() error {
+	if s.RecurrenceRule == "" {
+		return nil
+	}
+	rule, err := ParseRecurrenceRule(s.RecurrenceRule)
+	if err != nil {
+		return fmt.Errorf("validate recurrence rule: %w", err)
+	}
+	s.RecurrenceRule = rule.String()
+	s.Cadence = IncomeCadence(rule.Cadence())
+	return nil
+}
+
+// Recurrence returns the pay dates of a source with a recurrence rule. Sources without one only
+// have a cadence, which is continued from their last payment, so ok is false.
+func (s *IncomeSource) Recurrence() (rc *Recurrence, ok bool, err error) {
+	if s.RecurrenceRule == "" {
+		return nil, false, nil
+	}
+	rule, err := ParseRecurrenceRule(s.RecurrenceRule)
+	if err != nil {
+		return nil, false, err
+	}
+	return &Recurrence{Rule: rule, Start: s.StartDate, ExceptionDates: s.ExceptionDates}, true, nil
+}
+
+const DefaultIncomeSourceSortField = "create_time"
+
+// IncomeSourceSortFields registry maps sortable income source field names to cursor strings.
+var IncomeSourceSortFields = map[string]func(*IncomeSource) string{
+	"name":            func(s *IncomeSource) string { return s.Name },
+	"employer":        func(s *IncomeSource) string { return s.Employer },
+	"expected_amount": func(s *IncomeSource) string { return fmt.Sprintf("%018d", s.ExpectedAmount) },
+	"create_time":     func(s *IncomeSource) string { return s.CreateTime.Format(time.RFC3339) },
+}
+
+func IsIncomeSourceSortField(field string) bool {
+	_, ok := IncomeSourceSortFields[field]
+	return ok
+}
+
+func (s *IncomeSource) GetSortValue(field string) string {
+	if fn, ok := IncomeSourceSortFields[field]; ok {
+		return fn(s)
+	}
+	return s.GetSortValue(DefaultIncomeSourceSortField)
+}
@@ -0,0 +1,73 @@
+package finance
+
+import (
+	"testing"
+
+	"github.com/masterkeysrd/saturn/internal/platform/id"
+)
+
+func TestIncomeSourceID(t *testing.T) {
+	srcID, err := NewIncomeSourceID()
+	if err != nil {
+		t.Fatalf("unexpected error creating income source ID: %v", err)
+	}
+	if err := srcID.Validate(); err != nil {
+		t.Errorf("expected valid income source ID, got: %v", err)
+	}
+
+	parsed, err := ParseIncomeSourceID(string(srcID))
+	if err != nil || parsed != srcID {
+		t.Errorf("failed to parse income source ID: %v", err)
+	}
+
+	if _, err := ParseIncomeSourceID("rec_invalid"); err == nil {
+		t.Error("expected error parsing ID with a foreign prefix")
+	}
+}
+
+func TestIncomeSource_Validate(t *testing.T) {
+	srcID, _ := NewIncomeSourceID()
+	rawSpace, _ := id.Generate("spc_")
+	spaceID := SpaceID(rawSpace)
+	badAccount := AccountID("bad")
+
+	valid := func() IncomeSource {
+		return IncomeSource{
+			ID:             srcID,
+			SpaceID:        spaceID,
+			Name:           "Salary",
+			Employer:       "Acme Corp",
+			ExpectedAmount: 500000,
+			Currency:       "USD",
+			Cadence:        IncomeCadenceMonthly,
+		}
+	}
+
+	tests := []struct {
+		name    string
+		mutate  func(s *IncomeSource)
+		wantErr bool
+	}{
+		{name: "valid monthly salary", mutate: func(s *IncomeSource) {}},
+		{name: "irregular freelance income without expected amount", mutate: func(s *IncomeSource) {
+			s.Cadence = IncomeCadenceIrregular
+			s.ExpectedAmount = 0
+		}},
+		{name: "missing name", mutate: func(s *IncomeSource) { s.Name = "" }, wantErr: true},
+		{name: "negative expected amount", mutate: func(s *IncomeSource) { s.ExpectedAmount = -1 }, wantErr: true},
+		{name: "unknown cadence", mutate: func(s *IncomeSource) { s.Cadence = "daily" }, wantErr: true},
+		{name: "invalid currency", mutate: func(s *IncomeSource) { s.Currency = "XX" }, wantErr: true},
+		{name: "invalid account", mutate: func(s *IncomeSource) { s.AccountID = &badAccount }, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			src := valid()
+			tt.mutate(&src)
+			err := src.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
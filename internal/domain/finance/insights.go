@@ -68,6 +68,56 @@ type TopExpense struct {
 	EffectiveDate   time.Time
 }
 
+// CashFlowTrend represents income and expense totals for a given interval.
+// Card spending is the part of the expenses charged to credit cards; card payments are
+// transfers settling card balances and are not counted as expenses.
+type CashFlowTrend struct {
+	IntervalStart time.Time
+	IncomeInBase  int64
+	ExpenseInBase int64
+	CardSpending  int64
+	CardPayments  int64
+}
+
+// CashFlowInsights aggregates inflows against outflows over the requested window.
+type CashFlowInsights struct {
+	TotalIncome  int64
+	TotalExpense int64
+	NetCashFlow  int64
+	SavingsRate  float64
+	CardSpending int64 // Included in TotalExpense
+	CardPayments int64 // Excluded from TotalExpense to avoid counting card purchases twice
+	Trend        []*CashFlowDataPoint
+}
+
+type CashFlowDataPoint struct {
+	Label         string
+	StartDate     string
+	IncomeInBase  int64
+	ExpenseInBase int64
+	NetInBase     int64
+	CardSpending  int64
+	CardPayments  int64
+}
+
+// CategoryDistribution represents expense allocation per category. An empty CategoryID groups uncategorized spend.
+type CategoryDistribution struct {
+	CategoryID    string
+	ParentID      string
+	CategoryName  string
+	CategoryColor string
+	CategoryIcon  string
+	TxnCount      int32
+	SpentInBase   int64
+}
+
+// TagDistribution represents expense allocation per tag.
+type TagDistribution struct {
+	Tag         string
+	TxnCount    int32
+	SpentInBase int64
+}
+
 // SpentInsights aggregates all calculated outflow analytics.
 type SpentInsights struct {
 	TotalLimit      int64
@@ -77,6 +127,10 @@ type SpentInsights struct {
 	Trend           []*TrendDataPoint
 	Distributions   []*BudgetUsage
 	TopExpenses     []*HighValueExpense
+	CashFlow        *CashFlowInsights
+	Categories      []*CategoryUsage
+	Tags            []*TagUsage
+	Goals           []*GoalProgress // Active goals, without their contribution history
 }
 
 type TrendDataPoint struct {
@@ -108,6 +162,24 @@ type BudgetUsage struct {
 	UsagePercentage float64
 }
 
+type CategoryUsage struct {
+	CategoryID       string
+	ParentID         string
+	CategoryName     string
+	CategoryColor    string
+	CategoryIcon     string
+	TransactionCount int32
+	SpentInBase      int64
+	SharePercentage  float64
+}
+
+type TagUsage struct {
+	Tag              string
+	TransactionCount int32
+	SpentInBase      int64
+	SharePercentage  float64
+}
+
 type HighValueExpense struct {
 	TransactionID   string
 	Description     string
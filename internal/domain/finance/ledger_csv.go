@@ -0,0 +1,81 @@
+package finance
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// ledgerCSVHeader lists the columns of a CSV ledger export.
+var ledgerCSVHeader = []string{
+	"id", "date", "type", "account", "budget", "description", "amount", "currency",
+	"amount_in_base", "base_currency", "tags", "transfer_id", "counterpart_account", "cleared",
+}
+
+// ledgerCSVWriter writes one row per transaction, or one row per split line for split transactions.
+// Amounts are signed from the account's point of view: negative for money leaving it.
+type ledgerCSVWriter struct {
+	w *csv.Writer
+	l *Ledger
+}
+
+func newLedgerCSVWriter(w io.Writer, l *Ledger) *ledgerCSVWriter {
+	return &ledgerCSVWriter{w: csv.NewWriter(w), l: l}
+}
+
+func (cw *ledgerCSVWriter) Begin() error {
+	return cw.w.Write(ledgerCSVHeader)
+}
+
+func (cw *ledgerCSVWriter) Write(e *LedgerEntry) error {
+	txn := e.Transaction
+
+	transferID := ""
+	if txn.Metadata.TransferID != nil {
+		transferID = txn.Metadata.TransferID.String()
+	}
+
+	row := func(budgetID *BudgetID, amount, amountInBase int64) []string {
+		if ledgerOutflow(txn) {
+			amount, amountInBase = -amount, -amountInBase
+		}
+		return []string{
+			txn.ID.String(),
+			txn.TransactionDate.UTC().Format("2006-01-02"),
+			string(txn.Type),
+			cw.l.accountLabel(txn.AccountID),
+			cw.l.budgetLabel(budgetID),
+			txn.Description,
+			txn.Currency.FormatDecimal(amount),
+			string(txn.Currency),
+			cw.l.BaseCurrency.FormatDecimal(amountInBase),
+			string(cw.l.BaseCurrency),
+			strings.Join(txn.Tags, ";"),
+			transferID,
+			cw.l.accountLabel(txn.Metadata.CounterpartAccountID),
+			strconv.FormatBool(txn.Cleared),
+		}
+	}
+
+	if len(txn.Splits) == 0 {
+		return cw.w.Write(row(txn.BudgetID, txn.Amount, txn.AmountInBase))
+	}
+	for _, s := range txn.Splits {
+		if err := cw.w.Write(row(s.BudgetID, s.Amount, s.AmountInBase)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (cw *ledgerCSVWriter) Close() error {
+	cw.w.Flush()
+	return cw.w.Error()
+}
+
+// ledgerOutflow reports whether a transaction's unsigned amount represents money leaving its account.
+// Balance adjustments already carry their own sign.
+func ledgerOutflow(txn *Transaction) bool {
+	return txn.Type == TransactionTypeExpense || txn.Type == TransactionTypeTransferOut
+}
@@ -0,0 +1,531 @@
+package finance
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/masterkeysrd/saturn/internal/platform/id"
+	"github.com/masterkeysrd/saturn/internal/platform/sorting"
+)
+
+// LedgerFormat identifies the file format a ledger export is written in.
+type LedgerFormat string
+
+const (
+	LedgerFormatCSV       LedgerFormat = "csv"
+	LedgerFormatJSONL     LedgerFormat = "jsonl"
+	LedgerFormatOFX       LedgerFormat = "ofx"
+	LedgerFormatBeancount LedgerFormat = "beancount"
+	LedgerFormatHledger   LedgerFormat = "hledger"
+)
+
+// Validate checks if the ledger format is supported.
+func (f LedgerFormat) Validate() error {
+	switch f {
+	case LedgerFormatCSV, LedgerFormatJSONL, LedgerFormatOFX, LedgerFormatBeancount, LedgerFormatHledger:
+		return nil
+	default:
+		return fmt.Errorf("unsupported ledger format: %q", f)
+	}
+}
+
+// ContentType returns the MIME type of files written in this format.
+func (f LedgerFormat) ContentType() string {
+	switch f {
+	case LedgerFormatCSV:
+		return "text/csv; charset=utf-8"
+	case LedgerFormatJSONL:
+		return "application/x-ndjson"
+	case LedgerFormatOFX:
+		return "application/x-ofx"
+	default:
+		return "text/plain; charset=utf-8"
+	}
+}
+
+// FileName returns the suggested download name for an export covering start through end.
+func (f LedgerFormat) FileName(start, end time.Time) string {
+	ext := string(f)
+	if f == LedgerFormatHledger {
+		ext = "journal"
+	}
+	return fmt.Sprintf("ledger-%s-%s.%s", start.Format("20060102"), end.Format("20060102"), ext)
+}
+
+// LedgerQuery selects the space, date range and format of a ledger export.
+type LedgerQuery struct {
+	SpaceID   SpaceID
+	Format    LedgerFormat
+	StartDate time.Time // Inclusive, truncated to the day
+	EndDate   time.Time // Inclusive through the end of the day
+}
+
+// MaxLedgerExportRange bounds the date range a single export may cover.
+const MaxLedgerExportRange = 10 * 366 * 24 * time.Hour
+
+// Validate checks the query and normalizes its dates to whole UTC days.
+func (q *LedgerQuery) Validate() error {
+	if err := q.SpaceID.Validate(); err != nil {
+		return fmt.Errorf("validate space ID: %w", err)
+	}
+	if err := q.Format.Validate(); err != nil {
+		return err
+	}
+	if q.StartDate.IsZero() || q.EndDate.IsZero() {
+		return errors.New("start date and end date are required")
+	}
+	q.StartDate = truncateToDay(q.StartDate)
+	q.EndDate = truncateToDay(q.EndDate)
+	if q.EndDate.Before(q.StartDate) {
+		return errors.New("end date must not be before start date")
+	}
+	if q.EndDate.Sub(q.StartDate) > MaxLedgerExportRange {
+		return errors.New("ledger export range must not exceed 10 years")
+	}
+	return nil
+}
+
+// rangeEnd returns the last instant covered by the query.
+func (q *LedgerQuery) rangeEnd() time.Time {
+	return q.EndDate.AddDate(0, 0, 1).Add(-time.Nanosecond)
+}
+
+func truncateToDay(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// LedgerExportID is a custom string type representing a ledger export's unique identifier (KSUID).
+type LedgerExportID string
+
+const ledgerExportPrefix = "lex_"
+
+// NewLedgerExportID creates a new LedgerExportID using the default ID generator.
+func NewLedgerExportID() (LedgerExportID, error) {
+	raw, err := id.Generate(ledgerExportPrefix)
+	if err != nil {
+		return "", err
+	}
+	return LedgerExportID(raw), nil
+}
+
+// ParseLedgerExportID parses a string into a LedgerExportID and validates it.
+func ParseLedgerExportID(s string) (LedgerExportID, error) {
+	if err := id.Validate(s, ledgerExportPrefix); err != nil {
+		return "", fmt.Errorf("invalid ledger export ID: %w", err)
+	}
+	return LedgerExportID(s), nil
+}
+
+// String returns the string representation.
+func (lid LedgerExportID) String() string {
+	return string(lid)
+}
+
+// Validate checks if the LedgerExportID is valid.
+func (lid LedgerExportID) Validate() error {
+	return id.Validate(string(lid), ledgerExportPrefix)
+}
+
+// LedgerExportStatus tracks a background export through its lifecycle.
+type LedgerExportStatus string
+
+const (
+	LedgerExportStatusPending   LedgerExportStatus = "PENDING"
+	LedgerExportStatusRunning   LedgerExportStatus = "RUNNING"
+	LedgerExportStatusCompleted LedgerExportStatus = "COMPLETED"
+	LedgerExportStatusFailed    LedgerExportStatus = "FAILED"
+)
+
+// LedgerExport is a ledger export produced by a background job and kept for later download.
+type LedgerExport struct {
+	ID           LedgerExportID
+	SpaceID      SpaceID
+	Format       LedgerFormat
+	StartDate    time.Time
+	EndDate      time.Time
+	Status       LedgerExportStatus
+	FileName     string
+	ContentType  string
+	SizeBytes    int64
+	EntryCount   int64
+	Error        string // Failure reason when Status is FAILED
+	CreateTime   time.Time
+	UpdateTime   time.Time
+	CompleteTime *time.Time // Nullable
+}
+
+// Query returns the ledger query the export was requested with.
+func (e *LedgerExport) Query() *LedgerQuery {
+	return &LedgerQuery{
+		SpaceID:   e.SpaceID,
+		Format:    e.Format,
+		StartDate: e.StartDate,
+		EndDate:   e.EndDate,
+	}
+}
+
+// Validate checks the export's business rules.
+func (e *LedgerExport) Validate() error {
+	if err := e.ID.Validate(); err != nil {
+		return fmt.Errorf("validate ledger export ID: %w", err)
+	}
+	q := e.Query()
+	if err := q.Validate(); err != nil {
+		return err
+	}
+	e.StartDate, e.EndDate = q.StartDate, q.EndDate
+	switch e.Status {
+	case LedgerExportStatusPending, LedgerExportStatusRunning, LedgerExportStatusCompleted, LedgerExportStatusFailed:
+	default:
+		return fmt.Errorf("invalid ledger export status: %q", e.Status)
+	}
+	return nil
+}
+
+// DefaultLedgerExportSortField represents the fallback sorting column name for ledger exports.
+const DefaultLedgerExportSortField = "create_time"
+
+// LedgerExportSortFields registry maps sortable ledger export field names to their cursor string extraction logic.
+var LedgerExportSortFields = map[string]func(*LedgerExport) string{
+	"create_time": func(e *LedgerExport) string { return e.CreateTime.Format(time.RFC3339Nano) },
+}
+
+// IsLedgerExportSortField validates if a sort column name is allowed.
+func IsLedgerExportSortField(field string) bool {
+	_, ok := LedgerExportSortFields[field]
+	return ok
+}
+
+// GetSortValue extracts the sort value string for pagination cursor generation.
+func (e *LedgerExport) GetSortValue(field string) string {
+	if fn, ok := LedgerExportSortFields[field]; ok {
+		return fn(e)
+	}
+	return e.GetSortValue(DefaultLedgerExportSortField)
+}
+
+// ListLedgerExportsFilter encapsulates filtering parameters for listing ledger exports.
+type ListLedgerExportsFilter struct {
+	PageSize      int32
+	NextPageToken string
+	Sort          sorting.SortOrder
+}
+
+// Fallback ledger accounts used when a transaction does not reference a known account or budget.
+const (
+	LedgerAccountUnassigned    = "Assets:Unassigned"
+	LedgerAccountUncategorized = "Expenses:Uncategorized"
+	LedgerAccountIncome        = "Income:Uncategorized"
+	LedgerAccountAdjustments   = "Equity:Adjustments"
+	LedgerAccountTransfers     = "Equity:Transfers"
+)
+
+// Ledger maps a space's accounts and budgets to double-entry ledger account names.
+// Accounts become Assets (or Liabilities for credit cards) and budgets become Expenses.
+type Ledger struct {
+	BaseCurrency Currency
+	StartDate    time.Time
+	EndDate      time.Time
+	Accounts     []*Account
+	Budgets      []*Budget
+
+	accounts     map[AccountID]*Account
+	budgets      map[BudgetID]*Budget
+	accountNames map[AccountID]string
+	budgetNames  map[BudgetID]string
+}
+
+// NewLedger builds the ledger account names for the given accounts and budgets.
+// Names that collide after sanitizing receive a numeric suffix.
+func NewLedger(baseCurrency Currency, start, end time.Time, accounts []*Account, budgets []*Budget) *Ledger {
+	l := &Ledger{
+		BaseCurrency: baseCurrency,
+		StartDate:    start,
+		EndDate:      end,
+		Accounts:     accounts,
+		Budgets:      budgets,
+		accounts:     make(map[AccountID]*Account, len(accounts)),
+		budgets:      make(map[BudgetID]*Budget, len(budgets)),
+		accountNames: make(map[AccountID]string, len(accounts)),
+		budgetNames:  make(map[BudgetID]string, len(budgets)),
+	}
+
+	used := map[string]bool{
+		LedgerAccountUnassigned:    true,
+		LedgerAccountUncategorized: true,
+		LedgerAccountIncome:        true,
+		LedgerAccountAdjustments:   true,
+		LedgerAccountTransfers:     true,
+	}
+	unique := func(name string) string {
+		candidate := name
+		for n := 2; used[candidate]; n++ {
+			candidate = name + "-" + strconv.Itoa(n)
+		}
+		used[candidate] = true
+		return candidate
+	}
+
+	for _, a := range accounts {
+		root := "Assets:"
+		if a.Type == AccountTypeCreditCard {
+			root = "Liabilities:"
+		}
+		l.accounts[a.ID] = a
+		l.accountNames[a.ID] = unique(root + ledgerComponent(a.Name))
+	}
+	for _, b := range budgets {
+		l.budgets[b.ID] = b
+		l.budgetNames[b.ID] = unique("Expenses:" + ledgerComponent(b.Name))
+	}
+	return l
+}
+
+// ledgerComponent turns a display name into a valid account name component: runs of characters
+// other than letters and digits collapse into "-", and the component starts with a capital letter or digit.
+func ledgerComponent(name string) string {
+	var sb strings.Builder
+	dash := false
+	for _, r := range name {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if dash && sb.Len() > 0 {
+				sb.WriteByte('-')
+			}
+			sb.WriteRune(r)
+			dash = false
+			continue
+		}
+		dash = true
+	}
+	out := []rune(sb.String())
+	if len(out) == 0 {
+		return "Unnamed"
+	}
+	out[0] = unicode.ToUpper(out[0])
+	if !unicode.IsUpper(out[0]) && !unicode.IsDigit(out[0]) {
+		return "X" + string(out)
+	}
+	return string(out)
+}
+
+// Account returns the account with the given ID, or nil when it is unknown.
+func (l *Ledger) Account(accountID *AccountID) *Account {
+	if accountID == nil {
+		return nil
+	}
+	return l.accounts[*accountID]
+}
+
+// AccountName returns the ledger account name of a space account.
+func (l *Ledger) AccountName(accountID *AccountID) string {
+	if accountID == nil {
+		return LedgerAccountUnassigned
+	}
+	if name, ok := l.accountNames[*accountID]; ok {
+		return name
+	}
+	return LedgerAccountUnassigned
+}
+
+// BudgetName returns the ledger account name of a budget.
+func (l *Ledger) BudgetName(budgetID *BudgetID) string {
+	if budgetID == nil {
+		return LedgerAccountUncategorized
+	}
+	if name, ok := l.budgetNames[*budgetID]; ok {
+		return name
+	}
+	return LedgerAccountUncategorized
+}
+
+// accountLabel returns the display name of an account for tabular formats.
+func (l *Ledger) accountLabel(accountID *AccountID) string {
+	if a := l.Account(accountID); a != nil {
+		return a.Name
+	}
+	return ""
+}
+
+// budgetLabel returns the display name of a budget for tabular formats.
+func (l *Ledger) budgetLabel(budgetID *BudgetID) string {
+	if budgetID == nil {
+		return ""
+	}
+	if b, ok := l.budgets[*budgetID]; ok {
+		return b.Name
+	}
+	return ""
+}
+
+// AccountFlow returns the signed amount a transaction moves into (positive) or out of (negative)
+// its account, in the account's currency when the transaction was converted.
+func (l *Ledger) AccountFlow(txn *Transaction) (int64, Currency) {
+	amount, currency := txn.Amount, txn.Currency
+	account := l.Account(txn.AccountID)
+	if account != nil && txn.Metadata.AccountImpactAmount > 0 {
+		amount, currency = txn.Metadata.AccountImpactAmount, account.Currency
+	}
+
+	switch txn.Type {
+	case TransactionTypeBalanceAdjustment:
+		// Adjustments are signed against the tracked balance, which is debt for credit cards.
+		if account != nil && account.Type == AccountTypeCreditCard {
+			return -amount, currency
+		}
+		return amount, currency
+	case TransactionTypeExpense, TransactionTypeTransferOut:
+		return -amount, currency
+	default:
+		return amount, currency
+	}
+}
+
+// LedgerEntry is a transaction within an export, together with the transfer it belongs to, if any.
+type LedgerEntry struct {
+	Transaction *Transaction
+	Transfer    *Transfer // Nullable; set for transfer legs whose transfer record was found
+}
+
+// LedgerAmount is an amount in minor units of a currency.
+type LedgerAmount struct {
+	Amount   int64
+	Currency Currency
+}
+
+// LedgerPosting is one line of a double-entry transaction.
+type LedgerPosting struct {
+	Account string
+	Amount  LedgerAmount
+	Cost    *LedgerAmount // Total cost in another currency, for converted postings
+}
+
+// Postings returns the balanced postings of an entry. Transfers are booked once, on their outgoing
+// leg, so the incoming leg of a transfer with a known record yields no postings.
+func (l *Ledger) Postings(e *LedgerEntry) []LedgerPosting {
+	txn := e.Transaction
+	if tr := e.Transfer; tr != nil {
+		if txn.Type == TransactionTypeTransferIn {
+			return nil
+		}
+		srcCurrency := l.currencyOf(tr.SourceAccountID, txn.Currency)
+		dstCurrency := l.currencyOf(tr.DestinationAccountID, txn.Currency)
+		src := LedgerPosting{
+			Account: l.AccountName(&tr.SourceAccountID),
+			Amount:  LedgerAmount{Amount: -tr.SourceAmount, Currency: srcCurrency},
+		}
+		if srcCurrency != dstCurrency {
+			src.Cost = &LedgerAmount{Amount: tr.DestinationAmount, Currency: dstCurrency}
+		}
+		return []LedgerPosting{
+			{
+				Account: l.AccountName(&tr.DestinationAccountID),
+				Amount:  LedgerAmount{Amount: tr.DestinationAmount, Currency: dstCurrency},
+			},
+			src,
+		}
+	}
+
+	flow, flowCurrency := l.AccountFlow(txn)
+	account := LedgerPosting{
+		Account: l.AccountName(txn.AccountID),
+		Amount:  LedgerAmount{Amount: flow, Currency: flowCurrency},
+	}
+
+	var counter []LedgerPosting
+	switch txn.Type {
+	case TransactionTypeExpense:
+		if len(txn.Splits) > 0 {
+			for _, s := range txn.Splits {
+				counter = append(counter, LedgerPosting{
+					Account: l.BudgetName(s.BudgetID),
+					Amount:  LedgerAmount{Amount: s.Amount, Currency: txn.Currency},
+				})
+			}
+		} else {
+			counter = []LedgerPosting{{
+				Account: l.BudgetName(txn.BudgetID),
+				Amount:  LedgerAmount{Amount: txn.Amount, Currency: txn.Currency},
+			}}
+		}
+	case TransactionTypeIncome:
+		counter = []LedgerPosting{{
+			Account: LedgerAccountIncome,
+			Amount:  LedgerAmount{Amount: -txn.Amount, Currency: txn.Currency},
+		}}
+	case TransactionTypeBalanceAdjustment:
+		return []LedgerPosting{account, {
+			Account: LedgerAccountAdjustments,
+			Amount:  LedgerAmount{Amount: -flow, Currency: flowCurrency},
+		}}
+	default:
+		// A transfer leg whose transfer record is gone balances against equity.
+		return []LedgerPosting{account, {
+			Account: LedgerAccountTransfers,
+			Amount:  LedgerAmount{Amount: -flow, Currency: flowCurrency},
+		}}
+	}
+
+	if flowCurrency != txn.Currency {
+		account.Cost = &LedgerAmount{Amount: txn.Amount, Currency: txn.Currency}
+	}
+	return append([]LedgerPosting{account}, counter...)
+}
+
+// currencyOf returns the currency of an account, or fallback when the account is unknown.
+func (l *Ledger) currencyOf(accountID AccountID, fallback Currency) Currency {
+	if a, ok := l.accounts[accountID]; ok {
+		return a.Currency
+	}
+	return fallback
+}
+
+// ledgerWriter streams ledger entries to an output in a specific format.
+type ledgerWriter interface {
+	// Begin writes any preamble, such as headers and account declarations.
+	Begin() error
+	// Write writes a single entry.
+	Write(e *LedgerEntry) error
+	// Close writes any trailing content and flushes buffered output.
+	Close() error
+}
+
+// newLedgerWriter returns a writer for the given format.
+func newLedgerWriter(format LedgerFormat, w io.Writer, l *Ledger) (ledgerWriter, error) {
+	switch format {
+	case LedgerFormatCSV:
+		return newLedgerCSVWriter(w, l), nil
+	case LedgerFormatJSONL:
+		return newLedgerJSONLWriter(w, l), nil
+	case LedgerFormatOFX:
+		return newLedgerOFXWriter(w, l), nil
+	case LedgerFormatBeancount:
+		return newLedgerTextWriter(w, l, true), nil
+	case LedgerFormatHledger:
+		return newLedgerTextWriter(w, l, false), nil
+	default:
+		return nil, fmt.Errorf("unsupported ledger format: %q", format)
+	}
+}
+
+// WriteLedger writes the entries in the given format. Entries are expected in date order.
+func WriteLedger(format LedgerFormat, w io.Writer, l *Ledger, entries []*LedgerEntry) error {
+	lw, err := newLedgerWriter(format, w, l)
+	if err != nil {
+		return err
+	}
+	if err := lw.Begin(); err != nil {
+		return err
+	}
+	for _, e := range entries {
+		if err := lw.Write(e); err != nil {
+			return err
+		}
+	}
+	return lw.Close()
+}
@@ -0,0 +1,324 @@
+package finance
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/masterkeysrd/saturn/internal/platform/id"
+)
+
+func ledgerTestFixture() (*Ledger, []*LedgerEntry) {
+	checking := &Account{ID: "acc_checking", Name: "Main checking", Type: AccountTypeBank, Currency: "USD", CurrentBalance: 120000}
+	savings := &Account{ID: "acc_savings", Name: "Euro savings", Type: AccountTypeBank, Currency: "EUR"}
+	card := &Account{ID: "acc_card", Name: "Visa", Type: AccountTypeCreditCard, Currency: "USD", CurrentBalance: 2500}
+	food := &Budget{ID: "bud_food", Name: "Food & drinks", Currency: "USD"}
+	home := &Budget{ID: "bud_home", Name: "home", Currency: "USD"}
+
+	start := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC)
+	l := NewLedger("USD", start, end, []*Account{checking, savings, card}, []*Budget{food, home})
+
+	day := func(d int) time.Time { return time.Date(2026, 3, d, 12, 0, 0, 0, time.UTC) }
+	transferID := TransferID("trf_move")
+	transfer := &Transfer{
+		ID:                   transferID,
+		SourceAccountID:      checking.ID,
+		DestinationAccountID: savings.ID,
+		SourceAmount:         11000,
+		DestinationAmount:    10000,
+		TransferDate:         day(4),
+	}
+
+	entries := []*LedgerEntry{
+		{Transaction: &Transaction{
+			ID: "txn_salary", Type: TransactionTypeIncome, AccountID: &checking.ID,
+			Amount: 300000, Currency: "USD", AmountInBase: 300000, Description: "Salary",
+			TransactionDate: day(1), Cleared: true,
+		}},
+		{Transaction: &Transaction{
+			ID: "txn_groceries", Type: TransactionTypeExpense, AccountID: &card.ID,
+			Amount: 4520, Currency: "USD", AmountInBase: 4520, Description: `Market "Fresh"`,
+			Tags: []string{"weekly shop"}, TransactionDate: day(3),
+			Splits: []*TransactionSplit{
+				{BudgetID: &food.ID, Amount: 3000, AmountInBase: 3000},
+				{BudgetID: &home.ID, Amount: 1520, AmountInBase: 1520},
+			},
+		}},
+		{Transaction: &Transaction{
+			ID: "txn_move_out", Type: TransactionTypeTransferOut, AccountID: &checking.ID,
+			Amount: 11000, Currency: "USD", AmountInBase: 11000, Description: "To savings",
+			TransactionDate: day(4), Cleared: true,
+			Metadata: TransactionMetadata{TransferID: &transferID, CounterpartAccountID: &savings.ID},
+		}, Transfer: transfer},
+		{Transaction: &Transaction{
+			ID: "txn_move_in", Type: TransactionTypeTransferIn, AccountID: &savings.ID,
+			Amount: 10000, Currency: "EUR", AmountInBase: 11000, Description: "From checking",
+			TransactionDate: day(4), Cleared: true,
+			Metadata: TransactionMetadata{TransferID: &transferID, CounterpartAccountID: &checking.ID},
+		}, Transfer: transfer},
+		{Transaction: &Transaction{
+			ID: "txn_museum", Type: TransactionTypeExpense, AccountID: &checking.ID, BudgetID: &home.ID,
+			Amount: 2000, Currency: "EUR", AmountInBase: 2200, Description: "Museum; Paris",
+			TransactionDate: day(10), Cleared: true,
+			Metadata: TransactionMetadata{AccountImpactAmount: 2200},
+		}},
+	}
+	return l, entries
+}
+
+func TestNewLedger_AccountNames(t *testing.T) {
+	accounts := []*Account{
+		{ID: "acc_1", Name: "Main checking", Type: AccountTypeBank},
+		{ID: "acc_2", Name: "Main  checking!", Type: AccountTypeBank},
+		{ID: "acc_3", Name: "visa gold", Type: AccountTypeCreditCard},
+		{ID: "acc_4", Name: "***", Type: AccountTypeCash},
+		{ID: "acc_5", Name: "_wallet", Type: AccountTypeDigitalAccount},
+	}
+	budgets := []*Budget{
+		{ID: "bud_1", Name: "Food & drinks"},
+		{ID: "bud_2", Name: "Uncategorized"},
+	}
+	l := NewLedger("USD", time.Now(), time.Now(), accounts, budgets)
+
+	tests := []struct {
+		got, want string
+	}{
+		{l.AccountName(&accounts[0].ID), "Assets:Main-checking"},
+		{l.AccountName(&accounts[1].ID), "Assets:Main-checking-2"},
+		{l.AccountName(&accounts[2].ID), "Liabilities:Visa-gold"},
+		{l.AccountName(&accounts[3].ID), "Assets:Unnamed"},
+		{l.AccountName(&accounts[4].ID), "Assets:Wallet"},
+		{l.AccountName(nil), LedgerAccountUnassigned},
+		{l.BudgetName(&budgets[0].ID), "Expenses:Food-drinks"},
+		{l.BudgetName(&budgets[1].ID), "Expenses:Uncategorized-2"},
+		{l.BudgetName(nil), LedgerAccountUncategorized},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("got %q, want %q", tt.got, tt.want)
+		}
+	}
+}
+
+func TestLedgerQuery_Validate(t *testing.T) {
+	spIDStr, _ := id.Generate("spc_")
+	q := &LedgerQuery{
+		SpaceID:   SpaceID(spIDStr),
+		Format:    LedgerFormatCSV,
+		StartDate: time.Date(2026, 1, 1, 15, 30, 0, 0, time.UTC),
+		EndDate:   time.Date(2026, 1, 31, 8, 0, 0, 0, time.UTC),
+	}
+	if err := q.Validate(); err != nil {
+		t.Fatalf("Validate failed: %v", err)
+	}
+	if !q.StartDate.Equal(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("start date not truncated: %v", q.StartDate)
+	}
+	if got := q.rangeEnd(); got.Day() != 31 || got.Hour() != 23 {
+		t.Errorf("range end should cover the whole end day, got %v", got)
+	}
+
+	q.EndDate = time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC)
+	if err := q.Validate(); err == nil {
+		t.Error("expected error for end date before start date")
+	}
+
+	q.EndDate = time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	q.Format = "xlsx"
+	if err := q.Validate(); err == nil {
+		t.Error("expected error for unsupported format")
+	}
+}
+
+func TestLedgerFormat_FileName(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC)
+	if got := LedgerFormatHledger.FileName(start, end); got != "ledger-20260101-20260331.journal" {
+		t.Errorf("unexpected hledger file name %q", got)
+	}
+	if got := LedgerFormatCSV.FileName(start, end); got != "ledger-20260101-20260331.csv" {
+		t.Errorf("unexpected CSV file name %q", got)
+	}
+}
+
+func TestLedger_PostingsBalance(t *testing.T) {
+	l, entries := ledgerTestFixture()
+	for _, e := range entries {
+		postings := l.Postings(e)
+		if e.Transaction.Type == TransactionTypeTransferIn {
+			if len(postings) != 0 {
+				t.Errorf("%s: incoming transfer leg should be booked with the outgoing leg", e.Transaction.ID)
+			}
+			continue
+		}
+		// Sum the weight of every posting in the currency it balances in.
+		sums := make(map[Currency]int64)
+		for _, p := range postings {
+			if p.Cost != nil {
+				if p.Amount.Amount < 0 {
+					sums[p.Cost.Currency] -= p.Cost.Amount
+				} else {
+					sums[p.Cost.Currency] += p.Cost.Amount
+				}
+				continue
+			}
+			sums[p.Amount.Currency] += p.Amount.Amount
+		}
+		for cur, sum := range sums {
+			if sum != 0 {
+				t.Errorf("%s: postings do not balance in %s: %+v", e.Transaction.ID, cur, postings)
+			}
+		}
+	}
+}
+
+func TestWriteLedger_Beancount(t *testing.T) {
+	l, entries := ledgerTestFixture()
+	var buf bytes.Buffer
+	if err := WriteLedger(LedgerFormatBeancount, &buf, l, entries); err != nil {
+		t.Fatalf("WriteLedger failed: %v", err)
+	}
+	out := buf.String()
+
+	for _, want := range []string{
+		`option "operating_currency" "USD"`,
+		"2026-03-01 open Assets:Main-checking USD",
+		"2026-03-01 open Liabilities:Visa USD",
+		"2026-03-01 open Expenses:Food-drinks\n",
+		"2026-03-01 * \"Salary\"\n  id: \"txn_salary\"\n  Assets:Main-checking  3000.00 USD\n  Income:Uncategorized  -3000.00 USD\n",
+		"2026-03-03 ! \"Market \\\"Fresh\\\"\" #weekly-shop\n",
+		"  Liabilities:Visa  -45.20 USD\n  Expenses:Food-drinks  30.00 USD\n  Expenses:Home  15.20 USD\n",
+		"  transfer: \"trf_move\"\n  Assets:Euro-savings  100.00 EUR\n  Assets:Main-checking  -110.00 USD @@ 100.00 EUR\n",
+		"  Assets:Main-checking  -22.00 USD @@ 20.00 EUR\n  Expenses:Home  20.00 EUR\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("beancount output missing %q\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "txn_move_in") {
+		t.Errorf("incoming transfer leg should not produce its own entry\n%s", out)
+	}
+}
+
+func TestWriteLedger_Hledger(t *testing.T) {
+	l, entries := ledgerTestFixture()
+	var buf bytes.Buffer
+	if err := WriteLedger(LedgerFormatHledger, &buf, l, entries); err != nil {
+		t.Fatalf("WriteLedger failed: %v", err)
+	}
+	out := buf.String()
+
+	for _, want := range []string{
+		"account Assets:Main-checking\n",
+		"account Equity:Transfers\n",
+		"2026-03-03 ! Market \"Fresh\"  ; id:txn_groceries, weekly-shop:\n",
+		"2026-03-10 * Museum, Paris  ; id:txn_museum\n    Assets:Main-checking  -22.00 USD @@ 20.00 EUR\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("hledger output missing %q\n%s", want, out)
+		}
+	}
+}
+
+func TestWriteLedger_CSV(t *testing.T) {
+	l, entries := ledgerTestFixture()
+	var buf bytes.Buffer
+	if err := WriteLedger(LedgerFormatCSV, &buf, l, entries); err != nil {
+		t.Fatalf("WriteLedger failed: %v", err)
+	}
+
+	rows, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatalf("output is not valid CSV: %v", err)
+	}
+	// Header, salary, two split lines, two transfer legs and the museum ticket.
+	if len(rows) != 7 {
+		t.Fatalf("expected 7 rows, got %d: %v", len(rows), rows)
+	}
+	if strings.Join(rows[0], ",") != strings.Join(ledgerCSVHeader, ",") {
+		t.Errorf("unexpected header %v", rows[0])
+	}
+	if rows[2][4] != "Food & drinks" || rows[2][6] != "-30.00" || rows[3][4] != "home" || rows[3][6] != "-15.20" {
+		t.Errorf("unexpected split rows %v / %v", rows[2], rows[3])
+	}
+	if rows[4][11] != "trf_move" || rows[4][12] != "Euro savings" || rows[4][6] != "-110.00" {
+		t.Errorf("unexpected transfer row %v", rows[4])
+	}
+	if rows[5][6] != "100.00" || rows[5][7] != "EUR" || rows[5][8] != "110.00" {
+		t.Errorf("unexpected incoming transfer row %v", rows[5])
+	}
+}
+
+func TestWriteLedger_JSONL(t *testing.T) {
+	l, entries := ledgerTestFixture()
+	var buf bytes.Buffer
+	if err := WriteLedger(LedgerFormatJSONL, &buf, l, entries); err != nil {
+		t.Fatalf("WriteLedger failed: %v", err)
+	}
+
+	counts := make(map[string]int)
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		var rec map[string]any
+		if err := json.Unmarshal([]byte(line), &rec); err != nil {
+			t.Fatalf("invalid JSON line %q: %v", line, err)
+		}
+		counts[rec["record"].(string)]++
+		if rec["id"] == "txn_museum" && rec["account_amount"] != float64(-2200) {
+			t.Errorf("expected account amount in account currency, got %v", rec["account_amount"])
+		}
+	}
+	if counts["account"] != 3 || counts["budget"] != 2 || counts["transaction"] != 5 || counts["transfer"] != 1 {
+		t.Errorf("unexpected record counts %v", counts)
+	}
+}
+
+func TestWriteLedger_OFXRoundTrip(t *testing.T) {
+	l, entries := ledgerTestFixture()
+	var buf bytes.Buffer
+	if err := WriteLedger(LedgerFormatOFX, &buf, l, entries); err != nil {
+		t.Fatalf("WriteLedger failed: %v", err)
+	}
+	out := buf.String()
+	if !strings.Contains(out, "<CREDITCARDMSGSRSV1>") || !strings.Contains(out, "<BALAMT>-25.00</BALAMT>") {
+		t.Errorf("expected a credit card statement with a negative balance\n%s", out)
+	}
+
+	parsed, err := parseOFXStatement(buf.Bytes(), "USD")
+	if err != nil {
+		t.Fatalf("exported OFX does not parse: %v", err)
+	}
+	got := make(map[string]*StatementEntry, len(parsed))
+	for _, e := range parsed {
+		got[e.Reference] = e
+	}
+	want := map[string]struct {
+		amount   int64
+		currency Currency
+	}{
+		"txn_salary":    {300000, "USD"},
+		"txn_groceries": {-4520, "USD"},
+		"txn_move_out":  {-11000, "USD"},
+		"txn_move_in":   {10000, "EUR"},
+		"txn_museum":    {-2200, "USD"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("expected %d transactions, got %d", len(want), len(got))
+	}
+	for ref, w := range want {
+		e, ok := got[ref]
+		if !ok {
+			t.Errorf("missing transaction %s", ref)
+			continue
+		}
+		if e.Amount != w.amount || e.Currency != w.currency {
+			t.Errorf("%s: got %d %s, want %d %s", ref, e.Amount, e.Currency, w.amount, w.currency)
+		}
+	}
+	if got["txn_salary"].Date != time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC) {
+		t.Errorf("unexpected posted date %v", got["txn_salary"].Date)
+	}
+}
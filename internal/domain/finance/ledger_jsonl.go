@@ -0,0 +1,168 @@
+package finance
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+)
+
+// ledgerJSONLWriter writes one JSON object per line. Accounts and budgets come first, followed by
+// transactions in date order; each transfer is written once, right before its first leg.
+type ledgerJSONLWriter struct {
+	w         *bufio.Writer
+	enc       *json.Encoder
+	l         *Ledger
+	transfers map[TransferID]bool
+}
+
+type ledgerJSONAccount struct {
+	Record         string `json:"record"`
+	ID             string `json:"id"`
+	Name           string `json:"name"`
+	LedgerAccount  string `json:"ledger_account"`
+	Type           string `json:"type"`
+	Currency       string `json:"currency"`
+	InitialBalance int64  `json:"initial_balance"`
+	CurrentBalance int64  `json:"current_balance"`
+}
+
+type ledgerJSONBudget struct {
+	Record        string `json:"record"`
+	ID            string `json:"id"`
+	Name          string `json:"name"`
+	LedgerAccount string `json:"ledger_account"`
+	Currency      string `json:"currency"`
+	LimitAmount   int64  `json:"limit_amount"`
+	Interval      string `json:"interval"`
+}
+
+type ledgerJSONTransfer struct {
+	Record               string `json:"record"`
+	ID                   string `json:"id"`
+	Date                 string `json:"date"`
+	SourceAccountID      string `json:"source_account_id"`
+	DestinationAccountID string `json:"destination_account_id"`
+	SourceAmount         int64  `json:"source_amount"`
+	DestinationAmount    int64  `json:"destination_amount"`
+	Notes                string `json:"notes,omitempty"`
+}
+
+type ledgerJSONSplit struct {
+	BudgetID     string `json:"budget_id,omitempty"`
+	Amount       int64  `json:"amount"`
+	AmountInBase int64  `json:"amount_in_base"`
+	Note         string `json:"note,omitempty"`
+}
+
+type ledgerJSONTransaction struct {
+	Record        string            `json:"record"`
+	ID            string            `json:"id"`
+	Date          string            `json:"date"`
+	Type          string            `json:"type"`
+	AccountID     string            `json:"account_id,omitempty"`
+	BudgetID      string            `json:"budget_id,omitempty"`
+	CategoryID    string            `json:"category_id,omitempty"`
+	Description   string            `json:"description"`
+	Amount        int64             `json:"amount"`
+	Currency      string            `json:"currency"`
+	AmountInBase  int64             `json:"amount_in_base"`
+	AccountAmount int64             `json:"account_amount"`
+	Tags          []string          `json:"tags,omitempty"`
+	Splits        []ledgerJSONSplit `json:"splits,omitempty"`
+	TransferID    string            `json:"transfer_id,omitempty"`
+	Cleared       bool              `json:"cleared"`
+}
+
+func newLedgerJSONLWriter(w io.Writer, l *Ledger) *ledgerJSONLWriter {
+	bw := bufio.NewWriter(w)
+	return &ledgerJSONLWriter{w: bw, enc: json.NewEncoder(bw), l: l, transfers: make(map[TransferID]bool)}
+}
+
+func (jw *ledgerJSONLWriter) Begin() error {
+	for _, a := range jw.l.Accounts {
+		if err := jw.enc.Encode(ledgerJSONAccount{
+			Record:         "account",
+			ID:             a.ID.String(),
+			Name:           a.Name,
+			LedgerAccount:  jw.l.AccountName(&a.ID),
+			Type:           string(a.Type),
+			Currency:       string(a.Currency),
+			InitialBalance: a.InitialBalance,
+			CurrentBalance: a.CurrentBalance,
+		}); err != nil {
+			return err
+		}
+	}
+	for _, b := range jw.l.Budgets {
+		if err := jw.enc.Encode(ledgerJSONBudget{
+			Record:        "budget",
+			ID:            b.ID.String(),
+			Name:          b.Name,
+			LedgerAccount: jw.l.BudgetName(&b.ID),
+			Currency:      string(b.Currency),
+			LimitAmount:   b.LimitAmount,
+			Interval:      string(b.Interval),
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (jw *ledgerJSONLWriter) Write(e *LedgerEntry) error {
+	if tr := e.Transfer; tr != nil && !jw.transfers[tr.ID] {
+		jw.transfers[tr.ID] = true
+		if err := jw.enc.Encode(ledgerJSONTransfer{
+			Record:               "transfer",
+			ID:                   tr.ID.String(),
+			Date:                 tr.TransferDate.UTC().Format("2006-01-02"),
+			SourceAccountID:      tr.SourceAccountID.String(),
+			DestinationAccountID: tr.DestinationAccountID.String(),
+			SourceAmount:         tr.SourceAmount,
+			DestinationAmount:    tr.DestinationAmount,
+			Notes:                tr.Notes,
+		}); err != nil {
+			return err
+		}
+	}
+
+	txn := e.Transaction
+	flow, _ := jw.l.AccountFlow(txn)
+	rec := ledgerJSONTransaction{
+		Record:        "transaction",
+		ID:            txn.ID.String(),
+		Date:          txn.TransactionDate.UTC().Format("2006-01-02"),
+		Type:          string(txn.Type),
+		Description:   txn.Description,
+		Amount:        txn.Amount,
+		Currency:      string(txn.Currency),
+		AmountInBase:  txn.AmountInBase,
+		AccountAmount: flow,
+		Tags:          txn.Tags,
+		Cleared:       txn.Cleared,
+	}
+	if txn.AccountID != nil {
+		rec.AccountID = txn.AccountID.String()
+	}
+	if txn.BudgetID != nil {
+		rec.BudgetID = txn.BudgetID.String()
+	}
+	if txn.CategoryID != nil {
+		rec.CategoryID = txn.CategoryID.String()
+	}
+	if txn.Metadata.TransferID != nil {
+		rec.TransferID = txn.Metadata.TransferID.String()
+	}
+	for _, s := range txn.Splits {
+		split := ledgerJSONSplit{Amount: s.Amount, AmountInBase: s.AmountInBase, Note: s.Note}
+		if s.BudgetID != nil {
+			split.BudgetID = s.BudgetID.String()
+		}
+		rec.Splits = append(rec.Splits, split)
+	}
+	return jw.enc.Encode(rec)
+}
+
+func (jw *ledgerJSONLWriter) Close() error {
+	return jw.w.Flush()
+}
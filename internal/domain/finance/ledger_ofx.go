@@ -0,0 +1,168 @@
+package finance
+
+import (
+	"bufio"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// ofxNameMaxLength is the maximum length of the <NAME> element in OFX 2.2.
+const ofxNameMaxLength = 32
+
+// ledgerOFXWriter writes an OFX 2.2 document with one statement per account. OFX statements are
+// grouped by account, so entries are buffered until Close. Transactions without an account are skipped.
+type ledgerOFXWriter struct {
+	w        io.Writer
+	l        *Ledger
+	order    []AccountID
+	accounts map[AccountID][]*Transaction
+}
+
+func newLedgerOFXWriter(w io.Writer, l *Ledger) *ledgerOFXWriter {
+	return &ledgerOFXWriter{w: w, l: l, accounts: make(map[AccountID][]*Transaction)}
+}
+
+func (ow *ledgerOFXWriter) Begin() error {
+	return nil
+}
+
+func (ow *ledgerOFXWriter) Write(e *LedgerEntry) error {
+	txn := e.Transaction
+	if ow.l.Account(txn.AccountID) == nil {
+		return nil
+	}
+	id := *txn.AccountID
+	if _, ok := ow.accounts[id]; !ok {
+		ow.order = append(ow.order, id)
+	}
+	ow.accounts[id] = append(ow.accounts[id], txn)
+	return nil
+}
+
+func (ow *ledgerOFXWriter) Close() error {
+	bw := bufio.NewWriter(ow.w)
+	now := ofxDateTime(time.Now())
+
+	fmt.Fprint(bw, xml.Header)
+	fmt.Fprintln(bw, `<?OFX OFXHEADER="200" VERSION="220" SECURITY="NONE" OLDFILEUID="NONE" NEWFILEUID="NONE"?>`)
+	fmt.Fprintln(bw, "<OFX>")
+	fmt.Fprintln(bw, "<SIGNONMSGSRSV1><SONRS>")
+	fmt.Fprintln(bw, "<STATUS><CODE>0</CODE><SEVERITY>INFO</SEVERITY></STATUS>")
+	fmt.Fprintf(bw, "<DTSERVER>%s</DTSERVER>\n", now)
+	fmt.Fprintln(bw, "<LANGUAGE>ENG</LANGUAGE>")
+	fmt.Fprintln(bw, "</SONRS></SIGNONMSGSRSV1>")
+
+	var bank, cards []AccountID
+	for _, id := range ow.order {
+		if ow.l.accounts[id].Type == AccountTypeCreditCard {
+			cards = append(cards, id)
+		} else {
+			bank = append(bank, id)
+		}
+	}
+
+	if len(bank) > 0 {
+		fmt.Fprintln(bw, "<BANKMSGSRSV1>")
+		for i, id := range bank {
+			ow.writeStatement(bw, id, i+1, now)
+		}
+		fmt.Fprintln(bw, "</BANKMSGSRSV1>")
+	}
+	if len(cards) > 0 {
+		fmt.Fprintln(bw, "<CREDITCARDMSGSRSV1>")
+		for i, id := range cards {
+			ow.writeStatement(bw, id, len(bank)+i+1, now)
+		}
+		fmt.Fprintln(bw, "</CREDITCARDMSGSRSV1>")
+	}
+
+	fmt.Fprintln(bw, "</OFX>")
+	return bw.Flush()
+}
+
+func (ow *ledgerOFXWriter) writeStatement(w io.Writer, accountID AccountID, trnUID int, now string) {
+	account := ow.l.accounts[accountID]
+	card := account.Type == AccountTypeCreditCard
+
+	wrapper, stmt, acctFrom := "STMTTRNRS", "STMTRS", "BANKACCTFROM"
+	if card {
+		wrapper, stmt, acctFrom = "CCSTMTTRNRS", "CCSTMTRS", "CCACCTFROM"
+	}
+
+	fmt.Fprintf(w, "<%s>\n", wrapper)
+	fmt.Fprintf(w, "<TRNUID>%d</TRNUID>\n", trnUID)
+	fmt.Fprintln(w, "<STATUS><CODE>0</CODE><SEVERITY>INFO</SEVERITY></STATUS>")
+	fmt.Fprintf(w, "<%s>\n", stmt)
+	fmt.Fprintf(w, "<CURDEF>%s</CURDEF>\n", account.Currency)
+	fmt.Fprintf(w, "<%s>", acctFrom)
+	if !card {
+		fmt.Fprint(w, "<BANKID>SATURN</BANKID>")
+	}
+	fmt.Fprintf(w, "<ACCTID>%s</ACCTID>", ofxEscape(account.ID.String()))
+	if !card {
+		fmt.Fprint(w, "<ACCTTYPE>CHECKING</ACCTTYPE>")
+	}
+	fmt.Fprintf(w, "</%s>\n", acctFrom)
+
+	fmt.Fprintln(w, "<BANKTRANLIST>")
+	fmt.Fprintf(w, "<DTSTART>%s</DTSTART>\n", ofxDateTime(ow.l.StartDate))
+	fmt.Fprintf(w, "<DTEND>%s</DTEND>\n", ofxDateTime(ow.l.EndDate))
+	for _, txn := range ow.accounts[accountID] {
+		flow, currency := ow.l.AccountFlow(txn)
+		fmt.Fprintln(w, "<STMTTRN>")
+		fmt.Fprintf(w, "<TRNTYPE>%s</TRNTYPE>\n", ofxTransactionType(txn, flow))
+		fmt.Fprintf(w, "<DTPOSTED>%s</DTPOSTED>\n", ofxDateTime(txn.TransactionDate))
+		fmt.Fprintf(w, "<TRNAMT>%s</TRNAMT>\n", currency.FormatDecimal(flow))
+		fmt.Fprintf(w, "<FITID>%s</FITID>\n", ofxEscape(txn.ID.String()))
+		if name := ofxName(txn.Description); name != "" {
+			fmt.Fprintf(w, "<NAME>%s</NAME>\n", ofxEscape(name))
+		}
+		if memo := txn.Metadata.Notes; memo != "" {
+			fmt.Fprintf(w, "<MEMO>%s</MEMO>\n", ofxEscape(memo))
+		}
+		fmt.Fprintln(w, "</STMTTRN>")
+	}
+	fmt.Fprintln(w, "</BANKTRANLIST>")
+
+	// Credit card balances are tracked as debt; OFX reports them as a negative balance.
+	balance := account.CurrentBalance
+	if card {
+		balance = -balance
+	}
+	fmt.Fprintf(w, "<LEDGERBAL><BALAMT>%s</BALAMT><DTASOF>%s</DTASOF></LEDGERBAL>\n", account.Currency.FormatDecimal(balance), now)
+	fmt.Fprintf(w, "</%s>\n", stmt)
+	fmt.Fprintf(w, "</%s>\n", wrapper)
+}
+
+func ofxTransactionType(txn *Transaction, flow int64) string {
+	switch {
+	case txn.Type == TransactionTypeTransferIn || txn.Type == TransactionTypeTransferOut:
+		return "XFER"
+	case flow < 0:
+		return "DEBIT"
+	default:
+		return "CREDIT"
+	}
+}
+
+func ofxDateTime(t time.Time) string {
+	return t.UTC().Format("20060102150405") + "[0:GMT]"
+}
+
+// ofxName shortens a description to the OFX <NAME> limit.
+func ofxName(description string) string {
+	description = strings.TrimSpace(description)
+	if runes := []rune(description); len(runes) > ofxNameMaxLength {
+		return string(runes[:ofxNameMaxLength])
+	}
+	return description
+}
+
+func ofxEscape(s string) string {
+	var sb strings.Builder
+	_ = xml.EscapeText(&sb, []byte(s))
+	return sb.String()
+}
@@ -0,0 +1,167 @@
+package finance
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"unicode"
+)
+
+// ledgerTextWriter writes plain-text double-entry journals in beancount or hledger syntax.
+// Both share the same postings and differ only in directives, metadata and tag syntax.
+type ledgerTextWriter struct {
+	w         *bufio.Writer
+	l         *Ledger
+	beancount bool
+}
+
+func newLedgerTextWriter(w io.Writer, l *Ledger, beancount bool) *ledgerTextWriter {
+	return &ledgerTextWriter{w: bufio.NewWriter(w), l: l, beancount: beancount}
+}
+
+// ledgerFallbackAccounts are declared up front so that every posting refers to an open account.
+var ledgerFallbackAccounts = []string{
+	LedgerAccountUnassigned,
+	LedgerAccountUncategorized,
+	LedgerAccountIncome,
+	LedgerAccountAdjustments,
+	LedgerAccountTransfers,
+}
+
+func (tw *ledgerTextWriter) Begin() error {
+	start, end := tw.l.StartDate.Format("2006-01-02"), tw.l.EndDate.Format("2006-01-02")
+
+	type declaration struct {
+		name     string
+		currency Currency
+	}
+	var decls []declaration
+	for _, a := range tw.l.Accounts {
+		decls = append(decls, declaration{name: tw.l.AccountName(&a.ID), currency: a.Currency})
+	}
+	for _, b := range tw.l.Budgets {
+		decls = append(decls, declaration{name: tw.l.BudgetName(&b.ID)})
+	}
+	for _, name := range ledgerFallbackAccounts {
+		decls = append(decls, declaration{name: name})
+	}
+
+	if tw.beancount {
+		fmt.Fprintf(tw.w, "option \"title\" \"Saturn ledger %s to %s\"\n", start, end)
+		if tw.l.BaseCurrency != "" {
+			fmt.Fprintf(tw.w, "option \"operating_currency\" \"%s\"\n", tw.l.BaseCurrency)
+		}
+		fmt.Fprintln(tw.w)
+		for _, d := range decls {
+			if d.currency != "" {
+				fmt.Fprintf(tw.w, "%s open %s %s\n", start, d.name, d.currency)
+			} else {
+				fmt.Fprintf(tw.w, "%s open %s\n", start, d.name)
+			}
+		}
+	} else {
+		fmt.Fprintf(tw.w, "; Saturn ledger %s to %s\n\n", start, end)
+		for _, d := range decls {
+			fmt.Fprintf(tw.w, "account %s\n", d.name)
+		}
+	}
+	_, err := fmt.Fprintln(tw.w)
+	return err
+}
+
+func (tw *ledgerTextWriter) Write(e *LedgerEntry) error {
+	postings := tw.l.Postings(e)
+	if len(postings) == 0 {
+		return nil
+	}
+
+	txn := e.Transaction
+	date := txn.TransactionDate.UTC().Format("2006-01-02")
+	flag := "*"
+	if !txn.Cleared {
+		flag = "!"
+	}
+	description := txn.Description
+	if description == "" && e.Transfer != nil {
+		description = e.Transfer.Notes
+	}
+
+	if tw.beancount {
+		fmt.Fprintf(tw.w, "%s %s %s", date, flag, beancountString(description))
+		for _, tag := range txn.Tags {
+			if t := ledgerTag(tag); t != "" {
+				fmt.Fprintf(tw.w, " #%s", t)
+			}
+		}
+		fmt.Fprintln(tw.w)
+		fmt.Fprintf(tw.w, "  id: %s\n", beancountString(txn.ID.String()))
+		if e.Transfer != nil {
+			fmt.Fprintf(tw.w, "  transfer: %s\n", beancountString(e.Transfer.ID.String()))
+		}
+	} else {
+		comment := []string{"id:" + txn.ID.String()}
+		if e.Transfer != nil {
+			comment = append(comment, "transfer:"+e.Transfer.ID.String())
+		}
+		for _, tag := range txn.Tags {
+			if t := ledgerTag(tag); t != "" {
+				comment = append(comment, t+":")
+			}
+		}
+		fmt.Fprintf(tw.w, "%s %s %s  ; %s\n", date, flag, hledgerDescription(description), strings.Join(comment, ", "))
+	}
+
+	for _, p := range postings {
+		line := fmt.Sprintf("  %s  %s", p.Account, ledgerAmountText(p.Amount))
+		if p.Cost != nil {
+			line += " @@ " + ledgerAmountText(*p.Cost)
+		}
+		if !tw.beancount {
+			line = "  " + line
+		}
+		fmt.Fprintln(tw.w, line)
+	}
+	_, err := fmt.Fprintln(tw.w)
+	return err
+}
+
+func (tw *ledgerTextWriter) Close() error {
+	return tw.w.Flush()
+}
+
+func ledgerAmountText(a LedgerAmount) string {
+	return a.Currency.FormatDecimal(a.Amount) + " " + string(a.Currency)
+}
+
+// beancountString quotes a string literal, escaping quotes and backslashes.
+func beancountString(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	s = strings.ReplaceAll(s, "\n", " ")
+	return `"` + s + `"`
+}
+
+// hledgerDescription keeps a description on one line and out of the trailing comment.
+func hledgerDescription(s string) string {
+	s = strings.ReplaceAll(s, ";", ",")
+	s = strings.Join(strings.Fields(s), " ")
+	if s == "" {
+		return "(no description)"
+	}
+	return s
+}
+
+// ledgerTag reduces a tag to the characters both beancount and hledger accept in tag names.
+func ledgerTag(tag string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case unicode.IsLetter(r), unicode.IsDigit(r), r == '-', r == '_':
+			return r
+		case unicode.IsSpace(r), r == '/', r == '.':
+			return '-'
+		default:
+			return -1
+		}
+	}, strings.TrimSpace(tag))
+}
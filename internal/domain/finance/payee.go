@@ -0,0 +1,263 @@
+package finance
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/masterkeysrd/saturn/internal/platform/id"
+	"github.com/masterkeysrd/saturn/internal/platform/patch"
+	"github.com/masterkeysrd/saturn/internal/platform/sorting"
+)
+
+const (
+	maxPayeeAliases  = 50
+	maxPayeePatterns = 20
+	maxPatternLength = 200
+)
+
+// PayeeID is a custom string type representing a payee's unique identifier (KSUID).
+type PayeeID string
+
+const payeePrefix = "pye_"
+
+// NewPayeeID creates a new PayeeID using the default ID generator.
+func NewPayeeID() (PayeeID, error) {
+	raw, err := id.Generate(payeePrefix)
+	if err != nil {
+		return "", err
+	}
+	return PayeeID(raw), nil
+}
+
+// ParsePayeeID parses a string into a PayeeID and validates it.
+func ParsePayeeID(s string) (PayeeID, error) {
+	if err := id.Validate(s, payeePrefix); err != nil {
+		return "", fmt.Errorf("invalid payee ID: %w", err)
+	}
+	return PayeeID(s), nil
+}
+
+// String returns the string representation.
+func (pid PayeeID) String() string {
+	return string(pid)
+}
+
+// Validate checks if the PayeeID is valid.
+func (pid PayeeID) Validate() error {
+	return id.Validate(string(pid), payeePrefix)
+}
+
+// Payee is a merchant or counterparty that transaction descriptions are normalized to.
+// A description matches the payee when it contains the name or one of the aliases as whole words,
+// ignoring case and punctuation, or when one of the patterns matches it.
+type Payee struct {
+	ID               PayeeID
+	SpaceID          SpaceID
+	Name             string
+	Aliases          []string   // Alternative spellings, e.g. "AMZN MKTP" for Amazon
+	Patterns         []string   // Case-insensitive regular expressions
+	DefaultBudgetID  *BudgetID  // Nullable; budget assigned to unbudgeted expenses
+	DefaultAccountID *AccountID // Nullable; account assigned to transactions without one
+	LogoURL          string
+	CreateTime       time.Time
+	UpdateTime       time.Time
+}
+
+// Validate checks the payee's business rules, normalizing aliases and compiling patterns.
+func (p *Payee) Validate() error {
+	if err := p.ID.Validate(); err != nil {
+		return fmt.Errorf("validate payee ID: %w", err)
+	}
+	if err := p.SpaceID.Validate(); err != nil {
+		return fmt.Errorf("validate space ID: %w", err)
+	}
+	p.Name = strings.TrimSpace(p.Name)
+	if p.Name == "" {
+		return errors.New("payee name is required")
+	}
+	if len(p.Name) > 100 {
+		return errors.New("payee name must not exceed 100 characters")
+	}
+
+	aliases := make([]string, 0, len(p.Aliases))
+	for _, alias := range p.Aliases {
+		alias = strings.TrimSpace(alias)
+		if alias == "" || slices.Contains(aliases, alias) {
+			continue
+		}
+		if len(alias) > 100 {
+			return fmt.Errorf("payee alias %q must not exceed 100 characters", alias)
+		}
+		if normalizePayeeText(alias) == "" {
+			return fmt.Errorf("payee alias %q must contain a letter or digit", alias)
+		}
+		aliases = append(aliases, alias)
+	}
+	if len(aliases) > maxPayeeAliases {
+		return fmt.Errorf("a payee can have at most %d aliases", maxPayeeAliases)
+	}
+	p.Aliases = aliases
+
+	patterns := make([]string, 0, len(p.Patterns))
+	for _, pattern := range p.Patterns {
+		pattern = strings.TrimSpace(pattern)
+		if pattern == "" {
+			continue
+		}
+		if _, err := compilePattern(pattern); err != nil {
+			return err
+		}
+		patterns = append(patterns, pattern)
+	}
+	if len(patterns) > maxPayeePatterns {
+		return fmt.Errorf("a payee can have at most %d patterns", maxPayeePatterns)
+	}
+	p.Patterns = patterns
+
+	if p.DefaultBudgetID != nil {
+		if err := p.DefaultBudgetID.Validate(); err != nil {
+			return fmt.Errorf("validate default budget ID: %w", err)
+		}
+	}
+	if p.DefaultAccountID != nil {
+		if err := p.DefaultAccountID.Validate(); err != nil {
+			return fmt.Errorf("validate default account ID: %w", err)
+		}
+	}
+
+	p.LogoURL = strings.TrimSpace(p.LogoURL)
+	if p.LogoURL != "" {
+		if len(p.LogoURL) > 2048 {
+			return errors.New("payee logo URL must not exceed 2048 characters")
+		}
+		u, err := url.Parse(p.LogoURL)
+		if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+			return errors.New("payee logo URL must be an absolute http(s) URL")
+		}
+	}
+	return nil
+}
+
+// PayeePatchSchema defines all patchable fields for a Payee entity.
+var PayeePatchSchema = patch.NewSchema[Payee]().
+	Register("name", patch.Field(func(p *Payee) *string { return &p.Name })).
+	Register("aliases", patch.Field(func(p *Payee) *[]string { return &p.Aliases })).
+	Register("patterns", patch.Field(func(p *Payee) *[]string { return &p.Patterns })).
+	Register("default_budget_id", patch.Field(func(p *Payee) **BudgetID { return &p.DefaultBudgetID })).
+	Register("default_account_id", patch.Field(func(p *Payee) **AccountID { return &p.DefaultAccountID })).
+	Register("logo_url", patch.Field(func(p *Payee) *string { return &p.LogoURL }))
+
+// ApplyPatch applies partial updates from an incoming payee based on the field mask.
+func (p *Payee) ApplyPatch(incoming *Payee, mask []string) error {
+	if err := PayeePatchSchema.Apply(p, incoming, mask); err != nil {
+		return err
+	}
+	p.UpdateTime = time.Now().UTC()
+	return p.Validate()
+}
+
+// DefaultPayeeSortField represents the fallback sorting column name for payees.
+const DefaultPayeeSortField = "name"
+
+// PayeeSortFields registry maps sortable payee field names to their cursor string extraction logic.
+var PayeeSortFields = map[string]func(*Payee) string{
+	"name":        func(p *Payee) string { return p.Name },
+	"create_time": func(p *Payee) string { return p.CreateTime.Format(time.RFC3339Nano) },
+}
+
+// IsPayeeSortField validates if a sort column name is allowed.
+func IsPayeeSortField(field string) bool {
+	_, ok := PayeeSortFields[field]
+	return ok
+}
+
+// GetSortValue extracts the sort value string for pagination cursor generation.
+func (p *Payee) GetSortValue(field string) string {
+	if fn, ok := PayeeSortFields[field]; ok {
+		return fn(p)
+	}
+	return p.GetSortValue(DefaultPayeeSortField)
+}
+
+// ListPayeesFilter encapsulates filtering parameters for listing payees.
+type ListPayeesFilter struct {
+	PageSize      int32
+	NextPageToken string
+	Sort          sorting.SortOrder
+}
+
+// payeeMatcher is a payee with its name, aliases and patterns prepared for matching.
+type payeeMatcher struct {
+	payee    *Payee
+	terms    []string
+	patterns []*regexp.Regexp
+}
+
+func newPayeeMatcher(p *Payee) (*payeeMatcher, error) {
+	m := &payeeMatcher{payee: p}
+	for _, term := range append([]string{p.Name}, p.Aliases...) {
+		if t := normalizePayeeText(term); t != "" {
+			m.terms = append(m.terms, " "+t+" ")
+		}
+	}
+	for _, pattern := range p.Patterns {
+		re, err := compilePattern(pattern)
+		if err != nil {
+			return nil, err
+		}
+		m.patterns = append(m.patterns, re)
+	}
+	return m, nil
+}
+
+// match reports how specific the payee's match against the description is: the length of the
+// longest matching term, the pattern length for a pattern match, or zero when it does not match.
+func (m *payeeMatcher) match(description, normalized string) int {
+	var best int
+	for _, term := range m.terms {
+		if strings.Contains(normalized, term) {
+			best = max(best, len(term)-2)
+		}
+	}
+	for i, re := range m.patterns {
+		if re.MatchString(description) {
+			best = max(best, len(m.payee.Patterns[i]))
+		}
+	}
+	return best
+}
+
+// Matches reports whether the description refers to the payee.
+func (p *Payee) Matches(description string) bool {
+	m, err := newPayeeMatcher(p)
+	if err != nil {
+		return false
+	}
+	return m.match(description, " "+normalizePayeeText(description)+" ") > 0
+}
+
+// normalizePayeeText lowercases text and collapses every run of characters other than letters and
+// digits into a single space, so "AMZN Mktp US*2K3" becomes "amzn mktp us 2k3".
+func normalizePayeeText(s string) string {
+	return strings.Join(strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}), " ")
+}
+
+// compilePattern compiles a user supplied case-insensitive regular expression.
+func compilePattern(pattern string) (*regexp.Regexp, error) {
+	if len(pattern) > maxPatternLength {
+		return nil, fmt.Errorf("pattern %q must not exceed %d characters", pattern, maxPatternLength)
+	}
+	re, err := regexp.Compile("(?i)" + pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pattern %q: %w", pattern, err)
+	}
+	return re, nil
+}
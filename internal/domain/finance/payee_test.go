@@ -0,0 +1,90 @@
+package finance
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPayee_Validate(t *testing.T) {
+	payeeID, _ := NewPayeeID()
+	spaceID := SpaceID("spc_2NzN5q3y1pUQvU1ZQ9t2tq8xKxF")
+
+	tests := []struct {
+		name    string
+		payee   Payee
+		wantErr string
+	}{
+		{
+			name:  "valid",
+			payee: Payee{ID: payeeID, SpaceID: spaceID, Name: "Amazon", Aliases: []string{"AMZN MKTP"}, Patterns: []string{`^amzn\b`}, LogoURL: "https://example.com/amazon.png"},
+		},
+		{
+			name:    "name required",
+			payee:   Payee{ID: payeeID, SpaceID: spaceID, Name: "  "},
+			wantErr: "name is required",
+		},
+		{
+			name:    "alias without letters",
+			payee:   Payee{ID: payeeID, SpaceID: spaceID, Name: "Amazon", Aliases: []string{"***"}},
+			wantErr: "must contain a letter or digit",
+		},
+		{
+			name:    "invalid pattern",
+			payee:   Payee{ID: payeeID, SpaceID: spaceID, Name: "Amazon", Patterns: []string{"amzn("}},
+			wantErr: "invalid pattern",
+		},
+		{
+			name:    "relative logo URL",
+			payee:   Payee{ID: payeeID, SpaceID: spaceID, Name: "Amazon", LogoURL: "/logo.png"},
+			wantErr: "absolute http(s) URL",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.payee.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+
+	t.Run("normalizes aliases", func(t *testing.T) {
+		p := Payee{ID: payeeID, SpaceID: spaceID, Name: " Amazon ", Aliases: []string{" AMZN ", "AMZN", "", "Amazon.com"}}
+		if err := p.Validate(); err != nil {
+			t.Fatal(err)
+		}
+		if p.Name != "Amazon" || len(p.Aliases) != 2 || p.Aliases[0] != "AMZN" {
+			t.Errorf("unexpected normalized payee: %+v", p)
+		}
+	})
+}
+
+func TestPayee_Matches(t *testing.T) {
+	p := &Payee{Name: "Amazon", Aliases: []string{"AMZN Mktp"}, Patterns: []string{`^WHOLEFDS`}}
+
+	tests := []struct {
+		description string
+		want        bool
+	}{
+		{"AMAZON.COM*2K3 SEATTLE", true},
+		{"AMZN MKTP US*2K3", true},
+		{"amzn-mktp us", true},
+		{"WHOLEFDS MKT 10234", true},
+		{"AMZN DIGITAL", false},
+		{"Amazonia Cafe", false},
+		{"Paid at wholefds", false},
+	}
+
+	for _, tt := range tests {
+		if got := p.Matches(tt.description); got != tt.want {
+			t.Errorf("Matches(%q) = %v, want %v", tt.description, got, tt.want)
+		}
+	}
+}
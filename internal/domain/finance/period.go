@@ -60,6 +60,8 @@ type BudgetPeriod struct {
 	Currency           Currency
 	BaseCurrency       Currency
 	ExchangeRateToBase float64
+	CarriedIn          int64 // Signed balance rolled over from the previous period; negative for a deficit
+	CarriedOut         int64 // Signed balance rolled over into the next period, set once the next period exists
 	CreateTime         time.Time
 	UpdateTime         time.Time
 }
@@ -92,3 +94,8 @@ func (p *BudgetPeriod) Validate() error {
 	}
 	return nil
 }
+
+// EffectiveLimit returns the spendable limit for the period, including any carried-in balance.
+func (p *BudgetPeriod) EffectiveLimit() int64 {
+	return p.LimitAmount + p.CarriedIn
+}
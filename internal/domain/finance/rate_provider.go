@@ -0,0 +1,56 @@
+package finance
+
+import (
+	"context"
+	"time"
+)
+
+// triangulationCurrency is the pivot used to derive a cross rate when neither a direct
+// nor an inverse rate is available for a currency pair.
+const triangulationCurrency Currency = "USD"
+
+// RateProvider fetches reference exchange rates from an external source.
+type RateProvider interface {
+	// LatestRates returns the most recent published rates.
+	LatestRates(ctx context.Context) (*RateSnapshot, error)
+}
+
+// RateSnapshot is a set of rates published by a provider on a single date.
+// Rates are expressed as units of the quoted currency per one unit of Base.
+type RateSnapshot struct {
+	Base  Currency
+	Date  time.Time
+	Rates map[Currency]float64
+}
+
+// quote returns the units of c per one unit of the snapshot base.
+func (s *RateSnapshot) quote(c Currency) (float64, bool) {
+	if c == s.Base {
+		return 1, true
+	}
+	r, ok := s.Rates[c]
+	if !ok || r <= 0 {
+		return 0, false
+	}
+	return r, true
+}
+
+// Cross derives the rate converting one unit of from into to.
+func (s *RateSnapshot) Cross(from, to Currency) (float64, bool) {
+	fromQuote, ok := s.quote(from)
+	if !ok {
+		return 0, false
+	}
+	toQuote, ok := s.quote(to)
+	if !ok {
+		return 0, false
+	}
+	return toQuote / fromQuote, true
+}
+
+// CurrencyPair identifies a conversion a space needs rates for.
+type CurrencyPair struct {
+	SpaceID      SpaceID
+	FromCurrency Currency
+	ToCurrency   Currency
+}
@@ -0,0 +1,89 @@
+package rateprovider
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/masterkeysrd/saturn/internal/domain/finance"
+)
+
+// DefaultECBURL is the European Central Bank daily euro foreign exchange reference rates feed.
+const DefaultECBURL = "https://www.ecb.europa.eu/stats/eurofxref/eurofxref-daily.xml"
+
+// ECBProvider reads the European Central Bank reference rates XML feed. All rates are quoted against EUR.
+type ECBProvider struct {
+	client *http.Client
+	url    string
+}
+
+var _ finance.RateProvider = (*ECBProvider)(nil)
+
+// NewECBProvider creates an ECB provider. An empty url defaults to DefaultECBURL and a nil client to one
+// with a sane timeout.
+func NewECBProvider(client *http.Client, url string) *ECBProvider {
+	if url == "" {
+		url = DefaultECBURL
+	}
+	return &ECBProvider{client: defaultClient(client), url: url}
+}
+
+type ecbEnvelope struct {
+	Cube struct {
+		Days []struct {
+			Time  string `xml:"time,attr"`
+			Rates []struct {
+				Currency string `xml:"currency,attr"`
+				Rate     string `xml:"rate,attr"`
+			} `xml:"Cube"`
+		} `xml:"Cube"`
+	} `xml:"Cube"`
+}
+
+// LatestRates fetches the feed and returns its most recent publication day.
+func (p *ECBProvider) LatestRates(ctx context.Context) (*finance.RateSnapshot, error) {
+	body, err := fetch(ctx, p.client, p.url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("ecb: %w", err)
+	}
+	return parseECB(body)
+}
+
+func parseECB(body []byte) (*finance.RateSnapshot, error) {
+	var env ecbEnvelope
+	if err := xml.Unmarshal(body, &env); err != nil {
+		return nil, fmt.Errorf("ecb: decode feed: %w", err)
+	}
+	if len(env.Cube.Days) == 0 {
+		return nil, errors.New("ecb: feed contains no rates")
+	}
+
+	// The daily feed carries a single day; the historical feeds list the newest day first.
+	day := env.Cube.Days[0]
+	date, err := time.Parse("2006-01-02", day.Time)
+	if err != nil {
+		return nil, fmt.Errorf("ecb: invalid rate date %q: %w", day.Time, err)
+	}
+
+	snapshot := &finance.RateSnapshot{
+		Base:  "EUR",
+		Date:  date,
+		Rates: make(map[finance.Currency]float64, len(day.Rates)),
+	}
+	for _, r := range day.Rates {
+		currency, err := finance.ParseCurrency(r.Currency)
+		if err != nil {
+			continue // Currencies Saturn does not support are ignored
+		}
+		rate, err := strconv.ParseFloat(r.Rate, 64)
+		if err != nil || rate <= 0 {
+			return nil, fmt.Errorf("ecb: invalid rate %q for %s", r.Rate, r.Currency)
+		}
+		snapshot.Rates[currency] = rate
+	}
+	return snapshot, nil
+}
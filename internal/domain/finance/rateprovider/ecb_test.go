@@ -0,0 +1,87 @@
+package rateprovider
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+const ecbFeed = `<?xml version="1.0" encoding="UTF-8"?>
+<gesmes:Envelope xmlns:gesmes="http://www.gesmes.org/xml/2002-08-01" xmlns="http://www.ecb.int/vocabulary/2002-08-01/eurofxref">
+	<gesmes:subject>Reference rates</gesmes:subject>
+	<gesmes:Sender>
+		<gesmes:name>European Central Bank</gesmes:name>
+	</gesmes:Sender>
+	<Cube>
+		<Cube time='2026-10-16'>
+			<Cube currency='USD' rate='1.0850'/>
+			<Cube currency='JPY' rate='162.50'/>
+			<Cube currency='GBP' rate='0.8420'/>
+			<Cube currency='XAU' rate='0.0004'/>
+		</Cube>
+	</Cube>
+</gesmes:Envelope>`
+
+func TestECBProvider_LatestRates(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/xml")
+		_, _ = w.Write([]byte(ecbFeed))
+	}))
+	defer server.Close()
+
+	snapshot, err := NewECBProvider(server.Client(), server.URL).LatestRates(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if snapshot.Base != "EUR" {
+		t.Errorf("expected base EUR, got %s", snapshot.Base)
+	}
+	if got := snapshot.Date.Format("2006-01-02"); got != "2026-10-16" {
+		t.Errorf("expected date 2026-10-16, got %s", got)
+	}
+	if snapshot.Rates["USD"] != 1.085 {
+		t.Errorf("expected USD rate 1.085, got %v", snapshot.Rates["USD"])
+	}
+	if _, ok := snapshot.Rates["XAU"]; ok {
+		t.Errorf("expected unsupported currency XAU to be ignored")
+	}
+
+	// GBP -> USD through the EUR base: 1.085 / 0.842
+	rate, ok := snapshot.Cross("GBP", "USD")
+	if !ok {
+		t.Fatalf("expected GBP/USD cross rate")
+	}
+	if math.Abs(rate-1.085/0.842) > 1e-9 {
+		t.Errorf("unexpected GBP/USD cross rate %v", rate)
+	}
+}
+
+func TestECBProvider_Errors(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		body   string
+	}{
+		{name: "server error", status: http.StatusServiceUnavailable, body: "unavailable"},
+		{name: "malformed xml", status: http.StatusOK, body: "<Envelope><Cube>"},
+		{name: "empty feed", status: http.StatusOK, body: "<Envelope><Cube></Cube></Envelope>"},
+		{name: "invalid rate", status: http.StatusOK, body: "<Envelope><Cube><Cube time='2026-10-16'><Cube currency='USD' rate='abc'/></Cube></Cube></Envelope>"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			if _, err := NewECBProvider(server.Client(), server.URL).LatestRates(context.Background()); err == nil {
+				t.Errorf("expected error")
+			}
+		})
+	}
+}
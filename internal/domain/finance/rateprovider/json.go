@@ -0,0 +1,142 @@
+package rateprovider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/masterkeysrd/saturn/internal/domain/finance"
+)
+
+// JSONConfig describes a generic JSON-over-HTTP rates endpoint. Field paths use dot notation
+// (e.g. "data.rates") to reach nested objects.
+type JSONConfig struct {
+	URL        string
+	Headers    map[string]string // Extra request headers, e.g. an API key
+	Base       finance.Currency  // Used when the response does not name its base currency
+	BaseField  string            // Defaults to "base"
+	DateField  string            // Defaults to "date"; accepts YYYY-MM-DD, RFC 3339 or unix seconds
+	RatesField string            // Defaults to "rates"; an object of currency code to rate
+}
+
+// JSONProvider reads rates from any endpoint returning a base currency, a date and a map of rates,
+// such as Frankfurter or exchangerate.host style APIs.
+type JSONProvider struct {
+	client *http.Client
+	cfg    JSONConfig
+}
+
+var _ finance.RateProvider = (*JSONProvider)(nil)
+
+// NewJSONProvider creates a JSON provider. A nil client defaults to one with a sane timeout.
+func NewJSONProvider(client *http.Client, cfg JSONConfig) *JSONProvider {
+	if cfg.BaseField == "" {
+		cfg.BaseField = "base"
+	}
+	if cfg.DateField == "" {
+		cfg.DateField = "date"
+	}
+	if cfg.RatesField == "" {
+		cfg.RatesField = "rates"
+	}
+	return &JSONProvider{client: defaultClient(client), cfg: cfg}
+}
+
+// LatestRates fetches and decodes the configured endpoint.
+func (p *JSONProvider) LatestRates(ctx context.Context) (*finance.RateSnapshot, error) {
+	if p.cfg.URL == "" {
+		return nil, errors.New("json rates: url is required")
+	}
+	body, err := fetch(ctx, p.client, p.cfg.URL, p.cfg.Headers)
+	if err != nil {
+		return nil, fmt.Errorf("json rates: %w", err)
+	}
+	return p.parse(body)
+}
+
+func (p *JSONProvider) parse(body []byte) (*finance.RateSnapshot, error) {
+	var doc map[string]any
+	if err := json.Unmarshal(body, &doc); err != nil {
+		return nil, fmt.Errorf("json rates: decode response: %w", err)
+	}
+
+	base := p.cfg.Base
+	if raw, ok := lookupField(doc, p.cfg.BaseField).(string); ok && raw != "" {
+		c, err := finance.ParseCurrency(raw)
+		if err != nil {
+			return nil, fmt.Errorf("json rates: invalid base currency: %w", err)
+		}
+		base = c
+	}
+	if base == "" {
+		return nil, errors.New("json rates: base currency is missing")
+	}
+
+	date, err := parseRateDate(lookupField(doc, p.cfg.DateField))
+	if err != nil {
+		return nil, fmt.Errorf("json rates: %w", err)
+	}
+
+	rawRates, ok := lookupField(doc, p.cfg.RatesField).(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("json rates: field %q is not an object", p.cfg.RatesField)
+	}
+
+	snapshot := &finance.RateSnapshot{
+		Base:  base,
+		Date:  date,
+		Rates: make(map[finance.Currency]float64, len(rawRates)),
+	}
+	for code, v := range rawRates {
+		currency, err := finance.ParseCurrency(code)
+		if err != nil {
+			continue // Currencies Saturn does not support are ignored
+		}
+		rate, ok := v.(float64)
+		if !ok || rate <= 0 {
+			return nil, fmt.Errorf("json rates: invalid rate for %s", code)
+		}
+		snapshot.Rates[currency] = rate
+	}
+	return snapshot, nil
+}
+
+// lookupField resolves a dot-separated path inside a decoded JSON object.
+func lookupField(doc map[string]any, path string) any {
+	var cur any = doc
+	for _, part := range strings.Split(path, ".") {
+		obj, ok := cur.(map[string]any)
+		if !ok {
+			return nil
+		}
+		cur = obj[part]
+	}
+	return cur
+}
+
+// parseRateDate accepts the date formats commonly returned by rate APIs. A missing date
+// means the rates are current.
+func parseRateDate(v any) (time.Time, error) {
+	switch d := v.(type) {
+	case nil:
+		y, m, day := time.Now().UTC().Date()
+		return time.Date(y, m, day, 0, 0, 0, 0, time.UTC), nil
+	case float64:
+		return time.Unix(int64(d), 0).UTC(), nil
+	case string:
+		if t, err := time.Parse("2006-01-02", d); err == nil {
+			return t, nil
+		}
+		t, err := time.Parse(time.RFC3339, d)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid rate date %q", d)
+		}
+		return t.UTC(), nil
+	default:
+		return time.Time{}, fmt.Errorf("invalid rate date %v", v)
+	}
+}
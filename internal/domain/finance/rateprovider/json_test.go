@@ -0,0 +1,100 @@
+package rateprovider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestJSONProvider_LatestRates(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Api-Key") != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"base":"USD","date":"2026-10-16","rates":{"EUR":0.92,"DOP":60.5,"XAU":0.0004}}`))
+	}))
+	defer server.Close()
+
+	provider := NewJSONProvider(server.Client(), JSONConfig{
+		URL:     server.URL,
+		Headers: map[string]string{"X-Api-Key": "secret"},
+	})
+	snapshot, err := provider.LatestRates(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if snapshot.Base != "USD" {
+		t.Errorf("expected base USD, got %s", snapshot.Base)
+	}
+	if got := snapshot.Date.Format("2006-01-02"); got != "2026-10-16" {
+		t.Errorf("expected date 2026-10-16, got %s", got)
+	}
+	if len(snapshot.Rates) != 2 {
+		t.Errorf("expected 2 supported rates, got %d", len(snapshot.Rates))
+	}
+	if rate, ok := snapshot.Cross("DOP", "USD"); !ok || rate != 1/60.5 {
+		t.Errorf("unexpected DOP/USD cross rate %v", rate)
+	}
+}
+
+func TestJSONProvider_CustomFields(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"timestamp":1792108800,"data":{"quotes":{"EUR":0.9,"GBP":0.8}}}`))
+	}))
+	defer server.Close()
+
+	provider := NewJSONProvider(server.Client(), JSONConfig{
+		URL:        server.URL,
+		Base:       "USD",
+		DateField:  "timestamp",
+		RatesField: "data.quotes",
+	})
+	snapshot, err := provider.LatestRates(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if snapshot.Base != "USD" {
+		t.Errorf("expected configured base USD, got %s", snapshot.Base)
+	}
+	if snapshot.Date.Unix() != 1792108800 {
+		t.Errorf("expected unix timestamp date, got %v", snapshot.Date)
+	}
+	if snapshot.Rates["GBP"] != 0.8 {
+		t.Errorf("expected GBP rate 0.8, got %v", snapshot.Rates["GBP"])
+	}
+}
+
+func TestJSONProvider_Errors(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		body   string
+	}{
+		{name: "server error", status: http.StatusBadGateway, body: "{}"},
+		{name: "malformed json", status: http.StatusOK, body: "{"},
+		{name: "missing base", status: http.StatusOK, body: `{"date":"2026-10-16","rates":{"EUR":0.9}}`},
+		{name: "missing rates", status: http.StatusOK, body: `{"base":"USD","date":"2026-10-16"}`},
+		{name: "invalid date", status: http.StatusOK, body: `{"base":"USD","date":"yesterday","rates":{"EUR":0.9}}`},
+		{name: "negative rate", status: http.StatusOK, body: `{"base":"USD","date":"2026-10-16","rates":{"EUR":-1}}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			provider := NewJSONProvider(server.Client(), JSONConfig{URL: server.URL})
+			if _, err := provider.LatestRates(context.Background()); err == nil {
+				t.Errorf("expected error")
+			}
+		})
+	}
+}
@@ -0,0 +1,147 @@
+package finance
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/masterkeysrd/saturn/internal/platform/id"
+)
+
+type ReconciliationID string
+
+const reconciliationPrefix = "rcn_"
+
+func NewReconciliationID() (ReconciliationID, error) {
+	raw, err := id.Generate(reconciliationPrefix)
+	if err != nil {
+		return "", err
+	}
+	return ReconciliationID(raw), nil
+}
+
+func ParseReconciliationID(s string) (ReconciliationID, error) {
+	if err := id.Validate(s, reconciliationPrefix); err != nil {
+		return "", fmt.Errorf("invalid reconciliation ID: %w", err)
+	}
+	return ReconciliationID(s), nil
+}
+
+func (rid ReconciliationID) String() string {
+	return string(rid)
+}
+
+func (rid ReconciliationID) Validate() error {
+	return id.Validate(string(rid), reconciliationPrefix)
+}
+
+// ReconciliationStatus tracks the lifecycle of a reconciliation session.
+type ReconciliationStatus string
+
+const (
+	ReconciliationStatusInProgress ReconciliationStatus = "IN_PROGRESS"
+	ReconciliationStatusFinalized  ReconciliationStatus = "FINALIZED"
+)
+
+// Reconciliation is a session that matches an account's cleared transactions against a bank statement.
+// The opening balance is the statement balance of the account's previous finalized session, or the
+// account's initial balance for the first one.
+type Reconciliation struct {
+	ID                      ReconciliationID
+	SpaceID                 SpaceID
+	AccountID               AccountID
+	StatementDate           time.Time
+	StatementBalance        int64
+	OpeningBalance          int64
+	ClearedBalance          int64
+	Difference              int64 // StatementBalance - ClearedBalance
+	ClearedCount            int32
+	AdjustmentTransactionID *TransactionID // Balance adjustment booked to close a non-zero difference
+	Status                  ReconciliationStatus
+	Note                    string
+	FinalizeTime            *time.Time
+	CreateTime              time.Time
+	UpdateTime              time.Time
+}
+
+func (r *Reconciliation) Validate() error {
+	if err := r.ID.Validate(); err != nil {
+		return fmt.Errorf("validate reconciliation ID: %w", err)
+	}
+	if err := r.SpaceID.Validate(); err != nil {
+		return fmt.Errorf("validate space ID: %w", err)
+	}
+	if err := r.AccountID.Validate(); err != nil {
+		return fmt.Errorf("validate account ID: %w", err)
+	}
+	if r.StatementDate.IsZero() {
+		return errors.New("statement date is required")
+	}
+	if len(r.Note) > 500 {
+		return errors.New("reconciliation note must not exceed 500 characters")
+	}
+	switch r.Status {
+	case ReconciliationStatusInProgress, ReconciliationStatusFinalized:
+	default:
+		return fmt.Errorf("invalid reconciliation status: %q", r.Status)
+	}
+	return nil
+}
+
+// IsFinalized reports whether the session has been closed.
+func (r *Reconciliation) IsFinalized() bool {
+	return r.Status == ReconciliationStatusFinalized
+}
+
+// StatementCutoff returns the exclusive upper bound for transactions covered by the statement.
+func (r *Reconciliation) StatementCutoff() time.Time {
+	return r.StatementDate.AddDate(0, 0, 1)
+}
+
+// Recalculate derives the cleared balance and the remaining difference from the account's
+// unreconciled transactions. Only cleared transactions dated up to the statement date count.
+func (r *Reconciliation) Recalculate(accountType AccountType, txns []*Transaction) []*Transaction {
+	cutoff := r.StatementCutoff()
+
+	var cleared []*Transaction
+	balance := r.OpeningBalance
+	for _, txn := range txns {
+		if !txn.Cleared || txn.IsReconciled() || !txn.TransactionDate.Before(cutoff) {
+			continue
+		}
+		balance += AccountBalanceImpact(accountType, txn)
+		cleared = append(cleared, txn)
+	}
+
+	r.ClearedBalance = balance
+	r.ClearedCount = int32(len(cleared))
+	r.Difference = r.StatementBalance - balance
+	return cleared
+}
+
+// AccountBalanceImpact returns the signed change a transaction applies to an account's balance.
+// It mirrors the rules used when posting transactions: credit card balances track debt owed, so
+// outflows increase them, while asset accounts decrease on outflows.
+func AccountBalanceImpact(accountType AccountType, txn *Transaction) int64 {
+	amount := txn.Amount
+	if txn.Metadata.AccountImpactAmount > 0 {
+		amount = txn.Metadata.AccountImpactAmount
+	}
+
+	switch txn.Type {
+	case TransactionTypeBalanceAdjustment:
+		return amount
+	case TransactionTypeExpense, TransactionTypeTransferOut:
+		if accountType == AccountTypeCreditCard {
+			return amount
+		}
+		return -amount
+	case TransactionTypeIncome, TransactionTypeTransferIn:
+		if accountType == AccountTypeCreditCard {
+			return -amount
+		}
+		return amount
+	default:
+		return 0
+	}
+}
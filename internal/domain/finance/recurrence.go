@@ -0,0 +1,498 @@
+package finance
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxRecurrencePeriods bounds how many periods a rule is expanded over, so rules that can never
+// match (e.g. February 30th) terminate.
+const maxRecurrencePeriods = 10000
+
+// RecurrenceFrequency is the FREQ part of an RRULE.
+type RecurrenceFrequency string
+
+const (
+	FrequencyDaily   RecurrenceFrequency = "DAILY"
+	FrequencyWeekly  RecurrenceFrequency = "WEEKLY"
+	FrequencyMonthly RecurrenceFrequency = "MONTHLY"
+	FrequencyYearly  RecurrenceFrequency = "YEARLY"
+)
+
+// RecurrenceWeekday is a BYDAY entry. N selects the nth weekday of the month (or year); negative
+// values count from the end and zero matches every such weekday.
+type RecurrenceWeekday struct {
+	Weekday time.Weekday
+	N       int
+}
+
+var weekdayCodes = [...]string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}
+
+func parseWeekdayCode(code string) (time.Weekday, error) {
+	for i, c := range weekdayCodes {
+		if c == code {
+			return time.Weekday(i), nil
+		}
+	}
+	return 0, fmt.Errorf("invalid weekday %q", code)
+}
+
+// RecurrenceRule is the supported subset of an RFC 5545 recurrence rule: FREQ, INTERVAL, COUNT,
+// UNTIL, BYDAY, BYMONTHDAY, BYMONTH, BYSETPOS and WKST. Occurrences are calendar dates; the time of
+// day comes from the start date.
+type RecurrenceRule struct {
+	Frequency  RecurrenceFrequency
+	Interval   int
+	Count      int       // Ends the series after this many occurrences, exception dates included
+	Until      time.Time // Inclusive last date of the series
+	ByDay      []RecurrenceWeekday
+	ByMonthDay []int
+	ByMonth    []time.Month
+	BySetPos   []int
+	WeekStart  time.Weekday
+}
+
+// ParseRecurrenceRule parses an RRULE value such as "FREQ=MONTHLY;BYMONTHDAY=15,-1". A leading
+// "RRULE:" is accepted.
+func ParseRecurrenceRule(s string) (*RecurrenceRule, error) {
+	s = strings.TrimPrefix(strings.TrimSpace(s), "RRULE:")
+	if s == "" {
+		return nil, errors.New("recurrence rule cannot be empty")
+	}
+	r := &RecurrenceRule{Interval: 1, WeekStart: time.Monday}
+	seen := make(map[string]bool)
+	for part := range strings.SplitSeq(s, ";") {
+		key, value, ok := strings.Cut(part, "=")
+		key = strings.ToUpper(strings.TrimSpace(key))
+		value = strings.ToUpper(strings.TrimSpace(value))
+		if !ok || value == "" {
+			return nil, fmt.Errorf("invalid recurrence rule part %q", part)
+		}
+		if seen[key] {
+			return nil, fmt.Errorf("duplicate recurrence rule part %s", key)
+		}
+		seen[key] = true
+
+		var err error
+		switch key {
+		case "FREQ":
+			r.Frequency = RecurrenceFrequency(value)
+		case "INTERVAL":
+			r.Interval, err = strconv.Atoi(value)
+		case "COUNT":
+			r.Count, err = strconv.Atoi(value)
+		case "UNTIL":
+			r.Until, err = parseRecurrenceDate(value)
+		case "BYDAY":
+			r.ByDay, err = parseByDay(value)
+		case "BYMONTHDAY":
+			r.ByMonthDay, err = parseIntList(value)
+		case "BYMONTH":
+			var months []int
+			months, err = parseIntList(value)
+			for _, m := range months {
+				r.ByMonth = append(r.ByMonth, time.Month(m))
+			}
+		case "BYSETPOS":
+			r.BySetPos, err = parseIntList(value)
+		case "WKST":
+			r.WeekStart, err = parseWeekdayCode(value)
+		default:
+			return nil, fmt.Errorf("unsupported recurrence rule part %s", key)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("parse %s: %w", key, err)
+		}
+	}
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func parseRecurrenceDate(value string) (time.Time, error) {
+	for _, layout := range []string{"20060102T150405Z", "20060102T150405", "20060102"} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC), nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid date %q", value)
+}
+
+func parseByDay(value string) ([]RecurrenceWeekday, error) {
+	var days []RecurrenceWeekday
+	for item := range strings.SplitSeq(value, ",") {
+		if len(item) < 2 {
+			return nil, fmt.Errorf("invalid weekday %q", item)
+		}
+		wd, err := parseWeekdayCode(item[len(item)-2:])
+		if err != nil {
+			return nil, err
+		}
+		day := RecurrenceWeekday{Weekday: wd}
+		if prefix := item[:len(item)-2]; prefix != "" {
+			if day.N, err = strconv.Atoi(prefix); err != nil || day.N == 0 {
+				return nil, fmt.Errorf("invalid weekday %q", item)
+			}
+		}
+		days = append(days, day)
+	}
+	return days, nil
+}
+
+func parseIntList(value string) ([]int, error) {
+	var list []int
+	for item := range strings.SplitSeq(value, ",") {
+		n, err := strconv.Atoi(item)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %q", item)
+		}
+		list = append(list, n)
+	}
+	return list, nil
+}
+
+// Validate checks the rule's parts are in range and consistent with its frequency.
+func (r *RecurrenceRule) Validate() error {
+	switch r.Frequency {
+	case FrequencyDaily, FrequencyWeekly, FrequencyMonthly, FrequencyYearly:
+	case "":
+		return errors.New("recurrence rule requires FREQ")
+	default:
+		return fmt.Errorf("unsupported recurrence frequency %q", r.Frequency)
+	}
+	if r.Interval < 1 {
+		return errors.New("recurrence interval must be at least 1")
+	}
+	if r.Count < 0 {
+		return errors.New("recurrence count cannot be negative")
+	}
+	if r.Count > 0 && !r.Until.IsZero() {
+		return errors.New("recurrence rule cannot set both COUNT and UNTIL")
+	}
+	for _, d := range r.ByDay {
+		if d.N == 0 {
+			continue
+		}
+		if r.Frequency != FrequencyMonthly && r.Frequency != FrequencyYearly {
+			return errors.New("numbered BYDAY values require a monthly or yearly frequency")
+		}
+		if d.N < -53 || d.N > 53 {
+			return fmt.Errorf("BYDAY position %d out of range", d.N)
+		}
+	}
+	for _, d := range r.ByMonthDay {
+		if d == 0 || d < -31 || d > 31 {
+			return fmt.Errorf("BYMONTHDAY %d out of range", d)
+		}
+	}
+	if len(r.ByMonthDay) > 0 && r.Frequency == FrequencyWeekly {
+		return errors.New("BYMONTHDAY is not allowed with a weekly frequency")
+	}
+	for _, m := range r.ByMonth {
+		if m < time.January || m > time.December {
+			return fmt.Errorf("BYMONTH %d out of range", m)
+		}
+	}
+	for _, p := range r.BySetPos {
+		if p == 0 || p < -366 || p > 366 {
+			return fmt.Errorf("BYSETPOS %d out of range", p)
+		}
+	}
+	if len(r.BySetPos) > 0 && len(r.ByDay) == 0 && len(r.ByMonthDay) == 0 && len(r.ByMonth) == 0 {
+		return errors.New("BYSETPOS requires another BY rule part")
+	}
+	return nil
+}
+
+// String formats the rule as an RRULE value, without the "RRULE:" prefix.
+func (r *RecurrenceRule) String() string {
+	parts := []string{"FREQ=" + string(r.Frequency)}
+	if r.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+	if r.Count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.Count))
+	}
+	if !r.Until.IsZero() {
+		parts = append(parts, "UNTIL="+r.Until.Format("20060102"))
+	}
+	if len(r.ByMonth) > 0 {
+		months := make([]int, len(r.ByMonth))
+		for i, m := range r.ByMonth {
+			months[i] = int(m)
+		}
+		parts = append(parts, "BYMONTH="+joinInts(months))
+	}
+	if len(r.ByMonthDay) > 0 {
+		parts = append(parts, "BYMONTHDAY="+joinInts(r.ByMonthDay))
+	}
+	if len(r.ByDay) > 0 {
+		days := make([]string, len(r.ByDay))
+		for i, d := range r.ByDay {
+			days[i] = weekdayCodes[d.Weekday]
+			if d.N != 0 {
+				days[i] = strconv.Itoa(d.N) + days[i]
+			}
+		}
+		parts = append(parts, "BYDAY="+strings.Join(days, ","))
+	}
+	if len(r.BySetPos) > 0 {
+		parts = append(parts, "BYSETPOS="+joinInts(r.BySetPos))
+	}
+	if r.WeekStart != time.Monday {
+		parts = append(parts, "WKST="+weekdayCodes[r.WeekStart])
+	}
+	return strings.Join(parts, ";")
+}
+
+func joinInts(list []int) string {
+	out := make([]string, len(list))
+	for i, n := range list {
+		out[i] = strconv.Itoa(n)
+	}
+	return strings.Join(out, ",")
+}
+
+// Cadence summarises the rule as one of the simple interval names, or "custom" when it does not
+// reduce to one.
+func (r *RecurrenceRule) Cadence() string {
+	if len(r.ByDay) > 0 || len(r.ByMonthDay) > 0 || len(r.ByMonth) > 0 || len(r.BySetPos) > 0 {
+		return "custom"
+	}
+	switch {
+	case r.Frequency == FrequencyWeekly && r.Interval == 1:
+		return "weekly"
+	case r.Frequency == FrequencyWeekly && r.Interval == 2:
+		return "biweekly"
+	case r.Frequency == FrequencyMonthly && r.Interval == 1:
+		return "monthly"
+	case r.Frequency == FrequencyYearly && r.Interval == 1:
+		return "yearly"
+	default:
+		return "custom"
+	}
+}
+
+// RecurrenceRuleForInterval returns the rule equivalent to a simple interval name.
+func RecurrenceRuleForInterval(interval string) (*RecurrenceRule, error) {
+	r := &RecurrenceRule{Interval: 1, WeekStart: time.Monday}
+	switch interval {
+	case "daily":
+		r.Frequency = FrequencyDaily
+	case "weekly":
+		r.Frequency = FrequencyWeekly
+	case "biweekly":
+		r.Frequency, r.Interval = FrequencyWeekly, 2
+	case "monthly":
+		r.Frequency = FrequencyMonthly
+	case "yearly":
+		r.Frequency = FrequencyYearly
+	default:
+		return nil, fmt.Errorf("no recurrence rule for interval %q", interval)
+	}
+	return r, nil
+}
+
+// Recurrence is a rule anchored at a start date, with exception dates removed from the series.
+type Recurrence struct {
+	Rule           *RecurrenceRule
+	Start          time.Time
+	ExceptionDates []time.Time
+}
+
+// Between returns the occurrences on or after from and on or before to, in order.
+func (rc *Recurrence) Between(from, to time.Time) []time.Time {
+	var list []time.Time
+	rc.each(func(t time.Time) bool {
+		if t.After(to) {
+			return false
+		}
+		if !t.Before(from) {
+			list = append(list, t)
+		}
+		return true
+	})
+	return list
+}
+
+// After returns the first occurrence strictly after t.
+func (rc *Recurrence) After(t time.Time) (time.Time, bool) {
+	var next time.Time
+	found := false
+	rc.each(func(o time.Time) bool {
+		if o.After(t) {
+			next, found = o, true
+			return false
+		}
+		return true
+	})
+	return next, found
+}
+
+// excluded reports whether an occurrence falls on one of the exception dates.
+func (rc *Recurrence) excluded(t time.Time) bool {
+	return slices.ContainsFunc(rc.ExceptionDates, func(ex time.Time) bool { return sameDate(ex, t) })
+}
+
+// each calls fn with every occurrence in order until fn returns false or the series ends.
+// Exception dates are skipped but still count towards COUNT, as RFC 5545 specifies.
+func (rc *Recurrence) each(fn func(time.Time) bool) {
+	r := rc.Rule
+	start := rc.Start
+	byDay, byMonthDay, byMonth := r.ByDay, r.ByMonthDay, r.ByMonth
+
+	// Without BY parts the series repeats the start date's weekday, day of month or date.
+	switch r.Frequency {
+	case FrequencyWeekly:
+		if len(byDay) == 0 {
+			byDay = []RecurrenceWeekday{{Weekday: start.Weekday()}}
+		}
+	case FrequencyMonthly:
+		if len(byDay) == 0 && len(byMonthDay) == 0 {
+			byMonthDay = []int{start.Day()}
+		}
+	case FrequencyYearly:
+		if len(byDay) == 0 && len(byMonthDay) == 0 {
+			byMonthDay = []int{start.Day()}
+			if len(byMonth) == 0 {
+				byMonth = []time.Month{start.Month()}
+			}
+		}
+	}
+
+	periodStart := rc.periodStart(start)
+	emitted := 0
+	for range maxRecurrencePeriods {
+		if !r.Until.IsZero() && dateAfter(periodStart, r.Until) {
+			return
+		}
+		periodEnd := rc.advance(periodStart, 1)
+
+		var set []time.Time
+		for day := periodStart; day.Before(periodEnd); day = day.AddDate(0, 0, 1) {
+			if rc.matches(day, byDay, byMonthDay, byMonth) {
+				set = append(set, day)
+			}
+		}
+		set = applySetPos(set, r.BySetPos)
+
+		for _, day := range set {
+			if day.Before(time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, start.Location())) {
+				continue
+			}
+			if !r.Until.IsZero() && dateAfter(day, r.Until) {
+				return
+			}
+			occurrence := time.Date(day.Year(), day.Month(), day.Day(), start.Hour(), start.Minute(), start.Second(), 0, start.Location())
+			emitted++
+			if !rc.excluded(occurrence) && !fn(occurrence) {
+				return
+			}
+			if r.Count > 0 && emitted >= r.Count {
+				return
+			}
+		}
+		periodStart = rc.advance(periodStart, r.Interval)
+	}
+}
+
+// periodStart returns the first day of the period containing t.
+func (rc *Recurrence) periodStart(t time.Time) time.Time {
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	switch rc.Rule.Frequency {
+	case FrequencyWeekly:
+		offset := (int(day.Weekday()) - int(rc.Rule.WeekStart) + 7) % 7
+		return day.AddDate(0, 0, -offset)
+	case FrequencyMonthly:
+		return time.Date(day.Year(), day.Month(), 1, 0, 0, 0, 0, day.Location())
+	case FrequencyYearly:
+		return time.Date(day.Year(), time.January, 1, 0, 0, 0, 0, day.Location())
+	default:
+		return day
+	}
+}
+
+// advance moves a period start forward by n periods.
+func (rc *Recurrence) advance(periodStart time.Time, n int) time.Time {
+	switch rc.Rule.Frequency {
+	case FrequencyWeekly:
+		return periodStart.AddDate(0, 0, 7*n)
+	case FrequencyMonthly:
+		return periodStart.AddDate(0, n, 0)
+	case FrequencyYearly:
+		return periodStart.AddDate(n, 0, 0)
+	default:
+		return periodStart.AddDate(0, 0, n)
+	}
+}
+
+// matches reports whether a day of the current period satisfies the BY parts.
+func (rc *Recurrence) matches(day time.Time, byDay []RecurrenceWeekday, byMonthDay []int, byMonth []time.Month) bool {
+	if len(byMonth) > 0 && !slices.Contains(byMonth, day.Month()) {
+		return false
+	}
+	if len(byMonthDay) > 0 {
+		last := daysIn(day.Year(), day.Month())
+		if !slices.ContainsFunc(byMonthDay, func(d int) bool {
+			return d == day.Day() || (d < 0 && last+1+d == day.Day())
+		}) {
+			return false
+		}
+	}
+	if len(byDay) > 0 {
+		// Numbered weekdays count within the month, or within the year for yearly rules without BYMONTH.
+		inYear := rc.Rule.Frequency == FrequencyYearly && len(byMonth) == 0
+		if !slices.ContainsFunc(byDay, func(d RecurrenceWeekday) bool {
+			if d.Weekday != day.Weekday() {
+				return false
+			}
+			if d.N == 0 {
+				return true
+			}
+			index, total := day.Day(), daysIn(day.Year(), day.Month())
+			if inYear {
+				index, total = day.YearDay(), time.Date(day.Year(), time.December, 31, 0, 0, 0, 0, time.UTC).YearDay()
+			}
+			if d.N > 0 {
+				return (index-1)/7+1 == d.N
+			}
+			return -((total-index)/7 + 1) == d.N
+		}) {
+			return false
+		}
+	}
+	return true
+}
+
+// applySetPos keeps the positions of the period's candidate set that BYSETPOS selects.
+func applySetPos(set []time.Time, positions []int) []time.Time {
+	if len(positions) == 0 {
+		return set
+	}
+	var out []time.Time
+	for i, day := range set {
+		if slices.ContainsFunc(positions, func(p int) bool { return p == i+1 || p == i-len(set) }) {
+			out = append(out, day)
+		}
+	}
+	return out
+}
+
+func daysIn(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// sameDate reports whether two times fall on the same calendar date.
+func sameDate(a, b time.Time) bool {
+	return a.Year() == b.Year() && a.Month() == b.Month() && a.Day() == b.Day()
+}
+
+// dateAfter reports whether the calendar date of a is after the calendar date of b.
+func dateAfter(a, b time.Time) bool {
+	return daysBetween(b, a) > 0
+}
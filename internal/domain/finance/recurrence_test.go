@@ -0,0 +1,141 @@
+package finance
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRecurrence_Between(t *testing.T) {
+	date := func(m time.Month, d int) time.Time { return time.Date(2026, m, d, 0, 0, 0, 0, time.UTC) }
+
+	tests := []struct {
+		name       string
+		rule       string
+		start      time.Time
+		exceptions []time.Time
+		want       []time.Time
+	}{
+		{
+			name:  "every other Friday",
+			rule:  "FREQ=WEEKLY;INTERVAL=2;BYDAY=FR",
+			start: date(1, 2),
+			want:  []time.Time{date(1, 2), date(1, 16), date(1, 30), date(2, 13), date(2, 27), date(3, 13), date(3, 27)},
+		},
+		{
+			name:  "second Friday of the month",
+			rule:  "FREQ=MONTHLY;BYDAY=2FR",
+			start: date(1, 1),
+			want:  []time.Time{date(1, 9), date(2, 13), date(3, 13)},
+		},
+		{
+			name:  "last business day of the month",
+			rule:  "FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1",
+			start: date(1, 1),
+			want:  []time.Time{date(1, 30), date(2, 27), date(3, 31)},
+		},
+		{
+			name:  "the 15th and the last day",
+			rule:  "FREQ=MONTHLY;BYMONTHDAY=15,-1",
+			start: date(1, 1),
+			want:  []time.Time{date(1, 15), date(1, 31), date(2, 15), date(2, 28), date(3, 15), date(3, 31)},
+		},
+		{
+			name:  "monthly on the 31st skips short months",
+			rule:  "FREQ=MONTHLY",
+			start: date(1, 31),
+			want:  []time.Time{date(1, 31), date(3, 31)},
+		},
+		{
+			name:       "exception dates count towards COUNT",
+			rule:       "FREQ=MONTHLY;COUNT=3",
+			start:      date(1, 10),
+			exceptions: []time.Time{date(2, 10)},
+			want:       []time.Time{date(1, 10), date(3, 10)},
+		},
+		{
+			name:  "until is inclusive",
+			rule:  "FREQ=WEEKLY;UNTIL=20260119",
+			start: date(1, 5),
+			want:  []time.Time{date(1, 5), date(1, 12), date(1, 19)},
+		},
+		{
+			name:  "yearly repeats the start date",
+			rule:  "RRULE:FREQ=YEARLY",
+			start: date(2, 14),
+			want:  []time.Time{date(2, 14)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := ParseRecurrenceRule(tt.rule)
+			if err != nil {
+				t.Fatalf("ParseRecurrenceRule(%q) failed: %v", tt.rule, err)
+			}
+			rc := &Recurrence{Rule: rule, Start: tt.start, ExceptionDates: tt.exceptions}
+			got := rc.Between(date(1, 1), date(3, 31))
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d occurrences %v, want %v", len(got), got, tt.want)
+			}
+			for i := range got {
+				if !got[i].Equal(tt.want[i]) {
+					t.Errorf("occurrence %d = %s, want %s", i, got[i].Format(time.DateOnly), tt.want[i].Format(time.DateOnly))
+				}
+			}
+		})
+	}
+}
+
+func TestRecurrence_After(t *testing.T) {
+	rule, _ := ParseRecurrenceRule("FREQ=MONTHLY;BYMONTHDAY=15,-1;COUNT=2")
+	rc := &Recurrence{Rule: rule, Start: time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC)}
+
+	next, ok := rc.After(time.Date(2026, 1, 15, 9, 0, 0, 0, time.UTC))
+	if !ok || !next.Equal(time.Date(2026, 1, 31, 9, 0, 0, 0, time.UTC)) {
+		t.Errorf("After(Jan 15) = %s, %v, want 2026-01-31 09:00", next, ok)
+	}
+	if _, ok := rc.After(next); ok {
+		t.Error("expected the series to end after COUNT occurrences")
+	}
+}
+
+func TestParseRecurrenceRule(t *testing.T) {
+	tests := []struct {
+		rule    string
+		want    string
+		cadence string
+		wantErr bool
+	}{
+		{rule: "FREQ=WEEKLY", want: "FREQ=WEEKLY", cadence: "weekly"},
+		{rule: "freq=weekly;interval=2", want: "FREQ=WEEKLY;INTERVAL=2", cadence: "biweekly"},
+		{rule: "FREQ=MONTHLY;BYDAY=-1FR;COUNT=12", want: "FREQ=MONTHLY;COUNT=12;BYDAY=-1FR", cadence: "custom"},
+		{rule: "FREQ=YEARLY;BYMONTH=4;BYMONTHDAY=15;UNTIL=20300101", want: "FREQ=YEARLY;UNTIL=20300101;BYMONTH=4;BYMONTHDAY=15", cadence: "custom"},
+		{rule: "", wantErr: true},
+		{rule: "INTERVAL=2", wantErr: true},
+		{rule: "FREQ=HOURLY", wantErr: true},
+		{rule: "FREQ=WEEKLY;BYDAY=2FR", wantErr: true},
+		{rule: "FREQ=MONTHLY;BYMONTHDAY=32", wantErr: true},
+		{rule: "FREQ=MONTHLY;COUNT=2;UNTIL=20300101", wantErr: true},
+		{rule: "FREQ=MONTHLY;BYSETPOS=1", wantErr: true},
+		{rule: "FREQ=MONTHLY;BYHOUR=9", wantErr: true},
+		{rule: "FREQ=MONTHLY;FREQ=WEEKLY", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.rule, func(t *testing.T) {
+			rule, err := ParseRecurrenceRule(tt.rule)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseRecurrenceRule(%q) error = %v, wantErr %v", tt.rule, err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got := rule.String(); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+			if got := rule.Cadence(); got != tt.cadence {
+				t.Errorf("Cadence() = %q, want %q", got, tt.cadence)
+			}
+		})
+	}
+}
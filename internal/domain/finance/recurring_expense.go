@@ -46,7 +46,10 @@ type RecurringExpense struct {
 	Name            string
 	Amount          int64
 	Currency        Currency
-	Interval        string // "weekly", "monthly", "yearly"
+	Interval        string // "weekly", "monthly", "yearly"; "biweekly" or "custom" when derived from RecurrenceRule
+	RecurrenceRule  string // Optional RFC 5545 RRULE; overrides Interval when set
+	StartDate       time.Time
+	ExceptionDates  []time.Time
 	NextDueDate     time.Time
 	IsVariable      bool
 	Status          RecurringExpenseStatus
@@ -74,7 +77,11 @@ func (re *RecurringExpense) Validate() error {
 	if err := re.Currency.Validate(); err != nil {
 		return fmt.Errorf("validate currency: %w", err)
 	}
-	if re.Interval != "weekly" && re.Interval != "monthly" && re.Interval != "yearly" {
+	if re.RecurrenceRule != "" {
+		if _, err := ParseRecurrenceRule(re.RecurrenceRule); err != nil {
+			return fmt.Errorf("validate recurrence rule: %w", err)
+		}
+	} else if re.Interval != "weekly" && re.Interval != "monthly" && re.Interval != "yearly" {
 		return fmt.Errorf("invalid interval: %q", re.Interval)
 	}
 	if re.NextDueDate.IsZero() {
@@ -83,6 +90,55 @@ func (re *RecurringExpense) Validate() error {
 	return nil
 }
 
+// NormalizeRecurrence canonicalises the recurrence rule, derives Interval from it, anchors the series
+// at StartDate (defaulting to NextDueDate) and moves NextDueDate to the next occurrence on or after
+// both dates.
+func (re *RecurringExpense) NormalizeRecurrence() error {
+	if re.StartDate.IsZero() {
+		re.StartDate = re.NextDueDate
+	}
+	if re.RecurrenceRule != "" {
+		rule, err := ParseRecurrenceRule(re.RecurrenceRule)
+		if err != nil {
+			return fmt.Errorf("validate recurrence rule: %w", err)
+		}
+		re.RecurrenceRule = rule.String()
+		re.Interval = rule.Cadence()
+	}
+	rc, err := re.Recurrence()
+	if err != nil {
+		return err
+	}
+	next, ok := rc.After(latestDate(re.StartDate, re.NextDueDate).AddDate(0, 0, -1))
+	if !ok {
+		return errors.New("recurrence rule has no occurrences")
+	}
+	re.NextDueDate = next
+	return nil
+}
+
+// Recurrence returns the series of due dates. Templates without a rule repeat their interval from
+// the start date.
+func (re *RecurringExpense) Recurrence() (*Recurrence, error) {
+	var (
+		rule *RecurrenceRule
+		err  error
+	)
+	if re.RecurrenceRule != "" {
+		rule, err = ParseRecurrenceRule(re.RecurrenceRule)
+	} else {
+		rule, err = RecurrenceRuleForInterval(re.Interval)
+	}
+	if err != nil {
+		return nil, err
+	}
+	start := re.StartDate
+	if start.IsZero() {
+		start = re.NextDueDate
+	}
+	return &Recurrence{Rule: rule, Start: start, ExceptionDates: re.ExceptionDates}, nil
+}
+
 const DefaultRecurringExpenseSortField = "create_time"
 
 // RecurringExpenseSortFields registry maps sortable recurring expense field names to cursor strings.
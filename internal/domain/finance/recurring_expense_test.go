@@ -130,3 +130,40 @@ func TestRecurringExpense_SortFields(t *testing.T) {
 		t.Errorf("GetSortValue('name') = %q, want 'Spotify'", val)
 	}
 }
+
+func TestRecurringExpense_NormalizeRecurrence(t *testing.T) {
+	jan1 := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	rec := &RecurringExpense{
+		RecurrenceRule: "freq=monthly;bymonthday=15,-1",
+		NextDueDate:    jan1,
+	}
+	if err := rec.NormalizeRecurrence(); err != nil {
+		t.Fatalf("NormalizeRecurrence failed: %v", err)
+	}
+	if rec.RecurrenceRule != "FREQ=MONTHLY;BYMONTHDAY=15,-1" {
+		t.Errorf("RecurrenceRule = %q, want canonical form", rec.RecurrenceRule)
+	}
+	if rec.Interval != "custom" {
+		t.Errorf("Interval = %q, want custom", rec.Interval)
+	}
+	if !rec.StartDate.Equal(jan1) {
+		t.Errorf("StartDate = %s, want %s", rec.StartDate, jan1)
+	}
+	if want := time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC); !rec.NextDueDate.Equal(want) {
+		t.Errorf("NextDueDate = %s, want %s", rec.NextDueDate, want)
+	}
+
+	legacy := &RecurringExpense{Interval: "monthly", NextDueDate: jan1}
+	if err := legacy.NormalizeRecurrence(); err != nil {
+		t.Fatalf("NormalizeRecurrence failed: %v", err)
+	}
+	if !legacy.NextDueDate.Equal(jan1) || legacy.Interval != "monthly" {
+		t.Errorf("legacy template changed: next due %s, interval %q", legacy.NextDueDate, legacy.Interval)
+	}
+
+	ended := &RecurringExpense{RecurrenceRule: "FREQ=WEEKLY;UNTIL=20251231", NextDueDate: jan1}
+	if err := ended.NormalizeRecurrence(); err == nil {
+		t.Error("expected an error for a rule without occurrences")
+	}
+}
@@ -0,0 +1,173 @@
+package finance
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/masterkeysrd/saturn/internal/platform/filter"
+	"github.com/masterkeysrd/saturn/internal/platform/id"
+	"github.com/masterkeysrd/saturn/internal/platform/patch"
+	"github.com/masterkeysrd/saturn/internal/platform/sorting"
+)
+
+// SavedFilterID is a custom string type representing a saved transaction filter's unique identifier (KSUID).
+type SavedFilterID string
+
+const savedFilterPrefix = "sfl_"
+
+// NewSavedFilterID creates a new SavedFilterID using the default ID generator.
+func NewSavedFilterID() (SavedFilterID, error) {
+	raw, err := id.Generate(savedFilterPrefix)
+	if err != nil {
+		return "", err
+	}
+	return SavedFilterID(raw), nil
+}
+
+// ParseSavedFilterID parses a string into a SavedFilterID and validates it.
+func ParseSavedFilterID(s string) (SavedFilterID, error) {
+	if err := id.Validate(s, savedFilterPrefix); err != nil {
+		return "", fmt.Errorf("invalid saved filter ID: %w", err)
+	}
+	return SavedFilterID(s), nil
+}
+
+// String returns the string representation.
+func (sid SavedFilterID) String() string {
+	return string(sid)
+}
+
+// Validate checks if the SavedFilterID is valid.
+func (sid SavedFilterID) Validate() error {
+	return id.Validate(string(sid), savedFilterPrefix)
+}
+
+// SavedFilter is a named transaction filter expression shared across a space.
+type SavedFilter struct {
+	ID          SavedFilterID
+	SpaceID     SpaceID
+	Name        string
+	Description string
+	Expression  string // AIP-160 expression over TransactionFilterFields
+	Sort        string // Optional default sort (e.g. "amount:desc")
+	CreateTime  time.Time
+	UpdateTime  time.Time
+}
+
+// Validate checks the saved filter's business rules, including that the expression compiles.
+func (f *SavedFilter) Validate() error {
+	if err := f.ID.Validate(); err != nil {
+		return fmt.Errorf("validate saved filter ID: %w", err)
+	}
+	if err := f.SpaceID.Validate(); err != nil {
+		return fmt.Errorf("validate space ID: %w", err)
+	}
+	f.Name = strings.TrimSpace(f.Name)
+	if f.Name == "" {
+		return errors.New("saved filter name is required")
+	}
+	if len(f.Name) > 100 {
+		return errors.New("saved filter name must not exceed 100 characters")
+	}
+	if len(f.Description) > 500 {
+		return errors.New("saved filter description must not exceed 500 characters")
+	}
+	f.Expression = strings.TrimSpace(f.Expression)
+	if f.Expression == "" {
+		return errors.New("saved filter expression is required")
+	}
+	if _, err := ParseTransactionFilter(f.Expression); err != nil {
+		return err
+	}
+	f.Sort = strings.TrimSpace(f.Sort)
+	if f.Sort != "" && !IsTransactionSortField(sorting.Parse(f.Sort).Field) {
+		return fmt.Errorf("invalid sort field %q", sorting.Parse(f.Sort).Field)
+	}
+	return nil
+}
+
+// SavedFilterPatchSchema defines all patchable fields for a SavedFilter entity.
+var SavedFilterPatchSchema = patch.NewSchema[SavedFilter]().
+	Register("name", patch.Field(func(f *SavedFilter) *string { return &f.Name })).
+	Register("description", patch.Field(func(f *SavedFilter) *string { return &f.Description })).
+	Register("expression", patch.Field(func(f *SavedFilter) *string { return &f.Expression })).
+	Register("sort", patch.Field(func(f *SavedFilter) *string { return &f.Sort }))
+
+// ApplyPatch applies partial updates from an incoming saved filter based on the field mask.
+func (f *SavedFilter) ApplyPatch(incoming *SavedFilter, mask []string) error {
+	if err := SavedFilterPatchSchema.Apply(f, incoming, mask); err != nil {
+		return err
+	}
+	f.UpdateTime = time.Now().UTC()
+	return f.Validate()
+}
+
+// TransactionFilterFields lists the fields accepted in transaction filter expressions.
+// budget_id also matches split lines and category_id includes subcategories, as with the dedicated filters;
+// tags matches membership via `tags:value`.
+var TransactionFilterFields = filter.Schema{
+	"transaction_date": filter.Date,
+	"effective_date":   filter.Date,
+	"create_time":      filter.Date,
+	"amount":           filter.Int,
+	"amount_in_base":   filter.Int,
+	"currency":         filter.String,
+	"type":             filter.String,
+	"description":      filter.String,
+	"budget_id":        filter.String,
+	"account_id":       filter.String,
+	"category_id":      filter.String,
+	"payee_id":         filter.String,
+	"tags":             filter.String,
+	"cleared":          filter.Bool,
+}
+
+// ParseTransactionFilter parses an AIP-160 expression over TransactionFilterFields.
+func ParseTransactionFilter(expression string) (filter.Expr, error) {
+	return filter.Parse(expression, TransactionFilterFields)
+}
+
+// DefaultSavedFilterSortField represents the fallback sorting column name for saved filters.
+const DefaultSavedFilterSortField = "name"
+
+// SavedFilterSortFields registry maps sortable saved filter field names to their cursor string extraction logic.
+var SavedFilterSortFields = map[string]func(*SavedFilter) string{
+	"name":        func(f *SavedFilter) string { return f.Name },
+	"create_time": func(f *SavedFilter) string { return f.CreateTime.Format(time.RFC3339Nano) },
+}
+
+// IsSavedFilterSortField validates if a sort column name is allowed.
+func IsSavedFilterSortField(field string) bool {
+	_, ok := SavedFilterSortFields[field]
+	return ok
+}
+
+// GetSortValue extracts the sort value string for pagination cursor generation.
+func (f *SavedFilter) GetSortValue(field string) string {
+	if fn, ok := SavedFilterSortFields[field]; ok {
+		return fn(f)
+	}
+	return f.GetSortValue(DefaultSavedFilterSortField)
+}
+
+// ListSavedFiltersFilter encapsulates filtering parameters for listing saved filters.
+type ListSavedFiltersFilter struct {
+	PageSize      int32
+	NextPageToken string
+	Sort          sorting.SortOrder
+}
+
+// combineTransactionFilters parses each non-empty expression and AND-s them together.
+func combineTransactionFilters(expressions ...string) (filter.Expr, error) {
+	exprs := make([]filter.Expr, 0, len(expressions))
+	for _, e := range expressions {
+		parsed, err := ParseTransactionFilter(e)
+		if err != nil {
+			return nil, err
+		}
+		exprs = append(exprs, parsed)
+	}
+	return filter.Conjoin(exprs...), nil
+}
@@ -1,14 +1,20 @@
 package finance
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"math"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/masterkeysrd/saturn/internal/platform/id"
 	"github.com/masterkeysrd/saturn/internal/platform/paging"
+	"github.com/masterkeysrd/saturn/internal/platform/sorting"
 )
 
 // Dependencies defines the required persistence adapters for the service.
@@ -26,6 +32,19 @@ type Dependencies struct {
 	TransferStore         TransferStore
 	TransactionEventStore TransactionEventStore
 	InboxItemStore        InboxItemStore
+	IncomeSourceStore     IncomeSourceStore
+	CategoryStore         CategoryStore
+	StatementMappingStore StatementMappingStore
+	ReconciliationStore   ReconciliationStore
+	GoalStore             GoalStore
+	SavedFilterStore      SavedFilterStore
+	PayeeStore            PayeeStore
+	TransactionRuleStore  TransactionRuleStore
+	LedgerExportStore     LedgerExportStore
+	CardStatementStore    CardStatementStore
+	AlertStore            AlertStore
+	RateProvider          RateProvider        // Optional; automatic rate syncing is disabled when nil
+	TransactionNotifier   TransactionNotifier // Optional; expenses are not checked against the alert rules when nil
 }
 
 // Service implements the domain-level finance operations.
@@ -90,6 +109,29 @@ func (s *Service) GetFinanceSettings(ctx context.Context, spaceID SpaceID) (*Fin
 	return s.deps.SettingsStore.GetByID(ctx, spaceID)
 }
 
+// UpdateFinanceSettings applies a partial update to the workspace settings.
+// Only the enabled currency list and timezone are mutable; the base currency is fixed once configured.
+// A timezone change applies to budget periods created afterwards; existing periods keep their bounds.
+func (s *Service) UpdateFinanceSettings(ctx context.Context, settings *FinanceSettings, mask []string) (*FinanceSettings, error) {
+	if err := settings.SpaceID.Validate(); err != nil {
+		return nil, fmt.Errorf("validate space ID: %w", err)
+	}
+
+	existing, err := s.deps.SettingsStore.GetByID(ctx, settings.SpaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := existing.ApplyPatch(settings, mask); err != nil {
+		return nil, err
+	}
+
+	if err := s.deps.SettingsStore.Update(ctx, existing); err != nil {
+		return nil, err
+	}
+	return existing, nil
+}
+
 // CreateBudget creates a new budget template in a workspace.
 func (s *Service) CreateBudget(ctx context.Context, budget *Budget) (*Budget, error) {
 	if string(budget.ID) == "" {
@@ -105,10 +147,13 @@ func (s *Service) CreateBudget(ctx context.Context, budget *Budget) (*Budget, er
 	}
 
 	// Verify workspace settings exist
-	_, err := s.deps.SettingsStore.GetByID(ctx, budget.SpaceID)
+	settings, err := s.deps.SettingsStore.GetByID(ctx, budget.SpaceID)
 	if err != nil {
 		return nil, fmt.Errorf("verify workspace settings: %w", err)
 	}
+	if !settings.IsCurrencyEnabled(budget.Currency) {
+		return nil, fmt.Errorf("%w: %s", ErrCurrencyDisabled, budget.Currency)
+	}
 
 	budget.IsActive = true
 	budget.CreateTime = time.Now().UTC()
@@ -133,9 +178,15 @@ func (s *Service) UpdateBudget(ctx context.Context, budget *Budget, mask []strin
 		return nil, ErrBudgetVersionMismatch
 	}
 
+	previousCurrency := existing.Currency
 	if err := existing.ApplyPatch(budget, mask); err != nil {
 		return nil, err
 	}
+	if existing.Currency != previousCurrency {
+		if err := s.ensureCurrencyEnabled(ctx, existing.SpaceID, existing.Currency); err != nil {
+			return nil, err
+		}
+	}
 
 	if err := s.deps.BudgetStore.Update(ctx, existing); err != nil {
 		return nil, err
@@ -181,7 +232,7 @@ func (s *Service) ListBudgets(ctx context.Context, spaceID SpaceID, filter *List
 	return s.deps.BudgetStore.ListBySpace(ctx, spaceID, filter)
 }
 
-// GetOrCreatePeriod retrieves or lazily spawns a budget period for a target date.
+// GetOrCreatePeriod retrieves the budget period containing a target date, lazily spawning it when missing.
 func (s *Service) GetOrCreatePeriod(ctx context.Context, spaceID SpaceID, budgetID BudgetID, date time.Time) (*BudgetPeriod, error) {
 	budget, err := s.deps.BudgetStore.GetByID(ctx, spaceID, budgetID)
 	if err != nil {
@@ -193,17 +244,98 @@ func (s *Service) GetOrCreatePeriod(ctx context.Context, spaceID SpaceID, budget
 		return nil, fmt.Errorf("fetch workspace base currency settings: %w", err)
 	}
 
-	startDate, endDate := budget.CalculateBounds(date)
+	// Period bounds have a one second resolution
+	date = date.Truncate(time.Second)
 
 	// Try lookup
-	period, err := s.deps.PeriodStore.GetByRange(ctx, budgetID, startDate, endDate)
+	period, err := s.deps.PeriodStore.GetByDate(ctx, budgetID, date)
 	if err == nil {
+		if err := s.resolveCarry(ctx, budget, settings.Location(), period); err != nil {
+			return nil, err
+		}
 		return period, nil
 	}
 	if !errors.Is(err, ErrPeriodNotFound) {
 		return nil, err
 	}
 
+	return s.createPeriod(ctx, budget, settings, date)
+}
+
+// GetOrCreatePeriods retrieves or lazily spawns budget periods for a slice of budgets in batch.
+func (s *Service) GetOrCreatePeriods(ctx context.Context, budgets []*Budget, date time.Time) (map[BudgetID]*BudgetPeriod, error) {
+	if len(budgets) == 0 {
+		return make(map[BudgetID]*BudgetPeriod), nil
+	}
+
+	// Fetch workspace base currency settings once (using the SpaceID of the first budget)
+	settings, err := s.deps.SettingsStore.GetByID(ctx, budgets[0].SpaceID)
+	if err != nil {
+		return nil, fmt.Errorf("fetch workspace base currency settings: %w", err)
+	}
+
+	date = date.Truncate(time.Second)
+	budgetIDs := make([]BudgetID, len(budgets))
+	for i, b := range budgets {
+		budgetIDs[i] = b.ID
+	}
+
+	// 1. Bulk-retrieve existing periods in a single DB query
+	existingPeriods, err := s.deps.PeriodStore.GetByDates(ctx, budgetIDs, date)
+	if err != nil {
+		return nil, fmt.Errorf("bulk fetch existing budget periods: %w", err)
+	}
+
+	periodsMap := make(map[BudgetID]*BudgetPeriod)
+	for _, p := range existingPeriods {
+		periodsMap[p.BudgetID] = p
+	}
+
+	// 2. Identify missing periods and create them
+	for _, b := range budgets {
+		if p, exists := periodsMap[b.ID]; exists {
+			if err := s.resolveCarry(ctx, b, settings.Location(), p); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		newPeriod, err := s.createPeriod(ctx, b, settings, date)
+		if err != nil {
+			return nil, err
+		}
+		periodsMap[b.ID] = newPeriod
+	}
+
+	return periodsMap, nil
+}
+
+// createPeriod spawns the budget period containing date, which no existing period covers.
+func (s *Service) createPeriod(ctx context.Context, budget *Budget, settings *FinanceSettings, date time.Time) (*BudgetPeriod, error) {
+	newPeriod, err := s.newPeriod(ctx, budget, settings, date)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.resolveCarry(ctx, budget, settings.Location(), newPeriod); err != nil {
+		return nil, err
+	}
+
+	if err := s.deps.PeriodStore.Create(ctx, newPeriod); err != nil {
+		return nil, fmt.Errorf("create budget period: %w", err)
+	}
+
+	return newPeriod, nil
+}
+
+// newPeriod builds the budget period containing date, which no existing period covers, without storing it
+// or resolving its carried balances.
+func (s *Service) newPeriod(ctx context.Context, budget *Budget, settings *FinanceSettings, date time.Time) (*BudgetPeriod, error) {
+	startDate, endDate, err := s.newPeriodBounds(ctx, budget, date, settings.Location())
+	if err != nil {
+		return nil, err
+	}
+
 	// Determine exchange rate to base currency
 	rate := 1.0
 	if budget.Currency != settings.BaseCurrency {
@@ -246,109 +378,148 @@ func (s *Service) GetOrCreatePeriod(ctx context.Context, spaceID SpaceID, budget
 	if err := newPeriod.Validate(); err != nil {
 		return nil, err
 	}
-
-	if err := s.deps.PeriodStore.Create(ctx, newPeriod); err != nil {
-		return nil, err
-	}
-
 	return newPeriod, nil
 }
 
-// GetOrCreatePeriods retrieves or lazily spawns budget periods for a slice of budgets in batch.
-func (s *Service) GetOrCreatePeriods(ctx context.Context, budgets []*Budget, date time.Time) (map[BudgetID]*BudgetPeriod, error) {
-	if len(budgets) == 0 {
-		return make(map[BudgetID]*BudgetPeriod), nil
-	}
+// newPeriodBounds computes the bounds of a new period around date from the budget's cycle. Existing periods
+// keep the bounds they were created with, even after the cycle or the workspace timezone changed, so the new
+// period is trimmed to the gap between its neighbours instead of overlapping them.
+func (s *Service) newPeriodBounds(ctx context.Context, budget *Budget, date time.Time, loc *time.Location) (time.Time, time.Time, error) {
+	startDate, endDate := budget.CalculateBoundsIn(date, loc)
 
-	// Fetch workspace base currency settings once (using the SpaceID of the first budget)
-	settings, err := s.deps.SettingsStore.GetByID(ctx, budgets[0].SpaceID)
+	neighbours, err := s.deps.PeriodStore.ListByRange(ctx, budget.ID, startDate, endDate)
 	if err != nil {
-		return nil, fmt.Errorf("fetch workspace base currency settings: %w", err)
+		return time.Time{}, time.Time{}, fmt.Errorf("fetch overlapping budget periods: %w", err)
 	}
-
-	// Calculate bounds for each budget
-	keys := make([]PeriodRangeKey, len(budgets))
-	boundsMap := make(map[BudgetID]struct{ Start, End time.Time })
-	for i, b := range budgets {
-		start, end := b.CalculateBounds(date)
-		keys[i] = PeriodRangeKey{
-			BudgetID:  b.ID,
-			StartDate: start,
-			EndDate:   end,
+	for _, p := range neighbours {
+		if p.EndDate.Before(date) && !p.EndDate.Before(startDate) {
+			startDate = p.EndDate.Add(time.Second)
+		}
+		if p.StartDate.After(date) && !p.StartDate.After(endDate) {
+			endDate = p.StartDate.Add(-time.Second)
 		}
-		boundsMap[b.ID] = struct{ Start, End time.Time }{Start: start, End: end}
 	}
+	return startDate, endDate, nil
+}
 
-	// 1. Bulk-retrieve existing periods in a single DB query
-	existingPeriods, err := s.deps.PeriodStore.GetByRanges(ctx, keys)
+// resolveCarry sets the balances rolled into period and, when a later period exists, out of it, and writes
+// back the stored balances that changed. Period itself is only written when it is already stored.
+func (s *Service) resolveCarry(ctx context.Context, budget *Budget, loc *time.Location, period *BudgetPeriod) error {
+	changed, err := s.computeCarry(ctx, budget, loc, period)
 	if err != nil {
-		return nil, fmt.Errorf("bulk fetch existing budget periods: %w", err)
-	}
-
-	periodsMap := make(map[BudgetID]*BudgetPeriod)
-	for _, p := range existingPeriods {
-		periodsMap[p.BudgetID] = p
+		return err
 	}
-
-	// 2. Identify missing periods and create them
-	for _, b := range budgets {
-		if _, exists := periodsMap[b.ID]; exists {
-			continue
+	for _, p := range changed {
+		if err := s.deps.PeriodStore.UpdateCarry(ctx, p.ID, p.CarriedIn, p.CarriedOut); err != nil {
+			return fmt.Errorf("record carried balances: %w", err)
 		}
+	}
+	return nil
+}
 
-		bounds := boundsMap[b.ID]
-
-		// Determine exchange rate to base currency
-		rate := 1.0
-		if b.Currency != settings.BaseCurrency {
-			rateRecord, err := s.getExchangeRate(ctx, ExchangeRateKey{
-				SpaceID:      b.SpaceID,
-				FromCurrency: Currency(b.Currency),
-				ToCurrency:   Currency(settings.BaseCurrency),
-				RateDate:     date,
-			})
-			if err != nil {
-				if errors.Is(err, ErrExchangeRateNotFound) {
-					rate = 0.0
-				} else {
-					return nil, fmt.Errorf("fetch exchange rate from %s to %s for date %s: %w", b.Currency, settings.BaseCurrency, date.Format("2006-01-02"), err)
-				}
-			} else {
-				rate = rateRecord.Rate
-			}
+// computeCarry sets the balances rolled into period and, when a later period exists, out of it, and returns
+// the stored periods whose balances changed. They are recomputed from the budget's earlier periods under its
+// current rollover policy rather than trusted from storage, so spending edited after a period was closed
+// still reaches the periods after it.
+func (s *Service) computeCarry(ctx context.Context, budget *Budget, loc *time.Location, period *BudgetPeriod) ([]*BudgetPeriod, error) {
+	periods, err := s.deps.PeriodStore.ListByBudget(ctx, budget.ID)
+	if err != nil {
+		return nil, fmt.Errorf("fetch budget periods: %w", err)
+	}
+	slices.SortFunc(periods, func(a, b *BudgetPeriod) int { return a.StartDate.Compare(b.StartDate) })
+
+	// The chain ends at period; stored copies of it are replaced so its own balances are resolved too.
+	var chain []*BudgetPeriod
+	var stored, hasNext bool
+	for _, p := range periods {
+		switch {
+		case p.ID == period.ID:
+			stored = true
+		case p.StartDate.Before(period.StartDate):
+			chain = append(chain, p)
+		default:
+			hasNext = true
 		}
+	}
+	chain = append(chain, period)
 
-		periodID, err := NewPeriodID()
+	type carry struct{ in, out int64 }
+	before := make(map[PeriodID]carry, len(chain))
+	ids := make([]PeriodID, len(chain))
+	for i, p := range chain {
+		before[p.ID] = carry{p.CarriedIn, p.CarriedOut}
+		ids[i] = p.ID
+	}
+	spent := make(map[PeriodID]int64, len(chain))
+	if budget.RolloverPolicy != "" && budget.RolloverPolicy != RolloverNone {
+		stats, err := s.AggregateSpentBatch(ctx, ids)
 		if err != nil {
-			return nil, err
+			return nil, fmt.Errorf("aggregate budget periods spent: %w", err)
 		}
-
-		newPeriod := &BudgetPeriod{
-			ID:                 periodID,
-			BudgetID:           b.ID,
-			SpaceID:            b.SpaceID,
-			StartDate:          bounds.Start,
-			EndDate:            bounds.End,
-			LimitAmount:        b.LimitAmount,
-			Currency:           b.Currency,
-			BaseCurrency:       settings.BaseCurrency,
-			ExchangeRateToBase: rate,
-			CreateTime:         time.Now().UTC(),
-			UpdateTime:         time.Now().UTC(),
+		for _, ps := range stats {
+			spent[ps.PeriodID] = ps.SpentAmount
 		}
+	}
 
-		if err := newPeriod.Validate(); err != nil {
-			return nil, err
+	chain[0].CarriedIn = 0
+	for i, p := range chain {
+		remaining := p.EffectiveLimit() - spent[p.ID]
+		switch {
+		case i+1 < len(chain):
+			p.CarriedOut = budget.RolloverPolicy.Carry(remaining, budget.RolloverCap)
+			chain[i+1].CarriedIn = budget.carryAcross(p, remaining, chain[i+1], loc)
+		case hasNext:
+			p.CarriedOut = budget.RolloverPolicy.Carry(remaining, budget.RolloverCap)
+		default:
+			p.CarriedOut = 0
 		}
+	}
 
-		if err := s.deps.PeriodStore.Create(ctx, newPeriod); err != nil {
-			return nil, fmt.Errorf("create budget period: %w", err)
+	var changed []*BudgetPeriod
+	for _, p := range chain {
+		if (p != period || stored) && before[p.ID] != (carry{p.CarriedIn, p.CarriedOut}) {
+			changed = append(changed, p)
 		}
+	}
+	return changed, nil
+}
 
-		periodsMap[b.ID] = newPeriod
+// resolvePeriod returns the period of the budget containing date. Without a batch a missing period is
+// created right away. With one, nothing is written: the missing period is built and added to the batch,
+// so it is stored in the same database transaction as the batch, or not at all when only validating.
+func (s *Service) resolvePeriod(ctx context.Context, budget *Budget, date time.Time, batch *TransactionBatch) (*BudgetPeriod, error) {
+	if batch == nil {
+		return s.GetOrCreatePeriod(ctx, budget.SpaceID, budget.ID, date)
 	}
 
-	return periodsMap, nil
+	date = date.Truncate(time.Second)
+	for _, p := range batch.Periods {
+		if p.BudgetID == budget.ID && !p.StartDate.After(date) && !p.EndDate.Before(date) {
+			return p, nil
+		}
+	}
+	period, err := s.deps.PeriodStore.GetByDate(ctx, budget.ID, date)
+	if err == nil {
+		return period, nil
+	}
+	if !errors.Is(err, ErrPeriodNotFound) {
+		return nil, err
+	}
+
+	settings, err := s.deps.SettingsStore.GetByID(ctx, budget.SpaceID)
+	if err != nil {
+		return nil, fmt.Errorf("fetch workspace base currency settings: %w", err)
+	}
+	period, err = s.newPeriod(ctx, budget, settings, date)
+	if err != nil {
+		return nil, err
+	}
+	// Earlier periods with outdated balances are corrected the next time they are read.
+	if _, err := s.computeCarry(ctx, budget, settings.Location(), period); err != nil {
+		return nil, err
+	}
+	batch.Periods = append(batch.Periods, period)
+	return period, nil
 }
 
 // AggregateSpentBatch calculates dynamic transaction spent progress for a list of budget period IDs.
@@ -449,10 +620,11 @@ func (s *Service) DeleteExchangeRateByID(ctx context.Context, spaceID SpaceID, i
 }
 
 // getExchangeRate resolves the exchange rate for the given key.
-// It first looks for the closest rate on or before the target date (backward).
-// If no such rate exists, it falls back to the closest rate after the target date (forward fallback).
+// It walks a fallback chain: the direct rate on the closest date on or before the target date,
+// then the inverse of the opposite pair, then a cross rate triangulated through USD.
+// If none of those exist, it falls back to the closest direct rate after the target date (forward fallback).
 func (s *Service) getExchangeRate(ctx context.Context, key ExchangeRateKey) (*ExchangeRate, error) {
-	rateRecord, err := s.deps.ExchangeRateStore.GetRate(ctx, key)
+	rateRecord, err := s.getPreviousRate(ctx, key)
 	if err == nil {
 		return rateRecord, nil
 	}
@@ -460,13 +632,155 @@ func (s *Service) getExchangeRate(ctx context.Context, key ExchangeRateKey) (*Ex
 		return nil, err
 	}
 
+	if key.FromCurrency != triangulationCurrency && key.ToCurrency != triangulationCurrency {
+		rateRecord, err := s.triangulateRate(ctx, key)
+		if err == nil {
+			return rateRecord, nil
+		}
+		if !errors.Is(err, ErrExchangeRateNotFound) {
+			return nil, err
+		}
+	}
+
 	return s.deps.ExchangeRateStore.GetNextRate(ctx, key)
 }
 
+// getPreviousRate looks up the closest rate on or before the target date, using the
+// inverse of the opposite pair when no direct rate is stored.
+func (s *Service) getPreviousRate(ctx context.Context, key ExchangeRateKey) (*ExchangeRate, error) {
+	rateRecord, err := s.deps.ExchangeRateStore.GetRate(ctx, key)
+	if err == nil {
+		return rateRecord, nil
+	}
+	if !errors.Is(err, ErrExchangeRateNotFound) {
+		return nil, err
+	}
+
+	inverse, err := s.deps.ExchangeRateStore.GetRate(ctx, ExchangeRateKey{
+		SpaceID:      key.SpaceID,
+		FromCurrency: key.ToCurrency,
+		ToCurrency:   key.FromCurrency,
+		RateDate:     key.RateDate,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if inverse.Rate <= 0 {
+		return nil, ErrExchangeRateNotFound
+	}
+
+	return derivedExchangeRate(key, 1/inverse.Rate, inverse.RateDate), nil
+}
+
+// triangulateRate derives a cross rate by chaining FROM->USD and USD->TO.
+// The resulting rate is dated with the older of the two legs.
+func (s *Service) triangulateRate(ctx context.Context, key ExchangeRateKey) (*ExchangeRate, error) {
+	fromLeg, err := s.getPreviousRate(ctx, ExchangeRateKey{
+		SpaceID:      key.SpaceID,
+		FromCurrency: key.FromCurrency,
+		ToCurrency:   triangulationCurrency,
+		RateDate:     key.RateDate,
+	})
+	if err != nil {
+		return nil, err
+	}
+	toLeg, err := s.getPreviousRate(ctx, ExchangeRateKey{
+		SpaceID:      key.SpaceID,
+		FromCurrency: triangulationCurrency,
+		ToCurrency:   key.ToCurrency,
+		RateDate:     key.RateDate,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rateDate := fromLeg.RateDate
+	if toLeg.RateDate.Before(rateDate) {
+		rateDate = toLeg.RateDate
+	}
+	return derivedExchangeRate(key, fromLeg.Rate*toLeg.Rate, rateDate), nil
+}
+
+// SyncExchangeRates fetches the latest reference rates and records a rate for every
+// currency pair a space uses (account, budget and transaction currencies to the base currency).
+// Rates already stored for the publication date are kept, so manually entered rates take precedence.
+func (s *Service) SyncExchangeRates(ctx context.Context) error {
+	if s.deps.RateProvider == nil {
+		return nil
+	}
+
+	pairs, err := s.deps.ExchangeRateStore.ListCurrencyPairs(ctx)
+	if err != nil {
+		return fmt.Errorf("list currency pairs: %w", err)
+	}
+	if len(pairs) == 0 {
+		return nil
+	}
+
+	snapshot, err := s.deps.RateProvider.LatestRates(ctx)
+	if err != nil {
+		return fmt.Errorf("fetch latest rates: %w", err)
+	}
+	y, m, d := snapshot.Date.Date()
+	rateDate := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+
+	for _, pair := range pairs {
+		// Pairs the provider does not quote are left to the lookup fallback chain.
+		rate, ok := snapshot.Cross(pair.FromCurrency, pair.ToCurrency)
+		if !ok {
+			continue
+		}
+
+		key := ExchangeRateKey{
+			SpaceID:      pair.SpaceID,
+			FromCurrency: pair.FromCurrency,
+			ToCurrency:   pair.ToCurrency,
+			RateDate:     rateDate,
+		}
+		if _, err := s.deps.ExchangeRateStore.GetExactRate(ctx, key); err == nil {
+			continue
+		} else if !errors.Is(err, ErrExchangeRateNotFound) {
+			return fmt.Errorf("check existing rate %s/%s: %w", pair.FromCurrency, pair.ToCurrency, err)
+		}
+
+		r := &ExchangeRate{
+			SpaceID:      pair.SpaceID,
+			FromCurrency: pair.FromCurrency,
+			ToCurrency:   pair.ToCurrency,
+			Rate:         rate,
+			RateDate:     rateDate,
+		}
+		r.ID = r.ComputeID()
+		if err := r.Validate(); err != nil {
+			return fmt.Errorf("validate rate %s/%s: %w", pair.FromCurrency, pair.ToCurrency, err)
+		}
+		if err := s.deps.ExchangeRateStore.Create(ctx, r); err != nil {
+			return fmt.Errorf("store rate %s/%s: %w", pair.FromCurrency, pair.ToCurrency, err)
+		}
+	}
+	return nil
+}
+
+// derivedExchangeRate builds an in-memory rate record that is not persisted.
+func derivedExchangeRate(key ExchangeRateKey, rate float64, rateDate time.Time) *ExchangeRate {
+	r := &ExchangeRate{
+		SpaceID:      key.SpaceID,
+		FromCurrency: key.FromCurrency,
+		ToCurrency:   key.ToCurrency,
+		Rate:         rate,
+		RateDate:     rateDate,
+	}
+	r.ID = r.ComputeID()
+	return r
+}
+
 // CreateExpense logs a new expense transaction.
 func (s *Service) CreateExpense(ctx context.Context, txn *Transaction) (*Transaction, error) {
 	txn.Type = TransactionTypeExpense
-	if txn.BudgetID == nil {
+	if err := s.applyTransactionRules(ctx, txn); err != nil {
+		return nil, err
+	}
+	if txn.BudgetID == nil && !txn.IsSplit() {
 		return nil, errors.New("expense transaction requires a budget ID")
 	}
 
@@ -507,13 +821,16 @@ func (s *Service) DeleteTransaction(ctx context.Context, spaceID SpaceID, id Tra
 	if err != nil {
 		return fmt.Errorf("fetch existing transaction to delete: %w", err)
 	}
+	if existing.IsReconciled() {
+		return ErrTransactionReconciled
+	}
 	return s.deleteTransaction(ctx, existing)
 }
 
 // UpdateExpense modifies an existing expense transaction.
 func (s *Service) UpdateExpense(ctx context.Context, txn *Transaction) (*Transaction, error) {
 	txn.Type = TransactionTypeExpense
-	if txn.BudgetID == nil {
+	if txn.BudgetID == nil && !txn.IsSplit() {
 		return nil, errors.New("expense transaction requires a budget ID")
 	}
 
@@ -521,41 +838,17 @@ func (s *Service) UpdateExpense(ctx context.Context, txn *Transaction) (*Transac
 	if err != nil {
 		return nil, fmt.Errorf("fetch existing transaction: %w", err)
 	}
+	if existing.IsReconciled() {
+		return nil, ErrTransactionReconciled
+	}
+	txn.Cleared = existing.Cleared
 
 	if err := s.updateTransaction(ctx, txn, existing); err != nil {
 		return nil, err
 	}
 
 	// Log manual edit transaction event with field diff
-	metadata := map[string]any{}
-	if existing.Amount != txn.Amount {
-		metadata["old_amount"] = existing.Amount
-		metadata["new_amount"] = txn.Amount
-	}
-	if existing.Description != txn.Description {
-		metadata["old_description"] = existing.Description
-		metadata["new_description"] = txn.Description
-	}
-	if existing.Currency != txn.Currency {
-		metadata["old_currency"] = string(existing.Currency)
-		metadata["new_currency"] = string(txn.Currency)
-	}
-	if existing.BudgetID != nil && txn.BudgetID != nil && *existing.BudgetID != *txn.BudgetID {
-		metadata["old_budget_id"] = string(*existing.BudgetID)
-		metadata["new_budget_id"] = string(*txn.BudgetID)
-	}
-	if (existing.AccountID == nil && txn.AccountID != nil) ||
-		(existing.AccountID != nil && txn.AccountID == nil) ||
-		(existing.AccountID != nil && txn.AccountID != nil && *existing.AccountID != *txn.AccountID) {
-		if existing.AccountID != nil {
-			metadata["old_account_id"] = string(*existing.AccountID)
-		}
-		if txn.AccountID != nil {
-			metadata["new_account_id"] = string(*txn.AccountID)
-		}
-	}
-
-	if len(metadata) > 0 {
+	if metadata := transactionEditDiff(existing, txn); len(metadata) > 0 {
 		_, _ = s.LogTransactionEvent(ctx, &TransactionEvent{
 			SpaceID:       txn.SpaceID,
 			TransactionID: txn.ID,
@@ -567,2499 +860,5945 @@ func (s *Service) UpdateExpense(ctx context.Context, txn *Transaction) (*Transac
 	return txn, nil
 }
 
-// ListTransactions retrieves paginated transactions.
-func (s *Service) ListTransactions(ctx context.Context, spaceID SpaceID, filter *TransactionFilter) (*paging.Page[*Transaction], error) {
-	if err := spaceID.Validate(); err != nil {
-		return nil, fmt.Errorf("validate space ID: %w", err)
-	}
-	return s.deps.TransactionStore.ListBySpace(ctx, spaceID, filter)
-}
+// CreateIncome logs a new income transaction, crediting the target account.
+func (s *Service) CreateIncome(ctx context.Context, txn *Transaction) (*Transaction, error) {
+	txn.Type = TransactionTypeIncome
+	txn.BudgetID = nil
+	txn.PeriodID = nil
 
-// GetSpentInsights computes aggregated outflow analytics and trends for a space.
-func (s *Service) GetSpentInsights(ctx context.Context, req *GetSpentInsightsRequest) (*SpentInsights, error) {
-	if err := req.SpaceID.Validate(); err != nil {
-		return nil, fmt.Errorf("validate space ID: %w", err)
-	}
-
-	settings, err := s.deps.SettingsStore.GetByID(ctx, req.SpaceID)
-	if err != nil {
-		return nil, fmt.Errorf("verify workspace settings: %w", err)
+	if err := s.resolveIncomeSource(ctx, txn); err != nil {
+		return nil, err
 	}
-
-	g, err := ParseGranularity(req.Granularity)
-	if err != nil {
-		return nil, fmt.Errorf("invalid granularity: %w", err)
+	if err := s.applyTransactionRules(ctx, txn); err != nil {
+		return nil, err
 	}
 
-	start := req.StartDate
-	if start.IsZero() {
-		switch g {
-		case GranularityDaily:
-			start = time.Now().UTC().AddDate(0, 0, -30)
-		case GranularityWeekly:
-			start = time.Now().UTC().AddDate(0, 0, -84) // 12 weeks
-		case GranularityMonthly:
-			start = time.Now().UTC().AddDate(-1, 0, 0) // 12 months
-		case GranularityYearly:
-			start = time.Now().UTC().AddDate(-5, 0, 0) // 5 years
+	if txn.ID == "" {
+		tID, err := NewTransactionID()
+		if err != nil {
+			return nil, err
 		}
-	}
-	end := req.EndDate
-	if end.IsZero() {
-		end = time.Now().UTC()
+		txn.ID = tID
 	}
 
-	// Fetch trend, distributions, and top expenses from storage
-	trendRows, err := s.deps.InsightsStore.GetSpentTrend(ctx, &SpentTrendFilter{
-		SpaceID:     req.SpaceID,
-		Granularity: g,
-		StartDate:   start,
-		EndDate:     end,
-	})
-	if err != nil {
-		return nil, fmt.Errorf("fetch spent trend: %w", err)
+	if err := s.createTransaction(ctx, txn); err != nil {
+		return nil, err
 	}
+	return txn, nil
+}
 
-	distRows, err := s.deps.InsightsStore.GetBudgetDistribution(ctx, &BudgetDistributionFilter{
-		SpaceID:   req.SpaceID,
-		StartDate: start,
-		EndDate:   end,
-	})
-	if err != nil {
-		return nil, fmt.Errorf("fetch budget distributions: %w", err)
-	}
+// UpdateIncome modifies an existing income transaction.
+func (s *Service) UpdateIncome(ctx context.Context, txn *Transaction) (*Transaction, error) {
+	txn.Type = TransactionTypeIncome
+	txn.BudgetID = nil
 
-	topRows, err := s.deps.InsightsStore.GetTopExpenses(ctx, &TopExpensesFilter{
-		SpaceID:   req.SpaceID,
-		StartDate: start,
-		EndDate:   end,
-		Limit:     5,
-	})
+	existing, err := s.deps.TransactionStore.GetByID(ctx, txn.SpaceID, txn.ID)
 	if err != nil {
-		return nil, fmt.Errorf("fetch top expenses: %w", err)
+		return nil, fmt.Errorf("fetch existing transaction: %w", err)
+	}
+	if existing.IsReconciled() {
+		return nil, ErrTransactionReconciled
+	}
+	txn.Cleared = existing.Cleared
+	if existing.Type != TransactionTypeIncome {
+		return nil, fmt.Errorf("transaction %s is not an income transaction", txn.ID)
 	}
 
-	// 1. Group raw trend rows by interval_start
-	trendPoints := make([]*TrendDataPoint, 0)
-	var currentPoint *TrendDataPoint
-	var lastStart time.Time
-
-	for _, row := range trendRows {
-		if currentPoint == nil || !row.IntervalStart.Equal(lastStart) {
-			var label string
-			switch g {
-			case GranularityDaily:
-				label = row.IntervalStart.Format("02 Jan")
-			case GranularityWeekly:
-				_, w := row.IntervalStart.ISOWeek()
-				label = fmt.Sprintf("Wk %d", w)
-			case GranularityMonthly:
-				label = row.IntervalStart.Format("Jan 06")
-			case GranularityYearly:
-				label = row.IntervalStart.Format("2006")
-			}
-
-			currentPoint = &TrendDataPoint{
-				Label:     label,
-				StartDate: row.IntervalStart.Format(time.RFC3339),
-			}
-			trendPoints = append(trendPoints, currentPoint)
-			lastStart = row.IntervalStart
-		}
-
-		currentPoint.AmountInBase += row.SpentInBase
-		currentPoint.TransactionCount += row.TxnCount
+	incomeSourceID := txn.Metadata.IncomeSourceID
+	txn.Metadata = existing.Metadata
+	txn.Metadata.IncomeSourceID = incomeSourceID
+	if err := s.resolveIncomeSource(ctx, txn); err != nil {
+		return nil, err
+	}
 
-		if row.BudgetID != "" {
-			currentPoint.Contributions = append(currentPoint.Contributions, &BudgetContribution{
-				BudgetID:      row.BudgetID,
-				BudgetName:    row.BudgetName,
-				BudgetColor:   row.BudgetColor,
-				AmountInBase:  row.SpentInBase,
-				AmountInLocal: row.SpentInLocal,
-				LocalCurrency: row.BudgetCurrency,
-			})
-		} else {
-			currentPoint.Contributions = append(currentPoint.Contributions, &BudgetContribution{
-				BudgetID:      "unbudgeted",
-				BudgetName:    "Unbudgeted",
-				BudgetColor:   "#94a3b8",
-				AmountInBase:  row.SpentInBase,
-				AmountInLocal: row.SpentInLocal,
-				LocalCurrency: string(settings.BaseCurrency),
-			})
-		}
+	if err := s.updateTransaction(ctx, txn, existing); err != nil {
+		return nil, err
 	}
 
-	// Calculate contribution percentages
-	for _, pt := range trendPoints {
-		if pt.AmountInBase > 0 {
-			for _, c := range pt.Contributions {
-				c.ContributionPercentage = (float64(c.AmountInBase) / float64(pt.AmountInBase)) * 100.0
-			}
+	metadata := transactionEditDiff(existing, txn)
+	if !sameIncomeSource(existing.Metadata.IncomeSourceID, txn.Metadata.IncomeSourceID) {
+		if existing.Metadata.IncomeSourceID != nil {
+			metadata["old_income_source_id"] = string(*existing.Metadata.IncomeSourceID)
+		}
+		if txn.Metadata.IncomeSourceID != nil {
+			metadata["new_income_source_id"] = string(*txn.Metadata.IncomeSourceID)
 		}
 	}
 
-	var unbudgetedSpentInBase int64
-	for _, row := range trendRows {
-		if row.BudgetID == "" {
-			unbudgetedSpentInBase += row.SpentInBase
-		}
+	if len(metadata) > 0 {
+		_, _ = s.LogTransactionEvent(ctx, &TransactionEvent{
+			SpaceID:       txn.SpaceID,
+			TransactionID: txn.ID,
+			EventType:     "MANUAL_EDIT",
+			Metadata:      metadata,
+		})
 	}
 
-	// 2. Map budget distributions
-	var totalSpent int64
-	var totalLimit int64
-	distributions := make([]*BudgetUsage, 0, len(distRows)+1)
+	return txn, nil
+}
 
-	for _, r := range distRows {
-		totalSpent += r.SpentInBase
+// resolveIncomeSource verifies the linked income source and defaults the deposit account from it.
+func (s *Service) resolveIncomeSource(ctx context.Context, txn *Transaction) error {
+	if txn.Metadata.IncomeSourceID == nil {
+		return nil
+	}
+	source, err := s.deps.IncomeSourceStore.GetByID(ctx, txn.SpaceID, *txn.Metadata.IncomeSourceID)
+	if err != nil {
+		return fmt.Errorf("fetch income source: %w", err)
+	}
+	if txn.AccountID == nil && source.AccountID != nil {
+		accountID := *source.AccountID
+		txn.AccountID = &accountID
+	}
+	return nil
+}
 
-		// Convert budget limit to base currency using the period's exchange rate
-		limitInBase := int64(float64(r.BudgetLimit) * r.ExchangeRateToBase)
-		totalLimit += limitInBase
+func sameIncomeSource(a, b *IncomeSourceID) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
 
-		usagePct := 0.0
-		if r.BudgetLimit > 0 {
-			usagePct = (float64(r.SpentInLocalMatching) / float64(r.BudgetLimit)) * 100.0
+// transactionEditDiff collects the user-editable fields that changed between two versions of a transaction.
+func transactionEditDiff(existing, txn *Transaction) map[string]any {
+	metadata := map[string]any{}
+	if existing.Amount != txn.Amount {
+		metadata["old_amount"] = existing.Amount
+		metadata["new_amount"] = txn.Amount
+	}
+	if existing.Description != txn.Description {
+		metadata["old_description"] = existing.Description
+		metadata["new_description"] = txn.Description
+	}
+	if existing.Currency != txn.Currency {
+		metadata["old_currency"] = string(existing.Currency)
+		metadata["new_currency"] = string(txn.Currency)
+	}
+	if existing.BudgetID != nil && txn.BudgetID != nil && *existing.BudgetID != *txn.BudgetID {
+		metadata["old_budget_id"] = string(*existing.BudgetID)
+		metadata["new_budget_id"] = string(*txn.BudgetID)
+	}
+	if (existing.AccountID == nil && txn.AccountID != nil) ||
+		(existing.AccountID != nil && txn.AccountID == nil) ||
+		(existing.AccountID != nil && txn.AccountID != nil && *existing.AccountID != *txn.AccountID) {
+		if existing.AccountID != nil {
+			metadata["old_account_id"] = string(*existing.AccountID)
+		}
+		if txn.AccountID != nil {
+			metadata["new_account_id"] = string(*txn.AccountID)
 		}
-
-		distributions = append(distributions, &BudgetUsage{
-			BudgetID:        r.BudgetID,
-			BudgetName:      r.BudgetName,
-			BudgetColor:     r.BudgetColor,
-			BudgetIcon:      r.BudgetIcon,
-			Limit:           r.BudgetLimit,
-			Spent:           r.SpentInLocalMatching,
-			SpentInBase:     r.SpentInBase,
-			UsagePercentage: usagePct,
-		})
 	}
-
-	if unbudgetedSpentInBase > 0 {
-		totalSpent += unbudgetedSpentInBase
-		distributions = append(distributions, &BudgetUsage{
-			BudgetID:        "unbudgeted",
-			BudgetName:      "Unbudgeted",
-			BudgetColor:     "#94a3b8",
-			BudgetIcon:      "Coins",
-			Limit:           0,
-			Spent:           unbudgetedSpentInBase,
-			SpentInBase:     unbudgetedSpentInBase,
-			UsagePercentage: 0.0,
-		})
+	if !sameCategory(existing.CategoryID, txn.CategoryID) {
+		if existing.CategoryID != nil {
+			metadata["old_category_id"] = string(*existing.CategoryID)
+		}
+		if txn.CategoryID != nil {
+			metadata["new_category_id"] = string(*txn.CategoryID)
+		}
 	}
-
-	// 3. Overall calculation stats
-	remaining := totalLimit - totalSpent
-	burnRate := 0.0
-	days := end.Sub(start).Hours() / 24.0
-	if days > 0 {
-		burnRate = float64(totalSpent) / days
+	if !slices.Equal(existing.Tags, txn.Tags) {
+		metadata["old_tags"] = existing.Tags
+		metadata["new_tags"] = txn.Tags
 	}
+	if !sameSplits(existing.Splits, txn.Splits) {
+		metadata["old_split_count"] = len(existing.Splits)
+		metadata["new_split_count"] = len(txn.Splits)
+	}
+	return metadata
+}
 
-	// 4. Map top expenses
-	topExpenses := make([]*HighValueExpense, 0, len(topRows))
-	for _, r := range topRows {
-		topExpenses = append(topExpenses, &HighValueExpense{
-			TransactionID:   r.TransactionID,
-			Description:     r.Description,
-			Amount:          r.Amount,
-			Currency:        r.Currency,
-			AmountInBase:    r.AmountInBase,
-			BudgetName:      r.BudgetName,
-			TransactionDate: r.TransactionDate,
-			EffectiveDate:   r.EffectiveDate,
-		})
+// sameSplits reports whether two split line sets allocate the same amounts to the same budgets and categories.
+func sameSplits(a, b []*TransactionSplit) bool {
+	return slices.EqualFunc(a, b, func(x, y *TransactionSplit) bool {
+		return x.Amount == y.Amount &&
+			x.Note == y.Note &&
+			sameBudget(x.BudgetID, y.BudgetID) &&
+			sameCategory(x.CategoryID, y.CategoryID)
+	})
+}
+
+func sameBudget(a, b *BudgetID) bool {
+	if a == nil || b == nil {
+		return a == b
 	}
+	return *a == *b
+}
 
-	return &SpentInsights{
-		TotalLimit:      totalLimit,
-		TotalSpent:      totalSpent,
-		RemainingBudget: remaining,
-		BurnRate:        burnRate,
-		Trend:           trendPoints,
-		Distributions:   distributions,
-		TopExpenses:     topExpenses,
-	}, nil
+func sameCategory(a, b *CategoryID) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
 }
 
-// CreateRecurringExpense configures a new recurring expense rule.
-func (s *Service) CreateRecurringExpense(ctx context.Context, re *RecurringExpense) (*RecurringExpense, error) {
-	if re.ID == "" {
-		id, err := NewRecurringExpenseID()
+// CreateCategory registers a new transaction category, optionally nested under a parent.
+func (s *Service) CreateCategory(ctx context.Context, category *Category) (*Category, error) {
+	if category.ID == "" {
+		cID, err := NewCategoryID()
 		if err != nil {
 			return nil, err
 		}
-		re.ID = id
+		category.ID = cID
 	}
 
-	re.Status = RecurringExpenseActive
-	re.CreateTime = time.Now().UTC()
-	re.UpdateTime = time.Now().UTC()
+	category.CreateTime = time.Now().UTC()
+	category.UpdateTime = category.CreateTime
 
-	if err := re.Validate(); err != nil {
+	if err := category.Validate(); err != nil {
 		return nil, err
 	}
 
-	if err := s.deps.RecurringExpenseStore.Create(ctx, re); err != nil {
+	if err := s.validateCategoryParent(ctx, category); err != nil {
 		return nil, err
 	}
-	return re, nil
+
+	if err := s.deps.CategoryStore.Create(ctx, category); err != nil {
+		return nil, err
+	}
+	return category, nil
 }
 
-// GetRecurringExpense retrieves a recurring expense by ID for a space.
-func (s *Service) GetRecurringExpense(ctx context.Context, spaceID SpaceID, id RecurringExpenseID) (*RecurringExpense, error) {
+// GetCategory retrieves a category by ID for a space.
+func (s *Service) GetCategory(ctx context.Context, spaceID SpaceID, id CategoryID) (*Category, error) {
 	if err := spaceID.Validate(); err != nil {
-		return nil, err
+		return nil, fmt.Errorf("validate space ID: %w", err)
 	}
 	if err := id.Validate(); err != nil {
-		return nil, err
+		return nil, fmt.Errorf("validate category ID: %w", err)
 	}
-	return s.deps.RecurringExpenseStore.GetByID(ctx, spaceID, id)
+	return s.deps.CategoryStore.GetByID(ctx, spaceID, id)
 }
 
-// GetRecurringExpenses retrieves a batch of recurring expenses by their IDs for a space.
-func (s *Service) GetRecurringExpenses(ctx context.Context, spaceID SpaceID, ids []RecurringExpenseID) ([]*RecurringExpense, error) {
-	if err := spaceID.Validate(); err != nil {
+// UpdateCategory updates an existing category, including moving it under a different parent.
+func (s *Service) UpdateCategory(ctx context.Context, category *Category) (*Category, error) {
+	existing, err := s.deps.CategoryStore.GetByID(ctx, category.SpaceID, category.ID)
+	if err != nil {
 		return nil, err
 	}
-	for _, id := range ids {
-		if err := id.Validate(); err != nil {
-			return nil, err
-		}
-	}
-	return s.deps.RecurringExpenseStore.GetByIDs(ctx, spaceID, ids)
-}
 
-// UpdateRecurringExpense updates an existing recurring expense template.
-func (s *Service) UpdateRecurringExpense(ctx context.Context, re *RecurringExpense) (*RecurringExpense, error) {
-	existing, err := s.deps.RecurringExpenseStore.GetByID(ctx, re.SpaceID, re.ID)
-	if err != nil {
+	category.CreateTime = existing.CreateTime
+	category.UpdateTime = time.Now().UTC()
+
+	if err := category.Validate(); err != nil {
 		return nil, err
 	}
 
-	re.CreateTime = existing.CreateTime
-	re.UpdateTime = time.Now().UTC()
-
-	if err := re.Validate(); err != nil {
+	if err := s.validateCategoryParent(ctx, category); err != nil {
 		return nil, err
 	}
 
-	if err := s.deps.RecurringExpenseStore.Update(ctx, re); err != nil {
+	if err := s.deps.CategoryStore.Update(ctx, category); err != nil {
 		return nil, err
 	}
-	return re, nil
+	return category, nil
 }
 
-// DeleteRecurringExpense deletes a recurring expense rule.
-func (s *Service) DeleteRecurringExpense(ctx context.Context, id RecurringExpenseID) error {
+// DeleteCategory removes a category. Its subcategories are promoted to top-level and its transactions become uncategorized.
+func (s *Service) DeleteCategory(ctx context.Context, spaceID SpaceID, id CategoryID) error {
+	if err := spaceID.Validate(); err != nil {
+		return fmt.Errorf("validate space ID: %w", err)
+	}
 	if err := id.Validate(); err != nil {
-		return err
+		return fmt.Errorf("validate category ID: %w", err)
 	}
-	return s.deps.RecurringExpenseStore.Delete(ctx, id)
+	return s.deps.CategoryStore.Delete(ctx, spaceID, id)
 }
 
-// ListRecurringExpenses lists recurring expenses for a workspace.
-func (s *Service) ListRecurringExpenses(ctx context.Context, spaceID SpaceID, filter *ListRecurringExpensesFilter) (*paging.Page[*RecurringExpense], error) {
+// ListCategories lists categories for a workspace.
+func (s *Service) ListCategories(ctx context.Context, spaceID SpaceID, filter *ListCategoriesFilter) (*paging.Page[*Category], error) {
 	if err := spaceID.Validate(); err != nil {
-		return nil, err
+		return nil, fmt.Errorf("validate space ID: %w", err)
 	}
-	return s.deps.RecurringExpenseStore.ListBySpace(ctx, spaceID, filter)
+	return s.deps.CategoryStore.ListBySpace(ctx, spaceID, filter)
 }
 
-// ListScheduledPayments lists scheduled payments for a workspace.
-func (s *Service) ListScheduledPayments(ctx context.Context, spaceID SpaceID, filter *ListScheduledPaymentsFilter) (*paging.Page[*ScheduledPayment], error) {
-	if err := spaceID.Validate(); err != nil {
-		return nil, err
+// validateCategoryParent walks up the ancestor chain to reject cycles and overly deep nesting.
+func (s *Service) validateCategoryParent(ctx context.Context, category *Category) error {
+	parentID := category.ParentID
+	for depth := 1; parentID != nil; depth++ {
+		if *parentID == category.ID {
+			return ErrCategoryCycle
+		}
+		if depth > MaxCategoryDepth {
+			return fmt.Errorf("categories cannot be nested more than %d levels deep", MaxCategoryDepth)
+		}
+		parent, err := s.deps.CategoryStore.GetByID(ctx, category.SpaceID, *parentID)
+		if err != nil {
+			return fmt.Errorf("fetch parent category: %w", err)
+		}
+		parentID = parent.ParentID
 	}
-	return s.deps.ScheduledPaymentStore.ListBySpace(ctx, spaceID, filter)
+	return nil
 }
 
-// ConfirmScheduledPaymentRequest represents parameters to confirm a scheduled payment.
-type ConfirmScheduledPaymentRequest struct {
-	SpaceID         SpaceID
-	PaymentID       ScheduledPaymentID
-	TransactionDate time.Time
-	EffectiveDate   time.Time
-	ActualAmount    int64
-	Description     string
-	AccountID       *AccountID
-	BudgetID        *BudgetID
-	Currency        *Currency
-}
+// CreateIncomeSource registers a new income source in a workspace.
+func (s *Service) CreateIncomeSource(ctx context.Context, source *IncomeSource) (*IncomeSource, error) {
+	if source.ID == "" {
+		sID, err := NewIncomeSourceID()
+		if err != nil {
+			return nil, err
+		}
+		source.ID = sID
+	}
 
-// ConfirmScheduledPayment clears a scheduled payment by promoting it to a permanent transaction.
-func (s *Service) ConfirmScheduledPayment(ctx context.Context, req ConfirmScheduledPaymentRequest) (*Transaction, error) {
-	payment, err := s.deps.ScheduledPaymentStore.GetByID(ctx, req.SpaceID, req.PaymentID)
-	if err != nil {
-		return nil, err
+	if source.AccountID != nil {
+		if _, err := s.deps.AccountStore.GetByID(ctx, source.SpaceID, *source.AccountID); err != nil {
+			return nil, fmt.Errorf("fetch deposit account: %w", err)
+		}
 	}
 
-	settings, err := s.deps.SettingsStore.GetByID(ctx, payment.SpaceID)
-	if err != nil {
+	source.IsActive = true
+	source.CreateTime = time.Now().UTC()
+	source.UpdateTime = source.CreateTime
+
+	if err := source.NormalizeRecurrence(); err != nil {
+		return nil, err
+	}
+	if err := source.Validate(); err != nil {
 		return nil, err
 	}
 
-	budgetID := payment.BudgetID
-	if req.BudgetID != nil && *req.BudgetID != "" {
-		budgetID = *req.BudgetID
+	if err := s.deps.IncomeSourceStore.Create(ctx, source); err != nil {
+		return nil, err
 	}
+	return source, nil
+}
 
-	currency := payment.Currency
-	if req.Currency != nil && *req.Currency != "" {
-		currency = *req.Currency
+// GetIncomeSource retrieves an income source by ID for a space.
+func (s *Service) GetIncomeSource(ctx context.Context, spaceID SpaceID, id IncomeSourceID) (*IncomeSource, error) {
+	if err := spaceID.Validate(); err != nil {
+		return nil, fmt.Errorf("validate space ID: %w", err)
 	}
+	if err := id.Validate(); err != nil {
+		return nil, fmt.Errorf("validate income source ID: %w", err)
+	}
+	return s.deps.IncomeSourceStore.GetByID(ctx, spaceID, id)
+}
 
-	budget, err := s.deps.BudgetStore.GetByID(ctx, payment.SpaceID, budgetID)
+// UpdateIncomeSource updates an existing income source.
+func (s *Service) UpdateIncomeSource(ctx context.Context, source *IncomeSource) (*IncomeSource, error) {
+	existing, err := s.deps.IncomeSourceStore.GetByID(ctx, source.SpaceID, source.ID)
 	if err != nil {
 		return nil, err
 	}
 
-	actualAmount := req.ActualAmount
-	if actualAmount <= 0 {
-		actualAmount = payment.Amount
+	if source.AccountID != nil {
+		if _, err := s.deps.AccountStore.GetByID(ctx, source.SpaceID, *source.AccountID); err != nil {
+			return nil, fmt.Errorf("fetch deposit account: %w", err)
+		}
 	}
 
-	txnDate := req.TransactionDate
-	if txnDate.IsZero() {
-		txnDate = time.Now().UTC()
-	}
+	source.CreateTime = existing.CreateTime
+	source.UpdateTime = time.Now().UTC()
 
-	effDate := req.EffectiveDate
-	if effDate.IsZero() {
-		effDate = txnDate
+	if err := source.NormalizeRecurrence(); err != nil {
+		return nil, err
+	}
+	if err := source.Validate(); err != nil {
+		return nil, err
 	}
 
-	// Resolve budget period for the transaction based on effectiveDate
-	period, err := s.GetOrCreatePeriod(ctx, payment.SpaceID, budget.ID, effDate)
-	if err != nil {
+	if err := s.deps.IncomeSourceStore.Update(ctx, source); err != nil {
 		return nil, err
 	}
+	return source, nil
+}
 
-	// Calculate base currency conversion
-	rate := 1.0
-	if currency != settings.BaseCurrency {
-		rateRecord, err := s.getExchangeRate(ctx, ExchangeRateKey{
-			SpaceID:      payment.SpaceID,
-			FromCurrency: currency,
-			ToCurrency:   settings.BaseCurrency,
-			RateDate:     txnDate,
-		})
+// DeleteIncomeSource removes an income source. Linked transactions keep their history.
+func (s *Service) DeleteIncomeSource(ctx context.Context, spaceID SpaceID, id IncomeSourceID) error {
+	if err := spaceID.Validate(); err != nil {
+		return fmt.Errorf("validate space ID: %w", err)
+	}
+	if err := id.Validate(); err != nil {
+		return fmt.Errorf("validate income source ID: %w", err)
+	}
+	return s.deps.IncomeSourceStore.Delete(ctx, spaceID, id)
+}
+
+// ListIncomeSources lists income sources for a workspace.
+func (s *Service) ListIncomeSources(ctx context.Context, spaceID SpaceID, filter *ListIncomeSourcesFilter) (*paging.Page[*IncomeSource], error) {
+	if err := spaceID.Validate(); err != nil {
+		return nil, fmt.Errorf("validate space ID: %w", err)
+	}
+	return s.deps.IncomeSourceStore.ListBySpace(ctx, spaceID, filter)
+}
+
+// CreateGoal registers a new savings goal in a workspace.
+func (s *Service) CreateGoal(ctx context.Context, goal *Goal) (*Goal, error) {
+	if goal.ID == "" {
+		gID, err := NewGoalID()
 		if err != nil {
 			return nil, err
 		}
-		rate = rateRecord.Rate
+		goal.ID = gID
 	}
 
-	amountInBase := int64(float64(actualAmount) * rate)
+	goal.Status = GoalStatusActive
+	goal.CreateTime = time.Now().UTC()
+	goal.UpdateTime = goal.CreateTime
+	if goal.StartDate.IsZero() {
+		goal.StartDate = goal.CreateTime
+	}
 
-	description := ""
-	if req.Description != "" {
-		description = req.Description
-	} else if len(payment.Metadata) > 0 {
-		var meta struct {
-			Description string `json:"description"`
-		}
-		if err := json.Unmarshal(payment.Metadata, &meta); err == nil && meta.Description != "" {
-			description = meta.Description
+	if err := goal.Validate(); err != nil {
+		return nil, err
+	}
+	if err := s.ensureCurrencyEnabled(ctx, goal.SpaceID, goal.Currency); err != nil {
+		return nil, err
+	}
+	if goal.AccountID != nil {
+		if _, err := s.deps.AccountStore.GetByID(ctx, goal.SpaceID, *goal.AccountID); err != nil {
+			return nil, fmt.Errorf("fetch linked account: %w", err)
 		}
 	}
 
-	if description == "" && payment.SourceType == SourceTypeRecurrentExpense {
-		if exp, err := s.deps.RecurringExpenseStore.GetByID(ctx, payment.SpaceID, RecurringExpenseID(payment.SourceID)); err == nil {
-			description = exp.Name
-		}
-	} else if description == "" && payment.SourceType == "invoice" {
-		if item, err := s.deps.InboxItemStore.Get(ctx, payment.SpaceID, payment.SourceID); err == nil {
-			if item.VendorName != "" {
-				description = item.VendorName
-			}
-		}
+	if err := s.deps.GoalStore.Create(ctx, goal); err != nil {
+		return nil, err
 	}
+	return goal, nil
+}
 
-	if description == "" {
-		description = "Scheduled Payment"
+// GetGoal retrieves a savings goal by ID for a space.
+func (s *Service) GetGoal(ctx context.Context, spaceID SpaceID, id GoalID) (*Goal, error) {
+	if err := spaceID.Validate(); err != nil {
+		return nil, fmt.Errorf("validate space ID: %w", err)
+	}
+	if err := id.Validate(); err != nil {
+		return nil, fmt.Errorf("validate goal ID: %w", err)
 	}
+	return s.deps.GoalStore.GetByID(ctx, spaceID, id)
+}
 
-	tID, err := NewTransactionID()
+// UpdateGoal updates an existing savings goal.
+func (s *Service) UpdateGoal(ctx context.Context, goal *Goal) (*Goal, error) {
+	existing, err := s.deps.GoalStore.GetByID(ctx, goal.SpaceID, goal.ID)
 	if err != nil {
 		return nil, err
 	}
 
-	reID := RecurringExpenseID(payment.SourceID)
-	txn := &Transaction{
-		ID:              tID,
-		SpaceID:         payment.SpaceID,
-		AccountID:       req.AccountID,
-		Type:            TransactionTypeExpense,
-		BudgetID:        &budgetID,
-		PeriodID:        &period.ID,
-		Amount:          actualAmount,
-		Currency:        currency,
-		AmountInBase:    amountInBase,
-		Description:     description,
-		TransactionDate: txnDate,
-		EffectiveDate:   effDate,
-		Metadata: TransactionMetadata{
-			ScheduledPaymentID: &payment.ID,
-			RecurringExpenseID: &reID,
-		},
-		CreateTime: time.Now().UTC(),
-		UpdateTime: time.Now().UTC(),
+	if goal.StartDate.IsZero() {
+		goal.StartDate = existing.StartDate
 	}
-
-	if err := txn.Validate(); err != nil {
-		return nil, err
+	if goal.Status == "" {
+		goal.Status = existing.Status
 	}
+	goal.CreateTime = existing.CreateTime
+	goal.UpdateTime = time.Now().UTC()
 
-	if err := s.deps.TransactionStore.Create(ctx, txn); err != nil {
+	if err := goal.Validate(); err != nil {
 		return nil, err
 	}
-
-	if req.AccountID != nil && *req.AccountID != "" {
-		if err := s.adjustAccountBalance(ctx, payment.SpaceID, *req.AccountID, actualAmount, TransactionTypeExpense, false); err != nil {
-			return nil, fmt.Errorf("failed to adjust account balance: %w", err)
+	if goal.Currency != existing.Currency {
+		if err := s.ensureCurrencyEnabled(ctx, goal.SpaceID, goal.Currency); err != nil {
+			return nil, err
 		}
 	}
-
-	// Log the historical scheduled event with the deferred creation date
-	_, err = s.LogTransactionEvent(ctx, &TransactionEvent{
-		SpaceID:       payment.SpaceID,
-		TransactionID: txn.ID,
-		EventType:     "EXPENSE_SCHEDULED",
-		CreateTime:    payment.CreateTime,
-		Metadata:      map[string]any{"scheduled_payment_id": string(payment.ID)},
-	})
-	if err != nil {
-		return nil, fmt.Errorf("failed to log scheduled event: %w", err)
+	if goal.AccountID != nil {
+		if _, err := s.deps.AccountStore.GetByID(ctx, goal.SpaceID, *goal.AccountID); err != nil {
+			return nil, fmt.Errorf("fetch linked account: %w", err)
+		}
 	}
 
-	// Log the actual payment confirmation event with the transaction date
-	_, err = s.LogTransactionEvent(ctx, &TransactionEvent{
-		SpaceID:       payment.SpaceID,
-		TransactionID: txn.ID,
-		EventType:     "BANK_CONFIRM_RECEIVED",
-		CreateTime:    txnDate,
-		Metadata:      map[string]any{"actual_amount": actualAmount},
-	})
-	if err != nil {
-		return nil, fmt.Errorf("failed to log payment confirmation event: %w", err)
+	if err := s.deps.GoalStore.Update(ctx, goal); err != nil {
+		return nil, err
 	}
+	return goal, nil
+}
 
-	// Mark scheduled payment as paid
-	payment.Status = ScheduledPaymentPaid
-	payment.UpdateTime = time.Now().UTC()
-	if err := s.deps.ScheduledPaymentStore.Update(ctx, payment); err != nil {
-		return nil, fmt.Errorf("failed to update scheduled payment status: %w", err)
+// DeleteGoal removes a savings goal. Contributing transactions and transfers are preserved.
+func (s *Service) DeleteGoal(ctx context.Context, spaceID SpaceID, id GoalID) error {
+	if err := spaceID.Validate(); err != nil {
+		return fmt.Errorf("validate space ID: %w", err)
+	}
+	if err := id.Validate(); err != nil {
+		return fmt.Errorf("validate goal ID: %w", err)
 	}
+	return s.deps.GoalStore.Delete(ctx, spaceID, id)
+}
 
-	return txn, nil
+// ListGoals lists savings goals for a workspace.
+func (s *Service) ListGoals(ctx context.Context, spaceID SpaceID, filter *ListGoalsFilter) (*paging.Page[*Goal], error) {
+	if err := spaceID.Validate(); err != nil {
+		return nil, fmt.Errorf("validate space ID: %w", err)
+	}
+	return s.deps.GoalStore.ListBySpace(ctx, spaceID, filter)
 }
 
-// MatchScheduledPaymentRequest represents parameters to link an existing transaction to a scheduled payment.
-type MatchScheduledPaymentRequest struct {
-	SpaceID       SpaceID
-	PaymentID     ScheduledPaymentID
-	TransactionID TransactionID
+// GetGoalProgress computes the goal's saved amount, contribution velocity and projected completion date.
+func (s *Service) GetGoalProgress(ctx context.Context, spaceID SpaceID, id GoalID) (*GoalProgress, error) {
+	goal, err := s.GetGoal(ctx, spaceID, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.goalProgress(ctx, goal, time.Now().UTC())
 }
 
-// MatchScheduledPayment links an existing transaction with a pending scheduled payment, marking the payment cleared.
-func (s *Service) MatchScheduledPayment(ctx context.Context, req MatchScheduledPaymentRequest) (*Transaction, error) {
-	payment, err := s.deps.ScheduledPaymentStore.GetByID(ctx, req.SpaceID, req.PaymentID)
+func (s *Service) goalProgress(ctx context.Context, goal *Goal, now time.Time) (*GoalProgress, error) {
+	contributions, err := s.goalContributions(ctx, goal)
 	if err != nil {
-		return nil, fmt.Errorf("scheduled payment not found: %w", err)
+		return nil, err
 	}
+	return ProjectGoal(goal, contributions, now), nil
+}
 
-	txn, err := s.deps.TransactionStore.GetByID(ctx, req.SpaceID, req.TransactionID)
+// goalContributions collects the goal's contributing transactions, signed and converted into the goal currency.
+func (s *Service) goalContributions(ctx context.Context, goal *Goal) ([]*GoalContribution, error) {
+	txns, err := s.deps.TransactionStore.ListForGoal(ctx, goal.SpaceID, goal.AccountID, goal.Tag, goal.StartDate)
 	if err != nil {
-		return nil, fmt.Errorf("transaction not found: %w", err)
+		return nil, fmt.Errorf("list goal contributions: %w", err)
+	}
+	if len(txns) == 0 {
+		return []*GoalContribution{}, nil
 	}
 
-	if txn.SpaceID != payment.SpaceID {
-		return nil, errors.New("transaction and scheduled payment belong to different spaces")
+	settings, err := s.deps.SettingsStore.GetByID(ctx, goal.SpaceID)
+	if err != nil {
+		return nil, fmt.Errorf("verify workspace settings: %w", err)
 	}
 
-	// Update transaction link properties in metadata
-	reID := RecurringExpenseID(payment.SourceID)
-	txn.Metadata.ScheduledPaymentID = &payment.ID
-	txn.Metadata.RecurringExpenseID = &reID
-	txn.UpdateTime = time.Now().UTC()
+	// Lazily resolved rate from the base currency into the goal currency, used for foreign contributions.
+	var baseToGoal *float64
 
-	if err := s.deps.TransactionStore.Update(ctx, txn); err != nil {
-		return nil, fmt.Errorf("failed to link transaction: %w", err)
-	}
+	contributions := make([]*GoalContribution, 0, len(txns))
+	for _, txn := range txns {
+		if !goal.IsContribution(txn) {
+			continue
+		}
 
-	// Log event
-	_, err = s.LogTransactionEvent(ctx, &TransactionEvent{
-		SpaceID:       payment.SpaceID,
-		TransactionID: txn.ID,
-		EventType:     "SCHEDULED_PAYMENT_LINKED",
-		CreateTime:    time.Now().UTC(),
-		Metadata:      map[string]any{"scheduled_payment_id": string(payment.ID)},
-	})
+		amount := txn.Amount
+		if txn.Currency != goal.Currency {
+			amount = txn.AmountInBase
+			if goal.Currency != settings.BaseCurrency {
+				if baseToGoal == nil {
+					rate, err := s.getExchangeRate(ctx, ExchangeRateKey{
+						SpaceID:      goal.SpaceID,
+						FromCurrency: settings.BaseCurrency,
+						ToCurrency:   goal.Currency,
+						RateDate:     time.Now().UTC(),
+					})
+					if err != nil {
+						return nil, fmt.Errorf("resolve %s to %s exchange rate: %w", settings.BaseCurrency, goal.Currency, err)
+					}
+					baseToGoal = &rate.Rate
+				}
+				amount = ConvertAmount(txn.AmountInBase, settings.BaseCurrency, goal.Currency, *baseToGoal)
+			}
+		}
+
+		// Funds leaving the linked account draw the goal down; everything else adds to it.
+		if txn.Type == TransactionTypeTransferOut && goal.AccountID != nil && txn.AccountID != nil && *txn.AccountID == *goal.AccountID {
+			amount = -amount
+		}
+
+		contributions = append(contributions, &GoalContribution{
+			TransactionID:   txn.ID,
+			Type:            txn.Type,
+			Description:     txn.Description,
+			Amount:          amount,
+			TransactionDate: txn.TransactionDate,
+		})
+	}
+	return contributions, nil
+}
+
+// listActiveGoalProgress projects every active goal in the space for the insights dashboard.
+func (s *Service) listActiveGoalProgress(ctx context.Context, spaceID SpaceID, now time.Time) ([]*GoalProgress, error) {
+	active := GoalStatusActive
+	filter := &ListGoalsFilter{Status: &active, PageSize: 100}
+	progress := make([]*GoalProgress, 0)
+	for {
+		page, err := s.deps.GoalStore.ListBySpace(ctx, spaceID, filter)
+		if err != nil {
+			return nil, err
+		}
+		for _, goal := range page.Items {
+			p, err := s.goalProgress(ctx, goal, now)
+			if err != nil {
+				return nil, err
+			}
+			// The dashboard only needs the summary.
+			p.Contributions = nil
+			progress = append(progress, p)
+		}
+		if page.NextPageToken == "" {
+			break
+		}
+		filter.NextPageToken = page.NextPageToken
+	}
+	return progress, nil
+}
+
+// ListTransactions retrieves paginated transactions.
+func (s *Service) ListTransactions(ctx context.Context, spaceID SpaceID, filter *TransactionFilter) (*paging.Page[*Transaction], error) {
+	if err := spaceID.Validate(); err != nil {
+		return nil, fmt.Errorf("validate space ID: %w", err)
+	}
+
+	expressions := []string{filter.Expression}
+	if filter.SavedFilterID != nil {
+		saved, err := s.GetSavedFilter(ctx, spaceID, *filter.SavedFilterID)
+		if err != nil {
+			return nil, err
+		}
+		expressions = append(expressions, saved.Expression)
+		if filter.Sort.Field == "" && saved.Sort != "" {
+			filter.Sort = sorting.Parse(saved.Sort)
+		}
+	}
+	where, err := combineTransactionFilters(expressions...)
 	if err != nil {
-		return nil, fmt.Errorf("failed to log match event: %w", err)
+		return nil, err
 	}
+	filter.Where = where
 
-	// Mark scheduled payment as paid
-	payment.Status = ScheduledPaymentPaid
-	payment.UpdateTime = time.Now().UTC()
-	if err := s.deps.ScheduledPaymentStore.Update(ctx, payment); err != nil {
-		return nil, fmt.Errorf("failed to update scheduled payment status: %w", err)
+	return s.deps.TransactionStore.ListBySpace(ctx, spaceID, filter)
+}
+
+// CreateSavedFilter stores a named transaction filter expression for a workspace.
+func (s *Service) CreateSavedFilter(ctx context.Context, f *SavedFilter) (*SavedFilter, error) {
+	if string(f.ID) == "" {
+		fID, err := NewSavedFilterID()
+		if err != nil {
+			return nil, err
+		}
+		f.ID = fID
 	}
+	f.CreateTime = time.Now().UTC()
+	f.UpdateTime = f.CreateTime
 
-	return txn, nil
+	if err := f.Validate(); err != nil {
+		return nil, err
+	}
+	if err := s.deps.SavedFilterStore.Create(ctx, f); err != nil {
+		return nil, err
+	}
+	return f, nil
 }
 
-// GetScheduledPayment retrieves a scheduled payment by ID for a space.
-func (s *Service) GetScheduledPayment(ctx context.Context, spaceID SpaceID, id ScheduledPaymentID) (*ScheduledPayment, error) {
+// GetSavedFilter retrieves a saved transaction filter by ID for a space.
+func (s *Service) GetSavedFilter(ctx context.Context, spaceID SpaceID, id SavedFilterID) (*SavedFilter, error) {
 	if err := spaceID.Validate(); err != nil {
 		return nil, fmt.Errorf("validate space ID: %w", err)
 	}
 	if err := id.Validate(); err != nil {
-		return nil, fmt.Errorf("validate scheduled payment ID: %w", err)
+		return nil, fmt.Errorf("validate saved filter ID: %w", err)
 	}
-	return s.deps.ScheduledPaymentStore.GetByID(ctx, spaceID, id)
+	return s.deps.SavedFilterStore.GetByID(ctx, spaceID, id)
 }
 
-// SkipScheduledPayment marks a pending scheduled payment as skipped for a cycle.
-func (s *Service) SkipScheduledPayment(ctx context.Context, spaceID SpaceID, id ScheduledPaymentID) (*ScheduledPayment, error) {
-	if err := spaceID.Validate(); err != nil {
+// UpdateSavedFilter applies a partial update to a saved transaction filter.
+func (s *Service) UpdateSavedFilter(ctx context.Context, f *SavedFilter, mask []string) (*SavedFilter, error) {
+	existing, err := s.GetSavedFilter(ctx, f.SpaceID, f.ID)
+	if err != nil {
 		return nil, err
 	}
-	if err := id.Validate(); err != nil {
+	if err := existing.ApplyPatch(f, mask); err != nil {
 		return nil, err
 	}
-
-	payment, err := s.deps.ScheduledPaymentStore.GetByID(ctx, spaceID, id)
-	if err != nil {
+	if err := s.deps.SavedFilterStore.Update(ctx, existing); err != nil {
 		return nil, err
 	}
+	return existing, nil
+}
 
-	if payment.SpaceID != spaceID {
-		return nil, ErrScheduledPaymentNotFound
+// DeleteSavedFilter removes a saved transaction filter.
+func (s *Service) DeleteSavedFilter(ctx context.Context, spaceID SpaceID, id SavedFilterID) error {
+	if err := spaceID.Validate(); err != nil {
+		return fmt.Errorf("validate space ID: %w", err)
 	}
-
-	payment.Status = ScheduledPaymentSkipped
-	if err := s.deps.ScheduledPaymentStore.UpdateStatus(ctx, id, ScheduledPaymentSkipped); err != nil {
-		return nil, fmt.Errorf("update scheduled payment status: %w", err)
+	if err := id.Validate(); err != nil {
+		return fmt.Errorf("validate saved filter ID: %w", err)
 	}
-
-	return payment, nil
+	return s.deps.SavedFilterStore.Delete(ctx, spaceID, id)
 }
 
-// GenerateScheduledPayments performs bulk generation of pending scheduled payments for recurring expenses.
-func (s *Service) GenerateScheduledPayments(ctx context.Context) error {
-	// Query templates due in next 10 days
-	maxDueDate := time.Now().AddDate(0, 0, 10)
-	expenses, err := s.deps.RecurringExpenseStore.ListPendingGeneration(ctx, maxDueDate)
-	if err != nil {
-		return err
+// ListSavedFilters lists saved transaction filters for a workspace.
+func (s *Service) ListSavedFilters(ctx context.Context, spaceID SpaceID, filter *ListSavedFiltersFilter) (*paging.Page[*SavedFilter], error) {
+	if err := spaceID.Validate(); err != nil {
+		return nil, fmt.Errorf("validate space ID: %w", err)
 	}
+	return s.deps.SavedFilterStore.ListBySpace(ctx, spaceID, filter)
+}
 
-	for _, re := range expenses {
-		// Generate all scheduled payments up to 10 days in the future
-		for re.NextDueDate.Before(maxDueDate) || re.NextDueDate.Equal(maxDueDate) {
-			spID, err := NewScheduledPaymentID()
-			if err != nil {
-				return err
-			}
+// CreatePayee stores a payee for a workspace.
+func (s *Service) CreatePayee(ctx context.Context, p *Payee) (*Payee, error) {
+	if string(p.ID) == "" {
+		pID, err := NewPayeeID()
+		if err != nil {
+			return nil, err
+		}
+		p.ID = pID
+	}
+	p.CreateTime = time.Now().UTC()
+	p.UpdateTime = p.CreateTime
 
-			var dateTag string
-			switch re.Interval {
-			case "monthly":
-				dateTag = re.NextDueDate.Format("2006-01")
-			case "yearly":
-				dateTag = re.NextDueDate.Format("2006")
-			case "weekly":
-				dateTag = re.NextDueDate.Format("2006-01-02")
-			default:
-				dateTag = re.NextDueDate.Format("2006-01-02")
-			}
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	if err := s.validatePayeeReferences(ctx, p); err != nil {
+		return nil, err
+	}
+	if err := s.deps.PayeeStore.Create(ctx, p); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
 
-			descText := fmt.Sprintf("%s (%s)", re.Name, dateTag)
-			metaMap := map[string]any{
-				"name":        re.Name,
-				"due_date":    re.NextDueDate.Format("2006-01-02"),
-				"description": descText,
-			}
-			metaBytes, err := json.Marshal(metaMap)
-			if err != nil {
-				return fmt.Errorf("marshal scheduled payment metadata: %w", err)
-			}
+// GetPayee retrieves a payee by ID for a space.
+func (s *Service) GetPayee(ctx context.Context, spaceID SpaceID, id PayeeID) (*Payee, error) {
+	if err := spaceID.Validate(); err != nil {
+		return nil, fmt.Errorf("validate space ID: %w", err)
+	}
+	if err := id.Validate(); err != nil {
+		return nil, fmt.Errorf("validate payee ID: %w", err)
+	}
+	return s.deps.PayeeStore.GetByID(ctx, spaceID, id)
+}
 
-			payment := &ScheduledPayment{
-				ID:         spID,
-				SpaceID:    re.SpaceID,
-				BudgetID:   re.BudgetID,
-				SourceType: SourceTypeRecurrentExpense,
-				SourceID:   string(re.ID),
-				Amount:     re.Amount,
-				Currency:   re.Currency,
-				DueDate:    re.NextDueDate,
-				Status:     ScheduledPaymentPending,
-				Metadata:   metaBytes,
-				CreateTime: time.Now().UTC(),
-				UpdateTime: time.Now().UTC(),
-			}
+// UpdatePayee applies a partial update to a payee.
+func (s *Service) UpdatePayee(ctx context.Context, p *Payee, mask []string) (*Payee, error) {
+	existing, err := s.GetPayee(ctx, p.SpaceID, p.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := existing.ApplyPatch(p, mask); err != nil {
+		return nil, err
+	}
+	if err := s.validatePayeeReferences(ctx, existing); err != nil {
+		return nil, err
+	}
+	if err := s.deps.PayeeStore.Update(ctx, existing); err != nil {
+		return nil, err
+	}
+	return existing, nil
+}
 
-			if err := payment.Validate(); err != nil {
-				return err
-			}
+// DeletePayee removes a payee. Transactions keep their descriptions and lose the payee link.
+func (s *Service) DeletePayee(ctx context.Context, spaceID SpaceID, id PayeeID) error {
+	if err := spaceID.Validate(); err != nil {
+		return fmt.Errorf("validate space ID: %w", err)
+	}
+	if err := id.Validate(); err != nil {
+		return fmt.Errorf("validate payee ID: %w", err)
+	}
+	return s.deps.PayeeStore.Delete(ctx, spaceID, id)
+}
 
-			if err := s.deps.ScheduledPaymentStore.Create(ctx, payment); err != nil {
-				return err
-			}
+// ListPayees lists the payees of a workspace.
+func (s *Service) ListPayees(ctx context.Context, spaceID SpaceID, filter *ListPayeesFilter) (*paging.Page[*Payee], error) {
+	if err := spaceID.Validate(); err != nil {
+		return nil, fmt.Errorf("validate space ID: %w", err)
+	}
+	return s.deps.PayeeStore.ListBySpace(ctx, spaceID, filter)
+}
 
-			// Advance the template next due date
-			switch re.Interval {
-			case "weekly":
-				re.NextDueDate = re.NextDueDate.AddDate(0, 0, 7)
-			case "monthly":
-				re.NextDueDate = re.NextDueDate.AddDate(0, 1, 0)
-			case "yearly":
-				re.NextDueDate = re.NextDueDate.AddDate(1, 0, 0)
-			default:
-				return fmt.Errorf("unsupported interval for recurring expense %s: %s", re.ID, re.Interval)
-			}
+// validatePayeeReferences verifies the payee's default budget and account belong to its space.
+func (s *Service) validatePayeeReferences(ctx context.Context, p *Payee) error {
+	if p.DefaultBudgetID != nil {
+		if _, err := s.deps.BudgetStore.GetByID(ctx, p.SpaceID, *p.DefaultBudgetID); err != nil {
+			return fmt.Errorf("fetch default budget: %w", err)
 		}
-
-		re.UpdateTime = time.Now().UTC()
-		if err := s.deps.RecurringExpenseStore.Update(ctx, re); err != nil {
-			return err
+	}
+	if p.DefaultAccountID != nil {
+		if _, err := s.deps.AccountStore.GetByID(ctx, p.SpaceID, *p.DefaultAccountID); err != nil {
+			return fmt.Errorf("fetch default account: %w", err)
 		}
 	}
-
 	return nil
 }
 
-// createTransaction persists a transaction and adjusts the account balance.
-func (s *Service) createTransaction(ctx context.Context, txn *Transaction) error {
-	// 1. Set dates
-	if txn.TransactionDate.IsZero() {
-		txn.TransactionDate = time.Now().UTC()
+// CreateTransactionRule stores a transaction rule for a workspace. New rules are active.
+func (s *Service) CreateTransactionRule(ctx context.Context, r *TransactionRule) (*TransactionRule, error) {
+	if string(r.ID) == "" {
+		rID, err := NewTransactionRuleID()
+		if err != nil {
+			return nil, err
+		}
+		r.ID = rID
 	}
-	if txn.EffectiveDate.IsZero() {
-		txn.EffectiveDate = txn.TransactionDate
+	r.IsActive = true
+	r.CreateTime = time.Now().UTC()
+	r.UpdateTime = r.CreateTime
+
+	if err := r.Validate(); err != nil {
+		return nil, err
 	}
-	if txn.CreateTime.IsZero() {
-		txn.CreateTime = time.Now().UTC()
+	if err := s.validateTransactionRuleReferences(ctx, r); err != nil {
+		return nil, err
 	}
-	txn.UpdateTime = time.Now().UTC()
+	if err := s.deps.TransactionRuleStore.Create(ctx, r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
 
-	// 2. Fetch workspace settings
-	settings, err := s.deps.SettingsStore.GetByID(ctx, txn.SpaceID)
+// GetTransactionRule retrieves a transaction rule by ID for a space.
+func (s *Service) GetTransactionRule(ctx context.Context, spaceID SpaceID, id TransactionRuleID) (*TransactionRule, error) {
+	if err := spaceID.Validate(); err != nil {
+		return nil, fmt.Errorf("validate space ID: %w", err)
+	}
+	if err := id.Validate(); err != nil {
+		return nil, fmt.Errorf("validate transaction rule ID: %w", err)
+	}
+	return s.deps.TransactionRuleStore.GetByID(ctx, spaceID, id)
+}
+
+// UpdateTransactionRule applies a partial update to a transaction rule.
+func (s *Service) UpdateTransactionRule(ctx context.Context, r *TransactionRule, mask []string) (*TransactionRule, error) {
+	existing, err := s.GetTransactionRule(ctx, r.SpaceID, r.ID)
 	if err != nil {
-		return fmt.Errorf("verify workspace settings: %w", err)
+		return nil, err
+	}
+	if err := existing.ApplyPatch(r, mask); err != nil {
+		return nil, err
+	}
+	if err := s.validateTransactionRuleReferences(ctx, existing); err != nil {
+		return nil, err
+	}
+	if err := s.deps.TransactionRuleStore.Update(ctx, existing); err != nil {
+		return nil, err
 	}
+	return existing, nil
+}
 
-	// 3. Centralized Budget Period Resolution
-	if txn.BudgetID != nil {
-		budget, err := s.deps.BudgetStore.GetByID(ctx, txn.SpaceID, *txn.BudgetID)
-		if err != nil {
-			return fmt.Errorf("fetch budget template: %w", err)
+// DeleteTransactionRule removes a transaction rule. Transactions it already classified are unchanged.
+func (s *Service) DeleteTransactionRule(ctx context.Context, spaceID SpaceID, id TransactionRuleID) error {
+	if err := spaceID.Validate(); err != nil {
+		return fmt.Errorf("validate space ID: %w", err)
+	}
+	if err := id.Validate(); err != nil {
+		return fmt.Errorf("validate transaction rule ID: %w", err)
+	}
+	return s.deps.TransactionRuleStore.Delete(ctx, spaceID, id)
+}
+
+// ListTransactionRules lists the transaction rules of a workspace.
+func (s *Service) ListTransactionRules(ctx context.Context, spaceID SpaceID, filter *ListTransactionRulesFilter) (*paging.Page[*TransactionRule], error) {
+	if err := spaceID.Validate(); err != nil {
+		return nil, fmt.Errorf("validate space ID: %w", err)
+	}
+	return s.deps.TransactionRuleStore.ListBySpace(ctx, spaceID, filter)
+}
+
+// validateTransactionRuleReferences verifies the entities a rule matches on or assigns belong to its space.
+func (s *Service) validateTransactionRuleReferences(ctx context.Context, r *TransactionRule) error {
+	if r.AccountID != nil {
+		if _, err := s.deps.AccountStore.GetByID(ctx, r.SpaceID, *r.AccountID); err != nil {
+			return fmt.Errorf("fetch account: %w", err)
 		}
-		period, err := s.GetOrCreatePeriod(ctx, txn.SpaceID, budget.ID, txn.EffectiveDate)
-		if err != nil {
-			return fmt.Errorf("resolve active budget period: %w", err)
+	}
+	if r.SetBudgetID != nil {
+		if _, err := s.deps.BudgetStore.GetByID(ctx, r.SpaceID, *r.SetBudgetID); err != nil {
+			return fmt.Errorf("fetch budget: %w", err)
 		}
-		txn.PeriodID = &period.ID
 	}
-
-	// 4. Centralized Base Currency Exchange Rate Calculation
-	if txn.AmountInBase == 0 || txn.Currency != settings.BaseCurrency {
-		rate := 1.0
-		if txn.Currency != settings.BaseCurrency {
-			rateRecord, err := s.getExchangeRate(ctx, ExchangeRateKey{
-				SpaceID:      txn.SpaceID,
-				FromCurrency: txn.Currency,
-				ToCurrency:   settings.BaseCurrency,
-				RateDate:     txn.TransactionDate,
-			})
-			if err != nil {
-				return fmt.Errorf("fetch exchange rate from %s to %s for date %s: %w", txn.Currency, settings.BaseCurrency, txn.TransactionDate.Format("2006-01-02"), err)
-			}
-			rate = rateRecord.Rate
+	if r.SetPayeeID != nil {
+		if _, err := s.deps.PayeeStore.GetByID(ctx, r.SpaceID, *r.SetPayeeID); err != nil {
+			return fmt.Errorf("fetch payee: %w", err)
 		}
-		txn.AmountInBase = int64(float64(txn.Amount) * rate)
 	}
-
-	if err := txn.Validate(); err != nil {
-		return err
+	if r.SetCategoryID != nil {
+		if _, err := s.deps.CategoryStore.GetByID(ctx, r.SpaceID, *r.SetCategoryID); err != nil {
+			return fmt.Errorf("fetch category: %w", err)
+		}
 	}
+	return nil
+}
 
-	// 5. Persist the transaction
-	if err := s.deps.TransactionStore.Create(ctx, txn); err != nil {
-		return err
+// transactionRuleSet loads the space's active rules and payees.
+func (s *Service) transactionRuleSet(ctx context.Context, spaceID SpaceID) (*TransactionRuleSet, error) {
+	activeOnly := true
+	ruleFilter := &ListTransactionRulesFilter{PageSize: 100, ActiveOnly: &activeOnly}
+	var rules []*TransactionRule
+	for {
+		page, err := s.deps.TransactionRuleStore.ListBySpace(ctx, spaceID, ruleFilter)
+		if err != nil {
+			return nil, fmt.Errorf("list transaction rules: %w", err)
+		}
+		rules = append(rules, page.Items...)
+		if page.NextPageToken == "" {
+			break
+		}
+		ruleFilter.NextPageToken = page.NextPageToken
 	}
 
-	// 6. Adjust account balance
-	if txn.AccountID != nil {
-		if err := s.adjustAccountBalance(ctx, txn.SpaceID, *txn.AccountID, txn.Amount, txn.Type, false); err != nil {
-			return fmt.Errorf("failed to adjust account balance: %w", err)
+	payeeFilter := &ListPayeesFilter{PageSize: 100}
+	var payees []*Payee
+	for {
+		page, err := s.deps.PayeeStore.ListBySpace(ctx, spaceID, payeeFilter)
+		if err != nil {
+			return nil, fmt.Errorf("list payees: %w", err)
 		}
+		payees = append(payees, page.Items...)
+		if page.NextPageToken == "" {
+			break
+		}
+		payeeFilter.NextPageToken = page.NextPageToken
 	}
 
-	return nil
+	return NewTransactionRuleSet(rules, payees)
 }
 
-// updateTransaction updates a transaction and recalculates account balances.
-func (s *Service) updateTransaction(ctx context.Context, txn *Transaction, existing *Transaction) error {
-	if existing.Type == TransactionTypeBalanceAdjustment {
-		return errors.New("balance adjustment transactions cannot be edited directly; perform a new balance adjustment or delete this record to revert")
+// MatchTransactionRules evaluates the space's rules and payees against a draft transaction without
+// modifying it.
+func (s *Service) MatchTransactionRules(ctx context.Context, spaceID SpaceID, txn *Transaction) (*TransactionRuleMatch, error) {
+	if err := spaceID.Validate(); err != nil {
+		return nil, fmt.Errorf("validate space ID: %w", err)
 	}
+	rs, err := s.transactionRuleSet(ctx, spaceID)
+	if err != nil {
+		return nil, err
+	}
+	return rs.Match(txn), nil
+}
 
-	// 1. Set dates
-	if txn.EffectiveDate.IsZero() {
-		txn.EffectiveDate = txn.TransactionDate
+// applyTransactionRules fills the blank payee, budget, category and account of a new transaction
+// from the space's rules and payees.
+func (s *Service) applyTransactionRules(ctx context.Context, txn *Transaction) error {
+	match, err := s.MatchTransactionRules(ctx, txn.SpaceID, txn)
+	if err != nil {
+		return err
 	}
-	txn.UpdateTime = time.Now().UTC()
+	match.ApplyTo(txn, false)
+	return nil
+}
 
-	// 2. Fetch workspace settings
-	settings, err := s.deps.SettingsStore.GetByID(ctx, txn.SpaceID)
+// ApplyTransactionRules re-runs the space's rules and payees over the expenses and income within the
+// requested dates. Transactions locked by a finalized reconciliation are skipped.
+func (s *Service) ApplyTransactionRules(ctx context.Context, req *ApplyTransactionRulesRequest) (*ApplyTransactionRulesResult, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	rs, err := s.transactionRuleSet(ctx, req.SpaceID)
 	if err != nil {
-		return fmt.Errorf("verify workspace settings: %w", err)
+		return nil, err
 	}
 
-	// 3. Centralized Budget Period Resolution
-	if txn.BudgetID != nil {
-		budget, err := s.deps.BudgetStore.GetByID(ctx, txn.SpaceID, *txn.BudgetID)
+	filter := &TransactionFilter{
+		StartDate: req.StartDate,
+		EndDate:   req.EndDate,
+		PageSize:  100,
+		Sort:      sorting.SortOrder{Field: "transaction_date", Ascending: true},
+	}
+	result := &ApplyTransactionRulesResult{}
+	for {
+		page, err := s.deps.TransactionStore.ListBySpace(ctx, req.SpaceID, filter)
 		if err != nil {
-			return fmt.Errorf("fetch budget template: %w", err)
+			return nil, fmt.Errorf("list transactions: %w", err)
 		}
-		period, err := s.GetOrCreatePeriod(ctx, txn.SpaceID, budget.ID, txn.EffectiveDate)
-		if err != nil {
-			return fmt.Errorf("resolve active budget period: %w", err)
+		for _, existing := range page.Items {
+			if existing.Type != TransactionTypeExpense && existing.Type != TransactionTypeIncome {
+				continue
+			}
+			result.Scanned++
+			if existing.ReconciliationID != nil {
+				result.Skipped++
+				continue
+			}
+			txn := *existing
+			txn.Tags = slices.Clone(existing.Tags)
+			if !rs.Match(&txn).ApplyTo(&txn, req.Overwrite) {
+				continue
+			}
+			if err := s.updateTransaction(ctx, &txn, existing); err != nil {
+				return nil, fmt.Errorf("update transaction %s: %w", existing.ID, err)
+			}
+			result.Updated++
 		}
-		txn.PeriodID = &period.ID
-	} else {
-		txn.PeriodID = nil
-	}
-
-	// 4. Centralized Base Currency Exchange Rate Calculation
-	if txn.Currency != settings.BaseCurrency {
-		rateRecord, err := s.getExchangeRate(ctx, ExchangeRateKey{
-			SpaceID:      txn.SpaceID,
-			FromCurrency: txn.Currency,
-			ToCurrency:   settings.BaseCurrency,
-			RateDate:     txn.TransactionDate,
-		})
-		if err != nil {
-			return fmt.Errorf("fetch exchange rate from %s to %s for date %s: %w", txn.Currency, settings.BaseCurrency, txn.TransactionDate.Format("2006-01-02"), err)
+		if page.NextPageToken == "" {
+			break
 		}
-		txn.AmountInBase = int64(float64(txn.Amount) * rateRecord.Rate)
-	} else {
-		txn.AmountInBase = txn.Amount
-	}
-
-	if err := txn.Validate(); err != nil {
-		return err
+		filter.NextPageToken = page.NextPageToken
 	}
+	return result, nil
+}
 
-	// 5. Revert the old transaction's balance impact
-	if existing.AccountID != nil {
-		if err := s.adjustAccountBalance(ctx, existing.SpaceID, *existing.AccountID, existing.Amount, existing.Type, true); err != nil {
-			return fmt.Errorf("failed to revert account balance: %w", err)
-		}
+// BatchUpdateTransactions applies the same field changes to many expenses and income at once. The
+// transactions, their events and the net account balance changes are persisted in a single database
+// transaction. With ValidateOnly nothing is persisted and the result describes the would-be changes.
+func (s *Service) BatchUpdateTransactions(ctx context.Context, req *BatchUpdateTransactionsRequest) (*BatchTransactionsResult, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
 	}
-
-	// 6. Persist the updated transaction
-	if err := s.deps.TransactionStore.Update(ctx, txn); err != nil {
-		return err
+	targets, err := s.selectBatchTransactions(ctx, req.SpaceID, &req.Selector)
+	if err != nil {
+		return nil, err
 	}
 
-	// 7. Apply the new transaction's balance impact
-	if txn.AccountID != nil {
-		if err := s.adjustAccountBalance(ctx, txn.SpaceID, *txn.AccountID, txn.Amount, txn.Type, false); err != nil {
-			return fmt.Errorf("failed to apply updated account balance: %w", err)
+	batch := &TransactionBatch{SpaceID: req.SpaceID}
+	result := &BatchTransactionsResult{}
+	var balances []balanceImpact
+	var periodIDs []PeriodID
+	now := time.Now().UTC()
+	for _, existing := range targets {
+		if slices.Contains(req.UpdateMask, "budget_id") && existing.IsSplit() {
+			return nil, fmt.Errorf("transaction %s: split transactions carry budgets on their split lines", existing.ID)
 		}
-	}
-
-	return nil
-}
 
-// deleteTransaction deletes a transaction, reverts its account balance impact, and syncs linked borrowings.
-func (s *Service) deleteTransaction(ctx context.Context, txn *Transaction) error {
-	// 1. Revert the account balance impact using account_impact_amount if present
-	if txn.AccountID != nil && *txn.AccountID != "" {
-		impactAmount := txn.Amount
-		if txn.Metadata.AccountImpactAmount > 0 {
-			impactAmount = txn.Metadata.AccountImpactAmount
+		txn := *existing
+		txn.Splits = cloneSplits(existing.Splits)
+		if err := TransactionPatchSchema.Apply(&txn, req.Transaction, req.UpdateMask); err != nil {
+			return nil, err
 		}
-		if err := s.adjustAccountBalance(ctx, txn.SpaceID, *txn.AccountID, impactAmount, txn.Type, true); err != nil {
-			return fmt.Errorf("failed to revert account balance on deletion: %w", err)
+		txn.Tags = slices.Clone(txn.Tags)
+		if txn.Type == TransactionTypeIncome {
+			txn.BudgetID = nil
 		}
-	}
-
-	// 2. Revert borrowing remaining balance if linked via metadata
-	if txn.Metadata.BorrowingID != nil && *txn.Metadata.BorrowingID != "" {
-		role := txn.Metadata.BorrowingRole
-		borrowingAmount := txn.Amount
-		if txn.Metadata.BorrowingAmount > 0 {
-			borrowingAmount = txn.Metadata.BorrowingAmount
+		if txn.Type == TransactionTypeExpense && txn.BudgetID == nil && !txn.IsSplit() {
+			return nil, fmt.Errorf("transaction %s: expense transaction requires a budget ID", txn.ID)
+		}
+		if err := s.resolveTransactionUpdate(ctx, &txn, existing, batch); err != nil {
+			return nil, fmt.Errorf("transaction %s: %w", txn.ID, err)
 		}
 
-		if b, err := s.deps.BorrowingStore.GetByID(ctx, txn.SpaceID, *txn.Metadata.BorrowingID); err == nil {
-			switch role {
-			case "REPAYMENT":
-				b.RemainingAmount += borrowingAmount
-				if b.RemainingAmount > b.TotalAmount {
-					b.RemainingAmount = b.TotalAmount
-				}
-				b.Status = BorrowingStatusActive
-				b.UpdateTime = time.Now().UTC()
-				_ = s.deps.BorrowingStore.Update(ctx, b)
+		changes := diffTransactionFields(existing, &txn, req.UpdateMask)
+		if len(changes) == 0 {
+			continue
+		}
+		if existing.AccountID != nil {
+			balances = append(balances, balanceImpact{*existing.AccountID, existing.Amount, existing.Type, true})
+		}
+		if txn.AccountID != nil {
+			balances = append(balances, balanceImpact{*txn.AccountID, txn.Amount, txn.Type, false})
+		}
+		periodIDs = append(periodIDs, transactionPeriodIDs(existing)...)
+		periodIDs = append(periodIDs, transactionPeriodIDs(&txn)...)
 
-			case "INITIAL_FUNDING":
-				b.RemainingAmount = 0
-				b.Status = BorrowingStatusPaidOff
-				b.UpdateTime = time.Now().UTC()
-				_ = s.deps.BorrowingStore.Update(ctx, b)
-			}
+		eventID, err := NewTransactionEventID()
+		if err != nil {
+			return nil, err
 		}
+		metadata := make(map[string]any, 2*len(changes))
+		for _, c := range changes {
+			metadata["old_"+c.Field] = c.OldValue
+			metadata["new_"+c.Field] = c.NewValue
+		}
+		batch.Events = append(batch.Events, &TransactionEvent{
+			ID:            eventID,
+			SpaceID:       txn.SpaceID,
+			TransactionID: txn.ID,
+			EventType:     "BATCH_EDIT",
+			Metadata:      metadata,
+			CreateTime:    now,
+		})
+		batch.Updated = append(batch.Updated, &txn)
+		result.Transactions = append(result.Transactions, &txn)
+		result.Diffs = append(result.Diffs, &TransactionDiff{TransactionID: txn.ID, Changes: changes})
 	}
 
-	// 3. Delete the transaction from persistence
-	return s.deps.TransactionStore.Delete(ctx, txn.ID)
+	if err := s.finishTransactionBatch(ctx, batch, result, balances, periodIDs, req.ValidateOnly); err != nil {
+		return nil, err
+	}
+	return result, nil
 }
 
-// adjustAccountBalance updates the balance of the specified account based on transaction changes.
-func (s *Service) adjustAccountBalance(ctx context.Context, spaceID SpaceID, accountID AccountID, amount int64, txnType TransactionType, revert bool) error {
-	acc, err := s.deps.AccountStore.GetByID(ctx, spaceID, accountID)
+// BatchDeleteTransactions deletes many expenses and income at once. The deletions and the net
+// account balance changes are persisted in a single database transaction. With ValidateOnly nothing
+// is persisted and the result describes the would-be changes.
+func (s *Service) BatchDeleteTransactions(ctx context.Context, req *BatchDeleteTransactionsRequest) (*BatchTransactionsResult, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	targets, err := s.selectBatchTransactions(ctx, req.SpaceID, &req.Selector)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	if txnType == TransactionTypeBalanceAdjustment {
-		if revert {
-			acc.CurrentBalance -= amount
-		} else {
-			acc.CurrentBalance += amount
+	batch := &TransactionBatch{SpaceID: req.SpaceID}
+	result := &BatchTransactionsResult{}
+	var balances []balanceImpact
+	var periodIDs []PeriodID
+	for _, txn := range targets {
+		if txn.AccountID != nil {
+			impactAmount := txn.Amount
+			if txn.Metadata.AccountImpactAmount > 0 {
+				impactAmount = txn.Metadata.AccountImpactAmount
+			}
+			balances = append(balances, balanceImpact{*txn.AccountID, impactAmount, txn.Type, true})
 		}
-		acc.UpdateTime = time.Now().UTC()
-		return s.deps.AccountStore.Update(ctx, acc)
+		periodIDs = append(periodIDs, transactionPeriodIDs(txn)...)
+		batch.Deleted = append(batch.Deleted, txn.ID)
+		result.Transactions = append(result.Transactions, txn)
 	}
 
-	// Determine if the transaction is an inflow or an outflow
-	isOutflow := (txnType == TransactionTypeExpense || txnType == TransactionTypeTransferOut)
-	isInflow := (txnType == TransactionTypeIncome || txnType == TransactionTypeTransferIn)
-
-	// Reverse logic if we are reverting an operation (on update or delete)
-	if revert {
-		isOutflow, isInflow = isInflow, isOutflow
+	if err := s.finishTransactionBatch(ctx, batch, result, balances, periodIDs, req.ValidateOnly); err != nil {
+		return nil, err
 	}
+	return result, nil
+}
+
+// balanceImpact is one transaction's effect on an account balance, collected by batch operations so
+// every account is adjusted once by the net change.
+type balanceImpact struct {
+	accountID AccountID
+	amount    int64
+	txnType   TransactionType
+	revert    bool
+}
 
-	if acc.Type == AccountTypeCreditCard {
-		// Liability Account Rules (Positive = Debt Owed):
-		// Outflow (Purchase/Expense/TransferOut) INCREASES debt (+amount)
-		// Inflow (Card Payment/Refund/TransferIn) DECREASES debt (-amount)
-		if isOutflow {
-			acc.CurrentBalance += amount
-		} else if isInflow {
-			acc.CurrentBalance -= amount
+// selectBatchTransactions loads the transactions picked by the selector and checks that every one of
+// them can be changed in a batch.
+func (s *Service) selectBatchTransactions(ctx context.Context, spaceID SpaceID, sel *TransactionSelector) ([]*Transaction, error) {
+	var txns []*Transaction
+	if len(sel.IDs) > 0 {
+		for _, id := range sel.IDs {
+			txn, err := s.deps.TransactionStore.GetByID(ctx, spaceID, id)
+			if err != nil {
+				return nil, fmt.Errorf("fetch transaction %s: %w", id, err)
+			}
+			txns = append(txns, txn)
 		}
 	} else {
-		// Asset Account Rules (Positive = Money Owned):
-		// Outflow (Withdrawal/Expense/TransferOut) DECREASES asset (-amount)
-		// Inflow (Deposit/Income/TransferIn) INCREASES asset (+amount)
-		if isOutflow {
-			acc.CurrentBalance -= amount
-		} else if isInflow {
-			acc.CurrentBalance += amount
+		filter := &TransactionFilter{
+			Expression:    sel.Expression,
+			SavedFilterID: sel.SavedFilterID,
+			PageSize:      100,
+			Sort:          sorting.SortOrder{Field: "transaction_date", Ascending: true},
+		}
+		for {
+			page, err := s.ListTransactions(ctx, spaceID, filter)
+			if err != nil {
+				return nil, err
+			}
+			txns = append(txns, page.Items...)
+			if len(txns) > MaxTransactionBatchSize {
+				return nil, fmt.Errorf("filter matches more than %d transactions", MaxTransactionBatchSize)
+			}
+			if page.NextPageToken == "" {
+				break
+			}
+			filter.NextPageToken = page.NextPageToken
 		}
 	}
 
-	acc.UpdateTime = time.Now().UTC()
-	return s.deps.AccountStore.Update(ctx, acc)
-}
-
-type syncTransactionParams struct {
-	SpaceID         SpaceID
-	BorrowingID     string
-	Amount          int64
-	Currency        Currency
-	TransactionDate time.Time
-	Description     string
-	Type            TransactionType
-	AccountID       *AccountID
-}
-
-// Helper to create or update associated transaction
-func (s *Service) syncTransaction(ctx context.Context, params syncTransactionParams) error {
-	// Find if transaction already exists
-	bID := params.BorrowingID
-	page, err := s.deps.TransactionStore.ListBySpace(ctx, params.SpaceID, &TransactionFilter{
-		BorrowingID: &bID,
-		PageSize:    1,
-	})
-	if err != nil {
-		return fmt.Errorf("list existing transactions: %w", err)
+	for _, txn := range txns {
+		if err := checkBatchable(txn); err != nil {
+			return nil, err
+		}
 	}
-	existingTxs := page.Items
-
-	if len(existingTxs) > 0 {
-		existing := existingTxs[0]
-		// Clone and modify for update
-		txn := *existing
-		txn.Amount = params.Amount
-		txn.Currency = params.Currency
-		txn.Description = params.Description
-		txn.TransactionDate = params.TransactionDate
-		txn.EffectiveDate = params.TransactionDate
-		txn.Type = params.Type
-		txn.AccountID = params.AccountID
-		txn.UpdateTime = time.Now().UTC()
+	return txns, nil
+}
 
-		if err := s.updateTransaction(ctx, &txn, existing); err != nil {
-			return fmt.Errorf("update transaction: %w", err)
+// finishTransactionBatch nets the balance impacts per account, fills in the result and, unless
+// validateOnly is set, persists the batch.
+func (s *Service) finishTransactionBatch(ctx context.Context, batch *TransactionBatch, result *BatchTransactionsResult, balances []balanceImpact, periodIDs []PeriodID, validateOnly bool) error {
+	var accountIDs []AccountID
+	for _, b := range balances {
+		if !slices.Contains(accountIDs, b.accountID) {
+			accountIDs = append(accountIDs, b.accountID)
 		}
-	} else {
-		tID, err := NewTransactionID()
+	}
+	accounts := make(map[AccountID]*Account, len(accountIDs))
+	if len(accountIDs) > 0 {
+		list, err := s.deps.AccountStore.GetByIDs(ctx, batch.SpaceID, accountIDs)
 		if err != nil {
-			return err
+			return fmt.Errorf("fetch accounts: %w", err)
 		}
-		bID := BorrowingID(params.BorrowingID)
-		txn := &Transaction{
-			ID:              tID,
-			SpaceID:         params.SpaceID,
-			Type:            params.Type,
-			Amount:          params.Amount,
-			Currency:        params.Currency,
-			Description:     params.Description,
-			TransactionDate: params.TransactionDate,
-			EffectiveDate:   params.TransactionDate,
-			AccountID:       params.AccountID,
-			Metadata: TransactionMetadata{
-				BorrowingID:   &bID,
-				BorrowingRole: "INITIAL_FUNDING",
-			},
-			CreateTime: time.Now().UTC(),
-			UpdateTime: time.Now().UTC(),
+		for _, acc := range list {
+			accounts[acc.ID] = acc
 		}
-		if err := s.createTransaction(ctx, txn); err != nil {
-			return fmt.Errorf("create transaction: %w", err)
+	}
+
+	batch.BalanceDeltas = make(map[AccountID]int64, len(accountIDs))
+	for _, b := range balances {
+		acc, ok := accounts[b.accountID]
+		if !ok {
+			return fmt.Errorf("account %s: %w", b.accountID, ErrAccountNotFound)
+		}
+		impact := acc.BalanceImpact(b.amount, b.txnType)
+		if b.revert {
+			impact = -impact
 		}
+		batch.BalanceDeltas[b.accountID] += impact
+	}
+	for _, accountID := range accountIDs {
+		delta := batch.BalanceDeltas[accountID]
+		if delta == 0 {
+			delete(batch.BalanceDeltas, accountID)
+			continue
+		}
+		old := accounts[accountID].CurrentBalance
+		result.BalanceChanges = append(result.BalanceChanges, &AccountBalanceChange{
+			AccountID:  accountID,
+			OldBalance: old,
+			NewBalance: old + delta,
+		})
 	}
-	return nil
-}
 
-func (s *Service) deleteTransactionByBorrowingID(ctx context.Context, spaceID SpaceID, borrowingID string) error {
-	bID := borrowingID
-	page, err := s.deps.TransactionStore.ListBySpace(ctx, spaceID, &TransactionFilter{
-		BorrowingID: &bID,
-		PageSize:    50,
-	})
-	if err != nil {
+	slices.Sort(periodIDs)
+	result.PeriodIDs = slices.Compact(periodIDs)
+
+	if validateOnly || (len(batch.Updated) == 0 && len(batch.Deleted) == 0) {
+		return nil
+	}
+	if err := s.deps.TransactionStore.ApplyBatch(ctx, batch); err != nil {
 		return err
 	}
-	for _, txn := range page.Items {
-		if err := s.deleteTransaction(ctx, txn); err != nil {
-			return fmt.Errorf("delete borrowing transaction %s: %w", txn.ID, err)
-		}
+	for _, txn := range batch.Updated {
+		s.notifyTransactionChanged(ctx, txn)
 	}
 	return nil
 }
 
-// CreateBorrowing creates a new borrowing record and syncs a transaction.
-func (s *Service) CreateBorrowing(ctx context.Context, b *Borrowing, createAsTransaction bool) (*Borrowing, error) {
-	if b.ID == "" {
-		bID, err := NewBorrowingID()
-		if err != nil {
-			return nil, err
-		}
-		b.ID = bID
+// cloneSplits copies split lines so they can be resolved without touching the originals.
+func cloneSplits(splits []*TransactionSplit) []*TransactionSplit {
+	if splits == nil {
+		return nil
 	}
-	b.RemainingAmount = b.TotalAmount
-	b.Status = BorrowingStatusActive
-	b.CreateTime = time.Now().UTC()
-	b.UpdateTime = time.Now().UTC()
+	out := make([]*TransactionSplit, len(splits))
+	for i, split := range splits {
+		c := *split
+		out[i] = &c
+	}
+	return out
+}
 
-	if err := b.Validate(); err != nil {
-		return nil, err
+// ExportLedger writes the space's accounts, budgets, transactions and transfers within the query's
+// date range to w in the requested format. It returns the number of transactions written.
+func (s *Service) ExportLedger(ctx context.Context, q *LedgerQuery, w io.Writer) (int64, error) {
+	if err := q.Validate(); err != nil {
+		return 0, err
 	}
 
-	settings, err := s.deps.SettingsStore.GetByID(ctx, b.SpaceID)
+	settings, err := s.deps.SettingsStore.GetByID(ctx, q.SpaceID)
 	if err != nil {
-		return nil, err
+		return 0, err
+	}
+	accounts, err := s.listLedgerAccounts(ctx, q.SpaceID)
+	if err != nil {
+		return 0, err
+	}
+	budgets, err := s.listLedgerBudgets(ctx, q.SpaceID)
+	if err != nil {
+		return 0, err
 	}
 
-	if createAsTransaction && b.Currency != settings.BaseCurrency {
-		_, err := s.getExchangeRate(ctx, ExchangeRateKey{
-			SpaceID:      b.SpaceID,
-			FromCurrency: b.Currency,
-			ToCurrency:   settings.BaseCurrency,
-			RateDate:     b.EstablishedAt,
-		})
+	ledger := NewLedger(settings.BaseCurrency, q.StartDate, q.EndDate, accounts, budgets)
+	lw, err := newLedgerWriter(q.Format, w, ledger)
+	if err != nil {
+		return 0, err
+	}
+	if err := lw.Begin(); err != nil {
+		return 0, err
+	}
+
+	start, end := q.StartDate, q.rangeEnd()
+	filter := &TransactionFilter{
+		StartDate: &start,
+		EndDate:   &end,
+		PageSize:  100,
+		Sort:      sorting.SortOrder{Field: "transaction_date", Ascending: true},
+	}
+	// Both legs of a transfer reference the same record; a nil entry marks one that no longer exists.
+	transfers := make(map[TransferID]*Transfer)
+	var count int64
+	for {
+		page, err := s.deps.TransactionStore.ListBySpace(ctx, q.SpaceID, filter)
 		if err != nil {
-			return nil, fmt.Errorf("exchange rate not configured from %s to %s for date %s: %w", b.Currency, settings.BaseCurrency, b.EstablishedAt.Format("2006-01-02"), err)
+			return count, err
 		}
+		for _, txn := range page.Items {
+			entry := &LedgerEntry{Transaction: txn}
+			if transferID := txn.Metadata.TransferID; transferID != nil {
+				transfer, ok := transfers[*transferID]
+				if !ok {
+					transfer, err = s.deps.TransferStore.GetByID(ctx, q.SpaceID, *transferID)
+					if err != nil && !errors.Is(err, ErrTransferNotFound) {
+						return count, err
+					}
+					transfers[*transferID] = transfer
+				}
+				entry.Transfer = transfer
+			}
+			if err := lw.Write(entry); err != nil {
+				return count, err
+			}
+			count++
+		}
+		if page.NextPageToken == "" {
+			break
+		}
+		filter.NextPageToken = page.NextPageToken
 	}
 
-	if err := s.deps.BorrowingStore.Create(ctx, b); err != nil {
-		return nil, err
+	return count, lw.Close()
+}
+
+func (s *Service) listLedgerAccounts(ctx context.Context, spaceID SpaceID) ([]*Account, error) {
+	filter := &ListAccountsFilter{PageSize: 100}
+	var accounts []*Account
+	for {
+		page, err := s.deps.AccountStore.ListBySpace(ctx, spaceID, filter)
+		if err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, page.Items...)
+		if page.NextPageToken == "" {
+			return accounts, nil
+		}
+		filter.NextPageToken = page.NextPageToken
 	}
+}
 
-	if createAsTransaction {
-		// Sync transaction
-		var txnType TransactionType
-		var desc string
-		if b.Direction == BorrowingDirectionLent {
-			txnType = TransactionTypeExpense
-			desc = fmt.Sprintf("Lent to %s", b.Counterparty)
-		} else {
-			txnType = TransactionTypeIncome
-			desc = fmt.Sprintf("Borrowed from %s", b.Counterparty)
+func (s *Service) listLedgerBudgets(ctx context.Context, spaceID SpaceID) ([]*Budget, error) {
+	filter := &ListBudgetsFilter{PageSize: 100}
+	var budgets []*Budget
+	for {
+		page, err := s.deps.BudgetStore.ListBySpace(ctx, spaceID, filter)
+		if err != nil {
+			return nil, err
+		}
+		budgets = append(budgets, page.Items...)
+		if page.NextPageToken == "" {
+			return budgets, nil
 		}
+		filter.NextPageToken = page.NextPageToken
+	}
+}
 
-		err = s.syncTransaction(ctx, syncTransactionParams{
-			SpaceID:         b.SpaceID,
-			BorrowingID:     string(b.ID),
-			Amount:          b.TotalAmount,
-			Currency:        b.Currency,
-			TransactionDate: b.EstablishedAt,
-			Description:     desc,
-			Type:            txnType,
-			AccountID:       b.AccountID,
-		})
+// CreateLedgerExport records a pending ledger export. The file is produced later by RunLedgerExport.
+func (s *Service) CreateLedgerExport(ctx context.Context, e *LedgerExport) (*LedgerExport, error) {
+	if string(e.ID) == "" {
+		eID, err := NewLedgerExportID()
 		if err != nil {
 			return nil, err
 		}
+		e.ID = eID
 	}
+	e.Status = LedgerExportStatusPending
+	e.Error = ""
+	e.SizeBytes = 0
+	e.EntryCount = 0
+	e.CompleteTime = nil
+	e.CreateTime = time.Now().UTC()
+	e.UpdateTime = e.CreateTime
 
-	return b, nil
+	if err := e.Validate(); err != nil {
+		return nil, err
+	}
+	e.FileName = e.Format.FileName(e.StartDate, e.EndDate)
+	e.ContentType = e.Format.ContentType()
+
+	if err := s.deps.LedgerExportStore.Create(ctx, e); err != nil {
+		return nil, err
+	}
+	return e, nil
 }
 
-// GetBorrowing retrieves a borrowing record.
-func (s *Service) GetBorrowing(ctx context.Context, spaceID SpaceID, id BorrowingID) (*Borrowing, error) {
+// GetLedgerExport retrieves a ledger export by ID for a space.
+func (s *Service) GetLedgerExport(ctx context.Context, spaceID SpaceID, id LedgerExportID) (*LedgerExport, error) {
 	if err := spaceID.Validate(); err != nil {
-		return nil, err
+		return nil, fmt.Errorf("validate space ID: %w", err)
 	}
 	if err := id.Validate(); err != nil {
-		return nil, err
+		return nil, fmt.Errorf("validate ledger export ID: %w", err)
 	}
-	return s.deps.BorrowingStore.GetByID(ctx, spaceID, id)
+	return s.deps.LedgerExportStore.GetByID(ctx, spaceID, id)
 }
 
-// ListBorrowings lists borrowing records with filters.
-func (s *Service) ListBorrowings(ctx context.Context, spaceID SpaceID, filter *ListBorrowingsFilter) ([]*Borrowing, string, error) {
+// ListLedgerExports lists ledger exports for a workspace.
+func (s *Service) ListLedgerExports(ctx context.Context, spaceID SpaceID, filter *ListLedgerExportsFilter) (*paging.Page[*LedgerExport], error) {
 	if err := spaceID.Validate(); err != nil {
-		return nil, "", err
+		return nil, fmt.Errorf("validate space ID: %w", err)
 	}
-	return s.deps.BorrowingStore.ListBySpace(ctx, spaceID, filter)
+	return s.deps.LedgerExportStore.ListBySpace(ctx, spaceID, filter)
 }
 
-// UpdateBorrowing updates a borrowing record and its associated transaction.
-func (s *Service) UpdateBorrowing(ctx context.Context, b *Borrowing) (*Borrowing, error) {
-	existing, err := s.deps.BorrowingStore.GetByID(ctx, b.SpaceID, b.ID)
+// RunLedgerExport produces the file of a pending ledger export and stores it for download.
+// Completed exports are left untouched so that retried jobs do not regenerate them. When generation
+// fails the export is marked FAILED and the error is returned so the job can be retried.
+func (s *Service) RunLedgerExport(ctx context.Context, spaceID SpaceID, id LedgerExportID) (*LedgerExport, error) {
+	export, err := s.GetLedgerExport(ctx, spaceID, id)
 	if err != nil {
 		return nil, err
 	}
-
-	// Keep internal fields
-	b.RemainingAmount = existing.RemainingAmount
-	b.Status = existing.Status
-	b.CreateTime = existing.CreateTime
-	b.UpdateTime = time.Now().UTC()
-
-	if err := b.Validate(); err != nil {
-		return nil, err
+	if export.Status == LedgerExportStatusCompleted {
+		return export, nil
 	}
 
-	settings, err := s.deps.SettingsStore.GetByID(ctx, b.SpaceID)
-	if err != nil {
+	export.Status = LedgerExportStatusRunning
+	export.Error = ""
+	export.UpdateTime = time.Now().UTC()
+	if err := s.deps.LedgerExportStore.Update(ctx, export); err != nil {
 		return nil, err
 	}
 
-	// Check if a transaction already exists for this borrowing
-	bID := string(b.ID)
-	page, err := s.deps.TransactionStore.ListBySpace(ctx, b.SpaceID, &TransactionFilter{
-		BorrowingID: &bID,
-		PageSize:    1,
-	})
-	if err != nil {
-		return nil, fmt.Errorf("check existing transaction: %w", err)
+	var buf bytes.Buffer
+	count, err := s.ExportLedger(ctx, export.Query(), &buf)
+	if err == nil {
+		err = s.deps.LedgerExportStore.SaveContent(ctx, spaceID, id, buf.Bytes())
 	}
-	existingTxs := page.Items
-	hasTransaction := len(existingTxs) > 0
 
-	if hasTransaction && b.Currency != settings.BaseCurrency {
-		_, err := s.getExchangeRate(ctx, ExchangeRateKey{
-			SpaceID:      b.SpaceID,
-			FromCurrency: b.Currency,
-			ToCurrency:   settings.BaseCurrency,
-			RateDate:     b.EstablishedAt,
-		})
-		if err != nil {
-			return nil, fmt.Errorf("exchange rate not configured from %s to %s for date %s: %w", b.Currency, settings.BaseCurrency, b.EstablishedAt.Format("2006-01-02"), err)
+	now := time.Now().UTC()
+	export.UpdateTime = now
+	if err != nil {
+		export.Status = LedgerExportStatusFailed
+		export.Error = err.Error()
+		if uerr := s.deps.LedgerExportStore.Update(ctx, export); uerr != nil {
+			return nil, errors.Join(err, uerr)
 		}
+		return export, err
 	}
 
-	if err := s.deps.BorrowingStore.Update(ctx, b); err != nil {
+	export.Status = LedgerExportStatusCompleted
+	export.SizeBytes = int64(buf.Len())
+	export.EntryCount = count
+	export.CompleteTime = &now
+	if err := s.deps.LedgerExportStore.Update(ctx, export); err != nil {
 		return nil, err
 	}
-
-	if hasTransaction {
-		// Update associated transaction
-		var txnType TransactionType
-		var desc string
-		if b.Direction == BorrowingDirectionLent {
-			txnType = TransactionTypeExpense
-			desc = fmt.Sprintf("Lent to %s", b.Counterparty)
-		} else {
-			txnType = TransactionTypeIncome
-			desc = fmt.Sprintf("Borrowed from %s", b.Counterparty)
-		}
-
-		err = s.syncTransaction(ctx, syncTransactionParams{
-			SpaceID:         b.SpaceID,
-			BorrowingID:     string(b.ID),
-			Amount:          b.TotalAmount,
-			Currency:        b.Currency,
-			TransactionDate: b.EstablishedAt,
-			Description:     desc,
-			Type:            txnType,
-			AccountID:       b.AccountID,
-		})
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	return b, nil
+	return export, nil
 }
 
-// DeleteBorrowing removes a borrowing, its repayments, and their transactions.
-func (s *Service) DeleteBorrowing(ctx context.Context, spaceID SpaceID, id BorrowingID) error {
-	b, err := s.deps.BorrowingStore.GetByID(ctx, spaceID, id)
+// GetLedgerExportContent returns a completed ledger export together with its file content.
+func (s *Service) GetLedgerExportContent(ctx context.Context, spaceID SpaceID, id LedgerExportID) (*LedgerExport, []byte, error) {
+	export, err := s.GetLedgerExport(ctx, spaceID, id)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
-
-	if b.SpaceID != spaceID {
-		return errors.New("borrowing does not belong to space")
+	if export.Status != LedgerExportStatusCompleted {
+		return nil, nil, ErrLedgerExportNotReady
 	}
-
-	// 1. Delete associated transactions for borrowing
-	_ = s.deleteTransactionByBorrowingID(ctx, spaceID, string(id))
-
-	// 2. Delete borrowing agreement from DB
-	return s.deps.BorrowingStore.Delete(ctx, id)
-}
-
-// CreateBorrowingRepayment logs an installment repayment towards a borrowing.
-func (s *Service) CreateBorrowingRepayment(ctx context.Context, r *BorrowingRepayment) (*BorrowingRepayment, error) {
-	b, err := s.deps.BorrowingStore.GetByID(ctx, r.SpaceID, r.BorrowingID)
+	content, err := s.deps.LedgerExportStore.GetContent(ctx, spaceID, id)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
+	return export, content, nil
+}
 
-	if r.SpaceID != b.SpaceID {
-		return nil, errors.New("repayment space ID does not match borrowing space ID")
+// GetSpentInsights computes aggregated outflow analytics and trends for a space.
+func (s *Service) GetSpentInsights(ctx context.Context, req *GetSpentInsightsRequest) (*SpentInsights, error) {
+	if err := req.SpaceID.Validate(); err != nil {
+		return nil, fmt.Errorf("validate space ID: %w", err)
 	}
 
-	if r.Amount <= 0 {
-		return nil, errors.New("repayment amount must be greater than zero")
+	settings, err := s.deps.SettingsStore.GetByID(ctx, req.SpaceID)
+	if err != nil {
+		return nil, fmt.Errorf("verify workspace settings: %w", err)
 	}
 
-	if r.Amount > b.RemainingAmount {
-		return nil, fmt.Errorf("repayment amount %d exceeds remaining borrowing balance %d", r.Amount, b.RemainingAmount)
+	g, err := ParseGranularity(req.Granularity)
+	if err != nil {
+		return nil, fmt.Errorf("invalid granularity: %w", err)
 	}
 
-	if r.ID == "" {
-		rID, err := NewBorrowingRepaymentID()
-		if err != nil {
-			return nil, err
+	start := req.StartDate
+	if start.IsZero() {
+		switch g {
+		case GranularityDaily:
+			start = time.Now().UTC().AddDate(0, 0, -30)
+		case GranularityWeekly:
+			start = time.Now().UTC().AddDate(0, 0, -84) // 12 weeks
+		case GranularityMonthly:
+			start = time.Now().UTC().AddDate(-1, 0, 0) // 12 months
+		case GranularityYearly:
+			start = time.Now().UTC().AddDate(-5, 0, 0) // 5 years
 		}
-		r.ID = rID
 	}
-	r.CreateTime = time.Now().UTC()
-	r.UpdateTime = time.Now().UTC()
+	end := req.EndDate
+	if end.IsZero() {
+		end = time.Now().UTC()
+	}
 
-	settings, err := s.deps.SettingsStore.GetByID(ctx, r.SpaceID)
+	// Fetch trend, distributions, and top expenses from storage
+	trendRows, err := s.deps.InsightsStore.GetSpentTrend(ctx, &SpentTrendFilter{
+		SpaceID:     req.SpaceID,
+		Granularity: g,
+		StartDate:   start,
+		EndDate:     end,
+	})
 	if err != nil {
-		return nil, fmt.Errorf("verify workspace settings: %w", err)
+		return nil, fmt.Errorf("fetch spent trend: %w", err)
 	}
 
-	// 1. Calculate amount in workspace base currency
-	amountInBase := r.Amount
-	if b.Currency != settings.BaseCurrency {
-		rateRecord, err := s.getExchangeRate(ctx, ExchangeRateKey{
-			SpaceID:      r.SpaceID,
-			FromCurrency: b.Currency,
-			ToCurrency:   settings.BaseCurrency,
-			RateDate:     r.PaymentDate,
-		})
-		if err != nil {
-			return nil, fmt.Errorf("exchange rate not configured from %s to %s for date %s: %w", b.Currency, settings.BaseCurrency, r.PaymentDate.Format("2006-01-02"), err)
-		}
-		amountInBase = int64(float64(r.Amount) * rateRecord.Rate)
+	distRows, err := s.deps.InsightsStore.GetBudgetDistribution(ctx, &BudgetDistributionFilter{
+		SpaceID:   req.SpaceID,
+		StartDate: start,
+		EndDate:   end,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("fetch budget distributions: %w", err)
 	}
 
-	// 2. Calculate account impact amount (in payment account's currency)
-	accountImpactAmount := r.Amount
-	if r.AccountID != nil && *r.AccountID != "" {
-		acc, err := s.deps.AccountStore.GetByID(ctx, r.SpaceID, *r.AccountID)
-		if err != nil {
-			return nil, fmt.Errorf("fetch payment account: %w", err)
-		}
-		if acc.Currency != b.Currency {
-			rateRecord, err := s.getExchangeRate(ctx, ExchangeRateKey{
-				SpaceID:      r.SpaceID,
-				FromCurrency: b.Currency,
-				ToCurrency:   acc.Currency,
-				RateDate:     r.PaymentDate,
-			})
-			if err != nil {
-				return nil, fmt.Errorf("exchange rate not configured from %s to %s for date %s: %w", b.Currency, acc.Currency, r.PaymentDate.Format("2006-01-02"), err)
-			}
-			accountImpactAmount = int64(float64(r.Amount) * rateRecord.Rate)
-		}
+	topRows, err := s.deps.InsightsStore.GetTopExpenses(ctx, &TopExpensesFilter{
+		SpaceID:   req.SpaceID,
+		StartDate: start,
+		EndDate:   end,
+		Limit:     5,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("fetch top expenses: %w", err)
 	}
 
-	// 3. Update borrowing balance (in borrowing currency)
-	b.RemainingAmount -= r.Amount
-	if b.RemainingAmount == 0 {
-		b.Status = BorrowingStatusPaidOff
+	categoryRows, err := s.deps.InsightsStore.GetCategoryDistribution(ctx, &CategoryDistributionFilter{
+		SpaceID:   req.SpaceID,
+		StartDate: start,
+		EndDate:   end,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("fetch category distributions: %w", err)
 	}
-	b.UpdateTime = time.Now().UTC()
-	if err := s.deps.BorrowingStore.Update(ctx, b); err != nil {
-		return nil, fmt.Errorf("failed to update borrowing balance: %w", err)
+
+	tagRows, err := s.deps.InsightsStore.GetTagDistribution(ctx, &TagDistributionFilter{
+		SpaceID:   req.SpaceID,
+		StartDate: start,
+		EndDate:   end,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("fetch tag distributions: %w", err)
 	}
 
-	// 4. Create transaction for repayment with metadata
-	var txnType TransactionType
-	var desc string
-	if b.Direction == BorrowingDirectionLent {
-		txnType = TransactionTypeIncome // paid back to us
-		desc = fmt.Sprintf("Repayment from %s", b.Counterparty)
-	} else {
-		txnType = TransactionTypeExpense // we paid them back
-		desc = fmt.Sprintf("Repayment to %s", b.Counterparty)
-	}
-	if r.Notes != "" {
-		desc = r.Notes
+	cashFlowRows, err := s.deps.InsightsStore.GetCashFlowTrend(ctx, &CashFlowTrendFilter{
+		SpaceID:     req.SpaceID,
+		Granularity: g,
+		StartDate:   start,
+		EndDate:     end,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("fetch cash flow trend: %w", err)
 	}
 
-	txnID, err := NewTransactionID()
+	goals, err := s.listActiveGoalProgress(ctx, req.SpaceID, time.Now().UTC())
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("fetch goal progress: %w", err)
 	}
 
-	txn := &Transaction{
-		ID:              txnID,
-		SpaceID:         r.SpaceID,
-		Type:            txnType,
-		AccountID:       r.AccountID,
-		Amount:          r.Amount,
-		Currency:        b.Currency,
-		AmountInBase:    amountInBase,
-		Description:     desc,
-		TransactionDate: r.PaymentDate,
-		EffectiveDate:   time.Now().UTC(),
-		Metadata: TransactionMetadata{
-			BorrowingID:         &b.ID,
-			BorrowingRole:       "REPAYMENT",
-			BorrowingAmount:     r.Amount,
-			AccountImpactAmount: accountImpactAmount,
-			Notes:               r.Notes,
-		},
-		CreateTime: time.Now().UTC(),
-		UpdateTime: time.Now().UTC(),
+	// 1. Group raw trend rows by interval_start
+	trendPoints := make([]*TrendDataPoint, 0)
+	var currentPoint *TrendDataPoint
+	var lastStart time.Time
+
+	for _, row := range trendRows {
+		if currentPoint == nil || !row.IntervalStart.Equal(lastStart) {
+			currentPoint = &TrendDataPoint{
+				Label:     trendLabel(g, row.IntervalStart),
+				StartDate: row.IntervalStart.Format(time.RFC3339),
+			}
+			trendPoints = append(trendPoints, currentPoint)
+			lastStart = row.IntervalStart
+		}
+
+		currentPoint.AmountInBase += row.SpentInBase
+		currentPoint.TransactionCount += row.TxnCount
+
+		if row.BudgetID != "" {
+			currentPoint.Contributions = append(currentPoint.Contributions, &BudgetContribution{
+				BudgetID:      row.BudgetID,
+				BudgetName:    row.BudgetName,
+				BudgetColor:   row.BudgetColor,
+				AmountInBase:  row.SpentInBase,
+				AmountInLocal: row.SpentInLocal,
+				LocalCurrency: row.BudgetCurrency,
+			})
+		} else {
+			currentPoint.Contributions = append(currentPoint.Contributions, &BudgetContribution{
+				BudgetID:      "unbudgeted",
+				BudgetName:    "Unbudgeted",
+				BudgetColor:   "#94a3b8",
+				AmountInBase:  row.SpentInBase,
+				AmountInLocal: row.SpentInLocal,
+				LocalCurrency: string(settings.BaseCurrency),
+			})
+		}
 	}
 
-	if err := s.deps.TransactionStore.Create(ctx, txn); err != nil {
-		return nil, fmt.Errorf("create repayment transaction: %w", err)
+	// Calculate contribution percentages
+	for _, pt := range trendPoints {
+		if pt.AmountInBase > 0 {
+			for _, c := range pt.Contributions {
+				c.ContributionPercentage = (float64(c.AmountInBase) / float64(pt.AmountInBase)) * 100.0
+			}
+		}
 	}
 
-	// 5. Adjust account balance using converted account impact amount
-	if r.AccountID != nil && *r.AccountID != "" {
-		if err := s.adjustAccountBalance(ctx, r.SpaceID, *r.AccountID, accountImpactAmount, txnType, false); err != nil {
-			return nil, fmt.Errorf("adjust account balance for repayment: %w", err)
+	var unbudgetedSpentInBase int64
+	for _, row := range trendRows {
+		if row.BudgetID == "" {
+			unbudgetedSpentInBase += row.SpentInBase
 		}
 	}
 
-	r.ID = BorrowingRepaymentID(txn.ID)
-	return r, nil
-}
+	// 2. Map budget distributions
+	var totalSpent int64
+	var totalLimit int64
+	distributions := make([]*BudgetUsage, 0, len(distRows)+1)
 
-// ListBorrowingRepayments returns repayments for a borrowing.
-func (s *Service) ListBorrowingRepayments(ctx context.Context, spaceID SpaceID, borrowingID BorrowingID) ([]*BorrowingRepayment, error) {
-	if err := spaceID.Validate(); err != nil {
-		return nil, err
-	}
-	if err := borrowingID.Validate(); err != nil {
-		return nil, err
-	}
-	bIDStr := string(borrowingID)
-	page, err := s.deps.TransactionStore.ListBySpace(ctx, spaceID, &TransactionFilter{
-		BorrowingID: &bIDStr,
-		PageSize:    100,
-	})
-	if err != nil {
-		return nil, err
-	}
+	for _, r := range distRows {
+		totalSpent += r.SpentInBase
 
-	var result []*BorrowingRepayment
-	for _, t := range page.Items {
-		if t.Metadata.BorrowingRole != "REPAYMENT" {
-			continue
-		}
-		notes := t.Metadata.Notes
-		if notes == "" {
-			notes = t.Description
+		// Convert budget limit to base currency using the period's exchange rate
+		limitInBase := ConvertAmount(r.BudgetLimit, Currency(r.BudgetCurrency), settings.BaseCurrency, r.ExchangeRateToBase)
+		totalLimit += limitInBase
+
+		usagePct := 0.0
+		if r.BudgetLimit > 0 {
+			usagePct = (float64(r.SpentInLocalMatching) / float64(r.BudgetLimit)) * 100.0
 		}
 
-		result = append(result, &BorrowingRepayment{
-			ID:          BorrowingRepaymentID(t.ID),
-			BorrowingID: borrowingID,
-			SpaceID:     spaceID,
-			Amount:      t.Amount,
-			PaymentDate: t.TransactionDate,
-			Notes:       notes,
-			AccountID:   t.AccountID,
-			CreateTime:  t.CreateTime,
-			UpdateTime:  t.UpdateTime,
+		distributions = append(distributions, &BudgetUsage{
+			BudgetID:        r.BudgetID,
+			BudgetName:      r.BudgetName,
+			BudgetColor:     r.BudgetColor,
+			BudgetIcon:      r.BudgetIcon,
+			Limit:           r.BudgetLimit,
+			Spent:           r.SpentInLocalMatching,
+			SpentInBase:     r.SpentInBase,
+			UsagePercentage: usagePct,
 		})
 	}
 
-	return result, nil
-}
+	if unbudgetedSpentInBase > 0 {
+		totalSpent += unbudgetedSpentInBase
+		distributions = append(distributions, &BudgetUsage{
+			BudgetID:        "unbudgeted",
+			BudgetName:      "Unbudgeted",
+			BudgetColor:     "#94a3b8",
+			BudgetIcon:      "Coins",
+			Limit:           0,
+			Spent:           unbudgetedSpentInBase,
+			SpentInBase:     unbudgetedSpentInBase,
+			UsagePercentage: 0.0,
+		})
+	}
 
-// DeleteBorrowingRepaymentRequest represents parameters to delete a repayment installment.
-type DeleteBorrowingRepaymentRequest struct {
-	SpaceID     SpaceID
-	BorrowingID BorrowingID
-	ID          BorrowingRepaymentID
-}
+	// 3. Overall calculation stats
+	remaining := totalLimit - totalSpent
+	burnRate := 0.0
+	days := end.Sub(start).Hours() / 24.0
+	if days > 0 {
+		burnRate = float64(totalSpent) / days
+	}
 
-// DeleteBorrowingRepayment deletes a repayment installment, restoring account and borrowing balance.
-func (s *Service) DeleteBorrowingRepayment(ctx context.Context, req DeleteBorrowingRepaymentRequest) error {
-	b, err := s.deps.BorrowingStore.GetByID(ctx, req.SpaceID, req.BorrowingID)
-	if err != nil {
-		return err
+	// 4. Map top expenses
+	topExpenses := make([]*HighValueExpense, 0, len(topRows))
+	for _, r := range topRows {
+		topExpenses = append(topExpenses, &HighValueExpense{
+			TransactionID:   r.TransactionID,
+			Description:     r.Description,
+			Amount:          r.Amount,
+			Currency:        r.Currency,
+			AmountInBase:    r.AmountInBase,
+			BudgetName:      r.BudgetName,
+			TransactionDate: r.TransactionDate,
+			EffectiveDate:   r.EffectiveDate,
+		})
 	}
 
-	if b.SpaceID != req.SpaceID {
-		return errors.New("borrowing does not belong to space")
+	// 5. Net cash flow (income against all expenses, budgeted or not)
+	cashFlow := &CashFlowInsights{
+		Trend: make([]*CashFlowDataPoint, 0, len(cashFlowRows)),
+	}
+	for _, r := range cashFlowRows {
+		cashFlow.TotalIncome += r.IncomeInBase
+		cashFlow.TotalExpense += r.ExpenseInBase
+		cashFlow.CardSpending += r.CardSpending
+		cashFlow.CardPayments += r.CardPayments
+		cashFlow.Trend = append(cashFlow.Trend, &CashFlowDataPoint{
+			Label:         trendLabel(g, r.IntervalStart),
+			StartDate:     r.IntervalStart.Format(time.RFC3339),
+			IncomeInBase:  r.IncomeInBase,
+			ExpenseInBase: r.ExpenseInBase,
+			NetInBase:     r.IncomeInBase - r.ExpenseInBase,
+			CardSpending:  r.CardSpending,
+			CardPayments:  r.CardPayments,
+		})
+	}
+	cashFlow.NetCashFlow = cashFlow.TotalIncome - cashFlow.TotalExpense
+	if cashFlow.TotalIncome > 0 {
+		cashFlow.SavingsRate = (float64(cashFlow.NetCashFlow) / float64(cashFlow.TotalIncome)) * 100.0
 	}
 
-	txn, err := s.deps.TransactionStore.GetByID(ctx, req.SpaceID, TransactionID(req.ID))
-	if err != nil {
-		return fmt.Errorf("repayment transaction not found: %w", err)
+	// 6. Category and tag distributions, shared against all expenses in the window
+	var categorizedTotal int64
+	for _, r := range categoryRows {
+		categorizedTotal += r.SpentInBase
+	}
+	sharePct := func(amount int64) float64 {
+		if categorizedTotal <= 0 {
+			return 0.0
+		}
+		return (float64(amount) / float64(categorizedTotal)) * 100.0
+	}
+
+	categories := make([]*CategoryUsage, 0, len(categoryRows))
+	for _, r := range categoryRows {
+		usage := &CategoryUsage{
+			CategoryID:       r.CategoryID,
+			ParentID:         r.ParentID,
+			CategoryName:     r.CategoryName,
+			CategoryColor:    r.CategoryColor,
+			CategoryIcon:     r.CategoryIcon,
+			TransactionCount: r.TxnCount,
+			SpentInBase:      r.SpentInBase,
+			SharePercentage:  sharePct(r.SpentInBase),
+		}
+		if r.CategoryID == "" {
+			usage.CategoryID = "uncategorized"
+			usage.CategoryName = "Uncategorized"
+			usage.CategoryColor = "#94a3b8"
+			usage.CategoryIcon = "Tag"
+		}
+		categories = append(categories, usage)
 	}
 
-	return s.deleteTransaction(ctx, txn)
-}
+	tags := make([]*TagUsage, 0, len(tagRows))
+	for _, r := range tagRows {
+		tags = append(tags, &TagUsage{
+			Tag:              r.Tag,
+			TransactionCount: r.TxnCount,
+			SpentInBase:      r.SpentInBase,
+			SharePercentage:  sharePct(r.SpentInBase),
+		})
+	}
 
-// CurrencyInfo represents basic currency details.
-type CurrencyInfo struct {
-	Code string
-	Name string
+	return &SpentInsights{
+		TotalLimit:      totalLimit,
+		TotalSpent:      totalSpent,
+		RemainingBudget: remaining,
+		BurnRate:        burnRate,
+		Trend:           trendPoints,
+		Distributions:   distributions,
+		TopExpenses:     topExpenses,
+		CashFlow:        cashFlow,
+		Categories:      categories,
+		Tags:            tags,
+		Goals:           goals,
+	}, nil
 }
 
-// ListCurrencies returns the list of supported currencies.
-func (s *Service) ListCurrencies(ctx context.Context) ([]CurrencyInfo, error) {
-	return []CurrencyInfo{
-		{Code: "USD", Name: "US Dollar"},
-		{Code: "EUR", Name: "Euro"},
-		{Code: "GBP", Name: "British Pound"},
-		{Code: "CAD", Name: "Canadian Dollar"},
-		{Code: "JPY", Name: "Japanese Yen"},
-		{Code: "DOP", Name: "Dominican Peso"},
-	}, nil
+// trendLabel renders the human readable label of a trend interval for the given granularity.
+func trendLabel(g Granularity, intervalStart time.Time) string {
+	switch g {
+	case GranularityDaily:
+		return intervalStart.Format("02 Jan")
+	case GranularityWeekly:
+		_, w := intervalStart.ISOWeek()
+		return fmt.Sprintf("Wk %d", w)
+	case GranularityMonthly:
+		return intervalStart.Format("Jan 06")
+	case GranularityYearly:
+		return intervalStart.Format("2006")
+	}
+	return ""
 }
 
-// CreateAccount creates a new account.
-func (s *Service) CreateAccount(ctx context.Context, a *Account) (*Account, error) {
-	if a.ID == "" {
-		aID, err := NewAccountID()
+// CreateRecurringExpense configures a new recurring expense rule.
+func (s *Service) CreateRecurringExpense(ctx context.Context, re *RecurringExpense) (*RecurringExpense, error) {
+	if re.ID == "" {
+		id, err := NewRecurringExpenseID()
 		if err != nil {
 			return nil, err
 		}
-		a.ID = aID
+		re.ID = id
 	}
-	a.CreateTime = time.Now().UTC()
-	a.UpdateTime = time.Now().UTC()
-	a.IsActive = true
 
-	if err := a.Validate(); err != nil {
-		return nil, err
-	}
+	re.Status = RecurringExpenseActive
+	re.CreateTime = time.Now().UTC()
+	re.UpdateTime = time.Now().UTC()
 
-	// Check if first account in space
-	hasAny, err := s.deps.AccountStore.HasAny(ctx, a.SpaceID)
-	if err != nil {
+	if err := re.NormalizeRecurrence(); err != nil {
 		return nil, err
 	}
-	if !hasAny {
-		a.IsDefault = true
-	} else if a.IsDefault {
-		// Unset all other defaults space-wide atomically in the DB
-		if err := s.deps.AccountStore.UnsetDefaultsExcept(ctx, a.SpaceID, a.ID); err != nil {
-			return nil, err
-		}
-	}
-	if err := s.deps.AccountStore.Create(ctx, a); err != nil {
+	if err := re.Validate(); err != nil {
 		return nil, err
 	}
 
-	return a, nil
+	if err := s.deps.RecurringExpenseStore.Create(ctx, re); err != nil {
+		return nil, err
+	}
+	return re, nil
 }
 
-// GetAccount retrieves an account.
-func (s *Service) GetAccount(ctx context.Context, spaceID SpaceID, id AccountID) (*Account, error) {
+// GetRecurringExpense retrieves a recurring expense by ID for a space.
+func (s *Service) GetRecurringExpense(ctx context.Context, spaceID SpaceID, id RecurringExpenseID) (*RecurringExpense, error) {
 	if err := spaceID.Validate(); err != nil {
 		return nil, err
 	}
 	if err := id.Validate(); err != nil {
 		return nil, err
 	}
-	return s.deps.AccountStore.GetByID(ctx, spaceID, id)
+	return s.deps.RecurringExpenseStore.GetByID(ctx, spaceID, id)
 }
 
-// GetAccounts retrieves a list of accounts by their identifiers for a space.
-func (s *Service) GetAccounts(ctx context.Context, spaceID SpaceID, ids []AccountID) ([]*Account, error) {
+// GetRecurringExpenses retrieves a batch of recurring expenses by their IDs for a space.
+func (s *Service) GetRecurringExpenses(ctx context.Context, spaceID SpaceID, ids []RecurringExpenseID) ([]*RecurringExpense, error) {
 	if err := spaceID.Validate(); err != nil {
 		return nil, err
 	}
-	return s.deps.AccountStore.GetByIDs(ctx, spaceID, ids)
+	for _, id := range ids {
+		if err := id.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	return s.deps.RecurringExpenseStore.GetByIDs(ctx, spaceID, ids)
 }
 
-// UpdateAccount updates account metadata and handles default flag adjustments.
-func (s *Service) UpdateAccount(ctx context.Context, a *Account) (*Account, error) {
-	existing, err := s.deps.AccountStore.GetByID(ctx, a.SpaceID, a.ID)
+// UpdateRecurringExpense updates an existing recurring expense template.
+func (s *Service) UpdateRecurringExpense(ctx context.Context, re *RecurringExpense) (*RecurringExpense, error) {
+	existing, err := s.deps.RecurringExpenseStore.GetByID(ctx, re.SpaceID, re.ID)
 	if err != nil {
 		return nil, err
 	}
 
-	// Preserve space identity and internal balances if updated ad-hoc
-	a.SpaceID = existing.SpaceID
-	a.Type = existing.Type
-	a.Currency = existing.Currency
-	a.InitialBalance = existing.InitialBalance
-	a.CurrentBalance = existing.CurrentBalance
-	a.CreateTime = existing.CreateTime
-	a.UpdateTime = time.Now().UTC()
+	re.CreateTime = existing.CreateTime
+	re.UpdateTime = time.Now().UTC()
+	if re.StartDate.IsZero() {
+		re.StartDate = existing.StartDate
+	}
 
-	if err := a.Validate(); err != nil {
+	if err := re.NormalizeRecurrence(); err != nil {
 		return nil, err
 	}
-
-	if a.IsDefault && !existing.IsDefault {
-		// Unset all other defaults space-wide atomically in the DB
-		if err := s.deps.AccountStore.UnsetDefaultsExcept(ctx, a.SpaceID, a.ID); err != nil {
-			return nil, err
-		}
-	} else if !a.IsDefault && existing.IsDefault {
-		// Override and force it to remain default
-		a.IsDefault = true
+	if err := re.Validate(); err != nil {
+		return nil, err
 	}
-	if err := s.deps.AccountStore.Update(ctx, a); err != nil {
+
+	if err := s.deps.RecurringExpenseStore.Update(ctx, re); err != nil {
 		return nil, err
 	}
+	return re, nil
+}
 
-	return a, nil
+// DeleteRecurringExpense deletes a recurring expense rule.
+func (s *Service) DeleteRecurringExpense(ctx context.Context, id RecurringExpenseID) error {
+	if err := id.Validate(); err != nil {
+		return err
+	}
+	return s.deps.RecurringExpenseStore.Delete(ctx, id)
 }
 
-// AdjustAccountBalance reconciles an account's live balance to a target balance by logging a system reconciliation transaction.
-func (s *Service) AdjustAccountBalance(ctx context.Context, spaceID SpaceID, accountID AccountID, targetBalance int64, adjustmentDate string, note string) (*Account, error) {
+// ListRecurringExpenses lists recurring expenses for a workspace.
+func (s *Service) ListRecurringExpenses(ctx context.Context, spaceID SpaceID, filter *ListRecurringExpensesFilter) (*paging.Page[*RecurringExpense], error) {
 	if err := spaceID.Validate(); err != nil {
 		return nil, err
 	}
-	if err := accountID.Validate(); err != nil {
-		return nil, err
-	}
+	return s.deps.RecurringExpenseStore.ListBySpace(ctx, spaceID, filter)
+}
 
-	acc, err := s.deps.AccountStore.GetByID(ctx, spaceID, accountID)
-	if err != nil {
-		return nil, fmt.Errorf("fetch target account: %w", err)
+// DetectSubscriptions scans the space's recent expenses for periodic charges that no recurring
+// expense tracks yet. Vendors already named by an active or paused template are left out.
+func (s *Service) DetectSubscriptions(ctx context.Context, req *DetectSubscriptionsRequest) ([]*SubscriptionSuggestion, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
 	}
 
-	delta := targetBalance - acc.CurrentBalance
-	if delta == 0 {
-		return acc, nil
+	expense := TransactionTypeExpense
+	since := req.Now.AddDate(0, 0, -int(req.LookbackDays))
+	filter := &TransactionFilter{
+		Type:      &expense,
+		StartDate: &since,
+		EndDate:   &req.Now,
+		PageSize:  100,
+		Sort:      sorting.SortOrder{Field: "transaction_date", Ascending: true},
 	}
-
-	txnID, err := NewTransactionID()
-	if err != nil {
-		return nil, err
+	var txns []*Transaction
+	for {
+		page, err := s.deps.TransactionStore.ListBySpace(ctx, req.SpaceID, filter)
+		if err != nil {
+			return nil, fmt.Errorf("list expenses: %w", err)
+		}
+		txns = append(txns, page.Items...)
+		if page.NextPageToken == "" {
+			break
+		}
+		filter.NextPageToken = page.NextPageToken
 	}
 
-	parsedDate := time.Now().UTC()
-	if adjustmentDate != "" {
-		if t, parseErr := time.Parse(time.RFC3339, adjustmentDate); parseErr == nil {
-			parsedDate = t
-		} else if t, parseErr := time.Parse("2006-01-02", adjustmentDate); parseErr == nil {
-			parsedDate = t
+	tracked := make(map[string]bool)
+	reFilter := &ListRecurringExpensesFilter{PageSize: 100}
+	for {
+		page, err := s.deps.RecurringExpenseStore.ListBySpace(ctx, req.SpaceID, reFilter)
+		if err != nil {
+			return nil, fmt.Errorf("list recurring expenses: %w", err)
+		}
+		for _, re := range page.Items {
+			if re.Status != RecurringExpenseEnded {
+				tracked[vendorKey(re.Name)+"|"+string(re.Currency)] = true
+			}
+		}
+		if page.NextPageToken == "" {
+			break
 		}
+		reFilter.NextPageToken = page.NextPageToken
 	}
 
-	description := "Balance Adjustment"
-	if note != "" {
-		description += " (" + note + ")"
-	}
+	suggestions := DetectSubscriptions(txns, req.Now)
+	return slices.DeleteFunc(suggestions, func(sg *SubscriptionSuggestion) bool {
+		return tracked[vendorKey(sg.RecurringExpense.Name)+"|"+string(sg.RecurringExpense.Currency)]
+	}), nil
+}
 
-	accIDVal := accountID
-	txn := &Transaction{
-		ID:              txnID,
-		SpaceID:         spaceID,
-		AccountID:       &accIDVal,
-		Type:            TransactionTypeBalanceAdjustment,
-		Amount:          delta,
-		Currency:        acc.Currency,
-		Description:     description,
-		TransactionDate: parsedDate,
-		EffectiveDate:   parsedDate,
+// ConvertToRecurringExpense creates a recurring expense from a subscription suggestion and links the
+// supporting transactions to it. Transactions must be expenses not linked to another template.
+func (s *Service) ConvertToRecurringExpense(ctx context.Context, req *ConvertToRecurringExpenseRequest) (*RecurringExpense, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
 	}
+	spaceID := req.RecurringExpense.SpaceID
 
-	if err := s.createTransaction(ctx, txn); err != nil {
-		return nil, fmt.Errorf("record balance adjustment transaction: %w", err)
+	txns := make([]*Transaction, 0, len(req.TransactionIDs))
+	seen := make(map[TransactionID]bool)
+	for _, txnID := range req.TransactionIDs {
+		if seen[txnID] {
+			continue
+		}
+		seen[txnID] = true
+		txn, err := s.deps.TransactionStore.GetByID(ctx, spaceID, txnID)
+		if err != nil {
+			return nil, err
+		}
+		if txn.Type != TransactionTypeExpense {
+			return nil, fmt.Errorf("transaction %s is not an expense", txnID)
+		}
+		if txn.Metadata.RecurringExpenseID != nil {
+			return nil, ErrCannotRelinkTransactionToDifferentRecurringExpense
+		}
+		txns = append(txns, txn)
 	}
 
-	return s.deps.AccountStore.GetByID(ctx, spaceID, accountID)
-}
-
-// DeleteAccount deletes an account and moves default status if necessary.
-func (s *Service) DeleteAccount(ctx context.Context, spaceID SpaceID, id AccountID) error {
-	existing, err := s.deps.AccountStore.GetByID(ctx, spaceID, id)
+	re, err := s.CreateRecurringExpense(ctx, req.RecurringExpense)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	if existing.IsDefault {
-		return ErrCannotDeleteDefaultAccount
+	now := time.Now().UTC()
+	for _, txn := range txns {
+		txn.Metadata.RecurringExpenseID = &re.ID
+		txn.UpdateTime = now
+		if err := s.deps.TransactionStore.Update(ctx, txn); err != nil {
+			return nil, fmt.Errorf("link transaction to recurring expense: %w", err)
+		}
+		s.notifyTransactionChanged(ctx, txn)
 	}
-
-	return s.deps.AccountStore.Delete(ctx, id)
+	return re, nil
 }
 
-// ListAccounts lists all accounts for a space.
-func (s *Service) ListAccounts(ctx context.Context, spaceID SpaceID, filter *ListAccountsFilter) (*paging.Page[*Account], error) {
+// ListScheduledPayments lists scheduled payments for a workspace.
+func (s *Service) ListScheduledPayments(ctx context.Context, spaceID SpaceID, filter *ListScheduledPaymentsFilter) (*paging.Page[*ScheduledPayment], error) {
 	if err := spaceID.Validate(); err != nil {
 		return nil, err
 	}
-	return s.deps.AccountStore.ListBySpace(ctx, spaceID, filter)
+	return s.deps.ScheduledPaymentStore.ListBySpace(ctx, spaceID, filter)
 }
 
-// GetLatestRates retrieves the latest exchange rates for the given fromCurrencies to the target currency.
-func (s *Service) GetLatestRates(ctx context.Context, spaceID SpaceID, fromCurrencies []Currency, toCurrency Currency) ([]*ExchangeRate, error) {
-	if err := spaceID.Validate(); err != nil {
-		return nil, err
-	}
-	return s.deps.ExchangeRateStore.GetLatestRates(ctx, spaceID, fromCurrencies, toCurrency)
+// ConfirmScheduledPaymentRequest represents parameters to confirm a scheduled payment.
+type ConfirmScheduledPaymentRequest struct {
+	SpaceID         SpaceID
+	PaymentID       ScheduledPaymentID
+	TransactionDate time.Time
+	EffectiveDate   time.Time
+	ActualAmount    int64
+	Description     string
+	AccountID       *AccountID
+	BudgetID        *BudgetID
+	Currency        *Currency
 }
 
-// CreateTransfer logs a fund movement between accounts.
-func (s *Service) CreateTransfer(ctx context.Context, t *Transfer) (*Transfer, error) {
-	if t.ID == "" {
-		tID, err := NewTransferID()
-		if err != nil {
-			return nil, err
-		}
-		t.ID = tID
-	}
-	t.CreateTime = time.Now().UTC()
-	t.UpdateTime = time.Now().UTC()
-
-	if err := t.Validate(); err != nil {
+// ConfirmScheduledPayment clears a scheduled payment by promoting it to a permanent transaction.
+func (s *Service) ConfirmScheduledPayment(ctx context.Context, req ConfirmScheduledPaymentRequest) (*Transaction, error) {
+	payment, err := s.deps.ScheduledPaymentStore.GetByID(ctx, req.SpaceID, req.PaymentID)
+	if err != nil {
 		return nil, err
 	}
 
-	// Fetch both accounts to verify existence and check currencies
-	srcAcc, err := s.deps.AccountStore.GetByID(ctx, t.SpaceID, t.SourceAccountID)
-	if err != nil {
-		return nil, fmt.Errorf("source account: %w", err)
+	switch payment.SourceType {
+	case SourceTypeLoan:
+		return s.confirmLoanInstallment(ctx, payment, req)
+	case SourceTypeCardStatement:
+		return s.confirmCardStatementPayment(ctx, payment, req)
 	}
-	destAcc, err := s.deps.AccountStore.GetByID(ctx, t.SpaceID, t.DestinationAccountID)
+
+	settings, err := s.deps.SettingsStore.GetByID(ctx, payment.SpaceID)
 	if err != nil {
-		return nil, fmt.Errorf("destination account: %w", err)
+		return nil, err
 	}
 
-	if srcAcc.SpaceID != t.SpaceID || destAcc.SpaceID != t.SpaceID {
-		return nil, errors.New("accounts do not belong to the same space as the transfer")
+	budgetID := payment.BudgetID
+	if req.BudgetID != nil && *req.BudgetID != "" {
+		budgetID = *req.BudgetID
 	}
 
-	// Double-entry validation: same currency transfers must have matching source and destination amounts
-	if srcAcc.Currency == destAcc.Currency && t.SourceAmount != t.DestinationAmount {
-		return nil, errors.New("source and destination amounts must match for single-currency transfers")
+	currency := payment.Currency
+	if req.Currency != nil && *req.Currency != "" {
+		currency = *req.Currency
 	}
 
-	// 1. Insert Transfer parent record
-	if err := s.deps.TransferStore.Create(ctx, t); err != nil {
+	budget, err := s.deps.BudgetStore.GetByID(ctx, payment.SpaceID, budgetID)
+	if err != nil {
 		return nil, err
 	}
 
-	// 2. Create the Outflow Transaction Leg
-	outflowTxnID, err := NewTransactionID()
-	if err != nil {
-		return nil, err
+	actualAmount := req.ActualAmount
+	if actualAmount <= 0 {
+		actualAmount = payment.Amount
 	}
-	outflowTxn := &Transaction{
-		ID:              outflowTxnID,
-		SpaceID:         t.SpaceID,
-		Type:            TransactionTypeTransferOut,
-		Amount:          t.SourceAmount,
-		Currency:        srcAcc.Currency,
-		Description:     fmt.Sprintf("Transfer to %s", destAcc.Name),
-		TransactionDate: t.TransferDate,
-		EffectiveDate:   t.TransferDate,
-		AccountID:       &t.SourceAccountID,
-		Metadata: TransactionMetadata{
-			TransferID:           &t.ID,
-			CounterpartAccountID: &t.DestinationAccountID,
-			Notes:                t.Notes,
-		},
-		CreateTime: t.CreateTime,
-		UpdateTime: t.UpdateTime,
+
+	txnDate := req.TransactionDate
+	if txnDate.IsZero() {
+		txnDate = time.Now().UTC()
 	}
-	if err := s.createTransaction(ctx, outflowTxn); err != nil {
-		return nil, fmt.Errorf("failed to log transfer outflow leg: %w", err)
+
+	effDate := req.EffectiveDate
+	if effDate.IsZero() {
+		effDate = txnDate
 	}
 
-	// 3. Create the Inflow Transaction Leg
-	inflowTxnID, err := NewTransactionID()
+	// Resolve budget period for the transaction based on effectiveDate
+	period, err := s.GetOrCreatePeriod(ctx, payment.SpaceID, budget.ID, effDate)
 	if err != nil {
 		return nil, err
 	}
-	inflowTxn := &Transaction{
-		ID:              inflowTxnID,
-		SpaceID:         t.SpaceID,
-		Type:            TransactionTypeTransferIn,
-		Amount:          t.DestinationAmount,
-		Currency:        destAcc.Currency,
-		Description:     fmt.Sprintf("Transfer from %s", srcAcc.Name),
-		TransactionDate: t.TransferDate,
-		EffectiveDate:   t.TransferDate,
-		AccountID:       &t.DestinationAccountID,
-		Metadata: TransactionMetadata{
-			TransferID:           &t.ID,
-			CounterpartAccountID: &t.SourceAccountID,
-			Notes:                t.Notes,
-		},
-		CreateTime: t.CreateTime,
-		UpdateTime: t.UpdateTime,
-	}
-	if err := s.createTransaction(ctx, inflowTxn); err != nil {
-		return nil, fmt.Errorf("failed to log transfer inflow leg: %w", err)
-	}
-
-	return t, nil
-}
 
-// GetTransfer retrieves a transfer for a space.
-func (s *Service) GetTransfer(ctx context.Context, spaceID SpaceID, id TransferID) (*Transfer, error) {
-	if err := spaceID.Validate(); err != nil {
-		return nil, err
-	}
-	if err := id.Validate(); err != nil {
-		return nil, err
+	// Calculate base currency conversion
+	rate := 1.0
+	if currency != settings.BaseCurrency {
+		rateRecord, err := s.getExchangeRate(ctx, ExchangeRateKey{
+			SpaceID:      payment.SpaceID,
+			FromCurrency: currency,
+			ToCurrency:   settings.BaseCurrency,
+			RateDate:     txnDate,
+		})
+		if err != nil {
+			return nil, err
+		}
+		rate = rateRecord.Rate
 	}
-	return s.deps.TransferStore.GetByID(ctx, spaceID, id)
-}
 
-// DeleteTransfer deletes a transfer parent and deletes both linked ledger entries.
-func (s *Service) DeleteTransfer(ctx context.Context, spaceID SpaceID, id TransferID) error {
-	t, err := s.deps.TransferStore.GetByID(ctx, spaceID, id)
-	if err != nil {
-		return err
-	}
+	amountInBase := ConvertAmount(actualAmount, currency, settings.BaseCurrency, rate)
 
-	// Find the associated transaction legs using TransferID
-	page, err := s.deps.TransactionStore.ListBySpace(ctx, t.SpaceID, &TransactionFilter{
-		TransferID: &id,
-		PageSize:   10,
-	})
-	if err != nil {
-		return fmt.Errorf("failed to retrieve transfer transaction legs: %w", err)
+	description := ""
+	if req.Description != "" {
+		description = req.Description
+	} else if len(payment.Metadata) > 0 {
+		var meta struct {
+			Description string `json:"description"`
+		}
+		if err := json.Unmarshal(payment.Metadata, &meta); err == nil && meta.Description != "" {
+			description = meta.Description
+		}
 	}
-	legs := page.Items
 
-	// Delete both transaction legs
-	for _, leg := range legs {
-		if err := s.deleteTransaction(ctx, leg); err != nil {
-			return fmt.Errorf("failed to delete transfer leg transaction: %w", err)
+	if description == "" && payment.SourceType == SourceTypeRecurrentExpense {
+		if exp, err := s.deps.RecurringExpenseStore.GetByID(ctx, payment.SpaceID, RecurringExpenseID(payment.SourceID)); err == nil {
+			description = exp.Name
+		}
+	} else if description == "" && payment.SourceType == "invoice" {
+		if item, err := s.deps.InboxItemStore.Get(ctx, payment.SpaceID, payment.SourceID); err == nil {
+			if item.VendorName != "" {
+				description = item.VendorName
+			}
 		}
 	}
 
-	// Delete parent transfer record
-	return s.deps.TransferStore.Delete(ctx, id)
-}
-
-// ListTransfers lists transfer records inside a space.
-func (s *Service) ListTransfers(ctx context.Context, spaceID SpaceID, limit int32, pageToken string) ([]*Transfer, string, error) {
-	if err := spaceID.Validate(); err != nil {
-		return nil, "", err
+	if description == "" {
+		description = "Scheduled Payment"
 	}
-	return s.deps.TransferStore.ListBySpace(ctx, spaceID, limit, pageToken)
-}
 
-// LogTransactionEvent inserts a new lifecycle event for a transaction.
-func (s *Service) LogTransactionEvent(ctx context.Context, e *TransactionEvent) (*TransactionEvent, error) {
-	if e.ID == "" {
-		id, err := NewTransactionEventID()
-		if err != nil {
-			return nil, err
-		}
-		e.ID = id
-	}
-	if e.CreateTime.IsZero() {
-		e.CreateTime = time.Now().UTC()
-	}
-	if err := e.Validate(); err != nil {
-		return nil, err
-	}
-	if err := s.deps.TransactionEventStore.Create(ctx, e); err != nil {
+	tID, err := NewTransactionID()
+	if err != nil {
 		return nil, err
 	}
-	return e, nil
-}
 
-// ListTransactionEvents retrieves all lifecycle events for a specific transaction in a space.
-func (s *Service) ListTransactionEvents(ctx context.Context, spaceID SpaceID, txnID TransactionID) ([]*TransactionEvent, error) {
-	if err := spaceID.Validate(); err != nil {
-		return nil, fmt.Errorf("validate space ID: %w", err)
-	}
-	if err := txnID.Validate(); err != nil {
-		return nil, fmt.Errorf("validate transaction ID: %w", err)
+	reID := RecurringExpenseID(payment.SourceID)
+	txn := &Transaction{
+		ID:              tID,
+		SpaceID:         payment.SpaceID,
+		AccountID:       req.AccountID,
+		Type:            TransactionTypeExpense,
+		BudgetID:        &budgetID,
+		PeriodID:        &period.ID,
+		Amount:          actualAmount,
+		Currency:        currency,
+		AmountInBase:    amountInBase,
+		Description:     description,
+		TransactionDate: txnDate,
+		EffectiveDate:   effDate,
+		Metadata: TransactionMetadata{
+			ScheduledPaymentID: &payment.ID,
+			RecurringExpenseID: &reID,
+		},
+		CreateTime: time.Now().UTC(),
+		UpdateTime: time.Now().UTC(),
 	}
-	return s.deps.TransactionEventStore.ListByTransaction(ctx, spaceID, txnID)
-}
 
-// StageInboxItem parses extraction suggestions and inserts a new draft entry into the inbox queue.
-func (s *Service) StageInboxItem(ctx context.Context, spaceID SpaceID, req *StageInboxItem) (*InboxItem, error) {
-	if err := spaceID.Validate(); err != nil {
+	if err := txn.Validate(); err != nil {
 		return nil, err
 	}
 
-	// 1. Generate unique inbox item ID using "ibx_" prefix
-	ibxID, err := id.Generate("ibx_")
-	if err != nil {
-		return nil, fmt.Errorf("generate inbox item ID: %w", err)
+	if err := s.deps.TransactionStore.Create(ctx, txn); err != nil {
+		return nil, err
 	}
+	s.notifyTransactionChanged(ctx, txn)
 
-	// 2. Resolve target account ID (prioritizing explicit AccountID over CardLastFour fallback with currency priority)
-	var accountID *string
 	if req.AccountID != nil && *req.AccountID != "" {
-		accountID = req.AccountID
-	} else if req.CardLastFour != "" {
-		activeOnly := true
-		last4 := req.CardLastFour
-		page, err := s.deps.AccountStore.ListBySpace(ctx, spaceID, &ListAccountsFilter{
-			PageSize:   100,
-			ActiveOnly: &activeOnly,
-			LastFour:   &last4,
-		})
-		if err == nil && len(page.Items) > 0 {
-			var selected *Account
-			// Priority 1: Match currency exact
-			for _, acc := range page.Items {
-				if string(acc.Currency) == req.Currency {
-					selected = acc
-					break
-				}
-			}
-			// Priority 2: Fallback to first matching account
-			if selected == nil {
-				selected = page.Items[0]
-			}
-			accIDStr := string(selected.ID)
-			accountID = &accIDStr
-		}
-	}
-
-	// 3. Resolve and validate matching budget ID
-	var budgetID *string
-	if req.SuggestedBudget != "" {
-		bID, err := ParseBudgetID(req.SuggestedBudget)
-		if err == nil {
-			budget, err := s.deps.BudgetStore.GetByID(ctx, spaceID, bID)
-			if err == nil && budget != nil && budget.SpaceID == spaceID {
-				bIDStr := string(budget.ID)
-				budgetID = &bIDStr
-			}
+		if err := s.adjustAccountBalance(ctx, payment.SpaceID, *req.AccountID, actualAmount, TransactionTypeExpense, false); err != nil {
+			return nil, fmt.Errorf("failed to adjust account balance: %w", err)
 		}
 	}
 
-	// 4. Parse transaction timestamp
-	txDate := time.Now().UTC()
-	if req.Date != "" {
-		if t, err := time.Parse(time.RFC3339, req.Date); err == nil {
-			txDate = t.UTC()
-		}
+	// Log the historical scheduled event with the deferred creation date
+	_, err = s.LogTransactionEvent(ctx, &TransactionEvent{
+		SpaceID:       payment.SpaceID,
+		TransactionID: txn.ID,
+		EventType:     "EXPENSE_SCHEDULED",
+		CreateTime:    payment.CreateTime,
+		Metadata:      map[string]any{"scheduled_payment_id": string(payment.ID)},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to log scheduled event: %w", err)
 	}
 
-	// 5. Create and insert InboxItem
-	item := &InboxItem{
-		ID:              ibxID,
-		SpaceID:         string(spaceID),
-		IntegrationID:   req.IntegrationID,
-		Status:          InboxItemPending,
-		DocType:         req.DocType,
-		Amount:          req.Amount,
-		Currency:        req.Currency,
-		VendorName:      req.Vendor,
-		TransactionDate: txDate,
-		AccountID:       accountID,
-		BudgetID:        budgetID,
-		RawPayload:      req.RawPayload,
-		Metadata:        req.Metadata,
-		CreateTime:      time.Now().UTC(),
+	// Log the actual payment confirmation event with the transaction date
+	_, err = s.LogTransactionEvent(ctx, &TransactionEvent{
+		SpaceID:       payment.SpaceID,
+		TransactionID: txn.ID,
+		EventType:     "BANK_CONFIRM_RECEIVED",
+		CreateTime:    txnDate,
+		Metadata:      map[string]any{"actual_amount": actualAmount},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to log payment confirmation event: %w", err)
 	}
 
-	if err := s.deps.InboxItemStore.Insert(ctx, item); err != nil {
-		return nil, fmt.Errorf("insert inbox item: %w", err)
+	// Mark scheduled payment as paid
+	payment.Status = ScheduledPaymentPaid
+	payment.UpdateTime = time.Now().UTC()
+	if err := s.deps.ScheduledPaymentStore.Update(ctx, payment); err != nil {
+		return nil, fmt.Errorf("failed to update scheduled payment status: %w", err)
 	}
 
-	return item, nil
+	return txn, nil
 }
 
-// ListInboxItems lists all pending/staged items in the space inbox.
-func (s *Service) ListInboxItems(ctx context.Context, spaceID SpaceID, filter *ListInboxItemsFilter) (*paging.Page[*InboxItem], error) {
-	if err := spaceID.Validate(); err != nil {
-		return nil, err
-	}
-	return s.deps.InboxItemStore.ListBySpace(ctx, spaceID, filter)
+// MatchScheduledPaymentRequest represents parameters to link an existing transaction to a scheduled payment.
+type MatchScheduledPaymentRequest struct {
+	SpaceID       SpaceID
+	PaymentID     ScheduledPaymentID
+	TransactionID TransactionID
 }
 
-// UpdateInboxItem updates a staging inbox item's draft properties.
-func (s *Service) UpdateInboxItem(ctx context.Context, spaceID SpaceID, item *InboxItem) (*InboxItem, error) {
-	if err := spaceID.Validate(); err != nil {
-		return nil, err
-	}
-	if item.ID == "" {
-		return nil, errors.New("missing inbox item ID")
-	}
-	existing, err := s.deps.InboxItemStore.Get(ctx, spaceID, item.ID)
+// MatchScheduledPayment links an existing transaction with a pending scheduled payment, marking the payment cleared.
+func (s *Service) MatchScheduledPayment(ctx context.Context, req MatchScheduledPaymentRequest) (*Transaction, error) {
+	payment, err := s.deps.ScheduledPaymentStore.GetByID(ctx, req.SpaceID, req.PaymentID)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("scheduled payment not found: %w", err)
 	}
 
-	item.SpaceID = string(spaceID)
-	if item.Status == "" {
-		item.Status = existing.Status
-	}
-	if item.DocType == "" || item.DocType == InboxItemDocUnknown {
-		item.DocType = existing.DocType
-	}
-	if item.Currency == "" {
-		item.Currency = existing.Currency
+	txn, err := s.deps.TransactionStore.GetByID(ctx, req.SpaceID, req.TransactionID)
+	if err != nil {
+		return nil, fmt.Errorf("transaction not found: %w", err)
 	}
-	if item.Amount == 0 {
-		item.Amount = existing.Amount
+
+	if txn.SpaceID != payment.SpaceID {
+		return nil, errors.New("transaction and scheduled payment belong to different spaces")
 	}
-	if item.VendorName == "" {
-		item.VendorName = existing.VendorName
-	}
-	if item.AccountID == nil {
-		item.AccountID = existing.AccountID
-	}
-	if item.BudgetID == nil {
-		item.BudgetID = existing.BudgetID
-	}
-	if item.ScheduledPaymentID == nil {
-		item.ScheduledPaymentID = existing.ScheduledPaymentID
-	}
-	if item.TransactionID == nil {
-		item.TransactionID = existing.TransactionID
+
+	// Update transaction link properties in metadata
+	reID := RecurringExpenseID(payment.SourceID)
+	txn.Metadata.ScheduledPaymentID = &payment.ID
+	txn.Metadata.RecurringExpenseID = &reID
+	txn.UpdateTime = time.Now().UTC()
+
+	if err := s.deps.TransactionStore.Update(ctx, txn); err != nil {
+		return nil, fmt.Errorf("failed to link transaction: %w", err)
 	}
-	if item.BorrowingID == nil {
-		item.BorrowingID = existing.BorrowingID
+	s.notifyTransactionChanged(ctx, txn)
+
+	// Log event
+	_, err = s.LogTransactionEvent(ctx, &TransactionEvent{
+		SpaceID:       payment.SpaceID,
+		TransactionID: txn.ID,
+		EventType:     "SCHEDULED_PAYMENT_LINKED",
+		CreateTime:    time.Now().UTC(),
+		Metadata:      map[string]any{"scheduled_payment_id": string(payment.ID)},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to log match event: %w", err)
 	}
 
-	// Persist changes
-	if err := s.deps.InboxItemStore.Update(ctx, item); err != nil {
-		return nil, err
+	// Mark scheduled payment as paid
+	payment.Status = ScheduledPaymentPaid
+	payment.UpdateTime = time.Now().UTC()
+	if err := s.deps.ScheduledPaymentStore.Update(ctx, payment); err != nil {
+		return nil, fmt.Errorf("failed to update scheduled payment status: %w", err)
 	}
 
-	return item, nil
+	return txn, nil
 }
 
-// DiscardInboxItem deletes an item from the inbox without ledger changes.
-func (s *Service) DiscardInboxItem(ctx context.Context, spaceID SpaceID, id string) error {
+// GetScheduledPayment retrieves a scheduled payment by ID for a space.
+func (s *Service) GetScheduledPayment(ctx context.Context, spaceID SpaceID, id ScheduledPaymentID) (*ScheduledPayment, error) {
 	if err := spaceID.Validate(); err != nil {
-		return err
+		return nil, fmt.Errorf("validate space ID: %w", err)
 	}
-	return s.deps.InboxItemStore.Delete(ctx, spaceID, id)
+	if err := id.Validate(); err != nil {
+		return nil, fmt.Errorf("validate scheduled payment ID: %w", err)
+	}
+	return s.deps.ScheduledPaymentStore.GetByID(ctx, spaceID, id)
 }
 
-// ApproveInboxItem promotes an inbox item to the ledger or updates a scheduled payment, returning the resolved item.
-func (s *Service) ApproveInboxItem(ctx context.Context, spaceID SpaceID, id string) (*InboxItem, error) {
+// SkipScheduledPayment marks a pending scheduled payment as skipped for a cycle.
+func (s *Service) SkipScheduledPayment(ctx context.Context, spaceID SpaceID, id ScheduledPaymentID) (*ScheduledPayment, error) {
 	if err := spaceID.Validate(); err != nil {
 		return nil, err
 	}
+	if err := id.Validate(); err != nil {
+		return nil, err
+	}
 
-	// 1. Fetch current staging inbox item
-	item, err := s.deps.InboxItemStore.Get(ctx, spaceID, id)
+	payment, err := s.deps.ScheduledPaymentStore.GetByID(ctx, spaceID, id)
 	if err != nil {
-		return nil, fmt.Errorf("get inbox item: %w", err)
+		return nil, err
 	}
 
-	if item.Status != InboxItemPending {
-		return nil, fmt.Errorf("inbox item is already processed: status = %s", item.Status)
+	if payment.SpaceID != spaceID {
+		return nil, ErrScheduledPaymentNotFound
 	}
 
-	// 1b. If it is a system verification email: mark resolved immediately
-	if item.DocType == InboxItemDocSystemVerification {
-		item.Status = InboxItemResolved
-		if err := s.deps.InboxItemStore.Update(ctx, item); err != nil {
-			return nil, fmt.Errorf("resolve verification inbox item: %w", err)
-		}
-		return item, nil
+	payment.Status = ScheduledPaymentSkipped
+	if err := s.deps.ScheduledPaymentStore.UpdateStatus(ctx, id, ScheduledPaymentSkipped); err != nil {
+		return nil, fmt.Errorf("update scheduled payment status: %w", err)
 	}
 
-	// 2. If it is linked to an existing transaction:
-	if item.TransactionID != nil && *item.TransactionID != "" {
-		txnID, err := ParseTransactionID(*item.TransactionID)
-		if err != nil {
-			return nil, fmt.Errorf("invalid transaction ID: %w", err)
-		}
-		txn, err := s.deps.TransactionStore.GetByID(ctx, SpaceID(spaceID), txnID)
+	return payment, nil
+}
+
+// GenerateScheduledPayments performs bulk generation of pending scheduled payments for recurring expenses.
+func (s *Service) GenerateScheduledPayments(ctx context.Context) error {
+	// Query templates due in next 10 days
+	maxDueDate := time.Now().AddDate(0, 0, 10)
+	expenses, err := s.deps.RecurringExpenseStore.ListPendingGeneration(ctx, maxDueDate)
+	if err != nil {
+		return err
+	}
+
+	for _, re := range expenses {
+		rc, err := re.Recurrence()
 		if err != nil {
-			return nil, fmt.Errorf("get transaction: %w", err)
+			return fmt.Errorf("recurrence of recurring expense %s: %w", re.ID, err)
 		}
-		if txn.SpaceID != SpaceID(spaceID) {
-			return nil, fmt.Errorf("transaction does not belong to this space")
-		}
-		if txn.Type == TransactionTypeTransferOut || txn.Type == TransactionTypeTransferIn {
-			return nil, ErrCannotLinkReceiptToTransfer
-		}
-
-		overwrite := item.MetadataBool("overwrite_linked_transaction")
 
-		// If overwrite option is selected, update the ledger transaction to match receipt details
-		if overwrite {
-			diff := item.Amount - txn.Amount
-			if diff != 0 && txn.AccountID != nil {
-				if diff > 0 {
-					if err := s.adjustAccountBalance(ctx, SpaceID(spaceID), *txn.AccountID, diff, txn.Type, false); err != nil {
-						return nil, fmt.Errorf("failed to adjust account balance delta: %w", err)
-					}
-				} else {
-					if err := s.adjustAccountBalance(ctx, SpaceID(spaceID), *txn.AccountID, -diff, txn.Type, true); err != nil {
-						return nil, fmt.Errorf("failed to adjust account balance delta: %w", err)
-					}
+		// Generate all scheduled payments up to 10 days in the future, walking the series from the
+		// next due date. Exception dates added after the due date was set are skipped.
+		due := re.NextDueDate
+		for !due.After(maxDueDate) {
+			if !rc.excluded(due) {
+				if err := s.createRecurringPayment(ctx, re, due); err != nil {
+					return err
 				}
 			}
 
-			updatedTxn := *txn
-			updatedTxn.Amount = item.Amount
-			updatedTxn.Currency = Currency(item.Currency)
-			if item.VendorName != "" {
-				updatedTxn.Description = item.VendorName
-			}
-
-			if err := s.deps.TransactionStore.Update(ctx, &updatedTxn); err != nil {
-				return nil, fmt.Errorf("failed to update linked transaction: %w", err)
+			next, ok := rc.After(due)
+			if !ok {
+				re.Status = RecurringExpenseEnded
+				break
 			}
-			txn = &updatedTxn
+			due = next
 		}
+		re.NextDueDate = due
 
-		// Handle optional borrowing link for existing transaction
-		if item.BorrowingID != nil && *item.BorrowingID != "" {
-			linkType := BorrowingLinkTypeInitialReceipt
-			if item.BorrowingLinkType != nil {
-				linkType = *item.BorrowingLinkType
-			}
-			if err := s.handleBorrowingLinkForTransaction(ctx, SpaceID(spaceID), txn, *item.BorrowingID, linkType); err != nil {
-				return nil, fmt.Errorf("link borrowing to existing transaction: %w", err)
-			}
+		re.UpdateTime = time.Now().UTC()
+		if err := s.deps.RecurringExpenseStore.Update(ctx, re); err != nil {
+			return err
 		}
+	}
 
-		// Handle optional scheduled payment link for existing transaction
-		if item.ScheduledPaymentID != nil && *item.ScheduledPaymentID != "" {
-			if err := s.handleScheduledPaymentLinkForTransaction(ctx, SpaceID(spaceID), txn, *item.ScheduledPaymentID); err != nil {
-				return nil, fmt.Errorf("link scheduled payment to existing transaction: %w", err)
-			}
-			item.ScheduledPaymentID = nil
-		}
+	return nil
+}
 
-		// Mark inbox item as resolved and link it
-		item.Status = InboxItemResolved
-		if err := s.deps.InboxItemStore.Update(ctx, item); err != nil {
-			return nil, fmt.Errorf("resolve inbox item: %w", err)
-		}
+// createRecurringPayment creates the scheduled payment of one occurrence of a recurring expense.
+func (s *Service) createRecurringPayment(ctx context.Context, re *RecurringExpense, due time.Time) error {
+	spID, err := NewScheduledPaymentID()
+	if err != nil {
+		return err
+	}
 
-		// Event 1: Receipt/Document Ingested
-		evtIngested := &TransactionEvent{
-			SpaceID:       SpaceID(spaceID),
-			TransactionID: txn.ID,
-			EventType:     "RECEIPT_INGESTED",
-			Metadata: map[string]any{
-				"amount_cents": item.Amount,
-				"currency":     item.Currency,
-				"vendor_name":  item.VendorName,
-				"description":  "Receipt/Document ingested into staging queue",
-			},
-			CreateTime: item.CreateTime,
-		}
-		if _, err := s.LogTransactionEvent(ctx, evtIngested); err != nil {
-			fmt.Printf("warning: failed to log receipt ingested event: %v\n", err)
-		}
+	var dateTag string
+	switch re.Interval {
+	case "monthly":
+		dateTag = due.Format("2006-01")
+	case "yearly":
+		dateTag = due.Format("2006")
+	default:
+		dateTag = due.Format("2006-01-02")
+	}
 
-		// Event 2: Staging item linked to this transaction
-		linkedDesc := "Staged document linked to existing ledger entry"
-		if overwrite {
-			linkedDesc = "Staged document linked to existing ledger entry and updated transaction details"
+	descText := fmt.Sprintf("%s (%s)", re.Name, dateTag)
+	metaMap := map[string]any{
+		"name":        re.Name,
+		"due_date":    due.Format("2006-01-02"),
+		"description": descText,
+	}
+	metaBytes, err := json.Marshal(metaMap)
+	if err != nil {
+		return fmt.Errorf("marshal scheduled payment metadata: %w", err)
+	}
+
+	payment := &ScheduledPayment{
+		ID:         spID,
+		SpaceID:    re.SpaceID,
+		BudgetID:   re.BudgetID,
+		SourceType: SourceTypeRecurrentExpense,
+		SourceID:   string(re.ID),
+		Amount:     re.Amount,
+		Currency:   re.Currency,
+		DueDate:    due,
+		Status:     ScheduledPaymentPending,
+		Metadata:   metaBytes,
+		CreateTime: time.Now().UTC(),
+		UpdateTime: time.Now().UTC(),
+	}
+
+	if err := payment.Validate(); err != nil {
+		return err
+	}
+
+	return s.deps.ScheduledPaymentStore.Create(ctx, payment)
+}
+
+// createTransaction persists a transaction and adjusts the account balance.
+func (s *Service) createTransaction(ctx context.Context, txn *Transaction) error {
+	// 1. Set dates
+	if txn.TransactionDate.IsZero() {
+		txn.TransactionDate = time.Now().UTC()
+	}
+	if txn.EffectiveDate.IsZero() {
+		txn.EffectiveDate = txn.TransactionDate
+	}
+	if txn.CreateTime.IsZero() {
+		txn.CreateTime = time.Now().UTC()
+	}
+	txn.UpdateTime = time.Now().UTC()
+
+	// 2. Fetch workspace settings
+	settings, err := s.deps.SettingsStore.GetByID(ctx, txn.SpaceID)
+	if err != nil {
+		return fmt.Errorf("verify workspace settings: %w", err)
+	}
+	if !settings.IsCurrencyEnabled(txn.Currency) {
+		return fmt.Errorf("%w: %s", ErrCurrencyDisabled, txn.Currency)
+	}
+
+	// 3. Centralized Budget Period Resolution
+	if txn.IsSplit() {
+		if err := s.resolveSplits(ctx, txn, nil); err != nil {
+			return err
 		}
-		evtLinked := &TransactionEvent{
-			SpaceID:       SpaceID(spaceID),
-			TransactionID: txn.ID,
-			EventType:     "TRANSACTION_LINKED",
-			Metadata: map[string]any{
-				"inbox_item_id":                string(item.ID),
-				"overwrite_linked_transaction": overwrite,
-				"description":                  linkedDesc,
-			},
-			CreateTime: time.Now(),
+	} else if txn.BudgetID != nil {
+		budget, err := s.deps.BudgetStore.GetByID(ctx, txn.SpaceID, *txn.BudgetID)
+		if err != nil {
+			return fmt.Errorf("fetch budget template: %w", err)
 		}
-		if _, err := s.LogTransactionEvent(ctx, evtLinked); err != nil {
-			fmt.Printf("warning: failed to log transaction linked event: %v\n", err)
+		period, err := s.GetOrCreatePeriod(ctx, txn.SpaceID, budget.ID, txn.EffectiveDate)
+		if err != nil {
+			return fmt.Errorf("resolve active budget period: %w", err)
 		}
+		txn.PeriodID = &period.ID
+	}
 
-		return item, nil
+	// 4. Centralized Category and Tag Resolution
+	if err := s.resolveClassification(ctx, txn); err != nil {
+		return err
 	}
 
-	// 3. If it is linked to a bill/scheduled payment:
-	if item.ScheduledPaymentID != nil && *item.ScheduledPaymentID != "" {
-		payID := ScheduledPaymentID(*item.ScheduledPaymentID)
-		if item.DocType == InboxItemDocInvoice {
-			// A. If it's an unpaid INVOICE: do NOT create a transaction.
-			// Just update the scheduled payment amount and metadata to the actual values.
-			payment, err := s.deps.ScheduledPaymentStore.GetByID(ctx, SpaceID(spaceID), payID)
-			if err != nil {
-				return nil, fmt.Errorf("get scheduled payment: %w", err)
-			}
-			payment.Amount = item.Amount
-			payment.SourceType = item.VendorName
-			if err := s.deps.ScheduledPaymentStore.Update(ctx, payment); err != nil {
-				return nil, fmt.Errorf("update scheduled payment: %w", err)
+	// 5. Centralized Base Currency Exchange Rate Calculation
+	if txn.AmountInBase == 0 || txn.Currency != settings.BaseCurrency {
+		rate := 1.0
+		if txn.Currency != settings.BaseCurrency {
+			rateRecord, err := s.getExchangeRate(ctx, ExchangeRateKey{
+				SpaceID:      txn.SpaceID,
+				FromCurrency: txn.Currency,
+				ToCurrency:   settings.BaseCurrency,
+				RateDate:     txn.TransactionDate,
+			})
+			if err != nil {
+				return fmt.Errorf("fetch exchange rate from %s to %s for date %s: %w", txn.Currency, settings.BaseCurrency, txn.TransactionDate.Format("2006-01-02"), err)
+			}
+			rate = rateRecord.Rate
+		}
+		txn.AmountInBase = ConvertAmount(txn.Amount, txn.Currency, settings.BaseCurrency, rate)
+	}
+	txn.allocateSplitBase()
+
+	if err := txn.Validate(); err != nil {
+		return err
+	}
+
+	// 6. Persist the transaction
+	if err := s.deps.TransactionStore.Create(ctx, txn); err != nil {
+		return err
+	}
+	s.notifyTransactionChanged(ctx, txn)
+
+	// 7. Adjust account balance
+	if txn.AccountID != nil {
+		if err := s.adjustAccountBalance(ctx, txn.SpaceID, *txn.AccountID, txn.Amount, txn.Type, false); err != nil {
+			return fmt.Errorf("failed to adjust account balance: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// updateTransaction updates a transaction and recalculates account balances.
+func (s *Service) updateTransaction(ctx context.Context, txn *Transaction, existing *Transaction) error {
+	if err := s.resolveTransactionUpdate(ctx, txn, existing, nil); err != nil {
+		return err
+	}
+
+	// 6. Revert the old transaction's balance impact
+	if existing.AccountID != nil {
+		if err := s.adjustAccountBalance(ctx, existing.SpaceID, *existing.AccountID, existing.Amount, existing.Type, true); err != nil {
+			return fmt.Errorf("failed to revert account balance: %w", err)
+		}
+	}
+
+	// 7. Persist the updated transaction
+	if err := s.deps.TransactionStore.Update(ctx, txn); err != nil {
+		return err
+	}
+	s.notifyTransactionChanged(ctx, txn)
+
+	// 8. Apply the new transaction's balance impact
+	if txn.AccountID != nil {
+		if err := s.adjustAccountBalance(ctx, txn.SpaceID, *txn.AccountID, txn.Amount, txn.Type, false); err != nil {
+			return fmt.Errorf("failed to apply updated account balance: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// resolveTransactionUpdate resolves the budget period, classification and base amount of an edited
+// transaction and validates it. Missing budget periods are created, unless a batch is given to collect
+// them (see resolvePeriod).
+func (s *Service) resolveTransactionUpdate(ctx context.Context, txn *Transaction, existing *Transaction, batch *TransactionBatch) error {
+	if existing.Type == TransactionTypeBalanceAdjustment {
+		return errors.New("balance adjustment transactions cannot be edited directly; perform a new balance adjustment or delete this record to revert")
+	}
+
+	// 1. Set dates
+	if txn.EffectiveDate.IsZero() {
+		txn.EffectiveDate = txn.TransactionDate
+	}
+	txn.UpdateTime = time.Now().UTC()
+
+	// 2. Fetch workspace settings
+	settings, err := s.deps.SettingsStore.GetByID(ctx, txn.SpaceID)
+	if err != nil {
+		return fmt.Errorf("verify workspace settings: %w", err)
+	}
+	if txn.Currency != existing.Currency && !settings.IsCurrencyEnabled(txn.Currency) {
+		return fmt.Errorf("%w: %s", ErrCurrencyDisabled, txn.Currency)
+	}
+
+	// 3. Centralized Budget Period Resolution
+	if txn.IsSplit() {
+		if err := s.resolveSplits(ctx, txn, batch); err != nil {
+			return err
+		}
+	} else if txn.BudgetID != nil {
+		budget, err := s.deps.BudgetStore.GetByID(ctx, txn.SpaceID, *txn.BudgetID)
+		if err != nil {
+			return fmt.Errorf("fetch budget template: %w", err)
+		}
+		period, err := s.resolvePeriod(ctx, budget, txn.EffectiveDate, batch)
+		if err != nil {
+			return fmt.Errorf("resolve active budget period: %w", err)
+		}
+		txn.PeriodID = &period.ID
+	} else {
+		txn.PeriodID = nil
+	}
+
+	// 4. Centralized Category and Tag Resolution
+	if err := s.resolveClassification(ctx, txn); err != nil {
+		return err
+	}
+
+	// 5. Centralized Base Currency Exchange Rate Calculation
+	if txn.Currency != settings.BaseCurrency {
+		rateRecord, err := s.getExchangeRate(ctx, ExchangeRateKey{
+			SpaceID:      txn.SpaceID,
+			FromCurrency: txn.Currency,
+			ToCurrency:   settings.BaseCurrency,
+			RateDate:     txn.TransactionDate,
+		})
+		if err != nil {
+			return fmt.Errorf("fetch exchange rate from %s to %s for date %s: %w", txn.Currency, settings.BaseCurrency, txn.TransactionDate.Format("2006-01-02"), err)
+		}
+		txn.AmountInBase = ConvertAmount(txn.Amount, txn.Currency, settings.BaseCurrency, rateRecord.Rate)
+	} else {
+		txn.AmountInBase = txn.Amount
+	}
+	txn.allocateSplitBase()
+
+	return txn.Validate()
+}
+
+// resolveSplits assigns split line IDs and resolves the budget period and category of each split.
+// Split transactions carry their budgets on the split lines, so the transaction-level budget is cleared.
+// Missing budget periods are created, unless a batch is given to collect them (see resolvePeriod).
+func (s *Service) resolveSplits(ctx context.Context, txn *Transaction, batch *TransactionBatch) error {
+	txn.BudgetID = nil
+	txn.PeriodID = nil
+
+	for i, split := range txn.Splits {
+		if split.ID == "" {
+			splitID, err := NewTransactionSplitID()
+			if err != nil {
+				return fmt.Errorf("generate split ID: %w", err)
+			}
+			split.ID = splitID
+		}
+
+		split.PeriodID = nil
+		if split.BudgetID != nil {
+			budget, err := s.deps.BudgetStore.GetByID(ctx, txn.SpaceID, *split.BudgetID)
+			if err != nil {
+				return fmt.Errorf("split %d: fetch budget template: %w", i, err)
+			}
+			period, err := s.resolvePeriod(ctx, budget, txn.EffectiveDate, batch)
+			if err != nil {
+				return fmt.Errorf("split %d: resolve active budget period: %w", i, err)
+			}
+			split.PeriodID = &period.ID
+		}
+
+		if split.CategoryID != nil {
+			if _, err := s.deps.CategoryStore.GetByID(ctx, txn.SpaceID, *split.CategoryID); err != nil {
+				return fmt.Errorf("split %d: fetch category: %w", i, err)
+			}
+		}
+	}
+	return nil
+}
+
+// resolveClassification verifies the transaction category and payee and normalizes its free-form tags.
+func (s *Service) resolveClassification(ctx context.Context, txn *Transaction) error {
+	if txn.CategoryID != nil {
+		if _, err := s.deps.CategoryStore.GetByID(ctx, txn.SpaceID, *txn.CategoryID); err != nil {
+			return fmt.Errorf("fetch category: %w", err)
+		}
+	}
+	if txn.PayeeID != nil {
+		if _, err := s.deps.PayeeStore.GetByID(ctx, txn.SpaceID, *txn.PayeeID); err != nil {
+			return fmt.Errorf("fetch payee: %w", err)
+		}
+	}
+	tags, err := NormalizeTags(txn.Tags)
+	if err != nil {
+		return err
+	}
+	txn.Tags = tags
+	return nil
+}
+
+// deleteTransaction deletes a transaction, reverts its account balance impact, and syncs linked borrowings.
+func (s *Service) deleteTransaction(ctx context.Context, txn *Transaction) error {
+	// 1. Revert the account balance impact using account_impact_amount if present
+	if txn.AccountID != nil && *txn.AccountID != "" {
+		impactAmount := txn.Amount
+		if txn.Metadata.AccountImpactAmount > 0 {
+			impactAmount = txn.Metadata.AccountImpactAmount
+		}
+		if err := s.adjustAccountBalance(ctx, txn.SpaceID, *txn.AccountID, impactAmount, txn.Type, true); err != nil {
+			return fmt.Errorf("failed to revert account balance on deletion: %w", err)
+		}
+	}
+
+	// 2. Revert borrowing remaining balance if linked via metadata
+	if txn.Metadata.BorrowingID != nil && *txn.Metadata.BorrowingID != "" {
+		role := txn.Metadata.BorrowingRole
+		borrowingAmount := txn.Amount
+		if txn.Metadata.BorrowingAmount > 0 {
+			borrowingAmount = txn.Metadata.BorrowingAmount
+		}
+
+		if b, err := s.deps.BorrowingStore.GetByID(ctx, txn.SpaceID, *txn.Metadata.BorrowingID); err == nil {
+			switch role {
+			case "REPAYMENT":
+				b.ApplyRepayment(-borrowingAmount)
+				b.UpdateTime = time.Now().UTC()
+				_ = s.deps.BorrowingStore.Update(ctx, b)
+				if err := s.syncLoanInstallments(ctx, b); err != nil {
+					return fmt.Errorf("sync loan instalments: %w", err)
+				}
+
+			case "INITIAL_FUNDING":
+				b.RemainingAmount = 0
+				b.Status = BorrowingStatusPaidOff
+				b.UpdateTime = time.Now().UTC()
+				_ = s.deps.BorrowingStore.Update(ctx, b)
+			}
+		}
+	}
+
+	// 3. Delete the transaction from persistence
+	return s.deps.TransactionStore.Delete(ctx, txn.ID)
+}
+
+// adjustAccountBalance updates the balance of the specified account based on transaction changes.
+func (s *Service) adjustAccountBalance(ctx context.Context, spaceID SpaceID, accountID AccountID, amount int64, txnType TransactionType, revert bool) error {
+	acc, err := s.deps.AccountStore.GetByID(ctx, spaceID, accountID)
+	if err != nil {
+		return err
+	}
+
+	// Reverse the impact if we are reverting an operation (on update or delete)
+	impact := acc.BalanceImpact(amount, txnType)
+	if revert {
+		impact = -impact
+	}
+	acc.CurrentBalance += impact
+
+	acc.UpdateTime = time.Now().UTC()
+	return s.deps.AccountStore.Update(ctx, acc)
+}
+
+type syncTransactionParams struct {
+	SpaceID         SpaceID
+	BorrowingID     string
+	Amount          int64
+	Currency        Currency
+	TransactionDate time.Time
+	Description     string
+	Type            TransactionType
+	AccountID       *AccountID
+}
+
+// changes reports whether syncing the params into txn would modify it.
+func (p syncTransactionParams) changes(txn *Transaction) bool {
+	return txn.Amount != p.Amount ||
+		txn.Currency != p.Currency ||
+		txn.Description != p.Description ||
+		!txn.TransactionDate.Equal(p.TransactionDate) ||
+		txn.Type != p.Type ||
+		!equalAccountID(txn.AccountID, p.AccountID)
+}
+
+// equalAccountID reports whether two optional account IDs are the same.
+func equalAccountID(a, b *AccountID) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// Helper to create or update associated transaction
+func (s *Service) syncTransaction(ctx context.Context, params syncTransactionParams) error {
+	// Find if transaction already exists
+	bID := params.BorrowingID
+	page, err := s.deps.TransactionStore.ListBySpace(ctx, params.SpaceID, &TransactionFilter{
+		BorrowingID: &bID,
+		PageSize:    1,
+	})
+	if err != nil {
+		return fmt.Errorf("list existing transactions: %w", err)
+	}
+	existingTxs := page.Items
+
+	if len(existingTxs) > 0 {
+		existing := existingTxs[0]
+		if existing.IsReconciled() && params.changes(existing) {
+			return ErrTransactionReconciled
+		}
+		// Clone and modify for update
+		txn := *existing
+		txn.Amount = params.Amount
+		txn.Currency = params.Currency
+		txn.Description = params.Description
+		txn.TransactionDate = params.TransactionDate
+		txn.EffectiveDate = params.TransactionDate
+		txn.Type = params.Type
+		txn.AccountID = params.AccountID
+		txn.UpdateTime = time.Now().UTC()
+
+		if err := s.updateTransaction(ctx, &txn, existing); err != nil {
+			return fmt.Errorf("update transaction: %w", err)
+		}
+	} else {
+		tID, err := NewTransactionID()
+		if err != nil {
+			return err
+		}
+		bID := BorrowingID(params.BorrowingID)
+		txn := &Transaction{
+			ID:              tID,
+			SpaceID:         params.SpaceID,
+			Type:            params.Type,
+			Amount:          params.Amount,
+			Currency:        params.Currency,
+			Description:     params.Description,
+			TransactionDate: params.TransactionDate,
+			EffectiveDate:   params.TransactionDate,
+			AccountID:       params.AccountID,
+			Metadata: TransactionMetadata{
+				BorrowingID:   &bID,
+				BorrowingRole: "INITIAL_FUNDING",
+			},
+			CreateTime: time.Now().UTC(),
+			UpdateTime: time.Now().UTC(),
+		}
+		if err := s.createTransaction(ctx, txn); err != nil {
+			return fmt.Errorf("create transaction: %w", err)
+		}
+	}
+	return nil
+}
+
+func (s *Service) deleteTransactionByBorrowingID(ctx context.Context, spaceID SpaceID, borrowingID string) error {
+	bID := borrowingID
+	page, err := s.deps.TransactionStore.ListBySpace(ctx, spaceID, &TransactionFilter{
+		BorrowingID: &bID,
+		PageSize:    50,
+	})
+	if err != nil {
+		return err
+	}
+	// Check every transaction first so that none is deleted when one is locked
+	for _, txn := range page.Items {
+		if txn.IsReconciled() {
+			return fmt.Errorf("borrowing transaction %s: %w", txn.ID, ErrTransactionReconciled)
+		}
+	}
+	for _, txn := range page.Items {
+		if err := s.deleteTransaction(ctx, txn); err != nil {
+			return fmt.Errorf("delete borrowing transaction %s: %w", txn.ID, err)
+		}
+	}
+	return nil
+}
+
+// CreateBorrowing creates a new borrowing record and syncs a transaction.
+func (s *Service) CreateBorrowing(ctx context.Context, b *Borrowing, createAsTransaction bool) (*Borrowing, error) {
+	if b.ID == "" {
+		bID, err := NewBorrowingID()
+		if err != nil {
+			return nil, err
+		}
+		b.ID = bID
+	}
+	b.RemainingAmount = b.TotalAmount
+	b.PaidAmount = 0
+	b.Status = BorrowingStatusActive
+	b.CreateTime = time.Now().UTC()
+	b.UpdateTime = time.Now().UTC()
+
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+
+	settings, err := s.deps.SettingsStore.GetByID(ctx, b.SpaceID)
+	if err != nil {
+		return nil, err
+	}
+	if !settings.IsCurrencyEnabled(b.Currency) {
+		return nil, fmt.Errorf("%w: %s", ErrCurrencyDisabled, b.Currency)
+	}
+	if b.BudgetID != nil {
+		if _, err := s.deps.BudgetStore.GetByID(ctx, b.SpaceID, *b.BudgetID); err != nil {
+			return nil, err
+		}
+	}
+
+	if createAsTransaction && b.Currency != settings.BaseCurrency {
+		_, err := s.getExchangeRate(ctx, ExchangeRateKey{
+			SpaceID:      b.SpaceID,
+			FromCurrency: b.Currency,
+			ToCurrency:   settings.BaseCurrency,
+			RateDate:     b.EstablishedAt,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("exchange rate not configured from %s to %s for date %s: %w", b.Currency, settings.BaseCurrency, b.EstablishedAt.Format("2006-01-02"), err)
+		}
+	}
+
+	if err := s.deps.BorrowingStore.Create(ctx, b); err != nil {
+		return nil, err
+	}
+
+	if createAsTransaction {
+		// Sync transaction
+		var txnType TransactionType
+		var desc string
+		if b.Direction == BorrowingDirectionLent {
+			txnType = TransactionTypeExpense
+			desc = fmt.Sprintf("Lent to %s", b.Counterparty)
+		} else {
+			txnType = TransactionTypeIncome
+			desc = fmt.Sprintf("Borrowed from %s", b.Counterparty)
+		}
+
+		err = s.syncTransaction(ctx, syncTransactionParams{
+			SpaceID:         b.SpaceID,
+			BorrowingID:     string(b.ID),
+			Amount:          b.TotalAmount,
+			Currency:        b.Currency,
+			TransactionDate: b.EstablishedAt,
+			Description:     desc,
+			Type:            txnType,
+			AccountID:       b.AccountID,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.syncLoanInstallments(ctx, b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// GetBorrowing retrieves a borrowing record, including the state of its instalment plan.
+func (s *Service) GetBorrowing(ctx context.Context, spaceID SpaceID, id BorrowingID) (*Borrowing, error) {
+	if err := spaceID.Validate(); err != nil {
+		return nil, err
+	}
+	if err := id.Validate(); err != nil {
+		return nil, err
+	}
+	b, err := s.deps.BorrowingStore.GetByID(ctx, spaceID, id)
+	if err != nil {
+		return nil, err
+	}
+	b.Progress = b.ProgressAt(time.Now().UTC())
+	return b, nil
+}
+
+// ListBorrowings lists borrowing records with filters.
+func (s *Service) ListBorrowings(ctx context.Context, spaceID SpaceID, filter *ListBorrowingsFilter) ([]*Borrowing, string, error) {
+	if err := spaceID.Validate(); err != nil {
+		return nil, "", err
+	}
+	return s.deps.BorrowingStore.ListBySpace(ctx, spaceID, filter)
+}
+
+// UpdateBorrowing updates a borrowing record and its associated transaction.
+func (s *Service) UpdateBorrowing(ctx context.Context, b *Borrowing) (*Borrowing, error) {
+	existing, err := s.deps.BorrowingStore.GetByID(ctx, b.SpaceID, b.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Keep internal fields
+	b.RemainingAmount = existing.RemainingAmount
+	b.PaidAmount = existing.PaidAmount
+	b.Status = existing.Status
+	b.CreateTime = existing.CreateTime
+	b.UpdateTime = time.Now().UTC()
+
+	// A new or changed instalment plan re-allocates what was already repaid.
+	if b.HasInstallmentPlan() || existing.HasInstallmentPlan() {
+		if !b.HasInstallmentPlan() {
+			b.RemainingAmount = b.TotalAmount
+			b.PaidAmount = 0
+			b.ApplyRepayment(existing.PaidAmount)
+		} else {
+			b.ApplyRepayment(0)
+		}
+	}
+
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+
+	settings, err := s.deps.SettingsStore.GetByID(ctx, b.SpaceID)
+	if err != nil {
+		return nil, err
+	}
+	if b.BudgetID != nil {
+		if _, err := s.deps.BudgetStore.GetByID(ctx, b.SpaceID, *b.BudgetID); err != nil {
+			return nil, err
+		}
+	}
+
+	// Check if a transaction already exists for this borrowing
+	bID := string(b.ID)
+	page, err := s.deps.TransactionStore.ListBySpace(ctx, b.SpaceID, &TransactionFilter{
+		BorrowingID: &bID,
+		PageSize:    1,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("check existing transaction: %w", err)
+	}
+	existingTxs := page.Items
+	hasTransaction := len(existingTxs) > 0
+
+	if hasTransaction && b.Currency != settings.BaseCurrency {
+		_, err := s.getExchangeRate(ctx, ExchangeRateKey{
+			SpaceID:      b.SpaceID,
+			FromCurrency: b.Currency,
+			ToCurrency:   settings.BaseCurrency,
+			RateDate:     b.EstablishedAt,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("exchange rate not configured from %s to %s for date %s: %w", b.Currency, settings.BaseCurrency, b.EstablishedAt.Format("2006-01-02"), err)
+		}
+	}
+
+	var txnType TransactionType
+	var desc string
+	if b.Direction == BorrowingDirectionLent {
+		txnType = TransactionTypeExpense
+		desc = fmt.Sprintf("Lent to %s", b.Counterparty)
+	} else {
+		txnType = TransactionTypeIncome
+		desc = fmt.Sprintf("Borrowed from %s", b.Counterparty)
+	}
+	syncParams := syncTransactionParams{
+		SpaceID:         b.SpaceID,
+		BorrowingID:     string(b.ID),
+		Amount:          b.TotalAmount,
+		Currency:        b.Currency,
+		TransactionDate: b.EstablishedAt,
+		Description:     desc,
+		Type:            txnType,
+		AccountID:       b.AccountID,
+	}
+
+	// A reconciled transaction cannot follow the borrowing, so refuse before anything is written
+	if hasTransaction && existingTxs[0].IsReconciled() && syncParams.changes(existingTxs[0]) {
+		return nil, ErrTransactionReconciled
+	}
+
+	if err := s.deps.BorrowingStore.Update(ctx, b); err != nil {
+		return nil, err
+	}
+
+	if hasTransaction {
+		// Update associated transaction
+		if err := s.syncTransaction(ctx, syncParams); err != nil {
+			return nil, err
+		}
+	}
+
+	if existing.HasInstallmentPlan() && !b.HasInstallmentPlan() {
+		if err := s.deleteOpenLoanInstallments(ctx, b.SpaceID, b.ID); err != nil {
+			return nil, err
+		}
+	} else if err := s.syncLoanInstallments(ctx, b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// DeleteBorrowing removes a borrowing, its repayments, and their transactions.
+func (s *Service) DeleteBorrowing(ctx context.Context, spaceID SpaceID, id BorrowingID) error {
+	b, err := s.deps.BorrowingStore.GetByID(ctx, spaceID, id)
+	if err != nil {
+		return err
+	}
+
+	if b.SpaceID != spaceID {
+		return errors.New("borrowing does not belong to space")
+	}
+
+	// 1. Delete associated transactions for borrowing
+	if err := s.deleteTransactionByBorrowingID(ctx, spaceID, string(id)); err != nil {
+		return err
+	}
+
+	// 2. Drop instalments that are still open
+	if b.HasInstallmentPlan() {
+		if err := s.deleteOpenLoanInstallments(ctx, spaceID, id); err != nil {
+			return err
+		}
+	}
+
+	// 3. Delete borrowing agreement from DB
+	return s.deps.BorrowingStore.Delete(ctx, id)
+}
+
+// CreateBorrowingRepayment logs an installment repayment towards a borrowing.
+func (s *Service) CreateBorrowingRepayment(ctx context.Context, r *BorrowingRepayment) (*BorrowingRepayment, error) {
+	b, _, err := s.recordBorrowingRepayment(ctx, r, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.syncLoanInstallments(ctx, b); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// recordBorrowingRepayment books a repayment transaction and updates the borrowing balance.
+// When the repayment settles a loan instalment, the transaction is linked to its scheduled payment.
+func (s *Service) recordBorrowingRepayment(ctx context.Context, r *BorrowingRepayment, payment *ScheduledPayment) (*Borrowing, *Transaction, error) {
+	b, err := s.deps.BorrowingStore.GetByID(ctx, r.SpaceID, r.BorrowingID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if r.SpaceID != b.SpaceID {
+		return nil, nil, errors.New("repayment space ID does not match borrowing space ID")
+	}
+
+	if r.Amount <= 0 {
+		return nil, nil, errors.New("repayment amount must be greater than zero")
+	}
+
+	if outstanding := b.OutstandingPayable(); r.Amount > outstanding {
+		return nil, nil, fmt.Errorf("repayment amount %d exceeds remaining borrowing balance %d", r.Amount, outstanding)
+	}
+
+	if r.ID == "" {
+		rID, err := NewBorrowingRepaymentID()
+		if err != nil {
+			return nil, nil, err
+		}
+		r.ID = rID
+	}
+	r.CreateTime = time.Now().UTC()
+	r.UpdateTime = time.Now().UTC()
+
+	settings, err := s.deps.SettingsStore.GetByID(ctx, r.SpaceID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("verify workspace settings: %w", err)
+	}
+
+	// 1. Calculate amount in workspace base currency
+	amountInBase := r.Amount
+	if b.Currency != settings.BaseCurrency {
+		rateRecord, err := s.getExchangeRate(ctx, ExchangeRateKey{
+			SpaceID:      r.SpaceID,
+			FromCurrency: b.Currency,
+			ToCurrency:   settings.BaseCurrency,
+			RateDate:     r.PaymentDate,
+		})
+		if err != nil {
+			return nil, nil, fmt.Errorf("exchange rate not configured from %s to %s for date %s: %w", b.Currency, settings.BaseCurrency, r.PaymentDate.Format("2006-01-02"), err)
+		}
+		amountInBase = ConvertAmount(r.Amount, b.Currency, settings.BaseCurrency, rateRecord.Rate)
+	}
+
+	// 2. Calculate account impact amount (in payment account's currency)
+	accountImpactAmount := r.Amount
+	if r.AccountID != nil && *r.AccountID != "" {
+		acc, err := s.deps.AccountStore.GetByID(ctx, r.SpaceID, *r.AccountID)
+		if err != nil {
+			return nil, nil, fmt.Errorf("fetch payment account: %w", err)
+		}
+		if acc.Currency != b.Currency {
+			rateRecord, err := s.getExchangeRate(ctx, ExchangeRateKey{
+				SpaceID:      r.SpaceID,
+				FromCurrency: b.Currency,
+				ToCurrency:   acc.Currency,
+				RateDate:     r.PaymentDate,
+			})
+			if err != nil {
+				return nil, nil, fmt.Errorf("exchange rate not configured from %s to %s for date %s: %w", b.Currency, acc.Currency, r.PaymentDate.Format("2006-01-02"), err)
+			}
+			accountImpactAmount = ConvertAmount(r.Amount, b.Currency, acc.Currency, rateRecord.Rate)
+		}
+	}
+
+	// 3. Update borrowing balance (in borrowing currency)
+	b.ApplyRepayment(r.Amount)
+	b.UpdateTime = time.Now().UTC()
+	if err := s.deps.BorrowingStore.Update(ctx, b); err != nil {
+		return nil, nil, fmt.Errorf("failed to update borrowing balance: %w", err)
+	}
+
+	// 4. Create transaction for repayment with metadata
+	var txnType TransactionType
+	var desc string
+	if b.Direction == BorrowingDirectionLent {
+		txnType = TransactionTypeIncome // paid back to us
+		desc = fmt.Sprintf("Repayment from %s", b.Counterparty)
+	} else {
+		txnType = TransactionTypeExpense // we paid them back
+		desc = fmt.Sprintf("Repayment to %s", b.Counterparty)
+	}
+	if r.Notes != "" {
+		desc = r.Notes
+	}
+
+	txnID, err := NewTransactionID()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	txn := &Transaction{
+		ID:              txnID,
+		SpaceID:         r.SpaceID,
+		Type:            txnType,
+		AccountID:       r.AccountID,
+		Amount:          r.Amount,
+		Currency:        b.Currency,
+		AmountInBase:    amountInBase,
+		Description:     desc,
+		TransactionDate: r.PaymentDate,
+		EffectiveDate:   time.Now().UTC(),
+		Metadata: TransactionMetadata{
+			BorrowingID:         &b.ID,
+			BorrowingRole:       "REPAYMENT",
+			BorrowingAmount:     r.Amount,
+			AccountImpactAmount: accountImpactAmount,
+			Notes:               r.Notes,
+		},
+		CreateTime: time.Now().UTC(),
+		UpdateTime: time.Now().UTC(),
+	}
+	if payment != nil {
+		txn.Metadata.ScheduledPaymentID = &payment.ID
+	}
+
+	if err := s.deps.TransactionStore.Create(ctx, txn); err != nil {
+		return nil, nil, fmt.Errorf("create repayment transaction: %w", err)
+	}
+	s.notifyTransactionChanged(ctx, txn)
+
+	// 5. Adjust account balance using converted account impact amount
+	if r.AccountID != nil && *r.AccountID != "" {
+		if err := s.adjustAccountBalance(ctx, r.SpaceID, *r.AccountID, accountImpactAmount, txnType, false); err != nil {
+			return nil, nil, fmt.Errorf("adjust account balance for repayment: %w", err)
+		}
+	}
+
+	r.ID = BorrowingRepaymentID(txn.ID)
+	return b, txn, nil
+}
+
+// ListBorrowingRepayments returns repayments for a borrowing.
+func (s *Service) ListBorrowingRepayments(ctx context.Context, spaceID SpaceID, borrowingID BorrowingID) ([]*BorrowingRepayment, error) {
+	if err := spaceID.Validate(); err != nil {
+		return nil, err
+	}
+	if err := borrowingID.Validate(); err != nil {
+		return nil, err
+	}
+	bIDStr := string(borrowingID)
+	page, err := s.deps.TransactionStore.ListBySpace(ctx, spaceID, &TransactionFilter{
+		BorrowingID: &bIDStr,
+		PageSize:    100,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*BorrowingRepayment
+	for _, t := range page.Items {
+		if t.Metadata.BorrowingRole != "REPAYMENT" {
+			continue
+		}
+		notes := t.Metadata.Notes
+		if notes == "" {
+			notes = t.Description
+		}
+
+		result = append(result, &BorrowingRepayment{
+			ID:          BorrowingRepaymentID(t.ID),
+			BorrowingID: borrowingID,
+			SpaceID:     spaceID,
+			Amount:      t.Amount,
+			PaymentDate: t.TransactionDate,
+			Notes:       notes,
+			AccountID:   t.AccountID,
+			CreateTime:  t.CreateTime,
+			UpdateTime:  t.UpdateTime,
+		})
+	}
+
+	return result, nil
+}
+
+// DeleteBorrowingRepaymentRequest represents parameters to delete a repayment installment.
+type DeleteBorrowingRepaymentRequest struct {
+	SpaceID     SpaceID
+	BorrowingID BorrowingID
+	ID          BorrowingRepaymentID
+}
+
+// DeleteBorrowingRepayment deletes a repayment installment, restoring account and borrowing balance.
+func (s *Service) DeleteBorrowingRepayment(ctx context.Context, req DeleteBorrowingRepaymentRequest) error {
+	b, err := s.deps.BorrowingStore.GetByID(ctx, req.SpaceID, req.BorrowingID)
+	if err != nil {
+		return err
+	}
+
+	if b.SpaceID != req.SpaceID {
+		return errors.New("borrowing does not belong to space")
+	}
+
+	txn, err := s.deps.TransactionStore.GetByID(ctx, req.SpaceID, TransactionID(req.ID))
+	if err != nil {
+		return fmt.Errorf("repayment transaction not found: %w", err)
+	}
+	if txn.IsReconciled() {
+		return ErrTransactionReconciled
+	}
+
+	return s.deleteTransaction(ctx, txn)
+}
+
+// loanInstallmentMetadata is stored on the scheduled payment of a loan instalment.
+type loanInstallmentMetadata struct {
+	Description string `json:"description"`
+	Installment int32  `json:"installment"`
+	Principal   int64  `json:"principal"`
+	Interest    int64  `json:"interest"`
+}
+
+// syncLoanInstallments replaces the open scheduled payments of a borrowed loan with one per
+// instalment that repayments have not covered yet. Lent borrowings are receivables and get no
+// scheduled payments; their instalments are tracked through GetBorrowing only.
+func (s *Service) syncLoanInstallments(ctx context.Context, b *Borrowing) error {
+	if !b.HasInstallmentPlan() {
+		return nil
+	}
+	if err := s.deleteOpenLoanInstallments(ctx, b.SpaceID, b.ID); err != nil {
+		return err
+	}
+	if b.Direction != BorrowingDirectionBorrowed || b.BudgetID == nil || b.Status != BorrowingStatusActive {
+		return nil
+	}
+
+	progress := b.ProgressAt(time.Now().UTC())
+	if progress == nil {
+		return nil
+	}
+
+	for _, e := range progress.Schedule {
+		if e.Status == InstallmentStatusPaid {
+			continue
+		}
+
+		spID, err := NewScheduledPaymentID()
+		if err != nil {
+			return err
+		}
+		meta, err := json.Marshal(loanInstallmentMetadata{
+			Description: fmt.Sprintf("Instalment %d/%d to %s", e.Number, b.InstallmentCount, b.Counterparty),
+			Installment: e.Number,
+			Principal:   e.Principal,
+			Interest:    e.Interest,
+		})
+		if err != nil {
+			return err
+		}
+
+		payment := &ScheduledPayment{
+			ID:         spID,
+			SpaceID:    b.SpaceID,
+			BudgetID:   *b.BudgetID,
+			SourceType: SourceTypeLoan,
+			SourceID:   string(b.ID),
+			Amount:     e.Payment - e.PaidAmount,
+			Currency:   b.Currency,
+			DueDate:    e.DueDate,
+			Status:     ScheduledPaymentPending,
+			Metadata:   meta,
+			CreateTime: time.Now().UTC(),
+			UpdateTime: time.Now().UTC(),
+		}
+		if err := payment.Validate(); err != nil {
+			return err
+		}
+		if err := s.deps.ScheduledPaymentStore.Create(ctx, payment); err != nil {
+			return fmt.Errorf("create loan instalment %d: %w", e.Number, err)
+		}
+	}
+	return nil
+}
+
+// deleteOpenLoanInstallments removes the scheduled payments of a borrowing that were not paid.
+func (s *Service) deleteOpenLoanInstallments(ctx context.Context, spaceID SpaceID, id BorrowingID) error {
+	sourceType, sourceID := SourceTypeLoan, string(id)
+	filter := &ListScheduledPaymentsFilter{
+		SourceType: &sourceType,
+		SourceID:   &sourceID,
+		PageSize:   100,
+	}
+
+	var open []ScheduledPaymentID
+	for {
+		page, err := s.deps.ScheduledPaymentStore.ListBySpace(ctx, spaceID, filter)
+		if err != nil {
+			return fmt.Errorf("list loan instalments: %w", err)
+		}
+		for _, p := range page.Items {
+			if p.Status != ScheduledPaymentPaid {
+				open = append(open, p.ID)
+			}
+		}
+		if page.NextPageToken == "" {
+			break
+		}
+		filter.NextPageToken = page.NextPageToken
+	}
+
+	for _, pID := range open {
+		if err := s.deps.ScheduledPaymentStore.Delete(ctx, pID); err != nil {
+			return fmt.Errorf("delete loan instalment %s: %w", pID, err)
+		}
+	}
+	return nil
+}
+
+// confirmLoanInstallment settles a loan instalment by recording a borrowing repayment, so the
+// loan balance moves with the payment.
+func (s *Service) confirmLoanInstallment(ctx context.Context, payment *ScheduledPayment, req ConfirmScheduledPaymentRequest) (*Transaction, error) {
+	amount := req.ActualAmount
+	if amount <= 0 {
+		amount = payment.Amount
+	}
+	paymentDate := req.TransactionDate
+	if paymentDate.IsZero() {
+		paymentDate = time.Now().UTC()
+	}
+
+	_, txn, err := s.recordBorrowingRepayment(ctx, &BorrowingRepayment{
+		BorrowingID: BorrowingID(payment.SourceID),
+		SpaceID:     payment.SpaceID,
+		Amount:      amount,
+		PaymentDate: paymentDate,
+		Notes:       req.Description,
+		AccountID:   req.AccountID,
+	}, payment)
+	if err != nil {
+		return nil, err
+	}
+
+	payment.Status = ScheduledPaymentPaid
+	payment.UpdateTime = time.Now().UTC()
+	if err := s.deps.ScheduledPaymentStore.Update(ctx, payment); err != nil {
+		return nil, fmt.Errorf("failed to update scheduled payment status: %w", err)
+	}
+
+	// Re-read the borrowing so a partial payment leaves the rest of the instalment scheduled.
+	b, err := s.deps.BorrowingStore.GetByID(ctx, payment.SpaceID, BorrowingID(payment.SourceID))
+	if err != nil {
+		return nil, err
+	}
+	if err := s.syncLoanInstallments(ctx, b); err != nil {
+		return nil, err
+	}
+	return txn, nil
+}
+
+// SpaceCurrency is a catalog entry annotated with its availability in a space.
+type SpaceCurrency struct {
+	CurrencyInfo
+	Enabled bool
+}
+
+// ListCurrencies returns the ISO 4217 catalog, flagging the currencies enabled in the space.
+func (s *Service) ListCurrencies(ctx context.Context, spaceID SpaceID) ([]SpaceCurrency, error) {
+	settings, err := s.deps.SettingsStore.GetByID(ctx, spaceID)
+	if err != nil && !errors.Is(err, ErrSettingsNotFound) {
+		return nil, err
+	}
+
+	catalog := Currencies()
+	out := make([]SpaceCurrency, len(catalog))
+	for i, info := range catalog {
+		out[i] = SpaceCurrency{
+			CurrencyInfo: info,
+			Enabled:      settings == nil || settings.IsCurrencyEnabled(info.Code),
+		}
+	}
+	return out, nil
+}
+
+// ensureCurrencyEnabled rejects currencies an admin has disabled for the space.
+// Spaces without finance settings accept any catalog currency.
+func (s *Service) ensureCurrencyEnabled(ctx context.Context, spaceID SpaceID, c Currency) error {
+	settings, err := s.deps.SettingsStore.GetByID(ctx, spaceID)
+	if err != nil {
+		if errors.Is(err, ErrSettingsNotFound) {
+			return nil
+		}
+		return err
+	}
+	if !settings.IsCurrencyEnabled(c) {
+		return fmt.Errorf("%w: %s", ErrCurrencyDisabled, c)
+	}
+	return nil
+}
+
+// CreateAccount creates a new account.
+func (s *Service) CreateAccount(ctx context.Context, a *Account) (*Account, error) {
+	if a.ID == "" {
+		aID, err := NewAccountID()
+		if err != nil {
+			return nil, err
+		}
+		a.ID = aID
+	}
+	a.CreateTime = time.Now().UTC()
+	a.UpdateTime = time.Now().UTC()
+	a.IsActive = true
+
+	if err := a.Validate(); err != nil {
+		return nil, err
+	}
+	if err := s.ensureCurrencyEnabled(ctx, a.SpaceID, a.Currency); err != nil {
+		return nil, err
+	}
+	if err := s.ensurePaymentBudget(ctx, a); err != nil {
+		return nil, err
+	}
+
+	// Check if first account in space
+	hasAny, err := s.deps.AccountStore.HasAny(ctx, a.SpaceID)
+	if err != nil {
+		return nil, err
+	}
+	if !hasAny {
+		a.IsDefault = true
+	} else if a.IsDefault {
+		// Unset all other defaults space-wide atomically in the DB
+		if err := s.deps.AccountStore.UnsetDefaultsExcept(ctx, a.SpaceID, a.ID); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.deps.AccountStore.Create(ctx, a); err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+// GetAccount retrieves an account.
+func (s *Service) GetAccount(ctx context.Context, spaceID SpaceID, id AccountID) (*Account, error) {
+	if err := spaceID.Validate(); err != nil {
+		return nil, err
+	}
+	if err := id.Validate(); err != nil {
+		return nil, err
+	}
+	return s.deps.AccountStore.GetByID(ctx, spaceID, id)
+}
+
+// GetAccounts retrieves a list of accounts by their identifiers for a space.
+func (s *Service) GetAccounts(ctx context.Context, spaceID SpaceID, ids []AccountID) ([]*Account, error) {
+	if err := spaceID.Validate(); err != nil {
+		return nil, err
+	}
+	return s.deps.AccountStore.GetByIDs(ctx, spaceID, ids)
+}
+
+// UpdateAccount updates account metadata and handles default flag adjustments.
+func (s *Service) UpdateAccount(ctx context.Context, a *Account) (*Account, error) {
+	existing, err := s.deps.AccountStore.GetByID(ctx, a.SpaceID, a.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Preserve space identity and internal balances if updated ad-hoc
+	a.SpaceID = existing.SpaceID
+	a.Type = existing.Type
+	a.Currency = existing.Currency
+	a.InitialBalance = existing.InitialBalance
+	a.CurrentBalance = existing.CurrentBalance
+	a.CreateTime = existing.CreateTime
+	a.UpdateTime = time.Now().UTC()
+
+	if err := a.Validate(); err != nil {
+		return nil, err
+	}
+	if err := s.ensurePaymentBudget(ctx, a); err != nil {
+		return nil, err
+	}
+
+	if a.IsDefault && !existing.IsDefault {
+		// Unset all other defaults space-wide atomically in the DB
+		if err := s.deps.AccountStore.UnsetDefaultsExcept(ctx, a.SpaceID, a.ID); err != nil {
+			return nil, err
+		}
+	} else if !a.IsDefault && existing.IsDefault {
+		// Override and force it to remain default
+		a.IsDefault = true
+	}
+	if err := s.deps.AccountStore.Update(ctx, a); err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+// ensurePaymentBudget checks that the budget card statement payments are scheduled against exists.
+func (s *Service) ensurePaymentBudget(ctx context.Context, a *Account) error {
+	if a.PaymentBudgetID == nil {
+		return nil
+	}
+	_, err := s.deps.BudgetStore.GetByID(ctx, a.SpaceID, *a.PaymentBudgetID)
+	return err
+}
+
+// AdjustAccountBalance reconciles an account's live balance to a target balance by logging a system reconciliation transaction.
+func (s *Service) AdjustAccountBalance(ctx context.Context, spaceID SpaceID, accountID AccountID, targetBalance int64, adjustmentDate string, note string) (*Account, error) {
+	if err := spaceID.Validate(); err != nil {
+		return nil, err
+	}
+	if err := accountID.Validate(); err != nil {
+		return nil, err
+	}
+
+	acc, err := s.deps.AccountStore.GetByID(ctx, spaceID, accountID)
+	if err != nil {
+		return nil, fmt.Errorf("fetch target account: %w", err)
+	}
+
+	delta := targetBalance - acc.CurrentBalance
+	if delta == 0 {
+		return acc, nil
+	}
+
+	txnID, err := NewTransactionID()
+	if err != nil {
+		return nil, err
+	}
+
+	parsedDate := time.Now().UTC()
+	if adjustmentDate != "" {
+		if t, parseErr := time.Parse(time.RFC3339, adjustmentDate); parseErr == nil {
+			parsedDate = t
+		} else if t, parseErr := time.Parse("2006-01-02", adjustmentDate); parseErr == nil {
+			parsedDate = t
+		}
+	}
+
+	description := "Balance Adjustment"
+	if note != "" {
+		description += " (" + note + ")"
+	}
+
+	accIDVal := accountID
+	txn := &Transaction{
+		ID:              txnID,
+		SpaceID:         spaceID,
+		AccountID:       &accIDVal,
+		Type:            TransactionTypeBalanceAdjustment,
+		Amount:          delta,
+		Currency:        acc.Currency,
+		Description:     description,
+		TransactionDate: parsedDate,
+		EffectiveDate:   parsedDate,
+	}
+
+	if err := s.createTransaction(ctx, txn); err != nil {
+		return nil, fmt.Errorf("record balance adjustment transaction: %w", err)
+	}
+
+	return s.deps.AccountStore.GetByID(ctx, spaceID, accountID)
+}
+
+// StartReconciliation opens a reconciliation session for an account against a bank statement.
+func (s *Service) StartReconciliation(ctx context.Context, r *Reconciliation) (*Reconciliation, error) {
+	if err := r.SpaceID.Validate(); err != nil {
+		return nil, fmt.Errorf("validate space ID: %w", err)
+	}
+	if err := r.AccountID.Validate(); err != nil {
+		return nil, fmt.Errorf("validate account ID: %w", err)
+	}
+
+	acc, err := s.deps.AccountStore.GetByID(ctx, r.SpaceID, r.AccountID)
+	if err != nil {
+		return nil, fmt.Errorf("fetch account: %w", err)
+	}
+
+	history, err := s.deps.ReconciliationStore.ListByAccount(ctx, r.SpaceID, r.AccountID)
+	if err != nil {
+		return nil, fmt.Errorf("list reconciliation history: %w", err)
+	}
+
+	y, m, d := r.StatementDate.UTC().Date()
+	r.StatementDate = time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	r.OpeningBalance = acc.InitialBalance
+	for _, prev := range history {
+		if !prev.IsFinalized() {
+			return nil, ErrReconciliationInProgress
+		}
+	}
+	if len(history) > 0 {
+		last := history[0]
+		if !r.StatementDate.After(last.StatementDate) {
+			return nil, fmt.Errorf("statement date must be after the last reconciled statement on %s", last.StatementDate.Format("2006-01-02"))
+		}
+		r.OpeningBalance = last.StatementBalance
+	}
+
+	if r.ID == "" {
+		rID, err := NewReconciliationID()
+		if err != nil {
+			return nil, err
+		}
+		r.ID = rID
+	}
+	r.Status = ReconciliationStatusInProgress
+	r.AdjustmentTransactionID = nil
+	r.FinalizeTime = nil
+	r.CreateTime = time.Now().UTC()
+	r.UpdateTime = r.CreateTime
+
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.recalculateReconciliation(ctx, acc, r); err != nil {
+		return nil, err
+	}
+	if err := s.deps.ReconciliationStore.Create(ctx, r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// GetReconciliation retrieves a reconciliation session, refreshing the balances of one still in progress.
+func (s *Service) GetReconciliation(ctx context.Context, spaceID SpaceID, id ReconciliationID) (*Reconciliation, error) {
+	if err := spaceID.Validate(); err != nil {
+		return nil, fmt.Errorf("validate space ID: %w", err)
+	}
+	if err := id.Validate(); err != nil {
+		return nil, fmt.Errorf("validate reconciliation ID: %w", err)
+	}
+
+	r, err := s.deps.ReconciliationStore.GetByID(ctx, spaceID, id)
+	if err != nil {
+		return nil, err
+	}
+	if r.IsFinalized() {
+		return r, nil
+	}
+
+	acc, err := s.deps.AccountStore.GetByID(ctx, spaceID, r.AccountID)
+	if err != nil {
+		return nil, fmt.Errorf("fetch account: %w", err)
+	}
+	if _, err := s.recalculateReconciliation(ctx, acc, r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListReconciliations returns the reconciliation history of an account.
+func (s *Service) ListReconciliations(ctx context.Context, spaceID SpaceID, accountID AccountID) ([]*Reconciliation, error) {
+	if err := spaceID.Validate(); err != nil {
+		return nil, fmt.Errorf("validate space ID: %w", err)
+	}
+	if err := accountID.Validate(); err != nil {
+		return nil, fmt.Errorf("validate account ID: %w", err)
+	}
+	return s.deps.ReconciliationStore.ListByAccount(ctx, spaceID, accountID)
+}
+
+// ListReconciliationTransactions lists the transactions a session covers: the account's unreconciled
+// transactions up to the statement date while in progress, or the locked transactions once finalized.
+func (s *Service) ListReconciliationTransactions(ctx context.Context, spaceID SpaceID, id ReconciliationID) ([]*Transaction, error) {
+	r, err := s.GetReconciliation(ctx, spaceID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var reconciliationID *ReconciliationID
+	if r.IsFinalized() {
+		reconciliationID = &r.ID
+	}
+	return s.deps.TransactionStore.ListForReconciliation(ctx, spaceID, r.AccountID, reconciliationID, r.StatementCutoff())
+}
+
+// SetTransactionsCleared ticks or unticks transactions as cleared within an in-progress reconciliation.
+func (s *Service) SetTransactionsCleared(ctx context.Context, spaceID SpaceID, id ReconciliationID, txnIDs []TransactionID, cleared bool) (*Reconciliation, error) {
+	r, err := s.GetReconciliation(ctx, spaceID, id)
+	if err != nil {
+		return nil, err
+	}
+	if r.IsFinalized() {
+		return nil, ErrReconciliationFinalized
+	}
+
+	for _, txnID := range txnIDs {
+		txn, err := s.deps.TransactionStore.GetByID(ctx, spaceID, txnID)
+		if err != nil {
+			return nil, fmt.Errorf("fetch transaction %s: %w", txnID, err)
+		}
+		if txn.AccountID == nil || *txn.AccountID != r.AccountID {
+			return nil, fmt.Errorf("transaction %s does not belong to the reconciled account", txnID)
+		}
+		if txn.IsReconciled() {
+			return nil, fmt.Errorf("transaction %s: %w", txnID, ErrTransactionReconciled)
+		}
+	}
+
+	if err := s.deps.TransactionStore.SetCleared(ctx, spaceID, txnIDs, cleared); err != nil {
+		return nil, fmt.Errorf("update cleared transactions: %w", err)
+	}
+
+	acc, err := s.deps.AccountStore.GetByID(ctx, spaceID, r.AccountID)
+	if err != nil {
+		return nil, fmt.Errorf("fetch account: %w", err)
+	}
+	if _, err := s.recalculateReconciliation(ctx, acc, r); err != nil {
+		return nil, err
+	}
+	r.UpdateTime = time.Now().UTC()
+	if err := s.deps.ReconciliationStore.Update(ctx, r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// FinalizeReconciliation closes a balanced session and locks its cleared transactions from further edits.
+// When createAdjustment is set, a remaining difference is booked as a balance adjustment dated on the
+// statement date instead of blocking the finalization.
+func (s *Service) FinalizeReconciliation(ctx context.Context, spaceID SpaceID, id ReconciliationID, createAdjustment bool) (*Reconciliation, error) {
+	r, err := s.GetReconciliation(ctx, spaceID, id)
+	if err != nil {
+		return nil, err
+	}
+	if r.IsFinalized() {
+		return nil, ErrReconciliationFinalized
+	}
+
+	acc, err := s.deps.AccountStore.GetByID(ctx, spaceID, r.AccountID)
+	if err != nil {
+		return nil, fmt.Errorf("fetch account: %w", err)
+	}
+	cleared, err := s.recalculateReconciliation(ctx, acc, r)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.Difference != 0 {
+		if !createAdjustment {
+			return nil, ErrReconciliationUnbalanced
+		}
+
+		txnID, err := NewTransactionID()
+		if err != nil {
+			return nil, err
+		}
+		accountID := r.AccountID
+		adjustment := &Transaction{
+			ID:              txnID,
+			SpaceID:         spaceID,
+			AccountID:       &accountID,
+			Type:            TransactionTypeBalanceAdjustment,
+			Amount:          r.Difference,
+			Currency:        acc.Currency,
+			Description:     fmt.Sprintf("Reconciliation Adjustment (statement %s)", r.StatementDate.Format("2006-01-02")),
+			TransactionDate: r.StatementDate,
+			EffectiveDate:   r.StatementDate,
+			Cleared:         true,
+		}
+		if err := s.createTransaction(ctx, adjustment); err != nil {
+			return nil, fmt.Errorf("record reconciliation adjustment: %w", err)
+		}
+		r.AdjustmentTransactionID = &adjustment.ID
+		r.ClearedBalance += r.Difference
+		r.ClearedCount++
+		r.Difference = 0
+		cleared = append(cleared, adjustment)
+	}
+
+	txnIDs := make([]TransactionID, len(cleared))
+	for i, txn := range cleared {
+		txnIDs[i] = txn.ID
+	}
+	if err := s.deps.TransactionStore.MarkReconciled(ctx, spaceID, txnIDs, r.ID); err != nil {
+		return nil, fmt.Errorf("lock reconciled transactions: %w", err)
+	}
+
+	now := time.Now().UTC()
+	r.Status = ReconciliationStatusFinalized
+	r.FinalizeTime = &now
+	r.UpdateTime = now
+	if err := s.deps.ReconciliationStore.Update(ctx, r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// CancelReconciliation discards an in-progress session. Cleared flags are kept for the next session.
+func (s *Service) CancelReconciliation(ctx context.Context, spaceID SpaceID, id ReconciliationID) error {
+	r, err := s.GetReconciliation(ctx, spaceID, id)
+	if err != nil {
+		return err
+	}
+	if r.IsFinalized() {
+		return ErrReconciliationFinalized
+	}
+	return s.deps.ReconciliationStore.Delete(ctx, spaceID, id)
+}
+
+// recalculateReconciliation refreshes the session balances and returns the cleared transactions it covers.
+func (s *Service) recalculateReconciliation(ctx context.Context, acc *Account, r *Reconciliation) ([]*Transaction, error) {
+	txns, err := s.deps.TransactionStore.ListForReconciliation(ctx, r.SpaceID, r.AccountID, nil, r.StatementCutoff())
+	if err != nil {
+		return nil, fmt.Errorf("list unreconciled transactions: %w", err)
+	}
+	return r.Recalculate(acc.Type, txns), nil
+}
+
+// DeleteAccount deletes an account and moves default status if necessary.
+func (s *Service) DeleteAccount(ctx context.Context, spaceID SpaceID, id AccountID) error {
+	existing, err := s.deps.AccountStore.GetByID(ctx, spaceID, id)
+	if err != nil {
+		return err
+	}
+
+	if existing.IsDefault {
+		return ErrCannotDeleteDefaultAccount
+	}
+
+	return s.deps.AccountStore.Delete(ctx, id)
+}
+
+// ListAccounts lists all accounts for a space.
+func (s *Service) ListAccounts(ctx context.Context, spaceID SpaceID, filter *ListAccountsFilter) (*paging.Page[*Account], error) {
+	if err := spaceID.Validate(); err != nil {
+		return nil, err
+	}
+	return s.deps.AccountStore.ListBySpace(ctx, spaceID, filter)
+}
+
+// GetLatestRates retrieves the latest exchange rates for the given fromCurrencies to the target currency.
+func (s *Service) GetLatestRates(ctx context.Context, spaceID SpaceID, fromCurrencies []Currency, toCurrency Currency) ([]*ExchangeRate, error) {
+	if err := spaceID.Validate(); err != nil {
+		return nil, err
+	}
+	return s.deps.ExchangeRateStore.GetLatestRates(ctx, spaceID, fromCurrencies, toCurrency)
+}
+
+// CreateTransfer logs a fund movement between accounts.
+func (s *Service) CreateTransfer(ctx context.Context, t *Transfer) (*Transfer, error) {
+	if t.ID == "" {
+		tID, err := NewTransferID()
+		if err != nil {
+			return nil, err
+		}
+		t.ID = tID
+	}
+	t.CreateTime = time.Now().UTC()
+	t.UpdateTime = time.Now().UTC()
+
+	if err := t.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Fetch both accounts to verify existence and check currencies
+	srcAcc, err := s.deps.AccountStore.GetByID(ctx, t.SpaceID, t.SourceAccountID)
+	if err != nil {
+		return nil, fmt.Errorf("source account: %w", err)
+	}
+	destAcc, err := s.deps.AccountStore.GetByID(ctx, t.SpaceID, t.DestinationAccountID)
+	if err != nil {
+		return nil, fmt.Errorf("destination account: %w", err)
+	}
+
+	if srcAcc.SpaceID != t.SpaceID || destAcc.SpaceID != t.SpaceID {
+		return nil, errors.New("accounts do not belong to the same space as the transfer")
+	}
+
+	// Double-entry validation: same currency transfers must have matching source and destination amounts
+	if srcAcc.Currency == destAcc.Currency && t.SourceAmount != t.DestinationAmount {
+		return nil, errors.New("source and destination amounts must match for single-currency transfers")
+	}
+
+	// 1. Insert Transfer parent record
+	if err := s.deps.TransferStore.Create(ctx, t); err != nil {
+		return nil, err
+	}
+
+	// 2. Create the Outflow Transaction Leg
+	outflowTxnID, err := NewTransactionID()
+	if err != nil {
+		return nil, err
+	}
+	outflowTxn := &Transaction{
+		ID:              outflowTxnID,
+		SpaceID:         t.SpaceID,
+		Type:            TransactionTypeTransferOut,
+		Amount:          t.SourceAmount,
+		Currency:        srcAcc.Currency,
+		Description:     fmt.Sprintf("Transfer to %s", destAcc.Name),
+		TransactionDate: t.TransferDate,
+		EffectiveDate:   t.TransferDate,
+		AccountID:       &t.SourceAccountID,
+		Metadata: TransactionMetadata{
+			TransferID:           &t.ID,
+			CounterpartAccountID: &t.DestinationAccountID,
+			Notes:                t.Notes,
+		},
+		CreateTime: t.CreateTime,
+		UpdateTime: t.UpdateTime,
+	}
+	if err := s.createTransaction(ctx, outflowTxn); err != nil {
+		return nil, fmt.Errorf("failed to log transfer outflow leg: %w", err)
+	}
+
+	// 3. Create the Inflow Transaction Leg
+	inflowTxnID, err := NewTransactionID()
+	if err != nil {
+		return nil, err
+	}
+	inflowTxn := &Transaction{
+		ID:              inflowTxnID,
+		SpaceID:         t.SpaceID,
+		Type:            TransactionTypeTransferIn,
+		Amount:          t.DestinationAmount,
+		Currency:        destAcc.Currency,
+		Description:     fmt.Sprintf("Transfer from %s", srcAcc.Name),
+		TransactionDate: t.TransferDate,
+		EffectiveDate:   t.TransferDate,
+		AccountID:       &t.DestinationAccountID,
+		Metadata: TransactionMetadata{
+			TransferID:           &t.ID,
+			CounterpartAccountID: &t.SourceAccountID,
+			Notes:                t.Notes,
+		},
+		CreateTime: t.CreateTime,
+		UpdateTime: t.UpdateTime,
+	}
+	if err := s.createTransaction(ctx, inflowTxn); err != nil {
+		return nil, fmt.Errorf("failed to log transfer inflow leg: %w", err)
+	}
+
+	// 4. A payment into a credit card settles its closed statements
+	if destAcc.HasStatementCycle() {
+		if err := s.settleCardStatements(ctx, destAcc, t.DestinationAmount); err != nil {
+			return nil, fmt.Errorf("failed to settle card statements: %w", err)
+		}
+	}
+
+	return t, nil
+}
+
+// GetTransfer retrieves a transfer for a space.
+func (s *Service) GetTransfer(ctx context.Context, spaceID SpaceID, id TransferID) (*Transfer, error) {
+	if err := spaceID.Validate(); err != nil {
+		return nil, err
+	}
+	if err := id.Validate(); err != nil {
+		return nil, err
+	}
+	return s.deps.TransferStore.GetByID(ctx, spaceID, id)
+}
+
+// DeleteTransfer deletes a transfer parent and deletes both linked ledger entries.
+func (s *Service) DeleteTransfer(ctx context.Context, spaceID SpaceID, id TransferID) error {
+	t, err := s.deps.TransferStore.GetByID(ctx, spaceID, id)
+	if err != nil {
+		return err
+	}
+
+	// Find the associated transaction legs using TransferID
+	page, err := s.deps.TransactionStore.ListBySpace(ctx, t.SpaceID, &TransactionFilter{
+		TransferID: &id,
+		PageSize:   10,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to retrieve transfer transaction legs: %w", err)
+	}
+	legs := page.Items
+
+	// Transfer legs count towards reconciliations, so a locked leg locks the whole transfer
+	for _, leg := range legs {
+		if leg.IsReconciled() {
+			return fmt.Errorf("transfer leg %s: %w", leg.ID, ErrTransactionReconciled)
+		}
+	}
+
+	// Delete both transaction legs
+	for _, leg := range legs {
+		if err := s.deleteTransaction(ctx, leg); err != nil {
+			return fmt.Errorf("failed to delete transfer leg transaction: %w", err)
+		}
+	}
+
+	// Revert the card statement settlement made by the payment
+	destAcc, err := s.deps.AccountStore.GetByID(ctx, t.SpaceID, t.DestinationAccountID)
+	if err != nil && !errors.Is(err, ErrAccountNotFound) {
+		return fmt.Errorf("destination account: %w", err)
+	}
+	if destAcc != nil && destAcc.HasStatementCycle() {
+		if err := s.settleCardStatements(ctx, destAcc, -t.DestinationAmount); err != nil {
+			return fmt.Errorf("failed to revert card statement settlement: %w", err)
+		}
+	}
+
+	// Delete parent transfer record
+	return s.deps.TransferStore.Delete(ctx, id)
+}
+
+// CloseCardStatements issues a statement for every credit card whose closing day has elapsed
+// since its last statement, and schedules the payment due for the statement balance.
+func (s *Service) CloseCardStatements(ctx context.Context, now time.Time) error {
+	cards, err := s.deps.AccountStore.ListWithStatementCycle(ctx)
+	if err != nil {
+		return fmt.Errorf("list cards with statement cycle: %w", err)
+	}
+
+	var errs []error
+	for _, card := range cards {
+		if _, err := s.closeCardStatement(ctx, card, now); err != nil {
+			errs = append(errs, fmt.Errorf("close statement for account %s: %w", card.ID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// closeCardStatement snapshots the card's last elapsed billing cycle. It returns nil when that
+// cycle already has a statement.
+func (s *Service) closeCardStatement(ctx context.Context, card *Account, now time.Time) (*CardStatement, error) {
+	loc := time.UTC
+	settings, err := s.deps.SettingsStore.GetByID(ctx, card.SpaceID)
+	if err != nil && !errors.Is(err, ErrSettingsNotFound) {
+		return nil, err
+	}
+	if settings != nil {
+		loc = settings.Location()
+	}
+
+	closing := card.LastClosingDate(now, loc)
+	closingDay := time.Date(closing.Year(), closing.Month(), closing.Day(), 0, 0, 0, 0, time.UTC)
+
+	periodStart := card.PreviousClosingDate(closing).AddDate(0, 0, 1)
+	latest, err := s.deps.CardStatementStore.GetLatest(ctx, card.SpaceID, card.ID)
+	switch {
+	case err == nil:
+		if !latest.ClosingDate.Before(closingDay) {
+			return nil, nil
+		}
+		// Cycles missed while the job was not running are folded into this statement.
+		periodStart = time.Date(latest.ClosingDate.Year(), latest.ClosingDate.Month(), latest.ClosingDate.Day()+1, 0, 0, 0, 0, loc)
+	case !errors.Is(err, ErrCardStatementNotFound):
+		return nil, err
+	}
+	periodEnd := closing.AddDate(0, 0, 1)
+
+	// The statement balance is the live balance minus whatever posted after the close.
+	var purchases, credits, after int64
+	filter := &TransactionFilter{
+		AccountID: &card.ID,
+		StartDate: &periodStart,
+		PageSize:  100,
+	}
+	for {
+		page, err := s.deps.TransactionStore.ListBySpace(ctx, card.SpaceID, filter)
+		if err != nil {
+			return nil, fmt.Errorf("list card transactions: %w", err)
+		}
+		for _, t := range page.Items {
+			delta := cardBalanceDelta(t)
+			switch {
+			case !t.TransactionDate.Before(periodEnd):
+				after += delta
+			case delta > 0:
+				purchases += delta
+			default:
+				credits -= delta
+			}
+		}
+		if page.NextPageToken == "" {
+			break
+		}
+		filter.NextPageToken = page.NextPageToken
+	}
+	balance := card.CurrentBalance - after
+
+	stID, err := NewCardStatementID()
+	if err != nil {
+		return nil, err
+	}
+	due := card.PaymentDueDate(closing)
+	st := &CardStatement{
+		ID:               stID,
+		SpaceID:          card.SpaceID,
+		AccountID:        card.ID,
+		Currency:         card.Currency,
+		PeriodStart:      periodStart.UTC(),
+		ClosingDate:      closingDay,
+		DueDate:          time.Date(due.Year(), due.Month(), due.Day(), 0, 0, 0, 0, time.UTC),
+		OpeningBalance:   balance - purchases + credits,
+		Purchases:        purchases,
+		Credits:          credits,
+		StatementBalance: balance,
+		MinimumPayment:   card.MinimumPaymentFor(balance),
+		CreditLimit:      card.CreditLimit,
+		Status:           CardStatementStatusIssued,
+		CreateTime:       time.Now().UTC(),
+		UpdateTime:       time.Now().UTC(),
+	}
+	if card.CreditLimit > 0 && balance > 0 {
+		st.Utilization = float64(balance) / float64(card.CreditLimit) * 100.0
+	}
+	st.refreshStatus()
+
+	if st.RemainingDue() > 0 {
+		payment, err := s.scheduleCardPayment(ctx, card, st)
+		if err != nil {
+			return nil, err
+		}
+		st.ScheduledPaymentID = &payment.ID
+	}
+
+	if err := st.Validate(); err != nil {
+		return nil, err
+	}
+	if err := s.deps.CardStatementStore.Create(ctx, st); err != nil {
+		return nil, err
+	}
+	return st, nil
+}
+
+// scheduleCardPayment creates the pending payment for a statement balance, due on the statement due date.
+func (s *Service) scheduleCardPayment(ctx context.Context, card *Account, st *CardStatement) (*ScheduledPayment, error) {
+	spID, err := NewScheduledPaymentID()
+	if err != nil {
+		return nil, err
+	}
+	metaBytes, err := json.Marshal(map[string]any{
+		"description":       fmt.Sprintf("%s statement (%s)", card.Name, st.ClosingDate.Format("2006-01")),
+		"account_id":        string(card.ID),
+		"closing_date":      st.ClosingDate.Format("2006-01-02"),
+		"statement_balance": st.StatementBalance,
+		"minimum_payment":   st.MinimumPayment,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("marshal scheduled payment metadata: %w", err)
+	}
+
+	payment := &ScheduledPayment{
+		ID:         spID,
+		SpaceID:    card.SpaceID,
+		BudgetID:   *card.PaymentBudgetID,
+		SourceType: SourceTypeCardStatement,
+		SourceID:   string(st.ID),
+		Amount:     st.RemainingDue(),
+		Currency:   card.Currency,
+		DueDate:    st.DueDate,
+		Status:     ScheduledPaymentPending,
+		Metadata:   metaBytes,
+		CreateTime: time.Now().UTC(),
+		UpdateTime: time.Now().UTC(),
+	}
+	if err := payment.Validate(); err != nil {
+		return nil, err
+	}
+	if err := s.deps.ScheduledPaymentStore.Create(ctx, payment); err != nil {
+		return nil, err
+	}
+	return payment, nil
+}
+
+// settleCardStatements applies a card payment to its unpaid statements, oldest first.
+// A negative amount reverts a payment, starting from the most recent statement.
+func (s *Service) settleCardStatements(ctx context.Context, card *Account, amount int64) error {
+	statements, err := s.deps.CardStatementStore.ListByAccount(ctx, card.SpaceID, card.ID, &ListCardStatementsFilter{
+		UnpaidOnly: amount > 0,
+	})
+	if err != nil {
+		return err
+	}
+	if amount > 0 {
+		slices.Reverse(statements)
+	}
+
+	remaining := amount
+	for _, st := range statements {
+		if remaining == 0 {
+			break
+		}
+		applied := st.ApplyPayment(remaining)
+		if applied == 0 {
+			continue
+		}
+		remaining -= applied
+
+		st.UpdateTime = time.Now().UTC()
+		if err := s.deps.CardStatementStore.Update(ctx, st); err != nil {
+			return err
+		}
+		if err := s.syncCardStatementPayment(ctx, st); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// syncCardStatementPayment keeps the scheduled card payment in line with what is still owed on the statement.
+func (s *Service) syncCardStatementPayment(ctx context.Context, st *CardStatement) error {
+	if st.ScheduledPaymentID == nil {
+		return nil
+	}
+	payment, err := s.deps.ScheduledPaymentStore.GetByID(ctx, st.SpaceID, *st.ScheduledPaymentID)
+	if err != nil {
+		if errors.Is(err, ErrScheduledPaymentNotFound) {
+			return nil
+		}
+		return err
+	}
+	if payment.Status == ScheduledPaymentSkipped {
+		return nil
+	}
+
+	if due := st.RemainingDue(); due > 0 {
+		payment.Status = ScheduledPaymentPending
+		payment.Amount = due
+	} else {
+		payment.Status = ScheduledPaymentPaid
+	}
+	payment.UpdateTime = time.Now().UTC()
+	return s.deps.ScheduledPaymentStore.Update(ctx, payment)
+}
+
+// confirmCardStatementPayment pays a card statement with a transfer from the chosen account,
+// which settles the statement. The outflow leg of the transfer is returned.
+func (s *Service) confirmCardStatementPayment(ctx context.Context, payment *ScheduledPayment, req ConfirmScheduledPaymentRequest) (*Transaction, error) {
+	if req.AccountID == nil || *req.AccountID == "" {
+		return nil, errors.New("a source account is required to pay a card statement")
+	}
+	st, err := s.deps.CardStatementStore.GetByID(ctx, payment.SpaceID, CardStatementID(payment.SourceID))
+	if err != nil {
+		return nil, err
+	}
+	srcAcc, err := s.deps.AccountStore.GetByID(ctx, payment.SpaceID, *req.AccountID)
+	if err != nil {
+		return nil, fmt.Errorf("source account: %w", err)
+	}
+
+	amount := req.ActualAmount
+	if amount <= 0 {
+		amount = payment.Amount
+	}
+	transferDate := req.TransactionDate
+	if transferDate.IsZero() {
+		transferDate = time.Now().UTC()
+	}
+
+	sourceAmount := amount
+	if srcAcc.Currency != st.Currency {
+		rate, err := s.getExchangeRate(ctx, ExchangeRateKey{
+			SpaceID:      payment.SpaceID,
+			FromCurrency: st.Currency,
+			ToCurrency:   srcAcc.Currency,
+			RateDate:     transferDate,
+		})
+		if err != nil {
+			return nil, err
+		}
+		sourceAmount = ConvertAmount(amount, st.Currency, srcAcc.Currency, rate.Rate)
+	}
+
+	t, err := s.CreateTransfer(ctx, &Transfer{
+		SpaceID:              payment.SpaceID,
+		SourceAccountID:      srcAcc.ID,
+		DestinationAccountID: st.AccountID,
+		SourceAmount:         sourceAmount,
+		DestinationAmount:    amount,
+		TransferDate:         transferDate,
+		Notes:                req.Description,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	outType := TransactionTypeTransferOut
+	page, err := s.deps.TransactionStore.ListBySpace(ctx, payment.SpaceID, &TransactionFilter{
+		TransferID: &t.ID,
+		Type:       &outType,
+		PageSize:   1,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve transfer outflow leg: %w", err)
+	}
+	if len(page.Items) == 0 {
+		return nil, ErrTransactionNotFound
+	}
+	txn := page.Items[0]
+	txn.Metadata.ScheduledPaymentID = &payment.ID
+	txn.UpdateTime = time.Now().UTC()
+	if err := s.deps.TransactionStore.Update(ctx, txn); err != nil {
+		return nil, fmt.Errorf("failed to link transfer to scheduled payment: %w", err)
+	}
+	s.notifyTransactionChanged(ctx, txn)
+	return txn, nil
+}
+
+// ListCardStatements lists the closed statements of a credit card, most recent first.
+func (s *Service) ListCardStatements(ctx context.Context, spaceID SpaceID, accountID AccountID, filter *ListCardStatementsFilter) ([]*CardStatement, error) {
+	if err := spaceID.Validate(); err != nil {
+		return nil, err
+	}
+	if err := accountID.Validate(); err != nil {
+		return nil, err
+	}
+	if _, err := s.deps.AccountStore.GetByID(ctx, spaceID, accountID); err != nil {
+		return nil, err
+	}
+	return s.deps.CardStatementStore.ListByAccount(ctx, spaceID, accountID, filter)
+}
+
+// GetCardStatement retrieves a card statement by ID for a space.
+func (s *Service) GetCardStatement(ctx context.Context, spaceID SpaceID, id CardStatementID) (*CardStatement, error) {
+	if err := spaceID.Validate(); err != nil {
+		return nil, err
+	}
+	if err := id.Validate(); err != nil {
+		return nil, err
+	}
+	return s.deps.CardStatementStore.GetByID(ctx, spaceID, id)
+}
+
+// ListTransfers lists transfer records inside a space.
+func (s *Service) ListTransfers(ctx context.Context, spaceID SpaceID, limit int32, pageToken string) ([]*Transfer, string, error) {
+	if err := spaceID.Validate(); err != nil {
+		return nil, "", err
+	}
+	return s.deps.TransferStore.ListBySpace(ctx, spaceID, limit, pageToken)
+}
+
+// LogTransactionEvent inserts a new lifecycle event for a transaction.
+func (s *Service) LogTransactionEvent(ctx context.Context, e *TransactionEvent) (*TransactionEvent, error) {
+	if e.ID == "" {
+		id, err := NewTransactionEventID()
+		if err != nil {
+			return nil, err
+		}
+		e.ID = id
+	}
+	if e.CreateTime.IsZero() {
+		e.CreateTime = time.Now().UTC()
+	}
+	if err := e.Validate(); err != nil {
+		return nil, err
+	}
+	if err := s.deps.TransactionEventStore.Create(ctx, e); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// ListTransactionEvents retrieves all lifecycle events for a specific transaction in a space.
+func (s *Service) ListTransactionEvents(ctx context.Context, spaceID SpaceID, txnID TransactionID) ([]*TransactionEvent, error) {
+	if err := spaceID.Validate(); err != nil {
+		return nil, fmt.Errorf("validate space ID: %w", err)
+	}
+	if err := txnID.Validate(); err != nil {
+		return nil, fmt.Errorf("validate transaction ID: %w", err)
+	}
+	return s.deps.TransactionEventStore.ListByTransaction(ctx, spaceID, txnID)
+}
+
+// StageInboxItem parses extraction suggestions and inserts a new draft entry into the inbox queue.
+func (s *Service) StageInboxItem(ctx context.Context, spaceID SpaceID, req *StageInboxItem) (*InboxItem, error) {
+	if err := spaceID.Validate(); err != nil {
+		return nil, err
+	}
+
+	// 1. Generate unique inbox item ID using "ibx_" prefix
+	ibxID, err := id.Generate("ibx_")
+	if err != nil {
+		return nil, fmt.Errorf("generate inbox item ID: %w", err)
+	}
+
+	// 2. Resolve target account ID (prioritizing explicit AccountID over CardLastFour fallback with currency priority)
+	var accountID *string
+	if req.AccountID != nil && *req.AccountID != "" {
+		accountID = req.AccountID
+	} else if req.CardLastFour != "" {
+		activeOnly := true
+		last4 := req.CardLastFour
+		page, err := s.deps.AccountStore.ListBySpace(ctx, spaceID, &ListAccountsFilter{
+			PageSize:   100,
+			ActiveOnly: &activeOnly,
+			LastFour:   &last4,
+		})
+		if err == nil && len(page.Items) > 0 {
+			var selected *Account
+			// Priority 1: Match currency exact
+			for _, acc := range page.Items {
+				if string(acc.Currency) == req.Currency {
+					selected = acc
+					break
+				}
+			}
+			// Priority 2: Fallback to first matching account
+			if selected == nil {
+				selected = page.Items[0]
+			}
+			accIDStr := string(selected.ID)
+			accountID = &accIDStr
+		}
+	}
+
+	// 3. Resolve and validate matching budget ID
+	var budgetID *string
+	if req.SuggestedBudget != "" {
+		bID, err := ParseBudgetID(req.SuggestedBudget)
+		if err == nil {
+			budget, err := s.deps.BudgetStore.GetByID(ctx, spaceID, bID)
+			if err == nil && budget != nil && budget.SpaceID == spaceID {
+				bIDStr := string(budget.ID)
+				budgetID = &bIDStr
+			}
+		}
+	}
+
+	// 4. Apply the space's transaction rules and payees. Rule budgets take precedence over the
+	// suggested budget, while an explicit or card-matched account is kept.
+	draft := &Transaction{
+		SpaceID:     spaceID,
+		Type:        TransactionTypeExpense,
+		Description: req.Vendor,
+		Amount:      req.Amount,
+	}
+	if v, _ := req.Metadata["transaction_type"].(string); v == "INCOME" {
+		draft.Type = TransactionTypeIncome
+	}
+	if accountID != nil {
+		accID := AccountID(*accountID)
+		draft.AccountID = &accID
+	}
+	if req.PayeeID != nil && *req.PayeeID != "" {
+		if pID, err := ParsePayeeID(*req.PayeeID); err == nil {
+			draft.PayeeID = &pID
+		}
+	}
+	match, err := s.MatchTransactionRules(ctx, spaceID, draft)
+	if err != nil {
+		return nil, fmt.Errorf("match transaction rules: %w", err)
+	}
+	if match.BudgetID != nil && draft.Type == TransactionTypeExpense {
+		bIDStr := string(*match.BudgetID)
+		budgetID = &bIDStr
+	}
+	match.ApplyTo(draft, false)
+	if draft.AccountID != nil {
+		accIDStr := string(*draft.AccountID)
+		accountID = &accIDStr
+	}
+	var payeeID *string
+	if draft.PayeeID != nil {
+		pIDStr := string(*draft.PayeeID)
+		payeeID = &pIDStr
+	}
+
+	// 5. Parse transaction timestamp
+	txDate := time.Now().UTC()
+	if req.Date != "" {
+		if t, err := time.Parse(time.RFC3339, req.Date); err == nil {
+			txDate = t.UTC()
+		}
+	}
+
+	// 6. Create and insert InboxItem
+	item := &InboxItem{
+		ID:              ibxID,
+		SpaceID:         string(spaceID),
+		IntegrationID:   req.IntegrationID,
+		Source:          req.Source,
+		ExternalID:      req.ExternalID,
+		Status:          InboxItemPending,
+		DocType:         req.DocType,
+		Amount:          req.Amount,
+		Currency:        req.Currency,
+		VendorName:      req.Vendor,
+		TransactionDate: txDate,
+		AccountID:       accountID,
+		BudgetID:        budgetID,
+		PayeeID:         payeeID,
+		RawPayload:      req.RawPayload,
+		Metadata:        req.Metadata,
+		CreateTime:      time.Now().UTC(),
+	}
+
+	if err := s.deps.InboxItemStore.Insert(ctx, item); err != nil {
+		return nil, fmt.Errorf("insert inbox item: %w", err)
+	}
+
+	return item, nil
+}
+
+// ListStagedExternalIDs reports which statement external IDs have already been staged for an account.
+func (s *Service) ListStagedExternalIDs(ctx context.Context, spaceID SpaceID, accountID AccountID, externalIDs []string) (map[string]bool, error) {
+	if err := spaceID.Validate(); err != nil {
+		return nil, err
+	}
+	if err := accountID.Validate(); err != nil {
+		return nil, fmt.Errorf("validate account ID: %w", err)
+	}
+	return s.deps.InboxItemStore.ListExternalIDs(ctx, spaceID, accountID, externalIDs)
+}
+
+// GetStatementMapping retrieves the saved CSV column mapping for an account.
+func (s *Service) GetStatementMapping(ctx context.Context, spaceID SpaceID, accountID AccountID) (*StatementMapping, error) {
+	if err := spaceID.Validate(); err != nil {
+		return nil, err
+	}
+	if err := accountID.Validate(); err != nil {
+		return nil, fmt.Errorf("validate account ID: %w", err)
+	}
+	return s.deps.StatementMappingStore.Get(ctx, spaceID, accountID)
+}
+
+// SaveStatementMapping validates and stores the CSV column mapping for an account,
+// replacing any previously saved mapping.
+func (s *Service) SaveStatementMapping(ctx context.Context, mapping *StatementMapping) (*StatementMapping, error) {
+	if err := mapping.Validate(); err != nil {
+		return nil, err
+	}
+	if _, err := s.deps.AccountStore.GetByID(ctx, mapping.SpaceID, mapping.AccountID); err != nil {
+		return nil, fmt.Errorf("get account: %w", err)
+	}
+
+	mapping.UpdateTime = time.Now().UTC()
+	if err := s.deps.StatementMappingStore.Upsert(ctx, mapping); err != nil {
+		return nil, fmt.Errorf("save statement mapping: %w", err)
+	}
+	return mapping, nil
+}
+
+// ListInboxItems lists all pending/staged items in the space inbox.
+func (s *Service) ListInboxItems(ctx context.Context, spaceID SpaceID, filter *ListInboxItemsFilter) (*paging.Page[*InboxItem], error) {
+	if err := spaceID.Validate(); err != nil {
+		return nil, err
+	}
+	return s.deps.InboxItemStore.ListBySpace(ctx, spaceID, filter)
+}
+
+// UpdateInboxItem updates a staging inbox item's draft properties.
+func (s *Service) UpdateInboxItem(ctx context.Context, spaceID SpaceID, item *InboxItem) (*InboxItem, error) {
+	if err := spaceID.Validate(); err != nil {
+		return nil, err
+	}
+	if item.ID == "" {
+		return nil, errors.New("missing inbox item ID")
+	}
+	existing, err := s.deps.InboxItemStore.Get(ctx, spaceID, item.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	item.SpaceID = string(spaceID)
+	if item.Status == "" {
+		item.Status = existing.Status
+	}
+	if item.DocType == "" || item.DocType == InboxItemDocUnknown {
+		item.DocType = existing.DocType
+	}
+	if item.Currency == "" {
+		item.Currency = existing.Currency
+	}
+	if item.Amount == 0 {
+		item.Amount = existing.Amount
+	}
+	if item.VendorName == "" {
+		item.VendorName = existing.VendorName
+	}
+	if item.AccountID == nil {
+		item.AccountID = existing.AccountID
+	}
+	if item.BudgetID == nil {
+		item.BudgetID = existing.BudgetID
+	}
+	if item.PayeeID == nil {
+		item.PayeeID = existing.PayeeID
+	}
+	if item.ScheduledPaymentID == nil {
+		item.ScheduledPaymentID = existing.ScheduledPaymentID
+	}
+	if item.TransactionID == nil {
+		item.TransactionID = existing.TransactionID
+	}
+	if item.BorrowingID == nil {
+		item.BorrowingID = existing.BorrowingID
+	}
+	if item.Splits == nil {
+		item.Splits = existing.Splits
+	}
+
+	// Persist changes
+	if err := s.deps.InboxItemStore.Update(ctx, item); err != nil {
+		return nil, err
+	}
+
+	return item, nil
+}
+
+// DiscardInboxItem deletes an item from the inbox without ledger changes.
+func (s *Service) DiscardInboxItem(ctx context.Context, spaceID SpaceID, id string) error {
+	if err := spaceID.Validate(); err != nil {
+		return err
+	}
+	return s.deps.InboxItemStore.Delete(ctx, spaceID, id)
+}
+
+// ApproveInboxItem promotes an inbox item to the ledger or updates a scheduled payment, returning the resolved item.
+func (s *Service) ApproveInboxItem(ctx context.Context, spaceID SpaceID, id string) (*InboxItem, error) {
+	if err := spaceID.Validate(); err != nil {
+		return nil, err
+	}
+
+	// 1. Fetch current staging inbox item
+	item, err := s.deps.InboxItemStore.Get(ctx, spaceID, id)
+	if err != nil {
+		return nil, fmt.Errorf("get inbox item: %w", err)
+	}
+
+	if item.Status != InboxItemPending {
+		return nil, fmt.Errorf("inbox item is already processed: status = %s", item.Status)
+	}
+
+	// 1b. If it is a system verification email: mark resolved immediately
+	if item.DocType == InboxItemDocSystemVerification {
+		item.Status = InboxItemResolved
+		if err := s.deps.InboxItemStore.Update(ctx, item); err != nil {
+			return nil, fmt.Errorf("resolve verification inbox item: %w", err)
+		}
+		return item, nil
+	}
+
+	// 2. If it is linked to an existing transaction:
+	if item.TransactionID != nil && *item.TransactionID != "" {
+		txnID, err := ParseTransactionID(*item.TransactionID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid transaction ID: %w", err)
+		}
+		txn, err := s.deps.TransactionStore.GetByID(ctx, SpaceID(spaceID), txnID)
+		if err != nil {
+			return nil, fmt.Errorf("get transaction: %w", err)
+		}
+		if txn.SpaceID != SpaceID(spaceID) {
+			return nil, fmt.Errorf("transaction does not belong to this space")
+		}
+		if txn.Type == TransactionTypeTransferOut || txn.Type == TransactionTypeTransferIn {
+			return nil, ErrCannotLinkReceiptToTransfer
+		}
+
+		overwrite := item.MetadataBool("overwrite_linked_transaction")
+
+		// If overwrite option is selected, update the ledger transaction to match receipt details
+		if overwrite {
+			if txn.IsReconciled() {
+				return nil, ErrTransactionReconciled
+			}
+			if txn.IsSplit() && item.Amount != txn.Amount {
+				return nil, fmt.Errorf("%w: cannot overwrite the amount of a split transaction", ErrSplitAmountMismatch)
+			}
+			diff := item.Amount - txn.Amount
+			if diff != 0 && txn.AccountID != nil {
+				if diff > 0 {
+					if err := s.adjustAccountBalance(ctx, SpaceID(spaceID), *txn.AccountID, diff, txn.Type, false); err != nil {
+						return nil, fmt.Errorf("failed to adjust account balance delta: %w", err)
+					}
+				} else {
+					if err := s.adjustAccountBalance(ctx, SpaceID(spaceID), *txn.AccountID, -diff, txn.Type, true); err != nil {
+						return nil, fmt.Errorf("failed to adjust account balance delta: %w", err)
+					}
+				}
+			}
+
+			updatedTxn := *txn
+			updatedTxn.Amount = item.Amount
+			updatedTxn.Currency = Currency(item.Currency)
+			if item.VendorName != "" {
+				updatedTxn.Description = item.VendorName
+			}
+
+			if err := s.deps.TransactionStore.Update(ctx, &updatedTxn); err != nil {
+				return nil, fmt.Errorf("failed to update linked transaction: %w", err)
+			}
+			txn = &updatedTxn
+			s.notifyTransactionChanged(ctx, txn)
+		}
+
+		// Handle optional borrowing link for existing transaction
+		if item.BorrowingID != nil && *item.BorrowingID != "" {
+			linkType := BorrowingLinkTypeInitialReceipt
+			if item.BorrowingLinkType != nil {
+				linkType = *item.BorrowingLinkType
+			}
+			if err := s.handleBorrowingLinkForTransaction(ctx, SpaceID(spaceID), txn, *item.BorrowingID, linkType); err != nil {
+				return nil, fmt.Errorf("link borrowing to existing transaction: %w", err)
+			}
+		}
+
+		// Handle optional scheduled payment link for existing transaction
+		if item.ScheduledPaymentID != nil && *item.ScheduledPaymentID != "" {
+			if err := s.handleScheduledPaymentLinkForTransaction(ctx, SpaceID(spaceID), txn, *item.ScheduledPaymentID); err != nil {
+				return nil, fmt.Errorf("link scheduled payment to existing transaction: %w", err)
+			}
+			item.ScheduledPaymentID = nil
+		}
+
+		// Mark inbox item as resolved and link it
+		item.Status = InboxItemResolved
+		if err := s.deps.InboxItemStore.Update(ctx, item); err != nil {
+			return nil, fmt.Errorf("resolve inbox item: %w", err)
+		}
+
+		// Event 1: Receipt/Document Ingested
+		evtIngested := &TransactionEvent{
+			SpaceID:       SpaceID(spaceID),
+			TransactionID: txn.ID,
+			EventType:     "RECEIPT_INGESTED",
+			Metadata: map[string]any{
+				"amount_cents": item.Amount,
+				"currency":     item.Currency,
+				"vendor_name":  item.VendorName,
+				"description":  "Receipt/Document ingested into staging queue",
+			},
+			CreateTime: item.CreateTime,
+		}
+		if _, err := s.LogTransactionEvent(ctx, evtIngested); err != nil {
+			fmt.Printf("warning: failed to log receipt ingested event: %v\n", err)
+		}
+
+		// Event 2: Staging item linked to this transaction
+		linkedDesc := "Staged document linked to existing ledger entry"
+		if overwrite {
+			linkedDesc = "Staged document linked to existing ledger entry and updated transaction details"
+		}
+		evtLinked := &TransactionEvent{
+			SpaceID:       SpaceID(spaceID),
+			TransactionID: txn.ID,
+			EventType:     "TRANSACTION_LINKED",
+			Metadata: map[string]any{
+				"inbox_item_id":                string(item.ID),
+				"overwrite_linked_transaction": overwrite,
+				"description":                  linkedDesc,
+			},
+			CreateTime: time.Now(),
+		}
+		if _, err := s.LogTransactionEvent(ctx, evtLinked); err != nil {
+			fmt.Printf("warning: failed to log transaction linked event: %v\n", err)
+		}
+
+		return item, nil
+	}
+
+	// 3. If it is linked to a bill/scheduled payment:
+	if item.ScheduledPaymentID != nil && *item.ScheduledPaymentID != "" {
+		payID := ScheduledPaymentID(*item.ScheduledPaymentID)
+		if item.DocType == InboxItemDocInvoice {
+			// A. If it's an unpaid INVOICE: do NOT create a transaction.
+			// Just update the scheduled payment amount and metadata to the actual values.
+			payment, err := s.deps.ScheduledPaymentStore.GetByID(ctx, SpaceID(spaceID), payID)
+			if err != nil {
+				return nil, fmt.Errorf("get scheduled payment: %w", err)
+			}
+			payment.Amount = item.Amount
+			payment.SourceType = item.VendorName
+			if err := s.deps.ScheduledPaymentStore.Update(ctx, payment); err != nil {
+				return nil, fmt.Errorf("update scheduled payment: %w", err)
+			}
+
+			// Mark inbox item as resolved and link it
+			item.Status = InboxItemResolved
+			if err := s.deps.InboxItemStore.Update(ctx, item); err != nil {
+				return nil, fmt.Errorf("resolve inbox item: %w", err)
+			}
+			return item, nil
+		} else {
+			// B. If it's a RECEIPT or other: promote the scheduled payment to a finished transaction.
+			txn, err := s.ConfirmScheduledPayment(ctx, ConfirmScheduledPaymentRequest{
+				SpaceID:         SpaceID(spaceID),
+				PaymentID:       payID,
+				AccountID:       (*AccountID)(item.AccountID),
+				TransactionDate: item.TransactionDate,
+				EffectiveDate:   time.Now().UTC(),
+				ActualAmount:    item.Amount,
+				Description:     item.VendorName,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("confirm scheduled payment: %w", err)
+			}
+
+			// Mark inbox item as resolved and link it
+			item.Status = InboxItemResolved
+			tIDStr := string(txn.ID)
+			item.TransactionID = &tIDStr
+			if err := s.deps.InboxItemStore.Update(ctx, item); err != nil {
+				return nil, fmt.Errorf("resolve inbox item: %w", err)
+			}
+			return item, nil
+		}
+	}
+
+	// 4. Otherwise: create a standalone ledger transaction or a transfer
+	transactionType := item.MetadataString("transaction_type")
+	destinationAccountID := item.MetadataString("destination_account_id")
+	transferLeg := item.MetadataString("transfer_leg")
+	if item.DocType == InboxItemDocInvoice {
+		spID, err := NewScheduledPaymentID()
+		if err != nil {
+			return nil, err
+		}
+		var bID BudgetID
+		if item.BudgetID != nil && *item.BudgetID != "" {
+			bID, err = ParseBudgetID(*item.BudgetID)
+			if err != nil {
+				return nil, fmt.Errorf("parse budget ID: %w", err)
+			}
+		}
+
+		dueDate := item.TransactionDate
+		if dueDate.IsZero() {
+			dueDate = time.Now().UTC()
+		}
+
+		desc := item.VendorName
+		metaMap := map[string]any{
+			"vendor_name": desc,
+		}
+		var metaBytes []byte
+		if b, err := json.Marshal(metaMap); err == nil {
+			metaBytes = b
+		}
+
+		payment := &ScheduledPayment{
+			ID:         spID,
+			SpaceID:    SpaceID(spaceID),
+			BudgetID:   bID,
+			SourceType: "invoice",
+			SourceID:   item.ID,
+			Amount:     item.Amount,
+			Currency:   Currency(item.Currency),
+			DueDate:    dueDate,
+			Status:     ScheduledPaymentPending,
+			Metadata:   metaBytes,
+			CreateTime: time.Now().UTC(),
+			UpdateTime: time.Now().UTC(),
+		}
+
+		if err := payment.Validate(); err != nil {
+			return nil, fmt.Errorf("validate new scheduled payment: %w", err)
+		}
+
+		if err := s.deps.ScheduledPaymentStore.Create(ctx, payment); err != nil {
+			return nil, fmt.Errorf("create scheduled payment: %w", err)
+		}
+
+		// Mark inbox item as resolved and link it
+		item.Status = InboxItemResolved
+		pIDStr := string(payment.ID)
+		item.ScheduledPaymentID = &pIDStr
+		if err := s.deps.InboxItemStore.Update(ctx, item); err != nil {
+			return nil, fmt.Errorf("resolve inbox item: %w", err)
+		}
+
+		return item, nil
+	}
+
+	settings, err := s.deps.SettingsStore.GetByID(ctx, SpaceID(spaceID))
+	if err != nil {
+		return nil, err
+	}
+
+	if transactionType == "TRANSFER" {
+		if item.AccountID == nil || *item.AccountID == "" {
+			return nil, fmt.Errorf("missing source account for transfer")
+		}
+		srcAccID, err := ParseAccountID(*item.AccountID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid source account: %w", err)
+		}
+		if destinationAccountID == "" {
+			return nil, fmt.Errorf("missing destination account for transfer")
+		}
+		destAccID, err := ParseAccountID(destinationAccountID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid destination account: %w", err)
+		}
+
+		tDate := item.TransactionDate
+		if tDate.IsZero() {
+			tDate = time.Now().UTC()
+		}
+
+		t := &Transfer{
+			SpaceID:              SpaceID(spaceID),
+			SourceAccountID:      srcAccID,
+			DestinationAccountID: destAccID,
+			SourceAmount:         item.Amount,
+			DestinationAmount:    item.Amount,
+			TransferDate:         tDate,
+			Notes:                item.VendorName,
+		}
+		newT, err := s.CreateTransfer(ctx, t)
+		if err != nil {
+			return nil, fmt.Errorf("create transfer: %w", err)
+		}
+
+		targetLeg := TransactionTypeTransferOut
+		targetAccID := srcAccID
+		if transferLeg == "DESTINATION" {
+			targetLeg = TransactionTypeTransferIn
+			targetAccID = destAccID
+		}
+
+		page, err := s.deps.TransactionStore.ListBySpace(ctx, SpaceID(spaceID), &TransactionFilter{
+			TransferID: &newT.ID,
+		})
+		var txs []*Transaction
+		if err == nil {
+			txs = page.Items
+		}
+		var matchedTx *Transaction
+		if err == nil {
+			for _, tx := range txs {
+				if tx.Type == targetLeg {
+					matchedTx = tx
+					break
+				}
+			}
+		}
+
+		// Mark inbox item as resolved and link it
+		item.Status = InboxItemResolved
+		if matchedTx != nil {
+			tIDStr := string(matchedTx.ID)
+			item.TransactionID = &tIDStr
+		}
+		targetAccIDStr := string(targetAccID)
+		item.AccountID = &targetAccIDStr
+
+		if err := s.deps.InboxItemStore.Update(ctx, item); err != nil {
+			return nil, fmt.Errorf("resolve inbox item: %w", err)
+		}
+
+		return item, nil
+	}
+
+	var txnType TransactionType
+	var budgetID *BudgetID
+	var periodID *PeriodID
+
+	switch transactionType {
+	case "INCOME":
+		txnType = TransactionTypeIncome
+	case "EXPENSE":
+		txnType = TransactionTypeExpense
+		if item.BudgetID != nil && *item.BudgetID != "" {
+			bID, err := ParseBudgetID(*item.BudgetID)
+			if err != nil {
+				return nil, fmt.Errorf("parse budget ID: %w", err)
+			}
+			budget, err := s.deps.BudgetStore.GetByID(ctx, SpaceID(spaceID), bID)
+			if err != nil {
+				return nil, fmt.Errorf("get budget: %w", err)
+			}
+			period, err := s.GetOrCreatePeriod(ctx, SpaceID(spaceID), budget.ID, item.TransactionDate)
+			if err != nil {
+				return nil, fmt.Errorf("get or create period: %w", err)
+			}
+			budgetID = &budget.ID
+			periodID = &period.ID
+		}
+	default:
+		if item.DocType == InboxItemDocReceipt || item.DocType == InboxItemDocInvoice {
+			txnType = TransactionTypeExpense
+		} else {
+			txnType = TransactionTypeIncome
+		}
+		if item.BudgetID != nil && *item.BudgetID != "" {
+			bID, err := ParseBudgetID(*item.BudgetID)
+			if err != nil {
+				return nil, fmt.Errorf("parse budget ID: %w", err)
+			}
+			budget, err := s.deps.BudgetStore.GetByID(ctx, SpaceID(spaceID), bID)
+			if err != nil {
+				return nil, fmt.Errorf("get budget: %w", err)
+			}
+			period, err := s.GetOrCreatePeriod(ctx, SpaceID(spaceID), budget.ID, item.TransactionDate)
+			if err != nil {
+				return nil, fmt.Errorf("get or create period: %w", err)
+			}
+			budgetID = &budget.ID
+			periodID = &period.ID
+		}
+	}
+
+	// Calculate base currency conversion
+	var rate = 1.0
+	if item.Currency != string(settings.BaseCurrency) {
+		rateRecord, err := s.getExchangeRate(ctx, ExchangeRateKey{
+			SpaceID:      SpaceID(spaceID),
+			FromCurrency: Currency(item.Currency),
+			ToCurrency:   settings.BaseCurrency,
+			RateDate:     item.TransactionDate,
+		})
+		if err == nil {
+			rate = rateRecord.Rate
+		}
+	}
+	amountInBase := ConvertAmount(item.Amount, Currency(item.Currency), settings.BaseCurrency, rate)
+
+	tID, err := NewTransactionID()
+	if err != nil {
+		return nil, err
+	}
+
+	var accountID *AccountID
+	if item.AccountID != nil && *item.AccountID != "" {
+		accID, err := ParseAccountID(*item.AccountID)
+		if err == nil {
+			accountID = &accID
+		}
+	}
+
+	var payeeID *PayeeID
+	if item.PayeeID != nil && *item.PayeeID != "" {
+		pID, err := ParsePayeeID(*item.PayeeID)
+		if err == nil {
+			payeeID = &pID
+		}
+	}
+
+	txDate := item.TransactionDate
+	if txDate.IsZero() {
+		txDate = time.Now().UTC()
+	}
+
+	txn := &Transaction{
+		ID:              tID,
+		SpaceID:         SpaceID(spaceID),
+		Type:            txnType,
+		BudgetID:        budgetID,
+		PeriodID:        periodID,
+		AccountID:       accountID,
+		PayeeID:         payeeID,
+		Amount:          item.Amount,
+		Currency:        Currency(item.Currency),
+		AmountInBase:    amountInBase,
+		Description:     item.VendorName,
+		TransactionDate: txDate,
+		CreateTime:      time.Now().UTC(),
+		UpdateTime:      time.Now().UTC(),
+	}
+
+	// Promote draft split lines, allocating each portion to its own budget period
+	splits, err := item.TransactionSplits()
+	if err != nil {
+		return nil, fmt.Errorf("parse inbox item splits: %w", err)
+	}
+	if len(splits) > 0 {
+		txn.Splits = splits
+		if err := s.resolveSplits(ctx, txn, nil); err != nil {
+			return nil, err
+		}
+		txn.allocateSplitBase()
+	}
+
+	if err := txn.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := s.createTransaction(ctx, txn); err != nil {
+		return nil, err
+	}
+
+	// Handle optional borrowing link for newly promoted transaction
+	if item.BorrowingID != nil && *item.BorrowingID != "" {
+		linkType := BorrowingLinkTypeInitialReceipt
+		if item.BorrowingLinkType != nil {
+			linkType = *item.BorrowingLinkType
+		}
+		if err := s.handleBorrowingLinkForTransaction(ctx, SpaceID(spaceID), txn, *item.BorrowingID, linkType); err != nil {
+			return nil, fmt.Errorf("link borrowing to new transaction: %w", err)
+		}
+	}
+
+	// Mark inbox item as resolved and link it
+	item.Status = InboxItemResolved
+	tIDStr := string(txn.ID)
+	item.TransactionID = &tIDStr
+	if err := s.deps.InboxItemStore.Update(ctx, item); err != nil {
+		return nil, fmt.Errorf("resolve inbox item: %w", err)
+	}
+
+	return item, nil
+}
+
+func (s *Service) handleBorrowingLinkForTransaction(ctx context.Context, spaceID SpaceID, txn *Transaction, borrowingIDStr string, linkType BorrowingLinkType) error {
+	if borrowingIDStr == "" {
+		return nil
+	}
+	bID, err := ParseBorrowingID(borrowingIDStr)
+	if err != nil {
+		return fmt.Errorf("invalid borrowing ID: %w", err)
+	}
+	borrowing, err := s.deps.BorrowingStore.GetByID(ctx, spaceID, bID)
+	if err != nil {
+		return fmt.Errorf("get borrowing: %w", err)
+	}
+
+	if txn.Metadata.BorrowingID != nil {
+		if *txn.Metadata.BorrowingID != borrowing.ID {
+			return ErrCannotRelinkTransactionToDifferentBorrowing
+		}
+		// Already linked to this exact borrowing agreement (idempotent link attachment)
+		return nil
+	}
+
+	role := "INITIAL_FUNDING"
+	switch linkType {
+	case BorrowingLinkTypeInitialReceipt:
+		role = "INITIAL_FUNDING"
+
+	case BorrowingLinkTypeRepayment:
+		role = "REPAYMENT"
+		borrowing.RemainingAmount -= txn.Amount
+		if borrowing.RemainingAmount <= 0 {
+			borrowing.RemainingAmount = 0
+			borrowing.Status = BorrowingStatusPaidOff
+		}
+		borrowing.UpdateTime = time.Now().UTC()
+		if err := s.deps.BorrowingStore.Update(ctx, borrowing); err != nil {
+			return fmt.Errorf("update borrowing remaining balance: %w", err)
+		}
+
+	case BorrowingLinkTypeAdditionalLoan:
+		role = "ADDITIONAL_LOAN"
+		borrowing.TotalAmount += txn.Amount
+		borrowing.RemainingAmount += txn.Amount
+		borrowing.UpdateTime = time.Now().UTC()
+		if err := s.deps.BorrowingStore.Update(ctx, borrowing); err != nil {
+			return fmt.Errorf("update borrowing total balance: %w", err)
+		}
+	}
+
+	// Update metadata with borrowing link details
+	txn.Metadata.BorrowingID = &borrowing.ID
+	txn.Metadata.BorrowingRole = role
+	txn.UpdateTime = time.Now().UTC()
+
+	if err := s.deps.TransactionStore.Update(ctx, txn); err != nil {
+		return fmt.Errorf("update transaction borrowing metadata: %w", err)
+	}
+	s.notifyTransactionChanged(ctx, txn)
+
+	return nil
+}
+
+func (s *Service) handleScheduledPaymentLinkForTransaction(ctx context.Context, spaceID SpaceID, txn *Transaction, paymentIDStr string) error {
+	if paymentIDStr == "" {
+		return nil
+	}
+	pID, err := ParseScheduledPaymentID(paymentIDStr)
+	if err != nil {
+		return fmt.Errorf("invalid scheduled payment ID: %w", err)
+	}
+	payment, err := s.deps.ScheduledPaymentStore.GetByID(ctx, spaceID, pID)
+	if err != nil {
+		return fmt.Errorf("get scheduled payment: %w", err)
+	}
+
+	if txn.Metadata.ScheduledPaymentID != nil {
+		if *txn.Metadata.ScheduledPaymentID != payment.ID {
+			return ErrCannotRelinkTransactionToDifferentScheduledPayment
+		}
+		// Already linked to this exact scheduled payment (ensure payment is marked paid)
+		if payment.Status != ScheduledPaymentPaid {
+			payment.Status = ScheduledPaymentPaid
+			payment.UpdateTime = time.Now().UTC()
+			if err := s.deps.ScheduledPaymentStore.Update(ctx, payment); err != nil {
+				return fmt.Errorf("update scheduled payment status: %w", err)
 			}
+		}
+		return nil
+	}
+
+	// Retroactively mark scheduled payment as paid and link transaction
+	payment.Status = ScheduledPaymentPaid
+	payment.UpdateTime = time.Now().UTC()
+	if err := s.deps.ScheduledPaymentStore.Update(ctx, payment); err != nil {
+		return fmt.Errorf("update scheduled payment status: %w", err)
+	}
+
+	txn.Metadata.ScheduledPaymentID = &payment.ID
+	txn.UpdateTime = time.Now().UTC()
+	if err := s.deps.TransactionStore.Update(ctx, txn); err != nil {
+		return fmt.Errorf("update transaction scheduled payment metadata: %w", err)
+	}
+	s.notifyTransactionChanged(ctx, txn)
+
+	return nil
+}
+
+// GetBudget retrieves a budget by its unique identifier for a space.
+func (s *Service) GetBudget(ctx context.Context, spaceID SpaceID, id BudgetID) (*Budget, error) {
+	if err := spaceID.Validate(); err != nil {
+		return nil, err
+	}
+	if err := id.Validate(); err != nil {
+		return nil, err
+	}
+	return s.deps.BudgetStore.GetByID(ctx, spaceID, id)
+}
+
+// GetBudgets retrieves a list of budgets by their identifiers for a space.
+func (s *Service) GetBudgets(ctx context.Context, spaceID SpaceID, ids []BudgetID) ([]*Budget, error) {
+	if err := spaceID.Validate(); err != nil {
+		return nil, err
+	}
+	return s.deps.BudgetStore.GetByIDs(ctx, spaceID, ids)
+}
 
-			// Mark inbox item as resolved and link it
-			item.Status = InboxItemResolved
-			if err := s.deps.InboxItemStore.Update(ctx, item); err != nil {
-				return nil, fmt.Errorf("resolve inbox item: %w", err)
-			}
-			return item, nil
-		} else {
-			// B. If it's a RECEIPT or other: promote the scheduled payment to a finished transaction.
-			txn, err := s.ConfirmScheduledPayment(ctx, ConfirmScheduledPaymentRequest{
-				SpaceID:         SpaceID(spaceID),
-				PaymentID:       payID,
-				AccountID:       (*AccountID)(item.AccountID),
-				TransactionDate: item.TransactionDate,
-				EffectiveDate:   time.Now().UTC(),
-				ActualAmount:    item.Amount,
-				Description:     item.VendorName,
-			})
-			if err != nil {
-				return nil, fmt.Errorf("confirm scheduled payment: %w", err)
-			}
+// ForecastCashFlow projects the balance of every active account day by day over the requested horizon.
+// It replays pending scheduled payments, upcoming recurring expenses, instalments owed to the space
+// and expected income, and flags the days an account drops below zero or below its threshold.
+func (s *Service) ForecastCashFlow(ctx context.Context, req *ForecastCashFlowRequest) (*CashFlowForecast, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
 
-			// Mark inbox item as resolved and link it
-			item.Status = InboxItemResolved
-			tIDStr := string(txn.ID)
-			item.TransactionID = &tIDStr
-			if err := s.deps.InboxItemStore.Update(ctx, item); err != nil {
-				return nil, fmt.Errorf("resolve inbox item: %w", err)
-			}
-			return item, nil
-		}
+	loc := time.UTC
+	settings, err := s.deps.SettingsStore.GetByID(ctx, req.SpaceID)
+	if err != nil && !errors.Is(err, ErrSettingsNotFound) {
+		return nil, err
 	}
+	if settings != nil {
+		loc = settings.Location()
+	}
+	local := req.Now.In(loc)
+	start := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 0, int(req.Days))
 
-	// 4. Otherwise: create a standalone ledger transaction or a transfer
-	transactionType := item.MetadataString("transaction_type")
-	destinationAccountID := item.MetadataString("destination_account_id")
-	transferLeg := item.MetadataString("transfer_leg")
-	if item.DocType == InboxItemDocInvoice {
-		spID, err := NewScheduledPaymentID()
+	activeOnly := true
+	var accounts []*Account
+	accFilter := &ListAccountsFilter{ActiveOnly: &activeOnly, PageSize: 100}
+	for {
+		page, err := s.deps.AccountStore.ListBySpace(ctx, req.SpaceID, accFilter)
 		if err != nil {
-			return nil, err
+			return nil, fmt.Errorf("list accounts: %w", err)
 		}
-		var bID BudgetID
-		if item.BudgetID != nil && *item.BudgetID != "" {
-			bID, err = ParseBudgetID(*item.BudgetID)
-			if err != nil {
-				return nil, fmt.Errorf("parse budget ID: %w", err)
-			}
+		accounts = append(accounts, page.Items...)
+		if page.NextPageToken == "" {
+			break
 		}
-
-		dueDate := item.TransactionDate
-		if dueDate.IsZero() {
-			dueDate = time.Now().UTC()
+		accFilter.NextPageToken = page.NextPageToken
+	}
+
+	f := &forecaster{
+		svc:      s,
+		spaceID:  req.SpaceID,
+		loc:      loc,
+		now:      req.Now,
+		start:    start,
+		end:      end,
+		accounts: make(map[AccountID]*Account, len(accounts)),
+		budgets:  make(map[BudgetID]*AccountID),
+		rates:    make(map[[2]Currency]float64),
+	}
+	for _, acc := range accounts {
+		f.accounts[acc.ID] = acc
+		if acc.IsDefault {
+			f.defaultAccount = &acc.ID
 		}
+	}
 
-		desc := item.VendorName
-		metaMap := map[string]any{
-			"vendor_name": desc,
-		}
-		var metaBytes []byte
-		if b, err := json.Marshal(metaMap); err == nil {
-			metaBytes = b
+	for _, collect := range []func(context.Context) error{
+		f.collectScheduledPayments,
+		f.collectRecurringExpenses,
+		f.collectLoanInstallments,
+		f.collectIncome,
+	} {
+		if err := collect(ctx); err != nil {
+			return nil, err
 		}
+	}
 
-		payment := &ScheduledPayment{
-			ID:         spID,
-			SpaceID:    SpaceID(spaceID),
-			BudgetID:   bID,
-			SourceType: "invoice",
-			SourceID:   item.ID,
-			Amount:     item.Amount,
-			Currency:   Currency(item.Currency),
-			DueDate:    dueDate,
-			Status:     ScheduledPaymentPending,
-			Metadata:   metaBytes,
-			CreateTime: time.Now().UTC(),
-			UpdateTime: time.Now().UTC(),
-		}
+	forecast := SimulateCashFlow(accounts, f.events, start, int(req.Days))
+	forecast.Only(req.AccountIDs)
+	return forecast, nil
+}
 
-		if err := payment.Validate(); err != nil {
-			return nil, fmt.Errorf("validate new scheduled payment: %w", err)
-		}
+// forecaster gathers the projected events of one space for ForecastCashFlow.
+type forecaster struct {
+	svc            *Service
+	spaceID        SpaceID
+	loc            *time.Location
+	now            time.Time
+	start, end     time.Time // Calendar dates; end is exclusive
+	accounts       map[AccountID]*Account
+	defaultAccount *AccountID
+	budgets        map[BudgetID]*AccountID // Resolved default account per budget
+	rates          map[[2]Currency]float64 // Latest known rate per currency pair
+	events         []*ForecastEvent
+}
 
-		if err := s.deps.ScheduledPaymentStore.Create(ctx, payment); err != nil {
-			return nil, fmt.Errorf("create scheduled payment: %w", err)
-		}
+// inWindow reports whether the calendar date of t falls inside the forecast.
+func (f *forecaster) inWindow(t time.Time) bool {
+	day := daysBetween(f.start, t)
+	return day >= 0 && day < daysBetween(f.start, f.end)
+}
 
-		// Mark inbox item as resolved and link it
-		item.Status = InboxItemResolved
-		pIDStr := string(payment.ID)
-		item.ScheduledPaymentID = &pIDStr
-		if err := s.deps.InboxItemStore.Update(ctx, item); err != nil {
-			return nil, fmt.Errorf("resolve inbox item: %w", err)
+// budgetAccount returns the account a budget pays from, falling back to the space default account.
+func (f *forecaster) budgetAccount(ctx context.Context, budgetID BudgetID) (*AccountID, error) {
+	if accID, ok := f.budgets[budgetID]; ok {
+		return accID, nil
+	}
+	accID := f.defaultAccount
+	budget, err := f.svc.deps.BudgetStore.GetByID(ctx, f.spaceID, budgetID)
+	switch {
+	case err == nil:
+		if budget.DefaultAccountID != nil && f.accounts[*budget.DefaultAccountID] != nil {
+			accID = budget.DefaultAccountID
 		}
+	case !errors.Is(err, ErrBudgetNotFound):
+		return nil, fmt.Errorf("get budget %s: %w", budgetID, err)
+	}
+	f.budgets[budgetID] = accID
+	return accID, nil
+}
 
-		return item, nil
+// add records an event after converting its amount into the account currency.
+func (f *forecaster) add(ctx context.Context, e *ForecastEvent, currency Currency) error {
+	if e.AccountID != nil {
+		acc, ok := f.accounts[*e.AccountID]
+		if !ok {
+			e.AccountID = nil
+		} else if acc.Currency != currency {
+			rate, err := f.rate(ctx, currency, acc.Currency)
+			if err != nil {
+				return err
+			}
+			e.Amount = ConvertAmount(e.Amount, currency, acc.Currency, rate)
+		}
 	}
+	f.events = append(f.events, e)
+	return nil
+}
 
-	settings, err := s.deps.SettingsStore.GetByID(ctx, SpaceID(spaceID))
+func (f *forecaster) rate(ctx context.Context, from, to Currency) (float64, error) {
+	pair := [2]Currency{from, to}
+	if rate, ok := f.rates[pair]; ok {
+		return rate, nil
+	}
+	record, err := f.svc.getExchangeRate(ctx, ExchangeRateKey{
+		SpaceID:      f.spaceID,
+		FromCurrency: from,
+		ToCurrency:   to,
+		RateDate:     f.now,
+	})
 	if err != nil {
-		return nil, err
+		return 0, fmt.Errorf("get exchange rate %s/%s: %w", from, to, err)
 	}
+	f.rates[pair] = record.Rate
+	return record.Rate, nil
+}
 
-	if transactionType == "TRANSFER" {
-		if item.AccountID == nil || *item.AccountID == "" {
-			return nil, fmt.Errorf("missing source account for transfer")
-		}
-		srcAccID, err := ParseAccountID(*item.AccountID)
+// collectScheduledPayments projects pending payments due before the end of the window.
+// Overdue payments are assumed to be paid today.
+func (f *forecaster) collectScheduledPayments(ctx context.Context) error {
+	status := ScheduledPaymentPending
+	endDate := f.end.AddDate(0, 0, -1)
+	filter := &ListScheduledPaymentsFilter{Status: &status, EndDate: &endDate, PageSize: 100}
+	for {
+		page, err := f.svc.deps.ScheduledPaymentStore.ListBySpace(ctx, f.spaceID, filter)
 		if err != nil {
-			return nil, fmt.Errorf("invalid source account: %w", err)
+			return fmt.Errorf("list scheduled payments: %w", err)
 		}
-		if destinationAccountID == "" {
-			return nil, fmt.Errorf("missing destination account for transfer")
+		for _, p := range page.Items {
+			if err := f.addScheduledPayment(ctx, p); err != nil {
+				return err
+			}
 		}
-		destAccID, err := ParseAccountID(destinationAccountID)
-		if err != nil {
-			return nil, fmt.Errorf("invalid destination account: %w", err)
+		if page.NextPageToken == "" {
+			return nil
 		}
+		filter.NextPageToken = page.NextPageToken
+	}
+}
 
-		tDate := item.TransactionDate
-		if tDate.IsZero() {
-			tDate = time.Now().UTC()
-		}
+func (f *forecaster) addScheduledPayment(ctx context.Context, p *ScheduledPayment) error {
+	date := latestDate(p.DueDate, f.start)
+	if !f.inWindow(date) {
+		return nil
+	}
 
-		t := &Transfer{
-			SpaceID:              SpaceID(spaceID),
-			SourceAccountID:      srcAccID,
-			DestinationAccountID: destAccID,
-			SourceAmount:         item.Amount,
-			DestinationAmount:    item.Amount,
-			TransferDate:         tDate,
-			Notes:                item.VendorName,
-		}
-		newT, err := s.CreateTransfer(ctx, t)
-		if err != nil {
-			return nil, fmt.Errorf("create transfer: %w", err)
-		}
+	var meta struct {
+		Description string `json:"description"`
+		AccountID   string `json:"account_id"`
+	}
+	if len(p.Metadata) > 0 {
+		_ = json.Unmarshal(p.Metadata, &meta)
+	}
 
-		targetLeg := TransactionTypeTransferOut
-		targetAccID := srcAccID
-		if transferLeg == "DESTINATION" {
-			targetLeg = TransactionTypeTransferIn
-			targetAccID = destAccID
-		}
+	source, err := f.budgetAccount(ctx, p.BudgetID)
+	if err != nil {
+		return err
+	}
+	kind := ForecastEventScheduledPayment
+	switch p.SourceType {
+	case SourceTypeLoan:
+		kind = ForecastEventLoanInstallment
+	case SourceTypeCardStatement:
+		kind = ForecastEventCardPayment
+	}
+	if err := f.add(ctx, &ForecastEvent{
+		Date:        date,
+		AccountID:   source,
+		Kind:        kind,
+		SourceID:    string(p.ID),
+		Description: meta.Description,
+		Amount:      -p.Amount,
+	}, p.Currency); err != nil {
+		return err
+	}
 
-		page, err := s.deps.TransactionStore.ListBySpace(ctx, SpaceID(spaceID), &TransactionFilter{
-			TransferID: &newT.ID,
-		})
-		var txs []*Transaction
-		if err == nil {
-			txs = page.Items
+	// A card payment is a transfer: the card balance drops by the same amount.
+	if p.SourceType == SourceTypeCardStatement && meta.AccountID != "" {
+		card := AccountID(meta.AccountID)
+		return f.add(ctx, &ForecastEvent{
+			Date:        date,
+			AccountID:   &card,
+			Kind:        kind,
+			SourceID:    string(p.ID),
+			Description: meta.Description,
+			Amount:      p.Amount,
+		}, p.Currency)
+	}
+	return nil
+}
+
+// collectRecurringExpenses projects the occurrences of active recurring expenses that have not
+// been scheduled yet. Variable amounts are estimated from past payments.
+func (f *forecaster) collectRecurringExpenses(ctx context.Context) error {
+	status := RecurringExpenseActive
+	filter := &ListRecurringExpensesFilter{Status: &status, PageSize: 100}
+	for {
+		page, err := f.svc.deps.RecurringExpenseStore.ListBySpace(ctx, f.spaceID, filter)
+		if err != nil {
+			return fmt.Errorf("list recurring expenses: %w", err)
 		}
-		var matchedTx *Transaction
-		if err == nil {
-			for _, tx := range txs {
-				if tx.Type == targetLeg {
-					matchedTx = tx
-					break
-				}
+		for _, re := range page.Items {
+			if err := f.addRecurringExpense(ctx, re); err != nil {
+				return err
 			}
 		}
-
-		// Mark inbox item as resolved and link it
-		item.Status = InboxItemResolved
-		if matchedTx != nil {
-			tIDStr := string(matchedTx.ID)
-			item.TransactionID = &tIDStr
+		if page.NextPageToken == "" {
+			return nil
 		}
-		targetAccIDStr := string(targetAccID)
-		item.AccountID = &targetAccIDStr
+		filter.NextPageToken = page.NextPageToken
+	}
+}
 
-		if err := s.deps.InboxItemStore.Update(ctx, item); err != nil {
-			return nil, fmt.Errorf("resolve inbox item: %w", err)
+func (f *forecaster) addRecurringExpense(ctx context.Context, re *RecurringExpense) error {
+	amount, estimated := re.Amount, false
+	if re.IsVariable {
+		avg, ok, err := f.averageRecurringAmount(ctx, re)
+		if err != nil {
+			return err
+		}
+		if ok {
+			amount, estimated = avg, true
 		}
-
-		return item, nil
+	}
+	if amount <= 0 {
+		return nil
 	}
 
-	var txnType TransactionType
-	var budgetID *BudgetID
-	var periodID *PeriodID
+	accID, err := f.budgetAccount(ctx, re.BudgetID)
+	if err != nil {
+		return err
+	}
+	rc, err := re.Recurrence()
+	if err != nil {
+		return fmt.Errorf("recurrence of recurring expense %s: %w", re.ID, err)
+	}
+	// NextDueDate only moves once a scheduled payment exists, so these dates are not yet pending.
+	// Occurrences the generator has not caught up with are assumed to be paid today.
+	for due, ok := re.NextDueDate, true; ok && due.Before(f.end); due, ok = rc.After(due) {
+		if rc.excluded(due) {
+			continue
+		}
+		if err := f.add(ctx, &ForecastEvent{
+			Date:        latestDate(due, f.start),
+			AccountID:   accID,
+			Kind:        ForecastEventRecurringExpense,
+			SourceID:    string(re.ID),
+			Description: re.Name,
+			Amount:      -amount,
+			Estimated:   estimated,
+		}, re.Currency); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 
-	switch transactionType {
-	case "INCOME":
-		txnType = TransactionTypeIncome
-	case "EXPENSE":
-		txnType = TransactionTypeExpense
-		if item.BudgetID != nil && *item.BudgetID != "" {
-			bID, err := ParseBudgetID(*item.BudgetID)
-			if err != nil {
-				return nil, fmt.Errorf("parse budget ID: %w", err)
-			}
-			budget, err := s.deps.BudgetStore.GetByID(ctx, SpaceID(spaceID), bID)
-			if err != nil {
-				return nil, fmt.Errorf("get budget: %w", err)
-			}
-			period, err := s.GetOrCreatePeriod(ctx, SpaceID(spaceID), budget.ID, item.TransactionDate)
-			if err != nil {
-				return nil, fmt.Errorf("get or create period: %w", err)
-			}
-			budgetID = &budget.ID
-			periodID = &period.ID
+// averageRecurringAmount averages the most recent payments of a recurring expense in its currency.
+func (f *forecaster) averageRecurringAmount(ctx context.Context, re *RecurringExpense) (int64, bool, error) {
+	page, err := f.svc.deps.TransactionStore.ListBySpace(ctx, f.spaceID, &TransactionFilter{
+		RecurringExpenseID: &re.ID,
+		PageSize:           variableAmountSampleSize,
+		Sort:               sorting.SortOrder{Field: "transaction_date", Ascending: false},
+	})
+	if err != nil {
+		return 0, false, fmt.Errorf("list recurring expense payments: %w", err)
+	}
+	var total, count int64
+	for _, t := range page.Items {
+		if t.Currency != re.Currency {
+			continue
 		}
-	default:
-		if item.DocType == InboxItemDocReceipt || item.DocType == InboxItemDocInvoice {
-			txnType = TransactionTypeExpense
-		} else {
-			txnType = TransactionTypeIncome
+		total += t.Amount
+		count++
+	}
+	if count == 0 {
+		return 0, false, nil
+	}
+	return (total + count/2) / count, true, nil
+}
+
+// collectLoanInstallments projects the unpaid instalments of money lent by the space. Instalments
+// the space owes are already pending scheduled payments.
+func (f *forecaster) collectLoanInstallments(ctx context.Context) error {
+	status := BorrowingStatusActive
+	direction := BorrowingDirectionLent
+	filter := &ListBorrowingsFilter{Status: &status, Direction: &direction, PageSize: 100}
+	for {
+		borrowings, next, err := f.svc.deps.BorrowingStore.ListBySpace(ctx, f.spaceID, filter)
+		if err != nil {
+			return fmt.Errorf("list borrowings: %w", err)
 		}
-		if item.BudgetID != nil && *item.BudgetID != "" {
-			bID, err := ParseBudgetID(*item.BudgetID)
-			if err != nil {
-				return nil, fmt.Errorf("parse budget ID: %w", err)
-			}
-			budget, err := s.deps.BudgetStore.GetByID(ctx, SpaceID(spaceID), bID)
-			if err != nil {
-				return nil, fmt.Errorf("get budget: %w", err)
+		for _, b := range borrowings {
+			progress := b.ProgressAt(f.now)
+			if progress == nil {
+				continue
 			}
-			period, err := s.GetOrCreatePeriod(ctx, SpaceID(spaceID), budget.ID, item.TransactionDate)
-			if err != nil {
-				return nil, fmt.Errorf("get or create period: %w", err)
+			for _, entry := range progress.Schedule {
+				due := latestDate(entry.DueDate, f.start)
+				owed := entry.Payment - entry.PaidAmount
+				if owed <= 0 || !f.inWindow(due) {
+					continue
+				}
+				if err := f.add(ctx, &ForecastEvent{
+					Date:        due,
+					AccountID:   f.defaultAccount,
+					Kind:        ForecastEventLoanInstallment,
+					SourceID:    string(b.ID),
+					Description: fmt.Sprintf("%s instalment %d", b.Counterparty, entry.Number),
+					Amount:      owed,
+				}, b.Currency); err != nil {
+					return err
+				}
 			}
-			budgetID = &budget.ID
-			periodID = &period.ID
 		}
+		if next == "" {
+			return nil
+		}
+		filter.NextPageToken = next
 	}
+}
 
-	// Calculate base currency conversion
-	var rate = 1.0
-	if item.Currency != string(settings.BaseCurrency) {
-		rateRecord, err := s.getExchangeRate(ctx, ExchangeRateKey{
-			SpaceID:      SpaceID(spaceID),
-			FromCurrency: Currency(item.Currency),
-			ToCurrency:   settings.BaseCurrency,
-			RateDate:     item.TransactionDate,
-		})
-		if err == nil {
-			rate = rateRecord.Rate
+// collectIncome projects expected income, continuing each regular source's cadence from its last
+// recorded payment. Sources that were never received are skipped, since their pay date is unknown.
+func (f *forecaster) collectIncome(ctx context.Context) error {
+	activeOnly := true
+	filter := &ListIncomeSourcesFilter{ActiveOnly: &activeOnly, PageSize: 100}
+	for {
+		page, err := f.svc.deps.IncomeSourceStore.ListBySpace(ctx, f.spaceID, filter)
+		if err != nil {
+			return fmt.Errorf("list income sources: %w", err)
+		}
+		for _, src := range page.Items {
+			if err := f.addIncome(ctx, src); err != nil {
+				return err
+			}
 		}
+		if page.NextPageToken == "" {
+			return nil
+		}
+		filter.NextPageToken = page.NextPageToken
 	}
-	amountInBase := int64(float64(item.Amount) * rate)
+}
 
-	tID, err := NewTransactionID()
+func (f *forecaster) addIncome(ctx context.Context, src *IncomeSource) error {
+	if !src.IsActive || src.ExpectedAmount <= 0 || src.Cadence == IncomeCadenceIrregular {
+		return nil
+	}
+	if rc, ok, err := src.Recurrence(); err != nil {
+		return fmt.Errorf("recurrence of income source %s: %w", src.ID, err)
+	} else if ok {
+		return f.addScheduledIncome(ctx, src, rc)
+	}
+	page, err := f.svc.deps.TransactionStore.ListBySpace(ctx, f.spaceID, &TransactionFilter{
+		IncomeSourceID: &src.ID,
+		PageSize:       1,
+		Sort:           sorting.SortOrder{Field: "transaction_date", Ascending: false},
+	})
 	if err != nil {
-		return nil, err
+		return fmt.Errorf("list income payments: %w", err)
 	}
+	if len(page.Items) == 0 {
+		return nil
+	}
+	last := page.Items[0]
+	lastDate := last.TransactionDate.In(f.loc)
+	lastDay := time.Date(lastDate.Year(), lastDate.Month(), lastDate.Day(), 0, 0, 0, 0, time.UTC)
 
-	var accountID *AccountID
-	if item.AccountID != nil && *item.AccountID != "" {
-		accID, err := ParseAccountID(*item.AccountID)
-		if err == nil {
-			accountID = &accID
+	accID := src.AccountID
+	if accID == nil {
+		accID = last.AccountID
+	}
+	if accID == nil {
+		accID = f.defaultAccount
+	}
+
+	rule, err := RecurrenceRuleForInterval(string(src.Cadence))
+	if err != nil {
+		return nil
+	}
+	rc := &Recurrence{Rule: rule, Start: lastDay}
+	for pay, ok := rc.After(lastDay); ok && pay.Before(f.end); pay, ok = rc.After(pay) {
+		if pay.Before(f.start) {
+			continue
+		}
+		if err := f.addIncomeEvent(ctx, src, accID, pay); err != nil {
+			return err
 		}
 	}
+	return nil
+}
 
-	txDate := item.TransactionDate
-	if txDate.IsZero() {
-		txDate = time.Now().UTC()
+// addScheduledIncome projects the pay dates of a source with a recurrence rule. The dates are known,
+// so no payment history is needed.
+func (f *forecaster) addScheduledIncome(ctx context.Context, src *IncomeSource, rc *Recurrence) error {
+	accID := src.AccountID
+	if accID == nil {
+		accID = f.defaultAccount
+	}
+	for _, pay := range rc.Between(f.start, f.end.AddDate(0, 0, -1)) {
+		if err := f.addIncomeEvent(ctx, src, accID, pay); err != nil {
+			return err
+		}
 	}
+	return nil
+}
 
-	txn := &Transaction{
-		ID:              tID,
-		SpaceID:         SpaceID(spaceID),
-		Type:            txnType,
-		BudgetID:        budgetID,
-		PeriodID:        periodID,
-		AccountID:       accountID,
-		Amount:          item.Amount,
-		Currency:        Currency(item.Currency),
-		AmountInBase:    amountInBase,
-		Description:     item.VendorName,
-		TransactionDate: txDate,
-		CreateTime:      time.Now().UTC(),
-		UpdateTime:      time.Now().UTC(),
+func (f *forecaster) addIncomeEvent(ctx context.Context, src *IncomeSource, accID *AccountID, pay time.Time) error {
+	return f.add(ctx, &ForecastEvent{
+		Date:        pay,
+		AccountID:   accID,
+		Kind:        ForecastEventIncome,
+		SourceID:    string(src.ID),
+		Description: src.Name,
+		Amount:      src.ExpectedAmount,
+		Estimated:   true,
+	}, src.Currency)
+}
+
+// notifyTransactionChanged hands a stored expense to the TransactionNotifier so the alert rules
+// evaluate it.
+func (s *Service) notifyTransactionChanged(ctx context.Context, txn *Transaction) {
+	if s.deps.TransactionNotifier == nil || txn.Type != TransactionTypeExpense {
+		return
 	}
+	s.deps.TransactionNotifier.TransactionChanged(ctx, txn)
+}
 
-	if err := txn.Validate(); err != nil {
+// EvaluateTransactionAlerts runs the alert rules against an expense after it was created or changed,
+// stores the alerts it raises and returns the new ones. Conditions that already raised an alert are
+// not raised again, so re-evaluating the same transaction is safe.
+func (s *Service) EvaluateTransactionAlerts(ctx context.Context, spaceID SpaceID, txnID TransactionID, now time.Time) ([]*Alert, error) {
+	if err := spaceID.Validate(); err != nil {
+		return nil, fmt.Errorf("validate space ID: %w", err)
+	}
+	txn, err := s.deps.TransactionStore.GetByID(ctx, spaceID, txnID)
+	if err != nil {
+		if errors.Is(err, ErrTransactionNotFound) {
+			// Deleted before the evaluation ran; nothing left to check.
+			return nil, nil
+		}
 		return nil, err
 	}
+	if txn.Type != TransactionTypeExpense {
+		return nil, nil
+	}
 
-	if err := s.createTransaction(ctx, txn); err != nil {
+	settings, err := s.deps.SettingsStore.GetByID(ctx, spaceID)
+	if err != nil {
 		return nil, err
 	}
 
-	// Handle optional borrowing link for newly promoted transaction
-	if item.BorrowingID != nil && *item.BorrowingID != "" {
-		linkType := BorrowingLinkTypeInitialReceipt
-		if item.BorrowingLinkType != nil {
-			linkType = *item.BorrowingLinkType
+	// Duplicates are looked for on every expense; the budget rules run once per budget it is charged to.
+	duplicate, err := s.duplicateChargeAlert(ctx, settings, txn)
+	if err != nil {
+		return nil, err
+	}
+	candidates := []*Alert{duplicate}
+	for _, share := range txn.BudgetShares() {
+		budget, err := s.deps.BudgetStore.GetByID(ctx, spaceID, share.BudgetID)
+		if err != nil {
+			return nil, err
 		}
-		if err := s.handleBorrowingLinkForTransaction(ctx, SpaceID(spaceID), txn, *item.BorrowingID, linkType); err != nil {
-			return nil, fmt.Errorf("link borrowing to new transaction: %w", err)
+		budgetAlerts, err := s.budgetAlerts(ctx, settings, budget, txn, now)
+		if err != nil {
+			return nil, err
 		}
-	}
+		candidates = append(candidates, budgetAlerts...)
 
-	// Mark inbox item as resolved and link it
-	item.Status = InboxItemResolved
-	tIDStr := string(txn.ID)
-	item.TransactionID = &tIDStr
-	if err := s.deps.InboxItemStore.Update(ctx, item); err != nil {
-		return nil, fmt.Errorf("resolve inbox item: %w", err)
+		anomaly, err := s.amountAnomalyAlert(ctx, settings, budget, txn, share)
+		if err != nil {
+			return nil, err
+		}
+		candidates = append(candidates, anomaly)
 	}
 
-	return item, nil
+	var raised []*Alert
+	for _, a := range candidates {
+		if a == nil {
+			continue
+		}
+		aID, err := NewAlertID()
+		if err != nil {
+			return nil, err
+		}
+		a.ID = aID
+		a.SpaceID = spaceID
+		a.Status = AlertStatusOpen
+		a.CreateTime = now.UTC()
+		a.UpdateTime = a.CreateTime
+		if err := a.Validate(); err != nil {
+			return nil, err
+		}
+		created, err := s.deps.AlertStore.CreateIfAbsent(ctx, a)
+		if err != nil {
+			return nil, fmt.Errorf("store %s alert: %w", a.Rule, err)
+		}
+		if created {
+			raised = append(raised, a)
+		}
+	}
+	return raised, nil
 }
 
-func (s *Service) handleBorrowingLinkForTransaction(ctx context.Context, spaceID SpaceID, txn *Transaction, borrowingIDStr string, linkType BorrowingLinkType) error {
-	if borrowingIDStr == "" {
-		return nil
-	}
-	bID, err := ParseBorrowingID(borrowingIDStr)
+// budgetAlerts checks the expense's budget period against the configured usage percentages and
+// projects the spending pace to the end of the period.
+func (s *Service) budgetAlerts(ctx context.Context, settings *FinanceSettings, budget *Budget, txn *Transaction, now time.Time) ([]*Alert, error) {
+	period, err := s.GetOrCreatePeriod(ctx, txn.SpaceID, budget.ID, txn.EffectiveDate)
 	if err != nil {
-		return fmt.Errorf("invalid borrowing ID: %w", err)
+		return nil, err
 	}
-	borrowing, err := s.deps.BorrowingStore.GetByID(ctx, spaceID, bID)
+	limit := period.EffectiveLimit()
+	if limit <= 0 {
+		return nil, nil
+	}
+	_, spent, err := s.deps.TransactionStore.AggregateSpent(ctx, period.ID, period.Currency, period.ExchangeRateToBase)
 	if err != nil {
-		return fmt.Errorf("get borrowing: %w", err)
+		return nil, fmt.Errorf("aggregate period spending: %w", err)
 	}
 
-	if txn.Metadata.BorrowingID != nil {
-		if *txn.Metadata.BorrowingID != borrowing.ID {
-			return ErrCannotRelinkTransactionToDifferentBorrowing
+	var alerts []*Alert
+
+	// Only the highest percentage crossed is raised; lower ones are implied by it.
+	var crossed int32
+	for _, pct := range settings.AlertPercents() {
+		if spent*100 >= limit*int64(pct) {
+			crossed = max(crossed, pct)
 		}
-		// Already linked to this exact borrowing agreement (idempotent link attachment)
-		return nil
+	}
+	if crossed > 0 {
+		alerts = append(alerts, &Alert{
+			Rule:          AlertRuleBudgetThreshold,
+			Message:       fmt.Sprintf("%s reached %d%% of its limit", budget.Name, crossed),
+			BudgetID:      &budget.ID,
+			PeriodID:      &period.ID,
+			TransactionID: &txn.ID,
+			Amount:        spent,
+			Threshold:     limit * int64(crossed) / 100,
+			Currency:      period.Currency,
+			DedupKey:      fmt.Sprintf("budget_threshold:%s:%d", period.ID, crossed),
+		})
 	}
 
-	role := "INITIAL_FUNDING"
-	switch linkType {
-	case BorrowingLinkTypeInitialReceipt:
-		role = "INITIAL_FUNDING"
-
-	case BorrowingLinkTypeRepayment:
-		role = "REPAYMENT"
-		borrowing.RemainingAmount -= txn.Amount
-		if borrowing.RemainingAmount <= 0 {
-			borrowing.RemainingAmount = 0
-			borrowing.Status = BorrowingStatusPaidOff
-		}
-		borrowing.UpdateTime = time.Now().UTC()
-		if err := s.deps.BorrowingStore.Update(ctx, borrowing); err != nil {
-			return fmt.Errorf("update borrowing remaining balance: %w", err)
+	// Project the pace only for the running period, and only once it has a few days of data.
+	const minElapsedDays = 3
+	total := period.EndDate.Sub(period.StartDate).Hours() / 24
+	elapsed := now.Sub(period.StartDate).Hours() / 24
+	if spent < limit && elapsed >= minElapsedDays && now.Before(period.EndDate) && total > 0 {
+		projected := int64(math.Round(float64(spent) / elapsed * total))
+		if projected > limit {
+			alerts = append(alerts, &Alert{
+				Rule:          AlertRuleProjectedOverspend,
+				Message:       fmt.Sprintf("%s is on pace to exceed its limit before %s", budget.Name, period.EndDate.Format(time.DateOnly)),
+				BudgetID:      &budget.ID,
+				PeriodID:      &period.ID,
+				TransactionID: &txn.ID,
+				Amount:        projected,
+				Threshold:     limit,
+				Currency:      period.Currency,
+				DedupKey:      fmt.Sprintf("projected_overspend:%s", period.ID),
+			})
 		}
+	}
+	return alerts, nil
+}
 
-	case BorrowingLinkTypeAdditionalLoan:
-		role = "ADDITIONAL_LOAN"
-		borrowing.TotalAmount += txn.Amount
-		borrowing.RemainingAmount += txn.Amount
-		borrowing.UpdateTime = time.Now().UTC()
-		if err := s.deps.BorrowingStore.Update(ctx, borrowing); err != nil {
-			return fmt.Errorf("update borrowing total balance: %w", err)
-		}
+// amountAnomalyAlert flags an expense whose share of the budget is more than the configured number
+// of standard deviations above the budget's recent expenses. Amounts are compared in the base currency.
+func (s *Service) amountAnomalyAlert(ctx context.Context, settings *FinanceSettings, budget *Budget, txn *Transaction, share BudgetShare) (*Alert, error) {
+	expense := TransactionTypeExpense
+	before := txn.TransactionDate
+	page, err := s.deps.TransactionStore.ListBySpace(ctx, txn.SpaceID, &TransactionFilter{
+		BudgetID: &budget.ID,
+		Type:     &expense,
+		EndDate:  &before,
+		PageSize: anomalySampleSize + 1,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list budget history: %w", err)
 	}
 
-	// Update metadata with borrowing link details
-	txn.Metadata.BorrowingID = &borrowing.ID
-	txn.Metadata.BorrowingRole = role
-	txn.UpdateTime = time.Now().UTC()
+	samples := make([]float64, 0, len(page.Items))
+	for _, t := range page.Items {
+		if t.ID == txn.ID || len(samples) == anomalySampleSize {
+			continue
+		}
+		for _, sh := range t.BudgetShares() {
+			if sh.BudgetID == budget.ID {
+				samples = append(samples, float64(sh.AmountInBase))
+			}
+		}
+	}
+	if len(samples) < minAnomalySamples {
+		return nil, nil
+	}
 
-	if err := s.deps.TransactionStore.Update(ctx, txn); err != nil {
-		return fmt.Errorf("update transaction borrowing metadata: %w", err)
+	var sum float64
+	for _, v := range samples {
+		sum += v
 	}
+	mean := sum / float64(len(samples))
+	var variance float64
+	for _, v := range samples {
+		variance += (v - mean) * (v - mean)
+	}
+	// A floor of a tenth of the mean keeps perfectly regular histories from flagging small variations.
+	stdDev := max(math.Sqrt(variance/float64(len(samples))), mean/10)
 
-	return nil
+	expected := mean + settings.AnomalyThreshold()*stdDev
+	if float64(share.AmountInBase) <= expected {
+		return nil, nil
+	}
+	return &Alert{
+		Rule:          AlertRuleAmountAnomaly,
+		Message:       fmt.Sprintf("%q is unusually large for %s", txn.Description, budget.Name),
+		BudgetID:      &budget.ID,
+		PeriodID:      share.PeriodID,
+		TransactionID: &txn.ID,
+		Amount:        share.AmountInBase,
+		Threshold:     int64(math.Round(expected)),
+		Currency:      settings.BaseCurrency,
+		DedupKey:      fmt.Sprintf("amount_anomaly:%s:%s", txn.ID, budget.ID),
+	}, nil
 }
 
-func (s *Service) handleScheduledPaymentLinkForTransaction(ctx context.Context, spaceID SpaceID, txn *Transaction, paymentIDStr string) error {
-	if paymentIDStr == "" {
-		return nil
-	}
-	pID, err := ParseScheduledPaymentID(paymentIDStr)
-	if err != nil {
-		return fmt.Errorf("invalid scheduled payment ID: %w", err)
-	}
-	payment, err := s.deps.ScheduledPaymentStore.GetByID(ctx, spaceID, pID)
+// duplicateChargeAlert flags an expense matching another one of the same amount, currency, account
+// and description within the configured window.
+func (s *Service) duplicateChargeAlert(ctx context.Context, settings *FinanceSettings, txn *Transaction) (*Alert, error) {
+	window := settings.DuplicateWindow()
+	expense := TransactionTypeExpense
+	from, to := txn.TransactionDate.Add(-window), txn.TransactionDate.Add(window)
+	amount := txn.Amount
+	page, err := s.deps.TransactionStore.ListBySpace(ctx, txn.SpaceID, &TransactionFilter{
+		Type:      &expense,
+		AccountID: txn.AccountID,
+		MinAmount: &amount,
+		MaxAmount: &amount,
+		StartDate: &from,
+		EndDate:   &to,
+		PageSize:  20,
+	})
 	if err != nil {
-		return fmt.Errorf("get scheduled payment: %w", err)
+		return nil, fmt.Errorf("list similar charges: %w", err)
 	}
 
-	if txn.Metadata.ScheduledPaymentID != nil {
-		if *txn.Metadata.ScheduledPaymentID != payment.ID {
-			return ErrCannotRelinkTransactionToDifferentScheduledPayment
+	description := normalizeDescription(txn.Description)
+	var match *Transaction
+	for _, t := range page.Items {
+		if t.ID == txn.ID || t.Currency != txn.Currency || normalizeDescription(t.Description) != description {
+			continue
 		}
-		// Already linked to this exact scheduled payment (ensure payment is marked paid)
-		if payment.Status != ScheduledPaymentPaid {
-			payment.Status = ScheduledPaymentPaid
-			payment.UpdateTime = time.Now().UTC()
-			if err := s.deps.ScheduledPaymentStore.Update(ctx, payment); err != nil {
-				return fmt.Errorf("update scheduled payment status: %w", err)
-			}
+		if txn.AccountID == nil && t.AccountID != nil {
+			continue
+		}
+		if match == nil || t.TransactionDate.Before(match.TransactionDate) {
+			match = t
 		}
-		return nil
 	}
-
-	// Retroactively mark scheduled payment as paid and link transaction
-	payment.Status = ScheduledPaymentPaid
-	payment.UpdateTime = time.Now().UTC()
-	if err := s.deps.ScheduledPaymentStore.Update(ctx, payment); err != nil {
-		return fmt.Errorf("update scheduled payment status: %w", err)
+	if match == nil {
+		return nil, nil
 	}
 
-	txn.Metadata.ScheduledPaymentID = &payment.ID
-	txn.UpdateTime = time.Now().UTC()
-	if err := s.deps.TransactionStore.Update(ctx, txn); err != nil {
-		return fmt.Errorf("update transaction scheduled payment metadata: %w", err)
-	}
+	// Both charges of a pair map to the same key, whichever is evaluated first.
+	pair := []string{string(txn.ID), string(match.ID)}
+	slices.Sort(pair)
+	return &Alert{
+		Rule:                 AlertRuleDuplicateCharge,
+		Message:              fmt.Sprintf("%q looks like a duplicate of a charge on %s", txn.Description, match.TransactionDate.Format(time.DateOnly)),
+		BudgetID:             txn.BudgetID,
+		PeriodID:             txn.PeriodID,
+		TransactionID:        &txn.ID,
+		RelatedTransactionID: &match.ID,
+		Amount:               txn.Amount,
+		Currency:             txn.Currency,
+		DedupKey:             fmt.Sprintf("duplicate_charge:%s:%s", pair[0], pair[1]),
+	}, nil
+}
 
-	return nil
+// normalizeDescription folds case and whitespace so descriptions of the same merchant compare equal.
+func normalizeDescription(s string) string {
+	return strings.Join(strings.Fields(strings.ToLower(s)), " ")
 }
 
-// GetBudget retrieves a budget by its unique identifier for a space.
-func (s *Service) GetBudget(ctx context.Context, spaceID SpaceID, id BudgetID) (*Budget, error) {
+// GetAlert retrieves an alert by ID for a space.
+func (s *Service) GetAlert(ctx context.Context, spaceID SpaceID, id AlertID) (*Alert, error) {
 	if err := spaceID.Validate(); err != nil {
-		return nil, err
+		return nil, fmt.Errorf("validate space ID: %w", err)
 	}
 	if err := id.Validate(); err != nil {
-		return nil, err
+		return nil, fmt.Errorf("validate alert ID: %w", err)
 	}
-	return s.deps.BudgetStore.GetByID(ctx, spaceID, id)
+	return s.deps.AlertStore.GetByID(ctx, spaceID, id)
 }
 
-// GetBudgets retrieves a list of budgets by their identifiers for a space.
-func (s *Service) GetBudgets(ctx context.Context, spaceID SpaceID, ids []BudgetID) ([]*Budget, error) {
+// ListAlerts lists the alerts of a workspace, most recent first.
+func (s *Service) ListAlerts(ctx context.Context, spaceID SpaceID, filter *ListAlertsFilter) (*paging.Page[*Alert], error) {
 	if err := spaceID.Validate(); err != nil {
+		return nil, fmt.Errorf("validate space ID: %w", err)
+	}
+	if filter.ActiveOnly && filter.Now.IsZero() {
+		filter.Now = time.Now().UTC()
+	}
+	return s.deps.AlertStore.ListBySpace(ctx, spaceID, filter)
+}
+
+// AcknowledgeAlert marks an alert as handled so it leaves the active list for good.
+func (s *Service) AcknowledgeAlert(ctx context.Context, spaceID SpaceID, id AlertID, now time.Time) (*Alert, error) {
+	alert, err := s.GetAlert(ctx, spaceID, id)
+	if err != nil {
 		return nil, err
 	}
-	return s.deps.BudgetStore.GetByIDs(ctx, spaceID, ids)
+	alert.Acknowledge(now.UTC())
+	if err := s.deps.AlertStore.Update(ctx, alert); err != nil {
+		return nil, err
+	}
+	return alert, nil
+}
+
+// SnoozeAlert hides an alert from the active list until the given time.
+func (s *Service) SnoozeAlert(ctx context.Context, spaceID SpaceID, id AlertID, until, now time.Time) (*Alert, error) {
+	alert, err := s.GetAlert(ctx, spaceID, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := alert.Snooze(until.UTC(), now.UTC()); err != nil {
+		return nil, err
+	}
+	if err := s.deps.AlertStore.Update(ctx, alert); err != nil {
+		return nil, err
+	}
+	return alert, nil
 }
@@ -1,16 +1,21 @@
 package finance
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"fmt"
 	"math"
 	"slices"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/masterkeysrd/saturn/internal/platform/filter"
 	"github.com/masterkeysrd/saturn/internal/platform/id"
 	"github.com/masterkeysrd/saturn/internal/platform/paging"
+	"github.com/masterkeysrd/saturn/internal/platform/patch"
+	"github.com/masterkeysrd/saturn/internal/platform/sorting"
 )
 
 // --- In-Memory Mocks for Stores ---
@@ -32,6 +37,14 @@ func (m *mockSettingsStore) GetByID(ctx context.Context, spaceID SpaceID) (*Fina
 	return s, nil
 }
 
+func (m *mockSettingsStore) Update(ctx context.Context, settings *FinanceSettings) error {
+	if _, ok := m.data[settings.SpaceID]; !ok {
+		return ErrSettingsNotFound
+	}
+	m.data[settings.SpaceID] = settings
+	return nil
+}
+
 type mockBudgetStore struct {
 	data map[BudgetID]*Budget
 }
@@ -101,23 +114,33 @@ func (m *mockPeriodStore) Create(ctx context.Context, p *BudgetPeriod) error {
 	return nil
 }
 
-func (m *mockPeriodStore) GetByRange(ctx context.Context, budgetID BudgetID, startDate, endDate time.Time) (*BudgetPeriod, error) {
-	key := string(budgetID) + "_" + startDate.Format(time.RFC3339) + "_" + endDate.Format(time.RFC3339)
-	p, ok := m.data[key]
-	if !ok {
-		return nil, ErrPeriodNotFound
+func (m *mockPeriodStore) GetByDate(ctx context.Context, budgetID BudgetID, date time.Time) (*BudgetPeriod, error) {
+	for _, p := range m.data {
+		if p.BudgetID == budgetID && !p.StartDate.After(date) && !p.EndDate.Before(date) {
+			return p, nil
+		}
 	}
-	return p, nil
+	return nil, ErrPeriodNotFound
+}
+
+func (m *mockPeriodStore) GetByDates(ctx context.Context, budgetIDs []BudgetID, date time.Time) ([]*BudgetPeriod, error) {
+	var list []*BudgetPeriod
+	for _, budgetID := range budgetIDs {
+		if p, err := m.GetByDate(ctx, budgetID, date); err == nil {
+			list = append(list, p)
+		}
+	}
+	return list, nil
 }
 
-func (m *mockPeriodStore) GetByRanges(ctx context.Context, keys []PeriodRangeKey) ([]*BudgetPeriod, error) {
+func (m *mockPeriodStore) ListByRange(ctx context.Context, budgetID BudgetID, startDate, endDate time.Time) ([]*BudgetPeriod, error) {
 	var list []*BudgetPeriod
-	for _, key := range keys {
-		k := string(key.BudgetID) + "_" + key.StartDate.Format(time.RFC3339) + "_" + key.EndDate.Format(time.RFC3339)
-		if p, ok := m.data[k]; ok {
+	for _, p := range m.data {
+		if p.BudgetID == budgetID && !p.StartDate.After(endDate) && !p.EndDate.Before(startDate) {
 			list = append(list, p)
 		}
 	}
+	slices.SortFunc(list, func(a, b *BudgetPeriod) int { return a.StartDate.Compare(b.StartDate) })
 	return list, nil
 }
 
@@ -131,6 +154,17 @@ func (m *mockPeriodStore) UpdateLimit(ctx context.Context, id PeriodID, limit in
 	return ErrPeriodNotFound
 }
 
+func (m *mockPeriodStore) UpdateCarry(ctx context.Context, id PeriodID, carriedIn, carriedOut int64) error {
+	for _, p := range m.data {
+		if p.ID == id {
+			p.CarriedIn = carriedIn
+			p.CarriedOut = carriedOut
+			return nil
+		}
+	}
+	return ErrPeriodNotFound
+}
+
 func (m *mockPeriodStore) ListByBudget(ctx context.Context, budgetID BudgetID) ([]*BudgetPeriod, error) {
 	var list []*BudgetPeriod
 	for _, p := range m.data {
@@ -143,6 +177,11 @@ func (m *mockPeriodStore) ListByBudget(ctx context.Context, budgetID BudgetID) (
 
 type mockExchangeRateStore struct {
 	rates map[string]*ExchangeRate
+	pairs []*CurrencyPair
+}
+
+func (m *mockExchangeRateStore) ListCurrencyPairs(ctx context.Context) ([]*CurrencyPair, error) {
+	return m.pairs, nil
 }
 
 func (m *mockExchangeRateStore) Create(ctx context.Context, r *ExchangeRate) error {
@@ -247,7 +286,10 @@ func (m *mockExchangeRateStore) GetLatestRates(ctx context.Context, spaceID Spac
 }
 
 type mockTransactionStore struct {
-	txns map[TransactionID]*Transaction
+	txns     map[TransactionID]*Transaction
+	accounts *mockAccountStore // Optional; receives the balance deltas of batches
+	periods  *mockPeriodStore  // Optional; receives the budget periods created by batches
+	batches  int
 }
 
 func (m *mockTransactionStore) Create(ctx context.Context, t *Transaction) error {
@@ -268,7 +310,7 @@ func (m *mockTransactionStore) HasTransactions(ctx context.Context, spaceID Spac
 		if t.SpaceID != spaceID {
 			continue
 		}
-		if filter != nil && filter.BudgetID != nil && (t.BudgetID == nil || *t.BudgetID != *filter.BudgetID) {
+		if filter != nil && filter.BudgetID != nil && !mockTxnHasBudget(t, *filter.BudgetID) {
 			continue
 		}
 		return true, nil
@@ -276,6 +318,18 @@ func (m *mockTransactionStore) HasTransactions(ctx context.Context, spaceID Spac
 	return false, nil
 }
 
+func mockTxnHasBudget(t *Transaction, budgetID BudgetID) bool {
+	if t.BudgetID != nil && *t.BudgetID == budgetID {
+		return true
+	}
+	for _, split := range t.Splits {
+		if split.BudgetID != nil && *split.BudgetID == budgetID {
+			return true
+		}
+	}
+	return false
+}
+
 func (m *mockTransactionStore) Delete(ctx context.Context, id TransactionID) error {
 	if _, ok := m.txns[id]; !ok {
 		return ErrTransactionNotFound
@@ -296,12 +350,27 @@ func (m *mockTransactionStore) ListBySpace(ctx context.Context, spaceID SpaceID,
 	var list []*Transaction
 	for _, t := range m.txns {
 		if t.SpaceID == spaceID {
-			if filter.BudgetID != nil && (t.BudgetID == nil || *t.BudgetID != *filter.BudgetID) {
+			if filter.BudgetID != nil && !mockTxnHasBudget(t, *filter.BudgetID) {
 				continue
 			}
 			if filter.Type != nil && t.Type != *filter.Type {
 				continue
 			}
+			if filter.AccountID != nil && (t.AccountID == nil || *t.AccountID != *filter.AccountID) {
+				continue
+			}
+			if filter.TransferID != nil && (t.Metadata.TransferID == nil || *t.Metadata.TransferID != *filter.TransferID) {
+				continue
+			}
+			if filter.BorrowingID != nil && (t.Metadata.BorrowingID == nil || string(*t.Metadata.BorrowingID) != *filter.BorrowingID) {
+				continue
+			}
+			if filter.IncomeSourceID != nil && (t.Metadata.IncomeSourceID == nil || *t.Metadata.IncomeSourceID != *filter.IncomeSourceID) {
+				continue
+			}
+			if filter.RecurringExpenseID != nil && (t.Metadata.RecurringExpenseID == nil || *t.Metadata.RecurringExpenseID != *filter.RecurringExpenseID) {
+				continue
+			}
 			if filter.MinAmount != nil && t.Amount < *filter.MinAmount {
 				continue
 			}
@@ -333,12 +402,15 @@ func (m *mockTransactionStore) AggregateSpent(ctx context.Context, periodID Peri
 	var spentInBase int64
 	var spentAmount int64
 	for _, t := range m.txns {
-		if t.PeriodID != nil && *t.PeriodID == periodID {
-			spentInBase += t.AmountInBase
+		for _, share := range t.BudgetShares() {
+			if share.PeriodID == nil || *share.PeriodID != periodID {
+				continue
+			}
+			spentInBase += share.AmountInBase
 			if t.Currency == budgetCurrency {
-				spentAmount += t.Amount
+				spentAmount += share.Amount
 			} else if exchangeRateToBase > 0 {
-				spentAmount += int64(math.Round(float64(t.AmountInBase) / exchangeRateToBase))
+				spentAmount += int64(math.Round(float64(share.AmountInBase) / exchangeRateToBase))
 			}
 		}
 	}
@@ -365,10 +437,87 @@ func (m *mockTransactionStore) AggregateSpentBatch(ctx context.Context, periodID
 	return results, nil
 }
 
+func (m *mockTransactionStore) ListForReconciliation(ctx context.Context, spaceID SpaceID, accountID AccountID, reconciliationID *ReconciliationID, before time.Time) ([]*Transaction, error) {
+	var list []*Transaction
+	for _, t := range m.txns {
+		if t.SpaceID != spaceID || t.AccountID == nil || *t.AccountID != accountID || !t.TransactionDate.Before(before) {
+			continue
+		}
+		if reconciliationID == nil && t.IsReconciled() {
+			continue
+		}
+		if reconciliationID != nil && (t.ReconciliationID == nil || *t.ReconciliationID != *reconciliationID) {
+			continue
+		}
+		list = append(list, t)
+	}
+	slices.SortFunc(list, func(a, b *Transaction) int { return a.TransactionDate.Compare(b.TransactionDate) })
+	return list, nil
+}
+
+func (m *mockTransactionStore) ListForGoal(ctx context.Context, spaceID SpaceID, accountID *AccountID, tag string, since time.Time) ([]*Transaction, error) {
+	var list []*Transaction
+	for _, t := range m.txns {
+		if t.SpaceID != spaceID || t.TransactionDate.Before(since) {
+			continue
+		}
+		transfer := t.Type == TransactionTypeTransferIn || t.Type == TransactionTypeTransferOut
+		if (accountID != nil && transfer && t.AccountID != nil && *t.AccountID == *accountID) || (tag != "" && slices.Contains(t.Tags, tag)) {
+			list = append(list, t)
+		}
+	}
+	slices.SortFunc(list, func(a, b *Transaction) int { return a.TransactionDate.Compare(b.TransactionDate) })
+	return list, nil
+}
+
+func (m *mockTransactionStore) SetCleared(ctx context.Context, spaceID SpaceID, ids []TransactionID, cleared bool) error {
+	for _, id := range ids {
+		if t, ok := m.txns[id]; ok && !t.IsReconciled() {
+			t.Cleared = cleared
+		}
+	}
+	return nil
+}
+
+func (m *mockTransactionStore) MarkReconciled(ctx context.Context, spaceID SpaceID, ids []TransactionID, reconciliationID ReconciliationID) error {
+	for _, id := range ids {
+		if t, ok := m.txns[id]; ok {
+			rID := reconciliationID
+			t.Cleared = true
+			t.ReconciliationID = &rID
+		}
+	}
+	return nil
+}
+
+func (m *mockTransactionStore) ApplyBatch(ctx context.Context, b *TransactionBatch) error {
+	m.batches++
+	if m.periods != nil {
+		for _, p := range b.Periods {
+			_ = m.periods.Create(ctx, p)
+		}
+	}
+	for _, t := range b.Updated {
+		m.txns[t.ID] = t
+	}
+	for _, id := range b.Deleted {
+		delete(m.txns, id)
+	}
+	if m.accounts != nil {
+		for accountID, delta := range b.BalanceDeltas {
+			m.accounts.data[accountID].CurrentBalance += delta
+		}
+	}
+	return nil
+}
+
 type mockInsightsStore struct {
 	spentTrend         []*SpentTrend
 	budgetDistribution []*BudgetDistribution
 	topExpenses        []*TopExpense
+	cashFlowTrend      []*CashFlowTrend
+	categories         []*CategoryDistribution
+	tags               []*TagDistribution
 	err                error
 }
 
@@ -384,2162 +533,5809 @@ func (m *mockInsightsStore) GetTopExpenses(ctx context.Context, filter *TopExpen
 	return m.topExpenses, m.err
 }
 
-type mockAccountStore struct {
-	data map[AccountID]*Account
+func (m *mockInsightsStore) GetCashFlowTrend(ctx context.Context, filter *CashFlowTrendFilter) ([]*CashFlowTrend, error) {
+	return m.cashFlowTrend, m.err
 }
 
-func (m *mockAccountStore) Create(ctx context.Context, a *Account) error {
-	m.data[a.ID] = a
+func (m *mockInsightsStore) GetCategoryDistribution(ctx context.Context, filter *CategoryDistributionFilter) ([]*CategoryDistribution, error) {
+	return m.categories, m.err
+}
+
+func (m *mockInsightsStore) GetTagDistribution(ctx context.Context, filter *TagDistributionFilter) ([]*TagDistribution, error) {
+	return m.tags, m.err
+}
+
+type mockCategoryStore struct {
+	data map[CategoryID]*Category
+}
+
+func (m *mockCategoryStore) Create(ctx context.Context, c *Category) error {
+	m.data[c.ID] = c
 	return nil
 }
 
-func (m *mockAccountStore) GetByID(ctx context.Context, spaceID SpaceID, id AccountID) (*Account, error) {
-	a, ok := m.data[id]
-	if !ok || a.SpaceID != spaceID {
-		return nil, ErrAccountNotFound
+func (m *mockCategoryStore) GetByID(ctx context.Context, spaceID SpaceID, id CategoryID) (*Category, error) {
+	c, ok := m.data[id]
+	if !ok || c.SpaceID != spaceID {
+		return nil, ErrCategoryNotFound
 	}
-	return a, nil
+	return c, nil
 }
 
-func (m *mockAccountStore) Update(ctx context.Context, a *Account) error {
-	if _, ok := m.data[a.ID]; !ok {
-		return ErrAccountNotFound
+func (m *mockCategoryStore) Update(ctx context.Context, c *Category) error {
+	if _, ok := m.data[c.ID]; !ok {
+		return ErrCategoryNotFound
 	}
-	m.data[a.ID] = a
+	m.data[c.ID] = c
 	return nil
 }
 
-func (m *mockAccountStore) Delete(ctx context.Context, id AccountID) error {
+func (m *mockCategoryStore) Delete(ctx context.Context, spaceID SpaceID, id CategoryID) error {
 	if _, ok := m.data[id]; !ok {
-		return ErrAccountNotFound
+		return ErrCategoryNotFound
 	}
 	delete(m.data, id)
 	return nil
 }
 
-func (m *mockAccountStore) ListBySpace(ctx context.Context, spaceID SpaceID, filter *ListAccountsFilter) (*paging.Page[*Account], error) {
-	var list []*Account
-	for _, a := range m.data {
-		if a.SpaceID == spaceID {
-			if filter != nil && filter.ActiveOnly != nil && *filter.ActiveOnly && !a.IsActive {
-				continue
-			}
-			if filter != nil && filter.LastFour != nil && *filter.LastFour != "" && a.LastFour != *filter.LastFour {
-				continue
-			}
-			list = append(list, a)
+func (m *mockCategoryStore) ListBySpace(ctx context.Context, spaceID SpaceID, filter *ListCategoriesFilter) (*paging.Page[*Category], error) {
+	var list []*Category
+	for _, c := range m.data {
+		if c.SpaceID == spaceID {
+			list = append(list, c)
 		}
 	}
-	return &paging.Page[*Account]{
-		Items: list,
-	}, nil
+	return &paging.Page[*Category]{Items: list}, nil
 }
 
-func (m *mockAccountStore) HasDefault(ctx context.Context, spaceID SpaceID) (bool, error) {
-	for _, a := range m.data {
-		if a.SpaceID == spaceID && a.IsDefault {
-			return true, nil
-		}
+type mockIncomeSourceStore struct {
+	data map[IncomeSourceID]*IncomeSource
+}
+
+func (m *mockIncomeSourceStore) Create(ctx context.Context, src *IncomeSource) error {
+	m.data[src.ID] = src
+	return nil
+}
+
+func (m *mockIncomeSourceStore) GetByID(ctx context.Context, spaceID SpaceID, id IncomeSourceID) (*IncomeSource, error) {
+	src, ok := m.data[id]
+	if !ok || src.SpaceID != spaceID {
+		return nil, ErrIncomeSourceNotFound
 	}
-	return false, nil
+	return src, nil
 }
 
-func (m *mockAccountStore) GetByIDs(ctx context.Context, spaceID SpaceID, ids []AccountID) ([]*Account, error) {
-	var list []*Account
-	for _, id := range ids {
-		if a, ok := m.data[id]; ok && a.SpaceID == spaceID {
-			list = append(list, a)
-		}
+func (m *mockIncomeSourceStore) Update(ctx context.Context, src *IncomeSource) error {
+	if _, ok := m.data[src.ID]; !ok {
+		return ErrIncomeSourceNotFound
 	}
-	return list, nil
+	m.data[src.ID] = src
+	return nil
 }
 
-func (m *mockAccountStore) UnsetDefaultsExcept(ctx context.Context, spaceID SpaceID, id AccountID) error {
-	for _, a := range m.data {
-		if a.SpaceID == spaceID && a.ID != id {
-			a.IsDefault = false
-		}
+func (m *mockIncomeSourceStore) Delete(ctx context.Context, spaceID SpaceID, id IncomeSourceID) error {
+	if _, ok := m.data[id]; !ok {
+		return ErrIncomeSourceNotFound
 	}
+	delete(m.data, id)
 	return nil
 }
 
-func (m *mockAccountStore) HasAny(ctx context.Context, spaceID SpaceID) (bool, error) {
-	for _, a := range m.data {
-		if a.SpaceID == spaceID {
-			return true, nil
+func (m *mockIncomeSourceStore) ListBySpace(ctx context.Context, spaceID SpaceID, filter *ListIncomeSourcesFilter) (*paging.Page[*IncomeSource], error) {
+	var list []*IncomeSource
+	for _, src := range m.data {
+		if src.SpaceID == spaceID {
+			list = append(list, src)
 		}
 	}
-	return false, nil
+	return &paging.Page[*IncomeSource]{Items: list}, nil
 }
 
-type mockTransferStore struct {
-	data map[TransferID]*Transfer
+type mockGoalStore struct {
+	data map[GoalID]*Goal
 }
 
-func (m *mockTransferStore) Create(ctx context.Context, t *Transfer) error {
-	m.data[t.ID] = t
+func (m *mockGoalStore) Create(ctx context.Context, g *Goal) error {
+	m.data[g.ID] = g
 	return nil
 }
 
-func (m *mockTransferStore) GetByID(ctx context.Context, spaceID SpaceID, id TransferID) (*Transfer, error) {
-	t, ok := m.data[id]
-	if !ok {
-		return nil, ErrTransferNotFound
+func (m *mockGoalStore) GetByID(ctx context.Context, spaceID SpaceID, id GoalID) (*Goal, error) {
+	g, ok := m.data[id]
+	if !ok || g.SpaceID != spaceID {
+		return nil, ErrGoalNotFound
 	}
-	return t, nil
+	return g, nil
 }
 
-func (m *mockTransferStore) Delete(ctx context.Context, id TransferID) error {
-	if _, ok := m.data[id]; !ok {
-		return ErrTransferNotFound
+func (m *mockGoalStore) Update(ctx context.Context, g *Goal) error {
+	if _, ok := m.data[g.ID]; !ok {
+		return ErrGoalNotFound
 	}
-	delete(m.data, id)
+	m.data[g.ID] = g
 	return nil
 }
 
-func (m *mockTransferStore) ListBySpace(ctx context.Context, spaceID SpaceID, limit int32, pageToken string) ([]*Transfer, string, error) {
-	var list []*Transfer
-	for _, t := range m.data {
-		if t.SpaceID == spaceID {
-			list = append(list, t)
-		}
+func (m *mockGoalStore) Delete(ctx context.Context, spaceID SpaceID, id GoalID) error {
+	if _, ok := m.data[id]; !ok {
+		return ErrGoalNotFound
 	}
-	return list, "", nil
-}
-
-type mockTransactionEventStore struct {
-	events map[TransactionEventID]*TransactionEvent
-}
-
-func (m *mockTransactionEventStore) Create(ctx context.Context, e *TransactionEvent) error {
-	m.events[e.ID] = e
+	delete(m.data, id)
 	return nil
 }
 
-func (m *mockTransactionEventStore) ListByTransaction(ctx context.Context, spaceID SpaceID, txnID TransactionID) ([]*TransactionEvent, error) {
-	var list []*TransactionEvent
-	for _, e := range m.events {
-		if e.SpaceID == spaceID && e.TransactionID == txnID {
-			list = append(list, e)
+func (m *mockGoalStore) ListBySpace(ctx context.Context, spaceID SpaceID, filter *ListGoalsFilter) (*paging.Page[*Goal], error) {
+	var list []*Goal
+	for _, g := range m.data {
+		if g.SpaceID != spaceID {
+			continue
+		}
+		if filter.Status != nil && g.Status != *filter.Status {
+			continue
 		}
+		list = append(list, g)
 	}
-	return list, nil
+	return &paging.Page[*Goal]{Items: list}, nil
 }
 
-type mockBorrowingStore struct {
-	data map[BorrowingID]*Borrowing
+type mockSavedFilterStore struct {
+	data map[SavedFilterID]*SavedFilter
 }
 
-func (m *mockBorrowingStore) Create(ctx context.Context, b *Borrowing) error {
-	m.data[b.ID] = b
+func (m *mockSavedFilterStore) Create(ctx context.Context, f *SavedFilter) error {
+	m.data[f.ID] = f
 	return nil
 }
 
-func (m *mockBorrowingStore) GetByID(ctx context.Context, spaceID SpaceID, id BorrowingID) (*Borrowing, error) {
-	b, ok := m.data[id]
-	if !ok {
-		return nil, ErrBorrowingNotFound
+func (m *mockSavedFilterStore) GetByID(ctx context.Context, spaceID SpaceID, id SavedFilterID) (*SavedFilter, error) {
+	f, ok := m.data[id]
+	if !ok || f.SpaceID != spaceID {
+		return nil, ErrSavedFilterNotFound
 	}
-	return b, nil
+	return f, nil
 }
 
-func (m *mockBorrowingStore) GetByIDs(ctx context.Context, spaceID SpaceID, ids []BorrowingID) ([]*Borrowing, error) {
-	var list []*Borrowing
-	for _, id := range ids {
-		if b, ok := m.data[id]; ok {
-			list = append(list, b)
-		}
+func (m *mockSavedFilterStore) Update(ctx context.Context, f *SavedFilter) error {
+	if _, ok := m.data[f.ID]; !ok {
+		return ErrSavedFilterNotFound
 	}
-	return list, nil
-}
-
-func (m *mockBorrowingStore) Update(ctx context.Context, b *Borrowing) error {
-	m.data[b.ID] = b
+	m.data[f.ID] = f
 	return nil
 }
 
-func (m *mockBorrowingStore) Delete(ctx context.Context, id BorrowingID) error {
+func (m *mockSavedFilterStore) Delete(ctx context.Context, spaceID SpaceID, id SavedFilterID) error {
+	if _, ok := m.data[id]; !ok {
+		return ErrSavedFilterNotFound
+	}
 	delete(m.data, id)
 	return nil
 }
 
-func (m *mockBorrowingStore) ListBySpace(ctx context.Context, spaceID SpaceID, filter *ListBorrowingsFilter) ([]*Borrowing, string, error) {
-	var list []*Borrowing
-	for _, b := range m.data {
-		if b.SpaceID == spaceID {
-			list = append(list, b)
+func (m *mockSavedFilterStore) ListBySpace(ctx context.Context, spaceID SpaceID, filter *ListSavedFiltersFilter) (*paging.Page[*SavedFilter], error) {
+	var list []*SavedFilter
+	for _, f := range m.data {
+		if f.SpaceID == spaceID {
+			list = append(list, f)
 		}
 	}
-	return list, "", nil
+	return &paging.Page[*SavedFilter]{Items: list}, nil
 }
 
-type mockScheduledPaymentStore struct {
-	payments map[ScheduledPaymentID]*ScheduledPayment
+type mockPayeeStore struct {
+	data map[PayeeID]*Payee
 }
 
-func (m *mockScheduledPaymentStore) Create(ctx context.Context, payment *ScheduledPayment) error {
-	if m.payments == nil {
-		m.payments = make(map[ScheduledPaymentID]*ScheduledPayment)
-	}
-	m.payments[payment.ID] = payment
+func (m *mockPayeeStore) Create(ctx context.Context, p *Payee) error {
+	m.data[p.ID] = p
 	return nil
 }
 
-func (m *mockScheduledPaymentStore) GetByID(ctx context.Context, spaceID SpaceID, id ScheduledPaymentID) (*ScheduledPayment, error) {
-	p, ok := m.payments[id]
+func (m *mockPayeeStore) GetByID(ctx context.Context, spaceID SpaceID, id PayeeID) (*Payee, error) {
+	p, ok := m.data[id]
 	if !ok || p.SpaceID != spaceID {
-		return nil, ErrScheduledPaymentNotFound
+		return nil, ErrPayeeNotFound
 	}
 	return p, nil
 }
 
-func (m *mockScheduledPaymentStore) Update(ctx context.Context, payment *ScheduledPayment) error {
-	if _, ok := m.payments[payment.ID]; !ok {
-		return ErrScheduledPaymentNotFound
+func (m *mockPayeeStore) Update(ctx context.Context, p *Payee) error {
+	if _, ok := m.data[p.ID]; !ok {
+		return ErrPayeeNotFound
 	}
-	m.payments[payment.ID] = payment
+	m.data[p.ID] = p
 	return nil
 }
 
-func (m *mockScheduledPaymentStore) UpdateStatus(ctx context.Context, id ScheduledPaymentID, status ScheduledPaymentStatus) error {
-	p, ok := m.payments[id]
-	if !ok {
-		return ErrScheduledPaymentNotFound
+func (m *mockPayeeStore) Delete(ctx context.Context, spaceID SpaceID, id PayeeID) error {
+	if _, ok := m.data[id]; !ok {
+		return ErrPayeeNotFound
 	}
-	p.Status = status
-	return nil
-}
-
-func (m *mockScheduledPaymentStore) Delete(ctx context.Context, id ScheduledPaymentID) error {
-	delete(m.payments, id)
+	delete(m.data, id)
 	return nil
 }
 
-func (m *mockScheduledPaymentStore) ListBySpace(ctx context.Context, spaceID SpaceID, filter *ListScheduledPaymentsFilter) (*paging.Page[*ScheduledPayment], error) {
-	var list []*ScheduledPayment
-	for _, p := range m.payments {
+func (m *mockPayeeStore) ListBySpace(ctx context.Context, spaceID SpaceID, filter *ListPayeesFilter) (*paging.Page[*Payee], error) {
+	var list []*Payee
+	for _, p := range m.data {
 		if p.SpaceID == spaceID {
 			list = append(list, p)
 		}
 	}
-	return &paging.Page[*ScheduledPayment]{Items: list}, nil
+	return &paging.Page[*Payee]{Items: list}, nil
 }
 
-func (m *mockScheduledPaymentStore) HasScheduledPayments(ctx context.Context, spaceID SpaceID, filter *ListScheduledPaymentsFilter) (bool, error) {
-	for _, p := range m.payments {
-		if p.SpaceID == spaceID {
-			if filter != nil && filter.BudgetID != nil && p.BudgetID != *filter.BudgetID {
-				continue
-			}
-			return true, nil
-		}
+type mockTransactionRuleStore struct {
+	data map[TransactionRuleID]*TransactionRule
+}
+
+func (m *mockTransactionRuleStore) Create(ctx context.Context, r *TransactionRule) error {
+	m.data[r.ID] = r
+	return nil
+}
+
+func (m *mockTransactionRuleStore) GetByID(ctx context.Context, spaceID SpaceID, id TransactionRuleID) (*TransactionRule, error) {
+	r, ok := m.data[id]
+	if !ok || r.SpaceID != spaceID {
+		return nil, ErrTransactionRuleNotFound
 	}
-	return false, nil
+	return r, nil
 }
 
-// --- Test Cases ---
+func (m *mockTransactionRuleStore) Update(ctx context.Context, r *TransactionRule) error {
+	if _, ok := m.data[r.ID]; !ok {
+		return ErrTransactionRuleNotFound
+	}
+	m.data[r.ID] = r
+	return nil
+}
 
-func TestUpdateBudget(t *testing.T) {
-	ctx := context.Background()
-	spaceID := SpaceID("spc_2dE1V8ZqWz4eS2N9yX3bL1mK7pO")
-	bID, _ := NewBudgetID()
+func (m *mockTransactionRuleStore) Delete(ctx context.Context, spaceID SpaceID, id TransactionRuleID) error {
+	if _, ok := m.data[id]; !ok {
+		return ErrTransactionRuleNotFound
+	}
+	delete(m.data, id)
+	return nil
+}
 
-	budgetStore := &mockBudgetStore{data: make(map[BudgetID]*Budget)}
-	settingsStore := &mockSettingsStore{data: make(map[SpaceID]*FinanceSettings)}
-	_ = settingsStore.Create(ctx, &FinanceSettings{SpaceID: spaceID, BaseCurrency: "USD"})
+func (m *mockTransactionRuleStore) ListBySpace(ctx context.Context, spaceID SpaceID, filter *ListTransactionRulesFilter) (*paging.Page[*TransactionRule], error) {
+	var list []*TransactionRule
+	for _, r := range m.data {
+		if r.SpaceID != spaceID {
+			continue
+		}
+		if filter.ActiveOnly != nil && *filter.ActiveOnly && !r.IsActive {
+			continue
+		}
+		list = append(list, r)
+	}
+	return &paging.Page[*TransactionRule]{Items: list}, nil
+}
 
-	_ = budgetStore.Create(ctx, &Budget{
-		ID:          bID,
-		SpaceID:     spaceID,
-		Name:        "Dining Out",
-		LimitAmount: 30000,
-		Currency:    "USD",
-		Interval:    IntervalMonthly,
-		Version:     2,
-	})
+type mockLedgerExportStore struct {
+	data    map[LedgerExportID]*LedgerExport
+	content map[LedgerExportID][]byte
+}
 
-	svc := NewService(Dependencies{
-		BudgetStore:   budgetStore,
-		SettingsStore: settingsStore,
-	})
+func (m *mockLedgerExportStore) Create(ctx context.Context, e *LedgerExport) error {
+	m.data[e.ID] = e
+	return nil
+}
 
-	tests := []struct {
-		name      string
-		update    *Budget
-		mask      []string
-		wantErr   error
-		wantName  string
-		wantLimit int64
-	}{
-		{
-			name: "stale version returns ErrBudgetVersionMismatch",
-			update: &Budget{
-				ID:          bID,
-				SpaceID:     spaceID,
-				Name:        "Stale Update",
-				LimitAmount: 40000,
-				Version:     1,
-			},
-			wantErr: ErrBudgetVersionMismatch,
-		},
-		{
-			name: "valid matching version succeeds and updates fields",
-			update: &Budget{
-				ID:          bID,
-				SpaceID:     spaceID,
-				Name:        "Dining Out New",
-				LimitAmount: 35000,
-				Version:     2,
-			},
-			mask:      []string{"name", "limit_amount"},
-			wantErr:   nil,
-			wantName:  "Dining Out New",
-			wantLimit: 35000,
-		},
+func (m *mockLedgerExportStore) GetByID(ctx context.Context, spaceID SpaceID, id LedgerExportID) (*LedgerExport, error) {
+	e, ok := m.data[id]
+	if !ok || e.SpaceID != spaceID {
+		return nil, ErrLedgerExportNotFound
 	}
+	return e, nil
+}
 
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			res, err := svc.UpdateBudget(ctx, tt.update, tt.mask)
-			if tt.wantErr != nil {
-				if !errors.Is(err, tt.wantErr) {
-					t.Errorf("UpdateBudget error = %v, want %v", err, tt.wantErr)
-				}
-			} else {
-				if err != nil {
-					t.Fatalf("unexpected error: %v", err)
-				}
-				if res.Name != tt.wantName {
-					t.Errorf("Name = %s, want %s", res.Name, tt.wantName)
-				}
-				if res.LimitAmount != tt.wantLimit {
-					t.Errorf("LimitAmount = %d, want %d", res.LimitAmount, tt.wantLimit)
-				}
-			}
-		})
+func (m *mockLedgerExportStore) Update(ctx context.Context, e *LedgerExport) error {
+	if _, ok := m.data[e.ID]; !ok {
+		return ErrLedgerExportNotFound
 	}
+	m.data[e.ID] = e
+	return nil
 }
 
-func TestDeleteBudget(t *testing.T) {
-	ctx := context.Background()
-	spaceID := SpaceID("spc_2dE1V8ZqWz4eS2N9yX3bL1mK7pO")
+func (m *mockLedgerExportStore) ListBySpace(ctx context.Context, spaceID SpaceID, filter *ListLedgerExportsFilter) (*paging.Page[*LedgerExport], error) {
+	var list []*LedgerExport
+	for _, e := range m.data {
+		if e.SpaceID == spaceID {
+			list = append(list, e)
+		}
+	}
+	return &paging.Page[*LedgerExport]{Items: list}, nil
+}
 
-	bIDClean, _ := NewBudgetID()
-	bIDTxns, _ := NewBudgetID()
-	bIDSched, _ := NewBudgetID()
+func (m *mockLedgerExportStore) SaveContent(ctx context.Context, spaceID SpaceID, id LedgerExportID, content []byte) error {
+	if _, ok := m.data[id]; !ok {
+		return ErrLedgerExportNotFound
+	}
+	m.content[id] = content
+	return nil
+}
 
-	budgetStore := &mockBudgetStore{data: make(map[BudgetID]*Budget)}
-	txnStore := &mockTransactionStore{txns: make(map[TransactionID]*Transaction)}
-	schedStore := &mockScheduledPaymentStore{payments: make(map[ScheduledPaymentID]*ScheduledPayment)}
+func (m *mockLedgerExportStore) GetContent(ctx context.Context, spaceID SpaceID, id LedgerExportID) ([]byte, error) {
+	c, ok := m.content[id]
+	if !ok {
+		return nil, ErrLedgerExportNotFound
+	}
+	return c, nil
+}
 
-	_ = budgetStore.Create(ctx, &Budget{ID: bIDClean, SpaceID: spaceID, Name: "Clean Budget", IsActive: true})
-	_ = budgetStore.Create(ctx, &Budget{ID: bIDTxns, SpaceID: spaceID, Name: "Txn Budget", IsActive: true})
-	_ = budgetStore.Create(ctx, &Budget{ID: bIDSched, SpaceID: spaceID, Name: "Sched Budget", IsActive: true})
+// recordingTransactionStore captures the last filter passed to ListBySpace.
+type recordingTransactionStore struct {
+	*mockTransactionStore
+	lastFilter *TransactionFilter
+}
 
-	_ = txnStore.Create(ctx, &Transaction{
-		ID:       TransactionID("txn_1"),
-		SpaceID:  spaceID,
-		BudgetID: &bIDTxns,
-		Type:     TransactionTypeExpense,
-		Amount:   500,
-	})
+func (m *recordingTransactionStore) ListBySpace(ctx context.Context, spaceID SpaceID, filter *TransactionFilter) (*paging.Page[*Transaction], error) {
+	m.lastFilter = filter
+	return m.mockTransactionStore.ListBySpace(ctx, spaceID, filter)
+}
 
-	_ = schedStore.Create(ctx, &ScheduledPayment{
-		ID:       ScheduledPaymentID("sch_1"),
-		SpaceID:  spaceID,
-		BudgetID: bIDSched,
-		Status:   ScheduledPaymentPending,
-	})
+type mockAccountStore struct {
+	data map[AccountID]*Account
+}
 
-	svc := NewService(Dependencies{
-		BudgetStore:           budgetStore,
-		TransactionStore:      txnStore,
-		ScheduledPaymentStore: schedStore,
-	})
+func (m *mockAccountStore) Create(ctx context.Context, a *Account) error {
+	m.data[a.ID] = a
+	return nil
+}
 
-	tests := []struct {
-		name    string
-		bID     BudgetID
-		wantErr error
-	}{
-		{
-			name:    "deleting budget with active transactions fails",
-			bID:     bIDTxns,
-			wantErr: ErrBudgetHasTransactions,
-		},
-		{
-			name:    "deleting budget with active scheduled payments fails",
-			bID:     bIDSched,
-			wantErr: ErrBudgetHasScheduledPayments,
-		},
-		{
-			name:    "deleting clean budget succeeds",
-			bID:     bIDClean,
-			wantErr: nil,
-		},
+func (m *mockAccountStore) GetByID(ctx context.Context, spaceID SpaceID, id AccountID) (*Account, error) {
+	a, ok := m.data[id]
+	if !ok || a.SpaceID != spaceID {
+		return nil, ErrAccountNotFound
 	}
+	return a, nil
+}
 
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			err := svc.DeleteBudget(ctx, spaceID, tt.bID, DeleteOptions{})
-			if tt.wantErr != nil {
-				if !errors.Is(err, tt.wantErr) {
-					t.Errorf("DeleteBudget error = %v, want %v", err, tt.wantErr)
-				}
-			} else if err != nil {
-				t.Errorf("unexpected DeleteBudget error: %v", err)
-			}
-		})
+func (m *mockAccountStore) Update(ctx context.Context, a *Account) error {
+	if _, ok := m.data[a.ID]; !ok {
+		return ErrAccountNotFound
 	}
+	m.data[a.ID] = a
+	return nil
 }
 
-func TestGetScheduledPayment(t *testing.T) {
-	ctx := context.Background()
-	spaceID := SpaceID("spc_2dE1V8ZqWz4eS2N9yX3bL1mK7pO")
-	spID := ScheduledPaymentID("sch_2dE1V8ZqWz4eS2N9yX3bL1mK7pO")
+func (m *mockAccountStore) Delete(ctx context.Context, id AccountID) error {
+	if _, ok := m.data[id]; !ok {
+		return ErrAccountNotFound
+	}
+	delete(m.data, id)
+	return nil
+}
 
-	mockStore := &mockScheduledPaymentStore{
-		payments: map[ScheduledPaymentID]*ScheduledPayment{
-			spID: {
-				ID:       spID,
-				SpaceID:  spaceID,
-				Amount:   1500,
-				Currency: Currency("USD"),
-				Status:   ScheduledPaymentPending,
-			},
-		},
+func (m *mockAccountStore) ListBySpace(ctx context.Context, spaceID SpaceID, filter *ListAccountsFilter) (*paging.Page[*Account], error) {
+	var list []*Account
+	for _, a := range m.data {
+		if a.SpaceID == spaceID {
+			if filter != nil && filter.ActiveOnly != nil && *filter.ActiveOnly && !a.IsActive {
+				continue
+			}
+			if filter != nil && filter.LastFour != nil && *filter.LastFour != "" && a.LastFour != *filter.LastFour {
+				continue
+			}
+			list = append(list, a)
+		}
 	}
+	return &paging.Page[*Account]{
+		Items: list,
+	}, nil
+}
 
-	svc := NewService(Dependencies{
-		ScheduledPaymentStore: mockStore,
-	})
+func (m *mockAccountStore) HasDefault(ctx context.Context, spaceID SpaceID) (bool, error) {
+	for _, a := range m.data {
+		if a.SpaceID == spaceID && a.IsDefault {
+			return true, nil
+		}
+	}
+	return false, nil
+}
 
-	tests := []struct {
-		name    string
-		spaceID SpaceID
-		id      ScheduledPaymentID
-		wantErr bool
-	}{
-		{
-			name:    "valid query returns scheduled payment",
-			spaceID: spaceID,
-			id:      spID,
-			wantErr: false,
-		},
-		{
-			name:    "invalid space ID returns validation error",
-			spaceID: SpaceID("invalid"),
-			id:      spID,
-			wantErr: true,
-		},
-		{
-			name:    "invalid scheduled payment ID returns validation error",
-			spaceID: spaceID,
-			id:      ScheduledPaymentID("invalid"),
-			wantErr: true,
-		},
-		{
-			name:    "missing scheduled payment returns not found error",
-			spaceID: spaceID,
-			id:      ScheduledPaymentID("sch_2dE1V8ZqWz4eS2N9yX3bL999999"),
-			wantErr: true,
-		},
+func (m *mockAccountStore) GetByIDs(ctx context.Context, spaceID SpaceID, ids []AccountID) ([]*Account, error) {
+	var list []*Account
+	for _, id := range ids {
+		if a, ok := m.data[id]; ok && a.SpaceID == spaceID {
+			list = append(list, a)
+		}
 	}
+	return list, nil
+}
 
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			got, err := svc.GetScheduledPayment(ctx, tt.spaceID, tt.id)
-			if tt.wantErr {
-				if err == nil {
-					t.Errorf("GetScheduledPayment expected error, got nil")
-				}
-			} else {
-				if err != nil {
-					t.Errorf("unexpected GetScheduledPayment error: %v", err)
-				}
-				if got == nil || got.ID != spID {
-					t.Errorf("GetScheduledPayment got = %v, want ID %s", got, spID)
-				}
-			}
-		})
+func (m *mockAccountStore) UnsetDefaultsExcept(ctx context.Context, spaceID SpaceID, id AccountID) error {
+	for _, a := range m.data {
+		if a.SpaceID == spaceID && a.ID != id {
+			a.IsDefault = false
+		}
 	}
+	return nil
 }
 
-func TestGetOrCreatePeriod_MultiCurrencyRateResolution(t *testing.T) {
-	ctx := context.Background()
-	spaceID := SpaceID("spc_2dE1V8ZqWz4eS2N9yX3bL1mK7pO")
-	bID, _ := NewBudgetID()
-	now := time.Now().UTC()
-
-	budgetStore := &mockBudgetStore{data: make(map[BudgetID]*Budget)}
-	settingsStore := &mockSettingsStore{data: make(map[SpaceID]*FinanceSettings)}
-	periodStore := &mockPeriodStore{data: make(map[string]*BudgetPeriod)}
-	rateStore := &mockExchangeRateStore{rates: make(map[string]*ExchangeRate)}
-
-	_ = settingsStore.Create(ctx, &FinanceSettings{SpaceID: spaceID, BaseCurrency: "USD"})
-	_ = budgetStore.Create(ctx, &Budget{
-		ID:          bID,
-		SpaceID:     spaceID,
-		Name:        "Travel EUR",
-		LimitAmount: 100000,
-		Currency:    "EUR",
-		Interval:    IntervalMonthly,
-		IsActive:    true,
-	})
-
-	svc := NewService(Dependencies{
-		BudgetStore:       budgetStore,
-		SettingsStore:     settingsStore,
-		PeriodStore:       periodStore,
-		ExchangeRateStore: rateStore,
-	})
-
-	t.Run("missing exchange rate returns validation error", func(t *testing.T) {
-		_, err := svc.GetOrCreatePeriod(ctx, spaceID, bID, now)
-		if err == nil || !strings.Contains(err.Error(), "exchange rate must be greater than zero") {
-			t.Fatalf("expected exchange rate error, got %v", err)
+func (m *mockAccountStore) HasAny(ctx context.Context, spaceID SpaceID) (bool, error) {
+	for _, a := range m.data {
+		if a.SpaceID == spaceID {
+			return true, nil
 		}
-	})
-
-	t.Run("registered exchange rate sets rate on period", func(t *testing.T) {
-		_ = rateStore.Create(ctx, &ExchangeRate{
-			SpaceID:      spaceID,
-			FromCurrency: "EUR",
-			ToCurrency:   "USD",
-			Rate:         1.10,
-			RateDate:     now,
-		})
+	}
+	return false, nil
+}
 
-		// Create period for next month with registered rate
-		nextMonth := now.AddDate(0, 1, 0)
-		p, err := svc.GetOrCreatePeriod(ctx, spaceID, bID, nextMonth)
-		if err != nil {
-			t.Fatalf("GetOrCreatePeriod failed: %v", err)
-		}
-		if p.ExchangeRateToBase != 1.10 {
-			t.Errorf("ExchangeRateToBase = %f, want 1.10", p.ExchangeRateToBase)
+func (m *mockAccountStore) ListWithStatementCycle(ctx context.Context) ([]*Account, error) {
+	var list []*Account
+	for _, a := range m.data {
+		if a.IsActive && a.HasStatementCycle() {
+			list = append(list, a)
 		}
-	})
+	}
+	return list, nil
 }
 
-// --- Test Cases ---
+type mockCardStatementStore struct {
+	data map[CardStatementID]*CardStatement
+}
 
-func TestCalculateBounds(t *testing.T) {
-	tests := []struct {
-		name      string
-		interval  RecurrenceInterval
-		date      time.Time
-		wantStart time.Time
-		wantEnd   time.Time
-	}{
-		{
-			name:      "monthly bounds calculation",
-			interval:  IntervalMonthly,
-			date:      time.Date(2026, 2, 15, 12, 30, 0, 0, time.UTC),
-			wantStart: time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC),
-			wantEnd:   time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC).Add(-time.Second),
-		},
-		{
-			name:      "yearly bounds calculation",
-			interval:  IntervalYearly,
-			date:      time.Date(2026, 5, 20, 10, 0, 0, 0, time.UTC),
-			wantStart: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
-			wantEnd:   time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC).Add(-time.Second),
-		},
-		{
-			name:      "weekly bounds calculation (Wednesday mid-week)",
-			interval:  IntervalWeekly,
-			date:      time.Date(2026, 2, 18, 15, 0, 0, 0, time.UTC), // Feb 18 is Wednesday
-			wantStart: time.Date(2026, 2, 16, 0, 0, 0, 0, time.UTC),  // Monday is Feb 16
-			wantEnd:   time.Date(2026, 2, 23, 0, 0, 0, 0, time.UTC).Add(-time.Second),
-		},
+func (m *mockCardStatementStore) Create(ctx context.Context, st *CardStatement) error {
+	if m.data == nil {
+		m.data = make(map[CardStatementID]*CardStatement)
 	}
+	m.data[st.ID] = st
+	return nil
+}
 
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			b := &Budget{Interval: tt.interval}
-			start, end := b.CalculateBounds(tt.date)
-			if !start.Equal(tt.wantStart) {
-				t.Errorf("start date = %s, want %s", start, tt.wantStart)
-			}
-			if !end.Equal(tt.wantEnd) {
-				t.Errorf("end date = %s, want %s", end, tt.wantEnd)
-			}
-		})
+func (m *mockCardStatementStore) GetByID(ctx context.Context, spaceID SpaceID, id CardStatementID) (*CardStatement, error) {
+	st, ok := m.data[id]
+	if !ok || st.SpaceID != spaceID {
+		return nil, ErrCardStatementNotFound
 	}
+	return st, nil
 }
 
-func TestConfigureFinance(t *testing.T) {
-	settingsStore := &mockSettingsStore{data: make(map[SpaceID]*FinanceSettings)}
-	svc := NewService(Dependencies{
-		SettingsStore:         settingsStore,
-		AccountStore:          &mockAccountStore{data: make(map[AccountID]*Account)},
-		TransferStore:         &mockTransferStore{data: make(map[TransferID]*Transfer)},
-		TransactionEventStore: &mockTransactionEventStore{events: make(map[TransactionEventID]*TransactionEvent)},
-	})
-
-	spIDStr, _ := id.Generate("spc_")
-	spID := SpaceID(spIDStr)
-
-	settings := &FinanceSettings{
-		SpaceID:      spID,
-		BaseCurrency: Currency("USD"),
+func (m *mockCardStatementStore) Update(ctx context.Context, st *CardStatement) error {
+	if _, ok := m.data[st.ID]; !ok {
+		return ErrCardStatementNotFound
 	}
+	m.data[st.ID] = st
+	return nil
+}
 
-	res, err := svc.ConfigureFinance(context.Background(), settings)
-	if err != nil {
-		t.Fatal(err)
+func (m *mockCardStatementStore) GetLatest(ctx context.Context, spaceID SpaceID, accountID AccountID) (*CardStatement, error) {
+	list, _ := m.ListByAccount(ctx, spaceID, accountID, nil)
+	if len(list) == 0 {
+		return nil, ErrCardStatementNotFound
 	}
+	return list[0], nil
+}
 
-	if res.BaseCurrency != Currency("USD") {
-		t.Errorf("BaseCurrency = %s, want USD", res.BaseCurrency)
+func (m *mockCardStatementStore) ListByAccount(ctx context.Context, spaceID SpaceID, accountID AccountID, filter *ListCardStatementsFilter) ([]*CardStatement, error) {
+	var list []*CardStatement
+	for _, st := range m.data {
+		if st.SpaceID != spaceID || st.AccountID != accountID {
+			continue
+		}
+		if filter != nil && filter.UnpaidOnly && st.Status == CardStatementStatusPaid {
+			continue
+		}
+		list = append(list, st)
 	}
+	slices.SortFunc(list, func(a, b *CardStatement) int { return b.ClosingDate.Compare(a.ClosingDate) })
+	return list, nil
+}
 
-	// Verify settings exist
-	retrieved, err := settingsStore.GetByID(context.Background(), spID)
-	if err != nil {
-		t.Fatal(err)
-	}
-	if retrieved.BaseCurrency != Currency("USD") {
-		t.Errorf("stored BaseCurrency = %s, want USD", retrieved.BaseCurrency)
-	}
+type mockTransferStore struct {
+	data map[TransferID]*Transfer
+}
 
-	// Verify base currency cannot be modified (immutable test)
-	newSettings := &FinanceSettings{
-		SpaceID:      spID,
-		BaseCurrency: Currency("EUR"),
-	}
-	res2, err := svc.ConfigureFinance(context.Background(), newSettings)
-	if err != nil {
-		t.Fatal(err)
+func (m *mockTransferStore) Create(ctx context.Context, t *Transfer) error {
+	m.data[t.ID] = t
+	return nil
+}
+
+func (m *mockTransferStore) GetByID(ctx context.Context, spaceID SpaceID, id TransferID) (*Transfer, error) {
+	t, ok := m.data[id]
+	if !ok {
+		return nil, ErrTransferNotFound
 	}
-	if res2.BaseCurrency != Currency("USD") {
-		t.Errorf("immutable currency got updated to %s", res2.BaseCurrency)
+	return t, nil
+}
+
+func (m *mockTransferStore) Delete(ctx context.Context, id TransferID) error {
+	if _, ok := m.data[id]; !ok {
+		return ErrTransferNotFound
 	}
+	delete(m.data, id)
+	return nil
 }
 
-func TestGetOrCreatePeriod(t *testing.T) {
-	settingsStore := &mockSettingsStore{data: make(map[SpaceID]*FinanceSettings)}
-	budgetStore := &mockBudgetStore{data: make(map[BudgetID]*Budget)}
-	periodStore := &mockPeriodStore{data: make(map[string]*BudgetPeriod)}
-	rateStore := &mockExchangeRateStore{rates: make(map[string]*ExchangeRate)}
+func (m *mockTransferStore) ListBySpace(ctx context.Context, spaceID SpaceID, limit int32, pageToken string) ([]*Transfer, string, error) {
+	var list []*Transfer
+	for _, t := range m.data {
+		if t.SpaceID == spaceID {
+			list = append(list, t)
+		}
+	}
+	return list, "", nil
+}
 
-	txnStore := &mockTransactionStore{txns: make(map[TransactionID]*Transaction)}
+type mockTransactionEventStore struct {
+	events map[TransactionEventID]*TransactionEvent
+}
 
-	svc := NewService(Dependencies{
-		SettingsStore:         settingsStore,
-		BudgetStore:           budgetStore,
-		PeriodStore:           periodStore,
-		ExchangeRateStore:     rateStore,
-		TransactionStore:      txnStore,
-		InsightsStore:         &mockInsightsStore{},
-		AccountStore:          &mockAccountStore{data: make(map[AccountID]*Account)},
-		TransferStore:         &mockTransferStore{data: make(map[TransferID]*Transfer)},
-		TransactionEventStore: &mockTransactionEventStore{events: make(map[TransactionEventID]*TransactionEvent)},
-	})
+func (m *mockTransactionEventStore) Create(ctx context.Context, e *TransactionEvent) error {
+	m.events[e.ID] = e
+	return nil
+}
 
-	ctx := context.Background()
-	spIDStr, _ := id.Generate("spc_")
-	spID := SpaceID(spIDStr)
-
-	// 1. Setup workspace base currency
-	_, err := svc.ConfigureFinance(ctx, &FinanceSettings{SpaceID: spID, BaseCurrency: Currency("USD")})
-	if err != nil {
-		t.Fatal(err)
+func (m *mockTransactionEventStore) ListByTransaction(ctx context.Context, spaceID SpaceID, txnID TransactionID) ([]*TransactionEvent, error) {
+	var list []*TransactionEvent
+	for _, e := range m.events {
+		if e.SpaceID == spaceID && e.TransactionID == txnID {
+			list = append(list, e)
+		}
 	}
+	return list, nil
+}
 
-	bgtIDStr, _ := id.Generate("bgt_")
-	bgtID := BudgetID(bgtIDStr)
-
-	// 2. Setup budget template (EUR budget)
-	budget, err := svc.CreateBudget(ctx, &Budget{
-		ID:          bgtID,
-		SpaceID:     spID,
-		Name:        "Dining",
-		LimitAmount: 50000, // 500.00 EUR
-		Currency:    Currency("EUR"),
-		Interval:    IntervalMonthly,
-	})
-	if err != nil {
-		t.Fatal(err)
-	}
+type mockBorrowingStore struct {
+	data map[BorrowingID]*Borrowing
+}
 
-	// 3. Set up exchange rate (EUR to USD) for Feb 15
-	err = rateStore.Create(ctx, &ExchangeRate{
-		SpaceID:      spID,
-		FromCurrency: Currency("EUR"),
-		ToCurrency:   Currency("USD"),
-		Rate:         1.085,
-		RateDate:     time.Date(2026, 2, 10, 0, 0, 0, 0, time.UTC), // Pre-existing rate
-	})
-	if err != nil {
-		t.Fatal(err)
-	}
+func (m *mockBorrowingStore) Create(ctx context.Context, b *Borrowing) error {
+	m.data[b.ID] = b
+	return nil
+}
 
-	// 4. Trigger JIT period creation
-	targetDate := time.Date(2026, 2, 15, 12, 0, 0, 0, time.UTC)
-	period, err := svc.GetOrCreatePeriod(ctx, spID, budget.ID, targetDate)
-	if err != nil {
-		t.Fatal(err)
+func (m *mockBorrowingStore) GetByID(ctx context.Context, spaceID SpaceID, id BorrowingID) (*Borrowing, error) {
+	b, ok := m.data[id]
+	if !ok {
+		return nil, ErrBorrowingNotFound
 	}
+	return b, nil
+}
 
-	if period.LimitAmount != 50000 {
-		t.Errorf("period limit = %d, want 50000", period.LimitAmount)
-	}
-	if period.ExchangeRateToBase != 1.085 {
-		t.Errorf("period rate = %f, want 1.085", period.ExchangeRateToBase)
-	}
-	if period.BaseCurrency != Currency("USD") {
-		t.Errorf("period base currency = %s, want USD", period.BaseCurrency)
+func (m *mockBorrowingStore) GetByIDs(ctx context.Context, spaceID SpaceID, ids []BorrowingID) ([]*Borrowing, error) {
+	var list []*Borrowing
+	for _, id := range ids {
+		if b, ok := m.data[id]; ok {
+			list = append(list, b)
+		}
 	}
+	return list, nil
+}
 
-	// 5. Query again (should return the same period without recreating)
-	period2, err := svc.GetOrCreatePeriod(ctx, spID, budget.ID, targetDate)
-	if err != nil {
-		t.Fatal(err)
-	}
-	if period2.ID != period.ID {
-		t.Errorf("re-queried period ID = %s, want %s", period2.ID, period.ID)
-	}
+func (m *mockBorrowingStore) Update(ctx context.Context, b *Borrowing) error {
+	m.data[b.ID] = b
+	return nil
 }
 
-func TestTransactions(t *testing.T) {
-	settingsStore := &mockSettingsStore{data: make(map[SpaceID]*FinanceSettings)}
-	budgetStore := &mockBudgetStore{data: make(map[BudgetID]*Budget)}
-	periodStore := &mockPeriodStore{data: make(map[string]*BudgetPeriod)}
-	rateStore := &mockExchangeRateStore{rates: make(map[string]*ExchangeRate)}
-	txnStore := &mockTransactionStore{txns: make(map[TransactionID]*Transaction)}
+func (m *mockBorrowingStore) Delete(ctx context.Context, id BorrowingID) error {
+	delete(m.data, id)
+	return nil
+}
 
-	svc := NewService(Dependencies{
-		SettingsStore:         settingsStore,
-		BudgetStore:           budgetStore,
-		PeriodStore:           periodStore,
-		ExchangeRateStore:     rateStore,
-		TransactionStore:      txnStore,
-		InsightsStore:         &mockInsightsStore{},
-		AccountStore:          &mockAccountStore{data: make(map[AccountID]*Account)},
-		TransferStore:         &mockTransferStore{data: make(map[TransferID]*Transfer)},
-		TransactionEventStore: &mockTransactionEventStore{events: make(map[TransactionEventID]*TransactionEvent)},
-	})
+func (m *mockBorrowingStore) ListBySpace(ctx context.Context, spaceID SpaceID, filter *ListBorrowingsFilter) ([]*Borrowing, string, error) {
+	var list []*Borrowing
+	for _, b := range m.data {
+		if b.SpaceID != spaceID {
+			continue
+		}
+		if filter != nil && filter.Status != nil && b.Status != *filter.Status {
+			continue
+		}
+		if filter != nil && filter.Direction != nil && b.Direction != *filter.Direction {
+			continue
+		}
+		list = append(list, b)
+	}
+	return list, "", nil
+}
 
-	ctx := context.Background()
-	spIDStr, _ := id.Generate("spc_")
-	spID := SpaceID(spIDStr)
+type mockScheduledPaymentStore struct {
+	payments map[ScheduledPaymentID]*ScheduledPayment
+}
 
-	// 1. Setup settings
-	_, err := svc.ConfigureFinance(ctx, &FinanceSettings{SpaceID: spID, BaseCurrency: Currency("USD")})
-	if err != nil {
-		t.Fatal(err)
+func (m *mockScheduledPaymentStore) Create(ctx context.Context, payment *ScheduledPayment) error {
+	if m.payments == nil {
+		m.payments = make(map[ScheduledPaymentID]*ScheduledPayment)
 	}
+	m.payments[payment.ID] = payment
+	return nil
+}
 
-	// 2. Setup budget
-	bgtIDStr, _ := id.Generate("bgt_")
-	budget, err := svc.CreateBudget(ctx, &Budget{
-		ID:          BudgetID(bgtIDStr),
-		SpaceID:     spID,
-		Name:        "Food",
-		LimitAmount: 20000,
-		Currency:    Currency("EUR"),
-		Interval:    IntervalMonthly,
-	})
-	if err != nil {
-		t.Fatal(err)
+func (m *mockScheduledPaymentStore) GetByID(ctx context.Context, spaceID SpaceID, id ScheduledPaymentID) (*ScheduledPayment, error) {
+	p, ok := m.payments[id]
+	if !ok || p.SpaceID != spaceID {
+		return nil, ErrScheduledPaymentNotFound
 	}
+	return p, nil
+}
 
-	// 3. Setup exchange rate (EUR to USD = 1.10)
-	rateDate := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
-	err = rateStore.Create(ctx, &ExchangeRate{
-		SpaceID:      spID,
-		FromCurrency: Currency("EUR"),
-		ToCurrency:   Currency("USD"),
-		Rate:         1.10,
-		RateDate:     rateDate,
-	})
-	if err != nil {
-		t.Fatal(err)
+func (m *mockScheduledPaymentStore) Update(ctx context.Context, payment *ScheduledPayment) error {
+	if _, ok := m.payments[payment.ID]; !ok {
+		return ErrScheduledPaymentNotFound
 	}
+	m.payments[payment.ID] = payment
+	return nil
+}
 
-	// 4. Create an expense of 10.00 EUR (1000 cents) on Feb 15
-	targetDate := time.Date(2026, 2, 15, 12, 0, 0, 0, time.UTC)
-	txn := &Transaction{
-		SpaceID:         spID,
-		BudgetID:        &budget.ID,
-		Amount:          1000,
-		Currency:        Currency("EUR"),
-		Description:     "Dinner",
-		TransactionDate: targetDate,
+func (m *mockScheduledPaymentStore) UpdateStatus(ctx context.Context, id ScheduledPaymentID, status ScheduledPaymentStatus) error {
+	p, ok := m.payments[id]
+	if !ok {
+		return ErrScheduledPaymentNotFound
 	}
+	p.Status = status
+	return nil
+}
 
-	createdTxn, err := svc.CreateExpense(ctx, txn)
-	if err != nil {
-		t.Fatal(err)
-	}
+func (m *mockScheduledPaymentStore) Delete(ctx context.Context, id ScheduledPaymentID) error {
+	delete(m.payments, id)
+	return nil
+}
 
-	if createdTxn.AmountInBase != 1100 { // 1000 * 1.10 = 1100
-		t.Errorf("AmountInBase = %d, want 1100", createdTxn.AmountInBase)
+func (m *mockScheduledPaymentStore) ListBySpace(ctx context.Context, spaceID SpaceID, filter *ListScheduledPaymentsFilter) (*paging.Page[*ScheduledPayment], error) {
+	var list []*ScheduledPayment
+	for _, p := range m.payments {
+		if p.SpaceID != spaceID {
+			continue
+		}
+		if filter != nil && filter.SourceType != nil && p.SourceType != *filter.SourceType {
+			continue
+		}
+		if filter != nil && filter.SourceID != nil && p.SourceID != *filter.SourceID {
+			continue
+		}
+		if filter != nil && filter.Status != nil && p.Status != *filter.Status {
+			continue
+		}
+		if filter != nil && filter.EndDate != nil && p.DueDate.After(*filter.EndDate) {
+			continue
+		}
+		list = append(list, p)
 	}
+	return &paging.Page[*ScheduledPayment]{Items: list}, nil
+}
 
-	period, err := svc.GetOrCreatePeriod(ctx, spID, budget.ID, targetDate)
-	if err != nil {
-		t.Fatal(err)
+func (m *mockScheduledPaymentStore) HasScheduledPayments(ctx context.Context, spaceID SpaceID, filter *ListScheduledPaymentsFilter) (bool, error) {
+	for _, p := range m.payments {
+		if p.SpaceID == spaceID {
+			if filter != nil && filter.BudgetID != nil && p.BudgetID != *filter.BudgetID {
+				continue
+			}
+			return true, nil
+		}
 	}
+	return false, nil
+}
 
-	// Verify the period spent progress calculates correctly in batch query
-	stats, err := svc.AggregateSpentBatch(ctx, []PeriodID{period.ID})
-	if err != nil {
-		t.Fatal(err)
-	}
-	if len(stats) != 1 {
-		t.Fatalf("expected 1 stats item, got %d", len(stats))
-	}
-	if stats[0].SpentInBase != 1100 {
-		t.Errorf("Period SpentInBase = %d, want 1100", stats[0].SpentInBase)
-	}
-	if stats[0].SpentAmount != 1000 { // 1100 / 1.10 = 1000
-		t.Errorf("Period SpentAmount = %d, want 1000", stats[0].SpentAmount)
-	}
+// --- Test Cases ---
 
-	// Update the expense to 15.00 EUR (1500 cents)
-	createdTxn.Amount = 1500
-	updatedTxn, err := svc.UpdateExpense(ctx, createdTxn)
-	if err != nil {
-		t.Fatal(err)
-	}
+func TestUpdateBudget(t *testing.T) {
+	ctx := context.Background()
+	spaceID := SpaceID("spc_2dE1V8ZqWz4eS2N9yX3bL1mK7pO")
+	bID, _ := NewBudgetID()
 
-	if updatedTxn.AmountInBase != 1650 { // 1500 * 1.10 = 1650
-		t.Errorf("Updated AmountInBase = %d, want 1650", updatedTxn.AmountInBase)
-	}
+	budgetStore := &mockBudgetStore{data: make(map[BudgetID]*Budget)}
+	settingsStore := &mockSettingsStore{data: make(map[SpaceID]*FinanceSettings)}
+	_ = settingsStore.Create(ctx, &FinanceSettings{SpaceID: spaceID, BaseCurrency: "USD"})
 
-	// Verify the period updated its spent aggregates to reflect new amount
-	stats2, err := svc.AggregateSpentBatch(ctx, []PeriodID{period.ID})
-	if err != nil {
-		t.Fatal(err)
-	}
-	if len(stats2) != 1 {
-		t.Fatalf("expected 1 stats item, got %d", len(stats2))
-	}
-	if stats2[0].SpentInBase != 1650 {
-		t.Errorf("Period SpentInBase = %d, want 1650", stats2[0].SpentInBase)
-	}
-
-	// 5. Delete transaction
-	err = svc.DeleteTransaction(ctx, spID, createdTxn.ID)
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	// Verify period spent is back to 0
-	stats3, err := svc.AggregateSpentBatch(ctx, []PeriodID{period.ID})
-	if err != nil {
-		t.Fatal(err)
-	}
-	if len(stats3) != 1 {
-		t.Fatalf("expected 1 stats item, got %d", len(stats3))
-	}
-	if stats3[0].SpentInBase != 0 {
-		t.Errorf("After delete, SpentInBase = %d, want 0", stats3[0].SpentInBase)
-	}
-}
-
-func TestExchangeRateFallback(t *testing.T) {
-	ctx := context.Background()
-	spaceID := SpaceID("test-space")
-
-	rateStore := &mockExchangeRateStore{rates: make(map[string]*ExchangeRate)}
-	settingsStore := &mockSettingsStore{data: make(map[SpaceID]*FinanceSettings)}
-
-	// Create service
-	svc := NewService(Dependencies{
-		ExchangeRateStore: rateStore,
-		SettingsStore:     settingsStore,
-	})
-
-	// Configure finance settings
-	_ = settingsStore.Create(ctx, &FinanceSettings{
-		SpaceID:      spaceID,
-		BaseCurrency: "USD",
+	_ = budgetStore.Create(ctx, &Budget{
+		ID:          bID,
+		SpaceID:     spaceID,
+		Name:        "Dining Out",
+		LimitAmount: 30000,
+		Currency:    "USD",
+		Interval:    IntervalMonthly,
+		Version:     2,
 	})
 
-	// Set an exchange rate for 2026-07-24 (today) and 2026-07-28 (future)
-	rate1 := &ExchangeRate{
-		SpaceID:      spaceID,
-		FromCurrency: "EUR",
-		ToCurrency:   "USD",
-		Rate:         1.10,
-		RateDate:     time.Date(2026, 7, 24, 0, 0, 0, 0, time.UTC),
-	}
-	_ = rateStore.Create(ctx, rate1)
-
-	rate2 := &ExchangeRate{
-		SpaceID:      spaceID,
-		FromCurrency: "EUR",
-		ToCurrency:   "USD",
-		Rate:         1.20,
-		RateDate:     time.Date(2026, 7, 28, 0, 0, 0, 0, time.UTC),
-	}
-	_ = rateStore.Create(ctx, rate2)
-
-	// Test Case 1: Exact date lookup (2026-07-24)
-	r, err := svc.getExchangeRate(ctx, ExchangeRateKey{
-		SpaceID:      spaceID,
-		FromCurrency: "EUR",
-		ToCurrency:   "USD",
-		RateDate:     time.Date(2026, 7, 24, 0, 0, 0, 0, time.UTC),
+	svc := NewService(Dependencies{
+		BudgetStore:   budgetStore,
+		SettingsStore: settingsStore,
 	})
-	if err != nil {
-		t.Fatalf("Test Case 1 failed: %v", err)
-	}
-	if r.Rate != 1.10 {
-		t.Errorf("Test Case 1: rate = %f, want 1.10", r.Rate)
-	}
 
-	// Test Case 2: Past date before any rates exist (e.g., 2026-07-20)
-	// It should fall back to the oldest future rate (which is Rate 1 on 2026-07-24)
-	r, err = svc.getExchangeRate(ctx, ExchangeRateKey{
-		SpaceID:      spaceID,
-		FromCurrency: "EUR",
-		ToCurrency:   "USD",
-		RateDate:     time.Date(2026, 7, 20, 0, 0, 0, 0, time.UTC),
-	})
-	if err != nil {
-		t.Fatalf("Test Case 2 failed: %v", err)
-	}
-	if r.Rate != 1.10 {
-		t.Errorf("Test Case 2: rate = %f, want 1.10 (oldest future rate)", r.Rate)
+	tests := []struct {
+		name      string
+		update    *Budget
+		mask      []string
+		wantErr   error
+		wantName  string
+		wantLimit int64
+	}{
+		{
+			name: "stale version returns ErrBudgetVersionMismatch",
+			update: &Budget{
+				ID:          bID,
+				SpaceID:     spaceID,
+				Name:        "Stale Update",
+				LimitAmount: 40000,
+				Version:     1,
+			},
+			wantErr: ErrBudgetVersionMismatch,
+		},
+		{
+			name: "valid matching version succeeds and updates fields",
+			update: &Budget{
+				ID:          bID,
+				SpaceID:     spaceID,
+				Name:        "Dining Out New",
+				LimitAmount: 35000,
+				Version:     2,
+			},
+			mask:      []string{"name", "limit_amount"},
+			wantErr:   nil,
+			wantName:  "Dining Out New",
+			wantLimit: 35000,
+		},
 	}
 
-	// Test Case 3: Future date (e.g., 2026-07-26)
-	// It should find the closest rate in the past (which is Rate 1 on 2026-07-24)
-	r, err = svc.getExchangeRate(ctx, ExchangeRateKey{
-		SpaceID:      spaceID,
-		FromCurrency: "EUR",
-		ToCurrency:   "USD",
-		RateDate:     time.Date(2026, 7, 26, 0, 0, 0, 0, time.UTC),
-	})
-	if err != nil {
-		t.Fatalf("Test Case 3 failed: %v", err)
-	}
-	if r.Rate != 1.10 {
-		t.Errorf("Test Case 3: rate = %f, want 1.10 (most recent past rate)", r.Rate)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := svc.UpdateBudget(ctx, tt.update, tt.mask)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("UpdateBudget error = %v, want %v", err, tt.wantErr)
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if res.Name != tt.wantName {
+					t.Errorf("Name = %s, want %s", res.Name, tt.wantName)
+				}
+				if res.LimitAmount != tt.wantLimit {
+					t.Errorf("LimitAmount = %d, want %d", res.LimitAmount, tt.wantLimit)
+				}
+			}
+		})
 	}
 }
 
-func TestAdjustAccountBalance(t *testing.T) {
+func TestDeleteBudget(t *testing.T) {
 	ctx := context.Background()
-	spIDStr, _ := id.Generate("spc_")
-	spaceID := SpaceID(spIDStr)
+	spaceID := SpaceID("spc_2dE1V8ZqWz4eS2N9yX3bL1mK7pO")
 
-	accIDStr, _ := id.Generate("acc_")
-	accID := AccountID(accIDStr)
+	bIDClean, _ := NewBudgetID()
+	bIDTxns, _ := NewBudgetID()
+	bIDSched, _ := NewBudgetID()
 
-	accountStore := &mockAccountStore{data: make(map[AccountID]*Account)}
+	budgetStore := &mockBudgetStore{data: make(map[BudgetID]*Budget)}
 	txnStore := &mockTransactionStore{txns: make(map[TransactionID]*Transaction)}
-	settingsStore := &mockSettingsStore{data: make(map[SpaceID]*FinanceSettings)}
-	eventStore := &mockTransactionEventStore{events: make(map[TransactionEventID]*TransactionEvent)}
+	schedStore := &mockScheduledPaymentStore{payments: make(map[ScheduledPaymentID]*ScheduledPayment)}
 
-	_ = settingsStore.Create(ctx, &FinanceSettings{
-		SpaceID:      spaceID,
-		BaseCurrency: "USD",
+	_ = budgetStore.Create(ctx, &Budget{ID: bIDClean, SpaceID: spaceID, Name: "Clean Budget", IsActive: true})
+	_ = budgetStore.Create(ctx, &Budget{ID: bIDTxns, SpaceID: spaceID, Name: "Txn Budget", IsActive: true})
+	_ = budgetStore.Create(ctx, &Budget{ID: bIDSched, SpaceID: spaceID, Name: "Sched Budget", IsActive: true})
+
+	_ = txnStore.Create(ctx, &Transaction{
+		ID:       TransactionID("txn_1"),
+		SpaceID:  spaceID,
+		BudgetID: &bIDTxns,
+		Type:     TransactionTypeExpense,
+		Amount:   500,
 	})
 
-	initialAcc := &Account{
-		ID:             accID,
-		SpaceID:        spaceID,
-		Name:           "Checking",
-		Type:           AccountTypeBank,
-		Currency:       "USD",
-		CurrentBalance: 5000, // $50.00
-		IsActive:       true,
-	}
-	_ = accountStore.Create(ctx, initialAcc)
+	_ = schedStore.Create(ctx, &ScheduledPayment{
+		ID:       ScheduledPaymentID("sch_1"),
+		SpaceID:  spaceID,
+		BudgetID: bIDSched,
+		Status:   ScheduledPaymentPending,
+	})
 
 	svc := NewService(Dependencies{
-		SettingsStore:         settingsStore,
-		AccountStore:          accountStore,
+		BudgetStore:           budgetStore,
 		TransactionStore:      txnStore,
-		TransactionEventStore: eventStore,
+		ScheduledPaymentStore: schedStore,
 	})
 
-	// Test Case 1: Positive Adjustment ($50.00 -> $120.00, Delta = +$70.00)
-	updatedAcc, err := svc.AdjustAccountBalance(ctx, spaceID, accID, 12000, "", "Statement reconciliation")
-	if err != nil {
-		t.Fatalf("AdjustAccountBalance failed: %v", err)
+	tests := []struct {
+		name    string
+		bID     BudgetID
+		wantErr error
+	}{
+		{
+			name:    "deleting budget with active transactions fails",
+			bID:     bIDTxns,
+			wantErr: ErrBudgetHasTransactions,
+		},
+		{
+			name:    "deleting budget with active scheduled payments fails",
+			bID:     bIDSched,
+			wantErr: ErrBudgetHasScheduledPayments,
+		},
+		{
+			name:    "deleting clean budget succeeds",
+			bID:     bIDClean,
+			wantErr: nil,
+		},
 	}
 
-	if updatedAcc.CurrentBalance != 12000 {
-		t.Errorf("CurrentBalance = %d, want 12000", updatedAcc.CurrentBalance)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := svc.DeleteBudget(ctx, spaceID, tt.bID, DeleteOptions{})
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("DeleteBudget error = %v, want %v", err, tt.wantErr)
+				}
+			} else if err != nil {
+				t.Errorf("unexpected DeleteBudget error: %v", err)
+			}
+		})
 	}
+}
 
-	// Verify logged transaction
-	if len(txnStore.txns) != 1 {
-		t.Fatalf("expected 1 logged transaction, got %d", len(txnStore.txns))
-	}
-
-	var loggedTxn *Transaction
-	for _, tx := range txnStore.txns {
-		loggedTxn = tx
-	}
+func TestGetScheduledPayment(t *testing.T) {
+	ctx := context.Background()
+	spaceID := SpaceID("spc_2dE1V8ZqWz4eS2N9yX3bL1mK7pO")
+	spID := ScheduledPaymentID("sch_2dE1V8ZqWz4eS2N9yX3bL1mK7pO")
 
-	if loggedTxn.Type != TransactionTypeBalanceAdjustment {
-		t.Errorf("Type = %s, want BALANCE_ADJUSTMENT", loggedTxn.Type)
-	}
-	if loggedTxn.Amount != 7000 {
-		t.Errorf("Amount = %d, want 7000", loggedTxn.Amount)
+	mockStore := &mockScheduledPaymentStore{
+		payments: map[ScheduledPaymentID]*ScheduledPayment{
+			spID: {
+				ID:       spID,
+				SpaceID:  spaceID,
+				Amount:   1500,
+				Currency: Currency("USD"),
+				Status:   ScheduledPaymentPending,
+			},
+		},
 	}
 
-	// Test Case 2: Negative Adjustment ($120.00 -> $80.00, Delta = -$40.00)
-	updatedAcc2, err := svc.AdjustAccountBalance(ctx, spaceID, accID, 8000, "", "Fee adjustment")
-	if err != nil {
-		t.Fatalf("AdjustAccountBalance negative failed: %v", err)
-	}
+	svc := NewService(Dependencies{
+		ScheduledPaymentStore: mockStore,
+	})
 
-	if updatedAcc2.CurrentBalance != 8000 {
-		t.Errorf("CurrentBalance = %d, want 8000", updatedAcc2.CurrentBalance)
+	tests := []struct {
+		name    string
+		spaceID SpaceID
+		id      ScheduledPaymentID
+		wantErr bool
+	}{
+		{
+			name:    "valid query returns scheduled payment",
+			spaceID: spaceID,
+			id:      spID,
+			wantErr: false,
+		},
+		{
+			name:    "invalid space ID returns validation error",
+			spaceID: SpaceID("invalid"),
+			id:      spID,
+			wantErr: true,
+		},
+		{
+			name:    "invalid scheduled payment ID returns validation error",
+			spaceID: spaceID,
+			id:      ScheduledPaymentID("invalid"),
+			wantErr: true,
+		},
+		{
+			name:    "missing scheduled payment returns not found error",
+			spaceID: spaceID,
+			id:      ScheduledPaymentID("sch_2dE1V8ZqWz4eS2N9yX3bL999999"),
+			wantErr: true,
+		},
 	}
 
-	// Test Case 3: Prevent Manual Editing of Balance Adjustment
-	err = svc.updateTransaction(ctx, loggedTxn, loggedTxn)
-	if err == nil {
-		t.Error("expected error when attempting to update balance adjustment transaction, got nil")
-	} else if !strings.Contains(err.Error(), "balance adjustment transactions cannot be edited directly") {
-		t.Errorf("unexpected error message: %v", err)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := svc.GetScheduledPayment(ctx, tt.spaceID, tt.id)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("GetScheduledPayment expected error, got nil")
+				}
+			} else {
+				if err != nil {
+					t.Errorf("unexpected GetScheduledPayment error: %v", err)
+				}
+				if got == nil || got.ID != spID {
+					t.Errorf("GetScheduledPayment got = %v, want ID %s", got, spID)
+				}
+			}
+		})
 	}
 }
 
-func TestCreateBorrowingRepayment_SameCurrencyLent(t *testing.T) {
+func TestGetOrCreatePeriod_MultiCurrencyRateResolution(t *testing.T) {
 	ctx := context.Background()
-	spaceID := SpaceID("sp_test123")
-	accID := AccountID("acc_bank123")
-	borID, _ := NewBorrowingID()
+	spaceID := SpaceID("spc_2dE1V8ZqWz4eS2N9yX3bL1mK7pO")
+	bID, _ := NewBudgetID()
+	now := time.Now().UTC()
 
-	accountStore := &mockAccountStore{data: make(map[AccountID]*Account)}
-	txnStore := &mockTransactionStore{txns: make(map[TransactionID]*Transaction)}
-	borrowingStore := &mockBorrowingStore{data: make(map[BorrowingID]*Borrowing)}
+	budgetStore := &mockBudgetStore{data: make(map[BudgetID]*Budget)}
 	settingsStore := &mockSettingsStore{data: make(map[SpaceID]*FinanceSettings)}
+	periodStore := &mockPeriodStore{data: make(map[string]*BudgetPeriod)}
+	rateStore := &mockExchangeRateStore{rates: make(map[string]*ExchangeRate)}
 
 	_ = settingsStore.Create(ctx, &FinanceSettings{SpaceID: spaceID, BaseCurrency: "USD"})
-	_ = accountStore.Create(ctx, &Account{
-		ID:             accID,
-		SpaceID:        spaceID,
-		Name:           "Checking Account",
-		Type:           AccountTypeBank,
-		Currency:       "USD",
-		CurrentBalance: 50000, // $500.00
-		IsActive:       true,
-	})
-	_ = borrowingStore.Create(ctx, &Borrowing{
-		ID:              borID,
-		SpaceID:         spaceID,
-		Direction:       BorrowingDirectionLent,
-		Counterparty:    "John",
-		TotalAmount:     10000, // $100.00
-		RemainingAmount: 10000, // $100.00
-		Currency:        "USD",
-		Status:          BorrowingStatusActive,
-		EstablishedAt:   time.Now().UTC(),
+	_ = budgetStore.Create(ctx, &Budget{
+		ID:          bID,
+		SpaceID:     spaceID,
+		Name:        "Travel EUR",
+		LimitAmount: 100000,
+		Currency:    "EUR",
+		Interval:    IntervalMonthly,
+		IsActive:    true,
 	})
 
 	svc := NewService(Dependencies{
-		SettingsStore:    settingsStore,
-		AccountStore:     accountStore,
-		BorrowingStore:   borrowingStore,
-		TransactionStore: txnStore,
+		BudgetStore:       budgetStore,
+		SettingsStore:     settingsStore,
+		PeriodStore:       periodStore,
+		ExchangeRateStore: rateStore,
 	})
 
-	repayment, err := svc.CreateBorrowingRepayment(ctx, &BorrowingRepayment{
-		BorrowingID: borID,
-		SpaceID:     spaceID,
-		Amount:      3000, // $30.00 repayment
-		PaymentDate: time.Now().UTC(),
-		AccountID:   &accID,
-		Notes:       "Part payment from John",
+	t.Run("missing exchange rate returns validation error", func(t *testing.T) {
+		_, err := svc.GetOrCreatePeriod(ctx, spaceID, bID, now)
+		if err == nil || !strings.Contains(err.Error(), "exchange rate must be greater than zero") {
+			t.Fatalf("expected exchange rate error, got %v", err)
+		}
 	})
-	if err != nil {
-		t.Fatalf("CreateBorrowingRepayment failed: %v", err)
-	}
 
-	// Verify Borrowing remaining balance (10000 - 3000 = 7000)
-	b, _ := borrowingStore.GetByID(ctx, spaceID, borID)
-	if b.RemainingAmount != 7000 {
-		t.Errorf("Borrowing remaining balance = %d, want 7000", b.RemainingAmount)
-	}
+	t.Run("registered exchange rate sets rate on period", func(t *testing.T) {
+		_ = rateStore.Create(ctx, &ExchangeRate{
+			SpaceID:      spaceID,
+			FromCurrency: "EUR",
+			ToCurrency:   "USD",
+			Rate:         1.10,
+			RateDate:     now,
+		})
 
-	// Verify Account balance (50000 + 3000 = 53000 because LENT repayment is INFLOW)
-	acc, _ := accountStore.GetByID(ctx, spaceID, accID)
-	if acc.CurrentBalance != 53000 {
-		t.Errorf("Account balance = %d, want 53000", acc.CurrentBalance)
-	}
+		// Create period for next month with registered rate
+		nextMonth := now.AddDate(0, 1, 0)
+		p, err := svc.GetOrCreatePeriod(ctx, spaceID, bID, nextMonth)
+		if err != nil {
+			t.Fatalf("GetOrCreatePeriod failed: %v", err)
+		}
+		if p.ExchangeRateToBase != 1.10 {
+			t.Errorf("ExchangeRateToBase = %f, want 1.10", p.ExchangeRateToBase)
+		}
+	})
+}
 
-	// Verify Repayment Transaction logged
-	txn, err := txnStore.GetByID(ctx, spaceID, TransactionID(repayment.ID))
-	if err != nil {
-		t.Fatalf("Repayment transaction not found: %v", err)
-	}
-	if txn.Type != TransactionTypeIncome {
-		t.Errorf("Transaction type = %s, want INCOME", txn.Type)
+func TestGetOrCreatePeriod_Rollover(t *testing.T) {
+	ctx := context.Background()
+	spaceID := SpaceID("spc_2dE1V8ZqWz4eS2N9yX3bL1mK7pO")
+	march := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	april := time.Date(2026, 4, 10, 12, 0, 0, 0, time.UTC)
+	may := time.Date(2026, 5, 10, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		policy         RolloverPolicy
+		cap            int64
+		marchSpent     int64
+		wantAprilIn    int64
+		wantMarchOut   int64
+		wantAprilLimit int64
+	}{
+		{name: "none starts fresh", policy: RolloverNone, marchSpent: 30000, wantAprilIn: 0, wantMarchOut: 0, wantAprilLimit: 50000},
+		{name: "surplus carries unspent", policy: RolloverCarrySurplus, marchSpent: 30000, wantAprilIn: 20000, wantMarchOut: 20000, wantAprilLimit: 70000},
+		{name: "surplus ignores overspend", policy: RolloverCarrySurplus, marchSpent: 60000, wantAprilIn: 0, wantMarchOut: 0, wantAprilLimit: 50000},
+		{name: "deficit deducts overspend", policy: RolloverCarryDeficit, marchSpent: 60000, wantAprilIn: -10000, wantMarchOut: -10000, wantAprilLimit: 40000},
+		{name: "both respects cap", policy: RolloverCarryBoth, cap: 5000, marchSpent: 30000, wantAprilIn: 5000, wantMarchOut: 5000, wantAprilLimit: 55000},
 	}
-	if txn.Amount != 3000 {
-		t.Errorf("Transaction amount = %d, want 3000", txn.Amount)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bID, _ := NewBudgetID()
+			budgetStore := &mockBudgetStore{data: make(map[BudgetID]*Budget)}
+			settingsStore := &mockSettingsStore{data: make(map[SpaceID]*FinanceSettings)}
+			periodStore := &mockPeriodStore{data: make(map[string]*BudgetPeriod)}
+			txnStore := &mockTransactionStore{txns: make(map[TransactionID]*Transaction)}
+
+			_ = settingsStore.Create(ctx, &FinanceSettings{SpaceID: spaceID, BaseCurrency: "USD"})
+			_ = budgetStore.Create(ctx, &Budget{
+				ID:             bID,
+				SpaceID:        spaceID,
+				Name:           "Groceries",
+				LimitAmount:    50000,
+				Currency:       "USD",
+				Interval:       IntervalMonthly,
+				IsActive:       true,
+				RolloverPolicy: tt.policy,
+				RolloverCap:    tt.cap,
+			})
+
+			svc := NewService(Dependencies{
+				BudgetStore:      budgetStore,
+				SettingsStore:    settingsStore,
+				PeriodStore:      periodStore,
+				TransactionStore: txnStore,
+			})
+
+			marchPeriod, err := svc.GetOrCreatePeriod(ctx, spaceID, bID, march)
+			if err != nil {
+				t.Fatalf("GetOrCreatePeriod(march) failed: %v", err)
+			}
+			if marchPeriod.CarriedIn != 0 {
+				t.Errorf("first period CarriedIn = %d, want 0", marchPeriod.CarriedIn)
+			}
+
+			txnID, _ := NewTransactionID()
+			txnStore.txns[txnID] = &Transaction{
+				ID:           txnID,
+				SpaceID:      spaceID,
+				PeriodID:     &marchPeriod.ID,
+				Amount:       tt.marchSpent,
+				AmountInBase: tt.marchSpent,
+			}
+
+			aprilPeriod, err := svc.GetOrCreatePeriod(ctx, spaceID, bID, april)
+			if err != nil {
+				t.Fatalf("GetOrCreatePeriod(april) failed: %v", err)
+			}
+			if aprilPeriod.CarriedIn != tt.wantAprilIn {
+				t.Errorf("CarriedIn = %d, want %d", aprilPeriod.CarriedIn, tt.wantAprilIn)
+			}
+			if aprilPeriod.EffectiveLimit() != tt.wantAprilLimit {
+				t.Errorf("EffectiveLimit() = %d, want %d", aprilPeriod.EffectiveLimit(), tt.wantAprilLimit)
+			}
+			if marchPeriod.CarriedOut != tt.wantMarchOut {
+				t.Errorf("previous CarriedOut = %d, want %d", marchPeriod.CarriedOut, tt.wantMarchOut)
+			}
+
+			// A fully unspent period compounds the carried balance into the following one.
+			mayPeriods, err := svc.GetOrCreatePeriods(ctx, []*Budget{budgetStore.data[bID]}, may)
+			if err != nil {
+				t.Fatalf("GetOrCreatePeriods(may) failed: %v", err)
+			}
+			want := tt.policy.Carry(aprilPeriod.EffectiveLimit(), tt.cap)
+			if got := mayPeriods[bID].CarriedIn; got != want {
+				t.Errorf("May CarriedIn = %d, want %d", got, want)
+			}
+		})
 	}
 }
 
-func TestCreateBorrowingRepayment_MultiCurrency(t *testing.T) {
+func TestGetOrCreatePeriod_RolloverRecomputed(t *testing.T) {
 	ctx := context.Background()
-	spaceID := SpaceID("sp_test456")
-	accID := AccountID("acc_dop123")
-	borID, _ := NewBorrowingID()
+	spaceID := SpaceID("spc_2dE1V8ZqWz4eS2N9yX3bL1mK7pO")
+	march := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	april := time.Date(2026, 4, 10, 12, 0, 0, 0, time.UTC)
+	june := time.Date(2026, 6, 10, 12, 0, 0, 0, time.UTC)
 
-	accountStore := &mockAccountStore{data: make(map[AccountID]*Account)}
-	txnStore := &mockTransactionStore{txns: make(map[TransactionID]*Transaction)}
-	borrowingStore := &mockBorrowingStore{data: make(map[BorrowingID]*Borrowing)}
+	bID, _ := NewBudgetID()
+	budgetStore := &mockBudgetStore{data: make(map[BudgetID]*Budget)}
 	settingsStore := &mockSettingsStore{data: make(map[SpaceID]*FinanceSettings)}
-	rateStore := &mockExchangeRateStore{rates: make(map[string]*ExchangeRate)}
-
+	txnStore := &mockTransactionStore{txns: make(map[TransactionID]*Transaction)}
 	_ = settingsStore.Create(ctx, &FinanceSettings{SpaceID: spaceID, BaseCurrency: "USD"})
-	_ = rateStore.Create(ctx, &ExchangeRate{
-		SpaceID:      spaceID,
-		FromCurrency: "USD",
-		ToCurrency:   "DOP",
-		Rate:         60.0,
-		RateDate:     time.Now().UTC(),
-	})
-
-	_ = accountStore.Create(ctx, &Account{
-		ID:             accID,
+	_ = budgetStore.Create(ctx, &Budget{
+		ID:             bID,
 		SpaceID:        spaceID,
-		Name:           "Dominican Bank Account",
-		Type:           AccountTypeBank,
-		Currency:       "DOP",
-		CurrentBalance: 5000000, // 50,000.00 DOP
+		Name:           "Groceries",
+		LimitAmount:    50000,
+		Currency:       "USD",
+		Interval:       IntervalMonthly,
 		IsActive:       true,
+		RolloverPolicy: RolloverCarryBoth,
 	})
-	_ = borrowingStore.Create(ctx, &Borrowing{
-		ID:              borID,
-		SpaceID:         spaceID,
-		Direction:       BorrowingDirectionLent,
-		Counterparty:    "Maria",
-		TotalAmount:     10000, // $100.00 USD
-		RemainingAmount: 10000, // $100.00 USD
-		Currency:        "USD",
-		Status:          BorrowingStatusActive,
-		EstablishedAt:   time.Now().UTC(),
-	})
-
 	svc := NewService(Dependencies{
-		SettingsStore:     settingsStore,
-		ExchangeRateStore: rateStore,
-		AccountStore:      accountStore,
-		BorrowingStore:    borrowingStore,
-		TransactionStore:  txnStore,
+		BudgetStore:      budgetStore,
+		SettingsStore:    settingsStore,
+		PeriodStore:      &mockPeriodStore{data: make(map[string]*BudgetPeriod)},
+		TransactionStore: txnStore,
 	})
 
-	repayment, err := svc.CreateBorrowingRepayment(ctx, &BorrowingRepayment{
-		BorrowingID: borID,
-		SpaceID:     spaceID,
-		Amount:      1000, // $10.00 USD repayment
-		PaymentDate: time.Now().UTC(),
-		AccountID:   &accID,
-		Notes:       "Repayment from Maria in DOP",
-	})
+	marchPeriod, err := svc.GetOrCreatePeriod(ctx, spaceID, bID, march)
 	if err != nil {
-		t.Fatalf("CreateBorrowingRepayment multi-currency failed: %v", err)
+		t.Fatalf("GetOrCreatePeriod(march) failed: %v", err)
 	}
+	txnID, _ := NewTransactionID()
+	txnStore.txns[txnID] = &Transaction{ID: txnID, SpaceID: spaceID, PeriodID: &marchPeriod.ID, Amount: 30000, AmountInBase: 30000}
 
-	// Verify Borrowing remaining balance ($100 - $10 = $90 USD)
-	b, _ := borrowingStore.GetByID(ctx, spaceID, borID)
-	if b.RemainingAmount != 9000 {
-		t.Errorf("Borrowing remaining balance = %d, want 9000 USD", b.RemainingAmount)
+	aprilPeriod, err := svc.GetOrCreatePeriod(ctx, spaceID, bID, april)
+	if err != nil {
+		t.Fatalf("GetOrCreatePeriod(april) failed: %v", err)
 	}
-
-	// Verify DOP Account balance: 50,000 DOP + ($10 * 60 = 600 DOP = 60000 DOP cents)
-	acc, _ := accountStore.GetByID(ctx, spaceID, accID)
-	expectedDOPBalance := int64(5000000 + 60000)
-	if acc.CurrentBalance != expectedDOPBalance {
-		t.Errorf("Account DOP balance = %d, want %d", acc.CurrentBalance, expectedDOPBalance)
+	if aprilPeriod.CarriedIn != 20000 {
+		t.Fatalf("April CarriedIn = %d, want 20000", aprilPeriod.CarriedIn)
 	}
 
-	// Verify Deleting Repayment rolls back both DOP Account and USD Borrowing
-	err = svc.DeleteBorrowingRepayment(ctx, DeleteBorrowingRepaymentRequest{
-		SpaceID:     spaceID,
-		BorrowingID: borID,
-		ID:          repayment.ID,
+	t.Run("editing a closed period updates the carry", func(t *testing.T) {
+		txnStore.txns[txnID].Amount = 45000
+
+		got, err := svc.GetOrCreatePeriod(ctx, spaceID, bID, april)
+		if err != nil {
+			t.Fatalf("GetOrCreatePeriod(april) failed: %v", err)
+		}
+		if got.CarriedIn != 5000 {
+			t.Errorf("April CarriedIn = %d, want 5000", got.CarriedIn)
+		}
+		if marchPeriod.CarriedOut != 5000 {
+			t.Errorf("stored March CarriedOut = %d, want 5000", marchPeriod.CarriedOut)
+		}
 	})
-	if err != nil {
-		t.Fatalf("DeleteBorrowingRepayment failed: %v", err)
-	}
 
-	bAfterDelete, _ := borrowingStore.GetByID(ctx, spaceID, borID)
-	if bAfterDelete.RemainingAmount != 10000 {
-		t.Errorf("Borrowing balance after deletion = %d, want 10000 USD", bAfterDelete.RemainingAmount)
-	}
+	t.Run("deleting from a closed period updates the carry", func(t *testing.T) {
+		delete(txnStore.txns, txnID)
 
-	accAfterDelete, _ := accountStore.GetByID(ctx, spaceID, accID)
-	if accAfterDelete.CurrentBalance != 5000000 {
-		t.Errorf("Account DOP balance after deletion = %d, want 5000000 DOP", accAfterDelete.CurrentBalance)
-	}
+		got, err := svc.GetOrCreatePeriod(ctx, spaceID, bID, april)
+		if err != nil {
+			t.Fatalf("GetOrCreatePeriod(april) failed: %v", err)
+		}
+		if got.CarriedIn != 50000 {
+			t.Errorf("April CarriedIn = %d, want 50000", got.CarriedIn)
+		}
+	})
+
+	t.Run("missing cycles roll their limit forward", func(t *testing.T) {
+		aprilTxnID, _ := NewTransactionID()
+		txnStore.txns[aprilTxnID] = &Transaction{ID: aprilTxnID, SpaceID: spaceID, PeriodID: &aprilPeriod.ID, Amount: 120000, AmountInBase: 120000}
+
+		// April: 50000 + 50000 carried - 120000 spent leaves -20000; May never got a period.
+		junePeriod, err := svc.GetOrCreatePeriod(ctx, spaceID, bID, june)
+		if err != nil {
+			t.Fatalf("GetOrCreatePeriod(june) failed: %v", err)
+		}
+		if junePeriod.CarriedIn != 30000 {
+			t.Errorf("June CarriedIn = %d, want 30000", junePeriod.CarriedIn)
+		}
+		if aprilPeriod.CarriedOut != -20000 {
+			t.Errorf("stored April CarriedOut = %d, want -20000", aprilPeriod.CarriedOut)
+		}
+	})
 }
 
-func TestService_CreateTransfer(t *testing.T) {
-	spIDStr, _ := id.Generate("spc_")
-	spID := SpaceID(spIDStr)
-	otherSpIDStr, _ := id.Generate("spc_")
-	otherSpID := SpaceID(otherSpIDStr)
+// --- Test Cases ---
 
-	ctx := context.Background()
+func TestCalculateBounds(t *testing.T) {
+	tests := []struct {
+		name      string
+		interval  RecurrenceInterval
+		date      time.Time
+		wantStart time.Time
+		wantEnd   time.Time
+	}{
+		{
+			name:      "monthly bounds calculation",
+			interval:  IntervalMonthly,
+			date:      time.Date(2026, 2, 15, 12, 30, 0, 0, time.UTC),
+			wantStart: time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC),
+			wantEnd:   time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC).Add(-time.Second),
+		},
+		{
+			name:      "yearly bounds calculation",
+			interval:  IntervalYearly,
+			date:      time.Date(2026, 5, 20, 10, 0, 0, 0, time.UTC),
+			wantStart: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+			wantEnd:   time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC).Add(-time.Second),
+		},
+		{
+			name:      "weekly bounds calculation (Wednesday mid-week)",
+			interval:  IntervalWeekly,
+			date:      time.Date(2026, 2, 18, 15, 0, 0, 0, time.UTC), // Feb 18 is Wednesday
+			wantStart: time.Date(2026, 2, 16, 0, 0, 0, 0, time.UTC),  // Monday is Feb 16
+			wantEnd:   time.Date(2026, 2, 23, 0, 0, 0, 0, time.UTC).Add(-time.Second),
+		},
+	}
 
-	srcUSD, _ := NewAccountID()
-	dstUSD, _ := NewAccountID()
-	dstEUR, _ := NewAccountID()
-	otherSpaceAcc, _ := NewAccountID()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Budget{Interval: tt.interval}
+			start, end := b.CalculateBounds(tt.date)
+			if !start.Equal(tt.wantStart) {
+				t.Errorf("start date = %s, want %s", start, tt.wantStart)
+			}
+			if !end.Equal(tt.wantEnd) {
+				t.Errorf("end date = %s, want %s", end, tt.wantEnd)
+			}
+		})
+	}
+}
+
+func TestCalculateBoundsIn(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("load location: %v", err)
+	}
+	payday := time.Date(2026, 1, 9, 0, 0, 0, 0, time.UTC)   // Friday
+	dstAnchor := time.Date(2026, 3, 2, 0, 0, 0, 0, newYork) // Monday before the DST switch
 
 	tests := []struct {
-		name               string
-		transfer           *Transfer
-		setupAccounts      func(accStore *mockAccountStore)
-		wantErr            bool
-		errContains        string
-		expectedSrcBalance int64
-		expectedDstBalance int64
+		name      string
+		budget    Budget
+		loc       *time.Location
+		date      time.Time
+		wantStart time.Time
+		wantEnd   time.Time
 	}{
 		{
-			name: "Success - Single Currency (USD to USD)",
-			transfer: &Transfer{
-				SpaceID:              spID,
-				SourceAccountID:      srcUSD,
-				DestinationAccountID: dstUSD,
-				SourceAmount:         40000,
-				DestinationAmount:    40000,
-				TransferDate:         time.Now().UTC(),
-				Notes:                "Single currency savings transfer",
-			},
-			setupAccounts: func(as *mockAccountStore) {
-				_ = as.Create(ctx, &Account{ID: srcUSD, SpaceID: spID, Name: "Checking USD", Currency: "USD", CurrentBalance: 100000, IsActive: true})
-				_ = as.Create(ctx, &Account{ID: dstUSD, SpaceID: spID, Name: "Savings USD", Currency: "USD", CurrentBalance: 0, IsActive: true})
-			},
-			wantErr:            false,
-			expectedSrcBalance: 60000,
-			expectedDstBalance: 40000,
+			name:      "monthly with start day before boundary",
+			budget:    Budget{Interval: IntervalMonthly, PeriodStartDay: 15},
+			date:      time.Date(2026, 2, 10, 9, 0, 0, 0, time.UTC),
+			wantStart: time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC),
+			wantEnd:   time.Date(2026, 2, 15, 0, 0, 0, 0, time.UTC).Add(-time.Second),
 		},
 		{
-			name: "Success - Multi Currency (EUR to USD)",
-			transfer: &Transfer{
-				SpaceID:              spID,
-				SourceAccountID:      srcUSD,
-				DestinationAccountID: dstEUR,
-				SourceAmount:         50000, // €500
-				DestinationAmount:    54000, // $540
-				TransferDate:         time.Now().UTC(),
-				Notes:                "Multi currency transfer",
-			},
-			setupAccounts: func(as *mockAccountStore) {
-				_ = as.Create(ctx, &Account{ID: srcUSD, SpaceID: spID, Name: "Checking USD", Currency: "USD", CurrentBalance: 100000, IsActive: true})
-				_ = as.Create(ctx, &Account{ID: dstEUR, SpaceID: spID, Name: "Savings EUR", Currency: "EUR", CurrentBalance: 0, IsActive: true})
-			},
-			wantErr:            false,
-			expectedSrcBalance: 50000,
-			expectedDstBalance: 54000,
+			name:      "monthly with start day on boundary",
+			budget:    Budget{Interval: IntervalMonthly, PeriodStartDay: 15},
+			date:      time.Date(2026, 2, 15, 0, 0, 0, 0, time.UTC),
+			wantStart: time.Date(2026, 2, 15, 0, 0, 0, 0, time.UTC),
+			wantEnd:   time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC).Add(-time.Second),
 		},
 		{
-			name: "Err - Same Source and Destination Account",
-			transfer: &Transfer{
-				SpaceID:              spID,
-				SourceAccountID:      srcUSD,
-				DestinationAccountID: srcUSD,
-				SourceAmount:         10000,
-				DestinationAmount:    10000,
-				TransferDate:         time.Now().UTC(),
-			},
-			setupAccounts: func(as *mockAccountStore) {
-				_ = as.Create(ctx, &Account{ID: srcUSD, SpaceID: spID, Name: "Checking USD", Currency: "USD", CurrentBalance: 100000, IsActive: true})
-			},
-			wantErr:     true,
-			errContains: "source and destination accounts must be different",
+			name:      "quarterly calendar quarter",
+			budget:    Budget{Interval: IntervalQuarterly},
+			date:      time.Date(2026, 5, 20, 0, 0, 0, 0, time.UTC),
+			wantStart: time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC),
+			wantEnd:   time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC).Add(-time.Second),
 		},
 		{
-			name: "Err - Single Currency Mismatched Amounts",
-			transfer: &Transfer{
-				SpaceID:              spID,
-				SourceAccountID:      srcUSD,
-				DestinationAccountID: dstUSD,
-				SourceAmount:         50000,
-				DestinationAmount:    40000,
-				TransferDate:         time.Now().UTC(),
-			},
-			setupAccounts: func(as *mockAccountStore) {
-				_ = as.Create(ctx, &Account{ID: srcUSD, SpaceID: spID, Name: "Checking USD", Currency: "USD", CurrentBalance: 100000, IsActive: true})
-				_ = as.Create(ctx, &Account{ID: dstUSD, SpaceID: spID, Name: "Savings USD", Currency: "USD", CurrentBalance: 0, IsActive: true})
-			},
-			wantErr:     true,
-			errContains: "source and destination amounts must match for single-currency transfers",
+			name:      "quarterly with start day rolls back a quarter",
+			budget:    Budget{Interval: IntervalQuarterly, PeriodStartDay: 10},
+			date:      time.Date(2026, 4, 5, 0, 0, 0, 0, time.UTC),
+			wantStart: time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC),
+			wantEnd:   time.Date(2026, 4, 10, 0, 0, 0, 0, time.UTC).Add(-time.Second),
 		},
 		{
-			name: "Err - Zero or Negative Source Amount",
-			transfer: &Transfer{
-				SpaceID:              spID,
-				SourceAccountID:      srcUSD,
-				DestinationAccountID: dstUSD,
-				SourceAmount:         0,
-				DestinationAmount:    10000,
-				TransferDate:         time.Now().UTC(),
-			},
-			setupAccounts: func(as *mockAccountStore) {
-				_ = as.Create(ctx, &Account{ID: srcUSD, SpaceID: spID, Name: "Checking USD", Currency: "USD", CurrentBalance: 100000, IsActive: true})
-				_ = as.Create(ctx, &Account{ID: dstUSD, SpaceID: spID, Name: "Savings USD", Currency: "USD", CurrentBalance: 0, IsActive: true})
-			},
-			wantErr:     true,
-			errContains: "source amount must be greater than zero",
+			name:      "yearly with start day rolls back a year",
+			budget:    Budget{Interval: IntervalYearly, PeriodStartDay: 6},
+			date:      time.Date(2026, 1, 3, 0, 0, 0, 0, time.UTC),
+			wantStart: time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC),
+			wantEnd:   time.Date(2026, 1, 6, 0, 0, 0, 0, time.UTC).Add(-time.Second),
 		},
 		{
-			name: "Err - Account From Different Space",
-			transfer: &Transfer{
-				SpaceID:              spID,
-				SourceAccountID:      srcUSD,
-				DestinationAccountID: otherSpaceAcc,
-				SourceAmount:         10000,
-				DestinationAmount:    10000,
-				TransferDate:         time.Now().UTC(),
-			},
-			setupAccounts: func(as *mockAccountStore) {
-				_ = as.Create(ctx, &Account{ID: srcUSD, SpaceID: spID, Name: "Checking USD", Currency: "USD", CurrentBalance: 100000, IsActive: true})
-				_ = as.Create(ctx, &Account{ID: otherSpaceAcc, SpaceID: otherSpID, Name: "Foreign Space Account", Currency: "USD", CurrentBalance: 0, IsActive: true})
-			},
-			wantErr:     true,
-			errContains: "destination account",
+			name:      "weekly starting on Sunday",
+			budget:    Budget{Interval: IntervalWeekly, WeekStart: 7},
+			date:      time.Date(2026, 2, 18, 15, 0, 0, 0, time.UTC), // Wednesday
+			wantStart: time.Date(2026, 2, 15, 0, 0, 0, 0, time.UTC),
+			wantEnd:   time.Date(2026, 2, 22, 0, 0, 0, 0, time.UTC).Add(-time.Second),
+		},
+		{
+			name:      "biweekly after anchor",
+			budget:    Budget{Interval: IntervalBiweekly, PeriodAnchor: &payday},
+			date:      time.Date(2026, 2, 10, 0, 0, 0, 0, time.UTC),
+			wantStart: time.Date(2026, 2, 6, 0, 0, 0, 0, time.UTC),
+			wantEnd:   time.Date(2026, 2, 20, 0, 0, 0, 0, time.UTC).Add(-time.Second),
+		},
+		{
+			name:      "biweekly before anchor",
+			budget:    Budget{Interval: IntervalBiweekly, PeriodAnchor: &payday},
+			date:      time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+			wantStart: time.Date(2025, 12, 26, 0, 0, 0, 0, time.UTC),
+			wantEnd:   time.Date(2026, 1, 9, 0, 0, 0, 0, time.UTC).Add(-time.Second),
+		},
+		{
+			name:      "monthly in space timezone",
+			budget:    Budget{Interval: IntervalMonthly},
+			loc:       newYork,
+			date:      time.Date(2026, 3, 1, 3, 0, 0, 0, time.UTC), // Feb 28 22:00 EST
+			wantStart: time.Date(2026, 2, 1, 5, 0, 0, 0, time.UTC),
+			wantEnd:   time.Date(2026, 3, 1, 5, 0, 0, 0, time.UTC).Add(-time.Second),
+		},
+		{
+			name:      "biweekly across DST keeps local midnight",
+			budget:    Budget{Interval: IntervalBiweekly, PeriodAnchor: &dstAnchor},
+			loc:       newYork,
+			date:      time.Date(2026, 3, 20, 12, 0, 0, 0, time.UTC),
+			wantStart: time.Date(2026, 3, 16, 4, 0, 0, 0, time.UTC),
+			wantEnd:   time.Date(2026, 3, 30, 4, 0, 0, 0, time.UTC).Add(-time.Second),
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			settingsStore := &mockSettingsStore{data: make(map[SpaceID]*FinanceSettings)}
-			_ = settingsStore.Create(ctx, &FinanceSettings{SpaceID: spID, BaseCurrency: "USD"})
-			_ = settingsStore.Create(ctx, &FinanceSettings{SpaceID: otherSpID, BaseCurrency: "USD"})
+			start, end := tt.budget.CalculateBoundsIn(tt.date, tt.loc)
+			if !start.Equal(tt.wantStart) {
+				t.Errorf("start date = %s, want %s", start, tt.wantStart)
+			}
+			if !end.Equal(tt.wantEnd) {
+				t.Errorf("end date = %s, want %s", end, tt.wantEnd)
+			}
+		})
+	}
+}
 
-			rateStore := &mockExchangeRateStore{rates: make(map[string]*ExchangeRate)}
-			_ = rateStore.Create(ctx, &ExchangeRate{
-				SpaceID:      spID,
-				FromCurrency: "EUR",
-				ToCurrency:   "USD",
-				Rate:         1.08,
-				RateDate:     time.Now().UTC(),
-			})
-
-			accountStore := &mockAccountStore{data: make(map[AccountID]*Account)}
-			tt.setupAccounts(accountStore)
-
-			transactionStore := &mockTransactionStore{txns: make(map[TransactionID]*Transaction)}
-			transferStore := &mockTransferStore{data: make(map[TransferID]*Transfer)}
+func TestGetOrCreatePeriod_SpaceTimezone(t *testing.T) {
+	ctx := context.Background()
+	spaceID := SpaceID("spc_2dE1V8ZqWz4eS2N9yX3bL1mK7pO")
+	bID, _ := NewBudgetID()
 
-			svc := NewService(Dependencies{
-				SettingsStore:         settingsStore,
-				ExchangeRateStore:     rateStore,
-				AccountStore:          accountStore,
-				TransactionStore:      transactionStore,
-				TransferStore:         transferStore,
-				TransactionEventStore: &mockTransactionEventStore{events: make(map[TransactionEventID]*TransactionEvent)},
-			})
+	budgetStore := &mockBudgetStore{data: make(map[BudgetID]*Budget)}
+	settingsStore := &mockSettingsStore{data: make(map[SpaceID]*FinanceSettings)}
+	periodStore := &mockPeriodStore{data: make(map[string]*BudgetPeriod)}
 
-			res, err := svc.CreateTransfer(ctx, tt.transfer)
-			if tt.wantErr {
-				if err == nil {
-					t.Fatalf("expected error containing %q, got nil", tt.errContains)
-				}
-				if !strings.Contains(err.Error(), tt.errContains) {
-					t.Fatalf("error = %v, want error containing %q", err, tt.errContains)
-				}
-				return
-			}
-			if err != nil {
-				t.Fatalf("unexpected CreateTransfer error: %v", err)
-			}
+	_ = settingsStore.Create(ctx, &FinanceSettings{SpaceID: spaceID, BaseCurrency: "USD", Timezone: "Asia/Tokyo"})
+	_ = budgetStore.Create(ctx, &Budget{
+		ID:             bID,
+		SpaceID:        spaceID,
+		Name:           "Rent",
+		LimitAmount:    150000,
+		Currency:       "USD",
+		Interval:       IntervalMonthly,
+		PeriodStartDay: 15,
+		IsActive:       true,
+	})
 
-			// Verify balances
-			srcAcc, _ := accountStore.GetByID(ctx, spID, tt.transfer.SourceAccountID)
-			if srcAcc.CurrentBalance != tt.expectedSrcBalance {
-				t.Errorf("Source account balance = %d, want %d", srcAcc.CurrentBalance, tt.expectedSrcBalance)
-			}
+	svc := NewService(Dependencies{
+		BudgetStore:   budgetStore,
+		SettingsStore: settingsStore,
+		PeriodStore:   periodStore,
+	})
 
-			dstAcc, _ := accountStore.GetByID(ctx, spID, tt.transfer.DestinationAccountID)
-			if dstAcc.CurrentBalance != tt.expectedDstBalance {
-				t.Errorf("Destination account balance = %d, want %d", dstAcc.CurrentBalance, tt.expectedDstBalance)
-			}
+	// 2026-04-14T16:00Z is already April 15 in Tokyo, so the expense belongs to the period starting that day.
+	effective := time.Date(2026, 4, 14, 16, 0, 0, 0, time.UTC)
+	period, err := svc.GetOrCreatePeriod(ctx, spaceID, bID, effective)
+	if err != nil {
+		t.Fatalf("GetOrCreatePeriod failed: %v", err)
+	}
 
-			// Verify transaction legs
-			var outflow, inflow *Transaction
-			for _, tx := range transactionStore.txns {
-				if tx.Metadata.TransferID != nil && *tx.Metadata.TransferID == res.ID {
-					switch tx.Type {
-					case TransactionTypeTransferOut:
-						outflow = tx
-					case TransactionTypeTransferIn:
-						inflow = tx
-					}
-				}
-			}
-			if outflow == nil || inflow == nil {
-				t.Fatalf("expected both outflow and inflow transaction legs created")
-			}
-			if *outflow.Metadata.CounterpartAccountID != tt.transfer.DestinationAccountID {
-				t.Errorf("outflow counterpart = %s, want %s", *outflow.Metadata.CounterpartAccountID, tt.transfer.DestinationAccountID)
-			}
-			if *inflow.Metadata.CounterpartAccountID != tt.transfer.SourceAccountID {
-				t.Errorf("inflow counterpart = %s, want %s", *inflow.Metadata.CounterpartAccountID, tt.transfer.SourceAccountID)
-			}
-		})
+	wantStart := time.Date(2026, 4, 14, 15, 0, 0, 0, time.UTC)
+	if !period.StartDate.Equal(wantStart) {
+		t.Errorf("StartDate = %s, want %s", period.StartDate, wantStart)
+	}
+	wantEnd := time.Date(2026, 5, 14, 15, 0, 0, 0, time.UTC).Add(-time.Second)
+	if !period.EndDate.Equal(wantEnd) {
+		t.Errorf("EndDate = %s, want %s", period.EndDate, wantEnd)
 	}
 }
 
-func TestService_DeleteTransfer(t *testing.T) {
+func TestGetOrCreatePeriod_CycleChangeKeepsExistingPeriods(t *testing.T) {
 	ctx := context.Background()
-	spIDStr, _ := id.Generate("spc_")
-	spID := SpaceID(spIDStr)
+	spaceID := SpaceID("spc_2dE1V8ZqWz4eS2N9yX3bL1mK7pO")
+	bID, _ := NewBudgetID()
 
-	srcAccID, _ := NewAccountID()
-	dstAccID, _ := NewAccountID()
+	budgetStore := &mockBudgetStore{data: make(map[BudgetID]*Budget)}
+	settingsStore := &mockSettingsStore{data: make(map[SpaceID]*FinanceSettings)}
+	periodStore := &mockPeriodStore{data: make(map[string]*BudgetPeriod)}
+
+	_ = settingsStore.Create(ctx, &FinanceSettings{SpaceID: spaceID, BaseCurrency: "USD"})
+	_ = budgetStore.Create(ctx, &Budget{
+		ID:          bID,
+		SpaceID:     spaceID,
+		Name:        "Groceries",
+		LimitAmount: 50000,
+		Currency:    "USD",
+		Interval:    IntervalMonthly,
+		IsActive:    true,
+	})
+
+	svc := NewService(Dependencies{
+		BudgetStore:   budgetStore,
+		SettingsStore: settingsStore,
+		PeriodStore:   periodStore,
+	})
+
+	october, err := svc.GetOrCreatePeriod(ctx, spaceID, bID, time.Date(2026, 10, 10, 12, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("GetOrCreatePeriod failed: %v", err)
+	}
+
+	// Moving the cycle to the 15th leaves the current period as it was.
+	budgetStore.data[bID].PeriodStartDay = 15
+
+	again, err := svc.GetOrCreatePeriod(ctx, spaceID, bID, time.Date(2026, 10, 20, 12, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("GetOrCreatePeriod failed: %v", err)
+	}
+	if again.ID != october.ID {
+		t.Fatalf("expected the existing October period, got %s - %s", again.StartDate, again.EndDate)
+	}
 
+	// The next period fills the gap up to the new cycle, which the one after it follows.
 	tests := []struct {
-		name        string
-		transferID  TransferID
-		setupData   func(svc *Service, accStore *mockAccountStore) TransferID
-		wantErr     bool
-		errContains string
+		date      time.Time
+		wantStart time.Time
+		wantEnd   time.Time
 	}{
 		{
-			name: "Success - Delete Existing Transfer & Roll Back Balances",
-			setupData: func(svc *Service, as *mockAccountStore) TransferID {
-				_ = as.Create(ctx, &Account{ID: srcAccID, SpaceID: spID, Name: "Checking", Currency: "USD", CurrentBalance: 100000, IsActive: true})
-				_ = as.Create(ctx, &Account{ID: dstAccID, SpaceID: spID, Name: "Savings", Currency: "USD", CurrentBalance: 0, IsActive: true})
-				tr, _ := svc.CreateTransfer(ctx, &Transfer{
-					SpaceID:              spID,
-					SourceAccountID:      srcAccID,
-					DestinationAccountID: dstAccID,
-					SourceAmount:         30000,
-					DestinationAmount:    30000,
-					TransferDate:         time.Now().UTC(),
-				})
-				return tr.ID
-			},
-			wantErr: false,
+			date:      time.Date(2026, 11, 5, 12, 0, 0, 0, time.UTC),
+			wantStart: time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC),
+			wantEnd:   time.Date(2026, 11, 15, 0, 0, 0, 0, time.UTC).Add(-time.Second),
 		},
 		{
-			name: "Err - Transfer Not Found",
-			setupData: func(svc *Service, as *mockAccountStore) TransferID {
-				dummyID, _ := NewTransferID()
-				return dummyID
-			},
-			wantErr:     true,
-			errContains: "transfer not found",
+			date:      time.Date(2026, 11, 20, 12, 0, 0, 0, time.UTC),
+			wantStart: time.Date(2026, 11, 15, 0, 0, 0, 0, time.UTC),
+			wantEnd:   time.Date(2026, 12, 15, 0, 0, 0, 0, time.UTC).Add(-time.Second),
 		},
 	}
-
 	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			settingsStore := &mockSettingsStore{data: make(map[SpaceID]*FinanceSettings)}
-			_ = settingsStore.Create(ctx, &FinanceSettings{SpaceID: spID, BaseCurrency: "USD"})
-
-			accountStore := &mockAccountStore{data: make(map[AccountID]*Account)}
-			transactionStore := &mockTransactionStore{txns: make(map[TransactionID]*Transaction)}
-			transferStore := &mockTransferStore{data: make(map[TransferID]*Transfer)}
-
-			svc := NewService(Dependencies{
-				SettingsStore:         settingsStore,
-				AccountStore:          accountStore,
-				TransactionStore:      transactionStore,
-				TransferStore:         transferStore,
-				TransactionEventStore: &mockTransactionEventStore{events: make(map[TransactionEventID]*TransactionEvent)},
-			})
-
-			targetID := tt.setupData(svc, accountStore)
-
-			err := svc.DeleteTransfer(ctx, spID, targetID)
-			if tt.wantErr {
-				if err == nil {
-					t.Fatalf("expected error containing %q, got nil", tt.errContains)
-				}
-				if !strings.Contains(err.Error(), tt.errContains) {
-					t.Fatalf("error = %v, want error containing %q", err, tt.errContains)
-				}
-				return
-			}
-			if err != nil {
-				t.Fatalf("unexpected DeleteTransfer error: %v", err)
-			}
-
-			// Assert Transfer record deleted
-			_, err = transferStore.GetByID(ctx, spID, targetID)
-			if err == nil {
-				t.Errorf("expected transfer record to be deleted")
-			}
+		period, err := svc.GetOrCreatePeriod(ctx, spaceID, bID, tt.date)
+		if err != nil {
+			t.Fatalf("GetOrCreatePeriod failed: %v", err)
+		}
+		if !period.StartDate.Equal(tt.wantStart) || !period.EndDate.Equal(tt.wantEnd) {
+			t.Errorf("period for %s = %s - %s, want %s - %s", tt.date, period.StartDate, period.EndDate, tt.wantStart, tt.wantEnd)
+		}
+	}
 
-			// Assert Account Balances restored ($1000 and $0)
-			sAcc, _ := accountStore.GetByID(ctx, spID, srcAccID)
-			if sAcc.CurrentBalance != 100000 {
-				t.Errorf("Source account balance after deletion = %d, want 100000", sAcc.CurrentBalance)
-			}
-			dAcc, _ := accountStore.GetByID(ctx, spID, dstAccID)
-			if dAcc.CurrentBalance != 0 {
-				t.Errorf("Destination account balance after deletion = %d, want 0", dAcc.CurrentBalance)
-			}
-		})
+	if len(periodStore.data) != 3 {
+		t.Errorf("expected 3 periods, got %d", len(periodStore.data))
 	}
 }
 
-func TestService_GetAndListTransfers(t *testing.T) {
-	ctx := context.Background()
-	spIDStr, _ := id.Generate("spc_")
-	spID := SpaceID(spIDStr)
-
+func TestConfigureFinance(t *testing.T) {
 	settingsStore := &mockSettingsStore{data: make(map[SpaceID]*FinanceSettings)}
-	_ = settingsStore.Create(ctx, &FinanceSettings{SpaceID: spID, BaseCurrency: "USD"})
-
-	accountStore := &mockAccountStore{data: make(map[AccountID]*Account)}
-	transactionStore := &mockTransactionStore{txns: make(map[TransactionID]*Transaction)}
-	transferStore := &mockTransferStore{data: make(map[TransferID]*Transfer)}
-
 	svc := NewService(Dependencies{
 		SettingsStore:         settingsStore,
-		AccountStore:          accountStore,
-		TransactionStore:      transactionStore,
-		TransferStore:         transferStore,
+		AccountStore:          &mockAccountStore{data: make(map[AccountID]*Account)},
+		TransferStore:         &mockTransferStore{data: make(map[TransferID]*Transfer)},
 		TransactionEventStore: &mockTransactionEventStore{events: make(map[TransactionEventID]*TransactionEvent)},
 	})
 
-	srcAccID, _ := NewAccountID()
-	dstAccID, _ := NewAccountID()
+	spIDStr, _ := id.Generate("spc_")
+	spID := SpaceID(spIDStr)
 
-	srcAcc := &Account{
-		ID:             srcAccID,
-		SpaceID:        spID,
-		Name:           "Checking",
-		Currency:       Currency("USD"),
-		CurrentBalance: 100000,
-		IsActive:       true,
-	}
-	dstAcc := &Account{
-		ID:             dstAccID,
-		SpaceID:        spID,
-		Name:           "Savings",
-		Currency:       Currency("USD"),
-		CurrentBalance: 0,
-		IsActive:       true,
+	settings := &FinanceSettings{
+		SpaceID:      spID,
+		BaseCurrency: Currency("USD"),
 	}
-	_ = accountStore.Create(ctx, srcAcc)
-	_ = accountStore.Create(ctx, dstAcc)
 
-	tr, err := svc.CreateTransfer(ctx, &Transfer{
-		SpaceID:              spID,
-		SourceAccountID:      srcAcc.ID,
-		DestinationAccountID: dstAcc.ID,
-		SourceAmount:         15000,
-		DestinationAmount:    15000,
-		TransferDate:         time.Now().UTC(),
-	})
+	res, err := svc.ConfigureFinance(context.Background(), settings)
 	if err != nil {
-		t.Fatalf("CreateTransfer failed: %v", err)
+		t.Fatal(err)
 	}
 
-	// Test GetTransfer
-	fetched, err := svc.GetTransfer(ctx, spID, tr.ID)
-	if err != nil {
-		t.Fatalf("GetTransfer failed: %v", err)
-	}
-	if fetched.ID != tr.ID {
-		t.Errorf("GetTransfer ID = %s, want %s", fetched.ID, tr.ID)
+	if res.BaseCurrency != Currency("USD") {
+		t.Errorf("BaseCurrency = %s, want USD", res.BaseCurrency)
+	}
+
+	// Verify settings exist
+	retrieved, err := settingsStore.GetByID(context.Background(), spID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if retrieved.BaseCurrency != Currency("USD") {
+		t.Errorf("stored BaseCurrency = %s, want USD", retrieved.BaseCurrency)
+	}
+
+	// Verify base currency cannot be modified (immutable test)
+	newSettings := &FinanceSettings{
+		SpaceID:      spID,
+		BaseCurrency: Currency("EUR"),
+	}
+	res2, err := svc.ConfigureFinance(context.Background(), newSettings)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res2.BaseCurrency != Currency("USD") {
+		t.Errorf("immutable currency got updated to %s", res2.BaseCurrency)
+	}
+	if res2.Timezone != "UTC" {
+		t.Errorf("Timezone = %q, want UTC default", res2.Timezone)
+	}
+
+	// Timezone remains mutable and must be a known IANA zone
+	if _, err := svc.UpdateFinanceSettings(context.Background(), &FinanceSettings{SpaceID: spID, Timezone: "Mars/Olympus_Mons"}, []string{"timezone"}); err == nil {
+		t.Error("expected error for unknown timezone")
+	}
+	updated, err := svc.UpdateFinanceSettings(context.Background(), &FinanceSettings{SpaceID: spID, Timezone: "Europe/Madrid"}, []string{"timezone"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.Location().String() != "Europe/Madrid" {
+		t.Errorf("Location() = %s, want Europe/Madrid", updated.Location())
+	}
+}
+
+func TestGetOrCreatePeriod(t *testing.T) {
+	settingsStore := &mockSettingsStore{data: make(map[SpaceID]*FinanceSettings)}
+	budgetStore := &mockBudgetStore{data: make(map[BudgetID]*Budget)}
+	periodStore := &mockPeriodStore{data: make(map[string]*BudgetPeriod)}
+	rateStore := &mockExchangeRateStore{rates: make(map[string]*ExchangeRate)}
+
+	txnStore := &mockTransactionStore{txns: make(map[TransactionID]*Transaction)}
+
+	svc := NewService(Dependencies{
+		SettingsStore:         settingsStore,
+		BudgetStore:           budgetStore,
+		PeriodStore:           periodStore,
+		ExchangeRateStore:     rateStore,
+		TransactionStore:      txnStore,
+		InsightsStore:         &mockInsightsStore{},
+		AccountStore:          &mockAccountStore{data: make(map[AccountID]*Account)},
+		TransferStore:         &mockTransferStore{data: make(map[TransferID]*Transfer)},
+		TransactionEventStore: &mockTransactionEventStore{events: make(map[TransactionEventID]*TransactionEvent)},
+	})
+
+	ctx := context.Background()
+	spIDStr, _ := id.Generate("spc_")
+	spID := SpaceID(spIDStr)
+
+	// 1. Setup workspace base currency
+	_, err := svc.ConfigureFinance(ctx, &FinanceSettings{SpaceID: spID, BaseCurrency: Currency("USD")})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bgtIDStr, _ := id.Generate("bgt_")
+	bgtID := BudgetID(bgtIDStr)
+
+	// 2. Setup budget template (EUR budget)
+	budget, err := svc.CreateBudget(ctx, &Budget{
+		ID:          bgtID,
+		SpaceID:     spID,
+		Name:        "Dining",
+		LimitAmount: 50000, // 500.00 EUR
+		Currency:    Currency("EUR"),
+		Interval:    IntervalMonthly,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 3. Set up exchange rate (EUR to USD) for Feb 15
+	err = rateStore.Create(ctx, &ExchangeRate{
+		SpaceID:      spID,
+		FromCurrency: Currency("EUR"),
+		ToCurrency:   Currency("USD"),
+		Rate:         1.085,
+		RateDate:     time.Date(2026, 2, 10, 0, 0, 0, 0, time.UTC), // Pre-existing rate
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 4. Trigger JIT period creation
+	targetDate := time.Date(2026, 2, 15, 12, 0, 0, 0, time.UTC)
+	period, err := svc.GetOrCreatePeriod(ctx, spID, budget.ID, targetDate)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if period.LimitAmount != 50000 {
+		t.Errorf("period limit = %d, want 50000", period.LimitAmount)
+	}
+	if period.ExchangeRateToBase != 1.085 {
+		t.Errorf("period rate = %f, want 1.085", period.ExchangeRateToBase)
+	}
+	if period.BaseCurrency != Currency("USD") {
+		t.Errorf("period base currency = %s, want USD", period.BaseCurrency)
+	}
+
+	// 5. Query again (should return the same period without recreating)
+	period2, err := svc.GetOrCreatePeriod(ctx, spID, budget.ID, targetDate)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if period2.ID != period.ID {
+		t.Errorf("re-queried period ID = %s, want %s", period2.ID, period.ID)
+	}
+}
+
+func TestTransactions(t *testing.T) {
+	settingsStore := &mockSettingsStore{data: make(map[SpaceID]*FinanceSettings)}
+	budgetStore := &mockBudgetStore{data: make(map[BudgetID]*Budget)}
+	periodStore := &mockPeriodStore{data: make(map[string]*BudgetPeriod)}
+	rateStore := &mockExchangeRateStore{rates: make(map[string]*ExchangeRate)}
+	txnStore := &mockTransactionStore{txns: make(map[TransactionID]*Transaction)}
+
+	svc := NewService(Dependencies{
+		SettingsStore:         settingsStore,
+		BudgetStore:           budgetStore,
+		PeriodStore:           periodStore,
+		ExchangeRateStore:     rateStore,
+		TransactionStore:      txnStore,
+		InsightsStore:         &mockInsightsStore{},
+		AccountStore:          &mockAccountStore{data: make(map[AccountID]*Account)},
+		TransferStore:         &mockTransferStore{data: make(map[TransferID]*Transfer)},
+		TransactionEventStore: &mockTransactionEventStore{events: make(map[TransactionEventID]*TransactionEvent)},
+		PayeeStore:            &mockPayeeStore{data: make(map[PayeeID]*Payee)},
+		TransactionRuleStore:  &mockTransactionRuleStore{data: make(map[TransactionRuleID]*TransactionRule)},
+	})
+
+	ctx := context.Background()
+	spIDStr, _ := id.Generate("spc_")
+	spID := SpaceID(spIDStr)
+
+	// 1. Setup settings
+	_, err := svc.ConfigureFinance(ctx, &FinanceSettings{SpaceID: spID, BaseCurrency: Currency("USD")})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 2. Setup budget
+	bgtIDStr, _ := id.Generate("bgt_")
+	budget, err := svc.CreateBudget(ctx, &Budget{
+		ID:          BudgetID(bgtIDStr),
+		SpaceID:     spID,
+		Name:        "Food",
+		LimitAmount: 20000,
+		Currency:    Currency("EUR"),
+		Interval:    IntervalMonthly,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 3. Setup exchange rate (EUR to USD = 1.10)
+	rateDate := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	err = rateStore.Create(ctx, &ExchangeRate{
+		SpaceID:      spID,
+		FromCurrency: Currency("EUR"),
+		ToCurrency:   Currency("USD"),
+		Rate:         1.10,
+		RateDate:     rateDate,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 4. Create an expense of 10.00 EUR (1000 cents) on Feb 15
+	targetDate := time.Date(2026, 2, 15, 12, 0, 0, 0, time.UTC)
+	txn := &Transaction{
+		SpaceID:         spID,
+		BudgetID:        &budget.ID,
+		Amount:          1000,
+		Currency:        Currency("EUR"),
+		Description:     "Dinner",
+		TransactionDate: targetDate,
+	}
+
+	createdTxn, err := svc.CreateExpense(ctx, txn)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if createdTxn.AmountInBase != 1100 { // 1000 * 1.10 = 1100
+		t.Errorf("AmountInBase = %d, want 1100", createdTxn.AmountInBase)
+	}
+
+	period, err := svc.GetOrCreatePeriod(ctx, spID, budget.ID, targetDate)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Verify the period spent progress calculates correctly in batch query
+	stats, err := svc.AggregateSpentBatch(ctx, []PeriodID{period.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stats) != 1 {
+		t.Fatalf("expected 1 stats item, got %d", len(stats))
+	}
+	if stats[0].SpentInBase != 1100 {
+		t.Errorf("Period SpentInBase = %d, want 1100", stats[0].SpentInBase)
+	}
+	if stats[0].SpentAmount != 1000 { // 1100 / 1.10 = 1000
+		t.Errorf("Period SpentAmount = %d, want 1000", stats[0].SpentAmount)
+	}
+
+	// Update the expense to 15.00 EUR (1500 cents)
+	createdTxn.Amount = 1500
+	updatedTxn, err := svc.UpdateExpense(ctx, createdTxn)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if updatedTxn.AmountInBase != 1650 { // 1500 * 1.10 = 1650
+		t.Errorf("Updated AmountInBase = %d, want 1650", updatedTxn.AmountInBase)
+	}
+
+	// Verify the period updated its spent aggregates to reflect new amount
+	stats2, err := svc.AggregateSpentBatch(ctx, []PeriodID{period.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stats2) != 1 {
+		t.Fatalf("expected 1 stats item, got %d", len(stats2))
+	}
+	if stats2[0].SpentInBase != 1650 {
+		t.Errorf("Period SpentInBase = %d, want 1650", stats2[0].SpentInBase)
+	}
+
+	// 5. Delete transaction
+	err = svc.DeleteTransaction(ctx, spID, createdTxn.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Verify period spent is back to 0
+	stats3, err := svc.AggregateSpentBatch(ctx, []PeriodID{period.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stats3) != 1 {
+		t.Fatalf("expected 1 stats item, got %d", len(stats3))
+	}
+	if stats3[0].SpentInBase != 0 {
+		t.Errorf("After delete, SpentInBase = %d, want 0", stats3[0].SpentInBase)
+	}
+}
+
+func TestIncomeTransactions(t *testing.T) {
+	ctx := context.Background()
+	spIDStr, _ := id.Generate("spc_")
+	spID := SpaceID(spIDStr)
+	accIDStr, _ := id.Generate("acc_")
+	accID := AccountID(accIDStr)
+
+	settingsStore := &mockSettingsStore{data: make(map[SpaceID]*FinanceSettings)}
+	accountStore := &mockAccountStore{data: make(map[AccountID]*Account)}
+	txnStore := &mockTransactionStore{txns: make(map[TransactionID]*Transaction)}
+	eventStore := &mockTransactionEventStore{events: make(map[TransactionEventID]*TransactionEvent)}
+
+	_ = settingsStore.Create(ctx, &FinanceSettings{SpaceID: spID, BaseCurrency: "USD"})
+	_ = accountStore.Create(ctx, &Account{
+		ID:             accID,
+		SpaceID:        spID,
+		Name:           "Checking",
+		Type:           AccountTypeBank,
+		Currency:       "USD",
+		CurrentBalance: 1000,
+		IsActive:       true,
+	})
+
+	svc := NewService(Dependencies{
+		SettingsStore:         settingsStore,
+		AccountStore:          accountStore,
+		TransactionStore:      txnStore,
+		TransactionEventStore: eventStore,
+		IncomeSourceStore:     &mockIncomeSourceStore{data: make(map[IncomeSourceID]*IncomeSource)},
+		PayeeStore:            &mockPayeeStore{data: make(map[PayeeID]*Payee)},
+		TransactionRuleStore:  &mockTransactionRuleStore{data: make(map[TransactionRuleID]*TransactionRule)},
+	})
+
+	source, err := svc.CreateIncomeSource(ctx, &IncomeSource{
+		SpaceID:        spID,
+		Name:           "Salary",
+		Employer:       "Acme Corp",
+		ExpectedAmount: 250000,
+		Currency:       "USD",
+		Cadence:        IncomeCadenceBiweekly,
+		AccountID:      &accID,
+	})
+	if err != nil {
+		t.Fatalf("CreateIncomeSource failed: %v", err)
+	}
+	if !source.IsActive {
+		t.Error("expected new income source to be active")
+	}
+
+	// The deposit account defaults to the one configured on the income source.
+	income, err := svc.CreateIncome(ctx, &Transaction{
+		SpaceID:         spID,
+		Amount:          250000,
+		Currency:        "USD",
+		Description:     "Paycheck",
+		TransactionDate: time.Date(2026, 3, 13, 0, 0, 0, 0, time.UTC),
+		Metadata:        TransactionMetadata{IncomeSourceID: &source.ID},
+	})
+	if err != nil {
+		t.Fatalf("CreateIncome failed: %v", err)
+	}
+	if income.Type != TransactionTypeIncome {
+		t.Errorf("Type = %s, want INCOME", income.Type)
+	}
+	if income.AccountID == nil || *income.AccountID != accID {
+		t.Fatalf("AccountID = %v, want %s", income.AccountID, accID)
+	}
+	if income.BudgetID != nil || income.PeriodID != nil {
+		t.Error("income transactions must not be attached to a budget period")
+	}
+	if got := accountStore.data[accID].CurrentBalance; got != 251000 {
+		t.Errorf("CurrentBalance after income = %d, want 251000", got)
+	}
+
+	update := *income
+	update.Amount = 240000
+	update.Metadata = TransactionMetadata{IncomeSourceID: &source.ID}
+	if _, err := svc.UpdateIncome(ctx, &update); err != nil {
+		t.Fatalf("UpdateIncome failed: %v", err)
+	}
+	if got := accountStore.data[accID].CurrentBalance; got != 241000 {
+		t.Errorf("CurrentBalance after update = %d, want 241000", got)
+	}
+
+	events, _ := svc.ListTransactionEvents(ctx, spID, income.ID)
+	if len(events) != 1 || events[0].EventType != "MANUAL_EDIT" {
+		t.Fatalf("expected a single MANUAL_EDIT event, got %+v", events)
+	}
+	if events[0].Metadata["new_amount"] != int64(240000) {
+		t.Errorf("event new_amount = %v, want 240000", events[0].Metadata["new_amount"])
+	}
+
+	// Updating a non-income transaction through UpdateIncome is rejected.
+	if _, err := svc.AdjustAccountBalance(ctx, spID, accID, 300000, "", ""); err != nil {
+		t.Fatal(err)
+	}
+	var adjustment *Transaction
+	for _, txn := range txnStore.txns {
+		if txn.Type == TransactionTypeBalanceAdjustment {
+			adjustment = txn
+		}
+	}
+	if _, err := svc.UpdateIncome(ctx, &Transaction{ID: adjustment.ID, SpaceID: spID, Amount: 1, Currency: "USD"}); err == nil {
+		t.Error("expected error when updating a non-income transaction as income")
+	}
+
+	if err := svc.DeleteTransaction(ctx, spID, income.ID); err != nil {
+		t.Fatal(err)
+	}
+	if got := accountStore.data[accID].CurrentBalance; got != 60000 {
+		t.Errorf("CurrentBalance after delete = %d, want 60000", got)
+	}
+}
+
+func TestGetSpentInsights_CashFlow(t *testing.T) {
+	ctx := context.Background()
+	spIDStr, _ := id.Generate("spc_")
+	spID := SpaceID(spIDStr)
+
+	settingsStore := &mockSettingsStore{data: make(map[SpaceID]*FinanceSettings)}
+	_ = settingsStore.Create(ctx, &FinanceSettings{SpaceID: spID, BaseCurrency: "USD"})
+
+	jan := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	feb := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	svc := NewService(Dependencies{
+		SettingsStore: settingsStore,
+		GoalStore:     &mockGoalStore{data: make(map[GoalID]*Goal)},
+		InsightsStore: &mockInsightsStore{
+			cashFlowTrend: []*CashFlowTrend{
+				{IntervalStart: jan, IncomeInBase: 500000, ExpenseInBase: 320000},
+				{IntervalStart: feb, IncomeInBase: 500000, ExpenseInBase: 580000},
+			},
+		},
+	})
+
+	insights, err := svc.GetSpentInsights(ctx, &GetSpentInsightsRequest{
+		SpaceID:     spID,
+		Granularity: "monthly",
+		StartDate:   jan,
+		EndDate:     feb.AddDate(0, 1, -1),
+	})
+	if err != nil {
+		t.Fatalf("GetSpentInsights failed: %v", err)
+	}
+
+	cf := insights.CashFlow
+	if cf.TotalIncome != 1000000 || cf.TotalExpense != 900000 || cf.NetCashFlow != 100000 {
+		t.Errorf("cash flow totals = %+v, want income 1000000, expense 900000, net 100000", cf)
+	}
+	if math.Abs(cf.SavingsRate-10.0) > 0.001 {
+		t.Errorf("SavingsRate = %f, want 10", cf.SavingsRate)
+	}
+	if len(cf.Trend) != 2 || cf.Trend[1].NetInBase != -80000 || cf.Trend[1].Label != "Feb 26" {
+		t.Errorf("unexpected cash flow trend: %+v", cf.Trend)
+	}
+}
+
+func TestCategoryHierarchy(t *testing.T) {
+	ctx := context.Background()
+	spIDStr, _ := id.Generate("spc_")
+	spID := SpaceID(spIDStr)
+
+	svc := NewService(Dependencies{
+		CategoryStore: &mockCategoryStore{data: make(map[CategoryID]*Category)},
+	})
+
+	food, err := svc.CreateCategory(ctx, &Category{SpaceID: spID, Name: " Food "})
+	if err != nil {
+		t.Fatalf("CreateCategory failed: %v", err)
+	}
+	if food.Name != "Food" || food.Icon != "tag" || food.Color != "slate" {
+		t.Errorf("unexpected category defaults: %+v", food)
+	}
+
+	groceries, err := svc.CreateCategory(ctx, &Category{SpaceID: spID, Name: "Groceries", ParentID: &food.ID})
+	if err != nil {
+		t.Fatalf("CreateCategory with parent failed: %v", err)
+	}
+
+	missing := CategoryID("cat_2pXqR8kLmNvB3cYtWz9aFgHjK1s")
+	if _, err := svc.CreateCategory(ctx, &Category{SpaceID: spID, Name: "Orphan", ParentID: &missing}); !errors.Is(err, ErrCategoryNotFound) {
+		t.Errorf("expected ErrCategoryNotFound for unknown parent, got %v", err)
+	}
+
+	// Moving a parent under its own child must be rejected.
+	_, err = svc.UpdateCategory(ctx, &Category{ID: food.ID, SpaceID: spID, Name: "Food", ParentID: &groceries.ID})
+	if !errors.Is(err, ErrCategoryCycle) {
+		t.Errorf("expected ErrCategoryCycle, got %v", err)
+	}
+
+	// Nesting deeper than MaxCategoryDepth is rejected.
+	parent := groceries
+	for i := 2; i <= MaxCategoryDepth; i++ {
+		parent, err = svc.CreateCategory(ctx, &Category{SpaceID: spID, Name: fmt.Sprintf("Level %d", i), ParentID: &parent.ID})
+		if err != nil {
+			t.Fatalf("CreateCategory at depth %d failed: %v", i, err)
+		}
+	}
+	if _, err := svc.CreateCategory(ctx, &Category{SpaceID: spID, Name: "Too deep", ParentID: &parent.ID}); err == nil {
+		t.Error("expected error when exceeding maximum category depth")
+	}
+}
+
+func TestExpenseClassification(t *testing.T) {
+	ctx := context.Background()
+	spIDStr, _ := id.Generate("spc_")
+	spID := SpaceID(spIDStr)
+	budIDStr, _ := id.Generate("bgt_")
+	budID := BudgetID(budIDStr)
+
+	settingsStore := &mockSettingsStore{data: make(map[SpaceID]*FinanceSettings)}
+	budgetStore := &mockBudgetStore{data: make(map[BudgetID]*Budget)}
+	txnStore := &mockTransactionStore{txns: make(map[TransactionID]*Transaction)}
+	eventStore := &mockTransactionEventStore{events: make(map[TransactionEventID]*TransactionEvent)}
+	categoryStore := &mockCategoryStore{data: make(map[CategoryID]*Category)}
+
+	_ = settingsStore.Create(ctx, &FinanceSettings{SpaceID: spID, BaseCurrency: "USD"})
+	_ = budgetStore.Create(ctx, &Budget{ID: budID, SpaceID: spID, Name: "Living", LimitAmount: 100000, Currency: "USD", Interval: IntervalMonthly, IsActive: true})
+
+	svc := NewService(Dependencies{
+		SettingsStore:         settingsStore,
+		BudgetStore:           budgetStore,
+		PeriodStore:           &mockPeriodStore{data: make(map[string]*BudgetPeriod)},
+		TransactionStore:      txnStore,
+		TransactionEventStore: eventStore,
+		CategoryStore:         categoryStore,
+		PayeeStore:            &mockPayeeStore{data: make(map[PayeeID]*Payee)},
+		TransactionRuleStore:  &mockTransactionRuleStore{data: make(map[TransactionRuleID]*TransactionRule)},
+	})
+
+	dining, err := svc.CreateCategory(ctx, &Category{SpaceID: spID, Name: "Dining"})
+	if err != nil {
+		t.Fatalf("CreateCategory failed: %v", err)
+	}
+
+	txn, err := svc.CreateExpense(ctx, &Transaction{
+		SpaceID:         spID,
+		BudgetID:        &budID,
+		CategoryID:      &dining.ID,
+		Tags:            []string{" Vacation", "work", "vacation", ""},
+		Amount:          4500,
+		Currency:        "USD",
+		TransactionDate: time.Date(2026, 5, 2, 0, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("CreateExpense failed: %v", err)
+	}
+	if !slices.Equal(txn.Tags, []string{"vacation", "work"}) {
+		t.Errorf("Tags = %v, want [vacation work]", txn.Tags)
+	}
+
+	updated, err := svc.UpdateExpense(ctx, &Transaction{
+		ID:              txn.ID,
+		SpaceID:         spID,
+		BudgetID:        &budID,
+		Tags:            []string{"work"},
+		Amount:          4500,
+		Currency:        "USD",
+		TransactionDate: txn.TransactionDate,
+	})
+	if err != nil {
+		t.Fatalf("UpdateExpense failed: %v", err)
+	}
+	if updated.CategoryID != nil {
+		t.Errorf("expected category to be cleared, got %v", *updated.CategoryID)
+	}
+
+	var edit *TransactionEvent
+	for _, e := range eventStore.events {
+		if e.TransactionID == txn.ID && e.EventType == "MANUAL_EDIT" {
+			edit = e
+		}
+	}
+	if edit == nil {
+		t.Fatal("expected MANUAL_EDIT event")
+	}
+	if edit.Metadata["old_category_id"] != string(dining.ID) {
+		t.Errorf("old_category_id = %v, want %s", edit.Metadata["old_category_id"], dining.ID)
+	}
+	if _, ok := edit.Metadata["new_tags"]; !ok {
+		t.Error("expected new_tags in MANUAL_EDIT diff")
+	}
+
+	unknown := CategoryID("cat_2pXqR8kLmNvB3cYtWz9aFgHjK1s")
+	_, err = svc.CreateExpense(ctx, &Transaction{
+		SpaceID:         spID,
+		BudgetID:        &budID,
+		CategoryID:      &unknown,
+		Amount:          100,
+		Currency:        "USD",
+		TransactionDate: txn.TransactionDate,
+	})
+	if !errors.Is(err, ErrCategoryNotFound) {
+		t.Errorf("expected ErrCategoryNotFound, got %v", err)
+	}
+}
+
+func TestGetSpentInsights_CategoriesAndTags(t *testing.T) {
+	ctx := context.Background()
+	spIDStr, _ := id.Generate("spc_")
+	spID := SpaceID(spIDStr)
+
+	settingsStore := &mockSettingsStore{data: make(map[SpaceID]*FinanceSettings)}
+	_ = settingsStore.Create(ctx, &FinanceSettings{SpaceID: spID, BaseCurrency: "USD"})
+
+	svc := NewService(Dependencies{
+		SettingsStore: settingsStore,
+		GoalStore:     &mockGoalStore{data: make(map[GoalID]*Goal)},
+		InsightsStore: &mockInsightsStore{
+			categories: []*CategoryDistribution{
+				{CategoryID: "cat_food", CategoryName: "Food", TxnCount: 3, SpentInBase: 6000},
+				{CategoryID: "", TxnCount: 1, SpentInBase: 2000},
+			},
+			tags: []*TagDistribution{
+				{Tag: "vacation", TxnCount: 2, SpentInBase: 4000},
+			},
+		},
+	})
+
+	insights, err := svc.GetSpentInsights(ctx, &GetSpentInsightsRequest{SpaceID: spID, Granularity: "monthly"})
+	if err != nil {
+		t.Fatalf("GetSpentInsights failed: %v", err)
+	}
+
+	if len(insights.Categories) != 2 {
+		t.Fatalf("expected 2 category usages, got %d", len(insights.Categories))
+	}
+	if math.Abs(insights.Categories[0].SharePercentage-75.0) > 0.001 {
+		t.Errorf("Food share = %f, want 75", insights.Categories[0].SharePercentage)
+	}
+	if insights.Categories[1].CategoryID != "uncategorized" || insights.Categories[1].CategoryName != "Uncategorized" {
+		t.Errorf("unexpected uncategorized bucket: %+v", insights.Categories[1])
+	}
+	if len(insights.Tags) != 1 || math.Abs(insights.Tags[0].SharePercentage-50.0) > 0.001 {
+		t.Errorf("unexpected tag usages: %+v", insights.Tags)
+	}
+}
+
+func TestSplitTransactions(t *testing.T) {
+	ctx := context.Background()
+	spIDStr, _ := id.Generate("spc_")
+	spID := SpaceID(spIDStr)
+	foodIDStr, _ := id.Generate("bgt_")
+	foodID := BudgetID(foodIDStr)
+	homeIDStr, _ := id.Generate("bgt_")
+	homeID := BudgetID(homeIDStr)
+
+	settingsStore := &mockSettingsStore{data: make(map[SpaceID]*FinanceSettings)}
+	budgetStore := &mockBudgetStore{data: make(map[BudgetID]*Budget)}
+	txnStore := &mockTransactionStore{txns: make(map[TransactionID]*Transaction)}
+
+	_ = settingsStore.Create(ctx, &FinanceSettings{SpaceID: spID, BaseCurrency: "USD"})
+	_ = budgetStore.Create(ctx, &Budget{ID: foodID, SpaceID: spID, Name: "Food", LimitAmount: 50000, Currency: "USD", Interval: IntervalMonthly, IsActive: true})
+	_ = budgetStore.Create(ctx, &Budget{ID: homeID, SpaceID: spID, Name: "Household", LimitAmount: 30000, Currency: "USD", Interval: IntervalMonthly, IsActive: true})
+
+	svc := NewService(Dependencies{
+		SettingsStore:         settingsStore,
+		BudgetStore:           budgetStore,
+		PeriodStore:           &mockPeriodStore{data: make(map[string]*BudgetPeriod)},
+		TransactionStore:      txnStore,
+		TransactionEventStore: &mockTransactionEventStore{events: make(map[TransactionEventID]*TransactionEvent)},
+		ScheduledPaymentStore: &mockScheduledPaymentStore{payments: make(map[ScheduledPaymentID]*ScheduledPayment)},
+		CategoryStore:         &mockCategoryStore{data: make(map[CategoryID]*Category)},
+		PayeeStore:            &mockPayeeStore{data: make(map[PayeeID]*Payee)},
+		TransactionRuleStore:  &mockTransactionRuleStore{data: make(map[TransactionRuleID]*TransactionRule)},
+	})
+
+	date := time.Date(2026, 5, 2, 0, 0, 0, 0, time.UTC)
+
+	t.Run("split expense resolves a period per split line", func(t *testing.T) {
+		txn, err := svc.CreateExpense(ctx, &Transaction{
+			SpaceID:         spID,
+			Amount:          10000,
+			Currency:        "USD",
+			Description:     "Supermarket",
+			TransactionDate: date,
+			Splits: []*TransactionSplit{
+				{BudgetID: &foodID, Amount: 7000, Note: "Groceries"},
+				{BudgetID: &homeID, Amount: 3000, Note: "Cleaning supplies"},
+			},
+		})
+		if err != nil {
+			t.Fatalf("CreateExpense failed: %v", err)
+		}
+		if txn.BudgetID != nil || txn.PeriodID != nil {
+			t.Errorf("expected split transaction to carry no transaction-level budget")
+		}
+		for i, split := range txn.Splits {
+			if split.ID == "" {
+				t.Errorf("split %d: expected generated ID", i)
+			}
+			if split.PeriodID == nil {
+				t.Errorf("split %d: expected resolved period", i)
+			}
+		}
+		if txn.Splits[0].AmountInBase != 7000 || txn.Splits[1].AmountInBase != 3000 {
+			t.Errorf("split base amounts = %d/%d, want 7000/3000", txn.Splits[0].AmountInBase, txn.Splits[1].AmountInBase)
+		}
+
+		err = svc.DeleteBudget(ctx, spID, homeID, DeleteOptions{})
+		if !errors.Is(err, ErrBudgetHasTransactions) {
+			t.Errorf("DeleteBudget error = %v, want %v", err, ErrBudgetHasTransactions)
+		}
+	})
+
+	t.Run("split amounts must add up to the transaction amount", func(t *testing.T) {
+		_, err := svc.CreateExpense(ctx, &Transaction{
+			SpaceID:         spID,
+			Amount:          10000,
+			Currency:        "USD",
+			TransactionDate: date,
+			Splits: []*TransactionSplit{
+				{BudgetID: &foodID, Amount: 7000},
+				{BudgetID: &homeID, Amount: 2000},
+			},
+		})
+		if !errors.Is(err, ErrSplitAmountMismatch) {
+			t.Errorf("CreateExpense error = %v, want %v", err, ErrSplitAmountMismatch)
+		}
+	})
+
+	t.Run("income cannot be split", func(t *testing.T) {
+		_, err := svc.CreateIncome(ctx, &Transaction{
+			SpaceID:         spID,
+			Amount:          10000,
+			Currency:        "USD",
+			TransactionDate: date,
+			Splits: []*TransactionSplit{
+				{Amount: 5000},
+				{Amount: 5000},
+			},
+		})
+		if !errors.Is(err, ErrSplitNotSupported) {
+			t.Errorf("CreateIncome error = %v, want %v", err, ErrSplitNotSupported)
+		}
+	})
+
+	t.Run("update converts a split expense back to a single budget", func(t *testing.T) {
+		txn, err := svc.CreateExpense(ctx, &Transaction{
+			SpaceID:         spID,
+			Amount:          5000,
+			Currency:        "USD",
+			TransactionDate: date,
+			Splits: []*TransactionSplit{
+				{BudgetID: &foodID, Amount: 2500},
+				{BudgetID: &homeID, Amount: 2500},
+			},
+		})
+		if err != nil {
+			t.Fatalf("CreateExpense failed: %v", err)
+		}
+
+		updated, err := svc.UpdateExpense(ctx, &Transaction{
+			ID:              txn.ID,
+			SpaceID:         spID,
+			BudgetID:        &foodID,
+			Amount:          5000,
+			Currency:        "USD",
+			TransactionDate: date,
+		})
+		if err != nil {
+			t.Fatalf("UpdateExpense failed: %v", err)
+		}
+		if updated.IsSplit() {
+			t.Errorf("expected splits to be removed, got %d", len(updated.Splits))
+		}
+		if updated.PeriodID == nil {
+			t.Errorf("expected transaction-level period to be resolved")
+		}
+	})
+}
+
+func TestExchangeRateFallback(t *testing.T) {
+	ctx := context.Background()
+	spaceID := SpaceID("test-space")
+
+	rateStore := &mockExchangeRateStore{rates: make(map[string]*ExchangeRate)}
+	settingsStore := &mockSettingsStore{data: make(map[SpaceID]*FinanceSettings)}
+
+	// Create service
+	svc := NewService(Dependencies{
+		ExchangeRateStore: rateStore,
+		SettingsStore:     settingsStore,
+	})
+
+	// Configure finance settings
+	_ = settingsStore.Create(ctx, &FinanceSettings{
+		SpaceID:      spaceID,
+		BaseCurrency: "USD",
+	})
+
+	// Set an exchange rate for 2026-07-24 (today) and 2026-07-28 (future)
+	rate1 := &ExchangeRate{
+		SpaceID:      spaceID,
+		FromCurrency: "EUR",
+		ToCurrency:   "USD",
+		Rate:         1.10,
+		RateDate:     time.Date(2026, 7, 24, 0, 0, 0, 0, time.UTC),
+	}
+	_ = rateStore.Create(ctx, rate1)
+
+	rate2 := &ExchangeRate{
+		SpaceID:      spaceID,
+		FromCurrency: "EUR",
+		ToCurrency:   "USD",
+		Rate:         1.20,
+		RateDate:     time.Date(2026, 7, 28, 0, 0, 0, 0, time.UTC),
+	}
+	_ = rateStore.Create(ctx, rate2)
+
+	// Test Case 1: Exact date lookup (2026-07-24)
+	r, err := svc.getExchangeRate(ctx, ExchangeRateKey{
+		SpaceID:      spaceID,
+		FromCurrency: "EUR",
+		ToCurrency:   "USD",
+		RateDate:     time.Date(2026, 7, 24, 0, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("Test Case 1 failed: %v", err)
+	}
+	if r.Rate != 1.10 {
+		t.Errorf("Test Case 1: rate = %f, want 1.10", r.Rate)
+	}
+
+	// Test Case 2: Past date before any rates exist (e.g., 2026-07-20)
+	// It should fall back to the oldest future rate (which is Rate 1 on 2026-07-24)
+	r, err = svc.getExchangeRate(ctx, ExchangeRateKey{
+		SpaceID:      spaceID,
+		FromCurrency: "EUR",
+		ToCurrency:   "USD",
+		RateDate:     time.Date(2026, 7, 20, 0, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("Test Case 2 failed: %v", err)
+	}
+	if r.Rate != 1.10 {
+		t.Errorf("Test Case 2: rate = %f, want 1.10 (oldest future rate)", r.Rate)
+	}
+
+	// Test Case 3: Future date (e.g., 2026-07-26)
+	// It should find the closest rate in the past (which is Rate 1 on 2026-07-24)
+	r, err = svc.getExchangeRate(ctx, ExchangeRateKey{
+		SpaceID:      spaceID,
+		FromCurrency: "EUR",
+		ToCurrency:   "USD",
+		RateDate:     time.Date(2026, 7, 26, 0, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("Test Case 3 failed: %v", err)
+	}
+	if r.Rate != 1.10 {
+		t.Errorf("Test Case 3: rate = %f, want 1.10 (most recent past rate)", r.Rate)
+	}
+}
+
+func TestExchangeRateFallbackChain(t *testing.T) {
+	ctx := context.Background()
+	spaceID := SpaceID("test-space")
+	day := func(d int) time.Time { return time.Date(2026, 7, d, 0, 0, 0, 0, time.UTC) }
+
+	rateStore := &mockExchangeRateStore{rates: make(map[string]*ExchangeRate)}
+	svc := NewService(Dependencies{ExchangeRateStore: rateStore})
+
+	_ = rateStore.Create(ctx, &ExchangeRate{SpaceID: spaceID, FromCurrency: "USD", ToCurrency: "EUR", Rate: 0.80, RateDate: day(20)})
+	_ = rateStore.Create(ctx, &ExchangeRate{SpaceID: spaceID, FromCurrency: "GBP", ToCurrency: "USD", Rate: 1.25, RateDate: day(22)})
+	_ = rateStore.Create(ctx, &ExchangeRate{SpaceID: spaceID, FromCurrency: "CAD", ToCurrency: "USD", Rate: 0.70, RateDate: day(26)})
+
+	tests := []struct {
+		name     string
+		from, to Currency
+		date     time.Time
+		wantRate float64
+		wantDate time.Time
+		wantErr  bool
+	}{
+		{name: "inverse of opposite pair", from: "EUR", to: "USD", date: day(24), wantRate: 1.25, wantDate: day(20)},
+		{name: "triangulated through USD", from: "GBP", to: "EUR", date: day(24), wantRate: 1.0, wantDate: day(20)},
+		{name: "triangulated with inverse first leg", from: "EUR", to: "GBP", date: day(24), wantRate: 1.0, wantDate: day(20)},
+		{name: "forward fallback when no previous rate", from: "CAD", to: "USD", date: day(24), wantRate: 0.70, wantDate: day(26)},
+		{name: "unknown pair", from: "JPY", to: "DOP", date: day(24), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := svc.getExchangeRate(ctx, ExchangeRateKey{
+				SpaceID:      spaceID,
+				FromCurrency: tt.from,
+				ToCurrency:   tt.to,
+				RateDate:     tt.date,
+			})
+			if tt.wantErr {
+				if !errors.Is(err, ErrExchangeRateNotFound) {
+					t.Fatalf("expected ErrExchangeRateNotFound, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("getExchangeRate failed: %v", err)
+			}
+			if math.Abs(r.Rate-tt.wantRate) > 1e-9 {
+				t.Errorf("rate = %f, want %f", r.Rate, tt.wantRate)
+			}
+			if !r.RateDate.Equal(tt.wantDate) {
+				t.Errorf("rate date = %v, want %v", r.RateDate, tt.wantDate)
+			}
+			if r.FromCurrency != tt.from || r.ToCurrency != tt.to {
+				t.Errorf("pair = %s/%s, want %s/%s", r.FromCurrency, r.ToCurrency, tt.from, tt.to)
+			}
+		})
+	}
+}
+
+type stubRateProvider struct {
+	snapshot *RateSnapshot
+	calls    int
+}
+
+func (p *stubRateProvider) LatestRates(ctx context.Context) (*RateSnapshot, error) {
+	p.calls++
+	return p.snapshot, nil
+}
+
+func TestSyncExchangeRates(t *testing.T) {
+	ctx := context.Background()
+	spaceA := SpaceID("spc_2dE1V8ZqWz4eS2N9yX3bL1mK7pO")
+	spaceB := SpaceID("spc_2dE1V8ZqWz4eS2N9yX3bL1mK7pP")
+	rateDate := time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC)
+
+	rateStore := &mockExchangeRateStore{
+		rates: make(map[string]*ExchangeRate),
+		pairs: []*CurrencyPair{
+			{SpaceID: spaceA, FromCurrency: "EUR", ToCurrency: "USD"},
+			{SpaceID: spaceA, FromCurrency: "DOP", ToCurrency: "USD"},
+			{SpaceID: spaceB, FromCurrency: "USD", ToCurrency: "GBP"},
+		},
+	}
+	provider := &stubRateProvider{snapshot: &RateSnapshot{
+		Base:  "EUR",
+		Date:  rateDate,
+		Rates: map[Currency]float64{"USD": 1.10, "GBP": 0.88},
+	}}
+
+	// A manually entered rate for the same day must not be overwritten.
+	_ = rateStore.Create(ctx, &ExchangeRate{SpaceID: spaceB, FromCurrency: "USD", ToCurrency: "GBP", Rate: 0.75, RateDate: rateDate})
+
+	svc := NewService(Dependencies{ExchangeRateStore: rateStore, RateProvider: provider})
+	if err := svc.SyncExchangeRates(ctx); err != nil {
+		t.Fatalf("SyncExchangeRates failed: %v", err)
+	}
+
+	if provider.calls != 1 {
+		t.Errorf("expected provider to be called once, got %d", provider.calls)
+	}
+
+	eurUSD, err := rateStore.GetExactRate(ctx, ExchangeRateKey{SpaceID: spaceA, FromCurrency: "EUR", ToCurrency: "USD", RateDate: rateDate})
+	if err != nil {
+		t.Fatalf("expected EUR/USD rate to be stored: %v", err)
+	}
+	if eurUSD.Rate != 1.10 || eurUSD.ID != "rate_EUR_USD_20261016" {
+		t.Errorf("unexpected EUR/USD rate %+v", eurUSD)
+	}
+
+	if _, err := rateStore.GetExactRate(ctx, ExchangeRateKey{SpaceID: spaceA, FromCurrency: "DOP", ToCurrency: "USD", RateDate: rateDate}); !errors.Is(err, ErrExchangeRateNotFound) {
+		t.Errorf("expected unquoted DOP/USD to be skipped, got %v", err)
+	}
+
+	usdGBP, _ := rateStore.GetExactRate(ctx, ExchangeRateKey{SpaceID: spaceB, FromCurrency: "USD", ToCurrency: "GBP", RateDate: rateDate})
+	if usdGBP.Rate != 0.75 {
+		t.Errorf("expected manual USD/GBP rate to be kept, got %f", usdGBP.Rate)
+	}
+
+	t.Run("no provider configured", func(t *testing.T) {
+		svc := NewService(Dependencies{ExchangeRateStore: rateStore})
+		if err := svc.SyncExchangeRates(ctx); err != nil {
+			t.Fatalf("expected no-op without provider, got %v", err)
+		}
+	})
+}
+
+func TestAdjustAccountBalance(t *testing.T) {
+	ctx := context.Background()
+	spIDStr, _ := id.Generate("spc_")
+	spaceID := SpaceID(spIDStr)
+
+	accIDStr, _ := id.Generate("acc_")
+	accID := AccountID(accIDStr)
+
+	accountStore := &mockAccountStore{data: make(map[AccountID]*Account)}
+	txnStore := &mockTransactionStore{txns: make(map[TransactionID]*Transaction)}
+	settingsStore := &mockSettingsStore{data: make(map[SpaceID]*FinanceSettings)}
+	eventStore := &mockTransactionEventStore{events: make(map[TransactionEventID]*TransactionEvent)}
+
+	_ = settingsStore.Create(ctx, &FinanceSettings{
+		SpaceID:      spaceID,
+		BaseCurrency: "USD",
+	})
+
+	initialAcc := &Account{
+		ID:             accID,
+		SpaceID:        spaceID,
+		Name:           "Checking",
+		Type:           AccountTypeBank,
+		Currency:       "USD",
+		CurrentBalance: 5000, // $50.00
+		IsActive:       true,
+	}
+	_ = accountStore.Create(ctx, initialAcc)
+
+	svc := NewService(Dependencies{
+		SettingsStore:         settingsStore,
+		AccountStore:          accountStore,
+		TransactionStore:      txnStore,
+		TransactionEventStore: eventStore,
+	})
+
+	// Test Case 1: Positive Adjustment ($50.00 -> $120.00, Delta = +$70.00)
+	updatedAcc, err := svc.AdjustAccountBalance(ctx, spaceID, accID, 12000, "", "Statement reconciliation")
+	if err != nil {
+		t.Fatalf("AdjustAccountBalance failed: %v", err)
+	}
+
+	if updatedAcc.CurrentBalance != 12000 {
+		t.Errorf("CurrentBalance = %d, want 12000", updatedAcc.CurrentBalance)
+	}
+
+	// Verify logged transaction
+	if len(txnStore.txns) != 1 {
+		t.Fatalf("expected 1 logged transaction, got %d", len(txnStore.txns))
+	}
+
+	var loggedTxn *Transaction
+	for _, tx := range txnStore.txns {
+		loggedTxn = tx
+	}
+
+	if loggedTxn.Type != TransactionTypeBalanceAdjustment {
+		t.Errorf("Type = %s, want BALANCE_ADJUSTMENT", loggedTxn.Type)
+	}
+	if loggedTxn.Amount != 7000 {
+		t.Errorf("Amount = %d, want 7000", loggedTxn.Amount)
+	}
+
+	// Test Case 2: Negative Adjustment ($120.00 -> $80.00, Delta = -$40.00)
+	updatedAcc2, err := svc.AdjustAccountBalance(ctx, spaceID, accID, 8000, "", "Fee adjustment")
+	if err != nil {
+		t.Fatalf("AdjustAccountBalance negative failed: %v", err)
+	}
+
+	if updatedAcc2.CurrentBalance != 8000 {
+		t.Errorf("CurrentBalance = %d, want 8000", updatedAcc2.CurrentBalance)
+	}
+
+	// Test Case 3: Prevent Manual Editing of Balance Adjustment
+	err = svc.updateTransaction(ctx, loggedTxn, loggedTxn)
+	if err == nil {
+		t.Error("expected error when attempting to update balance adjustment transaction, got nil")
+	} else if !strings.Contains(err.Error(), "balance adjustment transactions cannot be edited directly") {
+		t.Errorf("unexpected error message: %v", err)
+	}
+}
+
+func TestCreateBorrowingRepayment_SameCurrencyLent(t *testing.T) {
+	ctx := context.Background()
+	spaceID := SpaceID("sp_test123")
+	accID := AccountID("acc_bank123")
+	borID, _ := NewBorrowingID()
+
+	accountStore := &mockAccountStore{data: make(map[AccountID]*Account)}
+	txnStore := &mockTransactionStore{txns: make(map[TransactionID]*Transaction)}
+	borrowingStore := &mockBorrowingStore{data: make(map[BorrowingID]*Borrowing)}
+	settingsStore := &mockSettingsStore{data: make(map[SpaceID]*FinanceSettings)}
+
+	_ = settingsStore.Create(ctx, &FinanceSettings{SpaceID: spaceID, BaseCurrency: "USD"})
+	_ = accountStore.Create(ctx, &Account{
+		ID:             accID,
+		SpaceID:        spaceID,
+		Name:           "Checking Account",
+		Type:           AccountTypeBank,
+		Currency:       "USD",
+		CurrentBalance: 50000, // $500.00
+		IsActive:       true,
+	})
+	_ = borrowingStore.Create(ctx, &Borrowing{
+		ID:              borID,
+		SpaceID:         spaceID,
+		Direction:       BorrowingDirectionLent,
+		Counterparty:    "John",
+		TotalAmount:     10000, // $100.00
+		RemainingAmount: 10000, // $100.00
+		Currency:        "USD",
+		Status:          BorrowingStatusActive,
+		EstablishedAt:   time.Now().UTC(),
+	})
+
+	svc := NewService(Dependencies{
+		SettingsStore:    settingsStore,
+		AccountStore:     accountStore,
+		BorrowingStore:   borrowingStore,
+		TransactionStore: txnStore,
+	})
+
+	repayment, err := svc.CreateBorrowingRepayment(ctx, &BorrowingRepayment{
+		BorrowingID: borID,
+		SpaceID:     spaceID,
+		Amount:      3000, // $30.00 repayment
+		PaymentDate: time.Now().UTC(),
+		AccountID:   &accID,
+		Notes:       "Part payment from John",
+	})
+	if err != nil {
+		t.Fatalf("CreateBorrowingRepayment failed: %v", err)
+	}
+
+	// Verify Borrowing remaining balance (10000 - 3000 = 7000)
+	b, _ := borrowingStore.GetByID(ctx, spaceID, borID)
+	if b.RemainingAmount != 7000 {
+		t.Errorf("Borrowing remaining balance = %d, want 7000", b.RemainingAmount)
+	}
+
+	// Verify Account balance (50000 + 3000 = 53000 because LENT repayment is INFLOW)
+	acc, _ := accountStore.GetByID(ctx, spaceID, accID)
+	if acc.CurrentBalance != 53000 {
+		t.Errorf("Account balance = %d, want 53000", acc.CurrentBalance)
+	}
+
+	// Verify Repayment Transaction logged
+	txn, err := txnStore.GetByID(ctx, spaceID, TransactionID(repayment.ID))
+	if err != nil {
+		t.Fatalf("Repayment transaction not found: %v", err)
+	}
+	if txn.Type != TransactionTypeIncome {
+		t.Errorf("Transaction type = %s, want INCOME", txn.Type)
+	}
+	if txn.Amount != 3000 {
+		t.Errorf("Transaction amount = %d, want 3000", txn.Amount)
+	}
+}
+
+func TestCreateBorrowingRepayment_MultiCurrency(t *testing.T) {
+	ctx := context.Background()
+	spaceID := SpaceID("sp_test456")
+	accID := AccountID("acc_dop123")
+	borID, _ := NewBorrowingID()
+
+	accountStore := &mockAccountStore{data: make(map[AccountID]*Account)}
+	txnStore := &mockTransactionStore{txns: make(map[TransactionID]*Transaction)}
+	borrowingStore := &mockBorrowingStore{data: make(map[BorrowingID]*Borrowing)}
+	settingsStore := &mockSettingsStore{data: make(map[SpaceID]*FinanceSettings)}
+	rateStore := &mockExchangeRateStore{rates: make(map[string]*ExchangeRate)}
+
+	_ = settingsStore.Create(ctx, &FinanceSettings{SpaceID: spaceID, BaseCurrency: "USD"})
+	_ = rateStore.Create(ctx, &ExchangeRate{
+		SpaceID:      spaceID,
+		FromCurrency: "USD",
+		ToCurrency:   "DOP",
+		Rate:         60.0,
+		RateDate:     time.Now().UTC(),
+	})
+
+	_ = accountStore.Create(ctx, &Account{
+		ID:             accID,
+		SpaceID:        spaceID,
+		Name:           "Dominican Bank Account",
+		Type:           AccountTypeBank,
+		Currency:       "DOP",
+		CurrentBalance: 5000000, // 50,000.00 DOP
+		IsActive:       true,
+	})
+	_ = borrowingStore.Create(ctx, &Borrowing{
+		ID:              borID,
+		SpaceID:         spaceID,
+		Direction:       BorrowingDirectionLent,
+		Counterparty:    "Maria",
+		TotalAmount:     10000, // $100.00 USD
+		RemainingAmount: 10000, // $100.00 USD
+		Currency:        "USD",
+		Status:          BorrowingStatusActive,
+		EstablishedAt:   time.Now().UTC(),
+	})
+
+	svc := NewService(Dependencies{
+		SettingsStore:     settingsStore,
+		ExchangeRateStore: rateStore,
+		AccountStore:      accountStore,
+		BorrowingStore:    borrowingStore,
+		TransactionStore:  txnStore,
+	})
+
+	repayment, err := svc.CreateBorrowingRepayment(ctx, &BorrowingRepayment{
+		BorrowingID: borID,
+		SpaceID:     spaceID,
+		Amount:      1000, // $10.00 USD repayment
+		PaymentDate: time.Now().UTC(),
+		AccountID:   &accID,
+		Notes:       "Repayment from Maria in DOP",
+	})
+	if err != nil {
+		t.Fatalf("CreateBorrowingRepayment multi-currency failed: %v", err)
+	}
+
+	// Verify Borrowing remaining balance ($100 - $10 = $90 USD)
+	b, _ := borrowingStore.GetByID(ctx, spaceID, borID)
+	if b.RemainingAmount != 9000 {
+		t.Errorf("Borrowing remaining balance = %d, want 9000 USD", b.RemainingAmount)
+	}
+
+	// Verify DOP Account balance: 50,000 DOP + ($10 * 60 = 600 DOP = 60000 DOP cents)
+	acc, _ := accountStore.GetByID(ctx, spaceID, accID)
+	expectedDOPBalance := int64(5000000 + 60000)
+	if acc.CurrentBalance != expectedDOPBalance {
+		t.Errorf("Account DOP balance = %d, want %d", acc.CurrentBalance, expectedDOPBalance)
+	}
+
+	// Verify Deleting Repayment rolls back both DOP Account and USD Borrowing
+	err = svc.DeleteBorrowingRepayment(ctx, DeleteBorrowingRepaymentRequest{
+		SpaceID:     spaceID,
+		BorrowingID: borID,
+		ID:          repayment.ID,
+	})
+	if err != nil {
+		t.Fatalf("DeleteBorrowingRepayment failed: %v", err)
+	}
+
+	bAfterDelete, _ := borrowingStore.GetByID(ctx, spaceID, borID)
+	if bAfterDelete.RemainingAmount != 10000 {
+		t.Errorf("Borrowing balance after deletion = %d, want 10000 USD", bAfterDelete.RemainingAmount)
+	}
+
+	accAfterDelete, _ := accountStore.GetByID(ctx, spaceID, accID)
+	if accAfterDelete.CurrentBalance != 5000000 {
+		t.Errorf("Account DOP balance after deletion = %d, want 5000000 DOP", accAfterDelete.CurrentBalance)
+	}
+}
+
+func TestService_CreateTransfer(t *testing.T) {
+	spIDStr, _ := id.Generate("spc_")
+	spID := SpaceID(spIDStr)
+	otherSpIDStr, _ := id.Generate("spc_")
+	otherSpID := SpaceID(otherSpIDStr)
+
+	ctx := context.Background()
+
+	srcUSD, _ := NewAccountID()
+	dstUSD, _ := NewAccountID()
+	dstEUR, _ := NewAccountID()
+	otherSpaceAcc, _ := NewAccountID()
+
+	tests := []struct {
+		name               string
+		transfer           *Transfer
+		setupAccounts      func(accStore *mockAccountStore)
+		wantErr            bool
+		errContains        string
+		expectedSrcBalance int64
+		expectedDstBalance int64
+	}{
+		{
+			name: "Success - Single Currency (USD to USD)",
+			transfer: &Transfer{
+				SpaceID:              spID,
+				SourceAccountID:      srcUSD,
+				DestinationAccountID: dstUSD,
+				SourceAmount:         40000,
+				DestinationAmount:    40000,
+				TransferDate:         time.Now().UTC(),
+				Notes:                "Single currency savings transfer",
+			},
+			setupAccounts: func(as *mockAccountStore) {
+				_ = as.Create(ctx, &Account{ID: srcUSD, SpaceID: spID, Name: "Checking USD", Currency: "USD", CurrentBalance: 100000, IsActive: true})
+				_ = as.Create(ctx, &Account{ID: dstUSD, SpaceID: spID, Name: "Savings USD", Currency: "USD", CurrentBalance: 0, IsActive: true})
+			},
+			wantErr:            false,
+			expectedSrcBalance: 60000,
+			expectedDstBalance: 40000,
+		},
+		{
+			name: "Success - Multi Currency (EUR to USD)",
+			transfer: &Transfer{
+				SpaceID:              spID,
+				SourceAccountID:      srcUSD,
+				DestinationAccountID: dstEUR,
+				SourceAmount:         50000, // €500
+				DestinationAmount:    54000, // $540
+				TransferDate:         time.Now().UTC(),
+				Notes:                "Multi currency transfer",
+			},
+			setupAccounts: func(as *mockAccountStore) {
+				_ = as.Create(ctx, &Account{ID: srcUSD, SpaceID: spID, Name: "Checking USD", Currency: "USD", CurrentBalance: 100000, IsActive: true})
+				_ = as.Create(ctx, &Account{ID: dstEUR, SpaceID: spID, Name: "Savings EUR", Currency: "EUR", CurrentBalance: 0, IsActive: true})
+			},
+			wantErr:            false,
+			expectedSrcBalance: 50000,
+			expectedDstBalance: 54000,
+		},
+		{
+			name: "Err - Same Source and Destination Account",
+			transfer: &Transfer{
+				SpaceID:              spID,
+				SourceAccountID:      srcUSD,
+				DestinationAccountID: srcUSD,
+				SourceAmount:         10000,
+				DestinationAmount:    10000,
+				TransferDate:         time.Now().UTC(),
+			},
+			setupAccounts: func(as *mockAccountStore) {
+				_ = as.Create(ctx, &Account{ID: srcUSD, SpaceID: spID, Name: "Checking USD", Currency: "USD", CurrentBalance: 100000, IsActive: true})
+			},
+			wantErr:     true,
+			errContains: "source and destination accounts must be different",
+		},
+		{
+			name: "Err - Single Currency Mismatched Amounts",
+			transfer: &Transfer{
+				SpaceID:              spID,
+				SourceAccountID:      srcUSD,
+				DestinationAccountID: dstUSD,
+				SourceAmount:         50000,
+				DestinationAmount:    40000,
+				TransferDate:         time.Now().UTC(),
+			},
+			setupAccounts: func(as *mockAccountStore) {
+				_ = as.Create(ctx, &Account{ID: srcUSD, SpaceID: spID, Name: "Checking USD", Currency: "USD", CurrentBalance: 100000, IsActive: true})
+				_ = as.Create(ctx, &Account{ID: dstUSD, SpaceID: spID, Name: "Savings USD", Currency: "USD", CurrentBalance: 0, IsActive: true})
+			},
+			wantErr:     true,
+			errContains: "source and destination amounts must match for single-currency transfers",
+		},
+		{
+			name: "Err - Zero or Negative Source Amount",
+			transfer: &Transfer{
+				SpaceID:              spID,
+				SourceAccountID:      srcUSD,
+				DestinationAccountID: dstUSD,
+				SourceAmount:         0,
+				DestinationAmount:    10000,
+				TransferDate:         time.Now().UTC(),
+			},
+			setupAccounts: func(as *mockAccountStore) {
+				_ = as.Create(ctx, &Account{ID: srcUSD, SpaceID: spID, Name: "Checking USD", Currency: "USD", CurrentBalance: 100000, IsActive: true})
+				_ = as.Create(ctx, &Account{ID: dstUSD, SpaceID: spID, Name: "Savings USD", Currency: "USD", CurrentBalance: 0, IsActive: true})
+			},
+			wantErr:     true,
+			errContains: "source amount must be greater than zero",
+		},
+		{
+			name: "Err - Account From Different Space",
+			transfer: &Transfer{
+				SpaceID:              spID,
+				SourceAccountID:      srcUSD,
+				DestinationAccountID: otherSpaceAcc,
+				SourceAmount:         10000,
+				DestinationAmount:    10000,
+				TransferDate:         time.Now().UTC(),
+			},
+			setupAccounts: func(as *mockAccountStore) {
+				_ = as.Create(ctx, &Account{ID: srcUSD, SpaceID: spID, Name: "Checking USD", Currency: "USD", CurrentBalance: 100000, IsActive: true})
+				_ = as.Create(ctx, &Account{ID: otherSpaceAcc, SpaceID: otherSpID, Name: "Foreign Space Account", Currency: "USD", CurrentBalance: 0, IsActive: true})
+			},
+			wantErr:     true,
+			errContains: "destination account",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			settingsStore := &mockSettingsStore{data: make(map[SpaceID]*FinanceSettings)}
+			_ = settingsStore.Create(ctx, &FinanceSettings{SpaceID: spID, BaseCurrency: "USD"})
+			_ = settingsStore.Create(ctx, &FinanceSettings{SpaceID: otherSpID, BaseCurrency: "USD"})
+
+			rateStore := &mockExchangeRateStore{rates: make(map[string]*ExchangeRate)}
+			_ = rateStore.Create(ctx, &ExchangeRate{
+				SpaceID:      spID,
+				FromCurrency: "EUR",
+				ToCurrency:   "USD",
+				Rate:         1.08,
+				RateDate:     time.Now().UTC(),
+			})
+
+			accountStore := &mockAccountStore{data: make(map[AccountID]*Account)}
+			tt.setupAccounts(accountStore)
+
+			transactionStore := &mockTransactionStore{txns: make(map[TransactionID]*Transaction)}
+			transferStore := &mockTransferStore{data: make(map[TransferID]*Transfer)}
+
+			svc := NewService(Dependencies{
+				SettingsStore:         settingsStore,
+				ExchangeRateStore:     rateStore,
+				AccountStore:          accountStore,
+				TransactionStore:      transactionStore,
+				TransferStore:         transferStore,
+				TransactionEventStore: &mockTransactionEventStore{events: make(map[TransactionEventID]*TransactionEvent)},
+			})
+
+			res, err := svc.CreateTransfer(ctx, tt.transfer)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error containing %q, got nil", tt.errContains)
+				}
+				if !strings.Contains(err.Error(), tt.errContains) {
+					t.Fatalf("error = %v, want error containing %q", err, tt.errContains)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected CreateTransfer error: %v", err)
+			}
+
+			// Verify balances
+			srcAcc, _ := accountStore.GetByID(ctx, spID, tt.transfer.SourceAccountID)
+			if srcAcc.CurrentBalance != tt.expectedSrcBalance {
+				t.Errorf("Source account balance = %d, want %d", srcAcc.CurrentBalance, tt.expectedSrcBalance)
+			}
+
+			dstAcc, _ := accountStore.GetByID(ctx, spID, tt.transfer.DestinationAccountID)
+			if dstAcc.CurrentBalance != tt.expectedDstBalance {
+				t.Errorf("Destination account balance = %d, want %d", dstAcc.CurrentBalance, tt.expectedDstBalance)
+			}
+
+			// Verify transaction legs
+			var outflow, inflow *Transaction
+			for _, tx := range transactionStore.txns {
+				if tx.Metadata.TransferID != nil && *tx.Metadata.TransferID == res.ID {
+					switch tx.Type {
+					case TransactionTypeTransferOut:
+						outflow = tx
+					case TransactionTypeTransferIn:
+						inflow = tx
+					}
+				}
+			}
+			if outflow == nil || inflow == nil {
+				t.Fatalf("expected both outflow and inflow transaction legs created")
+			}
+			if *outflow.Metadata.CounterpartAccountID != tt.transfer.DestinationAccountID {
+				t.Errorf("outflow counterpart = %s, want %s", *outflow.Metadata.CounterpartAccountID, tt.transfer.DestinationAccountID)
+			}
+			if *inflow.Metadata.CounterpartAccountID != tt.transfer.SourceAccountID {
+				t.Errorf("inflow counterpart = %s, want %s", *inflow.Metadata.CounterpartAccountID, tt.transfer.SourceAccountID)
+			}
+		})
+	}
+}
+
+func TestService_DeleteTransfer(t *testing.T) {
+	ctx := context.Background()
+	spIDStr, _ := id.Generate("spc_")
+	spID := SpaceID(spIDStr)
+
+	srcAccID, _ := NewAccountID()
+	dstAccID, _ := NewAccountID()
+
+	tests := []struct {
+		name        string
+		transferID  TransferID
+		setupData   func(svc *Service, accStore *mockAccountStore) TransferID
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name: "Success - Delete Existing Transfer & Roll Back Balances",
+			setupData: func(svc *Service, as *mockAccountStore) TransferID {
+				_ = as.Create(ctx, &Account{ID: srcAccID, SpaceID: spID, Name: "Checking", Currency: "USD", CurrentBalance: 100000, IsActive: true})
+				_ = as.Create(ctx, &Account{ID: dstAccID, SpaceID: spID, Name: "Savings", Currency: "USD", CurrentBalance: 0, IsActive: true})
+				tr, _ := svc.CreateTransfer(ctx, &Transfer{
+					SpaceID:              spID,
+					SourceAccountID:      srcAccID,
+					DestinationAccountID: dstAccID,
+					SourceAmount:         30000,
+					DestinationAmount:    30000,
+					TransferDate:         time.Now().UTC(),
+				})
+				return tr.ID
+			},
+			wantErr: false,
+		},
+		{
+			name: "Err - Transfer Not Found",
+			setupData: func(svc *Service, as *mockAccountStore) TransferID {
+				dummyID, _ := NewTransferID()
+				return dummyID
+			},
+			wantErr:     true,
+			errContains: "transfer not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			settingsStore := &mockSettingsStore{data: make(map[SpaceID]*FinanceSettings)}
+			_ = settingsStore.Create(ctx, &FinanceSettings{SpaceID: spID, BaseCurrency: "USD"})
+
+			accountStore := &mockAccountStore{data: make(map[AccountID]*Account)}
+			transactionStore := &mockTransactionStore{txns: make(map[TransactionID]*Transaction)}
+			transferStore := &mockTransferStore{data: make(map[TransferID]*Transfer)}
+
+			svc := NewService(Dependencies{
+				SettingsStore:         settingsStore,
+				AccountStore:          accountStore,
+				TransactionStore:      transactionStore,
+				TransferStore:         transferStore,
+				TransactionEventStore: &mockTransactionEventStore{events: make(map[TransactionEventID]*TransactionEvent)},
+			})
+
+			targetID := tt.setupData(svc, accountStore)
+
+			err := svc.DeleteTransfer(ctx, spID, targetID)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error containing %q, got nil", tt.errContains)
+				}
+				if !strings.Contains(err.Error(), tt.errContains) {
+					t.Fatalf("error = %v, want error containing %q", err, tt.errContains)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected DeleteTransfer error: %v", err)
+			}
+
+			// Assert Transfer record deleted
+			_, err = transferStore.GetByID(ctx, spID, targetID)
+			if err == nil {
+				t.Errorf("expected transfer record to be deleted")
+			}
+
+			// Assert Account Balances restored ($1000 and $0)
+			sAcc, _ := accountStore.GetByID(ctx, spID, srcAccID)
+			if sAcc.CurrentBalance != 100000 {
+				t.Errorf("Source account balance after deletion = %d, want 100000", sAcc.CurrentBalance)
+			}
+			dAcc, _ := accountStore.GetByID(ctx, spID, dstAccID)
+			if dAcc.CurrentBalance != 0 {
+				t.Errorf("Destination account balance after deletion = %d, want 0", dAcc.CurrentBalance)
+			}
+		})
+	}
+}
+
+func TestService_GetAndListTransfers(t *testing.T) {
+	ctx := context.Background()
+	spIDStr, _ := id.Generate("spc_")
+	spID := SpaceID(spIDStr)
+
+	settingsStore := &mockSettingsStore{data: make(map[SpaceID]*FinanceSettings)}
+	_ = settingsStore.Create(ctx, &FinanceSettings{SpaceID: spID, BaseCurrency: "USD"})
+
+	accountStore := &mockAccountStore{data: make(map[AccountID]*Account)}
+	transactionStore := &mockTransactionStore{txns: make(map[TransactionID]*Transaction)}
+	transferStore := &mockTransferStore{data: make(map[TransferID]*Transfer)}
+
+	svc := NewService(Dependencies{
+		SettingsStore:         settingsStore,
+		AccountStore:          accountStore,
+		TransactionStore:      transactionStore,
+		TransferStore:         transferStore,
+		TransactionEventStore: &mockTransactionEventStore{events: make(map[TransactionEventID]*TransactionEvent)},
+	})
+
+	srcAccID, _ := NewAccountID()
+	dstAccID, _ := NewAccountID()
+
+	srcAcc := &Account{
+		ID:             srcAccID,
+		SpaceID:        spID,
+		Name:           "Checking",
+		Currency:       Currency("USD"),
+		CurrentBalance: 100000,
+		IsActive:       true,
+	}
+	dstAcc := &Account{
+		ID:             dstAccID,
+		SpaceID:        spID,
+		Name:           "Savings",
+		Currency:       Currency("USD"),
+		CurrentBalance: 0,
+		IsActive:       true,
+	}
+	_ = accountStore.Create(ctx, srcAcc)
+	_ = accountStore.Create(ctx, dstAcc)
+
+	tr, err := svc.CreateTransfer(ctx, &Transfer{
+		SpaceID:              spID,
+		SourceAccountID:      srcAcc.ID,
+		DestinationAccountID: dstAcc.ID,
+		SourceAmount:         15000,
+		DestinationAmount:    15000,
+		TransferDate:         time.Now().UTC(),
+	})
+	if err != nil {
+		t.Fatalf("CreateTransfer failed: %v", err)
+	}
+
+	// Test GetTransfer
+	fetched, err := svc.GetTransfer(ctx, spID, tr.ID)
+	if err != nil {
+		t.Fatalf("GetTransfer failed: %v", err)
+	}
+	if fetched.ID != tr.ID {
+		t.Errorf("GetTransfer ID = %s, want %s", fetched.ID, tr.ID)
 	}
 
 	// Test ListTransfers
 	list, _, err := svc.ListTransfers(ctx, spID, 10, "")
 	if err != nil {
-		t.Fatalf("ListTransfers failed: %v", err)
+		t.Fatalf("ListTransfers failed: %v", err)
+	}
+	if len(list) != 1 {
+		t.Errorf("ListTransfers count = %d, want 1", len(list))
+	}
+}
+
+type mockRecurringExpenseStore struct {
+	data map[RecurringExpenseID]*RecurringExpense
+}
+
+func (m *mockRecurringExpenseStore) Create(ctx context.Context, re *RecurringExpense) error {
+	m.data[re.ID] = re
+	return nil
+}
+
+func (m *mockRecurringExpenseStore) GetByID(ctx context.Context, spaceID SpaceID, id RecurringExpenseID) (*RecurringExpense, error) {
+	re, ok := m.data[id]
+	if !ok || re.SpaceID != spaceID {
+		return nil, errors.New("recurring expense not found")
+	}
+	return re, nil
+}
+
+func (m *mockRecurringExpenseStore) GetByIDs(ctx context.Context, spaceID SpaceID, ids []RecurringExpenseID) ([]*RecurringExpense, error) {
+	var list []*RecurringExpense
+	for _, id := range ids {
+		if re, ok := m.data[id]; ok && re.SpaceID == spaceID {
+			list = append(list, re)
+		}
+	}
+	return list, nil
+}
+
+func (m *mockRecurringExpenseStore) Update(ctx context.Context, re *RecurringExpense) error {
+	m.data[re.ID] = re
+	return nil
+}
+
+func (m *mockRecurringExpenseStore) Delete(ctx context.Context, id RecurringExpenseID) error {
+	delete(m.data, id)
+	return nil
+}
+
+func (m *mockRecurringExpenseStore) ListBySpace(ctx context.Context, spaceID SpaceID, filter *ListRecurringExpensesFilter) (*paging.Page[*RecurringExpense], error) {
+	var list []*RecurringExpense
+	for _, re := range m.data {
+		if re.SpaceID != spaceID {
+			continue
+		}
+		if filter != nil && filter.Status != nil && re.Status != *filter.Status {
+			continue
+		}
+		list = append(list, re)
+	}
+	return &paging.Page[*RecurringExpense]{Items: list}, nil
+}
+
+func (m *mockRecurringExpenseStore) ListPendingGeneration(ctx context.Context, maxDueDate time.Time) ([]*RecurringExpense, error) {
+	var list []*RecurringExpense
+	for _, re := range m.data {
+		if re.Status == RecurringExpenseActive && !re.NextDueDate.After(maxDueDate) {
+			list = append(list, re)
+		}
+	}
+	return list, nil
+}
+
+type mockInboxItemStore struct {
+	items map[string]*InboxItem
+}
+
+func (m *mockInboxItemStore) Insert(ctx context.Context, item *InboxItem) error {
+	if m.items == nil {
+		m.items = make(map[string]*InboxItem)
+	}
+	m.items[item.ID] = item
+	return nil
+}
+
+func (m *mockInboxItemStore) Get(ctx context.Context, spaceID SpaceID, id string) (*InboxItem, error) {
+	item, ok := m.items[id]
+	if !ok || item.SpaceID != string(spaceID) {
+		return nil, errors.New("inbox item not found")
+	}
+	return item, nil
+}
+
+func (m *mockInboxItemStore) ListBySpace(ctx context.Context, spaceID SpaceID, filter *ListInboxItemsFilter) (*paging.Page[*InboxItem], error) {
+	var list []*InboxItem
+	for _, item := range m.items {
+		if item.SpaceID == string(spaceID) {
+			list = append(list, item)
+		}
+	}
+	return &paging.Page[*InboxItem]{Items: list}, nil
+}
+
+func (m *mockInboxItemStore) Update(ctx context.Context, item *InboxItem) error {
+	m.items[item.ID] = item
+	return nil
+}
+
+func (m *mockInboxItemStore) Delete(ctx context.Context, spaceID SpaceID, id string) error {
+	delete(m.items, id)
+	return nil
+}
+
+func (m *mockInboxItemStore) ListExternalIDs(ctx context.Context, spaceID SpaceID, accountID AccountID, externalIDs []string) (map[string]bool, error) {
+	found := make(map[string]bool)
+	for _, item := range m.items {
+		if item.SpaceID != string(spaceID) || item.ExternalID == nil || item.AccountID == nil || *item.AccountID != string(accountID) {
+			continue
+		}
+		if slices.Contains(externalIDs, *item.ExternalID) {
+			found[*item.ExternalID] = true
+		}
+	}
+	return found, nil
+}
+
+type mockStatementMappingStore struct {
+	data map[AccountID]*StatementMapping
+}
+
+func (m *mockStatementMappingStore) Get(ctx context.Context, spaceID SpaceID, accountID AccountID) (*StatementMapping, error) {
+	mapping, ok := m.data[accountID]
+	if !ok || mapping.SpaceID != spaceID {
+		return nil, ErrStatementMappingNotFound
+	}
+	return mapping, nil
+}
+
+func (m *mockStatementMappingStore) Upsert(ctx context.Context, mapping *StatementMapping) error {
+	m.data[mapping.AccountID] = mapping
+	return nil
+}
+
+func TestService_StatementMapping(t *testing.T) {
+	ctx := context.Background()
+	spIDStr, _ := id.Generate("spc_")
+	spID := SpaceID(spIDStr)
+	accIDStr, _ := id.Generate("acc_")
+	accID := AccountID(accIDStr)
+
+	accountStore := &mockAccountStore{data: make(map[AccountID]*Account)}
+	_ = accountStore.Create(ctx, &Account{ID: accID, SpaceID: spID, Name: "Checking", Currency: "USD"})
+	inboxStore := &mockInboxItemStore{items: make(map[string]*InboxItem)}
+
+	svc := NewService(Dependencies{
+		AccountStore:          accountStore,
+		InboxItemStore:        inboxStore,
+		StatementMappingStore: &mockStatementMappingStore{data: make(map[AccountID]*StatementMapping)},
+		PayeeStore:            &mockPayeeStore{data: make(map[PayeeID]*Payee)},
+		TransactionRuleStore:  &mockTransactionRuleStore{data: make(map[TransactionRuleID]*TransactionRule)},
+	})
+
+	if _, err := svc.GetStatementMapping(ctx, spID, accID); !errors.Is(err, ErrStatementMappingNotFound) {
+		t.Fatalf("expected ErrStatementMappingNotFound, got %v", err)
+	}
+
+	saved, err := svc.SaveStatementMapping(ctx, &StatementMapping{
+		AccountID:         accID,
+		SpaceID:           spID,
+		HasHeader:         true,
+		DateColumn:        "Date",
+		AmountColumn:      "Amount",
+		DescriptionColumn: "Payee",
+	})
+	if err != nil {
+		t.Fatalf("SaveStatementMapping failed: %v", err)
+	}
+	if saved.Delimiter != "," || saved.DateFormat != "2006-01-02" || saved.UpdateTime.IsZero() {
+		t.Errorf("expected mapping defaults to be applied, got %+v", saved)
+	}
+
+	otherAccStr, _ := id.Generate("acc_")
+	if _, err := svc.SaveStatementMapping(ctx, &StatementMapping{
+		AccountID:         AccountID(otherAccStr),
+		SpaceID:           spID,
+		DateColumn:        "0",
+		AmountColumn:      "1",
+		DescriptionColumn: "2",
+	}); !errors.Is(err, ErrAccountNotFound) {
+		t.Errorf("expected ErrAccountNotFound for unknown account, got %v", err)
+	}
+
+	extID := "FIT-1"
+	if _, err := svc.StageInboxItem(ctx, spID, &StageInboxItem{
+		Source:     InboxItemSourceStatementOFX,
+		ExternalID: &extID,
+		AccountID:  &accIDStr,
+		DocType:    InboxItemDocBankNotification,
+		Amount:     1200,
+		Currency:   "USD",
+		Vendor:     "Bakery",
+	}); err != nil {
+		t.Fatalf("StageInboxItem failed: %v", err)
+	}
+
+	staged, err := svc.ListStagedExternalIDs(ctx, spID, accID, []string{"FIT-1", "FIT-2"})
+	if err != nil {
+		t.Fatalf("ListStagedExternalIDs failed: %v", err)
+	}
+	if !staged["FIT-1"] || staged["FIT-2"] {
+		t.Errorf("unexpected staged external IDs: %v", staged)
+	}
+}
+
+func TestService_ApproveInboxItem(t *testing.T) {
+	ctx := context.Background()
+	spIDStr, _ := id.Generate("spc_")
+	spID := SpaceID(spIDStr)
+
+	tests := []struct {
+		name                 string
+		docType              InboxItemDocType
+		metadata             map[string]any
+		linkExistingTxn      bool
+		initialTxnAmount     int64
+		initialTxnDesc       string
+		expectedTxnAmount    int64
+		expectedTxnDesc      string
+		expectedTransferMade bool
+		expectErr            bool
+	}{
+		{
+			name:    "Receipt linked transaction with overwrite_linked_transaction = true",
+			docType: InboxItemDocReceipt,
+			metadata: map[string]any{
+				"overwrite_linked_transaction": true,
+			},
+			linkExistingTxn:   true,
+			initialTxnAmount:  1000,
+			initialTxnDesc:    "Old Merchant",
+			expectedTxnAmount: 4500,
+			expectedTxnDesc:   "New Supermarket",
+			expectErr:         false,
+		},
+		{
+			name:    "Receipt linked transaction with overwrite_linked_transaction = false",
+			docType: InboxItemDocReceipt,
+			metadata: map[string]any{
+				"overwrite_linked_transaction": false,
+			},
+			linkExistingTxn:   true,
+			initialTxnAmount:  1000,
+			initialTxnDesc:    "Old Merchant",
+			expectedTxnAmount: 1000,
+			expectedTxnDesc:   "Old Merchant",
+			expectErr:         false,
+		},
+		{
+			name:    "System verification docType resolves immediately",
+			docType: InboxItemDocSystemVerification,
+			metadata: map[string]any{
+				"verification_code": "123456",
+			},
+			linkExistingTxn: false,
+			expectErr:       false,
+		},
+		{
+			name:    "Transfer transaction_type creates ledger transfer",
+			docType: InboxItemDocReceipt,
+			metadata: map[string]any{
+				"transaction_type":       "TRANSFER",
+				"destination_account_id": "DESTINATION_ACCOUNT_PLACEHOLDER",
+			},
+			linkExistingTxn:      false,
+			expectedTransferMade: true,
+			expectErr:            false,
+		},
+		{
+			name:    "Bank Notification transfer source leg creates ledger transfer",
+			docType: InboxItemDocBankNotification,
+			metadata: map[string]any{
+				"transaction_type":       "TRANSFER",
+				"destination_account_id": "DESTINATION_ACCOUNT_PLACEHOLDER",
+				"transfer_leg":           "SOURCE",
+			},
+			linkExistingTxn:      false,
+			expectedTransferMade: true,
+			expectErr:            false,
+		},
+		{
+			name:    "Bank Notification transfer destination leg creates ledger transfer",
+			docType: InboxItemDocBankNotification,
+			metadata: map[string]any{
+				"transaction_type":       "TRANSFER",
+				"destination_account_id": "DESTINATION_ACCOUNT_PLACEHOLDER",
+				"transfer_leg":           "DESTINATION",
+			},
+			linkExistingTxn:      false,
+			expectedTransferMade: true,
+			expectErr:            false,
+		},
+		{
+			name:    "Bank Notification standalone income",
+			docType: InboxItemDocBankNotification,
+			metadata: map[string]any{
+				"transaction_type": "INCOME",
+			},
+			linkExistingTxn: false,
+			expectErr:       false,
+		},
+		{
+			name:    "Bank Notification standalone expense",
+			docType: InboxItemDocBankNotification,
+			metadata: map[string]any{
+				"transaction_type": "EXPENSE",
+			},
+			linkExistingTxn: false,
+			expectErr:       false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			settingsStore := &mockSettingsStore{data: make(map[SpaceID]*FinanceSettings)}
+			_ = settingsStore.Create(ctx, &FinanceSettings{SpaceID: spID, BaseCurrency: Currency("USD")})
+
+			accountStore := &mockAccountStore{data: make(map[AccountID]*Account)}
+			srcAccID, _ := NewAccountID()
+			srcAcc := &Account{
+				ID:             srcAccID,
+				SpaceID:        spID,
+				Name:           "Checking",
+				Currency:       Currency("USD"),
+				CurrentBalance: 100000,
+				IsActive:       true,
+			}
+			_ = accountStore.Create(ctx, srcAcc)
+
+			dstAccID, _ := NewAccountID()
+			dstAcc := &Account{
+				ID:             dstAccID,
+				SpaceID:        spID,
+				Name:           "Savings",
+				Currency:       Currency("USD"),
+				CurrentBalance: 0,
+				IsActive:       true,
+			}
+			_ = accountStore.Create(ctx, dstAcc)
+
+			budgetStore := &mockBudgetStore{data: make(map[BudgetID]*Budget)}
+			bID, _ := NewBudgetID()
+			bg := &Budget{
+				ID:          bID,
+				SpaceID:     spID,
+				Name:        "General",
+				LimitAmount: 50000,
+				Currency:    Currency("USD"),
+			}
+			_ = budgetStore.Create(ctx, bg)
+
+			periodStore := &mockPeriodStore{data: make(map[string]*BudgetPeriod)}
+
+			txnStore := &mockTransactionStore{txns: make(map[TransactionID]*Transaction)}
+			eventStore := &mockTransactionEventStore{events: make(map[TransactionEventID]*TransactionEvent)}
+			transferStore := &mockTransferStore{data: make(map[TransferID]*Transfer)}
+			scheduledStore := &mockScheduledPaymentStore{payments: make(map[ScheduledPaymentID]*ScheduledPayment)}
+			inboxStore := &mockInboxItemStore{items: make(map[string]*InboxItem)}
+
+			svc := NewService(Dependencies{
+				SettingsStore:         settingsStore,
+				AccountStore:          accountStore,
+				TransactionStore:      txnStore,
+				TransactionEventStore: eventStore,
+				TransferStore:         transferStore,
+				ScheduledPaymentStore: scheduledStore,
+				InboxItemStore:        inboxStore,
+				BudgetStore:           budgetStore,
+				PeriodStore:           periodStore,
+				PayeeStore:            &mockPayeeStore{data: make(map[PayeeID]*Payee)},
+				TransactionRuleStore:  &mockTransactionRuleStore{data: make(map[TransactionRuleID]*TransactionRule)},
+			})
+
+			// Prepare metadata overrides with valid destination account ID
+			metadata := make(map[string]any)
+			for k, v := range tt.metadata {
+				if v == "DESTINATION_ACCOUNT_PLACEHOLDER" {
+					metadata[k] = string(dstAccID)
+				} else {
+					metadata[k] = v
+				}
+			}
+
+			// Stage item
+			srcAccStr := string(srcAccID)
+			bIDStr := string(bID)
+			staged, err := svc.StageInboxItem(ctx, spID, &StageInboxItem{
+				DocType:    tt.docType,
+				Vendor:     "New Supermarket",
+				Amount:     4500,
+				Currency:   "USD",
+				AccountID:  &srcAccStr,
+				RawPayload: "{}",
+				Metadata:   metadata,
+			})
+			if err != nil {
+				t.Fatalf("StageInboxItem failed: %v", err)
+			}
+			if err != nil {
+				t.Fatalf("StageInboxItem failed: %v", err)
+			}
+			staged.BudgetID = &bIDStr
+			_, _ = svc.UpdateInboxItem(ctx, spID, staged)
+
+			var existingTxnID TransactionID
+			if tt.linkExistingTxn {
+				existingTxnID, _ = NewTransactionID()
+				txn := &Transaction{
+					ID:              existingTxnID,
+					SpaceID:         spID,
+					AccountID:       &srcAccID,
+					Amount:          tt.initialTxnAmount,
+					Currency:        Currency("USD"),
+					Description:     tt.initialTxnDesc,
+					TransactionDate: time.Now().UTC(),
+				}
+				_ = txnStore.Create(ctx, txn)
+
+				txnIDStr := string(existingTxnID)
+				staged.TransactionID = &txnIDStr
+				_, _ = svc.UpdateInboxItem(ctx, spID, staged)
+			}
+
+			_, err = svc.ApproveInboxItem(ctx, spID, staged.ID)
+			if (err != nil) != tt.expectErr {
+				t.Fatalf("ApproveInboxItem error = %v, expectErr = %v", err, tt.expectErr)
+			}
+
+			if tt.linkExistingTxn && !tt.expectErr {
+				updatedTxn, err := txnStore.GetByID(ctx, spID, existingTxnID)
+				if err != nil {
+					t.Fatalf("GetByID failed: %v", err)
+				}
+				if updatedTxn.Amount != tt.expectedTxnAmount {
+					t.Errorf("Txn Amount = %d, want %d", updatedTxn.Amount, tt.expectedTxnAmount)
+				}
+				if updatedTxn.Description != tt.expectedTxnDesc {
+					t.Errorf("Txn Description = %q, want %q", updatedTxn.Description, tt.expectedTxnDesc)
+				}
+			}
+
+			if tt.expectedTransferMade {
+				if len(transferStore.data) != 1 {
+					t.Errorf("Transfer count = %d, want 1", len(transferStore.data))
+				}
+			}
+
+			// Verify status marked resolved
+			finalItem, err := inboxStore.Get(ctx, spID, staged.ID)
+			if err != nil {
+				t.Fatalf("Get inbox item failed: %v", err)
+			}
+			if finalItem.Status != InboxItemResolved {
+				t.Errorf("InboxItem Status = %s, want %s", finalItem.Status, InboxItemResolved)
+			}
+		})
+	}
+}
+
+func TestService_ApproveInboxItem_Splits(t *testing.T) {
+	ctx := context.Background()
+	spIDStr, _ := id.Generate("spc_")
+	spID := SpaceID(spIDStr)
+	foodIDStr, _ := id.Generate("bgt_")
+	foodID := BudgetID(foodIDStr)
+	pharmacyIDStr, _ := id.Generate("bgt_")
+	pharmacyID := BudgetID(pharmacyIDStr)
+
+	settingsStore := &mockSettingsStore{data: make(map[SpaceID]*FinanceSettings)}
+	budgetStore := &mockBudgetStore{data: make(map[BudgetID]*Budget)}
+	txnStore := &mockTransactionStore{txns: make(map[TransactionID]*Transaction)}
+	inboxStore := &mockInboxItemStore{items: make(map[string]*InboxItem)}
+
+	_ = settingsStore.Create(ctx, &FinanceSettings{SpaceID: spID, BaseCurrency: "USD"})
+	_ = budgetStore.Create(ctx, &Budget{ID: foodID, SpaceID: spID, Name: "Food", LimitAmount: 50000, Currency: "USD", Interval: IntervalMonthly, IsActive: true})
+	_ = budgetStore.Create(ctx, &Budget{ID: pharmacyID, SpaceID: spID, Name: "Pharmacy", LimitAmount: 10000, Currency: "USD", Interval: IntervalMonthly, IsActive: true})
+
+	svc := NewService(Dependencies{
+		SettingsStore:         settingsStore,
+		BudgetStore:           budgetStore,
+		PeriodStore:           &mockPeriodStore{data: make(map[string]*BudgetPeriod)},
+		TransactionStore:      txnStore,
+		TransactionEventStore: &mockTransactionEventStore{events: make(map[TransactionEventID]*TransactionEvent)},
+		InboxItemStore:        inboxStore,
+		CategoryStore:         &mockCategoryStore{data: make(map[CategoryID]*Category)},
+	})
+
+	foodStr, pharmacyStr := string(foodID), string(pharmacyID)
+	_ = inboxStore.Insert(ctx, &InboxItem{
+		ID:              "ibx_split",
+		SpaceID:         string(spID),
+		Status:          InboxItemPending,
+		DocType:         InboxItemDocReceipt,
+		Amount:          8000,
+		Currency:        "USD",
+		VendorName:      "Corner Store",
+		TransactionDate: time.Date(2026, 5, 2, 0, 0, 0, 0, time.UTC),
+		Metadata:        map[string]any{"transaction_type": "EXPENSE"},
+		Splits: []*InboxItemSplit{
+			{BudgetID: &foodStr, Amount: 6500, Note: "Groceries"},
+			{BudgetID: &pharmacyStr, Amount: 1500, Note: "Vitamins"},
+		},
+	})
+
+	item, err := svc.ApproveInboxItem(ctx, spID, "ibx_split")
+	if err != nil {
+		t.Fatalf("ApproveInboxItem failed: %v", err)
+	}
+	if item.TransactionID == nil {
+		t.Fatal("expected inbox item to be linked to a transaction")
+	}
+
+	txn := txnStore.txns[TransactionID(*item.TransactionID)]
+	if txn == nil {
+		t.Fatal("expected promoted transaction to be persisted")
+	}
+	if len(txn.Splits) != 2 {
+		t.Fatalf("expected 2 splits, got %d", len(txn.Splits))
+	}
+	if *txn.Splits[1].BudgetID != pharmacyID || txn.Splits[1].Amount != 1500 || txn.Splits[1].PeriodID == nil {
+		t.Errorf("unexpected pharmacy split: %+v", txn.Splits[1])
+	}
+	if txn.BudgetID != nil {
+		t.Errorf("expected no transaction-level budget on split transaction")
+	}
+}
+
+func TestService_SystemVerification(t *testing.T) {
+	ctx := context.Background()
+	spIDStr, _ := id.Generate("spc_")
+	spID := SpaceID(spIDStr)
+
+	tests := []struct {
+		name     string
+		action   string // "approve" or "discard"
+		docType  InboxItemDocType
+		metadata map[string]any
+	}{
+		{
+			name:    "Approve system verification email resolves without ledger changes",
+			action:  "approve",
+			docType: InboxItemDocSystemVerification,
+			metadata: map[string]any{
+				"verification_code": "987654",
+				"sender":            "no-reply@auth.com",
+			},
+		},
+		{
+			name:    "Discard system verification email deletes item from queue",
+			action:  "discard",
+			docType: InboxItemDocSystemVerification,
+			metadata: map[string]any{
+				"verification_link": "https://auth.com/verify?token=abc",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			settingsStore := &mockSettingsStore{data: make(map[SpaceID]*FinanceSettings)}
+			_ = settingsStore.Create(ctx, &FinanceSettings{SpaceID: spID, BaseCurrency: Currency("USD")})
+
+			txnStore := &mockTransactionStore{txns: make(map[TransactionID]*Transaction)}
+			eventStore := &mockTransactionEventStore{events: make(map[TransactionEventID]*TransactionEvent)}
+			transferStore := &mockTransferStore{data: make(map[TransferID]*Transfer)}
+			scheduledStore := &mockScheduledPaymentStore{payments: make(map[ScheduledPaymentID]*ScheduledPayment)}
+			inboxStore := &mockInboxItemStore{items: make(map[string]*InboxItem)}
+
+			svc := NewService(Dependencies{
+				SettingsStore:         settingsStore,
+				TransactionStore:      txnStore,
+				TransactionEventStore: eventStore,
+				TransferStore:         transferStore,
+				ScheduledPaymentStore: scheduledStore,
+				InboxItemStore:        inboxStore,
+				PayeeStore:            &mockPayeeStore{data: make(map[PayeeID]*Payee)},
+				TransactionRuleStore:  &mockTransactionRuleStore{data: make(map[TransactionRuleID]*TransactionRule)},
+			})
+
+			// Stage system verification item
+			staged, err := svc.StageInboxItem(ctx, spID, &StageInboxItem{
+				DocType:    tt.docType,
+				Vendor:     "Auth System",
+				RawPayload: "Your verification code is 987654",
+				Metadata:   tt.metadata,
+			})
+			if err != nil {
+				t.Fatalf("StageInboxItem failed: %v", err)
+			}
+
+			if staged.DocType != InboxItemDocSystemVerification {
+				t.Fatalf("DocType = %s, want %s", staged.DocType, InboxItemDocSystemVerification)
+			}
+
+			switch tt.action {
+			case "approve":
+				_, err := svc.ApproveInboxItem(ctx, spID, staged.ID)
+				if err != nil {
+					t.Fatalf("ApproveInboxItem failed: %v", err)
+				}
+
+				// Verify item status resolved
+				item, err := inboxStore.Get(ctx, spID, staged.ID)
+				if err != nil {
+					t.Fatalf("Get inbox item failed: %v", err)
+				}
+				if item.Status != InboxItemResolved {
+					t.Errorf("Status = %s, want %s", item.Status, InboxItemResolved)
+				}
+
+			case "discard":
+				err := svc.DiscardInboxItem(ctx, spID, staged.ID)
+				if err != nil {
+					t.Fatalf("DiscardInboxItem failed: %v", err)
+				}
+
+				// Verify item deleted from store
+				_, err = inboxStore.Get(ctx, spID, staged.ID)
+				if err == nil {
+					t.Errorf("Expected error fetching discarded item, got nil")
+				}
+			}
+
+			// Assert 0 side-effect ledger entities created across all cases
+			if len(txnStore.txns) != 0 {
+				t.Errorf("Transactions created = %d, want 0", len(txnStore.txns))
+			}
+			if len(transferStore.data) != 0 {
+				t.Errorf("Transfers created = %d, want 0", len(transferStore.data))
+			}
+			if len(scheduledStore.payments) != 0 {
+				t.Errorf("Scheduled payments created = %d, want 0", len(scheduledStore.payments))
+			}
+		})
+	}
+}
+
+func TestService_InvoiceBranch(t *testing.T) {
+	ctx := context.Background()
+	spIDStr, _ := id.Generate("spc_")
+	spID := SpaceID(spIDStr)
+
+	tests := []struct {
+		name                     string
+		action                   string // "approve" or "discard"
+		linkScheduledPayment     bool
+		paymentInitialStatus     ScheduledPaymentStatus
+		expectedScheduledCount   int
+		expectedTransactionCount int
+		expectPaidTxnLinked      bool
+	}{
+		{
+			name:                     "Unlinked invoice creates new scheduled payment",
+			action:                   "approve",
+			linkScheduledPayment:     false,
+			expectedScheduledCount:   1,
+			expectedTransactionCount: 0,
+			expectPaidTxnLinked:      false,
+		},
+		{
+			name:                     "Linked invoice to unpaid scheduled payment updates bill figures",
+			action:                   "approve",
+			linkScheduledPayment:     true,
+			paymentInitialStatus:     ScheduledPaymentPending,
+			expectedScheduledCount:   1,
+			expectedTransactionCount: 0,
+			expectPaidTxnLinked:      false,
+		},
+		{
+			name:                     "Linked invoice to already paid scheduled payment attaches audit link to paid transaction",
+			action:                   "approve",
+			linkScheduledPayment:     true,
+			paymentInitialStatus:     ScheduledPaymentStatus("paid"),
+			expectedScheduledCount:   1,
+			expectedTransactionCount: 1,
+			expectPaidTxnLinked:      true,
+		},
+		{
+			name:                     "Discard invoice deletes item from queue",
+			action:                   "discard",
+			linkScheduledPayment:     false,
+			expectedScheduledCount:   0,
+			expectedTransactionCount: 0,
+			expectPaidTxnLinked:      false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			settingsStore := &mockSettingsStore{data: make(map[SpaceID]*FinanceSettings)}
+			_ = settingsStore.Create(ctx, &FinanceSettings{SpaceID: spID, BaseCurrency: Currency("USD")})
+
+			accountStore := &mockAccountStore{data: make(map[AccountID]*Account)}
+			accID, _ := NewAccountID()
+			_ = accountStore.Create(ctx, &Account{
+				ID:             accID,
+				SpaceID:        spID,
+				Name:           "Checking",
+				Currency:       Currency("USD"),
+				CurrentBalance: 100000,
+				IsActive:       true,
+			})
+
+			txnStore := &mockTransactionStore{txns: make(map[TransactionID]*Transaction)}
+			eventStore := &mockTransactionEventStore{events: make(map[TransactionEventID]*TransactionEvent)}
+			transferStore := &mockTransferStore{data: make(map[TransferID]*Transfer)}
+			scheduledStore := &mockScheduledPaymentStore{payments: make(map[ScheduledPaymentID]*ScheduledPayment)}
+			inboxStore := &mockInboxItemStore{items: make(map[string]*InboxItem)}
+
+			svc := NewService(Dependencies{
+				SettingsStore:         settingsStore,
+				AccountStore:          accountStore,
+				TransactionStore:      txnStore,
+				TransactionEventStore: eventStore,
+				TransferStore:         transferStore,
+				ScheduledPaymentStore: scheduledStore,
+				InboxItemStore:        inboxStore,
+				PayeeStore:            &mockPayeeStore{data: make(map[PayeeID]*Payee)},
+				TransactionRuleStore:  &mockTransactionRuleStore{data: make(map[TransactionRuleID]*TransactionRule)},
+			})
+
+			var preExistingPayID ScheduledPaymentID
+			var preExistingTxnID TransactionID
+
+			if tt.linkScheduledPayment {
+				preExistingPayID, _ = NewScheduledPaymentID()
+				pay := &ScheduledPayment{
+					ID:         preExistingPayID,
+					SpaceID:    spID,
+					SourceType: "Electric Co",
+					SourceID:   "src_elec",
+					Amount:     10000,
+					Currency:   Currency("USD"),
+					DueDate:    time.Now().UTC(),
+					Status:     tt.paymentInitialStatus,
+				}
+
+				if tt.expectPaidTxnLinked {
+					preExistingTxnID, _ = NewTransactionID()
+					txn := &Transaction{
+						ID:              preExistingTxnID,
+						SpaceID:         spID,
+						AccountID:       &accID,
+						Amount:          10000,
+						Currency:        Currency("USD"),
+						Description:     "Electric Co Bill Paid",
+						TransactionDate: time.Now().UTC(),
+					}
+					_ = txnStore.Create(ctx, txn)
+				}
+
+				_ = scheduledStore.Create(ctx, pay)
+			}
+
+			bID, _ := NewBudgetID()
+			bIDStr := string(bID)
+
+			// Stage Invoice item
+			accIDStr := string(accID)
+			staged, err := svc.StageInboxItem(ctx, spID, &StageInboxItem{
+				DocType:    InboxItemDocInvoice,
+				Vendor:     "Electric Co",
+				Amount:     12500, // $125.00
+				Currency:   "USD",
+				AccountID:  &accIDStr,
+				Date:       time.Now().UTC().Format(time.RFC3339),
+				RawPayload: "{}",
+			})
+			if err != nil {
+				t.Fatalf("StageInboxItem failed: %v", err)
+			}
+			staged.BudgetID = &bIDStr
+			_, _ = svc.UpdateInboxItem(ctx, spID, staged)
+
+			if tt.linkScheduledPayment {
+				pIDStr := string(preExistingPayID)
+				staged.ScheduledPaymentID = &pIDStr
+				if tt.expectPaidTxnLinked {
+					tIDStr := string(preExistingTxnID)
+					staged.TransactionID = &tIDStr
+				}
+				_, _ = svc.UpdateInboxItem(ctx, spID, staged)
+			}
+
+			switch tt.action {
+			case "approve":
+				_, err := svc.ApproveInboxItem(ctx, spID, staged.ID)
+				if err != nil {
+					t.Fatalf("ApproveInboxItem failed: %v", err)
+				}
+
+				item, err := inboxStore.Get(ctx, spID, staged.ID)
+				if err != nil {
+					t.Fatalf("Get inbox item failed: %v", err)
+				}
+				if item.Status != InboxItemResolved {
+					t.Errorf("InboxItem Status = %s, want %s", item.Status, InboxItemResolved)
+				}
+
+				if tt.expectPaidTxnLinked {
+					if item.TransactionID == nil || *item.TransactionID != string(preExistingTxnID) {
+						t.Errorf("Item TransactionID = %v, want %s", item.TransactionID, preExistingTxnID)
+					}
+				}
+
+			case "discard":
+				err := svc.DiscardInboxItem(ctx, spID, staged.ID)
+				if err != nil {
+					t.Fatalf("DiscardInboxItem failed: %v", err)
+				}
+
+				_, err = inboxStore.Get(ctx, spID, staged.ID)
+				if err == nil {
+					t.Errorf("Expected error fetching discarded invoice, got nil")
+				}
+			}
+
+			if len(scheduledStore.payments) != tt.expectedScheduledCount {
+				t.Errorf("Scheduled Payments count = %d, want %d", len(scheduledStore.payments), tt.expectedScheduledCount)
+			}
+			if len(txnStore.txns) != tt.expectedTransactionCount {
+				t.Errorf("Transactions count = %d, want %d", len(txnStore.txns), tt.expectedTransactionCount)
+			}
+		})
+	}
+}
+
+type mockReconciliationStore struct {
+	data map[ReconciliationID]*Reconciliation
+}
+
+func (m *mockReconciliationStore) Create(ctx context.Context, r *Reconciliation) error {
+	m.data[r.ID] = r
+	return nil
+}
+
+func (m *mockReconciliationStore) GetByID(ctx context.Context, spaceID SpaceID, id ReconciliationID) (*Reconciliation, error) {
+	r, ok := m.data[id]
+	if !ok || r.SpaceID != spaceID {
+		return nil, ErrReconciliationNotFound
+	}
+	return r, nil
+}
+
+func (m *mockReconciliationStore) Update(ctx context.Context, r *Reconciliation) error {
+	if _, ok := m.data[r.ID]; !ok {
+		return ErrReconciliationNotFound
+	}
+	m.data[r.ID] = r
+	return nil
+}
+
+func (m *mockReconciliationStore) Delete(ctx context.Context, spaceID SpaceID, id ReconciliationID) error {
+	if _, ok := m.data[id]; !ok {
+		return ErrReconciliationNotFound
+	}
+	delete(m.data, id)
+	return nil
+}
+
+func (m *mockReconciliationStore) ListByAccount(ctx context.Context, spaceID SpaceID, accountID AccountID) ([]*Reconciliation, error) {
+	var list []*Reconciliation
+	for _, r := range m.data {
+		if r.SpaceID == spaceID && r.AccountID == accountID {
+			list = append(list, r)
+		}
+	}
+	slices.SortFunc(list, func(a, b *Reconciliation) int { return b.StatementDate.Compare(a.StatementDate) })
+	return list, nil
+}
+
+func TestService_Reconciliation(t *testing.T) {
+	ctx := context.Background()
+	spIDStr, _ := id.Generate("spc_")
+	spaceID := SpaceID(spIDStr)
+	accIDStr, _ := id.Generate("acc_")
+	accID := AccountID(accIDStr)
+
+	accountStore := &mockAccountStore{data: make(map[AccountID]*Account)}
+	txnStore := &mockTransactionStore{txns: make(map[TransactionID]*Transaction)}
+	settingsStore := &mockSettingsStore{data: make(map[SpaceID]*FinanceSettings)}
+	reconStore := &mockReconciliationStore{data: make(map[ReconciliationID]*Reconciliation)}
+
+	_ = settingsStore.Create(ctx, &FinanceSettings{SpaceID: spaceID, BaseCurrency: "USD"})
+	_ = accountStore.Create(ctx, &Account{
+		ID:             accID,
+		SpaceID:        spaceID,
+		Name:           "Checking",
+		Type:           AccountTypeBank,
+		Currency:       "USD",
+		InitialBalance: 10000,
+		CurrentBalance: 12000,
+		IsActive:       true,
+	})
+
+	newTxn := func(txnType TransactionType, amount int64, date time.Time) *Transaction {
+		tID, _ := NewTransactionID()
+		accountID := accID
+		txn := &Transaction{
+			ID:              tID,
+			SpaceID:         spaceID,
+			Type:            txnType,
+			AccountID:       &accountID,
+			Amount:          amount,
+			Currency:        "USD",
+			AmountInBase:    amount,
+			TransactionDate: date,
+		}
+		_ = txnStore.Create(ctx, txn)
+		return txn
+	}
+	groceries := newTxn(TransactionTypeExpense, 2000, time.Date(2026, 3, 5, 10, 0, 0, 0, time.UTC))
+	salary := newTxn(TransactionTypeIncome, 5000, time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC))
+	lateFee := newTxn(TransactionTypeExpense, 1000, time.Date(2026, 3, 20, 12, 0, 0, 0, time.UTC))
+
+	svc := NewService(Dependencies{
+		SettingsStore:       settingsStore,
+		AccountStore:        accountStore,
+		TransactionStore:    txnStore,
+		ReconciliationStore: reconStore,
+	})
+
+	t.Run("Balance and lock cleared transactions", func(t *testing.T) {
+		r, err := svc.StartReconciliation(ctx, &Reconciliation{
+			SpaceID:          spaceID,
+			AccountID:        accID,
+			StatementDate:    time.Date(2026, 3, 15, 18, 30, 0, 0, time.UTC),
+			StatementBalance: 13000,
+		})
+		if err != nil {
+			t.Fatalf("StartReconciliation failed: %v", err)
+		}
+		if r.OpeningBalance != 10000 || r.ClearedBalance != 10000 || r.Difference != 3000 {
+			t.Errorf("unexpected initial balances: opening=%d cleared=%d difference=%d", r.OpeningBalance, r.ClearedBalance, r.Difference)
+		}
+
+		if _, err := svc.StartReconciliation(ctx, &Reconciliation{SpaceID: spaceID, AccountID: accID, StatementDate: time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC)}); !errors.Is(err, ErrReconciliationInProgress) {
+			t.Errorf("expected ErrReconciliationInProgress, got %v", err)
+		}
+
+		// The late fee is dated after the statement, so it stays out of this session.
+		r, err = svc.SetTransactionsCleared(ctx, spaceID, r.ID, []TransactionID{groceries.ID, salary.ID, lateFee.ID}, true)
+		if err != nil {
+			t.Fatalf("SetTransactionsCleared failed: %v", err)
+		}
+		if r.ClearedBalance != 13000 || r.Difference != 0 || r.ClearedCount != 2 {
+			t.Errorf("unexpected cleared balances: cleared=%d difference=%d count=%d", r.ClearedBalance, r.Difference, r.ClearedCount)
+		}
+
+		r, err = svc.FinalizeReconciliation(ctx, spaceID, r.ID, false)
+		if err != nil {
+			t.Fatalf("FinalizeReconciliation failed: %v", err)
+		}
+		if !r.IsFinalized() || r.FinalizeTime == nil || r.AdjustmentTransactionID != nil {
+			t.Errorf("expected finalized session without adjustment, got %+v", r)
+		}
+		if !groceries.IsReconciled() || !salary.IsReconciled() || lateFee.IsReconciled() {
+			t.Errorf("expected only statement-period transactions to be locked")
+		}
+
+		if err := svc.DeleteTransaction(ctx, spaceID, groceries.ID); !errors.Is(err, ErrTransactionReconciled) {
+			t.Errorf("expected ErrTransactionReconciled on delete, got %v", err)
+		}
+		edit := *groceries
+		edit.Description = "Edited"
+		budgetID := BudgetID("bgt_groceries")
+		edit.BudgetID = &budgetID
+		if _, err := svc.UpdateExpense(ctx, &edit); !errors.Is(err, ErrTransactionReconciled) {
+			t.Errorf("expected ErrTransactionReconciled on update, got %v", err)
+		}
+		if _, err := svc.SetTransactionsCleared(ctx, spaceID, r.ID, []TransactionID{groceries.ID}, false); !errors.Is(err, ErrReconciliationFinalized) {
+			t.Errorf("expected ErrReconciliationFinalized, got %v", err)
+		}
+	})
+
+	t.Run("Book adjustment for remaining difference", func(t *testing.T) {
+		if _, err := svc.StartReconciliation(ctx, &Reconciliation{SpaceID: spaceID, AccountID: accID, StatementDate: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)}); err == nil {
+			t.Errorf("expected error for statement date before the last reconciliation")
+		}
+
+		r, err := svc.StartReconciliation(ctx, &Reconciliation{
+			SpaceID:          spaceID,
+			AccountID:        accID,
+			StatementDate:    time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC),
+			StatementBalance: 11500,
+		})
+		if err != nil {
+			t.Fatalf("StartReconciliation failed: %v", err)
+		}
+		// Opening balance carries over from the previous statement and the late fee is already cleared.
+		if r.OpeningBalance != 13000 || r.ClearedBalance != 12000 || r.Difference != -500 {
+			t.Errorf("unexpected balances: opening=%d cleared=%d difference=%d", r.OpeningBalance, r.ClearedBalance, r.Difference)
+		}
+
+		if _, err := svc.FinalizeReconciliation(ctx, spaceID, r.ID, false); !errors.Is(err, ErrReconciliationUnbalanced) {
+			t.Fatalf("expected ErrReconciliationUnbalanced, got %v", err)
+		}
+
+		r, err = svc.FinalizeReconciliation(ctx, spaceID, r.ID, true)
+		if err != nil {
+			t.Fatalf("FinalizeReconciliation with adjustment failed: %v", err)
+		}
+		if r.AdjustmentTransactionID == nil || r.Difference != 0 || r.ClearedBalance != 11500 {
+			t.Fatalf("expected balanced session with adjustment, got %+v", r)
+		}
+
+		adjustment := txnStore.txns[*r.AdjustmentTransactionID]
+		if adjustment.Type != TransactionTypeBalanceAdjustment || adjustment.Amount != -500 || !adjustment.IsReconciled() {
+			t.Errorf("unexpected adjustment transaction: %+v", adjustment)
+		}
+		acc, _ := accountStore.GetByID(ctx, spaceID, accID)
+		if acc.CurrentBalance != 11500 {
+			t.Errorf("CurrentBalance = %d, want 11500", acc.CurrentBalance)
+		}
+
+		history, err := svc.ListReconciliations(ctx, spaceID, accID)
+		if err != nil {
+			t.Fatalf("ListReconciliations failed: %v", err)
+		}
+		if len(history) != 2 || history[0].ID != r.ID {
+			t.Errorf("expected 2 sessions with the latest first, got %d", len(history))
+		}
+
+		txns, err := svc.ListReconciliationTransactions(ctx, spaceID, r.ID)
+		if err != nil {
+			t.Fatalf("ListReconciliationTransactions failed: %v", err)
+		}
+		if len(txns) != 2 {
+			t.Errorf("expected late fee and adjustment in the session, got %d", len(txns))
+		}
+	})
+}
+
+func TestService_ReconciledTransactionLock(t *testing.T) {
+	ctx := context.Background()
+	spIDStr, _ := id.Generate("spc_")
+	spaceID := SpaceID(spIDStr)
+	srcAccID, _ := NewAccountID()
+	dstAccID, _ := NewAccountID()
+	reconID := ReconciliationID("rec_locked")
+
+	type fixture struct {
+		svc        *Service
+		accounts   *mockAccountStore
+		txns       *mockTransactionStore
+		transfers  *mockTransferStore
+		borrowings *mockBorrowingStore
+		inbox      *mockInboxItemStore
+	}
+	newFixture := func() *fixture {
+		settingsStore := &mockSettingsStore{data: make(map[SpaceID]*FinanceSettings)}
+		_ = settingsStore.Create(ctx, &FinanceSettings{SpaceID: spaceID, BaseCurrency: "USD"})
+		f := &fixture{
+			accounts:   &mockAccountStore{data: make(map[AccountID]*Account)},
+			txns:       &mockTransactionStore{txns: make(map[TransactionID]*Transaction)},
+			transfers:  &mockTransferStore{data: make(map[TransferID]*Transfer)},
+			borrowings: &mockBorrowingStore{data: make(map[BorrowingID]*Borrowing)},
+			inbox:      &mockInboxItemStore{items: make(map[string]*InboxItem)},
+		}
+		_ = f.accounts.Create(ctx, &Account{ID: srcAccID, SpaceID: spaceID, Name: "Checking", Type: AccountTypeBank, Currency: "USD", CurrentBalance: 100000, IsActive: true})
+		_ = f.accounts.Create(ctx, &Account{ID: dstAccID, SpaceID: spaceID, Name: "Savings", Type: AccountTypeBank, Currency: "USD", IsActive: true})
+		f.svc = NewService(Dependencies{
+			SettingsStore:         settingsStore,
+			AccountStore:          f.accounts,
+			TransactionStore:      f.txns,
+			TransferStore:         f.transfers,
+			BorrowingStore:        f.borrowings,
+			InboxItemStore:        f.inbox,
+			TransactionEventStore: &mockTransactionEventStore{events: make(map[TransactionEventID]*TransactionEvent)},
+		})
+		return f
+	}
+	// lockAll marks every stored transaction as reconciled and returns the account balance to compare.
+	lockAll := func(f *fixture) int64 {
+		for _, txn := range f.txns.txns {
+			txn.ReconciliationID = &reconID
+		}
+		acc, _ := f.accounts.GetByID(ctx, spaceID, srcAccID)
+		return acc.CurrentBalance
+	}
+	assertBalance := func(t *testing.T, f *fixture, want int64) {
+		t.Helper()
+		acc, _ := f.accounts.GetByID(ctx, spaceID, srcAccID)
+		if acc.CurrentBalance != want {
+			t.Errorf("account balance = %d, want %d", acc.CurrentBalance, want)
+		}
+	}
+	createBorrowing := func(t *testing.T, f *fixture) *Borrowing {
+		t.Helper()
+		accID := srcAccID
+		b, err := f.svc.CreateBorrowing(ctx, &Borrowing{
+			SpaceID:       spaceID,
+			Direction:     BorrowingDirectionLent,
+			Counterparty:  "John",
+			TotalAmount:   10000,
+			Currency:      "USD",
+			EstablishedAt: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+			AccountID:     &accID,
+		}, true)
+		if err != nil {
+			t.Fatalf("CreateBorrowing failed: %v", err)
+		}
+		return b
+	}
+
+	t.Run("DeleteTransfer", func(t *testing.T) {
+		f := newFixture()
+		tr, err := f.svc.CreateTransfer(ctx, &Transfer{
+			SpaceID:              spaceID,
+			SourceAccountID:      srcAccID,
+			DestinationAccountID: dstAccID,
+			SourceAmount:         30000,
+			DestinationAmount:    30000,
+			TransferDate:         time.Now().UTC(),
+		})
+		if err != nil {
+			t.Fatalf("CreateTransfer failed: %v", err)
+		}
+		balance := lockAll(f)
+
+		if err := f.svc.DeleteTransfer(ctx, spaceID, tr.ID); !errors.Is(err, ErrTransactionReconciled) {
+			t.Fatalf("expected ErrTransactionReconciled, got %v", err)
+		}
+		if _, err := f.transfers.GetByID(ctx, spaceID, tr.ID); err != nil || len(f.txns.txns) != 2 {
+			t.Errorf("expected the transfer and both legs to be kept, got %d legs (%v)", len(f.txns.txns), err)
+		}
+		assertBalance(t, f, balance)
+	})
+
+	t.Run("DeleteBorrowingRepayment", func(t *testing.T) {
+		f := newFixture()
+		b := createBorrowing(t, f)
+		accID := srcAccID
+		r, err := f.svc.CreateBorrowingRepayment(ctx, &BorrowingRepayment{
+			BorrowingID: b.ID,
+			SpaceID:     spaceID,
+			Amount:      3000,
+			PaymentDate: time.Now().UTC(),
+			AccountID:   &accID,
+		})
+		if err != nil {
+			t.Fatalf("CreateBorrowingRepayment failed: %v", err)
+		}
+		balance := lockAll(f)
+		remaining := f.borrowings.data[b.ID].RemainingAmount
+
+		err = f.svc.DeleteBorrowingRepayment(ctx, DeleteBorrowingRepaymentRequest{SpaceID: spaceID, BorrowingID: b.ID, ID: r.ID})
+		if !errors.Is(err, ErrTransactionReconciled) {
+			t.Fatalf("expected ErrTransactionReconciled, got %v", err)
+		}
+		if _, ok := f.txns.txns[TransactionID(r.ID)]; !ok {
+			t.Error("expected the repayment transaction to be kept")
+		}
+		if got := f.borrowings.data[b.ID].RemainingAmount; got != remaining {
+			t.Errorf("remaining amount = %d, want %d", got, remaining)
+		}
+		assertBalance(t, f, balance)
+	})
+
+	t.Run("UpdateBorrowing", func(t *testing.T) {
+		f := newFixture()
+		b := createBorrowing(t, f)
+		balance := lockAll(f)
+
+		edit := *b
+		edit.TotalAmount = 15000
+		if _, err := f.svc.UpdateBorrowing(ctx, &edit); !errors.Is(err, ErrTransactionReconciled) {
+			t.Fatalf("expected ErrTransactionReconciled, got %v", err)
+		}
+		if got := f.borrowings.data[b.ID].TotalAmount; got != 10000 {
+			t.Errorf("borrowing total = %d, want it unchanged at 10000", got)
+		}
+		for _, txn := range f.txns.txns {
+			if txn.Amount != 10000 {
+				t.Errorf("borrowing transaction amount = %d, want it unchanged at 10000", txn.Amount)
+			}
+		}
+		assertBalance(t, f, balance)
+
+		// Fields that do not reach the ledger can still be edited
+		edit = *f.borrowings.data[b.ID]
+		edit.Notes = "Paid back in cash"
+		if _, err := f.svc.UpdateBorrowing(ctx, &edit); err != nil {
+			t.Errorf("expected notes to stay editable, got %v", err)
+		}
+	})
+
+	t.Run("DeleteBorrowing", func(t *testing.T) {
+		f := newFixture()
+		b := createBorrowing(t, f)
+		balance := lockAll(f)
+
+		if err := f.svc.DeleteBorrowing(ctx, spaceID, b.ID); !errors.Is(err, ErrTransactionReconciled) {
+			t.Fatalf("expected ErrTransactionReconciled, got %v", err)
+		}
+		if _, ok := f.borrowings.data[b.ID]; !ok || len(f.txns.txns) != 1 {
+			t.Error("expected the borrowing and its transaction to be kept")
+		}
+		assertBalance(t, f, balance)
+	})
+
+	t.Run("ApproveInboxItem overwrite", func(t *testing.T) {
+		f := newFixture()
+		accID := srcAccID
+		budgetID := BudgetID("bgt_groceries")
+		txnID, _ := NewTransactionID()
+		_ = f.txns.Create(ctx, &Transaction{
+			ID:              txnID,
+			SpaceID:         spaceID,
+			Type:            TransactionTypeExpense,
+			AccountID:       &accID,
+			BudgetID:        &budgetID,
+			Amount:          1000,
+			Currency:        "USD",
+			Description:     "Old Merchant",
+			TransactionDate: time.Now().UTC(),
+		})
+		balance := lockAll(f)
+
+		rawTxnID := string(txnID)
+		_ = f.inbox.Insert(ctx, &InboxItem{
+			ID:            "inb_locked",
+			SpaceID:       string(spaceID),
+			Status:        InboxItemPending,
+			DocType:       InboxItemDocReceipt,
+			Amount:        4500,
+			Currency:      "USD",
+			VendorName:    "New Supermarket",
+			TransactionID: &rawTxnID,
+			Metadata:      map[string]any{"overwrite_linked_transaction": true},
+		})
+
+		if _, err := f.svc.ApproveInboxItem(ctx, spaceID, "inb_locked"); !errors.Is(err, ErrTransactionReconciled) {
+			t.Fatalf("expected ErrTransactionReconciled, got %v", err)
+		}
+		if txn := f.txns.txns[txnID]; txn.Amount != 1000 || txn.Description != "Old Merchant" {
+			t.Errorf("expected the reconciled transaction to be unchanged, got %d %q", txn.Amount, txn.Description)
+		}
+		if f.inbox.items["inb_locked"].Status != InboxItemPending {
+			t.Error("expected the inbox item to stay pending")
+		}
+		assertBalance(t, f, balance)
+	})
+}
+
+func TestService_GoalProgress(t *testing.T) {
+	ctx := context.Background()
+	spIDStr, _ := id.Generate("spc_")
+	spaceID := SpaceID(spIDStr)
+	now := time.Now().UTC()
+
+	settingsStore := &mockSettingsStore{data: make(map[SpaceID]*FinanceSettings)}
+	_ = settingsStore.Create(ctx, &FinanceSettings{SpaceID: spaceID, BaseCurrency: "USD"})
+	accountStore := &mockAccountStore{data: make(map[AccountID]*Account)}
+	txnStore := &mockTransactionStore{txns: make(map[TransactionID]*Transaction)}
+	goalStore := &mockGoalStore{data: make(map[GoalID]*Goal)}
+	rateStore := &mockExchangeRateStore{rates: make(map[string]*ExchangeRate)}
+	svc := NewService(Dependencies{
+		SettingsStore:     settingsStore,
+		AccountStore:      accountStore,
+		TransactionStore:  txnStore,
+		GoalStore:         goalStore,
+		ExchangeRateStore: rateStore,
+		InsightsStore:     &mockInsightsStore{},
+	})
+
+	checking, _ := NewAccountID()
+	savings, _ := NewAccountID()
+	_ = accountStore.Create(ctx, &Account{ID: checking, SpaceID: spaceID, Name: "Checking", Currency: "USD", IsActive: true})
+	_ = accountStore.Create(ctx, &Account{ID: savings, SpaceID: spaceID, Name: "Savings", Currency: "USD", IsActive: true})
+
+	t.Run("requires a contribution source", func(t *testing.T) {
+		_, err := svc.CreateGoal(ctx, &Goal{SpaceID: spaceID, Name: "Vacation", TargetAmount: 100000, Currency: "USD"})
+		if err == nil || !strings.Contains(err.Error(), "linked to an account or a contribution tag") {
+			t.Fatalf("expected missing contribution source error, got %v", err)
+		}
+	})
+
+	deadline := now.AddDate(0, 6, 0)
+	goal, err := svc.CreateGoal(ctx, &Goal{
+		SpaceID:      spaceID,
+		Name:         "Vacation",
+		TargetAmount: 100000,
+		Currency:     "USD",
+		StartDate:    now.AddDate(0, 0, -60),
+		Deadline:     &deadline,
+		AccountID:    &savings,
+		Tag:          " Vacation ",
+	})
+	if err != nil {
+		t.Fatalf("CreateGoal failed: %v", err)
+	}
+	if goal.Status != GoalStatusActive || goal.Tag != "vacation" {
+		t.Fatalf("unexpected goal: %+v", goal)
+	}
+
+	addTxn := func(txnType TransactionType, accountID AccountID, amount int64, currency Currency, amountInBase int64, daysAgo int, tags ...string) {
+		txnID, _ := NewTransactionID()
+		_ = txnStore.Create(ctx, &Transaction{
+			ID:              txnID,
+			SpaceID:         spaceID,
+			Type:            txnType,
+			AccountID:       &accountID,
+			Amount:          amount,
+			Currency:        currency,
+			AmountInBase:    amountInBase,
+			Tags:            tags,
+			TransactionDate: now.AddDate(0, 0, -daysAgo),
+		})
+	}
+	addTxn(TransactionTypeTransferIn, savings, 50000, "USD", 50000, 90)             // before the goal started
+	addTxn(TransactionTypeTransferIn, savings, 30000, "USD", 30000, 45)             // deposit
+	addTxn(TransactionTypeTransferOut, savings, 5000, "USD", 5000, 20)              // withdrawal
+	addTxn(TransactionTypeExpense, checking, 10000, "USD", 10000, 10, "vacation")   // tagged
+	addTxn(TransactionTypeExpense, checking, 9000, "EUR", 10000, 5, "vacation")     // tagged, foreign
+	addTxn(TransactionTypeTransferOut, checking, 30000, "USD", 30000, 45)           // other account
+	addTxn(TransactionTypeExpense, checking, 2500, "USD", 2500, 3, "entertainment") // other tag
+
+	progress, err := svc.GetGoalProgress(ctx, spaceID, goal.ID)
+	if err != nil {
+		t.Fatalf("GetGoalProgress failed: %v", err)
+	}
+	if progress.ContributionCount != 4 {
+		t.Errorf("ContributionCount = %d, want 4", progress.ContributionCount)
+	}
+	if progress.SavedAmount != 45000 || progress.RemainingAmount != 55000 {
+		t.Errorf("saved/remaining = %d/%d, want 45000/55000", progress.SavedAmount, progress.RemainingAmount)
+	}
+	if math.Abs(progress.Percentage-45.0) > 0.001 {
+		t.Errorf("Percentage = %v, want 45", progress.Percentage)
+	}
+	// 45000 saved over a 60 day window is 750/day, or 22500 per 30 days.
+	if progress.MonthlyVelocity != 22500 {
+		t.Errorf("MonthlyVelocity = %d, want 22500", progress.MonthlyVelocity)
+	}
+	if progress.ProjectedCompletionDate == nil {
+		t.Fatalf("expected a projected completion date")
+	}
+	wantProjected := now.AddDate(0, 0, 74) // ceil(55000 / 750)
+	if progress.ProjectedCompletionDate.Sub(wantProjected).Abs() > time.Hour {
+		t.Errorf("ProjectedCompletionDate = %v, want %v", progress.ProjectedCompletionDate, wantProjected)
+	}
+	if !progress.OnTrack {
+		t.Errorf("expected goal to be on track for its deadline")
+	}
+	if progress.RequiredMonthlyContribution <= 0 {
+		t.Errorf("expected a required monthly contribution, got %d", progress.RequiredMonthlyContribution)
+	}
+
+	insights, err := svc.GetSpentInsights(ctx, &GetSpentInsightsRequest{SpaceID: spaceID, Granularity: "monthly"})
+	if err != nil {
+		t.Fatalf("GetSpentInsights failed: %v", err)
+	}
+	if len(insights.Goals) != 1 || insights.Goals[0].SavedAmount != 45000 || insights.Goals[0].Contributions != nil {
+		t.Fatalf("expected the active goal summary in insights, got %+v", insights.Goals)
+	}
+
+	goal.Status = GoalStatusArchived
+	if _, err := svc.UpdateGoal(ctx, goal); err != nil {
+		t.Fatalf("UpdateGoal failed: %v", err)
+	}
+	insights, err = svc.GetSpentInsights(ctx, &GetSpentInsightsRequest{SpaceID: spaceID, Granularity: "monthly"})
+	if err != nil {
+		t.Fatalf("GetSpentInsights failed: %v", err)
+	}
+	if len(insights.Goals) != 0 {
+		t.Errorf("expected archived goals to be excluded from insights, got %d", len(insights.Goals))
+	}
+}
+
+func TestService_SavedFilters(t *testing.T) {
+	ctx := context.Background()
+	spIDStr, _ := id.Generate("spc_")
+	spaceID := SpaceID(spIDStr)
+
+	txnStore := &recordingTransactionStore{mockTransactionStore: &mockTransactionStore{txns: make(map[TransactionID]*Transaction)}}
+	svc := NewService(Dependencies{
+		TransactionStore: txnStore,
+		SavedFilterStore: &mockSavedFilterStore{data: make(map[SavedFilterID]*SavedFilter)},
+	})
+
+	t.Run("rejects invalid expressions", func(t *testing.T) {
+		_, err := svc.CreateSavedFilter(ctx, &SavedFilter{SpaceID: spaceID, Name: "Broken", Expression: "amount > lots"})
+		if !errors.Is(err, filter.ErrInvalidFilter) {
+			t.Fatalf("expected ErrInvalidFilter, got %v", err)
+		}
+		_, err = svc.CreateSavedFilter(ctx, &SavedFilter{SpaceID: spaceID, Name: "Unknown", Expression: "payee = \"ACME\""})
+		if !errors.Is(err, filter.ErrInvalidFilter) {
+			t.Fatalf("expected ErrInvalidFilter for unknown field, got %v", err)
+		}
+		_, err = svc.CreateSavedFilter(ctx, &SavedFilter{SpaceID: spaceID, Name: "Bad sort", Expression: "amount > 5", Sort: "payee:asc"})
+		if err == nil {
+			t.Fatal("expected invalid sort field error")
+		}
+	})
+
+	saved, err := svc.CreateSavedFilter(ctx, &SavedFilter{
+		SpaceID:    spaceID,
+		Name:       " Large groceries ",
+		Expression: `budget_id IN ("bud_food", "bud_home") AND amount >= 5000`,
+		Sort:       "amount:desc",
+	})
+	if err != nil {
+		t.Fatalf("CreateSavedFilter failed: %v", err)
+	}
+	if saved.Name != "Large groceries" || !strings.HasPrefix(string(saved.ID), "sfl_") {
+		t.Fatalf("unexpected saved filter: %+v", saved)
+	}
+
+	t.Run("combines request and saved expressions", func(t *testing.T) {
+		_, err := svc.ListTransactions(ctx, spaceID, &TransactionFilter{
+			Expression:    "transaction_date >= 2025-01-01",
+			SavedFilterID: &saved.ID,
+		})
+		if err != nil {
+			t.Fatalf("ListTransactions failed: %v", err)
+		}
+		where, ok := txnStore.lastFilter.Where.(filter.And)
+		if !ok || len(where.Exprs) != 3 {
+			t.Fatalf("expected a conjunction of 3 restrictions, got %#v", txnStore.lastFilter.Where)
+		}
+		if sort := txnStore.lastFilter.Sort; sort.Field != "amount" || sort.Ascending {
+			t.Errorf("expected saved sort amount:desc, got %+v", sort)
+		}
+	})
+
+	t.Run("request sort wins over saved sort", func(t *testing.T) {
+		_, err := svc.ListTransactions(ctx, spaceID, &TransactionFilter{
+			SavedFilterID: &saved.ID,
+			Sort:          sorting.Parse("transaction_date:asc"),
+		})
+		if err != nil {
+			t.Fatalf("ListTransactions failed: %v", err)
+		}
+		if txnStore.lastFilter.Sort.Field != "transaction_date" {
+			t.Errorf("expected request sort to be kept, got %+v", txnStore.lastFilter.Sort)
+		}
+	})
+
+	t.Run("rejects invalid request expressions", func(t *testing.T) {
+		_, err := svc.ListTransactions(ctx, spaceID, &TransactionFilter{Expression: "amount ~ 5"})
+		if !errors.Is(err, filter.ErrInvalidFilter) {
+			t.Fatalf("expected ErrInvalidFilter, got %v", err)
+		}
+	})
+
+	t.Run("update validates the patched expression", func(t *testing.T) {
+		_, err := svc.UpdateSavedFilter(ctx, &SavedFilter{ID: saved.ID, SpaceID: spaceID, Expression: "cleared > true"}, []string{"expression"})
+		if !errors.Is(err, filter.ErrInvalidFilter) {
+			t.Fatalf("expected ErrInvalidFilter, got %v", err)
+		}
+		updated, err := svc.UpdateSavedFilter(ctx, &SavedFilter{ID: saved.ID, SpaceID: spaceID, Expression: "cleared = true"}, []string{"expression"})
+		if err != nil {
+			t.Fatalf("UpdateSavedFilter failed: %v", err)
+		}
+		if updated.Expression != "cleared = true" || updated.Name != "Large groceries" {
+			t.Errorf("unexpected update result: %+v", updated)
+		}
+	})
+
+	t.Run("missing saved filter", func(t *testing.T) {
+		missing, _ := NewSavedFilterID()
+		_, err := svc.ListTransactions(ctx, spaceID, &TransactionFilter{SavedFilterID: &missing})
+		if !errors.Is(err, ErrSavedFilterNotFound) {
+			t.Fatalf("expected ErrSavedFilterNotFound, got %v", err)
+		}
+	})
+}
+
+func TestService_LedgerExports(t *testing.T) {
+	ctx := context.Background()
+	spIDStr, _ := id.Generate("spc_")
+	spaceID := SpaceID(spIDStr)
+
+	checkingID, _ := NewAccountID()
+	savingsID, _ := NewAccountID()
+	budgetID, _ := NewBudgetID()
+	transferID, _ := NewTransferID()
+
+	txnStore := &mockTransactionStore{txns: make(map[TransactionID]*Transaction)}
+	exportStore := &mockLedgerExportStore{data: make(map[LedgerExportID]*LedgerExport), content: make(map[LedgerExportID][]byte)}
+	svc := NewService(Dependencies{
+		SettingsStore: &mockSettingsStore{data: map[SpaceID]*FinanceSettings{
+			spaceID: {SpaceID: spaceID, BaseCurrency: "USD"},
+		}},
+		AccountStore: &mockAccountStore{data: map[AccountID]*Account{
+			checkingID: {ID: checkingID, SpaceID: spaceID, Name: "Checking", Type: AccountTypeBank, Currency: "USD"},
+			savingsID:  {ID: savingsID, SpaceID: spaceID, Name: "Savings", Type: AccountTypeBank, Currency: "USD"},
+		}},
+		BudgetStore: &mockBudgetStore{data: map[BudgetID]*Budget{
+			budgetID: {ID: budgetID, SpaceID: spaceID, Name: "Groceries", Currency: "USD"},
+		}},
+		TransferStore: &mockTransferStore{data: map[TransferID]*Transfer{
+			transferID: {ID: transferID, SpaceID: spaceID, SourceAccountID: checkingID, DestinationAccountID: savingsID, SourceAmount: 5000, DestinationAmount: 5000},
+		}},
+		TransactionStore:  txnStore,
+		LedgerExportStore: exportStore,
+	})
+
+	march := func(d int) time.Time { return time.Date(2026, 3, d, 9, 0, 0, 0, time.UTC) }
+	for _, txn := range []*Transaction{
+		{ID: "txn_groceries", Type: TransactionTypeExpense, AccountID: &checkingID, BudgetID: &budgetID, Amount: 1250, Currency: "USD", TransactionDate: march(2)},
+		{ID: "txn_out", Type: TransactionTypeTransferOut, AccountID: &checkingID, Amount: 5000, Currency: "USD", TransactionDate: march(5), Metadata: TransactionMetadata{TransferID: &transferID}},
+		{ID: "txn_in", Type: TransactionTypeTransferIn, AccountID: &savingsID, Amount: 5000, Currency: "USD", TransactionDate: march(5), Metadata: TransactionMetadata{TransferID: &transferID}},
+		{ID: "txn_april", Type: TransactionTypeExpense, AccountID: &checkingID, BudgetID: &budgetID, Amount: 999, Currency: "USD", TransactionDate: time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)},
+	} {
+		txn.SpaceID = spaceID
+		txnStore.txns[txn.ID] = txn
+	}
+
+	t.Run("streams entries within the range", func(t *testing.T) {
+		var buf bytes.Buffer
+		count, err := svc.ExportLedger(ctx, &LedgerQuery{
+			SpaceID:   spaceID,
+			Format:    LedgerFormatBeancount,
+			StartDate: march(1),
+			EndDate:   time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC),
+		}, &buf)
+		if err != nil {
+			t.Fatalf("ExportLedger failed: %v", err)
+		}
+		if count != 3 {
+			t.Errorf("expected 3 transactions in March, got %d", count)
+		}
+		out := buf.String()
+		if !strings.Contains(out, "Expenses:Groceries  12.50 USD") {
+			t.Errorf("expected budget posting\n%s", out)
+		}
+		if !strings.Contains(out, "Assets:Savings  50.00 USD\n  Assets:Checking  -50.00 USD") {
+			t.Errorf("expected the transfer to be booked once\n%s", out)
+		}
+		if strings.Contains(out, "txn_april") {
+			t.Errorf("transactions after the end date must be excluded\n%s", out)
+		}
+	})
+
+	t.Run("background export lifecycle", func(t *testing.T) {
+		export, err := svc.CreateLedgerExport(ctx, &LedgerExport{
+			SpaceID:   spaceID,
+			Format:    LedgerFormatCSV,
+			StartDate: march(1),
+			EndDate:   march(31),
+		})
+		if err != nil {
+			t.Fatalf("CreateLedgerExport failed: %v", err)
+		}
+		if export.Status != LedgerExportStatusPending || export.FileName != "ledger-20260301-20260331.csv" || !strings.HasPrefix(string(export.ID), "lex_") {
+			t.Fatalf("unexpected export: %+v", export)
+		}
+
+		if _, _, err := svc.GetLedgerExportContent(ctx, spaceID, export.ID); !errors.Is(err, ErrLedgerExportNotReady) {
+			t.Fatalf("expected ErrLedgerExportNotReady before the job runs, got %v", err)
+		}
+
+		done, err := svc.RunLedgerExport(ctx, spaceID, export.ID)
+		if err != nil {
+			t.Fatalf("RunLedgerExport failed: %v", err)
+		}
+		if done.Status != LedgerExportStatusCompleted || done.EntryCount != 3 || done.CompleteTime == nil {
+			t.Fatalf("unexpected completed export: %+v", done)
+		}
+
+		got, content, err := svc.GetLedgerExportContent(ctx, spaceID, export.ID)
+		if err != nil {
+			t.Fatalf("GetLedgerExportContent failed: %v", err)
+		}
+		if int64(len(content)) != got.SizeBytes || !strings.HasPrefix(string(content), "id,date,type,") {
+			t.Errorf("unexpected export content (%d bytes): %q", got.SizeBytes, content)
+		}
+	})
+
+	t.Run("records failures", func(t *testing.T) {
+		otherIDStr, _ := id.Generate("spc_")
+		export, err := svc.CreateLedgerExport(ctx, &LedgerExport{
+			SpaceID:   SpaceID(otherIDStr),
+			Format:    LedgerFormatJSONL,
+			StartDate: march(1),
+			EndDate:   march(31),
+		})
+		if err != nil {
+			t.Fatalf("CreateLedgerExport failed: %v", err)
+		}
+		if _, err := svc.RunLedgerExport(ctx, export.SpaceID, export.ID); !errors.Is(err, ErrSettingsNotFound) {
+			t.Fatalf("expected ErrSettingsNotFound, got %v", err)
+		}
+		failed, _ := svc.GetLedgerExport(ctx, export.SpaceID, export.ID)
+		if failed.Status != LedgerExportStatusFailed || failed.Error == "" {
+			t.Errorf("expected failed export with an error, got %+v", failed)
+		}
+	})
+}
+
+func TestService_LoanInstallments(t *testing.T) {
+	ctx := context.Background()
+	rawSpace, _ := id.Generate("spc_")
+	spaceID := SpaceID(rawSpace)
+	budgetID, _ := NewBudgetID()
+
+	settingsStore := &mockSettingsStore{data: make(map[SpaceID]*FinanceSettings)}
+	_ = settingsStore.Create(ctx, &FinanceSettings{SpaceID: spaceID, BaseCurrency: "USD"})
+	budgetStore := &mockBudgetStore{data: map[BudgetID]*Budget{budgetID: {ID: budgetID, SpaceID: spaceID}}}
+	borrowingStore := &mockBorrowingStore{data: make(map[BorrowingID]*Borrowing)}
+	txnStore := &mockTransactionStore{txns: make(map[TransactionID]*Transaction)}
+	paymentStore := &mockScheduledPaymentStore{}
+
+	svc := NewService(Dependencies{
+		SettingsStore:         settingsStore,
+		BudgetStore:           budgetStore,
+		BorrowingStore:        borrowingStore,
+		TransactionStore:      txnStore,
+		ScheduledPaymentStore: paymentStore,
+	})
+
+	b, err := svc.CreateBorrowing(ctx, &Borrowing{
+		SpaceID:              spaceID,
+		Direction:            BorrowingDirectionBorrowed,
+		Counterparty:         "Car Dealer",
+		TotalAmount:          1200000,
+		Currency:             "USD",
+		EstablishedAt:        time.Now().UTC(),
+		InterestType:         InterestTypeSimple,
+		InterestRateBps:      1200,
+		InstallmentCount:     12,
+		InstallmentFrequency: InstallmentFrequencyMonthly,
+		BudgetID:             &budgetID,
+	}, false)
+	if err != nil {
+		t.Fatalf("CreateBorrowing failed: %v", err)
+	}
+
+	loanPayments := func() []*ScheduledPayment {
+		var out []*ScheduledPayment
+		for _, p := range paymentStore.payments {
+			if p.SourceType == SourceTypeLoan && p.SourceID == string(b.ID) && p.Status == ScheduledPaymentPending {
+				out = append(out, p)
+			}
+		}
+		slices.SortFunc(out, func(a, b *ScheduledPayment) int { return a.DueDate.Compare(b.DueDate) })
+		return out
+	}
+
+	pending := loanPayments()
+	if len(pending) != 12 {
+		t.Fatalf("pending loan instalments = %d, want 12", len(pending))
+	}
+	if pending[0].Amount != 112000 || pending[0].BudgetID != budgetID {
+		t.Errorf("first instalment = %d on %s, want 112000 on %s", pending[0].Amount, pending[0].BudgetID, budgetID)
+	}
+
+	// Confirming an instalment books a repayment and settles interest before principal.
+	txn, err := svc.ConfirmScheduledPayment(ctx, ConfirmScheduledPaymentRequest{
+		SpaceID:   spaceID,
+		PaymentID: pending[0].ID,
+	})
+	if err != nil {
+		t.Fatalf("ConfirmScheduledPayment failed: %v", err)
+	}
+	if txn.Metadata.BorrowingRole != "REPAYMENT" || txn.Metadata.ScheduledPaymentID == nil || *txn.Metadata.ScheduledPaymentID != pending[0].ID {
+		t.Errorf("confirmation transaction metadata = %+v, want a repayment linked to the instalment", txn.Metadata)
+	}
+
+	got, err := svc.GetBorrowing(ctx, spaceID, b.ID)
+	if err != nil {
+		t.Fatalf("GetBorrowing failed: %v", err)
+	}
+	if got.PaidAmount != 112000 || got.RemainingAmount != 1100000 {
+		t.Errorf("after first instalment: paid %d remaining %d, want 112000 and 1100000", got.PaidAmount, got.RemainingAmount)
+	}
+	if got.Progress == nil || got.Progress.Schedule[0].Status != InstallmentStatusPaid {
+		t.Fatalf("expected first instalment to be reported as paid, got %+v", got.Progress)
+	}
+	if n := len(loanPayments()); n != 11 {
+		t.Errorf("pending loan instalments after confirmation = %d, want 11", n)
+	}
+
+	// A partial manual repayment reschedules only the unpaid part of the next instalment.
+	if _, err := svc.CreateBorrowingRepayment(ctx, &BorrowingRepayment{
+		BorrowingID: b.ID,
+		SpaceID:     spaceID,
+		Amount:      12000,
+		PaymentDate: time.Now().UTC(),
+	}); err != nil {
+		t.Fatalf("CreateBorrowingRepayment failed: %v", err)
+	}
+	pending = loanPayments()
+	if len(pending) != 11 || pending[0].Amount != 100000 {
+		t.Errorf("next instalment = %d (of %d pending), want 100000 of 11", pending[0].Amount, len(pending))
+	}
+
+	if err := svc.DeleteBorrowing(ctx, spaceID, b.ID); err != nil {
+		t.Fatalf("DeleteBorrowing failed: %v", err)
+	}
+	if n := len(loanPayments()); n != 0 {
+		t.Errorf("pending loan instalments after delete = %d, want 0", n)
+	}
+}
+
+func TestService_CardStatements(t *testing.T) {
+	ctx := context.Background()
+	rawSpace, _ := id.Generate("spc_")
+	spaceID := SpaceID(rawSpace)
+	budgetID, _ := NewBudgetID()
+	checkingID, _ := NewAccountID()
+	cardID, _ := NewAccountID()
+
+	settingsStore := &mockSettingsStore{data: make(map[SpaceID]*FinanceSettings)}
+	_ = settingsStore.Create(ctx, &FinanceSettings{SpaceID: spaceID, BaseCurrency: "USD"})
+	accountStore := &mockAccountStore{data: make(map[AccountID]*Account)}
+	_ = accountStore.Create(ctx, &Account{ID: checkingID, SpaceID: spaceID, Name: "Checking", Type: AccountTypeBank, Currency: "USD", CurrentBalance: 1000000, IsActive: true})
+	txnStore := &mockTransactionStore{txns: make(map[TransactionID]*Transaction)}
+	paymentStore := &mockScheduledPaymentStore{}
+	statementStore := &mockCardStatementStore{}
+
+	svc := NewService(Dependencies{
+		SettingsStore:         settingsStore,
+		BudgetStore:           &mockBudgetStore{data: map[BudgetID]*Budget{budgetID: {ID: budgetID, SpaceID: spaceID}}},
+		AccountStore:          accountStore,
+		TransactionStore:      txnStore,
+		TransferStore:         &mockTransferStore{data: make(map[TransferID]*Transfer)},
+		TransactionEventStore: &mockTransactionEventStore{events: make(map[TransactionEventID]*TransactionEvent)},
+		ScheduledPaymentStore: paymentStore,
+		CardStatementStore:    statementStore,
+	})
+
+	card, err := svc.CreateAccount(ctx, &Account{
+		ID:                  cardID,
+		SpaceID:             spaceID,
+		Name:                "Visa",
+		Type:                AccountTypeCreditCard,
+		Currency:            "USD",
+		CreditLimit:         500000,
+		StatementClosingDay: 25,
+		PaymentDueDay:       15,
+		MinimumPaymentBps:   300,
+		MinimumPaymentFloor: 2500,
+		PaymentBudgetID:     &budgetID,
+	})
+	if err != nil {
+		t.Fatalf("CreateAccount failed: %v", err)
+	}
+
+	// Two purchases and a refund in the March cycle, one purchase after it closed.
+	for _, c := range []struct {
+		typ    TransactionType
+		amount int64
+		day    int
+	}{
+		{TransactionTypeExpense, 60000, 5},
+		{TransactionTypeExpense, 40000, 20},
+		{TransactionTypeIncome, 5000, 22},
+		{TransactionTypeExpense, 10000, 27},
+	} {
+		tID, _ := NewTransactionID()
+		date := time.Date(2026, 3, c.day, 10, 0, 0, 0, time.UTC)
+		_ = txnStore.Create(ctx, &Transaction{ID: tID, SpaceID: spaceID, AccountID: &cardID, Type: c.typ, Amount: c.amount, Currency: "USD", TransactionDate: date, EffectiveDate: date})
+		card.CurrentBalance += cardBalanceDelta(&Transaction{Type: c.typ, Amount: c.amount})
+	}
+
+	now := time.Date(2026, 3, 28, 12, 0, 0, 0, time.UTC)
+	if err := svc.CloseCardStatements(ctx, now); err != nil {
+		t.Fatalf("CloseCardStatements failed: %v", err)
+	}
+	if err := svc.CloseCardStatements(ctx, now.Add(time.Hour)); err != nil {
+		t.Fatalf("second CloseCardStatements failed: %v", err)
+	}
+
+	statements, err := svc.ListCardStatements(ctx, spaceID, cardID, nil)
+	if err != nil {
+		t.Fatalf("ListCardStatements failed: %v", err)
+	}
+	if len(statements) != 1 {
+		t.Fatalf("statements = %d, want 1", len(statements))
+	}
+	st := statements[0]
+	if st.StatementBalance != 95000 || st.Purchases != 100000 || st.Credits != 5000 || st.OpeningBalance != 0 {
+		t.Errorf("statement balance %d purchases %d credits %d opening %d, want 95000 100000 5000 0", st.StatementBalance, st.Purchases, st.Credits, st.OpeningBalance)
+	}
+	if st.MinimumPayment != 2850 || st.Utilization != 19 {
+		t.Errorf("minimum payment %d utilization %v, want 2850 19", st.MinimumPayment, st.Utilization)
+	}
+	if got := st.DueDate.Format(time.DateOnly); got != "2026-04-15" {
+		t.Errorf("due date = %s, want 2026-04-15", got)
+	}
+	if st.ScheduledPaymentID == nil {
+		t.Fatal("expected a scheduled payment for the statement balance")
+	}
+	payment := paymentStore.payments[*st.ScheduledPaymentID]
+	if payment.SourceType != SourceTypeCardStatement || payment.Amount != 95000 || payment.BudgetID != budgetID {
+		t.Errorf("scheduled payment = %s %d on %s, want card_statement 95000 on %s", payment.SourceType, payment.Amount, payment.BudgetID, budgetID)
+	}
+
+	// A transfer into the card settles part of the statement.
+	transfer, err := svc.CreateTransfer(ctx, &Transfer{
+		SpaceID:              spaceID,
+		SourceAccountID:      checkingID,
+		DestinationAccountID: cardID,
+		SourceAmount:         50000,
+		DestinationAmount:    50000,
+		TransferDate:         now,
+	})
+	if err != nil {
+		t.Fatalf("CreateTransfer failed: %v", err)
+	}
+	if st.Status != CardStatementStatusPartiallyPaid || payment.Amount != 45000 || payment.Status != ScheduledPaymentPending {
+		t.Errorf("after partial payment: statement %s, scheduled %d %s, want PARTIALLY_PAID 45000 pending", st.Status, payment.Amount, payment.Status)
+	}
+
+	if _, err := svc.ConfirmScheduledPayment(ctx, ConfirmScheduledPaymentRequest{SpaceID: spaceID, PaymentID: payment.ID}); err == nil {
+		t.Error("expected an error confirming a card payment without a source account")
+	}
+
+	// Confirming the scheduled payment pays the rest with a transfer.
+	txn, err := svc.ConfirmScheduledPayment(ctx, ConfirmScheduledPaymentRequest{
+		SpaceID:         spaceID,
+		PaymentID:       payment.ID,
+		AccountID:       &checkingID,
+		TransactionDate: now,
+	})
+	if err != nil {
+		t.Fatalf("ConfirmScheduledPayment failed: %v", err)
+	}
+	if txn.Type != TransactionTypeTransferOut || txn.Amount != 45000 || txn.Metadata.ScheduledPaymentID == nil {
+		t.Errorf("confirmation txn = %s %d, want linked TRANSFER_OUT 45000", txn.Type, txn.Amount)
+	}
+	if st.Status != CardStatementStatusPaid || payment.Status != ScheduledPaymentPaid {
+		t.Errorf("after full payment: statement %s, scheduled %s, want PAID paid", st.Status, payment.Status)
+	}
+	if card.CurrentBalance != 10000 {
+		t.Errorf("card balance = %d, want 10000", card.CurrentBalance)
+	}
+
+	// Deleting a payment reopens the statement and its scheduled payment.
+	if err := svc.DeleteTransfer(ctx, spaceID, transfer.ID); err != nil {
+		t.Fatalf("DeleteTransfer failed: %v", err)
+	}
+	if st.Status != CardStatementStatusPartiallyPaid || st.PaidAmount != 45000 {
+		t.Errorf("after deleting payment: statement %s paid %d, want PARTIALLY_PAID 45000", st.Status, st.PaidAmount)
+	}
+	if payment.Status != ScheduledPaymentPending || payment.Amount != 50000 {
+		t.Errorf("after deleting payment: scheduled %s %d, want pending 50000", payment.Status, payment.Amount)
+	}
+}
+
+func TestService_ForecastCashFlow(t *testing.T) {
+	ctx := context.Background()
+	rawSpace, _ := id.Generate("spc_")
+	spaceID := SpaceID(rawSpace)
+	budgetID, _ := NewBudgetID()
+	checkingID, _ := NewAccountID()
+	cardID, _ := NewAccountID()
+	now := time.Date(2026, 4, 10, 12, 0, 0, 0, time.UTC)
+	date := func(month time.Month, day int) time.Time { return time.Date(2026, month, day, 0, 0, 0, 0, time.UTC) }
+
+	accountStore := &mockAccountStore{data: map[AccountID]*Account{
+		checkingID: {ID: checkingID, SpaceID: spaceID, Name: "Checking", Type: AccountTypeBank, Currency: "USD", CurrentBalance: 300000, IsDefault: true, IsActive: true, LowBalanceThreshold: 200000},
+		cardID:     {ID: cardID, SpaceID: spaceID, Name: "Visa", Type: AccountTypeCreditCard, Currency: "USD", CurrentBalance: 60000, CreditLimit: 500000, IsActive: true},
+	}}
+
+	paymentStore := &mockScheduledPaymentStore{payments: make(map[ScheduledPaymentID]*ScheduledPayment)}
+	rentID, _ := NewScheduledPaymentID()
+	paymentStore.payments[rentID] = &ScheduledPayment{ID: rentID, SpaceID: spaceID, BudgetID: budgetID, SourceType: "tax", Amount: 50000, Currency: "USD", DueDate: date(4, 12), Status: ScheduledPaymentPending}
+	cardPaymentID, _ := NewScheduledPaymentID()
+	paymentStore.payments[cardPaymentID] = &ScheduledPayment{
+		ID: cardPaymentID, SpaceID: spaceID, BudgetID: budgetID, SourceType: SourceTypeCardStatement,
+		Amount: 40000, Currency: "USD", DueDate: date(4, 15), Status: ScheduledPaymentPending,
+		Metadata: []byte(`{"account_id":"` + string(cardID) + `"}`),
+	}
+	paidID, _ := NewScheduledPaymentID()
+	paymentStore.payments[paidID] = &ScheduledPayment{ID: paidID, SpaceID: spaceID, BudgetID: budgetID, SourceType: "tax", Amount: 99999, Currency: "USD", DueDate: date(4, 13), Status: ScheduledPaymentPaid}
+
+	reID, _ := NewRecurringExpenseID()
+	recurringStore := &mockRecurringExpenseStore{data: map[RecurringExpenseID]*RecurringExpense{
+		reID: {ID: reID, SpaceID: spaceID, BudgetID: budgetID, Name: "Electricity", Amount: 10000, Currency: "USD", Interval: "monthly", NextDueDate: date(4, 20), IsVariable: true, Status: RecurringExpenseActive},
+	}}
+
+	sourceID, _ := NewIncomeSourceID()
+	incomeStore := &mockIncomeSourceStore{data: map[IncomeSourceID]*IncomeSource{
+		sourceID: {ID: sourceID, SpaceID: spaceID, Name: "Salary", ExpectedAmount: 100000, Currency: "USD", Cadence: IncomeCadenceMonthly, IsActive: true},
+	}}
+
+	txnStore := &mockTransactionStore{txns: make(map[TransactionID]*Transaction)}
+	for _, txn := range []*Transaction{
+		{Type: TransactionTypeExpense, Amount: 20000, TransactionDate: date(2, 20), Metadata: TransactionMetadata{RecurringExpenseID: &reID}},
+		{Type: TransactionTypeExpense, Amount: 30000, TransactionDate: date(3, 20), Metadata: TransactionMetadata{RecurringExpenseID: &reID}},
+		{Type: TransactionTypeIncome, Amount: 100000, TransactionDate: time.Date(2026, 3, 25, 9, 0, 0, 0, time.UTC), AccountID: &checkingID, Metadata: TransactionMetadata{IncomeSourceID: &sourceID}},
+	} {
+		txn.ID, _ = NewTransactionID()
+		txn.SpaceID = spaceID
+		txn.Currency = "USD"
+		txnStore.txns[txn.ID] = txn
+	}
+
+	svc := NewService(Dependencies{
+		SettingsStore:         &mockSettingsStore{data: map[SpaceID]*FinanceSettings{spaceID: {SpaceID: spaceID, BaseCurrency: "USD"}}},
+		BudgetStore:           &mockBudgetStore{data: map[BudgetID]*Budget{budgetID: {ID: budgetID, SpaceID: spaceID}}},
+		AccountStore:          accountStore,
+		TransactionStore:      txnStore,
+		ScheduledPaymentStore: paymentStore,
+		RecurringExpenseStore: recurringStore,
+		IncomeSourceStore:     incomeStore,
+		BorrowingStore:        &mockBorrowingStore{data: make(map[BorrowingID]*Borrowing)},
+	})
+
+	forecast, err := svc.ForecastCashFlow(ctx, &ForecastCashFlowRequest{SpaceID: spaceID, Now: now})
+	if err != nil {
+		t.Fatalf("ForecastCashFlow failed: %v", err)
+	}
+
+	if !forecast.StartDate.Equal(date(4, 10)) || !forecast.EndDate.Equal(date(5, 9)) {
+		t.Errorf("window = %s..%s, want 2026-04-10..2026-05-09", forecast.StartDate.Format(time.DateOnly), forecast.EndDate.Format(time.DateOnly))
+	}
+
+	balances := make(map[AccountID]map[string]int64)
+	for _, af := range forecast.Accounts {
+		balances[af.Account.ID] = make(map[string]int64)
+		for _, d := range af.Days {
+			balances[af.Account.ID][d.Date.Format(time.DateOnly)] = d.Balance
+		}
+	}
+	for _, c := range []struct {
+		account AccountID
+		day     string
+		want    int64
+	}{
+		{checkingID, "2026-04-11", 300000},
+		{checkingID, "2026-04-12", 250000}, // Scheduled payment
+		{checkingID, "2026-04-15", 210000}, // Card statement payment
+		{checkingID, "2026-04-20", 185000}, // Variable expense estimated at 25000
+		{checkingID, "2026-04-25", 285000}, // Salary one month after the last payment
+		{checkingID, "2026-05-09", 285000},
+		{cardID, "2026-04-15", 20000},
+	} {
+		if got := balances[c.account][c.day]; got != c.want {
+			t.Errorf("balance of %s on %s = %d, want %d", c.account, c.day, got, c.want)
+		}
+	}
+
+	var estimated bool
+	for _, e := range forecast.Events {
+		if e.Kind == ForecastEventRecurringExpense {
+			estimated = e.Estimated
+		}
+	}
+	if !estimated {
+		t.Error("expected the variable recurring expense to be flagged as estimated")
+	}
+
+	if len(forecast.Alerts) != 1 {
+		t.Fatalf("got %d alerts, want 1", len(forecast.Alerts))
+	}
+	if a := forecast.Alerts[0]; a.AccountID != checkingID || a.Kind != ForecastAlertBelowThreshold || a.Date.Format(time.DateOnly) != "2026-04-20" {
+		t.Errorf("alert = %s %s on %s, want checking BELOW_THRESHOLD on 2026-04-20", a.AccountID, a.Kind, a.Date.Format(time.DateOnly))
+	}
+}
+
+type mockAlertStore struct {
+	data map[AlertID]*Alert
+}
+
+func (m *mockAlertStore) CreateIfAbsent(ctx context.Context, a *Alert) (bool, error) {
+	for _, existing := range m.data {
+		if existing.SpaceID == a.SpaceID && existing.DedupKey == a.DedupKey {
+			return false, nil
+		}
+	}
+	m.data[a.ID] = a
+	return true, nil
+}
+
+func (m *mockAlertStore) GetByID(ctx context.Context, spaceID SpaceID, id AlertID) (*Alert, error) {
+	a, ok := m.data[id]
+	if !ok || a.SpaceID != spaceID {
+		return nil, ErrAlertNotFound
+	}
+	return a, nil
+}
+
+func (m *mockAlertStore) Update(ctx context.Context, a *Alert) error {
+	if _, ok := m.data[a.ID]; !ok {
+		return ErrAlertNotFound
+	}
+	m.data[a.ID] = a
+	return nil
+}
+
+func (m *mockAlertStore) ListBySpace(ctx context.Context, spaceID SpaceID, filter *ListAlertsFilter) (*paging.Page[*Alert], error) {
+	var list []*Alert
+	for _, a := range m.data {
+		if a.SpaceID != spaceID {
+			continue
+		}
+		if filter.ActiveOnly && !a.IsActive(filter.Now) {
+			continue
+		}
+		if filter.Rule != nil && a.Rule != *filter.Rule {
+			continue
+		}
+		list = append(list, a)
+	}
+	return &paging.Page[*Alert]{Items: list}, nil
+}
+
+func TestService_EvaluateTransactionAlerts(t *testing.T) {
+	ctx := context.Background()
+	alertStore := &mockAlertStore{data: make(map[AlertID]*Alert)}
+	svc := NewService(Dependencies{
+		SettingsStore:         &mockSettingsStore{data: make(map[SpaceID]*FinanceSettings)},
+		BudgetStore:           &mockBudgetStore{data: make(map[BudgetID]*Budget)},
+		PeriodStore:           &mockPeriodStore{data: make(map[string]*BudgetPeriod)},
+		ExchangeRateStore:     &mockExchangeRateStore{rates: make(map[string]*ExchangeRate)},
+		TransactionStore:      &mockTransactionStore{txns: make(map[TransactionID]*Transaction)},
+		InsightsStore:         &mockInsightsStore{},
+		AccountStore:          &mockAccountStore{data: make(map[AccountID]*Account)},
+		TransferStore:         &mockTransferStore{data: make(map[TransferID]*Transfer)},
+		TransactionEventStore: &mockTransactionEventStore{events: make(map[TransactionEventID]*TransactionEvent)},
+		AlertStore:            alertStore,
+		PayeeStore:            &mockPayeeStore{data: make(map[PayeeID]*Payee)},
+		TransactionRuleStore:  &mockTransactionRuleStore{data: make(map[TransactionRuleID]*TransactionRule)},
+	})
+
+	rawSpace, _ := id.Generate("spc_")
+	spaceID := SpaceID(rawSpace)
+	if _, err := svc.ConfigureFinance(ctx, &FinanceSettings{SpaceID: spaceID, BaseCurrency: "USD"}); err != nil {
+		t.Fatal(err)
+	}
+	budgetID, _ := NewBudgetID()
+	budget, err := svc.CreateBudget(ctx, &Budget{ID: budgetID, SpaceID: spaceID, Name: "Home", LimitAmount: 100000, Currency: "USD", Interval: IntervalMonthly})
+	if err != nil {
+		t.Fatal(err)
 	}
-	if len(list) != 1 {
-		t.Errorf("ListTransfers count = %d, want 1", len(list))
+
+	expense := func(day int, amount int64, description string) *Transaction {
+		t.Helper()
+		txn, err := svc.CreateExpense(ctx, &Transaction{
+			SpaceID:         spaceID,
+			BudgetID:        &budget.ID,
+			Amount:          amount,
+			Currency:        "USD",
+			Description:     description,
+			TransactionDate: time.Date(2026, 3, day, 12, 0, 0, 0, time.UTC),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return txn
+	}
+	rules := func(alerts []*Alert) []AlertRule {
+		var list []AlertRule
+		for _, a := range alerts {
+			list = append(list, a.Rule)
+		}
+		slices.Sort(list)
+		return list
 	}
-}
 
-type mockInboxItemStore struct {
-	items map[string]*InboxItem
-}
+	for day := 1; day <= 5; day++ {
+		expense(day, 1000, fmt.Sprintf("Groceries %d", day))
+	}
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
 
-func (m *mockInboxItemStore) Insert(ctx context.Context, item *InboxItem) error {
-	if m.items == nil {
-		m.items = make(map[string]*InboxItem)
+	// 75% spent by the 10th: below the thresholds, but on pace to overspend and far above the usual amount.
+	sofa := expense(10, 70000, "Sofa store")
+	raised, err := svc.EvaluateTransactionAlerts(ctx, spaceID, sofa.ID, now)
+	if err != nil {
+		t.Fatalf("EvaluateTransactionAlerts failed: %v", err)
+	}
+	if got, want := rules(raised), []AlertRule{AlertRuleAmountAnomaly, AlertRuleProjectedOverspend}; !slices.Equal(got, want) {
+		t.Errorf("raised %v, want %v", got, want)
 	}
-	m.items[item.ID] = item
-	return nil
-}
 
-func (m *mockInboxItemStore) Get(ctx context.Context, spaceID SpaceID, id string) (*InboxItem, error) {
-	item, ok := m.items[id]
-	if !ok || item.SpaceID != string(spaceID) {
-		return nil, errors.New("inbox item not found")
+	raised, err = svc.EvaluateTransactionAlerts(ctx, spaceID, sofa.ID, now)
+	if err != nil {
+		t.Fatalf("EvaluateTransactionAlerts failed: %v", err)
+	}
+	if len(raised) != 0 {
+		t.Errorf("re-evaluation raised %v, want nothing", rules(raised))
 	}
-	return item, nil
-}
 
-func (m *mockInboxItemStore) ListBySpace(ctx context.Context, spaceID SpaceID, filter *ListInboxItemsFilter) (*paging.Page[*InboxItem], error) {
-	var list []*InboxItem
-	for _, item := range m.items {
-		if item.SpaceID == string(spaceID) {
-			list = append(list, item)
+	// The same charge again crosses the limit and looks duplicated; the pace alert was already raised.
+	again := expense(10, 70000, "SOFA  store")
+	raised, err = svc.EvaluateTransactionAlerts(ctx, spaceID, again.ID, now)
+	if err != nil {
+		t.Fatalf("EvaluateTransactionAlerts failed: %v", err)
+	}
+	if got, want := rules(raised), []AlertRule{AlertRuleBudgetThreshold, AlertRuleDuplicateCharge}; !slices.Equal(got, want) {
+		t.Fatalf("raised %v, want %v", got, want)
+	}
+	for _, a := range raised {
+		switch a.Rule {
+		case AlertRuleBudgetThreshold:
+			if a.Amount != 145000 || a.Threshold != 100000 {
+				t.Errorf("threshold alert amount = %d, threshold = %d, want 145000 and 100000", a.Amount, a.Threshold)
+			}
+		case AlertRuleDuplicateCharge:
+			if a.RelatedTransactionID == nil || *a.RelatedTransactionID != sofa.ID {
+				t.Errorf("duplicate alert related transaction = %v, want %s", a.RelatedTransactionID, sofa.ID)
+			}
 		}
 	}
-	return &paging.Page[*InboxItem]{Items: list}, nil
+
+	// Acknowledged and snoozed alerts leave the active list.
+	if _, err := svc.AcknowledgeAlert(ctx, spaceID, raised[0].ID, now); err != nil {
+		t.Fatalf("AcknowledgeAlert failed: %v", err)
+	}
+	if _, err := svc.SnoozeAlert(ctx, spaceID, raised[1].ID, now.Add(time.Hour), now); err != nil {
+		t.Fatalf("SnoozeAlert failed: %v", err)
+	}
+	if _, err := svc.SnoozeAlert(ctx, spaceID, raised[0].ID, now.Add(time.Hour), now); !errors.Is(err, ErrAlertAcknowledged) {
+		t.Errorf("snoozing an acknowledged alert error = %v, want ErrAlertAcknowledged", err)
+	}
+	page, err := svc.ListAlerts(ctx, spaceID, &ListAlertsFilter{ActiveOnly: true, Now: now})
+	if err != nil {
+		t.Fatalf("ListAlerts failed: %v", err)
+	}
+	if len(page.Items) != 2 {
+		t.Errorf("got %d active alerts, want 2", len(page.Items))
+	}
 }
 
-func (m *mockInboxItemStore) Update(ctx context.Context, item *InboxItem) error {
-	m.items[item.ID] = item
-	return nil
+type recordingTransactionNotifier struct {
+	changed []TransactionID
 }
 
-func (m *mockInboxItemStore) Delete(ctx context.Context, spaceID SpaceID, id string) error {
-	delete(m.items, id)
-	return nil
+func (n *recordingTransactionNotifier) TransactionChanged(ctx context.Context, txn *Transaction) {
+	n.changed = append(n.changed, txn.ID)
 }
 
-func TestService_ApproveInboxItem(t *testing.T) {
+func TestService_EvaluateTransactionAlerts_SplitAndUnbudgeted(t *testing.T) {
 	ctx := context.Background()
-	spIDStr, _ := id.Generate("spc_")
-	spID := SpaceID(spIDStr)
+	txnStore := &mockTransactionStore{txns: make(map[TransactionID]*Transaction)}
+	svc := NewService(Dependencies{
+		SettingsStore:         &mockSettingsStore{data: make(map[SpaceID]*FinanceSettings)},
+		BudgetStore:           &mockBudgetStore{data: make(map[BudgetID]*Budget)},
+		PeriodStore:           &mockPeriodStore{data: make(map[string]*BudgetPeriod)},
+		ExchangeRateStore:     &mockExchangeRateStore{rates: make(map[string]*ExchangeRate)},
+		TransactionStore:      txnStore,
+		InsightsStore:         &mockInsightsStore{},
+		AccountStore:          &mockAccountStore{data: make(map[AccountID]*Account)},
+		TransferStore:         &mockTransferStore{data: make(map[TransferID]*Transfer)},
+		TransactionEventStore: &mockTransactionEventStore{events: make(map[TransactionEventID]*TransactionEvent)},
+		AlertStore:            &mockAlertStore{data: make(map[AlertID]*Alert)},
+		CategoryStore:         &mockCategoryStore{data: make(map[CategoryID]*Category)},
+		PayeeStore:            &mockPayeeStore{data: make(map[PayeeID]*Payee)},
+		TransactionRuleStore:  &mockTransactionRuleStore{data: make(map[TransactionRuleID]*TransactionRule)},
+	})
 
-	tests := []struct {
-		name                 string
-		docType              InboxItemDocType
-		metadata             map[string]any
-		linkExistingTxn      bool
-		initialTxnAmount     int64
-		initialTxnDesc       string
-		expectedTxnAmount    int64
-		expectedTxnDesc      string
-		expectedTransferMade bool
-		expectErr            bool
-	}{
-		{
-			name:    "Receipt linked transaction with overwrite_linked_transaction = true",
-			docType: InboxItemDocReceipt,
-			metadata: map[string]any{
-				"overwrite_linked_transaction": true,
-			},
-			linkExistingTxn:   true,
-			initialTxnAmount:  1000,
-			initialTxnDesc:    "Old Merchant",
-			expectedTxnAmount: 4500,
-			expectedTxnDesc:   "New Supermarket",
-			expectErr:         false,
-		},
-		{
-			name:    "Receipt linked transaction with overwrite_linked_transaction = false",
-			docType: InboxItemDocReceipt,
-			metadata: map[string]any{
-				"overwrite_linked_transaction": false,
-			},
-			linkExistingTxn:   true,
-			initialTxnAmount:  1000,
-			initialTxnDesc:    "Old Merchant",
-			expectedTxnAmount: 1000,
-			expectedTxnDesc:   "Old Merchant",
-			expectErr:         false,
-		},
-		{
-			name:    "System verification docType resolves immediately",
-			docType: InboxItemDocSystemVerification,
-			metadata: map[string]any{
-				"verification_code": "123456",
-			},
-			linkExistingTxn: false,
-			expectErr:       false,
-		},
-		{
-			name:    "Transfer transaction_type creates ledger transfer",
-			docType: InboxItemDocReceipt,
-			metadata: map[string]any{
-				"transaction_type":       "TRANSFER",
-				"destination_account_id": "DESTINATION_ACCOUNT_PLACEHOLDER",
-			},
-			linkExistingTxn:      false,
-			expectedTransferMade: true,
-			expectErr:            false,
-		},
-		{
-			name:    "Bank Notification transfer source leg creates ledger transfer",
-			docType: InboxItemDocBankNotification,
-			metadata: map[string]any{
-				"transaction_type":       "TRANSFER",
-				"destination_account_id": "DESTINATION_ACCOUNT_PLACEHOLDER",
-				"transfer_leg":           "SOURCE",
-			},
-			linkExistingTxn:      false,
-			expectedTransferMade: true,
-			expectErr:            false,
-		},
-		{
-			name:    "Bank Notification transfer destination leg creates ledger transfer",
-			docType: InboxItemDocBankNotification,
-			metadata: map[string]any{
-				"transaction_type":       "TRANSFER",
-				"destination_account_id": "DESTINATION_ACCOUNT_PLACEHOLDER",
-				"transfer_leg":           "DESTINATION",
-			},
-			linkExistingTxn:      false,
-			expectedTransferMade: true,
-			expectErr:            false,
-		},
-		{
-			name:    "Bank Notification standalone income",
-			docType: InboxItemDocBankNotification,
-			metadata: map[string]any{
-				"transaction_type": "INCOME",
-			},
-			linkExistingTxn: false,
-			expectErr:       false,
-		},
-		{
-			name:    "Bank Notification standalone expense",
-			docType: InboxItemDocBankNotification,
-			metadata: map[string]any{
-				"transaction_type": "EXPENSE",
-			},
-			linkExistingTxn: false,
-			expectErr:       false,
-		},
+	rawSpace, _ := id.Generate("spc_")
+	spaceID := SpaceID(rawSpace)
+	if _, err := svc.ConfigureFinance(ctx, &FinanceSettings{SpaceID: spaceID, BaseCurrency: "USD"}); err != nil {
+		t.Fatal(err)
 	}
+	newBudget := func(name string, limit int64) *Budget {
+		t.Helper()
+		budgetID, _ := NewBudgetID()
+		budget, err := svc.CreateBudget(ctx, &Budget{ID: budgetID, SpaceID: spaceID, Name: name, LimitAmount: limit, Currency: "USD", Interval: IntervalMonthly})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return budget
+	}
+	food := newBudget("Food", 10000)
+	home := newBudget("Home", 100000)
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	t.Run("split expense is checked against each budget", func(t *testing.T) {
+		txn, err := svc.CreateExpense(ctx, &Transaction{
+			SpaceID:         spaceID,
+			Amount:          10500,
+			Currency:        "USD",
+			Description:     "Supermarket",
+			TransactionDate: now,
+			Splits: []*TransactionSplit{
+				{BudgetID: &food.ID, Amount: 9000},
+				{BudgetID: &home.ID, Amount: 500},
+				{BudgetID: &food.ID, Amount: 1000},
+			},
+		})
+		if err != nil {
+			t.Fatalf("CreateExpense failed: %v", err)
+		}
 
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			settingsStore := &mockSettingsStore{data: make(map[SpaceID]*FinanceSettings)}
-			_ = settingsStore.Create(ctx, &FinanceSettings{SpaceID: spID, BaseCurrency: Currency("USD")})
-
-			accountStore := &mockAccountStore{data: make(map[AccountID]*Account)}
-			srcAccID, _ := NewAccountID()
-			srcAcc := &Account{
-				ID:             srcAccID,
-				SpaceID:        spID,
-				Name:           "Checking",
-				Currency:       Currency("USD"),
-				CurrentBalance: 100000,
-				IsActive:       true,
-			}
-			_ = accountStore.Create(ctx, srcAcc)
-
-			dstAccID, _ := NewAccountID()
-			dstAcc := &Account{
-				ID:             dstAccID,
-				SpaceID:        spID,
-				Name:           "Savings",
-				Currency:       Currency("USD"),
-				CurrentBalance: 0,
-				IsActive:       true,
-			}
-			_ = accountStore.Create(ctx, dstAcc)
+		raised, err := svc.EvaluateTransactionAlerts(ctx, spaceID, txn.ID, now)
+		if err != nil {
+			t.Fatalf("EvaluateTransactionAlerts failed: %v", err)
+		}
+		if len(raised) != 1 {
+			t.Fatalf("raised %d alerts, want 1", len(raised))
+		}
+		a := raised[0]
+		if a.Rule != AlertRuleBudgetThreshold || a.BudgetID == nil || *a.BudgetID != food.ID {
+			t.Errorf("raised %s for budget %v, want %s for %s", a.Rule, a.BudgetID, AlertRuleBudgetThreshold, food.ID)
+		}
+		if a.Amount != 10000 || a.Threshold != 10000 {
+			t.Errorf("threshold alert amount = %d, threshold = %d, want 10000 and 10000", a.Amount, a.Threshold)
+		}
+	})
 
-			budgetStore := &mockBudgetStore{data: make(map[BudgetID]*Budget)}
-			bID, _ := NewBudgetID()
-			bg := &Budget{
-				ID:          bID,
-				SpaceID:     spID,
-				Name:        "General",
-				LimitAmount: 50000,
-				Currency:    Currency("USD"),
+	t.Run("unbudgeted expense is checked for duplicates", func(t *testing.T) {
+		borrowingID, _ := NewBorrowingID()
+		var charges []TransactionID
+		for range 2 {
+			txnID, _ := NewTransactionID()
+			txnStore.txns[txnID] = &Transaction{
+				ID:              txnID,
+				SpaceID:         spaceID,
+				Type:            TransactionTypeExpense,
+				Amount:          25000,
+				AmountInBase:    25000,
+				Currency:        "USD",
+				Description:     "Loan to Sam",
+				TransactionDate: now,
+				Metadata:        TransactionMetadata{BorrowingID: &borrowingID},
 			}
-			_ = budgetStore.Create(ctx, bg)
+			charges = append(charges, txnID)
+		}
 
-			periodStore := &mockPeriodStore{data: make(map[string]*BudgetPeriod)}
+		raised, err := svc.EvaluateTransactionAlerts(ctx, spaceID, charges[1], now)
+		if err != nil {
+			t.Fatalf("EvaluateTransactionAlerts failed: %v", err)
+		}
+		if len(raised) != 1 || raised[0].Rule != AlertRuleDuplicateCharge {
+			t.Fatalf("raised %v, want a single %s alert", raised, AlertRuleDuplicateCharge)
+		}
+		if a := raised[0]; a.RelatedTransactionID == nil || *a.RelatedTransactionID != charges[0] {
+			t.Errorf("duplicate alert related transaction = %v, want %s", a.RelatedTransactionID, charges[0])
+		}
+	})
+}
 
-			txnStore := &mockTransactionStore{txns: make(map[TransactionID]*Transaction)}
-			eventStore := &mockTransactionEventStore{events: make(map[TransactionEventID]*TransactionEvent)}
-			transferStore := &mockTransferStore{data: make(map[TransferID]*Transfer)}
-			scheduledStore := &mockScheduledPaymentStore{payments: make(map[ScheduledPaymentID]*ScheduledPayment)}
-			inboxStore := &mockInboxItemStore{items: make(map[string]*InboxItem)}
+func TestService_ApproveInboxItemNotifiesTransactionChanged(t *testing.T) {
+	ctx := context.Background()
+	spIDStr, _ := id.Generate("spc_")
+	spID := SpaceID(spIDStr)
+	budgetIDStr, _ := id.Generate("bgt_")
+	budgetID := BudgetID(budgetIDStr)
 
-			svc := NewService(Dependencies{
-				SettingsStore:         settingsStore,
-				AccountStore:          accountStore,
-				TransactionStore:      txnStore,
-				TransactionEventStore: eventStore,
-				TransferStore:         transferStore,
-				ScheduledPaymentStore: scheduledStore,
-				InboxItemStore:        inboxStore,
-				BudgetStore:           budgetStore,
-				PeriodStore:           periodStore,
-			})
+	settingsStore := &mockSettingsStore{data: make(map[SpaceID]*FinanceSettings)}
+	budgetStore := &mockBudgetStore{data: make(map[BudgetID]*Budget)}
+	txnStore := &mockTransactionStore{txns: make(map[TransactionID]*Transaction)}
+	inboxStore := &mockInboxItemStore{items: make(map[string]*InboxItem)}
+	notifier := &recordingTransactionNotifier{}
 
-			// Prepare metadata overrides with valid destination account ID
-			metadata := make(map[string]any)
-			for k, v := range tt.metadata {
-				if v == "DESTINATION_ACCOUNT_PLACEHOLDER" {
-					metadata[k] = string(dstAccID)
-				} else {
-					metadata[k] = v
-				}
-			}
+	_ = settingsStore.Create(ctx, &FinanceSettings{SpaceID: spID, BaseCurrency: "USD"})
+	_ = budgetStore.Create(ctx, &Budget{ID: budgetID, SpaceID: spID, Name: "Food", LimitAmount: 50000, Currency: "USD", Interval: IntervalMonthly, IsActive: true})
 
-			// Stage item
-			srcAccStr := string(srcAccID)
-			bIDStr := string(bID)
-			staged, err := svc.StageInboxItem(ctx, spID, &StageInboxItem{
-				DocType:    tt.docType,
-				Vendor:     "New Supermarket",
-				Amount:     4500,
-				Currency:   "USD",
-				AccountID:  &srcAccStr,
-				RawPayload: "{}",
-				Metadata:   metadata,
-			})
-			if err != nil {
-				t.Fatalf("StageInboxItem failed: %v", err)
-			}
-			if err != nil {
-				t.Fatalf("StageInboxItem failed: %v", err)
-			}
-			staged.BudgetID = &bIDStr
-			_, _ = svc.UpdateInboxItem(ctx, spID, staged)
+	svc := NewService(Dependencies{
+		SettingsStore:         settingsStore,
+		BudgetStore:           budgetStore,
+		PeriodStore:           &mockPeriodStore{data: make(map[string]*BudgetPeriod)},
+		TransactionStore:      txnStore,
+		TransactionEventStore: &mockTransactionEventStore{events: make(map[TransactionEventID]*TransactionEvent)},
+		InboxItemStore:        inboxStore,
+		CategoryStore:         &mockCategoryStore{data: make(map[CategoryID]*Category)},
+		TransactionNotifier:   notifier,
+	})
 
-			var existingTxnID TransactionID
-			if tt.linkExistingTxn {
-				existingTxnID, _ = NewTransactionID()
-				txn := &Transaction{
-					ID:              existingTxnID,
-					SpaceID:         spID,
-					AccountID:       &srcAccID,
-					Amount:          tt.initialTxnAmount,
-					Currency:        Currency("USD"),
-					Description:     tt.initialTxnDesc,
-					TransactionDate: time.Now().UTC(),
-				}
-				_ = txnStore.Create(ctx, txn)
+	_ = inboxStore.Insert(ctx, &InboxItem{
+		ID:              "ibx_expense",
+		SpaceID:         string(spID),
+		Status:          InboxItemPending,
+		DocType:         InboxItemDocReceipt,
+		Amount:          4200,
+		Currency:        "USD",
+		VendorName:      "Corner Store",
+		BudgetID:        &budgetIDStr,
+		TransactionDate: time.Date(2026, 5, 2, 0, 0, 0, 0, time.UTC),
+		Metadata:        map[string]any{"transaction_type": "EXPENSE"},
+	})
 
-				txnIDStr := string(existingTxnID)
-				staged.TransactionID = &txnIDStr
-				_, _ = svc.UpdateInboxItem(ctx, spID, staged)
-			}
+	item, err := svc.ApproveInboxItem(ctx, spID, "ibx_expense")
+	if err != nil {
+		t.Fatalf("ApproveInboxItem failed: %v", err)
+	}
+	if item.TransactionID == nil {
+		t.Fatal("expected inbox item to be linked to a transaction")
+	}
+	if want := []TransactionID{TransactionID(*item.TransactionID)}; !slices.Equal(notifier.changed, want) {
+		t.Errorf("notified %v, want %v", notifier.changed, want)
+	}
+}
 
-			_, err = svc.ApproveInboxItem(ctx, spID, staged.ID)
-			if (err != nil) != tt.expectErr {
-				t.Fatalf("ApproveInboxItem error = %v, expectErr = %v", err, tt.expectErr)
-			}
+func TestService_GenerateScheduledPayments_Recurrence(t *testing.T) {
+	ctx := context.Background()
+	rawSpace, _ := id.Generate("spc_")
+	spaceID := SpaceID(rawSpace)
+	budgetID, _ := NewBudgetID()
+	now := time.Now().UTC()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
 
-			if tt.linkExistingTxn && !tt.expectErr {
-				updatedTxn, err := txnStore.GetByID(ctx, spID, existingTxnID)
-				if err != nil {
-					t.Fatalf("GetByID failed: %v", err)
-				}
-				if updatedTxn.Amount != tt.expectedTxnAmount {
-					t.Errorf("Txn Amount = %d, want %d", updatedTxn.Amount, tt.expectedTxnAmount)
-				}
-				if updatedTxn.Description != tt.expectedTxnDesc {
-					t.Errorf("Txn Description = %q, want %q", updatedTxn.Description, tt.expectedTxnDesc)
-				}
-			}
+	recurringStore := &mockRecurringExpenseStore{data: make(map[RecurringExpenseID]*RecurringExpense)}
+	paymentStore := &mockScheduledPaymentStore{payments: make(map[ScheduledPaymentID]*ScheduledPayment)}
+	svc := NewService(Dependencies{
+		RecurringExpenseStore: recurringStore,
+		ScheduledPaymentStore: paymentStore,
+	})
 
-			if tt.expectedTransferMade {
-				if len(transferStore.data) != 1 {
-					t.Errorf("Transfer count = %d, want 1", len(transferStore.data))
-				}
-			}
+	// Every other day, four times, with the second occurrence cancelled.
+	re, err := svc.CreateRecurringExpense(ctx, &RecurringExpense{
+		SpaceID:        spaceID,
+		BudgetID:       budgetID,
+		Name:           "Parking",
+		Amount:         500,
+		Currency:       "USD",
+		RecurrenceRule: "freq=daily;interval=2;count=4",
+		NextDueDate:    today,
+		ExceptionDates: []time.Time{today.AddDate(0, 0, 2)},
+	})
+	if err != nil {
+		t.Fatalf("CreateRecurringExpense failed: %v", err)
+	}
+	if re.RecurrenceRule != "FREQ=DAILY;INTERVAL=2;COUNT=4" || re.Interval != "custom" || !re.StartDate.Equal(today) {
+		t.Errorf("normalized rule = %q, interval = %q, start = %s", re.RecurrenceRule, re.Interval, re.StartDate)
+	}
 
-			// Verify status marked resolved
-			finalItem, err := inboxStore.Get(ctx, spID, staged.ID)
-			if err != nil {
-				t.Fatalf("Get inbox item failed: %v", err)
-			}
-			if finalItem.Status != InboxItemResolved {
-				t.Errorf("InboxItem Status = %s, want %s", finalItem.Status, InboxItemResolved)
-			}
-		})
+	if err := svc.GenerateScheduledPayments(ctx); err != nil {
+		t.Fatalf("GenerateScheduledPayments failed: %v", err)
+	}
+
+	var due []time.Time
+	for _, p := range paymentStore.payments {
+		due = append(due, p.DueDate)
+	}
+	slices.SortFunc(due, time.Time.Compare)
+	want := []time.Time{today, today.AddDate(0, 0, 4), today.AddDate(0, 0, 6)}
+	if !slices.EqualFunc(due, want, time.Time.Equal) {
+		t.Errorf("due dates = %v, want %v", due, want)
+	}
+	if re.Status != RecurringExpenseEnded {
+		t.Errorf("status = %s, want ended once the series is exhausted", re.Status)
 	}
 }
 
-func TestService_SystemVerification(t *testing.T) {
+func TestService_ForecastCashFlow_IncomeRecurrence(t *testing.T) {
 	ctx := context.Background()
-	spIDStr, _ := id.Generate("spc_")
-	spID := SpaceID(spIDStr)
+	rawSpace, _ := id.Generate("spc_")
+	spaceID := SpaceID(rawSpace)
+	checkingID, _ := NewAccountID()
+	sourceID, _ := NewIncomeSourceID()
+	now := time.Date(2026, 1, 10, 12, 0, 0, 0, time.UTC)
 
-	tests := []struct {
-		name     string
-		action   string // "approve" or "discard"
-		docType  InboxItemDocType
-		metadata map[string]any
-	}{
-		{
-			name:    "Approve system verification email resolves without ledger changes",
-			action:  "approve",
-			docType: InboxItemDocSystemVerification,
-			metadata: map[string]any{
-				"verification_code": "987654",
-				"sender":            "no-reply@auth.com",
-			},
-		},
-		{
-			name:    "Discard system verification email deletes item from queue",
-			action:  "discard",
-			docType: InboxItemDocSystemVerification,
-			metadata: map[string]any{
-				"verification_link": "https://auth.com/verify?token=abc",
+	svc := NewService(Dependencies{
+		SettingsStore: &mockSettingsStore{data: map[SpaceID]*FinanceSettings{spaceID: {SpaceID: spaceID, BaseCurrency: "USD"}}},
+		BudgetStore:   &mockBudgetStore{data: make(map[BudgetID]*Budget)},
+		AccountStore: &mockAccountStore{data: map[AccountID]*Account{
+			checkingID: {ID: checkingID, SpaceID: spaceID, Name: "Checking", Type: AccountTypeBank, Currency: "USD", IsDefault: true, IsActive: true},
+		}},
+		TransactionStore:      &mockTransactionStore{txns: make(map[TransactionID]*Transaction)},
+		ScheduledPaymentStore: &mockScheduledPaymentStore{payments: make(map[ScheduledPaymentID]*ScheduledPayment)},
+		RecurringExpenseStore: &mockRecurringExpenseStore{data: make(map[RecurringExpenseID]*RecurringExpense)},
+		BorrowingStore:        &mockBorrowingStore{data: make(map[BorrowingID]*Borrowing)},
+		// Paid on the 15th and the last day of the month, never received yet.
+		IncomeSourceStore: &mockIncomeSourceStore{data: map[IncomeSourceID]*IncomeSource{
+			sourceID: {
+				ID: sourceID, SpaceID: spaceID, Name: "Salary", ExpectedAmount: 100000, Currency: "USD", IsActive: true,
+				Cadence: IncomeCadenceCustom, RecurrenceRule: "FREQ=MONTHLY;BYMONTHDAY=15,-1",
+				StartDate: time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC),
 			},
-		},
+		}},
+	})
+
+	forecast, err := svc.ForecastCashFlow(ctx, &ForecastCashFlowRequest{SpaceID: spaceID, Days: 40, Now: now})
+	if err != nil {
+		t.Fatalf("ForecastCashFlow failed: %v", err)
 	}
 
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			settingsStore := &mockSettingsStore{data: make(map[SpaceID]*FinanceSettings)}
-			_ = settingsStore.Create(ctx, &FinanceSettings{SpaceID: spID, BaseCurrency: Currency("USD")})
+	var pays []string
+	for _, e := range forecast.Events {
+		if e.Kind == ForecastEventIncome {
+			pays = append(pays, e.Date.Format(time.DateOnly))
+		}
+	}
+	if want := []string{"2026-01-15", "2026-01-31", "2026-02-15"}; !slices.Equal(pays, want) {
+		t.Errorf("income dates = %v, want %v", pays, want)
+	}
+}
 
-			txnStore := &mockTransactionStore{txns: make(map[TransactionID]*Transaction)}
-			eventStore := &mockTransactionEventStore{events: make(map[TransactionEventID]*TransactionEvent)}
-			transferStore := &mockTransferStore{data: make(map[TransferID]*Transfer)}
-			scheduledStore := &mockScheduledPaymentStore{payments: make(map[ScheduledPaymentID]*ScheduledPayment)}
-			inboxStore := &mockInboxItemStore{items: make(map[string]*InboxItem)}
+func TestService_ConvertToRecurringExpense(t *testing.T) {
+	ctx := context.Background()
+	rawSpace, _ := id.Generate("spc_")
+	spaceID := SpaceID(rawSpace)
+	budgetID, _ := NewBudgetID()
+	now := time.Now().UTC()
 
-			svc := NewService(Dependencies{
-				SettingsStore:         settingsStore,
-				TransactionStore:      txnStore,
-				TransactionEventStore: eventStore,
-				TransferStore:         transferStore,
-				ScheduledPaymentStore: scheduledStore,
-				InboxItemStore:        inboxStore,
-			})
+	txnStore := &mockTransactionStore{txns: make(map[TransactionID]*Transaction)}
+	recurringStore := &mockRecurringExpenseStore{data: make(map[RecurringExpenseID]*RecurringExpense)}
+	svc := NewService(Dependencies{
+		TransactionStore:      txnStore,
+		RecurringExpenseStore: recurringStore,
+	})
 
-			// Stage system verification item
-			staged, err := svc.StageInboxItem(ctx, spID, &StageInboxItem{
-				DocType:    tt.docType,
-				Vendor:     "Auth System",
-				RawPayload: "Your verification code is 987654",
-				Metadata:   tt.metadata,
-			})
-			if err != nil {
-				t.Fatalf("StageInboxItem failed: %v", err)
-			}
+	var ids []TransactionID
+	for i := range 3 {
+		txnID, _ := NewTransactionID()
+		txnStore.txns[txnID] = &Transaction{
+			ID: txnID, SpaceID: spaceID, Type: TransactionTypeExpense, BudgetID: &budgetID,
+			Amount: 1599, Currency: "USD", Description: "Netflix", TransactionDate: now.AddDate(0, -3+i, 0),
+		}
+		ids = append(ids, txnID)
+	}
 
-			if staged.DocType != InboxItemDocSystemVerification {
-				t.Fatalf("DocType = %s, want %s", staged.DocType, InboxItemDocSystemVerification)
-			}
+	suggestions, err := svc.DetectSubscriptions(ctx, &DetectSubscriptionsRequest{SpaceID: spaceID, Now: now})
+	if err != nil {
+		t.Fatalf("DetectSubscriptions failed: %v", err)
+	}
+	if len(suggestions) != 1 {
+		t.Fatalf("got %d suggestions, want 1", len(suggestions))
+	}
 
-			switch tt.action {
-			case "approve":
-				_, err := svc.ApproveInboxItem(ctx, spID, staged.ID)
-				if err != nil {
-					t.Fatalf("ApproveInboxItem failed: %v", err)
-				}
+	re, err := svc.ConvertToRecurringExpense(ctx, &ConvertToRecurringExpenseRequest{
+		RecurringExpense: suggestions[0].RecurringExpense,
+		TransactionIDs:   suggestions[0].TransactionIDs,
+	})
+	if err != nil {
+		t.Fatalf("ConvertToRecurringExpense failed: %v", err)
+	}
+	if _, ok := recurringStore.data[re.ID]; !ok {
+		t.Fatal("expected the recurring expense to be stored")
+	}
+	for _, txnID := range ids {
+		if got := txnStore.txns[txnID].Metadata.RecurringExpenseID; got == nil || *got != re.ID {
+			t.Errorf("transaction %s recurring expense = %v, want %s", txnID, got, re.ID)
+		}
+	}
 
-				// Verify item status resolved
-				item, err := inboxStore.Get(ctx, spID, staged.ID)
-				if err != nil {
-					t.Fatalf("Get inbox item failed: %v", err)
-				}
-				if item.Status != InboxItemResolved {
-					t.Errorf("Status = %s, want %s", item.Status, InboxItemResolved)
-				}
+	// The vendor is tracked now, so it is no longer suggested and its charges cannot be relinked.
+	suggestions, err = svc.DetectSubscriptions(ctx, &DetectSubscriptionsRequest{SpaceID: spaceID, Now: now})
+	if err != nil {
+		t.Fatalf("DetectSubscriptions failed: %v", err)
+	}
+	if len(suggestions) != 0 {
+		t.Errorf("got %d suggestions after converting, want 0", len(suggestions))
+	}
+	_, err = svc.ConvertToRecurringExpense(ctx, &ConvertToRecurringExpenseRequest{
+		RecurringExpense: &RecurringExpense{SpaceID: spaceID, BudgetID: budgetID, Name: "Netflix", Amount: 1599, Currency: "USD", Interval: "monthly", NextDueDate: now},
+		TransactionIDs:   ids[:1],
+	})
+	if !errors.Is(err, ErrCannotRelinkTransactionToDifferentRecurringExpense) {
+		t.Errorf("relinking error = %v, want ErrCannotRelinkTransactionToDifferentRecurringExpense", err)
+	}
+}
 
-			case "discard":
-				err := svc.DiscardInboxItem(ctx, spID, staged.ID)
-				if err != nil {
-					t.Fatalf("DiscardInboxItem failed: %v", err)
-				}
+func TestService_TransactionRules(t *testing.T) {
+	ctx := context.Background()
+	spIDStr, _ := id.Generate("spc_")
+	spaceID := SpaceID(spIDStr)
 
-				// Verify item deleted from store
-				_, err = inboxStore.Get(ctx, spID, staged.ID)
-				if err == nil {
-					t.Errorf("Expected error fetching discarded item, got nil")
-				}
-			}
+	txnStore := &mockTransactionStore{txns: make(map[TransactionID]*Transaction)}
+	svc := NewService(Dependencies{
+		SettingsStore:         &mockSettingsStore{data: make(map[SpaceID]*FinanceSettings)},
+		BudgetStore:           &mockBudgetStore{data: make(map[BudgetID]*Budget)},
+		PeriodStore:           &mockPeriodStore{data: make(map[string]*BudgetPeriod)},
+		ExchangeRateStore:     &mockExchangeRateStore{rates: make(map[string]*ExchangeRate)},
+		TransactionStore:      txnStore,
+		InsightsStore:         &mockInsightsStore{},
+		AccountStore:          &mockAccountStore{data: make(map[AccountID]*Account)},
+		TransferStore:         &mockTransferStore{data: make(map[TransferID]*Transfer)},
+		TransactionEventStore: &mockTransactionEventStore{events: make(map[TransactionEventID]*TransactionEvent)},
+		PayeeStore:            &mockPayeeStore{data: make(map[PayeeID]*Payee)},
+		TransactionRuleStore:  &mockTransactionRuleStore{data: make(map[TransactionRuleID]*TransactionRule)},
+	})
 
-			// Assert 0 side-effect ledger entities created across all cases
-			if len(txnStore.txns) != 0 {
-				t.Errorf("Transactions created = %d, want 0", len(txnStore.txns))
-			}
-			if len(transferStore.data) != 0 {
-				t.Errorf("Transfers created = %d, want 0", len(transferStore.data))
-			}
-			if len(scheduledStore.payments) != 0 {
-				t.Errorf("Scheduled payments created = %d, want 0", len(scheduledStore.payments))
-			}
+	if _, err := svc.ConfigureFinance(ctx, &FinanceSettings{SpaceID: spaceID, BaseCurrency: Currency("USD")}); err != nil {
+		t.Fatal(err)
+	}
+	newBudget := func(name string) *Budget {
+		bgtIDStr, _ := id.Generate("bgt_")
+		b, err := svc.CreateBudget(ctx, &Budget{
+			ID:          BudgetID(bgtIDStr),
+			SpaceID:     spaceID,
+			Name:        name,
+			LimitAmount: 50000,
+			Currency:    Currency("USD"),
+			Interval:    IntervalMonthly,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return b
+	}
+	shopping := newBudget("Shopping")
+	groceries := newBudget("Groceries")
+
+	amazon, err := svc.CreatePayee(ctx, &Payee{
+		SpaceID:         spaceID,
+		Name:            "Amazon",
+		Aliases:         []string{"AMZN MKTP"},
+		DefaultBudgetID: &shopping.ID,
+	})
+	if err != nil {
+		t.Fatalf("CreatePayee failed: %v", err)
+	}
+
+	date := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	t.Run("payee fills the budget on create", func(t *testing.T) {
+		created, err := svc.CreateExpense(ctx, &Transaction{
+			SpaceID:         spaceID,
+			Amount:          2599,
+			Currency:        Currency("USD"),
+			Description:     "AMZN MKTP US*2K3",
+			TransactionDate: date,
+		})
+		if err != nil {
+			t.Fatalf("CreateExpense failed: %v", err)
+		}
+		if created.PayeeID == nil || *created.PayeeID != amazon.ID || *created.BudgetID != shopping.ID {
+			t.Errorf("expected Amazon payee and shopping budget, got payee %v budget %v", created.PayeeID, created.BudgetID)
+		}
+	})
+
+	t.Run("expense without budget or match is rejected", func(t *testing.T) {
+		_, err := svc.CreateExpense(ctx, &Transaction{
+			SpaceID:         spaceID,
+			Amount:          1000,
+			Currency:        Currency("USD"),
+			Description:     "Unknown shop",
+			TransactionDate: date,
 		})
+		if err == nil {
+			t.Fatal("expected an error for an expense without budget")
+		}
+	})
+
+	market, err := svc.CreateExpense(ctx, &Transaction{
+		SpaceID:         spaceID,
+		BudgetID:        &shopping.ID,
+		Amount:          4200,
+		Currency:        Currency("USD"),
+		Description:     "CORNER MARKET #12",
+		TransactionDate: date,
+	})
+	if err != nil {
+		t.Fatal(err)
 	}
-}
 
-func TestService_InvoiceBranch(t *testing.T) {
-	ctx := context.Background()
-	spIDStr, _ := id.Generate("spc_")
-	spID := SpaceID(spIDStr)
+	t.Run("rules validate references", func(t *testing.T) {
+		missing, _ := NewPayeeID()
+		_, err := svc.CreateTransactionRule(ctx, &TransactionRule{SpaceID: spaceID, Name: "Ghost", DescriptionPattern: "ghost", SetPayeeID: &missing})
+		if !errors.Is(err, ErrPayeeNotFound) {
+			t.Fatalf("expected ErrPayeeNotFound, got %v", err)
+		}
+	})
 
-	tests := []struct {
-		name                     string
-		action                   string // "approve" or "discard"
-		linkScheduledPayment     bool
-		paymentInitialStatus     ScheduledPaymentStatus
-		expectedScheduledCount   int
-		expectedTransactionCount int
-		expectPaidTxnLinked      bool
-	}{
-		{
-			name:                     "Unlinked invoice creates new scheduled payment",
-			action:                   "approve",
-			linkScheduledPayment:     false,
-			expectedScheduledCount:   1,
-			expectedTransactionCount: 0,
-			expectPaidTxnLinked:      false,
-		},
-		{
-			name:                     "Linked invoice to unpaid scheduled payment updates bill figures",
-			action:                   "approve",
-			linkScheduledPayment:     true,
-			paymentInitialStatus:     ScheduledPaymentPending,
-			expectedScheduledCount:   1,
-			expectedTransactionCount: 0,
-			expectPaidTxnLinked:      false,
-		},
-		{
-			name:                     "Linked invoice to already paid scheduled payment attaches audit link to paid transaction",
-			action:                   "approve",
-			linkScheduledPayment:     true,
-			paymentInitialStatus:     ScheduledPaymentStatus("paid"),
-			expectedScheduledCount:   1,
-			expectedTransactionCount: 1,
-			expectPaidTxnLinked:      true,
-		},
-		{
-			name:                     "Discard invoice deletes item from queue",
-			action:                   "discard",
-			linkScheduledPayment:     false,
-			expectedScheduledCount:   0,
-			expectedTransactionCount: 0,
-			expectPaidTxnLinked:      false,
-		},
+	rule, err := svc.CreateTransactionRule(ctx, &TransactionRule{
+		SpaceID:            spaceID,
+		Name:               "Markets are groceries",
+		DescriptionPattern: `\bmarket\b`,
+		SetBudgetID:        &groceries.ID,
+		AddTags:            []string{"food"},
+	})
+	if err != nil {
+		t.Fatalf("CreateTransactionRule failed: %v", err)
+	}
+	if !rule.IsActive {
+		t.Error("expected new rules to be active")
 	}
 
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			settingsStore := &mockSettingsStore{data: make(map[SpaceID]*FinanceSettings)}
-			_ = settingsStore.Create(ctx, &FinanceSettings{SpaceID: spID, BaseCurrency: Currency("USD")})
-
-			accountStore := &mockAccountStore{data: make(map[AccountID]*Account)}
-			accID, _ := NewAccountID()
-			_ = accountStore.Create(ctx, &Account{
-				ID:             accID,
-				SpaceID:        spID,
-				Name:           "Checking",
-				Currency:       Currency("USD"),
-				CurrentBalance: 100000,
-				IsActive:       true,
-			})
+	t.Run("bulk run fills blanks only", func(t *testing.T) {
+		result, err := svc.ApplyTransactionRules(ctx, &ApplyTransactionRulesRequest{SpaceID: spaceID})
+		if err != nil {
+			t.Fatalf("ApplyTransactionRules failed: %v", err)
+		}
+		if result.Scanned != 2 || result.Updated != 1 {
+			t.Errorf("unexpected result: %+v", result)
+		}
+		got := txnStore.txns[market.ID]
+		if *got.BudgetID != shopping.ID || !slices.Contains(got.Tags, "food") {
+			t.Errorf("expected budget kept and tag added, got budget %s tags %v", *got.BudgetID, got.Tags)
+		}
+	})
 
-			txnStore := &mockTransactionStore{txns: make(map[TransactionID]*Transaction)}
-			eventStore := &mockTransactionEventStore{events: make(map[TransactionEventID]*TransactionEvent)}
-			transferStore := &mockTransferStore{data: make(map[TransferID]*Transfer)}
-			scheduledStore := &mockScheduledPaymentStore{payments: make(map[ScheduledPaymentID]*ScheduledPayment)}
-			inboxStore := &mockInboxItemStore{items: make(map[string]*InboxItem)}
+	t.Run("bulk run with overwrite replaces the budget", func(t *testing.T) {
+		result, err := svc.ApplyTransactionRules(ctx, &ApplyTransactionRulesRequest{SpaceID: spaceID, Overwrite: true})
+		if err != nil {
+			t.Fatalf("ApplyTransactionRules failed: %v", err)
+		}
+		if result.Updated != 1 {
+			t.Errorf("expected one update, got %+v", result)
+		}
+		if got := txnStore.txns[market.ID]; *got.BudgetID != groceries.ID {
+			t.Errorf("expected groceries budget, got %s", *got.BudgetID)
+		}
 
-			svc := NewService(Dependencies{
-				SettingsStore:         settingsStore,
-				AccountStore:          accountStore,
-				TransactionStore:      txnStore,
-				TransactionEventStore: eventStore,
-				TransferStore:         transferStore,
-				ScheduledPaymentStore: scheduledStore,
-				InboxItemStore:        inboxStore,
-			})
+		result, err = svc.ApplyTransactionRules(ctx, &ApplyTransactionRulesRequest{SpaceID: spaceID, Overwrite: true})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result.Updated != 0 {
+			t.Errorf("expected a second run to be a no-op, got %+v", result)
+		}
+	})
+}
 
-			var preExistingPayID ScheduledPaymentID
-			var preExistingTxnID TransactionID
+func TestService_BatchTransactions(t *testing.T) {
+	ctx := context.Background()
+	spIDStr, _ := id.Generate("spc_")
+	spaceID := SpaceID(spIDStr)
+	checkingID, _ := NewAccountID()
+	cardID, _ := NewAccountID()
 
-			if tt.linkScheduledPayment {
-				preExistingPayID, _ = NewScheduledPaymentID()
-				pay := &ScheduledPayment{
-					ID:         preExistingPayID,
-					SpaceID:    spID,
-					SourceType: "Electric Co",
-					SourceID:   "src_elec",
-					Amount:     10000,
-					Currency:   Currency("USD"),
-					DueDate:    time.Now().UTC(),
-					Status:     tt.paymentInitialStatus,
-				}
+	accountStore := &mockAccountStore{data: make(map[AccountID]*Account)}
+	periodStore := &mockPeriodStore{data: make(map[string]*BudgetPeriod)}
+	txnStore := &mockTransactionStore{txns: make(map[TransactionID]*Transaction), accounts: accountStore, periods: periodStore}
+	svc := NewService(Dependencies{
+		SettingsStore:         &mockSettingsStore{data: make(map[SpaceID]*FinanceSettings)},
+		BudgetStore:           &mockBudgetStore{data: make(map[BudgetID]*Budget)},
+		PeriodStore:           periodStore,
+		ExchangeRateStore:     &mockExchangeRateStore{rates: make(map[string]*ExchangeRate)},
+		TransactionStore:      txnStore,
+		AccountStore:          accountStore,
+		TransactionEventStore: &mockTransactionEventStore{events: make(map[TransactionEventID]*TransactionEvent)},
+		PayeeStore:            &mockPayeeStore{data: make(map[PayeeID]*Payee)},
+		TransactionRuleStore:  &mockTransactionRuleStore{data: make(map[TransactionRuleID]*TransactionRule)},
+	})
 
-				if tt.expectPaidTxnLinked {
-					preExistingTxnID, _ = NewTransactionID()
-					txn := &Transaction{
-						ID:              preExistingTxnID,
-						SpaceID:         spID,
-						AccountID:       &accID,
-						Amount:          10000,
-						Currency:        Currency("USD"),
-						Description:     "Electric Co Bill Paid",
-						TransactionDate: time.Now().UTC(),
-					}
-					_ = txnStore.Create(ctx, txn)
-				}
+	if _, err := svc.ConfigureFinance(ctx, &FinanceSettings{SpaceID: spaceID, BaseCurrency: Currency("USD")}); err != nil {
+		t.Fatal(err)
+	}
+	_ = accountStore.Create(ctx, &Account{ID: checkingID, SpaceID: spaceID, Name: "Checking", Type: AccountTypeBank, Currency: "USD", CurrentBalance: 100000, IsActive: true})
+	_ = accountStore.Create(ctx, &Account{ID: cardID, SpaceID: spaceID, Name: "Visa", Type: AccountTypeCreditCard, Currency: "USD", IsActive: true})
+
+	newBudget := func(name string) *Budget {
+		bgtIDStr, _ := id.Generate("bgt_")
+		b, err := svc.CreateBudget(ctx, &Budget{
+			ID:          BudgetID(bgtIDStr),
+			SpaceID:     spaceID,
+			Name:        name,
+			LimitAmount: 50000,
+			Currency:    Currency("USD"),
+			Interval:    IntervalMonthly,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return b
+	}
+	food := newBudget("Food")
+	home := newBudget("Home")
+
+	date := time.Date(2026, 4, 8, 12, 0, 0, 0, time.UTC)
+	var expenses []*Transaction
+	for _, amount := range []int64{1000, 2000, 3000} {
+		txn, err := svc.CreateExpense(ctx, &Transaction{
+			SpaceID:         spaceID,
+			BudgetID:        &food.ID,
+			AccountID:       &checkingID,
+			Amount:          amount,
+			Currency:        Currency("USD"),
+			Description:     "Hardware store",
+			TransactionDate: date,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		expenses = append(expenses, txn)
+	}
+	if got := accountStore.data[checkingID].CurrentBalance; got != 94000 {
+		t.Fatalf("checking balance = %d, want 94000", got)
+	}
 
-				_ = scheduledStore.Create(ctx, pay)
-			}
+	ids := []TransactionID{expenses[0].ID, expenses[1].ID}
 
-			bID, _ := NewBudgetID()
-			bIDStr := string(bID)
+	t.Run("validate only reports the diff without persisting", func(t *testing.T) {
+		periods := len(periodStore.data)
+		result, err := svc.BatchUpdateTransactions(ctx, &BatchUpdateTransactionsRequest{
+			SpaceID:      spaceID,
+			Selector:     TransactionSelector{IDs: ids},
+			Transaction:  &Transaction{BudgetID: &home.ID},
+			UpdateMask:   []string{"budget_id"},
+			ValidateOnly: true,
+		})
+		if err != nil {
+			t.Fatalf("BatchUpdateTransactions failed: %v", err)
+		}
+		if len(result.Diffs) != 2 {
+			t.Fatalf("expected 2 diffs, got %d", len(result.Diffs))
+		}
+		change := result.Diffs[0].Changes[0]
+		if change.Field != "budget_id" || change.OldValue != string(food.ID) || change.NewValue != string(home.ID) {
+			t.Errorf("unexpected change: %+v", change)
+		}
+		if len(result.PeriodIDs) != 2 || len(result.BalanceChanges) != 0 {
+			t.Errorf("expected 2 affected periods and no balance change, got %+v", result)
+		}
+		if txnStore.batches != 0 || *txnStore.txns[expenses[0].ID].BudgetID != food.ID {
+			t.Error("validate only must not persist changes")
+		}
+		if len(periodStore.data) != periods {
+			t.Errorf("validate only created %d budget periods, want none", len(periodStore.data)-periods)
+		}
+	})
 
-			// Stage Invoice item
-			accIDStr := string(accID)
-			staged, err := svc.StageInboxItem(ctx, spID, &StageInboxItem{
-				DocType:    InboxItemDocInvoice,
-				Vendor:     "Electric Co",
-				Amount:     12500, // $125.00
-				Currency:   "USD",
-				AccountID:  &accIDStr,
-				Date:       time.Now().UTC().Format(time.RFC3339),
-				RawPayload: "{}",
-			})
-			if err != nil {
-				t.Fatalf("StageInboxItem failed: %v", err)
+	t.Run("moves budgets in a single batch", func(t *testing.T) {
+		_, err := svc.BatchUpdateTransactions(ctx, &BatchUpdateTransactionsRequest{
+			SpaceID:     spaceID,
+			Selector:    TransactionSelector{IDs: ids},
+			Transaction: &Transaction{BudgetID: &home.ID},
+			UpdateMask:  []string{"budget_id"},
+		})
+		if err != nil {
+			t.Fatalf("BatchUpdateTransactions failed: %v", err)
+		}
+		if txnStore.batches != 1 {
+			t.Errorf("expected a single batch write, got %d", txnStore.batches)
+		}
+		for _, id := range ids {
+			if got := txnStore.txns[id]; *got.BudgetID != home.ID || got.PeriodID == nil {
+				t.Errorf("transaction %s was not moved to the home budget: %+v", id, got)
 			}
-			staged.BudgetID = &bIDStr
-			_, _ = svc.UpdateInboxItem(ctx, spID, staged)
-
-			if tt.linkScheduledPayment {
-				pIDStr := string(preExistingPayID)
-				staged.ScheduledPaymentID = &pIDStr
-				if tt.expectPaidTxnLinked {
-					tIDStr := string(preExistingTxnID)
-					staged.TransactionID = &tIDStr
-				}
-				_, _ = svc.UpdateInboxItem(ctx, spID, staged)
+		}
+		period, err := periodStore.GetByDate(ctx, home.ID, date)
+		if err != nil {
+			t.Fatalf("home budget period was not created: %v", err)
+		}
+		for _, id := range ids {
+			if got := txnStore.txns[id].PeriodID; *got != period.ID {
+				t.Errorf("transaction %s period = %s, want %s", id, *got, period.ID)
 			}
+		}
+	})
 
-			switch tt.action {
-			case "approve":
-				_, err := svc.ApproveInboxItem(ctx, spID, staged.ID)
-				if err != nil {
-					t.Fatalf("ApproveInboxItem failed: %v", err)
-				}
-
-				item, err := inboxStore.Get(ctx, spID, staged.ID)
-				if err != nil {
-					t.Fatalf("Get inbox item failed: %v", err)
-				}
-				if item.Status != InboxItemResolved {
-					t.Errorf("InboxItem Status = %s, want %s", item.Status, InboxItemResolved)
-				}
+	t.Run("nets balance changes per account", func(t *testing.T) {
+		result, err := svc.BatchUpdateTransactions(ctx, &BatchUpdateTransactionsRequest{
+			SpaceID:     spaceID,
+			Selector:    TransactionSelector{IDs: []TransactionID{expenses[1].ID, expenses[2].ID}},
+			Transaction: &Transaction{AccountID: &cardID},
+			UpdateMask:  []string{"account_id"},
+		})
+		if err != nil {
+			t.Fatalf("BatchUpdateTransactions failed: %v", err)
+		}
+		if len(result.BalanceChanges) != 2 {
+			t.Fatalf("expected 2 balance changes, got %d", len(result.BalanceChanges))
+		}
+		if got := accountStore.data[checkingID].CurrentBalance; got != 99000 {
+			t.Errorf("checking balance = %d, want 99000", got)
+		}
+		if got := accountStore.data[cardID].CurrentBalance; got != 5000 {
+			t.Errorf("card balance = %d, want 5000", got)
+		}
+	})
 
-				if tt.expectPaidTxnLinked {
-					if item.TransactionID == nil || *item.TransactionID != string(preExistingTxnID) {
-						t.Errorf("Item TransactionID = %v, want %s", item.TransactionID, preExistingTxnID)
-					}
-				}
+	t.Run("rejects the whole batch when one transaction is locked", func(t *testing.T) {
+		rID, _ := NewReconciliationID()
+		txnStore.txns[expenses[2].ID].ReconciliationID = &rID
+		defer func() { txnStore.txns[expenses[2].ID].ReconciliationID = nil }()
 
-			case "discard":
-				err := svc.DiscardInboxItem(ctx, spID, staged.ID)
-				if err != nil {
-					t.Fatalf("DiscardInboxItem failed: %v", err)
-				}
+		_, err := svc.BatchDeleteTransactions(ctx, &BatchDeleteTransactionsRequest{
+			SpaceID:  spaceID,
+			Selector: TransactionSelector{IDs: []TransactionID{expenses[0].ID, expenses[2].ID}},
+		})
+		if !errors.Is(err, ErrTransactionReconciled) {
+			t.Fatalf("expected ErrTransactionReconciled, got %v", err)
+		}
+		if len(txnStore.txns) != 3 {
+			t.Error("no transaction must be deleted when the batch is rejected")
+		}
+	})
 
-				_, err = inboxStore.Get(ctx, spID, staged.ID)
-				if err == nil {
-					t.Errorf("Expected error fetching discarded invoice, got nil")
-				}
-			}
+	t.Run("rejects unknown fields and mixed selectors", func(t *testing.T) {
+		_, err := svc.BatchUpdateTransactions(ctx, &BatchUpdateTransactionsRequest{
+			SpaceID:     spaceID,
+			Selector:    TransactionSelector{IDs: ids},
+			Transaction: &Transaction{Amount: 1},
+			UpdateMask:  []string{"amount"},
+		})
+		if !errors.Is(err, patch.ErrUnsupportedField) {
+			t.Errorf("expected ErrUnsupportedField, got %v", err)
+		}
+		_, err = svc.BatchDeleteTransactions(ctx, &BatchDeleteTransactionsRequest{
+			SpaceID:  spaceID,
+			Selector: TransactionSelector{IDs: ids, Expression: "amount > 0"},
+		})
+		if err == nil {
+			t.Error("expected an error for IDs combined with a filter")
+		}
+	})
 
-			if len(scheduledStore.payments) != tt.expectedScheduledCount {
-				t.Errorf("Scheduled Payments count = %d, want %d", len(scheduledStore.payments), tt.expectedScheduledCount)
-			}
-			if len(txnStore.txns) != tt.expectedTransactionCount {
-				t.Errorf("Transactions count = %d, want %d", len(txnStore.txns), tt.expectedTransactionCount)
-			}
+	t.Run("deletes by filter and restores balances", func(t *testing.T) {
+		result, err := svc.BatchDeleteTransactions(ctx, &BatchDeleteTransactionsRequest{
+			SpaceID:  spaceID,
+			Selector: TransactionSelector{Expression: "amount > 0"},
 		})
-	}
+		if err != nil {
+			t.Fatalf("BatchDeleteTransactions failed: %v", err)
+		}
+		if len(result.Transactions) != 3 || len(txnStore.txns) != 0 {
+			t.Errorf("expected all 3 transactions to be deleted, got %d left", len(txnStore.txns))
+		}
+		if got := accountStore.data[checkingID].CurrentBalance; got != 100000 {
+			t.Errorf("checking balance = %d, want 100000", got)
+		}
+		if got := accountStore.data[cardID].CurrentBalance; got != 0 {
+			t.Errorf("card balance = %d, want 0", got)
+		}
+	})
 }
@@ -1,10 +1,14 @@
 package finance
 
 import (
+	"errors"
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/masterkeysrd/saturn/internal/platform/id"
+	"github.com/masterkeysrd/saturn/internal/platform/patch"
 )
 
 // SpaceID is a custom string type representing a space's identifier.
@@ -32,10 +36,17 @@ const spacePrefix = "spc_"
 
 // FinanceSettings stores workspace-scoped configurations.
 type FinanceSettings struct {
-	SpaceID      SpaceID
-	BaseCurrency Currency
-	CreateTime   time.Time
-	UpdateTime   time.Time
+	SpaceID           SpaceID
+	BaseCurrency      Currency
+	EnabledCurrencies []Currency // Currencies allowed in the space; empty enables the whole catalog
+	Timezone          string     // IANA zone used to compute budget period bounds; defaults to UTC
+	CreateTime        time.Time
+	UpdateTime        time.Time
+
+	// Alert rules. Zero values fall back to the defaults in alert.go.
+	BudgetAlertPercents  []int32 // Budget usage percentages that raise an alert, e.g. 80 and 100
+	AnomalyStdDevs       float64 // Standard deviations above a budget's history that flag an expense
+	DuplicateWindowHours int32   // How far apart two identical charges may be to look duplicated
 }
 
 // Validate checks the settings validity.
@@ -46,5 +57,73 @@ func (fs *FinanceSettings) Validate() error {
 	if err := fs.SpaceID.Validate(); err != nil {
 		return fmt.Errorf("validate space ID: %w", err)
 	}
+	for _, c := range fs.EnabledCurrencies {
+		if err := c.Validate(); err != nil {
+			return fmt.Errorf("validate enabled currency: %w", err)
+		}
+	}
+	if len(fs.EnabledCurrencies) > 0 && !slices.Contains(fs.EnabledCurrencies, fs.BaseCurrency) {
+		return fmt.Errorf("base currency %s cannot be disabled", fs.BaseCurrency)
+	}
+	fs.Timezone = strings.TrimSpace(fs.Timezone)
+	if fs.Timezone == "" {
+		fs.Timezone = "UTC"
+	}
+	if _, err := time.LoadLocation(fs.Timezone); err != nil {
+		return fmt.Errorf("invalid timezone %q: %w", fs.Timezone, err)
+	}
+	for _, pct := range fs.BudgetAlertPercents {
+		if pct <= 0 || pct > MaxBudgetAlertPercent {
+			return fmt.Errorf("budget alert percentages must be between 1 and %d", MaxBudgetAlertPercent)
+		}
+	}
+	if fs.AnomalyStdDevs < 0 {
+		return errors.New("anomaly standard deviations cannot be negative")
+	}
+	if fs.DuplicateWindowHours < 0 {
+		return errors.New("duplicate window cannot be negative")
+	}
 	return nil
 }
+
+// Location returns the space's timezone, falling back to UTC when it is unset or unknown.
+func (fs *FinanceSettings) Location() *time.Location {
+	if fs.Timezone == "" {
+		return time.UTC
+	}
+	loc, err := time.LoadLocation(fs.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// IsCurrencyEnabled reports whether amounts in c may be recorded in the space.
+// The base currency is always enabled.
+func (fs *FinanceSettings) IsCurrencyEnabled(c Currency) bool {
+	if c == fs.BaseCurrency || len(fs.EnabledCurrencies) == 0 {
+		return true
+	}
+	return slices.Contains(fs.EnabledCurrencies, c)
+}
+
+// SettingsPatchSchema defines all patchable fields for FinanceSettings. The base currency is immutable.
+var SettingsPatchSchema = patch.NewSchema[FinanceSettings]().
+	Register("enabled_currencies", patch.Field(func(fs *FinanceSettings) *[]Currency { return &fs.EnabledCurrencies })).
+	Register("timezone", patch.Field(func(fs *FinanceSettings) *string { return &fs.Timezone })).
+	Register("budget_alert_percents", patch.Field(func(fs *FinanceSettings) *[]int32 { return &fs.BudgetAlertPercents })).
+	Register("anomaly_std_devs", patch.Field(func(fs *FinanceSettings) *float64 { return &fs.AnomalyStdDevs })).
+	Register("duplicate_window_hours", patch.Field(func(fs *FinanceSettings) *int32 { return &fs.DuplicateWindowHours }))
+
+// ApplyPatch applies partial updates from incoming settings based on the field mask.
+func (fs *FinanceSettings) ApplyPatch(incoming *FinanceSettings, mask []string) error {
+	if err := SettingsPatchSchema.Apply(fs, incoming, mask); err != nil {
+		return err
+	}
+	slices.Sort(fs.EnabledCurrencies)
+	fs.EnabledCurrencies = slices.Compact(fs.EnabledCurrencies)
+	slices.Sort(fs.BudgetAlertPercents)
+	fs.BudgetAlertPercents = slices.Compact(fs.BudgetAlertPercents)
+	fs.UpdateTime = time.Now().UTC()
+	return fs.Validate()
+}
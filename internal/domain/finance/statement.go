@@ -0,0 +1,342 @@
+package finance
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/masterkeysrd/saturn/internal/platform/hash"
+)
+
+// StatementFormat identifies the file format of an imported bank statement.
+type StatementFormat string
+
+const (
+	StatementFormatCSV     StatementFormat = "csv"
+	StatementFormatOFX     StatementFormat = "ofx"
+	StatementFormatQFX     StatementFormat = "qfx"
+	StatementFormatCAMT053 StatementFormat = "camt053"
+)
+
+// MaxStatementSize bounds the size of an uploaded statement file in bytes.
+const MaxStatementSize = 5 << 20
+
+// Validate checks if the statement format is supported.
+func (f StatementFormat) Validate() error {
+	switch f {
+	case StatementFormatCSV, StatementFormatOFX, StatementFormatQFX, StatementFormatCAMT053:
+		return nil
+	default:
+		return fmt.Errorf("unsupported statement format: %q", f)
+	}
+}
+
+// InboxSource returns the inbox item source tag for rows imported in this format.
+func (f StatementFormat) InboxSource() InboxItemSource {
+	switch f {
+	case StatementFormatOFX:
+		return InboxItemSourceStatementOFX
+	case StatementFormatQFX:
+		return InboxItemSourceStatementQFX
+	case StatementFormatCAMT053:
+		return InboxItemSourceStatementCAMT053
+	default:
+		return InboxItemSourceStatementCSV
+	}
+}
+
+// StatementEntry is a single normalized row parsed from a bank statement.
+type StatementEntry struct {
+	Date        time.Time
+	Amount      int64    // Signed in minor units: negative for debits, positive for credits
+	Currency    Currency // Empty when the statement does not state a currency
+	Description string
+	Reference   string // Bank-assigned identifier (FITID, AcctSvcrRef, ...) when available
+	Raw         string // Original row or block the entry was parsed from
+}
+
+// IsDebit reports whether the entry represents money leaving the account.
+func (e *StatementEntry) IsDebit() bool {
+	return e.Amount < 0
+}
+
+// AbsAmount returns the unsigned entry amount.
+func (e *StatementEntry) AbsAmount() int64 {
+	if e.Amount < 0 {
+		return -e.Amount
+	}
+	return e.Amount
+}
+
+// TransactionType returns the ledger transaction type the entry maps to.
+func (e *StatementEntry) TransactionType() TransactionType {
+	if e.IsDebit() {
+		return TransactionTypeExpense
+	}
+	return TransactionTypeIncome
+}
+
+// MatchDuplicate picks the ledger transaction that most likely records the same
+// movement as the entry. Candidates are expected to already be narrowed down to the
+// deduplication window; only those sharing the entry's direction and account are
+// considered. Ties are broken by amount distance, then date distance, then ID so the
+// result is stable across runs.
+func (e *StatementEntry) MatchDuplicate(accountID AccountID, candidates []*Transaction) *Transaction {
+	var matches []*Transaction
+	for _, txn := range candidates {
+		if txn.Type != e.TransactionType() {
+			continue
+		}
+		if accountID != "" && txn.AccountID != nil && *txn.AccountID != accountID {
+			continue
+		}
+		matches = append(matches, txn)
+	}
+	if len(matches) == 0 {
+		return nil
+	}
+
+	amount := e.AbsAmount()
+	sort.SliceStable(matches, func(i, j int) bool {
+		ai, aj := absInt64(matches[i].Amount-amount), absInt64(matches[j].Amount-amount)
+		if ai != aj {
+			return ai < aj
+		}
+		di, dj := matches[i].TransactionDate.Sub(e.Date).Abs(), matches[j].TransactionDate.Sub(e.Date).Abs()
+		if di != dj {
+			return di < dj
+		}
+		return matches[i].ID < matches[j].ID
+	})
+	return matches[0]
+}
+
+// StatementExternalIDs derives a stable identifier for each entry, used to skip rows
+// that were already staged by a previous import of an overlapping statement. The
+// bank reference is used when present; otherwise the identifier is a fingerprint of
+// the date, amount and description, suffixed with the occurrence number so identical
+// rows within the same file stay distinct.
+func StatementExternalIDs(entries []*StatementEntry) []string {
+	ids := make([]string, len(entries))
+	seen := make(map[string]int)
+	for i, e := range entries {
+		if e.Reference != "" {
+			ids[i] = "ref:" + e.Reference
+			continue
+		}
+		sum := hash.SHA256String(fmt.Sprintf("%s|%d|%s", e.Date.Format("2006-01-02"), e.Amount, strings.ToLower(e.Description)))
+		fp := "fp:" + hex.EncodeToString(sum[:12])
+		seen[fp]++
+		ids[i] = fmt.Sprintf("%s#%d", fp, seen[fp])
+	}
+	return ids
+}
+
+func absInt64(v int64) int64 {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
+// StatementMapping describes how the columns of an account's CSV exports map onto
+// statement entries. Columns are referenced by header name when the file has a
+// header row, or by zero-based index otherwise.
+type StatementMapping struct {
+	AccountID         AccountID
+	SpaceID           SpaceID
+	Delimiter         string // Defaults to ","
+	HasHeader         bool
+	DateColumn        string
+	DateFormat        string // Go reference layout, defaults to "2006-01-02"
+	AmountColumn      string // Signed amount column; alternative to Debit/Credit columns
+	DebitColumn       string
+	CreditColumn      string
+	DescriptionColumn string
+	ReferenceColumn   string
+	CurrencyColumn    string
+	DecimalSeparator  string // "." or ",", defaults to "."
+	InvertAmounts     bool   // Set for exports that list purchases as positive amounts
+	UpdateTime        time.Time
+}
+
+// Validate checks the mapping and fills in defaults.
+func (m *StatementMapping) Validate() error {
+	if err := m.AccountID.Validate(); err != nil {
+		return fmt.Errorf("validate account ID: %w", err)
+	}
+	if err := m.SpaceID.Validate(); err != nil {
+		return fmt.Errorf("validate space ID: %w", err)
+	}
+
+	if m.Delimiter == "" {
+		m.Delimiter = ","
+	}
+	if len([]rune(m.Delimiter)) != 1 {
+		return errors.New("delimiter must be a single character")
+	}
+	if m.DateFormat == "" {
+		m.DateFormat = "2006-01-02"
+	}
+	if m.DecimalSeparator == "" {
+		m.DecimalSeparator = "."
+	}
+	if m.DecimalSeparator != "." && m.DecimalSeparator != "," {
+		return errors.New("decimal separator must be '.' or ','")
+	}
+
+	m.DateColumn = strings.TrimSpace(m.DateColumn)
+	m.AmountColumn = strings.TrimSpace(m.AmountColumn)
+	m.DebitColumn = strings.TrimSpace(m.DebitColumn)
+	m.CreditColumn = strings.TrimSpace(m.CreditColumn)
+	m.DescriptionColumn = strings.TrimSpace(m.DescriptionColumn)
+	m.ReferenceColumn = strings.TrimSpace(m.ReferenceColumn)
+	m.CurrencyColumn = strings.TrimSpace(m.CurrencyColumn)
+
+	if m.DateColumn == "" {
+		return errors.New("date column is required")
+	}
+	if m.AmountColumn == "" && m.DebitColumn == "" && m.CreditColumn == "" {
+		return errors.New("either an amount column or debit/credit columns are required")
+	}
+	if m.AmountColumn != "" && (m.DebitColumn != "" || m.CreditColumn != "") {
+		return errors.New("amount column cannot be combined with debit/credit columns")
+	}
+	if m.DescriptionColumn == "" {
+		return errors.New("description column is required")
+	}
+	return nil
+}
+
+// ParseStatement parses a statement file into normalized entries. CSV files
+// require a column mapping; the other formats are self-describing. Amounts are
+// scaled to the minor units of each entry's currency, falling back to the
+// account currency when the file does not name one.
+func ParseStatement(format StatementFormat, data []byte, mapping *StatementMapping, currency Currency) ([]*StatementEntry, error) {
+	if err := format.Validate(); err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, fmt.Errorf("%w: file is empty", ErrInvalidStatement)
+	}
+	if len(data) > MaxStatementSize {
+		return nil, fmt.Errorf("%w: file exceeds %d bytes", ErrInvalidStatement, MaxStatementSize)
+	}
+
+	switch format {
+	case StatementFormatCSV:
+		if mapping == nil {
+			return nil, ErrStatementMappingNotFound
+		}
+		return parseCSVStatement(data, mapping, currency)
+	case StatementFormatOFX, StatementFormatQFX:
+		return parseOFXStatement(data, currency)
+	case StatementFormatCAMT053:
+		return parseCAMT053Statement(data, currency)
+	}
+	return nil, fmt.Errorf("unsupported statement format: %q", format)
+}
+
+// parseStatementAmount converts a decimal amount string into signed minor units
+// using the currency exponent; extra decimals are rounded half up.
+// It accepts currency symbols, thousands separators, a leading or trailing minus
+// sign and accounting-style parentheses for negatives.
+func parseStatementAmount(raw string, decimalSep string, minorUnits int32) (int64, error) {
+	s := strings.TrimSpace(raw)
+	if s == "" {
+		return 0, errors.New("empty amount")
+	}
+
+	negative := false
+	if strings.HasPrefix(s, "(") && strings.HasSuffix(s, ")") {
+		negative = true
+		s = s[1 : len(s)-1]
+	}
+
+	thousandsSep := ","
+	if decimalSep == "," {
+		thousandsSep = "."
+	}
+
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r >= '0' && r <= '9':
+			b.WriteRune(r)
+		case string(r) == decimalSep:
+			b.WriteRune('.')
+		case r == '-':
+			negative = !negative
+		case r == '+', string(r) == thousandsSep, r == ' ', r == '\u00a0', r == '\'':
+		default:
+			// Skip currency symbols and codes such as "$" or "EUR".
+			if r == '$' || r >= 'A' && r <= 'Z' || r >= 'a' && r <= 'z' || r > 127 {
+				continue
+			}
+			return 0, fmt.Errorf("invalid amount %q", raw)
+		}
+	}
+
+	whole, frac, _ := strings.Cut(b.String(), ".")
+	if whole == "" && frac == "" {
+		return 0, fmt.Errorf("invalid amount %q", raw)
+	}
+	if whole == "" {
+		whole = "0"
+	}
+
+	digits := int(minorUnits)
+	roundUp := false
+	if len(frac) > digits {
+		roundUp = frac[digits] >= '5'
+		frac = frac[:digits]
+	}
+	for len(frac) < digits {
+		frac += "0"
+	}
+	scale := int64(1)
+	for range digits {
+		scale *= 10
+	}
+
+	units, err := strconv.ParseInt(whole, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid amount %q: %w", raw, err)
+	}
+	var minor int64
+	if frac != "" {
+		minor, err = strconv.ParseInt(frac, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid amount %q: %w", raw, err)
+		}
+	}
+
+	amount := units*scale + minor
+	if roundUp {
+		amount++
+	}
+	if negative {
+		amount = -amount
+	}
+	return amount, nil
+}
+
+// parseStatementCurrency validates an optional currency code found in a statement.
+func parseStatementCurrency(raw string) (Currency, error) {
+	if strings.TrimSpace(raw) == "" {
+		return "", nil
+	}
+	return ParseCurrency(raw)
+}
+
+// amountCurrency returns the currency whose exponent scales an entry's amount.
+func amountCurrency(entry, fallback Currency) Currency {
+	if entry != "" {
+		return entry
+	}
+	return fallback
+}
@@ -0,0 +1,189 @@
+package finance
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// camtDocument models the subset of an ISO 20022 camt.053 (BankToCustomerStatement)
+// document needed to stage entries. Element names are matched without namespaces so
+// any camt.053.001.xx version is accepted.
+type camtDocument struct {
+	XMLName    xml.Name        `xml:"Document"`
+	Statements []camtStatement `xml:"BkToCstmrStmt>Stmt"`
+}
+
+type camtStatement struct {
+	ID      string      `xml:"Id"`
+	Entries []camtEntry `xml:"Ntry"`
+}
+
+type camtEntry struct {
+	Amount      camtAmount       `xml:"Amt"`
+	CreditDebit string           `xml:"CdtDbtInd"`
+	Status      camtStatus       `xml:"Sts"`
+	BookingDate camtDate         `xml:"BookgDt"`
+	ValueDate   camtDate         `xml:"ValDt"`
+	EntryRef    string           `xml:"NtryRef"`
+	ServicerRef string           `xml:"AcctSvcrRef"`
+	AddtlInfo   string           `xml:"AddtlNtryInf"`
+	Details     []camtTxnDetails `xml:"NtryDtls>TxDtls"`
+	InnerXML    string           `xml:",innerxml"`
+}
+
+// camtStatus holds the entry status, a plain code in camt.053.001.02 and a
+// <Cd> child element in later versions.
+type camtStatus struct {
+	Value string `xml:",chardata"`
+	Code  string `xml:"Cd"`
+}
+
+type camtAmount struct {
+	Value    string `xml:",chardata"`
+	Currency string `xml:"Ccy,attr"`
+}
+
+type camtDate struct {
+	Date     string `xml:"Dt"`
+	DateTime string `xml:"DtTm"`
+}
+
+type camtTxnDetails struct {
+	EndToEndID   string   `xml:"Refs>EndToEndId"`
+	Unstructured []string `xml:"RmtInf>Ustrd"`
+	CreditorName string   `xml:"RltdPties>Cdtr>Nm"`
+	CreditorPty  string   `xml:"RltdPties>Cdtr>Pty>Nm"`
+	DebtorName   string   `xml:"RltdPties>Dbtr>Nm"`
+	DebtorPty    string   `xml:"RltdPties>Dbtr>Pty>Nm"`
+}
+
+// parseCAMT053Statement parses an ISO 20022 camt.053 bank-to-customer statement.
+// Pending entries are skipped since they are not yet booked.
+func parseCAMT053Statement(data []byte, defaultCurrency Currency) ([]*StatementEntry, error) {
+	var doc camtDocument
+	if err := xml.NewDecoder(bytes.NewReader(data)).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidStatement, err)
+	}
+	if len(doc.Statements) == 0 {
+		return nil, fmt.Errorf("%w: no statements found", ErrInvalidStatement)
+	}
+
+	var entries []*StatementEntry
+	for _, stmt := range doc.Statements {
+		for i, ntry := range stmt.Entries {
+			status := strings.ToUpper(strings.TrimSpace(ntry.Status.Code))
+			if status == "" {
+				status = strings.ToUpper(strings.TrimSpace(ntry.Status.Value))
+			}
+			if status == "PDNG" {
+				continue
+			}
+
+			date, err := ntry.BookingDate.parse()
+			if err != nil {
+				date, err = ntry.ValueDate.parse()
+			}
+			if err != nil {
+				return nil, fmt.Errorf("%w: statement %q entry %d: missing booking date", ErrInvalidStatement, stmt.ID, i)
+			}
+
+			currency, err := parseStatementCurrency(ntry.Amount.Currency)
+			if err != nil {
+				return nil, fmt.Errorf("%w: statement %q entry %d: %v", ErrInvalidStatement, stmt.ID, i, err)
+			}
+
+			amount, err := parseStatementAmount(ntry.Amount.Value, ".", amountCurrency(currency, defaultCurrency).MinorUnits())
+			if err != nil {
+				return nil, fmt.Errorf("%w: statement %q entry %d: %v", ErrInvalidStatement, stmt.ID, i, err)
+			}
+			amount = absInt64(amount)
+			switch strings.ToUpper(strings.TrimSpace(ntry.CreditDebit)) {
+			case "DBIT":
+				amount = -amount
+			case "CRDT":
+			default:
+				return nil, fmt.Errorf("%w: statement %q entry %d: invalid credit/debit indicator %q", ErrInvalidStatement, stmt.ID, i, ntry.CreditDebit)
+			}
+			if amount == 0 {
+				continue
+			}
+
+			entries = append(entries, &StatementEntry{
+				Date:        date,
+				Amount:      amount,
+				Currency:    currency,
+				Description: ntry.description(amount < 0),
+				Reference:   ntry.reference(),
+				Raw:         "<Ntry>" + strings.TrimSpace(ntry.InnerXML) + "</Ntry>",
+			})
+		}
+	}
+
+	return entries, nil
+}
+
+func (d camtDate) parse() (time.Time, error) {
+	if d.Date != "" {
+		t, err := time.Parse("2006-01-02", strings.TrimSpace(d.Date))
+		if err != nil {
+			return time.Time{}, err
+		}
+		return t.UTC(), nil
+	}
+	if d.DateTime != "" {
+		raw := strings.TrimSpace(d.DateTime)
+		if t, err := time.Parse(time.RFC3339, raw); err == nil {
+			return t.UTC(), nil
+		}
+		t, err := time.Parse("2006-01-02T15:04:05", raw)
+		if err != nil {
+			return time.Time{}, err
+		}
+		return t.UTC(), nil
+	}
+	return time.Time{}, fmt.Errorf("empty date")
+}
+
+// description prefers the counterparty name, then remittance information, then the
+// free-text entry information.
+func (e camtEntry) description(debit bool) string {
+	for _, d := range e.Details {
+		name := d.DebtorName
+		if name == "" {
+			name = d.DebtorPty
+		}
+		if debit {
+			name = d.CreditorName
+			if name == "" {
+				name = d.CreditorPty
+			}
+		}
+		if name = strings.TrimSpace(name); name != "" {
+			return name
+		}
+	}
+	for _, d := range e.Details {
+		if info := strings.TrimSpace(strings.Join(d.Unstructured, " ")); info != "" {
+			return info
+		}
+	}
+	return strings.TrimSpace(e.AddtlInfo)
+}
+
+func (e camtEntry) reference() string {
+	if ref := strings.TrimSpace(e.ServicerRef); ref != "" {
+		return ref
+	}
+	if ref := strings.TrimSpace(e.EntryRef); ref != "" {
+		return ref
+	}
+	for _, d := range e.Details {
+		if ref := strings.TrimSpace(d.EndToEndID); ref != "" && ref != "NOTPROVIDED" {
+			return ref
+		}
+	}
+	return ""
+}
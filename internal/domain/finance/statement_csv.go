@@ -0,0 +1,170 @@
+package finance
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// parseCSVStatement parses a delimited bank export using the account's column mapping.
+func parseCSVStatement(data []byte, mapping *StatementMapping, defaultCurrency Currency) ([]*StatementEntry, error) {
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf")) // UTF-8 BOM
+
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.Comma = []rune(mapping.Delimiter)[0]
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	var header []string
+	if mapping.HasHeader {
+		row, err := reader.Read()
+		if err != nil {
+			return nil, fmt.Errorf("%w: read header: %v", ErrInvalidStatement, err)
+		}
+		header = row
+	}
+
+	cols := csvColumns{header: header}
+	dateIdx, err := cols.index(mapping.DateColumn)
+	if err != nil {
+		return nil, err
+	}
+	descIdx, err := cols.index(mapping.DescriptionColumn)
+	if err != nil {
+		return nil, err
+	}
+	amountIdx, err := cols.optionalIndex(mapping.AmountColumn)
+	if err != nil {
+		return nil, err
+	}
+	debitIdx, err := cols.optionalIndex(mapping.DebitColumn)
+	if err != nil {
+		return nil, err
+	}
+	creditIdx, err := cols.optionalIndex(mapping.CreditColumn)
+	if err != nil {
+		return nil, err
+	}
+	refIdx, err := cols.optionalIndex(mapping.ReferenceColumn)
+	if err != nil {
+		return nil, err
+	}
+	currencyIdx, err := cols.optionalIndex(mapping.CurrencyColumn)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []*StatementEntry
+	for {
+		row, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidStatement, err)
+		}
+		if isBlankCSVRow(row) {
+			continue
+		}
+
+		line, _ := reader.FieldPos(0)
+		field := func(idx int) string {
+			if idx < 0 || idx >= len(row) {
+				return ""
+			}
+			return strings.TrimSpace(row[idx])
+		}
+
+		date, err := time.Parse(mapping.DateFormat, field(dateIdx))
+		if err != nil {
+			return nil, fmt.Errorf("%w: line %d: invalid date %q", ErrInvalidStatement, line, field(dateIdx))
+		}
+
+		currency, err := parseStatementCurrency(field(currencyIdx))
+		if err != nil {
+			return nil, fmt.Errorf("%w: line %d: %v", ErrInvalidStatement, line, err)
+		}
+		minorUnits := amountCurrency(currency, defaultCurrency).MinorUnits()
+
+		var amount int64
+		if amountIdx >= 0 {
+			amount, err = parseStatementAmount(field(amountIdx), mapping.DecimalSeparator, minorUnits)
+			if err != nil {
+				return nil, fmt.Errorf("%w: line %d: %v", ErrInvalidStatement, line, err)
+			}
+		} else {
+			debit, err := parseOptionalStatementAmount(field(debitIdx), mapping.DecimalSeparator, minorUnits)
+			if err != nil {
+				return nil, fmt.Errorf("%w: line %d: %v", ErrInvalidStatement, line, err)
+			}
+			credit, err := parseOptionalStatementAmount(field(creditIdx), mapping.DecimalSeparator, minorUnits)
+			if err != nil {
+				return nil, fmt.Errorf("%w: line %d: %v", ErrInvalidStatement, line, err)
+			}
+			amount = absInt64(credit) - absInt64(debit)
+		}
+		if mapping.InvertAmounts {
+			amount = -amount
+		}
+		if amount == 0 {
+			continue
+		}
+
+		entries = append(entries, &StatementEntry{
+			Date:        date.UTC(),
+			Amount:      amount,
+			Currency:    currency,
+			Description: field(descIdx),
+			Reference:   field(refIdx),
+			Raw:         strings.Join(row, mapping.Delimiter),
+		})
+	}
+
+	return entries, nil
+}
+
+// csvColumns resolves mapping column references against an optional header row.
+type csvColumns struct {
+	header []string
+}
+
+func (c csvColumns) index(ref string) (int, error) {
+	for i, name := range c.header {
+		if strings.EqualFold(strings.TrimSpace(name), ref) {
+			return i, nil
+		}
+	}
+	idx, err := strconv.Atoi(ref)
+	if err != nil || idx < 0 {
+		return -1, fmt.Errorf("%w: column %q not found", ErrInvalidStatement, ref)
+	}
+	return idx, nil
+}
+
+func (c csvColumns) optionalIndex(ref string) (int, error) {
+	if ref == "" {
+		return -1, nil
+	}
+	return c.index(ref)
+}
+
+func parseOptionalStatementAmount(raw string, decimalSep string, minorUnits int32) (int64, error) {
+	if strings.TrimSpace(raw) == "" {
+		return 0, nil
+	}
+	return parseStatementAmount(raw, decimalSep, minorUnits)
+}
+
+func isBlankCSVRow(row []string) bool {
+	for _, v := range row {
+		if strings.TrimSpace(v) != "" {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,145 @@
+package finance
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+var (
+	ofxTxnStart = []byte("<STMTTRN>")
+	ofxTxnEnd   = []byte("</STMTTRN>")
+	ofxCurDef   = []byte("<CURDEF>")
+)
+
+// parseOFXStatement parses OFX and QFX files. Both the SGML (1.x) and XML (2.x)
+// dialects are supported by reading leaf elements up to the next tag or line break,
+// which does not depend on closing tags being present.
+func parseOFXStatement(data []byte, defaultCurrency Currency) ([]*StatementEntry, error) {
+	upper := bytes.ToUpper(data)
+	if !bytes.Contains(upper, []byte("<OFX>")) {
+		return nil, fmt.Errorf("%w: missing <OFX> root element", ErrInvalidStatement)
+	}
+
+	var entries []*StatementEntry
+	offset := 0
+	for {
+		start := bytes.Index(upper[offset:], ofxTxnStart)
+		if start < 0 {
+			break
+		}
+		start += offset
+
+		end := bytes.Index(upper[start:], ofxTxnEnd)
+		blockEnd := start + end
+		if end < 0 {
+			// SGML files may omit the closing aggregate tag; stop at the next transaction.
+			next := bytes.Index(upper[start+len(ofxTxnStart):], ofxTxnStart)
+			if next < 0 {
+				blockEnd = len(data)
+			} else {
+				blockEnd = start + len(ofxTxnStart) + next
+			}
+		}
+		block := string(data[start:blockEnd])
+		offset = blockEnd
+
+		// The statement currency precedes its transaction list.
+		var currency Currency
+		if curIdx := bytes.LastIndex(upper[:start], ofxCurDef); curIdx >= 0 {
+			parsed, err := parseStatementCurrency(ofxElementAt(string(data[curIdx:])))
+			if err != nil {
+				return nil, fmt.Errorf("%w: %v", ErrInvalidStatement, err)
+			}
+			currency = parsed
+		}
+
+		entry, err := parseOFXTransaction(block, amountCurrency(currency, defaultCurrency).MinorUnits())
+		if err != nil {
+			return nil, err
+		}
+		entry.Currency = currency
+
+		if entry.Amount != 0 {
+			entries = append(entries, entry)
+		}
+	}
+
+	return entries, nil
+}
+
+func parseOFXTransaction(block string, minorUnits int32) (*StatementEntry, error) {
+	fitID := ofxElement(block, "FITID")
+
+	posted := ofxElement(block, "DTPOSTED")
+	if len(posted) < 8 {
+		return nil, fmt.Errorf("%w: transaction %q: invalid DTPOSTED %q", ErrInvalidStatement, fitID, posted)
+	}
+	date, err := time.Parse("20060102", posted[:8])
+	if err != nil {
+		return nil, fmt.Errorf("%w: transaction %q: invalid DTPOSTED %q", ErrInvalidStatement, fitID, posted)
+	}
+
+	rawAmount := ofxElement(block, "TRNAMT")
+	decimalSep := "."
+	if strings.Contains(rawAmount, ",") && !strings.Contains(rawAmount, ".") {
+		decimalSep = ","
+	}
+	amount, err := parseStatementAmount(rawAmount, decimalSep, minorUnits)
+	if err != nil {
+		return nil, fmt.Errorf("%w: transaction %q: %v", ErrInvalidStatement, fitID, err)
+	}
+
+	description := ofxElement(block, "NAME")
+	if description == "" {
+		description = ofxElement(block, "MEMO")
+	}
+
+	return &StatementEntry{
+		Date:        date.UTC(),
+		Amount:      amount,
+		Description: description,
+		Reference:   fitID,
+		Raw:         strings.TrimSpace(block),
+	}, nil
+}
+
+// ofxElementPatterns holds the leaf elements read from each <STMTTRN> aggregate.
+var ofxElementPatterns = map[string]*regexp.Regexp{
+	"DTPOSTED": ofxElementPattern("DTPOSTED"),
+	"TRNAMT":   ofxElementPattern("TRNAMT"),
+	"FITID":    ofxElementPattern("FITID"),
+	"NAME":     ofxElementPattern("NAME"),
+	"MEMO":     ofxElementPattern("MEMO"),
+}
+
+func ofxElementPattern(tag string) *regexp.Regexp {
+	return regexp.MustCompile(`(?i)<` + tag + `>([^<\r\n]*)`)
+}
+
+// ofxElement returns the trimmed text of the first leaf element with the given tag.
+func ofxElement(block, tag string) string {
+	re, ok := ofxElementPatterns[tag]
+	if !ok {
+		return ""
+	}
+	m := re.FindStringSubmatch(block)
+	if m == nil {
+		return ""
+	}
+	return strings.TrimSpace(m[1])
+}
+
+// ofxElementAt returns the text of the leaf element starting at the beginning of s.
+func ofxElementAt(s string) string {
+	_, rest, ok := strings.Cut(s, ">")
+	if !ok {
+		return ""
+	}
+	if idx := strings.IndexAny(rest, "<\r\n"); idx >= 0 {
+		rest = rest[:idx]
+	}
+	return strings.TrimSpace(rest)
+}
@@ -0,0 +1,291 @@
+package finance
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseStatementAmount(t *testing.T) {
+	tests := []struct {
+		raw        string
+		decimalSep string
+		minorUnits int32
+		want       int64
+		wantErr    bool
+	}{
+		{raw: "12.34", decimalSep: ".", minorUnits: 2, want: 1234},
+		{raw: "-1,234.5", decimalSep: ".", minorUnits: 2, want: -123450},
+		{raw: "$ 1,000", decimalSep: ".", minorUnits: 2, want: 100000},
+		{raw: "(45.20)", decimalSep: ".", minorUnits: 2, want: -4520},
+		{raw: "19.99-", decimalSep: ".", minorUnits: 2, want: -1999},
+		{raw: "1.234,56", decimalSep: ",", minorUnits: 2, want: 123456},
+		{raw: "EUR -7,5", decimalSep: ",", minorUnits: 2, want: -750},
+		{raw: "0.125", decimalSep: ".", minorUnits: 2, want: 13},
+		{raw: "1,234", decimalSep: ".", minorUnits: 0, want: 1234},
+		{raw: "99.5", decimalSep: ".", minorUnits: 0, want: 100},
+		{raw: "12.3456", decimalSep: ".", minorUnits: 3, want: 12346},
+		{raw: "-0.5", decimalSep: ".", minorUnits: 3, want: -500},
+		{raw: "", decimalSep: ".", minorUnits: 2, wantErr: true},
+		{raw: "12#3", decimalSep: ".", minorUnits: 2, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.raw, func(t *testing.T) {
+			got, err := parseStatementAmount(tt.raw, tt.decimalSep, tt.minorUnits)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("expected error for %q, got %d", tt.raw, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("parseStatementAmount(%q) = %d, want %d", tt.raw, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseStatement_CSV(t *testing.T) {
+	mapping := &StatementMapping{
+		AccountID:         "acc_2Yg7Ij9Z3j2WnHFVdE6o7vKkXbc",
+		SpaceID:           "spc_2Yg7Ij9Z3j2WnHFVdE6o7vKkXbc",
+		HasHeader:         true,
+		DateColumn:        "Posting Date",
+		DateFormat:        "01/02/2006",
+		DebitColumn:       "Debit",
+		CreditColumn:      "Credit",
+		DescriptionColumn: "Description",
+		ReferenceColumn:   "Ref",
+	}
+	if err := mapping.Validate(); err != nil {
+		t.Fatalf("mapping validation failed: %v", err)
+	}
+
+	data := []byte("\xef\xbb\xbfPosting Date,Description,Debit,Credit,Ref\n" +
+		"03/01/2026,Corner Grocery,\"1,045.20\",,R1\n" +
+		"03/02/2026,Payroll,,2500.00,R2\n" +
+		",,,,\n" +
+		"03/03/2026,Zero row,0.00,,R3\n")
+
+	entries, err := ParseStatement(StatementFormatCSV, data, mapping, "USD")
+	if err != nil {
+		t.Fatalf("ParseStatement failed: %v", err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("expected 2 entries, got %d", len(entries))
+	}
+
+	if entries[0].Amount != -104520 || entries[0].Description != "Corner Grocery" || entries[0].Reference != "R1" {
+		t.Errorf("unexpected debit entry: %+v", entries[0])
+	}
+	if !entries[0].Date.Equal(time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected debit date: %v", entries[0].Date)
+	}
+	if entries[1].Amount != 250000 || entries[1].TransactionType() != TransactionTypeIncome {
+		t.Errorf("unexpected credit entry: %+v", entries[1])
+	}
+
+	if _, err := ParseStatement(StatementFormatCSV, data, nil, "USD"); !errors.Is(err, ErrStatementMappingNotFound) {
+		t.Errorf("expected ErrStatementMappingNotFound without mapping, got %v", err)
+	}
+
+	badMapping := *mapping
+	badMapping.DateColumn = "Booked"
+	if _, err := ParseStatement(StatementFormatCSV, data, &badMapping, "USD"); !errors.Is(err, ErrInvalidStatement) {
+		t.Errorf("expected ErrInvalidStatement for unknown column, got %v", err)
+	}
+}
+
+func TestParseStatement_OFX(t *testing.T) {
+	sgml := []byte(`OFXHEADER:100
+DATA:OFXSGML
+VERSION:102
+
+<OFX>
+<BANKMSGSRSV1><STMTTRNRS><STMTRS>
+<CURDEF>USD
+<BANKTRANLIST>
+<STMTTRN>
+<TRNTYPE>DEBIT
+<DTPOSTED>20260305120000[-5:EST]
+<TRNAMT>-45.20
+<FITID>202603050001
+<NAME>COFFEE HOUSE
+</STMTTRN>
+<STMTTRN>
+<TRNTYPE>CREDIT
+<DTPOSTED>20260306
+<TRNAMT>1500.00
+<FITID>202603060001
+<MEMO>Salary March
+</STMTTRN>
+</BANKTRANLIST>
+</STMTRS></STMTTRNRS></BANKMSGSRSV1>
+</OFX>`)
+
+	entries, err := ParseStatement(StatementFormatOFX, sgml, nil, "USD")
+	if err != nil {
+		t.Fatalf("ParseStatement failed: %v", err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("expected 2 entries, got %d", len(entries))
+	}
+	if entries[0].Amount != -4520 || entries[0].Reference != "202603050001" || entries[0].Description != "COFFEE HOUSE" || entries[0].Currency != "USD" {
+		t.Errorf("unexpected first entry: %+v", entries[0])
+	}
+	if entries[1].Description != "Salary March" || entries[1].Amount != 150000 {
+		t.Errorf("unexpected second entry: %+v", entries[1])
+	}
+
+	xmlDialect := []byte(`<?xml version="1.0"?><?OFX OFXHEADER="200" VERSION="220"?>
+<OFX><CREDITCARDMSGSRSV1><CCSTMTTRNRS><CCSTMTRS><CURDEF>EUR</CURDEF><BANKTRANLIST>
+<STMTTRN><TRNTYPE>DEBIT</TRNTYPE><DTPOSTED>20260310</DTPOSTED><TRNAMT>-12.00</TRNAMT><FITID>X1</FITID><NAME>Bakery</NAME></STMTTRN>
+</BANKTRANLIST></CCSTMTRS></CCSTMTTRNRS></CREDITCARDMSGSRSV1></OFX>`)
+
+	entries, err = ParseStatement(StatementFormatQFX, xmlDialect, nil, "USD")
+	if err != nil {
+		t.Fatalf("ParseStatement (XML) failed: %v", err)
+	}
+	if len(entries) != 1 || entries[0].Amount != -1200 || entries[0].Currency != "EUR" || entries[0].Description != "Bakery" {
+		t.Errorf("unexpected XML dialect entries: %+v", entries)
+	}
+
+	if _, err := ParseStatement(StatementFormatOFX, []byte("not a statement"), nil, "USD"); !errors.Is(err, ErrInvalidStatement) {
+		t.Errorf("expected ErrInvalidStatement, got %v", err)
+	}
+}
+
+func TestParseStatement_CAMT053(t *testing.T) {
+	data := []byte(`<?xml version="1.0" encoding="UTF-8"?>
+<Document xmlns="urn:iso:std:iso:20022:tech:xsd:camt.053.001.08">
+  <BkToCstmrStmt>
+    <Stmt>
+      <Id>STMT-2026-03</Id>
+      <Ntry>
+        <Amt Ccy="EUR">82.15</Amt>
+        <CdtDbtInd>DBIT</CdtDbtInd>
+        <Sts><Cd>BOOK</Cd></Sts>
+        <BookgDt><Dt>2026-03-04</Dt></BookgDt>
+        <AcctSvcrRef>BANKREF-1</AcctSvcrRef>
+        <NtryDtls><TxDtls>
+          <RltdPties><Cdtr><Pty><Nm>Energy Provider</Nm></Pty></Cdtr></RltdPties>
+          <RmtInf><Ustrd>Invoice 42</Ustrd></RmtInf>
+        </TxDtls></NtryDtls>
+      </Ntry>
+      <Ntry>
+        <Amt Ccy="EUR">10.00</Amt>
+        <CdtDbtInd>CRDT</CdtDbtInd>
+        <Sts><Cd>PDNG</Cd></Sts>
+        <BookgDt><Dt>2026-03-05</Dt></BookgDt>
+      </Ntry>
+      <Ntry>
+        <Amt Ccy="EUR">300.00</Amt>
+        <CdtDbtInd>CRDT</CdtDbtInd>
+        <Sts>BOOK</Sts>
+        <ValDt><DtTm>2026-03-06T09:30:00</DtTm></ValDt>
+        <NtryDtls><TxDtls>
+          <Refs><EndToEndId>E2E-9</EndToEndId></Refs>
+          <RltdPties><Dbtr><Nm>Jane Tenant</Nm></Dbtr></RltdPties>
+        </TxDtls></NtryDtls>
+      </Ntry>
+    </Stmt>
+  </BkToCstmrStmt>
+</Document>`)
+
+	entries, err := ParseStatement(StatementFormatCAMT053, data, nil, "USD")
+	if err != nil {
+		t.Fatalf("ParseStatement failed: %v", err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("expected 2 booked entries, got %d", len(entries))
+	}
+	if entries[0].Amount != -8215 || entries[0].Currency != "EUR" || entries[0].Description != "Energy Provider" || entries[0].Reference != "BANKREF-1" {
+		t.Errorf("unexpected debit entry: %+v", entries[0])
+	}
+	if entries[1].Amount != 30000 || entries[1].Description != "Jane Tenant" || entries[1].Reference != "E2E-9" {
+		t.Errorf("unexpected credit entry: %+v", entries[1])
+	}
+	if !entries[1].Date.Equal(time.Date(2026, 3, 6, 9, 30, 0, 0, time.UTC)) {
+		t.Errorf("unexpected credit date: %v", entries[1].Date)
+	}
+}
+
+func TestStatementEntry_MatchDuplicate(t *testing.T) {
+	accID := AccountID("acc_checking")
+	otherAccID := AccountID("acc_savings")
+	date := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+
+	entry := &StatementEntry{Date: date, Amount: -5000}
+	candidates := []*Transaction{
+		{ID: "txn_c", Type: TransactionTypeExpense, Amount: 5000, AccountID: &accID, TransactionDate: date.AddDate(0, 0, 3)},
+		{ID: "txn_b", Type: TransactionTypeExpense, Amount: 5000, AccountID: &accID, TransactionDate: date.AddDate(0, 0, -1)},
+		{ID: "txn_a", Type: TransactionTypeExpense, Amount: 5100, AccountID: &accID, TransactionDate: date},
+		{ID: "txn_i", Type: TransactionTypeIncome, Amount: 5000, AccountID: &accID, TransactionDate: date},
+		{ID: "txn_o", Type: TransactionTypeExpense, Amount: 5000, AccountID: &otherAccID, TransactionDate: date},
+	}
+
+	match := entry.MatchDuplicate(accID, candidates)
+	if match == nil || match.ID != "txn_b" {
+		t.Fatalf("expected closest candidate txn_b, got %+v", match)
+	}
+
+	credit := &StatementEntry{Date: date, Amount: 5000}
+	if match := credit.MatchDuplicate(otherAccID, candidates); match != nil {
+		t.Errorf("expected no income candidate on other account, got %s", match.ID)
+	}
+}
+
+func TestStatementExternalIDs(t *testing.T) {
+	date := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+	entries := []*StatementEntry{
+		{Date: date, Amount: -450, Description: "Coffee"},
+		{Date: date, Amount: -450, Description: "COFFEE"},
+		{Date: date, Amount: -450, Description: "Coffee", Reference: "FIT-9"},
+		{Date: date, Amount: -500, Description: "Coffee"},
+	}
+
+	ids := StatementExternalIDs(entries)
+	if ids[0] == ids[1] {
+		t.Errorf("expected identical rows to get distinct IDs, got %s", ids[0])
+	}
+	if ids[2] != "ref:FIT-9" {
+		t.Errorf("expected reference-based ID, got %s", ids[2])
+	}
+	if again := StatementExternalIDs(entries); again[1] != ids[1] || again[3] != ids[3] {
+		t.Errorf("expected external IDs to be stable across runs")
+	}
+}
+
+func TestParseStatement_CSVZeroDecimalCurrency(t *testing.T) {
+	mapping := &StatementMapping{
+		AccountID:         "acc_2Yg7Ij9Z3j2WnHFVdE6o7vKkXbc",
+		SpaceID:           "spc_2Yg7Ij9Z3j2WnHFVdE6o7vKkXbc",
+		Delimiter:         ",",
+		HasHeader:         true,
+		DateColumn:        "Date",
+		DateFormat:        "2006-01-02",
+		AmountColumn:      "Amount",
+		DescriptionColumn: "Description",
+		CurrencyColumn:    "Currency",
+		DecimalSeparator:  ".",
+	}
+	data := []byte("Date,Description,Amount,Currency\n2026-03-01,Ramen,-1200,\n2026-03-02,Fuel,-12.500,KWD\n")
+
+	entries, err := ParseStatement(StatementFormatCSV, data, mapping, "JPY")
+	if err != nil {
+		t.Fatalf("ParseStatement failed: %v", err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("expected 2 entries, got %d", len(entries))
+	}
+	if entries[0].Amount != -1200 {
+		t.Errorf("expected JPY amount -1200, got %d", entries[0].Amount)
+	}
+	if entries[1].Amount != -12500 || entries[1].Currency != "KWD" {
+		t.Errorf("expected KWD amount -12500, got %d %s", entries[1].Amount, entries[1].Currency)
+	}
+}
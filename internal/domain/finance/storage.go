@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/masterkeysrd/saturn/internal/platform/filter"
 	"github.com/masterkeysrd/saturn/internal/platform/paging"
 	"github.com/masterkeysrd/saturn/internal/platform/sorting"
 )
@@ -12,6 +13,7 @@ import (
 type SettingsStore interface {
 	Create(ctx context.Context, settings *FinanceSettings) error
 	GetByID(ctx context.Context, spaceID SpaceID) (*FinanceSettings, error)
+	Update(ctx context.Context, settings *FinanceSettings) error
 }
 
 // DeleteOptions defines optional parameters for entity deletion (e.g. optimistic lock version checks).
@@ -29,18 +31,18 @@ type BudgetStore interface {
 	ListBySpace(ctx context.Context, spaceID SpaceID, filter *ListBudgetsFilter) (*paging.Page[*Budget], error)
 }
 
-type PeriodRangeKey struct {
-	BudgetID  BudgetID
-	StartDate time.Time
-	EndDate   time.Time
-}
-
 // PeriodStore defines persistence for budget periods.
 type PeriodStore interface {
 	Create(ctx context.Context, period *BudgetPeriod) error
-	GetByRange(ctx context.Context, budgetID BudgetID, startDate, endDate time.Time) (*BudgetPeriod, error)
-	GetByRanges(ctx context.Context, keys []PeriodRangeKey) ([]*BudgetPeriod, error)
+	// GetByDate returns the period of the budget that contains date, or ErrPeriodNotFound.
+	GetByDate(ctx context.Context, budgetID BudgetID, date time.Time) (*BudgetPeriod, error)
+	// GetByDates returns, for each budget that has one, the period that contains date.
+	GetByDates(ctx context.Context, budgetIDs []BudgetID, date time.Time) ([]*BudgetPeriod, error)
+	// ListByRange returns the periods of the budget that overlap [startDate, endDate], oldest first.
+	ListByRange(ctx context.Context, budgetID BudgetID, startDate, endDate time.Time) ([]*BudgetPeriod, error)
 	UpdateLimit(ctx context.Context, periodID PeriodID, limitAmount int64) error
+	// UpdateCarry records the balances rolled into and out of the period.
+	UpdateCarry(ctx context.Context, periodID PeriodID, carriedIn, carriedOut int64) error
 	ListByBudget(ctx context.Context, budgetID BudgetID) ([]*BudgetPeriod, error)
 }
 
@@ -64,6 +66,8 @@ type ExchangeRateStore interface {
 	ListBySpace(ctx context.Context, spaceID SpaceID, filter *ListExchangeRatesFilter) ([]*ExchangeRate, string, error)
 	GetLatestRates(ctx context.Context, spaceID SpaceID, fromCurrencies []Currency, toCurrency Currency) ([]*ExchangeRate, error)
 	Delete(ctx context.Context, key ExchangeRateKey) error
+	// ListCurrencyPairs returns, across all spaces, every non-base currency in use paired with the space's base currency.
+	ListCurrencyPairs(ctx context.Context) ([]*CurrencyPair, error)
 }
 
 type PeriodSpent struct {
@@ -82,6 +86,17 @@ type TransactionStore interface {
 	HasTransactions(ctx context.Context, spaceID SpaceID, filter *TransactionFilter) (bool, error)
 	AggregateSpent(ctx context.Context, periodID PeriodID, budgetCurrency Currency, exchangeRateToBase float64) (spentInBase int64, spentAmount int64, err error)
 	AggregateSpentBatch(ctx context.Context, periodIDs []PeriodID) ([]PeriodSpent, error)
+	// ListForReconciliation lists the account's transactions dated before the cutoff that belong to the
+	// given reconciliation, or that are not yet reconciled when reconciliationID is nil.
+	ListForReconciliation(ctx context.Context, spaceID SpaceID, accountID AccountID, reconciliationID *ReconciliationID, before time.Time) ([]*Transaction, error)
+	SetCleared(ctx context.Context, spaceID SpaceID, ids []TransactionID, cleared bool) error
+	// MarkReconciled locks the given transactions under a finalized reconciliation.
+	MarkReconciled(ctx context.Context, spaceID SpaceID, ids []TransactionID, reconciliationID ReconciliationID) error
+	// ListForGoal lists transactions dated on or after since that either move funds in or out of the
+	// given account or carry the given tag, oldest first.
+	ListForGoal(ctx context.Context, spaceID SpaceID, accountID *AccountID, tag string, since time.Time) ([]*Transaction, error)
+	// ApplyBatch persists the new budget periods, updates, deletions, balance deltas and events of a batch atomically.
+	ApplyBatch(ctx context.Context, batch *TransactionBatch) error
 }
 
 // TransactionEventStore defines persistence for transaction events.
@@ -95,6 +110,9 @@ type InsightsStore interface {
 	GetSpentTrend(ctx context.Context, filter *SpentTrendFilter) ([]*SpentTrend, error)
 	GetBudgetDistribution(ctx context.Context, filter *BudgetDistributionFilter) ([]*BudgetDistribution, error)
 	GetTopExpenses(ctx context.Context, filter *TopExpensesFilter) ([]*TopExpense, error)
+	GetCashFlowTrend(ctx context.Context, filter *CashFlowTrendFilter) ([]*CashFlowTrend, error)
+	GetCategoryDistribution(ctx context.Context, filter *CategoryDistributionFilter) ([]*CategoryDistribution, error)
+	GetTagDistribution(ctx context.Context, filter *TagDistributionFilter) ([]*TagDistribution, error)
 }
 
 type SpentTrendFilter struct {
@@ -110,6 +128,25 @@ type BudgetDistributionFilter struct {
 	EndDate   time.Time
 }
 
+type CashFlowTrendFilter struct {
+	SpaceID     SpaceID
+	Granularity Granularity
+	StartDate   time.Time
+	EndDate     time.Time
+}
+
+type CategoryDistributionFilter struct {
+	SpaceID   SpaceID
+	StartDate time.Time
+	EndDate   time.Time
+}
+
+type TagDistributionFilter struct {
+	SpaceID   SpaceID
+	StartDate time.Time
+	EndDate   time.Time
+}
+
 type TopExpensesFilter struct {
 	SpaceID   SpaceID
 	StartDate time.Time
@@ -155,6 +192,10 @@ type TransactionFilter struct {
 	TransferID         *TransferID
 	BorrowingID        *string
 	ScheduledPaymentID *string
+	IncomeSourceID     *IncomeSourceID
+	RecurringExpenseID *RecurringExpenseID
+	CategoryID         *CategoryID // Matches the category and all of its subcategories
+	Tags               []string    // Matches transactions carrying every listed tag
 	PageSize           int32
 	NextPageToken      string
 	Sort               sorting.SortOrder
@@ -165,6 +206,12 @@ type TransactionFilter struct {
 	StartDate   *time.Time
 	EndDate     *time.Time
 	SearchQuery *string
+
+	// AIP-160 expression over TransactionFilterFields, AND-ed with the saved filter when one is referenced.
+	Expression    string
+	SavedFilterID *SavedFilterID
+	// Where is the parsed expression compiled by the store; the service fills it from Expression and SavedFilterID.
+	Where filter.Expr
 }
 
 // RecurringExpenseStore defines persistence for recurring expense templates.
@@ -202,6 +249,8 @@ type ListRecurringExpensesFilter struct {
 type ListScheduledPaymentsFilter struct {
 	BudgetID      *BudgetID
 	Status        *ScheduledPaymentStatus
+	SourceType    *string
+	SourceID      *string
 	StartDate     *time.Time
 	EndDate       *time.Time
 	PageSize      int32
@@ -210,6 +259,43 @@ type ListScheduledPaymentsFilter struct {
 	Sort          sorting.SortOrder
 }
 
+// IncomeSourceStore defines persistence for income sources.
+type IncomeSourceStore interface {
+	Create(ctx context.Context, source *IncomeSource) error
+	GetByID(ctx context.Context, spaceID SpaceID, id IncomeSourceID) (*IncomeSource, error)
+	Update(ctx context.Context, source *IncomeSource) error
+	Delete(ctx context.Context, spaceID SpaceID, id IncomeSourceID) error
+	ListBySpace(ctx context.Context, spaceID SpaceID, filter *ListIncomeSourcesFilter) (*paging.Page[*IncomeSource], error)
+}
+
+// ListIncomeSourcesFilter encapsulates filtering parameters for income sources.
+type ListIncomeSourcesFilter struct {
+	PageSize      int32
+	NextPageToken string
+	ActiveOnly    *bool
+	SearchQuery   *string
+	Sort          sorting.SortOrder
+}
+
+// CategoryStore defines persistence for transaction categories.
+type CategoryStore interface {
+	Create(ctx context.Context, category *Category) error
+	GetByID(ctx context.Context, spaceID SpaceID, id CategoryID) (*Category, error)
+	Update(ctx context.Context, category *Category) error
+	Delete(ctx context.Context, spaceID SpaceID, id CategoryID) error
+	ListBySpace(ctx context.Context, spaceID SpaceID, filter *ListCategoriesFilter) (*paging.Page[*Category], error)
+}
+
+// ListCategoriesFilter encapsulates filtering parameters for categories.
+type ListCategoriesFilter struct {
+	PageSize      int32
+	NextPageToken string
+	ParentID      *CategoryID
+	TopLevelOnly  bool
+	SearchQuery   *string
+	Sort          sorting.SortOrder
+}
+
 // BorrowingStore defines persistence for personal borrowing/lending agreements.
 type BorrowingStore interface {
 	Create(ctx context.Context, b *Borrowing) error
@@ -238,6 +324,18 @@ type AccountStore interface {
 	HasDefault(ctx context.Context, spaceID SpaceID) (bool, error)
 	UnsetDefaultsExcept(ctx context.Context, spaceID SpaceID, id AccountID) error
 	HasAny(ctx context.Context, spaceID SpaceID) (bool, error)
+	// ListWithStatementCycle returns the active credit cards of every space that have a billing cycle configured.
+	ListWithStatementCycle(ctx context.Context) ([]*Account, error)
+}
+
+// AlertStore defines persistence for alerts raised by the alert rules engine.
+type AlertStore interface {
+	// CreateIfAbsent stores the alert unless the space already has one with the same dedup key,
+	// and reports whether it was stored.
+	CreateIfAbsent(ctx context.Context, alert *Alert) (bool, error)
+	GetByID(ctx context.Context, spaceID SpaceID, id AlertID) (*Alert, error)
+	Update(ctx context.Context, alert *Alert) error
+	ListBySpace(ctx context.Context, spaceID SpaceID, filter *ListAlertsFilter) (*paging.Page[*Alert], error)
 }
 
 // TransferStore defines persistence for parent transfer logs.
@@ -265,4 +363,79 @@ type InboxItemStore interface {
 	ListBySpace(ctx context.Context, spaceID SpaceID, filter *ListInboxItemsFilter) (*paging.Page[*InboxItem], error)
 	Update(ctx context.Context, item *InboxItem) error
 	Delete(ctx context.Context, spaceID SpaceID, id string) error
+	// ListExternalIDs returns which of the given external IDs are already staged for the account.
+	ListExternalIDs(ctx context.Context, spaceID SpaceID, accountID AccountID, externalIDs []string) (map[string]bool, error)
+}
+
+// StatementMappingStore defines persistence for per-account CSV statement column mappings.
+type StatementMappingStore interface {
+	Get(ctx context.Context, spaceID SpaceID, accountID AccountID) (*StatementMapping, error)
+	Upsert(ctx context.Context, mapping *StatementMapping) error
+}
+
+// ReconciliationStore defines persistence for account reconciliation sessions.
+type ReconciliationStore interface {
+	Create(ctx context.Context, r *Reconciliation) error
+	GetByID(ctx context.Context, spaceID SpaceID, id ReconciliationID) (*Reconciliation, error)
+	Update(ctx context.Context, r *Reconciliation) error
+	Delete(ctx context.Context, spaceID SpaceID, id ReconciliationID) error
+	// ListByAccount returns the account's reconciliation history, most recent statement first.
+	ListByAccount(ctx context.Context, spaceID SpaceID, accountID AccountID) ([]*Reconciliation, error)
+}
+
+// GoalStore defines persistence for savings goals.
+type GoalStore interface {
+	Create(ctx context.Context, goal *Goal) error
+	GetByID(ctx context.Context, spaceID SpaceID, id GoalID) (*Goal, error)
+	Update(ctx context.Context, goal *Goal) error
+	Delete(ctx context.Context, spaceID SpaceID, id GoalID) error
+	ListBySpace(ctx context.Context, spaceID SpaceID, filter *ListGoalsFilter) (*paging.Page[*Goal], error)
+}
+
+// SavedFilterStore defines persistence for named transaction filters.
+type SavedFilterStore interface {
+	Create(ctx context.Context, f *SavedFilter) error
+	GetByID(ctx context.Context, spaceID SpaceID, id SavedFilterID) (*SavedFilter, error)
+	Update(ctx context.Context, f *SavedFilter) error
+	Delete(ctx context.Context, spaceID SpaceID, id SavedFilterID) error
+	ListBySpace(ctx context.Context, spaceID SpaceID, filter *ListSavedFiltersFilter) (*paging.Page[*SavedFilter], error)
+}
+
+// PayeeStore defines persistence for payees.
+type PayeeStore interface {
+	Create(ctx context.Context, p *Payee) error
+	GetByID(ctx context.Context, spaceID SpaceID, id PayeeID) (*Payee, error)
+	Update(ctx context.Context, p *Payee) error
+	Delete(ctx context.Context, spaceID SpaceID, id PayeeID) error
+	ListBySpace(ctx context.Context, spaceID SpaceID, filter *ListPayeesFilter) (*paging.Page[*Payee], error)
+}
+
+// TransactionRuleStore defines persistence for user-defined transaction rules.
+type TransactionRuleStore interface {
+	Create(ctx context.Context, r *TransactionRule) error
+	GetByID(ctx context.Context, spaceID SpaceID, id TransactionRuleID) (*TransactionRule, error)
+	Update(ctx context.Context, r *TransactionRule) error
+	Delete(ctx context.Context, spaceID SpaceID, id TransactionRuleID) error
+	ListBySpace(ctx context.Context, spaceID SpaceID, filter *ListTransactionRulesFilter) (*paging.Page[*TransactionRule], error)
+}
+
+// LedgerExportStore defines persistence for background ledger exports and their generated files.
+type LedgerExportStore interface {
+	Create(ctx context.Context, export *LedgerExport) error
+	GetByID(ctx context.Context, spaceID SpaceID, id LedgerExportID) (*LedgerExport, error)
+	Update(ctx context.Context, export *LedgerExport) error
+	ListBySpace(ctx context.Context, spaceID SpaceID, filter *ListLedgerExportsFilter) (*paging.Page[*LedgerExport], error)
+	SaveContent(ctx context.Context, spaceID SpaceID, id LedgerExportID, content []byte) error
+	GetContent(ctx context.Context, spaceID SpaceID, id LedgerExportID) ([]byte, error)
+}
+
+// CardStatementStore defines persistence for closed credit card statements.
+type CardStatementStore interface {
+	Create(ctx context.Context, st *CardStatement) error
+	GetByID(ctx context.Context, spaceID SpaceID, id CardStatementID) (*CardStatement, error)
+	Update(ctx context.Context, st *CardStatement) error
+	// GetLatest returns the card's most recent statement, or ErrCardStatementNotFound when none was issued.
+	GetLatest(ctx context.Context, spaceID SpaceID, accountID AccountID) (*CardStatement, error)
+	// ListByAccount returns the card's statements, most recent closing date first.
+	ListByAccount(ctx context.Context, spaceID SpaceID, accountID AccountID, filter *ListCardStatementsFilter) ([]*CardStatement, error)
 }
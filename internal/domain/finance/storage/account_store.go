@@ -28,9 +28,22 @@ type accountDB struct {
 	LastFour       string       `db:"last_four"`
 	CreateTime     sql.NullTime `db:"create_time"`
 	UpdateTime     sql.NullTime `db:"update_time"`
+
+	StatementClosingDay int32          `db:"statement_closing_day"`
+	PaymentDueDay       int32          `db:"payment_due_day"`
+	MinimumPaymentBps   int32          `db:"minimum_payment_bps"`
+	MinimumPaymentFloor int64          `db:"minimum_payment_floor"`
+	PaymentBudgetID     sql.NullString `db:"payment_budget_id"`
+	LowBalanceThreshold int64          `db:"low_balance_threshold"`
 }
 
 func (row *accountDB) toDomain() *finance.Account {
+	var paymentBudgetID *finance.BudgetID
+	if row.PaymentBudgetID.Valid {
+		bID := finance.BudgetID(row.PaymentBudgetID.String)
+		paymentBudgetID = &bID
+	}
+
 	return &finance.Account{
 		ID:             finance.AccountID(row.ID),
 		SpaceID:        finance.SpaceID(row.SpaceID),
@@ -47,7 +60,21 @@ func (row *accountDB) toDomain() *finance.Account {
 		LastFour:       row.LastFour,
 		CreateTime:     nullTimeToTime(row.CreateTime),
 		UpdateTime:     nullTimeToTime(row.UpdateTime),
+
+		StatementClosingDay: row.StatementClosingDay,
+		PaymentDueDay:       row.PaymentDueDay,
+		MinimumPaymentBps:   row.MinimumPaymentBps,
+		MinimumPaymentFloor: row.MinimumPaymentFloor,
+		PaymentBudgetID:     paymentBudgetID,
+		LowBalanceThreshold: row.LowBalanceThreshold,
+	}
+}
+
+func accountPaymentBudgetID(a *finance.Account) sql.NullString {
+	if a.PaymentBudgetID == nil {
+		return sql.NullString{}
 	}
+	return sql.NullString{String: string(*a.PaymentBudgetID), Valid: true}
 }
 
 type AccountStore struct {
@@ -75,6 +102,13 @@ func (s *AccountStore) Create(ctx context.Context, a *finance.Account) error {
 		"last_four":       a.LastFour,
 		"create_time":     a.CreateTime,
 		"update_time":     a.UpdateTime,
+
+		"statement_closing_day": a.StatementClosingDay,
+		"payment_due_day":       a.PaymentDueDay,
+		"minimum_payment_bps":   a.MinimumPaymentBps,
+		"minimum_payment_floor": a.MinimumPaymentFloor,
+		"payment_budget_id":     accountPaymentBudgetID(a),
+		"low_balance_threshold": a.LowBalanceThreshold,
 	})
 	query, args, err := ds.Prepared(true).ToSQL()
 	if err != nil {
@@ -115,6 +149,13 @@ func (s *AccountStore) Update(ctx context.Context, a *finance.Account) error {
 			"notes":           a.Notes,
 			"last_four":       a.LastFour,
 			"update_time":     a.UpdateTime,
+
+			"statement_closing_day": a.StatementClosingDay,
+			"payment_due_day":       a.PaymentDueDay,
+			"minimum_payment_bps":   a.MinimumPaymentBps,
+			"minimum_payment_floor": a.MinimumPaymentFloor,
+			"payment_budget_id":     accountPaymentBudgetID(a),
+			"low_balance_threshold": a.LowBalanceThreshold,
 		}).
 		Where(goqu.Ex{"id": string(a.ID)})
 	query, args, err := ds.Prepared(true).ToSQL()
@@ -265,6 +306,30 @@ func (s *AccountStore) HasAny(ctx context.Context, spaceID finance.SpaceID) (boo
 	return true, nil
 }
 
+func (s *AccountStore) ListWithStatementCycle(ctx context.Context) ([]*finance.Account, error) {
+	ds := pgDialect.From(goqu.S("finance").Table("account")).
+		Select("*").
+		Where(
+			goqu.Ex{"type": string(finance.AccountTypeCreditCard), "is_active": true},
+			goqu.I("statement_closing_day").Gt(0),
+		)
+	query, args, err := ds.Prepared(true).ToSQL()
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []accountDB
+	if err := s.db.SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, err
+	}
+
+	accounts := make([]*finance.Account, len(rows))
+	for i := range rows {
+		accounts[i] = rows[i].toDomain()
+	}
+	return accounts, nil
+}
+
 func (s *AccountStore) GetByIDs(ctx context.Context, spaceID finance.SpaceID, ids []finance.AccountID) ([]*finance.Account, error) {
 	if len(ids) == 0 {
 		return nil, nil
@@ -0,0 +1,225 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/jmoiron/sqlx"
+	"github.com/masterkeysrd/saturn/internal/domain/finance"
+	"github.com/masterkeysrd/saturn/internal/platform/paging"
+)
+
+type alertDB struct {
+	ID                   string         `db:"id"`
+	SpaceID              string         `db:"space_id"`
+	Rule                 string         `db:"rule"`
+	Status               string         `db:"status"`
+	Message              string         `db:"message"`
+	BudgetID             sql.NullString `db:"budget_id"`
+	PeriodID             sql.NullString `db:"period_id"`
+	TransactionID        sql.NullString `db:"transaction_id"`
+	RelatedTransactionID sql.NullString `db:"related_transaction_id"`
+	Amount               int64          `db:"amount"`
+	Threshold            int64          `db:"threshold"`
+	Currency             string         `db:"currency"`
+	DedupKey             string         `db:"dedup_key"`
+	SnoozedUntil         sql.NullTime   `db:"snoozed_until"`
+	AcknowledgeTime      sql.NullTime   `db:"acknowledge_time"`
+	CreateTime           sql.NullTime   `db:"create_time"`
+	UpdateTime           sql.NullTime   `db:"update_time"`
+}
+
+func (row *alertDB) toDomain() *finance.Alert {
+	a := &finance.Alert{
+		ID:         finance.AlertID(row.ID),
+		SpaceID:    finance.SpaceID(row.SpaceID),
+		Rule:       finance.AlertRule(row.Rule),
+		Status:     finance.AlertStatus(row.Status),
+		Message:    row.Message,
+		Amount:     row.Amount,
+		Threshold:  row.Threshold,
+		Currency:   finance.Currency(row.Currency),
+		DedupKey:   row.DedupKey,
+		CreateTime: nullTimeToTime(row.CreateTime),
+		UpdateTime: nullTimeToTime(row.UpdateTime),
+	}
+	if row.BudgetID.Valid {
+		bID := finance.BudgetID(row.BudgetID.String)
+		a.BudgetID = &bID
+	}
+	if row.PeriodID.Valid {
+		pID := finance.PeriodID(row.PeriodID.String)
+		a.PeriodID = &pID
+	}
+	if row.TransactionID.Valid {
+		tID := finance.TransactionID(row.TransactionID.String)
+		a.TransactionID = &tID
+	}
+	if row.RelatedTransactionID.Valid {
+		tID := finance.TransactionID(row.RelatedTransactionID.String)
+		a.RelatedTransactionID = &tID
+	}
+	if row.SnoozedUntil.Valid {
+		t := row.SnoozedUntil.Time.UTC()
+		a.SnoozedUntil = &t
+	}
+	if row.AcknowledgeTime.Valid {
+		t := row.AcknowledgeTime.Time.UTC()
+		a.AcknowledgeTime = &t
+	}
+	return a
+}
+
+// alertRef converts an optional reference of an alert into a nullable column.
+func alertRef[T ~string](v *T) sql.NullString {
+	if v == nil {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: string(*v), Valid: true}
+}
+
+type AlertStore struct {
+	db *sqlx.DB
+}
+
+func NewAlertStore(db *sqlx.DB) *AlertStore {
+	return &AlertStore{db: db}
+}
+
+func (s *AlertStore) CreateIfAbsent(ctx context.Context, a *finance.Alert) (bool, error) {
+	ds := pgDialect.Insert(goqu.S("finance").Table("alert")).Rows(goqu.Record{
+		"id":                     string(a.ID),
+		"space_id":               string(a.SpaceID),
+		"rule":                   string(a.Rule),
+		"status":                 string(a.Status),
+		"message":                a.Message,
+		"budget_id":              alertRef(a.BudgetID),
+		"period_id":              alertRef(a.PeriodID),
+		"transaction_id":         alertRef(a.TransactionID),
+		"related_transaction_id": alertRef(a.RelatedTransactionID),
+		"amount":                 a.Amount,
+		"threshold":              a.Threshold,
+		"currency":               string(a.Currency),
+		"dedup_key":              a.DedupKey,
+		"snoozed_until":          timeToNullTime(ptrToTime(a.SnoozedUntil)),
+		"acknowledge_time":       timeToNullTime(ptrToTime(a.AcknowledgeTime)),
+		"create_time":            a.CreateTime,
+		"update_time":            a.UpdateTime,
+	}).OnConflict(goqu.DoNothing())
+	query, args, err := ds.Prepared(true).ToSQL()
+	if err != nil {
+		return false, err
+	}
+	res, err := s.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return false, err
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rows > 0, nil
+}
+
+func (s *AlertStore) GetByID(ctx context.Context, spaceID finance.SpaceID, id finance.AlertID) (*finance.Alert, error) {
+	ds := pgDialect.From(goqu.S("finance").Table("alert")).Select("*").Where(goqu.Ex{"space_id": string(spaceID), "id": string(id)})
+	query, args, err := ds.Prepared(true).ToSQL()
+	if err != nil {
+		return nil, err
+	}
+	var row alertDB
+	if err := s.db.GetContext(ctx, &row, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, finance.ErrAlertNotFound
+		}
+		return nil, err
+	}
+	return row.toDomain(), nil
+}
+
+func (s *AlertStore) Update(ctx context.Context, a *finance.Alert) error {
+	ds := pgDialect.Update(goqu.S("finance").Table("alert")).
+		Set(goqu.Record{
+			"status":           string(a.Status),
+			"snoozed_until":    timeToNullTime(ptrToTime(a.SnoozedUntil)),
+			"acknowledge_time": timeToNullTime(ptrToTime(a.AcknowledgeTime)),
+			"update_time":      a.UpdateTime,
+		}).
+		Where(goqu.Ex{"space_id": string(a.SpaceID), "id": string(a.ID)})
+	query, args, err := ds.Prepared(true).ToSQL()
+	if err != nil {
+		return err
+	}
+	res, err := s.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return finance.ErrAlertNotFound
+	}
+	return nil
+}
+
+func (s *AlertStore) ListBySpace(ctx context.Context, spaceID finance.SpaceID, filter *finance.ListAlertsFilter) (*paging.Page[*finance.Alert], error) {
+	if filter.PageSize <= 0 || filter.PageSize > 100 {
+		filter.PageSize = 50
+	}
+
+	ds := pgDialect.From(goqu.S("finance").Table("alert")).Select("*")
+	ds = ds.Where(goqu.Ex{"space_id": string(spaceID)})
+	if filter.ActiveOnly {
+		ds = ds.Where(goqu.Or(
+			goqu.I("status").Eq(string(finance.AlertStatusOpen)),
+			goqu.And(
+				goqu.I("status").Eq(string(finance.AlertStatusSnoozed)),
+				goqu.I("snoozed_until").Lte(filter.Now),
+			),
+		))
+	}
+	if filter.Rule != nil {
+		ds = ds.Where(goqu.I("rule").Eq(string(*filter.Rule)))
+	}
+
+	cursor, _ := paging.Decode(filter.NextPageToken)
+
+	sortOrder := filter.Sort
+	if !finance.IsAlertSortField(sortOrder.Field) {
+		sortOrder.Field = finance.DefaultAlertSortField
+		sortOrder.Ascending = false
+	}
+
+	ds = paging.ApplyPagination(ds, paging.Options{
+		Sort:     sortOrder,
+		Cursor:   cursor,
+		PageSize: uint(filter.PageSize),
+	})
+
+	query, args, err := ds.Prepared(true).ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("build sql query: %w", err)
+	}
+
+	var rows []alertDB
+	if err := s.db.SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, fmt.Errorf("select context: %w", err)
+	}
+
+	alerts := make([]*finance.Alert, len(rows))
+	for i := range rows {
+		alerts[i] = rows[i].toDomain()
+	}
+
+	return paging.NewPage(alerts, int(filter.PageSize), func(a *finance.Alert) paging.Cursor {
+		return paging.Cursor{
+			SortValue: a.GetSortValue(sortOrder.Field),
+			ID:        string(a.ID),
+		}
+	}), nil
+}
@@ -29,6 +29,14 @@ type borrowingDB struct {
 	Notes           string       `db:"notes"`
 	CreateTime      sql.NullTime `db:"create_time"`
 	UpdateTime      sql.NullTime `db:"update_time"`
+
+	InterestType         string         `db:"interest_type"`
+	InterestRateBps      int32          `db:"interest_rate_bps"`
+	InstallmentCount     int32          `db:"installment_count"`
+	InstallmentFrequency string         `db:"installment_frequency"`
+	FirstInstallmentDate sql.NullTime   `db:"first_installment_date"`
+	BudgetID             sql.NullString `db:"budget_id"`
+	PaidAmount           int64          `db:"paid_amount"`
 }
 
 func (row *borrowingDB) toDomain() *finance.Borrowing {
@@ -37,6 +45,17 @@ func (row *borrowingDB) toDomain() *finance.Borrowing {
 		dueAtPtr = &row.DueAt.Time
 	}
 
+	var firstInstallmentPtr *time.Time
+	if row.FirstInstallmentDate.Valid {
+		firstInstallmentPtr = &row.FirstInstallmentDate.Time
+	}
+
+	var budgetIDPtr *finance.BudgetID
+	if row.BudgetID.Valid {
+		bID := finance.BudgetID(row.BudgetID.String)
+		budgetIDPtr = &bID
+	}
+
 	return &finance.Borrowing{
 		ID:              finance.BorrowingID(row.ID),
 		SpaceID:         finance.SpaceID(row.SpaceID),
@@ -52,7 +71,29 @@ func (row *borrowingDB) toDomain() *finance.Borrowing {
 		Notes:           row.Notes,
 		CreateTime:      nullTimeToTime(row.CreateTime),
 		UpdateTime:      nullTimeToTime(row.UpdateTime),
+
+		InterestType:         finance.InterestType(row.InterestType),
+		InterestRateBps:      row.InterestRateBps,
+		InstallmentCount:     row.InstallmentCount,
+		InstallmentFrequency: finance.InstallmentFrequency(row.InstallmentFrequency),
+		FirstInstallmentDate: firstInstallmentPtr,
+		BudgetID:             budgetIDPtr,
+		PaidAmount:           row.PaidAmount,
+	}
+}
+
+func borrowingBudgetID(b *finance.Borrowing) sql.NullString {
+	if b.BudgetID == nil {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: string(*b.BudgetID), Valid: true}
+}
+
+func borrowingInterestType(b *finance.Borrowing) string {
+	if b.InterestType == "" {
+		return string(finance.InterestTypeNone)
 	}
+	return string(b.InterestType)
 }
 
 type BorrowingStore struct {
@@ -65,20 +106,27 @@ func NewBorrowingStore(db *sqlx.DB) *BorrowingStore {
 
 func (s *BorrowingStore) Create(ctx context.Context, b *finance.Borrowing) error {
 	ds := pgDialect.Insert(goqu.S("finance").Table("borrowing")).Rows(goqu.Record{
-		"id":               string(b.ID),
-		"space_id":         string(b.SpaceID),
-		"direction":        string(b.Direction),
-		"counterparty":     b.Counterparty,
-		"contact_info":     b.ContactInfo,
-		"total_amount":     b.TotalAmount,
-		"remaining_amount": b.RemainingAmount,
-		"currency":         string(b.Currency),
-		"status":           string(b.Status),
-		"established_at":   timeToNullTime(b.EstablishedAt),
-		"due_at":           timeToNullTime(ptrToTime(b.DueAt)),
-		"notes":            b.Notes,
-		"create_time":      b.CreateTime,
-		"update_time":      b.UpdateTime,
+		"id":                     string(b.ID),
+		"space_id":               string(b.SpaceID),
+		"direction":              string(b.Direction),
+		"counterparty":           b.Counterparty,
+		"contact_info":           b.ContactInfo,
+		"total_amount":           b.TotalAmount,
+		"remaining_amount":       b.RemainingAmount,
+		"currency":               string(b.Currency),
+		"status":                 string(b.Status),
+		"established_at":         timeToNullTime(b.EstablishedAt),
+		"due_at":                 timeToNullTime(ptrToTime(b.DueAt)),
+		"notes":                  b.Notes,
+		"create_time":            b.CreateTime,
+		"update_time":            b.UpdateTime,
+		"interest_type":          borrowingInterestType(b),
+		"interest_rate_bps":      b.InterestRateBps,
+		"installment_count":      b.InstallmentCount,
+		"installment_frequency":  string(b.InstallmentFrequency),
+		"first_installment_date": timeToNullTime(ptrToTime(b.FirstInstallmentDate)),
+		"budget_id":              borrowingBudgetID(b),
+		"paid_amount":            b.PaidAmount,
 	})
 
 	query, args, err := ds.Prepared(true).ToSQL()
@@ -114,17 +162,24 @@ func (s *BorrowingStore) GetByID(ctx context.Context, spaceID finance.SpaceID, i
 func (s *BorrowingStore) Update(ctx context.Context, b *finance.Borrowing) error {
 	ds := pgDialect.Update(goqu.S("finance").Table("borrowing")).
 		Set(goqu.Record{
-			"direction":        string(b.Direction),
-			"counterparty":     b.Counterparty,
-			"contact_info":     b.ContactInfo,
-			"total_amount":     b.TotalAmount,
-			"remaining_amount": b.RemainingAmount,
-			"currency":         string(b.Currency),
-			"status":           string(b.Status),
-			"established_at":   timeToNullTime(b.EstablishedAt),
-			"due_at":           timeToNullTime(ptrToTime(b.DueAt)),
-			"notes":            b.Notes,
-			"update_time":      b.UpdateTime,
+			"direction":              string(b.Direction),
+			"counterparty":           b.Counterparty,
+			"contact_info":           b.ContactInfo,
+			"total_amount":           b.TotalAmount,
+			"remaining_amount":       b.RemainingAmount,
+			"currency":               string(b.Currency),
+			"status":                 string(b.Status),
+			"established_at":         timeToNullTime(b.EstablishedAt),
+			"due_at":                 timeToNullTime(ptrToTime(b.DueAt)),
+			"notes":                  b.Notes,
+			"update_time":            b.UpdateTime,
+			"interest_type":          borrowingInterestType(b),
+			"interest_rate_bps":      b.InterestRateBps,
+			"installment_count":      b.InstallmentCount,
+			"installment_frequency":  string(b.InstallmentFrequency),
+			"first_installment_date": timeToNullTime(ptrToTime(b.FirstInstallmentDate)),
+			"budget_id":              borrowingBudgetID(b),
+			"paid_amount":            b.PaidAmount,
 		}).
 		Where(goqu.Ex{"id": string(b.ID)})
 
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/doug-martin/goqu/v9"
 	_ "github.com/doug-martin/goqu/v9/dialect/postgres"
@@ -24,6 +25,11 @@ type budgetDB struct {
 	Icon             string         `db:"icon"`
 	Color            string         `db:"color"`
 	DefaultAccountID sql.NullString `db:"default_account_id"`
+	RolloverPolicy   string         `db:"rollover_policy"`
+	RolloverCap      int64          `db:"rollover_cap"`
+	PeriodStartDay   int            `db:"period_start_day"`
+	WeekStart        int            `db:"week_start"`
+	PeriodAnchor     sql.NullTime   `db:"period_anchor"`
 	Version          int64          `db:"version"`
 	CreateTime       sql.NullTime   `db:"create_time"`
 	UpdateTime       sql.NullTime   `db:"update_time"`
@@ -35,6 +41,11 @@ func (row *budgetDB) toDomain() *finance.Budget {
 		idVal := finance.AccountID(row.DefaultAccountID.String)
 		defaultAccountID = &idVal
 	}
+	var periodAnchor *time.Time
+	if row.PeriodAnchor.Valid {
+		anchor := row.PeriodAnchor.Time
+		periodAnchor = &anchor
+	}
 	return &finance.Budget{
 		ID:               finance.BudgetID(row.ID),
 		SpaceID:          finance.SpaceID(row.SpaceID),
@@ -46,6 +57,11 @@ func (row *budgetDB) toDomain() *finance.Budget {
 		Icon:             row.Icon,
 		Color:            row.Color,
 		DefaultAccountID: defaultAccountID,
+		RolloverPolicy:   finance.RolloverPolicy(row.RolloverPolicy),
+		RolloverCap:      row.RolloverCap,
+		PeriodStartDay:   row.PeriodStartDay,
+		WeekStart:        row.WeekStart,
+		PeriodAnchor:     periodAnchor,
 		Version:          row.Version,
 		CreateTime:       nullTimeToTime(row.CreateTime),
 		UpdateTime:       nullTimeToTime(row.UpdateTime),
@@ -57,6 +73,10 @@ func toDB(b *finance.Budget) budgetDB {
 	if b.DefaultAccountID != nil {
 		defaultAccountID = sql.NullString{String: string(*b.DefaultAccountID), Valid: true}
 	}
+	var periodAnchor sql.NullTime
+	if b.PeriodAnchor != nil {
+		periodAnchor = sql.NullTime{Time: *b.PeriodAnchor, Valid: true}
+	}
 	return budgetDB{
 		ID:               string(b.ID),
 		SpaceID:          string(b.SpaceID),
@@ -68,6 +88,11 @@ func toDB(b *finance.Budget) budgetDB {
 		Icon:             b.Icon,
 		Color:            b.Color,
 		DefaultAccountID: defaultAccountID,
+		RolloverPolicy:   string(b.RolloverPolicy),
+		RolloverCap:      b.RolloverCap,
+		PeriodStartDay:   b.PeriodStartDay,
+		WeekStart:        b.WeekStart,
+		PeriodAnchor:     periodAnchor,
 		Version:          b.Version,
 		CreateTime:       sql.NullTime{Time: b.CreateTime, Valid: !b.CreateTime.IsZero()},
 		UpdateTime:       sql.NullTime{Time: b.UpdateTime, Valid: !b.UpdateTime.IsZero()},
@@ -126,6 +151,11 @@ func (s *BudgetStore) Update(ctx context.Context, b *finance.Budget) error {
 			"icon":               row.Icon,
 			"color":              row.Color,
 			"default_account_id": row.DefaultAccountID,
+			"rollover_policy":    row.RolloverPolicy,
+			"rollover_cap":       row.RolloverCap,
+			"period_start_day":   row.PeriodStartDay,
+			"week_start":         row.WeekStart,
+			"period_anchor":      row.PeriodAnchor,
 			"version":            goqu.L("version + 1"),
 			"update_time":        row.UpdateTime,
 		}).
@@ -0,0 +1,198 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/jmoiron/sqlx"
+	"github.com/masterkeysrd/saturn/internal/domain/finance"
+)
+
+type cardStatementDB struct {
+	ID                 string         `db:"id"`
+	SpaceID            string         `db:"space_id"`
+	AccountID          string         `db:"account_id"`
+	Currency           string         `db:"currency"`
+	PeriodStart        time.Time      `db:"period_start"`
+	ClosingDate        time.Time      `db:"closing_date"`
+	DueDate            time.Time      `db:"due_date"`
+	OpeningBalance     int64          `db:"opening_balance"`
+	Purchases          int64          `db:"purchases"`
+	Credits            int64          `db:"credits"`
+	StatementBalance   int64          `db:"statement_balance"`
+	MinimumPayment     int64          `db:"minimum_payment"`
+	PaidAmount         int64          `db:"paid_amount"`
+	CreditLimit        int64          `db:"credit_limit"`
+	Utilization        float64        `db:"utilization"`
+	Status             string         `db:"status"`
+	ScheduledPaymentID sql.NullString `db:"scheduled_payment_id"`
+	CreateTime         sql.NullTime   `db:"create_time"`
+	UpdateTime         sql.NullTime   `db:"update_time"`
+}
+
+func (row *cardStatementDB) toDomain() *finance.CardStatement {
+	var paymentID *finance.ScheduledPaymentID
+	if row.ScheduledPaymentID.Valid {
+		pID := finance.ScheduledPaymentID(row.ScheduledPaymentID.String)
+		paymentID = &pID
+	}
+	cy, cm, cd := row.ClosingDate.Date()
+	dy, dm, dd := row.DueDate.Date()
+	return &finance.CardStatement{
+		ID:                 finance.CardStatementID(row.ID),
+		SpaceID:            finance.SpaceID(row.SpaceID),
+		AccountID:          finance.AccountID(row.AccountID),
+		Currency:           finance.Currency(row.Currency),
+		PeriodStart:        row.PeriodStart.UTC(),
+		ClosingDate:        time.Date(cy, cm, cd, 0, 0, 0, 0, time.UTC),
+		DueDate:            time.Date(dy, dm, dd, 0, 0, 0, 0, time.UTC),
+		OpeningBalance:     row.OpeningBalance,
+		Purchases:          row.Purchases,
+		Credits:            row.Credits,
+		StatementBalance:   row.StatementBalance,
+		MinimumPayment:     row.MinimumPayment,
+		PaidAmount:         row.PaidAmount,
+		CreditLimit:        row.CreditLimit,
+		Utilization:        row.Utilization,
+		Status:             finance.CardStatementStatus(row.Status),
+		ScheduledPaymentID: paymentID,
+		CreateTime:         nullTimeToTime(row.CreateTime),
+		UpdateTime:         nullTimeToTime(row.UpdateTime),
+	}
+}
+
+func cardStatementPaymentID(st *finance.CardStatement) sql.NullString {
+	if st.ScheduledPaymentID == nil {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: string(*st.ScheduledPaymentID), Valid: true}
+}
+
+type CardStatementStore struct {
+	db *sqlx.DB
+}
+
+func NewCardStatementStore(db *sqlx.DB) *CardStatementStore {
+	return &CardStatementStore{db: db}
+}
+
+func (s *CardStatementStore) Create(ctx context.Context, st *finance.CardStatement) error {
+	ds := pgDialect.Insert(goqu.S("finance").Table("card_statement")).Rows(goqu.Record{
+		"id":                   string(st.ID),
+		"space_id":             string(st.SpaceID),
+		"account_id":           string(st.AccountID),
+		"currency":             string(st.Currency),
+		"period_start":         st.PeriodStart,
+		"closing_date":         st.ClosingDate.Format(time.DateOnly),
+		"due_date":             st.DueDate.Format(time.DateOnly),
+		"opening_balance":      st.OpeningBalance,
+		"purchases":            st.Purchases,
+		"credits":              st.Credits,
+		"statement_balance":    st.StatementBalance,
+		"minimum_payment":      st.MinimumPayment,
+		"paid_amount":          st.PaidAmount,
+		"credit_limit":         st.CreditLimit,
+		"utilization":          st.Utilization,
+		"status":               string(st.Status),
+		"scheduled_payment_id": cardStatementPaymentID(st),
+		"create_time":          st.CreateTime,
+		"update_time":          st.UpdateTime,
+	})
+	query, args, err := ds.Prepared(true).ToSQL()
+	if err != nil {
+		return err
+	}
+	_, err = s.db.ExecContext(ctx, query, args...)
+	return err
+}
+
+func (s *CardStatementStore) GetByID(ctx context.Context, spaceID finance.SpaceID, id finance.CardStatementID) (*finance.CardStatement, error) {
+	ds := pgDialect.From(goqu.S("finance").Table("card_statement")).Select("*").Where(goqu.Ex{"space_id": string(spaceID), "id": string(id)})
+	return s.getOne(ctx, ds)
+}
+
+func (s *CardStatementStore) GetLatest(ctx context.Context, spaceID finance.SpaceID, accountID finance.AccountID) (*finance.CardStatement, error) {
+	ds := pgDialect.From(goqu.S("finance").Table("card_statement")).
+		Select("*").
+		Where(goqu.Ex{"space_id": string(spaceID), "account_id": string(accountID)}).
+		Order(goqu.I("closing_date").Desc()).
+		Limit(1)
+	return s.getOne(ctx, ds)
+}
+
+func (s *CardStatementStore) getOne(ctx context.Context, ds *goqu.SelectDataset) (*finance.CardStatement, error) {
+	query, args, err := ds.Prepared(true).ToSQL()
+	if err != nil {
+		return nil, err
+	}
+	var row cardStatementDB
+	if err := s.db.GetContext(ctx, &row, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, finance.ErrCardStatementNotFound
+		}
+		return nil, err
+	}
+	return row.toDomain(), nil
+}
+
+func (s *CardStatementStore) Update(ctx context.Context, st *finance.CardStatement) error {
+	ds := pgDialect.Update(goqu.S("finance").Table("card_statement")).
+		Set(goqu.Record{
+			"paid_amount":          st.PaidAmount,
+			"status":               string(st.Status),
+			"scheduled_payment_id": cardStatementPaymentID(st),
+			"update_time":          st.UpdateTime,
+		}).
+		Where(goqu.Ex{"space_id": string(st.SpaceID), "id": string(st.ID)})
+	query, args, err := ds.Prepared(true).ToSQL()
+	if err != nil {
+		return err
+	}
+	res, err := s.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return finance.ErrCardStatementNotFound
+	}
+	return nil
+}
+
+func (s *CardStatementStore) ListByAccount(ctx context.Context, spaceID finance.SpaceID, accountID finance.AccountID, filter *finance.ListCardStatementsFilter) ([]*finance.CardStatement, error) {
+	ds := pgDialect.From(goqu.S("finance").Table("card_statement")).
+		Select("*").
+		Where(goqu.Ex{"space_id": string(spaceID), "account_id": string(accountID)}).
+		Order(goqu.I("closing_date").Desc())
+
+	if filter != nil {
+		if filter.UnpaidOnly {
+			ds = ds.Where(goqu.I("status").Neq(string(finance.CardStatementStatusPaid)))
+		}
+		if filter.PageSize > 0 {
+			ds = ds.Limit(uint(filter.PageSize))
+		}
+	}
+
+	query, args, err := ds.Prepared(true).ToSQL()
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []cardStatementDB
+	if err := s.db.SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, err
+	}
+
+	statements := make([]*finance.CardStatement, len(rows))
+	for i := range rows {
+		statements[i] = rows[i].toDomain()
+	}
+	return statements, nil
+}
@@ -0,0 +1,189 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/jmoiron/sqlx"
+	"github.com/masterkeysrd/saturn/internal/domain/finance"
+	"github.com/masterkeysrd/saturn/internal/platform/conv"
+	"github.com/masterkeysrd/saturn/internal/platform/paging"
+)
+
+type categoryDB struct {
+	ID         string         `db:"id"`
+	SpaceID    string         `db:"space_id"`
+	ParentID   sql.NullString `db:"parent_id"`
+	Name       string         `db:"name"`
+	Icon       string         `db:"icon"`
+	Color      string         `db:"color"`
+	CreateTime sql.NullTime   `db:"create_time"`
+	UpdateTime sql.NullTime   `db:"update_time"`
+}
+
+func (row *categoryDB) toDomain() *finance.Category {
+	var parentID *finance.CategoryID
+	if row.ParentID.Valid {
+		idVal := finance.CategoryID(row.ParentID.String)
+		parentID = &idVal
+	}
+	return &finance.Category{
+		ID:         finance.CategoryID(row.ID),
+		SpaceID:    finance.SpaceID(row.SpaceID),
+		ParentID:   parentID,
+		Name:       row.Name,
+		Icon:       row.Icon,
+		Color:      row.Color,
+		CreateTime: nullTimeToTime(row.CreateTime),
+		UpdateTime: nullTimeToTime(row.UpdateTime),
+	}
+}
+
+type CategoryStore struct {
+	db *sqlx.DB
+}
+
+func NewCategoryStore(db *sqlx.DB) *CategoryStore {
+	return &CategoryStore{db: db}
+}
+
+func (s *CategoryStore) Create(ctx context.Context, c *finance.Category) error {
+	ds := pgDialect.Insert(goqu.S("finance").Table("category")).Rows(goqu.Record{
+		"id":          string(c.ID),
+		"space_id":    string(c.SpaceID),
+		"parent_id":   conv.StringPtr(c.ParentID),
+		"name":        c.Name,
+		"icon":        c.Icon,
+		"color":       c.Color,
+		"create_time": c.CreateTime,
+		"update_time": c.UpdateTime,
+	})
+	query, args, err := ds.Prepared(true).ToSQL()
+	if err != nil {
+		return err
+	}
+	_, err = s.db.ExecContext(ctx, query, args...)
+	return err
+}
+
+func (s *CategoryStore) GetByID(ctx context.Context, spaceID finance.SpaceID, id finance.CategoryID) (*finance.Category, error) {
+	ds := pgDialect.From(goqu.S("finance").Table("category")).Select("*").Where(goqu.Ex{"space_id": string(spaceID), "id": string(id)})
+	query, args, err := ds.Prepared(true).ToSQL()
+	if err != nil {
+		return nil, err
+	}
+	var row categoryDB
+	if err := s.db.GetContext(ctx, &row, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, finance.ErrCategoryNotFound
+		}
+		return nil, err
+	}
+	return row.toDomain(), nil
+}
+
+func (s *CategoryStore) Update(ctx context.Context, c *finance.Category) error {
+	ds := pgDialect.Update(goqu.S("finance").Table("category")).
+		Set(goqu.Record{
+			"parent_id":   conv.StringPtr(c.ParentID),
+			"name":        c.Name,
+			"icon":        c.Icon,
+			"color":       c.Color,
+			"update_time": c.UpdateTime,
+		}).
+		Where(goqu.Ex{"space_id": string(c.SpaceID), "id": string(c.ID)})
+	query, args, err := ds.Prepared(true).ToSQL()
+	if err != nil {
+		return err
+	}
+	res, err := s.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return finance.ErrCategoryNotFound
+	}
+	return nil
+}
+
+func (s *CategoryStore) Delete(ctx context.Context, spaceID finance.SpaceID, id finance.CategoryID) error {
+	ds := pgDialect.Delete(goqu.S("finance").Table("category")).Where(goqu.Ex{"space_id": string(spaceID), "id": string(id)})
+	query, args, err := ds.Prepared(true).ToSQL()
+	if err != nil {
+		return err
+	}
+	res, err := s.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return finance.ErrCategoryNotFound
+	}
+	return nil
+}
+
+func (s *CategoryStore) ListBySpace(ctx context.Context, spaceID finance.SpaceID, filter *finance.ListCategoriesFilter) (*paging.Page[*finance.Category], error) {
+	if filter.PageSize <= 0 || filter.PageSize > 100 {
+		filter.PageSize = 20
+	}
+
+	ds := pgDialect.From(goqu.S("finance").Table("category")).Select("*")
+	ds = ds.Where(goqu.Ex{"space_id": string(spaceID)})
+
+	if filter.ParentID != nil {
+		ds = ds.Where(goqu.Ex{"parent_id": string(*filter.ParentID)})
+	} else if filter.TopLevelOnly {
+		ds = ds.Where(goqu.Ex{"parent_id": nil})
+	}
+
+	if filter.SearchQuery != nil && *filter.SearchQuery != "" {
+		ds = ds.Where(goqu.I("name").ILike("%" + *filter.SearchQuery + "%"))
+	}
+
+	cursor, _ := paging.Decode(filter.NextPageToken)
+
+	sortOrder := filter.Sort
+	if !finance.IsCategorySortField(sortOrder.Field) {
+		sortOrder.Field = finance.DefaultCategorySortField
+		sortOrder.Ascending = true
+	}
+
+	ds = paging.ApplyPagination(ds, paging.Options{
+		Sort:     sortOrder,
+		Cursor:   cursor,
+		PageSize: uint(filter.PageSize),
+	})
+
+	query, args, err := ds.Prepared(true).ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("build sql query: %w", err)
+	}
+
+	var rows []categoryDB
+	if err := s.db.SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, fmt.Errorf("select context: %w", err)
+	}
+
+	categories := make([]*finance.Category, len(rows))
+	for i := range rows {
+		categories[i] = rows[i].toDomain()
+	}
+
+	return paging.NewPage(categories, int(filter.PageSize), func(c *finance.Category) paging.Cursor {
+		return paging.Cursor{
+			SortValue: c.GetSortValue(sortOrder.Field),
+			ID:        string(c.ID),
+		}
+	}), nil
+}
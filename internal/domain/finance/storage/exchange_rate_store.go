@@ -316,3 +316,40 @@ func (s *ExchangeRateStore) GetLatestRates(ctx context.Context, spaceID finance.
 
 	return rates, nil
 }
+
+type currencyPairDB struct {
+	SpaceID      string `db:"space_id"`
+	FromCurrency string `db:"from_currency"`
+	ToCurrency   string `db:"to_currency"`
+}
+
+func (s *ExchangeRateStore) ListCurrencyPairs(ctx context.Context) ([]*finance.CurrencyPair, error) {
+	query := `
+		SELECT DISTINCT u.space_id, u.currency AS from_currency, st.base_currency AS to_currency
+		FROM (
+			SELECT space_id, currency FROM finance.account
+			UNION
+			SELECT space_id, currency FROM finance.budget
+			UNION
+			SELECT space_id, currency FROM finance.transaction
+		) u
+		INNER JOIN finance.settings st ON st.space_id = u.space_id
+		WHERE u.currency <> st.base_currency
+		ORDER BY u.space_id, from_currency
+	`
+
+	var rows []currencyPairDB
+	if err := s.db.SelectContext(ctx, &rows, query); err != nil {
+		return nil, fmt.Errorf("select context: %w", err)
+	}
+
+	pairs := make([]*finance.CurrencyPair, len(rows))
+	for i, row := range rows {
+		pairs[i] = &finance.CurrencyPair{
+			SpaceID:      finance.SpaceID(row.SpaceID),
+			FromCurrency: finance.Currency(row.FromCurrency),
+			ToCurrency:   finance.Currency(row.ToCurrency),
+		}
+	}
+	return pairs, nil
+}
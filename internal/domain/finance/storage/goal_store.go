@@ -0,0 +1,230 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/jmoiron/sqlx"
+	"github.com/masterkeysrd/saturn/internal/domain/finance"
+	"github.com/masterkeysrd/saturn/internal/platform/paging"
+)
+
+type goalDB struct {
+	ID           string         `db:"id"`
+	SpaceID      string         `db:"space_id"`
+	Name         string         `db:"name"`
+	Description  string         `db:"description"`
+	TargetAmount int64          `db:"target_amount"`
+	Currency     string         `db:"currency"`
+	StartDate    sql.NullTime   `db:"start_date"`
+	Deadline     sql.NullTime   `db:"deadline"`
+	AccountID    sql.NullString `db:"account_id"`
+	Tag          string         `db:"tag"`
+	Color        string         `db:"color"`
+	Icon         string         `db:"icon"`
+	Status       string         `db:"status"`
+	CreateTime   sql.NullTime   `db:"create_time"`
+	UpdateTime   sql.NullTime   `db:"update_time"`
+}
+
+func (row *goalDB) toDomain() *finance.Goal {
+	var deadline *time.Time
+	if row.Deadline.Valid {
+		deadline = &row.Deadline.Time
+	}
+	var accountID *finance.AccountID
+	if row.AccountID.Valid {
+		idVal := finance.AccountID(row.AccountID.String)
+		accountID = &idVal
+	}
+	return &finance.Goal{
+		ID:           finance.GoalID(row.ID),
+		SpaceID:      finance.SpaceID(row.SpaceID),
+		Name:         row.Name,
+		Description:  row.Description,
+		TargetAmount: row.TargetAmount,
+		Currency:     finance.Currency(row.Currency),
+		StartDate:    nullTimeToTime(row.StartDate),
+		Deadline:     deadline,
+		AccountID:    accountID,
+		Tag:          row.Tag,
+		Color:        row.Color,
+		Icon:         row.Icon,
+		Status:       finance.GoalStatus(row.Status),
+		CreateTime:   nullTimeToTime(row.CreateTime),
+		UpdateTime:   nullTimeToTime(row.UpdateTime),
+	}
+}
+
+func goalAccountID(g *finance.Goal) sql.NullString {
+	if g.AccountID == nil {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: string(*g.AccountID), Valid: true}
+}
+
+type GoalStore struct {
+	db *sqlx.DB
+}
+
+func NewGoalStore(db *sqlx.DB) *GoalStore {
+	return &GoalStore{db: db}
+}
+
+func (s *GoalStore) Create(ctx context.Context, g *finance.Goal) error {
+	ds := pgDialect.Insert(goqu.S("finance").Table("goal")).Rows(goqu.Record{
+		"id":            string(g.ID),
+		"space_id":      string(g.SpaceID),
+		"name":          g.Name,
+		"description":   g.Description,
+		"target_amount": g.TargetAmount,
+		"currency":      string(g.Currency),
+		"start_date":    g.StartDate,
+		"deadline":      timeToNullTime(ptrToTime(g.Deadline)),
+		"account_id":    goalAccountID(g),
+		"tag":           g.Tag,
+		"color":         g.Color,
+		"icon":          g.Icon,
+		"status":        string(g.Status),
+		"create_time":   g.CreateTime,
+		"update_time":   g.UpdateTime,
+	})
+	query, args, err := ds.Prepared(true).ToSQL()
+	if err != nil {
+		return err
+	}
+	_, err = s.db.ExecContext(ctx, query, args...)
+	return err
+}
+
+func (s *GoalStore) GetByID(ctx context.Context, spaceID finance.SpaceID, id finance.GoalID) (*finance.Goal, error) {
+	ds := pgDialect.From(goqu.S("finance").Table("goal")).Select("*").Where(goqu.Ex{"space_id": string(spaceID), "id": string(id)})
+	query, args, err := ds.Prepared(true).ToSQL()
+	if err != nil {
+		return nil, err
+	}
+	var row goalDB
+	if err := s.db.GetContext(ctx, &row, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, finance.ErrGoalNotFound
+		}
+		return nil, err
+	}
+	return row.toDomain(), nil
+}
+
+func (s *GoalStore) Update(ctx context.Context, g *finance.Goal) error {
+	ds := pgDialect.Update(goqu.S("finance").Table("goal")).
+		Set(goqu.Record{
+			"name":          g.Name,
+			"description":   g.Description,
+			"target_amount": g.TargetAmount,
+			"currency":      string(g.Currency),
+			"start_date":    g.StartDate,
+			"deadline":      timeToNullTime(ptrToTime(g.Deadline)),
+			"account_id":    goalAccountID(g),
+			"tag":           g.Tag,
+			"color":         g.Color,
+			"icon":          g.Icon,
+			"status":        string(g.Status),
+			"update_time":   g.UpdateTime,
+		}).
+		Where(goqu.Ex{"space_id": string(g.SpaceID), "id": string(g.ID)})
+	query, args, err := ds.Prepared(true).ToSQL()
+	if err != nil {
+		return err
+	}
+	res, err := s.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return finance.ErrGoalNotFound
+	}
+	return nil
+}
+
+func (s *GoalStore) Delete(ctx context.Context, spaceID finance.SpaceID, id finance.GoalID) error {
+	ds := pgDialect.Delete(goqu.S("finance").Table("goal")).Where(goqu.Ex{"space_id": string(spaceID), "id": string(id)})
+	query, args, err := ds.Prepared(true).ToSQL()
+	if err != nil {
+		return err
+	}
+	res, err := s.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return finance.ErrGoalNotFound
+	}
+	return nil
+}
+
+func (s *GoalStore) ListBySpace(ctx context.Context, spaceID finance.SpaceID, filter *finance.ListGoalsFilter) (*paging.Page[*finance.Goal], error) {
+	if filter.PageSize <= 0 || filter.PageSize > 100 {
+		filter.PageSize = 20
+	}
+
+	ds := pgDialect.From(goqu.S("finance").Table("goal")).Select("*")
+	ds = ds.Where(goqu.Ex{"space_id": string(spaceID)})
+
+	if filter.Status != nil {
+		ds = ds.Where(goqu.Ex{"status": string(*filter.Status)})
+	}
+
+	if filter.SearchQuery != nil && *filter.SearchQuery != "" {
+		pattern := "%" + *filter.SearchQuery + "%"
+		ds = ds.Where(goqu.Or(
+			goqu.I("name").ILike(pattern),
+			goqu.I("description").ILike(pattern),
+		))
+	}
+
+	cursor, _ := paging.Decode(filter.NextPageToken)
+
+	sortOrder := filter.Sort
+	if !finance.IsGoalSortField(sortOrder.Field) {
+		sortOrder.Field = finance.DefaultGoalSortField
+		sortOrder.Ascending = false // default: newest first
+	}
+
+	ds = paging.ApplyPagination(ds, paging.Options{
+		Sort:     sortOrder,
+		Cursor:   cursor,
+		PageSize: uint(filter.PageSize),
+	})
+
+	query, args, err := ds.Prepared(true).ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("build sql query: %w", err)
+	}
+
+	var rows []goalDB
+	if err := s.db.SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, fmt.Errorf("select context: %w", err)
+	}
+
+	goals := make([]*finance.Goal, len(rows))
+	for i := range rows {
+		goals[i] = rows[i].toDomain()
+	}
+
+	return paging.NewPage(goals, int(filter.PageSize), func(g *finance.Goal) paging.Cursor {
+		return paging.Cursor{
+			SortValue: g.GetSortValue(sortOrder.Field),
+			ID:        string(g.ID),
+		}
+	}), nil
+}
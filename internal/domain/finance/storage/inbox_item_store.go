@@ -19,7 +19,9 @@ import (
 type inboxItemDB struct {
 	ID                 string         `db:"id"`
 	SpaceID            string         `db:"space_id"`
-	IntegrationID      string         `db:"integration_id"`
+	IntegrationID      sql.NullString `db:"integration_id"`
+	Source             string         `db:"source"`
+	ExternalID         sql.NullString `db:"external_id"`
 	Status             string         `db:"status"`
 	DocType            string         `db:"doc_type"`
 	Amount             sql.NullInt64  `db:"amount"`
@@ -28,24 +30,32 @@ type inboxItemDB struct {
 	TransactionDate    sql.NullTime   `db:"transaction_date"`
 	AccountID          sql.NullString `db:"account_id"`
 	BudgetID           sql.NullString `db:"budget_id"`
+	PayeeID            sql.NullString `db:"payee_id"`
 	ScheduledPaymentID sql.NullString `db:"scheduled_payment_id"`
 	TransactionID      sql.NullString `db:"transaction_id"`
 	BorrowingID        sql.NullString `db:"borrowing_id"`
 	BorrowingLinkType  sql.NullString `db:"borrowing_link_type"`
 	RawPayload         string         `db:"raw_payload"`
 	MetadataJSON       string         `db:"metadata"`
+	SplitsJSON         sql.NullString `db:"splits"`
 	CreateTime         sql.NullTime   `db:"create_time"`
 }
 
 func toInboxItemDomain(db inboxItemDB) *finance.InboxItem {
-	var accountID, budgetID, paymentID, transactionID, borrowingID *string
+	var accountID, budgetID, payeeID, paymentID, transactionID, borrowingID, externalID *string
 	var linkType *finance.BorrowingLinkType
+	if db.ExternalID.Valid {
+		externalID = &db.ExternalID.String
+	}
 	if db.AccountID.Valid {
 		accountID = &db.AccountID.String
 	}
 	if db.BudgetID.Valid {
 		budgetID = &db.BudgetID.String
 	}
+	if db.PayeeID.Valid {
+		payeeID = &db.PayeeID.String
+	}
 	if db.ScheduledPaymentID.Valid {
 		paymentID = &db.ScheduledPaymentID.String
 	}
@@ -73,10 +83,17 @@ func toInboxItemDomain(db inboxItemDB) *finance.InboxItem {
 		metadata = make(map[string]any)
 	}
 
+	var splits []*finance.InboxItemSplit
+	if db.SplitsJSON.Valid && db.SplitsJSON.String != "" {
+		_ = json.Unmarshal([]byte(db.SplitsJSON.String), &splits)
+	}
+
 	return &finance.InboxItem{
 		ID:                 db.ID,
 		SpaceID:            db.SpaceID,
-		IntegrationID:      db.IntegrationID,
+		IntegrationID:      db.IntegrationID.String,
+		Source:             finance.InboxItemSource(db.Source),
+		ExternalID:         externalID,
 		Status:             finance.InboxItemStatus(db.Status),
 		DocType:            finance.InboxItemDocType(db.DocType),
 		Amount:             amount,
@@ -85,16 +102,30 @@ func toInboxItemDomain(db inboxItemDB) *finance.InboxItem {
 		TransactionDate:    db.TransactionDate.Time,
 		AccountID:          accountID,
 		BudgetID:           budgetID,
+		PayeeID:            payeeID,
 		ScheduledPaymentID: paymentID,
 		TransactionID:      transactionID,
 		BorrowingID:        borrowingID,
 		BorrowingLinkType:  linkType,
+		Splits:             splits,
 		RawPayload:         db.RawPayload,
 		Metadata:           metadata,
 		CreateTime:         db.CreateTime.Time,
 	}
 }
 
+// inboxSplitsJSON encodes draft split lines, defaulting to an empty JSON array.
+func inboxSplitsJSON(splits []*finance.InboxItemSplit) string {
+	if len(splits) == 0 {
+		return "[]"
+	}
+	b, err := json.Marshal(splits)
+	if err != nil {
+		return "[]"
+	}
+	return string(b)
+}
+
 type InboxItemStore struct {
 	db *sqlx.DB
 }
@@ -122,10 +153,19 @@ func (s *InboxItemStore) Insert(ctx context.Context, item *finance.InboxItem) er
 		}
 	}
 
+	splitsJSON := inboxSplitsJSON(item.Splits)
+
+	source := item.Source
+	if source == "" {
+		source = finance.InboxItemSourceIntegration
+	}
+
 	ds := pgDialect.Insert(goqu.S("finance").Table("inbox_item")).Rows(goqu.Record{
 		"id":                   item.ID,
 		"space_id":             item.SpaceID,
-		"integration_id":       item.IntegrationID,
+		"integration_id":       conv.Ptr(item.IntegrationID),
+		"source":               string(source),
+		"external_id":          conv.StringPtr(item.ExternalID),
 		"status":               string(item.Status),
 		"doc_type":             string(item.DocType),
 		"amount":               conv.Ptr(item.Amount),
@@ -134,12 +174,14 @@ func (s *InboxItemStore) Insert(ctx context.Context, item *finance.InboxItem) er
 		"transaction_date":     conv.Ptr(item.TransactionDate),
 		"account_id":           conv.StringPtr(item.AccountID),
 		"budget_id":            conv.StringPtr(item.BudgetID),
+		"payee_id":             conv.StringPtr(item.PayeeID),
 		"scheduled_payment_id": conv.StringPtr(item.ScheduledPaymentID),
 		"transaction_id":       conv.StringPtr(item.TransactionID),
 		"borrowing_id":         conv.StringPtr(item.BorrowingID),
 		"borrowing_link_type":  conv.StringPtr(linkTypeStr),
 		"raw_payload":          item.RawPayload,
 		"metadata":             metaJSON,
+		"splits":               splitsJSON,
 		"create_time":          createTime,
 	})
 	query, args, err := ds.Prepared(true).ToSQL()
@@ -181,9 +223,9 @@ func (s *InboxItemStore) ListBySpace(ctx context.Context, spaceID finance.SpaceI
 	var ds *goqu.SelectDataset
 	if filter.ExcludePayload {
 		ds = pgDialect.From(goqu.S("finance").Table("inbox_item")).Select(
-			"id", "space_id", "integration_id", "status", "doc_type",
+			"id", "space_id", "integration_id", "source", "external_id", "status", "doc_type",
 			"amount", "currency", "vendor_name", "transaction_date",
-			"account_id", "budget_id", "scheduled_payment_id", "transaction_id",
+			"account_id", "budget_id", "payee_id", "scheduled_payment_id", "transaction_id",
 			"create_time",
 		)
 	} else {
@@ -249,6 +291,34 @@ func (s *InboxItemStore) ListBySpace(ctx context.Context, spaceID finance.SpaceI
 	return page, nil
 }
 
+func (s *InboxItemStore) ListExternalIDs(ctx context.Context, spaceID finance.SpaceID, accountID finance.AccountID, externalIDs []string) (map[string]bool, error) {
+	found := make(map[string]bool)
+	if len(externalIDs) == 0 {
+		return found, nil
+	}
+
+	ds := pgDialect.From(goqu.S("finance").Table("inbox_item")).
+		Select("external_id").
+		Where(goqu.Ex{
+			"space_id":    string(spaceID),
+			"account_id":  string(accountID),
+			"external_id": externalIDs,
+		})
+	query, args, err := ds.Prepared(true).ToSQL()
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []string
+	if err := s.db.SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, fmt.Errorf("select inbox item external IDs: %w", err)
+	}
+	for _, id := range rows {
+		found[id] = true
+	}
+	return found, nil
+}
+
 func (s *InboxItemStore) Delete(ctx context.Context, spaceID finance.SpaceID, id string) error {
 	ds := pgDialect.Delete(goqu.S("finance").Table("inbox_item")).Where(goqu.Ex{
 		"space_id": string(spaceID),
@@ -286,6 +356,8 @@ func (s *InboxItemStore) Update(ctx context.Context, item *finance.InboxItem) er
 		}
 	}
 
+	splitsJSON := inboxSplitsJSON(item.Splits)
+
 	ds := pgDialect.Update(goqu.S("finance").Table("inbox_item")).
 		Set(goqu.Record{
 			"status":               string(item.Status),
@@ -296,12 +368,14 @@ func (s *InboxItemStore) Update(ctx context.Context, item *finance.InboxItem) er
 			"transaction_date":     conv.Ptr(item.TransactionDate),
 			"account_id":           conv.StringPtr(item.AccountID),
 			"budget_id":            conv.StringPtr(item.BudgetID),
+			"payee_id":             conv.StringPtr(item.PayeeID),
 			"scheduled_payment_id": conv.StringPtr(item.ScheduledPaymentID),
 			"transaction_id":       conv.StringPtr(item.TransactionID),
 			"borrowing_id":         conv.StringPtr(item.BorrowingID),
 			"borrowing_link_type":  conv.StringPtr(linkTypeStr),
 			"raw_payload":          item.RawPayload,
 			"metadata":             metaJSON,
+			"splits":               splitsJSON,
 		}).
 		Where(goqu.Ex{
 			"space_id": item.SpaceID,
@@ -0,0 +1,222 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/masterkeysrd/saturn/internal/domain/finance"
+	"github.com/masterkeysrd/saturn/internal/platform/paging"
+)
+
+type incomeSourceDB struct {
+	ID             string         `db:"id"`
+	SpaceID        string         `db:"space_id"`
+	Name           string         `db:"name"`
+	Employer       string         `db:"employer"`
+	ExpectedAmount int64          `db:"expected_amount"`
+	Currency       string         `db:"currency"`
+	Cadence        string         `db:"cadence"`
+	RecurrenceRule string         `db:"recurrence_rule"`
+	StartDate      sql.NullTime   `db:"start_date"`
+	ExceptionDates pq.StringArray `db:"exception_dates"`
+	AccountID      sql.NullString `db:"account_id"`
+	IsActive       bool           `db:"is_active"`
+	CreateTime     sql.NullTime   `db:"create_time"`
+	UpdateTime     sql.NullTime   `db:"update_time"`
+}
+
+func (row *incomeSourceDB) toDomain() *finance.IncomeSource {
+	var accountID *finance.AccountID
+	if row.AccountID.Valid {
+		idVal := finance.AccountID(row.AccountID.String)
+		accountID = &idVal
+	}
+	return &finance.IncomeSource{
+		ID:             finance.IncomeSourceID(row.ID),
+		SpaceID:        finance.SpaceID(row.SpaceID),
+		Name:           row.Name,
+		Employer:       row.Employer,
+		ExpectedAmount: row.ExpectedAmount,
+		Currency:       finance.Currency(row.Currency),
+		Cadence:        finance.IncomeCadence(row.Cadence),
+		RecurrenceRule: row.RecurrenceRule,
+		StartDate:      nullTimeToTime(row.StartDate),
+		ExceptionDates: arrayToDates(row.ExceptionDates),
+		AccountID:      accountID,
+		IsActive:       row.IsActive,
+		CreateTime:     nullTimeToTime(row.CreateTime),
+		UpdateTime:     nullTimeToTime(row.UpdateTime),
+	}
+}
+
+func incomeSourceAccountID(src *finance.IncomeSource) sql.NullString {
+	if src.AccountID == nil {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: string(*src.AccountID), Valid: true}
+}
+
+type IncomeSourceStore struct {
+	db *sqlx.DB
+}
+
+func NewIncomeSourceStore(db *sqlx.DB) *IncomeSourceStore {
+	return &IncomeSourceStore{db: db}
+}
+
+func (s *IncomeSourceStore) Create(ctx context.Context, src *finance.IncomeSource) error {
+	ds := pgDialect.Insert(goqu.S("finance").Table("income_source")).Rows(goqu.Record{
+		"id":              string(src.ID),
+		"space_id":        string(src.SpaceID),
+		"name":            src.Name,
+		"employer":        src.Employer,
+		"expected_amount": src.ExpectedAmount,
+		"currency":        string(src.Currency),
+		"cadence":         string(src.Cadence),
+		"recurrence_rule": src.RecurrenceRule,
+		"start_date":      timeToNullTime(src.StartDate),
+		"exception_dates": datesToArray(src.ExceptionDates),
+		"account_id":      incomeSourceAccountID(src),
+		"is_active":       src.IsActive,
+		"create_time":     src.CreateTime,
+		"update_time":     src.UpdateTime,
+	})
+	query, args, err := ds.Prepared(true).ToSQL()
+	if err != nil {
+		return err
+	}
+	_, err = s.db.ExecContext(ctx, query, args...)
+	return err
+}
+
+func (s *IncomeSourceStore) GetByID(ctx context.Context, spaceID finance.SpaceID, id finance.IncomeSourceID) (*finance.IncomeSource, error) {
+	ds := pgDialect.From(goqu.S("finance").Table("income_source")).Select("*").Where(goqu.Ex{"space_id": string(spaceID), "id": string(id)})
+	query, args, err := ds.Prepared(true).ToSQL()
+	if err != nil {
+		return nil, err
+	}
+	var row incomeSourceDB
+	if err := s.db.GetContext(ctx, &row, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, finance.ErrIncomeSourceNotFound
+		}
+		return nil, err
+	}
+	return row.toDomain(), nil
+}
+
+func (s *IncomeSourceStore) Update(ctx context.Context, src *finance.IncomeSource) error {
+	ds := pgDialect.Update(goqu.S("finance").Table("income_source")).
+		Set(goqu.Record{
+			"name":            src.Name,
+			"employer":        src.Employer,
+			"expected_amount": src.ExpectedAmount,
+			"currency":        string(src.Currency),
+			"cadence":         string(src.Cadence),
+			"recurrence_rule": src.RecurrenceRule,
+			"start_date":      timeToNullTime(src.StartDate),
+			"exception_dates": datesToArray(src.ExceptionDates),
+			"account_id":      incomeSourceAccountID(src),
+			"is_active":       src.IsActive,
+			"update_time":     src.UpdateTime,
+		}).
+		Where(goqu.Ex{"space_id": string(src.SpaceID), "id": string(src.ID)})
+	query, args, err := ds.Prepared(true).ToSQL()
+	if err != nil {
+		return err
+	}
+	res, err := s.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return finance.ErrIncomeSourceNotFound
+	}
+	return nil
+}
+
+func (s *IncomeSourceStore) Delete(ctx context.Context, spaceID finance.SpaceID, id finance.IncomeSourceID) error {
+	ds := pgDialect.Delete(goqu.S("finance").Table("income_source")).Where(goqu.Ex{"space_id": string(spaceID), "id": string(id)})
+	query, args, err := ds.Prepared(true).ToSQL()
+	if err != nil {
+		return err
+	}
+	res, err := s.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return finance.ErrIncomeSourceNotFound
+	}
+	return nil
+}
+
+func (s *IncomeSourceStore) ListBySpace(ctx context.Context, spaceID finance.SpaceID, filter *finance.ListIncomeSourcesFilter) (*paging.Page[*finance.IncomeSource], error) {
+	if filter.PageSize <= 0 || filter.PageSize > 100 {
+		filter.PageSize = 20
+	}
+
+	ds := pgDialect.From(goqu.S("finance").Table("income_source")).Select("*")
+	ds = ds.Where(goqu.Ex{"space_id": string(spaceID)})
+
+	if filter.ActiveOnly != nil && *filter.ActiveOnly {
+		ds = ds.Where(goqu.Ex{"is_active": true})
+	}
+
+	if filter.SearchQuery != nil && *filter.SearchQuery != "" {
+		pattern := "%" + *filter.SearchQuery + "%"
+		ds = ds.Where(goqu.Or(
+			goqu.I("name").ILike(pattern),
+			goqu.I("employer").ILike(pattern),
+		))
+	}
+
+	cursor, _ := paging.Decode(filter.NextPageToken)
+
+	sortOrder := filter.Sort
+	if !finance.IsIncomeSourceSortField(sortOrder.Field) {
+		sortOrder.Field = finance.DefaultIncomeSourceSortField
+		sortOrder.Ascending = false // default: newest first
+	}
+
+	ds = paging.ApplyPagination(ds, paging.Options{
+		Sort:     sortOrder,
+		Cursor:   cursor,
+		PageSize: uint(filter.PageSize),
+	})
+
+	query, args, err := ds.Prepared(true).ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("build sql query: %w", err)
+	}
+
+	var rows []incomeSourceDB
+	if err := s.db.SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, fmt.Errorf("select context: %w", err)
+	}
+
+	sources := make([]*finance.IncomeSource, len(rows))
+	for i := range rows {
+		sources[i] = rows[i].toDomain()
+	}
+
+	return paging.NewPage(sources, int(filter.PageSize), func(src *finance.IncomeSource) paging.Cursor {
+		return paging.Cursor{
+			SortValue: src.GetSortValue(sortOrder.Field),
+			ID:        string(src.ID),
+		}
+	}), nil
+}
@@ -28,20 +28,26 @@ type spentTrendRow struct {
 	SpentInLocal   int64     `db:"spent_in_local"`
 }
 
-func (s *InsightsStore) GetSpentTrend(ctx context.Context, filter *finance.SpentTrendFilter) ([]*finance.SpentTrend, error) {
-	var trunc string
-	switch filter.Granularity {
+// granularityTrunc maps a granularity to its PostgreSQL date_trunc field.
+func granularityTrunc(g finance.Granularity) string {
+	switch g {
 	case finance.GranularityDaily:
-		trunc = "day"
+		return "day"
 	case finance.GranularityWeekly:
-		trunc = "week"
+		return "week"
 	case finance.GranularityMonthly:
-		trunc = "month"
+		return "month"
 	case finance.GranularityYearly:
-		trunc = "year"
+		return "year"
 	default:
-		trunc = "month"
+		return "month"
 	}
+}
+
+// GetSpentTrend aggregates expense allocations per interval and budget. Split transactions
+// contribute each split line to its own budget but are counted once, on their first line.
+func (s *InsightsStore) GetSpentTrend(ctx context.Context, filter *finance.SpentTrendFilter) ([]*finance.SpentTrend, error) {
+	trunc := granularityTrunc(filter.Granularity)
 
 	query := fmt.Sprintf(`SELECT 
 		date_trunc('%s', t.effective_date) as interval_start,
@@ -49,10 +55,10 @@ func (s *InsightsStore) GetSpentTrend(ctx context.Context, filter *finance.Spent
 		COALESCE(b.name, '') as budget_name,
 		COALESCE(b.color, '') as budget_color,
 		COALESCE(b.currency, '') as budget_currency,
-		COUNT(t.id) as txn_count,
+		COUNT(t.transaction_id) FILTER (WHERE COALESCE(t.split_position, 0) = 0) as txn_count,
 		SUM(t.amount_in_base) as spent_in_base,
 		SUM(t.amount) as spent_in_local
-	FROM finance.transaction t
+	FROM finance.transaction_allocation t
 	LEFT JOIN finance.budget b ON t.budget_id = b.id
 	WHERE t.space_id = $1 AND t.type = 'EXPENSE' AND t.effective_date >= $2 AND t.effective_date <= $3
 	GROUP BY interval_start, t.budget_id, b.name, b.color, b.currency
@@ -110,7 +116,7 @@ func (s *InsightsStore) GetBudgetDistribution(ctx context.Context, filter *finan
 		SELECT 
 			COALESCE(SUM(amount_in_base), 0) as spent_in_base,
 			COALESCE(SUM(CASE WHEN currency = b.currency THEN amount ELSE 0 END), 0) as spent_in_local_matching
-		FROM finance.transaction
+		FROM finance.transaction_allocation
 		WHERE budget_id = b.id AND type = 'EXPENSE' AND effective_date >= $2 AND effective_date <= $3
 	) t ON TRUE
 	LEFT JOIN LATERAL (
@@ -164,7 +170,12 @@ func (s *InsightsStore) GetTopExpenses(ctx context.Context, filter *finance.TopE
 		t.amount,
 		t.currency,
 		t.amount_in_base,
-		COALESCE(b.name, '') as budget_name,
+		COALESCE(b.name, (
+			SELECT string_agg(sb.name, ', ' ORDER BY ts.position)
+			FROM finance.transaction_split ts
+			JOIN finance.budget sb ON ts.budget_id = sb.id
+			WHERE ts.transaction_id = t.id
+		), '') as budget_name,
 		t.transaction_date,
 		t.effective_date
 	FROM finance.transaction t
@@ -196,3 +207,130 @@ func (s *InsightsStore) GetTopExpenses(ctx context.Context, filter *finance.TopE
 	}
 	return results, nil
 }
+
+type cashFlowTrendRow struct {
+	IntervalStart time.Time `db:"interval_start"`
+	IncomeInBase  int64     `db:"income_in_base"`
+	ExpenseInBase int64     `db:"expense_in_base"`
+	CardSpending  int64     `db:"card_spending_in_base"`
+	CardPayments  int64     `db:"card_payments_in_base"`
+}
+
+func (s *InsightsStore) GetCashFlowTrend(ctx context.Context, filter *finance.CashFlowTrendFilter) ([]*finance.CashFlowTrend, error) {
+	query := fmt.Sprintf(`SELECT 
+		date_trunc('%s', t.effective_date) as interval_start,
+		COALESCE(SUM(CASE WHEN t.type = 'INCOME' THEN t.amount_in_base ELSE 0 END), 0) as income_in_base,
+		COALESCE(SUM(CASE WHEN t.type = 'EXPENSE' THEN t.amount_in_base ELSE 0 END), 0) as expense_in_base,
+		COALESCE(SUM(CASE WHEN t.type = 'EXPENSE' AND a.type = 'CREDIT_CARD' THEN t.amount_in_base ELSE 0 END), 0) as card_spending_in_base,
+		COALESCE(SUM(CASE WHEN t.type = 'TRANSFER_IN' AND a.type = 'CREDIT_CARD' THEN t.amount_in_base ELSE 0 END), 0) as card_payments_in_base
+	FROM finance.transaction t
+	LEFT JOIN finance.account a ON t.account_id = a.id
+	WHERE t.space_id = $1 AND t.effective_date >= $2 AND t.effective_date <= $3
+		AND (t.type IN ('INCOME', 'EXPENSE') OR (t.type = 'TRANSFER_IN' AND a.type = 'CREDIT_CARD'))
+	GROUP BY interval_start
+	ORDER BY interval_start ASC`, granularityTrunc(filter.Granularity))
+
+	startDateStr := filter.StartDate.UTC().Format("2006-01-02")
+	endDateStr := filter.EndDate.UTC().Format("2006-01-02")
+
+	var rows []*cashFlowTrendRow
+	if err := s.db.SelectContext(ctx, &rows, query, string(filter.SpaceID), startDateStr, endDateStr); err != nil {
+		return nil, err
+	}
+
+	results := make([]*finance.CashFlowTrend, len(rows))
+	for i, r := range rows {
+		results[i] = &finance.CashFlowTrend{
+			IntervalStart: r.IntervalStart,
+			IncomeInBase:  r.IncomeInBase,
+			ExpenseInBase: r.ExpenseInBase,
+			CardSpending:  r.CardSpending,
+			CardPayments:  r.CardPayments,
+		}
+	}
+	return results, nil
+}
+
+type categoryDistributionRow struct {
+	CategoryID    string `db:"category_id"`
+	ParentID      string `db:"parent_id"`
+	CategoryName  string `db:"category_name"`
+	CategoryColor string `db:"category_color"`
+	CategoryIcon  string `db:"category_icon"`
+	TxnCount      int32  `db:"txn_count"`
+	SpentInBase   int64  `db:"spent_in_base"`
+}
+
+func (s *InsightsStore) GetCategoryDistribution(ctx context.Context, filter *finance.CategoryDistributionFilter) ([]*finance.CategoryDistribution, error) {
+	query := `SELECT 
+		COALESCE(t.category_id, '') as category_id,
+		COALESCE(c.parent_id, '') as parent_id,
+		COALESCE(c.name, '') as category_name,
+		COALESCE(c.color, '') as category_color,
+		COALESCE(c.icon, '') as category_icon,
+		COUNT(DISTINCT t.transaction_id) as txn_count,
+		SUM(t.amount_in_base) as spent_in_base
+	FROM finance.transaction_allocation t
+	LEFT JOIN finance.category c ON t.category_id = c.id
+	WHERE t.space_id = $1 AND t.type = 'EXPENSE' AND t.effective_date >= $2 AND t.effective_date <= $3
+	GROUP BY t.category_id, c.parent_id, c.name, c.color, c.icon
+	ORDER BY spent_in_base DESC`
+
+	startDateStr := filter.StartDate.UTC().Format("2006-01-02")
+	endDateStr := filter.EndDate.UTC().Format("2006-01-02")
+
+	var rows []*categoryDistributionRow
+	if err := s.db.SelectContext(ctx, &rows, query, string(filter.SpaceID), startDateStr, endDateStr); err != nil {
+		return nil, err
+	}
+
+	results := make([]*finance.CategoryDistribution, len(rows))
+	for i, r := range rows {
+		results[i] = &finance.CategoryDistribution{
+			CategoryID:    r.CategoryID,
+			ParentID:      r.ParentID,
+			CategoryName:  r.CategoryName,
+			CategoryColor: r.CategoryColor,
+			CategoryIcon:  r.CategoryIcon,
+			TxnCount:      r.TxnCount,
+			SpentInBase:   r.SpentInBase,
+		}
+	}
+	return results, nil
+}
+
+type tagDistributionRow struct {
+	Tag         string `db:"tag"`
+	TxnCount    int32  `db:"txn_count"`
+	SpentInBase int64  `db:"spent_in_base"`
+}
+
+func (s *InsightsStore) GetTagDistribution(ctx context.Context, filter *finance.TagDistributionFilter) ([]*finance.TagDistribution, error) {
+	query := `SELECT 
+		tag,
+		COUNT(t.id) as txn_count,
+		SUM(t.amount_in_base) as spent_in_base
+	FROM finance.transaction t
+	CROSS JOIN LATERAL unnest(t.tags) as tag
+	WHERE t.space_id = $1 AND t.type = 'EXPENSE' AND t.effective_date >= $2 AND t.effective_date <= $3
+	GROUP BY tag
+	ORDER BY spent_in_base DESC`
+
+	startDateStr := filter.StartDate.UTC().Format("2006-01-02")
+	endDateStr := filter.EndDate.UTC().Format("2006-01-02")
+
+	var rows []*tagDistributionRow
+	if err := s.db.SelectContext(ctx, &rows, query, string(filter.SpaceID), startDateStr, endDateStr); err != nil {
+		return nil, err
+	}
+
+	results := make([]*finance.TagDistribution, len(rows))
+	for i, r := range rows {
+		results[i] = &finance.TagDistribution{
+			Tag:         r.Tag,
+			TxnCount:    r.TxnCount,
+			SpentInBase: r.SpentInBase,
+		}
+	}
+	return results, nil
+}
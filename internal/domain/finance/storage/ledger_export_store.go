@@ -0,0 +1,215 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/jmoiron/sqlx"
+	"github.com/masterkeysrd/saturn/internal/domain/finance"
+	"github.com/masterkeysrd/saturn/internal/platform/paging"
+)
+
+// ledgerExportColumns lists every column except the file content, which is only read by GetContent.
+var ledgerExportColumns = []any{
+	"id", "space_id", "format", "start_date", "end_date", "status", "file_name", "content_type",
+	"size_bytes", "entry_count", "error", "create_time", "update_time", "complete_time",
+}
+
+type ledgerExportDB struct {
+	ID           string       `db:"id"`
+	SpaceID      string       `db:"space_id"`
+	Format       string       `db:"format"`
+	StartDate    time.Time    `db:"start_date"`
+	EndDate      time.Time    `db:"end_date"`
+	Status       string       `db:"status"`
+	FileName     string       `db:"file_name"`
+	ContentType  string       `db:"content_type"`
+	SizeBytes    int64        `db:"size_bytes"`
+	EntryCount   int64        `db:"entry_count"`
+	Error        string       `db:"error"`
+	CreateTime   sql.NullTime `db:"create_time"`
+	UpdateTime   sql.NullTime `db:"update_time"`
+	CompleteTime sql.NullTime `db:"complete_time"`
+}
+
+func (row *ledgerExportDB) toDomain() *finance.LedgerExport {
+	var completeTime *time.Time
+	if row.CompleteTime.Valid {
+		t := row.CompleteTime.Time
+		completeTime = &t
+	}
+	sy, sm, sd := row.StartDate.Date()
+	ey, em, ed := row.EndDate.Date()
+	return &finance.LedgerExport{
+		ID:           finance.LedgerExportID(row.ID),
+		SpaceID:      finance.SpaceID(row.SpaceID),
+		Format:       finance.LedgerFormat(row.Format),
+		StartDate:    time.Date(sy, sm, sd, 0, 0, 0, 0, time.UTC),
+		EndDate:      time.Date(ey, em, ed, 0, 0, 0, 0, time.UTC),
+		Status:       finance.LedgerExportStatus(row.Status),
+		FileName:     row.FileName,
+		ContentType:  row.ContentType,
+		SizeBytes:    row.SizeBytes,
+		EntryCount:   row.EntryCount,
+		Error:        row.Error,
+		CreateTime:   nullTimeToTime(row.CreateTime),
+		UpdateTime:   nullTimeToTime(row.UpdateTime),
+		CompleteTime: completeTime,
+	}
+}
+
+type LedgerExportStore struct {
+	db *sqlx.DB
+}
+
+func NewLedgerExportStore(db *sqlx.DB) *LedgerExportStore {
+	return &LedgerExportStore{db: db}
+}
+
+func (s *LedgerExportStore) Create(ctx context.Context, e *finance.LedgerExport) error {
+	ds := pgDialect.Insert(goqu.S("finance").Table("ledger_export")).Rows(goqu.Record{
+		"id":            string(e.ID),
+		"space_id":      string(e.SpaceID),
+		"format":        string(e.Format),
+		"start_date":    e.StartDate,
+		"end_date":      e.EndDate,
+		"status":        string(e.Status),
+		"file_name":     e.FileName,
+		"content_type":  e.ContentType,
+		"size_bytes":    e.SizeBytes,
+		"entry_count":   e.EntryCount,
+		"error":         e.Error,
+		"create_time":   e.CreateTime,
+		"update_time":   e.UpdateTime,
+		"complete_time": e.CompleteTime,
+	})
+	query, args, err := ds.Prepared(true).ToSQL()
+	if err != nil {
+		return err
+	}
+	_, err = s.db.ExecContext(ctx, query, args...)
+	return err
+}
+
+func (s *LedgerExportStore) GetByID(ctx context.Context, spaceID finance.SpaceID, id finance.LedgerExportID) (*finance.LedgerExport, error) {
+	ds := pgDialect.From(goqu.S("finance").Table("ledger_export")).Select(ledgerExportColumns...).Where(goqu.Ex{"space_id": string(spaceID), "id": string(id)})
+	query, args, err := ds.Prepared(true).ToSQL()
+	if err != nil {
+		return nil, err
+	}
+	var row ledgerExportDB
+	if err := s.db.GetContext(ctx, &row, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, finance.ErrLedgerExportNotFound
+		}
+		return nil, err
+	}
+	return row.toDomain(), nil
+}
+
+func (s *LedgerExportStore) Update(ctx context.Context, e *finance.LedgerExport) error {
+	ds := pgDialect.Update(goqu.S("finance").Table("ledger_export")).
+		Set(goqu.Record{
+			"status":        string(e.Status),
+			"file_name":     e.FileName,
+			"content_type":  e.ContentType,
+			"size_bytes":    e.SizeBytes,
+			"entry_count":   e.EntryCount,
+			"error":         e.Error,
+			"update_time":   e.UpdateTime,
+			"complete_time": e.CompleteTime,
+		}).
+		Where(goqu.Ex{"space_id": string(e.SpaceID), "id": string(e.ID)})
+	return s.execOne(ctx, ds)
+}
+
+func (s *LedgerExportStore) SaveContent(ctx context.Context, spaceID finance.SpaceID, id finance.LedgerExportID, content []byte) error {
+	ds := pgDialect.Update(goqu.S("finance").Table("ledger_export")).
+		Set(goqu.Record{"content": content}).
+		Where(goqu.Ex{"space_id": string(spaceID), "id": string(id)})
+	return s.execOne(ctx, ds)
+}
+
+func (s *LedgerExportStore) GetContent(ctx context.Context, spaceID finance.SpaceID, id finance.LedgerExportID) ([]byte, error) {
+	ds := pgDialect.From(goqu.S("finance").Table("ledger_export")).Select("content").Where(goqu.Ex{"space_id": string(spaceID), "id": string(id)})
+	query, args, err := ds.Prepared(true).ToSQL()
+	if err != nil {
+		return nil, err
+	}
+	var content []byte
+	if err := s.db.GetContext(ctx, &content, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, finance.ErrLedgerExportNotFound
+		}
+		return nil, err
+	}
+	return content, nil
+}
+
+func (s *LedgerExportStore) execOne(ctx context.Context, ds *goqu.UpdateDataset) error {
+	query, args, err := ds.Prepared(true).ToSQL()
+	if err != nil {
+		return err
+	}
+	res, err := s.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return finance.ErrLedgerExportNotFound
+	}
+	return nil
+}
+
+func (s *LedgerExportStore) ListBySpace(ctx context.Context, spaceID finance.SpaceID, filter *finance.ListLedgerExportsFilter) (*paging.Page[*finance.LedgerExport], error) {
+	if filter.PageSize <= 0 || filter.PageSize > 100 {
+		filter.PageSize = 50
+	}
+
+	ds := pgDialect.From(goqu.S("finance").Table("ledger_export")).Select(ledgerExportColumns...)
+	ds = ds.Where(goqu.Ex{"space_id": string(spaceID)})
+
+	cursor, _ := paging.Decode(filter.NextPageToken)
+
+	sortOrder := filter.Sort
+	if !finance.IsLedgerExportSortField(sortOrder.Field) {
+		sortOrder.Field = finance.DefaultLedgerExportSortField
+		sortOrder.Ascending = false
+	}
+
+	ds = paging.ApplyPagination(ds, paging.Options{
+		Sort:     sortOrder,
+		Cursor:   cursor,
+		PageSize: uint(filter.PageSize),
+	})
+
+	query, args, err := ds.Prepared(true).ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("build sql query: %w", err)
+	}
+
+	var rows []ledgerExportDB
+	if err := s.db.SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, fmt.Errorf("select context: %w", err)
+	}
+
+	exports := make([]*finance.LedgerExport, len(rows))
+	for i := range rows {
+		exports[i] = rows[i].toDomain()
+	}
+
+	return paging.NewPage(exports, int(filter.PageSize), func(e *finance.LedgerExport) paging.Cursor {
+		return paging.Cursor{
+			SortValue: e.GetSortValue(sortOrder.Field),
+			ID:        string(e.ID),
+		}
+	}), nil
+}
@@ -0,0 +1,190 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/masterkeysrd/saturn/internal/domain/finance"
+	"github.com/masterkeysrd/saturn/internal/platform/conv"
+	"github.com/masterkeysrd/saturn/internal/platform/paging"
+)
+
+type payeeDB struct {
+	ID               string         `db:"id"`
+	SpaceID          string         `db:"space_id"`
+	Name             string         `db:"name"`
+	Aliases          pq.StringArray `db:"aliases"`
+	Patterns         pq.StringArray `db:"patterns"`
+	DefaultBudgetID  sql.NullString `db:"default_budget_id"`
+	DefaultAccountID sql.NullString `db:"default_account_id"`
+	LogoURL          string         `db:"logo_url"`
+	CreateTime       sql.NullTime   `db:"create_time"`
+	UpdateTime       sql.NullTime   `db:"update_time"`
+}
+
+func (row *payeeDB) toDomain() *finance.Payee {
+	p := &finance.Payee{
+		ID:         finance.PayeeID(row.ID),
+		SpaceID:    finance.SpaceID(row.SpaceID),
+		Name:       row.Name,
+		Aliases:    []string(row.Aliases),
+		Patterns:   []string(row.Patterns),
+		LogoURL:    row.LogoURL,
+		CreateTime: nullTimeToTime(row.CreateTime),
+		UpdateTime: nullTimeToTime(row.UpdateTime),
+	}
+	if row.DefaultBudgetID.Valid {
+		bID := finance.BudgetID(row.DefaultBudgetID.String)
+		p.DefaultBudgetID = &bID
+	}
+	if row.DefaultAccountID.Valid {
+		aID := finance.AccountID(row.DefaultAccountID.String)
+		p.DefaultAccountID = &aID
+	}
+	return p
+}
+
+type PayeeStore struct {
+	db *sqlx.DB
+}
+
+func NewPayeeStore(db *sqlx.DB) *PayeeStore {
+	return &PayeeStore{db: db}
+}
+
+func (s *PayeeStore) Create(ctx context.Context, p *finance.Payee) error {
+	ds := pgDialect.Insert(goqu.S("finance").Table("payee")).Rows(goqu.Record{
+		"id":                 string(p.ID),
+		"space_id":           string(p.SpaceID),
+		"name":               p.Name,
+		"aliases":            stringsToArray(p.Aliases),
+		"patterns":           stringsToArray(p.Patterns),
+		"default_budget_id":  conv.StringPtr(p.DefaultBudgetID),
+		"default_account_id": conv.StringPtr(p.DefaultAccountID),
+		"logo_url":           p.LogoURL,
+		"create_time":        p.CreateTime,
+		"update_time":        p.UpdateTime,
+	})
+	query, args, err := ds.Prepared(true).ToSQL()
+	if err != nil {
+		return err
+	}
+	_, err = s.db.ExecContext(ctx, query, args...)
+	return err
+}
+
+func (s *PayeeStore) GetByID(ctx context.Context, spaceID finance.SpaceID, id finance.PayeeID) (*finance.Payee, error) {
+	ds := pgDialect.From(goqu.S("finance").Table("payee")).Select("*").Where(goqu.Ex{"space_id": string(spaceID), "id": string(id)})
+	query, args, err := ds.Prepared(true).ToSQL()
+	if err != nil {
+		return nil, err
+	}
+	var row payeeDB
+	if err := s.db.GetContext(ctx, &row, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, finance.ErrPayeeNotFound
+		}
+		return nil, err
+	}
+	return row.toDomain(), nil
+}
+
+func (s *PayeeStore) Update(ctx context.Context, p *finance.Payee) error {
+	ds := pgDialect.Update(goqu.S("finance").Table("payee")).
+		Set(goqu.Record{
+			"name":               p.Name,
+			"aliases":            stringsToArray(p.Aliases),
+			"patterns":           stringsToArray(p.Patterns),
+			"default_budget_id":  conv.StringPtr(p.DefaultBudgetID),
+			"default_account_id": conv.StringPtr(p.DefaultAccountID),
+			"logo_url":           p.LogoURL,
+			"update_time":        p.UpdateTime,
+		}).
+		Where(goqu.Ex{"space_id": string(p.SpaceID), "id": string(p.ID)})
+	query, args, err := ds.Prepared(true).ToSQL()
+	if err != nil {
+		return err
+	}
+	res, err := s.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return finance.ErrPayeeNotFound
+	}
+	return nil
+}
+
+func (s *PayeeStore) Delete(ctx context.Context, spaceID finance.SpaceID, id finance.PayeeID) error {
+	ds := pgDialect.Delete(goqu.S("finance").Table("payee")).Where(goqu.Ex{"space_id": string(spaceID), "id": string(id)})
+	query, args, err := ds.Prepared(true).ToSQL()
+	if err != nil {
+		return err
+	}
+	res, err := s.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return finance.ErrPayeeNotFound
+	}
+	return nil
+}
+
+func (s *PayeeStore) ListBySpace(ctx context.Context, spaceID finance.SpaceID, filter *finance.ListPayeesFilter) (*paging.Page[*finance.Payee], error) {
+	if filter.PageSize <= 0 || filter.PageSize > 100 {
+		filter.PageSize = 50
+	}
+
+	ds := pgDialect.From(goqu.S("finance").Table("payee")).Select("*")
+	ds = ds.Where(goqu.Ex{"space_id": string(spaceID)})
+
+	cursor, _ := paging.Decode(filter.NextPageToken)
+
+	sortOrder := filter.Sort
+	if !finance.IsPayeeSortField(sortOrder.Field) {
+		sortOrder.Field = finance.DefaultPayeeSortField
+		sortOrder.Ascending = true
+	}
+
+	ds = paging.ApplyPagination(ds, paging.Options{
+		Sort:     sortOrder,
+		Cursor:   cursor,
+		PageSize: uint(filter.PageSize),
+	})
+
+	query, args, err := ds.Prepared(true).ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("build sql query: %w", err)
+	}
+
+	var rows []payeeDB
+	if err := s.db.SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, fmt.Errorf("select context: %w", err)
+	}
+
+	payees := make([]*finance.Payee, len(rows))
+	for i := range rows {
+		payees[i] = rows[i].toDomain()
+	}
+
+	return paging.NewPage(payees, int(filter.PageSize), func(p *finance.Payee) paging.Cursor {
+		return paging.Cursor{
+			SortValue: p.GetSortValue(sortOrder.Field),
+			ID:        string(p.ID),
+		}
+	}), nil
+}
@@ -21,6 +21,8 @@ type periodDB struct {
 	Currency           string       `db:"currency"`
 	BaseCurrency       string       `db:"base_currency"`
 	ExchangeRateToBase float64      `db:"exchange_rate_to_base"`
+	CarriedIn          int64        `db:"carried_in"`
+	CarriedOut         int64        `db:"carried_out"`
 	CreateTime         sql.NullTime `db:"create_time"`
 	UpdateTime         sql.NullTime `db:"update_time"`
 }
@@ -36,6 +38,8 @@ func (row *periodDB) toDomain() *finance.BudgetPeriod {
 		Currency:           finance.Currency(row.Currency),
 		BaseCurrency:       finance.Currency(row.BaseCurrency),
 		ExchangeRateToBase: row.ExchangeRateToBase,
+		CarriedIn:          row.CarriedIn,
+		CarriedOut:         row.CarriedOut,
 		CreateTime:         nullTimeToTime(row.CreateTime),
 		UpdateTime:         nullTimeToTime(row.UpdateTime),
 	}
@@ -50,16 +54,22 @@ func NewPeriodStore(db *sqlx.DB) *PeriodStore {
 }
 
 func (s *PeriodStore) Create(ctx context.Context, p *finance.BudgetPeriod) error {
-	query := `INSERT INTO finance.budget_period (id, budget_id, space_id, start_date, end_date, limit_amount, currency, base_currency, exchange_rate_to_base, create_time, update_time)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`
-	_, err := s.db.ExecContext(ctx, query, string(p.ID), string(p.BudgetID), string(p.SpaceID), p.StartDate, p.EndDate, p.LimitAmount, p.Currency, p.BaseCurrency, p.ExchangeRateToBase, p.CreateTime, p.UpdateTime)
+	return insertBudgetPeriod(ctx, s.db, p)
+}
+
+// insertBudgetPeriod stores a new period, either directly or as part of a larger database transaction.
+func insertBudgetPeriod(ctx context.Context, db sqlx.ExecerContext, p *finance.BudgetPeriod) error {
+	query := `INSERT INTO finance.budget_period (id, budget_id, space_id, start_date, end_date, limit_amount, currency, base_currency, exchange_rate_to_base, carried_in, carried_out, create_time, update_time)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)`
+	_, err := db.ExecContext(ctx, query, string(p.ID), string(p.BudgetID), string(p.SpaceID), p.StartDate, p.EndDate, p.LimitAmount, p.Currency, p.BaseCurrency, p.ExchangeRateToBase, p.CarriedIn, p.CarriedOut, p.CreateTime, p.UpdateTime)
 	return err
 }
 
-func (s *PeriodStore) GetByRange(ctx context.Context, budgetID finance.BudgetID, startDate, endDate time.Time) (*finance.BudgetPeriod, error) {
+// GetByDate returns the period of the budget that contains date.
+func (s *PeriodStore) GetByDate(ctx context.Context, budgetID finance.BudgetID, date time.Time) (*finance.BudgetPeriod, error) {
 	var row periodDB
-	query := `SELECT * FROM finance.budget_period WHERE budget_id = $1 AND start_date = $2 AND end_date = $3`
-	if err := s.db.GetContext(ctx, &row, query, string(budgetID), startDate, endDate); err != nil {
+	query := `SELECT * FROM finance.budget_period WHERE budget_id = $1 AND start_date <= $2 AND end_date >= $2`
+	if err := s.db.GetContext(ctx, &row, query, string(budgetID), date); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, finance.ErrPeriodNotFound
 		}
@@ -68,22 +78,23 @@ func (s *PeriodStore) GetByRange(ctx context.Context, budgetID finance.BudgetID,
 	return row.toDomain(), nil
 }
 
-func (s *PeriodStore) GetByRanges(ctx context.Context, keys []finance.PeriodRangeKey) ([]*finance.BudgetPeriod, error) {
-	if len(keys) == 0 {
+// GetByDates returns, for each budget that has one, the period that contains date.
+func (s *PeriodStore) GetByDates(ctx context.Context, budgetIDs []finance.BudgetID, date time.Time) ([]*finance.BudgetPeriod, error) {
+	if len(budgetIDs) == 0 {
 		return nil, nil
 	}
 
-	var orExprs []goqu.Expression
-	for _, key := range keys {
-		orExprs = append(orExprs, goqu.And(
-			goqu.C("budget_id").Eq(string(key.BudgetID)),
-			goqu.C("start_date").Eq(key.StartDate),
-			goqu.C("end_date").Eq(key.EndDate),
-		))
+	ids := make([]string, len(budgetIDs))
+	for i, id := range budgetIDs {
+		ids[i] = string(id)
 	}
 
 	ds := pgDialect.From(goqu.S("finance").Table("budget_period")).
-		Where(goqu.Or(orExprs...))
+		Where(
+			goqu.C("budget_id").In(ids),
+			goqu.C("start_date").Lte(date),
+			goqu.C("end_date").Gte(date),
+		)
 	sqlStr, args, err := ds.ToSQL()
 	if err != nil {
 		return nil, err
@@ -101,6 +112,21 @@ func (s *PeriodStore) GetByRanges(ctx context.Context, keys []finance.PeriodRang
 	return periods, nil
 }
 
+// ListByRange returns the periods of the budget that overlap [startDate, endDate], oldest first.
+func (s *PeriodStore) ListByRange(ctx context.Context, budgetID finance.BudgetID, startDate, endDate time.Time) ([]*finance.BudgetPeriod, error) {
+	var rows []periodDB
+	query := `SELECT * FROM finance.budget_period WHERE budget_id = $1 AND start_date <= $3 AND end_date >= $2 ORDER BY start_date`
+	if err := s.db.SelectContext(ctx, &rows, query, string(budgetID), startDate, endDate); err != nil {
+		return nil, err
+	}
+
+	periods := make([]*finance.BudgetPeriod, len(rows))
+	for i := range rows {
+		periods[i] = rows[i].toDomain()
+	}
+	return periods, nil
+}
+
 func (s *PeriodStore) UpdateLimit(ctx context.Context, id finance.PeriodID, limit int64) error {
 	query := `UPDATE finance.budget_period SET limit_amount = $1, update_time = NOW() WHERE id = $2`
 	res, err := s.db.ExecContext(ctx, query, limit, string(id))
@@ -117,6 +143,22 @@ func (s *PeriodStore) UpdateLimit(ctx context.Context, id finance.PeriodID, limi
 	return nil
 }
 
+func (s *PeriodStore) UpdateCarry(ctx context.Context, id finance.PeriodID, carriedIn, carriedOut int64) error {
+	query := `UPDATE finance.budget_period SET carried_in = $1, carried_out = $2, update_time = NOW() WHERE id = $3`
+	res, err := s.db.ExecContext(ctx, query, carriedIn, carriedOut, string(id))
+	if err != nil {
+		return err
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return finance.ErrPeriodNotFound
+	}
+	return nil
+}
+
 func (s *PeriodStore) ListByBudget(ctx context.Context, budgetID finance.BudgetID) ([]*finance.BudgetPeriod, error) {
 	var rows []periodDB
 	query := `SELECT * FROM finance.budget_period WHERE budget_id = $1 ORDER BY start_date DESC`
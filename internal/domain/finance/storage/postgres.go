@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/doug-martin/goqu/v9"
+	"github.com/lib/pq"
 )
 
 var pgDialect = goqu.Dialect("postgres")
@@ -21,3 +22,34 @@ func nullTimeToTime(nt sql.NullTime) time.Time {
 	}
 	return nt.Time
 }
+
+// datesToArray converts calendar dates to a value for a DATE[] column.
+func datesToArray(dates []time.Time) pq.StringArray {
+	out := make(pq.StringArray, len(dates))
+	for i, d := range dates {
+		out[i] = d.Format(time.DateOnly)
+	}
+	return out
+}
+
+// arrayToDates converts a scanned DATE[] column to calendar dates at UTC midnight.
+func arrayToDates(arr pq.StringArray) []time.Time {
+	if len(arr) == 0 {
+		return nil
+	}
+	out := make([]time.Time, 0, len(arr))
+	for _, s := range arr {
+		if d, err := time.Parse(time.DateOnly, s); err == nil {
+			out = append(out, d)
+		}
+	}
+	return out
+}
+
+// stringsToArray converts a string slice to a value for a TEXT[] column, storing nil as an empty array.
+func stringsToArray(values []string) pq.StringArray {
+	if values == nil {
+		return pq.StringArray{}
+	}
+	return pq.StringArray(values)
+}
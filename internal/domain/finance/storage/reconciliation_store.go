@@ -0,0 +1,185 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/jmoiron/sqlx"
+	"github.com/masterkeysrd/saturn/internal/domain/finance"
+	"github.com/masterkeysrd/saturn/internal/platform/conv"
+)
+
+type reconciliationDB struct {
+	ID                      string         `db:"id"`
+	SpaceID                 string         `db:"space_id"`
+	AccountID               string         `db:"account_id"`
+	StatementDate           time.Time      `db:"statement_date"`
+	StatementBalance        int64          `db:"statement_balance"`
+	OpeningBalance          int64          `db:"opening_balance"`
+	ClearedBalance          int64          `db:"cleared_balance"`
+	Difference              int64          `db:"difference"`
+	ClearedCount            int32          `db:"cleared_count"`
+	AdjustmentTransactionID sql.NullString `db:"adjustment_transaction_id"`
+	Status                  string         `db:"status"`
+	Note                    string         `db:"note"`
+	FinalizeTime            sql.NullTime   `db:"finalize_time"`
+	CreateTime              sql.NullTime   `db:"create_time"`
+	UpdateTime              sql.NullTime   `db:"update_time"`
+}
+
+func (row *reconciliationDB) toDomain() *finance.Reconciliation {
+	var adjustmentID *finance.TransactionID
+	if row.AdjustmentTransactionID.Valid {
+		tID := finance.TransactionID(row.AdjustmentTransactionID.String)
+		adjustmentID = &tID
+	}
+	var finalizeTime *time.Time
+	if row.FinalizeTime.Valid {
+		t := row.FinalizeTime.Time
+		finalizeTime = &t
+	}
+	y, m, d := row.StatementDate.Date()
+	return &finance.Reconciliation{
+		ID:                      finance.ReconciliationID(row.ID),
+		SpaceID:                 finance.SpaceID(row.SpaceID),
+		AccountID:               finance.AccountID(row.AccountID),
+		StatementDate:           time.Date(y, m, d, 0, 0, 0, 0, time.UTC),
+		StatementBalance:        row.StatementBalance,
+		OpeningBalance:          row.OpeningBalance,
+		ClearedBalance:          row.ClearedBalance,
+		Difference:              row.Difference,
+		ClearedCount:            row.ClearedCount,
+		AdjustmentTransactionID: adjustmentID,
+		Status:                  finance.ReconciliationStatus(row.Status),
+		Note:                    row.Note,
+		FinalizeTime:            finalizeTime,
+		CreateTime:              nullTimeToTime(row.CreateTime),
+		UpdateTime:              nullTimeToTime(row.UpdateTime),
+	}
+}
+
+type ReconciliationStore struct {
+	db *sqlx.DB
+}
+
+func NewReconciliationStore(db *sqlx.DB) *ReconciliationStore {
+	return &ReconciliationStore{db: db}
+}
+
+func (s *ReconciliationStore) Create(ctx context.Context, r *finance.Reconciliation) error {
+	ds := pgDialect.Insert(goqu.S("finance").Table("reconciliation")).Rows(goqu.Record{
+		"id":                        string(r.ID),
+		"space_id":                  string(r.SpaceID),
+		"account_id":                string(r.AccountID),
+		"statement_date":            r.StatementDate.Format("2006-01-02"),
+		"statement_balance":         r.StatementBalance,
+		"opening_balance":           r.OpeningBalance,
+		"cleared_balance":           r.ClearedBalance,
+		"difference":                r.Difference,
+		"cleared_count":             r.ClearedCount,
+		"adjustment_transaction_id": conv.StringPtr(r.AdjustmentTransactionID),
+		"status":                    string(r.Status),
+		"note":                      r.Note,
+		"finalize_time":             r.FinalizeTime,
+		"create_time":               r.CreateTime,
+		"update_time":               r.UpdateTime,
+	})
+	query, args, err := ds.Prepared(true).ToSQL()
+	if err != nil {
+		return err
+	}
+	_, err = s.db.ExecContext(ctx, query, args...)
+	return err
+}
+
+func (s *ReconciliationStore) GetByID(ctx context.Context, spaceID finance.SpaceID, id finance.ReconciliationID) (*finance.Reconciliation, error) {
+	ds := pgDialect.From(goqu.S("finance").Table("reconciliation")).Select("*").Where(goqu.Ex{"space_id": string(spaceID), "id": string(id)})
+	query, args, err := ds.Prepared(true).ToSQL()
+	if err != nil {
+		return nil, err
+	}
+	var row reconciliationDB
+	if err := s.db.GetContext(ctx, &row, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, finance.ErrReconciliationNotFound
+		}
+		return nil, err
+	}
+	return row.toDomain(), nil
+}
+
+func (s *ReconciliationStore) Update(ctx context.Context, r *finance.Reconciliation) error {
+	ds := pgDialect.Update(goqu.S("finance").Table("reconciliation")).
+		Set(goqu.Record{
+			"cleared_balance":           r.ClearedBalance,
+			"difference":                r.Difference,
+			"cleared_count":             r.ClearedCount,
+			"adjustment_transaction_id": conv.StringPtr(r.AdjustmentTransactionID),
+			"status":                    string(r.Status),
+			"note":                      r.Note,
+			"finalize_time":             r.FinalizeTime,
+			"update_time":               r.UpdateTime,
+		}).
+		Where(goqu.Ex{"space_id": string(r.SpaceID), "id": string(r.ID)})
+	query, args, err := ds.Prepared(true).ToSQL()
+	if err != nil {
+		return err
+	}
+	res, err := s.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return finance.ErrReconciliationNotFound
+	}
+	return nil
+}
+
+func (s *ReconciliationStore) Delete(ctx context.Context, spaceID finance.SpaceID, id finance.ReconciliationID) error {
+	ds := pgDialect.Delete(goqu.S("finance").Table("reconciliation")).Where(goqu.Ex{"space_id": string(spaceID), "id": string(id)})
+	query, args, err := ds.Prepared(true).ToSQL()
+	if err != nil {
+		return err
+	}
+	res, err := s.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return finance.ErrReconciliationNotFound
+	}
+	return nil
+}
+
+func (s *ReconciliationStore) ListByAccount(ctx context.Context, spaceID finance.SpaceID, accountID finance.AccountID) ([]*finance.Reconciliation, error) {
+	ds := pgDialect.From(goqu.S("finance").Table("reconciliation")).Select("*").
+		Where(goqu.Ex{"space_id": string(spaceID), "account_id": string(accountID)}).
+		Order(goqu.I("statement_date").Desc(), goqu.I("create_time").Desc())
+	query, args, err := ds.Prepared(true).ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("build sql query: %w", err)
+	}
+
+	var rows []reconciliationDB
+	if err := s.db.SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, fmt.Errorf("select context: %w", err)
+	}
+
+	out := make([]*finance.Reconciliation, len(rows))
+	for i := range rows {
+		out[i] = rows[i].toDomain()
+	}
+	return out, nil
+}
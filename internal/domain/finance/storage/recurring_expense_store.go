@@ -8,24 +8,28 @@ import (
 
 	"github.com/doug-martin/goqu/v9"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 	"github.com/masterkeysrd/saturn/internal/domain/finance"
 	"github.com/masterkeysrd/saturn/internal/platform/paging"
 )
 
 type recurringExpenseDB struct {
-	ID              string       `db:"id"`
-	SpaceID         string       `db:"space_id"`
-	BudgetID        string       `db:"budget_id"`
-	Name            string       `db:"name"`
-	Amount          int64        `db:"amount"`
-	Currency        string       `db:"currency"`
-	Interval        string       `db:"interval"`
-	NextDueDate     time.Time    `db:"next_due_date"`
-	IsVariable      bool         `db:"is_variable"`
-	Status          string       `db:"status"`
-	GracePeriodDays int32        `db:"grace_period_days"`
-	CreateTime      sql.NullTime `db:"create_time"`
-	UpdateTime      sql.NullTime `db:"update_time"`
+	ID              string         `db:"id"`
+	SpaceID         string         `db:"space_id"`
+	BudgetID        string         `db:"budget_id"`
+	Name            string         `db:"name"`
+	Amount          int64          `db:"amount"`
+	Currency        string         `db:"currency"`
+	Interval        string         `db:"interval"`
+	RecurrenceRule  string         `db:"recurrence_rule"`
+	StartDate       time.Time      `db:"start_date"`
+	ExceptionDates  pq.StringArray `db:"exception_dates"`
+	NextDueDate     time.Time      `db:"next_due_date"`
+	IsVariable      bool           `db:"is_variable"`
+	Status          string         `db:"status"`
+	GracePeriodDays int32          `db:"grace_period_days"`
+	CreateTime      sql.NullTime   `db:"create_time"`
+	UpdateTime      sql.NullTime   `db:"update_time"`
 }
 
 func (r *recurringExpenseDB) toDomain() *finance.RecurringExpense {
@@ -37,6 +41,9 @@ func (r *recurringExpenseDB) toDomain() *finance.RecurringExpense {
 		Amount:          r.Amount,
 		Currency:        finance.Currency(r.Currency),
 		Interval:        r.Interval,
+		RecurrenceRule:  r.RecurrenceRule,
+		StartDate:       r.StartDate,
+		ExceptionDates:  arrayToDates(r.ExceptionDates),
 		NextDueDate:     r.NextDueDate,
 		IsVariable:      r.IsVariable,
 		Status:          finance.RecurringExpenseStatus(r.Status),
@@ -55,11 +62,12 @@ func NewRecurringExpenseStore(db *sqlx.DB) *RecurringExpenseStore {
 }
 
 func (s *RecurringExpenseStore) Create(ctx context.Context, re *finance.RecurringExpense) error {
-	query := `INSERT INTO finance.recurring_expense (id, space_id, budget_id, name, amount, currency, interval, next_due_date, is_variable, status, grace_period_days, create_time, update_time)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)`
+	query := `INSERT INTO finance.recurring_expense (id, space_id, budget_id, name, amount, currency, interval, next_due_date, is_variable, status, grace_period_days, create_time, update_time, recurrence_rule, start_date, exception_dates)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)`
 	_, err := s.db.ExecContext(ctx, query,
 		string(re.ID), string(re.SpaceID), string(re.BudgetID), re.Name, re.Amount, string(re.Currency),
 		re.Interval, re.NextDueDate, re.IsVariable, string(re.Status), re.GracePeriodDays, re.CreateTime, re.UpdateTime,
+		re.RecurrenceRule, re.StartDate, datesToArray(re.ExceptionDates),
 	)
 	return err
 }
@@ -116,11 +124,15 @@ func (s *RecurringExpenseStore) Update(ctx context.Context, re *finance.Recurrin
 		is_variable = $8, 
 		status = $9, 
 		grace_period_days = $10, 
-		update_time = $11 
+		update_time = $11, 
+		recurrence_rule = $12, 
+		start_date = $13, 
+		exception_dates = $14 
 		WHERE id = $1`
 	res, err := s.db.ExecContext(ctx, query,
 		string(re.ID), string(re.BudgetID), re.Name, re.Amount, string(re.Currency),
 		re.Interval, re.NextDueDate, re.IsVariable, string(re.Status), re.GracePeriodDays, re.UpdateTime,
+		re.RecurrenceRule, re.StartDate, datesToArray(re.ExceptionDates),
 	)
 	if err != nil {
 		return err
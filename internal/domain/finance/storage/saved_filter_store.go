@@ -0,0 +1,173 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/jmoiron/sqlx"
+	"github.com/masterkeysrd/saturn/internal/domain/finance"
+	"github.com/masterkeysrd/saturn/internal/platform/paging"
+)
+
+type savedFilterDB struct {
+	ID          string       `db:"id"`
+	SpaceID     string       `db:"space_id"`
+	Name        string       `db:"name"`
+	Description string       `db:"description"`
+	Expression  string       `db:"expression"`
+	Sort        string       `db:"sort"`
+	CreateTime  sql.NullTime `db:"create_time"`
+	UpdateTime  sql.NullTime `db:"update_time"`
+}
+
+func (row *savedFilterDB) toDomain() *finance.SavedFilter {
+	return &finance.SavedFilter{
+		ID:          finance.SavedFilterID(row.ID),
+		SpaceID:     finance.SpaceID(row.SpaceID),
+		Name:        row.Name,
+		Description: row.Description,
+		Expression:  row.Expression,
+		Sort:        row.Sort,
+		CreateTime:  nullTimeToTime(row.CreateTime),
+		UpdateTime:  nullTimeToTime(row.UpdateTime),
+	}
+}
+
+type SavedFilterStore struct {
+	db *sqlx.DB
+}
+
+func NewSavedFilterStore(db *sqlx.DB) *SavedFilterStore {
+	return &SavedFilterStore{db: db}
+}
+
+func (s *SavedFilterStore) Create(ctx context.Context, f *finance.SavedFilter) error {
+	ds := pgDialect.Insert(goqu.S("finance").Table("saved_filter")).Rows(goqu.Record{
+		"id":          string(f.ID),
+		"space_id":    string(f.SpaceID),
+		"name":        f.Name,
+		"description": f.Description,
+		"expression":  f.Expression,
+		"sort":        f.Sort,
+		"create_time": f.CreateTime,
+		"update_time": f.UpdateTime,
+	})
+	query, args, err := ds.Prepared(true).ToSQL()
+	if err != nil {
+		return err
+	}
+	_, err = s.db.ExecContext(ctx, query, args...)
+	return err
+}
+
+func (s *SavedFilterStore) GetByID(ctx context.Context, spaceID finance.SpaceID, id finance.SavedFilterID) (*finance.SavedFilter, error) {
+	ds := pgDialect.From(goqu.S("finance").Table("saved_filter")).Select("*").Where(goqu.Ex{"space_id": string(spaceID), "id": string(id)})
+	query, args, err := ds.Prepared(true).ToSQL()
+	if err != nil {
+		return nil, err
+	}
+	var row savedFilterDB
+	if err := s.db.GetContext(ctx, &row, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, finance.ErrSavedFilterNotFound
+		}
+		return nil, err
+	}
+	return row.toDomain(), nil
+}
+
+func (s *SavedFilterStore) Update(ctx context.Context, f *finance.SavedFilter) error {
+	ds := pgDialect.Update(goqu.S("finance").Table("saved_filter")).
+		Set(goqu.Record{
+			"name":        f.Name,
+			"description": f.Description,
+			"expression":  f.Expression,
+			"sort":        f.Sort,
+			"update_time": f.UpdateTime,
+		}).
+		Where(goqu.Ex{"space_id": string(f.SpaceID), "id": string(f.ID)})
+	query, args, err := ds.Prepared(true).ToSQL()
+	if err != nil {
+		return err
+	}
+	res, err := s.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return finance.ErrSavedFilterNotFound
+	}
+	return nil
+}
+
+func (s *SavedFilterStore) Delete(ctx context.Context, spaceID finance.SpaceID, id finance.SavedFilterID) error {
+	ds := pgDialect.Delete(goqu.S("finance").Table("saved_filter")).Where(goqu.Ex{"space_id": string(spaceID), "id": string(id)})
+	query, args, err := ds.Prepared(true).ToSQL()
+	if err != nil {
+		return err
+	}
+	res, err := s.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return finance.ErrSavedFilterNotFound
+	}
+	return nil
+}
+
+func (s *SavedFilterStore) ListBySpace(ctx context.Context, spaceID finance.SpaceID, filter *finance.ListSavedFiltersFilter) (*paging.Page[*finance.SavedFilter], error) {
+	if filter.PageSize <= 0 || filter.PageSize > 100 {
+		filter.PageSize = 50
+	}
+
+	ds := pgDialect.From(goqu.S("finance").Table("saved_filter")).Select("*")
+	ds = ds.Where(goqu.Ex{"space_id": string(spaceID)})
+
+	cursor, _ := paging.Decode(filter.NextPageToken)
+
+	sortOrder := filter.Sort
+	if !finance.IsSavedFilterSortField(sortOrder.Field) {
+		sortOrder.Field = finance.DefaultSavedFilterSortField
+		sortOrder.Ascending = true
+	}
+
+	ds = paging.ApplyPagination(ds, paging.Options{
+		Sort:     sortOrder,
+		Cursor:   cursor,
+		PageSize: uint(filter.PageSize),
+	})
+
+	query, args, err := ds.Prepared(true).ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("build sql query: %w", err)
+	}
+
+	var rows []savedFilterDB
+	if err := s.db.SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, fmt.Errorf("select context: %w", err)
+	}
+
+	filters := make([]*finance.SavedFilter, len(rows))
+	for i := range rows {
+		filters[i] = rows[i].toDomain()
+	}
+
+	return paging.NewPage(filters, int(filter.PageSize), func(f *finance.SavedFilter) paging.Cursor {
+		return paging.Cursor{
+			SortValue: f.GetSortValue(sortOrder.Field),
+			ID:        string(f.ID),
+		}
+	}), nil
+}
@@ -143,6 +143,14 @@ func (s *ScheduledPaymentStore) ListBySpace(ctx context.Context, spaceID finance
 		ds = ds.Where(goqu.Ex{"status": string(*filter.Status)})
 	}
 
+	if filter.SourceType != nil {
+		ds = ds.Where(goqu.Ex{"source_type": *filter.SourceType})
+	}
+
+	if filter.SourceID != nil {
+		ds = ds.Where(goqu.Ex{"source_id": *filter.SourceID})
+	}
+
 	if filter.StartDate != nil {
 		ds = ds.Where(goqu.I("due_date").Gte(*filter.StartDate))
 	}
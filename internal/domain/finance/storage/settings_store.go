@@ -6,14 +6,20 @@ import (
 	"errors"
 
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 	"github.com/masterkeysrd/saturn/internal/domain/finance"
 )
 
 type settingsDB struct {
-	SpaceID      string       `db:"space_id"`
-	BaseCurrency string       `db:"base_currency"`
-	CreateTime   sql.NullTime `db:"create_time"`
-	UpdateTime   sql.NullTime `db:"update_time"`
+	SpaceID           string         `db:"space_id"`
+	BaseCurrency      string         `db:"base_currency"`
+	EnabledCurrencies pq.StringArray `db:"enabled_currencies"`
+	Timezone          string         `db:"timezone"`
+	AlertPercents     pq.Int32Array  `db:"budget_alert_percents"`
+	AnomalyStdDevs    float64        `db:"anomaly_std_devs"`
+	DuplicateWindow   int32          `db:"duplicate_window_hours"`
+	CreateTime        sql.NullTime   `db:"create_time"`
+	UpdateTime        sql.NullTime   `db:"update_time"`
 }
 
 type SettingsStore struct {
@@ -25,25 +31,63 @@ func NewSettingsStore(db *sqlx.DB) *SettingsStore {
 }
 
 func (s *SettingsStore) Create(ctx context.Context, settings *finance.FinanceSettings) error {
-	query := `INSERT INTO finance.settings (space_id, base_currency, create_time, update_time)
-		VALUES ($1, $2, $3, $4)`
-	_, err := s.db.ExecContext(ctx, query, string(settings.SpaceID), settings.BaseCurrency, settings.CreateTime, settings.UpdateTime)
+	query := `INSERT INTO finance.settings (space_id, base_currency, enabled_currencies, timezone, budget_alert_percents, anomaly_std_devs, duplicate_window_hours, create_time, update_time)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`
+	_, err := s.db.ExecContext(ctx, query, string(settings.SpaceID), settings.BaseCurrency, currencyStrings(settings.EnabledCurrencies), settings.Timezone,
+		pq.Int32Array(settings.BudgetAlertPercents), settings.AnomalyStdDevs, settings.DuplicateWindowHours, settings.CreateTime, settings.UpdateTime)
 	return err
 }
 
 func (s *SettingsStore) GetByID(ctx context.Context, spaceID finance.SpaceID) (*finance.FinanceSettings, error) {
 	var row settingsDB
-	query := `SELECT space_id, base_currency, create_time, update_time FROM finance.settings WHERE space_id = $1`
+	query := `SELECT space_id, base_currency, enabled_currencies, timezone, budget_alert_percents, anomaly_std_devs, duplicate_window_hours, create_time, update_time FROM finance.settings WHERE space_id = $1`
 	if err := s.db.GetContext(ctx, &row, query, string(spaceID)); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, finance.ErrSettingsNotFound
 		}
 		return nil, err
 	}
+
+	var enabled []finance.Currency
+	for _, c := range row.EnabledCurrencies {
+		enabled = append(enabled, finance.Currency(c))
+	}
 	return &finance.FinanceSettings{
-		SpaceID:      finance.SpaceID(row.SpaceID),
-		BaseCurrency: finance.Currency(row.BaseCurrency),
-		CreateTime:   nullTimeToTime(row.CreateTime),
-		UpdateTime:   nullTimeToTime(row.UpdateTime),
+		SpaceID:           finance.SpaceID(row.SpaceID),
+		BaseCurrency:      finance.Currency(row.BaseCurrency),
+		EnabledCurrencies: enabled,
+		Timezone:          row.Timezone,
+		CreateTime:        nullTimeToTime(row.CreateTime),
+		UpdateTime:        nullTimeToTime(row.UpdateTime),
+
+		BudgetAlertPercents:  []int32(row.AlertPercents),
+		AnomalyStdDevs:       row.AnomalyStdDevs,
+		DuplicateWindowHours: row.DuplicateWindow,
 	}, nil
 }
+
+func (s *SettingsStore) Update(ctx context.Context, settings *finance.FinanceSettings) error {
+	query := `UPDATE finance.settings SET enabled_currencies = $2, timezone = $3, budget_alert_percents = $4, anomaly_std_devs = $5,
+		duplicate_window_hours = $6, update_time = $7 WHERE space_id = $1`
+	res, err := s.db.ExecContext(ctx, query, string(settings.SpaceID), currencyStrings(settings.EnabledCurrencies), settings.Timezone,
+		pq.Int32Array(settings.BudgetAlertPercents), settings.AnomalyStdDevs, settings.DuplicateWindowHours, settings.UpdateTime)
+	if err != nil {
+		return err
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return finance.ErrSettingsNotFound
+	}
+	return nil
+}
+
+func currencyStrings(currencies []finance.Currency) pq.StringArray {
+	out := make(pq.StringArray, len(currencies))
+	for i, c := range currencies {
+		out[i] = string(c)
+	}
+	return out
+}
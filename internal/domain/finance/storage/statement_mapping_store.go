@@ -0,0 +1,110 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/jmoiron/sqlx"
+	"github.com/masterkeysrd/saturn/internal/domain/finance"
+)
+
+type statementMappingDB struct {
+	AccountID         string       `db:"account_id"`
+	SpaceID           string       `db:"space_id"`
+	Delimiter         string       `db:"delimiter"`
+	HasHeader         bool         `db:"has_header"`
+	DateColumn        string       `db:"date_column"`
+	DateFormat        string       `db:"date_format"`
+	AmountColumn      string       `db:"amount_column"`
+	DebitColumn       string       `db:"debit_column"`
+	CreditColumn      string       `db:"credit_column"`
+	DescriptionColumn string       `db:"description_column"`
+	ReferenceColumn   string       `db:"reference_column"`
+	CurrencyColumn    string       `db:"currency_column"`
+	DecimalSeparator  string       `db:"decimal_separator"`
+	InvertAmounts     bool         `db:"invert_amounts"`
+	UpdateTime        sql.NullTime `db:"update_time"`
+}
+
+func (db *statementMappingDB) toDomain() *finance.StatementMapping {
+	return &finance.StatementMapping{
+		AccountID:         finance.AccountID(db.AccountID),
+		SpaceID:           finance.SpaceID(db.SpaceID),
+		Delimiter:         db.Delimiter,
+		HasHeader:         db.HasHeader,
+		DateColumn:        db.DateColumn,
+		DateFormat:        db.DateFormat,
+		AmountColumn:      db.AmountColumn,
+		DebitColumn:       db.DebitColumn,
+		CreditColumn:      db.CreditColumn,
+		DescriptionColumn: db.DescriptionColumn,
+		ReferenceColumn:   db.ReferenceColumn,
+		CurrencyColumn:    db.CurrencyColumn,
+		DecimalSeparator:  db.DecimalSeparator,
+		InvertAmounts:     db.InvertAmounts,
+		UpdateTime:        nullTimeToTime(db.UpdateTime),
+	}
+}
+
+type StatementMappingStore struct {
+	db *sqlx.DB
+}
+
+func NewStatementMappingStore(db *sqlx.DB) *StatementMappingStore {
+	return &StatementMappingStore{db: db}
+}
+
+func (s *StatementMappingStore) Get(ctx context.Context, spaceID finance.SpaceID, accountID finance.AccountID) (*finance.StatementMapping, error) {
+	ds := pgDialect.From(goqu.S("finance").Table("statement_mapping")).Select("*").Where(goqu.Ex{
+		"space_id":   string(spaceID),
+		"account_id": string(accountID),
+	})
+	query, args, err := ds.Prepared(true).ToSQL()
+	if err != nil {
+		return nil, err
+	}
+
+	var row statementMappingDB
+	if err := s.db.GetContext(ctx, &row, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, finance.ErrStatementMappingNotFound
+		}
+		return nil, fmt.Errorf("query statement mapping: %w", err)
+	}
+	return row.toDomain(), nil
+}
+
+func (s *StatementMappingStore) Upsert(ctx context.Context, m *finance.StatementMapping) error {
+	record := goqu.Record{
+		"account_id":         string(m.AccountID),
+		"space_id":           string(m.SpaceID),
+		"delimiter":          m.Delimiter,
+		"has_header":         m.HasHeader,
+		"date_column":        m.DateColumn,
+		"date_format":        m.DateFormat,
+		"amount_column":      m.AmountColumn,
+		"debit_column":       m.DebitColumn,
+		"credit_column":      m.CreditColumn,
+		"description_column": m.DescriptionColumn,
+		"reference_column":   m.ReferenceColumn,
+		"currency_column":    m.CurrencyColumn,
+		"decimal_separator":  m.DecimalSeparator,
+		"invert_amounts":     m.InvertAmounts,
+		"update_time":        m.UpdateTime,
+	}
+
+	ds := pgDialect.Insert(goqu.S("finance").Table("statement_mapping")).
+		Rows(record).
+		OnConflict(goqu.DoUpdate("account_id", record))
+	query, args, err := ds.Prepared(true).ToSQL()
+	if err != nil {
+		return err
+	}
+	if _, err := s.db.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("upsert statement mapping: %w", err)
+	}
+	return nil
+}
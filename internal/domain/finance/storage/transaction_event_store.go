@@ -2,6 +2,7 @@ package storage
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/jmoiron/sqlx"
@@ -41,6 +42,26 @@ func (s *TransactionEventStore) Create(ctx context.Context, e *finance.Transacti
 	return err
 }
 
+// insertTransactionEvents persists events within tx.
+func insertTransactionEvents(ctx context.Context, tx *sqlx.Tx, events []*finance.TransactionEvent) error {
+	query := `INSERT INTO finance.transaction_events (id, space_id, txn_id, event_type, metadata, create_time)
+		VALUES ($1, $2, $3, $4, $5, $6)`
+
+	for _, e := range events {
+		metadataBytes, err := e.MetadataJSON()
+		if err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, query,
+			string(e.ID), string(e.SpaceID), string(e.TransactionID),
+			e.EventType, metadataBytes, e.CreateTime,
+		); err != nil {
+			return fmt.Errorf("insert transaction event: %w", err)
+		}
+	}
+	return nil
+}
+
 func (s *TransactionEventStore) ListByTransaction(ctx context.Context, spaceID finance.SpaceID, txnID finance.TransactionID) ([]*finance.TransactionEvent, error) {
 	query := `SELECT id, space_id, txn_id, event_type, metadata, create_time 
 		FROM finance.transaction_events 
@@ -0,0 +1,217 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/masterkeysrd/saturn/internal/domain/finance"
+	"github.com/masterkeysrd/saturn/internal/platform/conv"
+	"github.com/masterkeysrd/saturn/internal/platform/paging"
+)
+
+type transactionRuleDB struct {
+	ID                 string         `db:"id"`
+	SpaceID            string         `db:"space_id"`
+	Name               string         `db:"name"`
+	Priority           int32          `db:"priority"`
+	IsActive           bool           `db:"is_active"`
+	DescriptionPattern string         `db:"description_pattern"`
+	AccountID          sql.NullString `db:"account_id"`
+	MinAmount          sql.NullInt64  `db:"min_amount"`
+	MaxAmount          sql.NullInt64  `db:"max_amount"`
+	SetBudgetID        sql.NullString `db:"set_budget_id"`
+	SetPayeeID         sql.NullString `db:"set_payee_id"`
+	SetCategoryID      sql.NullString `db:"set_category_id"`
+	AddTags            pq.StringArray `db:"add_tags"`
+	CreateTime         sql.NullTime   `db:"create_time"`
+	UpdateTime         sql.NullTime   `db:"update_time"`
+}
+
+func (row *transactionRuleDB) toDomain() *finance.TransactionRule {
+	r := &finance.TransactionRule{
+		ID:                 finance.TransactionRuleID(row.ID),
+		SpaceID:            finance.SpaceID(row.SpaceID),
+		Name:               row.Name,
+		Priority:           row.Priority,
+		IsActive:           row.IsActive,
+		DescriptionPattern: row.DescriptionPattern,
+		AddTags:            []string(row.AddTags),
+		CreateTime:         nullTimeToTime(row.CreateTime),
+		UpdateTime:         nullTimeToTime(row.UpdateTime),
+	}
+	if row.AccountID.Valid {
+		aID := finance.AccountID(row.AccountID.String)
+		r.AccountID = &aID
+	}
+	if row.MinAmount.Valid {
+		r.MinAmount = &row.MinAmount.Int64
+	}
+	if row.MaxAmount.Valid {
+		r.MaxAmount = &row.MaxAmount.Int64
+	}
+	if row.SetBudgetID.Valid {
+		bID := finance.BudgetID(row.SetBudgetID.String)
+		r.SetBudgetID = &bID
+	}
+	if row.SetPayeeID.Valid {
+		pID := finance.PayeeID(row.SetPayeeID.String)
+		r.SetPayeeID = &pID
+	}
+	if row.SetCategoryID.Valid {
+		cID := finance.CategoryID(row.SetCategoryID.String)
+		r.SetCategoryID = &cID
+	}
+	return r
+}
+
+type TransactionRuleStore struct {
+	db *sqlx.DB
+}
+
+func NewTransactionRuleStore(db *sqlx.DB) *TransactionRuleStore {
+	return &TransactionRuleStore{db: db}
+}
+
+func transactionRuleRecord(r *finance.TransactionRule) goqu.Record {
+	return goqu.Record{
+		"name":                r.Name,
+		"priority":            r.Priority,
+		"is_active":           r.IsActive,
+		"description_pattern": r.DescriptionPattern,
+		"account_id":          conv.StringPtr(r.AccountID),
+		"min_amount":          r.MinAmount,
+		"max_amount":          r.MaxAmount,
+		"set_budget_id":       conv.StringPtr(r.SetBudgetID),
+		"set_payee_id":        conv.StringPtr(r.SetPayeeID),
+		"set_category_id":     conv.StringPtr(r.SetCategoryID),
+		"add_tags":            stringsToArray(r.AddTags),
+		"update_time":         r.UpdateTime,
+	}
+}
+
+func (s *TransactionRuleStore) Create(ctx context.Context, r *finance.TransactionRule) error {
+	record := transactionRuleRecord(r)
+	record["id"] = string(r.ID)
+	record["space_id"] = string(r.SpaceID)
+	record["create_time"] = r.CreateTime
+
+	ds := pgDialect.Insert(goqu.S("finance").Table("transaction_rule")).Rows(record)
+	query, args, err := ds.Prepared(true).ToSQL()
+	if err != nil {
+		return err
+	}
+	_, err = s.db.ExecContext(ctx, query, args...)
+	return err
+}
+
+func (s *TransactionRuleStore) GetByID(ctx context.Context, spaceID finance.SpaceID, id finance.TransactionRuleID) (*finance.TransactionRule, error) {
+	ds := pgDialect.From(goqu.S("finance").Table("transaction_rule")).Select("*").Where(goqu.Ex{"space_id": string(spaceID), "id": string(id)})
+	query, args, err := ds.Prepared(true).ToSQL()
+	if err != nil {
+		return nil, err
+	}
+	var row transactionRuleDB
+	if err := s.db.GetContext(ctx, &row, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, finance.ErrTransactionRuleNotFound
+		}
+		return nil, err
+	}
+	return row.toDomain(), nil
+}
+
+func (s *TransactionRuleStore) Update(ctx context.Context, r *finance.TransactionRule) error {
+	ds := pgDialect.Update(goqu.S("finance").Table("transaction_rule")).
+		Set(transactionRuleRecord(r)).
+		Where(goqu.Ex{"space_id": string(r.SpaceID), "id": string(r.ID)})
+	query, args, err := ds.Prepared(true).ToSQL()
+	if err != nil {
+		return err
+	}
+	res, err := s.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return finance.ErrTransactionRuleNotFound
+	}
+	return nil
+}
+
+func (s *TransactionRuleStore) Delete(ctx context.Context, spaceID finance.SpaceID, id finance.TransactionRuleID) error {
+	ds := pgDialect.Delete(goqu.S("finance").Table("transaction_rule")).Where(goqu.Ex{"space_id": string(spaceID), "id": string(id)})
+	query, args, err := ds.Prepared(true).ToSQL()
+	if err != nil {
+		return err
+	}
+	res, err := s.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return finance.ErrTransactionRuleNotFound
+	}
+	return nil
+}
+
+func (s *TransactionRuleStore) ListBySpace(ctx context.Context, spaceID finance.SpaceID, filter *finance.ListTransactionRulesFilter) (*paging.Page[*finance.TransactionRule], error) {
+	if filter.PageSize <= 0 || filter.PageSize > 100 {
+		filter.PageSize = 50
+	}
+
+	ds := pgDialect.From(goqu.S("finance").Table("transaction_rule")).Select("*")
+	ds = ds.Where(goqu.Ex{"space_id": string(spaceID)})
+
+	if filter.ActiveOnly != nil && *filter.ActiveOnly {
+		ds = ds.Where(goqu.Ex{"is_active": true})
+	}
+
+	cursor, _ := paging.Decode(filter.NextPageToken)
+
+	sortOrder := filter.Sort
+	if !finance.IsTransactionRuleSortField(sortOrder.Field) {
+		sortOrder.Field = finance.DefaultTransactionRuleSortField
+		sortOrder.Ascending = true
+	}
+
+	ds = paging.ApplyPagination(ds, paging.Options{
+		Sort:     sortOrder,
+		Cursor:   cursor,
+		PageSize: uint(filter.PageSize),
+	})
+
+	query, args, err := ds.Prepared(true).ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("build sql query: %w", err)
+	}
+
+	var rows []transactionRuleDB
+	if err := s.db.SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, fmt.Errorf("select context: %w", err)
+	}
+
+	rules := make([]*finance.TransactionRule, len(rows))
+	for i := range rows {
+		rules[i] = rows[i].toDomain()
+	}
+
+	return paging.NewPage(rules, int(filter.PageSize), func(r *finance.TransactionRule) paging.Cursor {
+		return paging.Cursor{
+			SortValue: r.GetSortValue(sortOrder.Field),
+			ID:        string(r.ID),
+		}
+	}), nil
+}
@@ -6,31 +6,73 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/doug-martin/goqu/v9"
 	_ "github.com/doug-martin/goqu/v9/dialect/postgres"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 	"github.com/masterkeysrd/saturn/internal/domain/finance"
 	"github.com/masterkeysrd/saturn/internal/platform/conv"
+	"github.com/masterkeysrd/saturn/internal/platform/filter"
 	"github.com/masterkeysrd/saturn/internal/platform/paging"
 )
 
 type transactionDB struct {
-	ID              string         `db:"id"`
-	SpaceID         string         `db:"space_id"`
-	Type            string         `db:"type"`
-	BudgetID        sql.NullString `db:"budget_id"`
-	PeriodID        sql.NullString `db:"period_id"`
-	AccountID       sql.NullString `db:"account_id"`
-	Amount          int64          `db:"amount"`
-	Currency        string         `db:"currency"`
-	AmountInBase    int64          `db:"amount_in_base"`
-	Description     string         `db:"description"`
-	TransactionDate sql.NullTime   `db:"transaction_date"`
-	EffectiveDate   sql.NullTime   `db:"effective_date"`
-	Metadata        sql.NullString `db:"metadata"`
-	CreateTime      sql.NullTime   `db:"create_time"`
-	UpdateTime      sql.NullTime   `db:"update_time"`
+	ID               string         `db:"id"`
+	SpaceID          string         `db:"space_id"`
+	Type             string         `db:"type"`
+	BudgetID         sql.NullString `db:"budget_id"`
+	PeriodID         sql.NullString `db:"period_id"`
+	AccountID        sql.NullString `db:"account_id"`
+	CategoryID       sql.NullString `db:"category_id"`
+	PayeeID          sql.NullString `db:"payee_id"`
+	Tags             pq.StringArray `db:"tags"`
+	Amount           int64          `db:"amount"`
+	Currency         string         `db:"currency"`
+	AmountInBase     int64          `db:"amount_in_base"`
+	Description      string         `db:"description"`
+	TransactionDate  sql.NullTime   `db:"transaction_date"`
+	EffectiveDate    sql.NullTime   `db:"effective_date"`
+	Metadata         sql.NullString `db:"metadata"`
+	Cleared          bool           `db:"cleared"`
+	ReconciliationID sql.NullString `db:"reconciliation_id"`
+	CreateTime       sql.NullTime   `db:"create_time"`
+	UpdateTime       sql.NullTime   `db:"update_time"`
+}
+
+type transactionSplitDB struct {
+	ID            string         `db:"id"`
+	TransactionID string         `db:"transaction_id"`
+	Position      int            `db:"position"`
+	BudgetID      sql.NullString `db:"budget_id"`
+	PeriodID      sql.NullString `db:"period_id"`
+	CategoryID    sql.NullString `db:"category_id"`
+	Amount        int64          `db:"amount"`
+	AmountInBase  int64          `db:"amount_in_base"`
+	Note          string         `db:"note"`
+}
+
+func (row *transactionSplitDB) toDomain() *finance.TransactionSplit {
+	split := &finance.TransactionSplit{
+		ID:           finance.TransactionSplitID(row.ID),
+		Amount:       row.Amount,
+		AmountInBase: row.AmountInBase,
+		Note:         row.Note,
+	}
+	if row.BudgetID.Valid {
+		bID := finance.BudgetID(row.BudgetID.String)
+		split.BudgetID = &bID
+	}
+	if row.PeriodID.Valid {
+		pID := finance.PeriodID(row.PeriodID.String)
+		split.PeriodID = &pID
+	}
+	if row.CategoryID.Valid {
+		cID := finance.CategoryID(row.CategoryID.String)
+		split.CategoryID = &cID
+	}
+	return split
 }
 
 type TransactionStore struct {
@@ -54,6 +96,9 @@ func (s *TransactionStore) Create(ctx context.Context, t *finance.Transaction) e
 		"budget_id":        conv.StringPtr(t.BudgetID),
 		"period_id":        conv.StringPtr(t.PeriodID),
 		"account_id":       conv.StringPtr(t.AccountID),
+		"category_id":      conv.StringPtr(t.CategoryID),
+		"payee_id":         conv.StringPtr(t.PayeeID),
+		"tags":             transactionTags(t.Tags),
 		"amount":           t.Amount,
 		"currency":         string(t.Currency),
 		"amount_in_base":   t.AmountInBase,
@@ -61,6 +106,7 @@ func (s *TransactionStore) Create(ctx context.Context, t *finance.Transaction) e
 		"transaction_date": t.TransactionDate,
 		"effective_date":   t.EffectiveDate,
 		"metadata":         goqu.L("?::jsonb", string(metaJSON)),
+		"cleared":          t.Cleared,
 		"create_time":      t.CreateTime,
 		"update_time":      t.UpdateTime,
 	})
@@ -68,8 +114,86 @@ func (s *TransactionStore) Create(ctx context.Context, t *finance.Transaction) e
 	if err != nil {
 		return err
 	}
-	_, err = s.db.ExecContext(ctx, query, args...)
-	return err
+
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return err
+	}
+	if err := insertTransactionSplits(ctx, tx, t.ID, t.Splits); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// insertTransactionSplits persists the split lines of a transaction in their given order.
+func insertTransactionSplits(ctx context.Context, tx *sqlx.Tx, txnID finance.TransactionID, splits []*finance.TransactionSplit) error {
+	if len(splits) == 0 {
+		return nil
+	}
+
+	rows := make([]any, len(splits))
+	for i, split := range splits {
+		rows[i] = goqu.Record{
+			"id":             string(split.ID),
+			"transaction_id": string(txnID),
+			"position":       i,
+			"budget_id":      conv.StringPtr(split.BudgetID),
+			"period_id":      conv.StringPtr(split.PeriodID),
+			"category_id":    conv.StringPtr(split.CategoryID),
+			"amount":         split.Amount,
+			"amount_in_base": split.AmountInBase,
+			"note":           split.Note,
+		}
+	}
+
+	ds := pgDialect.Insert(goqu.S("finance").Table("transaction_split")).Rows(rows...)
+	query, args, err := ds.Prepared(true).ToSQL()
+	if err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("insert transaction splits: %w", err)
+	}
+	return nil
+}
+
+// loadSplits attaches split lines to the given transactions with a single query.
+func (s *TransactionStore) loadSplits(ctx context.Context, txns ...*finance.Transaction) error {
+	if len(txns) == 0 {
+		return nil
+	}
+
+	ids := make([]string, len(txns))
+	byID := make(map[finance.TransactionID]*finance.Transaction, len(txns))
+	for i, t := range txns {
+		ids[i] = string(t.ID)
+		byID[t.ID] = t
+	}
+
+	ds := pgDialect.From(goqu.S("finance").Table("transaction_split")).
+		Select("*").
+		Where(goqu.Ex{"transaction_id": ids}).
+		Order(goqu.I("transaction_id").Asc(), goqu.I("position").Asc())
+	query, args, err := ds.Prepared(true).ToSQL()
+	if err != nil {
+		return err
+	}
+
+	var rows []transactionSplitDB
+	if err := s.db.SelectContext(ctx, &rows, query, args...); err != nil {
+		return fmt.Errorf("select transaction splits: %w", err)
+	}
+	for i := range rows {
+		if t, ok := byID[finance.TransactionID(rows[i].TransactionID)]; ok {
+			t.Splits = append(t.Splits, rows[i].toDomain())
+		}
+	}
+	return nil
 }
 
 func (row *transactionDB) toDomain() *finance.Transaction {
@@ -89,28 +213,59 @@ func (row *transactionDB) toDomain() *finance.Transaction {
 		accountIDPtr = &aID
 	}
 
+	var categoryIDPtr *finance.CategoryID
+	if row.CategoryID.Valid {
+		cID := finance.CategoryID(row.CategoryID.String)
+		categoryIDPtr = &cID
+	}
+
+	var payeeIDPtr *finance.PayeeID
+	if row.PayeeID.Valid {
+		pyID := finance.PayeeID(row.PayeeID.String)
+		payeeIDPtr = &pyID
+	}
+
+	var reconciliationIDPtr *finance.ReconciliationID
+	if row.ReconciliationID.Valid {
+		rID := finance.ReconciliationID(row.ReconciliationID.String)
+		reconciliationIDPtr = &rID
+	}
+
 	var meta finance.TransactionMetadata
 	if row.Metadata.Valid && row.Metadata.String != "" {
 		_ = json.Unmarshal([]byte(row.Metadata.String), &meta)
 	}
 
 	return &finance.Transaction{
-		ID:              finance.TransactionID(row.ID),
-		SpaceID:         finance.SpaceID(row.SpaceID),
-		Type:            finance.TransactionType(row.Type),
-		BudgetID:        budgetIDPtr,
-		PeriodID:        periodIDPtr,
-		AccountID:       accountIDPtr,
-		Amount:          row.Amount,
-		Currency:        finance.Currency(row.Currency),
-		AmountInBase:    row.AmountInBase,
-		Description:     row.Description,
-		TransactionDate: nullTimeToTime(row.TransactionDate),
-		EffectiveDate:   nullTimeToTime(row.EffectiveDate),
-		Metadata:        meta,
-		CreateTime:      nullTimeToTime(row.CreateTime),
-		UpdateTime:      nullTimeToTime(row.UpdateTime),
+		ID:               finance.TransactionID(row.ID),
+		SpaceID:          finance.SpaceID(row.SpaceID),
+		Type:             finance.TransactionType(row.Type),
+		BudgetID:         budgetIDPtr,
+		PeriodID:         periodIDPtr,
+		AccountID:        accountIDPtr,
+		CategoryID:       categoryIDPtr,
+		PayeeID:          payeeIDPtr,
+		Tags:             []string(row.Tags),
+		Amount:           row.Amount,
+		Currency:         finance.Currency(row.Currency),
+		AmountInBase:     row.AmountInBase,
+		Description:      row.Description,
+		TransactionDate:  nullTimeToTime(row.TransactionDate),
+		EffectiveDate:    nullTimeToTime(row.EffectiveDate),
+		Metadata:         meta,
+		Cleared:          row.Cleared,
+		ReconciliationID: reconciliationIDPtr,
+		CreateTime:       nullTimeToTime(row.CreateTime),
+		UpdateTime:       nullTimeToTime(row.UpdateTime),
+	}
+}
+
+// transactionTags converts domain tags into a non-null PostgreSQL text array.
+func transactionTags(tags []string) pq.StringArray {
+	if tags == nil {
+		return pq.StringArray{}
 	}
+	return pq.StringArray(tags)
 }
 
 func (s *TransactionStore) GetByID(ctx context.Context, spaceID finance.SpaceID, id finance.TransactionID) (*finance.Transaction, error) {
@@ -126,7 +281,11 @@ func (s *TransactionStore) GetByID(ctx context.Context, spaceID finance.SpaceID,
 		}
 		return nil, err
 	}
-	return row.toDomain(), nil
+	txn := row.toDomain()
+	if err := s.loadSplits(ctx, txn); err != nil {
+		return nil, err
+	}
+	return txn, nil
 }
 
 func (s *TransactionStore) Delete(ctx context.Context, id finance.TransactionID) error {
@@ -150,6 +309,20 @@ func (s *TransactionStore) Delete(ctx context.Context, id finance.TransactionID)
 }
 
 func (s *TransactionStore) Update(ctx context.Context, t *finance.Transaction) error {
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if err := updateTransaction(ctx, tx, t); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// updateTransaction writes a transaction row and replaces its split lines within tx.
+func updateTransaction(ctx context.Context, tx *sqlx.Tx, t *finance.Transaction) error {
 	metaJSON, _ := json.Marshal(t.Metadata)
 	if len(metaJSON) == 0 || string(metaJSON) == "null" {
 		metaJSON = []byte("{}")
@@ -160,6 +333,9 @@ func (s *TransactionStore) Update(ctx context.Context, t *finance.Transaction) e
 			"budget_id":        conv.StringPtr(t.BudgetID),
 			"period_id":        conv.StringPtr(t.PeriodID),
 			"account_id":       conv.StringPtr(t.AccountID),
+			"category_id":      conv.StringPtr(t.CategoryID),
+			"payee_id":         conv.StringPtr(t.PayeeID),
+			"tags":             transactionTags(t.Tags),
 			"amount":           t.Amount,
 			"currency":         string(t.Currency),
 			"amount_in_base":   t.AmountInBase,
@@ -174,7 +350,8 @@ func (s *TransactionStore) Update(ctx context.Context, t *finance.Transaction) e
 	if err != nil {
 		return err
 	}
-	res, err := s.db.ExecContext(ctx, query, args...)
+
+	res, err := tx.ExecContext(ctx, query, args...)
 	if err != nil {
 		return err
 	}
@@ -185,7 +362,88 @@ func (s *TransactionStore) Update(ctx context.Context, t *finance.Transaction) e
 	if rows == 0 {
 		return finance.ErrTransactionNotFound
 	}
-	return nil
+
+	// Split lines are replaced wholesale on every update
+	if _, err := tx.ExecContext(ctx, `DELETE FROM finance.transaction_split WHERE transaction_id = $1`, string(t.ID)); err != nil {
+		return fmt.Errorf("delete transaction splits: %w", err)
+	}
+	return insertTransactionSplits(ctx, tx, t.ID, t.Splits)
+}
+
+// ApplyBatch persists the updates, deletions, balance deltas and events of a batch in a single
+// database transaction.
+func (s *TransactionStore) ApplyBatch(ctx context.Context, b *finance.TransactionBatch) error {
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	for _, p := range b.Periods {
+		if err := insertBudgetPeriod(ctx, tx, p); err != nil {
+			return fmt.Errorf("create budget period: %w", err)
+		}
+	}
+
+	for _, t := range b.Updated {
+		if err := updateTransaction(ctx, tx, t); err != nil {
+			return fmt.Errorf("update transaction %s: %w", t.ID, err)
+		}
+	}
+
+	if len(b.Deleted) > 0 {
+		ids := make([]string, len(b.Deleted))
+		for i, id := range b.Deleted {
+			ids[i] = string(id)
+		}
+		ds := pgDialect.Delete(goqu.S("finance").Table("transaction")).
+			Where(goqu.Ex{"space_id": string(b.SpaceID), "id": ids})
+		query, args, err := ds.Prepared(true).ToSQL()
+		if err != nil {
+			return err
+		}
+		res, err := tx.ExecContext(ctx, query, args...)
+		if err != nil {
+			return fmt.Errorf("delete transactions: %w", err)
+		}
+		rows, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rows != int64(len(ids)) {
+			return finance.ErrTransactionNotFound
+		}
+	}
+
+	now := time.Now().UTC()
+	for accountID, delta := range b.BalanceDeltas {
+		ds := pgDialect.Update(goqu.S("finance").Table("account")).
+			Set(goqu.Record{
+				"current_balance": goqu.L("current_balance + ?", delta),
+				"update_time":     now,
+			}).
+			Where(goqu.Ex{"space_id": string(b.SpaceID), "id": string(accountID)})
+		query, args, err := ds.Prepared(true).ToSQL()
+		if err != nil {
+			return err
+		}
+		res, err := tx.ExecContext(ctx, query, args...)
+		if err != nil {
+			return fmt.Errorf("adjust account balance: %w", err)
+		}
+		rows, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rows == 0 {
+			return finance.ErrAccountNotFound
+		}
+	}
+
+	if err := insertTransactionEvents(ctx, tx, b.Events); err != nil {
+		return err
+	}
+	return tx.Commit()
 }
 
 func (s *TransactionStore) ListBySpace(ctx context.Context, spaceID finance.SpaceID, filter *finance.TransactionFilter) (*paging.Page[*finance.Transaction], error) {
@@ -199,7 +457,7 @@ func (s *TransactionStore) ListBySpace(ctx context.Context, spaceID finance.Spac
 	ds = ds.Where(goqu.Ex{"space_id": string(spaceID)})
 
 	if filter.BudgetID != nil {
-		ds = ds.Where(goqu.Ex{"budget_id": string(*filter.BudgetID)})
+		ds = ds.Where(budgetMatch(*filter.BudgetID))
 	}
 	if filter.Type != nil {
 		ds = ds.Where(goqu.Ex{"type": string(*filter.Type)})
@@ -216,6 +474,26 @@ func (s *TransactionStore) ListBySpace(ctx context.Context, spaceID finance.Spac
 	if filter.ScheduledPaymentID != nil {
 		ds = ds.Where(goqu.L("metadata->>'scheduled_payment_id'").Eq(*filter.ScheduledPaymentID))
 	}
+	if filter.IncomeSourceID != nil {
+		ds = ds.Where(goqu.L("metadata->>'income_source_id'").Eq(string(*filter.IncomeSourceID)))
+	}
+	if filter.RecurringExpenseID != nil {
+		ds = ds.Where(goqu.L("metadata->>'recurring_expense_id'").Eq(string(*filter.RecurringExpenseID)))
+	}
+	if filter.CategoryID != nil {
+		ds = ds.Where(goqu.L(`id IN (
+			WITH RECURSIVE subtree AS (
+				SELECT id FROM finance.category WHERE id = ?
+				UNION ALL
+				SELECT c.id FROM finance.category c JOIN subtree st ON c.parent_id = st.id
+			)
+			SELECT a.transaction_id FROM finance.transaction_allocation a
+			WHERE a.category_id IN (SELECT id FROM subtree)
+		)`, string(*filter.CategoryID)))
+	}
+	if len(filter.Tags) > 0 {
+		ds = ds.Where(goqu.L("tags @> ?::text[]", pq.StringArray(filter.Tags)))
+	}
 	if filter.MinAmount != nil {
 		ds = ds.Where(goqu.I("amount").Gte(*filter.MinAmount))
 	}
@@ -231,6 +509,13 @@ func (s *TransactionStore) ListBySpace(ctx context.Context, spaceID finance.Spac
 	if filter.SearchQuery != nil && *filter.SearchQuery != "" {
 		ds = ds.Where(goqu.I("description").ILike("%" + *filter.SearchQuery + "%"))
 	}
+	if filter.Where != nil {
+		where, err := compileTransactionFilter(filter.Where)
+		if err != nil {
+			return nil, err
+		}
+		ds = ds.Where(where)
+	}
 
 	// Keyset Cursor decoding
 	cursor, _ := paging.Decode(filter.NextPageToken)
@@ -263,6 +548,9 @@ func (s *TransactionStore) ListBySpace(ctx context.Context, spaceID finance.Spac
 	for i := range dbRows {
 		txns[i] = dbRows[i].toDomain()
 	}
+	if err := s.loadSplits(ctx, txns...); err != nil {
+		return nil, err
+	}
 
 	page := paging.NewPage(txns, int(filter.PageSize), func(t *finance.Transaction) paging.Cursor {
 		return paging.Cursor{
@@ -274,6 +562,116 @@ func (s *TransactionStore) ListBySpace(ctx context.Context, spaceID finance.Spac
 	return page, nil
 }
 
+// budgetMatch matches transactions assigned to the budget directly or through any of their split lines.
+func budgetMatch(budgetID finance.BudgetID) goqu.Expression {
+	return goqu.Or(
+		goqu.Ex{"budget_id": string(budgetID)},
+		goqu.L(`EXISTS (SELECT 1 FROM finance.transaction_split s WHERE s.transaction_id = "transaction".id AND s.budget_id = ?)`, string(budgetID)),
+	)
+}
+
+// compileTransactionFilter translates a parsed filter expression into a condition on finance.transaction.
+// It is AND-ed with the other filters, so keyset pagination applies unchanged on top of it.
+func compileTransactionFilter(e filter.Expr) (goqu.Expression, error) {
+	switch e := e.(type) {
+	case filter.And:
+		children, err := compileTransactionFilters(e.Exprs)
+		if err != nil {
+			return nil, err
+		}
+		return goqu.And(children...), nil
+	case filter.Or:
+		children, err := compileTransactionFilters(e.Exprs)
+		if err != nil {
+			return nil, err
+		}
+		return goqu.Or(children...), nil
+	case filter.Not:
+		c, err := compileTransactionFilter(e.Expr)
+		if err != nil {
+			return nil, err
+		}
+		// Unknown (NULL) predicates count as non-matching, so their negation matches.
+		return goqu.L("NOT COALESCE((?), FALSE)", c), nil
+	case filter.Restriction:
+		return compileTransactionRestriction(e)
+	default:
+		return nil, fmt.Errorf("unsupported filter expression %T", e)
+	}
+}
+
+func compileTransactionFilters(exprs []filter.Expr) ([]goqu.Expression, error) {
+	compiled := make([]goqu.Expression, len(exprs))
+	for i, e := range exprs {
+		c, err := compileTransactionFilter(e)
+		if err != nil {
+			return nil, err
+		}
+		compiled[i] = c
+	}
+	return compiled, nil
+}
+
+func compileTransactionRestriction(r filter.Restriction) (goqu.Expression, error) {
+	values := make([]any, len(r.Values))
+	strs := make([]string, len(r.Values))
+	for i, v := range r.Values {
+		values[i] = v.Any()
+		strs[i] = v.Str
+	}
+
+	var match goqu.Expression
+	switch r.Field {
+	case "budget_id":
+		match = goqu.Or(
+			goqu.L("budget_id = ANY(?::text[])", pq.StringArray(strs)),
+			goqu.L(`EXISTS (SELECT 1 FROM finance.transaction_split s WHERE s.transaction_id = "transaction".id AND s.budget_id = ANY(?::text[]))`, pq.StringArray(strs)),
+		)
+	case "category_id":
+		match = goqu.L(`id IN (
+			WITH RECURSIVE subtree AS (
+				SELECT id FROM finance.category WHERE id = ANY(?::text[])
+				UNION ALL
+				SELECT c.id FROM finance.category c JOIN subtree st ON c.parent_id = st.id
+			)
+			SELECT a.transaction_id FROM finance.transaction_allocation a
+			WHERE a.category_id IN (SELECT id FROM subtree)
+		)`, pq.StringArray(strs))
+	case "tags":
+		match = goqu.L("tags && ?::text[]", pq.StringArray(strs))
+	}
+	if match != nil {
+		// Relation fields only support (in)equality and membership.
+		if r.Op == filter.OpNeq {
+			return goqu.L("NOT COALESCE((?), FALSE)", match), nil
+		}
+		return match, nil
+	}
+
+	col := goqu.I(r.Field)
+	v := r.Value()
+	switch r.Op {
+	case filter.OpEq:
+		return col.Eq(v.Any()), nil
+	case filter.OpNeq:
+		return goqu.L("? IS DISTINCT FROM ?", col, v.Any()), nil
+	case filter.OpLt:
+		return col.Lt(v.Any()), nil
+	case filter.OpLte:
+		return col.Lte(v.Any()), nil
+	case filter.OpGt:
+		return col.Gt(v.Any()), nil
+	case filter.OpGte:
+		return col.Gte(v.Any()), nil
+	case filter.OpHas:
+		return col.ILike("%" + v.Str + "%"), nil
+	case filter.OpIn:
+		return col.In(values...), nil
+	default:
+		return nil, fmt.Errorf("unsupported operator %s for field %q", r.Op, r.Field)
+	}
+}
+
 func (s *TransactionStore) AggregateSpent(ctx context.Context, periodID finance.PeriodID, budgetCurrency finance.Currency, exchangeRateToBase float64) (int64, int64, error) {
 	query := `SELECT 
 		COALESCE(SUM(amount_in_base), 0) as spent_in_base,
@@ -284,7 +682,7 @@ func (s *TransactionStore) AggregateSpent(ctx context.Context, periodID finance.
 				ELSE ROUND(amount_in_base::numeric / $3)::bigint 
 			END
 		), 0) as spent_amount
-	FROM finance.transaction 
+	FROM finance.transaction_allocation 
 	WHERE period_id = $1`
 
 	var row struct {
@@ -320,7 +718,7 @@ func (s *TransactionStore) AggregateSpentBatch(ctx context.Context, periodIDs []
 					ELSE ROUND(t.amount_in_base::numeric / p.exchange_rate_to_base)::bigint 
 				END
 			), 0) as spent_amount
-		FROM finance.transaction t
+		FROM finance.transaction_allocation t
 		JOIN finance.budget_period p ON t.period_id = p.id
 		WHERE t.period_id IN (?)
 		GROUP BY t.period_id
@@ -352,12 +750,125 @@ func (s *TransactionStore) AggregateSpentBatch(ctx context.Context, periodIDs []
 	return results, nil
 }
 
+func (s *TransactionStore) ListForReconciliation(ctx context.Context, spaceID finance.SpaceID, accountID finance.AccountID, reconciliationID *finance.ReconciliationID, before time.Time) ([]*finance.Transaction, error) {
+	ds := pgDialect.From(goqu.S("finance").Table("transaction")).Select("*").
+		Where(
+			goqu.Ex{"space_id": string(spaceID), "account_id": string(accountID)},
+			goqu.I("transaction_date").Lt(before),
+		).
+		Order(goqu.I("transaction_date").Asc(), goqu.I("id").Asc())
+	if reconciliationID != nil {
+		ds = ds.Where(goqu.Ex{"reconciliation_id": string(*reconciliationID)})
+	} else {
+		ds = ds.Where(goqu.Ex{"reconciliation_id": nil})
+	}
+
+	query, args, err := ds.Prepared(true).ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("build sql query: %w", err)
+	}
+
+	var dbRows []transactionDB
+	if err := s.db.SelectContext(ctx, &dbRows, query, args...); err != nil {
+		return nil, fmt.Errorf("select context: %w", err)
+	}
+
+	txns := make([]*finance.Transaction, len(dbRows))
+	for i := range dbRows {
+		txns[i] = dbRows[i].toDomain()
+	}
+	if err := s.loadSplits(ctx, txns...); err != nil {
+		return nil, err
+	}
+	return txns, nil
+}
+
+func (s *TransactionStore) ListForGoal(ctx context.Context, spaceID finance.SpaceID, accountID *finance.AccountID, tag string, since time.Time) ([]*finance.Transaction, error) {
+	var matches []goqu.Expression
+	if accountID != nil {
+		matches = append(matches, goqu.Ex{
+			"account_id": string(*accountID),
+			"type":       []string{string(finance.TransactionTypeTransferIn), string(finance.TransactionTypeTransferOut)},
+		})
+	}
+	if tag != "" {
+		matches = append(matches, goqu.L("tags @> ?::text[]", pq.StringArray{tag}))
+	}
+	if len(matches) == 0 {
+		return []*finance.Transaction{}, nil
+	}
+
+	ds := pgDialect.From(goqu.S("finance").Table("transaction")).Select("*").
+		Where(
+			goqu.Ex{"space_id": string(spaceID)},
+			goqu.I("transaction_date").Gte(since),
+			goqu.Or(matches...),
+		).
+		Order(goqu.I("transaction_date").Asc(), goqu.I("id").Asc())
+
+	query, args, err := ds.Prepared(true).ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("build sql query: %w", err)
+	}
+
+	var dbRows []transactionDB
+	if err := s.db.SelectContext(ctx, &dbRows, query, args...); err != nil {
+		return nil, fmt.Errorf("select context: %w", err)
+	}
+
+	txns := make([]*finance.Transaction, len(dbRows))
+	for i := range dbRows {
+		txns[i] = dbRows[i].toDomain()
+	}
+	return txns, nil
+}
+
+func (s *TransactionStore) SetCleared(ctx context.Context, spaceID finance.SpaceID, ids []finance.TransactionID, cleared bool) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	ds := pgDialect.Update(goqu.S("finance").Table("transaction")).
+		Set(goqu.Record{"cleared": cleared}).
+		Where(
+			goqu.Ex{"space_id": string(spaceID), "id": transactionIDStrings(ids), "reconciliation_id": nil},
+		)
+	query, args, err := ds.Prepared(true).ToSQL()
+	if err != nil {
+		return err
+	}
+	_, err = s.db.ExecContext(ctx, query, args...)
+	return err
+}
+
+func (s *TransactionStore) MarkReconciled(ctx context.Context, spaceID finance.SpaceID, ids []finance.TransactionID, reconciliationID finance.ReconciliationID) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	ds := pgDialect.Update(goqu.S("finance").Table("transaction")).
+		Set(goqu.Record{"cleared": true, "reconciliation_id": string(reconciliationID)}).
+		Where(goqu.Ex{"space_id": string(spaceID), "id": transactionIDStrings(ids)})
+	query, args, err := ds.Prepared(true).ToSQL()
+	if err != nil {
+		return err
+	}
+	_, err = s.db.ExecContext(ctx, query, args...)
+	return err
+}
+
+func transactionIDStrings(ids []finance.TransactionID) []string {
+	out := make([]string, len(ids))
+	for i, id := range ids {
+		out[i] = string(id)
+	}
+	return out
+}
+
 func (s *TransactionStore) HasTransactions(ctx context.Context, spaceID finance.SpaceID, filter *finance.TransactionFilter) (bool, error) {
 	ds := pgDialect.From(goqu.S("finance").Table("transaction")).Select(goqu.L("1")).Where(goqu.Ex{"space_id": string(spaceID)})
 
 	if filter != nil {
 		if filter.BudgetID != nil {
-			ds = ds.Where(goqu.Ex{"budget_id": string(*filter.BudgetID)})
+			ds = ds.Where(budgetMatch(*filter.BudgetID))
 		}
 		if filter.Type != nil {
 			ds = ds.Where(goqu.Ex{"type": string(*filter.Type)})
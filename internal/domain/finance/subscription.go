@@ -0,0 +1,257 @@
+package finance
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+)
+
+const (
+	// DefaultSubscriptionLookbackDays covers just over a year so yearly charges repeat at least once.
+	DefaultSubscriptionLookbackDays = 400
+	// MaxSubscriptionLookbackDays bounds how much history the analyzer scans.
+	MaxSubscriptionLookbackDays = 1100
+	// MinSubscriptionConfidence is the lowest confidence a suggestion is reported with.
+	MinSubscriptionConfidence = 0.5
+	// maxSubscriptionAmountVariation is the largest coefficient of variation of the charged amounts
+	// still considered the same subscription.
+	maxSubscriptionAmountVariation = 0.25
+	// variableSubscriptionAmountVariation marks suggestions whose amounts vary as variable.
+	variableSubscriptionAmountVariation = 0.05
+	// fullSubscriptionHistory is the number of charges that earns the full history score.
+	fullSubscriptionHistory = 6
+)
+
+// subscriptionCadence is a billing cadence the analyzer recognises in the gaps between charges.
+type subscriptionCadence struct {
+	interval   string
+	days       int
+	tolerance  int
+	minCharges int
+}
+
+var subscriptionCadences = []subscriptionCadence{
+	{interval: "weekly", days: 7, tolerance: 1, minCharges: 3},
+	{interval: "biweekly", days: 14, tolerance: 2, minCharges: 3},
+	{interval: "monthly", days: 30, tolerance: 3, minCharges: 3},
+	{interval: "yearly", days: 365, tolerance: 7, minCharges: 2},
+}
+
+// SubscriptionSuggestion is a periodic charge found in the transaction history that is not yet
+// tracked by a recurring expense.
+type SubscriptionSuggestion struct {
+	// RecurringExpense is an unsaved template prefilled from the charges. BudgetID is empty when
+	// none of the charges were budgeted.
+	RecurringExpense *RecurringExpense
+	Confidence       float64         // Between 0 and 1
+	TransactionIDs   []TransactionID // Supporting charges, oldest first
+}
+
+// DetectSubscriptionsRequest scopes a subscription scan.
+type DetectSubscriptionsRequest struct {
+	SpaceID      SpaceID
+	LookbackDays int32
+	Now          time.Time
+}
+
+// Validate checks the request and applies the default lookback.
+func (r *DetectSubscriptionsRequest) Validate() error {
+	if err := r.SpaceID.Validate(); err != nil {
+		return fmt.Errorf("validate space ID: %w", err)
+	}
+	if r.LookbackDays == 0 {
+		r.LookbackDays = DefaultSubscriptionLookbackDays
+	}
+	if r.LookbackDays < 0 || r.LookbackDays > MaxSubscriptionLookbackDays {
+		return fmt.Errorf("lookback days must be between 1 and %d", MaxSubscriptionLookbackDays)
+	}
+	if r.Now.IsZero() {
+		return errors.New("detection time is required")
+	}
+	return nil
+}
+
+// ConvertToRecurringExpenseRequest turns a suggestion, possibly edited, into a recurring expense.
+type ConvertToRecurringExpenseRequest struct {
+	RecurringExpense *RecurringExpense
+	TransactionIDs   []TransactionID
+}
+
+// Validate checks the request.
+func (r *ConvertToRecurringExpenseRequest) Validate() error {
+	if r.RecurringExpense == nil {
+		return errors.New("recurring expense is required")
+	}
+	if err := r.RecurringExpense.SpaceID.Validate(); err != nil {
+		return fmt.Errorf("validate space ID: %w", err)
+	}
+	if len(r.TransactionIDs) == 0 {
+		return errors.New("at least one transaction is required")
+	}
+	for _, txnID := range r.TransactionIDs {
+		if err := txnID.Validate(); err != nil {
+			return fmt.Errorf("validate transaction ID: %w", err)
+		}
+	}
+	return nil
+}
+
+// DetectSubscriptions groups expenses by vendor and currency and suggests a recurring expense for
+// every group charged on a regular cadence with a stable amount. Expenses already linked to a
+// recurring expense, scheduled payment, transfer or borrowing are ignored, as are groups whose last
+// charge is more than two periods before now. Suggestions are ordered by confidence, highest first.
+func DetectSubscriptions(txns []*Transaction, now time.Time) []*SubscriptionSuggestion {
+	groups := make(map[string][]*Transaction)
+	for _, t := range txns {
+		if t.Type != TransactionTypeExpense || t.Metadata.RecurringExpenseID != nil ||
+			t.Metadata.ScheduledPaymentID != nil || t.Metadata.TransferID != nil || t.Metadata.BorrowingID != nil {
+			continue
+		}
+		vendor := vendorKey(t.Description)
+		if vendor == "" {
+			continue
+		}
+		key := vendor + "|" + string(t.Currency)
+		groups[key] = append(groups[key], t)
+	}
+
+	var suggestions []*SubscriptionSuggestion
+	for _, charges := range groups {
+		if s := suggestSubscription(charges, now); s != nil {
+			suggestions = append(suggestions, s)
+		}
+	}
+	slices.SortFunc(suggestions, func(a, b *SubscriptionSuggestion) int {
+		if c := cmp.Compare(b.Confidence, a.Confidence); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.RecurringExpense.Name, b.RecurringExpense.Name)
+	})
+	return suggestions
+}
+
+// suggestSubscription scores one vendor's charges, returning nil when they do not look periodic.
+func suggestSubscription(charges []*Transaction, now time.Time) *SubscriptionSuggestion {
+	if len(charges) < 2 {
+		return nil
+	}
+	slices.SortFunc(charges, func(a, b *Transaction) int {
+		return a.TransactionDate.Compare(b.TransactionDate)
+	})
+
+	gaps := make([]int, 0, len(charges)-1)
+	for i := 1; i < len(charges); i++ {
+		gaps = append(gaps, daysBetween(charges[i-1].TransactionDate, charges[i].TransactionDate))
+	}
+	sorted := slices.Sorted(slices.Values(gaps))
+	median := sorted[len(sorted)/2]
+
+	idx := slices.IndexFunc(subscriptionCadences, func(c subscriptionCadence) bool {
+		return abs(median-c.days) <= c.tolerance
+	})
+	if idx < 0 {
+		return nil
+	}
+	cadence := subscriptionCadences[idx]
+	if len(charges) < cadence.minCharges {
+		return nil
+	}
+
+	last := charges[len(charges)-1]
+	if daysBetween(last.TransactionDate, now) > 2*cadence.days+cadence.tolerance {
+		return nil
+	}
+
+	var regular int
+	for _, g := range gaps {
+		if abs(g-cadence.days) <= cadence.tolerance {
+			regular++
+		}
+	}
+	regularity := float64(regular) / float64(len(gaps))
+
+	var sum float64
+	for _, t := range charges {
+		sum += float64(t.Amount)
+	}
+	mean := sum / float64(len(charges))
+	var variance float64
+	for _, t := range charges {
+		variance += (float64(t.Amount) - mean) * (float64(t.Amount) - mean)
+	}
+	variation := math.Sqrt(variance/float64(len(charges))) / mean
+	if variation > maxSubscriptionAmountVariation {
+		return nil
+	}
+
+	history := min(float64(len(charges))/fullSubscriptionHistory, 1)
+	confidence := 0.5*regularity + 0.3*(1-variation/maxSubscriptionAmountVariation) + 0.2*history
+	confidence = math.Round(confidence*100) / 100
+	if confidence < MinSubscriptionConfidence {
+		return nil
+	}
+
+	re := &RecurringExpense{
+		SpaceID:    last.SpaceID,
+		BudgetID:   mostCommonBudget(charges),
+		Name:       strings.TrimSpace(last.Description),
+		Amount:     last.Amount,
+		Currency:   last.Currency,
+		Interval:   cadence.interval,
+		StartDate:  last.TransactionDate,
+		IsVariable: variation > variableSubscriptionAmountVariation,
+		Status:     RecurringExpenseActive,
+	}
+	rule, _ := RecurrenceRuleForInterval(cadence.interval)
+	if cadence.interval == "biweekly" {
+		re.RecurrenceRule = rule.String()
+	}
+	// Anchored at the latest charge so the series follows the current billing day. Occurrences
+	// already past without a charge are not suggested as due.
+	rc := &Recurrence{Rule: rule, Start: last.TransactionDate}
+	re.NextDueDate, _ = rc.After(latestDate(last.TransactionDate, now.AddDate(0, 0, -1)))
+
+	ids := make([]TransactionID, 0, len(charges))
+	for _, t := range charges {
+		ids = append(ids, t.ID)
+	}
+	return &SubscriptionSuggestion{RecurringExpense: re, Confidence: confidence, TransactionIDs: ids}
+}
+
+// vendorKey reduces a description to the words identifying the merchant, dropping the reference
+// numbers and dates card processors append to each charge.
+func vendorKey(description string) string {
+	words := strings.Fields(strings.ToLower(description))
+	words = slices.DeleteFunc(words, func(w string) bool {
+		return strings.ContainsFunc(w, unicode.IsDigit)
+	})
+	return strings.Join(words, " ")
+}
+
+// mostCommonBudget returns the budget most charges were booked against, or an empty ID.
+func mostCommonBudget(charges []*Transaction) BudgetID {
+	counts := make(map[BudgetID]int)
+	var best BudgetID
+	for _, t := range charges {
+		if t.BudgetID == nil {
+			continue
+		}
+		counts[*t.BudgetID]++
+		if counts[*t.BudgetID] > counts[best] {
+			best = *t.BudgetID
+		}
+	}
+	return best
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
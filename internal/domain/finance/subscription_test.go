@@ -0,0 +1,99 @@
+package finance
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestDetectSubscriptions(t *testing.T) {
+	budgetID, _ := NewBudgetID()
+	now := time.Date(2026, 6, 20, 12, 0, 0, 0, time.UTC)
+
+	var txns []*Transaction
+	charge := func(desc string, amount int64, date time.Time) {
+		txnID, _ := NewTransactionID()
+		txns = append(txns, &Transaction{
+			ID:              txnID,
+			Type:            TransactionTypeExpense,
+			BudgetID:        &budgetID,
+			Amount:          amount,
+			Currency:        "USD",
+			Description:     desc,
+			TransactionDate: date,
+		})
+	}
+
+	// Monthly streaming charge with a rotating reference number.
+	for i := range 6 {
+		charge(fmt.Sprintf("NETFLIX.COM %04d", 1000+i), 1599, time.Date(2026, time.January+time.Month(i), 5, 0, 0, 0, 0, time.UTC))
+	}
+	// Weekly groceries of wildly different amounts are not a subscription.
+	for i, amount := range []int64{4200, 11800, 2500, 9900} {
+		charge("Corner Market", amount, now.AddDate(0, 0, -7*(i+1)))
+	}
+	// A gym membership that stopped in February.
+	for i := range 3 {
+		charge("Gym", 3000, time.Date(2025, time.December+time.Month(i), 1, 0, 0, 0, 0, time.UTC))
+	}
+	// Every other week with a small price change.
+	for i, amount := range []int64{1000, 1000, 1200, 1000} {
+		charge("Cleaning Service", amount, now.AddDate(0, 0, -14*(i+1)))
+	}
+	// Already tracked charges are ignored.
+	recID, _ := NewRecurringExpenseID()
+	for i := range 4 {
+		charge("Rent", 150000, time.Date(2026, time.March+time.Month(i), 1, 0, 0, 0, 0, time.UTC))
+		txns[len(txns)-1].Metadata.RecurringExpenseID = &recID
+	}
+
+	suggestions := DetectSubscriptions(txns, now)
+	if len(suggestions) != 2 {
+		for _, s := range suggestions {
+			t.Logf("suggestion %q confidence %.2f", s.RecurringExpense.Name, s.Confidence)
+		}
+		t.Fatalf("got %d suggestions, want 2", len(suggestions))
+	}
+
+	netflix := suggestions[0]
+	if netflix.RecurringExpense.Name != "NETFLIX.COM 1005" || netflix.RecurringExpense.Interval != "monthly" {
+		t.Errorf("first suggestion = %q every %q, want the monthly Netflix charge", netflix.RecurringExpense.Name, netflix.RecurringExpense.Interval)
+	}
+	if netflix.Confidence != 1 {
+		t.Errorf("confidence = %v, want 1 for six identical monthly charges", netflix.Confidence)
+	}
+	if len(netflix.TransactionIDs) != 6 || netflix.TransactionIDs[0] != txns[0].ID {
+		t.Errorf("transaction IDs = %v, want the six charges oldest first", netflix.TransactionIDs)
+	}
+	if netflix.RecurringExpense.BudgetID != budgetID || netflix.RecurringExpense.Amount != 1599 || netflix.RecurringExpense.IsVariable {
+		t.Errorf("template = %+v, want a fixed 15.99 charge on the shared budget", netflix.RecurringExpense)
+	}
+	if want := time.Date(2026, 7, 5, 0, 0, 0, 0, time.UTC); !netflix.RecurringExpense.NextDueDate.Equal(want) {
+		t.Errorf("next due date = %s, want %s", netflix.RecurringExpense.NextDueDate, want)
+	}
+
+	cleaning := suggestions[1]
+	if cleaning.RecurringExpense.Interval != "biweekly" || cleaning.RecurringExpense.RecurrenceRule != "FREQ=WEEKLY;INTERVAL=2" {
+		t.Errorf("cleaning cadence = %q / %q, want biweekly", cleaning.RecurringExpense.Interval, cleaning.RecurringExpense.RecurrenceRule)
+	}
+	if !cleaning.RecurringExpense.IsVariable || cleaning.Confidence >= netflix.Confidence {
+		t.Errorf("cleaning variable = %v, confidence = %v, want a variable, less confident suggestion", cleaning.RecurringExpense.IsVariable, cleaning.Confidence)
+	}
+	if cleaning.RecurringExpense.Amount != 1000 {
+		t.Errorf("cleaning amount = %d, want the latest charge", cleaning.RecurringExpense.Amount)
+	}
+}
+
+func TestVendorKey(t *testing.T) {
+	tests := map[string]string{
+		"NETFLIX.COM 866-579-7172": "netflix.com",
+		"  Spotify   P2A3B4 ":      "spotify",
+		"AMZN Mktp US*2K4":         "amzn mktp",
+		"12/05":                    "",
+	}
+	for in, want := range tests {
+		if got := vendorKey(in); got != want {
+			t.Errorf("vendorKey(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/masterkeysrd/saturn/internal/platform/id"
+	"github.com/masterkeysrd/saturn/internal/platform/patch"
 )
 
 type TransactionType string
@@ -68,6 +69,7 @@ const transactionPrefix = "txn_"
 type TransactionMetadata struct {
 	ScheduledPaymentID   *ScheduledPaymentID `json:"scheduled_payment_id,omitempty"`
 	RecurringExpenseID   *RecurringExpenseID `json:"recurring_expense_id,omitempty"`
+	IncomeSourceID       *IncomeSourceID     `json:"income_source_id,omitempty"`
 	BorrowingID          *BorrowingID        `json:"borrowing_id,omitempty"`
 	BorrowingRole        string              `json:"borrowing_role,omitempty"` // "INITIAL_FUNDING", "REPAYMENT", "ADDITIONAL_LOAN"
 	BorrowingAmount      int64               `json:"borrowing_amount,omitempty"`
@@ -79,21 +81,32 @@ type TransactionMetadata struct {
 
 // Transaction represents a financial record in the space ledger.
 type Transaction struct {
-	ID              TransactionID
-	SpaceID         SpaceID
-	Type            TransactionType
-	BudgetID        *BudgetID  // Nullable
-	PeriodID        *PeriodID  // Nullable
-	AccountID       *AccountID // Nullable
-	Amount          int64      // Unsigned in local currency cents
-	Currency        Currency
-	AmountInBase    int64 // Unsigned in workspace base currency cents
-	Description     string
-	TransactionDate time.Time
-	EffectiveDate   time.Time
-	Metadata        TransactionMetadata
-	CreateTime      time.Time
-	UpdateTime      time.Time
+	ID               TransactionID
+	SpaceID          SpaceID
+	Type             TransactionType
+	BudgetID         *BudgetID   // Nullable
+	PeriodID         *PeriodID   // Nullable
+	AccountID        *AccountID  // Nullable
+	CategoryID       *CategoryID // Nullable
+	PayeeID          *PayeeID    // Nullable
+	Tags             []string
+	Splits           []*TransactionSplit
+	Amount           int64 // Unsigned in local currency cents
+	Currency         Currency
+	AmountInBase     int64 // Unsigned in workspace base currency cents
+	Description      string
+	TransactionDate  time.Time
+	EffectiveDate    time.Time
+	Metadata         TransactionMetadata
+	Cleared          bool              // Confirmed against a bank statement
+	ReconciliationID *ReconciliationID // Set once a finalized reconciliation locks the transaction
+	CreateTime       time.Time
+	UpdateTime       time.Time
+}
+
+// IsReconciled reports whether the transaction is locked by a finalized reconciliation.
+func (t *Transaction) IsReconciled() bool {
+	return t.ReconciliationID != nil && *t.ReconciliationID != ""
 }
 
 // Validate checks basic properties of a transaction.
@@ -116,6 +129,9 @@ func (t *Transaction) Validate() error {
 	if err := t.Currency.Validate(); err != nil {
 		return fmt.Errorf("validate currency: %w", err)
 	}
+	if err := t.Currency.ValidateAmount(t.Amount); err != nil {
+		return fmt.Errorf("validate amount: %w", err)
+	}
 	if t.Type != TransactionTypeBalanceAdjustment && t.AmountInBase <= 0 {
 		return errors.New("transaction amount in base currency must be greater than zero")
 	}
@@ -124,11 +140,29 @@ func (t *Transaction) Validate() error {
 			return fmt.Errorf("validate account ID: %w", err)
 		}
 	}
+	if t.CategoryID != nil {
+		if err := t.CategoryID.Validate(); err != nil {
+			return fmt.Errorf("validate category ID: %w", err)
+		}
+	}
+	if t.PayeeID != nil {
+		if err := t.PayeeID.Validate(); err != nil {
+			return fmt.Errorf("validate payee ID: %w", err)
+		}
+	}
 	if t.Metadata.TransferID != nil {
 		if err := t.Metadata.TransferID.Validate(); err != nil {
 			return fmt.Errorf("validate transfer ID: %w", err)
 		}
 	}
+	if t.Metadata.IncomeSourceID != nil {
+		if err := t.Metadata.IncomeSourceID.Validate(); err != nil {
+			return fmt.Errorf("validate income source ID: %w", err)
+		}
+	}
+	if t.IsSplit() {
+		return t.validateSplits()
+	}
 	if t.Type == TransactionTypeExpense {
 		isBorrowing := t.Metadata.BorrowingID != nil && *t.Metadata.BorrowingID != ""
 		if !isBorrowing {
@@ -149,6 +183,15 @@ func (t *Transaction) Validate() error {
 	return nil
 }
 
+// TransactionPatchSchema defines the transaction fields that can be changed in bulk.
+var TransactionPatchSchema = patch.NewSchema[Transaction]().
+	Register("description", patch.Field(func(t *Transaction) *string { return &t.Description })).
+	Register("budget_id", patch.Field(func(t *Transaction) **BudgetID { return &t.BudgetID })).
+	Register("account_id", patch.Field(func(t *Transaction) **AccountID { return &t.AccountID })).
+	Register("category_id", patch.Field(func(t *Transaction) **CategoryID { return &t.CategoryID })).
+	Register("payee_id", patch.Field(func(t *Transaction) **PayeeID { return &t.PayeeID })).
+	Register("tags", patch.Field(func(t *Transaction) *[]string { return &t.Tags }))
+
 // DefaultTransactionSortField represents the fallback sorting column name for transactions.
 const DefaultTransactionSortField = "transaction_date"
 
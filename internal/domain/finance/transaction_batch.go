@@ -0,0 +1,202 @@
+package finance
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// MaxTransactionBatchSize is the maximum number of transactions a single batch operation may touch.
+const MaxTransactionBatchSize = 500
+
+// TransactionSelector picks the transactions of a batch operation, either by ID or by a filter
+// expression optionally combined with a saved filter.
+type TransactionSelector struct {
+	IDs           []TransactionID
+	Expression    string         // AIP-160 expression over TransactionFilterFields
+	SavedFilterID *SavedFilterID // Nullable
+}
+
+// Validate checks that exactly one selection mode is used, and deduplicates the IDs.
+func (s *TransactionSelector) Validate() error {
+	s.Expression = strings.TrimSpace(s.Expression)
+	byFilter := s.Expression != "" || s.SavedFilterID != nil
+	if len(s.IDs) == 0 && !byFilter {
+		return errors.New("transaction IDs or a filter are required")
+	}
+	if len(s.IDs) > 0 && byFilter {
+		return errors.New("transaction IDs and a filter are mutually exclusive")
+	}
+
+	ids := make([]TransactionID, 0, len(s.IDs))
+	for _, id := range s.IDs {
+		if err := id.Validate(); err != nil {
+			return fmt.Errorf("validate transaction ID: %w", err)
+		}
+		if !slices.Contains(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) > MaxTransactionBatchSize {
+		return fmt.Errorf("a batch can contain at most %d transactions", MaxTransactionBatchSize)
+	}
+	s.IDs = ids
+
+	if s.SavedFilterID != nil {
+		if err := s.SavedFilterID.Validate(); err != nil {
+			return fmt.Errorf("validate saved filter ID: %w", err)
+		}
+	}
+	return nil
+}
+
+// BatchUpdateTransactionsRequest applies the same field changes to many transactions at once.
+type BatchUpdateTransactionsRequest struct {
+	SpaceID      SpaceID
+	Selector     TransactionSelector
+	Transaction  *Transaction // Carries the new values of the fields in UpdateMask
+	UpdateMask   []string     // Fields of TransactionPatchSchema to change
+	ValidateOnly bool         // Compute the outcome without persisting it
+}
+
+// Validate checks the request.
+func (r *BatchUpdateTransactionsRequest) Validate() error {
+	if err := r.SpaceID.Validate(); err != nil {
+		return fmt.Errorf("validate space ID: %w", err)
+	}
+	if err := r.Selector.Validate(); err != nil {
+		return err
+	}
+	if r.Transaction == nil {
+		return errors.New("transaction values are required")
+	}
+	if len(r.UpdateMask) == 0 {
+		return errors.New("update mask is required")
+	}
+	return nil
+}
+
+// BatchDeleteTransactionsRequest deletes many transactions at once.
+type BatchDeleteTransactionsRequest struct {
+	SpaceID      SpaceID
+	Selector     TransactionSelector
+	ValidateOnly bool // Compute the outcome without persisting it
+}
+
+// Validate checks the request.
+func (r *BatchDeleteTransactionsRequest) Validate() error {
+	if err := r.SpaceID.Validate(); err != nil {
+		return fmt.Errorf("validate space ID: %w", err)
+	}
+	return r.Selector.Validate()
+}
+
+// TransactionFieldChange is the old and new value of a single field changed by a batch update.
+type TransactionFieldChange struct {
+	Field    string
+	OldValue string
+	NewValue string
+}
+
+// TransactionDiff lists the field changes a batch update makes to one transaction.
+type TransactionDiff struct {
+	TransactionID TransactionID
+	Changes       []TransactionFieldChange
+}
+
+// AccountBalanceChange is the net effect of a batch operation on an account's current balance.
+type AccountBalanceChange struct {
+	AccountID  AccountID
+	OldBalance int64
+	NewBalance int64
+}
+
+// BatchTransactionsResult reports the outcome of a batch operation. When the request was
+// validate-only it describes what would have happened.
+type BatchTransactionsResult struct {
+	Transactions   []*Transaction // Updated or deleted transactions
+	Diffs          []*TransactionDiff
+	BalanceChanges []*AccountBalanceChange
+	PeriodIDs      []PeriodID // Budget periods whose spent totals change
+}
+
+// TransactionBatch is a set of changes persisted in a single database transaction.
+type TransactionBatch struct {
+	SpaceID       SpaceID
+	Updated       []*Transaction
+	Deleted       []TransactionID
+	BalanceDeltas map[AccountID]int64 // Added to each account's current balance
+	Events        []*TransactionEvent
+	Periods       []*BudgetPeriod // Budget periods created for the updated transactions
+}
+
+// checkBatchable reports whether a transaction can be changed by a batch operation. Transfers,
+// balance adjustments and borrowing-linked transactions keep counterpart records in sync and must
+// be changed one at a time.
+func checkBatchable(txn *Transaction) error {
+	if txn.Type != TransactionTypeExpense && txn.Type != TransactionTypeIncome {
+		return fmt.Errorf("transaction %s: only expenses and income can be changed in a batch", txn.ID)
+	}
+	if txn.Metadata.BorrowingID != nil && *txn.Metadata.BorrowingID != "" {
+		return fmt.Errorf("transaction %s: borrowing transactions cannot be changed in a batch", txn.ID)
+	}
+	if txn.IsReconciled() {
+		return fmt.Errorf("transaction %s: %w", txn.ID, ErrTransactionReconciled)
+	}
+	return nil
+}
+
+// diffTransactionFields compares the patchable fields named in the mask.
+func diffTransactionFields(existing, txn *Transaction, mask []string) []TransactionFieldChange {
+	var changes []TransactionFieldChange
+	for _, field := range mask {
+		oldValue, newValue := transactionFieldValue(existing, field), transactionFieldValue(txn, field)
+		if oldValue != newValue {
+			changes = append(changes, TransactionFieldChange{Field: field, OldValue: oldValue, NewValue: newValue})
+		}
+	}
+	return changes
+}
+
+func transactionFieldValue(txn *Transaction, field string) string {
+	switch field {
+	case "description":
+		return txn.Description
+	case "budget_id":
+		return optionalString(txn.BudgetID)
+	case "account_id":
+		return optionalString(txn.AccountID)
+	case "category_id":
+		return optionalString(txn.CategoryID)
+	case "payee_id":
+		return optionalString(txn.PayeeID)
+	case "tags":
+		return strings.Join(txn.Tags, ",")
+	}
+	return ""
+}
+
+func optionalString[T ~string](v *T) string {
+	if v == nil {
+		return ""
+	}
+	return string(*v)
+}
+
+// transactionPeriodIDs returns the budget periods a transaction's spend is attributed to.
+func transactionPeriodIDs(txn *Transaction) []PeriodID {
+	if !txn.IsSplit() {
+		if txn.PeriodID == nil {
+			return nil
+		}
+		return []PeriodID{*txn.PeriodID}
+	}
+	var ids []PeriodID
+	for _, split := range txn.Splits {
+		if split.PeriodID != nil {
+			ids = append(ids, *split.PeriodID)
+		}
+	}
+	return ids
+}
@@ -0,0 +1,387 @@
+package finance
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/masterkeysrd/saturn/internal/platform/id"
+	"github.com/masterkeysrd/saturn/internal/platform/patch"
+	"github.com/masterkeysrd/saturn/internal/platform/sorting"
+)
+
+// TransactionRuleID is a custom string type representing a transaction rule's unique identifier (KSUID).
+type TransactionRuleID string
+
+const transactionRulePrefix = "trr_"
+
+// NewTransactionRuleID creates a new TransactionRuleID using the default ID generator.
+func NewTransactionRuleID() (TransactionRuleID, error) {
+	raw, err := id.Generate(transactionRulePrefix)
+	if err != nil {
+		return "", err
+	}
+	return TransactionRuleID(raw), nil
+}
+
+// ParseTransactionRuleID parses a string into a TransactionRuleID and validates it.
+func ParseTransactionRuleID(s string) (TransactionRuleID, error) {
+	if err := id.Validate(s, transactionRulePrefix); err != nil {
+		return "", fmt.Errorf("invalid transaction rule ID: %w", err)
+	}
+	return TransactionRuleID(s), nil
+}
+
+// String returns the string representation.
+func (rid TransactionRuleID) String() string {
+	return string(rid)
+}
+
+// Validate checks if the TransactionRuleID is valid.
+func (rid TransactionRuleID) Validate() error {
+	return id.Validate(string(rid), transactionRulePrefix)
+}
+
+// TransactionRule is a user-defined "if ... then ..." rule that classifies transactions.
+// Every condition that is set must hold for the rule to match; rules are evaluated by ascending
+// priority and, for each action, the first matching rule that sets it wins.
+type TransactionRule struct {
+	ID       TransactionRuleID
+	SpaceID  SpaceID
+	Name     string
+	Priority int32 // Lower runs first
+	IsActive bool
+
+	// Conditions
+	DescriptionPattern string     // Case-insensitive regular expression over the description
+	AccountID          *AccountID // Nullable
+	MinAmount          *int64     // Nullable; inclusive, in transaction currency cents
+	MaxAmount          *int64     // Nullable; inclusive, in transaction currency cents
+
+	// Actions
+	SetBudgetID   *BudgetID   // Nullable; only applied to expenses that are not split
+	SetPayeeID    *PayeeID    // Nullable
+	SetCategoryID *CategoryID // Nullable
+	AddTags       []string
+
+	CreateTime time.Time
+	UpdateTime time.Time
+}
+
+// Validate checks the rule's business rules, including that it has a condition and an action.
+func (r *TransactionRule) Validate() error {
+	if err := r.ID.Validate(); err != nil {
+		return fmt.Errorf("validate transaction rule ID: %w", err)
+	}
+	if err := r.SpaceID.Validate(); err != nil {
+		return fmt.Errorf("validate space ID: %w", err)
+	}
+	r.Name = strings.TrimSpace(r.Name)
+	if r.Name == "" {
+		return errors.New("transaction rule name is required")
+	}
+	if len(r.Name) > 100 {
+		return errors.New("transaction rule name must not exceed 100 characters")
+	}
+	if r.Priority < 0 {
+		return errors.New("transaction rule priority must not be negative")
+	}
+
+	r.DescriptionPattern = strings.TrimSpace(r.DescriptionPattern)
+	if r.DescriptionPattern != "" {
+		if _, err := compilePattern(r.DescriptionPattern); err != nil {
+			return err
+		}
+	}
+	if r.AccountID != nil {
+		if err := r.AccountID.Validate(); err != nil {
+			return fmt.Errorf("validate account ID: %w", err)
+		}
+	}
+	if (r.MinAmount != nil && *r.MinAmount < 0) || (r.MaxAmount != nil && *r.MaxAmount < 0) {
+		return errors.New("transaction rule amounts must not be negative")
+	}
+	if r.MinAmount != nil && r.MaxAmount != nil && *r.MinAmount > *r.MaxAmount {
+		return errors.New("transaction rule minimum amount must not exceed the maximum amount")
+	}
+	if r.DescriptionPattern == "" && r.AccountID == nil && r.MinAmount == nil && r.MaxAmount == nil {
+		return errors.New("transaction rule requires at least one condition")
+	}
+
+	if r.SetBudgetID != nil {
+		if err := r.SetBudgetID.Validate(); err != nil {
+			return fmt.Errorf("validate budget ID: %w", err)
+		}
+	}
+	if r.SetPayeeID != nil {
+		if err := r.SetPayeeID.Validate(); err != nil {
+			return fmt.Errorf("validate payee ID: %w", err)
+		}
+	}
+	if r.SetCategoryID != nil {
+		if err := r.SetCategoryID.Validate(); err != nil {
+			return fmt.Errorf("validate category ID: %w", err)
+		}
+	}
+	tags, err := NormalizeTags(r.AddTags)
+	if err != nil {
+		return err
+	}
+	r.AddTags = tags
+	if r.SetBudgetID == nil && r.SetPayeeID == nil && r.SetCategoryID == nil && len(r.AddTags) == 0 {
+		return errors.New("transaction rule requires at least one action")
+	}
+	return nil
+}
+
+// TransactionRulePatchSchema defines all patchable fields for a TransactionRule entity.
+var TransactionRulePatchSchema = patch.NewSchema[TransactionRule]().
+	Register("name", patch.Field(func(r *TransactionRule) *string { return &r.Name })).
+	Register("priority", patch.Field(func(r *TransactionRule) *int32 { return &r.Priority })).
+	Register("is_active", patch.Field(func(r *TransactionRule) *bool { return &r.IsActive })).
+	Register("description_pattern", patch.Field(func(r *TransactionRule) *string { return &r.DescriptionPattern })).
+	Register("account_id", patch.Field(func(r *TransactionRule) **AccountID { return &r.AccountID })).
+	Register("min_amount", patch.Field(func(r *TransactionRule) **int64 { return &r.MinAmount })).
+	Register("max_amount", patch.Field(func(r *TransactionRule) **int64 { return &r.MaxAmount })).
+	Register("set_budget_id", patch.Field(func(r *TransactionRule) **BudgetID { return &r.SetBudgetID })).
+	Register("set_payee_id", patch.Field(func(r *TransactionRule) **PayeeID { return &r.SetPayeeID })).
+	Register("set_category_id", patch.Field(func(r *TransactionRule) **CategoryID { return &r.SetCategoryID })).
+	Register("add_tags", patch.Field(func(r *TransactionRule) *[]string { return &r.AddTags }))
+
+// ApplyPatch applies partial updates from an incoming rule based on the field mask.
+func (r *TransactionRule) ApplyPatch(incoming *TransactionRule, mask []string) error {
+	if err := TransactionRulePatchSchema.Apply(r, incoming, mask); err != nil {
+		return err
+	}
+	r.UpdateTime = time.Now().UTC()
+	return r.Validate()
+}
+
+// DefaultTransactionRuleSortField represents the fallback sorting column name for transaction rules.
+const DefaultTransactionRuleSortField = "priority"
+
+// TransactionRuleSortFields registry maps sortable rule field names to their cursor string extraction logic.
+var TransactionRuleSortFields = map[string]func(*TransactionRule) string{
+	"priority":    func(r *TransactionRule) string { return fmt.Sprintf("%010d", r.Priority) },
+	"name":        func(r *TransactionRule) string { return r.Name },
+	"create_time": func(r *TransactionRule) string { return r.CreateTime.Format(time.RFC3339Nano) },
+}
+
+// IsTransactionRuleSortField validates if a sort column name is allowed.
+func IsTransactionRuleSortField(field string) bool {
+	_, ok := TransactionRuleSortFields[field]
+	return ok
+}
+
+// GetSortValue extracts the sort value string for pagination cursor generation.
+func (r *TransactionRule) GetSortValue(field string) string {
+	if fn, ok := TransactionRuleSortFields[field]; ok {
+		return fn(r)
+	}
+	return r.GetSortValue(DefaultTransactionRuleSortField)
+}
+
+// ListTransactionRulesFilter encapsulates filtering parameters for listing transaction rules.
+type ListTransactionRulesFilter struct {
+	PageSize      int32
+	NextPageToken string
+	Sort          sorting.SortOrder
+	ActiveOnly    *bool
+}
+
+// TransactionRuleMatch is the combined outcome of the rules and payees matching a transaction.
+type TransactionRuleMatch struct {
+	RuleIDs    []TransactionRuleID // Matching rules, in evaluation order
+	Payee      *Payee              // Nil when no rule or payee matched
+	BudgetID   *BudgetID
+	AccountID  *AccountID
+	CategoryID *CategoryID
+	Tags       []string
+}
+
+// IsEmpty reports whether the match has nothing to apply.
+func (m *TransactionRuleMatch) IsEmpty() bool {
+	return m.Payee == nil && m.BudgetID == nil && m.AccountID == nil && m.CategoryID == nil && len(m.Tags) == 0
+}
+
+// ApplyTo fills the transaction's payee, budget, category and account from the match and adds its
+// tags, reporting whether anything changed. Without overwrite only empty fields are filled. The
+// account is never replaced, and the budget is only set on expenses that are not split.
+func (m *TransactionRuleMatch) ApplyTo(txn *Transaction, overwrite bool) bool {
+	var changed bool
+	if m.Payee != nil && (txn.PayeeID == nil || (overwrite && *txn.PayeeID != m.Payee.ID)) {
+		payeeID := m.Payee.ID
+		txn.PayeeID = &payeeID
+		changed = true
+	}
+	if m.BudgetID != nil && txn.Type == TransactionTypeExpense && !txn.IsSplit() &&
+		(txn.BudgetID == nil || (overwrite && *txn.BudgetID != *m.BudgetID)) {
+		budgetID := *m.BudgetID
+		txn.BudgetID = &budgetID
+		changed = true
+	}
+	if m.CategoryID != nil && (txn.CategoryID == nil || (overwrite && *txn.CategoryID != *m.CategoryID)) {
+		categoryID := *m.CategoryID
+		txn.CategoryID = &categoryID
+		changed = true
+	}
+	if m.AccountID != nil && txn.AccountID == nil {
+		accountID := *m.AccountID
+		txn.AccountID = &accountID
+		changed = true
+	}
+	for _, tag := range m.Tags {
+		if !slices.Contains(txn.Tags, tag) {
+			txn.Tags = append(slices.Clip(txn.Tags), tag)
+			changed = true
+		}
+	}
+	return changed
+}
+
+// compiledTransactionRule is an active rule with its description pattern compiled.
+type compiledTransactionRule struct {
+	rule    *TransactionRule
+	pattern *regexp.Regexp
+}
+
+func (c *compiledTransactionRule) matches(txn *Transaction) bool {
+	r := c.rule
+	if c.pattern != nil && !c.pattern.MatchString(txn.Description) {
+		return false
+	}
+	if r.AccountID != nil && (txn.AccountID == nil || *txn.AccountID != *r.AccountID) {
+		return false
+	}
+	if r.MinAmount != nil && txn.Amount < *r.MinAmount {
+		return false
+	}
+	if r.MaxAmount != nil && txn.Amount > *r.MaxAmount {
+		return false
+	}
+	return true
+}
+
+// TransactionRuleSet evaluates a space's active rules and payees against transactions.
+type TransactionRuleSet struct {
+	rules  []*compiledTransactionRule
+	payees []*payeeMatcher
+	byID   map[PayeeID]*Payee
+}
+
+// NewTransactionRuleSet compiles the active rules, ordered by priority, and the payee matchers.
+func NewTransactionRuleSet(rules []*TransactionRule, payees []*Payee) (*TransactionRuleSet, error) {
+	rs := &TransactionRuleSet{byID: make(map[PayeeID]*Payee, len(payees))}
+	for _, r := range rules {
+		if !r.IsActive {
+			continue
+		}
+		c := &compiledTransactionRule{rule: r}
+		if r.DescriptionPattern != "" {
+			re, err := compilePattern(r.DescriptionPattern)
+			if err != nil {
+				return nil, fmt.Errorf("rule %q: %w", r.Name, err)
+			}
+			c.pattern = re
+		}
+		rs.rules = append(rs.rules, c)
+	}
+	slices.SortStableFunc(rs.rules, func(a, b *compiledTransactionRule) int {
+		if c := cmp.Compare(a.rule.Priority, b.rule.Priority); c != 0 {
+			return c
+		}
+		return a.rule.CreateTime.Compare(b.rule.CreateTime)
+	})
+
+	for _, p := range payees {
+		m, err := newPayeeMatcher(p)
+		if err != nil {
+			return nil, fmt.Errorf("payee %q: %w", p.Name, err)
+		}
+		rs.payees = append(rs.payees, m)
+		rs.byID[p.ID] = p
+	}
+	return rs, nil
+}
+
+// Match evaluates the rules against the transaction. When no rule sets a payee, the payee whose
+// name, alias or pattern matches the description most specifically is used, and its defaults fill
+// the budget and account left unset by the rules.
+func (rs *TransactionRuleSet) Match(txn *Transaction) *TransactionRuleMatch {
+	m := &TransactionRuleMatch{}
+	for _, c := range rs.rules {
+		if !c.matches(txn) {
+			continue
+		}
+		r := c.rule
+		m.RuleIDs = append(m.RuleIDs, r.ID)
+		if m.Payee == nil && r.SetPayeeID != nil {
+			m.Payee = rs.byID[*r.SetPayeeID]
+		}
+		if m.BudgetID == nil && r.SetBudgetID != nil {
+			m.BudgetID = r.SetBudgetID
+		}
+		if m.CategoryID == nil && r.SetCategoryID != nil {
+			m.CategoryID = r.SetCategoryID
+		}
+		for _, tag := range r.AddTags {
+			if !slices.Contains(m.Tags, tag) {
+				m.Tags = append(m.Tags, tag)
+			}
+		}
+	}
+
+	if m.Payee == nil {
+		m.Payee = rs.matchPayee(txn.Description)
+	}
+	if m.Payee != nil {
+		if m.BudgetID == nil {
+			m.BudgetID = m.Payee.DefaultBudgetID
+		}
+		m.AccountID = m.Payee.DefaultAccountID
+	}
+	return m
+}
+
+// matchPayee returns the payee matching the description most specifically, or nil.
+func (rs *TransactionRuleSet) matchPayee(description string) *Payee {
+	normalized := " " + normalizePayeeText(description) + " "
+	var best *Payee
+	var bestScore int
+	for _, pm := range rs.payees {
+		if score := pm.match(description, normalized); score > bestScore {
+			best, bestScore = pm.payee, score
+		}
+	}
+	return best
+}
+
+// ApplyTransactionRulesRequest re-runs the rules over the transaction history of a space.
+type ApplyTransactionRulesRequest struct {
+	SpaceID   SpaceID
+	StartDate *time.Time // Nullable; inclusive
+	EndDate   *time.Time // Nullable; inclusive
+	Overwrite bool       // Replace payees, budgets and categories that are already set
+}
+
+// Validate checks the request.
+func (r *ApplyTransactionRulesRequest) Validate() error {
+	if err := r.SpaceID.Validate(); err != nil {
+		return fmt.Errorf("validate space ID: %w", err)
+	}
+	if r.StartDate != nil && r.EndDate != nil && r.EndDate.Before(*r.StartDate) {
+		return errors.New("end date must not be before start date")
+	}
+	return nil
+}
+
+// ApplyTransactionRulesResult reports the outcome of a bulk rule run.
+type ApplyTransactionRulesResult struct {
+	Scanned int32 // Transactions evaluated
+	Updated int32 // Transactions changed by a rule or payee
+	Skipped int32 // Reconciled transactions left untouched
+}
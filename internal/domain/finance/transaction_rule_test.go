@@ -0,0 +1,158 @@
+package finance
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTransactionRule_Validate(t *testing.T) {
+	ruleID, _ := NewTransactionRuleID()
+	budgetID, _ := NewBudgetID()
+	spaceID := SpaceID("spc_2NzN5q3y1pUQvU1ZQ9t2tq8xKxF")
+	small, large := int64(100), int64(50)
+
+	tests := []struct {
+		name    string
+		rule    TransactionRule
+		wantErr string
+	}{
+		{
+			name: "valid",
+			rule: TransactionRule{ID: ruleID, SpaceID: spaceID, Name: "Groceries", DescriptionPattern: "market", SetBudgetID: &budgetID},
+		},
+		{
+			name:    "no condition",
+			rule:    TransactionRule{ID: ruleID, SpaceID: spaceID, Name: "Everything", SetBudgetID: &budgetID},
+			wantErr: "condition",
+		},
+		{
+			name:    "no action",
+			rule:    TransactionRule{ID: ruleID, SpaceID: spaceID, Name: "Nothing", DescriptionPattern: "market"},
+			wantErr: "action",
+		},
+		{
+			name:    "inverted amount range",
+			rule:    TransactionRule{ID: ruleID, SpaceID: spaceID, Name: "Range", MinAmount: &small, MaxAmount: &large, AddTags: []string{"big"}},
+			wantErr: "amount",
+		},
+		{
+			name:    "invalid pattern",
+			rule:    TransactionRule{ID: ruleID, SpaceID: spaceID, Name: "Broken", DescriptionPattern: "[a-", SetBudgetID: &budgetID},
+			wantErr: "invalid pattern",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.rule.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestTransactionRuleSet_Match(t *testing.T) {
+	groceries, _ := NewBudgetID()
+	shopping, _ := NewBudgetID()
+	travel, _ := NewBudgetID()
+	visa, _ := NewAccountID()
+	checking, _ := NewAccountID()
+
+	amazonID, _ := NewPayeeID()
+	amazon := &Payee{ID: amazonID, Name: "Amazon", Aliases: []string{"AMZN MKTP"}, DefaultBudgetID: &shopping, DefaultAccountID: &visa}
+	marketID, _ := NewPayeeID()
+	market := &Payee{ID: marketID, Name: "Market"}
+	freshID, _ := NewPayeeID()
+	fresh := &Payee{ID: freshID, Name: "Fresh Market"}
+
+	rule := func(priority int32, r TransactionRule) *TransactionRule {
+		ruleID, _ := NewTransactionRuleID()
+		r.ID = ruleID
+		r.Priority = priority
+		r.IsActive = true
+		r.CreateTime = time.Now()
+		return &r
+	}
+	over := int64(50000)
+	rules := []*TransactionRule{
+		rule(20, TransactionRule{Name: "Amazon on checking", DescriptionPattern: "amzn", AccountID: &checking, SetBudgetID: &groceries, AddTags: []string{"household"}}),
+		rule(10, TransactionRule{Name: "Big Amazon orders", DescriptionPattern: "amzn", MinAmount: &over, SetBudgetID: &travel, AddTags: []string{"large"}}),
+		rule(0, TransactionRule{Name: "Disabled", DescriptionPattern: ".*", SetBudgetID: &shopping}),
+	}
+	rules[2].IsActive = false
+
+	rs, err := NewTransactionRuleSet(rules, []*Payee{amazon, market, fresh})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("payee defaults apply when no rule matches", func(t *testing.T) {
+		txn := &Transaction{Type: TransactionTypeExpense, Description: "AMZN Mktp US*2K3", Amount: 1299}
+		m := rs.Match(txn)
+		if m.Payee != amazon || *m.BudgetID != shopping || *m.AccountID != visa {
+			t.Fatalf("unexpected match: %+v", m)
+		}
+		if len(m.RuleIDs) != 0 {
+			t.Errorf("expected no rules to match, got %v", m.RuleIDs)
+		}
+		if !m.ApplyTo(txn, false) || *txn.PayeeID != amazonID || *txn.BudgetID != shopping || *txn.AccountID != visa {
+			t.Errorf("unexpected transaction after apply: %+v", txn)
+		}
+	})
+
+	t.Run("rules win in priority order", func(t *testing.T) {
+		txn := &Transaction{Type: TransactionTypeExpense, Description: "AMZN MKTP US", Amount: 80000, AccountID: &checking}
+		m := rs.Match(txn)
+		if len(m.RuleIDs) != 2 || m.RuleIDs[0] != rules[1].ID {
+			t.Fatalf("expected both active rules in priority order, got %v", m.RuleIDs)
+		}
+		if *m.BudgetID != travel {
+			t.Errorf("expected the higher priority budget, got %s", *m.BudgetID)
+		}
+		if !slices.Equal(m.Tags, []string{"large", "household"}) {
+			t.Errorf("unexpected tags: %v", m.Tags)
+		}
+	})
+
+	t.Run("most specific payee wins", func(t *testing.T) {
+		m := rs.Match(&Transaction{Description: "FRESH MARKET #12"})
+		if m.Payee != fresh {
+			t.Fatalf("expected Fresh Market, got %+v", m.Payee)
+		}
+	})
+
+	t.Run("apply keeps existing values without overwrite", func(t *testing.T) {
+		txn := &Transaction{Type: TransactionTypeExpense, Description: "AMZN MKTP", BudgetID: &groceries, AccountID: &checking, Tags: []string{"household"}}
+		m := rs.Match(txn)
+		m.ApplyTo(txn, false)
+		if *txn.BudgetID != groceries || *txn.AccountID != checking {
+			t.Errorf("expected existing budget and account to be kept: %+v", txn)
+		}
+		if !slices.Equal(txn.Tags, []string{"household"}) {
+			t.Errorf("expected tags not to be duplicated, got %v", txn.Tags)
+		}
+
+		overwritten := &Transaction{Type: TransactionTypeExpense, Description: "AMZN MKTP", Amount: 60000, BudgetID: &groceries, AccountID: &checking}
+		rs.Match(overwritten).ApplyTo(overwritten, true)
+		if *overwritten.BudgetID != travel || *overwritten.AccountID != checking {
+			t.Errorf("expected budget to be overwritten and account kept: %+v", overwritten)
+		}
+	})
+
+	t.Run("income never gets a budget", func(t *testing.T) {
+		txn := &Transaction{Type: TransactionTypeIncome, Description: "Amazon refund"}
+		rs.Match(txn).ApplyTo(txn, false)
+		if txn.BudgetID != nil || txn.PayeeID == nil {
+			t.Errorf("unexpected income after apply: %+v", txn)
+		}
+	})
+}
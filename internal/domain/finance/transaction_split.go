@@ -0,0 +1,191 @@
+package finance
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/masterkeysrd/saturn/internal/platform/id"
+)
+
+// TransactionSplitID is a custom string type representing a split line's unique identifier (KSUID).
+type TransactionSplitID string
+
+const transactionSplitPrefix = "tsp_"
+
+// MaxTransactionSplits bounds the number of split lines a single transaction can carry.
+const MaxTransactionSplits = 50
+
+// NewTransactionSplitID creates a new TransactionSplitID using the default ID generator.
+func NewTransactionSplitID() (TransactionSplitID, error) {
+	raw, err := id.Generate(transactionSplitPrefix)
+	if err != nil {
+		return "", err
+	}
+	return TransactionSplitID(raw), nil
+}
+
+// ParseTransactionSplitID parses a string into a TransactionSplitID and validates it.
+func ParseTransactionSplitID(s string) (TransactionSplitID, error) {
+	if err := id.Validate(s, transactionSplitPrefix); err != nil {
+		return "", fmt.Errorf("invalid transaction split ID: %w", err)
+	}
+	return TransactionSplitID(s), nil
+}
+
+// String returns the string representation.
+func (sid TransactionSplitID) String() string {
+	return string(sid)
+}
+
+// Validate checks if the TransactionSplitID is valid.
+func (sid TransactionSplitID) Validate() error {
+	return id.Validate(string(sid), transactionSplitPrefix)
+}
+
+// TransactionSplit allocates a portion of a transaction to its own budget and category.
+// The amounts of all splits on a transaction must add up to the transaction amount.
+type TransactionSplit struct {
+	ID           TransactionSplitID
+	BudgetID     *BudgetID   // Nullable
+	PeriodID     *PeriodID   // Nullable
+	CategoryID   *CategoryID // Nullable
+	Amount       int64       // Unsigned in transaction currency cents
+	AmountInBase int64       // Unsigned in workspace base currency cents
+	Note         string
+}
+
+// Validate checks basic properties of a split line.
+func (s *TransactionSplit) Validate() error {
+	s.Note = strings.TrimSpace(s.Note)
+	if err := s.ID.Validate(); err != nil {
+		return fmt.Errorf("validate split ID: %w", err)
+	}
+	if s.Amount <= 0 {
+		return errors.New("split amount must be greater than zero")
+	}
+	if len(s.Note) > 255 {
+		return errors.New("split note must be at most 255 characters")
+	}
+	if s.BudgetID != nil {
+		if err := s.BudgetID.Validate(); err != nil {
+			return fmt.Errorf("validate split budget ID: %w", err)
+		}
+	}
+	if s.PeriodID != nil {
+		if err := s.PeriodID.Validate(); err != nil {
+			return fmt.Errorf("validate split period ID: %w", err)
+		}
+	}
+	if s.CategoryID != nil {
+		if err := s.CategoryID.Validate(); err != nil {
+			return fmt.Errorf("validate split category ID: %w", err)
+		}
+	}
+	return nil
+}
+
+// IsSplit reports whether the transaction is divided into split lines.
+func (t *Transaction) IsSplit() bool {
+	return len(t.Splits) > 0
+}
+
+// validateSplits checks that split lines are well-formed and add up to the transaction amount.
+func (t *Transaction) validateSplits() error {
+	if t.Type != TransactionTypeExpense {
+		return ErrSplitNotSupported
+	}
+	if len(t.Splits) < 2 {
+		return errors.New("split transactions require at least two split lines")
+	}
+	if len(t.Splits) > MaxTransactionSplits {
+		return fmt.Errorf("split transactions support at most %d split lines", MaxTransactionSplits)
+	}
+	if t.BudgetID != nil || t.PeriodID != nil {
+		return errors.New("split transactions carry budgets on each split line")
+	}
+
+	isBorrowing := t.Metadata.BorrowingID != nil && *t.Metadata.BorrowingID != ""
+	var total int64
+	for i, s := range t.Splits {
+		if err := s.Validate(); err != nil {
+			return fmt.Errorf("split %d: %w", i, err)
+		}
+		if !isBorrowing {
+			if s.BudgetID == nil {
+				return fmt.Errorf("split %d: expense split requires a budget ID", i)
+			}
+			if s.PeriodID == nil {
+				return fmt.Errorf("split %d: expense split requires a period ID", i)
+			}
+		}
+		total += s.Amount
+	}
+	if total != t.Amount {
+		return fmt.Errorf("%w: splits total %d, transaction amount %d", ErrSplitAmountMismatch, total, t.Amount)
+	}
+	return nil
+}
+
+// allocateSplitBase distributes the transaction's base currency amount across its
+// splits proportionally, assigning any rounding remainder to the last split so the
+// split base amounts always add up to AmountInBase.
+func (t *Transaction) allocateSplitBase() {
+	if len(t.Splits) == 0 || t.Amount <= 0 {
+		return
+	}
+	var allocated int64
+	last := len(t.Splits) - 1
+	for i, s := range t.Splits {
+		if i == last {
+			s.AmountInBase = t.AmountInBase - allocated
+			break
+		}
+		s.AmountInBase = int64(float64(t.AmountInBase) * float64(s.Amount) / float64(t.Amount))
+		allocated += s.AmountInBase
+	}
+}
+
+// BudgetShare is the part of a transaction charged to one budget period.
+type BudgetShare struct {
+	BudgetID     BudgetID
+	PeriodID     *PeriodID
+	Amount       int64
+	AmountInBase int64
+}
+
+// BudgetShares returns the parts of the transaction charged to each budget, merging split lines
+// that share a budget period. Amounts without a budget are left out.
+func (t *Transaction) BudgetShares() []BudgetShare {
+	if !t.IsSplit() {
+		if t.BudgetID == nil {
+			return nil
+		}
+		return []BudgetShare{{BudgetID: *t.BudgetID, PeriodID: t.PeriodID, Amount: t.Amount, AmountInBase: t.AmountInBase}}
+	}
+	var shares []BudgetShare
+	for _, s := range t.Splits {
+		if s.BudgetID == nil {
+			continue
+		}
+		i := slices.IndexFunc(shares, func(sh BudgetShare) bool {
+			return sh.BudgetID == *s.BudgetID && equalPeriodID(sh.PeriodID, s.PeriodID)
+		})
+		if i < 0 {
+			shares = append(shares, BudgetShare{BudgetID: *s.BudgetID, PeriodID: s.PeriodID})
+			i = len(shares) - 1
+		}
+		shares[i].Amount += s.Amount
+		shares[i].AmountInBase += s.AmountInBase
+	}
+	return shares
+}
+
+// equalPeriodID reports whether two optional period IDs are the same.
+func equalPeriodID(a, b *PeriodID) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
@@ -0,0 +1,147 @@
+package finance
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/masterkeysrd/saturn/internal/platform/id"
+)
+
+func TestTransactionSplitID(t *testing.T) {
+	splitID, err := NewTransactionSplitID()
+	if err != nil {
+		t.Fatalf("unexpected error creating split ID: %v", err)
+	}
+	if err := splitID.Validate(); err != nil {
+		t.Errorf("expected valid split ID, got: %v", err)
+	}
+
+	parsed, err := ParseTransactionSplitID(string(splitID))
+	if err != nil || parsed != splitID {
+		t.Errorf("failed to parse split ID: %v", err)
+	}
+
+	if _, err := ParseTransactionSplitID("txn_invalid"); err == nil {
+		t.Error("expected error parsing split ID with wrong prefix")
+	}
+}
+
+func TestTransaction_ValidateSplits(t *testing.T) {
+	txnID, _ := NewTransactionID()
+	rawSpace, _ := id.Generate("spc_")
+	spaceID := SpaceID(rawSpace)
+	rawBudget, _ := id.Generate("bgt_")
+	budgetID := BudgetID(rawBudget)
+	rawPeriod, _ := id.Generate("bgp_")
+	periodID := PeriodID(rawPeriod)
+
+	newSplit := func(amount int64) *TransactionSplit {
+		splitID, _ := NewTransactionSplitID()
+		return &TransactionSplit{ID: splitID, BudgetID: &budgetID, PeriodID: &periodID, Amount: amount, AmountInBase: amount}
+	}
+
+	tests := []struct {
+		name    string
+		mutate  func(txn *Transaction)
+		wantErr error
+		errSub  bool
+	}{
+		{
+			name:   "valid split expense",
+			mutate: func(txn *Transaction) {},
+		},
+		{
+			name: "amount mismatch",
+			mutate: func(txn *Transaction) {
+				txn.Splits[1].Amount = 100
+			},
+			wantErr: ErrSplitAmountMismatch,
+		},
+		{
+			name: "income split",
+			mutate: func(txn *Transaction) {
+				txn.Type = TransactionTypeIncome
+			},
+			wantErr: ErrSplitNotSupported,
+		},
+		{
+			name: "single split line",
+			mutate: func(txn *Transaction) {
+				txn.Splits = []*TransactionSplit{newSplit(txn.Amount)}
+			},
+			errSub: true,
+		},
+		{
+			name: "split without budget",
+			mutate: func(txn *Transaction) {
+				txn.Splits[0].BudgetID = nil
+			},
+			errSub: true,
+		},
+		{
+			name: "transaction-level budget on split transaction",
+			mutate: func(txn *Transaction) {
+				txn.BudgetID = &budgetID
+			},
+			errSub: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			txn := &Transaction{
+				ID:              txnID,
+				SpaceID:         spaceID,
+				Type:            TransactionTypeExpense,
+				Amount:          10000,
+				Currency:        "USD",
+				AmountInBase:    10000,
+				TransactionDate: time.Now(),
+				Splits:          []*TransactionSplit{newSplit(6000), newSplit(4000)},
+			}
+			tt.mutate(txn)
+
+			err := txn.Validate()
+			switch {
+			case tt.wantErr != nil:
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("Validate() error = %v, want %v", err, tt.wantErr)
+				}
+			case tt.errSub:
+				if err == nil {
+					t.Error("Validate() expected error, got nil")
+				}
+			default:
+				if err != nil {
+					t.Errorf("Validate() unexpected error: %v", err)
+				}
+			}
+		})
+	}
+}
+
+func TestTransaction_AllocateSplitBase(t *testing.T) {
+	txn := &Transaction{
+		Amount:       1000,
+		AmountInBase: 1001,
+		Splits: []*TransactionSplit{
+			{Amount: 333},
+			{Amount: 333},
+			{Amount: 334},
+		},
+	}
+	txn.allocateSplitBase()
+
+	var total int64
+	for _, s := range txn.Splits {
+		total += s.AmountInBase
+	}
+	if total != txn.AmountInBase {
+		t.Errorf("split base total = %d, want %d", total, txn.AmountInBase)
+	}
+	if txn.Splits[0].AmountInBase != 333 || txn.Splits[2].AmountInBase != 335 {
+		t.Errorf("split base amounts = %d/%d/%d, want 333/333/335",
+			txn.Splits[0].AmountInBase, txn.Splits[1].AmountInBase, txn.Splits[2].AmountInBase)
+	}
+}
@@ -0,0 +1,103 @@
+package identity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// IssueAccountToken stores a new token of the purpose for the user's current email address and
+// returns the raw token to mail. Earlier unused tokens of the same purpose stop working.
+func (s *Service) IssueAccountToken(ctx context.Context, user *User, purpose AccountTokenPurpose, now time.Time) (string, error) {
+	raw, tokenHash, err := GenerateAccountToken()
+	if err != nil {
+		return "", fmt.Errorf("generate account token: %w", err)
+	}
+
+	if err := s.deps.AccountTokenStore.Replace(ctx, &AccountToken{
+		TokenHash:  tokenHash,
+		UserID:     user.ID,
+		Purpose:    purpose,
+		Email:      user.Email,
+		ExpireTime: now.Add(purpose.TTL()),
+		CreateTime: now,
+	}); err != nil {
+		return "", fmt.Errorf("store account token: %w", err)
+	}
+	return raw, nil
+}
+
+// ConsumeAccountToken redeems a raw token of the purpose and returns the token and its user. A
+// token mailed to an address the user no longer has is rejected.
+func (s *Service) ConsumeAccountToken(ctx context.Context, purpose AccountTokenPurpose, raw string, now time.Time) (*AccountToken, *User, error) {
+	if strings.TrimSpace(raw) == "" {
+		return nil, nil, ErrInvalidAccountToken
+	}
+
+	token, err := s.deps.AccountTokenStore.Consume(ctx, purpose, HashAccountToken(raw), now)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	user, err := s.GetUserByID(ctx, token.UserID)
+	if err != nil || user.Email != token.Email {
+		return nil, nil, ErrInvalidAccountToken
+	}
+	return token, user, nil
+}
+
+// VerifyPassword checks the user's current password. Returns ErrIncorrectPassword if it does not
+// match or the user has no password.
+func (s *Service) VerifyPassword(ctx context.Context, userID UserID, password string) error {
+	cred, err := s.deps.CredentialStore.GetByUserIDAndAuthType(ctx, userID, AuthTypePassword)
+	if err != nil {
+		if errors.Is(err, ErrCredentialNotFound) {
+			return ErrIncorrectPassword
+		}
+		return err
+	}
+
+	if _, err := s.deps.Hasher.Verify(cred.SecretData, password); err != nil {
+		return ErrIncorrectPassword
+	}
+	return nil
+}
+
+// SetPassword stores a new password hash for the user, adding a password to accounts that only
+// have passkeys, and invalidates any outstanding password reset links.
+func (s *Service) SetPassword(ctx context.Context, userID UserID, encodedHash string) error {
+	cred, err := s.deps.CredentialStore.GetByUserIDAndAuthType(ctx, userID, AuthTypePassword)
+	switch {
+	case err == nil:
+		cred.SecretData = encodedHash
+		err = s.deps.CredentialStore.Update(ctx, cred)
+	case errors.Is(err, ErrCredentialNotFound):
+		err = s.deps.CredentialStore.Create(ctx, &Credential{
+			UserID:     userID,
+			AuthType:   AuthTypePassword,
+			SecretData: encodedHash,
+		})
+	}
+	if err != nil {
+		return fmt.Errorf("store password: %w", err)
+	}
+
+	if err := s.deps.AccountTokenStore.DeleteByUserID(ctx, userID, AccountTokenPasswordReset); err != nil {
+		return fmt.Errorf("delete password reset tokens: %w", err)
+	}
+	return nil
+}
+
+// MarkEmailVerified records that the user proved ownership of their current email address.
+func (s *Service) MarkEmailVerified(ctx context.Context, user *User, now time.Time) error {
+	if user.IsEmailVerified() {
+		return nil
+	}
+	if err := s.deps.UserStore.MarkEmailVerified(ctx, user.ID, user.Email, now); err != nil {
+		return fmt.Errorf("mark email verified: %w", err)
+	}
+	user.EmailVerifyTime = &now
+	return nil
+}
@@ -0,0 +1,134 @@
+package identity
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+type memAccountTokenStore struct {
+	tokens map[string]*AccountToken
+}
+
+func (m *memAccountTokenStore) Replace(_ context.Context, token *AccountToken) error {
+	for h, t := range m.tokens {
+		if t.UserID == token.UserID && t.Purpose == token.Purpose && t.UseTime == nil {
+			delete(m.tokens, h)
+		}
+	}
+	cp := *token
+	m.tokens[token.TokenHash] = &cp
+	return nil
+}
+
+func (m *memAccountTokenStore) Consume(_ context.Context, purpose AccountTokenPurpose, tokenHash string, now time.Time) (*AccountToken, error) {
+	t, ok := m.tokens[tokenHash]
+	if !ok || t.Purpose != purpose || t.UseTime != nil || !t.ExpireTime.After(now) {
+		return nil, ErrInvalidAccountToken
+	}
+	t.UseTime = &now
+	cp := *t
+	return &cp, nil
+}
+
+func (m *memAccountTokenStore) DeleteByUserID(_ context.Context, userID UserID, purpose AccountTokenPurpose) error {
+	for h, t := range m.tokens {
+		if t.UserID == userID && t.Purpose == purpose {
+			delete(m.tokens, h)
+		}
+	}
+	return nil
+}
+
+func newAccountTestService() (*Service, *User, *memCredentialStore) {
+	user := &User{ID: "usr_2NzN5q3y1pUQvU1ZQ9t2tq8xKxF", Email: "jane@example.com", Name: "Jane"}
+	creds := &memCredentialStore{creds: make(map[string]*Credential)}
+	svc := NewService(Dependencies{
+		UserStore:         &memUserStore{users: map[UserID]*User{user.ID: user}},
+		CredentialStore:   creds,
+		AccountTokenStore: &memAccountTokenStore{tokens: make(map[string]*AccountToken)},
+	})
+	return svc, user, creds
+}
+
+func TestService_AccountTokenIsSingleUse(t *testing.T) {
+	ctx := context.Background()
+	svc, user, _ := newAccountTestService()
+	now := time.Now()
+
+	raw, err := svc.IssueAccountToken(ctx, user, AccountTokenPasswordReset, now)
+	if err != nil {
+		t.Fatalf("IssueAccountToken: %v", err)
+	}
+
+	if _, _, err := svc.ConsumeAccountToken(ctx, AccountTokenEmailVerification, raw, now); !errors.Is(err, ErrInvalidAccountToken) {
+		t.Fatalf("expected a token of another purpose to be rejected, got %v", err)
+	}
+	token, got, err := svc.ConsumeAccountToken(ctx, AccountTokenPasswordReset, raw, now)
+	if err != nil {
+		t.Fatalf("ConsumeAccountToken: %v", err)
+	}
+	if got.ID != user.ID || token.Email != user.Email || token.TokenHash == raw {
+		t.Errorf("unexpected token %+v for user %s", token, got.ID)
+	}
+	if _, _, err := svc.ConsumeAccountToken(ctx, AccountTokenPasswordReset, raw, now); !errors.Is(err, ErrInvalidAccountToken) {
+		t.Fatalf("expected a used token to be rejected, got %v", err)
+	}
+}
+
+func TestService_AccountTokenRejections(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	t.Run("expired", func(t *testing.T) {
+		svc, user, _ := newAccountTestService()
+		raw, _ := svc.IssueAccountToken(ctx, user, AccountTokenPasswordReset, now)
+		if _, _, err := svc.ConsumeAccountToken(ctx, AccountTokenPasswordReset, raw, now.Add(PasswordResetTokenTTL)); !errors.Is(err, ErrInvalidAccountToken) {
+			t.Fatalf("expected ErrInvalidAccountToken, got %v", err)
+		}
+	})
+
+	t.Run("superseded", func(t *testing.T) {
+		svc, user, _ := newAccountTestService()
+		first, _ := svc.IssueAccountToken(ctx, user, AccountTokenEmailVerification, now)
+		if _, err := svc.IssueAccountToken(ctx, user, AccountTokenEmailVerification, now); err != nil {
+			t.Fatal(err)
+		}
+		if _, _, err := svc.ConsumeAccountToken(ctx, AccountTokenEmailVerification, first, now); !errors.Is(err, ErrInvalidAccountToken) {
+			t.Fatalf("expected ErrInvalidAccountToken, got %v", err)
+		}
+	})
+
+	t.Run("email changed", func(t *testing.T) {
+		svc, user, _ := newAccountTestService()
+		raw, _ := svc.IssueAccountToken(ctx, user, AccountTokenEmailVerification, now)
+		user.Email = "jane@example.org"
+		if _, _, err := svc.ConsumeAccountToken(ctx, AccountTokenEmailVerification, raw, now); !errors.Is(err, ErrInvalidAccountToken) {
+			t.Fatalf("expected ErrInvalidAccountToken, got %v", err)
+		}
+	})
+}
+
+func TestService_SetPassword(t *testing.T) {
+	ctx := context.Background()
+	svc, user, creds := newAccountTestService()
+	now := time.Now()
+
+	raw, _ := svc.IssueAccountToken(ctx, user, AccountTokenPasswordReset, now)
+
+	// A passkey-only account gets a password credential
+	if err := svc.SetPassword(ctx, user.ID, "$argon2id$first"); err != nil {
+		t.Fatalf("SetPassword: %v", err)
+	}
+	if err := svc.SetPassword(ctx, user.ID, "$argon2id$second"); err != nil {
+		t.Fatalf("SetPassword: %v", err)
+	}
+	if cred := creds.creds[AuthTypePassword]; cred == nil || cred.SecretData != "$argon2id$second" || cred.UserID != user.ID {
+		t.Errorf("unexpected password credential %+v", cred)
+	}
+
+	if _, _, err := svc.ConsumeAccountToken(ctx, AccountTokenPasswordReset, raw, now); !errors.Is(err, ErrInvalidAccountToken) {
+		t.Fatalf("expected outstanding reset links to stop working, got %v", err)
+	}
+}
@@ -0,0 +1,82 @@
+package identity
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/masterkeysrd/saturn/internal/platform/hash"
+	"github.com/masterkeysrd/saturn/internal/platform/token"
+)
+
+// AccountTokenPurpose is the self-service flow an account token belongs to.
+type AccountTokenPurpose string
+
+const (
+	AccountTokenPasswordReset     AccountTokenPurpose = "password_reset"
+	AccountTokenEmailVerification AccountTokenPurpose = "email_verification"
+)
+
+const (
+	// PasswordResetTokenTTL is how long a password reset link stays valid.
+	PasswordResetTokenTTL = time.Hour
+
+	// EmailVerificationTokenTTL is how long an email verification link stays valid.
+	EmailVerificationTokenTTL = 48 * time.Hour
+)
+
+var (
+	ErrInvalidAccountToken = errors.New("token is invalid or expired")
+	ErrIncorrectPassword   = errors.New("current password is incorrect")
+	ErrEmailNotVerified    = errors.New("email address is not verified")
+)
+
+// TTL returns how long tokens of the purpose stay valid.
+func (p AccountTokenPurpose) TTL() time.Duration {
+	if p == AccountTokenPasswordReset {
+		return PasswordResetTokenTTL
+	}
+	return EmailVerificationTokenTTL
+}
+
+// AccountToken is a single-use token mailed to a user to reset their password or verify their
+// email address. Only the SHA-256 hash of the token is stored.
+type AccountToken struct {
+	TokenHash  string
+	UserID     UserID
+	Purpose    AccountTokenPurpose
+	Email      string // The address the token was sent to
+	ExpireTime time.Time
+	UseTime    *time.Time // Nullable
+	CreateTime time.Time
+}
+
+// AccountTokenStore defines the persistence interface for account tokens.
+type AccountTokenStore interface {
+	// Replace discards the user's unused tokens of the same purpose and stores the given one, so
+	// that only the most recently mailed link works.
+	Replace(ctx context.Context, token *AccountToken) error
+
+	// Consume marks an unused, unexpired token as used and returns it. Returns
+	// ErrInvalidAccountToken when no such token matches.
+	Consume(ctx context.Context, purpose AccountTokenPurpose, tokenHash string, now time.Time) (*AccountToken, error)
+
+	// DeleteByUserID removes the user's tokens of the given purpose.
+	DeleteByUserID(ctx context.Context, userID UserID, purpose AccountTokenPurpose) error
+}
+
+// GenerateAccountToken returns a new random account token and the hash to persist.
+func GenerateAccountToken() (raw string, tokenHash string, err error) {
+	raw, err = token.GenerateRandomHex(32)
+	if err != nil {
+		return "", "", err
+	}
+	return raw, HashAccountToken(raw), nil
+}
+
+// HashAccountToken hashes a raw account token for lookup.
+func HashAccountToken(raw string) string {
+	return hex.EncodeToString(hash.SHA256String(strings.TrimSpace(raw)))
+}
@@ -1,8 +1,81 @@
 package identity
 
+import (
+	"fmt"
+	"time"
+
+	"github.com/masterkeysrd/saturn/internal/platform/id"
+)
+
+const (
+	// AuthTypePassword identifies a credential holding an Argon2id password hash.
+	AuthTypePassword = "password"
+
+	// AuthTypeTOTP identifies a credential holding an encrypted TOTP secret.
+	AuthTypeTOTP = "totp"
+
+	// AuthTypeWebAuthn identifies WebAuthn passkeys. A user may have several, so they are stored
+	// as Passkey records rather than as a Credential.
+	AuthTypeWebAuthn = "webauthn"
+)
+
 // Credential represents user authentication credentials.
 type Credential struct {
 	UserID     UserID `json:"user_id"`
 	AuthType   string `json:"auth_type"`
 	SecretData string `json:"secret_data"`
+
+	// ConfirmTime is set once a second factor has been verified and is in use. Nullable.
+	ConfirmTime *time.Time `json:"confirm_time,omitempty"`
+	// LastUsedStep is the last accepted TOTP time step, used to reject replayed codes.
+	LastUsedStep int64 `json:"last_used_step,omitempty"`
+}
+
+// IsConfirmed reports whether the credential has been confirmed.
+func (c *Credential) IsConfirmed() bool {
+	return c.ConfirmTime != nil
+}
+
+const passkeyPrefix = "pk_"
+
+// PasskeyID is a string type representing a passkey's unique identifier.
+type PasskeyID string
+
+// NewPasskeyID creates a new PasskeyID using the default ID generator.
+func NewPasskeyID() (PasskeyID, error) {
+	raw, err := id.Generate(passkeyPrefix)
+	if err != nil {
+		return "", err
+	}
+	return PasskeyID(raw), nil
+}
+
+// ParsePasskeyID parses a string into a PasskeyID and validates it.
+func ParsePasskeyID(s string) (PasskeyID, error) {
+	if err := id.Validate(s, passkeyPrefix); err != nil {
+		return "", fmt.Errorf("invalid passkey ID: %w", err)
+	}
+	return PasskeyID(s), nil
+}
+
+// Passkey is a WebAuthn public key credential registered by a user. Only the public key is
+// stored; the private key never leaves the authenticator.
+type Passkey struct {
+	ID     PasskeyID `json:"id"`
+	UserID UserID    `json:"user_id"`
+	// Name is chosen by the user to tell their passkeys apart, e.g. "Work laptop".
+	Name string `json:"name"`
+
+	CredentialID    []byte   `json:"credential_id"`
+	PublicKey       []byte   `json:"public_key"`
+	AttestationType string   `json:"attestation_type"`
+	Transports      []string `json:"transports"`
+	AAGUID          []byte   `json:"aaguid"`
+	SignCount       uint32   `json:"sign_count"`
+	// BackupEligible and BackupState report whether the passkey can be, and is, synced between devices.
+	BackupEligible bool `json:"backup_eligible"`
+	BackupState    bool `json:"backup_state"`
+
+	CreateTime   time.Time  `json:"create_time"`
+	LastUsedTime *time.Time `json:"last_used_time,omitempty"` // Nullable
 }
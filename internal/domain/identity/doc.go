@@ -7,11 +7,20 @@
 // Types:
 //
 //	User — represents a registered user with identity fields and optimistic locking.
-//	Credential — stores user authentication secrets keyed by auth type.
+//	Credential — stores user authentication secrets keyed by auth type (password, totp).
+//	RecoveryCode — a hashed one-time code that replaces a TOTP code.
+//	Passkey — a named WebAuthn public key credential; a user may register several.
+//	AccountToken — a hashed single-use token mailed to reset a password or verify an email.
 //	UserID — a KSUID-based string type with prefix validation.
 //
 // Interfaces:
 //
 //	UserStore — CRUD operations for user entities.
 //	UserCredentialStore — CRUD operations for user credentials.
+//	RecoveryCodeStore — issues and consumes MFA recovery codes.
+//	PasskeyStore — CRUD operations for passkeys.
+//	PasskeyCeremonyStore — single-use state of started WebAuthn ceremonies.
+//	AccountTokenStore — issues and consumes password reset and email verification tokens.
+//	Cipher — encrypts TOTP secrets at rest.
+//	RelyingParty — runs WebAuthn registration and login ceremonies.
 package identity
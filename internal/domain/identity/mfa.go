@@ -0,0 +1,101 @@
+package identity
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	// RecoveryCodeCount is the number of recovery codes issued when MFA is enabled.
+	RecoveryCodeCount = 10
+
+	// TOTPIssuer is the issuer shown by authenticator apps.
+	TOTPIssuer = "Saturn"
+
+	// totpSkew is the number of time steps a TOTP code may drift either way.
+	totpSkew = 1
+
+	// recoveryCodeSize is the entropy of a recovery code in bytes (80 bits).
+	recoveryCodeSize = 10
+)
+
+var (
+	ErrMFANotEnrolled      = errors.New("mfa is not enrolled")
+	ErrMFAAlreadyEnabled   = errors.New("mfa is already enabled")
+	ErrInvalidMFACode      = errors.New("invalid mfa code")
+	ErrInvalidRecoveryCode = errors.New("invalid recovery code")
+	ErrMFAChallengeExpired = errors.New("mfa challenge is invalid or expired")
+)
+
+// Cipher encrypts secrets that must be readable again, such as TOTP seeds.
+type Cipher interface {
+	Encrypt(plaintext string) (string, error)
+	Decrypt(ciphertext string) (string, error)
+}
+
+// RecoveryCode is a one-time code that replaces a TOTP code when the authenticator is lost.
+// Only the hash of the code is stored.
+type RecoveryCode struct {
+	UserID     UserID
+	CodeHash   string
+	UseTime    *time.Time // Nullable
+	CreateTime time.Time
+}
+
+// RecoveryCodeStore defines the persistence interface for recovery codes.
+type RecoveryCodeStore interface {
+	// Replace atomically discards the user's codes and stores the given ones.
+	Replace(ctx context.Context, userID UserID, codes []*RecoveryCode) error
+
+	// Consume marks an unused code as used. Returns ErrInvalidRecoveryCode when no unused code matches.
+	Consume(ctx context.Context, userID UserID, codeHash string, now time.Time) error
+
+	// CountUnused returns the number of codes the user has left.
+	CountUnused(ctx context.Context, userID UserID) (int, error)
+
+	// DeleteByUserID removes all codes of the user.
+	DeleteByUserID(ctx context.Context, userID UserID) error
+}
+
+// MFAStatus summarises the second factors of a user.
+type MFAStatus struct {
+	Enabled                bool
+	ConfirmTime            *time.Time
+	RecoveryCodesRemaining int
+}
+
+// TOTPEnrollment is the secret handed to the user when enrolling an authenticator app.
+type TOTPEnrollment struct {
+	Secret string
+	URI    string
+}
+
+var recoveryCodeEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateRecoveryCodes returns RecoveryCodeCount raw codes formatted as xxxx-xxxx-xxxx-xxxx.
+func GenerateRecoveryCodes() ([]string, error) {
+	codes := make([]string, RecoveryCodeCount)
+	for i := range codes {
+		b := make([]byte, recoveryCodeSize)
+		if _, err := rand.Read(b); err != nil {
+			return nil, fmt.Errorf("generate recovery code: %w", err)
+		}
+		raw := strings.ToLower(recoveryCodeEncoding.EncodeToString(b))
+		codes[i] = raw[0:4] + "-" + raw[4:8] + "-" + raw[8:12] + "-" + raw[12:16]
+	}
+	return codes, nil
+}
+
+// HashRecoveryCode returns the hex SHA-256 of a recovery code, ignoring case, spaces and dashes.
+func HashRecoveryCode(code string) string {
+	normalized := strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(strings.TrimSpace(code)))
+	sum := sha256.Sum256([]byte(normalized))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,189 @@
+package identity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/masterkeysrd/saturn/internal/platform/totp"
+)
+
+// EnrollTOTP generates a new TOTP secret for the user and stores it encrypted and unconfirmed.
+// Enrolling again before confirming replaces the previous secret.
+func (s *Service) EnrollTOTP(ctx context.Context, user *User) (*TOTPEnrollment, error) {
+	existing, err := s.getTOTPCredential(ctx, user.ID)
+	if err != nil && !errors.Is(err, ErrMFANotEnrolled) {
+		return nil, err
+	}
+	if existing != nil && existing.IsConfirmed() {
+		return nil, ErrMFAAlreadyEnabled
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, err
+	}
+	encrypted, err := s.deps.Cipher.Encrypt(secret)
+	if err != nil {
+		return nil, fmt.Errorf("encrypt totp secret: %w", err)
+	}
+
+	cred := &Credential{UserID: user.ID, AuthType: AuthTypeTOTP, SecretData: encrypted}
+	if existing != nil {
+		err = s.deps.CredentialStore.Update(ctx, cred)
+	} else {
+		err = s.deps.CredentialStore.Create(ctx, cred)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("store totp credential: %w", err)
+	}
+
+	return &TOTPEnrollment{
+		Secret: secret,
+		URI:    totp.URI(TOTPIssuer, user.Email, secret),
+	}, nil
+}
+
+// ConfirmTOTP completes enrollment by checking a code from the authenticator app, and returns a
+// fresh set of recovery codes. The raw codes are only available in the return value.
+func (s *Service) ConfirmTOTP(ctx context.Context, userID UserID, code string, now time.Time) ([]string, error) {
+	cred, err := s.getTOTPCredential(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if cred.IsConfirmed() {
+		return nil, ErrMFAAlreadyEnabled
+	}
+
+	if err := s.checkTOTPCode(cred, code, now); err != nil {
+		return nil, err
+	}
+
+	cred.ConfirmTime = &now
+	if err := s.deps.CredentialStore.Update(ctx, cred); err != nil {
+		return nil, fmt.Errorf("confirm totp credential: %w", err)
+	}
+
+	return s.RegenerateRecoveryCodes(ctx, userID)
+}
+
+// VerifyTOTP checks a code against the user's confirmed TOTP credential. Each time step is accepted
+// only once, so an intercepted code cannot be replayed.
+func (s *Service) VerifyTOTP(ctx context.Context, userID UserID, code string, now time.Time) error {
+	cred, err := s.getTOTPCredential(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !cred.IsConfirmed() {
+		return ErrMFANotEnrolled
+	}
+
+	if err := s.checkTOTPCode(cred, code, now); err != nil {
+		return err
+	}
+
+	// The store only accepts a step newer than the stored one, so a replay racing this call fails
+	if err := s.deps.CredentialStore.UseTOTPStep(ctx, userID, cred.LastUsedStep); err != nil {
+		if errors.Is(err, ErrInvalidMFACode) {
+			return err
+		}
+		return fmt.Errorf("update totp credential: %w", err)
+	}
+	return nil
+}
+
+// UseRecoveryCode consumes one of the user's recovery codes.
+func (s *Service) UseRecoveryCode(ctx context.Context, userID UserID, code string, now time.Time) error {
+	if code == "" {
+		return ErrInvalidRecoveryCode
+	}
+	return s.deps.RecoveryCodeStore.Consume(ctx, userID, HashRecoveryCode(code), now)
+}
+
+// RegenerateRecoveryCodes replaces the user's recovery codes with a new set and returns the raw codes.
+func (s *Service) RegenerateRecoveryCodes(ctx context.Context, userID UserID) ([]string, error) {
+	raw, err := GenerateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	codes := make([]*RecoveryCode, len(raw))
+	for i, code := range raw {
+		codes[i] = &RecoveryCode{UserID: userID, CodeHash: HashRecoveryCode(code), CreateTime: now}
+	}
+	if err := s.deps.RecoveryCodeStore.Replace(ctx, userID, codes); err != nil {
+		return nil, fmt.Errorf("store recovery codes: %w", err)
+	}
+	return raw, nil
+}
+
+// GetMFAStatus reports whether the user has a confirmed second factor. Storage errors are returned
+// rather than treated as "not enrolled" so that login never silently skips the second factor.
+func (s *Service) GetMFAStatus(ctx context.Context, userID UserID) (*MFAStatus, error) {
+	cred, err := s.getTOTPCredential(ctx, userID)
+	if errors.Is(err, ErrMFANotEnrolled) {
+		return &MFAStatus{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !cred.IsConfirmed() {
+		return &MFAStatus{}, nil
+	}
+
+	remaining, err := s.deps.RecoveryCodeStore.CountUnused(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("count recovery codes: %w", err)
+	}
+	return &MFAStatus{
+		Enabled:                true,
+		ConfirmTime:            cred.ConfirmTime,
+		RecoveryCodesRemaining: remaining,
+	}, nil
+}
+
+// DisableMFA removes the user's TOTP credential and recovery codes.
+func (s *Service) DisableMFA(ctx context.Context, userID UserID) error {
+	if _, err := s.getTOTPCredential(ctx, userID); err != nil {
+		return err
+	}
+	if err := s.deps.CredentialStore.Delete(ctx, userID, AuthTypeTOTP); err != nil {
+		return fmt.Errorf("delete totp credential: %w", err)
+	}
+	if err := s.deps.RecoveryCodeStore.DeleteByUserID(ctx, userID); err != nil {
+		return fmt.Errorf("delete recovery codes: %w", err)
+	}
+	return nil
+}
+
+func (s *Service) getTOTPCredential(ctx context.Context, userID UserID) (*Credential, error) {
+	cred, err := s.deps.CredentialStore.GetByUserIDAndAuthType(ctx, userID, AuthTypeTOTP)
+	if errors.Is(err, ErrCredentialNotFound) {
+		return nil, ErrMFANotEnrolled
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get totp credential: %w", err)
+	}
+	return cred, nil
+}
+
+// checkTOTPCode validates code and records its time step on cred.
+func (s *Service) checkTOTPCode(cred *Credential, code string, now time.Time) error {
+	secret, err := s.deps.Cipher.Decrypt(cred.SecretData)
+	if err != nil {
+		return fmt.Errorf("decrypt totp secret: %w", err)
+	}
+
+	step, ok, err := totp.Validate(secret, code, now, totpSkew)
+	if err != nil {
+		return fmt.Errorf("validate totp code: %w", err)
+	}
+	if !ok || step <= cred.LastUsedStep {
+		return ErrInvalidMFACode
+	}
+
+	cred.LastUsedStep = step
+	return nil
+}
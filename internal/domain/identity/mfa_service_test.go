@@ -0,0 +1,164 @@
+package identity
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/masterkeysrd/saturn/internal/platform/crypto"
+	"github.com/masterkeysrd/saturn/internal/platform/totp"
+)
+
+type memCredentialStore struct {
+	CredentialStoreProvider
+	creds map[string]*Credential
+}
+
+func (m *memCredentialStore) Create(_ context.Context, c *Credential) error {
+	cp := *c
+	m.creds[c.AuthType] = &cp
+	return nil
+}
+
+func (m *memCredentialStore) Update(ctx context.Context, c *Credential) error {
+	return m.Create(ctx, c)
+}
+
+func (m *memCredentialStore) UseTOTPStep(_ context.Context, _ UserID, step int64) error {
+	c, ok := m.creds[AuthTypeTOTP]
+	if !ok || c.LastUsedStep >= step {
+		return ErrInvalidMFACode
+	}
+	c.LastUsedStep = step
+	return nil
+}
+
+func (m *memCredentialStore) GetByUserIDAndAuthType(_ context.Context, _ UserID, authType string) (*Credential, error) {
+	c, ok := m.creds[authType]
+	if !ok {
+		return nil, ErrCredentialNotFound
+	}
+	cp := *c
+	return &cp, nil
+}
+
+type memRecoveryCodeStore struct {
+	RecoveryCodeStore
+	codes map[string]bool // hash -> used
+}
+
+func (m *memRecoveryCodeStore) Replace(_ context.Context, _ UserID, codes []*RecoveryCode) error {
+	m.codes = make(map[string]bool)
+	for _, c := range codes {
+		m.codes[c.CodeHash] = false
+	}
+	return nil
+}
+
+func (m *memRecoveryCodeStore) Consume(_ context.Context, _ UserID, codeHash string, _ time.Time) error {
+	used, ok := m.codes[codeHash]
+	if !ok || used {
+		return ErrInvalidRecoveryCode
+	}
+	m.codes[codeHash] = true
+	return nil
+}
+
+func TestService_TOTP(t *testing.T) {
+	ctx := context.Background()
+	cipher, _ := crypto.NewCipher("test-key")
+	creds := &memCredentialStore{creds: make(map[string]*Credential)}
+	recovery := &memRecoveryCodeStore{}
+	svc := NewService(Dependencies{CredentialStore: creds, RecoveryCodeStore: recovery, Cipher: cipher})
+	user := &User{ID: "usr_2NzN5q3y1pUQvU1ZQ9t2tq8xKxF", Email: "jane@example.com"}
+	now := time.Now()
+
+	enrollment, err := svc.EnrollTOTP(ctx, user)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stored := creds.creds[AuthTypeTOTP].SecretData; stored == enrollment.Secret {
+		t.Fatal("expected the TOTP secret to be stored encrypted")
+	}
+
+	if err := svc.VerifyTOTP(ctx, user.ID, "000000", now); !errors.Is(err, ErrMFANotEnrolled) {
+		t.Fatalf("expected an unconfirmed secret to be unusable, got %v", err)
+	}
+
+	code, _ := totp.Code(enrollment.Secret, now.Add(-totp.Period))
+	recoveryCodes, err := svc.ConfirmTOTP(ctx, user.ID, code, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(recoveryCodes) != RecoveryCodeCount {
+		t.Fatalf("expected %d recovery codes, got %d", RecoveryCodeCount, len(recoveryCodes))
+	}
+
+	if _, err := svc.EnrollTOTP(ctx, user); !errors.Is(err, ErrMFAAlreadyEnabled) {
+		t.Errorf("expected ErrMFAAlreadyEnabled, got %v", err)
+	}
+
+	if err := svc.VerifyTOTP(ctx, user.ID, code, now); !errors.Is(err, ErrInvalidMFACode) {
+		t.Errorf("expected a used code to be rejected, got %v", err)
+	}
+	current, _ := totp.Code(enrollment.Secret, now)
+	if err := svc.VerifyTOTP(ctx, user.ID, current, now); err != nil {
+		t.Errorf("expected the current code to be accepted, got %v", err)
+	}
+
+	if err := svc.UseRecoveryCode(ctx, user.ID, "  "+recoveryCodes[0]+" ", now); err != nil {
+		t.Errorf("expected recovery code to be accepted, got %v", err)
+	}
+	if err := svc.UseRecoveryCode(ctx, user.ID, recoveryCodes[0], now); !errors.Is(err, ErrInvalidRecoveryCode) {
+		t.Errorf("expected a used recovery code to be rejected, got %v", err)
+	}
+}
+
+// staleCredentialStore returns a snapshot of the TOTP credential, like a read that raced a
+// concurrent login.
+type staleCredentialStore struct {
+	*memCredentialStore
+	snapshot Credential
+}
+
+func (s *staleCredentialStore) GetByUserIDAndAuthType(_ context.Context, _ UserID, _ string) (*Credential, error) {
+	cp := s.snapshot
+	return &cp, nil
+}
+
+func TestService_VerifyTOTPRejectsConcurrentReplay(t *testing.T) {
+	ctx := context.Background()
+	cipher, _ := crypto.NewCipher("test-key")
+	creds := &memCredentialStore{creds: make(map[string]*Credential)}
+	svc := NewService(Dependencies{CredentialStore: creds, RecoveryCodeStore: &memRecoveryCodeStore{}, Cipher: cipher})
+	user := &User{ID: "usr_2NzN5q3y1pUQvU1ZQ9t2tq8xKxF", Email: "jane@example.com"}
+	now := time.Now()
+
+	enrollment, err := svc.EnrollTOTP(ctx, user)
+	if err != nil {
+		t.Fatal(err)
+	}
+	confirmCode, _ := totp.Code(enrollment.Secret, now.Add(-totp.Period))
+	if _, err := svc.ConfirmTOTP(ctx, user.ID, confirmCode, now); err != nil {
+		t.Fatal(err)
+	}
+
+	stale := &staleCredentialStore{memCredentialStore: creds, snapshot: *creds.creds[AuthTypeTOTP]}
+	code, _ := totp.Code(enrollment.Secret, now)
+	if err := svc.VerifyTOTP(ctx, user.ID, code, now); err != nil {
+		t.Fatalf("VerifyTOTP: %v", err)
+	}
+
+	// The second login read the credential before the first one stored the used step
+	racing := NewService(Dependencies{CredentialStore: stale, Cipher: cipher})
+	if err := racing.VerifyTOTP(ctx, user.ID, code, now); !errors.Is(err, ErrInvalidMFACode) {
+		t.Fatalf("expected ErrInvalidMFACode, got %v", err)
+	}
+}
+
+func TestHashRecoveryCode(t *testing.T) {
+	if HashRecoveryCode("abcd-efgh-ijkl-mnop") != HashRecoveryCode("ABCD EFGH IJKL MNOP") {
+		t.Error("expected recovery codes to be compared without case, spaces or dashes")
+	}
+}
@@ -0,0 +1,114 @@
+package identity
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/masterkeysrd/saturn/internal/platform/passkey"
+)
+
+const (
+	// PasskeyCeremonyTTL is how long a started registration or login ceremony can be completed.
+	PasskeyCeremonyTTL = 5 * time.Minute
+
+	// MaxPasskeysPerUser limits the number of passkeys a user can register.
+	MaxPasskeysPerUser = 20
+
+	// DefaultPasskeyName names a passkey registered without a name.
+	DefaultPasskeyName = "Passkey"
+
+	// maxPasskeyNameLength is the maximum length of a passkey name in characters.
+	maxPasskeyNameLength = 64
+)
+
+var (
+	ErrPasskeyNotFound        = errors.New("passkey not found")
+	ErrPasskeyExists          = errors.New("passkey is already registered")
+	ErrInvalidPasskey         = errors.New("invalid passkey")
+	ErrInvalidPasskeyName     = errors.New("passkey name must be at most 64 characters")
+	ErrTooManyPasskeys        = errors.New("too many passkeys")
+	ErrPasskeyCeremonyExpired = errors.New("passkey ceremony is invalid or expired")
+
+	// ErrLastCredential is returned when removing a passkey would leave the account without any
+	// way to sign in.
+	ErrLastCredential = errors.New("cannot remove the last sign-in method of the account")
+)
+
+// PasskeyCeremonyKind tells apart the ceremonies a PasskeyCeremony can complete.
+type PasskeyCeremonyKind string
+
+const (
+	// PasskeyCeremonyRegistration adds a passkey to an existing account.
+	PasskeyCeremonyRegistration PasskeyCeremonyKind = "registration"
+	// PasskeyCeremonySignup creates the first passkey of an account that is registered without a password.
+	PasskeyCeremonySignup PasskeyCeremonyKind = "signup"
+	// PasskeyCeremonyLogin signs in with a passkey.
+	PasskeyCeremonyLogin PasskeyCeremonyKind = "login"
+)
+
+// PasskeyCeremony is the server side state of a started WebAuthn ceremony. It can be completed
+// once, before ExpireTime.
+type PasskeyCeremony struct {
+	ID   string
+	Kind PasskeyCeremonyKind
+	// UserID is the account the ceremony runs for. For a signup it is the ID reserved for the new
+	// account; for a discoverable login it is nil.
+	UserID      *UserID
+	SessionData []byte
+	ExpireTime  time.Time
+}
+
+// PasskeyChallenge is handed to the client to run a ceremony in the browser.
+type PasskeyChallenge struct {
+	CeremonyID string
+	// Options is the JSON for navigator.credentials.create or navigator.credentials.get.
+	Options    []byte
+	ExpireTime time.Time
+}
+
+// FinishPasskeyRegistrationRequest completes a registration or signup ceremony.
+type FinishPasskeyRegistrationRequest struct {
+	// UserID is the account adding the passkey. Empty when completing a signup ceremony.
+	UserID     UserID
+	CeremonyID string
+	Name       string
+	// Response is the JSON serialized PublicKeyCredential returned by navigator.credentials.create.
+	Response []byte
+}
+
+// PasskeyStore defines the persistence interface for passkeys.
+type PasskeyStore interface {
+	// Create stores a passkey. Returns ErrPasskeyExists if the credential ID is already registered.
+	Create(ctx context.Context, passkey *Passkey) error
+
+	// GetByID retrieves a passkey of the user. Returns ErrPasskeyNotFound if not found.
+	GetByID(ctx context.Context, userID UserID, id PasskeyID) (*Passkey, error)
+
+	// ListByUserID returns the user's passkeys, oldest first.
+	ListByUserID(ctx context.Context, userID UserID) ([]*Passkey, error)
+
+	// Update saves the name, signature counter, backup state and last use of a passkey.
+	Update(ctx context.Context, passkey *Passkey) error
+
+	// Delete removes a passkey of the user. Returns ErrPasskeyNotFound if not found.
+	Delete(ctx context.Context, userID UserID, id PasskeyID) error
+}
+
+// PasskeyCeremonyStore defines the persistence interface for started ceremonies.
+type PasskeyCeremonyStore interface {
+	// Create stores a ceremony and prunes expired ones.
+	Create(ctx context.Context, ceremony *PasskeyCeremony) error
+
+	// Consume removes and returns an unexpired ceremony of the given kind. Returns
+	// ErrPasskeyCeremonyExpired if there is none.
+	Consume(ctx context.Context, id string, kind PasskeyCeremonyKind, now time.Time) (*PasskeyCeremony, error)
+}
+
+// RelyingParty runs the WebAuthn ceremonies. It is implemented by passkey.RelyingParty.
+type RelyingParty interface {
+	BeginRegistration(user passkey.User) (options, session []byte, err error)
+	FinishRegistration(user passkey.User, session, response []byte) (*passkey.Credential, error)
+	BeginLogin(user *passkey.User) (options, session []byte, err error)
+	FinishLogin(session, response []byte, lookup func(userHandle []byte) (*passkey.User, error)) (*passkey.User, *passkey.Credential, error)
+}
@@ -0,0 +1,281 @@
+package identity
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/masterkeysrd/saturn/internal/platform/id"
+	"github.com/masterkeysrd/saturn/internal/platform/passkey"
+)
+
+const passkeyCeremonyPrefix = "pkc_"
+
+// BeginPasskeyRegistration starts a registration or signup ceremony for user. For a signup the
+// user does not exist yet and user.ID is the ID reserved for the account.
+func (s *Service) BeginPasskeyRegistration(ctx context.Context, user *User, kind PasskeyCeremonyKind, now time.Time) (*PasskeyChallenge, error) {
+	var existing []*Passkey
+	if kind == PasskeyCeremonyRegistration {
+		var err error
+		if existing, err = s.deps.PasskeyStore.ListByUserID(ctx, user.ID); err != nil {
+			return nil, fmt.Errorf("list passkeys: %w", err)
+		}
+		if len(existing) >= MaxPasskeysPerUser {
+			return nil, ErrTooManyPasskeys
+		}
+	}
+
+	options, session, err := s.deps.RelyingParty.BeginRegistration(passkeyUser(user, existing))
+	if err != nil {
+		return nil, err
+	}
+	return s.startPasskeyCeremony(ctx, kind, &user.ID, options, session, now)
+}
+
+// FinishPasskeyRegistration verifies the authenticator's response to a registration or signup
+// ceremony and returns the new passkey. The passkey is not stored; for a signup the account must
+// be created first.
+func (s *Service) FinishPasskeyRegistration(ctx context.Context, req *FinishPasskeyRegistrationRequest, now time.Time) (*Passkey, error) {
+	name, err := normalizePasskeyName(req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	kind := PasskeyCeremonyRegistration
+	if req.UserID == "" {
+		kind = PasskeyCeremonySignup
+	}
+	ceremony, err := s.deps.PasskeyCeremonyStore.Consume(ctx, req.CeremonyID, kind, now)
+	if err != nil {
+		return nil, err
+	}
+	if ceremony.UserID == nil || (kind == PasskeyCeremonyRegistration && *ceremony.UserID != req.UserID) {
+		return nil, ErrPasskeyCeremonyExpired
+	}
+
+	cred, err := s.deps.RelyingParty.FinishRegistration(passkey.User{ID: []byte(*ceremony.UserID)}, ceremony.SessionData, req.Response)
+	if errors.Is(err, passkey.ErrVerificationFailed) {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPasskey, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	passkeyID, err := NewPasskeyID()
+	if err != nil {
+		return nil, err
+	}
+	return &Passkey{
+		ID:              passkeyID,
+		UserID:          *ceremony.UserID,
+		Name:            name,
+		CredentialID:    cred.ID,
+		PublicKey:       cred.PublicKey,
+		AttestationType: cred.AttestationType,
+		Transports:      cred.Transports,
+		AAGUID:          cred.AAGUID,
+		SignCount:       cred.SignCount,
+		BackupEligible:  cred.BackupEligible,
+		BackupState:     cred.BackupState,
+		CreateTime:      now,
+	}, nil
+}
+
+// CreatePasskey stores a passkey returned by FinishPasskeyRegistration.
+func (s *Service) CreatePasskey(ctx context.Context, pk *Passkey) error {
+	return s.deps.PasskeyStore.Create(ctx, pk)
+}
+
+// BeginPasskeyLogin starts a login ceremony. When user is nil, or has no passkeys, the ceremony is
+// discoverable so that the response does not reveal whether an account exists.
+func (s *Service) BeginPasskeyLogin(ctx context.Context, user *User, now time.Time) (*PasskeyChallenge, error) {
+	var target *passkey.User
+	var userID *UserID
+	if user != nil {
+		existing, err := s.deps.PasskeyStore.ListByUserID(ctx, user.ID)
+		if err != nil {
+			return nil, fmt.Errorf("list passkeys: %w", err)
+		}
+		if len(existing) > 0 {
+			pu := passkeyUser(user, existing)
+			target, userID = &pu, &user.ID
+		}
+	}
+
+	options, session, err := s.deps.RelyingParty.BeginLogin(target)
+	if err != nil {
+		return nil, err
+	}
+	return s.startPasskeyCeremony(ctx, PasskeyCeremonyLogin, userID, options, session, now)
+}
+
+// FinishPasskeyLogin verifies the authenticator's response to a login ceremony and returns the
+// account it signs in. The passkey's signature counter and last use are updated. It does not check
+// the account status.
+func (s *Service) FinishPasskeyLogin(ctx context.Context, ceremonyID string, response []byte, now time.Time) (*User, error) {
+	ceremony, err := s.deps.PasskeyCeremonyStore.Consume(ctx, ceremonyID, PasskeyCeremonyLogin, now)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		user     *User
+		passkeys []*Passkey
+	)
+	lookup := func(userHandle []byte) (*passkey.User, error) {
+		userID := UserID(userHandle)
+		if ceremony.UserID != nil && *ceremony.UserID != userID {
+			return nil, ErrInvalidPasskey
+		}
+		if user, err = s.deps.UserStore.GetByID(ctx, userID); err != nil {
+			return nil, ErrInvalidPasskey
+		}
+		if passkeys, err = s.deps.PasskeyStore.ListByUserID(ctx, userID); err != nil {
+			return nil, fmt.Errorf("list passkeys: %w", err)
+		}
+		pu := passkeyUser(user, passkeys)
+		return &pu, nil
+	}
+
+	_, cred, err := s.deps.RelyingParty.FinishLogin(ceremony.SessionData, response, lookup)
+	if errors.Is(err, passkey.ErrVerificationFailed) {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPasskey, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for _, pk := range passkeys {
+		if !bytes.Equal(pk.CredentialID, cred.ID) {
+			continue
+		}
+		pk.SignCount = cred.SignCount
+		pk.BackupState = cred.BackupState
+		pk.LastUsedTime = &now
+		if err := s.deps.PasskeyStore.Update(ctx, pk); err != nil {
+			return nil, fmt.Errorf("update passkey: %w", err)
+		}
+		return user, nil
+	}
+	return nil, ErrInvalidPasskey
+}
+
+// ListPasskeys returns the user's passkeys, oldest first.
+func (s *Service) ListPasskeys(ctx context.Context, userID UserID) ([]*Passkey, error) {
+	return s.deps.PasskeyStore.ListByUserID(ctx, userID)
+}
+
+// RenamePasskey changes the name of one of the user's passkeys.
+func (s *Service) RenamePasskey(ctx context.Context, userID UserID, passkeyID PasskeyID, name string) (*Passkey, error) {
+	name, err := normalizePasskeyName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	pk, err := s.deps.PasskeyStore.GetByID(ctx, userID, passkeyID)
+	if err != nil {
+		return nil, err
+	}
+	pk.Name = name
+	if err := s.deps.PasskeyStore.Update(ctx, pk); err != nil {
+		return nil, fmt.Errorf("update passkey: %w", err)
+	}
+	return pk, nil
+}
+
+// DeletePasskey removes one of the user's passkeys. The last passkey of an account without a
+// password cannot be removed.
+func (s *Service) DeletePasskey(ctx context.Context, userID UserID, passkeyID PasskeyID) (*Passkey, error) {
+	pk, err := s.deps.PasskeyStore.GetByID(ctx, userID, passkeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	passkeys, err := s.deps.PasskeyStore.ListByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("list passkeys: %w", err)
+	}
+	if len(passkeys) == 1 {
+		_, err := s.deps.CredentialStore.GetByUserIDAndAuthType(ctx, userID, AuthTypePassword)
+		if errors.Is(err, ErrCredentialNotFound) {
+			return nil, ErrLastCredential
+		}
+		if err != nil {
+			return nil, fmt.Errorf("get password credential: %w", err)
+		}
+	}
+
+	if err := s.deps.PasskeyStore.Delete(ctx, userID, passkeyID); err != nil {
+		return nil, err
+	}
+	return pk, nil
+}
+
+func (s *Service) startPasskeyCeremony(ctx context.Context, kind PasskeyCeremonyKind, userID *UserID, options, session []byte, now time.Time) (*PasskeyChallenge, error) {
+	ceremonyID, err := id.Generate(passkeyCeremonyPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	ceremony := &PasskeyCeremony{
+		ID:          ceremonyID,
+		Kind:        kind,
+		UserID:      userID,
+		SessionData: session,
+		ExpireTime:  now.Add(PasskeyCeremonyTTL),
+	}
+	if err := s.deps.PasskeyCeremonyStore.Create(ctx, ceremony); err != nil {
+		return nil, fmt.Errorf("store passkey ceremony: %w", err)
+	}
+
+	return &PasskeyChallenge{
+		CeremonyID: ceremony.ID,
+		Options:    options,
+		ExpireTime: ceremony.ExpireTime,
+	}, nil
+}
+
+// passkeyUser maps a user and their passkeys to the relying party's view of the account. The
+// user ID is the WebAuthn user handle.
+func passkeyUser(user *User, passkeys []*Passkey) passkey.User {
+	displayName := user.Name
+	if displayName == "" {
+		displayName = user.Email
+	}
+
+	creds := make([]passkey.Credential, len(passkeys))
+	for i, pk := range passkeys {
+		creds[i] = passkey.Credential{
+			ID:              pk.CredentialID,
+			PublicKey:       pk.PublicKey,
+			AttestationType: pk.AttestationType,
+			Transports:      pk.Transports,
+			AAGUID:          pk.AAGUID,
+			SignCount:       pk.SignCount,
+			BackupEligible:  pk.BackupEligible,
+			BackupState:     pk.BackupState,
+		}
+	}
+
+	return passkey.User{
+		ID:          []byte(user.ID),
+		Name:        user.Email,
+		DisplayName: displayName,
+		Credentials: creds,
+	}
+}
+
+func normalizePasskeyName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return DefaultPasskeyName, nil
+	}
+	if utf8.RuneCountInString(name) > maxPasskeyNameLength {
+		return "", ErrInvalidPasskeyName
+	}
+	return name, nil
+}
@@ -0,0 +1,287 @@
+package identity
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/masterkeysrd/saturn/internal/platform/passkey"
+	"github.com/masterkeysrd/saturn/internal/platform/passkey/passkeytest"
+)
+
+const testOrigin = "https://saturn.example.com"
+
+type memUserStore struct {
+	UserStoreProvider
+	users map[UserID]*User
+}
+
+func (m *memUserStore) GetByID(_ context.Context, id UserID) (*User, error) {
+	u, ok := m.users[id]
+	if !ok {
+		return nil, ErrUserNotFound
+	}
+	return u, nil
+}
+
+type memPasskeyStore struct {
+	passkeys []*Passkey
+}
+
+func (m *memPasskeyStore) Create(_ context.Context, pk *Passkey) error {
+	for _, existing := range m.passkeys {
+		if bytes.Equal(existing.CredentialID, pk.CredentialID) {
+			return ErrPasskeyExists
+		}
+	}
+	cp := *pk
+	m.passkeys = append(m.passkeys, &cp)
+	return nil
+}
+
+func (m *memPasskeyStore) GetByID(_ context.Context, userID UserID, id PasskeyID) (*Passkey, error) {
+	for _, pk := range m.passkeys {
+		if pk.UserID == userID && pk.ID == id {
+			cp := *pk
+			return &cp, nil
+		}
+	}
+	return nil, ErrPasskeyNotFound
+}
+
+func (m *memPasskeyStore) ListByUserID(_ context.Context, userID UserID) ([]*Passkey, error) {
+	var out []*Passkey
+	for _, pk := range m.passkeys {
+		if pk.UserID == userID {
+			cp := *pk
+			out = append(out, &cp)
+		}
+	}
+	return out, nil
+}
+
+func (m *memPasskeyStore) Update(_ context.Context, pk *Passkey) error {
+	for i, existing := range m.passkeys {
+		if existing.UserID == pk.UserID && existing.ID == pk.ID {
+			cp := *pk
+			m.passkeys[i] = &cp
+			return nil
+		}
+	}
+	return ErrPasskeyNotFound
+}
+
+func (m *memPasskeyStore) Delete(_ context.Context, userID UserID, id PasskeyID) error {
+	for i, pk := range m.passkeys {
+		if pk.UserID == userID && pk.ID == id {
+			m.passkeys = append(m.passkeys[:i], m.passkeys[i+1:]...)
+			return nil
+		}
+	}
+	return ErrPasskeyNotFound
+}
+
+type memPasskeyCeremonyStore struct {
+	ceremonies map[string]*PasskeyCeremony
+}
+
+func (m *memPasskeyCeremonyStore) Create(_ context.Context, c *PasskeyCeremony) error {
+	m.ceremonies[c.ID] = c
+	return nil
+}
+
+func (m *memPasskeyCeremonyStore) Consume(_ context.Context, id string, kind PasskeyCeremonyKind, now time.Time) (*PasskeyCeremony, error) {
+	c, ok := m.ceremonies[id]
+	if !ok || c.Kind != kind || !c.ExpireTime.After(now) {
+		return nil, ErrPasskeyCeremonyExpired
+	}
+	delete(m.ceremonies, id)
+	return c, nil
+}
+
+type passkeyFixture struct {
+	svc      *Service
+	creds    *memCredentialStore
+	passkeys *memPasskeyStore
+	auth     *passkeytest.Authenticator
+	user     *User
+}
+
+func newPasskeyFixture(t *testing.T) *passkeyFixture {
+	t.Helper()
+	rp, err := passkey.New(passkey.Config{RPID: "saturn.example.com", RPDisplayName: "Saturn", RPOrigins: []string{testOrigin}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	user := &User{ID: "usr_2NzN5q3y1pUQvU1ZQ9t2tq8xKxF", Email: "jane@example.com", Name: "Jane"}
+	f := &passkeyFixture{
+		creds:    &memCredentialStore{creds: make(map[string]*Credential)},
+		passkeys: &memPasskeyStore{},
+		auth:     passkeytest.New(testOrigin),
+		user:     user,
+	}
+	f.svc = NewService(Dependencies{
+		UserStore:            &memUserStore{users: map[UserID]*User{user.ID: user}},
+		CredentialStore:      f.creds,
+		PasskeyStore:         f.passkeys,
+		PasskeyCeremonyStore: &memPasskeyCeremonyStore{ceremonies: make(map[string]*PasskeyCeremony)},
+		RelyingParty:         rp,
+	})
+	return f
+}
+
+func (f *passkeyFixture) register(t *testing.T, name string, now time.Time) *Passkey {
+	t.Helper()
+	ctx := context.Background()
+	challenge, err := f.svc.BeginPasskeyRegistration(ctx, f.user, PasskeyCeremonyRegistration, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	response, err := f.auth.Create(challenge.Options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pk, err := f.svc.FinishPasskeyRegistration(ctx, &FinishPasskeyRegistrationRequest{
+		UserID:     f.user.ID,
+		CeremonyID: challenge.CeremonyID,
+		Name:       name,
+		Response:   response,
+	}, now)
+	if err != nil {
+		t.Fatalf("FinishPasskeyRegistration: %v", err)
+	}
+	if err := f.svc.CreatePasskey(ctx, pk); err != nil {
+		t.Fatal(err)
+	}
+	return pk
+}
+
+func (f *passkeyFixture) login(t *testing.T, user *User, now time.Time) (*User, error) {
+	t.Helper()
+	ctx := context.Background()
+	challenge, err := f.svc.BeginPasskeyLogin(ctx, user, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	response, err := f.auth.Get(challenge.Options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return f.svc.FinishPasskeyLogin(ctx, challenge.CeremonyID, response, now)
+}
+
+func TestService_PasskeyRegistrationAndLogin(t *testing.T) {
+	f := newPasskeyFixture(t)
+	now := time.Now()
+
+	pk := f.register(t, "  Laptop ", now)
+	if pk.Name != "Laptop" || pk.UserID != f.user.ID {
+		t.Errorf("unexpected passkey: %+v", pk)
+	}
+
+	for _, user := range []*User{f.user, nil} {
+		got, err := f.login(t, user, now)
+		if err != nil {
+			t.Fatalf("FinishPasskeyLogin: %v", err)
+		}
+		if got.ID != f.user.ID {
+			t.Errorf("expected %s, got %s", f.user.ID, got.ID)
+		}
+	}
+
+	stored, _ := f.svc.ListPasskeys(context.Background(), f.user.ID)
+	if stored[0].SignCount != 2 || stored[0].LastUsedTime == nil {
+		t.Errorf("expected the sign count and last use to be recorded, got %+v", stored[0])
+	}
+}
+
+func TestService_PasskeyCeremonyIsSingleUse(t *testing.T) {
+	f := newPasskeyFixture(t)
+	ctx := context.Background()
+	now := time.Now()
+	f.register(t, "Laptop", now)
+
+	challenge, err := f.svc.BeginPasskeyLogin(ctx, nil, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	response, err := f.auth.Get(challenge.Options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.svc.FinishPasskeyLogin(ctx, challenge.CeremonyID, response, now.Add(PasskeyCeremonyTTL)); !errors.Is(err, ErrPasskeyCeremonyExpired) {
+		t.Errorf("expected an expired ceremony to be rejected, got %v", err)
+	}
+
+	challenge, _ = f.svc.BeginPasskeyLogin(ctx, nil, now)
+	response, _ = f.auth.Get(challenge.Options)
+	if _, err := f.svc.FinishPasskeyLogin(ctx, challenge.CeremonyID, response, now); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.svc.FinishPasskeyLogin(ctx, challenge.CeremonyID, response, now); !errors.Is(err, ErrPasskeyCeremonyExpired) {
+		t.Errorf("expected a replayed response to be rejected, got %v", err)
+	}
+}
+
+func TestService_PasskeyRegistrationChecksOwner(t *testing.T) {
+	f := newPasskeyFixture(t)
+	ctx := context.Background()
+	now := time.Now()
+
+	challenge, err := f.svc.BeginPasskeyRegistration(ctx, f.user, PasskeyCeremonyRegistration, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	response, _ := f.auth.Create(challenge.Options)
+	_, err = f.svc.FinishPasskeyRegistration(ctx, &FinishPasskeyRegistrationRequest{
+		UserID:     "usr_2NzN5q3y1pUQvU1ZQ9t2tq8xKxG",
+		CeremonyID: challenge.CeremonyID,
+		Response:   response,
+	}, now)
+	if !errors.Is(err, ErrPasskeyCeremonyExpired) {
+		t.Errorf("expected another user's ceremony to be rejected, got %v", err)
+	}
+}
+
+func TestService_DeleteLastPasskey(t *testing.T) {
+	f := newPasskeyFixture(t)
+	ctx := context.Background()
+	now := time.Now()
+	laptop := f.register(t, "Laptop", now)
+	f.auth = passkeytest.New(testOrigin)
+	phone := f.register(t, "Phone", now)
+
+	if _, err := f.svc.DeletePasskey(ctx, f.user.ID, laptop.ID); err != nil {
+		t.Fatalf("expected a passkey to be removable while another remains, got %v", err)
+	}
+	if _, err := f.svc.DeletePasskey(ctx, f.user.ID, phone.ID); !errors.Is(err, ErrLastCredential) {
+		t.Fatalf("expected ErrLastCredential for a passkey-only account, got %v", err)
+	}
+
+	f.creds.creds[AuthTypePassword] = &Credential{UserID: f.user.ID, AuthType: AuthTypePassword, SecretData: "hash"}
+	if _, err := f.svc.DeletePasskey(ctx, f.user.ID, phone.ID); err != nil {
+		t.Fatalf("expected the last passkey to be removable with a password set, got %v", err)
+	}
+	if _, err := f.login(t, nil, now); err == nil {
+		t.Error("expected a removed passkey to be rejected")
+	}
+}
+
+func TestService_RenamePasskey(t *testing.T) {
+	f := newPasskeyFixture(t)
+	ctx := context.Background()
+	pk := f.register(t, "", time.Now())
+	if pk.Name != DefaultPasskeyName {
+		t.Errorf("expected the default name, got %q", pk.Name)
+	}
+
+	renamed, err := f.svc.RenamePasskey(ctx, f.user.ID, pk.ID, "YubiKey")
+	if err != nil || renamed.Name != "YubiKey" {
+		t.Fatalf("RenamePasskey: %v, %+v", err, renamed)
+	}
+	if _, err := f.svc.RenamePasskey(ctx, "usr_2NzN5q3y1pUQvU1ZQ9t2tq8xKxG", pk.ID, "Mine"); !errors.Is(err, ErrPasskeyNotFound) {
+		t.Errorf("expected ErrPasskeyNotFound for another user, got %v", err)
+	}
+}
@@ -13,6 +13,24 @@ const (
 	SecurityEventLoginFailed     SecurityEventType = "login_failed"
 	SecurityEventAccountLocked   SecurityEventType = "account_locked"
 	SecurityEventAccountUnlocked SecurityEventType = "account_unlocked"
+
+	SecurityEventMFAEnrolled         SecurityEventType = "mfa_enrolled"
+	SecurityEventMFADisabled         SecurityEventType = "mfa_disabled"
+	SecurityEventMFAReset            SecurityEventType = "mfa_reset"
+	SecurityEventMFASuccess          SecurityEventType = "mfa_success"
+	SecurityEventMFAFailed           SecurityEventType = "mfa_failed"
+	SecurityEventRecoveryCodeUsed    SecurityEventType = "mfa_recovery_code_used"
+	SecurityEventRecoveryCodesIssued SecurityEventType = "mfa_recovery_codes_issued"
+
+	SecurityEventPasskeyRegistered SecurityEventType = "passkey_registered"
+	SecurityEventPasskeyDeleted    SecurityEventType = "passkey_deleted"
+
+	SecurityEventPasswordResetRequested SecurityEventType = "password_reset_requested"
+	SecurityEventPasswordReset          SecurityEventType = "password_reset"
+	SecurityEventPasswordChanged        SecurityEventType = "password_changed"
+	SecurityEventPasswordChangeFailed   SecurityEventType = "password_change_failed"
+	SecurityEventEmailVerificationSent  SecurityEventType = "email_verification_sent"
+	SecurityEventEmailVerified          SecurityEventType = "email_verified"
 )
 
 // SecurityEvent represents a recorded authentication or authorization event.
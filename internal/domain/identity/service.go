@@ -11,6 +11,8 @@ var (
 	ErrUserNotFound     = errors.New("user not found")
 	ErrUserExists       = errors.New("user already exists")
 	ErrCredentialExists = errors.New("credential already exists")
+
+	ErrCredentialNotFound = errors.New("credential not found")
 )
 
 // UserStoreProvider provides access to the UserStore.
@@ -25,6 +27,7 @@ type UserStoreProvider interface {
 	GetAuthVersion(ctx context.Context, id UserID) (int64, error)
 	IncrementAuthVersion(ctx context.Context, id UserID) (int64, error)
 	UpdateLockoutState(ctx context.Context, req UpdateLockoutRequest) error
+	MarkEmailVerified(ctx context.Context, id UserID, email string, at time.Time) error
 }
 
 // CredentialStoreProvider provides access to the CredentialStore.
@@ -34,15 +37,22 @@ type CredentialStoreProvider interface {
 	GetByUserIDAndAuthType(ctx context.Context, userID UserID, authType string) (*Credential, error)
 	Delete(ctx context.Context, userID UserID, authType string) error
 	Update(ctx context.Context, credential *Credential) error
+	UseTOTPStep(ctx context.Context, userID UserID, step int64) error
 }
 
 // Dependencies holds all storage and hashing interfaces required by the Service.
 type Dependencies struct {
-	UserStore          UserStoreProvider
-	CredentialStore    CredentialStoreProvider
-	SessionStore       SessionStoreProvider
-	SecurityEventStore SecurityEventStore
-	Hasher             Hasher
+	UserStore            UserStoreProvider
+	CredentialStore      CredentialStoreProvider
+	SessionStore         SessionStoreProvider
+	SecurityEventStore   SecurityEventStore
+	RecoveryCodeStore    RecoveryCodeStore
+	PasskeyStore         PasskeyStore
+	PasskeyCeremonyStore PasskeyCeremonyStore
+	AccountTokenStore    AccountTokenStore
+	Hasher               Hasher
+	Cipher               Cipher
+	RelyingParty         RelyingParty
 }
 
 // Hasher is the password hashing interface used for authentication.
@@ -254,7 +264,7 @@ func (s *Service) Authenticate(ctx context.Context, identifier string, password
 		return nil, ErrAccountInactive
 	}
 
-	cred, err := s.deps.CredentialStore.GetByUserIDAndAuthType(ctx, user.ID, "password")
+	cred, err := s.deps.CredentialStore.GetByUserIDAndAuthType(ctx, user.ID, AuthTypePassword)
 	if err != nil {
 		return nil, errors.New("invalid credentials")
 	}
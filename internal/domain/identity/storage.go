@@ -30,6 +30,9 @@ type UserStore interface {
 
 	// UpdateLockoutState modifies the failed login attempts and lockout timestamps.
 	UpdateLockoutState(ctx context.Context, req UpdateLockoutRequest) error
+
+	// MarkEmailVerified records the verification of the user's email address, provided it is still email.
+	MarkEmailVerified(ctx context.Context, id UserID, email string, at time.Time) error
 }
 
 // UpdateLockoutRequest contains parameters for modifying a user's lockout state.
@@ -47,12 +50,16 @@ type UserCredentialStore interface {
 	// GetByUserID retrieves all credentials for a user.
 	GetByUserID(ctx context.Context, userID UserID) ([]*Credential, error)
 
-	// GetByUserIDAndAuthType retrieves a specific credential for a user.
+	// GetByUserIDAndAuthType retrieves a specific credential for a user. Returns ErrCredentialNotFound if missing.
 	GetByUserIDAndAuthType(ctx context.Context, userID UserID, authType string) (*Credential, error)
 
 	// Delete removes a credential for a user.
 	Delete(ctx context.Context, userID UserID, authType string) error
 
-	// Update replaces the secret_data for an existing credential.
+	// Update replaces the secret_data and second factor state of an existing credential.
 	Update(ctx context.Context, credential *Credential) error
+
+	// UseTOTPStep records step as the last accepted TOTP time step unless an equal or later step
+	// was already recorded. Returns ErrInvalidMFACode if the step was already used.
+	UseTOTPStep(ctx context.Context, userID UserID, step int64) error
 }
@@ -0,0 +1,88 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/masterkeysrd/saturn/internal/domain/identity"
+)
+
+// accountTokenDB is the internal DB record type for identity.user_account_tokens.
+type accountTokenDB struct {
+	TokenHash  string       `db:"token_hash"`
+	UserID     string       `db:"user_id"`
+	Purpose    string       `db:"purpose"`
+	Email      string       `db:"email"`
+	ExpireTime time.Time    `db:"expire_time"`
+	UseTime    sql.NullTime `db:"use_time"`
+	CreateTime time.Time    `db:"create_time"`
+}
+
+// AccountTokenStore implements identity.AccountTokenStore using sqlx.
+type AccountTokenStore struct {
+	db *sqlx.DB
+}
+
+// NewAccountTokenStore creates a new AccountTokenStore.
+func NewAccountTokenStore(db *sqlx.DB) *AccountTokenStore {
+	return &AccountTokenStore{db: db}
+}
+
+// Replace discards the user's unused tokens of the same purpose and stores the given one.
+func (s *AccountTokenStore) Replace(ctx context.Context, token *identity.AccountToken) error {
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM identity.user_account_tokens WHERE user_id = $1 AND purpose = $2 AND use_time IS NULL`,
+		token.UserID, token.Purpose); err != nil {
+		return fmt.Errorf("delete account tokens: %w", err)
+	}
+
+	query := `INSERT INTO identity.user_account_tokens (token_hash, user_id, purpose, email, expire_time, create_time)
+		VALUES ($1, $2, $3, $4, $5, $6)`
+	if _, err := tx.ExecContext(ctx, query, token.TokenHash, token.UserID, token.Purpose, token.Email,
+		token.ExpireTime.UTC(), token.CreateTime.UTC()); err != nil {
+		return fmt.Errorf("insert account token: %w", err)
+	}
+
+	return tx.Commit()
+}
+
+// Consume marks an unused, unexpired token as used and returns it. Returns
+// identity.ErrInvalidAccountToken when no such token matches.
+func (s *AccountTokenStore) Consume(ctx context.Context, purpose identity.AccountTokenPurpose, tokenHash string, now time.Time) (*identity.AccountToken, error) {
+	query := `UPDATE identity.user_account_tokens SET use_time = $3
+		WHERE token_hash = $1 AND purpose = $2 AND use_time IS NULL AND expire_time > $3
+		RETURNING token_hash, user_id, purpose, email, expire_time, use_time, create_time`
+	var db accountTokenDB
+	if err := s.db.GetContext(ctx, &db, query, tokenHash, purpose, now.UTC()); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, identity.ErrInvalidAccountToken
+		}
+		return nil, err
+	}
+
+	return &identity.AccountToken{
+		TokenHash:  db.TokenHash,
+		UserID:     identity.UserID(db.UserID),
+		Purpose:    identity.AccountTokenPurpose(db.Purpose),
+		Email:      db.Email,
+		ExpireTime: db.ExpireTime,
+		UseTime:    nullTimeToTimePtr(db.UseTime),
+		CreateTime: db.CreateTime,
+	}, nil
+}
+
+// DeleteByUserID removes the user's tokens of the given purpose.
+func (s *AccountTokenStore) DeleteByUserID(ctx context.Context, userID identity.UserID, purpose identity.AccountTokenPurpose) error {
+	_, err := s.db.ExecContext(ctx, `DELETE FROM identity.user_account_tokens WHERE user_id = $1 AND purpose = $2`, userID, purpose)
+	return err
+}
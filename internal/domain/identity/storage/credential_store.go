@@ -2,6 +2,7 @@ package storage
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 
 	"github.com/jmoiron/sqlx"
@@ -11,9 +12,11 @@ import (
 
 // credentialDB is the internal DB record type for identity.user_credentials.
 type credentialDB struct {
-	UserID     string `db:"user_id"`
-	AuthType   string `db:"auth_type"`
-	SecretData string `db:"secret_data"`
+	UserID       string       `db:"user_id"`
+	AuthType     string       `db:"auth_type"`
+	SecretData   string       `db:"secret_data"`
+	ConfirmTime  sql.NullTime `db:"confirm_time"`
+	LastUsedStep int64        `db:"last_used_step"`
 }
 
 // CredentialStore implements identity.UserCredentialStore using sqlx.
@@ -28,34 +31,46 @@ func NewCredentialStore(db *sqlx.DB) *CredentialStore {
 
 // toDomainCredential converts a credentialDB to a domain Credential.
 func toDomainCredential(c *credentialDB) *identity.Credential {
-	return &identity.Credential{
-		UserID:     identity.UserID(c.UserID),
-		AuthType:   c.AuthType,
-		SecretData: c.SecretData,
+	cred := &identity.Credential{
+		UserID:       identity.UserID(c.UserID),
+		AuthType:     c.AuthType,
+		SecretData:   c.SecretData,
+		LastUsedStep: c.LastUsedStep,
 	}
+	if c.ConfirmTime.Valid {
+		t := c.ConfirmTime.Time
+		cred.ConfirmTime = &t
+	}
+	return cred
 }
 
 // toDB converts a domain Credential to a credentialDB.
 func toDBCredential(c *identity.Credential) *credentialDB {
-	return &credentialDB{
-		UserID:     string(c.UserID),
-		AuthType:   c.AuthType,
-		SecretData: c.SecretData,
+	db := &credentialDB{
+		UserID:       string(c.UserID),
+		AuthType:     c.AuthType,
+		SecretData:   c.SecretData,
+		LastUsedStep: c.LastUsedStep,
+	}
+	if c.ConfirmTime != nil {
+		db.ConfirmTime = sql.NullTime{Time: c.ConfirmTime.UTC(), Valid: true}
 	}
+	return db
 }
 
 // Create inserts a new credential for the given user.
 func (s *CredentialStore) Create(ctx context.Context, credential *identity.Credential) error {
 	db := toDBCredential(credential)
-	query := `INSERT INTO identity.user_credentials (user_id, auth_type, secret_data)
-		VALUES ($1, $2, $3) ON CONFLICT (user_id, auth_type) DO UPDATE SET secret_data = $3`
-	_, err := s.db.ExecContext(ctx, query, db.UserID, db.AuthType, db.SecretData)
+	query := `INSERT INTO identity.user_credentials (user_id, auth_type, secret_data, confirm_time, last_used_step)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (user_id, auth_type) DO UPDATE SET secret_data = $3, confirm_time = $4, last_used_step = $5`
+	_, err := s.db.ExecContext(ctx, query, db.UserID, db.AuthType, db.SecretData, db.ConfirmTime, db.LastUsedStep)
 	return err
 }
 
 // GetByUserID retrieves all credentials for a user.
 func (s *CredentialStore) GetByUserID(ctx context.Context, userID identity.UserID) ([]*identity.Credential, error) {
-	query := `SELECT * FROM identity.user_credentials WHERE user_id = $1`
+	query := `SELECT user_id, auth_type, secret_data, confirm_time, last_used_step FROM identity.user_credentials WHERE user_id = $1`
 	var dbList []*credentialDB
 	if err := s.db.SelectContext(ctx, &dbList, query, userID); err != nil {
 		return nil, err
@@ -69,9 +84,12 @@ func (s *CredentialStore) GetByUserID(ctx context.Context, userID identity.UserI
 
 // GetByUserIDAndAuthType retrieves a specific credential for a user.
 func (s *CredentialStore) GetByUserIDAndAuthType(ctx context.Context, userID identity.UserID, authType string) (*identity.Credential, error) {
-	query := `SELECT * FROM identity.user_credentials WHERE user_id = $1 AND auth_type = $2`
+	query := `SELECT user_id, auth_type, secret_data, confirm_time, last_used_step FROM identity.user_credentials WHERE user_id = $1 AND auth_type = $2`
 	var db credentialDB
 	if err := s.db.GetContext(ctx, &db, query, userID, authType); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, identity.ErrCredentialNotFound
+		}
 		return nil, err
 	}
 	return toDomainCredential(&db), nil
@@ -94,11 +112,12 @@ func (s *CredentialStore) Delete(ctx context.Context, userID identity.UserID, au
 	return nil
 }
 
-// Update replaces the secret_data for an existing credential.
+// Update replaces the secret_data and second factor state of an existing credential.
 func (s *CredentialStore) Update(ctx context.Context, credential *identity.Credential) error {
 	db := toDBCredential(credential)
-	query := `UPDATE identity.user_credentials SET secret_data = $3 WHERE user_id = $1 AND auth_type = $2`
-	result, err := s.db.ExecContext(ctx, query, db.UserID, db.AuthType, db.SecretData)
+	query := `UPDATE identity.user_credentials SET secret_data = $3, confirm_time = $4, last_used_step = $5
+		WHERE user_id = $1 AND auth_type = $2`
+	result, err := s.db.ExecContext(ctx, query, db.UserID, db.AuthType, db.SecretData, db.ConfirmTime, db.LastUsedStep)
 	if err != nil {
 		return err
 	}
@@ -111,3 +130,22 @@ func (s *CredentialStore) Update(ctx context.Context, credential *identity.Crede
 	}
 	return nil
 }
+
+// UseTOTPStep records the last accepted TOTP time step. The step only moves forward, so two
+// concurrent logins with the same code cannot both succeed.
+func (s *CredentialStore) UseTOTPStep(ctx context.Context, userID identity.UserID, step int64) error {
+	query := `UPDATE identity.user_credentials SET last_used_step = $3
+		WHERE user_id = $1 AND auth_type = $2 AND last_used_step < $3`
+	result, err := s.db.ExecContext(ctx, query, userID, identity.AuthTypeTOTP, step)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return identity.ErrInvalidMFACode
+	}
+	return nil
+}
@@ -0,0 +1,219 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	"github.com/masterkeysrd/saturn/internal/domain/identity"
+)
+
+const passkeyColumns = `id, user_id, name, credential_id, public_key, attestation_type, transports, aaguid,
+	sign_count, backup_eligible, backup_state, create_time, last_used_time`
+
+// passkeyDB is the internal DB record type for identity.user_passkeys.
+type passkeyDB struct {
+	ID              string         `db:"id"`
+	UserID          string         `db:"user_id"`
+	Name            string         `db:"name"`
+	CredentialID    []byte         `db:"credential_id"`
+	PublicKey       []byte         `db:"public_key"`
+	AttestationType string         `db:"attestation_type"`
+	Transports      pq.StringArray `db:"transports"`
+	AAGUID          []byte         `db:"aaguid"`
+	SignCount       int64          `db:"sign_count"`
+	BackupEligible  bool           `db:"backup_eligible"`
+	BackupState     bool           `db:"backup_state"`
+	CreateTime      time.Time      `db:"create_time"`
+	LastUsedTime    sql.NullTime   `db:"last_used_time"`
+}
+
+func toDomainPasskey(p *passkeyDB) *identity.Passkey {
+	pk := &identity.Passkey{
+		ID:              identity.PasskeyID(p.ID),
+		UserID:          identity.UserID(p.UserID),
+		Name:            p.Name,
+		CredentialID:    p.CredentialID,
+		PublicKey:       p.PublicKey,
+		AttestationType: p.AttestationType,
+		Transports:      p.Transports,
+		AAGUID:          p.AAGUID,
+		SignCount:       uint32(p.SignCount),
+		BackupEligible:  p.BackupEligible,
+		BackupState:     p.BackupState,
+		CreateTime:      p.CreateTime,
+	}
+	if p.LastUsedTime.Valid {
+		t := p.LastUsedTime.Time
+		pk.LastUsedTime = &t
+	}
+	return pk
+}
+
+// PasskeyStore implements identity.PasskeyStore using sqlx.
+type PasskeyStore struct {
+	db *sqlx.DB
+}
+
+// NewPasskeyStore creates a new PasskeyStore.
+func NewPasskeyStore(db *sqlx.DB) *PasskeyStore {
+	return &PasskeyStore{db: db}
+}
+
+// Create stores a passkey. Returns identity.ErrPasskeyExists if the credential ID is already registered.
+func (s *PasskeyStore) Create(ctx context.Context, pk *identity.Passkey) error {
+	query := `INSERT INTO identity.user_passkeys (` + passkeyColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+		ON CONFLICT (credential_id) DO NOTHING`
+	transports := pq.StringArray(pk.Transports)
+	if transports == nil {
+		transports = pq.StringArray{}
+	}
+	var lastUsed sql.NullTime
+	if pk.LastUsedTime != nil {
+		lastUsed = sql.NullTime{Time: pk.LastUsedTime.UTC(), Valid: true}
+	}
+	result, err := s.db.ExecContext(ctx, query,
+		pk.ID, pk.UserID, pk.Name, pk.CredentialID, pk.PublicKey, pk.AttestationType,
+		transports, pk.AAGUID, int64(pk.SignCount), pk.BackupEligible, pk.BackupState,
+		pk.CreateTime.UTC(), lastUsed)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return identity.ErrPasskeyExists
+	}
+	return nil
+}
+
+// GetByID retrieves a passkey of the user. Returns identity.ErrPasskeyNotFound if not found.
+func (s *PasskeyStore) GetByID(ctx context.Context, userID identity.UserID, id identity.PasskeyID) (*identity.Passkey, error) {
+	query := `SELECT ` + passkeyColumns + ` FROM identity.user_passkeys WHERE user_id = $1 AND id = $2`
+	var db passkeyDB
+	if err := s.db.GetContext(ctx, &db, query, userID, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, identity.ErrPasskeyNotFound
+		}
+		return nil, err
+	}
+	return toDomainPasskey(&db), nil
+}
+
+// ListByUserID returns the user's passkeys, oldest first.
+func (s *PasskeyStore) ListByUserID(ctx context.Context, userID identity.UserID) ([]*identity.Passkey, error) {
+	query := `SELECT ` + passkeyColumns + ` FROM identity.user_passkeys WHERE user_id = $1 ORDER BY create_time, id`
+	var dbList []*passkeyDB
+	if err := s.db.SelectContext(ctx, &dbList, query, userID); err != nil {
+		return nil, err
+	}
+	result := make([]*identity.Passkey, len(dbList))
+	for i, db := range dbList {
+		result[i] = toDomainPasskey(db)
+	}
+	return result, nil
+}
+
+// Update saves the name, signature counter, backup state and last use of a passkey.
+func (s *PasskeyStore) Update(ctx context.Context, pk *identity.Passkey) error {
+	query := `UPDATE identity.user_passkeys SET name = $3, sign_count = $4, backup_state = $5, last_used_time = $6
+		WHERE user_id = $1 AND id = $2`
+	var lastUsed sql.NullTime
+	if pk.LastUsedTime != nil {
+		lastUsed = sql.NullTime{Time: pk.LastUsedTime.UTC(), Valid: true}
+	}
+	result, err := s.db.ExecContext(ctx, query, pk.UserID, pk.ID, pk.Name, int64(pk.SignCount), pk.BackupState, lastUsed)
+	if err != nil {
+		return err
+	}
+	return expectPasskeyRow(result)
+}
+
+// Delete removes a passkey of the user. Returns identity.ErrPasskeyNotFound if not found.
+func (s *PasskeyStore) Delete(ctx context.Context, userID identity.UserID, id identity.PasskeyID) error {
+	result, err := s.db.ExecContext(ctx, `DELETE FROM identity.user_passkeys WHERE user_id = $1 AND id = $2`, userID, id)
+	if err != nil {
+		return err
+	}
+	return expectPasskeyRow(result)
+}
+
+func expectPasskeyRow(result sql.Result) error {
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return identity.ErrPasskeyNotFound
+	}
+	return nil
+}
+
+// PasskeyCeremonyStore implements identity.PasskeyCeremonyStore using sqlx.
+type PasskeyCeremonyStore struct {
+	db *sqlx.DB
+}
+
+// NewPasskeyCeremonyStore creates a new PasskeyCeremonyStore.
+func NewPasskeyCeremonyStore(db *sqlx.DB) *PasskeyCeremonyStore {
+	return &PasskeyCeremonyStore{db: db}
+}
+
+// Create stores a ceremony and prunes expired ones.
+func (s *PasskeyCeremonyStore) Create(ctx context.Context, ceremony *identity.PasskeyCeremony) error {
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM identity.webauthn_ceremonies WHERE expire_time < NOW()`); err != nil {
+		return err
+	}
+
+	query := `INSERT INTO identity.webauthn_ceremonies (id, kind, user_id, session_data, expire_time)
+		VALUES ($1, $2, $3, $4, $5)`
+	if _, err := tx.ExecContext(ctx, query, ceremony.ID, ceremony.Kind, ceremony.UserID, string(ceremony.SessionData), ceremony.ExpireTime.UTC()); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// Consume removes and returns an unexpired ceremony of the given kind. Returns
+// identity.ErrPasskeyCeremonyExpired if there is none.
+func (s *PasskeyCeremonyStore) Consume(ctx context.Context, id string, kind identity.PasskeyCeremonyKind, now time.Time) (*identity.PasskeyCeremony, error) {
+	query := `DELETE FROM identity.webauthn_ceremonies WHERE id = $1 AND kind = $2 AND expire_time > $3
+		RETURNING id, kind, user_id, session_data, expire_time`
+	var db struct {
+		ID          string         `db:"id"`
+		Kind        string         `db:"kind"`
+		UserID      sql.NullString `db:"user_id"`
+		SessionData []byte         `db:"session_data"`
+		ExpireTime  time.Time      `db:"expire_time"`
+	}
+	if err := s.db.GetContext(ctx, &db, query, id, kind, now.UTC()); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, identity.ErrPasskeyCeremonyExpired
+		}
+		return nil, err
+	}
+
+	ceremony := &identity.PasskeyCeremony{
+		ID:          db.ID,
+		Kind:        identity.PasskeyCeremonyKind(db.Kind),
+		SessionData: db.SessionData,
+		ExpireTime:  db.ExpireTime,
+	}
+	if db.UserID.Valid {
+		userID := identity.UserID(db.UserID.String)
+		ceremony.UserID = &userID
+	}
+	return ceremony, nil
+}
@@ -0,0 +1,77 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/masterkeysrd/saturn/internal/domain/identity"
+)
+
+// RecoveryCodeStore implements identity.RecoveryCodeStore using sqlx.
+type RecoveryCodeStore struct {
+	db *sqlx.DB
+}
+
+// NewRecoveryCodeStore creates a new RecoveryCodeStore.
+func NewRecoveryCodeStore(db *sqlx.DB) *RecoveryCodeStore {
+	return &RecoveryCodeStore{db: db}
+}
+
+// Replace atomically discards the user's codes and stores the given ones.
+func (s *RecoveryCodeStore) Replace(ctx context.Context, userID identity.UserID, codes []*identity.RecoveryCode) error {
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM identity.user_recovery_codes WHERE user_id = $1`, userID); err != nil {
+		return fmt.Errorf("delete recovery codes: %w", err)
+	}
+
+	query := `INSERT INTO identity.user_recovery_codes (user_id, code_hash, create_time) VALUES ($1, $2, $3)`
+	for _, code := range codes {
+		if _, err := tx.ExecContext(ctx, query, userID, code.CodeHash, code.CreateTime.UTC()); err != nil {
+			return fmt.Errorf("insert recovery code: %w", err)
+		}
+	}
+
+	return tx.Commit()
+}
+
+// Consume marks an unused code as used. Returns identity.ErrInvalidRecoveryCode when no unused code matches.
+func (s *RecoveryCodeStore) Consume(ctx context.Context, userID identity.UserID, codeHash string, now time.Time) error {
+	query := `UPDATE identity.user_recovery_codes SET use_time = $3
+		WHERE user_id = $1 AND code_hash = $2 AND use_time IS NULL`
+	result, err := s.db.ExecContext(ctx, query, userID, codeHash, now.UTC())
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return identity.ErrInvalidRecoveryCode
+	}
+	return nil
+}
+
+// CountUnused returns the number of codes the user has left.
+func (s *RecoveryCodeStore) CountUnused(ctx context.Context, userID identity.UserID) (int, error) {
+	var count int
+	query := `SELECT COUNT(*) FROM identity.user_recovery_codes WHERE user_id = $1 AND use_time IS NULL`
+	if err := s.db.GetContext(ctx, &count, query, userID); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// DeleteByUserID removes all codes of the user.
+func (s *RecoveryCodeStore) DeleteByUserID(ctx context.Context, userID identity.UserID) error {
+	_, err := s.db.ExecContext(ctx, `DELETE FROM identity.user_recovery_codes WHERE user_id = $1`, userID)
+	return err
+}
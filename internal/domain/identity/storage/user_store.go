@@ -28,6 +28,7 @@ type userDB struct {
 	AuthVersion         int64        `db:"auth_version"`
 	FailedLoginAttempts int          `db:"failed_login_attempts"`
 	LockedUntil         sql.NullTime `db:"locked_until"`
+	EmailVerifyTime     sql.NullTime `db:"email_verify_time"`
 	CreateTime          sql.NullTime `db:"create_time"`
 	UpdateTime          sql.NullTime `db:"update_time"`
 }
@@ -56,6 +57,7 @@ func toDomainUser(u *userDB) *identity.User {
 		AuthVersion:         u.AuthVersion,
 		FailedLoginAttempts: u.FailedLoginAttempts,
 		LockedUntil:         nullTimeToTimePtr(u.LockedUntil),
+		EmailVerifyTime:     nullTimeToTimePtr(u.EmailVerifyTime),
 		CreateTime:          nullTimeToTime(u.CreateTime),
 		UpdateTime:          nullTimeToTime(u.UpdateTime),
 	}
@@ -75,6 +77,7 @@ func toDBUser(u *identity.User) *userDB {
 		AuthVersion:         u.AuthVersion,
 		FailedLoginAttempts: u.FailedLoginAttempts,
 		LockedUntil:         timePtrToNullTime(u.LockedUntil),
+		EmailVerifyTime:     timePtrToNullTime(u.EmailVerifyTime),
 		CreateTime:          timeToNullTime(u.CreateTime),
 		UpdateTime:          timeToNullTime(u.UpdateTime),
 	}
@@ -83,9 +86,9 @@ func toDBUser(u *identity.User) *userDB {
 // Create inserts a new user and returns the created record.
 func (s *UserStore) Create(ctx context.Context, user *identity.User) error {
 	db := toDBUser(user)
-	query := `INSERT INTO identity.user (id, email, username, name, avatar_url, status, access_level, version, create_time, update_time)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NOW(), NOW())`
-	_, err := s.db.ExecContext(ctx, query, db.ID, db.Email, db.Username, db.Name, db.AvatarURL, db.Status, db.AccessLevel, db.Version)
+	query := `INSERT INTO identity.user (id, email, username, name, avatar_url, status, access_level, version, email_verify_time, create_time, update_time)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, NOW(), NOW())`
+	_, err := s.db.ExecContext(ctx, query, db.ID, db.Email, db.Username, db.Name, db.AvatarURL, db.Status, db.AccessLevel, db.Version, db.EmailVerifyTime)
 	return err
 }
 
@@ -119,9 +122,10 @@ func (s *UserStore) GetByUsername(ctx context.Context, username string) (*identi
 	return toDomainUser(&db), nil
 }
 
-// Update modifies an existing user with optimistic locking.
+// Update modifies an existing user with optimistic locking. Changing the email address clears its verification.
 func (s *UserStore) Update(ctx context.Context, user *identity.User) error {
-	query := `UPDATE identity.user SET email = $2, username = $3, name = $4, avatar_url = $5, status = $6, access_level = $7, version = $8 + 1, update_time = NOW()
+	query := `UPDATE identity.user SET email = $2, username = $3, name = $4, avatar_url = $5, status = $6, access_level = $7, version = $8 + 1, update_time = NOW(),
+		email_verify_time = CASE WHEN email = $2 THEN email_verify_time END
 		WHERE id = $1 AND version = $8`
 	result, err := s.db.ExecContext(ctx, query, user.ID, user.Email, user.Username, user.Name, user.AvatarURL, user.Status, string(user.AccessLevel), user.Version)
 	if err != nil {
@@ -266,6 +270,23 @@ func (s *UserStore) UpdateLockoutState(ctx context.Context, req identity.UpdateL
 	return err
 }
 
+// MarkEmailVerified records the verification of the user's email address, provided it is still email.
+func (s *UserStore) MarkEmailVerified(ctx context.Context, id identity.UserID, email string, at time.Time) error {
+	query := `UPDATE identity.user SET email_verify_time = $3, update_time = NOW() WHERE id = $1 AND email = $2`
+	result, err := s.db.ExecContext(ctx, query, string(id), email, at.UTC())
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return identity.ErrUserNotFound
+	}
+	return nil
+}
+
 func nullTimeToTimePtr(nt sql.NullTime) *time.Time {
 	if !nt.Valid {
 		return nil
@@ -38,10 +38,16 @@ type User struct {
 	AuthVersion         int64       `json:"auth_version"`
 	FailedLoginAttempts int         `json:"failed_login_attempts"`
 	LockedUntil         *time.Time  `json:"locked_until,omitempty"`
+	EmailVerifyTime     *time.Time  `json:"email_verify_time,omitempty"`
 	CreateTime          time.Time   `json:"create_time"`
 	UpdateTime          time.Time   `json:"update_time"`
 }
 
+// IsEmailVerified reports whether the user has proven ownership of their email address.
+func (u *User) IsEmailVerified() bool {
+	return u.EmailVerifyTime != nil
+}
+
 const userIDPrefix = "usr_"
 
 // ErrInvalidUserID is returned when a UserID does not conform to the expected format.
@@ -8,10 +8,12 @@
 //
 //	Space — represents a workspace with CRUD operations and ownership.
 //	Member — represents a user's membership in a workspace with role-based access.
+//	Invitation — offers membership to an email address through an expiring, hashed token.
 //	SpaceID — a KSUID-based string type with prefix validation.
 //
 // Interfaces:
 //
 //	SpaceStore — CRUD operations for space entities.
 //	MemberStore — CRUD operations for member entities with access checks.
+//	InvitationStore — persistence for invitations, including atomic acceptance.
 package space
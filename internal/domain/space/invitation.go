@@ -0,0 +1,129 @@
+package space
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net/mail"
+	"strings"
+	"time"
+
+	"github.com/masterkeysrd/saturn/internal/platform/hash"
+	"github.com/masterkeysrd/saturn/internal/platform/id"
+	"github.com/masterkeysrd/saturn/internal/platform/token"
+)
+
+// InvitationID is a custom string type representing an invitation's unique identifier (KSUID).
+type InvitationID string
+
+// NewInvitationID creates a new InvitationID using the default ID generator.
+func NewInvitationID() (InvitationID, error) {
+	raw, err := id.Generate(invitationPrefix)
+	if err != nil {
+		return "", err
+	}
+	return InvitationID(raw), nil
+}
+
+// ParseInvitationID parses a string into an InvitationID and validates it.
+func ParseInvitationID(s string) (InvitationID, error) {
+	if err := id.Validate(s, invitationPrefix); err != nil {
+		return "", fmt.Errorf("invalid invitation ID: %w", err)
+	}
+	return InvitationID(s), nil
+}
+
+// String returns the string representation of the InvitationID.
+func (iid InvitationID) String() string {
+	return string(iid)
+}
+
+const invitationPrefix = "inv_"
+
+// DefaultInvitationTTL is how long an invitation stays valid when no expiry is requested.
+const DefaultInvitationTTL = 7 * 24 * time.Hour
+
+// MaxInvitationTTL bounds the expiry an inviter may request.
+const MaxInvitationTTL = 30 * 24 * time.Hour
+
+// InvitationStatus is the lifecycle state of an invitation.
+type InvitationStatus string
+
+const (
+	InvitationStatusPending  InvitationStatus = "pending"
+	InvitationStatusAccepted InvitationStatus = "accepted"
+	InvitationStatusDeclined InvitationStatus = "declined"
+	InvitationStatusRevoked  InvitationStatus = "revoked"
+)
+
+// Invitation offers membership of a space to an email address. Only the SHA-256 hash of the
+// invite token is stored; the raw token is delivered to the invitee once, by email.
+type Invitation struct {
+	ID         InvitationID     `json:"id"`
+	SpaceID    SpaceID          `json:"space_id"`
+	Email      string           `json:"email"`
+	Role       SpaceRole        `json:"role"`
+	InviterID  SpaceID          `json:"inviter_id"`
+	TokenHash  string           `json:"-"`
+	Status     InvitationStatus `json:"status"`
+	ExpireTime time.Time        `json:"expire_time"`
+	AcceptedBy *SpaceID         `json:"accepted_by,omitempty"` // Nullable
+	CreateTime time.Time        `json:"create_time"`
+	UpdateTime time.Time        `json:"update_time"`
+}
+
+// Validate checks the invitation for business rule violations and normalizes the email.
+func (i *Invitation) Validate() error {
+	email, err := NormalizeEmail(i.Email)
+	if err != nil {
+		return err
+	}
+	i.Email = email
+
+	if !i.Role.IsValid() {
+		return ErrInvalidRole
+	}
+	if i.Role == RoleOwner {
+		return fmt.Errorf("%w: ownership cannot be granted by invitation", ErrInvalidRole)
+	}
+	return nil
+}
+
+// IsExpired reports whether the invitation can no longer be answered.
+func (i *Invitation) IsExpired(now time.Time) bool {
+	return !now.Before(i.ExpireTime)
+}
+
+// IsPending reports whether the invitation is still awaiting an answer.
+func (i *Invitation) IsPending(now time.Time) bool {
+	return i.Status == InvitationStatusPending && !i.IsExpired(now)
+}
+
+// IsFor reports whether the invitation was addressed to the given email.
+func (i *Invitation) IsFor(email string) bool {
+	normalized, err := NormalizeEmail(email)
+	return err == nil && normalized == i.Email
+}
+
+// NormalizeEmail validates a bare email address and lowercases it.
+func NormalizeEmail(email string) (string, error) {
+	email = strings.ToLower(strings.TrimSpace(email))
+	addr, err := mail.ParseAddress(email)
+	if err != nil || addr.Address != email {
+		return "", fmt.Errorf("invalid email address %q", email)
+	}
+	return email, nil
+}
+
+// GenerateInvitationToken returns a new random invite token and the hash to persist.
+func GenerateInvitationToken() (raw string, tokenHash string, err error) {
+	raw, err = token.GenerateRandomHex(32)
+	if err != nil {
+		return "", "", err
+	}
+	return raw, HashInvitationToken(raw), nil
+}
+
+// HashInvitationToken hashes a raw invite token for lookup.
+func HashInvitationToken(raw string) string {
+	return hex.EncodeToString(hash.SHA256String(strings.TrimSpace(raw)))
+}
@@ -0,0 +1,280 @@
+package space
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+type memberKey struct {
+	spaceID SpaceID
+	userID  SpaceID
+}
+
+type mockSpaceStore struct {
+	SpaceStore
+	spaces map[SpaceID]*Space
+}
+
+func (m *mockSpaceStore) GetByID(_ context.Context, id SpaceID) (*Space, error) {
+	sp, ok := m.spaces[id]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return sp, nil
+}
+
+type mockMemberStore struct {
+	MemberStore
+	members map[memberKey]*Member
+}
+
+func (m *mockMemberStore) GetByID(_ context.Context, spaceID SpaceID, userID SpaceID) (*Member, error) {
+	member, ok := m.members[memberKey{spaceID, userID}]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return member, nil
+}
+
+func (m *mockMemberStore) Exists(_ context.Context, spaceID SpaceID, userID SpaceID) (bool, error) {
+	_, ok := m.members[memberKey{spaceID, userID}]
+	return ok, nil
+}
+
+type mockInvitationStore struct {
+	invitations map[InvitationID]*Invitation
+	members     *mockMemberStore
+}
+
+func (m *mockInvitationStore) Create(_ context.Context, inv *Invitation) error {
+	cp := *inv
+	m.invitations[inv.ID] = &cp
+	return nil
+}
+
+func (m *mockInvitationStore) GetByID(_ context.Context, spaceID SpaceID, id InvitationID) (*Invitation, error) {
+	inv, ok := m.invitations[id]
+	if !ok || inv.SpaceID != spaceID {
+		return nil, errors.New("not found")
+	}
+	cp := *inv
+	return &cp, nil
+}
+
+func (m *mockInvitationStore) GetByTokenHash(_ context.Context, tokenHash string) (*Invitation, error) {
+	for _, inv := range m.invitations {
+		if inv.TokenHash == tokenHash {
+			cp := *inv
+			return &cp, nil
+		}
+	}
+	return nil, errors.New("not found")
+}
+
+func (m *mockInvitationStore) Update(_ context.Context, inv *Invitation) error {
+	if m.invitations[inv.ID].Status != InvitationStatusPending {
+		return ErrInvitationNotPending
+	}
+	cp := *inv
+	m.invitations[inv.ID] = &cp
+	return nil
+}
+
+func (m *mockInvitationStore) Accept(ctx context.Context, inv *Invitation, member *Member) error {
+	if err := m.Update(ctx, inv); err != nil {
+		return err
+	}
+	m.members.members[memberKey{member.SpaceID, member.UserID}] = member
+	return nil
+}
+
+func (m *mockInvitationStore) ListBySpace(_ context.Context, spaceID SpaceID, _ *ListInvitationsFilter) ([]*Invitation, string, error) {
+	var out []*Invitation
+	for _, inv := range m.invitations {
+		if inv.SpaceID == spaceID {
+			out = append(out, inv)
+		}
+	}
+	return out, "", nil
+}
+
+func (m *mockInvitationStore) ExistsPending(_ context.Context, spaceID SpaceID, email string) (bool, error) {
+	for _, inv := range m.invitations {
+		if inv.SpaceID == spaceID && inv.Email == email && inv.IsPending(time.Now()) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func TestInvitation_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		inv     Invitation
+		wantErr string
+	}{
+		{name: "valid", inv: Invitation{Email: " Jane@Example.com ", Role: RoleMember}},
+		{name: "display name is not a bare address", inv: Invitation{Email: "Jane <jane@example.com>", Role: RoleMember}, wantErr: "invalid email"},
+		{name: "missing email", inv: Invitation{Role: RoleViewer}, wantErr: "invalid email"},
+		{name: "unknown role", inv: Invitation{Email: "jane@example.com", Role: "superuser"}, wantErr: "invalid role"},
+		{name: "owner role", inv: Invitation{Email: "jane@example.com", Role: RoleOwner}, wantErr: "ownership"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.inv.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if tt.inv.Email != "jane@example.com" {
+					t.Errorf("expected normalized email, got %q", tt.inv.Email)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestService_Invitations(t *testing.T) {
+	ctx := context.Background()
+	spaceID := MustSpaceID("spc_2NzN5q3y1pUQvU1ZQ9t2tq8xKxF")
+	owner, member, newcomer := SpaceID("usr_owner"), SpaceID("usr_member"), SpaceID("usr_newcomer")
+
+	setup := func() (*Service, *mockMemberStore, *mockInvitationStore) {
+		members := &mockMemberStore{members: map[memberKey]*Member{
+			{spaceID, owner}:  {SpaceID: spaceID, UserID: owner, Role: RoleOwner},
+			{spaceID, member}: {SpaceID: spaceID, UserID: member, Role: RoleMember},
+		}}
+		invitations := &mockInvitationStore{invitations: make(map[InvitationID]*Invitation), members: members}
+		svc := NewService(Dependencies{
+			SpaceStore:      &mockSpaceStore{spaces: map[SpaceID]*Space{spaceID: {ID: spaceID, Name: "Home"}}},
+			MemberStore:     members,
+			InvitationStore: invitations,
+		})
+		return svc, members, invitations
+	}
+	ownerSession := Session{SpaceID: spaceID, UserID: owner}
+
+	t.Run("accept joins the space with the invited role", func(t *testing.T) {
+		svc, members, invitations := setup()
+
+		inv, token, err := svc.CreateInvitation(ctx, ownerSession, &Invitation{Email: "New@Example.com", Role: RoleViewer}, "", 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if inv.TokenHash == token || inv.TokenHash != HashInvitationToken(token) {
+			t.Fatal("expected only the token hash to be stored")
+		}
+		if d := time.Until(inv.ExpireTime); d < DefaultInvitationTTL-time.Minute || d > DefaultInvitationTTL {
+			t.Errorf("expected default expiry, got %s", d)
+		}
+
+		m, err := svc.AcceptInvitation(ctx, newcomer, "new@example.com", token)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if m.Role != RoleViewer || members.members[memberKey{spaceID, newcomer}] == nil {
+			t.Errorf("expected viewer membership, got %+v", m)
+		}
+		if stored := invitations.invitations[inv.ID]; stored.Status != InvitationStatusAccepted || *stored.AcceptedBy != newcomer {
+			t.Errorf("expected invitation accepted by newcomer, got %+v", stored)
+		}
+
+		if _, err := svc.AcceptInvitation(ctx, newcomer, "new@example.com", token); !errors.Is(err, ErrInvitationNotPending) {
+			t.Errorf("expected a used token to be rejected, got %v", err)
+		}
+	})
+
+	t.Run("only member managers can invite", func(t *testing.T) {
+		svc, _, _ := setup()
+		_, _, err := svc.CreateInvitation(ctx, Session{SpaceID: spaceID, UserID: member}, &Invitation{Email: "new@example.com", Role: RoleViewer}, "", 0)
+		if !errors.Is(err, ErrInsufficientRole) {
+			t.Fatalf("expected ErrInsufficientRole, got %v", err)
+		}
+	})
+
+	t.Run("existing members are only revealed to member managers", func(t *testing.T) {
+		svc, _, _ := setup()
+		_, _, err := svc.CreateInvitation(ctx, Session{SpaceID: spaceID, UserID: member}, &Invitation{Email: "owner@example.com", Role: RoleViewer}, owner, 0)
+		if !errors.Is(err, ErrInsufficientRole) {
+			t.Fatalf("expected ErrInsufficientRole, got %v", err)
+		}
+		_, _, err = svc.CreateInvitation(ctx, ownerSession, &Invitation{Email: "member@example.com", Role: RoleViewer}, member, 0)
+		if !errors.Is(err, ErrMemberAlreadyExists) {
+			t.Fatalf("expected ErrMemberAlreadyExists, got %v", err)
+		}
+	})
+
+	t.Run("duplicate pending invitation", func(t *testing.T) {
+		svc, _, _ := setup()
+		if _, _, err := svc.CreateInvitation(ctx, ownerSession, &Invitation{Email: "new@example.com", Role: RoleMember}, "", 0); err != nil {
+			t.Fatal(err)
+		}
+		_, _, err := svc.CreateInvitation(ctx, ownerSession, &Invitation{Email: "NEW@example.com", Role: RoleAdmin}, "", 0)
+		if !errors.Is(err, ErrInvitationAlreadyExists) {
+			t.Fatalf("expected ErrInvitationAlreadyExists, got %v", err)
+		}
+	})
+
+	t.Run("email must match", func(t *testing.T) {
+		svc, _, _ := setup()
+		_, token, err := svc.CreateInvitation(ctx, ownerSession, &Invitation{Email: "new@example.com", Role: RoleMember}, "", 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := svc.AcceptInvitation(ctx, newcomer, "someone-else@example.com", token); !errors.Is(err, ErrInvitationEmailMismatch) {
+			t.Fatalf("expected ErrInvitationEmailMismatch, got %v", err)
+		}
+	})
+
+	t.Run("revoked and declined invitations cannot be accepted", func(t *testing.T) {
+		svc, _, _ := setup()
+		revoked, revokedToken, err := svc.CreateInvitation(ctx, ownerSession, &Invitation{Email: "a@example.com", Role: RoleMember}, "", 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := svc.RevokeInvitation(ctx, ownerSession, revoked.ID); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := svc.AcceptInvitation(ctx, newcomer, "a@example.com", revokedToken); !errors.Is(err, ErrInvitationNotPending) {
+			t.Errorf("expected revoked invitation to be rejected, got %v", err)
+		}
+
+		_, declinedToken, err := svc.CreateInvitation(ctx, ownerSession, &Invitation{Email: "b@example.com", Role: RoleMember}, "", 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if inv, err := svc.DeclineInvitation(ctx, "b@example.com", declinedToken); err != nil || inv.Status != InvitationStatusDeclined {
+			t.Fatalf("expected decline to succeed, got %+v, %v", inv, err)
+		}
+		if _, err := svc.AcceptInvitation(ctx, newcomer, "b@example.com", declinedToken); !errors.Is(err, ErrInvitationNotPending) {
+			t.Errorf("expected declined invitation to be rejected, got %v", err)
+		}
+	})
+
+	t.Run("expired invitation", func(t *testing.T) {
+		svc, _, invitations := setup()
+		inv, token, err := svc.CreateInvitation(ctx, ownerSession, &Invitation{Email: "new@example.com", Role: RoleMember}, "", time.Hour)
+		if err != nil {
+			t.Fatal(err)
+		}
+		invitations.invitations[inv.ID].ExpireTime = time.Now().Add(-time.Minute)
+		if _, err := svc.AcceptInvitation(ctx, newcomer, "new@example.com", token); !errors.Is(err, ErrInvitationExpired) {
+			t.Fatalf("expected ErrInvitationExpired, got %v", err)
+		}
+	})
+
+	t.Run("unknown token", func(t *testing.T) {
+		svc, _, _ := setup()
+		if _, err := svc.AcceptInvitation(ctx, newcomer, "new@example.com", "deadbeef"); !errors.Is(err, ErrInvitationNotFound) {
+			t.Fatalf("expected ErrInvitationNotFound, got %v", err)
+		}
+	})
+}
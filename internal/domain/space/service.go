@@ -3,6 +3,7 @@ package space
 import (
 	"context"
 	"errors"
+	"fmt"
 	"time"
 )
 
@@ -15,12 +16,19 @@ var (
 	ErrMemberNotFound      = errors.New("member not found")
 	ErrMemberAlreadyExists = errors.New("member already exists")
 	ErrInvalidRole         = errors.New("invalid role")
+
+	ErrInvitationNotFound      = errors.New("invitation not found")
+	ErrInvitationAlreadyExists = errors.New("a pending invitation already exists for this email")
+	ErrInvitationNotPending    = errors.New("invitation is no longer pending")
+	ErrInvitationExpired       = errors.New("invitation has expired")
+	ErrInvitationEmailMismatch = errors.New("invitation was sent to a different email address")
 )
 
 // Dependencies holds all storage interfaces required by the Service.
 type Dependencies struct {
-	SpaceStore  SpaceStore
-	MemberStore MemberStore
+	SpaceStore      SpaceStore
+	MemberStore     MemberStore
+	InvitationStore InvitationStore
 }
 
 // Service handles space business logic.
@@ -309,3 +317,193 @@ func (s *Service) IsSpaceMember(ctx context.Context, spaceID SpaceID, userID Spa
 	}
 	return true, nil
 }
+
+// CreateInvitation invites an email address to the workspace. It returns the stored invitation
+// together with the raw invite token, which is not persisted and must be delivered to the invitee.
+// inviteeID is the user already registered with the invited email, or empty if there is none.
+// A zero ttl uses DefaultInvitationTTL.
+func (s *Service) CreateInvitation(ctx context.Context, session Session, invitation *Invitation, inviteeID SpaceID, ttl time.Duration) (*Invitation, string, error) {
+	if err := invitation.Validate(); err != nil {
+		return nil, "", err
+	}
+	if ttl == 0 {
+		ttl = DefaultInvitationTTL
+	}
+	if ttl < 0 || ttl > MaxInvitationTTL {
+		return nil, "", fmt.Errorf("invitation expiry must be between 0 and %s", MaxInvitationTTL)
+	}
+
+	// Check requestor has permission
+	reqMember, err := s.deps.MemberStore.GetByID(ctx, session.SpaceID, session.UserID)
+	if err != nil {
+		return nil, "", ErrInsufficientRole
+	}
+	if !reqMember.CanManageMembers() {
+		return nil, "", ErrInsufficientRole
+	}
+
+	// Check space exists
+	if _, err := s.deps.SpaceStore.GetByID(ctx, session.SpaceID); err != nil {
+		return nil, "", ErrSpaceNotFound
+	}
+
+	// Reject invitations for users who already belong to the workspace
+	if inviteeID != "" {
+		isMember, err := s.deps.MemberStore.Exists(ctx, session.SpaceID, inviteeID)
+		if err != nil {
+			return nil, "", err
+		}
+		if isMember {
+			return nil, "", ErrMemberAlreadyExists
+		}
+	}
+
+	exists, err := s.deps.InvitationStore.ExistsPending(ctx, session.SpaceID, invitation.Email)
+	if err != nil {
+		return nil, "", err
+	}
+	if exists {
+		return nil, "", ErrInvitationAlreadyExists
+	}
+
+	invitationID, err := NewInvitationID()
+	if err != nil {
+		return nil, "", err
+	}
+	rawToken, tokenHash, err := GenerateInvitationToken()
+	if err != nil {
+		return nil, "", err
+	}
+
+	now := time.Now()
+	invitation.ID = invitationID
+	invitation.SpaceID = session.SpaceID
+	invitation.InviterID = session.UserID
+	invitation.TokenHash = tokenHash
+	invitation.Status = InvitationStatusPending
+	invitation.ExpireTime = now.Add(ttl)
+	invitation.AcceptedBy = nil
+	invitation.CreateTime = now
+	invitation.UpdateTime = now
+
+	if err := s.deps.InvitationStore.Create(ctx, invitation); err != nil {
+		return nil, "", err
+	}
+
+	return invitation, rawToken, nil
+}
+
+// ListInvitations lists the invitations of a workspace. Requestor must be able to manage members.
+func (s *Service) ListInvitations(ctx context.Context, session Session, filter *ListInvitationsFilter) ([]*Invitation, string, error) {
+	reqMember, err := s.deps.MemberStore.GetByID(ctx, session.SpaceID, session.UserID)
+	if err != nil {
+		return nil, "", ErrInsufficientRole
+	}
+	if !reqMember.CanManageMembers() {
+		return nil, "", ErrInsufficientRole
+	}
+
+	return s.deps.InvitationStore.ListBySpace(ctx, session.SpaceID, filter)
+}
+
+// RevokeInvitation withdraws a pending invitation so its token can no longer be used.
+func (s *Service) RevokeInvitation(ctx context.Context, session Session, id InvitationID) (*Invitation, error) {
+	reqMember, err := s.deps.MemberStore.GetByID(ctx, session.SpaceID, session.UserID)
+	if err != nil {
+		return nil, ErrInsufficientRole
+	}
+	if !reqMember.CanManageMembers() {
+		return nil, ErrInsufficientRole
+	}
+
+	invitation, err := s.deps.InvitationStore.GetByID(ctx, session.SpaceID, id)
+	if err != nil {
+		return nil, ErrInvitationNotFound
+	}
+	if invitation.Status != InvitationStatusPending {
+		return nil, ErrInvitationNotPending
+	}
+
+	invitation.Status = InvitationStatusRevoked
+	invitation.UpdateTime = time.Now()
+	if err := s.deps.InvitationStore.Update(ctx, invitation); err != nil {
+		return nil, err
+	}
+
+	return invitation, nil
+}
+
+// GetPendingInvitation resolves a raw invite token to an invitation that can still be answered.
+func (s *Service) GetPendingInvitation(ctx context.Context, rawToken string) (*Invitation, error) {
+	if rawToken == "" {
+		return nil, ErrInvitationNotFound
+	}
+	invitation, err := s.deps.InvitationStore.GetByTokenHash(ctx, HashInvitationToken(rawToken))
+	if err != nil {
+		return nil, ErrInvitationNotFound
+	}
+	if invitation.Status != InvitationStatusPending {
+		return nil, ErrInvitationNotPending
+	}
+	if invitation.IsExpired(time.Now()) {
+		return nil, ErrInvitationExpired
+	}
+	return invitation, nil
+}
+
+// AcceptInvitation joins the user to the invitation's workspace with the invited role.
+// The user's email must match the address the invitation was sent to.
+func (s *Service) AcceptInvitation(ctx context.Context, userID SpaceID, email string, rawToken string) (*Member, error) {
+	invitation, err := s.GetPendingInvitation(ctx, rawToken)
+	if err != nil {
+		return nil, err
+	}
+	if !invitation.IsFor(email) {
+		return nil, ErrInvitationEmailMismatch
+	}
+
+	exists, err := s.deps.MemberStore.Exists(ctx, invitation.SpaceID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, ErrMemberAlreadyExists
+	}
+
+	now := time.Now()
+	member := &Member{
+		SpaceID:    invitation.SpaceID,
+		UserID:     userID,
+		Role:       invitation.Role,
+		CreateTime: now,
+		UpdateTime: now,
+	}
+
+	invitation.Status = InvitationStatusAccepted
+	invitation.AcceptedBy = &userID
+	invitation.UpdateTime = now
+	if err := s.deps.InvitationStore.Accept(ctx, invitation, member); err != nil {
+		return nil, err
+	}
+
+	return member, nil
+}
+
+// DeclineInvitation rejects an invitation addressed to the given email.
+func (s *Service) DeclineInvitation(ctx context.Context, email string, rawToken string) (*Invitation, error) {
+	invitation, err := s.GetPendingInvitation(ctx, rawToken)
+	if err != nil {
+		return nil, err
+	}
+	if !invitation.IsFor(email) {
+		return nil, ErrInvitationEmailMismatch
+	}
+
+	invitation.Status = InvitationStatusDeclined
+	invitation.UpdateTime = time.Now()
+	if err := s.deps.InvitationStore.Update(ctx, invitation); err != nil {
+		return nil, err
+	}
+
+	return invitation, nil
+}
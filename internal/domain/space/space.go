@@ -65,6 +65,26 @@ func (r SpaceRole) IsValid() bool {
 	return false
 }
 
+// rank orders roles by privilege; unknown roles rank below viewer.
+func (r SpaceRole) rank() int {
+	switch r {
+	case RoleOwner:
+		return 4
+	case RoleAdmin:
+		return 3
+	case RoleMember:
+		return 2
+	case RoleViewer:
+		return 1
+	}
+	return 0
+}
+
+// AtLeast reports whether the role grants at least the privileges of min.
+func (r SpaceRole) AtLeast(min SpaceRole) bool {
+	return r.IsValid() && r.rank() >= min.rank()
+}
+
 // Session represents the active user session context in a workspace.
 type Session struct {
 	SpaceID SpaceID
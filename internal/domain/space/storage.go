@@ -49,6 +49,30 @@ type MemberStore interface {
 	Exists(ctx context.Context, spaceID SpaceID, userID SpaceID) (bool, error)
 }
 
+// InvitationStore defines the interface for invitation persistence operations.
+type InvitationStore interface {
+	// Create inserts a new invitation.
+	Create(ctx context.Context, invitation *Invitation) error
+
+	// GetByID retrieves an invitation of a space by its unique ID.
+	GetByID(ctx context.Context, spaceID SpaceID, id InvitationID) (*Invitation, error)
+
+	// GetByTokenHash retrieves an invitation by the hash of its invite token.
+	GetByTokenHash(ctx context.Context, tokenHash string) (*Invitation, error)
+
+	// Update modifies the status of an existing invitation.
+	Update(ctx context.Context, invitation *Invitation) error
+
+	// Accept marks the invitation accepted and creates the membership in a single transaction.
+	Accept(ctx context.Context, invitation *Invitation, member *Member) error
+
+	// ListBySpace returns the invitations of a space, newest first.
+	ListBySpace(ctx context.Context, spaceID SpaceID, filter *ListInvitationsFilter) ([]*Invitation, string, error)
+
+	// ExistsPending checks if an unexpired pending invitation exists for the email in the space.
+	ExistsPending(ctx context.Context, spaceID SpaceID, email string) (bool, error)
+}
+
 // ListSpacesFilter encapsulates filtering parameters for listing spaces.
 type ListSpacesFilter struct {
 	PageSize      int32
@@ -60,3 +84,10 @@ type ListMembersFilter struct {
 	PageSize      int32
 	NextPageToken string
 }
+
+// ListInvitationsFilter encapsulates filtering parameters for listing invitations.
+type ListInvitationsFilter struct {
+	PageSize      int32
+	NextPageToken string
+	PendingOnly   bool
+}
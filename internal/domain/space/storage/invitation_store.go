@@ -0,0 +1,230 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/masterkeysrd/saturn/internal/domain/space"
+)
+
+// invitationDB is the internal DB record type for space.invitation.
+type invitationDB struct {
+	ID         string       `db:"id"`
+	SpaceID    string       `db:"space_id"`
+	Email      string       `db:"email"`
+	Role       string       `db:"role"`
+	InviterID  string       `db:"inviter_id"`
+	TokenHash  string       `db:"token_hash"`
+	Status     string       `db:"status"`
+	ExpireTime sql.NullTime `db:"expire_time"`
+	AcceptedBy *string      `db:"accepted_by"`
+	CreateTime sql.NullTime `db:"create_time"`
+	UpdateTime sql.NullTime `db:"update_time"`
+}
+
+// InvitationStore implements space.InvitationStore using sqlx.
+type InvitationStore struct {
+	db *sqlx.DB
+}
+
+// NewInvitationStore creates a new InvitationStore.
+func NewInvitationStore(db *sqlx.DB) *InvitationStore {
+	return &InvitationStore{db: db}
+}
+
+// toDomainInvitation converts an invitationDB to a domain Invitation.
+func toDomainInvitation(i *invitationDB) *space.Invitation {
+	var acceptedBy *space.SpaceID
+	if i.AcceptedBy != nil {
+		id := space.SpaceID(*i.AcceptedBy)
+		acceptedBy = &id
+	}
+	return &space.Invitation{
+		ID:         space.InvitationID(i.ID),
+		SpaceID:    space.SpaceID(i.SpaceID),
+		Email:      i.Email,
+		Role:       space.SpaceRole(i.Role),
+		InviterID:  space.SpaceID(i.InviterID),
+		TokenHash:  i.TokenHash,
+		Status:     space.InvitationStatus(i.Status),
+		ExpireTime: nullTimeToTime(i.ExpireTime),
+		AcceptedBy: acceptedBy,
+		CreateTime: nullTimeToTime(i.CreateTime),
+		UpdateTime: nullTimeToTime(i.UpdateTime),
+	}
+}
+
+// Create inserts a new invitation.
+func (s *InvitationStore) Create(ctx context.Context, inv *space.Invitation) error {
+	query := `INSERT INTO space.invitation (id, space_id, email, role, inviter_id, token_hash, status, expire_time, create_time, update_time)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NOW(), NOW())`
+	_, err := s.db.ExecContext(ctx, query, inv.ID, inv.SpaceID, inv.Email, inv.Role, inv.InviterID, inv.TokenHash, inv.Status, inv.ExpireTime)
+	return err
+}
+
+// GetByID retrieves an invitation of a space by its unique ID.
+func (s *InvitationStore) GetByID(ctx context.Context, spaceID space.SpaceID, id space.InvitationID) (*space.Invitation, error) {
+	query := `SELECT * FROM space.invitation WHERE space_id = $1 AND id = $2`
+	var db invitationDB
+	if err := s.db.GetContext(ctx, &db, query, spaceID, id); err != nil {
+		return nil, err
+	}
+	return toDomainInvitation(&db), nil
+}
+
+// GetByTokenHash retrieves an invitation by the hash of its invite token.
+func (s *InvitationStore) GetByTokenHash(ctx context.Context, tokenHash string) (*space.Invitation, error) {
+	query := `SELECT * FROM space.invitation WHERE token_hash = $1`
+	var db invitationDB
+	if err := s.db.GetContext(ctx, &db, query, tokenHash); err != nil {
+		return nil, err
+	}
+	return toDomainInvitation(&db), nil
+}
+
+// Update modifies the status of an existing invitation. Only pending invitations can change.
+func (s *InvitationStore) Update(ctx context.Context, inv *space.Invitation) error {
+	return updateInvitationStatus(ctx, s.db, inv)
+}
+
+// Accept marks the invitation accepted and creates the membership in a single transaction.
+func (s *InvitationStore) Accept(ctx context.Context, inv *space.Invitation, member *space.Member) error {
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if err := updateInvitationStatus(ctx, tx, inv); err != nil {
+		return err
+	}
+
+	query := `INSERT INTO space.member (space_id, user_id, role, create_time, update_time)
+		VALUES ($1, $2, $3, NOW(), NOW())`
+	if _, err := tx.ExecContext(ctx, query, member.SpaceID, member.UserID, member.Role); err != nil {
+		return fmt.Errorf("insert member: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+	return nil
+}
+
+// updateInvitationStatus moves a pending invitation to its new status, guarding against
+// concurrent answers by requiring the stored row to still be pending.
+func updateInvitationStatus(ctx context.Context, exec sqlx.ExecerContext, inv *space.Invitation) error {
+	var acceptedBy *string
+	if inv.AcceptedBy != nil {
+		id := string(*inv.AcceptedBy)
+		acceptedBy = &id
+	}
+
+	query := `UPDATE space.invitation SET status = $3, accepted_by = $4, update_time = NOW()
+		WHERE space_id = $1 AND id = $2 AND status = 'pending'`
+	result, err := exec.ExecContext(ctx, query, inv.SpaceID, inv.ID, inv.Status, acceptedBy)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return space.ErrInvitationNotPending
+	}
+	return nil
+}
+
+// ListBySpace returns the invitations of a space, newest first.
+func (s *InvitationStore) ListBySpace(ctx context.Context, spaceID space.SpaceID, filter *space.ListInvitationsFilter) ([]*space.Invitation, string, error) {
+	if filter.PageSize <= 0 || filter.PageSize > 100 {
+		filter.PageSize = 20
+	}
+
+	query := `SELECT * FROM space.invitation WHERE space_id = $1`
+	args := []any{string(spaceID)}
+	argIndex := 2
+
+	if filter.PendingOnly {
+		query += fmt.Sprintf(` AND status = 'pending' AND expire_time > $%d`, argIndex)
+		args = append(args, time.Now())
+		argIndex++
+	}
+
+	if filter.NextPageToken != "" {
+		cursor, err := decodeInvitationCursor(filter.NextPageToken)
+		if err != nil {
+			return nil, "", err
+		}
+		query += fmt.Sprintf(` AND (create_time, id) < ($%d, $%d)`, argIndex, argIndex+1)
+		args = append(args, cursor.CreateTime, cursor.ID)
+		argIndex += 2
+	}
+
+	query += fmt.Sprintf(` ORDER BY create_time DESC, id DESC LIMIT $%d`, argIndex)
+	args = append(args, filter.PageSize+1)
+
+	var dbInvitations []invitationDB
+	if err := s.db.SelectContext(ctx, &dbInvitations, query, args...); err != nil {
+		return nil, "", err
+	}
+
+	hasMore := len(dbInvitations) > int(filter.PageSize)
+	if hasMore {
+		dbInvitations = dbInvitations[:filter.PageSize]
+	}
+
+	invitations := make([]*space.Invitation, 0, len(dbInvitations))
+	for i := range dbInvitations {
+		invitations = append(invitations, toDomainInvitation(&dbInvitations[i]))
+	}
+
+	var nextToken string
+	if hasMore && len(dbInvitations) > 0 {
+		last := dbInvitations[len(dbInvitations)-1]
+		tokenBytes, err := json.Marshal(invitationCursor{CreateTime: last.CreateTime.Time, ID: last.ID})
+		if err == nil {
+			nextToken = base64.URLEncoding.EncodeToString(tokenBytes)
+		}
+	}
+
+	return invitations, nextToken, nil
+}
+
+// ExistsPending checks if an unexpired pending invitation exists for the email in the space.
+func (s *InvitationStore) ExistsPending(ctx context.Context, spaceID space.SpaceID, email string) (bool, error) {
+	query := `SELECT 1 FROM space.invitation
+		WHERE space_id = $1 AND email = $2 AND status = 'pending' AND expire_time > NOW() LIMIT 1`
+	var exists int
+	err := s.db.GetContext(ctx, &exists, query, spaceID, email)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// invitationCursor is the keyset position encoded in invitation page tokens.
+type invitationCursor struct {
+	CreateTime time.Time `json:"create_time"`
+	ID         string    `json:"id"`
+}
+
+func decodeInvitationCursor(token string) (*invitationCursor, error) {
+	raw, err := base64.URLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("invalid page token: %w", err)
+	}
+	var cursor invitationCursor
+	if err := json.Unmarshal(raw, &cursor); err != nil {
+		return nil, fmt.Errorf("invalid page token: %w", err)
+	}
+	return &cursor, nil
+}
@@ -0,0 +1,174 @@
+// Package filter parses AIP-160 style filter expressions (e.g. `amount > 5000 AND type = "EXPENSE"`)
+// into a typed expression tree that storage layers compile into their own query builders.
+package filter
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Kind describes the value type a filterable field accepts.
+type Kind int
+
+const (
+	String Kind = iota
+	Int
+	Date
+	Bool
+)
+
+func (k Kind) String() string {
+	switch k {
+	case Int:
+		return "integer"
+	case Date:
+		return "date"
+	case Bool:
+		return "boolean"
+	default:
+		return "string"
+	}
+}
+
+// Schema maps the field names accepted in an expression to their value kinds.
+type Schema map[string]Kind
+
+// Operator is a comparison operator within a restriction.
+type Operator string
+
+const (
+	OpEq  Operator = "="
+	OpNeq Operator = "!="
+	OpLt  Operator = "<"
+	OpLte Operator = "<="
+	OpGt  Operator = ">"
+	OpGte Operator = ">="
+	OpHas Operator = ":"  // Substring match for strings, membership for repeated fields
+	OpIn  Operator = "IN" // Matches any of a parenthesized value list
+)
+
+// allows reports whether the operator can be applied to a field of kind k.
+func (op Operator) allows(k Kind) bool {
+	switch op {
+	case OpEq, OpNeq:
+		return true
+	case OpLt, OpLte, OpGt, OpGte:
+		return k == Int || k == Date
+	case OpHas:
+		return k == String
+	case OpIn:
+		return k == String || k == Int
+	default:
+		return false
+	}
+}
+
+// Expr is a node of a parsed filter expression.
+type Expr interface {
+	expr()
+}
+
+// And matches when every child matches.
+type And struct {
+	Exprs []Expr
+}
+
+// Or matches when any child matches.
+type Or struct {
+	Exprs []Expr
+}
+
+// Not negates its child.
+type Not struct {
+	Expr Expr
+}
+
+// Restriction compares a field against one value, or several for OpIn.
+type Restriction struct {
+	Field  string
+	Op     Operator
+	Values []Value
+}
+
+func (And) expr()         {}
+func (Or) expr()          {}
+func (Not) expr()         {}
+func (Restriction) expr() {}
+
+// Value is a literal converted to the kind of the field it is compared with.
+type Value struct {
+	Kind Kind
+	Str  string
+	Int  int64
+	Time time.Time
+	Bool bool
+}
+
+// Any returns the value as a plain Go value suitable for query arguments.
+func (v Value) Any() any {
+	switch v.Kind {
+	case Int:
+		return v.Int
+	case Date:
+		return v.Time
+	case Bool:
+		return v.Bool
+	default:
+		return v.Str
+	}
+}
+
+// Value returns the single value of a non-IN restriction.
+func (r Restriction) Value() Value {
+	if len(r.Values) == 0 {
+		return Value{}
+	}
+	return r.Values[0]
+}
+
+// parseValue converts a literal into the given kind. Dates accept YYYY-MM-DD or RFC 3339.
+func parseValue(field string, kind Kind, raw string) (Value, error) {
+	switch kind {
+	case Int:
+		n, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return Value{}, fmt.Errorf("field %q expects an integer, got %q", field, raw)
+		}
+		return Value{Kind: Int, Int: n}, nil
+	case Date:
+		if t, err := time.Parse("2006-01-02", raw); err == nil {
+			return Value{Kind: Date, Time: t}, nil
+		}
+		t, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			return Value{}, fmt.Errorf("field %q expects a date (YYYY-MM-DD or RFC 3339), got %q", field, raw)
+		}
+		return Value{Kind: Date, Time: t.UTC()}, nil
+	case Bool:
+		switch strings.ToLower(raw) {
+		case "true":
+			return Value{Kind: Bool, Bool: true}, nil
+		case "false":
+			return Value{Kind: Bool, Bool: false}, nil
+		}
+		return Value{}, fmt.Errorf("field %q expects true or false, got %q", field, raw)
+	default:
+		return Value{Kind: String, Str: raw}, nil
+	}
+}
+
+// Conjoin combines expressions with AND, skipping nil entries. It returns nil when nothing remains.
+func Conjoin(exprs ...Expr) Expr {
+	var present []Expr
+	for _, e := range exprs {
+		if e != nil {
+			present = append(present, e)
+		}
+	}
+	if len(present) == 0 {
+		return nil
+	}
+	return joinAnd(present)
+}
@@ -0,0 +1,148 @@
+package filter
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+var testSchema = Schema{
+	"amount":         Int,
+	"effective_date": Date,
+	"budget_id":      String,
+	"description":    String,
+	"type":           String,
+	"cleared":        Bool,
+}
+
+func str(s string) Value { return Value{Kind: String, Str: s} }
+func num(n int64) Value  { return Value{Kind: Int, Int: n} }
+
+func TestParse(t *testing.T) {
+	jan1 := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		input string
+		want  Expr
+	}{
+		{name: "empty", input: "  ", want: nil},
+		{
+			name:  "single restriction",
+			input: "amount > 5000",
+			want:  Restriction{Field: "amount", Op: OpGt, Values: []Value{num(5000)}},
+		},
+		{
+			name:  "negative number",
+			input: "amount >= -250",
+			want:  Restriction{Field: "amount", Op: OpGte, Values: []Value{num(-250)}},
+		},
+		{
+			name:  "quoted date",
+			input: `effective_date >= "2026-01-01"`,
+			want:  Restriction{Field: "effective_date", Op: OpGte, Values: []Value{{Kind: Date, Time: jan1}}},
+		},
+		{
+			name:  "explicit and implicit conjunction flatten",
+			input: `amount > 1 AND type = EXPENSE cleared = true`,
+			want: And{Exprs: []Expr{
+				Restriction{Field: "amount", Op: OpGt, Values: []Value{num(1)}},
+				Restriction{Field: "type", Op: OpEq, Values: []Value{str("EXPENSE")}},
+				Restriction{Field: "cleared", Op: OpEq, Values: []Value{{Kind: Bool, Bool: true}}},
+			}},
+		},
+		{
+			name:  "OR binds tighter than AND",
+			input: `amount > 1 AND type = EXPENSE OR type = INCOME`,
+			want: And{Exprs: []Expr{
+				Restriction{Field: "amount", Op: OpGt, Values: []Value{num(1)}},
+				Or{Exprs: []Expr{
+					Restriction{Field: "type", Op: OpEq, Values: []Value{str("EXPENSE")}},
+					Restriction{Field: "type", Op: OpEq, Values: []Value{str("INCOME")}},
+				}},
+			}},
+		},
+		{
+			name:  "IN list",
+			input: `budget_id IN ("bgt_1", bgt_2)`,
+			want:  Restriction{Field: "budget_id", Op: OpIn, Values: []Value{str("bgt_1"), str("bgt_2")}},
+		},
+		{
+			name:  "negation and grouping",
+			input: `NOT (amount < 10 OR amount > 20) -description:"coffee shop"`,
+			want: And{Exprs: []Expr{
+				Not{Expr: Or{Exprs: []Expr{
+					Restriction{Field: "amount", Op: OpLt, Values: []Value{num(10)}},
+					Restriction{Field: "amount", Op: OpGt, Values: []Value{num(20)}},
+				}}},
+				Not{Expr: Restriction{Field: "description", Op: OpHas, Values: []Value{str("coffee shop")}}},
+			}},
+		},
+		{
+			name:  "escaped quote",
+			input: `description = "say \"hi\""`,
+			want:  Restriction{Field: "description", Op: OpEq, Values: []Value{str(`say "hi"`)}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.input, testSchema)
+			if err != nil {
+				t.Fatalf("Parse(%q) error: %v", tt.input, err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Parse(%q) = %#v, want %#v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParse_Errors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{name: "unknown field", input: "vendor = acme"},
+		{name: "missing comparator", input: "amount"},
+		{name: "missing value", input: "amount >"},
+		{name: "bad integer", input: "amount > lots"},
+		{name: "bad date", input: `effective_date > "yesterday"`},
+		{name: "ordering on string", input: "description < a"},
+		{name: "has on integer", input: "amount : 5"},
+		{name: "unclosed group", input: "(amount > 5"},
+		{name: "unterminated string", input: `description = "abc`},
+		{name: "dangling AND", input: "amount > 5 AND"},
+		{name: "unclosed IN", input: "budget_id IN (a, b"},
+		{name: "stray bang", input: "amount ! 5"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(tt.input, testSchema)
+			if err == nil {
+				t.Fatalf("Parse(%q) expected error", tt.input)
+			}
+			if !errors.Is(err, ErrInvalidFilter) {
+				t.Errorf("Parse(%q) error %v does not wrap ErrInvalidFilter", tt.input, err)
+			}
+		})
+	}
+}
+
+func TestConjoin(t *testing.T) {
+	a := Restriction{Field: "amount", Op: OpGt, Values: []Value{num(1)}}
+	b := Restriction{Field: "amount", Op: OpLt, Values: []Value{num(9)}}
+
+	if got := Conjoin(nil, nil); got != nil {
+		t.Errorf("Conjoin(nil, nil) = %#v, want nil", got)
+	}
+	if got := Conjoin(nil, a); !reflect.DeepEqual(got, a) {
+		t.Errorf("Conjoin(nil, a) = %#v, want a", got)
+	}
+	want := And{Exprs: []Expr{a, b}}
+	if got := Conjoin(a, b); !reflect.DeepEqual(got, want) {
+		t.Errorf("Conjoin(a, b) = %#v, want %#v", got, want)
+	}
+}
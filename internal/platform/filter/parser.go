@@ -0,0 +1,339 @@
+package filter
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// MaxLength bounds the size of an accepted filter expression.
+const MaxLength = 2048
+
+// ErrInvalidFilter is wrapped by every parse and validation error.
+var ErrInvalidFilter = errors.New("invalid filter")
+
+// Parse parses an AIP-160 expression and validates it against the schema. An empty input yields a nil Expr.
+//
+// Supported grammar: restrictions (`field op value`), implicit and explicit AND, OR, NOT or a leading `-`,
+// parentheses, and `field IN (v1, v2, ...)`. As in AIP-160, OR binds tighter than AND, so
+// `a AND b OR c` means `a AND (b OR c)`.
+func Parse(input string, schema Schema) (Expr, error) {
+	if strings.TrimSpace(input) == "" {
+		return nil, nil
+	}
+	if len(input) > MaxLength {
+		return nil, fmt.Errorf("%w: expression exceeds %d characters", ErrInvalidFilter, MaxLength)
+	}
+
+	tokens, err := lex(input)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidFilter, err)
+	}
+
+	p := &parser{tokens: tokens, schema: schema}
+	e, err := p.expression()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidFilter, err)
+	}
+	if tok := p.peek(); tok.kind != tokEOF {
+		return nil, fmt.Errorf("%w: unexpected %s at position %d", ErrInvalidFilter, tok, tok.pos)
+	}
+	return e, nil
+}
+
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokText
+	tokString
+	tokOp
+	tokLParen
+	tokRParen
+	tokComma
+	tokMinus
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+func (t token) String() string {
+	switch t.kind {
+	case tokEOF:
+		return "end of input"
+	case tokString:
+		return fmt.Sprintf("%q", t.text)
+	default:
+		return fmt.Sprintf("'%s'", t.text)
+	}
+}
+
+// keyword reports whether the token is the given reserved word. Keywords are case-sensitive per AIP-160.
+func (t token) keyword(word string) bool {
+	return t.kind == tokText && t.text == word
+}
+
+func isDelimiter(r rune) bool {
+	return unicode.IsSpace(r) || strings.ContainsRune(`()=!<>:,"`, r)
+}
+
+func lex(input string) ([]token, error) {
+	var tokens []token
+	runes := []rune(input)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(':
+			tokens = append(tokens, token{kind: tokLParen, text: "(", pos: i})
+			i++
+		case r == ')':
+			tokens = append(tokens, token{kind: tokRParen, text: ")", pos: i})
+			i++
+		case r == ',':
+			tokens = append(tokens, token{kind: tokComma, text: ",", pos: i})
+			i++
+		case r == ':' || r == '=':
+			tokens = append(tokens, token{kind: tokOp, text: string(r), pos: i})
+			i++
+		case r == '!' || r == '<' || r == '>':
+			if i+1 < len(runes) && runes[i+1] == '=' {
+				tokens = append(tokens, token{kind: tokOp, text: string(r) + "=", pos: i})
+				i += 2
+				continue
+			}
+			if r == '!' {
+				return nil, fmt.Errorf("unexpected '!' at position %d", i)
+			}
+			tokens = append(tokens, token{kind: tokOp, text: string(r), pos: i})
+			i++
+		case r == '"':
+			var sb strings.Builder
+			start := i
+			i++
+			closed := false
+			for i < len(runes) {
+				c := runes[i]
+				if c == '\\' && i+1 < len(runes) {
+					sb.WriteRune(runes[i+1])
+					i += 2
+					continue
+				}
+				if c == '"' {
+					closed = true
+					i++
+					break
+				}
+				sb.WriteRune(c)
+				i++
+			}
+			if !closed {
+				return nil, fmt.Errorf("unterminated string starting at position %d", start)
+			}
+			tokens = append(tokens, token{kind: tokString, text: sb.String(), pos: start})
+		case r == '-' && (i+1 >= len(runes) || !unicode.IsDigit(runes[i+1])):
+			tokens = append(tokens, token{kind: tokMinus, text: "-", pos: i})
+			i++
+		default:
+			start := i
+			for i < len(runes) && !isDelimiter(runes[i]) {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokText, text: string(runes[start:i]), pos: start})
+		}
+	}
+	return append(tokens, token{kind: tokEOF, pos: len(runes)}), nil
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+	schema Schema
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	tok := p.tokens[p.pos]
+	if tok.kind != tokEOF {
+		p.pos++
+	}
+	return tok
+}
+
+// expression := sequence { "AND" sequence }
+func (p *parser) expression() (Expr, error) {
+	var exprs []Expr
+	for {
+		e, err := p.sequence()
+		if err != nil {
+			return nil, err
+		}
+		exprs = append(exprs, e)
+		if !p.peek().keyword("AND") {
+			break
+		}
+		p.next()
+	}
+	return joinAnd(exprs), nil
+}
+
+// sequence := factor { factor }, an implicit conjunction.
+func (p *parser) sequence() (Expr, error) {
+	var exprs []Expr
+	for {
+		e, err := p.factor()
+		if err != nil {
+			return nil, err
+		}
+		exprs = append(exprs, e)
+		tok := p.peek()
+		if tok.kind == tokEOF || tok.kind == tokRParen || tok.keyword("AND") {
+			break
+		}
+	}
+	return joinAnd(exprs), nil
+}
+
+// factor := term { "OR" term }
+func (p *parser) factor() (Expr, error) {
+	var exprs []Expr
+	for {
+		e, err := p.term()
+		if err != nil {
+			return nil, err
+		}
+		exprs = append(exprs, e)
+		if !p.peek().keyword("OR") {
+			break
+		}
+		p.next()
+	}
+	if len(exprs) == 1 {
+		return exprs[0], nil
+	}
+	return Or{Exprs: exprs}, nil
+}
+
+// term := [ "NOT" | "-" ] simple
+func (p *parser) term() (Expr, error) {
+	if tok := p.peek(); tok.keyword("NOT") || tok.kind == tokMinus {
+		p.next()
+		e, err := p.simple()
+		if err != nil {
+			return nil, err
+		}
+		return Not{Expr: e}, nil
+	}
+	return p.simple()
+}
+
+// simple := "(" expression ")" | restriction
+func (p *parser) simple() (Expr, error) {
+	if p.peek().kind == tokLParen {
+		p.next()
+		e, err := p.expression()
+		if err != nil {
+			return nil, err
+		}
+		if tok := p.next(); tok.kind != tokRParen {
+			return nil, fmt.Errorf("expected ')' at position %d, got %s", tok.pos, tok)
+		}
+		return e, nil
+	}
+	return p.restriction()
+}
+
+// restriction := field comparator arg | field "IN" "(" arg { "," arg } ")"
+func (p *parser) restriction() (Expr, error) {
+	fieldTok := p.next()
+	if fieldTok.kind != tokText || isReserved(fieldTok.text) {
+		return nil, fmt.Errorf("expected field name at position %d, got %s", fieldTok.pos, fieldTok)
+	}
+	field := fieldTok.text
+	kind, ok := p.schema[field]
+	if !ok {
+		return nil, fmt.Errorf("unknown field %q", field)
+	}
+
+	opTok := p.next()
+	var op Operator
+	switch {
+	case opTok.kind == tokOp:
+		op = Operator(opTok.text)
+	case opTok.keyword("IN"):
+		op = OpIn
+	default:
+		return nil, fmt.Errorf("expected comparator after %q at position %d, got %s", field, opTok.pos, opTok)
+	}
+	if !op.allows(kind) {
+		return nil, fmt.Errorf("operator %s is not supported for %s field %q", op, kind, field)
+	}
+
+	if op != OpIn {
+		v, err := p.arg(field, kind)
+		if err != nil {
+			return nil, err
+		}
+		return Restriction{Field: field, Op: op, Values: []Value{v}}, nil
+	}
+
+	if tok := p.next(); tok.kind != tokLParen {
+		return nil, fmt.Errorf("expected '(' after IN at position %d, got %s", tok.pos, tok)
+	}
+	var values []Value
+	for {
+		v, err := p.arg(field, kind)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, v)
+		tok := p.next()
+		if tok.kind == tokRParen {
+			break
+		}
+		if tok.kind != tokComma {
+			return nil, fmt.Errorf("expected ',' or ')' at position %d, got %s", tok.pos, tok)
+		}
+	}
+	return Restriction{Field: field, Op: OpIn, Values: values}, nil
+}
+
+func (p *parser) arg(field string, kind Kind) (Value, error) {
+	tok := p.next()
+	if tok.kind != tokText && tok.kind != tokString {
+		return Value{}, fmt.Errorf("expected value for %q at position %d, got %s", field, tok.pos, tok)
+	}
+	return parseValue(field, kind, tok.text)
+}
+
+func isReserved(word string) bool {
+	switch word {
+	case "AND", "OR", "NOT", "IN":
+		return true
+	}
+	return false
+}
+
+// joinAnd flattens a conjunction, collapsing single-element lists and nested Ands.
+func joinAnd(exprs []Expr) Expr {
+	if len(exprs) == 1 {
+		return exprs[0]
+	}
+	var flat []Expr
+	for _, e := range exprs {
+		if a, ok := e.(And); ok {
+			flat = append(flat, a.Exprs...)
+			continue
+		}
+		flat = append(flat, e)
+	}
+	return And{Exprs: flat}
+}
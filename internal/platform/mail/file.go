@@ -0,0 +1,44 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/masterkeysrd/saturn/internal/platform/token"
+)
+
+// FileSender implements Sender by writing each message as an .eml file into a directory.
+type FileSender struct {
+	dir string
+	now func() time.Time
+}
+
+// NewFileSender creates a new FileSender, creating dir if needed.
+func NewFileSender(dir string) (*FileSender, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("create mail directory: %w", err)
+	}
+	return &FileSender{dir: dir, now: time.Now}, nil
+}
+
+// Send writes the message to a new file named after the send time.
+func (s *FileSender) Send(_ context.Context, msg *Message) error {
+	if err := msg.Validate(); err != nil {
+		return err
+	}
+
+	suffix, err := token.GenerateRandomHex(4)
+	if err != nil {
+		return err
+	}
+	now := s.now().UTC()
+	name := fmt.Sprintf("%s-%s.eml", now.Format("20060102T150405.000000000"), suffix)
+
+	if err := os.WriteFile(filepath.Join(s.dir, name), msg.Bytes(now), 0600); err != nil {
+		return fmt.Errorf("write mail file: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,29 @@
+package mail
+
+import (
+	"context"
+	"log/slog"
+)
+
+// LogSender implements Sender by writing messages to the structured log.
+// It is meant for development, where no mail server is available.
+type LogSender struct {
+	logger *slog.Logger
+}
+
+// NewLogSender creates a new LogSender. A nil logger uses slog.Default().
+func NewLogSender(logger *slog.Logger) *LogSender {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &LogSender{logger: logger}
+}
+
+// Send logs the message.
+func (s *LogSender) Send(ctx context.Context, msg *Message) error {
+	if err := msg.Validate(); err != nil {
+		return err
+	}
+	s.logger.InfoContext(ctx, "email sent", "from", msg.From, "to", msg.To, "subject", msg.Subject, "body", msg.Body)
+	return nil
+}
@@ -0,0 +1,52 @@
+// Package mail provides pluggable delivery of outbound email.
+package mail
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/mail"
+	"strings"
+	"time"
+)
+
+// Message is a plain-text email.
+type Message struct {
+	From    string
+	To      string
+	Subject string
+	Body    string
+}
+
+// Validate checks that the message has parseable addresses and a subject.
+func (m *Message) Validate() error {
+	if _, err := mail.ParseAddress(m.From); err != nil {
+		return fmt.Errorf("invalid sender address: %w", err)
+	}
+	if _, err := mail.ParseAddress(m.To); err != nil {
+		return fmt.Errorf("invalid recipient address: %w", err)
+	}
+	if strings.TrimSpace(m.Subject) == "" {
+		return errors.New("subject is required")
+	}
+	return nil
+}
+
+// Bytes renders the message in RFC 5322 format.
+func (m *Message) Bytes(date time.Time) []byte {
+	var b strings.Builder
+	fmt.Fprintf(&b, "From: %s\r\n", m.From)
+	fmt.Fprintf(&b, "To: %s\r\n", m.To)
+	fmt.Fprintf(&b, "Subject: %s\r\n", m.Subject)
+	fmt.Fprintf(&b, "Date: %s\r\n", date.Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(strings.ReplaceAll(m.Body, "\n", "\r\n"))
+	return []byte(b.String())
+}
+
+// Sender delivers email messages.
+type Sender interface {
+	Send(ctx context.Context, msg *Message) error
+}
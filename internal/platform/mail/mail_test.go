@@ -0,0 +1,69 @@
+package mail
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestFileSender_Send(t *testing.T) {
+	dir := t.TempDir()
+	sender, err := NewFileSender(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	msg := &Message{
+		From:    "Saturn <no-reply@saturn.local>",
+		To:      "jane@example.com",
+		Subject: "You're invited",
+		Body:    "Line one\nLine two",
+	}
+	if err := sender.Send(context.Background(), msg); err != nil {
+		t.Fatalf("Send failed: %v", err)
+	}
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.eml"))
+	if err != nil || len(files) != 1 {
+		t.Fatalf("expected one .eml file, got %v (%v)", files, err)
+	}
+	data, err := os.ReadFile(files[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"To: jane@example.com\r\n", "Subject: You're invited\r\n", "\r\n\r\nLine one\r\nLine two"} {
+		if !strings.Contains(string(data), want) {
+			t.Errorf("expected message to contain %q, got:\n%s", want, data)
+		}
+	}
+}
+
+func TestMessage_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		msg     Message
+		wantErr string
+	}{
+		{"valid", Message{From: "a@example.com", To: "b@example.com", Subject: "Hi"}, ""},
+		{"bad recipient", Message{From: "a@example.com", To: "not-an-address", Subject: "Hi"}, "recipient"},
+		{"bad sender", Message{From: "", To: "b@example.com", Subject: "Hi"}, "sender"},
+		{"no subject", Message{From: "a@example.com", To: "b@example.com"}, "subject"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
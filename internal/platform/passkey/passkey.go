@@ -0,0 +1,243 @@
+// Package passkey runs WebAuthn registration and assertion ceremonies for a relying party. It wraps
+// go-webauthn so that callers only deal with plain credential records and the JSON exchanged with
+// the browser's navigator.credentials API.
+package passkey
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+)
+
+// ErrVerificationFailed is returned when a browser response does not satisfy the ceremony, for
+// example a wrong challenge, origin or signature.
+var ErrVerificationFailed = errors.New("passkey verification failed")
+
+// Config identifies the relying party.
+type Config struct {
+	// RPID is the domain passkeys are scoped to, e.g. "saturn.example.com".
+	RPID string
+	// RPDisplayName is the name shown by the authenticator.
+	RPDisplayName string
+	// RPOrigins are the fully qualified origins allowed to run ceremonies, e.g. "https://saturn.example.com".
+	RPOrigins []string
+}
+
+// User is the account a ceremony runs for.
+type User struct {
+	// ID is the user handle stored by the authenticator. At most 64 bytes.
+	ID          []byte
+	Name        string
+	DisplayName string
+	Credentials []Credential
+}
+
+// Credential is the stored record of a registered public key credential.
+type Credential struct {
+	ID              []byte
+	PublicKey       []byte
+	AttestationType string
+	Transports      []string
+	AAGUID          []byte
+	SignCount       uint32
+	BackupEligible  bool
+	BackupState     bool
+}
+
+// RelyingParty runs ceremonies for a single relying party. Passkeys are always created as
+// discoverable credentials and every ceremony requires user verification, so a passkey alone is a
+// multi-factor credential.
+type RelyingParty struct {
+	wa *webauthn.WebAuthn
+}
+
+// New creates a RelyingParty from cfg.
+func New(cfg Config) (*RelyingParty, error) {
+	wa, err := webauthn.New(&webauthn.Config{
+		RPID:                  cfg.RPID,
+		RPDisplayName:         cfg.RPDisplayName,
+		RPOrigins:             cfg.RPOrigins,
+		AttestationPreference: protocol.PreferNoAttestation,
+		AuthenticatorSelection: protocol.AuthenticatorSelection{
+			RequireResidentKey: protocol.ResidentKeyRequired(),
+			ResidentKey:        protocol.ResidentKeyRequirementRequired,
+			UserVerification:   protocol.VerificationRequired,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("configure webauthn: %w", err)
+	}
+	return &RelyingParty{wa: wa}, nil
+}
+
+// BeginRegistration starts creating a passkey for user. Existing credentials are excluded so the
+// same authenticator is not registered twice. It returns the options to pass to
+// navigator.credentials.create and the session state to hand back to FinishRegistration.
+func (rp *RelyingParty) BeginRegistration(user User) (options, session []byte, err error) {
+	wu := webauthnUser{user}
+	creation, data, err := rp.wa.BeginRegistration(wu,
+		webauthn.WithExclusions(webauthn.Credentials(wu.WebAuthnCredentials()).CredentialDescriptors()),
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("begin registration: %w", err)
+	}
+	return marshalCeremony(creation, data)
+}
+
+// FinishRegistration verifies the browser's response to BeginRegistration and returns the new credential.
+func (rp *RelyingParty) FinishRegistration(user User, session, response []byte) (*Credential, error) {
+	var data webauthn.SessionData
+	if err := json.Unmarshal(session, &data); err != nil {
+		return nil, fmt.Errorf("decode session: %w", err)
+	}
+
+	parsed, err := protocol.ParseCredentialCreationResponseBytes(response)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrVerificationFailed, describe(err))
+	}
+
+	cred, err := rp.wa.CreateCredential(webauthnUser{user}, data, parsed)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrVerificationFailed, describe(err))
+	}
+	return fromWebAuthn(cred), nil
+}
+
+// BeginLogin starts an assertion. With a nil user the ceremony is discoverable: the authenticator
+// offers any passkey it holds for the relying party and the account is identified by its user
+// handle. It returns the options to pass to navigator.credentials.get and the session state to
+// hand back to FinishLogin.
+func (rp *RelyingParty) BeginLogin(user *User) (options, session []byte, err error) {
+	var (
+		assertion *protocol.CredentialAssertion
+		data      *webauthn.SessionData
+	)
+	if user == nil {
+		assertion, data, err = rp.wa.BeginDiscoverableLogin(webauthn.WithUserVerification(protocol.VerificationRequired))
+	} else {
+		assertion, data, err = rp.wa.BeginLogin(webauthnUser{*user}, webauthn.WithUserVerification(protocol.VerificationRequired))
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("begin login: %w", err)
+	}
+	return marshalCeremony(assertion, data)
+}
+
+// FinishLogin verifies the browser's response to BeginLogin. lookup loads the account for a user
+// handle together with its credentials. It returns the account and the used credential with its
+// updated signature counter and backup state.
+//
+// A signature counter that did not increase means the private key may have been cloned, and the
+// assertion is rejected. Synced passkeys report a counter of zero and are not affected.
+func (rp *RelyingParty) FinishLogin(session, response []byte, lookup func(userHandle []byte) (*User, error)) (*User, *Credential, error) {
+	var data webauthn.SessionData
+	if err := json.Unmarshal(session, &data); err != nil {
+		return nil, nil, fmt.Errorf("decode session: %w", err)
+	}
+
+	parsed, err := protocol.ParseCredentialRequestResponseBytes(response)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrVerificationFailed, describe(err))
+	}
+
+	var (
+		user *User
+		cred *webauthn.Credential
+	)
+	if len(data.UserID) > 0 {
+		if user, err = lookup(data.UserID); err != nil {
+			return nil, nil, err
+		}
+		cred, err = rp.wa.ValidateLogin(webauthnUser{*user}, data, parsed)
+	} else {
+		handler := func(_, userHandle []byte) (webauthn.User, error) {
+			if user, err = lookup(userHandle); err != nil {
+				return nil, err
+			}
+			return webauthnUser{*user}, nil
+		}
+		_, cred, err = rp.wa.ValidatePasskeyLogin(handler, data, parsed)
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrVerificationFailed, describe(err))
+	}
+	if cred.Authenticator.CloneWarning {
+		return nil, nil, fmt.Errorf("%w: signature counter did not increase", ErrVerificationFailed)
+	}
+	return user, fromWebAuthn(cred), nil
+}
+
+func marshalCeremony(options any, data *webauthn.SessionData) ([]byte, []byte, error) {
+	rawOptions, err := json.Marshal(options)
+	if err != nil {
+		return nil, nil, fmt.Errorf("encode options: %w", err)
+	}
+	rawSession, err := json.Marshal(data)
+	if err != nil {
+		return nil, nil, fmt.Errorf("encode session: %w", err)
+	}
+	return rawOptions, rawSession, nil
+}
+
+// describe includes the developer details go-webauthn keeps out of its error strings.
+func describe(err error) string {
+	var perr *protocol.Error
+	if errors.As(err, &perr) && perr.DevInfo != "" {
+		return perr.Details + ": " + perr.DevInfo
+	}
+	return err.Error()
+}
+
+func fromWebAuthn(c *webauthn.Credential) *Credential {
+	transports := make([]string, len(c.Transport))
+	for i, t := range c.Transport {
+		transports[i] = string(t)
+	}
+	return &Credential{
+		ID:              c.ID,
+		PublicKey:       c.PublicKey,
+		AttestationType: c.AttestationType,
+		Transports:      transports,
+		AAGUID:          c.Authenticator.AAGUID,
+		SignCount:       c.Authenticator.SignCount,
+		BackupEligible:  c.Flags.BackupEligible,
+		BackupState:     c.Flags.BackupState,
+	}
+}
+
+// webauthnUser adapts User to webauthn.User.
+type webauthnUser struct {
+	User
+}
+
+func (u webauthnUser) WebAuthnID() []byte          { return u.ID }
+func (u webauthnUser) WebAuthnName() string        { return u.Name }
+func (u webauthnUser) WebAuthnDisplayName() string { return u.DisplayName }
+
+func (u webauthnUser) WebAuthnCredentials() []webauthn.Credential {
+	creds := make([]webauthn.Credential, len(u.Credentials))
+	for i, c := range u.Credentials {
+		transports := make([]protocol.AuthenticatorTransport, len(c.Transports))
+		for j, t := range c.Transports {
+			transports[j] = protocol.AuthenticatorTransport(t)
+		}
+		creds[i] = webauthn.Credential{
+			ID:              c.ID,
+			PublicKey:       c.PublicKey,
+			AttestationType: c.AttestationType,
+			Transport:       transports,
+			Flags: webauthn.CredentialFlags{
+				BackupEligible: c.BackupEligible,
+				BackupState:    c.BackupState,
+			},
+			Authenticator: webauthn.Authenticator{
+				AAGUID:    c.AAGUID,
+				SignCount: c.SignCount,
+			},
+		}
+	}
+	return creds
+}
@@ -0,0 +1,153 @@
+package passkey_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/masterkeysrd/saturn/internal/platform/passkey"
+	"github.com/masterkeysrd/saturn/internal/platform/passkey/passkeytest"
+)
+
+const origin = "https://saturn.example.com"
+
+func newRelyingParty(t *testing.T) *passkey.RelyingParty {
+	t.Helper()
+	rp, err := passkey.New(passkey.Config{
+		RPID:          "saturn.example.com",
+		RPDisplayName: "Saturn",
+		RPOrigins:     []string{origin},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return rp
+}
+
+func register(t *testing.T, rp *passkey.RelyingParty, auth *passkeytest.Authenticator, user passkey.User) *passkey.Credential {
+	t.Helper()
+	options, session, err := rp.BeginRegistration(user)
+	if err != nil {
+		t.Fatal(err)
+	}
+	response, err := auth.Create(options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cred, err := rp.FinishRegistration(user, session, response)
+	if err != nil {
+		t.Fatalf("FinishRegistration: %v", err)
+	}
+	return cred
+}
+
+func TestRegistrationAndLogin(t *testing.T) {
+	rp := newRelyingParty(t)
+	auth := passkeytest.New(origin)
+	user := passkey.User{ID: []byte("usr_2NzN5q3y1pUQvU1ZQ9t2tq8xKxF"), Name: "jane@example.com", DisplayName: "Jane"}
+
+	cred := register(t, rp, auth, user)
+	if cred.AttestationType != "none" || len(cred.Transports) != 1 || cred.Transports[0] != "internal" {
+		t.Errorf("unexpected credential: %+v", cred)
+	}
+	user.Credentials = []passkey.Credential{*cred}
+	lookup := func(handle []byte) (*passkey.User, error) {
+		if string(handle) != string(user.ID) {
+			return nil, errors.New("unknown user")
+		}
+		return &user, nil
+	}
+
+	t.Run("known user", func(t *testing.T) {
+		options, session, err := rp.BeginLogin(&user)
+		if err != nil {
+			t.Fatal(err)
+		}
+		response, err := auth.Get(options)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, used, err := rp.FinishLogin(session, response, lookup)
+		if err != nil {
+			t.Fatalf("FinishLogin: %v", err)
+		}
+		if string(got.ID) != string(user.ID) || used.SignCount != 1 {
+			t.Errorf("unexpected result: user %q, sign count %d", got.ID, used.SignCount)
+		}
+		user.Credentials[0].SignCount = used.SignCount
+	})
+
+	t.Run("discoverable", func(t *testing.T) {
+		options, session, err := rp.BeginLogin(nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		response, err := auth.Get(options)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, used, err := rp.FinishLogin(session, response, lookup)
+		if err != nil {
+			t.Fatalf("FinishLogin: %v", err)
+		}
+		if string(got.ID) != string(user.ID) || used.SignCount != 2 {
+			t.Errorf("unexpected result: user %q, sign count %d", got.ID, used.SignCount)
+		}
+	})
+
+	t.Run("cloned authenticator", func(t *testing.T) {
+		// The stored counter is ahead of the authenticator, as if a copy of the key had been used
+		user.Credentials[0].SignCount = 10
+		options, session, _ := rp.BeginLogin(&user)
+		response, err := auth.Get(options)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, _, err := rp.FinishLogin(session, response, lookup); !errors.Is(err, passkey.ErrVerificationFailed) {
+			t.Errorf("expected ErrVerificationFailed, got %v", err)
+		}
+	})
+
+	t.Run("other session", func(t *testing.T) {
+		options, _, _ := rp.BeginLogin(nil)
+		_, session, _ := rp.BeginLogin(nil)
+		response, err := auth.Get(options)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, _, err := rp.FinishLogin(session, response, lookup); !errors.Is(err, passkey.ErrVerificationFailed) {
+			t.Errorf("expected a response to another challenge to fail, got %v", err)
+		}
+	})
+}
+
+func TestRegistrationRejectsForeignOrigin(t *testing.T) {
+	rp := newRelyingParty(t)
+	user := passkey.User{ID: []byte("usr_2NzN5q3y1pUQvU1ZQ9t2tq8xKxF"), Name: "jane@example.com", DisplayName: "Jane"}
+
+	options, session, err := rp.BeginRegistration(user)
+	if err != nil {
+		t.Fatal(err)
+	}
+	response, err := passkeytest.New("https://phishing.example.net").Create(options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := rp.FinishRegistration(user, session, response); !errors.Is(err, passkey.ErrVerificationFailed) {
+		t.Errorf("expected ErrVerificationFailed, got %v", err)
+	}
+}
+
+func TestRegistrationExcludesExistingCredentials(t *testing.T) {
+	rp := newRelyingParty(t)
+	auth := passkeytest.New(origin)
+	user := passkey.User{ID: []byte("usr_2NzN5q3y1pUQvU1ZQ9t2tq8xKxF"), Name: "jane@example.com", DisplayName: "Jane"}
+	user.Credentials = []passkey.Credential{*register(t, rp, auth, user)}
+
+	options, _, err := rp.BeginRegistration(user)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := auth.Create(options); err == nil {
+		t.Error("expected the authenticator to refuse registering a second passkey for the same account")
+	}
+}
@@ -0,0 +1,227 @@
+// Package passkeytest provides a software authenticator that answers passkey ceremonies the way a
+// browser and platform authenticator would, for tests.
+package passkeytest
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/go-webauthn/webauthn/protocol/webauthncbor"
+	"github.com/go-webauthn/webauthn/protocol/webauthncose"
+)
+
+const (
+	flagUserPresent      = 0x01
+	flagUserVerified     = 0x04
+	flagAttestedCredData = 0x40
+)
+
+var b64 = base64.RawURLEncoding
+
+// ErrNoCredential is returned by Get when the authenticator holds no matching credential.
+var ErrNoCredential = errors.New("no matching credential")
+
+// Authenticator is a user-verifying authenticator holding ES256 discoverable credentials. It
+// reports "none" attestation and increments its signature counter on every assertion.
+type Authenticator struct {
+	// Origin is reported in the client data, as a browser would for the calling page.
+	Origin string
+
+	creds []*credential
+}
+
+type credential struct {
+	id         []byte
+	rpID       string
+	userHandle []byte
+	key        *ecdsa.PrivateKey
+	signCount  uint32
+}
+
+// New returns an empty authenticator used from origin.
+func New(origin string) *Authenticator {
+	return &Authenticator{Origin: origin}
+}
+
+type creationOptions struct {
+	PublicKey struct {
+		Challenge string `json:"challenge"`
+		RP        struct {
+			ID string `json:"id"`
+		} `json:"rp"`
+		User struct {
+			ID string `json:"id"`
+		} `json:"user"`
+		ExcludeCredentials []struct {
+			ID string `json:"id"`
+		} `json:"excludeCredentials"`
+	} `json:"publicKey"`
+}
+
+type assertionOptions struct {
+	PublicKey struct {
+		Challenge        string `json:"challenge"`
+		RPID             string `json:"rpId"`
+		AllowCredentials []struct {
+			ID string `json:"id"`
+		} `json:"allowCredentials"`
+	} `json:"publicKey"`
+}
+
+// Create answers the options of a registration ceremony with a new credential, like
+// navigator.credentials.create.
+func (a *Authenticator) Create(options []byte) ([]byte, error) {
+	var opts creationOptions
+	if err := json.Unmarshal(options, &opts); err != nil {
+		return nil, fmt.Errorf("decode creation options: %w", err)
+	}
+	userHandle, err := b64.DecodeString(opts.PublicKey.User.ID)
+	if err != nil {
+		return nil, fmt.Errorf("decode user id: %w", err)
+	}
+	for _, excluded := range opts.PublicKey.ExcludeCredentials {
+		if a.find(opts.PublicKey.RP.ID, excluded.ID) != nil {
+			return nil, errors.New("credential already registered")
+		}
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	cred := &credential{id: make([]byte, 16), rpID: opts.PublicKey.RP.ID, userHandle: userHandle, key: key}
+	if _, err := rand.Read(cred.id); err != nil {
+		return nil, err
+	}
+
+	point, err := key.PublicKey.Bytes()
+	if err != nil {
+		return nil, err
+	}
+	coseKey, err := webauthncbor.Marshal(webauthncose.EC2PublicKeyData{
+		PublicKeyData: webauthncose.PublicKeyData{
+			KeyType:   int64(webauthncose.EllipticKey),
+			Algorithm: int64(webauthncose.AlgES256),
+		},
+		Curve:  1, // P-256
+		XCoord: point[1:33],
+		YCoord: point[33:],
+	})
+	if err != nil {
+		return nil, fmt.Errorf("encode public key: %w", err)
+	}
+
+	authData := cred.authenticatorData(flagUserPresent | flagUserVerified | flagAttestedCredData)
+	authData = append(authData, make([]byte, 16)...) // AAGUID
+	authData = binary.BigEndian.AppendUint16(authData, uint16(len(cred.id)))
+	authData = append(authData, cred.id...)
+	authData = append(authData, coseKey...)
+
+	attestationObject, err := webauthncbor.Marshal(map[string]any{
+		"fmt":      "none",
+		"attStmt":  map[string]any{},
+		"authData": authData,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("encode attestation object: %w", err)
+	}
+
+	a.creds = append(a.creds, cred)
+	return json.Marshal(map[string]any{
+		"id":    b64.EncodeToString(cred.id),
+		"rawId": b64.EncodeToString(cred.id),
+		"type":  "public-key",
+		"response": map[string]any{
+			"clientDataJSON":    b64.EncodeToString(a.clientData("webauthn.create", opts.PublicKey.Challenge)),
+			"attestationObject": b64.EncodeToString(attestationObject),
+			"transports":        []string{"internal"},
+		},
+	})
+}
+
+// Get answers the options of an assertion ceremony, like navigator.credentials.get. It uses the
+// first allowed credential it holds, or its first credential for the relying party when the
+// ceremony is discoverable.
+func (a *Authenticator) Get(options []byte) ([]byte, error) {
+	var opts assertionOptions
+	if err := json.Unmarshal(options, &opts); err != nil {
+		return nil, fmt.Errorf("decode assertion options: %w", err)
+	}
+
+	var cred *credential
+	if len(opts.PublicKey.AllowCredentials) == 0 {
+		for _, c := range a.creds {
+			if c.rpID == opts.PublicKey.RPID {
+				cred = c
+				break
+			}
+		}
+	}
+	for _, allowed := range opts.PublicKey.AllowCredentials {
+		if cred = a.find(opts.PublicKey.RPID, allowed.ID); cred != nil {
+			break
+		}
+	}
+	if cred == nil {
+		return nil, ErrNoCredential
+	}
+
+	cred.signCount++
+	authData := cred.authenticatorData(flagUserPresent | flagUserVerified)
+	clientData := a.clientData("webauthn.get", opts.PublicKey.Challenge)
+	clientDataHash := sha256.Sum256(clientData)
+	digest := sha256.Sum256(append(bytes.Clone(authData), clientDataHash[:]...))
+	signature, err := ecdsa.SignASN1(rand.Reader, cred.key, digest[:])
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(map[string]any{
+		"id":    b64.EncodeToString(cred.id),
+		"rawId": b64.EncodeToString(cred.id),
+		"type":  "public-key",
+		"response": map[string]any{
+			"clientDataJSON":    b64.EncodeToString(clientData),
+			"authenticatorData": b64.EncodeToString(authData),
+			"signature":         b64.EncodeToString(signature),
+			"userHandle":        b64.EncodeToString(cred.userHandle),
+		},
+	})
+}
+
+func (a *Authenticator) find(rpID, encodedID string) *credential {
+	id, err := b64.DecodeString(encodedID)
+	if err != nil {
+		return nil
+	}
+	for _, c := range a.creds {
+		if c.rpID == rpID && bytes.Equal(c.id, id) {
+			return c
+		}
+	}
+	return nil
+}
+
+func (a *Authenticator) clientData(ceremony, challenge string) []byte {
+	data, _ := json.Marshal(map[string]any{
+		"type":        ceremony,
+		"challenge":   challenge,
+		"origin":      a.Origin,
+		"crossOrigin": false,
+	})
+	return data
+}
+
+func (c *credential) authenticatorData(flags byte) []byte {
+	rpIDHash := sha256.Sum256([]byte(c.rpID))
+	data := append(rpIDHash[:], flags)
+	return binary.BigEndian.AppendUint32(data, c.signCount)
+}
@@ -25,6 +25,11 @@ func (c *Claims) IsRefresh() bool {
 	return c.TokenUse == "refresh"
 }
 
+// IsMFAChallenge returns true if the token is an MFA challenge token.
+func (c *Claims) IsMFAChallenge() bool {
+	return c.TokenUse == "mfa_challenge"
+}
+
 // ValidateBasic checks that all required claims are present and non-empty.
 func (c *Claims) ValidateBasic() error {
 	var issues []string
@@ -21,6 +21,9 @@ var (
 	ErrExpiredToken = errors.New("expired token")
 )
 
+// MFAChallengeTTL is how long a user has to enter their second factor after a correct password.
+const MFAChallengeTTL = 5 * time.Minute
+
 // Config holds JWT configuration parameters.
 type Config struct {
 	Issuer      string
@@ -156,75 +159,57 @@ func (s *Ed25519Service) IssueRefreshToken(input IssueInput, now time.Time, abso
 
 // ValidateAccessToken validates a JWT access token and returns its claims.
 func (s *Ed25519Service) ValidateAccessToken(raw string, now time.Time) (*Claims, error) {
-	if strings.TrimSpace(raw) == "" {
-		return nil, ErrInvalidToken
-	}
+	return s.validate(raw, now, "access")
+}
 
-	claims := &Claims{}
-	parser := jwt.NewParser(
-		jwt.WithExpirationRequired(),
-		jwt.WithIssuedAt(),
-		jwt.WithNotBeforeRequired(),
-		jwt.WithLeeway(s.config.ClockSkew),
-	)
+// ValidateRefreshToken validates a JWT refresh token and returns its claims.
+func (s *Ed25519Service) ValidateRefreshToken(raw string, now time.Time) (*Claims, error) {
+	return s.validate(raw, now, "refresh")
+}
 
-	_, err := parser.ParseWithClaims(raw, claims, func(token *jwt.Token) (any, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodEd25519); !ok {
-			return nil, fmt.Errorf("unexpected signing method")
-		}
-		kid, _ := token.Header["kid"].(string)
-		kid = strings.TrimSpace(kid)
-		if kid == "" {
-			return nil, fmt.Errorf("missing kid")
-		}
-		pub, ok := s.publicKeys[kid]
-		if !ok {
-			return nil, fmt.Errorf("unknown key ID: %s", kid)
-		}
-		return pub, nil
-	})
+// IssueMFAChallengeToken creates a short-lived token proving that the first factor succeeded.
+// It is only accepted by ValidateMFAChallengeToken, never as an access or refresh token.
+func (s *Ed25519Service) IssueMFAChallengeToken(input IssueInput, now time.Time) (string, time.Time, error) {
+	jti, err := generateJTI()
 	if err != nil {
-		return nil, ErrInvalidToken
-	}
-	if claims.ID == "" {
-		return nil, ErrInvalidToken
-	}
-
-	if err := claims.ValidateBasic(); err != nil {
-		return nil, ErrInvalidToken
-	}
-
-	if claims.TokenUse != "access" {
-		return nil, ErrInvalidToken
+		return "", time.Time{}, fmt.Errorf("generate jti: %w", err)
 	}
 
-	nowUnix := now.Unix()
-	clockSkew := int64(s.config.ClockSkew.Seconds())
+	expiresAt := now.Add(MFAChallengeTTL)
 
-	exp, _ := claims.GetExpirationTime()
-	if exp != nil && exp.Unix() <= nowUnix {
-		return nil, ErrExpiredToken
+	claims := &Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    s.config.Issuer,
+			Subject:   input.Subject,
+			Audience:  []string{s.config.Audience},
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+			ID:        jti,
+		},
+		AccessLevel: input.AccessLevel,
+		TokenUse:    "mfa_challenge",
+		AuthVersion: input.AuthVersion,
 	}
 
-	nbf, _ := claims.GetNotBefore()
-	if nbf != nil && nbf.Unix() > nowUnix+clockSkew {
-		return nil, ErrExpiredToken
-	}
+	token := jwt.NewWithClaims(jwt.SigningMethodEdDSA, claims)
+	token.Header["kid"] = s.activeKeyID
 
-	iat, _ := claims.GetIssuedAt()
-	if iat != nil && iat.Unix() > nowUnix+clockSkew {
-		return nil, ErrExpiredToken
+	raw, err := token.SignedString(s.activePriv)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("sign mfa challenge token: %w", err)
 	}
 
-	if claims.AuthVersion < 0 {
-		return nil, ErrInvalidToken
-	}
+	return raw, expiresAt, nil
+}
 
-	return claims, nil
+// ValidateMFAChallengeToken validates a JWT MFA challenge token and returns its claims.
+func (s *Ed25519Service) ValidateMFAChallengeToken(raw string, now time.Time) (*Claims, error) {
+	return s.validate(raw, now, "mfa_challenge")
 }
 
-// ValidateRefreshToken validates a JWT refresh token and returns its claims.
-func (s *Ed25519Service) ValidateRefreshToken(raw string, now time.Time) (*Claims, error) {
+// validate verifies the signature and time claims of raw and checks that it was issued for tokenUse.
+func (s *Ed25519Service) validate(raw string, now time.Time, tokenUse string) (*Claims, error) {
 	if strings.TrimSpace(raw) == "" {
 		return nil, ErrInvalidToken
 	}
@@ -263,7 +248,7 @@ func (s *Ed25519Service) ValidateRefreshToken(raw string, now time.Time) (*Claim
 		return nil, ErrInvalidToken
 	}
 
-	if claims.TokenUse != "refresh" {
+	if claims.TokenUse != tokenUse {
 		return nil, ErrInvalidToken
 	}
 
@@ -305,6 +290,8 @@ type Service interface {
 	IssueRefreshToken(input IssueInput, now time.Time, absoluteExpiry time.Time) (string, time.Time, error)
 	ValidateAccessToken(raw string, now time.Time) (*Claims, error)
 	ValidateRefreshToken(raw string, now time.Time) (*Claims, error)
+	IssueMFAChallengeToken(input IssueInput, now time.Time) (string, time.Time, error)
+	ValidateMFAChallengeToken(raw string, now time.Time) (*Claims, error)
 }
 
 // generateJTI creates a cryptographically random token identifier.
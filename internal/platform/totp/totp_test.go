@@ -0,0 +1,77 @@
+package totp
+
+import (
+	"encoding/base32"
+	"strings"
+	"testing"
+	"time"
+)
+
+// rfcSecret is the SHA1 seed from RFC 6238 appendix B.
+var rfcSecret = base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString([]byte("12345678901234567890"))
+
+func TestCode_RFC6238Vectors(t *testing.T) {
+	// RFC 6238 lists eight digit codes; the six digit code is their last six digits.
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+		{20000000000, "353130"},
+	}
+
+	for _, tt := range tests {
+		got, err := Code(rfcSecret, time.Unix(tt.unix, 0))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tt.want {
+			t.Errorf("Code(%d) = %s, want %s", tt.unix, got, tt.want)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	secret, err := GenerateSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Unix(1_700_000_000, 0)
+
+	previous, _ := Code(secret, now.Add(-Period))
+	step, ok, err := Validate(secret, previous, now, 1)
+	if err != nil || !ok {
+		t.Fatalf("expected previous code to be accepted within skew, got %v, %v", ok, err)
+	}
+	if step != Step(now)-1 {
+		t.Errorf("expected the previous step, got %d", step)
+	}
+
+	stale, _ := Code(secret, now.Add(-2*Period))
+	if _, ok, _ := Validate(secret, stale, now, 1); ok {
+		t.Error("expected a code outside the skew window to be rejected")
+	}
+
+	current, _ := Code(secret, now)
+	if _, ok, _ := Validate(secret, current[:3]+" "+current[3:], now, 0); !ok {
+		t.Error("expected a code with a separating space to be accepted")
+	}
+
+	if _, _, err := Validate("not base32!", current, now, 0); err != ErrInvalidSecret {
+		t.Errorf("expected ErrInvalidSecret, got %v", err)
+	}
+}
+
+func TestURI(t *testing.T) {
+	uri := URI("Saturn", "jane@example.com", "JBSWY3DPEHPK3PXP")
+	if !strings.HasPrefix(uri, "otpauth://totp/Saturn:jane@example.com?") {
+		t.Errorf("unexpected URI: %s", uri)
+	}
+	if !strings.Contains(uri, "secret=JBSWY3DPEHPK3PXP") || !strings.Contains(uri, "issuer=Saturn") {
+		t.Errorf("missing parameters in URI: %s", uri)
+	}
+}
@@ -2,6 +2,7 @@ package auth
 
 import (
 	"context"
+	"slices"
 	"strings"
 	"sync"
 
@@ -19,11 +20,31 @@ type MemberStoreProvider interface {
 	GetByID(ctx context.Context, spaceID space.SpaceID, userID space.SpaceID) (*space.Member, error)
 }
 
-// SpaceInterceptor validates space-scoped gRPC requests by checking user membership.
+// spacePolicy holds the evaluated space scoping and role policy for a method.
+type spacePolicy struct {
+	Scoped  bool
+	MinRole space.SpaceRole
+	Roles   []space.SpaceRole
+}
+
+// allows reports whether a member holding role may call the method.
+// Unknown roles in the policy match nobody, so a misconfigured rule fails closed.
+func (p *spacePolicy) allows(role space.SpaceRole) bool {
+	if p.MinRole != "" && (!p.MinRole.IsValid() || !role.AtLeast(p.MinRole)) {
+		return false
+	}
+	if len(p.Roles) > 0 && !slices.Contains(p.Roles, role) {
+		return false
+	}
+	return true
+}
+
+// SpaceInterceptor validates space-scoped gRPC requests by checking user membership
+// and the member's role against the method's space rule.
 type SpaceInterceptor struct {
 	memberStore MemberStoreProvider
 	rules       []api.SpaceRule
-	cache       sync.Map // Cache of: string (method) -> bool (needs scoping)
+	cache       sync.Map // Cache of: string (method) -> *spacePolicy
 }
 
 // NewSpaceInterceptor creates a new SpaceInterceptor.
@@ -60,7 +81,8 @@ func (si *SpaceInterceptor) StreamServerInterceptor() grpc.StreamServerIntercept
 // intercept validates space scope for the given method and updates context.
 func (si *SpaceInterceptor) intercept(ctx context.Context, fullMethod string) (context.Context, error) {
 	// Determine if this method requires space scoping
-	if !si.resolveSpacePolicy(fullMethod) {
+	policy := si.resolveSpacePolicy(fullMethod)
+	if !policy.Scoped {
 		return ctx, nil
 	}
 
@@ -100,16 +122,20 @@ func (si *SpaceInterceptor) intercept(ctx context.Context, fullMethod string) (c
 		return ctx, status.Error(codes.PermissionDenied, "user is not a member of this space")
 	}
 
+	// Check the member's role against the method's rule
+	if !policy.allows(member.Role) {
+		return ctx, status.Errorf(codes.PermissionDenied, "space role %q is not allowed to call this method", member.Role)
+	}
+
 	// Inject space scope into context
 	return foundationauth.WithSpaceScope(ctx, string(spaceID), string(member.Role)), nil
 }
 
 // resolveSpacePolicy evaluates the space scoping rules for a given gRPC method name.
-// Returns true if space scoping is required.
-func (si *SpaceInterceptor) resolveSpacePolicy(method string) bool {
+func (si *SpaceInterceptor) resolveSpacePolicy(method string) *spacePolicy {
 	// Check cache first
 	if cached, ok := si.cache.Load(method); ok {
-		return cached.(bool)
+		return cached.(*spacePolicy)
 	}
 
 	// Normalize gRPC method (e.g. "/saturn.space.v1.Spaces/CreateSpace" -> "saturn.space.v1.Spaces.CreateSpace")
@@ -117,16 +143,22 @@ func (si *SpaceInterceptor) resolveSpacePolicy(method string) bool {
 	normalizedMethod = strings.ReplaceAll(normalizedMethod, "/", ".")
 
 	// Iterate all rules; last matching rule wins
-	scoped := false
+	policy := &spacePolicy{}
 	for _, rule := range si.rules {
 		if matchSelector(rule.Selector, normalizedMethod) {
-			scoped = rule.Scoped
+			policy = &spacePolicy{
+				Scoped:  rule.IsScoped(),
+				MinRole: space.SpaceRole(rule.MinRole),
+			}
+			for _, r := range rule.Roles {
+				policy.Roles = append(policy.Roles, space.SpaceRole(r))
+			}
 		}
 	}
 
 	// Cache the result
-	si.cache.Store(method, scoped)
-	return scoped
+	si.cache.Store(method, policy)
+	return policy
 }
 
 // scopedStream wraps a ServerStream to use a scoped context.
@@ -0,0 +1,358 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/masterkeysrd/saturn/api"
+	financev1 "github.com/masterkeysrd/saturn/apis/saturn/finance/v1"
+	adminidentityv1 "github.com/masterkeysrd/saturn/apis/saturn/identity/admin/v1"
+	identityv1 "github.com/masterkeysrd/saturn/apis/saturn/identity/v1"
+	agentv1 "github.com/masterkeysrd/saturn/apis/saturn/platform/agent/v1"
+	backupv1 "github.com/masterkeysrd/saturn/apis/saturn/platform/backup/v1"
+	integrationv1 "github.com/masterkeysrd/saturn/apis/saturn/platform/integration/v1"
+	messagev1 "github.com/masterkeysrd/saturn/apis/saturn/platform/message/v1"
+	schedulerv1 "github.com/masterkeysrd/saturn/apis/saturn/platform/scheduler/v1"
+	spacev1 "github.com/masterkeysrd/saturn/apis/saturn/space/v1"
+	"github.com/masterkeysrd/saturn/internal/domain/space"
+	foundationauth "github.com/masterkeysrd/saturn/internal/foundation/auth"
+)
+
+type mockMemberStoreProvider struct {
+	members map[space.SpaceID]*space.Member
+}
+
+func (m *mockMemberStoreProvider) GetByID(_ context.Context, _ space.SpaceID, userID space.SpaceID) (*space.Member, error) {
+	member, ok := m.members[userID]
+	if !ok {
+		return nil, errors.New("member not found")
+	}
+	return member, nil
+}
+
+// TestSpaceInterceptor_DeclaredRules checks every registered RPC against the space rule declared
+// for it in the module YAMLs. New RPCs must be added here with their intended role.
+func TestSpaceInterceptor_DeclaredRules(t *testing.T) {
+	global, modules, err := api.LoadServiceConfigs()
+	if err != nil {
+		t.Fatalf("failed to load service configs: %v", err)
+	}
+	interceptor := NewSpaceInterceptor(&mockMemberStoreProvider{}, api.CompileAllSpaceRules(global, modules))
+
+	tests := []struct {
+		grpcMethod      string
+		expectedScoped  bool
+		expectedMinRole space.SpaceRole
+	}{
+		// saturn.finance.v1.Finance
+		{"/saturn.finance.v1.Finance/ConfigureFinance", true, space.RoleAdmin},
+		{"/saturn.finance.v1.Finance/GetFinanceSettings", true, space.RoleViewer},
+		{"/saturn.finance.v1.Finance/UpdateFinanceSettings", true, space.RoleAdmin},
+		{"/saturn.finance.v1.Finance/CreateBudget", true, space.RoleMember},
+		{"/saturn.finance.v1.Finance/GetBudget", true, space.RoleViewer},
+		{"/saturn.finance.v1.Finance/UpdateBudget", true, space.RoleMember},
+		{"/saturn.finance.v1.Finance/DeleteBudget", true, space.RoleAdmin},
+		{"/saturn.finance.v1.Finance/ListBudgets", true, space.RoleViewer},
+		{"/saturn.finance.v1.Finance/GetBudgetPeriod", true, space.RoleViewer},
+		{"/saturn.finance.v1.Finance/CreateExchangeRate", true, space.RoleMember},
+		{"/saturn.finance.v1.Finance/GetExchangeRate", true, space.RoleViewer},
+		{"/saturn.finance.v1.Finance/UpdateExchangeRate", true, space.RoleMember},
+		{"/saturn.finance.v1.Finance/ListExchangeRates", true, space.RoleViewer},
+		{"/saturn.finance.v1.Finance/DeleteExchangeRate", true, space.RoleAdmin},
+		{"/saturn.finance.v1.Finance/CreateExpense", true, space.RoleMember},
+		{"/saturn.finance.v1.Finance/UpdateExpense", true, space.RoleMember},
+		{"/saturn.finance.v1.Finance/CreateIncome", true, space.RoleMember},
+		{"/saturn.finance.v1.Finance/UpdateIncome", true, space.RoleMember},
+		{"/saturn.finance.v1.Finance/CreateIncomeSource", true, space.RoleMember},
+		{"/saturn.finance.v1.Finance/GetIncomeSource", true, space.RoleViewer},
+		{"/saturn.finance.v1.Finance/UpdateIncomeSource", true, space.RoleMember},
+		{"/saturn.finance.v1.Finance/DeleteIncomeSource", true, space.RoleAdmin},
+		{"/saturn.finance.v1.Finance/ListIncomeSources", true, space.RoleViewer},
+		{"/saturn.finance.v1.Finance/CreateGoal", true, space.RoleMember},
+		{"/saturn.finance.v1.Finance/GetGoal", true, space.RoleViewer},
+		{"/saturn.finance.v1.Finance/UpdateGoal", true, space.RoleMember},
+		{"/saturn.finance.v1.Finance/DeleteGoal", true, space.RoleAdmin},
+		{"/saturn.finance.v1.Finance/ListGoals", true, space.RoleViewer},
+		{"/saturn.finance.v1.Finance/GetGoalProgress", true, space.RoleViewer},
+		{"/saturn.finance.v1.Finance/CreateCategory", true, space.RoleMember},
+		{"/saturn.finance.v1.Finance/GetCategory", true, space.RoleViewer},
+		{"/saturn.finance.v1.Finance/UpdateCategory", true, space.RoleMember},
+		{"/saturn.finance.v1.Finance/DeleteCategory", true, space.RoleAdmin},
+		{"/saturn.finance.v1.Finance/ListCategories", true, space.RoleViewer},
+		{"/saturn.finance.v1.Finance/DeleteTransaction", true, space.RoleAdmin},
+		{"/saturn.finance.v1.Finance/ListTransactions", true, space.RoleViewer},
+		{"/saturn.finance.v1.Finance/BatchUpdateTransactions", true, space.RoleMember},
+		{"/saturn.finance.v1.Finance/BatchDeleteTransactions", true, space.RoleAdmin},
+		{"/saturn.finance.v1.Finance/CreateSavedFilter", true, space.RoleMember},
+		{"/saturn.finance.v1.Finance/GetSavedFilter", true, space.RoleViewer},
+		{"/saturn.finance.v1.Finance/UpdateSavedFilter", true, space.RoleMember},
+		{"/saturn.finance.v1.Finance/DeleteSavedFilter", true, space.RoleAdmin},
+		{"/saturn.finance.v1.Finance/ListSavedFilters", true, space.RoleViewer},
+		{"/saturn.finance.v1.Finance/CreatePayee", true, space.RoleMember},
+		{"/saturn.finance.v1.Finance/GetPayee", true, space.RoleViewer},
+		{"/saturn.finance.v1.Finance/UpdatePayee", true, space.RoleMember},
+		{"/saturn.finance.v1.Finance/DeletePayee", true, space.RoleAdmin},
+		{"/saturn.finance.v1.Finance/ListPayees", true, space.RoleViewer},
+		{"/saturn.finance.v1.Finance/CreateTransactionRule", true, space.RoleMember},
+		{"/saturn.finance.v1.Finance/GetTransactionRule", true, space.RoleViewer},
+		{"/saturn.finance.v1.Finance/UpdateTransactionRule", true, space.RoleMember},
+		{"/saturn.finance.v1.Finance/DeleteTransactionRule", true, space.RoleAdmin},
+		{"/saturn.finance.v1.Finance/ListTransactionRules", true, space.RoleViewer},
+		{"/saturn.finance.v1.Finance/ApplyTransactionRules", true, space.RoleMember},
+		{"/saturn.finance.v1.Finance/GetTransaction", true, space.RoleViewer},
+		{"/saturn.finance.v1.Finance/ListTransactionEvents", true, space.RoleViewer},
+		{"/saturn.finance.v1.Finance/GetInsights", true, space.RoleViewer},
+		{"/saturn.finance.v1.Finance/ForecastCashFlow", true, space.RoleViewer},
+		{"/saturn.finance.v1.Finance/ListAlerts", true, space.RoleViewer},
+		{"/saturn.finance.v1.Finance/GetAlert", true, space.RoleViewer},
+		{"/saturn.finance.v1.Finance/AcknowledgeAlert", true, space.RoleMember},
+		{"/saturn.finance.v1.Finance/SnoozeAlert", true, space.RoleMember},
+		{"/saturn.finance.v1.Finance/CreateRecurringExpense", true, space.RoleMember},
+		{"/saturn.finance.v1.Finance/UpdateRecurringExpense", true, space.RoleMember},
+		{"/saturn.finance.v1.Finance/DeleteRecurringExpense", true, space.RoleAdmin},
+		{"/saturn.finance.v1.Finance/ListRecurringExpenses", true, space.RoleViewer},
+		{"/saturn.finance.v1.Finance/DetectSubscriptions", true, space.RoleViewer},
+		{"/saturn.finance.v1.Finance/ConvertToRecurringExpense", true, space.RoleMember},
+		{"/saturn.finance.v1.Finance/ListScheduledPayments", true, space.RoleViewer},
+		{"/saturn.finance.v1.Finance/GetScheduledPayment", true, space.RoleViewer},
+		{"/saturn.finance.v1.Finance/ConfirmScheduledPayment", true, space.RoleMember},
+		{"/saturn.finance.v1.Finance/MatchScheduledPayment", true, space.RoleMember},
+		{"/saturn.finance.v1.Finance/SkipScheduledPayment", true, space.RoleMember},
+		{"/saturn.finance.v1.Finance/CreateBorrowing", true, space.RoleMember},
+		{"/saturn.finance.v1.Finance/GetBorrowing", true, space.RoleViewer},
+		{"/saturn.finance.v1.Finance/ListBorrowings", true, space.RoleViewer},
+		{"/saturn.finance.v1.Finance/UpdateBorrowing", true, space.RoleMember},
+		{"/saturn.finance.v1.Finance/DeleteBorrowing", true, space.RoleAdmin},
+		{"/saturn.finance.v1.Finance/CreateBorrowingRepayment", true, space.RoleMember},
+		{"/saturn.finance.v1.Finance/ListBorrowingRepayments", true, space.RoleViewer},
+		{"/saturn.finance.v1.Finance/DeleteBorrowingRepayment", true, space.RoleAdmin},
+		{"/saturn.finance.v1.Finance/CreateAccount", true, space.RoleMember},
+		{"/saturn.finance.v1.Finance/GetAccount", true, space.RoleViewer},
+		{"/saturn.finance.v1.Finance/UpdateAccount", true, space.RoleMember},
+		{"/saturn.finance.v1.Finance/AdjustAccountBalance", true, space.RoleMember},
+		{"/saturn.finance.v1.Finance/ListCardStatements", true, space.RoleViewer},
+		{"/saturn.finance.v1.Finance/GetCardStatement", true, space.RoleViewer},
+		{"/saturn.finance.v1.Finance/StartReconciliation", true, space.RoleMember},
+		{"/saturn.finance.v1.Finance/GetReconciliation", true, space.RoleViewer},
+		{"/saturn.finance.v1.Finance/ListReconciliations", true, space.RoleViewer},
+		{"/saturn.finance.v1.Finance/ListReconciliationTransactions", true, space.RoleViewer},
+		{"/saturn.finance.v1.Finance/SetTransactionsCleared", true, space.RoleMember},
+		{"/saturn.finance.v1.Finance/FinalizeReconciliation", true, space.RoleMember},
+		{"/saturn.finance.v1.Finance/CancelReconciliation", true, space.RoleMember},
+		{"/saturn.finance.v1.Finance/DeleteAccount", true, space.RoleAdmin},
+		{"/saturn.finance.v1.Finance/ListAccounts", true, space.RoleViewer},
+		{"/saturn.finance.v1.Finance/CreateTransfer", true, space.RoleMember},
+		{"/saturn.finance.v1.Finance/ListTransfers", true, space.RoleViewer},
+		{"/saturn.finance.v1.Finance/ListCurrencies", true, space.RoleViewer},
+		{"/saturn.finance.v1.Finance/ListInboxItems", true, space.RoleViewer},
+		{"/saturn.finance.v1.Finance/UpdateInboxItem", true, space.RoleMember},
+		{"/saturn.finance.v1.Finance/ApproveInboxItem", true, space.RoleMember},
+		{"/saturn.finance.v1.Finance/DiscardInboxItem", true, space.RoleMember},
+		{"/saturn.finance.v1.Finance/ImportStatement", true, space.RoleMember},
+		{"/saturn.finance.v1.Finance/GetStatementMapping", true, space.RoleViewer},
+		{"/saturn.finance.v1.Finance/ExportLedger", true, space.RoleViewer},
+		{"/saturn.finance.v1.Finance/CreateLedgerExport", true, space.RoleMember},
+		{"/saturn.finance.v1.Finance/GetLedgerExport", true, space.RoleViewer},
+		{"/saturn.finance.v1.Finance/ListLedgerExports", true, space.RoleViewer},
+		{"/saturn.finance.v1.Finance/DownloadLedgerExport", true, space.RoleViewer},
+		// saturn.platform.agent.v1.AgentService
+		{"/saturn.platform.agent.v1.AgentService/CreateProvider", true, space.RoleAdmin},
+		{"/saturn.platform.agent.v1.AgentService/GetProvider", true, space.RoleViewer},
+		{"/saturn.platform.agent.v1.AgentService/ListProviders", true, space.RoleViewer},
+		{"/saturn.platform.agent.v1.AgentService/UpdateProvider", true, space.RoleAdmin},
+		{"/saturn.platform.agent.v1.AgentService/DeleteProvider", true, space.RoleAdmin},
+		{"/saturn.platform.agent.v1.AgentService/CreateAgent", true, space.RoleAdmin},
+		{"/saturn.platform.agent.v1.AgentService/GetAgent", true, space.RoleViewer},
+		{"/saturn.platform.agent.v1.AgentService/ListAgents", true, space.RoleViewer},
+		{"/saturn.platform.agent.v1.AgentService/UpdateAgent", true, space.RoleAdmin},
+		{"/saturn.platform.agent.v1.AgentService/DeleteAgent", true, space.RoleAdmin},
+		{"/saturn.platform.agent.v1.AgentService/ListAgentRuns", true, space.RoleViewer},
+		{"/saturn.platform.agent.v1.AgentService/GetAgentCatalog", true, space.RoleViewer},
+		{"/saturn.platform.agent.v1.AgentService/GetProviderCatalog", true, space.RoleViewer},
+		{"/saturn.platform.agent.v1.AgentService/GetSuggestions", true, space.RoleViewer},
+		// saturn.platform.integration.v1.IntegrationService
+		{"/saturn.platform.integration.v1.IntegrationService/GetIntegration", true, space.RoleViewer},
+		{"/saturn.platform.integration.v1.IntegrationService/ConfigureIntegration", true, space.RoleAdmin},
+		{"/saturn.platform.integration.v1.IntegrationService/RotateIntegrationToken", true, space.RoleAdmin},
+		{"/saturn.platform.integration.v1.IntegrationService/SimulateWebhook", true, space.RoleAdmin},
+		{"/saturn.platform.integration.v1.IntegrationService/ListCatalog", true, space.RoleViewer},
+		{"/saturn.platform.integration.v1.IntegrationService/ListIntegrations", true, space.RoleViewer},
+		{"/saturn.platform.integration.v1.IntegrationService/CreateIntegrationToken", true, space.RoleAdmin},
+		{"/saturn.platform.integration.v1.IntegrationService/ListIntegrationTokens", true, space.RoleViewer},
+		{"/saturn.platform.integration.v1.IntegrationService/DeleteIntegrationToken", true, space.RoleAdmin},
+		// saturn.space.v1.Spaces
+		{"/saturn.space.v1.Spaces/CreateSpace", false, ""},
+		{"/saturn.space.v1.Spaces/GetSpace", false, ""},
+		{"/saturn.space.v1.Spaces/UpdateSpace", false, ""},
+		{"/saturn.space.v1.Spaces/DeleteSpace", false, ""},
+		{"/saturn.space.v1.Spaces/ListSpaces", false, ""},
+		{"/saturn.space.v1.Spaces/AddSpaceMember", false, ""},
+		{"/saturn.space.v1.Spaces/RemoveSpaceMember", false, ""},
+		{"/saturn.space.v1.Spaces/UpdateSpaceMemberRole", false, ""},
+		{"/saturn.space.v1.Spaces/ListSpaceMembers", false, ""},
+		{"/saturn.space.v1.Spaces/CreateInvitation", false, ""},
+		{"/saturn.space.v1.Spaces/ListInvitations", false, ""},
+		{"/saturn.space.v1.Spaces/RevokeInvitation", false, ""},
+		{"/saturn.space.v1.Spaces/AcceptInvitation", false, ""},
+		{"/saturn.space.v1.Spaces/DeclineInvitation", false, ""},
+		// saturn.identity.v1.Identity
+		{"/saturn.identity.v1.Identity/LoginUser", false, ""},
+		{"/saturn.identity.v1.Identity/RegisterUser", false, ""},
+		{"/saturn.identity.v1.Identity/RefreshSession", false, ""},
+		{"/saturn.identity.v1.Identity/Logout", false, ""},
+		{"/saturn.identity.v1.Identity/GetCurrentUser", false, ""},
+		{"/saturn.identity.v1.Identity/ListActiveSessions", false, ""},
+		{"/saturn.identity.v1.Identity/RevokeSession", false, ""},
+		{"/saturn.identity.v1.Identity/RevokeAllSessions", false, ""},
+		{"/saturn.identity.v1.Identity/ListMySecurityEvents", false, ""},
+		{"/saturn.identity.v1.Identity/GetMFAStatus", false, ""},
+		{"/saturn.identity.v1.Identity/EnrollTOTP", false, ""},
+		{"/saturn.identity.v1.Identity/ConfirmTOTP", false, ""},
+		{"/saturn.identity.v1.Identity/RegenerateRecoveryCodes", false, ""},
+		{"/saturn.identity.v1.Identity/DisableMFA", false, ""},
+		{"/saturn.identity.v1.Identity/BeginPasskeyLogin", false, ""},
+		{"/saturn.identity.v1.Identity/BeginPasskeySignup", false, ""},
+		{"/saturn.identity.v1.Identity/BeginPasskeyRegistration", false, ""},
+		{"/saturn.identity.v1.Identity/CreatePasskey", false, ""},
+		{"/saturn.identity.v1.Identity/ListPasskeys", false, ""},
+		{"/saturn.identity.v1.Identity/RenamePasskey", false, ""},
+		{"/saturn.identity.v1.Identity/DeletePasskey", false, ""},
+		{"/saturn.identity.v1.Identity/RequestPasswordReset", false, ""},
+		{"/saturn.identity.v1.Identity/ConfirmPasswordReset", false, ""},
+		{"/saturn.identity.v1.Identity/ChangePassword", false, ""},
+		{"/saturn.identity.v1.Identity/ResendVerificationEmail", false, ""},
+		{"/saturn.identity.v1.Identity/VerifyEmail", false, ""},
+		// saturn.identity.admin.v1.AdminIdentity
+		{"/saturn.identity.admin.v1.AdminIdentity/ListUsers", false, ""},
+		{"/saturn.identity.admin.v1.AdminIdentity/ApproveUser", false, ""},
+		{"/saturn.identity.admin.v1.AdminIdentity/RejectUser", false, ""},
+		{"/saturn.identity.admin.v1.AdminIdentity/UpdateUserRole", false, ""},
+		{"/saturn.identity.admin.v1.AdminIdentity/RevokeAllSessions", false, ""},
+		{"/saturn.identity.admin.v1.AdminIdentity/ListSecurityEvents", false, ""},
+		{"/saturn.identity.admin.v1.AdminIdentity/ResetUserMFA", false, ""},
+		// saturn.platform.backup.v1.BackupAdmin
+		{"/saturn.platform.backup.v1.BackupAdmin/ListBackups", false, ""},
+		{"/saturn.platform.backup.v1.BackupAdmin/TriggerBackup", false, ""},
+		// saturn.platform.message.v1.MessageAdmin
+		{"/saturn.platform.message.v1.MessageAdmin/GetQueueMetrics", false, ""},
+		{"/saturn.platform.message.v1.MessageAdmin/ListDeliveries", false, ""},
+		{"/saturn.platform.message.v1.MessageAdmin/RetryDelivery", false, ""},
+		// saturn.platform.scheduler.v1.SchedulerAdmin
+		{"/saturn.platform.scheduler.v1.SchedulerAdmin/ListSchedules", false, ""},
+		{"/saturn.platform.scheduler.v1.SchedulerAdmin/ListJobs", false, ""},
+		{"/saturn.platform.scheduler.v1.SchedulerAdmin/GetSchedulerStatus", false, ""},
+		{"/saturn.platform.scheduler.v1.SchedulerAdmin/TriggerSchedule", false, ""},
+		{"/saturn.platform.scheduler.v1.SchedulerAdmin/PauseSchedule", false, ""},
+		{"/saturn.platform.scheduler.v1.SchedulerAdmin/ResumeSchedule", false, ""},
+		{"/saturn.platform.scheduler.v1.SchedulerAdmin/RetryJob", false, ""},
+		{"/saturn.platform.scheduler.v1.SchedulerAdmin/DeleteJob", false, ""},
+	}
+
+	declared := make(map[string]bool, len(tests))
+	for _, tc := range tests {
+		declared[tc.grpcMethod] = true
+		t.Run(tc.grpcMethod, func(t *testing.T) {
+			policy := interceptor.resolveSpacePolicy(tc.grpcMethod)
+			if policy.Scoped != tc.expectedScoped {
+				t.Errorf("expected Scoped=%v, got %v", tc.expectedScoped, policy.Scoped)
+			}
+			if policy.MinRole != tc.expectedMinRole {
+				t.Errorf("expected MinRole=%q, got %q", tc.expectedMinRole, policy.MinRole)
+			}
+			for _, r := range policy.Roles {
+				if !r.IsValid() {
+					t.Errorf("method declares unknown role %q", r)
+				}
+			}
+		})
+	}
+
+	services := []grpc.ServiceDesc{
+		financev1.Finance_ServiceDesc,
+		agentv1.AgentService_ServiceDesc,
+		integrationv1.IntegrationService_ServiceDesc,
+		spacev1.Spaces_ServiceDesc,
+		identityv1.Identity_ServiceDesc,
+		adminidentityv1.AdminIdentity_ServiceDesc,
+		backupv1.BackupAdmin_ServiceDesc,
+		messagev1.MessageAdmin_ServiceDesc,
+		schedulerv1.SchedulerAdmin_ServiceDesc,
+	}
+	for _, svc := range services {
+		for _, m := range svc.Methods {
+			if method := "/" + svc.ServiceName + "/" + m.MethodName; !declared[method] {
+				t.Errorf("%s has no declared space rule expectation", method)
+			}
+		}
+		for _, s := range svc.Streams {
+			if method := "/" + svc.ServiceName + "/" + s.StreamName; !declared[method] {
+				t.Errorf("%s has no declared space rule expectation", method)
+			}
+		}
+	}
+}
+
+func TestSpaceInterceptor_Intercept(t *testing.T) {
+	spaceID := "spc_2NzN5q3y1pUQvU1ZQ9t2tq8xKxF"
+	store := &mockMemberStoreProvider{members: map[space.SpaceID]*space.Member{
+		"usr_owner":  {Role: space.RoleOwner},
+		"usr_admin":  {Role: space.RoleAdmin},
+		"usr_member": {Role: space.RoleMember},
+		"usr_viewer": {Role: space.RoleViewer},
+	}}
+	rules := []api.SpaceRule{
+		{Selector: "*", Scoped: true},
+		{Selector: "saturn.finance.v1.Finance.*", Scoped: true, MinRole: "viewer"},
+		{Selector: "saturn.finance.v1.Finance.Create*", MinRole: "member"},
+		{Selector: "saturn.finance.v1.Finance.Delete*", MinRole: "admin"},
+		{Selector: "saturn.finance.v1.Finance.ConfigureFinance", Roles: []string{"owner"}},
+		{Selector: "saturn.finance.v1.Finance.Misconfigured", MinRole: "superuser"},
+		{Selector: "saturn.space.v1.Spaces.*", Scoped: false},
+	}
+	interceptor := NewSpaceInterceptor(store, rules)
+
+	tests := []struct {
+		name         string
+		grpcMethod   string
+		userID       string
+		expectedCode codes.Code
+	}{
+		{"viewer can read", "/saturn.finance.v1.Finance/ListTransactions", "usr_viewer", codes.OK},
+		{"viewer cannot write", "/saturn.finance.v1.Finance/CreateExpense", "usr_viewer", codes.PermissionDenied},
+		{"member can write", "/saturn.finance.v1.Finance/CreateExpense", "usr_member", codes.OK},
+		{"member cannot delete", "/saturn.finance.v1.Finance/DeleteBudget", "usr_member", codes.PermissionDenied},
+		{"admin can delete", "/saturn.finance.v1.Finance/DeleteBudget", "usr_admin", codes.OK},
+		{"owner outranks admin", "/saturn.finance.v1.Finance/DeleteBudget", "usr_owner", codes.OK},
+		{"explicit roles exclude admin", "/saturn.finance.v1.Finance/ConfigureFinance", "usr_admin", codes.PermissionDenied},
+		{"explicit roles allow owner", "/saturn.finance.v1.Finance/ConfigureFinance", "usr_owner", codes.OK},
+		{"unknown min_role fails closed", "/saturn.finance.v1.Finance/Misconfigured", "usr_owner", codes.PermissionDenied},
+		{"non-member is rejected", "/saturn.finance.v1.Finance/ListTransactions", "usr_stranger", codes.PermissionDenied},
+		{"unscoped method skips membership", "/saturn.space.v1.Spaces/ListSpaces", "usr_stranger", codes.OK},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("space-id", spaceID))
+			ctx = foundationauth.WithPrincipal(ctx, foundationauth.Principal{Subject: tc.userID})
+
+			ctx, err := interceptor.intercept(ctx, tc.grpcMethod)
+			if code := status.Code(err); code != tc.expectedCode {
+				t.Fatalf("expected code %v, got %v (%v)", tc.expectedCode, code, err)
+			}
+			if err != nil || !interceptor.resolveSpacePolicy(tc.grpcMethod).Scoped {
+				return
+			}
+
+			sc, ok := foundationauth.SpaceContextFromContext(ctx)
+			if !ok || sc.SpaceID != spaceID || sc.Role != string(store.members[space.SpaceID(tc.userID)].Role) {
+				t.Errorf("expected space scope with the member role in context, got %+v", sc)
+			}
+		})
+	}
+}
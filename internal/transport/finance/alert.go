@@ -0,0 +1,172 @@
+package finance
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	financev1 "github.com/masterkeysrd/saturn/apis/saturn/finance/v1"
+	"github.com/masterkeysrd/saturn/internal/domain/finance"
+)
+
+func (h *Handler) ListAlerts(ctx context.Context, req *financev1.ListAlertsRequest) (*financev1.ListAlertsResponse, error) {
+	filter := &finance.ListAlertsFilter{
+		ActiveOnly:    req.GetActiveOnly(),
+		PageSize:      req.GetPageSize(),
+		NextPageToken: req.GetPageToken(),
+	}
+	if req.Rule != nil {
+		rule, err := fromProtoAlertRule(req.GetRule())
+		if err != nil {
+			return nil, err
+		}
+		filter.Rule = &rule
+	}
+
+	page, err := h.Coordinator.ListAlerts(ctx, filter)
+	if err != nil {
+		return nil, h.mapError(err)
+	}
+
+	alerts := make([]*financev1.Alert, 0, len(page.Items))
+	for _, a := range page.Items {
+		alerts = append(alerts, toProtoAlert(a))
+	}
+
+	return &financev1.ListAlertsResponse{
+		Alerts:        alerts,
+		NextPageToken: page.NextPageToken,
+	}, nil
+}
+
+func (h *Handler) GetAlert(ctx context.Context, req *financev1.GetAlertRequest) (*financev1.Alert, error) {
+	id, err := finance.ParseAlertID(req.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	a, err := h.Coordinator.GetAlert(ctx, id)
+	if err != nil {
+		return nil, h.mapError(err)
+	}
+
+	return toProtoAlert(a), nil
+}
+
+func (h *Handler) AcknowledgeAlert(ctx context.Context, req *financev1.AcknowledgeAlertRequest) (*financev1.Alert, error) {
+	id, err := finance.ParseAlertID(req.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	a, err := h.Coordinator.AcknowledgeAlert(ctx, id)
+	if err != nil {
+		return nil, h.mapError(err)
+	}
+
+	return toProtoAlert(a), nil
+}
+
+func (h *Handler) SnoozeAlert(ctx context.Context, req *financev1.SnoozeAlertRequest) (*financev1.Alert, error) {
+	id, err := finance.ParseAlertID(req.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if req.GetSnoozeUntil() == nil {
+		return nil, status.Error(codes.InvalidArgument, "snooze_until is required")
+	}
+
+	a, err := h.Coordinator.SnoozeAlert(ctx, id, req.GetSnoozeUntil().AsTime())
+	if err != nil {
+		return nil, h.mapError(err)
+	}
+
+	return toProtoAlert(a), nil
+}
+
+// --- Mappers ---
+
+func toProtoAlertRule(r finance.AlertRule) financev1.Alert_Rule {
+	switch r {
+	case finance.AlertRuleBudgetThreshold:
+		return financev1.Alert_BUDGET_THRESHOLD
+	case finance.AlertRuleProjectedOverspend:
+		return financev1.Alert_PROJECTED_OVERSPEND
+	case finance.AlertRuleAmountAnomaly:
+		return financev1.Alert_AMOUNT_ANOMALY
+	case finance.AlertRuleDuplicateCharge:
+		return financev1.Alert_DUPLICATE_CHARGE
+	default:
+		return financev1.Alert_RULE_UNSPECIFIED
+	}
+}
+
+func fromProtoAlertRule(r financev1.Alert_Rule) (finance.AlertRule, error) {
+	switch r {
+	case financev1.Alert_BUDGET_THRESHOLD:
+		return finance.AlertRuleBudgetThreshold, nil
+	case financev1.Alert_PROJECTED_OVERSPEND:
+		return finance.AlertRuleProjectedOverspend, nil
+	case financev1.Alert_AMOUNT_ANOMALY:
+		return finance.AlertRuleAmountAnomaly, nil
+	case financev1.Alert_DUPLICATE_CHARGE:
+		return finance.AlertRuleDuplicateCharge, nil
+	default:
+		return "", status.Errorf(codes.InvalidArgument, "invalid alert rule: %s", r)
+	}
+}
+
+func toProtoAlertStatus(s finance.AlertStatus) financev1.Alert_Status {
+	switch s {
+	case finance.AlertStatusOpen:
+		return financev1.Alert_OPEN
+	case finance.AlertStatusAcknowledged:
+		return financev1.Alert_ACKNOWLEDGED
+	case finance.AlertStatusSnoozed:
+		return financev1.Alert_SNOOZED
+	default:
+		return financev1.Alert_STATUS_UNSPECIFIED
+	}
+}
+
+func toProtoAlert(a *finance.Alert) *financev1.Alert {
+	if a == nil {
+		return nil
+	}
+	pb := &financev1.Alert{
+		Id:         string(a.ID),
+		Rule:       toProtoAlertRule(a.Rule),
+		Status:     toProtoAlertStatus(a.Status),
+		Message:    a.Message,
+		Amount:     a.Amount,
+		Threshold:  a.Threshold,
+		Currency:   string(a.Currency),
+		CreateTime: timestamppb.New(a.CreateTime),
+		UpdateTime: timestamppb.New(a.UpdateTime),
+	}
+	if a.BudgetID != nil {
+		v := string(*a.BudgetID)
+		pb.BudgetId = &v
+	}
+	if a.PeriodID != nil {
+		v := string(*a.PeriodID)
+		pb.PeriodId = &v
+	}
+	if a.TransactionID != nil {
+		v := string(*a.TransactionID)
+		pb.TransactionId = &v
+	}
+	if a.RelatedTransactionID != nil {
+		v := string(*a.RelatedTransactionID)
+		pb.RelatedTransactionId = &v
+	}
+	if a.SnoozedUntil != nil {
+		pb.SnoozeUntil = timestamppb.New(*a.SnoozedUntil)
+	}
+	if a.AcknowledgeTime != nil {
+		pb.AcknowledgeTime = timestamppb.New(*a.AcknowledgeTime)
+	}
+	return pb
+}
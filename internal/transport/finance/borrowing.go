@@ -47,17 +47,28 @@ func (h *Handler) CreateBorrowing(ctx context.Context, req *financev1.CreateBorr
 		accountID = &idVal
 	}
 
+	plan, err := toDomainInstallmentPlan(input)
+	if err != nil {
+		return nil, err
+	}
+
 	appReq := &financeapp.CreateBorrowingRequest{
-		Direction:           toDomainBorrowingDirection(input.GetDirection()),
-		Counterparty:        input.GetCounterparty(),
-		ContactInfo:         input.GetContactInfo(),
-		TotalAmount:         input.GetTotalAmount(),
-		Currency:            string(currency),
-		EstablishedAt:       establishedAt,
-		DueAt:               dueAt,
-		Notes:               input.GetNotes(),
-		CreateAsTransaction: input.GetCreateAsTransaction(),
-		AccountID:           accountID,
+		Direction:            toDomainBorrowingDirection(input.GetDirection()),
+		Counterparty:         input.GetCounterparty(),
+		ContactInfo:          input.GetContactInfo(),
+		TotalAmount:          input.GetTotalAmount(),
+		Currency:             string(currency),
+		EstablishedAt:        establishedAt,
+		DueAt:                dueAt,
+		Notes:                input.GetNotes(),
+		CreateAsTransaction:  input.GetCreateAsTransaction(),
+		AccountID:            accountID,
+		InterestType:         plan.InterestType,
+		InterestRateBps:      plan.InterestRateBps,
+		InstallmentCount:     plan.InstallmentCount,
+		InstallmentFrequency: plan.InstallmentFrequency,
+		FirstInstallmentDate: plan.FirstInstallmentDate,
+		BudgetID:             plan.BudgetID,
 	}
 
 	b, err := h.Coordinator.CreateBorrowing(ctx, appReq)
@@ -178,17 +189,28 @@ func (h *Handler) UpdateBorrowing(ctx context.Context, req *financev1.UpdateBorr
 		accountID = &idVal
 	}
 
+	plan, err := toDomainInstallmentPlan(input)
+	if err != nil {
+		return nil, err
+	}
+
 	appReq := &financeapp.UpdateBorrowingRequest{
-		ID:            bID,
-		Direction:     toDomainBorrowingDirection(input.GetDirection()),
-		Counterparty:  input.GetCounterparty(),
-		ContactInfo:   input.GetContactInfo(),
-		TotalAmount:   input.GetTotalAmount(),
-		Currency:      string(currency),
-		EstablishedAt: establishedAt,
-		DueAt:         dueAt,
-		Notes:         input.GetNotes(),
-		AccountID:     accountID,
+		ID:                   bID,
+		Direction:            toDomainBorrowingDirection(input.GetDirection()),
+		Counterparty:         input.GetCounterparty(),
+		ContactInfo:          input.GetContactInfo(),
+		TotalAmount:          input.GetTotalAmount(),
+		Currency:             string(currency),
+		EstablishedAt:        establishedAt,
+		DueAt:                dueAt,
+		Notes:                input.GetNotes(),
+		AccountID:            accountID,
+		InterestType:         plan.InterestType,
+		InterestRateBps:      plan.InterestRateBps,
+		InstallmentCount:     plan.InstallmentCount,
+		InstallmentFrequency: plan.InstallmentFrequency,
+		FirstInstallmentDate: plan.FirstInstallmentDate,
+		BudgetID:             plan.BudgetID,
 	}
 
 	b, err := h.Coordinator.UpdateBorrowing(ctx, appReq)
@@ -345,22 +367,158 @@ func toProtoBorrowing(b *finance.Borrowing) *financev1.Borrowing {
 		dueAt = timestamppb.New(*b.DueAt)
 	}
 
-	return &financev1.Borrowing{
-		Id:                  string(b.ID),
-		SpaceId:             string(b.SpaceID),
-		Direction:           toProtoBorrowingDirection(b.Direction),
-		Counterparty:        b.Counterparty,
-		ContactInfo:         b.ContactInfo,
-		TotalAmount:         b.TotalAmount,
-		RemainingAmount:     b.RemainingAmount,
-		Currency:            string(b.Currency),
-		Status:              toProtoBorrowingStatus(b.Status),
-		EstablishedAt:       timestamppb.New(b.EstablishedAt),
-		DueAt:               dueAt,
-		Notes:               b.Notes,
-		CreateTime:          timestamppb.New(b.CreateTime),
-		UpdateTime:          timestamppb.New(b.UpdateTime),
-		CreateAsTransaction: false,
+	pb := &financev1.Borrowing{
+		Id:                   string(b.ID),
+		SpaceId:              string(b.SpaceID),
+		Direction:            toProtoBorrowingDirection(b.Direction),
+		Counterparty:         b.Counterparty,
+		ContactInfo:          b.ContactInfo,
+		TotalAmount:          b.TotalAmount,
+		RemainingAmount:      b.RemainingAmount,
+		Currency:             string(b.Currency),
+		Status:               toProtoBorrowingStatus(b.Status),
+		EstablishedAt:        timestamppb.New(b.EstablishedAt),
+		DueAt:                dueAt,
+		Notes:                b.Notes,
+		CreateTime:           timestamppb.New(b.CreateTime),
+		UpdateTime:           timestamppb.New(b.UpdateTime),
+		CreateAsTransaction:  false,
+		InterestType:         toProtoInterestType(b.InterestType),
+		InterestRateBps:      b.InterestRateBps,
+		InstallmentCount:     b.InstallmentCount,
+		InstallmentFrequency: toProtoInstallmentFrequency(b.InstallmentFrequency),
+		BudgetId:             (*string)(b.BudgetID),
+		PaidAmount:           b.PaidAmount,
+	}
+	if b.FirstInstallmentDate != nil {
+		pb.FirstInstallmentDate = timestamppb.New(*b.FirstInstallmentDate)
+	}
+
+	if p := b.Progress; p != nil {
+		pb.Installments = make([]*financev1.BorrowingInstallment, 0, len(p.Schedule))
+		for i := range p.Schedule {
+			pb.Installments = append(pb.Installments, toProtoBorrowingInstallment(&p.Schedule[i]))
+		}
+		pb.TotalPayable = p.TotalPayable
+		pb.TotalInterest = p.TotalInterest
+		pb.AccruedInterest = p.AccruedInterest
+		pb.InterestPaid = p.InterestPaid
+		pb.OverdueInstallmentCount = p.OverdueCount
+		pb.OverdueAmount = p.OverdueAmount
+		pb.NextInstallment = toProtoBorrowingInstallment(p.NextInstallment)
+	}
+
+	return pb
+}
+
+// toDomainInstallmentPlan extracts the instalment plan fields of a borrowing payload.
+func toDomainInstallmentPlan(input *financev1.Borrowing) (*finance.Borrowing, error) {
+	plan := &finance.Borrowing{
+		InterestRateBps:  input.GetInterestRateBps(),
+		InstallmentCount: input.GetInstallmentCount(),
+	}
+
+	switch input.GetInterestType() {
+	case financev1.Borrowing_INTEREST_TYPE_UNSPECIFIED, financev1.Borrowing_NO_INTEREST:
+		plan.InterestType = finance.InterestTypeNone
+	case financev1.Borrowing_SIMPLE:
+		plan.InterestType = finance.InterestTypeSimple
+	case financev1.Borrowing_COMPOUND:
+		plan.InterestType = finance.InterestTypeCompound
+	default:
+		return nil, status.Error(codes.InvalidArgument, "invalid interest type")
+	}
+
+	switch input.GetInstallmentFrequency() {
+	case financev1.Borrowing_INSTALLMENT_FREQUENCY_UNSPECIFIED:
+	case financev1.Borrowing_WEEKLY:
+		plan.InstallmentFrequency = finance.InstallmentFrequencyWeekly
+	case financev1.Borrowing_BIWEEKLY:
+		plan.InstallmentFrequency = finance.InstallmentFrequencyBiweekly
+	case financev1.Borrowing_MONTHLY:
+		plan.InstallmentFrequency = finance.InstallmentFrequencyMonthly
+	case financev1.Borrowing_QUARTERLY:
+		plan.InstallmentFrequency = finance.InstallmentFrequencyQuarterly
+	case financev1.Borrowing_YEARLY:
+		plan.InstallmentFrequency = finance.InstallmentFrequencyYearly
+	default:
+		return nil, status.Error(codes.InvalidArgument, "invalid instalment frequency")
+	}
+
+	if input.GetFirstInstallmentDate() != nil {
+		t := input.GetFirstInstallmentDate().AsTime()
+		plan.FirstInstallmentDate = &t
+	}
+
+	if input.BudgetId != nil && *input.BudgetId != "" {
+		budgetID, err := finance.ParseBudgetID(*input.BudgetId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		plan.BudgetID = &budgetID
+	}
+
+	return plan, nil
+}
+
+func toProtoInterestType(t finance.InterestType) financev1.Borrowing_InterestType {
+	switch t {
+	case finance.InterestTypeNone, "":
+		return financev1.Borrowing_NO_INTEREST
+	case finance.InterestTypeSimple:
+		return financev1.Borrowing_SIMPLE
+	case finance.InterestTypeCompound:
+		return financev1.Borrowing_COMPOUND
+	default:
+		return financev1.Borrowing_INTEREST_TYPE_UNSPECIFIED
+	}
+}
+
+func toProtoInstallmentFrequency(f finance.InstallmentFrequency) financev1.Borrowing_InstallmentFrequency {
+	switch f {
+	case finance.InstallmentFrequencyWeekly:
+		return financev1.Borrowing_WEEKLY
+	case finance.InstallmentFrequencyBiweekly:
+		return financev1.Borrowing_BIWEEKLY
+	case finance.InstallmentFrequencyMonthly:
+		return financev1.Borrowing_MONTHLY
+	case finance.InstallmentFrequencyQuarterly:
+		return financev1.Borrowing_QUARTERLY
+	case finance.InstallmentFrequencyYearly:
+		return financev1.Borrowing_YEARLY
+	default:
+		return financev1.Borrowing_INSTALLMENT_FREQUENCY_UNSPECIFIED
+	}
+}
+
+func toProtoInstallmentStatus(s finance.InstallmentStatus) financev1.BorrowingInstallment_Status {
+	switch s {
+	case finance.InstallmentStatusPaid:
+		return financev1.BorrowingInstallment_PAID
+	case finance.InstallmentStatusPartial:
+		return financev1.BorrowingInstallment_PARTIAL
+	case finance.InstallmentStatusOverdue:
+		return financev1.BorrowingInstallment_OVERDUE
+	case finance.InstallmentStatusUpcoming:
+		return financev1.BorrowingInstallment_UPCOMING
+	default:
+		return financev1.BorrowingInstallment_STATUS_UNSPECIFIED
+	}
+}
+
+func toProtoBorrowingInstallment(e *finance.AmortizationEntry) *financev1.BorrowingInstallment {
+	if e == nil {
+		return nil
+	}
+	return &financev1.BorrowingInstallment{
+		Number:           e.Number,
+		DueDate:          timestamppb.New(e.DueDate),
+		Payment:          e.Payment,
+		Principal:        e.Principal,
+		Interest:         e.Interest,
+		RemainingBalance: e.RemainingBalance,
+		PaidAmount:       e.PaidAmount,
+		Status:           toProtoInstallmentStatus(e.Status),
 	}
 }
 
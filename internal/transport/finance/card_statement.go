@@ -0,0 +1,92 @@
+package finance
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	financev1 "github.com/masterkeysrd/saturn/apis/saturn/finance/v1"
+	"github.com/masterkeysrd/saturn/internal/domain/finance"
+)
+
+func (h *Handler) ListCardStatements(ctx context.Context, req *financev1.ListCardStatementsRequest) (*financev1.ListCardStatementsResponse, error) {
+	accountID, err := finance.ParseAccountID(req.GetAccountId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	list, err := h.Coordinator.ListCardStatements(ctx, accountID, &finance.ListCardStatementsFilter{
+		UnpaidOnly: req.GetUnpaidOnly(),
+		PageSize:   req.GetPageSize(),
+	})
+	if err != nil {
+		return nil, h.mapError(err)
+	}
+
+	items := make([]*financev1.CardStatement, 0, len(list))
+	for _, st := range list {
+		items = append(items, toProtoCardStatement(st))
+	}
+
+	return &financev1.ListCardStatementsResponse{CardStatements: items}, nil
+}
+
+func (h *Handler) GetCardStatement(ctx context.Context, req *financev1.GetCardStatementRequest) (*financev1.CardStatement, error) {
+	id, err := finance.ParseCardStatementID(req.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	st, err := h.Coordinator.GetCardStatement(ctx, id)
+	if err != nil {
+		return nil, h.mapError(err)
+	}
+
+	return toProtoCardStatement(st), nil
+}
+
+func toProtoCardStatementStatus(s finance.CardStatementStatus) financev1.CardStatement_Status {
+	switch s {
+	case finance.CardStatementStatusIssued:
+		return financev1.CardStatement_ISSUED
+	case finance.CardStatementStatusPartiallyPaid:
+		return financev1.CardStatement_PARTIALLY_PAID
+	case finance.CardStatementStatusPaid:
+		return financev1.CardStatement_PAID
+	default:
+		return financev1.CardStatement_STATUS_UNSPECIFIED
+	}
+}
+
+func toProtoCardStatement(st *finance.CardStatement) *financev1.CardStatement {
+	if st == nil {
+		return nil
+	}
+	var paymentID string
+	if st.ScheduledPaymentID != nil {
+		paymentID = string(*st.ScheduledPaymentID)
+	}
+	return &financev1.CardStatement{
+		Id:                 string(st.ID),
+		SpaceId:            string(st.SpaceID),
+		AccountId:          string(st.AccountID),
+		Currency:           string(st.Currency),
+		PeriodStart:        timestamppb.New(st.PeriodStart),
+		ClosingDate:        timestamppb.New(st.ClosingDate),
+		DueDate:            timestamppb.New(st.DueDate),
+		OpeningBalance:     st.OpeningBalance,
+		Purchases:          st.Purchases,
+		Credits:            st.Credits,
+		StatementBalance:   st.StatementBalance,
+		MinimumPayment:     st.MinimumPayment,
+		PaidAmount:         st.PaidAmount,
+		CreditLimit:        st.CreditLimit,
+		Utilization:        st.Utilization,
+		Status:             toProtoCardStatementStatus(st.Status),
+		ScheduledPaymentId: paymentID,
+		CreateTime:         timestamppb.New(st.CreateTime),
+		UpdateTime:         timestamppb.New(st.UpdateTime),
+	}
+}
@@ -0,0 +1,169 @@
+package finance
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	financev1 "github.com/masterkeysrd/saturn/apis/saturn/finance/v1"
+	financeapp "github.com/masterkeysrd/saturn/internal/application/finance"
+	"github.com/masterkeysrd/saturn/internal/domain/finance"
+	"github.com/masterkeysrd/saturn/internal/platform/sorting"
+)
+
+func (h *Handler) CreateCategory(ctx context.Context, req *financev1.CreateCategoryRequest) (*financev1.Category, error) {
+	cat := req.GetCategory()
+	if cat == nil {
+		return nil, status.Error(codes.InvalidArgument, "category is required")
+	}
+
+	parentID, err := parseOptionalCategoryID(cat.ParentId)
+	if err != nil {
+		return nil, err
+	}
+
+	appReq := &financeapp.CreateCategoryRequest{
+		ParentID: parentID,
+		Name:     cat.GetName(),
+		Icon:     cat.GetIcon(),
+		Color:    cat.GetColor(),
+	}
+
+	created, err := h.Coordinator.CreateCategory(ctx, appReq)
+	if err != nil {
+		return nil, h.mapError(err)
+	}
+
+	return toProtoCategory(created), nil
+}
+
+func (h *Handler) GetCategory(ctx context.Context, req *financev1.GetCategoryRequest) (*financev1.Category, error) {
+	id, err := finance.ParseCategoryID(req.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	cat, err := h.Coordinator.GetCategory(ctx, id)
+	if err != nil {
+		return nil, h.mapError(err)
+	}
+
+	return toProtoCategory(cat), nil
+}
+
+func (h *Handler) UpdateCategory(ctx context.Context, req *financev1.UpdateCategoryRequest) (*financev1.Category, error) {
+	cat := req.GetCategory()
+	if cat == nil {
+		return nil, status.Error(codes.InvalidArgument, "category is required")
+	}
+
+	id, err := finance.ParseCategoryID(req.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	parentID, err := parseOptionalCategoryID(cat.ParentId)
+	if err != nil {
+		return nil, err
+	}
+
+	appReq := &financeapp.UpdateCategoryRequest{
+		ID:       id,
+		ParentID: parentID,
+		Name:     cat.GetName(),
+		Icon:     cat.GetIcon(),
+		Color:    cat.GetColor(),
+	}
+
+	updated, err := h.Coordinator.UpdateCategory(ctx, appReq)
+	if err != nil {
+		return nil, h.mapError(err)
+	}
+
+	return toProtoCategory(updated), nil
+}
+
+func (h *Handler) DeleteCategory(ctx context.Context, req *financev1.DeleteCategoryRequest) (*emptypb.Empty, error) {
+	id, err := finance.ParseCategoryID(req.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := h.Coordinator.DeleteCategory(ctx, id); err != nil {
+		return nil, h.mapError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *Handler) ListCategories(ctx context.Context, req *financev1.ListCategoriesRequest) (*financev1.ListCategoriesResponse, error) {
+	parentID, err := parseOptionalCategoryID(req.ParentId)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := &finance.ListCategoriesFilter{
+		PageSize:      req.GetPageSize(),
+		NextPageToken: req.GetPageToken(),
+		ParentID:      parentID,
+		TopLevelOnly:  req.GetTopLevelOnly(),
+		SearchQuery:   req.SearchQuery,
+		Sort:          sorting.Parse(req.GetSort()),
+	}
+
+	page, err := h.Coordinator.ListCategories(ctx, filter)
+	if err != nil {
+		return nil, h.mapError(err)
+	}
+
+	protoCategories := make([]*financev1.Category, 0, len(page.Items))
+	for _, c := range page.Items {
+		protoCategories = append(protoCategories, toProtoCategory(c))
+	}
+
+	return &financev1.ListCategoriesResponse{
+		Categories:    protoCategories,
+		NextPageToken: page.NextPageToken,
+	}, nil
+}
+
+// --- Mappers ---
+
+func parseOptionalCategoryID(raw *string) (*finance.CategoryID, error) {
+	if raw == nil || *raw == "" {
+		return nil, nil
+	}
+
+	id, err := finance.ParseCategoryID(*raw)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &id, nil
+}
+
+func toProtoCategory(c *finance.Category) *financev1.Category {
+	if c == nil {
+		return nil
+	}
+
+	var parentID *string
+	if c.ParentID != nil {
+		idStr := string(*c.ParentID)
+		parentID = &idStr
+	}
+
+	return &financev1.Category{
+		Id:         string(c.ID),
+		SpaceId:    string(c.SpaceID),
+		ParentId:   parentID,
+		Name:       c.Name,
+		Icon:       c.Icon,
+		Color:      c.Color,
+		CreateTime: timestamppb.New(c.CreateTime),
+		UpdateTime: timestamppb.New(c.UpdateTime),
+	}
+}
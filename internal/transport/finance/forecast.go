@@ -0,0 +1,129 @@
+package finance
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	financev1 "github.com/masterkeysrd/saturn/apis/saturn/finance/v1"
+	financeapp "github.com/masterkeysrd/saturn/internal/application/finance"
+	"github.com/masterkeysrd/saturn/internal/domain/finance"
+)
+
+func (h *Handler) ForecastCashFlow(ctx context.Context, req *financev1.ForecastCashFlowRequest) (*financev1.ForecastCashFlowResponse, error) {
+	if req.GetDays() < 0 || req.GetDays() > finance.MaxForecastDays {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("days must be between 1 and %d", finance.MaxForecastDays))
+	}
+
+	accountIDs := make([]finance.AccountID, 0, len(req.GetAccountIds()))
+	for _, raw := range req.GetAccountIds() {
+		accountID, err := finance.ParseAccountID(raw)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		accountIDs = append(accountIDs, accountID)
+	}
+
+	forecast, err := h.Coordinator.ForecastCashFlow(ctx, &financeapp.ForecastCashFlowRequest{
+		Days:       req.GetDays(),
+		AccountIDs: accountIDs,
+	})
+	if err != nil {
+		return nil, h.mapError(err)
+	}
+
+	return toProtoCashFlowForecast(forecast), nil
+}
+
+func toProtoCashFlowForecast(f *finance.CashFlowForecast) *financev1.ForecastCashFlowResponse {
+	accounts := make([]*financev1.AccountForecast, 0, len(f.Accounts))
+	for _, af := range f.Accounts {
+		days := make([]*financev1.ForecastDay, 0, len(af.Days))
+		for _, d := range af.Days {
+			days = append(days, &financev1.ForecastDay{
+				Date:      timestamppb.New(d.Date),
+				Balance:   d.Balance,
+				Available: d.Available,
+				Inflow:    d.Inflow,
+				Outflow:   d.Outflow,
+			})
+		}
+		accounts = append(accounts, &financev1.AccountForecast{
+			AccountId:           string(af.Account.ID),
+			AccountName:         af.Account.Name,
+			Currency:            string(af.Account.Currency),
+			StartingBalance:     af.StartingBalance,
+			LowestAvailable:     af.LowestAvailable,
+			LowestDate:          timestamppb.New(af.LowestDate),
+			LowBalanceThreshold: af.Account.LowBalanceThreshold,
+			Days:                days,
+		})
+	}
+
+	events := make([]*financev1.ForecastEvent, 0, len(f.Events))
+	for _, e := range f.Events {
+		var accountID string
+		if e.AccountID != nil {
+			accountID = string(*e.AccountID)
+		}
+		events = append(events, &financev1.ForecastEvent{
+			Date:        timestamppb.New(e.Date),
+			AccountId:   accountID,
+			Kind:        toProtoForecastEventKind(e.Kind),
+			SourceId:    e.SourceID,
+			Description: e.Description,
+			Amount:      e.Amount,
+			Estimated:   e.Estimated,
+		})
+	}
+
+	alerts := make([]*financev1.ForecastAlert, 0, len(f.Alerts))
+	for _, a := range f.Alerts {
+		alerts = append(alerts, &financev1.ForecastAlert{
+			AccountId: string(a.AccountID),
+			Date:      timestamppb.New(a.Date),
+			Kind:      toProtoForecastAlertKind(a.Kind),
+			Available: a.Available,
+			Threshold: a.Threshold,
+		})
+	}
+
+	return &financev1.ForecastCashFlowResponse{
+		StartDate: timestamppb.New(f.StartDate),
+		EndDate:   timestamppb.New(f.EndDate),
+		Accounts:  accounts,
+		Events:    events,
+		Alerts:    alerts,
+	}
+}
+
+func toProtoForecastEventKind(k finance.ForecastEventKind) financev1.ForecastEvent_Kind {
+	switch k {
+	case finance.ForecastEventScheduledPayment:
+		return financev1.ForecastEvent_SCHEDULED_PAYMENT
+	case finance.ForecastEventRecurringExpense:
+		return financev1.ForecastEvent_RECURRING_EXPENSE
+	case finance.ForecastEventLoanInstallment:
+		return financev1.ForecastEvent_LOAN_INSTALLMENT
+	case finance.ForecastEventCardPayment:
+		return financev1.ForecastEvent_CARD_PAYMENT
+	case finance.ForecastEventIncome:
+		return financev1.ForecastEvent_INCOME
+	default:
+		return financev1.ForecastEvent_KIND_UNSPECIFIED
+	}
+}
+
+func toProtoForecastAlertKind(k finance.ForecastAlertKind) financev1.ForecastAlert_Kind {
+	switch k {
+	case finance.ForecastAlertBelowZero:
+		return financev1.ForecastAlert_BELOW_ZERO
+	case finance.ForecastAlertBelowThreshold:
+		return financev1.ForecastAlert_BELOW_THRESHOLD
+	default:
+		return financev1.ForecastAlert_KIND_UNSPECIFIED
+	}
+}
@@ -0,0 +1,301 @@
+package finance
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	financev1 "github.com/masterkeysrd/saturn/apis/saturn/finance/v1"
+	financeapp "github.com/masterkeysrd/saturn/internal/application/finance"
+	"github.com/masterkeysrd/saturn/internal/domain/finance"
+	"github.com/masterkeysrd/saturn/internal/platform/sorting"
+)
+
+func (h *Handler) CreateGoal(ctx context.Context, req *financev1.CreateGoalRequest) (*financev1.Goal, error) {
+	g := req.GetGoal()
+	if g == nil {
+		return nil, status.Error(codes.InvalidArgument, "goal is required")
+	}
+
+	currency, err := finance.ParseCurrency(g.GetCurrency())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	accountID, err := parseGoalAccountID(g)
+	if err != nil {
+		return nil, err
+	}
+
+	appReq := &financeapp.CreateGoalRequest{
+		Name:         g.GetName(),
+		Description:  g.GetDescription(),
+		TargetAmount: g.GetTargetAmount(),
+		Currency:     currency,
+		StartDate:    goalStartDate(g),
+		Deadline:     goalDeadline(g),
+		AccountID:    accountID,
+		Tag:          g.GetTag(),
+		Color:        g.GetColor(),
+		Icon:         g.GetIcon(),
+	}
+
+	created, err := h.Coordinator.CreateGoal(ctx, appReq)
+	if err != nil {
+		return nil, h.mapError(err)
+	}
+
+	return toProtoGoal(created), nil
+}
+
+func (h *Handler) GetGoal(ctx context.Context, req *financev1.GetGoalRequest) (*financev1.Goal, error) {
+	id, err := finance.ParseGoalID(req.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	goal, err := h.Coordinator.GetGoal(ctx, id)
+	if err != nil {
+		return nil, h.mapError(err)
+	}
+
+	return toProtoGoal(goal), nil
+}
+
+func (h *Handler) UpdateGoal(ctx context.Context, req *financev1.UpdateGoalRequest) (*financev1.Goal, error) {
+	g := req.GetGoal()
+	if g == nil {
+		return nil, status.Error(codes.InvalidArgument, "goal is required")
+	}
+
+	id, err := finance.ParseGoalID(req.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	currency, err := finance.ParseCurrency(g.GetCurrency())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	accountID, err := parseGoalAccountID(g)
+	if err != nil {
+		return nil, err
+	}
+
+	appReq := &financeapp.UpdateGoalRequest{
+		ID:           id,
+		Name:         g.GetName(),
+		Description:  g.GetDescription(),
+		TargetAmount: g.GetTargetAmount(),
+		Currency:     currency,
+		StartDate:    goalStartDate(g),
+		Deadline:     goalDeadline(g),
+		AccountID:    accountID,
+		Tag:          g.GetTag(),
+		Color:        g.GetColor(),
+		Icon:         g.GetIcon(),
+		Status:       toDomainGoalStatus(g.GetStatus()),
+	}
+
+	updated, err := h.Coordinator.UpdateGoal(ctx, appReq)
+	if err != nil {
+		return nil, h.mapError(err)
+	}
+
+	return toProtoGoal(updated), nil
+}
+
+func (h *Handler) DeleteGoal(ctx context.Context, req *financev1.DeleteGoalRequest) (*emptypb.Empty, error) {
+	id, err := finance.ParseGoalID(req.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := h.Coordinator.DeleteGoal(ctx, id); err != nil {
+		return nil, h.mapError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *Handler) ListGoals(ctx context.Context, req *financev1.ListGoalsRequest) (*financev1.ListGoalsResponse, error) {
+	filter := &finance.ListGoalsFilter{
+		PageSize:      req.GetPageSize(),
+		NextPageToken: req.GetPageToken(),
+		SearchQuery:   req.SearchQuery,
+		Sort:          sorting.Parse(req.GetSort()),
+	}
+	if req.Status != nil {
+		goalStatus := toDomainGoalStatus(req.GetStatus())
+		if goalStatus == "" {
+			return nil, status.Error(codes.InvalidArgument, "invalid goal status")
+		}
+		filter.Status = &goalStatus
+	}
+
+	page, err := h.Coordinator.ListGoals(ctx, filter)
+	if err != nil {
+		return nil, h.mapError(err)
+	}
+
+	protoGoals := make([]*financev1.Goal, 0, len(page.Items))
+	for _, g := range page.Items {
+		protoGoals = append(protoGoals, toProtoGoal(g))
+	}
+
+	return &financev1.ListGoalsResponse{
+		Goals:         protoGoals,
+		NextPageToken: page.NextPageToken,
+	}, nil
+}
+
+func (h *Handler) GetGoalProgress(ctx context.Context, req *financev1.GetGoalProgressRequest) (*financev1.GoalProgress, error) {
+	id, err := finance.ParseGoalID(req.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	progress, err := h.Coordinator.GetGoalProgress(ctx, id)
+	if err != nil {
+		return nil, h.mapError(err)
+	}
+
+	return toProtoGoalProgress(progress), nil
+}
+
+// --- Mappers ---
+
+func parseGoalAccountID(g *financev1.Goal) (*finance.AccountID, error) {
+	if g.AccountId == nil || *g.AccountId == "" {
+		return nil, nil
+	}
+	accountID, err := finance.ParseAccountID(*g.AccountId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &accountID, nil
+}
+
+func goalStartDate(g *financev1.Goal) time.Time {
+	if g.GetStartDate() == nil {
+		return time.Time{}
+	}
+	return g.GetStartDate().AsTime().UTC()
+}
+
+func goalDeadline(g *financev1.Goal) *time.Time {
+	if g.GetDeadline() == nil {
+		return nil
+	}
+	deadline := g.GetDeadline().AsTime().UTC()
+	return &deadline
+}
+
+func toDomainGoalStatus(s financev1.Goal_Status) finance.GoalStatus {
+	switch s {
+	case financev1.Goal_ACTIVE:
+		return finance.GoalStatusActive
+	case financev1.Goal_COMPLETED:
+		return finance.GoalStatusCompleted
+	case financev1.Goal_ARCHIVED:
+		return finance.GoalStatusArchived
+	default:
+		return ""
+	}
+}
+
+func toProtoGoalStatus(s finance.GoalStatus) financev1.Goal_Status {
+	switch s {
+	case finance.GoalStatusActive:
+		return financev1.Goal_ACTIVE
+	case finance.GoalStatusCompleted:
+		return financev1.Goal_COMPLETED
+	case finance.GoalStatusArchived:
+		return financev1.Goal_ARCHIVED
+	default:
+		return financev1.Goal_STATUS_UNSPECIFIED
+	}
+}
+
+func toProtoGoal(g *finance.Goal) *financev1.Goal {
+	if g == nil {
+		return nil
+	}
+
+	var accountID *string
+	if g.AccountID != nil {
+		idStr := string(*g.AccountID)
+		accountID = &idStr
+	}
+
+	var deadline *timestamppb.Timestamp
+	if g.Deadline != nil {
+		deadline = timestamppb.New(*g.Deadline)
+	}
+
+	return &financev1.Goal{
+		Id:           string(g.ID),
+		SpaceId:      string(g.SpaceID),
+		Name:         g.Name,
+		Description:  g.Description,
+		TargetAmount: g.TargetAmount,
+		Currency:     string(g.Currency),
+		StartDate:    timestamppb.New(g.StartDate),
+		Deadline:     deadline,
+		AccountId:    accountID,
+		Tag:          g.Tag,
+		Color:        g.Color,
+		Icon:         g.Icon,
+		Status:       toProtoGoalStatus(g.Status),
+		CreateTime:   timestamppb.New(g.CreateTime),
+		UpdateTime:   timestamppb.New(g.UpdateTime),
+	}
+}
+
+func toProtoGoalProgress(p *finance.GoalProgress) *financev1.GoalProgress {
+	if p == nil {
+		return nil
+	}
+
+	var projected *timestamppb.Timestamp
+	if p.ProjectedCompletionDate != nil {
+		projected = timestamppb.New(*p.ProjectedCompletionDate)
+	}
+
+	contributions := make([]*financev1.GoalProgress_Contribution, 0, len(p.Contributions))
+	for _, c := range p.Contributions {
+		contributions = append(contributions, &financev1.GoalProgress_Contribution{
+			TransactionId:   string(c.TransactionID),
+			Type:            toProtoTransactionType(c.Type),
+			Description:     c.Description,
+			Amount:          c.Amount,
+			TransactionDate: timestamppb.New(c.TransactionDate),
+		})
+	}
+
+	return &financev1.GoalProgress{
+		Goal:                        toProtoGoal(p.Goal),
+		SavedAmount:                 p.SavedAmount,
+		RemainingAmount:             p.RemainingAmount,
+		Percentage:                  p.Percentage,
+		ContributionCount:           p.ContributionCount,
+		MonthlyVelocity:             p.MonthlyVelocity,
+		ProjectedCompletionDate:     projected,
+		RequiredMonthlyContribution: p.RequiredMonthlyContribution,
+		OnTrack:                     p.OnTrack,
+		Contributions:               contributions,
+	}
+}
+
+func toProtoGoalProgressList(list []*finance.GoalProgress) []*financev1.GoalProgress {
+	out := make([]*financev1.GoalProgress, 0, len(list))
+	for _, p := range list {
+		out = append(out, toProtoGoalProgress(p))
+	}
+	return out
+}
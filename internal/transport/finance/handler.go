@@ -18,6 +18,7 @@ import (
 	"github.com/masterkeysrd/saturn/internal/domain/finance"
 	"github.com/masterkeysrd/saturn/internal/foundation/auth"
 	"github.com/masterkeysrd/saturn/internal/platform/conv"
+	"github.com/masterkeysrd/saturn/internal/platform/scheduler"
 	"github.com/masterkeysrd/saturn/internal/platform/sorting"
 )
 
@@ -26,13 +27,15 @@ type Handler struct {
 	financev1.UnimplementedFinanceServer
 	Coordinator *financeapp.Coordinator
 	Aggregator  *financeaggregator.Service
+	Scheduler   scheduler.Scheduler
 }
 
 // NewHandler creates a new Handler.
-func NewHandler(coordinator *financeapp.Coordinator, financeAggregator *financeaggregator.Service) *Handler {
+func NewHandler(coordinator *financeapp.Coordinator, financeAggregator *financeaggregator.Service, sched scheduler.Scheduler) *Handler {
 	return &Handler{
 		Coordinator: coordinator,
 		Aggregator:  financeAggregator,
+		Scheduler:   sched,
 	}
 }
 
@@ -40,17 +43,34 @@ func NewHandler(coordinator *financeapp.Coordinator, financeAggregator *financea
 
 func toProtoSettings(s *finance.FinanceSettings) *financev1.FinanceSettings {
 	return &financev1.FinanceSettings{
-		SpaceId:      string(s.SpaceID),
-		BaseCurrency: string(s.BaseCurrency),
-		CreateTime:   timestamppb.New(s.CreateTime),
-		UpdateTime:   timestamppb.New(s.UpdateTime),
+		SpaceId:              string(s.SpaceID),
+		BaseCurrency:         string(s.BaseCurrency),
+		EnabledCurrencies:    currencyCodes(s.EnabledCurrencies),
+		Timezone:             s.Timezone,
+		BudgetAlertPercents:  s.BudgetAlertPercents,
+		AnomalyStdDevs:       s.AnomalyStdDevs,
+		DuplicateWindowHours: s.DuplicateWindowHours,
+		CreateTime:           timestamppb.New(s.CreateTime),
+		UpdateTime:           timestamppb.New(s.UpdateTime),
 	}
 }
 
+func currencyCodes(currencies []finance.Currency) []string {
+	out := make([]string, len(currencies))
+	for i, c := range currencies {
+		out[i] = string(c)
+	}
+	return out
+}
+
 func toProtoInterval(interval finance.RecurrenceInterval) financev1.Budget_RecurrenceInterval {
 	switch interval {
 	case finance.IntervalWeekly:
 		return financev1.Budget_WEEKLY
+	case finance.IntervalBiweekly:
+		return financev1.Budget_BIWEEKLY
+	case finance.IntervalQuarterly:
+		return financev1.Budget_QUARTERLY
 	case finance.IntervalYearly:
 		return financev1.Budget_YEARLY
 	case finance.IntervalMonthly:
@@ -64,6 +84,10 @@ func toDomainInterval(interval financev1.Budget_RecurrenceInterval) finance.Recu
 	switch interval {
 	case financev1.Budget_WEEKLY:
 		return finance.IntervalWeekly
+	case financev1.Budget_BIWEEKLY:
+		return finance.IntervalBiweekly
+	case financev1.Budget_QUARTERLY:
+		return finance.IntervalQuarterly
 	case financev1.Budget_YEARLY:
 		return finance.IntervalYearly
 	case financev1.Budget_MONTHLY:
@@ -73,6 +97,36 @@ func toDomainInterval(interval financev1.Budget_RecurrenceInterval) finance.Recu
 	}
 }
 
+func toProtoRolloverPolicy(p finance.RolloverPolicy) financev1.Budget_RolloverPolicy {
+	switch p {
+	case finance.RolloverNone:
+		return financev1.Budget_NONE
+	case finance.RolloverCarrySurplus:
+		return financev1.Budget_CARRY_SURPLUS
+	case finance.RolloverCarryDeficit:
+		return financev1.Budget_CARRY_DEFICIT
+	case finance.RolloverCarryBoth:
+		return financev1.Budget_CARRY_BOTH
+	default:
+		return financev1.Budget_ROLLOVER_POLICY_UNSPECIFIED
+	}
+}
+
+func toDomainRolloverPolicy(p financev1.Budget_RolloverPolicy) finance.RolloverPolicy {
+	switch p {
+	case financev1.Budget_CARRY_SURPLUS:
+		return finance.RolloverCarrySurplus
+	case financev1.Budget_CARRY_DEFICIT:
+		return finance.RolloverCarryDeficit
+	case financev1.Budget_CARRY_BOTH:
+		return finance.RolloverCarryBoth
+	case financev1.Budget_NONE:
+		fallthrough
+	default:
+		return finance.RolloverNone
+	}
+}
+
 func toDomainPropagation(p financev1.LimitPropagation) finance.LimitPropagation {
 	switch p {
 	case financev1.LimitPropagation_LIMIT_PROPAGATION_CURRENT_PERIOD:
@@ -90,6 +144,10 @@ func toProtoBudget(b *finance.Budget) *financev1.Budget {
 		idStr := string(*b.DefaultAccountID)
 		defaultAccountID = &idStr
 	}
+	var periodAnchor *timestamppb.Timestamp
+	if b.PeriodAnchor != nil {
+		periodAnchor = timestamppb.New(*b.PeriodAnchor)
+	}
 	return &financev1.Budget{
 		Id:               string(b.ID),
 		SpaceId:          string(b.SpaceID),
@@ -104,6 +162,11 @@ func toProtoBudget(b *finance.Budget) *financev1.Budget {
 		Color:            b.Color,
 		DefaultAccountId: defaultAccountID,
 		Version:          b.Version,
+		RolloverPolicy:   toProtoRolloverPolicy(b.RolloverPolicy),
+		RolloverCap:      b.RolloverCap,
+		PeriodStartDay:   int32(b.PeriodStartDay),
+		WeekStart:        int32(b.WeekStart),
+		PeriodAnchor:     periodAnchor,
 	}
 }
 
@@ -127,6 +190,12 @@ func toDomainBudget(pb *financev1.Budget) (*finance.Budget, error) {
 		defaultAccountID = &idVal
 	}
 
+	var periodAnchor *time.Time
+	if pb.GetPeriodAnchor() != nil {
+		anchor := pb.GetPeriodAnchor().AsTime()
+		periodAnchor = &anchor
+	}
+
 	return &finance.Budget{
 		ID:               finance.BudgetID(pb.GetId()),
 		SpaceID:          finance.SpaceID(pb.GetSpaceId()),
@@ -138,6 +207,11 @@ func toDomainBudget(pb *financev1.Budget) (*finance.Budget, error) {
 		Icon:             pb.GetIcon(),
 		Color:            pb.GetColor(),
 		DefaultAccountID: defaultAccountID,
+		RolloverPolicy:   toDomainRolloverPolicy(pb.GetRolloverPolicy()),
+		RolloverCap:      pb.GetRolloverCap(),
+		PeriodStartDay:   int(pb.GetPeriodStartDay()),
+		WeekStart:        int(pb.GetWeekStart()),
+		PeriodAnchor:     periodAnchor,
 		Version:          pb.GetVersion(),
 	}, nil
 }
@@ -157,6 +231,9 @@ func toProtoBudgetPeriod(p *financeaggregator.AggregatedBudgetPeriod) *financev1
 		UpdateTime:         timestamppb.New(p.UpdateTime),
 		SpentAmount:        p.SpentAmount,
 		SpentInBase:        p.SpentInBase,
+		CarriedIn:          p.CarriedIn,
+		CarriedOut:         p.CarriedOut,
+		EffectiveLimit:     p.EffectiveLimit(),
 	}
 }
 
@@ -170,6 +247,7 @@ func (h *Handler) ConfigureFinance(ctx context.Context, req *financev1.Configure
 
 	appReq := &financeapp.ConfigureFinanceRequest{
 		BaseCurrency: baseCurrency,
+		Timezone:     req.GetTimezone(),
 	}
 
 	settings, err := h.Coordinator.ConfigureFinance(ctx, appReq)
@@ -189,6 +267,36 @@ func (h *Handler) GetFinanceSettings(ctx context.Context, req *financev1.GetFina
 	return toProtoSettings(settings), nil
 }
 
+func (h *Handler) UpdateFinanceSettings(ctx context.Context, req *financev1.UpdateFinanceSettingsRequest) (*financev1.FinanceSettings, error) {
+	var enabled []finance.Currency
+	for _, code := range req.GetSettings().GetEnabledCurrencies() {
+		c, err := finance.ParseCurrency(code)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		enabled = append(enabled, c)
+	}
+
+	mask := req.GetUpdateMask().GetPaths()
+	if len(mask) == 0 {
+		mask = []string{"enabled_currencies"}
+	}
+
+	settings, err := h.Coordinator.UpdateFinanceSettings(ctx, &financeapp.UpdateFinanceSettingsRequest{
+		EnabledCurrencies:    enabled,
+		Timezone:             req.GetSettings().GetTimezone(),
+		BudgetAlertPercents:  req.GetSettings().GetBudgetAlertPercents(),
+		AnomalyStdDevs:       req.GetSettings().GetAnomalyStdDevs(),
+		DuplicateWindowHours: req.GetSettings().GetDuplicateWindowHours(),
+		UpdateMask:           mask,
+	})
+	if err != nil {
+		return nil, h.mapError(err)
+	}
+
+	return toProtoSettings(settings), nil
+}
+
 func (h *Handler) ListCurrencies(ctx context.Context, req *financev1.ListCurrenciesRequest) (*financev1.ListCurrenciesResponse, error) {
 	list, err := h.Coordinator.ListCurrencies(ctx)
 	if err != nil {
@@ -198,8 +306,11 @@ func (h *Handler) ListCurrencies(ctx context.Context, req *financev1.ListCurrenc
 	currencies := make([]*financev1.CurrencyInfo, len(list))
 	for i, c := range list {
 		currencies[i] = &financev1.CurrencyInfo{
-			Code: c.Code,
-			Name: c.Name,
+			Code:       string(c.Code),
+			Name:       c.Name,
+			Symbol:     c.Symbol,
+			MinorUnits: c.MinorUnits,
+			Enabled:    c.Enabled,
 		}
 	}
 
@@ -335,6 +446,9 @@ func (h *Handler) ListBudgets(ctx context.Context, req *financev1.ListBudgetsReq
 				ExchangeRateToBase: ab.Period.ExchangeRateToBase,
 				BaseCurrency:       string(ab.Period.BaseCurrency),
 				LimitInBase:        ab.Period.LimitInBase,
+				CarriedIn:          ab.Period.CarriedIn,
+				CarriedOut:         ab.Period.CarriedOut,
+				EffectiveLimit:     ab.Period.EffectiveLimit(),
 			}
 		}
 		protoBudgets = append(protoBudgets, pbBgt)
@@ -549,6 +663,8 @@ func (h *Handler) mapError(err error) error {
 		return status.Error(codes.NotFound, "budget not found")
 	case errors.Is(err, finance.ErrPeriodNotFound):
 		return status.Error(codes.NotFound, "budget period not found")
+	case errors.Is(err, finance.ErrCurrencyDisabled):
+		return status.Error(codes.FailedPrecondition, err.Error())
 	case errors.Is(err, finance.ErrExchangeRateNotFound):
 		return status.Error(codes.FailedPrecondition, "exchange rate not found")
 	case errors.Is(err, finance.ErrTransactionNotFound):
@@ -559,6 +675,41 @@ func (h *Handler) mapError(err error) error {
 		return status.Error(codes.NotFound, "borrowing not found")
 	case errors.Is(err, finance.ErrRepaymentNotFound):
 		return status.Error(codes.NotFound, "borrowing repayment not found")
+	case errors.Is(err, finance.ErrIncomeSourceNotFound):
+		return status.Error(codes.NotFound, "income source not found")
+	case errors.Is(err, finance.ErrGoalNotFound):
+		return status.Error(codes.NotFound, "goal not found")
+	case errors.Is(err, finance.ErrSavedFilterNotFound):
+		return status.Error(codes.NotFound, "saved filter not found")
+	case errors.Is(err, finance.ErrPayeeNotFound):
+		return status.Error(codes.NotFound, "payee not found")
+	case errors.Is(err, finance.ErrTransactionRuleNotFound):
+		return status.Error(codes.NotFound, "transaction rule not found")
+	case errors.Is(err, finance.ErrLedgerExportNotFound):
+		return status.Error(codes.NotFound, "ledger export not found")
+	case errors.Is(err, finance.ErrLedgerExportNotReady):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, finance.ErrCardStatementNotFound):
+		return status.Error(codes.NotFound, "card statement not found")
+	case errors.Is(err, finance.ErrAlertNotFound):
+		return status.Error(codes.NotFound, "alert not found")
+	case errors.Is(err, finance.ErrAlertAcknowledged):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, finance.ErrCategoryNotFound):
+		return status.Error(codes.NotFound, "category not found")
+	case errors.Is(err, finance.ErrCategoryCycle):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, finance.ErrAccountNotFound):
+		return status.Error(codes.NotFound, "account not found")
+	case errors.Is(err, finance.ErrStatementMappingNotFound):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, finance.ErrReconciliationNotFound):
+		return status.Error(codes.NotFound, "reconciliation not found")
+	case errors.Is(err, finance.ErrReconciliationInProgress),
+		errors.Is(err, finance.ErrReconciliationFinalized),
+		errors.Is(err, finance.ErrReconciliationUnbalanced),
+		errors.Is(err, finance.ErrTransactionReconciled):
+		return status.Error(codes.FailedPrecondition, err.Error())
 	}
 
 	return status.Error(codes.InvalidArgument, err.Error())
@@ -595,6 +746,21 @@ func (h *Handler) CreateExpense(ctx context.Context, req *financev1.CreateExpens
 		accountID = &idVal
 	}
 
+	categoryID, err := parseOptionalCategoryID(expense.CategoryId)
+	if err != nil {
+		return nil, err
+	}
+
+	payeeID, err := parseOptionalPayeeID(expense.PayeeId)
+	if err != nil {
+		return nil, err
+	}
+
+	splits, err := parseTransactionSplits(expense.GetSplits())
+	if err != nil {
+		return nil, err
+	}
+
 	appReq := &financeapp.CreateExpenseRequest{
 		BudgetID:        finance.BudgetID(expense.GetBudgetId()),
 		Amount:          expense.GetAmount(),
@@ -603,6 +769,10 @@ func (h *Handler) CreateExpense(ctx context.Context, req *financev1.CreateExpens
 		TransactionDate: transactionDate,
 		EffectiveDate:   effectiveDate,
 		AccountID:       accountID,
+		CategoryID:      categoryID,
+		PayeeID:         payeeID,
+		Tags:            expense.GetTags(),
+		Splits:          splits,
 	}
 
 	txn, err := h.Coordinator.CreateExpense(ctx, appReq)
@@ -645,6 +815,21 @@ func (h *Handler) UpdateExpense(ctx context.Context, req *financev1.UpdateExpens
 		accountID = &idVal
 	}
 
+	categoryID, err := parseOptionalCategoryID(expense.CategoryId)
+	if err != nil {
+		return nil, err
+	}
+
+	payeeID, err := parseOptionalPayeeID(expense.PayeeId)
+	if err != nil {
+		return nil, err
+	}
+
+	splits, err := parseTransactionSplits(expense.GetSplits())
+	if err != nil {
+		return nil, err
+	}
+
 	appReq := &financeapp.UpdateExpenseRequest{
 		TransactionID:   tID,
 		BudgetID:        finance.BudgetID(expense.GetBudgetId()),
@@ -654,6 +839,10 @@ func (h *Handler) UpdateExpense(ctx context.Context, req *financev1.UpdateExpens
 		TransactionDate: transactionDate,
 		EffectiveDate:   effectiveDate,
 		AccountID:       accountID,
+		CategoryID:      categoryID,
+		PayeeID:         payeeID,
+		Tags:            expense.GetTags(),
+		Splits:          splits,
 	}
 
 	txn, err := h.Coordinator.UpdateExpense(ctx, appReq)
@@ -763,6 +952,26 @@ func (h *Handler) ListTransactions(ctx context.Context, req *financev1.ListTrans
 		borrowingID = &val
 	}
 
+	var incomeSourceID *finance.IncomeSourceID
+	if req.IncomeSourceId != nil && *req.IncomeSourceId != "" {
+		idVal := finance.IncomeSourceID(*req.IncomeSourceId)
+		incomeSourceID = &idVal
+	}
+
+	categoryID, err := parseOptionalCategoryID(req.CategoryId)
+	if err != nil {
+		return nil, err
+	}
+
+	var savedFilterID *finance.SavedFilterID
+	if req.SavedFilterId != nil && *req.SavedFilterId != "" {
+		idVal, err := finance.ParseSavedFilterID(*req.SavedFilterId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		savedFilterID = &idVal
+	}
+
 	filter := finance.TransactionFilter{
 		BudgetID:           budgetID,
 		Type:               txnType,
@@ -770,10 +979,15 @@ func (h *Handler) ListTransactions(ctx context.Context, req *financev1.ListTrans
 		TransferID:         transferID,
 		ScheduledPaymentID: scheduledPaymentID,
 		BorrowingID:        borrowingID,
+		IncomeSourceID:     incomeSourceID,
+		CategoryID:         categoryID,
+		Tags:               req.GetTags(),
 		PageSize:           req.GetPageSize(),
 		NextPageToken:      req.GetPageToken(),
 		Sort:               sorting.Parse(req.GetSort()),
 		SearchQuery:        searchQuery,
+		Expression:         req.GetFilter(),
+		SavedFilterID:      savedFilterID,
 	}
 
 	view := financeaggregator.ViewBasic
@@ -797,25 +1011,28 @@ func (h *Handler) ListTransactions(ctx context.Context, req *financev1.ListTrans
 	}, nil
 }
 
-func toProtoTransaction(t *finance.Transaction) *financev1.Transaction {
-	if t == nil {
-		return nil
-	}
-	var protoType financev1.Transaction_Type
-	switch t.Type {
+func toProtoTransactionType(t finance.TransactionType) financev1.Transaction_Type {
+	switch t {
 	case finance.TransactionTypeExpense:
-		protoType = financev1.Transaction_EXPENSE
+		return financev1.Transaction_EXPENSE
 	case finance.TransactionTypeIncome:
-		protoType = financev1.Transaction_INCOME
+		return financev1.Transaction_INCOME
 	case finance.TransactionTypeTransferOut:
-		protoType = financev1.Transaction_TRANSFER_OUT
+		return financev1.Transaction_TRANSFER_OUT
 	case finance.TransactionTypeTransferIn:
-		protoType = financev1.Transaction_TRANSFER_IN
+		return financev1.Transaction_TRANSFER_IN
 	case finance.TransactionTypeBalanceAdjustment:
-		protoType = financev1.Transaction_BALANCE_ADJUSTMENT
+		return financev1.Transaction_BALANCE_ADJUSTMENT
 	default:
-		protoType = financev1.Transaction_TYPE_UNSPECIFIED
+		return financev1.Transaction_TYPE_UNSPECIFIED
+	}
+}
+
+func toProtoTransaction(t *finance.Transaction) *financev1.Transaction {
+	if t == nil {
+		return nil
 	}
+	protoType := toProtoTransactionType(t.Type)
 
 	var budgetID, periodID, accountID string
 	if t.BudgetID != nil {
@@ -831,6 +1048,11 @@ func toProtoTransaction(t *finance.Transaction) *financev1.Transaction {
 	if accountID != "" {
 		accountIDPtr = &accountID
 	}
+	var categoryIDPtr *string
+	if t.CategoryID != nil {
+		categoryID := string(*t.CategoryID)
+		categoryIDPtr = &categoryID
+	}
 
 	metaMap := make(map[string]string)
 	if t.Metadata.ScheduledPaymentID != nil {
@@ -839,6 +1061,9 @@ func toProtoTransaction(t *finance.Transaction) *financev1.Transaction {
 	if t.Metadata.RecurringExpenseID != nil {
 		metaMap["recurring_expense_id"] = string(*t.Metadata.RecurringExpenseID)
 	}
+	if t.Metadata.IncomeSourceID != nil {
+		metaMap["income_source_id"] = string(*t.Metadata.IncomeSourceID)
+	}
 	if t.Metadata.BorrowingID != nil {
 		metaMap["borrowing_id"] = string(*t.Metadata.BorrowingID)
 	}
@@ -855,22 +1080,40 @@ func toProtoTransaction(t *finance.Transaction) *financev1.Transaction {
 		metaMap["notes"] = t.Metadata.Notes
 	}
 
+	var payeeIDPtr *string
+	if t.PayeeID != nil {
+		payeeID := string(*t.PayeeID)
+		payeeIDPtr = &payeeID
+	}
+
+	var reconciliationIDPtr *string
+	if t.ReconciliationID != nil {
+		reconciliationID := string(*t.ReconciliationID)
+		reconciliationIDPtr = &reconciliationID
+	}
+
 	return &financev1.Transaction{
-		Id:              string(t.ID),
-		SpaceId:         string(t.SpaceID),
-		Type:            protoType,
-		BudgetId:        budgetID,
-		PeriodId:        periodID,
-		Amount:          t.Amount,
-		Currency:        string(t.Currency),
-		AmountInBase:    t.AmountInBase,
-		Description:     t.Description,
-		TransactionDate: timestamppb.New(t.TransactionDate),
-		EffectiveDate:   timestamppb.New(t.EffectiveDate),
-		AccountId:       accountIDPtr,
-		Metadata:        metaMap,
-		CreateTime:      timestamppb.New(t.CreateTime),
-		UpdateTime:      timestamppb.New(t.UpdateTime),
+		Id:               string(t.ID),
+		SpaceId:          string(t.SpaceID),
+		Type:             protoType,
+		BudgetId:         budgetID,
+		PeriodId:         periodID,
+		Amount:           t.Amount,
+		Currency:         string(t.Currency),
+		AmountInBase:     t.AmountInBase,
+		Description:      t.Description,
+		TransactionDate:  timestamppb.New(t.TransactionDate),
+		EffectiveDate:    timestamppb.New(t.EffectiveDate),
+		AccountId:        accountIDPtr,
+		Metadata:         metaMap,
+		CategoryId:       categoryIDPtr,
+		Tags:             t.Tags,
+		Splits:           toProtoTransactionSplits(t.Splits),
+		Cleared:          t.Cleared,
+		ReconciliationId: reconciliationIDPtr,
+		PayeeId:          payeeIDPtr,
+		CreateTime:       timestamppb.New(t.CreateTime),
+		UpdateTime:       timestamppb.New(t.UpdateTime),
 	}
 }
 
@@ -1033,6 +1276,30 @@ func toProtoInsightsResponse(in *finance.SpentInsights) *financev1.GetInsightsRe
 		})
 	}
 
+	categories := make([]*financev1.SpentInsights_CategoryUsage, 0, len(in.Categories))
+	for _, c := range in.Categories {
+		categories = append(categories, &financev1.SpentInsights_CategoryUsage{
+			CategoryId:       c.CategoryID,
+			ParentId:         c.ParentID,
+			CategoryName:     c.CategoryName,
+			CategoryColor:    c.CategoryColor,
+			CategoryIcon:     c.CategoryIcon,
+			TransactionCount: c.TransactionCount,
+			SpentInBase:      c.SpentInBase,
+			SharePercentage:  c.SharePercentage,
+		})
+	}
+
+	tags := make([]*financev1.SpentInsights_TagUsage, 0, len(in.Tags))
+	for _, t := range in.Tags {
+		tags = append(tags, &financev1.SpentInsights_TagUsage{
+			Tag:              t.Tag,
+			TransactionCount: t.TransactionCount,
+			SpentInBase:      t.SpentInBase,
+			SharePercentage:  t.SharePercentage,
+		})
+	}
+
 	return &financev1.GetInsightsResponse{
 		Spent: &financev1.SpentInsights{
 			TotalLimit:      in.TotalLimit,
@@ -1042,7 +1309,40 @@ func toProtoInsightsResponse(in *finance.SpentInsights) *financev1.GetInsightsRe
 			Trend:           trendPoints,
 			Distributions:   dists,
 			TopExpenses:     tops,
+			Categories:      categories,
+			Tags:            tags,
 		},
+		CashFlow: toProtoCashFlowInsights(in.CashFlow),
+		Goals:    toProtoGoalProgressList(in.Goals),
+	}
+}
+
+func toProtoCashFlowInsights(cf *finance.CashFlowInsights) *financev1.CashFlowInsights {
+	if cf == nil {
+		return nil
+	}
+
+	trend := make([]*financev1.CashFlowInsights_CashFlowDataPoint, 0, len(cf.Trend))
+	for _, pt := range cf.Trend {
+		trend = append(trend, &financev1.CashFlowInsights_CashFlowDataPoint{
+			Label:              pt.Label,
+			StartDate:          pt.StartDate,
+			IncomeInBase:       pt.IncomeInBase,
+			ExpenseInBase:      pt.ExpenseInBase,
+			NetInBase:          pt.NetInBase,
+			CardSpendingInBase: pt.CardSpending,
+			CardPaymentsInBase: pt.CardPayments,
+		})
+	}
+
+	return &financev1.CashFlowInsights{
+		TotalIncome:  cf.TotalIncome,
+		TotalExpense: cf.TotalExpense,
+		NetCashFlow:  cf.NetCashFlow,
+		SavingsRate:  cf.SavingsRate,
+		Trend:        trend,
+		CardSpending: cf.CardSpending,
+		CardPayments: cf.CardPayments,
 	}
 }
 
@@ -1080,6 +1380,10 @@ func toProtoAccount(a *finance.Account) *financev1.Account {
 	if a == nil {
 		return nil
 	}
+	var paymentBudgetID string
+	if a.PaymentBudgetID != nil {
+		paymentBudgetID = string(*a.PaymentBudgetID)
+	}
 	return &financev1.Account{
 		Id:             string(a.ID),
 		SpaceId:        string(a.SpaceID),
@@ -1096,9 +1400,29 @@ func toProtoAccount(a *finance.Account) *financev1.Account {
 		LastFour:       a.LastFour,
 		CreateTime:     timestamppb.New(a.CreateTime),
 		UpdateTime:     timestamppb.New(a.UpdateTime),
+
+		StatementClosingDay: a.StatementClosingDay,
+		PaymentDueDay:       a.PaymentDueDay,
+		MinimumPaymentBps:   a.MinimumPaymentBps,
+		MinimumPaymentFloor: a.MinimumPaymentFloor,
+		PaymentBudgetId:     paymentBudgetID,
+		Utilization:         a.Utilization(),
+		LowBalanceThreshold: a.LowBalanceThreshold,
 	}
 }
 
+// toDomainPaymentBudgetID parses the optional budget card statement payments are drawn from.
+func toDomainPaymentBudgetID(account *financev1.Account) (*finance.BudgetID, error) {
+	if account.GetPaymentBudgetId() == "" {
+		return nil, nil
+	}
+	budgetID, err := finance.ParseBudgetID(account.GetPaymentBudgetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &budgetID, nil
+}
+
 func toProtoTransfer(t *finance.Transfer) *financev1.Transfer {
 	if t == nil {
 		return nil
@@ -1123,6 +1447,11 @@ func (h *Handler) CreateAccount(ctx context.Context, req *financev1.CreateAccoun
 		return nil, status.Error(codes.InvalidArgument, "account resource is required")
 	}
 
+	paymentBudgetID, err := toDomainPaymentBudgetID(account)
+	if err != nil {
+		return nil, err
+	}
+
 	appReq := &financeapp.CreateAccountRequest{
 		Name:           account.GetName(),
 		Type:           string(toDomainAccountType(account.GetType())),
@@ -1133,6 +1462,13 @@ func (h *Handler) CreateAccount(ctx context.Context, req *financev1.CreateAccoun
 		Color:          account.GetColor(),
 		Notes:          account.GetNotes(),
 		LastFour:       account.GetLastFour(),
+
+		StatementClosingDay: account.GetStatementClosingDay(),
+		PaymentDueDay:       account.GetPaymentDueDay(),
+		MinimumPaymentBps:   account.GetMinimumPaymentBps(),
+		MinimumPaymentFloor: account.GetMinimumPaymentFloor(),
+		PaymentBudgetID:     paymentBudgetID,
+		LowBalanceThreshold: account.GetLowBalanceThreshold(),
 	}
 
 	acc, err := h.Coordinator.CreateAccount(ctx, appReq)
@@ -1187,6 +1523,11 @@ func (h *Handler) UpdateAccount(ctx context.Context, req *financev1.UpdateAccoun
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	paymentBudgetID, err := toDomainPaymentBudgetID(account)
+	if err != nil {
+		return nil, err
+	}
+
 	appReq := &financeapp.UpdateAccountRequest{
 		ID:          aID,
 		Name:        account.GetName(),
@@ -1196,6 +1537,13 @@ func (h *Handler) UpdateAccount(ctx context.Context, req *financev1.UpdateAccoun
 		Color:       account.GetColor(),
 		Notes:       account.GetNotes(),
 		LastFour:    account.GetLastFour(),
+
+		StatementClosingDay: account.GetStatementClosingDay(),
+		PaymentDueDay:       account.GetPaymentDueDay(),
+		MinimumPaymentBps:   account.GetMinimumPaymentBps(),
+		MinimumPaymentFloor: account.GetMinimumPaymentFloor(),
+		PaymentBudgetID:     paymentBudgetID,
+		LowBalanceThreshold: account.GetLowBalanceThreshold(),
 	}
 
 	acc, err := h.Coordinator.UpdateAccount(ctx, appReq)
@@ -1491,12 +1839,16 @@ func toProtoInboxItem(pt *finance.InboxItem) *financev1.InboxItem {
 		BorrowingLinkType:  toProtoBorrowingLinkType(pt.BorrowingLinkType),
 		RawPayload:         pt.RawPayload,
 		Metadata:           protoMeta,
+		Splits:             toProtoInboxItemSplits(pt.Splits),
+		Source:             string(pt.Source),
+		ExternalId:         pt.ExternalID,
+		PayeeId:            pt.PayeeID,
 		CreateTime:         timestamppb.New(pt.CreateTime),
 	}
 }
 
 func toDomainInboxItem(pb *financev1.InboxItem) *finance.InboxItem {
-	var accountID, budgetID, paymentID, transactionID, borrowingID *string
+	var accountID, budgetID, payeeID, paymentID, transactionID, borrowingID *string
 	if pb.GetAccountId() != "" {
 		val := pb.GetAccountId()
 		accountID = &val
@@ -1505,6 +1857,10 @@ func toDomainInboxItem(pb *financev1.InboxItem) *finance.InboxItem {
 		val := pb.GetBudgetId()
 		budgetID = &val
 	}
+	if pb.GetPayeeId() != "" {
+		val := pb.GetPayeeId()
+		payeeID = &val
+	}
 	if pb.GetScheduledPaymentId() != "" {
 		val := pb.GetScheduledPaymentId()
 		paymentID = &val
@@ -1548,12 +1904,14 @@ func toDomainInboxItem(pb *financev1.InboxItem) *finance.InboxItem {
 		TransactionDate:    txDate,
 		AccountID:          accountID,
 		BudgetID:           budgetID,
+		PayeeID:            payeeID,
 		ScheduledPaymentID: paymentID,
 		TransactionID:      transactionID,
 		BorrowingID:        borrowingID,
 		BorrowingLinkType:  toDomainBorrowingLinkType(pb.GetBorrowingLinkType()),
 		RawPayload:         pb.GetRawPayload(),
 		Metadata:           domainMeta,
+		Splits:             toDomainInboxItemSplits(pb.GetSplits()),
 	}
 }
 
@@ -0,0 +1,385 @@
+package finance
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	financev1 "github.com/masterkeysrd/saturn/apis/saturn/finance/v1"
+	financeapp "github.com/masterkeysrd/saturn/internal/application/finance"
+	"github.com/masterkeysrd/saturn/internal/domain/finance"
+	"github.com/masterkeysrd/saturn/internal/platform/sorting"
+)
+
+func (h *Handler) CreateIncome(ctx context.Context, req *financev1.CreateIncomeRequest) (*financev1.Transaction, error) {
+	income := req.GetIncome()
+	if income == nil {
+		return nil, status.Error(codes.InvalidArgument, "income details are required")
+	}
+
+	currency, err := finance.ParseCurrency(income.GetCurrency())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var transactionDate time.Time
+	if income.GetTransactionDate() != nil {
+		transactionDate = income.GetTransactionDate().AsTime()
+	} else {
+		transactionDate = time.Now().UTC()
+	}
+
+	var effectiveDate time.Time
+	if income.GetEffectiveDate() != nil {
+		effectiveDate = income.GetEffectiveDate().AsTime()
+	} else {
+		effectiveDate = transactionDate
+	}
+
+	accountID, sourceID, err := parseIncomeReferences(income)
+	if err != nil {
+		return nil, err
+	}
+
+	categoryID, err := parseOptionalCategoryID(income.CategoryId)
+	if err != nil {
+		return nil, err
+	}
+
+	payeeID, err := parseOptionalPayeeID(income.PayeeId)
+	if err != nil {
+		return nil, err
+	}
+
+	appReq := &financeapp.CreateIncomeRequest{
+		Amount:          income.GetAmount(),
+		Currency:        currency,
+		Description:     income.GetDescription(),
+		TransactionDate: transactionDate,
+		EffectiveDate:   effectiveDate,
+		AccountID:       accountID,
+		IncomeSourceID:  sourceID,
+		CategoryID:      categoryID,
+		PayeeID:         payeeID,
+		Tags:            income.GetTags(),
+	}
+
+	txn, err := h.Coordinator.CreateIncome(ctx, appReq)
+	if err != nil {
+		return nil, h.mapError(err)
+	}
+
+	return toProtoTransaction(txn), nil
+}
+
+func (h *Handler) UpdateIncome(ctx context.Context, req *financev1.UpdateIncomeRequest) (*financev1.Transaction, error) {
+	income := req.GetIncome()
+	if income == nil {
+		return nil, status.Error(codes.InvalidArgument, "income details are required")
+	}
+
+	currency, err := finance.ParseCurrency(income.GetCurrency())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var transactionDate time.Time
+	if income.GetTransactionDate() != nil {
+		transactionDate = income.GetTransactionDate().AsTime()
+	}
+
+	var effectiveDate time.Time
+	if income.GetEffectiveDate() != nil {
+		effectiveDate = income.GetEffectiveDate().AsTime()
+	}
+
+	tID, err := finance.ParseTransactionID(req.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	accountID, sourceID, err := parseIncomeReferences(income)
+	if err != nil {
+		return nil, err
+	}
+
+	categoryID, err := parseOptionalCategoryID(income.CategoryId)
+	if err != nil {
+		return nil, err
+	}
+
+	payeeID, err := parseOptionalPayeeID(income.PayeeId)
+	if err != nil {
+		return nil, err
+	}
+
+	appReq := &financeapp.UpdateIncomeRequest{
+		TransactionID:   tID,
+		Amount:          income.GetAmount(),
+		Currency:        currency,
+		Description:     income.GetDescription(),
+		TransactionDate: transactionDate,
+		EffectiveDate:   effectiveDate,
+		AccountID:       accountID,
+		IncomeSourceID:  sourceID,
+		CategoryID:      categoryID,
+		PayeeID:         payeeID,
+		Tags:            income.GetTags(),
+	}
+
+	txn, err := h.Coordinator.UpdateIncome(ctx, appReq)
+	if err != nil {
+		return nil, h.mapError(err)
+	}
+
+	return toProtoTransaction(txn), nil
+}
+
+func (h *Handler) CreateIncomeSource(ctx context.Context, req *financev1.CreateIncomeSourceRequest) (*financev1.IncomeSource, error) {
+	src := req.GetIncomeSource()
+	if src == nil {
+		return nil, status.Error(codes.InvalidArgument, "income_source is required")
+	}
+
+	currency, err := finance.ParseCurrency(src.GetCurrency())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	cadence, err := incomeSourceCadence(src)
+	if err != nil {
+		return nil, err
+	}
+
+	var accountID *finance.AccountID
+	if src.AccountId != nil && *src.AccountId != "" {
+		idVal := finance.AccountID(*src.AccountId)
+		accountID = &idVal
+	}
+
+	appReq := &financeapp.CreateIncomeSourceRequest{
+		Name:           src.GetName(),
+		Employer:       src.GetEmployer(),
+		ExpectedAmount: src.GetExpectedAmount(),
+		Currency:       currency,
+		Cadence:        cadence,
+		RecurrenceRule: src.GetRecurrenceRule(),
+		StartDate:      fromProtoDate(src.GetStartDate()),
+		ExceptionDates: fromProtoDates(src.GetExceptionDates()),
+		AccountID:      accountID,
+	}
+
+	created, err := h.Coordinator.CreateIncomeSource(ctx, appReq)
+	if err != nil {
+		return nil, h.mapError(err)
+	}
+
+	return toProtoIncomeSource(created), nil
+}
+
+func (h *Handler) GetIncomeSource(ctx context.Context, req *financev1.GetIncomeSourceRequest) (*financev1.IncomeSource, error) {
+	id, err := finance.ParseIncomeSourceID(req.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	src, err := h.Coordinator.GetIncomeSource(ctx, id)
+	if err != nil {
+		return nil, h.mapError(err)
+	}
+
+	return toProtoIncomeSource(src), nil
+}
+
+func (h *Handler) UpdateIncomeSource(ctx context.Context, req *financev1.UpdateIncomeSourceRequest) (*financev1.IncomeSource, error) {
+	src := req.GetIncomeSource()
+	if src == nil {
+		return nil, status.Error(codes.InvalidArgument, "income_source is required")
+	}
+
+	id, err := finance.ParseIncomeSourceID(req.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	currency, err := finance.ParseCurrency(src.GetCurrency())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	cadence, err := incomeSourceCadence(src)
+	if err != nil {
+		return nil, err
+	}
+
+	var accountID *finance.AccountID
+	if src.AccountId != nil && *src.AccountId != "" {
+		idVal := finance.AccountID(*src.AccountId)
+		accountID = &idVal
+	}
+
+	appReq := &financeapp.UpdateIncomeSourceRequest{
+		ID:             id,
+		Name:           src.GetName(),
+		Employer:       src.GetEmployer(),
+		ExpectedAmount: src.GetExpectedAmount(),
+		Currency:       currency,
+		Cadence:        cadence,
+		RecurrenceRule: src.GetRecurrenceRule(),
+		StartDate:      fromProtoDate(src.GetStartDate()),
+		ExceptionDates: fromProtoDates(src.GetExceptionDates()),
+		AccountID:      accountID,
+		IsActive:       src.GetIsActive(),
+	}
+
+	updated, err := h.Coordinator.UpdateIncomeSource(ctx, appReq)
+	if err != nil {
+		return nil, h.mapError(err)
+	}
+
+	return toProtoIncomeSource(updated), nil
+}
+
+func (h *Handler) DeleteIncomeSource(ctx context.Context, req *financev1.DeleteIncomeSourceRequest) (*emptypb.Empty, error) {
+	id, err := finance.ParseIncomeSourceID(req.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := h.Coordinator.DeleteIncomeSource(ctx, id); err != nil {
+		return nil, h.mapError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *Handler) ListIncomeSources(ctx context.Context, req *financev1.ListIncomeSourcesRequest) (*financev1.ListIncomeSourcesResponse, error) {
+	filter := &finance.ListIncomeSourcesFilter{
+		PageSize:      req.GetPageSize(),
+		NextPageToken: req.GetPageToken(),
+		ActiveOnly:    req.ActiveOnly,
+		SearchQuery:   req.SearchQuery,
+		Sort:          sorting.Parse(req.GetSort()),
+	}
+
+	page, err := h.Coordinator.ListIncomeSources(ctx, filter)
+	if err != nil {
+		return nil, h.mapError(err)
+	}
+
+	protoSources := make([]*financev1.IncomeSource, 0, len(page.Items))
+	for _, src := range page.Items {
+		protoSources = append(protoSources, toProtoIncomeSource(src))
+	}
+
+	return &financev1.ListIncomeSourcesResponse{
+		IncomeSources: protoSources,
+		NextPageToken: page.NextPageToken,
+	}, nil
+}
+
+// --- Mappers ---
+
+func parseIncomeReferences(in *financev1.IncomeInput) (*finance.AccountID, *finance.IncomeSourceID, error) {
+	var accountID *finance.AccountID
+	if in.AccountId != nil && *in.AccountId != "" {
+		idVal := finance.AccountID(*in.AccountId)
+		accountID = &idVal
+	}
+
+	var sourceID *finance.IncomeSourceID
+	if in.IncomeSourceId != nil && *in.IncomeSourceId != "" {
+		idVal, err := finance.ParseIncomeSourceID(*in.IncomeSourceId)
+		if err != nil {
+			return nil, nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		sourceID = &idVal
+	}
+
+	return accountID, sourceID, nil
+}
+
+// incomeSourceCadence maps the requested cadence. It may be omitted when a recurrence rule is given,
+// since the domain derives it from the rule.
+func incomeSourceCadence(src *financev1.IncomeSource) (finance.IncomeCadence, error) {
+	if src.GetRecurrenceRule() != "" && src.GetCadence() == financev1.IncomeSource_CADENCE_UNSPECIFIED {
+		return finance.IncomeCadenceCustom, nil
+	}
+	return toDomainIncomeCadence(src.GetCadence())
+}
+
+func toDomainIncomeCadence(c financev1.IncomeSource_Cadence) (finance.IncomeCadence, error) {
+	switch c {
+	case financev1.IncomeSource_WEEKLY:
+		return finance.IncomeCadenceWeekly, nil
+	case financev1.IncomeSource_BIWEEKLY:
+		return finance.IncomeCadenceBiweekly, nil
+	case financev1.IncomeSource_MONTHLY:
+		return finance.IncomeCadenceMonthly, nil
+	case financev1.IncomeSource_YEARLY:
+		return finance.IncomeCadenceYearly, nil
+	case financev1.IncomeSource_IRREGULAR:
+		return finance.IncomeCadenceIrregular, nil
+	case financev1.IncomeSource_CUSTOM:
+		return finance.IncomeCadenceCustom, nil
+	default:
+		return "", status.Error(codes.InvalidArgument, "invalid income source cadence")
+	}
+}
+
+func toProtoIncomeCadence(c finance.IncomeCadence) financev1.IncomeSource_Cadence {
+	switch c {
+	case finance.IncomeCadenceWeekly:
+		return financev1.IncomeSource_WEEKLY
+	case finance.IncomeCadenceBiweekly:
+		return financev1.IncomeSource_BIWEEKLY
+	case finance.IncomeCadenceMonthly:
+		return financev1.IncomeSource_MONTHLY
+	case finance.IncomeCadenceYearly:
+		return financev1.IncomeSource_YEARLY
+	case finance.IncomeCadenceIrregular:
+		return financev1.IncomeSource_IRREGULAR
+	case finance.IncomeCadenceCustom:
+		return financev1.IncomeSource_CUSTOM
+	default:
+		return financev1.IncomeSource_CADENCE_UNSPECIFIED
+	}
+}
+
+func toProtoIncomeSource(s *finance.IncomeSource) *financev1.IncomeSource {
+	if s == nil {
+		return nil
+	}
+
+	var accountID *string
+	if s.AccountID != nil {
+		idStr := string(*s.AccountID)
+		accountID = &idStr
+	}
+
+	var startDate *timestamppb.Timestamp
+	if !s.StartDate.IsZero() {
+		startDate = timestamppb.New(s.StartDate)
+	}
+
+	return &financev1.IncomeSource{
+		Id:             string(s.ID),
+		SpaceId:        string(s.SpaceID),
+		Name:           s.Name,
+		Employer:       s.Employer,
+		ExpectedAmount: s.ExpectedAmount,
+		Currency:       string(s.Currency),
+		Cadence:        toProtoIncomeCadence(s.Cadence),
+		AccountId:      accountID,
+		IsActive:       s.IsActive,
+		CreateTime:     timestamppb.New(s.CreateTime),
+		UpdateTime:     timestamppb.New(s.UpdateTime),
+		RecurrenceRule: s.RecurrenceRule,
+		StartDate:      startDate,
+		ExceptionDates: toProtoDates(s.ExceptionDates),
+	}
+}
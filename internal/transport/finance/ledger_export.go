@@ -0,0 +1,346 @@
+package finance
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/utilities"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	financev1 "github.com/masterkeysrd/saturn/apis/saturn/finance/v1"
+	financeapp "github.com/masterkeysrd/saturn/internal/application/finance"
+	"github.com/masterkeysrd/saturn/internal/domain/finance"
+)
+
+// ledgerChunkSize bounds the data carried by a single LedgerExportChunk.
+const ledgerChunkSize = 32 << 10
+
+func (h *Handler) ExportLedger(req *financev1.ExportLedgerRequest, stream grpc.ServerStreamingServer[financev1.LedgerExportChunk]) error {
+	ctx := stream.Context()
+
+	if req.GetStartDate() == nil || req.GetEndDate() == nil {
+		return status.Error(codes.InvalidArgument, "start date and end date are required")
+	}
+	format, err := toDomainLedgerFormat(req.GetFormat())
+	if err != nil {
+		return err
+	}
+	appReq := &financeapp.ExportLedgerRequest{
+		Format:    format,
+		StartDate: req.GetStartDate().AsTime(),
+		EndDate:   req.GetEndDate().AsTime(),
+	}
+
+	// Report invalid requests as a status before the first chunk commits the response.
+	if err := h.Coordinator.ValidateLedgerQuery(ctx, appReq); err != nil {
+		return h.mapError(err)
+	}
+
+	start, end := appReq.StartDate.UTC(), appReq.EndDate.UTC()
+	if err := stream.Send(&financev1.LedgerExportChunk{
+		ContentType: format.ContentType(),
+		FileName:    format.FileName(start, end),
+	}); err != nil {
+		return err
+	}
+
+	w := bufio.NewWriterSize(&ledgerChunkWriter{stream: stream}, ledgerChunkSize)
+	if _, err := h.Coordinator.ExportLedger(ctx, appReq, w); err != nil {
+		return h.mapError(err)
+	}
+	return w.Flush()
+}
+
+func (h *Handler) CreateLedgerExport(ctx context.Context, req *financev1.CreateLedgerExportRequest) (*financev1.LedgerExport, error) {
+	e := req.GetLedgerExport()
+	if e == nil {
+		return nil, status.Error(codes.InvalidArgument, "ledger export is required")
+	}
+	if e.GetStartDate() == nil || e.GetEndDate() == nil {
+		return nil, status.Error(codes.InvalidArgument, "start date and end date are required")
+	}
+
+	format, err := toDomainLedgerFormat(e.GetFormat())
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := h.Coordinator.CreateLedgerExport(ctx, &financeapp.ExportLedgerRequest{
+		Format:    format,
+		StartDate: e.GetStartDate().AsTime(),
+		EndDate:   e.GetEndDate().AsTime(),
+	})
+	if err != nil {
+		return nil, h.mapError(err)
+	}
+
+	if err := financev1.EnqueueRunLedgerExportPayload(ctx, h.Scheduler, financev1.RunLedgerExportPayloadJob{
+		Payload: &financev1.RunLedgerExportPayload{
+			SpaceId:  string(created.SpaceID),
+			ExportId: string(created.ID),
+		},
+		RunAt:       time.Now(),
+		MaxAttempts: 3,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "enqueue ledger export: %v", err)
+	}
+
+	return toProtoLedgerExport(created), nil
+}
+
+func (h *Handler) GetLedgerExport(ctx context.Context, req *financev1.GetLedgerExportRequest) (*financev1.LedgerExport, error) {
+	id, err := finance.ParseLedgerExportID(req.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	e, err := h.Coordinator.GetLedgerExport(ctx, id)
+	if err != nil {
+		return nil, h.mapError(err)
+	}
+
+	return toProtoLedgerExport(e), nil
+}
+
+func (h *Handler) ListLedgerExports(ctx context.Context, req *financev1.ListLedgerExportsRequest) (*financev1.ListLedgerExportsResponse, error) {
+	filter := &finance.ListLedgerExportsFilter{
+		PageSize:      req.GetPageSize(),
+		NextPageToken: req.GetPageToken(),
+	}
+
+	page, err := h.Coordinator.ListLedgerExports(ctx, filter)
+	if err != nil {
+		return nil, h.mapError(err)
+	}
+
+	protoExports := make([]*financev1.LedgerExport, 0, len(page.Items))
+	for _, e := range page.Items {
+		protoExports = append(protoExports, toProtoLedgerExport(e))
+	}
+
+	return &financev1.ListLedgerExportsResponse{
+		LedgerExports: protoExports,
+		NextPageToken: page.NextPageToken,
+	}, nil
+}
+
+func (h *Handler) DownloadLedgerExport(req *financev1.DownloadLedgerExportRequest, stream grpc.ServerStreamingServer[financev1.LedgerExportChunk]) error {
+	id, err := finance.ParseLedgerExportID(req.GetId())
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	e, content, err := h.Coordinator.GetLedgerExportContent(stream.Context(), id)
+	if err != nil {
+		return h.mapError(err)
+	}
+
+	if err := stream.Send(&financev1.LedgerExportChunk{
+		ContentType: e.ContentType,
+		FileName:    e.FileName,
+	}); err != nil {
+		return err
+	}
+	_, err = (&ledgerChunkWriter{stream: stream}).Write(content)
+	return err
+}
+
+// HandleRunLedgerExport generates a queued ledger export in the background.
+func (h *Handler) HandleRunLedgerExport(ctx context.Context, payload *financev1.RunLedgerExportPayload) error {
+	id, err := finance.ParseLedgerExportID(payload.GetExportId())
+	if err != nil {
+		return err
+	}
+	return h.Coordinator.RunLedgerExport(ctx, finance.SpaceID(payload.GetSpaceId()), id)
+}
+
+// ledgerChunkWriter sends everything written to it as LedgerExportChunk messages.
+type ledgerChunkWriter struct {
+	stream grpc.ServerStreamingServer[financev1.LedgerExportChunk]
+}
+
+func (cw *ledgerChunkWriter) Write(p []byte) (int, error) {
+	written := 0
+	for written < len(p) {
+		end := min(written+ledgerChunkSize, len(p))
+		if err := cw.stream.Send(&financev1.LedgerExportChunk{Data: p[written:end]}); err != nil {
+			return written, err
+		}
+		written = end
+	}
+	return written, nil
+}
+
+// RegisterDownloadHandlers serves the ledger export streams as plain file downloads on the gateway.
+// The gateway's own stream forwarding writes a delimiter after every message, which would corrupt
+// the files, so chunks are copied to the response body verbatim instead.
+func RegisterDownloadHandlers(mux *runtime.ServeMux, conn grpc.ClientConnInterface) error {
+	client := financev1.NewFinanceClient(conn)
+
+	const exportPattern = "/v1/finance/ledger:export"
+	if err := mux.HandlePath(http.MethodGet, exportPattern, func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		ctx, err := runtime.AnnotateContext(r.Context(), mux, r, financev1.Finance_ExportLedger_FullMethodName, runtime.WithHTTPPathPattern(exportPattern))
+		if err != nil {
+			writeGatewayError(r.Context(), mux, w, r, err)
+			return
+		}
+		req := &financev1.ExportLedgerRequest{}
+		if err := runtime.PopulateQueryParameters(req, r.URL.Query(), utilities.NewDoubleArray(nil)); err != nil {
+			writeGatewayError(ctx, mux, w, r, status.Error(codes.InvalidArgument, err.Error()))
+			return
+		}
+		stream, err := client.ExportLedger(ctx, req)
+		if err != nil {
+			writeGatewayError(ctx, mux, w, r, err)
+			return
+		}
+		forwardLedgerChunks(ctx, mux, w, r, stream)
+	}); err != nil {
+		return err
+	}
+
+	const downloadPattern = "/v1/finance/ledger-exports/{id}:download"
+	return mux.HandlePath(http.MethodGet, downloadPattern, func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		ctx, err := runtime.AnnotateContext(r.Context(), mux, r, financev1.Finance_DownloadLedgerExport_FullMethodName, runtime.WithHTTPPathPattern(downloadPattern))
+		if err != nil {
+			writeGatewayError(r.Context(), mux, w, r, err)
+			return
+		}
+		stream, err := client.DownloadLedgerExport(ctx, &financev1.DownloadLedgerExportRequest{Id: pathParams["id"]})
+		if err != nil {
+			writeGatewayError(ctx, mux, w, r, err)
+			return
+		}
+		forwardLedgerChunks(ctx, mux, w, r, stream)
+	})
+}
+
+// forwardLedgerChunks writes a chunk stream as an attachment. Errors before the first chunk are
+// reported as regular gateway errors; later failures abort the connection so that clients do not
+// mistake a truncated file for a complete one.
+func forwardLedgerChunks(ctx context.Context, mux *runtime.ServeMux, w http.ResponseWriter, r *http.Request, stream grpc.ServerStreamingClient[financev1.LedgerExportChunk]) {
+	first, err := stream.Recv()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			err = status.Error(codes.Internal, "ledger export stream ended without data")
+		}
+		writeGatewayError(ctx, mux, w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", first.GetContentType())
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": first.GetFileName()}))
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(first.GetData()); err != nil {
+		return
+	}
+
+	flusher, _ := w.(http.Flusher)
+	for {
+		chunk, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return
+		}
+		if err != nil {
+			slog.ErrorContext(ctx, "ledger export stream failed", "path", r.URL.Path, "err", err)
+			panic(http.ErrAbortHandler)
+		}
+		if _, err := w.Write(chunk.GetData()); err != nil {
+			return
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+	}
+}
+
+func writeGatewayError(ctx context.Context, mux *runtime.ServeMux, w http.ResponseWriter, r *http.Request, err error) {
+	_, outbound := runtime.MarshalerForRequest(mux, r)
+	runtime.HTTPError(ctx, mux, outbound, w, r, err)
+}
+
+// --- Mappers ---
+
+func toDomainLedgerFormat(f financev1.LedgerFormat) (finance.LedgerFormat, error) {
+	switch f {
+	case financev1.LedgerFormat_LEDGER_FORMAT_CSV:
+		return finance.LedgerFormatCSV, nil
+	case financev1.LedgerFormat_LEDGER_FORMAT_JSONL:
+		return finance.LedgerFormatJSONL, nil
+	case financev1.LedgerFormat_LEDGER_FORMAT_OFX:
+		return finance.LedgerFormatOFX, nil
+	case financev1.LedgerFormat_LEDGER_FORMAT_BEANCOUNT:
+		return finance.LedgerFormatBeancount, nil
+	case financev1.LedgerFormat_LEDGER_FORMAT_HLEDGER:
+		return finance.LedgerFormatHledger, nil
+	default:
+		return "", status.Error(codes.InvalidArgument, "ledger format is required")
+	}
+}
+
+func toProtoLedgerFormat(f finance.LedgerFormat) financev1.LedgerFormat {
+	switch f {
+	case finance.LedgerFormatCSV:
+		return financev1.LedgerFormat_LEDGER_FORMAT_CSV
+	case finance.LedgerFormatJSONL:
+		return financev1.LedgerFormat_LEDGER_FORMAT_JSONL
+	case finance.LedgerFormatOFX:
+		return financev1.LedgerFormat_LEDGER_FORMAT_OFX
+	case finance.LedgerFormatBeancount:
+		return financev1.LedgerFormat_LEDGER_FORMAT_BEANCOUNT
+	case finance.LedgerFormatHledger:
+		return financev1.LedgerFormat_LEDGER_FORMAT_HLEDGER
+	default:
+		return financev1.LedgerFormat_LEDGER_FORMAT_UNSPECIFIED
+	}
+}
+
+func toProtoLedgerExportStatus(s finance.LedgerExportStatus) financev1.LedgerExport_Status {
+	switch s {
+	case finance.LedgerExportStatusPending:
+		return financev1.LedgerExport_PENDING
+	case finance.LedgerExportStatusRunning:
+		return financev1.LedgerExport_RUNNING
+	case finance.LedgerExportStatusCompleted:
+		return financev1.LedgerExport_COMPLETED
+	case finance.LedgerExportStatusFailed:
+		return financev1.LedgerExport_FAILED
+	default:
+		return financev1.LedgerExport_STATUS_UNSPECIFIED
+	}
+}
+
+func toProtoLedgerExport(e *finance.LedgerExport) *financev1.LedgerExport {
+	if e == nil {
+		return nil
+	}
+	pb := &financev1.LedgerExport{
+		Id:          string(e.ID),
+		SpaceId:     string(e.SpaceID),
+		Format:      toProtoLedgerFormat(e.Format),
+		StartDate:   timestamppb.New(e.StartDate),
+		EndDate:     timestamppb.New(e.EndDate),
+		Status:      toProtoLedgerExportStatus(e.Status),
+		FileName:    e.FileName,
+		ContentType: e.ContentType,
+		SizeBytes:   e.SizeBytes,
+		EntryCount:  e.EntryCount,
+		Error:       e.Error,
+		CreateTime:  timestamppb.New(e.CreateTime),
+		UpdateTime:  timestamppb.New(e.UpdateTime),
+	}
+	if e.CompleteTime != nil {
+		pb.CompleteTime = timestamppb.New(*e.CompleteTime)
+	}
+	return pb
+}
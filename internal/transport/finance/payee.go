@@ -0,0 +1,199 @@
+package finance
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	financev1 "github.com/masterkeysrd/saturn/apis/saturn/finance/v1"
+	financeapp "github.com/masterkeysrd/saturn/internal/application/finance"
+	"github.com/masterkeysrd/saturn/internal/domain/finance"
+	"github.com/masterkeysrd/saturn/internal/platform/conv"
+	"github.com/masterkeysrd/saturn/internal/platform/sorting"
+)
+
+func (h *Handler) CreatePayee(ctx context.Context, req *financev1.CreatePayeeRequest) (*financev1.Payee, error) {
+	p := req.GetPayee()
+	if p == nil {
+		return nil, status.Error(codes.InvalidArgument, "payee is required")
+	}
+
+	budgetID, err := parseOptionalBudgetID(p.DefaultBudgetId)
+	if err != nil {
+		return nil, err
+	}
+
+	accountID, err := parseOptionalAccountID(p.DefaultAccountId)
+	if err != nil {
+		return nil, err
+	}
+
+	appReq := &financeapp.CreatePayeeRequest{
+		Name:             p.GetName(),
+		Aliases:          p.GetAliases(),
+		Patterns:         p.GetPatterns(),
+		DefaultBudgetID:  budgetID,
+		DefaultAccountID: accountID,
+		LogoURL:          p.GetLogoUrl(),
+	}
+
+	created, err := h.Coordinator.CreatePayee(ctx, appReq)
+	if err != nil {
+		return nil, h.mapError(err)
+	}
+
+	return toProtoPayee(created), nil
+}
+
+func (h *Handler) GetPayee(ctx context.Context, req *financev1.GetPayeeRequest) (*financev1.Payee, error) {
+	id, err := finance.ParsePayeeID(req.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	p, err := h.Coordinator.GetPayee(ctx, id)
+	if err != nil {
+		return nil, h.mapError(err)
+	}
+
+	return toProtoPayee(p), nil
+}
+
+func (h *Handler) UpdatePayee(ctx context.Context, req *financev1.UpdatePayeeRequest) (*financev1.Payee, error) {
+	p := req.GetPayee()
+	if p == nil {
+		return nil, status.Error(codes.InvalidArgument, "payee is required")
+	}
+
+	id, err := finance.ParsePayeeID(req.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	budgetID, err := parseOptionalBudgetID(p.DefaultBudgetId)
+	if err != nil {
+		return nil, err
+	}
+
+	accountID, err := parseOptionalAccountID(p.DefaultAccountId)
+	if err != nil {
+		return nil, err
+	}
+
+	appReq := &financeapp.UpdatePayeeRequest{
+		ID:               id,
+		Name:             p.GetName(),
+		Aliases:          p.GetAliases(),
+		Patterns:         p.GetPatterns(),
+		DefaultBudgetID:  budgetID,
+		DefaultAccountID: accountID,
+		LogoURL:          p.GetLogoUrl(),
+		UpdateMask:       req.GetUpdateMask().GetPaths(),
+	}
+
+	updated, err := h.Coordinator.UpdatePayee(ctx, appReq)
+	if err != nil {
+		return nil, h.mapError(err)
+	}
+
+	return toProtoPayee(updated), nil
+}
+
+func (h *Handler) DeletePayee(ctx context.Context, req *financev1.DeletePayeeRequest) (*emptypb.Empty, error) {
+	id, err := finance.ParsePayeeID(req.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := h.Coordinator.DeletePayee(ctx, id); err != nil {
+		return nil, h.mapError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *Handler) ListPayees(ctx context.Context, req *financev1.ListPayeesRequest) (*financev1.ListPayeesResponse, error) {
+	filter := &finance.ListPayeesFilter{
+		PageSize:      req.GetPageSize(),
+		NextPageToken: req.GetPageToken(),
+		Sort:          sorting.Parse(req.GetSort()),
+	}
+
+	page, err := h.Coordinator.ListPayees(ctx, filter)
+	if err != nil {
+		return nil, h.mapError(err)
+	}
+
+	protoPayees := make([]*financev1.Payee, 0, len(page.Items))
+	for _, p := range page.Items {
+		protoPayees = append(protoPayees, toProtoPayee(p))
+	}
+
+	return &financev1.ListPayeesResponse{
+		Payees:        protoPayees,
+		NextPageToken: page.NextPageToken,
+	}, nil
+}
+
+// --- Mappers ---
+
+func parseOptionalPayeeID(raw *string) (*finance.PayeeID, error) {
+	if raw == nil || *raw == "" {
+		return nil, nil
+	}
+
+	id, err := finance.ParsePayeeID(*raw)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &id, nil
+}
+
+func parseOptionalBudgetID(raw *string) (*finance.BudgetID, error) {
+	if raw == nil || *raw == "" {
+		return nil, nil
+	}
+
+	id, err := finance.ParseBudgetID(*raw)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &id, nil
+}
+
+func parseOptionalAccountID(raw *string) (*finance.AccountID, error) {
+	if raw == nil || *raw == "" {
+		return nil, nil
+	}
+
+	id, err := finance.ParseAccountID(*raw)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &id, nil
+}
+
+func toProtoPayee(p *finance.Payee) *financev1.Payee {
+	if p == nil {
+		return nil
+	}
+
+	return &financev1.Payee{
+		Id:               string(p.ID),
+		SpaceId:          string(p.SpaceID),
+		Name:             p.Name,
+		Aliases:          p.Aliases,
+		Patterns:         p.Patterns,
+		DefaultBudgetId:  conv.StringPtr(p.DefaultBudgetID),
+		DefaultAccountId: conv.StringPtr(p.DefaultAccountID),
+		LogoUrl:          p.LogoURL,
+		CreateTime:       timestamppb.New(p.CreateTime),
+		UpdateTime:       timestamppb.New(p.UpdateTime),
+	}
+}
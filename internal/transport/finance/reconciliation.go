@@ -0,0 +1,189 @@
+package finance
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	financev1 "github.com/masterkeysrd/saturn/apis/saturn/finance/v1"
+	financeapp "github.com/masterkeysrd/saturn/internal/application/finance"
+	"github.com/masterkeysrd/saturn/internal/domain/finance"
+)
+
+func (h *Handler) StartReconciliation(ctx context.Context, req *financev1.StartReconciliationRequest) (*financev1.Reconciliation, error) {
+	accountID, err := finance.ParseAccountID(req.GetAccountId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	rec := req.GetReconciliation()
+	if rec == nil {
+		return nil, status.Error(codes.InvalidArgument, "reconciliation details are required")
+	}
+	if rec.GetStatementDate() == nil {
+		return nil, status.Error(codes.InvalidArgument, "statement_date is required")
+	}
+
+	appReq := &financeapp.StartReconciliationRequest{
+		AccountID:        accountID,
+		StatementDate:    rec.GetStatementDate().AsTime(),
+		StatementBalance: rec.GetStatementBalance(),
+		Note:             rec.GetNote(),
+	}
+
+	r, err := h.Coordinator.StartReconciliation(ctx, appReq)
+	if err != nil {
+		return nil, h.mapError(err)
+	}
+
+	return toProtoReconciliation(r), nil
+}
+
+func (h *Handler) GetReconciliation(ctx context.Context, req *financev1.GetReconciliationRequest) (*financev1.Reconciliation, error) {
+	id, err := finance.ParseReconciliationID(req.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	r, err := h.Coordinator.GetReconciliation(ctx, id)
+	if err != nil {
+		return nil, h.mapError(err)
+	}
+
+	return toProtoReconciliation(r), nil
+}
+
+func (h *Handler) ListReconciliations(ctx context.Context, req *financev1.ListReconciliationsRequest) (*financev1.ListReconciliationsResponse, error) {
+	accountID, err := finance.ParseAccountID(req.GetAccountId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	list, err := h.Coordinator.ListReconciliations(ctx, accountID)
+	if err != nil {
+		return nil, h.mapError(err)
+	}
+
+	items := make([]*financev1.Reconciliation, 0, len(list))
+	for _, r := range list {
+		items = append(items, toProtoReconciliation(r))
+	}
+
+	return &financev1.ListReconciliationsResponse{Reconciliations: items}, nil
+}
+
+func (h *Handler) ListReconciliationTransactions(ctx context.Context, req *financev1.ListReconciliationTransactionsRequest) (*financev1.ListReconciliationTransactionsResponse, error) {
+	id, err := finance.ParseReconciliationID(req.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	txns, err := h.Coordinator.ListReconciliationTransactions(ctx, id)
+	if err != nil {
+		return nil, h.mapError(err)
+	}
+
+	items := make([]*financev1.Transaction, 0, len(txns))
+	for _, t := range txns {
+		items = append(items, toProtoTransaction(t))
+	}
+
+	return &financev1.ListReconciliationTransactionsResponse{Transactions: items}, nil
+}
+
+func (h *Handler) SetTransactionsCleared(ctx context.Context, req *financev1.SetTransactionsClearedRequest) (*financev1.Reconciliation, error) {
+	id, err := finance.ParseReconciliationID(req.GetReconciliationId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if len(req.GetTransactionIds()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "transaction_ids is required")
+	}
+
+	txnIDs := make([]finance.TransactionID, 0, len(req.GetTransactionIds()))
+	for _, raw := range req.GetTransactionIds() {
+		txnID, err := finance.ParseTransactionID(raw)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		txnIDs = append(txnIDs, txnID)
+	}
+
+	r, err := h.Coordinator.SetTransactionsCleared(ctx, id, txnIDs, req.GetCleared())
+	if err != nil {
+		return nil, h.mapError(err)
+	}
+
+	return toProtoReconciliation(r), nil
+}
+
+func (h *Handler) FinalizeReconciliation(ctx context.Context, req *financev1.FinalizeReconciliationRequest) (*financev1.Reconciliation, error) {
+	id, err := finance.ParseReconciliationID(req.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	r, err := h.Coordinator.FinalizeReconciliation(ctx, id, req.GetCreateAdjustment())
+	if err != nil {
+		return nil, h.mapError(err)
+	}
+
+	return toProtoReconciliation(r), nil
+}
+
+func (h *Handler) CancelReconciliation(ctx context.Context, req *financev1.CancelReconciliationRequest) (*emptypb.Empty, error) {
+	id, err := finance.ParseReconciliationID(req.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := h.Coordinator.CancelReconciliation(ctx, id); err != nil {
+		return nil, h.mapError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// --- Mappers ---
+
+func toProtoReconciliation(r *finance.Reconciliation) *financev1.Reconciliation {
+	if r == nil {
+		return nil
+	}
+
+	var reconStatus financev1.Reconciliation_Status
+	switch r.Status {
+	case finance.ReconciliationStatusInProgress:
+		reconStatus = financev1.Reconciliation_IN_PROGRESS
+	case finance.ReconciliationStatusFinalized:
+		reconStatus = financev1.Reconciliation_FINALIZED
+	default:
+		reconStatus = financev1.Reconciliation_STATUS_UNSPECIFIED
+	}
+
+	pb := &financev1.Reconciliation{
+		Id:               string(r.ID),
+		AccountId:        string(r.AccountID),
+		StatementDate:    timestamppb.New(r.StatementDate),
+		StatementBalance: r.StatementBalance,
+		OpeningBalance:   r.OpeningBalance,
+		ClearedBalance:   r.ClearedBalance,
+		Difference:       r.Difference,
+		ClearedCount:     r.ClearedCount,
+		Status:           reconStatus,
+		Note:             r.Note,
+		CreateTime:       timestamppb.New(r.CreateTime),
+		UpdateTime:       timestamppb.New(r.UpdateTime),
+	}
+	if r.AdjustmentTransactionID != nil {
+		adjustmentID := string(*r.AdjustmentTransactionID)
+		pb.AdjustmentTransactionId = &adjustmentID
+	}
+	if r.FinalizeTime != nil {
+		pb.FinalizeTime = timestamppb.New(*r.FinalizeTime)
+	}
+	return pb
+}
@@ -18,7 +18,20 @@ import (
 )
 
 func (h *Handler) CreateRecurringExpense(ctx context.Context, req *financev1.CreateRecurringExpenseRequest) (*financev1.RecurringExpense, error) {
-	exp := req.GetRecurringExpense()
+	appReq, err := toAppCreateRecurringExpenseRequest(req.GetRecurringExpense())
+	if err != nil {
+		return nil, err
+	}
+
+	expense, err := h.Coordinator.CreateRecurringExpense(ctx, appReq)
+	if err != nil {
+		return nil, h.mapError(err)
+	}
+
+	return toProtoRecurringExpense(expense), nil
+}
+
+func toAppCreateRecurringExpenseRequest(exp *financev1.RecurringExpense) (*financeapp.CreateRecurringExpenseRequest, error) {
 	if exp == nil {
 		return nil, status.Error(codes.InvalidArgument, "recurring_expense is required")
 	}
@@ -33,28 +46,24 @@ func (h *Handler) CreateRecurringExpense(ctx context.Context, req *financev1.Cre
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	interval, err := mapProtoIntervalToDomain(exp.GetInterval())
+	interval, err := recurringExpenseInterval(exp)
 	if err != nil {
 		return nil, err
 	}
 
-	appReq := &financeapp.CreateRecurringExpenseRequest{
+	return &financeapp.CreateRecurringExpenseRequest{
 		BudgetID:        finance.BudgetID(exp.GetBudgetId()),
 		Name:            exp.GetName(),
 		Amount:          exp.GetAmount(),
 		Currency:        currency,
 		Interval:        interval,
+		RecurrenceRule:  exp.GetRecurrenceRule(),
+		StartDate:       fromProtoDate(exp.GetStartDate()),
+		ExceptionDates:  fromProtoDates(exp.GetExceptionDates()),
 		DueDate:         nextDueDate,
 		IsVariable:      exp.GetIsVariable(),
 		GracePeriodDays: exp.GetGracePeriodDays(),
-	}
-
-	expense, err := h.Coordinator.CreateRecurringExpense(ctx, appReq)
-	if err != nil {
-		return nil, h.mapError(err)
-	}
-
-	return toProtoRecurringExpense(expense), nil
+	}, nil
 }
 
 func (h *Handler) UpdateRecurringExpense(ctx context.Context, req *financev1.UpdateRecurringExpenseRequest) (*financev1.RecurringExpense, error) {
@@ -78,7 +87,7 @@ func (h *Handler) UpdateRecurringExpense(ctx context.Context, req *financev1.Upd
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	interval, err := mapProtoIntervalToDomain(exp.GetInterval())
+	interval, err := recurringExpenseInterval(exp)
 	if err != nil {
 		return nil, err
 	}
@@ -95,6 +104,9 @@ func (h *Handler) UpdateRecurringExpense(ctx context.Context, req *financev1.Upd
 		Amount:          exp.GetAmount(),
 		Currency:        currency,
 		Interval:        interval,
+		RecurrenceRule:  exp.GetRecurrenceRule(),
+		StartDate:       fromProtoDate(exp.GetStartDate()),
+		ExceptionDates:  fromProtoDates(exp.GetExceptionDates()),
 		DueDate:         nextDueDate,
 		IsVariable:      exp.GetIsVariable(),
 		Status:          string(statusVal),
@@ -168,6 +180,47 @@ func (h *Handler) ListRecurringExpenses(ctx context.Context, req *financev1.List
 	}, nil
 }
 
+func (h *Handler) DetectSubscriptions(ctx context.Context, req *financev1.DetectSubscriptionsRequest) (*financev1.DetectSubscriptionsResponse, error) {
+	suggestions, err := h.Coordinator.DetectSubscriptions(ctx, &financeapp.DetectSubscriptionsRequest{
+		LookbackDays: req.GetLookbackDays(),
+	})
+	if err != nil {
+		return nil, h.mapError(err)
+	}
+
+	out := make([]*financev1.SubscriptionSuggestion, 0, len(suggestions))
+	for _, s := range suggestions {
+		out = append(out, toProtoSubscriptionSuggestion(s))
+	}
+	return &financev1.DetectSubscriptionsResponse{Suggestions: out}, nil
+}
+
+func (h *Handler) ConvertToRecurringExpense(ctx context.Context, req *financev1.ConvertToRecurringExpenseRequest) (*financev1.RecurringExpense, error) {
+	template, err := toAppCreateRecurringExpenseRequest(req.GetRecurringExpense())
+	if err != nil {
+		return nil, err
+	}
+
+	txnIDs := make([]finance.TransactionID, 0, len(req.GetTransactionIds()))
+	for _, raw := range req.GetTransactionIds() {
+		txnID, err := finance.ParseTransactionID(raw)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		txnIDs = append(txnIDs, txnID)
+	}
+
+	expense, err := h.Coordinator.ConvertToRecurringExpense(ctx, &financeapp.ConvertToRecurringExpenseRequest{
+		RecurringExpense: *template,
+		TransactionIDs:   txnIDs,
+	})
+	if err != nil {
+		return nil, h.mapError(err)
+	}
+
+	return toProtoRecurringExpense(expense), nil
+}
+
 func (h *Handler) ListScheduledPayments(ctx context.Context, req *financev1.ListScheduledPaymentsRequest) (*financev1.ListScheduledPaymentsResponse, error) {
 	spaceIDStr, ok := auth.SpaceIDFromContext(ctx)
 	if !ok {
@@ -342,14 +395,31 @@ func (h *Handler) SkipScheduledPayment(ctx context.Context, req *financev1.SkipS
 
 // --- Mappers ---
 
+// recurringExpenseInterval maps the requested interval. It may be omitted when a recurrence rule is
+// given, since the domain derives it from the rule.
+func recurringExpenseInterval(exp *financev1.RecurringExpense) (string, error) {
+	if exp.GetRecurrenceRule() != "" {
+		// BIWEEKLY and CUSTOM are output only, derived from the rule.
+		switch exp.GetInterval() {
+		case financev1.RecurringExpense_INTERVAL_UNSPECIFIED, financev1.RecurringExpense_BIWEEKLY, financev1.RecurringExpense_CUSTOM:
+			return "", nil
+		}
+	}
+	return mapProtoIntervalToDomain(exp.GetInterval())
+}
+
 func mapProtoIntervalToDomain(interval financev1.RecurringExpense_Interval) (string, error) {
 	switch interval {
 	case financev1.RecurringExpense_WEEKLY:
 		return "weekly", nil
+	case financev1.RecurringExpense_BIWEEKLY:
+		return "biweekly", nil
 	case financev1.RecurringExpense_MONTHLY:
 		return "monthly", nil
 	case financev1.RecurringExpense_YEARLY:
 		return "yearly", nil
+	case financev1.RecurringExpense_CUSTOM:
+		return "custom", nil
 	default:
 		return "", status.Error(codes.InvalidArgument, "invalid recurring expense interval")
 	}
@@ -359,10 +429,14 @@ func mapDomainIntervalToProto(interval string) financev1.RecurringExpense_Interv
 	switch interval {
 	case "weekly":
 		return financev1.RecurringExpense_WEEKLY
+	case "biweekly":
+		return financev1.RecurringExpense_BIWEEKLY
 	case "monthly":
 		return financev1.RecurringExpense_MONTHLY
 	case "yearly":
 		return financev1.RecurringExpense_YEARLY
+	case "custom":
+		return financev1.RecurringExpense_CUSTOM
 	default:
 		return financev1.RecurringExpense_INTERVAL_UNSPECIFIED
 	}
@@ -401,6 +475,8 @@ func mapDomainSourceTypeToProto(st string) financev1.ScheduledPayment_SourceType
 		return financev1.ScheduledPayment_LOAN
 	case "tax":
 		return financev1.ScheduledPayment_TAX
+	case finance.SourceTypeCardStatement:
+		return financev1.ScheduledPayment_CARD_STATEMENT
 	default:
 		return financev1.ScheduledPayment_SOURCE_TYPE_UNSPECIFIED
 	}
@@ -456,6 +532,27 @@ func toProtoRecurringExpense(e *finance.RecurringExpense) *financev1.RecurringEx
 		GracePeriodDays: e.GracePeriodDays,
 		CreateTime:      timestamppb.New(e.CreateTime),
 		UpdateTime:      timestamppb.New(e.UpdateTime),
+		RecurrenceRule:  e.RecurrenceRule,
+		StartDate:       timestamppb.New(e.StartDate),
+		ExceptionDates:  toProtoDates(e.ExceptionDates),
+	}
+}
+
+func toProtoSubscriptionSuggestion(s *finance.SubscriptionSuggestion) *financev1.SubscriptionSuggestion {
+	// The template is not persisted yet, so it has no identity or timestamps.
+	template := toProtoRecurringExpense(s.RecurringExpense)
+	template.CreateTime = nil
+	template.UpdateTime = nil
+
+	txnIDs := make([]string, 0, len(s.TransactionIDs))
+	for _, txnID := range s.TransactionIDs {
+		txnIDs = append(txnIDs, string(txnID))
+	}
+
+	return &financev1.SubscriptionSuggestion{
+		RecurringExpense: template,
+		Confidence:       s.Confidence,
+		TransactionIds:   txnIDs,
 	}
 }
 
@@ -517,3 +614,26 @@ func toProtoAggregatedScheduledPayment(ap *financeaggregator.AggregatedScheduled
 	}
 	return protoVal
 }
+
+func fromProtoDate(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+	return ts.AsTime()
+}
+
+func fromProtoDates(in []*timestamppb.Timestamp) []time.Time {
+	out := make([]time.Time, 0, len(in))
+	for _, ts := range in {
+		out = append(out, ts.AsTime())
+	}
+	return out
+}
+
+func toProtoDates(in []time.Time) []*timestamppb.Timestamp {
+	out := make([]*timestamppb.Timestamp, 0, len(in))
+	for _, t := range in {
+		out = append(out, timestamppb.New(t))
+	}
+	return out
+}
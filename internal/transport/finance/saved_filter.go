@@ -0,0 +1,133 @@
+package finance
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	financev1 "github.com/masterkeysrd/saturn/apis/saturn/finance/v1"
+	financeapp "github.com/masterkeysrd/saturn/internal/application/finance"
+	"github.com/masterkeysrd/saturn/internal/domain/finance"
+	"github.com/masterkeysrd/saturn/internal/platform/sorting"
+)
+
+func (h *Handler) CreateSavedFilter(ctx context.Context, req *financev1.CreateSavedFilterRequest) (*financev1.SavedFilter, error) {
+	f := req.GetSavedFilter()
+	if f == nil {
+		return nil, status.Error(codes.InvalidArgument, "saved filter is required")
+	}
+
+	appReq := &financeapp.CreateSavedFilterRequest{
+		Name:        f.GetName(),
+		Description: f.GetDescription(),
+		Expression:  f.GetExpression(),
+		Sort:        f.GetSort(),
+	}
+
+	created, err := h.Coordinator.CreateSavedFilter(ctx, appReq)
+	if err != nil {
+		return nil, h.mapError(err)
+	}
+
+	return toProtoSavedFilter(created), nil
+}
+
+func (h *Handler) GetSavedFilter(ctx context.Context, req *financev1.GetSavedFilterRequest) (*financev1.SavedFilter, error) {
+	id, err := finance.ParseSavedFilterID(req.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	f, err := h.Coordinator.GetSavedFilter(ctx, id)
+	if err != nil {
+		return nil, h.mapError(err)
+	}
+
+	return toProtoSavedFilter(f), nil
+}
+
+func (h *Handler) UpdateSavedFilter(ctx context.Context, req *financev1.UpdateSavedFilterRequest) (*financev1.SavedFilter, error) {
+	f := req.GetSavedFilter()
+	if f == nil {
+		return nil, status.Error(codes.InvalidArgument, "saved filter is required")
+	}
+
+	id, err := finance.ParseSavedFilterID(req.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	appReq := &financeapp.UpdateSavedFilterRequest{
+		ID:          id,
+		Name:        f.GetName(),
+		Description: f.GetDescription(),
+		Expression:  f.GetExpression(),
+		Sort:        f.GetSort(),
+		UpdateMask:  req.GetUpdateMask().GetPaths(),
+	}
+
+	updated, err := h.Coordinator.UpdateSavedFilter(ctx, appReq)
+	if err != nil {
+		return nil, h.mapError(err)
+	}
+
+	return toProtoSavedFilter(updated), nil
+}
+
+func (h *Handler) DeleteSavedFilter(ctx context.Context, req *financev1.DeleteSavedFilterRequest) (*emptypb.Empty, error) {
+	id, err := finance.ParseSavedFilterID(req.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := h.Coordinator.DeleteSavedFilter(ctx, id); err != nil {
+		return nil, h.mapError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *Handler) ListSavedFilters(ctx context.Context, req *financev1.ListSavedFiltersRequest) (*financev1.ListSavedFiltersResponse, error) {
+	filter := &finance.ListSavedFiltersFilter{
+		PageSize:      req.GetPageSize(),
+		NextPageToken: req.GetPageToken(),
+		Sort:          sorting.Parse(req.GetSort()),
+	}
+
+	page, err := h.Coordinator.ListSavedFilters(ctx, filter)
+	if err != nil {
+		return nil, h.mapError(err)
+	}
+
+	protoFilters := make([]*financev1.SavedFilter, 0, len(page.Items))
+	for _, f := range page.Items {
+		protoFilters = append(protoFilters, toProtoSavedFilter(f))
+	}
+
+	return &financev1.ListSavedFiltersResponse{
+		SavedFilters:  protoFilters,
+		NextPageToken: page.NextPageToken,
+	}, nil
+}
+
+// --- Mappers ---
+
+func toProtoSavedFilter(f *finance.SavedFilter) *financev1.SavedFilter {
+	if f == nil {
+		return nil
+	}
+
+	return &financev1.SavedFilter{
+		Id:          string(f.ID),
+		SpaceId:     string(f.SpaceID),
+		Name:        f.Name,
+		Description: f.Description,
+		Expression:  f.Expression,
+		Sort:        f.Sort,
+		CreateTime:  timestamppb.New(f.CreateTime),
+		UpdateTime:  timestamppb.New(f.UpdateTime),
+	}
+}
@@ -12,12 +12,45 @@ func (h *Handler) HandleGenerateScheduledPayments(ctx context.Context, payload *
 	return h.Coordinator.GenerateScheduledPayments(ctx)
 }
 
+// HandleSyncExchangeRates processes the system-wide refresh of exchange rates from the rate provider.
+func (h *Handler) HandleSyncExchangeRates(ctx context.Context, payload *financev1.SyncExchangeRatesPayload) error {
+	return h.Coordinator.SyncExchangeRates(ctx)
+}
+
+// HandleCloseCardStatements processes the system-wide closing of elapsed credit card billing cycles.
+func (h *Handler) HandleCloseCardStatements(ctx context.Context, payload *financev1.CloseCardStatementsPayload) error {
+	return h.Coordinator.CloseCardStatements(ctx)
+}
+
 // RegisterSchedules seeds the cron triggers/templates into the platform database.
 func (h *Handler) RegisterSchedules(ctx context.Context, engine *scheduler.Engine) error {
-	return engine.RegisterSchedule(ctx, scheduler.Schedule{
-		ID:             "generate_scheduled_payments",
-		JobType:        "finance.GenerateScheduledPayments",
-		CronExpression: "0 0 0 * * *",
-		Payload:        struct{}{},
-	})
+	schedules := []scheduler.Schedule{
+		{
+			ID:             "generate_scheduled_payments",
+			JobType:        "finance.GenerateScheduledPayments",
+			CronExpression: "0 0 0 * * *",
+			Payload:        struct{}{},
+		},
+		{
+			// Reference rates are typically published mid-afternoon CET; sync once they are out.
+			ID:             "sync_exchange_rates",
+			JobType:        "finance.SyncExchangeRates",
+			CronExpression: "0 0 17 * * *",
+			Payload:        struct{}{},
+		},
+		{
+			// Closing days are evaluated in each space's timezone, so check every hour.
+			ID:             "close_card_statements",
+			JobType:        "finance.CloseCardStatements",
+			CronExpression: "0 5 * * * *",
+			Payload:        struct{}{},
+		},
+	}
+
+	for _, schedule := range schedules {
+		if err := engine.RegisterSchedule(ctx, schedule); err != nil {
+			return err
+		}
+	}
+	return nil
 }
@@ -0,0 +1,126 @@
+package finance
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	financev1 "github.com/masterkeysrd/saturn/apis/saturn/finance/v1"
+	financeapp "github.com/masterkeysrd/saturn/internal/application/finance"
+	"github.com/masterkeysrd/saturn/internal/domain/finance"
+)
+
+func (h *Handler) ImportStatement(ctx context.Context, req *financev1.ImportStatementRequest) (*financev1.ImportStatementResponse, error) {
+	accountID, err := finance.ParseAccountID(req.GetAccountId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	format, err := toDomainStatementFormat(req.GetFormat())
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.GetContent()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "statement content is required")
+	}
+
+	appReq := &financeapp.ImportStatementRequest{
+		AccountID:   accountID,
+		Format:      format,
+		Content:     req.GetContent(),
+		Mapping:     toDomainStatementMapping(req.GetCsvMapping()),
+		SaveMapping: req.GetSaveMapping(),
+	}
+
+	result, err := h.Coordinator.ImportStatement(ctx, appReq)
+	if err != nil {
+		return nil, h.mapError(err)
+	}
+
+	items := make([]*financev1.InboxItem, 0, len(result.Items))
+	for _, item := range result.Items {
+		items = append(items, toProtoInboxItem(item))
+	}
+
+	return &financev1.ImportStatementResponse{
+		InboxItems:     items,
+		SkippedCount:   result.SkippedCount,
+		DuplicateCount: result.DuplicateCount,
+	}, nil
+}
+
+func (h *Handler) GetStatementMapping(ctx context.Context, req *financev1.GetStatementMappingRequest) (*financev1.StatementMapping, error) {
+	accountID, err := finance.ParseAccountID(req.GetAccountId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	mapping, err := h.Coordinator.GetStatementMapping(ctx, accountID)
+	if err != nil {
+		return nil, h.mapError(err)
+	}
+
+	return toProtoStatementMapping(mapping), nil
+}
+
+// --- Mappers ---
+
+func toDomainStatementFormat(f financev1.StatementFormat) (finance.StatementFormat, error) {
+	switch f {
+	case financev1.StatementFormat_STATEMENT_FORMAT_CSV:
+		return finance.StatementFormatCSV, nil
+	case financev1.StatementFormat_STATEMENT_FORMAT_OFX:
+		return finance.StatementFormatOFX, nil
+	case financev1.StatementFormat_STATEMENT_FORMAT_QFX:
+		return finance.StatementFormatQFX, nil
+	case financev1.StatementFormat_STATEMENT_FORMAT_CAMT_053:
+		return finance.StatementFormatCAMT053, nil
+	default:
+		return "", status.Error(codes.InvalidArgument, "statement format is required")
+	}
+}
+
+func toDomainStatementMapping(pb *financev1.StatementMapping) *finance.StatementMapping {
+	if pb == nil {
+		return nil
+	}
+	return &finance.StatementMapping{
+		Delimiter:         pb.GetDelimiter(),
+		HasHeader:         pb.GetHasHeader(),
+		DateColumn:        pb.GetDateColumn(),
+		DateFormat:        pb.GetDateFormat(),
+		AmountColumn:      pb.GetAmountColumn(),
+		DebitColumn:       pb.GetDebitColumn(),
+		CreditColumn:      pb.GetCreditColumn(),
+		DescriptionColumn: pb.GetDescriptionColumn(),
+		ReferenceColumn:   pb.GetReferenceColumn(),
+		CurrencyColumn:    pb.GetCurrencyColumn(),
+		DecimalSeparator:  pb.GetDecimalSeparator(),
+		InvertAmounts:     pb.GetInvertAmounts(),
+	}
+}
+
+func toProtoStatementMapping(m *finance.StatementMapping) *financev1.StatementMapping {
+	if m == nil {
+		return nil
+	}
+	return &financev1.StatementMapping{
+		AccountId:         string(m.AccountID),
+		Delimiter:         m.Delimiter,
+		HasHeader:         m.HasHeader,
+		DateColumn:        m.DateColumn,
+		DateFormat:        m.DateFormat,
+		AmountColumn:      m.AmountColumn,
+		DebitColumn:       m.DebitColumn,
+		CreditColumn:      m.CreditColumn,
+		DescriptionColumn: m.DescriptionColumn,
+		ReferenceColumn:   m.ReferenceColumn,
+		CurrencyColumn:    m.CurrencyColumn,
+		DecimalSeparator:  m.DecimalSeparator,
+		InvertAmounts:     m.InvertAmounts,
+		UpdateTime:        timestamppb.New(m.UpdateTime),
+	}
+}
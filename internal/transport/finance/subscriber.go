@@ -0,0 +1,61 @@
+package finance
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	financev1 "github.com/masterkeysrd/saturn/apis/saturn/finance/v1"
+	"github.com/masterkeysrd/saturn/internal/domain/finance"
+	"github.com/masterkeysrd/saturn/internal/platform/eventbus"
+)
+
+// RegisterSubscribers binds all finance event bus subscribers.
+func (h *Handler) RegisterSubscribers(bus *eventbus.Engine) {
+	financev1.SubscribeTransactionChangedEvent(bus, "finance_alert_rules", func(ctx context.Context, payload *financev1.TransactionChangedEvent) error {
+		spaceID := finance.SpaceID(payload.GetSpaceId())
+		txnID, err := finance.ParseTransactionID(payload.GetTransactionId())
+		if err != nil {
+			return fmt.Errorf("parse transaction ID: %w", err)
+		}
+
+		alerts, err := h.Coordinator.EvaluateTransactionAlerts(ctx, spaceID, txnID)
+		if err != nil {
+			return fmt.Errorf("evaluate transaction alerts: %w", err)
+		}
+
+		for _, a := range alerts {
+			evt := &financev1.AlertRaisedEvent{
+				SpaceId: string(a.SpaceID),
+				Alert:   toProtoAlert(a),
+			}
+			if err := financev1.PublishAlertRaisedEvent(ctx, bus, evt); err != nil {
+				return fmt.Errorf("publish alert raised event: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
+// TransactionChangedPublisher implements finance.TransactionNotifier by publishing a
+// TransactionChangedEvent for the alert rules subscriber.
+type TransactionChangedPublisher struct {
+	bus *eventbus.Engine
+}
+
+// NewTransactionChangedPublisher creates a new TransactionChangedPublisher.
+func NewTransactionChangedPublisher(bus *eventbus.Engine) *TransactionChangedPublisher {
+	return &TransactionChangedPublisher{bus: bus}
+}
+
+// TransactionChanged publishes the change. The transaction is already stored, so a failed publish
+// is logged rather than failing the request.
+func (p *TransactionChangedPublisher) TransactionChanged(ctx context.Context, txn *finance.Transaction) {
+	evt := &financev1.TransactionChangedEvent{
+		SpaceId:       string(txn.SpaceID),
+		TransactionId: string(txn.ID),
+	}
+	if err := financev1.PublishTransactionChangedEvent(ctx, p.bus, evt); err != nil {
+		slog.ErrorContext(ctx, "publish transaction changed event failed", "transaction_id", txn.ID, "err", err)
+	}
+}
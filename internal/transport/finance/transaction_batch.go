@@ -0,0 +1,162 @@
+package finance
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	financev1 "github.com/masterkeysrd/saturn/apis/saturn/finance/v1"
+	financeapp "github.com/masterkeysrd/saturn/internal/application/finance"
+	"github.com/masterkeysrd/saturn/internal/domain/finance"
+	"github.com/masterkeysrd/saturn/internal/platform/conv"
+)
+
+func (h *Handler) BatchUpdateTransactions(ctx context.Context, req *financev1.BatchUpdateTransactionsRequest) (*financev1.BatchUpdateTransactionsResponse, error) {
+	t := req.GetTransaction()
+	if t == nil {
+		return nil, status.Error(codes.InvalidArgument, "transaction values are required")
+	}
+
+	ids, savedFilterID, err := parseTransactionSelector(req.GetIds(), req.SavedFilterId)
+	if err != nil {
+		return nil, err
+	}
+
+	budgetID, err := parseOptionalBudgetID(conv.Ptr(t.GetBudgetId()))
+	if err != nil {
+		return nil, err
+	}
+
+	accountID, err := parseOptionalAccountID(t.AccountId)
+	if err != nil {
+		return nil, err
+	}
+
+	categoryID, err := parseOptionalCategoryID(t.CategoryId)
+	if err != nil {
+		return nil, err
+	}
+
+	payeeID, err := parseOptionalPayeeID(t.PayeeId)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := h.Coordinator.BatchUpdateTransactions(ctx, &financeapp.BatchUpdateTransactionsRequest{
+		IDs:           ids,
+		Filter:        req.GetFilter(),
+		SavedFilterID: savedFilterID,
+		Description:   t.GetDescription(),
+		BudgetID:      budgetID,
+		AccountID:     accountID,
+		CategoryID:    categoryID,
+		PayeeID:       payeeID,
+		Tags:          t.GetTags(),
+		UpdateMask:    req.GetUpdateMask().GetPaths(),
+		ValidateOnly:  req.GetValidateOnly(),
+	})
+	if err != nil {
+		return nil, h.mapError(err)
+	}
+
+	protoDiffs := make([]*financev1.TransactionDiff, 0, len(result.Diffs))
+	for _, d := range result.Diffs {
+		protoDiffs = append(protoDiffs, toProtoTransactionDiff(d))
+	}
+
+	return &financev1.BatchUpdateTransactionsResponse{
+		Transactions:   toProtoTransactions(result.Transactions),
+		Diffs:          protoDiffs,
+		BalanceChanges: toProtoAccountBalanceChanges(result.BalanceChanges),
+		PeriodIds:      periodIDStrings(result.PeriodIDs),
+	}, nil
+}
+
+func (h *Handler) BatchDeleteTransactions(ctx context.Context, req *financev1.BatchDeleteTransactionsRequest) (*financev1.BatchDeleteTransactionsResponse, error) {
+	ids, savedFilterID, err := parseTransactionSelector(req.GetIds(), req.SavedFilterId)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := h.Coordinator.BatchDeleteTransactions(ctx, &financeapp.BatchDeleteTransactionsRequest{
+		IDs:           ids,
+		Filter:        req.GetFilter(),
+		SavedFilterID: savedFilterID,
+		ValidateOnly:  req.GetValidateOnly(),
+	})
+	if err != nil {
+		return nil, h.mapError(err)
+	}
+
+	return &financev1.BatchDeleteTransactionsResponse{
+		Transactions:   toProtoTransactions(result.Transactions),
+		BalanceChanges: toProtoAccountBalanceChanges(result.BalanceChanges),
+		PeriodIds:      periodIDStrings(result.PeriodIDs),
+	}, nil
+}
+
+// --- Mappers ---
+
+func parseTransactionSelector(rawIDs []string, rawSavedFilterID *string) ([]finance.TransactionID, *finance.SavedFilterID, error) {
+	ids := make([]finance.TransactionID, 0, len(rawIDs))
+	for _, raw := range rawIDs {
+		id, err := finance.ParseTransactionID(raw)
+		if err != nil {
+			return nil, nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		ids = append(ids, id)
+	}
+
+	if rawSavedFilterID == nil || *rawSavedFilterID == "" {
+		return ids, nil, nil
+	}
+	savedFilterID, err := finance.ParseSavedFilterID(*rawSavedFilterID)
+	if err != nil {
+		return nil, nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return ids, &savedFilterID, nil
+}
+
+func toProtoTransactions(txns []*finance.Transaction) []*financev1.Transaction {
+	out := make([]*financev1.Transaction, 0, len(txns))
+	for _, t := range txns {
+		out = append(out, toProtoTransaction(t))
+	}
+	return out
+}
+
+func toProtoTransactionDiff(d *finance.TransactionDiff) *financev1.TransactionDiff {
+	changes := make([]*financev1.TransactionDiff_FieldChange, 0, len(d.Changes))
+	for _, c := range d.Changes {
+		changes = append(changes, &financev1.TransactionDiff_FieldChange{
+			Field:    c.Field,
+			OldValue: c.OldValue,
+			NewValue: c.NewValue,
+		})
+	}
+	return &financev1.TransactionDiff{
+		TransactionId: string(d.TransactionID),
+		Changes:       changes,
+	}
+}
+
+func toProtoAccountBalanceChanges(changes []*finance.AccountBalanceChange) []*financev1.AccountBalanceChange {
+	out := make([]*financev1.AccountBalanceChange, 0, len(changes))
+	for _, c := range changes {
+		out = append(out, &financev1.AccountBalanceChange{
+			AccountId:  string(c.AccountID),
+			OldBalance: c.OldBalance,
+			NewBalance: c.NewBalance,
+		})
+	}
+	return out
+}
+
+func periodIDStrings(ids []finance.PeriodID) []string {
+	out := make([]string, len(ids))
+	for i, id := range ids {
+		out[i] = string(id)
+	}
+	return out
+}
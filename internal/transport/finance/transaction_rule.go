@@ -0,0 +1,217 @@
+package finance
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	financev1 "github.com/masterkeysrd/saturn/apis/saturn/finance/v1"
+	financeapp "github.com/masterkeysrd/saturn/internal/application/finance"
+	"github.com/masterkeysrd/saturn/internal/domain/finance"
+	"github.com/masterkeysrd/saturn/internal/platform/conv"
+	"github.com/masterkeysrd/saturn/internal/platform/sorting"
+)
+
+func (h *Handler) CreateTransactionRule(ctx context.Context, req *financev1.CreateTransactionRuleRequest) (*financev1.TransactionRule, error) {
+	r := req.GetTransactionRule()
+	if r == nil {
+		return nil, status.Error(codes.InvalidArgument, "transaction rule is required")
+	}
+
+	refs, err := parseTransactionRuleRefs(r)
+	if err != nil {
+		return nil, err
+	}
+
+	appReq := &financeapp.CreateTransactionRuleRequest{
+		Name:               r.GetName(),
+		Priority:           r.GetPriority(),
+		DescriptionPattern: r.GetDescriptionPattern(),
+		AccountID:          refs.accountID,
+		MinAmount:          r.MinAmount,
+		MaxAmount:          r.MaxAmount,
+		SetBudgetID:        refs.budgetID,
+		SetPayeeID:         refs.payeeID,
+		SetCategoryID:      refs.categoryID,
+		AddTags:            r.GetAddTags(),
+	}
+
+	created, err := h.Coordinator.CreateTransactionRule(ctx, appReq)
+	if err != nil {
+		return nil, h.mapError(err)
+	}
+
+	return toProtoTransactionRule(created), nil
+}
+
+func (h *Handler) GetTransactionRule(ctx context.Context, req *financev1.GetTransactionRuleRequest) (*financev1.TransactionRule, error) {
+	id, err := finance.ParseTransactionRuleID(req.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	r, err := h.Coordinator.GetTransactionRule(ctx, id)
+	if err != nil {
+		return nil, h.mapError(err)
+	}
+
+	return toProtoTransactionRule(r), nil
+}
+
+func (h *Handler) UpdateTransactionRule(ctx context.Context, req *financev1.UpdateTransactionRuleRequest) (*financev1.TransactionRule, error) {
+	r := req.GetTransactionRule()
+	if r == nil {
+		return nil, status.Error(codes.InvalidArgument, "transaction rule is required")
+	}
+
+	id, err := finance.ParseTransactionRuleID(req.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	refs, err := parseTransactionRuleRefs(r)
+	if err != nil {
+		return nil, err
+	}
+
+	appReq := &financeapp.UpdateTransactionRuleRequest{
+		ID:                 id,
+		Name:               r.GetName(),
+		Priority:           r.GetPriority(),
+		IsActive:           r.GetIsActive(),
+		DescriptionPattern: r.GetDescriptionPattern(),
+		AccountID:          refs.accountID,
+		MinAmount:          r.MinAmount,
+		MaxAmount:          r.MaxAmount,
+		SetBudgetID:        refs.budgetID,
+		SetPayeeID:         refs.payeeID,
+		SetCategoryID:      refs.categoryID,
+		AddTags:            r.GetAddTags(),
+		UpdateMask:         req.GetUpdateMask().GetPaths(),
+	}
+
+	updated, err := h.Coordinator.UpdateTransactionRule(ctx, appReq)
+	if err != nil {
+		return nil, h.mapError(err)
+	}
+
+	return toProtoTransactionRule(updated), nil
+}
+
+func (h *Handler) DeleteTransactionRule(ctx context.Context, req *financev1.DeleteTransactionRuleRequest) (*emptypb.Empty, error) {
+	id, err := finance.ParseTransactionRuleID(req.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := h.Coordinator.DeleteTransactionRule(ctx, id); err != nil {
+		return nil, h.mapError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *Handler) ListTransactionRules(ctx context.Context, req *financev1.ListTransactionRulesRequest) (*financev1.ListTransactionRulesResponse, error) {
+	filter := &finance.ListTransactionRulesFilter{
+		PageSize:      req.GetPageSize(),
+		NextPageToken: req.GetPageToken(),
+		Sort:          sorting.Parse(req.GetSort()),
+		ActiveOnly:    req.ActiveOnly,
+	}
+
+	page, err := h.Coordinator.ListTransactionRules(ctx, filter)
+	if err != nil {
+		return nil, h.mapError(err)
+	}
+
+	protoRules := make([]*financev1.TransactionRule, 0, len(page.Items))
+	for _, r := range page.Items {
+		protoRules = append(protoRules, toProtoTransactionRule(r))
+	}
+
+	return &financev1.ListTransactionRulesResponse{
+		TransactionRules: protoRules,
+		NextPageToken:    page.NextPageToken,
+	}, nil
+}
+
+func (h *Handler) ApplyTransactionRules(ctx context.Context, req *financev1.ApplyTransactionRulesRequest) (*financev1.ApplyTransactionRulesResponse, error) {
+	appReq := &financeapp.ApplyTransactionRulesRequest{
+		Overwrite: req.GetOverwrite(),
+	}
+	if req.GetStartDate() != nil {
+		start := req.GetStartDate().AsTime().UTC()
+		appReq.StartDate = &start
+	}
+	if req.GetEndDate() != nil {
+		end := req.GetEndDate().AsTime().UTC()
+		appReq.EndDate = &end
+	}
+
+	result, err := h.Coordinator.ApplyTransactionRules(ctx, appReq)
+	if err != nil {
+		return nil, h.mapError(err)
+	}
+
+	return &financev1.ApplyTransactionRulesResponse{
+		ScannedCount: result.Scanned,
+		UpdatedCount: result.Updated,
+		SkippedCount: result.Skipped,
+	}, nil
+}
+
+// --- Mappers ---
+
+type transactionRuleRefs struct {
+	accountID  *finance.AccountID
+	budgetID   *finance.BudgetID
+	payeeID    *finance.PayeeID
+	categoryID *finance.CategoryID
+}
+
+func parseTransactionRuleRefs(r *financev1.TransactionRule) (*transactionRuleRefs, error) {
+	var (
+		refs transactionRuleRefs
+		err  error
+	)
+	if refs.accountID, err = parseOptionalAccountID(r.AccountId); err != nil {
+		return nil, err
+	}
+	if refs.budgetID, err = parseOptionalBudgetID(r.SetBudgetId); err != nil {
+		return nil, err
+	}
+	if refs.payeeID, err = parseOptionalPayeeID(r.SetPayeeId); err != nil {
+		return nil, err
+	}
+	if refs.categoryID, err = parseOptionalCategoryID(r.SetCategoryId); err != nil {
+		return nil, err
+	}
+	return &refs, nil
+}
+
+func toProtoTransactionRule(r *finance.TransactionRule) *financev1.TransactionRule {
+	if r == nil {
+		return nil
+	}
+
+	return &financev1.TransactionRule{
+		Id:                 string(r.ID),
+		SpaceId:            string(r.SpaceID),
+		Name:               r.Name,
+		Priority:           r.Priority,
+		IsActive:           r.IsActive,
+		DescriptionPattern: r.DescriptionPattern,
+		AccountId:          conv.StringPtr(r.AccountID),
+		MinAmount:          r.MinAmount,
+		MaxAmount:          r.MaxAmount,
+		SetBudgetId:        conv.StringPtr(r.SetBudgetID),
+		SetPayeeId:         conv.StringPtr(r.SetPayeeID),
+		SetCategoryId:      conv.StringPtr(r.SetCategoryID),
+		AddTags:            r.AddTags,
+		CreateTime:         timestamppb.New(r.CreateTime),
+		UpdateTime:         timestamppb.New(r.UpdateTime),
+	}
+}
@@ -0,0 +1,119 @@
+package finance
+
+import (
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	financev1 "github.com/masterkeysrd/saturn/apis/saturn/finance/v1"
+	"github.com/masterkeysrd/saturn/internal/domain/finance"
+)
+
+// --- Mappers ---
+
+func parseTransactionSplits(pbSplits []*financev1.TransactionSplit) ([]*finance.TransactionSplit, error) {
+	if len(pbSplits) == 0 {
+		return nil, nil
+	}
+
+	splits := make([]*finance.TransactionSplit, 0, len(pbSplits))
+	for i, pb := range pbSplits {
+		split := &finance.TransactionSplit{
+			Amount: pb.GetAmount(),
+			Note:   pb.GetNote(),
+		}
+
+		if pb.GetBudgetId() != "" {
+			budgetID, err := finance.ParseBudgetID(pb.GetBudgetId())
+			if err != nil {
+				return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("split %d: %v", i, err))
+			}
+			split.BudgetID = &budgetID
+		}
+
+		categoryID, err := parseOptionalCategoryID(pb.CategoryId)
+		if err != nil {
+			return nil, err
+		}
+		split.CategoryID = categoryID
+
+		splits = append(splits, split)
+	}
+
+	return splits, nil
+}
+
+func toProtoTransactionSplits(splits []*finance.TransactionSplit) []*financev1.TransactionSplit {
+	if len(splits) == 0 {
+		return nil
+	}
+
+	pbSplits := make([]*financev1.TransactionSplit, 0, len(splits))
+	for _, s := range splits {
+		pb := &financev1.TransactionSplit{
+			Id:           string(s.ID),
+			Amount:       s.Amount,
+			AmountInBase: s.AmountInBase,
+			Note:         s.Note,
+		}
+		if s.BudgetID != nil {
+			budgetID := string(*s.BudgetID)
+			pb.BudgetId = &budgetID
+		}
+		if s.PeriodID != nil {
+			periodID := string(*s.PeriodID)
+			pb.PeriodId = &periodID
+		}
+		if s.CategoryID != nil {
+			categoryID := string(*s.CategoryID)
+			pb.CategoryId = &categoryID
+		}
+		pbSplits = append(pbSplits, pb)
+	}
+
+	return pbSplits
+}
+
+func toProtoInboxItemSplits(splits []*finance.InboxItemSplit) []*financev1.TransactionSplit {
+	if len(splits) == 0 {
+		return nil
+	}
+
+	pbSplits := make([]*financev1.TransactionSplit, 0, len(splits))
+	for _, s := range splits {
+		pbSplits = append(pbSplits, &financev1.TransactionSplit{
+			BudgetId:   s.BudgetID,
+			CategoryId: s.CategoryID,
+			Amount:     s.Amount,
+			Note:       s.Note,
+		})
+	}
+
+	return pbSplits
+}
+
+func toDomainInboxItemSplits(pbSplits []*financev1.TransactionSplit) []*finance.InboxItemSplit {
+	if len(pbSplits) == 0 {
+		return nil
+	}
+
+	splits := make([]*finance.InboxItemSplit, 0, len(pbSplits))
+	for _, pb := range pbSplits {
+		split := &finance.InboxItemSplit{
+			Amount: pb.GetAmount(),
+			Note:   pb.GetNote(),
+		}
+		if pb.GetBudgetId() != "" {
+			budgetID := pb.GetBudgetId()
+			split.BudgetID = &budgetID
+		}
+		if pb.GetCategoryId() != "" {
+			categoryID := pb.GetCategoryId()
+			split.CategoryID = &categoryID
+		}
+		splits = append(splits, split)
+	}
+
+	return splits
+}
@@ -0,0 +1,136 @@
+package identity
+
+import (
+	"context"
+	"errors"
+
+	identityv1 "github.com/masterkeysrd/saturn/apis/saturn/identity/v1"
+	"github.com/masterkeysrd/saturn/internal/application/iam"
+	"github.com/masterkeysrd/saturn/internal/domain/identity"
+	"github.com/masterkeysrd/saturn/internal/foundation/auth"
+	"github.com/masterkeysrd/saturn/internal/platform/password"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RequestPasswordReset mails a password reset link. It succeeds for unknown email addresses too.
+func (h *Handler) RequestPasswordReset(ctx context.Context, req *identityv1.RequestPasswordResetRequest) (*identityv1.RequestPasswordResetResponse, error) {
+	if req.GetEmail() == "" {
+		return nil, status.Error(codes.InvalidArgument, "email is required")
+	}
+
+	ua, ip := extractClientInfo(ctx)
+	if err := h.IAM.Coordinator.RequestPasswordReset(ctx, &iam.RequestPasswordResetRequest{
+		Email:     req.GetEmail(),
+		UserAgent: ua,
+		IPAddress: ip,
+	}); err != nil {
+		return nil, mapAccountError(err)
+	}
+
+	return &identityv1.RequestPasswordResetResponse{}, nil
+}
+
+// ConfirmPasswordReset sets a new password with the token of a reset link.
+func (h *Handler) ConfirmPasswordReset(ctx context.Context, req *identityv1.ConfirmPasswordResetRequest) (*identityv1.ConfirmPasswordResetResponse, error) {
+	if req.GetToken() == "" {
+		return nil, status.Error(codes.InvalidArgument, "token is required")
+	}
+	if req.GetNewPassword() == "" {
+		return nil, status.Error(codes.InvalidArgument, "new_password is required")
+	}
+
+	ua, ip := extractClientInfo(ctx)
+	if err := h.IAM.Coordinator.ConfirmPasswordReset(ctx, &iam.ConfirmPasswordResetRequest{
+		Token:       req.GetToken(),
+		NewPassword: req.GetNewPassword(),
+		UserAgent:   ua,
+		IPAddress:   ip,
+	}); err != nil {
+		return nil, mapAccountError(err)
+	}
+
+	return &identityv1.ConfirmPasswordResetResponse{}, nil
+}
+
+// ChangePassword replaces the authenticated user's password and signs out all their sessions.
+func (h *Handler) ChangePassword(ctx context.Context, req *identityv1.ChangePasswordRequest) (*identityv1.ChangePasswordResponse, error) {
+	principal, ok := auth.PrincipalFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing principal")
+	}
+	if req.GetCurrentPassword() == "" {
+		return nil, status.Error(codes.InvalidArgument, "current_password is required")
+	}
+	if req.GetNewPassword() == "" {
+		return nil, status.Error(codes.InvalidArgument, "new_password is required")
+	}
+
+	ua, ip := extractClientInfo(ctx)
+	if err := h.IAM.Coordinator.ChangePassword(ctx, &iam.ChangePasswordRequest{
+		UserID:          principal.Subject,
+		CurrentPassword: req.GetCurrentPassword(),
+		NewPassword:     req.GetNewPassword(),
+		UserAgent:       ua,
+		IPAddress:       ip,
+	}); err != nil {
+		return nil, mapAccountError(err)
+	}
+
+	return &identityv1.ChangePasswordResponse{}, nil
+}
+
+// ResendVerificationEmail mails a new email verification link. It succeeds for unknown email
+// addresses too.
+func (h *Handler) ResendVerificationEmail(ctx context.Context, req *identityv1.ResendVerificationEmailRequest) (*identityv1.ResendVerificationEmailResponse, error) {
+	if req.GetEmail() == "" {
+		return nil, status.Error(codes.InvalidArgument, "email is required")
+	}
+
+	ua, ip := extractClientInfo(ctx)
+	if err := h.IAM.Coordinator.ResendVerificationEmail(ctx, &iam.ResendVerificationEmailRequest{
+		Email:     req.GetEmail(),
+		UserAgent: ua,
+		IPAddress: ip,
+	}); err != nil {
+		return nil, mapAccountError(err)
+	}
+
+	return &identityv1.ResendVerificationEmailResponse{}, nil
+}
+
+// VerifyEmail verifies an email address with the token of a verification link.
+func (h *Handler) VerifyEmail(ctx context.Context, req *identityv1.VerifyEmailRequest) (*identityv1.VerifyEmailResponse, error) {
+	if req.GetToken() == "" {
+		return nil, status.Error(codes.InvalidArgument, "token is required")
+	}
+
+	ua, ip := extractClientInfo(ctx)
+	if _, err := h.IAM.Coordinator.VerifyEmail(ctx, &iam.VerifyEmailRequest{
+		Token:     req.GetToken(),
+		UserAgent: ua,
+		IPAddress: ip,
+	}); err != nil {
+		return nil, mapAccountError(err)
+	}
+
+	return &identityv1.VerifyEmailResponse{}, nil
+}
+
+// mapAccountError converts password and email verification errors to gRPC status errors.
+func mapAccountError(err error) error {
+	switch {
+	case errors.Is(err, identity.ErrUserNotFound):
+		return status.Error(codes.NotFound, "user not found")
+	case errors.Is(err, password.ErrInvalidPassword):
+		return status.Error(codes.InvalidArgument, "password must be at least 12 characters long")
+	case errors.Is(err, identity.ErrInvalidAccountToken):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, identity.ErrIncorrectPassword):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, iam.ErrAccountLocked):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
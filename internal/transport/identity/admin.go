@@ -23,16 +23,17 @@ func NewAdminHandler(coordinator *iam.Coordinator) *AdminHandler {
 // toAdminUser converts a domain identity.User to an admin proto User.
 func toAdminUser(u *identity.User) *adminidentityv1.User {
 	return &adminidentityv1.User{
-		Id:          string(u.ID),
-		Email:       u.Email,
-		Username:    u.Username,
-		Name:        u.Name,
-		AvatarUrl:   u.AvatarURL,
-		Status:      string(u.Status),
-		AccessLevel: adminAccessLevel(u.AccessLevel),
-		Version:     u.Version,
-		CreateTime:  timestamppb.New(u.CreateTime),
-		UpdateTime:  timestamppb.New(u.UpdateTime),
+		Id:            string(u.ID),
+		Email:         u.Email,
+		Username:      u.Username,
+		Name:          u.Name,
+		AvatarUrl:     u.AvatarURL,
+		Status:        string(u.Status),
+		AccessLevel:   adminAccessLevel(u.AccessLevel),
+		Version:       u.Version,
+		CreateTime:    timestamppb.New(u.CreateTime),
+		UpdateTime:    timestamppb.New(u.UpdateTime),
+		EmailVerified: u.IsEmailVerified(),
 	}
 }
 
@@ -155,6 +156,21 @@ func (h *AdminHandler) RevokeAllSessions(ctx context.Context, req *adminidentity
 	return &adminidentityv1.RevokeAllSessionsResponse{}, nil
 }
 
+// ResetUserMFA removes a user's two-factor authentication and signs them out everywhere.
+func (h *AdminHandler) ResetUserMFA(ctx context.Context, req *adminidentityv1.ResetUserMFARequest) (*adminidentityv1.ResetUserMFAResponse, error) {
+	ua, ip := extractClientInfo(ctx)
+	err := h.Coordinator.ResetUserMFA(ctx, &iam.ResetUserMFARequest{
+		UserID:    req.GetUserId(),
+		UserAgent: ua,
+		IPAddress: ip,
+	})
+	if err != nil {
+		return nil, mapMFAError(err)
+	}
+
+	return &adminidentityv1.ResetUserMFAResponse{}, nil
+}
+
 // ListSecurityEvents lists security audit logs.
 func (h *AdminHandler) ListSecurityEvents(ctx context.Context, req *adminidentityv1.ListSecurityEventsRequest) (*adminidentityv1.ListSecurityEventsResponse, error) {
 	events, nextToken, err := h.Coordinator.ListSecurityEvents(ctx, identity.SecurityEventFilter{
@@ -9,6 +9,7 @@ import (
 	identityv1 "github.com/masterkeysrd/saturn/apis/saturn/identity/v1"
 	"github.com/masterkeysrd/saturn/internal/application/iam"
 	"github.com/masterkeysrd/saturn/internal/domain/identity"
+	"github.com/masterkeysrd/saturn/internal/domain/space"
 	"github.com/masterkeysrd/saturn/internal/foundation/auth"
 	"github.com/masterkeysrd/saturn/internal/platform/password"
 	"google.golang.org/grpc/codes"
@@ -40,18 +41,41 @@ func NewHandler(iam *IAMApplication) *Handler {
 	return &Handler{IAM: iam}
 }
 
-// LoginUser authenticates a user and returns a session token.
+// LoginUser authenticates a user and returns a session token. Users with two-factor authentication
+// get an MFA challenge from the password step and complete it with a second mfa_challenge call.
+// A passkey login completes a ceremony started with BeginPasskeyLogin and needs no second factor.
 func (h *Handler) LoginUser(ctx context.Context, req *identityv1.LoginUserRequest) (*identityv1.LoginUserResponse, error) {
-	ident := req.GetUserPassword().GetIdentifier()
-	pass := req.GetUserPassword().GetPassword()
 	ua, ip := extractClientInfo(ctx)
 
-	resp, err := h.IAM.Coordinator.Login(ctx, &iam.LoginRequest{
-		Identifier: ident,
-		Password:   pass,
-		UserAgent:  ua,
-		IPAddress:  ip,
-	})
+	var (
+		resp  *iam.LoginResponse
+		err   error
+		ident string
+	)
+	if challenge := req.GetMfaChallenge(); challenge != nil {
+		resp, err = h.IAM.Coordinator.LoginMFA(ctx, &iam.LoginMFARequest{
+			MFAToken:     challenge.GetMfaToken(),
+			Code:         challenge.GetCode(),
+			RecoveryCode: challenge.GetRecoveryCode(),
+			UserAgent:    ua,
+			IPAddress:    ip,
+		})
+	} else if assertion := req.GetPasskey(); assertion != nil {
+		resp, err = h.IAM.Coordinator.LoginPasskey(ctx, &iam.LoginPasskeyRequest{
+			CeremonyID: assertion.GetCeremonyId(),
+			Credential: assertion.GetCredential(),
+			UserAgent:  ua,
+			IPAddress:  ip,
+		})
+	} else {
+		ident = req.GetUserPassword().GetIdentifier()
+		resp, err = h.IAM.Coordinator.Login(ctx, &iam.LoginRequest{
+			Identifier: ident,
+			Password:   req.GetUserPassword().GetPassword(),
+			UserAgent:  ua,
+			IPAddress:  ip,
+		})
+	}
 	if err != nil {
 		if errors.Is(err, identity.ErrAccountPendingApproval) {
 			return nil, status.Error(codes.PermissionDenied, "account pending approval")
@@ -62,15 +86,40 @@ func (h *Handler) LoginUser(ctx context.Context, req *identityv1.LoginUserReques
 		if errors.Is(err, identity.ErrAccountInactive) {
 			return nil, status.Error(codes.PermissionDenied, "account is inactive")
 		}
-		if strings.Contains(err.Error(), "temporarily locked") {
+		if errors.Is(err, identity.ErrEmailNotVerified) {
+			return nil, status.Error(codes.PermissionDenied, "email address is not verified")
+		}
+		if errors.Is(err, iam.ErrAccountLocked) {
 			return nil, status.Error(codes.ResourceExhausted, err.Error())
 		}
+		if errors.Is(err, identity.ErrMFAChallengeExpired) {
+			return nil, status.Error(codes.Unauthenticated, "mfa challenge is invalid or expired")
+		}
+		if errors.Is(err, identity.ErrInvalidMFACode) || errors.Is(err, identity.ErrInvalidRecoveryCode) {
+			return nil, status.Error(codes.Unauthenticated, "invalid mfa code")
+		}
+		if errors.Is(err, identity.ErrPasskeyCeremonyExpired) {
+			return nil, status.Error(codes.Unauthenticated, "passkey ceremony is invalid or expired")
+		}
+		if errors.Is(err, identity.ErrInvalidPasskey) {
+			slog.Info("passkey login failed", "error", err)
+			return nil, status.Error(codes.Unauthenticated, "invalid credentials")
+		}
 		if err.Error() != "invalid credentials" {
 			slog.Error("login failed with internal system error", "identifier", ident, "error", err)
 		}
 		return nil, status.Error(codes.Unauthenticated, "invalid credentials")
 	}
 
+	if resp.MFARequired {
+		return &identityv1.LoginUserResponse{
+			UserId:            string(resp.User.ID),
+			MfaRequired:       true,
+			MfaToken:          resp.MFAToken,
+			MfaTokenExpiresAt: resp.MFATokenExpiresAt,
+		}, nil
+	}
+
 	return &identityv1.LoginUserResponse{
 		UserId:                string(resp.User.ID),
 		AccessToken:           resp.AccessToken,
@@ -80,14 +129,28 @@ func (h *Handler) LoginUser(ctx context.Context, req *identityv1.LoginUserReques
 	}, nil
 }
 
-// RegisterUser creates a new user account.
+// RegisterUser creates a new user account with a password, a passkey, or both.
 func (h *Handler) RegisterUser(ctx context.Context, req *identityv1.RegisterUserRequest) (*identityv1.User, error) {
+	if (req.GetPasskeyCeremonyId() == "") != (req.GetPasskeyCredential() == "") {
+		return nil, status.Error(codes.InvalidArgument, "passkey_ceremony_id and passkey_credential must be set together")
+	}
+
+	ua, ip := extractClientInfo(ctx)
 	appReq := &iam.RegisterUserRequest{
 		Email:     req.GetEmail(),
 		Username:  req.GetUsername(),
 		Name:      req.GetName(),
 		AvatarURL: req.GetAvatarUrl(),
 		Password:  req.GetPassword(),
+
+		PasskeyCeremonyID: req.GetPasskeyCeremonyId(),
+		PasskeyCredential: req.GetPasskeyCredential(),
+		PasskeyName:       req.GetPasskeyName(),
+
+		InvitationToken: req.GetInvitationToken(),
+
+		UserAgent: ua,
+		IPAddress: ip,
 	}
 
 	appResp, err := h.IAM.Coordinator.Register(ctx, appReq)
@@ -95,19 +158,33 @@ func (h *Handler) RegisterUser(ctx context.Context, req *identityv1.RegisterUser
 		if errors.Is(err, password.ErrInvalidPassword) {
 			return nil, status.Error(codes.InvalidArgument, "password must be at least 12 characters long")
 		}
+		if errors.Is(err, space.ErrInvitationNotFound) {
+			return nil, status.Error(codes.NotFound, "invitation not found")
+		}
+		if errors.Is(err, space.ErrInvitationNotPending) || errors.Is(err, space.ErrInvitationExpired) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		if errors.Is(err, space.ErrInvitationEmailMismatch) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if errors.Is(err, identity.ErrPasskeyCeremonyExpired) || errors.Is(err, identity.ErrInvalidPasskey) ||
+			errors.Is(err, identity.ErrPasskeyExists) || errors.Is(err, identity.ErrInvalidPasskeyName) {
+			return nil, mapPasskeyError(err)
+		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
 	return &identityv1.User{
-		Id:         appResp.UserID,
-		Email:      appResp.Email,
-		Username:   appResp.Username,
-		Name:       appResp.Name,
-		AvatarUrl:  appResp.AvatarURL,
-		Status:     string(appResp.Status),
-		Version:    appResp.Version,
-		CreateTime: timestamppb.New(appResp.CreateTime),
-		UpdateTime: timestamppb.New(appResp.UpdateTime),
+		Id:            appResp.UserID,
+		Email:         appResp.Email,
+		Username:      appResp.Username,
+		Name:          appResp.Name,
+		AvatarUrl:     appResp.AvatarURL,
+		Status:        string(appResp.Status),
+		EmailVerified: appResp.EmailVerified,
+		Version:       appResp.Version,
+		CreateTime:    timestamppb.New(appResp.CreateTime),
+		UpdateTime:    timestamppb.New(appResp.UpdateTime),
 	}, nil
 }
 
@@ -124,15 +201,16 @@ func (h *Handler) GetCurrentUser(ctx context.Context, req *identityv1.GetCurrent
 	}
 
 	return &identityv1.User{
-		Id:         string(user.ID),
-		Email:      user.Email,
-		Username:   user.Username,
-		Name:       user.Name,
-		AvatarUrl:  user.AvatarURL,
-		Status:     string(user.Status),
-		Version:    user.Version,
-		CreateTime: timestamppb.New(user.CreateTime),
-		UpdateTime: timestamppb.New(user.UpdateTime),
+		Id:            string(user.ID),
+		Email:         user.Email,
+		Username:      user.Username,
+		Name:          user.Name,
+		AvatarUrl:     user.AvatarURL,
+		Status:        string(user.Status),
+		EmailVerified: user.IsEmailVerified(),
+		Version:       user.Version,
+		CreateTime:    timestamppb.New(user.CreateTime),
+		UpdateTime:    timestamppb.New(user.UpdateTime),
 	}, nil
 }
 
@@ -0,0 +1,147 @@
+package identity
+
+import (
+	"context"
+	"errors"
+
+	identityv1 "github.com/masterkeysrd/saturn/apis/saturn/identity/v1"
+	"github.com/masterkeysrd/saturn/internal/application/iam"
+	"github.com/masterkeysrd/saturn/internal/domain/identity"
+	"github.com/masterkeysrd/saturn/internal/foundation/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// GetMFAStatus reports whether the authenticated user has two-factor authentication enabled.
+func (h *Handler) GetMFAStatus(ctx context.Context, req *identityv1.GetMFAStatusRequest) (*identityv1.MFAStatus, error) {
+	principal, ok := auth.PrincipalFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing principal")
+	}
+
+	mfa, err := h.IAM.Coordinator.GetMFAStatus(ctx, identity.UserID(principal.Subject))
+	if err != nil {
+		return nil, mapMFAError(err)
+	}
+
+	resp := &identityv1.MFAStatus{
+		Enabled:                mfa.Enabled,
+		RecoveryCodesRemaining: int32(mfa.RecoveryCodesRemaining),
+	}
+	if mfa.ConfirmTime != nil {
+		resp.ConfirmTime = timestamppb.New(*mfa.ConfirmTime)
+	}
+	return resp, nil
+}
+
+// EnrollTOTP generates a TOTP secret for the authenticated user's authenticator app.
+func (h *Handler) EnrollTOTP(ctx context.Context, req *identityv1.EnrollTOTPRequest) (*identityv1.EnrollTOTPResponse, error) {
+	principal, ok := auth.PrincipalFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing principal")
+	}
+
+	enrollment, err := h.IAM.Coordinator.EnrollTOTP(ctx, &iam.EnrollTOTPRequest{
+		UserID: principal.Subject,
+	})
+	if err != nil {
+		return nil, mapMFAError(err)
+	}
+
+	return &identityv1.EnrollTOTPResponse{
+		Secret: enrollment.Secret,
+		Uri:    enrollment.URI,
+	}, nil
+}
+
+// ConfirmTOTP enables two-factor authentication and returns the recovery codes.
+func (h *Handler) ConfirmTOTP(ctx context.Context, req *identityv1.ConfirmTOTPRequest) (*identityv1.RecoveryCodesResponse, error) {
+	principal, ok := auth.PrincipalFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing principal")
+	}
+	if req.GetCode() == "" {
+		return nil, status.Error(codes.InvalidArgument, "code is required")
+	}
+
+	ua, ip := extractClientInfo(ctx)
+	resp, err := h.IAM.Coordinator.ConfirmTOTP(ctx, &iam.ConfirmTOTPRequest{
+		UserID:    principal.Subject,
+		Code:      req.GetCode(),
+		UserAgent: ua,
+		IPAddress: ip,
+	})
+	if err != nil {
+		return nil, mapMFAError(err)
+	}
+
+	return &identityv1.RecoveryCodesResponse{RecoveryCodes: resp.RecoveryCodes}, nil
+}
+
+// RegenerateRecoveryCodes replaces the recovery codes of the authenticated user.
+func (h *Handler) RegenerateRecoveryCodes(ctx context.Context, req *identityv1.RegenerateRecoveryCodesRequest) (*identityv1.RecoveryCodesResponse, error) {
+	secondFactor, err := secondFactorRequest(ctx, req.GetCode(), req.GetRecoveryCode())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.IAM.Coordinator.RegenerateRecoveryCodes(ctx, secondFactor)
+	if err != nil {
+		return nil, mapMFAError(err)
+	}
+
+	return &identityv1.RecoveryCodesResponse{RecoveryCodes: resp.RecoveryCodes}, nil
+}
+
+// DisableMFA removes two-factor authentication from the authenticated user.
+func (h *Handler) DisableMFA(ctx context.Context, req *identityv1.DisableMFARequest) (*identityv1.DisableMFAResponse, error) {
+	secondFactor, err := secondFactorRequest(ctx, req.GetCode(), req.GetRecoveryCode())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.IAM.Coordinator.DisableMFA(ctx, secondFactor); err != nil {
+		return nil, mapMFAError(err)
+	}
+
+	return &identityv1.DisableMFAResponse{}, nil
+}
+
+// secondFactorRequest builds a step-up request for the authenticated user.
+func secondFactorRequest(ctx context.Context, code, recoveryCode string) (*iam.SecondFactorRequest, error) {
+	principal, ok := auth.PrincipalFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing principal")
+	}
+	if code == "" && recoveryCode == "" {
+		return nil, status.Error(codes.InvalidArgument, "code or recovery_code is required")
+	}
+
+	ua, ip := extractClientInfo(ctx)
+	return &iam.SecondFactorRequest{
+		UserID:       principal.Subject,
+		Code:         code,
+		RecoveryCode: recoveryCode,
+		UserAgent:    ua,
+		IPAddress:    ip,
+	}, nil
+}
+
+// mapMFAError converts MFA domain errors to gRPC status errors.
+func mapMFAError(err error) error {
+	switch {
+	case errors.Is(err, identity.ErrUserNotFound):
+		return status.Error(codes.NotFound, "user not found")
+	case errors.Is(err, identity.ErrMFANotEnrolled):
+		return status.Error(codes.FailedPrecondition, "two-factor authentication is not enrolled")
+	case errors.Is(err, identity.ErrMFAAlreadyEnabled):
+		return status.Error(codes.AlreadyExists, "two-factor authentication is already enabled")
+	case errors.Is(err, identity.ErrInvalidMFACode), errors.Is(err, identity.ErrInvalidRecoveryCode):
+		return status.Error(codes.InvalidArgument, "invalid mfa code")
+	case errors.Is(err, iam.ErrAccountLocked):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
@@ -0,0 +1,192 @@
+package identity
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	identityv1 "github.com/masterkeysrd/saturn/apis/saturn/identity/v1"
+	"github.com/masterkeysrd/saturn/internal/application/iam"
+	"github.com/masterkeysrd/saturn/internal/domain/identity"
+	"github.com/masterkeysrd/saturn/internal/foundation/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// BeginPasskeyLogin starts a passkey login.
+func (h *Handler) BeginPasskeyLogin(ctx context.Context, req *identityv1.BeginPasskeyLoginRequest) (*identityv1.PasskeyChallenge, error) {
+	challenge, err := h.IAM.Coordinator.BeginPasskeyLogin(ctx, &iam.BeginPasskeyLoginRequest{
+		Identifier: req.GetIdentifier(),
+	})
+	if err != nil {
+		return nil, mapPasskeyError(err)
+	}
+	return toPasskeyChallengeProto(challenge), nil
+}
+
+// BeginPasskeySignup starts creating the passkey of a new account.
+func (h *Handler) BeginPasskeySignup(ctx context.Context, req *identityv1.BeginPasskeySignupRequest) (*identityv1.PasskeyChallenge, error) {
+	if req.GetEmail() == "" || req.GetUsername() == "" || req.GetName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "email, username and name are required")
+	}
+
+	challenge, err := h.IAM.Coordinator.BeginPasskeySignup(ctx, &iam.BeginPasskeySignupRequest{
+		Email:    req.GetEmail(),
+		Username: req.GetUsername(),
+		Name:     req.GetName(),
+	})
+	if err != nil {
+		return nil, mapPasskeyError(err)
+	}
+	return toPasskeyChallengeProto(challenge), nil
+}
+
+// BeginPasskeyRegistration starts adding a passkey to the authenticated user.
+func (h *Handler) BeginPasskeyRegistration(ctx context.Context, req *identityv1.BeginPasskeyRegistrationRequest) (*identityv1.PasskeyChallenge, error) {
+	principal, ok := auth.PrincipalFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing principal")
+	}
+
+	challenge, err := h.IAM.Coordinator.BeginPasskeyRegistration(ctx, &iam.BeginPasskeyRegistrationRequest{
+		UserID: principal.Subject,
+	})
+	if err != nil {
+		return nil, mapPasskeyError(err)
+	}
+	return toPasskeyChallengeProto(challenge), nil
+}
+
+// CreatePasskey verifies and stores the passkey of a registration ceremony.
+func (h *Handler) CreatePasskey(ctx context.Context, req *identityv1.CreatePasskeyRequest) (*identityv1.Passkey, error) {
+	principal, ok := auth.PrincipalFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing principal")
+	}
+	if req.GetCeremonyId() == "" || req.GetCredential() == "" {
+		return nil, status.Error(codes.InvalidArgument, "ceremony_id and credential are required")
+	}
+
+	ua, ip := extractClientInfo(ctx)
+	pk, err := h.IAM.Coordinator.FinishPasskeyRegistration(ctx, &iam.FinishPasskeyRegistrationRequest{
+		UserID:     principal.Subject,
+		CeremonyID: req.GetCeremonyId(),
+		Name:       req.GetName(),
+		Credential: req.GetCredential(),
+		UserAgent:  ua,
+		IPAddress:  ip,
+	})
+	if err != nil {
+		return nil, mapPasskeyError(err)
+	}
+	return toPasskeyProto(pk), nil
+}
+
+// ListPasskeys lists the passkeys of the authenticated user.
+func (h *Handler) ListPasskeys(ctx context.Context, req *identityv1.ListPasskeysRequest) (*identityv1.ListPasskeysResponse, error) {
+	principal, ok := auth.PrincipalFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing principal")
+	}
+
+	passkeys, err := h.IAM.Coordinator.ListPasskeys(ctx, identity.UserID(principal.Subject))
+	if err != nil {
+		return nil, mapPasskeyError(err)
+	}
+
+	resp := &identityv1.ListPasskeysResponse{Passkeys: make([]*identityv1.Passkey, len(passkeys))}
+	for i, pk := range passkeys {
+		resp.Passkeys[i] = toPasskeyProto(pk)
+	}
+	return resp, nil
+}
+
+// RenamePasskey changes the name of a passkey.
+func (h *Handler) RenamePasskey(ctx context.Context, req *identityv1.RenamePasskeyRequest) (*identityv1.Passkey, error) {
+	principal, ok := auth.PrincipalFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing principal")
+	}
+	if req.GetName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+
+	pk, err := h.IAM.Coordinator.RenamePasskey(ctx, &iam.RenamePasskeyRequest{
+		UserID:    principal.Subject,
+		PasskeyID: req.GetPasskeyId(),
+		Name:      req.GetName(),
+	})
+	if err != nil {
+		return nil, mapPasskeyError(err)
+	}
+	return toPasskeyProto(pk), nil
+}
+
+// DeletePasskey removes a passkey of the authenticated user.
+func (h *Handler) DeletePasskey(ctx context.Context, req *identityv1.DeletePasskeyRequest) (*identityv1.DeletePasskeyResponse, error) {
+	principal, ok := auth.PrincipalFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing principal")
+	}
+
+	ua, ip := extractClientInfo(ctx)
+	if err := h.IAM.Coordinator.DeletePasskey(ctx, &iam.DeletePasskeyRequest{
+		UserID:    principal.Subject,
+		PasskeyID: req.GetPasskeyId(),
+		UserAgent: ua,
+		IPAddress: ip,
+	}); err != nil {
+		return nil, mapPasskeyError(err)
+	}
+	return &identityv1.DeletePasskeyResponse{}, nil
+}
+
+func toPasskeyChallengeProto(challenge *identity.PasskeyChallenge) *identityv1.PasskeyChallenge {
+	return &identityv1.PasskeyChallenge{
+		CeremonyId: challenge.CeremonyID,
+		Options:    string(challenge.Options),
+		ExpireTime: timestamppb.New(challenge.ExpireTime),
+	}
+}
+
+func toPasskeyProto(pk *identity.Passkey) *identityv1.Passkey {
+	resp := &identityv1.Passkey{
+		Id:         string(pk.ID),
+		Name:       pk.Name,
+		Transports: pk.Transports,
+		BackedUp:   pk.BackupState,
+		CreateTime: timestamppb.New(pk.CreateTime),
+	}
+	if pk.LastUsedTime != nil {
+		resp.LastUsedTime = timestamppb.New(*pk.LastUsedTime)
+	}
+	return resp
+}
+
+// mapPasskeyError converts passkey domain errors to gRPC status errors.
+func mapPasskeyError(err error) error {
+	switch {
+	case errors.Is(err, identity.ErrUserNotFound):
+		return status.Error(codes.NotFound, "user not found")
+	case errors.Is(err, identity.ErrUserExists):
+		return status.Error(codes.AlreadyExists, "user already exists")
+	case errors.Is(err, identity.ErrPasskeyNotFound):
+		return status.Error(codes.NotFound, "passkey not found")
+	case errors.Is(err, identity.ErrPasskeyExists):
+		return status.Error(codes.AlreadyExists, "passkey is already registered")
+	case errors.Is(err, identity.ErrTooManyPasskeys):
+		return status.Error(codes.ResourceExhausted, "too many passkeys")
+	case errors.Is(err, identity.ErrInvalidPasskeyName):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, identity.ErrLastCredential):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, identity.ErrPasskeyCeremonyExpired):
+		return status.Error(codes.FailedPrecondition, "passkey ceremony is invalid or expired")
+	case errors.Is(err, identity.ErrInvalidPasskey):
+		slog.Info("passkey verification failed", "error", err)
+		return status.Error(codes.InvalidArgument, "passkey verification failed")
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
@@ -0,0 +1,179 @@
+package space
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	spacev1 "github.com/masterkeysrd/saturn/apis/saturn/space/v1"
+	spaceapp "github.com/masterkeysrd/saturn/internal/application/space"
+	"github.com/masterkeysrd/saturn/internal/domain/space"
+	"github.com/masterkeysrd/saturn/internal/platform/conv"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// toProtoInvitation converts a domain Invitation to a proto Invitation.
+func toProtoInvitation(inv *space.Invitation) *spacev1.Invitation {
+	return &spacev1.Invitation{
+		Id:         string(inv.ID),
+		SpaceId:    string(inv.SpaceID),
+		Email:      inv.Email,
+		Role:       string(inv.Role),
+		InviterId:  string(inv.InviterID),
+		Status:     string(inv.Status),
+		ExpireTime: timestamppb.New(inv.ExpireTime),
+		AcceptedBy: conv.StringPtr(inv.AcceptedBy),
+		CreateTime: timestamppb.New(inv.CreateTime),
+		UpdateTime: timestamppb.New(inv.UpdateTime),
+	}
+}
+
+// mapInvitationError converts invitation errors to gRPC status errors.
+func mapInvitationError(err error) error {
+	switch {
+	case errors.Is(err, space.ErrInsufficientRole):
+		return status.Error(codes.PermissionDenied, "insufficient role to manage invitations")
+	case errors.Is(err, space.ErrSpaceNotFound):
+		return status.Error(codes.NotFound, "space not found")
+	case errors.Is(err, space.ErrInvitationNotFound):
+		return status.Error(codes.NotFound, "invitation not found")
+	case errors.Is(err, space.ErrInvitationAlreadyExists), errors.Is(err, space.ErrMemberAlreadyExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, space.ErrInvitationNotPending), errors.Is(err, space.ErrInvitationExpired), errors.Is(err, spaceapp.ErrUserNotActive):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, space.ErrInvitationEmailMismatch):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, space.ErrInvalidRole):
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
+
+// CreateInvitation invites an email address to a workspace.
+func (h *Handler) CreateInvitation(ctx context.Context, req *spacev1.CreateInvitationRequest) (*spacev1.Invitation, error) {
+	userID, err := h.getSpaceUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetExpireHours() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "expire_hours must not be negative")
+	}
+	if _, err := space.NormalizeEmail(req.GetEmail()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	inv, err := h.Coordinator.CreateInvitation(ctx, &spaceapp.CreateInvitationRequest{
+		SpaceID: req.GetSpaceId(),
+		UserID:  userID,
+		Email:   req.GetEmail(),
+		Role:    req.GetRole(),
+		TTL:     time.Duration(req.GetExpireHours()) * time.Hour,
+	})
+	if err != nil {
+		return nil, mapInvitationError(err)
+	}
+
+	return toProtoInvitation(inv), nil
+}
+
+// ListInvitations lists the invitations of a workspace.
+func (h *Handler) ListInvitations(ctx context.Context, req *spacev1.ListInvitationsRequest) (*spacev1.ListInvitationsResponse, error) {
+	userID, err := h.getSpaceUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	invitations, nextToken, err := h.Coordinator.ListInvitations(ctx, &spaceapp.ListInvitationsRequest{
+		SpaceID: req.GetSpaceId(),
+		UserID:  userID,
+		Filter: &space.ListInvitationsFilter{
+			PageSize:      req.GetPageSize(),
+			NextPageToken: req.GetNextPageToken(),
+			PendingOnly:   req.GetPendingOnly(),
+		},
+	})
+	if err != nil {
+		return nil, mapInvitationError(err)
+	}
+
+	protoInvitations := make([]*spacev1.Invitation, 0, len(invitations))
+	for _, inv := range invitations {
+		protoInvitations = append(protoInvitations, toProtoInvitation(inv))
+	}
+
+	return &spacev1.ListInvitationsResponse{
+		Invitations:   protoInvitations,
+		NextPageToken: nextToken,
+	}, nil
+}
+
+// RevokeInvitation withdraws a pending invitation.
+func (h *Handler) RevokeInvitation(ctx context.Context, req *spacev1.RevokeInvitationRequest) (*spacev1.Invitation, error) {
+	userID, err := h.getSpaceUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	invitationID, err := space.ParseInvitationID(req.GetInvitationId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	inv, err := h.Coordinator.RevokeInvitation(ctx, &spaceapp.RevokeInvitationRequest{
+		SpaceID:      req.GetSpaceId(),
+		UserID:       userID,
+		InvitationID: invitationID,
+	})
+	if err != nil {
+		return nil, mapInvitationError(err)
+	}
+
+	return toProtoInvitation(inv), nil
+}
+
+// AcceptInvitation joins the authenticated user to the inviting workspace.
+func (h *Handler) AcceptInvitation(ctx context.Context, req *spacev1.AcceptInvitationRequest) (*spacev1.SpaceMember, error) {
+	userID, err := h.getSpaceUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetToken() == "" {
+		return nil, status.Error(codes.InvalidArgument, "token is required")
+	}
+
+	m, err := h.Coordinator.AcceptInvitation(ctx, &spaceapp.AnswerInvitationRequest{
+		UserID: userID,
+		Token:  req.GetToken(),
+	})
+	if err != nil {
+		return nil, mapInvitationError(err)
+	}
+
+	return toProtoSpaceMember(m), nil
+}
+
+// DeclineInvitation declines an invitation addressed to the authenticated user.
+func (h *Handler) DeclineInvitation(ctx context.Context, req *spacev1.DeclineInvitationRequest) (*spacev1.Invitation, error) {
+	userID, err := h.getSpaceUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetToken() == "" {
+		return nil, status.Error(codes.InvalidArgument, "token is required")
+	}
+
+	inv, err := h.Coordinator.DeclineInvitation(ctx, &spaceapp.AnswerInvitationRequest{
+		UserID: userID,
+		Token:  req.GetToken(),
+	})
+	if err != nil {
+		return nil, mapInvitationError(err)
+	}
+
+	return toProtoInvitation(inv), nil
+}
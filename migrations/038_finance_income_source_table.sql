@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE finance.income_source (
+    id               TEXT         COLLATE "C" NOT NULL,
+    space_id         TEXT         COLLATE "C" NOT NULL,
+    name             VARCHAR(255) NOT NULL,
+    employer         VARCHAR(255) NOT NULL DEFAULT '',
+    expected_amount  BIGINT       NOT NULL DEFAULT 0,
+    currency         VARCHAR(3)   NOT NULL,
+    cadence          VARCHAR(50)  NOT NULL,
+    account_id       TEXT         COLLATE "C" DEFAULT NULL,
+    is_active        BOOLEAN      NOT NULL DEFAULT true,
+    create_time      TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    update_time      TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (id),
+    CONSTRAINT fk_income_source_space FOREIGN KEY (space_id) REFERENCES space.space(id) ON DELETE CASCADE,
+    CONSTRAINT fk_income_source_account FOREIGN KEY (account_id) REFERENCES finance.account(id) ON DELETE SET NULL
+);
+
+CREATE INDEX idx_income_source_space ON finance.income_source (space_id);
+CREATE INDEX idx_transaction_income_source
+ON finance.transaction ((metadata->>'income_source_id'))
+WHERE metadata->>'income_source_id' IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS finance.idx_transaction_income_source;
+DROP TABLE IF EXISTS finance.income_source;
+-- +goose StatementEnd
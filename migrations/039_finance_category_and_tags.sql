@@ -0,0 +1,35 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE finance.category (
+    id           TEXT         COLLATE "C" NOT NULL,
+    space_id     TEXT         COLLATE "C" NOT NULL,
+    parent_id    TEXT         COLLATE "C" DEFAULT NULL,
+    name         VARCHAR(255) NOT NULL,
+    icon         VARCHAR(50)  NOT NULL DEFAULT 'tag',
+    color        VARCHAR(50)  NOT NULL DEFAULT 'slate',
+    create_time  TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    update_time  TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (id),
+    CONSTRAINT fk_category_space FOREIGN KEY (space_id) REFERENCES space.space(id) ON DELETE CASCADE,
+    CONSTRAINT fk_category_parent FOREIGN KEY (parent_id) REFERENCES finance.category(id) ON DELETE SET NULL
+);
+
+CREATE INDEX idx_category_space ON finance.category (space_id);
+CREATE INDEX idx_category_parent ON finance.category (parent_id);
+
+ALTER TABLE finance.transaction
+    ADD COLUMN category_id TEXT COLLATE "C" REFERENCES finance.category(id) ON DELETE SET NULL,
+    ADD COLUMN tags TEXT[] NOT NULL DEFAULT '{}';
+
+CREATE INDEX idx_transaction_category ON finance.transaction (category_id);
+CREATE INDEX idx_transaction_tags ON finance.transaction USING GIN (tags);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS finance.idx_transaction_tags;
+DROP INDEX IF EXISTS finance.idx_transaction_category;
+ALTER TABLE finance.transaction DROP COLUMN tags;
+ALTER TABLE finance.transaction DROP COLUMN category_id;
+DROP TABLE IF EXISTS finance.category;
+-- +goose StatementEnd
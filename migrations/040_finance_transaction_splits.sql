@@ -0,0 +1,69 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE finance.transaction_split (
+    id              TEXT         COLLATE "C" NOT NULL,
+    transaction_id  TEXT         COLLATE "C" NOT NULL,
+    position        INTEGER      NOT NULL,
+    budget_id       TEXT         COLLATE "C",
+    period_id       TEXT         COLLATE "C",
+    category_id     TEXT         COLLATE "C",
+    amount          BIGINT       NOT NULL,
+    amount_in_base  BIGINT       NOT NULL,
+    note            VARCHAR(255) NOT NULL DEFAULT '',
+    PRIMARY KEY (id),
+    CONSTRAINT fk_split_transaction FOREIGN KEY (transaction_id) REFERENCES finance.transaction(id) ON DELETE CASCADE,
+    CONSTRAINT fk_split_budget FOREIGN KEY (budget_id) REFERENCES finance.budget(id) ON DELETE SET NULL,
+    CONSTRAINT fk_split_period FOREIGN KEY (period_id) REFERENCES finance.budget_period(id) ON DELETE SET NULL,
+    CONSTRAINT fk_split_category FOREIGN KEY (category_id) REFERENCES finance.category(id) ON DELETE SET NULL,
+    UNIQUE (transaction_id, position)
+);
+
+CREATE INDEX idx_transaction_split_budget ON finance.transaction_split (budget_id);
+CREATE INDEX idx_transaction_split_period ON finance.transaction_split (period_id);
+CREATE INDEX idx_transaction_split_category ON finance.transaction_split (category_id);
+
+-- transaction_allocation attributes spend to budgets and categories: one row per
+-- unsplit transaction, and one row per split line for split transactions.
+CREATE VIEW finance.transaction_allocation AS
+SELECT
+    t.id AS transaction_id,
+    NULL::TEXT COLLATE "C" AS split_id,
+    NULL::INTEGER AS split_position,
+    t.space_id,
+    t.type,
+    t.budget_id,
+    t.period_id,
+    t.category_id,
+    t.amount,
+    t.currency,
+    t.amount_in_base,
+    t.effective_date
+FROM finance.transaction t
+WHERE NOT EXISTS (SELECT 1 FROM finance.transaction_split s WHERE s.transaction_id = t.id)
+UNION ALL
+SELECT
+    t.id AS transaction_id,
+    s.id AS split_id,
+    s.position AS split_position,
+    t.space_id,
+    t.type,
+    s.budget_id,
+    s.period_id,
+    COALESCE(s.category_id, t.category_id) AS category_id,
+    s.amount,
+    t.currency,
+    s.amount_in_base,
+    t.effective_date
+FROM finance.transaction_split s
+JOIN finance.transaction t ON t.id = s.transaction_id;
+
+ALTER TABLE finance.inbox_item
+    ADD COLUMN splits JSONB NOT NULL DEFAULT '[]';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE finance.inbox_item DROP COLUMN splits;
+DROP VIEW IF EXISTS finance.transaction_allocation;
+DROP TABLE IF EXISTS finance.transaction_split;
+-- +goose StatementEnd
@@ -0,0 +1,42 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE finance.inbox_item
+    ALTER COLUMN integration_id DROP NOT NULL,
+    ADD COLUMN source      VARCHAR(30) NOT NULL DEFAULT 'integration',
+    ADD COLUMN external_id TEXT        COLLATE "C";
+
+CREATE UNIQUE INDEX idx_inbox_item_external_id
+    ON finance.inbox_item (space_id, account_id, external_id)
+    WHERE external_id IS NOT NULL;
+
+CREATE TABLE finance.statement_mapping (
+    account_id         TEXT         COLLATE "C" PRIMARY KEY REFERENCES finance.account(id) ON DELETE CASCADE,
+    space_id           TEXT         COLLATE "C" NOT NULL REFERENCES space.space(id) ON DELETE CASCADE,
+    delimiter          VARCHAR(4)   NOT NULL DEFAULT ',',
+    has_header         BOOLEAN      NOT NULL DEFAULT TRUE,
+    date_column        VARCHAR(100) NOT NULL,
+    date_format        VARCHAR(50)  NOT NULL DEFAULT '2006-01-02',
+    amount_column      VARCHAR(100) NOT NULL DEFAULT '',
+    debit_column       VARCHAR(100) NOT NULL DEFAULT '',
+    credit_column      VARCHAR(100) NOT NULL DEFAULT '',
+    description_column VARCHAR(100) NOT NULL,
+    reference_column   VARCHAR(100) NOT NULL DEFAULT '',
+    currency_column    VARCHAR(100) NOT NULL DEFAULT '',
+    decimal_separator  VARCHAR(1)   NOT NULL DEFAULT '.',
+    invert_amounts     BOOLEAN      NOT NULL DEFAULT FALSE,
+    update_time        TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_statement_mapping_space ON finance.statement_mapping (space_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS finance.statement_mapping;
+DROP INDEX IF EXISTS finance.idx_inbox_item_external_id;
+DELETE FROM finance.inbox_item WHERE integration_id IS NULL;
+ALTER TABLE finance.inbox_item
+    DROP COLUMN external_id,
+    DROP COLUMN source,
+    ALTER COLUMN integration_id SET NOT NULL;
+-- +goose StatementEnd
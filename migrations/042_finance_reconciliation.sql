@@ -0,0 +1,48 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE finance.reconciliation (
+    id                        TEXT         COLLATE "C" NOT NULL,
+    space_id                  TEXT         COLLATE "C" NOT NULL,
+    account_id                TEXT         COLLATE "C" NOT NULL,
+    statement_date            DATE         NOT NULL,
+    statement_balance         BIGINT       NOT NULL,
+    opening_balance           BIGINT       NOT NULL,
+    cleared_balance           BIGINT       NOT NULL DEFAULT 0,
+    difference                BIGINT       NOT NULL DEFAULT 0,
+    cleared_count             INTEGER      NOT NULL DEFAULT 0,
+    adjustment_transaction_id TEXT         COLLATE "C" DEFAULT NULL,
+    status                    VARCHAR(20)  NOT NULL DEFAULT 'IN_PROGRESS',
+    note                      VARCHAR(500) NOT NULL DEFAULT '',
+    finalize_time             TIMESTAMP WITH TIME ZONE DEFAULT NULL,
+    create_time               TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    update_time               TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (id),
+    CONSTRAINT fk_reconciliation_space FOREIGN KEY (space_id) REFERENCES space.space(id) ON DELETE CASCADE,
+    CONSTRAINT fk_reconciliation_account FOREIGN KEY (account_id) REFERENCES finance.account(id) ON DELETE CASCADE,
+    CONSTRAINT fk_reconciliation_adjustment FOREIGN KEY (adjustment_transaction_id) REFERENCES finance.transaction(id) ON DELETE SET NULL
+);
+
+CREATE INDEX idx_reconciliation_account ON finance.reconciliation (account_id, statement_date DESC);
+
+-- Only one open session per account
+CREATE UNIQUE INDEX idx_reconciliation_in_progress
+    ON finance.reconciliation (account_id)
+    WHERE status = 'IN_PROGRESS';
+
+ALTER TABLE finance.transaction
+    ADD COLUMN cleared           BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN reconciliation_id TEXT    COLLATE "C" DEFAULT NULL
+        REFERENCES finance.reconciliation(id) ON DELETE SET NULL;
+
+CREATE INDEX idx_transaction_reconciliation
+    ON finance.transaction (account_id, reconciliation_id, transaction_date);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS finance.idx_transaction_reconciliation;
+ALTER TABLE finance.transaction
+    DROP COLUMN reconciliation_id,
+    DROP COLUMN cleared;
+DROP TABLE IF EXISTS finance.reconciliation;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE finance.settings
+    ADD COLUMN enabled_currencies TEXT[] NOT NULL DEFAULT '{}';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE finance.settings
+    DROP COLUMN IF EXISTS enabled_currencies;
+-- +goose StatementEnd
@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE finance.goal (
+    id             TEXT         COLLATE "C" NOT NULL,
+    space_id       TEXT         COLLATE "C" NOT NULL,
+    name           VARCHAR(255) NOT NULL,
+    description    TEXT         NOT NULL DEFAULT '',
+    target_amount  BIGINT       NOT NULL,
+    currency       VARCHAR(3)   NOT NULL,
+    start_date     TIMESTAMP WITH TIME ZONE NOT NULL,
+    deadline       TIMESTAMP WITH TIME ZONE DEFAULT NULL,
+    account_id     TEXT         COLLATE "C" DEFAULT NULL,
+    tag            VARCHAR(50)  NOT NULL DEFAULT '',
+    color          VARCHAR(50)  NOT NULL DEFAULT '#10b981',
+    icon           VARCHAR(50)  NOT NULL DEFAULT 'PiggyBank',
+    status         VARCHAR(20)  NOT NULL DEFAULT 'ACTIVE',
+    create_time    TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    update_time    TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (id),
+    CONSTRAINT fk_goal_space FOREIGN KEY (space_id) REFERENCES space.space(id) ON DELETE CASCADE,
+    CONSTRAINT fk_goal_account FOREIGN KEY (account_id) REFERENCES finance.account(id) ON DELETE SET NULL
+);
+
+CREATE INDEX idx_goal_space ON finance.goal (space_id, status);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS finance.goal;
+-- +goose StatementEnd
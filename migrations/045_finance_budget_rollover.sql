@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE finance.budget
+    ADD COLUMN rollover_policy VARCHAR(20) NOT NULL DEFAULT 'none',
+    ADD COLUMN rollover_cap    BIGINT      NOT NULL DEFAULT 0;
+
+ALTER TABLE finance.budget_period
+    ADD COLUMN carried_in  BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN carried_out BIGINT NOT NULL DEFAULT 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE finance.budget_period
+    DROP COLUMN IF EXISTS carried_out,
+    DROP COLUMN IF EXISTS carried_in;
+
+ALTER TABLE finance.budget
+    DROP COLUMN IF EXISTS rollover_cap,
+    DROP COLUMN IF EXISTS rollover_policy;
+-- +goose StatementEnd
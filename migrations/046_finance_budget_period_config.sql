@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE finance.settings
+    ADD COLUMN timezone VARCHAR(64) NOT NULL DEFAULT 'UTC';
+
+ALTER TABLE finance.budget
+    ADD COLUMN period_start_day SMALLINT NOT NULL DEFAULT 1,
+    ADD COLUMN week_start       SMALLINT NOT NULL DEFAULT 1,
+    ADD COLUMN period_anchor    TIMESTAMP WITH TIME ZONE DEFAULT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE finance.budget
+    DROP COLUMN IF EXISTS period_anchor,
+    DROP COLUMN IF EXISTS week_start,
+    DROP COLUMN IF EXISTS period_start_day;
+
+ALTER TABLE finance.settings
+    DROP COLUMN IF EXISTS timezone;
+-- +goose StatementEnd
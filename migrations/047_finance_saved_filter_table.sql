@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE finance.saved_filter (
+    id           TEXT          COLLATE "C" NOT NULL,
+    space_id     TEXT          COLLATE "C" NOT NULL,
+    name         VARCHAR(100)  NOT NULL,
+    description  VARCHAR(500)  NOT NULL DEFAULT '',
+    expression   VARCHAR(2048) NOT NULL,
+    sort         VARCHAR(100)  NOT NULL DEFAULT '',
+    create_time  TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    update_time  TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (id),
+    CONSTRAINT fk_saved_filter_space FOREIGN KEY (space_id) REFERENCES space.space(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_saved_filter_space ON finance.saved_filter (space_id, name);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS finance.saved_filter;
+-- +goose StatementEnd
@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE finance.ledger_export (
+    id             TEXT          COLLATE "C" NOT NULL,
+    space_id       TEXT          COLLATE "C" NOT NULL,
+    format         VARCHAR(20)   NOT NULL,
+    start_date     DATE          NOT NULL,
+    end_date       DATE          NOT NULL,
+    status         VARCHAR(20)   NOT NULL DEFAULT 'PENDING',
+    file_name      VARCHAR(255)  NOT NULL DEFAULT '',
+    content_type   VARCHAR(100)  NOT NULL DEFAULT '',
+    size_bytes     BIGINT        NOT NULL DEFAULT 0,
+    entry_count    BIGINT        NOT NULL DEFAULT 0,
+    error          TEXT          NOT NULL DEFAULT '',
+    content        BYTEA         DEFAULT NULL,
+    create_time    TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    update_time    TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    complete_time  TIMESTAMP WITH TIME ZONE DEFAULT NULL,
+    PRIMARY KEY (id),
+    CONSTRAINT fk_ledger_export_space FOREIGN KEY (space_id) REFERENCES space.space(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_ledger_export_space ON finance.ledger_export (space_id, create_time DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS finance.ledger_export;
+-- +goose StatementEnd
@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE finance.borrowing
+    ADD COLUMN interest_type          VARCHAR(20) NOT NULL DEFAULT 'NONE',
+    ADD COLUMN interest_rate_bps      INTEGER     NOT NULL DEFAULT 0,
+    ADD COLUMN installment_count      INTEGER     NOT NULL DEFAULT 0,
+    ADD COLUMN installment_frequency  VARCHAR(20) NOT NULL DEFAULT '',
+    ADD COLUMN first_installment_date TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN budget_id              TEXT        COLLATE "C" REFERENCES finance.budget(id) ON DELETE SET NULL,
+    ADD COLUMN paid_amount            BIGINT      NOT NULL DEFAULT 0;
+
+-- Existing borrowings are repaid ad hoc, so everything repaid reduced the principal.
+UPDATE finance.borrowing SET paid_amount = total_amount - remaining_amount;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE finance.borrowing
+    DROP COLUMN IF EXISTS paid_amount,
+    DROP COLUMN IF EXISTS budget_id,
+    DROP COLUMN IF EXISTS first_installment_date,
+    DROP COLUMN IF EXISTS installment_frequency,
+    DROP COLUMN IF EXISTS installment_count,
+    DROP COLUMN IF EXISTS interest_rate_bps,
+    DROP COLUMN IF EXISTS interest_type;
+-- +goose StatementEnd
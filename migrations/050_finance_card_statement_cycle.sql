@@ -0,0 +1,49 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE finance.account
+    ADD COLUMN statement_closing_day  INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN payment_due_day        INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN minimum_payment_bps    INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN minimum_payment_floor  BIGINT  NOT NULL DEFAULT 0,
+    ADD COLUMN payment_budget_id      TEXT    COLLATE "C" REFERENCES finance.budget(id) ON DELETE SET NULL;
+
+CREATE TABLE finance.card_statement (
+    id                    TEXT          COLLATE "C" NOT NULL,
+    space_id              TEXT          COLLATE "C" NOT NULL,
+    account_id            TEXT          COLLATE "C" NOT NULL,
+    currency              VARCHAR(3)    NOT NULL,
+    period_start          TIMESTAMP WITH TIME ZONE NOT NULL,
+    closing_date          DATE          NOT NULL,
+    due_date              DATE          NOT NULL,
+    opening_balance       BIGINT        NOT NULL DEFAULT 0,
+    purchases             BIGINT        NOT NULL DEFAULT 0,
+    credits               BIGINT        NOT NULL DEFAULT 0,
+    statement_balance     BIGINT        NOT NULL DEFAULT 0,
+    minimum_payment       BIGINT        NOT NULL DEFAULT 0,
+    paid_amount           BIGINT        NOT NULL DEFAULT 0,
+    credit_limit          BIGINT        NOT NULL DEFAULT 0,
+    utilization           DOUBLE PRECISION NOT NULL DEFAULT 0,
+    status                VARCHAR(20)   NOT NULL DEFAULT 'ISSUED',
+    scheduled_payment_id  TEXT          COLLATE "C" DEFAULT NULL,
+    create_time           TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    update_time           TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (id),
+    CONSTRAINT fk_card_statement_space FOREIGN KEY (space_id) REFERENCES space.space(id) ON DELETE CASCADE,
+    CONSTRAINT fk_card_statement_account FOREIGN KEY (account_id) REFERENCES finance.account(id) ON DELETE CASCADE,
+    CONSTRAINT uq_card_statement_closing UNIQUE (account_id, closing_date)
+);
+
+CREATE INDEX idx_card_statement_account ON finance.card_statement (space_id, account_id, closing_date DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS finance.card_statement;
+
+ALTER TABLE finance.account
+    DROP COLUMN IF EXISTS payment_budget_id,
+    DROP COLUMN IF EXISTS minimum_payment_floor,
+    DROP COLUMN IF EXISTS minimum_payment_bps,
+    DROP COLUMN IF EXISTS payment_due_day,
+    DROP COLUMN IF EXISTS statement_closing_day;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE finance.account
+    ADD COLUMN low_balance_threshold BIGINT NOT NULL DEFAULT 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE finance.account
+    DROP COLUMN IF EXISTS low_balance_threshold;
+-- +goose StatementEnd
@@ -0,0 +1,43 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE finance.settings
+    ADD COLUMN budget_alert_percents  INTEGER[]        NOT NULL DEFAULT '{}',
+    ADD COLUMN anomaly_std_devs       DOUBLE PRECISION NOT NULL DEFAULT 0,
+    ADD COLUMN duplicate_window_hours INTEGER          NOT NULL DEFAULT 0;
+
+CREATE TABLE finance.alert (
+    id                      TEXT          COLLATE "C" NOT NULL,
+    space_id                TEXT          COLLATE "C" NOT NULL,
+    rule                    VARCHAR(32)   NOT NULL,
+    status                  VARCHAR(20)   NOT NULL DEFAULT 'OPEN',
+    message                 TEXT          NOT NULL DEFAULT '',
+    budget_id               TEXT          COLLATE "C" DEFAULT NULL,
+    period_id               TEXT          COLLATE "C" DEFAULT NULL,
+    transaction_id          TEXT          COLLATE "C" DEFAULT NULL,
+    related_transaction_id  TEXT          COLLATE "C" DEFAULT NULL,
+    amount                  BIGINT        NOT NULL DEFAULT 0,
+    threshold               BIGINT        NOT NULL DEFAULT 0,
+    currency                VARCHAR(3)    NOT NULL DEFAULT '',
+    dedup_key               TEXT          NOT NULL,
+    snoozed_until           TIMESTAMP WITH TIME ZONE DEFAULT NULL,
+    acknowledge_time        TIMESTAMP WITH TIME ZONE DEFAULT NULL,
+    create_time             TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    update_time             TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (id),
+    CONSTRAINT fk_alert_space FOREIGN KEY (space_id) REFERENCES space.space(id) ON DELETE CASCADE,
+    CONSTRAINT fk_alert_budget FOREIGN KEY (budget_id) REFERENCES finance.budget(id) ON DELETE CASCADE,
+    CONSTRAINT uq_alert_dedup_key UNIQUE (space_id, dedup_key)
+);
+
+CREATE INDEX idx_alert_space_create_time ON finance.alert (space_id, create_time DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS finance.alert;
+
+ALTER TABLE finance.settings
+    DROP COLUMN IF EXISTS duplicate_window_hours,
+    DROP COLUMN IF EXISTS anomaly_std_devs,
+    DROP COLUMN IF EXISTS budget_alert_percents;
+-- +goose StatementEnd
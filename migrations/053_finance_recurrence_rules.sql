@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE finance.recurring_expense
+    ADD COLUMN recurrence_rule TEXT   NOT NULL DEFAULT '',
+    ADD COLUMN start_date      DATE   DEFAULT NULL,
+    ADD COLUMN exception_dates DATE[] NOT NULL DEFAULT '{}';
+
+-- Existing templates continue their interval from the current due date.
+UPDATE finance.recurring_expense SET start_date = next_due_date;
+
+ALTER TABLE finance.recurring_expense ALTER COLUMN start_date SET NOT NULL;
+
+ALTER TABLE finance.income_source
+    ADD COLUMN recurrence_rule TEXT   NOT NULL DEFAULT '',
+    ADD COLUMN start_date      DATE   DEFAULT NULL,
+    ADD COLUMN exception_dates DATE[] NOT NULL DEFAULT '{}';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE finance.income_source
+    DROP COLUMN IF EXISTS exception_dates,
+    DROP COLUMN IF EXISTS start_date,
+    DROP COLUMN IF EXISTS recurrence_rule;
+
+ALTER TABLE finance.recurring_expense
+    DROP COLUMN IF EXISTS exception_dates,
+    DROP COLUMN IF EXISTS start_date,
+    DROP COLUMN IF EXISTS recurrence_rule;
+-- +goose StatementEnd
@@ -0,0 +1,64 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE finance.payee (
+    id                  TEXT          COLLATE "C" NOT NULL,
+    space_id            TEXT          COLLATE "C" NOT NULL,
+    name                VARCHAR(100)  NOT NULL,
+    aliases             TEXT[]        NOT NULL DEFAULT '{}',
+    patterns            TEXT[]        NOT NULL DEFAULT '{}',
+    default_budget_id   TEXT          COLLATE "C" DEFAULT NULL,
+    default_account_id  TEXT          COLLATE "C" DEFAULT NULL,
+    logo_url            VARCHAR(2048) NOT NULL DEFAULT '',
+    create_time         TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    update_time         TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (id),
+    CONSTRAINT fk_payee_space FOREIGN KEY (space_id) REFERENCES space.space(id) ON DELETE CASCADE,
+    CONSTRAINT fk_payee_budget FOREIGN KEY (default_budget_id) REFERENCES finance.budget(id) ON DELETE SET NULL,
+    CONSTRAINT fk_payee_account FOREIGN KEY (default_account_id) REFERENCES finance.account(id) ON DELETE SET NULL
+);
+
+CREATE INDEX idx_payee_space ON finance.payee (space_id, name);
+
+CREATE TABLE finance.transaction_rule (
+    id                   TEXT          COLLATE "C" NOT NULL,
+    space_id             TEXT          COLLATE "C" NOT NULL,
+    name                 VARCHAR(100)  NOT NULL,
+    priority             INTEGER       NOT NULL DEFAULT 0,
+    is_active            BOOLEAN       NOT NULL DEFAULT TRUE,
+    description_pattern  VARCHAR(200)  NOT NULL DEFAULT '',
+    account_id           TEXT          COLLATE "C" DEFAULT NULL,
+    min_amount           BIGINT        DEFAULT NULL,
+    max_amount           BIGINT        DEFAULT NULL,
+    set_budget_id        TEXT          COLLATE "C" DEFAULT NULL,
+    set_payee_id         TEXT          COLLATE "C" DEFAULT NULL,
+    set_category_id      TEXT          COLLATE "C" DEFAULT NULL,
+    add_tags             TEXT[]        NOT NULL DEFAULT '{}',
+    create_time          TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    update_time          TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (id),
+    CONSTRAINT fk_transaction_rule_space FOREIGN KEY (space_id) REFERENCES space.space(id) ON DELETE CASCADE,
+    CONSTRAINT fk_transaction_rule_account FOREIGN KEY (account_id) REFERENCES finance.account(id) ON DELETE CASCADE,
+    CONSTRAINT fk_transaction_rule_budget FOREIGN KEY (set_budget_id) REFERENCES finance.budget(id) ON DELETE SET NULL,
+    CONSTRAINT fk_transaction_rule_payee FOREIGN KEY (set_payee_id) REFERENCES finance.payee(id) ON DELETE SET NULL,
+    CONSTRAINT fk_transaction_rule_category FOREIGN KEY (set_category_id) REFERENCES finance.category(id) ON DELETE SET NULL
+);
+
+CREATE INDEX idx_transaction_rule_space ON finance.transaction_rule (space_id, priority);
+
+ALTER TABLE finance.transaction
+    ADD COLUMN payee_id TEXT COLLATE "C" REFERENCES finance.payee(id) ON DELETE SET NULL;
+
+CREATE INDEX idx_transaction_payee ON finance.transaction (payee_id);
+
+ALTER TABLE finance.inbox_item
+    ADD COLUMN payee_id TEXT COLLATE "C" REFERENCES finance.payee(id) ON DELETE SET NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE finance.inbox_item DROP COLUMN payee_id;
+DROP INDEX IF EXISTS finance.idx_transaction_payee;
+ALTER TABLE finance.transaction DROP COLUMN payee_id;
+DROP TABLE IF EXISTS finance.transaction_rule;
+DROP TABLE IF EXISTS finance.payee;
+-- +goose StatementEnd
@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE space.invitation (
+    id          TEXT          COLLATE "C" NOT NULL,
+    space_id    TEXT          COLLATE "C" NOT NULL,
+    email       VARCHAR(320)  NOT NULL,
+    role        VARCHAR(50)   NOT NULL,
+    inviter_id  TEXT          COLLATE "C" NOT NULL,
+    token_hash  CHAR(64)      NOT NULL,
+    status      VARCHAR(20)   NOT NULL DEFAULT 'pending',
+    expire_time TIMESTAMP WITH TIME ZONE NOT NULL,
+    accepted_by TEXT          COLLATE "C" DEFAULT NULL,
+    create_time TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    update_time TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (id),
+    CONSTRAINT uq_invitation_token_hash UNIQUE (token_hash),
+    CONSTRAINT fk_invitation_space FOREIGN KEY (space_id) REFERENCES space.space(id) ON DELETE CASCADE,
+    CONSTRAINT fk_invitation_inviter FOREIGN KEY (inviter_id) REFERENCES identity.user(id) ON DELETE CASCADE,
+    CONSTRAINT fk_invitation_accepted_by FOREIGN KEY (accepted_by) REFERENCES identity.user(id) ON DELETE SET NULL
+);
+
+CREATE INDEX idx_invitation_space ON space.invitation (space_id, create_time DESC, id DESC);
+CREATE INDEX idx_invitation_pending_email ON space.invitation (space_id, email) WHERE status = 'pending';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS space.invitation;
+-- +goose StatementEnd
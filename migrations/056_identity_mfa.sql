@@ -0,0 +1,20 @@
+-- +goose Up
+-- Second factor state for TOTP credentials
+ALTER TABLE identity.user_credentials
+  ADD COLUMN confirm_time TIMESTAMPTZ DEFAULT NULL,
+  ADD COLUMN last_used_step BIGINT NOT NULL DEFAULT 0;
+
+-- One-time MFA recovery codes; only SHA-256 hashes are stored
+CREATE TABLE identity.user_recovery_codes (
+    user_id     TEXT        NOT NULL REFERENCES identity.user(id) ON DELETE CASCADE,
+    code_hash   TEXT        NOT NULL,
+    use_time    TIMESTAMPTZ DEFAULT NULL,
+    create_time TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (user_id, code_hash)
+);
+
+-- +goose Down
+DROP TABLE identity.user_recovery_codes;
+ALTER TABLE identity.user_credentials
+  DROP COLUMN confirm_time,
+  DROP COLUMN last_used_step;
@@ -0,0 +1,42 @@
+-- +goose Up
+-- +goose StatementBegin
+-- WebAuthn passkeys; a user may register several
+CREATE TABLE identity.user_passkeys (
+    id               TEXT         COLLATE "C" NOT NULL,
+    user_id          TEXT         COLLATE "C" NOT NULL REFERENCES identity.user(id) ON DELETE CASCADE,
+    name             VARCHAR(64)  NOT NULL,
+    credential_id    BYTEA        NOT NULL,
+    public_key       BYTEA        NOT NULL,
+    attestation_type VARCHAR(32)  NOT NULL,
+    transports       TEXT[]       NOT NULL DEFAULT '{}',
+    aaguid           BYTEA        NOT NULL,
+    sign_count       BIGINT       NOT NULL DEFAULT 0,
+    backup_eligible  BOOLEAN      NOT NULL DEFAULT FALSE,
+    backup_state     BOOLEAN      NOT NULL DEFAULT FALSE,
+    create_time      TIMESTAMP WITH TIME ZONE NOT NULL,
+    last_used_time   TIMESTAMP WITH TIME ZONE DEFAULT NULL,
+    PRIMARY KEY (id),
+    CONSTRAINT uq_user_passkeys_credential_id UNIQUE (credential_id)
+);
+
+CREATE INDEX idx_user_passkeys_user ON identity.user_passkeys (user_id, create_time);
+
+-- Started registration and login ceremonies; each row is consumed once. user_id has no foreign
+-- key because a signup ceremony reserves the ID of an account that does not exist yet.
+CREATE TABLE identity.webauthn_ceremonies (
+    id           TEXT         COLLATE "C" NOT NULL,
+    kind         VARCHAR(20)  NOT NULL,
+    user_id      TEXT         COLLATE "C" DEFAULT NULL,
+    session_data JSONB        NOT NULL,
+    expire_time  TIMESTAMP WITH TIME ZONE NOT NULL,
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX idx_webauthn_ceremonies_expire_time ON identity.webauthn_ceremonies (expire_time);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS identity.webauthn_ceremonies;
+DROP TABLE IF EXISTS identity.user_passkeys;
+-- +goose StatementEnd
@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Email verification; accounts that existed before verification was introduced count as verified
+ALTER TABLE identity.user ADD COLUMN email_verify_time TIMESTAMP WITH TIME ZONE DEFAULT NULL;
+UPDATE identity.user SET email_verify_time = create_time;
+
+-- Single-use password reset and email verification tokens; only SHA-256 hashes are stored
+CREATE TABLE identity.user_account_tokens (
+    token_hash  TEXT         NOT NULL,
+    user_id     TEXT         COLLATE "C" NOT NULL REFERENCES identity.user(id) ON DELETE CASCADE,
+    purpose     VARCHAR(32)  NOT NULL,
+    email       VARCHAR(255) NOT NULL,
+    expire_time TIMESTAMP WITH TIME ZONE NOT NULL,
+    use_time    TIMESTAMP WITH TIME ZONE DEFAULT NULL,
+    create_time TIMESTAMP WITH TIME ZONE NOT NULL,
+    PRIMARY KEY (token_hash)
+);
+
+CREATE INDEX idx_user_account_tokens_user ON identity.user_account_tokens (user_id, purpose);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS identity.user_account_tokens;
+ALTER TABLE identity.user DROP COLUMN IF EXISTS email_verify_time;
+-- +goose StatementEnd
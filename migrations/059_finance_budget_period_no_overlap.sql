@@ -0,0 +1,52 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Periods used to be looked up by their exact bounds, so changing a budget's cycle or the space timezone
+-- created a second period overlapping the current one. Periods that lie inside another one are folded into it.
+CREATE TEMPORARY TABLE budget_period_merge AS
+SELECT DISTINCT ON (inner_period.id) inner_period.id AS from_id, outer_period.id AS to_id
+FROM finance.budget_period inner_period
+JOIN finance.budget_period outer_period
+  ON outer_period.budget_id = inner_period.budget_id
+ AND outer_period.id <> inner_period.id
+ AND outer_period.start_date <= inner_period.start_date
+ AND outer_period.end_date >= inner_period.end_date
+ORDER BY inner_period.id, outer_period.start_date, outer_period.end_date DESC;
+
+UPDATE finance.transaction t SET period_id = m.to_id
+FROM budget_period_merge m WHERE t.period_id = m.from_id;
+
+UPDATE finance.transaction_split s SET period_id = m.to_id
+FROM budget_period_merge m WHERE s.period_id = m.from_id;
+
+UPDATE finance.alert a SET period_id = m.to_id
+FROM budget_period_merge m WHERE a.period_id = m.from_id;
+
+DELETE FROM finance.budget_period p USING budget_period_merge m WHERE p.id = m.from_id;
+DROP TABLE budget_period_merge;
+
+-- The remaining overlapping periods start after the end of the period they overlap.
+UPDATE finance.budget_period p
+SET start_date = o.previous_end + INTERVAL '1 second', update_time = NOW()
+FROM (
+    SELECT later.id, MAX(earlier.end_date) AS previous_end
+    FROM finance.budget_period later
+    JOIN finance.budget_period earlier
+      ON earlier.budget_id = later.budget_id
+     AND earlier.id <> later.id
+     AND earlier.start_date < later.start_date
+     AND earlier.end_date >= later.start_date
+    GROUP BY later.id
+) o
+WHERE p.id = o.id;
+
+CREATE EXTENSION IF NOT EXISTS btree_gist;
+
+ALTER TABLE finance.budget_period
+    ADD CONSTRAINT excl_period_budget_overlap
+    EXCLUDE USING gist (budget_id WITH =, tstzrange(start_date, end_date, '[]') WITH &&);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE finance.budget_period DROP CONSTRAINT IF EXISTS excl_period_budget_overlap;
+-- +goose StatementEnd
@@ -0,0 +1,235 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Amounts were stored as hundredths of the major unit for every currency. They are now stored in each
+-- currency's ISO 4217 minor units, so amounts in currencies without two decimals are rescaled.
+CREATE TEMPORARY TABLE currency_rescale (code VARCHAR(3) PRIMARY KEY, factor NUMERIC NOT NULL);
+INSERT INTO currency_rescale (code, factor)
+SELECT code, 0.01 FROM unnest(ARRAY['BIF','CLP','DJF','GNF','ISK','JPY','KMF','KRW','PYG','RWF','UGX','VND','VUV','XAF','XOF','XPF']) AS code
+UNION ALL
+SELECT code, 10 FROM unnest(ARRAY['BHD','IQD','JOD','KWD','LYD','OMR','TND']) AS code;
+
+UPDATE finance.budget b
+SET limit_amount = ROUND(b.limit_amount * r.factor), rollover_cap = ROUND(b.rollover_cap * r.factor)
+FROM currency_rescale r WHERE r.code = b.currency;
+
+UPDATE finance.budget_period p
+SET limit_amount = ROUND(p.limit_amount * r.factor),
+    carried_in   = ROUND(p.carried_in * r.factor),
+    carried_out  = ROUND(p.carried_out * r.factor)
+FROM currency_rescale r WHERE r.code = p.currency;
+
+UPDATE finance.transaction t
+SET amount = ROUND(t.amount * r.factor)
+FROM currency_rescale r WHERE r.code = t.currency;
+
+UPDATE finance.transaction t
+SET amount_in_base = ROUND(t.amount_in_base * r.factor)
+FROM finance.settings s JOIN currency_rescale r ON r.code = s.base_currency
+WHERE s.space_id = t.space_id;
+
+UPDATE finance.transaction_split sp
+SET amount = ROUND(sp.amount * r.factor)
+FROM finance.transaction t JOIN currency_rescale r ON r.code = t.currency
+WHERE t.id = sp.transaction_id;
+
+UPDATE finance.transaction_split sp
+SET amount_in_base = ROUND(sp.amount_in_base * r.factor)
+FROM finance.transaction t
+JOIN finance.settings s ON s.space_id = t.space_id
+JOIN currency_rescale r ON r.code = s.base_currency
+WHERE t.id = sp.transaction_id;
+
+UPDATE finance.account a
+SET initial_balance       = ROUND(a.initial_balance * r.factor),
+    current_balance       = ROUND(a.current_balance * r.factor),
+    credit_limit          = ROUND(a.credit_limit * r.factor),
+    minimum_payment_floor = ROUND(a.minimum_payment_floor * r.factor),
+    low_balance_threshold = ROUND(a.low_balance_threshold * r.factor)
+FROM currency_rescale r WHERE r.code = a.currency;
+
+UPDATE finance.transfer tr
+SET source_amount = ROUND(tr.source_amount * r.factor)
+FROM finance.account a JOIN currency_rescale r ON r.code = a.currency
+WHERE a.id = tr.source_account_id;
+
+UPDATE finance.transfer tr
+SET destination_amount = ROUND(tr.destination_amount * r.factor)
+FROM finance.account a JOIN currency_rescale r ON r.code = a.currency
+WHERE a.id = tr.destination_account_id;
+
+UPDATE finance.reconciliation rc
+SET statement_balance = ROUND(rc.statement_balance * r.factor),
+    opening_balance   = ROUND(rc.opening_balance * r.factor),
+    cleared_balance   = ROUND(rc.cleared_balance * r.factor),
+    difference        = ROUND(rc.difference * r.factor)
+FROM finance.account a JOIN currency_rescale r ON r.code = a.currency
+WHERE a.id = rc.account_id;
+
+UPDATE finance.card_statement cs
+SET opening_balance   = ROUND(cs.opening_balance * r.factor),
+    purchases         = ROUND(cs.purchases * r.factor),
+    credits           = ROUND(cs.credits * r.factor),
+    statement_balance = ROUND(cs.statement_balance * r.factor),
+    minimum_payment   = ROUND(cs.minimum_payment * r.factor),
+    paid_amount       = ROUND(cs.paid_amount * r.factor),
+    credit_limit      = ROUND(cs.credit_limit * r.factor)
+FROM currency_rescale r WHERE r.code = cs.currency;
+
+UPDATE finance.transaction_rule tr
+SET min_amount = ROUND(tr.min_amount * r.factor), max_amount = ROUND(tr.max_amount * r.factor)
+FROM finance.account a JOIN currency_rescale r ON r.code = a.currency
+WHERE a.id = tr.account_id;
+
+UPDATE finance.borrowing b
+SET total_amount     = ROUND(b.total_amount * r.factor),
+    remaining_amount = ROUND(b.remaining_amount * r.factor),
+    paid_amount      = ROUND(b.paid_amount * r.factor)
+FROM currency_rescale r WHERE r.code = b.currency;
+
+UPDATE finance.recurring_expense e
+SET amount = ROUND(e.amount * r.factor)
+FROM currency_rescale r WHERE r.code = e.currency;
+
+UPDATE finance.scheduled_payment sp
+SET amount = ROUND(sp.amount * r.factor)
+FROM currency_rescale r WHERE r.code = sp.currency;
+
+UPDATE finance.income_source i
+SET expected_amount = ROUND(i.expected_amount * r.factor)
+FROM currency_rescale r WHERE r.code = i.currency;
+
+UPDATE finance.goal g
+SET target_amount = ROUND(g.target_amount * r.factor)
+FROM currency_rescale r WHERE r.code = g.currency;
+
+UPDATE finance.alert al
+SET amount = ROUND(al.amount * r.factor), threshold = ROUND(al.threshold * r.factor)
+FROM currency_rescale r WHERE r.code = al.currency;
+
+UPDATE finance.inbox_item i
+SET amount = ROUND(i.amount * r.factor),
+    splits = COALESCE((
+        SELECT jsonb_agg(jsonb_set(line, '{amount}', to_jsonb(ROUND((line->>'amount')::NUMERIC * r.factor)::BIGINT)) ORDER BY n)
+        FROM jsonb_array_elements(i.splits) WITH ORDINALITY AS l(line, n)
+    ), '[]'::JSONB)
+FROM currency_rescale r WHERE r.code = i.currency;
+
+DROP TABLE currency_rescale;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE TEMPORARY TABLE currency_rescale (code VARCHAR(3) PRIMARY KEY, factor NUMERIC NOT NULL);
+INSERT INTO currency_rescale (code, factor)
+SELECT code, 100 FROM unnest(ARRAY['BIF','CLP','DJF','GNF','ISK','JPY','KMF','KRW','PYG','RWF','UGX','VND','VUV','XAF','XOF','XPF']) AS code
+UNION ALL
+SELECT code, 0.1 FROM unnest(ARRAY['BHD','IQD','JOD','KWD','LYD','OMR','TND']) AS code;
+
+UPDATE finance.budget b
+SET limit_amount = ROUND(b.limit_amount * r.factor), rollover_cap = ROUND(b.rollover_cap * r.factor)
+FROM currency_rescale r WHERE r.code = b.currency;
+
+UPDATE finance.budget_period p
+SET limit_amount = ROUND(p.limit_amount * r.factor),
+    carried_in   = ROUND(p.carried_in * r.factor),
+    carried_out  = ROUND(p.carried_out * r.factor)
+FROM currency_rescale r WHERE r.code = p.currency;
+
+UPDATE finance.transaction t
+SET amount = ROUND(t.amount * r.factor)
+FROM currency_rescale r WHERE r.code = t.currency;
+
+UPDATE finance.transaction t
+SET amount_in_base = ROUND(t.amount_in_base * r.factor)
+FROM finance.settings s JOIN currency_rescale r ON r.code = s.base_currency
+WHERE s.space_id = t.space_id;
+
+UPDATE finance.transaction_split sp
+SET amount = ROUND(sp.amount * r.factor)
+FROM finance.transaction t JOIN currency_rescale r ON r.code = t.currency
+WHERE t.id = sp.transaction_id;
+
+UPDATE finance.transaction_split sp
+SET amount_in_base = ROUND(sp.amount_in_base * r.factor)
+FROM finance.transaction t
+JOIN finance.settings s ON s.space_id = t.space_id
+JOIN currency_rescale r ON r.code = s.base_currency
+WHERE t.id = sp.transaction_id;
+
+UPDATE finance.account a
+SET initial_balance       = ROUND(a.initial_balance * r.factor),
+    current_balance       = ROUND(a.current_balance * r.factor),
+    credit_limit          = ROUND(a.credit_limit * r.factor),
+    minimum_payment_floor = ROUND(a.minimum_payment_floor * r.factor),
+    low_balance_threshold = ROUND(a.low_balance_threshold * r.factor)
+FROM currency_rescale r WHERE r.code = a.currency;
+
+UPDATE finance.transfer tr
+SET source_amount = ROUND(tr.source_amount * r.factor)
+FROM finance.account a JOIN currency_rescale r ON r.code = a.currency
+WHERE a.id = tr.source_account_id;
+
+UPDATE finance.transfer tr
+SET destination_amount = ROUND(tr.destination_amount * r.factor)
+FROM finance.account a JOIN currency_rescale r ON r.code = a.currency
+WHERE a.id = tr.destination_account_id;
+
+UPDATE finance.reconciliation rc
+SET statement_balance = ROUND(rc.statement_balance * r.factor),
+    opening_balance   = ROUND(rc.opening_balance * r.factor),
+    cleared_balance   = ROUND(rc.cleared_balance * r.factor),
+    difference        = ROUND(rc.difference * r.factor)
+FROM finance.account a JOIN currency_rescale r ON r.code = a.currency
+WHERE a.id = rc.account_id;
+
+UPDATE finance.card_statement cs
+SET opening_balance   = ROUND(cs.opening_balance * r.factor),
+    purchases         = ROUND(cs.purchases * r.factor),
+    credits           = ROUND(cs.credits * r.factor),
+    statement_balance = ROUND(cs.statement_balance * r.factor),
+    minimum_payment   = ROUND(cs.minimum_payment * r.factor),
+    paid_amount       = ROUND(cs.paid_amount * r.factor),
+    credit_limit      = ROUND(cs.credit_limit * r.factor)
+FROM currency_rescale r WHERE r.code = cs.currency;
+
+UPDATE finance.transaction_rule tr
+SET min_amount = ROUND(tr.min_amount * r.factor), max_amount = ROUND(tr.max_amount * r.factor)
+FROM finance.account a JOIN currency_rescale r ON r.code = a.currency
+WHERE a.id = tr.account_id;
+
+UPDATE finance.borrowing b
+SET total_amount     = ROUND(b.total_amount * r.factor),
+    remaining_amount = ROUND(b.remaining_amount * r.factor),
+    paid_amount      = ROUND(b.paid_amount * r.factor)
+FROM currency_rescale r WHERE r.code = b.currency;
+
+UPDATE finance.recurring_expense e
+SET amount = ROUND(e.amount * r.factor)
+FROM currency_rescale r WHERE r.code = e.currency;
+
+UPDATE finance.scheduled_payment sp
+SET amount = ROUND(sp.amount * r.factor)
+FROM currency_rescale r WHERE r.code = sp.currency;
+
+UPDATE finance.income_source i
+SET expected_amount = ROUND(i.expected_amount * r.factor)
+FROM currency_rescale r WHERE r.code = i.currency;
+
+UPDATE finance.goal g
+SET target_amount = ROUND(g.target_amount * r.factor)
+FROM currency_rescale r WHERE r.code = g.currency;
+
+UPDATE finance.alert al
+SET amount = ROUND(al.amount * r.factor), threshold = ROUND(al.threshold * r.factor)
+FROM currency_rescale r WHERE r.code = al.currency;
+
+UPDATE finance.inbox_item i
+SET amount = ROUND(i.amount * r.factor),
+    splits = COALESCE((
+        SELECT jsonb_agg(jsonb_set(line, '{amount}', to_jsonb(ROUND((line->>'amount')::NUMERIC * r.factor)::BIGINT)) ORDER BY n)
+        FROM jsonb_array_elements(i.splits) WITH ORDINALITY AS l(line, n)
+    ), '[]'::JSONB)
+FROM currency_rescale r WHERE r.code = i.currency;
+
+DROP TABLE currency_rescale;
+-- +goose StatementEnd
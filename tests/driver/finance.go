@@ -17,6 +17,9 @@ import (
 type ExpenseOptions struct {
 	Account     string
 	Budget      string
+	Category    string // Optional: name/alias of category
+	Tags        []string
+	Splits      []SplitOptions // Optional: split lines replacing Budget
 	Currency    string
 	Amount      int64
 	Description string
@@ -24,6 +27,52 @@ type ExpenseOptions struct {
 	Assert      func(tb testing.TB, txn *financev1.Transaction)
 }
 
+// SplitOptions encapsulates parameters for a single split line of an expense.
+type SplitOptions struct {
+	Budget   string
+	Category string // Optional: name/alias of category
+	Amount   int64
+	Note     string
+}
+
+// IncomeOptions encapsulates parameters for logging an income transaction.
+type IncomeOptions struct {
+	Account      string
+	IncomeSource string
+	Currency     string
+	Amount       int64
+	Description  string
+	ExpectErr    string
+	Assert       func(tb testing.TB, txn *financev1.Transaction)
+}
+
+// IncomeSourceOptions encapsulates parameters for registering an income source.
+type IncomeSourceOptions struct {
+	Name           string
+	Employer       string
+	Account        string
+	Currency       string
+	ExpectedAmount int64
+	Cadence        financev1.IncomeSource_Cadence
+}
+
+// CategoryOptions encapsulates parameters for creating a transaction category.
+type CategoryOptions struct {
+	Name      string
+	Parent    string // Optional: name/alias of parent category
+	Icon      string
+	Color     string
+	ExpectErr string
+}
+
+// TransactionFilterOptions encapsulates classification filters for listing transactions.
+type TransactionFilterOptions struct {
+	Category    string // Optional: name/alias of category
+	Tags        []string
+	Filter      string // Optional: AIP-160 filter expression
+	SavedFilter string // Optional: name/alias of a saved filter
+}
+
 // BudgetDeleteOptions encapsulates options for deleting a budget.
 type BudgetDeleteOptions struct {
 	Budget    string
@@ -623,9 +672,13 @@ func (f *FinanceDriver) CreateExpense(tb testing.TB, opts ExpenseOptions) *Finan
 	if !ok {
 		tb.Fatalf("account named %q not found in state registry", opts.Account)
 	}
-	budID, ok := f.driver.state.Budgets[opts.Budget]
-	if !ok {
-		tb.Fatalf("budget named %q not found in state registry", opts.Budget)
+	var budID string
+	if len(opts.Splits) == 0 {
+		id, ok := f.driver.state.Budgets[opts.Budget]
+		if !ok {
+			tb.Fatalf("budget named %q not found in state registry", opts.Budget)
+		}
+		budID = id
 	}
 
 	currency := opts.Currency
@@ -633,15 +686,44 @@ func (f *FinanceDriver) CreateExpense(tb testing.TB, opts ExpenseOptions) *Finan
 		currency = acc.Currency
 	}
 
+	input := &financev1.ExpenseInput{
+		BudgetId:    budID,
+		Amount:      opts.Amount,
+		Currency:    currency,
+		Description: opts.Description,
+		AccountId:   &acc.ID,
+		Tags:        opts.Tags,
+	}
+	if opts.Category != "" {
+		catID, ok := f.driver.state.Categories[opts.Category]
+		if !ok {
+			tb.Fatalf("category named %q not found in state registry", opts.Category)
+		}
+		input.CategoryId = &catID
+	}
+	for _, so := range opts.Splits {
+		splitBudID, ok := f.driver.state.Budgets[so.Budget]
+		if !ok {
+			tb.Fatalf("split budget named %q not found in state registry", so.Budget)
+		}
+		split := &financev1.TransactionSplit{
+			BudgetId: &splitBudID,
+			Amount:   so.Amount,
+			Note:     so.Note,
+		}
+		if so.Category != "" {
+			catID, ok := f.driver.state.Categories[so.Category]
+			if !ok {
+				tb.Fatalf("category named %q not found in state registry", so.Category)
+			}
+			split.CategoryId = &catID
+		}
+		input.Splits = append(input.Splits, split)
+	}
+
 	client := f.getClient()
 	txn, err := client.CreateExpense(tb.Context(), &financev1.CreateExpenseRequest{
-		Expense: &financev1.ExpenseInput{
-			BudgetId:    budID,
-			Amount:      opts.Amount,
-			Currency:    currency,
-			Description: opts.Description,
-			AccountId:   &acc.ID,
-		},
+		Expense: input,
 	})
 
 	if opts.ExpectErr != "" {
@@ -670,6 +752,9 @@ func (f *FinanceDriver) CreateExpense(tb testing.TB, opts ExpenseOptions) *Finan
 	if txn.GetBudgetId() != budID {
 		tb.Errorf("CreateExpense BudgetId = %s, want %s", txn.GetBudgetId(), budID)
 	}
+	if len(txn.GetSplits()) != len(opts.Splits) {
+		tb.Errorf("CreateExpense Splits = %d, want %d", len(txn.GetSplits()), len(opts.Splits))
+	}
 
 	f.driver.state.LastTransactionID = txn.GetId()
 	if opts.Assert != nil {
@@ -678,6 +763,283 @@ func (f *FinanceDriver) CreateExpense(tb testing.TB, opts ExpenseOptions) *Finan
 	return f
 }
 
+// CreateCategory registers a transaction category using CategoryOptions struct.
+func (f *FinanceDriver) CreateCategory(tb testing.TB, opts CategoryOptions) *FinanceDriver {
+	tb.Helper()
+	if tb.Failed() {
+		return f
+	}
+
+	category := &financev1.Category{
+		Name:  opts.Name,
+		Icon:  opts.Icon,
+		Color: opts.Color,
+	}
+	if opts.Parent != "" {
+		parentID, ok := f.driver.state.Categories[opts.Parent]
+		if !ok {
+			tb.Fatalf("category named %q not found in state registry", opts.Parent)
+		}
+		category.ParentId = &parentID
+	}
+
+	client := f.getClient()
+	created, err := client.CreateCategory(tb.Context(), &financev1.CreateCategoryRequest{
+		Category: category,
+	})
+
+	if opts.ExpectErr != "" {
+		if err == nil {
+			tb.Fatalf("CreateCategory succeeded, but expected error containing %q", opts.ExpectErr)
+		}
+		if !strings.Contains(err.Error(), opts.ExpectErr) {
+			tb.Fatalf("CreateCategory error = %v, want error containing %q", err, opts.ExpectErr)
+		}
+		return f
+	}
+
+	if err != nil {
+		tb.Fatalf("CreateCategory SDK call failed: %v", err)
+	}
+	if created.GetName() != opts.Name {
+		tb.Errorf("CreateCategory Name = %q, want %q", created.GetName(), opts.Name)
+	}
+	if category.ParentId != nil && created.GetParentId() != *category.ParentId {
+		tb.Errorf("CreateCategory ParentId = %s, want %s", created.GetParentId(), *category.ParentId)
+	}
+
+	f.driver.state.Categories[opts.Name] = created.GetId()
+	return f
+}
+
+// AssertFilteredTransactionCount asserts the number of transactions matching category and tag filters.
+func (f *FinanceDriver) AssertFilteredTransactionCount(tb testing.TB, opts TransactionFilterOptions, expectedCount int) *FinanceDriver {
+	tb.Helper()
+	if tb.Failed() {
+		return f
+	}
+
+	req := &financev1.ListTransactionsRequest{Tags: opts.Tags}
+	if opts.Category != "" {
+		catID, ok := f.driver.state.Categories[opts.Category]
+		if !ok {
+			tb.Fatalf("category named %q not found in state registry", opts.Category)
+		}
+		req.CategoryId = &catID
+	}
+	if opts.Filter != "" {
+		req.Filter = &opts.Filter
+	}
+	if opts.SavedFilter != "" {
+		sfID, ok := f.driver.state.SavedFilters[opts.SavedFilter]
+		if !ok {
+			tb.Fatalf("saved filter named %q not found in state registry", opts.SavedFilter)
+		}
+		req.SavedFilterId = &sfID
+	}
+
+	client := f.getClient()
+	resp, err := client.ListTransactions(tb.Context(), req)
+	if err != nil {
+		tb.Fatalf("ListTransactions SDK call failed: %v", err)
+	}
+
+	count := len(resp.GetTransactions())
+	if count != expectedCount {
+		tb.Errorf("Filtered transaction count = %d, want %d", count, expectedCount)
+	}
+	return f
+}
+
+// AssertSpentInsightsCategory asserts the spend attributed to a category in the insights distribution.
+func (f *FinanceDriver) AssertSpentInsightsCategory(tb testing.TB, categoryName string, expectedSpent int64) *FinanceDriver {
+	tb.Helper()
+	if tb.Failed() {
+		return f
+	}
+
+	catID, ok := f.driver.state.Categories[categoryName]
+	if !ok {
+		tb.Fatalf("category named %q not found in state registry", categoryName)
+	}
+
+	client := f.getClient()
+	now := time.Now()
+	resp, err := client.GetInsights(tb.Context(), &financev1.GetInsightsRequest{
+		Granularity: financev1.InsightGranularity_MONTHLY,
+		StartDate:   timestamppb.New(now.AddDate(-1, 0, 0)),
+		EndDate:     timestamppb.New(now.AddDate(1, 0, 0)),
+	})
+	if err != nil {
+		tb.Fatalf("GetInsights SDK call failed: %v", err)
+	}
+
+	for _, c := range resp.GetSpent().GetCategories() {
+		if c.GetCategoryId() == catID {
+			if c.GetSpentInBase() != expectedSpent {
+				tb.Errorf("Category %q SpentInBase = %d, want %d", categoryName, c.GetSpentInBase(), expectedSpent)
+			}
+			return f
+		}
+	}
+
+	tb.Errorf("Category %q not found in insights distribution", categoryName)
+	return f
+}
+
+// CreateIncomeSource registers an income source using IncomeSourceOptions struct.
+func (f *FinanceDriver) CreateIncomeSource(tb testing.TB, opts IncomeSourceOptions) *FinanceDriver {
+	tb.Helper()
+	if tb.Failed() {
+		return f
+	}
+
+	var accountID *string
+	if opts.Account != "" {
+		acc, ok := f.driver.state.Accounts[opts.Account]
+		if !ok {
+			tb.Fatalf("account named %q not found in state registry", opts.Account)
+		}
+		accountID = &acc.ID
+	}
+
+	cadence := opts.Cadence
+	if cadence == financev1.IncomeSource_CADENCE_UNSPECIFIED {
+		cadence = financev1.IncomeSource_MONTHLY
+	}
+
+	client := f.getClient()
+	src, err := client.CreateIncomeSource(tb.Context(), &financev1.CreateIncomeSourceRequest{
+		IncomeSource: &financev1.IncomeSource{
+			Name:           opts.Name,
+			Employer:       opts.Employer,
+			ExpectedAmount: opts.ExpectedAmount,
+			Currency:       opts.Currency,
+			Cadence:        cadence,
+			AccountId:      accountID,
+		},
+	})
+	if err != nil {
+		tb.Fatalf("CreateIncomeSource SDK call failed: %v", err)
+	}
+
+	if src.GetName() != opts.Name {
+		tb.Errorf("CreateIncomeSource Name = %q, want %q", src.GetName(), opts.Name)
+	}
+	if !src.GetIsActive() {
+		tb.Errorf("CreateIncomeSource IsActive = false, want true")
+	}
+
+	f.driver.state.IncomeSources[opts.Name] = src.GetId()
+	return f
+}
+
+// CreateIncome logs an income transaction using IncomeOptions struct.
+func (f *FinanceDriver) CreateIncome(tb testing.TB, opts IncomeOptions) *FinanceDriver {
+	tb.Helper()
+	if tb.Failed() {
+		return f
+	}
+
+	input := &financev1.IncomeInput{
+		Amount:      opts.Amount,
+		Currency:    opts.Currency,
+		Description: opts.Description,
+	}
+
+	if opts.Account != "" {
+		acc, ok := f.driver.state.Accounts[opts.Account]
+		if !ok {
+			tb.Fatalf("account named %q not found in state registry", opts.Account)
+		}
+		input.AccountId = &acc.ID
+		if input.Currency == "" {
+			input.Currency = acc.Currency
+		}
+	}
+
+	if opts.IncomeSource != "" {
+		srcID, ok := f.driver.state.IncomeSources[opts.IncomeSource]
+		if !ok {
+			tb.Fatalf("income source named %q not found in state registry", opts.IncomeSource)
+		}
+		input.IncomeSourceId = &srcID
+	}
+
+	client := f.getClient()
+	txn, err := client.CreateIncome(tb.Context(), &financev1.CreateIncomeRequest{
+		Income: input,
+	})
+
+	if opts.ExpectErr != "" {
+		if err == nil {
+			tb.Fatalf("CreateIncome succeeded, but expected error containing %q", opts.ExpectErr)
+		}
+		if !strings.Contains(err.Error(), opts.ExpectErr) {
+			tb.Fatalf("CreateIncome error = %v, want error containing %q", err, opts.ExpectErr)
+		}
+		return f
+	}
+
+	if err != nil {
+		tb.Fatalf("CreateIncome SDK call failed: %v", err)
+	}
+
+	if txn.GetType() != financev1.Transaction_INCOME {
+		tb.Errorf("CreateIncome Type = %v, want INCOME", txn.GetType())
+	}
+	if txn.GetAmount() != opts.Amount {
+		tb.Errorf("CreateIncome Amount = %d, want %d", txn.GetAmount(), opts.Amount)
+	}
+	if txn.GetBudgetId() != "" {
+		tb.Errorf("CreateIncome BudgetId = %s, want empty", txn.GetBudgetId())
+	}
+
+	f.driver.state.LastTransactionID = txn.GetId()
+	if opts.Assert != nil {
+		opts.Assert(tb, txn)
+	}
+	return f
+}
+
+// AssertCashFlow verifies net cash flow insights for the active space.
+func (f *FinanceDriver) AssertCashFlow(tb testing.TB, expectedIncome, expectedExpense int64) *FinanceDriver {
+	tb.Helper()
+	if tb.Failed() {
+		return f
+	}
+
+	client := f.getClient()
+	now := time.Now()
+	start := timestamppb.New(now.AddDate(-1, 0, 0))
+	end := timestamppb.New(now.AddDate(1, 0, 0))
+
+	resp, err := client.GetInsights(tb.Context(), &financev1.GetInsightsRequest{
+		Granularity: financev1.InsightGranularity_MONTHLY,
+		StartDate:   start,
+		EndDate:     end,
+	})
+	if err != nil {
+		tb.Fatalf("GetInsights SDK call failed: %v", err)
+	}
+
+	cf := resp.GetCashFlow()
+	if cf == nil {
+		tb.Fatalf("GetInsights returned nil cash flow statistics")
+	}
+
+	if cf.GetTotalIncome() != expectedIncome {
+		tb.Errorf("GetInsights TotalIncome = %d, want %d", cf.GetTotalIncome(), expectedIncome)
+	}
+	if cf.GetTotalExpense() != expectedExpense {
+		tb.Errorf("GetInsights TotalExpense = %d, want %d", cf.GetTotalExpense(), expectedExpense)
+	}
+	if cf.GetNetCashFlow() != expectedIncome-expectedExpense {
+		tb.Errorf("GetInsights NetCashFlow = %d, want %d", cf.GetNetCashFlow(), expectedIncome-expectedExpense)
+	}
+	return f
+}
+
 // AssertTransaction executes an assertion callback against a transaction fetched live by ID from the API.
 func (f *FinanceDriver) AssertTransaction(tb testing.TB, txnID string, fn func(txn *financev1.Transaction)) *FinanceDriver {
 	tb.Helper()
@@ -1204,6 +1566,84 @@ func (f *FinanceDriver) StageInboxItem(tb testing.TB, opts StageInboxItemOptions
 	return f
 }
 
+// StatementImportOptions encapsulates parameters for importing a bank statement file.
+type StatementImportOptions struct {
+	Account          string
+	Format           financev1.StatementFormat
+	Content          string
+	Mapping          *financev1.StatementMapping
+	SaveMapping      bool
+	ExpectStaged     int
+	ExpectSkipped    int32
+	ExpectDuplicates int32
+	ExpectErr        string
+	Assert           func(tb testing.TB, resp *financev1.ImportStatementResponse)
+}
+
+// ImportStatement uploads a statement file via API and registers each staged row in the
+// inbox registry under its vendor name.
+func (f *FinanceDriver) ImportStatement(tb testing.TB, opts StatementImportOptions) *FinanceDriver {
+	tb.Helper()
+	if tb.Failed() {
+		return f
+	}
+
+	acc, ok := f.driver.state.Accounts[opts.Account]
+	if !ok {
+		tb.Fatalf("ImportStatement: account named %q not found in state registry", opts.Account)
+	}
+
+	client := f.getClient()
+	resp, err := client.ImportStatement(tb.Context(), &financev1.ImportStatementRequest{
+		AccountId:   acc.ID,
+		Format:      opts.Format,
+		Content:     []byte(opts.Content),
+		CsvMapping:  opts.Mapping,
+		SaveMapping: opts.SaveMapping,
+	})
+
+	if opts.ExpectErr != "" {
+		if err == nil {
+			tb.Fatalf("ImportStatement succeeded, but expected error containing %q", opts.ExpectErr)
+		}
+		if !strings.Contains(err.Error(), opts.ExpectErr) {
+			tb.Fatalf("ImportStatement error = %v, want error containing %q", err, opts.ExpectErr)
+		}
+		return f
+	}
+	if err != nil {
+		tb.Fatalf("ImportStatement API call failed: %v", err)
+	}
+
+	if len(resp.GetInboxItems()) != opts.ExpectStaged {
+		tb.Errorf("ImportStatement staged = %d, want %d", len(resp.GetInboxItems()), opts.ExpectStaged)
+	}
+	if resp.GetSkippedCount() != opts.ExpectSkipped {
+		tb.Errorf("ImportStatement skipped = %d, want %d", resp.GetSkippedCount(), opts.ExpectSkipped)
+	}
+	if resp.GetDuplicateCount() != opts.ExpectDuplicates {
+		tb.Errorf("ImportStatement duplicates = %d, want %d", resp.GetDuplicateCount(), opts.ExpectDuplicates)
+	}
+
+	for _, item := range resp.GetInboxItems() {
+		info := &InboxItemInfo{
+			ID:        item.GetId(),
+			DocType:   item.GetDocType(),
+			Amount:    item.GetAmount(),
+			Vendor:    item.GetVendorName(),
+			AccountID: item.GetAccountId(),
+			BudgetID:  item.GetBudgetId(),
+		}
+		f.driver.state.InboxItems[item.GetVendorName()] = info
+		f.driver.state.LastInboxItem = info
+	}
+
+	if opts.Assert != nil {
+		opts.Assert(tb, resp)
+	}
+	return f
+}
+
 // AssertInboxItemCount queries ListInboxItems API for a given status and asserts the expected count.
 func (f *FinanceDriver) AssertInboxItemCount(tb testing.TB, status financev1.InboxItem_Status, expectedCount int) *FinanceDriver {
 	tb.Helper()
@@ -1480,3 +1920,327 @@ func unptr(s *string) string {
 	}
 	return *s
 }
+
+// ReconciliationOptions encapsulates parameters for starting an account reconciliation.
+type ReconciliationOptions struct {
+	Key              string
+	Account          string
+	StatementDate    time.Time
+	StatementBalance int64
+	ExpectErr        string
+	Assert           func(tb testing.TB, r *financev1.Reconciliation)
+}
+
+// StartReconciliation opens a reconciliation session via API and registers it under Key.
+func (f *FinanceDriver) StartReconciliation(tb testing.TB, opts ReconciliationOptions) *FinanceDriver {
+	tb.Helper()
+	if tb.Failed() {
+		return f
+	}
+
+	acc, ok := f.driver.state.Accounts[opts.Account]
+	if !ok {
+		tb.Fatalf("StartReconciliation: account named %q not found in state registry", opts.Account)
+	}
+
+	client := f.getClient()
+	r, err := client.StartReconciliation(tb.Context(), &financev1.StartReconciliationRequest{
+		AccountId: acc.ID,
+		Reconciliation: &financev1.Reconciliation{
+			StatementDate:    timestamppb.New(opts.StatementDate),
+			StatementBalance: opts.StatementBalance,
+		},
+	})
+
+	if opts.ExpectErr != "" {
+		if err == nil {
+			tb.Fatalf("StartReconciliation succeeded, but expected error containing %q", opts.ExpectErr)
+		}
+		if !strings.Contains(err.Error(), opts.ExpectErr) {
+			tb.Fatalf("StartReconciliation error = %v, want error containing %q", err, opts.ExpectErr)
+		}
+		return f
+	}
+	if err != nil {
+		tb.Fatalf("StartReconciliation API call failed: %v", err)
+	}
+
+	if opts.Key != "" {
+		f.driver.state.Reconciliations[opts.Key] = r.GetId()
+	}
+	if opts.Assert != nil {
+		opts.Assert(tb, r)
+	}
+	return f
+}
+
+// ClearTransactionsOptions encapsulates parameters for ticking transactions within a reconciliation.
+type ClearTransactionsOptions struct {
+	Reconciliation   string
+	Descriptions     []string // Transactions listed in the session, matched by description
+	Cleared          bool
+	ExpectDifference int64
+	Assert           func(tb testing.TB, r *financev1.Reconciliation)
+}
+
+// ClearTransactions marks the session transactions matching the given descriptions as cleared
+// and asserts the remaining difference.
+func (f *FinanceDriver) ClearTransactions(tb testing.TB, opts ClearTransactionsOptions) *FinanceDriver {
+	tb.Helper()
+	if tb.Failed() {
+		return f
+	}
+
+	reconID, ok := f.driver.state.Reconciliations[opts.Reconciliation]
+	if !ok {
+		tb.Fatalf("ClearTransactions: reconciliation %q not found in state registry", opts.Reconciliation)
+	}
+
+	client := f.getClient()
+	listResp, err := client.ListReconciliationTransactions(tb.Context(), &financev1.ListReconciliationTransactionsRequest{
+		Id: reconID,
+	})
+	if err != nil {
+		tb.Fatalf("ListReconciliationTransactions API call failed: %v", err)
+	}
+
+	var txnIDs []string
+	for _, desc := range opts.Descriptions {
+		found := false
+		for _, txn := range listResp.GetTransactions() {
+			if txn.GetDescription() == desc {
+				txnIDs = append(txnIDs, txn.GetId())
+				found = true
+			}
+		}
+		if !found {
+			tb.Fatalf("ClearTransactions: no transaction described %q in reconciliation %q", desc, opts.Reconciliation)
+		}
+	}
+
+	r, err := client.SetTransactionsCleared(tb.Context(), &financev1.SetTransactionsClearedRequest{
+		ReconciliationId: reconID,
+		TransactionIds:   txnIDs,
+		Cleared:          opts.Cleared,
+	})
+	if err != nil {
+		tb.Fatalf("SetTransactionsCleared API call failed: %v", err)
+	}
+
+	if r.GetDifference() != opts.ExpectDifference {
+		tb.Errorf("SetTransactionsCleared Difference = %d, want %d", r.GetDifference(), opts.ExpectDifference)
+	}
+	if opts.Assert != nil {
+		opts.Assert(tb, r)
+	}
+	return f
+}
+
+// FinalizeReconciliationOptions encapsulates parameters for finalizing a reconciliation.
+type FinalizeReconciliationOptions struct {
+	Reconciliation   string
+	CreateAdjustment bool
+	ExpectErr        string
+	Assert           func(tb testing.TB, r *financev1.Reconciliation)
+}
+
+// FinalizeReconciliation closes a reconciliation session via API.
+func (f *FinanceDriver) FinalizeReconciliation(tb testing.TB, opts FinalizeReconciliationOptions) *FinanceDriver {
+	tb.Helper()
+	if tb.Failed() {
+		return f
+	}
+
+	reconID, ok := f.driver.state.Reconciliations[opts.Reconciliation]
+	if !ok {
+		tb.Fatalf("FinalizeReconciliation: reconciliation %q not found in state registry", opts.Reconciliation)
+	}
+
+	client := f.getClient()
+	r, err := client.FinalizeReconciliation(tb.Context(), &financev1.FinalizeReconciliationRequest{
+		Id:               reconID,
+		CreateAdjustment: opts.CreateAdjustment,
+	})
+
+	if opts.ExpectErr != "" {
+		if err == nil {
+			tb.Fatalf("FinalizeReconciliation succeeded, but expected error containing %q", opts.ExpectErr)
+		}
+		if !strings.Contains(err.Error(), opts.ExpectErr) {
+			tb.Fatalf("FinalizeReconciliation error = %v, want error containing %q", err, opts.ExpectErr)
+		}
+		return f
+	}
+	if err != nil {
+		tb.Fatalf("FinalizeReconciliation API call failed: %v", err)
+	}
+
+	if r.GetStatus() != financev1.Reconciliation_FINALIZED {
+		tb.Errorf("FinalizeReconciliation Status = %v, want FINALIZED", r.GetStatus())
+	}
+	if opts.Assert != nil {
+		opts.Assert(tb, r)
+	}
+	return f
+}
+
+// DeleteLastTransaction deletes the most recently created transaction via API.
+func (f *FinanceDriver) DeleteLastTransaction(tb testing.TB, expectErr ...string) *FinanceDriver {
+	tb.Helper()
+	if tb.Failed() {
+		return f
+	}
+	if f.driver.state.LastTransactionID == "" {
+		tb.Fatalf("DeleteLastTransaction called, but no LastTransactionID recorded in driver state")
+	}
+
+	client := f.getClient()
+	_, err := client.DeleteTransaction(tb.Context(), &financev1.DeleteTransactionRequest{
+		Id: f.driver.state.LastTransactionID,
+	})
+
+	if len(expectErr) > 0 && expectErr[0] != "" {
+		if err == nil {
+			tb.Fatalf("DeleteTransaction succeeded, but expected error containing %q", expectErr[0])
+		}
+		if !strings.Contains(err.Error(), expectErr[0]) {
+			tb.Fatalf("DeleteTransaction error = %v, want error containing %q", err, expectErr[0])
+		}
+		return f
+	}
+	if err != nil {
+		tb.Fatalf("DeleteTransaction API call failed: %v", err)
+	}
+	return f
+}
+
+// GoalOptions encapsulates parameters for creating a savings goal.
+type GoalOptions struct {
+	Name         string
+	TargetAmount int64
+	Currency     string
+	Account      string // Optional: name/alias of the linked savings account
+	Tag          string // Optional: contribution tag
+	Deadline     *time.Time
+	StartDate    *time.Time
+	ExpectErr    string
+}
+
+// CreateGoal creates a savings goal using GoalOptions struct.
+func (f *FinanceDriver) CreateGoal(tb testing.TB, opts GoalOptions) *FinanceDriver {
+	tb.Helper()
+	if tb.Failed() {
+		return f
+	}
+
+	goal := &financev1.Goal{
+		Name:         opts.Name,
+		TargetAmount: opts.TargetAmount,
+		Currency:     opts.Currency,
+		Tag:          opts.Tag,
+	}
+	if opts.Account != "" {
+		acc, ok := f.driver.state.Accounts[opts.Account]
+		if !ok {
+			tb.Fatalf("account named %q not found in state registry", opts.Account)
+		}
+		goal.AccountId = &acc.ID
+		if goal.Currency == "" {
+			goal.Currency = acc.Currency
+		}
+	}
+	if opts.Deadline != nil {
+		goal.Deadline = timestamppb.New(*opts.Deadline)
+	}
+	if opts.StartDate != nil {
+		goal.StartDate = timestamppb.New(*opts.StartDate)
+	}
+
+	client := f.getClient()
+	created, err := client.CreateGoal(tb.Context(), &financev1.CreateGoalRequest{Goal: goal})
+	if opts.ExpectErr != "" {
+		if err == nil {
+			tb.Fatalf("CreateGoal succeeded, but expected error containing %q", opts.ExpectErr)
+		}
+		if !strings.Contains(err.Error(), opts.ExpectErr) {
+			tb.Fatalf("CreateGoal error = %v, want error containing %q", err, opts.ExpectErr)
+		}
+		return f
+	}
+	if err != nil {
+		tb.Fatalf("CreateGoal SDK call failed: %v", err)
+	}
+
+	if created.GetStatus() != financev1.Goal_ACTIVE {
+		tb.Errorf("CreateGoal Status = %v, want ACTIVE", created.GetStatus())
+	}
+
+	f.driver.state.Goals[opts.Name] = created.GetId()
+	return f
+}
+
+// AssertGoalProgress fetches a goal's progress and verifies the saved amount.
+func (f *FinanceDriver) AssertGoalProgress(tb testing.TB, goalName string, expectedSaved int64, assert ...func(tb testing.TB, p *financev1.GoalProgress)) *FinanceDriver {
+	tb.Helper()
+	if tb.Failed() {
+		return f
+	}
+	goalID, ok := f.driver.state.Goals[goalName]
+	if !ok {
+		tb.Fatalf("goal named %q not found in state registry", goalName)
+	}
+
+	client := f.getClient()
+	progress, err := client.GetGoalProgress(tb.Context(), &financev1.GetGoalProgressRequest{Id: goalID})
+	if err != nil {
+		tb.Fatalf("GetGoalProgress API call failed: %v", err)
+	}
+
+	if progress.GetSavedAmount() != expectedSaved {
+		tb.Errorf("Goal %q SavedAmount = %d, want %d", goalName, progress.GetSavedAmount(), expectedSaved)
+	}
+	for _, fn := range assert {
+		fn(tb, progress)
+	}
+	return f
+}
+
+// SavedFilterOptions encapsulates parameters for creating a saved transaction filter.
+type SavedFilterOptions struct {
+	Name       string
+	Expression string
+	Sort       string
+	ExpectErr  string
+}
+
+// CreateSavedFilter creates a saved transaction filter using SavedFilterOptions struct.
+func (f *FinanceDriver) CreateSavedFilter(tb testing.TB, opts SavedFilterOptions) *FinanceDriver {
+	tb.Helper()
+	if tb.Failed() {
+		return f
+	}
+
+	client := f.getClient()
+	created, err := client.CreateSavedFilter(tb.Context(), &financev1.CreateSavedFilterRequest{
+		SavedFilter: &financev1.SavedFilter{
+			Name:       opts.Name,
+			Expression: opts.Expression,
+			Sort:       opts.Sort,
+		},
+	})
+	if opts.ExpectErr != "" {
+		if err == nil {
+			tb.Fatalf("CreateSavedFilter succeeded, but expected error containing %q", opts.ExpectErr)
+		}
+		if !strings.Contains(err.Error(), opts.ExpectErr) {
+			tb.Fatalf("CreateSavedFilter error = %v, want error containing %q", err, opts.ExpectErr)
+		}
+		return f
+	}
+	if err != nil {
+		tb.Fatalf("CreateSavedFilter SDK call failed: %v", err)
+	}
+
+	f.driver.state.SavedFilters[opts.Name] = created.GetId()
+	return f
+}
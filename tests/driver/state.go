@@ -67,6 +67,11 @@ type State struct {
 	InboxItems        map[string]*InboxItemInfo
 	Budgets           map[string]string
 	ScheduledPayments map[string]string
+	IncomeSources     map[string]string
+	Categories        map[string]string
+	Reconciliations   map[string]string
+	Goals             map[string]string
+	SavedFilters      map[string]string
 
 	LastAccount                     *AccountInfo
 	LastBorrowing                   *BorrowingInfo
@@ -90,6 +95,11 @@ func newState(t *testing.T) *State {
 		InboxItems:        make(map[string]*InboxItemInfo),
 		Budgets:           make(map[string]string),
 		ScheduledPayments: make(map[string]string),
+		IncomeSources:     make(map[string]string),
+		Categories:        make(map[string]string),
+		Reconciliations:   make(map[string]string),
+		Goals:             make(map[string]string),
+		SavedFilters:      make(map[string]string),
 	}
 }
 
@@ -106,6 +116,11 @@ func (s *State) ClearRegistries() {
 	s.InboxItems = make(map[string]*InboxItemInfo)
 	s.Budgets = make(map[string]string)
 	s.ScheduledPayments = make(map[string]string)
+	s.IncomeSources = make(map[string]string)
+	s.Categories = make(map[string]string)
+	s.Reconciliations = make(map[string]string)
+	s.Goals = make(map[string]string)
+	s.SavedFilters = make(map[string]string)
 	s.LastAccount = nil
 	s.LastBorrowing = nil
 	s.LastRepayment = nil
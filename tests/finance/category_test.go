@@ -0,0 +1,71 @@
+package finance_test
+
+import (
+	"testing"
+
+	financev1 "github.com/masterkeysrd/saturn/apis/saturn/finance/v1"
+	"github.com/masterkeysrd/saturn/tests/driver"
+)
+
+// TestFinanceCategories_ClassifyExpenses tests classifying expenses with nested categories and tags.
+func TestFinanceCategories_ClassifyExpenses(t *testing.T) {
+	d := driver.New(t, testEnv)
+
+	d.Auth().
+		CreateApprovedUser(t).
+		Login(t)
+
+	d.Space().
+		Ensure(t, "Personal Space")
+
+	d.Finance().
+		InitSettings(t, "USD").
+		CreateAccount(t, driver.AccountOptions{
+			Name:           "Checking Account",
+			Type:           financev1.Account_BANK,
+			Currency:       "USD",
+			InitialBalance: 100000,
+		}).
+		CreateBudget(t, driver.BudgetOptions{
+			Name:        "Household",
+			Currency:    "USD",
+			LimitAmount: 50000,
+		}).
+		CreateCategory(t, driver.CategoryOptions{Name: "Food", Icon: "utensils", Color: "orange"}).
+		CreateCategory(t, driver.CategoryOptions{Name: "Groceries", Parent: "Food"}).
+		CreateCategory(t, driver.CategoryOptions{Name: "Restaurants", Parent: "Food"}).
+		CreateExpense(t, driver.ExpenseOptions{
+			Account:     "Checking Account",
+			Budget:      "Household",
+			Category:    "Groceries",
+			Tags:        []string{"Weekly", "costco"},
+			Amount:      4500,
+			Description: "Weekly groceries",
+			Assert: func(tb testing.TB, txn *financev1.Transaction) {
+				if len(txn.GetTags()) != 2 || txn.GetTags()[0] != "costco" || txn.GetTags()[1] != "weekly" {
+					tb.Errorf("expected normalized tags [costco weekly], got %v", txn.GetTags())
+				}
+			},
+		}).
+		CreateExpense(t, driver.ExpenseOptions{
+			Account:     "Checking Account",
+			Budget:      "Household",
+			Category:    "Restaurants",
+			Tags:        []string{"vacation"},
+			Amount:      3000,
+			Description: "Dinner out",
+		}).
+		CreateExpense(t, driver.ExpenseOptions{
+			Account:     "Checking Account",
+			Budget:      "Household",
+			Tags:        []string{"weekly"},
+			Amount:      1200,
+			Description: "Parking",
+		}).
+		AssertFilteredTransactionCount(t, driver.TransactionFilterOptions{Category: "Food"}, 2).
+		AssertFilteredTransactionCount(t, driver.TransactionFilterOptions{Category: "Groceries"}, 1).
+		AssertFilteredTransactionCount(t, driver.TransactionFilterOptions{Tags: []string{"weekly"}}, 2).
+		AssertFilteredTransactionCount(t, driver.TransactionFilterOptions{Category: "Food", Tags: []string{"vacation"}}, 1).
+		AssertSpentInsightsCategory(t, "Groceries", 4500).
+		AssertSpentInsightsCategory(t, "Restaurants", 3000)
+}
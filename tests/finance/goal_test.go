@@ -0,0 +1,96 @@
+package finance_test
+
+import (
+	"testing"
+	"time"
+
+	financev1 "github.com/masterkeysrd/saturn/apis/saturn/finance/v1"
+	"github.com/masterkeysrd/saturn/tests/driver"
+)
+
+// TestFinanceGoal_TransfersAndTaggedContributions tests goal progress from savings transfers and tagged expenses.
+func TestFinanceGoal_TransfersAndTaggedContributions(t *testing.T) {
+	d := driver.New(t, testEnv)
+
+	d.Auth().
+		CreateApprovedUser(t).
+		Login(t)
+
+	d.Space().
+		Ensure(t, "Personal Space")
+
+	deadline := time.Now().UTC().AddDate(1, 0, 0)
+	startDate := time.Now().UTC().AddDate(0, 0, -1)
+
+	d.Finance().
+		InitSettings(t, "USD").
+		CreateAccount(t, driver.AccountOptions{
+			Name:           "Checking Account",
+			Type:           financev1.Account_BANK,
+			Currency:       "USD",
+			InitialBalance: 500000,
+		}).
+		CreateAccount(t, driver.AccountOptions{
+			Name:     "Savings Account",
+			Type:     financev1.Account_BANK,
+			Currency: "USD",
+		}).
+		CreateBudget(t, driver.BudgetOptions{
+			Name:        "Travel",
+			LimitAmount: 100000,
+			Currency:    "USD",
+		}).
+		CreateGoal(t, driver.GoalOptions{
+			Name:         "No Source",
+			TargetAmount: 100000,
+			Currency:     "USD",
+			ExpectErr:    "linked to an account or a contribution tag",
+		}).
+		CreateGoal(t, driver.GoalOptions{
+			Name:         "Vacation",
+			TargetAmount: 200000,
+			Account:      "Savings Account",
+			Tag:          "vacation",
+			Deadline:     &deadline,
+			StartDate:    &startDate,
+		}).
+		CreateTransfer(t, driver.TransferOptions{
+			FromAccount:  "Checking Account",
+			ToAccount:    "Savings Account",
+			SourceAmount: 60000,
+		}).
+		CreateTransfer(t, driver.TransferOptions{
+			FromAccount:  "Savings Account",
+			ToAccount:    "Checking Account",
+			SourceAmount: 10000,
+		}).
+		CreateExpense(t, driver.ExpenseOptions{
+			Account:     "Checking Account",
+			Budget:      "Travel",
+			Amount:      15000,
+			Currency:    "USD",
+			Description: "Flight deposit",
+			Tags:        []string{"vacation"},
+		}).
+		AssertGoalProgress(t, "Vacation", 65000, func(tb testing.TB, p *financev1.GoalProgress) {
+			if p.GetContributionCount() != 3 {
+				tb.Errorf("ContributionCount = %d, want 3", p.GetContributionCount())
+			}
+			if p.GetRemainingAmount() != 135000 {
+				tb.Errorf("RemainingAmount = %d, want 135000", p.GetRemainingAmount())
+			}
+			if p.GetProjectedCompletionDate() == nil || !p.GetOnTrack() {
+				tb.Errorf("expected an on-track projection, got %v (on track %v)", p.GetProjectedCompletionDate(), p.GetOnTrack())
+			}
+		})
+
+	insights, err := d.Finance().Client().GetInsights(t.Context(), &financev1.GetInsightsRequest{
+		Granularity: financev1.InsightGranularity_MONTHLY,
+	})
+	if err != nil {
+		t.Fatalf("GetInsights failed: %v", err)
+	}
+	if len(insights.GetGoals()) != 1 || insights.GetGoals()[0].GetSavedAmount() != 65000 {
+		t.Errorf("expected the Vacation goal in insights, got %v", insights.GetGoals())
+	}
+}
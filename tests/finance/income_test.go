@@ -0,0 +1,88 @@
+package finance_test
+
+import (
+	"testing"
+
+	financev1 "github.com/masterkeysrd/saturn/apis/saturn/finance/v1"
+	"github.com/masterkeysrd/saturn/tests/driver"
+)
+
+// TestFinanceIncome_SalaryIntoChecking tests logging income from a registered income source.
+func TestFinanceIncome_SalaryIntoChecking(t *testing.T) {
+	d := driver.New(t, testEnv)
+
+	d.Auth().
+		CreateApprovedUser(t).
+		Login(t)
+
+	d.Space().
+		Ensure(t, "Personal Space")
+
+	d.Finance().
+		InitSettings(t, "USD").
+		CreateAccount(t, driver.AccountOptions{
+			Name:           "Checking Account",
+			Type:           financev1.Account_BANK,
+			Currency:       "USD",
+			InitialBalance: 100000,
+		}).
+		CreateIncomeSource(t, driver.IncomeSourceOptions{
+			Name:           "Salary",
+			Employer:       "Acme Corp",
+			Account:        "Checking Account",
+			Currency:       "USD",
+			ExpectedAmount: 250000,
+			Cadence:        financev1.IncomeSource_MONTHLY,
+		}).
+		CreateIncome(t, driver.IncomeOptions{
+			IncomeSource: "Salary",
+			Currency:     "USD",
+			Amount:       250000, // $2,500.00
+			Description:  "October paycheck",
+			Assert: func(tb testing.TB, txn *financev1.Transaction) {
+				if txn.GetMetadata()["income_source_id"] == "" {
+					tb.Errorf("expected income_source_id metadata to be set")
+				}
+			},
+		}).
+		AssertAccountBalance(t, "Checking Account", 350000)
+}
+
+// TestFinanceIncome_NetCashFlow tests net cash flow aggregation across income and expenses.
+func TestFinanceIncome_NetCashFlow(t *testing.T) {
+	d := driver.New(t, testEnv)
+
+	d.Auth().
+		CreateApprovedUser(t).
+		Login(t)
+
+	d.Space().
+		Ensure(t, "Personal Space")
+
+	d.Finance().
+		InitSettings(t, "USD").
+		CreateAccount(t, driver.AccountOptions{
+			Name:           "Checking Account",
+			Type:           financev1.Account_BANK,
+			Currency:       "USD",
+			InitialBalance: 0,
+		}).
+		CreateBudget(t, driver.BudgetOptions{
+			Name:        "Groceries",
+			LimitAmount: 50000,
+			Currency:    "USD",
+		}).
+		CreateIncome(t, driver.IncomeOptions{
+			Account:     "Checking Account",
+			Amount:      200000,
+			Description: "Freelance invoice",
+		}).
+		CreateExpense(t, driver.ExpenseOptions{
+			Account:     "Checking Account",
+			Budget:      "Groceries",
+			Amount:      30000,
+			Description: "Weekly groceries",
+		}).
+		AssertCashFlow(t, 200000, 30000).
+		AssertAccountBalance(t, "Checking Account", 170000)
+}
@@ -0,0 +1,106 @@
+package finance_test
+
+import (
+	"testing"
+	"time"
+
+	financev1 "github.com/masterkeysrd/saturn/apis/saturn/finance/v1"
+	"github.com/masterkeysrd/saturn/tests/driver"
+)
+
+// TestFinanceReconciliation tests clearing transactions against statements, locking them on finalize and booking adjustments.
+func TestFinanceReconciliation(t *testing.T) {
+	d := driver.New(t, testEnv)
+
+	d.Auth().
+		CreateApprovedUser(t).
+		Login(t)
+
+	d.Space().
+		Ensure(t, "Personal Space")
+
+	today := time.Now().UTC()
+
+	d.Finance().
+		InitSettings(t, "USD").
+		CreateAccount(t, driver.AccountOptions{
+			Name:           "Checking Account",
+			Type:           financev1.Account_BANK,
+			Currency:       "USD",
+			InitialBalance: 100000,
+		}).
+		CreateBudget(t, driver.BudgetOptions{
+			Name:        "Household",
+			Currency:    "USD",
+			LimitAmount: 50000,
+		}).
+		CreateExpense(t, driver.ExpenseOptions{
+			Account:     "Checking Account",
+			Budget:      "Household",
+			Amount:      500,
+			Description: "Coffee",
+		}).
+		CreateExpense(t, driver.ExpenseOptions{
+			Account:     "Checking Account",
+			Budget:      "Household",
+			Amount:      2000,
+			Description: "Groceries",
+		}).
+		StartReconciliation(t, driver.ReconciliationOptions{
+			Key:              "first",
+			Account:          "Checking Account",
+			StatementDate:    today,
+			StatementBalance: 98000,
+			Assert: func(tb testing.TB, r *financev1.Reconciliation) {
+				if r.GetOpeningBalance() != 100000 || r.GetDifference() != -2000 {
+					tb.Errorf("unexpected balances: opening=%d difference=%d", r.GetOpeningBalance(), r.GetDifference())
+				}
+			},
+		}).
+		StartReconciliation(t, driver.ReconciliationOptions{
+			Account:       "Checking Account",
+			StatementDate: today,
+			ExpectErr:     "reconciliation in progress",
+		}).
+		ClearTransactions(t, driver.ClearTransactionsOptions{
+			Reconciliation:   "first",
+			Descriptions:     []string{"Groceries"},
+			Cleared:          true,
+			ExpectDifference: 0,
+		}).
+		FinalizeReconciliation(t, driver.FinalizeReconciliationOptions{
+			Reconciliation: "first",
+		}).
+		AssertLastTransaction(t, func(txn *financev1.Transaction) {
+			if !txn.GetCleared() || txn.GetReconciliationId() == "" {
+				t.Errorf("expected Groceries to be cleared and reconciled, got cleared=%v reconciliation=%q", txn.GetCleared(), txn.GetReconciliationId())
+			}
+		}).
+		DeleteLastTransaction(t, "locked by a finalized reconciliation").
+		StartReconciliation(t, driver.ReconciliationOptions{
+			Key:              "second",
+			Account:          "Checking Account",
+			StatementDate:    today.AddDate(0, 0, 1),
+			StatementBalance: 99000,
+		}).
+		ClearTransactions(t, driver.ClearTransactionsOptions{
+			Reconciliation:   "second",
+			Descriptions:     []string{"Coffee"},
+			Cleared:          true,
+			ExpectDifference: 1500,
+		}).
+		FinalizeReconciliation(t, driver.FinalizeReconciliationOptions{
+			Reconciliation: "second",
+			ExpectErr:      "difference must be zero",
+		}).
+		FinalizeReconciliation(t, driver.FinalizeReconciliationOptions{
+			Reconciliation:   "second",
+			CreateAdjustment: true,
+			Assert: func(tb testing.TB, r *financev1.Reconciliation) {
+				if r.GetAdjustmentTransactionId() == "" || r.GetClearedBalance() != 99000 {
+					tb.Errorf("expected adjustment closing the statement, got %+v", r)
+				}
+			},
+		}).
+		AssertAccountBalance(t, "Checking Account", 99000)
+}
@@ -0,0 +1,73 @@
+package finance_test
+
+import (
+	"testing"
+
+	financev1 "github.com/masterkeysrd/saturn/apis/saturn/finance/v1"
+	"github.com/masterkeysrd/saturn/tests/driver"
+)
+
+// TestFinanceTransactions_FilterExpressions tests AIP-160 filters and saved filters on ListTransactions.
+func TestFinanceTransactions_FilterExpressions(t *testing.T) {
+	d := driver.New(t, testEnv)
+
+	d.Auth().
+		CreateApprovedUser(t).
+		Login(t)
+
+	d.Space().
+		Ensure(t, "Personal Space")
+
+	d.Finance().
+		InitSettings(t, "USD").
+		CreateAccount(t, driver.AccountOptions{
+			Name:           "Checking Account",
+			Type:           financev1.Account_BANK,
+			Currency:       "USD",
+			InitialBalance: 100000,
+		}).
+		CreateBudget(t, driver.BudgetOptions{
+			Name:        "Groceries",
+			Currency:    "USD",
+			LimitAmount: 50000,
+		}).
+		CreateBudget(t, driver.BudgetOptions{
+			Name:        "Leisure",
+			Currency:    "USD",
+			LimitAmount: 50000,
+		}).
+		CreateExpense(t, driver.ExpenseOptions{
+			Account:     "Checking Account",
+			Budget:      "Groceries",
+			Amount:      8000,
+			Description: "Weekly groceries",
+		}).
+		CreateExpense(t, driver.ExpenseOptions{
+			Account:     "Checking Account",
+			Budget:      "Groceries",
+			Amount:      1500,
+			Description: "Bakery",
+		}).
+		CreateExpense(t, driver.ExpenseOptions{
+			Account:     "Checking Account",
+			Budget:      "Leisure",
+			Amount:      6000,
+			Description: "Concert tickets",
+			Tags:        []string{"music"},
+		}).
+		AssertFilteredTransactionCount(t, driver.TransactionFilterOptions{Filter: `type = "EXPENSE" AND amount >= 5000`}, 2).
+		AssertFilteredTransactionCount(t, driver.TransactionFilterOptions{Filter: `type = "EXPENSE" AND (description:"groceries" OR tags:"music")`}, 2).
+		AssertFilteredTransactionCount(t, driver.TransactionFilterOptions{Filter: `type = "EXPENSE" AND NOT amount < 2000`}, 2).
+		CreateSavedFilter(t, driver.SavedFilterOptions{
+			Name:       "Broken",
+			Expression: "amount >",
+			ExpectErr:  "invalid filter",
+		}).
+		CreateSavedFilter(t, driver.SavedFilterOptions{
+			Name:       "Large expenses",
+			Expression: `type = "EXPENSE" AND amount > 5000`,
+			Sort:       "amount:desc",
+		}).
+		AssertFilteredTransactionCount(t, driver.TransactionFilterOptions{SavedFilter: "Large expenses"}, 2).
+		AssertFilteredTransactionCount(t, driver.TransactionFilterOptions{SavedFilter: "Large expenses", Filter: `tags:"music"`}, 1)
+}
@@ -0,0 +1,71 @@
+package finance_test
+
+import (
+	"testing"
+
+	financev1 "github.com/masterkeysrd/saturn/apis/saturn/finance/v1"
+	"github.com/masterkeysrd/saturn/tests/driver"
+)
+
+// TestFinanceSplits_AttributeSpendPerBudget tests splitting a single expense across budgets and categories.
+func TestFinanceSplits_AttributeSpendPerBudget(t *testing.T) {
+	d := driver.New(t, testEnv)
+
+	d.Auth().
+		CreateApprovedUser(t).
+		Login(t)
+
+	d.Space().
+		Ensure(t, "Personal Space")
+
+	d.Finance().
+		InitSettings(t, "USD").
+		CreateAccount(t, driver.AccountOptions{
+			Name:           "Checking Account",
+			Type:           financev1.Account_BANK,
+			Currency:       "USD",
+			InitialBalance: 100000,
+		}).
+		CreateBudget(t, driver.BudgetOptions{
+			Name:        "Groceries",
+			Currency:    "USD",
+			LimitAmount: 40000,
+		}).
+		CreateBudget(t, driver.BudgetOptions{
+			Name:        "Pharmacy",
+			Currency:    "USD",
+			LimitAmount: 10000,
+		}).
+		CreateCategory(t, driver.CategoryOptions{Name: "Health"}).
+		CreateExpense(t, driver.ExpenseOptions{
+			Account:     "Checking Account",
+			Amount:      8000,
+			Description: "Supermarket run",
+			Splits: []driver.SplitOptions{
+				{Budget: "Groceries", Amount: 6500, Note: "Weekly groceries"},
+				{Budget: "Pharmacy", Category: "Health", Amount: 1500, Note: "Vitamins"},
+			},
+			Assert: func(tb testing.TB, txn *financev1.Transaction) {
+				if txn.GetBudgetId() != "" {
+					tb.Errorf("expected no transaction-level budget, got %s", txn.GetBudgetId())
+				}
+				for _, s := range txn.GetSplits() {
+					if s.GetPeriodId() == "" {
+						tb.Errorf("expected split %s to resolve a budget period", s.GetId())
+					}
+				}
+			},
+		}).
+		AssertBudgetProgress(t, "Groceries", 6500, 33500).
+		AssertBudgetProgress(t, "Pharmacy", 1500, 8500).
+		AssertSpentInsightsCategory(t, "Health", 1500).
+		CreateExpense(t, driver.ExpenseOptions{
+			Account: "Checking Account",
+			Amount:  5000,
+			Splits: []driver.SplitOptions{
+				{Budget: "Groceries", Amount: 3000},
+				{Budget: "Pharmacy", Amount: 1000},
+			},
+			ExpectErr: "split amounts must add up to the transaction amount",
+		})
+}
@@ -0,0 +1,149 @@
+package finance_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	financev1 "github.com/masterkeysrd/saturn/apis/saturn/finance/v1"
+	"github.com/masterkeysrd/saturn/tests/driver"
+)
+
+// TestFinanceStatements_ImportOFX tests staging OFX rows, flagging ledger duplicates and skipping re-imports.
+func TestFinanceStatements_ImportOFX(t *testing.T) {
+	d := driver.New(t, testEnv)
+
+	d.Auth().
+		CreateApprovedUser(t).
+		Login(t)
+
+	d.Space().
+		Ensure(t, "Personal Space")
+
+	today := time.Now().UTC().Format("20060102")
+	ofx := fmt.Sprintf(`OFXHEADER:100
+DATA:OFXSGML
+VERSION:102
+
+<OFX>
+<BANKMSGSRSV1><STMTTRNRS><STMTRS>
+<CURDEF>USD
+<BANKTRANLIST>
+<STMTTRN>
+<TRNTYPE>DEBIT
+<DTPOSTED>%[1]s
+<TRNAMT>-45.20
+<FITID>FIT-001
+<NAME>Coffee House
+</STMTTRN>
+<STMTTRN>
+<TRNTYPE>CREDIT
+<DTPOSTED>%[1]s
+<TRNAMT>1500.00
+<FITID>FIT-002
+<NAME>Acme Payroll
+</STMTTRN>
+</BANKTRANLIST>
+</STMTRS></STMTTRNRS></BANKMSGSRSV1>
+</OFX>`, today)
+
+	d.Finance().
+		InitSettings(t, "USD").
+		CreateAccount(t, driver.AccountOptions{
+			Name:           "Checking Account",
+			Type:           financev1.Account_BANK,
+			Currency:       "USD",
+			InitialBalance: 100000,
+		}).
+		CreateBudget(t, driver.BudgetOptions{
+			Name:        "Dining",
+			Currency:    "USD",
+			LimitAmount: 20000,
+		}).
+		CreateExpense(t, driver.ExpenseOptions{
+			Account:     "Checking Account",
+			Budget:      "Dining",
+			Amount:      4520,
+			Description: "Coffee House",
+		}).
+		ImportStatement(t, driver.StatementImportOptions{
+			Account:          "Checking Account",
+			Format:           financev1.StatementFormat_STATEMENT_FORMAT_OFX,
+			Content:          ofx,
+			ExpectStaged:     2,
+			ExpectDuplicates: 1,
+			Assert: func(tb testing.TB, resp *financev1.ImportStatementResponse) {
+				for _, item := range resp.GetInboxItems() {
+					if item.GetSource() != "statement_ofx" {
+						tb.Errorf("expected source statement_ofx, got %q", item.GetSource())
+					}
+					if item.GetVendorName() == "Coffee House" && item.GetMetadata()["duplicate_warning"] != "true" {
+						tb.Errorf("expected Coffee House row to be flagged as a duplicate")
+					}
+				}
+			},
+		}).
+		AssertInboxItemCount(t, financev1.InboxItem_PENDING, 2).
+		ImportStatement(t, driver.StatementImportOptions{
+			Account:       "Checking Account",
+			Format:        financev1.StatementFormat_STATEMENT_FORMAT_OFX,
+			Content:       ofx,
+			ExpectStaged:  0,
+			ExpectSkipped: 2,
+		}).
+		AssertInboxItemCount(t, financev1.InboxItem_PENDING, 2).
+		ApproveInboxItem(t, "Acme Payroll").
+		AssertInboxItemCount(t, financev1.InboxItem_RESOLVED, 1)
+}
+
+// TestFinanceStatements_ImportCSVWithSavedMapping tests that a saved CSV column mapping is reused by later imports.
+func TestFinanceStatements_ImportCSVWithSavedMapping(t *testing.T) {
+	d := driver.New(t, testEnv)
+
+	d.Auth().
+		CreateApprovedUser(t).
+		Login(t)
+
+	d.Space().
+		Ensure(t, "Personal Space")
+
+	mapping := &financev1.StatementMapping{
+		HasHeader:         true,
+		DateColumn:        "Date",
+		DateFormat:        "01/02/2006",
+		DebitColumn:       "Withdrawal",
+		CreditColumn:      "Deposit",
+		DescriptionColumn: "Payee",
+	}
+
+	d.Finance().
+		InitSettings(t, "USD").
+		CreateAccount(t, driver.AccountOptions{
+			Name:           "Checking Account",
+			Type:           financev1.Account_BANK,
+			Currency:       "USD",
+			InitialBalance: 100000,
+		}).
+		ImportStatement(t, driver.StatementImportOptions{
+			Account:   "Checking Account",
+			Format:    financev1.StatementFormat_STATEMENT_FORMAT_CSV,
+			Content:   "Date,Payee,Withdrawal,Deposit\n03/01/2026,Hardware Store,120.00,\n",
+			ExpectErr: "statement column mapping not found",
+		}).
+		ImportStatement(t, driver.StatementImportOptions{
+			Account:      "Checking Account",
+			Format:       financev1.StatementFormat_STATEMENT_FORMAT_CSV,
+			Content:      "Date,Payee,Withdrawal,Deposit\n03/01/2026,Hardware Store,120.00,\n",
+			Mapping:      mapping,
+			SaveMapping:  true,
+			ExpectStaged: 1,
+		}).
+		ImportStatement(t, driver.StatementImportOptions{
+			Account:       "Checking Account",
+			Format:        financev1.StatementFormat_STATEMENT_FORMAT_CSV,
+			Content:       "Date,Payee,Withdrawal,Deposit\n03/01/2026,Hardware Store,120.00,\n03/02/2026,Refund,,15.00\n",
+			ExpectStaged:  1,
+			ExpectSkipped: 1,
+		}).
+		AssertInboxItemCount(t, financev1.InboxItem_PENDING, 2)
+}
@@ -60,6 +60,10 @@ func generateSDKFile(gen *protogen.Plugin, f *protogen.File) {
 		g.P()
 
 		for _, method := range service.Methods {
+			// Streaming RPCs have no JSON request/response mapping; they are served by custom HTTP handlers.
+			if method.Desc.IsStreamingClient() || method.Desc.IsStreamingServer() {
+				continue
+			}
 			generateSDKMethod(g, method)
 		}
 	}